@@ -21,6 +21,9 @@ import (
 	smartaccountante "github.com/osmosis-labs/osmosis/v26/x/smart-account/ante"
 	smartaccountkeeper "github.com/osmosis-labs/osmosis/v26/x/smart-account/keeper"
 
+	circuitbreakerante "github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/ante"
+	circuitbreakerkeeper "github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/keeper"
+
 	auctionkeeper "github.com/skip-mev/block-sdk/v2/x/auction/keeper"
 
 	txfeeskeeper "github.com/osmosis-labs/osmosis/v26/x/txfees/keeper"
@@ -47,6 +50,7 @@ func NewAnteHandler(
 	txCounterStoreKey corestoretypes.KVStoreService,
 	accountKeeper ante.AccountKeeper,
 	smartAccountKeeper *smartaccountkeeper.Keeper,
+	circuitBreakerKeeper *circuitbreakerkeeper.Keeper,
 	bankKeeper txfeestypes.BankKeeper,
 	txFeesKeeper *txfeeskeeper.Keeper,
 	spotPriceCalculator txfeestypes.SpotPriceCalculator,
@@ -104,6 +108,7 @@ func NewAnteHandler(
 		wasmkeeper.NewCountTXDecorator(txCounterStoreKey),
 		ante.NewExtensionOptionsDecorator(nil),
 		v9.MsgFilterDecorator{},
+		circuitbreakerante.NewMsgCircuitBreakerDecorator(circuitBreakerKeeper),
 		// Use Mempool Fee Decorator from our txfees module instead of default one from auth
 		// https://github.com/cosmos/cosmos-sdk/blob/master/x/auth/middleware/fee.go#L34
 		mempoolFeeDecorator,
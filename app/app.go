@@ -156,11 +156,15 @@ import (
 	clclient "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/client"
 	cwpoolclient "github.com/osmosis-labs/osmosis/v26/x/cosmwasmpool/client"
 	gammclient "github.com/osmosis-labs/osmosis/v26/x/gamm/client"
+	ibcratelimitclient "github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/client"
 	incentivesclient "github.com/osmosis-labs/osmosis/v26/x/incentives/client"
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v26/x/pool-incentives/client"
 	poolmanagerclient "github.com/osmosis-labs/osmosis/v26/x/poolmanager/client"
 	superfluidclient "github.com/osmosis-labs/osmosis/v26/x/superfluid/client"
+	twapclient "github.com/osmosis-labs/osmosis/v26/x/twap/client"
 	txfeesclient "github.com/osmosis-labs/osmosis/v26/x/txfees/client"
+
+	epochsclient "github.com/osmosis-labs/osmosis/x/epochs/client"
 )
 
 const appName = "OsmosisApp"
@@ -519,13 +523,23 @@ func NewOsmosisApp(
 					gammclient.UpdateMigrationRecordsProposalHandler,
 					gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 					gammclient.SetScalingFactorControllerProposalHandler,
+					gammclient.SetPoolDrainProtectionProposalHandler,
+					gammclient.SetPoolRebalancingFeeDiscountProposalHandler,
 					clclient.CreateConcentratedLiquidityPoolProposalHandler,
 					clclient.TickSpacingDecreaseProposalHandler,
+					clclient.SetPoolMetadataProposalHandler,
 					cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 					cwpoolclient.MigratePoolContractsProposalHandler,
 					txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 					poolmanagerclient.DenomPairTakerFeeProposalHandler,
+					poolmanagerclient.SetPoolMigrationLinksProposalHandler,
+					poolmanagerclient.SetCanonicalRoutesProposalHandler,
+					twapclient.FreezeTwapProposalHandler,
+					twapclient.SetPoolObservationIntervalProposalHandler,
+					ibcratelimitclient.SetRateLimitProposalHandler,
 					incentivesclient.HandleCreateGroupsProposal,
+					epochsclient.AddEpochInfosProposalHandler,
+					epochsclient.RemoveEpochInfosProposalHandler,
 				},
 			),
 		},
@@ -584,6 +598,7 @@ func NewOsmosisApp(
 		runtime.NewKVStoreService(app.GetKey(wasmtypes.StoreKey)),
 		app.AccountKeeper,
 		app.SmartAccountKeeper,
+		app.CircuitBreakerKeeper,
 		app.BankKeeper,
 		app.TxFeesKeeper,
 		app.GAMMKeeper,
@@ -1,11 +1,15 @@
 package apptesting
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
 	clmath "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/math"
 	clmodel "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
@@ -55,6 +59,39 @@ type ConcentratedSwapTest struct {
 	ExpectErr      bool
 }
 
+// ConcentratedPosition describes a position to create with PrepareConcentratedPoolWithPositions.
+// The price range is expressed in terms of the pool's spot price and is rounded down to the
+// pool's tick spacing. A nil LowerPrice and UpperPrice creates a full range position.
+// Setting only one of them is invalid.
+type ConcentratedPosition struct {
+	Owner      sdk.AccAddress
+	Coins      sdk.Coins
+	LowerPrice osmomath.Dec
+	UpperPrice osmomath.Dec
+}
+
+// TickRange returns the lower and upper ticks of the position, rounded down to the given tick spacing,
+// or the full range if the position has no price range.
+// Returns an error if only one of LowerPrice and UpperPrice is set or if either is not positive.
+func (p ConcentratedPosition) TickRange(tickSpacing uint64) (lowerTick, upperTick int64, err error) {
+	if p.LowerPrice.IsNil() && p.UpperPrice.IsNil() {
+		return types.MinInitializedTick, types.MaxTick, nil
+	}
+	if p.LowerPrice.IsNil() || p.UpperPrice.IsNil() {
+		return 0, 0, fmt.Errorf("position must set both or neither of its lower and upper prices, got lower %s and upper %s", p.LowerPrice, p.UpperPrice)
+	}
+
+	lowerTick, err = priceToTickRoundDownSpacing(p.LowerPrice, tickSpacing)
+	if err != nil {
+		return 0, 0, err
+	}
+	upperTick, err = priceToTickRoundDownSpacing(p.UpperPrice, tickSpacing)
+	if err != nil {
+		return 0, 0, err
+	}
+	return lowerTick, upperTick, nil
+}
+
 type SecondConcentratedPosition struct {
 	TickIndex                  int64
 	ExpectedSpreadRewardGrowth sdk.DecCoins
@@ -906,6 +943,105 @@ func (s *KeeperTestHelper) WithdrawFullRangePosition(pool types.ConcentratedPool
 	s.Require().NoError(err)
 }
 
+// PrepareConcentratedPoolWithPositions sets up a concentrated liquidity pool with the given denoms and spread
+// factor whose spot price is initialized to spotPrice, and creates the given positions in it. The spot price is
+// set by a full range position owned by the first test account, funded with DefaultCoinAmount of denom0.
+// The owners of the positions are funded with the position coins. The spread factor is authorized if needed.
+// Fails the test if spreadFactor or spotPrice is nil, spotPrice is not positive or a position's price range is invalid.
+func (s *KeeperTestHelper) PrepareConcentratedPoolWithPositions(denom0, denom1 string, spreadFactor, spotPrice osmomath.Dec, positions []ConcentratedPosition) types.ConcentratedPoolExtension {
+	s.Require().False(spreadFactor.IsNil(), "spread factor must be set")
+	s.Require().False(spotPrice.IsNil(), "spot price must be set")
+	s.Require().True(spotPrice.IsPositive(), "spot price must be positive, got %s", spotPrice)
+
+	clParams := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	if !osmoutils.Contains(clParams.AuthorizedSpreadFactors, spreadFactor) {
+		clParams.AuthorizedSpreadFactors = append(clParams.AuthorizedSpreadFactors, spreadFactor)
+		s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, clParams)
+	}
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], denom0, denom1, DefaultTickSpacing, spreadFactor)
+
+	// The first position in a pool sets its spot price to the ratio of the provided amounts.
+	initialCoins := sdk.NewCoins(sdk.NewCoin(denom0, DefaultCoinAmount), sdk.NewCoin(denom1, spotPrice.MulInt(DefaultCoinAmount).TruncateInt()))
+	s.Require().Len(initialCoins, 2, "spot price %s is too low to initialize the pool", spotPrice)
+	s.CreateFullRangePosition(pool, initialCoins)
+
+	for _, position := range positions {
+		lowerTick, upperTick, err := position.TickRange(pool.GetTickSpacing())
+		s.Require().NoError(err)
+
+		s.FundAcc(position.Owner, position.Coins)
+		_, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), position.Owner, position.Coins, osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+		s.Require().NoError(err)
+	}
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	return pool
+}
+
+// PriceToTickRoundDownSpacing converts the given price to a tick, rounded down to the given tick spacing.
+// Fails the test if the price is nil or not positive.
+func (s *KeeperTestHelper) PriceToTickRoundDownSpacing(price osmomath.Dec, tickSpacing uint64) int64 {
+	tick, err := priceToTickRoundDownSpacing(price, tickSpacing)
+	s.Require().NoError(err)
+	return tick
+}
+
+// priceToTickRoundDownSpacing converts the given price to a tick, rounded down to the given tick spacing.
+// Returns an error if the price is nil or not positive.
+func priceToTickRoundDownSpacing(price osmomath.Dec, tickSpacing uint64) (int64, error) {
+	if price.IsNil() || !price.IsPositive() {
+		return 0, fmt.Errorf("price must be positive, got %s", price)
+	}
+	sqrtPrice, err := osmomath.MonotonicSqrt(price)
+	if err != nil {
+		return 0, err
+	}
+	return clmath.SqrtPriceToTickRoundDownSpacing(osmomath.BigDecFromDec(sqrtPrice), tickSpacing)
+}
+
+// SwapTo swaps against the concentrated liquidity pool with the given id on behalf of sender until the pool's
+// spot price reaches targetPrice. The pool must have enough liquidity to reach it. The sender is funded with
+// the token in required, including the taker fee. Returns the token in consumed by the pool and the token out.
+// Fails the test if targetPrice is nil or not positive.
+func (s *KeeperTestHelper) SwapTo(poolId uint64, sender sdk.AccAddress, targetPrice osmomath.BigDec) (tokenIn, tokenOut sdk.Coin) {
+	s.Require().False(targetPrice.IsNil(), "target price must be set")
+	s.Require().True(targetPrice.IsPositive(), "target price must be positive, got %s", targetPrice)
+
+	clk := s.App.ConcentratedLiquidityKeeper
+	pool, err := clk.GetConcentratedPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	// Swapping token1 in increases the spot price, swapping token0 in decreases it.
+	tokenInDenom, tokenOutDenom := pool.GetToken0(), pool.GetToken1()
+	if targetPrice.GT(pool.GetCurrentSqrtPrice().Mul(pool.GetCurrentSqrtPrice())) {
+		tokenInDenom, tokenOutDenom = pool.GetToken1(), pool.GetToken0()
+	}
+	sqrtPriceLimit := osmomath.MustMonotonicSqrtBigDec(targetPrice)
+
+	// Estimate the token in required to reach the target price on a cached context by offering the pool
+	// far more token in than it may consume. The estimating sender is exempt from the taker fee so that
+	// the amount consumed is exactly the amount swapped.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	estimateSender := CreateRandomAccounts(1)[0]
+	maxTokenIn := sdk.NewCoin(tokenInDenom, osmomath.NewIntWithDecimal(1, 40))
+	s.Require().NoError(testutil.FundAccount(cacheCtx, s.App.BankKeeper, estimateSender, sdk.NewCoins(maxTokenIn)))
+	s.App.PoolManagerKeeper.SetParam(cacheCtx, poolmanagertypes.KeyReducedTakerFeeByWhitelist, []string{estimateSender.String()})
+	requiredTokenIn, _, err := clk.SwapExactAmountInWithSqrtPriceLimit(cacheCtx, estimateSender, poolId, maxTokenIn, tokenOutDenom, osmomath.ZeroInt(), sqrtPriceLimit)
+	s.Require().NoError(err)
+
+	// Gross up the required token in by the taker fee, which is charged before swapping.
+	takerFee, err := s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, tokenInDenom, tokenOutDenom)
+	s.Require().NoError(err)
+	tokenInWithTakerFee := sdk.NewCoin(tokenInDenom, requiredTokenIn.Amount.ToLegacyDec().Quo(osmomath.OneDec().Sub(takerFee)).Ceil().TruncateInt())
+
+	s.FundAcc(sender, sdk.NewCoins(tokenInWithTakerFee))
+	tokenIn, tokenOut, err = clk.SwapExactAmountInWithSqrtPriceLimit(s.Ctx, sender, poolId, tokenInWithTakerFee, tokenOutDenom, osmomath.ZeroInt(), sqrtPriceLimit)
+	s.Require().NoError(err)
+	return tokenIn, tokenOut
+}
+
 // SetupConcentratedLiquidityDenomsAndPoolCreation sets up the default authorized quote denoms.
 // Additionally, enables permissionless pool creation.
 // This is to overwrite the default params set in concentrated liquidity genesis to account for the test cases that
@@ -151,6 +151,24 @@ func (s *KeeperTestHelper) PrepareImbalancedStableswapPool() uint64 {
 	return poolId
 }
 
+// PrepareStableswapPoolWithCoins returns a stableswap pool
+// consisted of given coins with equal scaling factors.
+func (s *KeeperTestHelper) PrepareStableswapPoolWithCoins(coins ...sdk.Coin) uint64 {
+	// Mint some assets to the account.
+	poolCoins := sdk.NewCoins(coins...)
+	s.FundAcc(s.TestAccs[0], poolCoins)
+
+	params := stableswap.PoolParams{
+		SwapFee: osmomath.NewDec(0),
+		ExitFee: osmomath.NewDec(0),
+	}
+
+	msg := stableswap.NewMsgCreateStableswapPool(s.TestAccs[0], params, poolCoins, []uint64{}, "")
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+	s.NoError(err)
+	return poolId
+}
+
 // PrepareBalancerPoolWithPoolParams sets up a Balancer pool with poolParams.
 // Uses default pool assets.
 func (s *KeeperTestHelper) PrepareBalancerPoolWithPoolParams(poolParams balancer.PoolParams) uint64 {
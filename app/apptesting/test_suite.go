@@ -333,6 +333,15 @@ func (s *KeeperTestHelper) FundAcc(acc sdk.AccAddress, amounts sdk.Coins) {
 	s.Require().NoError(err)
 }
 
+// CreateFundedAccounts creates numAccts random accounts and funds each of them with the given coins.
+func (s *KeeperTestHelper) CreateFundedAccounts(numAccts int, coins sdk.Coins) []sdk.AccAddress {
+	accs := CreateRandomAccounts(numAccts)
+	for _, acc := range accs {
+		s.FundAcc(acc, coins)
+	}
+	return accs
+}
+
 // FundModuleAcc funds target modules with specified amount.
 func (s *KeeperTestHelper) FundModuleAcc(moduleName string, amounts sdk.Coins) {
 	err := testutil.FundModuleAccount(s.Ctx, s.App.BankKeeper, moduleName, amounts)
@@ -51,6 +51,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v26/x/gamm"
 	ibcratelimit "github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit"
 	ibcratelimittypes "github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/types"
+	ibcswap "github.com/osmosis-labs/osmosis/v26/x/ibc-swap"
 	"github.com/osmosis-labs/osmosis/v26/x/poolmanager"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v26/x/protorev"
@@ -79,6 +80,8 @@ import (
 	// IBC Transfer: Defines the "transfer" IBC port
 	transfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 
+	circuitbreakerkeeper "github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/keeper"
+	circuitbreakertypes "github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
 	"github.com/osmosis-labs/osmosis/v26/x/smart-account/authenticator"
 	smartaccountkeeper "github.com/osmosis-labs/osmosis/v26/x/smart-account/keeper"
 	smartaccounttypes "github.com/osmosis-labs/osmosis/v26/x/smart-account/types"
@@ -177,6 +180,7 @@ type AppKeepers struct {
 	ConcentratedLiquidityKeeper  *concentratedliquidity.Keeper
 	CosmwasmPoolKeeper           *cosmwasmpool.Keeper
 	SmartAccountKeeper           *smartaccountkeeper.Keeper
+	CircuitBreakerKeeper         *circuitbreakerkeeper.Keeper
 	AuthenticatorManager         *authenticator.AuthenticatorManager
 
 	// IBC modules
@@ -187,6 +191,7 @@ type AppKeepers struct {
 	Ics20WasmHooks            *ibchooks.WasmHooks
 	HooksICS4Wrapper          ibchooks.ICS4Middleware
 	PacketForwardKeeper       *packetforwardkeeper.Keeper
+	IBCSwapper                *ibcswap.Swapper
 
 	// BlockSDK
 	AuctionKeeper *auctionkeeper.Keeper
@@ -253,6 +258,13 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.SmartAccountKeeper = &smartAccountKeeper
 
+	circuitBreakerKeeper := circuitbreakerkeeper.NewKeeper(
+		appKeepers.keys[circuitbreakertypes.StoreKey],
+		appKeepers.GetSubspace(circuitbreakertypes.ModuleName),
+		govModuleAddr,
+	)
+	appKeepers.CircuitBreakerKeeper = &circuitBreakerKeeper
+
 	authzKeeper := authzkeeper.NewKeeper(
 		runtime.NewKVStoreService(appKeepers.keys[authzkeeper.StoreKey]),
 		appCodec,
@@ -284,6 +296,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 
 	appKeepers.DowntimeKeeper = downtimedetector.NewKeeper(
 		appKeepers.keys[downtimetypes.StoreKey],
+		appKeepers.GetSubspace(downtimetypes.ModuleName),
 	)
 
 	slashingKeeper := slashingkeeper.NewKeeper(
@@ -428,7 +441,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.BankKeeper, appKeepers.DistrKeeper,
 		appKeepers.ConcentratedLiquidityKeeper,
 		appKeepers.PoolIncentivesKeeper,
-		appKeepers.IncentivesKeeper)
+		appKeepers.IncentivesKeeper,
+		appKeepers.LockupKeeper)
 	appKeepers.GAMMKeeper = &gammKeeper
 	appKeepers.ConcentratedLiquidityKeeper.SetGammKeeper(appKeepers.GAMMKeeper)
 
@@ -448,6 +462,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.WasmKeeper,
 	)
 	appKeepers.PoolManagerKeeper.SetStakingKeeper(appKeepers.StakingKeeper)
+	appKeepers.IBCSwapper.PoolManagerKeeper = appKeepers.PoolManagerKeeper
 	appKeepers.GAMMKeeper.SetPoolManager(appKeepers.PoolManagerKeeper)
 	appKeepers.ConcentratedLiquidityKeeper.SetPoolManagerKeeper(appKeepers.PoolManagerKeeper)
 	appKeepers.CosmwasmPoolKeeper.SetPoolManagerKeeper(appKeepers.PoolManagerKeeper)
@@ -457,8 +472,10 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.PoolManagerKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
+	appKeepers.GAMMKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
 
-	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
+	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey], appKeepers.GetSubspace(epochstypes.ModuleName))
 
 	protorevKeeper := protorevkeeper.NewKeeper(
 		appCodec, appKeepers.keys[protorevtypes.StoreKey],
@@ -480,6 +497,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.BankKeeper,
 		appKeepers.keys[txfeestypes.StoreKey],
 		appKeepers.PoolManagerKeeper,
+		appKeepers.TwapKeeper,
 		appKeepers.ProtoRevKeeper,
 		appKeepers.DistrKeeper,
 		appKeepers.ConsensusParamsKeeper,
@@ -504,6 +522,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.ConcentratedLiquidityKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
 	appKeepers.GAMMKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
+	appKeepers.LockupKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
 
 	mintKeeper := mintkeeper.NewKeeper(
 		appKeepers.keys[minttypes.StoreKey],
@@ -513,6 +532,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.DistrKeeper,
 		appKeepers.EpochsKeeper,
 		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.MintKeeper = &mintKeeper
 
@@ -526,6 +546,11 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.PoolManagerKeeper,
 		appKeepers.EpochsKeeper,
 		appKeepers.GAMMKeeper,
+		appKeepers.MintKeeper,
+		appKeepers.LockupKeeper,
+		appKeepers.ConcentratedLiquidityKeeper,
+		appKeepers.TwapKeeper,
+		appKeepers.ProtoRevKeeper,
 	)
 	appKeepers.PoolIncentivesKeeper = &poolIncentivesKeeper
 	appKeepers.PoolManagerKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
@@ -540,8 +565,10 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.TokenFactoryKeeper = &tokenFactoryKeeper
+	appKeepers.IncentivesKeeper.SetTokenFactoryKeeper(appKeepers.TokenFactoryKeeper)
 
 	validatorSetPreferenceKeeper := valsetpref.NewKeeper(
 		appKeepers.keys[valsetpreftypes.StoreKey],
@@ -549,6 +576,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.StakingKeeper,
 		appKeepers.DistrKeeper,
 		appKeepers.LockupKeeper,
+		appKeepers.SlashingKeeper,
 	)
 
 	// initialize the auction keeper
@@ -568,7 +596,13 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.SuperfluidKeeper = superfluidkeeper.NewKeeper(
 		appKeepers.keys[superfluidtypes.StoreKey], appKeepers.GetSubspace(superfluidtypes.ModuleName),
 		*appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.StakingKeeper, appKeepers.DistrKeeper, appKeepers.EpochsKeeper, appKeepers.LockupKeeper, appKeepers.GAMMKeeper, appKeepers.IncentivesKeeper,
-		lockupkeeper.NewMsgServerImpl(appKeepers.LockupKeeper), appKeepers.ConcentratedLiquidityKeeper, appKeepers.PoolManagerKeeper, appKeepers.ValidatorSetPreferenceKeeper)
+		lockupkeeper.NewMsgServerImpl(appKeepers.LockupKeeper), appKeepers.ConcentratedLiquidityKeeper, appKeepers.PoolManagerKeeper, appKeepers.ValidatorSetPreferenceKeeper,
+		appKeepers.TokenFactoryKeeper)
+
+	// superfluid receipt tokens are not transferable unless governance allows it.
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.SuperfluidKeeper.ReceiptTokenSendRestriction)
+	// tokenfactory denoms frozen by their admin are not transferable.
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.TokenFactoryKeeper.DenomFrozenSendRestriction)
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -586,7 +620,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		"cosmwasm_2_1",
 	}
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper, appKeepers.DowntimeKeeper, appKeepers.PoolManagerKeeper, appKeepers.GAMMKeeper), wasmOpts...)
 	wasmOpts = append(owasm.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
 
 	wasmKeeper := wasmkeeper.NewKeeper(
@@ -646,7 +680,10 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(concentratedliquiditytypes.RouterKey, concentratedliquidity.NewConcentratedLiquidityProposalHandler(*appKeepers.ConcentratedLiquidityKeeper)).
 		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper)).
 		AddRoute(poolmanagertypes.RouterKey, poolmanager.NewPoolManagerProposalHandler(*appKeepers.PoolManagerKeeper)).
-		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper))
+		AddRoute(twaptypes.RouterKey, twap.NewTwapProposalHandler(*appKeepers.TwapKeeper)).
+		AddRoute(ibcratelimittypes.RouterKey, ibcratelimit.NewRateLimitProposalHandler(appKeepers.RateLimitingICS4Wrapper)).
+		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper)).
+		AddRoute(epochstypes.RouterKey, epochskeeper.NewEpochsProposalHandler(*appKeepers.EpochsKeeper))
 
 	govConfig := govtypes.DefaultConfig()
 	// Set the maximum metadata length for government-related configurations to 10,200, deviating from the default value of 256.
@@ -664,14 +701,17 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 // * SendPacket. Originates from the transferKeeper and goes up the stack:
 // transferKeeper.SendPacket -> ibc_rate_limit.SendPacket -> ibc_hooks.SendPacket -> channel.SendPacket
 // * RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-// channel.RecvPacket -> ibc_hooks.OnRecvPacket -> ibc_rate_limit.OnRecvPacket -> forward.OnRecvPacket -> transfer.OnRecvPacket
+// channel.RecvPacket -> ibc_hooks.OnRecvPacket -> ibc_rate_limit.OnRecvPacket -> ibc_swap.OnRecvPacket -> forward.OnRecvPacket -> transfer.OnRecvPacket
 //
 // Note that the forward middleware is only integrated on the "receive" direction. It can be safely skipped when sending.
 // Note also that the forward middleware is called "router", but we are using the name "forward" for clarity
 // This may later be renamed upstream: https://github.com/ibc-apps/middleware/packet-forward-middleware/issues/10
 //
+// Note that the swap middleware is only integrated on the "receive" direction as well.
+//
 // After this, the wasm keeper is required to be set on both
-// appkeepers.WasmHooks AND appKeepers.RateLimitingICS4Wrapper
+// appkeepers.WasmHooks AND appKeepers.RateLimitingICS4Wrapper,
+// and the pool manager keeper is required to be set on appKeepers.IBCSwapper
 func (appKeepers *AppKeepers) WireICS20PreWasmKeeper(
 	appCodec codec.Codec,
 	bApp *baseapp.BaseApp,
@@ -694,6 +734,7 @@ func (appKeepers *AppKeepers) WireICS20PreWasmKeeper(
 		nil,
 		appKeepers.BankKeeper,
 		appKeepers.GetSubspace(ibcratelimittypes.ModuleName),
+		appKeepers.keys[ibcratelimittypes.StoreKey],
 	)
 	appKeepers.RateLimitingICS4Wrapper = &rateLimitingICS4Wrapper
 
@@ -735,8 +776,14 @@ func (appKeepers *AppKeepers) WireICS20PreWasmKeeper(
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
 
+	// Swap Middleware
+	// The pool manager keeper is set later
+	swapper := ibcswap.NewSwapper(nil, appKeepers.BankKeeper, appKeepers.TransferKeeper)
+	appKeepers.IBCSwapper = &swapper
+	swapTransferModule := ibcswap.NewIBCModule(packetForwardMiddleware, appKeepers.IBCSwapper)
+
 	// RateLimiting IBC Middleware
-	rateLimitingTransferModule := ibcratelimit.NewIBCModule(packetForwardMiddleware, appKeepers.RateLimitingICS4Wrapper)
+	rateLimitingTransferModule := ibcratelimit.NewIBCModule(&swapTransferModule, appKeepers.RateLimitingICS4Wrapper)
 
 	// Hooks Middleware
 	hooksTransferModule := ibchooks.NewIBCMiddleware(&rateLimitingTransferModule, &appKeepers.HooksICS4Wrapper)
@@ -827,8 +874,11 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(cosmwasmpooltypes.ModuleName)
 	paramsKeeper.Subspace(ibchookstypes.ModuleName)
 	paramsKeeper.Subspace(smartaccounttypes.ModuleName).WithKeyTable(smartaccounttypes.ParamKeyTable())
+	paramsKeeper.Subspace(circuitbreakertypes.ModuleName).WithKeyTable(circuitbreakertypes.ParamKeyTable())
 	paramsKeeper.Subspace(txfeestypes.ModuleName)
 	paramsKeeper.Subspace(auctiontypes.ModuleName)
+	paramsKeeper.Subspace(epochstypes.ModuleName)
+	paramsKeeper.Subspace(downtimetypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -875,6 +925,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		lockuptypes.NewMultiLockupHooks(
 			// insert lockup hooks receivers here
 			appKeepers.SuperfluidKeeper.Hooks(),
+			appKeepers.IncentivesKeeper.LockupHooks(),
 		),
 	)
 
@@ -900,6 +951,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.GAMMKeeper.EpochHooks(),
 		),
 	)
 
@@ -953,5 +1005,7 @@ func KVStoreKeys() []string {
 		cosmwasmpooltypes.StoreKey,
 		auctiontypes.StoreKey,
 		smartaccounttypes.StoreKey,
+		circuitbreakertypes.StoreKey,
+		ibcratelimittypes.StoreKey,
 	}
 }
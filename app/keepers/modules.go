@@ -35,6 +35,7 @@ import (
 
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
+	circuitbreaker "github.com/osmosis-labs/osmosis/v26/x/circuit-breaker"
 	smartaccount "github.com/osmosis-labs/osmosis/v26/x/smart-account"
 
 	"github.com/skip-mev/block-sdk/v2/x/auction"
@@ -47,6 +48,7 @@ import (
 	downtimemodule "github.com/osmosis-labs/osmosis/v26/x/downtime-detector/module"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm"
 	gammclient "github.com/osmosis-labs/osmosis/v26/x/gamm/client"
+	ibcratelimitclient "github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/client"
 	"github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/ibcratelimitmodule"
 	"github.com/osmosis-labs/osmosis/v26/x/incentives"
 	incentivesclient "github.com/osmosis-labs/osmosis/v26/x/incentives/client"
@@ -60,11 +62,13 @@ import (
 	superfluid "github.com/osmosis-labs/osmosis/v26/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v26/x/superfluid/client"
 	"github.com/osmosis-labs/osmosis/v26/x/tokenfactory"
+	twapclient "github.com/osmosis-labs/osmosis/v26/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v26/x/twap/twapmodule"
 	"github.com/osmosis-labs/osmosis/v26/x/txfees"
 	txfeesclient "github.com/osmosis-labs/osmosis/v26/x/txfees/client"
 	valsetprefmodule "github.com/osmosis-labs/osmosis/v26/x/valset-pref/valpref-module"
 	"github.com/osmosis-labs/osmosis/x/epochs"
+	epochsclient "github.com/osmosis-labs/osmosis/x/epochs/client"
 	ibc_hooks "github.com/osmosis-labs/osmosis/x/ibc-hooks"
 )
 
@@ -90,13 +94,23 @@ var AppModuleBasics = module.NewBasicManager(
 			gammclient.UpdateMigrationRecordsProposalHandler,
 			gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 			gammclient.SetScalingFactorControllerProposalHandler,
+			gammclient.SetPoolDrainProtectionProposalHandler,
+			gammclient.SetPoolRebalancingFeeDiscountProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.SetPoolMetadataProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 			poolmanagerclient.DenomPairTakerFeeProposalHandler,
+			poolmanagerclient.SetPoolMigrationLinksProposalHandler,
+			poolmanagerclient.SetCanonicalRoutesProposalHandler,
+			twapclient.FreezeTwapProposalHandler,
+			twapclient.SetPoolObservationIntervalProposalHandler,
+			ibcratelimitclient.SetRateLimitProposalHandler,
 			incentivesclient.HandleCreateGroupsProposal,
+			epochsclient.AddEpochInfosProposalHandler,
+			epochsclient.RemoveEpochInfosProposalHandler,
 		},
 	),
 	params.AppModuleBasic{},
@@ -133,4 +147,5 @@ var AppModuleBasics = module.NewBasicManager(
 	tendermint.AppModuleBasic{},
 	auction.AppModuleBasic{},
 	smartaccount.AppModuleBasic{},
+	circuitbreaker.AppModuleBasic{},
 )
@@ -71,10 +71,17 @@ func CreateLanes(app *OsmosisApp, txConfig client.TxConfig) (*mevlane.MEVLane, *
 		mevMatchHandler,
 	)
 
-	defaultLane := defaultlane.NewDefaultLane(
+	// The default lane orders txs by the worth of their fee in the base denom per unit of gas, rather than by their
+	// raw fee amount, so it is built like defaultlane.NewDefaultLane but with the txfees mempool priority.
+	defaultLane, err := base.NewBaseLane(
 		defaultConfig,
-		defaultMatchHandler,
+		defaultlane.LaneName,
+		base.WithMatchHandler(defaultMatchHandler),
+		base.WithMempoolConfigs(defaultConfig, app.TxFeesKeeper.MempoolTxPriority()),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	return mevLane, defaultLane
 }
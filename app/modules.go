@@ -70,6 +70,8 @@ import (
 	auctiontypes "github.com/skip-mev/block-sdk/v2/x/auction/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/partialord"
+	circuitbreaker "github.com/osmosis-labs/osmosis/v26/x/circuit-breaker"
+	circuitbreakertypes "github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
 	smartaccount "github.com/osmosis-labs/osmosis/v26/x/smart-account"
 	smartaccounttypes "github.com/osmosis-labs/osmosis/v26/x/smart-account/types"
 
@@ -113,35 +115,38 @@ import (
 // moduleAccountPermissions defines module account permissions
 // TODO: Having to input nil's here is unacceptable, we need a way to automatically derive this.
 var moduleAccountPermissions = map[string][]string{
-	authtypes.FeeCollectorName:               nil,
-	distrtypes.ModuleName:                    nil,
-	ibchookstypes.ModuleName:                 nil,
-	icatypes.ModuleName:                      nil,
-	icqtypes.ModuleName:                      nil,
-	minttypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
-	minttypes.DeveloperVestingModuleAcctName: nil,
-	stakingtypes.BondedPoolName:              {authtypes.Burner, authtypes.Staking},
-	stakingtypes.NotBondedPoolName:           {authtypes.Burner, authtypes.Staking},
-	govtypes.ModuleName:                      {authtypes.Burner},
-	ibctransfertypes.ModuleName:              {authtypes.Minter, authtypes.Burner},
-	gammtypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
-	incentivestypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
-	protorevtypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
-	lockuptypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
-	poolincentivestypes.ModuleName:           nil,
-	superfluidtypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
-	txfeestypes.ModuleName:                   nil,
-	txfeestypes.NonNativeTxFeeCollectorName:  nil,
-	txfeestypes.TakerFeeStakersName:          nil,
-	txfeestypes.TakerFeeCommunityPoolName:    nil,
-	txfeestypes.TakerFeeCollectorName:        nil,
-	wasmtypes.ModuleName:                     {authtypes.Burner},
-	tokenfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-	valsetpreftypes.ModuleName:               {authtypes.Staking},
-	poolmanagertypes.ModuleName:              nil,
-	cosmwasmpooltypes.ModuleName:             nil,
-	auctiontypes.ModuleName:                  nil,
-	smartaccounttypes.ModuleName:             nil,
+	authtypes.FeeCollectorName:                 nil,
+	distrtypes.ModuleName:                      nil,
+	ibchookstypes.ModuleName:                   nil,
+	icatypes.ModuleName:                        nil,
+	icqtypes.ModuleName:                        nil,
+	minttypes.ModuleName:                       {authtypes.Minter, authtypes.Burner},
+	minttypes.DeveloperVestingModuleAcctName:   nil,
+	stakingtypes.BondedPoolName:                {authtypes.Burner, authtypes.Staking},
+	stakingtypes.NotBondedPoolName:             {authtypes.Burner, authtypes.Staking},
+	govtypes.ModuleName:                        {authtypes.Burner},
+	ibctransfertypes.ModuleName:                {authtypes.Minter, authtypes.Burner},
+	gammtypes.ModuleName:                       {authtypes.Minter, authtypes.Burner},
+	incentivestypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+	protorevtypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
+	lockuptypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
+	poolincentivestypes.ModuleName:             nil,
+	superfluidtypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+	txfeestypes.ModuleName:                     nil,
+	txfeestypes.NonNativeTxFeeCollectorName:    nil,
+	txfeestypes.TakerFeeStakersName:            nil,
+	txfeestypes.TakerFeeCommunityPoolName:      nil,
+	txfeestypes.TakerFeeCollectorName:          nil,
+	txfeestypes.FeeBurnerName:                  {authtypes.Burner},
+	wasmtypes.ModuleName:                       {authtypes.Burner},
+	tokenfactorytypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
+	valsetpreftypes.ModuleName:                 {authtypes.Staking},
+	poolmanagertypes.ModuleName:                nil,
+	poolmanagertypes.PoolCreationFeeBurnerName: {authtypes.Burner},
+	cosmwasmpooltypes.ModuleName:               nil,
+	auctiontypes.ModuleName:                    nil,
+	smartaccounttypes.ModuleName:               nil,
+	concentratedliquiditytypes.ModuleName:      nil,
 }
 
 // appModules return modules to initialize module manager.
@@ -209,6 +214,7 @@ func appModules(
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		auction.NewAppModule(appCodec, *app.AuctionKeeper),
 		smartaccount.NewAppModule(appCodec, *app.SmartAccountKeeper),
+		circuitbreaker.NewAppModule(*app.CircuitBreakerKeeper),
 	}
 }
 
@@ -221,7 +227,9 @@ func orderBeginBlockers(allModuleNames []string) []string {
 	// Epochs must come before staking, because txfees epoch hook sends fees to the auth "fee collector"
 	// module account, which is then distributed to stakers. If staking comes before epochs, then the
 	// funds will not be distributed to stakers as expected.
-	ord.FirstElements(epochstypes.ModuleName, capabilitytypes.ModuleName)
+	// Txfees comes before epochs, so that it only burns its fee burn share of the tx fees in the auth
+	// "fee collector", and not of the funds the epoch hooks send there.
+	ord.FirstElements(txfeestypes.ModuleName, epochstypes.ModuleName, capabilitytypes.ModuleName)
 
 	// Staking ordering
 	// TODO: Perhaps this can be relaxed, left to future work to analyze.
@@ -277,6 +285,7 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		twaptypes.ModuleName,
 		txfeestypes.ModuleName,
 		smartaccounttypes.ModuleName,
+		circuitbreakertypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		paramstypes.ModuleName,
@@ -20,7 +20,7 @@ func NewPostHandler(
 	sigModeHandler *txsigning.HandlerMap,
 ) sdk.PostHandler {
 	return sdk.ChainPostDecorators(
-		protorevkeeper.NewProtoRevDecorator(*protoRevKeeper),
+		protorevkeeper.NewProtoRevDecorator(protoRevKeeper),
 		smartaccountpost.NewAuthenticatorPostDecorator(
 			cdc,
 			smartAccountKeeper,
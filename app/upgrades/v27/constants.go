@@ -2,6 +2,8 @@ package v27
 
 import (
 	"github.com/osmosis-labs/osmosis/v26/app/upgrades"
+	circuitbreakertypes "github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+	ibcratelimittypes "github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/types"
 
 	store "cosmossdk.io/store/types"
 )
@@ -13,7 +15,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{},
+		Added:   []string{ibcratelimittypes.StoreKey, circuitbreakertypes.StoreKey},
 		Deleted: []string{},
 	},
 }
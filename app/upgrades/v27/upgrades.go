@@ -6,8 +6,22 @@ import (
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v26/app/keepers"
 	"github.com/osmosis-labs/osmosis/v26/app/upgrades"
+	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	downtimetypes "github.com/osmosis-labs/osmosis/v26/x/downtime-detector/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v26/x/incentives/types"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v26/x/lockup/keeper"
+	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v26/x/protorev/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v26/x/txfees/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 func CreateUpgradeHandler(
@@ -16,7 +30,8 @@ func CreateUpgradeHandler(
 	bpm upgrades.BaseAppParamManager,
 	keepers *keepers.AppKeepers,
 ) upgradetypes.UpgradeHandler {
-	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+	return func(context context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(context)
 		// Run migrations before applying any other state changes.
 		// NOTE: DO NOT PUT ANY STATE CHANGES BEFORE RunMigrations().
 		migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
@@ -24,6 +39,128 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		// Set the newly added max pool superfluid ratio param. It defaults to 100%, which leaves
+		// superfluid delegations uncapped until governance lowers it.
+		keepers.SuperfluidKeeper.SetParam(ctx, superfluidtypes.KeyMaxPoolSuperfluidRatio, superfluidtypes.DefaultMaxPoolSuperfluidRatio)
+
+		// Set the newly added fee burn fraction param. It defaults to 0%, so all collected fees keep
+		// being distributed until governance opts into burning a share of them.
+		keepers.TxFeesKeeper.SetParam(ctx, txfeestypes.KeyFeeBurnFraction, txfeestypes.DefaultFeeBurnFraction)
+
+		// Set the newly added fee swap params. There are no route overrides and the max slippage defaults to 0,
+		// so fee tokens keep being swapped through the protorev pool of their denom pair with full slippage.
+		keepers.TxFeesKeeper.SetParam(ctx, txfeestypes.KeyFeeSwapRoutes, txfeestypes.DefaultFeeSwapRoutes)
+		keepers.TxFeesKeeper.SetParam(ctx, txfeestypes.KeyFeeSwapMaxSlippage, txfeestypes.DefaultFeeSwapMaxSlippage)
+
+		// Set the newly added dynamic base fee params. It is disabled by default, so the min gas price
+		// in DeliverTx stays the consensus min fee until governance enables it.
+		keepers.TxFeesKeeper.SetParam(ctx, txfeestypes.KeyDynamicBaseFeeEnabled, txfeestypes.DefaultDynamicBaseFeeEnabled)
+		keepers.TxFeesKeeper.SetParam(ctx, txfeestypes.KeyMinDynamicBaseFee, txfeestypes.DefaultMinDynamicBaseFee)
+		keepers.TxFeesKeeper.SetParam(ctx, txfeestypes.KeyMaxDynamicBaseFee, txfeestypes.DefaultMaxDynamicBaseFee)
+		keepers.TxFeesKeeper.SetParam(ctx, txfeestypes.KeyDynamicBaseFeeMaxChangeRate, txfeestypes.DefaultDynamicBaseFeeMaxChangeRate)
+
+		// Set the newly added route spot price TWAP check params. The max deviation defaults to 0,
+		// which leaves the check disabled until governance enables it.
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyRouteSpotPriceMaxTwapDeviation, poolmanagertypes.DefaultRouteSpotPriceMaxTwapDeviation)
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyRouteSpotPriceTwapWindow, poolmanagertypes.DefaultRouteSpotPriceTwapWindow)
+
+		// Set the newly added canonical route min pool liquidity param. No canonical routes are registered
+		// at upgrade, so pricing keeps using the existing pools until governance registers routes.
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyCanonicalRouteMinPoolLiquidity, poolmanagertypes.DefaultCanonicalRouteMinPoolLiquidity)
+
+		// Set the newly added pool type creation fees param. There are no overrides, so every pool type
+		// keeps being charged pool_creation_fee to the community pool until governance sets per-type fees.
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyPoolTypeCreationFees, poolmanagertypes.DefaultPoolTypeCreationFees)
+
+		// Set the newly added peg arbitrage params. Peg arbitrage is disabled and there are no links,
+		// so stableswap pools are not rebalanced until governance links them and enables it.
+		keepers.GAMMKeeper.SetParam(ctx, gammtypes.KeyPegArbitrageEnabled, gammtypes.DefaultPegArbitrageEnabled)
+		keepers.GAMMKeeper.SetParam(ctx, gammtypes.KeyPegArbitrageEpochIdentifier, gammtypes.DefaultPegArbitrageEpochIdentifier)
+		keepers.GAMMKeeper.SetParam(ctx, gammtypes.KeyPegArbitrageLinks, gammtypes.DefaultPegArbitrageLinks)
+
+		// Set the newly added lazy reward claiming param. It defaults to false, so lock rewards keep
+		// being sent during the epoch hook until governance switches to claimable reward records.
+		keepers.IncentivesKeeper.SetParam(ctx, incentivestypes.KeyLazyRewardClaiming, incentivestypes.DefaultLazyRewardClaiming)
+
+		// Set the newly added gauge fee per lock param. It defaults to zero, so gauge fees stay flat
+		// until governance prices in the number of locks a gauge distributes to.
+		keepers.IncentivesKeeper.SetParam(ctx, incentivestypes.KeyGaugeFeePerLock, incentivestypes.DefaultGaugeFeePerLock)
+
+		// Set the newly added superfluid receipt token params. They default to false, so no receipt tokens
+		// are issued until governance enables them, and once enabled they are non-transferable by default.
+		keepers.SuperfluidKeeper.SetParam(ctx, superfluidtypes.KeyReceiptTokensEnabled, superfluidtypes.DefaultReceiptTokensEnabled)
+		keepers.SuperfluidKeeper.SetParam(ctx, superfluidtypes.KeyReceiptTokensTransferable, superfluidtypes.DefaultReceiptTokensTransferable)
+
+		// Set the newly added delegation snapshot retention param. It defaults to zero, so no superfluid
+		// delegation snapshots are taken until governance sets how many epochs to retain them for.
+		keepers.SuperfluidKeeper.SetParam(ctx, superfluidtypes.KeyDelegationSnapshotRetentionEpochs, superfluidtypes.DefaultDelegationSnapshotRetentionEpochs)
+
+		// Set the newly added permanent lock conversion duration param. It defaults to zero, so permanent
+		// locks can not be converted, and thus unlocked, until governance sets a conversion duration.
+		keepers.LockupKeeper.SetParam(ctx, lockuptypes.KeyPermanentLockConversionDuration, lockuptypes.DefaultPermanentLockConversionDuration)
+
+		// Set the newly added bulk lock creator addresses param. It defaults to empty, so only
+		// governance can schedule bulk lock distributions.
+		keepers.LockupKeeper.SetParam(ctx, lockuptypes.KeyBulkLockCreatorAddresses, []string{})
+
+		// Set the newly added protorev profit distribution param. All its weights default to zero, so OSMO
+		// profits keep being burned and other profits keep being sent to the community pool.
+		keepers.ProtoRevKeeper.SetParam(ctx, protorevtypes.ParamStoreKeyProfitDistribution, protorevtypes.DefaultProfitDistribution)
+
+		// Set the newly added concentrated liquidity default tick spacings param. Pools created with a tick
+		// spacing keep it, pools created without one get the default tick spacing of their spread factor.
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, cltypes.KeyDefaultTickSpacings, cltypes.DefaultTickSpacings)
+
+		// Set the newly added epochs params, with the epoch hooks of every module enabled.
+		keepers.EpochsKeeper.SetParams(ctx, epochstypes.DefaultParams())
+
+		// Set the newly added downtime-detector params, tracking every downtime length as before.
+		keepers.DowntimeKeeper.SetParams(ctx, downtimetypes.DefaultParams())
+
+		// Replace the mint distribution proportions param with the distribution targets param, keeping the
+		// same split of minted coins.
+		keepers.MintKeeper.MigrateDistributionProportions(ctx)
+
+		// Split the remaining multi-coin locks into single-coin locks, now that new ones are rejected.
+		if err := lockupkeeper.SplitMultiCoinLocks(ctx, *keepers.LockupKeeper); err != nil {
+			return nil, err
+		}
+
+		// Count the existing locks of each denom by duration, now that gauge fees read the number of locks a
+		// gauge distributes to from the lock count store. Runs after the multi-coin locks split.
+		if err := keepers.LockupKeeper.RebuildLockCounts(ctx); err != nil {
+			return nil, err
+		}
+
+		// Index the existing LBP-style balancer pools with a smooth weight change in progress, now that their
+		// completion is picked up from the index in BeginBlock.
+		if err := keepers.GAMMKeeper.BackfillSmoothWeightChanges(ctx); err != nil {
+			return nil, err
+		}
+
+		// Index the existing historical TWAP records by time, now that pruning iterates records by time
+		// instead of by pool.
+		if err := keepers.TwapKeeper.BackfillPruningTimeIndex(ctx); err != nil {
+			return nil, err
+		}
+
+		// Index the existing gauges by the denoms of their reward coins, now that gauges are queryable by reward denom.
+		if err := keepers.IncentivesKeeper.BackfillGaugeRewardDenomRefs(ctx); err != nil {
+			return nil, err
+		}
+
+		// Set the total superfluid bonded amount of each denom, now that superfluid caps read it instead of
+		// iterating all intermediary accounts.
+		if err := keepers.LockupKeeper.BackfillSuperbondedTotals(ctx); err != nil {
+			return nil, err
+		}
+
+		// Checkpoint the existing locks in the reward growth accumulators, now that lazily claimed rewards of
+		// gauges distributing to all locks of a denom are settled from them. Runs after the multi-coin locks split.
+		if err := keepers.IncentivesKeeper.BackfillLockRewardCheckpoints(ctx); err != nil {
+			return nil, err
+		}
+
 		return migrations, nil
 	}
 }
@@ -381,11 +381,11 @@ func MainnetGenesisParams() GenesisParams {
 	genParams.MintParams.MintDenom = genParams.NativeCoinMetadatas[0].Base
 	genParams.MintParams.ReductionFactor = osmomath.NewDec(2).QuoInt64(3) // 2/3
 	genParams.MintParams.ReductionPeriodInEpochs = 365                    // 1 year (screw leap years)
-	genParams.MintParams.DistributionProportions = minttypes.DistributionProportions{
-		Staking:          osmomath.MustNewDecFromStr("0.25"), // 25%
-		DeveloperRewards: osmomath.MustNewDecFromStr("0.25"), // 25%
-		PoolIncentives:   osmomath.MustNewDecFromStr("0.45"), // 45%
-		CommunityPool:    osmomath.MustNewDecFromStr("0.05"), // 5%
+	genParams.MintParams.DistributionTargets = []minttypes.DistributionTarget{
+		{Name: minttypes.DistributionTargetStaking, Weight: osmomath.MustNewDecFromStr("0.25")},          // 25%
+		{Name: minttypes.DistributionTargetDeveloperRewards, Weight: osmomath.MustNewDecFromStr("0.25")}, // 25%
+		{Name: minttypes.DistributionTargetPoolIncentives, Weight: osmomath.MustNewDecFromStr("0.45")},   // 45%
+		{Name: minttypes.DistributionTargetCommunityPool, Weight: osmomath.MustNewDecFromStr("0.05")},    // 5%
 	}
 	genParams.MintParams.MintingRewardsDistributionStartEpoch = 1
 	genParams.MintParams.WeightedDeveloperRewardsReceivers = []minttypes.WeightedAddress{
@@ -828,6 +828,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, t
 		snapshot.Cmd(newApp),
 		pruning.Cmd(newApp, osmosis.DefaultNodeHome),
 	)
+	addSwapTestVectorsCmd(rootCmd, newApp)
 
 	server.AddCommands(rootCmd, osmosis.DefaultNodeHome, newApp, createOsmosisAppAndExport, addModuleInitFlags)
 	server.AddTestnetCreatorCommand(rootCmd, newTestnetApp, addModuleInitFlags)
@@ -0,0 +1,160 @@
+//go:build test_vectors
+
+package cmd
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	osmosis "github.com/osmosis-labs/osmosis/v26/app"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+const (
+	flagSwapTestVectorsHeight  = "height"
+	flagSwapTestVectorsAmounts = "amounts"
+	flagSwapTestVectorsOutput  = "output"
+)
+
+// addSwapTestVectorsCmd adds the hidden command exporting swap test vectors, which is only built with the
+// test_vectors build tag.
+func addSwapTestVectorsCmd(rootCmd *cobra.Command, appCreator servertypes.AppCreator) {
+	rootCmd.AddCommand(exportSwapTestVectorsCmd(appCreator))
+}
+
+// exportSwapTestVectorsCmd exports swap math test vectors of pools at a given height, for client SDKs to
+// validate their local swap math against.
+func exportSwapTestVectorsCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "export-swap-test-vectors [pool-ids]",
+		Short:  "Export swap math test vectors of pools as JSON",
+		Hidden: true,
+		Long: `Export the expected outcome of exact in and exact out swaps between every pair of denoms of the given pools,
+computed by the chain at the given height, as JSON test vectors for client SDKs.
+If no pool ids are given, all pools are exported.
+If no amounts are given, swaps of 1 and of fractions of the pool liquidity of each denom are exported.
+The node must not be running.
+Example:
+	osmosisd export-swap-test-vectors 1,1066,1400 --height 16841115 --amounts 1000,1000000 --output vectors.json
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolIds := []uint64{}
+			if len(args) == 1 {
+				for _, poolIdStr := range strings.Split(args[0], ",") {
+					poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+					if err != nil {
+						return err
+					}
+					poolIds = append(poolIds, poolId)
+				}
+			}
+
+			amountStrs, err := cmd.Flags().GetStringSlice(flagSwapTestVectorsAmounts)
+			if err != nil {
+				return err
+			}
+			amounts := []osmomath.Int{}
+			for _, amountStr := range amountStrs {
+				amount, ok := osmomath.NewIntFromString(amountStr)
+				if !ok || !amount.IsPositive() {
+					return fmt.Errorf("invalid amount %s", amountStr)
+				}
+				amounts = append(amounts, amount)
+			}
+
+			height, err := cmd.Flags().GetInt64(flagSwapTestVectorsHeight)
+			if err != nil {
+				return err
+			}
+
+			testVectors, err := getSwapTestVectors(server.GetServerContextFromCmd(cmd), appCreator, height, poolIds, amounts)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(testVectors, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flagSwapTestVectorsOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				fmt.Println(string(bz))
+				return nil
+			}
+			return os.WriteFile(output, bz, 0o644)
+		},
+	}
+
+	cmd.Flags().Int64(flagSwapTestVectorsHeight, 0, "Height of the state to compute the vectors against, defaults to the latest height")
+	cmd.Flags().StringSlice(flagSwapTestVectorsAmounts, nil, "Comma separated amounts to swap, defaults to 1 and fractions of the pool liquidity")
+	cmd.Flags().String(flagSwapTestVectorsOutput, "", "File to write the vectors to, defaults to stdout")
+
+	return cmd
+}
+
+func getSwapTestVectors(svrCtx *server.Context, appCreator servertypes.AppCreator, height int64, poolIds []uint64, amounts []osmomath.Int) (poolmanagertypes.SwapTestVectors, error) {
+	home := svrCtx.Config.RootDir
+	db, err := openDB(home, server.GetAppDBBackend(svrCtx.Viper))
+	if err != nil {
+		return poolmanagertypes.SwapTestVectors{}, fmt.Errorf("error opening DB, make sure osmosisd is not running when calling this command: %w", err)
+	}
+	app, ok := appCreator(svrCtx.Logger, db, nil, svrCtx.Viper).(*osmosis.OsmosisApp)
+	if !ok {
+		return poolmanagertypes.SwapTestVectors{}, fmt.Errorf("expected *OsmosisApp")
+	}
+
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return poolmanagertypes.SwapTestVectors{}, err
+	}
+	rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return poolmanagertypes.SwapTestVectors{}, fmt.Errorf("expected rootmulti.Store, got %T", app.CommitMultiStore())
+	}
+	commitInfo, err := rms.GetCommitInfo(ctx.BlockHeight())
+	if err != nil {
+		return poolmanagertypes.SwapTestVectors{}, err
+	}
+	ctx = ctx.WithBlockTime(commitInfo.Timestamp).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	testVectors, err := app.PoolManagerKeeper.GenerateSwapTestVectors(ctx, poolIds, amounts)
+	if err != nil {
+		return poolmanagertypes.SwapTestVectors{}, err
+	}
+
+	// Concentrated liquidity swaps depend on the liquidity of the ticks crossed, which is not part of the pool.
+	for i, pool := range testVectors.Pools {
+		if pool.PoolType != poolmanagertypes.Concentrated.String() {
+			continue
+		}
+		tickLiquidity, _, err := app.ConcentratedLiquidityKeeper.GetTickLiquidityForFullRange(ctx, pool.PoolId)
+		if err != nil {
+			return poolmanagertypes.SwapTestVectors{}, err
+		}
+		testVectors.Pools[i].TickLiquidity, err = json.Marshal(tickLiquidity)
+		if err != nil {
+			return poolmanagertypes.SwapTestVectors{}, err
+		}
+	}
+
+	return testVectors, nil
+}
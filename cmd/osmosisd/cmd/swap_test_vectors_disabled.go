@@ -0,0 +1,13 @@
+//go:build !test_vectors
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// addSwapTestVectorsCmd does not add the command exporting swap test vectors, which is only built with the
+// test_vectors build tag.
+func addSwapTestVectorsCmd(_ *cobra.Command, _ servertypes.AppCreator) {}
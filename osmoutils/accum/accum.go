@@ -335,6 +335,25 @@ func (accum *AccumulatorObject) SetPositionIntervalAccumulation(name string, int
 	return nil
 }
 
+// SetPositionUnclaimedRewards sets the unclaimed rewards of the position with the given name,
+// overwriting the existing ones. The accumulator value per share, options and the number of shares
+// stay the same as in the original position. This is meant for migrations only.
+// Returns error if no position exists for the given name or if negative rewards are provided.
+func (accum *AccumulatorObject) SetPositionUnclaimedRewards(name string, unclaimedRewardsTotal sdk.DecCoins) error {
+	position, err := GetPosition(accum, name)
+	if err != nil {
+		return err
+	}
+
+	if unclaimedRewardsTotal.IsAnyNegative() {
+		return NegativeRewardsAdditionError{PositionName: name, AccumName: accum.name}
+	}
+
+	initOrUpdatePosition(accum, position.AccumValuePerShare, name, position.NumShares, unclaimedRewardsTotal, position.Options)
+
+	return nil
+}
+
 // DeletePosition claims rewards and deletes the position from the accumulator state.
 // Prior to deletion, claims rewards and returns them. Decrements total accumulator share
 // counter by the number of shares in the position tracker.
@@ -1675,6 +1675,67 @@ func (suite *AccumTestSuite) TestAddToUnclaimedRewards() {
 	}
 }
 
+func (suite *AccumTestSuite) TestSetPositionUnclaimedRewards() {
+	// We setup store and accum
+	// once at beginning.
+	suite.SetupTest()
+
+	// Setup.
+	var (
+		accObject = accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+	)
+
+	tests := map[string]struct {
+		positionName     string
+		unclaimedRewards sdk.DecCoins
+		expectedError    error
+	}{
+		"valid update": {
+			positionName:     validPositionName,
+			unclaimedRewards: initialCoinsDenomOne,
+		},
+		"zero rewards - clears unclaimed rewards": {
+			positionName:     validPositionName,
+			unclaimedRewards: emptyCoins,
+		},
+		"error: negative rewards": {
+			positionName:     validPositionName,
+			unclaimedRewards: negativeCoins,
+			expectedError:    accumPackage.NegativeRewardsAdditionError{AccumName: accObject.GetName(), PositionName: validPositionName},
+		},
+		"invalid position - different name": {
+			positionName:  invalidPositionName,
+			expectedError: accumPackage.NoPositionError{Name: invalidPositionName},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			err := accObject.NewPositionIntervalAccumulation(validPositionName, osmomath.OneDec(), initialCoinsDenomOne, nil)
+			suite.Require().NoError(err)
+			err = accObject.AddToUnclaimedRewards(validPositionName, initialCoinsDenomOne.Add(initialCoinDenomOne))
+			suite.Require().NoError(err)
+
+			// System under test.
+			err = accObject.SetPositionUnclaimedRewards(tc.positionName, tc.unclaimedRewards)
+
+			// Assertions.
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expectedError, err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			position := accObject.MustGetPosition(tc.positionName)
+			suite.Require().Equal(tc.unclaimedRewards, position.GetUnclaimedRewardsTotal())
+			// unchanged
+			suite.Require().Equal(initialCoinsDenomOne, position.GetAccumValuePerShare())
+			suite.Require().Equal(osmomath.OneDec(), position.NumShares)
+		})
+	}
+}
+
 func (suite *AccumTestSuite) TestDeletePosition() {
 	tests := map[string]struct {
 		positionName             string
@@ -2,10 +2,12 @@ package sumtree_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"sort"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
 	"github.com/stretchr/testify/suite"
@@ -140,3 +142,38 @@ func (suite *TreeTestSuite) TestTreeInvariants() {
 		}
 	}
 }
+
+// benchmarkSubsetAccumulation measures suffix sum queries, such as the total amount locked for a
+// duration or longer, over a tree with numLeaves leaves keyed by big endian durations.
+func benchmarkSubsetAccumulation(b *testing.B, numLeaves int) {
+	b.Helper()
+	db := wrapper.NewIAVLDB(dbm.NewMemDB())
+	iavlTree := iavl.NewMutableTree(db, 100, false, log.NewNopLogger())
+	tree := sumtree.NewTree(iavlstore.UnsafeNewStore(iavlTree), 10)
+
+	keys := make([][]byte, numLeaves)
+	for i := range keys {
+		keys[i] = make([]byte, 8)
+		binary.BigEndian.PutUint64(keys[i], uint64(i+1)*uint64(time.Second))
+		tree.Set(keys[i], osmomath.NewInt(int64(i+1)))
+	}
+
+	r := rand.New(rand.NewSource(1))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.SubsetAccumulation(keys[r.Intn(numLeaves)], nil)
+	}
+}
+
+func BenchmarkSubsetAccumulation100(b *testing.B) {
+	benchmarkSubsetAccumulation(b, 100)
+}
+
+func BenchmarkSubsetAccumulation10000(b *testing.B) {
+	benchmarkSubsetAccumulation(b, 10000)
+}
+
+func BenchmarkSubsetAccumulation100000(b *testing.B) {
+	benchmarkSubsetAccumulation(b, 100000)
+}
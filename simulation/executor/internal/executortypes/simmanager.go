@@ -148,6 +148,19 @@ func (m Manager) Actions(seed int64, cdc codec.JSONCodec) []simtypes.ActionsWith
 	return actions
 }
 
+// PropertyChecks returns the property checks of every simulation module, in module name order.
+func (m Manager) PropertyChecks() []simtypes.PropertyCheck {
+	checks := []simtypes.PropertyCheck{}
+	moduleKeys := maps.Keys(m.Modules)
+	osmoutils.SortSlice(moduleKeys)
+	for _, simModuleName := range moduleKeys {
+		if simModule, ok := m.Modules[simModuleName].(simtypes.AppModuleSimulationPropertyCheck); ok {
+			checks = append(checks, simModule.PropertyChecks()...)
+		}
+	}
+	return checks
+}
+
 // TODO: Fix this
 // Unfortunately I'm temporarily giving up on fixing genesis logic, its very screwed up in the legacy designs
 // and I want to move on to the more interesting goals of this simulation refactor.
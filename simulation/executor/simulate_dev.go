@@ -11,8 +11,10 @@ import (
 	"github.com/cometbft/cometbft/crypto/merkle"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
+	"github.com/osmosis-labs/osmosis/v26/simulation/executor/internal/pubsub"
 	"github.com/osmosis-labs/osmosis/v26/simulation/executor/internal/stats"
 	"github.com/osmosis-labs/osmosis/v26/simulation/simtypes"
 )
@@ -47,6 +49,9 @@ type simState struct {
 	eventStats stats.EventStats
 	opCount    int
 
+	// pubsub notifies the module property checks of simulator events
+	pubsub pubsub.Manager
+
 	config Config
 }
 
@@ -64,10 +69,23 @@ func newSimulatorState(tb testing.TB, simParams Params, initialHeader tmproto.He
 		w:              w,
 		eventStats:     stats.NewEventStats(),
 		opCount:        0,
+		pubsub:         pubsub.NewPubSubManager(),
 		config:         config,
 	}
 }
 
+// subscribePropertyChecks subscribes every property check to the simulator events it listens for.
+func (simState *simState) subscribePropertyChecks(checks []simtypes.PropertyCheck) {
+	for _, check := range checks {
+		for _, key := range check.SubscriptionKeys() {
+			check, key := check, key
+			simState.pubsub.Subscribe(key, fmt.Sprintf("%T", check), func(sim *simtypes.SimCtx, ctx sdk.Context, value interface{}) error {
+				return check.Check(sim, ctx, key, value)
+			})
+		}
+	}
+}
+
 func (simState *simState) SimulateAllBlocks(
 	w io.Writer,
 	simCtx *simtypes.SimCtx,
@@ -142,6 +160,11 @@ func (simState *simState) SimulateBlock(simCtx *simtypes.SimCtx, blockSimulator
 		return true, err
 	}
 
+	err = simState.pubsub.Publish(simCtx, ctx, simtypes.BlockEndKey, simState.header.Height)
+	if err != nil {
+		return true, fmt.Errorf("property check failed on block %d: %w", simState.header.Height, err)
+	}
+
 	err = simState.prepareNextSimState(simCtx, finalizeBlockReq, responseFinalizeBlock)
 	if err != nil {
 		return true, err
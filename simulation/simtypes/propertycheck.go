@@ -2,6 +2,10 @@ package simtypes
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
+// BlockEndKey is published after the operations of every simulated block have run,
+// with the block height as the value.
+const BlockEndKey = "block end"
+
 type SimCallbackFn func(sim *SimCtx, ctx sdk.Context, value interface{}) error
 
 type PubSubManager interface {
@@ -11,7 +11,8 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 	osmomath "github.com/osmosis-labs/osmosis/osmomath"
-	types0 "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	types0 "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	types1 "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 )
 
 // MockConcentratedPoolExtension is a mock of ConcentratedPoolExtension interface.
@@ -52,10 +53,10 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) ApplySwap(newLiquidity, new
 }
 
 // AsSerializablePool mocks base method.
-func (m *MockConcentratedPoolExtension) AsSerializablePool() types0.PoolI {
+func (m *MockConcentratedPoolExtension) AsSerializablePool() types1.PoolI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AsSerializablePool")
-	ret0, _ := ret[0].(types0.PoolI)
+	ret0, _ := ret[0].(types1.PoolI)
 	return ret0
 }
 
@@ -193,6 +194,20 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) GetLiquidity() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiquidity", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).GetLiquidity))
 }
 
+// GetMetadata mocks base method.
+func (m *MockConcentratedPoolExtension) GetMetadata() types0.PoolMetadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata")
+	ret0, _ := ret[0].(types0.PoolMetadata)
+	return ret0
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockConcentratedPoolExtensionMockRecorder) GetMetadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).GetMetadata))
+}
+
 // GetPoolDenoms mocks base method.
 func (m *MockConcentratedPoolExtension) GetPoolDenoms(arg0 types.Context) []string {
 	m.ctrl.T.Helper()
@@ -278,10 +293,10 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) GetToken1() *gomock.Call {
 }
 
 // GetType mocks base method.
-func (m *MockConcentratedPoolExtension) GetType() types0.PoolType {
+func (m *MockConcentratedPoolExtension) GetType() types1.PoolType {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetType")
-	ret0, _ := ret[0].(types0.PoolType)
+	ret0, _ := ret[0].(types1.PoolType)
 	return ret0
 }
 
@@ -379,6 +394,18 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) SetLastLiquidityUpdate(newT
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastLiquidityUpdate", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).SetLastLiquidityUpdate), newTime)
 }
 
+// SetMetadata mocks base method.
+func (m *MockConcentratedPoolExtension) SetMetadata(metadata types0.PoolMetadata) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMetadata", metadata)
+}
+
+// SetMetadata indicates an expected call of SetMetadata.
+func (mr *MockConcentratedPoolExtensionMockRecorder) SetMetadata(metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetadata", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).SetMetadata), metadata)
+}
+
 // SetTickSpacing mocks base method.
 func (m *MockConcentratedPoolExtension) SetTickSpacing(newTickSpacing uint64) {
 	m.ctrl.T.Helper()
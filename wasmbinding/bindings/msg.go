@@ -15,6 +15,8 @@ type OsmosisMsg struct {
 	/// that they are the admin of.
 	/// Currently, the burn from address must be the admin contract.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+	/// Contracts can swap tokens they own over one or more pools.
+	Swap *SwapMsg `json:"swap,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -46,3 +48,48 @@ type BurnTokens struct {
 	// BurnFromAddress must be set to "" for now.
 	BurnFromAddress string `json:"burn_from_address"`
 }
+
+// SwapMsg swaps the contract's tokens through the First pool and then every pool of the Route.
+type SwapMsg struct {
+	First  Swap                `json:"first"`
+	Route  []Step              `json:"route"`
+	Amount SwapAmountWithLimit `json:"amount"`
+}
+
+type Swap struct {
+	PoolId   uint64 `json:"pool_id"`
+	DenomIn  string `json:"denom_in"`
+	DenomOut string `json:"denom_out"`
+}
+
+type Step struct {
+	PoolId   uint64 `json:"pool_id"`
+	DenomOut string `json:"denom_out"`
+}
+
+// SwapAmountWithLimit sets the amounts of a swap. Exactly one of ExactIn and ExactOut must be set.
+type SwapAmountWithLimit struct {
+	ExactIn  *ExactIn  `json:"exact_in,omitempty"`
+	ExactOut *ExactOut `json:"exact_out,omitempty"`
+}
+
+type ExactIn struct {
+	Input     osmomath.Int `json:"input"`
+	MinOutput osmomath.Int `json:"min_output"`
+}
+
+type ExactOut struct {
+	MaxInput osmomath.Int `json:"max_input"`
+	Output   osmomath.Int `json:"output"`
+}
+
+// SwapAmount is the amount swapped in or out, whichever was not fixed by the swap.
+type SwapAmount struct {
+	In  *osmomath.Int `json:"in,omitempty"`
+	Out *osmomath.Int `json:"out,omitempty"`
+}
+
+// SwapResponse is returned as the data of a swap message.
+type SwapResponse struct {
+	Amount SwapAmount `json:"amount"`
+}
@@ -1,5 +1,12 @@
 package bindings
 
+import "github.com/osmosis-labs/osmosis/osmomath"
+
+// PoolStateVersion is the current version of the PoolStateResponse schema.
+// It is bumped on any breaking change to the schema, so that contracts can
+// detect responses they do not know how to decode.
+const PoolStateVersion uint32 = 1
+
 // OsmosisQuery contains osmosis custom queries.
 // See https://github.com/osmosis-labs/osmosis-bindings/blob/main/packages/bindings/src/query.rs
 type OsmosisQuery struct {
@@ -8,6 +15,12 @@ type OsmosisQuery struct {
 	FullDenom *FullDenom `json:"full_denom,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Returns whether the chain has been down for at least a downtime within the last window,
+	/// e.g. to pause liquidations after a chain halt.
+	DownWithinWindow *DownWithinWindow `json:"down_within_window,omitempty"`
+	/// Returns a read-only snapshot of the state of a gamm pool,
+	/// in the schema version given by `PoolStateResponse::version`.
+	PoolState *PoolState `json:"pool_state,omitempty"`
 }
 
 type FullDenom struct {
@@ -26,3 +39,45 @@ type DenomAdminResponse struct {
 type FullDenomResponse struct {
 	Denom string `json:"denom"`
 }
+
+type DownWithinWindow struct {
+	// DowntimeSeconds is the length of the downtime, which must be one of the
+	// downtime-detector's tracked downtime thresholds.
+	DowntimeSeconds uint64 `json:"downtime_seconds"`
+	WindowSeconds   uint64 `json:"window_seconds"`
+}
+
+type DownWithinWindowResponse struct {
+	Down bool `json:"down"`
+}
+
+type PoolState struct {
+	PoolId uint64 `json:"pool_id"`
+	// Version is the schema version the contract expects. Zero accepts the current version.
+	Version uint32 `json:"version,omitempty"`
+}
+
+type PoolStateResponse struct {
+	Version  uint32 `json:"version"`
+	PoolId   uint64 `json:"pool_id"`
+	PoolType string `json:"pool_type"`
+	// Reserves are sorted by denom.
+	Reserves []PoolReserve `json:"reserves"`
+	// Weights are in the order of reserves, and empty for pools without weights.
+	Weights []PoolWeight `json:"weights"`
+	// ScalingFactors are in the order of reserves, and empty for pools without scaling factors.
+	ScalingFactors []uint64     `json:"scaling_factors"`
+	SpreadFactor   osmomath.Dec `json:"spread_factor"`
+	ExitFee        osmomath.Dec `json:"exit_fee"`
+	TotalShares    PoolReserve  `json:"total_shares"`
+}
+
+type PoolReserve struct {
+	Denom  string       `json:"denom"`
+	Amount osmomath.Int `json:"amount"`
+}
+
+type PoolWeight struct {
+	Denom  string       `json:"denom"`
+	Weight osmomath.Int `json:"weight"`
+}
@@ -11,18 +11,21 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"github.com/osmosis-labs/osmosis/v26/wasmbinding/bindings"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v26/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v26/x/tokenfactory/types"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper, poolManager *poolmanager.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
 			wrapped:      old,
 			bank:         bank,
 			tokenFactory: tokenFactory,
+			poolManager:  poolManager,
 		}
 	}
 }
@@ -31,6 +34,7 @@ type CustomMessenger struct {
 	wrapped      wasmkeeper.Messenger
 	bank         *bankkeeper.BaseKeeper
 	tokenFactory *tokenfactorykeeper.Keeper
+	poolManager  *poolmanager.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -56,6 +60,9 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.BurnTokens != nil {
 			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
 		}
+		if contractMsg.Swap != nil {
+			return m.swap(ctx, contractAddr, contractMsg.Swap)
+		}
 	}
 
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
@@ -194,6 +201,78 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	return nil
 }
 
+// swap swaps the contract's tokens over one or more pools.
+func (m *CustomMessenger) swap(ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	amount, err := PerformSwap(m.poolManager, ctx, contractAddr, swap)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform swap")
+	}
+	bz, err := json.Marshal(bindings.SwapResponse{Amount: *amount})
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "swap response")
+	}
+	return nil, [][]byte{bz}, nil, nil
+}
+
+// PerformSwap validates the swap message and swaps the contract's tokens through the poolmanager message server,
+// the same way as native swap messages. Returns the amount swapped out for exact in swaps,
+// and the amount swapped in for exact out swaps.
+func PerformSwap(pm *poolmanager.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapMsg) (*bindings.SwapAmount, error) {
+	if swap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "swap null swap"}
+	}
+	if (swap.Amount.ExactIn == nil) == (swap.Amount.ExactOut == nil) {
+		return nil, wasmvmtypes.InvalidRequest{Err: "swap must set exactly one of exact_in and exact_out"}
+	}
+
+	msgServer := poolmanager.NewMsgServerImpl(pm)
+	if exactIn := swap.Amount.ExactIn; exactIn != nil {
+		routes := []poolmanagertypes.SwapAmountInRoute{{PoolId: swap.First.PoolId, TokenOutDenom: swap.First.DenomOut}}
+		for _, step := range swap.Route {
+			routes = append(routes, poolmanagertypes.SwapAmountInRoute{PoolId: step.PoolId, TokenOutDenom: step.DenomOut})
+		}
+		sdkMsg := &poolmanagertypes.MsgSwapExactAmountIn{
+			Sender:            contractAddr.String(),
+			Routes:            routes,
+			TokenIn:           sdk.Coin{Denom: swap.First.DenomIn, Amount: exactIn.Input},
+			TokenOutMinAmount: exactIn.MinOutput,
+		}
+		if err := sdkMsg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		res, err := msgServer.SwapExactAmountIn(ctx, sdkMsg)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "swapping exact amount in from message")
+		}
+		return &bindings.SwapAmount{Out: &res.TokenOutAmount}, nil
+	}
+
+	// Every hop swaps in the token swapped out of the previous hop.
+	exactOut := swap.Amount.ExactOut
+	routes := []poolmanagertypes.SwapAmountOutRoute{{PoolId: swap.First.PoolId, TokenInDenom: swap.First.DenomIn}}
+	denomOut := swap.First.DenomOut
+	for _, step := range swap.Route {
+		routes = append(routes, poolmanagertypes.SwapAmountOutRoute{PoolId: step.PoolId, TokenInDenom: denomOut})
+		denomOut = step.DenomOut
+	}
+	sdkMsg := &poolmanagertypes.MsgSwapExactAmountOut{
+		Sender:           contractAddr.String(),
+		Routes:           routes,
+		TokenInMaxAmount: exactOut.MaxInput,
+		TokenOut:         sdk.Coin{Denom: denomOut, Amount: exactOut.Output},
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := msgServer.SwapExactAmountOut(ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "swapping exact amount out from message")
+	}
+	return &bindings.SwapAmount{In: &res.TokenInAmount}, nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
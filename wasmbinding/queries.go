@@ -2,21 +2,29 @@ package wasmbinding
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v26/wasmbinding/bindings"
+	downtimedetector "github.com/osmosis-labs/osmosis/v26/x/downtime-detector"
+	downtimetypes "github.com/osmosis-labs/osmosis/v26/x/downtime-detector/types"
+	gammkeeper "github.com/osmosis-labs/osmosis/v26/x/gamm/keeper"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v26/x/tokenfactory/keeper"
 )
 
 type QueryPlugin struct {
 	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	downtimeKeeper     *downtimedetector.Keeper
+	gammKeeper         *gammkeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper, dk *downtimedetector.Keeper, gk *gammkeeper.Keeper) *QueryPlugin {
 	return &QueryPlugin{
 		tokenFactoryKeeper: tfk,
+		downtimeKeeper:     dk,
+		gammKeeper:         gk,
 	}
 }
 
@@ -29,3 +37,51 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindings.De
 
 	return &bindings.DenomAdminResponse{Admin: metadata.Admin}, nil
 }
+
+// GetDownWithinWindow is a query to get whether the chain has been down for at least a downtime within the last window.
+func (qp QueryPlugin) GetDownWithinWindow(ctx sdk.Context, query *bindings.DownWithinWindow) (*bindings.DownWithinWindowResponse, error) {
+	downtime, err := downtimetypes.DowntimeByDuration(time.Duration(query.DowntimeSeconds) * time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	down, err := qp.downtimeKeeper.DownWithinWindow(ctx, downtime, time.Duration(query.WindowSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.DownWithinWindowResponse{Down: down}, nil
+}
+
+// GetPoolState is a query to get a read-only snapshot of the state of a gamm pool.
+func (qp QueryPlugin) GetPoolState(ctx sdk.Context, query *bindings.PoolState) (*bindings.PoolStateResponse, error) {
+	if query.Version != 0 && query.Version != bindings.PoolStateVersion {
+		return nil, fmt.Errorf("unsupported pool state version %d, current version is %d", query.Version, bindings.PoolStateVersion)
+	}
+
+	snapshot, err := qp.gammKeeper.GetPoolSnapshot(ctx, query.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	reserves := make([]bindings.PoolReserve, len(snapshot.Reserves))
+	for i, coin := range snapshot.Reserves {
+		reserves[i] = bindings.PoolReserve{Denom: coin.Denom, Amount: coin.Amount}
+	}
+	weights := make([]bindings.PoolWeight, len(snapshot.Weights))
+	for i, weight := range snapshot.Weights {
+		weights[i] = bindings.PoolWeight{Denom: weight.Denom, Weight: weight.Weight}
+	}
+
+	return &bindings.PoolStateResponse{
+		Version:        bindings.PoolStateVersion,
+		PoolId:         snapshot.PoolId,
+		PoolType:       snapshot.PoolType.String(),
+		Reserves:       reserves,
+		Weights:        weights,
+		ScalingFactors: snapshot.ScalingFactors,
+		SpreadFactor:   snapshot.SpreadFactor,
+		ExitFee:        snapshot.ExitFee,
+		TotalShares:    bindings.PoolReserve{Denom: snapshot.TotalShares.Denom, Amount: snapshot.TotalShares.Amount},
+	}, nil
+}
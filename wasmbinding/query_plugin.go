@@ -95,6 +95,32 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.DownWithinWindow != nil:
+			res, err := qp.GetDownWithinWindow(ctx, contractQuery.DownWithinWindow)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "osmo down within window query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal DownWithinWindowResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.PoolState != nil:
+			res, err := qp.GetPoolState(ctx, contractQuery.PoolState)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "osmo pool state query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal PoolStateResponse response: %w", err)
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown osmosis query variant"}
 		}
@@ -153,7 +153,11 @@ func init() {
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut", &poolmanagerqueryproto.EstimateSwapExactAmountOutResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/Pool", &poolmanagerqueryproto.PoolResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/SpotPrice", &poolmanagerqueryproto.SpotPriceResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/RouteSpotPrice", &poolmanagerqueryproto.RouteSpotPriceResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/PoolTypeGasCosts", &poolmanagerqueryproto.PoolTypeGasCostsResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateRouteGas", &poolmanagerqueryproto.EstimateRouteGasResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity", &poolmanagerqueryproto.TotalPoolLiquidityResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/CanonicalRoute", &poolmanagerqueryproto.CanonicalRouteResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/Params", &poolmanagerqueryproto.ParamsResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", &poolmanagerqueryproto.TradingPairTakerFeeResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", &poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{})
@@ -163,6 +167,7 @@ func init() {
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/DenomSpotPrice", &txfeestypes.QueryDenomSpotPriceResponse{})
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/DenomPoolId", &txfeestypes.QueryDenomPoolIdResponse{})
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/BaseDenom", &txfeestypes.QueryBaseDenomResponse{})
+	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/DynamicBaseFee", &txfeestypes.QueryDynamicBaseFeeResponse{})
 
 	// tokenfactory
 	setWhitelistedQuery("/osmosis.tokenfactory.v1beta1.Query/Params", &tokenfactorytypes.QueryParamsResponse{})
@@ -178,6 +183,7 @@ func init() {
 
 	// downtime-detector
 	setWhitelistedQuery("/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfLength", &downtimequerytypes.RecoveredSinceDowntimeOfLengthResponse{})
+	setWhitelistedQuery("/osmosis.downtimedetector.v1beta1.Query/Params", &downtimequerytypes.ParamsResponse{})
 
 	// concentrated-liquidity
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", &concentratedliquidityquery.UserPositionsResponse{})
@@ -190,6 +196,9 @@ func init() {
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", &concentratedliquidityquery.IncentiveRecordsResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", &concentratedliquidityquery.TickAccumulatorTrackersResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", &concentratedliquidityquery.CFMMPoolIdLinkFromConcentratedPoolIdResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/StopCondition", &concentratedliquidityquery.StopConditionResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/StopConditionsByPool", &concentratedliquidityquery.StopConditionsByPoolResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/SpreadRewardGrowthSaturatedPools", &concentratedliquidityquery.SpreadRewardGrowthSaturatedPoolsResponse{})
 }
 
 // IsWhitelistedQuery returns if the query is not whitelisted.
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v26/app"
+	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
 	"github.com/osmosis-labs/osmosis/v26/wasmbinding/bindings"
 )
 
@@ -232,12 +233,82 @@ func TestBurnMsg(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSwapMsg(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	creator := RandomAccountAddress()
+	osmosis, ctx, homeDir := SetupCustomApp(t, creator)
+	defer os.RemoveAll(homeDir)
+	state := prepareSwapState(t, ctx, osmosis)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+
+	// The contract swaps with its own funds.
+	fundAccount(t, ctx, osmosis, reflect, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10_000_000)))
+
+	// Swap exactly 1_000_000 uatom for ustar through uosmo.
+	msg := bindings.OsmosisMsg{Swap: &bindings.SwapMsg{
+		First: bindings.Swap{PoolId: state.AtomPool, DenomIn: "uatom", DenomOut: appparams.BaseCoinUnit},
+		Route: []bindings.Step{{PoolId: state.StarPool, DenomOut: "ustar"}},
+		Amount: bindings.SwapAmountWithLimit{ExactIn: &bindings.ExactIn{
+			Input:     osmomath.NewInt(1_000_000),
+			MinOutput: osmomath.NewInt(3_000_000),
+		}},
+	}}
+	err := executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+
+	balances := osmosis.BankKeeper.GetAllBalances(ctx, reflect)
+	require.Equal(t, osmomath.NewInt(9_000_000), balances.AmountOf("uatom"))
+	require.True(t, balances.AmountOf("ustar").GTE(osmomath.NewInt(3_000_000)))
+
+	// Swap uatom for exactly 1_000_000 uregen through uosmo.
+	msg = bindings.OsmosisMsg{Swap: &bindings.SwapMsg{
+		First: bindings.Swap{PoolId: state.AtomPool, DenomIn: "uatom", DenomOut: appparams.BaseCoinUnit},
+		Route: []bindings.Step{{PoolId: state.RegenPool, DenomOut: "uregen"}},
+		Amount: bindings.SwapAmountWithLimit{ExactOut: &bindings.ExactOut{
+			MaxInput: osmomath.NewInt(1_000_000),
+			Output:   osmomath.NewInt(1_000_000),
+		}},
+	}}
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+
+	balances = osmosis.BankKeeper.GetAllBalances(ctx, reflect)
+	require.Equal(t, osmomath.NewInt(1_000_000), balances.AmountOf("uregen"))
+	require.True(t, balances.AmountOf("uatom").LT(osmomath.NewInt(9_000_000)))
+
+	// The contract can't swap more than it owns.
+	msg = bindings.OsmosisMsg{Swap: &bindings.SwapMsg{
+		First: bindings.Swap{PoolId: state.AtomPool, DenomIn: "uatom", DenomOut: appparams.BaseCoinUnit},
+		Route: []bindings.Step{},
+		Amount: bindings.SwapAmountWithLimit{ExactIn: &bindings.ExactIn{
+			Input:     osmomath.NewInt(10_000_000),
+			MinOutput: osmomath.OneInt(),
+		}},
+	}}
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.Error(t, err)
+}
+
 type BaseState struct {
 	StarPool  uint64
 	AtomPool  uint64
 	RegenPool uint64
 }
 
+// prepareSwapState creates a uosmo pool paired with each of ustar, uatom and uregen.
+func prepareSwapState(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp) BaseState {
+	t.Helper()
+	osmo := sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000_000)
+	return BaseState{
+		StarPool:  PreparePool(t, ctx, osmosis, sdk.NewCoins(osmo, sdk.NewInt64Coin("ustar", 2_000_000_000))),
+		AtomPool:  PreparePool(t, ctx, osmosis, sdk.NewCoins(osmo, sdk.NewInt64Coin("uatom", 500_000_000))),
+		RegenPool: PreparePool(t, ctx, osmosis, sdk.NewCoins(osmo, sdk.NewInt64Coin("uregen", 1_000_000_000))),
+	}
+}
+
 type ReflectExec struct {
 	ReflectMsg    *ReflectMsgs    `json:"reflect_msg,omitempty"`
 	ReflectSubMsg *ReflectSubMsgs `json:"reflect_sub_msg,omitempty"`
@@ -18,6 +18,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
 )
 
 func CreateTestInput() (*app.OsmosisApp, sdk.Context, string) {
@@ -35,6 +36,28 @@ func FundAccount(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp, acct sd
 	require.NoError(t, err)
 }
 
+// PreparePool creates a balancer pool with equal weights and no spread factor
+// from the given coins, and returns its id.
+func PreparePool(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp, coins sdk.Coins) uint64 {
+	t.Helper()
+	creator := RandomAccountAddress()
+	// Fund the pool creation fee along with the pool liquidity.
+	err := testutil.FundAccount(ctx, osmosis.BankKeeper, creator, coins.Add(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(10000000000))))
+	require.NoError(t, err)
+
+	poolAssets := make([]balancer.PoolAsset, len(coins))
+	for i, coin := range coins {
+		poolAssets[i] = balancer.PoolAsset{Weight: osmomath.NewInt(1), Token: coin}
+	}
+	msg := balancer.NewMsgCreateBalancerPool(creator, balancer.PoolParams{
+		SwapFee: osmomath.ZeroDec(),
+		ExitFee: osmomath.ZeroDec(),
+	}, poolAssets, "")
+	poolId, err := osmosis.PoolManagerKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	return poolId
+}
+
 // we need to make this deterministic (same every test run), as content might affect gas costs
 func keyPubAddr() (crypto.PrivKey, crypto.PubKey, sdk.AccAddress) {
 	key := ed25519.GenPrivKey()
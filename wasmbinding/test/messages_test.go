@@ -9,6 +9,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
 	"github.com/osmosis-labs/osmosis/v26/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v26/wasmbinding/bindings"
 
@@ -415,3 +416,104 @@ func TestBurn(t *testing.T) {
 		})
 	}
 }
+
+func TestSwap(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	creator := RandomAccountAddress()
+	osmosis, ctx, homeDir := SetupCustomApp(t, creator)
+	defer os.RemoveAll(homeDir)
+
+	// Route uosmo -> uatom -> ustar through two pools.
+	osmoAtomPool := PreparePool(t, ctx, osmosis, sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000_000), sdk.NewInt64Coin("uatom", 1_000_000_000)))
+	atomStarPool := PreparePool(t, ctx, osmosis, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000_000), sdk.NewInt64Coin("ustar", 1_000_000_000)))
+	FundAccount(t, ctx, osmosis, creator)
+
+	first := bindings.Swap{PoolId: osmoAtomPool, DenomIn: appparams.BaseCoinUnit, DenomOut: "uatom"}
+	multiHopRoute := []bindings.Step{{PoolId: atomStarPool, DenomOut: "ustar"}}
+	exactIn := bindings.SwapAmountWithLimit{ExactIn: &bindings.ExactIn{Input: osmomath.NewInt(1_000_000), MinOutput: osmomath.OneInt()}}
+	exactOut := bindings.SwapAmountWithLimit{ExactOut: &bindings.ExactOut{MaxInput: osmomath.NewInt(2_000_000), Output: osmomath.NewInt(1_000_000)}}
+
+	specs := map[string]struct {
+		swap          *bindings.SwapMsg
+		tokenOutDenom string
+		expErr        bool
+	}{
+		"exact in": {
+			swap:          &bindings.SwapMsg{First: first, Amount: exactIn},
+			tokenOutDenom: "uatom",
+		},
+		"exact in multi-hop": {
+			swap:          &bindings.SwapMsg{First: first, Route: multiHopRoute, Amount: exactIn},
+			tokenOutDenom: "ustar",
+		},
+		"exact out": {
+			swap:          &bindings.SwapMsg{First: first, Amount: exactOut},
+			tokenOutDenom: "uatom",
+		},
+		"exact out multi-hop": {
+			swap:          &bindings.SwapMsg{First: first, Route: multiHopRoute, Amount: exactOut},
+			tokenOutDenom: "ustar",
+		},
+		"output less than the min output": {
+			swap: &bindings.SwapMsg{First: first, Amount: bindings.SwapAmountWithLimit{
+				ExactIn: &bindings.ExactIn{Input: osmomath.NewInt(1_000_000), MinOutput: osmomath.NewInt(1_000_000)},
+			}},
+			expErr: true,
+		},
+		"input more than the max input": {
+			swap: &bindings.SwapMsg{First: first, Amount: bindings.SwapAmountWithLimit{
+				ExactOut: &bindings.ExactOut{MaxInput: osmomath.NewInt(1_000_000), Output: osmomath.NewInt(1_000_000)},
+			}},
+			expErr: true,
+		},
+		"zero input": {
+			swap: &bindings.SwapMsg{First: first, Amount: bindings.SwapAmountWithLimit{
+				ExactIn: &bindings.ExactIn{Input: osmomath.ZeroInt(), MinOutput: osmomath.OneInt()},
+			}},
+			expErr: true,
+		},
+		"nonexistent pool": {
+			swap:   &bindings.SwapMsg{First: bindings.Swap{PoolId: 1000, DenomIn: appparams.BaseCoinUnit, DenomOut: "uatom"}, Amount: exactIn},
+			expErr: true,
+		},
+		"both exact in and exact out": {
+			swap:   &bindings.SwapMsg{First: first, Amount: bindings.SwapAmountWithLimit{ExactIn: exactIn.ExactIn, ExactOut: exactOut.ExactOut}},
+			expErr: true,
+		},
+		"neither exact in nor exact out": {
+			swap:   &bindings.SwapMsg{First: first},
+			expErr: true,
+		},
+		"null swap": {
+			swap:   nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			balancesBefore := osmosis.BankKeeper.GetAllBalances(ctx, creator)
+
+			// when
+			gotAmount, gotErr := wasmbinding.PerformSwap(osmosis.PoolManagerKeeper, ctx, creator, spec.swap)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			balancesAfter := osmosis.BankKeeper.GetAllBalances(ctx, creator)
+			tokenOutAmount := balancesAfter.AmountOf(spec.tokenOutDenom).Sub(balancesBefore.AmountOf(spec.tokenOutDenom))
+			tokenInAmount := balancesBefore.AmountOf(appparams.BaseCoinUnit).Sub(balancesAfter.AmountOf(appparams.BaseCoinUnit))
+			if spec.swap.Amount.ExactIn != nil {
+				require.Nil(t, gotAmount.In)
+				require.Equal(t, spec.swap.Amount.ExactIn.Input, tokenInAmount)
+				require.Equal(t, *gotAmount.Out, tokenOutAmount)
+				return
+			}
+			require.Nil(t, gotAmount.Out)
+			require.Equal(t, *gotAmount.In, tokenInAmount)
+			require.Equal(t, spec.swap.Amount.ExactOut.Output, tokenOutAmount)
+		})
+	}
+}
@@ -10,9 +10,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
 	"github.com/osmosis-labs/osmosis/v26/wasmbinding"
+	"github.com/osmosis-labs/osmosis/v26/wasmbinding/bindings"
 )
 
 func TestFullDenom(t *testing.T) {
@@ -82,7 +84,7 @@ func TestDenomAdmin(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, tfDenom)
 
-	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper, app.DowntimeKeeper, app.GAMMKeeper)
 
 	testCases := []struct {
 		name        string
@@ -118,3 +120,66 @@ func TestDenomAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolState(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	addr := RandomAccountAddress()
+	app, ctx, homeDir := SetupCustomApp(t, addr)
+	defer os.RemoveAll(homeDir)
+
+	poolCoins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 12000000), sdk.NewInt64Coin("uosmo", 24000000))
+	poolId := PreparePool(t, ctx, app, poolCoins)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper, app.DowntimeKeeper, app.GAMMKeeper)
+
+	testCases := []struct {
+		name      string
+		query     bindings.PoolState
+		expectErr bool
+	}{
+		{
+			name:  "current version",
+			query: bindings.PoolState{PoolId: poolId, Version: bindings.PoolStateVersion},
+		},
+		{
+			name:  "unspecified version",
+			query: bindings.PoolState{PoolId: poolId},
+		},
+		{
+			name:      "unsupported version",
+			query:     bindings.PoolState{PoolId: poolId, Version: bindings.PoolStateVersion + 1},
+			expectErr: true,
+		},
+		{
+			name:      "pool does not exist",
+			query:     bindings.PoolState{PoolId: poolId + 1},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := queryPlugin.GetPoolState(ctx, &tc.query)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, bindings.PoolStateVersion, resp.Version)
+			require.Equal(t, poolId, resp.PoolId)
+			require.Equal(t, "Balancer", resp.PoolType)
+			require.Equal(t, []bindings.PoolReserve{
+				{Denom: "uatom", Amount: osmomath.NewInt(12000000)},
+				{Denom: "uosmo", Amount: osmomath.NewInt(24000000)},
+			}, resp.Reserves)
+			require.Len(t, resp.Weights, 2)
+			require.Equal(t, resp.Weights[0].Weight, resp.Weights[1].Weight)
+			require.Empty(t, resp.ScalingFactors)
+			require.Equal(t, osmomath.ZeroDec(), resp.SpreadFactor)
+			require.Equal(t, osmomath.ZeroDec(), resp.ExitFee)
+			require.Equal(t, fmt.Sprintf("gamm/pool/%d", poolId), resp.TotalShares.Denom)
+		})
+	}
+}
@@ -7,20 +7,26 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	downtimedetector "github.com/osmosis-labs/osmosis/v26/x/downtime-detector"
+	gammkeeper "github.com/osmosis-labs/osmosis/v26/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v26/x/tokenfactory/keeper"
 )
 
 func RegisterCustomPlugins(
 	bank *bankkeeper.BaseKeeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
+	downtime *downtimedetector.Keeper,
+	poolManager *poolmanager.Keeper,
+	gamm *gammkeeper.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(tokenFactory)
+	wasmQueryPlugin := NewQueryPlugin(tokenFactory, downtime, gamm)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(bank, tokenFactory),
+		CustomMessageDecorator(bank, tokenFactory, poolManager),
 	)
 
 	return []wasmkeeper.Option{
@@ -0,0 +1,60 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+// nestedMsgs is implemented by messages that wrap other messages, such as authz's MsgExec.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// MsgCircuitBreakerDecorator rejects transactions containing a message whose type URL is disabled by a tripped
+// circuit breaker, including messages nested inside of authz's MsgExec.
+//
+// Messages dispatched by CosmWasm contracts do not go through the ante handler and are therefore not affected.
+type MsgCircuitBreakerDecorator struct {
+	circuitBreakerKeeper *keeper.Keeper
+}
+
+// NewMsgCircuitBreakerDecorator creates a new MsgCircuitBreakerDecorator.
+func NewMsgCircuitBreakerDecorator(circuitBreakerKeeper *keeper.Keeper) MsgCircuitBreakerDecorator {
+	return MsgCircuitBreakerDecorator{
+		circuitBreakerKeeper: circuitBreakerKeeper,
+	}
+}
+
+// AnteHandle returns an error if any of the messages of the tx is disabled by a circuit breaker.
+func (d MsgCircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d MsgCircuitBreakerDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		msgTypeUrl := sdk.MsgTypeURL(msg)
+		if d.circuitBreakerKeeper.IsTripped(ctx, msgTypeUrl) {
+			return errorsmod.Wrap(types.ErrCircuitBreakerTripped, msgTypeUrl)
+		}
+
+		if nested, ok := msg.(nestedMsgs); ok {
+			innerMsgs, err := nested.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := d.checkMsgs(ctx, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
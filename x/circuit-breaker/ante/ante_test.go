@@ -0,0 +1,71 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/ante"
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+type AnteTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+func (s *AnteTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func (s *AnteTestSuite) TestMsgCircuitBreakerDecorator() {
+	sendMsg := banktypes.NewMsgSend(s.TestAccs[0], s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)))
+	execMsg := authz.NewMsgExec(s.TestAccs[1], []sdk.Msg{sendMsg})
+	nextCalled := false
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	decorator := ante.NewMsgCircuitBreakerDecorator(s.App.CircuitBreakerKeeper)
+	anteHandle := func(msgs ...sdk.Msg) error {
+		nextCalled = false
+		txBuilder := s.App.GetTxConfig().NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msgs...))
+		_, err := decorator.AnteHandle(s.Ctx, txBuilder.GetTx(), false, next)
+		return err
+	}
+
+	s.Require().NoError(anteHandle(sendMsg))
+	s.Require().True(nextCalled)
+
+	_, err := s.App.CircuitBreakerKeeper.TripCircuitBreaker(s.Ctx, s.TestAccs[0], sdk.MsgTypeURL(sendMsg), time.Hour)
+	s.Require().NoError(err)
+
+	// The disabled message is rejected, both on its own and nested in an authz exec.
+	s.Require().ErrorIs(anteHandle(sendMsg), types.ErrCircuitBreakerTripped)
+	s.Require().False(nextCalled)
+	s.Require().ErrorIs(anteHandle(&execMsg), types.ErrCircuitBreakerTripped)
+	s.Require().False(nextCalled)
+
+	// Other messages are not affected.
+	multiSendMsg := banktypes.NewMsgMultiSend(
+		banktypes.NewInput(s.TestAccs[0], sendMsg.Amount),
+		[]banktypes.Output{banktypes.NewOutput(s.TestAccs[1], sendMsg.Amount)},
+	)
+	s.Require().NoError(anteHandle(multiSendMsg))
+	s.Require().True(nextCalled)
+
+	// The message is accepted again once the circuit breaker expires.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(anteHandle(sendMsg))
+	s.Require().True(nextCalled)
+}
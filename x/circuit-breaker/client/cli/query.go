@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdParams)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdTrippedCircuitBreakers)
+
+	return cmd
+}
+
+func GetCmdParams() (*osmocli.QueryDescriptor, *types.QueryParamsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "params",
+		Short: "Query circuit breaker params",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} params`,
+	}, &types.QueryParamsRequest{}
+}
+
+func GetCmdTrippedCircuitBreakers() (*osmocli.QueryDescriptor, *types.QueryTrippedCircuitBreakersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "tripped-circuit-breakers",
+		Short: "Query the message types currently disabled by a tripped circuit breaker",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} tripped-circuit-breakers`,
+	}, &types.QueryTrippedCircuitBreakersRequest{}
+}
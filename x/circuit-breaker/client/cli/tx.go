@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+// NewTxCmd returns the cli transaction commands for this module.
+func NewTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(txCmd, NewTripCircuitBreakerCmd)
+	osmocli.AddTxCmd(txCmd, NewResetCircuitBreakerCmd)
+	return txCmd
+}
+
+func NewTripCircuitBreakerCmd() (*osmocli.TxCliDesc, *types.MsgTripCircuitBreaker) {
+	return &osmocli.TxCliDesc{
+		Use:   "trip [msg-type-urls] [duration]",
+		Short: "disable the comma separated message type URLs for a duration, must be a guardian",
+		Example: `
+			osmosisd tx circuitbreaker trip /osmosis.concentratedliquidity.v1beta1.MsgCreatePosition,/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn 24h \
+			--from guardian --chain-id osmosis-1 -b sync --fees 1000uosmo
+		`,
+		NumArgs:          2,
+		ParseAndBuildMsg: BuildTripCircuitBreakerMsg,
+	}, &types.MsgTripCircuitBreaker{}
+}
+
+func NewResetCircuitBreakerCmd() (*osmocli.TxCliDesc, *types.MsgResetCircuitBreaker) {
+	return &osmocli.TxCliDesc{
+		Use:   "reset [msg-type-urls]",
+		Short: "enable the comma separated message type URLs again before their circuit breakers expire, must be a guardian",
+		Example: `
+			osmosisd tx circuitbreaker reset /osmosis.concentratedliquidity.v1beta1.MsgCreatePosition \
+			--from guardian --chain-id osmosis-1 -b sync --fees 1000uosmo
+		`,
+		NumArgs:          1,
+		ParseAndBuildMsg: BuildResetCircuitBreakerMsg,
+	}, &types.MsgResetCircuitBreaker{}
+}
+
+func BuildTripCircuitBreakerMsg(clientCtx client.Context, args []string, flags *pflag.FlagSet) (sdk.Msg, error) {
+	duration, err := time.ParseDuration(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), strings.Split(args[0], ","), duration), nil
+}
+
+func BuildResetCircuitBreakerMsg(clientCtx client.Context, args []string, flags *pflag.FlagSet) (sdk.Msg, error) {
+	return types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), strings.Split(args[0], ",")), nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+// InitGenesis initializes the circuit breaker module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, breaker := range genState.TrippedCircuitBreakers {
+		k.setTrippedCircuitBreaker(ctx, breaker)
+	}
+}
+
+// ExportGenesis returns the circuit breaker module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	breakers, err := k.GetTrippedCircuitBreakers(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:                 k.GetParams(ctx),
+		TrippedCircuitBreakers: breakers,
+	}
+}
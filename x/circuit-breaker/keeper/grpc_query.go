@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the parameters of the circuit breaker module.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// TrippedCircuitBreakers returns the circuit breakers that are currently tripped.
+func (k Keeper) TrippedCircuitBreakers(goCtx context.Context, req *types.QueryTrippedCircuitBreakersRequest) (*types.QueryTrippedCircuitBreakersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	breakers, err := k.GetTrippedCircuitBreakers(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTrippedCircuitBreakersResponse{TrippedCircuitBreakers: breakers}, nil
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+type Keeper struct {
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+
+	// governor is the governance module account, which is always allowed to trip and reset circuit breakers.
+	governor sdk.AccAddress
+}
+
+func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, govModuleAddr sdk.AccAddress) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		governor:   govModuleAddr,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns all of the parameters in the circuit breaker module.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets all of the parameters in the circuit breaker module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsAuthorized returns true if the given address is the governance module account or one of the guardians.
+func (k Keeper) IsAuthorized(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if addr.Equals(k.governor) {
+		return true
+	}
+
+	for _, guardian := range k.GetParams(ctx).Guardians {
+		if guardian == addr.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TripCircuitBreaker disables the messages of the given type URL until the block time plus the given duration.
+// Tripping an already tripped circuit breaker overwrites its expiry.
+func (k Keeper) TripCircuitBreaker(ctx sdk.Context, trippedBy sdk.AccAddress, msgTypeUrl string, duration time.Duration) (types.TrippedCircuitBreaker, error) {
+	if err := types.ValidateMsgTypeUrl(msgTypeUrl); err != nil {
+		return types.TrippedCircuitBreaker{}, err
+	}
+
+	maxTripDuration := k.GetParams(ctx).MaxTripDuration
+	if duration <= 0 || duration > maxTripDuration {
+		return types.TrippedCircuitBreaker{}, errorsmod.Wrapf(types.ErrInvalidTripDuration, "duration %s must be positive and at most %s", duration, maxTripDuration)
+	}
+
+	breaker := types.TrippedCircuitBreaker{
+		MsgTypeUrl: msgTypeUrl,
+		Expiry:     ctx.BlockTime().Add(duration),
+		TrippedBy:  trippedBy.String(),
+	}
+	k.setTrippedCircuitBreaker(ctx, breaker)
+
+	return breaker, nil
+}
+
+// ResetCircuitBreaker enables the messages of the given type URL again before the circuit breaker expires.
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, msgTypeUrl string) error {
+	if !k.IsTripped(ctx, msgTypeUrl) {
+		return errorsmod.Wrap(types.ErrNotTripped, msgTypeUrl)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyTrippedCircuitBreaker(msgTypeUrl))
+	return nil
+}
+
+// IsTripped returns true if the messages of the given type URL are disabled by a circuit breaker that has not expired yet.
+func (k Keeper) IsTripped(ctx sdk.Context, msgTypeUrl string) bool {
+	breaker := types.TrippedCircuitBreaker{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyTrippedCircuitBreaker(msgTypeUrl), &breaker)
+	if err != nil {
+		panic(err)
+	}
+
+	return found && ctx.BlockTime().Before(breaker.Expiry)
+}
+
+// GetTrippedCircuitBreakers returns the circuit breakers that have not expired yet.
+func (k Keeper) GetTrippedCircuitBreakers(ctx sdk.Context) ([]types.TrippedCircuitBreaker, error) {
+	breakers, err := k.GetAllTrippedCircuitBreakers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tripped := []types.TrippedCircuitBreaker{}
+	for _, breaker := range breakers {
+		if ctx.BlockTime().Before(breaker.Expiry) {
+			tripped = append(tripped, breaker)
+		}
+	}
+
+	return tripped, nil
+}
+
+// GetAllTrippedCircuitBreakers returns all of the stored circuit breakers, including the expired ones that have not
+// been pruned yet.
+func (k Keeper) GetAllTrippedCircuitBreakers(ctx sdk.Context) ([]types.TrippedCircuitBreaker, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyTrippedCircuitBreakerPrefix, parseTrippedCircuitBreaker)
+}
+
+// PruneExpiredCircuitBreakers deletes the circuit breakers that have expired as of the block time.
+func (k Keeper) PruneExpiredCircuitBreakers(ctx sdk.Context) error {
+	breakers, err := k.GetAllTrippedCircuitBreakers(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, breaker := range breakers {
+		if !ctx.BlockTime().Before(breaker.Expiry) {
+			store.Delete(types.KeyTrippedCircuitBreaker(breaker.MsgTypeUrl))
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setTrippedCircuitBreaker(ctx sdk.Context, breaker types.TrippedCircuitBreaker) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyTrippedCircuitBreaker(breaker.MsgTypeUrl), &breaker)
+}
+
+func parseTrippedCircuitBreaker(bz []byte) (types.TrippedCircuitBreaker, error) {
+	breaker := types.TrippedCircuitBreaker{}
+	err := breaker.Unmarshal(bz)
+	return breaker, err
+}
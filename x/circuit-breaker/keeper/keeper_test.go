@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+const (
+	createPositionMsgTypeUrl = "/osmosis.concentratedliquidity.v1beta1.MsgCreatePosition"
+	swapMsgTypeUrl           = "/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+
+	// TestAccs[0] is the only guardian.
+	s.App.CircuitBreakerKeeper.SetParams(s.Ctx, types.NewParams([]string{s.TestAccs[0].String()}, 24*time.Hour))
+}
+
+func (s *KeeperTestSuite) TestIsAuthorized() {
+	k := s.App.CircuitBreakerKeeper
+
+	s.Require().True(k.IsAuthorized(s.Ctx, s.TestAccs[0]))
+	s.Require().True(k.IsAuthorized(s.Ctx, authtypes.NewModuleAddress(govtypes.ModuleName)))
+	s.Require().False(k.IsAuthorized(s.Ctx, s.TestAccs[1]))
+}
+
+func (s *KeeperTestSuite) TestTripCircuitBreaker() {
+	k := s.App.CircuitBreakerKeeper
+
+	// A trip longer than the max trip duration is rejected.
+	_, err := k.TripCircuitBreaker(s.Ctx, s.TestAccs[0], createPositionMsgTypeUrl, 25*time.Hour)
+	s.Require().ErrorIs(err, types.ErrInvalidTripDuration)
+	s.Require().False(k.IsTripped(s.Ctx, createPositionMsgTypeUrl))
+
+	breaker, err := k.TripCircuitBreaker(s.Ctx, s.TestAccs[0], createPositionMsgTypeUrl, time.Hour)
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime().Add(time.Hour), breaker.Expiry)
+	s.Require().Equal(s.TestAccs[0].String(), breaker.TrippedBy)
+	s.Require().True(k.IsTripped(s.Ctx, createPositionMsgTypeUrl))
+	s.Require().False(k.IsTripped(s.Ctx, swapMsgTypeUrl))
+
+	breakers, err := k.GetTrippedCircuitBreakers(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TrippedCircuitBreaker{breaker}, breakers)
+
+	// The circuit breaker expires automatically, before it is pruned.
+	s.Ctx = s.Ctx.WithBlockTime(breaker.Expiry)
+	s.Require().False(k.IsTripped(s.Ctx, createPositionMsgTypeUrl))
+	breakers, err = k.GetTrippedCircuitBreakers(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(breakers)
+
+	s.Require().NoError(k.PruneExpiredCircuitBreakers(s.Ctx))
+	breakers, err = k.GetAllTrippedCircuitBreakers(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(breakers)
+}
+
+func (s *KeeperTestSuite) TestResetCircuitBreaker() {
+	k := s.App.CircuitBreakerKeeper
+
+	err := k.ResetCircuitBreaker(s.Ctx, createPositionMsgTypeUrl)
+	s.Require().ErrorIs(err, types.ErrNotTripped)
+
+	_, err = k.TripCircuitBreaker(s.Ctx, s.TestAccs[0], createPositionMsgTypeUrl, time.Hour)
+	s.Require().NoError(err)
+
+	s.Require().NoError(k.ResetCircuitBreaker(s.Ctx, createPositionMsgTypeUrl))
+	s.Require().False(k.IsTripped(s.Ctx, createPositionMsgTypeUrl))
+}
+
+func (s *KeeperTestSuite) TestMsgServer() {
+	msgServer := keeper.NewMsgServerImpl(*s.App.CircuitBreakerKeeper)
+	msgTypeUrls := []string{createPositionMsgTypeUrl, swapMsgTypeUrl}
+
+	// Only guardians and governance can trip circuit breakers.
+	_, err := msgServer.TripCircuitBreaker(s.Ctx, types.NewMsgTripCircuitBreaker(s.TestAccs[1].String(), msgTypeUrls, time.Hour))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.TripCircuitBreaker(s.Ctx, types.NewMsgTripCircuitBreaker(s.TestAccs[0].String(), msgTypeUrls, time.Hour))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.EventTypeCircuitBreakerTripped, len(msgTypeUrls))
+
+	res, err := s.App.CircuitBreakerKeeper.TrippedCircuitBreakers(s.Ctx, &types.QueryTrippedCircuitBreakersRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.TrippedCircuitBreakers, len(msgTypeUrls))
+
+	_, err = msgServer.ResetCircuitBreaker(s.Ctx, types.NewMsgResetCircuitBreaker(s.TestAccs[1].String(), msgTypeUrls))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.ResetCircuitBreaker(s.Ctx, types.NewMsgResetCircuitBreaker(govAddr.String(), msgTypeUrls))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.EventTypeCircuitBreakerReset, len(msgTypeUrls))
+
+	res, err = s.App.CircuitBreakerKeeper.TrippedCircuitBreakers(s.Ctx, &types.QueryTrippedCircuitBreakersRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.TrippedCircuitBreakers)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	k := s.App.CircuitBreakerKeeper
+
+	_, err := k.TripCircuitBreaker(s.Ctx, s.TestAccs[0], createPositionMsgTypeUrl, time.Hour)
+	s.Require().NoError(err)
+
+	genState := k.ExportGenesis(s.Ctx)
+	s.Require().NoError(genState.Validate())
+
+	s.SetupTest()
+	k = s.App.CircuitBreakerKeeper
+	s.Require().False(k.IsTripped(s.Ctx, createPositionMsgTypeUrl))
+
+	k.InitGenesis(s.Ctx, *genState)
+	s.Require().True(k.IsTripped(s.Ctx, createPositionMsgTypeUrl))
+	s.Require().Equal(genState, k.ExportGenesis(s.Ctx))
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// TripCircuitBreaker disables the messages of the given type URLs for the given duration.
+// The sender must be governance or one of the guardians.
+func (server msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := server.authorize(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msgTypeUrl := range msg.MsgTypeUrls {
+		breaker, err := server.Keeper.TripCircuitBreaker(ctx, sender, msgTypeUrl, msg.Duration)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCircuitBreakerTripped,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeUrl, msgTypeUrl),
+				sdk.NewAttribute(types.AttributeKeyExpiry, breaker.Expiry.Format(time.RFC3339)),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker enables the messages of the given type URLs again.
+// The sender must be governance or one of the guardians.
+func (server msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := server.authorize(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, msgTypeUrl := range msg.MsgTypeUrls {
+		if err := server.Keeper.ResetCircuitBreaker(ctx, msgTypeUrl); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCircuitBreakerReset,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeUrl, msgTypeUrl),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// authorize returns the sender's address if it is allowed to trip and reset circuit breakers.
+func (server msgServer) authorize(ctx sdk.Context, sender string) (sdk.AccAddress, error) {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !server.Keeper.IsAuthorized(ctx, senderAddr) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, sender)
+	}
+
+	return senderAddr, nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// untrippableMsgTypeUrlPrefixes are the prefixes of the message type URLs that can not be disabled, so that neither
+// governance nor the circuit breakers themselves can be locked out.
+var untrippableMsgTypeUrlPrefixes = []string{
+	"/cosmos.gov.",
+	"/osmosis.circuitbreaker.",
+}
+
+// ValidateMsgTypeUrl returns an error if the given message type URL is malformed or can not be disabled.
+func ValidateMsgTypeUrl(msgTypeUrl string) error {
+	if len(msgTypeUrl) < 2 || !strings.HasPrefix(msgTypeUrl, "/") || strings.ContainsAny(msgTypeUrl, " \t\n") {
+		return errorsmod.Wrapf(ErrInvalidMsgTypeUrl, "%q", msgTypeUrl)
+	}
+
+	for _, prefix := range untrippableMsgTypeUrlPrefixes {
+		if strings.HasPrefix(msgTypeUrl, prefix) {
+			return errorsmod.Wrapf(ErrInvalidMsgTypeUrl, "%s can not be disabled", msgTypeUrl)
+		}
+	}
+
+	return nil
+}
+
+// validateMsgTypeUrls validates each of the given message type URLs and that there is at least one.
+func validateMsgTypeUrls(msgTypeUrls []string) error {
+	if len(msgTypeUrls) == 0 {
+		return errorsmod.Wrap(ErrInvalidMsgTypeUrl, "no message type URLs")
+	}
+
+	for _, msgTypeUrl := range msgTypeUrls {
+		if err := ValidateMsgTypeUrl(msgTypeUrl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
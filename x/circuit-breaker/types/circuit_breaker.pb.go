@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/circuitbreaker/v1beta1/circuit_breaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TrippedCircuitBreaker is a circuit breaker disabling the messages of a type
+// URL until it expires or is reset.
+type TrippedCircuitBreaker struct {
+	// msg_type_url is the type URL of the disabled messages, e.g.
+	// /osmosis.concentratedliquidity.v1beta1.MsgCreatePosition.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// expiry is the time at which the circuit breaker resets automatically.
+	Expiry time.Time `protobuf:"bytes,2,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+	// tripped_by is the address that tripped the circuit breaker.
+	TrippedBy string `protobuf:"bytes,3,opt,name=tripped_by,json=trippedBy,proto3" json:"tripped_by,omitempty" yaml:"tripped_by"`
+}
+
+func (m *TrippedCircuitBreaker) Reset()         { *m = TrippedCircuitBreaker{} }
+func (m *TrippedCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*TrippedCircuitBreaker) ProtoMessage()    {}
+func (*TrippedCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dcac3281f260026, []int{0}
+}
+func (m *TrippedCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrippedCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrippedCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrippedCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrippedCircuitBreaker.Merge(m, src)
+}
+func (m *TrippedCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrippedCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrippedCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrippedCircuitBreaker proto.InternalMessageInfo
+
+func (m *TrippedCircuitBreaker) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TrippedCircuitBreaker) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *TrippedCircuitBreaker) GetTrippedBy() string {
+	if m != nil {
+		return m.TrippedBy
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TrippedCircuitBreaker)(nil), "osmosis.circuitbreaker.v1beta1.TrippedCircuitBreaker")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/circuitbreaker/v1beta1/circuit_breaker.proto", fileDescriptor_9dcac3281f260026)
+}
+
+var fileDescriptor_9dcac3281f260026 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0x7b, 0xa5, 0x4a, 0xcd, 0xbd, 0x0c, 0x04, 0x2a, 0x4a, 0x07, 0xa7, 0xca, 0xd4,
+	0xa5, 0xb6, 0x5a, 0x2a, 0x24, 0x60, 0x0b, 0x33, 0x4b, 0xd5, 0x2e, 0x2c, 0x51, 0x5c, 0x8c, 0xb1,
+	0x88, 0x65, 0xcb, 0x76, 0xaa, 0xe6, 0x2d, 0xfa, 0x58, 0x1d, 0xbb, 0xc1, 0x14, 0x50, 0xfb, 0x06,
+	0x7d, 0x02, 0xd4, 0x38, 0x11, 0xb0, 0xf9, 0x3f, 0xe7, 0x7c, 0xbf, 0xfe, 0xe3, 0xe3, 0x4f, 0xa4,
+	0x11, 0xd2, 0x70, 0x83, 0x17, 0x5c, 0x2f, 0x72, 0x6e, 0x89, 0xa6, 0xe9, 0x2b, 0xd5, 0x78, 0x39,
+	0x22, 0xd4, 0xa6, 0xa3, 0xa6, 0x9c, 0xd4, 0x75, 0xa4, 0xb4, 0xb4, 0x32, 0x80, 0x35, 0x85, 0x7e,
+	0x53, 0xa8, 0xa6, 0x7a, 0xe7, 0x4c, 0x32, 0x59, 0x8d, 0xe2, 0xe3, 0xcb, 0x51, 0xbd, 0x90, 0x49,
+	0xc9, 0x32, 0x8a, 0x2b, 0x45, 0xf2, 0x67, 0x6c, 0xb9, 0xa0, 0xc6, 0xa6, 0x42, 0xb9, 0x81, 0xe8,
+	0x0d, 0xf8, 0x9d, 0x99, 0xe6, 0x4a, 0xd1, 0xa7, 0x7b, 0x67, 0x1c, 0x3b, 0xe3, 0xe0, 0xc6, 0xff,
+	0x2f, 0x0c, 0x4b, 0x6c, 0xa1, 0x68, 0x92, 0xeb, 0xac, 0x0b, 0xfa, 0x60, 0xd0, 0x8e, 0x2f, 0x0e,
+	0x65, 0x78, 0x56, 0xa4, 0x22, 0xbb, 0x8d, 0x7e, 0x76, 0xa3, 0xa9, 0x2f, 0x0c, 0x9b, 0x15, 0x8a,
+	0xce, 0x75, 0x16, 0x3c, 0xf8, 0x2d, 0xba, 0x52, 0x5c, 0x17, 0xdd, 0x3f, 0x7d, 0x30, 0xf8, 0x37,
+	0xee, 0x21, 0x17, 0x03, 0x35, 0x31, 0xd0, 0xac, 0x89, 0x11, 0x5f, 0x6e, 0xca, 0xd0, 0x3b, 0x94,
+	0xe1, 0x89, 0x33, 0x75, 0x5c, 0xb4, 0xfe, 0x08, 0xc1, 0xb4, 0x36, 0x09, 0x26, 0xbe, 0x6f, 0x5d,
+	0xc4, 0x84, 0x14, 0xdd, 0xbf, 0x55, 0x8e, 0xce, 0xa1, 0x0c, 0x4f, 0x1d, 0xf2, 0xdd, 0x8b, 0xa6,
+	0xed, 0x5a, 0xc4, 0x45, 0x3c, 0xdf, 0xec, 0x20, 0xd8, 0xee, 0x20, 0xf8, 0xdc, 0x41, 0xb0, 0xde,
+	0x43, 0x6f, 0xbb, 0x87, 0xde, 0xfb, 0x1e, 0x7a, 0x8f, 0x77, 0x8c, 0xdb, 0x97, 0x9c, 0xa0, 0x85,
+	0x14, 0xb8, 0xfe, 0xd5, 0x61, 0x96, 0x12, 0xd3, 0x08, 0xbc, 0x1c, 0x5f, 0xe3, 0x55, 0x73, 0x87,
+	0x61, 0x73, 0x9f, 0xe3, 0xae, 0x86, 0xb4, 0xaa, 0x1d, 0xae, 0xbe, 0x06, 0x00, 0xfa, 0x2d, 0x76,
+	0x64, 0xc6, 0x01, 0x00, 0x00,
+}
+
+func (m *TrippedCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrippedCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrippedCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrippedBy) > 0 {
+		i -= len(m.TrippedBy)
+		copy(dAtA[i:], m.TrippedBy)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.TrippedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCircuitBreaker(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TrippedCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	l = len(m.TrippedBy)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TrippedCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrippedCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrippedCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
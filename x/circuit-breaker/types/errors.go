@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/circuitbreaker module sentinel errors
+var (
+	ErrUnauthorized          = errorsmod.Register(ModuleName, 2, "signer is neither a guardian nor governance")
+	ErrInvalidMsgTypeUrl     = errorsmod.Register(ModuleName, 3, "invalid message type URL")
+	ErrInvalidTripDuration   = errorsmod.Register(ModuleName, 4, "invalid trip duration")
+	ErrCircuitBreakerTripped = errorsmod.Register(ModuleName, 5, "message type is disabled by a tripped circuit breaker")
+	ErrNotTripped            = errorsmod.Register(ModuleName, 6, "circuit breaker is not tripped")
+)
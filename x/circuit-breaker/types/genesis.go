@@ -0,0 +1,32 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:                 DefaultParams(),
+		TrippedCircuitBreakers: []TrippedCircuitBreaker{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.TrippedCircuitBreakers))
+	for _, breaker := range gs.TrippedCircuitBreakers {
+		if err := ValidateMsgTypeUrl(breaker.MsgTypeUrl); err != nil {
+			return err
+		}
+		if seen[breaker.MsgTypeUrl] {
+			return fmt.Errorf("duplicate tripped circuit breaker for %s", breaker.MsgTypeUrl)
+		}
+		seen[breaker.MsgTypeUrl] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuitbreaker"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	EventTypeCircuitBreakerTripped = "circuit_breaker_tripped"
+	EventTypeCircuitBreakerReset   = "circuit_breaker_reset"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyMsgTypeUrl = "msg_type_url"
+	AttributeKeyExpiry     = "expiry"
+)
+
+var (
+	// Store prefix keys
+	KeyTrippedCircuitBreakerPrefix = []byte{0x01}
+
+	// Parameter keys
+	KeyGuardians       = []byte("Guardians")
+	KeyMaxTripDuration = []byte("MaxTripDuration")
+)
+
+// KeyTrippedCircuitBreaker returns the key of the tripped circuit breaker of the given message type URL.
+func KeyTrippedCircuitBreaker(msgTypeUrl string) []byte {
+	return append(KeyTrippedCircuitBreakerPrefix, []byte(msgTypeUrl)...)
+}
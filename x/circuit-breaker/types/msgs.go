@@ -0,0 +1,71 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgTripCircuitBreaker  = "trip_circuit_breaker"
+	TypeMsgResetCircuitBreaker = "reset_circuit_breaker"
+)
+
+var (
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// NewMsgTripCircuitBreaker creates a msg to disable the messages of the given type URLs for a duration.
+func NewMsgTripCircuitBreaker(sender string, msgTypeUrls []string, duration time.Duration) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Sender:      sender,
+		MsgTypeUrls: msgTypeUrls,
+		Duration:    duration,
+	}
+}
+
+func (m MsgTripCircuitBreaker) Route() string { return RouterKey }
+func (m MsgTripCircuitBreaker) Type() string  { return TypeMsgTripCircuitBreaker }
+func (m MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidTripDuration, "duration must be positive: %s", m.Duration)
+	}
+
+	return validateMsgTypeUrls(m.MsgTypeUrls)
+}
+
+func (m MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgResetCircuitBreaker creates a msg to enable the messages of the given type URLs again.
+func NewMsgResetCircuitBreaker(sender string, msgTypeUrls []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Sender:      sender,
+		MsgTypeUrls: msgTypeUrls,
+	}
+}
+
+func (m MsgResetCircuitBreaker) Route() string { return RouterKey }
+func (m MsgResetCircuitBreaker) Type() string  { return TypeMsgResetCircuitBreaker }
+func (m MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateMsgTypeUrls(m.MsgTypeUrls)
+}
+
+func (m MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v26/x/circuit-breaker/types"
+)
+
+const createPositionMsgTypeUrl = "/osmosis.concentratedliquidity.v1beta1.MsgCreatePosition"
+
+func TestMsgTripCircuitBreakerValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	tests := map[string]struct {
+		msg         *types.MsgTripCircuitBreaker
+		expectedErr error
+	}{
+		"valid": {
+			msg: types.NewMsgTripCircuitBreaker(addr, []string{createPositionMsgTypeUrl}, time.Hour),
+		},
+		"invalid sender": {
+			msg:         types.NewMsgTripCircuitBreaker("osmo1invalid", []string{createPositionMsgTypeUrl}, time.Hour),
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		"no message type URLs": {
+			msg:         types.NewMsgTripCircuitBreaker(addr, []string{}, time.Hour),
+			expectedErr: types.ErrInvalidMsgTypeUrl,
+		},
+		"message type URL without leading slash": {
+			msg:         types.NewMsgTripCircuitBreaker(addr, []string{createPositionMsgTypeUrl[1:]}, time.Hour),
+			expectedErr: types.ErrInvalidMsgTypeUrl,
+		},
+		"governance message type URL": {
+			msg:         types.NewMsgTripCircuitBreaker(addr, []string{"/cosmos.gov.v1.MsgSubmitProposal"}, time.Hour),
+			expectedErr: types.ErrInvalidMsgTypeUrl,
+		},
+		"circuit breaker message type URL": {
+			msg:         types.NewMsgTripCircuitBreaker(addr, []string{"/osmosis.circuitbreaker.v1beta1.MsgResetCircuitBreaker"}, time.Hour),
+			expectedErr: types.ErrInvalidMsgTypeUrl,
+		},
+		"zero duration": {
+			msg:         types.NewMsgTripCircuitBreaker(addr, []string{createPositionMsgTypeUrl}, 0),
+			expectedErr: types.ErrInvalidTripDuration,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgResetCircuitBreakerValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	require.NoError(t, types.NewMsgResetCircuitBreaker(addr, []string{createPositionMsgTypeUrl}).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgResetCircuitBreaker("", []string{createPositionMsgTypeUrl}).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, types.NewMsgResetCircuitBreaker(addr, nil).ValidateBasic(), types.ErrInvalidMsgTypeUrl)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	expiry := time.Unix(1_700_000_000, 0).UTC()
+
+	tests := map[string]struct {
+		genState  *types.GenesisState
+		expectErr bool
+	}{
+		"default": {
+			genState: types.DefaultGenesis(),
+		},
+		"tripped circuit breakers": {
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TrippedCircuitBreakers: []types.TrippedCircuitBreaker{
+					{MsgTypeUrl: createPositionMsgTypeUrl, Expiry: expiry},
+					{MsgTypeUrl: "/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn", Expiry: expiry},
+				},
+			},
+		},
+		"duplicate tripped circuit breakers": {
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TrippedCircuitBreakers: []types.TrippedCircuitBreaker{
+					{MsgTypeUrl: createPositionMsgTypeUrl, Expiry: expiry},
+					{MsgTypeUrl: createPositionMsgTypeUrl, Expiry: expiry},
+				},
+			},
+			expectErr: true,
+		},
+		"invalid guardian": {
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"osmo1invalid"}, types.DefaultMaxTripDuration),
+			},
+			expectErr: true,
+		},
+		"non-positive max trip duration": {
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{}, 0),
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
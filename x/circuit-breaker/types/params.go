@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultMaxTripDuration is the default longest duration a circuit breaker can be tripped for.
+const DefaultMaxTripDuration = 7 * 24 * time.Hour
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(guardians []string, maxTripDuration time.Duration) Params {
+	return Params{
+		Guardians:       guardians,
+		MaxTripDuration: maxTripDuration,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams([]string{}, DefaultMaxTripDuration)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGuardians, &p.Guardians, validateGuardians),
+		paramtypes.NewParamSetPair(KeyMaxTripDuration, &p.MaxTripDuration, validateMaxTripDuration),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateGuardians(p.Guardians); err != nil {
+		return err
+	}
+
+	return validateMaxTripDuration(p.MaxTripDuration)
+}
+
+func validateGuardians(i interface{}) error {
+	guardians, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// each string in the array should be a valid address
+	for _, addr := range guardians {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid address: %s", addr)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxTripDuration(i interface{}) error {
+	maxTripDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxTripDuration <= 0 {
+		return fmt.Errorf("max trip duration must be positive: %s", maxTripDuration)
+	}
+
+	return nil
+}
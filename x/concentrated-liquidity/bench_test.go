@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"testing"
 
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/stretchr/testify/require"
@@ -251,6 +252,31 @@ func BenchmarkSwapExactAmountIn(b *testing.B) {
 	})
 }
 
+// BenchmarkSwapExactAmountInGas reports the gas consumed by a large swap, in total and per crossed tick.
+func BenchmarkSwapExactAmountInGas(b *testing.B) {
+	runBenchmark(b, func(b *testing.B, s *BenchTestSuite, pool types.ConcentratedPoolExtension, largeSwapInCoin sdk.Coin, currentTick int64) {
+		clKeeper := s.App.ConcentratedLiquidityKeeper
+
+		liquidityNet, err := clKeeper.GetTickLiquidityNetInDirection(s.Ctx, pool.GetId(), largeSwapInCoin.Denom, osmomath.NewInt(currentTick), osmomath.Int{})
+		noError(b, err)
+		testutil.FundAccount(s.Ctx, s.App.BankKeeper, s.TestAccs[0], sdk.NewCoins(largeSwapInCoin))
+		ctx := s.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		b.StartTimer()
+
+		// System under test
+		_, err = clKeeper.SwapExactAmountIn(ctx, s.TestAccs[0], pool, largeSwapInCoin, DefaultCoin1.Denom, osmomath.NewInt(1), pool.GetSpreadFactor(ctx))
+		b.StopTimer()
+		noError(b, err)
+
+		gasConsumed := ctx.GasMeter().GasConsumed()
+		b.ReportMetric(float64(gasConsumed), "gas/op")
+		if len(liquidityNet) > 0 {
+			b.ReportMetric(float64(gasConsumed)/float64(len(liquidityNet)), "gas/tick")
+		}
+	})
+}
+
 func BenchmarkGetTickLiquidityNetInDirection(b *testing.B) {
 	runBenchmark(b, func(b *testing.B, s *BenchTestSuite, pool types.ConcentratedPoolExtension, largeSwapInCoin sdk.Coin, currentTick int64) {
 		clKeeper := s.App.ConcentratedLiquidityKeeper
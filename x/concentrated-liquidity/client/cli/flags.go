@@ -8,6 +8,9 @@ const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagPoolIdToMetadataRecords    = "pool-metadata-records"
+	FlagPoolName                   = "pool-name"
+	FlagTickerPairing              = "ticker-pairing"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -15,3 +18,10 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetPoolMetadata() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagPoolName, "", "The optional human readable name of the pool")
+	fs.String(FlagTickerPairing, "", "The optional display pairing of the pool's assets, e.g. OSMO/USDC")
+	return fs
+}
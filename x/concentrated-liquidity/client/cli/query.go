@@ -17,12 +17,18 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionById)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableSpreadRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableIncentives)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPendingSpreadRewards)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPendingIncentives)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetIncentiveRecords)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCFMMPoolIdLinkFromConcentratedPoolId)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickLiquidityNetInDirection)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetExportTicks)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRange)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetStopCondition)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetStopConditionsByPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetSpreadRewardGrowthSaturatedPools)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -79,6 +85,24 @@ func GetClaimableIncentives() (*osmocli.QueryDescriptor, *queryproto.ClaimableIn
 	}, &queryproto.ClaimableIncentivesRequest{}
 }
 
+func GetPendingSpreadRewards() (*osmocli.QueryDescriptor, *queryproto.PendingSpreadRewardsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pending-spread-rewards",
+		Short: "Query pending spread rewards of all of an owner's positions in a pool and tick range",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pending-spread-rewards 1 osmo1... "[-100]" 100`,
+	}, &queryproto.PendingSpreadRewardsRequest{}
+}
+
+func GetPendingIncentives() (*osmocli.QueryDescriptor, *queryproto.PendingIncentivesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pending-incentives",
+		Short: "Query pending incentives of all of an owner's positions in a pool and tick range",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pending-incentives 1 osmo1... "[-100]" 100`,
+	}, &queryproto.PendingIncentivesRequest{}
+}
+
 func GetIncentiveRecords() (*osmocli.QueryDescriptor, *queryproto.IncentiveRecordsRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "incentive-records",
@@ -146,3 +170,39 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetStopCondition() (*osmocli.QueryDescriptor, *queryproto.StopConditionRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "stop-condition",
+		Short: "Query the stop condition of a position and whether it is met",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} stop-condition 53`,
+	}, &queryproto.StopConditionRequest{}
+}
+
+func GetStopConditionsByPool() (*osmocli.QueryDescriptor, *queryproto.StopConditionsByPoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "stop-conditions-by-pool",
+		Short: "Query the stop conditions of the positions in a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} stop-conditions-by-pool 1`,
+	}, &queryproto.StopConditionsByPoolRequest{}
+}
+
+func GetSpreadRewardGrowthSaturatedPools() (*osmocli.QueryDescriptor, *queryproto.SpreadRewardGrowthSaturatedPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "spread-reward-growth-saturated-pools",
+		Short: "Query the pools whose spread reward growth saturated, optionally restricted to the given pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} spread-reward-growth-saturated-pools 0`,
+	}, &queryproto.SpreadRewardGrowthSaturatedPoolsRequest{}
+}
+
+func GetExportTicks() (*osmocli.QueryDescriptor, *queryproto.ExportTicksRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "export-ticks",
+		Short: "Query every initialized tick of a pool with its liquidity and accumulator trackers, paginated",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} export-ticks 1 --limit 100`,
+	}, &queryproto.ExportTicksRequest{}
+}
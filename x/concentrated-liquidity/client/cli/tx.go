@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -31,6 +32,9 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewRegisterStopConditionCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelStopConditionCmd)
+	osmocli.AddTxCmd(txCmd, NewExecuteStopConditionCmd)
 	return txCmd
 }
 
@@ -43,7 +47,12 @@ func NewCreateConcentratedPoolCmd() (*osmocli.TxCliDesc, *clmodel.MsgCreateConce
 		Use:     "create-pool",
 		Short:   "create a concentrated liquidity pool with the given denom pair, tick spacing, and spread factor",
 		Long:    "denom-1 (the quote denom), tick spacing, and spread factors must all be authorized by the concentrated liquidity module",
-		Example: "osmosisd tx concentratedliquidity create-pool uion uosmo 100 0.01 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+		Example: "osmosisd tx concentratedliquidity create-pool uion uosmo 100 0.01 --pool-name \"ION/OSMO\" --ticker-pairing ION/OSMO --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+		CustomFlagOverrides: map[string]string{
+			"name":          FlagPoolName,
+			"tickerpairing": FlagTickerPairing,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetPoolMetadata()}},
 	}, &clmodel.MsgCreateConcentratedPool{}
 }
 
@@ -103,6 +112,31 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewRegisterStopConditionCmd() (*osmocli.TxCliDesc, *types.MsgRegisterStopCondition) {
+	return &osmocli.TxCliDesc{
+		Use:     "register-stop-condition",
+		Short:   "register a stop condition withdrawing a position once the price stays outside of [lower-tick, upper-tick) for the given duration",
+		Long:    "the bounty is escrowed and paid to whichever account executes the stop condition once it is met",
+		Example: "osmosisd tx concentratedliquidity register-stop-condition 53 \"[-69082]\" 69082 24h 1000uosmo --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgRegisterStopCondition{}
+}
+
+func NewCancelStopConditionCmd() (*osmocli.TxCliDesc, *types.MsgCancelStopCondition) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-stop-condition",
+		Short:   "cancel the stop condition of a position and refund its bounty",
+		Example: "osmosisd tx concentratedliquidity cancel-stop-condition 53 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCancelStopCondition{}
+}
+
+func NewExecuteStopConditionCmd() (*osmocli.TxCliDesc, *types.MsgExecuteStopCondition) {
+	return &osmocli.TxCliDesc{
+		Use:     "execute-stop-condition",
+		Short:   "withdraw a position whose stop condition is met to its owner and collect the bounty",
+		Example: "osmosisd tx concentratedliquidity execute-stop-condition 53 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgExecuteStopCondition{}
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -190,6 +224,50 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+func NewSetPoolMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-metadata-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a set pool metadata proposal",
+		Long: strings.TrimSpace(`Submit a set pool metadata proposal.
+
+Passing in FlagPoolIdToMetadataRecords separated by commas would be parsed automatically to triples of PoolIdToMetadata records.
+Ex) --pool-metadata-records=1,Osmosis Hub,OSMO/USDC,5,,ATOM/OSMO -> [(poolId 1, name Osmosis Hub, tickerPairing OSMO/USDC), (poolId 5, no name, tickerPairing ATOM/OSMO)]
+Note: The pools' creators are left unchanged.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parsePoolIdToMetadataRecordsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagPoolIdToMetadataRecords, "", "The pool ID to new name and ticker pairing records array")
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -275,6 +353,63 @@ func parsePoolIdToTickSpacingRecords(cmd *cobra.Command) ([]types.PoolIdToTickSp
 	return poolIdToTickSpacingRecords, nil
 }
 
+func parsePoolIdToMetadataRecordsArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdToMetadataRecords, err := parsePoolIdToMetadataRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetPoolMetadataProposal{
+		Title:                   title,
+		Description:             description,
+		PoolIdToMetadataRecords: poolIdToMetadataRecords,
+	}
+	return content, nil
+}
+
+func parsePoolIdToMetadataRecords(cmd *cobra.Command) ([]types.PoolIdToMetadataRecord, error) {
+	recordsStr, err := cmd.Flags().GetString(FlagPoolIdToMetadataRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	records := strings.Split(recordsStr, ",")
+
+	if len(records)%3 != 0 {
+		return nil, fmt.Errorf("poolIdToMetadataRecords must be a list of triples of poolId, name and tickerPairing")
+	}
+
+	poolIdToMetadataRecords := []types.PoolIdToMetadataRecord{}
+	i := 0
+	for i < len(records) {
+		poolId, err := strconv.ParseUint(records[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		poolIdToMetadataRecords = append(poolIdToMetadataRecords, types.PoolIdToMetadataRecord{
+			PoolId:        poolId,
+			Name:          records[i+1],
+			TickerPairing: records[i+2],
+		})
+
+		// increase counter by the next 3
+		i = i + 3
+	}
+
+	return poolIdToMetadataRecords, nil
+}
+
 func parsePoolRecords(cmd *cobra.Command) ([]types.PoolRecord, error) {
 	poolRecordsStr, err := cmd.Flags().GetString(FlagPoolRecords)
 	if err != nil {
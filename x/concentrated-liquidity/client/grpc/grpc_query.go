@@ -41,6 +41,16 @@ func (q Querier) UserPositions(grpcCtx context.Context,
 	return q.Q.UserPositions(ctx, *req)
 }
 
+func (q Querier) ExportTicks(grpcCtx context.Context,
+	req *queryproto.ExportTicksRequest,
+) (*queryproto.ExportTicksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ExportTicks(ctx, *req)
+}
+
 func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	req *queryproto.TickAccumulatorTrackersRequest,
 ) (*queryproto.TickAccumulatorTrackersResponse, error) {
@@ -51,6 +61,36 @@ func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	return q.Q.TickAccumulatorTrackers(ctx, *req)
 }
 
+func (q Querier) SpreadRewardGrowthSaturatedPools(grpcCtx context.Context,
+	req *queryproto.SpreadRewardGrowthSaturatedPoolsRequest,
+) (*queryproto.SpreadRewardGrowthSaturatedPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SpreadRewardGrowthSaturatedPools(ctx, *req)
+}
+
+func (q Querier) StopConditionsByPool(grpcCtx context.Context,
+	req *queryproto.StopConditionsByPoolRequest,
+) (*queryproto.StopConditionsByPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.StopConditionsByPool(ctx, *req)
+}
+
+func (q Querier) StopCondition(grpcCtx context.Context,
+	req *queryproto.StopConditionRequest,
+) (*queryproto.StopConditionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.StopCondition(ctx, *req)
+}
+
 func (q Querier) PositionById(grpcCtx context.Context,
 	req *queryproto.PositionByIdRequest,
 ) (*queryproto.PositionByIdResponse, error) {
@@ -81,6 +121,26 @@ func (q Querier) PoolAccumulatorRewards(grpcCtx context.Context,
 	return q.Q.PoolAccumulatorRewards(ctx, *req)
 }
 
+func (q Querier) PendingSpreadRewards(grpcCtx context.Context,
+	req *queryproto.PendingSpreadRewardsRequest,
+) (*queryproto.PendingSpreadRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PendingSpreadRewards(ctx, *req)
+}
+
+func (q Querier) PendingIncentives(grpcCtx context.Context,
+	req *queryproto.PendingIncentivesRequest,
+) (*queryproto.PendingIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PendingIncentives(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -182,6 +182,49 @@ func (q Querier) ClaimableIncentives(ctx sdk.Context, req clquery.ClaimableIncen
 	}, nil
 }
 
+// PendingSpreadRewards returns the total amount of spread rewards claimable by all positions
+// of the given owner in the given pool and tick range.
+func (q Querier) PendingSpreadRewards(ctx sdk.Context, req clquery.PendingSpreadRewardsRequest) (*clquery.PendingSpreadRewardsResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pendingSpreadRewards, err := q.Keeper.GetPendingSpreadRewards(ctx, req.PoolId, owner, req.LowerTick, req.UpperTick)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PendingSpreadRewardsResponse{
+		PendingSpreadRewards: pendingSpreadRewards,
+	}, nil
+}
+
+// PendingIncentives returns the total amount of incentives claimable and forfeitable by all
+// positions of the given owner in the given pool and tick range.
+func (q Querier) PendingIncentives(ctx sdk.Context, req clquery.PendingIncentivesRequest) (*clquery.PendingIncentivesResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pendingIncentives, forfeitedIncentives, err := q.Keeper.GetPendingIncentives(ctx, req.PoolId, owner, req.LowerTick, req.UpperTick)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PendingIncentivesResponse{
+		PendingIncentives:   pendingIncentives,
+		ForfeitedIncentives: forfeitedIncentives,
+	}, nil
+}
+
 // PoolAccumulatorRewards returns pool accumulator rewards.
 // It includes global spread reward growth and global uptime growth accumulator values.
 func (q Querier) PoolAccumulatorRewards(ctx sdk.Context, req clquery.PoolAccumulatorRewardsRequest) (*clquery.PoolAccumulatorRewardsResponse, error) {
@@ -250,6 +293,34 @@ func (q Querier) TickAccumulatorTrackers(ctx sdk.Context, req clquery.TickAccumu
 	}, nil
 }
 
+// ExportTicks returns a page of the initialized ticks of a pool, with their liquidity and accumulator trackers.
+func (q Querier) ExportTicks(ctx sdk.Context, req clquery.ExportTicksRequest) (*clquery.ExportTicksResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	ticks, pageRes, err := q.Keeper.GetInitializedTicksForPoolPaginated(ctx, req.PoolId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	exportedTicks := make([]clquery.ExportedTick, 0, len(ticks))
+	for _, tick := range ticks {
+		exportedTicks = append(exportedTicks, clquery.ExportedTick{
+			TickIndex:      tick.TickIndex,
+			LiquidityGross: tick.Info.LiquidityGross,
+			LiquidityNet:   tick.Info.LiquidityNet,
+			SpreadRewardGrowthOppositeDirectionOfLastTraversal: tick.Info.SpreadRewardGrowthOppositeDirectionOfLastTraversal,
+			UptimeTrackers: tick.Info.UptimeTrackers.List,
+		})
+	}
+
+	return &clquery.ExportTicksResponse{
+		Ticks:      exportedTicks,
+		Pagination: pageRes,
+	}, nil
+}
+
 // CFMMPoolIdLinkFromConcentratedPoolId queries the cfmm pool id linked to a concentrated pool id.
 func (q Querier) CFMMPoolIdLinkFromConcentratedPoolId(ctx sdk.Context, req clquery.CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*clquery.CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
 	if req.ConcentratedPoolId == 0 {
@@ -335,3 +406,56 @@ func (q Querier) NumPoolPositions(ctx sdk.Context, req clquery.NumPoolPositionsR
 		PositionCount: uint64(len(positionIDs)),
 	}, nil
 }
+
+// StopCondition returns the stop condition registered for a position and whether it is currently met.
+func (q Querier) StopCondition(ctx sdk.Context, req clquery.StopConditionRequest) (*clquery.StopConditionResponse, error) {
+	if req.PositionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "position id is zero")
+	}
+
+	stopCondition, err := q.Keeper.GetStopCondition(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	isMet, err := q.Keeper.IsStopConditionMet(ctx, stopCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clquery.StopConditionResponse{StopCondition: stopCondition, Met: isMet}, nil
+}
+
+// StopConditionsByPool returns all stop conditions registered for positions in a pool.
+func (q Querier) StopConditionsByPool(ctx sdk.Context, req clquery.StopConditionsByPoolRequest) (*clquery.StopConditionsByPoolResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	stopConditions, err := q.Keeper.GetStopConditionsForPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clquery.StopConditionsByPoolResponse{StopConditions: stopConditions}, nil
+}
+
+// SpreadRewardGrowthSaturatedPools returns the pools whose spread reward growth saturated, along with the saturated denoms.
+// If a pool id is given, only that pool is returned if it is saturated.
+func (q Querier) SpreadRewardGrowthSaturatedPools(ctx sdk.Context, req clquery.SpreadRewardGrowthSaturatedPoolsRequest) (*clquery.SpreadRewardGrowthSaturatedPoolsResponse, error) {
+	poolIds := []uint64{req.PoolId}
+	if req.PoolId == 0 {
+		poolIds = q.Keeper.GetAllSpreadRewardGrowthSaturatedPoolIds(ctx)
+	}
+
+	pools := []clquery.SpreadRewardGrowthSaturatedPool{}
+	for _, poolId := range poolIds {
+		denoms := q.Keeper.GetSpreadRewardGrowthSaturatedDenoms(ctx, poolId)
+		if len(denoms) == 0 {
+			continue
+		}
+		pools = append(pools, clquery.SpreadRewardGrowthSaturatedPool{PoolId: poolId, Denoms: denoms})
+	}
+
+	return &clquery.SpreadRewardGrowthSaturatedPoolsResponse{Pools: pools}, nil
+}
@@ -1028,6 +1028,240 @@ func (m *ClaimableIncentivesResponse) GetForfeitedIncentives() []types2.Coin {
 	return nil
 }
 
+// ===================== QueryPendingSpreadRewards
+type PendingSpreadRewardsRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick int64  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *PendingSpreadRewardsRequest) Reset()         { *m = PendingSpreadRewardsRequest{} }
+func (m *PendingSpreadRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingSpreadRewardsRequest) ProtoMessage()    {}
+func (*PendingSpreadRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{20}
+}
+func (m *PendingSpreadRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSpreadRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSpreadRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSpreadRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSpreadRewardsRequest.Merge(m, src)
+}
+func (m *PendingSpreadRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSpreadRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSpreadRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSpreadRewardsRequest proto.InternalMessageInfo
+
+func (m *PendingSpreadRewardsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PendingSpreadRewardsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PendingSpreadRewardsRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *PendingSpreadRewardsRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+type PendingSpreadRewardsResponse struct {
+	PendingSpreadRewards []types2.Coin `protobuf:"bytes,1,rep,name=pending_spread_rewards,json=pendingSpreadRewards,proto3" json:"pending_spread_rewards" yaml:"pending_spread_rewards"`
+}
+
+func (m *PendingSpreadRewardsResponse) Reset()         { *m = PendingSpreadRewardsResponse{} }
+func (m *PendingSpreadRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingSpreadRewardsResponse) ProtoMessage()    {}
+func (*PendingSpreadRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{21}
+}
+func (m *PendingSpreadRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSpreadRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSpreadRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSpreadRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSpreadRewardsResponse.Merge(m, src)
+}
+func (m *PendingSpreadRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSpreadRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSpreadRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSpreadRewardsResponse proto.InternalMessageInfo
+
+func (m *PendingSpreadRewardsResponse) GetPendingSpreadRewards() []types2.Coin {
+	if m != nil {
+		return m.PendingSpreadRewards
+	}
+	return nil
+}
+
+// ===================== QueryPendingIncentives
+type PendingIncentivesRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick int64  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *PendingIncentivesRequest) Reset()         { *m = PendingIncentivesRequest{} }
+func (m *PendingIncentivesRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingIncentivesRequest) ProtoMessage()    {}
+func (*PendingIncentivesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{22}
+}
+func (m *PendingIncentivesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingIncentivesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingIncentivesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingIncentivesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingIncentivesRequest.Merge(m, src)
+}
+func (m *PendingIncentivesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingIncentivesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingIncentivesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingIncentivesRequest proto.InternalMessageInfo
+
+func (m *PendingIncentivesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PendingIncentivesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PendingIncentivesRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *PendingIncentivesRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+type PendingIncentivesResponse struct {
+	PendingIncentives   []types2.Coin `protobuf:"bytes,1,rep,name=pending_incentives,json=pendingIncentives,proto3" json:"pending_incentives" yaml:"pending_incentives"`
+	ForfeitedIncentives []types2.Coin `protobuf:"bytes,2,rep,name=forfeited_incentives,json=forfeitedIncentives,proto3" json:"forfeited_incentives" yaml:"forfeited_incentives"`
+}
+
+func (m *PendingIncentivesResponse) Reset()         { *m = PendingIncentivesResponse{} }
+func (m *PendingIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingIncentivesResponse) ProtoMessage()    {}
+func (*PendingIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{23}
+}
+func (m *PendingIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingIncentivesResponse.Merge(m, src)
+}
+func (m *PendingIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingIncentivesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingIncentivesResponse proto.InternalMessageInfo
+
+func (m *PendingIncentivesResponse) GetPendingIncentives() []types2.Coin {
+	if m != nil {
+		return m.PendingIncentives
+	}
+	return nil
+}
+
+func (m *PendingIncentivesResponse) GetForfeitedIncentives() []types2.Coin {
+	if m != nil {
+		return m.ForfeitedIncentives
+	}
+	return nil
+}
+
 // ===================== QueryPoolAccumulatorRewards
 type PoolAccumulatorRewardsRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -1037,7 +1271,7 @@ func (m *PoolAccumulatorRewardsRequest) Reset()         { *m = PoolAccumulatorRe
 func (m *PoolAccumulatorRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*PoolAccumulatorRewardsRequest) ProtoMessage()    {}
 func (*PoolAccumulatorRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{20}
+	return fileDescriptor_5da291368ba4d8e3, []int{24}
 }
 func (m *PoolAccumulatorRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1316,7 @@ func (m *PoolAccumulatorRewardsResponse) Reset()         { *m = PoolAccumulatorR
 func (m *PoolAccumulatorRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolAccumulatorRewardsResponse) ProtoMessage()    {}
 func (*PoolAccumulatorRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{21}
+	return fileDescriptor_5da291368ba4d8e3, []int{25}
 }
 func (m *PoolAccumulatorRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1135,7 +1369,7 @@ func (m *TickAccumulatorTrackersRequest) Reset()         { *m = TickAccumulatorT
 func (m *TickAccumulatorTrackersRequest) String() string { return proto.CompactTextString(m) }
 func (*TickAccumulatorTrackersRequest) ProtoMessage()    {}
 func (*TickAccumulatorTrackersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{22}
+	return fileDescriptor_5da291368ba4d8e3, []int{26}
 }
 func (m *TickAccumulatorTrackersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1421,7 @@ func (m *TickAccumulatorTrackersResponse) Reset()         { *m = TickAccumulator
 func (m *TickAccumulatorTrackersResponse) String() string { return proto.CompactTextString(m) }
 func (*TickAccumulatorTrackersResponse) ProtoMessage()    {}
 func (*TickAccumulatorTrackersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{23}
+	return fileDescriptor_5da291368ba4d8e3, []int{27}
 }
 func (m *TickAccumulatorTrackersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,24 +1464,24 @@ func (m *TickAccumulatorTrackersResponse) GetUptimeTrackers() []model.UptimeTrac
 	return nil
 }
 
-// ===================== QueryIncentiveRecords
-type IncentiveRecordsRequest struct {
+// ===================== QueryExportTicks
+type ExportTicksRequest struct {
 	PoolId     uint64             `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *IncentiveRecordsRequest) Reset()         { *m = IncentiveRecordsRequest{} }
-func (m *IncentiveRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*IncentiveRecordsRequest) ProtoMessage()    {}
-func (*IncentiveRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{24}
+func (m *ExportTicksRequest) Reset()         { *m = ExportTicksRequest{} }
+func (m *ExportTicksRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTicksRequest) ProtoMessage()    {}
+func (*ExportTicksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{28}
 }
-func (m *IncentiveRecordsRequest) XXX_Unmarshal(b []byte) error {
+func (m *ExportTicksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IncentiveRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExportTicksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IncentiveRecordsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExportTicksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1257,50 +1491,54 @@ func (m *IncentiveRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *IncentiveRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncentiveRecordsRequest.Merge(m, src)
+func (m *ExportTicksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTicksRequest.Merge(m, src)
 }
-func (m *IncentiveRecordsRequest) XXX_Size() int {
+func (m *ExportTicksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *IncentiveRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncentiveRecordsRequest.DiscardUnknown(m)
+func (m *ExportTicksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTicksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncentiveRecordsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportTicksRequest proto.InternalMessageInfo
 
-func (m *IncentiveRecordsRequest) GetPoolId() uint64 {
+func (m *ExportTicksRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *IncentiveRecordsRequest) GetPagination() *query.PageRequest {
+func (m *ExportTicksRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type IncentiveRecordsResponse struct {
-	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,1,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type ExportedTick struct {
+	TickIndex      int64                       `protobuf:"varint,1,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	LiquidityGross cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	LiquidityNet   cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_net" yaml:"liquidity_net"`
+	// spread_reward_growth_opposite_direction_of_last_traversal is the spread
+	// reward growth outside of the tick. See TickInfo.
+	SpreadRewardGrowthOppositeDirectionOfLastTraversal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=spread_reward_growth_opposite_direction_of_last_traversal,json=spreadRewardGrowthOppositeDirectionOfLastTraversal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"spread_reward_growth_opposite_direction_of_last_traversal"`
+	UptimeTrackers                                     []model.UptimeTracker                       `protobuf:"bytes,5,rep,name=uptime_trackers,json=uptimeTrackers,proto3" json:"uptime_trackers" yaml:"uptime_trackers"`
 }
 
-func (m *IncentiveRecordsResponse) Reset()         { *m = IncentiveRecordsResponse{} }
-func (m *IncentiveRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*IncentiveRecordsResponse) ProtoMessage()    {}
-func (*IncentiveRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{25}
+func (m *ExportedTick) Reset()         { *m = ExportedTick{} }
+func (m *ExportedTick) String() string { return proto.CompactTextString(m) }
+func (*ExportedTick) ProtoMessage()    {}
+func (*ExportedTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{29}
 }
-func (m *IncentiveRecordsResponse) XXX_Unmarshal(b []byte) error {
+func (m *ExportedTick) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IncentiveRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExportedTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IncentiveRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExportedTick.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1310,53 +1548,56 @@ func (m *IncentiveRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *IncentiveRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncentiveRecordsResponse.Merge(m, src)
+func (m *ExportedTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportedTick.Merge(m, src)
 }
-func (m *IncentiveRecordsResponse) XXX_Size() int {
+func (m *ExportedTick) XXX_Size() int {
 	return m.Size()
 }
-func (m *IncentiveRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncentiveRecordsResponse.DiscardUnknown(m)
+func (m *ExportedTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportedTick.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncentiveRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportedTick proto.InternalMessageInfo
 
-func (m *IncentiveRecordsResponse) GetIncentiveRecords() []types1.IncentiveRecord {
+func (m *ExportedTick) GetTickIndex() int64 {
 	if m != nil {
-		return m.IncentiveRecords
+		return m.TickIndex
 	}
-	return nil
+	return 0
 }
 
-func (m *IncentiveRecordsResponse) GetPagination() *query.PageResponse {
+func (m *ExportedTick) GetSpreadRewardGrowthOppositeDirectionOfLastTraversal() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	if m != nil {
-		return m.Pagination
+		return m.SpreadRewardGrowthOppositeDirectionOfLastTraversal
 	}
 	return nil
 }
 
-// =============================== CFMMPoolIdLinkFromConcentratedPoolId
-type CFMMPoolIdLinkFromConcentratedPoolIdRequest struct {
-	ConcentratedPoolId uint64 `protobuf:"varint,1,opt,name=concentrated_pool_id,json=concentratedPoolId,proto3" json:"concentrated_pool_id,omitempty" yaml:"concentrated_pool_id"`
+func (m *ExportedTick) GetUptimeTrackers() []model.UptimeTracker {
+	if m != nil {
+		return m.UptimeTrackers
+	}
+	return nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Reset() {
-	*m = CFMMPoolIdLinkFromConcentratedPoolIdRequest{}
-}
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) String() string {
-	return proto.CompactTextString(m)
+type ExportTicksResponse struct {
+	Ticks      []ExportedTick      `protobuf:"bytes,1,rep,name=ticks,proto3" json:"ticks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*CFMMPoolIdLinkFromConcentratedPoolIdRequest) ProtoMessage() {}
-func (*CFMMPoolIdLinkFromConcentratedPoolIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{26}
+
+func (m *ExportTicksResponse) Reset()         { *m = ExportTicksResponse{} }
+func (m *ExportTicksResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportTicksResponse) ProtoMessage()    {}
+func (*ExportTicksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{30}
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *ExportTicksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExportTicksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CFMMPoolIdLinkFromConcentratedPoolIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExportTicksResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1366,38 +1607,200 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CFMMPoolIdLinkFromConcentratedPoolIdRequest.Merge(m, src)
+func (m *ExportTicksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTicksResponse.Merge(m, src)
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Size() int {
+func (m *ExportTicksResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CFMMPoolIdLinkFromConcentratedPoolIdRequest.DiscardUnknown(m)
+func (m *ExportTicksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTicksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CFMMPoolIdLinkFromConcentratedPoolIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportTicksResponse proto.InternalMessageInfo
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) GetConcentratedPoolId() uint64 {
+func (m *ExportTicksResponse) GetTicks() []ExportedTick {
 	if m != nil {
-		return m.ConcentratedPoolId
+		return m.Ticks
 	}
-	return 0
+	return nil
 }
 
-type CFMMPoolIdLinkFromConcentratedPoolIdResponse struct {
-	CfmmPoolId uint64 `protobuf:"varint,1,opt,name=cfmm_pool_id,json=cfmmPoolId,proto3" json:"cfmm_pool_id,omitempty" yaml:"cfmm_pool_id"`
+func (m *ExportTicksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Reset() {
-	*m = CFMMPoolIdLinkFromConcentratedPoolIdResponse{}
-}
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) String() string {
-	return proto.CompactTextString(m)
+// ===================== QueryIncentiveRecords
+type IncentiveRecordsRequest struct {
+	PoolId     uint64             `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*CFMMPoolIdLinkFromConcentratedPoolIdResponse) ProtoMessage() {}
-func (*CFMMPoolIdLinkFromConcentratedPoolIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{27}
+
+func (m *IncentiveRecordsRequest) Reset()         { *m = IncentiveRecordsRequest{} }
+func (m *IncentiveRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRecordsRequest) ProtoMessage()    {}
+func (*IncentiveRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{31}
+}
+func (m *IncentiveRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRecordsRequest.Merge(m, src)
+}
+func (m *IncentiveRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRecordsRequest proto.InternalMessageInfo
+
+func (m *IncentiveRecordsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *IncentiveRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type IncentiveRecordsResponse struct {
+	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,1,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *IncentiveRecordsResponse) Reset()         { *m = IncentiveRecordsResponse{} }
+func (m *IncentiveRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRecordsResponse) ProtoMessage()    {}
+func (*IncentiveRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{32}
+}
+func (m *IncentiveRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRecordsResponse.Merge(m, src)
+}
+func (m *IncentiveRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRecordsResponse proto.InternalMessageInfo
+
+func (m *IncentiveRecordsResponse) GetIncentiveRecords() []types1.IncentiveRecord {
+	if m != nil {
+		return m.IncentiveRecords
+	}
+	return nil
+}
+
+func (m *IncentiveRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== CFMMPoolIdLinkFromConcentratedPoolId
+type CFMMPoolIdLinkFromConcentratedPoolIdRequest struct {
+	ConcentratedPoolId uint64 `protobuf:"varint,1,opt,name=concentrated_pool_id,json=concentratedPoolId,proto3" json:"concentrated_pool_id,omitempty" yaml:"concentrated_pool_id"`
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Reset() {
+	*m = CFMMPoolIdLinkFromConcentratedPoolIdRequest{}
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CFMMPoolIdLinkFromConcentratedPoolIdRequest) ProtoMessage() {}
+func (*CFMMPoolIdLinkFromConcentratedPoolIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{33}
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CFMMPoolIdLinkFromConcentratedPoolIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CFMMPoolIdLinkFromConcentratedPoolIdRequest.Merge(m, src)
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CFMMPoolIdLinkFromConcentratedPoolIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CFMMPoolIdLinkFromConcentratedPoolIdRequest proto.InternalMessageInfo
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) GetConcentratedPoolId() uint64 {
+	if m != nil {
+		return m.ConcentratedPoolId
+	}
+	return 0
+}
+
+type CFMMPoolIdLinkFromConcentratedPoolIdResponse struct {
+	CfmmPoolId uint64 `protobuf:"varint,1,opt,name=cfmm_pool_id,json=cfmmPoolId,proto3" json:"cfmm_pool_id,omitempty" yaml:"cfmm_pool_id"`
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Reset() {
+	*m = CFMMPoolIdLinkFromConcentratedPoolIdResponse{}
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CFMMPoolIdLinkFromConcentratedPoolIdResponse) ProtoMessage() {}
+func (*CFMMPoolIdLinkFromConcentratedPoolIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
 }
 func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,7 +1845,7 @@ func (m *UserUnbondingPositionsRequest) Reset()         { *m = UserUnbondingPosi
 func (m *UserUnbondingPositionsRequest) String() string { return proto.CompactTextString(m) }
 func (*UserUnbondingPositionsRequest) ProtoMessage()    {}
 func (*UserUnbondingPositionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{28}
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
 }
 func (m *UserUnbondingPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1486,7 +1889,7 @@ func (m *UserUnbondingPositionsResponse) Reset()         { *m = UserUnbondingPos
 func (m *UserUnbondingPositionsResponse) String() string { return proto.CompactTextString(m) }
 func (*UserUnbondingPositionsResponse) ProtoMessage()    {}
 func (*UserUnbondingPositionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{29}
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
 }
 func (m *UserUnbondingPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,7 +1933,7 @@ func (m *GetTotalLiquidityRequest) Reset()         { *m = GetTotalLiquidityReque
 func (m *GetTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTotalLiquidityRequest) ProtoMessage()    {}
 func (*GetTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{30}
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
 }
 func (m *GetTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1567,7 +1970,7 @@ func (m *GetTotalLiquidityResponse) Reset()         { *m = GetTotalLiquidityResp
 func (m *GetTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTotalLiquidityResponse) ProtoMessage()    {}
 func (*GetTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{31}
+	return fileDescriptor_5da291368ba4d8e3, []int{38}
 }
 func (m *GetTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,7 +2017,7 @@ func (m *NumNextInitializedTicksRequest) Reset()         { *m = NumNextInitializ
 func (m *NumNextInitializedTicksRequest) String() string { return proto.CompactTextString(m) }
 func (*NumNextInitializedTicksRequest) ProtoMessage()    {}
 func (*NumNextInitializedTicksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{32}
+	return fileDescriptor_5da291368ba4d8e3, []int{39}
 }
 func (m *NumNextInitializedTicksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1674,7 +2077,7 @@ func (m *NumNextInitializedTicksResponse) Reset()         { *m = NumNextInitiali
 func (m *NumNextInitializedTicksResponse) String() string { return proto.CompactTextString(m) }
 func (*NumNextInitializedTicksResponse) ProtoMessage()    {}
 func (*NumNextInitializedTicksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{33}
+	return fileDescriptor_5da291368ba4d8e3, []int{40}
 }
 func (m *NumNextInitializedTicksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1717,211 +2120,593 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
-	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
-	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
-	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
-	proto.RegisterType((*NumPoolPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsRequest")
-	proto.RegisterType((*NumPoolPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsResponse")
-	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
-	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
-	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
-	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
-	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
-	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
-	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
-	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
-	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
-	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
-	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
-	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
-	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
-	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
-	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
-	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
-	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
-	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
-	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
-	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
-	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
-	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
-	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
-	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+// =============================== StopCondition
+type StopConditionRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/query.proto", fileDescriptor_5da291368ba4d8e3)
+func (m *StopConditionRequest) Reset()         { *m = StopConditionRequest{} }
+func (m *StopConditionRequest) String() string { return proto.CompactTextString(m) }
+func (*StopConditionRequest) ProtoMessage()    {}
+func (*StopConditionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{41}
+}
+func (m *StopConditionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopConditionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopConditionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopConditionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopConditionRequest.Merge(m, src)
+}
+func (m *StopConditionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopConditionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopConditionRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x39, 0xbf, 0xf3, 0xe2, 0x38, 0x49, 0xd9, 0xb1, 0x9d, 0x49, 0x32, 0x93, 0x2d, 0x08,
-	0x6b, 0x91, 0x64, 0x86, 0xfc, 0x6d, 0xc8, 0xdf, 0x26, 0x1e, 0x7b, 0x1d, 0x8d, 0xd6, 0xf1, 0x3a,
-	0x9d, 0x18, 0x10, 0x42, 0xf4, 0xf6, 0x74, 0x97, 0xc7, 0xad, 0xe9, 0xe9, 0x1a, 0x77, 0x57, 0x27,
-	0x31, 0x4b, 0xa4, 0xd5, 0xee, 0x11, 0x09, 0x16, 0x71, 0x45, 0x48, 0x88, 0x0b, 0x5a, 0x71, 0xe4,
-	0x02, 0x17, 0x04, 0x07, 0x14, 0x71, 0x58, 0xad, 0x84, 0x90, 0xd0, 0x1e, 0x66, 0x21, 0xe1, 0x80,
-	0xb4, 0x80, 0x84, 0xb9, 0x70, 0x44, 0x5d, 0x5d, 0xfd, 0x33, 0x33, 0x3d, 0x76, 0xcf, 0x38, 0x9c,
-	0xf6, 0xe4, 0xa9, 0x7e, 0xf5, 0xde, 0xfb, 0xde, 0x4f, 0xbd, 0xae, 0xf7, 0xda, 0x70, 0x81, 0xb9,
-	0x4d, 0xe6, 0x9a, 0x6e, 0x59, 0x67, 0xb6, 0x4e, 0x6d, 0xee, 0x68, 0x9c, 0x1a, 0x96, 0xb9, 0xee,
-	0x99, 0x86, 0xc9, 0x37, 0xca, 0x8f, 0x2e, 0xd4, 0x28, 0xd7, 0x2e, 0x94, 0xd7, 0x3d, 0xea, 0x6c,
-	0x94, 0x5a, 0x0e, 0xe3, 0x0c, 0x9f, 0x91, 0x2c, 0xa5, 0x54, 0x96, 0x92, 0x64, 0xc9, 0x4f, 0xd4,
-	0x59, 0x9d, 0x09, 0x8e, 0xb2, 0xff, 0x2b, 0x60, 0xce, 0x7f, 0x79, 0x6b, 0x7d, 0x2d, 0xcd, 0xd1,
-	0x9a, 0xae, 0xdc, 0x7b, 0x25, 0x1b, 0x36, 0x6e, 0xea, 0x0d, 0xd5, 0xb4, 0x57, 0x43, 0x15, 0x05,
-	0x5d, 0xf0, 0x95, 0x6b, 0x9a, 0x4b, 0xa3, 0x4d, 0x3a, 0x33, 0xed, 0x10, 0x42, 0x92, 0x2e, 0x0c,
-	0x8b, 0x76, 0xb5, 0xb4, 0xba, 0x69, 0x6b, 0xdc, 0x64, 0xe1, 0xde, 0x93, 0x75, 0xc6, 0xea, 0x16,
-	0x2d, 0x6b, 0x2d, 0xb3, 0xac, 0xd9, 0x36, 0xe3, 0x82, 0x18, 0x02, 0x3c, 0x2e, 0xa9, 0x62, 0x55,
-	0xf3, 0x56, 0xcb, 0x9a, 0xbd, 0x11, 0x92, 0x02, 0x25, 0x6a, 0xe0, 0x80, 0x60, 0x21, 0x49, 0x97,
-	0xb3, 0x99, 0xd5, 0x62, 0xae, 0x99, 0x40, 0x72, 0x33, 0x1b, 0x97, 0x29, 0x88, 0xe6, 0x23, 0xaa,
-	0x3a, 0x54, 0x67, 0x8e, 0x11, 0x70, 0x93, 0x5f, 0x21, 0x98, 0x58, 0x71, 0xa9, 0xb3, 0x2c, 0x85,
-	0xba, 0x0a, 0x5d, 0xf7, 0xa8, 0xcb, 0xf1, 0x39, 0xd8, 0xaf, 0x19, 0x86, 0x43, 0x5d, 0x77, 0x1a,
-	0x9d, 0x46, 0x33, 0xb9, 0x0a, 0xde, 0x6c, 0x17, 0xc7, 0x36, 0xb4, 0xa6, 0x75, 0x9d, 0x48, 0x02,
-	0x51, 0xc2, 0x2d, 0xf8, 0x2c, 0xec, 0x6f, 0x31, 0x66, 0xa9, 0xa6, 0x31, 0x3d, 0x72, 0x1a, 0xcd,
-	0xec, 0x49, 0xee, 0x96, 0x04, 0xa2, 0xec, 0xf3, 0x7f, 0x55, 0x0d, 0xbc, 0x00, 0x10, 0xfb, 0x73,
-	0x7a, 0xf7, 0x69, 0x34, 0x73, 0xf0, 0xe2, 0x97, 0x4a, 0xd2, 0x15, 0xbe, 0xf3, 0x4b, 0x41, 0x56,
-	0x49, 0xe8, 0xa5, 0x65, 0xad, 0x4e, 0x25, 0x2c, 0x25, 0xc1, 0x49, 0x7e, 0x87, 0xe0, 0x58, 0x17,
-	0x76, 0xb7, 0xc5, 0x6c, 0x97, 0xe2, 0xb7, 0x21, 0x17, 0x7a, 0xc9, 0x87, 0xbf, 0x7b, 0xe6, 0xe0,
-	0xc5, 0x9b, 0xa5, 0x4c, 0xd9, 0x59, 0x5a, 0xf0, 0x2c, 0x2b, 0x14, 0x58, 0x71, 0xa8, 0xd6, 0x30,
-	0xd8, 0x63, 0xbb, 0xb2, 0xe7, 0x59, 0xbb, 0xb8, 0x4b, 0x89, 0x85, 0xe2, 0xbb, 0x1d, 0x36, 0x8c,
-	0x08, 0x1b, 0x5e, 0xdd, 0xd6, 0x86, 0x00, 0x5e, 0x87, 0x11, 0x4b, 0x30, 0x1e, 0xa9, 0xdb, 0xa8,
-	0x1a, 0xa1, 0xfb, 0xaf, 0xc2, 0xc1, 0x50, 0x99, 0xef, 0x54, 0x24, 0x9c, 0x3a, 0xb9, 0xd9, 0x2e,
-	0xe2, 0xd0, 0xa9, 0x11, 0x91, 0x28, 0x10, 0xae, 0xaa, 0x06, 0x79, 0x04, 0x13, 0x9d, 0xf2, 0xa4,
-	0x4b, 0xbe, 0x0d, 0x07, 0xc2, 0x5d, 0x42, 0xda, 0xcb, 0xf1, 0x48, 0x24, 0x93, 0x2c, 0xc0, 0xd4,
-	0x92, 0xd7, 0x5c, 0x66, 0xcc, 0xea, 0x49, 0xa5, 0x44, 0x72, 0xa0, 0xed, 0x92, 0x83, 0x7c, 0x0b,
-	0xa6, 0x7b, 0xe5, 0x48, 0x1b, 0xee, 0xc0, 0x58, 0x64, 0xb7, 0xce, 0x3c, 0x9b, 0x4b, 0x79, 0xc7,
-	0x37, 0xdb, 0xc5, 0x63, 0x5d, 0x7e, 0x11, 0x74, 0xa2, 0x1c, 0x0a, 0x1f, 0xcc, 0x89, 0xf5, 0xd7,
-	0x60, 0xd4, 0x17, 0x1d, 0x41, 0x5b, 0x48, 0x09, 0xe3, 0x30, 0xa9, 0xf8, 0x03, 0x04, 0x87, 0xa4,
-	0x60, 0x89, 0xf5, 0x0a, 0xec, 0xf5, 0x2d, 0x0a, 0xd3, 0x6f, 0xa2, 0x14, 0x94, 0x84, 0x52, 0x58,
-	0x12, 0x4a, 0xb3, 0xf6, 0x46, 0x25, 0xf7, 0x87, 0x5f, 0x9e, 0xdf, 0xeb, 0xf3, 0x55, 0x95, 0x60,
-	0xf7, 0xcb, 0xcb, 0xab, 0xc3, 0x70, 0x68, 0x59, 0xd4, 0x4c, 0x09, 0x97, 0xac, 0xc0, 0x58, 0xf8,
-	0x40, 0x42, 0x9c, 0x83, 0x7d, 0x41, 0x59, 0x95, 0x09, 0x71, 0x66, 0x9b, 0x84, 0x08, 0xd8, 0x65,
-	0xe4, 0x25, 0x2b, 0xf9, 0x10, 0xc1, 0x91, 0x87, 0xa6, 0xde, 0x58, 0x0c, 0xb7, 0x2d, 0x51, 0x8e,
-	0xdf, 0x86, 0x43, 0x11, 0x9b, 0x6a, 0x53, 0x2e, 0x4b, 0xc8, 0x0d, 0x9f, 0xf3, 0x93, 0x76, 0xf1,
-	0x44, 0x60, 0x8f, 0x6b, 0x34, 0x4a, 0x26, 0x2b, 0x37, 0x35, 0xbe, 0x56, 0x5a, 0xa4, 0x75, 0x4d,
-	0xdf, 0x98, 0xa7, 0xfa, 0x66, 0xbb, 0x38, 0x11, 0x84, 0xb2, 0x43, 0x02, 0x51, 0x46, 0xad, 0xa4,
-	0x86, 0xcb, 0x00, 0xb2, 0xbc, 0x1b, 0xf4, 0x89, 0xf0, 0xd3, 0xee, 0xca, 0xb1, 0xcd, 0x76, 0xf1,
-	0x68, 0xc0, 0x1b, 0xd3, 0x88, 0x92, 0xf3, 0x17, 0x55, 0xf1, 0xfb, 0x9f, 0x08, 0xa6, 0x22, 0xa0,
-	0xf3, 0xb4, 0xc5, 0xd7, 0xbe, 0x6e, 0xf2, 0x35, 0x45, 0xb3, 0xeb, 0x14, 0xaf, 0xc2, 0x91, 0x58,
-	0xa3, 0xd6, 0x8c, 0xd2, 0x6b, 0x87, 0xb0, 0x0f, 0x47, 0xeb, 0x59, 0x21, 0xd3, 0x47, 0x6e, 0xb1,
-	0xc7, 0xd4, 0x51, 0x7d, 0x58, 0xbd, 0xc8, 0x63, 0x1a, 0x51, 0x72, 0x62, 0xe1, 0x7b, 0xd7, 0xe7,
-	0xf2, 0x5a, 0xad, 0x90, 0x6b, 0x77, 0x37, 0x57, 0x4c, 0x23, 0x4a, 0x4e, 0x2c, 0x7c, 0x2e, 0xf2,
-	0xe9, 0x08, 0x14, 0x92, 0x81, 0xa9, 0xda, 0xf3, 0xa6, 0x43, 0x75, 0x3f, 0x41, 0x86, 0x39, 0x9c,
-	0xb8, 0x04, 0x07, 0x38, 0x6b, 0x50, 0x5b, 0x35, 0x83, 0xdc, 0xcc, 0x55, 0xc6, 0x37, 0xdb, 0xc5,
-	0xc3, 0xd2, 0xe7, 0x92, 0x42, 0x94, 0xfd, 0xe2, 0x67, 0xd5, 0xf6, 0x51, 0xbb, 0x5c, 0x73, 0x78,
-	0x1f, 0xd4, 0x31, 0x8d, 0x28, 0x39, 0xb1, 0x10, 0xb6, 0x5e, 0x83, 0x51, 0xcf, 0xa5, 0xaa, 0xee,
-	0x49, 0x6b, 0xf7, 0x9c, 0x46, 0x33, 0x07, 0x2a, 0x53, 0x9b, 0xed, 0xe2, 0xb8, 0xb4, 0x36, 0x41,
-	0x25, 0x0a, 0x78, 0x2e, 0x9d, 0xf3, 0x22, 0x37, 0xd5, 0x98, 0x67, 0x1b, 0x01, 0xe3, 0xde, 0x6e,
-	0x85, 0x31, 0x8d, 0x28, 0x39, 0xb1, 0x48, 0x2a, 0xb4, 0x99, 0x2a, 0x9e, 0x4d, 0xef, 0x4b, 0x53,
-	0x18, 0x52, 0x03, 0x85, 0x4b, 0xac, 0x22, 0x16, 0x3f, 0xdd, 0x0d, 0xc5, 0xbe, 0x1e, 0x96, 0xe7,
-	0x6c, 0x2d, 0x99, 0x59, 0x86, 0x9f, 0x75, 0x61, 0x55, 0xb8, 0x9a, 0xb1, 0x04, 0x77, 0x1f, 0x30,
-	0x79, 0x06, 0xe3, 0xdc, 0x12, 0xb9, 0xec, 0xe2, 0x57, 0x60, 0x54, 0xf7, 0x1c, 0x87, 0xda, 0x3c,
-	0x91, 0x5d, 0xca, 0x41, 0xf9, 0x4c, 0xd8, 0x6a, 0xc1, 0xd1, 0x70, 0x4b, 0xc4, 0x2d, 0x22, 0x93,
-	0xab, 0xdc, 0xce, 0x96, 0xe7, 0xd3, 0x81, 0x4f, 0x7a, 0xa4, 0x10, 0xe5, 0x88, 0x7c, 0x16, 0x41,
-	0xc5, 0xef, 0x21, 0xc0, 0xe1, 0x46, 0x77, 0xdd, 0xe1, 0x6a, 0xcb, 0x31, 0x75, 0x2a, 0x22, 0x9a,
-	0xab, 0x3c, 0x94, 0xfa, 0xca, 0x75, 0x93, 0xaf, 0x79, 0xb5, 0x92, 0xce, 0x9a, 0x65, 0xe9, 0x8f,
-	0xf3, 0x96, 0x56, 0x73, 0xc3, 0x85, 0xf8, 0x2b, 0x60, 0x54, 0xcc, 0x7a, 0x80, 0xe1, 0x78, 0x27,
-	0x86, 0x58, 0x74, 0x0c, 0xe2, 0xc1, 0xba, 0xc3, 0x97, 0xc5, 0xa3, 0x37, 0xe1, 0x64, 0x84, 0x68,
-	0x39, 0x38, 0x19, 0xe2, 0xc8, 0x0f, 0xf5, 0x7e, 0xfa, 0x0d, 0x82, 0x53, 0x7d, 0xa4, 0xc9, 0x70,
-	0xd7, 0x20, 0x17, 0x7b, 0x36, 0x88, 0xf3, 0xeb, 0x19, 0xe3, 0xdc, 0xa7, 0x36, 0x85, 0xd7, 0x8f,
-	0x88, 0x01, 0x5f, 0x87, 0xd1, 0x9a, 0xa7, 0x37, 0x28, 0xef, 0x28, 0x80, 0x89, 0x8c, 0x4d, 0x52,
-	0x89, 0x72, 0x30, 0x58, 0x06, 0x45, 0xf0, 0x1b, 0x70, 0x6a, 0xce, 0xd2, 0xcc, 0xa6, 0x56, 0xb3,
-	0xe8, 0x83, 0x96, 0x43, 0x35, 0x43, 0xa1, 0x8f, 0x35, 0xc7, 0x70, 0x77, 0x7c, 0xf7, 0xf8, 0x09,
-	0x82, 0x42, 0x3f, 0xd1, 0xd2, 0x39, 0xdf, 0x85, 0x69, 0x3d, 0xdc, 0xa1, 0xba, 0x62, 0x8b, 0xea,
-	0x04, 0x7b, 0xa4, 0xaf, 0x8e, 0x77, 0xbc, 0xed, 0x42, 0xcf, 0xcc, 0x31, 0xd3, 0xae, 0xbc, 0xea,
-	0xbb, 0x61, 0xb3, 0x5d, 0x2c, 0xca, 0xe8, 0xf7, 0x11, 0x44, 0x94, 0x49, 0x3d, 0x15, 0x05, 0x59,
-	0x81, 0x7c, 0x84, 0xaf, 0x1a, 0x5e, 0x88, 0x77, 0x6e, 0xf7, 0xfb, 0x23, 0x70, 0x22, 0x55, 0xae,
-	0x34, 0x7a, 0x1d, 0x26, 0x62, 0xac, 0xd1, 0x45, 0x3c, 0x83, 0xc1, 0x5f, 0x90, 0x06, 0x9f, 0xe8,
-	0x36, 0x38, 0x16, 0x42, 0x94, 0x71, 0xbd, 0x57, 0xb5, 0xaf, 0x72, 0x95, 0x39, 0xab, 0xd4, 0xe4,
-	0xd4, 0x48, 0xaa, 0x1c, 0x19, 0x50, 0x65, 0x9a, 0x10, 0xa2, 0x8c, 0x47, 0x8f, 0x63, 0x95, 0x64,
-	0x11, 0x4e, 0xf9, 0x57, 0x99, 0x59, 0x5d, 0xf7, 0x9a, 0x9e, 0xa5, 0x71, 0xe6, 0x74, 0xe5, 0xd5,
-	0x40, 0xe7, 0xec, 0xb7, 0x23, 0x50, 0xe8, 0x27, 0x4e, 0xba, 0xf5, 0x03, 0x04, 0x27, 0x3a, 0x22,
-	0xaf, 0xd6, 0x1d, 0xf6, 0x98, 0xaf, 0xa9, 0x75, 0x8b, 0xd5, 0x34, 0x4b, 0xba, 0xf7, 0x64, 0xaa,
-	0xad, 0xf3, 0x54, 0x17, 0xe6, 0x5e, 0xf2, 0xcd, 0xfd, 0xf0, 0xd3, 0xe2, 0xd9, 0x44, 0x0d, 0x92,
-	0x6d, 0x60, 0xf0, 0xe7, 0xbc, 0x6b, 0x34, 0xca, 0x7c, 0xa3, 0x45, 0xdd, 0x90, 0xc7, 0x55, 0xa6,
-	0xdd, 0x44, 0x56, 0xdd, 0x15, 0x3a, 0xef, 0x0a, 0x95, 0xf8, 0x7b, 0x08, 0x26, 0xbc, 0x16, 0x37,
-	0x9b, 0xb4, 0x0b, 0x4b, 0xe0, 0xf7, 0xcb, 0x19, 0xeb, 0xc0, 0x8a, 0x10, 0xf1, 0xd0, 0xd1, 0xf4,
-	0x06, 0x75, 0xba, 0x43, 0x92, 0x26, 0x9f, 0x28, 0x38, 0x78, 0x9c, 0x44, 0x43, 0xde, 0x47, 0x50,
-	0xf0, 0xeb, 0x53, 0xc2, 0x87, 0x52, 0xe6, 0x50, 0x31, 0x19, 0xf2, 0xd2, 0xf5, 0xd9, 0x08, 0x14,
-	0xfb, 0xa2, 0x90, 0xa1, 0x7c, 0x86, 0xe0, 0x5a, 0x6a, 0x28, 0x59, 0x4b, 0x9c, 0x33, 0xaa, 0x1a,
-	0xe1, 0x6b, 0x55, 0x65, 0xab, 0xaa, 0xa5, 0xb9, 0x5c, 0xe5, 0x8e, 0xf6, 0x88, 0x3a, 0xee, 0xff,
-	0x33, 0xd0, 0x17, 0x7b, 0x03, 0xfd, 0x96, 0x04, 0x14, 0xbd, 0xe6, 0xdf, 0x5a, 0x5d, 0xd4, 0x5c,
-	0xfe, 0x30, 0x04, 0x83, 0x9f, 0xc2, 0x61, 0x19, 0x21, 0x2e, 0xad, 0xdc, 0x51, 0xf0, 0x0b, 0x32,
-	0xf8, 0x93, 0x1d, 0xc1, 0x0f, 0x45, 0x13, 0x65, 0xcc, 0x4b, 0x6e, 0x77, 0xc9, 0xf7, 0x11, 0x4c,
-	0x45, 0x87, 0x52, 0x11, 0xad, 0xfe, 0x70, 0xc1, 0x7e, 0x59, 0xad, 0xd1, 0x47, 0x08, 0xa6, 0x7b,
-	0x01, 0xc9, 0xb8, 0x9b, 0x70, 0xb4, 0x7b, 0x30, 0x11, 0x96, 0xc5, 0xd7, 0x32, 0xba, 0xab, 0x4b,
-	0xb6, 0x7c, 0x57, 0x1e, 0x31, 0xbb, 0x54, 0xbe, 0xbc, 0xce, 0xea, 0x5d, 0x04, 0x67, 0xe7, 0x16,
-	0xee, 0xdd, 0x13, 0x7d, 0x9b, 0xb1, 0x68, 0xda, 0x8d, 0x05, 0x87, 0x35, 0xe7, 0x12, 0x20, 0x03,
-	0x4a, 0xe8, 0xf5, 0xfb, 0x30, 0x91, 0xb4, 0x40, 0xed, 0x0c, 0x41, 0x31, 0x51, 0xde, 0x53, 0x76,
-	0x11, 0x05, 0xeb, 0x3d, 0x92, 0x89, 0x09, 0xe7, 0xb2, 0x21, 0x90, 0x6e, 0xbe, 0x06, 0xa3, 0xfa,
-	0x6a, 0xb3, 0xd9, 0xa5, 0x3a, 0x71, 0x5d, 0x48, 0x52, 0x89, 0x02, 0xfe, 0x52, 0xaa, 0xba, 0x07,
-	0xa7, 0x56, 0x5c, 0xea, 0xac, 0xd8, 0x35, 0x66, 0x1b, 0xa6, 0x5d, 0xdf, 0xd9, 0xa0, 0x88, 0xfc,
-	0x0c, 0x41, 0xa1, 0x9f, 0x3c, 0x09, 0xf6, 0x5d, 0x04, 0xf9, 0x68, 0xd0, 0xa2, 0x3e, 0x36, 0xf9,
-	0x9a, 0xda, 0xa2, 0x8e, 0xc9, 0x0c, 0xd5, 0x62, 0x7a, 0x43, 0x66, 0xc7, 0xad, 0x8c, 0xd9, 0x11,
-	0x8a, 0xf7, 0xef, 0x52, 0xcb, 0x42, 0xca, 0x22, 0xd3, 0x1b, 0x32, 0x49, 0xa6, 0x22, 0x35, 0x9d,
-	0x64, 0x92, 0x87, 0xe9, 0xbb, 0x94, 0x3f, 0x64, 0x5c, 0xb3, 0xa2, 0x2b, 0x59, 0xd8, 0x47, 0xff,
-	0x10, 0xc1, 0xf1, 0x14, 0xa2, 0x04, 0xcf, 0xe1, 0x30, 0xf7, 0x29, 0x6a, 0xf7, 0x15, 0x70, 0x8b,
-	0x57, 0xee, 0x57, 0x64, 0x69, 0x9a, 0xc9, 0x50, 0x9a, 0x82, 0xba, 0x34, 0xc6, 0x3b, 0xb4, 0x93,
-	0x4d, 0x04, 0x85, 0x25, 0xaf, 0xb9, 0x44, 0x9f, 0xf0, 0xaa, 0x6d, 0x72, 0x53, 0xb3, 0xcc, 0xef,
-	0x50, 0xd1, 0xdb, 0x0c, 0x77, 0xf6, 0x6f, 0xc3, 0x58, 0xd8, 0xcd, 0xa9, 0x06, 0xb5, 0x59, 0x53,
-	0x76, 0x7b, 0x89, 0x41, 0x4b, 0x27, 0x9d, 0x28, 0xa3, 0xb2, 0xe7, 0x9b, 0xf7, 0x97, 0xb8, 0x06,
-	0x79, 0xdb, 0x6b, 0xaa, 0x36, 0x7d, 0xe2, 0xdf, 0x41, 0x23, 0x44, 0xa2, 0x2b, 0x71, 0x45, 0xbb,
-	0xb1, 0xa7, 0x72, 0x66, 0xb3, 0x5d, 0x7c, 0x25, 0x10, 0xd6, 0x7f, 0x2f, 0x51, 0xa6, 0xec, 0x74,
-	0xc3, 0xc8, 0x8f, 0x47, 0xa0, 0xd8, 0xd7, 0xe8, 0xcf, 0x7d, 0xeb, 0x75, 0xf1, 0xdf, 0x79, 0xd8,
-	0x7b, 0xdf, 0xaf, 0x68, 0xf8, 0xe7, 0x08, 0xc4, 0x90, 0xc9, 0xc5, 0x97, 0x32, 0x9f, 0x9a, 0x78,
-	0x46, 0x96, 0xbf, 0x3c, 0x18, 0x53, 0xe0, 0x79, 0x72, 0xf9, 0xbd, 0x3f, 0xfe, 0xed, 0x47, 0x23,
-	0x25, 0x7c, 0xae, 0x9c, 0x75, 0xaa, 0xed, 0x03, 0xfc, 0x05, 0x82, 0x7d, 0xc1, 0x98, 0x09, 0x67,
-	0x56, 0x9b, 0x9c, 0x72, 0xe5, 0xaf, 0x0c, 0xc8, 0x25, 0xd1, 0x5e, 0x11, 0x68, 0xcb, 0xf8, 0x7c,
-	0x56, 0xb4, 0x01, 0xc6, 0x8f, 0x10, 0x1c, 0xea, 0x98, 0x40, 0xe3, 0x1b, 0x59, 0x5f, 0xf2, 0x29,
-	0x33, 0xf7, 0xfc, 0xcd, 0xe1, 0x98, 0xa5, 0x0d, 0x15, 0x61, 0xc3, 0x4d, 0x7c, 0xbd, 0x3c, 0xd8,
-	0x77, 0x04, 0xb7, 0xfc, 0x8e, 0xac, 0xce, 0x4f, 0xf1, 0x67, 0x08, 0x8e, 0xa5, 0x76, 0xb7, 0x78,
-	0x6e, 0xd0, 0x16, 0x36, 0xa5, 0xd3, 0xce, 0xcf, 0xef, 0x4c, 0x88, 0x34, 0xf4, 0xae, 0x30, 0x74,
-	0x16, 0xdf, 0xce, 0x68, 0x68, 0x5c, 0x01, 0xc2, 0x21, 0x99, 0xea, 0x08, 0x9b, 0xfe, 0x93, 0x1c,
-	0x07, 0x76, 0x0e, 0x6f, 0xf0, 0x1b, 0x83, 0x42, 0x4d, 0x1d, 0xaf, 0xe5, 0x17, 0x76, 0x2a, 0x46,
-	0xda, 0x5c, 0x15, 0x36, 0xcf, 0xe1, 0xd9, 0x81, 0x6d, 0xb6, 0xc5, 0x18, 0x20, 0xbe, 0x3f, 0xe3,
-	0x7f, 0x21, 0x98, 0x4c, 0xef, 0xd2, 0x71, 0xd6, 0xf8, 0x6c, 0x39, 0x3f, 0xc8, 0xbf, 0xb1, 0x43,
-	0x29, 0x43, 0x86, 0xb9, 0xdf, 0x38, 0x00, 0xff, 0x15, 0xc1, 0x78, 0x4a, 0x7b, 0x8e, 0x67, 0x07,
-	0xc5, 0xd9, 0x33, 0x32, 0xc8, 0x57, 0x76, 0x22, 0x42, 0xda, 0x39, 0x27, 0xec, 0xbc, 0x85, 0x6f,
-	0x0c, 0x6c, 0x67, 0xdc, 0x92, 0xe3, 0xdf, 0x23, 0x18, 0x4d, 0x7e, 0xf7, 0xc1, 0xd7, 0x07, 0xbc,
-	0x20, 0x25, 0x3e, 0x3e, 0xe5, 0x6f, 0x0c, 0xc5, 0x2b, 0xcd, 0xb9, 0x25, 0xcc, 0xb9, 0x8a, 0xaf,
-	0x0c, 0x58, 0x86, 0xd4, 0xda, 0x86, 0x6a, 0x1a, 0xf8, 0xef, 0x08, 0x26, 0xd3, 0xfb, 0xfe, 0xcc,
-	0xd9, 0xb9, 0xe5, 0x14, 0x22, 0x73, 0x76, 0x6e, 0x3d, 0x7c, 0x20, 0xb3, 0xc2, 0xcc, 0x1b, 0xf8,
-	0xda, 0x00, 0xef, 0x37, 0x55, 0xf3, 0xe5, 0x45, 0x79, 0xf9, 0x27, 0x04, 0x47, 0xba, 0x3b, 0x23,
-	0xfc, 0xfa, 0x70, 0x6d, 0x4f, 0x64, 0xde, 0xed, 0xa1, 0xf9, 0xa5, 0x61, 0x77, 0x84, 0x61, 0xd7,
-	0xf1, 0x57, 0xcb, 0xc3, 0x7d, 0x58, 0x76, 0xf1, 0x3f, 0x10, 0x4c, 0xf5, 0x69, 0xf8, 0x33, 0x97,
-	0xd5, 0xad, 0xc7, 0x16, 0x99, 0xcb, 0xea, 0x36, 0x73, 0x87, 0x81, 0xdf, 0x99, 0xe2, 0xe5, 0x11,
-	0x44, 0x31, 0x6c, 0xc1, 0xf1, 0xaf, 0x47, 0xe0, 0x8b, 0x59, 0xba, 0x31, 0xac, 0x64, 0x2d, 0x16,
-	0xd9, 0x9b, 0xcb, 0xfc, 0x83, 0x97, 0x2a, 0x53, 0x7a, 0xc5, 0x14, 0x5e, 0xd1, 0xb1, 0x96, 0xb5,
-	0x22, 0x25, 0xba, 0x47, 0xd5, 0x32, 0xed, 0x86, 0xba, 0xea, 0xb0, 0xa6, 0x9a, 0x64, 0x2a, 0xbf,
-	0x93, 0xd6, 0xdd, 0x3e, 0xc5, 0xff, 0x45, 0x30, 0x99, 0xde, 0x0f, 0x66, 0x3e, 0xee, 0x5b, 0xb6,
-	0xa7, 0x99, 0x8f, 0xfb, 0xd6, 0x4d, 0x29, 0xb9, 0x2f, 0x5c, 0xf2, 0x26, 0xae, 0x66, 0x74, 0x89,
-	0xe7, 0x52, 0x47, 0xf5, 0x42, 0x79, 0x6a, 0xda, 0x5d, 0xeb, 0x13, 0x04, 0x47, 0x7b, 0x1a, 0x49,
-	0x9c, 0xf5, 0xfc, 0xf6, 0xeb, 0x4f, 0xf3, 0x77, 0x86, 0x17, 0x30, 0xe4, 0xa1, 0xa8, 0x53, 0xae,
-	0x76, 0x35, 0xbd, 0xe2, 0x6a, 0xd5, 0xa7, 0x39, 0xcb, 0x5c, 0x03, 0xb6, 0xee, 0x68, 0x33, 0xd7,
-	0x80, 0x6d, 0x7a, 0xc4, 0x81, 0xaf, 0x56, 0xfd, 0x9b, 0xd5, 0xca, 0xda, 0xb3, 0xe7, 0x05, 0xf4,
-	0xf1, 0xf3, 0x02, 0xfa, 0xcb, 0xf3, 0x02, 0xfa, 0xe0, 0x45, 0x61, 0xd7, 0xc7, 0x2f, 0x0a, 0xbb,
-	0xfe, 0xfc, 0xa2, 0xb0, 0xeb, 0x9b, 0x4b, 0xdb, 0x7d, 0xe3, 0x7a, 0x74, 0xf1, 0xb5, 0xf2, 0x93,
-	0x0e, 0xcd, 0xe7, 0x63, 0xd5, 0xba, 0x65, 0x52, 0x9b, 0x07, 0xff, 0x93, 0x14, 0xfc, 0x03, 0xc1,
-	0x3e, 0xf1, 0xe7, 0xd2, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x78, 0x8a, 0x7f, 0x31, 0xa7, 0x25,
-	0x00, 0x00,
+var xxx_messageInfo_StopConditionRequest proto.InternalMessageInfo
+
+func (m *StopConditionRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+type StopConditionResponse struct {
+	StopCondition types1.StopCondition `protobuf:"bytes,1,opt,name=stop_condition,json=stopCondition,proto3" json:"stop_condition"`
+	// met is true if the stop condition can currently be executed.
+	Met bool `protobuf:"varint,2,opt,name=met,proto3" json:"met,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *StopConditionResponse) Reset()         { *m = StopConditionResponse{} }
+func (m *StopConditionResponse) String() string { return proto.CompactTextString(m) }
+func (*StopConditionResponse) ProtoMessage()    {}
+func (*StopConditionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{42}
+}
+func (m *StopConditionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopConditionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopConditionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopConditionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopConditionResponse.Merge(m, src)
+}
+func (m *StopConditionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopConditionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopConditionResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
+var xxx_messageInfo_StopConditionResponse proto.InternalMessageInfo
+
+func (m *StopConditionResponse) GetStopCondition() types1.StopCondition {
+	if m != nil {
+		return m.StopCondition
+	}
+	return types1.StopCondition{}
+}
+
+func (m *StopConditionResponse) GetMet() bool {
+	if m != nil {
+		return m.Met
+	}
+	return false
+}
+
+// =============================== StopConditionsByPool
+type StopConditionsByPoolRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *StopConditionsByPoolRequest) Reset()         { *m = StopConditionsByPoolRequest{} }
+func (m *StopConditionsByPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*StopConditionsByPoolRequest) ProtoMessage()    {}
+func (*StopConditionsByPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{43}
+}
+func (m *StopConditionsByPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopConditionsByPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopConditionsByPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopConditionsByPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopConditionsByPoolRequest.Merge(m, src)
+}
+func (m *StopConditionsByPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopConditionsByPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopConditionsByPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopConditionsByPoolRequest proto.InternalMessageInfo
+
+func (m *StopConditionsByPoolRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type StopConditionsByPoolResponse struct {
+	StopConditions []types1.StopCondition `protobuf:"bytes,1,rep,name=stop_conditions,json=stopConditions,proto3" json:"stop_conditions"`
+}
+
+func (m *StopConditionsByPoolResponse) Reset()         { *m = StopConditionsByPoolResponse{} }
+func (m *StopConditionsByPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*StopConditionsByPoolResponse) ProtoMessage()    {}
+func (*StopConditionsByPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{44}
+}
+func (m *StopConditionsByPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopConditionsByPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopConditionsByPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopConditionsByPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopConditionsByPoolResponse.Merge(m, src)
+}
+func (m *StopConditionsByPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopConditionsByPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopConditionsByPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopConditionsByPoolResponse proto.InternalMessageInfo
+
+func (m *StopConditionsByPoolResponse) GetStopConditions() []types1.StopCondition {
+	if m != nil {
+		return m.StopConditions
+	}
+	return nil
+}
+
+// =============================== SpreadRewardGrowthSaturatedPools
+type SpreadRewardGrowthSaturatedPoolsRequest struct {
+	// pool_id restricts the response to the given pool if non-zero.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) Reset() {
+	*m = SpreadRewardGrowthSaturatedPoolsRequest{}
+}
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*SpreadRewardGrowthSaturatedPoolsRequest) ProtoMessage()    {}
+func (*SpreadRewardGrowthSaturatedPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{45}
+}
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpreadRewardGrowthSaturatedPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpreadRewardGrowthSaturatedPoolsRequest.Merge(m, src)
+}
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpreadRewardGrowthSaturatedPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpreadRewardGrowthSaturatedPoolsRequest proto.InternalMessageInfo
+
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type SpreadRewardGrowthSaturatedPool struct {
+	PoolId uint64   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+}
+
+func (m *SpreadRewardGrowthSaturatedPool) Reset()         { *m = SpreadRewardGrowthSaturatedPool{} }
+func (m *SpreadRewardGrowthSaturatedPool) String() string { return proto.CompactTextString(m) }
+func (*SpreadRewardGrowthSaturatedPool) ProtoMessage()    {}
+func (*SpreadRewardGrowthSaturatedPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{46}
+}
+func (m *SpreadRewardGrowthSaturatedPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpreadRewardGrowthSaturatedPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpreadRewardGrowthSaturatedPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpreadRewardGrowthSaturatedPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpreadRewardGrowthSaturatedPool.Merge(m, src)
+}
+func (m *SpreadRewardGrowthSaturatedPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpreadRewardGrowthSaturatedPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpreadRewardGrowthSaturatedPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpreadRewardGrowthSaturatedPool proto.InternalMessageInfo
+
+func (m *SpreadRewardGrowthSaturatedPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SpreadRewardGrowthSaturatedPool) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+type SpreadRewardGrowthSaturatedPoolsResponse struct {
+	Pools []SpreadRewardGrowthSaturatedPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) Reset() {
+	*m = SpreadRewardGrowthSaturatedPoolsResponse{}
+}
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*SpreadRewardGrowthSaturatedPoolsResponse) ProtoMessage()    {}
+func (*SpreadRewardGrowthSaturatedPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{47}
+}
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpreadRewardGrowthSaturatedPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpreadRewardGrowthSaturatedPoolsResponse.Merge(m, src)
+}
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpreadRewardGrowthSaturatedPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpreadRewardGrowthSaturatedPoolsResponse proto.InternalMessageInfo
+
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) GetPools() []SpreadRewardGrowthSaturatedPool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
+	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
+	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
+	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
+	proto.RegisterType((*NumPoolPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsRequest")
+	proto.RegisterType((*NumPoolPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsResponse")
+	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
+	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
+	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
+	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
+	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
+	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
+	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
+	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
+	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
+	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
+	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
+	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
+	proto.RegisterType((*PendingSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PendingSpreadRewardsRequest")
+	proto.RegisterType((*PendingSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PendingSpreadRewardsResponse")
+	proto.RegisterType((*PendingIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PendingIncentivesRequest")
+	proto.RegisterType((*PendingIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PendingIncentivesResponse")
+	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
+	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
+	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
+	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
+	proto.RegisterType((*ExportTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ExportTicksRequest")
+	proto.RegisterType((*ExportedTick)(nil), "osmosis.concentratedliquidity.v1beta1.ExportedTick")
+	proto.RegisterType((*ExportTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ExportTicksResponse")
+	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
+	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
+	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
+	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
+	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
+	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
+	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
+	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*StopConditionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.StopConditionRequest")
+	proto.RegisterType((*StopConditionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.StopConditionResponse")
+	proto.RegisterType((*StopConditionsByPoolRequest)(nil), "osmosis.concentratedliquidity.v1beta1.StopConditionsByPoolRequest")
+	proto.RegisterType((*StopConditionsByPoolResponse)(nil), "osmosis.concentratedliquidity.v1beta1.StopConditionsByPoolResponse")
+	proto.RegisterType((*SpreadRewardGrowthSaturatedPoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.SpreadRewardGrowthSaturatedPoolsRequest")
+	proto.RegisterType((*SpreadRewardGrowthSaturatedPool)(nil), "osmosis.concentratedliquidity.v1beta1.SpreadRewardGrowthSaturatedPool")
+	proto.RegisterType((*SpreadRewardGrowthSaturatedPoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.SpreadRewardGrowthSaturatedPoolsResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/query.proto", fileDescriptor_5da291368ba4d8e3)
+}
+
+var fileDescriptor_5da291368ba4d8e3 = []byte{
+	// 2926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
+	0xf5, 0xcf, 0xb5, 0xe3, 0x34, 0x7b, 0xe2, 0xcf, 0x6b, 0xc7, 0x1f, 0x9b, 0x64, 0x37, 0xbd, 0xff,
+	0x7f, 0x5a, 0x43, 0x9b, 0x5d, 0x9a, 0x8f, 0x96, 0x38, 0x49, 0x13, 0xef, 0x26, 0x8e, 0x4c, 0x13,
+	0xc7, 0x1d, 0x27, 0x05, 0x21, 0xc4, 0x74, 0x76, 0xe6, 0x7a, 0x3d, 0xf2, 0xee, 0xdc, 0xcd, 0x7c,
+	0x24, 0x31, 0x25, 0x52, 0xd5, 0x3e, 0x21, 0x10, 0x14, 0x21, 0x21, 0x1e, 0x10, 0x12, 0xe2, 0x05,
+	0x55, 0x48, 0xbc, 0xf0, 0x02, 0x2f, 0x08, 0x1e, 0x50, 0x01, 0xa9, 0xaa, 0x84, 0x2a, 0x95, 0x22,
+	0x6d, 0xa1, 0x41, 0x80, 0x54, 0xe0, 0xc1, 0xbc, 0x20, 0xf1, 0x82, 0xe6, 0xce, 0x9d, 0xd9, 0x99,
+	0xdd, 0x59, 0x7b, 0x76, 0xd6, 0x50, 0x10, 0x4f, 0xde, 0x99, 0x7b, 0xcf, 0xef, 0x9e, 0xdf, 0x39,
+	0xe7, 0x7e, 0x9d, 0x33, 0x86, 0xa7, 0x98, 0x55, 0x67, 0x96, 0x6e, 0x15, 0x55, 0x66, 0xa8, 0xd4,
+	0xb0, 0x4d, 0xc5, 0xa6, 0x5a, 0x4d, 0xbf, 0xe3, 0xe8, 0x9a, 0x6e, 0x6f, 0x15, 0xef, 0x3e, 0x55,
+	0xa1, 0xb6, 0xf2, 0x54, 0xf1, 0x8e, 0x43, 0xcd, 0xad, 0x42, 0xc3, 0x64, 0x36, 0xc3, 0x27, 0x84,
+	0x48, 0x21, 0x56, 0xa4, 0x20, 0x44, 0xb2, 0x53, 0x55, 0x56, 0x65, 0x5c, 0xa2, 0xe8, 0xfe, 0xf2,
+	0x84, 0xb3, 0x1f, 0xdd, 0x79, 0xbc, 0x86, 0x62, 0x2a, 0x75, 0x4b, 0xf4, 0x3d, 0x9b, 0x4c, 0x37,
+	0x5b, 0x57, 0x37, 0x65, 0xdd, 0x58, 0xf7, 0x87, 0xc8, 0xa9, 0x5c, 0xae, 0x58, 0x51, 0x2c, 0x1a,
+	0x74, 0x52, 0x99, 0x6e, 0xf8, 0x2a, 0x84, 0xdb, 0x39, 0xb1, 0xa0, 0x57, 0x43, 0xa9, 0xea, 0x86,
+	0x62, 0xeb, 0xcc, 0xef, 0x7b, 0xb4, 0xca, 0x58, 0xb5, 0x46, 0x8b, 0x4a, 0x43, 0x2f, 0x2a, 0x86,
+	0xc1, 0x6c, 0xde, 0xe8, 0x2b, 0x38, 0x27, 0x5a, 0xf9, 0x53, 0xc5, 0x59, 0x2f, 0x2a, 0xc6, 0x96,
+	0xdf, 0xe4, 0x0d, 0x22, 0x7b, 0x06, 0xf0, 0x1e, 0x44, 0xd3, 0x99, 0x64, 0xb4, 0x1a, 0xcc, 0xd2,
+	0x43, 0x9a, 0x5c, 0x48, 0x26, 0xa5, 0xf3, 0x46, 0xfd, 0x2e, 0x95, 0x4d, 0xaa, 0x32, 0x53, 0x13,
+	0xd2, 0x0b, 0xc9, 0xa4, 0x2d, 0x9b, 0x35, 0x64, 0x95, 0x19, 0x5a, 0x68, 0x64, 0xf2, 0x43, 0x04,
+	0x53, 0xb7, 0x2d, 0x6a, 0xae, 0x0a, 0x85, 0x2c, 0x89, 0xde, 0x71, 0xa8, 0x65, 0xe3, 0x27, 0xe1,
+	0x11, 0x45, 0xd3, 0x4c, 0x6a, 0x59, 0xb3, 0xe8, 0x38, 0x9a, 0xcf, 0x94, 0xf0, 0x76, 0x33, 0x3f,
+	0xba, 0xa5, 0xd4, 0x6b, 0x0b, 0x44, 0x34, 0x10, 0xc9, 0xef, 0x82, 0x9f, 0x80, 0x47, 0x1a, 0x8c,
+	0xd5, 0x64, 0x5d, 0x9b, 0x1d, 0x38, 0x8e, 0xe6, 0xf7, 0x87, 0x7b, 0x8b, 0x06, 0x22, 0x1d, 0x70,
+	0x7f, 0x2d, 0x6b, 0x78, 0x09, 0xa0, 0xe5, 0x8b, 0xd9, 0xc1, 0xe3, 0x68, 0xfe, 0xd0, 0xa9, 0xc7,
+	0x0a, 0xc2, 0x8c, 0xae, 0xe3, 0x0a, 0x5e, 0x44, 0x0a, 0xc5, 0x0b, 0xab, 0x4a, 0x95, 0x0a, 0xb5,
+	0xa4, 0x90, 0x24, 0xf9, 0x29, 0x82, 0xc3, 0x6d, 0xba, 0x5b, 0x0d, 0x66, 0x58, 0x14, 0xbf, 0x08,
+	0x19, 0xdf, 0xc2, 0xae, 0xfa, 0x83, 0xf3, 0x87, 0x4e, 0x5d, 0x28, 0x24, 0x8a, 0xec, 0xc2, 0x92,
+	0x53, 0xab, 0xf9, 0x80, 0x25, 0x93, 0x2a, 0x9b, 0x1a, 0xbb, 0x67, 0x94, 0xf6, 0xbf, 0xd1, 0xcc,
+	0xef, 0x93, 0x5a, 0xa0, 0xf8, 0x5a, 0x84, 0xc3, 0x00, 0xe7, 0xf0, 0xf8, 0xae, 0x1c, 0x3c, 0xf5,
+	0x22, 0x24, 0x56, 0x60, 0x32, 0x18, 0x6e, 0x6b, 0x59, 0xf3, 0xcd, 0xff, 0x0c, 0x1c, 0xf2, 0x07,
+	0x73, 0x8d, 0x8a, 0xb8, 0x51, 0xa7, 0xb7, 0x9b, 0x79, 0xec, 0x1b, 0x35, 0x68, 0x24, 0x12, 0xf8,
+	0x4f, 0xcb, 0x1a, 0xb9, 0x0b, 0x53, 0x51, 0x3c, 0x61, 0x92, 0xcf, 0xc2, 0x41, 0xbf, 0x17, 0x47,
+	0xdb, 0x1b, 0x8b, 0x04, 0x98, 0x64, 0x09, 0x66, 0x56, 0x9c, 0xfa, 0x2a, 0x63, 0xb5, 0x8e, 0x50,
+	0x0a, 0x05, 0x07, 0xda, 0x2d, 0x38, 0xc8, 0x67, 0x60, 0xb6, 0x13, 0x47, 0x70, 0xb8, 0x0c, 0xa3,
+	0x01, 0x6f, 0x95, 0x39, 0x86, 0x2d, 0xf0, 0xe6, 0xb6, 0x9b, 0xf9, 0xc3, 0x6d, 0x76, 0xe1, 0xed,
+	0x44, 0x1a, 0xf1, 0x5f, 0x94, 0xf9, 0xf3, 0x0b, 0x30, 0xec, 0x42, 0x07, 0xaa, 0x2d, 0xc5, 0xb8,
+	0x31, 0x4d, 0x28, 0x7e, 0x05, 0xc1, 0x88, 0x00, 0x16, 0xba, 0x9e, 0x85, 0x21, 0x97, 0x91, 0x1f,
+	0x7e, 0x53, 0x05, 0x6f, 0x39, 0x29, 0xf8, 0xcb, 0x49, 0x61, 0xd1, 0xd8, 0x2a, 0x65, 0x7e, 0xf1,
+	0x83, 0x93, 0x43, 0xae, 0xdc, 0xb2, 0xe4, 0xf5, 0xde, 0xbb, 0xb8, 0x1a, 0x83, 0x91, 0x55, 0xbe,
+	0xde, 0x0a, 0x75, 0xc9, 0x6d, 0x18, 0xf5, 0x5f, 0x08, 0x15, 0xcb, 0x70, 0xc0, 0x5b, 0x92, 0x45,
+	0x40, 0x9c, 0xd8, 0x25, 0x20, 0x3c, 0x71, 0xe1, 0x79, 0x21, 0x4a, 0x5e, 0x47, 0x30, 0x7e, 0x4b,
+	0x57, 0x37, 0xaf, 0xfb, 0xdd, 0x56, 0xa8, 0x8d, 0x5f, 0x84, 0x91, 0x40, 0x4c, 0x36, 0xa8, 0x2d,
+	0x96, 0x90, 0xf3, 0xae, 0xe4, 0xbb, 0xcd, 0xfc, 0x11, 0x8f, 0x8f, 0xa5, 0x6d, 0x16, 0x74, 0x56,
+	0xac, 0x2b, 0xf6, 0x46, 0xe1, 0x3a, 0xad, 0x2a, 0xea, 0xd6, 0x15, 0xaa, 0x6e, 0x37, 0xf3, 0x53,
+	0x9e, 0x2b, 0x23, 0x08, 0x44, 0x1a, 0xae, 0x85, 0x47, 0x38, 0x03, 0x20, 0xb6, 0x06, 0x8d, 0xde,
+	0xe7, 0x76, 0x1a, 0x2c, 0x1d, 0xde, 0x6e, 0xe6, 0x27, 0x3c, 0xd9, 0x56, 0x1b, 0x91, 0x32, 0xee,
+	0xc3, 0x32, 0xff, 0xfd, 0x17, 0x04, 0x33, 0x81, 0xa2, 0x57, 0x68, 0xc3, 0xde, 0xf8, 0xa4, 0x6e,
+	0x6f, 0x48, 0x8a, 0x51, 0xa5, 0x78, 0x1d, 0xc6, 0x5b, 0x23, 0x2a, 0xf5, 0x20, 0xbc, 0xfa, 0x54,
+	0x7b, 0x2c, 0x78, 0x5e, 0xe4, 0x98, 0xae, 0xe6, 0x35, 0x76, 0x8f, 0x9a, 0xb2, 0xab, 0x56, 0xa7,
+	0xe6, 0xad, 0x36, 0x22, 0x65, 0xf8, 0x83, 0x6b, 0x5d, 0x57, 0xca, 0x69, 0x34, 0x7c, 0xa9, 0xc1,
+	0x76, 0xa9, 0x56, 0x1b, 0x91, 0x32, 0xfc, 0xc1, 0x95, 0x22, 0xef, 0x0d, 0x40, 0x2e, 0xec, 0x98,
+	0x65, 0xe3, 0x8a, 0x6e, 0x52, 0xd5, 0x0d, 0x90, 0x34, 0x93, 0x13, 0x17, 0xe0, 0xa0, 0xcd, 0x36,
+	0xa9, 0x21, 0xeb, 0x5e, 0x6c, 0x66, 0x4a, 0x93, 0xdb, 0xcd, 0xfc, 0x98, 0xb0, 0xb9, 0x68, 0x21,
+	0xd2, 0x23, 0xfc, 0xe7, 0xb2, 0xe1, 0x6a, 0x6d, 0xd9, 0x8a, 0x69, 0x77, 0xd1, 0xba, 0xd5, 0x46,
+	0xa4, 0x0c, 0x7f, 0xe0, 0x5c, 0xcf, 0xc1, 0xb0, 0x63, 0x51, 0x59, 0x75, 0x04, 0xdb, 0xfd, 0xc7,
+	0xd1, 0xfc, 0xc1, 0xd2, 0xcc, 0x76, 0x33, 0x3f, 0x29, 0xd8, 0x86, 0x5a, 0x89, 0x04, 0x8e, 0x45,
+	0xcb, 0x4e, 0x60, 0xa6, 0x0a, 0x73, 0x0c, 0xcd, 0x13, 0x1c, 0x6a, 0x1f, 0xb0, 0xd5, 0x46, 0xa4,
+	0x0c, 0x7f, 0x08, 0x0f, 0x68, 0x30, 0x99, 0xbf, 0x9b, 0x3d, 0x10, 0x37, 0xa0, 0xdf, 0xea, 0x0d,
+	0xb8, 0xc2, 0x4a, 0xfc, 0xe1, 0xdb, 0x83, 0x90, 0xef, 0x6a, 0x61, 0x31, 0xcf, 0x36, 0xc2, 0x91,
+	0xa5, 0xb9, 0x51, 0xe7, 0xaf, 0x0a, 0xcf, 0x24, 0x5c, 0x82, 0xdb, 0x27, 0x98, 0x98, 0x83, 0x63,
+	0xb5, 0x48, 0x2c, 0x5b, 0xf8, 0x51, 0x18, 0x56, 0x1d, 0xd3, 0xa4, 0x86, 0x1d, 0x8a, 0x2e, 0xe9,
+	0x90, 0x78, 0xc7, 0xb9, 0xd6, 0x60, 0xc2, 0xef, 0x12, 0x48, 0x73, 0xcf, 0x64, 0x4a, 0x97, 0x92,
+	0xc5, 0xf9, 0xac, 0x67, 0x93, 0x0e, 0x14, 0x22, 0x8d, 0x8b, 0x77, 0x81, 0xaa, 0xf8, 0x15, 0x04,
+	0xd8, 0xef, 0x68, 0xdd, 0x31, 0x6d, 0xb9, 0x61, 0xea, 0x2a, 0xe5, 0x1e, 0xcd, 0x94, 0x6e, 0x89,
+	0xf1, 0x8a, 0x55, 0xdd, 0xde, 0x70, 0x2a, 0x05, 0x95, 0xd5, 0x8b, 0xc2, 0x1e, 0x27, 0x6b, 0x4a,
+	0xc5, 0xf2, 0x1f, 0xf8, 0x5f, 0xae, 0x46, 0x49, 0xaf, 0x7a, 0x3a, 0xcc, 0x45, 0x75, 0x68, 0x41,
+	0xb7, 0x94, 0x58, 0xbb, 0x63, 0xda, 0xab, 0xfc, 0xd5, 0x73, 0x70, 0x34, 0xd0, 0x68, 0xd5, 0x9b,
+	0x19, 0x7c, 0xca, 0xa7, 0xda, 0x9f, 0x7e, 0x8c, 0xe0, 0x58, 0x17, 0x34, 0xe1, 0xee, 0x0a, 0x64,
+	0x5a, 0x96, 0xf5, 0xfc, 0xfc, 0x6c, 0x42, 0x3f, 0x77, 0x59, 0x9b, 0xfc, 0xe3, 0x47, 0x20, 0x80,
+	0x17, 0x60, 0xb8, 0xe2, 0xa8, 0x9b, 0xd4, 0x8e, 0x2c, 0x80, 0xa1, 0x88, 0x0d, 0xb7, 0x12, 0xe9,
+	0x90, 0xf7, 0xe8, 0x2d, 0x82, 0x9f, 0x82, 0x63, 0xe5, 0x9a, 0xa2, 0xd7, 0x95, 0x4a, 0x8d, 0xae,
+	0x35, 0x4c, 0xaa, 0x68, 0x12, 0xbd, 0xa7, 0x98, 0x9a, 0xd5, 0xf7, 0xd9, 0xe3, 0x5b, 0x08, 0x72,
+	0xdd, 0xa0, 0x85, 0x71, 0x3e, 0x0f, 0xb3, 0xaa, 0xdf, 0x43, 0xb6, 0x78, 0x17, 0xd9, 0xf4, 0xfa,
+	0x08, 0x5b, 0xcd, 0x45, 0x76, 0x3b, 0xdf, 0x32, 0x65, 0xa6, 0x1b, 0xa5, 0xc7, 0x5d, 0x33, 0x6c,
+	0x37, 0xf3, 0x79, 0xe1, 0xfd, 0x2e, 0x40, 0x44, 0x9a, 0x56, 0x63, 0xb5, 0x20, 0xb7, 0x21, 0x1b,
+	0xe8, 0xb7, 0xec, 0x1f, 0xa6, 0xfb, 0xe7, 0xfd, 0xea, 0x00, 0x1c, 0x89, 0xc5, 0x15, 0xa4, 0xef,
+	0xc0, 0x54, 0x4b, 0xd7, 0xe0, 0x10, 0x9f, 0x80, 0xf0, 0xff, 0x09, 0xc2, 0x47, 0xda, 0x09, 0xb7,
+	0x40, 0x88, 0x34, 0xa9, 0x76, 0x0e, 0xed, 0x0e, 0xb9, 0xce, 0xcc, 0x75, 0xaa, 0xdb, 0x54, 0x0b,
+	0x0f, 0x39, 0xd0, 0xe3, 0x90, 0x71, 0x20, 0x44, 0x9a, 0x0c, 0x5e, 0xb7, 0x86, 0x24, 0x4d, 0x04,
+	0x47, 0x56, 0xa9, 0xa1, 0xe9, 0x46, 0x35, 0x36, 0xac, 0x7a, 0xda, 0x69, 0x1e, 0x83, 0x21, 0x76,
+	0xcf, 0xa0, 0xa6, 0xd8, 0x66, 0xc6, 0xb7, 0x9b, 0xf9, 0x61, 0xaf, 0x2b, 0x7f, 0x4d, 0x24, 0xaf,
+	0xb9, 0x6d, 0x37, 0x1d, 0x4c, 0xb5, 0x9b, 0xee, 0x4f, 0xb8, 0x9b, 0x7e, 0x1d, 0xc1, 0xd1, 0x78,
+	0x82, 0xc2, 0xcf, 0x77, 0x61, 0xba, 0xe1, 0xb5, 0xf7, 0x1c, 0xda, 0x27, 0x84, 0xd9, 0x8f, 0x09,
+	0x7b, 0xc4, 0xc2, 0x10, 0x69, 0xaa, 0x11, 0x33, 0x3e, 0xf9, 0x0d, 0x82, 0x59, 0xa1, 0x58, 0x67,
+	0x54, 0xff, 0xd7, 0x9b, 0xfd, 0x1f, 0x08, 0xe6, 0x62, 0xd8, 0x09, 0x9b, 0x6f, 0x02, 0xf6, 0x8d,
+	0xd5, 0xcb, 0xcc, 0x7a, 0x54, 0xd8, 0x7b, 0x2e, 0x6a, 0xef, 0x70, 0x90, 0x4f, 0x34, 0xda, 0x07,
+	0xfd, 0x30, 0x66, 0xd5, 0x75, 0x38, 0xe6, 0x5e, 0x10, 0x16, 0x55, 0xd5, 0xa9, 0x3b, 0x35, 0xc5,
+	0x66, 0x66, 0x1f, 0xd3, 0x8a, 0xfc, 0x64, 0x00, 0x72, 0xdd, 0xe0, 0x84, 0x41, 0x5f, 0x43, 0x70,
+	0x24, 0x12, 0x76, 0x72, 0xd5, 0x64, 0xf7, 0xec, 0x0d, 0xb9, 0x5a, 0x63, 0x15, 0xa5, 0x26, 0x4c,
+	0x7b, 0x34, 0x96, 0xeb, 0x15, 0xaa, 0x72, 0xba, 0xa7, 0x5d, 0xba, 0xaf, 0xbf, 0x97, 0x7f, 0x22,
+	0xb4, 0xb3, 0x7b, 0xfd, 0xc5, 0x9f, 0x93, 0x96, 0xb6, 0x59, 0xb4, 0xb7, 0x1a, 0xd4, 0xf2, 0x65,
+	0x2c, 0x69, 0xd6, 0x0a, 0x05, 0xf5, 0x35, 0x3e, 0xe6, 0x35, 0x3e, 0x24, 0xfe, 0x22, 0x82, 0x29,
+	0xa7, 0x61, 0xeb, 0x75, 0xda, 0xa6, 0x8b, 0x67, 0xf7, 0x33, 0x09, 0x77, 0xd7, 0xdb, 0x1c, 0xe2,
+	0x96, 0xa9, 0xa8, 0x9b, 0xd4, 0x6c, 0x77, 0x49, 0x1c, 0x3e, 0x91, 0xb0, 0xf7, 0x3a, 0xac, 0x0d,
+	0x79, 0x15, 0x41, 0xce, 0x0d, 0xcc, 0x90, 0x0d, 0x05, 0x66, 0xba, 0x39, 0x97, 0xee, 0x2a, 0xf3,
+	0xc1, 0x00, 0xe4, 0xbb, 0x6a, 0x21, 0x5c, 0xf9, 0x06, 0x82, 0x73, 0xb1, 0xae, 0x64, 0x0d, 0xbe,
+	0x7b, 0x51, 0x59, 0xf3, 0x0f, 0xab, 0x32, 0x5b, 0x97, 0x6b, 0x8a, 0x65, 0xcb, 0xb6, 0xa9, 0xdc,
+	0xa5, 0xa6, 0xf5, 0xaf, 0x74, 0xf4, 0xa9, 0x4e, 0x47, 0xdf, 0x14, 0x0a, 0x05, 0x87, 0xe7, 0x9b,
+	0xeb, 0xd7, 0x15, 0xcb, 0xbe, 0xe5, 0x2b, 0x83, 0x1f, 0xc0, 0x98, 0xf0, 0x90, 0x2d, 0x58, 0xf6,
+	0xe5, 0xfc, 0x9c, 0x70, 0xfe, 0x74, 0xc4, 0xf9, 0x3e, 0x34, 0x91, 0x46, 0x9d, 0x70, 0x77, 0x8b,
+	0x7c, 0x01, 0x01, 0xbe, 0x7a, 0xbf, 0xc1, 0xbc, 0x1b, 0x4a, 0x3a, 0x3f, 0xef, 0x55, 0xae, 0xe1,
+	0x9d, 0xfd, 0x30, 0xec, 0xe9, 0x42, 0x35, 0x7f, 0x59, 0x0d, 0x05, 0x10, 0x4a, 0x16, 0x40, 0x78,
+	0x1d, 0x5a, 0xd7, 0x07, 0x37, 0x2e, 0x2c, 0x4b, 0x2c, 0xfa, 0x17, 0x93, 0x5d, 0x03, 0xa6, 0xdb,
+	0xaf, 0xbb, 0x1c, 0x83, 0x48, 0xa3, 0xc1, 0x9b, 0x6b, 0xee, 0x8b, 0xce, 0x5c, 0xc0, 0xe0, 0x5e,
+	0xe7, 0x02, 0xfa, 0x0b, 0xf3, 0xfd, 0xff, 0xe1, 0x61, 0x3e, 0xf4, 0x6f, 0x0c, 0xf3, 0xef, 0x23,
+	0x98, 0x8c, 0x84, 0xb9, 0x58, 0x48, 0x6e, 0xc2, 0x90, 0x1b, 0x38, 0xfe, 0xbe, 0x7a, 0x3a, 0xa1,
+	0x32, 0xe1, 0x28, 0x15, 0x77, 0x18, 0x0f, 0x67, 0xef, 0xd2, 0x5c, 0x5f, 0x46, 0x30, 0x13, 0xec,
+	0x96, 0x12, 0xcf, 0x8a, 0x7f, 0xb8, 0xb3, 0xf3, 0x4d, 0x04, 0xb3, 0x9d, 0x0a, 0x09, 0x3b, 0xea,
+	0x30, 0xd1, 0x9e, 0xc3, 0xf7, 0x6d, 0xfa, 0x74, 0x42, 0x9b, 0xb6, 0x61, 0x0b, 0xb3, 0x8e, 0xeb,
+	0x6d, 0x43, 0xee, 0x9d, 0x85, 0x5f, 0x46, 0xf0, 0x44, 0x79, 0xe9, 0xc6, 0x0d, 0x9e, 0xa6, 0xd4,
+	0xae, 0xeb, 0xc6, 0xe6, 0x92, 0xc9, 0xea, 0xe5, 0x90, 0x92, 0x5e, 0x8b, 0x6f, 0xf5, 0xe7, 0x61,
+	0x2a, 0xcc, 0x40, 0x8e, 0xba, 0x20, 0x1f, 0xba, 0xcd, 0xc4, 0xf4, 0x22, 0x12, 0x56, 0x3b, 0x90,
+	0x89, 0x0e, 0x4f, 0x26, 0xd3, 0x40, 0x98, 0xf9, 0x1c, 0x0c, 0xab, 0xeb, 0xf5, 0x7a, 0xdb, 0xd0,
+	0xa1, 0xdb, 0x71, 0xb8, 0x95, 0x48, 0xe0, 0x3e, 0x8a, 0xa1, 0x6e, 0xc0, 0x31, 0xb7, 0xa4, 0x70,
+	0xdb, 0xa8, 0x30, 0x7e, 0xf8, 0xeb, 0xaf, 0x2e, 0x42, 0xbe, 0x83, 0x20, 0xd7, 0x0d, 0x4f, 0x28,
+	0xfb, 0x32, 0x82, 0x6c, 0x50, 0x57, 0x90, 0xef, 0xe9, 0xf6, 0x86, 0xdc, 0xa0, 0xa6, 0xce, 0x34,
+	0xb9, 0xc6, 0xd4, 0x4d, 0x11, 0x1d, 0x17, 0x13, 0x46, 0x87, 0x0f, 0xef, 0xa6, 0x0e, 0x56, 0x39,
+	0xca, 0x75, 0x16, 0xcc, 0xbd, 0x99, 0x60, 0x98, 0x68, 0x33, 0xc9, 0xc2, 0xec, 0x35, 0x6a, 0xdf,
+	0x62, 0xb6, 0x52, 0x0b, 0x32, 0x10, 0x7e, 0xda, 0xf8, 0xab, 0x08, 0xe6, 0x62, 0x1a, 0x85, 0xf2,
+	0x36, 0x8c, 0xd9, 0x6e, 0x8b, 0xdc, 0x9e, 0xf1, 0xd8, 0xe1, 0x2c, 0xfc, 0x31, 0xb1, 0x98, 0xce,
+	0x27, 0x58, 0x4c, 0xbd, 0x95, 0x74, 0xd4, 0x8e, 0x8c, 0x4e, 0xb6, 0x11, 0xe4, 0x56, 0x9c, 0xfa,
+	0x0a, 0xbd, 0x6f, 0x2f, 0x1b, 0xba, 0xad, 0x2b, 0x35, 0xfd, 0x73, 0xde, 0x2a, 0x93, 0x6e, 0xee,
+	0x5f, 0x82, 0x51, 0x3f, 0x79, 0x29, 0x6b, 0xd4, 0x60, 0x75, 0xb1, 0x13, 0x86, 0xea, 0x0a, 0xd1,
+	0x76, 0x22, 0x0d, 0x8b, 0x14, 0xe7, 0x15, 0xf7, 0x11, 0x57, 0x20, 0x6b, 0x38, 0x75, 0xd9, 0xa0,
+	0xf7, 0x6d, 0x59, 0x6f, 0x69, 0x24, 0x7b, 0x8b, 0xe6, 0x20, 0x57, 0xe0, 0xc4, 0x76, 0x33, 0xff,
+	0xa8, 0x07, 0xd6, 0xbd, 0x2f, 0x91, 0x66, 0x8c, 0x78, 0x62, 0xe4, 0x9b, 0x03, 0x90, 0xef, 0x4a,
+	0xfa, 0x7f, 0x3e, 0xd3, 0x48, 0x6e, 0xc2, 0xd4, 0x9a, 0xcd, 0x1a, 0x65, 0xbf, 0xbe, 0xd9, 0x77,
+	0x52, 0xe7, 0x4b, 0x08, 0x0e, 0xb7, 0x21, 0x0a, 0x2b, 0x2b, 0x30, 0x1a, 0xad, 0xa5, 0x8a, 0xfa,
+	0x49, 0xd2, 0x3d, 0x3a, 0x82, 0x2a, 0x0c, 0x3c, 0x62, 0x85, 0x5f, 0xe2, 0x71, 0x18, 0xac, 0x53,
+	0x9b, 0x5b, 0xf5, 0xa0, 0xe4, 0xfe, 0x24, 0x9f, 0x80, 0x23, 0x11, 0x39, 0xab, 0xb4, 0xe5, 0x2e,
+	0x59, 0xa9, 0x6e, 0x81, 0xaf, 0x22, 0x38, 0x1a, 0x0f, 0x26, 0x18, 0xaa, 0x30, 0x16, 0x65, 0xe8,
+	0x87, 0x51, 0x3f, 0x14, 0x47, 0x23, 0x14, 0x2d, 0xf2, 0x02, 0x3c, 0xbe, 0xd6, 0x71, 0x42, 0x5a,
+	0x53, 0x6c, 0x27, 0x58, 0xd2, 0xd3, 0xdd, 0x71, 0xb7, 0x20, 0xbf, 0x0b, 0x6e, 0x6f, 0xab, 0xc3,
+	0x47, 0xe0, 0x00, 0x9f, 0xf4, 0xde, 0x8d, 0x23, 0x53, 0x9a, 0xd8, 0x6e, 0xe6, 0x47, 0xbc, 0xbe,
+	0xde, 0x7b, 0x22, 0x89, 0x0e, 0xee, 0x69, 0x64, 0x7e, 0x77, 0x4e, 0x41, 0x9e, 0x38, 0x52, 0x21,
+	0x5c, 0x4a, 0x6a, 0xda, 0x9d, 0xf1, 0xfd, 0x73, 0x16, 0x87, 0x3e, 0xf5, 0xf6, 0x09, 0x18, 0x7a,
+	0xde, 0xdd, 0xe7, 0xf1, 0x77, 0x11, 0xf0, 0x4a, 0xa3, 0x85, 0x4f, 0x27, 0xde, 0x4b, 0x5a, 0x1e,
+	0xc8, 0x9e, 0xe9, 0x4d, 0xc8, 0xa3, 0x48, 0xce, 0xbc, 0xf2, 0xab, 0xdf, 0x7f, 0x6d, 0xa0, 0x80,
+	0x9f, 0x2c, 0x26, 0xfd, 0x2c, 0xc2, 0x55, 0xf0, 0x7b, 0x08, 0x0e, 0x78, 0xb5, 0x46, 0x9c, 0x78,
+	0xd8, 0x70, 0xa9, 0x33, 0x7b, 0xb6, 0x47, 0x29, 0xa1, 0xed, 0x59, 0xae, 0x6d, 0x11, 0x9f, 0x4c,
+	0xaa, 0xad, 0xa7, 0xe3, 0x9b, 0x08, 0x46, 0x22, 0x9f, 0x21, 0xe0, 0xf3, 0x49, 0x0f, 0xeb, 0x31,
+	0x1f, 0x5e, 0x64, 0x2f, 0xa4, 0x13, 0x16, 0x1c, 0x4a, 0x9c, 0xc3, 0x05, 0xbc, 0x50, 0xec, 0xed,
+	0x43, 0x14, 0xab, 0xf8, 0x92, 0x38, 0xb3, 0x3c, 0xc0, 0x1f, 0x20, 0x38, 0x1c, 0x5b, 0xe2, 0xc0,
+	0xe5, 0x5e, 0xeb, 0x18, 0x31, 0xe5, 0x96, 0xec, 0x95, 0xfe, 0x40, 0x04, 0xd1, 0x6b, 0x9c, 0xe8,
+	0x22, 0xbe, 0x94, 0x90, 0x68, 0xf0, 0x46, 0xf6, 0x93, 0x8c, 0xb2, 0xc9, 0x39, 0xfd, 0x2d, 0x5c,
+	0x13, 0x8e, 0x56, 0xf0, 0xf0, 0xd5, 0x5e, 0x55, 0x8d, 0xad, 0xb1, 0x66, 0x97, 0xfa, 0x85, 0x11,
+	0x9c, 0x97, 0x39, 0xe7, 0x32, 0x5e, 0xec, 0x99, 0xb3, 0xc1, 0x6b, 0x41, 0xad, 0x7b, 0x30, 0xfe,
+	0x2b, 0x82, 0xe9, 0xf8, 0x52, 0x0d, 0x4e, 0xea, 0x9f, 0x1d, 0x8b, 0x48, 0xd9, 0xab, 0x7d, 0xa2,
+	0xa4, 0x74, 0x73, 0xb7, 0x9a, 0x10, 0xfe, 0x1d, 0x82, 0xc9, 0x98, 0x1a, 0x0d, 0x5e, 0xec, 0x55,
+	0xcf, 0x8e, 0x0c, 0x7b, 0xb6, 0xd4, 0x0f, 0x84, 0xe0, 0x59, 0xe6, 0x3c, 0x2f, 0xe2, 0xf3, 0x3d,
+	0xf3, 0x6c, 0x65, 0x90, 0xf1, 0x1f, 0x10, 0x4c, 0xc5, 0x15, 0x28, 0x70, 0x52, 0x0d, 0x77, 0x28,
+	0xdf, 0x64, 0xcb, 0x7d, 0x61, 0x08, 0x9a, 0x57, 0x39, 0xcd, 0x4b, 0xf8, 0x62, 0xd2, 0xe5, 0x29,
+	0xb6, 0x0e, 0x82, 0x7f, 0x8d, 0x60, 0xa2, 0xa3, 0x24, 0x80, 0x2f, 0xf5, 0xa6, 0x61, 0xa7, 0x23,
+	0x2f, 0xa7, 0x07, 0x10, 0xfc, 0x16, 0x39, 0xbf, 0xf3, 0xf8, 0x5c, 0x8f, 0xfc, 0x42, 0x4e, 0xfc,
+	0x19, 0x72, 0xbf, 0x51, 0x6a, 0x7d, 0xc1, 0x85, 0x17, 0x7a, 0xbc, 0xfb, 0x85, 0x3e, 0x23, 0xcb,
+	0x9e, 0x4f, 0x25, 0x2b, 0xc8, 0x5c, 0xe4, 0x64, 0x9e, 0xc1, 0x67, 0x7b, 0xdc, 0x4b, 0xe4, 0xca,
+	0x96, 0xac, 0x6b, 0xf8, 0x4f, 0x08, 0xa6, 0xe3, 0x6b, 0x0d, 0x89, 0x97, 0x98, 0x1d, 0x2b, 0x1f,
+	0xd9, 0xab, 0x7d, 0xa2, 0xa4, 0xf5, 0x99, 0x7b, 0x40, 0x54, 0x5c, 0xbc, 0x20, 0x1e, 0xdf, 0x46,
+	0x30, 0xde, 0x9e, 0xf4, 0xc1, 0xcf, 0xa6, 0xcb, 0xe8, 0x04, 0xf4, 0x2e, 0xa5, 0x96, 0x17, 0xc4,
+	0x2e, 0x73, 0x62, 0x0b, 0xf8, 0xe3, 0xc5, 0x74, 0x9f, 0x97, 0x5a, 0xf8, 0xcf, 0x08, 0x66, 0xba,
+	0x14, 0x19, 0x12, 0xef, 0x8d, 0x3b, 0x97, 0x4a, 0xb2, 0x4b, 0xfd, 0xc2, 0xa4, 0x3c, 0xf8, 0xf0,
+	0x13, 0x80, 0xe7, 0x45, 0x3f, 0x1f, 0x8a, 0x7f, 0x89, 0xe0, 0x50, 0x28, 0xfd, 0x89, 0xcf, 0xf5,
+	0x94, 0xe7, 0x0c, 0xe7, 0x1f, 0xb2, 0x0b, 0x69, 0x44, 0x53, 0x2e, 0x92, 0x94, 0x63, 0x78, 0x09,
+	0x84, 0xe2, 0x4b, 0xe2, 0xfe, 0xf2, 0x00, 0xff, 0x68, 0x00, 0xfe, 0x3f, 0x49, 0xda, 0x0c, 0x4b,
+	0x49, 0xf7, 0xaf, 0xe4, 0x59, 0xc0, 0xec, 0xda, 0x9e, 0x62, 0x0a, 0xc3, 0xe8, 0xdc, 0x30, 0x2a,
+	0x56, 0x92, 0x6e, 0x92, 0xa1, 0x34, 0x9f, 0x5c, 0xd3, 0x8d, 0x4d, 0x79, 0xdd, 0x64, 0x75, 0x39,
+	0x2c, 0x54, 0x7c, 0x29, 0x2e, 0x0d, 0xf9, 0x00, 0xff, 0x1d, 0xc1, 0x74, 0x7c, 0xe2, 0x2e, 0xf1,
+	0xe2, 0xb5, 0x63, 0x1e, 0x31, 0x7b, 0xb5, 0x4f, 0x14, 0x61, 0x92, 0xe7, 0xb9, 0x49, 0x9e, 0xc3,
+	0xcb, 0x09, 0x4d, 0xe2, 0x58, 0xd4, 0x94, 0x1d, 0x1f, 0x4f, 0x8e, 0x3b, 0xfe, 0xbf, 0x8b, 0x60,
+	0xa2, 0x23, 0xe3, 0x97, 0x78, 0x73, 0xed, 0x96, 0x48, 0xcc, 0x5e, 0x4e, 0x0f, 0x90, 0x72, 0x8a,
+	0x57, 0xa9, 0x2d, 0xb7, 0x65, 0x27, 0xf9, 0x69, 0xbf, 0x4b, 0x16, 0x2d, 0xf1, 0x8a, 0xb6, 0x73,
+	0xea, 0x31, 0xbb, 0xd4, 0x2f, 0x4c, 0xca, 0xd3, 0x7e, 0xf7, 0xac, 0x22, 0xfe, 0x39, 0x82, 0x91,
+	0x48, 0x4a, 0x26, 0xf1, 0x15, 0x35, 0x2e, 0xa7, 0x96, 0xbd, 0x90, 0x4e, 0x38, 0xe5, 0xb1, 0x22,
+	0x9a, 0x89, 0xc2, 0x7f, 0x44, 0x30, 0x15, 0x97, 0xbc, 0x4a, 0x7c, 0xc8, 0xdd, 0x21, 0x8d, 0x96,
+	0x2d, 0xf7, 0x85, 0x21, 0x08, 0x2e, 0x71, 0x82, 0x97, 0xf1, 0xb3, 0xa9, 0x08, 0x5a, 0xee, 0xf1,
+	0xc9, 0x5d, 0x87, 0xf0, 0x37, 0x06, 0xe0, 0xf8, 0x6e, 0xd9, 0x24, 0xbc, 0xb2, 0x37, 0x69, 0xa3,
+	0x20, 0x7a, 0x6f, 0xee, 0x19, 0x9e, 0xb0, 0xc6, 0x1a, 0xb7, 0xc6, 0x0d, 0xfc, 0x5c, 0x52, 0x6b,
+	0xc4, 0x55, 0x72, 0x2d, 0x1f, 0x9b, 0x5b, 0xc6, 0x2a, 0x6d, 0xbc, 0xf1, 0x7e, 0x0e, 0xbd, 0xf5,
+	0x7e, 0x0e, 0xfd, 0xf6, 0xfd, 0x1c, 0x7a, 0xed, 0x61, 0x6e, 0xdf, 0x5b, 0x0f, 0x73, 0xfb, 0xde,
+	0x79, 0x98, 0xdb, 0xf7, 0xe9, 0x95, 0xdd, 0x3e, 0x26, 0xbd, 0x7b, 0xea, 0xe9, 0xe2, 0xfd, 0x88,
+	0x0e, 0x27, 0x5b, 0x4a, 0xa8, 0x35, 0x9d, 0x1a, 0xb6, 0xf7, 0x8f, 0x43, 0xde, 0x97, 0xfa, 0x07,
+	0xf8, 0x9f, 0xd3, 0xff, 0x1c, 0x00, 0x29, 0x0b, 0xab, 0x53, 0x4c, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
 	// Pools returns all concentrated liquidity pools
 	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
 	// Params returns concentrated liquidity module params.
@@ -1941,6 +2726,13 @@ type QueryClient interface {
 	// ClaimableIncentives returns the amount of incentives that can be claimed
 	// and how many would be forfeited by a position with the given id.
 	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
+	// PendingSpreadRewards returns the amount of spread rewards that can be
+	// claimed by all positions of an owner in a pool with the given tick range.
+	PendingSpreadRewards(ctx context.Context, in *PendingSpreadRewardsRequest, opts ...grpc.CallOption) (*PendingSpreadRewardsResponse, error)
+	// PendingIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by all positions of an owner in a pool
+	// with the given tick range.
+	PendingIncentives(ctx context.Context, in *PendingIncentivesRequest, opts ...grpc.CallOption) (*PendingIncentivesResponse, error)
 	// PositionById returns a position with the given id.
 	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
 	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
@@ -1951,6 +2743,9 @@ type QueryClient interface {
 	// TickAccumulatorTrackers returns the tick accumulator trackers.
 	// Contains spread factor and uptime accumulator trackers.
 	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
+	// ExportTicks returns every initialized tick of the given pool in
+	// ascending tick index order, with its liquidity and accumulator trackers.
+	ExportTicks(ctx context.Context, in *ExportTicksRequest, opts ...grpc.CallOption) (*ExportTicksResponse, error)
 	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
 	// pool that is linked with the given concentrated pool.
 	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
@@ -1962,6 +2757,16 @@ type QueryClient interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// StopCondition returns the stop condition registered for the given
+	// position and whether it is currently met.
+	StopCondition(ctx context.Context, in *StopConditionRequest, opts ...grpc.CallOption) (*StopConditionResponse, error)
+	// StopConditionsByPool returns all stop conditions registered for positions
+	// in the given pool.
+	StopConditionsByPool(ctx context.Context, in *StopConditionsByPoolRequest, opts ...grpc.CallOption) (*StopConditionsByPoolResponse, error)
+	// SpreadRewardGrowthSaturatedPools returns the pools whose spread reward
+	// growth saturated at the maximum spread reward growth per unit of
+	// liquidity, along with the saturated denoms.
+	SpreadRewardGrowthSaturatedPools(ctx context.Context, in *SpreadRewardGrowthSaturatedPoolsRequest, opts ...grpc.CallOption) (*SpreadRewardGrowthSaturatedPoolsResponse, error)
 }
 
 type queryClient struct {
@@ -2035,6 +2840,24 @@ func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableInce
 	return out, nil
 }
 
+func (c *queryClient) PendingSpreadRewards(ctx context.Context, in *PendingSpreadRewardsRequest, opts ...grpc.CallOption) (*PendingSpreadRewardsResponse, error) {
+	out := new(PendingSpreadRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PendingSpreadRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingIncentives(ctx context.Context, in *PendingIncentivesRequest, opts ...grpc.CallOption) (*PendingIncentivesResponse, error) {
+	out := new(PendingIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PendingIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
 	out := new(PositionByIdResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
@@ -2071,6 +2894,15 @@ func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccum
 	return out, nil
 }
 
+func (c *queryClient) ExportTicks(ctx context.Context, in *ExportTicksRequest, opts ...grpc.CallOption) (*ExportTicksResponse, error) {
+	out := new(ExportTicksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ExportTicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
 	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
@@ -2107,6 +2939,33 @@ func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextIn
 	return out, nil
 }
 
+func (c *queryClient) StopCondition(ctx context.Context, in *StopConditionRequest, opts ...grpc.CallOption) (*StopConditionResponse, error) {
+	out := new(StopConditionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/StopCondition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StopConditionsByPool(ctx context.Context, in *StopConditionsByPoolRequest, opts ...grpc.CallOption) (*StopConditionsByPoolResponse, error) {
+	out := new(StopConditionsByPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/StopConditionsByPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpreadRewardGrowthSaturatedPools(ctx context.Context, in *SpreadRewardGrowthSaturatedPoolsRequest, opts ...grpc.CallOption) (*SpreadRewardGrowthSaturatedPoolsResponse, error) {
+	out := new(SpreadRewardGrowthSaturatedPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/SpreadRewardGrowthSaturatedPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Pools returns all concentrated liquidity pools
@@ -2128,6 +2987,13 @@ type QueryServer interface {
 	// ClaimableIncentives returns the amount of incentives that can be claimed
 	// and how many would be forfeited by a position with the given id.
 	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
+	// PendingSpreadRewards returns the amount of spread rewards that can be
+	// claimed by all positions of an owner in a pool with the given tick range.
+	PendingSpreadRewards(context.Context, *PendingSpreadRewardsRequest) (*PendingSpreadRewardsResponse, error)
+	// PendingIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by all positions of an owner in a pool
+	// with the given tick range.
+	PendingIncentives(context.Context, *PendingIncentivesRequest) (*PendingIncentivesResponse, error)
 	// PositionById returns a position with the given id.
 	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
 	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
@@ -2138,6 +3004,9 @@ type QueryServer interface {
 	// TickAccumulatorTrackers returns the tick accumulator trackers.
 	// Contains spread factor and uptime accumulator trackers.
 	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
+	// ExportTicks returns every initialized tick of the given pool in
+	// ascending tick index order, with its liquidity and accumulator trackers.
+	ExportTicks(context.Context, *ExportTicksRequest) (*ExportTicksResponse, error)
 	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
 	// pool that is linked with the given concentrated pool.
 	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
@@ -2149,6 +3018,16 @@ type QueryServer interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// StopCondition returns the stop condition registered for the given
+	// position and whether it is currently met.
+	StopCondition(context.Context, *StopConditionRequest) (*StopConditionResponse, error)
+	// StopConditionsByPool returns all stop conditions registered for positions
+	// in the given pool.
+	StopConditionsByPool(context.Context, *StopConditionsByPoolRequest) (*StopConditionsByPoolResponse, error)
+	// SpreadRewardGrowthSaturatedPools returns the pools whose spread reward
+	// growth saturated at the maximum spread reward growth per unit of
+	// liquidity, along with the saturated denoms.
+	SpreadRewardGrowthSaturatedPools(context.Context, *SpreadRewardGrowthSaturatedPoolsRequest) (*SpreadRewardGrowthSaturatedPoolsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2176,6 +3055,12 @@ func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req
 func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
 }
+func (*UnimplementedQueryServer) PendingSpreadRewards(ctx context.Context, req *PendingSpreadRewardsRequest) (*PendingSpreadRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSpreadRewards not implemented")
+}
+func (*UnimplementedQueryServer) PendingIncentives(ctx context.Context, req *PendingIncentivesRequest) (*PendingIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingIncentives not implemented")
+}
 func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
 }
@@ -2188,6 +3073,9 @@ func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *Ince
 func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
 }
+func (*UnimplementedQueryServer) ExportTicks(ctx context.Context, req *ExportTicksRequest) (*ExportTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTicks not implemented")
+}
 func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
 }
@@ -2200,6 +3088,15 @@ func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *Get
 func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
 }
+func (*UnimplementedQueryServer) StopCondition(ctx context.Context, req *StopConditionRequest) (*StopConditionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopCondition not implemented")
+}
+func (*UnimplementedQueryServer) StopConditionsByPool(ctx context.Context, req *StopConditionsByPoolRequest) (*StopConditionsByPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopConditionsByPool not implemented")
+}
+func (*UnimplementedQueryServer) SpreadRewardGrowthSaturatedPools(ctx context.Context, req *SpreadRewardGrowthSaturatedPoolsRequest) (*SpreadRewardGrowthSaturatedPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpreadRewardGrowthSaturatedPools not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2331,20 +3228,56 @@ func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PositionByIdRequest)
+func _Query_PendingSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingSpreadRewardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).PositionById(ctx, in)
+		return srv.(QueryServer).PendingSpreadRewards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PendingSpreadRewards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
+		return srv.(QueryServer).PendingSpreadRewards(ctx, req.(*PendingSpreadRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PendingIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingIncentives(ctx, req.(*PendingIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2403,6 +3336,24 @@ func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExportTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExportTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ExportTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExportTicks(ctx, req.(*ExportTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
 	if err := dec(in); err != nil {
@@ -2475,6 +3426,61 @@ func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StopCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StopCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/StopCondition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StopCondition(ctx, req.(*StopConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StopConditionsByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopConditionsByPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StopConditionsByPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/StopConditionsByPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StopConditionsByPool(ctx, req.(*StopConditionsByPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpreadRewardGrowthSaturatedPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpreadRewardGrowthSaturatedPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpreadRewardGrowthSaturatedPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/SpreadRewardGrowthSaturatedPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpreadRewardGrowthSaturatedPools(ctx, req.(*SpreadRewardGrowthSaturatedPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2507,6 +3513,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimableIncentives",
 			Handler:    _Query_ClaimableIncentives_Handler,
 		},
+		{
+			MethodName: "PendingSpreadRewards",
+			Handler:    _Query_PendingSpreadRewards_Handler,
+		},
+		{
+			MethodName: "PendingIncentives",
+			Handler:    _Query_PendingIncentives_Handler,
+		},
 		{
 			MethodName: "PositionById",
 			Handler:    _Query_PositionById_Handler,
@@ -2523,6 +3537,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TickAccumulatorTrackers",
 			Handler:    _Query_TickAccumulatorTrackers_Handler,
 		},
+		{
+			MethodName: "ExportTicks",
+			Handler:    _Query_ExportTicks_Handler,
+		},
 		{
 			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
 			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
@@ -2539,6 +3557,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NumNextInitializedTicks",
 			Handler:    _Query_NumNextInitializedTicks_Handler,
 		},
+		{
+			MethodName: "StopCondition",
+			Handler:    _Query_StopCondition_Handler,
+		},
+		{
+			MethodName: "StopConditionsByPool",
+			Handler:    _Query_StopConditionsByPool_Handler,
+		},
+		{
+			MethodName: "SpreadRewardGrowthSaturatedPools",
+			Handler:    _Query_SpreadRewardGrowthSaturatedPools_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
@@ -3319,7 +4349,7 @@ func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *PendingSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3329,16 +4359,33 @@ func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3347,7 +4394,7 @@ func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PendingSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3357,34 +4404,20 @@ func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingSpreadRewards) > 0 {
+		for iNdEx := len(m.PendingSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3398,7 +4431,7 @@ func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
+func (m *PendingIncentivesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3408,20 +4441,32 @@ func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
@@ -3431,7 +4476,7 @@ func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
+func (m *PendingIncentivesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3441,20 +4486,20 @@ func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UptimeTrackers) > 0 {
-		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ForfeitedIncentives) > 0 {
+		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3465,10 +4510,10 @@ func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingIncentives) > 0 {
+		for iNdEx := len(m.PendingIncentives) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3482,7 +4527,7 @@ func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3492,28 +4537,16 @@ func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3522,7 +4555,7 @@ func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3532,32 +4565,34 @@ func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.IncentiveRecords) > 0 {
-		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3571,7 +4606,7 @@ func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3581,53 +4616,30 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConcentratedPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x10
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CfmmPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3637,27 +4649,48 @@ func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.UptimeTrackers) > 0 {
+		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ExportTicksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3667,34 +4700,37 @@ func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExportTicksRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExportTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *ExportedTick) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3704,20 +4740,73 @@ func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExportedTick) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExportedTick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.UptimeTrackers) > 0 {
+		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *ExportTicksResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3727,20 +4816,32 @@ func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExportTicksResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExportTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3754,7 +4855,7 @@ func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
+func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3764,25 +4865,25 @@ func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NumNextInitializedTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumNextInitializedTicks != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumNextInitializedTicks))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3794,7 +4895,7 @@ func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
+func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3804,35 +4905,32 @@ func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NumNextInitializedTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IncentiveRecords) > 0 {
+		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3846,401 +4944,540 @@ func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+	if m.ConcentratedPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NumPoolPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumPoolPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PositionCount != 0 {
-		n += 1 + sovQuery(uint64(m.PositionCount))
+	if m.CfmmPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TickLiquidityNet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LiquidityNet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	if len(m.TotalLiquidity) > 0 {
+		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityDepthWithRange) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NumNextInitializedTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LowerTick != 0 {
-		n += 1 + sovQuery(uint64(m.LowerTick))
+	if m.NumNextInitializedTicks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumNextInitializedTicks))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.UpperTick != 0 {
-		n += 1 + sovQuery(uint64(m.UpperTick))
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityNetInDirectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NumNextInitializedTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.StartTick != 0 {
-		n += 1 + sovQuery(uint64(m.StartTick))
-	}
-	if m.UseCurTick {
-		n += 2
-	}
-	if m.BoundTick != 0 {
-		n += 1 + sovQuery(uint64(m.BoundTick))
-	}
-	if m.UseNoBound {
-		n += 2
-	}
-	return n
-}
-
-func (m *LiquidityNetInDirectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
 	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
-	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentSqrtPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityPerTickRangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *StopConditionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidityPerTickRangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.BucketIndex != 0 {
-		n += 1 + sovQuery(uint64(m.BucketIndex))
-	}
-	return n
+func (m *StopConditionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StopConditionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClaimableSpreadRewards) > 0 {
-		for _, e := range m.ClaimableSpreadRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *StopConditionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClaimableIncentivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
-	}
-	return n
+func (m *StopConditionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StopConditionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ClaimableIncentives) > 0 {
-		for _, e := range m.ClaimableIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Met {
+		i--
+		if m.Met {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ForfeitedIncentives) > 0 {
-		for _, e := range m.ForfeitedIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.StopCondition.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StopConditionsByPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StopConditionsByPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopConditionsByPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StopConditionsByPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StopConditionsByPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopConditionsByPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for _, e := range m.SpreadRewardGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for _, e := range m.UptimeGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.StopConditions) > 0 {
+		for iNdEx := len(m.StopConditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StopConditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpreadRewardGrowthSaturatedPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *SpreadRewardGrowthSaturatedPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpreadRewardGrowthSaturatedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.UptimeTrackers) > 0 {
-		for _, e := range m.UptimeTrackers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsRequest) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
@@ -4251,14 +5488,14 @@ func (m *IncentiveRecordsRequest) Size() (n int) {
 	return n
 }
 
-func (m *IncentiveRecordsResponse) Size() (n int) {
+func (m *UserPositionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.IncentiveRecords) > 0 {
-		for _, e := range m.IncentiveRecords {
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -4270,59 +5507,86 @@ func (m *IncentiveRecordsResponse) Size() (n int) {
 	return n
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
+func (m *PositionByIdRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ConcentratedPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
 	}
 	return n
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
+func (m *PositionByIdResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CfmmPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
 	}
 	return n
 }
 
-func (m *UserUnbondingPositionsRequest) Size() (n int) {
+func (m *NumPoolPositionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
+	if m.PositionCount != 0 {
+		n += 1 + sovQuery(uint64(m.PositionCount))
+	}
+	return n
+}
+
+func (m *PoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *UserUnbondingPositionsResponse) Size() (n int) {
+func (m *PoolsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for _, e := range m.PositionsWithPeriodLock {
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *GetTotalLiquidityRequest) Size() (n int) {
+func (m *ParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4331,22 +5595,49 @@ func (m *GetTotalLiquidityRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetTotalLiquidityResponse) Size() (n int) {
+func (m *ParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for _, e := range m.TotalLiquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TickLiquidityNet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
 	}
 	return n
 }
 
-func (m *NumNextInitializedTicksRequest) Size() (n int) {
+func (m *LiquidityDepthWithRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4355,17 +5646,26 @@ func (m *NumNextInitializedTicksRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
-	l = len(m.TokenInDenom)
+	l = len(m.TokenIn)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.NumNextInitializedTicks != 0 {
-		n += 1 + sovQuery(uint64(m.NumNextInitializedTicks))
+	if m.StartTick != 0 {
+		n += 1 + sovQuery(uint64(m.StartTick))
+	}
+	if m.UseCurTick {
+		n += 2
+	}
+	if m.BoundTick != 0 {
+		n += 1 + sovQuery(uint64(m.BoundTick))
+	}
+	if m.UseNoBound {
+		n += 2
 	}
 	return n
 }
 
-func (m *NumNextInitializedTicksResponse) Size() (n int) {
+func (m *LiquidityNetInDirectionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4382,49 +5682,2131 @@ func (m *NumNextInitializedTicksResponse) Size() (n int) {
 	}
 	l = m.CurrentLiquidity.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *LiquidityPerTickRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
 }
-func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *LiquidityPerTickRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+	}
+	if m.BucketIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BucketIndex))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableSpreadRewards) > 0 {
+		for _, e := range m.ClaimableSpreadRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableIncentives) > 0 {
+		for _, e := range m.ClaimableIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingSpreadRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *PendingSpreadRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSpreadRewards) > 0 {
+		for _, e := range m.PendingSpreadRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *PendingIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingIncentives) > 0 {
+		for _, e := range m.PendingIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for _, e := range m.SpreadRewardGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for _, e := range m.UptimeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeTrackers) > 0 {
+		for _, e := range m.UptimeTrackers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExportTicksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExportedTick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeTrackers) > 0 {
+		for _, e := range m.UptimeTrackers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExportTicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IncentiveRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IncentiveRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentiveRecords) > 0 {
+		for _, e := range m.IncentiveRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConcentratedPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for _, e := range m.PositionsWithPeriodLock {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for _, e := range m.TotalLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NumNextInitializedTicksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumNextInitializedTicks != 0 {
+		n += 1 + sovQuery(uint64(m.NumNextInitializedTicks))
+	}
+	return n
+}
+
+func (m *NumNextInitializedTicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StopConditionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *StopConditionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StopCondition.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Met {
+		n += 2
+	}
+	return n
+}
+
+func (m *StopConditionsByPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *StopConditionsByPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StopConditions) > 0 {
+		for _, e := range m.StopConditions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *SpreadRewardGrowthSaturatedPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionCount", wireType)
+			}
+			m.PositionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseCurTick = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+			}
+			m.BoundTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoundTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseNoBound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4434,48 +7816,31 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
 			}
-			var msglen int
+			m.BucketIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4485,28 +7850,11 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BucketIndex |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4528,7 +7876,7 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4551,17 +7899,17 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			var msglen int
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4571,29 +7919,64 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4620,10 +8003,8 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
+			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4648,7 +8029,7 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4671,10 +8052,10 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4717,7 +8098,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4740,15 +8121,15 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4775,7 +8156,42 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
+			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4800,7 +8216,7 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4819,21 +8235,91 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolPositionsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSpreadRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
 			}
-			m.PoolId = 0
+			m.UpperTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4843,7 +8329,7 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.UpperTick |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4869,7 +8355,7 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4892,17 +8378,17 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSpreadRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSpreadRewards", wireType)
 			}
-			m.PositionCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4912,11 +8398,26 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSpreadRewards = append(m.PendingSpreadRewards, types2.Coin{})
+			if err := m.PendingSpreadRewards[len(m.PendingSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4938,7 +8439,7 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4961,17 +8462,36 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4981,28 +8501,62 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5024,7 +8578,7 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5047,15 +8601,15 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5082,14 +8636,14 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingIncentives = append(m.PendingIncentives, types2.Coin{})
+			if err := m.PendingIncentives[len(m.PendingIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5116,10 +8670,8 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5144,7 +8696,7 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5167,12 +8719,31 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5194,7 +8765,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5217,15 +8788,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5252,7 +8823,42 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
+			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5277,7 +8883,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5300,17 +8906,17 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5320,26 +8926,11 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
@@ -5380,7 +8971,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5403,17 +8994,17 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5423,31 +9014,31 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
 			}
-			m.LowerTick = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5457,30 +9048,26 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowerTick |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.UpperTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpperTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
+			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5502,7 +9089,7 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportTicksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5525,10 +9112,10 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportTicksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5552,9 +9139,9 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5564,102 +9151,28 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
-			}
-			m.StartTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseCurTick = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
-			}
-			m.BoundTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BoundTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UseNoBound = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5681,7 +9194,7 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+func (m *ExportedTick) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5704,17 +9217,17 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportedTick: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportedTick: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
 			}
-			var msglen int
+			m.TickIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5724,31 +9237,16 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TickIndex |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
 			}
-			m.CurrentTick = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5758,14 +9256,29 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5793,15 +9306,15 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5811,81 +9324,31 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5895,11 +9358,26 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
+			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5921,7 +9399,7 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
+func (m *ExportTicksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5944,15 +9422,15 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportTicksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5979,16 +9457,16 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ticks = append(m.Ticks, ExportedTick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.BucketIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5998,11 +9476,28 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketIndex |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6024,7 +9519,7 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6047,17 +9542,17 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6067,64 +9562,14 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6151,8 +9596,10 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
-			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6177,7 +9624,7 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6200,17 +9647,17 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
 			}
-			m.PositionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6220,11 +9667,62 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6246,7 +9744,7 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6269,51 +9767,17 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
-			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
 			}
-			var msglen int
+			m.ConcentratedPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6323,26 +9787,11 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
-			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6364,7 +9813,7 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6387,17 +9836,17 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
 			}
-			m.PoolId = 0
+			m.CfmmPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6407,7 +9856,7 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.CfmmPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6433,7 +9882,7 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6456,17 +9905,17 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6476,29 +9925,77 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6525,8 +10022,8 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
-			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
+			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6551,7 +10048,7 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6574,50 +10071,12 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
-			}
-			m.TickIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6639,7 +10098,7 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6662,49 +10121,15 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6731,8 +10156,8 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
-			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
+			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6757,7 +10182,7 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6780,10 +10205,10 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6807,9 +10232,9 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6819,28 +10244,43 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NumNextInitializedTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6862,7 +10302,7 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6885,15 +10325,15 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6920,16 +10360,35 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
-			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6939,25 +10398,23 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6982,7 +10439,7 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *StopConditionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7005,17 +10462,17 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopConditionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopConditionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.ConcentratedPoolId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7025,7 +10482,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7051,7 +10508,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *StopConditionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7074,17 +10531,50 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopConditionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopConditionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopCondition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopCondition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Met", wireType)
 			}
-			m.CfmmPoolId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7094,11 +10584,12 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CfmmPoolId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Met = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7120,7 +10611,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *StopConditionsByPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7143,17 +10634,17 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopConditionsByPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopConditionsByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7163,24 +10654,11 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7202,7 +10680,7 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *StopConditionsByPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7225,15 +10703,15 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopConditionsByPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopConditionsByPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopConditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7260,8 +10738,8 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
-			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StopConditions = append(m.StopConditions, types1.StopCondition{})
+			if err := m.StopConditions[len(m.StopConditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7286,57 +10764,7 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *SpreadRewardGrowthSaturatedPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7359,17 +10787,17 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpreadRewardGrowthSaturatedPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpreadRewardGrowthSaturatedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7379,26 +10807,11 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
-			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7420,7 +10833,7 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
+func (m *SpreadRewardGrowthSaturatedPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7443,10 +10856,10 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpreadRewardGrowthSaturatedPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpreadRewardGrowthSaturatedPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7470,7 +10883,7 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7498,27 +10911,8 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
-			}
-			m.NumNextInitializedTicks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7540,7 +10934,7 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
+func (m *SpreadRewardGrowthSaturatedPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7563,15 +10957,15 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpreadRewardGrowthSaturatedPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpreadRewardGrowthSaturatedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7598,61 +10992,8 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
-			}
-			m.CurrentTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, SpreadRewardGrowthSaturatedPool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
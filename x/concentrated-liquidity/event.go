@@ -58,3 +58,64 @@ func (l *liquidityChangeEvent) emit(ctx sdk.Context) {
 		))
 	}
 }
+
+// guarantee that swapExecutionEvent type implements the event interface
+var _ event = &swapExecutionEvent{}
+
+// swapExecutionEvent represents the execution of a swap through a pool, so that its execution quality can be audited
+// without re-deriving it from the token deltas. It holds:
+// - the tokens in, including the spread factor charged, and the tokens out.
+// - the spread factor charged, in token in terms.
+// The taker fee is charged by x/poolmanager before the swap reaches the pool, so it is excluded from all of these.
+// - the pool's quote denom (token1) and its current tick after the swap.
+type swapExecutionEvent struct {
+	sender      sdk.AccAddress
+	poolId      uint64
+	quoteDenom  string
+	tokenIn     sdk.Coin
+	tokenOut    sdk.Coin
+	spreadFee   sdk.Coin
+	currentTick int64
+}
+
+// emit emits an event for the execution of a swap, along with its effective price and the quote equivalent of its spread fee.
+// The fee attributes are suffixed with "only" to make it explicit that they exclude the taker fee.
+func (s *swapExecutionEvent) emit(ctx sdk.Context) {
+	if s != nil {
+		effectivePrice := s.effectivePrice()
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSwapExecution,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, s.sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(s.poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, s.tokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, s.tokenOut.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectivePrice, effectivePrice.String()),
+			sdk.NewAttribute(types.AttributeKeySpreadFeeOnly, s.spreadFee.String()),
+			sdk.NewAttribute(types.AttributeKeySpreadFeeOnlyQuoteEquivalent, s.spreadFeeQuoteEquivalent(effectivePrice).String()),
+			sdk.NewAttribute(types.AttributeKeyCurrentTick, strconv.FormatInt(s.currentTick, 10)),
+		))
+	}
+}
+
+// effectivePrice returns the price the swap was executed at, spread factor included but taker fee excluded, in quote
+// denom per unit of the pool's other denom, i.e. in the same terms as the pool's spot price.
+// Returns zero if no tokens were swapped in the denominator's denom.
+func (s *swapExecutionEvent) effectivePrice() osmomath.BigDec {
+	quoteAmount, baseAmount := s.tokenOut.Amount, s.tokenIn.Amount
+	if s.tokenIn.Denom == s.quoteDenom {
+		quoteAmount, baseAmount = s.tokenIn.Amount, s.tokenOut.Amount
+	}
+	if baseAmount.IsZero() {
+		return osmomath.ZeroBigDec()
+	}
+	return osmomath.BigDecFromSDKInt(quoteAmount).Quo(osmomath.BigDecFromSDKInt(baseAmount))
+}
+
+// spreadFeeQuoteEquivalent returns the spread fee valued in the quote denom at the effective price of the swap.
+func (s *swapExecutionEvent) spreadFeeQuoteEquivalent(effectivePrice osmomath.BigDec) sdk.DecCoin {
+	if s.spreadFee.Denom == s.quoteDenom {
+		return sdk.NewDecCoinFromDec(s.quoteDenom, s.spreadFee.Amount.ToLegacyDec())
+	}
+	return sdk.NewDecCoinFromDec(s.quoteDenom, effectivePrice.Mul(osmomath.BigDecFromSDKInt(s.spreadFee.Amount)).Dec())
+}
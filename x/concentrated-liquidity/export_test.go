@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/swapstrategy"
@@ -365,3 +366,13 @@ func ScaleDownSpreadRewardAmount(incentiveAmount osmomath.Int, scalingFactor osm
 func (k Keeper) RedepositForfeitedIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, scaledForfeitedIncentivesByUptime []sdk.Coins, totalForefeitedIncentives sdk.Coins) error {
 	return k.redepositForfeitedIncentives(ctx, poolId, owner, scaledForfeitedIncentivesByUptime, totalForefeitedIncentives)
 }
+
+func (k Keeper) RecordStopConditionTick(ctx sdk.Context, poolId uint64, tick int64) {
+	k.recordStopConditionTick(ctx, poolId, tick)
+}
+
+func (k Keeper) GetStopConditionTicks(ctx sdk.Context, poolId uint64) ([]int64, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyStopConditionTicks(poolId), func(bz []byte) (int64, error) {
+		return parseStopConditionTick(bz), nil
+	})
+}
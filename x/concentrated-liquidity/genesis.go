@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	types "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types/genesis"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 )
 
 // InitGenesis initializes the concentrated-liquidity module with the provided genesis state.
@@ -68,6 +69,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		// set pool hook contracts
+		for _, hookContract := range poolData.PoolHookContracts {
+			err = k.setPoolHookContract(ctx, poolId, hookContract.ActionPrefix, hookContract.ContractAddress)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		for _, denom := range poolData.SpreadRewardGrowthSaturatedDenoms {
+			k.markSpreadRewardGrowthSaturated(ctx, poolId, denom)
+		}
 	}
 
 	// set positions for pool
@@ -76,29 +89,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 			panic(fmt.Sprintf("found position with pool id (%d) but there is no pool with such id that exists", positionWrapper.Position.PoolId))
 		}
 
-		err := k.SetPosition(ctx, positionWrapper.Position.PoolId, sdk.MustAccAddressFromBech32(positionWrapper.Position.Address), positionWrapper.Position.LowerTick, positionWrapper.Position.UpperTick, positionWrapper.Position.JoinTime, positionWrapper.Position.Liquidity, positionWrapper.Position.PositionId, positionWrapper.LockId)
-		if err != nil {
-			panic(err)
-		}
-
-		// set individual spread reward accumulator state position
-		spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, positionWrapper.Position.PoolId)
-		if err != nil {
-			panic(err)
-		}
-		spreadRewardPositionKey := types.KeySpreadRewardPositionAccumulator(positionWrapper.Position.PositionId)
-
-		k.initOrUpdateAccumPosition(ctx, spreadRewardAccumObject, positionWrapper.SpreadRewardAccumRecord.AccumValuePerShare, spreadRewardPositionKey, positionWrapper.SpreadRewardAccumRecord.NumShares, positionWrapper.SpreadRewardAccumRecord.UnclaimedRewardsTotal, positionWrapper.SpreadRewardAccumRecord.Options)
-
-		positionName := string(types.KeyPositionId(positionWrapper.Position.PositionId))
-		uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, positionWrapper.Position.PoolId)
-		if err != nil {
+		if err := k.initPositionData(ctx, positionWrapper); err != nil {
 			panic(err)
 		}
-
-		for uptimeIndex, uptimeRecord := range positionWrapper.UptimeAccumRecords {
-			k.initOrUpdateAccumPosition(ctx, uptimeAccumulators[uptimeIndex], uptimeRecord.AccumValuePerShare, positionName, uptimeRecord.NumShares, uptimeRecord.UnclaimedRewardsTotal, uptimeRecord.Options)
-		}
 	}
 
 	// set total liquidity
@@ -118,142 +111,201 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 	poolData := make([]genesis.PoolData, 0, len(pools))
 
 	for _, poolI := range pools {
-		poolI := poolI
-		any, err := codectypes.NewAnyWithValue(poolI)
+		poolDataForPool, err := k.exportPoolData(ctx, poolI)
 		if err != nil {
 			panic(err)
 		}
-		anyCopy := *any
+		poolData = append(poolData, poolDataForPool)
+	}
 
-		ticks, err := k.GetAllInitializedTicksForPool(ctx, poolI.GetId())
-		if err != nil {
-			panic(err)
-		}
-		accumObject, err := k.GetSpreadRewardAccumulator(ctx, poolI.GetId())
+	positions, err := k.getAllPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	positionData := make([]genesis.PositionData, 0, len(positions))
+	for _, position := range positions {
+		positionDataForPosition, err := k.exportPositionData(ctx, position.PositionId)
 		if err != nil {
 			panic(err)
 		}
+		positionData = append(positionData, positionDataForPosition)
+	}
 
-		totalShares := accumObject.GetTotalShares()
+	// Get the incentive pool ID migration threshold
+	incentivesAccumulatorPoolIDMigrationThreshold, err := k.GetIncentivePoolIDMigrationThreshold(ctx)
+	if err != nil {
+		panic(err)
+	}
 
-		spreadRewardAccumObject := genesis.AccumObject{
-			Name: types.KeySpreadRewardPoolAccumulator(poolI.GetId()),
-			AccumContent: &accum.AccumulatorContent{
-				AccumValue:  accumObject.GetValue(),
-				TotalShares: totalShares,
-			},
-		}
+	// Get the spread factor pool ID migration threshold
+	spreadFactorPoolIdMigrationThreshold, err := k.GetSpreadFactorPoolIDMigrationThreshold(ctx)
+	if err != nil {
+		panic(err)
+	}
 
-		poolId := poolI.GetId()
-		incentiveRecordsForPool, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
-		if err != nil {
-			panic(err)
-		}
+	return &genesis.GenesisState{
+		Params:                k.GetParams(ctx),
+		PoolData:              poolData,
+		PositionData:          positionData,
+		NextPositionId:        k.GetNextPositionId(ctx),
+		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
+		IncentivesAccumulatorPoolIdMigrationThreshold: incentivesAccumulatorPoolIDMigrationThreshold,
+		SpreadFactorPoolIdMigrationThreshold:          spreadFactorPoolIdMigrationThreshold,
+	}
+}
 
-		incentivesAccum, err := k.GetUptimeAccumulators(ctx, poolId)
-		if err != nil {
-			panic(err)
-		}
+// exportPoolData returns the genesis representation of the given pool, its initialized ticks,
+// accumulators, incentive records, pool hook contracts and saturated spread reward denoms.
+func (k Keeper) exportPoolData(ctx sdk.Context, poolI poolmanagertypes.PoolI) (genesis.PoolData, error) {
+	any, err := codectypes.NewAnyWithValue(poolI)
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
+	anyCopy := *any
+
+	poolId := poolI.GetId()
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
+	accumObject, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
 
-		incentivesAccumObject := make([]genesis.AccumObject, len(incentivesAccum))
-		for i, incentiveAccum := range incentivesAccum {
-			incentiveAccumTotalShares := incentiveAccum.GetTotalShares()
+	spreadRewardAccumObject := genesis.AccumObject{
+		Name: types.KeySpreadRewardPoolAccumulator(poolId),
+		AccumContent: &accum.AccumulatorContent{
+			AccumValue:  accumObject.GetValue(),
+			TotalShares: accumObject.GetTotalShares(),
+		},
+	}
 
-			genesisAccum := genesis.AccumObject{
-				Name: incentiveAccum.GetName(),
-				AccumContent: &accum.AccumulatorContent{
-					AccumValue:  incentiveAccum.GetValue(),
-					TotalShares: incentiveAccumTotalShares,
-				},
-			}
-			incentivesAccumObject[i] = genesisAccum
+	incentiveRecordsForPool, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
+
+	incentivesAccum, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
+
+	incentivesAccumObject := make([]genesis.AccumObject, len(incentivesAccum))
+	for i, incentiveAccum := range incentivesAccum {
+		incentivesAccumObject[i] = genesis.AccumObject{
+			Name: incentiveAccum.GetName(),
+			AccumContent: &accum.AccumulatorContent{
+				AccumValue:  incentiveAccum.GetValue(),
+				TotalShares: incentiveAccum.GetTotalShares(),
+			},
 		}
+	}
 
-		poolData = append(poolData, genesis.PoolData{
-			Pool:                    &anyCopy,
-			Ticks:                   ticks,
-			SpreadRewardAccumulator: spreadRewardAccumObject,
-			IncentivesAccumulators:  incentivesAccumObject,
-			IncentiveRecords:        incentiveRecordsForPool,
+	poolHookContracts := []genesis.PoolHookContract{}
+	for _, actionPrefix := range types.GetAllActionPrefixes() {
+		contractAddress := k.getPoolHookContract(ctx, poolId, actionPrefix)
+		if contractAddress == "" {
+			continue
+		}
+		poolHookContracts = append(poolHookContracts, genesis.PoolHookContract{
+			ActionPrefix:    actionPrefix,
+			ContractAddress: contractAddress,
 		})
 	}
 
-	positions, err := k.getAllPositions(ctx)
+	return genesis.PoolData{
+		Pool:                              &anyCopy,
+		Ticks:                             ticks,
+		SpreadRewardAccumulator:           spreadRewardAccumObject,
+		IncentivesAccumulators:            incentivesAccumObject,
+		IncentiveRecords:                  incentiveRecordsForPool,
+		PoolHookContracts:                 poolHookContracts,
+		SpreadRewardGrowthSaturatedDenoms: k.GetSpreadRewardGrowthSaturatedDenoms(ctx, poolId),
+	}, nil
+}
+
+// exportPositionData returns the genesis representation of the given position, its underlying lock
+// and its spread reward and uptime accumulator records.
+func (k Keeper) exportPositionData(ctx sdk.Context, positionId uint64) (genesis.PositionData, error) {
+	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
-		panic(err)
+		return genesis.PositionData{}, err
 	}
 
-	positionData := make([]genesis.PositionData, 0, len(positions))
-	for _, position := range positions {
-		position, err := k.GetPosition(ctx, position.PositionId)
-		if err != nil {
-			panic(err)
+	lockId, err := k.GetLockIdFromPositionId(ctx, positionId)
+	if err != nil {
+		if !errors.Is(err, types.PositionIdToLockNotFoundError{PositionId: positionId}) {
+			return genesis.PositionData{}, err
 		}
+	}
 
-		lockId, err := k.GetLockIdFromPositionId(ctx, position.PositionId)
-		if err != nil {
-			if !errors.Is(err, types.PositionIdToLockNotFoundError{PositionId: position.PositionId}) {
-				panic(err)
-			}
-		}
+	// Retrieve spread reward accumulator state for position
+	spreadRewardPositionKey := types.KeySpreadRewardPositionAccumulator(positionId)
+	spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
+	if err != nil {
+		return genesis.PositionData{}, err
+	}
+	spreadRewardAccumPositionRecord, err := spreadRewardAccumObject.GetPosition(spreadRewardPositionKey)
+	if err != nil {
+		return genesis.PositionData{}, err
+	}
 
-		// Retrieve spread reward accumulator state for position
-		spreadRewardPositionKey := types.KeySpreadRewardPositionAccumulator(position.PositionId)
-		spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
-		if err != nil {
-			panic(err)
-		}
-		spreadRewardAccumPositionRecord, err := spreadRewardAccumObject.GetPosition(spreadRewardPositionKey)
-		if err != nil {
-			panic(err)
-		}
+	// Retrieve uptime incentive accumulator state for position
+	positionName := string(types.KeyPositionId(positionId))
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, position.PoolId)
+	if err != nil {
+		return genesis.PositionData{}, err
+	}
 
-		// Retrieve uptime incentive accumulator state for position
-		positionName := string(types.KeyPositionId(position.PositionId))
-		uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, position.PoolId)
+	uptimeAccumObject := make([]accum.Record, len(uptimeAccumulators))
+	for uptimeIndex := range types.SupportedUptimes {
+		accumRecord, err := uptimeAccumulators[uptimeIndex].GetPosition(positionName)
 		if err != nil {
-			panic(err)
+			return genesis.PositionData{}, err
 		}
 
-		uptimeAccumObject := make([]accum.Record, len(uptimeAccumulators))
-		for uptimeIndex := range types.SupportedUptimes {
-			accumRecord, err := uptimeAccumulators[uptimeIndex].GetPosition(positionName)
-			if err != nil {
-				panic(err)
-			}
+		uptimeAccumObject[uptimeIndex] = accumRecord
+	}
 
-			uptimeAccumObject[uptimeIndex] = accumRecord
-		}
+	return genesis.PositionData{
+		LockId:                  lockId,
+		Position:                &position,
+		SpreadRewardAccumRecord: spreadRewardAccumPositionRecord,
+		UptimeAccumRecords:      uptimeAccumObject,
+	}, nil
+}
 
-		positionData = append(positionData, genesis.PositionData{
-			LockId:                  lockId,
-			Position:                &position,
-			SpreadRewardAccumRecord: spreadRewardAccumPositionRecord,
-			UptimeAccumRecords:      uptimeAccumObject,
-		})
+// initPositionData sets the given position, its underlying lock and its spread reward
+// and uptime accumulator records. The pool of the position must already be initialized.
+func (k Keeper) initPositionData(ctx sdk.Context, positionData genesis.PositionData) error {
+	position := positionData.Position
+	err := k.SetPosition(ctx, position.PoolId, sdk.MustAccAddressFromBech32(position.Address), position.LowerTick, position.UpperTick, position.JoinTime, position.Liquidity, position.PositionId, positionData.LockId)
+	if err != nil {
+		return err
 	}
 
-	// Get the incentive pool ID migration threshold
-	incentivesAccumulatorPoolIDMigrationThreshold, err := k.GetIncentivePoolIDMigrationThreshold(ctx)
+	// set individual spread reward accumulator state position
+	spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
 	if err != nil {
-		panic(err)
+		return err
 	}
+	spreadRewardPositionKey := types.KeySpreadRewardPositionAccumulator(position.PositionId)
 
-	// Get the spread factor pool ID migration threshold
-	spreadFactorPoolIdMigrationThreshold, err := k.GetSpreadFactorPoolIDMigrationThreshold(ctx)
+	k.initOrUpdateAccumPosition(ctx, spreadRewardAccumObject, positionData.SpreadRewardAccumRecord.AccumValuePerShare, spreadRewardPositionKey, positionData.SpreadRewardAccumRecord.NumShares, positionData.SpreadRewardAccumRecord.UnclaimedRewardsTotal, positionData.SpreadRewardAccumRecord.Options)
+
+	positionName := string(types.KeyPositionId(position.PositionId))
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, position.PoolId)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	return &genesis.GenesisState{
-		Params:                k.GetParams(ctx),
-		PoolData:              poolData,
-		PositionData:          positionData,
-		NextPositionId:        k.GetNextPositionId(ctx),
-		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
-		IncentivesAccumulatorPoolIdMigrationThreshold: incentivesAccumulatorPoolIDMigrationThreshold,
-		SpreadFactorPoolIdMigrationThreshold:          spreadFactorPoolIdMigrationThreshold,
+	for uptimeIndex, uptimeRecord := range positionData.UptimeAccumRecords {
+		k.initOrUpdateAccumPosition(ctx, uptimeAccumulators[uptimeIndex], uptimeRecord.AccumValuePerShare, positionName, uptimeRecord.NumShares, uptimeRecord.UnclaimedRewardsTotal, uptimeRecord.Options)
 	}
+
+	return nil
 }
 
 // initOrUpdateAccumPosition creates a new position or override an existing position
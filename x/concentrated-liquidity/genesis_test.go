@@ -839,6 +839,86 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	}
 }
 
+// TestGenesisRoundTrip creates live state by providing liquidity, swapping, emitting incentives and
+// registering a pool hook, exports it and imports it into a fresh app. It asserts that the state
+// exported after the import is identical and that all positions can claim the same rewards.
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(100, 0).UTC())
+
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.002"))
+	poolId := clPool.GetId()
+
+	s.FundAcc(clPool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(1000000))))
+	err := s.Clk.SetMultipleIncentiveRecords(s.Ctx, []types.IncentiveRecord{{
+		PoolId: poolId,
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingCoin: sdk.NewDecCoinFromDec(USDC, osmomath.NewDec(1000000)),
+			EmissionRate:  osmomath.NewDec(1),
+			StartTime:     s.Ctx.BlockTime(),
+		},
+		MinUptime: time.Nanosecond,
+	}})
+	s.Require().NoError(err)
+
+	positionIds := []uint64{
+		s.SetupDefaultPositionAcc(poolId, s.TestAccs[0]),
+		s.SetupFullRangePositionAcc(poolId, s.TestAccs[1]),
+		s.SetupConsecutiveRangePositionAcc(poolId, s.TestAccs[2]),
+	}
+
+	// Swap in both directions to accrue spread rewards and initialize tick accumulators.
+	s.swapOneForZeroRightWithSpread(poolId, sdk.NewCoin(USDC, osmomath.NewInt(1000000000)), clPool.GetSpreadFactor(s.Ctx))
+	s.swapZeroForOneLeftWithSpread(poolId, sdk.NewCoin(ETH, osmomath.NewInt(1000000)), clPool.GetSpreadFactor(s.Ctx))
+	s.AddBlockTime(time.Hour)
+
+	err = s.Clk.SetPoolHookContract(s.Ctx, poolId, types.BeforeActionPrefix(types.SwapExactAmountInPrefix), s.TestAccs[0].String())
+	s.Require().NoError(err)
+
+	claimableSpreadRewards := make([]sdk.Coins, len(positionIds))
+	claimableIncentives := make([]sdk.Coins, len(positionIds))
+	for i, positionId := range positionIds {
+		claimableSpreadRewards[i], err = s.Clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+		s.Require().NoError(err)
+		claimableIncentives[i], _, err = s.Clk.GetClaimableIncentives(s.Ctx, positionId)
+		s.Require().NoError(err)
+	}
+	s.Require().False(claimableSpreadRewards[0].IsZero())
+	s.Require().False(claimableIncentives[0].IsZero())
+
+	exported := s.Clk.ExportGenesis(s.Ctx)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.PositionData, len(positionIds))
+	s.Require().Len(exported.PoolData, 1)
+	s.Require().Equal([]genesis.PoolHookContract{{
+		ActionPrefix:    types.BeforeActionPrefix(types.SwapExactAmountInPrefix),
+		ContractAddress: s.TestAccs[0].String(),
+	}}, exported.PoolData[0].PoolHookContracts)
+
+	exportedBz, err := exported.Marshal()
+	s.Require().NoError(err)
+
+	// Import the exported state into a fresh app at the same block time.
+	blockTime := s.Ctx.BlockTime()
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.Clk.InitGenesis(s.Ctx, *exported)
+
+	reExportedBz, err := s.Clk.ExportGenesis(s.Ctx).Marshal()
+	s.Require().NoError(err)
+	s.Require().Equal(exportedBz, reExportedBz)
+
+	for i, positionId := range positionIds {
+		spreadRewards, err := s.Clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().Equal(claimableSpreadRewards[i], spreadRewards)
+
+		incentives, _, err := s.Clk.GetClaimableIncentives(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().Equal(claimableIncentives[i], incentives)
+	}
+}
+
 // TestMarshalUnmarshalGenesis tests the MarshalUnmarshalGenesis functions of the ConcentratedLiquidityKeeper.
 // It checks that the exported genesis can be marshaled and unmarshaled without panicking.
 func TestMarshalUnmarshalGenesis(t *testing.T) {
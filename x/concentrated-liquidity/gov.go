@@ -17,6 +17,8 @@ func (k Keeper) HandleCreateConcentratedLiquidityPoolsProposal(ctx sdk.Context,
 	poolCreatorAddress := poolmanagerModuleAcc.GetAddress()
 	for _, record := range p.PoolRecords {
 		createPoolMsg := clmodel.NewMsgCreateConcentratedPool(poolCreatorAddress, record.Denom0, record.Denom1, record.TickSpacing, record.SpreadFactor)
+		createPoolMsg.Name = record.Name
+		createPoolMsg.TickerPairing = record.TickerPairing
 		_, err := k.poolmanagerKeeper.CreateConcentratedPoolAsPoolManager(ctx, createPoolMsg)
 		if err != nil {
 			return err
@@ -30,6 +32,11 @@ func (k Keeper) HandleTickSpacingDecreaseProposal(ctx sdk.Context, p *types.Tick
 	return k.DecreaseConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
 }
 
+// HandleSetPoolMetadataProposal handles a set pool metadata proposal to the corresponding keeper method.
+func (k Keeper) HandleSetPoolMetadataProposal(ctx sdk.Context, p *types.SetPoolMetadataProposal) error {
+	return k.SetConcentratedPoolsMetadata(ctx, p.PoolIdToMetadataRecords)
+}
+
 func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
@@ -37,6 +44,8 @@ func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
 		case *types.CreateConcentratedLiquidityPoolsProposal:
 			return k.HandleCreateConcentratedLiquidityPoolsProposal(ctx, c)
+		case *types.SetPoolMetadataProposal:
+			return k.HandleSetPoolMetadataProposal(ctx, c)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
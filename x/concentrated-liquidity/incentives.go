@@ -930,6 +930,33 @@ func (k Keeper) GetClaimableIncentives(ctx sdk.Context, positionId uint64) (sdk.
 	return collectedIncentives, forfeitedIncentives, err
 }
 
+// GetPendingIncentives returns the total amount of incentives that all positions of the given
+// owner in the given pool and tick range are eligible to claim, as well as the amount that would
+// be forfeited if they were claimed now. Positions whose ticks do not match the range exactly are
+// ignored. No state is modified.
+//
+// Returns error if:
+// - pool with the given id does not exist
+// - other internal database or math errors.
+func (k Keeper) GetPendingIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (sdk.Coins, sdk.Coins, error) {
+	positions, err := k.getUserPositionsInRange(ctx, owner, poolId, lowerTick, upperTick)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pendingIncentives, forfeitedIncentives := sdk.NewCoins(), sdk.NewCoins()
+	for _, position := range positions {
+		claimable, forfeited, err := k.GetClaimableIncentives(ctx, position.PositionId)
+		if err != nil {
+			return nil, nil, err
+		}
+		pendingIncentives = pendingIncentives.Add(claimable...)
+		forfeitedIncentives = forfeitedIncentives.Add(forfeited...)
+	}
+
+	return pendingIncentives, forfeitedIncentives, nil
+}
+
 // collectIncentives collects incentives for all uptime accumulators for the specified position id.
 //
 // Upon successful collection, it bank sends the incentives from the pool address to the owner and returns the collected coins.
@@ -3136,6 +3136,66 @@ func (s *KeeperTestSuite) TestPrepareClaimAllIncentivesForPosition() {
 // at different times.
 // This is important because the final amount of incentives claimed depends on the last time when the pool
 // was updated. We use this time to calculate the amount of incentives to emit into the uptime accumulators.
+func (s *KeeperTestSuite) TestGetPendingIncentives() {
+	s.SetupTest()
+	owner, otherAcc := s.TestAccs[0], s.TestAccs[1]
+	defaultBlockTime := time.Unix(1, 1).UTC()
+	s.Ctx = s.Ctx.WithBlockTime(defaultBlockTime)
+
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	s.FundAcc(pool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(1000000))))
+	err := s.Clk.SetMultipleIncentiveRecords(s.Ctx, []types.IncentiveRecord{{
+		PoolId: poolId,
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingCoin: sdk.NewDecCoinFromDec(USDC, osmomath.NewDec(1000000)),
+			EmissionRate:  osmomath.NewDec(1), // 1 per second
+			StartTime:     defaultBlockTime,
+		},
+		MinUptime: time.Nanosecond,
+	}})
+	s.Require().NoError(err)
+
+	// Two positions of the owner in the default range are expected to be summed up.
+	// The owner's full range position and the other account's position in the same range are expected to be ignored.
+	ownerPositionIds := []uint64{s.SetupDefaultPositionAcc(poolId, owner), s.SetupDefaultPositionAcc(poolId, owner)}
+	s.SetupFullRangePositionAcc(poolId, owner)
+	s.SetupDefaultPositionAcc(poolId, otherAcc)
+
+	// Accrue incentives.
+	s.AddBlockTime(time.Hour)
+
+	expectedPendingIncentives, expectedForfeitedIncentives := sdk.NewCoins(), sdk.NewCoins()
+	for _, positionId := range ownerPositionIds {
+		claimable, forfeited, err := s.Clk.GetClaimableIncentives(s.Ctx, positionId)
+		s.Require().NoError(err)
+		expectedPendingIncentives = expectedPendingIncentives.Add(claimable...)
+		expectedForfeitedIncentives = expectedForfeitedIncentives.Add(forfeited...)
+	}
+	s.Require().False(expectedPendingIncentives.IsZero())
+
+	pendingIncentives, forfeitedIncentives, err := s.Clk.GetPendingIncentives(s.Ctx, poolId, owner, DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPendingIncentives, pendingIncentives)
+	s.Require().Equal(expectedForfeitedIncentives, forfeitedIncentives)
+
+	// Querying again yields the same result since no state is modified.
+	pendingIncentives, _, err = s.Clk.GetPendingIncentives(s.Ctx, poolId, owner, DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPendingIncentives, pendingIncentives)
+
+	// No positions in the given range.
+	pendingIncentives, forfeitedIncentives, err = s.Clk.GetPendingIncentives(s.Ctx, poolId, owner, DefaultLowerTick, DefaultUpperTick+int64(DefaultTickSpacing))
+	s.Require().NoError(err)
+	s.Require().True(pendingIncentives.IsZero())
+	s.Require().True(forfeitedIncentives.IsZero())
+
+	// Pool does not exist.
+	_, _, err = s.Clk.GetPendingIncentives(s.Ctx, poolId+1, owner, DefaultLowerTick, DefaultUpperTick)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: poolId + 1})
+}
+
 func (s *KeeperTestSuite) TestFunctional_ClaimIncentives_LiquidityChange_VaryingTime() {
 	s.runMultipleAuthorizedUptimes(func() {
 		// Init suite for the test.
@@ -433,6 +433,97 @@ func (s *KeeperTestSuite) TestIsPermissionlessPoolCreationEnabled() {
 	s.Require().False(s.App.ConcentratedLiquidityKeeper.IsPermissionlessPoolCreationEnabled(s.Ctx))
 }
 
+// TestIntegrationHarness tests the exported apptesting helpers that external consumers use to set up
+// concentrated liquidity pools with positions and move their price.
+func (s *KeeperTestSuite) TestIntegrationHarness() {
+	s.SetupTest()
+	accs := s.CreateFundedAccounts(2, DefaultCoins)
+	for _, acc := range accs {
+		s.Require().Equal(DefaultCoins, s.App.BankKeeper.GetAllBalances(s.Ctx, acc))
+	}
+
+	spreadFactor := osmomath.MustNewDecFromStr("0.0007")
+	pool := s.PrepareConcentratedPoolWithPositions(ETH, USDC, spreadFactor, DefaultCurrPrice, []apptesting.ConcentratedPosition{
+		{Owner: accs[0], Coins: DefaultCoins, LowerPrice: DefaultLowerPrice, UpperPrice: DefaultUpperPrice},
+		{Owner: accs[1], Coins: DefaultCoins},
+	})
+	s.Require().Equal(spreadFactor, pool.GetSpreadFactor(s.Ctx))
+	s.Require().Equal(DefaultCurrTick, pool.GetCurrentTick())
+
+	positions, err := s.Clk.GetUserPositions(s.Ctx, accs[0], pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(positions, 1)
+	s.Require().Equal(DefaultLowerTick, positions[0].LowerTick)
+	s.Require().Equal(DefaultUpperTick, positions[0].UpperTick)
+
+	positions, err = s.Clk.GetUserPositions(s.Ctx, accs[1], pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(positions, 1)
+	s.Require().Equal(DefaultMinTick, positions[0].LowerTick)
+	s.Require().Equal(DefaultMaxTick, positions[0].UpperTick)
+
+	// swap the price up and then back down. The pool stops at the target sqrt price up to rounding.
+	errTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.NewDecWithPrec(1, 12)}
+	for _, targetPrice := range []osmomath.BigDec{osmomath.NewBigDec(5100), osmomath.NewBigDec(4900)} {
+		swapper := apptesting.CreateRandomAccounts(1)[0]
+		tokenIn, tokenOut := s.SwapTo(pool.GetId(), swapper, targetPrice)
+		s.Require().True(tokenIn.IsPositive())
+		s.Require().True(tokenOut.IsPositive())
+
+		pool, err = s.Clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		s.Require().Equal(0, errTolerance.CompareBigDec(osmomath.MustMonotonicSqrtBigDec(targetPrice), pool.GetCurrentSqrtPrice()))
+	}
+}
+
+// TestConcentratedPositionTickRange tests that the tick range of an integration test position is its price range
+// rounded down to the tick spacing, the full range without a price range and an error for a half set or
+// non-positive price range.
+func (s *KeeperTestSuite) TestConcentratedPositionTickRange() {
+	tests := map[string]struct {
+		position          apptesting.ConcentratedPosition
+		expectedLowerTick int64
+		expectedUpperTick int64
+		expectedErr       bool
+	}{
+		"price range": {
+			position:          apptesting.ConcentratedPosition{LowerPrice: DefaultLowerPrice, UpperPrice: DefaultUpperPrice},
+			expectedLowerTick: DefaultLowerTick,
+			expectedUpperTick: DefaultUpperTick,
+		},
+		"no price range: full range": {
+			position:          apptesting.ConcentratedPosition{},
+			expectedLowerTick: DefaultMinTick,
+			expectedUpperTick: DefaultMaxTick,
+		},
+		"only lower price": {
+			position:    apptesting.ConcentratedPosition{LowerPrice: DefaultLowerPrice},
+			expectedErr: true,
+		},
+		"only upper price": {
+			position:    apptesting.ConcentratedPosition{UpperPrice: DefaultUpperPrice},
+			expectedErr: true,
+		},
+		"zero lower price": {
+			position:    apptesting.ConcentratedPosition{LowerPrice: osmomath.ZeroDec(), UpperPrice: DefaultUpperPrice},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			lowerTick, upperTick, err := tc.position.TickRange(DefaultTickSpacing)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedLowerTick, lowerTick)
+			s.Require().Equal(tc.expectedUpperTick, upperTick)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) runMultipleAuthorizedUptimes(tests func()) {
 	authorizedUptimesTested := [][]time.Duration{
 		DefaultAuthorizedUptimes,
@@ -308,6 +308,11 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 			return osmomath.Int{}, osmomath.Int{}, err
 		}
 
+		// A stop condition can not outlive its position, so refund its bounty.
+		if err := k.removeStopConditionAndRefund(ctx, positionId); err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+
 		// Note that here we currently use the iterator based definition to search
 		// for a remaining position in the pool. Since we have removed a position we need to
 		// search if there are more.
@@ -44,9 +44,7 @@ func (msg MsgCreateConcentratedPool) ValidateBasic() error {
 		return fmt.Errorf("Invalid sender address (%s)", err)
 	}
 
-	if msg.TickSpacing <= 0 {
-		return fmt.Errorf("tick spacing must be positive")
-	}
+	// A zero tick spacing is resolved to the default tick spacing of the spread factor on pool initialization.
 
 	if msg.Denom0 == msg.Denom1 {
 		return cltypes.MatchingDenomError{Denom: msg.Denom0}
@@ -65,6 +63,10 @@ func (msg MsgCreateConcentratedPool) ValidateBasic() error {
 		return cltypes.InvalidSpreadFactorError{ActualSpreadFactor: spreadFactor}
 	}
 
+	if err := cltypes.ValidatePoolMetadataFields(msg.Name, msg.TickerPairing); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -96,7 +98,15 @@ func (msg MsgCreateConcentratedPool) InitialLiquidity() sdk.Coins {
 
 func (msg MsgCreateConcentratedPool) CreatePool(ctx sdk.Context, poolID uint64) (poolmanagertypes.PoolI, error) {
 	poolI, err := NewConcentratedLiquidityPool(poolID, msg.Denom0, msg.Denom1, msg.TickSpacing, msg.SpreadFactor)
-	return &poolI, err
+	if err != nil {
+		return &poolI, err
+	}
+	poolI.SetMetadata(cltypes.PoolMetadata{
+		Name:          msg.Name,
+		TickerPairing: msg.TickerPairing,
+		Creator:       msg.Sender,
+	})
+	return &poolI, nil
 }
 
 func (msg MsgCreateConcentratedPool) GetPoolType() poolmanagertypes.PoolType {
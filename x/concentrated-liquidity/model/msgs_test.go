@@ -1,6 +1,7 @@
 package model_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -98,6 +99,43 @@ func TestMsgCreateConcentratedPool(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "proper msg with metadata",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:        addr1,
+				Denom0:        ETH,
+				Denom1:        USDC,
+				TickSpacing:   DefaultTickSpacing,
+				SpreadFactor:  DefaultSpreadFactor,
+				Name:          "Ether / USD Coin",
+				TickerPairing: "ETH/USDC",
+			},
+			expectPass: true,
+		},
+		{
+			name: "name too long",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:       addr1,
+				Denom0:       ETH,
+				Denom1:       USDC,
+				TickSpacing:  DefaultTickSpacing,
+				SpreadFactor: DefaultSpreadFactor,
+				Name:         strings.Repeat("a", types.MaxPoolNameLength+1),
+			},
+			expectPass: false,
+		},
+		{
+			name: "ticker pairing too long",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:        addr1,
+				Denom0:        ETH,
+				Denom1:        USDC,
+				TickSpacing:   DefaultTickSpacing,
+				SpreadFactor:  DefaultSpreadFactor,
+				TickerPairing: strings.Repeat("a", types.MaxPoolTickerPairingLength+1),
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
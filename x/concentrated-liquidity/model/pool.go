@@ -168,6 +168,11 @@ func (p Pool) GetLastLiquidityUpdate() time.Time {
 	return p.LastLiquidityUpdate
 }
 
+// GetMetadata returns the pool's descriptive metadata.
+func (p Pool) GetMetadata() types.PoolMetadata {
+	return p.Metadata
+}
+
 func (p Pool) GetType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Concentrated
 }
@@ -197,6 +202,11 @@ func (p *Pool) SetLastLiquidityUpdate(newTime time.Time) {
 	p.LastLiquidityUpdate = newTime
 }
 
+// SetMetadata updates the pool's descriptive metadata.
+func (p *Pool) SetMetadata(metadata types.PoolMetadata) {
+	p.Metadata = metadata
+}
+
 // updateLiquidityIfActivePosition updates the pool's liquidity if the position is active.
 // Returns true if updated, false otherwise.
 func (p *Pool) UpdateLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upperTick int64, liquidityDelta osmomath.Dec) bool {
@@ -16,6 +16,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	github_com_osmosis_labs_osmosis_osmomath "github.com/osmosis-labs/osmosis/osmomath"
+	types "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -58,6 +59,9 @@ type Pool struct {
 	// last_liquidity_update is the last time either the pool liquidity or the
 	// active tick changed
 	LastLiquidityUpdate time.Time `protobuf:"bytes,13,opt,name=last_liquidity_update,json=lastLiquidityUpdate,proto3,stdtime" json:"last_liquidity_update" yaml:"last_liquidity_update"`
+	// metadata is optional descriptive information about the pool, set at
+	// creation and editable by governance.
+	Metadata types.PoolMetadata `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata" yaml:"metadata"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -101,49 +105,51 @@ func init() {
 }
 
 var fileDescriptor_8b899353e6a19a1a = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0x8e, 0xf9, 0x2d, 0x1b, 0x40, 0x65, 0x09, 0xd4, 0xd0, 0x12, 0xa7, 0x96, 0x2a, 0xe5, 0x50,
-	0x6c, 0x42, 0xa5, 0x1e, 0xe8, 0x89, 0x08, 0x55, 0xaa, 0x14, 0xa9, 0x68, 0xa1, 0xaa, 0x54, 0x55,
-	0x72, 0x37, 0xf6, 0x62, 0x56, 0x71, 0xbc, 0xc6, 0xbb, 0xa1, 0xc0, 0x13, 0xf4, 0xc8, 0xb1, 0x47,
-	0x1e, 0xa2, 0x0f, 0x81, 0x7a, 0x29, 0xc7, 0xaa, 0x07, 0xb7, 0x82, 0x37, 0xc8, 0x13, 0x54, 0xde,
-	0x5d, 0x93, 0x20, 0x22, 0xb5, 0xa7, 0x64, 0xbe, 0x99, 0xef, 0xdb, 0x6f, 0xc6, 0xb3, 0x0b, 0x36,
-	0x18, 0xef, 0x32, 0x4e, 0xb9, 0xeb, 0xb3, 0xd8, 0x27, 0xb1, 0x48, 0xb1, 0x20, 0x41, 0x44, 0x8f,
-	0x7a, 0x34, 0xa0, 0xe2, 0xd4, 0x3d, 0x6e, 0xb4, 0x89, 0xc0, 0x0d, 0x37, 0x61, 0x2c, 0x72, 0x92,
-	0x94, 0x09, 0x06, 0x9f, 0x69, 0x86, 0x33, 0x92, 0xe1, 0x68, 0xc6, 0xea, 0x8a, 0x2f, 0xeb, 0x3c,
-	0x49, 0x72, 0x55, 0xa0, 0x14, 0x56, 0x2b, 0x21, 0x0b, 0x99, 0xc2, 0xf3, 0x7f, 0x1a, 0xb5, 0x42,
-	0xc6, 0xc2, 0x88, 0xb8, 0x32, 0x6a, 0xf7, 0x0e, 0x5c, 0x41, 0xbb, 0x84, 0x0b, 0xdc, 0x4d, 0x54,
-	0x81, 0xfd, 0x63, 0x1a, 0x4c, 0xec, 0x32, 0x16, 0xc1, 0xe7, 0x60, 0x1a, 0x07, 0x41, 0x4a, 0x38,
-	0x37, 0x8d, 0x9a, 0x51, 0x9f, 0x69, 0xc2, 0x7e, 0x66, 0xcd, 0x9f, 0xe2, 0x6e, 0xb4, 0x65, 0xeb,
-	0x84, 0x8d, 0x8a, 0x12, 0xd8, 0x02, 0x90, 0x4a, 0xa3, 0xf4, 0x98, 0x70, 0xaf, 0x20, 0x8e, 0x49,
-	0xe2, 0x5a, 0x3f, 0xb3, 0x56, 0x14, 0xf1, 0x7e, 0x8d, 0x8d, 0x16, 0x06, 0xe0, 0xb6, 0x56, 0x7b,
-	0x0f, 0x96, 0x79, 0x92, 0x12, 0x1c, 0x78, 0x29, 0xf9, 0x8c, 0xd3, 0x60, 0xa0, 0x38, 0x2e, 0x15,
-	0x9f, 0xf6, 0x33, 0x6b, 0x4d, 0x29, 0x8e, 0xae, 0xb3, 0x51, 0x45, 0x25, 0x90, 0xc2, 0x0b, 0xe1,
-	0x79, 0x30, 0x46, 0x03, 0x73, 0xa2, 0x66, 0xd4, 0x27, 0xd0, 0x18, 0x0d, 0xe0, 0x19, 0x58, 0xf6,
-	0x7b, 0x69, 0x4a, 0x62, 0xe1, 0x09, 0xea, 0x77, 0xbc, 0xdb, 0x09, 0x9b, 0x93, 0xf2, 0xa0, 0x9d,
-	0xcb, 0xcc, 0x2a, 0xfd, 0xca, 0xac, 0xc7, 0x6a, 0xb4, 0x3c, 0xe8, 0x38, 0x94, 0xb9, 0x5d, 0x2c,
-	0x0e, 0x9d, 0x16, 0x09, 0xb1, 0x7f, 0xba, 0x43, 0xfc, 0x81, 0x97, 0xd1, 0x52, 0x36, 0xaa, 0xe8,
-	0xc4, 0x3e, 0xf5, 0x3b, 0xad, 0x02, 0x86, 0xcb, 0x60, 0x4a, 0xb0, 0x0e, 0x89, 0x37, 0xcc, 0xa9,
-	0xfc, 0x2c, 0xa4, 0xa3, 0x5b, 0xbc, 0x61, 0x4e, 0x0f, 0xe1, 0x0d, 0x78, 0x06, 0x60, 0x71, 0x00,
-	0x3f, 0x4a, 0x85, 0x97, 0xa4, 0xd4, 0x27, 0xe6, 0x03, 0xe9, 0xb3, 0xa5, 0x7d, 0xba, 0x21, 0x15,
-	0x87, 0xbd, 0xb6, 0xe3, 0xb3, 0xae, 0xab, 0x37, 0x68, 0x3d, 0xc2, 0x6d, 0x5e, 0x04, 0xf2, 0x57,
-	0xda, 0x6f, 0xd2, 0x50, 0x79, 0x5f, 0x28, 0xe6, 0xc8, 0xb4, 0xa4, 0x8d, 0x1e, 0xea, 0x73, 0xf6,
-	0x8e, 0x52, 0xb1, 0x9b, 0x43, 0x70, 0x0b, 0xcc, 0x0e, 0x37, 0x67, 0xce, 0xd4, 0x8c, 0xfa, 0x78,
-	0xf3, 0x51, 0x3f, 0xb3, 0x16, 0xef, 0xb7, 0x6e, 0xa3, 0xf2, 0x50, 0xc3, 0x39, 0x57, 0x0e, 0x84,
-	0x27, 0xd8, 0xa7, 0x71, 0x68, 0x82, 0x7c, 0xfa, 0xc3, 0xdc, 0xe1, 0xac, 0x8d, 0xca, 0x79, 0xb8,
-	0xa7, 0x22, 0xb8, 0x07, 0x96, 0xc8, 0x49, 0xc2, 0xe2, 0x5c, 0x1a, 0x6b, 0x7f, 0x1e, 0x8b, 0x89,
-	0x59, 0x96, 0x06, 0x6a, 0xfd, 0xcc, 0x7a, 0xa2, 0x44, 0x46, 0x96, 0xd9, 0x08, 0x16, 0xf8, 0xb6,
-	0xea, 0xe4, 0x6d, 0x4c, 0xe0, 0x27, 0x30, 0xa7, 0xb7, 0xe6, 0x00, 0xfb, 0x82, 0xa5, 0xe6, 0xac,
-	0x9c, 0xe1, 0xab, 0xff, 0xfb, 0xd6, 0x95, 0x3b, 0x7b, 0xa7, 0x14, 0x6c, 0x34, 0xab, 0xe2, 0xd7,
-	0x32, 0x84, 0x27, 0x60, 0x29, 0xc2, 0x5c, 0x0c, 0x76, 0xc0, 0xeb, 0x25, 0x01, 0x16, 0xc4, 0x9c,
-	0xab, 0x19, 0xf5, 0xf2, 0xe6, 0xaa, 0xa3, 0x6e, 0xa1, 0x53, 0xdc, 0x42, 0x67, 0xbf, 0xb8, 0x85,
-	0xcd, 0x7a, 0xee, 0x62, 0xd0, 0xd6, 0x48, 0x19, 0xfb, 0xfc, 0xb7, 0x65, 0xa0, 0xc5, 0x3c, 0x77,
-	0xbb, 0x4e, 0xef, 0x64, 0x66, 0x6b, 0xe1, 0xcb, 0x85, 0x55, 0xfa, 0x7a, 0x61, 0x95, 0xbe, 0x7f,
-	0x5b, 0x9f, 0xcc, 0xef, 0xf1, 0x9b, 0xe6, 0xc7, 0xcb, 0xeb, 0xaa, 0x71, 0x75, 0x5d, 0x35, 0xfe,
-	0x5c, 0x57, 0x8d, 0xf3, 0x9b, 0x6a, 0xe9, 0xea, 0xa6, 0x5a, 0xfa, 0x79, 0x53, 0x2d, 0x7d, 0x68,
-	0xfe, 0x6b, 0x5b, 0x8e, 0x37, 0x5f, 0xba, 0x27, 0x77, 0x1e, 0xad, 0xf5, 0xc1, 0xab, 0xd5, 0x65,
-	0x01, 0x89, 0xda, 0x53, 0xb2, 0x87, 0x17, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x93, 0x70, 0x51,
-	0x58, 0xe3, 0x04, 0x00, 0x00,
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0xf9, 0x67, 0x02, 0x7c, 0x1f, 0x43, 0x00, 0x43, 0x4b, 0x9c, 0x5a, 0xaa, 0x94, 0x45,
+	0xb1, 0x09, 0x48, 0x95, 0x4a, 0x57, 0x44, 0xa8, 0x52, 0xa5, 0x54, 0x45, 0x03, 0x55, 0xa5, 0xaa,
+	0x92, 0x3b, 0xb1, 0x07, 0x33, 0x8a, 0xed, 0x31, 0xf6, 0x84, 0x02, 0x4f, 0xd0, 0x25, 0xcb, 0x2e,
+	0x79, 0x88, 0x6e, 0xfa, 0x06, 0xa8, 0x2b, 0x96, 0x55, 0x17, 0x69, 0x05, 0x6f, 0x90, 0x27, 0xa8,
+	0x3c, 0x33, 0x4e, 0x82, 0x88, 0x54, 0x56, 0xc9, 0xfd, 0x39, 0xe7, 0x9e, 0x7b, 0x7d, 0xef, 0x80,
+	0x0d, 0x96, 0x86, 0x2c, 0xa5, 0xa9, 0xed, 0xb2, 0xc8, 0x25, 0x11, 0x4f, 0x30, 0x27, 0x5e, 0x40,
+	0x8f, 0xdb, 0xd4, 0xa3, 0xfc, 0xcc, 0x3e, 0xa9, 0x35, 0x09, 0xc7, 0x35, 0x3b, 0x66, 0x2c, 0xb0,
+	0xe2, 0x84, 0x71, 0x06, 0x9f, 0x2a, 0x84, 0x35, 0x14, 0x61, 0x29, 0xc4, 0xea, 0x8a, 0x2b, 0xf2,
+	0x1c, 0x01, 0xb2, 0xa5, 0x21, 0x19, 0x56, 0x4b, 0x3e, 0xf3, 0x99, 0xf4, 0x67, 0xff, 0x94, 0xd7,
+	0xf0, 0x19, 0xf3, 0x03, 0x62, 0x0b, 0xab, 0xd9, 0x3e, 0xb4, 0x39, 0x0d, 0x49, 0xca, 0x71, 0x18,
+	0xab, 0x84, 0x17, 0x0f, 0x97, 0xea, 0x84, 0x84, 0x63, 0x0f, 0x73, 0x2c, 0xa1, 0xe6, 0xf7, 0x29,
+	0x30, 0xb6, 0xc7, 0x58, 0x00, 0x9f, 0x81, 0x49, 0xec, 0x79, 0x09, 0x49, 0x53, 0x5d, 0xab, 0x68,
+	0xd5, 0xe9, 0x3a, 0xec, 0x76, 0x8c, 0xb9, 0x33, 0x1c, 0x06, 0xdb, 0xa6, 0x0a, 0x98, 0x28, 0x4f,
+	0x81, 0x0d, 0x00, 0xa9, 0x28, 0x45, 0x4f, 0x48, 0xea, 0xe4, 0xc0, 0x11, 0x01, 0x5c, 0xeb, 0x76,
+	0x8c, 0x15, 0x09, 0xbc, 0x9f, 0x63, 0xa2, 0xf9, 0xbe, 0x73, 0x47, 0xb1, 0xbd, 0x07, 0x4b, 0x69,
+	0x9c, 0x10, 0xec, 0x39, 0x09, 0xf9, 0x8c, 0x13, 0xaf, 0xcf, 0x38, 0x2a, 0x18, 0x9f, 0x74, 0x3b,
+	0xc6, 0x9a, 0x64, 0x1c, 0x9e, 0x67, 0xa2, 0x92, 0x0c, 0x20, 0xe9, 0xcf, 0x89, 0xe7, 0xc0, 0x08,
+	0xf5, 0xf4, 0xb1, 0x8a, 0x56, 0x1d, 0x43, 0x23, 0xd4, 0x83, 0xe7, 0x60, 0xc9, 0x6d, 0x27, 0x09,
+	0x89, 0xb8, 0xc3, 0xa9, 0xdb, 0x72, 0x7a, 0x33, 0xd2, 0xc7, 0x45, 0xa1, 0xdd, 0xab, 0x8e, 0x51,
+	0xf8, 0xd5, 0x31, 0x1e, 0xc9, 0xaf, 0x92, 0x7a, 0x2d, 0x8b, 0x32, 0x3b, 0xc4, 0xfc, 0xc8, 0x6a,
+	0x10, 0x1f, 0xbb, 0x67, 0xbb, 0xc4, 0xed, 0x6b, 0x19, 0x4e, 0x65, 0xa2, 0x92, 0x0a, 0x1c, 0x50,
+	0xb7, 0xd5, 0xc8, 0xdd, 0x70, 0x09, 0x4c, 0x70, 0xd6, 0x22, 0xd1, 0x86, 0x3e, 0x91, 0xd5, 0x42,
+	0xca, 0xea, 0xf9, 0x6b, 0xfa, 0xe4, 0x80, 0xbf, 0x06, 0xcf, 0x01, 0xcc, 0x0b, 0xa4, 0xc7, 0x09,
+	0x77, 0xe2, 0x84, 0xba, 0x44, 0x9f, 0x12, 0x3a, 0x1b, 0x4a, 0xa7, 0xed, 0x53, 0x7e, 0xd4, 0x6e,
+	0x5a, 0x2e, 0x0b, 0x6d, 0xb5, 0x03, 0xeb, 0x01, 0x6e, 0xa6, 0xb9, 0x21, 0x7e, 0x85, 0xfc, 0x3a,
+	0xf5, 0xa5, 0xf6, 0xf9, 0x7c, 0x8e, 0x4c, 0x51, 0x9a, 0xe8, 0x7f, 0x55, 0x67, 0xff, 0x38, 0xe1,
+	0x7b, 0x99, 0x0b, 0x6e, 0x83, 0x99, 0xc1, 0xe6, 0xf4, 0xe9, 0x8a, 0x56, 0x1d, 0xad, 0x2f, 0x77,
+	0x3b, 0xc6, 0xc2, 0xfd, 0xd6, 0x4d, 0x54, 0x1c, 0x68, 0x38, 0xc3, 0x8a, 0x81, 0xa4, 0x31, 0x76,
+	0x69, 0xe4, 0xeb, 0x20, 0x9b, 0xfe, 0x20, 0x76, 0x30, 0x6a, 0xa2, 0x62, 0x66, 0xee, 0x4b, 0x0b,
+	0xee, 0x83, 0x45, 0x72, 0x1a, 0xb3, 0x28, 0xa3, 0xc6, 0x4a, 0x9f, 0xc3, 0x22, 0xa2, 0x17, 0x85,
+	0x80, 0x4a, 0xb7, 0x63, 0x3c, 0x96, 0x24, 0x43, 0xd3, 0x4c, 0x04, 0x73, 0xff, 0x8e, 0xec, 0xe4,
+	0x6d, 0x44, 0xe0, 0x27, 0x30, 0xab, 0xb6, 0xe6, 0x10, 0xbb, 0x9c, 0x25, 0xfa, 0x8c, 0x98, 0xe1,
+	0xcb, 0x87, 0x7d, 0xeb, 0xd2, 0x9d, 0xbd, 0x93, 0x0c, 0x26, 0x9a, 0x91, 0xf6, 0x2b, 0x61, 0xc2,
+	0x53, 0xb0, 0x18, 0xe0, 0x94, 0xf7, 0x77, 0xc0, 0x69, 0xc7, 0x1e, 0xe6, 0x44, 0x9f, 0xad, 0x68,
+	0xd5, 0xe2, 0xe6, 0xaa, 0x25, 0x0f, 0xd8, 0xca, 0x0f, 0xd8, 0x3a, 0xc8, 0x0f, 0xb8, 0x5e, 0xcd,
+	0x54, 0xf4, 0xdb, 0x1a, 0x4a, 0x63, 0x5e, 0xfc, 0x36, 0x34, 0xb4, 0x90, 0xc5, 0x7a, 0xeb, 0xf4,
+	0x4e, 0x44, 0xe0, 0x11, 0x98, 0xca, 0x0f, 0x5a, 0x9f, 0x13, 0xc5, 0xb6, 0xac, 0x07, 0xbd, 0x42,
+	0x56, 0x76, 0xf4, 0x6f, 0x14, 0xb4, 0xbe, 0xac, 0x54, 0xfc, 0x27, 0x55, 0xe4, 0x94, 0x26, 0xea,
+	0xb1, 0x6f, 0xcf, 0x7f, 0xb9, 0x34, 0x0a, 0x5f, 0x2f, 0x8d, 0xc2, 0x8f, 0x6f, 0xeb, 0xe3, 0x19,
+	0xf8, 0x75, 0xfd, 0xe3, 0xd5, 0x4d, 0x59, 0xbb, 0xbe, 0x29, 0x6b, 0x7f, 0x6e, 0xca, 0xda, 0xc5,
+	0x6d, 0xb9, 0x70, 0x7d, 0x5b, 0x2e, 0xfc, 0xbc, 0x2d, 0x17, 0x3e, 0xd4, 0xff, 0xb5, 0x97, 0x27,
+	0x9b, 0xcf, 0xed, 0xd3, 0x3b, 0xcf, 0xd5, 0x7a, 0xff, 0xbd, 0x0a, 0x99, 0x47, 0x82, 0xe6, 0x84,
+	0x98, 0xd6, 0xd6, 0xdf, 0x01, 0x00, 0x39, 0x36, 0xae, 0x9c, 0x88, 0x05, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -166,12 +172,22 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastLiquidityUpdate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastLiquidityUpdate):])
-	if err1 != nil {
-		return 0, err1
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastLiquidityUpdate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastLiquidityUpdate):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintPool(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintPool(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x6a
 	{
@@ -319,6 +335,8 @@ func (m *Pool) Size() (n int) {
 	n += 1 + l + sovPool(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastLiquidityUpdate)
 	n += 1 + l + sovPool(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovPool(uint64(l))
 	return n
 }
 
@@ -728,6 +746,39 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
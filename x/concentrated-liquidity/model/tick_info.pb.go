@@ -55,6 +55,11 @@ type TickInfo struct {
 	// because we need the ability to serialize and deserialize the
 	// container easily for events when crossing a tick.
 	UptimeTrackers UptimeTrackers `protobuf:"bytes,4,opt,name=uptime_trackers,json=uptimeTrackers,proto3" json:"uptime_trackers" yaml:"uptime_trackers"`
+	// spread_reward_growth_saturated is true if the spread reward growth global
+	// was saturated at MaxSpreadRewardGrowthPerUnitLiquidity when the tick was
+	// last crossed. The spread rewards earned by positions bounded by the tick
+	// are then understated.
+	SpreadRewardGrowthSaturated bool `protobuf:"varint,5,opt,name=spread_reward_growth_saturated,json=spreadRewardGrowthSaturated,proto3" json:"spread_reward_growth_saturated,omitempty" yaml:"spread_reward_growth_saturated"`
 }
 
 func (m *TickInfo) Reset()         { *m = TickInfo{} }
@@ -104,6 +109,13 @@ func (m *TickInfo) GetUptimeTrackers() UptimeTrackers {
 	return UptimeTrackers{}
 }
 
+func (m *TickInfo) GetSpreadRewardGrowthSaturated() bool {
+	if m != nil {
+		return m.SpreadRewardGrowthSaturated
+	}
+	return false
+}
+
 type UptimeTrackers struct {
 	List []UptimeTracker `protobuf:"bytes,1,rep,name=list,proto3" json:"list" yaml:"list"`
 }
@@ -203,41 +215,43 @@ func init() {
 }
 
 var fileDescriptor_193d635744e474d2 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x8b, 0xd3, 0x4e,
-	0x14, 0xc7, 0x9b, 0xdf, 0x96, 0x1f, 0x9a, 0xba, 0x5d, 0xc8, 0xae, 0x52, 0x56, 0x49, 0x4b, 0x40,
-	0x28, 0x48, 0x27, 0x6c, 0xd7, 0x15, 0x54, 0xbc, 0xc4, 0xc2, 0x22, 0x2c, 0x2e, 0x84, 0x7a, 0x11,
-	0x25, 0x4e, 0x27, 0xd3, 0x76, 0x68, 0x92, 0x89, 0x79, 0xaf, 0x5d, 0x7b, 0xf1, 0xe6, 0xdd, 0x9b,
-	0x47, 0xef, 0xfe, 0x25, 0x3d, 0xee, 0x51, 0x3c, 0x54, 0x69, 0xff, 0x03, 0xff, 0x02, 0x49, 0x32,
-	0x69, 0x6d, 0xf1, 0xb0, 0x08, 0x9e, 0x92, 0x99, 0xbc, 0xef, 0xf7, 0xfb, 0xe1, 0xbd, 0x3c, 0xfd,
-	0x44, 0x42, 0x28, 0x41, 0x80, 0xcd, 0x64, 0xc4, 0x78, 0x84, 0x09, 0x45, 0xee, 0x07, 0xe2, 0xed,
-	0x58, 0xf8, 0x02, 0xa7, 0xf6, 0xe4, 0xa8, 0xc7, 0x91, 0x1e, 0xd9, 0x28, 0xd8, 0xc8, 0x13, 0x51,
-	0x5f, 0x92, 0x38, 0x91, 0x28, 0x8d, 0xbb, 0x4a, 0x46, 0xfe, 0x28, 0x23, 0x4a, 0x76, 0x78, 0x30,
-	0x90, 0x03, 0x99, 0x29, 0xec, 0xf4, 0x2d, 0x17, 0x1f, 0x9a, 0x2c, 0x53, 0xdb, 0x3d, 0x0a, 0x7c,
-	0x95, 0xc0, 0xa4, 0x88, 0xf2, 0xef, 0xd6, 0xe7, 0xb2, 0x7e, 0xad, 0x2b, 0xd8, 0xe8, 0x59, 0xd4,
-	0x97, 0x46, 0x5f, 0xdf, 0x5b, 0xf9, 0x7a, 0x83, 0x44, 0x02, 0xd4, 0xb4, 0x86, 0xd6, 0xbc, 0xee,
-	0x3c, 0x99, 0xcd, 0xeb, 0xa5, 0x6f, 0xf3, 0xfa, 0xed, 0xdc, 0x0d, 0xfc, 0x11, 0x11, 0xd2, 0x0e,
-	0x29, 0x0e, 0xc9, 0x19, 0x1f, 0x50, 0x36, 0xed, 0x70, 0xf6, 0x73, 0x5e, 0xbf, 0x35, 0xa5, 0x61,
-	0xf0, 0xc8, 0xda, 0xf2, 0xb0, 0xdc, 0xea, 0xea, 0xe6, 0x34, 0xbd, 0x30, 0xde, 0xe8, 0xbb, 0xeb,
-	0x9a, 0x88, 0x63, 0xed, 0xbf, 0x2c, 0xe5, 0xf1, 0xd5, 0x52, 0x0e, 0xb6, 0x53, 0x22, 0x8e, 0x96,
-	0x7b, 0x63, 0x75, 0x7e, 0xce, 0xd1, 0x98, 0x69, 0xfa, 0x43, 0x88, 0x13, 0x4e, 0x7d, 0x2f, 0xe1,
-	0x17, 0x34, 0xf1, 0x53, 0x94, 0x0b, 0x1c, 0x7a, 0x32, 0x8e, 0x25, 0x08, 0xe4, 0x9e, 0x2f, 0x12,
-	0xce, 0x50, 0xc8, 0xc8, 0x93, 0x7d, 0x2f, 0xa0, 0x80, 0x1e, 0x26, 0x74, 0xc2, 0x13, 0xa0, 0x41,
-	0x6d, 0xa7, 0xb1, 0xd3, 0xac, 0xb4, 0xef, 0x90, 0x9c, 0x83, 0xa4, 0xbd, 0x2b, 0xda, 0x4c, 0x3a,
-	0x9c, 0x3d, 0x95, 0x22, 0x72, 0x8e, 0x53, 0xd8, 0x2f, 0xdf, 0xeb, 0xf7, 0x06, 0x02, 0x87, 0xe3,
-	0x1e, 0x61, 0x32, 0xb4, 0x55, 0xaf, 0xf3, 0x47, 0x0b, 0xfc, 0x91, 0x8d, 0xd3, 0x98, 0x43, 0xa1,
-	0x01, 0xb7, 0x9d, 0x33, 0xb9, 0x19, 0xd2, 0x69, 0x46, 0x74, 0xae, 0x80, 0x3a, 0x05, 0xcf, 0x79,
-	0xff, 0x8c, 0x02, 0x76, 0x0b, 0x18, 0xe3, 0xbd, 0xbe, 0x37, 0x8e, 0x51, 0x84, 0x3c, 0x05, 0x64,
-	0x23, 0x9e, 0x40, 0xad, 0xdc, 0xd0, 0x9a, 0x95, 0xf6, 0x09, 0xb9, 0xd2, 0x8f, 0x41, 0x5e, 0x64,
-	0xea, 0xae, 0x12, 0x3b, 0x66, 0x0a, 0xbe, 0x1e, 0xd6, 0x96, 0xb7, 0xe5, 0x56, 0xc7, 0x1b, 0xf5,
-	0x96, 0xd4, 0xab, 0x9b, 0x0e, 0xc6, 0x6b, 0xbd, 0x1c, 0x08, 0xc0, 0x9a, 0x96, 0xb5, 0xe9, 0xfe,
-	0xdf, 0x60, 0x38, 0xfb, 0x8a, 0xa2, 0x52, 0x0c, 0x13, 0xd0, 0x72, 0x33, 0x5b, 0xeb, 0x93, 0xa6,
-	0xef, 0x6e, 0x14, 0x1b, 0x1f, 0x34, 0xfd, 0xa6, 0xe2, 0x2c, 0xc6, 0x38, 0x46, 0x10, 0x3e, 0x57,
-	0x08, 0xff, 0x60, 0x52, 0xfb, 0x79, 0x9e, 0x9a, 0x51, 0x9e, 0xe6, 0xbc, 0x9a, 0x2d, 0x4c, 0xed,
-	0x72, 0x61, 0x6a, 0x3f, 0x16, 0xa6, 0xf6, 0x71, 0x69, 0x96, 0x2e, 0x97, 0x66, 0xe9, 0xeb, 0xd2,
-	0x2c, 0xbd, 0x74, 0x7e, 0xf3, 0x56, 0xed, 0x68, 0x05, 0xb4, 0x07, 0xc5, 0xc1, 0x9e, 0xb4, 0x1f,
-	0xd8, 0xef, 0x36, 0x16, 0xbf, 0xb5, 0xde, 0xfc, 0x50, 0xfa, 0x3c, 0xe8, 0xfd, 0x9f, 0x6d, 0xe4,
-	0xf1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x6b, 0xe8, 0xb5, 0x27, 0x04, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0xd8, 0x56, 0xea, 0xd6, 0xa6, 0xb0, 0xad, 0xb2, 0xb4, 0xb2, 0x09, 0x0b, 0x85, 0x88,
+	0x64, 0x96, 0xa6, 0x56, 0x50, 0xf1, 0xb2, 0x06, 0x8a, 0x50, 0x2c, 0xac, 0xf1, 0x22, 0xca, 0x3a,
+	0x99, 0x9d, 0x24, 0x43, 0x76, 0x77, 0xd6, 0x9d, 0x6f, 0x53, 0x73, 0xf1, 0xe6, 0x59, 0x6f, 0xbe,
+	0x83, 0x4f, 0x92, 0x63, 0x8f, 0xe2, 0x21, 0x4a, 0xf2, 0x06, 0x7d, 0x02, 0xd9, 0x7f, 0x89, 0x89,
+	0x45, 0x8a, 0xe0, 0x69, 0x77, 0x66, 0xbe, 0xdf, 0x9f, 0xf9, 0xcd, 0xc7, 0xa7, 0x1c, 0x09, 0xe9,
+	0x0b, 0xc9, 0xa5, 0x49, 0x45, 0x40, 0x59, 0x00, 0x11, 0x01, 0xe6, 0x7a, 0xfc, 0x5d, 0xcc, 0x5d,
+	0x0e, 0x43, 0x73, 0x70, 0xd0, 0x66, 0x40, 0x0e, 0x4c, 0xe0, 0xb4, 0xef, 0xf0, 0xa0, 0x23, 0x70,
+	0x18, 0x09, 0x10, 0xea, 0x7e, 0x0e, 0xc3, 0x97, 0xc2, 0x70, 0x0e, 0xdb, 0xdd, 0xe9, 0x8a, 0xae,
+	0x48, 0x11, 0x66, 0xf2, 0x97, 0x81, 0x77, 0x75, 0x9a, 0xa2, 0xcd, 0x36, 0x91, 0x6c, 0xa6, 0x40,
+	0x05, 0x0f, 0xb2, 0x73, 0xe3, 0xd3, 0x9a, 0xb2, 0xde, 0xe2, 0xb4, 0xff, 0x2c, 0xe8, 0x08, 0xb5,
+	0xa3, 0x6c, 0xcd, 0x78, 0x9d, 0x6e, 0x24, 0xa4, 0xd4, 0x50, 0x15, 0xd5, 0x6e, 0x58, 0x4f, 0x46,
+	0xe3, 0x4a, 0xe9, 0xfb, 0xb8, 0xb2, 0x97, 0xb1, 0x49, 0xb7, 0x8f, 0xb9, 0x30, 0x7d, 0x02, 0x3d,
+	0x7c, 0xc2, 0xba, 0x84, 0x0e, 0x9b, 0x8c, 0x5e, 0x8c, 0x2b, 0xb7, 0x87, 0xc4, 0xf7, 0x1e, 0x19,
+	0x4b, 0x1c, 0x86, 0x5d, 0x9e, 0xed, 0x1c, 0x27, 0x1b, 0xea, 0x5b, 0x65, 0x73, 0x5e, 0x13, 0x30,
+	0xd0, 0xae, 0xa5, 0x2a, 0x8f, 0xaf, 0xa6, 0xb2, 0xb3, 0xac, 0x12, 0x30, 0x30, 0xec, 0x9b, 0xb3,
+	0xf5, 0x73, 0x06, 0xea, 0x08, 0x29, 0x0f, 0x65, 0x18, 0x31, 0xe2, 0x3a, 0x11, 0x3b, 0x23, 0x91,
+	0x9b, 0x58, 0x39, 0x83, 0x9e, 0x23, 0xc2, 0x50, 0x48, 0x0e, 0xcc, 0x71, 0x79, 0xc4, 0x28, 0x70,
+	0x11, 0x38, 0xa2, 0xe3, 0x78, 0x44, 0x82, 0x03, 0x11, 0x19, 0xb0, 0x48, 0x12, 0x4f, 0x5b, 0xa9,
+	0xae, 0xd4, 0x36, 0x1a, 0x77, 0x70, 0xe6, 0x03, 0x27, 0xd9, 0x15, 0x31, 0xe3, 0x26, 0xa3, 0x4f,
+	0x05, 0x0f, 0xac, 0xc3, 0xc4, 0xec, 0xd7, 0x1f, 0x95, 0x7b, 0x5d, 0x0e, 0xbd, 0xb8, 0x8d, 0xa9,
+	0xf0, 0xcd, 0x3c, 0xeb, 0xec, 0x53, 0x97, 0x6e, 0xdf, 0x84, 0x61, 0xc8, 0x64, 0x81, 0x91, 0x76,
+	0x23, 0xf3, 0x64, 0xa7, 0x96, 0x8e, 0x53, 0x47, 0xa7, 0xb9, 0xa1, 0x66, 0xe1, 0xe7, 0xb4, 0x73,
+	0x42, 0x24, 0xb4, 0x0a, 0x33, 0xea, 0x07, 0x65, 0x2b, 0x0e, 0x81, 0xfb, 0x2c, 0x31, 0x48, 0xfb,
+	0x2c, 0x92, 0xda, 0x6a, 0x15, 0xd5, 0x36, 0x1a, 0x47, 0xf8, 0x4a, 0x8d, 0x81, 0x5f, 0xa6, 0xe8,
+	0x56, 0x0e, 0xb6, 0xf4, 0xc4, 0xf8, 0xfc, 0xb1, 0x96, 0xb8, 0x0d, 0xbb, 0x1c, 0x2f, 0xd4, 0xab,
+	0x81, 0xa2, 0x5f, 0x9a, 0xa4, 0x24, 0x10, 0xa7, 0x8a, 0xda, 0x5a, 0x15, 0xd5, 0xd6, 0xad, 0xbb,
+	0x17, 0xe3, 0xca, 0x7e, 0xc6, 0xf9, 0xf7, 0x7a, 0xc3, 0xde, 0xfb, 0x33, 0x86, 0x17, 0xb3, 0x53,
+	0xa1, 0x94, 0x17, 0x1d, 0xab, 0x6f, 0x94, 0x55, 0x8f, 0x4b, 0xd0, 0x50, 0xfa, 0x2c, 0xf7, 0xff,
+	0xe5, 0xda, 0xd6, 0x76, 0x7e, 0xeb, 0x8d, 0xa2, 0x79, 0x24, 0x18, 0x76, 0x4a, 0x6b, 0x7c, 0x41,
+	0xca, 0xe6, 0x42, 0xb1, 0xfa, 0x11, 0x29, 0xb7, 0xf2, 0x5c, 0x8a, 0xb6, 0x89, 0x41, 0x72, 0x97,
+	0x69, 0xe8, 0x7f, 0x75, 0xc6, 0x76, 0xa6, 0x97, 0xf7, 0x44, 0xa6, 0x66, 0xbd, 0x1e, 0x4d, 0x74,
+	0x74, 0x3e, 0xd1, 0xd1, 0xcf, 0x89, 0x8e, 0x3e, 0x4f, 0xf5, 0xd2, 0xf9, 0x54, 0x2f, 0x7d, 0x9b,
+	0xea, 0xa5, 0x57, 0xd6, 0x6f, 0xdc, 0x79, 0x1c, 0x75, 0x8f, 0xb4, 0x65, 0xb1, 0x30, 0x07, 0x8d,
+	0x07, 0xe6, 0xfb, 0x85, 0x41, 0x53, 0x9f, 0x4f, 0x1a, 0x5f, 0xb8, 0xcc, 0x6b, 0x5f, 0x4f, 0x27,
+	0xc0, 0xe1, 0xaf, 0x01, 0x00, 0xe2, 0xe8, 0xff, 0xf1, 0x97, 0x04, 0x00, 0x00,
 }
 
 func (m *TickInfo) Marshal() (dAtA []byte, err error) {
@@ -260,6 +274,16 @@ func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SpreadRewardGrowthSaturated {
+		i--
+		if m.SpreadRewardGrowthSaturated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.UptimeTrackers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -410,6 +434,9 @@ func (m *TickInfo) Size() (n int) {
 	}
 	l = m.UptimeTrackers.Size()
 	n += 1 + l + sovTickInfo(uint64(l))
+	if m.SpreadRewardGrowthSaturated {
+		n += 2
+	}
 	return n
 }
 
@@ -613,6 +640,26 @@ func (m *TickInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthSaturated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpreadRewardGrowthSaturated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTickInfo(dAtA[iNdEx:])
@@ -33,11 +33,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ===================== MsgCreateConcentratedPool
 type MsgCreateConcentratedPool struct {
-	Sender       string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	Denom0       string                      `protobuf:"bytes,2,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
-	Denom1       string                      `protobuf:"bytes,3,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom0 string `protobuf:"bytes,2,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	Denom1 string `protobuf:"bytes,3,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+	// tick_spacing of the pool. If zero, the pool gets the default tick spacing
+	// of its spread factor.
 	TickSpacing  uint64                      `protobuf:"varint,4,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
 	SpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor" yaml:"spread_factor"`
+	// name is an optional human readable name for the pool.
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// ticker_pairing is an optional display pairing of the pool's assets, e.g.
+	// "OSMO/USDC".
+	TickerPairing string `protobuf:"bytes,7,opt,name=ticker_pairing,json=tickerPairing,proto3" json:"ticker_pairing,omitempty" yaml:"ticker_pairing"`
 }
 
 func (m *MsgCreateConcentratedPool) Reset()         { *m = MsgCreateConcentratedPool{} }
@@ -101,6 +108,20 @@ func (m *MsgCreateConcentratedPool) GetTickSpacing() uint64 {
 	return 0
 }
 
+func (m *MsgCreateConcentratedPool) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgCreateConcentratedPool) GetTickerPairing() string {
+	if m != nil {
+		return m.TickerPairing
+	}
+	return ""
+}
+
 // Returns a unique poolID to identify the pool with.
 type MsgCreateConcentratedPoolResponse struct {
 	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -156,38 +177,41 @@ func init() {
 }
 
 var fileDescriptor_ce205b40e975faec = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0xdb, 0x10, 0xc4, 0xd1, 0x0e, 0x35, 0x15, 0x35, 0x41, 0xb2, 0x83, 0x59, 0x4a, 0xa5,
-	0xf8, 0xea, 0x22, 0x31, 0x04, 0x26, 0xb7, 0x20, 0x2a, 0x51, 0x09, 0x99, 0x01, 0x09, 0x21, 0x85,
-	0x8b, 0xef, 0x70, 0x4f, 0xb5, 0xfd, 0x8c, 0xef, 0x1a, 0x35, 0x2b, 0x23, 0x13, 0x3f, 0xa5, 0x3f,
-	0xa3, 0x63, 0xc5, 0x84, 0x3a, 0x58, 0x28, 0x19, 0xba, 0x30, 0xe5, 0x17, 0x20, 0x9f, 0x9d, 0x90,
-	0xa0, 0x64, 0x42, 0x5d, 0x6c, 0xbf, 0xf7, 0x7d, 0xfe, 0xde, 0xbb, 0xef, 0xbd, 0x43, 0x2f, 0x41,
-	0xc4, 0x20, 0xb8, 0xc0, 0x01, 0x24, 0x01, 0x4b, 0x64, 0x46, 0x24, 0xa3, 0x11, 0xff, 0x72, 0xca,
-	0x29, 0x97, 0x03, 0x9c, 0x02, 0x44, 0x31, 0x50, 0x16, 0xcd, 0xe1, 0xb8, 0xef, 0xf6, 0x98, 0x24,
-	0x2e, 0x96, 0x67, 0x4e, 0x9a, 0x81, 0x04, 0xfd, 0x45, 0x25, 0xe3, 0x2c, 0x94, 0x71, 0xa6, 0x32,
-	0x73, 0xb8, 0x53, 0xc9, 0x34, 0x37, 0x43, 0x08, 0x41, 0x09, 0xe1, 0xe2, 0xab, 0xd4, 0x6c, 0x6e,
-	0x90, 0x98, 0x27, 0x80, 0xd5, 0xb3, 0x4a, 0x6d, 0x05, 0xaa, 0x0e, 0x8e, 0x45, 0x88, 0xfb, 0x6e,
-	0xf1, 0x2a, 0x01, 0xfb, 0xf7, 0x0a, 0x7a, 0x70, 0x24, 0xc2, 0xfd, 0x8c, 0x11, 0xc9, 0xf6, 0x67,
-	0x6a, 0xbc, 0x05, 0x88, 0xf4, 0x27, 0xa8, 0x21, 0x58, 0x42, 0x59, 0x66, 0x68, 0x2d, 0x6d, 0xfb,
-	0x8e, 0xb7, 0x31, 0xce, 0xad, 0xf5, 0x01, 0x89, 0xa3, 0x8e, 0x5d, 0xe6, 0x6d, 0xbf, 0x22, 0x14,
-	0x54, 0xca, 0x12, 0x88, 0x77, 0x8d, 0x95, 0x7f, 0xa9, 0x65, 0xde, 0xf6, 0x2b, 0xc2, 0x94, 0xea,
-	0x1a, 0xab, 0x0b, 0xa9, 0xee, 0x84, 0xea, 0xea, 0x1d, 0xb4, 0x26, 0x79, 0x70, 0xd2, 0x15, 0x29,
-	0x09, 0x78, 0x12, 0x1a, 0xf5, 0x96, 0xb6, 0x5d, 0xf7, 0xb6, 0xc6, 0xb9, 0x75, 0xaf, 0xfc, 0x61,
-	0x16, 0xb5, 0xfd, 0xbb, 0x45, 0xf8, 0xae, 0x8c, 0xf4, 0x4f, 0x68, 0x5d, 0xa4, 0x19, 0x23, 0xb4,
-	0xfb, 0x99, 0x04, 0x12, 0x32, 0xe3, 0x96, 0xaa, 0xf6, 0xfc, 0x22, 0xb7, 0x6a, 0x57, 0xb9, 0xf5,
-	0xb0, 0xb4, 0x44, 0xd0, 0x13, 0x87, 0x03, 0x8e, 0x89, 0x3c, 0x76, 0xde, 0xb0, 0x90, 0x04, 0x83,
-	0x03, 0x16, 0x8c, 0x73, 0x6b, 0xb3, 0x3a, 0xe6, 0xac, 0x82, 0xed, 0xaf, 0x95, 0xf1, 0x2b, 0x15,
-	0x76, 0x76, 0xbf, 0x5e, 0x9f, 0xef, 0x54, 0x06, 0x7c, 0xbb, 0x3e, 0xdf, 0x69, 0x4d, 0x77, 0x42,
-	0xd9, 0xd9, 0x9e, 0x9d, 0x59, 0xbb, 0x18, 0xa5, 0xfd, 0x1a, 0x3d, 0x5a, 0xea, 0xb6, 0xcf, 0x44,
-	0x0a, 0x89, 0x60, 0xfa, 0x63, 0x74, 0xbb, 0x20, 0x77, 0x39, 0x55, 0xb6, 0xd7, 0x3d, 0x34, 0xcc,
-	0xad, 0x46, 0x41, 0x39, 0x3c, 0xf0, 0x1b, 0x05, 0x74, 0x48, 0xf7, 0xae, 0x34, 0xb4, 0x7a, 0x24,
-	0x42, 0xfd, 0x87, 0x86, 0xee, 0x2f, 0x99, 0xde, 0x7b, 0xe7, 0x7f, 0x96, 0xcb, 0x59, 0xda, 0x68,
-	0xb3, 0x7b, 0x43, 0xc2, 0x13, 0x07, 0xbc, 0x8f, 0x17, 0x43, 0x53, 0xbb, 0x1c, 0x9a, 0xda, 0xaf,
-	0xa1, 0xa9, 0x7d, 0x1f, 0x99, 0xb5, 0xcb, 0x91, 0x59, 0xfb, 0x39, 0x32, 0x6b, 0x1f, 0xbc, 0x90,
-	0xcb, 0xe3, 0xd3, 0x9e, 0x13, 0x40, 0x8c, 0xab, 0x26, 0xda, 0x11, 0xe9, 0x89, 0x49, 0x80, 0xfb,
-	0x7b, 0xcf, 0xf0, 0xd9, 0xdc, 0xa5, 0x6b, 0xff, 0xbd, 0x95, 0xaa, 0xa9, 0x5e, 0x43, 0xad, 0xfe,
-	0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x4c, 0x15, 0x8d, 0xc3, 0x03, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0x8e, 0x49, 0x48, 0xc5, 0xb5, 0x01, 0xd5, 0x14, 0xea, 0x06, 0xc9, 0x0e, 0xee, 0x52, 0x2a,
+	0xc5, 0x57, 0x17, 0x89, 0x21, 0x30, 0xa0, 0xb4, 0x20, 0x2a, 0x51, 0xa9, 0x32, 0x03, 0x12, 0x42,
+	0x0a, 0x17, 0xfb, 0x70, 0x4f, 0xb5, 0x7d, 0xc6, 0x77, 0x8d, 0x9a, 0x95, 0x91, 0x89, 0x9f, 0xd2,
+	0x9f, 0xd1, 0xb1, 0x82, 0x05, 0x75, 0xb0, 0x50, 0x32, 0x74, 0xcf, 0x2f, 0x40, 0x77, 0xe7, 0x7c,
+	0xa1, 0x66, 0x42, 0x2c, 0x89, 0x9f, 0xf7, 0x79, 0xee, 0xb9, 0xf7, 0xde, 0x0f, 0xf0, 0x8a, 0xb2,
+	0x98, 0x32, 0xc2, 0xa0, 0x4f, 0x13, 0x1f, 0x27, 0x3c, 0x43, 0x1c, 0x07, 0x11, 0xf9, 0x72, 0x4a,
+	0x02, 0xc2, 0xfb, 0x30, 0xa5, 0x34, 0x8a, 0x69, 0x80, 0xa3, 0x39, 0x1e, 0xf6, 0xdc, 0x2e, 0xe6,
+	0xc8, 0x85, 0xfc, 0xcc, 0x49, 0x33, 0xca, 0xa9, 0xfe, 0xa2, 0xb0, 0x71, 0x6e, 0xb4, 0x71, 0x26,
+	0x36, 0x73, 0xbc, 0x53, 0xd8, 0xd4, 0xd7, 0x42, 0x1a, 0x52, 0x69, 0x04, 0xc5, 0x97, 0xf2, 0xac,
+	0xaf, 0xa2, 0x98, 0x24, 0x14, 0xca, 0xdf, 0x22, 0xb4, 0xee, 0xcb, 0x7b, 0x60, 0xcc, 0x42, 0xd8,
+	0x73, 0xc5, 0x9f, 0x22, 0xec, 0x9f, 0x65, 0xb0, 0x71, 0xc8, 0xc2, 0xbd, 0x0c, 0x23, 0x8e, 0xf7,
+	0x66, 0xee, 0x38, 0xa2, 0x34, 0xd2, 0x9f, 0x80, 0x2a, 0xc3, 0x49, 0x80, 0x33, 0x43, 0x6b, 0x68,
+	0x5b, 0x77, 0xda, 0xab, 0xa3, 0xdc, 0xaa, 0xf5, 0x51, 0x1c, 0xb5, 0x6c, 0x15, 0xb7, 0xbd, 0x42,
+	0x20, 0xa4, 0x01, 0x4e, 0x68, 0xbc, 0x63, 0xdc, 0xfa, 0x5b, 0xaa, 0xe2, 0xb6, 0x57, 0x08, 0x26,
+	0x52, 0xd7, 0x28, 0xdf, 0x28, 0x75, 0xc7, 0x52, 0x57, 0x6f, 0x81, 0x15, 0x4e, 0xfc, 0x93, 0x0e,
+	0x4b, 0x91, 0x4f, 0x92, 0xd0, 0xa8, 0x34, 0xb4, 0xad, 0x4a, 0x7b, 0x7d, 0x94, 0x5b, 0xf7, 0xd5,
+	0x81, 0x59, 0xd6, 0xf6, 0x96, 0x05, 0x7c, 0xa7, 0x90, 0xfe, 0x09, 0xd4, 0x58, 0x9a, 0x61, 0x14,
+	0x74, 0x3e, 0x23, 0x9f, 0xd3, 0xcc, 0xb8, 0x2d, 0x6f, 0x7b, 0x7e, 0x91, 0x5b, 0xa5, 0xab, 0xdc,
+	0x7a, 0xa4, 0x4a, 0xc2, 0x82, 0x13, 0x87, 0x50, 0x18, 0x23, 0x7e, 0xec, 0xbc, 0xc5, 0x21, 0xf2,
+	0xfb, 0xfb, 0xd8, 0x1f, 0xe5, 0xd6, 0x5a, 0xf1, 0xcc, 0x59, 0x07, 0xdb, 0x5b, 0x51, 0xf8, 0xb5,
+	0x84, 0xfa, 0x26, 0xa8, 0x24, 0x28, 0xc6, 0x46, 0x55, 0x1a, 0xdf, 0x1b, 0xe5, 0xd6, 0xb2, 0x3a,
+	0x25, 0xa2, 0xb6, 0x27, 0x49, 0xfd, 0x25, 0xb8, 0x2b, 0xb2, 0xc2, 0x59, 0x27, 0x45, 0x24, 0x13,
+	0x8f, 0x58, 0x92, 0xf2, 0x8d, 0x51, 0x6e, 0x3d, 0x98, 0x3e, 0x62, 0xca, 0xdb, 0x5e, 0x4d, 0x05,
+	0x8e, 0x14, 0x6e, 0xed, 0x7c, 0xbd, 0x3e, 0xdf, 0x2e, 0xea, 0xfc, 0xed, 0xfa, 0x7c, 0xbb, 0x31,
+	0x19, 0x3d, 0xd9, 0xb5, 0xe6, 0xec, 0x68, 0x34, 0xc5, 0xc4, 0xd8, 0x6f, 0xc0, 0xe3, 0x85, 0x4d,
+	0xf5, 0x30, 0x4b, 0x69, 0xc2, 0xb0, 0xbe, 0x09, 0x96, 0x84, 0xb8, 0x43, 0x02, 0xd9, 0xdd, 0x4a,
+	0x1b, 0x0c, 0x72, 0xab, 0x2a, 0x24, 0x07, 0xfb, 0x5e, 0x55, 0x50, 0x07, 0xc1, 0xee, 0x95, 0x06,
+	0xca, 0x87, 0x2c, 0xd4, 0x7f, 0x68, 0xe0, 0xe1, 0x82, 0x21, 0x79, 0xef, 0xfc, 0xcb, 0x0c, 0x3b,
+	0x0b, 0x13, 0xad, 0x77, 0xfe, 0x93, 0xf1, 0xb8, 0x02, 0xed, 0x8f, 0x17, 0x03, 0x53, 0xbb, 0x1c,
+	0x98, 0xda, 0xef, 0x81, 0xa9, 0x7d, 0x1f, 0x9a, 0xa5, 0xcb, 0xa1, 0x59, 0xfa, 0x35, 0x34, 0x4b,
+	0x1f, 0xda, 0x21, 0xe1, 0xc7, 0xa7, 0x5d, 0xc7, 0xa7, 0x31, 0x2c, 0x92, 0x68, 0x46, 0xa8, 0xcb,
+	0xc6, 0x00, 0xf6, 0x76, 0x9f, 0xc1, 0xb3, 0xb9, 0xdd, 0x6e, 0x4e, 0x97, 0x5f, 0x26, 0xd5, 0xad,
+	0xca, 0x0d, 0x7b, 0xfa, 0x67, 0x00, 0xf4, 0x4c, 0x83, 0xd7, 0x2a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -257,6 +281,7 @@ func _Msg_CreateConcentratedPool_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.concentratedliquidity.poolmodel.concentrated.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -290,6 +315,20 @@ func (m *MsgCreateConcentratedPool) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.TickerPairing) > 0 {
+		i -= len(m.TickerPairing)
+		copy(dAtA[i:], m.TickerPairing)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TickerPairing)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.SpreadFactor.Size()
 		i -= size
@@ -391,6 +430,14 @@ func (m *MsgCreateConcentratedPool) Size() (n int) {
 	}
 	l = m.SpreadFactor.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TickerPairing)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -590,6 +637,70 @@ func (m *MsgCreateConcentratedPool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickerPairing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickerPairing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
@@ -63,6 +64,31 @@ func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCrea
 	return &types.MsgCreatePositionResponse{PositionId: positionData.ID, Amount0: positionData.Amount0, Amount1: positionData.Amount1, LiquidityCreated: positionData.Liquidity, LowerTick: positionData.LowerTick, UpperTick: positionData.UpperTick}, nil
 }
 
+// CreateMultiplePositions creates all of the given positions in the pool on behalf of the sender.
+// Since messages are executed atomically, if any of the positions fails to be created, none of them are.
+func (server msgServer) CreateMultiplePositions(goCtx context.Context, msg *types.MsgCreateMultiplePositions) (*types.MsgCreateMultiplePositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	createdPositions := make([]types.MsgCreatePositionResponse, 0, len(msg.Positions))
+	for _, position := range msg.Positions {
+		positionData, err := server.keeper.CreatePosition(ctx, msg.PoolId, sender, position.TokensProvided, position.TokenMinAmount0, position.TokenMinAmount1, position.LowerTick, position.UpperTick)
+		if err != nil {
+			return nil, err
+		}
+
+		createdPositions = append(createdPositions, types.MsgCreatePositionResponse{PositionId: positionData.ID, Amount0: positionData.Amount0, Amount1: positionData.Amount1, LiquidityCreated: positionData.Liquidity, LowerTick: positionData.LowerTick, UpperTick: positionData.UpperTick})
+	}
+
+	// Note: create position events are emitted in keeper.createPosition(...)
+
+	return &types.MsgCreateMultiplePositionsResponse{Positions: createdPositions}, nil
+}
+
 func (server msgServer) AddToPosition(goCtx context.Context, msg *types.MsgAddToPosition) (*types.MsgAddToPositionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -198,3 +224,112 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+// RecoverPosition rebuilds the spread reward accumulator entry of a position from the canonical position state.
+// Only the governance module account is allowed to execute this message.
+func (server msgServer) RecoverPosition(goCtx context.Context, msg *types.MsgRecoverPosition) (*types.MsgRecoverPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	govAddr := server.keeper.accountKeeper.GetModuleAccount(ctx, govtypes.ModuleName)
+	if msg.Sender != govAddr.GetAddress().String() {
+		return nil, types.ErrUnauthorizedGov
+	}
+
+	// Note: recover position event is emitted in keeper.recoverPositionSpreadRewardAccumulator(...)
+	if err := server.keeper.recoverPositionSpreadRewardAccumulator(ctx, msg.PositionId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecoverPositionResponse{}, nil
+}
+
+// SwapExactAmountInWithSqrtPriceLimit swaps up to the given token in, stopping at the given sqrt price limit.
+// The response contains the amount of token in actually consumed as well as the average execution price.
+func (server msgServer) SwapExactAmountInWithSqrtPriceLimit(goCtx context.Context, msg *types.MsgSwapExactAmountInWithSqrtPriceLimit) (*types.MsgSwapExactAmountInWithSqrtPriceLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: swap event is emitted in keeper.updatePoolForSwap(...)
+	tokenIn, tokenOut, err := server.keeper.SwapExactAmountInWithSqrtPriceLimit(ctx, sender, msg.PoolId, msg.TokenIn, msg.TokenOutDenom, msg.TokenOutMinAmount, msg.SqrtPriceLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// token out is guaranteed to be positive by the swap.
+	averageExecutionPrice := tokenIn.Amount.ToLegacyDec().QuoMut(tokenOut.Amount.ToLegacyDec())
+
+	return &types.MsgSwapExactAmountInWithSqrtPriceLimitResponse{TokenIn: tokenIn, TokenOut: tokenOut, AverageExecutionPrice: averageExecutionPrice}, nil
+}
+
+// RegisterStopCondition registers a stop condition for a position owned by the sender and escrows its bounty.
+func (server msgServer) RegisterStopCondition(goCtx context.Context, msg *types.MsgRegisterStopCondition) (*types.MsgRegisterStopConditionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: register stop condition event is emitted in keeper.RegisterStopCondition(...)
+	if err := server.keeper.RegisterStopCondition(ctx, sender, msg.PositionId, msg.LowerTick, msg.UpperTick, msg.Duration, msg.Bounty); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterStopConditionResponse{}, nil
+}
+
+// CancelStopCondition removes the stop condition of a position owned by the sender and refunds its bounty.
+func (server msgServer) CancelStopCondition(goCtx context.Context, msg *types.MsgCancelStopCondition) (*types.MsgCancelStopConditionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: cancel stop condition event is emitted in keeper.CancelStopCondition(...)
+	if err := server.keeper.CancelStopCondition(ctx, sender, msg.PositionId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelStopConditionResponse{}, nil
+}
+
+// ExecuteStopCondition withdraws a position whose stop condition is met to its owner and pays the bounty to the sender.
+func (server msgServer) ExecuteStopCondition(goCtx context.Context, msg *types.MsgExecuteStopCondition) (*types.MsgExecuteStopConditionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: execute stop condition event is emitted in keeper.ExecuteStopCondition(...)
+	amount0, amount1, bounty, err := server.keeper.ExecuteStopCondition(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteStopConditionResponse{Amount0: amount0, Amount1: amount1, Bounty: bounty}, nil
+}
+
+// SetPoolTickSpacing overrides the tick spacing of a pool with a finer one.
+// Only the governance module account is allowed to execute this message.
+func (server msgServer) SetPoolTickSpacing(goCtx context.Context, msg *types.MsgSetPoolTickSpacing) (*types.MsgSetPoolTickSpacingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	govAddr := server.keeper.accountKeeper.GetModuleAccount(ctx, govtypes.ModuleName)
+	if msg.Sender != govAddr.GetAddress().String() {
+		return nil, types.ErrUnauthorizedGov
+	}
+
+	if err := server.keeper.SetPoolTickSpacing(ctx, msg.PoolId, msg.TickSpacing); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPoolTickSpacingResponse{}, nil
+}
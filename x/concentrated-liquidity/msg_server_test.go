@@ -1,17 +1,19 @@
 package concentrated_liquidity_test
 
 import (
-	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
 	cl "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity"
 	clmodel "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v26/x/txfees/types"
 )
 
 // TestCreateConcentratedPool_Events tests that events are correctly emitted
@@ -33,11 +35,12 @@ func (s *KeeperTestSuite) TestCreateConcentratedPool_Events() {
 			expectedPoolCreatedEvent: 1,
 			expectedMessageEvents:    3, // 1 for coin spent, 1 for coin received, 1 for after pool create hook
 		},
-		"error: tickSpacing zero": {
-			denom0:        ETH,
-			denom1:        USDC,
-			tickSpacing:   0,
-			expectedError: fmt.Errorf("tick spacing must be positive"),
+		"tickSpacing zero: default tick spacing of the spread factor": {
+			denom0:                   ETH,
+			denom1:                   USDC,
+			tickSpacing:              0,
+			expectedPoolCreatedEvent: 1,
+			expectedMessageEvents:    3, // 1 for coin spent, 1 for coin received, 1 for after pool create hook
 		},
 		"error: tickSpacing not authorized": {
 			denom0:        ETH,
@@ -149,6 +152,78 @@ func (s *KeeperTestSuite) TestCreatePositionMsg() {
 	}
 }
 
+// TestCreateMultiplePositionsMsg tests that all positions in the message are created,
+// and that the message fails if any of them fails to be created.
+func (s *KeeperTestSuite) TestCreateMultiplePositionsMsg() {
+	testcases := map[string]struct {
+		positions     []types.PositionToCreate
+		expectedError bool
+	}{
+		"single position": {
+			positions: []types.PositionToCreate{
+				{LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, TokensProvided: DefaultCoins, TokenMinAmount0: osmomath.ZeroInt(), TokenMinAmount1: osmomath.ZeroInt()},
+			},
+		},
+		"ladder of positions": {
+			positions: []types.PositionToCreate{
+				{LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, TokensProvided: DefaultCoins, TokenMinAmount0: osmomath.ZeroInt(), TokenMinAmount1: osmomath.ZeroInt()},
+				{LowerTick: DefaultLowerTick - 100, UpperTick: DefaultUpperTick + 100, TokensProvided: DefaultCoins, TokenMinAmount0: osmomath.ZeroInt(), TokenMinAmount1: osmomath.ZeroInt()},
+				{LowerTick: DefaultUpperTick + 100, UpperTick: DefaultUpperTick + 200, TokensProvided: sdk.NewCoins(DefaultCoin0), TokenMinAmount0: osmomath.ZeroInt(), TokenMinAmount1: osmomath.ZeroInt()},
+			},
+		},
+		"error: second position has invalid upper tick": {
+			positions: []types.PositionToCreate{
+				{LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, TokensProvided: DefaultCoins, TokenMinAmount0: osmomath.ZeroInt(), TokenMinAmount1: osmomath.ZeroInt()},
+				{LowerTick: DefaultLowerTick, UpperTick: types.MaxTick + 1, TokensProvided: DefaultCoins, TokenMinAmount0: osmomath.ZeroInt(), TokenMinAmount1: osmomath.ZeroInt()},
+			},
+			expectedError: true,
+		},
+	}
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			pool := s.PrepareConcentratedPool()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+			// fund Sender to create positions
+			for range tc.positions {
+				s.FundAcc(s.TestAccs[0], DefaultCoins)
+			}
+
+			// Reset event counts to 0 by creating a new manager.
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			msg := &types.MsgCreateMultiplePositions{
+				PoolId:    pool.GetId(),
+				Sender:    s.TestAccs[0].String(),
+				Positions: tc.positions,
+			}
+			s.Require().NoError(msg.ValidateBasic())
+
+			response, err := msgServer.CreateMultiplePositions(s.Ctx, msg)
+			if tc.expectedError {
+				s.Require().Error(err)
+				s.Require().Nil(response)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Len(response.Positions, len(tc.positions))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtCreatePosition, len(tc.positions))
+
+			for i, createdPosition := range response.Positions {
+				position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, createdPosition.PositionId)
+				s.Require().NoError(err)
+				s.Require().Equal(s.TestAccs[0].String(), position.Address)
+				s.Require().Equal(tc.positions[i].LowerTick, position.LowerTick)
+				s.Require().Equal(tc.positions[i].UpperTick, position.UpperTick)
+				s.Require().Equal(createdPosition.LiquidityCreated, position.Liquidity)
+			}
+		})
+	}
+}
+
 // TestAddToPosition_Events tests that events are correctly emitted
 // when calling AddToPosition.
 func (s *KeeperTestSuite) TestAddToPosition_Events() {
@@ -682,3 +757,324 @@ func (s *KeeperTestSuite) TestTransferPositions_Events() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRecoverPosition() {
+	testcases := map[string]struct {
+		positionId       uint64
+		deleteAccumEntry bool
+		sharesToRemove   osmomath.Dec
+		isNonGovSender   bool
+		expectedEvents   int
+		expectedError    error
+	}{
+		"missing accumulator entry": {
+			positionId:       DefaultPositionId,
+			deleteAccumEntry: true,
+			expectedEvents:   1,
+		},
+		"accumulator entry shares mismatch": {
+			positionId:     DefaultPositionId,
+			sharesToRemove: osmomath.NewDec(100),
+			expectedEvents: 1,
+		},
+		"accumulator entry not corrupted": {
+			positionId:    DefaultPositionId,
+			expectedError: types.SpreadRewardPositionNotCorruptedError{PositionId: DefaultPositionId},
+		},
+		"position does not exist": {
+			positionId:    DefaultPositionId + 1,
+			expectedError: types.PositionIdNotFoundError{PositionId: DefaultPositionId + 1},
+		},
+		"sender is not the governance module": {
+			positionId:       DefaultPositionId,
+			deleteAccumEntry: true,
+			isNonGovSender:   true,
+			expectedError:    types.ErrUnauthorizedGov,
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+
+			spreadRewardAccumulator, err := s.App.ConcentratedLiquidityKeeper.GetSpreadRewardAccumulator(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			positionKey := types.KeySpreadRewardPositionAccumulator(DefaultPositionId)
+
+			if tc.deleteAccumEntry {
+				_, err = spreadRewardAccumulator.DeletePosition(positionKey)
+				s.Require().NoError(err)
+			}
+
+			if !tc.sharesToRemove.IsNil() {
+				err = spreadRewardAccumulator.RemoveFromPosition(positionKey, tc.sharesToRemove)
+				s.Require().NoError(err)
+			}
+
+			sender := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+			if tc.isNonGovSender {
+				sender = s.TestAccs[0].String()
+			}
+
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+			// Reset event counts to 0 by creating a new manager.
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test
+			response, err := msgServer.RecoverPosition(s.Ctx, &types.MsgRecoverPosition{
+				Sender:     sender,
+				PositionId: tc.positionId,
+			})
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Nil(response)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(response)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRecoverPosition, tc.expectedEvents)
+
+			// The accumulator entry must now reflect the canonical position liquidity.
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, tc.positionId)
+			s.Require().NoError(err)
+			spreadRewardAccumulator, err = s.App.ConcentratedLiquidityKeeper.GetSpreadRewardAccumulator(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			positionShares, err := spreadRewardAccumulator.GetPositionSize(positionKey)
+			s.Require().NoError(err)
+			s.Require().Equal(position.Liquidity, positionShares)
+
+			// The position is no longer bricked and can collect spread rewards.
+			_, err = s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, tc.positionId)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountInWithSqrtPriceLimit() {
+	// sqrt(4900), below the default current price of 5000.
+	sqrt4900 := osmomath.NewBigDec(70)
+
+	testcases := map[string]struct {
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+		tokenOutMinAmount osmomath.Int
+		sqrtPriceLimit    osmomath.BigDec
+		takerFee          osmomath.Dec
+
+		expectPartialFill bool
+		expectedError     bool
+	}{
+		"no limit: swap fully filled": {
+			tokenIn:           sdk.NewCoin(ETH, osmomath.NewInt(10000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: osmomath.OneInt(),
+			sqrtPriceLimit:    osmomath.ZeroBigDec(),
+		},
+		"limit not reached: swap fully filled": {
+			tokenIn:           sdk.NewCoin(ETH, osmomath.NewInt(10000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: osmomath.OneInt(),
+			sqrtPriceLimit:    sqrt4900,
+		},
+		"limit reached: swap partially filled": {
+			tokenIn:           DefaultCoin0,
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: osmomath.OneInt(),
+			sqrtPriceLimit:    sqrt4900,
+			expectPartialFill: true,
+		},
+		"limit reached with taker fee: taker fee only kept on the consumed token in": {
+			tokenIn:           DefaultCoin0,
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: osmomath.OneInt(),
+			sqrtPriceLimit:    sqrt4900,
+			takerFee:          osmomath.MustNewDecFromStr("0.01"),
+			expectPartialFill: true,
+		},
+		"error: limit in the wrong direction": {
+			tokenIn:           sdk.NewCoin(ETH, osmomath.NewInt(10000)),
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: osmomath.OneInt(),
+			sqrtPriceLimit:    osmomath.NewBigDec(80),
+			expectedError:     true,
+		},
+		"error: token out less than min amount due to partial fill": {
+			tokenIn:           DefaultCoin0,
+			tokenOutDenom:     USDC,
+			tokenOutMinAmount: DefaultAmt1,
+			sqrtPriceLimit:    sqrt4900,
+			expectedError:     true,
+		},
+	}
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+			takerFee := osmomath.ZeroDec()
+			if !tc.takerFee.IsNil() {
+				takerFee = tc.takerFee
+			}
+			poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			poolManagerParams.TakerFeeParams.DefaultTakerFee = takerFee
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+			takerFeeCollector := s.App.AccountKeeper.GetModuleAddress(txfeestypes.TakerFeeCollectorName)
+			takerFeeCollectorBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, takerFeeCollector, tc.tokenIn.Denom)
+
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+
+			msg := &types.MsgSwapExactAmountInWithSqrtPriceLimit{
+				PoolId:            pool.GetId(),
+				Sender:            sender.String(),
+				TokenIn:           tc.tokenIn,
+				TokenOutDenom:     tc.tokenOutDenom,
+				TokenOutMinAmount: tc.tokenOutMinAmount,
+				SqrtPriceLimit:    tc.sqrtPriceLimit,
+			}
+			s.Require().NoError(msg.ValidateBasic())
+
+			response, err := msgServer.SwapExactAmountInWithSqrtPriceLimit(s.Ctx, msg)
+			if tc.expectedError {
+				s.Require().Error(err)
+				s.Require().Nil(response)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Only the consumed portion of token in is taken from the sender, in addition to the taker fee.
+			s.Require().True(response.TokenIn.Amount.LTE(tc.tokenIn.Amount))
+			s.Require().Equal(tc.tokenOutDenom, response.TokenOut.Denom)
+			s.Require().Equal(response.TokenOut, s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenOutDenom))
+			s.Require().Equal(response.TokenIn.Amount.ToLegacyDec().Quo(response.TokenOut.Amount.ToLegacyDec()), response.AverageExecutionPrice)
+
+			pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			if tc.expectPartialFill {
+				s.Require().True(response.TokenIn.Amount.LT(tc.tokenIn.Amount))
+				s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenIn.Denom).Amount.IsPositive())
+				s.Require().Equal(tc.sqrtPriceLimit, pool.GetCurrentSqrtPrice())
+			} else {
+				s.Require().True(pool.GetCurrentSqrtPrice().GT(tc.sqrtPriceLimit))
+			}
+
+			// The taker fee is kept pro rata to the consumed share of token in, rounded up,
+			// and the rest of token in is left with the sender.
+			tokenInAfterTakerFee, takerFeeCharged := poolmanager.CalcTakerFeeExactIn(tc.tokenIn, takerFee)
+			expectedTakerFee := takerFeeCharged.Amount
+			if response.TokenIn.Amount.LT(tokenInAfterTakerFee.Amount) {
+				expectedTakerFee = takerFeeCharged.Amount.ToLegacyDec().MulInt(response.TokenIn.Amount).QuoInt(tokenInAfterTakerFee.Amount).Ceil().TruncateInt()
+			}
+			if tc.takerFee.IsNil() {
+				s.Require().True(expectedTakerFee.IsZero())
+			} else {
+				s.Require().True(expectedTakerFee.IsPositive())
+				s.Require().True(expectedTakerFee.LT(takerFeeCharged.Amount))
+			}
+			takerFeeCollectorBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, takerFeeCollector, tc.tokenIn.Denom)
+			s.Require().Equal(expectedTakerFee, takerFeeCollectorBalanceAfter.Amount.Sub(takerFeeCollectorBalanceBefore.Amount))
+			s.Require().Equal(tc.tokenIn.Amount.Sub(response.TokenIn.Amount).Sub(expectedTakerFee), s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenIn.Denom).Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetPoolTickSpacing() {
+	testcases := map[string]struct {
+		poolId                 uint64
+		tickSpacing            uint64
+		extraAuthorizedSpacing uint64
+		isNonGovSender         bool
+		expectedError          error
+	}{
+		"happy path: tick spacing 100 -> 10": {
+			poolId:      1,
+			tickSpacing: 10,
+		},
+		"sender is not the governance module": {
+			poolId:         1,
+			tickSpacing:    10,
+			isNonGovSender: true,
+			expectedError:  types.ErrUnauthorizedGov,
+		},
+		"pool does not exist": {
+			poolId:        2,
+			tickSpacing:   10,
+			expectedError: types.PoolNotFoundError{PoolId: 2},
+		},
+		"new tick spacing not authorized": {
+			poolId:        1,
+			tickSpacing:   20,
+			expectedError: types.InvalidTickSpacingUpdateError{PoolId: 1, CurrentTickSpacing: DefaultTickSpacing, NewTickSpacing: 20},
+		},
+		"new tick spacing not finer than current": {
+			poolId:        1,
+			tickSpacing:   1000,
+			expectedError: types.InvalidTickSpacingUpdateError{PoolId: 1, CurrentTickSpacing: DefaultTickSpacing, NewTickSpacing: 1000},
+		},
+		"initialized tick not divisible by new tick spacing": {
+			poolId:                 1,
+			tickSpacing:            30,
+			extraAuthorizedSpacing: 30,
+			expectedError:          types.InitializedTickNotDivisibleError{PoolId: 1, TickIndex: -100, TickSpacing: 30},
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+
+			if tc.extraAuthorizedSpacing != 0 {
+				params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+				params.AuthorizedTickSpacing = append(params.AuthorizedTickSpacing, tc.extraAuthorizedSpacing)
+				s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+			}
+
+			// Create a pool with tick spacing of 100, and initialize ticks -100 and 100.
+			concentratedPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+			_, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, concentratedPool.GetId(), owner, DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), -100, 100)
+			s.Require().NoError(err)
+
+			sender := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+			if tc.isNonGovSender {
+				sender = owner.String()
+			}
+
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+			// System under test
+			response, err := msgServer.SetPoolTickSpacing(s.Ctx, &types.MsgSetPoolTickSpacing{
+				Sender:      sender,
+				PoolId:      tc.poolId,
+				TickSpacing: tc.tickSpacing,
+			})
+
+			pool, poolErr := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, concentratedPool.GetId())
+			s.Require().NoError(poolErr)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Nil(response)
+				s.Require().Equal(DefaultTickSpacing, pool.GetTickSpacing())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(response)
+			s.Require().Equal(tc.tickSpacing, pool.GetTickSpacing())
+
+			// Positions can now be created at ticks that are multiples of the new tick spacing.
+			_, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, concentratedPool.GetId(), owner, DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), -90, 90)
+			s.Require().NoError(err)
+		})
+	}
+}
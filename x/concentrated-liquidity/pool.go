@@ -41,6 +41,13 @@ func (k Keeper) InitializePool(ctx sdk.Context, poolI poolmanagertypes.PoolI, cr
 	params := k.GetParams(ctx)
 	tickSpacing := concentratedPool.GetTickSpacing()
 	spreadFactor := concentratedPool.GetSpreadFactor(ctx)
+	if tickSpacing == 0 {
+		tickSpacing, err = getDefaultTickSpacing(params, spreadFactor)
+		if err != nil {
+			return err
+		}
+		concentratedPool.SetTickSpacing(tickSpacing)
+	}
 	poolId := concentratedPool.GetId()
 	quoteAsset := concentratedPool.GetToken1()
 	poolManagerParams := k.poolmanagerKeeper.GetParams(ctx)
@@ -142,6 +149,17 @@ func (k Keeper) GetPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
 	)
 }
 
+// GetPoolTypeGasCosts returns the base gas costs of swaps, position creations and withdrawals of
+// concentrated pools. This implements poolmanagertypes.PoolModuleGasCostsI.
+func (k Keeper) GetPoolTypeGasCosts(poolType poolmanagertypes.PoolType) poolmanagertypes.PoolTypeGasCosts {
+	return poolmanagertypes.PoolTypeGasCosts{
+		PoolType: poolType,
+		SwapGas:  types.ConcentratedSwapGas,
+		JoinGas:  types.ConcentratedJoinGas,
+		ExitGas:  types.ConcentratedExitGas,
+	}
+}
+
 // setPool stores a ConcentratedPoolExtension in the Keeper's KVStore.
 // It returns an error if the provided pool is not of type *model.Pool.
 func (k Keeper) setPool(ctx sdk.Context, pool types.ConcentratedPoolExtension) error {
@@ -317,6 +335,60 @@ func (k Keeper) DecreaseConcentratedPoolTickSpacing(ctx sdk.Context, poolIdToTic
 	return nil
 }
 
+// SetPoolTickSpacing overrides the tick spacing of the given pool with the given finer tick spacing.
+// Returns error if the pool does not exist, if the new tick spacing is not authorized or not less than
+// the current one, or if any initialized tick of the pool is not divisible by the new tick spacing.
+func (k Keeper) SetPoolTickSpacing(ctx sdk.Context, poolId uint64, newTickSpacing uint64) error {
+	pool, err := k.GetConcentratedPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if !k.validateTickSpacingUpdate(pool, k.GetParams(ctx), newTickSpacing) {
+		return types.InvalidTickSpacingUpdateError{PoolId: poolId, CurrentTickSpacing: pool.GetTickSpacing(), NewTickSpacing: newTickSpacing}
+	}
+
+	// Positions can only be modified at ticks that are multiples of the tick spacing,
+	// so every initialized tick must remain reachable with the new one.
+	initializedTicks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	for _, tick := range initializedTicks {
+		if tick.TickIndex%int64(newTickSpacing) != 0 {
+			return types.InitializedTickNotDivisibleError{PoolId: poolId, TickIndex: tick.TickIndex, TickSpacing: newTickSpacing}
+		}
+	}
+
+	pool.SetTickSpacing(newTickSpacing)
+	return k.setPool(ctx, pool)
+}
+
+// SetConcentratedPoolsMetadata sets the name and ticker pairing of each given pool, leaving the pool's creator unchanged.
+// Returns error if any of the pools does not exist or the metadata is invalid.
+func (k Keeper) SetConcentratedPoolsMetadata(ctx sdk.Context, poolIdToMetadataRecords []types.PoolIdToMetadataRecord) error {
+	for _, record := range poolIdToMetadataRecords {
+		if err := types.ValidatePoolMetadataFields(record.Name, record.TickerPairing); err != nil {
+			return err
+		}
+
+		pool, err := k.GetConcentratedPoolById(ctx, record.PoolId)
+		if err != nil {
+			return err
+		}
+
+		metadata := pool.GetMetadata()
+		metadata.Name = record.Name
+		metadata.TickerPairing = record.TickerPairing
+		pool.SetMetadata(metadata)
+		err = k.setPool(ctx, pool)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // validateTickSpacing returns true if the given tick spacing is one of the authorized tick spacings set in the
 // params. False otherwise.
 func (k Keeper) validateTickSpacing(params types.Params, tickSpacing uint64) bool {
@@ -328,6 +400,17 @@ func (k Keeper) validateTickSpacing(params types.Params, tickSpacing uint64) boo
 	return false
 }
 
+// getDefaultTickSpacing returns the default tick spacing of the given spread factor set in the params.
+// Returns error if there is none.
+func getDefaultTickSpacing(params types.Params, spreadFactor osmomath.Dec) (uint64, error) {
+	for _, defaultTickSpacing := range params.DefaultTickSpacings {
+		if defaultTickSpacing.SpreadFactor.Equal(spreadFactor) {
+			return defaultTickSpacing.TickSpacing, nil
+		}
+	}
+	return 0, types.NoDefaultTickSpacingError{SpreadFactor: spreadFactor}
+}
+
 // validateTickSpacingUpdate returns true if the given tick spacing is one of the authorized tick spacings set in the
 // params and is less than the current tick spacing. False otherwise.
 func (k Keeper) validateTickSpacingUpdate(pool types.ConcentratedPoolExtension, params types.Params, newTickSpacing uint64) bool {
@@ -3,6 +3,7 @@ package concentrated_liquidity_test
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -475,6 +476,52 @@ func (s *KeeperTestSuite) TestValidateAuthorizedQuoteDenoms() {
 	}
 }
 
+func (s *KeeperTestSuite) TestInitializePool_DefaultTickSpacing() {
+	tests := map[string]struct {
+		spreadFactor        osmomath.Dec
+		tickSpacing         uint64
+		expectedTickSpacing uint64
+		expectedErr         error
+	}{
+		"zero tick spacing gets the default of the spread factor": {
+			spreadFactor:        osmomath.MustNewDecFromStr("0.0005"),
+			expectedTickSpacing: 10,
+		},
+		"provided tick spacing is kept": {
+			spreadFactor:        osmomath.MustNewDecFromStr("0.0005"),
+			tickSpacing:         1000,
+			expectedTickSpacing: 1000,
+		},
+		"error: no default tick spacing for the spread factor": {
+			spreadFactor: osmomath.MustNewDecFromStr("0.0002"),
+			expectedErr:  types.NoDefaultTickSpacingError{SpreadFactor: osmomath.MustNewDecFromStr("0.0002")},
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.FundAcc(s.TestAccs[0], s.App.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee)
+
+			// Allow the spread factor without a default tick spacing to be used.
+			params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+			params.AuthorizedSpreadFactors = append(params.AuthorizedSpreadFactors, osmomath.MustNewDecFromStr("0.0002"))
+			s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+
+			poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, clmodel.NewMsgCreateConcentratedPool(s.TestAccs[0], ETH, USDC, test.tickSpacing, test.spreadFactor))
+			if test.expectedErr != nil {
+				s.Require().ErrorContains(err, test.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedTickSpacing, pool.GetTickSpacing())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestDecreaseConcentratedPoolTickSpacing() {
 	type positionRange struct {
 		lowerTick int64
@@ -556,6 +603,68 @@ func (s *KeeperTestSuite) TestDecreaseConcentratedPoolTickSpacing() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSetConcentratedPoolsMetadata() {
+	tests := []struct {
+		name                    string
+		poolIdToMetadataRecords []types.PoolIdToMetadataRecord
+		expectedErr             error
+	}{
+		{
+			name:                    "happy path: set name and ticker pairing",
+			poolIdToMetadataRecords: []types.PoolIdToMetadataRecord{{PoolId: 1, Name: "Ether / USD Coin", TickerPairing: "ETH/USDC"}},
+		},
+		{
+			name:                    "happy path: clear name and ticker pairing",
+			poolIdToMetadataRecords: []types.PoolIdToMetadataRecord{{PoolId: 1}},
+		},
+		{
+			name:                    "error: pool does not exist",
+			poolIdToMetadataRecords: []types.PoolIdToMetadataRecord{{PoolId: 2, Name: "Ether / USD Coin"}},
+			expectedErr:             types.PoolNotFoundError{PoolId: 2},
+		},
+		{
+			name:                    "error: name too long",
+			poolIdToMetadataRecords: []types.PoolIdToMetadataRecord{{PoolId: 1, Name: strings.Repeat("a", types.MaxPoolNameLength+1)}},
+			expectedErr:             types.PoolMetadataFieldTooLongError{Field: "name", Length: types.MaxPoolNameLength + 1, MaxLength: types.MaxPoolNameLength},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+
+			// Create a pool with metadata so that setting it can be observed
+			s.FundAcc(owner, s.App.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee)
+			createPoolMsg := clmodel.NewMsgCreateConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, DefaultZeroSpreadFactor)
+			createPoolMsg.Name = "ETH pool"
+			createPoolMsg.TickerPairing = "WETH/USDC"
+			poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, createPoolMsg)
+			s.Require().NoError(err)
+
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+			s.Require().NoError(err)
+			originalMetadata := types.PoolMetadata{Name: "ETH pool", TickerPairing: "WETH/USDC", Creator: owner.String()}
+			s.Require().Equal(originalMetadata, pool.GetMetadata())
+
+			err = s.App.ConcentratedLiquidityKeeper.SetConcentratedPoolsMetadata(s.Ctx, test.poolIdToMetadataRecords)
+
+			pool, getErr := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+			s.Require().NoError(getErr)
+			if test.expectedErr != nil {
+				s.Require().ErrorContains(err, test.expectedErr.Error())
+				s.Require().Equal(originalMetadata, pool.GetMetadata())
+				return
+			}
+			s.Require().NoError(err)
+
+			// The creator is left unchanged
+			record := test.poolIdToMetadataRecords[0]
+			s.Require().Equal(types.PoolMetadata{Name: record.Name, TickerPairing: record.TickerPairing, Creator: owner.String()}, pool.GetMetadata())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetTotalPoolLiquidity() {
 	var (
 		defaultPoolCoinOne = sdk.NewCoin(USDC, osmomath.OneInt())
@@ -201,6 +201,32 @@ func (k Keeper) GetUserPositions(ctx sdk.Context, addr sdk.AccAddress, poolId ui
 	return positions, nil
 }
 
+// getUserPositionsInRange returns all positions of the given owner in the given pool
+// whose lower and upper ticks match the provided tick range exactly.
+//
+// Returns error if:
+// - pool with the given id does not exist
+// - other internal database errors.
+func (k Keeper) getUserPositionsInRange(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int64) ([]model.Position, error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	positions, err := k.GetUserPositions(ctx, owner, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	positionsInRange := []model.Position{}
+	for _, position := range positions {
+		if position.LowerTick == lowerTick && position.UpperTick == upperTick {
+			positionsInRange = append(positionsInRange, position)
+		}
+	}
+
+	return positionsInRange, nil
+}
+
 // GetUserPositionsSerialized behaves similarly to GetUserPositions, but returns the positions in a way that can be paginated.
 func (k Keeper) GetUserPositionsSerialized(ctx sdk.Context, addr sdk.AccAddress, poolId uint64, pagination *query.PageRequest) ([]model.FullPositionBreakdown, *query.PageResponse, error) {
 	var prefix []byte
@@ -717,6 +743,11 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 			return err
 		}
 
+		// Stop conditions are registered by the position owner, so the bounty is refunded to the previous owner.
+		if err := k.removeStopConditionAndRefund(ctx, positionId); err != nil {
+			return err
+		}
+
 		// Check if transferring the last position in a pool.
 		anyPositionsRemainingInPool, err := k.HasAnyPositionForPool(ctx, position.PoolId)
 		if err != nil {
@@ -0,0 +1,177 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	types "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types/genesis"
+)
+
+// PoolSnapshot is an in-memory copy of the complete state of a single concentrated liquidity pool:
+// the pool, its initialized ticks, accumulators, incentive records, pool hook contracts and positions.
+// It uses the genesis representation of the pool and position state.
+type PoolSnapshot struct {
+	PoolData     genesis.PoolData
+	PositionData []genesis.PositionData
+}
+
+// SnapshotPool returns a snapshot of the complete state of the pool with the given id.
+// The snapshot is detached from the store, so it is not affected by later state changes.
+//
+// State kept outside of the concentrated liquidity store, such as the pool balances and
+// the locks underlying positions, is not part of the snapshot.
+func (k Keeper) SnapshotPool(ctx sdk.Context, poolId uint64) (PoolSnapshot, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return PoolSnapshot{}, err
+	}
+
+	poolData, err := k.exportPoolData(ctx, pool)
+	if err != nil {
+		return PoolSnapshot{}, err
+	}
+
+	positionIds, err := k.GetPositionIDsByPoolID(ctx, poolId)
+	if err != nil {
+		return PoolSnapshot{}, err
+	}
+
+	positionData := make([]genesis.PositionData, 0, len(positionIds))
+	for _, positionId := range positionIds {
+		positionDataForPosition, err := k.exportPositionData(ctx, positionId)
+		if err != nil {
+			return PoolSnapshot{}, err
+		}
+		positionData = append(positionData, positionDataForPosition)
+	}
+
+	return PoolSnapshot{
+		PoolData:     poolData,
+		PositionData: positionData,
+	}, nil
+}
+
+// RestorePool restores the state of a pool to the given snapshot. The pool must still exist.
+// All the ticks, positions, incentive records and pool hook contracts of the pool are removed
+// before the snapshot state is written, so positions created after the snapshot was taken are deleted.
+//
+// The global position and incentive record ids, as well as state kept outside of the
+// concentrated liquidity store, are left untouched.
+// Returns error if:
+// - the snapshot pool can not be unpacked or does not exist.
+// - a snapshot position does not belong to the snapshot pool.
+func (k Keeper) RestorePool(ctx sdk.Context, snapshot PoolSnapshot) error {
+	var unpacker codectypes.AnyUnpacker = k.cdc
+	var pool types.ConcentratedPoolExtension
+	if err := unpacker.UnpackAny(snapshot.PoolData.Pool, &pool); err != nil {
+		return err
+	}
+
+	poolId := pool.GetId()
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return err
+	}
+	for _, positionData := range snapshot.PositionData {
+		if positionData.Position.PoolId != poolId {
+			return fmt.Errorf("snapshot position (%d) belongs to pool (%d), expected pool (%d)", positionData.Position.PositionId, positionData.Position.PoolId, poolId)
+		}
+	}
+
+	if err := k.clearPoolState(ctx, poolId); err != nil {
+		return err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	for _, tick := range snapshot.PoolData.Ticks {
+		k.SetTickInfo(ctx, poolId, tick.TickIndex, &tick.Info)
+	}
+
+	// overwrite the spread reward and incentive accumulators, which always exist for an existing pool
+	store := ctx.KVStore(k.storeKey)
+	spreadRewardAccum := snapshot.PoolData.SpreadRewardAccumulator
+	err := accum.OverwriteAccumulatorUnsafe(store, spreadRewardAccum.Name, spreadRewardAccum.AccumContent.AccumValue, spreadRewardAccum.AccumContent.TotalShares)
+	if err != nil {
+		return err
+	}
+	for _, incentiveAccum := range snapshot.PoolData.IncentivesAccumulators {
+		err = accum.OverwriteAccumulatorUnsafe(store, incentiveAccum.Name, incentiveAccum.AccumContent.AccumValue, incentiveAccum.AccumContent.TotalShares)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := k.setMultipleIncentiveRecords(ctx, snapshot.PoolData.IncentiveRecords); err != nil {
+		return err
+	}
+
+	for _, hookContract := range snapshot.PoolData.PoolHookContracts {
+		if err := k.setPoolHookContract(ctx, poolId, hookContract.ActionPrefix, hookContract.ContractAddress); err != nil {
+			return err
+		}
+	}
+
+	for _, positionData := range snapshot.PositionData {
+		if err := k.initPositionData(ctx, positionData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clearPoolState removes the ticks, positions and their accumulator records, full range liquidity,
+// incentive records and pool hook contracts of the given pool. The pool and its accumulators are kept.
+func (k Keeper) clearPoolState(ctx sdk.Context, poolId uint64) error {
+	store := ctx.KVStore(k.storeKey)
+
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	positionIds, err := k.GetPositionIDsByPoolID(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return err
+		}
+		if err := k.deletePosition(ctx, positionId, sdk.MustAccAddressFromBech32(position.Address), poolId); err != nil {
+			return err
+		}
+
+		store.Delete(accum.FormatPositionPrefixKey(spreadRewardAccumulator.GetName(), types.KeySpreadRewardPositionAccumulator(positionId)))
+		positionName := string(types.KeyPositionId(positionId))
+		for _, uptimeAccumulator := range uptimeAccumulators {
+			store.Delete(accum.FormatPositionPrefixKey(uptimeAccumulator.GetName(), positionName))
+		}
+	}
+
+	// full range liquidity is recomputed when the positions are set again
+	store.Delete(types.KeyFullRangeLiquidityPrefix(poolId))
+
+	osmoutils.DeleteAllKeysFromPrefix(store, types.KeyTickPrefixByPoolId(poolId))
+	osmoutils.DeleteAllKeysFromPrefix(store, types.KeyPoolIncentiveRecords(poolId))
+
+	for _, actionPrefix := range types.GetAllActionPrefixes() {
+		if err := k.setPoolHookContract(ctx, poolId, actionPrefix, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
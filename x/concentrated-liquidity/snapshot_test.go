@@ -0,0 +1,97 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// TestSnapshotRestorePool snapshots a pool with live state, mutates it by swapping, adding positions
+// and registering a pool hook, restores it and asserts that the pool state matches the snapshot again.
+func (s *KeeperTestSuite) TestSnapshotRestorePool() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(100, 0).UTC())
+
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.002"))
+	poolId := clPool.GetId()
+	otherPool := s.PrepareConcentratedPool()
+
+	s.FundAcc(clPool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(1000000))))
+	err := s.Clk.SetMultipleIncentiveRecords(s.Ctx, []types.IncentiveRecord{{
+		PoolId: poolId,
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingCoin: sdk.NewDecCoinFromDec(USDC, osmomath.NewDec(1000000)),
+			EmissionRate:  osmomath.NewDec(1),
+			StartTime:     s.Ctx.BlockTime(),
+		},
+		MinUptime: time.Nanosecond,
+	}})
+	s.Require().NoError(err)
+
+	positionIds := []uint64{
+		s.SetupDefaultPositionAcc(poolId, s.TestAccs[0]),
+		s.SetupFullRangePositionAcc(poolId, s.TestAccs[1]),
+	}
+	otherPositionId := s.SetupDefaultPositionAcc(otherPool.GetId(), s.TestAccs[0])
+
+	s.swapOneForZeroRightWithSpread(poolId, sdk.NewCoin(USDC, osmomath.NewInt(1000000000)), clPool.GetSpreadFactor(s.Ctx))
+	s.AddBlockTime(time.Hour)
+
+	claimableSpreadRewards := make([]sdk.Coins, len(positionIds))
+	for i, positionId := range positionIds {
+		claimableSpreadRewards[i], err = s.Clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+		s.Require().NoError(err)
+	}
+	s.Require().False(claimableSpreadRewards[0].IsZero())
+
+	snapshot, err := s.Clk.SnapshotPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Len(snapshot.PositionData, len(positionIds))
+	otherPoolSnapshot, err := s.Clk.SnapshotPool(s.Ctx, otherPool.GetId())
+	s.Require().NoError(err)
+
+	// mutate the pool state
+	newPositionId := s.SetupConsecutiveRangePositionAcc(poolId, s.TestAccs[2])
+	s.swapZeroForOneLeftWithSpread(poolId, sdk.NewCoin(ETH, osmomath.NewInt(1000000)), clPool.GetSpreadFactor(s.Ctx))
+	err = s.Clk.SetPoolHookContract(s.Ctx, poolId, types.BeforeActionPrefix(types.SwapExactAmountInPrefix), s.TestAccs[0].String())
+	s.Require().NoError(err)
+	s.AddBlockTime(time.Hour)
+
+	mutated, err := s.Clk.SnapshotPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().NotEqual(snapshot, mutated)
+
+	err = s.Clk.RestorePool(s.Ctx, snapshot)
+	s.Require().NoError(err)
+
+	restored, err := s.Clk.SnapshotPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(snapshot, restored)
+
+	// positions created after the snapshot are removed
+	s.Require().False(s.Clk.HasPosition(s.Ctx, newPositionId))
+	for i, positionId := range positionIds {
+		claimable, err := s.Clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().Equal(claimableSpreadRewards[i], claimable)
+	}
+
+	// other pools are left untouched
+	otherPoolAfterRestore, err := s.Clk.SnapshotPool(s.Ctx, otherPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(otherPoolSnapshot, otherPoolAfterRestore)
+	s.Require().True(s.Clk.HasPosition(s.Ctx, otherPositionId))
+
+	// a snapshot with positions of another pool can not be restored
+	invalidSnapshot := otherPoolSnapshot
+	invalidSnapshot.PositionData = append(invalidSnapshot.PositionData, snapshot.PositionData...)
+	err = s.Clk.RestorePool(s.Ctx, invalidSnapshot)
+	s.Require().ErrorContains(err, "belongs to pool")
+
+	// a pool that does not exist can not be snapshotted
+	_, err = s.Clk.SnapshotPool(s.Ctx, 100)
+	s.Require().Error(err)
+}
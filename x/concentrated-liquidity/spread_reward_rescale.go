@@ -0,0 +1,157 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// RescaleSpreadRewardAccumulator multiplies the spread reward accumulator of the given pool, the accumulator
+// snapshots and unclaimed rewards of all its positions, and its tick spread reward trackers by the given factor.
+// Only the given denom is rescaled, or all denoms if denom is empty. It is meant to be called from an upgrade
+// handler to correct accumulators inflated by a mispriced spread reward denom or a decimal bug.
+//
+// Invariants are checked before and after the rescale:
+// - every position of the pool must have a spread reward accumulator position
+// - the spread rewards claimable by all positions after the rescale must be covered by the pool's spread rewards balance
+// - the claimable spread rewards of the denoms that are not rescaled must be unchanged
+//
+// If dryRun is true, or any invariant fails, no state is written. The returned report describes
+// the effect of the rescale either way.
+func (k Keeper) RescaleSpreadRewardAccumulator(ctx sdk.Context, poolId uint64, denom string, factor osmomath.Dec, dryRun bool) (types.SpreadRewardRescaleReport, error) {
+	report := types.SpreadRewardRescaleReport{PoolId: poolId, Denom: denom, DryRun: dryRun}
+	if !factor.IsPositive() {
+		return report, types.InvalidSpreadRewardRescaleFactorError{Factor: factor}
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return report, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(cacheCtx, poolId)
+	if err != nil {
+		return report, err
+	}
+
+	positionIDs, err := k.GetPositionIDsByPoolID(cacheCtx, poolId)
+	if err != nil {
+		return report, err
+	}
+	report.NumPositions = len(positionIDs)
+
+	// Check that every position is tracked by the accumulator before modifying anything.
+	for _, positionId := range positionIDs {
+		if !spreadRewardAccumulator.HasPosition(types.KeySpreadRewardPositionAccumulator(positionId)) {
+			return report, types.SpreadRewardPositionNotFoundError{PositionId: positionId}
+		}
+	}
+
+	report.ClaimableBefore, err = k.getTotalClaimableSpreadRewards(cacheCtx, positionIDs)
+	if err != nil {
+		return report, err
+	}
+
+	ctx.Logger().Info("spread reward rescale start", "pool_id", poolId, "denom", denom, "factor", factor, "dry_run", dryRun)
+
+	value := scaleSpreadRewardDenom(spreadRewardAccumulator.GetValue(), denom, factor)
+	if err := accum.OverwriteAccumulatorUnsafe(cacheCtx.KVStore(k.storeKey), types.KeySpreadRewardPoolAccumulator(poolId), value, spreadRewardAccumulator.GetTotalShares()); err != nil {
+		return report, err
+	}
+
+	for _, positionId := range positionIDs {
+		positionKey := types.KeySpreadRewardPositionAccumulator(positionId)
+		positionSnapshot, err := spreadRewardAccumulator.GetPosition(positionKey)
+		if err != nil {
+			return report, err
+		}
+
+		if err := spreadRewardAccumulator.SetPositionIntervalAccumulation(positionKey, scaleSpreadRewardDenom(positionSnapshot.GetAccumValuePerShare(), denom, factor)); err != nil {
+			return report, err
+		}
+		if err := spreadRewardAccumulator.SetPositionUnclaimedRewards(positionKey, scaleSpreadRewardDenom(positionSnapshot.GetUnclaimedRewardsTotal(), denom, factor)); err != nil {
+			return report, err
+		}
+	}
+
+	ticks, err := k.GetAllInitializedTicksForPool(cacheCtx, poolId)
+	if err != nil {
+		return report, err
+	}
+	report.NumTicks = len(ticks)
+
+	for _, tick := range ticks {
+		tick.Info.SpreadRewardGrowthOppositeDirectionOfLastTraversal = scaleSpreadRewardDenom(tick.Info.SpreadRewardGrowthOppositeDirectionOfLastTraversal, denom, factor)
+		k.SetTickInfo(cacheCtx, poolId, tick.TickIndex, &tick.Info)
+	}
+
+	report.ClaimableAfter, err = k.getTotalClaimableSpreadRewards(cacheCtx, positionIDs)
+	if err != nil {
+		return report, err
+	}
+
+	spreadRewardsAddress := pool.GetSpreadRewardsAddress()
+	report.SpreadRewardsBalance = sdk.NewCoins()
+	for _, coin := range report.ClaimableBefore.Add(report.ClaimableAfter...) {
+		report.SpreadRewardsBalance = report.SpreadRewardsBalance.Add(k.bankKeeper.GetBalance(cacheCtx, spreadRewardsAddress, coin.Denom))
+	}
+
+	ctx.Logger().Info("spread reward rescale report", "pool_id", poolId, "num_positions", report.NumPositions, "num_ticks", report.NumTicks,
+		"claimable_before", report.ClaimableBefore, "claimable_after", report.ClaimableAfter, "spread_rewards_balance", report.SpreadRewardsBalance)
+
+	for _, coin := range report.ClaimableAfter {
+		if balance := report.SpreadRewardsBalance.AmountOf(coin.Denom); coin.Amount.GT(balance) {
+			return report, types.SpreadRewardRescaleInsolventError{PoolId: poolId, Denom: coin.Denom, Claimable: coin.Amount, Balance: balance}
+		}
+	}
+	if denom != "" {
+		for _, coin := range report.ClaimableBefore.Add(report.ClaimableAfter...) {
+			if coin.Denom == denom {
+				continue
+			}
+			before, after := report.ClaimableBefore.AmountOf(coin.Denom), report.ClaimableAfter.AmountOf(coin.Denom)
+			if !before.Equal(after) {
+				return report, types.SpreadRewardRescaleUnscaledDenomChangedError{PoolId: poolId, Denom: coin.Denom, Before: before, After: after}
+			}
+		}
+	}
+
+	if !dryRun {
+		writeCache()
+	}
+
+	ctx.Logger().Info("spread reward rescale end", "pool_id", poolId, "dry_run", dryRun)
+	return report, nil
+}
+
+// getTotalClaimableSpreadRewards returns the spread rewards claimable by all the given positions.
+func (k Keeper) getTotalClaimableSpreadRewards(ctx sdk.Context, positionIDs []uint64) (sdk.Coins, error) {
+	claimable := sdk.NewCoins()
+	for _, positionId := range positionIDs {
+		positionClaimable, err := k.GetClaimableSpreadRewards(ctx, positionId)
+		if err != nil {
+			return nil, err
+		}
+		claimable = claimable.Add(positionClaimable...)
+	}
+	return claimable, nil
+}
+
+// scaleSpreadRewardDenom returns the coins with the amount of the given denom multiplied by the factor
+// and truncated, or the amounts of all denoms if denom is empty. Coins truncated to zero are dropped.
+func scaleSpreadRewardDenom(coins sdk.DecCoins, denom string, factor osmomath.Dec) sdk.DecCoins {
+	scaled := sdk.DecCoins{}
+	for _, coin := range coins {
+		if denom == "" || coin.Denom == denom {
+			coin = sdk.DecCoin{Denom: coin.Denom, Amount: coin.Amount.MulTruncate(factor)}
+		}
+		if coin.Amount.IsZero() {
+			continue
+		}
+		scaled = append(scaled, coin)
+	}
+	return scaled
+}
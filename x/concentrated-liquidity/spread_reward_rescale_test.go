@@ -0,0 +1,120 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestRescaleSpreadRewardAccumulator() {
+	tests := map[string]struct {
+		denom       string
+		factor      osmomath.Dec
+		dryRun      bool
+		expectedErr error
+	}{
+		"halve one denom": {
+			denom:  ETH,
+			factor: osmomath.NewDecWithPrec(5, 1),
+		},
+		"halve all denoms": {
+			factor: osmomath.NewDecWithPrec(5, 1),
+		},
+		"dry run": {
+			denom:  ETH,
+			factor: osmomath.NewDecWithPrec(5, 1),
+			dryRun: true,
+		},
+		"claimable spread rewards exceed the balance": {
+			denom:       ETH,
+			factor:      osmomath.NewDec(2),
+			expectedErr: types.SpreadRewardRescaleInsolventError{},
+		},
+		"zero factor": {
+			factor:      osmomath.ZeroDec(),
+			expectedErr: types.InvalidSpreadRewardRescaleFactorError{Factor: osmomath.ZeroDec()},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.003"))
+			s.SetupDefaultPositions(pool.GetId())
+
+			// Swap in both directions so that spread rewards accrue in both denoms.
+			for _, tokenIn := range []sdk.Coin{sdk.NewCoin(ETH, osmomath.NewInt(100_000)), sdk.NewCoin(USDC, osmomath.NewInt(500_000_000))} {
+				s.FundAcc(s.TestAccs[4], sdk.NewCoins(tokenIn))
+				swapPool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+				s.Require().NoError(err)
+				tokenOutDenom := ETH
+				if tokenIn.Denom == ETH {
+					tokenOutDenom = USDC
+				}
+				_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[4], swapPool, tokenIn, tokenOutDenom, osmomath.OneInt(), swapPool.GetSpreadFactor(s.Ctx))
+				s.Require().NoError(err)
+			}
+
+			positionIDs, err := clKeeper.GetPositionIDsByPoolID(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			claimable := func() sdk.Coins {
+				total := sdk.NewCoins()
+				for _, positionId := range positionIDs {
+					positionClaimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+					s.Require().NoError(err)
+					total = total.Add(positionClaimable...)
+				}
+				return total
+			}
+			claimableBefore := claimable()
+			s.Require().True(claimableBefore.AmountOf(ETH).IsPositive())
+			s.Require().True(claimableBefore.AmountOf(USDC).IsPositive())
+			ticksBefore, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			report, err := clKeeper.RescaleSpreadRewardAccumulator(s.Ctx, pool.GetId(), tc.denom, tc.factor, tc.dryRun)
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedErr, err)
+				s.Require().Equal(claimableBefore, claimable())
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(pool.GetId(), report.PoolId)
+			s.Require().Equal(len(positionIDs), report.NumPositions)
+			s.Require().Equal(len(ticksBefore), report.NumTicks)
+			s.Require().Equal(claimableBefore, report.ClaimableBefore)
+			s.Require().True(report.ClaimableAfter.IsAllLTE(report.SpreadRewardsBalance))
+
+			// The rescaled claimable spread rewards are halved, up to the truncation of each position.
+			for _, denom := range []string{ETH, USDC} {
+				before, after := claimableBefore.AmountOf(denom), report.ClaimableAfter.AmountOf(denom)
+				if tc.denom != "" && denom != tc.denom {
+					s.Require().Equal(before, after)
+					continue
+				}
+				s.Require().True(after.MulRaw(2).LTE(before))
+				s.Require().True(after.MulRaw(2).GTE(before.SubRaw(2 * int64(len(positionIDs)))))
+			}
+
+			if tc.dryRun {
+				s.Require().True(report.DryRun)
+				s.Require().Equal(claimableBefore, claimable())
+				return
+			}
+			s.Require().Equal(report.ClaimableAfter, claimable())
+
+			// The tick spread reward trackers are rescaled.
+			ticksAfter, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			for i, tick := range ticksBefore {
+				trackerBefore := tick.Info.SpreadRewardGrowthOppositeDirectionOfLastTraversal
+				trackerAfter := ticksAfter[i].Info.SpreadRewardGrowthOppositeDirectionOfLastTraversal
+				s.Require().Equal(trackerBefore.AmountOf(ETH).MulTruncate(tc.factor), trackerAfter.AmountOf(ETH))
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+var (
+	maxSpreadRewardGrowthBigDec     = osmomath.BigDecFromDec(types.MaxSpreadRewardGrowthPerUnitLiquidity)
+	maxPositionAccruedRewardsBigDec = osmomath.BigDecFromDec(types.MaxPositionAccruedRewardsPerDenom)
+)
+
+// saturatingQuoTruncate returns x / y rounded down, saturated at MaxSpreadRewardGrowthPerUnitLiquidity,
+// and whether it saturated. Dividing a large spread reward charge by a tiny liquidity would otherwise
+// overflow Dec and panic mid-swap.
+// CONTRACT: y is positive.
+func saturatingQuoTruncate(x, y osmomath.Dec) (osmomath.Dec, bool) {
+	// x / y > max <=> x > max * y, compared in BigDec since max * y may overflow Dec.
+	if osmomath.BigDecFromDec(x).GT(maxSpreadRewardGrowthBigDec.Mul(osmomath.BigDecFromDec(y))) {
+		return types.MaxSpreadRewardGrowthPerUnitLiquidity.Clone(), true
+	}
+	return x.QuoTruncate(y), false
+}
+
+// saturatingAdd returns a + b, saturated at MaxSpreadRewardGrowthPerUnitLiquidity, and whether it saturated.
+// CONTRACT: a and b are non-negative and at most MaxSpreadRewardGrowthPerUnitLiquidity.
+func saturatingAdd(a, b osmomath.Dec) (osmomath.Dec, bool) {
+	growth, saturated := capSpreadRewardGrowth(a, b)
+	return a.Add(growth), saturated
+}
+
+// capSpreadRewardGrowth returns the part of growth that can be added to the spread reward growth value
+// without exceeding MaxSpreadRewardGrowthPerUnitLiquidity, and whether growth had to be capped.
+// CONTRACT: value and growth are non-negative and value is at most MaxSpreadRewardGrowthPerUnitLiquidity.
+func capSpreadRewardGrowth(value, growth osmomath.Dec) (osmomath.Dec, bool) {
+	headroom := types.MaxSpreadRewardGrowthPerUnitLiquidity.Sub(value)
+	if growth.GT(headroom) {
+		return headroom, true
+	}
+	return growth, false
+}
+
+// boundPositionAccruedRewards raises the accumulator value per share of the position so that the rewards
+// accrued by the position, i.e. its unclaimed rewards plus (accumulator value - position value) * shares,
+// do not exceed MaxPositionAccruedRewardsPerDenom for any denom. Saturated growth multiplied by a large
+// position liquidity would otherwise overflow Dec and panic when the rewards are claimed or the position's
+// liquidity is updated. The rewards above the bound are forfeited to the pool.
+func boundPositionAccruedRewards(accumulator *accum.AccumulatorObject, positionKey string) error {
+	position, err := accum.GetPosition(accumulator, positionKey)
+	if err != nil {
+		return err
+	}
+	if !position.NumShares.IsPositive() {
+		return nil
+	}
+
+	shares := osmomath.BigDecFromDec(position.NumShares)
+	raise := sdk.DecCoins{}
+	for _, value := range accumulator.GetValue() {
+		growth := value.Amount.Sub(position.AccumValuePerShare.AmountOf(value.Denom))
+		if !growth.IsPositive() {
+			continue
+		}
+
+		// Compared in BigDec since the accrued rewards may overflow Dec.
+		unclaimed := osmomath.BigDecFromDec(position.UnclaimedRewardsTotal.AmountOf(value.Denom))
+		if osmomath.BigDecFromDec(growth).Mul(shares).Add(unclaimed).LTE(maxPositionAccruedRewardsBigDec) {
+			continue
+		}
+
+		// (max - unclaimed) / shares is below growth, so it fits in Dec.
+		allowedGrowth := osmomath.ZeroBigDec()
+		if unclaimed.LT(maxPositionAccruedRewardsBigDec) {
+			allowedGrowth = maxPositionAccruedRewardsBigDec.Sub(unclaimed).QuoTruncate(shares)
+		}
+		raise = raise.Add(sdk.NewDecCoinFromDec(value.Denom, growth.Sub(allowedGrowth.Dec())))
+	}
+
+	if raise.IsZero() {
+		return nil
+	}
+	return accumulator.SetPositionIntervalAccumulation(positionKey, position.AccumValuePerShare.Add(raise...))
+}
+
+// isSpreadRewardGrowthSaturated returns true if the growth of any denom reached MaxSpreadRewardGrowthPerUnitLiquidity.
+func isSpreadRewardGrowthSaturated(spreadRewardGrowth sdk.DecCoins) bool {
+	for _, coin := range spreadRewardGrowth {
+		if coin.Amount.GTE(types.MaxSpreadRewardGrowthPerUnitLiquidity) {
+			return true
+		}
+	}
+	return false
+}
+
+// addSpreadRewardGrowth adds the spread reward growth per unit of liquidity of a swap to the pool's spread reward
+// accumulator, saturating the accumulator value of the denom at MaxSpreadRewardGrowthPerUnitLiquidity.
+// If the accumulator value of the denom is saturated, the denom is marked as saturated for the pool.
+//
+// Once saturated, the spread rewards charged in the denom are still sent to the spread rewards address but no
+// longer accrue to positions, so they remain unclaimable.
+func (k Keeper) addSpreadRewardGrowth(ctx sdk.Context, poolId uint64, spreadRewardAccumulator *accum.AccumulatorObject, spreadRewardGrowth sdk.DecCoin) {
+	growth, _ := capSpreadRewardGrowth(spreadRewardAccumulator.GetValue().AmountOf(spreadRewardGrowth.Denom), spreadRewardGrowth.Amount)
+	spreadRewardAccumulator.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoinFromDec(spreadRewardGrowth.Denom, growth)))
+
+	if spreadRewardAccumulator.GetValue().AmountOf(spreadRewardGrowth.Denom).GTE(types.MaxSpreadRewardGrowthPerUnitLiquidity) {
+		k.markSpreadRewardGrowthSaturated(ctx, poolId, spreadRewardGrowth.Denom)
+	}
+}
+
+// markSpreadRewardGrowthSaturated marks the spread reward growth of the denom as saturated in the given pool,
+// emitting an event the first time the denom saturates.
+func (k Keeper) markSpreadRewardGrowthSaturated(ctx sdk.Context, poolId uint64, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeySpreadRewardGrowthSaturated(poolId, denom)
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, []byte{1})
+
+	ctx.Logger().Error("spread reward growth saturated", "pool_id", poolId, "denom", denom)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSpreadRewardGrowthSaturated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+	))
+}
+
+// GetSpreadRewardGrowthSaturatedDenoms returns the denoms whose spread reward growth is saturated
+// in the given pool, in ascending order.
+func (k Keeper) GetSpreadRewardGrowthSaturatedDenoms(ctx sdk.Context, poolId uint64) []string {
+	prefix := types.KeySpreadRewardGrowthSaturatedPool(poolId)
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(prefix):]))
+	}
+	return denoms
+}
+
+// GetAllSpreadRewardGrowthSaturatedPoolIds returns the ids of the pools with at least one saturated denom,
+// in ascending order.
+func (k Keeper) GetAllSpreadRewardGrowthSaturatedPoolIds(ctx sdk.Context) []uint64 {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SpreadRewardGrowthSaturatedPrefix)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolId := sdk.BigEndianToUint64(iterator.Key()[len(types.SpreadRewardGrowthSaturatedPrefix) : len(types.SpreadRewardGrowthSaturatedPrefix)+types.Uint64ByteSize])
+		if len(poolIds) == 0 || poolIds[len(poolIds)-1] != poolId {
+			poolIds = append(poolIds, poolId)
+		}
+	}
+	return poolIds
+}
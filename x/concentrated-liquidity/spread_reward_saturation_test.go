@@ -0,0 +1,128 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/client"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestSwap_SpreadRewardGrowthSaturation() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.003"))
+	s.SetupDefaultPosition(pool.GetId())
+	querier := client.Querier{Keeper: *clKeeper}
+
+	// Bring the spread reward growth of ETH just below the max.
+	spreadRewardAccumulator, err := clKeeper.GetSpreadRewardAccumulator(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	spreadRewardAccumulator.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoinFromDec(ETH, types.MaxSpreadRewardGrowthPerUnitLiquidity.Sub(osmomath.OneDec()))))
+
+	swap := func() {
+		tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(1_000_000))
+		s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+		swapPool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], swapPool, tokenIn, USDC, osmomath.OneInt(), swapPool.GetSpreadFactor(s.Ctx))
+		s.Require().NoError(err)
+	}
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	swap()
+
+	// The growth is saturated instead of overflowing and the pool is flagged.
+	spreadRewardAccumulator, err = clKeeper.GetSpreadRewardAccumulator(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(types.MaxSpreadRewardGrowthPerUnitLiquidity, spreadRewardAccumulator.GetValue().AmountOf(ETH))
+	s.Require().Equal([]string{ETH}, clKeeper.GetSpreadRewardGrowthSaturatedDenoms(s.Ctx, pool.GetId()))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSpreadRewardGrowthSaturated, 1)
+
+	// Swapping again keeps the growth saturated without emitting another event.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	swap()
+	spreadRewardAccumulator, err = clKeeper.GetSpreadRewardAccumulator(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(types.MaxSpreadRewardGrowthPerUnitLiquidity, spreadRewardAccumulator.GetValue().AmountOf(ETH))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSpreadRewardGrowthSaturated, 0)
+
+	// A second pool is not saturated.
+	otherPool := s.PrepareConcentratedPool()
+
+	expectedPools := []queryproto.SpreadRewardGrowthSaturatedPool{{PoolId: pool.GetId(), Denoms: []string{ETH}}}
+	res, err := querier.SpreadRewardGrowthSaturatedPools(s.Ctx, queryproto.SpreadRewardGrowthSaturatedPoolsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPools, res.Pools)
+
+	res, err = querier.SpreadRewardGrowthSaturatedPools(s.Ctx, queryproto.SpreadRewardGrowthSaturatedPoolsRequest{PoolId: pool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPools, res.Pools)
+
+	res, err = querier.SpreadRewardGrowthSaturatedPools(s.Ctx, queryproto.SpreadRewardGrowthSaturatedPoolsRequest{PoolId: otherPool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Pools)
+
+	// The saturated denoms are exported and imported with the pool.
+	exported := clKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]string{ETH}, exported.PoolData[0].SpreadRewardGrowthSaturatedDenoms)
+	s.Require().Empty(exported.PoolData[1].SpreadRewardGrowthSaturatedDenoms)
+
+	s.SetupTest()
+	s.App.ConcentratedLiquidityKeeper.InitGenesis(s.Ctx, *exported)
+	s.Require().Equal([]string{ETH}, s.App.ConcentratedLiquidityKeeper.GetSpreadRewardGrowthSaturatedDenoms(s.Ctx, pool.GetId()))
+}
+
+func (s *KeeperTestSuite) TestCrossTick_SpreadRewardGrowthSaturation() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	tickInfo, err := s.Clk.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+	s.Require().NoError(err)
+	uptimeAccums, err := s.Clk.GetUptimeAccumulators(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	// The swap's spread reward growth would take the spread reward growth global above the max.
+	spreadRewardAccumValue := sdk.NewDecCoins(sdk.NewDecCoinFromDec(ETH, types.MaxSpreadRewardGrowthPerUnitLiquidity.Sub(osmomath.OneDec())))
+	swapSpreadRewardGrowth := sdk.NewDecCoinFromDec(ETH, osmomath.NewDec(10))
+
+	err = s.Clk.CrossTick(s.Ctx, pool.GetId(), DefaultLowerTick, &tickInfo, swapSpreadRewardGrowth, spreadRewardAccumValue, uptimeAccums)
+	s.Require().NoError(err)
+
+	// The tick's spread reward growth is computed from the saturated spread reward growth global.
+	tickInfo, err = s.Clk.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec(ETH, types.MaxSpreadRewardGrowthPerUnitLiquidity)), tickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal)
+	s.Require().True(tickInfo.SpreadRewardGrowthSaturated)
+}
+
+func (s *KeeperTestSuite) TestClaim_PositionAccruedRewardsBound() {
+	s.SetupTest()
+	spreadRewardAccumulator := s.prepareSpreadRewardsAccumulator()
+	positionKey := types.KeySpreadRewardPositionAccumulator(1)
+
+	// Saturated growth multiplied by the position's liquidity would overflow Dec.
+	liquidity := osmomath.MustNewDecFromStr("10000000000000000000000000000000000000000")
+	err := spreadRewardAccumulator.NewPosition(positionKey, liquidity, nil)
+	s.Require().NoError(err)
+	spreadRewardAccumulator.AddToAccumulator(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(ETH, types.MaxSpreadRewardGrowthPerUnitLiquidity),
+		sdk.NewDecCoinFromDec(USDC, osmomath.OneDec()),
+	))
+
+	// The rewards of the saturated denom are claimed at the bound, the others in full.
+	claimed, _, err := cl.UpdateAccumAndClaimRewards(spreadRewardAccumulator, positionKey, sdk.DecCoins{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin(ETH, types.MaxPositionAccruedRewardsPerDenom.TruncateInt()),
+		sdk.NewCoin(USDC, liquidity.TruncateInt()),
+	), claimed)
+
+	// The position is up to date with the accumulator, so nothing is left to claim.
+	claimed, _, err = cl.UpdateAccumAndClaimRewards(spreadRewardAccumulator, positionKey, sdk.DecCoins{})
+	s.Require().NoError(err)
+	s.Require().True(claimed.IsZero())
+}
@@ -215,6 +215,31 @@ func (k Keeper) GetClaimableSpreadRewards(ctx sdk.Context, positionId uint64) (s
 	return k.prepareClaimableSpreadRewards(cacheCtx, positionId)
 }
 
+// GetPendingSpreadRewards returns the total amount of spread rewards that all positions of the given
+// owner in the given pool and tick range are eligible to claim. Positions whose ticks do not match
+// the range exactly are ignored. No state is modified.
+//
+// Returns error if:
+// - pool with the given id does not exist
+// - other internal database or math errors.
+func (k Keeper) GetPendingSpreadRewards(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (sdk.Coins, error) {
+	positions, err := k.getUserPositionsInRange(ctx, owner, poolId, lowerTick, upperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingSpreadRewards := sdk.NewCoins()
+	for _, position := range positions {
+		claimableSpreadRewards, err := k.GetClaimableSpreadRewards(ctx, position.PositionId)
+		if err != nil {
+			return nil, err
+		}
+		pendingSpreadRewards = pendingSpreadRewards.Add(claimableSpreadRewards...)
+	}
+
+	return pendingSpreadRewards, nil
+}
+
 // prepareClaimableSpreadRewards returns the amount of spread rewards that a position is eligible to claim.
 // Note that it mutates the internal state of the spread reward accumulator by setting the position's
 // unclaimed rewards to zero and update the position's accumulator value to reflect the
@@ -307,6 +332,65 @@ func (k Keeper) prepareClaimableSpreadRewards(ctx sdk.Context, positionId uint64
 	return spreadRewardsClaimed, nil
 }
 
+// recoverPositionSpreadRewardAccumulator rebuilds the spread reward accumulator entry of the given position
+// from the canonical position state. This is meant to recover positions whose accumulator entry is corrupted,
+// bricking spread reward collection and withdrawals.
+//
+// If the accumulator entry is missing, a new one is initialized with the position's liquidity and the current
+// spread reward growth inside the position's range. Any spread rewards accrued prior to the corruption cannot be
+// reconstructed and are forfeited. If the entry exists but its number of shares does not match the position's liquidity,
+// the shares are adjusted to match the position's liquidity, moving accrued rewards into the unclaimed rewards.
+//
+// Returns error if:
+// - the position does not exist
+// - the spread reward accumulator entry of the position is not corrupted
+// - fails to update the spread reward accumulator
+func (k Keeper) recoverPositionSpreadRewardAccumulator(ctx sdk.Context, positionId uint64) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	positionKey := types.KeySpreadRewardPositionAccumulator(positionId)
+
+	// If the position is missing from the accumulator, the full position liquidity is used
+	// to initialize a new accumulator entry. Otherwise, only the share discrepancy is applied.
+	liquidityDelta := position.Liquidity
+	if spreadRewardAccumulator.HasPosition(positionKey) {
+		positionShares, err := spreadRewardAccumulator.GetPositionSize(positionKey)
+		if err != nil {
+			return err
+		}
+
+		if positionShares.Equal(position.Liquidity) {
+			return types.SpreadRewardPositionNotCorruptedError{PositionId: positionId}
+		}
+
+		liquidityDelta = position.Liquidity.Sub(positionShares)
+	}
+
+	if err := k.initOrUpdatePositionSpreadRewardAccumulator(ctx, position.PoolId, position.LowerTick, position.UpperTick, positionId, liquidityDelta); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRecoverPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeLiquidity, position.Liquidity.String()),
+		),
+	})
+
+	return nil
+}
+
 // calculateSpreadRewardGrowth above or below the given tick.
 // If calculating spread reward growth for an upper tick, we consider the following two cases
 // 1. currentTick >= upperTick: If current Tick is GTE than the upper Tick, the spread reward growth would be pool spread reward growth - uppertick's spread reward growth outside
@@ -341,7 +425,9 @@ func updatePositionToInitValuePlusGrowthOutside(accumulator *accum.AccumulatorOb
 	if err != nil {
 		return err
 	}
-	return nil
+
+	// Bound the rewards the position accrued in the interval so that claiming them cannot overflow.
+	return boundPositionAccruedRewards(accumulator, positionKey)
 }
 
 // scaleDownSpreadRewardAmount scales down the spread reward amount by the scaling factor.
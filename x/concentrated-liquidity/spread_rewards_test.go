@@ -1150,6 +1150,49 @@ func (s *KeeperTestSuite) TestPrepareClaimableSpreadRewards() {
 // It validates that another position within the same tick does not affect the current position.
 // It also validates that the position's changes are applied at the right time relative to position's
 // spread reward accumulator creation or update.
+func (s *KeeperTestSuite) TestGetPendingSpreadRewards() {
+	s.SetupTest()
+	owner, otherAcc := s.TestAccs[0], s.TestAccs[1]
+
+	clPool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.002"))
+	poolId := clPool.GetId()
+
+	// Two positions of the owner in the default range are expected to be summed up.
+	// The owner's full range position and the other account's position in the same range are expected to be ignored.
+	ownerPositionIds := []uint64{s.SetupDefaultPositionAcc(poolId, owner), s.SetupDefaultPositionAcc(poolId, owner)}
+	s.SetupFullRangePositionAcc(poolId, owner)
+	s.SetupDefaultPositionAcc(poolId, otherAcc)
+
+	// Swap to accrue spread rewards.
+	s.swapOneForZeroRightWithSpread(poolId, sdk.NewCoin(USDC, osmomath.NewInt(1000000)), clPool.GetSpreadFactor(s.Ctx))
+
+	expectedPendingSpreadRewards := sdk.NewCoins()
+	for _, positionId := range ownerPositionIds {
+		claimableSpreadRewards, err := s.Clk.GetClaimableSpreadRewards(s.Ctx, positionId)
+		s.Require().NoError(err)
+		expectedPendingSpreadRewards = expectedPendingSpreadRewards.Add(claimableSpreadRewards...)
+	}
+	s.Require().False(expectedPendingSpreadRewards.IsZero())
+
+	pendingSpreadRewards, err := s.Clk.GetPendingSpreadRewards(s.Ctx, poolId, owner, DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPendingSpreadRewards, pendingSpreadRewards)
+
+	// Querying again yields the same result since no state is modified.
+	pendingSpreadRewards, err = s.Clk.GetPendingSpreadRewards(s.Ctx, poolId, owner, DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPendingSpreadRewards, pendingSpreadRewards)
+
+	// No positions in the given range.
+	pendingSpreadRewards, err = s.Clk.GetPendingSpreadRewards(s.Ctx, poolId, owner, DefaultLowerTick, DefaultUpperTick+int64(DefaultTickSpacing))
+	s.Require().NoError(err)
+	s.Require().True(pendingSpreadRewards.IsZero())
+
+	// Pool does not exist.
+	_, err = s.Clk.GetPendingSpreadRewards(s.Ctx, poolId+1, owner, DefaultLowerTick, DefaultUpperTick)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: poolId + 1})
+}
+
 func (s *KeeperTestSuite) TestInitOrUpdateSpreadRewardAccumulatorPosition_UpdatingPosition() {
 	type updateSpreadRewardAccumPositionTest struct {
 		doesSpreadRewardGrowBeforeFirstCall           bool
@@ -0,0 +1,341 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// GetStopCondition returns the stop condition registered for the given position.
+// Returns error if no stop condition is registered for the position.
+func (k Keeper) GetStopCondition(ctx sdk.Context, positionId uint64) (types.StopCondition, error) {
+	stopCondition := types.StopCondition{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyStopCondition(positionId), &stopCondition)
+	if err != nil {
+		return types.StopCondition{}, err
+	}
+	if !found {
+		return types.StopCondition{}, types.StopConditionNotFoundError{PositionId: positionId}
+	}
+	return stopCondition, nil
+}
+
+// GetStopConditionsForPool returns all stop conditions registered for positions in the given pool,
+// ordered by position id.
+func (k Keeper) GetStopConditionsForPool(ctx sdk.Context, poolId uint64) ([]types.StopCondition, error) {
+	positionIds, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPoolStopConditions(poolId), func(bz []byte) (uint64, error) {
+		return sdk.BigEndianToUint64(bz), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stopConditions := make([]types.StopCondition, 0, len(positionIds))
+	for _, positionId := range positionIds {
+		stopCondition, err := k.GetStopCondition(ctx, positionId)
+		if err != nil {
+			return nil, err
+		}
+		stopConditions = append(stopConditions, stopCondition)
+	}
+	return stopConditions, nil
+}
+
+// RegisterStopCondition registers a stop condition for the given position on behalf of its owner and escrows
+// the bounty in the module account. If the position already has a stop condition, it is replaced and its
+// bounty is refunded to the owner.
+// Returns error if:
+// - the position does not exist or is not owned by the owner.
+// - the position has an active underlying lock, since it could not be withdrawn on execution.
+// - the owner does not have enough balance to pay for the bounty.
+func (k Keeper) RegisterStopCondition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, lowerTick, upperTick int64, duration time.Duration, bounty sdk.Coin) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != position.Address {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	positionHasActiveUnderlyingLock, lockId, err := k.PositionHasActiveUnderlyingLock(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if positionHasActiveUnderlyingLock {
+		return types.LockNotMatureError{PositionId: positionId, LockId: lockId}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeStopConditionAndRefund(ctx, positionId); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(bounty)); err != nil {
+		return err
+	}
+
+	stopCondition := types.StopCondition{
+		PositionId:     positionId,
+		PoolId:         position.PoolId,
+		Owner:          position.Address,
+		LowerTick:      lowerTick,
+		UpperTick:      upperTick,
+		Duration:       duration,
+		Bounty:         bounty,
+		RegisteredTime: ctx.BlockTime(),
+	}
+	k.setStopCondition(ctx, stopCondition)
+
+	// The tick history of the pool only covers the time since it first had a stop condition,
+	// so we record the current tick to have a starting point for the new condition.
+	k.recordStopConditionTick(ctx, pool.GetId(), pool.GetCurrentTick())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtRegisterStopCondition,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(lowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(upperTick, 10)),
+		sdk.NewAttribute(types.AttributeKeyStopConditionDuration, duration.String()),
+		sdk.NewAttribute(types.AttributeKeyBounty, bounty.String()),
+	))
+
+	return nil
+}
+
+// CancelStopCondition removes the stop condition of the given position and refunds the bounty to its owner.
+// Returns error if no stop condition is registered for the position or if the sender is not its owner.
+func (k Keeper) CancelStopCondition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) error {
+	stopCondition, err := k.GetStopCondition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if sender.String() != stopCondition.Owner {
+		return types.StopConditionOwnerMismatchError{Owner: stopCondition.Owner, Sender: sender.String()}
+	}
+
+	if err := k.removeStopConditionAndRefund(ctx, positionId); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCancelStopCondition,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyBounty, stopCondition.Bounty.String()),
+	))
+
+	return nil
+}
+
+// ExecuteStopCondition withdraws the full liquidity of the given position to its owner once its stop condition
+// is met, and pays the escrowed bounty to the executor. Any account can execute a stop condition.
+// Returns error if:
+// - no stop condition is registered for the position.
+// - the stop condition is not met.
+// - the position can not be withdrawn.
+func (k Keeper) ExecuteStopCondition(ctx sdk.Context, executor sdk.AccAddress, positionId uint64) (amount0, amount1 osmomath.Int, bounty sdk.Coin, err error) {
+	stopCondition, err := k.GetStopCondition(ctx, positionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coin{}, err
+	}
+
+	isMet, err := k.IsStopConditionMet(ctx, stopCondition)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coin{}, err
+	}
+	if !isMet {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coin{}, types.StopConditionNotMetError{PositionId: positionId}
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coin{}, err
+	}
+
+	// Defense in depth, stop conditions are removed whenever their position is transferred or fully withdrawn.
+	if position.Address != stopCondition.Owner {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coin{}, types.PositionOwnerMismatchError{PositionOwner: position.Address, Sender: stopCondition.Owner}
+	}
+	owner, err := sdk.AccAddressFromBech32(stopCondition.Owner)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coin{}, err
+	}
+
+	// The stop condition is removed prior to the withdrawal so that the bounty is not refunded to the owner
+	// when the position is deleted.
+	k.removeStopCondition(ctx, stopCondition)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, executor, sdk.NewCoins(stopCondition.Bounty)); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coin{}, err
+	}
+
+	amount0, amount1, err = k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtExecuteStopCondition,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, executor.String()),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(stopCondition.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+		sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+		sdk.NewAttribute(types.AttributeKeyBounty, stopCondition.Bounty.String()),
+	))
+
+	return amount0, amount1, stopCondition.Bounty, nil
+}
+
+// IsStopConditionMet returns true if the current tick of the pool has stayed outside of the range
+// [lower tick, upper tick) of the stop condition for at least its duration, as verified against the
+// tick history of the pool. The tick history only covers the time since the stop condition was
+// registered, so a stop condition can not be met before its duration has elapsed since registration.
+//
+// The tick history records the current tick of the pool after the last swap of each block that
+// moved it, so a price excursion back into the range within a single block is not observed.
+func (k Keeper) IsStopConditionMet(ctx sdk.Context, stopCondition types.StopCondition) (bool, error) {
+	windowStart := ctx.BlockTime().Add(-stopCondition.Duration)
+	if windowStart.Before(stopCondition.RegisteredTime) {
+		return false, nil
+	}
+
+	pool, err := k.getPoolById(ctx, stopCondition.PoolId)
+	if err != nil {
+		return false, err
+	}
+	if isTickInStopConditionRange(stopCondition, pool.GetCurrentTick()) {
+		return false, nil
+	}
+
+	// Walk the tick history backwards from the current block time. Every tick observed after the start of
+	// the window, as well as the tick in effect at the start of the window, must be outside of the range.
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyStopConditionTicks(stopCondition.PoolId)
+	iterator := store.ReverseIterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if isTickInStopConditionRange(stopCondition, parseStopConditionTick(iterator.Value())) {
+			return false, nil
+		}
+
+		observedTime, err := sdk.ParseTimeBytes(iterator.Key()[len(prefix):])
+		if err != nil {
+			return false, err
+		}
+		if !observedTime.After(windowStart) {
+			return true, nil
+		}
+	}
+
+	// The tick history does not cover the start of the window.
+	return false, nil
+}
+
+// recordStopConditionTick records the given tick as the current tick of the pool at the current block time,
+// overwriting any tick recorded earlier in the same block. This is a no-op for pools without stop conditions.
+// History that is no longer needed to verify any stop condition is pruned.
+func (k Keeper) recordStopConditionTick(ctx sdk.Context, poolId uint64, tick int64) {
+	store := ctx.KVStore(k.storeKey)
+	poolStopConditionsPrefix := types.KeyPoolStopConditions(poolId)
+	iterator := storetypes.KVStorePrefixIterator(store, poolStopConditionsPrefix)
+	hasStopConditions := iterator.Valid()
+	iterator.Close()
+	if !hasStopConditions {
+		return
+	}
+
+	store.Set(types.KeyStopConditionTick(poolId, ctx.BlockTime()), sdk.Uint64ToBigEndian(uint64(tick)))
+
+	k.pruneStopConditionTicks(ctx, poolId)
+}
+
+// pruneStopConditionTicks removes the ticks recorded for the pool before the start of the longest possible
+// stop condition window, except for the last of them, which is the tick in effect at the start of the window.
+func (k Keeper) pruneStopConditionTicks(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	cutoff := ctx.BlockTime().Add(-types.MaxStopConditionDuration)
+	iterator := store.Iterator(types.KeyStopConditionTicks(poolId), types.KeyStopConditionTick(poolId, cutoff))
+
+	keysToDelete := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(keysToDelete) <= 1 {
+		return
+	}
+	for _, key := range keysToDelete[:len(keysToDelete)-1] {
+		store.Delete(key)
+	}
+}
+
+// removeStopConditionAndRefund removes the stop condition of the given position, if any, and refunds its bounty
+// to its owner.
+func (k Keeper) removeStopConditionAndRefund(ctx sdk.Context, positionId uint64) error {
+	stopCondition, err := k.GetStopCondition(ctx, positionId)
+	if err != nil {
+		if _, ok := err.(types.StopConditionNotFoundError); ok {
+			return nil
+		}
+		return err
+	}
+
+	k.removeStopCondition(ctx, stopCondition)
+
+	owner, err := sdk.AccAddressFromBech32(stopCondition.Owner)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(stopCondition.Bounty))
+}
+
+func (k Keeper) setStopCondition(ctx sdk.Context, stopCondition types.StopCondition) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyStopCondition(stopCondition.PositionId), &stopCondition)
+	store.Set(types.KeyPoolStopCondition(stopCondition.PoolId, stopCondition.PositionId), sdk.Uint64ToBigEndian(stopCondition.PositionId))
+}
+
+// removeStopCondition deletes the stop condition from state. The tick history of the pool is deleted along with
+// its last stop condition.
+func (k Keeper) removeStopCondition(ctx sdk.Context, stopCondition types.StopCondition) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyStopCondition(stopCondition.PositionId))
+	store.Delete(types.KeyPoolStopCondition(stopCondition.PoolId, stopCondition.PositionId))
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPoolStopConditions(stopCondition.PoolId))
+	hasStopConditions := iterator.Valid()
+	iterator.Close()
+	if !hasStopConditions {
+		osmoutils.DeleteAllKeysFromPrefix(store, types.KeyStopConditionTicks(stopCondition.PoolId))
+	}
+}
+
+// isTickInStopConditionRange returns true if the tick is within [lower tick, upper tick) of the stop condition,
+// consistent with the active range of a position.
+func isTickInStopConditionRange(stopCondition types.StopCondition, tick int64) bool {
+	return tick >= stopCondition.LowerTick && tick < stopCondition.UpperTick
+}
+
+func parseStopConditionTick(bz []byte) int64 {
+	return int64(sdk.BigEndianToUint64(bz))
+}
@@ -0,0 +1,301 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+const stopConditionBountyDenom = "ubounty"
+
+var (
+	defaultStopConditionBounty   = sdk.NewCoin(stopConditionBountyDenom, osmomath.NewInt(1000))
+	defaultStopConditionDuration = time.Hour
+	// the default position's pool is at DefaultCurrTick, which is below this range.
+	defaultStopConditionLowerTick = DefaultCurrTick + 100
+	defaultStopConditionUpperTick = DefaultCurrTick + 200
+)
+
+// registerDefaultStopCondition funds the owner with the default bounty and registers the default stop condition
+// for the given position.
+func (s *KeeperTestSuite) registerDefaultStopCondition(owner sdk.AccAddress, positionId uint64) {
+	s.FundAcc(owner, sdk.NewCoins(defaultStopConditionBounty))
+	err := s.Clk.RegisterStopCondition(s.Ctx, owner, positionId, defaultStopConditionLowerTick, defaultStopConditionUpperTick, defaultStopConditionDuration, defaultStopConditionBounty)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestRegisterStopCondition() {
+	tests := map[string]struct {
+		isNotOwner    bool
+		positionId    uint64
+		expectedError error
+	}{
+		"registered by the position owner": {
+			positionId: DefaultPositionId,
+		},
+		"position does not exist": {
+			positionId:    DefaultPositionId + 1,
+			expectedError: types.PositionIdNotFoundError{PositionId: DefaultPositionId + 1},
+		},
+		"sender is not the position owner": {
+			isNotOwner:    true,
+			positionId:    DefaultPositionId,
+			expectedError: types.NotPositionOwnerError{PositionId: DefaultPositionId, Address: s.TestAccs[1].String()},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+
+			sender := s.TestAccs[0]
+			if tc.isNotOwner {
+				sender = s.TestAccs[1]
+			}
+			s.FundAcc(sender, sdk.NewCoins(defaultStopConditionBounty))
+			moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+			err := s.Clk.RegisterStopCondition(s.Ctx, sender, tc.positionId, defaultStopConditionLowerTick, defaultStopConditionUpperTick, defaultStopConditionDuration, defaultStopConditionBounty)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, moduleAddr, stopConditionBountyDenom).IsZero())
+				return
+			}
+			s.Require().NoError(err)
+
+			// the bounty is escrowed in the module account.
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sender, stopConditionBountyDenom).IsZero())
+			s.Require().Equal(defaultStopConditionBounty, s.App.BankKeeper.GetBalance(s.Ctx, moduleAddr, stopConditionBountyDenom))
+
+			expectedStopCondition := types.StopCondition{
+				PositionId:     tc.positionId,
+				PoolId:         pool.GetId(),
+				Owner:          sender.String(),
+				LowerTick:      defaultStopConditionLowerTick,
+				UpperTick:      defaultStopConditionUpperTick,
+				Duration:       defaultStopConditionDuration,
+				Bounty:         defaultStopConditionBounty,
+				RegisteredTime: s.Ctx.BlockTime(),
+			}
+			stopCondition, err := s.Clk.GetStopCondition(s.Ctx, tc.positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedStopCondition, stopCondition)
+
+			stopConditions, err := s.Clk.GetStopConditionsForPool(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal([]types.StopCondition{expectedStopCondition}, stopConditions)
+
+			// the current tick is recorded at registration.
+			ticks, err := s.Clk.GetStopConditionTicks(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal([]int64{DefaultCurrTick}, ticks)
+
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRegisterStopCondition, 1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestIsStopConditionMet() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+	s.registerDefaultStopCondition(s.TestAccs[0], positionId)
+
+	stopCondition, err := s.Clk.GetStopCondition(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	isMet := func() bool {
+		isMet, err := s.Clk.IsStopConditionMet(s.Ctx, stopCondition)
+		s.Require().NoError(err)
+		return isMet
+	}
+
+	// the duration has not elapsed since registration.
+	s.Require().False(isMet())
+
+	// the pool briefly trades within the range.
+	s.AddBlockTime(defaultStopConditionDuration / 2)
+	s.Clk.RecordStopConditionTick(s.Ctx, pool.GetId(), defaultStopConditionLowerTick)
+	s.AddBlockTime(defaultStopConditionDuration / 2)
+	s.Clk.RecordStopConditionTick(s.Ctx, pool.GetId(), DefaultCurrTick)
+	s.Require().False(isMet())
+
+	// the tick in effect at the start of the window is within the range.
+	s.AddBlockTime(defaultStopConditionDuration / 2)
+	s.Require().False(isMet())
+
+	// the pool has stayed outside of the range for the whole window.
+	s.AddBlockTime(defaultStopConditionDuration / 2)
+	s.Require().True(isMet())
+
+	// the upper tick is excluded from the range.
+	s.Clk.RecordStopConditionTick(s.Ctx, pool.GetId(), defaultStopConditionUpperTick)
+	s.Require().True(isMet())
+}
+
+func (s *KeeperTestSuite) TestExecuteStopCondition() {
+	tests := map[string]struct {
+		timeElapsed   time.Duration
+		isCancelled   bool
+		expectedError error
+	}{
+		"stop condition is met": {
+			timeElapsed: defaultStopConditionDuration,
+		},
+		"stop condition is not met": {
+			timeElapsed:   defaultStopConditionDuration - time.Second,
+			expectedError: types.StopConditionNotMetError{PositionId: DefaultPositionId},
+		},
+		"stop condition was cancelled": {
+			timeElapsed:   defaultStopConditionDuration,
+			isCancelled:   true,
+			expectedError: types.StopConditionNotFoundError{PositionId: DefaultPositionId},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			owner, executor := s.TestAccs[0], s.TestAccs[1]
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			s.registerDefaultStopCondition(owner, positionId)
+			if tc.isCancelled {
+				s.Require().NoError(s.Clk.CancelStopCondition(s.Ctx, owner, positionId))
+			}
+
+			s.AddBlockTime(tc.timeElapsed)
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			amount0, amount1, bounty, err := s.Clk.ExecuteStopCondition(s.Ctx, executor, positionId)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().True(s.Clk.HasPosition(s.Ctx, positionId))
+				return
+			}
+			s.Require().NoError(err)
+
+			// the position is withdrawn to its owner and the bounty is paid to the executor.
+			s.Require().False(s.Clk.HasPosition(s.Ctx, positionId))
+			expectedOwnerBalance := ownerBalanceBefore.Add(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1))
+			s.Require().Equal(expectedOwnerBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().Equal(defaultStopConditionBounty, bounty)
+			s.Require().Equal(defaultStopConditionBounty, s.App.BankKeeper.GetBalance(s.Ctx, executor, stopConditionBountyDenom))
+
+			// the stop condition and the tick history of the pool are removed.
+			_, err = s.Clk.GetStopCondition(s.Ctx, positionId)
+			s.Require().ErrorIs(err, types.StopConditionNotFoundError{PositionId: positionId})
+			ticks, err := s.Clk.GetStopConditionTicks(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Empty(ticks)
+
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtExecuteStopCondition, 1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelStopCondition() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	owner := s.TestAccs[0]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.registerDefaultStopCondition(owner, positionId)
+
+	err := s.Clk.CancelStopCondition(s.Ctx, s.TestAccs[1], positionId)
+	s.Require().ErrorIs(err, types.StopConditionOwnerMismatchError{Owner: owner.String(), Sender: s.TestAccs[1].String()})
+
+	err = s.Clk.CancelStopCondition(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultStopConditionBounty, s.App.BankKeeper.GetBalance(s.Ctx, owner, stopConditionBountyDenom))
+
+	_, err = s.Clk.GetStopCondition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.StopConditionNotFoundError{PositionId: positionId})
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCancelStopCondition, 1)
+}
+
+// TestStopConditionRefunds tests that the bounty of a stop condition is refunded to its owner when the stop condition
+// is replaced or when its position is fully withdrawn or transferred.
+func (s *KeeperTestSuite) TestStopConditionRefunds() {
+	tests := map[string]struct {
+		removeStopCondition func(owner sdk.AccAddress, positionId uint64)
+	}{
+		"stop condition is replaced": {
+			removeStopCondition: func(owner sdk.AccAddress, positionId uint64) {
+				// the bounty of the new stop condition is escrowed instead.
+				s.registerDefaultStopCondition(owner, positionId)
+			},
+		},
+		"position is fully withdrawn": {
+			removeStopCondition: func(owner sdk.AccAddress, positionId uint64) {
+				position, err := s.Clk.GetPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+				_, _, err = s.Clk.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+				s.Require().NoError(err)
+			},
+		},
+		"position is transferred": {
+			removeStopCondition: func(owner sdk.AccAddress, positionId uint64) {
+				err := s.Clk.TransferPositions(s.Ctx, []uint64{positionId}, owner, s.TestAccs[2])
+				s.Require().NoError(err)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			owner := s.TestAccs[0]
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			// the last position of a pool can not be transferred.
+			s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+			s.registerDefaultStopCondition(owner, positionId)
+
+			tc.removeStopCondition(owner, positionId)
+
+			s.Require().Equal(defaultStopConditionBounty, s.App.BankKeeper.GetBalance(s.Ctx, owner, stopConditionBountyDenom))
+		})
+	}
+}
+
+// TestStopConditionTickHistory tests that swaps record the current tick of pools with stop conditions and that
+// the tick history is pruned past the maximum stop condition duration.
+func (s *KeeperTestSuite) TestStopConditionTickHistory() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+
+	// swaps in pools without stop conditions are not recorded.
+	s.swapOneForZeroRightWithSpread(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(1000)), osmomath.ZeroDec())
+	ticks, err := s.Clk.GetStopConditionTicks(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(ticks)
+
+	s.registerDefaultStopCondition(s.TestAccs[0], positionId)
+
+	s.AddBlockTime(time.Minute)
+	s.swapOneForZeroRightWithSpread(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(1000000)), osmomath.ZeroDec())
+	pool, err = s.Clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	ticks, err = s.Clk.GetStopConditionTicks(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(ticks, 2)
+	s.Require().Equal(pool.GetCurrentTick(), ticks[1])
+
+	// record a tick every day, only the last tick recorded before the maximum duration is kept.
+	maxDurationDays := int(types.MaxStopConditionDuration / (time.Hour * 24))
+	for i := 0; i < maxDurationDays+2; i++ {
+		s.AddBlockTime(time.Hour * 24)
+		s.Clk.RecordStopConditionTick(s.Ctx, pool.GetId(), int64(i))
+	}
+	ticks, err = s.Clk.GetStopConditionTicks(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(ticks, maxDurationDays+2)
+	s.Require().Equal(int64(maxDurationDays+1), ticks[len(ticks)-1])
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/swapstrategy"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v26/x/txfees/types"
 )
 
 // SwapState defines the state of a swap.
@@ -145,11 +146,13 @@ func (ss *SwapState) updateSpreadRewardGrowthGlobal(spreadRewardChargeTotal osmo
 		return osmomath.ZeroDec(), nil
 	}
 
-	// Calculate spread factors accrued per unit of liquidity, rounding down to avoid overdistribution
-	spreadFactorsAccruedPerUnitOfLiquidityScaled := spreadRewardChargeTotalScaled.QuoTruncate(ss.liquidity)
+	// Calculate spread factors accrued per unit of liquidity, rounding down to avoid overdistribution.
+	// Both the accrued spread factors and the global spread reward growth saturate at
+	// MaxSpreadRewardGrowthPerUnitLiquidity instead of overflowing, see addSpreadRewardGrowth.
+	spreadFactorsAccruedPerUnitOfLiquidityScaled, _ := saturatingQuoTruncate(spreadRewardChargeTotalScaled, ss.liquidity)
 
 	// Update global spread reward growth per unit liquidity
-	ss.globalSpreadRewardGrowthPerUnitLiquidity.AddMut(spreadFactorsAccruedPerUnitOfLiquidityScaled)
+	ss.globalSpreadRewardGrowthPerUnitLiquidity, _ = saturatingAdd(ss.globalSpreadRewardGrowthPerUnitLiquidity, spreadFactorsAccruedPerUnitOfLiquidityScaled)
 
 	return spreadFactorsAccruedPerUnitOfLiquidityScaled, nil
 }
@@ -265,6 +268,100 @@ func (k Keeper) SwapExactAmountOut(
 	return tokenInAmount, nil
 }
 
+// SwapExactAmountInWithSqrtPriceLimit swaps up to tokenIn for tokenOutDenom in the given pool,
+// stopping once the pool's sqrt price reaches sqrtPriceLimit. Unlike SwapExactAmountIn, reaching the
+// limit is not an error: the swap is partially filled and only the consumed portion of tokenIn is
+// taken from the sender. A zero sqrtPriceLimit is equivalent to no limit.
+// The taker fee is charged on the full tokenIn prior to the swap, same as for regular swaps routed
+// through the poolmanager. On a partial fill, the share of the taker fee charged on the unconsumed
+// portion of tokenIn is refunded to the sender.
+// Returns the consumed token in and the token out.
+func (k Keeper) SwapExactAmountInWithSqrtPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount osmomath.Int,
+	sqrtPriceLimit osmomath.BigDec,
+) (tokenInConsumed, tokenOut sdk.Coin, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return sdk.Coin{}, sdk.Coin{}, types.DenomDuplicatedError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if !pool.IsActive(ctx) {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("pool %d is not active", poolId)
+	}
+
+	tokenInAfterSubTakerFee, takerFeeCharged, err := k.poolmanagerKeeper.ChargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	spreadFactor := pool.GetSpreadFactor(ctx)
+
+	// Trigger before hook for SwapExactAmountIn prior to mutating state.
+	// If no contract is set, this will be a no-op.
+	err = k.BeforeSwapExactAmountIn(ctx, poolId, sender, tokenInAfterSubTakerFee, tokenOutDenom, tokenOutMinAmount, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// The swap internals operate on a price limit, so we convert the provided sqrt price limit.
+	// Zero is preserved, resulting in the min or max price limit depending on swap direction.
+	priceLimit := sqrtPriceLimit.Mul(sqrtPriceLimit)
+	tokenInConsumed, tokenOut, _, err = k.swapOutAmtGivenIn(ctx, sender, pool, tokenInAfterSubTakerFee, tokenOutDenom, spreadFactor, priceLimit)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// price impact protection.
+	if tokenOut.Amount.LT(tokenOutMinAmount) {
+		return sdk.Coin{}, sdk.Coin{}, types.AmountLessThanMinError{TokenAmount: tokenOut.Amount, TokenMin: tokenOutMinAmount}
+	}
+
+	if err := k.refundUnconsumedTakerFee(ctx, sender, tokenInAfterSubTakerFee, tokenInConsumed, takerFeeCharged); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	k.RecordTotalLiquidityIncrease(ctx, sdk.NewCoins(tokenInConsumed))
+	k.RecordTotalLiquidityDecrease(ctx, sdk.NewCoins(tokenOut))
+
+	// Trigger after hook for SwapExactAmountIn after mutating state.
+	// If no contract is set, this will be a no-op.
+	err = k.AfterSwapExactAmountIn(ctx, poolId, sender, tokenInConsumed, tokenOutDenom, tokenOutMinAmount, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Track volume for volume-splitting incentives
+	k.poolmanagerKeeper.TrackVolume(ctx, poolId, tokenInConsumed)
+
+	return tokenInConsumed, tokenOut, nil
+}
+
+// refundUnconsumedTakerFee refunds to the sender the share of the taker fee charged on the portion of
+// tokenInAfterSubTakerFee that was not consumed by a partially filled swap. The taker fee kept on the
+// consumed portion is rounded up, so that the refund never exceeds the unconsumed share.
+// No-op if the swap was fully filled or no taker fee was charged.
+func (k Keeper) refundUnconsumedTakerFee(ctx sdk.Context, sender sdk.AccAddress, tokenInAfterSubTakerFee, tokenInConsumed, takerFeeCharged sdk.Coin) error {
+	if takerFeeCharged.Amount.IsNil() || !takerFeeCharged.Amount.IsPositive() || !tokenInConsumed.Amount.LT(tokenInAfterSubTakerFee.Amount) {
+		return nil
+	}
+
+	takerFeeOnConsumed := takerFeeCharged.Amount.ToLegacyDec().MulInt(tokenInConsumed.Amount).QuoInt(tokenInAfterSubTakerFee.Amount).Ceil().TruncateInt()
+	refund := sdk.NewCoin(takerFeeCharged.Denom, takerFeeCharged.Amount.Sub(takerFeeOnConsumed))
+	if !refund.IsPositive() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, txfeestypes.TakerFeeCollectorName, sender, sdk.NewCoins(refund))
+}
+
 func (k Keeper) swapOutAmtGivenIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -535,7 +632,7 @@ func (k Keeper) computeOutAmtGivenIn(
 	// Add spread reward growth per share to the pool-global spread reward accumulator.
 	if updateAccumulators {
 		spreadRewardGrowth := sdk.DecCoin{Denom: tokenInMin.Denom, Amount: swapState.globalSpreadRewardGrowthPerUnitLiquidity}
-		spreadRewardAccumulator.AddToAccumulator(sdk.NewDecCoins(spreadRewardGrowth))
+		k.addSpreadRewardGrowth(ctx, poolId, spreadRewardAccumulator, spreadRewardGrowth)
 	}
 
 	// Coin amounts require int values
@@ -678,7 +775,7 @@ func (k Keeper) computeInAmtGivenOut(
 
 	// Add spread reward growth per share to the pool-global spread reward accumulator.
 	if updateAccumulators {
-		spreadRewardAccumulator.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoinFromDec(tokenInDenom, swapState.globalSpreadRewardGrowthPerUnitLiquidity)))
+		k.addSpreadRewardGrowth(ctx, poolId, spreadRewardAccumulator, sdk.NewDecCoinFromDec(tokenInDenom, swapState.globalSpreadRewardGrowthPerUnitLiquidity))
 	}
 
 	// coin amounts require int values
@@ -709,7 +806,8 @@ func emitSwapDebugLogs(ctx sdk.Context, swapState SwapState, reachedPrice osmoma
 
 // logic for crossing a tick during a swap
 //
-// if uptimeAccums is nil, fetches uptime accumulators.
+// if uptimeAccums is nil, fetches uptime accumulators and updates them until the current block time.
+// The fetched accumulators are stored in uptimeAccums so that they are fetched and updated only once per swap.
 // uptime accumulators are always mutated for the tick crossing.
 func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 	swapState SwapState, strategy swapstrategy.SwapStrategy,
@@ -727,11 +825,13 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 			if err != nil {
 				return swapState, err
 			}
-			uptimeAccums = &uptimeAccumsRaw
-		}
 
-		if err := k.updateGivenPoolUptimeAccumulatorsToNow(ctx, p, *uptimeAccums); err != nil {
-			return swapState, err
+			// Once updated, the pool's last liquidity update is the current block time,
+			// so updating again on subsequent tick crossings of the same swap would be a no-op.
+			if err := k.updateGivenPoolUptimeAccumulatorsToNow(ctx, p, uptimeAccumsRaw); err != nil {
+				return swapState, err
+			}
+			*uptimeAccums = uptimeAccumsRaw
 		}
 
 		// Retrieve the liquidity held in the next closest initialized tick
@@ -784,6 +884,7 @@ func (k Keeper) updatePoolForSwap(
 
 	// Spread factors should already be rounded up to a whole number dec, but we do this as a precaution
 	spreadFactorsRoundedUp := sdk.NewCoin(swapDetails.TokenIn.Denom, totalSpreadFactors.Ceil().TruncateInt())
+	tokenInWithSpreadFactors := swapDetails.TokenIn
 
 	// Remove the spread factors from the input token
 	swapDetails.TokenIn.Amount = swapDetails.TokenIn.Amount.Sub(spreadFactorsRoundedUp.Amount)
@@ -823,6 +924,8 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
+	k.recordStopConditionTick(ctx, poolId, pool.GetCurrentTick())
+
 	k.listeners.AfterConcentratedPoolSwap(ctx, swapDetails.Sender, poolId, sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut})
 
 	// Emit swap event. Note that we emit these at the layer of each pool module rather than the poolmanager module
@@ -831,6 +934,17 @@ func (k Keeper) updatePoolForSwap(
 	// Each new pool module will have to emit this event separately
 	events.EmitSwapEvent(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut})
 
+	// Emit the concentrated liquidity specific swap execution event, detailing the execution quality of the swap.
+	(&swapExecutionEvent{
+		sender:      swapDetails.Sender,
+		poolId:      poolId,
+		quoteDenom:  pool.GetToken1(),
+		tokenIn:     tokenInWithSpreadFactors,
+		tokenOut:    swapDetails.TokenOut,
+		spreadFee:   spreadFactorsRoundedUp,
+		currentTick: pool.GetCurrentTick(),
+	}).emit(ctx)
+
 	return err
 }
 
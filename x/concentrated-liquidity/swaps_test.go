@@ -3116,6 +3116,7 @@ func (s *KeeperTestSuite) TestUpdateSpreadRewardGrowthGlobal() {
 	tests := map[string]struct {
 		liquidity                        osmomath.Dec
 		spreadRewardChargeTotal          osmomath.Dec
+		initialSpreadRewardGrowthGlobal  osmomath.Dec
 		expectedSpreadRewardGrowthGlobal osmomath.Dec
 	}{
 		"zero liquidity -> no-op": {
@@ -3135,6 +3136,18 @@ func (s *KeeperTestSuite) TestUpdateSpreadRewardGrowthGlobal() {
 			// 10 / (20 * 10^18) = 5 * 10^-19, which we expect to truncate and leave 0.
 			expectedSpreadRewardGrowthGlobal: osmomath.ZeroDec(),
 		},
+		"spread reward growth overflowing Dec -> saturated": {
+			liquidity: osmomath.SmallestDec(),
+			// 10^50 / 10^-18 = 10^68, which overflows Dec.
+			spreadRewardChargeTotal:          osmomath.MustNewDecFromStr("100000000000000000000000000000000000000000000000000"),
+			expectedSpreadRewardGrowthGlobal: types.MaxSpreadRewardGrowthPerUnitLiquidity,
+		},
+		"spread reward growth global exceeding max -> saturated": {
+			liquidity:                        ten,
+			spreadRewardChargeTotal:          ten.Mul(ten),
+			initialSpreadRewardGrowthGlobal:  types.MaxSpreadRewardGrowthPerUnitLiquidity.Sub(ten),
+			expectedSpreadRewardGrowthGlobal: types.MaxSpreadRewardGrowthPerUnitLiquidity,
+		},
 	}
 
 	for name, tc := range tests {
@@ -3146,11 +3159,15 @@ func (s *KeeperTestSuite) TestUpdateSpreadRewardGrowthGlobal() {
 			swapState := cl.SwapState{}
 			swapState.SetLiquidity(tc.liquidity)
 			swapState.SetGlobalSpreadRewardGrowthPerUnitLiquidity(osmomath.ZeroDec())
+			if !tc.initialSpreadRewardGrowthGlobal.IsNil() {
+				swapState.SetGlobalSpreadRewardGrowthPerUnitLiquidity(tc.initialSpreadRewardGrowthGlobal)
+			}
 			swapState.SetGlobalSpreadRewardGrowth(osmomath.ZeroDec())
 
 			// System under test.
 			// TODO: Dont hardcode one here
-			swapState.UpdateSpreadRewardGrowthGlobal(tc.spreadRewardChargeTotal, osmomath.OneDec())
+			_, err := swapState.UpdateSpreadRewardGrowthGlobal(tc.spreadRewardChargeTotal, osmomath.OneDec())
+			s.Require().NoError(err)
 
 			// Assertion.
 			s.Require().Equal(tc.expectedSpreadRewardGrowthGlobal, swapState.GetGlobalSpreadRewardGrowthPerUnitLiquidity())
@@ -3787,3 +3804,70 @@ func (s *KeeperTestSuite) calculateExpectedTokens(tokenInDenom string, testMaxTi
 	}
 	return currentTotal
 }
+
+func (s *KeeperTestSuite) TestSwapExecutionEvent() {
+	spreadFactor := osmomath.MustNewDecFromStr("0.005")
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+	}{
+		"swap base for quote": {
+			tokenIn:       sdk.NewCoin(ETH, osmomath.NewInt(10_000)),
+			tokenOutDenom: USDC,
+		},
+		"swap quote for base": {
+			tokenIn:       sdk.NewCoin(USDC, osmomath.NewInt(50_000_000)),
+			tokenOutDenom: ETH,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, spreadFactor)
+			s.SetupDefaultPosition(pool.GetId())
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(tc.tokenIn))
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			tokenOut, err := s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, tc.tokenIn, tc.tokenOutDenom, osmomath.ZeroInt(), spreadFactor)
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSwapExecution, 1)
+
+			pool, err = s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			spreadFee := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetSpreadRewardsAddress(), tc.tokenIn.Denom)
+			s.Require().True(spreadFee.IsPositive())
+
+			// the effective price is in quote (token1) per base (token0), as the spot price.
+			expectedEffectivePrice := osmomath.BigDecFromSDKInt(tokenOut).Quo(osmomath.BigDecFromSDKInt(tc.tokenIn.Amount))
+			expectedSpreadFeeQuoteEquivalent := sdk.NewDecCoinFromDec(USDC, expectedEffectivePrice.Mul(osmomath.BigDecFromSDKInt(spreadFee.Amount)).Dec())
+			if tc.tokenIn.Denom == USDC {
+				expectedEffectivePrice = osmomath.BigDecFromSDKInt(tc.tokenIn.Amount).Quo(osmomath.BigDecFromSDKInt(tokenOut))
+				expectedSpreadFeeQuoteEquivalent = sdk.NewDecCoinFromDec(USDC, spreadFee.Amount.ToLegacyDec())
+			}
+
+			expectedAttributes := map[string]string{
+				types.AttributeKeyPoolId:                       fmt.Sprint(pool.GetId()),
+				types.AttributeKeyTokensIn:                     tc.tokenIn.String(),
+				types.AttributeKeyTokensOut:                    sdk.NewCoin(tc.tokenOutDenom, tokenOut).String(),
+				types.AttributeKeyEffectivePrice:               expectedEffectivePrice.String(),
+				types.AttributeKeySpreadFeeOnly:                spreadFee.String(),
+				types.AttributeKeySpreadFeeOnlyQuoteEquivalent: expectedSpreadFeeQuoteEquivalent.String(),
+				types.AttributeKeyCurrentTick:                  fmt.Sprint(pool.GetCurrentTick()),
+			}
+			for _, event := range s.Ctx.EventManager().Events() {
+				if event.Type != types.TypeEvtSwapExecution {
+					continue
+				}
+				for key, expectedValue := range expectedAttributes {
+					attr, found := event.GetAttribute(key)
+					s.Require().True(found, key)
+					s.Require().Equal(expectedValue, attr.Value, key)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package concentrated_liquidity_test
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -1553,3 +1554,46 @@ func (s *KeeperTestSuite) TestSwapOutGivenIn_GetLiquidityFromAmountsPositionBoun
 		s.Ctx = setupCtx
 	})
 }
+
+// TestSwap_CrossedTicksWrittenToState tests that the tick infos of all the ticks crossed during a swap
+// are written to state once the swap completes, matching the values computed when crossing them.
+func (s *KeeperTestSuite) TestSwap_CrossedTicksWrittenToState() {
+	s.SetupTest()
+	poolId, positionMetas := s.setupPoolAndPositions(tickSpacing100, defaultTickSpacingsAway, DefaultCoins)
+
+	// Swap left past the lower tick of the widest narrow range position, crossing the lower ticks
+	// of all narrow range positions. The extra amount accounts for the spread factor.
+	widestPosition := positionMetas[0]
+	tokenIn, _, _ := s.computeSwapAmounts(poolId, osmomath.BigDec{}, widestPosition.lowerTick, true, false)
+	tokenIn = tokenIn.Mul(osmomath.MustNewDecFromStr("1.1"))
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.swapZeroForOneLeftWithSpread(poolId, sdk.NewCoin(ETH, tokenIn.Ceil().TruncateInt()), osmomath.MustNewDecFromStr("0.01"))
+	s.assertPositionOutOfRange(poolId, widestPosition.lowerTick, widestPosition.upperTick)
+
+	crossedTicks := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.TypeEvtCrossTick {
+			continue
+		}
+		crossedTicks++
+
+		tickIndexAttr, found := event.GetAttribute(types.AttributeKeyTickIndex)
+		s.Require().True(found)
+		tickIndex, err := strconv.ParseInt(tickIndexAttr.Value, 10, 64)
+		s.Require().NoError(err)
+
+		tickInfo, err := s.App.ConcentratedLiquidityKeeper.GetTickInfo(s.Ctx, poolId, tickIndex)
+		s.Require().NoError(err)
+		s.Require().False(tickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal.IsZero())
+
+		spreadRewardGrowthAttr, found := event.GetAttribute(types.AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal)
+		s.Require().True(found)
+		s.Require().Equal(spreadRewardGrowthAttr.Value, tickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal.String())
+
+		uptimeGrowthAttr, found := event.GetAttribute(types.AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal)
+		s.Require().True(found)
+		s.Require().Equal(uptimeGrowthAttr.Value, tickInfo.UptimeTrackers.String())
+	}
+	s.Require().Equal(len(positionMetas), crossedTicks)
+}
@@ -3,7 +3,9 @@ package concentrated_liquidity
 import (
 	"strconv"
 
+	sdkprefix "cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -71,7 +73,11 @@ func (k Keeper) crossTick(ctx sdk.Context, poolId uint64, tickIndex int64, tickI
 	}
 
 	// subtract tick's spread reward growth opposite direction of last traversal from current spread reward growth global, including the spread reward growth of the current swap.
-	tickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal = spreadRewardAccumValue.Add(swapStateSpreadRewardGrowth).Sub(tickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal)
+	// The spread reward growth global is saturated the same way as when the swap's growth is added to the accumulator, see addSpreadRewardGrowth.
+	swapSpreadRewardGrowth, _ := capSpreadRewardGrowth(spreadRewardAccumValue.AmountOf(swapStateSpreadRewardGrowth.Denom), swapStateSpreadRewardGrowth.Amount)
+	spreadRewardGrowthGlobal := spreadRewardAccumValue.Add(sdk.NewDecCoinFromDec(swapStateSpreadRewardGrowth.Denom, swapSpreadRewardGrowth))
+	tickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal = spreadRewardGrowthGlobal.Sub(tickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal)
+	tickInfo.SpreadRewardGrowthSaturated = isSpreadRewardGrowthSaturated(spreadRewardGrowthGlobal)
 
 	// For each supported uptime, subtract tick's uptime growth outside from the respective uptime accumulator
 	// This is functionally equivalent to "flipping" the trackers once the tick is crossed
@@ -184,6 +190,42 @@ func (k Keeper) GetAllInitializedTicksForPool(ctx sdk.Context, poolId uint64) ([
 	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyTickPrefixByPoolId(poolId), ParseFullTickFromBytes)
 }
 
+// GetInitializedTicksForPoolPaginated returns a page of the initialized ticks of the given pool,
+// in ascending tick index order unless the pagination is reversed.
+// Returns error if the pool does not exist.
+func (k Keeper) GetInitializedTicksForPoolPaginated(ctx sdk.Context, poolId uint64, pagination *query.PageRequest) ([]genesis.FullTick, *query.PageResponse, error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, nil, err
+	}
+
+	ticksStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTickPrefixByPoolId(poolId))
+
+	ticks := []genesis.FullTick{}
+	pageRes, err := query.Paginate(ticksStore, pagination, func(key, value []byte) error {
+		tickIndex, err := types.TickIndexFromBytes(key)
+		if err != nil {
+			return err
+		}
+
+		tickInfo, err := ParseTickFromBz(value)
+		if err != nil {
+			return types.ValueParseError{Wrapped: err}
+		}
+
+		ticks = append(ticks, genesis.FullTick{
+			PoolId:    poolId,
+			TickIndex: tickIndex,
+			Info:      tickInfo,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ticks, pageRes, nil
+}
+
 // validateTickInRangeIsValid validates that given ticks are valid. That is:
 // - both lower and upper ticks are divisible by the tick spacing
 // - both lower and upper ticks are within MinTick and MaxTick range
@@ -7,6 +7,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
@@ -487,6 +488,34 @@ func (s *KeeperTestSuite) TestGetTickInfo() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGetInitializedTicksForPoolPaginated() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+
+	expectedTicks, err := s.Clk.GetAllInitializedTicksForPool(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(expectedTicks, 4)
+
+	firstPage, pageRes, err := s.Clk.GetInitializedTicksForPoolPaginated(s.Ctx, pool.GetId(), &query.PageRequest{Limit: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTicks[:3], firstPage)
+	s.Require().NotNil(pageRes.NextKey)
+
+	secondPage, pageRes, err := s.Clk.GetInitializedTicksForPoolPaginated(s.Ctx, pool.GetId(), &query.PageRequest{Key: pageRes.NextKey, Limit: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTicks[3:], secondPage)
+	s.Require().Nil(pageRes.NextKey)
+
+	reversed, _, err := s.Clk.GetInitializedTicksForPoolPaginated(s.Ctx, pool.GetId(), &query.PageRequest{Limit: 1, Reverse: true})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTicks[3:], reversed)
+
+	_, _, err = s.Clk.GetInitializedTicksForPoolPaginated(s.Ctx, pool.GetId()+1, nil)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: pool.GetId() + 1})
+}
+
 func (s *KeeperTestSuite) TestCrossTick() {
 	var (
 		preInitializedTickIndex     = DefaultCurrTick - 2
@@ -24,10 +24,12 @@ type ConcentratedPoolExtension interface {
 	GetTickSpacing() uint64
 	GetLiquidity() sdkmath.LegacyDec
 	GetLastLiquidityUpdate() time.Time
+	GetMetadata() PoolMetadata
 	SetCurrentSqrtPrice(newSqrtPrice osmomath.BigDec)
 	SetCurrentTick(newTick int64)
 	SetTickSpacing(newTickSpacing uint64)
 	SetLastLiquidityUpdate(newTime time.Time)
+	SetMetadata(metadata PoolMetadata)
 
 	UpdateLiquidity(newLiquidity osmomath.Dec)
 	ApplySwap(newLiquidity osmomath.Dec, newCurrentTick int64, newCurrentSqrtPrice osmomath.BigDec) error
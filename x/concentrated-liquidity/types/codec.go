@@ -13,15 +13,23 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 
 	// msgs
 	cdc.RegisterConcrete(&MsgCreatePosition{}, "osmosis/cl-create-position", nil)
+	cdc.RegisterConcrete(&MsgCreateMultiplePositions{}, "osmosis/cl-create-multiple-positions", nil)
 	cdc.RegisterConcrete(&MsgAddToPosition{}, "osmosis/cl-add-to-position", nil)
 	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "osmosis/cl-withdraw-position", nil)
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgRecoverPosition{}, "osmosis/cl-recover-position", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInWithSqrtPriceLimit{}, "osmosis/cl-swap-exact-in-sqrt-price-limit", nil)
+	cdc.RegisterConcrete(&MsgRegisterStopCondition{}, "osmosis/cl-register-stop-condition", nil)
+	cdc.RegisterConcrete(&MsgCancelStopCondition{}, "osmosis/cl-cancel-stop-condition", nil)
+	cdc.RegisterConcrete(&MsgExecuteStopCondition{}, "osmosis/cl-execute-stop-condition", nil)
+	cdc.RegisterConcrete(&MsgSetPoolTickSpacing{}, "osmosis/cl-set-pool-tick-spacing", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&SetPoolMetadataProposal{}, "osmosis/cl-set-pool-metadata-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -33,17 +41,25 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreatePosition{},
+		&MsgCreateMultiplePositions{},
 		&MsgAddToPosition{},
 		&MsgWithdrawPosition{},
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgRecoverPosition{},
+		&MsgSwapExactAmountInWithSqrtPriceLimit{},
+		&MsgRegisterStopCondition{},
+		&MsgCancelStopCondition{},
+		&MsgExecuteStopCondition{},
+		&MsgSetPoolTickSpacing{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypesv1.Content)(nil),
 		&CreateConcentratedLiquidityPoolsProposal{},
 		&TickSpacingDecreaseProposal{},
+		&SetPoolMetadataProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,6 +28,21 @@ const (
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
 	BaseGasFeeForTransferPosition       = 10_000
+	// Base gas costs of swaps, position creations and withdrawals, declared to the pool manager
+	// for gas estimation. Every initialized tick crossed during a swap costs additional gas.
+	ConcentratedSwapGas uint64 = 150_000
+	ConcentratedJoinGas uint64 = 200_000
+	ConcentratedExitGas uint64 = 170_000
+	// MaxPositionsPerCreateMultiplePositions bounds the number of positions
+	// that can be created in a single MsgCreateMultiplePositions.
+	MaxPositionsPerCreateMultiplePositions = 50
+	// MaxPoolNameLength bounds the length of a pool's metadata name.
+	MaxPoolNameLength = 64
+	// MaxPoolTickerPairingLength bounds the length of a pool's metadata ticker pairing.
+	MaxPoolTickerPairingLength = 32
+	// MaxStopConditionDuration bounds the duration of a stop condition, which in turn bounds
+	// how much tick history is kept for pools with stop conditions.
+	MaxStopConditionDuration = time.Hour * 24 * 7
 )
 
 var (
@@ -55,7 +70,24 @@ var (
 		osmomath.MustNewDecFromStr("0.003"),  // 0.3%
 		osmomath.MustNewDecFromStr("0.005"),  // 0.5%
 	}
+	// DefaultTickSpacings gives finer tick spacings to lower fee tiers, whose pools are
+	// expected to trade in tighter price ranges.
+	DefaultTickSpacings = []SpreadFactorTickSpacing{
+		{SpreadFactor: osmomath.ZeroDec(), TickSpacing: 1},
+		{SpreadFactor: osmomath.MustNewDecFromStr("0.0001"), TickSpacing: 1},
+		{SpreadFactor: osmomath.MustNewDecFromStr("0.0005"), TickSpacing: 10},
+		{SpreadFactor: osmomath.MustNewDecFromStr("0.001"), TickSpacing: 10},
+		{SpreadFactor: osmomath.MustNewDecFromStr("0.002"), TickSpacing: 100},
+		{SpreadFactor: osmomath.MustNewDecFromStr("0.003"), TickSpacing: 100},
+		{SpreadFactor: osmomath.MustNewDecFromStr("0.005"), TickSpacing: 100},
+	}
 	DefaultBalancerSharesDiscount = osmomath.MustNewDecFromStr("0.05")
+	// MaxSpreadRewardGrowthPerUnitLiquidity (10^38) bounds the pool-global spread reward growth per unit of liquidity
+	// of every denom. Swaps saturate the growth at this value instead of overflowing the accumulator.
+	MaxSpreadRewardGrowthPerUnitLiquidity = osmomath.MustNewDecFromStr("100000000000000000000000000000000000000")
+	// MaxPositionAccruedRewardsPerDenom (10^60) bounds the rewards of every denom a position accrues between two
+	// updates, since the growth multiplied by position liquidity may overflow Dec even below the saturated growth.
+	MaxPositionAccruedRewardsPerDenom = osmomath.MustNewDecFromStr("1000000000000000000000000000000000000000000000000000000000000")
 	// By default, we only authorize one nanosecond (one block) uptime as an option
 	DefaultAuthorizedUptimes                = []time.Duration{time.Nanosecond}
 	DefaultUnrestrictedPoolCreatorWhitelist = []string{}
@@ -20,6 +20,7 @@ var (
 	ErrNextTickInfoNil                    = errors.New("next tick info cannot be nil")
 	ErrPoolNil                            = errors.New("pool cannot be nil")
 	ErrCalculateSqrtPriceToTick           = errors.New("internal error in computing square roots within CalculateSqrtPriceToTick")
+	ErrUnauthorizedGov                    = errors.New("only the governance module is allowed to execute this message")
 )
 
 // x/concentrated-liquidity module sentinel errors.
@@ -86,6 +87,14 @@ func (e PositionIdNotFoundError) Error() string {
 	return fmt.Sprintf("position not found. position id (%d)", e.PositionId)
 }
 
+type SpreadRewardPositionNotCorruptedError struct {
+	PositionId uint64
+}
+
+func (e SpreadRewardPositionNotCorruptedError) Error() string {
+	return fmt.Sprintf("spread reward accumulator entry for position id (%d) is not corrupted, nothing to recover", e.PositionId)
+}
+
 type SpreadRewardPositionNotFoundError struct {
 	PositionId uint64
 }
@@ -509,6 +518,15 @@ func (e InvalidNextPositionIdError) Error() string {
 	return fmt.Sprintf("invalid next incentive record id (%d), must be positive", e.NextPositionId)
 }
 
+type PositionIdNotBelowNextPositionIdError struct {
+	PositionId     uint64
+	NextPositionId uint64
+}
+
+func (e PositionIdNotBelowNextPositionIdError) Error() string {
+	return fmt.Sprintf("position id (%d) must be less than the next position id (%d)", e.PositionId, e.NextPositionId)
+}
+
 type InvalidNextIncentiveRecordIdError struct {
 	NextIncentiveRecordId uint64
 }
@@ -711,6 +729,34 @@ func (e UnauthorizedTickSpacingError) Error() string {
 	return fmt.Sprintf("attempted to create pool with unauthorized tick spacing (%d), must be one of the following: (%d)", e.ProvidedTickSpacing, e.AuthorizedTickSpacings)
 }
 
+type NoDefaultTickSpacingError struct {
+	SpreadFactor osmomath.Dec
+}
+
+func (e NoDefaultTickSpacingError) Error() string {
+	return fmt.Sprintf("no tick spacing provided and there is no default tick spacing for spread factor (%s)", e.SpreadFactor)
+}
+
+type InvalidTickSpacingUpdateError struct {
+	PoolId             uint64
+	CurrentTickSpacing uint64
+	NewTickSpacing     uint64
+}
+
+func (e InvalidTickSpacingUpdateError) Error() string {
+	return fmt.Sprintf("tick spacing (%d) of pool (%d) must be authorized and less than the current tick spacing (%d)", e.NewTickSpacing, e.PoolId, e.CurrentTickSpacing)
+}
+
+type InitializedTickNotDivisibleError struct {
+	PoolId      uint64
+	TickIndex   int64
+	TickSpacing uint64
+}
+
+func (e InitializedTickNotDivisibleError) Error() string {
+	return fmt.Sprintf("initialized tick (%d) of pool (%d) is not divisible by tick spacing (%d)", e.TickIndex, e.PoolId, e.TickSpacing)
+}
+
 type NonPositiveLiquidityForNewPositionError struct {
 	LiquidityDelta osmomath.Dec
 	PositionId     uint64
@@ -956,3 +1002,77 @@ type InvalidForfeitedIncentivesLengthError struct {
 func (e InvalidForfeitedIncentivesLengthError) Error() string {
 	return fmt.Sprintf("attempted to redeposit incorrectly constructed forfeited incentives slice. forfeited incentives must have an entry for each supported uptime. forfeit entries: %d, expected: %d", e.ForfeitedIncentivesLength, e.ExpectedLength)
 }
+
+type PoolMetadataFieldTooLongError struct {
+	Field     string
+	Length    int
+	MaxLength int
+}
+
+func (e PoolMetadataFieldTooLongError) Error() string {
+	return fmt.Sprintf("pool metadata %s length (%d) exceeds the maximum length (%d)", e.Field, e.Length, e.MaxLength)
+}
+
+type StopConditionNotFoundError struct {
+	PositionId uint64
+}
+
+func (e StopConditionNotFoundError) Error() string {
+	return fmt.Sprintf("no stop condition registered for position (%d)", e.PositionId)
+}
+
+type StopConditionNotMetError struct {
+	PositionId uint64
+}
+
+func (e StopConditionNotMetError) Error() string {
+	return fmt.Sprintf("stop condition of position (%d) is not met", e.PositionId)
+}
+
+type StopConditionOwnerMismatchError struct {
+	Owner  string
+	Sender string
+}
+
+func (e StopConditionOwnerMismatchError) Error() string {
+	return fmt.Sprintf("stop condition owner mismatch, expected (%s), got (%s)", e.Owner, e.Sender)
+}
+
+type InvalidStopConditionDurationError struct {
+	Duration    time.Duration
+	MaxDuration time.Duration
+}
+
+func (e InvalidStopConditionDurationError) Error() string {
+	return fmt.Sprintf("stop condition duration (%s) must be positive and at most (%s)", e.Duration, e.MaxDuration)
+}
+
+type InvalidSpreadRewardRescaleFactorError struct {
+	Factor osmomath.Dec
+}
+
+func (e InvalidSpreadRewardRescaleFactorError) Error() string {
+	return fmt.Sprintf("spread reward rescale factor (%s) must be positive", e.Factor)
+}
+
+type SpreadRewardRescaleInsolventError struct {
+	PoolId    uint64
+	Denom     string
+	Claimable osmomath.Int
+	Balance   osmomath.Int
+}
+
+func (e SpreadRewardRescaleInsolventError) Error() string {
+	return fmt.Sprintf("claimable spread rewards (%s%s) of pool (%d) exceed the spread rewards balance (%s%s) after rescale", e.Claimable, e.Denom, e.PoolId, e.Balance, e.Denom)
+}
+
+type SpreadRewardRescaleUnscaledDenomChangedError struct {
+	PoolId uint64
+	Denom  string
+	Before osmomath.Int
+	After  osmomath.Int
+}
+
+func (e SpreadRewardRescaleUnscaledDenomChangedError) Error() string {
+	return fmt.Sprintf("claimable spread rewards of unscaled denom (%s) of pool (%d) changed from (%s) to (%s) after rescale", e.Denom, e.PoolId, e.Before, e.After)
+}
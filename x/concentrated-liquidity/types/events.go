@@ -1,20 +1,26 @@
 package types
 
 const (
-	TypeEvtCreatePosition            = "create_position"
-	TypeEvtWithdrawPosition          = "withdraw_position"
-	TypeEvtAddToPosition             = "add_to_position"
-	TypeEvtTotalCollectSpreadRewards = "total_collect_spread_rewards"
-	TypeEvtCollectSpreadRewards      = "collect_spread_rewards"
-	TypeEvtTotalCollectIncentives    = "total_collect_incentives"
-	TypeEvtCollectIncentives         = "collect_incentives"
-	TypeEvtCreateIncentive           = "create_incentive"
-	TypeEvtFungifyChargedPosition    = "fungify_charged_position"
-	TypeEvtMoveRewards               = "move_rewards"
-	TypeEvtCrossTick                 = "cross_tick"
-	TypeEvtTransferPositions         = "transfer_positions"
-	TypeEvtInitTick                  = "init_tick"
-	TypeEvtRemoveTick                = "remove_tick"
+	TypeEvtCreatePosition              = "create_position"
+	TypeEvtWithdrawPosition            = "withdraw_position"
+	TypeEvtAddToPosition               = "add_to_position"
+	TypeEvtTotalCollectSpreadRewards   = "total_collect_spread_rewards"
+	TypeEvtCollectSpreadRewards        = "collect_spread_rewards"
+	TypeEvtTotalCollectIncentives      = "total_collect_incentives"
+	TypeEvtCollectIncentives           = "collect_incentives"
+	TypeEvtCreateIncentive             = "create_incentive"
+	TypeEvtFungifyChargedPosition      = "fungify_charged_position"
+	TypeEvtMoveRewards                 = "move_rewards"
+	TypeEvtCrossTick                   = "cross_tick"
+	TypeEvtTransferPositions           = "transfer_positions"
+	TypeEvtInitTick                    = "init_tick"
+	TypeEvtRemoveTick                  = "remove_tick"
+	TypeEvtRecoverPosition             = "recover_position"
+	TypeEvtRegisterStopCondition       = "register_stop_condition"
+	TypeEvtCancelStopCondition         = "cancel_stop_condition"
+	TypeEvtExecuteStopCondition        = "execute_stop_condition"
+	TypeEvtSpreadRewardGrowthSaturated = "spread_reward_growth_saturated"
+	TypeEvtSwapExecution               = "swap_execution"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -50,4 +56,11 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeKeyStopConditionDuration                              = "duration"
+	AttributeKeyBounty                                             = "bounty"
+	AttributeKeyDenom                                              = "denom"
+	AttributeKeyEffectivePrice                                     = "effective_price"
+	AttributeKeySpreadFeeOnly                                      = "spread_fee_only"
+	AttributeKeySpreadFeeOnlyQuoteEquivalent                       = "spread_fee_only_quote_equivalent"
+	AttributeKeyCurrentTick                                        = "current_tick"
 )
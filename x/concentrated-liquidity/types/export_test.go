@@ -7,3 +7,7 @@ func ValidateTicks(i interface{}) error {
 func ValidateBalancerSharesDiscount(i interface{}) error {
 	return validateBalancerSharesDiscount(i)
 }
+
+func ValidateDefaultTickSpacings(i interface{}) error {
+	return validateDefaultTickSpacings(i)
+}
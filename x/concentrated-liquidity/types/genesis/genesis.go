@@ -1,6 +1,11 @@
 package genesis
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 )
 
@@ -28,5 +33,33 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+
+	seenPositionIds := make(map[uint64]struct{}, len(gs.PositionData))
+	for _, positionData := range gs.PositionData {
+		if positionData.Position == nil {
+			return fmt.Errorf("position data with lock id (%d) is missing its position", positionData.LockId)
+		}
+		positionId := positionData.Position.PositionId
+		if _, ok := seenPositionIds[positionId]; ok {
+			return types.DuplicatePositionIdsError{PositionIds: []uint64{positionId}}
+		}
+		seenPositionIds[positionId] = struct{}{}
+		if positionId >= gs.NextPositionId {
+			return types.PositionIdNotBelowNextPositionIdError{PositionId: positionId, NextPositionId: gs.NextPositionId}
+		}
+	}
+
+	validActionPrefixes := types.GetAllActionPrefixes()
+	for _, poolData := range gs.PoolData {
+		for _, hookContract := range poolData.PoolHookContracts {
+			if !osmoutils.Contains(validActionPrefixes, hookContract.ActionPrefix) {
+				return types.InvalidActionPrefixError{ActionPrefix: hookContract.ActionPrefix, ValidActions: validActionPrefixes}
+			}
+			if _, err := sdk.AccAddressFromBech32(hookContract.ContractAddress); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
@@ -104,6 +104,11 @@ type PoolData struct {
 	IncentivesAccumulators  []AccumObject `protobuf:"bytes,4,rep,name=incentives_accumulators,json=incentivesAccumulators,proto3" json:"incentives_accumulators" yaml:"incentives_accumulator"`
 	// incentive records to be set
 	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,5,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records"`
+	// cosmwasm contracts registered as hooks for the pool's actions
+	PoolHookContracts []PoolHookContract `protobuf:"bytes,6,rep,name=pool_hook_contracts,json=poolHookContracts,proto3" json:"pool_hook_contracts" yaml:"pool_hook_contracts"`
+	// denoms whose spread reward growth is saturated in the pool's spread
+	// reward accumulator.
+	SpreadRewardGrowthSaturatedDenoms []string `protobuf:"bytes,7,rep,name=spread_reward_growth_saturated_denoms,json=spreadRewardGrowthSaturatedDenoms,proto3" json:"spread_reward_growth_saturated_denoms,omitempty" yaml:"spread_reward_growth_saturated_denoms"`
 }
 
 func (m *PoolData) Reset()         { *m = PoolData{} }
@@ -174,6 +179,76 @@ func (m *PoolData) GetIncentiveRecords() []types1.IncentiveRecord {
 	return nil
 }
 
+func (m *PoolData) GetPoolHookContracts() []PoolHookContract {
+	if m != nil {
+		return m.PoolHookContracts
+	}
+	return nil
+}
+
+func (m *PoolData) GetSpreadRewardGrowthSaturatedDenoms() []string {
+	if m != nil {
+		return m.SpreadRewardGrowthSaturatedDenoms
+	}
+	return nil
+}
+
+// PoolHookContract represents a cosmwasm contract that is registered as a
+// hook for the given action of a pool.
+type PoolHookContract struct {
+	// action_prefix is the hook action, e.g. "beforeSwapExactAmountIn".
+	ActionPrefix string `protobuf:"bytes,1,opt,name=action_prefix,json=actionPrefix,proto3" json:"action_prefix,omitempty" yaml:"action_prefix"`
+	// contract_address is the bech32 address of the hook contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+}
+
+func (m *PoolHookContract) Reset()         { *m = PoolHookContract{} }
+func (m *PoolHookContract) String() string { return proto.CompactTextString(m) }
+func (*PoolHookContract) ProtoMessage()    {}
+func (*PoolHookContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cdf50d18c43a7c5, []int{2}
+}
+func (m *PoolHookContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHookContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHookContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolHookContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHookContract.Merge(m, src)
+}
+func (m *PoolHookContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHookContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHookContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolHookContract proto.InternalMessageInfo
+
+func (m *PoolHookContract) GetActionPrefix() string {
+	if m != nil {
+		return m.ActionPrefix
+	}
+	return ""
+}
+
+func (m *PoolHookContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
 type PositionData struct {
 	Position                *model.Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	LockId                  uint64          `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
@@ -185,7 +260,7 @@ func (m *PositionData) Reset()         { *m = PositionData{} }
 func (m *PositionData) String() string { return proto.CompactTextString(m) }
 func (*PositionData) ProtoMessage()    {}
 func (*PositionData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{2}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{3}
 }
 func (m *PositionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +334,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{3}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +422,7 @@ func (m *AccumObject) Reset()         { *m = AccumObject{} }
 func (m *AccumObject) String() string { return proto.CompactTextString(m) }
 func (*AccumObject) ProtoMessage()    {}
 func (*AccumObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{4}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{5}
 }
 func (m *AccumObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,6 +468,7 @@ func (m *AccumObject) GetAccumContent() *accum.AccumulatorContent {
 func init() {
 	proto.RegisterType((*FullTick)(nil), "osmosis.concentratedliquidity.v1beta1.FullTick")
 	proto.RegisterType((*PoolData)(nil), "osmosis.concentratedliquidity.v1beta1.PoolData")
+	proto.RegisterType((*PoolHookContract)(nil), "osmosis.concentratedliquidity.v1beta1.PoolHookContract")
 	proto.RegisterType((*PositionData)(nil), "osmosis.concentratedliquidity.v1beta1.PositionData")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.concentratedliquidity.v1beta1.GenesisState")
 	proto.RegisterType((*AccumObject)(nil), "osmosis.concentratedliquidity.v1beta1.AccumObject")
@@ -403,67 +479,77 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x26, 0xb6, 0x9b, 0x8c, 0xdd, 0x92, 0x8e, 0x52, 0xb2, 0x0d, 0xaa, 0xd7, 0x4c, 0x89,
-	0x94, 0x82, 0xe2, 0xa5, 0x4e, 0xa8, 0x04, 0x82, 0x43, 0x5c, 0x28, 0x32, 0x08, 0x88, 0x86, 0x70,
-	0xe1, 0xcb, 0x8c, 0x77, 0xc7, 0xce, 0xd0, 0xf5, 0x8e, 0xd9, 0x19, 0x87, 0xf8, 0xca, 0x1d, 0x09,
-	0x71, 0xe2, 0x27, 0xf0, 0x03, 0x90, 0x38, 0x73, 0xab, 0x10, 0x87, 0x1c, 0x39, 0xad, 0x50, 0x72,
-	0xe0, 0xee, 0x5f, 0x80, 0x76, 0x3e, 0xfc, 0x11, 0x9c, 0xc4, 0xe9, 0x6d, 0xc6, 0xef, 0xfb, 0x3c,
-	0xef, 0x33, 0xef, 0x97, 0x17, 0xec, 0x70, 0xd1, 0xe5, 0x82, 0x09, 0x3f, 0xe0, 0x71, 0x40, 0x63,
-	0x99, 0x10, 0x49, 0xc3, 0x88, 0x7d, 0xd7, 0x67, 0x21, 0x93, 0x03, 0xff, 0xe8, 0x61, 0x8b, 0x4a,
-	0xf2, 0xd0, 0xef, 0xd0, 0x98, 0x0a, 0x26, 0xaa, 0xbd, 0x84, 0x4b, 0x0e, 0x37, 0x0d, 0xa8, 0x3a,
-	0x13, 0x54, 0x35, 0xa0, 0x8d, 0xb5, 0x0e, 0xef, 0x70, 0x85, 0xf0, 0xb3, 0x93, 0x06, 0x6f, 0xdc,
-	0x0d, 0x14, 0xba, 0xa9, 0x0d, 0xfa, 0x62, 0x4d, 0x1d, 0xce, 0x3b, 0x11, 0xf5, 0xd5, 0xad, 0xd5,
-	0x6f, 0xfb, 0x24, 0x1e, 0x18, 0xd3, 0xcb, 0x56, 0x27, 0x09, 0x82, 0x7e, 0x77, 0xa4, 0x4b, 0xdd,
-	0x8c, 0xcb, 0xab, 0x97, 0x3f, 0xa5, 0x47, 0x12, 0xd2, 0xb5, 0x91, 0x76, 0xe7, 0x7b, 0x76, 0x8f,
-	0x0b, 0x26, 0x19, 0x8f, 0x0d, 0xea, 0x8d, 0xf9, 0x50, 0x92, 0x05, 0x4f, 0x9b, 0x2c, 0x6e, 0xdb,
-	0x17, 0xbf, 0x3d, 0x1f, 0x8c, 0x29, 0x23, 0x3b, 0xa2, 0xcd, 0x84, 0x06, 0x3c, 0x09, 0x35, 0x1a,
-	0xfd, 0xe5, 0x80, 0xe5, 0x27, 0xfd, 0x28, 0x3a, 0x60, 0xc1, 0x53, 0xf8, 0x1a, 0xb8, 0xd1, 0xe3,
-	0x3c, 0x6a, 0xb2, 0xd0, 0x75, 0x2a, 0xce, 0x56, 0xae, 0x0e, 0x87, 0xa9, 0x77, 0x6b, 0x40, 0xba,
-	0xd1, 0x5b, 0xc8, 0x18, 0x10, 0x2e, 0x64, 0xa7, 0x46, 0x08, 0x77, 0x01, 0x30, 0x52, 0x42, 0x7a,
-	0xec, 0x2e, 0x56, 0x9c, 0xad, 0xa5, 0xfa, 0x9d, 0x61, 0xea, 0xdd, 0xd6, 0xfe, 0x63, 0x1b, 0xc2,
-	0x2b, 0xd9, 0xa5, 0x91, 0x9d, 0xe1, 0x57, 0x20, 0x97, 0x69, 0x77, 0x97, 0x2a, 0xce, 0x56, 0xb1,
-	0xe6, 0x57, 0xe7, 0xaa, 0x75, 0xf5, 0x40, 0xe1, 0xdb, 0xbc, 0xee, 0x3e, 0x4b, 0xbd, 0x85, 0x61,
-	0xea, 0xad, 0x4e, 0x05, 0x69, 0x73, 0x84, 0x15, 0x2d, 0xfa, 0x3d, 0x07, 0x96, 0xf7, 0x39, 0x8f,
-	0xde, 0x25, 0x92, 0xc0, 0x1d, 0x90, 0xcb, 0xb4, 0xaa, 0xb7, 0x14, 0x6b, 0x6b, 0x55, 0x5d, 0xff,
-	0xaa, 0xad, 0x7f, 0x75, 0x2f, 0x1e, 0xd4, 0x57, 0xfe, 0xfc, 0x6d, 0x3b, 0x9f, 0x21, 0x1a, 0x58,
-	0x39, 0xc3, 0x2f, 0x40, 0x3e, 0x63, 0x15, 0xee, 0x62, 0x65, 0xe9, 0x1a, 0x0a, 0x6d, 0x0e, 0xeb,
-	0x6b, 0x46, 0x61, 0x69, 0xac, 0x50, 0x20, 0xac, 0x39, 0xe1, 0x2f, 0x0e, 0xb8, 0x2b, 0x7a, 0x09,
-	0x25, 0x61, 0x33, 0xa1, 0xdf, 0x93, 0x24, 0x6c, 0xaa, 0x16, 0xeb, 0x47, 0x44, 0xf2, 0xc4, 0xe4,
-	0xa4, 0x36, 0x67, 0xc4, 0xbd, 0x0c, 0xf9, 0x49, 0xeb, 0x5b, 0x1a, 0xc8, 0xfa, 0x96, 0x09, 0x5a,
-	0xd1, 0x41, 0x2f, 0x0c, 0x81, 0xf0, 0xba, 0xb6, 0x61, 0x65, 0xda, 0x1b, 0x5b, 0xe0, 0xcf, 0x0e,
-	0x58, 0x1f, 0xf5, 0x88, 0x98, 0x04, 0x09, 0x37, 0xa7, 0x52, 0xf1, 0x3c, 0xc2, 0x36, 0x8d, 0xb0,
-	0x7b, 0x5a, 0xd8, 0xec, 0x00, 0x08, 0xbf, 0x38, 0x36, 0x4c, 0x68, 0x12, 0x90, 0x81, 0xdb, 0xe7,
-	0xfb, 0x56, 0xb8, 0x79, 0xa5, 0xe6, 0xd1, 0x9c, 0x6a, 0x1a, 0x16, 0x8f, 0x15, 0xbc, 0x9e, 0xcb,
-	0x14, 0xe1, 0x55, 0x36, 0xfd, 0xb3, 0x40, 0x7f, 0x2c, 0x82, 0xd2, 0xbe, 0x19, 0x48, 0xd5, 0x3d,
-	0x1f, 0x82, 0x65, 0x3b, 0xa0, 0xa6, 0x83, 0xe6, 0xed, 0x05, 0x4b, 0x83, 0x47, 0x04, 0xd9, 0x64,
-	0x45, 0x3c, 0xeb, 0xd5, 0x50, 0x4d, 0xca, 0xd4, 0x64, 0x19, 0x03, 0xc2, 0x85, 0xec, 0xd4, 0x08,
-	0xe1, 0x37, 0x60, 0x63, 0x46, 0x05, 0xcd, 0xfb, 0x4d, 0x97, 0xdc, 0x1b, 0x69, 0xd1, 0x4b, 0xca,
-	0xc6, 0x9e, 0x7a, 0xe5, 0xff, 0x8b, 0xad, 0xcd, 0xf0, 0x33, 0xb0, 0xd6, 0xef, 0x49, 0xd6, 0xa5,
-	0x53, 0xd4, 0xb6, 0xd0, 0x73, 0x71, 0x43, 0x4d, 0x30, 0xc1, 0x2a, 0xd0, 0xbf, 0x79, 0x50, 0x7a,
-	0x5f, 0xef, 0xf2, 0x4f, 0x25, 0x91, 0x14, 0x3e, 0x06, 0x05, 0xbd, 0x18, 0x4d, 0x06, 0x37, 0xaf,
-	0xc8, 0xe0, 0xbe, 0x72, 0x36, 0x11, 0x0c, 0x14, 0x62, 0xb0, 0xa2, 0x96, 0x4f, 0x48, 0x24, 0xb9,
-	0xe6, 0x54, 0xda, 0x55, 0x60, 0x18, 0x97, 0x7b, 0x76, 0x35, 0x7c, 0x0d, 0x6e, 0xda, 0xda, 0x68,
-	0xde, 0x25, 0xc5, 0xbb, 0x73, 0xcd, 0x0a, 0x4f, 0x70, 0x97, 0x7a, 0x93, 0xcd, 0xf3, 0x1e, 0x58,
-	0x8d, 0xe9, 0xb1, 0x6c, 0x8e, 0x82, 0xb0, 0xd0, 0xcd, 0xa9, 0xc2, 0xbf, 0x34, 0x4c, 0xbd, 0x75,
-	0x5d, 0xf8, 0xf3, 0x1e, 0x08, 0xdf, 0xca, 0x7e, 0xb2, 0xe4, 0x8d, 0x10, 0x7e, 0x09, 0x5c, 0xe5,
-	0x74, 0x7e, 0x08, 0x32, 0xba, 0xbc, 0xa2, 0xbb, 0x3f, 0x4c, 0x3d, 0x6f, 0x82, 0x6e, 0x86, 0x27,
-	0xc2, 0x77, 0x32, 0xd3, 0xb9, 0x41, 0x68, 0x84, 0xf0, 0x57, 0x07, 0xd4, 0x66, 0x4f, 0x64, 0xd3,
-	0x6c, 0xfb, 0x66, 0x97, 0x75, 0x12, 0xa2, 0xe4, 0xc9, 0xc3, 0x84, 0x8a, 0x43, 0x1e, 0x85, 0x6e,
-	0x41, 0x05, 0x7e, 0x67, 0x98, 0x7a, 0x6f, 0x5e, 0x36, 0xd5, 0x97, 0x71, 0x20, 0xbc, 0x3d, 0x73,
-	0xe2, 0xd5, 0x22, 0x0e, 0x3f, 0xb2, 0x80, 0x03, 0xeb, 0x0f, 0x7f, 0x74, 0xc0, 0x03, 0x33, 0x13,
-	0x6d, 0x12, 0x5c, 0xa5, 0xf0, 0x86, 0x52, 0xb8, 0x3b, 0x4c, 0xbd, 0xd7, 0xa7, 0x16, 0xe2, 0xd5,
-	0x50, 0x84, 0x5f, 0xd1, 0xbe, 0x4f, 0x94, 0xeb, 0x45, 0x7a, 0xd0, 0x0f, 0x0e, 0x28, 0x4e, 0xec,
-	0x39, 0x78, 0x1f, 0xe4, 0x62, 0xd2, 0xa5, 0xaa, 0xcd, 0x57, 0xea, 0x2f, 0x0c, 0x53, 0xaf, 0x68,
-	0x8a, 0x42, 0xba, 0x14, 0x61, 0x65, 0x84, 0x1f, 0x83, 0x9b, 0x7a, 0xdc, 0x02, 0x1e, 0x4b, 0x1a,
-	0x4b, 0xb5, 0x0a, 0x8a, 0xb5, 0x07, 0x17, 0x8c, 0xdb, 0x44, 0x5e, 0x1e, 0x6b, 0x00, 0x2e, 0x29,
-	0x0f, 0x73, 0xab, 0x87, 0xcf, 0x4e, 0xcb, 0xce, 0xc9, 0x69, 0xd9, 0xf9, 0xe7, 0xb4, 0xec, 0xfc,
-	0x74, 0x56, 0x5e, 0x38, 0x39, 0x2b, 0x2f, 0xfc, 0x7d, 0x56, 0x5e, 0xf8, 0xfc, 0x83, 0x0e, 0x93,
-	0x87, 0xfd, 0x56, 0x35, 0xe0, 0x5d, 0xdf, 0x90, 0x6f, 0x47, 0xa4, 0x25, 0xec, 0xc5, 0x3f, 0xaa,
-	0x3d, 0xf2, 0x8f, 0xa7, 0xbe, 0x18, 0xb6, 0xc7, 0x9f, 0x0c, 0x72, 0xd0, 0xa3, 0xc2, 0x7e, 0x94,
-	0xb5, 0x0a, 0xea, 0xff, 0x72, 0xe7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x06, 0x85, 0x4a,
-	0xcc, 0x09, 0x00, 0x00,
+	// 1109 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x62, 0xc7, 0x89, 0x37, 0x49, 0x9b, 0x2c, 0x29, 0x51, 0xc3, 0xd4, 0x72, 0xb7, 0x64,
+	0x26, 0x05, 0x62, 0xb7, 0x4e, 0x28, 0x03, 0x43, 0x0f, 0x51, 0x4a, 0x8a, 0x61, 0x80, 0xcc, 0x36,
+	0x5c, 0xf8, 0x27, 0xd6, 0xd2, 0xda, 0x11, 0xb1, 0xb5, 0x42, 0xbb, 0x4e, 0x93, 0x6b, 0xaf, 0x0c,
+	0x33, 0x0c, 0x27, 0xf8, 0x06, 0x7c, 0x00, 0xbe, 0x00, 0xb7, 0x4e, 0x87, 0x43, 0x8f, 0x9c, 0x34,
+	0x4c, 0x72, 0xe0, 0xee, 0x4f, 0xc0, 0x68, 0x77, 0xe5, 0xc8, 0xc6, 0x49, 0x1c, 0x6e, 0x5a, 0xbd,
+	0xf7, 0xfb, 0xbd, 0xdf, 0xee, 0xbe, 0xf7, 0x93, 0xc0, 0x06, 0xe3, 0x1d, 0xc6, 0x7d, 0x5e, 0x75,
+	0x59, 0xe0, 0xd2, 0x40, 0x44, 0x44, 0x50, 0xaf, 0xed, 0x7f, 0xdf, 0xf5, 0x3d, 0x5f, 0x1c, 0x57,
+	0x0f, 0xef, 0x37, 0xa8, 0x20, 0xf7, 0xab, 0x2d, 0x1a, 0x50, 0xee, 0xf3, 0x4a, 0x18, 0x31, 0xc1,
+	0xe0, 0xaa, 0x06, 0x55, 0x46, 0x82, 0x2a, 0x1a, 0xb4, 0xb2, 0xd4, 0x62, 0x2d, 0x26, 0x11, 0xd5,
+	0xe4, 0x49, 0x81, 0x57, 0x6e, 0xba, 0x12, 0xed, 0xa8, 0x80, 0x5a, 0xa4, 0xa1, 0x16, 0x63, 0xad,
+	0x36, 0xad, 0xca, 0x55, 0xa3, 0xdb, 0xac, 0x92, 0xe0, 0x58, 0x87, 0x6e, 0xa7, 0x3a, 0x89, 0xeb,
+	0x76, 0x3b, 0x7d, 0x5d, 0x72, 0xa5, 0x53, 0xde, 0xb8, 0x78, 0x2b, 0x21, 0x89, 0x48, 0x27, 0xad,
+	0xb4, 0x39, 0xde, 0xb6, 0x43, 0xc6, 0x7d, 0xe1, 0xb3, 0x40, 0xa3, 0xde, 0x1e, 0x0f, 0x25, 0x7c,
+	0xf7, 0xc0, 0xf1, 0x83, 0x66, 0xba, 0xe3, 0xf7, 0xc7, 0x83, 0xf9, 0x32, 0xe8, 0x1f, 0x52, 0x27,
+	0xa2, 0x2e, 0x8b, 0x3c, 0x85, 0x46, 0x7f, 0x1a, 0x60, 0x66, 0xa7, 0xdb, 0x6e, 0xef, 0xf9, 0xee,
+	0x01, 0x7c, 0x13, 0x4c, 0x87, 0x8c, 0xb5, 0x1d, 0xdf, 0x33, 0x8d, 0xb2, 0xb1, 0x96, 0xb7, 0x61,
+	0x2f, 0xb6, 0xae, 0x1d, 0x93, 0x4e, 0xfb, 0x3d, 0xa4, 0x03, 0x08, 0x17, 0x92, 0xa7, 0xba, 0x07,
+	0x37, 0x01, 0xd0, 0x52, 0x3c, 0x7a, 0x64, 0x4e, 0x96, 0x8d, 0xb5, 0x9c, 0x7d, 0xa3, 0x17, 0x5b,
+	0x8b, 0x2a, 0xff, 0x2c, 0x86, 0x70, 0x31, 0x59, 0xd4, 0x93, 0x67, 0xf8, 0x35, 0xc8, 0x27, 0xda,
+	0xcd, 0x5c, 0xd9, 0x58, 0x9b, 0xad, 0x55, 0x2b, 0x63, 0xdd, 0x75, 0x65, 0x4f, 0xe2, 0x9b, 0xcc,
+	0x36, 0x9f, 0xc7, 0xd6, 0x44, 0x2f, 0xb6, 0x16, 0x06, 0x8a, 0x34, 0x19, 0xc2, 0x92, 0x16, 0xbd,
+	0x28, 0x80, 0x99, 0x5d, 0xc6, 0xda, 0x8f, 0x88, 0x20, 0x70, 0x03, 0xe4, 0x13, 0xad, 0x72, 0x2f,
+	0xb3, 0xb5, 0xa5, 0x8a, 0xba, 0xff, 0x4a, 0x7a, 0xff, 0x95, 0xad, 0xe0, 0xd8, 0x2e, 0xbe, 0xf8,
+	0x7d, 0x7d, 0x2a, 0x41, 0xd4, 0xb1, 0x4c, 0x86, 0x5f, 0x82, 0xa9, 0x84, 0x95, 0x9b, 0x93, 0xe5,
+	0xdc, 0x15, 0x14, 0xa6, 0x67, 0x68, 0x2f, 0x69, 0x85, 0x73, 0x67, 0x0a, 0x39, 0xc2, 0x8a, 0x13,
+	0xfe, 0x62, 0x80, 0x9b, 0x3c, 0x8c, 0x28, 0xf1, 0x9c, 0x88, 0x3e, 0x25, 0x91, 0xe7, 0xc8, 0x16,
+	0xeb, 0xb6, 0x89, 0x60, 0x91, 0x3e, 0x93, 0xda, 0x98, 0x15, 0xb7, 0x12, 0xe4, 0x67, 0x8d, 0xef,
+	0xa8, 0x2b, 0xec, 0x35, 0x5d, 0xb4, 0xac, 0x8a, 0x9e, 0x5b, 0x02, 0xe1, 0x65, 0x15, 0xc3, 0x32,
+	0xb4, 0x75, 0x16, 0x81, 0x3f, 0x1b, 0x60, 0xb9, 0xdf, 0x23, 0x3c, 0x0b, 0xe2, 0x66, 0xbe, 0x9c,
+	0xfb, 0x9f, 0xc2, 0x56, 0xb5, 0xb0, 0x5b, 0x4a, 0xd8, 0xe8, 0x02, 0x08, 0xbf, 0x7a, 0x16, 0xc8,
+	0x68, 0xe2, 0xd0, 0x07, 0x8b, 0xc3, 0x7d, 0xcb, 0xcd, 0x29, 0xa9, 0xe6, 0xc1, 0x98, 0x6a, 0xea,
+	0x29, 0x1e, 0x4b, 0xb8, 0x9d, 0x4f, 0x14, 0xe1, 0x05, 0x7f, 0xf0, 0x35, 0x87, 0x3f, 0x18, 0xe0,
+	0x15, 0xd9, 0xe3, 0xfb, 0x8c, 0x1d, 0x38, 0x2e, 0x4b, 0x08, 0x5d, 0xc1, 0xcd, 0x82, 0xac, 0xf6,
+	0xce, 0x98, 0xd5, 0x92, 0x4e, 0xfa, 0x90, 0xb1, 0x83, 0x6d, 0x8d, 0xb7, 0x91, 0x3e, 0x80, 0x95,
+	0xcc, 0x14, 0x0d, 0x56, 0x40, 0x78, 0x31, 0x1c, 0x42, 0x71, 0xf8, 0xcc, 0x00, 0xab, 0x83, 0xb7,
+	0xd8, 0x8a, 0xd8, 0x53, 0xb1, 0xef, 0x70, 0x22, 0xba, 0xb2, 0xb6, 0xe3, 0xd1, 0x80, 0x75, 0xb8,
+	0x39, 0x5d, 0xce, 0xad, 0x15, 0xed, 0x7b, 0xbd, 0xd8, 0x7a, 0x6b, 0xd4, 0xe5, 0x9f, 0x03, 0x43,
+	0xf8, 0x76, 0xb6, 0x11, 0x1e, 0xcb, 0xac, 0x27, 0x69, 0xd2, 0x23, 0x95, 0xf3, 0xab, 0x01, 0x16,
+	0x86, 0x37, 0x04, 0x1f, 0x82, 0x79, 0xe2, 0x26, 0xae, 0xe5, 0x84, 0x11, 0x6d, 0xfa, 0x47, 0x72,
+	0xba, 0x8a, 0xb6, 0xd9, 0x8b, 0xad, 0x25, 0x25, 0x60, 0x20, 0x8c, 0xf0, 0x9c, 0x5a, 0xef, 0xca,
+	0x25, 0xdc, 0x01, 0x0b, 0xe9, 0xce, 0x1d, 0xe2, 0x79, 0x11, 0xe5, 0x5c, 0x7a, 0x47, 0xd1, 0x7e,
+	0xad, 0x17, 0x5b, 0xcb, 0x8a, 0x61, 0x38, 0x03, 0xe1, 0xeb, 0xe9, 0xab, 0x2d, 0xfd, 0xe6, 0x8f,
+	0x49, 0x30, 0xb7, 0xab, 0xfd, 0x53, 0x0e, 0xfb, 0xc7, 0x60, 0x26, 0xf5, 0x53, 0x3d, 0xf0, 0xd5,
+	0xb1, 0xef, 0x4c, 0xc1, 0x70, 0x9f, 0x20, 0x31, 0xc2, 0x36, 0x4b, 0xac, 0xc5, 0x33, 0x27, 0x87,
+	0x8d, 0x50, 0x07, 0x10, 0x2e, 0x24, 0x4f, 0x75, 0x0f, 0x7e, 0x0b, 0x56, 0x46, 0x0c, 0x9c, 0x6e,
+	0x57, 0x3d, 0xd4, 0xb7, 0xfa, 0x5a, 0x64, 0xb0, 0x5f, 0x7b, 0xa0, 0x29, 0xff, 0x3b, 0x9b, 0x2a,
+	0x0c, 0x3f, 0x07, 0x4b, 0xdd, 0x50, 0xf8, 0x1d, 0x3a, 0x40, 0x9d, 0xce, 0xe5, 0x58, 0xdc, 0x50,
+	0x11, 0x64, 0x58, 0x39, 0xfa, 0x67, 0x0a, 0xcc, 0x3d, 0x56, 0x9f, 0xde, 0x27, 0x82, 0x08, 0x0a,
+	0xb7, 0x41, 0x41, 0x7d, 0xc7, 0xf4, 0x09, 0xae, 0x5e, 0x72, 0x82, 0xbb, 0x32, 0x59, 0x57, 0xd0,
+	0x50, 0x88, 0x41, 0x51, 0x76, 0xb9, 0x47, 0x04, 0xb9, 0xa2, 0x89, 0xa6, 0xce, 0xad, 0x19, 0x67,
+	0xc2, 0xd4, 0xc9, 0xbf, 0x01, 0xf3, 0xe9, 0xdd, 0x28, 0xde, 0x9c, 0xe4, 0xdd, 0xb8, 0xe2, 0x0d,
+	0x67, 0xb8, 0xe7, 0xc2, 0x6c, 0xf3, 0x7c, 0x00, 0x16, 0x02, 0x7a, 0x24, 0x9c, 0x7e, 0x11, 0xdf,
+	0x33, 0xf3, 0xf2, 0xe2, 0x33, 0x5d, 0x39, 0x9c, 0x81, 0xf0, 0xb5, 0xe4, 0x55, 0x4a, 0x5e, 0xf7,
+	0xe0, 0x57, 0xc0, 0x94, 0x49, 0xc3, 0x9e, 0x95, 0xd0, 0x4d, 0x49, 0xba, 0x3b, 0xbd, 0xd8, 0xb2,
+	0x32, 0x74, 0x23, 0x32, 0x11, 0xbe, 0x91, 0x84, 0x86, 0x7c, 0xab, 0xee, 0xc1, 0xdf, 0x0c, 0x50,
+	0x1b, 0x6d, 0xa0, 0x8e, 0xfe, 0x38, 0x3b, 0x1d, 0xbf, 0x15, 0x11, 0x29, 0x4f, 0xec, 0x47, 0x94,
+	0xef, 0xb3, 0xb6, 0x67, 0x16, 0x64, 0xe1, 0x87, 0xbd, 0xd8, 0x7a, 0xf7, 0x22, 0x13, 0xbe, 0x88,
+	0x03, 0xe1, 0xf5, 0x91, 0x06, 0x2d, 0xbf, 0x9b, 0xde, 0x27, 0x29, 0x60, 0x2f, 0xcd, 0x87, 0x3f,
+	0x1a, 0xe0, 0xae, 0x9e, 0x89, 0x26, 0x71, 0x2f, 0x53, 0x38, 0x2d, 0x15, 0x6e, 0xf6, 0x62, 0xeb,
+	0xde, 0x80, 0x85, 0x5d, 0x0e, 0x45, 0xf8, 0x75, 0x95, 0xbb, 0x43, 0xdc, 0x0b, 0xf4, 0xa0, 0x67,
+	0x06, 0x98, 0xcd, 0x7c, 0x96, 0xe0, 0x1d, 0x90, 0x0f, 0x48, 0x87, 0x6a, 0xef, 0xba, 0xde, 0x8b,
+	0xad, 0x59, 0x7d, 0x29, 0xa4, 0x43, 0x11, 0x96, 0x41, 0xf8, 0x29, 0x98, 0x57, 0xe3, 0x96, 0x78,
+	0x0f, 0x0d, 0x84, 0xb4, 0x82, 0xd9, 0xda, 0xdd, 0x73, 0xc6, 0x2d, 0x73, 0x2e, 0xdb, 0x0a, 0x90,
+	0x58, 0x9f, 0xdb, 0xed, 0xe8, 0x95, 0xed, 0x3d, 0x3f, 0x29, 0x19, 0x2f, 0x4f, 0x4a, 0xc6, 0xdf,
+	0x27, 0x25, 0xe3, 0xa7, 0xd3, 0xd2, 0xc4, 0xcb, 0xd3, 0xd2, 0xc4, 0x5f, 0xa7, 0xa5, 0x89, 0x2f,
+	0x3e, 0x6a, 0xf9, 0x62, 0xbf, 0xdb, 0xa8, 0xb8, 0xac, 0x53, 0xd5, 0xe4, 0xeb, 0x6d, 0xd2, 0xe0,
+	0xe9, 0xa2, 0x7a, 0x58, 0x7b, 0x50, 0x3d, 0x1a, 0xf8, 0xc1, 0x5b, 0x3f, 0xfb, 0xc3, 0x13, 0xc7,
+	0x21, 0xe5, 0xe9, 0x3f, 0x74, 0xa3, 0x20, 0x7f, 0x6f, 0x36, 0xfe, 0x1d, 0x00, 0x96, 0x29, 0x0d,
+	0xb0, 0x7b, 0x0b, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -529,6 +615,29 @@ func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpreadRewardGrowthSaturatedDenoms) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthSaturatedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpreadRewardGrowthSaturatedDenoms[iNdEx])
+			copy(dAtA[i:], m.SpreadRewardGrowthSaturatedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SpreadRewardGrowthSaturatedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PoolHookContracts) > 0 {
+		for iNdEx := len(m.PoolHookContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolHookContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IncentiveRecords) > 0 {
 		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -596,6 +705,43 @@ func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolHookContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHookContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHookContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActionPrefix) > 0 {
+		i -= len(m.ActionPrefix)
+		copy(dAtA[i:], m.ActionPrefix)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActionPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PositionData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -841,6 +987,35 @@ func (m *PoolData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolHookContracts) > 0 {
+		for _, e := range m.PoolHookContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpreadRewardGrowthSaturatedDenoms) > 0 {
+		for _, s := range m.SpreadRewardGrowthSaturatedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolHookContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActionPrefix)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1247,6 +1422,186 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolHookContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolHookContracts = append(m.PoolHookContracts, PoolHookContract{})
+			if err := m.PoolHookContracts[len(m.PoolHookContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthSaturatedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardGrowthSaturatedDenoms = append(m.SpreadRewardGrowthSaturatedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolHookContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolHookContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolHookContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,11 +5,15 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types/genesis"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	validAddress := sdk.AccAddress([]byte("addr1---------------")).String()
 	tests := []struct {
 		name           string
 		genesis        genesis.GenesisState
@@ -50,6 +54,68 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			exepectedError: true,
 		},
+		{
+			name: "duplicate position ids",
+			genesis: genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				NextPositionId:        3,
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				PositionData: []genesis.PositionData{
+					{Position: &model.Position{PositionId: 1}},
+					{Position: &model.Position{PositionId: 1}},
+				},
+			},
+			exepectedError: true,
+		},
+		{
+			name: "position id not below next position id",
+			genesis: genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				NextPositionId:        2,
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				PositionData: []genesis.PositionData{
+					{Position: &model.Position{PositionId: 1}},
+					{Position: &model.Position{PositionId: 2}},
+				},
+			},
+			exepectedError: true,
+		},
+		{
+			name: "valid pool hook contract",
+			genesis: genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				PoolData: []genesis.PoolData{{
+					PoolHookContracts: []genesis.PoolHookContract{{ActionPrefix: types.BeforeActionPrefix(types.CreatePositionPrefix), ContractAddress: validAddress}},
+				}},
+			},
+			exepectedError: false,
+		},
+		{
+			name: "invalid pool hook action prefix",
+			genesis: genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				PoolData: []genesis.PoolData{{
+					PoolHookContracts: []genesis.PoolHookContract{{ActionPrefix: "beforeInvalidAction", ContractAddress: validAddress}},
+				}},
+			},
+			exepectedError: true,
+		},
+		{
+			name: "invalid pool hook contract address",
+			genesis: genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				PoolData: []genesis.PoolData{{
+					PoolHookContracts: []genesis.PoolHookContract{{ActionPrefix: types.BeforeActionPrefix(types.CreatePositionPrefix), ContractAddress: "invalid"}},
+				}},
+			},
+			exepectedError: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -14,16 +14,19 @@ import (
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeSetPoolMetadata                 = "SetPoolMetadata"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPool)
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeSetPoolMetadata)
 }
 
 var (
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &SetPoolMetadataProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -76,6 +79,10 @@ func (p *CreateConcentratedLiquidityPoolsProposal) ValidateBasic() error {
 		if spreadFactor.IsNegative() || spreadFactor.GTE(osmomath.OneDec()) {
 			return InvalidSpreadFactorError{ActualSpreadFactor: spreadFactor}
 		}
+
+		if err := ValidatePoolMetadataFields(record.Name, record.TickerPairing); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -84,7 +91,7 @@ func (p *CreateConcentratedLiquidityPoolsProposal) ValidateBasic() error {
 func (p CreateConcentratedLiquidityPoolsProposal) String() string {
 	recordsStr := ""
 	for _, record := range p.PoolRecords {
-		recordsStr = recordsStr + fmt.Sprintf("(Denom0: %s, Denom1: %s, TickSpacing: %d, SpreadFactor: %d) ", record.Denom0, record.Denom1, record.TickSpacing, record.SpreadFactor)
+		recordsStr = recordsStr + fmt.Sprintf("(Denom0: %s, Denom1: %s, TickSpacing: %d, SpreadFactor: %d, Name: %s, TickerPairing: %s) ", record.Denom0, record.Denom1, record.TickSpacing, record.SpreadFactor, record.Name, record.TickerPairing)
 	}
 
 	var b strings.Builder
@@ -155,3 +162,63 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewSetPoolMetadataProposal(title, description string, records []PoolIdToMetadataRecord) govtypesv1.Content {
+	return &SetPoolMetadataProposal{
+		Title:                   title,
+		Description:             description,
+		PoolIdToMetadataRecords: records,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetPoolMetadataProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetPoolMetadataProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetPoolMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetPoolMetadataProposal) ProposalType() string {
+	return ProposalTypeSetPoolMetadata
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SetPoolMetadataProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIdToMetadataRecords) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	for _, record := range p.PoolIdToMetadataRecords {
+		if record.PoolId == 0 {
+			return fmt.Errorf("pool id must be positive")
+		}
+
+		if err := ValidatePoolMetadataFields(record.Name, record.TickerPairing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns a string containing the set pool metadata proposal.
+func (p SetPoolMetadataProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.PoolIdToMetadataRecords {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, Name: %s, TickerPairing: %s) ", record.PoolId, record.Name, record.TickerPairing)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pools Metadata Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
@@ -170,6 +170,11 @@ type PoolRecord struct {
 	// DEPRECATED
 	ExponentAtPriceOne cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=exponent_at_price_one,json=exponentAtPriceOne,proto3,customtype=cosmossdk.io/math.Int" json:"exponent_at_price_one" yaml:"exponent_at_price_one",deprecated:"true"` // Deprecated: Do not use.
 	SpreadFactor       cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor" yaml:"spread_factor"`
+	// name is an optional human readable name for the pool.
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// ticker_pairing is an optional display pairing of the pool's assets, e.g.
+	// "OSMO/USDC".
+	TickerPairing string `protobuf:"bytes,7,opt,name=ticker_pairing,json=tickerPairing,proto3" json:"ticker_pairing,omitempty" yaml:"ticker_pairing"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -226,11 +231,130 @@ func (m *PoolRecord) GetTickSpacing() uint64 {
 	return 0
 }
 
+func (m *PoolRecord) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PoolRecord) GetTickerPairing() string {
+	if m != nil {
+		return m.TickerPairing
+	}
+	return ""
+}
+
+// SetPoolMetadataProposal is a gov Content type for setting the name and
+// ticker pairing of concentrated liquidity pools. The pools' creators are left
+// unchanged. The proposal will fail if one of the pools does not exist.
+type SetPoolMetadataProposal struct {
+	Title                   string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description             string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIdToMetadataRecords []PoolIdToMetadataRecord `protobuf:"bytes,3,rep,name=pool_id_to_metadata_records,json=poolIdToMetadataRecords,proto3" json:"pool_id_to_metadata_records"`
+}
+
+func (m *SetPoolMetadataProposal) Reset()      { *m = SetPoolMetadataProposal{} }
+func (*SetPoolMetadataProposal) ProtoMessage() {}
+func (*SetPoolMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
+}
+func (m *SetPoolMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolMetadataProposal.Merge(m, src)
+}
+func (m *SetPoolMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolMetadataProposal proto.InternalMessageInfo
+
+// PoolIdToMetadataRecord is a struct that contains a pool id to new name and
+// ticker pairing pair.
+type PoolIdToMetadataRecord struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TickerPairing string `protobuf:"bytes,3,opt,name=ticker_pairing,json=tickerPairing,proto3" json:"ticker_pairing,omitempty"`
+}
+
+func (m *PoolIdToMetadataRecord) Reset()         { *m = PoolIdToMetadataRecord{} }
+func (m *PoolIdToMetadataRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolIdToMetadataRecord) ProtoMessage()    {}
+func (*PoolIdToMetadataRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{5}
+}
+func (m *PoolIdToMetadataRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolIdToMetadataRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolIdToMetadataRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolIdToMetadataRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolIdToMetadataRecord.Merge(m, src)
+}
+func (m *PoolIdToMetadataRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolIdToMetadataRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolIdToMetadataRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolIdToMetadataRecord proto.InternalMessageInfo
+
+func (m *PoolIdToMetadataRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolIdToMetadataRecord) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PoolIdToMetadataRecord) GetTickerPairing() string {
+	if m != nil {
+		return m.TickerPairing
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
+	proto.RegisterType((*SetPoolMetadataProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SetPoolMetadataProposal")
+	proto.RegisterType((*PoolIdToMetadataRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToMetadataRecord")
 }
 
 func init() {
@@ -238,45 +362,53 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x9a, 0xb4, 0xe2, 0x24, 0x15, 0x5d, 0x5b, 0x1a, 0x5b, 0xc8, 0x96, 0x05, 0x21, 0x82,
-	0xdd, 0x35, 0x15, 0x3c, 0xc4, 0x8b, 0xa6, 0x45, 0x28, 0x14, 0xac, 0x6b, 0x4f, 0x22, 0xac, 0x93,
-	0xd9, 0x67, 0x3a, 0x74, 0xb3, 0x6f, 0x3b, 0x33, 0xfd, 0x91, 0xbb, 0x07, 0x41, 0x04, 0x8f, 0x1e,
-	0xfb, 0xe7, 0xf4, 0xd8, 0xa3, 0x78, 0x08, 0xd2, 0x5c, 0xbc, 0x9a, 0xbf, 0x40, 0x76, 0x26, 0x3f,
-	0xfb, 0x03, 0x14, 0x6f, 0x3b, 0x33, 0xdf, 0xf7, 0xbe, 0xef, 0x7b, 0x6f, 0x79, 0xc4, 0x47, 0xd9,
-	0x46, 0xc9, 0xa5, 0xcf, 0x30, 0x61, 0x90, 0x28, 0x41, 0x15, 0x44, 0x31, 0xdf, 0x3f, 0xe0, 0x11,
-	0x57, 0x1d, 0xff, 0xb0, 0xd6, 0x04, 0x45, 0x6b, 0x7e, 0x0b, 0x0f, 0xbd, 0x54, 0xa0, 0x42, 0xfb,
-	0xc1, 0x80, 0xe0, 0x5d, 0x49, 0xf0, 0x06, 0x84, 0xa5, 0xf9, 0x16, 0xb6, 0x50, 0x33, 0xfc, 0xec,
-	0xcb, 0x90, 0xdd, 0x9e, 0x45, 0xaa, 0xeb, 0x02, 0xa8, 0x82, 0xf5, 0x09, 0xf6, 0xd6, 0x90, 0xbd,
-	0x8d, 0x18, 0xcb, 0x6d, 0x81, 0x29, 0x4a, 0x1a, 0xdb, 0xf3, 0x64, 0x46, 0x71, 0x15, 0x43, 0xd9,
-	0x5a, 0xb1, 0xaa, 0xb7, 0x02, 0x73, 0xb0, 0x57, 0x48, 0x31, 0x02, 0xc9, 0x04, 0x4f, 0x15, 0xc7,
-	0xa4, 0x7c, 0x43, 0xbf, 0x4d, 0x5e, 0xd9, 0xfb, 0xa4, 0x94, 0x22, 0xc6, 0xa1, 0x00, 0x86, 0x22,
-	0x92, 0xe5, 0xfc, 0x4a, 0xbe, 0x5a, 0x5c, 0xab, 0x79, 0x7f, 0x65, 0xdc, 0xcb, 0x3c, 0x04, 0x9a,
-	0xd9, 0x58, 0x3e, 0xed, 0x3a, 0xb9, 0x7e, 0xd7, 0xb9, 0xd7, 0xa1, 0xed, 0xb8, 0xee, 0x4e, 0x16,
-	0x75, 0x83, 0x62, 0x3a, 0x02, 0xca, 0x7a, 0xe9, 0xd3, 0x89, 0x93, 0xfb, 0x76, 0xe2, 0xe4, 0x7e,
-	0x9d, 0x38, 0x96, 0xfb, 0xdb, 0x22, 0xcb, 0x3b, 0x9c, 0xed, 0xbd, 0x49, 0x29, 0xe3, 0x49, 0x6b,
-	0x03, 0x98, 0x00, 0x2a, 0xe1, 0xbf, 0x83, 0x7d, 0xb6, 0x88, 0xa3, 0x4d, 0xf0, 0x28, 0x54, 0x18,
-	0x2a, 0xce, 0xf6, 0x42, 0x69, 0x34, 0x2e, 0x84, 0x7d, 0xfe, 0x0f, 0x61, 0x37, 0xa3, 0x1d, 0x9c,
-	0x70, 0x3b, 0xc8, 0x5e, 0xc8, 0xb2, 0x07, 0x4b, 0xe9, 0x75, 0x80, 0x8b, 0x99, 0x23, 0x72, 0xff,
-	0xda, 0x62, 0xf6, 0x22, 0xb9, 0x39, 0xf0, 0xad, 0x23, 0x17, 0x82, 0x59, 0x53, 0xd7, 0xae, 0x92,
-	0x3b, 0x09, 0x1c, 0x4d, 0x25, 0xd1, 0xc1, 0x0b, 0xc1, 0xed, 0x04, 0x8e, 0x26, 0x0a, 0xd5, 0x0b,
-	0x5a, 0xe5, 0x4b, 0x9e, 0x90, 0xf1, 0x80, 0xec, 0x87, 0x64, 0x36, 0x82, 0x04, 0xdb, 0x8f, 0x4d,
-	0x27, 0x1b, 0x77, 0xfb, 0x5d, 0x67, 0xce, 0x0c, 0xcb, 0xdc, 0xbb, 0xc1, 0x00, 0x30, 0x82, 0xd6,
-	0x4c, 0x63, 0x2f, 0x41, 0x6b, 0x43, 0x68, 0xcd, 0xae, 0x93, 0xd2, 0x94, 0xa1, 0x7c, 0x66, 0xa8,
-	0xb1, 0x38, 0xfe, 0x11, 0x26, 0x5f, 0xdd, 0xa0, 0xa8, 0xc6, 0x36, 0xed, 0x8f, 0x16, 0x59, 0x80,
-	0xe3, 0x14, 0x13, 0x48, 0x54, 0x48, 0x55, 0x98, 0x0a, 0xce, 0x20, 0xc4, 0x04, 0xca, 0x05, 0x2d,
-	0xfb, 0x3a, 0x6b, 0xeb, 0x8f, 0xae, 0xb3, 0xc0, 0xf4, 0x80, 0x64, 0xb4, 0xe7, 0x71, 0xf4, 0xdb,
-	0x54, 0xed, 0x7a, 0x9b, 0x89, 0xea, 0x77, 0x1d, 0xcf, 0x48, 0x5c, 0x59, 0xc3, 0x7d, 0x14, 0x41,
-	0x2a, 0x80, 0x65, 0xb3, 0xac, 0xbb, 0x4a, 0x1c, 0x80, 0x5b, 0xb6, 0x02, 0x7b, 0x88, 0x7d, 0xa1,
-	0xb6, 0x33, 0xe4, 0xab, 0x04, 0xec, 0xf7, 0x64, 0x4e, 0xa6, 0x02, 0x68, 0x14, 0x7e, 0xa0, 0x4c,
-	0xa1, 0x28, 0xcf, 0x68, 0xf5, 0x67, 0x03, 0xf5, 0xe5, 0xcb, 0xea, 0x5b, 0xd0, 0xa2, 0xac, 0xb3,
-	0x01, 0xac, 0xdf, 0x75, 0xe6, 0x8d, 0x87, 0xa9, 0x0a, 0x6e, 0x50, 0x32, 0xe7, 0x97, 0xfa, 0x68,
-	0xe6, 0xd1, 0x78, 0x77, 0x7a, 0x5e, 0xb1, 0xce, 0xce, 0x2b, 0xd6, 0xcf, 0xf3, 0x8a, 0xf5, 0xb5,
-	0x57, 0xc9, 0x9d, 0xf5, 0x2a, 0xb9, 0xef, 0xbd, 0x4a, 0xee, 0x6d, 0xa3, 0xc5, 0xd5, 0xee, 0x41,
-	0xd3, 0x63, 0xd8, 0x1e, 0xae, 0x98, 0xd5, 0x98, 0x36, 0xe5, 0x68, 0xdf, 0x1c, 0xae, 0x3d, 0xf5,
-	0x8f, 0xa7, 0xb6, 0xce, 0xea, 0x78, 0xed, 0xa8, 0x4e, 0x0a, 0xb2, 0x39, 0xab, 0x97, 0xc6, 0x93,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xa3, 0xf9, 0x03, 0xa4, 0x04, 0x00, 0x00,
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6b, 0x13, 0x4f,
+	0x14, 0xcf, 0x36, 0x69, 0xca, 0x77, 0x92, 0xf6, 0xab, 0x6b, 0x6b, 0xb6, 0x0d, 0x64, 0xcb, 0x4a,
+	0x21, 0x82, 0xdd, 0x35, 0x15, 0x3c, 0x44, 0x84, 0x9a, 0x16, 0xa1, 0x50, 0x31, 0x6e, 0x7b, 0x12,
+	0x61, 0x9d, 0xec, 0x3e, 0xd3, 0xa1, 0xc9, 0xce, 0x76, 0x76, 0xfa, 0x23, 0x47, 0xc1, 0x83, 0xe0,
+	0xc5, 0xa3, 0xc7, 0xfe, 0x39, 0x3d, 0xf6, 0x28, 0x1e, 0x16, 0x69, 0x2e, 0x5e, 0x0d, 0xfe, 0x01,
+	0xb2, 0x33, 0x9b, 0xe6, 0x47, 0x53, 0xb0, 0xf4, 0x96, 0x99, 0xf7, 0x3e, 0x9f, 0xf7, 0xf9, 0xbc,
+	0x97, 0xb7, 0x83, 0x2c, 0x1a, 0xb6, 0x69, 0x48, 0x42, 0xcb, 0xa5, 0xbe, 0x0b, 0x3e, 0x67, 0x98,
+	0x83, 0xd7, 0x22, 0x07, 0x87, 0xc4, 0x23, 0xbc, 0x63, 0x1d, 0x55, 0x1a, 0xc0, 0x71, 0xc5, 0x6a,
+	0xd2, 0x23, 0x33, 0x60, 0x94, 0x53, 0x75, 0x25, 0x01, 0x98, 0x13, 0x01, 0x66, 0x02, 0x58, 0x9a,
+	0x6f, 0xd2, 0x26, 0x15, 0x08, 0x2b, 0xfe, 0x25, 0xc1, 0x46, 0x57, 0x41, 0xe5, 0x0d, 0x06, 0x98,
+	0xc3, 0xc6, 0x10, 0x7a, 0xbb, 0x8f, 0xae, 0x53, 0xda, 0x0a, 0xeb, 0x8c, 0x06, 0x34, 0xc4, 0x2d,
+	0x75, 0x1e, 0x4d, 0x73, 0xc2, 0x5b, 0xa0, 0x29, 0xcb, 0x4a, 0xf9, 0x3f, 0x5b, 0x1e, 0xd4, 0x65,
+	0x94, 0xf3, 0x20, 0x74, 0x19, 0x09, 0x38, 0xa1, 0xbe, 0x36, 0x25, 0x62, 0xc3, 0x57, 0xea, 0x01,
+	0xca, 0x07, 0x94, 0xb6, 0x1c, 0x06, 0x2e, 0x65, 0x5e, 0xa8, 0xa5, 0x97, 0xd3, 0xe5, 0xdc, 0x5a,
+	0xc5, 0xfc, 0x27, 0xe1, 0x66, 0xac, 0xc1, 0x16, 0xc8, 0x5a, 0xf1, 0x2c, 0xd2, 0x53, 0xbd, 0x48,
+	0xbf, 0xd7, 0xc1, 0xed, 0x56, 0xd5, 0x18, 0x26, 0x35, 0xec, 0x5c, 0x70, 0x99, 0x18, 0x56, 0xf3,
+	0x9f, 0x4f, 0xf5, 0xd4, 0xb7, 0x53, 0x3d, 0xf5, 0xeb, 0x54, 0x57, 0x8c, 0xdf, 0x0a, 0x2a, 0xee,
+	0x12, 0x77, 0x7f, 0x27, 0xc0, 0x2e, 0xf1, 0x9b, 0x9b, 0xe0, 0x32, 0xc0, 0x21, 0xdc, 0xda, 0xd8,
+	0x17, 0x05, 0xe9, 0x42, 0x04, 0xf1, 0x1c, 0x4e, 0x1d, 0x4e, 0xdc, 0x7d, 0x27, 0x94, 0x35, 0xc6,
+	0xcc, 0xae, 0xdf, 0xc0, 0xec, 0x96, 0xb7, 0x4b, 0x87, 0xd4, 0x26, 0xde, 0x33, 0xb1, 0x77, 0x7b,
+	0x29, 0xb8, 0x2e, 0x61, 0xdc, 0xb3, 0x87, 0x16, 0xaf, 0x25, 0x53, 0x0b, 0x68, 0x26, 0xd1, 0x2d,
+	0x2c, 0x67, 0xec, 0xac, 0xe4, 0x55, 0xcb, 0xe8, 0x8e, 0x0f, 0xc7, 0x23, 0x4e, 0x84, 0xf1, 0x8c,
+	0x3d, 0xe7, 0xc3, 0xf1, 0x10, 0x51, 0x35, 0x23, 0xaa, 0xfc, 0x49, 0x23, 0x34, 0x18, 0x90, 0xfa,
+	0x10, 0x65, 0x3d, 0xf0, 0x69, 0xfb, 0xb1, 0xec, 0x64, 0xed, 0x6e, 0x2f, 0xd2, 0x67, 0xe5, 0xb0,
+	0xe4, 0xbd, 0x61, 0x27, 0x09, 0x97, 0xa9, 0x15, 0x6d, 0x6a, 0x62, 0x6a, 0xa5, 0x9f, 0x5a, 0x51,
+	0xab, 0x28, 0x3f, 0x22, 0x28, 0x1d, 0x0b, 0xaa, 0x15, 0x06, 0x7f, 0x84, 0xe1, 0xa8, 0x61, 0xe7,
+	0xf8, 0x40, 0xa6, 0xfa, 0x49, 0x41, 0x0b, 0x70, 0x12, 0x50, 0x1f, 0x7c, 0xee, 0x60, 0xee, 0x04,
+	0x8c, 0xb8, 0xe0, 0x50, 0x1f, 0xb4, 0x8c, 0x28, 0xfb, 0x26, 0x6e, 0xeb, 0x8f, 0x48, 0x5f, 0x70,
+	0xc5, 0x80, 0x42, 0x6f, 0xdf, 0x24, 0xd4, 0x6a, 0x63, 0xbe, 0x67, 0x6e, 0xf9, 0xbc, 0x17, 0xe9,
+	0xa6, 0x2c, 0x31, 0x91, 0xc3, 0x78, 0xe4, 0x41, 0xc0, 0xc0, 0x8d, 0x67, 0x59, 0x35, 0x38, 0x3b,
+	0x04, 0x43, 0x53, 0x6c, 0xb5, 0x9f, 0xfb, 0x82, 0xd7, 0xe3, 0xcc, 0xd7, 0x3e, 0xa8, 0xef, 0xd1,
+	0x6c, 0x18, 0x30, 0xc0, 0x9e, 0xf3, 0x01, 0xbb, 0x9c, 0x32, 0x6d, 0x5a, 0x54, 0x7f, 0x96, 0x54,
+	0x2f, 0x5e, 0xad, 0xbe, 0x0d, 0x4d, 0xec, 0x76, 0x36, 0xc1, 0xed, 0x45, 0xfa, 0xbc, 0xd4, 0x30,
+	0xc2, 0x60, 0xd8, 0x79, 0x79, 0x7e, 0x29, 0x8e, 0xea, 0x03, 0x94, 0xf1, 0x71, 0x1b, 0xb4, 0xac,
+	0x20, 0xfe, 0xbf, 0x17, 0xe9, 0x39, 0x89, 0x8a, 0x6f, 0x0d, 0x5b, 0x04, 0xd5, 0x75, 0x34, 0x17,
+	0x37, 0x07, 0x98, 0x13, 0x60, 0xc2, 0xe2, 0x5e, 0xce, 0x88, 0xf4, 0xc5, 0x5e, 0xa4, 0x2f, 0x0c,
+	0x7a, 0x39, 0x88, 0x1b, 0xf6, 0xac, 0xbc, 0xa8, 0xcb, 0x73, 0x32, 0xf6, 0xae, 0x82, 0x0a, 0x3b,
+	0xc0, 0xe3, 0xc9, 0xbf, 0x02, 0x8e, 0x3d, 0xcc, 0xf1, 0xad, 0x97, 0xe9, 0xa3, 0x82, 0x8a, 0x43,
+	0xcb, 0xd4, 0x4e, 0x78, 0xc7, 0x16, 0xe9, 0xf9, 0x0d, 0x17, 0xa9, 0x2f, 0x6f, 0x64, 0x8b, 0x0a,
+	0xc1, 0xc4, 0xe8, 0xf8, 0x0a, 0x31, 0x74, 0x7f, 0x32, 0xcd, 0xf5, 0xfb, 0xa3, 0x26, 0x53, 0x90,
+	0xfe, 0x64, 0xd3, 0x57, 0xae, 0x34, 0x3d, 0x2d, 0xa2, 0x93, 0x3a, 0x5b, 0x7b, 0x77, 0x76, 0x51,
+	0x52, 0xce, 0x2f, 0x4a, 0xca, 0xcf, 0x8b, 0x92, 0xf2, 0xb5, 0x5b, 0x4a, 0x9d, 0x77, 0x4b, 0xa9,
+	0xef, 0xdd, 0x52, 0xea, 0x6d, 0xad, 0x49, 0xf8, 0xde, 0x61, 0xc3, 0x74, 0x69, 0xbb, 0xff, 0x46,
+	0xac, 0xb6, 0x70, 0x23, 0xec, 0x1f, 0xac, 0xa3, 0xb5, 0xa7, 0xd6, 0xc9, 0xc8, 0xb3, 0xb1, 0x3a,
+	0x78, 0x37, 0x78, 0x27, 0x80, 0xb0, 0x91, 0x15, 0x5f, 0xfd, 0x27, 0x7f, 0x07, 0x00, 0xb1, 0xaf,
+	0xf7, 0x23, 0x65, 0x06, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -410,6 +542,77 @@ func (this *PoolRecord) Equal(that interface{}) bool {
 	if !this.SpreadFactor.Equal(that1.SpreadFactor) {
 		return false
 	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.TickerPairing != that1.TickerPairing {
+		return false
+	}
+	return true
+}
+func (this *SetPoolMetadataProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPoolMetadataProposal)
+	if !ok {
+		that2, ok := that.(SetPoolMetadataProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIdToMetadataRecords) != len(that1.PoolIdToMetadataRecords) {
+		return false
+	}
+	for i := range this.PoolIdToMetadataRecords {
+		if !this.PoolIdToMetadataRecords[i].Equal(&that1.PoolIdToMetadataRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PoolIdToMetadataRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolIdToMetadataRecord)
+	if !ok {
+		that2, ok := that.(PoolIdToMetadataRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.TickerPairing != that1.TickerPairing {
+		return false
+	}
 	return true
 }
 func (m *CreateConcentratedLiquidityPoolsProposal) Marshal() (dAtA []byte, err error) {
@@ -567,6 +770,20 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TickerPairing) > 0 {
+		i -= len(m.TickerPairing)
+		copy(dAtA[i:], m.TickerPairing)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TickerPairing)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.SpreadFactor.Size()
 		i -= size
@@ -609,6 +826,99 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetPoolMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIdToMetadataRecords) > 0 {
+		for iNdEx := len(m.PoolIdToMetadataRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolIdToMetadataRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolIdToMetadataRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolIdToMetadataRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolIdToMetadataRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TickerPairing) > 0 {
+		i -= len(m.TickerPairing)
+		copy(dAtA[i:], m.TickerPairing)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TickerPairing)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -702,6 +1012,57 @@ func (m *PoolRecord) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.SpreadFactor.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TickerPairing)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *SetPoolMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIdToMetadataRecords) > 0 {
+		for _, e := range m.PoolIdToMetadataRecords {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolIdToMetadataRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TickerPairing)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1275,6 +1636,351 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickerPairing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickerPairing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPoolMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdToMetadataRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolIdToMetadataRecords = append(m.PoolIdToMetadataRecords, PoolIdToMetadataRecord{})
+			if err := m.PoolIdToMetadataRecords[len(m.PoolIdToMetadataRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolIdToMetadataRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolIdToMetadataRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolIdToMetadataRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickerPairing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickerPairing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	proto "github.com/cosmos/gogoproto/proto"
@@ -141,6 +142,11 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		return record
 	}
 
+	withTooLongName := func(record types.PoolRecord) types.PoolRecord {
+		record.Name = strings.Repeat("a", types.MaxPoolNameLength+1)
+		return record
+	}
+
 	tests := []struct {
 		name       string
 		modifyFunc func(types.PoolRecord) types.PoolRecord
@@ -176,6 +182,11 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 			modifyFunc: withInvalidSpreadFactor,
 			expectPass: false,
 		},
+		{
+			name:       "name too long",
+			modifyFunc: withTooLongName,
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -190,3 +201,42 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestSetPoolMetadataProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		records    []types.PoolIdToMetadataRecord
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			records:    []types.PoolIdToMetadataRecord{{PoolId: 1, Name: "Ion / Osmosis", TickerPairing: "ION/OSMO"}},
+			expectPass: true,
+		},
+		{
+			name:       "empty records",
+			records:    []types.PoolIdToMetadataRecord{},
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			records:    []types.PoolIdToMetadataRecord{{PoolId: 0, Name: "Ion / Osmosis"}},
+			expectPass: false,
+		},
+		{
+			name:       "ticker pairing too long",
+			records:    []types.PoolIdToMetadataRecord{{PoolId: 1, TickerPairing: strings.Repeat("a", types.MaxPoolTickerPairingLength+1)}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		setPoolMetadataProposal := types.NewSetPoolMetadataProposal("title", "description", test.records)
+
+		if test.expectPass {
+			require.NoError(t, setPoolMetadataProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, setPoolMetadataProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -53,6 +54,12 @@ var (
 	KeyIncentiveAccumulatorMigrationThreshold    = []byte{0x15}
 	KeySpreadRewardAccumulatorMigrationThreshold = []byte{0x16}
 
+	StopConditionPrefix     = []byte{0x17}
+	PoolStopConditionPrefix = []byte{0x18}
+	StopConditionTickPrefix = []byte{0x19}
+
+	SpreadRewardGrowthSaturatedPrefix = []byte{0x1A}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + Uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -224,6 +231,60 @@ func KeyPoolPosition(poolId uint64) []byte {
 	return key
 }
 
+// Stop Condition Prefix Keys
+
+// KeyStopCondition returns the key used to store the stop condition of the given position.
+func KeyStopCondition(positionId uint64) []byte {
+	key := make([]byte, 0, len(StopConditionPrefix)+Uint64ByteSize)
+	key = append(key, StopConditionPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// KeyPoolStopCondition returns the key used to index the stop condition of the given position by its pool.
+func KeyPoolStopCondition(poolId uint64, positionId uint64) []byte {
+	key := make([]byte, 0, len(PoolStopConditionPrefix)+2*Uint64ByteSize)
+	key = append(key, KeyPoolStopConditions(poolId)...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// KeyPoolStopConditions returns the prefix key used to iterate over the stop conditions of a pool.
+func KeyPoolStopConditions(poolId uint64) []byte {
+	key := make([]byte, 0, len(PoolStopConditionPrefix)+Uint64ByteSize)
+	key = append(key, PoolStopConditionPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyStopConditionTick returns the key used to store the current tick of a pool observed at the given time.
+// The time is encoded with sdk.FormatTimeBytes so that the entries of a pool are iterated in chronological order.
+func KeyStopConditionTick(poolId uint64, blockTime time.Time) []byte {
+	return append(KeyStopConditionTicks(poolId), sdk.FormatTimeBytes(blockTime)...)
+}
+
+// KeyStopConditionTicks returns the prefix key used to iterate over the tick history of a pool.
+func KeyStopConditionTicks(poolId uint64) []byte {
+	key := make([]byte, 0, len(StopConditionTickPrefix)+Uint64ByteSize)
+	key = append(key, StopConditionTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeySpreadRewardGrowthSaturated returns the key used to mark the spread reward growth of the given denom
+// as saturated in the given pool's spread reward accumulator.
+func KeySpreadRewardGrowthSaturated(poolId uint64, denom string) []byte {
+	return append(KeySpreadRewardGrowthSaturatedPool(poolId), []byte(denom)...)
+}
+
+// KeySpreadRewardGrowthSaturatedPool returns the prefix key used to iterate over the saturated denoms of a pool.
+func KeySpreadRewardGrowthSaturatedPool(poolId uint64) []byte {
+	key := make([]byte, 0, len(SpreadRewardGrowthSaturatedPrefix)+Uint64ByteSize)
+	key = append(key, SpreadRewardGrowthSaturatedPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
 // Pool Prefix Keys
 // KeyPool is used to map a pool id to a pool struct
 func KeyPool(poolId uint64) []byte {
@@ -1,5 +1,26 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SpreadRewardRescaleReport summarizes the effect of rescaling the spread reward accumulator of a pool.
+type SpreadRewardRescaleReport struct {
+	PoolId uint64
+	// Denom is the rescaled denom. Empty if all denoms were rescaled.
+	Denom        string
+	NumPositions int
+	NumTicks     int
+	// ClaimableBefore and ClaimableAfter are the spread rewards claimable by all the positions of the pool
+	// before and after the rescale.
+	ClaimableBefore sdk.Coins
+	ClaimableAfter  sdk.Coins
+	// SpreadRewardsBalance is the balance of the pool's spread rewards address in the claimable denoms.
+	SpreadRewardsBalance sdk.Coins
+	// DryRun is true if the rescale was not written to state.
+	DryRun bool
+}
+
 var MigratedIncentiveAccumulatorPoolIDs = map[uint64]struct{}{
 	1423: {},
 	1213: {},
@@ -12,13 +12,20 @@ import (
 
 // constants.
 const (
-	TypeMsgCreatePosition          = "create-position"
-	TypeAddToPosition              = "add-to-position"
-	TypeMsgWithdrawPosition        = "withdraw-position"
-	TypeMsgCollectSpreadRewards    = "collect-spread-rewards"
-	TypeMsgCollectIncentives       = "collect-incentives"
-	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
-	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgCreatePosition                      = "create-position"
+	TypeMsgCreateMultiplePositions             = "create-multiple-positions"
+	TypeAddToPosition                          = "add-to-position"
+	TypeMsgWithdrawPosition                    = "withdraw-position"
+	TypeMsgCollectSpreadRewards                = "collect-spread-rewards"
+	TypeMsgCollectIncentives                   = "collect-incentives"
+	TypeMsgFungifyChargedPositions             = "fungify-charged-positions"
+	TypeMsgTransferPositions                   = "transfer-positions"
+	TypeMsgRecoverPosition                     = "recover-position"
+	TypeMsgSwapExactAmountInWithSqrtPriceLimit = "swap-exact-amount-in-with-sqrt-price-limit"
+	TypeMsgRegisterStopCondition               = "register-stop-condition"
+	TypeMsgCancelStopCondition                 = "cancel-stop-condition"
+	TypeMsgExecuteStopCondition                = "execute-stop-condition"
+	TypeMsgSetPoolTickSpacing                  = "set-pool-tick-spacing"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -31,34 +38,39 @@ func (msg MsgCreatePosition) ValidateBasic() error {
 		return fmt.Errorf("Invalid sender address (%s)", err)
 	}
 
-	if msg.LowerTick >= msg.UpperTick {
-		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	return validatePositionToCreate(msg.LowerTick, msg.UpperTick, msg.TokensProvided, msg.TokenMinAmount0, msg.TokenMinAmount1)
+}
+
+// validatePositionToCreate performs the stateless validation of the parameters of a position to be created.
+func validatePositionToCreate(lowerTick, upperTick int64, tokensProvided sdk.Coins, tokenMinAmount0, tokenMinAmount1 osmomath.Int) error {
+	if lowerTick >= upperTick {
+		return InvalidLowerUpperTickError{LowerTick: lowerTick, UpperTick: upperTick}
 	}
 
-	if msg.TokensProvided.Empty() {
-		return fmt.Errorf("Empty coins provided (%s)", msg.TokensProvided.String())
+	if tokensProvided.Empty() {
+		return fmt.Errorf("Empty coins provided (%s)", tokensProvided.String())
 	}
 
-	if !msg.TokensProvided.IsValid() {
-		return fmt.Errorf("Invalid coins (%s)", msg.TokensProvided.String())
+	if !tokensProvided.IsValid() {
+		return fmt.Errorf("Invalid coins (%s)", tokensProvided.String())
 	}
 
-	if len(msg.TokensProvided) > 2 {
-		return CoinLengthError{Length: len(msg.TokensProvided), MaxLength: 2}
+	if len(tokensProvided) > 2 {
+		return CoinLengthError{Length: len(tokensProvided), MaxLength: 2}
 	}
 
-	for _, coin := range msg.TokensProvided {
+	for _, coin := range tokensProvided {
 		if coin.Amount.LTE(osmomath.ZeroInt()) {
 			return NotPositiveRequireAmountError{Amount: coin.Amount.String()}
 		}
 	}
 
-	if msg.TokenMinAmount0.IsNegative() {
-		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	if tokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: tokenMinAmount0.String()}
 	}
 
-	if msg.TokenMinAmount1.IsNegative() {
-		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	if tokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: tokenMinAmount1.String()}
 	}
 
 	return nil
@@ -72,6 +84,41 @@ func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgCreateMultiplePositions{}
+
+func (msg MsgCreateMultiplePositions) Route() string { return RouterKey }
+func (msg MsgCreateMultiplePositions) Type() string  { return TypeMsgCreateMultiplePositions }
+func (msg MsgCreateMultiplePositions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if len(msg.Positions) == 0 {
+		return fmt.Errorf("Must provide at least 1 position to create, got %d", len(msg.Positions))
+	}
+
+	if len(msg.Positions) > MaxPositionsPerCreateMultiplePositions {
+		return fmt.Errorf("Cannot create more than %d positions in a single message, got %d", MaxPositionsPerCreateMultiplePositions, len(msg.Positions))
+	}
+
+	for i, position := range msg.Positions {
+		if err := validatePositionToCreate(position.LowerTick, position.UpperTick, position.TokensProvided, position.TokenMinAmount0, position.TokenMinAmount1); err != nil {
+			return fmt.Errorf("Invalid position at index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgCreateMultiplePositions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgAddToPosition{}
 
 func (msg MsgAddToPosition) Route() string { return RouterKey }
@@ -239,3 +286,183 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgRecoverPosition{}
+
+func (msg MsgRecoverPosition) Route() string { return RouterKey }
+func (msg MsgRecoverPosition) Type() string  { return TypeMsgRecoverPosition }
+func (msg MsgRecoverPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgRecoverPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountInWithSqrtPriceLimit{}
+
+func (msg MsgSwapExactAmountInWithSqrtPriceLimit) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountInWithSqrtPriceLimit) Type() string {
+	return TypeMsgSwapExactAmountInWithSqrtPriceLimit
+}
+func (msg MsgSwapExactAmountInWithSqrtPriceLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return fmt.Errorf("Invalid token in (%s)", msg.TokenIn)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return DenomDuplicatedError{TokenInDenom: msg.TokenIn.Denom, TokenOutDenom: msg.TokenOutDenom}
+	}
+
+	if msg.TokenOutMinAmount.IsNil() || msg.TokenOutMinAmount.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenOutMinAmount.String()}
+	}
+
+	if msg.SqrtPriceLimit.IsNil() || msg.SqrtPriceLimit.IsNegative() {
+		return SqrtPriceNegativeError{ProvidedSqrtPrice: msg.SqrtPriceLimit}
+	}
+
+	return nil
+}
+
+func (msg MsgSwapExactAmountInWithSqrtPriceLimit) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRegisterStopCondition{}
+
+func (msg MsgRegisterStopCondition) Route() string { return RouterKey }
+func (msg MsgRegisterStopCondition) Type() string  { return TypeMsgRegisterStopCondition }
+func (msg MsgRegisterStopCondition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if msg.Duration <= 0 || msg.Duration > MaxStopConditionDuration {
+		return InvalidStopConditionDurationError{Duration: msg.Duration, MaxDuration: MaxStopConditionDuration}
+	}
+
+	if !msg.Bounty.IsValid() || !msg.Bounty.IsPositive() {
+		return fmt.Errorf("Invalid bounty (%s)", msg.Bounty)
+	}
+
+	return nil
+}
+
+func (msg MsgRegisterStopCondition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelStopCondition{}
+
+func (msg MsgCancelStopCondition) Route() string { return RouterKey }
+func (msg MsgCancelStopCondition) Type() string  { return TypeMsgCancelStopCondition }
+func (msg MsgCancelStopCondition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgCancelStopCondition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgExecuteStopCondition{}
+
+func (msg MsgExecuteStopCondition) Route() string { return RouterKey }
+func (msg MsgExecuteStopCondition) Type() string  { return TypeMsgExecuteStopCondition }
+func (msg MsgExecuteStopCondition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgExecuteStopCondition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPoolTickSpacing{}
+
+func (msg MsgSetPoolTickSpacing) Route() string { return RouterKey }
+func (msg MsgSetPoolTickSpacing) Type() string  { return TypeMsgSetPoolTickSpacing }
+func (msg MsgSetPoolTickSpacing) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.TickSpacing == 0 {
+		return fmt.Errorf("tick spacing must be positive")
+	}
+
+	return nil
+}
+
+func (msg MsgSetPoolTickSpacing) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
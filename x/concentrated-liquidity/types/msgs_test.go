@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -439,3 +440,312 @@ func TestMsgTransferPositions(t *testing.T) {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
 	}
 }
+
+func TestMsgRecoverPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgRecoverPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgRecoverPosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero position id",
+			msg: types.MsgRecoverPosition{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgRecoverPosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgRecoverPosition)
+	}
+}
+
+func TestMsgCreateMultiplePositions(t *testing.T) {
+	validPosition := types.PositionToCreate{
+		LowerTick:       1,
+		UpperTick:       10,
+		TokensProvided:  sdk.NewCoins(sdk.NewCoin("stake", osmomath.OneInt()), sdk.NewCoin("osmo", osmomath.OneInt())),
+		TokenMinAmount0: osmomath.OneInt(),
+		TokenMinAmount1: osmomath.OneInt(),
+	}
+	invalidTicksPosition := validPosition
+	invalidTicksPosition.LowerTick = 10
+
+	tooManyPositions := make([]types.PositionToCreate, types.MaxPositionsPerCreateMultiplePositions+1)
+	for i := range tooManyPositions {
+		tooManyPositions[i] = validPosition
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCreateMultiplePositions
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCreateMultiplePositions{
+				PoolId:    1,
+				Sender:    addr1,
+				Positions: []types.PositionToCreate{validPosition, validPosition},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCreateMultiplePositions{
+				PoolId:    1,
+				Sender:    invalidAddr.String(),
+				Positions: []types.PositionToCreate{validPosition},
+			},
+			expectPass: false,
+		},
+		{
+			name: "no positions",
+			msg: types.MsgCreateMultiplePositions{
+				PoolId: 1,
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "too many positions",
+			msg: types.MsgCreateMultiplePositions{
+				PoolId:    1,
+				Sender:    addr1,
+				Positions: tooManyPositions,
+			},
+			expectPass: false,
+		},
+		{
+			name: "one of the positions is invalid",
+			msg: types.MsgCreateMultiplePositions{
+				PoolId:    1,
+				Sender:    addr1,
+				Positions: []types.PositionToCreate{validPosition, invalidTicksPosition},
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCreateMultiplePositions)
+	}
+}
+
+func TestMsgSwapExactAmountInWithSqrtPriceLimit(t *testing.T) {
+	validMsg := types.MsgSwapExactAmountInWithSqrtPriceLimit{
+		PoolId:            1,
+		Sender:            addr1,
+		TokenIn:           sdk.NewCoin("stake", osmomath.NewInt(100)),
+		TokenOutDenom:     "osmo",
+		TokenOutMinAmount: osmomath.OneInt(),
+		SqrtPriceLimit:    osmomath.NewBigDec(70),
+	}
+	withMsg := func(modify func(msg *types.MsgSwapExactAmountInWithSqrtPriceLimit)) types.MsgSwapExactAmountInWithSqrtPriceLimit {
+		msg := validMsg
+		modify(&msg)
+		return msg
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSwapExactAmountInWithSqrtPriceLimit
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        validMsg,
+			expectPass: true,
+		},
+		{
+			name: "zero sqrt price limit",
+			msg: withMsg(func(msg *types.MsgSwapExactAmountInWithSqrtPriceLimit) {
+				msg.SqrtPriceLimit = osmomath.ZeroBigDec()
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: withMsg(func(msg *types.MsgSwapExactAmountInWithSqrtPriceLimit) {
+				msg.Sender = invalidAddr.String()
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: withMsg(func(msg *types.MsgSwapExactAmountInWithSqrtPriceLimit) {
+				msg.TokenIn = sdk.NewCoin("stake", osmomath.ZeroInt())
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: withMsg(func(msg *types.MsgSwapExactAmountInWithSqrtPriceLimit) {
+				msg.TokenOutDenom = "1"
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same token in and out denom",
+			msg: withMsg(func(msg *types.MsgSwapExactAmountInWithSqrtPriceLimit) {
+				msg.TokenOutDenom = "stake"
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative token out min amount",
+			msg: withMsg(func(msg *types.MsgSwapExactAmountInWithSqrtPriceLimit) {
+				msg.TokenOutMinAmount = osmomath.NewInt(-1)
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative sqrt price limit",
+			msg: withMsg(func(msg *types.MsgSwapExactAmountInWithSqrtPriceLimit) {
+				msg.SqrtPriceLimit = osmomath.NewBigDec(-1)
+			}),
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSwapExactAmountInWithSqrtPriceLimit)
+	}
+}
+
+func TestMsgRegisterStopCondition(t *testing.T) {
+	validMsg := func() types.MsgRegisterStopCondition {
+		return types.MsgRegisterStopCondition{
+			Sender:     addr1,
+			PositionId: 1,
+			LowerTick:  -10,
+			UpperTick:  10,
+			Duration:   time.Hour,
+			Bounty:     sdk.NewCoin("uosmo", osmomath.NewInt(1000)),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgRegisterStopCondition
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        validMsg,
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: func() types.MsgRegisterStopCondition {
+				msg := validMsg()
+				msg.Sender = invalidAddr.String()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero position id",
+			msg: func() types.MsgRegisterStopCondition {
+				msg := validMsg()
+				msg.PositionId = 0
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "lower tick equal to upper tick",
+			msg: func() types.MsgRegisterStopCondition {
+				msg := validMsg()
+				msg.LowerTick = msg.UpperTick
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: func() types.MsgRegisterStopCondition {
+				msg := validMsg()
+				msg.Duration = 0
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duration at the maximum",
+			msg: func() types.MsgRegisterStopCondition {
+				msg := validMsg()
+				msg.Duration = types.MaxStopConditionDuration
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "duration above the maximum",
+			msg: func() types.MsgRegisterStopCondition {
+				msg := validMsg()
+				msg.Duration = types.MaxStopConditionDuration + time.Nanosecond
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero bounty",
+			msg: func() types.MsgRegisterStopCondition {
+				msg := validMsg()
+				msg.Bounty = sdk.NewCoin("uosmo", osmomath.ZeroInt())
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		msg := test.msg()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgRegisterStopCondition)
+	}
+}
+
+func TestMsgCancelAndExecuteStopCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		sender     string
+		positionId uint64
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			sender:     addr1,
+			positionId: 1,
+			expectPass: true,
+		},
+		{
+			name:       "zero position id",
+			sender:     addr1,
+			expectPass: false,
+		},
+		{
+			name:       "invalid sender",
+			sender:     invalidAddr.String(),
+			positionId: 1,
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &types.MsgCancelStopCondition{Sender: test.sender, PositionId: test.positionId}, test.expectPass, types.TypeMsgCancelStopCondition)
+		runValidateBasicTest(t, test.name, &types.MsgExecuteStopCondition{Sender: test.sender, PositionId: test.positionId}, test.expectPass, types.TypeMsgExecuteStopCondition)
+	}
+}
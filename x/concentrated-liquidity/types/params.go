@@ -20,6 +20,7 @@ var (
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
 	KeyUnrestrictedPoolCreatorWhitelist   = []byte("UnrestrictedPoolCreatorWhitelist")
 	KeyHookGasLimit                       = []byte("HookGasLimit")
+	KeyDefaultTickSpacings                = []byte("DefaultTickSpacings")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -29,7 +30,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64, defaultTickSpacings []SpreadFactorTickSpacing) Params {
 	return Params{
 		AuthorizedTickSpacing:               authorizedTickSpacing,
 		AuthorizedSpreadFactors:             authorizedSpreadFactors,
@@ -38,6 +39,7 @@ func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomat
 		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
 		UnrestrictedPoolCreatorWhitelist:    unrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        hookGasLimit,
+		DefaultTickSpacings:                 defaultTickSpacings,
 	}
 }
 
@@ -51,6 +53,7 @@ func DefaultParams() Params {
 		IsPermissionlessPoolCreationEnabled: false,
 		UnrestrictedPoolCreatorWhitelist:    DefaultUnrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        DefaultContractHookGasLimit,
+		DefaultTickSpacings:                 DefaultTickSpacings,
 	}
 }
 
@@ -77,6 +80,9 @@ func (p Params) Validate() error {
 	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
 		return err
 	}
+	if err := validateDefaultTickSpacings(p.DefaultTickSpacings); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -90,6 +96,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
 		paramtypes.NewParamSetPair(KeyUnrestrictedPoolCreatorWhitelist, &p.UnrestrictedPoolCreatorWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateHookGasLimit),
+		paramtypes.NewParamSetPair(KeyDefaultTickSpacings, &p.DefaultTickSpacings, validateDefaultTickSpacings),
 	}
 }
 
@@ -223,3 +230,30 @@ func validateHookGasLimit(i interface{}) error {
 
 	return nil
 }
+
+// validateDefaultTickSpacings validates that the given parameter is a slice of default tick spacings
+// with valid tick spacings and valid, unique spread factors.
+func validateDefaultTickSpacings(i interface{}) error {
+	defaultTickSpacings, ok := i.([]SpreadFactorTickSpacing)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for default tick spacings: %T", i)
+	}
+
+	seenSpreadFactors := make(map[string]struct{}, len(defaultTickSpacings))
+	for _, defaultTickSpacing := range defaultTickSpacings {
+		spreadFactor := defaultTickSpacing.SpreadFactor
+		if spreadFactor.IsNil() || spreadFactor.IsNegative() || spreadFactor.GTE(osmomath.OneDec()) {
+			return InvalidSpreadFactorError{ActualSpreadFactor: spreadFactor}
+		}
+		if _, ok := seenSpreadFactors[spreadFactor.String()]; ok {
+			return fmt.Errorf("duplicate default tick spacing for spread factor %s", spreadFactor)
+		}
+		seenSpreadFactors[spreadFactor.String()] = struct{}{}
+
+		if err := validateTicks([]uint64{defaultTickSpacing.TickSpacing}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -64,6 +64,10 @@ type Params struct {
 	// double creation of pools, etc.
 	UnrestrictedPoolCreatorWhitelist []string `protobuf:"bytes,7,rep,name=unrestricted_pool_creator_whitelist,json=unrestrictedPoolCreatorWhitelist,proto3" json:"unrestricted_pool_creator_whitelist,omitempty" yaml:"unrestricted_pool_creator_whitelist"`
 	HookGasLimit                     uint64   `protobuf:"varint,8,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
+	// default_tick_spacings ties a default tick spacing to spread factors (fee
+	// tiers). Pools created without a tick spacing get the default tick spacing
+	// of their spread factor.
+	DefaultTickSpacings []SpreadFactorTickSpacing `protobuf:"bytes,9,rep,name=default_tick_spacings,json=defaultTickSpacings,proto3" json:"default_tick_spacings" yaml:"default_tick_spacings"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -142,8 +146,63 @@ func (m *Params) GetHookGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetDefaultTickSpacings() []SpreadFactorTickSpacing {
+	if m != nil {
+		return m.DefaultTickSpacings
+	}
+	return nil
+}
+
+// SpreadFactorTickSpacing is the default tick spacing of pools created with
+// the given spread factor.
+type SpreadFactorTickSpacing struct {
+	SpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor" yaml:"spread_factor"`
+	TickSpacing  uint64                      `protobuf:"varint,2,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+}
+
+func (m *SpreadFactorTickSpacing) Reset()         { *m = SpreadFactorTickSpacing{} }
+func (m *SpreadFactorTickSpacing) String() string { return proto.CompactTextString(m) }
+func (*SpreadFactorTickSpacing) ProtoMessage()    {}
+func (*SpreadFactorTickSpacing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42a3f6981164624c, []int{1}
+}
+func (m *SpreadFactorTickSpacing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpreadFactorTickSpacing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpreadFactorTickSpacing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpreadFactorTickSpacing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpreadFactorTickSpacing.Merge(m, src)
+}
+func (m *SpreadFactorTickSpacing) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpreadFactorTickSpacing) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpreadFactorTickSpacing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpreadFactorTickSpacing proto.InternalMessageInfo
+
+func (m *SpreadFactorTickSpacing) GetTickSpacing() uint64 {
+	if m != nil {
+		return m.TickSpacing
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
+	proto.RegisterType((*SpreadFactorTickSpacing)(nil), "osmosis.concentratedliquidity.SpreadFactorTickSpacing")
 }
 
 func init() {
@@ -151,48 +210,54 @@ func init() {
 }
 
 var fileDescriptor_42a3f6981164624c = []byte{
-	// 652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x37, 0xbf, 0xed, 0xaf, 0x7f, 0xa2, 0x08, 0x06, 0x8b, 0xd9, 0xaa, 0x49, 0x98, 0x82,
-	0x2e, 0xa5, 0x4d, 0xa4, 0x42, 0x0f, 0xf5, 0x20, 0xc4, 0xd5, 0x5e, 0x2a, 0xd4, 0x54, 0x11, 0x8a,
-	0x30, 0xcc, 0x4e, 0xa6, 0xd9, 0x61, 0x93, 0x3c, 0xe9, 0xcc, 0xc4, 0xba, 0x82, 0x27, 0x11, 0x3c,
-	0x7a, 0xf0, 0xe0, 0x0b, 0xf2, 0xd0, 0x63, 0x8f, 0xe2, 0x21, 0x4a, 0x7b, 0xf3, 0xb8, 0xaf, 0x40,
-	0x36, 0xd9, 0xb5, 0x59, 0xaa, 0xd8, 0x5b, 0x66, 0xbe, 0x9f, 0xe7, 0xcf, 0x3c, 0xf9, 0xf2, 0xe8,
-	0x2b, 0x20, 0x13, 0x90, 0x5c, 0x7a, 0x14, 0x52, 0xca, 0x52, 0x25, 0x88, 0x62, 0x61, 0xcc, 0x0f,
-	0x72, 0x1e, 0x72, 0x35, 0xf0, 0x32, 0x22, 0x48, 0x22, 0xdd, 0x4c, 0x80, 0x02, 0xe3, 0xd6, 0x98,
-	0x75, 0xff, 0xc8, 0x2e, 0x5d, 0x8b, 0x20, 0x82, 0x92, 0xf4, 0x46, 0x5f, 0x55, 0xd0, 0x92, 0x15,
-	0x01, 0x44, 0x31, 0xf3, 0xca, 0x53, 0x37, 0xdf, 0xf7, 0xc2, 0x5c, 0x10, 0xc5, 0x21, 0xad, 0x74,
-	0xf4, 0x65, 0x4e, 0x9f, 0xdd, 0x29, 0xab, 0x18, 0x7b, 0xfa, 0x75, 0x92, 0xab, 0x1e, 0x08, 0xfe,
-	0x86, 0x85, 0x58, 0x71, 0xda, 0xc7, 0x32, 0x23, 0x94, 0xa7, 0x91, 0xa9, 0x39, 0xcd, 0xf6, 0x8c,
-	0x8f, 0x86, 0x85, 0x6d, 0x0d, 0x48, 0x12, 0x6f, 0xa2, 0xbf, 0x80, 0x28, 0x58, 0x3c, 0x53, 0x9e,
-	0x71, 0xda, 0xdf, 0xad, 0xee, 0x8d, 0x77, 0x9a, 0xde, 0xaa, 0xc5, 0xc8, 0x4c, 0x30, 0x12, 0xe2,
-	0x7d, 0x42, 0x15, 0x08, 0x69, 0xfe, 0xe7, 0x34, 0xdb, 0x0b, 0xfe, 0xd6, 0x51, 0x61, 0x37, 0xbe,
-	0x15, 0xf6, 0x0d, 0x5a, 0x3e, 0x54, 0x86, 0x7d, 0x97, 0x83, 0x97, 0x10, 0xd5, 0x73, 0xb7, 0x59,
-	0x44, 0xe8, 0xa0, 0xc3, 0xe8, 0xb0, 0xb0, 0x9d, 0x73, 0x1d, 0x4c, 0x67, 0x43, 0x41, 0xed, 0x19,
-	0xbb, 0xa5, 0xf4, 0xb8, 0x52, 0x8c, 0x4f, 0x9a, 0x6e, 0x77, 0x49, 0x4c, 0x52, 0xca, 0x04, 0x96,
-	0x3d, 0x22, 0x98, 0xc4, 0x82, 0x1d, 0x12, 0x11, 0xe2, 0x90, 0x4b, 0x0a, 0x79, 0xaa, 0xcc, 0xa6,
-	0xa3, 0xb5, 0x17, 0xfc, 0x27, 0x17, 0xeb, 0xe5, 0x76, 0xd5, 0xcb, 0x3f, 0x72, 0xa2, 0xe0, 0xe6,
-	0x84, 0xd8, 0x2d, 0x81, 0xa0, 0xd4, 0x3b, 0x63, 0xd9, 0x48, 0xa7, 0x06, 0x7f, 0x90, 0x83, 0x62,
-	0x38, 0x64, 0x29, 0x24, 0xd2, 0x9c, 0x29, 0x27, 0xb3, 0x31, 0x2c, 0xec, 0xbb, 0xe7, 0x9e, 0x5d,
-	0x07, 0xd1, 0x6a, 0xc8, 0x32, 0xc1, 0xe8, 0xc8, 0x12, 0x9b, 0x48, 0x89, 0x9c, 0x21, 0x53, 0xab,
-	0xff, 0x8c, 0xa7, 0x23, 0xb8, 0x53, 0xb2, 0xc6, 0x7b, 0x4d, 0x37, 0x6a, 0x79, 0xf2, 0x4c, 0xf1,
-	0x84, 0x49, 0xf3, 0x7f, 0xa7, 0xd9, 0xbe, 0xb4, 0xde, 0x72, 0x2b, 0xc7, 0xb8, 0x13, 0xc7, 0xb8,
-	0x9d, 0xb1, 0x63, 0xfc, 0xfb, 0xa3, 0xa1, 0xfc, 0x2c, 0x6c, 0x63, 0xe2, 0xa1, 0x55, 0x48, 0xb8,
-	0x62, 0x49, 0xa6, 0x06, 0xc3, 0xc2, 0x6e, 0x9d, 0x6b, 0x70, 0x9c, 0x18, 0x7d, 0xfe, 0x6e, 0x6b,
-	0xc1, 0xd5, 0x33, 0xe1, 0x79, 0x75, 0x6f, 0x7c, 0xd0, 0xf4, 0x3b, 0x5c, 0xe2, 0x8c, 0x89, 0x84,
-	0x4b, 0xc9, 0x21, 0x8d, 0x99, 0x94, 0x38, 0x03, 0x88, 0x31, 0x15, 0xac, 0xac, 0x80, 0x59, 0x4a,
-	0xba, 0x31, 0x0b, 0xcd, 0x59, 0x47, 0x6b, 0xcf, 0xfb, 0xeb, 0xc3, 0xc2, 0x76, 0xab, 0x3a, 0x17,
-	0x0c, 0x44, 0xc1, 0x32, 0x97, 0x3b, 0x53, 0xe0, 0x0e, 0x40, 0xfc, 0x70, 0x8c, 0x3d, 0xaa, 0x28,
-	0xe3, 0xad, 0xbe, 0x9c, 0xa7, 0x82, 0x49, 0x25, 0x38, 0x55, 0x2c, 0xac, 0xe5, 0x02, 0x81, 0x0f,
-	0x7b, 0x5c, 0xb1, 0x98, 0x4b, 0x65, 0xce, 0x95, 0xbf, 0xc3, 0x1d, 0x16, 0xf6, 0x4a, 0xd5, 0xc5,
-	0x05, 0x82, 0x50, 0xe0, 0xd4, 0xa9, 0xdf, 0xd5, 0x41, 0xbc, 0x98, 0x20, 0xc6, 0x03, 0xfd, 0x4a,
-	0x0f, 0xa0, 0x8f, 0x23, 0x22, 0x71, 0xcc, 0x13, 0xae, 0xcc, 0x79, 0x47, 0x6b, 0xcf, 0xf8, 0xad,
-	0x61, 0x61, 0x2f, 0x56, 0x95, 0xa6, 0x75, 0x14, 0x5c, 0x1e, 0x5d, 0x6c, 0x11, 0xb9, 0x3d, 0x3a,
-	0xfa, 0x2f, 0x8f, 0x4e, 0x2c, 0xed, 0xf8, 0xc4, 0xd2, 0x7e, 0x9c, 0x58, 0xda, 0xc7, 0x53, 0xab,
-	0x71, 0x7c, 0x6a, 0x35, 0xbe, 0x9e, 0x5a, 0x8d, 0x3d, 0x3f, 0xe2, 0xaa, 0x97, 0x77, 0x5d, 0x0a,
-	0x89, 0x37, 0x5e, 0x20, 0x6b, 0x31, 0xe9, 0xca, 0xc9, 0xc1, 0x7b, 0xb5, 0xbe, 0xe1, 0xbd, 0x9e,
-	0xda, 0x3f, 0x6b, 0x67, 0x0b, 0x48, 0x0d, 0x32, 0x26, 0xbb, 0xb3, 0xa5, 0x17, 0xee, 0xfd, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0x26, 0x79, 0x09, 0x52, 0xae, 0x04, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4f, 0xd4, 0x4a,
+	0x1c, 0xdf, 0x61, 0x79, 0x3c, 0x28, 0xbc, 0x97, 0xbc, 0x02, 0xa1, 0xcb, 0xc3, 0xb6, 0x19, 0x8c,
+	0x6e, 0x08, 0xb4, 0x06, 0x13, 0x0e, 0x70, 0x30, 0xa9, 0xab, 0x5c, 0x30, 0xc1, 0xa2, 0x31, 0x21,
+	0x26, 0x75, 0xb6, 0x1d, 0xba, 0x93, 0x6d, 0x3b, 0x65, 0x66, 0x2a, 0xae, 0x89, 0x27, 0x63, 0xe2,
+	0x91, 0x83, 0x07, 0xff, 0x13, 0xff, 0x05, 0x8e, 0xdc, 0x34, 0x1e, 0xaa, 0x81, 0x9b, 0xc7, 0xfe,
+	0x05, 0x66, 0xdb, 0x5d, 0x69, 0x03, 0xe8, 0xde, 0x66, 0xbe, 0x9f, 0xcf, 0xf7, 0xf7, 0x0f, 0x69,
+	0x85, 0xf2, 0x90, 0x72, 0xc2, 0x4d, 0x97, 0x46, 0x2e, 0x8e, 0x04, 0x43, 0x02, 0x7b, 0x01, 0x39,
+	0x4c, 0x88, 0x47, 0x44, 0xcf, 0x8c, 0x11, 0x43, 0x21, 0x37, 0x62, 0x46, 0x05, 0x95, 0x6f, 0x0c,
+	0xb8, 0xc6, 0x95, 0xdc, 0xc5, 0x39, 0x9f, 0xfa, 0x34, 0x67, 0x9a, 0xfd, 0x57, 0xa1, 0xb4, 0xa8,
+	0xfa, 0x94, 0xfa, 0x01, 0x36, 0xf3, 0x5f, 0x3b, 0x39, 0x30, 0xbd, 0x84, 0x21, 0x41, 0x68, 0x54,
+	0xe0, 0xf0, 0xf3, 0xa4, 0x34, 0xb1, 0x9b, 0x7b, 0x91, 0xf7, 0xa5, 0x05, 0x94, 0x88, 0x0e, 0x65,
+	0xe4, 0x35, 0xf6, 0x1c, 0x41, 0xdc, 0xae, 0xc3, 0x63, 0xe4, 0x92, 0xc8, 0x57, 0x80, 0x5e, 0x6f,
+	0x8e, 0x5b, 0x30, 0x4b, 0x35, 0xb5, 0x87, 0xc2, 0x60, 0x13, 0x5e, 0x43, 0x84, 0xf6, 0xfc, 0x05,
+	0xf2, 0x84, 0xb8, 0xdd, 0xbd, 0x42, 0x2e, 0xbf, 0x05, 0x52, 0xa3, 0xa4, 0xc3, 0x63, 0x86, 0x91,
+	0xe7, 0x1c, 0x20, 0x57, 0x50, 0xc6, 0x95, 0x31, 0xbd, 0xde, 0x9c, 0xb2, 0xb6, 0x4f, 0x52, 0xad,
+	0xf6, 0x35, 0xd5, 0xfe, 0x77, 0xf3, 0x44, 0xb9, 0xd7, 0x35, 0x08, 0x35, 0x43, 0x24, 0x3a, 0xc6,
+	0x0e, 0xf6, 0x91, 0xdb, 0x6b, 0x61, 0x37, 0x4b, 0x35, 0xfd, 0x52, 0x04, 0x55, 0x6b, 0xd0, 0x2e,
+	0xa5, 0xb1, 0x97, 0x43, 0x0f, 0x0b, 0x44, 0xfe, 0x00, 0x24, 0xad, 0x8d, 0x02, 0x14, 0xb9, 0x98,
+	0x39, 0xbc, 0x83, 0x18, 0xe6, 0x0e, 0xc3, 0x47, 0x88, 0x79, 0x8e, 0x47, 0xb8, 0x4b, 0x93, 0x48,
+	0x28, 0x75, 0x1d, 0x34, 0xa7, 0xac, 0x47, 0xa3, 0xc5, 0x72, 0xab, 0x88, 0xe5, 0x0f, 0x36, 0xa1,
+	0xbd, 0x34, 0x64, 0xec, 0xe5, 0x04, 0x3b, 0xc7, 0x5b, 0x03, 0x58, 0x8e, 0x2a, 0x85, 0x3f, 0x4c,
+	0xa8, 0xc0, 0x8e, 0x87, 0x23, 0x1a, 0x72, 0x65, 0x3c, 0xaf, 0xcc, 0x46, 0x96, 0x6a, 0x77, 0x2e,
+	0xa5, 0x5d, 0x26, 0xc2, 0x55, 0x0f, 0xc7, 0x0c, 0xbb, 0xfd, 0x91, 0xd8, 0x84, 0x82, 0x25, 0x18,
+	0x2a, 0xa0, 0xdc, 0x8c, 0xc7, 0x7d, 0x72, 0x2b, 0xe7, 0xca, 0xef, 0x80, 0x24, 0x97, 0xec, 0x24,
+	0xb1, 0x20, 0x21, 0xe6, 0xca, 0x5f, 0x7a, 0xbd, 0x39, 0xbd, 0xde, 0x30, 0x8a, 0x89, 0x31, 0x86,
+	0x13, 0x63, 0xb4, 0x06, 0x13, 0x63, 0x6d, 0xf5, 0x8b, 0xf2, 0x23, 0xd5, 0xe4, 0xe1, 0x0c, 0xad,
+	0xd2, 0x90, 0x08, 0x1c, 0xc6, 0xa2, 0x97, 0xa5, 0x5a, 0xe3, 0x52, 0x80, 0x03, 0xc3, 0xf0, 0xe3,
+	0x37, 0x0d, 0xd8, 0xff, 0x5d, 0x00, 0x4f, 0x0b, 0xb9, 0xfc, 0x1e, 0x48, 0xb7, 0x09, 0x77, 0x62,
+	0xcc, 0x42, 0xc2, 0x39, 0xa1, 0x51, 0x80, 0x39, 0x77, 0x62, 0x4a, 0x03, 0xc7, 0x65, 0x38, 0xf7,
+	0xe0, 0xe0, 0x08, 0xb5, 0x03, 0xec, 0x29, 0x13, 0x3a, 0x68, 0x4e, 0x5a, 0xeb, 0x59, 0xaa, 0x19,
+	0x85, 0x9f, 0x11, 0x15, 0xa1, 0xbd, 0x4c, 0xf8, 0x6e, 0x85, 0xb8, 0x4b, 0x69, 0x70, 0x7f, 0x40,
+	0x7b, 0x50, 0xb0, 0xe4, 0x37, 0xd2, 0x72, 0x12, 0x31, 0xcc, 0x05, 0x23, 0xae, 0xc0, 0x5e, 0xc9,
+	0x16, 0x65, 0xce, 0x51, 0x87, 0x08, 0x1c, 0x10, 0x2e, 0x94, 0xbf, 0xf3, 0x76, 0x18, 0x59, 0xaa,
+	0xad, 0x14, 0x51, 0x8c, 0xa0, 0x04, 0x6d, 0xbd, 0xcc, 0xfa, 0xe5, 0x9d, 0xb2, 0x67, 0x43, 0x8a,
+	0x7c, 0x4f, 0xfa, 0xb7, 0x43, 0x69, 0xd7, 0xf1, 0x11, 0x77, 0x02, 0x12, 0x12, 0xa1, 0x4c, 0xea,
+	0xa0, 0x39, 0x6e, 0x35, 0xb2, 0x54, 0x9b, 0x2f, 0x3c, 0x55, 0x71, 0x68, 0xcf, 0xf4, 0x05, 0xdb,
+	0x88, 0xef, 0xf4, 0xbf, 0xf2, 0x31, 0x90, 0xe6, 0x3d, 0x7c, 0x80, 0x92, 0x40, 0x54, 0x16, 0x92,
+	0x2b, 0x53, 0x79, 0x57, 0x37, 0x8c, 0xdf, 0x1e, 0x0f, 0xa3, 0xbc, 0x27, 0xa5, 0xbd, 0xb5, 0x6e,
+	0xf6, 0x5b, 0x9e, 0xa5, 0xda, 0x52, 0x11, 0xc4, 0x95, 0x2e, 0xa0, 0x3d, 0x3b, 0x90, 0x97, 0x34,
+	0x39, 0xfc, 0x04, 0xa4, 0x85, 0x6b, 0xcc, 0xca, 0x2f, 0xa4, 0x7f, 0x2a, 0x4b, 0xab, 0x80, 0x7c,
+	0xeb, 0xb6, 0x46, 0xdb, 0xba, 0xb9, 0x22, 0x98, 0x8a, 0x05, 0x68, 0xcf, 0xf0, 0x92, 0x33, 0x79,
+	0x53, 0x9a, 0xa9, 0x5c, 0xb0, 0xb1, 0xbc, 0x9e, 0x0b, 0x59, 0xaa, 0xcd, 0x16, 0xda, 0xd5, 0xb3,
+	0x35, 0x2d, 0x4a, 0x49, 0x3f, 0x3f, 0x39, 0x53, 0xc1, 0xe9, 0x99, 0x0a, 0xbe, 0x9f, 0xa9, 0xe0,
+	0xf8, 0x5c, 0xad, 0x9d, 0x9e, 0xab, 0xb5, 0x2f, 0xe7, 0x6a, 0x6d, 0xdf, 0xf2, 0x89, 0xe8, 0x24,
+	0x6d, 0xc3, 0xa5, 0xa1, 0x39, 0x28, 0xe8, 0x5a, 0x80, 0xda, 0x7c, 0xf8, 0x31, 0x5f, 0xae, 0x6f,
+	0x98, 0xaf, 0x2a, 0xc7, 0x7c, 0xed, 0xe2, 0x9a, 0x8b, 0x5e, 0x8c, 0x79, 0x7b, 0x22, 0x5f, 0xac,
+	0xbb, 0x3f, 0x07, 0x00, 0x20, 0x0e, 0xa6, 0x8a, 0xfb, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -215,6 +280,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DefaultTickSpacings) > 0 {
+		for iNdEx := len(m.DefaultTickSpacings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DefaultTickSpacings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.HookGasLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
 		i--
@@ -305,6 +384,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpreadFactorTickSpacing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpreadFactorTickSpacing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpreadFactorTickSpacing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickSpacing != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -361,6 +478,26 @@ func (m *Params) Size() (n int) {
 	if m.HookGasLimit != 0 {
 		n += 1 + sovParams(uint64(m.HookGasLimit))
 	}
+	if len(m.DefaultTickSpacings) > 0 {
+		for _, e := range m.DefaultTickSpacings {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpreadFactorTickSpacing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.TickSpacing != 0 {
+		n += 1 + sovParams(uint64(m.TickSpacing))
+	}
 	return n
 }
 
@@ -682,6 +819,143 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTickSpacings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultTickSpacings = append(m.DefaultTickSpacings, SpreadFactorTickSpacing{})
+			if err := m.DefaultTickSpacings[len(m.DefaultTickSpacings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpreadFactorTickSpacing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpreadFactorTickSpacing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpreadFactorTickSpacing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -107,3 +107,53 @@ func TestValidateBalancerSharesDiscount(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDefaultTickSpacings(t *testing.T) {
+	tests := map[string]struct {
+		i           interface{}
+		expectError bool
+	}{
+		"happy path": {
+			i: types.DefaultTickSpacings,
+		},
+		"empty": {
+			i: []types.SpreadFactorTickSpacing{},
+		},
+		"error: wrong type": {
+			i:           []uint64{1},
+			expectError: true,
+		},
+		"error: negative spread factor": {
+			i:           []types.SpreadFactorTickSpacing{{SpreadFactor: osmomath.NewDecWithPrec(-1, 4), TickSpacing: 1}},
+			expectError: true,
+		},
+		"error: spread factor of one": {
+			i:           []types.SpreadFactorTickSpacing{{SpreadFactor: osmomath.OneDec(), TickSpacing: 1}},
+			expectError: true,
+		},
+		"error: duplicate spread factor": {
+			i: []types.SpreadFactorTickSpacing{
+				{SpreadFactor: osmomath.NewDecWithPrec(1, 4), TickSpacing: 1},
+				{SpreadFactor: osmomath.NewDecWithPrec(1, 4), TickSpacing: 10},
+			},
+			expectError: true,
+		},
+		"error: zero tick spacing": {
+			i:           []types.SpreadFactorTickSpacing{{SpreadFactor: osmomath.NewDecWithPrec(1, 4), TickSpacing: 0}},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateDefaultTickSpacings(tc.i)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
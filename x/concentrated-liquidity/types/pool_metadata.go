@@ -0,0 +1,13 @@
+package types
+
+// ValidatePoolMetadataFields validates the user supplied fields of a pool's metadata.
+// Both fields are optional, but must not exceed their maximum lengths.
+func ValidatePoolMetadataFields(name, tickerPairing string) error {
+	if len(name) > MaxPoolNameLength {
+		return PoolMetadataFieldTooLongError{Field: "name", Length: len(name), MaxLength: MaxPoolNameLength}
+	}
+	if len(tickerPairing) > MaxPoolTickerPairingLength {
+		return PoolMetadataFieldTooLongError{Field: "ticker pairing", Length: len(tickerPairing), MaxLength: MaxPoolTickerPairingLength}
+	}
+	return nil
+}
@@ -0,0 +1,644 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/stop_condition.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StopCondition is a condition registered by a position owner under which
+// the position is fully withdrawn to the owner. The condition is met once the
+// pool's current tick has stayed outside of [lower_tick, upper_tick) for at
+// least duration. Any account can then execute the condition and receive the
+// bounty escrowed by the owner at registration.
+type StopCondition struct {
+	PositionId     uint64        `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	PoolId         uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner          string        `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick      int64         `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick      int64         `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Duration       time.Duration `protobuf:"bytes,6,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	Bounty         types.Coin    `protobuf:"bytes,7,opt,name=bounty,proto3" json:"bounty" yaml:"bounty"`
+	RegisteredTime time.Time     `protobuf:"bytes,8,opt,name=registered_time,json=registeredTime,proto3,stdtime" json:"registered_time" yaml:"registered_time"`
+}
+
+func (m *StopCondition) Reset()         { *m = StopCondition{} }
+func (m *StopCondition) String() string { return proto.CompactTextString(m) }
+func (*StopCondition) ProtoMessage()    {}
+func (*StopCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7c3b7f5f63b16, []int{0}
+}
+func (m *StopCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopCondition.Merge(m, src)
+}
+func (m *StopCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopCondition proto.InternalMessageInfo
+
+func (m *StopCondition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *StopCondition) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *StopCondition) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StopCondition) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *StopCondition) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *StopCondition) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *StopCondition) GetBounty() types.Coin {
+	if m != nil {
+		return m.Bounty
+	}
+	return types.Coin{}
+}
+
+func (m *StopCondition) GetRegisteredTime() time.Time {
+	if m != nil {
+		return m.RegisteredTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*StopCondition)(nil), "osmosis.concentratedliquidity.v1beta1.StopCondition")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/stop_condition.proto", fileDescriptor_73a7c3b7f5f63b16)
+}
+
+var fileDescriptor_73a7c3b7f5f63b16 = []byte{
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x1b, 0xb6, 0x75, 0xab, 0xc7, 0x36, 0x88, 0xd8, 0x14, 0x8a, 0x94, 0x54, 0x96, 0x40,
+	0x95, 0xd0, 0x62, 0x6d, 0x20, 0x90, 0x76, 0xcc, 0x38, 0xb0, 0x6b, 0xd8, 0x09, 0x21, 0x55, 0xf9,
+	0x63, 0x82, 0xb5, 0x24, 0x6f, 0x88, 0x9d, 0x8d, 0x7e, 0x8b, 0x1e, 0xf9, 0x48, 0x3b, 0xee, 0xc8,
+	0x29, 0xa0, 0xf6, 0x1b, 0xe4, 0x13, 0xa0, 0xd8, 0xf1, 0x02, 0x85, 0x9b, 0xdf, 0xfc, 0xfc, 0x3c,
+	0x79, 0xe5, 0xd7, 0x46, 0x67, 0xc0, 0x33, 0xe0, 0x8c, 0x93, 0x08, 0xf2, 0x88, 0xe6, 0xa2, 0x0c,
+	0x04, 0x8d, 0x53, 0xf6, 0xb5, 0x62, 0x31, 0x13, 0x73, 0x72, 0x7d, 0x12, 0x52, 0x11, 0x9c, 0x10,
+	0x2e, 0xa0, 0x98, 0x45, 0x90, 0xc7, 0x4c, 0x30, 0xc8, 0xdd, 0xa2, 0x04, 0x01, 0xe6, 0xf3, 0x8e,
+	0x75, 0xff, 0xcb, 0xba, 0x1d, 0x3b, 0x7e, 0x92, 0x40, 0x02, 0x92, 0x20, 0xed, 0x4a, 0xc1, 0x63,
+	0x3b, 0x01, 0x48, 0x52, 0x4a, 0x64, 0x15, 0x56, 0x9f, 0x49, 0x5c, 0x95, 0x41, 0x2f, 0x1f, 0x3b,
+	0xeb, 0xb9, 0x60, 0x19, 0xe5, 0x22, 0xc8, 0x0a, 0x2d, 0x88, 0xe4, 0xef, 0x49, 0x18, 0x70, 0x7a,
+	0xdf, 0x67, 0x04, 0xac, 0x13, 0xe0, 0xc5, 0x26, 0xda, 0xfb, 0x20, 0xa0, 0x38, 0xd7, 0x5d, 0x9b,
+	0x6f, 0xd1, 0x6e, 0x01, 0x5c, 0xae, 0x67, 0x2c, 0xb6, 0x8c, 0x89, 0x31, 0xdd, 0xf4, 0x8e, 0x9a,
+	0xda, 0x31, 0xe7, 0x41, 0x96, 0x9e, 0xe1, 0x3f, 0x42, 0xec, 0x23, 0x5d, 0x5d, 0xc4, 0xe6, 0x4b,
+	0xb4, 0x5d, 0x00, 0xa4, 0x2d, 0xf4, 0x40, 0x42, 0x66, 0x53, 0x3b, 0xfb, 0x1a, 0x92, 0x01, 0xf6,
+	0x87, 0xed, 0xea, 0x22, 0x36, 0x5f, 0xa0, 0x2d, 0xb8, 0xc9, 0x69, 0x69, 0x6d, 0x4c, 0x8c, 0xe9,
+	0xc8, 0x7b, 0xd4, 0xd4, 0xce, 0x43, 0xb5, 0x55, 0x7e, 0xc6, 0xbe, 0x8a, 0xcd, 0xd7, 0x08, 0xa5,
+	0x70, 0x43, 0xcb, 0x99, 0x60, 0xd1, 0x95, 0xb5, 0x39, 0x31, 0xa6, 0x1b, 0xde, 0x61, 0x53, 0x3b,
+	0x8f, 0xd5, 0xe6, 0x3e, 0xc3, 0xfe, 0x48, 0x16, 0x97, 0x2c, 0xba, 0x6a, 0xa9, 0xaa, 0x28, 0x34,
+	0xb5, 0xb5, 0x4e, 0xf5, 0x19, 0xf6, 0x47, 0xb2, 0x90, 0x94, 0x8f, 0x76, 0xf4, 0xf1, 0x5a, 0xc3,
+	0x89, 0x31, 0xdd, 0x3d, 0x7d, 0xea, 0xaa, 0xf3, 0x75, 0xf5, 0xf9, 0xba, 0xef, 0xba, 0x0d, 0xde,
+	0xb3, 0xdb, 0xda, 0x19, 0x34, 0xb5, 0x73, 0xa0, 0x94, 0x1a, 0xc4, 0xdf, 0x7f, 0x3a, 0x86, 0x7f,
+	0xef, 0x31, 0xdf, 0xa3, 0x61, 0x08, 0x55, 0x2e, 0xe6, 0xd6, 0x76, 0x67, 0x54, 0x03, 0x71, 0xdb,
+	0x81, 0xe8, 0xe1, 0xbb, 0xe7, 0xc0, 0x72, 0xef, 0xb0, 0x33, 0xee, 0x29, 0xa3, 0xc2, 0xb0, 0xdf,
+	0xf1, 0x66, 0x82, 0x0e, 0x4a, 0x9a, 0x30, 0x2e, 0x68, 0x49, 0xe3, 0x59, 0x3b, 0x67, 0x6b, 0x47,
+	0x2a, 0xc7, 0xff, 0x34, 0x79, 0xa9, 0x2f, 0x81, 0x87, 0x3b, 0xe7, 0x91, 0x72, 0xae, 0x09, 0xf0,
+	0xa2, 0x6d, 0x76, 0xbf, 0xff, 0xda, 0x82, 0xde, 0xa7, 0xdb, 0xa5, 0x6d, 0xdc, 0x2d, 0x6d, 0xe3,
+	0xd7, 0xd2, 0x36, 0x16, 0x2b, 0x7b, 0x70, 0xb7, 0xb2, 0x07, 0x3f, 0x56, 0xf6, 0xe0, 0xa3, 0x97,
+	0x30, 0xf1, 0xa5, 0x0a, 0xdd, 0x08, 0x32, 0xd2, 0xdd, 0xea, 0xe3, 0x34, 0x08, 0xb9, 0x2e, 0xc8,
+	0xf5, 0xe9, 0x1b, 0xf2, 0xed, 0xaf, 0x47, 0x72, 0xdc, 0xbf, 0x12, 0x31, 0x2f, 0x28, 0x0f, 0x87,
+	0xb2, 0xcb, 0x57, 0xbf, 0x07, 0x00, 0xc9, 0xb0, 0xd7, 0x0f, 0x53, 0x03, 0x00, 0x00,
+}
+
+func (m *StopCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RegisteredTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStopCondition(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Bounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStopCondition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStopCondition(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if m.UpperTick != 0 {
+		i = encodeVarintStopCondition(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintStopCondition(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStopCondition(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintStopCondition(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintStopCondition(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStopCondition(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStopCondition(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StopCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovStopCondition(uint64(m.PositionId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovStopCondition(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStopCondition(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovStopCondition(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovStopCondition(uint64(m.UpperTick))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStopCondition(uint64(l))
+	l = m.Bounty.Size()
+	n += 1 + l + sovStopCondition(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredTime)
+	n += 1 + l + sovStopCondition(uint64(l))
+	return n
+}
+
+func sovStopCondition(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStopCondition(x uint64) (n int) {
+	return sovStopCondition(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StopCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStopCondition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RegisteredTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStopCondition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStopCondition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStopCondition(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStopCondition
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStopCondition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStopCondition
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStopCondition
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStopCondition
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStopCondition        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStopCondition          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStopCondition = fmt.Errorf("proto: unexpected end of group")
+)
@@ -410,6 +410,16 @@ func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins
 	return pool.GetTotalPoolLiquidity(ctx), nil
 }
 
+// GetPoolTypeGasCosts returns the base gas cost of swaps through cosmwasm pools.
+// Joins and exits are not supported through the pool manager, so their gas costs are zero.
+// This implements poolmanagertypes.PoolModuleGasCostsI.
+func (k Keeper) GetPoolTypeGasCosts(poolType poolmanagertypes.PoolType) poolmanagertypes.PoolTypeGasCosts {
+	return poolmanagertypes.PoolTypeGasCosts{
+		PoolType: poolType,
+		SwapGas:  types.CosmWasmSwapGas,
+	}
+}
+
 // GetTotalLiquidity retrieves the total liquidity of all cw pools.
 func (k Keeper) GetTotalLiquidity(ctx sdk.Context) (sdk.Coins, error) {
 	totalLiquidity := sdk.Coins{}
@@ -10,6 +10,10 @@ const (
 	StoreKey = ModuleName
 
 	RouterKey = ModuleName
+
+	// CosmWasmSwapGas is the base gas cost of a swap through a cosmwasm pool, declared to the pool manager
+	// for gas estimation. The actual cost depends on the pool's contract.
+	CosmWasmSwapGas uint64 = 300_000
 )
 
 var (
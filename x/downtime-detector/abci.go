@@ -20,18 +20,18 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 }
 
 // saveDowntimeUpdates saves the current block time as the
-// last time the chain was down for all downtime lengths that are LTE the provided downtime.
+// last time the chain was down for all tracked downtime thresholds that are LTE the provided downtime.
 func (k *Keeper) saveDowntimeUpdates(ctx sdk.Context, downtime time.Duration) {
 	// minimum stored downtime is 30S, so if downtime is less than that, don't update anything.
 	if downtime < 30*time.Second {
 		return
 	}
-	types.DowntimeToDuration.Ascend(0, func(downType types.Downtime, duration time.Duration) bool {
-		// if downtime < duration of this entry, stop iterating further, don't update this entry.
+	for _, downType := range k.GetParams(ctx).DowntimeThresholds {
+		duration, _ := types.DowntimeToDuration.Get(downType)
+		// if downtime < duration of this threshold, don't update this entry.
 		if downtime < duration {
-			return false
+			continue
 		}
 		k.StoreLastDowntimeOfLength(ctx, downType, ctx.BlockTime())
-		return true
-	})
+	}
 }
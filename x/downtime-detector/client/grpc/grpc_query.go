@@ -31,3 +31,13 @@ func (q Querier) RecoveredSinceDowntimeOfLength(grpcCtx context.Context,
 	return q.Q.RecoveredSinceDowntimeOfLength(ctx, *req)
 }
 
+func (q Querier) Params(grpcCtx context.Context,
+	req *queryproto.ParamsRequest,
+) (*queryproto.ParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Params(ctx, *req)
+}
+
@@ -20,3 +20,7 @@ func (querier *Querier) RecoveredSinceDowntimeOfLength(ctx sdk.Context, req quer
 		SuccesfullyRecovered: val,
 	}, nil
 }
+
+func (querier *Querier) Params(ctx sdk.Context, req queryproto.ParamsRequest) (*queryproto.ParamsResponse, error) {
+	return &queryproto.ParamsResponse{Params: querier.K.GetParams(ctx)}, nil
+}
@@ -139,9 +139,91 @@ func (m *RecoveredSinceDowntimeOfLengthResponse) GetSuccesfullyRecovered() bool
 	return false
 }
 
+type ParamsRequest struct {
+}
+
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f82bc400cce002f, []int{2}
+}
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+
+type ParamsResponse struct {
+	Params types.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f82bc400cce002f, []int{3}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() types.Params {
+	if m != nil {
+		return m.Params
+	}
+	return types.Params{}
+}
+
 func init() {
 	proto.RegisterType((*RecoveredSinceDowntimeOfLengthRequest)(nil), "osmosis.downtimedetector.v1beta1.RecoveredSinceDowntimeOfLengthRequest")
 	proto.RegisterType((*RecoveredSinceDowntimeOfLengthResponse)(nil), "osmosis.downtimedetector.v1beta1.RecoveredSinceDowntimeOfLengthResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.downtimedetector.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.downtimedetector.v1beta1.ParamsResponse")
 }
 
 func init() {
@@ -149,38 +231,42 @@ func init() {
 }
 
 var fileDescriptor_3f82bc400cce002f = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x8b, 0xd3, 0x4e,
-	0x14, 0x6e, 0x0a, 0xbf, 0x1f, 0x4b, 0x04, 0x85, 0xb8, 0x42, 0xb7, 0x48, 0x52, 0x82, 0xca, 0xb2,
-	0xb8, 0x33, 0x6c, 0x17, 0x44, 0xbc, 0x59, 0x17, 0x74, 0x41, 0x10, 0xe3, 0x4d, 0x91, 0x32, 0x99,
-	0xbe, 0x66, 0x07, 0x92, 0x99, 0x6c, 0x66, 0x52, 0xcd, 0xd5, 0x93, 0x47, 0xc1, 0x8b, 0x7f, 0xd2,
-	0x1e, 0x17, 0xbc, 0x78, 0xaa, 0xda, 0xfa, 0x17, 0xec, 0x1f, 0x20, 0x92, 0x4c, 0x26, 0x94, 0x28,
-	0x1b, 0xc1, 0x53, 0x3b, 0x7c, 0xdf, 0xfb, 0xde, 0xf7, 0xbd, 0x97, 0x67, 0xdf, 0x15, 0x32, 0x11,
-	0x92, 0x49, 0x3c, 0x13, 0x6f, 0xb8, 0x62, 0x09, 0xcc, 0x40, 0x01, 0x55, 0x22, 0xc3, 0x8b, 0x83,
-	0x10, 0x14, 0x39, 0xc0, 0xa7, 0x39, 0x64, 0x05, 0x4a, 0x33, 0xa1, 0x84, 0x33, 0xaa, 0xd9, 0xa8,
-	0xcd, 0x46, 0x35, 0x7b, 0xb8, 0x1d, 0x89, 0x48, 0x54, 0x64, 0x5c, 0xfe, 0xd3, 0x75, 0x43, 0xd4,
-	0xd9, 0x25, 0x02, 0x0e, 0xa5, 0xb0, 0xe6, 0xdf, 0xef, 0xe4, 0x1b, 0x60, 0x3a, 0xcb, 0x33, 0xa2,
-	0x98, 0xe0, 0x75, 0xa5, 0x4b, 0xab, 0x52, 0x1c, 0x12, 0x09, 0x0d, 0x99, 0x0a, 0x66, 0xf0, 0xbd,
-	0x4d, 0xbc, 0x8a, 0xd6, 0xb0, 0x52, 0x12, 0x31, 0xbe, 0xa9, 0x75, 0x33, 0x12, 0x22, 0x8a, 0x01,
-	0x93, 0x94, 0x61, 0xc2, 0xb9, 0x50, 0x15, 0x68, 0x3c, 0xee, 0xd4, 0x68, 0xf5, 0x0a, 0xf3, 0x39,
-	0x26, 0xbc, 0x30, 0x90, 0x6e, 0x32, 0xd5, 0x73, 0xd0, 0x0f, 0xe3, 0xaf, 0x5d, 0xd5, 0xf2, 0xef,
-	0xb5, 0xf1, 0x32, 0xa4, 0x54, 0x24, 0x49, 0x35, 0xc1, 0xff, 0x6e, 0xd9, 0xb7, 0x03, 0xa0, 0x62,
-	0x01, 0x19, 0xcc, 0x5e, 0x30, 0x4e, 0xe1, 0xa8, 0x1e, 0xc5, 0xb3, 0xf9, 0x53, 0xe0, 0x91, 0x3a,
-	0x09, 0xe0, 0x34, 0x07, 0xa9, 0x9c, 0x57, 0xf6, 0x96, 0x99, 0xd2, 0xc0, 0x1a, 0x59, 0xbb, 0x57,
-	0xc7, 0x7b, 0xa8, 0x6b, 0x7f, 0xc8, 0x88, 0x4d, 0xae, 0x5f, 0x2c, 0xbd, 0x6b, 0x05, 0x49, 0xe2,
-	0x07, 0xbe, 0x21, 0xfb, 0x41, 0x23, 0x58, 0x8a, 0x67, 0xda, 0x45, 0x31, 0xe8, 0x8f, 0xac, 0xdd,
-	0x2b, 0xe3, 0x1d, 0xa4, 0xad, 0x23, 0x63, 0x1d, 0x1d, 0xd5, 0xd1, 0x26, 0xb7, 0xce, 0x96, 0x5e,
-	0xef, 0x62, 0xe9, 0x0d, 0xb4, 0x9e, 0x29, 0x6c, 0x76, 0xe7, 0x7f, 0xfa, 0xea, 0x59, 0x41, 0x23,
-	0xe8, 0xbf, 0xb6, 0xef, 0x74, 0x45, 0x94, 0xa9, 0xe0, 0x12, 0x9c, 0x43, 0xfb, 0x86, 0xcc, 0x29,
-	0x05, 0x39, 0xcf, 0xe3, 0xb8, 0x98, 0x66, 0xa6, 0xaa, 0x0a, 0xbc, 0x15, 0x6c, 0x6f, 0x80, 0x8d,
-	0xe2, 0xf8, 0x7d, 0xdf, 0xfe, 0xef, 0x79, 0xb9, 0x7a, 0xe7, 0xa7, 0x65, 0xbb, 0x97, 0x77, 0x72,
-	0x1e, 0x77, 0xcf, 0xec, 0xaf, 0xd6, 0x31, 0x7c, 0xf2, 0xef, 0x42, 0x3a, 0xb4, 0x7f, 0xfc, 0xee,
-	0xf3, 0x8f, 0x8f, 0xfd, 0x47, 0xce, 0x43, 0xdc, 0x3e, 0x93, 0xfd, 0xdf, 0xee, 0xe4, 0x72, 0xc9,
-	0x09, 0x3d, 0x5b, 0xb9, 0xd6, 0xf9, 0xca, 0xb5, 0xbe, 0xad, 0x5c, 0xeb, 0xc3, 0xda, 0xed, 0x9d,
-	0xaf, 0xdd, 0xde, 0x97, 0xb5, 0xdb, 0x7b, 0x79, 0x1c, 0x31, 0x75, 0x92, 0x87, 0x88, 0x8a, 0xc4,
-	0xb4, 0xd9, 0x8f, 0x49, 0x28, 0x9b, 0x9e, 0x8b, 0xf1, 0x3d, 0xfc, 0xf6, 0x0f, 0x9d, 0x69, 0xcc,
-	0x80, 0x2b, 0x7d, 0x5b, 0xfa, 0x53, 0xf8, 0xbf, 0xfa, 0x39, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0xac, 0xe7, 0xb1, 0x00, 0x6c, 0x04, 0x00, 0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6b, 0x13, 0x4f,
+	0x1c, 0xce, 0xf6, 0xff, 0x37, 0x94, 0x29, 0xb6, 0xb0, 0x56, 0x48, 0x83, 0x6c, 0xc2, 0xa2, 0x12,
+	0xab, 0xdd, 0x31, 0x29, 0x88, 0x78, 0x33, 0x16, 0xb5, 0x20, 0xa8, 0xeb, 0x45, 0x14, 0x09, 0x93,
+	0xc9, 0x64, 0xbb, 0xb0, 0x3b, 0xb3, 0xdd, 0x99, 0x8d, 0xe6, 0xea, 0x27, 0x10, 0xbc, 0x78, 0xf2,
+	0xee, 0x37, 0xe9, 0xb1, 0xe0, 0xc5, 0x53, 0xd4, 0xc4, 0x4f, 0xd0, 0x0f, 0x20, 0xb2, 0xf3, 0xb2,
+	0x84, 0x55, 0xdc, 0x80, 0xa7, 0x64, 0xe6, 0x79, 0x99, 0xe7, 0xf9, 0x4d, 0x26, 0xe0, 0x06, 0xe3,
+	0x31, 0xe3, 0x21, 0x87, 0x23, 0xf6, 0x9a, 0x8a, 0x30, 0x26, 0x23, 0x22, 0x08, 0x16, 0x2c, 0x85,
+	0x93, 0xee, 0x90, 0x08, 0xd4, 0x85, 0xc7, 0x19, 0x49, 0xa7, 0x5e, 0x92, 0x32, 0xc1, 0xec, 0xb6,
+	0x66, 0x7b, 0x65, 0xb6, 0xa7, 0xd9, 0xcd, 0xed, 0x80, 0x05, 0x4c, 0x92, 0x61, 0xfe, 0x4d, 0xe9,
+	0x9a, 0x5e, 0xe5, 0x29, 0x01, 0xa1, 0x24, 0x37, 0x56, 0xfc, 0xdb, 0x95, 0x7c, 0x03, 0x0c, 0x46,
+	0x59, 0x8a, 0x44, 0xc8, 0xa8, 0x56, 0x3a, 0x58, 0x4a, 0xe1, 0x10, 0x71, 0x52, 0x90, 0x31, 0x0b,
+	0x0d, 0xbe, 0xbb, 0x8c, 0xcb, 0x6a, 0x05, 0x2b, 0x41, 0x41, 0x48, 0x97, 0xbd, 0x2e, 0x05, 0x8c,
+	0x05, 0x11, 0x81, 0x28, 0x09, 0x21, 0xa2, 0x94, 0x09, 0x09, 0x9a, 0x8c, 0x3b, 0x1a, 0x95, 0xab,
+	0x61, 0x36, 0x86, 0x88, 0x4e, 0x0d, 0xa4, 0x0e, 0x19, 0xc8, 0x15, 0x54, 0x0b, 0x93, 0xaf, 0xac,
+	0x2a, 0xe5, 0x6f, 0x95, 0xf1, 0xbc, 0x24, 0x17, 0x28, 0x4e, 0x14, 0xc1, 0xfd, 0x6e, 0x81, 0x2b,
+	0x3e, 0xc1, 0x6c, 0x42, 0x52, 0x32, 0x7a, 0x16, 0x52, 0x4c, 0x0e, 0xf4, 0x28, 0x1e, 0x8f, 0x1f,
+	0x11, 0x1a, 0x88, 0x23, 0x9f, 0x1c, 0x67, 0x84, 0x0b, 0xfb, 0x25, 0x58, 0x37, 0x53, 0x6a, 0x58,
+	0x6d, 0xab, 0xb3, 0xd9, 0xdb, 0xf5, 0xaa, 0xee, 0xcf, 0x33, 0x66, 0xfd, 0x0b, 0x67, 0xb3, 0xd6,
+	0xd6, 0x14, 0xc5, 0xd1, 0x1d, 0xd7, 0x90, 0x5d, 0xbf, 0x30, 0xcc, 0xcd, 0x53, 0x95, 0x62, 0xda,
+	0x58, 0x6b, 0x5b, 0x9d, 0x8d, 0xde, 0x8e, 0xa7, 0xa2, 0x7b, 0x26, 0xba, 0x77, 0xa0, 0xab, 0xf5,
+	0x2f, 0x9f, 0xcc, 0x5a, 0xb5, 0xb3, 0x59, 0xab, 0xa1, 0xfc, 0x8c, 0xb0, 0xb8, 0x3b, 0xf7, 0xc3,
+	0xd7, 0x96, 0xe5, 0x17, 0x86, 0xee, 0x2b, 0x70, 0xb5, 0xaa, 0x22, 0x4f, 0x18, 0xe5, 0xc4, 0xde,
+	0x07, 0x17, 0x79, 0x86, 0x31, 0xe1, 0xe3, 0x2c, 0x8a, 0xa6, 0x83, 0xd4, 0xa8, 0x64, 0xe1, 0x75,
+	0x7f, 0x7b, 0x09, 0x2c, 0x1c, 0xdd, 0x2d, 0x70, 0xfe, 0x09, 0x4a, 0x51, 0xcc, 0xf5, 0xa4, 0xdc,
+	0xe7, 0x60, 0xd3, 0x6c, 0x68, 0xdf, 0xfb, 0xa0, 0x9e, 0xc8, 0x1d, 0x69, 0xb4, 0xd1, 0xeb, 0x54,
+	0x4f, 0x4e, 0x39, 0xf4, 0xff, 0xcf, 0xbb, 0xfa, 0x5a, 0xdd, 0xfb, 0xf4, 0x1f, 0x38, 0xf7, 0x34,
+	0xff, 0x95, 0xd9, 0x3f, 0x2d, 0xe0, 0xfc, 0xbd, 0x94, 0xfd, 0xa0, 0xfa, 0x90, 0x95, 0x6e, 0xbe,
+	0xf9, 0xf0, 0xdf, 0x8d, 0xd4, 0x1c, 0xdc, 0xc3, 0xb7, 0x9f, 0x7f, 0xbc, 0x5f, 0xbb, 0x67, 0xdf,
+	0x85, 0xe5, 0x17, 0xb9, 0xf7, 0xdb, 0x93, 0xac, 0x68, 0xf7, 0xd1, 0x02, 0x75, 0x35, 0x23, 0x1b,
+	0xae, 0x3a, 0x4d, 0x53, 0xe8, 0xe6, 0xea, 0x02, 0x1d, 0xbc, 0x2b, 0x83, 0x5f, 0xb7, 0xaf, 0xad,
+	0x10, 0x5c, 0xdf, 0x1c, 0x3e, 0x99, 0x3b, 0xd6, 0xe9, 0xdc, 0xb1, 0xbe, 0xcd, 0x1d, 0xeb, 0xdd,
+	0xc2, 0xa9, 0x9d, 0x2e, 0x9c, 0xda, 0x97, 0x85, 0x53, 0x7b, 0x71, 0x18, 0x84, 0xe2, 0x28, 0x1b,
+	0x7a, 0x98, 0xc5, 0xc6, 0x6e, 0x2f, 0x42, 0x43, 0x5e, 0x78, 0x4f, 0x7a, 0xb7, 0xe0, 0x9b, 0x3f,
+	0x9c, 0x80, 0xa3, 0x90, 0x50, 0xa1, 0xfe, 0x67, 0xd4, 0xb3, 0xa8, 0xcb, 0x8f, 0xfd, 0x5f, 0x03,
+	0x00, 0x39, 0x26, 0x45, 0xb1, 0x78, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -196,6 +282,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	RecoveredSinceDowntimeOfLength(ctx context.Context, in *RecoveredSinceDowntimeOfLengthRequest, opts ...grpc.CallOption) (*RecoveredSinceDowntimeOfLengthResponse, error)
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
 
 type queryClient struct {
@@ -215,9 +302,19 @@ func (c *queryClient) RecoveredSinceDowntimeOfLength(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.downtimedetector.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	RecoveredSinceDowntimeOfLength(context.Context, *RecoveredSinceDowntimeOfLengthRequest) (*RecoveredSinceDowntimeOfLengthResponse, error)
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -227,6 +324,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) RecoveredSinceDowntimeOfLength(ctx context.Context, req *RecoveredSinceDowntimeOfLengthRequest) (*RecoveredSinceDowntimeOfLengthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoveredSinceDowntimeOfLength not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -250,6 +350,25 @@ func _Query_RecoveredSinceDowntimeOfLength_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.downtimedetector.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.downtimedetector.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -258,6 +377,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecoveredSinceDowntimeOfLength",
 			Handler:    _Query_RecoveredSinceDowntimeOfLength_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/downtimedetector/v1beta1/query.proto",
@@ -332,6 +455,62 @@ func (m *RecoveredSinceDowntimeOfLengthResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -369,6 +548,26 @@ func (m *RecoveredSinceDowntimeOfLengthResponse) Size() (n int) {
 	return n
 }
 
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -547,6 +746,139 @@ func (m *RecoveredSinceDowntimeOfLengthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
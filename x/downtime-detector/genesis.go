@@ -7,6 +7,7 @@ import (
 )
 
 func (k *Keeper) InitGenesis(ctx sdk.Context, gen *types.GenesisState) {
+	k.SetParams(ctx, gen.Params)
 	k.StoreLastBlockTime(ctx, gen.LastBlockTime)
 	// set all default genesis down times, in case the provided list in genesis misses some.
 	k.setGenDowntimes(ctx, types.DefaultGenesis().GetDowntimes())
@@ -29,6 +30,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
 		Downtimes:     k.getGenDowntimes(ctx),
 		LastBlockTime: t,
+		Params:        k.GetParams(ctx),
 	}
 }
 
@@ -150,6 +150,38 @@ func (s *KeeperTestSuite) TestRecoveryQueryErrors() {
 	}
 }
 
+func (s *KeeperTestSuite) TestDowntimeThresholds() {
+	s.SetupTest()
+	s.App.DowntimeKeeper.SetParams(s.Ctx, types.NewParams([]types.Downtime{types.Downtime_DURATION_1M, types.Downtime_DURATION_10M}))
+	lastFiveMinDowntime, err := s.App.DowntimeKeeper.GetLastDowntimeOfLength(s.Ctx, types.Downtime_DURATION_5M)
+	s.Require().NoError(err)
+
+	s.runBlocktimes(abruptRecovery5minDowntime10min)
+
+	// Tracked downtime thresholds are updated.
+	lastDowntime, err := s.App.DowntimeKeeper.GetLastDowntimeOfLength(s.Ctx, types.Downtime_DURATION_1M)
+	s.Require().NoError(err)
+	s.Require().Equal(fifteenMinEndtime, lastDowntime)
+	lastDowntime, err = s.App.DowntimeKeeper.GetLastDowntimeOfLength(s.Ctx, types.Downtime_DURATION_10M)
+	s.Require().NoError(err)
+	s.Require().Equal(tenMinEndtime, lastDowntime)
+
+	// Untracked ones are not, and can't be queried.
+	lastDowntime, err = s.App.DowntimeKeeper.GetLastDowntimeOfLength(s.Ctx, types.Downtime_DURATION_5M)
+	s.Require().NoError(err)
+	s.Require().Equal(lastFiveMinDowntime, lastDowntime)
+	_, err = s.App.DowntimeKeeper.RecoveredSinceDowntimeOfLength(s.Ctx, types.Downtime_DURATION_5M, min)
+	s.Require().Error(err)
+
+	// The chain was down for 10 minutes 5 minutes ago.
+	down, err := s.App.DowntimeKeeper.DownWithinWindow(s.Ctx, types.Downtime_DURATION_10M, 6*min)
+	s.Require().NoError(err)
+	s.Require().True(down)
+	down, err = s.App.DowntimeKeeper.DownWithinWindow(s.Ctx, types.Downtime_DURATION_10M, 4*min)
+	s.Require().NoError(err)
+	s.Require().False(down)
+}
+
 type KeeperTestSuite struct {
 	apptesting.KeeperTestHelper
 }
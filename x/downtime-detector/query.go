@@ -2,6 +2,7 @@ package downtimedetector
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +11,9 @@ import (
 )
 
 func (k *Keeper) RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtime types.Downtime, recoveryDuration time.Duration) (bool, error) {
+	if !k.GetParams(ctx).IsTracked(downtime) {
+		return false, fmt.Errorf("downtime of %s is not a tracked downtime threshold", downtime)
+	}
 	lastDowntime, err := k.GetLastDowntimeOfLength(ctx, downtime)
 	if err != nil {
 		return false, err
@@ -24,3 +28,13 @@ func (k *Keeper) RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtime types.
 	}
 	return true, nil
 }
+
+// DownWithinWindow returns true if the chain has been down for at least the given downtime within the last window,
+// i.e. if it has not recovered from such a downtime for the whole window.
+func (k *Keeper) DownWithinWindow(ctx sdk.Context, downtime types.Downtime, window time.Duration) (bool, error) {
+	recovered, err := k.RecoveredSinceDowntimeOfLength(ctx, downtime, window)
+	if err != nil {
+		return false, err
+	}
+	return !recovered, nil
+}
@@ -13,11 +13,12 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Downtimes:     genDowntimes,
 		LastBlockTime: time.Unix(0, 0),
+		Params:        DefaultParams(),
 	}
 }
 
 func (g *GenesisState) Validate() error {
-	return nil
+	return g.Params.Validate()
 }
 
 func NewGenesisDowntimeEntry(dur Downtime, time time.Time) GenesisDowntimeEntry {
@@ -82,17 +82,66 @@ func (m *GenesisDowntimeEntry) GetLastDowntime() time.Time {
 	return time.Time{}
 }
 
+// Params holds parameters for the downtime-detector module
+type Params struct {
+	// downtime_thresholds are the downtime lengths that are tracked, i.e. for
+	// which the last time the chain was down for at least that long is stored
+	// and can be queried.
+	DowntimeThresholds []Downtime `protobuf:"varint,1,rep,packed,name=downtime_thresholds,json=downtimeThresholds,proto3,enum=osmosis.downtimedetector.v1beta1.Downtime" json:"downtime_thresholds,omitempty" yaml:"downtime_thresholds"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d44d4cc05d2cb13, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDowntimeThresholds() []Downtime {
+	if m != nil {
+		return m.DowntimeThresholds
+	}
+	return nil
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	Downtimes     []GenesisDowntimeEntry `protobuf:"bytes,1,rep,name=downtimes,proto3" json:"downtimes"`
 	LastBlockTime time.Time              `protobuf:"bytes,2,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time" yaml:"last_block_time"`
+	Params        Params                 `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d44d4cc05d2cb13, []int{1}
+	return fileDescriptor_3d44d4cc05d2cb13, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,8 +184,16 @@ func (m *GenesisState) GetLastBlockTime() time.Time {
 	return time.Time{}
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisDowntimeEntry)(nil), "osmosis.downtimedetector.v1beta1.GenesisDowntimeEntry")
+	proto.RegisterType((*Params)(nil), "osmosis.downtimedetector.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.downtimedetector.v1beta1.GenesisState")
 }
 
@@ -145,33 +202,37 @@ func init() {
 }
 
 var fileDescriptor_3d44d4cc05d2cb13 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6a, 0xe2, 0x40,
-	0x1c, 0xc6, 0x33, 0xbb, 0xcb, 0xb2, 0x1b, 0xdd, 0x15, 0xb2, 0xb2, 0xb8, 0x1e, 0x92, 0x90, 0x93,
-	0x2c, 0x38, 0x83, 0x29, 0x48, 0x29, 0xf4, 0x12, 0x5a, 0x7a, 0xb7, 0x85, 0x82, 0x3d, 0x84, 0x49,
-	0x1c, 0xd3, 0xd0, 0x24, 0x23, 0x99, 0xd1, 0x36, 0x6f, 0xe1, 0x63, 0x79, 0x94, 0x1e, 0x4a, 0x4f,
-	0xb6, 0xe8, 0x1b, 0xf8, 0x04, 0x25, 0xc9, 0x8c, 0x56, 0x29, 0xd8, 0x5b, 0xfe, 0xf9, 0x7f, 0xdf,
-	0xc7, 0xf7, 0xfb, 0x33, 0x2a, 0xa4, 0x2c, 0xa6, 0x2c, 0x64, 0x68, 0x40, 0xef, 0x13, 0x1e, 0xc6,
-	0x64, 0x40, 0x38, 0xf1, 0x39, 0x4d, 0xd1, 0xa4, 0xe3, 0x11, 0x8e, 0x3b, 0x28, 0x20, 0x09, 0x61,
-	0x21, 0x83, 0xa3, 0x94, 0x72, 0xaa, 0x99, 0x42, 0x0f, 0xf7, 0xf5, 0x50, 0xe8, 0x9b, 0xf5, 0x80,
-	0x06, 0xb4, 0x10, 0xa3, 0xfc, 0xab, 0xf4, 0x35, 0xff, 0x05, 0x94, 0x06, 0x11, 0x41, 0xc5, 0xe4,
-	0x8d, 0x87, 0x08, 0x27, 0x99, 0x5c, 0xf9, 0x45, 0xa6, 0x5b, 0x7a, 0xca, 0x41, 0xac, 0xf4, 0x7d,
-	0xd7, 0x60, 0x9c, 0x62, 0x1e, 0xd2, 0x44, 0xec, 0x8d, 0xfd, 0x7d, 0xde, 0x88, 0x71, 0x1c, 0x8f,
-	0x84, 0xe0, 0xf8, 0x20, 0x9e, 0x5c, 0xb8, 0xbb, 0xd1, 0xd6, 0x13, 0x50, 0xeb, 0x17, 0x25, 0xfa,
-	0x99, 0x90, 0x9c, 0x27, 0x3c, 0xcd, 0xb4, 0x1b, 0xf5, 0x87, 0x94, 0x36, 0x80, 0x09, 0x5a, 0xbf,
-	0xed, 0xff, 0xf0, 0xd0, 0x51, 0xa0, 0x8c, 0x70, 0xfe, 0xac, 0x17, 0x46, 0x2d, 0xc3, 0x71, 0x74,
-	0x62, 0xc9, 0x14, 0xab, 0xb7, 0x09, 0xd4, 0xb0, 0xfa, 0x2b, 0xc2, 0x8c, 0xbb, 0x32, 0xa8, 0xf1,
-	0xc5, 0x04, 0xad, 0x8a, 0xdd, 0x84, 0x25, 0x28, 0x94, 0xa0, 0xf0, 0x4a, 0x82, 0x3a, 0xe6, 0x6c,
-	0x61, 0x28, 0xeb, 0x85, 0x51, 0x2f, 0x53, 0x77, 0xec, 0xd6, 0xf4, 0xc5, 0x00, 0xbd, 0x6a, 0xfe,
-	0x4f, 0x36, 0xb0, 0x1e, 0x81, 0x5a, 0x15, 0x60, 0x97, 0x1c, 0x73, 0xa2, 0xf5, 0xd5, 0x9f, 0x52,
-	0xcf, 0x1a, 0xc0, 0xfc, 0xda, 0xaa, 0xd8, 0xdd, 0xc3, 0x44, 0x1f, 0xdd, 0xc6, 0xf9, 0x96, 0x77,
-	0xe9, 0x6d, 0xe3, 0xb4, 0xa1, 0x5a, 0x2b, 0x0a, 0x79, 0x11, 0xf5, 0xef, 0xdc, 0x4f, 0x12, 0x59,
-	0x82, 0xe8, 0xef, 0x3b, 0xa2, 0x6d, 0x40, 0xc9, 0x54, 0x9c, 0xc9, 0xc9, 0x7f, 0xe6, 0x3e, 0xe7,
-	0x7a, 0xb6, 0xd4, 0xc1, 0x7c, 0xa9, 0x83, 0xd7, 0xa5, 0x0e, 0xa6, 0x2b, 0x5d, 0x99, 0xaf, 0x74,
-	0xe5, 0x79, 0xa5, 0x2b, 0xfd, 0xd3, 0x20, 0xe4, 0xb7, 0x63, 0x0f, 0xfa, 0x34, 0x46, 0x02, 0xaa,
-	0x1d, 0x61, 0x8f, 0xc9, 0x01, 0x4d, 0xec, 0x2e, 0x7a, 0xd8, 0x3c, 0x83, 0xf6, 0xe6, 0x81, 0xf0,
-	0x6c, 0x44, 0x98, 0xf7, 0xbd, 0xe8, 0x77, 0xf4, 0x16, 0x00, 0x00, 0xff, 0xff, 0x55, 0xe5, 0xff,
-	0xa7, 0x28, 0x03, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x3b, 0xbb, 0x52, 0x74, 0x76, 0x75, 0x61, 0xb6, 0x48, 0xed, 0x61, 0x12, 0x72, 0x2a,
+	0xc2, 0xce, 0xb0, 0x15, 0x16, 0x11, 0xbc, 0x04, 0xff, 0x5c, 0xa5, 0x2e, 0x08, 0xeb, 0xa1, 0x4c,
+	0xda, 0xd9, 0x34, 0x98, 0x64, 0x4a, 0x66, 0xba, 0x5a, 0x3c, 0x7b, 0xdf, 0xb3, 0x9f, 0x68, 0x8f,
+	0x7b, 0x12, 0x4f, 0x51, 0xda, 0x6f, 0xd0, 0x4f, 0x20, 0x99, 0x3f, 0xa9, 0xad, 0x42, 0xf4, 0x96,
+	0x79, 0xdf, 0xe7, 0xfd, 0xf1, 0x3e, 0xcf, 0x1b, 0x48, 0x84, 0xcc, 0x84, 0x4c, 0x24, 0x9d, 0x88,
+	0x8f, 0xb9, 0x4a, 0x32, 0x3e, 0xe1, 0x8a, 0x8f, 0x95, 0x28, 0xe8, 0xd5, 0x69, 0xc4, 0x15, 0x3b,
+	0xa5, 0x31, 0xcf, 0xb9, 0x4c, 0x24, 0x99, 0x15, 0x42, 0x09, 0xe4, 0x5b, 0x3d, 0xd9, 0xd5, 0x13,
+	0xab, 0xef, 0x75, 0x62, 0x11, 0x0b, 0x2d, 0xa6, 0xd5, 0x97, 0x99, 0xeb, 0x3d, 0x8a, 0x85, 0x88,
+	0x53, 0x4e, 0xf5, 0x2b, 0x9a, 0x5f, 0x52, 0x96, 0x2f, 0x5c, 0x6b, 0xac, 0x99, 0x23, 0x33, 0x63,
+	0x1e, 0xb6, 0x85, 0x77, 0xa7, 0x26, 0xf3, 0x82, 0xa9, 0x44, 0xe4, 0xb6, 0xef, 0xed, 0xf6, 0xab,
+	0x8d, 0xa4, 0x62, 0xd9, 0xcc, 0x0a, 0x9e, 0x36, 0xda, 0x73, 0x8d, 0xd1, 0x36, 0x3a, 0xf8, 0x06,
+	0x60, 0xe7, 0xb5, 0xb1, 0xfe, 0xc2, 0x4a, 0x5e, 0xe6, 0xaa, 0x58, 0xa0, 0xf7, 0xf0, 0xae, 0x93,
+	0x76, 0x81, 0x0f, 0xfa, 0x0f, 0x06, 0x8f, 0x49, 0x53, 0x28, 0xc4, 0x21, 0xc2, 0xe3, 0x75, 0xe9,
+	0x1d, 0x2d, 0x58, 0x96, 0x3e, 0x0b, 0x1c, 0x25, 0x18, 0xd6, 0x40, 0xc4, 0xe0, 0xfd, 0x94, 0x49,
+	0x35, 0x72, 0xa0, 0xee, 0x9e, 0x0f, 0xfa, 0x07, 0x83, 0x1e, 0x31, 0x46, 0x89, 0x33, 0x4a, 0xce,
+	0x9d, 0xd1, 0xd0, 0xbf, 0x29, 0xbd, 0xd6, 0xba, 0xf4, 0x3a, 0x86, 0xba, 0x35, 0x1e, 0x5c, 0xff,
+	0xf0, 0xc0, 0xf0, 0xb0, 0xaa, 0xb9, 0x0d, 0x82, 0x2f, 0x00, 0xb6, 0xdf, 0xb0, 0x82, 0x65, 0x12,
+	0x7d, 0x86, 0xc7, 0xb5, 0x7d, 0x35, 0x2d, 0xb8, 0x9c, 0x8a, 0x74, 0x22, 0xbb, 0xc0, 0xdf, 0xff,
+	0x4f, 0x57, 0x78, 0x5d, 0x7a, 0x3d, 0xeb, 0xea, 0x4f, 0x60, 0x30, 0x44, 0xae, 0x7a, 0xbe, 0x29,
+	0x7e, 0xdd, 0x83, 0x87, 0x36, 0xe0, 0xb7, 0x8a, 0x29, 0x8e, 0x2e, 0xe0, 0x3d, 0x27, 0x33, 0x3b,
+	0x1c, 0x0c, 0xce, 0x9a, 0x77, 0xf8, 0xdb, 0x8d, 0xc2, 0x3b, 0x55, 0x26, 0xc3, 0x0d, 0x0e, 0x5d,
+	0xc2, 0x23, 0x1d, 0x4c, 0x94, 0x8a, 0xf1, 0x87, 0xd1, 0x3f, 0x26, 0x1b, 0xd8, 0x64, 0x1f, 0xfe,
+	0x96, 0xec, 0x06, 0x60, 0xb2, 0xd5, 0xe7, 0x0a, 0xab, 0x62, 0x35, 0x87, 0x5e, 0xc1, 0xf6, 0x4c,
+	0x67, 0xdb, 0xdd, 0xd7, 0xf8, 0x7e, 0xb3, 0x01, 0x73, 0x0b, 0xbb, 0xb2, 0x9d, 0x0e, 0xdf, 0xdd,
+	0x2c, 0x31, 0xb8, 0x5d, 0x62, 0xf0, 0x73, 0x89, 0xc1, 0xf5, 0x0a, 0xb7, 0x6e, 0x57, 0xb8, 0xf5,
+	0x7d, 0x85, 0x5b, 0x17, 0xcf, 0xe3, 0x44, 0x4d, 0xe7, 0x11, 0x19, 0x8b, 0x8c, 0x5a, 0xf6, 0x49,
+	0xca, 0x22, 0xe9, 0x1e, 0xf4, 0x6a, 0x70, 0x46, 0x3f, 0xd5, 0xbf, 0xf5, 0x49, 0xfd, 0xc3, 0xab,
+	0xc5, 0x8c, 0xcb, 0xa8, 0xad, 0x7d, 0x3e, 0xf9, 0x35, 0x00, 0x74, 0xae, 0x77, 0x5c, 0xf8, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisDowntimeEntry) Marshal() (dAtA []byte, err error) {
@@ -210,6 +271,47 @@ func (m *GenesisDowntimeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DowntimeThresholds) > 0 {
+		dAtA3 := make([]byte, len(m.DowntimeThresholds)*10)
+		var j2 int
+		for _, num := range m.DowntimeThresholds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -230,12 +332,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBlockTime):])
-	if err2 != nil {
-		return 0, err2
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Downtimes) > 0 {
@@ -280,6 +392,22 @@ func (m *GenesisDowntimeEntry) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DowntimeThresholds) > 0 {
+		l = 0
+		for _, e := range m.DowntimeThresholds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -294,6 +422,8 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBlockTime)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -405,6 +535,125 @@ func (m *GenesisDowntimeEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v Downtime
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Downtime(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DowntimeThresholds = append(m.DowntimeThresholds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.DowntimeThresholds) == 0 {
+					m.DowntimeThresholds = make([]Downtime, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Downtime
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Downtime(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DowntimeThresholds = append(m.DowntimeThresholds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeThresholds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -501,6 +750,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyDowntimeThresholds = []byte("DowntimeThresholds")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+// ParamTable for downtime-detector module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(downtimeThresholds []Downtime) Params {
+	return Params{
+		DowntimeThresholds: downtimeThresholds,
+	}
+}
+
+// default downtime-detector module parameters, tracking every downtime length.
+func DefaultParams() Params {
+	return Params{
+		DowntimeThresholds: DowntimeToDuration.Keys(),
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	return validateDowntimeThresholds(p.DowntimeThresholds)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDowntimeThresholds, &p.DowntimeThresholds, validateDowntimeThresholds),
+	}
+}
+
+// IsTracked returns true if the given downtime length is one of the downtime thresholds.
+func (p Params) IsTracked(downtime Downtime) bool {
+	for _, threshold := range p.DowntimeThresholds {
+		if threshold == downtime {
+			return true
+		}
+	}
+	return false
+}
+
+func validateDowntimeThresholds(i interface{}) error {
+	v, ok := i.([]Downtime)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	thresholds := map[Downtime]bool{}
+	for _, threshold := range v {
+		if _, ok := DowntimeToDuration.Get(threshold); !ok {
+			return fmt.Errorf("unknown downtime threshold %d", threshold)
+		}
+		if thresholds[threshold] {
+			return fmt.Errorf("duplicate downtime threshold %s", threshold)
+		}
+		thresholds[threshold] = true
+	}
+
+	return nil
+}
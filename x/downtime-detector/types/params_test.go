@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v26/x/downtime-detector/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := map[string]struct {
+		params      types.Params
+		expectedErr bool
+	}{
+		"default params": {
+			params: types.DefaultParams(),
+		},
+		"no downtime thresholds": {
+			params: types.NewParams([]types.Downtime{}),
+		},
+		"some downtime thresholds": {
+			params: types.NewParams([]types.Downtime{types.Downtime_DURATION_30M, types.Downtime_DURATION_1H}),
+		},
+		"unknown downtime threshold": {
+			params:      types.NewParams([]types.Downtime{types.Downtime(0x7F)}),
+			expectedErr: true,
+		},
+		"duplicate downtime threshold": {
+			params:      types.NewParams([]types.Downtime{types.Downtime_DURATION_1H, types.Downtime_DURATION_1H}),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
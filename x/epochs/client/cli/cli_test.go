@@ -31,3 +31,14 @@ func TestGetCmdEpochsInfo(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+
+func TestGetCmdEpochCountdowns(t *testing.T) {
+	desc, _ := cli.GetCmdEpochCountdowns()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryEpochCountdownsRequest]{
+		"basic test": {
+			Cmd:           "",
+			ExpectedQuery: &types.QueryEpochCountdownsRequest{},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
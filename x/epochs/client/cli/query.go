@@ -12,6 +12,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEpochInfos)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdCurrentEpoch)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEpochCountdowns)
 
 	return cmd
 }
@@ -34,3 +35,13 @@ func GetCmdCurrentEpoch() (*osmocli.QueryDescriptor, *types.QueryCurrentEpochReq
 {{.CommandPrefix}} day`,
 	}, &types.QueryCurrentEpochRequest{}
 }
+
+func GetCmdEpochCountdowns() (*osmocli.QueryDescriptor, *types.QueryEpochCountdownsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "epoch-countdowns",
+		Short: "Query the time and estimated blocks left until the next epoch of every epoch identifier.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}}`,
+		QueryFnName: "EpochCountdowns",
+	}, &types.QueryEpochCountdownsRequest{}
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+const FlagStartTime = "start-time"
+
+// NewCmdHandleAddEpochInfosProposal implements a command handler for add epoch infos proposal
+func NewCmdHandleAddEpochInfosProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-epoch-infos-proposal [identifier] [duration] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to register a new epoch identifier",
+		Long: strings.TrimSpace(`Submit a proposal to register a new epoch identifier.
+
+The start time is given in unix seconds, and defaults to the time the proposal is executed at.
+Ex) add-epoch-infos-proposal 6hrs 6h --start-time=1667088000 ->
+[epoch 6hrs ticking every 6 hours, starting at 1667088000]
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			epoch := types.NewGenesisEpochInfo(args[0], duration)
+
+			startTimeUnix, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if startTimeUnix != 0 {
+				epoch.StartTime = time.Unix(startTimeUnix, 0).UTC()
+			}
+
+			content := types.NewAddEpochInfosProposal(title, description, []types.EpochInfo{epoch})
+			return submitLegacyProposal(cmd, content)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Int64(FlagStartTime, 0, "Unix time in seconds of the first epoch start, defaults to the proposal execution time")
+
+	return cmd
+}
+
+// NewCmdHandleRemoveEpochInfosProposal implements a command handler for remove epoch infos proposal
+func NewCmdHandleRemoveEpochInfosProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-epoch-infos-proposal [identifiers] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove registered epoch identifiers",
+		Long: strings.TrimSpace(`Submit a proposal to remove registered epoch identifiers.
+
+Ex) remove-epoch-infos-proposal 6hrs,12hrs ->
+[epochs 6hrs and 12hrs removed]
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveEpochInfosProposal(title, description, strings.Split(args[0], ","))
+			return submitLegacyProposal(cmd, content)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func submitLegacyProposal(cmd *cobra.Command, content govtypesv1beta1.Content) error {
+	clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+	if err != nil {
+		return err
+	}
+
+	contentMsg, err := v1.NewLegacyContent(content, authority.String())
+	if err != nil {
+		return err
+	}
+
+	msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+	proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+}
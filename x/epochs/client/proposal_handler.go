@@ -0,0 +1,12 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/x/epochs/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	AddEpochInfosProposalHandler    = govclient.NewProposalHandler(cli.NewCmdHandleAddEpochInfosProposal)
+	RemoveEpochInfosProposalHandler = govclient.NewProposalHandler(cli.NewCmdHandleRemoveEpochInfosProposal)
+)
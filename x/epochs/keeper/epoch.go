@@ -65,6 +65,16 @@ func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
 	store.Delete(append(types.KeyPrefixEpoch, []byte(identifier)...))
 }
 
+// RemoveEpochInfo removes the epoch info of an existing identifier, after which no hooks
+// are called for it anymore. Will return an error if no epoch with the identifier exists.
+func (k Keeper) RemoveEpochInfo(ctx sdk.Context, identifier string) error {
+	if (k.GetEpochInfo(ctx, identifier) == types.EpochInfo{}) {
+		return fmt.Errorf("epoch with identifier %s not found", identifier)
+	}
+	k.DeleteEpochInfo(ctx, identifier)
+	return nil
+}
+
 // IterateEpochInfo iterate through epochs.
 func (k Keeper) IterateEpochInfo(ctx sdk.Context, fn func(index int64, epochInfo types.EpochInfo) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -110,3 +120,47 @@ func (k Keeper) NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (in
 	}
 	return ctx.BlockHeight() - epoch.CurrentEpochStartHeight, nil
 }
+
+// AllEpochCountdowns returns the countdown to the next epoch of every epoch identifier.
+func (k Keeper) AllEpochCountdowns(ctx sdk.Context) []types.EpochCountdown {
+	countdowns := []types.EpochCountdown{}
+	k.IterateEpochInfo(ctx, func(index int64, epochInfo types.EpochInfo) (stop bool) {
+		countdowns = append(countdowns, epochCountdown(ctx, epochInfo))
+		return false
+	})
+	return countdowns
+}
+
+// epochCountdown returns the time and the estimated number of blocks left at the current block
+// until the next epoch of the given epoch starts.
+// The number of blocks is estimated from the average block time since the current epoch started,
+// so it is 0 before epoch counting has started or while no block time has been observed.
+func epochCountdown(ctx sdk.Context, epoch types.EpochInfo) types.EpochCountdown {
+	nextEpochStartTime := epoch.StartTime
+	if epoch.EpochCountingStarted {
+		nextEpochStartTime = epoch.CurrentEpochStartTime.Add(epoch.Duration)
+	}
+	timeRemaining := nextEpochStartTime.Sub(ctx.BlockTime())
+	if timeRemaining < 0 {
+		timeRemaining = 0
+	}
+
+	estimatedBlocksRemaining := int64(0)
+	elapsedBlocks := ctx.BlockHeight() - epoch.CurrentEpochStartHeight
+	elapsedTime := ctx.BlockTime().Sub(epoch.CurrentEpochStartTime)
+	if epoch.EpochCountingStarted && elapsedBlocks > 0 && elapsedTime > 0 {
+		averageBlockTime := elapsedTime / time.Duration(elapsedBlocks)
+		if averageBlockTime > 0 {
+			estimatedBlocksRemaining = int64((timeRemaining + averageBlockTime - 1) / averageBlockTime)
+		}
+	}
+
+	return types.EpochCountdown{
+		Identifier:               epoch.Identifier,
+		CurrentEpoch:             epoch.CurrentEpoch,
+		CurrentEpochStartTime:    epoch.CurrentEpochStartTime,
+		NextEpochStartTime:       nextEpochStartTime,
+		TimeRemaining:            timeRemaining,
+		EstimatedBlocksRemaining: estimatedBlocksRemaining,
+	}
+}
@@ -75,6 +75,22 @@ func (s *KeeperTestSuite) TestDuplicateAddEpochInfo() {
 	s.Require().Error(err)
 }
 
+func (s *KeeperTestSuite) TestRemoveEpochInfo() {
+	identifier := "remove_epoch_info"
+	err := s.EpochsKeeper.RemoveEpochInfo(s.Ctx, identifier)
+	s.Require().Error(err)
+
+	err = s.EpochsKeeper.AddEpochInfo(s.Ctx, types.NewGenesisEpochInfo(identifier, time.Hour*6))
+	s.Require().NoError(err)
+	err = s.EpochsKeeper.RemoveEpochInfo(s.Ctx, identifier)
+	s.Require().NoError(err)
+	s.Require().Equal(types.EpochInfo{}, s.EpochsKeeper.GetEpochInfo(s.Ctx, identifier))
+
+	// the identifier can be registered again after removal
+	err = s.EpochsKeeper.AddEpochInfo(s.Ctx, types.NewGenesisEpochInfo(identifier, time.Hour*6))
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestEpochLifeCycle() {
 	s.SetupTest()
 
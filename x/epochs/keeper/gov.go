@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// HandleAddEpochInfosProposal registers the epochs of the proposal.
+// Returns error if any of the identifiers is already registered.
+func (k Keeper) HandleAddEpochInfosProposal(ctx sdk.Context, p *types.AddEpochInfosProposal) error {
+	for _, epoch := range p.Epochs {
+		if err := k.AddEpochInfo(ctx, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleRemoveEpochInfosProposal removes the epochs of the proposal's identifiers.
+// Returns error if any of the identifiers is not registered.
+func (k Keeper) HandleRemoveEpochInfosProposal(ctx sdk.Context, p *types.RemoveEpochInfosProposal) error {
+	for _, identifier := range p.Identifiers {
+		if err := k.RemoveEpochInfo(ctx, identifier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewEpochsProposalHandler(k Keeper) govtypesv1.Handler {
+	return func(ctx sdk.Context, content govtypesv1.Content) error {
+		switch c := content.(type) {
+		case *types.AddEpochInfosProposal:
+			return k.HandleAddEpochInfosProposal(ctx, c)
+		case *types.RemoveEpochInfosProposal:
+			return k.HandleRemoveEpochInfosProposal(ctx, c)
+
+		default:
+			return fmt.Errorf("unrecognized epochs proposal content type: %T", c)
+		}
+	}
+}
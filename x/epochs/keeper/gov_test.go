@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/keeper"
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestEpochsProposalHandler() {
+	handler := keeper.NewEpochsProposalHandler(*s.EpochsKeeper)
+
+	addProposal := types.NewAddEpochInfosProposal("title", "description", []types.EpochInfo{
+		types.NewGenesisEpochInfo("6hrs", time.Hour*6),
+	})
+	s.Require().NoError(addProposal.ValidateBasic())
+	s.Require().NoError(handler(s.Ctx, addProposal))
+
+	epoch := s.EpochsKeeper.GetEpochInfo(s.Ctx, "6hrs")
+	s.Require().Equal(time.Hour*6, epoch.Duration)
+	s.Require().Equal(s.Ctx.BlockTime(), epoch.StartTime)
+
+	// re-adding an existing identifier fails
+	s.Require().Error(handler(s.Ctx, addProposal))
+
+	removeProposal := types.NewRemoveEpochInfosProposal("title", "description", []string{"6hrs"})
+	s.Require().NoError(removeProposal.ValidateBasic())
+	s.Require().NoError(handler(s.Ctx, removeProposal))
+	s.Require().Equal(types.EpochInfo{}, s.EpochsKeeper.GetEpochInfo(s.Ctx, "6hrs"))
+
+	// removing an unknown identifier fails
+	s.Require().Error(handler(s.Ctx, removeProposal))
+}
@@ -53,3 +53,12 @@ func (q Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochReq
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// EpochCountdowns provides the countdown to the next epoch of every epoch identifier.
+func (q Querier) EpochCountdowns(c context.Context, _ *types.QueryEpochCountdownsRequest) (*types.QueryEpochCountdownsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEpochCountdownsResponse{
+		Countdowns: q.Keeper.AllEpochCountdowns(ctx),
+	}, nil
+}
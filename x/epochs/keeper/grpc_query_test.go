@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/x/epochs/types"
 )
@@ -22,3 +23,58 @@ func (s *KeeperTestSuite) TestQueryEpochInfos() {
 
 	s.Require().Equal(expectedEpochs, epochInfosResponse.Epochs)
 }
+
+func (s *KeeperTestSuite) TestQueryEpochCountdowns() {
+	s.SetupTest()
+
+	// Querying epoch countdowns on default genesis returns a countdown per epoch identifier.
+	countdownsResponse, err := s.queryClient.EpochCountdowns(gocontext.Background(), &types.QueryEpochCountdownsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(countdownsResponse.Countdowns, 3)
+
+	for _, epoch := range s.EpochsKeeper.AllEpochInfos(s.Ctx) {
+		s.EpochsKeeper.DeleteEpochInfo(s.Ctx, epoch.Identifier)
+	}
+	block1Time := time.Unix(1656907200, 0).UTC()
+	ctx := s.Ctx.WithBlockHeight(1).WithBlockTime(block1Time)
+	err = s.EpochsKeeper.AddEpochInfo(ctx, types.EpochInfo{
+		Identifier:            "hour",
+		StartTime:             block1Time,
+		Duration:              time.Hour,
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: block1Time,
+		EpochCountingStarted:  true,
+	})
+	s.Require().NoError(err)
+	err = s.EpochsKeeper.AddEpochInfo(ctx, types.EpochInfo{
+		Identifier: "week",
+		StartTime:  block1Time.Add(24 * time.Hour),
+		Duration:   7 * 24 * time.Hour,
+	})
+	s.Require().NoError(err)
+
+	// Ten blocks of one minute later, fifty blocks are expected until the next hourly epoch,
+	// while the weekly epoch has not started yet.
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(block1Time.Add(10 * time.Minute))
+	s.Require().Equal([]types.EpochCountdown{
+		{
+			Identifier:               "hour",
+			CurrentEpoch:             1,
+			CurrentEpochStartTime:    block1Time,
+			NextEpochStartTime:       block1Time.Add(time.Hour),
+			TimeRemaining:            50 * time.Minute,
+			EstimatedBlocksRemaining: 50,
+		},
+		{
+			Identifier:         "week",
+			NextEpochStartTime: block1Time.Add(24 * time.Hour),
+			TimeRemaining:      24*time.Hour - 10*time.Minute,
+		},
+	}, s.EpochsKeeper.AllEpochCountdowns(ctx))
+
+	// Past the expected end time, no time nor blocks remain until the next block starts the next epoch.
+	ctx = ctx.WithBlockHeight(70).WithBlockTime(block1Time.Add(time.Hour))
+	countdowns := s.EpochsKeeper.AllEpochCountdowns(ctx)
+	s.Require().Equal(time.Duration(0), countdowns[0].TimeRemaining)
+	s.Require().Equal(int64(0), countdowns[0].EstimatedBlocksRemaining)
+}
@@ -1,17 +1,38 @@
 package keeper
 
 import (
+	"slices"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // AfterEpochEnd gets called at the end of the epoch, end of epoch is the timestamp of first block produced after epoch duration.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
 	// Error is not handled as AfterEpochEnd Hooks use osmoutils.ApplyFuncIfNoError()
-	_ = k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	_ = k.enabledHooks(ctx).AfterEpochEnd(ctx, identifier, epochNumber)
 }
 
 // BeforeEpochStart new epoch is next block of epoch end block
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
 	// Error is not handled as BeforeEpochStart Hooks use osmoutils.ApplyFuncIfNoError()
-	_ = k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	_ = k.enabledHooks(ctx).BeforeEpochStart(ctx, identifier, epochNumber)
+}
+
+// enabledHooks returns the epoch hooks without the hooks of the modules disabled by the
+// DisabledHookModules param.
+func (k Keeper) enabledHooks(ctx sdk.Context) types.EpochHooks {
+	disabledHookModules := k.GetParams(ctx).DisabledHookModules
+	if len(disabledHookModules) == 0 {
+		return k.hooks
+	}
+
+	if multiHooks, ok := k.hooks.(types.MultiEpochHooks); ok {
+		return multiHooks.WithoutModules(disabledHookModules)
+	}
+	if slices.Contains(disabledHookModules, k.hooks.GetModuleName()) {
+		return types.NewMultiEpochHooks()
+	}
+	return k.hooks
 }
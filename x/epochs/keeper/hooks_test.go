@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// countingEpochHooks counts the epoch hook calls of a module, optionally panicking on every call.
+type countingEpochHooks struct {
+	moduleName  string
+	shouldPanic bool
+	calls       int
+}
+
+var _ types.EpochHooks = &countingEpochHooks{}
+
+func (h *countingEpochHooks) GetModuleName() string { return h.moduleName }
+
+func (h *countingEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return h.call()
+}
+
+func (h *countingEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return h.call()
+}
+
+func (h *countingEpochHooks) call() error {
+	if h.shouldPanic {
+		panic("countingEpochHooks is panicking")
+	}
+	h.calls++
+	return nil
+}
+
+func (s *KeeperTestSuite) TestDisabledHookModules() {
+	tests := map[string]struct {
+		disabledHookModules  []string
+		expectedHealthyCalls int
+		expectedFaultyCalls  int
+	}{
+		"no disabled modules": {
+			expectedHealthyCalls: 2,
+		},
+		"panicking module disabled": {
+			disabledHookModules:  []string{"faulty"},
+			expectedHealthyCalls: 2,
+		},
+		"every module disabled": {
+			disabledHookModules: []string{"faulty", "healthy"},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			healthyHooks := &countingEpochHooks{moduleName: "healthy"}
+			faultyHooks := &countingEpochHooks{moduleName: "faulty", shouldPanic: true}
+			ctx, epochsKeeper := SetupWithHooks(types.NewMultiEpochHooks(faultyHooks, healthyHooks))
+			epochsKeeper.SetParams(ctx, types.NewParams(tc.disabledHookModules))
+
+			s.Require().NotPanics(func() {
+				epochsKeeper.BeforeEpochStart(ctx, "day", 1)
+				epochsKeeper.AfterEpochEnd(ctx, "day", 1)
+			})
+			s.Require().Equal(tc.expectedHealthyCalls, healthyHooks.calls)
+			s.Require().Equal(tc.expectedFaultyCalls, faultyHooks.calls)
+		})
+	}
+}
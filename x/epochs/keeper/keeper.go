@@ -8,21 +8,29 @@ import (
 	"github.com/osmosis-labs/osmosis/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	storetypes "cosmossdk.io/store/types"
 )
 
 type (
 	Keeper struct {
-		storeKey storetypes.StoreKey
-		hooks    types.EpochHooks
+		storeKey   storetypes.StoreKey
+		paramSpace paramtypes.Subspace
+		hooks      types.EpochHooks
 	}
 )
 
 // NewKeeper returns a new keeper by codec and storeKey inputs.
-func NewKeeper(storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
-		storeKey: storeKey,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
 	}
 }
 
@@ -40,3 +48,16 @@ func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// GetParams returns the total set of epochs parameters.
+// The default parameters are returned if they have not been set yet, e.g. before the upgrade adding them.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of epochs parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	cdcutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	epochskeeper "github.com/osmosis-labs/osmosis/x/epochs/keeper"
 	"github.com/osmosis-labs/osmosis/x/epochs/types"
@@ -47,10 +49,18 @@ func TestKeeperTestSuite(t *testing.T) {
 }
 
 func Setup() (sdk.Context, *epochskeeper.Keeper) {
-	epochsStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
-	ctx := testutil.DefaultContext(epochsStoreKey, storetypes.NewTransientStoreKey("transient_test"))
-	epochsKeeper := epochskeeper.NewKeeper(epochsStoreKey)
-	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
+	return SetupWithHooks(types.NewMultiEpochHooks())
+}
+
+// SetupWithHooks sets up an epochs keeper calling the given hooks.
+func SetupWithHooks(hooks types.EpochHooks) (sdk.Context, *epochskeeper.Keeper) {
+	keys := storetypes.NewKVStoreKeys(types.StoreKey, paramstypes.StoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, tkeys, nil)
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	paramSpace := paramstypes.NewSubspace(encodingConfig.Codec, encodingConfig.Amino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey], types.ModuleName)
+	epochsKeeper := epochskeeper.NewKeeper(keys[types.StoreKey], paramSpace)
+	epochsKeeper = epochsKeeper.SetHooks(hooks)
 	ctx.WithBlockHeight(1).WithChainID("osmosis-1").WithBlockTime(time.Now().UTC())
 	epochsKeeper.InitGenesis(ctx, *types.DefaultGenesis())
 	SetEpochStartTime(ctx, epochsKeeper)
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/epochs interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddEpochInfosProposal{}, "osmosis/epochs/add-epoch-infos-proposal", nil)
+	cdc.RegisterConcrete(&RemoveEpochInfosProposal{}, "osmosis/epochs/remove-epoch-infos-proposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypesv1.Content)(nil),
+		&AddEpochInfosProposal{},
+		&RemoveEpochInfosProposal{},
+	)
+}
@@ -155,16 +155,65 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+// Params holds parameters for the epochs module.
+type Params struct {
+	// disabled_hook_modules are the names of the modules whose epoch hooks are
+	// skipped. It acts as a circuit breaker, letting governance stop a
+	// repeatedly failing epoch hook of a module until it is fixed.
+	DisabledHookModules []string `protobuf:"bytes,1,rep,name=disabled_hook_modules,json=disabledHookModules,proto3" json:"disabled_hook_modules,omitempty" yaml:"disabled_hook_modules"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd2db84ad8300ca, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDisabledHookModules() []string {
+	if m != nil {
+		return m.DisabledHookModules
+	}
+	return nil
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Params Params      `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd2db84ad8300ca, []int{1}
+	return fileDescriptor_7dd2db84ad8300ca, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,8 +249,16 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "osmosis.epochs.v1beta1.EpochInfo")
+	proto.RegisterType((*Params)(nil), "osmosis.epochs.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.epochs.v1beta1.GenesisState")
 }
 
@@ -210,37 +267,41 @@ func init() {
 }
 
 var fileDescriptor_7dd2db84ad8300ca = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x6b, 0x5a, 0x4a, 0xea, 0x3b, 0x04, 0x44, 0xc7, 0x11, 0x2a, 0x91, 0x84, 0xc0, 0x10,
-	0x09, 0x70, 0xd4, 0x83, 0x09, 0x06, 0xa4, 0x02, 0xe2, 0x60, 0x41, 0x4a, 0x19, 0x10, 0x4b, 0x95,
-	0xb4, 0x6e, 0x62, 0xa9, 0x89, 0xa3, 0xf8, 0x05, 0xd1, 0x8d, 0x8f, 0xd0, 0x91, 0x8f, 0x74, 0xe3,
-	0x8d, 0x4c, 0x05, 0xb5, 0x1b, 0xe3, 0x7d, 0x02, 0x14, 0xdb, 0x29, 0x85, 0x3b, 0xc4, 0x16, 0xfb,
-	0xff, 0x7b, 0xff, 0xbf, 0xdf, 0xd3, 0x0b, 0xbe, 0xcf, 0x45, 0xc6, 0x05, 0x13, 0x01, 0x2d, 0xf8,
-	0x24, 0x15, 0xc1, 0xa7, 0x41, 0x4c, 0x21, 0x1a, 0x04, 0x09, 0xcd, 0xa9, 0x60, 0x82, 0x14, 0x25,
-	0x07, 0x6e, 0x1e, 0x6a, 0x8a, 0x28, 0x8a, 0x68, 0xaa, 0x7f, 0x90, 0xf0, 0x84, 0x4b, 0x24, 0xa8,
-	0xbf, 0x14, 0xdd, 0xb7, 0x13, 0xce, 0x93, 0x39, 0x0d, 0xe4, 0x29, 0xae, 0x66, 0xc1, 0xb4, 0x2a,
-	0x23, 0x60, 0x3c, 0xd7, 0xba, 0xf3, 0xb7, 0x0e, 0x2c, 0xa3, 0x02, 0xa2, 0xac, 0x50, 0x80, 0xb7,
-	0xec, 0xe0, 0xde, 0xab, 0x3a, 0xe9, 0x4d, 0x3e, 0xe3, 0xa6, 0x8d, 0x31, 0x9b, 0xd2, 0x1c, 0xd8,
-	0x8c, 0xd1, 0xd2, 0x42, 0x2e, 0xf2, 0x7b, 0xe1, 0xce, 0x8d, 0xf9, 0x01, 0x63, 0x01, 0x51, 0x09,
-	0xe3, 0xda, 0xc6, 0xba, 0xe4, 0x22, 0x7f, 0xef, 0xa8, 0x4f, 0x54, 0x06, 0x69, 0x32, 0xc8, 0xfb,
-	0x26, 0x63, 0x78, 0xe7, 0x64, 0xe5, 0xb4, 0xce, 0x56, 0xce, 0x8d, 0x45, 0x94, 0xcd, 0x9f, 0x7a,
-	0xbf, 0x6b, 0xbd, 0xe5, 0x77, 0x07, 0x85, 0x3d, 0x79, 0x51, 0xe3, 0x66, 0x8a, 0x8d, 0xe6, 0xe9,
-	0x56, 0x5b, 0xfa, 0xde, 0x3e, 0xe7, 0xfb, 0x52, 0x03, 0xc3, 0x41, 0x6d, 0xfb, 0x73, 0xe5, 0x98,
-	0x4d, 0xc9, 0x43, 0x9e, 0x31, 0xa0, 0x59, 0x01, 0x8b, 0xb3, 0x95, 0x73, 0x4d, 0x85, 0x35, 0x9a,
-	0xf7, 0xb5, 0x8e, 0xda, 0xba, 0x9b, 0xf7, 0xf0, 0xd5, 0x49, 0x55, 0x96, 0x34, 0x87, 0xb1, 0x1c,
-	0xb1, 0xd5, 0x71, 0x91, 0xdf, 0x0e, 0xf7, 0xf5, 0xa5, 0x1c, 0x86, 0xf9, 0x05, 0x61, 0xeb, 0x0f,
-	0x6a, 0xbc, 0xd3, 0xf7, 0xe5, 0xff, 0xf6, 0xfd, 0x40, 0xf7, 0xed, 0xa8, 0xa7, 0xfc, 0xcb, 0x49,
-	0x4d, 0xe1, 0xe6, 0x6e, 0xf2, 0x68, 0x3b, 0x91, 0x27, 0xf8, 0x50, 0xf1, 0x13, 0x5e, 0xe5, 0xc0,
-	0xf2, 0x44, 0x15, 0xd2, 0xa9, 0xd5, 0x75, 0x91, 0x6f, 0x84, 0x07, 0x52, 0x7d, 0xa1, 0xc5, 0x91,
-	0xd2, 0xcc, 0x67, 0xb8, 0x7f, 0x51, 0x5a, 0x4a, 0x59, 0x92, 0x82, 0x65, 0xc8, 0x56, 0x6f, 0x9d,
-	0x0b, 0x3c, 0x96, 0xf2, 0xdb, 0x8e, 0x71, 0xe5, 0xba, 0xe1, 0xbd, 0xc3, 0xfb, 0xaf, 0xd5, 0x4a,
-	0x8e, 0x20, 0x02, 0x6a, 0x3e, 0xc7, 0x5d, 0xb5, 0x8b, 0x16, 0x72, 0xdb, 0xfe, 0xde, 0xd1, 0x5d,
-	0x72, 0xf1, 0x8a, 0x92, 0xed, 0x1e, 0x0d, 0x3b, 0x75, 0xff, 0xa1, 0x2e, 0x1b, 0x1e, 0x9f, 0xac,
-	0x6d, 0x74, 0xba, 0xb6, 0xd1, 0x8f, 0xb5, 0x8d, 0x96, 0x1b, 0xbb, 0x75, 0xba, 0xb1, 0x5b, 0xdf,
-	0x36, 0x76, 0xeb, 0x23, 0x49, 0x18, 0xa4, 0x55, 0x4c, 0x26, 0x3c, 0x0b, 0xb4, 0xe9, 0xa3, 0x79,
-	0x14, 0x8b, 0xe6, 0x10, 0x7c, 0x6e, 0x7e, 0x16, 0x58, 0x14, 0x54, 0xc4, 0x5d, 0x39, 0xeb, 0xc7,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x53, 0xe7, 0xa2, 0xc2, 0x4b, 0x03, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x8f, 0xd3, 0x4c,
+	0x10, 0xcd, 0x7e, 0xc9, 0x17, 0x92, 0xbd, 0x43, 0xc0, 0x72, 0x77, 0x98, 0x08, 0x6c, 0x63, 0x28,
+	0x22, 0x01, 0xb6, 0x72, 0x50, 0x01, 0x12, 0x52, 0x00, 0x11, 0x90, 0x90, 0x90, 0x73, 0x05, 0xa2,
+	0x20, 0xb2, 0xe3, 0x8d, 0xbd, 0xba, 0xd8, 0x6b, 0x79, 0xd7, 0x88, 0x74, 0xfc, 0x01, 0xa4, 0x94,
+	0xfc, 0xa4, 0x2b, 0xaf, 0xa4, 0x32, 0x28, 0xe9, 0x28, 0xf3, 0x0b, 0x90, 0x77, 0xd7, 0x21, 0x70,
+	0x39, 0xd1, 0xd9, 0xf3, 0xde, 0xbc, 0x37, 0xf3, 0x34, 0x0b, 0xef, 0x50, 0x16, 0x53, 0x46, 0x98,
+	0x83, 0x53, 0x3a, 0x8e, 0x98, 0xf3, 0xb1, 0xe7, 0x63, 0xee, 0xf5, 0x9c, 0x10, 0x27, 0x98, 0x11,
+	0x66, 0xa7, 0x19, 0xe5, 0x14, 0x1d, 0x28, 0x96, 0x2d, 0x59, 0xb6, 0x62, 0x75, 0xf6, 0x42, 0x1a,
+	0x52, 0x41, 0x71, 0xca, 0x2f, 0xc9, 0xee, 0xe8, 0x21, 0xa5, 0xe1, 0x14, 0x3b, 0xe2, 0xcf, 0xcf,
+	0x27, 0x4e, 0x90, 0x67, 0x1e, 0x27, 0x34, 0x51, 0xb8, 0xf1, 0x37, 0xce, 0x49, 0x8c, 0x19, 0xf7,
+	0xe2, 0x54, 0x12, 0xac, 0x79, 0x03, 0xb6, 0x5f, 0x94, 0x4e, 0xaf, 0x92, 0x09, 0x45, 0x3a, 0x84,
+	0x24, 0xc0, 0x09, 0x27, 0x13, 0x82, 0x33, 0x0d, 0x98, 0xa0, 0xdb, 0x76, 0x37, 0x2a, 0xe8, 0x1d,
+	0x84, 0x8c, 0x7b, 0x19, 0x1f, 0x95, 0x32, 0xda, 0x7f, 0x26, 0xe8, 0xee, 0x1c, 0x76, 0x6c, 0xe9,
+	0x61, 0x57, 0x1e, 0xf6, 0x51, 0xe5, 0xd1, 0xbf, 0x79, 0x52, 0x18, 0xb5, 0x55, 0x61, 0x5c, 0x99,
+	0x79, 0xf1, 0xf4, 0x91, 0xf5, 0xbb, 0xd7, 0x9a, 0x7f, 0x37, 0x80, 0xdb, 0x16, 0x85, 0x92, 0x8e,
+	0x22, 0xd8, 0xaa, 0x46, 0xd7, 0xea, 0x42, 0xf7, 0xfa, 0x19, 0xdd, 0xe7, 0x8a, 0xd0, 0xef, 0x95,
+	0xb2, 0x3f, 0x0b, 0x03, 0x55, 0x2d, 0xf7, 0x68, 0x4c, 0x38, 0x8e, 0x53, 0x3e, 0x5b, 0x15, 0xc6,
+	0x25, 0x69, 0x56, 0x61, 0xd6, 0xd7, 0xd2, 0x6a, 0xad, 0x8e, 0x6e, 0xc3, 0x8b, 0xe3, 0x3c, 0xcb,
+	0x70, 0xc2, 0x47, 0x22, 0x62, 0xad, 0x61, 0x82, 0x6e, 0xdd, 0xdd, 0x55, 0x45, 0x11, 0x06, 0xfa,
+	0x0c, 0xa0, 0xf6, 0x07, 0x6b, 0xb4, 0xb1, 0xf7, 0xff, 0xff, 0xdc, 0xfb, 0xae, 0xda, 0xdb, 0x90,
+	0xa3, 0x9c, 0xa7, 0x24, 0x53, 0xd8, 0xdf, 0x74, 0x1e, 0xae, 0x13, 0x79, 0x08, 0x0f, 0x24, 0x7f,
+	0x4c, 0xf3, 0x84, 0x93, 0x24, 0x94, 0x8d, 0x38, 0xd0, 0x9a, 0x26, 0xe8, 0xb6, 0xdc, 0x3d, 0x81,
+	0x3e, 0x53, 0xe0, 0x50, 0x62, 0xe8, 0x31, 0xec, 0x6c, 0x73, 0x8b, 0x30, 0x09, 0x23, 0xae, 0xb5,
+	0xc4, 0xaa, 0xd7, 0xce, 0x18, 0x0e, 0x04, 0xfc, 0xba, 0xd1, 0xba, 0x70, 0xb9, 0x65, 0x7d, 0x80,
+	0xcd, 0xb7, 0x5e, 0xe6, 0xc5, 0x0c, 0x1d, 0xc1, 0xfd, 0x80, 0x30, 0xcf, 0x9f, 0xe2, 0x60, 0x14,
+	0x51, 0x7a, 0x3c, 0x8a, 0x69, 0x90, 0x4f, 0x31, 0xd3, 0x80, 0x59, 0xef, 0xb6, 0xfb, 0xe6, 0xaa,
+	0x30, 0x6e, 0xa8, 0xb0, 0xb7, 0xd1, 0x2c, 0xf7, 0x6a, 0x55, 0x1f, 0x50, 0x7a, 0xfc, 0x46, 0x55,
+	0xbf, 0x00, 0xb8, 0xfb, 0x52, 0xde, 0xfc, 0x90, 0x7b, 0x1c, 0xa3, 0xa7, 0xb0, 0x29, 0x8f, 0x5d,
+	0xe8, 0xee, 0x1c, 0xde, 0xb2, 0xb7, 0xbf, 0x01, 0x7b, 0x7d, 0xa8, 0xfd, 0x46, 0x19, 0xb0, 0xab,
+	0xda, 0xd0, 0x13, 0xd8, 0x4c, 0xc5, 0xc4, 0xea, 0x24, 0xf5, 0xf3, 0x04, 0xe4, 0x5e, 0x55, 0xb7,
+	0xec, 0xe9, 0x0f, 0x4e, 0x16, 0x3a, 0x38, 0x5d, 0xe8, 0xe0, 0xc7, 0x42, 0x07, 0xf3, 0xa5, 0x5e,
+	0x3b, 0x5d, 0xea, 0xb5, 0x6f, 0x4b, 0xbd, 0xf6, 0xde, 0x0e, 0x09, 0x8f, 0x72, 0xdf, 0x1e, 0xd3,
+	0xd8, 0x51, 0x8a, 0xf7, 0xa7, 0x9e, 0xcf, 0xaa, 0x1f, 0xe7, 0x53, 0xf5, 0x96, 0xf9, 0x2c, 0xc5,
+	0xcc, 0x6f, 0x8a, 0x53, 0x78, 0xf0, 0x6b, 0x00, 0xc2, 0xcc, 0x14, 0x4a, 0xea, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -317,6 +378,38 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledHookModules) > 0 {
+		for iNdEx := len(m.DisabledHookModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledHookModules[iNdEx])
+			copy(dAtA[i:], m.DisabledHookModules[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DisabledHookModules[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -337,6 +430,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -393,6 +496,21 @@ func (m *EpochInfo) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisabledHookModules) > 0 {
+		for _, s := range m.DisabledHookModules {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -405,6 +523,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -653,6 +773,88 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledHookModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledHookModules = append(m.DisabledHookModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -716,6 +918,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
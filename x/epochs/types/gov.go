@@ -0,0 +1,138 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeAddEpochInfos    = "AddEpochInfos"
+	ProposalTypeRemoveEpochInfos = "RemoveEpochInfos"
+)
+
+func init() {
+	govtypesv1.RegisterProposalType(ProposalTypeAddEpochInfos)
+	govtypesv1.RegisterProposalType(ProposalTypeRemoveEpochInfos)
+}
+
+var (
+	_ govtypesv1.Content = &AddEpochInfosProposal{}
+	_ govtypesv1.Content = &RemoveEpochInfosProposal{}
+)
+
+// NewAddEpochInfosProposal returns a new instance of an add epoch infos proposal struct.
+func NewAddEpochInfosProposal(title, description string, epochs []EpochInfo) govtypesv1.Content {
+	return &AddEpochInfosProposal{
+		Title:       title,
+		Description: description,
+		Epochs:      epochs,
+	}
+}
+
+func (p *AddEpochInfosProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *AddEpochInfosProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *AddEpochInfosProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *AddEpochInfosProposal) ProposalType() string {
+	return ProposalTypeAddEpochInfos
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+// Epochs added by proposal must not have started counting yet.
+func (p *AddEpochInfosProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Epochs) == 0 {
+		return errors.New("proposal must add at least one epoch")
+	}
+
+	if err := NewGenesisState(p.Epochs).Validate(); err != nil {
+		return err
+	}
+	for _, epoch := range p.Epochs {
+		if epoch.EpochCountingStarted || epoch.CurrentEpoch != 0 {
+			return fmt.Errorf("epoch %s must not have started counting", epoch.Identifier)
+		}
+	}
+	return nil
+}
+
+// String returns a string containing the add epoch infos proposal.
+func (p AddEpochInfosProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Epoch Infos Proposal:
+Title:       %s
+Description: %s
+Epochs:
+`, p.Title, p.Description))
+	for _, epoch := range p.Epochs {
+		b.WriteString(fmt.Sprintf("  Identifier: %s, Start Time: %s, Duration: %s\n", epoch.Identifier, epoch.StartTime, epoch.Duration))
+	}
+	return b.String()
+}
+
+// NewRemoveEpochInfosProposal returns a new instance of a remove epoch infos proposal struct.
+func NewRemoveEpochInfosProposal(title, description string, identifiers []string) govtypesv1.Content {
+	return &RemoveEpochInfosProposal{
+		Title:       title,
+		Description: description,
+		Identifiers: identifiers,
+	}
+}
+
+func (p *RemoveEpochInfosProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *RemoveEpochInfosProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *RemoveEpochInfosProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *RemoveEpochInfosProposal) ProposalType() string {
+	return ProposalTypeRemoveEpochInfos
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *RemoveEpochInfosProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Identifiers) == 0 {
+		return errors.New("proposal must remove at least one epoch")
+	}
+
+	identifiers := map[string]bool{}
+	for _, identifier := range p.Identifiers {
+		if identifier == "" {
+			return errors.New("epoch identifier should NOT be empty")
+		}
+		if identifiers[identifier] {
+			return fmt.Errorf("duplicate epoch identifier %s", identifier)
+		}
+		identifiers[identifier] = true
+	}
+	return nil
+}
+
+// String returns a string containing the remove epoch infos proposal.
+func (p RemoveEpochInfosProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Epoch Infos Proposal:
+Title:       %s
+Description: %s
+Identifiers: %s
+`, p.Title, p.Description, strings.Join(p.Identifiers, ", ")))
+	return b.String()
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	fmt "fmt"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,10 +41,21 @@ func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
 	return hooks
 }
 
+// WithoutModules returns the hooks without the hooks of the given modules.
+func (h MultiEpochHooks) WithoutModules(moduleNames []string) MultiEpochHooks {
+	hooks := make(MultiEpochHooks, 0, len(h))
+	for _, hook := range h {
+		if !slices.Contains(moduleNames, hook.GetModuleName()) {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
+
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the number of epoch that is ending.
 func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	for _, hook := range h {
-		panicCatchingEpochHook(ctx, hook.AfterEpochEnd, epochIdentifier, epochNumber, h.GetModuleName(), !isBeforeEpoch)
+		panicCatchingEpochHook(ctx, hook.AfterEpochEnd, epochIdentifier, epochNumber, hook.GetModuleName(), !isBeforeEpoch)
 	}
 	return nil
 }
@@ -55,6 +68,45 @@ func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier strin
 	return nil
 }
 
+var _ EpochHooks = subscribedEpochHooks{}
+
+// subscribedEpochHooks forwards the epoch hooks of the subscribed identifiers only.
+type subscribedEpochHooks struct {
+	hooks       EpochHooks
+	identifiers map[string]bool
+}
+
+// NewSubscribedEpochHooks returns epoch hooks that are only called for epochs of the given identifiers,
+// so modules registering their own epoch identifiers do not have to filter the epochs of other modules.
+func NewSubscribedEpochHooks(hooks EpochHooks, epochIdentifiers ...string) EpochHooks {
+	identifiers := make(map[string]bool, len(epochIdentifiers))
+	for _, identifier := range epochIdentifiers {
+		identifiers[identifier] = true
+	}
+	return subscribedEpochHooks{hooks: hooks, identifiers: identifiers}
+}
+
+// GetModuleName implements EpochHooks.
+func (h subscribedEpochHooks) GetModuleName() string {
+	return h.hooks.GetModuleName()
+}
+
+// AfterEpochEnd calls the subscribed hooks if they are subscribed to the epoch identifier.
+func (h subscribedEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if !h.identifiers[epochIdentifier] {
+		return nil
+	}
+	return h.hooks.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
+
+// BeforeEpochStart calls the subscribed hooks if they are subscribed to the epoch identifier.
+func (h subscribedEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if !h.identifiers[epochIdentifier] {
+		return nil
+	}
+	return h.hooks.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
 func panicCatchingEpochHook(
 	ctx sdk.Context,
 	hookFn func(ctx sdk.Context, epochIdentifier string, epochNumber int64) error,
@@ -63,13 +115,40 @@ func panicCatchingEpochHook(
 	moduleName string,
 	isBeforeEpoch bool,
 ) {
+	panicked := false
 	wrappedHookFn := func(ctx sdk.Context) error {
+		defer func() {
+			// record the panic and re-panic, so that it is recovered by osmoutils.ApplyFuncIfNoError
+			if r := recover(); r != nil {
+				panicked = true
+				panic(r)
+			}
+		}()
 		return hookFn(ctx, epochIdentifier, epochNumber)
 	}
-	// TODO: Thread info for which hook this is, may be dependent on larger hook system refactoring
+
+	start := time.Now()
 	err := osmoutils.ApplyFuncIfNoError(ctx, wrappedHookFn)
+
+	hookLabels := []metrics.Label{
+		{
+			Name:  "module_name",
+			Value: moduleName,
+		},
+		{
+			Name:  "is_before_hook",
+			Value: strconv.FormatBool(isBeforeEpoch),
+		},
+	}
+	if telemetry.IsTelemetryEnabled() {
+		metrics.MeasureSinceWithLabels([]string{EpochHookDurationMetricName}, start, hookLabels)
+	}
+	if panicked {
+		telemetry.IncrCounterWithLabels([]string{EpochHookPanickedMetricName}, 1, hookLabels)
+	}
+
 	if err != nil {
-		telemetry.IncrCounterWithLabels([]string{}, 1, []metrics.Label{
+		telemetry.IncrCounterWithLabels([]string{EpochHookFailedMetricName}, 1, []metrics.Label{
 			{
 				Name:  "module_name",
 				Value: moduleName,
@@ -145,3 +145,28 @@ func (s *KeeperTestSuite) TestHooksPanicRecovery() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestSubscribedEpochHooks() {
+	s.SetupTest()
+	subscribedHook := &dummyEpochHook{}
+	allHook := &dummyEpochHook{}
+	hooks := types.NewMultiEpochHooks(types.NewSubscribedEpochHooks(subscribedHook, "6hrs"), allHook)
+
+	for _, identifier := range []string{"day", "6hrs", "week"} {
+		s.Require().NoError(hooks.BeforeEpochStart(s.Ctx, identifier, 1))
+		s.Require().NoError(hooks.AfterEpochEnd(s.Ctx, identifier, 1))
+	}
+
+	s.Require().Equal(2, subscribedHook.successCounter)
+	s.Require().Equal(6, allHook.successCounter)
+	s.Require().Equal("dummy", types.NewSubscribedEpochHooks(subscribedHook).GetModuleName())
+}
+
+func (s *KeeperTestSuite) TestMultiEpochHooksWithoutModules() {
+	hookA := &dummyEpochHook{}
+	hookB := &dummyEpochHook{}
+	hooks := types.NewMultiEpochHooks(hookA, hookB)
+
+	s.Require().Equal(hooks, hooks.WithoutModules([]string{"other"}))
+	s.Require().Empty(hooks.WithoutModules([]string{"dummy"}))
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyDisabledHookModules = []byte("DisabledHookModules")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+// ParamTable for epochs module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(disabledHookModules []string) Params {
+	return Params{
+		DisabledHookModules: disabledHookModules,
+	}
+}
+
+// default epochs module parameters, with the hooks of every module enabled.
+func DefaultParams() Params {
+	return Params{
+		DisabledHookModules: []string{},
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	return validateDisabledHookModules(p.DisabledHookModules)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDisabledHookModules, &p.DisabledHookModules, validateDisabledHookModules),
+	}
+}
+
+func validateDisabledHookModules(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	moduleNames := map[string]bool{}
+	for _, moduleName := range v {
+		if moduleName == "" {
+			return fmt.Errorf("disabled hook module name cannot be empty")
+		}
+		if moduleNames[moduleName] {
+			return fmt.Errorf("duplicate disabled hook module %s", moduleName)
+		}
+		moduleNames[moduleName] = true
+	}
+
+	return nil
+}
@@ -10,19 +10,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -198,11 +203,191 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+type QueryEpochCountdownsRequest struct {
+}
+
+func (m *QueryEpochCountdownsRequest) Reset()         { *m = QueryEpochCountdownsRequest{} }
+func (m *QueryEpochCountdownsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochCountdownsRequest) ProtoMessage()    {}
+func (*QueryEpochCountdownsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{4}
+}
+func (m *QueryEpochCountdownsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochCountdownsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochCountdownsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochCountdownsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochCountdownsRequest.Merge(m, src)
+}
+func (m *QueryEpochCountdownsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochCountdownsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochCountdownsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochCountdownsRequest proto.InternalMessageInfo
+
+type QueryEpochCountdownsResponse struct {
+	Countdowns []EpochCountdown `protobuf:"bytes,1,rep,name=countdowns,proto3" json:"countdowns"`
+}
+
+func (m *QueryEpochCountdownsResponse) Reset()         { *m = QueryEpochCountdownsResponse{} }
+func (m *QueryEpochCountdownsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochCountdownsResponse) ProtoMessage()    {}
+func (*QueryEpochCountdownsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{5}
+}
+func (m *QueryEpochCountdownsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochCountdownsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochCountdownsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochCountdownsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochCountdownsResponse.Merge(m, src)
+}
+func (m *QueryEpochCountdownsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochCountdownsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochCountdownsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochCountdownsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochCountdownsResponse) GetCountdowns() []EpochCountdown {
+	if m != nil {
+		return m.Countdowns
+	}
+	return nil
+}
+
+// EpochCountdown describes the progress of the current epoch of an epoch
+// identifier towards the start of its next epoch.
+type EpochCountdown struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// current_epoch is the current epoch number, 0 if epoch counting has not
+	// started yet.
+	CurrentEpoch int64 `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// current_epoch_start_time is the start time of the current epoch.
+	CurrentEpochStartTime time.Time `protobuf:"bytes,3,opt,name=current_epoch_start_time,json=currentEpochStartTime,proto3,stdtime" json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
+	// next_epoch_start_time is the time after which the next epoch starts, that
+	// is the expected end time of the current epoch. If epoch counting has not
+	// started yet, it is the start time of the first epoch.
+	NextEpochStartTime time.Time `protobuf:"bytes,4,opt,name=next_epoch_start_time,json=nextEpochStartTime,proto3,stdtime" json:"next_epoch_start_time" yaml:"next_epoch_start_time"`
+	// time_remaining is the time left until next_epoch_start_time, 0 if it has
+	// already passed.
+	TimeRemaining time.Duration `protobuf:"bytes,5,opt,name=time_remaining,json=timeRemaining,proto3,stdduration" json:"time_remaining" yaml:"time_remaining"`
+	// estimated_blocks_remaining is the number of blocks expected until
+	// next_epoch_start_time, based on the average block time since the current
+	// epoch started. It is 0 if no block time could be observed yet.
+	EstimatedBlocksRemaining int64 `protobuf:"varint,6,opt,name=estimated_blocks_remaining,json=estimatedBlocksRemaining,proto3" json:"estimated_blocks_remaining,omitempty" yaml:"estimated_blocks_remaining"`
+}
+
+func (m *EpochCountdown) Reset()         { *m = EpochCountdown{} }
+func (m *EpochCountdown) String() string { return proto.CompactTextString(m) }
+func (*EpochCountdown) ProtoMessage()    {}
+func (*EpochCountdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{6}
+}
+func (m *EpochCountdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCountdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCountdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochCountdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCountdown.Merge(m, src)
+}
+func (m *EpochCountdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCountdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCountdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochCountdown proto.InternalMessageInfo
+
+func (m *EpochCountdown) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochCountdown) GetCurrentEpoch() int64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func (m *EpochCountdown) GetCurrentEpochStartTime() time.Time {
+	if m != nil {
+		return m.CurrentEpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochCountdown) GetNextEpochStartTime() time.Time {
+	if m != nil {
+		return m.NextEpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochCountdown) GetTimeRemaining() time.Duration {
+	if m != nil {
+		return m.TimeRemaining
+	}
+	return 0
+}
+
+func (m *EpochCountdown) GetEstimatedBlocksRemaining() int64 {
+	if m != nil {
+		return m.EstimatedBlocksRemaining
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "osmosis.epochs.v1beta1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "osmosis.epochs.v1beta1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "osmosis.epochs.v1beta1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "osmosis.epochs.v1beta1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryEpochCountdownsRequest)(nil), "osmosis.epochs.v1beta1.QueryEpochCountdownsRequest")
+	proto.RegisterType((*QueryEpochCountdownsResponse)(nil), "osmosis.epochs.v1beta1.QueryEpochCountdownsResponse")
+	proto.RegisterType((*EpochCountdown)(nil), "osmosis.epochs.v1beta1.EpochCountdown")
 }
 
 func init() {
@@ -210,33 +395,50 @@ func init() {
 }
 
 var fileDescriptor_82bf2f47d6aaa9fa = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0xd3, 0x40,
-	0x1c, 0xc7, 0x33, 0xad, 0x16, 0x1c, 0xeb, 0x65, 0x90, 0x1a, 0x83, 0x8c, 0x35, 0xfe, 0x2b, 0x42,
-	0x67, 0x6c, 0xbd, 0x79, 0x51, 0x2a, 0x82, 0x1e, 0xcd, 0xb1, 0x17, 0x49, 0xe2, 0x34, 0x1d, 0x68,
-	0x67, 0xd2, 0xcc, 0x44, 0xec, 0xd5, 0x27, 0x10, 0xc4, 0x17, 0xf0, 0x59, 0xf6, 0xd0, 0x63, 0x61,
-	0x2f, 0x7b, 0x5a, 0x96, 0x76, 0x1f, 0x64, 0xc9, 0x64, 0x5a, 0xba, 0x6c, 0xb2, 0xec, 0xde, 0x92,
-	0x99, 0xcf, 0xf7, 0xcf, 0xef, 0x97, 0x40, 0x5f, 0xaa, 0xb9, 0x54, 0x5c, 0x51, 0x96, 0xca, 0x78,
-	0xaa, 0xe8, 0xcf, 0x41, 0xc4, 0x74, 0x38, 0xa0, 0x8b, 0x9c, 0x65, 0x4b, 0x92, 0x66, 0x52, 0x4b,
-	0xd4, 0xb1, 0x0c, 0x29, 0x19, 0x62, 0x19, 0xef, 0x61, 0x22, 0x13, 0x69, 0x10, 0x5a, 0x3c, 0x95,
-	0xb4, 0xf7, 0x24, 0x91, 0x32, 0x99, 0x31, 0x1a, 0xa6, 0x9c, 0x86, 0x42, 0x48, 0x1d, 0x6a, 0x2e,
-	0x85, 0xb2, 0xb7, 0x6f, 0x62, 0x63, 0x46, 0xa3, 0x50, 0xb1, 0x32, 0x64, 0x1f, 0x99, 0x86, 0x09,
-	0x17, 0x06, 0xb6, 0xec, 0x8b, 0x9a, 0x6e, 0x09, 0x13, 0xac, 0xa8, 0x63, 0x28, 0xdf, 0x85, 0x9d,
-	0x6f, 0x85, 0xcf, 0x67, 0x03, 0x7d, 0x15, 0x13, 0x19, 0xb0, 0x45, 0xce, 0x94, 0xf6, 0xc7, 0xf0,
-	0xd1, 0x95, 0x1b, 0x95, 0x4a, 0xa1, 0x18, 0xfa, 0x00, 0x5b, 0xa5, 0xa9, 0x0b, 0xba, 0xcd, 0xde,
-	0xfd, 0xe1, 0x33, 0x52, 0x3d, 0x23, 0x31, 0xda, 0x42, 0x3a, 0xba, 0xb3, 0x3a, 0x7d, 0xea, 0x04,
-	0x56, 0xe6, 0xbf, 0x87, 0xae, 0xf1, 0xfe, 0x94, 0x67, 0x19, 0x13, 0xda, 0x60, 0x36, 0x17, 0x61,
-	0x08, 0xf9, 0x0f, 0x26, 0x34, 0x9f, 0x70, 0x96, 0xb9, 0xa0, 0x0b, 0x7a, 0xf7, 0x82, 0x83, 0x13,
-	0xff, 0x23, 0x7c, 0x5c, 0xa1, 0xb5, 0xcd, 0x9e, 0xc3, 0x07, 0x71, 0x79, 0xfe, 0xdd, 0x44, 0x19,
-	0x7d, 0x33, 0x68, 0xc7, 0x07, 0xf0, 0xf0, 0xa8, 0x01, 0xef, 0x1a, 0x0b, 0xf4, 0x0f, 0x40, 0xb8,
-	0xef, 0xa8, 0x10, 0xa9, 0x9b, 0xa3, 0x7a, 0x45, 0x1e, 0xbd, 0x31, 0x5f, 0xd6, 0xf3, 0x5f, 0xfd,
-	0x3e, 0x3e, 0xff, 0xdb, 0xe8, 0x22, 0x4c, 0x6b, 0x3e, 0x4e, 0xf9, 0x8a, 0xfe, 0x03, 0xd8, 0x3e,
-	0x9c, 0x0f, 0xbd, 0xbd, 0x36, 0xa9, 0x62, 0x8d, 0xde, 0xe0, 0x16, 0x0a, 0xdb, 0xae, 0x6f, 0xda,
-	0xbd, 0x46, 0x2f, 0xeb, 0xda, 0x5d, 0x5a, 0xed, 0xe8, 0xcb, 0x6a, 0x83, 0xc1, 0x7a, 0x83, 0xc1,
-	0xd9, 0x06, 0x83, 0x3f, 0x5b, 0xec, 0xac, 0xb7, 0xd8, 0x39, 0xd9, 0x62, 0x67, 0x4c, 0x12, 0xae,
-	0xa7, 0x79, 0x44, 0x62, 0x39, 0xdf, 0x59, 0xf5, 0x67, 0x61, 0xa4, 0xf6, 0xbe, 0xbf, 0x76, 0xce,
-	0x7a, 0x99, 0x32, 0x15, 0xb5, 0xcc, 0xbf, 0xf8, 0xee, 0x22, 0x00, 0x00, 0xff, 0xff, 0x94, 0xf9,
-	0xbd, 0x8a, 0x4f, 0x03, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0xd4, 0x5c,
+	0x14, 0x9e, 0x32, 0x40, 0xf2, 0x5e, 0x3e, 0xde, 0xe4, 0x46, 0xb0, 0x54, 0xec, 0x0c, 0x55, 0x70,
+	0x82, 0xa1, 0xe5, 0xc3, 0x15, 0x1b, 0xcd, 0xa0, 0x89, 0x26, 0x6e, 0xac, 0xae, 0xd8, 0x4c, 0xda,
+	0xce, 0xa5, 0x34, 0x4e, 0xef, 0x2d, 0xbd, 0xb7, 0x02, 0x3b, 0xe3, 0x2f, 0x20, 0x31, 0x26, 0x26,
+	0xee, 0xfc, 0x01, 0xfe, 0x0e, 0x96, 0x24, 0x6e, 0x5c, 0xa1, 0x01, 0x7f, 0x01, 0x89, 0x7b, 0x73,
+	0x3f, 0xa6, 0x74, 0x98, 0x0e, 0x23, 0xbb, 0xe9, 0x39, 0xcf, 0x79, 0x9e, 0xe7, 0x9c, 0x39, 0xe7,
+	0x02, 0x8b, 0xd0, 0x98, 0xd0, 0x88, 0x3a, 0x28, 0x21, 0xc1, 0x2e, 0x75, 0xde, 0xad, 0xf9, 0x88,
+	0x79, 0x6b, 0xce, 0x5e, 0x86, 0xd2, 0x43, 0x3b, 0x49, 0x09, 0x23, 0x70, 0x56, 0x61, 0x6c, 0x89,
+	0xb1, 0x15, 0xc6, 0xb8, 0x15, 0x92, 0x90, 0x08, 0x88, 0xc3, 0x7f, 0x49, 0xb4, 0x31, 0x1f, 0x12,
+	0x12, 0x76, 0x90, 0xe3, 0x25, 0x91, 0xe3, 0x61, 0x4c, 0x98, 0xc7, 0x22, 0x82, 0xa9, 0xca, 0x9a,
+	0x2a, 0x2b, 0xbe, 0xfc, 0x6c, 0xc7, 0x69, 0x67, 0xa9, 0x00, 0xa8, 0x7c, 0xed, 0x6a, 0x9e, 0x45,
+	0x31, 0xa2, 0xcc, 0x8b, 0x13, 0x05, 0x58, 0x0e, 0x84, 0x1b, 0xc7, 0xf7, 0x28, 0x92, 0x2e, 0x73,
+	0xcf, 0x89, 0x17, 0x46, 0xb8, 0x48, 0x76, 0x7f, 0x40, 0x73, 0x21, 0xc2, 0x88, 0xf7, 0x23, 0x50,
+	0x96, 0x0e, 0x66, 0x5f, 0x71, 0x9e, 0x67, 0x02, 0xf4, 0x02, 0xef, 0x10, 0x17, 0xed, 0x65, 0x88,
+	0x32, 0x6b, 0x1b, 0xdc, 0xee, 0xcb, 0xd0, 0x84, 0x60, 0x8a, 0xe0, 0x63, 0x30, 0x2e, 0x49, 0x75,
+	0xad, 0x5e, 0x6d, 0x4c, 0xac, 0x2f, 0xd8, 0xe5, 0x43, 0xb2, 0x45, 0x2d, 0x2f, 0x6d, 0x8e, 0x1e,
+	0x9f, 0xd6, 0x2a, 0xae, 0x2a, 0xb3, 0x36, 0x81, 0x2e, 0xb8, 0xb7, 0xb2, 0x34, 0x45, 0x98, 0x09,
+	0x98, 0xd2, 0x85, 0x26, 0x00, 0x51, 0x1b, 0x61, 0x16, 0xed, 0x44, 0x28, 0xd5, 0xb5, 0xba, 0xd6,
+	0xf8, 0xcf, 0x2d, 0x44, 0xac, 0x27, 0x60, 0xae, 0xa4, 0x56, 0x39, 0xbb, 0x07, 0xa6, 0x02, 0x19,
+	0x6f, 0x09, 0x29, 0x51, 0x5f, 0x75, 0x27, 0x83, 0x02, 0xd8, 0xba, 0x0b, 0xee, 0x5c, 0x76, 0xb6,
+	0x45, 0x32, 0xcc, 0xda, 0x64, 0x1f, 0xd3, 0x6e, 0xe3, 0x1d, 0x30, 0x5f, 0x9e, 0x56, 0x1a, 0x2f,
+	0x01, 0x08, 0xf2, 0xa8, 0x9a, 0xc0, 0xd2, 0xb5, 0x13, 0xc8, 0x49, 0xd4, 0x18, 0x0a, 0xf5, 0xd6,
+	0x97, 0x51, 0x30, 0xdd, 0x0b, 0x1a, 0x36, 0x81, 0xfe, 0x26, 0x47, 0xfa, 0x9b, 0x84, 0xef, 0x35,
+	0xa0, 0xf7, 0xa0, 0x5a, 0x94, 0x79, 0x29, 0x6b, 0xf1, 0x95, 0xd2, 0xab, 0x75, 0xad, 0x31, 0xb1,
+	0x6e, 0xd8, 0x72, 0xdf, 0xec, 0xee, 0xbe, 0xd9, 0x6f, 0xba, 0xfb, 0xd6, 0x7c, 0xc8, 0x8d, 0x5e,
+	0x9c, 0xd6, 0x6a, 0x87, 0x5e, 0xdc, 0xd9, 0xb4, 0x06, 0x31, 0x59, 0x47, 0x3f, 0x6b, 0x9a, 0x3b,
+	0x53, 0x54, 0x7e, 0xcd, 0x93, 0x9c, 0x08, 0xee, 0x83, 0x19, 0x8c, 0x0e, 0x4a, 0xe4, 0x47, 0x87,
+	0xca, 0x37, 0x94, 0xfc, 0xbc, 0x94, 0x2f, 0xa5, 0x91, 0xda, 0x90, 0xe7, 0xae, 0x08, 0x07, 0x60,
+	0x9a, 0x03, 0x5a, 0x29, 0x8a, 0xbd, 0x08, 0x47, 0x38, 0xd4, 0xc7, 0x84, 0xe2, 0x5c, 0x9f, 0xe2,
+	0x53, 0x75, 0x80, 0xcd, 0x05, 0x25, 0x38, 0x23, 0x05, 0x7b, 0xcb, 0xad, 0xcf, 0x5c, 0x69, 0x8a,
+	0x07, 0xdd, 0x6e, 0x0c, 0x06, 0xc0, 0x40, 0x94, 0x45, 0xb1, 0xc7, 0x50, 0xbb, 0xe5, 0x77, 0x48,
+	0xf0, 0x96, 0x16, 0x04, 0xc7, 0xf9, 0x5f, 0xd2, 0x5c, 0xbc, 0x38, 0xad, 0x2d, 0x48, 0xc6, 0xc1,
+	0x58, 0xcb, 0xd5, 0xf3, 0x64, 0x53, 0xe4, 0x72, 0x91, 0xf5, 0x3f, 0x55, 0x30, 0x26, 0x96, 0x11,
+	0x7e, 0xd2, 0x00, 0xc8, 0xcf, 0x89, 0x42, 0x7b, 0xd0, 0xc2, 0x95, 0x5f, 0xb3, 0xe1, 0xfc, 0x33,
+	0x5e, 0x6e, 0xb9, 0xb5, 0xf4, 0xe1, 0xfb, 0xef, 0x8f, 0x23, 0x75, 0x68, 0x3a, 0x03, 0xde, 0x11,
+	0xf9, 0x09, 0xbf, 0x6a, 0x60, 0xb2, 0x78, 0x8a, 0x70, 0xf5, 0x5a, 0xa5, 0x92, 0x8b, 0x37, 0xd6,
+	0x6e, 0x50, 0xa1, 0xdc, 0xad, 0x08, 0x77, 0x0f, 0xe0, 0xe2, 0x20, 0x77, 0x3d, 0x0b, 0x0b, 0xbf,
+	0x69, 0xe0, 0xff, 0x2b, 0xe7, 0x0c, 0x37, 0x86, 0x4f, 0xa4, 0xef, 0x6d, 0x30, 0x1e, 0xdd, 0xac,
+	0x48, 0xb9, 0x5d, 0x15, 0x6e, 0x97, 0x61, 0xe3, 0xda, 0x59, 0xb6, 0x2e, 0x5f, 0x85, 0xe6, 0xf3,
+	0xe3, 0x33, 0x53, 0x3b, 0x39, 0x33, 0xb5, 0x5f, 0x67, 0xa6, 0x76, 0x74, 0x6e, 0x56, 0x4e, 0xce,
+	0xcd, 0xca, 0x8f, 0x73, 0xb3, 0xb2, 0x6d, 0x87, 0x11, 0xdb, 0xcd, 0x7c, 0x3b, 0x20, 0x71, 0x97,
+	0x6d, 0xa5, 0xe3, 0xf9, 0x34, 0xa7, 0x3e, 0xe8, 0x92, 0xb3, 0xc3, 0x04, 0x51, 0x7f, 0x5c, 0xec,
+	0xfa, 0xc6, 0xdf, 0x01, 0x00, 0x5c, 0x09, 0x6a, 0x27, 0xec, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -255,6 +457,10 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochCountdowns provides, for every epoch identifier, the current epoch
+	// and the time and estimated number of blocks left until the next epoch
+	// starts.
+	EpochCountdowns(ctx context.Context, in *QueryEpochCountdownsRequest, opts ...grpc.CallOption) (*QueryEpochCountdownsResponse, error)
 }
 
 type queryClient struct {
@@ -283,12 +489,25 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) EpochCountdowns(ctx context.Context, in *QueryEpochCountdownsRequest, opts ...grpc.CallOption) (*QueryEpochCountdownsResponse, error) {
+	out := new(QueryEpochCountdownsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.epochs.v1beta1.Query/EpochCountdowns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochCountdowns provides, for every epoch identifier, the current epoch
+	// and the time and estimated number of blocks left until the next epoch
+	// starts.
+	EpochCountdowns(context.Context, *QueryEpochCountdownsRequest) (*QueryEpochCountdownsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -301,6 +520,9 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) EpochCountdowns(ctx context.Context, req *QueryEpochCountdownsRequest) (*QueryEpochCountdownsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochCountdowns not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -342,6 +564,25 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochCountdowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochCountdownsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochCountdowns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.epochs.v1beta1.Query/EpochCountdowns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochCountdowns(ctx, req.(*QueryEpochCountdownsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.epochs.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -354,6 +595,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "EpochCountdowns",
+			Handler:    _Query_EpochCountdowns_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/epochs/v1beta1/query.proto",
@@ -477,6 +722,130 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochCountdownsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochCountdownsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochCountdownsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochCountdownsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochCountdownsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochCountdownsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Countdowns) > 0 {
+		for iNdEx := len(m.Countdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Countdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCountdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCountdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCountdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedBlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedBlocksRemaining))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeRemaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeRemaining):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextEpochStartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -537,6 +906,55 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochCountdownsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochCountdownsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Countdowns) > 0 {
+		for _, e := range m.Countdowns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochCountdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextEpochStartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeRemaining)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EstimatedBlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedBlocksRemaining))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -828,6 +1246,359 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochCountdownsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochCountdownsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochCountdownsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochCountdownsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochCountdownsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochCountdownsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countdowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countdowns = append(m.Countdowns, EpochCountdown{})
+			if err := m.Countdowns[len(m.Countdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochCountdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCountdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCountdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CurrentEpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextEpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedBlocksRemaining", wireType)
+			}
+			m.EstimatedBlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedBlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
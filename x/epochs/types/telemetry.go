@@ -12,6 +12,24 @@ var (
 	// * err - the error or panic returned
 	// * is_before_hook - true if this is a before epoch hook. False otherwise.
 	EpochHookFailedMetricName = formatEpochMetricName("hook_failed")
+
+	// epoch_hook_panicked
+	//
+	// counter that is increased if epoch hook panics
+	//
+	// Has the following labels:
+	// * module_name - the name of the module that panicked
+	// * is_before_hook - true if this is a before epoch hook. False otherwise.
+	EpochHookPanickedMetricName = formatEpochMetricName("hook_panicked")
+
+	// epoch_hook_duration
+	//
+	// histogram of the time spent executing an epoch hook
+	//
+	// Has the following labels:
+	// * module_name - the name of the module whose hook is executed
+	// * is_before_hook - true if this is a before epoch hook. False otherwise.
+	EpochHookDurationMetricName = formatEpochMetricName("hook_duration")
 )
 
 // formatTxFeesMetricName formats the epochs module metric name.
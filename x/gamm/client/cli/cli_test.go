@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -192,6 +193,23 @@ func TestNewJoinPoolCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewJoinPoolAndLockCmd(t *testing.T) {
+	desc, _ := cli.NewJoinPoolAndLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgJoinPoolAndLock]{
+		"join pool and lock": {
+			Cmd: "336h --pool-id=1 --max-amounts-in=100stake --share-amount-out=100 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgJoinPoolAndLock{
+				Sender:         testAddresses[0].String(),
+				PoolId:         1,
+				ShareOutAmount: osmomath.NewIntFromUint64(100),
+				TokenInMaxs:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				Duration:       time.Hour * 336,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestNewExitPoolCmd(t *testing.T) {
 	desc, _ := cli.NewExitPoolCmd()
 	tcs := map[string]osmocli.TxCliTestCase[*types.MsgExitPool]{
@@ -306,6 +324,53 @@ func TestNewExitSwapShareAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewExitSwapShareAmountInMaxPriceImpactCmd(t *testing.T) {
+	desc, _ := cli.NewExitSwapShareAmountInMaxPriceImpact()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgExitSwapShareAmountInMaxPriceImpact]{
+		"exit swap share amount in max price impact": {
+			Cmd: "stake 10 1 0.05 --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgExitSwapShareAmountInMaxPriceImpact{
+				Sender:            testAddresses[0].String(),
+				PoolId:            1,
+				TokenOutDenom:     "stake",
+				ShareInAmount:     osmomath.NewIntFromUint64(10),
+				TokenOutMinAmount: osmomath.NewIntFromUint64(1),
+				MaxPriceImpact:    osmomath.MustNewDecFromStr("0.05"),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewExitSwapShareAmountInToDenomsCmd(t *testing.T) {
+	desc, _ := cli.NewExitSwapShareAmountInToDenoms()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgExitSwapShareAmountInToDenoms]{
+		"exit swap share amount in to denoms": {
+			Cmd: "10 stake,node0token 0.05 --pool-id=1 --min-amounts-out=1stake --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgExitSwapShareAmountInToDenoms{
+				Sender:         testAddresses[0].String(),
+				PoolId:         1,
+				ShareInAmount:  osmomath.NewIntFromUint64(10),
+				TokenOutDenoms: []string{"stake", "node0token"},
+				TokenOutMins:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+				MaxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+			},
+		},
+		"exit swap share amount in to a single denom without min amounts": {
+			Cmd: "10 stake 0.05 --pool-id=1 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgExitSwapShareAmountInToDenoms{
+				Sender:         testAddresses[0].String(),
+				PoolId:         1,
+				ShareInAmount:  osmomath.NewIntFromUint64(10),
+				TokenOutDenoms: []string{"stake"},
+				TokenOutMins:   sdk.Coins{},
+				MaxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestGetCmdPools(t *testing.T) {
 	desc, _ := cli.GetCmdPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolsRequest]{
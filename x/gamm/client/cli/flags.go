@@ -41,10 +41,17 @@ const (
 	// FlagScalingFactors represents the flag name for the scaling factors.
 	FlagScalingFactors                 = "scaling-factors"
 	FlagScalingFactorControllerAddress = "scaling-factor-controller-address"
+	FlagRampDuration                   = "ramp-duration"
 
 	FlagMigrationRecords = "migration-records"
 
 	FlagPoolRecords = "pool-records"
+
+	// Will be parsed to osmomath.Dec.
+	FlagMinReserveRatio = "min-reserve-ratio"
+
+	// Will be parsed to osmomath.Dec.
+	FlagRebalancingFeeDiscount = "rebalancing-fee-discount"
 )
 
 type createBalancerPoolInputs struct {
@@ -108,6 +115,12 @@ func FlagSetExitPool() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetMinAmountsOut() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringArray(FlagMinAmountsOut, []string{""}, "Minimum tokens out")
+	return fs
+}
+
 func FlagSetJustPoolId() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
@@ -120,6 +133,12 @@ func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetRampScalingFactors() *flag.FlagSet {
+	fs := FlagSetAdjustScalingFactors()
+	fs.Duration(FlagRampDuration, 0, "The duration to ramp the scaling factors over")
+	return fs
+}
+
 func FlagSetMigratePosition() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.StringArray(FlagMinAmountsOut, []string{""}, "Minimum tokens out")
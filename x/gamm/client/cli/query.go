@@ -30,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetConcentratedPoolIdLinkFromCFMMRequest)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCFMMConcentratedPoolLinksRequest)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdScalingFactorRamp)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdSmoothWeightChange)
 	cmd.AddCommand(
 		osmocli.GetParams[*types.ParamsRequest](
 			types.ModuleName, types.NewQueryClient),
@@ -364,3 +366,23 @@ func GetCFMMConcentratedPoolLinksRequest() (*osmocli.QueryDescriptor, *types.Que
 {{.CommandPrefix}} cfmm-cl-pool-links`,
 	}, &types.QueryCFMMConcentratedPoolLinksRequest{}
 }
+
+// GetCmdScalingFactorRamp returns the current scaling factors of a stableswap pool and its scaling factor ramp in progress.
+func GetCmdScalingFactorRamp() (*osmocli.QueryDescriptor, *types.QueryScalingFactorRampRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "scaling-factor-ramp",
+		Short: "Query the current scaling factors of a stableswap pool and its scaling factor ramp in progress",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} scaling-factor-ramp 1`,
+	}, &types.QueryScalingFactorRampRequest{}
+}
+
+// GetCmdSmoothWeightChange returns the current weights of a balancer pool and the projected end state of its smooth weight change.
+func GetCmdSmoothWeightChange() (*osmocli.QueryDescriptor, *types.QuerySmoothWeightChangeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "smooth-weight-change",
+		Short: "Query the current weights of a balancer pool and the weights and time its smooth weight change completes at",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} smooth-weight-change 1`,
+	}, &types.QuerySmoothWeightChangeRequest{}
+}
@@ -31,6 +31,7 @@ import (
 func NewTxCmd() *cobra.Command {
 	txCmd := osmocli.TxIndexCmd(types.ModuleName)
 	osmocli.AddTxCmd(txCmd, NewJoinPoolCmd)
+	osmocli.AddTxCmd(txCmd, NewJoinPoolAndLockCmd)
 	osmocli.AddTxCmd(txCmd, NewExitPoolCmd)
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInCmd)
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
@@ -38,9 +39,12 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewJoinSwapShareAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
+	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountInMaxPriceImpact)
+	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountInToDenoms)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewStableSwapRampScalingFactorsCmd(),
 	)
 	return txCmd
 }
@@ -95,6 +99,22 @@ func NewJoinPoolCmd() (*osmocli.TxCliDesc, *types.MsgJoinPool) {
 	}, &types.MsgJoinPool{}
 }
 
+func NewJoinPoolAndLockCmd() (*osmocli.TxCliDesc, *types.MsgJoinPoolAndLock) {
+	return &osmocli.TxCliDesc{
+		Use:     "join-pool-and-lock",
+		Short:   "join a pool and lock the received shares for the given duration",
+		Example: "osmosisd tx gamm join-pool-and-lock 336h --pool-id=1 --max-amounts-in=100uosmo --max-amounts-in=100uion --share-amount-out=100 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: map[string]string{
+			"poolid":         FlagPoolId,
+			"ShareOutAmount": FlagShareAmountOut,
+		},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"TokenInMaxs": osmocli.FlagOnlyParser(maxAmountsInParser),
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJoinPool()}},
+	}, &types.MsgJoinPoolAndLock{}
+}
+
 func NewExitPoolCmd() (*osmocli.TxCliDesc, *types.MsgExitPool) {
 	return &osmocli.TxCliDesc{
 		Use:   "exit-pool",
@@ -168,6 +188,34 @@ func NewExitSwapShareAmountIn() (*osmocli.TxCliDesc, *types.MsgExitSwapShareAmou
 	}, &types.MsgExitSwapShareAmountIn{}
 }
 
+func NewExitSwapShareAmountInMaxPriceImpact() (*osmocli.TxCliDesc, *types.MsgExitSwapShareAmountInMaxPriceImpact) {
+	return &osmocli.TxCliDesc{
+		Use:                 "exit-swap-share-amount-in-max-price-impact",
+		Short:               "exit swap share amount in, failing if the price impact exceeds the max price impact",
+		Example:             "osmosisd tx gamm exit-swap-share-amount-in-max-price-impact uosmo 1000000 1 0.05 --pool-id=1 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: poolIdFlagOverride,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+	}, &types.MsgExitSwapShareAmountInMaxPriceImpact{}
+}
+
+func NewExitSwapShareAmountInToDenoms() (*osmocli.TxCliDesc, *types.MsgExitSwapShareAmountInToDenoms) {
+	return &osmocli.TxCliDesc{
+		Use:                 "exit-swap-share-amount-in-to-denoms",
+		Short:               "exit swap share amount in to a subset of the pool's denoms, failing if the price impact exceeds the max price impact",
+		Example:             "osmosisd tx gamm exit-swap-share-amount-in-to-denoms 1000000 uosmo,uatom 0.05 --pool-id=1 --min-amounts-out=1uosmo --from val --chain-id osmosis-1",
+		NumArgs:             3,
+		CustomFlagOverrides: poolIdFlagOverride,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"TokenOutDenoms": parseTokenOutDenoms,
+			"TokenOutMins":   osmocli.FlagOnlyParser(minAmountsOutParser),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()},
+			OptionalFlags: []*flag.FlagSet{FlagSetMinAmountsOut()},
+		},
+	}, &types.MsgExitSwapShareAmountInToDenoms{}
+}
+
 // TODO: Change these flags to args. Required flags don't make that much sense.
 func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	cmd := osmocli.TxCliDesc{
@@ -184,6 +232,22 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapRampScalingFactorsCmd() *cobra.Command {
+	cmd := osmocli.TxCliDesc{
+		Use:              "ramp-scaling-factors --pool-id=[pool-id] --scaling-factors=[scaling-factors] --ramp-duration=[ramp-duration]",
+		Short:            "linearly ramp scaling factors to the given scaling factors over the ramp duration",
+		Example:          "osmosisd ramp-scaling-factors --pool-id=1 --scaling-factors=\"100,110\" --ramp-duration=24h",
+		NumArgs:          0,
+		ParseAndBuildMsg: NewStableSwapRampScalingFactorsMsg,
+	}.BuildCommandCustomFn()
+
+	cmd.Flags().AddFlagSet(FlagSetRampScalingFactors())
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagScalingFactors)
+	_ = cmd.MarkFlagRequired(FlagRampDuration)
+	return cmd
+}
+
 // NewCmdSubmitReplaceMigrationRecordsProposal implements a command handler for replace migration records proposal
 func NewCmdSubmitReplaceMigrationRecordsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -373,6 +437,132 @@ Sample proposal with flags
 	return cmd
 }
 
+// NewCmdSubmitSetPoolDrainProtectionProposal implements a command handler for the set pool drain protection proposal
+func NewCmdSubmitSetPoolDrainProtectionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-drain-protection-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a set pool drain protection proposal",
+		Long: strings.TrimSpace(`Submit a set pool drain protection proposal.
+The pool's current reserves per share are used as the reference the minimum reserve ratio is measured against.
+A min reserve ratio of 0 removes the pool's drain protection.
+
+Sample proposal file:
+{
+	"title": "Set Pool Drain Protection Proposal",
+	"description": "Prevent pool 1 reserves per share from falling below 50% of their current value"
+	"pool_id": 1,
+	"min_reserve_ratio": "0.5"
+}
+>>> osmosisd tx gov submit-proposal set-pool-drain-protection-proposal \
+        --proposal proposal.json \
+		--deposit 1600000000uosmo \
+
+Sample proposal with flags
+>>> osmosisd tx gov submit-proposal set-pool-drain-protection-proposal \
+        --title "Set Pool Drain Protection Proposal" \
+		--summary "Prevent pool 1 reserves per share from falling below 50% of their current value"
+		--deposit 1600000000uosmo
+		--pool-id 1
+		--min-reserve-ratio 0.5
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetPoolDrainProtectionArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Uint64(FlagPoolId, 0, "pool-id")
+	cmd.Flags().String(FlagMinReserveRatio, "", "minimum reserve ratio, 0 removes the drain protection")
+	cmd.Flags().String(govcli.FlagProposal, "", "proposal file path") //nolint:staticcheck
+
+	return cmd
+}
+
+// NewCmdSubmitSetPoolRebalancingFeeDiscountProposal implements a command handler for the set pool rebalancing fee discount proposal
+func NewCmdSubmitSetPoolRebalancingFeeDiscountProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-rebalancing-fee-discount-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a set pool rebalancing fee discount proposal",
+		Long: strings.TrimSpace(`Submit a set pool rebalancing fee discount proposal.
+Swaps that move the pool's weighted balances toward each other are charged the pool's spread factor times (1 - discount).
+A rebalancing fee discount of 0 removes the pool's discount.
+
+Sample proposal file:
+{
+	"title": "Set Pool Rebalancing Fee Discount Proposal",
+	"description": "Halve the spread factor of swaps restoring the peg of pool 1",
+	"pool_id": 1,
+	"discount": "0.5"
+}
+>>> osmosisd tx gov submit-proposal set-pool-rebalancing-fee-discount-proposal \
+        --proposal proposal.json \
+		--deposit 1600000000uosmo \
+
+Sample proposal with flags
+>>> osmosisd tx gov submit-proposal set-pool-rebalancing-fee-discount-proposal \
+        --title "Set Pool Rebalancing Fee Discount Proposal" \
+		--summary "Halve the spread factor of swaps restoring the peg of pool 1"
+		--deposit 1600000000uosmo
+		--pool-id 1
+		--rebalancing-fee-discount 0.5
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetPoolRebalancingFeeDiscountArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Uint64(FlagPoolId, 0, "pool-id")
+	cmd.Flags().String(FlagRebalancingFeeDiscount, "", "rebalancing fee discount, 0 removes the discount")
+	cmd.Flags().String(govcli.FlagProposal, "", "proposal file path") //nolint:staticcheck
+
+	return cmd
+}
+
 func BuildCreatePoolCmd(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
 	poolType, err := fs.GetString(FlagPoolType)
 	if err != nil {
@@ -549,6 +739,16 @@ func minAmountsOutParser(fs *flag.FlagSet) (sdk.Coins, error) {
 	return stringArrayCoinsParser(FlagMinAmountsOut, fs)
 }
 
+func parseTokenOutDenoms(arg string, _ *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	denoms := strings.Split(arg, ",")
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, osmocli.UsedArg, err
+		}
+	}
+	return denoms, osmocli.UsedArg, nil
+}
+
 func stringArrayCoinsParser(flagName string, fs *flag.FlagSet) (sdk.Coins, error) {
 	amountsArr, err := fs.GetStringArray(flagName)
 	if err != nil {
@@ -658,6 +858,47 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 		return nil, err
 	}
 
+	scalingFactors, err := parseScalingFactors(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
+		Sender:         clientCtx.GetFromAddress().String(),
+		PoolID:         poolID,
+		ScalingFactors: scalingFactors,
+	}
+
+	return msg, nil
+}
+
+func NewStableSwapRampScalingFactorsMsg(clientCtx client.Context, _args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	scalingFactors, err := parseScalingFactors(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	rampDuration, err := fs.GetDuration(FlagRampDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stableswap.MsgStableSwapRampScalingFactors{
+		Sender:         clientCtx.GetFromAddress().String(),
+		PoolID:         poolID,
+		ScalingFactors: scalingFactors,
+		RampDuration:   rampDuration,
+	}
+
+	return msg, nil
+}
+
+func parseScalingFactors(fs *flag.FlagSet) ([]uint64, error) {
 	scalingFactorsStr, err := fs.GetString(FlagScalingFactors)
 	if err != nil {
 		return nil, err
@@ -674,13 +915,7 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 		scalingFactors[i] = scalingFactor
 	}
 
-	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
-		Sender:         clientCtx.GetFromAddress().String(),
-		PoolID:         poolID,
-		ScalingFactors: scalingFactors,
-	}
-
-	return msg, nil
+	return scalingFactors, nil
 }
 
 // ParseCoinsNoSort parses coins from coinsStr but does not sort them.
@@ -912,3 +1147,111 @@ func parseSetScalingFactorControllerArgsToContent(cmd *cobra.Command) (govtypesv
 
 	return content, nil
 }
+
+func parseSetPoolDrainProtectionArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	proposalFile, err := cmd.Flags().GetString(govcli.FlagProposal) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+
+	if proposalFile != "" {
+		contents, err := os.ReadFile(proposalFile)
+		if err != nil {
+			return nil, err
+		}
+
+		var proposal types.SetPoolDrainProtectionProposal
+		if err := json.Unmarshal(contents, &proposal); err != nil {
+			return nil, err
+		}
+		return &proposal, nil
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := cmd.Flags().GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	minReserveRatioStr, err := cmd.Flags().GetString(FlagMinReserveRatio)
+	if err != nil {
+		return nil, err
+	}
+
+	minReserveRatio, err := osmomath.NewDecFromStr(minReserveRatioStr)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetPoolDrainProtectionProposal{
+		Title:           title,
+		Description:     description,
+		PoolId:          poolId,
+		MinReserveRatio: minReserveRatio,
+	}
+
+	return content, nil
+}
+
+func parseSetPoolRebalancingFeeDiscountArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	proposalFile, err := cmd.Flags().GetString(govcli.FlagProposal) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+
+	if proposalFile != "" {
+		contents, err := os.ReadFile(proposalFile)
+		if err != nil {
+			return nil, err
+		}
+
+		var proposal types.SetPoolRebalancingFeeDiscountProposal
+		if err := json.Unmarshal(contents, &proposal); err != nil {
+			return nil, err
+		}
+		return &proposal, nil
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := cmd.Flags().GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	discountStr, err := cmd.Flags().GetString(FlagRebalancingFeeDiscount)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := osmomath.NewDecFromStr(discountStr)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetPoolRebalancingFeeDiscountProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		Discount:    discount,
+	}
+
+	return content, nil
+}
@@ -7,8 +7,10 @@ import (
 )
 
 var (
-	ReplaceMigrationRecordsProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitReplaceMigrationRecordsProposal)
-	UpdateMigrationRecordsProposalHandler     = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateMigrationRecordsProposal)
-	CreateCLPoolAndLinkToCFMMProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitCreateCLPoolAndLinkToCFMMProposal)
-	SetScalingFactorControllerProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetScalingFactorControllerProposal)
+	ReplaceMigrationRecordsProposalHandler       = govclient.NewProposalHandler(cli.NewCmdSubmitReplaceMigrationRecordsProposal)
+	UpdateMigrationRecordsProposalHandler        = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateMigrationRecordsProposal)
+	CreateCLPoolAndLinkToCFMMProposalHandler     = govclient.NewProposalHandler(cli.NewCmdSubmitCreateCLPoolAndLinkToCFMMProposal)
+	SetScalingFactorControllerProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitSetScalingFactorControllerProposal)
+	SetPoolDrainProtectionProposalHandler        = govclient.NewProposalHandler(cli.NewCmdSubmitSetPoolDrainProtectionProposal)
+	SetPoolRebalancingFeeDiscountProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetPoolRebalancingFeeDiscountProposal)
 )
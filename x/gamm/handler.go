@@ -23,6 +23,10 @@ func NewGammProposalHandler(k keeper.Keeper) govtypesv1.Handler {
 			return handleCreatingCLPoolAndLinkToCFMMProposal(ctx, k, c)
 		case *types.SetScalingFactorControllerProposal:
 			return handleSetScalingFactorControllerProposal(ctx, k, c)
+		case *types.SetPoolDrainProtectionProposal:
+			return handleSetPoolDrainProtectionProposal(ctx, k, c)
+		case *types.SetPoolRebalancingFeeDiscountProposal:
+			return handleSetPoolRebalancingFeeDiscountProposal(ctx, k, c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized migration record proposal content type: %T", c)
@@ -55,3 +59,15 @@ func handleCreatingCLPoolAndLinkToCFMMProposal(ctx sdk.Context, k keeper.Keeper,
 func handleSetScalingFactorControllerProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetScalingFactorControllerProposal) error {
 	return k.HandleSetScalingFactorControllerProposal(ctx, p)
 }
+
+// handleSetPoolDrainProtectionProposal is a handler for gov proposals to set a pool's
+// minimum reserve ratio
+func handleSetPoolDrainProtectionProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetPoolDrainProtectionProposal) error {
+	return k.HandleSetPoolDrainProtectionProposal(ctx, p)
+}
+
+// handleSetPoolRebalancingFeeDiscountProposal is a handler for gov proposals to set a pool's
+// rebalancing fee discount
+func handleSetPoolRebalancingFeeDiscountProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetPoolRebalancingFeeDiscountProposal) error {
+	return k.HandleSetPoolRebalancingFeeDiscountProposal(ctx, p)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// SetPoolDrainProtection sets the minimum reserve ratio of the given pool, using the pool's
+// current reserves per share as the reference the ratio is measured against.
+// Setting a ratio on a pool that is already protected overrides its previous reference.
+// A ratio of zero removes the protection from the pool.
+func (k Keeper) SetPoolDrainProtection(ctx sdk.Context, poolId uint64, minReserveRatio osmomath.Dec) error {
+	if err := types.ValidateMinReserveRatio(minReserveRatio); err != nil {
+		return err
+	}
+
+	pool, err := k.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if minReserveRatio.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(types.GetKeyPrefixPoolDrainProtection(poolId))
+		return nil
+	}
+
+	totalShares := pool.GetTotalShares()
+	if !totalShares.IsPositive() {
+		return fmt.Errorf("pool %d has no shares to set a drain protection reference from", poolId)
+	}
+
+	k.setPoolDrainProtection(ctx, types.PoolDrainProtection{
+		PoolId:               poolId,
+		MinReserveRatio:      minReserveRatio,
+		ReferenceReserves:    pool.GetTotalPoolLiquidity(ctx),
+		ReferenceTotalShares: totalShares,
+	})
+	return nil
+}
+
+// GetPoolDrainProtection returns the drain protection of the given pool.
+// Returns false if the pool has no drain protection set.
+func (k Keeper) GetPoolDrainProtection(ctx sdk.Context, poolId uint64) (types.PoolDrainProtection, bool, error) {
+	protection := types.PoolDrainProtection{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyPrefixPoolDrainProtection(poolId), &protection)
+	if err != nil {
+		return types.PoolDrainProtection{}, false, err
+	}
+	return protection, found, nil
+}
+
+// GetAllPoolDrainProtections returns the drain protections of all pools.
+func (k Keeper) GetAllPoolDrainProtections(ctx sdk.Context) ([]types.PoolDrainProtection, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixPoolDrainProtection, func(bz []byte) (types.PoolDrainProtection, error) {
+		protection := types.PoolDrainProtection{}
+		err := k.cdc.Unmarshal(bz, &protection)
+		return protection, err
+	})
+}
+
+func (k Keeper) setPoolDrainProtection(ctx sdk.Context, protection types.PoolDrainProtection) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.GetKeyPrefixPoolDrainProtection(protection.PoolId), &protection)
+}
+
+// checkPoolDrainProtection returns an error if the pool has a drain protection set and any of the
+// pool's updated reserves per share is below the minimum reserve ratio of its reference reserves per share.
+// It is expected to be called with the pool holding its post swap or exit state, before that state is persisted.
+// Using reserves per share rather than raw reserves lets LPs join and exit proportionally without tripping the check.
+func (k Keeper) checkPoolDrainProtection(ctx sdk.Context, pool poolmanagertypes.PoolI) error {
+	protection, found, err := k.GetPoolDrainProtection(ctx, pool.GetId())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	cfmmPool, err := asCFMMPool(pool)
+	if err != nil {
+		return err
+	}
+
+	totalShares := cfmmPool.GetTotalShares()
+	liquidity := cfmmPool.GetTotalPoolLiquidity(ctx)
+	minReserveRatio := osmomath.BigDecFromDec(protection.MinReserveRatio)
+	for _, referenceReserve := range protection.ReferenceReserves {
+		// reserve / totalShares >= minReserveRatio * referenceReserve / referenceTotalShares,
+		// cross multiplied to avoid rounding.
+		reserve := liquidity.AmountOf(referenceReserve.Denom)
+		actual := osmomath.BigDecFromSDKInt(reserve.Mul(protection.ReferenceTotalShares))
+		minimum := minReserveRatio.Mul(osmomath.BigDecFromSDKInt(referenceReserve.Amount.Mul(totalShares)))
+		if actual.LT(minimum) {
+			return errorsmod.Wrapf(types.ErrPoolDrainProtection,
+				"pool %d reserve of %s would be %s with %s total shares, min reserve ratio is %s of %s with %s total shares",
+				pool.GetId(), referenceReserve.Denom, reserve, totalShares, protection.MinReserveRatio, referenceReserve.Amount, protection.ReferenceTotalShares)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+func (s *KeeperTestSuite) TestSetPoolDrainProtection() {
+	tests := map[string]struct {
+		poolId          uint64
+		minReserveRatio osmomath.Dec
+		expectedErr     error
+	}{
+		"valid ratio": {
+			poolId:          1,
+			minReserveRatio: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"zero ratio removes protection": {
+			poolId:          1,
+			minReserveRatio: osmomath.ZeroDec(),
+		},
+		"ratio of one": {
+			poolId:          1,
+			minReserveRatio: osmomath.OneDec(),
+			expectedErr:     types.ErrInvalidMinReserveRatio,
+		},
+		"negative ratio": {
+			poolId:          1,
+			minReserveRatio: osmomath.MustNewDecFromStr("-0.1"),
+			expectedErr:     types.ErrInvalidMinReserveRatio,
+		},
+		"pool does not exist": {
+			poolId:          2,
+			minReserveRatio: osmomath.MustNewDecFromStr("0.5"),
+			expectedErr:     types.PoolDoesNotExistError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.PrepareBalancerPool()
+
+			err := s.App.GAMMKeeper.SetPoolDrainProtection(s.Ctx, tc.poolId, tc.minReserveRatio)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			protection, found, err := s.App.GAMMKeeper.GetPoolDrainProtection(s.Ctx, poolId)
+			s.Require().NoError(err)
+			if tc.minReserveRatio.IsZero() {
+				s.Require().False(found)
+				return
+			}
+			s.Require().True(found)
+
+			pool, err := s.App.GAMMKeeper.GetCFMMPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.minReserveRatio, protection.MinReserveRatio)
+			s.Require().Equal(pool.GetTotalPoolLiquidity(s.Ctx), protection.ReferenceReserves)
+			s.Require().Equal(pool.GetTotalShares(), protection.ReferenceTotalShares)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPoolDrainProtection_SwapAndExit() {
+	s.SetupTest()
+	poolId := s.PrepareBalancerPool()
+	gammKeeper := s.App.GAMMKeeper
+
+	err := gammKeeper.SetPoolDrainProtection(s.Ctx, poolId, osmomath.MustNewDecFromStr("0.9"))
+	s.Require().NoError(err)
+
+	pool, err := gammKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	// A small swap keeps the bar reserve above 90% of its reference.
+	_, err = gammKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], pool, sdk.NewCoin(apptesting.FOO, osmomath.NewInt(1000)), apptesting.BAR, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+
+	// A swap that drains the bar reserve below 90% of its reference is rejected.
+	pool, err = gammKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	_, err = gammKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], pool, sdk.NewCoin(apptesting.FOO, osmomath.NewInt(5000000)), apptesting.BAR, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().ErrorIs(err, types.ErrPoolDrainProtection)
+
+	// Exiting proportionally leaves the reserves per share unchanged.
+	_, err = gammKeeper.ExitPool(s.Ctx, s.TestAccs[0], poolId, types.InitPoolSharesSupply.QuoRaw(2), sdk.Coins{})
+	s.Require().NoError(err)
+
+	// Removing the protection allows the draining swap.
+	err = gammKeeper.SetPoolDrainProtection(s.Ctx, poolId, osmomath.ZeroDec())
+	s.Require().NoError(err)
+	pool, err = gammKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	_, err = gammKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], pool, sdk.NewCoin(apptesting.FOO, osmomath.NewInt(5000000)), apptesting.BAR, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+}
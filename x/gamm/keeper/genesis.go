@@ -27,6 +27,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		if err != nil {
 			panic(err)
 		}
+		k.trackSmoothWeightChange(ctx, pool)
 
 		poolAssets := pool.GetTotalPoolLiquidity(ctx)
 		for _, asset := range poolAssets {
@@ -41,6 +42,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	} else {
 		k.SetMigrationRecords(ctx, *genState.MigrationRecords)
 	}
+
+	for _, protection := range genState.PoolDrainProtections {
+		k.setPoolDrainProtection(ctx, protection)
+	}
+
+	for _, discount := range genState.PoolRebalancingFeeDiscounts {
+		k.setPoolRebalancingFeeDiscount(ctx, discount)
+	}
+
+	for _, ramp := range genState.ScalingFactorRamps {
+		k.setScalingFactorRamp(ctx, ramp)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -49,6 +62,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	poolDrainProtections, err := k.GetAllPoolDrainProtections(ctx)
+	if err != nil {
+		panic(err)
+	}
+	poolRebalancingFeeDiscounts, err := k.GetAllPoolRebalancingFeeDiscounts(ctx)
+	if err != nil {
+		panic(err)
+	}
+	scalingFactorRamps, err := k.GetAllScalingFactorRamps(ctx)
+	if err != nil {
+		panic(err)
+	}
 	pools, err := k.GetPoolsAndPoke(ctx)
 	if err != nil {
 		panic(err)
@@ -62,9 +87,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		poolAnys = append(poolAnys, any)
 	}
 	return &types.GenesisState{
-		NextPoolNumber:   k.GetNextPoolId(ctx),
-		Pools:            poolAnys,
-		Params:           k.GetParams(ctx),
-		MigrationRecords: &migrationInfo,
+		NextPoolNumber:              k.GetNextPoolId(ctx),
+		Pools:                       poolAnys,
+		Params:                      k.GetParams(ctx),
+		MigrationRecords:            &migrationInfo,
+		PoolDrainProtections:        poolDrainProtections,
+		PoolRebalancingFeeDiscounts: poolRebalancingFeeDiscounts,
+		ScalingFactorRamps:          scalingFactorRamps,
 	}
 }
@@ -55,7 +55,8 @@ func (s *KeeperTestSuite) TestGammInitGenesis() {
 		Pools:          poolAnys,
 		NextPoolNumber: 7,
 		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PoolCreationFee:             sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PegArbitrageEpochIdentifier: types.DefaultPegArbitrageEpochIdentifier,
 		},
 		MigrationRecords: &DefaultMigrationRecords,
 	}, s.App.AppCodec())
@@ -122,9 +123,18 @@ func (s *KeeperTestSuite) TestGammExportGenesis() {
 
 	s.App.GAMMKeeper.SetMigrationRecords(ctx, DefaultMigrationRecords)
 
+	err = s.App.GAMMKeeper.SetPoolDrainProtection(ctx, 1, osmomath.NewDecWithPrec(5, 1))
+	s.Require().NoError(err)
+	err = s.App.GAMMKeeper.SetPoolRebalancingFeeDiscount(ctx, 2, osmomath.NewDecWithPrec(5, 1))
+	s.Require().NoError(err)
+
 	genesis := s.App.GAMMKeeper.ExportGenesis(ctx)
 	s.Require().Len(genesis.Pools, 2)
 	s.Require().Equal(&DefaultMigrationRecords, genesis.MigrationRecords)
+	s.Require().Len(genesis.PoolDrainProtections, 1)
+	s.Require().Equal(uint64(1), genesis.PoolDrainProtections[0].PoolId)
+	s.Require().Equal(osmomath.NewDecWithPrec(5, 1), genesis.PoolDrainProtections[0].MinReserveRatio)
+	s.Require().Equal([]types.PoolRebalancingFeeDiscount{{PoolId: 2, Discount: osmomath.NewDecWithPrec(5, 1)}}, genesis.PoolRebalancingFeeDiscounts)
 }
 
 func (s *KeeperTestSuite) TestMarshalUnmarshalGenesis() {
@@ -17,3 +17,11 @@ func (k Keeper) HandleUpdateMigrationRecordsProposal(ctx sdk.Context, p *types.U
 func (k Keeper) HandleSetScalingFactorControllerProposal(ctx sdk.Context, p *types.SetScalingFactorControllerProposal) error {
 	return k.setStableSwapScalingFactorController(ctx, p.PoolId, p.ControllerAddress)
 }
+
+func (k Keeper) HandleSetPoolDrainProtectionProposal(ctx sdk.Context, p *types.SetPoolDrainProtectionProposal) error {
+	return k.SetPoolDrainProtection(ctx, p.PoolId, p.MinReserveRatio)
+}
+
+func (k Keeper) HandleSetPoolRebalancingFeeDiscountProposal(ctx sdk.Context, p *types.SetPoolRebalancingFeeDiscountProposal) error {
+	return k.SetPoolRebalancingFeeDiscount(ctx, p.PoolId, p.Discount)
+}
@@ -534,3 +534,67 @@ func (q Querier) CFMMConcentratedPoolLinks(ctx context.Context, req *types.Query
 		MigrationRecords: &poolLinks,
 	}, nil
 }
+
+// ScalingFactorRamp returns the current scaling factors of a stableswap pool
+// along with its scaling factor ramp, if one is in progress.
+func (q Querier) ScalingFactorRamp(ctx context.Context, req *types.QueryScalingFactorRampRequest) (*types.QueryScalingFactorRampResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	stableswapPool, err := q.Keeper.getStableswapPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ramp, found, err := q.Keeper.GetScalingFactorRamp(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryScalingFactorRampResponse{ScalingFactors: stableswapPool.GetScalingFactors()}
+	if found {
+		res.Ramp = &ramp
+	}
+	return res, nil
+}
+
+// SmoothWeightChange returns the current weights of a balancer pool, interpolated to the block time,
+// along with the weights and time its smooth weight change completes at, if one is in progress.
+func (q Querier) SmoothWeightChange(ctx context.Context, req *types.QuerySmoothWeightChangeRequest) (*types.QuerySmoothWeightChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "pool id %d is not of type balancer pool", req.PoolId)
+	}
+
+	res := &types.QuerySmoothWeightChangeResponse{CurrentWeights: toDenomWeights(balancerPool.GetAllPoolAssets())}
+	params := balancerPool.PoolParams.SmoothWeightChangeParams
+	if params == nil {
+		res.TargetWeights = res.CurrentWeights
+		return res, nil
+	}
+
+	res.TargetWeights = toDenomWeights(params.TargetPoolWeights)
+	res.InProgress = true
+	res.StartTime = params.StartTime
+	res.EndTime = params.StartTime.Add(params.Duration)
+	return res, nil
+}
+
+func toDenomWeights(poolAssets []balancer.PoolAsset) []types.DenomWeight {
+	weights := make([]types.DenomWeight, 0, len(poolAssets))
+	for _, poolAsset := range poolAssets {
+		weights = append(weights, types.DenomWeight{Denom: poolAsset.Token.Denom, Weight: poolAsset.Weight})
+	}
+	return weights
+}
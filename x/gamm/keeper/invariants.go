@@ -7,12 +7,25 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
 )
 
-const poolBalanceInvariantName = "pool-account-balance-equals-expected"
+const (
+	poolBalanceInvariantName = "pool-account-balance-equals-expected"
+	cfmmInvariantName        = "cfmm-invariant-per-share-non-decreasing"
+)
+
+// cfmmInvariantTolerance is the amount by which the log2 of a pool's invariant per share
+// may decrease between two checks. It absorbs the approximation errors of the power
+// and CFMM solver functions used by the pool models, which are bounded well below it.
+var cfmmInvariantTolerance = osmomath.MustNewBigDecFromStr("0.000001")
 
 // RegisterInvariants registers all gamm invariants.
+// CFMMInvariant is not registered, as it compares against a baseline kept in memory,
+// which differs between nodes. The simulator runs it as a property check instead.
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolAccountInvariant(keeper, bk))
 }
@@ -49,3 +62,120 @@ func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 			"\tgamm all pool asset coins and account coins match\n"), false
 	}
 }
+
+// cfmmObservation is the last observed invariant per share of a pool, along with
+// the pool configuration it was computed under.
+type cfmmObservation struct {
+	configuration     string
+	invariantPerShare osmomath.BigDec
+}
+
+// CFMMInvariant checks that the CFMM invariant per share of every balancer and
+// stableswap pool does not decrease between two checks. Swaps only grow it by the
+// spread factor, and joins and exits leave it unchanged up to rounding in the pool's
+// favor, so a decrease means that a solver gave away more than it should have.
+//
+// The previous observations are kept in memory by the returned function, so the first
+// check only records a baseline. A pool's baseline is also reset when its configuration
+// changes, e.g. balancer weights or stableswap scaling factors. As the baseline depends
+// on when the node started checking, this must only be run by the simulator and never
+// be registered with the crisis module.
+func CFMMInvariant(keeper Keeper) sdk.Invariant {
+	observations := map[uint64]cfmmObservation{}
+
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPoolsAndPoke(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, cfmmInvariantName,
+				"\tgamm pool retrieval failed"), true
+		}
+
+		for _, pool := range pools {
+			var observation cfmmObservation
+			var ok bool
+			switch pool := pool.(type) {
+			case *balancer.Pool:
+				observation, ok = balancerInvariantPerShare(pool)
+			case *stableswap.Pool:
+				observation, ok = stableswapInvariantPerShare(pool)
+			}
+			if !ok {
+				delete(observations, pool.GetId())
+				continue
+			}
+
+			previous, found := observations[pool.GetId()]
+			observations[pool.GetId()] = observation
+			if !found || previous.configuration != observation.configuration {
+				continue
+			}
+
+			if observation.invariantPerShare.LT(previous.invariantPerShare.Sub(cfmmInvariantTolerance)) {
+				return sdk.FormatInvariant(types.ModuleName, cfmmInvariantName,
+					fmt.Sprintf("\tgamm pool id %d\n\t previous log2 invariant per share: %s\n\t current log2 invariant per share: %s\n",
+						pool.GetId(), previous.invariantPerShare, observation.invariantPerShare)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, cfmmInvariantName,
+			"\tgamm all pool invariants per share are non-decreasing\n"), false
+	}
+}
+
+// balancerInvariantPerShare returns the log2 of the weighted product invariant
+// prod(balance_i^normalized_weight_i) divided by the total shares of the pool.
+// Returns false if the pool is empty.
+func balancerInvariantPerShare(pool *balancer.Pool) (cfmmObservation, bool) {
+	totalShares := pool.GetTotalShares()
+	totalWeight := pool.GetTotalWeight()
+	if !totalShares.IsPositive() || !totalWeight.IsPositive() {
+		return cfmmObservation{}, false
+	}
+
+	invariant := osmomath.ZeroBigDec()
+	configuration := ""
+	for _, asset := range pool.GetAllPoolAssets() {
+		if !asset.Token.Amount.IsPositive() {
+			return cfmmObservation{}, false
+		}
+		normalizedWeight := osmomath.BigDecFromSDKInt(asset.Weight).QuoMut(osmomath.BigDecFromSDKInt(totalWeight))
+		invariant.AddMut(osmomath.BigDecFromSDKInt(asset.Token.Amount).LogBase2().MulMut(normalizedWeight))
+		configuration += fmt.Sprintf("%s:%s,", asset.Token.Denom, asset.Weight)
+	}
+	invariant.SubMut(osmomath.BigDecFromSDKInt(totalShares).LogBase2())
+
+	return cfmmObservation{configuration: configuration, invariantPerShare: invariant}, true
+}
+
+// stableswapInvariantPerShare returns the log2 of the n+2'th root of the stableswap
+// invariant prod(x_i) * sum(x_i^2) over the scaled reserves x_i, divided by the total
+// shares of the pool. The root makes the invariant linear in the reserves, so that it
+// can be compared per share. Returns false if the pool is empty.
+func stableswapInvariantPerShare(pool *stableswap.Pool) (cfmmObservation, bool) {
+	totalShares := pool.GetTotalShares()
+	liquidity := pool.PoolLiquidity
+	scalingFactors := pool.GetScalingFactors()
+	if !totalShares.IsPositive() || len(liquidity) == 0 || len(liquidity) != len(scalingFactors) {
+		return cfmmObservation{}, false
+	}
+
+	productLog := osmomath.ZeroBigDec()
+	sumSquares := osmomath.ZeroBigDec()
+	for i, coin := range liquidity {
+		if !coin.Amount.IsPositive() || scalingFactors[i] == 0 {
+			return cfmmObservation{}, false
+		}
+		scaledReserve := osmomath.BigDecFromSDKInt(coin.Amount).QuoMut(osmomath.NewBigDec(int64(scalingFactors[i])))
+		if !scaledReserve.IsPositive() {
+			return cfmmObservation{}, false
+		}
+		productLog.AddMut(scaledReserve.LogBase2())
+		sumSquares.AddMut(scaledReserve.Mul(scaledReserve))
+	}
+
+	invariant := productLog.AddMut(sumSquares.LogBase2()).
+		QuoMut(osmomath.NewBigDec(int64(len(liquidity) + 2))).
+		SubMut(osmomath.BigDecFromSDKInt(totalShares).LogBase2())
+
+	return cfmmObservation{configuration: fmt.Sprint(scalingFactors), invariantPerShare: invariant}, true
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+func (s *KeeperTestSuite) TestCFMMInvariant() {
+	tests := map[string]struct {
+		// corruptPool removes liquidity from the pool without burning shares.
+		corruptPool  bool
+		expectBroken bool
+	}{
+		"swaps, joins and exits keep the invariant per share": {},
+		"liquidity removed without burning shares breaks the invariant": {
+			corruptPool:  true,
+			expectBroken: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			balancerPoolId := s.PrepareBalancerPool()
+			stableswapPoolId := s.PrepareBasicStableswapPool()
+			sender := s.TestAccs[0]
+			s.FundAcc(sender, apptesting.DefaultAcctFunds)
+
+			invariant := keeper.CFMMInvariant(*s.App.GAMMKeeper)
+
+			// The first check only records the baseline.
+			_, broken := invariant(s.Ctx)
+			s.Require().False(broken)
+
+			for _, poolId := range []uint64{balancerPoolId, stableswapPoolId} {
+				_, _, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, sender, poolId, sdk.NewInt64Coin(apptesting.FOO, 100_000), apptesting.BAR, osmomath.OneInt())
+				s.Require().NoError(err)
+				_, _, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, sender, poolId, sdk.NewInt64Coin(apptesting.BAR, 100_000), apptesting.FOO, osmomath.OneInt())
+				s.Require().NoError(err)
+				_, _, err = s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+				s.Require().NoError(err)
+				_, err = s.App.GAMMKeeper.ExitPool(s.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+				s.Require().NoError(err)
+			}
+
+			if tc.corruptPool {
+				pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, stableswapPoolId)
+				s.Require().NoError(err)
+				stableswapPool := pool.(*stableswap.Pool)
+				stableswapPool.PoolLiquidity = stableswapPool.PoolLiquidity.Sub(sdk.NewInt64Coin(apptesting.FOO, 1_000_000))
+				s.Require().NoError(s.App.GAMMKeeper.SetPool(s.Ctx, stableswapPool))
+
+				pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, balancerPoolId)
+				s.Require().NoError(err)
+				balancerPool := pool.(*balancer.Pool)
+				fooBalance, err := balancerPool.GetTokenBalance(apptesting.FOO)
+				s.Require().NoError(err)
+				s.Require().NoError(balancerPool.UpdatePoolAssetBalance(sdk.NewCoin(apptesting.FOO, fooBalance.QuoRaw(2))))
+				s.Require().NoError(s.App.GAMMKeeper.SetPool(s.Ctx, balancerPool))
+			}
+
+			_, broken = invariant(s.Ctx)
+			s.Require().Equal(tc.expectBroken, broken)
+		})
+	}
+}
@@ -63,6 +63,16 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapRampScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapRampScalingFactors) (*stableswap.MsgStableSwapRampScalingFactorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.RampStableSwapScalingFactors(ctx, msg.PoolID, msg.ScalingFactors, msg.RampDuration, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapRampScalingFactorsResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
@@ -108,6 +118,28 @@ func (server msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool)
 	}, nil
 }
 
+// JoinPoolAndLock joins a pool and locks the received shares for the given duration atomically,
+// returning the id of the newly created lock.
+func (server msgServer) JoinPoolAndLock(goCtx context.Context, msg *types.MsgJoinPoolAndLock) (*types.MsgJoinPoolAndLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	neededLp, sharesOut, lockId, err := server.keeper.JoinPoolAndLock(ctx, sender, msg.PoolId, msg.ShareOutAmount, msg.TokenInMaxs, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgJoinPoolAndLockResponse{
+		ShareOutAmount: sharesOut,
+		TokenIn:        neededLp,
+		LockId:         lockId,
+	}, nil
+}
+
 func (server msgServer) ExitPool(goCtx context.Context, msg *types.MsgExitPool) (*types.MsgExitPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -238,3 +270,43 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 
 	return &types.MsgExitSwapShareAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
+
+// ExitSwapShareAmountInMaxPriceImpact exits a pool to a single asset, failing if the price impact
+// of the exit exceeds the given max price impact.
+func (server msgServer) ExitSwapShareAmountInMaxPriceImpact(goCtx context.Context, msg *types.MsgExitSwapShareAmountInMaxPriceImpact) (*types.MsgExitSwapShareAmountInMaxPriceImpactResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, priceImpact, err := server.keeper.ExitSwapShareAmountInMaxPriceImpact(ctx, sender, msg.PoolId, msg.TokenOutDenom, msg.ShareInAmount, msg.TokenOutMinAmount, msg.MaxPriceImpact)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap and LP events are handled elsewhere
+
+	return &types.MsgExitSwapShareAmountInMaxPriceImpactResponse{TokenOutAmount: tokenOutAmount, PriceImpact: priceImpact}, nil
+}
+
+// ExitSwapShareAmountInToDenoms exits a pool to a subset of its assets, swapping the other assets into them,
+// failing if the price impact of the exit exceeds the given max price impact.
+func (server msgServer) ExitSwapShareAmountInToDenoms(goCtx context.Context, msg *types.MsgExitSwapShareAmountInToDenoms) (*types.MsgExitSwapShareAmountInToDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, priceImpact, err := server.keeper.ExitSwapShareAmountInToDenoms(ctx, sender, msg.PoolId, msg.TokenOutDenoms, msg.ShareInAmount, msg.TokenOutMins, msg.MaxPriceImpact)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap and LP events are handled elsewhere
+
+	return &types.MsgExitSwapShareAmountInToDenomsResponse{TokenOut: tokensOut, PriceImpact: priceImpact}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -289,6 +291,90 @@ func (s *KeeperTestSuite) TestJoinPool_Events() {
 	}
 }
 
+// TestJoinPoolAndLock tests that joining a pool and locking the received shares
+// happens atomically and that the created lock is returned.
+func (s *KeeperTestSuite) TestJoinPoolAndLock() {
+	const (
+		tokenInMaxAmount = int64Max
+		shareOut         = 110
+	)
+
+	defaultTokenInMaxs := sdk.NewCoins(
+		sdk.NewCoin("foo", osmomath.NewInt(tokenInMaxAmount)),
+		sdk.NewCoin("bar", osmomath.NewInt(tokenInMaxAmount)),
+		sdk.NewCoin("baz", osmomath.NewInt(tokenInMaxAmount)),
+		sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(tokenInMaxAmount)),
+	)
+
+	testcases := map[string]struct {
+		poolId         uint64
+		shareOutAmount osmomath.Int
+		tokenInMaxs    sdk.Coins
+		duration       time.Duration
+		expectError    bool
+	}{
+		"successful join and lock": {
+			poolId:         1,
+			shareOutAmount: osmomath.NewInt(shareOut),
+			tokenInMaxs:    defaultTokenInMaxs,
+			duration:       time.Hour * 24 * 14,
+		},
+		"tokenInMaxs do not match all tokens in pool - invalid join": {
+			poolId:         1,
+			shareOutAmount: osmomath.NewInt(shareOut),
+			tokenInMaxs:    sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(tokenInMaxAmount))),
+			duration:       time.Hour * 24 * 14,
+			expectError:    true,
+		},
+		"pool does not exist": {
+			poolId:         2,
+			shareOutAmount: osmomath.NewInt(shareOut),
+			tokenInMaxs:    defaultTokenInMaxs,
+			duration:       time.Hour * 24 * 14,
+			expectError:    true,
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.Reset()
+
+			s.PrepareBalancerPool()
+			shareDenom := types.GetPoolShareDenom(1)
+			sharesBefore := s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], shareDenom)
+
+			msgServer := keeper.NewMsgServerImpl(s.App.GAMMKeeper)
+
+			response, err := msgServer.JoinPoolAndLock(s.Ctx, &types.MsgJoinPoolAndLock{
+				Sender:         s.TestAccs[0].String(),
+				PoolId:         tc.poolId,
+				ShareOutAmount: tc.shareOutAmount,
+				TokenInMaxs:    tc.tokenInMaxs,
+				Duration:       tc.duration,
+			})
+
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Require().Nil(response)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(response)
+
+			// All of the received shares are locked rather than held by the sender.
+			sharesAfter := s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], shareDenom)
+			s.Require().Equal(sharesBefore, sharesAfter)
+
+			lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, response.LockId)
+			s.Require().NoError(err)
+			s.Require().Equal(s.TestAccs[0].String(), lock.Owner)
+			s.Require().Equal(tc.duration, lock.Duration)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(shareDenom, response.ShareOutAmount)), lock.Coins)
+		})
+	}
+}
+
 // TestExitPool_Events tests that events are correctly emitted
 // when calling ExitPool.
 func (s *KeeperTestSuite) TestExitPool_Events() {
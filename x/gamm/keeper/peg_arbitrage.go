@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// maxPegArbitrageAttempts is the number of times the rebalance amount of a peg arbitrage link is tried,
+// halving it every time, before giving up on rebalancing the link's stableswap pool for the epoch.
+const maxPegArbitrageAttempts = 5
+
+// pegArbitrageTwapWindow is the window of the TWAPs the peg deviation of a peg arbitrage link is computed with,
+// so that the pools' prices can not be manipulated right before the epoch end to trigger or skew a rebalance.
+const pegArbitrageTwapWindow = time.Hour
+
+// ArbitragePegs rebalances the stableswap pool of every peg arbitrage link whose TWAP deviates
+// from its linked pool's TWAP by more than the link's max deviation.
+// Each link is processed in its own cache context, so that a link failing to rebalance is skipped
+// without reverting or blocking the others.
+func (k Keeper) ArbitragePegs(ctx sdk.Context) {
+	for _, link := range k.GetParams(ctx).PegArbitrageLinks {
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.arbitragePeg(cacheCtx, link)
+		})
+	}
+}
+
+// arbitragePeg rebalances the stableswap pool of the given link if its peg deviates beyond the link's max deviation.
+// The peg deviation is the relative deviation of the stableswap pool's TWAP of baseDenom in quoteDenom from the
+// linked pool's TWAP of the same pair, over pegArbitrageTwapWindow.
+// The stableswap pool's excess asset, capped to the link's max rebalance fraction of its reserve, is swapped through
+// the linked pool for the pool's other asset. The swap is paid for by the pool's reserves, including its accrued
+// spread fees, and must return at least the stableswap pool's own spot price, so that its LPs never lose value.
+// If the rebalance would push the pool's spot price past the linked pool's TWAP by more than the max deviation,
+// or the swap fails, the amount is halved and the rebalance retried, up to maxPegArbitrageAttempts times.
+func (k Keeper) arbitragePeg(ctx sdk.Context, link types.PegArbitrageLink) error {
+	pool, err := k.getStableswapPool(ctx, link.StableswapPoolId)
+	if err != nil {
+		return err
+	}
+
+	denoms := pool.GetPoolDenoms(ctx)
+	if len(denoms) != 2 {
+		return fmt.Errorf("peg arbitrage requires a two asset stableswap pool, pool %d has %d assets", pool.GetId(), len(denoms))
+	}
+	quoteDenom, baseDenom := denoms[0], denoms[1]
+
+	startTime := ctx.BlockTime().Add(-pegArbitrageTwapWindow)
+	stableswapTwap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, link.StableswapPoolId, baseDenom, quoteDenom, startTime)
+	if err != nil {
+		return err
+	}
+	linkedTwap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, link.LinkedPoolId, baseDenom, quoteDenom, startTime)
+	if err != nil {
+		return err
+	}
+	if !stableswapTwap.IsPositive() || !linkedTwap.IsPositive() {
+		return fmt.Errorf("pools %d and %d have no TWAP of %s in %s", link.StableswapPoolId, link.LinkedPoolId, baseDenom, quoteDenom)
+	}
+
+	deviation := pegDeviation(stableswapTwap, linkedTwap)
+	if deviation.Abs().LTE(link.MaxDeviation) {
+		return nil
+	}
+
+	// A positive deviation means the base asset is more expensive in the stableswap pool than in the linked pool,
+	// i.e. the stableswap pool is short of the base asset and holds an excess of the quote asset.
+	excessDenom, scarceDenom := baseDenom, quoteDenom
+	if deviation.IsPositive() {
+		excessDenom, scarceDenom = quoteDenom, baseDenom
+	}
+
+	amount := link.MaxRebalanceFraction.MulInt(pool.PoolLiquidity.AmountOf(excessDenom)).TruncateInt()
+	for attempt := 0; attempt < maxPegArbitrageAttempts && amount.IsPositive(); attempt++ {
+		tokenOut := sdk.NewCoin(excessDenom, amount)
+		attemptCtx, write := ctx.CacheContext()
+		tokenIn, newDeviation, err := k.rebalancePeg(attemptCtx, link, tokenOut, scarceDenom, quoteDenom, baseDenom, stableswapTwap, linkedTwap)
+		if err == nil && (newDeviation.Abs().LTE(link.MaxDeviation) || newDeviation.IsNegative() == deviation.IsNegative()) {
+			write()
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.TypeEvtPegArbitrage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(link.StableswapPoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyLinkedPoolId, strconv.FormatUint(link.LinkedPoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+				sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOut.String()),
+				sdk.NewAttribute(types.AttributeKeyDeviation, deviation.String()),
+			))
+			return nil
+		}
+		amount = amount.QuoRaw(2)
+	}
+
+	return fmt.Errorf("failed to rebalance pool %d against pool %d within %d attempts, deviation %s",
+		link.StableswapPoolId, link.LinkedPoolId, maxPegArbitrageAttempts, deviation)
+}
+
+// rebalancePeg moves tokenOut from the link's stableswap pool's reserves to the gamm module account, swaps it
+// through the linked pool for scarceDenom and adds the tokens in to the stableswap pool's reserves.
+// The swap must return at least tokenOut's worth at the stableswap pool's spot price, and at least its worth at the
+// stableswap pool's TWAP minus the link's max deviation, so that a spot price manipulated down before the epoch end
+// can not lower the minimum amount in.
+// Returns the tokens added to the stableswap pool and the deviation of the pool's spot price from the linked pool's
+// TWAP after the rebalance.
+func (k Keeper) rebalancePeg(ctx sdk.Context, link types.PegArbitrageLink, tokenOut sdk.Coin, scarceDenom, quoteDenom, baseDenom string, stableswapTwap, linkedTwap osmomath.Dec) (sdk.Coin, osmomath.Dec, error) {
+	pool, err := k.getStableswapPool(ctx, link.StableswapPoolId)
+	if err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+
+	spotPrice, err := pool.SpotPrice(ctx, scarceDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+	minAmountIn := spotPrice.Mul(osmomath.BigDecFromSDKInt(tokenOut.Amount)).Dec().TruncateInt()
+
+	// The TWAP is the price of baseDenom in quoteDenom, so it is inverted when the excess asset is the quote asset.
+	twapPrice := stableswapTwap
+	if tokenOut.Denom == quoteDenom {
+		twapPrice = osmomath.OneDec().Quo(stableswapTwap)
+	}
+	twapMinAmountIn := twapPrice.Mul(osmomath.OneDec().Sub(link.MaxDeviation)).MulInt(tokenOut.Amount).TruncateInt()
+	if twapMinAmountIn.GT(minAmountIn) {
+		minAmountIn = twapMinAmountIn
+	}
+	if !minAmountIn.IsPositive() {
+		return sdk.Coin{}, osmomath.Dec{}, fmt.Errorf("rebalance amount %s of pool %d is worth no %s", tokenOut, pool.GetId(), scarceDenom)
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), moduleAddress, sdk.NewCoins(tokenOut)); err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: link.LinkedPoolId, TokenOutDenom: scarceDenom}}
+	amountIn, err := k.poolManager.RouteExactAmountIn(ctx, moduleAddress, route, tokenOut, minAmountIn)
+	if err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+	tokenIn := sdk.NewCoin(scarceDenom, amountIn)
+
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddress, pool.GetAddress(), sdk.NewCoins(tokenIn)); err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+
+	if err := pool.RebalanceLiquidity(tokenIn, tokenOut); err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+	if err := k.checkPoolDrainProtection(ctx, pool); err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+
+	k.hooks.AfterCFMMSwap(ctx, moduleAddress, pool.GetId(), sdk.NewCoins(tokenIn), sdk.NewCoins(tokenOut))
+	k.RecordTotalLiquidityIncrease(ctx, sdk.NewCoins(tokenIn))
+	k.RecordTotalLiquidityDecrease(ctx, sdk.NewCoins(tokenOut))
+
+	spotPriceAfter, err := pool.SpotPrice(ctx, quoteDenom, baseDenom)
+	if err != nil {
+		return sdk.Coin{}, osmomath.Dec{}, err
+	}
+	return tokenIn, pegDeviation(spotPriceAfter.Dec(), linkedTwap), nil
+}
+
+// pegDeviation returns the relative deviation of the given stableswap pool price from the given linked pool price.
+// CONTRACT: linkedPrice is positive.
+func pegDeviation(stableswapPrice, linkedPrice osmomath.Dec) osmomath.Dec {
+	return stableswapPrice.Sub(linkedPrice).Quo(linkedPrice)
+}
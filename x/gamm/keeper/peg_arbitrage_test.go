@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// TestPegArbitrage tests that the epoch hook rebalances a stableswap pool trading at 1 bar per baz
+// toward a deep linked balancer pool trading at 100/110 bar per baz, only when enabled, at the end of
+// the peg arbitrage epoch and when the deviation of the pools' TWAPs is above the link's max deviation.
+func (s *KeeperTestSuite) TestPegArbitrage() {
+	tests := map[string]struct {
+		disabled          bool
+		epochIdentifier   string
+		maxDeviation      osmomath.Dec
+		linkedPoolBaz     int64
+		manipulateSpot    bool
+		expectedRebalance bool
+	}{
+		"deviation above max deviation": {
+			epochIdentifier:   "day",
+			maxDeviation:      osmomath.MustNewDecFromStr("0.01"),
+			expectedRebalance: true,
+		},
+		"spot price deviation without TWAP deviation": {
+			epochIdentifier: "day",
+			maxDeviation:    osmomath.MustNewDecFromStr("0.01"),
+			linkedPoolBaz:   100_000_000_000,
+			manipulateSpot:  true,
+		},
+		"deviation within max deviation": {
+			epochIdentifier: "day",
+			maxDeviation:    osmomath.MustNewDecFromStr("0.2"),
+		},
+		"other epoch": {
+			epochIdentifier: "week",
+			maxDeviation:    osmomath.MustNewDecFromStr("0.01"),
+		},
+		"disabled": {
+			disabled:        true,
+			epochIdentifier: "day",
+			maxDeviation:    osmomath.MustNewDecFromStr("0.01"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			stableswapPoolId := s.PrepareStableswapPoolWithCoins(sdk.NewInt64Coin("bar", 10_000_000), sdk.NewInt64Coin("baz", 10_000_000))
+			linkedPoolBaz := int64(110_000_000_000)
+			if tc.linkedPoolBaz != 0 {
+				linkedPoolBaz = tc.linkedPoolBaz
+			}
+			linkedPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 100_000_000_000), sdk.NewInt64Coin("baz", linkedPoolBaz))
+
+			// Let the TWAP window of the peg deviation pass since the pools were created.
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+			if tc.manipulateSpot {
+				// Move the stableswap pool's spot price right before the epoch end, without moving its TWAP.
+				tokenIn := sdk.NewInt64Coin("baz", 2_000_000)
+				s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+				_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], []poolmanagertypes.SwapAmountInRoute{{PoolId: stableswapPoolId, TokenOutDenom: "bar"}}, tokenIn, osmomath.OneInt())
+				s.Require().NoError(err)
+
+				manipulatedPool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, stableswapPoolId)
+				s.Require().NoError(err)
+				manipulatedSpotPrice, err := manipulatedPool.SpotPrice(s.Ctx, "baz", "bar")
+				s.Require().NoError(err)
+				s.Require().True(manipulatedSpotPrice.Dec().Sub(osmomath.OneDec()).Abs().GT(tc.maxDeviation))
+			}
+
+			params := s.App.GAMMKeeper.GetParams(s.Ctx)
+			params.PegArbitrageEnabled = !tc.disabled
+			params.PegArbitrageLinks = []types.PegArbitrageLink{{
+				StableswapPoolId:     stableswapPoolId,
+				LinkedPoolId:         linkedPoolId,
+				MaxDeviation:         tc.maxDeviation,
+				MaxRebalanceFraction: osmomath.MustNewDecFromStr("0.1"),
+			}}
+			s.App.GAMMKeeper.SetParams(s.Ctx, params)
+
+			poolBefore, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, stableswapPoolId)
+			s.Require().NoError(err)
+			liquidityBefore := poolBefore.GetTotalPoolLiquidity(s.Ctx)
+			spotPriceBefore, err := poolBefore.SpotPrice(s.Ctx, "baz", "bar")
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			err = s.App.GAMMKeeper.EpochHooks().AfterEpochEnd(s.Ctx, tc.epochIdentifier, 1)
+			s.Require().NoError(err)
+
+			poolAfter, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, stableswapPoolId)
+			s.Require().NoError(err)
+			liquidityAfter := poolAfter.GetTotalPoolLiquidity(s.Ctx)
+			s.Require().Equal(liquidityAfter, s.App.BankKeeper.GetAllBalances(s.Ctx, poolAfter.GetAddress()))
+
+			if !tc.expectedRebalance {
+				s.Require().Equal(liquidityBefore, liquidityAfter)
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtPegArbitrage, 0)
+				return
+			}
+
+			// A tenth of the pool's excess bar is swapped for baz.
+			barOut := liquidityBefore.AmountOf("bar").Sub(liquidityAfter.AmountOf("bar"))
+			bazIn := liquidityAfter.AmountOf("baz").Sub(liquidityBefore.AmountOf("baz"))
+			s.Require().Equal(osmomath.NewInt(1_000_000), barOut)
+			s.Require().True(bazIn.IsPositive())
+
+			// The pool gets more baz than it would have at its own spot price or at its TWAP minus the max deviation,
+			// and its price moves toward the linked pool's.
+			s.Require().True(osmomath.BigDecFromSDKInt(bazIn).GT(spotPriceBefore.Mul(osmomath.BigDecFromSDKInt(barOut))))
+			s.Require().True(bazIn.ToLegacyDec().GTE(osmomath.OneDec().Sub(tc.maxDeviation).MulInt(barOut)))
+			spotPriceAfter, err := poolAfter.SpotPrice(s.Ctx, "baz", "bar")
+			s.Require().NoError(err)
+			s.Require().True(spotPriceAfter.GT(spotPriceBefore))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtPegArbitrage, 1)
+		})
+	}
+}
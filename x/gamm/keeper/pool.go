@@ -309,7 +309,7 @@ func (k Keeper) GetTotalPoolShares(ctx sdk.Context, poolId uint64) (osmomath.Int
 	return pool.GetTotalShares(), nil
 }
 
-// setStableSwapScalingFactors sets the stable swap scaling factors.
+// setStableSwapScalingFactors sets the stable swap scaling factors, cancelling any scaling factor ramp in progress.
 // errors if the pool does not exist, the sender is not the scaling factor controller, or due to other
 // internal errors.
 func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
@@ -325,6 +325,7 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 		return err
 	}
 
+	k.deleteScalingFactorRamp(ctx, poolId)
 	return k.setPool(ctx, stableswapPool)
 }
 
@@ -356,6 +357,25 @@ func asCFMMPool(pool poolmanagertypes.PoolI) (types.CFMMPoolI, error) {
 	return cfmmPool, nil
 }
 
+// GetPoolTypeGasCosts returns the base gas costs of swaps, joins and exits of the given gamm pool type.
+// This implements poolmanagertypes.PoolModuleGasCostsI.
+func (k Keeper) GetPoolTypeGasCosts(poolType poolmanagertypes.PoolType) poolmanagertypes.PoolTypeGasCosts {
+	if poolType == poolmanagertypes.Stableswap {
+		return poolmanagertypes.PoolTypeGasCosts{
+			PoolType: poolType,
+			SwapGas:  types.StableswapSwapGas,
+			JoinGas:  types.StableswapJoinGas,
+			ExitGas:  types.StableswapExitGas,
+		}
+	}
+	return poolmanagertypes.PoolTypeGasCosts{
+		PoolType: poolType,
+		SwapGas:  types.BalancerSwapGas,
+		JoinGas:  types.BalancerJoinGas,
+		ExitGas:  types.BalancerExitGas,
+	}
+}
+
 // GetTradingPairTakerFee is a wrapper for poolmanager's GetTradingPairTakerFee, and is solely used
 // to get access to this method for use in sim_msgs.go for the GAMM module.
 func (k Keeper) GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error) {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 
@@ -115,6 +116,7 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sen
 	if err := k.setPool(ctx, pool); err != nil {
 		return err
 	}
+	k.trackSmoothWeightChange(ctx, cfmmPool)
 
 	// N.B.: these hooks propagate to x/twap to create
 	// twap records at pool creation time.
@@ -194,6 +196,31 @@ func (k Keeper) JoinPoolNoSwap(
 	return neededLpLiquidity, sharesOut, err
 }
 
+// JoinPoolAndLock joins pool #{poolId} via JoinPoolNoSwap and locks all of the received shares
+// for the given duration on behalf of the sender. The lock ID of the newly created lock is returned.
+// If either the join or the lock creation fails, an error is returned & the message reverts.
+func (k Keeper) JoinPoolAndLock(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	shareOutAmount osmomath.Int,
+	tokenInMaxs sdk.Coins,
+	duration time.Duration,
+) (tokenIn sdk.Coins, sharesOut osmomath.Int, lockId uint64, err error) {
+	tokenIn, sharesOut, err = k.JoinPoolNoSwap(ctx, sender, poolId, shareOutAmount, tokenInMaxs)
+	if err != nil {
+		return nil, osmomath.ZeroInt(), 0, err
+	}
+
+	sharesToLock := sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolId), sharesOut))
+	lock, err := k.lockupKeeper.CreateLock(ctx, sender, sharesToLock, duration)
+	if err != nil {
+		return nil, osmomath.ZeroInt(), 0, err
+	}
+
+	return tokenIn, sharesOut, lock.ID, nil
+}
+
 // getMaximalNoSwapLPAmount returns the coins(lp liquidity) needed to get the specified amount of shares in the pool.
 // Steps to getting the needed lp liquidity coins needed for the share of the pools are
 // 1. calculate how much percent of the pool does given share account for(# of input shares / # of current total shares)
@@ -405,6 +432,174 @@ func (k Keeper) ExitSwapShareAmountIn(
 	return tokenOutAmount, nil
 }
 
+// ExitSwapShareAmountInMaxPriceImpact is an ExitSwapShareAmountIn that fails if the price impact of the exit
+// exceeds maxPriceImpact. The price impact is the largest relative decrease, over the pool's denoms swapped
+// into tokenOutDenom, of their spot price in tokenOutDenom from before the exit to after it.
+// Returns the amount of tokens gotten out and the price impact of the exit.
+func (k Keeper) ExitSwapShareAmountInMaxPriceImpact(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenOutDenom string,
+	shareInAmount osmomath.Int,
+	tokenOutMinAmount osmomath.Int,
+	maxPriceImpact osmomath.Dec,
+) (tokenOutAmount osmomath.Int, priceImpact osmomath.Dec, err error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	swappedDenoms := []string{}
+	spotPricesBefore := []osmomath.BigDec{}
+	for _, denom := range pool.GetPoolDenoms(ctx) {
+		if denom == tokenOutDenom {
+			continue
+		}
+		spotPrice, err := k.CalculateSpotPrice(ctx, poolId, tokenOutDenom, denom)
+		if err != nil {
+			return osmomath.Int{}, osmomath.Dec{}, err
+		}
+		swappedDenoms = append(swappedDenoms, denom)
+		spotPricesBefore = append(spotPricesBefore, spotPrice)
+	}
+
+	tokenOutAmount, err = k.ExitSwapShareAmountIn(ctx, sender, poolId, tokenOutDenom, shareInAmount, tokenOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	priceImpact = osmomath.ZeroDec()
+	for i, denom := range swappedDenoms {
+		spotPriceAfter, err := k.CalculateSpotPrice(ctx, poolId, tokenOutDenom, denom)
+		if err != nil {
+			return osmomath.Int{}, osmomath.Dec{}, err
+		}
+		if spotPriceAfter.GTE(spotPricesBefore[i]) {
+			continue
+		}
+		denomPriceImpact := spotPricesBefore[i].Sub(spotPriceAfter).QuoRoundUp(spotPricesBefore[i]).DecRoundUp()
+		priceImpact = osmomath.MaxDec(priceImpact, denomPriceImpact)
+	}
+
+	if priceImpact.GT(maxPriceImpact) {
+		return osmomath.Int{}, osmomath.Dec{}, errorsmod.Wrapf(types.ErrPriceImpactExceeded,
+			"exiting to %s has a price impact of %s, wanted a maximum of %s", tokenOutDenom, priceImpact, maxPriceImpact)
+	}
+	return tokenOutAmount, priceImpact, nil
+}
+
+// ExitSwapShareAmountInToDenoms is an Exit Pool transaction that will exit all of the provided LP shares,
+// and then swap the exited tokens of the pool's other denoms against the pool into tokenOutDenoms.
+// Each of these tokens is split evenly between tokenOutDenoms, so that the sender only receives the chosen denoms.
+// If the tokens gotten out are less than tokenOutMins, or if the price impact of the exit exceeds maxPriceImpact,
+// return an error. The price impact is the largest relative decrease, over the pairs of a swapped denom
+// and a token out denom, of the spot price of the swapped denom in the token out denom from before the exit to after it.
+// Returns the tokens gotten out and the price impact of the exit.
+func (k Keeper) ExitSwapShareAmountInToDenoms(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenOutDenoms []string,
+	shareInAmount osmomath.Int,
+	tokenOutMins sdk.Coins,
+	maxPriceImpact osmomath.Dec,
+) (tokensOut sdk.Coins, priceImpact osmomath.Dec, err error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, osmomath.Dec{}, err
+	}
+
+	poolDenoms := pool.GetPoolDenoms(ctx)
+	for _, denom := range tokenOutDenoms {
+		if !osmoutils.Contains(poolDenoms, denom) {
+			return sdk.Coins{}, osmomath.Dec{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "denom %s is not in pool %d", denom, poolId)
+		}
+	}
+
+	type denomPair struct {
+		swappedDenom  string
+		tokenOutDenom string
+	}
+	swappedPairs := []denomPair{}
+	spotPricesBefore := []osmomath.BigDec{}
+	for _, denom := range poolDenoms {
+		if osmoutils.Contains(tokenOutDenoms, denom) {
+			continue
+		}
+		for _, tokenOutDenom := range tokenOutDenoms {
+			spotPrice, err := k.CalculateSpotPrice(ctx, poolId, tokenOutDenom, denom)
+			if err != nil {
+				return sdk.Coins{}, osmomath.Dec{}, err
+			}
+			swappedPairs = append(swappedPairs, denomPair{swappedDenom: denom, tokenOutDenom: tokenOutDenom})
+			spotPricesBefore = append(spotPricesBefore, spotPrice)
+		}
+	}
+
+	exitCoins, err := k.ExitPool(ctx, sender, poolId, shareInAmount, sdk.Coins{})
+	if err != nil {
+		return sdk.Coins{}, osmomath.Dec{}, err
+	}
+
+	pool, err = k.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, osmomath.Dec{}, err
+	}
+	spreadFactor := pool.GetSpreadFactor(ctx)
+
+	tokensOut = sdk.NewCoins()
+	for _, coin := range exitCoins {
+		if osmoutils.Contains(tokenOutDenoms, coin.Denom) {
+			tokensOut = tokensOut.Add(coin)
+			continue
+		}
+
+		// The first token out denom gets the remainder of the split.
+		splitAmount := coin.Amount.QuoRaw(int64(len(tokenOutDenoms)))
+		remainder := coin.Amount.Sub(splitAmount.MulRaw(int64(len(tokenOutDenoms))))
+		for i, tokenOutDenom := range tokenOutDenoms {
+			tokenInAmount := splitAmount
+			if i == 0 {
+				tokenInAmount = tokenInAmount.Add(remainder)
+			}
+			if tokenInAmount.IsZero() {
+				continue
+			}
+			swapOut, err := k.SwapExactAmountIn(ctx, sender, pool, sdk.NewCoin(coin.Denom, tokenInAmount), tokenOutDenom, osmomath.ZeroInt(), spreadFactor)
+			if err != nil {
+				return sdk.Coins{}, osmomath.Dec{}, err
+			}
+			tokensOut = tokensOut.Add(sdk.NewCoin(tokenOutDenom, swapOut))
+		}
+	}
+
+	if !tokenOutMins.DenomsSubsetOf(tokensOut) || tokenOutMins.IsAnyGT(tokensOut) {
+		return sdk.Coins{}, osmomath.Dec{}, errorsmod.Wrapf(types.ErrLimitMinAmount,
+			"Provided LP shares yield %s tokens out, wanted a minimum of %s for it to work",
+			tokensOut, tokenOutMins)
+	}
+
+	priceImpact = osmomath.ZeroDec()
+	for i, pair := range swappedPairs {
+		spotPriceAfter, err := k.CalculateSpotPrice(ctx, poolId, pair.tokenOutDenom, pair.swappedDenom)
+		if err != nil {
+			return sdk.Coins{}, osmomath.Dec{}, err
+		}
+		if spotPriceAfter.GTE(spotPricesBefore[i]) {
+			continue
+		}
+		pairPriceImpact := spotPricesBefore[i].Sub(spotPriceAfter).QuoRoundUp(spotPricesBefore[i]).DecRoundUp()
+		priceImpact = osmomath.MaxDec(priceImpact, pairPriceImpact)
+	}
+
+	if priceImpact.GT(maxPriceImpact) {
+		return sdk.Coins{}, osmomath.Dec{}, errorsmod.Wrapf(types.ErrPriceImpactExceeded,
+			"exiting to %v has a price impact of %s, wanted a maximum of %s", tokenOutDenoms, priceImpact, maxPriceImpact)
+	}
+	return tokensOut, priceImpact, nil
+}
+
 func (k Keeper) ExitSwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
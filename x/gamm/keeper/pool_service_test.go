@@ -968,3 +968,169 @@ func (s *KeeperTestSuite) TestGetPoolDenom() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestExitSwapShareAmountInMaxPriceImpact() {
+	tests := map[string]struct {
+		// sharesInPercent is the percentage of the pool's shares exited.
+		sharesInPercent     int64
+		maxPriceImpact      osmomath.Dec
+		expectedPriceImpact osmomath.Dec
+		expectedErr         error
+	}{
+		"small exit within max price impact": {
+			sharesInPercent:     1,
+			maxPriceImpact:      osmomath.MustNewDecFromStr("0.05"),
+			expectedPriceImpact: osmomath.MustNewDecFromStr("0.02"),
+		},
+		"large exit above max price impact": {
+			sharesInPercent: 10,
+			maxPriceImpact:  osmomath.MustNewDecFromStr("0.05"),
+			expectedErr:     types.ErrPriceImpactExceeded,
+		},
+		"large exit within a loose max price impact": {
+			sharesInPercent:     10,
+			maxPriceImpact:      osmomath.MustNewDecFromStr("0.5"),
+			expectedPriceImpact: osmomath.MustNewDecFromStr("0.19"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			gammKeeper := s.App.GAMMKeeper
+			testAccount := s.TestAccs[0]
+
+			poolId := s.prepareCustomBalancerPool(
+				defaultAcctFunds,
+				[]balancer.PoolAsset{
+					{
+						Weight: osmomath.NewInt(100),
+						Token:  sdk.NewCoin("foo", osmomath.NewInt(5000000)),
+					},
+					{
+						Weight: osmomath.NewInt(100),
+						Token:  sdk.NewCoin("bar", osmomath.NewInt(5000000)),
+					},
+				},
+				balancer.PoolParams{
+					SwapFee: osmomath.ZeroDec(),
+					ExitFee: osmomath.ZeroDec(),
+				},
+			)
+			pool, err := gammKeeper.GetCFMMPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			shareInAmount := pool.GetTotalShares().MulRaw(tc.sharesInPercent).QuoRaw(100)
+
+			// System under test.
+			tokenOutAmount, priceImpact, err := gammKeeper.ExitSwapShareAmountInMaxPriceImpact(s.Ctx, testAccount, poolId, "foo", shareInAmount, osmomath.OneInt(), tc.maxPriceImpact)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(tokenOutAmount.IsPositive())
+			s.Require().True(priceImpact.LTE(tc.maxPriceImpact))
+			// the price impact of the exit is about the expected one.
+			s.Require().True(priceImpact.Sub(tc.expectedPriceImpact).Abs().LT(osmomath.MustNewDecFromStr("0.005")), "price impact %s", priceImpact)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestExitSwapShareAmountInToDenoms() {
+	tests := map[string]struct {
+		// sharesInPercent is the percentage of the pool's shares exited.
+		sharesInPercent int64
+		tokenOutDenoms  []string
+		tokenOutMins    sdk.Coins
+		maxPriceImpact  osmomath.Dec
+		expectedErr     error
+	}{
+		"small exit to two denoms within max price impact": {
+			sharesInPercent: 1,
+			tokenOutDenoms:  []string{"foo", "bar"},
+			tokenOutMins:    sdk.NewCoins(sdk.NewInt64Coin("foo", 1), sdk.NewInt64Coin("bar", 1)),
+			maxPriceImpact:  osmomath.MustNewDecFromStr("0.05"),
+		},
+		"small exit to a single denom within max price impact": {
+			sharesInPercent: 1,
+			tokenOutDenoms:  []string{"foo"},
+			tokenOutMins:    sdk.NewCoins(),
+			maxPriceImpact:  osmomath.MustNewDecFromStr("0.05"),
+		},
+		"exit to all the pool's denoms has no price impact": {
+			sharesInPercent: 10,
+			tokenOutDenoms:  []string{"foo", "bar", "baz", appparams.BaseCoinUnit},
+			tokenOutMins:    sdk.NewCoins(),
+			maxPriceImpact:  osmomath.MustNewDecFromStr("0.0001"),
+		},
+		"large exit above max price impact": {
+			sharesInPercent: 10,
+			tokenOutDenoms:  []string{"foo", "bar"},
+			tokenOutMins:    sdk.NewCoins(),
+			maxPriceImpact:  osmomath.MustNewDecFromStr("0.05"),
+			expectedErr:     types.ErrPriceImpactExceeded,
+		},
+		"min amount out not met": {
+			sharesInPercent: 1,
+			tokenOutDenoms:  []string{"foo", "bar"},
+			tokenOutMins:    sdk.NewCoins(sdk.NewInt64Coin("foo", 5000000)),
+			maxPriceImpact:  osmomath.MustNewDecFromStr("0.05"),
+			expectedErr:     types.ErrLimitMinAmount,
+		},
+		"token out denom not in pool": {
+			sharesInPercent: 1,
+			tokenOutDenoms:  []string{"foo", "qux"},
+			tokenOutMins:    sdk.NewCoins(),
+			maxPriceImpact:  osmomath.MustNewDecFromStr("0.05"),
+			expectedErr:     types.ErrDenomNotFoundInPool,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			gammKeeper := s.App.GAMMKeeper
+			testAccount := s.TestAccs[0]
+
+			poolAssets := []balancer.PoolAsset{}
+			for _, denom := range []string{"foo", "bar", "baz", appparams.BaseCoinUnit} {
+				poolAssets = append(poolAssets, balancer.PoolAsset{
+					Weight: osmomath.NewInt(100),
+					Token:  sdk.NewCoin(denom, osmomath.NewInt(5000000)),
+				})
+			}
+			poolId := s.prepareCustomBalancerPool(
+				defaultAcctFunds,
+				poolAssets,
+				balancer.PoolParams{
+					SwapFee: osmomath.ZeroDec(),
+					ExitFee: osmomath.ZeroDec(),
+				},
+			)
+			pool, err := gammKeeper.GetCFMMPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			shareInAmount := pool.GetTotalShares().MulRaw(tc.sharesInPercent).QuoRaw(100)
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+
+			// System under test.
+			tokensOut, priceImpact, err := gammKeeper.ExitSwapShareAmountInToDenoms(s.Ctx, testAccount, poolId, tc.tokenOutDenoms, shareInAmount, tc.tokenOutMins, tc.maxPriceImpact)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(priceImpact.LTE(tc.maxPriceImpact))
+
+			// Only the token out denoms are received, in the returned amounts.
+			s.Require().Len(tokensOut, len(tc.tokenOutDenoms))
+			balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+			for _, denom := range []string{"foo", "bar", "baz", appparams.BaseCoinUnit} {
+				received := balancesAfter.AmountOf(denom).Sub(balancesBefore.AmountOf(denom))
+				s.Require().Equal(tokensOut.AmountOf(denom), received, "denom %s", denom)
+			}
+			s.Require().True(tokensOut.IsAllGTE(tc.tokenOutMins))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// GetPoolSnapshot returns a read-only snapshot of the state of the given pool, with the weights
+// of weighted pools updated to the current block time. It does not mutate state.
+func (k Keeper) GetPoolSnapshot(ctx sdk.Context, poolId uint64) (types.PoolSnapshot, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return types.PoolSnapshot{}, err
+	}
+
+	reserves := pool.GetTotalPoolLiquidity(ctx)
+	snapshot := types.PoolSnapshot{
+		PoolId:         poolId,
+		PoolType:       pool.GetType(),
+		Reserves:       reserves,
+		Weights:        []types.DenomWeight{},
+		ScalingFactors: []uint64{},
+		SpreadFactor:   pool.GetSpreadFactor(ctx),
+		ExitFee:        pool.GetExitFee(ctx),
+		TotalShares:    sdk.NewCoin(types.GetPoolShareDenom(poolId), pool.GetTotalShares()),
+	}
+
+	switch p := pool.(type) {
+	case *stableswap.Pool:
+		for _, coin := range reserves {
+			snapshot.ScalingFactors = append(snapshot.ScalingFactors, p.GetScalingFactorByDenom(coin.Denom))
+		}
+	case types.WeightedPoolExtension:
+		for _, coin := range reserves {
+			weight, err := p.GetTokenWeight(coin.Denom)
+			if err != nil {
+				return types.PoolSnapshot{}, err
+			}
+			snapshot.Weights = append(snapshot.Weights, types.DenomWeight{Denom: coin.Denom, Weight: weight})
+		}
+	}
+
+	return snapshot, nil
+}
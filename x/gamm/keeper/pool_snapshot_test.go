@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestGetPoolSnapshot() {
+	s.Run("balancer pool", func() {
+		s.SetupTest()
+		poolId := s.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
+			SwapFee: osmomath.MustNewDecFromStr("0.003"),
+			ExitFee: osmomath.ZeroDec(),
+		})
+		pool, err := s.App.GAMMKeeper.GetCFMMPool(s.Ctx, poolId)
+		s.Require().NoError(err)
+		balancerPool := pool.(*balancer.Pool)
+
+		snapshot, err := s.App.GAMMKeeper.GetPoolSnapshot(s.Ctx, poolId)
+		s.Require().NoError(err)
+
+		s.Require().Equal(poolId, snapshot.PoolId)
+		s.Require().Equal(poolmanagertypes.Balancer, snapshot.PoolType)
+		s.Require().Equal(pool.GetTotalPoolLiquidity(s.Ctx), snapshot.Reserves)
+		s.Require().Len(snapshot.Weights, len(snapshot.Reserves))
+		for i, coin := range snapshot.Reserves {
+			weight, err := balancerPool.GetTokenWeight(coin.Denom)
+			s.Require().NoError(err)
+			s.Require().Equal(types.DenomWeight{Denom: coin.Denom, Weight: weight}, snapshot.Weights[i])
+		}
+		s.Require().Empty(snapshot.ScalingFactors)
+		s.Require().Equal(osmomath.MustNewDecFromStr("0.003"), snapshot.SpreadFactor)
+		s.Require().Equal(osmomath.ZeroDec(), snapshot.ExitFee)
+		s.Require().Equal(sdk.NewCoin(types.GetPoolShareDenom(poolId), pool.GetTotalShares()), snapshot.TotalShares)
+	})
+
+	s.Run("stableswap pool", func() {
+		s.SetupTest()
+		poolId := s.PrepareImbalancedStableswapPool()
+
+		snapshot, err := s.App.GAMMKeeper.GetPoolSnapshot(s.Ctx, poolId)
+		s.Require().NoError(err)
+
+		s.Require().Equal(poolmanagertypes.Stableswap, snapshot.PoolType)
+		s.Require().Equal(apptesting.ImbalancedStableswapLiquidity, snapshot.Reserves)
+		s.Require().Empty(snapshot.Weights)
+		s.Require().Equal([]uint64{1, 1, 1}, snapshot.ScalingFactors)
+	})
+
+	s.Run("pool does not exist", func() {
+		s.SetupTest()
+
+		_, err := s.App.GAMMKeeper.GetPoolSnapshot(s.Ctx, 1)
+		s.Require().ErrorIs(err, types.PoolDoesNotExistError{PoolId: 1})
+	})
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// SetPoolRebalancingFeeDiscount sets the spread factor discount of the given pool for swaps
+// that move its weighted balances toward each other. A discount of zero removes it from the pool.
+func (k Keeper) SetPoolRebalancingFeeDiscount(ctx sdk.Context, poolId uint64, discount osmomath.Dec) error {
+	if err := types.ValidateRebalancingFeeDiscount(discount); err != nil {
+		return err
+	}
+
+	pool, err := k.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if discount.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(types.GetKeyPrefixPoolRebalancingFeeDiscount(poolId))
+		return nil
+	}
+
+	for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+		if _, err := getRebalancingWeight(pool, coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	k.setPoolRebalancingFeeDiscount(ctx, types.PoolRebalancingFeeDiscount{
+		PoolId:   poolId,
+		Discount: discount,
+	})
+	return nil
+}
+
+// GetPoolRebalancingFeeDiscount returns the rebalancing fee discount of the given pool.
+// Returns false if the pool has no rebalancing fee discount set.
+func (k Keeper) GetPoolRebalancingFeeDiscount(ctx sdk.Context, poolId uint64) (types.PoolRebalancingFeeDiscount, bool, error) {
+	discount := types.PoolRebalancingFeeDiscount{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyPrefixPoolRebalancingFeeDiscount(poolId), &discount)
+	if err != nil {
+		return types.PoolRebalancingFeeDiscount{}, false, err
+	}
+	return discount, found, nil
+}
+
+// GetAllPoolRebalancingFeeDiscounts returns the rebalancing fee discounts of all pools.
+func (k Keeper) GetAllPoolRebalancingFeeDiscounts(ctx sdk.Context) ([]types.PoolRebalancingFeeDiscount, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixPoolRebalancingFeeDiscount, func(bz []byte) (types.PoolRebalancingFeeDiscount, error) {
+		discount := types.PoolRebalancingFeeDiscount{}
+		err := k.cdc.Unmarshal(bz, &discount)
+		return discount, err
+	})
+}
+
+func (k Keeper) setPoolRebalancingFeeDiscount(ctx sdk.Context, discount types.PoolRebalancingFeeDiscount) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.GetKeyPrefixPoolRebalancingFeeDiscount(discount.PoolId), &discount)
+}
+
+// getRebalancingWeight returns the weight the balance of denom is divided by to get its weighted balance.
+// For balancer pools this is the token's weight, for stableswap pools it is the token's scaling factor,
+// so that the weighted balances of a pool at its target weights or peg are equal.
+func getRebalancingWeight(pool types.CFMMPoolI, denom string) (osmomath.Int, error) {
+	switch p := pool.(type) {
+	case *stableswap.Pool:
+		scalingFactor := p.GetScalingFactorByDenom(denom)
+		if scalingFactor == 0 {
+			return osmomath.Int{}, fmt.Errorf("denom %s does not have a scaling factor in pool %d", denom, p.GetId())
+		}
+		return osmomath.NewIntFromUint64(scalingFactor), nil
+	case types.WeightedPoolExtension:
+		return p.GetTokenWeight(denom)
+	default:
+		return osmomath.Int{}, fmt.Errorf("pool %d of type %T does not support rebalancing fee discounts", pool.GetId(), pool)
+	}
+}
+
+// getRebalancingSpreadFactor returns the spread factor to charge for swapping tokenInDenom for tokenOutDenom
+// in the pool, given the pool's state before the swap. calcSwap is expected to return the tokens in and out
+// of the swap at the given spread factor, without updating the pool.
+//
+// If the pool has a rebalancing fee discount, tokenInDenom has the lower weighted balance of the two denoms,
+// and swapping at the discounted spread factor leaves it no higher than the weighted balance of tokenOutDenom,
+// the swap restores the pool toward its target and the discounted spread factor is returned.
+// Otherwise, including when the swap would overshoot the target, spreadFactor is returned unchanged.
+func (k Keeper) getRebalancingSpreadFactor(
+	ctx sdk.Context,
+	pool types.CFMMPoolI,
+	tokenInDenom string,
+	tokenOutDenom string,
+	spreadFactor osmomath.Dec,
+	calcSwap func(spreadFactor osmomath.Dec) (tokenIn, tokenOut sdk.Coin, err error),
+) (osmomath.Dec, error) {
+	discount, found, err := k.GetPoolRebalancingFeeDiscount(ctx, pool.GetId())
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if !found || spreadFactor.IsZero() {
+		return spreadFactor, nil
+	}
+
+	weightIn, err := getRebalancingWeight(pool, tokenInDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	weightOut, err := getRebalancingWeight(pool, tokenOutDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	// balanceIn / weightIn < balanceOut / weightOut, cross multiplied to avoid rounding.
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	balanceIn, balanceOut := liquidity.AmountOf(tokenInDenom), liquidity.AmountOf(tokenOutDenom)
+	if balanceIn.Mul(weightOut).GTE(balanceOut.Mul(weightIn)) {
+		return spreadFactor, nil
+	}
+
+	discountedSpreadFactor := spreadFactor.Mul(osmomath.OneDec().Sub(discount.Discount))
+	tokenIn, tokenOut, err := calcSwap(discountedSpreadFactor)
+	if err != nil {
+		// the swap itself surfaces the error.
+		return spreadFactor, nil
+	}
+
+	balanceIn, balanceOut = balanceIn.Add(tokenIn.Amount), balanceOut.Sub(tokenOut.Amount)
+	if balanceIn.Mul(weightOut).GT(balanceOut.Mul(weightIn)) {
+		return spreadFactor, nil
+	}
+	return discountedSpreadFactor, nil
+}
+
+// getRebalancingSpreadFactorGivenIn returns the spread factor to charge for swapping an exact tokenIn
+// for tokenOutDenom in the pool. See getRebalancingSpreadFactor.
+func (k Keeper) getRebalancingSpreadFactorGivenIn(ctx sdk.Context, pool types.CFMMPoolI, tokenIn sdk.Coin, tokenOutDenom string, spreadFactor osmomath.Dec) (osmomath.Dec, error) {
+	return k.getRebalancingSpreadFactor(ctx, pool, tokenIn.Denom, tokenOutDenom, spreadFactor, func(spreadFactor osmomath.Dec) (sdk.Coin, sdk.Coin, error) {
+		tokenOut, err := pool.CalcOutAmtGivenIn(ctx, sdk.Coins{tokenIn}, tokenOutDenom, spreadFactor)
+		return tokenIn, tokenOut, err
+	})
+}
+
+// getRebalancingSpreadFactorGivenOut returns the spread factor to charge for swapping tokenInDenom
+// for an exact tokenOut in the pool. See getRebalancingSpreadFactor.
+func (k Keeper) getRebalancingSpreadFactorGivenOut(ctx sdk.Context, pool types.CFMMPoolI, tokenInDenom string, tokenOut sdk.Coin, spreadFactor osmomath.Dec) (osmomath.Dec, error) {
+	return k.getRebalancingSpreadFactor(ctx, pool, tokenInDenom, tokenOut.Denom, spreadFactor, func(spreadFactor osmomath.Dec) (sdk.Coin, sdk.Coin, error) {
+		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, spreadFactor)
+		return tokenIn, tokenOut, err
+	})
+}
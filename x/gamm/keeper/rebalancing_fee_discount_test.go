@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+func (s *KeeperTestSuite) TestSetPoolRebalancingFeeDiscount() {
+	tests := map[string]struct {
+		poolId      uint64
+		discount    osmomath.Dec
+		expectedErr error
+	}{
+		"valid discount": {
+			poolId:   1,
+			discount: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"full discount": {
+			poolId:   1,
+			discount: osmomath.OneDec(),
+		},
+		"zero discount removes it": {
+			poolId:   1,
+			discount: osmomath.ZeroDec(),
+		},
+		"discount above one": {
+			poolId:      1,
+			discount:    osmomath.MustNewDecFromStr("1.1"),
+			expectedErr: types.ErrInvalidRebalancingDiscount,
+		},
+		"negative discount": {
+			poolId:      1,
+			discount:    osmomath.MustNewDecFromStr("-0.1"),
+			expectedErr: types.ErrInvalidRebalancingDiscount,
+		},
+		"pool does not exist": {
+			poolId:      2,
+			discount:    osmomath.MustNewDecFromStr("0.5"),
+			expectedErr: types.PoolDoesNotExistError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.PrepareBalancerPool()
+
+			err := s.App.GAMMKeeper.SetPoolRebalancingFeeDiscount(s.Ctx, tc.poolId, tc.discount)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			discount, found, err := s.App.GAMMKeeper.GetPoolRebalancingFeeDiscount(s.Ctx, poolId)
+			s.Require().NoError(err)
+			if tc.discount.IsZero() {
+				s.Require().False(found)
+				return
+			}
+			s.Require().True(found)
+			s.Require().Equal(tc.discount, discount.Discount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRebalancingFeeDiscount_Swap() {
+	spreadFactor := osmomath.MustNewDecFromStr("0.01")
+	discountedSpreadFactor := osmomath.MustNewDecFromStr("0.005")
+
+	tests := map[string]struct {
+		tokenIn              sdk.Coin
+		tokenOutDenom        string
+		noDiscount           bool
+		expectedSpreadFactor osmomath.Dec
+	}{
+		"swapping in the underweight denom: discounted": {
+			tokenIn:              sdk.NewInt64Coin(apptesting.FOO, 1000),
+			tokenOutDenom:        apptesting.BAR,
+			expectedSpreadFactor: discountedSpreadFactor,
+		},
+		"swapping in the overweight denom: not discounted": {
+			tokenIn:              sdk.NewInt64Coin(apptesting.BAR, 1000),
+			tokenOutDenom:        apptesting.FOO,
+			expectedSpreadFactor: spreadFactor,
+		},
+		"swap overshooting the target: not discounted": {
+			tokenIn:              sdk.NewInt64Coin(apptesting.FOO, 1_000_000),
+			tokenOutDenom:        apptesting.BAR,
+			expectedSpreadFactor: spreadFactor,
+		},
+		"pool without discount: not discounted": {
+			tokenIn:              sdk.NewInt64Coin(apptesting.FOO, 1000),
+			tokenOutDenom:        apptesting.BAR,
+			noDiscount:           true,
+			expectedSpreadFactor: spreadFactor,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			// foo has half the weighted balance of bar.
+			poolId := s.PrepareCustomBalancerPoolFromCoins(
+				sdk.NewCoins(sdk.NewInt64Coin(apptesting.FOO, 1_000_000), sdk.NewInt64Coin(apptesting.BAR, 2_000_000)),
+				balancer.PoolParams{SwapFee: spreadFactor, ExitFee: osmomath.ZeroDec()},
+			)
+			gammKeeper := s.App.GAMMKeeper
+
+			if !tc.noDiscount {
+				err := gammKeeper.SetPoolRebalancingFeeDiscount(s.Ctx, poolId, osmomath.MustNewDecFromStr("0.5"))
+				s.Require().NoError(err)
+			}
+
+			pool, err := gammKeeper.GetCFMMPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			expectedTokenOut, err := pool.CalcOutAmtGivenIn(s.Ctx, sdk.Coins{tc.tokenIn}, tc.tokenOutDenom, tc.expectedSpreadFactor)
+			s.Require().NoError(err)
+
+			tokenOut, err := gammKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tc.tokenIn, tc.tokenOutDenom, spreadFactor)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut, tokenOut)
+
+			expectedTokenIn, err := pool.CalcInAmtGivenOut(s.Ctx, sdk.Coins{expectedTokenOut}, tc.tokenIn.Denom, tc.expectedSpreadFactor)
+			s.Require().NoError(err)
+
+			tokenIn, err := gammKeeper.CalcInAmtGivenOut(s.Ctx, pool, expectedTokenOut, tc.tokenIn.Denom, spreadFactor)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenIn, tokenIn)
+
+			s.FundAcc(s.TestAccs[0], sdk.Coins{tc.tokenIn})
+			tokenOutAmount, err := gammKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], pool, tc.tokenIn, tc.tokenOutDenom, osmomath.OneInt(), spreadFactor)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut.Amount, tokenOutAmount)
+		})
+	}
+}
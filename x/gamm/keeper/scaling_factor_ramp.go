@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// RampStableSwapScalingFactors starts a linear ramp of the given stableswap pool's scaling factors
+// from their current values to the given scaling factors over rampDuration, replacing any ramp in progress.
+// The pool's scaling factors are updated at the beginning of every block until the ramp ends.
+// errors if the pool does not exist, is not a stableswap pool, the sender is not the scaling factor controller,
+// or the given scaling factors are invalid for the pool.
+func (k Keeper) RampStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, rampDuration time.Duration, sender string) error {
+	if rampDuration <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidScalingFactorRamp, "ramp duration must be positive, got %s", rampDuration)
+	}
+
+	stableswapPool, err := k.getStableswapPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	targetScalingFactors, err := stableswapPool.ValidateScalingFactorsUpdate(scalingFactors, sender)
+	if err != nil {
+		return err
+	}
+
+	k.setScalingFactorRamp(ctx, types.ScalingFactorRamp{
+		PoolId:               poolId,
+		StartScalingFactors:  stableswapPool.GetScalingFactors(),
+		TargetScalingFactors: targetScalingFactors,
+		StartTime:            ctx.BlockTime(),
+		EndTime:              ctx.BlockTime().Add(rampDuration),
+	})
+	return nil
+}
+
+// GetScalingFactorRamp returns the scaling factor ramp in progress for the given pool.
+// Returns false if the pool has no scaling factor ramp in progress.
+func (k Keeper) GetScalingFactorRamp(ctx sdk.Context, poolId uint64) (types.ScalingFactorRamp, bool, error) {
+	ramp := types.ScalingFactorRamp{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyPrefixScalingFactorRamp(poolId), &ramp)
+	if err != nil {
+		return types.ScalingFactorRamp{}, false, err
+	}
+	return ramp, found, nil
+}
+
+// GetAllScalingFactorRamps returns the scaling factor ramps in progress for all pools.
+func (k Keeper) GetAllScalingFactorRamps(ctx sdk.Context) ([]types.ScalingFactorRamp, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixScalingFactorRamp, func(bz []byte) (types.ScalingFactorRamp, error) {
+		ramp := types.ScalingFactorRamp{}
+		err := k.cdc.Unmarshal(bz, &ramp)
+		return ramp, err
+	})
+}
+
+func (k Keeper) setScalingFactorRamp(ctx sdk.Context, ramp types.ScalingFactorRamp) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.GetKeyPrefixScalingFactorRamp(ramp.PoolId), &ramp)
+}
+
+func (k Keeper) deleteScalingFactorRamp(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetKeyPrefixScalingFactorRamp(poolId))
+}
+
+// UpdateScalingFactorRamps sets the scaling factors of every pool with a ramp in progress to the
+// ramp's scaling factors at the block time, and removes the ramps that have ended.
+// A ramp whose scaling factors can no longer be applied to its pool, e.g. because the pool's liquidity
+// changed such that they are out of bounds, is cancelled, leaving the pool at its last scaling factors.
+func (k Keeper) UpdateScalingFactorRamps(ctx sdk.Context) {
+	ramps, err := k.GetAllScalingFactorRamps(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to get scaling factor ramps", "error", err)
+		return
+	}
+
+	for _, ramp := range ramps {
+		if err := k.updateScalingFactorRamp(ctx, ramp); err != nil {
+			ctx.Logger().Error("cancelling scaling factor ramp", "pool_id", ramp.PoolId, "error", err)
+			k.deleteScalingFactorRamp(ctx, ramp.PoolId)
+			continue
+		}
+
+		if !ctx.BlockTime().Before(ramp.EndTime) {
+			k.deleteScalingFactorRamp(ctx, ramp.PoolId)
+		}
+	}
+}
+
+func (k Keeper) updateScalingFactorRamp(ctx sdk.Context, ramp types.ScalingFactorRamp) error {
+	stableswapPool, err := k.getStableswapPool(ctx, ramp.PoolId)
+	if err != nil {
+		return err
+	}
+
+	scalingFactors := ramp.ScalingFactorsAt(ctx.BlockTime())
+	if slices.Equal(scalingFactors, stableswapPool.GetScalingFactors()) {
+		return nil
+	}
+
+	if err := stableswapPool.SetRampedScalingFactors(scalingFactors); err != nil {
+		return err
+	}
+	return k.setPool(ctx, stableswapPool)
+}
+
+// getStableswapPool returns the given pool, erroring if it is not a stableswap pool.
+func (k Keeper) getStableswapPool(ctx sdk.Context, poolId uint64) (*stableswap.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	return stableswapPool, nil
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// prepareRampStableswapPool creates a stableswap pool with scaling factors of 100 and sets
+// the first test account as its scaling factor controller.
+func (s *KeeperTestSuite) prepareRampStableswapPool() uint64 {
+	poolId := s.prepareCustomStableswapPool(
+		defaultAcctFunds,
+		stableswap.PoolParams{
+			SwapFee: defaultSpreadFactor,
+			ExitFee: defaultZeroExitFee,
+		},
+		sdk.NewCoins(sdk.NewCoin(defaultAcctFunds[0].Denom, defaultAcctFunds[0].Amount.QuoRaw(2)), sdk.NewCoin(defaultAcctFunds[1].Denom, defaultAcctFunds[1].Amount.QuoRaw(2))),
+		[]uint64{100, 100},
+	)
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	stableswapPool := pool.(*stableswap.Pool)
+	stableswapPool.ScalingFactorController = s.TestAccs[0].String()
+	err = s.App.GAMMKeeper.SetPool(s.Ctx, stableswapPool)
+	s.Require().NoError(err)
+	return poolId
+}
+
+func (s *KeeperTestSuite) getScalingFactors(poolId uint64) []uint64 {
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	return pool.(*stableswap.Pool).GetScalingFactors()
+}
+
+func (s *KeeperTestSuite) TestRampStableSwapScalingFactors() {
+	tests := map[string]struct {
+		sender         sdk.AccAddress
+		scalingFactors []uint64
+		rampDuration   time.Duration
+		expectedErr    error
+	}{
+		"valid ramp": {
+			sender:         s.TestAccs[0],
+			scalingFactors: []uint64{100, 300},
+			rampDuration:   100 * time.Second,
+		},
+		"sender is not the controller": {
+			sender:         s.TestAccs[1],
+			scalingFactors: []uint64{100, 300},
+			rampDuration:   100 * time.Second,
+			expectedErr:    types.ErrNotScalingFactorGovernor,
+		},
+		"zero ramp duration": {
+			sender:         s.TestAccs[0],
+			scalingFactors: []uint64{100, 300},
+			expectedErr:    types.ErrInvalidScalingFactorRamp,
+		},
+		"wrong number of scaling factors": {
+			sender:         s.TestAccs[0],
+			scalingFactors: []uint64{100},
+			rampDuration:   100 * time.Second,
+			expectedErr:    types.ErrInvalidScalingFactorLength,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.prepareRampStableswapPool()
+
+			err := s.App.GAMMKeeper.RampStableSwapScalingFactors(s.Ctx, poolId, tc.scalingFactors, tc.rampDuration, tc.sender.String())
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				_, found, err := s.App.GAMMKeeper.GetScalingFactorRamp(s.Ctx, poolId)
+				s.Require().NoError(err)
+				s.Require().False(found)
+				return
+			}
+			s.Require().NoError(err)
+
+			ramp, found, err := s.App.GAMMKeeper.GetScalingFactorRamp(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(poolId, ramp.PoolId)
+			s.Require().Equal([]uint64{100, 100}, ramp.StartScalingFactors)
+			s.Require().Equal(tc.scalingFactors, ramp.TargetScalingFactors)
+			s.Require().True(s.Ctx.BlockTime().Equal(ramp.StartTime))
+			s.Require().True(s.Ctx.BlockTime().Add(tc.rampDuration).Equal(ramp.EndTime))
+
+			// the scaling factors only change once the ramp is applied.
+			s.Require().Equal([]uint64{100, 100}, s.getScalingFactors(poolId))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateScalingFactorRamps() {
+	s.SetupTest()
+	poolId := s.prepareRampStableswapPool()
+	gammKeeper := s.App.GAMMKeeper
+	startTime := s.Ctx.BlockTime()
+
+	err := gammKeeper.RampStableSwapScalingFactors(s.Ctx, poolId, []uint64{100, 300}, 100*time.Second, s.TestAccs[0].String())
+	s.Require().NoError(err)
+
+	// Halfway through the ramp, the scaling factors are halfway to their targets.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(50 * time.Second))
+	gammKeeper.UpdateScalingFactorRamps(s.Ctx)
+	s.Require().Equal([]uint64{100, 200}, s.getScalingFactors(poolId))
+
+	res, err := s.queryClient.ScalingFactorRamp(s.Ctx, &types.QueryScalingFactorRampRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{100, 200}, res.ScalingFactors)
+	s.Require().NotNil(res.Ramp)
+	s.Require().Equal([]uint64{100, 300}, res.Ramp.TargetScalingFactors)
+
+	// At the end of the ramp, the scaling factors reach their targets and the ramp is removed.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(100 * time.Second))
+	gammKeeper.UpdateScalingFactorRamps(s.Ctx)
+	s.Require().Equal([]uint64{100, 300}, s.getScalingFactors(poolId))
+
+	res, err = s.queryClient.ScalingFactorRamp(s.Ctx, &types.QueryScalingFactorRampRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{100, 300}, res.ScalingFactors)
+	s.Require().Nil(res.Ramp)
+
+	// Adjusting the scaling factors instantly cancels a ramp in progress.
+	err = gammKeeper.RampStableSwapScalingFactors(s.Ctx, poolId, []uint64{100, 100}, 100*time.Second, s.TestAccs[0].String())
+	s.Require().NoError(err)
+	err = gammKeeper.SetStableSwapScalingFactors(s.Ctx, poolId, []uint64{100, 250}, s.TestAccs[0].String())
+	s.Require().NoError(err)
+	_, found, err := gammKeeper.GetScalingFactorRamp(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(200 * time.Second))
+	gammKeeper.UpdateScalingFactorRamps(s.Ctx)
+	s.Require().Equal([]uint64{100, 250}, s.getScalingFactors(poolId))
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// sentinelExistsValue is stored for keys that only index a pool, for the store to not register it as a delete.
+var sentinelExistsValue = []byte{1}
+
+// trackSmoothWeightChange indexes the pool if it is a balancer pool with a smooth weight change in progress,
+// so that the completion of the weight change is picked up by CompleteSmoothWeightChanges.
+func (k Keeper) trackSmoothWeightChange(ctx sdk.Context, pool types.CFMMPoolI) {
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok || balancerPool.PoolParams.SmoothWeightChangeParams == nil {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetKeyPrefixSmoothWeightChange(pool.GetId()), sentinelExistsValue)
+}
+
+// BackfillSmoothWeightChanges indexes the existing balancer pools that have a smooth weight change in progress,
+// including the ones whose weight change ended but were not persisted with their target weights yet,
+// so that CompleteSmoothWeightChanges completes them. The pools are read without poking them,
+// as poking clears the weight change params of pools past their end time.
+func (k Keeper) BackfillSmoothWeightChanges(ctx sdk.Context) error {
+	iter := k.iterator(ctx, types.KeyPrefixPools)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		pool, err := k.UnmarshalPool(iter.Value())
+		if err != nil {
+			return err
+		}
+		k.trackSmoothWeightChange(ctx, pool)
+	}
+	return nil
+}
+
+// getSmoothWeightChangePoolIds returns the ids of the pools indexed as having a smooth weight change in progress.
+func (k Keeper) getSmoothWeightChangePoolIds(ctx sdk.Context) ([]uint64, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyPrefixSmoothWeightChange, func(key, _ []byte) (uint64, error) {
+		return sdk.BigEndianToUint64(key[len(types.KeyPrefixSmoothWeightChange):]), nil
+	})
+}
+
+// CompleteSmoothWeightChanges persists the target weights of every balancer pool whose smooth weight change
+// completed by the block time, i.e. the first block after the end of the weight change, emits an event,
+// and calls the AfterSmoothWeightChangeCompleted hook for it.
+// Indexed pools that no longer exist are dropped from the index without an event.
+func (k Keeper) CompleteSmoothWeightChanges(ctx sdk.Context) {
+	poolIds, err := k.getSmoothWeightChangePoolIds(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to get pools with a smooth weight change", "error", err)
+		return
+	}
+
+	for _, poolId := range poolIds {
+		completed, err := k.completeSmoothWeightChange(ctx, poolId)
+		if err != nil {
+			ctx.Logger().Error("dropping smooth weight change", "pool_id", poolId, "error", err)
+			ctx.KVStore(k.storeKey).Delete(types.GetKeyPrefixSmoothWeightChange(poolId))
+			continue
+		}
+		if !completed {
+			continue
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.GetKeyPrefixSmoothWeightChange(poolId))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSmoothWeightChangeCompleted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		))
+		k.hooks.AfterSmoothWeightChangeCompleted(ctx, poolId)
+	}
+}
+
+// completeSmoothWeightChange persists the given balancer pool with its target weights
+// if its smooth weight change completed by the block time. Returns whether it completed.
+func (k Keeper) completeSmoothWeightChange(ctx sdk.Context, poolId uint64) (bool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return false, err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return false, fmt.Errorf("pool id %d is not of type balancer pool", poolId)
+	}
+	if balancerPool.PoolParams.SmoothWeightChangeParams != nil {
+		return false, nil
+	}
+	return true, k.setPool(ctx, balancerPool)
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// TestSmoothWeightChange creates a pool changing its foo weight from 50 to 100 over an hour,
+// and tests the smooth weight change query and the completion of the weight change.
+func (s *KeeperTestSuite) TestSmoothWeightChange() {
+	startTime := s.Ctx.BlockTime()
+	endTime := startTime.Add(time.Hour)
+
+	// N.B.: We make copies because SmoothWeightChangeParams get mutated.
+	initialPoolAssets := []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(50), Token: defaultFooAsset.Token},
+		defaultBarAsset,
+	}
+	targetPoolAssets := make([]balancer.PoolAsset, 2)
+	copy(targetPoolAssets, defaultPoolAssets)
+	poolId := s.prepareCustomBalancerPool(defaultAcctFunds, initialPoolAssets, balancer.PoolParams{
+		SwapFee: defaultSpreadFactor,
+		ExitFee: defaultZeroExitFee,
+		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+			StartTime:         startTime,
+			Duration:          time.Hour,
+			TargetPoolWeights: targetPoolAssets,
+		},
+	})
+
+	querier := keeper.NewQuerier(*s.App.GAMMKeeper)
+	querySmoothWeightChange := func(ctx sdk.Context) *types.QuerySmoothWeightChangeResponse {
+		res, err := querier.SmoothWeightChange(ctx, &types.QuerySmoothWeightChangeRequest{PoolId: poolId})
+		s.Require().NoError(err)
+		return res
+	}
+	completeSmoothWeightChanges := func(blockTime time.Time) sdk.Context {
+		ctx := s.Ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		s.App.GAMMKeeper.CompleteSmoothWeightChanges(ctx)
+		return ctx
+	}
+
+	// halfway through, the foo weight is halfway to its target.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	res := querySmoothWeightChange(s.Ctx)
+	s.Require().True(res.InProgress)
+	s.Require().True(startTime.Equal(res.StartTime))
+	s.Require().True(endTime.Equal(res.EndTime))
+	s.Require().Len(res.CurrentWeights, 2)
+	s.Require().Equal("bar", res.CurrentWeights[0].Denom)
+	s.Require().Equal("foo", res.CurrentWeights[1].Denom)
+	s.Require().Equal(res.CurrentWeights[0].Weight, res.TargetWeights[0].Weight)
+	s.Require().Equal(res.CurrentWeights[1].Weight.MulRaw(4), res.TargetWeights[1].Weight.MulRaw(3))
+	targetWeights := res.TargetWeights
+
+	// the weight change has not completed at its end time.
+	ctx := completeSmoothWeightChanges(endTime)
+	s.AssertEventEmitted(ctx, types.TypeEvtSmoothWeightChangeCompleted, 0)
+
+	// it completes in the first block after its end time.
+	ctx = completeSmoothWeightChanges(endTime.Add(time.Second))
+	s.AssertEventEmitted(ctx, types.TypeEvtSmoothWeightChangeCompleted, 1)
+	res = querySmoothWeightChange(ctx)
+	s.Require().False(res.InProgress)
+	s.Require().Equal(targetWeights, res.CurrentWeights)
+	s.Require().Equal(targetWeights, res.TargetWeights)
+
+	// the pool was persisted with its target weights, so the weights no longer change, and it is not completed again.
+	res = querySmoothWeightChange(s.Ctx)
+	s.Require().False(res.InProgress)
+	s.Require().Equal(targetWeights, res.CurrentWeights)
+	ctx = completeSmoothWeightChanges(endTime.Add(2 * time.Second))
+	s.AssertEventEmitted(ctx, types.TypeEvtSmoothWeightChangeCompleted, 0)
+
+	// the query errors for pools that do not exist.
+	_, err := querier.SmoothWeightChange(s.Ctx, &types.QuerySmoothWeightChangeRequest{PoolId: poolId + 1})
+	s.Require().Error(err)
+}
+
+// TestBackfillSmoothWeightChanges tests that a pool with a smooth weight change in progress that is not indexed,
+// as for pools created before the index, is completed once backfilled.
+func (s *KeeperTestSuite) TestBackfillSmoothWeightChanges() {
+	startTime := s.Ctx.BlockTime()
+	targetPoolAssets := make([]balancer.PoolAsset, 2)
+	copy(targetPoolAssets, defaultPoolAssets)
+	poolId := s.prepareCustomBalancerPool(defaultAcctFunds, []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(50), Token: defaultFooAsset.Token},
+		defaultBarAsset,
+	}, balancer.PoolParams{
+		SwapFee: defaultSpreadFactor,
+		ExitFee: defaultZeroExitFee,
+		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+			StartTime:         startTime,
+			Duration:          time.Hour,
+			TargetPoolWeights: targetPoolAssets,
+		},
+	})
+	s.PrepareBalancerPool()
+	s.Ctx.KVStore(s.App.AppKeepers.GetKey(types.StoreKey)).Delete(types.GetKeyPrefixSmoothWeightChange(poolId))
+
+	// the weight change ended, but the pool is not indexed, so it is not completed.
+	ctx := s.Ctx.WithBlockTime(startTime.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	s.App.GAMMKeeper.CompleteSmoothWeightChanges(ctx)
+	s.AssertEventEmitted(ctx, types.TypeEvtSmoothWeightChangeCompleted, 0)
+
+	// System under test.
+	s.Require().NoError(s.App.GAMMKeeper.BackfillSmoothWeightChanges(ctx))
+
+	// only the pool with the weight change is completed.
+	s.App.GAMMKeeper.CompleteSmoothWeightChanges(ctx)
+	s.AssertEventEmitted(ctx, types.TypeEvtSmoothWeightChangeCompleted, 1)
+}
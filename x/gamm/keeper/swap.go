@@ -55,6 +55,11 @@ func (k Keeper) SwapExactAmountIn(
 		return osmomath.Int{}, err
 	}
 
+	spreadFactor, err = k.getRebalancingSpreadFactorGivenIn(ctx, cfmmPool, tokenIn, tokenOutDenom, spreadFactor)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Executes the swap in the pool and stores the output. Updates pool assets but
 	// does not actually transfer any tokens to or from the pool.
 	tokenOutCoin, err := cfmmPool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, spreadFactor)
@@ -124,6 +129,11 @@ func (k Keeper) SwapExactAmountOut(
 		return osmomath.Int{}, err
 	}
 
+	spreadFactor, err = k.getRebalancingSpreadFactorGivenOut(ctx, cfmmPool, tokenInDenom, tokenOut, spreadFactor)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	tokenIn, err := cfmmPool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, spreadFactor)
 	if err != nil {
 		return osmomath.Int{}, err
@@ -146,6 +156,7 @@ func (k Keeper) SwapExactAmountOut(
 }
 
 // CalcOutAmtGivenIn calculates the amount of tokenOut given tokenIn and the pool's current state.
+// Applies the pool's rebalancing fee discount to the spread factor if the swap restores the pool.
 // Returns error if the given pool is not a CFMM pool. Returns error on internal calculations.
 func (k Keeper) CalcOutAmtGivenIn(
 	ctx sdk.Context,
@@ -158,10 +169,15 @@ func (k Keeper) CalcOutAmtGivenIn(
 	if err != nil {
 		return sdk.Coin{}, err
 	}
+	spreadFactor, err = k.getRebalancingSpreadFactorGivenIn(ctx, cfmmPool, tokenIn, tokenOutDenom, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 	return cfmmPool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, spreadFactor)
 }
 
 // CalcInAmtGivenOut calculates the amount of tokenIn given tokenOut and the pool's current state.
+// Applies the pool's rebalancing fee discount to the spread factor if the swap restores the pool.
 // Returns error if the given pool is not a CFMM pool. Returns error on internal calculations.
 func (k Keeper) CalcInAmtGivenOut(
 	ctx sdk.Context,
@@ -174,6 +190,10 @@ func (k Keeper) CalcInAmtGivenOut(
 	if err != nil {
 		return sdk.Coin{}, err
 	}
+	spreadFactor, err = k.getRebalancingSpreadFactorGivenOut(ctx, cfmmPool, tokenInDenom, tokenOut, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 	return cfmmPool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, spreadFactor)
 }
 
@@ -190,7 +210,12 @@ func (k Keeper) updatePoolForSwap(
 	tokensIn := sdk.Coins{tokenIn}
 	tokensOut := sdk.Coins{tokenOut}
 
-	err := k.setPool(ctx, pool)
+	err := k.checkPoolDrainProtection(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	err = k.setPool(ctx, pool)
 	if err != nil {
 		return err
 	}
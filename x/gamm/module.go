@@ -39,6 +39,7 @@ var (
 	_ module.HasGenesisBasics = AppModuleBasic{}
 
 	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
@@ -152,6 +153,17 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// BeginBlock executes all ABCI BeginBlock logic respective to the gamm module.
+// It moves the scaling factors of stableswap pools with a scaling factor ramp in progress
+// along their ramps, so that swaps in the block use the scaling factors at the block time,
+// and completes the smooth weight changes of balancer pools that ended.
+func (am AppModule) BeginBlock(context context.Context) error {
+	ctx := sdk.UnwrapSDKContext(context)
+	am.keeper.UpdateScalingFactorRamps(ctx)
+	am.keeper.CompleteSmoothWeightChanges(ctx)
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
@@ -166,3 +178,7 @@ func (am AppModule) SimulatorGenesisState(simState *module.SimulationState, s *s
 func (am AppModule) Actions() []simtypes.Action {
 	return simulation.DefaultActions(am.keeper)
 }
+
+func (am AppModule) PropertyChecks() []simtypes.PropertyCheck {
+	return simulation.DefaultPropertyChecks(am.keeper)
+}
@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +14,7 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapRampScalingFactors   = "stable_swap_ramp_scaling_factors"
 )
 
 var (
@@ -161,3 +164,51 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapRampScalingFactors{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapRampScalingFactors(
+	sender string,
+	poolID uint64,
+	scalingFactors []uint64,
+	rampDuration time.Duration,
+) MsgStableSwapRampScalingFactors {
+	return MsgStableSwapRampScalingFactors{
+		Sender:         sender,
+		PoolID:         poolID,
+		ScalingFactors: scalingFactors,
+		RampDuration:   rampDuration,
+	}
+}
+
+func (msg MsgStableSwapRampScalingFactors) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapRampScalingFactors) Type() string { return TypeMsgStableSwapRampScalingFactors }
+func (msg MsgStableSwapRampScalingFactors) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := validateScalingFactors(msg.ScalingFactors, len(msg.ScalingFactors)); err != nil {
+		return err
+	}
+
+	if msg.RampDuration <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidScalingFactorRamp, "ramp duration must be positive, got %s", msg.RampDuration)
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapRampScalingFactors) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
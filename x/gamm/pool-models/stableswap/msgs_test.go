@@ -2,6 +2,7 @@ package stableswap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -297,6 +298,54 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgStableSwapRampScalingFactorsValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	baseMsg := stableswap.NewMsgStableSwapRampScalingFactors(addr1.String(), 1, []uint64{100, 110}, 24*time.Hour)
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "stable_swap_ramp_scaling_factors")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := map[string]struct {
+		modify     func(*stableswap.MsgStableSwapRampScalingFactors)
+		expectPass bool
+	}{
+		"proper msg": {
+			modify:     func(*stableswap.MsgStableSwapRampScalingFactors) {},
+			expectPass: true,
+		},
+		"invalid sender": {
+			modify: func(msg *stableswap.MsgStableSwapRampScalingFactors) { msg.Sender = "invalid" },
+		},
+		"zero scaling factor": {
+			modify: func(msg *stableswap.MsgStableSwapRampScalingFactors) { msg.ScalingFactors = []uint64{0, 110} },
+		},
+		"zero ramp duration": {
+			modify: func(msg *stableswap.MsgStableSwapRampScalingFactors) { msg.RampDuration = 0 },
+		},
+		"negative ramp duration": {
+			modify: func(msg *stableswap.MsgStableSwapRampScalingFactors) { msg.RampDuration = -time.Hour },
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := stableswap.NewMsgStableSwapRampScalingFactors(addr1.String(), 1, []uint64{100, 110}, 24*time.Hour)
+			tc.modify(&msg)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func (suite *TestSuite) TestMsgCreateStableswapPool() {
 	suite.SetupTest()
 
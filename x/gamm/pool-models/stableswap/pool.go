@@ -399,20 +399,48 @@ func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares osmomat
 // It should only be able to be successfully called by the pool's ScalingFactorGovernor
 // TODO: move commented test for this function from x/gamm/keeper/pool_service_test.go once a pool_test.go file has been created for stableswap
 func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sender string) error {
+	scalingFactors, err := p.ValidateScalingFactorsUpdate(scalingFactors, sender)
+	if err != nil {
+		return err
+	}
+
+	p.ScalingFactors = scalingFactors
+	return nil
+}
+
+// ValidateScalingFactorsUpdate validates that the sender is the pool's ScalingFactorGovernor
+// and that the given scaling factors are valid for the pool's liquidity.
+// Returns the scaling factors with the scaling factor multiplier applied, as they are stored in the pool.
+func (p Pool) ValidateScalingFactorsUpdate(scalingFactors []uint64, sender string) ([]uint64, error) {
 	if sender != p.ScalingFactorController {
-		return types.ErrNotScalingFactorGovernor
+		return nil, types.ErrNotScalingFactorGovernor
 	}
 
 	scalingFactors, err := applyScalingFactorMultiplier(scalingFactors)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err = validateScalingFactors(scalingFactors, p.PoolLiquidity.Len()); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err = validatePoolLiquidity(p.PoolLiquidity, scalingFactors); err != nil {
+		return nil, err
+	}
+
+	return scalingFactors, nil
+}
+
+// SetRampedScalingFactors sets the pool's scaling factors to the given scaling factors of a scaling factor ramp.
+// Unlike SetScalingFactors, the scaling factors are expected to already have the scaling factor multiplier applied,
+// and the caller is expected to have checked that the ramp was started by the pool's ScalingFactorGovernor.
+func (p *Pool) SetRampedScalingFactors(scalingFactors []uint64) error {
+	if err := validateScalingFactors(scalingFactors, p.PoolLiquidity.Len()); err != nil {
+		return err
+	}
+
+	if err := validatePoolLiquidity(p.PoolLiquidity, scalingFactors); err != nil {
 		return err
 	}
 
@@ -420,6 +448,32 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 	return nil
 }
 
+// RebalanceLiquidity moves tokenOut out of and tokenIn into the pool's liquidity outside of a swap, e.g. when
+// the gamm module rebalances the pool toward its peg. The caller is expected to move the tokens to and from
+// the pool's address.
+// errors if either token is not in the pool or the pool's liquidity would end up out of bounds.
+func (p *Pool) RebalanceLiquidity(tokenIn sdk.Coin, tokenOut sdk.Coin) error {
+	if tokenIn.Denom == tokenOut.Denom {
+		return fmt.Errorf("can not rebalance %s against itself", tokenIn.Denom)
+	}
+	for _, token := range []sdk.Coin{tokenIn, tokenOut} {
+		if p.PoolLiquidity.AmountOf(token.Denom).IsZero() {
+			return types.ErrDenomNotFoundInPool
+		}
+	}
+	if tokenOut.Amount.GTE(p.PoolLiquidity.AmountOf(tokenOut.Denom)) {
+		return fmt.Errorf("can not rebalance %s out of a pool holding %s", tokenOut, p.PoolLiquidity.AmountOf(tokenOut.Denom))
+	}
+
+	newLiquidity := p.PoolLiquidity.Add(tokenIn).Sub(tokenOut)
+	if err := validatePoolLiquidity(newLiquidity, p.ScalingFactors); err != nil {
+		return err
+	}
+
+	p.PoolLiquidity = newLiquidity
+	return nil
+}
+
 func validateScalingFactorController(scalingFactorController string) error {
 	if len(scalingFactorController) == 0 {
 		return nil
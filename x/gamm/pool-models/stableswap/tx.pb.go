@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -264,11 +268,123 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_governor in order for the tx to
+// succeed. Linearly ramps stableswap scaling factors from their current values
+// to the given scaling factors over ramp_duration, updating them every block.
+// Replaces any ramp already in progress for the pool.
+type MsgStableSwapRampScalingFactors struct {
+	Sender         string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID         uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ScalingFactors []uint64      `protobuf:"varint,3,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
+	RampDuration   time.Duration `protobuf:"bytes,4,opt,name=ramp_duration,json=rampDuration,proto3,stdduration" json:"ramp_duration" yaml:"ramp_duration"`
+}
+
+func (m *MsgStableSwapRampScalingFactors) Reset()         { *m = MsgStableSwapRampScalingFactors{} }
+func (m *MsgStableSwapRampScalingFactors) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampScalingFactors) ProtoMessage()    {}
+func (*MsgStableSwapRampScalingFactors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{4}
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampScalingFactors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampScalingFactors.Merge(m, src)
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampScalingFactors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampScalingFactors proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampScalingFactors) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetScalingFactors() []uint64 {
+	if m != nil {
+		return m.ScalingFactors
+	}
+	return nil
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetRampDuration() time.Duration {
+	if m != nil {
+		return m.RampDuration
+	}
+	return 0
+}
+
+type MsgStableSwapRampScalingFactorsResponse struct {
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Reset() {
+	*m = MsgStableSwapRampScalingFactorsResponse{}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampScalingFactorsResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampScalingFactorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{5}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapRampScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampScalingFactors")
+	proto.RegisterType((*MsgStableSwapRampScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampScalingFactorsResponse")
 }
 
 func init() {
@@ -276,51 +392,58 @@ func init() {
 }
 
 var fileDescriptor_3a59a47ae7445405 = []byte{
-	// 689 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x93, 0x10, 0xc4, 0x55, 0x80, 0x6a, 0x45, 0x6d, 0x1a, 0x24, 0x3b, 0xb8, 0x0c, 0x69,
-	0x91, 0x6d, 0x9a, 0x4a, 0x1d, 0x3a, 0x20, 0x9a, 0xa0, 0xa2, 0x0a, 0x22, 0x15, 0x57, 0x2c, 0x30,
-	0x84, 0x8b, 0x7d, 0x35, 0x07, 0xb6, 0xcf, 0xf8, 0x2e, 0x69, 0x3b, 0xd2, 0x91, 0x89, 0x3f, 0x03,
-	0x31, 0xf5, 0xaf, 0x40, 0x1d, 0x3b, 0x32, 0x05, 0x94, 0x0e, 0xdd, 0xb3, 0xb1, 0x21, 0x9f, 0x2f,
-	0xbf, 0x20, 0xa1, 0x04, 0x75, 0x89, 0x9d, 0x77, 0xef, 0xfb, 0xbe, 0xf7, 0xbe, 0xf7, 0x7c, 0x60,
-	0x9d, 0x50, 0x9f, 0x50, 0x4c, 0x4d, 0x17, 0xfa, 0xbe, 0x19, 0x12, 0xe2, 0xf9, 0xc4, 0x41, 0x1e,
-	0x35, 0x29, 0x83, 0x4d, 0x0f, 0xd1, 0x03, 0x18, 0x9a, 0xed, 0xb5, 0x26, 0x62, 0x70, 0xcd, 0x64,
-	0x87, 0x46, 0x18, 0x11, 0x46, 0xe4, 0x55, 0x01, 0x32, 0x62, 0x90, 0x31, 0x04, 0x19, 0x43, 0x90,
-	0x21, 0x40, 0x45, 0xc5, 0xe6, 0xc9, 0x66, 0x13, 0x52, 0x34, 0x60, 0xb2, 0x09, 0x0e, 0x12, 0xae,
-	0x62, 0xde, 0x25, 0x2e, 0xe1, 0xaf, 0x66, 0xfc, 0x26, 0xa2, 0xf3, 0xd0, 0xc7, 0x01, 0x31, 0xf9,
-	0xaf, 0x08, 0x3d, 0x9a, 0xa1, 0xd2, 0x61, 0xa8, 0x11, 0x27, 0x0a, 0x86, 0x45, 0x51, 0x8a, 0x4f,
-	0x5d, 0xb3, 0xbd, 0x16, 0x3f, 0x92, 0x03, 0xad, 0x97, 0x05, 0x8b, 0x75, 0xea, 0xd6, 0x22, 0x04,
-	0x19, 0xda, 0x1b, 0x60, 0x77, 0x09, 0xf1, 0xe4, 0x15, 0x90, 0xa3, 0x28, 0x70, 0x50, 0x54, 0x90,
-	0x4a, 0x52, 0xf9, 0x46, 0x75, 0xbe, 0xd7, 0x51, 0x6f, 0x1e, 0x41, 0xdf, 0xdb, 0xd4, 0x92, 0xb8,
-	0x66, 0x89, 0x04, 0x99, 0x80, 0xb9, 0x58, 0xad, 0x11, 0xc2, 0x08, 0xfa, 0xb4, 0x90, 0x2e, 0x49,
-	0xe5, 0xb9, 0xca, 0x86, 0xf1, 0xef, 0x66, 0x19, 0xb1, 0xe2, 0x2e, 0x47, 0x57, 0x17, 0x7a, 0x1d,
-	0x55, 0x4e, 0x74, 0x46, 0x48, 0x35, 0x0b, 0x84, 0x83, 0x1c, 0xf9, 0x83, 0x04, 0x16, 0x70, 0x80,
-	0x19, 0x86, 0x1e, 0xef, 0xb3, 0xe1, 0xe1, 0xf7, 0x2d, 0xec, 0x60, 0x76, 0x54, 0xc8, 0x94, 0x32,
-	0xe5, 0xb9, 0xca, 0x92, 0x91, 0xb4, 0x6c, 0xc4, 0xee, 0x0f, 0x54, 0x6a, 0x04, 0x07, 0xd5, 0x07,
-	0xa7, 0x1d, 0x35, 0xf5, 0xe5, 0xbb, 0x5a, 0x76, 0x31, 0x7b, 0xd3, 0x6a, 0x1a, 0x36, 0xf1, 0x4d,
-	0xe1, 0x4f, 0xf2, 0xd0, 0xa9, 0xf3, 0xce, 0x64, 0x47, 0x21, 0xa2, 0x1c, 0x40, 0xad, 0xbc, 0x90,
-	0x8a, 0x8b, 0x7c, 0xd6, 0x17, 0x92, 0xeb, 0xe0, 0x36, 0xb5, 0xa1, 0x87, 0x03, 0xb7, 0xb1, 0x0f,
-	0x6d, 0x46, 0x22, 0x5a, 0xc8, 0x96, 0x32, 0xe5, 0x6c, 0xf5, 0x5e, 0xaf, 0xa3, 0x96, 0x84, 0x51,
-	0xc3, 0x71, 0x8c, 0xe7, 0x6a, 0xd6, 0x2d, 0x11, 0xd8, 0x4e, 0xb0, 0xf2, 0x73, 0x90, 0xdf, 0x6f,
-	0xb1, 0x56, 0x84, 0x92, 0x86, 0x5c, 0xd2, 0x46, 0x51, 0x40, 0xa2, 0xc2, 0x35, 0x6e, 0xbe, 0xda,
-	0xeb, 0xa8, 0x77, 0x12, 0xce, 0x49, 0x59, 0x9a, 0x25, 0x27, 0xe1, 0xb8, 0xc4, 0x27, 0x22, 0x28,
-	0xbf, 0x06, 0x4b, 0xe3, 0xaa, 0x0d, 0x9b, 0x04, 0x2c, 0x22, 0x9e, 0x87, 0xa2, 0x42, 0x8e, 0xf3,
-	0x8e, 0xd6, 0x3a, 0x2d, 0x55, 0xb3, 0x16, 0xc7, 0x6a, 0xad, 0x0d, 0x4e, 0x36, 0xd7, 0x8f, 0x2f,
-	0x4e, 0x56, 0xc5, 0x16, 0x7c, 0xbc, 0x38, 0x59, 0x5d, 0x1e, 0x5b, 0x55, 0x9b, 0xaf, 0x95, 0x3e,
-	0x34, 0x41, 0x8f, 0x8b, 0xd6, 0xb6, 0x81, 0x3a, 0x65, 0xe7, 0x2c, 0x44, 0x43, 0x12, 0x50, 0x24,
-	0x2f, 0x83, 0xeb, 0xbc, 0x3f, 0xec, 0xf0, 0xe5, 0xcb, 0x56, 0x41, 0xb7, 0xa3, 0xe6, 0xe2, 0x94,
-	0x9d, 0xc7, 0x56, 0x2e, 0x3e, 0xda, 0x71, 0xb4, 0xe3, 0x34, 0xb8, 0x5b, 0xa7, 0x6e, 0x42, 0xb1,
-	0x77, 0x00, 0xc3, 0x2d, 0xe7, 0x6d, 0x8b, 0xb2, 0xbd, 0x71, 0x5f, 0x67, 0x58, 0xe3, 0x11, 0xd5,
-	0xf4, 0x34, 0xd5, 0x49, 0x63, 0xcf, 0xfc, 0xff, 0xd8, 0x37, 0x1f, 0xfe, 0xe6, 0xa0, 0x31, 0xe6,
-	0xe0, 0x88, 0x75, 0x90, 0x37, 0xa7, 0x0b, 0xb8, 0x2e, 0xb4, 0xb5, 0xfb, 0x60, 0xe5, 0x52, 0x0f,
-	0xfa, 0xb6, 0x56, 0x7e, 0xa6, 0x41, 0xa6, 0x4e, 0x5d, 0xf9, 0xb3, 0x04, 0xf2, 0x13, 0xbf, 0xf9,
-	0xda, 0x2c, 0xdf, 0xec, 0x94, 0x21, 0x16, 0x9f, 0x5e, 0x01, 0xc9, 0x60, 0x13, 0xbe, 0x4a, 0x40,
-	0xb9, 0x64, 0xc2, 0xf5, 0x19, 0xf5, 0xfe, 0x4e, 0x57, 0x7c, 0x71, 0xa5, 0x74, 0xfd, 0x46, 0xaa,
-	0xaf, 0x4e, 0xbb, 0x8a, 0x74, 0xd6, 0x55, 0xa4, 0x1f, 0x5d, 0x45, 0xfa, 0x74, 0xae, 0xa4, 0xce,
-	0xce, 0x95, 0xd4, 0xb7, 0x73, 0x25, 0xf5, 0x72, 0x6b, 0xe4, 0x22, 0x12, 0xd2, 0xba, 0x07, 0x9b,
-	0xb4, 0xff, 0xc7, 0x6c, 0x57, 0x36, 0xcc, 0xc3, 0xe1, 0xed, 0xaf, 0xff, 0x71, 0xfd, 0x37, 0x73,
-	0xfc, 0x3a, 0x5f, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xde, 0x16, 0xbe, 0xd5, 0x06, 0x00,
-	0x00,
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x3f, 0x4f, 0xdb, 0x5a,
+	0x14, 0x8f, 0x93, 0xbc, 0x3c, 0xbd, 0xcb, 0xe3, 0x3d, 0x61, 0x45, 0x10, 0xf2, 0x9e, 0xec, 0xd4,
+	0x54, 0x6a, 0xa0, 0x8d, 0x5d, 0x82, 0xc4, 0x10, 0x55, 0x55, 0x49, 0x10, 0x15, 0xa2, 0x91, 0xa8,
+	0xa3, 0x2e, 0xed, 0x10, 0x6e, 0x62, 0xe3, 0xba, 0xb5, 0x7d, 0x5d, 0x5f, 0x27, 0xc0, 0x58, 0xc6,
+	0x4e, 0x1d, 0xfb, 0x11, 0xaa, 0x4e, 0x7c, 0x80, 0x0e, 0x9d, 0x2a, 0x46, 0xc6, 0x4e, 0x01, 0x85,
+	0x81, 0x3d, 0x9f, 0xa0, 0xba, 0xd7, 0xd7, 0x49, 0x0c, 0x04, 0x1a, 0xc4, 0xd2, 0x25, 0xb6, 0x8f,
+	0xcf, 0xf9, 0xfd, 0xce, 0xfd, 0x9d, 0x3f, 0x31, 0x58, 0x42, 0xd8, 0x46, 0xd8, 0xc4, 0x8a, 0x01,
+	0x6d, 0x5b, 0x71, 0x11, 0xb2, 0x6c, 0xa4, 0xe9, 0x16, 0x56, 0xb0, 0x0f, 0x1b, 0x96, 0x8e, 0x77,
+	0xa0, 0xab, 0xb4, 0x17, 0x1b, 0xba, 0x0f, 0x17, 0x15, 0x7f, 0x57, 0x76, 0x3d, 0xe4, 0x23, 0x7e,
+	0x81, 0x05, 0xc9, 0x24, 0x48, 0x1e, 0x04, 0xc9, 0x83, 0x20, 0x99, 0x05, 0x65, 0x85, 0x26, 0x75,
+	0x56, 0x1a, 0x10, 0xeb, 0x7d, 0xa4, 0x26, 0x32, 0x9d, 0x00, 0x2b, 0x9b, 0x36, 0x90, 0x81, 0xe8,
+	0xad, 0x42, 0xee, 0x98, 0x75, 0x0a, 0xda, 0xa6, 0x83, 0x14, 0xfa, 0xcb, 0x4c, 0x4f, 0xc6, 0xc8,
+	0x74, 0x60, 0xaa, 0x13, 0x47, 0x86, 0x30, 0xc3, 0x52, 0xb1, 0xb1, 0xa1, 0xb4, 0x17, 0xc9, 0x85,
+	0xbd, 0x10, 0x0c, 0x84, 0x0c, 0x4b, 0x57, 0xe8, 0x53, 0xa3, 0xb5, 0xad, 0x68, 0x2d, 0x0f, 0xfa,
+	0x26, 0x62, 0x39, 0x4a, 0xbd, 0x24, 0x98, 0xa9, 0x62, 0xa3, 0xe2, 0xe9, 0xd0, 0xd7, 0x6b, 0x7d,
+	0xec, 0x4d, 0x84, 0x2c, 0x7e, 0x1e, 0xa4, 0xb0, 0xee, 0x68, 0xba, 0x97, 0xe1, 0x72, 0x5c, 0xfe,
+	0xaf, 0xf2, 0x54, 0xaf, 0x23, 0x4e, 0xee, 0x41, 0xdb, 0x2a, 0x49, 0x81, 0x5d, 0x52, 0x99, 0x03,
+	0x8f, 0xc0, 0x04, 0xc9, 0xa6, 0xee, 0x42, 0x0f, 0xda, 0x38, 0x13, 0xcf, 0x71, 0xf9, 0x89, 0xe2,
+	0xb2, 0xfc, 0xeb, 0x62, 0xca, 0x84, 0x71, 0x93, 0x46, 0x97, 0xa7, 0x7b, 0x1d, 0x91, 0x0f, 0x78,
+	0x86, 0x40, 0x25, 0x15, 0xb8, 0x7d, 0x1f, 0xfe, 0x3d, 0x07, 0xa6, 0x4d, 0xc7, 0xf4, 0x4d, 0x68,
+	0x51, 0x1d, 0xea, 0x96, 0xf9, 0xae, 0x65, 0x6a, 0xa6, 0xbf, 0x97, 0x49, 0xe4, 0x12, 0xf9, 0x89,
+	0xe2, 0xac, 0x1c, 0x48, 0x22, 0x93, 0xea, 0xf4, 0x59, 0x2a, 0xc8, 0x74, 0xca, 0x0f, 0x0f, 0x3b,
+	0x62, 0xec, 0xcb, 0xb1, 0x98, 0x37, 0x4c, 0xff, 0x75, 0xab, 0x21, 0x37, 0x91, 0xad, 0x30, 0xfd,
+	0x82, 0x4b, 0x01, 0x6b, 0x6f, 0x15, 0x7f, 0xcf, 0xd5, 0x31, 0x0d, 0xc0, 0x6a, 0x9a, 0x51, 0x91,
+	0x24, 0x9f, 0x85, 0x44, 0x7c, 0x15, 0xfc, 0x8b, 0x9b, 0xd0, 0x32, 0x1d, 0xa3, 0xbe, 0x0d, 0x9b,
+	0x3e, 0xf2, 0x70, 0x26, 0x99, 0x4b, 0xe4, 0x93, 0xe5, 0xbb, 0xbd, 0x8e, 0x98, 0x63, 0x42, 0x0d,
+	0xca, 0x15, 0xf5, 0x95, 0xd4, 0x7f, 0x98, 0x61, 0x2d, 0x88, 0xe5, 0x9f, 0x83, 0xf4, 0x76, 0xcb,
+	0x6f, 0x79, 0x7a, 0x70, 0x20, 0x03, 0xb5, 0x75, 0xcf, 0x41, 0x5e, 0xe6, 0x0f, 0x2a, 0xbe, 0xd8,
+	0xeb, 0x88, 0xff, 0x05, 0x98, 0x97, 0x79, 0x49, 0x2a, 0x1f, 0x98, 0x49, 0x8a, 0x4f, 0x99, 0x91,
+	0xdf, 0x02, 0xb3, 0x51, 0xd6, 0x7a, 0x13, 0x39, 0xbe, 0x87, 0x2c, 0x4b, 0xf7, 0x32, 0x29, 0x8a,
+	0x3b, 0x9c, 0xeb, 0x28, 0x57, 0x49, 0x9d, 0x89, 0xe4, 0x5a, 0xe9, 0xbf, 0x29, 0x2d, 0xed, 0x9f,
+	0x1d, 0x2c, 0xb0, 0x2e, 0xf8, 0x70, 0x76, 0xb0, 0x30, 0x17, 0x69, 0xe5, 0x26, 0x6d, 0xab, 0xc2,
+	0x40, 0x84, 0x02, 0x49, 0x5a, 0x5a, 0x03, 0xe2, 0x88, 0x9e, 0x53, 0x75, 0xec, 0x22, 0x07, 0xeb,
+	0xfc, 0x1c, 0xf8, 0x93, 0x9e, 0xcf, 0xd4, 0x68, 0xf3, 0x25, 0xcb, 0xa0, 0xdb, 0x11, 0x53, 0xc4,
+	0x65, 0x7d, 0x55, 0x4d, 0x91, 0x57, 0xeb, 0x9a, 0xb4, 0x1f, 0x07, 0x77, 0xaa, 0xd8, 0x08, 0x20,
+	0x6a, 0x3b, 0xd0, 0x5d, 0xd1, 0xde, 0xb4, 0xb0, 0x5f, 0x8b, 0xea, 0x3a, 0x46, 0x1b, 0x0f, 0xb1,
+	0xc6, 0x47, 0xb1, 0x5e, 0x56, 0xf6, 0xc4, 0xcd, 0xcb, 0x5e, 0x7a, 0x7c, 0x4e, 0x41, 0x39, 0xa2,
+	0xe0, 0x90, 0x74, 0x90, 0x1e, 0xae, 0xc0, 0xc2, 0x0b, 0x8c, 0x5b, 0xba, 0x0f, 0xe6, 0xaf, 0xd5,
+	0x20, 0x94, 0x55, 0x3a, 0x89, 0x03, 0x31, 0xe2, 0xad, 0x42, 0xdb, 0xfd, 0xad, 0xf4, 0xe2, 0xb7,
+	0xc0, 0xa4, 0x07, 0x6d, 0xb7, 0x1e, 0x2e, 0xb2, 0x4c, 0x92, 0x2e, 0x9b, 0x59, 0x39, 0xd8, 0x74,
+	0x72, 0xb8, 0xe9, 0xe4, 0x55, 0xe6, 0x50, 0xce, 0x91, 0x79, 0xef, 0x75, 0xc4, 0x74, 0xc0, 0x15,
+	0x89, 0x96, 0x3e, 0x1d, 0x8b, 0x9c, 0xfa, 0x37, 0xb1, 0x85, 0xfe, 0xa5, 0x47, 0xe7, 0x2a, 0xf2,
+	0x60, 0x54, 0x45, 0x48, 0xd4, 0x85, 0x7a, 0xcc, 0x83, 0x7b, 0xd7, 0x28, 0x1c, 0x56, 0xa3, 0xf8,
+	0x35, 0x09, 0x12, 0x55, 0x6c, 0xf0, 0x9f, 0x39, 0x90, 0xbe, 0x74, 0x03, 0x57, 0xc6, 0xd9, 0xa0,
+	0x23, 0x46, 0x2a, 0xbb, 0x71, 0x0b, 0x20, 0xfd, 0xb9, 0xfc, 0xce, 0x01, 0xe1, 0x9a, 0x79, 0xab,
+	0x8e, 0xc9, 0x77, 0x35, 0x5c, 0xf6, 0xc5, 0xad, 0xc2, 0xf5, 0x0f, 0xf2, 0x8d, 0x03, 0xff, 0x5f,
+	0x39, 0x06, 0x1b, 0x37, 0xe6, 0xbd, 0x08, 0x96, 0xad, 0xdd, 0x22, 0x58, 0x78, 0x84, 0xf2, 0xab,
+	0xc3, 0xae, 0xc0, 0x1d, 0x75, 0x05, 0xee, 0xa4, 0x2b, 0x70, 0x1f, 0x4f, 0x85, 0xd8, 0xd1, 0xa9,
+	0x10, 0xfb, 0x71, 0x2a, 0xc4, 0x5e, 0xae, 0x0c, 0xfd, 0xb3, 0x31, 0xe2, 0x82, 0x05, 0x1b, 0x38,
+	0x7c, 0x50, 0xda, 0xc5, 0x65, 0x65, 0x77, 0xf0, 0xb9, 0x51, 0xb8, 0xf0, 0xbd, 0xd1, 0x48, 0xd1,
+	0x39, 0x5a, 0xfa, 0x39, 0x00, 0xfd, 0xf4, 0x0f, 0x3f, 0x46, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +460,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampScalingFactors(ctx context.Context, in *MsgStableSwapRampScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapRampScalingFactorsResponse, error)
 }
 
 type msgClient struct {
@@ -365,10 +489,20 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapRampScalingFactors(ctx context.Context, in *MsgStableSwapRampScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapRampScalingFactorsResponse, error) {
+	out := new(MsgStableSwapRampScalingFactorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampScalingFactors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampScalingFactors(context.Context, *MsgStableSwapRampScalingFactors) (*MsgStableSwapRampScalingFactorsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -381,6 +515,9 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapRampScalingFactors(ctx context.Context, req *MsgStableSwapRampScalingFactors) (*MsgStableSwapRampScalingFactorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampScalingFactors not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -422,6 +559,25 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapRampScalingFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampScalingFactors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampScalingFactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampScalingFactors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampScalingFactors(ctx, req.(*MsgStableSwapRampScalingFactors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -434,6 +590,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapRampScalingFactors",
+			Handler:    _Msg_StableSwapRampScalingFactors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/poolmodels/stableswap/v1beta1/tx.proto",
@@ -631,6 +791,90 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapRampScalingFactors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampScalingFactors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampScalingFactors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RampDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RampDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ScalingFactors) > 0 {
+		dAtA8 := make([]byte, len(m.ScalingFactors)*10)
+		var j7 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -724,6 +968,40 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapRampScalingFactors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RampDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1318,6 +1596,266 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapRampScalingFactors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RampDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,33 @@
+package gammsimulation
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/simulation/simtypes"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/keeper"
+)
+
+func DefaultPropertyChecks(k keeper.Keeper) []simtypes.PropertyCheck {
+	return []simtypes.PropertyCheck{
+		cfmmPropertyCheck{invariant: keeper.CFMMInvariant(k)},
+	}
+}
+
+// cfmmPropertyCheck runs the CFMM invariant at the end of every simulated block.
+// It is not registered with the crisis module, as it keeps its baseline in memory.
+type cfmmPropertyCheck struct {
+	invariant sdk.Invariant
+}
+
+func (c cfmmPropertyCheck) SubscriptionKeys() []string {
+	return []string{simtypes.BlockEndKey}
+}
+
+func (c cfmmPropertyCheck) Check(sim *simtypes.SimCtx, ctx sdk.Context, key string, value interface{}) error {
+	if msg, broken := c.invariant(ctx); broken {
+		return errors.New(msg)
+	}
+	return nil
+}
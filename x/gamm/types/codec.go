@@ -20,10 +20,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJoinSwapShareAmountOut{}, "osmosis/gamm/join-swap-share-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapExternAmountOut{}, "osmosis/gamm/exit-swap-extern-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapShareAmountIn{}, "osmosis/gamm/exit-swap-share-amount-in", nil)
+	cdc.RegisterConcrete(&MsgJoinPoolAndLock{}, "osmosis/gamm/join-pool-and-lock", nil)
+	cdc.RegisterConcrete(&MsgExitSwapShareAmountInMaxPriceImpact{}, "osmosis/gamm/exit-swap-max-price-impact", nil)
+	cdc.RegisterConcrete(&MsgExitSwapShareAmountInToDenoms{}, "osmosis/gamm/exit-swap-to-denoms", nil)
 	cdc.RegisterConcrete(&UpdateMigrationRecordsProposal{}, "osmosis/gamm/update-migration-records-proposal", nil)
 	cdc.RegisterConcrete(&ReplaceMigrationRecordsProposal{}, "osmosis/gamm/replace-migration-records-proposal", nil)
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal{}, "osmosis/gamm/create-cl-pool-and-cfmm-link", nil)
 	cdc.RegisterConcrete(&SetScalingFactorControllerProposal{}, "osmosis/gamm/scaling-factor-controller", nil)
+	cdc.RegisterConcrete(&SetPoolDrainProtectionProposal{}, "osmosis/gamm/set-pool-drain-protection", nil)
+	cdc.RegisterConcrete(&SetPoolRebalancingFeeDiscountProposal{}, "osmosis/gamm/set-pool-rebalancing-discount", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -42,6 +47,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgJoinSwapShareAmountOut{},
 		&MsgExitSwapExternAmountOut{},
 		&MsgExitSwapShareAmountIn{},
+		&MsgJoinPoolAndLock{},
+		&MsgExitSwapShareAmountInMaxPriceImpact{},
+		&MsgExitSwapShareAmountInToDenoms{},
 	)
 
 	registry.RegisterImplementations(
@@ -50,6 +58,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ReplaceMigrationRecordsProposal{},
 		&CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal{},
 		&SetScalingFactorControllerProposal{},
+		&SetPoolDrainProtectionProposal{},
+		&SetPoolRebalancingFeeDiscountProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
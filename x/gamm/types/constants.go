@@ -11,6 +11,14 @@ const (
 	SigFigsExponent       = 8
 	BalancerGasFeeForSwap = 10_000
 
+	// Base gas costs of the operations on gamm pools, declared to the pool manager for gas estimation.
+	BalancerSwapGas   = 60_000
+	BalancerJoinGas   = 90_000
+	BalancerExitGas   = 80_000
+	StableswapSwapGas = 100_000
+	StableswapJoinGas = 130_000
+	StableswapExitGas = 90_000
+
 	StableswapMinScaledAmtPerAsset = 1
 	// We keep this multiplier at 1, but can increase if needed in the unlikely scenario where default scaling factors of 1 cannot accommodate enough assets
 	ScalingFactorMultiplier = 1
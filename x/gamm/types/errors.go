@@ -108,4 +108,11 @@ var (
 	ErrHitMinScaledAssets         = errorsmod.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
 	ErrNoGaugeToRedirect          = errorsmod.Register(ModuleName, 67, "could not find gauge to redirect")
 	ErrMustHaveTwoDenoms          = errorsmod.Register(ModuleName, 68, "can only have 2 denoms in CL pool")
+	ErrPoolDrainProtection        = errorsmod.Register(ModuleName, 69, "pool reserves would fall below the pool's minimum reserve ratio")
+	ErrInvalidMinReserveRatio     = errorsmod.Register(ModuleName, 70, "min reserve ratio must be in [0, 1)")
+	ErrPriceImpactExceeded        = errorsmod.Register(ModuleName, 71, "price impact exceeds the max price impact")
+	ErrInvalidMaxPriceImpact      = errorsmod.Register(ModuleName, 72, "max price impact must be in (0, 1)")
+	ErrInvalidRebalancingDiscount = errorsmod.Register(ModuleName, 73, "rebalancing fee discount must be in [0, 1]")
+	ErrInvalidScalingFactorRamp   = errorsmod.Register(ModuleName, 74, "invalid scaling factor ramp")
+	ErrInvalidTokenOutDenoms      = errorsmod.Register(ModuleName, 75, "invalid token out denoms")
 )
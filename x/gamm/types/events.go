@@ -6,6 +6,9 @@ const (
 	TypeEvtTokenSwapped  = "token_swapped"
 	TypeEvtMigrateShares = "migrate_shares"
 
+	TypeEvtSmoothWeightChangeCompleted = "smooth_weight_change_completed"
+	TypeEvtPegArbitrage                = "peg_arbitrage"
+
 	AttributeValueCategory     = ModuleName
 	AttributeKeyPoolId         = "pool_id"
 	AttributeKeyPoolIdEntering = "pool_id_entering"
@@ -13,6 +16,8 @@ const (
 	AttributeKeySwapFee        = "swap_fee"
 	AttributeKeyTokensIn       = "tokens_in"
 	AttributeKeyTokensOut      = "tokens_out"
+	AttributeKeyLinkedPoolId   = "linked_pool_id"
+	AttributeKeyDeviation      = "deviation"
 
 	AttributePositionId = "position_id"
 	AttributeAmount0    = "amount0"
@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v26/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v26/x/pool-incentives/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
@@ -23,6 +24,7 @@ type AccountKeeper interface {
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	SetAccount(ctx context.Context, acc sdk.AccountI)
 
+	GetModuleAddress(moduleName string) sdk.AccAddress
 	GetModuleAddressAndPermissions(moduleName string) (addr sdk.AccAddress, permissions []string)
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
 }
@@ -110,3 +112,13 @@ type PoolIncentivesKeeper interface {
 type IncentivesKeeper interface {
 	GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo
 }
+
+// TwapKeeper defines the contract needed to be fulfilled for the twap keeper.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
+
+// LockupKeeper defines the contract needed to be fulfilled for the lockup keeper.
+type LockupKeeper interface {
+	CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (lockuptypes.PeriodLock, error)
+}
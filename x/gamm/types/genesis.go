@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	gammmigration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
 )
@@ -8,10 +11,13 @@ import (
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Pools:            []*codectypes.Any{},
-		NextPoolNumber:   1,
-		Params:           DefaultParams(),
-		MigrationRecords: &gammmigration.MigrationRecords{},
+		Pools:                       []*codectypes.Any{},
+		NextPoolNumber:              1,
+		Params:                      DefaultParams(),
+		MigrationRecords:            &gammmigration.MigrationRecords{},
+		PoolDrainProtections:        []PoolDrainProtection{},
+		PoolRebalancingFeeDiscounts: []PoolRebalancingFeeDiscount{},
+		ScalingFactorRamps:          []ScalingFactorRamp{},
 	}
 }
 
@@ -21,5 +27,54 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, protection := range gs.PoolDrainProtections {
+		if err := protection.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, discount := range gs.PoolRebalancingFeeDiscounts {
+		if err := discount.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, ramp := range gs.ScalingFactorRamps {
+		if err := ramp.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate performs basic validation of a pool drain protection.
+func (p PoolDrainProtection) Validate() error {
+	if p.PoolId == 0 {
+		return fmt.Errorf("invalid pool id for drain protection: %d", p.PoolId)
+	}
+	if err := ValidateMinReserveRatio(p.MinReserveRatio); err != nil {
+		return err
+	}
+	if !p.MinReserveRatio.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMinReserveRatio, "drain protection of pool %d must have a positive ratio", p.PoolId)
+	}
+	if err := p.ReferenceReserves.Validate(); err != nil {
+		return err
+	}
+	if p.ReferenceTotalShares.IsNil() || !p.ReferenceTotalShares.IsPositive() {
+		return fmt.Errorf("drain protection of pool %d must have positive reference total shares", p.PoolId)
+	}
+	return nil
+}
+
+// Validate performs basic validation of a pool rebalancing fee discount.
+func (d PoolRebalancingFeeDiscount) Validate() error {
+	if d.PoolId == 0 {
+		return fmt.Errorf("invalid pool id for rebalancing fee discount: %d", d.PoolId)
+	}
+	if err := ValidateRebalancingFeeDiscount(d.Discount); err != nil {
+		return err
+	}
+	if !d.Discount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidRebalancingDiscount, "rebalancing fee discount of pool %d must be positive", d.PoolId)
+	}
 	return nil
 }
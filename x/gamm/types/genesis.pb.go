@@ -4,22 +4,28 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	migration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,9 +37,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
-	NextPoolNumber   uint64                      `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params           Params                      `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
-	MigrationRecords *migration.MigrationRecords `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	NextPoolNumber              uint64                       `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params                      Params                       `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	MigrationRecords            *migration.MigrationRecords  `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	PoolDrainProtections        []PoolDrainProtection        `protobuf:"bytes,5,rep,name=pool_drain_protections,json=poolDrainProtections,proto3" json:"pool_drain_protections"`
+	PoolRebalancingFeeDiscounts []PoolRebalancingFeeDiscount `protobuf:"bytes,6,rep,name=pool_rebalancing_fee_discounts,json=poolRebalancingFeeDiscounts,proto3" json:"pool_rebalancing_fee_discounts"`
+	ScalingFactorRamps          []ScalingFactorRamp          `protobuf:"bytes,7,rep,name=scaling_factor_ramps,json=scalingFactorRamps,proto3" json:"scaling_factor_ramps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,8 +106,221 @@ func (m *GenesisState) GetMigrationRecords() *migration.MigrationRecords {
 	return nil
 }
 
+func (m *GenesisState) GetPoolDrainProtections() []PoolDrainProtection {
+	if m != nil {
+		return m.PoolDrainProtections
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolRebalancingFeeDiscounts() []PoolRebalancingFeeDiscount {
+	if m != nil {
+		return m.PoolRebalancingFeeDiscounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScalingFactorRamps() []ScalingFactorRamp {
+	if m != nil {
+		return m.ScalingFactorRamps
+	}
+	return nil
+}
+
+// PoolDrainProtection defines the minimum reserve ratio set by governance for
+// a single pool. Swaps and exits that would leave any of the pool's reserves
+// per share below min_reserve_ratio times its reference reserves per share
+// are rejected. The reference is the pool's composition at the time the
+// protection was set.
+type PoolDrainProtection struct {
+	PoolId               uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	MinReserveRatio      cosmossdk_io_math.LegacyDec              `protobuf:"bytes,2,opt,name=min_reserve_ratio,json=minReserveRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_reserve_ratio" yaml:"min_reserve_ratio"`
+	ReferenceReserves    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reference_reserves,json=referenceReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reference_reserves" yaml:"reference_reserves"`
+	ReferenceTotalShares cosmossdk_io_math.Int                    `protobuf:"bytes,4,opt,name=reference_total_shares,json=referenceTotalShares,proto3,customtype=cosmossdk.io/math.Int" json:"reference_total_shares" yaml:"reference_total_shares"`
+}
+
+func (m *PoolDrainProtection) Reset()         { *m = PoolDrainProtection{} }
+func (m *PoolDrainProtection) String() string { return proto.CompactTextString(m) }
+func (*PoolDrainProtection) ProtoMessage()    {}
+func (*PoolDrainProtection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+}
+func (m *PoolDrainProtection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDrainProtection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDrainProtection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDrainProtection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDrainProtection.Merge(m, src)
+}
+func (m *PoolDrainProtection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDrainProtection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDrainProtection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDrainProtection proto.InternalMessageInfo
+
+func (m *PoolDrainProtection) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolDrainProtection) GetReferenceReserves() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReferenceReserves
+	}
+	return nil
+}
+
+// PoolRebalancingFeeDiscount defines the spread factor discount set by
+// governance for swaps that move a pool's weighted balances back toward each
+// other. The discounted spread factor is the pool's spread factor times
+// (1 - discount).
+type PoolRebalancingFeeDiscount struct {
+	PoolId   uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount" yaml:"discount"`
+}
+
+func (m *PoolRebalancingFeeDiscount) Reset()         { *m = PoolRebalancingFeeDiscount{} }
+func (m *PoolRebalancingFeeDiscount) String() string { return proto.CompactTextString(m) }
+func (*PoolRebalancingFeeDiscount) ProtoMessage()    {}
+func (*PoolRebalancingFeeDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{2}
+}
+func (m *PoolRebalancingFeeDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRebalancingFeeDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRebalancingFeeDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRebalancingFeeDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRebalancingFeeDiscount.Merge(m, src)
+}
+func (m *PoolRebalancingFeeDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRebalancingFeeDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRebalancingFeeDiscount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRebalancingFeeDiscount proto.InternalMessageInfo
+
+func (m *PoolRebalancingFeeDiscount) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// ScalingFactorRamp defines a linear change of a stableswap pool's scaling
+// factors over a time window. Every block until end_time, the pool's scaling
+// factors are set to the linear interpolation between start_scaling_factors
+// and target_scaling_factors at the block time. The scaling factors include
+// the stableswap scaling factor multiplier.
+type ScalingFactorRamp struct {
+	PoolId               uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	StartScalingFactors  []uint64  `protobuf:"varint,2,rep,packed,name=start_scaling_factors,json=startScalingFactors,proto3" json:"start_scaling_factors,omitempty" yaml:"start_scaling_factors"`
+	TargetScalingFactors []uint64  `protobuf:"varint,3,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+	StartTime            time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime              time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *ScalingFactorRamp) Reset()         { *m = ScalingFactorRamp{} }
+func (m *ScalingFactorRamp) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRamp) ProtoMessage()    {}
+func (*ScalingFactorRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{3}
+}
+func (m *ScalingFactorRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRamp.Merge(m, src)
+}
+func (m *ScalingFactorRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRamp proto.InternalMessageInfo
+
+func (m *ScalingFactorRamp) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *ScalingFactorRamp) GetStartScalingFactors() []uint64 {
+	if m != nil {
+		return m.StartScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorRamp) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorRamp) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorRamp) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
+	proto.RegisterType((*PoolDrainProtection)(nil), "osmosis.gamm.v1beta1.PoolDrainProtection")
+	proto.RegisterType((*PoolRebalancingFeeDiscount)(nil), "osmosis.gamm.v1beta1.PoolRebalancingFeeDiscount")
+	proto.RegisterType((*ScalingFactorRamp)(nil), "osmosis.gamm.v1beta1.ScalingFactorRamp")
 }
 
 func init() {
@@ -106,32 +328,175 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x93, 0xfe, 0x83, 0x2f, 0xfd, 0x90, 0x1a, 0xba, 0x88, 0x45, 0x62, 0xed, 0x42, 0xb2,
-	0xe9, 0x8c, 0xad, 0xe8, 0xc2, 0x9d, 0xdd, 0x88, 0x82, 0x52, 0xd2, 0x9d, 0x9b, 0x30, 0x49, 0xc7,
-	0x69, 0x20, 0x93, 0x1b, 0x32, 0xd3, 0xd2, 0xee, 0x7c, 0x04, 0x1f, 0xc6, 0x87, 0x28, 0xae, 0xba,
-	0x74, 0x25, 0xd2, 0xbe, 0x88, 0x24, 0x93, 0xb8, 0x90, 0xe2, 0x2e, 0xe7, 0xde, 0xdf, 0x3d, 0x39,
-	0x73, 0x8c, 0x1e, 0x08, 0x0e, 0x22, 0x14, 0x98, 0x11, 0xce, 0xf1, 0x62, 0xe0, 0x53, 0x49, 0x06,
-	0x98, 0xd1, 0x98, 0x8a, 0x50, 0xa0, 0x24, 0x05, 0x09, 0x66, 0xbb, 0x60, 0x50, 0xc6, 0xa0, 0x82,
-	0xe9, 0xb4, 0x19, 0x30, 0xc8, 0x01, 0x9c, 0x7d, 0x29, 0xb6, 0x73, 0xc4, 0x00, 0x58, 0x44, 0x71,
-	0xae, 0xfc, 0xf9, 0x33, 0x26, 0xf1, 0xaa, 0x5c, 0x05, 0xb9, 0x8f, 0xa7, 0x6e, 0x94, 0x28, 0x56,
-	0xb6, 0x52, 0xd8, 0x27, 0x82, 0xfe, 0x84, 0x08, 0x20, 0x8c, 0x8b, 0xfd, 0xe9, 0xde, 0x94, 0x62,
-	0x46, 0x52, 0x3a, 0xfd, 0x13, 0x49, 0x48, 0x4a, 0x78, 0xf1, 0x97, 0xde, 0x4b, 0xc5, 0xf8, 0x7f,
-	0xab, 0x5e, 0x36, 0x91, 0x44, 0x52, 0xf3, 0xd2, 0xa8, 0x27, 0x00, 0x91, 0xb0, 0xf4, 0x6e, 0xd5,
-	0x69, 0x0e, 0xdb, 0x48, 0x85, 0x47, 0x65, 0x78, 0x74, 0x13, 0xaf, 0x46, 0xff, 0xde, 0xdf, 0xfa,
-	0xf5, 0x31, 0x40, 0x74, 0xe7, 0x2a, 0xda, 0x74, 0x8c, 0x56, 0x4c, 0x97, 0xd2, 0xcb, 0x94, 0x17,
-	0xcf, 0xb9, 0x4f, 0x53, 0xab, 0xd2, 0xd5, 0x9d, 0x9a, 0x7b, 0x90, 0xcd, 0x33, 0xf6, 0x31, 0x9f,
-	0x9a, 0xd7, 0x46, 0x43, 0x25, 0xb0, 0xaa, 0x5d, 0xdd, 0x69, 0x0e, 0x8f, 0xd1, 0xbe, 0x2a, 0xd1,
-	0x38, 0x67, 0x46, 0xb5, 0xf5, 0xe7, 0x89, 0xe6, 0x16, 0x17, 0xe6, 0xc4, 0x38, 0xe4, 0x21, 0x4b,
-	0x89, 0x0c, 0x21, 0xf6, 0x52, 0x1a, 0x40, 0x3a, 0x15, 0x56, 0x2d, 0xb7, 0x39, 0xdb, 0x6f, 0xf3,
-	0x50, 0xe2, 0xae, 0xa2, 0xdd, 0x16, 0xff, 0x35, 0x19, 0xdd, 0xaf, 0xb7, 0xb6, 0xbe, 0xd9, 0xda,
-	0xfa, 0xd7, 0xd6, 0xd6, 0x5f, 0x77, 0xb6, 0xb6, 0xd9, 0xd9, 0xda, 0xc7, 0xce, 0xd6, 0x9e, 0xce,
-	0x59, 0x28, 0x67, 0x73, 0x1f, 0x05, 0xc0, 0x71, 0xe1, 0xde, 0x8f, 0x88, 0x2f, 0x4a, 0x81, 0x17,
-	0xc3, 0x2b, 0xbc, 0x54, 0xed, 0xca, 0x55, 0x42, 0x85, 0xdf, 0xc8, 0x6b, 0xba, 0xf8, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0x1a, 0xaa, 0xcb, 0x2f, 0x43, 0x02, 0x00, 0x00,
+	// 871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xbb, 0x9b, 0x84, 0x4c, 0x51, 0xdb, 0x4c, 0xb7, 0x91, 0x93, 0xb4, 0xf6, 0xd6, 0x07,
+	0x58, 0x84, 0x62, 0xb7, 0x41, 0xf4, 0x90, 0x03, 0x08, 0x13, 0x15, 0x05, 0x51, 0x14, 0x4d, 0x22,
+	0x81, 0xb8, 0x58, 0x63, 0x7b, 0xe2, 0x58, 0xb1, 0x67, 0xac, 0x99, 0x49, 0xd4, 0x15, 0x5f, 0xa2,
+	0x37, 0xae, 0x48, 0xdc, 0x38, 0xf3, 0x15, 0x2a, 0x55, 0x9c, 0x7a, 0x44, 0x1c, 0xb6, 0x28, 0xb9,
+	0x70, 0xde, 0x4f, 0x80, 0xe6, 0x8f, 0x37, 0xe9, 0xee, 0x06, 0xe8, 0x69, 0x77, 0xde, 0xfb, 0xbd,
+	0xdf, 0xfb, 0xcd, 0xfb, 0xe3, 0x01, 0x01, 0x13, 0x35, 0x13, 0xa5, 0x88, 0x0a, 0x5c, 0xd7, 0xd1,
+	0xd9, 0xe3, 0x94, 0x48, 0xfc, 0x38, 0x2a, 0x08, 0x25, 0xa2, 0x14, 0x61, 0xc3, 0x99, 0x64, 0xb0,
+	0x67, 0x31, 0xa1, 0xc2, 0x84, 0x16, 0xb3, 0xd1, 0x2b, 0x58, 0xc1, 0x34, 0x20, 0x52, 0xff, 0x0c,
+	0x76, 0x63, 0xbd, 0x60, 0xac, 0xa8, 0x48, 0xa4, 0x4f, 0xe9, 0xe9, 0x51, 0x84, 0xe9, 0xd0, 0xba,
+	0xfc, 0x69, 0x97, 0x2c, 0x6b, 0x22, 0x24, 0xae, 0x9b, 0x36, 0x36, 0xd3, 0x89, 0x12, 0x43, 0x6a,
+	0x0e, 0xd6, 0xe5, 0x99, 0x53, 0x94, 0x62, 0x41, 0x26, 0x2a, 0x33, 0x56, 0x52, 0xeb, 0x7f, 0x38,
+	0xf7, 0x1a, 0xe2, 0x18, 0x73, 0x92, 0xff, 0x2b, 0xa4, 0xc1, 0x1c, 0xd7, 0x36, 0x4b, 0xf0, 0xb2,
+	0x0b, 0xde, 0xff, 0xca, 0x5c, 0xfd, 0x40, 0x62, 0x49, 0xe0, 0xa7, 0x60, 0xb1, 0x61, 0xac, 0x12,
+	0xae, 0xd3, 0xef, 0x0c, 0x6e, 0x6e, 0xf7, 0x42, 0x73, 0x85, 0xb0, 0xbd, 0x42, 0xf8, 0x05, 0x1d,
+	0xc6, 0x2b, 0xbf, 0xff, 0xb6, 0xb5, 0xb8, 0xcf, 0x58, 0xb5, 0x87, 0x0c, 0x1a, 0x0e, 0xc0, 0x1d,
+	0x4a, 0x9e, 0xcb, 0x44, 0x9d, 0x12, 0x7a, 0x5a, 0xa7, 0x84, 0xbb, 0x37, 0xfa, 0xce, 0xa0, 0x8b,
+	0x6e, 0x29, 0xbb, 0xc2, 0x7e, 0xab, 0xad, 0x70, 0x07, 0x2c, 0x19, 0x05, 0x6e, 0xa7, 0xef, 0x0c,
+	0x6e, 0x6e, 0xdf, 0x0f, 0xe7, 0xd5, 0x3a, 0xdc, 0xd7, 0x98, 0xb8, 0xfb, 0x6a, 0xe4, 0x2f, 0x20,
+	0x1b, 0x01, 0x0f, 0xc0, 0x6a, 0x5d, 0x16, 0x1c, 0xcb, 0x92, 0xd1, 0x84, 0x93, 0x8c, 0xf1, 0x5c,
+	0xb8, 0x5d, 0x4d, 0xf3, 0xc1, 0x7c, 0x9a, 0x67, 0x2d, 0x1c, 0x19, 0x34, 0xba, 0x53, 0x4f, 0x59,
+	0x20, 0x01, 0x6b, 0x5a, 0x75, 0xce, 0x71, 0x49, 0x75, 0x27, 0x48, 0xa6, 0xfc, 0xc2, 0x5d, 0xd4,
+	0x25, 0xf8, 0xe8, 0x1a, 0x81, 0x8c, 0x55, 0xbb, 0x2a, 0x64, 0x7f, 0x12, 0x61, 0xd5, 0xf6, 0x9a,
+	0x59, 0x97, 0x80, 0x3f, 0x02, 0x4f, 0xa7, 0xe1, 0x24, 0xc5, 0x15, 0xa6, 0x59, 0x49, 0x8b, 0xe4,
+	0x88, 0x90, 0x24, 0x2f, 0x45, 0xc6, 0x4e, 0xa9, 0x14, 0xee, 0x92, 0x4e, 0xf7, 0xe8, 0xfa, 0x74,
+	0xe8, 0x32, 0xf4, 0x29, 0x21, 0xbb, 0x36, 0xd0, 0x66, 0xdd, 0x6c, 0xae, 0x45, 0x08, 0x98, 0x80,
+	0x9e, 0xc8, 0x70, 0xa5, 0x73, 0xe2, 0x4c, 0x32, 0x9e, 0x70, 0x5c, 0x37, 0xc2, 0x5d, 0xd6, 0x29,
+	0x3f, 0x9c, 0x9f, 0xf2, 0xc0, 0x44, 0x3c, 0xd5, 0x01, 0x08, 0xd7, 0x8d, 0xcd, 0x04, 0xc5, 0xb4,
+	0x43, 0x04, 0x2f, 0x3b, 0xe0, 0xee, 0x9c, 0x8a, 0xc0, 0x8f, 0xc1, 0xb2, 0xbe, 0x75, 0x99, 0xbb,
+	0x8e, 0x1a, 0x87, 0x18, 0x8e, 0x47, 0xfe, 0xad, 0x21, 0xae, 0xab, 0x9d, 0xc0, 0x3a, 0x02, 0xb4,
+	0xa4, 0xfe, 0xed, 0xe5, 0xf0, 0x44, 0xb5, 0x57, 0x35, 0x56, 0x10, 0x7e, 0x46, 0x12, 0xdd, 0x26,
+	0x3d, 0x45, 0x2b, 0xf1, 0xe7, 0x2a, 0xf3, 0x9f, 0x23, 0x7f, 0xd3, 0x6c, 0x85, 0xc8, 0x4f, 0xc2,
+	0x92, 0x45, 0x35, 0x96, 0xc7, 0xe1, 0x37, 0xa4, 0xc0, 0xd9, 0x70, 0x97, 0x64, 0xe3, 0x91, 0xef,
+	0x1a, 0xe6, 0x19, 0x96, 0x00, 0xdd, 0xae, 0x4b, 0x8a, 0x8c, 0x09, 0x29, 0x0b, 0xfc, 0xc9, 0x01,
+	0x90, 0x93, 0x23, 0xc2, 0x09, 0xcd, 0x48, 0x8b, 0x56, 0x43, 0xa9, 0x2a, 0xb2, 0x1e, 0xda, 0x5d,
+	0x54, 0xdb, 0x37, 0x29, 0xc8, 0x97, 0xac, 0xa4, 0xf1, 0x33, 0xa5, 0x64, 0x3c, 0xf2, 0xd7, 0x4d,
+	0xaa, 0x59, 0x8a, 0xe0, 0xd7, 0x37, 0xfe, 0xa0, 0x28, 0xe5, 0xf1, 0x69, 0x1a, 0x66, 0xac, 0xb6,
+	0x5b, 0x6d, 0x7f, 0xb6, 0x44, 0x7e, 0x12, 0xc9, 0x61, 0x43, 0x84, 0x66, 0x13, 0x68, 0x75, 0x42,
+	0x60, 0xd5, 0x09, 0x28, 0xc1, 0xda, 0x25, 0xab, 0x64, 0x12, 0x57, 0x89, 0x5e, 0x6b, 0x33, 0xea,
+	0x2b, 0xf1, 0x67, 0xb6, 0x16, 0xf7, 0x66, 0x6b, 0xb1, 0x47, 0xe5, 0x78, 0xe4, 0x3f, 0x98, 0x96,
+	0x76, 0x95, 0x24, 0x40, 0xbd, 0x89, 0xe3, 0x50, 0xd9, 0x0f, 0xb4, 0x79, 0xa7, 0xfb, 0xf7, 0xcf,
+	0xbe, 0x13, 0xfc, 0xe2, 0x80, 0x8d, 0xeb, 0x47, 0xed, 0xdd, 0xda, 0x89, 0xc0, 0x7b, 0xed, 0x70,
+	0xdb, 0x2e, 0x3e, 0xf9, 0x7f, 0x5d, 0xbc, 0x6d, 0x08, 0xdb, 0xe0, 0x00, 0x4d, 0x78, 0x5a, 0x95,
+	0x1d, 0xb0, 0x3a, 0x33, 0x9d, 0xef, 0x26, 0xee, 0x10, 0xdc, 0x13, 0x12, 0x73, 0x99, 0xbc, 0xbd,
+	0x17, 0xc2, 0xbd, 0xd1, 0xef, 0x0c, 0xba, 0x71, 0x7f, 0x3c, 0xf2, 0xef, 0x9b, 0xd0, 0xb9, 0xb0,
+	0x00, 0xdd, 0xd5, 0xf6, 0xb7, 0x54, 0x08, 0xf8, 0x1d, 0x58, 0x93, 0x98, 0x17, 0x64, 0x96, 0xb6,
+	0xa3, 0x69, 0x1f, 0x5e, 0x76, 0x67, 0x3e, 0x2e, 0x40, 0x3d, 0xe3, 0x98, 0x22, 0xfe, 0x1e, 0x00,
+	0xa3, 0x43, 0xbd, 0x20, 0xf6, 0x93, 0xb7, 0x31, 0xf3, 0x6d, 0x3e, 0x6c, 0x9f, 0x97, 0xf8, 0x81,
+	0x9d, 0xd2, 0xd5, 0xab, 0x77, 0x50, 0xb1, 0xc1, 0x8b, 0x37, 0xbe, 0x83, 0x56, 0xb4, 0x41, 0xc1,
+	0x55, 0x97, 0x08, 0xcd, 0x0d, 0xef, 0xe2, 0x7f, 0xf2, 0x6e, 0x5a, 0x5e, 0xdb, 0xa2, 0x36, 0xd2,
+	0xb0, 0x2e, 0x13, 0x9a, 0x2b, 0xa8, 0xe9, 0x52, 0xfc, 0xf5, 0xab, 0x73, 0xcf, 0x79, 0x7d, 0xee,
+	0x39, 0x7f, 0x9d, 0x7b, 0xce, 0x8b, 0x0b, 0x6f, 0xe1, 0xf5, 0x85, 0xb7, 0xf0, 0xc7, 0x85, 0xb7,
+	0xf0, 0xc3, 0xa3, 0x2b, 0xeb, 0x61, 0x3f, 0x3d, 0x5b, 0x15, 0x4e, 0x45, 0x7b, 0x88, 0xce, 0xb6,
+	0x9f, 0x44, 0xcf, 0xcd, 0xb3, 0xa5, 0x97, 0x25, 0x5d, 0xd2, 0x5a, 0x3e, 0xf9, 0x67, 0x00, 0x5d,
+	0xd0, 0x96, 0x77, 0xbd, 0x07, 0x00, 0x00,
+}
+
+func (this *PoolDrainProtection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolDrainProtection)
+	if !ok {
+		that2, ok := that.(PoolDrainProtection)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.MinReserveRatio.Equal(that1.MinReserveRatio) {
+		return false
+	}
+	if len(this.ReferenceReserves) != len(that1.ReferenceReserves) {
+		return false
+	}
+	for i := range this.ReferenceReserves {
+		if !this.ReferenceReserves[i].Equal(&that1.ReferenceReserves[i]) {
+			return false
+		}
+	}
+	if !this.ReferenceTotalShares.Equal(that1.ReferenceTotalShares) {
+		return false
+	}
+	return true
+}
+func (this *PoolRebalancingFeeDiscount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolRebalancingFeeDiscount)
+	if !ok {
+		that2, ok := that.(PoolRebalancingFeeDiscount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.Discount.Equal(that1.Discount) {
+		return false
+	}
+	return true
 }
+func (this *ScalingFactorRamp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*ScalingFactorRamp)
+	if !ok {
+		that2, ok := that.(ScalingFactorRamp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if len(this.StartScalingFactors) != len(that1.StartScalingFactors) {
+		return false
+	}
+	for i := range this.StartScalingFactors {
+		if this.StartScalingFactors[i] != that1.StartScalingFactors[i] {
+			return false
+		}
+	}
+	if len(this.TargetScalingFactors) != len(that1.TargetScalingFactors) {
+		return false
+	}
+	for i := range this.TargetScalingFactors {
+		if this.TargetScalingFactors[i] != that1.TargetScalingFactors[i] {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	return true
+}
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -152,92 +517,843 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScalingFactorRamps) > 0 {
+		for iNdEx := len(m.ScalingFactorRamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScalingFactorRamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PoolRebalancingFeeDiscounts) > 0 {
+		for iNdEx := len(m.PoolRebalancingFeeDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRebalancingFeeDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PoolDrainProtections) > 0 {
+		for iNdEx := len(m.PoolDrainProtections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolDrainProtections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.MigrationRecords != nil {
 		{
 			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.NextPoolNumber != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolNumber))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NextPoolNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolDrainProtection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDrainProtection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDrainProtection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReferenceTotalShares.Size()
+		i -= size
+		if _, err := m.ReferenceTotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ReferenceReserves) > 0 {
+		for iNdEx := len(m.ReferenceReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferenceReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.MinReserveRatio.Size()
+		i -= size
+		if _, err := m.MinReserveRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRebalancingFeeDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRebalancingFeeDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRebalancingFeeDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScalingFactorRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA6 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j5 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StartScalingFactors) > 0 {
+		dAtA8 := make([]byte, len(m.StartScalingFactors)*10)
+		var j7 int
+		for _, num := range m.StartScalingFactors {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGenesis(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPoolNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPoolNumber))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MigrationRecords != nil {
+		l = m.MigrationRecords.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PoolDrainProtections) > 0 {
+		for _, e := range m.PoolDrainProtections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolRebalancingFeeDiscounts) > 0 {
+		for _, e := range m.PoolRebalancingFeeDiscounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScalingFactorRamps) > 0 {
+		for _, e := range m.ScalingFactorRamps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolDrainProtection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.MinReserveRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReferenceReserves) > 0 {
+		for _, e := range m.ReferenceReserves {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ReferenceTotalShares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PoolRebalancingFeeDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ScalingFactorRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.StartScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.StartScalingFactors {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolNumber", wireType)
+			}
+			m.NextPoolNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPoolNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationRecords == nil {
+				m.MigrationRecords = &migration.MigrationRecords{}
+			}
+			if err := m.MigrationRecords.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDrainProtections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDrainProtections = append(m.PoolDrainProtections, PoolDrainProtection{})
+			if err := m.PoolDrainProtections[len(m.PoolDrainProtections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRebalancingFeeDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRebalancingFeeDiscounts = append(m.PoolRebalancingFeeDiscounts, PoolRebalancingFeeDiscount{})
+			if err := m.PoolRebalancingFeeDiscounts[len(m.PoolRebalancingFeeDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingFactorRamps = append(m.ScalingFactorRamps, ScalingFactorRamp{})
+			if err := m.ScalingFactorRamps[len(m.ScalingFactorRamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolDrainProtection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDrainProtection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDrainProtection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReserveRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReserveRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceReserves = append(m.ReferenceReserves, types1.Coin{})
+			if err := m.ReferenceReserves[len(m.ReferenceReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceTotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferenceTotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.NextPoolNumber != 0 {
-		n += 1 + sovGenesis(uint64(m.NextPoolNumber))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.MigrationRecords != nil {
-		l = m.MigrationRecords.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *PoolRebalancingFeeDiscount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -260,17 +1376,36 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRebalancingFeeDiscount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRebalancingFeeDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -280,31 +1415,81 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.NextPoolNumber = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -314,14 +1499,166 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextPoolNumber |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StartScalingFactors = append(m.StartScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.StartScalingFactors) == 0 {
+					m.StartScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StartScalingFactors = append(m.StartScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartScalingFactors", wireType)
+			}
 		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -348,13 +1685,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -381,10 +1718,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MigrationRecords == nil {
-				m.MigrationRecords = &migration.MigrationRecords{}
-			}
-			if err := m.MigrationRecords.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
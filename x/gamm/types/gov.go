@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -17,6 +18,8 @@ const (
 	ProposalTypeReplaceMigrationRecords                      = "ReplaceMigrationRecords"
 	ProposalTypeCreateConcentratedLiquidityPoolAndLinktoCFMM = "CreateConcentratedLiquidityPoolAndLinktoCFMM"
 	ProposalTypeSetScalingFactorController                   = "SetScalingFactorController"
+	ProposalTypeSetPoolDrainProtection                       = "SetPoolDrainProtection"
+	ProposalTypeSetPoolRebalancingFeeDiscount                = "SetPoolRebalancingFeeDiscount"
 )
 
 // Init registers proposals to update and replace migration records.
@@ -25,6 +28,8 @@ func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeReplaceMigrationRecords)
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPoolAndLinktoCFMM)
 	govtypesv1.RegisterProposalType(ProposalTypeSetScalingFactorController)
+	govtypesv1.RegisterProposalType(ProposalTypeSetPoolDrainProtection)
+	govtypesv1.RegisterProposalType(ProposalTypeSetPoolRebalancingFeeDiscount)
 }
 
 var (
@@ -32,6 +37,8 @@ var (
 	_ govtypesv1.Content = &ReplaceMigrationRecordsProposal{}
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal{}
 	_ govtypesv1.Content = &SetScalingFactorControllerProposal{}
+	_ govtypesv1.Content = &SetPoolDrainProtectionProposal{}
+	_ govtypesv1.Content = &SetPoolRebalancingFeeDiscountProposal{}
 )
 
 // NewReplacePoolIncentivesProposal returns a new instance of a replace migration record's proposal struct.
@@ -266,3 +273,119 @@ func (p SetScalingFactorControllerProposal) String() string {
 `, p.Title, p.Description, p.PoolId, p.ControllerAddress))
 	return b.String()
 }
+
+// NewSetPoolDrainProtectionProposal returns a new instance of a set pool drain protection proposal struct.
+func NewSetPoolDrainProtectionProposal(title, description string, poolId uint64, minReserveRatio osmomath.Dec) govtypesv1.Content {
+	return &SetPoolDrainProtectionProposal{
+		Title:           title,
+		Description:     description,
+		PoolId:          poolId,
+		MinReserveRatio: minReserveRatio,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetPoolDrainProtectionProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetPoolDrainProtectionProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetPoolDrainProtectionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetPoolDrainProtectionProposal) ProposalType() string {
+	return ProposalTypeSetPoolDrainProtection
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetPoolDrainProtectionProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("Invalid Pool Id")
+	}
+
+	return ValidateMinReserveRatio(p.MinReserveRatio)
+}
+
+// String returns a string containing the set pool drain protection proposal.
+func (p SetPoolDrainProtectionProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Drain Protection Proposal:
+  Title:           %s
+  Description:     %s
+  PoolId:          %d
+  MinReserveRatio: %s
+`, p.Title, p.Description, p.PoolId, p.MinReserveRatio))
+	return b.String()
+}
+
+// ValidateMinReserveRatio validates that a pool's min reserve ratio is in [0, 1).
+// A ratio of 1 would block every swap out of the pool.
+func ValidateMinReserveRatio(minReserveRatio osmomath.Dec) error {
+	if minReserveRatio.IsNil() || minReserveRatio.IsNegative() || minReserveRatio.GTE(osmomath.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidMinReserveRatio, "got %s", minReserveRatio)
+	}
+	return nil
+}
+
+// NewSetPoolRebalancingFeeDiscountProposal returns a new instance of a set pool rebalancing fee discount proposal struct.
+func NewSetPoolRebalancingFeeDiscountProposal(title, description string, poolId uint64, discount osmomath.Dec) govtypesv1.Content {
+	return &SetPoolRebalancingFeeDiscountProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		Discount:    discount,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetPoolRebalancingFeeDiscountProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetPoolRebalancingFeeDiscountProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetPoolRebalancingFeeDiscountProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetPoolRebalancingFeeDiscountProposal) ProposalType() string {
+	return ProposalTypeSetPoolRebalancingFeeDiscount
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetPoolRebalancingFeeDiscountProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("Invalid Pool Id")
+	}
+
+	return ValidateRebalancingFeeDiscount(p.Discount)
+}
+
+// String returns a string containing the set pool rebalancing fee discount proposal.
+func (p SetPoolRebalancingFeeDiscountProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Rebalancing Fee Discount Proposal:
+  Title:       %s
+  Description: %s
+  PoolId:      %d
+  Discount:    %s
+`, p.Title, p.Description, p.PoolId, p.Discount))
+	return b.String()
+}
+
+// ValidateRebalancingFeeDiscount validates that a pool's rebalancing fee discount is in [0, 1].
+// A discount of 1 waives the spread factor of rebalancing swaps entirely.
+func ValidateRebalancingFeeDiscount(discount osmomath.Dec) error {
+	if discount.IsNil() || discount.IsNegative() || discount.GT(osmomath.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidRebalancingDiscount, "got %s", discount)
+	}
+	return nil
+}
@@ -269,69 +269,163 @@ func (m *SetScalingFactorControllerProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetScalingFactorControllerProposal proto.InternalMessageInfo
 
+// SetPoolDrainProtectionProposal is a gov Content type for setting the minimum
+// reserve ratio of a pool. If the proposal passes, the pool's current
+// reserves per share become the reference the ratio is measured against,
+// overriding any previously set protection. A min_reserve_ratio of zero
+// removes the protection from the pool.
+type SetPoolDrainProtectionProposal struct {
+	Title           string                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId          uint64                      `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	MinReserveRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_reserve_ratio,json=minReserveRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_reserve_ratio"`
+}
+
+func (m *SetPoolDrainProtectionProposal) Reset()      { *m = SetPoolDrainProtectionProposal{} }
+func (*SetPoolDrainProtectionProposal) ProtoMessage() {}
+func (*SetPoolDrainProtectionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{5}
+}
+func (m *SetPoolDrainProtectionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolDrainProtectionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolDrainProtectionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolDrainProtectionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolDrainProtectionProposal.Merge(m, src)
+}
+func (m *SetPoolDrainProtectionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolDrainProtectionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolDrainProtectionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolDrainProtectionProposal proto.InternalMessageInfo
+
+// SetPoolRebalancingFeeDiscountProposal is a gov Content type for setting the
+// spread factor discount of a pool for swaps that restore its weighted
+// balances. A discount of zero removes the discount from the pool.
+type SetPoolRebalancingFeeDiscountProposal struct {
+	Title       string                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId      uint64                      `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Discount    cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+}
+
+func (m *SetPoolRebalancingFeeDiscountProposal) Reset()      { *m = SetPoolRebalancingFeeDiscountProposal{} }
+func (*SetPoolRebalancingFeeDiscountProposal) ProtoMessage() {}
+func (*SetPoolRebalancingFeeDiscountProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{6}
+}
+func (m *SetPoolRebalancingFeeDiscountProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolRebalancingFeeDiscountProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolRebalancingFeeDiscountProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolRebalancingFeeDiscountProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolRebalancingFeeDiscountProposal.Merge(m, src)
+}
+func (m *SetPoolRebalancingFeeDiscountProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolRebalancingFeeDiscountProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolRebalancingFeeDiscountProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolRebalancingFeeDiscountProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ReplaceMigrationRecordsProposal)(nil), "osmosis.gamm.v1beta1.ReplaceMigrationRecordsProposal")
 	proto.RegisterType((*UpdateMigrationRecordsProposal)(nil), "osmosis.gamm.v1beta1.UpdateMigrationRecordsProposal")
 	proto.RegisterType((*PoolRecordWithCFMMLink)(nil), "osmosis.gamm.v1beta1.PoolRecordWithCFMMLink")
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal)(nil), "osmosis.gamm.v1beta1.CreateConcentratedLiquidityPoolsAndLinktoCFMMProposal")
 	proto.RegisterType((*SetScalingFactorControllerProposal)(nil), "osmosis.gamm.v1beta1.SetScalingFactorControllerProposal")
+	proto.RegisterType((*SetPoolDrainProtectionProposal)(nil), "osmosis.gamm.v1beta1.SetPoolDrainProtectionProposal")
+	proto.RegisterType((*SetPoolRebalancingFeeDiscountProposal)(nil), "osmosis.gamm.v1beta1.SetPoolRebalancingFeeDiscountProposal")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/gov.proto", fileDescriptor_f31b9a6c0dbbdfa3) }
 
 var fileDescriptor_f31b9a6c0dbbdfa3 = []byte{
-	// 806 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x6c, 0x56, 0x4c, 0x77, 0x11, 0x35, 0x59, 0x12, 0x5a, 0x64, 0x07, 0x1f, 0x96,
-	0xb2, 0xa2, 0xf6, 0x66, 0xf9, 0x71, 0x08, 0xda, 0x43, 0x13, 0x58, 0x69, 0x51, 0x2b, 0x2a, 0x77,
-	0x17, 0x04, 0x17, 0x33, 0x19, 0x4f, 0x9d, 0x51, 0xec, 0x19, 0x33, 0x33, 0x2d, 0xcd, 0x1f, 0x80,
-	0x40, 0x9c, 0x38, 0x22, 0x4e, 0xfd, 0x13, 0x38, 0xf0, 0x47, 0x54, 0x70, 0xe9, 0x11, 0x71, 0xb0,
-	0x50, 0x7b, 0x80, 0x73, 0x8e, 0x5c, 0x40, 0x33, 0x63, 0x97, 0x42, 0x23, 0xaa, 0xd2, 0x13, 0x97,
-	0x28, 0xef, 0xbd, 0xcf, 0xdf, 0xe7, 0xf7, 0xbd, 0xf7, 0x64, 0xe0, 0x30, 0x91, 0x31, 0x41, 0x44,
-	0x90, 0xc0, 0x2c, 0x0b, 0xf6, 0xfb, 0x63, 0x2c, 0x61, 0x3f, 0x48, 0xd8, 0xbe, 0x9f, 0x73, 0x26,
-	0x99, 0xdd, 0x2e, 0xeb, 0xbe, 0xaa, 0xfb, 0x65, 0x7d, 0xa5, 0x9d, 0xb0, 0x84, 0x69, 0x40, 0xa0,
-	0xfe, 0x19, 0xec, 0x8a, 0xb7, 0x98, 0x0b, 0x53, 0xac, 0x08, 0x0c, 0xe6, 0xe5, 0x85, 0x18, 0x31,
-	0x81, 0x1c, 0xc7, 0x25, 0xe4, 0x45, 0xa4, 0x31, 0x91, 0xe1, 0x37, 0x41, 0x59, 0x5a, 0x86, 0x19,
-	0xa1, 0x2c, 0xd0, 0xbf, 0x26, 0xe5, 0x7d, 0x51, 0x07, 0x6e, 0x88, 0xf3, 0x14, 0x22, 0xbc, 0x45,
-	0x12, 0x0e, 0x25, 0x61, 0x34, 0xc4, 0x88, 0xf1, 0x58, 0x6c, 0x73, 0x96, 0x33, 0x01, 0x53, 0xbb,
-	0x0d, 0x6e, 0x48, 0x22, 0x53, 0xdc, 0xb5, 0x7a, 0xd6, 0xda, 0x33, 0xa1, 0x09, 0xec, 0x1e, 0x58,
-	0x8a, 0xb1, 0x40, 0x9c, 0xe4, 0xea, 0x99, 0x6e, 0x5d, 0xd7, 0xce, 0xa7, 0xec, 0x27, 0xe0, 0x26,
-	0x37, 0x54, 0xdd, 0x46, 0xaf, 0xb1, 0xb6, 0xf4, 0xe0, 0x0d, 0x7f, 0x91, 0x1d, 0xfe, 0x10, 0xa6,
-	0x90, 0x22, 0xcc, 0x9f, 0xb0, 0x11, 0xa3, 0x08, 0x53, 0xc9, 0xa1, 0xc4, 0xf1, 0x36, 0x63, 0xe9,
-	0x26, 0xa1, 0xd3, 0x61, 0xf3, 0xa8, 0x70, 0x6b, 0x61, 0x45, 0x35, 0xf8, 0xe0, 0xcb, 0x43, 0xb7,
-	0xf6, 0xcd, 0xa1, 0x5b, 0xfb, 0xed, 0xd0, 0xb5, 0x7e, 0xf8, 0x7e, 0x7d, 0xa5, 0x6c, 0x51, 0x99,
-	0x5e, 0x31, 0x8e, 0x18, 0x95, 0x98, 0xca, 0xaf, 0x7e, 0xfd, 0xee, 0xde, 0x2b, 0x95, 0x63, 0x97,
-	0x74, 0xe9, 0x7d, 0x5e, 0x07, 0xce, 0xd3, 0x3c, 0x86, 0xf2, 0xff, 0x62, 0xc4, 0xd3, 0xab, 0x19,
-	0x71, 0xb7, 0x32, 0xe2, 0xdf, 0x9b, 0xf4, 0x7e, 0x6c, 0x80, 0x17, 0x94, 0xa4, 0xc9, 0x7f, 0x48,
-	0xe4, 0x64, 0xf4, 0x68, 0x6b, 0x4b, 0xbd, 0x80, 0xfd, 0x2a, 0x68, 0xc5, 0x98, 0xb2, 0xec, 0xbe,
-	0x31, 0x60, 0xb8, 0x3c, 0x2f, 0xdc, 0xdb, 0x33, 0x98, 0xa5, 0x03, 0xcf, 0xe4, 0xbd, 0xb0, 0x04,
-	0x9c, 0x41, 0xfb, 0xc6, 0x8f, 0x0b, 0xd0, 0x7e, 0x05, 0xed, 0xdb, 0x03, 0x70, 0x4b, 0x12, 0x34,
-	0x8d, 0x44, 0x0e, 0x11, 0xa1, 0x49, 0xb7, 0xd1, 0xb3, 0xd6, 0x9a, 0xc3, 0xce, 0xbc, 0x70, 0x9f,
-	0x37, 0x0f, 0x9c, 0xaf, 0x7a, 0xe1, 0x92, 0x0a, 0x77, 0x4c, 0x64, 0xe7, 0xe0, 0x0e, 0x3e, 0xc8,
-	0x19, 0xc5, 0x54, 0x46, 0x50, 0x46, 0x39, 0x27, 0x08, 0x47, 0x8c, 0xe2, 0x6e, 0x53, 0xab, 0x3e,
-	0x54, 0x8e, 0xfd, 0x5c, 0xb8, 0x77, 0x8c, 0x35, 0x22, 0x9e, 0xfa, 0x84, 0x05, 0x19, 0x94, 0x13,
-	0xff, 0x31, 0x95, 0xf3, 0xc2, 0x7d, 0xc9, 0x28, 0x2c, 0xe4, 0xf0, 0x42, 0xbb, 0xca, 0x6f, 0xc8,
-	0x6d, 0x95, 0x7d, 0x9f, 0x62, 0xfb, 0x13, 0x70, 0x5b, 0xe4, 0x1c, 0xc3, 0x38, 0xda, 0x85, 0x48,
-	0x32, 0xde, 0xbd, 0xa1, 0x95, 0xde, 0x2e, 0x95, 0x56, 0x2f, 0x2a, 0x6d, 0xe2, 0x04, 0xa2, 0xd9,
-	0x3b, 0x18, 0xcd, 0x0b, 0xb7, 0x6d, 0xf4, 0xfe, 0xc6, 0xe0, 0x85, 0xb7, 0x4c, 0xfc, 0x48, 0x87,
-	0xf6, 0xbb, 0xe0, 0xb9, 0x71, 0xb9, 0x08, 0x51, 0xce, 0x58, 0x1a, 0x91, 0xb8, 0xdb, 0xd2, 0x9e,
-	0xac, 0xce, 0x0b, 0xb7, 0x63, 0x18, 0xfe, 0x89, 0xf0, 0xc2, 0x67, 0xab, 0x94, 0x1a, 0xde, 0xe3,
-	0x78, 0xd0, 0x54, 0x6b, 0xe1, 0xfd, 0x5e, 0x07, 0x6f, 0x8e, 0x38, 0x86, 0x12, 0x9f, 0x5f, 0xa9,
-	0x4d, 0xf2, 0xe9, 0x1e, 0x89, 0x89, 0x9c, 0x29, 0xac, 0xd8, 0xa0, 0xb1, 0x1a, 0xaf, 0x64, 0x6a,
-	0xd0, 0xd7, 0x5e, 0xf6, 0x6f, 0x2d, 0xb0, 0xaa, 0x5f, 0xaa, 0xdc, 0xd3, 0xe8, 0x33, 0x22, 0x27,
-	0x11, 0xda, 0xcd, 0xb2, 0x28, 0x25, 0x74, 0x5a, 0x5e, 0xc0, 0x6b, 0x8b, 0x2f, 0x60, 0xf1, 0xe2,
-	0x0d, 0x7d, 0xe5, 0xee, 0xbc, 0x70, 0xef, 0x9a, 0xe6, 0x91, 0x6e, 0x28, 0x42, 0xa9, 0xe9, 0x1e,
-	0xd2, 0x58, 0x53, 0x47, 0x92, 0x69, 0x1d, 0x2f, 0xec, 0xe4, 0x67, 0x3c, 0x42, 0x13, 0xed, 0x66,
-	0x99, 0x22, 0x1a, 0xa4, 0x57, 0xbb, 0x99, 0x87, 0xd5, 0xcd, 0xfc, 0x27, 0x0b, 0xbd, 0x3f, 0x2c,
-	0xe0, 0xed, 0x60, 0xb9, 0x83, 0x60, 0x4a, 0x68, 0x62, 0xc6, 0xab, 0xd8, 0x39, 0x4b, 0x53, 0xcc,
-	0xaf, 0xed, 0x74, 0x07, 0xdc, 0xac, 0xf6, 0x43, 0xdf, 0x4c, 0xd8, 0xca, 0xf5, 0xe8, 0xed, 0x75,
-	0x60, 0xa3, 0x33, 0x99, 0x08, 0xc6, 0x31, 0xc7, 0x42, 0x98, 0x93, 0x08, 0x97, 0xff, 0xaa, 0x6c,
-	0x98, 0xc2, 0xe0, 0xa3, 0xab, 0x99, 0x72, 0xaf, 0x32, 0xe5, 0xf2, 0xd6, 0x86, 0xef, 0x1d, 0x9d,
-	0x38, 0xd6, 0xf1, 0x89, 0x63, 0xfd, 0x72, 0xe2, 0x58, 0x5f, 0x9f, 0x3a, 0xb5, 0xe3, 0x53, 0xa7,
-	0xf6, 0xd3, 0xa9, 0x53, 0xfb, 0xf8, 0x7e, 0x42, 0xe4, 0x64, 0x6f, 0xec, 0x23, 0x96, 0x05, 0x25,
-	0xe1, 0x7a, 0x0a, 0xc7, 0xa2, 0x0a, 0x82, 0xfd, 0x07, 0x6f, 0x05, 0x07, 0xe6, 0x3b, 0x27, 0x67,
-	0x39, 0x16, 0xe3, 0x96, 0xfe, 0x62, 0xbd, 0xfe, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x2f,
-	0x02, 0x4f, 0x74, 0x07, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0x6f, 0xd2, 0x14, 0x26, 0x2d, 0x10, 0x93, 0x92, 0x25, 0x41, 0x76, 0x30, 0xa2, 0x84,
+	0x8a, 0xd8, 0xdd, 0xf2, 0xe7, 0xb0, 0xa8, 0x42, 0xd9, 0x84, 0x4a, 0x45, 0x89, 0x1a, 0x39, 0x2d,
+	0x08, 0x84, 0x64, 0x66, 0xc7, 0x2f, 0xde, 0x51, 0xec, 0x19, 0x33, 0x33, 0x09, 0xcd, 0x07, 0x40,
+	0x20, 0x4e, 0xdc, 0xf8, 0x73, 0xca, 0x47, 0xe0, 0xc0, 0x87, 0xa8, 0xe0, 0xd2, 0x23, 0xe2, 0xb0,
+	0x42, 0xc9, 0x01, 0xce, 0x7b, 0xe4, 0x02, 0x9a, 0x19, 0x3b, 0x84, 0xb2, 0x4a, 0xd9, 0x56, 0x3d,
+	0xf4, 0xb2, 0xda, 0xf7, 0xde, 0x6f, 0x7e, 0x6f, 0xde, 0xef, 0xbd, 0x37, 0x32, 0xf2, 0xb8, 0x2c,
+	0xb8, 0xa4, 0x32, 0xca, 0x70, 0x51, 0x44, 0x7b, 0xed, 0x1e, 0x28, 0xdc, 0x8e, 0x32, 0xbe, 0x17,
+	0x96, 0x82, 0x2b, 0xee, 0xce, 0x56, 0xf1, 0x50, 0xc7, 0xc3, 0x2a, 0x3e, 0x3f, 0x9b, 0xf1, 0x8c,
+	0x1b, 0x40, 0xa4, 0xff, 0x59, 0xec, 0x7c, 0x30, 0x9a, 0x0b, 0x18, 0x68, 0x02, 0x8b, 0x79, 0x71,
+	0x24, 0x46, 0xf6, 0xb1, 0x80, 0xb4, 0x82, 0x3c, 0x4f, 0x0c, 0x26, 0xb1, 0xfc, 0xd6, 0xa8, 0x42,
+	0x33, 0xb8, 0xa0, 0x8c, 0x47, 0xe6, 0xd7, 0xba, 0x82, 0x2f, 0x9a, 0xc8, 0x8f, 0xa1, 0xcc, 0x31,
+	0x81, 0x0d, 0x9a, 0x09, 0xac, 0x28, 0x67, 0x31, 0x10, 0x2e, 0x52, 0xb9, 0x29, 0x78, 0xc9, 0x25,
+	0xce, 0xdd, 0x59, 0x74, 0x46, 0x51, 0x95, 0x43, 0xcb, 0x59, 0x74, 0x96, 0x9e, 0x8c, 0xad, 0xe1,
+	0x2e, 0xa2, 0xe9, 0x14, 0x24, 0x11, 0xb4, 0xd4, 0x67, 0x5a, 0x4d, 0x13, 0x3b, 0xe9, 0x72, 0x6f,
+	0xa2, 0xb3, 0xc2, 0x52, 0xb5, 0x26, 0x16, 0x27, 0x96, 0xa6, 0xaf, 0xbc, 0x11, 0x8e, 0x92, 0x23,
+	0xec, 0xe2, 0x1c, 0x33, 0x02, 0xe2, 0x26, 0x5f, 0xe5, 0x8c, 0x00, 0x53, 0x02, 0x2b, 0x48, 0x37,
+	0x39, 0xcf, 0xd7, 0x29, 0xdb, 0xe9, 0x4e, 0xde, 0x19, 0xf8, 0x8d, 0xb8, 0xa6, 0xea, 0xbc, 0xff,
+	0xe5, 0x81, 0xdf, 0xf8, 0xf6, 0xc0, 0x6f, 0xfc, 0x71, 0xe0, 0x3b, 0x3f, 0xfd, 0xb8, 0x3c, 0x5f,
+	0x95, 0xa8, 0x45, 0xaf, 0x19, 0x57, 0x39, 0x53, 0xc0, 0xd4, 0x57, 0xbf, 0xff, 0x70, 0xe9, 0x95,
+	0x5a, 0xb1, 0xfb, 0x54, 0x19, 0x7c, 0xde, 0x44, 0xde, 0xad, 0x32, 0xc5, 0xea, 0x71, 0x11, 0xe2,
+	0xd6, 0x78, 0x42, 0x5c, 0xac, 0x85, 0x38, 0xbd, 0xc8, 0xe0, 0xe7, 0x09, 0xf4, 0x9c, 0x4e, 0x69,
+	0xfd, 0x1f, 0x50, 0xd5, 0x5f, 0xbd, 0xb6, 0xb1, 0xa1, 0x2f, 0xe0, 0xbe, 0x8a, 0xa6, 0x52, 0x60,
+	0xbc, 0xb8, 0x6c, 0x05, 0xe8, 0xce, 0x0c, 0x07, 0xfe, 0xf9, 0x7d, 0x5c, 0xe4, 0x9d, 0xc0, 0xfa,
+	0x83, 0xb8, 0x02, 0x1c, 0x43, 0xdb, 0xad, 0xe6, 0x48, 0x68, 0xbb, 0x86, 0xb6, 0xdd, 0x0e, 0x3a,
+	0xa7, 0x28, 0xd9, 0x49, 0x64, 0x89, 0x09, 0x65, 0x59, 0x6b, 0x62, 0xd1, 0x59, 0x9a, 0xec, 0xce,
+	0x0d, 0x07, 0xfe, 0xb3, 0xf6, 0xc0, 0xc9, 0x68, 0x10, 0x4f, 0x6b, 0x73, 0xcb, 0x5a, 0x6e, 0x89,
+	0x2e, 0xc0, 0xed, 0x92, 0x33, 0x60, 0x2a, 0xc1, 0x2a, 0x29, 0x05, 0x25, 0x90, 0x70, 0x06, 0xad,
+	0x49, 0x93, 0xf5, 0xaa, 0x56, 0xec, 0xd7, 0x81, 0x7f, 0xc1, 0x4a, 0x23, 0xd3, 0x9d, 0x90, 0xf2,
+	0xa8, 0xc0, 0xaa, 0x1f, 0x5e, 0x67, 0x6a, 0x38, 0xf0, 0x5f, 0xb0, 0x19, 0x46, 0x72, 0x04, 0xb1,
+	0x5b, 0xfb, 0x57, 0xd4, 0xa6, 0xf6, 0xde, 0x60, 0xe0, 0x7e, 0x82, 0xce, 0xcb, 0x52, 0x00, 0x4e,
+	0x93, 0x6d, 0x4c, 0x14, 0x17, 0xad, 0x33, 0x26, 0xd3, 0xdb, 0x55, 0xa6, 0x85, 0xff, 0x66, 0x5a,
+	0x87, 0x0c, 0x93, 0xfd, 0x35, 0x20, 0xc3, 0x81, 0x3f, 0x6b, 0xf3, 0xfd, 0x8b, 0x21, 0x88, 0xcf,
+	0x59, 0xfb, 0x9a, 0x31, 0xdd, 0x77, 0xd1, 0x33, 0xbd, 0x6a, 0x10, 0x92, 0x92, 0xf3, 0x3c, 0xa1,
+	0x69, 0x6b, 0xca, 0x68, 0xb2, 0x30, 0x1c, 0xf8, 0x73, 0x96, 0xe1, 0x5e, 0x44, 0x10, 0x3f, 0x55,
+	0xbb, 0x74, 0xf3, 0xae, 0xa7, 0x9d, 0x49, 0x3d, 0x16, 0xc1, 0x9f, 0x4d, 0xf4, 0xe6, 0xaa, 0x00,
+	0xac, 0xe0, 0xe4, 0x48, 0xad, 0xd3, 0x4f, 0x77, 0x69, 0x4a, 0xd5, 0xbe, 0xc6, 0xca, 0x15, 0x96,
+	0xea, 0xf6, 0x2a, 0xae, 0x1b, 0xfd, 0xd0, 0xc3, 0xfe, 0xbd, 0x83, 0x16, 0xcc, 0xa5, 0xaa, 0x39,
+	0x4d, 0x3e, 0xa3, 0xaa, 0x9f, 0x90, 0xed, 0xa2, 0x48, 0x72, 0xca, 0x76, 0xaa, 0x0d, 0x78, 0x6d,
+	0xf4, 0x06, 0x8c, 0x1e, 0xbc, 0x6e, 0xa8, 0xd5, 0x1d, 0x0e, 0xfc, 0x8b, 0xb6, 0x78, 0x62, 0x0a,
+	0x4a, 0x48, 0x6e, 0xab, 0xc7, 0x2c, 0x35, 0xd4, 0x89, 0xe2, 0x26, 0x4f, 0x10, 0xcf, 0x95, 0xc7,
+	0x3c, 0xd2, 0x10, 0x6d, 0x17, 0x85, 0x26, 0xea, 0xe4, 0xe3, 0xed, 0xcc, 0xd5, 0x7a, 0x67, 0x1e,
+	0x48, 0xc2, 0xe0, 0x2f, 0x07, 0x05, 0x5b, 0xa0, 0xb6, 0x08, 0xce, 0x29, 0xcb, 0x6c, 0x7b, 0x35,
+	0xbb, 0xe0, 0x79, 0x0e, 0xe2, 0xa1, 0x95, 0x9e, 0x43, 0x67, 0xeb, 0xf9, 0x30, 0x3b, 0x13, 0x4f,
+	0x95, 0xa6, 0xf5, 0xee, 0x32, 0x72, 0xc9, 0x71, 0x9a, 0x04, 0xa7, 0xa9, 0x00, 0x29, 0xed, 0x4a,
+	0xc4, 0x33, 0xff, 0x44, 0x56, 0x6c, 0xa0, 0xf3, 0xe1, 0x78, 0xa2, 0x5c, 0xaa, 0x45, 0xb9, 0x7f,
+	0x69, 0xc1, 0x77, 0x4d, 0xe4, 0x6d, 0x81, 0xd2, 0x1a, 0xad, 0x09, 0x4c, 0xd9, 0xa6, 0xe0, 0x0a,
+	0x88, 0xbe, 0xfd, 0xa3, 0xab, 0xfe, 0x06, 0x9a, 0x29, 0x28, 0x4b, 0x04, 0x48, 0x10, 0x7b, 0x90,
+	0x98, 0x57, 0xae, 0x7a, 0x0f, 0x5e, 0xfa, 0x1f, 0x5b, 0x1a, 0x3f, 0x5d, 0x50, 0x16, 0xdb, 0xc3,
+	0xb1, 0x3e, 0xfb, 0xc0, 0x0f, 0xed, 0xe9, 0x85, 0x07, 0xdf, 0x34, 0xd1, 0xcb, 0x15, 0x24, 0x06,
+	0xbb, 0xbc, 0x5a, 0x4a, 0x80, 0x35, 0x2a, 0x09, 0xdf, 0x65, 0xea, 0xd1, 0x49, 0xf4, 0x0e, 0x7a,
+	0x22, 0xad, 0x92, 0x8c, 0xa3, 0xcc, 0xf1, 0xa1, 0xce, 0xc7, 0xe3, 0x49, 0xb2, 0x7c, 0x8f, 0x24,
+	0xa7, 0xd7, 0xdb, 0x7d, 0xef, 0xce, 0xa1, 0xe7, 0xdc, 0x3d, 0xf4, 0x9c, 0xdf, 0x0e, 0x3d, 0xe7,
+	0xeb, 0x23, 0xaf, 0x71, 0xf7, 0xc8, 0x6b, 0xfc, 0x72, 0xe4, 0x35, 0x3e, 0xba, 0x9c, 0x51, 0xd5,
+	0xdf, 0xed, 0x85, 0x84, 0x17, 0x51, 0xc5, 0xb9, 0x9c, 0xe3, 0x9e, 0xac, 0x8d, 0x68, 0xef, 0xca,
+	0x5b, 0xd1, 0x6d, 0xfb, 0x75, 0xa4, 0xf6, 0x4b, 0x90, 0xbd, 0x29, 0xf3, 0x9d, 0xf3, 0xfa, 0xdf,
+	0x03, 0x00, 0xaf, 0xca, 0x77, 0x33, 0xaa, 0x09, 0x00, 0x00,
 }
 
 func (this *ReplaceMigrationRecordsProposal) Equal(that interface{}) bool {
@@ -511,6 +605,72 @@ func (this *SetScalingFactorControllerProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetPoolDrainProtectionProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPoolDrainProtectionProposal)
+	if !ok {
+		that2, ok := that.(SetPoolDrainProtectionProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.MinReserveRatio.Equal(that1.MinReserveRatio) {
+		return false
+	}
+	return true
+}
+func (this *SetPoolRebalancingFeeDiscountProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPoolRebalancingFeeDiscountProposal)
+	if !ok {
+		that2, ok := that.(SetPoolRebalancingFeeDiscountProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.Discount.Equal(that1.Discount) {
+		return false
+	}
+	return true
+}
 func (m *ReplaceMigrationRecordsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -780,6 +940,110 @@ func (m *SetScalingFactorControllerProposal) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *SetPoolDrainProtectionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolDrainProtectionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolDrainProtectionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinReserveRatio.Size()
+		i -= size
+		if _, err := m.MinReserveRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPoolRebalancingFeeDiscountProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolRebalancingFeeDiscountProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolRebalancingFeeDiscountProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -911,6 +1175,50 @@ func (m *SetScalingFactorControllerProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetPoolDrainProtectionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.MinReserveRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *SetPoolRebalancingFeeDiscountProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1746,6 +2054,340 @@ func (m *SetScalingFactorControllerProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetPoolDrainProtectionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolDrainProtectionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolDrainProtectionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReserveRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReserveRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPoolRebalancingFeeDiscountProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolRebalancingFeeDiscountProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolRebalancingFeeDiscountProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,10 @@ type GammHooks interface {
 
 	// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
 	AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+
+	// AfterSmoothWeightChangeCompleted is called in BeginBlock once the smooth weight change of a balancer pool
+	// completed, and the pool was persisted with its target weights.
+	AfterSmoothWeightChangeCompleted(ctx sdk.Context, poolId uint64)
 }
 
 var _ GammHooks = MultiGammHooks{}
@@ -53,3 +57,9 @@ func (h MultiGammHooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, po
 		h[i].AfterCFMMSwap(ctx, sender, poolId, input, output)
 	}
 }
+
+func (h MultiGammHooks) AfterSmoothWeightChangeCompleted(ctx sdk.Context, poolId uint64) {
+	for i := range h {
+		h[i].AfterSmoothWeightChangeCompleted(ctx, poolId)
+	}
+}
@@ -30,6 +30,18 @@ var (
 
 	KeyPrefixMigrationInfoBalancerPool = []byte{0x04}
 	KeyPrefixMigrationInfoCLPool       = []byte{0x05}
+
+	// KeyPrefixPoolDrainProtection defines prefix to store the governance set drain protection of each pool.
+	KeyPrefixPoolDrainProtection = []byte{0x06}
+
+	// KeyPrefixPoolRebalancingFeeDiscount defines prefix to store the governance set rebalancing fee discount of each pool.
+	KeyPrefixPoolRebalancingFeeDiscount = []byte{0x07}
+
+	// KeyPrefixScalingFactorRamp defines prefix to store the scaling factor ramp in progress of each stableswap pool.
+	KeyPrefixScalingFactorRamp = []byte{0x08}
+
+	// KeyPrefixSmoothWeightChange defines prefix to index the balancer pools with a smooth weight change in progress.
+	KeyPrefixSmoothWeightChange = []byte{0x09}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -68,3 +80,19 @@ func GetKeyPrefixMigrationInfoBalancerPool(balancerPoolId uint64) []byte {
 func GetKeyPrefixMigrationInfoPoolCLPool(concentratedPoolId uint64) []byte {
 	return append(KeyPrefixMigrationInfoCLPool, sdk.Uint64ToBigEndian(concentratedPoolId)...)
 }
+
+func GetKeyPrefixPoolDrainProtection(poolId uint64) []byte {
+	return append(KeyPrefixPoolDrainProtection, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixPoolRebalancingFeeDiscount(poolId uint64) []byte {
+	return append(KeyPrefixPoolRebalancingFeeDiscount, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixScalingFactorRamp(poolId uint64) []byte {
+	return append(KeyPrefixScalingFactorRamp, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixSmoothWeightChange(poolId uint64) []byte {
+	return append(KeyPrefixSmoothWeightChange, sdk.Uint64ToBigEndian(poolId)...)
+}
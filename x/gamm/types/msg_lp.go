@@ -17,12 +17,15 @@ var (
 	_ LiquidityChangeMsg = MsgExitPool{}
 	_ LiquidityChangeMsg = MsgExitSwapShareAmountIn{}
 	_ LiquidityChangeMsg = MsgExitSwapExternAmountOut{}
+	_ LiquidityChangeMsg = MsgExitSwapShareAmountInMaxPriceImpact{}
+	_ LiquidityChangeMsg = MsgExitSwapShareAmountInToDenoms{}
 )
 
 var (
 	_ LiquidityChangeMsg = MsgJoinPool{}
 	_ LiquidityChangeMsg = MsgJoinSwapExternAmountIn{}
 	_ LiquidityChangeMsg = MsgJoinSwapShareAmountOut{}
+	_ LiquidityChangeMsg = MsgJoinPoolAndLock{}
 )
 
 func (msg MsgExitPool) LiquidityChangeType() LiquidityChangeType {
@@ -37,6 +40,14 @@ func (msg MsgExitSwapExternAmountOut) LiquidityChangeType() LiquidityChangeType
 	return RemoveLiquidity
 }
 
+func (msg MsgExitSwapShareAmountInMaxPriceImpact) LiquidityChangeType() LiquidityChangeType {
+	return RemoveLiquidity
+}
+
+func (msg MsgExitSwapShareAmountInToDenoms) LiquidityChangeType() LiquidityChangeType {
+	return RemoveLiquidity
+}
+
 func (msg MsgJoinPool) LiquidityChangeType() LiquidityChangeType {
 	return AddLiquidity
 }
@@ -48,3 +59,7 @@ func (msg MsgJoinSwapExternAmountIn) LiquidityChangeType() LiquidityChangeType {
 func (msg MsgJoinSwapShareAmountOut) LiquidityChangeType() LiquidityChangeType {
 	return AddLiquidity
 }
+
+func (msg MsgJoinPoolAndLock) LiquidityChangeType() LiquidityChangeType {
+	return AddLiquidity
+}
@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // constants.
@@ -15,11 +17,14 @@ const (
 	TypeMsgSwapExactAmountIn       = "swap_exact_amount_in"
 	TypeMsgSwapExactAmountOut      = "swap_exact_amount_out"
 	TypeMsgJoinPool                = "join_pool"
+	TypeMsgJoinPoolAndLock         = "join_pool_and_lock"
 	TypeMsgExitPool                = "exit_pool"
 	TypeMsgJoinSwapExternAmountIn  = "join_swap_extern_amount_in"
 	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
 	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
 	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgExitSwapMaxPriceImpact  = "exit_swap_share_amount_in_max_price_impact"
+	TypeMsgExitSwapToDenoms        = "exit_swap_share_amount_in_to_denoms"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -161,6 +166,40 @@ func (msg MsgJoinPool) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgJoinPoolAndLock{}
+
+func (msg MsgJoinPoolAndLock) Route() string { return RouterKey }
+func (msg MsgJoinPoolAndLock) Type() string  { return TypeMsgJoinPoolAndLock }
+func (msg MsgJoinPoolAndLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.ShareOutAmount.IsPositive() {
+		return errorsmod.Wrap(ErrNotPositiveRequireAmount, msg.ShareOutAmount.String())
+	}
+
+	tokenInMaxs := sdk.Coins(msg.TokenInMaxs)
+	if !tokenInMaxs.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, tokenInMaxs.String())
+	}
+
+	if msg.Duration <= 0 {
+		return fmt.Errorf("duration should be positive: %d < 0", msg.Duration)
+	}
+
+	return nil
+}
+
+func (msg MsgJoinPoolAndLock) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgExitPool{}
 
 func (msg MsgExitPool) Route() string { return RouterKey }
@@ -316,3 +355,95 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgExitSwapShareAmountInMaxPriceImpact{}
+
+func (msg MsgExitSwapShareAmountInMaxPriceImpact) Route() string { return RouterKey }
+func (msg MsgExitSwapShareAmountInMaxPriceImpact) Type() string  { return TypeMsgExitSwapMaxPriceImpact }
+func (msg MsgExitSwapShareAmountInMaxPriceImpact) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	if !msg.ShareInAmount.IsPositive() {
+		return errorsmod.Wrap(ErrNotPositiveRequireAmount, msg.ShareInAmount.String())
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return errorsmod.Wrap(ErrNotPositiveCriteria, msg.TokenOutMinAmount.String())
+	}
+
+	if msg.MaxPriceImpact.IsNil() || !msg.MaxPriceImpact.IsPositive() || msg.MaxPriceImpact.GTE(osmomath.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidMaxPriceImpact, "got %s", msg.MaxPriceImpact)
+	}
+
+	return nil
+}
+
+func (msg MsgExitSwapShareAmountInMaxPriceImpact) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgExitSwapShareAmountInToDenoms{}
+
+func (msg MsgExitSwapShareAmountInToDenoms) Route() string { return RouterKey }
+func (msg MsgExitSwapShareAmountInToDenoms) Type() string  { return TypeMsgExitSwapToDenoms }
+func (msg MsgExitSwapShareAmountInToDenoms) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.ShareInAmount.IsPositive() {
+		return errorsmod.Wrap(ErrNotPositiveRequireAmount, msg.ShareInAmount.String())
+	}
+
+	if len(msg.TokenOutDenoms) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenOutDenoms, "no token out denoms given")
+	}
+	seenDenoms := make(map[string]bool, len(msg.TokenOutDenoms))
+	for _, denom := range msg.TokenOutDenoms {
+		err = sdk.ValidateDenom(denom)
+		if err != nil {
+			return err
+		}
+		if seenDenoms[denom] {
+			return errorsmod.Wrapf(ErrInvalidTokenOutDenoms, "duplicate denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	tokenOutMins := sdk.Coins(msg.TokenOutMins)
+	if !tokenOutMins.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, tokenOutMins.String())
+	}
+	for _, coin := range tokenOutMins {
+		if !seenDenoms[coin.Denom] {
+			return errorsmod.Wrapf(ErrInvalidTokenOutDenoms, "min amount given for %s, which is not a token out denom", coin.Denom)
+		}
+	}
+
+	if msg.MaxPriceImpact.IsNil() || !msg.MaxPriceImpact.IsPositive() || msg.MaxPriceImpact.GTE(osmomath.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidMaxPriceImpact, "got %s", msg.MaxPriceImpact)
+	}
+
+	return nil
+}
+
+func (msg MsgExitSwapShareAmountInToDenoms) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
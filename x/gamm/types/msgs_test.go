@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -377,6 +378,99 @@ func TestMsgJoinPool(t *testing.T) {
 	}
 }
 
+func TestMsgJoinPoolAndLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock {
+		properMsg := gammtypes.MsgJoinPoolAndLock{
+			Sender:         addr1,
+			PoolId:         1,
+			ShareOutAmount: osmomath.NewInt(10),
+			TokenInMaxs:    sdk.NewCoins(sdk.NewCoin("test1", osmomath.NewInt(10)), sdk.NewCoin("test2", osmomath.NewInt(20))),
+			Duration:       time.Hour,
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "join_pool_and_lock")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgJoinPoolAndLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative requirement",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock {
+				msg.ShareOutAmount = osmomath.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative amount",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock {
+				msg.TokenInMaxs[1].Amount = osmomath.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock {
+				msg.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative duration",
+			msg: createMsg(func(msg gammtypes.MsgJoinPoolAndLock) gammtypes.MsgJoinPoolAndLock {
+				msg.Duration = -time.Hour
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestMsgExitPool(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -875,6 +969,248 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 }
 
 // Test authz serialize and de-serializes for gamm msg.
+
+func TestMsgExitSwapShareAmountInMaxPriceImpact(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+		properMsg := gammtypes.MsgExitSwapShareAmountInMaxPriceImpact{
+			Sender:            addr1,
+			PoolId:            1,
+			TokenOutDenom:     "test",
+			ShareInAmount:     osmomath.NewInt(100),
+			TokenOutMinAmount: osmomath.NewInt(100),
+			MaxPriceImpact:    osmomath.MustNewDecFromStr("0.05"),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "exit_swap_share_amount_in_max_price_impact")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgExitSwapShareAmountInMaxPriceImpact
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+				msg.ShareInAmount = osmomath.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+				msg.TokenOutMinAmount = osmomath.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil max price impact",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero max price impact",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.ZeroDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max price impact of one",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInMaxPriceImpact) gammtypes.MsgExitSwapShareAmountInMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+func TestMsgExitSwapShareAmountInToDenoms(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+		properMsg := gammtypes.MsgExitSwapShareAmountInToDenoms{
+			Sender:         addr1,
+			PoolId:         1,
+			ShareInAmount:  osmomath.NewInt(100),
+			TokenOutDenoms: []string{"test", "test2"},
+			TokenOutMins:   sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			MaxPriceImpact: osmomath.MustNewDecFromStr("0.05"),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "exit_swap_share_amount_in_to_denoms")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgExitSwapShareAmountInToDenoms
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no min amounts",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.TokenOutMins = sdk.Coins{}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.ShareInAmount = osmomath.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no token out denoms",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.TokenOutDenoms = []string{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.TokenOutDenoms = []string{"test", "1"}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate token out denom",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.TokenOutDenoms = []string{"test", "test"}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "min amount of a denom that is not a token out denom",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.TokenOutMins = sdk.NewCoins(sdk.NewInt64Coin("other", 100))
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid min amounts",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.TokenOutMins = []sdk.Coin{{Denom: "test", Amount: osmomath.NewInt(-10)}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero max price impact",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.MaxPriceImpact = osmomath.ZeroDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max price impact of one",
+			msg: createMsg(func(msg gammtypes.MsgExitSwapShareAmountInToDenoms) gammtypes.MsgExitSwapShareAmountInToDenoms {
+				msg.MaxPriceImpact = osmomath.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
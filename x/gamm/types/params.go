@@ -4,14 +4,24 @@ import (
 	"fmt"
 
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee             = []byte("PoolCreationFee")
+	KeyPegArbitrageEnabled         = []byte("PegArbitrageEnabled")
+	KeyPegArbitrageEpochIdentifier = []byte("PegArbitrageEpochIdentifier")
+	KeyPegArbitrageLinks           = []byte("PegArbitrageLinks")
+
+	DefaultPegArbitrageEnabled         = false
+	DefaultPegArbitrageEpochIdentifier = "day"
+	DefaultPegArbitrageLinks           = []PegArbitrageLink{}
 )
 
 // ParamTable for gamm module.
@@ -28,7 +38,10 @@ func NewParams(poolCreationFee sdk.Coins) Params {
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:             sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PegArbitrageEnabled:         DefaultPegArbitrageEnabled,
+		PegArbitrageEpochIdentifier: DefaultPegArbitrageEpochIdentifier,
+		PegArbitrageLinks:           DefaultPegArbitrageLinks,
 	}
 }
 
@@ -37,6 +50,15 @@ func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
+	if err := validatePegArbitrageEnabled(p.PegArbitrageEnabled); err != nil {
+		return err
+	}
+	if err := epochtypes.ValidateEpochIdentifierInterface(p.PegArbitrageEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validatePegArbitrageLinks(p.PegArbitrageLinks); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -45,6 +67,9 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyPegArbitrageEnabled, &p.PegArbitrageEnabled, validatePegArbitrageEnabled),
+		paramtypes.NewParamSetPair(KeyPegArbitrageEpochIdentifier, &p.PegArbitrageEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyPegArbitrageLinks, &p.PegArbitrageLinks, validatePegArbitrageLinks),
 	}
 }
 
@@ -60,3 +85,49 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validatePegArbitrageEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePegArbitrageLinks(i interface{}) error {
+	v, ok := i.([]PegArbitrageLink)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	stableswapPoolIds := make(map[uint64]struct{}, len(v))
+	for _, link := range v {
+		if err := link.Validate(); err != nil {
+			return err
+		}
+		if _, ok := stableswapPoolIds[link.StableswapPoolId]; ok {
+			return fmt.Errorf("duplicate peg arbitrage link for stableswap pool %d", link.StableswapPoolId)
+		}
+		stableswapPoolIds[link.StableswapPoolId] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a peg arbitrage link.
+func (l PegArbitrageLink) Validate() error {
+	if l.StableswapPoolId == 0 || l.LinkedPoolId == 0 {
+		return fmt.Errorf("peg arbitrage link pool ids must be positive, got %d and %d", l.StableswapPoolId, l.LinkedPoolId)
+	}
+	if l.StableswapPoolId == l.LinkedPoolId {
+		return fmt.Errorf("stableswap pool %d can not be linked to itself", l.StableswapPoolId)
+	}
+	if l.MaxDeviation.IsNil() || !l.MaxDeviation.IsPositive() {
+		return fmt.Errorf("max deviation of peg arbitrage link of pool %d must be positive, got %s", l.StableswapPoolId, l.MaxDeviation)
+	}
+	if l.MaxRebalanceFraction.IsNil() || !l.MaxRebalanceFraction.IsPositive() || l.MaxRebalanceFraction.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("max rebalance fraction of peg arbitrage link of pool %d must be in (0, 1), got %s", l.StableswapPoolId, l.MaxRebalanceFraction)
+	}
+	return nil
+}
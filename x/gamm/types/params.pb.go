@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -28,7 +29,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params holds parameters for the incentives module
 type Params struct {
+	// pool_creation_fee is unused. Pool creation fees are charged by
+	// x/poolmanager, per pool type.
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// peg_arbitrage_enabled is the kill switch of the epoch end peg arbitrage.
+	// No peg arbitrage link is processed while it is false.
+	PegArbitrageEnabled bool `protobuf:"varint,2,opt,name=peg_arbitrage_enabled,json=pegArbitrageEnabled,proto3" json:"peg_arbitrage_enabled,omitempty" yaml:"peg_arbitrage_enabled"`
+	// peg_arbitrage_epoch_identifier is the identifier of the epoch at the end
+	// of which the peg arbitrage links are processed.
+	PegArbitrageEpochIdentifier string `protobuf:"bytes,3,opt,name=peg_arbitrage_epoch_identifier,json=pegArbitrageEpochIdentifier,proto3" json:"peg_arbitrage_epoch_identifier,omitempty" yaml:"peg_arbitrage_epoch_identifier"`
+	// peg_arbitrage_links are the stableswap pools whose peg is maintained
+	// against a linked pool at the end of every peg arbitrage epoch.
+	PegArbitrageLinks []PegArbitrageLink `protobuf:"bytes,4,rep,name=peg_arbitrage_links,json=pegArbitrageLinks,proto3" json:"peg_arbitrage_links" yaml:"peg_arbitrage_links"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,32 +83,139 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetPegArbitrageEnabled() bool {
+	if m != nil {
+		return m.PegArbitrageEnabled
+	}
+	return false
+}
+
+func (m *Params) GetPegArbitrageEpochIdentifier() string {
+	if m != nil {
+		return m.PegArbitrageEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetPegArbitrageLinks() []PegArbitrageLink {
+	if m != nil {
+		return m.PegArbitrageLinks
+	}
+	return nil
+}
+
+// PegArbitrageLink links a two asset stableswap pool to a deeper pool holding
+// the same two assets. At the end of every peg arbitrage epoch, if the TWAP
+// of the stableswap pool deviates from the TWAP of the linked pool by more
+// than max_deviation, part of the stableswap pool's excess asset is
+// swapped through the linked pool for the other asset, moving the stableswap
+// pool's price back toward the linked pool's price.
+type PegArbitrageLink struct {
+	StableswapPoolId uint64 `protobuf:"varint,1,opt,name=stableswap_pool_id,json=stableswapPoolId,proto3" json:"stableswap_pool_id,omitempty" yaml:"stableswap_pool_id"`
+	LinkedPoolId     uint64 `protobuf:"varint,2,opt,name=linked_pool_id,json=linkedPoolId,proto3" json:"linked_pool_id,omitempty" yaml:"linked_pool_id"`
+	// max_deviation is the relative deviation of the stableswap pool's TWAP
+	// from the linked pool's TWAP above which the stableswap pool is
+	// rebalanced.
+	MaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_deviation,json=maxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_deviation" yaml:"max_deviation"`
+	// max_rebalance_fraction is the maximum fraction of the stableswap pool's
+	// reserve of its excess asset swapped in a single rebalance.
+	MaxRebalanceFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_rebalance_fraction,json=maxRebalanceFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rebalance_fraction" yaml:"max_rebalance_fraction"`
+}
+
+func (m *PegArbitrageLink) Reset()         { *m = PegArbitrageLink{} }
+func (m *PegArbitrageLink) String() string { return proto.CompactTextString(m) }
+func (*PegArbitrageLink) ProtoMessage()    {}
+func (*PegArbitrageLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e29150f8b2a668b, []int{1}
+}
+func (m *PegArbitrageLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PegArbitrageLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PegArbitrageLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PegArbitrageLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PegArbitrageLink.Merge(m, src)
+}
+func (m *PegArbitrageLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *PegArbitrageLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_PegArbitrageLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PegArbitrageLink proto.InternalMessageInfo
+
+func (m *PegArbitrageLink) GetStableswapPoolId() uint64 {
+	if m != nil {
+		return m.StableswapPoolId
+	}
+	return 0
+}
+
+func (m *PegArbitrageLink) GetLinkedPoolId() uint64 {
+	if m != nil {
+		return m.LinkedPoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
+	proto.RegisterType((*PegArbitrageLink)(nil), "osmosis.gamm.v1beta1.PegArbitrageLink")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/params.proto", fileDescriptor_8e29150f8b2a668b) }
 
 var fileDescriptor_8e29150f8b2a668b = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0x4f, 0xcc, 0xcd, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x2a, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd0,
-	0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x24, 0x93, 0xc1, 0x8a, 0xe3, 0x21, 0x12, 0x10, 0x0e, 0x54, 0x4a,
-	0x0e, 0xc2, 0xd3, 0x4f, 0x4a, 0x2c, 0x4e, 0x85, 0x5b, 0x94, 0x9c, 0x9f, 0x99, 0x07, 0x91, 0x57,
-	0x9a, 0xc7, 0xc8, 0xc5, 0x16, 0x00, 0xb6, 0x57, 0x68, 0x32, 0x23, 0x97, 0x60, 0x41, 0x7e, 0x7e,
-	0x4e, 0x7c, 0x72, 0x51, 0x6a, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x7c, 0x5a, 0x6a, 0xaa, 0x04, 0xa3,
-	0x02, 0xb3, 0x06, 0xb7, 0x91, 0xa4, 0x1e, 0xd4, 0x54, 0x90, 0x39, 0x30, 0xd7, 0xe8, 0x39, 0xe7,
-	0x67, 0xe6, 0x39, 0xf9, 0x9c, 0xb8, 0x27, 0xcf, 0xf0, 0xe9, 0x9e, 0xbc, 0x44, 0x65, 0x62, 0x6e,
-	0x8e, 0x95, 0x12, 0x86, 0x09, 0x4a, 0xab, 0xee, 0xcb, 0x6b, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0x42, 0x9d, 0x07, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x86, 0x15, 0x07, 0xf1, 0x83, 0xf4, 0x3b, 0x43, 0xb5, 0xbb, 0xa5, 0xa6, 0x3a,
-	0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e,
-	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x01, 0x92, 0xa1, 0xd0,
-	0xc0, 0xd2, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0xcc, 0xf4, 0x2b, 0x20, 0x41,
-	0x0c, 0xb6, 0x22, 0x89, 0x0d, 0xec, 0x67, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0xe2,
-	0xb5, 0x02, 0x7f, 0x01, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0xe3, 0xa6, 0xaa, 0x60, 0x28, 0xd0, 0xba, 0x29, 0x72, 0x5a, 0x6a, 0x07, 0x4b, 0xa0,
+	0xb0, 0xa8, 0x4d, 0x8b, 0xc4, 0x82, 0x0d, 0xc2, 0x0d, 0x95, 0x02, 0x59, 0x44, 0x16, 0x2b, 0x36,
+	0xd6, 0xd8, 0x9e, 0x38, 0xa3, 0xd8, 0x1e, 0xcb, 0x63, 0x42, 0xc2, 0x09, 0x58, 0x22, 0xb1, 0xe4,
+	0x06, 0xac, 0x39, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xc2, 0x54, 0xc9, 0x0d, 0x7c, 0x02, 0xe4, 0x99,
+	0x49, 0xda, 0xfc, 0x59, 0xb0, 0xb2, 0xe7, 0xbd, 0xef, 0xfd, 0xbe, 0xe7, 0x37, 0x33, 0x06, 0x8f,
+	0x08, 0x8d, 0x08, 0xc5, 0xd4, 0x0c, 0x60, 0x14, 0x99, 0xc3, 0x13, 0x17, 0x65, 0xf0, 0xc4, 0x4c,
+	0x60, 0x0a, 0x23, 0x6a, 0x24, 0x29, 0xc9, 0x88, 0x5c, 0x13, 0x12, 0xa3, 0x94, 0x18, 0x42, 0x72,
+	0x50, 0x0b, 0x48, 0x40, 0x98, 0xc0, 0x2c, 0xdf, 0xb8, 0xf6, 0xa0, 0xee, 0x31, 0xb1, 0xc3, 0x13,
+	0x7c, 0x21, 0x52, 0x2a, 0x5f, 0x99, 0x2e, 0xa4, 0x68, 0x6e, 0xe4, 0x11, 0x1c, 0xf3, 0xbc, 0x7e,
+	0x55, 0x05, 0x5b, 0x5d, 0xe6, 0x2b, 0x7f, 0x93, 0xc0, 0x6e, 0x42, 0x48, 0xe8, 0x78, 0x29, 0x82,
+	0x19, 0x26, 0xb1, 0xd3, 0x43, 0x48, 0x91, 0x1a, 0xd5, 0xe6, 0x9d, 0xd3, 0xba, 0x21, 0xa8, 0x25,
+	0x67, 0xd6, 0x8d, 0x71, 0x46, 0x70, 0x6c, 0x75, 0x2e, 0x72, 0xad, 0x52, 0xe4, 0x9a, 0x32, 0x86,
+	0x51, 0xf8, 0x52, 0x5f, 0x21, 0xe8, 0x3f, 0xfe, 0x6a, 0xcd, 0x00, 0x67, 0xfd, 0x8f, 0xae, 0xe1,
+	0x91, 0x48, 0xb4, 0x27, 0x1e, 0xc7, 0xd4, 0x1f, 0x98, 0xd9, 0x38, 0x41, 0x94, 0xc1, 0xa8, 0x7d,
+	0xbf, 0xac, 0x3f, 0x13, 0xe5, 0xe7, 0x08, 0xc9, 0xef, 0xc1, 0x7e, 0x82, 0x02, 0x07, 0xa6, 0x2e,
+	0xce, 0x52, 0x18, 0x20, 0x07, 0xc5, 0xd0, 0x0d, 0x91, 0xaf, 0x6c, 0x34, 0xa4, 0xe6, 0x2d, 0xab,
+	0x51, 0xe4, 0xda, 0x43, 0xe1, 0xbc, 0x4e, 0xa6, 0xdb, 0x7b, 0x09, 0x0a, 0x5e, 0xcf, 0xc2, 0x6f,
+	0x78, 0x54, 0x8e, 0x81, 0xba, 0x24, 0x4f, 0x88, 0xd7, 0x77, 0xb0, 0x8f, 0xe2, 0x0c, 0xf7, 0x30,
+	0x4a, 0x95, 0x6a, 0x43, 0x6a, 0xde, 0xb6, 0x9e, 0x16, 0xb9, 0xf6, 0x78, 0x2d, 0x7e, 0x49, 0xaf,
+	0xdb, 0x87, 0x0b, 0x3e, 0x65, 0xba, 0x3d, 0xcf, 0xca, 0x9f, 0xc1, 0xde, 0x62, 0x7d, 0x88, 0xe3,
+	0x01, 0x55, 0x36, 0xd9, 0x70, 0x9f, 0x18, 0xeb, 0xf6, 0xda, 0xe8, 0xde, 0xe0, 0x75, 0x70, 0x3c,
+	0xb0, 0x74, 0x31, 0xe9, 0x83, 0x75, 0x0d, 0x31, 0xa0, 0x6e, 0xef, 0x26, 0x4b, 0x55, 0x54, 0xff,
+	0x5e, 0x05, 0x3b, 0xcb, 0x2c, 0xf9, 0x1d, 0x90, 0x69, 0x56, 0xce, 0x82, 0x7e, 0x82, 0x89, 0xc3,
+	0x36, 0x0d, 0xfb, 0x8a, 0xd4, 0x90, 0x9a, 0x9b, 0xd6, 0x51, 0x91, 0x6b, 0x75, 0xee, 0xb1, 0xaa,
+	0xd1, 0xed, 0x9d, 0xeb, 0x60, 0x97, 0x90, 0xb0, 0xed, 0xcb, 0xaf, 0xc0, 0xbd, 0xd2, 0x1e, 0xf9,
+	0x73, 0xd0, 0x06, 0x03, 0xd5, 0x8b, 0x5c, 0xdb, 0xe7, 0xa0, 0xc5, 0xbc, 0x6e, 0x6f, 0xf3, 0x80,
+	0x00, 0xc4, 0xe0, 0x6e, 0x04, 0x47, 0x8e, 0x8f, 0x86, 0x98, 0x6d, 0xbc, 0x98, 0x7e, 0xbb, 0xfc,
+	0xe0, 0x3f, 0xb9, 0x76, 0xc8, 0x0f, 0x0b, 0xf5, 0x07, 0x06, 0x26, 0x66, 0x04, 0xb3, 0xbe, 0xd1,
+	0x41, 0x01, 0xf4, 0xc6, 0x2d, 0xe4, 0x15, 0xb9, 0x56, 0xe3, 0x16, 0x0b, 0x04, 0xfd, 0xd7, 0xcf,
+	0x63, 0x20, 0xce, 0x6c, 0x0b, 0x79, 0xf6, 0x76, 0x04, 0x47, 0xad, 0x59, 0x52, 0xfe, 0x22, 0x81,
+	0x07, 0xa5, 0x3c, 0x45, 0x2e, 0x0c, 0x61, 0xec, 0x21, 0xa7, 0x97, 0x42, 0x8f, 0x39, 0x6f, 0x32,
+	0x67, 0xfb, 0xff, 0x9c, 0x8f, 0xae, 0x9d, 0x57, 0x51, 0xcb, 0x2d, 0xd4, 0x22, 0x38, 0xb2, 0x67,
+	0xaa, 0x73, 0x21, 0xb2, 0xde, 0x5e, 0x4c, 0x54, 0xe9, 0x72, 0xa2, 0x4a, 0x57, 0x13, 0x55, 0xfa,
+	0x3a, 0x55, 0x2b, 0x97, 0x53, 0xb5, 0xf2, 0x7b, 0xaa, 0x56, 0x3e, 0x3c, 0xbb, 0x71, 0x69, 0xc4,
+	0x01, 0x39, 0x0e, 0xa1, 0x4b, 0x67, 0x0b, 0x73, 0x78, 0xfa, 0xc2, 0x1c, 0xf1, 0x5f, 0x08, 0xbb,
+	0x42, 0xee, 0x16, 0xbb, 0xd3, 0xcf, 0xff, 0x0d, 0x00, 0x4d, 0x19, 0x26, 0xfb, 0x5f, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -119,6 +238,37 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PegArbitrageLinks) > 0 {
+		for iNdEx := len(m.PegArbitrageLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PegArbitrageLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PegArbitrageEpochIdentifier) > 0 {
+		i -= len(m.PegArbitrageEpochIdentifier)
+		copy(dAtA[i:], m.PegArbitrageEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PegArbitrageEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PegArbitrageEnabled {
+		i--
+		if m.PegArbitrageEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -136,6 +286,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PegArbitrageLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PegArbitrageLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PegArbitrageLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxRebalanceFraction.Size()
+		i -= size
+		if _, err := m.MaxRebalanceFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LinkedPoolId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LinkedPoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StableswapPoolId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StableswapPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -159,6 +362,38 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.PegArbitrageEnabled {
+		n += 2
+	}
+	l = len(m.PegArbitrageEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.PegArbitrageLinks) > 0 {
+		for _, e := range m.PegArbitrageLinks {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PegArbitrageLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StableswapPoolId != 0 {
+		n += 1 + sovParams(uint64(m.StableswapPoolId))
+	}
+	if m.LinkedPoolId != 0 {
+		n += 1 + sovParams(uint64(m.LinkedPoolId))
+	}
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxRebalanceFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -231,6 +466,248 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegArbitrageEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PegArbitrageEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegArbitrageEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PegArbitrageEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegArbitrageLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PegArbitrageLinks = append(m.PegArbitrageLinks, PegArbitrageLink{})
+			if err := m.PegArbitrageLinks[len(m.PegArbitrageLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PegArbitrageLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PegArbitrageLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PegArbitrageLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableswapPoolId", wireType)
+			}
+			m.StableswapPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableswapPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedPoolId", wireType)
+			}
+			m.LinkedPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkedPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRebalanceFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRebalanceFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
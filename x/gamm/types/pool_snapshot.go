@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// PoolSnapshot is a read-only view of a gamm pool's state, decoupled from the
+// pool's stored encoding so that it can be exposed to external consumers.
+type PoolSnapshot struct {
+	PoolId   uint64
+	PoolType poolmanagertypes.PoolType
+	// Reserves are the pool's liquidity, sorted by denom.
+	Reserves sdk.Coins
+	// Weights are the token weights of weighted pools, in the order of Reserves.
+	// Empty for pools without weights.
+	Weights []DenomWeight
+	// ScalingFactors are the scaling factors of stableswap pools, in the order of Reserves.
+	// Empty for pools without scaling factors.
+	ScalingFactors []uint64
+	SpreadFactor   osmomath.Dec
+	ExitFee        osmomath.Dec
+	TotalShares    sdk.Coin
+}
@@ -15,21 +15,25 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	migration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
 	types2 "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1764,6 +1768,280 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) GetMigrationRecords() *migratio
 	return nil
 }
 
+// =============================== ScalingFactorRamp
+type QueryScalingFactorRampRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryScalingFactorRampRequest) Reset()         { *m = QueryScalingFactorRampRequest{} }
+func (m *QueryScalingFactorRampRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRampRequest) ProtoMessage()    {}
+func (*QueryScalingFactorRampRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{36}
+}
+func (m *QueryScalingFactorRampRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScalingFactorRampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScalingFactorRampRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScalingFactorRampRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRampRequest.Merge(m, src)
+}
+func (m *QueryScalingFactorRampRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScalingFactorRampRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRampRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScalingFactorRampRequest proto.InternalMessageInfo
+
+func (m *QueryScalingFactorRampRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryScalingFactorRampResponse struct {
+	// scaling_factors are the scaling factors the pool currently swaps at.
+	ScalingFactors []uint64 `protobuf:"varint,1,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"scaling_factors"`
+	// ramp is the scaling factor ramp in progress for the pool, if any.
+	Ramp *ScalingFactorRamp `protobuf:"bytes,2,opt,name=ramp,proto3" json:"ramp,omitempty" yaml:"ramp"`
+}
+
+func (m *QueryScalingFactorRampResponse) Reset()         { *m = QueryScalingFactorRampResponse{} }
+func (m *QueryScalingFactorRampResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRampResponse) ProtoMessage()    {}
+func (*QueryScalingFactorRampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{37}
+}
+func (m *QueryScalingFactorRampResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScalingFactorRampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScalingFactorRampResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScalingFactorRampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRampResponse.Merge(m, src)
+}
+func (m *QueryScalingFactorRampResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScalingFactorRampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRampResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScalingFactorRampResponse proto.InternalMessageInfo
+
+func (m *QueryScalingFactorRampResponse) GetScalingFactors() []uint64 {
+	if m != nil {
+		return m.ScalingFactors
+	}
+	return nil
+}
+
+func (m *QueryScalingFactorRampResponse) GetRamp() *ScalingFactorRamp {
+	if m != nil {
+		return m.Ramp
+	}
+	return nil
+}
+
+// =============================== SmoothWeightChange
+type QuerySmoothWeightChangeRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QuerySmoothWeightChangeRequest) Reset()         { *m = QuerySmoothWeightChangeRequest{} }
+func (m *QuerySmoothWeightChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySmoothWeightChangeRequest) ProtoMessage()    {}
+func (*QuerySmoothWeightChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{38}
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySmoothWeightChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySmoothWeightChangeRequest.Merge(m, src)
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySmoothWeightChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySmoothWeightChangeRequest proto.InternalMessageInfo
+
+func (m *QuerySmoothWeightChangeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// DenomWeight is the non-normalized balancer weight of a pool's denom.
+type DenomWeight struct {
+	Denom  string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Weight cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.Int" json:"weight" yaml:"weight"`
+}
+
+func (m *DenomWeight) Reset()         { *m = DenomWeight{} }
+func (m *DenomWeight) String() string { return proto.CompactTextString(m) }
+func (*DenomWeight) ProtoMessage()    {}
+func (*DenomWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{39}
+}
+func (m *DenomWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomWeight.Merge(m, src)
+}
+func (m *DenomWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomWeight proto.InternalMessageInfo
+
+func (m *DenomWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QuerySmoothWeightChangeResponse struct {
+	// current_weights are the weights the pool currently swaps at.
+	CurrentWeights []DenomWeight `protobuf:"bytes,1,rep,name=current_weights,json=currentWeights,proto3" json:"current_weights" yaml:"current_weights"`
+	// target_weights are the weights of the pool once its smooth weight change
+	// completes. Equal to current_weights if none is in progress.
+	TargetWeights []DenomWeight `protobuf:"bytes,2,rep,name=target_weights,json=targetWeights,proto3" json:"target_weights" yaml:"target_weights"`
+	// in_progress is whether the pool has a smooth weight change in progress.
+	InProgress bool `protobuf:"varint,3,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty" yaml:"in_progress"`
+	// start_time is the time the smooth weight change starts at, unset if
+	// none is in progress.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time is the time the smooth weight change completes at, unset if
+	// none is in progress.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *QuerySmoothWeightChangeResponse) Reset()         { *m = QuerySmoothWeightChangeResponse{} }
+func (m *QuerySmoothWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySmoothWeightChangeResponse) ProtoMessage()    {}
+func (*QuerySmoothWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{40}
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySmoothWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySmoothWeightChangeResponse.Merge(m, src)
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySmoothWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySmoothWeightChangeResponse proto.InternalMessageInfo
+
+func (m *QuerySmoothWeightChangeResponse) GetCurrentWeights() []DenomWeight {
+	if m != nil {
+		return m.CurrentWeights
+	}
+	return nil
+}
+
+func (m *QuerySmoothWeightChangeResponse) GetTargetWeights() []DenomWeight {
+	if m != nil {
+		return m.TargetWeights
+	}
+	return nil
+}
+
+func (m *QuerySmoothWeightChangeResponse) GetInProgress() bool {
+	if m != nil {
+		return m.InProgress
+	}
+	return false
+}
+
+func (m *QuerySmoothWeightChangeResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QuerySmoothWeightChangeResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.gamm.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.gamm.v1beta1.ParamsResponse")
@@ -1801,147 +2079,176 @@ func init() {
 	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMResponse)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMResponse")
 	proto.RegisterType((*QueryCFMMConcentratedPoolLinksRequest)(nil), "osmosis.gamm.v1beta1.QueryCFMMConcentratedPoolLinksRequest")
 	proto.RegisterType((*QueryCFMMConcentratedPoolLinksResponse)(nil), "osmosis.gamm.v1beta1.QueryCFMMConcentratedPoolLinksResponse")
+	proto.RegisterType((*QueryScalingFactorRampRequest)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorRampRequest")
+	proto.RegisterType((*QueryScalingFactorRampResponse)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorRampResponse")
+	proto.RegisterType((*QuerySmoothWeightChangeRequest)(nil), "osmosis.gamm.v1beta1.QuerySmoothWeightChangeRequest")
+	proto.RegisterType((*DenomWeight)(nil), "osmosis.gamm.v1beta1.DenomWeight")
+	proto.RegisterType((*QuerySmoothWeightChangeResponse)(nil), "osmosis.gamm.v1beta1.QuerySmoothWeightChangeResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 2149 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5d, 0x6c, 0x1c, 0x57,
-	0x15, 0xf6, 0x38, 0x8e, 0x6b, 0x9f, 0xc4, 0x7f, 0xb7, 0x4e, 0xb3, 0x5e, 0x3b, 0xbb, 0xe9, 0x6d,
-	0x6a, 0xbb, 0x89, 0xbd, 0x6b, 0x3b, 0x2e, 0x2d, 0x26, 0x69, 0x63, 0xbb, 0x76, 0xe2, 0x28, 0x8e,
-	0xd3, 0x49, 0x25, 0x04, 0x08, 0x46, 0xe3, 0xdd, 0xf1, 0x7a, 0xea, 0x9d, 0x99, 0xcd, 0xce, 0x9d,
-	0xc6, 0x56, 0x15, 0x55, 0x02, 0x09, 0xb5, 0xbc, 0x14, 0x09, 0xe8, 0x13, 0x82, 0x97, 0x0a, 0x01,
-	0xcf, 0x48, 0x3c, 0xf1, 0x80, 0x78, 0x89, 0x78, 0x8a, 0x80, 0x07, 0xc4, 0xc3, 0x82, 0x12, 0xe0,
-	0x1d, 0xbf, 0xf0, 0x8a, 0xee, 0xbd, 0x67, 0x7e, 0x76, 0x77, 0x3c, 0xfb, 0x53, 0x45, 0x2a, 0x4f,
-	0xde, 0xb9, 0xf7, 0xfc, 0x7c, 0xe7, 0x9c, 0x7b, 0xce, 0x3d, 0xf7, 0x18, 0x2e, 0x3a, 0xae, 0xe5,
-	0xb8, 0xa6, 0x9b, 0x2f, 0xe9, 0x96, 0x95, 0xff, 0x60, 0x71, 0xd7, 0x60, 0xfa, 0x62, 0xfe, 0x81,
-	0x67, 0x54, 0x8f, 0x72, 0x95, 0xaa, 0xc3, 0x1c, 0x32, 0x8e, 0x14, 0x39, 0x4e, 0x91, 0x43, 0x8a,
-	0xf4, 0x78, 0xc9, 0x29, 0x39, 0x82, 0x20, 0xcf, 0x7f, 0x49, 0xda, 0xf4, 0x85, 0x58, 0x69, 0xec,
-	0x10, 0xb7, 0xe7, 0xfc, 0xed, 0x8a, 0xe3, 0x94, 0x2d, 0xdd, 0xd6, 0x4b, 0x46, 0x35, 0xa0, 0x72,
-	0x1f, 0xea, 0x15, 0xad, 0xea, 0x78, 0xcc, 0x40, 0xea, 0x4c, 0x41, 0x90, 0xe7, 0x77, 0x75, 0xd7,
-	0x08, 0xa8, 0x0a, 0x8e, 0x69, 0xe3, 0xfe, 0xe5, 0xe8, 0xbe, 0x40, 0x1c, 0x50, 0x55, 0xf4, 0x92,
-	0x69, 0xeb, 0xcc, 0x74, 0x7c, 0xda, 0xa9, 0x92, 0xe3, 0x94, 0xca, 0x46, 0x5e, 0xaf, 0x98, 0x79,
-	0xdd, 0xb6, 0x1d, 0x26, 0x36, 0x5d, 0xdc, 0x9d, 0xc0, 0x5d, 0xf1, 0xb5, 0xeb, 0xed, 0xe5, 0x75,
-	0xfb, 0xc8, 0xdf, 0x92, 0x4a, 0x34, 0x69, 0xaa, 0xfc, 0xc0, 0xad, 0x97, 0x63, 0x8d, 0x75, 0xf7,
-	0xf5, 0xaa, 0x51, 0x4c, 0x24, 0xa9, 0xe8, 0x55, 0xdd, 0x42, 0x29, 0x74, 0x04, 0x86, 0xee, 0x89,
-	0x6f, 0xd5, 0x78, 0xe0, 0x19, 0x2e, 0xa3, 0x77, 0x60, 0xd8, 0x5f, 0x70, 0x2b, 0x8e, 0xed, 0x1a,
-	0x64, 0x05, 0xfa, 0x25, 0x4b, 0x4a, 0xb9, 0xa8, 0xcc, 0x9e, 0x59, 0x9a, 0xca, 0xc5, 0x85, 0x24,
-	0x27, 0xb9, 0xd6, 0xfa, 0x1e, 0xd7, 0xb2, 0x3d, 0x2a, 0x72, 0xd0, 0x75, 0x18, 0x7d, 0x97, 0xbb,
-	0xe6, 0x9e, 0xe3, 0x94, 0x51, 0x03, 0xb9, 0x02, 0x2f, 0xf0, 0x00, 0x68, 0x66, 0x51, 0x08, 0xec,
-	0x5b, 0x23, 0xc7, 0xb5, 0xec, 0xf0, 0x91, 0x6e, 0x95, 0x57, 0x28, 0x6e, 0x50, 0xb5, 0x9f, 0xff,
-	0xda, 0x2a, 0xae, 0xf4, 0xa6, 0x14, 0x7a, 0x07, 0xc6, 0x22, 0x42, 0x10, 0xd5, 0x55, 0xe8, 0xe3,
-	0x24, 0x88, 0x69, 0x3c, 0x27, 0x7d, 0x98, 0xf3, 0x7d, 0x98, 0x5b, 0xb5, 0x8f, 0xd6, 0x06, 0xff,
-	0xf8, 0x9b, 0xf9, 0xd3, 0x9c, 0x6b, 0x4b, 0x15, 0xc4, 0x42, 0xda, 0xb7, 0x22, 0xd2, 0x7c, 0xab,
-	0xc9, 0x26, 0x40, 0x18, 0xb4, 0x54, 0xaf, 0x90, 0x39, 0x9d, 0x43, 0x7f, 0xf3, 0x08, 0xe7, 0xe4,
-	0x99, 0x0c, 0x8d, 0x2d, 0x19, 0xc8, 0xab, 0x46, 0x38, 0xe9, 0x8f, 0x15, 0x20, 0x51, 0xe9, 0x08,
-	0xf6, 0x75, 0x38, 0xcd, 0xf5, 0x73, 0x0f, 0x9e, 0x6a, 0x07, 0xad, 0xa4, 0x26, 0x37, 0x63, 0x50,
-	0xcd, 0xb4, 0x44, 0x25, 0x75, 0xd6, 0xc1, 0x4a, 0xc3, 0xb8, 0x40, 0x75, 0xd7, 0xb3, 0xa2, 0x66,
-	0x0b, 0x7f, 0xdc, 0x85, 0x73, 0x0d, 0x7b, 0x08, 0x7a, 0x11, 0x06, 0x6d, 0xcf, 0xd2, 0x7c, 0xe0,
-	0x3c, 0x52, 0xe3, 0xc7, 0xb5, 0xec, 0xa8, 0x8c, 0x54, 0xb0, 0x45, 0xd5, 0x01, 0x1b, 0x59, 0x85,
-	0xbc, 0x75, 0xd4, 0xc5, 0x57, 0xde, 0x3b, 0xaa, 0x18, 0xdd, 0x84, 0x9d, 0xde, 0x46, 0x50, 0xa1,
-	0x90, 0x10, 0x94, 0x20, 0x66, 0x47, 0x15, 0x43, 0xc8, 0x19, 0x8c, 0x82, 0x0a, 0xb6, 0xa8, 0x3a,
-	0x50, 0x41, 0x56, 0xfa, 0x5b, 0x05, 0x32, 0x42, 0xd8, 0xba, 0x5e, 0x2e, 0xdc, 0x76, 0x4c, 0x9b,
-	0x0b, 0xbd, 0xcf, 0xf3, 0xc4, 0xed, 0x06, 0x1b, 0xd9, 0x87, 0x41, 0xe6, 0x1c, 0x18, 0xb6, 0xab,
-	0x99, 0x3c, 0x28, 0x3c, 0xa0, 0x13, 0x75, 0x41, 0xf1, 0xc3, 0xb1, 0xee, 0x98, 0xf6, 0xda, 0x02,
-	0xcf, 0x87, 0x5f, 0xff, 0x3d, 0x3b, 0x5b, 0x32, 0xd9, 0xbe, 0xb7, 0x9b, 0x2b, 0x38, 0x16, 0xe6,
-	0x31, 0xfe, 0x99, 0x77, 0x8b, 0x07, 0x79, 0x8e, 0xd9, 0x15, 0x0c, 0xae, 0x3a, 0x20, 0xa5, 0x6f,
-	0xd9, 0xf4, 0x3f, 0x0a, 0x64, 0x4f, 0x44, 0x8e, 0x0e, 0xd9, 0x85, 0x51, 0x91, 0xf3, 0x9a, 0xe3,
-	0x31, 0x4d, 0xb7, 0x1c, 0xcf, 0x66, 0xe8, 0x97, 0x37, 0xb9, 0xe6, 0xbf, 0xd5, 0xb2, 0xe7, 0xa4,
-	0x1e, 0xb7, 0x78, 0x90, 0x33, 0x9d, 0xbc, 0xa5, 0xb3, 0xfd, 0xdc, 0x96, 0xcd, 0x8e, 0x6b, 0xd9,
-	0xf3, 0xd2, 0xc0, 0x46, 0x76, 0xaa, 0x0e, 0x8b, 0xa5, 0x1d, 0x8f, 0xad, 0x8a, 0x05, 0xf2, 0x3e,
-	0x00, 0x5a, 0xec, 0x78, 0xec, 0x79, 0x98, 0x8c, 0x0e, 0xdd, 0xf1, 0x18, 0xfd, 0x44, 0x81, 0x99,
-	0xc0, 0xe6, 0x8d, 0x43, 0x93, 0x71, 0x9b, 0x05, 0xd5, 0x66, 0xd5, 0xb1, 0xea, 0xc3, 0x76, 0xbe,
-	0x21, 0x6c, 0x41, 0x88, 0x36, 0x60, 0x44, 0x5a, 0x65, 0xda, 0xbe, 0x4f, 0x7a, 0x85, 0x4f, 0x2e,
-	0x24, 0xfa, 0x44, 0x1d, 0x12, 0x5c, 0x5b, 0xb6, 0xb4, 0x9b, 0x7e, 0xa6, 0xc0, 0x6c, 0x6b, 0x2c,
-	0x18, 0x88, 0x7a, 0x27, 0x29, 0xcf, 0xd5, 0x49, 0x1b, 0xf0, 0x52, 0x90, 0x1e, 0x75, 0xe5, 0xbb,
-	0xb3, 0x2c, 0xbb, 0x09, 0xe7, 0x9b, 0xc4, 0xa0, 0x35, 0x73, 0x0d, 0x45, 0x3f, 0xb6, 0x64, 0x05,
-	0x65, 0xfe, 0x5d, 0xcc, 0xb0, 0xf7, 0x1c, 0xa6, 0x97, 0xb9, 0xb4, 0x3b, 0xe6, 0x03, 0xcf, 0x2c,
-	0x9a, 0xec, 0xa8, 0xeb, 0xa2, 0xff, 0xb9, 0x7f, 0xf6, 0xe3, 0x64, 0x22, 0xc8, 0x47, 0x30, 0x58,
-	0xf6, 0x17, 0x5b, 0x7b, 0xfc, 0x1d, 0xee, 0xf1, 0xb0, 0x56, 0x04, 0x9c, 0xb4, 0xb3, 0x28, 0x04,
-	0x7c, 0x02, 0xe6, 0x26, 0xba, 0x50, 0xa0, 0xec, 0xbe, 0xa8, 0x50, 0x0f, 0x52, 0xcd, 0x72, 0xd0,
-	0xcc, 0x6f, 0xc0, 0x59, 0xc6, 0x97, 0x35, 0x71, 0x3a, 0xfd, 0x88, 0x24, 0x58, 0x3a, 0x89, 0x96,
-	0xbe, 0x28, 0x95, 0x45, 0x99, 0xa9, 0x7a, 0x86, 0x85, 0x2a, 0xe8, 0xef, 0x14, 0xb8, 0xd4, 0x54,
-	0x61, 0xee, 0x3a, 0xf7, 0x1f, 0xea, 0x95, 0xff, 0x8b, 0x0a, 0xf9, 0x6f, 0x05, 0x5e, 0x6d, 0x81,
-	0x1f, 0x9d, 0xf8, 0x51, 0x67, 0xe9, 0xb9, 0x81, 0x2e, 0x1c, 0xf3, 0x5d, 0xe8, 0xb3, 0xd2, 0x2e,
-	0x73, 0x96, 0x5c, 0x03, 0x90, 0x21, 0xc0, 0x22, 0xda, 0x46, 0x39, 0x1a, 0x94, 0x0c, 0x3c, 0xe3,
-	0x7f, 0xd5, 0x8b, 0x37, 0xe2, 0xfd, 0x8a, 0xc3, 0xee, 0x55, 0xcd, 0x42, 0x57, 0xf7, 0x2a, 0xd9,
-	0x80, 0x51, 0x6e, 0xab, 0xa6, 0xbb, 0xae, 0xc1, 0xb4, 0xa2, 0x61, 0x3b, 0x16, 0x42, 0x99, 0x0c,
-	0x2f, 0x84, 0x46, 0x0a, 0xaa, 0x0e, 0xf3, 0xa5, 0x55, 0xbe, 0xf2, 0x0e, 0x5f, 0x20, 0xb7, 0x60,
-	0xec, 0x81, 0xe7, 0xb0, 0x7a, 0x39, 0xa7, 0x84, 0x9c, 0xa9, 0xe3, 0x5a, 0x36, 0x25, 0xe5, 0x34,
-	0x91, 0x50, 0x75, 0x44, 0xac, 0x45, 0x24, 0xdd, 0x85, 0x33, 0x0f, 0x4d, 0xb6, 0xcf, 0x03, 0xb6,
-	0x69, 0x18, 0xa9, 0xbe, 0x8b, 0xca, 0xec, 0xc0, 0xda, 0xdc, 0x71, 0x2d, 0x3b, 0x2d, 0x65, 0xf0,
-	0x4d, 0x4d, 0xf4, 0xe6, 0x7b, 0x86, 0x41, 0xe7, 0x8a, 0x46, 0xa5, 0x6a, 0x14, 0x74, 0x66, 0x14,
-	0x57, 0x28, 0xab, 0x7a, 0x06, 0x4d, 0x29, 0x6a, 0x54, 0x80, 0xc8, 0xc9, 0xdf, 0x2b, 0x30, 0x19,
-	0x36, 0x61, 0x5f, 0x37, 0xd9, 0xfe, 0xa6, 0x59, 0x66, 0x46, 0xd5, 0xf7, 0xd8, 0x75, 0x18, 0xb2,
-	0x4c, 0x5b, 0x8b, 0x96, 0x0e, 0x8e, 0x3c, 0x75, 0x5c, 0xcb, 0x8e, 0x4b, 0xad, 0x75, 0xdb, 0x54,
-	0x3d, 0x6b, 0x99, 0x76, 0x50, 0x7d, 0xc8, 0x64, 0xb4, 0x05, 0x11, 0xce, 0x0b, 0x9b, 0x8d, 0x86,
-	0x46, 0xf2, 0x54, 0xd7, 0x8d, 0xe4, 0xcf, 0x14, 0x98, 0x8a, 0xb7, 0xe1, 0x4b, 0xd2, 0x52, 0xaa,
-	0x78, 0x05, 0x45, 0xce, 0x23, 0x22, 0x5b, 0x06, 0x70, 0x2b, 0x0e, 0xd3, 0x2a, 0x7c, 0x15, 0x7d,
-	0x7b, 0x2e, 0x4c, 0xa5, 0x70, 0x8f, 0xaa, 0x83, 0xae, 0xcf, 0x2d, 0x02, 0xf7, 0x83, 0x5e, 0xb8,
-	0x20, 0x85, 0x3e, 0xd4, 0x2b, 0x1b, 0x87, 0x7a, 0x01, 0x1b, 0x90, 0x2d, 0xdb, 0x0f, 0xdd, 0x6b,
-	0xd0, 0xef, 0x1a, 0x76, 0xd1, 0xa8, 0xa2, 0xdc, 0xb1, 0xe3, 0x5a, 0x76, 0x08, 0xe5, 0x8a, 0x75,
-	0xaa, 0x22, 0x41, 0x34, 0x2f, 0x7a, 0x5b, 0xe6, 0x45, 0x0e, 0x64, 0x4d, 0xe1, 0x05, 0x4b, 0x9e,
-	0xe3, 0x17, 0x8f, 0x6b, 0xd9, 0x91, 0x48, 0xf2, 0x6b, 0xa6, 0x4d, 0xd5, 0x17, 0xc4, 0xcf, 0x2d,
-	0x9b, 0x7c, 0x1b, 0xfa, 0xc5, 0x5b, 0xd1, 0x4d, 0xf5, 0x09, 0xf7, 0xe7, 0x82, 0x37, 0x51, 0xe4,
-	0x6d, 0x19, 0x38, 0x91, 0x9b, 0x13, 0x58, 0xc2, 0xd9, 0xd6, 0xce, 0x61, 0x79, 0x41, 0xec, 0x52,
-	0x16, 0x55, 0x51, 0xa8, 0x70, 0xc6, 0xc7, 0x7e, 0xdb, 0x1a, 0xe3, 0x8c, 0xb0, 0xf7, 0x93, 0xd8,
-	0xba, 0xee, 0xfd, 0x1a, 0xd9, 0xa9, 0x3a, 0x2c, 0x96, 0x82, 0xde, 0x4f, 0x40, 0xf9, 0xb4, 0x37,
-	0x1e, 0xca, 0x8e, 0xc7, 0x9e, 0x77, 0x60, 0xbe, 0x13, 0x38, 0xfa, 0x94, 0x70, 0x74, 0xbe, 0x4d,
-	0x47, 0x73, 0x68, 0x6d, 0x78, 0x9a, 0xbf, 0x27, 0x02, 0x1f, 0x88, 0xea, 0x53, 0xf7, 0x9e, 0x08,
-	0xb6, 0x28, 0xde, 0x39, 0x3b, 0x9e, 0xf4, 0xc8, 0xf7, 0xfd, 0xee, 0x24, 0xce, 0x23, 0x18, 0x1d,
-	0x0d, 0x46, 0xfc, 0x93, 0x53, 0x1f, 0x9c, 0x37, 0x5a, 0x05, 0xe7, 0xa5, 0xfa, 0x73, 0x17, 0xc4,
-	0x66, 0x08, 0x8f, 0x5f, 0x24, 0x34, 0x53, 0x90, 0x0e, 0xfb, 0x86, 0xc6, 0xae, 0x8b, 0xfe, 0xd4,
-	0xaf, 0x84, 0x8d, 0xdb, 0x5f, 0x8a, 0x06, 0x8a, 0x96, 0xe0, 0xb2, 0xbc, 0xbc, 0x1d, 0xbb, 0x60,
-	0xd8, 0xac, 0xca, 0xeb, 0xba, 0xa8, 0x56, 0xc5, 0x3b, 0xa6, 0x7d, 0xc0, 0x7b, 0xec, 0xf5, 0xcd,
-	0xed, 0x6d, 0xff, 0x88, 0x7d, 0x15, 0xce, 0x16, 0xf6, 0x2c, 0xf9, 0xea, 0x0c, 0x6f, 0xbb, 0xf3,
-	0x61, 0x9f, 0x13, 0xdd, 0xa5, 0x2a, 0xf0, 0x4f, 0x29, 0x8d, 0x6a, 0x70, 0xa5, 0x2d, 0x45, 0xe8,
-	0x96, 0x05, 0x18, 0x2f, 0x44, 0x28, 0xeb, 0x35, 0xaa, 0xa4, 0xd0, 0x24, 0x85, 0xce, 0xf8, 0x6d,
-	0xc8, 0xe6, 0xf6, 0x76, 0xa3, 0x12, 0xae, 0x22, 0x18, 0xaf, 0x3c, 0x82, 0xe9, 0x56, 0x84, 0x08,
-	0xe2, 0x3e, 0x8c, 0x59, 0x66, 0xa9, 0x2a, 0xaa, 0xad, 0x56, 0x35, 0x0a, 0x4e, 0xb5, 0xe8, 0xb7,
-	0x7e, 0xd3, 0xf1, 0x13, 0x98, 0x6d, 0x9f, 0x5c, 0x95, 0xd4, 0xea, 0xa8, 0xd5, 0xb0, 0xb2, 0xf4,
-	0xbd, 0x09, 0x38, 0x2d, 0xf4, 0x93, 0x8f, 0x40, 0x5c, 0x0c, 0x2e, 0x99, 0x89, 0x17, 0xd6, 0x34,
-	0x23, 0x49, 0xcf, 0xb6, 0x26, 0x94, 0xd0, 0xe9, 0x2b, 0xdf, 0xfd, 0xf3, 0x3f, 0x7f, 0xd4, 0x7b,
-	0x81, 0x4c, 0xe6, 0xe3, 0x07, 0x50, 0x42, 0xef, 0xa7, 0x0a, 0x0c, 0xf8, 0x33, 0x07, 0x72, 0x39,
-	0x41, 0x76, 0xc3, 0xd0, 0x22, 0x7d, 0xa5, 0x2d, 0x5a, 0x84, 0x72, 0x59, 0x40, 0x79, 0x99, 0x64,
-	0xe3, 0xa1, 0x04, 0x53, 0x8c, 0x8f, 0x7b, 0x15, 0xf2, 0xb9, 0x02, 0xc3, 0xf5, 0x89, 0x42, 0x16,
-	0x12, 0x74, 0xc5, 0xa6, 0x5c, 0x7a, 0xb1, 0x03, 0x0e, 0xc4, 0x38, 0x2f, 0x30, 0xce, 0x90, 0x57,
-	0xe3, 0x31, 0xca, 0xf6, 0x3d, 0xc8, 0x1a, 0xf2, 0x0b, 0x05, 0x46, 0x1a, 0xba, 0x02, 0xb2, 0xd8,
-	0x2a, 0x36, 0x4d, 0x5d, 0x50, 0x7a, 0xa9, 0x13, 0x16, 0x44, 0x3a, 0x27, 0x90, 0x4e, 0x93, 0x4b,
-	0xf1, 0x48, 0xf7, 0x04, 0x35, 0x26, 0x8c, 0x4b, 0x3e, 0x51, 0xa0, 0x8f, 0x4b, 0x22, 0xd3, 0x2d,
-	0x54, 0xf9, 0x90, 0x66, 0x5a, 0xd2, 0x21, 0x8e, 0x85, 0x64, 0x8f, 0x09, 0xf5, 0xf9, 0x0f, 0x31,
-	0x6d, 0x1f, 0xf1, 0xd8, 0x7e, 0xa6, 0xc0, 0x80, 0x3f, 0x4c, 0x4a, 0x3c, 0x6d, 0x0d, 0x63, 0xab,
-	0xc4, 0xd3, 0xd6, 0x38, 0x9d, 0xa2, 0x8b, 0x02, 0xd7, 0x15, 0xf2, 0xda, 0xc9, 0xb8, 0x44, 0xdb,
-	0x18, 0x62, 0x23, 0x3f, 0x51, 0x20, 0x75, 0xd2, 0xe3, 0x85, 0xac, 0x24, 0x28, 0x6f, 0xf1, 0x62,
-	0x4b, 0x7f, 0xad, 0x2b, 0x5e, 0x34, 0xa4, 0x87, 0xfc, 0x41, 0x01, 0xd2, 0x3c, 0x76, 0x22, 0xcb,
-	0x6d, 0x4a, 0xad, 0xc7, 0xf2, 0x7a, 0x87, 0x5c, 0x88, 0xe2, 0x86, 0x70, 0xe7, 0x0a, 0x79, 0xb3,
-	0xad, 0x30, 0xe7, 0xdf, 0x77, 0x4c, 0x5b, 0xbe, 0x15, 0x0c, 0x7e, 0x23, 0x6b, 0xa6, 0x4d, 0xfe,
-	0xa5, 0xc0, 0x64, 0xc2, 0xf0, 0x86, 0x5c, 0x6f, 0x01, 0x2c, 0x79, 0x00, 0x95, 0x7e, 0xab, 0x5b,
-	0x76, 0x34, 0xf0, 0xa6, 0x30, 0x70, 0x95, 0xbc, 0xdd, 0x9e, 0x81, 0xc6, 0xa1, 0xc9, 0xa4, 0x81,
-	0x72, 0xba, 0x25, 0xfb, 0x02, 0x6e, 0xe7, 0xcf, 0x15, 0x80, 0x70, 0x8a, 0x43, 0xe6, 0x5a, 0x1c,
-	0xda, 0xba, 0x99, 0x51, 0x7a, 0xbe, 0x4d, 0x6a, 0x04, 0xbd, 0x2c, 0x40, 0xe7, 0xc8, 0x5c, 0x7b,
-	0xa0, 0xe5, 0x88, 0x88, 0x3c, 0x56, 0x80, 0x34, 0x8f, 0x72, 0x12, 0xcf, 0xd3, 0x89, 0xd3, 0xa4,
-	0xc4, 0xf3, 0x74, 0xf2, 0xbc, 0x88, 0x6e, 0x08, 0xe4, 0xd7, 0xc8, 0x4a, 0x7b, 0xc8, 0x65, 0xe1,
-	0x15, 0x9f, 0x41, 0xf5, 0xe5, 0xb5, 0xe4, 0x97, 0x0a, 0x9c, 0x89, 0xcc, 0x69, 0xc8, 0x7c, 0x2b,
-	0x34, 0xf5, 0x87, 0x26, 0xd7, 0x2e, 0x39, 0xa2, 0x5e, 0x11, 0xa8, 0x97, 0xc9, 0x52, 0x27, 0xa8,
-	0xe5, 0xe4, 0x80, 0x9f, 0x8b, 0xc1, 0xe0, 0x85, 0x46, 0x92, 0x6a, 0x59, 0xe3, 0x5c, 0x21, 0x3d,
-	0xd7, 0x1e, 0x31, 0x82, 0x7c, 0xa3, 0xc3, 0x43, 0xc1, 0x99, 0xc5, 0xa5, 0xfb, 0x44, 0x81, 0x89,
-	0x0d, 0x97, 0x99, 0x96, 0xce, 0x8c, 0xa6, 0x97, 0x0e, 0xb9, 0x9a, 0x04, 0xe2, 0x84, 0x47, 0x62,
-	0x7a, 0xb9, 0x33, 0x26, 0xb4, 0xe0, 0x96, 0xb0, 0xe0, 0x6d, 0x72, 0x3d, 0xde, 0x82, 0x48, 0x16,
-	0x22, 0xda, 0x7c, 0xa4, 0xd4, 0x04, 0x99, 0xc8, 0x4d, 0xfa, 0x8b, 0x02, 0xe9, 0x13, 0x4c, 0xda,
-	0xf1, 0x18, 0xe9, 0x00, 0x5e, 0xf8, 0xc0, 0x4a, 0x3c, 0xf2, 0x27, 0x3f, 0x42, 0xe8, 0x96, 0xb0,
-	0xea, 0x06, 0x79, 0xeb, 0x0b, 0x58, 0xe5, 0x78, 0x8c, 0x9b, 0xf5, 0x5f, 0x05, 0x32, 0xc9, 0x0d,
-	0x34, 0xb9, 0x91, 0x54, 0x0f, 0xdb, 0x69, 0xf2, 0xd3, 0xab, 0x5f, 0x40, 0x02, 0x9a, 0x7c, 0x4f,
-	0x98, 0x7c, 0x9b, 0xdc, 0x8a, 0x37, 0x39, 0xae, 0xb3, 0xd7, 0xca, 0xa6, 0x7d, 0xa0, 0xed, 0x55,
-	0x1d, 0x4b, 0xe3, 0xaf, 0x86, 0xfc, 0x87, 0xd1, 0xa7, 0xc4, 0x23, 0xf2, 0x27, 0x05, 0x26, 0x4e,
-	0x6c, 0xd8, 0x49, 0xe2, 0x45, 0xdb, 0xe2, 0x3d, 0x90, 0xbe, 0xd6, 0x1d, 0x73, 0x7b, 0xa5, 0x41,
-	0x58, 0xd1, 0x6c, 0x6f, 0x59, 0xc0, 0x3e, 0x82, 0x7e, 0xbc, 0x2d, 0x5e, 0x49, 0xfa, 0x87, 0xae,
-	0x0f, 0xf4, 0x52, 0x32, 0x11, 0x02, 0xba, 0x24, 0x00, 0x65, 0xc8, 0x54, 0x3e, 0xe1, 0x5f, 0xcf,
-	0x6b, 0xb7, 0x1f, 0x3f, 0xcd, 0x28, 0x4f, 0x9e, 0x66, 0x94, 0x7f, 0x3c, 0xcd, 0x28, 0x3f, 0x7c,
-	0x96, 0xe9, 0x79, 0xf2, 0x2c, 0xd3, 0xf3, 0xd7, 0x67, 0x99, 0x9e, 0x6f, 0x2e, 0x44, 0x9e, 0x91,
-	0x28, 0x61, 0xbe, 0xac, 0xef, 0xba, 0x81, 0xb8, 0x0f, 0x96, 0xbe, 0x92, 0x3f, 0x94, 0x42, 0xc5,
-	0xa3, 0x72, 0xb7, 0x5f, 0x0c, 0xbc, 0xae, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xd7, 0xbb,
-	0x06, 0x4a, 0x20, 0x00, 0x00,
+	// 2531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5d, 0x6c, 0x14, 0xd7,
+	0x15, 0x66, 0x8c, 0x31, 0xf6, 0x31, 0xf8, 0xe7, 0xc6, 0x06, 0x33, 0x36, 0xbb, 0x70, 0x43, 0x0c,
+	0x01, 0x7b, 0x17, 0x1b, 0x53, 0x12, 0x07, 0x12, 0xbc, 0x8e, 0x0d, 0x46, 0x36, 0x90, 0x01, 0x29,
+	0xfd, 0x51, 0x3b, 0x1a, 0xef, 0x8e, 0xd7, 0x13, 0x76, 0x66, 0x96, 0x99, 0xbb, 0x01, 0x2b, 0x42,
+	0x91, 0xfa, 0x50, 0x25, 0x7d, 0x21, 0x52, 0xdb, 0x3c, 0x55, 0xed, 0x4b, 0x54, 0xb5, 0x7d, 0x6d,
+	0xa5, 0x3e, 0xf5, 0x21, 0xaa, 0x2a, 0xa1, 0x3e, 0xa1, 0xb6, 0x0f, 0x55, 0xa5, 0x6e, 0x2a, 0x68,
+	0xfb, 0xde, 0x7d, 0xe9, 0x6b, 0x75, 0xef, 0x3d, 0x33, 0x3b, 0xbb, 0x3b, 0xfb, 0x1b, 0x21, 0x25,
+	0x4f, 0xde, 0xb9, 0xf7, 0xfc, 0x7c, 0xe7, 0x9c, 0x7b, 0xcf, 0x3d, 0xe7, 0xc8, 0x70, 0xc2, 0xf5,
+	0x6d, 0xd7, 0xb7, 0xfc, 0x74, 0xde, 0xb0, 0xed, 0xf4, 0xfb, 0x0b, 0xdb, 0x26, 0x33, 0x16, 0xd2,
+	0xf7, 0x4b, 0xa6, 0xb7, 0x97, 0x2a, 0x7a, 0x2e, 0x73, 0xc9, 0x04, 0x52, 0xa4, 0x38, 0x45, 0x0a,
+	0x29, 0xd4, 0x89, 0xbc, 0x9b, 0x77, 0x05, 0x41, 0x9a, 0xff, 0x92, 0xb4, 0xea, 0xf1, 0x58, 0x69,
+	0xec, 0x21, 0x6e, 0xcf, 0x05, 0xdb, 0x45, 0xd7, 0x2d, 0xd8, 0x86, 0x63, 0xe4, 0x4d, 0x2f, 0xa4,
+	0xf2, 0x1f, 0x18, 0x45, 0xdd, 0x73, 0x4b, 0xcc, 0x44, 0xea, 0x44, 0x56, 0x90, 0xa7, 0xb7, 0x0d,
+	0xdf, 0x0c, 0xa9, 0xb2, 0xae, 0xe5, 0xe0, 0xfe, 0xd9, 0xe8, 0xbe, 0x40, 0x1c, 0x52, 0x15, 0x8d,
+	0xbc, 0xe5, 0x18, 0xcc, 0x72, 0x03, 0xda, 0x99, 0xbc, 0xeb, 0xe6, 0x0b, 0x66, 0xda, 0x28, 0x5a,
+	0x69, 0xc3, 0x71, 0x5c, 0x26, 0x36, 0x7d, 0xdc, 0x3d, 0x86, 0xbb, 0xe2, 0x6b, 0xbb, 0xb4, 0x93,
+	0x36, 0x9c, 0xbd, 0x60, 0x4b, 0x2a, 0xd1, 0xa5, 0xa9, 0xf2, 0x03, 0xb7, 0x4e, 0xc6, 0x1a, 0xeb,
+	0xef, 0x1a, 0x9e, 0x99, 0x6b, 0x49, 0x52, 0x34, 0x3c, 0xc3, 0x0e, 0xa4, 0xd0, 0x58, 0x92, 0xbc,
+	0xe9, 0x98, 0xbe, 0x15, 0xd0, 0x24, 0xeb, 0xf1, 0x31, 0xcb, 0x36, 0x7d, 0x66, 0xd8, 0x45, 0x49,
+	0x40, 0x47, 0xe1, 0xf0, 0x6d, 0x21, 0x54, 0x33, 0xef, 0x97, 0x4c, 0x9f, 0xd1, 0x4d, 0x18, 0x09,
+	0x16, 0xfc, 0xa2, 0xeb, 0xf8, 0x26, 0x59, 0x86, 0x01, 0xa9, 0x77, 0x4a, 0x39, 0xa1, 0x9c, 0x19,
+	0x5e, 0x9c, 0x49, 0xc5, 0xc5, 0x35, 0x25, 0xb9, 0x32, 0xfd, 0x4f, 0xca, 0xc9, 0x7d, 0x1a, 0x72,
+	0xd0, 0x55, 0x18, 0x7b, 0x87, 0xfb, 0xf7, 0xb6, 0xeb, 0x16, 0x50, 0x03, 0x39, 0x07, 0x07, 0x79,
+	0x14, 0x75, 0x2b, 0x27, 0x04, 0xf6, 0x67, 0x48, 0xa5, 0x9c, 0x1c, 0xd9, 0x33, 0xec, 0xc2, 0x32,
+	0xc5, 0x0d, 0xaa, 0x0d, 0xf0, 0x5f, 0x1b, 0xb9, 0xe5, 0xbe, 0x29, 0x85, 0x6e, 0xc2, 0x78, 0x44,
+	0x08, 0xa2, 0xba, 0x00, 0xfd, 0x9c, 0x04, 0x31, 0x4d, 0xa4, 0xa4, 0xa1, 0xa9, 0xc0, 0xd0, 0xd4,
+	0x8a, 0xb3, 0x97, 0x19, 0xfa, 0xd3, 0x6f, 0xe7, 0x0f, 0x70, 0xae, 0x0d, 0x4d, 0x10, 0x0b, 0x69,
+	0xdf, 0x89, 0x48, 0x0b, 0xac, 0x26, 0xeb, 0x00, 0xd5, 0xc8, 0x4f, 0xf5, 0x09, 0x99, 0xb3, 0x29,
+	0x0c, 0x1a, 0x3f, 0x26, 0x29, 0x79, 0xb0, 0xab, 0xc6, 0xe6, 0x4d, 0xe4, 0xd5, 0x22, 0x9c, 0xf4,
+	0xc7, 0x0a, 0x90, 0xa8, 0x74, 0x04, 0x7b, 0x11, 0x0e, 0x70, 0xfd, 0xdc, 0x83, 0xfb, 0x3b, 0x41,
+	0x2b, 0xa9, 0xc9, 0xb5, 0x18, 0x54, 0xa7, 0xdb, 0xa2, 0x92, 0x3a, 0x6b, 0x60, 0xa9, 0x30, 0x21,
+	0x50, 0xdd, 0x2c, 0xd9, 0x51, 0xb3, 0x85, 0x3f, 0x6e, 0xc2, 0x64, 0xdd, 0x1e, 0x82, 0x5e, 0x80,
+	0x21, 0xa7, 0x64, 0xeb, 0x01, 0x70, 0x1e, 0xa9, 0x89, 0x4a, 0x39, 0x39, 0x26, 0x23, 0x15, 0x6e,
+	0x51, 0x6d, 0xd0, 0x41, 0x56, 0x21, 0x6f, 0x15, 0x75, 0xf1, 0x95, 0xbb, 0x7b, 0x45, 0xb3, 0x97,
+	0xb0, 0xd3, 0x1b, 0x30, 0x59, 0x27, 0xa4, 0x0a, 0x4a, 0x10, 0xb3, 0xbd, 0xa2, 0x29, 0xe4, 0x0c,
+	0x45, 0x41, 0x85, 0x5b, 0x54, 0x1b, 0x2c, 0x22, 0x2b, 0xfd, 0x9d, 0x02, 0x09, 0x21, 0x6c, 0xd5,
+	0x28, 0x64, 0x6f, 0xb8, 0x96, 0xc3, 0x85, 0xde, 0xe1, 0x97, 0xcd, 0xef, 0x05, 0x1b, 0xd9, 0x85,
+	0x21, 0xe6, 0xde, 0x33, 0x1d, 0x5f, 0xb7, 0x78, 0x50, 0x78, 0x40, 0x8f, 0xd5, 0x04, 0x25, 0x08,
+	0xc7, 0xaa, 0x6b, 0x39, 0x99, 0xf3, 0xfc, 0x3e, 0xfc, 0xfa, 0x8b, 0xe4, 0x99, 0xbc, 0xc5, 0x76,
+	0x4b, 0xdb, 0xa9, 0xac, 0x6b, 0x63, 0x32, 0xc0, 0x3f, 0xf3, 0x7e, 0xee, 0x5e, 0x9a, 0x63, 0xf6,
+	0x05, 0x83, 0xaf, 0x0d, 0x4a, 0xe9, 0x1b, 0x0e, 0xfd, 0xaf, 0x02, 0xc9, 0xa6, 0xc8, 0xd1, 0x21,
+	0xdb, 0x30, 0x26, 0x12, 0x87, 0xee, 0x96, 0x98, 0x6e, 0xd8, 0x6e, 0xc9, 0x61, 0xe8, 0x97, 0xd7,
+	0xb8, 0xe6, 0xbf, 0x97, 0x93, 0x93, 0x52, 0x8f, 0x9f, 0xbb, 0x97, 0xb2, 0xdc, 0xb4, 0x6d, 0xb0,
+	0xdd, 0xd4, 0x86, 0xc3, 0x2a, 0xe5, 0xe4, 0x51, 0x69, 0x60, 0x3d, 0x3b, 0xd5, 0x46, 0xc4, 0xd2,
+	0xad, 0x12, 0x5b, 0x11, 0x0b, 0xe4, 0x3d, 0x00, 0xb4, 0xd8, 0x2d, 0xb1, 0x17, 0x61, 0x32, 0x3a,
+	0xf4, 0x56, 0x89, 0xd1, 0x8f, 0x15, 0x38, 0x1d, 0xda, 0xbc, 0xf6, 0xd0, 0x62, 0xdc, 0x66, 0x41,
+	0xb5, 0xee, 0xb9, 0x76, 0x6d, 0xd8, 0x8e, 0xd6, 0x85, 0x2d, 0x0c, 0xd1, 0x1a, 0x8c, 0x4a, 0xab,
+	0x2c, 0x27, 0xf0, 0x49, 0x9f, 0xf0, 0xc9, 0xf1, 0x96, 0x3e, 0xd1, 0x0e, 0x0b, 0xae, 0x0d, 0x47,
+	0xda, 0x4d, 0x3f, 0x55, 0xe0, 0x4c, 0x7b, 0x2c, 0x18, 0x88, 0x5a, 0x27, 0x29, 0x2f, 0xd4, 0x49,
+	0x6b, 0x70, 0x24, 0xbc, 0x1e, 0x35, 0xe9, 0xbb, 0xbb, 0x5b, 0x76, 0x0d, 0x8e, 0x36, 0x88, 0x41,
+	0x6b, 0xe6, 0xea, 0x92, 0x7e, 0x6c, 0xca, 0x0a, 0xd3, 0xfc, 0x3b, 0x78, 0xc3, 0xee, 0xba, 0xcc,
+	0x28, 0x70, 0x69, 0x9b, 0xd6, 0xfd, 0x92, 0x95, 0xb3, 0xd8, 0x5e, 0xcf, 0x49, 0xff, 0xb3, 0xe0,
+	0xec, 0xc7, 0xc9, 0x44, 0x90, 0x8f, 0x60, 0xa8, 0x10, 0x2c, 0xb6, 0xf7, 0xf8, 0xdb, 0xdc, 0xe3,
+	0xd5, 0x5c, 0x11, 0x72, 0xd2, 0xee, 0xa2, 0x10, 0xf2, 0x09, 0x98, 0xeb, 0x70, 0xb4, 0x8a, 0xb2,
+	0xf7, 0xa4, 0x42, 0x4b, 0x30, 0xd5, 0x28, 0x07, 0xcd, 0xfc, 0x16, 0x1c, 0x62, 0x7c, 0x59, 0x17,
+	0xa7, 0x33, 0x88, 0x48, 0x0b, 0x4b, 0xa7, 0xd1, 0xd2, 0x97, 0xa4, 0xb2, 0x28, 0x33, 0xd5, 0x86,
+	0x59, 0x55, 0x05, 0xfd, 0xbd, 0x02, 0xa7, 0x1a, 0x32, 0xcc, 0x4d, 0xf7, 0xce, 0x03, 0xa3, 0xf8,
+	0xb5, 0xc8, 0x90, 0xff, 0x51, 0xe0, 0x95, 0x36, 0xf8, 0xd1, 0x89, 0x1f, 0x76, 0x77, 0x3d, 0xd7,
+	0xd0, 0x85, 0xe3, 0x81, 0x0b, 0x03, 0x56, 0xda, 0xe3, 0x9d, 0x25, 0x97, 0x01, 0x64, 0x08, 0x30,
+	0x89, 0x76, 0x90, 0x8e, 0x86, 0x24, 0x03, 0xbf, 0xf1, 0xbf, 0xea, 0xc3, 0x17, 0xf1, 0x4e, 0xd1,
+	0x65, 0xb7, 0x3d, 0x2b, 0xdb, 0xd3, 0xbb, 0x4a, 0xd6, 0x60, 0x8c, 0xdb, 0xaa, 0x1b, 0xbe, 0x6f,
+	0x32, 0x3d, 0x67, 0x3a, 0xae, 0x8d, 0x50, 0xa6, 0xab, 0x0f, 0x42, 0x3d, 0x05, 0xd5, 0x46, 0xf8,
+	0xd2, 0x0a, 0x5f, 0x79, 0x9b, 0x2f, 0x90, 0xeb, 0x30, 0x7e, 0xbf, 0xe4, 0xb2, 0x5a, 0x39, 0xfb,
+	0x85, 0x9c, 0x99, 0x4a, 0x39, 0x39, 0x25, 0xe5, 0x34, 0x90, 0x50, 0x6d, 0x54, 0xac, 0x45, 0x24,
+	0xdd, 0x84, 0xe1, 0x07, 0x16, 0xdb, 0xe5, 0x01, 0x5b, 0x37, 0xcd, 0xa9, 0xfe, 0x13, 0xca, 0x99,
+	0xc1, 0xcc, 0x5c, 0xa5, 0x9c, 0x9c, 0x95, 0x32, 0xf8, 0xa6, 0x2e, 0x0a, 0xfc, 0x1d, 0xd3, 0xa4,
+	0x73, 0x39, 0xb3, 0xe8, 0x99, 0x59, 0x83, 0x99, 0xb9, 0x65, 0xca, 0xbc, 0x92, 0x49, 0xa7, 0x14,
+	0x2d, 0x2a, 0x40, 0xdc, 0xc9, 0xcf, 0x15, 0x98, 0xae, 0x16, 0x61, 0xef, 0x5a, 0x6c, 0x77, 0xdd,
+	0x2a, 0x30, 0xd3, 0x0b, 0x3c, 0x76, 0x05, 0x0e, 0xdb, 0x96, 0xa3, 0x47, 0x53, 0x07, 0x47, 0x3e,
+	0x55, 0x29, 0x27, 0x27, 0xa4, 0xd6, 0x9a, 0x6d, 0xaa, 0x1d, 0xb2, 0x2d, 0x27, 0xcc, 0x3e, 0x64,
+	0x3a, 0x5a, 0x82, 0x08, 0xe7, 0x55, 0x8b, 0x8d, 0xba, 0x42, 0x72, 0x7f, 0xcf, 0x85, 0xe4, 0xcf,
+	0x14, 0x98, 0x89, 0xb7, 0xe1, 0x2b, 0x52, 0x52, 0x6a, 0x70, 0xa4, 0xfe, 0x3c, 0x22, 0xb2, 0x25,
+	0x00, 0xbf, 0xe8, 0x32, 0xbd, 0xc8, 0x57, 0xd1, 0xb7, 0x93, 0xd5, 0xab, 0x54, 0xdd, 0xa3, 0xda,
+	0x90, 0x1f, 0x70, 0x8b, 0xc0, 0xfd, 0xb0, 0x0f, 0x8e, 0x4b, 0xa1, 0x0f, 0x8c, 0xe2, 0xda, 0x43,
+	0x23, 0x8b, 0x05, 0xc8, 0x86, 0x13, 0x84, 0xee, 0x55, 0x18, 0xf0, 0x4d, 0x27, 0x67, 0x7a, 0x28,
+	0x77, 0xbc, 0x52, 0x4e, 0x1e, 0x46, 0xb9, 0x62, 0x9d, 0x6a, 0x48, 0x10, 0xbd, 0x17, 0x7d, 0x6d,
+	0xef, 0x45, 0x0a, 0x64, 0x4e, 0xd1, 0x2d, 0x19, 0xb4, 0xa1, 0xcc, 0x4b, 0x95, 0x72, 0x72, 0x34,
+	0x72, 0xf9, 0x75, 0xcb, 0xa1, 0xda, 0x41, 0xf1, 0x73, 0xc3, 0x21, 0xdf, 0x85, 0x01, 0xd1, 0x70,
+	0xfa, 0x53, 0xfd, 0xc2, 0xfd, 0xa9, 0xb0, 0x27, 0x8a, 0x34, 0xa8, 0xa1, 0x13, 0xb9, 0x39, 0xa1,
+	0x25, 0x9c, 0x2d, 0x33, 0x89, 0xe9, 0x05, 0xb1, 0x4b, 0x59, 0x54, 0x43, 0xa1, 0xc2, 0x19, 0x1f,
+	0x05, 0x65, 0x6b, 0x8c, 0x33, 0xaa, 0xb5, 0x9f, 0xc4, 0xd6, 0x73, 0xed, 0x57, 0xcf, 0x4e, 0xb5,
+	0x11, 0xb1, 0x14, 0xd6, 0x7e, 0x02, 0xca, 0xe3, 0xbe, 0x78, 0x28, 0xb7, 0x4a, 0xec, 0x45, 0x07,
+	0xe6, 0x7b, 0xa1, 0xa3, 0xf7, 0x0b, 0x47, 0xa7, 0x3b, 0x74, 0x34, 0x87, 0xd6, 0x81, 0xa7, 0x79,
+	0x3f, 0x11, 0xfa, 0x60, 0xaa, 0xbf, 0xbe, 0x9f, 0x08, 0xb7, 0x28, 0xbe, 0x39, 0xb7, 0x4a, 0xd2,
+	0x23, 0x3f, 0x08, 0xaa, 0x93, 0x38, 0x8f, 0x60, 0x74, 0x74, 0x18, 0x0d, 0x4e, 0x4e, 0x6d, 0x70,
+	0x2e, 0xb5, 0x0b, 0xce, 0x91, 0xda, 0x73, 0x17, 0xc6, 0xe6, 0x30, 0x1e, 0xbf, 0x48, 0x68, 0x66,
+	0x40, 0xad, 0xd6, 0x0d, 0xf5, 0x55, 0x17, 0xfd, 0x69, 0x90, 0x09, 0xeb, 0xb7, 0xbf, 0x12, 0x05,
+	0x14, 0xcd, 0xc3, 0x59, 0xf9, 0x78, 0xbb, 0x4e, 0xd6, 0x74, 0x98, 0xc7, 0xf3, 0xba, 0xc8, 0x56,
+	0xb9, 0x4d, 0xcb, 0xb9, 0xc7, 0x6b, 0xec, 0xd5, 0xf5, 0xad, 0xad, 0xe0, 0x88, 0xbd, 0x0e, 0x87,
+	0xb2, 0x3b, 0xb6, 0xad, 0x07, 0x87, 0x47, 0xbe, 0x76, 0x47, 0xab, 0x75, 0x4e, 0x74, 0x97, 0x6a,
+	0xc0, 0x3f, 0xa5, 0x34, 0xaa, 0xc3, 0xb9, 0x8e, 0x14, 0xa1, 0x5b, 0xce, 0xc3, 0x44, 0x36, 0x42,
+	0x59, 0xab, 0x51, 0x23, 0xd9, 0x06, 0x29, 0xf4, 0x74, 0x50, 0x86, 0xac, 0x6f, 0x6d, 0xd5, 0x2b,
+	0xe1, 0x2a, 0xc2, 0xf1, 0xca, 0x23, 0x98, 0x6d, 0x47, 0x88, 0x20, 0xee, 0xc0, 0xb8, 0x6d, 0xe5,
+	0x3d, 0x91, 0x6d, 0x75, 0xcf, 0xcc, 0xba, 0x5e, 0x2e, 0x28, 0xfd, 0x66, 0xe3, 0x27, 0x30, 0x5b,
+	0x01, 0xb9, 0x26, 0xa9, 0xb5, 0x31, 0xbb, 0x6e, 0x85, 0x6e, 0x06, 0x09, 0x36, 0x6b, 0x14, 0x2c,
+	0x27, 0xbf, 0x6e, 0x64, 0x99, 0xeb, 0x69, 0x86, 0x5d, 0xec, 0xa9, 0x68, 0xfd, 0x4d, 0x98, 0xa2,
+	0x1a, 0xc5, 0xa1, 0x15, 0xab, 0x30, 0xea, 0xcb, 0x4d, 0x7d, 0x47, 0xec, 0xca, 0x07, 0xab, 0x3f,
+	0xa3, 0x56, 0xcf, 0x79, 0x1d, 0x01, 0xef, 0x3f, 0xa3, 0xf2, 0x7c, 0xb2, 0x09, 0xfd, 0x9e, 0x61,
+	0x17, 0xc3, 0xe7, 0x2a, 0xd6, 0xfa, 0x06, 0x0c, 0x99, 0xd1, 0x4a, 0x39, 0x39, 0x8c, 0xd7, 0xde,
+	0xb0, 0x8b, 0x54, 0x13, 0x52, 0xe8, 0x56, 0x00, 0xda, 0x76, 0x5d, 0xb6, 0xfb, 0xae, 0x69, 0xe5,
+	0x77, 0xd9, 0xea, 0xae, 0xe1, 0xe4, 0x7b, 0x1b, 0x55, 0x3c, 0x82, 0x61, 0x51, 0xca, 0x48, 0x41,
+	0x64, 0x16, 0x0e, 0xc8, 0x72, 0x48, 0xde, 0xf5, 0xb1, 0x4a, 0x39, 0x79, 0x48, 0x72, 0x62, 0x09,
+	0x24, 0xb7, 0xc9, 0x3a, 0x0c, 0x3c, 0x10, 0x1c, 0x58, 0x7f, 0xa5, 0xda, 0x25, 0x05, 0x4c, 0x60,
+	0x92, 0x89, 0x6a, 0xc8, 0x4d, 0xff, 0xb1, 0x1f, 0x92, 0x4d, 0xcd, 0x09, 0x5b, 0xd3, 0xd1, 0x6c,
+	0xc9, 0xf3, 0x4c, 0x87, 0xe9, 0x92, 0x2b, 0xa8, 0x1a, 0x4e, 0xc6, 0xbb, 0x32, 0x62, 0x4f, 0x26,
+	0x81, 0x97, 0x1e, 0x63, 0x55, 0x27, 0x87, 0x6a, 0x23, 0xb8, 0x22, 0xc9, 0x7d, 0x92, 0x87, 0x11,
+	0x66, 0x78, 0x79, 0xb3, 0xaa, 0xaa, 0xaf, 0x53, 0x55, 0xc7, 0x51, 0xd5, 0xa4, 0x54, 0x55, 0x2b,
+	0x86, 0x67, 0x3f, 0xb1, 0x10, 0x28, 0xba, 0x04, 0xc3, 0x96, 0xc3, 0xa7, 0xab, 0x79, 0xcf, 0xf4,
+	0x7d, 0xf1, 0x6a, 0x0f, 0x66, 0x8e, 0x54, 0xca, 0x49, 0x22, 0xd9, 0x23, 0x9b, 0x54, 0x03, 0xcb,
+	0xb9, 0x8d, 0x1f, 0xe4, 0x9b, 0x00, 0x3e, 0x33, 0x3c, 0xa6, 0x33, 0xcb, 0x96, 0x15, 0xe7, 0xf0,
+	0xa2, 0xda, 0x50, 0x3e, 0xdd, 0x0d, 0x06, 0xa5, 0x21, 0xac, 0xa0, 0x7e, 0x09, 0x79, 0xe9, 0x27,
+	0x5f, 0x24, 0x15, 0x6d, 0x48, 0x2c, 0x70, 0x72, 0xa2, 0xc1, 0xa0, 0xe9, 0xe4, 0xa4, 0xdc, 0x03,
+	0x6d, 0xe5, 0x06, 0x5d, 0x1a, 0x56, 0x19, 0x01, 0xa7, 0x94, 0x7a, 0xd0, 0x74, 0x72, 0x9c, 0x74,
+	0xf1, 0xf1, 0x0c, 0x1c, 0x10, 0xf1, 0x25, 0x1f, 0x82, 0x28, 0xe5, 0x7c, 0xd2, 0xe4, 0x02, 0x34,
+	0x4c, 0x35, 0xd5, 0x33, 0xed, 0x09, 0xe5, 0x09, 0xa1, 0x2f, 0x7f, 0xff, 0x2f, 0xff, 0xfa, 0x51,
+	0xdf, 0x71, 0x32, 0x9d, 0x8e, 0x9f, 0x3b, 0x0b, 0xbd, 0x8f, 0x15, 0x18, 0x0c, 0xa6, 0x84, 0xe4,
+	0x6c, 0x0b, 0xd9, 0x75, 0x63, 0x46, 0xf5, 0x5c, 0x47, 0xb4, 0x08, 0xe5, 0xac, 0x80, 0x72, 0x92,
+	0x24, 0xe3, 0xa1, 0x84, 0x73, 0xc7, 0x8f, 0xfa, 0x14, 0xf2, 0x99, 0x02, 0x23, 0xb5, 0x4f, 0x1b,
+	0x39, 0xdf, 0x42, 0x57, 0xec, 0x23, 0xa9, 0x2e, 0x74, 0xc1, 0x81, 0x18, 0xe7, 0x05, 0xc6, 0xd3,
+	0xe4, 0x95, 0x78, 0x8c, 0xb2, 0xe1, 0x0e, 0xdf, 0x39, 0xf2, 0x0b, 0x05, 0x46, 0xeb, 0xea, 0x78,
+	0xb2, 0xd0, 0x2e, 0x36, 0x0d, 0x7d, 0x8b, 0xba, 0xd8, 0x0d, 0x0b, 0x22, 0x9d, 0x13, 0x48, 0x67,
+	0xc9, 0xa9, 0x78, 0xa4, 0x3b, 0x82, 0x1a, 0x9f, 0x38, 0x9f, 0x7c, 0xac, 0x40, 0x3f, 0x97, 0x44,
+	0x66, 0xdb, 0xa8, 0x0a, 0x20, 0x9d, 0x6e, 0x4b, 0x87, 0x38, 0xce, 0xb7, 0xf6, 0x98, 0x50, 0x9f,
+	0xfe, 0x00, 0x93, 0xeb, 0x23, 0x1e, 0xdb, 0x4f, 0x15, 0x18, 0x0c, 0xc6, 0xbf, 0x2d, 0x4f, 0x5b,
+	0xdd, 0xa0, 0x59, 0x3d, 0xd7, 0x11, 0x2d, 0xe2, 0x5a, 0x10, 0xb8, 0xce, 0x91, 0x57, 0x9b, 0xe3,
+	0x12, 0x8d, 0x5e, 0x15, 0x1b, 0xf9, 0x89, 0x02, 0x53, 0xcd, 0xc6, 0x0d, 0x64, 0xb9, 0x85, 0xf2,
+	0x36, 0x33, 0x16, 0xf5, 0x8d, 0x9e, 0x78, 0xd1, 0x90, 0x7d, 0xe4, 0x0f, 0x0a, 0x90, 0xc6, 0x41,
+	0x31, 0x59, 0xea, 0x50, 0x6a, 0x2d, 0x96, 0x8b, 0x5d, 0x72, 0x21, 0x8a, 0xab, 0xc2, 0x9d, 0xcb,
+	0xe4, 0xb5, 0x8e, 0xc2, 0x9c, 0x7e, 0xcf, 0xb5, 0x1c, 0xd9, 0xdd, 0x9b, 0xbc, 0x86, 0xd6, 0x2d,
+	0x87, 0xfc, 0x5b, 0x81, 0xe9, 0x16, 0xe3, 0x56, 0x72, 0xa5, 0x0d, 0xb0, 0xd6, 0x23, 0x63, 0xf5,
+	0xcd, 0x5e, 0xd9, 0xd1, 0xc0, 0x6b, 0xc2, 0xc0, 0x15, 0xf2, 0x56, 0x67, 0x06, 0x9a, 0x0f, 0x2d,
+	0x26, 0x0d, 0x94, 0xf3, 0x68, 0x59, 0xc9, 0x73, 0x3b, 0x7f, 0xae, 0x00, 0x54, 0xe7, 0xae, 0x64,
+	0xae, 0xcd, 0xa1, 0xad, 0x99, 0xf2, 0xaa, 0xf3, 0x1d, 0x52, 0x23, 0xe8, 0x25, 0x01, 0x3a, 0x45,
+	0xe6, 0x3a, 0x03, 0x2d, 0x87, 0xba, 0xe4, 0x89, 0x02, 0xa4, 0x71, 0xf8, 0xda, 0xf2, 0x3c, 0x35,
+	0x9d, 0xff, 0xaa, 0x17, 0xbb, 0xe4, 0x42, 0xe4, 0x6b, 0x02, 0xf9, 0x65, 0xb2, 0xdc, 0x19, 0x72,
+	0x99, 0x78, 0xc5, 0x67, 0x98, 0x7d, 0x79, 0x2e, 0xf9, 0xa5, 0x02, 0xc3, 0x91, 0xc9, 0x2a, 0x99,
+	0x6f, 0x87, 0xa6, 0xf6, 0xd0, 0xa4, 0x3a, 0x25, 0x47, 0xd4, 0xcb, 0x02, 0xf5, 0x12, 0x59, 0xec,
+	0x06, 0xb5, 0x9c, 0xf5, 0xf1, 0x73, 0x31, 0x14, 0xce, 0x54, 0x48, 0xab, 0x5c, 0x56, 0x3f, 0x09,
+	0x54, 0xe7, 0x3a, 0x23, 0x46, 0x90, 0x97, 0xba, 0x3c, 0x14, 0x9c, 0x59, 0x3c, 0xba, 0x4f, 0x15,
+	0x38, 0xb6, 0xe6, 0x33, 0xcb, 0x36, 0x98, 0xd9, 0x30, 0x9b, 0x20, 0x17, 0x5a, 0x81, 0x68, 0x32,
+	0xd6, 0x51, 0x97, 0xba, 0x63, 0x42, 0x0b, 0xae, 0x0b, 0x0b, 0xde, 0x22, 0x57, 0xe2, 0x2d, 0x88,
+	0xdc, 0x42, 0x44, 0x9b, 0x8e, 0xa4, 0x9a, 0xf0, 0x26, 0x72, 0x93, 0xfe, 0xaa, 0x80, 0xda, 0xc4,
+	0x24, 0x3e, 0xba, 0xed, 0x02, 0x5e, 0x75, 0x24, 0xa2, 0x5e, 0xec, 0x92, 0x0b, 0xad, 0xda, 0x10,
+	0x56, 0x5d, 0x25, 0x6f, 0x7e, 0x09, 0xab, 0xdc, 0x12, 0xe3, 0x66, 0xfd, 0x4f, 0x81, 0x44, 0xeb,
+	0x96, 0x97, 0x5c, 0x6d, 0x95, 0x0f, 0x3b, 0x69, 0xcb, 0xd5, 0x95, 0x2f, 0x21, 0x01, 0x4d, 0xbe,
+	0x2d, 0x4c, 0xbe, 0x41, 0xae, 0xc7, 0x9b, 0x1c, 0xd7, 0x8b, 0xeb, 0x05, 0xcb, 0xb9, 0xa7, 0xef,
+	0x78, 0xae, 0xad, 0xf3, 0x3e, 0x3f, 0xfd, 0x41, 0xb4, 0xf9, 0x7f, 0x44, 0xfe, 0xac, 0xc0, 0xb1,
+	0xa6, 0x2d, 0x36, 0x69, 0xf9, 0xd0, 0xb6, 0xe9, 0xe0, 0xd5, 0xcb, 0xbd, 0x31, 0x77, 0x96, 0x1a,
+	0x84, 0x15, 0x8d, 0xf6, 0x16, 0x04, 0xec, 0x3d, 0x18, 0xc0, 0xd7, 0xe2, 0xe5, 0x56, 0xff, 0x82,
+	0x11, 0x00, 0x3d, 0xd5, 0x9a, 0x08, 0x01, 0x9d, 0x12, 0x80, 0x12, 0x64, 0x26, 0xdd, 0xe2, 0x3f,
+	0x4e, 0xc8, 0xe7, 0x0a, 0x8c, 0x37, 0x34, 0xd8, 0xad, 0xef, 0x7a, 0x93, 0x09, 0x83, 0xba, 0xd4,
+	0x1d, 0x13, 0xc2, 0x5c, 0x11, 0x30, 0xdf, 0x20, 0xaf, 0x77, 0x96, 0xad, 0x6a, 0x47, 0x0a, 0x3a,
+	0xef, 0xfb, 0xc9, 0x1f, 0x15, 0x20, 0x8d, 0x4d, 0x72, 0xeb, 0xcb, 0xdd, 0x6c, 0x44, 0xa0, 0x5e,
+	0xec, 0x92, 0x0b, 0xcd, 0xc8, 0x74, 0xf7, 0x9e, 0xf9, 0x42, 0x12, 0xb6, 0xc0, 0x7a, 0x56, 0xc8,
+	0xca, 0xdc, 0x78, 0xf2, 0x2c, 0xa1, 0x3c, 0x7d, 0x96, 0x50, 0xfe, 0xf9, 0x2c, 0xa1, 0x7c, 0xf2,
+	0x3c, 0xb1, 0xef, 0xe9, 0xf3, 0xc4, 0xbe, 0xbf, 0x3d, 0x4f, 0xec, 0xfb, 0xf6, 0xf9, 0xc8, 0x10,
+	0x0e, 0xe5, 0xcf, 0x17, 0x8c, 0x6d, 0x3f, 0x54, 0xf6, 0xfe, 0xe2, 0x37, 0xd2, 0x0f, 0xa5, 0x4a,
+	0x31, 0x92, 0xdb, 0x1e, 0x10, 0x7d, 0xe9, 0x85, 0xff, 0x0f, 0x00, 0xb4, 0x2d, 0xc6, 0x04, 0xcd,
+	0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1993,6 +2300,14 @@ type QueryClient interface {
 	CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCFMMConcentratedPoolLinksRequest, opts ...grpc.CallOption) (*QueryCFMMConcentratedPoolLinksResponse, error)
 	// Params returns gamm module params.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// ScalingFactorRamp returns the current effective scaling factors of a
+	// stableswap pool along with its scaling factor ramp, if one is in
+	// progress.
+	ScalingFactorRamp(ctx context.Context, in *QueryScalingFactorRampRequest, opts ...grpc.CallOption) (*QueryScalingFactorRampResponse, error)
+	// SmoothWeightChange returns the current weights of a balancer pool,
+	// interpolated to the block time, along with the weights and time its
+	// smooth weight change completes at, if one is in progress.
+	SmoothWeightChange(ctx context.Context, in *QuerySmoothWeightChangeRequest, opts ...grpc.CallOption) (*QuerySmoothWeightChangeResponse, error)
 }
 
 type queryClient struct {
@@ -2171,6 +2486,24 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) ScalingFactorRamp(ctx context.Context, in *QueryScalingFactorRampRequest, opts ...grpc.CallOption) (*QueryScalingFactorRampResponse, error) {
+	out := new(QueryScalingFactorRampResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ScalingFactorRamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SmoothWeightChange(ctx context.Context, in *QuerySmoothWeightChangeRequest, opts ...grpc.CallOption) (*QuerySmoothWeightChangeResponse, error) {
+	out := new(QuerySmoothWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SmoothWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
@@ -2210,6 +2543,14 @@ type QueryServer interface {
 	CFMMConcentratedPoolLinks(context.Context, *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error)
 	// Params returns gamm module params.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// ScalingFactorRamp returns the current effective scaling factors of a
+	// stableswap pool along with its scaling factor ramp, if one is in
+	// progress.
+	ScalingFactorRamp(context.Context, *QueryScalingFactorRampRequest) (*QueryScalingFactorRampResponse, error)
+	// SmoothWeightChange returns the current weights of a balancer pool,
+	// interpolated to the block time, along with the weights and time its
+	// smooth weight change completes at, if one is in progress.
+	SmoothWeightChange(context.Context, *QuerySmoothWeightChangeRequest) (*QuerySmoothWeightChangeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2270,6 +2611,12 @@ func (*UnimplementedQueryServer) CFMMConcentratedPoolLinks(ctx context.Context,
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ScalingFactorRamp(ctx context.Context, req *QueryScalingFactorRampRequest) (*QueryScalingFactorRampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScalingFactorRamp not implemented")
+}
+func (*UnimplementedQueryServer) SmoothWeightChange(ctx context.Context, req *QuerySmoothWeightChangeRequest) (*QuerySmoothWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SmoothWeightChange not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2599,6 +2946,43 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScalingFactorRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScalingFactorRampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScalingFactorRamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/ScalingFactorRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScalingFactorRamp(ctx, req.(*QueryScalingFactorRampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SmoothWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySmoothWeightChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SmoothWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/SmoothWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SmoothWeightChange(ctx, req.(*QuerySmoothWeightChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2675,6 +3059,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ScalingFactorRamp",
+			Handler:    _Query_ScalingFactorRamp_Handler,
+		},
+		{
+			MethodName: "SmoothWeightChange",
+			Handler:    _Query_SmoothWeightChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/query.proto",
@@ -3966,57 +4358,283 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScalingFactorRampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryScalingFactorRampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScalingFactorRampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScalingFactorRampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
+	return dAtA[:n], nil
+}
+
+func (m *QueryScalingFactorRampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScalingFactorRampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ramp != nil {
+		{
+			size, err := m.Ramp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScalingFactors) > 0 {
+		dAtA12 := make([]byte, len(m.ScalingFactors)*10)
+		var j11 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintQuery(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySmoothWeightChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySmoothWeightChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySmoothWeightChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySmoothWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySmoothWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySmoothWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	if m.InProgress {
+		i--
+		if m.InProgress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TargetWeights) > 0 {
+		for iNdEx := len(m.TargetWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CurrentWeights) > 0 {
+		for iNdEx := len(m.CurrentWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -4494,6 +5112,93 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScalingFactorRampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryScalingFactorRampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Ramp != nil {
+		l = m.Ramp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySmoothWeightChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *DenomWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySmoothWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentWeights) > 0 {
+		for _, e := range m.CurrentWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TargetWeights) > 0 {
+		for _, e := range m.TargetWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.InProgress {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7768,6 +8473,626 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryScalingFactorRampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScalingFactorRampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScalingFactorRampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScalingFactorRampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScalingFactorRampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScalingFactorRampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ramp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ramp == nil {
+				m.Ramp = &ScalingFactorRamp{}
+			}
+			if err := m.Ramp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySmoothWeightChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySmoothWeightChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySmoothWeightChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySmoothWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySmoothWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySmoothWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentWeights = append(m.CurrentWeights, DenomWeight{})
+			if err := m.CurrentWeights[len(m.CurrentWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetWeights = append(m.TargetWeights, DenomWeight{})
+			if err := m.TargetWeights[len(m.TargetWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InProgress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InProgress = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// ScalingFactorsAt returns the scaling factors of the ramp at the given time, linearly interpolated
+// between its start and target scaling factors. Returns the start scaling factors before the ramp's
+// start time and the target scaling factors from its end time on.
+func (r ScalingFactorRamp) ScalingFactorsAt(t time.Time) []uint64 {
+	if !t.After(r.StartTime) {
+		return r.StartScalingFactors
+	}
+	if !t.Before(r.EndTime) {
+		return r.TargetScalingFactors
+	}
+
+	elapsed := osmomath.NewInt(t.Sub(r.StartTime).Nanoseconds())
+	duration := osmomath.NewInt(r.EndTime.Sub(r.StartTime).Nanoseconds())
+	scalingFactors := make([]uint64, len(r.TargetScalingFactors))
+	for i, target := range r.TargetScalingFactors {
+		start := osmomath.NewIntFromUint64(r.StartScalingFactors[i])
+		// start + (target - start) * elapsed / duration, computed in Int to avoid overflowing uint64.
+		delta := osmomath.NewIntFromUint64(target).Sub(start).Mul(elapsed).Quo(duration)
+		scalingFactors[i] = start.Add(delta).Uint64()
+	}
+	return scalingFactors
+}
+
+// Validate performs basic validation of a scaling factor ramp.
+func (r ScalingFactorRamp) Validate() error {
+	if r.PoolId == 0 {
+		return fmt.Errorf("invalid pool id for scaling factor ramp: %d", r.PoolId)
+	}
+	if len(r.TargetScalingFactors) == 0 || len(r.StartScalingFactors) != len(r.TargetScalingFactors) {
+		return errorsmod.Wrapf(ErrInvalidScalingFactorRamp, "scaling factor ramp of pool %d has %d start and %d target scaling factors",
+			r.PoolId, len(r.StartScalingFactors), len(r.TargetScalingFactors))
+	}
+	for i := range r.TargetScalingFactors {
+		if r.StartScalingFactors[i] == 0 || r.TargetScalingFactors[i] == 0 {
+			return errorsmod.Wrapf(ErrInvalidScalingFactorRamp, "scaling factor ramp of pool %d has a zero scaling factor", r.PoolId)
+		}
+	}
+	if !r.EndTime.After(r.StartTime) {
+		return errorsmod.Wrapf(ErrInvalidScalingFactorRamp, "scaling factor ramp of pool %d must end after it starts", r.PoolId)
+	}
+	return nil
+}
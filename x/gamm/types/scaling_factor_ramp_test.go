@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+func TestScalingFactorRampScalingFactorsAt(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	ramp := types.ScalingFactorRamp{
+		PoolId:               1,
+		StartScalingFactors:  []uint64{100, 200},
+		TargetScalingFactors: []uint64{200, 100},
+		StartTime:            startTime,
+		EndTime:              startTime.Add(100 * time.Second),
+	}
+
+	tests := map[string]struct {
+		time                   time.Time
+		expectedScalingFactors []uint64
+	}{
+		"before start": {
+			time:                   startTime.Add(-time.Second),
+			expectedScalingFactors: []uint64{100, 200},
+		},
+		"at start": {
+			time:                   startTime,
+			expectedScalingFactors: []uint64{100, 200},
+		},
+		"a quarter of the way": {
+			time:                   startTime.Add(25 * time.Second),
+			expectedScalingFactors: []uint64{125, 175},
+		},
+		"rounds toward the start": {
+			time:                   startTime.Add(25*time.Second + 500*time.Millisecond),
+			expectedScalingFactors: []uint64{125, 175},
+		},
+		"at end": {
+			time:                   startTime.Add(100 * time.Second),
+			expectedScalingFactors: []uint64{200, 100},
+		},
+		"after end": {
+			time:                   startTime.Add(time.Hour),
+			expectedScalingFactors: []uint64{200, 100},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedScalingFactors, ramp.ScalingFactorsAt(tc.time))
+		})
+	}
+}
+
+func TestScalingFactorRampValidate(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	validRamp := func() types.ScalingFactorRamp {
+		return types.ScalingFactorRamp{
+			PoolId:               1,
+			StartScalingFactors:  []uint64{100, 200},
+			TargetScalingFactors: []uint64{200, 100},
+			StartTime:            startTime,
+			EndTime:              startTime.Add(time.Hour),
+		}
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.ScalingFactorRamp)
+		expectErr bool
+	}{
+		"valid":           {modify: func(*types.ScalingFactorRamp) {}},
+		"zero pool id":    {modify: func(r *types.ScalingFactorRamp) { r.PoolId = 0 }, expectErr: true},
+		"length mismatch": {modify: func(r *types.ScalingFactorRamp) { r.TargetScalingFactors = []uint64{1} }, expectErr: true},
+		"no scaling factors": {
+			modify: func(r *types.ScalingFactorRamp) {
+				r.StartScalingFactors, r.TargetScalingFactors = nil, nil
+			},
+			expectErr: true,
+		},
+		"zero scaling factor": {modify: func(r *types.ScalingFactorRamp) { r.TargetScalingFactors[0] = 0 }, expectErr: true},
+		"ends at start":       {modify: func(r *types.ScalingFactorRamp) { r.EndTime = r.StartTime }, expectErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ramp := validRamp()
+			tc.modify(&ramp)
+			err := ramp.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
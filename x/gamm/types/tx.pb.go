@@ -13,19 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -141,6 +145,130 @@ func (m *MsgJoinPoolResponse) GetTokenIn() []types.Coin {
 	return nil
 }
 
+// ===================== MsgJoinPoolAndLock
+type MsgJoinPoolAndLock struct {
+	Sender         string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId         uint64                `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	ShareOutAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"share_out_amount" yaml:"pool_amount_out"`
+	TokenInMaxs    []types.Coin          `protobuf:"bytes,4,rep,name=token_in_maxs,json=tokenInMaxs,proto3" json:"token_in_maxs" yaml:"token_in_max_amounts"`
+	// duration is the duration the received shares are locked for.
+	Duration time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+}
+
+func (m *MsgJoinPoolAndLock) Reset()         { *m = MsgJoinPoolAndLock{} }
+func (m *MsgJoinPoolAndLock) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinPoolAndLock) ProtoMessage()    {}
+func (*MsgJoinPoolAndLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{2}
+}
+func (m *MsgJoinPoolAndLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinPoolAndLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinPoolAndLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinPoolAndLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinPoolAndLock.Merge(m, src)
+}
+func (m *MsgJoinPoolAndLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinPoolAndLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinPoolAndLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinPoolAndLock proto.InternalMessageInfo
+
+func (m *MsgJoinPoolAndLock) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgJoinPoolAndLock) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgJoinPoolAndLock) GetTokenInMaxs() []types.Coin {
+	if m != nil {
+		return m.TokenInMaxs
+	}
+	return nil
+}
+
+func (m *MsgJoinPoolAndLock) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgJoinPoolAndLockResponse struct {
+	ShareOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"share_out_amount" yaml:"share_out_amount"`
+	TokenIn        []types.Coin          `protobuf:"bytes,2,rep,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	LockId         uint64                `protobuf:"varint,3,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+}
+
+func (m *MsgJoinPoolAndLockResponse) Reset()         { *m = MsgJoinPoolAndLockResponse{} }
+func (m *MsgJoinPoolAndLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinPoolAndLockResponse) ProtoMessage()    {}
+func (*MsgJoinPoolAndLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{3}
+}
+func (m *MsgJoinPoolAndLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinPoolAndLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinPoolAndLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinPoolAndLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinPoolAndLockResponse.Merge(m, src)
+}
+func (m *MsgJoinPoolAndLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinPoolAndLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinPoolAndLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinPoolAndLockResponse proto.InternalMessageInfo
+
+func (m *MsgJoinPoolAndLockResponse) GetTokenIn() []types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return nil
+}
+
+func (m *MsgJoinPoolAndLockResponse) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
 // ===================== MsgExitPool
 type MsgExitPool struct {
 	Sender        string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -153,7 +281,7 @@ func (m *MsgExitPool) Reset()         { *m = MsgExitPool{} }
 func (m *MsgExitPool) String() string { return proto.CompactTextString(m) }
 func (*MsgExitPool) ProtoMessage()    {}
 func (*MsgExitPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{2}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{4}
 }
 func (m *MsgExitPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,7 +339,7 @@ func (m *MsgExitPoolResponse) Reset()         { *m = MsgExitPoolResponse{} }
 func (m *MsgExitPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitPoolResponse) ProtoMessage()    {}
 func (*MsgExitPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{3}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{5}
 }
 func (m *MsgExitPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +387,7 @@ func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
 func (m *MsgSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountIn) ProtoMessage()    {}
 func (*MsgSwapExactAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{4}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{6}
 }
 func (m *MsgSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +445,7 @@ func (m *MsgSwapExactAmountInResponse) Reset()         { *m = MsgSwapExactAmount
 func (m *MsgSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountInResponse) ProtoMessage()    {}
 func (*MsgSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{5}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{7}
 }
 func (m *MsgSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +485,7 @@ func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
 func (m *MsgSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOut) ProtoMessage()    {}
 func (*MsgSwapExactAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{6}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{8}
 }
 func (m *MsgSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +543,7 @@ func (m *MsgSwapExactAmountOutResponse) Reset()         { *m = MsgSwapExactAmoun
 func (m *MsgSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOutResponse) ProtoMessage()    {}
 func (*MsgSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{7}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{9}
 }
 func (m *MsgSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,7 +585,7 @@ func (m *MsgJoinSwapExternAmountIn) Reset()         { *m = MsgJoinSwapExternAmou
 func (m *MsgJoinSwapExternAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountIn) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{8}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
 }
 func (m *MsgJoinSwapExternAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +643,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Reset()         { *m = MsgJoinSwapEx
 func (m *MsgJoinSwapExternAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountInResponse) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{9}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
 }
 func (m *MsgJoinSwapExternAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,7 +685,7 @@ func (m *MsgJoinSwapShareAmountOut) Reset()         { *m = MsgJoinSwapShareAmoun
 func (m *MsgJoinSwapShareAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOut) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
 }
 func (m *MsgJoinSwapShareAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +743,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Reset()         { *m = MsgJoinSwapSh
 func (m *MsgJoinSwapShareAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOutResponse) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
 }
 func (m *MsgJoinSwapShareAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -657,7 +785,7 @@ func (m *MsgExitSwapShareAmountIn) Reset()         { *m = MsgExitSwapShareAmount
 func (m *MsgExitSwapShareAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountIn) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
 }
 func (m *MsgExitSwapShareAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,7 +843,7 @@ func (m *MsgExitSwapShareAmountInResponse) Reset()         { *m = MsgExitSwapSha
 func (m *MsgExitSwapShareAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountInResponse) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
 }
 func (m *MsgExitSwapShareAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,6 +872,241 @@ func (m *MsgExitSwapShareAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapShareAmountInResponse proto.InternalMessageInfo
 
+// ===================== MsgExitSwapShareAmountInMaxPriceImpact
+type MsgExitSwapShareAmountInMaxPriceImpact struct {
+	Sender            string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId            uint64                `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenOutDenom     string                `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	ShareInAmount     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"share_in_amount" yaml:"share_in_amount"`
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// max_price_impact is the maximum relative decrease, in (0, 1), of the spot
+	// price of any denom swapped into token_out_denom by the exit.
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
+}
+
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) Reset() {
+	*m = MsgExitSwapShareAmountInMaxPriceImpact{}
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) String() string { return proto.CompactTextString(m) }
+func (*MsgExitSwapShareAmountInMaxPriceImpact) ProtoMessage()    {}
+func (*MsgExitSwapShareAmountInMaxPriceImpact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitSwapShareAmountInMaxPriceImpact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitSwapShareAmountInMaxPriceImpact.Merge(m, src)
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitSwapShareAmountInMaxPriceImpact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitSwapShareAmountInMaxPriceImpact proto.InternalMessageInfo
+
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type MsgExitSwapShareAmountInMaxPriceImpactResponse struct {
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// price_impact is the price impact of the exit.
+	PriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact" yaml:"price_impact"`
+}
+
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) Reset() {
+	*m = MsgExitSwapShareAmountInMaxPriceImpactResponse{}
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgExitSwapShareAmountInMaxPriceImpactResponse) ProtoMessage() {}
+func (*MsgExitSwapShareAmountInMaxPriceImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitSwapShareAmountInMaxPriceImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitSwapShareAmountInMaxPriceImpactResponse.Merge(m, src)
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitSwapShareAmountInMaxPriceImpactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitSwapShareAmountInMaxPriceImpactResponse proto.InternalMessageInfo
+
+// ===================== MsgExitSwapShareAmountInToDenoms
+type MsgExitSwapShareAmountInToDenoms struct {
+	Sender        string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId        uint64                `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	ShareInAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"share_in_amount" yaml:"share_in_amount"`
+	// token_out_denoms are the denoms of the pool to exit to. The exited amounts
+	// of the other denoms of the pool are split evenly and swapped into them.
+	TokenOutDenoms []string `protobuf:"bytes,4,rep,name=token_out_denoms,json=tokenOutDenoms,proto3" json:"token_out_denoms,omitempty" yaml:"token_out_denoms"`
+	// token_out_mins are the minimum amounts of token_out_denoms to get out.
+	TokenOutMins []types.Coin `protobuf:"bytes,5,rep,name=token_out_mins,json=tokenOutMins,proto3" json:"token_out_mins" yaml:"token_out_min_amounts"`
+	// max_price_impact is the maximum relative decrease, in (0, 1), of the spot
+	// price of any denom swapped into any of token_out_denoms by the exit.
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
+}
+
+func (m *MsgExitSwapShareAmountInToDenoms) Reset()         { *m = MsgExitSwapShareAmountInToDenoms{} }
+func (m *MsgExitSwapShareAmountInToDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgExitSwapShareAmountInToDenoms) ProtoMessage()    {}
+func (*MsgExitSwapShareAmountInToDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
+}
+func (m *MsgExitSwapShareAmountInToDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitSwapShareAmountInToDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitSwapShareAmountInToDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExitSwapShareAmountInToDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitSwapShareAmountInToDenoms.Merge(m, src)
+}
+func (m *MsgExitSwapShareAmountInToDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitSwapShareAmountInToDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitSwapShareAmountInToDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitSwapShareAmountInToDenoms proto.InternalMessageInfo
+
+func (m *MsgExitSwapShareAmountInToDenoms) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgExitSwapShareAmountInToDenoms) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgExitSwapShareAmountInToDenoms) GetTokenOutDenoms() []string {
+	if m != nil {
+		return m.TokenOutDenoms
+	}
+	return nil
+}
+
+func (m *MsgExitSwapShareAmountInToDenoms) GetTokenOutMins() []types.Coin {
+	if m != nil {
+		return m.TokenOutMins
+	}
+	return nil
+}
+
+type MsgExitSwapShareAmountInToDenomsResponse struct {
+	TokenOut []types.Coin `protobuf:"bytes,1,rep,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// price_impact is the price impact of the exit.
+	PriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact" yaml:"price_impact"`
+}
+
+func (m *MsgExitSwapShareAmountInToDenomsResponse) Reset() {
+	*m = MsgExitSwapShareAmountInToDenomsResponse{}
+}
+func (m *MsgExitSwapShareAmountInToDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExitSwapShareAmountInToDenomsResponse) ProtoMessage()    {}
+func (*MsgExitSwapShareAmountInToDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
+}
+func (m *MsgExitSwapShareAmountInToDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitSwapShareAmountInToDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitSwapShareAmountInToDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExitSwapShareAmountInToDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitSwapShareAmountInToDenomsResponse.Merge(m, src)
+}
+func (m *MsgExitSwapShareAmountInToDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitSwapShareAmountInToDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitSwapShareAmountInToDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitSwapShareAmountInToDenomsResponse proto.InternalMessageInfo
+
+func (m *MsgExitSwapShareAmountInToDenomsResponse) GetTokenOut() []types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return nil
+}
+
 // ===================== MsgExitSwapExternAmountOut
 type MsgExitSwapExternAmountOut struct {
 	Sender           string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -756,7 +1119,7 @@ func (m *MsgExitSwapExternAmountOut) Reset()         { *m = MsgExitSwapExternAmo
 func (m *MsgExitSwapExternAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOut) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{20}
 }
 func (m *MsgExitSwapExternAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,7 +1177,7 @@ func (m *MsgExitSwapExternAmountOutResponse) Reset()         { *m = MsgExitSwapE
 func (m *MsgExitSwapExternAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOutResponse) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{21}
 }
 func (m *MsgExitSwapExternAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -846,6 +1209,8 @@ var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
+	proto.RegisterType((*MsgJoinPoolAndLock)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolAndLock")
+	proto.RegisterType((*MsgJoinPoolAndLockResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolAndLockResponse")
 	proto.RegisterType((*MsgExitPool)(nil), "osmosis.gamm.v1beta1.MsgExitPool")
 	proto.RegisterType((*MsgExitPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgExitPoolResponse")
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountIn")
@@ -858,6 +1223,10 @@ func init() {
 	proto.RegisterType((*MsgJoinSwapShareAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinSwapShareAmountOutResponse")
 	proto.RegisterType((*MsgExitSwapShareAmountIn)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountIn")
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
+	proto.RegisterType((*MsgExitSwapShareAmountInMaxPriceImpact)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInMaxPriceImpact")
+	proto.RegisterType((*MsgExitSwapShareAmountInMaxPriceImpactResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInMaxPriceImpactResponse")
+	proto.RegisterType((*MsgExitSwapShareAmountInToDenoms)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInToDenoms")
+	proto.RegisterType((*MsgExitSwapShareAmountInToDenomsResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInToDenomsResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
 }
@@ -865,84 +1234,107 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x3d, 0x6f, 0xdb, 0x46,
-	0x18, 0x36, 0x2d, 0xc5, 0x71, 0xce, 0xf5, 0x17, 0xe3, 0x0f, 0x99, 0x49, 0x24, 0xfb, 0x5a, 0xa4,
-	0x8e, 0x53, 0x93, 0xb1, 0x03, 0xd8, 0x86, 0x5b, 0xa0, 0xa8, 0xda, 0x0c, 0x0a, 0x2a, 0x28, 0x60,
-	0x96, 0xa0, 0x8b, 0x40, 0xd9, 0x04, 0xcd, 0xc4, 0xbc, 0x13, 0x74, 0x47, 0x47, 0x99, 0x1a, 0xb8,
-	0x4d, 0x0b, 0x74, 0xea, 0x4f, 0xc9, 0xda, 0xa1, 0x9d, 0x3d, 0x66, 0x68, 0x81, 0xa2, 0x83, 0x50,
-	0xd8, 0x43, 0x80, 0xa2, 0x93, 0x7e, 0x41, 0x71, 0xe4, 0x91, 0x22, 0x29, 0xd2, 0x94, 0x54, 0xc9,
-	0x8b, 0x2d, 0xf1, 0xde, 0x8f, 0xe7, 0xde, 0xf7, 0xb9, 0xe7, 0x5e, 0x0a, 0xdc, 0xc1, 0xc4, 0xc2,
-	0xc4, 0x24, 0x8a, 0xa1, 0x59, 0x96, 0x72, 0xb2, 0x55, 0xd3, 0xa9, 0xb6, 0xa5, 0xd0, 0xa6, 0x5c,
-	0x6f, 0x60, 0x8a, 0xc5, 0x05, 0xbe, 0x2c, 0xb3, 0x65, 0x99, 0x2f, 0x4b, 0x0b, 0x06, 0x36, 0xb0,
-	0x63, 0xa0, 0xb0, 0x4f, 0xae, 0xad, 0x34, 0xaf, 0x59, 0x26, 0xc2, 0x8a, 0xf3, 0x97, 0x3f, 0xca,
-	0x1f, 0x38, 0xfe, 0x4a, 0x4d, 0x23, 0xba, 0x1f, 0xfc, 0x00, 0x9b, 0x88, 0xaf, 0x7f, 0xe2, 0x65,
-	0xaf, 0x63, 0x7c, 0x6c, 0x69, 0x48, 0x33, 0xf4, 0x86, 0x6f, 0x47, 0x5e, 0x6a, 0xf5, 0x6a, 0x03,
-	0xdb, 0x54, 0xe7, 0xd6, 0xcb, 0x3c, 0x9a, 0x45, 0x0c, 0xe5, 0x64, 0x8b, 0xfd, 0x73, 0x17, 0xe0,
-	0xef, 0xe3, 0x60, 0xaa, 0x4c, 0x8c, 0xc7, 0xd8, 0x44, 0x4f, 0x30, 0x3e, 0x16, 0xef, 0x81, 0x09,
-	0xa2, 0xa3, 0x43, 0xbd, 0x91, 0x13, 0x56, 0x85, 0xf5, 0x1b, 0xc5, 0xf9, 0x76, 0xab, 0x30, 0xfd,
-	0x4a, 0xb3, 0x8e, 0xf7, 0xa1, 0xfb, 0x1c, 0xaa, 0xdc, 0x40, 0xbc, 0x0f, 0xae, 0xb3, 0xdc, 0x55,
-	0xf3, 0x30, 0x37, 0xbe, 0x2a, 0xac, 0x67, 0x8b, 0x62, 0xbb, 0x55, 0x98, 0x71, 0x6d, 0xf9, 0x02,
-	0x54, 0x27, 0xd8, 0xa7, 0xd2, 0xa1, 0xa8, 0x81, 0x39, 0x72, 0xa4, 0x35, 0xf4, 0x2a, 0xb6, 0x69,
-	0x55, 0xb3, 0xb0, 0x8d, 0x68, 0x2e, 0xe3, 0x64, 0xd8, 0x3d, 0x6b, 0x15, 0xc6, 0xfe, 0x6a, 0x15,
-	0x16, 0x5d, 0x88, 0xe4, 0xf0, 0x85, 0x6c, 0x62, 0xc5, 0xd2, 0xe8, 0x91, 0x5c, 0x42, 0xb4, 0xdd,
-	0x2a, 0x2c, 0x05, 0x42, 0xba, 0x9e, 0x2c, 0x08, 0x54, 0x67, 0x9c, 0x80, 0x15, 0x9b, 0x7e, 0xe1,
-	0x3c, 0x14, 0x6b, 0x60, 0x9a, 0xe2, 0x17, 0x3a, 0xaa, 0x9a, 0xa8, 0x6a, 0x69, 0x4d, 0x92, 0xcb,
-	0xae, 0x66, 0xd6, 0xa7, 0xb6, 0x57, 0x64, 0x37, 0xb0, 0xcc, 0x2a, 0xe9, 0xf5, 0x41, 0xfe, 0x12,
-	0x9b, 0xa8, 0xf8, 0x21, 0x4b, 0xdd, 0x6e, 0x15, 0x6e, 0xb9, 0x19, 0x82, 0xde, 0x3c, 0x13, 0x81,
-	0xea, 0x94, 0xf3, 0xb8, 0x84, 0xca, 0x5a, 0x93, 0xec, 0xdf, 0x3d, 0x7d, 0xff, 0x76, 0x83, 0x17,
-	0xe0, 0xa7, 0xf7, 0x6f, 0x37, 0x96, 0x42, 0x1c, 0x78, 0x8e, 0x4d, 0xb4, 0xc9, 0x70, 0xc2, 0x33,
-	0x01, 0xdc, 0x0c, 0x94, 0x55, 0xd5, 0x49, 0x1d, 0x23, 0xa2, 0x8b, 0xb5, 0x98, 0x32, 0xb8, 0x85,
-	0xde, 0x4b, 0x2b, 0xc3, 0x32, 0xef, 0x42, 0xc4, 0xbd, 0xbb, 0x0e, 0x65, 0x30, 0xe9, 0xed, 0x24,
-	0x37, 0x9e, 0x56, 0x82, 0x65, 0x5e, 0x82, 0xd9, 0x70, 0x09, 0xa0, 0x7a, 0x9d, 0x6f, 0x1b, 0xfe,
-	0xe1, 0x32, 0xe4, 0x51, 0xd3, 0xa4, 0x23, 0x65, 0x48, 0x15, 0xcc, 0xba, 0x7b, 0x33, 0xd1, 0x60,
-	0x04, 0x89, 0x78, 0x43, 0x75, 0xda, 0x79, 0x52, 0x42, 0xbc, 0x2e, 0x3a, 0x98, 0x71, 0xb7, 0xc7,
-	0x8a, 0x67, 0x99, 0xa8, 0x07, 0x82, 0x7c, 0xc4, 0xab, 0x73, 0x3b, 0x58, 0x1d, 0xee, 0xde, 0x61,
-	0xc8, 0x07, 0xce, 0xf3, 0x8a, 0x4d, 0xcb, 0x26, 0x4a, 0xa3, 0x88, 0xde, 0x34, 0xa9, 0x4b, 0x11,
-	0xc3, 0x61, 0x88, 0x57, 0x56, 0x9f, 0x21, 0x4f, 0xc0, 0x0d, 0x3f, 0x4d, 0x4e, 0x48, 0x03, 0x98,
-	0xe3, 0x00, 0xe7, 0x22, 0x00, 0xa1, 0x3a, 0xe9, 0x81, 0x82, 0xaf, 0x33, 0x60, 0xa1, 0x4c, 0x8c,
-	0xa7, 0x2f, 0xb5, 0xfa, 0xa3, 0xa6, 0x76, 0xc0, 0x69, 0x52, 0x42, 0xfd, 0x74, 0xf2, 0x6b, 0x30,
-	0xe1, 0xc8, 0x09, 0xe1, 0x8c, 0x92, 0x65, 0x4f, 0xdd, 0x02, 0xf2, 0xe3, 0x43, 0x63, 0xa9, 0xbc,
-	0x2c, 0x2a, 0x73, 0x2b, 0x66, 0x19, 0x4e, 0x95, 0xc7, 0x08, 0x31, 0x94, 0xf5, 0xf8, 0xff, 0x31,
-	0x54, 0xb4, 0xc0, 0x42, 0x5c, 0x67, 0x72, 0x59, 0x67, 0x57, 0x9f, 0xa5, 0xd1, 0xe7, 0x56, 0x72,
-	0x73, 0xa1, 0x3a, 0x1f, 0xe8, 0xad, 0xbb, 0xa5, 0xfd, 0xad, 0x48, 0x83, 0xd7, 0x42, 0x0d, 0x66,
-	0xd2, 0xbb, 0xa9, 0xb3, 0x3a, 0x6f, 0xba, 0x31, 0x36, 0x4d, 0x04, 0x4f, 0x05, 0x70, 0x3b, 0xae,
-	0x05, 0x41, 0x5d, 0xe8, 0xe4, 0x1f, 0x48, 0x17, 0xa2, 0xee, 0x50, 0x9d, 0xf1, 0xa0, 0xbb, 0xd9,
-	0xe0, 0x77, 0x19, 0xb0, 0xd8, 0x0d, 0xa2, 0x62, 0xd3, 0x7e, 0x88, 0x50, 0x8e, 0x10, 0x41, 0xe9,
-	0x91, 0x08, 0x15, 0x9b, 0xc6, 0x31, 0xe1, 0x39, 0xb8, 0x19, 0xa3, 0xba, 0xfc, 0xe0, 0x7f, 0x9a,
-	0xb6, 0x75, 0x29, 0x51, 0xb7, 0xa1, 0x3a, 0xd7, 0x91, 0x6d, 0x7e, 0xfe, 0x43, 0x27, 0x2b, 0x9b,
-	0x46, 0xbb, 0x1e, 0x4e, 0xd6, 0xfe, 0x76, 0x84, 0x09, 0x30, 0x85, 0x09, 0xcc, 0xfd, 0xb5, 0x00,
-	0xee, 0xc4, 0x76, 0xc1, 0xe7, 0x42, 0x15, 0xcc, 0xfa, 0x3b, 0x0a, 0x51, 0xa1, 0x57, 0x21, 0x8c,
-	0x78, 0x43, 0x75, 0x9a, 0xd7, 0x82, 0x13, 0xe1, 0x9f, 0x71, 0xb0, 0xc2, 0x2f, 0x27, 0x17, 0x06,
-	0xd5, 0x1b, 0x68, 0x10, 0x55, 0xe8, 0x4b, 0xdf, 0x87, 0x7f, 0xe8, 0x3b, 0x57, 0xe1, 0xc0, 0x87,
-	0x3e, 0x2e, 0x04, 0x54, 0xe7, 0xbd, 0x1b, 0xb5, 0x73, 0xe8, 0x77, 0x23, 0xad, 0xfe, 0xb8, 0xfb,
-	0xe2, 0xe7, 0xfd, 0x66, 0xc5, 0x0c, 0x1c, 0xfd, 0x1f, 0x05, 0xb0, 0x96, 0x58, 0xec, 0xab, 0x9c,
-	0x0b, 0xe0, 0xaf, 0x99, 0x50, 0xdb, 0x9f, 0xb2, 0xd5, 0x81, 0x34, 0xa0, 0xaf, 0xb6, 0x7f, 0xee,
-	0xdd, 0xba, 0x26, 0xaa, 0x1e, 0xea, 0x08, 0x5b, 0xfc, 0x70, 0xaf, 0xb4, 0x5b, 0x85, 0xc5, 0x08,
-	0x5f, 0x9d, 0x75, 0xef, 0x3e, 0x2d, 0xa1, 0xaf, 0xd8, 0xd7, 0xd8, 0xd2, 0x64, 0x87, 0x3c, 0x32,
-	0x25, 0xc8, 0xd0, 0xb5, 0x11, 0xc8, 0x50, 0xcf, 0x4c, 0x72, 0x20, 0x06, 0x95, 0xe3, 0xfb, 0x30,
-	0x93, 0xc2, 0xfd, 0xbb, 0x3a, 0xf5, 0xf8, 0x2d, 0x03, 0x72, 0x7c, 0x70, 0x89, 0xc0, 0x18, 0xa1,
-	0x78, 0x14, 0xbd, 0x5d, 0xb1, 0x2e, 0x06, 0x69, 0x24, 0x45, 0x81, 0xfb, 0x06, 0x1e, 0xf0, 0x8a,
-	0x4d, 0x5d, 0x22, 0xc5, 0x0c, 0x98, 0xd9, 0xa1, 0x0e, 0x98, 0x49, 0x73, 0xc8, 0xb5, 0xd1, 0xcc,
-	0x21, 0x3b, 0x11, 0x22, 0xdd, 0xed, 0x1e, 0x34, 0xbb, 0x89, 0x64, 0x22, 0xf8, 0x83, 0x00, 0x56,
-	0x93, 0x1a, 0x78, 0xa5, 0x03, 0xc9, 0xbf, 0xe3, 0x40, 0x0a, 0x00, 0x09, 0x4a, 0xe3, 0x28, 0x15,
-	0x29, 0x34, 0x07, 0x64, 0x86, 0x30, 0x07, 0x30, 0xf9, 0xf0, 0xb9, 0x11, 0x90, 0x8f, 0x6c, 0x5f,
-	0xf2, 0x11, 0x13, 0x01, 0xaa, 0x73, 0x9c, 0x61, 0x1d, 0xf9, 0xd8, 0x8b, 0x74, 0x7d, 0x3d, 0xa1,
-	0xeb, 0xe1, 0x8b, 0x88, 0x01, 0x7e, 0x23, 0x00, 0x98, 0x5c, 0xee, 0xa0, 0x80, 0x44, 0x8f, 0x89,
-	0x30, 0xcc, 0x63, 0xb2, 0xfd, 0xcb, 0x75, 0x90, 0x29, 0x13, 0x43, 0x7c, 0x06, 0x26, 0xfd, 0x9f,
-	0x1d, 0xd6, 0xe4, 0xb8, 0x5f, 0x4b, 0xe4, 0xc0, 0x2b, 0xb4, 0x74, 0x2f, 0xd5, 0xc4, 0xdf, 0xc2,
-	0x33, 0x30, 0xe9, 0xbf, 0xae, 0x26, 0x47, 0xf6, 0x4c, 0x2e, 0x89, 0xdc, 0xf5, 0x76, 0x46, 0xc0,
-	0x7c, 0xf7, 0x7b, 0xd4, 0x46, 0xa2, 0x7f, 0x97, 0xad, 0xb4, 0xdd, 0xbb, 0xad, 0x9f, 0xf4, 0x04,
-	0x88, 0x31, 0x43, 0xfb, 0xfd, 0x5e, 0x23, 0x55, 0x6c, 0x2a, 0x3d, 0xec, 0xc3, 0xd8, 0xcf, 0x7b,
-	0x2a, 0x80, 0xa5, 0x84, 0x21, 0x51, 0xb9, 0xb4, 0x19, 0xdd, 0x0e, 0xd2, 0x6e, 0x9f, 0x0e, 0xb1,
-	0x20, 0x22, 0x23, 0x4b, 0x3a, 0x88, 0xb0, 0x43, 0x0f, 0x20, 0x12, 0x2e, 0xd5, 0x37, 0x02, 0x58,
-	0x4e, 0x92, 0xa9, 0x07, 0x97, 0xb2, 0x27, 0xc6, 0x43, 0xda, 0xeb, 0xd7, 0xc3, 0xc7, 0xf1, 0x2d,
-	0x58, 0x8c, 0xbf, 0x77, 0xe5, 0xd4, 0x90, 0x21, 0x7b, 0x69, 0xa7, 0x3f, 0x7b, 0x0f, 0x40, 0xf1,
-	0xf1, 0xd9, 0x79, 0x5e, 0x78, 0x77, 0x9e, 0x17, 0xfe, 0x3e, 0xcf, 0x0b, 0x3f, 0x5f, 0xe4, 0xc7,
-	0xde, 0x5d, 0xe4, 0xc7, 0xfe, 0xbc, 0xc8, 0x8f, 0x7d, 0xf3, 0xc0, 0x30, 0xe9, 0x91, 0x5d, 0x93,
-	0x0f, 0xb0, 0xa5, 0xf0, 0xd8, 0x9b, 0xc7, 0x5a, 0x8d, 0x78, 0x5f, 0x94, 0x93, 0xed, 0x1d, 0xa5,
-	0xe9, 0xaa, 0x14, 0x7d, 0x55, 0xd7, 0x49, 0x6d, 0xc2, 0xf9, 0x05, 0xf2, 0xe1, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xc1, 0x4d, 0xa3, 0xff, 0x48, 0x15, 0x00, 0x00,
+	// 1595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xbd, 0x6f, 0xdb, 0x46,
+	0x14, 0x37, 0x2d, 0xd9, 0x71, 0xce, 0xf1, 0x17, 0x6d, 0xc7, 0x0a, 0x9d, 0x48, 0xca, 0xa5, 0x48,
+	0x9d, 0x0f, 0x91, 0xb6, 0x03, 0xc4, 0x81, 0x1b, 0xa4, 0x88, 0xea, 0x0c, 0x0a, 0x22, 0x38, 0x60,
+	0x3a, 0x04, 0x05, 0x0a, 0x81, 0x92, 0x58, 0x9a, 0xb1, 0x79, 0x27, 0x88, 0x27, 0x47, 0x9e, 0x1a,
+	0xa4, 0x4d, 0x0b, 0x74, 0xea, 0xd6, 0xfe, 0x03, 0x1d, 0xba, 0x65, 0xeb, 0xd4, 0xce, 0x99, 0x8a,
+	0x0c, 0x2d, 0x50, 0xb4, 0x80, 0x5a, 0xc4, 0x43, 0x80, 0xa0, 0xe8, 0xe0, 0xae, 0x1d, 0x8a, 0x23,
+	0x8f, 0x14, 0x49, 0x91, 0xa6, 0xe4, 0x58, 0x06, 0x02, 0x64, 0xb1, 0x25, 0xde, 0xfb, 0xba, 0xdf,
+	0xfb, 0xbd, 0x77, 0xef, 0x28, 0x70, 0x06, 0x9b, 0x06, 0x36, 0x75, 0x53, 0xd2, 0x14, 0xc3, 0x90,
+	0xb6, 0x97, 0xca, 0x2a, 0x51, 0x96, 0x24, 0xd2, 0x14, 0x6b, 0x75, 0x4c, 0x30, 0x3f, 0xc3, 0x96,
+	0x45, 0xba, 0x2c, 0xb2, 0x65, 0x61, 0x46, 0xc3, 0x1a, 0xb6, 0x04, 0x24, 0xfa, 0xc9, 0x96, 0x15,
+	0xa6, 0x14, 0x43, 0x47, 0x58, 0xb2, 0xfe, 0xb2, 0x47, 0xe9, 0x8a, 0xa5, 0x2f, 0x95, 0x15, 0x53,
+	0x75, 0x8d, 0x57, 0xb0, 0x8e, 0xd8, 0xfa, 0x65, 0xc7, 0x7b, 0x0d, 0xe3, 0x2d, 0x43, 0x41, 0x8a,
+	0xa6, 0xd6, 0x5d, 0x39, 0xf3, 0xa1, 0x52, 0x2b, 0xd5, 0x71, 0x83, 0xa8, 0x4c, 0x7a, 0x8e, 0x59,
+	0x33, 0x4c, 0x4d, 0xda, 0x5e, 0xa2, 0xff, 0x1c, 0x37, 0x1a, 0xc6, 0xda, 0x96, 0x2a, 0x59, 0xdf,
+	0xca, 0x8d, 0x4f, 0xa4, 0x6a, 0xa3, 0xae, 0x10, 0x1d, 0x33, 0x37, 0xf0, 0x97, 0x41, 0x30, 0x5a,
+	0x34, 0xb5, 0xdb, 0x58, 0x47, 0x77, 0x31, 0xde, 0xe2, 0x2f, 0x80, 0x61, 0x53, 0x45, 0x55, 0xb5,
+	0x9e, 0xe2, 0xb2, 0xdc, 0xc2, 0xf1, 0xfc, 0xd4, 0x5e, 0x2b, 0x33, 0xb6, 0xa3, 0x18, 0x5b, 0xab,
+	0xd0, 0x7e, 0x0e, 0x65, 0x26, 0xc0, 0x5f, 0x02, 0xc7, 0x68, 0x6c, 0x25, 0xbd, 0x9a, 0x1a, 0xcc,
+	0x72, 0x0b, 0xc9, 0x3c, 0xbf, 0xd7, 0xca, 0x8c, 0xdb, 0xb2, 0x6c, 0x01, 0xca, 0xc3, 0xf4, 0x53,
+	0xa1, 0xca, 0x2b, 0x60, 0xd2, 0xdc, 0x50, 0xea, 0x6a, 0x09, 0x37, 0x48, 0x49, 0x31, 0x70, 0x03,
+	0x91, 0x54, 0xc2, 0xf2, 0xb0, 0xf2, 0xac, 0x95, 0x19, 0xf8, 0xbd, 0x95, 0x99, 0xb5, 0xb7, 0x60,
+	0x56, 0x37, 0x45, 0x1d, 0x4b, 0x86, 0x42, 0x36, 0xc4, 0x02, 0x22, 0x7b, 0xad, 0xcc, 0x49, 0x8f,
+	0x49, 0x5b, 0x93, 0x1a, 0x81, 0xf2, 0xb8, 0x65, 0x70, 0xbd, 0x41, 0x6e, 0x5a, 0x0f, 0xf9, 0x32,
+	0x18, 0x23, 0x78, 0x53, 0x45, 0x25, 0x1d, 0x95, 0x0c, 0xa5, 0x69, 0xa6, 0x92, 0xd9, 0xc4, 0xc2,
+	0xe8, 0xf2, 0x29, 0xd1, 0x36, 0x2c, 0x52, 0xa4, 0x9d, 0x3c, 0x89, 0x1f, 0x60, 0x1d, 0xe5, 0xcf,
+	0x51, 0xd7, 0x7b, 0xad, 0xcc, 0xbc, 0xed, 0xc1, 0xab, 0xcd, 0x3c, 0x99, 0x50, 0x1e, 0xb5, 0x1e,
+	0x17, 0x50, 0x51, 0x69, 0x9a, 0xab, 0xe7, 0x1f, 0xbf, 0x7c, 0x7a, 0x91, 0x01, 0xf0, 0xd5, 0xcb,
+	0xa7, 0x17, 0x4f, 0xfa, 0x38, 0xf2, 0x00, 0xeb, 0x28, 0x47, 0xe3, 0x84, 0xcf, 0x38, 0x30, 0xed,
+	0x81, 0x55, 0x56, 0xcd, 0x1a, 0x46, 0xa6, 0xca, 0x97, 0x43, 0x60, 0xb0, 0x81, 0xbe, 0x16, 0x07,
+	0xc3, 0x1c, 0xcb, 0x42, 0x40, 0xbd, 0x13, 0x87, 0x22, 0x18, 0x71, 0x76, 0x92, 0x1a, 0x8c, 0x83,
+	0x60, 0x8e, 0x41, 0x30, 0xe1, 0x87, 0x00, 0xca, 0xc7, 0xd8, 0xb6, 0xe1, 0xcf, 0x09, 0xc0, 0x7b,
+	0xb6, 0x72, 0x13, 0x55, 0xef, 0xe0, 0xca, 0xe6, 0x5b, 0xa2, 0xec, 0x4b, 0x14, 0x7e, 0x03, 0x8c,
+	0x38, 0x95, 0x96, 0x1a, 0xca, 0x72, 0x96, 0x79, 0xbb, 0x14, 0x45, 0xa7, 0x14, 0xc5, 0x35, 0x26,
+	0x90, 0x5f, 0xa2, 0xe6, 0x5f, 0xb5, 0x32, 0xbc, 0xa3, 0x72, 0x19, 0x1b, 0x3a, 0x51, 0x8d, 0x1a,
+	0xd9, 0x69, 0xa7, 0xc6, 0x59, 0x83, 0xdf, 0xfe, 0x99, 0xe1, 0x64, 0xd7, 0xfa, 0xaa, 0x14, 0xa0,
+	0x64, 0x26, 0x9c, 0x92, 0x39, 0x05, 0x55, 0x73, 0x5b, 0xb8, 0xb2, 0x09, 0xff, 0xe3, 0x80, 0xd0,
+	0x99, 0xd0, 0x37, 0x98, 0xa2, 0x94, 0x60, 0x74, 0x67, 0x94, 0x60, 0x89, 0x20, 0xc1, 0xd8, 0x02,
+	0x94, 0x87, 0xe9, 0xa7, 0x42, 0x15, 0xfe, 0x6a, 0x77, 0xbc, 0x5b, 0x4d, 0x9d, 0xf4, 0xb5, 0xe3,
+	0x95, 0xc0, 0x84, 0x0d, 0x84, 0x8e, 0x0e, 0xc6, 0xe3, 0x80, 0x36, 0x94, 0xc7, 0xac, 0x27, 0x05,
+	0xc4, 0x40, 0x54, 0xc1, 0xb8, 0x8d, 0x05, 0x45, 0xda, 0xd0, 0x51, 0x17, 0x3c, 0x7e, 0x87, 0x41,
+	0x79, 0xda, 0x0b, 0x25, 0x53, 0x6f, 0x13, 0xf9, 0x84, 0xf5, 0x7c, 0xbd, 0x41, 0x8a, 0x3a, 0x8a,
+	0x6b, 0x79, 0x6a, 0x53, 0x27, 0x76, 0xcb, 0xd3, 0xc0, 0xb4, 0x07, 0x56, 0x97, 0x4e, 0x77, 0xc1,
+	0x71, 0xd7, 0x4d, 0x8a, 0x8b, 0x0b, 0x30, 0xc5, 0x02, 0x9c, 0x0c, 0x04, 0x08, 0xe5, 0x11, 0x27,
+	0x28, 0xf8, 0x28, 0x01, 0x66, 0x8a, 0xa6, 0x76, 0xef, 0xa1, 0x52, 0xbb, 0xd5, 0x54, 0x2a, 0x8c,
+	0x53, 0x05, 0xd4, 0x4b, 0x26, 0xef, 0x80, 0x61, 0xeb, 0xf8, 0x34, 0x19, 0xfd, 0x44, 0xd1, 0x39,
+	0xcd, 0x3d, 0xc7, 0xad, 0x1b, 0x1a, 0x75, 0xe5, 0x78, 0x91, 0xa9, 0x5a, 0x3e, 0x49, 0xe3, 0x94,
+	0x99, 0x0d, 0x1f, 0x9d, 0x13, 0x59, 0x6e, 0xff, 0x2d, 0xc6, 0xd3, 0xd9, 0x00, 0x33, 0x61, 0x99,
+	0x49, 0x25, 0xad, 0x5d, 0x5d, 0x8f, 0xa3, 0xcf, 0x7c, 0x74, 0x72, 0xa1, 0x3c, 0xe5, 0xc9, 0xad,
+	0xbd, 0xa5, 0xd5, 0xa5, 0x40, 0x82, 0xcf, 0xfa, 0x12, 0x4c, 0x47, 0x8d, 0x9c, 0x4a, 0x71, 0xce,
+	0xd9, 0x36, 0x72, 0x3a, 0x82, 0x8f, 0x39, 0x70, 0x3a, 0x2c, 0x05, 0xde, 0x26, 0xd2, 0xf6, 0x7f,
+	0xa0, 0x26, 0x12, 0x54, 0x87, 0xf2, 0xb8, 0x13, 0xba, 0xed, 0x0d, 0x7e, 0x96, 0x00, 0xb3, 0x9d,
+	0x41, 0xac, 0x37, 0x48, 0x2f, 0x44, 0x28, 0x06, 0x88, 0x20, 0x75, 0x49, 0x84, 0xf5, 0x06, 0x09,
+	0x63, 0xc2, 0x03, 0x30, 0x1d, 0x72, 0x38, 0xb0, 0xc2, 0x7f, 0x2f, 0x6e, 0xeb, 0x42, 0xe4, 0xf1,
+	0x02, 0xe5, 0xc9, 0xf6, 0xe9, 0xc2, 0xea, 0xdf, 0x57, 0x59, 0xc9, 0x2c, 0xf7, 0xda, 0x95, 0xb5,
+	0xba, 0x1c, 0x60, 0x02, 0x8c, 0x61, 0x02, 0x55, 0x7f, 0xc4, 0x81, 0x33, 0xa1, 0x59, 0x70, 0xb9,
+	0x50, 0x02, 0x13, 0xee, 0x8e, 0x7c, 0x54, 0xe8, 0xb6, 0x11, 0x06, 0xb4, 0xa1, 0x3c, 0xc6, 0xb0,
+	0x60, 0x44, 0x78, 0x35, 0x08, 0x4e, 0xb1, 0x03, 0xcd, 0x0e, 0x83, 0xa8, 0x75, 0x74, 0x90, 0xae,
+	0xd0, 0x53, 0x7f, 0x3f, 0xfc, 0xa2, 0x6f, 0x9f, 0x9b, 0x07, 0x2e, 0xfa, 0x30, 0x13, 0x50, 0x9e,
+	0x72, 0x8e, 0xdf, 0x76, 0xd1, 0xaf, 0x04, 0x52, 0xfd, 0x6e, 0xe7, 0xd4, 0xc0, 0xf2, 0x4d, 0xc1,
+	0xf4, 0x94, 0xfe, 0x97, 0x1c, 0x38, 0x1b, 0x09, 0xf6, 0x51, 0x0e, 0x11, 0xf0, 0xc7, 0x84, 0x2f,
+	0xed, 0xf7, 0xe8, 0xea, 0x81, 0x7a, 0x40, 0x4f, 0x69, 0x7f, 0xdf, 0x39, 0x75, 0x75, 0x54, 0xaa,
+	0xaa, 0x08, 0x1b, 0xac, 0xb8, 0x4f, 0xed, 0xb5, 0x32, 0xb3, 0x01, 0xbe, 0x5a, 0xeb, 0xce, 0x79,
+	0x5a, 0x40, 0x6b, 0xf4, 0x6b, 0x28, 0x34, 0xc9, 0x43, 0x9e, 0xaf, 0x22, 0xda, 0xd0, 0x50, 0x1f,
+	0xda, 0x50, 0xd7, 0x4c, 0xb2, 0x42, 0xf4, 0x76, 0x8e, 0xcf, 0xfd, 0x4c, 0xf2, 0xe7, 0xef, 0xe8,
+	0xba, 0xc7, 0x4f, 0x09, 0x90, 0x62, 0x83, 0x4b, 0x20, 0x8c, 0x3e, 0x36, 0x8f, 0xbc, 0xb3, 0x2b,
+	0x9a, 0x45, 0x2f, 0x8d, 0x84, 0x60, 0xe0, 0xae, 0x80, 0x13, 0xf8, 0x7a, 0x83, 0xd8, 0x44, 0x0a,
+	0x19, 0x30, 0x93, 0x87, 0x3a, 0x60, 0x46, 0xcd, 0x21, 0x43, 0xfd, 0x99, 0x43, 0xae, 0x06, 0x88,
+	0x74, 0xbe, 0x73, 0xd0, 0xec, 0x24, 0x92, 0x8e, 0xe0, 0x17, 0x1c, 0xc8, 0x46, 0x25, 0xf0, 0x48,
+	0x07, 0x92, 0x1f, 0x92, 0xe0, 0x7c, 0x54, 0x20, 0x45, 0xa5, 0x79, 0xb7, 0xae, 0x57, 0xd4, 0x82,
+	0x51, 0x53, 0x2a, 0xe4, 0x2d, 0xaf, 0x8e, 0x8e, 0x57, 0xfc, 0x06, 0x98, 0xa4, 0x1d, 0xac, 0x46,
+	0xe1, 0x2f, 0xe9, 0x16, 0xfe, 0xa9, 0x61, 0xcb, 0xd5, 0x0d, 0xe6, 0x6a, 0xbe, 0xd3, 0xd5, 0x1d,
+	0x55, 0x53, 0x2a, 0x3b, 0x6b, 0x6a, 0xa5, 0x4d, 0x80, 0xa0, 0x11, 0x28, 0x8f, 0x1b, 0xbe, 0xac,
+	0xc6, 0xb4, 0xc2, 0x36, 0x83, 0x0d, 0xa5, 0x99, 0xb3, 0x2c, 0xe5, 0x98, 0xa5, 0x7f, 0x39, 0x20,
+	0x76, 0xc7, 0x9c, 0xa3, 0x24, 0x34, 0xff, 0x31, 0x38, 0xe1, 0x43, 0x6d, 0xd0, 0xb2, 0xbf, 0xda,
+	0x1d, 0x6a, 0xd3, 0x8c, 0xa2, 0x3e, 0xc4, 0x46, 0x6b, 0xed, 0xad, 0xc0, 0xef, 0x92, 0xd1, 0x85,
+	0xfb, 0x21, 0xb6, 0xc8, 0x68, 0xbe, 0xb9, 0xd7, 0xf3, 0x5b, 0x60, 0x32, 0x50, 0x69, 0xf6, 0x05,
+	0xfd, 0x78, 0x7e, 0x3e, 0x2c, 0x07, 0xb6, 0x84, 0x27, 0x07, 0x6c, 0xff, 0x9d, 0xb7, 0xfc, 0xa1,
+	0x3e, 0xdc, 0xf2, 0x8f, 0xb0, 0x48, 0x16, 0x03, 0x45, 0x92, 0x8d, 0x28, 0x12, 0x82, 0x73, 0x0c,
+	0x93, 0x3f, 0x38, 0xb0, 0x10, 0xc7, 0x93, 0xfe, 0xbd, 0x6f, 0xe8, 0x77, 0x15, 0xfc, 0x3d, 0x08,
+	0x04, 0xcf, 0xee, 0xbc, 0x03, 0x75, 0x3f, 0xe7, 0x58, 0x1f, 0x4e, 0x89, 0x43, 0xb8, 0x3d, 0xd2,
+	0xa1, 0xd3, 0xad, 0x09, 0xcf, 0xd0, 0x99, 0xec, 0x69, 0xe8, 0x0c, 0xb1, 0x00, 0xe5, 0x49, 0x56,
+	0x59, 0xed, 0xa1, 0xf3, 0x5a, 0x80, 0x44, 0x0b, 0x11, 0x24, 0xf2, 0x5f, 0x5f, 0x68, 0xc0, 0x4f,
+	0x38, 0x00, 0xa3, 0xe1, 0xf6, 0x8e, 0x9d, 0xc1, 0xf6, 0xc0, 0x1d, 0x66, 0x7b, 0x58, 0xfe, 0x07,
+	0x80, 0x44, 0xd1, 0xd4, 0xf8, 0xfb, 0x60, 0xc4, 0xfd, 0xf1, 0xe5, 0xac, 0x18, 0xf6, 0x9b, 0x92,
+	0xe8, 0x79, 0x59, 0x2b, 0x5c, 0x88, 0x15, 0x71, 0xb7, 0x70, 0x1f, 0x8c, 0xb8, 0x2f, 0x39, 0xa3,
+	0x2d, 0x3b, 0x22, 0xc2, 0x85, 0x58, 0x11, 0xd7, 0xb2, 0x09, 0xa6, 0x3a, 0xdf, 0xbe, 0x5d, 0x8c,
+	0xd4, 0xef, 0x90, 0x15, 0x96, 0xbb, 0x97, 0x75, 0x9d, 0x6e, 0x03, 0x3e, 0xe4, 0x55, 0xcf, 0xa5,
+	0x6e, 0x2d, 0xad, 0x37, 0x88, 0x70, 0xa5, 0x07, 0x61, 0xd7, 0xef, 0x63, 0x0e, 0x9c, 0x8c, 0x78,
+	0xb5, 0x20, 0xed, 0x9b, 0x8c, 0x4e, 0x05, 0x61, 0xa5, 0x47, 0x85, 0xd0, 0x20, 0x02, 0x17, 0xdd,
+	0xf8, 0x20, 0xfc, 0x0a, 0xc2, 0x4a, 0x8f, 0x0a, 0x6e, 0x10, 0x4f, 0x38, 0x30, 0x17, 0xd5, 0xa6,
+	0x16, 0xf7, 0x65, 0x4f, 0x88, 0x86, 0x70, 0xad, 0x57, 0x0d, 0x37, 0x8e, 0x4f, 0xc1, 0x6c, 0xf8,
+	0x6d, 0x4d, 0x8c, 0x35, 0xe9, 0x93, 0x17, 0xae, 0xf6, 0x26, 0xef, 0x06, 0x60, 0x80, 0x89, 0xe0,
+	0xcf, 0x61, 0x0b, 0xb1, 0x75, 0xc9, 0x24, 0x85, 0xc5, 0x6e, 0x25, 0x5d, 0x77, 0xdf, 0x73, 0xe0,
+	0x5c, 0x37, 0x97, 0x8a, 0xeb, 0xbd, 0x6d, 0xc7, 0xaf, 0x2d, 0xac, 0xbd, 0x8e, 0xb6, 0x1b, 0xeb,
+	0x37, 0x1c, 0x38, 0xb3, 0xff, 0x40, 0xd7, 0x23, 0xe8, 0x8e, 0x9e, 0x70, 0xe3, 0x60, 0x7a, 0x4e,
+	0x64, 0xf9, 0xdb, 0xcf, 0x5e, 0xa4, 0xb9, 0xe7, 0x2f, 0xd2, 0xdc, 0x5f, 0x2f, 0xd2, 0xdc, 0xd7,
+	0xbb, 0xe9, 0x81, 0xe7, 0xbb, 0xe9, 0x81, 0xdf, 0x76, 0xd3, 0x03, 0x1f, 0x2d, 0x6a, 0x3a, 0xd9,
+	0x68, 0x94, 0xc5, 0x0a, 0x36, 0x24, 0xe6, 0x23, 0xb7, 0xa5, 0x94, 0x4d, 0xe7, 0x8b, 0xb4, 0xbd,
+	0x7c, 0x55, 0x6a, 0xda, 0x47, 0x0b, 0xd9, 0xa9, 0xa9, 0x66, 0x79, 0xd8, 0xfa, 0x0d, 0xef, 0xca,
+	0xff, 0x03, 0x00, 0xee, 0x58, 0xaa, 0x66, 0x23, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -965,6 +1357,17 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	// JoinPoolAndLock joins a pool and locks the received shares for the given
+	// duration atomically.
+	JoinPoolAndLock(ctx context.Context, in *MsgJoinPoolAndLock, opts ...grpc.CallOption) (*MsgJoinPoolAndLockResponse, error)
+	// ExitSwapShareAmountInMaxPriceImpact exits a pool to a single asset, like
+	// ExitSwapShareAmountIn, but fails if the price impact of the exit exceeds
+	// the given bound.
+	ExitSwapShareAmountInMaxPriceImpact(ctx context.Context, in *MsgExitSwapShareAmountInMaxPriceImpact, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInMaxPriceImpactResponse, error)
+	// ExitSwapShareAmountInToDenoms exits a pool to a subset of its assets,
+	// swapping the other assets into them, and fails if the price impact of the
+	// exit exceeds the given bound.
+	ExitSwapShareAmountInToDenoms(ctx context.Context, in *MsgExitSwapShareAmountInToDenoms, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInToDenomsResponse, error)
 }
 
 type msgClient struct {
@@ -1047,16 +1450,54 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
-	ExitPool(context.Context, *MsgExitPool) (*MsgExitPoolResponse, error)
+func (c *msgClient) JoinPoolAndLock(ctx context.Context, in *MsgJoinPoolAndLock, opts ...grpc.CallOption) (*MsgJoinPoolAndLockResponse, error) {
+	out := new(MsgJoinPoolAndLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/JoinPoolAndLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExitSwapShareAmountInMaxPriceImpact(ctx context.Context, in *MsgExitSwapShareAmountInMaxPriceImpact, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInMaxPriceImpactResponse, error) {
+	out := new(MsgExitSwapShareAmountInMaxPriceImpactResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/ExitSwapShareAmountInMaxPriceImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExitSwapShareAmountInToDenoms(ctx context.Context, in *MsgExitSwapShareAmountInToDenoms, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInToDenomsResponse, error) {
+	out := new(MsgExitSwapShareAmountInToDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/ExitSwapShareAmountInToDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
+	ExitPool(context.Context, *MsgExitPool) (*MsgExitPoolResponse, error)
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
 	JoinSwapExternAmountIn(context.Context, *MsgJoinSwapExternAmountIn) (*MsgJoinSwapExternAmountInResponse, error)
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	// JoinPoolAndLock joins a pool and locks the received shares for the given
+	// duration atomically.
+	JoinPoolAndLock(context.Context, *MsgJoinPoolAndLock) (*MsgJoinPoolAndLockResponse, error)
+	// ExitSwapShareAmountInMaxPriceImpact exits a pool to a single asset, like
+	// ExitSwapShareAmountIn, but fails if the price impact of the exit exceeds
+	// the given bound.
+	ExitSwapShareAmountInMaxPriceImpact(context.Context, *MsgExitSwapShareAmountInMaxPriceImpact) (*MsgExitSwapShareAmountInMaxPriceImpactResponse, error)
+	// ExitSwapShareAmountInToDenoms exits a pool to a subset of its assets,
+	// swapping the other assets into them, and fails if the price impact of the
+	// exit exceeds the given bound.
+	ExitSwapShareAmountInToDenoms(context.Context, *MsgExitSwapShareAmountInToDenoms) (*MsgExitSwapShareAmountInToDenomsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1087,6 +1528,15 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) JoinPoolAndLock(ctx context.Context, req *MsgJoinPoolAndLock) (*MsgJoinPoolAndLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinPoolAndLock not implemented")
+}
+func (*UnimplementedMsgServer) ExitSwapShareAmountInMaxPriceImpact(ctx context.Context, req *MsgExitSwapShareAmountInMaxPriceImpact) (*MsgExitSwapShareAmountInMaxPriceImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountInMaxPriceImpact not implemented")
+}
+func (*UnimplementedMsgServer) ExitSwapShareAmountInToDenoms(ctx context.Context, req *MsgExitSwapShareAmountInToDenoms) (*MsgExitSwapShareAmountInToDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountInToDenoms not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1236,6 +1686,61 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_JoinPoolAndLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinPoolAndLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinPoolAndLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/JoinPoolAndLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinPoolAndLock(ctx, req.(*MsgJoinPoolAndLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExitSwapShareAmountInMaxPriceImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExitSwapShareAmountInMaxPriceImpact)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExitSwapShareAmountInMaxPriceImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/ExitSwapShareAmountInMaxPriceImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExitSwapShareAmountInMaxPriceImpact(ctx, req.(*MsgExitSwapShareAmountInMaxPriceImpact))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExitSwapShareAmountInToDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExitSwapShareAmountInToDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExitSwapShareAmountInToDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/ExitSwapShareAmountInToDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExitSwapShareAmountInToDenoms(ctx, req.(*MsgExitSwapShareAmountInToDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1272,6 +1777,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "JoinPoolAndLock",
+			Handler:    _Msg_JoinPoolAndLock_Handler,
+		},
+		{
+			MethodName: "ExitSwapShareAmountInMaxPriceImpact",
+			Handler:    _Msg_ExitSwapShareAmountInMaxPriceImpact_Handler,
+		},
+		{
+			MethodName: "ExitSwapShareAmountInToDenoms",
+			Handler:    _Msg_ExitSwapShareAmountInToDenoms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -1383,6 +1900,125 @@ func (m *MsgJoinPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgJoinPoolAndLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPoolAndLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPoolAndLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenInMaxs) > 0 {
+		for iNdEx := len(m.TokenInMaxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenInMaxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinPoolAndLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinPoolAndLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinPoolAndLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenIn) > 0 {
+		for iNdEx := len(m.TokenIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExitPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1951,7 +2587,7 @@ func (m *MsgExitSwapShareAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExitSwapExternAmountOut) Marshal() (dAtA []byte, err error) {
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1961,36 +2597,53 @@ func (m *MsgExitSwapExternAmountOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExitSwapExternAmountOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExitSwapExternAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.ShareInMaxAmount.Size()
+		size := m.MaxPriceImpact.Size()
 		i -= size
-		if _, err := m.ShareInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x32
 	{
-		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ShareInAmount.Size()
 		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
@@ -2006,7 +2659,7 @@ func (m *MsgExitSwapExternAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExitSwapExternAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2016,20 +2669,30 @@ func (m *MsgExitSwapExternAmountOutResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgExitSwapExternAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.ShareInAmount.Size()
+		size := m.PriceImpact.Size()
 		i -= size
-		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -2039,31 +2702,244 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgExitSwapShareAmountInToDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgJoinPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgExitSwapShareAmountInToDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitSwapShareAmountInToDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ShareOutAmount.Size()
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenOutMins) > 0 {
+		for iNdEx := len(m.TokenOutMins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOutMins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenOutDenoms) > 0 {
+		for iNdEx := len(m.TokenOutDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenOutDenoms[iNdEx])
+			copy(dAtA[i:], m.TokenOutDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitSwapShareAmountInToDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitSwapShareAmountInToDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitSwapShareAmountInToDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenOut) > 0 {
+		for iNdEx := len(m.TokenOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitSwapExternAmountOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitSwapExternAmountOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitSwapExternAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareInMaxAmount.Size()
+		i -= size
+		if _, err := m.ShareInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitSwapExternAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitSwapExternAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgJoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareOutAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
 	if len(m.TokenInMaxs) > 0 {
 		for _, e := range m.TokenInMaxs {
@@ -2091,6 +2967,52 @@ func (m *MsgJoinPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgJoinPoolAndLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenInMaxs) > 0 {
+		for _, e := range m.TokenInMaxs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgJoinPoolAndLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenIn) > 0 {
+		for _, e := range m.TokenIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
 func (m *MsgExitPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2299,7 +3221,7 @@ func (m *MsgExitSwapShareAmountInResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgExitSwapExternAmountOut) Size() (n int) {
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2312,31 +3234,998 @@ func (m *MsgExitSwapExternAmountOut) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovTx(uint64(m.PoolId))
 	}
-	l = m.TokenOut.Size()
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ShareInAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = m.ShareInMaxAmount.Size()
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPriceImpact.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ShareInAmount.Size()
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PriceImpact.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
+func (m *MsgExitSwapShareAmountInToDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenOutDenoms) > 0 {
+		for _, s := range m.TokenOutDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExitSwapShareAmountInToDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		for _, e := range m.TokenOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExitSwapExternAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ShareInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInMaxs = append(m.TokenInMaxs, types.Coin{})
+			if err := m.TokenInMaxs[len(m.TokenInMaxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = append(m.TokenIn, types.Coin{})
+			if err := m.TokenIn[len(m.TokenIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinPoolAndLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinPoolAndLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinPoolAndLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInMaxs = append(m.TokenInMaxs, types.Coin{})
+			if err := m.TokenInMaxs[len(m.TokenInMaxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinPoolAndLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinPoolAndLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinPoolAndLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = append(m.TokenIn, types.Coin{})
+			if err := m.TokenIn[len(m.TokenIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = append(m.TokenOut, types.Coin{})
+			if err := m.TokenOut[len(m.TokenOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2359,10 +4248,10 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2398,10 +4287,10 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2411,16 +4300,31 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2430,31 +4334,30 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2464,23 +4367,23 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInMaxs = append(m.TokenInMaxs, types.Coin{})
-			if err := m.TokenInMaxs[len(m.TokenInMaxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2505,7 +4408,7 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2528,15 +4431,15 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2564,41 +4467,7 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenIn = append(m.TokenIn, types.Coin{})
-			if err := m.TokenIn[len(m.TokenIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2623,7 +4492,7 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,10 +4515,10 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2685,10 +4554,10 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2698,14 +4567,29 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types1.SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2733,13 +4617,13 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2766,8 +4650,7 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
-			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2792,7 +4675,7 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2815,17 +4698,17 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2835,23 +4718,23 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = append(m.TokenOut, types.Coin{})
-			if err := m.TokenOut[len(m.TokenOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2876,7 +4759,7 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2899,10 +4782,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2938,10 +4821,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2951,26 +4834,11 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
@@ -3006,7 +4874,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3034,7 +4902,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3059,7 +4927,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3082,15 +4950,15 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3118,7 +4986,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3143,7 +5011,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3166,10 +5034,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3205,10 +5073,29 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3218,29 +5105,27 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types1.SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3268,15 +5153,15 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3286,22 +5171,23 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3326,7 +5212,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3349,10 +5235,10 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3410,7 +5296,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3433,10 +5319,10 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3492,9 +5378,9 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3504,28 +5390,61 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3553,7 +5472,7 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3578,7 +5497,7 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3601,15 +5520,15 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3637,7 +5556,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3662,7 +5581,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountInMaxPriceImpact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3685,10 +5604,10 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInMaxPriceImpact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInMaxPriceImpact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3744,7 +5663,7 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3772,11 +5691,11 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3804,13 +5723,13 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3838,7 +5757,41 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3863,7 +5816,7 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountInMaxPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3886,15 +5839,15 @@ func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInMaxPriceImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInMaxPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3922,7 +5875,41 @@ func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3947,7 +5934,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountInToDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3970,10 +5957,10 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInToDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInToDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4029,7 +6016,7 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4057,11 +6044,13 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4089,13 +6078,45 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenOutDenoms = append(m.TokenOutDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4123,7 +6144,7 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4148,7 +6169,7 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountInToDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4171,15 +6192,49 @@ func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInToDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInToDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = append(m.TokenOut, types.Coin{})
+			if err := m.TokenOut[len(m.TokenOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4207,7 +6262,7 @@ func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16,6 +16,29 @@ func GetQueryCmd() *cobra.Command {
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
 	)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRateLimits)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRateLimitUtilization)
 
 	return cmd
 }
+
+// GetCmdRateLimits returns the native rate limits of all channels and denoms.
+func GetCmdRateLimits() (*osmocli.QueryDescriptor, *queryproto.RateLimitsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rate-limits",
+		Short: "Query the native rate limits of all channels and denoms",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} rate-limits`,
+	}, &queryproto.RateLimitsRequest{}
+}
+
+// GetCmdRateLimitUtilization returns the current utilization of the quotas of the native rate limit of a denom over a channel.
+func GetCmdRateLimitUtilization() (*osmocli.QueryDescriptor, *queryproto.RateLimitUtilizationRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rate-limit-utilization [channel-id] [denom]",
+		Short: "Query the current utilization of the quotas of the native rate limit of a denom over a channel",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} rate-limit-utilization channel-0 uosmo
+{{.CommandPrefix}} rate-limit-utilization any uosmo`,
+	}, &queryproto.RateLimitUtilizationRequest{}
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/types"
+)
+
+// NewCmdHandleSetRateLimitProposal implements a command handler for set rate limit proposal
+func NewCmdHandleSetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit-proposal [channel-id] [denom] [quotas] [flags]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit a set rate limit proposal",
+		Long: strings.TrimSpace(`Submit a set rate limit proposal.
+
+Sets the quotas of the native rate limit of the denom over the channel, resetting their flows.
+A channel id of "any" applies the quotas to the transfers of the denom over every channel.
+Quotas are given as a comma-separated list of name:max-percentage-send:max-percentage-recv:duration.
+Omitting the quotas removes the rate limit.
+Ex) set-rate-limit-proposal channel-0 uosmo daily:5:5:24h,weekly:20:20:168h ->
+[net transfers of uosmo over channel-0 limited to 5% of its supply per day and 20% per week in each direction]
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetRateLimitArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func parseSetRateLimitArgsToContent(cmd *cobra.Command, args []string) (*types.SetRateLimitProposal, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := []types.Quota{}
+	if len(args) == 3 {
+		quotas, err = parseQuotas(args[2])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content := types.NewSetRateLimitProposal(title, description, args[0], args[1], quotas)
+	return content.(*types.SetRateLimitProposal), nil
+}
+
+// parseQuotas parses a comma-separated list of name:max-percentage-send:max-percentage-recv:duration quotas.
+func parseQuotas(arg string) ([]types.Quota, error) {
+	quotas := []types.Quota{}
+	for _, quotaStr := range strings.Split(arg, ",") {
+		fields := strings.Split(quotaStr, ":")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid quota %s, expected name:max-percentage-send:max-percentage-recv:duration", quotaStr)
+		}
+
+		maxPercentageSend, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		maxPercentageRecv, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		duration, err := time.ParseDuration(fields[3])
+		if err != nil {
+			return nil, err
+		}
+
+		quotas = append(quotas, types.Quota{
+			Name:              fields[0],
+			MaxPercentageSend: uint32(maxPercentageSend),
+			MaxPercentageRecv: uint32(maxPercentageRecv),
+			Duration:          duration,
+		})
+	}
+	return quotas, nil
+}
@@ -31,3 +31,23 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+
+func (q Querier) RateLimits(grpcCtx context.Context,
+	req *queryproto.RateLimitsRequest,
+) (*queryproto.RateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RateLimits(ctx, *req)
+}
+
+func (q Querier) RateLimitUtilization(grpcCtx context.Context,
+	req *queryproto.RateLimitUtilizationRequest,
+) (*queryproto.RateLimitUtilizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RateLimitUtilization(ctx, *req)
+}
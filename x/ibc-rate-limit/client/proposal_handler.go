@@ -0,0 +1,9 @@
+package client
+
+import (
+	ibcratelimitcli "github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var SetRateLimitProposalHandler = govclient.NewProposalHandler(ibcratelimitcli.NewCmdHandleSetRateLimitProposal)
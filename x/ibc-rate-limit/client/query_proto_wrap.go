@@ -19,3 +19,36 @@ func (q Querier) Params(ctx sdk.Context,
 	params := q.K.GetParams(ctx)
 	return &queryproto.ParamsResponse{Params: params}, nil
 }
+
+func (q Querier) RateLimits(ctx sdk.Context,
+	req queryproto.RateLimitsRequest,
+) (*queryproto.RateLimitsResponse, error) {
+	rateLimits, err := q.K.GetAllRateLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.RateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
+func (q Querier) RateLimitUtilization(ctx sdk.Context,
+	req queryproto.RateLimitUtilizationRequest,
+) (*queryproto.RateLimitUtilizationResponse, error) {
+	rateLimit, err := q.K.GetCurrentRateLimit(ctx, req.ChannelId, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := make([]queryproto.QuotaUtilization, len(rateLimit.Quotas))
+	for i, quota := range rateLimit.Quotas {
+		flow := rateLimit.Flows[i]
+		quotas[i] = queryproto.QuotaUtilization{
+			Name:         quota.Name,
+			SendUsed:     flow.NetOutflow(),
+			SendCapacity: quota.SendCapacity(flow.ChannelValue),
+			RecvUsed:     flow.NetInflow(),
+			RecvCapacity: quota.RecvCapacity(flow.ChannelValue),
+			PeriodEnd:    flow.PeriodEnd,
+		}
+	}
+	return &queryproto.RateLimitUtilizationResponse{Quotas: quotas}, nil
+}
@@ -5,25 +5,31 @@ package queryproto
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -114,9 +120,256 @@ func (m *ParamsResponse) GetParams() types.Params {
 	return types.Params{}
 }
 
+// RateLimitsRequest is the request type for the Query/RateLimits RPC method.
+type RateLimitsRequest struct {
+}
+
+func (m *RateLimitsRequest) Reset()         { *m = RateLimitsRequest{} }
+func (m *RateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsRequest) ProtoMessage()    {}
+func (*RateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{2}
+}
+func (m *RateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsRequest.Merge(m, src)
+}
+func (m *RateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsRequest proto.InternalMessageInfo
+
+// RateLimitsResponse is the response type for the Query/RateLimits RPC
+// method.
+type RateLimitsResponse struct {
+	RateLimits []types.RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+}
+
+func (m *RateLimitsResponse) Reset()         { *m = RateLimitsResponse{} }
+func (m *RateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsResponse) ProtoMessage()    {}
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{3}
+}
+func (m *RateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsResponse.Merge(m, src)
+}
+func (m *RateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsResponse proto.InternalMessageInfo
+
+func (m *RateLimitsResponse) GetRateLimits() []types.RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+// RateLimitUtilizationRequest is the request type for the
+// Query/RateLimitUtilization RPC method.
+type RateLimitUtilizationRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *RateLimitUtilizationRequest) Reset()         { *m = RateLimitUtilizationRequest{} }
+func (m *RateLimitUtilizationRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUtilizationRequest) ProtoMessage()    {}
+func (*RateLimitUtilizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{4}
+}
+func (m *RateLimitUtilizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUtilizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUtilizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitUtilizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUtilizationRequest.Merge(m, src)
+}
+func (m *RateLimitUtilizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUtilizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUtilizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitUtilizationRequest proto.InternalMessageInfo
+
+func (m *RateLimitUtilizationRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimitUtilizationRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QuotaUtilization is the utilization of a quota in its current period.
+// The used amounts are the net flows in each direction, which are zero once
+// the period has ended.
+type QuotaUtilization struct {
+	Name         string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	SendUsed     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=send_used,json=sendUsed,proto3,customtype=cosmossdk.io/math.Int" json:"send_used" yaml:"send_used"`
+	SendCapacity cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=send_capacity,json=sendCapacity,proto3,customtype=cosmossdk.io/math.Int" json:"send_capacity" yaml:"send_capacity"`
+	RecvUsed     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=recv_used,json=recvUsed,proto3,customtype=cosmossdk.io/math.Int" json:"recv_used" yaml:"recv_used"`
+	RecvCapacity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=recv_capacity,json=recvCapacity,proto3,customtype=cosmossdk.io/math.Int" json:"recv_capacity" yaml:"recv_capacity"`
+	PeriodEnd    time.Time             `protobuf:"bytes,6,opt,name=period_end,json=periodEnd,proto3,stdtime" json:"period_end" yaml:"period_end"`
+}
+
+func (m *QuotaUtilization) Reset()         { *m = QuotaUtilization{} }
+func (m *QuotaUtilization) String() string { return proto.CompactTextString(m) }
+func (*QuotaUtilization) ProtoMessage()    {}
+func (*QuotaUtilization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{5}
+}
+func (m *QuotaUtilization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUtilization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaUtilization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaUtilization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUtilization.Merge(m, src)
+}
+func (m *QuotaUtilization) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUtilization) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUtilization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUtilization proto.InternalMessageInfo
+
+func (m *QuotaUtilization) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QuotaUtilization) GetPeriodEnd() time.Time {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return time.Time{}
+}
+
+// RateLimitUtilizationResponse is the response type for the
+// Query/RateLimitUtilization RPC method.
+type RateLimitUtilizationResponse struct {
+	Quotas []QuotaUtilization `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas"`
+}
+
+func (m *RateLimitUtilizationResponse) Reset()         { *m = RateLimitUtilizationResponse{} }
+func (m *RateLimitUtilizationResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUtilizationResponse) ProtoMessage()    {}
+func (*RateLimitUtilizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{6}
+}
+func (m *RateLimitUtilizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUtilizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUtilizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitUtilizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUtilizationResponse.Merge(m, src)
+}
+func (m *RateLimitUtilizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUtilizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUtilizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitUtilizationResponse proto.InternalMessageInfo
+
+func (m *RateLimitUtilizationResponse) GetQuotas() []QuotaUtilization {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.ibcratelimit.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.ibcratelimit.v1beta1.ParamsResponse")
+	proto.RegisterType((*RateLimitsRequest)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitsRequest")
+	proto.RegisterType((*RateLimitsResponse)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitsResponse")
+	proto.RegisterType((*RateLimitUtilizationRequest)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitUtilizationRequest")
+	proto.RegisterType((*QuotaUtilization)(nil), "osmosis.ibcratelimit.v1beta1.QuotaUtilization")
+	proto.RegisterType((*RateLimitUtilizationResponse)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitUtilizationResponse")
 }
 
 func init() {
@@ -124,28 +377,56 @@ func init() {
 }
 
 var fileDescriptor_6904fea69f32464e = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4a, 0x2b, 0x31,
-	0x18, 0xc5, 0x27, 0x97, 0x6b, 0x17, 0x23, 0x2a, 0x0c, 0x2e, 0xa4, 0x94, 0x28, 0x45, 0xa4, 0xfe,
-	0x69, 0x42, 0x2b, 0xf8, 0x00, 0x5d, 0xb8, 0xd6, 0xe2, 0xca, 0x95, 0xc9, 0x10, 0x62, 0x60, 0x26,
-	0xdf, 0x74, 0x92, 0x16, 0xdd, 0xfa, 0x04, 0x42, 0xd7, 0xbe, 0x4f, 0x97, 0x05, 0x37, 0xae, 0x44,
-	0x5a, 0x1f, 0x44, 0x26, 0x49, 0x4b, 0x11, 0x29, 0x5d, 0xcd, 0x24, 0xf9, 0x9d, 0xf3, 0x9d, 0x9c,
-	0xc4, 0x2d, 0x30, 0x39, 0x18, 0x65, 0xa8, 0xe2, 0x69, 0xc9, 0xac, 0xc8, 0x54, 0xae, 0x2c, 0x1d,
-	0x75, 0xb8, 0xb0, 0xac, 0x43, 0x07, 0x43, 0x51, 0x3e, 0x93, 0xa2, 0x04, 0x0b, 0x49, 0x23, 0x90,
-	0x64, 0x95, 0x24, 0x81, 0xac, 0xef, 0x4b, 0x90, 0xe0, 0x40, 0x5a, 0xfd, 0x79, 0x4d, 0xbd, 0x21,
-	0x01, 0x64, 0x26, 0x28, 0x2b, 0x14, 0x65, 0x5a, 0x83, 0x65, 0x56, 0x81, 0x36, 0xe1, 0xf4, 0x2c,
-	0x75, 0x96, 0x94, 0x33, 0x23, 0xfc, 0xa8, 0xe5, 0xe0, 0x82, 0x49, 0xa5, 0x1d, 0x1c, 0xd8, 0xd3,
-	0xb5, 0x39, 0x0b, 0x56, 0xb2, 0x3c, 0xd8, 0x36, 0xf7, 0xe2, 0x9d, 0x1b, 0xb7, 0xee, 0x8b, 0xc1,
-	0x50, 0x18, 0xdb, 0xbc, 0x8b, 0x77, 0x17, 0x1b, 0xa6, 0x00, 0x6d, 0x44, 0xd2, 0x8b, 0x6b, 0x5e,
-	0x72, 0x80, 0x8e, 0x50, 0x6b, 0xbb, 0x7b, 0x4c, 0xd6, 0x5d, 0x8e, 0x78, 0x75, 0xef, 0xff, 0xe4,
-	0xf3, 0x30, 0xea, 0x07, 0x65, 0xf7, 0x0d, 0xc5, 0x5b, 0xb7, 0x55, 0xe8, 0x64, 0x8c, 0xe2, 0x9a,
-	0x47, 0x92, 0xf3, 0x4d, 0x8c, 0x42, 0xae, 0xfa, 0xc5, 0x66, 0xb0, 0xcf, 0xdc, 0x24, 0x2f, 0xef,
-	0xdf, 0xe3, 0x7f, 0xad, 0xe4, 0x84, 0xae, 0x54, 0xd1, 0xae, 0x64, 0xed, 0xbf, 0xca, 0xe8, 0x3d,
-	0x4c, 0x66, 0x18, 0x4d, 0x67, 0x18, 0x7d, 0xcd, 0x30, 0x7a, 0x9d, 0xe3, 0x68, 0x3a, 0xc7, 0xd1,
-	0xc7, 0x1c, 0x47, 0xf7, 0xd7, 0x52, 0xd9, 0xc7, 0x21, 0x27, 0x29, 0xe4, 0x0b, 0xaf, 0x76, 0xc6,
-	0xb8, 0x59, 0x1a, 0x8f, 0xba, 0x57, 0xf4, 0xe9, 0xb7, 0x7d, 0x9a, 0x29, 0xa1, 0xad, 0x7f, 0x27,
-	0x57, 0x34, 0xaf, 0xb9, 0xcf, 0xe5, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x19, 0x6c, 0x13,
-	0x44, 0x02, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0x8e, 0x21, 0x44, 0x9b, 0x09, 0x2c, 0x30, 0x0b, 0x52, 0x36, 0xcb, 0xc6, 0x2b, 0xef, 0x8a,
+	0xcd, 0x2e, 0x8d, 0x5d, 0x42, 0x85, 0x5a, 0x2e, 0x5a, 0x29, 0xa8, 0x95, 0x90, 0xb8, 0x28, 0x16,
+	0x48, 0x55, 0x55, 0x29, 0x9d, 0xd8, 0xd3, 0x64, 0x54, 0xdb, 0x63, 0xe2, 0x09, 0x2a, 0xf4, 0xae,
+	0x4f, 0x80, 0x44, 0x5f, 0xa0, 0x52, 0x1f, 0xa1, 0x0f, 0xc1, 0x4d, 0x25, 0xd4, 0xde, 0x54, 0xbd,
+	0x48, 0x2b, 0xe8, 0x13, 0xe4, 0x09, 0xaa, 0xf9, 0xb1, 0xe3, 0x02, 0x0a, 0xa1, 0x57, 0x89, 0xcf,
+	0xf9, 0xbe, 0xf3, 0x9d, 0x2f, 0x3e, 0xe7, 0x04, 0x54, 0x68, 0xe4, 0xd3, 0x88, 0x44, 0x16, 0x69,
+	0x3a, 0x1d, 0xc4, 0xb0, 0x47, 0x7c, 0xc2, 0xac, 0xbd, 0xe5, 0x26, 0x66, 0x68, 0xd9, 0xda, 0xed,
+	0xe2, 0xce, 0xbe, 0x19, 0x76, 0x28, 0xa3, 0x70, 0x41, 0x21, 0xcd, 0x34, 0xd2, 0x54, 0xc8, 0xd2,
+	0x5c, 0x8b, 0xb6, 0xa8, 0x00, 0x5a, 0xfc, 0x9b, 0xe4, 0x94, 0x16, 0x5a, 0x94, 0xb6, 0x3c, 0x6c,
+	0xa1, 0x90, 0x58, 0x28, 0x08, 0x28, 0x43, 0x8c, 0xd0, 0x20, 0x52, 0xd9, 0xff, 0x1d, 0x51, 0xd2,
+	0x6a, 0xa2, 0x08, 0x4b, 0xa9, 0x44, 0x38, 0x44, 0x2d, 0x12, 0x08, 0xb0, 0xc2, 0xea, 0xaa, 0x92,
+	0x78, 0x6a, 0x76, 0x9f, 0x59, 0x8c, 0xf8, 0x38, 0x62, 0xc8, 0x0f, 0x15, 0xe0, 0x77, 0x59, 0xac,
+	0x21, 0x7b, 0x90, 0x0f, 0x2a, 0xf5, 0xdf, 0x50, 0x8f, 0x21, 0xea, 0x20, 0x3f, 0x86, 0x56, 0x87,
+	0x42, 0x79, 0xa4, 0x21, 0x7d, 0x0b, 0xb8, 0x31, 0x0d, 0xa6, 0x1e, 0x0a, 0xba, 0x8d, 0x77, 0xbb,
+	0x38, 0x62, 0xc6, 0x36, 0xf8, 0x35, 0x0e, 0x44, 0x21, 0x0d, 0x22, 0x0c, 0xeb, 0x20, 0x27, 0x15,
+	0x8a, 0xda, 0x5f, 0x5a, 0xa5, 0x50, 0xfb, 0xc7, 0x1c, 0xf6, 0x3b, 0x9a, 0x92, 0x5d, 0xcf, 0x1e,
+	0xf7, 0xf4, 0x8c, 0xad, 0x98, 0xc6, 0x6f, 0x60, 0xd6, 0x46, 0x0c, 0x6f, 0x72, 0x64, 0x22, 0x75,
+	0x00, 0x60, 0x3a, 0xa8, 0xe4, 0x5c, 0x50, 0x18, 0x74, 0xc9, 0x35, 0xc7, 0x2b, 0x85, 0xda, 0xbf,
+	0xc3, 0x35, 0x93, 0x32, 0xf5, 0x12, 0x97, 0xed, 0xf7, 0x74, 0xb8, 0x8f, 0x7c, 0x6f, 0xcd, 0x48,
+	0x55, 0x32, 0x6c, 0xd0, 0x49, 0xd4, 0x8c, 0x97, 0xe0, 0x8f, 0x84, 0xb4, 0xc3, 0x88, 0x47, 0x0e,
+	0xc4, 0xbb, 0x52, 0xad, 0xc1, 0x5b, 0x00, 0x38, 0x6d, 0x14, 0x04, 0xd8, 0x6b, 0x10, 0x57, 0xf8,
+	0xce, 0xd7, 0xe7, 0xfb, 0x3d, 0x7d, 0x56, 0x96, 0x1d, 0xe4, 0x0c, 0x3b, 0xaf, 0x1e, 0x36, 0x5c,
+	0xb8, 0x08, 0x26, 0x5c, 0x1c, 0x50, 0xbf, 0x38, 0x26, 0x08, 0x33, 0xfd, 0x9e, 0x3e, 0x29, 0x09,
+	0x22, 0x6c, 0xd8, 0x32, 0x6d, 0xbc, 0xc9, 0x82, 0x99, 0xad, 0x2e, 0x65, 0x28, 0xa5, 0x0c, 0xff,
+	0x06, 0xd9, 0x00, 0xf9, 0x58, 0x89, 0x4d, 0xf7, 0x7b, 0x7a, 0x41, 0x72, 0x79, 0xd4, 0xb0, 0x45,
+	0x12, 0x3e, 0x01, 0xf9, 0x08, 0x07, 0x6e, 0xa3, 0x1b, 0x61, 0x57, 0xa9, 0xdc, 0xe3, 0x8e, 0x3f,
+	0xf7, 0xf4, 0x79, 0x39, 0x31, 0x91, 0xfb, 0xdc, 0x24, 0xd4, 0xf2, 0x11, 0x6b, 0x9b, 0x1b, 0x01,
+	0xeb, 0xf7, 0xf4, 0x19, 0x59, 0x26, 0xe1, 0x19, 0x1f, 0xde, 0x55, 0x81, 0x1a, 0xaf, 0x8d, 0x80,
+	0xd9, 0xbf, 0xf0, 0xcc, 0x4e, 0x84, 0x5d, 0xd8, 0x06, 0x53, 0x02, 0xe5, 0xa0, 0x10, 0x39, 0x84,
+	0xed, 0x17, 0xc7, 0x85, 0xc2, 0xfa, 0x55, 0x0a, 0x73, 0x29, 0x85, 0x98, 0x7b, 0x5e, 0x65, 0x92,
+	0x67, 0xd7, 0x55, 0x92, 0xfb, 0xe8, 0x60, 0x67, 0x4f, 0xfa, 0xc8, 0x5e, 0xcb, 0x47, 0xc2, 0xbb,
+	0xe0, 0x83, 0x67, 0x62, 0x1f, 0x02, 0x95, 0xf8, 0x98, 0xb8, 0x96, 0x8f, 0x1f, 0xb8, 0x17, 0x7c,
+	0xf0, 0x6c, 0xe2, 0xe3, 0x11, 0x00, 0x21, 0xee, 0x10, 0xea, 0x36, 0x70, 0xe0, 0x16, 0x73, 0x62,
+	0x3f, 0x4a, 0xa6, 0xdc, 0x74, 0x33, 0xde, 0x74, 0x73, 0x3b, 0xde, 0xf4, 0xfa, 0x9f, 0x6a, 0x3c,
+	0xd5, 0x1c, 0x0d, 0xb8, 0xc6, 0xe1, 0x17, 0x5d, 0xb3, 0xf3, 0x32, 0x70, 0x3f, 0x70, 0x0d, 0x0f,
+	0x2c, 0x5c, 0x3e, 0xa0, 0x6a, 0x4d, 0x36, 0x41, 0x6e, 0x97, 0x8f, 0x50, 0xbc, 0x21, 0xe6, 0xf0,
+	0x0d, 0x39, 0x3f, 0x6e, 0xf1, 0x7e, 0xca, 0x1a, 0xb5, 0xd7, 0x59, 0x30, 0xb1, 0xc5, 0xef, 0x17,
+	0x3c, 0xd2, 0x40, 0x4e, 0xae, 0x30, 0x5c, 0x1a, 0x65, 0xd1, 0xd5, 0xc6, 0x94, 0x6e, 0x8c, 0x06,
+	0x96, 0xdd, 0x1b, 0xe6, 0xab, 0x8f, 0xdf, 0x8e, 0xc6, 0x2a, 0x70, 0xd1, 0x4a, 0x9d, 0xab, 0x2a,
+	0xa7, 0x55, 0x2f, 0xbb, 0x6d, 0xf0, 0xad, 0x06, 0xc0, 0xe0, 0x56, 0x40, 0x6b, 0xc4, 0x73, 0x90,
+	0x74, 0x77, 0x73, 0x74, 0x82, 0xea, 0x70, 0x45, 0x74, 0x58, 0x85, 0x4b, 0x57, 0x75, 0x98, 0x3a,
+	0x31, 0xf0, 0xbd, 0x06, 0xe6, 0x2e, 0x7b, 0x6b, 0xf0, 0xce, 0x88, 0xfa, 0x17, 0x4f, 0x51, 0x69,
+	0xed, 0x67, 0xa8, 0xca, 0xc4, 0x5d, 0x61, 0xe2, 0x36, 0x5c, 0x1d, 0xdd, 0x44, 0xa3, 0x9b, 0x1a,
+	0x92, 0xa7, 0xc7, 0xa7, 0x65, 0xed, 0xe4, 0xb4, 0xac, 0x7d, 0x3d, 0x2d, 0x6b, 0x87, 0x67, 0xe5,
+	0xcc, 0xc9, 0x59, 0x39, 0xf3, 0xe9, 0xac, 0x9c, 0x79, 0xfc, 0xa0, 0x45, 0x58, 0xbb, 0xdb, 0x34,
+	0x1d, 0xea, 0xc7, 0xb5, 0xab, 0x1e, 0x6a, 0x46, 0x89, 0xd0, 0x5e, 0x6d, 0xd5, 0x7a, 0x71, 0x5e,
+	0xce, 0xf1, 0x08, 0x0e, 0x98, 0xfc, 0xa7, 0x94, 0xdb, 0x91, 0x13, 0x1f, 0x2b, 0xdf, 0x07, 0x00,
+	0x4d, 0xcb, 0xce, 0xa1, 0xc6, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -163,6 +444,11 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// RateLimits returns the native rate limits of all channels and denoms.
+	RateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
+	// RateLimitUtilization returns the current utilization of each quota of the
+	// native rate limit of a denom over a channel.
+	RateLimitUtilization(ctx context.Context, in *RateLimitUtilizationRequest, opts ...grpc.CallOption) (*RateLimitUtilizationResponse, error)
 }
 
 type queryClient struct {
@@ -182,11 +468,34 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitUtilization(ctx context.Context, in *RateLimitUtilizationRequest, opts ...grpc.CallOption) (*RateLimitUtilizationResponse, error) {
+	out := new(RateLimitUtilizationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/RateLimitUtilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// RateLimits returns the native rate limits of all channels and denoms.
+	RateLimits(context.Context, *RateLimitsRequest) (*RateLimitsResponse, error)
+	// RateLimitUtilization returns the current utilization of each quota of the
+	// native rate limit of a denom over a channel.
+	RateLimitUtilization(context.Context, *RateLimitUtilizationRequest) (*RateLimitUtilizationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -196,6 +505,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *RateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitUtilization(ctx context.Context, req *RateLimitUtilizationRequest) (*RateLimitUtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitUtilization not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -219,6 +534,43 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*RateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitUtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/RateLimitUtilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitUtilization(ctx, req.(*RateLimitUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.ibcratelimit.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -227,6 +579,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitUtilization",
+			Handler:    _Query_RateLimitUtilization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/ibcratelimit/v1beta1/query.proto",
@@ -288,57 +648,348 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RateLimitUtilizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RateLimitUtilizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+
+func (m *RateLimitUtilizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUtilization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUtilization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUtilization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RecvCapacity.Size()
+		i -= size
+		if _, err := m.RecvCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RecvUsed.Size()
+		i -= size
+		if _, err := m.RecvUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SendCapacity.Size()
+		i -= size
+		if _, err := m.SendCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SendUsed.Size()
+		i -= size
+		if _, err := m.SendUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUtilizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUtilizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUtilizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitUtilizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuotaUtilization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.SendUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SendCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecvUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecvCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RateLimitUtilizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -458,6 +1109,589 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, types.RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUtilizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUtilizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUtilizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUtilization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUtilization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUtilization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecvUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecvCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUtilizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUtilizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUtilizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, QuotaUtilization{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,6 @@
+package ibc_rate_limit
+
+var (
+	MsgSend = msgSend
+	MsgRecv = msgRecv
+)
@@ -7,14 +7,22 @@ import (
 )
 
 // InitGenesis initializes the x/ibc-rate-limit module's state from a provided genesis
-// state, which includes the parameter for the contract address.
+// state, which includes the parameter for the contract address and the native rate limits.
 func (i *ICS4Wrapper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	i.SetParams(ctx, genState.Params)
+	for _, rateLimit := range genState.RateLimits {
+		i.setRateLimit(ctx, rateLimit)
+	}
 }
 
 // ExportGenesis returns the x/ibc-rate-limit module's exported genesis.
 func (i *ICS4Wrapper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	rateLimits, err := i.GetAllRateLimits(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		Params: i.GetParams(ctx),
+		Params:     i.GetParams(ctx),
+		RateLimits: rateLimits,
 	}
 }
@@ -2,10 +2,12 @@ package ibc_rate_limit_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/types"
 )
@@ -31,6 +33,20 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 		Params: types.Params{
 			ContractAddress: testAddress,
 		},
+		RateLimits: []types.RateLimit{
+			types.NewRateLimit(types.AnyChannel, "uosmo", []types.Quota{{Name: "weekly", MaxPercentageSend: 20, MaxPercentageRecv: 20, Duration: 7 * 24 * time.Hour}}),
+			{
+				ChannelId: "channel-0",
+				Denom:     "uosmo",
+				Quotas:    []types.Quota{{Name: "daily", MaxPercentageSend: 5, MaxPercentageRecv: 10, Duration: 24 * time.Hour}},
+				Flows: []types.Flow{{
+					Inflow:       osmomath.NewInt(10),
+					Outflow:      osmomath.NewInt(20),
+					ChannelValue: osmomath.NewInt(1000),
+					PeriodEnd:    time.Unix(1700000000, 0).UTC(),
+				}},
+			},
+		},
 	}
 
 	k.InitGenesis(suite.Ctx, initialGenesis)
@@ -0,0 +1,29 @@
+package ibc_rate_limit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/types"
+)
+
+// HandleSetRateLimitProposal sets the quotas of the native rate limit of the proposal's denom over its channel,
+// resetting their flows. Empty quotas remove the rate limit.
+func (i *ICS4Wrapper) HandleSetRateLimitProposal(ctx sdk.Context, p *types.SetRateLimitProposal) error {
+	return i.SetRateLimit(ctx, p.ChannelId, p.Denom, p.Quotas)
+}
+
+func NewRateLimitProposalHandler(i *ICS4Wrapper) govtypesv1.Handler {
+	return func(ctx sdk.Context, content govtypesv1.Content) error {
+		switch c := content.(type) {
+		case *types.SetRateLimitProposal:
+			return i.HandleSetRateLimitProposal(ctx, c)
+
+		default:
+			return fmt.Errorf("unrecognized ibc-rate-limit proposal content type: %T", c)
+		}
+	}
+}
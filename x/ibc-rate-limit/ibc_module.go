@@ -135,6 +135,10 @@ func (im *IBCModule) OnRecvPacket(
 		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadMessage, err.Error())
 	}
 
+	if err := im.ics4Middleware.CheckAndUpdateNativeRateLimits(ctx, msgRecv, packet); err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
@@ -208,11 +212,15 @@ func (im *IBCModule) OnTimeoutPacket(
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received
+// RevertSentPacket Notifies the native rate limits and the contract that a sent packet wasn't properly received
 func (im *IBCModule) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
+	if err := im.ics4Middleware.UndoNativeSendRateLimit(ctx, packet); err != nil {
+		return err
+	}
+
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
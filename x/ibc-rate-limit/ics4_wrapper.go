@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,7 @@ type ICS4Wrapper struct {
 	bankKeeper     *bankkeeper.BaseKeeper
 	ContractKeeper *wasmkeeper.PermissionedKeeper
 	paramSpace     paramtypes.Subspace
+	storeKey       storetypes.StoreKey
 }
 
 func (i *ICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
@@ -41,6 +43,7 @@ func NewICS4Middleware(
 	channel porttypes.ICS4Wrapper,
 	accountKeeper *authkeeper.AccountKeeper, contractKeeper *wasmkeeper.PermissionedKeeper,
 	bankKeeper *bankkeeper.BaseKeeper, paramSpace paramtypes.Subspace,
+	storeKey storetypes.StoreKey,
 ) ICS4Wrapper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -51,11 +54,14 @@ func NewICS4Middleware(
 		ContractKeeper: contractKeeper,
 		bankKeeper:     bankKeeper,
 		paramSpace:     paramSpace,
+		storeKey:       storeKey,
 	}
 }
 
 // SendPacket implements the ICS4 interface and is called when sending packets.
-// This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
+// The transfer is first checked against the native rate limits of its (channel+denom), which are kept in the module's
+// store, returning an error preventing the IBC send from taking place if they have been exceeded.
+// This method then retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
 // the current transfer, in which case it returns an error preventing the IBC send from taking place.
 // If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
 // used, transfers are not prevented and handled by the wrapped IBC app
@@ -67,11 +73,6 @@ func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capab
 	if packetdata.Denom == "" || packetdata.Amount == "" {
 		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
-	contract := i.GetContractAddress(ctx)
-	if contract == "" {
-		// The contract has not been configured. Continue as usual
-		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
-	}
 
 	// setting 0 as a default so it can be properly parsed by cosmwasm
 	fullPacket := channeltypes.Packet{
@@ -85,6 +86,16 @@ func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capab
 		TimeoutHeight:      timeoutHeight,
 	}
 
+	if err := i.CheckAndUpdateNativeRateLimits(ctx, msgSend, fullPacket); err != nil {
+		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+	}
+
+	contract := i.GetContractAddress(ctx)
+	if contract == "" {
+		// The contract has not been configured. Continue as usual
+		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
 	err := CheckAndUpdateRateLimits(ctx, i.ContractKeeper, "send_packet", contract, fullPacket)
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
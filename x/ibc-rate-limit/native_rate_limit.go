@@ -0,0 +1,208 @@
+package ibc_rate_limit
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/types"
+)
+
+// SetRateLimit sets the quotas of the native rate limit of the denom over the channel, resetting their flows.
+// Empty quotas remove the rate limit.
+func (i *ICS4Wrapper) SetRateLimit(ctx sdk.Context, channelId, denom string, quotas []types.Quota) error {
+	if err := types.ValidateRateLimitPath(channelId, denom); err != nil {
+		return err
+	}
+	if err := types.ValidateQuotas(quotas); err != nil {
+		return err
+	}
+
+	if len(quotas) == 0 {
+		ctx.KVStore(i.storeKey).Delete(types.GetRateLimitKey(channelId, denom))
+		return nil
+	}
+	i.setRateLimit(ctx, types.NewRateLimit(channelId, denom, quotas))
+	return nil
+}
+
+// GetRateLimit returns the native rate limit of the denom over the channel.
+// Returns false if the denom is not rate limited over the channel.
+func (i *ICS4Wrapper) GetRateLimit(ctx sdk.Context, channelId, denom string) (types.RateLimit, bool, error) {
+	rateLimit := types.RateLimit{}
+	found, err := osmoutils.Get(ctx.KVStore(i.storeKey), types.GetRateLimitKey(channelId, denom), &rateLimit)
+	if err != nil {
+		return types.RateLimit{}, false, err
+	}
+	return rateLimit, found, nil
+}
+
+// GetAllRateLimits returns the native rate limits of all channels and denoms.
+func (i *ICS4Wrapper) GetAllRateLimits(ctx sdk.Context) ([]types.RateLimit, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(i.storeKey), types.RateLimitPrefix, func(bz []byte) (types.RateLimit, error) {
+		rateLimit := types.RateLimit{}
+		err := rateLimit.Unmarshal(bz)
+		return rateLimit, err
+	})
+}
+
+func (i *ICS4Wrapper) setRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	osmoutils.MustSet(ctx.KVStore(i.storeKey), types.GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom), &rateLimit)
+}
+
+// GetCurrentRateLimit returns the native rate limit of the denom over the channel with the flows it has at the
+// block time: flows whose period has ended are reset, starting a new period with the current supply of the denom
+// as their channel value.
+func (i *ICS4Wrapper) GetCurrentRateLimit(ctx sdk.Context, channelId, denom string) (types.RateLimit, error) {
+	rateLimit, found, err := i.GetRateLimit(ctx, channelId, denom)
+	if err != nil {
+		return types.RateLimit{}, err
+	}
+	if !found {
+		return types.RateLimit{}, errorsmod.Wrapf(types.ErrInvalidRateLimit, "no rate limit of %s over %s", denom, channelId)
+	}
+
+	channelValue := i.bankKeeper.GetSupply(ctx, denom).Amount
+	for idx, quota := range rateLimit.Quotas {
+		resetExpiredFlow(ctx, &rateLimit.Flows[idx], quota, channelValue)
+	}
+	return rateLimit, nil
+}
+
+// resetExpiredFlow starts a new period of the flow with the given channel value if its period has ended.
+func resetExpiredFlow(ctx sdk.Context, flow *types.Flow, quota types.Quota, channelValue osmomath.Int) {
+	if !flow.IsExpired(ctx.BlockTime()) {
+		return
+	}
+	*flow = types.Flow{
+		Inflow:       osmomath.ZeroInt(),
+		Outflow:      osmomath.ZeroInt(),
+		ChannelValue: channelValue,
+		PeriodEnd:    ctx.BlockTime().Add(quota.Duration),
+	}
+}
+
+// CheckAndUpdateNativeRateLimits applies the transfer of the packet to the native rate limits of its denom over
+// its channel and over any channel, erroring if the transfer takes the net flow of any of their quotas above its
+// capacity, in which case no flow is updated.
+// Packets that are not fungible token transfers, and denoms without native rate limits, are not rate limited.
+func (i *ICS4Wrapper) CheckAndUpdateNativeRateLimits(ctx sdk.Context, msgType string, packet exported.PacketI) error {
+	channelId, denom, amount, ok, err := parseNativeRateLimitPacket(msgType, packet)
+	if err != nil || !ok {
+		return err
+	}
+
+	rateLimits, err := i.getPacketRateLimits(ctx, channelId, denom)
+	if err != nil || len(rateLimits) == 0 {
+		return err
+	}
+
+	channelValue := i.bankKeeper.GetSupply(ctx, denom).Amount
+	// Non-native tokens are burned before the packet is sent, so the amount is added back to the supply.
+	if msgType == msgSend && strings.HasPrefix(denom, "ibc/") {
+		channelValue = channelValue.Add(amount)
+	}
+
+	for idx := range rateLimits {
+		rateLimit := &rateLimits[idx]
+		for quotaIdx, quota := range rateLimit.Quotas {
+			flow := &rateLimit.Flows[quotaIdx]
+			resetExpiredFlow(ctx, flow, quota, channelValue)
+
+			used, capacity := flow.NetInflow(), quota.RecvCapacity(flow.ChannelValue)
+			if msgType == msgSend {
+				flow.Outflow = flow.Outflow.Add(amount)
+				used, capacity = flow.NetOutflow(), quota.SendCapacity(flow.ChannelValue)
+			} else {
+				flow.Inflow = flow.Inflow.Add(amount)
+			}
+
+			if used.GT(capacity) {
+				return errorsmod.Wrapf(types.ErrRateLimitExceeded,
+					"transfer of %s %s over %s exceeds quota %s of channel %s: net flow %s is greater than capacity %s until %s",
+					amount, denom, channelId, quota.Name, rateLimit.ChannelId, used, capacity, flow.PeriodEnd)
+			}
+		}
+	}
+
+	for _, rateLimit := range rateLimits {
+		i.setRateLimit(ctx, rateLimit)
+	}
+	return nil
+}
+
+// UndoNativeSendRateLimit removes the transfer of a sent packet that failed from the outflows of the native rate
+// limits of its denom over its channel and over any channel, floored at zero.
+func (i *ICS4Wrapper) UndoNativeSendRateLimit(ctx sdk.Context, packet exported.PacketI) error {
+	channelId, denom, amount, ok, err := parseNativeRateLimitPacket(msgSend, packet)
+	if err != nil || !ok {
+		return err
+	}
+
+	rateLimits, err := i.getPacketRateLimits(ctx, channelId, denom)
+	if err != nil {
+		return err
+	}
+
+	for _, rateLimit := range rateLimits {
+		for idx := range rateLimit.Flows {
+			flow := &rateLimit.Flows[idx]
+			flow.Outflow = osmomath.MaxInt(flow.Outflow.Sub(amount), osmomath.ZeroInt())
+		}
+		i.setRateLimit(ctx, rateLimit)
+	}
+	return nil
+}
+
+// getPacketRateLimits returns the native rate limits of the denom over the channel and over any channel.
+func (i *ICS4Wrapper) getPacketRateLimits(ctx sdk.Context, channelId, denom string) ([]types.RateLimit, error) {
+	rateLimits := []types.RateLimit{}
+	for _, rateLimitChannelId := range []string{channelId, types.AnyChannel} {
+		rateLimit, found, err := i.GetRateLimit(ctx, rateLimitChannelId, denom)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+	return rateLimits, nil
+}
+
+// parseNativeRateLimitPacket returns the local channel, local denom and amount of a fungible token packet
+// sent or received by this chain. Returns false if the packet is not a fungible token transfer.
+//
+// The local denom of a received token that originated on this chain is its denom with the counterparty's
+// prefix removed, and the local denom of any other token is the ibc denom of its trace on this chain.
+func parseNativeRateLimitPacket(msgType string, packet exported.PacketI) (string, string, osmomath.Int, bool, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil || packetData.Denom == "" || packetData.Amount == "" {
+		return "", "", osmomath.Int{}, false, nil
+	}
+
+	amount, ok := osmomath.NewIntFromString(packetData.Amount)
+	if !ok || amount.IsNegative() {
+		return "", "", osmomath.Int{}, false, errorsmod.Wrapf(types.ErrBadMessage, "invalid packet amount %s", packetData.Amount)
+	}
+
+	switch msgType {
+	case msgSend:
+		return packet.GetSourceChannel(), transfertypes.ParseDenomTrace(packetData.Denom).IBCDenom(), amount, true, nil
+	case msgRecv:
+		denom := packetData.Denom
+		if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+			denom = strings.TrimPrefix(denom, transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel()))
+		} else {
+			denom = transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + denom
+		}
+		return packet.GetDestChannel(), transfertypes.ParseDenomTrace(denom).IBCDenom(), amount, true, nil
+	default:
+		return "", "", osmomath.Int{}, false, types.ErrBadMessage
+	}
+}
@@ -0,0 +1,209 @@
+package ibc_rate_limit_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	ibcratelimit "github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/client"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-rate-limit/types"
+)
+
+const (
+	nativeDenom    = "ufoo"
+	localChannel   = "channel-0"
+	counterChannel = "channel-1"
+)
+
+var dailyQuota = types.Quota{Name: "daily", MaxPercentageSend: 10, MaxPercentageRecv: 20, Duration: 24 * time.Hour}
+
+type NativeRateLimitTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestNativeRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(NativeRateLimitTestSuite))
+}
+
+func (s *NativeRateLimitTestSuite) SetupTest() {
+	s.Setup()
+	// supply of 1000 ufoo, so the daily quota allows a net outflow of 100 and a net inflow of 200.
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 1000)))
+}
+
+// sendPacket returns a packet sending amount of the native denom over the local channel.
+func sendPacket(amount int64) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(nativeDenom, osmomath.NewInt(amount).String(), "sender", "receiver", "")
+	return channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, localChannel, transfertypes.PortID, counterChannel, clienttypes.NewHeight(0, 100), 0)
+}
+
+// recvPacket returns a packet returning amount of the native denom over the local channel.
+func recvPacket(amount int64) channeltypes.Packet {
+	denom := transfertypes.GetPrefixedDenom(transfertypes.PortID, counterChannel, nativeDenom)
+	data := transfertypes.NewFungibleTokenPacketData(denom, osmomath.NewInt(amount).String(), "sender", "receiver", "")
+	return channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, counterChannel, transfertypes.PortID, localChannel, clienttypes.NewHeight(0, 100), 0)
+}
+
+func (s *NativeRateLimitTestSuite) TestCheckAndUpdateNativeRateLimits() {
+	type transfer struct {
+		packet    channeltypes.Packet
+		isSend    bool
+		expectErr bool
+	}
+
+	tests := map[string]struct {
+		channelId string
+		quotas    []types.Quota
+		transfers []transfer
+	}{
+		"no rate limit: any transfer succeeds": {
+			transfers: []transfer{{packet: sendPacket(1000), isSend: true}},
+		},
+		"send up to the capacity succeeds": {
+			channelId: localChannel,
+			quotas:    []types.Quota{dailyQuota},
+			transfers: []transfer{
+				{packet: sendPacket(60), isSend: true},
+				{packet: sendPacket(40), isSend: true},
+			},
+		},
+		"send above the capacity fails": {
+			channelId: localChannel,
+			quotas:    []types.Quota{dailyQuota},
+			transfers: []transfer{
+				{packet: sendPacket(60), isSend: true},
+				{packet: sendPacket(41), isSend: true, expectErr: true},
+			},
+		},
+		"receive nets out the outflow": {
+			channelId: localChannel,
+			quotas:    []types.Quota{dailyQuota},
+			transfers: []transfer{
+				{packet: sendPacket(100), isSend: true},
+				{packet: recvPacket(50)},
+				{packet: sendPacket(50), isSend: true},
+			},
+		},
+		"receive above the capacity fails": {
+			channelId: localChannel,
+			quotas:    []types.Quota{dailyQuota},
+			transfers: []transfer{{packet: recvPacket(201), expectErr: true}},
+		},
+		"rate limit over any channel applies": {
+			channelId: types.AnyChannel,
+			quotas:    []types.Quota{dailyQuota},
+			transfers: []transfer{{packet: sendPacket(101), isSend: true, expectErr: true}},
+		},
+		"every quota is checked": {
+			channelId: localChannel,
+			quotas:    []types.Quota{dailyQuota, {Name: "hourly", MaxPercentageSend: 5, MaxPercentageRecv: 5, Duration: time.Hour}},
+			transfers: []transfer{{packet: sendPacket(51), isSend: true, expectErr: true}},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			k := s.App.RateLimitingICS4Wrapper
+			if tc.quotas != nil {
+				s.Require().NoError(k.SetRateLimit(s.Ctx, tc.channelId, nativeDenom, tc.quotas))
+			}
+
+			for _, transfer := range tc.transfers {
+				msgType := ibcratelimit.MsgRecv
+				if transfer.isSend {
+					msgType = ibcratelimit.MsgSend
+				}
+				rateLimitsBefore, err := k.GetAllRateLimits(s.Ctx)
+				s.Require().NoError(err)
+
+				err = k.CheckAndUpdateNativeRateLimits(s.Ctx, msgType, transfer.packet)
+				if transfer.expectErr {
+					s.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+					// no flow is updated by a rejected transfer
+					rateLimitsAfter, err := k.GetAllRateLimits(s.Ctx)
+					s.Require().NoError(err)
+					s.Require().Equal(rateLimitsBefore, rateLimitsAfter)
+					continue
+				}
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *NativeRateLimitTestSuite) TestNativeRateLimitFlows() {
+	s.SetupTest()
+	k := s.App.RateLimitingICS4Wrapper
+	querier := client.Querier{K: *k}
+	s.Require().NoError(k.SetRateLimit(s.Ctx, localChannel, nativeDenom, []types.Quota{dailyQuota}))
+	startTime := s.Ctx.BlockTime()
+
+	s.Require().NoError(k.CheckAndUpdateNativeRateLimits(s.Ctx, ibcratelimit.MsgSend, sendPacket(80)))
+	s.Require().NoError(k.CheckAndUpdateNativeRateLimits(s.Ctx, ibcratelimit.MsgRecv, recvPacket(30)))
+
+	res, err := querier.RateLimitUtilization(s.Ctx, queryproto.RateLimitUtilizationRequest{ChannelId: localChannel, Denom: nativeDenom})
+	s.Require().NoError(err)
+	s.Require().Equal([]queryproto.QuotaUtilization{{
+		Name:         dailyQuota.Name,
+		SendUsed:     osmomath.NewInt(50),
+		SendCapacity: osmomath.NewInt(100),
+		RecvUsed:     osmomath.ZeroInt(),
+		RecvCapacity: osmomath.NewInt(200),
+		PeriodEnd:    startTime.Add(dailyQuota.Duration),
+	}}, res.Quotas)
+
+	// Undoing a failed send removes it from the outflow, floored at zero.
+	s.Require().NoError(k.UndoNativeSendRateLimit(s.Ctx, sendPacket(100)))
+	rateLimit, found, err := k.GetRateLimit(s.Ctx, localChannel, nativeDenom)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(osmomath.ZeroInt(), rateLimit.Flows[0].Outflow)
+	s.Require().Equal(osmomath.NewInt(30), rateLimit.Flows[0].Inflow)
+
+	// Once the period ends, the flow is reset with the channel value at the time of the next transfer.
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 1000)))
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(dailyQuota.Duration + time.Second))
+	s.Require().NoError(k.CheckAndUpdateNativeRateLimits(s.Ctx, ibcratelimit.MsgSend, sendPacket(200)))
+	rateLimit, _, err = k.GetRateLimit(s.Ctx, localChannel, nativeDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Flow{
+		Inflow:       osmomath.ZeroInt(),
+		Outflow:      osmomath.NewInt(200),
+		ChannelValue: osmomath.NewInt(2000),
+		PeriodEnd:    s.Ctx.BlockTime().Add(dailyQuota.Duration),
+	}, rateLimit.Flows[0])
+
+	// Empty quotas remove the rate limit.
+	s.Require().NoError(k.SetRateLimit(s.Ctx, localChannel, nativeDenom, nil))
+	s.Require().NoError(k.CheckAndUpdateNativeRateLimits(s.Ctx, ibcratelimit.MsgSend, sendPacket(2000)))
+	rateLimits, err := querier.RateLimits(s.Ctx, queryproto.RateLimitsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(rateLimits.RateLimits)
+}
+
+func (s *NativeRateLimitTestSuite) TestHandleSetRateLimitProposal() {
+	s.SetupTest()
+	handler := ibcratelimit.NewRateLimitProposalHandler(s.App.RateLimitingICS4Wrapper)
+
+	err := handler(s.Ctx, &types.SetRateLimitProposal{Title: "title", Description: "description", ChannelId: localChannel, Denom: nativeDenom, Quotas: []types.Quota{dailyQuota}})
+	s.Require().NoError(err)
+	rateLimit, found, err := s.App.RateLimitingICS4Wrapper.GetRateLimit(s.Ctx, localChannel, nativeDenom)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.NewRateLimit(localChannel, nativeDenom, []types.Quota{dailyQuota}), rateLimit)
+
+	invalidQuota := dailyQuota
+	invalidQuota.MaxPercentageSend = 101
+	err = handler(s.Ctx, &types.SetRateLimitProposal{Title: "title", Description: "description", ChannelId: localChannel, Denom: nativeDenom, Quotas: []types.Quota{invalidQuota}})
+	s.Require().ErrorIs(err, types.ErrInvalidRateLimit)
+}
@@ -8,4 +8,5 @@ var (
 	ErrRateLimitExceeded = errorsmod.Register(ModuleName, 2, "rate limit exceeded")
 	ErrBadMessage        = errorsmod.Register(ModuleName, 3, "bad message")
 	ErrContractError     = errorsmod.Register(ModuleName, 4, "contract error")
+	ErrInvalidRateLimit  = errorsmod.Register(ModuleName, 5, "invalid rate limit")
 )
@@ -1,9 +1,14 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:     DefaultParams(),
+		RateLimits: []RateLimit{},
 	}
 }
 
@@ -13,5 +18,17 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	paths := make(map[string]struct{}, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		path := string(GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom))
+		if _, ok := paths[path]; ok {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate rate limit of %s over %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		paths[path] = struct{}{}
+	}
 	return nil
 }
@@ -29,6 +29,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params are all the parameters of the module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// rate_limits are the native rate limits, enforced alongside the contract.
+	RateLimits []RateLimit `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +73,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.ibcratelimit.v1beta1.GenesisState")
 }
@@ -80,23 +89,27 @@ func init() {
 }
 
 var fileDescriptor_37b7c83ed1422177 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x49, 0x26,
-	0x83, 0x35, 0xc5, 0x43, 0x24, 0x20, 0x1c, 0x98, 0x54, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e,
-	0x98, 0x97, 0x54, 0x9a, 0xa6, 0x9f, 0x98, 0x57, 0x09, 0x95, 0xd2, 0xc4, 0xeb, 0xaa, 0x82, 0xc4,
-	0xa2, 0xc4, 0x5c, 0xa8, 0x29, 0x4a, 0x41, 0x5c, 0x3c, 0xee, 0x10, 0x57, 0x06, 0x97, 0x24, 0x96,
-	0xa4, 0x0a, 0x39, 0x71, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x54, 0xf4,
-	0xf0, 0xb9, 0x5a, 0x2f, 0x00, 0xac, 0xd6, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x4e,
-	0xa7, 0x90, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4a, 0xcf, 0x2c,
-	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x9a, 0xab, 0x9b, 0x93, 0x98, 0x54, 0x0c,
-	0xe3, 0xe8, 0x97, 0x19, 0x99, 0xe9, 0x57, 0x80, 0x9c, 0xad, 0x0b, 0xb2, 0x4b, 0x17, 0xe2, 0xf0,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x83, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd1, 0x8a, 0xb8, 0xa8, 0x73, 0x01, 0x00, 0x00,
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x3d, 0x4e, 0xc3, 0x30,
+	0x14, 0x80, 0x63, 0x40, 0x1d, 0x52, 0xa6, 0x88, 0xa1, 0x54, 0xc8, 0xad, 0x2a, 0x24, 0x0a, 0x52,
+	0x6c, 0xb5, 0x48, 0x0c, 0x1d, 0xb3, 0xb0, 0x30, 0xa0, 0xc0, 0xc4, 0x52, 0xd9, 0xc1, 0x18, 0x4b,
+	0x49, 0x1d, 0xc5, 0x6e, 0x45, 0x6e, 0xc1, 0x71, 0x38, 0x42, 0xc7, 0x8e, 0x4c, 0x15, 0x4a, 0x6e,
+	0xc0, 0x09, 0x90, 0xed, 0x44, 0x74, 0xca, 0xe6, 0xe7, 0xf7, 0xbd, 0xef, 0xfd, 0xf8, 0x37, 0x52,
+	0x65, 0x52, 0x09, 0x85, 0x05, 0x4d, 0x0a, 0xa2, 0x59, 0x2a, 0x32, 0xa1, 0xf1, 0x66, 0x46, 0x99,
+	0x26, 0x33, 0xcc, 0xd9, 0x8a, 0x29, 0xa1, 0x50, 0x5e, 0x48, 0x2d, 0x83, 0x8b, 0x86, 0x45, 0x87,
+	0x2c, 0x6a, 0xd8, 0xe1, 0x19, 0x97, 0x5c, 0x5a, 0x10, 0x9b, 0x97, 0xab, 0x19, 0x9e, 0x27, 0xb6,
+	0x68, 0xe9, 0x12, 0x2e, 0x68, 0x53, 0x5c, 0x4a, 0x9e, 0x32, 0x6c, 0x23, 0xba, 0x7e, 0xc3, 0x64,
+	0x55, 0x36, 0xa9, 0xeb, 0xce, 0xa9, 0x72, 0x52, 0x90, 0xac, 0xb5, 0x84, 0x9d, 0xa8, 0xf9, 0x59,
+	0xba, 0x39, 0x2d, 0x3e, 0xf9, 0x02, 0xfe, 0xe9, 0xbd, 0xdb, 0xea, 0x49, 0x13, 0xcd, 0x82, 0xc8,
+	0xef, 0x39, 0xdf, 0x00, 0x8c, 0xc1, 0xb4, 0x3f, 0xbf, 0x44, 0x5d, 0x5b, 0xa2, 0x47, 0xcb, 0x46,
+	0x27, 0xdb, 0xfd, 0xc8, 0x8b, 0x9b, 0xca, 0xe0, 0xd5, 0xef, 0xff, 0x37, 0x52, 0x83, 0xa3, 0xf1,
+	0xf1, 0xb4, 0x3f, 0xbf, 0xea, 0x16, 0xc5, 0x44, 0xb3, 0x07, 0xf3, 0x13, 0x0d, 0x8d, 0xeb, 0x77,
+	0x3f, 0x0a, 0x4a, 0x92, 0xa5, 0x8b, 0xc9, 0x81, 0x69, 0x12, 0xfb, 0x45, 0x8b, 0xa9, 0xe8, 0x79,
+	0x5b, 0x41, 0xb0, 0xab, 0x20, 0xf8, 0xa9, 0x20, 0xf8, 0xac, 0xa1, 0xb7, 0xab, 0xa1, 0xf7, 0x5d,
+	0x43, 0xef, 0x65, 0xc1, 0x85, 0x7e, 0x5f, 0x53, 0x94, 0xc8, 0x0c, 0x37, 0x4d, 0xc3, 0x94, 0x50,
+	0xd5, 0x06, 0x78, 0x33, 0xbf, 0xc3, 0x1f, 0xe6, 0x42, 0xa1, 0xf1, 0x85, 0xee, 0x46, 0xba, 0xcc,
+	0x99, 0xa2, 0x3d, 0x7b, 0x97, 0xdb, 0xbf, 0x01, 0x00, 0x25, 0xb8, 0x2b, 0xfe, 0x09, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +132,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -151,6 +178,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -222,6 +255,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeSetRateLimit = "SetRateLimit"
+)
+
+func init() {
+	govtypesv1.RegisterProposalType(ProposalTypeSetRateLimit)
+}
+
+var _ govtypesv1.Content = &SetRateLimitProposal{}
+
+// NewSetRateLimitProposal returns a new instance of a set rate limit proposal struct.
+func NewSetRateLimitProposal(title, description, channelId, denom string, quotas []Quota) govtypesv1.Content {
+	return &SetRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelId,
+		Denom:       denom,
+		Quotas:      quotas,
+	}
+}
+
+func (p *SetRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetRateLimitProposal) ProposalType() string {
+	return ProposalTypeSetRateLimit
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetRateLimitProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateRateLimitPath(p.ChannelId, p.Denom); err != nil {
+		return err
+	}
+	return ValidateQuotas(p.Quotas)
+}
+
+// String returns a string containing the set rate limit proposal.
+func (p SetRateLimitProposal) String() string {
+	var b strings.Builder
+	for _, quota := range p.Quotas {
+		b.WriteString(fmt.Sprintf("(Name: %s, Send: %d%%, Recv: %d%%, Duration: %s) ", quota.Name, quota.MaxPercentageSend, quota.MaxPercentageRecv, quota.Duration))
+	}
+
+	quotasStr := b.String()
+	b.Reset()
+
+	b.WriteString(fmt.Sprintf(`Set Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel ID:  %s
+Denom:       %s
+Quotas:      %s
+`, p.Title, p.Description, p.ChannelId, p.Denom, quotasStr))
+	return b.String()
+}
@@ -5,8 +5,22 @@ import "strings"
 const (
 	ModuleName = "rate-limited-ibc" // IBC at the end to avoid conflicts with the ibc prefix
 
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// KeySeparator separates the channel id and the denom of a rate limit key.
+	// Channel ids cannot contain it.
+	KeySeparator = "|"
 )
 
 // RouterKey is the message route. Can only contain
 // alphanumeric characters.
 var RouterKey = strings.ReplaceAll(ModuleName, "-", "")
+
+// RateLimitPrefix is the prefix of the keys of the native rate limits.
+var RateLimitPrefix = []byte{0x01}
+
+// GetRateLimitKey returns the key of the native rate limit of the denom over the channel.
+func GetRateLimitKey(channelId, denom string) []byte {
+	return append(append([]byte{}, RateLimitPrefix...), []byte(channelId+KeySeparator+denom)...)
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// AnyChannel is the channel id of the rate limits applied to the transfers of a denom over every channel.
+const AnyChannel = "any"
+
+// MaxPercentage is the maximum percentage of the channel value a quota can allow to flow in either direction.
+const MaxPercentage = 100
+
+// NewRateLimit returns a rate limit of the denom over the channel with the given quotas, whose flows start
+// at the first transfer.
+func NewRateLimit(channelId, denom string, quotas []Quota) RateLimit {
+	flows := make([]Flow, len(quotas))
+	for i := range flows {
+		flows[i] = NewExpiredFlow()
+	}
+	return RateLimit{
+		ChannelId: channelId,
+		Denom:     denom,
+		Quotas:    quotas,
+		Flows:     flows,
+	}
+}
+
+// NewExpiredFlow returns an empty flow whose period has ended, so that it is reset by the next transfer.
+func NewExpiredFlow() Flow {
+	return Flow{
+		Inflow:       osmomath.ZeroInt(),
+		Outflow:      osmomath.ZeroInt(),
+		ChannelValue: osmomath.ZeroInt(),
+	}
+}
+
+// IsExpired returns whether the period of the flow ended before the given time.
+func (f Flow) IsExpired(now time.Time) bool {
+	return f.PeriodEnd.Before(now)
+}
+
+// NetInflow returns the inflow minus the outflow of the flow, floored at zero.
+func (f Flow) NetInflow() osmomath.Int {
+	return osmomath.MaxInt(f.Inflow.Sub(f.Outflow), osmomath.ZeroInt())
+}
+
+// NetOutflow returns the outflow minus the inflow of the flow, floored at zero.
+func (f Flow) NetOutflow() osmomath.Int {
+	return osmomath.MaxInt(f.Outflow.Sub(f.Inflow), osmomath.ZeroInt())
+}
+
+// SendCapacity returns the maximum net outflow the quota allows for the given channel value.
+func (q Quota) SendCapacity(channelValue osmomath.Int) osmomath.Int {
+	return channelValue.MulRaw(int64(q.MaxPercentageSend)).QuoRaw(MaxPercentage)
+}
+
+// RecvCapacity returns the maximum net inflow the quota allows for the given channel value.
+func (q Quota) RecvCapacity(channelValue osmomath.Int) osmomath.Int {
+	return channelValue.MulRaw(int64(q.MaxPercentageRecv)).QuoRaw(MaxPercentage)
+}
+
+// Validate validates that the quota is named, its percentages are at most MaxPercentage and its duration is positive.
+func (q Quota) Validate() error {
+	if q.Name == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "quota name cannot be empty")
+	}
+	if q.MaxPercentageSend > MaxPercentage || q.MaxPercentageRecv > MaxPercentage {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "quota %s percentages cannot be greater than %d", q.Name, MaxPercentage)
+	}
+	if q.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "quota %s duration must be positive, got %s", q.Name, q.Duration)
+	}
+	return nil
+}
+
+// ValidateQuotas validates the quotas and that their names are unique.
+func ValidateQuotas(quotas []Quota) error {
+	names := make(map[string]struct{}, len(quotas))
+	for _, quota := range quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[quota.Name]; ok {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate quota name %s", quota.Name)
+		}
+		names[quota.Name] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateRateLimitPath validates that the channel id is AnyChannel or a valid channel identifier,
+// and that the denom is valid.
+func ValidateRateLimitPath(channelId, denom string) error {
+	if channelId != AnyChannel {
+		if err := host.ChannelIdentifierValidator(channelId); err != nil {
+			return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+		}
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+	return nil
+}
+
+// Validate validates the path and quotas of the rate limit, and that it has a non-negative flow per quota.
+func (r RateLimit) Validate() error {
+	if err := ValidateRateLimitPath(r.ChannelId, r.Denom); err != nil {
+		return err
+	}
+	if len(r.Quotas) == 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "rate limit of %s over %s has no quotas", r.Denom, r.ChannelId)
+	}
+	if err := ValidateQuotas(r.Quotas); err != nil {
+		return err
+	}
+	if len(r.Flows) != len(r.Quotas) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "rate limit of %s over %s has %d flows for %d quotas", r.Denom, r.ChannelId, len(r.Flows), len(r.Quotas))
+	}
+	for _, flow := range r.Flows {
+		if flow.Inflow.IsNil() || flow.Outflow.IsNil() || flow.ChannelValue.IsNil() ||
+			flow.Inflow.IsNegative() || flow.Outflow.IsNegative() || flow.ChannelValue.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "rate limit of %s over %s has an invalid flow", r.Denom, r.ChannelId)
+		}
+	}
+	return nil
+}
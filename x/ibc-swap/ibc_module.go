@@ -0,0 +1,177 @@
+package ibc_swap
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-swap/types"
+)
+
+var _ porttypes.IBCModule = &IBCModule{}
+
+// IBCModule is the transfer stack middleware swapping the tokens received by ICS20 packets with a swap memo.
+// Every other packet, and every other callback, is passed down the stack unchanged.
+type IBCModule struct {
+	app     porttypes.IBCModule
+	swapper *Swapper
+}
+
+func NewIBCModule(app porttypes.IBCModule, swapper *Swapper) IBCModule {
+	return IBCModule{
+		app:     app,
+		swapper: swapper,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im *IBCModule) OnChanOpenInit(ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im *IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im *IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im *IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im *IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im *IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// The tokens of ICS20 packets with a swap memo are received to the intermediate account of their sender,
+// swapped and sent out as instructed by the memo. If any of it fails, an error acknowledgement is returned,
+// which discards all state changes and refunds the tokens to the sender on the counterparty chain.
+func (im *IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	if !im.swapper.ProperlyConfigured() {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	isSwapRouted, swapMemo, err := types.ParseSwapMemo(data.GetMemo())
+	if !isSwapRouted {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	// The tokens are received to the intermediate account of the sender by overriding the packet's receiver,
+	// and swapped from it once the rest of the stack has received them.
+	sender := types.DeriveIntermediateSender(packet.GetDestChannel(), data.GetSender())
+	data.Receiver = sender.String()
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadPacket, err.Error())
+	}
+	packet.Data = bz
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	amount, ok := osmomath.NewIntFromString(data.GetAmount())
+	if !ok {
+		// This should never happen, as it should've been caught in the underlying call to OnRecvPacket,
+		// but returning here for completeness
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadPacket, "amount is not an int")
+	}
+	tokenIn := sdk.NewCoin(osmoutils.MustExtractDenomFromPacketOnRecv(packet), amount)
+
+	tokenOut, err := im.swapper.SwapAndForward(ctx, sender, tokenIn, swapMemo)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	bz, err = json.Marshal(types.SwapAck{TokenOut: tokenOut, IbcAck: ack.Acknowledgement()})
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadPacket, err.Error())
+	}
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im *IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im *IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,179 @@
+package ibc_swap_test
+
+import (
+	"context"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	ibcswap "github.com/osmosis-labs/osmosis/v26/x/ibc-swap"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-swap/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+var mockTransferAck = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+// mockTransferModule stands for the rest of the transfer stack, receiving the tokens of ICS20 packets
+// by funding their receiver, or failing to receive them.
+type mockTransferModule struct {
+	porttypes.IBCModule
+	fund   func(receiver sdk.AccAddress, coins sdk.Coins)
+	failed bool
+}
+
+func (m mockTransferModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	if m.failed {
+		return channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		panic(err)
+	}
+	amount, _ := osmomath.NewIntFromString(data.Amount)
+	m.fund(sdk.MustAccAddressFromBech32(data.Receiver), sdk.NewCoins(sdk.NewCoin(osmoutils.MustExtractDenomFromPacketOnRecv(packet), amount)))
+	return mockTransferAck
+}
+
+// mockTransferKeeper records the forwarded transfers instead of sending them.
+type mockTransferKeeper struct {
+	msgs []*transfertypes.MsgTransfer
+}
+
+func (m *mockTransferKeeper) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	m.msgs = append(m.msgs, msg)
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(m.msgs))}, nil
+}
+
+func (s *SwapperTestSuite) TestOnRecvPacket() {
+	tokenIn := sdk.NewCoin(apptesting.FOO, osmomath.NewInt(10_000))
+	originalSender := "cosmos1sender"
+	forwardReceiver := "cosmos1receiver"
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+
+	tests := map[string]struct {
+		tokenOutMinAmount osmomath.Int
+		forward           *types.ForwardMemo
+		receiver          sdk.AccAddress
+		recoveryAddress   sdk.AccAddress
+		transferFailed    bool
+		expectedErr       error
+	}{
+		"swap to a local receiver": {
+			tokenOutMinAmount: osmomath.OneInt(),
+		},
+		"swap and forward": {
+			tokenOutMinAmount: osmomath.OneInt(),
+			forward:           &types.ForwardMemo{Channel: "channel-2", Memo: "forwarded"},
+		},
+		"error: bad swap memo": {
+			tokenOutMinAmount: osmomath.ZeroInt(),
+			expectedErr:       types.ErrBadMemo,
+		},
+		"error: swap gives less than the min amount out": {
+			tokenOutMinAmount: tokenIn.Amount.MulRaw(10),
+			expectedErr:       types.ErrSwapFailed,
+		},
+		"error: the local receiver is a blocked module account": {
+			tokenOutMinAmount: osmomath.OneInt(),
+			receiver:          bondedPool,
+			expectedErr:       types.ErrBadMemo,
+		},
+		"error: the recovery address is a blocked module account": {
+			tokenOutMinAmount: osmomath.OneInt(),
+			forward:           &types.ForwardMemo{Channel: "channel-2"},
+			recoveryAddress:   bondedPool,
+			expectedErr:       types.ErrBadMemo,
+		},
+		"error: the tokens are not received": {
+			tokenOutMinAmount: osmomath.OneInt(),
+			transferFailed:    true,
+			expectedErr:       transfertypes.ErrReceiveDisabled,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			poolId := s.PrepareBalancerPool()
+
+			transferKeeper := &mockTransferKeeper{}
+			swapper := ibcswap.NewSwapper(s.App.PoolManagerKeeper, s.App.BankKeeper, transferKeeper)
+			ibcModule := ibcswap.NewIBCModule(mockTransferModule{
+				fund:   func(receiver sdk.AccAddress, coins sdk.Coins) { s.FundAcc(receiver, coins) },
+				failed: tc.transferFailed,
+			}, &swapper)
+
+			swapMemo := types.SwapMemo{
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: apptesting.BAR}},
+				TokenOutMinAmount: tc.tokenOutMinAmount,
+				Receiver:          s.TestAccs[1].String(),
+				Forward:           tc.forward,
+			}
+			if tc.receiver != nil {
+				swapMemo.Receiver = tc.receiver.String()
+			}
+			if tc.forward != nil {
+				swapMemo.Receiver = forwardReceiver
+				swapMemo.RecoveryAddress = s.TestAccs[2].String()
+				if tc.recoveryAddress != nil {
+					swapMemo.RecoveryAddress = tc.recoveryAddress.String()
+				}
+			}
+			memo, err := json.Marshal(map[string]types.SwapMemo{types.MemoKey: swapMemo})
+			s.Require().NoError(err)
+
+			// The token in is sent back to this chain, so it is received with its base denom.
+			data := transfertypes.NewFungibleTokenPacketData("transfer/channel-0/"+tokenIn.Denom, tokenIn.Amount.String(), originalSender, s.TestAccs[0].String(), string(memo))
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+			sender := types.DeriveIntermediateSender("channel-1", originalSender)
+
+			expectedAmountOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, swapMemo.Routes, tokenIn)
+			s.Require().NoError(err)
+			expectedTokenOut := sdk.NewCoin(apptesting.BAR, expectedAmountOut)
+
+			ack := ibcModule.OnRecvPacket(s.Ctx, packet, s.TestAccs[0])
+
+			if tc.expectedErr != nil {
+				s.Require().Equal(channeltypes.NewErrorAcknowledgement(tc.expectedErr), ack)
+				s.Require().Empty(transferKeeper.msgs)
+				s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, bondedPool, apptesting.BAR).IsZero())
+				return
+			}
+			s.Require().True(ack.Success())
+
+			var swapAck types.SwapAck
+			s.Require().NoError(json.Unmarshal(ack.(channeltypes.Acknowledgement).GetResult(), &swapAck))
+			s.Require().Equal(types.SwapAck{TokenOut: expectedTokenOut, IbcAck: mockTransferAck.Acknowledgement()}, swapAck)
+
+			// The tokens received to the intermediate account are all swapped.
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, sender).IsZero())
+
+			if tc.forward == nil {
+				s.Require().Equal(expectedTokenOut, s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], apptesting.BAR))
+				s.Require().Empty(transferKeeper.msgs)
+				return
+			}
+
+			// The tokens out are forwarded from the recovery address.
+			s.Require().Equal(expectedTokenOut, s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[2], apptesting.BAR))
+			s.Require().Len(transferKeeper.msgs, 1)
+			msg := transferKeeper.msgs[0]
+			s.Require().Equal(tc.forward.Channel, msg.SourceChannel)
+			s.Require().Equal(expectedTokenOut, msg.Token)
+			s.Require().Equal(s.TestAccs[2].String(), msg.Sender)
+			s.Require().Equal(forwardReceiver, msg.Receiver)
+			s.Require().Equal(tc.forward.Memo, msg.Memo)
+		})
+	}
+}
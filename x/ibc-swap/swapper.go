@@ -0,0 +1,109 @@
+package ibc_swap
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-swap/types"
+)
+
+// Swapper swaps the tokens received by ICS20 packets with a swap memo and sends the tokens out to their
+// receiver on this chain or forwards them to another chain.
+type Swapper struct {
+	PoolManagerKeeper types.PoolManagerKeeper
+	bankKeeper        types.BankKeeper
+	transferKeeper    types.TransferKeeper
+}
+
+// NewSwapper returns a swapper. The pool manager keeper can be set later, packets are not swapped until it is.
+func NewSwapper(poolManagerKeeper types.PoolManagerKeeper, bankKeeper types.BankKeeper, transferKeeper types.TransferKeeper) Swapper {
+	return Swapper{
+		PoolManagerKeeper: poolManagerKeeper,
+		bankKeeper:        bankKeeper,
+		transferKeeper:    transferKeeper,
+	}
+}
+
+// ProperlyConfigured returns whether the swapper has the keepers it needs to swap packets.
+func (s Swapper) ProperlyConfigured() bool {
+	return s.PoolManagerKeeper != nil && s.bankKeeper != nil && s.transferKeeper != nil
+}
+
+// SwapAndForward swaps tokenIn from sender along the routes of the swap memo and sends the tokens out to
+// its receiver, or transfers them to its receiver over its forward channel from its recovery address.
+// Returns the tokens out.
+//
+// Errors if the account the tokens out are sent to on this chain is blocked from receiving funds, if the swap
+// gives less than the minimum amount out, or if the forwarded packet cannot be sent.
+// Failures of the forwarded packet once it is sent refund the tokens out to the recovery address.
+func (s Swapper) SwapAndForward(ctx sdk.Context, sender sdk.AccAddress, tokenIn sdk.Coin, swapMemo types.SwapMemo) (sdk.Coin, error) {
+	// The memo is chosen by the remote sender, and the tokens out are sent with the bank keeper directly,
+	// so the blocked addresses, such as the staking pools, have to be checked here.
+	if swapMemo.Forward == nil {
+		if s.bankKeeper.BlockedAddr(sdk.MustAccAddressFromBech32(swapMemo.Receiver)) {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrBadMemo, "receiver %s is not allowed to receive funds", swapMemo.Receiver)
+		}
+	} else if s.bankKeeper.BlockedAddr(sdk.MustAccAddressFromBech32(swapMemo.RecoveryAddress)) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrBadMemo, "recovery address %s is not allowed to receive funds", swapMemo.RecoveryAddress)
+	}
+
+	tokenOutAmount, err := s.PoolManagerKeeper.RouteExactAmountIn(ctx, sender, swapMemo.Routes, tokenIn, swapMemo.TokenOutMinAmount)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrSwapFailed, err.Error())
+	}
+	tokenOut := sdk.NewCoin(swapMemo.TokenOutDenom(), tokenOutAmount)
+
+	event := sdk.NewEvent(
+		types.TypeEvtSwap,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenOut, tokenOut.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, swapMemo.Receiver),
+	)
+
+	if swapMemo.Forward == nil {
+		// The receiver was validated by the memo.
+		receiver := sdk.MustAccAddressFromBech32(swapMemo.Receiver)
+		if err := s.bankKeeper.SendCoins(ctx, sender, receiver, sdk.NewCoins(tokenOut)); err != nil {
+			return sdk.Coin{}, err
+		}
+		ctx.EventManager().EmitEvent(event)
+		return tokenOut, nil
+	}
+
+	// The tokens out are forwarded from the recovery address so that the transfer module refunds them to it.
+	recoveryAddress := sdk.MustAccAddressFromBech32(swapMemo.RecoveryAddress)
+	if err := s.bankKeeper.SendCoins(ctx, sender, recoveryAddress, sdk.NewCoins(tokenOut)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	msgTransfer := transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		swapMemo.Forward.Channel,
+		tokenOut,
+		swapMemo.RecoveryAddress,
+		swapMemo.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(swapMemo.Forward.Timeout()).UnixNano()),
+		swapMemo.Forward.Memo,
+	)
+	if err := msgTransfer.ValidateBasic(); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrForward, err.Error())
+	}
+	res, err := s.transferKeeper.Transfer(ctx, msgTransfer)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrForward, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(event.AppendAttributes(
+		sdk.NewAttribute(types.AttributeKeyForwardChannel, swapMemo.Forward.Channel),
+		sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(res.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecoveryAddress, swapMemo.RecoveryAddress),
+	))
+	return tokenOut, nil
+}
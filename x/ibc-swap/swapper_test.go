@@ -0,0 +1,78 @@
+package ibc_swap_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-swap/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+type SwapperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestSwapperTestSuite(t *testing.T) {
+	suite.Run(t, new(SwapperTestSuite))
+}
+
+func (s *SwapperTestSuite) TestSwapAndForward() {
+	tokenIn := sdk.NewCoin(apptesting.FOO, osmomath.NewInt(10_000))
+
+	tests := map[string]struct {
+		tokenOutMinAmount osmomath.Int
+		forward           *types.ForwardMemo
+		expectedErr       error
+	}{
+		"swap to a local receiver": {
+			tokenOutMinAmount: osmomath.OneInt(),
+		},
+		"swap gives less than the min amount out": {
+			tokenOutMinAmount: tokenIn.Amount.MulRaw(10),
+			expectedErr:       types.ErrSwapFailed,
+		},
+		"forward over a channel that does not exist": {
+			tokenOutMinAmount: osmomath.OneInt(),
+			forward:           &types.ForwardMemo{Channel: "channel-1000"},
+			expectedErr:       types.ErrForward,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			poolId := s.PrepareBalancerPool()
+			sender := types.DeriveIntermediateSender("channel-0", "cosmos1sender")
+			s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+			swapMemo := types.SwapMemo{
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: apptesting.BAR}},
+				TokenOutMinAmount: tc.tokenOutMinAmount,
+				Receiver:          s.TestAccs[1].String(),
+				Forward:           tc.forward,
+			}
+			if tc.forward != nil {
+				swapMemo.Receiver = "cosmos1receiver"
+				swapMemo.RecoveryAddress = s.TestAccs[2].String()
+			}
+			s.Require().NoError(swapMemo.Validate())
+			expectedAmountOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, swapMemo.Routes, tokenIn)
+			s.Require().NoError(err)
+
+			tokenOut, err := s.App.IBCSwapper.SwapAndForward(s.Ctx, sender, tokenIn, swapMemo)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(sdk.NewCoin(apptesting.BAR, expectedAmountOut), tokenOut)
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, sender).IsZero())
+			s.Require().Equal(tokenOut, s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], apptesting.BAR))
+		})
+	}
+}
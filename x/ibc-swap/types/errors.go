@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrBadMemo    = errorsmod.Register(ModuleName, 2, "bad swap memo")
+	ErrBadPacket  = errorsmod.Register(ModuleName, 3, "bad packet")
+	ErrSwapFailed = errorsmod.Register(ModuleName, 4, "swap failed")
+	ErrForward    = errorsmod.Register(ModuleName, 5, "forward failed")
+)
@@ -0,0 +1,13 @@
+package types
+
+const (
+	TypeEvtSwap = "ibc_swap"
+
+	AttributeKeySender          = "sender"
+	AttributeKeyTokenIn         = "token_in"
+	AttributeKeyTokenOut        = "token_out"
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyRecoveryAddress = "recovery_address"
+)
@@ -0,0 +1,11 @@
+package types
+
+const (
+	ModuleName = "ibc-swap"
+
+	// MemoKey is the key of the swap instruction in the memo of ICS20 packets.
+	MemoKey = "swap"
+
+	// SenderPrefix is the prefix the intermediate accounts swapping the tokens of packets are derived with.
+	SenderPrefix = "ibc-swap-intermediary"
+)
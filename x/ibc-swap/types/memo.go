@@ -0,0 +1,135 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// DefaultForwardTimeout is the timeout of forwarded packets whose swap memo does not set one.
+const DefaultForwardTimeout = 10 * time.Minute
+
+// conflictingMemoKeys are the memo keys of other middlewares of the transfer stack, which handle the
+// received tokens themselves and cannot be combined with a swap.
+var conflictingMemoKeys = []string{"wasm", "forward"}
+
+// SwapMemo is the instruction in the memo of an ICS20 packet to swap the received tokens along Routes for
+// at least TokenOutMinAmount, and to send the tokens out to Receiver.
+//
+// Without Forward, Receiver is an address on this chain. With Forward, the tokens out are transferred to
+// Receiver on the chain at the other end of the forward channel, from RecoveryAddress, which the tokens
+// are refunded to if the transfer fails.
+type SwapMemo struct {
+	Routes            []poolmanagertypes.SwapAmountInRoute `json:"routes"`
+	TokenOutMinAmount osmomath.Int                         `json:"token_out_min_amount"`
+	Receiver          string                               `json:"receiver"`
+	Forward           *ForwardMemo                         `json:"forward,omitempty"`
+	RecoveryAddress   string                               `json:"recovery_address,omitempty"`
+}
+
+// ForwardMemo is the instruction to transfer the tokens out of a swap over Channel.
+type ForwardMemo struct {
+	Channel        string `json:"channel"`
+	TimeoutSeconds uint64 `json:"timeout_seconds,omitempty"`
+	Memo           string `json:"memo,omitempty"`
+}
+
+// Timeout returns the timeout of the forwarded packet, defaulting to DefaultForwardTimeout.
+func (f ForwardMemo) Timeout() time.Duration {
+	if f.TimeoutSeconds == 0 {
+		return DefaultForwardTimeout
+	}
+	return time.Duration(f.TimeoutSeconds) * time.Second
+}
+
+// ParseSwapMemo parses the swap instruction of the memo of an ICS20 packet.
+// Returns false if the memo has no swap instruction, in which case the packet is not swapped.
+func ParseSwapMemo(memo string) (isSwapRouted bool, swapMemo SwapMemo, err error) {
+	if len(memo) == 0 {
+		return false, SwapMemo{}, nil
+	}
+
+	// Memos that are not JSON objects are not swap instructions.
+	metadata := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return false, SwapMemo{}, nil
+	}
+	swapRaw, ok := metadata[MemoKey]
+	if !ok {
+		return false, SwapMemo{}, nil
+	}
+
+	for _, key := range conflictingMemoKeys {
+		if _, ok := metadata[key]; ok {
+			return true, SwapMemo{}, errorsmod.Wrapf(ErrBadMemo, "swap cannot be combined with %s", key)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(swapRaw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&swapMemo); err != nil {
+		return true, SwapMemo{}, errorsmod.Wrap(ErrBadMemo, err.Error())
+	}
+	if err := swapMemo.Validate(); err != nil {
+		return true, SwapMemo{}, err
+	}
+	return true, swapMemo, nil
+}
+
+// Validate checks that the swap memo has a valid route, a positive minimum amount out and valid addresses.
+func (m SwapMemo) Validate() error {
+	if err := poolmanagertypes.SwapAmountInRoutes(m.Routes).Validate(); err != nil {
+		return errorsmod.Wrap(ErrBadMemo, err.Error())
+	}
+
+	if m.TokenOutMinAmount.IsNil() || !m.TokenOutMinAmount.IsPositive() {
+		return errorsmod.Wrapf(ErrBadMemo, "token out min amount must be positive")
+	}
+
+	if m.Forward == nil {
+		if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+			return errorsmod.Wrapf(ErrBadMemo, "invalid receiver %s: %s", m.Receiver, err)
+		}
+		if m.RecoveryAddress != "" {
+			return errorsmod.Wrapf(ErrBadMemo, "recovery address is only used to forward")
+		}
+		return nil
+	}
+
+	if m.Receiver == "" {
+		return errorsmod.Wrapf(ErrBadMemo, "receiver cannot be empty")
+	}
+	if err := host.ChannelIdentifierValidator(m.Forward.Channel); err != nil {
+		return errorsmod.Wrapf(ErrBadMemo, "invalid forward channel %s: %s", m.Forward.Channel, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.RecoveryAddress); err != nil {
+		return errorsmod.Wrapf(ErrBadMemo, "invalid recovery address %s: %s", m.RecoveryAddress, err)
+	}
+	return nil
+}
+
+// TokenOutDenom returns the denom the swap memo swaps to.
+func (m SwapMemo) TokenOutDenom() string {
+	return m.Routes[len(m.Routes)-1].TokenOutDenom
+}
+
+// DeriveIntermediateSender returns the account the tokens a sender sends over a channel with a swap memo are
+// received to and swapped from. It is derived from both, so that it cannot be controlled by anyone else.
+func DeriveIntermediateSender(channel, originalSender string) sdk.AccAddress {
+	return address.Hash(SenderPrefix, []byte(fmt.Sprintf("%s/%s", channel, originalSender)))
+}
+
+// SwapAck is the result of the acknowledgement of a packet whose tokens were swapped.
+type SwapAck struct {
+	TokenOut sdk.Coin `json:"token_out"`
+	IbcAck   []byte   `json:"ibc_ack"`
+}
@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/ibc-swap/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func TestParseSwapMemo(t *testing.T) {
+	receiver := sdk.AccAddress([]byte("receiver____________")).String()
+	recoveryAddress := sdk.AccAddress([]byte("recovery____________")).String()
+	routes := `[{"pool_id":1,"token_out_denom":"uosmo"},{"pool_id":2,"token_out_denom":"uatom"}]`
+
+	tests := map[string]struct {
+		memo             string
+		expectSwapRouted bool
+		expectedMemo     types.SwapMemo
+		expectErr        bool
+	}{
+		"empty memo": {
+			memo: "",
+		},
+		"memo that is not json": {
+			memo: "hello",
+		},
+		"memo without swap": {
+			memo: `{"forward":{"receiver":"cosmos1"}}`,
+		},
+		"swap to a local receiver": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"token_out_min_amount":"100","receiver":"%s"}}`, routes, receiver),
+			expectSwapRouted: true,
+			expectedMemo: types.SwapMemo{
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}, {PoolId: 2, TokenOutDenom: "uatom"}},
+				TokenOutMinAmount: osmomath.NewInt(100),
+				Receiver:          receiver,
+			},
+		},
+		"swap and forward": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"token_out_min_amount":"100","receiver":"cosmos1remote","forward":{"channel":"channel-0","timeout_seconds":60},"recovery_address":"%s"}}`, routes, recoveryAddress),
+			expectSwapRouted: true,
+			expectedMemo: types.SwapMemo{
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}, {PoolId: 2, TokenOutDenom: "uatom"}},
+				TokenOutMinAmount: osmomath.NewInt(100),
+				Receiver:          "cosmos1remote",
+				Forward:           &types.ForwardMemo{Channel: "channel-0", TimeoutSeconds: 60},
+				RecoveryAddress:   recoveryAddress,
+			},
+		},
+		"swap is not an object": {
+			memo:             `{"swap":"uosmo"}`,
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+		"unknown field": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"token_out_min_amount":"100","receiver":"%s","slippage":"1"}}`, routes, receiver),
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+		"swap combined with wasm": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"token_out_min_amount":"100","receiver":"%s"},"wasm":{}}`, routes, receiver),
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+		"empty routes": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":[],"token_out_min_amount":"100","receiver":"%s"}}`, receiver),
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+		"no token out min amount": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"receiver":"%s"}}`, routes, receiver),
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+		"invalid local receiver": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"token_out_min_amount":"100","receiver":"cosmos1remote"}}`, routes),
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+		"recovery address without forward": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"token_out_min_amount":"100","receiver":"%s","recovery_address":"%s"}}`, routes, receiver, recoveryAddress),
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+		"forward without recovery address": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"token_out_min_amount":"100","receiver":"cosmos1remote","forward":{"channel":"channel-0"}}}`, routes),
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+		"invalid forward channel": {
+			memo:             fmt.Sprintf(`{"swap":{"routes":%s,"token_out_min_amount":"100","receiver":"cosmos1remote","forward":{"channel":"0"},"recovery_address":"%s"}}`, routes, recoveryAddress),
+			expectSwapRouted: true,
+			expectErr:        true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			isSwapRouted, swapMemo, err := types.ParseSwapMemo(tc.memo)
+			require.Equal(t, tc.expectSwapRouted, isSwapRouted)
+			if tc.expectErr {
+				require.ErrorIs(t, err, types.ErrBadMemo)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMemo, swapMemo)
+		})
+	}
+}
+
+func TestForwardMemoTimeout(t *testing.T) {
+	require.Equal(t, types.DefaultForwardTimeout, types.ForwardMemo{}.Timeout())
+	require.Equal(t, time.Minute, types.ForwardMemo{TimeoutSeconds: 60}.Timeout())
+}
@@ -111,6 +111,21 @@ func TestGetCmdGaugesByPoolID(t *testing.T) {
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
 
+func TestGetCmdGaugesByRewardDenom(t *testing.T) {
+	desc, _ := GetCmdGaugesByRewardDenom()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryGaugesByRewardDenomRequest]{
+		"basic test": {
+			Cmd: "uosmo true",
+			ExpectedQuery: &types.QueryGaugesByRewardDenomRequest{
+				Denom:      "uosmo",
+				ActiveOnly: true,
+				Pagination: &query.PageRequest{Key: []uint8{}, Offset: 0, Limit: 100},
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func TestGetCmdExternalGauges(t *testing.T) {
 	desc, _ := GetCmdExternalGauges()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryExternalGaugesRequest]{
@@ -16,6 +16,13 @@ const (
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
 	FlagEndEpoch  = "end-epoch"
+
+	FlagRecipientAllowlist = "recipient-allowlist"
+	FlagRecipientDenylist  = "recipient-denylist"
+	FlagMinLockAmount      = "min-lock-amount"
+	FlagAlignStartToEpoch  = "align-start-to-epoch"
+
+	FlagEmissionDecayFactor = "emission-decay-factor"
 )
 
 // FlagSetCreateGauge returns flags for creating gauges.
@@ -27,5 +34,17 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.StringSlice(FlagRecipientAllowlist, []string{}, "Comma-separated lock reward receiver addresses that are the only ones receiving distributions")
+	fs.StringSlice(FlagRecipientDenylist, []string{}, "Comma-separated lock reward receiver addresses excluded from distributions")
+	fs.String(FlagMinLockAmount, "", "Minimum amount of the locked denom a lock must hold to receive distributions")
+	fs.Bool(FlagAlignStartToEpoch, false, "Move the start time forward to the next distribution epoch boundary, defaults the start time to now")
+	return fs
+}
+
+// FlagSetCreateGroup returns flags for creating groups.
+func FlagSetCreateGroup() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagEmissionDecayFactor, "", "Ratio in (0, 1) between consecutive epochs' emissions of the group, no decay if unset")
 	return fs
 }
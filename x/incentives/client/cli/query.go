@@ -37,6 +37,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdGaugesByPoolID)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdExternalGauges)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdInternalGauges)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdLockRewards)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdUpcomingEmissions)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdGaugesByRewardDenom)
 	cmd.AddCommand(
 		osmocli.GetParams[*types.ParamsRequest](
 			types.ModuleName, types.NewQueryClient),
@@ -295,3 +298,33 @@ func GetCmdInternalGauges() (*osmocli.QueryDescriptor, *types.QueryInternalGauge
 		Long:  `{{.Short}}`,
 	}, &types.QueryInternalGaugesRequest{}
 }
+
+// GetCmdLockRewards returns the rewards accrued to a lock that have not been claimed yet.
+func GetCmdLockRewards() (*osmocli.QueryDescriptor, *types.QueryLockRewardsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "lock-rewards [lock-id]",
+		Short: "Query the rewards accrued to a lock that have not been claimed yet.",
+		Long:  `{{.Short}}`,
+	}, &types.QueryLockRewardsRequest{}
+}
+
+// GetCmdUpcomingEmissions returns the projected per epoch emissions of the gauges attached to a pool.
+func GetCmdUpcomingEmissions() (*osmocli.QueryDescriptor, *types.QueryUpcomingEmissionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "upcoming-emissions [pool-id] [num-epochs]",
+		Short: "Query the projected per epoch emissions of all active and upcoming gauges attached to a pool.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} upcoming-emissions 1 7`,
+	}, &types.QueryUpcomingEmissionsRequest{}
+}
+
+// GetCmdGaugesByRewardDenom returns the gauges rewarding a denom, with their remaining balance of it and their end epoch.
+func GetCmdGaugesByRewardDenom() (*osmocli.QueryDescriptor, *types.QueryGaugesByRewardDenomRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "gauges-by-reward-denom [denom] [active-only]",
+		Short: "Query the gauges whose rewards include a denom, with their remaining balance of it and their end epoch.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} gauges-by-reward-denom uosmo false
+{{.CommandPrefix}} gauges-by-reward-denom uosmo true`,
+	}, &types.QueryGaugesByRewardDenomRequest{}
+}
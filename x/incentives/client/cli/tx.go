@@ -2,17 +2,20 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
@@ -29,7 +32,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewAddToGaugeAndExtendCmd(),
 		NewCreateGroupCmd(),
+		NewClaimRewardsCmd(),
 	)
 
 	return cmd
@@ -60,12 +65,19 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
+			alignStartToEpoch, err := cmd.Flags().GetBool(FlagAlignStartToEpoch)
+			if err != nil {
+				return err
+			}
+
 			var startTime time.Time
 			timeStr, err := cmd.Flags().GetString(FlagStartTime)
 			if err != nil {
 				return err
 			}
-			if timeStr == "" { // empty start time
+			if timeStr == "" && alignStartToEpoch { // aligned gauges cannot start far in the past
+				startTime = time.Now()
+			} else if timeStr == "" { // empty start time
 				startTime = time.Unix(0, 0)
 			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
 				startTime = time.Unix(timeUnix, 0)
@@ -126,6 +138,27 @@ func NewCreateGaugeCmd() *cobra.Command {
 				poolId,
 			)
 
+			msg.RecipientAllowlist, err = cmd.Flags().GetStringSlice(FlagRecipientAllowlist)
+			if err != nil {
+				return err
+			}
+			msg.RecipientDenylist, err = cmd.Flags().GetStringSlice(FlagRecipientDenylist)
+			if err != nil {
+				return err
+			}
+			minLockAmountStr, err := cmd.Flags().GetString(FlagMinLockAmount)
+			if err != nil {
+				return err
+			}
+			if minLockAmountStr != "" {
+				minLockAmount, ok := osmomath.NewIntFromString(minLockAmountStr)
+				if !ok {
+					return fmt.Errorf("invalid min lock amount: %s", minLockAmountStr)
+				}
+				msg.MinLockAmount = &minLockAmount
+			}
+			msg.AlignStartToEpoch = alignStartToEpoch
+
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
@@ -142,10 +175,48 @@ func NewAddToGaugeCmd() *cobra.Command {
 	})
 }
 
+func NewAddToGaugeAndExtendCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgAddToGaugeAndExtend](&osmocli.TxCliDesc{
+		Use:   "add-to-gauge-and-extend",
+		Short: "add coins to a non-perpetual gauge and extend the number of epochs it is paid over",
+		Long:  "add coins to a non-perpetual gauge and extend the number of epochs it is paid over. The gauge's emission per epoch must not decrease.",
+	})
+}
+
 func NewCreateGroupCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgCreateGroup](&osmocli.TxCliDesc{
 		Use:   "create-group",
 		Short: "create a group in order to split incentives between pools",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"EmissionDecay": osmocli.FlagOnlyParser(parseEmissionDecay),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetCreateGroup()}},
+	})
+}
+
+// parseEmissionDecay parses the emission decay of a group from its factor flag, returning nil if unset.
+func parseEmissionDecay(fs *flag.FlagSet) (*types.EmissionDecay, error) {
+	factorStr, err := fs.GetString(FlagEmissionDecayFactor)
+	if err != nil {
+		return nil, err
+	}
+	if factorStr == "" {
+		return nil, nil
+	}
+
+	factor, err := osmomath.NewDecFromStr(factorStr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.EmissionDecay{Factor: factor}, nil
+}
+
+func NewClaimRewardsCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgClaimRewards](&osmocli.TxCliDesc{
+		Use:     "claim-rewards",
+		Short:   "claim the rewards accrued to the given locks",
+		Long:    "claim the rewards accrued to the given locks. Rewards are only accrued to locks when lazy reward claiming is enabled.",
+		Example: "osmosisd tx incentives claim-rewards 1,2,3 --from=val --chain-id=osmosis-1",
 	})
 }
 
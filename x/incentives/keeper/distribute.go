@@ -9,10 +9,12 @@ import (
 	db "github.com/cosmos/cosmos-db"
 	"github.com/hashicorp/go-metrics"
 
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/coinutil"
 	"github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
@@ -51,7 +53,9 @@ type DistributionValueCache struct {
 // - group cannot outlive the gauges associated with it.
 // CONTRACT:
 // - every group in the input is active. If inactive group is passed in, it will be skipped.
-func (k Keeper) AllocateAcrossGauges(ctx sdk.Context, activeGroups []types.Group) error {
+// - epochNumber is the number of the distribution epoch that is ending, used to taper the emission of groups with
+// an emission decay.
+func (k Keeper) AllocateAcrossGauges(ctx sdk.Context, activeGroups []types.Group, epochNumber int64) error {
 	for _, group := range activeGroups {
 		err := k.syncGroupWeights(ctx, group)
 		if err != nil {
@@ -90,11 +94,17 @@ func (k Keeper) AllocateAcrossGauges(ctx sdk.Context, activeGroups []types.Group
 
 		// Get amount to distribute in coins (based on perpetual or non perpetual group gauge)
 		coinsToDistribute := groupGauge.Coins.Sub(groupGauge.DistributedCoins...)
-		if !groupGauge.IsPerpetual {
-			remainingEpochs := int64(groupGauge.NumEpochsPaidOver - groupGauge.FilledEpochs)
-
+		remainingEpochs := groupGauge.NumEpochsPaidOver - groupGauge.FilledEpochs
+		if group.EmissionDecay != nil {
+			// Taper the emission according to the group's decay schedule.
+			epochsElapsed := uint64(0)
+			if epochNumber > group.EmissionDecayStartEpoch {
+				epochsElapsed = uint64(epochNumber - group.EmissionDecayStartEpoch)
+			}
+			coinsToDistribute = group.EmissionDecay.DecayedEmission(coinsToDistribute, groupGauge.IsPerpetual, remainingEpochs, epochsElapsed)
+		} else if !groupGauge.IsPerpetual {
 			// Divide each coin by remainingEpochs
-			coinutil.QuoRawMut(coinsToDistribute, remainingEpochs)
+			coinutil.QuoRawMut(coinsToDistribute, int64(remainingEpochs))
 		}
 
 		// Exit early if nothing to distribute.
@@ -240,6 +250,7 @@ func (k Keeper) moveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gaug
 	if err := k.deleteGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
 		return err
 	}
+	k.deleteGaugeRewardDenomRefs(ctx, &gauge)
 	k.hooks.AfterFinishDistribution(ctx, gauge.Id)
 	return nil
 }
@@ -301,6 +312,9 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 		filteredDistrCoins = remainCoinsPerEpoch
 	}
 	for _, lock := range filteredLocks {
+		if !gauge.IsEligibleRecipient(&lock) {
+			continue
+		}
 		denomLockAmt := lock.Coins.AmountOf(gauge.DistributeTo.Denom)
 
 		for _, coin := range remainCoinsPerEpoch {
@@ -327,6 +341,19 @@ type distributionInfo struct {
 	idToBech32Addr                []string
 	idToDecodedRewardReceiverAddr []sdk.AccAddress
 	idToDistrCoins                []sdk.Coins
+
+	// lazyRewardClaiming determines whether lock rewards are accrued to lock reward records instead of being sent.
+	lazyRewardClaiming bool
+	// accruedLockIDs holds the IDs of the locks rewards were accrued to, in the order they were first accrued to.
+	accruedLockIDs         []uint64
+	lockIDToAccruedRewards map[uint64]types.LockRewardRecord
+
+	// hookedLockIDs holds the IDs of the locks rewarded in denoms with a tokenfactory before send hook,
+	// in the order they were first rewarded. These rewards are sent per lock rather than in bulk.
+	hookedLockIDs         []uint64
+	lockIDToHookedRewards map[uint64]types.LockRewardRecord
+	// denomHasBeforeSendHook caches whether a reward denom has a tokenfactory before send hook.
+	denomHasBeforeSendHook map[string]bool
 }
 
 // newDistributionInfo creates a new distributionInfo struct
@@ -338,6 +365,11 @@ func newDistributionInfo() distributionInfo {
 		idToBech32Addr:                []string{},
 		idToDecodedRewardReceiverAddr: []sdk.AccAddress{},
 		idToDistrCoins:                []sdk.Coins{},
+		accruedLockIDs:                []uint64{},
+		lockIDToAccruedRewards:        make(map[uint64]types.LockRewardRecord),
+		hookedLockIDs:                 []uint64{},
+		lockIDToHookedRewards:         make(map[uint64]types.LockRewardRecord),
+		denomHasBeforeSendHook:        make(map[string]bool),
 	}
 }
 
@@ -363,6 +395,46 @@ func (d *distributionInfo) addLockRewards(owner, rewardReceiver string, rewards
 	return nil
 }
 
+// addAccruedLockRewards adds the provided rewards to the rewards accrued to the provided lock.
+func (d *distributionInfo) addAccruedLockRewards(lock *lockuptypes.PeriodLock, rewards sdk.Coins) {
+	record, ok := d.lockIDToAccruedRewards[lock.ID]
+	if !ok {
+		d.accruedLockIDs = append(d.accruedLockIDs, lock.ID)
+		record = types.LockRewardRecord{LockId: lock.ID, Owner: lock.Owner, RewardReceiver: lock.RewardReceiverAddress}
+	}
+	record.Rewards = record.Rewards.Add(rewards...)
+	d.lockIDToAccruedRewards[lock.ID] = record
+}
+
+// addHookedLockRewards adds the provided rewards, in denoms with a before send hook, to the rewards to send to the provided lock.
+func (d *distributionInfo) addHookedLockRewards(lock *lockuptypes.PeriodLock, rewards sdk.Coins) {
+	record, ok := d.lockIDToHookedRewards[lock.ID]
+	if !ok {
+		d.hookedLockIDs = append(d.hookedLockIDs, lock.ID)
+		record = types.LockRewardRecord{LockId: lock.ID, Owner: lock.Owner, RewardReceiver: lock.RewardReceiverAddress}
+	}
+	record.Rewards = record.Rewards.Add(rewards...)
+	d.lockIDToHookedRewards[lock.ID] = record
+}
+
+// splitBeforeSendHookCoins splits the provided coins into the coins in denoms with a tokenfactory before send hook
+// and the remaining coins.
+func (k Keeper) splitBeforeSendHookCoins(ctx sdk.Context, distrs *distributionInfo, coins sdk.Coins) (hookedCoins, plainCoins sdk.Coins) {
+	for _, coin := range coins {
+		hasHook, ok := distrs.denomHasBeforeSendHook[coin.Denom]
+		if !ok {
+			hasHook = k.tfk != nil && k.tfk.GetBeforeSendHook(ctx, coin.Denom) != ""
+			distrs.denomHasBeforeSendHook[coin.Denom] = hasHook
+		}
+		if hasHook {
+			hookedCoins = append(hookedCoins, coin)
+		} else {
+			plainCoins = append(plainCoins, coin)
+		}
+	}
+	return hookedCoins, plainCoins
+}
+
 // doDistributionSends utilizes provided distributionInfo to send coins from the module account to various recipients.
 func (k Keeper) doDistributionSends(ctx sdk.Context, distrs *distributionInfo) error {
 	numIDs := len(distrs.idToDecodedRewardReceiverAddr)
@@ -392,6 +464,111 @@ func (k Keeper) doDistributionSends(ctx sdk.Context, distrs *distributionInfo) e
 	return nil
 }
 
+// doDistributionAccruals utilizes provided distributionInfo to add the rewards accrued to locks to their lock reward records.
+// The rewards stay in the module account until they are claimed by the lock owners.
+func (k Keeper) doDistributionAccruals(ctx sdk.Context, distrs *distributionInfo) {
+	numLocks := len(distrs.accruedLockIDs)
+	if numLocks == 0 {
+		return
+	}
+
+	totalAccruedRewards := sdk.NewCoins()
+	for _, lockID := range distrs.accruedLockIDs {
+		record := distrs.lockIDToAccruedRewards[lockID]
+		k.accrueLockRewards(ctx, record)
+		totalAccruedRewards = totalAccruedRewards.Add(record.Rewards...)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRewardsAccrued,
+			sdk.NewAttribute(types.AttributeNumLocks, strconv.Itoa(numLocks)),
+			sdk.NewAttribute(types.AttributeAmount, totalAccruedRewards.String()),
+		),
+	})
+	ctx.Logger().Debug(fmt.Sprintf("Finished accruing rewards to %d locks", numLocks))
+}
+
+// doHookedDistributionSends sends the rewards in denoms with a tokenfactory before send hook to the reward receivers of their locks.
+// Since every send runs the hooks, each lock's rewards are sent on their own, so that a reverting hook only fails the
+// send to its recipient instead of the whole distribution. The sends share a gas budget of MaxBeforeSendHookRewardsGas,
+// bounding the gas the hooks can use in the epoch block.
+// The rewards of a failed send, or of the sends left once the budget is spent, are accrued to their lock instead,
+// to be claimed by the lock owner, and the failure is reported through an event and a telemetry counter.
+func (k Keeper) doHookedDistributionSends(ctx sdk.Context, distrs *distributionInfo) {
+	gasUsed := uint64(0)
+	for _, lockID := range distrs.hookedLockIDs {
+		record := distrs.lockIDToHookedRewards[lockID]
+		// if the reward receiver is an empty string, it indicates that the owner is the reward receiver.
+		rewardReceiver := record.RewardReceiver
+		if rewardReceiver == "" {
+			rewardReceiver = record.Owner
+		}
+
+		var err error
+		if gasUsed >= types.MaxBeforeSendHookRewardsGas {
+			err = errors.New("before send hook rewards gas budget exhausted")
+		} else {
+			var sendGasUsed uint64
+			sendGasUsed, err = k.sendHookedRewards(ctx, rewardReceiver, record.Rewards, types.MaxBeforeSendHookRewardsGas-gasUsed)
+			gasUsed += sendGasUsed
+		}
+		if err == nil {
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtDistribution,
+					sdk.NewAttribute(types.AttributeReceiver, rewardReceiver),
+					sdk.NewAttribute(types.AttributeAmount, record.Rewards.String()),
+				),
+			})
+			continue
+		}
+
+		k.accrueLockRewards(ctx, record)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtRewardSendFailed,
+				sdk.NewAttribute(types.AttributeLockID, osmoutils.Uint64ToString(lockID)),
+				sdk.NewAttribute(types.AttributeReceiver, rewardReceiver),
+				sdk.NewAttribute(types.AttributeAmount, record.Rewards.String()),
+				sdk.NewAttribute(types.AttributeError, err.Error()),
+			),
+		})
+		// the lock ID and error are only reported in the event, as labels must have a bounded set of values.
+		for _, coin := range record.Rewards {
+			telemetry.IncrCounterWithLabels([]string{types.RewardSendFailureMetricName}, 1, []metrics.Label{
+				{
+					Name:  "denom",
+					Value: coin.Denom,
+				},
+			})
+		}
+	}
+}
+
+// sendHookedRewards sends the rewards from the module account to the reward receiver with the given gas limit.
+// The send is applied only if it succeeds, and any panic, including running out of gas, is returned as an error.
+// Returns the gas used by the send.
+func (k Keeper) sendHookedRewards(ctx sdk.Context, rewardReceiver string, rewards sdk.Coins, gasLimit uint64) (gasUsed uint64, err error) {
+	rewardReceiverAddr, err := sdk.AccAddressFromBech32(rewardReceiver)
+	if err != nil {
+		return 0, err
+	}
+
+	childCtx := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while sending rewards: %v", r)
+		}
+		gasUsed = childCtx.GasMeter().GasConsumedToLimit()
+	}()
+
+	err = osmoutils.ApplyFuncIfNoError(childCtx, func(cacheCtx sdk.Context) error {
+		return k.bk.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, rewardReceiverAddr, rewards)
+	})
+	return gasUsed, err
+}
+
 // distributeSyntheticInternal runs the distribution logic for a synthetic rewards distribution gauge, and adds the sends to
 // the distrInfo struct. It also updates the gauge for the distribution.
 // locks is expected to be the correct set of lock recipients for this gauge.
@@ -444,7 +621,8 @@ func (k Keeper) distributeSyntheticInternal(
 // - the splitting policy is not supported
 // - a lower level issue arises when syncing weights (e.g. the volume for a linked pool cannot be found under volume-splitting policy)
 func (k Keeper) syncGroupWeights(ctx sdk.Context, group types.Group) error {
-	if group.SplittingPolicy == types.ByVolume {
+	switch group.SplittingPolicy {
+	case types.ByVolume:
 		err := k.syncVolumeSplitGroup(ctx, group)
 		// This error implies that there was volume initialized at some point
 		// but has not been updated since the last epoch.
@@ -452,13 +630,35 @@ func (k Keeper) syncGroupWeights(ctx sdk.Context, group types.Group) error {
 		if err != nil && !errors.As(err, &types.NoVolumeSinceLastSyncError{}) {
 			return err
 		}
-	} else {
+	case types.Evenly:
+		k.SetGroup(ctx, calculateEvenSplitGroupWeights(group))
+	default:
 		return types.UnsupportedSplittingPolicyError{GroupGaugeId: group.GroupGaugeId, SplittingPolicy: group.SplittingPolicy}
 	}
 
 	return nil
 }
 
+// calculateEvenSplitGroupWeights calculates the weights of the group records under the even splitting policy,
+// assigning the same weight to every record. It does not mutate the passed in object.
+func calculateEvenSplitGroupWeights(group types.Group) types.Group {
+	updatedGroup := types.Group{
+		GroupGaugeId: group.GroupGaugeId,
+		InternalGaugeInfo: types.InternalGaugeInfo{
+			TotalWeight:  osmomath.NewInt(int64(len(group.InternalGaugeInfo.GaugeRecords))),
+			GaugeRecords: make([]types.InternalGaugeRecord, len(group.InternalGaugeInfo.GaugeRecords)),
+		},
+		SplittingPolicy: group.SplittingPolicy,
+	}
+
+	for i, gaugeRecord := range group.InternalGaugeInfo.GaugeRecords {
+		gaugeRecord.CurrentWeight = osmomath.OneInt()
+		updatedGroup.InternalGaugeInfo.GaugeRecords[i] = gaugeRecord
+	}
+
+	return updatedGroup
+}
+
 // calculateGroupWeights calculates the updated weights of the group records based on the pool volumes.
 // It returns the updated group and an error if any. It does not mutate the passed in object.
 func (k Keeper) calculateGroupWeights(ctx sdk.Context, group types.Group) (types.Group, error) {
@@ -700,6 +900,13 @@ func (k Keeper) distributeInternal(
 			totalDistrCoins = totalDistrCoins.Add(remainCoinPerEpoch)
 		}
 	} else {
+		// Exclude the locks whose owners are not eligible per the gauge's recipient allowlist or denylist.
+		// Their share is distributed to the remaining locks.
+		locks = filterLocksByRecipientLists(gauge, locks)
+		// Exclude the locks holding less than the gauge's min lock amount, whose rewards would cost
+		// more to pay out than they are worth. Their share is distributed to the remaining locks.
+		locks = filterLocksByMinLockAmount(gauge, locks)
+
 		// This is a standard lock distribution flow that assumes that we have locks associated with the gauge.
 		isSpam, totaltotalDistrCoins, err := k.skipSpamGaugeDistribute(ctx, locks, gauge, totalDistrCoins, remainCoins)
 		if isSpam {
@@ -798,6 +1005,24 @@ func (k Keeper) distributeInternal(
 			if distrCoins.Empty() {
 				continue
 			}
+			// if lazy reward claiming is enabled, accrue the rewards to the lock instead of sending them.
+			if distrInfo.lazyRewardClaiming {
+				distrInfo.addAccruedLockRewards(lock, distrCoins)
+				totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+				continue
+			}
+
+			totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+
+			// rewards in denoms with a before send hook are sent per lock, isolated from the other sends.
+			hookedCoins, plainCoins := k.splitBeforeSendHookCoins(ctx, distrInfo, distrCoins)
+			if !hookedCoins.Empty() {
+				distrInfo.addHookedLockRewards(lock, hookedCoins)
+			}
+			if plainCoins.Empty() {
+				continue
+			}
+
 			// update the amount for that address
 			rewardReceiver := lock.RewardReceiverAddress
 
@@ -805,12 +1030,10 @@ func (k Keeper) distributeInternal(
 			if rewardReceiver == "" {
 				rewardReceiver = lock.Owner
 			}
-			err := distrInfo.addLockRewards(lock.Owner, rewardReceiver, distrCoins)
+			err := distrInfo.addLockRewards(lock.Owner, rewardReceiver, plainCoins)
 			if err != nil {
 				return nil, err
 			}
-
-			totalDistrCoins = totalDistrCoins.Add(distrCoins...)
 		}
 	}
 
@@ -818,11 +1041,51 @@ func (k Keeper) distributeInternal(
 	return totalDistrCoins, err
 }
 
+// filterLocksByRecipientLists returns the locks whose reward receivers are eligible to receive distributions
+// from the gauge according to its recipient allowlist or denylist.
+// Returns the given locks as is if the gauge has no recipient list.
+func filterLocksByRecipientLists(gauge types.Gauge, locks []*lockuptypes.PeriodLock) []*lockuptypes.PeriodLock {
+	if !gauge.HasRecipientList() {
+		return locks
+	}
+
+	filteredLocks := make([]*lockuptypes.PeriodLock, 0, len(locks))
+	for _, lock := range locks {
+		if gauge.IsEligibleRecipient(lock) {
+			filteredLocks = append(filteredLocks, lock)
+		}
+	}
+	return filteredLocks
+}
+
+// filterLocksByMinLockAmount returns the locks holding at least the gauge's min lock amount
+// of the distributed denom.
+// Returns the given locks as is if the gauge has no min lock amount.
+func filterLocksByMinLockAmount(gauge types.Gauge, locks []*lockuptypes.PeriodLock) []*lockuptypes.PeriodLock {
+	if !gauge.HasMinLockAmount() {
+		return locks
+	}
+
+	denom := lockuptypes.NativeDenom(gauge.DistributeTo.Denom)
+	filteredLocks := make([]*lockuptypes.PeriodLock, 0, len(locks))
+	for _, lock := range locks {
+		if lock.Coins.AmountOf(denom).GTE(*gauge.MinLockAmount) {
+			filteredLocks = append(filteredLocks, lock)
+		}
+	}
+	return filteredLocks
+}
+
 func (k Keeper) skipSpamGaugeDistribute(ctx sdk.Context, locks []*lockuptypes.PeriodLock, gauge types.Gauge, totalDistrCoins sdk.Coins, remainCoins sdk.Coins) (bool, sdk.Coins, error) {
 	if len(locks) == 0 {
 		return true, nil, nil
 	}
+	return k.skipSpamGaugeCoins(ctx, gauge, totalDistrCoins, remainCoins)
+}
 
+// skipSpamGaugeCoins skips the distribution of gauges whose remaining coins are empty or not worth distributing,
+// only updating the gauge for the distribution.
+func (k Keeper) skipSpamGaugeCoins(ctx sdk.Context, gauge types.Gauge, totalDistrCoins sdk.Coins, remainCoins sdk.Coins) (bool, sdk.Coins, error) {
 	// In this case, remove redundant cases.
 	// Namely: gauge empty OR gauge coins undistributable.
 	if remainCoins.Empty() {
@@ -884,6 +1147,7 @@ func (k Keeper) handleGroupPostDistribute(ctx sdk.Context, groupGauge types.Gaug
 		store.Delete(types.KeyGroupByGaugeID(groupGauge.Id))
 		// Delete the group gauge.
 		store.Delete(gaugeStoreKey(groupGauge.Id))
+		k.deleteGaugeRewardDenomRefs(ctx, &groupGauge)
 	} else {
 		// Update total coins distributed and filled epoch of the group gauge.
 		if err := k.updateGaugePostDistribute(ctx, groupGauge, coinsDistributed); err != nil {
@@ -916,7 +1180,9 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 // Skips any group gauges as they are handled separately in AllocateAcrossGauges()
 // CONTRACT: gauges must be active.
 func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
 	distrInfo := newDistributionInfo()
+	distrInfo.lazyRewardClaiming = params.LazyRewardClaiming
 
 	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
 	totalDistributedCoins := sdk.NewCoins()
@@ -927,12 +1193,26 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 	// While this isn't precise as it doesn't account for price impact, it is good enough for the sole
 	// purpose of determining if we should distribute the token or not.
 	minDistrValueCache := &DistributionValueCache{
-		minDistrValue:      k.GetParams(ctx).MinValueForDistribution,
+		minDistrValue:      params.MinValueForDistribution,
 		denomToMinValueMap: make(map[string]osmomath.Int),
 	}
 
 	for _, gauge := range gauges {
 		var gaugeDistributedCoins sdk.Coins
+		// if lazy reward claiming is enabled, gauges distributing to all locks of a denom and duration
+		// do not iterate over the locks, but distribute through the reward growth accumulators.
+		if distrInfo.lazyRewardClaiming && usesRewardGrowth(gauge) {
+			if gauge.Coins.Empty() {
+				continue
+			}
+			gaugeDistributedCoins, err := k.distributeThroughRewardGrowth(ctx, gauge)
+			if err != nil {
+				return nil, err
+			}
+			totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
+			continue
+		}
+
 		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache, &scratchSlice)
 		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		var err error
@@ -959,6 +1239,8 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 		// TODO: add test case to cover this
 		return nil, err
 	}
+	k.doHookedDistributionSends(ctx, &distrInfo)
+	k.doDistributionAccruals(ctx, &distrInfo)
 
 	k.hooks.AfterEpochDistribution(ctx)
 
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"os"
 	"time"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
@@ -17,6 +20,8 @@ import (
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 	poolincentivetypes "github.com/osmosis-labs/osmosis/v26/x/pool-incentives/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v26/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v26/x/tokenfactory/types"
 )
 
 var _ = suite.TestingSuite(nil)
@@ -303,6 +308,281 @@ func (s *KeeperTestSuite) TestDistribute() {
 	}
 }
 
+// TestDistribute_RecipientLists tests that gauge distributions respect the gauge's
+// recipient allowlist and denylist, and that the share of excluded locks goes to the remaining locks.
+func (s *KeeperTestSuite) TestDistribute_RecipientLists() {
+	noRewardCoins := sdk.Coins{}
+	oneKRewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}
+	twoKRewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}
+	threeKRewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+
+	// gauge gives 3k coins. three locks, all eligible by duration.
+	// oneLockupUser has one lock and twoLockupUser has two locks.
+	tests := []struct {
+		name                     string
+		allowlistedUserIndexes   []int
+		denylistedUserIndexes    []int
+		changeRewardReceiver     []changeRewardReceiver
+		expectedRewards          []sdk.Coins
+		expectedDistributedCoins sdk.Coins
+	}{
+		{
+			name:                     "no recipient lists",
+			expectedRewards:          []sdk.Coins{oneKRewardCoins, twoKRewardCoins},
+			expectedDistributedCoins: threeKRewardCoins,
+		},
+		{
+			name:                     "first user denylisted",
+			denylistedUserIndexes:    []int{0},
+			expectedRewards:          []sdk.Coins{noRewardCoins, threeKRewardCoins},
+			expectedDistributedCoins: threeKRewardCoins,
+		},
+		{
+			name:                     "first user allowlisted",
+			allowlistedUserIndexes:   []int{0},
+			expectedRewards:          []sdk.Coins{threeKRewardCoins, noRewardCoins},
+			expectedDistributedCoins: threeKRewardCoins,
+		},
+		{
+			name:                  "second user denylisted, first user's lock rewarding the second user",
+			denylistedUserIndexes: []int{1},
+			changeRewardReceiver: []changeRewardReceiver{
+				{
+					lockId:              1,
+					newReceiverAccIndex: 1,
+				},
+			},
+			expectedRewards:          []sdk.Coins{noRewardCoins, noRewardCoins},
+			expectedDistributedCoins: noRewardCoins,
+		},
+		{
+			name:                   "first user allowlisted, second user's locks rewarding the first user",
+			allowlistedUserIndexes: []int{0},
+			changeRewardReceiver: []changeRewardReceiver{
+				{
+					lockId:              2,
+					newReceiverAccIndex: 0,
+				},
+				{
+					lockId:              3,
+					newReceiverAccIndex: 0,
+				},
+			},
+			expectedRewards:          []sdk.Coins{threeKRewardCoins, noRewardCoins},
+			expectedDistributedCoins: threeKRewardCoins,
+		},
+		{
+			name:                     "all users denylisted",
+			denylistedUserIndexes:    []int{0, 1},
+			expectedRewards:          []sdk.Coins{noRewardCoins, noRewardCoins},
+			expectedDistributedCoins: noRewardCoins,
+		},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			s.App.IncentivesKeeper.SetParam(s.Ctx, types.KeyMinValueForDistr, sdk.NewCoin(defaultRewardDenom, osmomath.NewInt(1000)))
+			err := s.App.TxFeesKeeper.SetBaseDenom(s.Ctx, defaultRewardDenom)
+			s.Require().NoError(err)
+
+			addrs := s.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUser})
+			toBech32 := func(indexes []int) []string {
+				recipients := []string{}
+				for _, i := range indexes {
+					recipients = append(recipients, addrs[i].String())
+				}
+				return recipients
+			}
+			for _, change := range tc.changeRewardReceiver {
+				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, change.lockId)
+				s.Require().NoError(err)
+				err = s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, change.lockId, lock.OwnerAddress(), addrs[change.newReceiverAccIndex].String())
+				s.Require().NoError(err)
+			}
+
+			owner := s.setupAddr(99, "owner", threeKRewardCoins)
+			s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, appparams.BaseCoinUnit, defaultRewardDenom, 9999)
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         defaultLPDenom,
+				Duration:      defaultLockDuration,
+			}
+			gaugeId, err := s.App.IncentivesKeeper.CreateGaugeWithRecipientLists(s.Ctx, true, owner, threeKRewardCoins, distrTo, s.Ctx.BlockTime(), 1, 0, toBech32(tc.allowlistedUserIndexes), toBech32(tc.denylistedUserIndexes))
+			s.Require().NoError(err)
+			gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+			s.Require().NoError(err)
+
+			distributedCoins, err := s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedDistributedCoins.String(), distributedCoins.String())
+
+			for i, addr := range addrs {
+				bal := s.App.BankKeeper.GetAllBalances(s.Ctx, addr)
+				s.Require().Equal(tc.expectedRewards[i].String(), bal.String(), "person %d", i)
+			}
+		})
+	}
+}
+
+// TestDistribute_MinLockAmount tests that gauge distributions skip the locks holding less than
+// the gauge's min lock amount, and that their share goes to the remaining locks.
+func (s *KeeperTestSuite) TestDistribute_MinLockAmount() {
+	noRewardCoins := sdk.Coins{}
+	sixHundredRewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 600)}
+	twentyFourHundredRewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2400)}
+	threeKRewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+
+	// gauge gives 3k coins. three locks, all eligible by duration.
+	// oneLockupUser has one lock of 10 lptoken and twoLockupUserDoubleAmt has two locks of 20 lptoken.
+	tests := []struct {
+		name                     string
+		minLockAmount            osmomath.Int
+		expectedRewards          []sdk.Coins
+		expectedDistributedCoins sdk.Coins
+	}{
+		{
+			name:                     "no min lock amount",
+			minLockAmount:            osmomath.ZeroInt(),
+			expectedRewards:          []sdk.Coins{sixHundredRewardCoins, twentyFourHundredRewardCoins},
+			expectedDistributedCoins: threeKRewardCoins,
+		},
+		{
+			name:                     "min lock amount equal to the smallest lock",
+			minLockAmount:            osmomath.NewInt(10),
+			expectedRewards:          []sdk.Coins{sixHundredRewardCoins, twentyFourHundredRewardCoins},
+			expectedDistributedCoins: threeKRewardCoins,
+		},
+		{
+			name:                     "min lock amount excludes the smallest lock",
+			minLockAmount:            osmomath.NewInt(15),
+			expectedRewards:          []sdk.Coins{noRewardCoins, threeKRewardCoins},
+			expectedDistributedCoins: threeKRewardCoins,
+		},
+		{
+			name:                     "min lock amount excludes all locks",
+			minLockAmount:            osmomath.NewInt(25),
+			expectedRewards:          []sdk.Coins{noRewardCoins, noRewardCoins},
+			expectedDistributedCoins: noRewardCoins,
+		},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			s.App.IncentivesKeeper.SetParam(s.Ctx, types.KeyMinValueForDistr, sdk.NewCoin(defaultRewardDenom, osmomath.NewInt(100)))
+			err := s.App.TxFeesKeeper.SetBaseDenom(s.Ctx, defaultRewardDenom)
+			s.Require().NoError(err)
+
+			addrs := s.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUserDoubleAmt})
+
+			owner := s.setupAddr(99, "owner", threeKRewardCoins)
+			s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, appparams.BaseCoinUnit, defaultRewardDenom, 9999)
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         defaultLPDenom,
+				Duration:      defaultLockDuration,
+			}
+			gaugeId, err := s.App.IncentivesKeeper.CreateGaugeWithLockFilters(s.Ctx, true, owner, threeKRewardCoins, distrTo, s.Ctx.BlockTime(), 1, 0, nil, nil, tc.minLockAmount)
+			s.Require().NoError(err)
+			gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.minLockAmount.IsPositive(), gauge.HasMinLockAmount())
+
+			distributedCoins, err := s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedDistributedCoins.String(), distributedCoins.String())
+
+			for i, addr := range addrs {
+				bal := s.App.BankKeeper.GetAllBalances(s.Ctx, addr)
+				s.Require().Equal(tc.expectedRewards[i].String(), bal.String(), "person %d", i)
+			}
+		})
+	}
+}
+
+// TestDistribute_BeforeSendHookRewards tests that rewards in a tokenfactory denom with a before send hook
+// are sent per lock, and that a failed send only accrues that lock's rewards instead of failing the distribution.
+func (s *KeeperTestSuite) TestDistribute_BeforeSendHookRewards() {
+	s.SkipIfWSL()
+
+	// gauge gives 3k coins. three locks, all eligible by duration.
+	// oneLockupUser has one lock and twoLockupUser has two locks.
+	tests := []struct {
+		name                    string
+		rejectFirstUserRewards  bool
+		expectedRewards         []int64
+		expectedAccruedRewards  int64
+		expectedRewardSendFails int
+	}{
+		{
+			name:            "rewards sent to all locks",
+			expectedRewards: []int64{1000, 2000},
+		},
+		{
+			name:                    "send to first user fails: its rewards are accrued to its lock",
+			rejectFirstUserRewards:  true,
+			expectedRewards:         []int64{0, 2000},
+			expectedAccruedRewards:  1000,
+			expectedRewardSendFails: 1,
+		},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// create a tokenfactory denom with a before send hook.
+			wasmCode, err := os.ReadFile("../../tokenfactory/keeper/testdata/no100.wasm")
+			s.Require().NoError(err)
+			contractKeeper := wasmkeeper.NewGovPermissionKeeper(s.App.WasmKeeper)
+			codeID, _, err := contractKeeper.Create(s.Ctx, s.TestAccs[0], wasmCode, nil)
+			s.Require().NoError(err)
+			cosmwasmAddress, _, err := contractKeeper.Instantiate(s.Ctx, codeID, s.TestAccs[0], s.TestAccs[0], []byte("{}"), "", sdk.NewCoins())
+			s.Require().NoError(err)
+			tokenFactoryMsgServer := tokenfactorykeeper.NewMsgServerImpl(*s.App.TokenFactoryKeeper)
+			res, err := tokenFactoryMsgServer.CreateDenom(s.Ctx, tokenfactorytypes.NewMsgCreateDenom(s.TestAccs[0].String(), "reward"))
+			s.Require().NoError(err)
+			rewardDenom := res.GetNewTokenDenom()
+			_, err = tokenFactoryMsgServer.SetBeforeSendHook(s.Ctx, tokenfactorytypes.NewMsgSetBeforeSendHook(s.TestAccs[0].String(), rewardDenom, cosmwasmAddress.String()))
+			s.Require().NoError(err)
+
+			s.App.IncentivesKeeper.SetParam(s.Ctx, types.KeyMinValueForDistr, sdk.NewCoin(rewardDenom, osmomath.OneInt()))
+			addrs := s.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUser})
+			if tc.rejectFirstUserRewards {
+				// module accounts are blocked from receiving funds, so sending the rewards to them fails.
+				err = s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, 1, addrs[0], s.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String())
+				s.Require().NoError(err)
+			}
+
+			gauges := s.SetupGauges([]perpGaugeDesc{{
+				lockDenom:    defaultLPDenom,
+				lockDuration: defaultLockDuration,
+				rewardAmount: sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 3000)),
+			}}, defaultLPDenom)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			distributedCoins, err := s.App.IncentivesKeeper.Distribute(s.Ctx, gauges)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 3000)).String(), distributedCoins.String())
+
+			for i, addr := range addrs {
+				s.Require().Equal(tc.expectedRewards[i], s.App.BankKeeper.GetBalance(s.Ctx, addr, rewardDenom).Amount.Int64(), "person %d", i)
+			}
+
+			records, err := s.App.IncentivesKeeper.GetAllLockRewardRecords(s.Ctx)
+			s.Require().NoError(err)
+			if tc.expectedAccruedRewards == 0 {
+				s.Require().Empty(records)
+			} else {
+				s.Require().Len(records, 1)
+				s.Require().Equal(uint64(1), records[0].LockId)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, tc.expectedAccruedRewards)).String(), records[0].Rewards.String())
+			}
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRewardSendFailed, tc.expectedRewardSendFails)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestDistribute_InternalIncentives_NoLock() {
 	fiveKRewardCoins := sdk.NewInt64Coin(defaultRewardDenom, 5000)
 	fiveKRewardCoinsUosmo := sdk.NewInt64Coin(appParams.BaseCoinUnit, 5000)
@@ -1437,8 +1717,18 @@ func deepCopyGroup(src types.Group) types.Group {
 			TotalWeight:  src.InternalGaugeInfo.TotalWeight,
 			GaugeRecords: gaugeRecords,
 		},
-		SplittingPolicy: src.SplittingPolicy,
+		SplittingPolicy:         src.SplittingPolicy,
+		EmissionDecay:           deepCopyEmissionDecay(src.EmissionDecay),
+		EmissionDecayStartEpoch: src.EmissionDecayStartEpoch,
+	}
+}
+
+// deepCopyEmissionDecay creates a deep copy of the passed in emission decay.
+func deepCopyEmissionDecay(src *types.EmissionDecay) *types.EmissionDecay {
+	if src == nil {
+		return nil
 	}
+	return &types.EmissionDecay{Factor: src.Factor.Clone()}
 }
 
 // deepCopyGauge creates a deep copy of the passed in gauge.
@@ -1527,6 +1817,25 @@ func withSplittingPolicy(group types.Group, splittingPolicy types.SplittingPolic
 	return updatedGroup
 }
 
+// withEmissionDecay returns a deep copy of the passed in group with the given emission decay factor.
+func withEmissionDecay(group types.Group, factor string) types.Group {
+	updatedGroup := deepCopyGroup(group)
+	updatedGroup.EmissionDecay = &types.EmissionDecay{Factor: osmomath.MustNewDecFromStr(factor)}
+
+	return updatedGroup
+}
+
+// withEvenWeights returns a deep copy of the passed in group with a weight of one set on every gauge record.
+func withEvenWeights(group types.Group) types.Group {
+	updatedGroup := deepCopyGroup(group)
+	for i := range updatedGroup.InternalGaugeInfo.GaugeRecords {
+		updatedGroup.InternalGaugeInfo.GaugeRecords[i].CurrentWeight = osmomath.OneInt()
+	}
+	updatedGroup.InternalGaugeInfo.TotalWeight = osmomath.NewInt(int64(len(updatedGroup.InternalGaugeInfo.GaugeRecords)))
+
+	return updatedGroup
+}
+
 // withGroupGaugeId returns a deep copy of the passed in group with the group id to the passed in value.
 func withGroupGaugeId(group types.Group, groupGaugeId uint64) types.Group {
 	// We make a deep copy of the group to ensure we don't modify the original input/defaults
@@ -1860,6 +2169,13 @@ func (s *KeeperTestSuite) TestSyncGroupWeights() {
 			expectedError: nil,
 		},
 
+		"happy path: even splitting group": {
+			groupToSync: withSplittingPolicy(defaultGroup, types.Evenly),
+
+			expectedSyncedGroup: withEvenWeights(withSplittingPolicy(defaultGroup, types.Evenly)),
+			expectedError:       nil,
+		},
+
 		// Error catching
 		"unsupported splitting policy": {
 			groupToSync:     withSplittingPolicy(defaultGroup, types.SplittingPolicy(100)),
@@ -1880,8 +2196,7 @@ func (s *KeeperTestSuite) TestSyncGroupWeights() {
 
 			poolIds := []uint64{clPool.GetId(), balPoolId}
 
-			// Currently the only supported splitting policy is volume splitting.
-			// When more are added in the future, setup logic should route to the appropriate setup function here.
+			// Even splitting requires no setup, so only volume splitting routes to a setup function here.
 			switch tc.groupToSync.SplittingPolicy {
 			case types.ByVolume:
 				s.overwriteVolumes(poolIds, tc.volumeOverwrite)
@@ -1960,6 +2275,10 @@ func (s *KeeperTestSuite) TestAllocateAcrossGauges() {
 
 	const (
 		invalidUnderlyingGaugeId = uint64(100)
+
+		// The epoch the groups are allocated at. Groups with an emission decay start at epoch 0,
+		// so one epoch has elapsed.
+		allocationEpoch = int64(1)
 	)
 
 	var (
@@ -2012,10 +2331,20 @@ func (s *KeeperTestSuite) TestAllocateAcrossGauges() {
 	// returns the expected coins that group is expected to distributed based on the gauge that it
 	// is associated with.
 	// WARNING: only use on the test configuration gauges.
-	estimateDistributedGroupCoins := func(group types.Gauge) (expecteDistributedCoins sdk.Coins) {
-		expecteDistributedCoins = group.Coins.Sub(group.DistributedCoins...)
-		if !group.IsPerpetual {
-			remainingEpochs := group.NumEpochsPaidOver - group.FilledEpochs
+	estimateDistributedGroupCoins := func(group types.Group, groupGauge types.Gauge) (expecteDistributedCoins sdk.Coins) {
+		expecteDistributedCoins = groupGauge.Coins.Sub(groupGauge.DistributedCoins...)
+		if group.EmissionDecay != nil {
+			// Perpetual: factor^epochsElapsed of the remaining coins.
+			// Non-perpetual: (1 - factor) / (1 - factor^remainingEpochs) of the remaining coins.
+			factor := group.EmissionDecay.Factor
+			ratio := factor.Power(uint64(allocationEpoch - group.EmissionDecayStartEpoch))
+			if !groupGauge.IsPerpetual {
+				ratio = osmomath.OneDec().Sub(factor).Quo(osmomath.OneDec().Sub(factor.Power(groupGauge.NumEpochsPaidOver - groupGauge.FilledEpochs)))
+			}
+			return coinutil.MulDec(expecteDistributedCoins, ratio)
+		}
+		if !groupGauge.IsPerpetual {
+			remainingEpochs := groupGauge.NumEpochsPaidOver - groupGauge.FilledEpochs
 
 			// For testing edge case with finished non-perpetual gauge.
 			if remainingEpochs == 0 {
@@ -2067,7 +2396,7 @@ func (s *KeeperTestSuite) TestAllocateAcrossGauges() {
 				continue
 			}
 
-			expectedAmountDistributed := estimateDistributedGroupCoins(groupConfig.groupGauge)
+			expectedAmountDistributed := estimateDistributedGroupCoins(groupConfig.group, groupConfig.groupGauge)
 			groupConfigs[i].expectedTotalDistribution = expectedAmountDistributed
 
 			// updates how much each gauge is expected to receive from the current group. Since we allow groups to distribute to overlapping gauges,
@@ -2244,6 +2573,30 @@ func (s *KeeperTestSuite) TestAllocateAcrossGauges() {
 			volumeToSet: balancerAndCLVolumeConfig,
 		},
 
+		///////////////// emission decay
+
+		"13: perpetual group gauge with emission decay distributes factor^epochsElapsed of its coins": {
+			groups: []groupConfig{
+				{
+					group:      withEmissionDecay(defaultGroup, "0.9"),
+					groupGauge: defaultPerpetualGauge,
+				},
+			},
+
+			volumeToSet: balancerAndCLVolumeConfig,
+		},
+
+		"14: non-perpetual group gauge with emission decay front-loads its distribution": {
+			groups: []groupConfig{
+				{
+					group:      withEmissionDecay(defaultGroup, "0.5"),
+					groupGauge: nonPerpetualGauge,
+				},
+			},
+
+			volumeToSet: balancerAndCLVolumeConfig,
+		},
+
 		///////////////// error cases
 
 		"12: invalid underlying group gauge (cannot add to finished pool gauge)": {
@@ -2314,7 +2667,7 @@ func (s *KeeperTestSuite) TestAllocateAcrossGauges() {
 			expectedGaugeDistributions := computeAndSetExpectedDistributions(tc.groups)
 
 			// --- System under test ---
-			err = incentivesKeeper.AllocateAcrossGauges(s.Ctx, inputGroups)
+			err = incentivesKeeper.AllocateAcrossGauges(s.Ctx, inputGroups, allocationEpoch)
 
 			if tc.expectedError != nil {
 				s.Require().Error(err)
@@ -2392,7 +2745,7 @@ func (s *KeeperTestSuite) TestCreateGroupsAndAllocate_GroupRefetchingInAllocate(
 	s.overwriteVolumes([]uint64{poolInfo.BalancerPoolID, poolInfo.ConcentratedPoolID}, []osmomath.Int{defaultVolume, defaultVolume})
 
 	// Non-perpetual group over 2 epochs
-	groupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...), incentivetypes.PerpetualNumEpochsPaidOver+2, s.TestAccs[0], []uint64{poolInfo.BalancerPoolID, poolInfo.ConcentratedPoolID})
+	groupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...), incentivetypes.PerpetualNumEpochsPaidOver+2, s.TestAccs[0], []uint64{poolInfo.BalancerPoolID, poolInfo.ConcentratedPoolID}, incentivetypes.ByVolume, nil)
 	s.Require().NoError(err)
 
 	// Increase the volume from creation time. Otherwise, the group will not be allocated and allocation would be a no-op.
@@ -2402,7 +2755,7 @@ func (s *KeeperTestSuite) TestCreateGroupsAndAllocate_GroupRefetchingInAllocate(
 	group, err := s.App.IncentivesKeeper.GetGroupByGaugeID(s.Ctx, groupGaugeID)
 
 	// Allocate right after creating the group
-	err = s.App.IncentivesKeeper.AllocateAcrossGauges(s.Ctx, []types.Group{group})
+	err = s.App.IncentivesKeeper.AllocateAcrossGauges(s.Ctx, []types.Group{group}, s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).CurrentEpoch)
 	s.Require().NoError(err)
 
 	// Increase the volume.
@@ -2415,7 +2768,7 @@ func (s *KeeperTestSuite) TestCreateGroupsAndAllocate_GroupRefetchingInAllocate(
 	// This triggers a panic if group inside the loop is not refetched with updated weights
 	// Ensure that fetch happens correctly
 	// The group given is outdated without volume being synched and reflected yet.
-	err = s.App.IncentivesKeeper.AllocateAcrossGauges(s.Ctx, []types.Group{group})
+	err = s.App.IncentivesKeeper.AllocateAcrossGauges(s.Ctx, []types.Group{group}, s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).CurrentEpoch)
 	s.Require().NoError(err)
 
 	// Validate that the concentrated pool gauge was updated correctly
@@ -91,8 +91,8 @@ func (k Keeper) ChargeGroupCreationFeeIfNotWhitelisted(ctx sdk.Context, sender s
 	return k.chargeGroupCreationFeeIfNotWhitelisted(ctx, sender)
 }
 
-func (k Keeper) CreateGroupInternal(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64) (types.Group, error) {
-	return k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs)
+func (k Keeper) CreateGroupInternal(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64, splittingPolicy types.SplittingPolicy, emissionDecay *types.EmissionDecay) (types.Group, error) {
+	return k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs, splittingPolicy, emissionDecay)
 }
 
 func (k Keeper) CalculateGroupWeights(ctx sdk.Context, group types.Group) (types.Group, error) {
@@ -110,3 +110,8 @@ func (k Keeper) SkipSpamGaugeDistribute(ctx sdk.Context, locks []*lockuptypes.Pe
 func (k Keeper) CheckIfDenomsAreDistributable(ctx sdk.Context, coins sdk.Coins) error {
 	return k.checkIfDenomsAreDistributable(ctx, coins)
 }
+
+// SetLockRewardRecord sets the rewards accrued to a lock.
+func (k Keeper) SetLockRewardRecord(ctx sdk.Context, record types.LockRewardRecord) {
+	k.setLockRewardRecord(ctx, record)
+}
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
 	"github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
@@ -47,6 +48,7 @@ func (k Keeper) getGaugesFromIterator(ctx sdk.Context, iterator db.Iterator) []t
 }
 
 // setGauge set the gauge inside store.
+// It does not index the gauge by reward denom, this is done when the gauge is created or a new denom is added to it.
 func (k Keeper) setGauge(ctx sdk.Context, gauge *types.Gauge) error {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := proto.Marshal(gauge)
@@ -78,7 +80,7 @@ func (k Keeper) CreateGaugeRefKeys(ctx sdk.Context, gauge *types.Gauge, combined
 
 // SetGaugeWithRefKey takes a single gauge and assigns a key.
 // Takes combinedKey (the keyPrefix for upcoming, active, or finished gauges combined with gauge start time) and adds a reference to the respective gauge ID.
-// If this gauge is active or upcoming, creates reference between the denom and gauge ID.
+// If this gauge is active or upcoming, creates reference between the denom and gauge ID, and indexes it by reward denom.
 func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 	err := k.setGauge(ctx, gauge)
 	if err != nil {
@@ -88,6 +90,10 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 	curTime := ctx.BlockTime()
 	timeKey := getTimeKey(gauge.StartTime)
 
+	if !gauge.IsFinishedGauge(curTime) {
+		k.addGaugeRewardDenomRefs(ctx, gauge.Id, gauge.Coins)
+	}
+
 	if gauge.IsUpcomingGauge(curTime) {
 		combinedKeys := combineKeys(types.KeyPrefixUpcomingGauges, timeKey)
 		return k.CreateGaugeRefKeys(ctx, gauge, combinedKeys)
@@ -124,10 +130,47 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 //
 // On success, returns the gauge ID.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, poolId, nil, nil, osmomath.Int{})
+}
+
+// CreateGaugeWithRecipientLists creates a gauge the same way as CreateGauge, additionally restricting
+// the owners of the locks that receive its distributions with either an allowlist or a denylist.
+// Recipient lists are only supported for lockuptypes.ByDuration gauges.
+//
+// Returns error if:
+// - both recipientAllowlist and recipientDenylist are set
+// - the set list is longer than types.MaxGaugeRecipientListLength or has invalid or duplicate addresses
+// - a list is set for a gauge that is not lockuptypes.ByDuration
+func (k Keeper) CreateGaugeWithRecipientLists(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64, recipientAllowlist, recipientDenylist []string) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, poolId, recipientAllowlist, recipientDenylist, osmomath.Int{})
+}
+
+// CreateGaugeWithLockFilters creates a gauge the same way as CreateGaugeWithRecipientLists, additionally
+// skipping the locks holding less than minLockAmount of the distributed denom at distribution time.
+// A nil or zero minLockAmount disables the filter.
+//
+// Returns error if:
+// - any of the conditions of CreateGaugeWithRecipientLists is not met
+// - minLockAmount is negative, or positive for a gauge that is not lockuptypes.ByDuration
+func (k Keeper) CreateGaugeWithLockFilters(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64, recipientAllowlist, recipientDenylist []string, minLockAmount osmomath.Int) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, poolId, recipientAllowlist, recipientDenylist, minLockAmount)
+}
+
+func (k Keeper) createGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64, recipientAllowlist, recipientDenylist []string, minLockAmount osmomath.Int) (uint64, error) {
 	if numEpochsPaidOver == types.PerpetualNumEpochsPaidOver && !isPerpetual {
 		return 0, types.ErrZeroNumEpochsPaidOver
 	}
 
+	if err := types.ValidateRecipientLists(recipientAllowlist, recipientDenylist); err != nil {
+		return 0, err
+	}
+	if (len(recipientAllowlist) > 0 || len(recipientDenylist) > 0) && distrTo.LockQueryType != lockuptypes.ByDuration {
+		return 0, types.RecipientListUnsupportedError{LockQueryType: distrTo.LockQueryType}
+	}
+	if err := types.ValidateMinLockAmount(minLockAmount, distrTo.LockQueryType); err != nil {
+		return 0, err
+	}
+
 	// Check that the coins being sent to the gauge exist as a skip hot route
 	// This is used to determine the underlying value of the rewards per user at epoch,
 	// since we don't distribute tokens values under a certain threshold.
@@ -241,12 +284,18 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	}
 
 	gauge := types.Gauge{
-		Id:                nextGaugeId,
-		IsPerpetual:       isPerpetual,
-		DistributeTo:      distrTo,
-		Coins:             coins,
-		StartTime:         startTime,
-		NumEpochsPaidOver: numEpochsPaidOver,
+		Id:                 nextGaugeId,
+		IsPerpetual:        isPerpetual,
+		DistributeTo:       distrTo,
+		Coins:              coins,
+		StartTime:          startTime,
+		NumEpochsPaidOver:  numEpochsPaidOver,
+		RecipientAllowlist: recipientAllowlist,
+		RecipientDenylist:  recipientDenylist,
+	}
+	// The min lock amount is only stored when it is set, so that gauges without it are unchanged.
+	if !minLockAmount.IsNil() && minLockAmount.IsPositive() {
+		gauge.MinLockAmount = &minLockAmount
 	}
 
 	// Fixed gas consumption create gauge based on the number of coins to add
@@ -260,6 +309,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	if err != nil {
 		return 0, err
 	}
+	k.addGaugeRewardDenomRefs(ctx, gauge.Id, gauge.Coins)
 	k.SetLastGaugeID(ctx, gauge.Id)
 
 	combinedKeys := combineKeys(types.KeyPrefixUpcomingGauges, getTimeKey(gauge.StartTime))
@@ -395,12 +445,164 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 	return estimatedRewards
 }
 
+// GetUpcomingEmissions projects the emissions of all active and upcoming gauges attached to the given pool
+// for the given number of upcoming epochs. Gauges are attached to a pool if they distribute to its lockable
+// duration locks or, for no lock gauges, to the pool itself.
+// The projection assumes that no coins are added to the gauges, and that every gauge distributes its
+// per epoch amount in full. Perpetual gauges therefore distribute all of their remaining coins at the
+// end of the first epoch in which they are active.
+func (k Keeper) GetUpcomingEmissions(ctx sdk.Context, poolId uint64, numEpochs uint64) []types.EpochEmission {
+	lockableDurations := k.GetLockableDurations(ctx)
+	gauges := []types.Gauge{}
+	for _, gauge := range k.GetNotFinishedGauges(ctx) {
+		if !gauge.IsLinkedToPool(poolId) {
+			continue
+		}
+		if gauge.IsDurationLockGauge() && !osmoutils.Contains(lockableDurations, gauge.DistributeTo.Duration) {
+			continue
+		}
+		gauges = append(gauges, gauge)
+	}
+
+	epochInfo := k.GetEpochInfo(ctx)
+	currentEpoch, currentEpochStartTime := epochInfo.CurrentEpoch, epochInfo.CurrentEpochStartTime
+	if !epochInfo.EpochCountingStarted {
+		// the first epoch begins at the epoch start time.
+		currentEpoch, currentEpochStartTime = 1, epochInfo.StartTime
+	}
+
+	emissions := make([]types.EpochEmission, 0, numEpochs)
+	for i := uint64(0); i < numEpochs; i++ {
+		// gauges are distributed at the end of every epoch, once upcoming gauges that have started are activated.
+		distrTime := currentEpochStartTime.Add(time.Duration(i+1) * epochInfo.Duration)
+		emission := types.EpochEmission{
+			EpochNumber:      currentEpoch + int64(i),
+			DistributionTime: distrTime,
+			Coins:            sdk.NewCoins(),
+		}
+		for j := range gauges {
+			gauge := &gauges[j]
+			if !gauge.IsActiveGauge(distrTime) {
+				continue
+			}
+
+			distrCoins := gauge.NextEpochDistribution()
+			emission.Coins = emission.Coins.Add(distrCoins...)
+			gauge.FilledEpochs++
+			gauge.DistributedCoins = gauge.DistributedCoins.Add(distrCoins...)
+		}
+		emissions = append(emissions, emission)
+	}
+	return emissions
+}
+
+// GetGaugeEndEpoch returns the number of the distribution epoch at whose end the given gauge is projected to make its
+// last distribution, assuming that it distributes at the end of every epoch from the first one at whose end it is active.
+// Returns 0 for perpetual and finished gauges, which have no last distribution ahead of them.
+func (k Keeper) GetGaugeEndEpoch(ctx sdk.Context, gauge types.Gauge) int64 {
+	if gauge.IsPerpetual || gauge.IsFinishedGauge(ctx.BlockTime()) || gauge.FilledEpochs >= gauge.NumEpochsPaidOver {
+		return 0
+	}
+
+	epochInfo := k.GetEpochInfo(ctx)
+	currentEpoch, currentEpochStartTime := epochInfo.CurrentEpoch, epochInfo.CurrentEpochStartTime
+	if !epochInfo.EpochCountingStarted {
+		// the first epoch begins at the epoch start time.
+		currentEpoch, currentEpochStartTime = 1, epochInfo.StartTime
+	}
+
+	// upcoming gauges first distribute at the end of the first epoch ending at or after their start time.
+	epochsBeforeStart := int64(0)
+	if firstDistrTime := currentEpochStartTime.Add(epochInfo.Duration); epochInfo.Duration > 0 && gauge.StartTime.After(firstDistrTime) {
+		epochsBeforeStart = int64((gauge.StartTime.Sub(firstDistrTime) + epochInfo.Duration - 1) / epochInfo.Duration)
+	}
+	return currentEpoch + epochsBeforeStart + int64(gauge.NumEpochsPaidOver-gauge.FilledEpochs) - 1
+}
+
+// GetNextDistributionTime returns the time of the next gauge distribution, which is the first distribution epoch
+// boundary at or after the block time, i.e. when a gauge created in this block first distributes.
+func (k Keeper) GetNextDistributionTime(ctx sdk.Context) time.Time {
+	// aligning the block time never errors, as it is not in the past.
+	distrTime, _ := k.AlignGaugeStartTimeToEpoch(ctx, ctx.BlockTime())
+	return distrTime
+}
+
+// GetQualifyingGaugeIDs returns the ids of the duration lock gauges that distribute to the given lock at the given
+// distribution time, either directly or through the lock's synthetic lockup if it is not nil.
+// A gauge qualifies if it is active at the distribution time, if the lock is at least as long as the gauge's duration,
+// if the lock's reward receiver passes the gauge's recipient lists, and if the lock holds the gauge's min lock amount.
+func (k Keeper) GetQualifyingGaugeIDs(ctx sdk.Context, lock lockuptypes.PeriodLock, synthLock *lockuptypes.SyntheticLock, distrTime time.Time) ([]uint64, error) {
+	type lockedDenom struct {
+		denom    string
+		duration time.Duration
+	}
+	lockedDenoms := make([]lockedDenom, 0, len(lock.Coins)+1)
+	for _, coin := range lock.Coins {
+		lockedDenoms = append(lockedDenoms, lockedDenom{denom: coin.Denom, duration: lock.Duration})
+	}
+	if synthLock != nil {
+		lockedDenoms = append(lockedDenoms, lockedDenom{denom: synthLock.SynthDenom, duration: synthLock.Duration})
+	}
+
+	gaugeIDs := []uint64{}
+	for _, lockedDenom := range lockedDenoms {
+		// each gauge only distributes to one denom, so there are no duplicates.
+		for _, gaugeID := range k.getAllGaugeIDsByDenom(ctx, lockedDenom.denom) {
+			gauge, err := k.GetGaugeByID(ctx, gaugeID)
+			if err != nil {
+				return nil, err
+			}
+
+			if !gauge.IsDurationLockGauge() || !gauge.IsActiveGauge(distrTime) || lockedDenom.duration < gauge.DistributeTo.Duration {
+				continue
+			}
+			if !gauge.IsEligibleRecipient(&lock) {
+				continue
+			}
+			if gauge.HasMinLockAmount() && lock.Coins.AmountOf(lockuptypes.NativeDenom(gauge.DistributeTo.Denom)).LT(*gauge.MinLockAmount) {
+				continue
+			}
+			gaugeIDs = append(gaugeIDs, gauge.Id)
+		}
+	}
+	return gaugeIDs, nil
+}
+
 // GetEpochInfo returns EpochInfo struct given context.
 func (k Keeper) GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo {
 	params := k.GetParams(ctx)
 	return k.ek.GetEpochInfo(ctx, params.DistrEpochIdentifier)
 }
 
+// AlignGaugeStartTimeToEpoch returns the first boundary of the distribution epoch at or after the given start time,
+// which is when a gauge starting at that time first distributes. Start times in the past are aligned from the block
+// time, so that the gauge first distributes at the end of the current epoch.
+//
+// Returns error if the start time is more than types.MaxGaugeStartTimePastTolerance before the block time.
+func (k Keeper) AlignGaugeStartTimeToEpoch(ctx sdk.Context, startTime time.Time) (time.Time, error) {
+	blockTime := ctx.BlockTime()
+	if startTime.Before(blockTime.Add(-types.MaxGaugeStartTimePastTolerance)) {
+		return time.Time{}, types.GaugeStartTimeInPastError{StartTime: startTime, BlockTime: blockTime, Tolerance: types.MaxGaugeStartTimePastTolerance}
+	}
+	if startTime.Before(blockTime) {
+		startTime = blockTime
+	}
+
+	epochInfo := k.GetEpochInfo(ctx)
+	epochStartTime := epochInfo.CurrentEpochStartTime
+	if !epochInfo.EpochCountingStarted {
+		// the first epoch begins at the epoch start time.
+		epochStartTime = epochInfo.StartTime
+	}
+
+	// gauges are distributed at the end of every epoch, i.e. at epochStartTime + n * duration with n >= 1.
+	numEpochs := int64(1)
+	if elapsed := startTime.Sub(epochStartTime); elapsed > epochInfo.Duration {
+		numEpochs = int64((elapsed + epochInfo.Duration - 1) / epochInfo.Duration)
+	}
+	return epochStartTime.Add(time.Duration(numEpochs) * epochInfo.Duration), nil
+}
+
 // AddToGaugeRewards adds coins to gauge.
 func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error {
 	if err := k.addToGaugeRewards(ctx, coins, gaugeID); err != nil {
@@ -435,6 +637,7 @@ func (k Keeper) addToGaugeRewards(ctx sdk.Context, coins sdk.Coins, gaugeID uint
 		return types.UnexpectedFinishedGaugeError{GaugeId: gaugeID}
 	}
 
+	k.addNewGaugeRewardDenomRefs(ctx, gauge, coins)
 	gauge.Coins = gauge.Coins.Add(coins...)
 	err = k.setGauge(ctx, gauge)
 	if err != nil {
@@ -448,6 +651,91 @@ func (k Keeper) addToGaugeRewards(ctx sdk.Context, coins sdk.Coins, gaugeID uint
 	return nil
 }
 
+// AddToGaugeRewardsAndExtend adds coins to a non-perpetual gauge and extends the number of epochs
+// it is paid over by additionalEpochs. Returns the gauge's updated number of epochs paid over.
+//
+// Returns error if:
+// - fails to retrieve gauge from state
+// - gauge is perpetual or finished.
+// - the gauge's emission per epoch would decrease for any of its remaining coins.
+// - fails to store an updated gauge to state
+func (k Keeper) AddToGaugeRewardsAndExtend(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64, additionalEpochs uint64) (uint64, error) {
+	if err := k.checkIfDenomsAreDistributable(ctx, coins); err != nil {
+		return 0, err
+	}
+
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return 0, err
+	}
+	if gauge.IsPerpetual {
+		return 0, types.PerpetualGaugeExtensionError{GaugeId: gaugeID}
+	}
+	if gauge.IsFinishedGauge(ctx.BlockTime()) {
+		return 0, types.UnexpectedFinishedGaugeError{GaugeId: gaugeID}
+	}
+
+	extendedGauge := *gauge
+	extendedGauge.Coins = gauge.Coins.Add(coins...)
+	extendedGauge.NumEpochsPaidOver = gauge.NumEpochsPaidOver + additionalEpochs
+	if err := validateNonDecreasingEmission(*gauge, extendedGauge); err != nil {
+		return 0, err
+	}
+
+	if err := k.setGauge(ctx, &extendedGauge); err != nil {
+		return 0, err
+	}
+	k.addNewGaugeRewardDenomRefs(ctx, gauge, coins)
+
+	// Fixed gas consumption adding reward to gauges based on the number of coins to add
+	ctx.GasMeter().ConsumeGas(uint64(types.BaseGasFeeForAddRewardToGauge*(len(coins)+len(extendedGauge.Coins))), "scaling gas cost for adding to gauge rewards")
+
+	k.hooks.AfterAddToGauge(ctx, extendedGauge.Id)
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	return extendedGauge.NumEpochsPaidOver, nil
+}
+
+// validateNonDecreasingEmission returns an error if the amount of any remaining coin that extendedGauge
+// distributes per epoch is lower than the amount gauge distributes per epoch.
+// Both gauges are expected to be non-perpetual and unfinished.
+func validateNonDecreasingEmission(gauge, extendedGauge types.Gauge) error {
+	remainEpochs := int64(gauge.NumEpochsPaidOver - gauge.FilledEpochs)
+	extendedRemainEpochs := int64(extendedGauge.NumEpochsPaidOver - extendedGauge.FilledEpochs)
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins...)
+	extendedRemainCoins := extendedGauge.Coins.Sub(extendedGauge.DistributedCoins...)
+	for _, coin := range remainCoins {
+		emissionPerEpoch := coin.Amount.QuoRaw(remainEpochs)
+		extendedEmissionPerEpoch := extendedRemainCoins.AmountOf(coin.Denom).QuoRaw(extendedRemainEpochs)
+		if extendedEmissionPerEpoch.LT(emissionPerEpoch) {
+			return types.GaugeEmissionDecreasedError{
+				GaugeId:                  gauge.Id,
+				Denom:                    coin.Denom,
+				EmissionPerEpoch:         emissionPerEpoch,
+				ExtendedEmissionPerEpoch: extendedEmissionPerEpoch,
+			}
+		}
+	}
+	return nil
+}
+
+// getGaugeFee returns the fee for creating or adding to a gauge that distributes to distrTo.
+// It is the given flat fee plus the gauge fee per lock param times the number of locks
+// the gauge would distribute to at the moment, pricing in the cost of distributing to many locks.
+// The number of locks is read from the lockup lock count store, so no lock is iterated.
+// Only lock duration gauges distribute to locks, so the flat fee is returned for all other gauges.
+func (k Keeper) getGaugeFee(ctx sdk.Context, flatFee osmomath.Int, distrTo lockuptypes.QueryCondition) osmomath.Int {
+	feePerLock := k.GetParams(ctx).GaugeFeePerLock
+	if feePerLock.IsNil() || feePerLock.IsZero() || distrTo.LockQueryType != lockuptypes.ByDuration {
+		return flatFee
+	}
+	numLocks := k.lk.GetPeriodLocksCount(ctx, distrTo)
+	return flatFee.Add(feePerLock.Mul(numLocks))
+}
+
 // chargeFeeIfSufficientFeeDenomBalance charges fee in the base denom on the address if the address has
 // balance that is less than fee + amount of the coin from gaugeCoins that is of base denom.
 // gaugeCoins might not have a coin of tx base denom. In that case, fee is only compared to balance.
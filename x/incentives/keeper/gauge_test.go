@@ -518,6 +518,111 @@ func (s *KeeperTestSuite) TestAddToGaugeRewards() {
 	}
 }
 
+func (s *KeeperTestSuite) TestAddToGaugeRewardsAndExtend() {
+	defaultCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name             string
+		isPerpetual      bool
+		coinsToAdd       sdk.Coins
+		gaugeId          uint64
+		additionalEpochs uint64
+
+		expectedNumEpochsPaidOver uint64
+		expectedErr               error
+	}{
+		{
+			name:             "valid case: emission per epoch unchanged",
+			coinsToAdd:       sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			gaugeId:          1,
+			additionalEpochs: 1,
+
+			expectedNumEpochsPaidOver: 3,
+		},
+		{
+			name:             "valid case: emission per epoch increases",
+			coinsToAdd:       sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+			gaugeId:          1,
+			additionalEpochs: 2,
+
+			expectedNumEpochsPaidOver: 4,
+		},
+		{
+			name:             "valid case: new denom added",
+			coinsToAdd:       sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("atom", 30)),
+			gaugeId:          1,
+			additionalEpochs: 1,
+
+			expectedNumEpochsPaidOver: 3,
+		},
+		{
+			name:             "invalid case: emission per epoch decreases",
+			coinsToAdd:       sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			gaugeId:          1,
+			additionalEpochs: 1,
+
+			expectedErr: types.GaugeEmissionDecreasedError{GaugeId: 1, Denom: "stake", EmissionPerEpoch: osmomath.NewInt(50), ExtendedEmissionPerEpoch: osmomath.NewInt(46)},
+		},
+		{
+			name:             "invalid case: perpetual gauge",
+			isPerpetual:      true,
+			coinsToAdd:       sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			gaugeId:          1,
+			additionalEpochs: 1,
+
+			expectedErr: types.PerpetualGaugeExtensionError{GaugeId: 1},
+		},
+		{
+			name:             "invalid case: gauge does not exist",
+			coinsToAdd:       sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			gaugeId:          2,
+			additionalEpochs: 1,
+
+			expectedErr: types.GaugeNotFoundError{GaugeID: 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+
+			// Since this test adds to a gauge, we need to ensure a route exists in protorev hot routes.
+			for _, coin := range tc.coinsToAdd {
+				s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, appparams.BaseCoinUnit, coin.Denom, 9999)
+			}
+
+			_, _, existingGaugeCoins, _ := s.SetupNewGauge(tc.isPerpetual, defaultCoins)
+
+			s.FundAcc(owner, tc.coinsToAdd)
+
+			numEpochsPaidOver, err := s.App.IncentivesKeeper.AddToGaugeRewardsAndExtend(s.Ctx, owner, tc.coinsToAdd, tc.gaugeId, tc.additionalEpochs)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+
+				// balance shouldn't change in the module
+				balance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName))
+				s.Require().Equal(existingGaugeCoins, balance)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedNumEpochsPaidOver, numEpochsPaidOver)
+
+			expectedCoins := existingGaugeCoins.Add(tc.coinsToAdd...)
+
+			// check module account balance, should go up
+			balance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName))
+			s.Require().Equal(expectedCoins, balance)
+
+			// check gauge coins and epochs paid over were updated
+			gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, tc.gaugeId)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedCoins, gauge.Coins)
+			s.Require().Equal(tc.expectedNumEpochsPaidOver, gauge.NumEpochsPaidOver)
+		})
+	}
+}
+
 // TestCreateGauge_NoLockGauges tests the CreateGauge function
 // specifically focusing on the no lock gauge type and test cases around it.
 // It tests the following:
@@ -954,3 +1059,153 @@ func (s *KeeperTestSuite) TestCheckIfDenomsAreDistributable() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestAlignGaugeStartTimeToEpoch() {
+	epochStartTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	epochDuration := 24 * time.Hour
+	blockTime := epochStartTime.Add(2 * time.Hour)
+
+	testCases := []struct {
+		name                 string
+		epochCountingStarted bool
+		startTime            time.Time
+		expectedStartTime    time.Time
+		expectedErr          error
+	}{
+		{
+			name:                 "start time in the current epoch is aligned to its end",
+			epochCountingStarted: true,
+			startTime:            blockTime.Add(time.Hour),
+			expectedStartTime:    epochStartTime.Add(epochDuration),
+		},
+		{
+			name:                 "start time right after an epoch boundary is aligned to the following one",
+			epochCountingStarted: true,
+			startTime:            epochStartTime.Add(epochDuration + time.Second),
+			expectedStartTime:    epochStartTime.Add(2 * epochDuration),
+		},
+		{
+			name:                 "start time on an epoch boundary is unchanged",
+			epochCountingStarted: true,
+			startTime:            epochStartTime.Add(3 * epochDuration),
+			expectedStartTime:    epochStartTime.Add(3 * epochDuration),
+		},
+		{
+			name:                 "start time in the past within the tolerance is aligned from the block time",
+			epochCountingStarted: true,
+			startTime:            blockTime.Add(-types.MaxGaugeStartTimePastTolerance),
+			expectedStartTime:    epochStartTime.Add(epochDuration),
+		},
+		{
+			name:                 "epoch counting not started: aligned to the end of the first epoch",
+			epochCountingStarted: false,
+			startTime:            blockTime,
+			expectedStartTime:    epochStartTime.Add(epochDuration),
+		},
+		{
+			name:                 "error: start time in the past beyond the tolerance",
+			epochCountingStarted: true,
+			startTime:            blockTime.Add(-types.MaxGaugeStartTimePastTolerance - time.Second),
+			expectedErr: types.GaugeStartTimeInPastError{
+				StartTime: blockTime.Add(-types.MaxGaugeStartTimePastTolerance - time.Second),
+				BlockTime: blockTime,
+				Tolerance: types.MaxGaugeStartTimePastTolerance,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(blockTime)
+
+			epochInfo := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx)
+			s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx, epochInfo.Identifier)
+			epochInfo.StartTime = epochStartTime
+			epochInfo.CurrentEpochStartTime = epochStartTime
+			epochInfo.Duration = epochDuration
+			epochInfo.EpochCountingStarted = tc.epochCountingStarted
+			if !tc.epochCountingStarted {
+				epochInfo.CurrentEpoch = 0
+				epochInfo.CurrentEpochStartTime = time.Time{}
+			}
+			s.Require().NoError(s.App.EpochsKeeper.AddEpochInfo(s.Ctx, epochInfo))
+
+			// System under test
+			startTime, err := s.App.IncentivesKeeper.AlignGaugeStartTimeToEpoch(s.Ctx, tc.startTime)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedStartTime, startTime)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetNextDistributionTime() {
+	epochStartTime := time.Unix(1_700_000_000, 0).UTC()
+	epochDuration := 24 * time.Hour
+
+	for _, epochCountingStarted := range []bool{true, false} {
+		s.Run(fmt.Sprintf("epoch counting started: %t", epochCountingStarted), func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(epochStartTime.Add(epochDuration + time.Hour))
+
+			epochInfo := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx)
+			s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx, epochInfo.Identifier)
+			epochInfo.StartTime = epochStartTime
+			epochInfo.CurrentEpochStartTime = epochStartTime.Add(epochDuration)
+			epochInfo.Duration = epochDuration
+			epochInfo.EpochCountingStarted = epochCountingStarted
+			expectedDistrTime := epochStartTime.Add(2 * epochDuration)
+			if !epochCountingStarted {
+				s.Ctx = s.Ctx.WithBlockTime(epochStartTime.Add(-time.Hour))
+				epochInfo.CurrentEpoch = 0
+				epochInfo.CurrentEpochStartTime = time.Time{}
+				// the first distribution is at the end of the first epoch.
+				expectedDistrTime = epochStartTime.Add(epochDuration)
+			}
+			s.Require().NoError(s.App.EpochsKeeper.AddEpochInfo(s.Ctx, epochInfo))
+
+			s.Require().Equal(expectedDistrTime, s.App.IncentivesKeeper.GetNextDistributionTime(s.Ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetQualifyingGaugeIDs() {
+	s.SetupTest()
+	rewards := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	s.LockTokens(lockOwner, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, defaultLockDuration)
+	lock := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, lockOwner)[0]
+	err := s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "lptoken/superbonding", defaultLockDuration, false)
+	s.Require().NoError(err)
+	synthLock, found, err := s.App.LockupKeeper.GetSyntheticLockupByUnderlyingLockId(s.Ctx, lock.ID)
+	s.Require().NoError(err)
+	s.Require().True(found)
+
+	// gauges distributing to the lock's denom or to its synthetic denom for at most its duration qualify.
+	lockGaugeID, gauge, _, _ := s.setupNewGaugeWithDuration(false, rewards, defaultLockDuration, "lptoken")
+	synthLockGaugeID, _, _, _ := s.setupNewGaugeWithDuration(false, rewards, defaultLockDuration, "lptoken/superbonding")
+	// gauges that are longer than the lock or distribute to other denoms do not.
+	s.setupNewGaugeWithDuration(false, rewards, 2*defaultLockDuration, "lptoken")
+	s.setupNewGaugeWithDuration(false, rewards, defaultLockDuration, "otherlptoken")
+
+	distrTime := gauge.StartTime
+	gaugeIDs, err := s.App.IncentivesKeeper.GetQualifyingGaugeIDs(s.Ctx, lock, &synthLock, distrTime)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{lockGaugeID, synthLockGaugeID}, gaugeIDs)
+
+	gaugeIDs, err = s.App.IncentivesKeeper.GetQualifyingGaugeIDs(s.Ctx, lock, nil, distrTime)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{lockGaugeID}, gaugeIDs)
+
+	// gauges have yet to start before their start time.
+	gaugeIDs, err = s.App.IncentivesKeeper.GetQualifyingGaugeIDs(s.Ctx, lock, &synthLock, distrTime.Add(-time.Second))
+	s.Require().NoError(err)
+	s.Require().Empty(gaugeIDs)
+}
@@ -26,6 +26,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		k.addGaugeRewardDenomRefs(ctx, groupGauges.Id, groupGauges.Coins)
 	}
 
 	k.SetLastGaugeID(ctx, genState.LastGaugeId)
@@ -33,6 +34,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, group := range genState.Groups {
 		k.SetGroup(ctx, group)
 	}
+
+	for _, record := range genState.LockRewardRecords {
+		k.setLockRewardRecord(ctx, record)
+	}
+
+	for _, checkpoint := range genState.LockRewardCheckpoints {
+		k.setLockRewardCheckpointRecord(ctx, checkpoint)
+	}
+
+	for _, accumulator := range genState.RewardGrowthAccumulators {
+		k.setRewardGrowthAccumulator(ctx, accumulator)
+	}
 }
 
 // ExportGenesis returns the x/incentives module's exported genesis.
@@ -47,12 +60,30 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	lockRewardRecords, err := k.GetAllLockRewardRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lockRewardCheckpoints, err := k.GetAllLockRewardCheckpoints(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	rewardGrowthAccumulators, err := k.GetAllRewardGrowthAccumulators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		Gauges:            k.GetNotFinishedGauges(ctx),
-		LastGaugeId:       k.GetLastGaugeID(ctx),
-		GroupGauges:       groupGauges,
-		Groups:            groups,
+		Params:                   k.GetParams(ctx),
+		LockableDurations:        k.GetLockableDurations(ctx),
+		Gauges:                   k.GetNotFinishedGauges(ctx),
+		LastGaugeId:              k.GetLastGaugeID(ctx),
+		GroupGauges:              groupGauges,
+		Groups:                   groups,
+		LockRewardRecords:        lockRewardRecords,
+		LockRewardCheckpoints:    lockRewardCheckpoints,
+		RewardGrowthAccumulators: rewardGrowthAccumulators,
 	}
 }
@@ -260,7 +260,7 @@ func createAllGaugeTypes(t *testing.T, app *osmoapp.OsmosisApp, ctx sdk.Context,
 	for _, poolID := range groupPoolIDs {
 		app.PoolManagerKeeper.SetVolume(ctx, poolID, volumeCoins)
 	}
-	_, err = app.IncentivesKeeper.CreateGroup(ctx, sdk.Coins{}, 0, addr, groupPoolIDs)
+	_, err = app.IncentivesKeeper.CreateGroup(ctx, sdk.Coins{}, 0, addr, groupPoolIDs, types.ByVolume, nil)
 	require.NoError(t, err)
 }
 
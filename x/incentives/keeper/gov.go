@@ -19,7 +19,7 @@ func (k Keeper) HandleCreateGaugeProposal(ctx sdk.Context, p *types.CreateGroups
 		// then modify it here as well.
 		// Note: do not replace with CreateGroupAsIncentivesModuleAcc as that implementation does not attempt to sync weights
 		// We still want to sync the weights here to ensure that the pools are valid and have the associated volume at group creation time.
-		_, err := k.CreateGroup(ctx, sdk.Coins{}, types.PerpetualNumEpochsPaidOver, incentivesModuleAddress, group.PoolIds)
+		_, err := k.CreateGroup(ctx, sdk.Coins{}, types.PerpetualNumEpochsPaidOver, incentivesModuleAddress, group.PoolIds, group.SplittingPolicy, group.EmissionDecay)
 		if err != nil {
 			return err
 		}
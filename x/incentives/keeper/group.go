@@ -16,7 +16,7 @@ import (
 var emptyCoins = sdk.NewCoins()
 
 // CreateGroup creates a new group. The group is 1:1 mapped to a group gauge that allocates rewards dynamically across its internal pool gauges based on
-// the volume splitting policy.
+// the given splitting policy.
 // For each pool ID in the given slice, its main internal gauge is used to create gauge records to be associated with the Group.
 // Note, that implies that only perpetual pool gauges can be associated with the Group.
 // For Group's own distribution policy, a 1:1 group Gauge is created. This is the Gauge that receives incentives at the end of an epoch
@@ -28,12 +28,14 @@ var emptyCoins = sdk.NewCoins()
 // Returns nil on success.
 // Returns error if:
 // - given pool IDs slice is empty or has 1 pool only
+// - the splitting policy is not supported
+// - the emission decay factor is not in (0, 1)
 // - fails to initialize gauge information for every pool ID
 // - fails to send coins from owner to the incentives module for the Group's Gauge
 // - fails to charge group creation fee
 // - fails to set the Group's Gauge to state
-func (k Keeper) CreateGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64) (uint64, error) {
-	newGroup, err := k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs)
+func (k Keeper) CreateGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64, splittingPolicy types.SplittingPolicy, emissionDecay *types.EmissionDecay) (uint64, error) {
+	newGroup, err := k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs, splittingPolicy, emissionDecay)
 	if err != nil {
 		return 0, err
 	}
@@ -73,7 +75,7 @@ func (k Keeper) CreateGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver u
 // - fails to create Group
 func (k Keeper) CreateGroupAsIncentivesModuleAcc(ctx sdk.Context, numEpochPaidOver uint64, poolIDs []uint64) (uint64, error) {
 	incentivesModuleAddress := k.ak.GetModuleAddress(types.ModuleName)
-	newGroup, err := k.createGroup(ctx, emptyCoins, numEpochPaidOver, incentivesModuleAddress, poolIDs)
+	newGroup, err := k.createGroup(ctx, emptyCoins, numEpochPaidOver, incentivesModuleAddress, poolIDs, types.ByVolume, nil)
 	if err != nil {
 		return 0, err
 	}
@@ -85,17 +87,20 @@ func (k Keeper) CreateGroupAsIncentivesModuleAcc(ctx sdk.Context, numEpochPaidOv
 }
 
 // createGroup creates a new group. The group is 1:1 mapped to a group gauge that allocates rewards dynamically across its internal pool gauges based on
-// the volume splitting policy.
+// the given splitting policy.
 // For each pool ID in the given slice, its main internal gauge is used to create gauge records to be associated with the Group.
 // Note, that implies that only perpetual pool gauges can be associated with the Group.
 // For Group's own distribution policy, a 1:1 group Gauge is created. This is the Gauge that receives incentives at the end of an epoch
 // in the pool incentives as defined by the DistrRecord. The Group's Gauge can either be perpetual or non-perpetual.
 // If numEpochPaidOver is 0, then the Group's Gauge is perpetual. Otherwise, it is non-perpetual.
+// If emissionDecay is set, the Group's per-epoch emission tapers according to it.
 // It does not attempt to sync the group's weights. Use with care since it is
 // possible to create a group of pools that are invalid (have no volume).
 // Returns nil on success.
 // Returns error if:
 // - given pool IDs slice is empty or has 1 pool only
+// - the splitting policy is not supported
+// - the emission decay factor is not in (0, 1)
 // - fails to initialize gauge information for every pool ID
 // - fails to send coins from owner to the incentives module for the Group's Gauge
 // - fails to charge group creation fee
@@ -105,7 +110,7 @@ func (k Keeper) CreateGroupAsIncentivesModuleAcc(ctx sdk.Context, numEpochPaidOv
 // - does not persist the group to state
 // - persists group's Gauge to state
 // - does not charge group creation fee if sender is the incentives module account
-func (k Keeper) createGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64) (types.Group, error) {
+func (k Keeper) createGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64, splittingPolicy types.SplittingPolicy, emissionDecay *types.EmissionDecay) (types.Group, error) {
 	if len(poolIDs) == 0 {
 		return types.Group{}, types.ErrNoPoolIDsGiven
 	}
@@ -117,6 +122,14 @@ func (k Keeper) createGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver u
 		return types.Group{}, types.DuplicatePoolIDError{PoolIDs: poolIDs}
 	}
 
+	if err := types.ValidateSplittingPolicy(splittingPolicy); err != nil {
+		return types.Group{}, err
+	}
+
+	if err := types.ValidateEmissionDecay(emissionDecay); err != nil {
+		return types.Group{}, err
+	}
+
 	// Initialize gauge information for every pool ID.
 	initialInternalGaugeInfo, err := k.initGaugeInfo(ctx, poolIDs)
 	if err != nil {
@@ -137,10 +150,11 @@ func (k Keeper) createGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver u
 	newGroup := types.Group{
 		GroupGaugeId:      groupGaugeID,
 		InternalGaugeInfo: initialInternalGaugeInfo,
-		// Note: only Volume splitting exists today.
-		// We allow for other splitting policies to be added in the future
-		// by extending the enum.
-		SplittingPolicy: types.ByVolume,
+		SplittingPolicy:   splittingPolicy,
+		EmissionDecay:     emissionDecay,
+	}
+	if emissionDecay != nil {
+		newGroup.EmissionDecayStartEpoch = k.GetEpochInfo(ctx).CurrentEpoch
 	}
 
 	return newGroup, nil
@@ -281,7 +281,7 @@ func (s *KeeperTestSuite) runCreateGroupTests(poolInfo apptesting.SupportedPoolA
 			// Always fund the account with fullyFundedAddressIndex
 			s.FundAcc(s.TestAccs[fullyFundedAddressIndex], tc.coins.Add(customGroupCreationFee...))
 
-			groupGaugeId, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, tc.coins, tc.numEpochPaidOver, s.TestAccs[tc.creatorAddressIndex], tc.poolIDs)
+			groupGaugeId, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, tc.coins, tc.numEpochPaidOver, s.TestAccs[tc.creatorAddressIndex], tc.poolIDs, types.ByVolume, nil)
 
 			if tc.expectErr != nil {
 				s.Require().Error(err)
@@ -349,7 +349,7 @@ func (s *KeeperTestSuite) runCreateGroupInternalTests(poolInfo apptesting.Suppor
 			// Always fund the account with fullyFundedAddressIndex
 			s.FundAcc(s.TestAccs[fullyFundedAddressIndex], tc.coins.Add(customGroupCreationFee...))
 
-			groupReturn, err := s.App.IncentivesKeeper.CreateGroupInternal(s.Ctx, tc.coins, tc.numEpochPaidOver, s.TestAccs[tc.creatorAddressIndex], tc.poolIDs)
+			groupReturn, err := s.App.IncentivesKeeper.CreateGroupInternal(s.Ctx, tc.coins, tc.numEpochPaidOver, s.TestAccs[tc.creatorAddressIndex], tc.poolIDs, types.ByVolume, nil)
 			if tc.expectErr != nil {
 				s.Require().Error(err)
 				s.Require().ErrorContains(err, tc.expectErr.Error())
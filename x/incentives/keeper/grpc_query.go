@@ -248,6 +248,77 @@ func (q Querier) Params(goCtx context.Context, req *types.ParamsRequest) (*types
 	}, nil
 }
 
+// LockRewards returns the rewards accrued to a lock that have not been claimed yet.
+func (q Querier) LockRewards(goCtx context.Context, req *types.QueryLockRewardsRequest) (*types.QueryLockRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, _, err := q.Keeper.GetLockRewardRecord(ctx, req.LockId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// include the rewards distributed through the reward growth accumulators that are not settled yet.
+	pendingRewards, err := q.Keeper.getPendingLockRewards(ctx, req.LockId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryLockRewardsResponse{Rewards: record.Rewards.Add(pendingRewards...)}, nil
+}
+
+// UpcomingEmissions returns the projected per epoch emissions of all active and upcoming gauges attached to a pool.
+func (q Querier) UpcomingEmissions(goCtx context.Context, req *types.QueryUpcomingEmissionsRequest) (*types.QueryUpcomingEmissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.NumEpochs == 0 || req.NumEpochs > types.MaxUpcomingEmissionsEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "number of epochs must be between 1 and %d, got %d", types.MaxUpcomingEmissionsEpochs, req.NumEpochs)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryUpcomingEmissionsResponse{Emissions: q.Keeper.GetUpcomingEmissions(ctx, req.PoolId, req.NumEpochs)}, nil
+}
+
+// GaugesByRewardDenom returns the unfinished gauges whose rewards include the given denom, along with their remaining balance of it
+// and their end epoch. If active only is set, only the gauges that are currently active are returned.
+func (q Querier) GaugesByRewardDenom(goCtx context.Context, req *types.QueryGaugesByRewardDenomRequest) (*types.QueryGaugesByRewardDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gauges := []types.GaugeRewardDenomInfo{}
+	store := ctx.KVStore(q.Keeper.storeKey)
+	valStore := prefix.NewStore(store, types.KeyPrefixGaugesByRewardDenomForDenom(req.Denom))
+	pageRes, err := query.FilteredPaginate(valStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		gauge, err := q.Keeper.GetGaugeByID(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return false, err
+		}
+		if req.ActiveOnly && !gauge.IsActiveGauge(ctx.BlockTime()) {
+			return false, nil
+		}
+		if accumulate {
+			remaining := gauge.Coins.AmountOf(req.Denom).Sub(gauge.DistributedCoins.AmountOf(req.Denom))
+			gauges = append(gauges, types.GaugeRewardDenomInfo{
+				Gauge:     *gauge,
+				Remaining: sdk.NewCoin(req.Denom, remaining),
+				EndEpoch:  q.Keeper.GetGaugeEndEpoch(ctx, *gauge),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryGaugesByRewardDenomResponse{
+		Gauges:     gauges,
+		Pagination: pageRes,
+	}, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from the json bytes of gaugeIDs.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
@@ -386,15 +457,21 @@ func (q Querier) filterByPrefixAndDenom(ctx sdk.Context, prefixType []byte, deno
 }
 
 // queryWeightSplitGroup calculates the ratio of volume for each gauge in a group since the last epoch.
-// It first updates the group weights based on the pool volumes.
+// It first updates the group weights based on the pool volumes, or evenly under the even splitting policy.
 // Then, for each gauge in the updated group, it calculates the ratio of the gauge's current weight to the total weight of the group.
 // If the total weight of the group is zero, the ratio of volume for the gauge is set to zero.
 // The function returns a slice of GaugeVolume, each representing a gauge and its ratio of volume.
 // It returns an error if there is an issue updating the group weights.
 func (k Keeper) queryWeightSplitGroup(ctx sdk.Context, group types.Group) ([]types.GaugeWeight, error) {
-	updatedGroup, err := k.calculateGroupWeights(ctx, group)
-	if err != nil {
-		return nil, err
+	var updatedGroup types.Group
+	if group.SplittingPolicy == types.Evenly {
+		updatedGroup = calculateEvenSplitGroupWeights(group)
+	} else {
+		var err error
+		updatedGroup, err = k.calculateGroupWeights(ctx, group)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	gaugeVolumes := make([]types.GaugeWeight, len(updatedGroup.InternalGaugeInfo.GaugeRecords))
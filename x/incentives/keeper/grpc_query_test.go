@@ -546,6 +546,134 @@ func (s *KeeperTestSuite) TestRewardsEstWithPoolIncentives() {
 	s.Require().Equal(res.Coins, coins.Add(mintCoins))
 }
 
+// TestGRPCUpcomingEmissions tests that querying upcoming emissions via gRPC projects the per epoch emissions
+// of the active and upcoming gauges attached to the pool only.
+func (s *KeeperTestSuite) TestGRPCUpcomingEmissions() {
+	s.SetupTest()
+	epochInfo := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx)
+	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "gamm/pool/100",
+		Duration:      s.App.IncentivesKeeper.GetLockableDurations(s.Ctx)[0],
+	}
+	otherPoolDistrTo := distrTo
+	otherPoolDistrTo.Denom = "gamm/pool/101"
+
+	// without gauges, the projected emissions are empty
+	res, err := s.querier.UpcomingEmissions(s.Ctx, &types.QueryUpcomingEmissionsRequest{PoolId: 100, NumEpochs: 3})
+	s.Require().NoError(err)
+	s.Require().Len(res.Emissions, 3)
+	for i, emission := range res.Emissions {
+		s.Require().True(emission.Coins.IsZero())
+		if i > 0 {
+			s.Require().Equal(res.Emissions[i-1].EpochNumber+1, emission.EpochNumber)
+			s.Require().Equal(res.Emissions[i-1].DistributionTime.Add(epochInfo.Duration), emission.DistributionTime)
+		}
+	}
+	firstDistrTime := res.Emissions[0].DistributionTime
+
+	// active gauge paying out over two epochs
+	s.CreateGauge(false, addr, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, distrTo, s.Ctx.BlockTime(), 2)
+	// perpetual gauge starting after the first distribution
+	s.CreateGauge(true, addr, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, distrTo, firstDistrTime.Add(time.Second), 1)
+	// gauge attached to another pool
+	s.CreateGauge(false, addr, sdk.Coins{sdk.NewInt64Coin("stake", 1000)}, otherPoolDistrTo, s.Ctx.BlockTime(), 1)
+
+	res, err = s.querier.UpcomingEmissions(s.Ctx, &types.QueryUpcomingEmissionsRequest{PoolId: 100, NumEpochs: 3})
+	s.Require().NoError(err)
+	s.Require().Len(res.Emissions, 3)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), res.Emissions[0].Coins)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), res.Emissions[1].Coins)
+	s.Require().True(res.Emissions[2].Coins.IsZero())
+
+	_, err = s.querier.UpcomingEmissions(s.Ctx, &types.QueryUpcomingEmissionsRequest{PoolId: 100, NumEpochs: 0})
+	s.Require().Error(err)
+	_, err = s.querier.UpcomingEmissions(s.Ctx, &types.QueryUpcomingEmissionsRequest{PoolId: 100, NumEpochs: types.MaxUpcomingEmissionsEpochs + 1})
+	s.Require().Error(err)
+}
+
+// TestGRPCGaugesByRewardDenom tests querying gauges by reward denom via gRPC returns the gauges rewarding the denom,
+// with their remaining balance of it and their end epoch.
+func (s *KeeperTestSuite) TestGRPCGaugesByRewardDenom() {
+	s.SetupTest()
+	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "lptoken",
+		Duration:      time.Second,
+	}
+	firstEmission := s.App.IncentivesKeeper.GetUpcomingEmissions(s.Ctx, 0, 1)[0]
+
+	// active gauge paying out stake and foo over two epochs
+	activeGaugeID, _ := s.CreateGauge(false, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("foo", 50)), distrTo, s.Ctx.BlockTime(), 2)
+	// perpetual gauge starting after the first distribution
+	upcomingGaugeID, _ := s.CreateGauge(true, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), distrTo, firstEmission.DistributionTime.Add(time.Second), 1)
+	// active gauge paying out foo over one epoch, to which stake is added afterwards
+	addedToGaugeID, _ := s.CreateGauge(false, addr, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), distrTo, s.Ctx.BlockTime(), 1)
+	s.AddToGauge(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), addedToGaugeID)
+
+	getGauges := func(denom string, activeOnly bool, pagination *query.PageRequest) []types.GaugeRewardDenomInfo {
+		res, err := s.querier.GaugesByRewardDenom(s.Ctx, &types.QueryGaugesByRewardDenomRequest{Denom: denom, ActiveOnly: activeOnly, Pagination: pagination})
+		s.Require().NoError(err)
+		return res.Gauges
+	}
+	type expectedGauge struct {
+		id        uint64
+		remaining sdk.Coin
+		endEpoch  int64
+	}
+	requireGauges := func(expected []expectedGauge, gauges []types.GaugeRewardDenomInfo) {
+		s.Require().Len(gauges, len(expected))
+		for i, gauge := range gauges {
+			s.Require().Equal(expected[i].id, gauge.Gauge.Id)
+			s.Require().Equal(expected[i].remaining, gauge.Remaining)
+			s.Require().Equal(expected[i].endEpoch, gauge.EndEpoch)
+		}
+	}
+
+	requireGauges([]expectedGauge{
+		{activeGaugeID, sdk.NewInt64Coin("stake", 100), firstEmission.EpochNumber + 1},
+		{upcomingGaugeID, sdk.NewInt64Coin("stake", 30), 0},
+		{addedToGaugeID, sdk.NewInt64Coin("stake", 5), firstEmission.EpochNumber},
+	}, getGauges("stake", false, nil))
+	requireGauges([]expectedGauge{
+		{activeGaugeID, sdk.NewInt64Coin("stake", 100), firstEmission.EpochNumber + 1},
+		{addedToGaugeID, sdk.NewInt64Coin("stake", 5), firstEmission.EpochNumber},
+	}, getGauges("stake", true, nil))
+	requireGauges([]expectedGauge{
+		{activeGaugeID, sdk.NewInt64Coin("foo", 50), firstEmission.EpochNumber + 1},
+	}, getGauges("foo", false, &query.PageRequest{Limit: 1}))
+	s.Require().Empty(getGauges("bar", false, nil))
+
+	// the remaining balance decreases as the gauge distributes
+	activeGauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, activeGaugeID)
+	s.Require().NoError(err)
+	activeGauge.DistributedCoins = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	err = s.App.IncentivesKeeper.SetGauge(s.Ctx, activeGauge)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 50), getGauges("stake", true, nil)[0].Remaining)
+
+	// a denom added to a gauge that does not reward it yet is indexed
+	s.AddToGauge(sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("foo", 5)), addedToGaugeID)
+	requireGauges([]expectedGauge{
+		{addedToGaugeID, sdk.NewInt64Coin("bar", 20), firstEmission.EpochNumber},
+	}, getGauges("bar", false, nil))
+
+	// a finished gauge is no longer indexed under its reward denoms
+	err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *activeGauge)
+	s.Require().NoError(err)
+	err = s.App.IncentivesKeeper.MoveActiveGaugeToFinishedGauge(s.Ctx, *activeGauge)
+	s.Require().NoError(err)
+	requireGauges([]expectedGauge{
+		{addedToGaugeID, sdk.NewInt64Coin("foo", 15), firstEmission.EpochNumber},
+	}, getGauges("foo", false, nil))
+	s.Require().Len(getGauges("stake", false, nil), 2)
+
+	_, err = s.querier.GaugesByRewardDenom(s.Ctx, &types.QueryGaugesByRewardDenomRequest{})
+	s.Require().Error(err)
+}
+
 // TestGRPCToDistributeCoins tests querying coins that are going to be distributed via gRPC returns the correct response.
 func (s *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	s.SetupTest()
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
@@ -26,7 +27,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		}
 
 		ctx.Logger().Info(fmt.Sprintf("x/incentives AfterEpochEnd, num groups %d, %d", len(groups), ctx.BlockHeight()))
-		err = k.AllocateAcrossGauges(ctx, groups)
+		err = k.AllocateAcrossGauges(ctx, groups, epochNumber)
 		if err != nil {
 			return err
 		}
@@ -98,3 +99,93 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// ___________________________________________________________________________________________________
+
+// LockupHooks is the wrapper struct of the incentives keeper for lockup hooks.
+// It keeps the reward growth checkpoints of locks in sync with their coins and durations.
+type LockupHooks struct {
+	k Keeper
+}
+
+var _ lockuptypes.LockupHooks = LockupHooks{}
+
+// LockupHooks returns the lockup hook wrapper struct.
+func (k Keeper) LockupHooks() LockupHooks {
+	return LockupHooks{k}
+}
+
+// AfterAddTokensToLock is a no-op, as adding tokens to a lock runs OnTokenLocked as well.
+func (h LockupHooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+}
+
+// OnTokenLocked checkpoints a new lock, or settles and checkpoints a lock tokens were added to.
+func (h LockupHooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.syncLockRewardCheckpoint(ctx, lockID)
+}
+
+// OnStartUnlock is a no-op, as unlocking locks keep their shares until they are unlocked.
+func (h LockupHooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+// BeforeTokenUnlocked settles the rewards of the lock being unlocked and deletes its checkpoint.
+// The rewards are accrued to its reward record with its current reward receiver, which they are sent to
+// once its owner claims them, since the lock is deleted by then.
+func (h LockupHooks) BeforeTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	if err := h.k.settleLockRewards(ctx, lockID, lock.Owner, lock.RewardReceiverAddress); err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+	}
+	h.k.deleteLockRewardCheckpoint(ctx, lockID)
+}
+
+// OnTokenUnlocked is a no-op, as the rewards of unlocked locks are settled before the locks are deleted.
+func (h LockupHooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+// OnTokenSlashed settles and checkpoints the slashed lock.
+func (h LockupHooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	h.syncLockRewardCheckpoint(ctx, lockID)
+}
+
+// OnLockupExtend settles and checkpoints the lock whose duration changed.
+func (h LockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+	h.syncLockRewardCheckpoint(ctx, lockID)
+}
+
+// OnLockSplit settles and checkpoints the split lock, and checkpoints the lock split from it.
+func (h LockupHooks) OnLockSplit(ctx sdk.Context, lockID, splitLockID uint64) {
+	h.syncLockRewardCheckpoint(ctx, lockID)
+	h.syncLockRewardCheckpoint(ctx, splitLockID)
+}
+
+// OnLocksMerged settles the rewards of the deleted merged locks and deletes their checkpoints, then settles
+// and checkpoints the lock they were merged into. Since merged locks have the same owner and reward receiver,
+// the rewards of the deleted locks are accrued to those of the remaining lock.
+func (h LockupHooks) OnLocksMerged(ctx sdk.Context, lockID uint64, mergedLockIDs []uint64) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	for _, mergedLockID := range mergedLockIDs {
+		rewards, err := h.k.getPendingLockRewards(ctx, mergedLockID)
+		if err != nil {
+			h.k.Logger(ctx).Error(err.Error())
+		} else if !rewards.Empty() {
+			h.k.accrueLockRewards(ctx, types.LockRewardRecord{LockId: lockID, Owner: lock.Owner, RewardReceiver: lock.RewardReceiverAddress, Rewards: rewards})
+		}
+		h.k.deleteLockRewardCheckpoint(ctx, mergedLockID)
+	}
+	h.syncLockRewardCheckpoint(ctx, lockID)
+}
+
+func (h LockupHooks) syncLockRewardCheckpoint(ctx sdk.Context, lockID uint64) {
+	if err := h.k.syncLockRewardCheckpoint(ctx, lockID); err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+	}
+}
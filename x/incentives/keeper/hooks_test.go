@@ -74,7 +74,7 @@ func (s *KeeperTestSuite) TestAfterEpochEnd_Group_General() {
 	// Setup volumes to let group creation pass.
 	s.SetupVolumeForPools(perpetualGroupPoolIDs, unevenPoolVolumes, map[uint64]osmomath.Int{})
 
-	perpetualGroupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], perpetualGroupPoolIDs)
+	perpetualGroupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], perpetualGroupPoolIDs, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	// Update volumes post-group creation
@@ -91,7 +91,7 @@ func (s *KeeperTestSuite) TestAfterEpochEnd_Group_General() {
 	// Setup volumes to let group creation pass.
 	s.SetupVolumeForPools(nonPerpetualGroupPoolIDs, equalPoolVolumes, map[uint64]osmomath.Int{})
 
-	nonPerpetualGroupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...).Add(defaultCoins...), types.PerpetualNumEpochsPaidOver+3, s.TestAccs[0], nonPerpetualGroupPoolIDs)
+	nonPerpetualGroupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...).Add(defaultCoins...), types.PerpetualNumEpochsPaidOver+3, s.TestAccs[0], nonPerpetualGroupPoolIDs, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	// Update volumes post-group creation
@@ -225,11 +225,11 @@ func (s *KeeperTestSuite) TestAfterEpochEnd_Group_OverlappingPoolsInGroups() {
 	s.SetupVolumeForPools(overlappingPoolIDs, unevenPoolVolumes, poolIDToVolumeMap)
 
 	// Create first group
-	_, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], overlappingPoolIDs)
+	_, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], overlappingPoolIDs, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	// Create second group
-	_, err = s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...).Add(defaultCoins...), types.PerpetualNumEpochsPaidOver+3, s.TestAccs[0], overlappingPoolIDs)
+	_, err = s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...).Add(defaultCoins...), types.PerpetualNumEpochsPaidOver+3, s.TestAccs[0], overlappingPoolIDs, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	// Calculate the expected distribution
@@ -265,7 +265,7 @@ func (s *KeeperTestSuite) TestAfterEpochEnd_Group_NoVolumeOnePool_SkipSilent() {
 	poolIDsGroupOne := []uint64{poolAndGaugeInfoOne.ConcentratedPoolID, poolAndGaugeInfoOne.StableSwapPoolID}
 	// setup initial volumes so that a Group can be created.
 	s.overwriteVolumes(poolIDsGroupOne, []osmomath.Int{defaultVolumeAmount, defaultVolumeAmount})
-	_, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], poolIDsGroupOne)
+	_, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], poolIDsGroupOne, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	// Create the second set of pools with internal gauges and a group for them.
@@ -273,7 +273,7 @@ func (s *KeeperTestSuite) TestAfterEpochEnd_Group_NoVolumeOnePool_SkipSilent() {
 	poolIDsGroupTwo := []uint64{poolAndGaugeInfoTwo.ConcentratedPoolID, poolAndGaugeInfoTwo.StableSwapPoolID}
 	// setup initial volumes so that a Group can be created.
 	s.overwriteVolumes(poolIDsGroupTwo, []osmomath.Int{defaultVolumeAmount, defaultVolumeAmount})
-	_, err = s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], poolIDsGroupTwo)
+	_, err = s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], poolIDsGroupTwo, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	// Overwrite the volumes with zero amounts to trigger an error.
@@ -326,7 +326,7 @@ func (s *KeeperTestSuite) Test_AfterEpochEnd_Group_ChangeVolumeBetween() {
 	s.SetupVolumeForPools(poolIDsGroup, unevenPoolVolumes, poolIDToVolumeMap)
 
 	// Create non-perpetual group distribution over 2 epochs.
-	_, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...), types.PerpetualNumEpochsPaidOver+2, s.TestAccs[0], poolIDsGroup)
+	_, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...), types.PerpetualNumEpochsPaidOver+2, s.TestAccs[0], poolIDsGroup, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	distrEpochIdentifier := s.App.IncentivesKeeper.GetParams(s.Ctx).DistrEpochIdentifier
@@ -386,7 +386,7 @@ func (s *KeeperTestSuite) Test_AfterEpochEnd_Group_CreateGroupsBetween() {
 	s.SetupVolumeForPools(poolIDsGroup, equalPoolVolumes, poolIDToVolumeMap)
 
 	// Create non-perpetual group distribution over 2 epochs.
-	_, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...), types.PerpetualNumEpochsPaidOver+2, s.TestAccs[0], poolIDsGroup)
+	_, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins.Add(defaultCoins...), types.PerpetualNumEpochsPaidOver+2, s.TestAccs[0], poolIDsGroup, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	distrEpochIdentifier := s.App.IncentivesKeeper.GetParams(s.Ctx).DistrEpochIdentifier
@@ -401,7 +401,7 @@ func (s *KeeperTestSuite) Test_AfterEpochEnd_Group_CreateGroupsBetween() {
 	s.validateDistributionForGroup(poolIDsGroup, poolIDToExpectedDistributionMap)
 
 	// Create perpetual group distributing to the same pool (for ease of setup)
-	_, err = s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], poolIDsGroup)
+	_, err = s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], poolIDsGroup, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	s.IncreaseVolumeForPools(poolIDsGroup, equalPoolVolumes)
@@ -421,6 +421,59 @@ func (s *KeeperTestSuite) Test_AfterEpochEnd_Group_CreateGroupsBetween() {
 	s.validateDistributionForGroup(poolIDsGroup, expectedFinalCoinsDistributed)
 }
 
+// This test focuses on the emission decay of a perpetual group that is refilled between epochs.
+// The structure is:
+// Setup even volume across pools
+// Create a perpetual group with defaultCoins and an emission decay factor of 0.5
+// Call after epoch hook, refill the group gauge with defaultCoins and increase the volume, for 3 epochs
+// Validate that every epoch distributes its remaining coins multiplied by 0.5^epochsElapsed
+func (s *KeeperTestSuite) Test_AfterEpochEnd_Group_EmissionDecayRefilled() {
+	s.SetupTest()
+
+	poolAndGaugeInfo := s.PrepareAllSupportedPools()
+	poolIDsGroup := []uint64{poolAndGaugeInfo.ConcentratedPoolID, poolAndGaugeInfo.StableSwapPoolID}
+
+	equalPoolVolumes, _ := setupEqualVolumeWeights(len(poolIDsGroup), oneMillionVolumeAmt)
+	s.SetupVolumeForPools(poolIDsGroup, equalPoolVolumes, map[uint64]osmomath.Int{})
+
+	emissionDecay := &types.EmissionDecay{Factor: osmomath.MustNewDecFromStr("0.5")}
+	groupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], poolIDsGroup, types.ByVolume, emissionDecay)
+	s.Require().NoError(err)
+
+	distrEpochIdentifier := s.App.IncentivesKeeper.GetParams(s.Ctx).DistrEpochIdentifier
+	startEpoch := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).CurrentEpoch
+
+	group, err := s.App.IncentivesKeeper.GetGroupByGaugeID(s.Ctx, groupGaugeID)
+	s.Require().NoError(err)
+	s.Require().Equal(startEpoch, group.EmissionDecayStartEpoch)
+
+	// Without the decay, every epoch would distribute the defaultCoins refilled in it.
+	// 1. All of the defaultCoins.
+	// 2. Half of the refilled defaultCoins, leaving 0.5 x defaultCoins.
+	// 3. A quarter of the 1.5 x defaultCoins remaining after the refill.
+	expectedDistributed := []sdk.Coins{
+		defaultCoins,
+		coinutil.MulDec(defaultCoins, osmomath.MustNewDecFromStr("1.5")),
+		coinutil.MulDec(defaultCoins, osmomath.MustNewDecFromStr("1.875")),
+	}
+
+	for i, expected := range expectedDistributed {
+		if i > 0 {
+			err = s.App.IncentivesKeeper.AddToGaugeRewards(s.Ctx, s.TestAccs[0], defaultCoins, groupGaugeID)
+			s.Require().NoError(err)
+		}
+		s.IncreaseVolumeForPools(poolIDsGroup, equalPoolVolumes)
+
+		// System under test
+		err = s.App.IncentivesKeeper.AfterEpochEnd(s.Ctx, distrEpochIdentifier, startEpoch+int64(i))
+		s.Require().NoError(err)
+
+		groupGauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, groupGaugeID)
+		s.Require().NoError(err)
+		s.Require().Equal(expected.String(), groupGauge.DistributedCoins.String(), "epoch %d", i)
+	}
+}
+
 // This test focuses on configuring volume by swapping instead of using
 // a direct volume setter helper in poolmanager contrary to all other tests.
 // Since we track volume in bond denom (OSMO), we first setup 2 pools that are paired with the bond denom.
@@ -474,7 +527,7 @@ func (s *KeeperTestSuite) Test_AfterEpochEnd_Group_SwapAndDistribute() {
 	s.increaseVolumeBySwap(fooBARPoolID, barCoinIn, defaultAmount, FOO)
 
 	// Create a perpetual group.
-	_, err = s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], []uint64{ethUSDCPoolID, fooBARPoolID})
+	_, err = s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], []uint64{ethUSDCPoolID, fooBARPoolID}, types.ByVolume, nil)
 	s.Require().NoError(err)
 
 	distrEpochIdentifier := s.App.IncentivesKeeper.GetParams(s.Ctx).DistrEpochIdentifier
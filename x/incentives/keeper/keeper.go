@@ -29,6 +29,7 @@ type Keeper struct {
 	pmk        types.PoolManagerKeeper
 	pik        types.PoolIncentiveKeeper
 	prk        types.ProtorevKeeper
+	tfk        types.TokenFactoryKeeper
 }
 
 // NewKeeper returns a new instance of the incentive module keeper struct.
@@ -88,3 +89,8 @@ func (k Keeper) GetLockableDurations(ctx sdk.Context) []time.Duration {
 func (k *Keeper) SetPoolIncentivesKeeper(poolIncentiveKeeper types.PoolIncentiveKeeper) {
 	k.pik = poolIncentiveKeeper
 }
+
+// SetTokenFactoryKeeper sets tokenfactory keeper
+func (k *Keeper) SetTokenFactoryKeeper(tokenFactoryKeeper types.TokenFactoryKeeper) {
+	k.tfk = tokenFactoryKeeper
+}
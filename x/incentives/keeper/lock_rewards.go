@@ -0,0 +1,335 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+)
+
+// rewardGrowthScalingFactor scales up the reward growth per share stored in the reward growth accumulators and
+// lock checkpoints. Lock shares such as gamm shares have large amounts, so without scaling, the growth per share
+// of small distributions would be mostly lost to the 18 decimals of precision.
+var rewardGrowthScalingFactor = osmomath.NewDec(1e18)
+
+// GetLockRewardRecord returns the rewards accrued to the given lock that have not been claimed yet.
+// Returns false if there are no such rewards.
+func (k Keeper) GetLockRewardRecord(ctx sdk.Context, lockId uint64) (types.LockRewardRecord, bool, error) {
+	record := types.LockRewardRecord{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyLockRewardRecord(lockId), &record)
+	if err != nil || !found {
+		return types.LockRewardRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// GetAllLockRewardRecords returns the rewards accrued to all locks that have not been claimed yet.
+func (k Keeper) GetAllLockRewardRecords(ctx sdk.Context) ([]types.LockRewardRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixLockRewardRecord, func(bz []byte) (types.LockRewardRecord, error) {
+		record := types.LockRewardRecord{}
+		err := record.Unmarshal(bz)
+		return record, err
+	})
+}
+
+func (k Keeper) setLockRewardRecord(ctx sdk.Context, record types.LockRewardRecord) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyLockRewardRecord(record.LockId), &record)
+}
+
+func (k Keeper) deleteLockRewardRecord(ctx sdk.Context, lockId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyLockRewardRecord(lockId))
+}
+
+// accrueLockRewards adds the rewards of the given record to the rewards already accrued to its lock.
+// The owner and reward receiver of the stored record are updated to those of the given record.
+func (k Keeper) accrueLockRewards(ctx sdk.Context, record types.LockRewardRecord) {
+	existingRecord, found, err := k.GetLockRewardRecord(ctx, record.LockId)
+	if err != nil {
+		panic(err)
+	}
+	if found {
+		record.Rewards = record.Rewards.Add(existingRecord.Rewards...)
+	}
+	k.setLockRewardRecord(ctx, record)
+}
+
+// ClaimLockRewards settles the rewards distributed to the given locks through the reward growth accumulators,
+// sends the rewards accrued to the locks to the locks' reward receivers, and removes the locks' reward records.
+// Returns the total rewards claimed.
+// If a lock still exists, its current reward receiver is used, otherwise the reward receiver
+// recorded when the rewards were last accrued.
+// Returns error if:
+// - no rewards have been accrued to any of the locks.
+// - any of the locks is not owned by the given owner.
+func (k Keeper) ClaimLockRewards(ctx sdk.Context, owner sdk.AccAddress, lockIds []uint64) (sdk.Coins, error) {
+	claimedRewards := sdk.NewCoins()
+	for _, lockId := range lockIds {
+		// settle the rewards distributed to the lock through the reward growth accumulators, if it still exists.
+		if _, err := k.lk.GetLockByID(ctx, lockId); err == nil {
+			if err := k.syncLockRewardCheckpoint(ctx, lockId); err != nil {
+				return nil, err
+			}
+		}
+
+		record, found, err := k.GetLockRewardRecord(ctx, lockId)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, types.LockRewardRecordNotFoundError{LockId: lockId}
+		}
+		if record.Owner != owner.String() {
+			return nil, types.LockRewardRecordOwnerMismatchError{LockId: lockId, Owner: record.Owner, Sender: owner.String()}
+		}
+
+		rewardReceiver := record.RewardReceiver
+		if lock, err := k.lk.GetLockByID(ctx, lockId); err == nil {
+			rewardReceiver = lock.RewardReceiverAddress
+		}
+		// if the reward receiver is an empty string, it indicates that the owner is the reward receiver.
+		if rewardReceiver == "" {
+			rewardReceiver = record.Owner
+		}
+		rewardReceiverAddr, err := sdk.AccAddressFromBech32(rewardReceiver)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, rewardReceiverAddr, record.Rewards); err != nil {
+			return nil, err
+		}
+		k.deleteLockRewardRecord(ctx, lockId)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtClaimRewards,
+				sdk.NewAttribute(types.AttributeLockID, osmoutils.Uint64ToString(lockId)),
+				sdk.NewAttribute(types.AttributeReceiver, rewardReceiver),
+				sdk.NewAttribute(types.AttributeAmount, record.Rewards.String()),
+			),
+		})
+
+		claimedRewards = claimedRewards.Add(record.Rewards...)
+	}
+	return claimedRewards, nil
+}
+
+// usesRewardGrowth returns true if the gauge distributes through the reward growth accumulators when lazy reward
+// claiming is enabled, that is if it distributes to all locks of a native denom with at least a given duration.
+// Gauges distributing to synthetic lockups, or with a recipient list or a min lock amount, accrue rewards to each
+// of their eligible locks instead.
+func usesRewardGrowth(gauge types.Gauge) bool {
+	return gauge.DistributeTo.LockQueryType == lockuptypes.ByDuration &&
+		!lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) &&
+		!gauge.HasRecipientList() &&
+		!gauge.HasMinLockAmount()
+}
+
+// distributeThroughRewardGrowth distributes the gauge's coins for this epoch to the locks of its denom with at least
+// its duration, by adding them per locked share to the reward growth accumulator of the denom and duration.
+// The total shares are read from the lockup accumulation store, so no lock is read or written: the rewards of each
+// lock are settled to its reward record when its coin or duration changes, or when its rewards are claimed.
+// The coins left over from rounding the growth down stay in the gauge. Returns the distributed coins.
+// CONTRACT: gauge passed in as argument must be an active gauge and usesRewardGrowth must be true for it.
+func (k Keeper) distributeThroughRewardGrowth(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins...)
+
+	// if its a perpetual gauge, we set remaining epochs to 1.
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+	if remainEpochs == uint64(0) {
+		return nil, fmt.Errorf("gauge with id of %d is not active", gauge.Id)
+	}
+
+	totalShares := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	if !totalShares.IsPositive() {
+		return nil, nil
+	}
+
+	isSpam, totalDistrCoins, err := k.skipSpamGaugeCoins(ctx, gauge, sdk.NewCoins(), remainCoins)
+	if isSpam {
+		return totalDistrCoins, err
+	}
+
+	growth := sdk.NewDecCoins()
+	totalSharesDec := osmomath.NewDecFromInt(totalShares)
+	for _, coin := range remainCoins {
+		amountPerEpoch := coin.Amount.Quo(osmomath.NewIntFromUint64(remainEpochs))
+		growthPerShare := osmomath.NewDecFromInt(amountPerEpoch).MulMut(rewardGrowthScalingFactor).QuoTruncateMut(totalSharesDec)
+		distributedAmount := growthPerShare.MulInt(totalShares).QuoTruncateMut(rewardGrowthScalingFactor).TruncateInt()
+		if !distributedAmount.IsPositive() {
+			continue
+		}
+		growth = growth.Add(sdk.NewDecCoinFromDec(coin.Denom, growthPerShare))
+		totalDistrCoins = totalDistrCoins.Add(sdk.NewCoin(coin.Denom, distributedAmount))
+	}
+	if !growth.Empty() {
+		if err := k.addRewardGrowth(ctx, gauge.DistributeTo.Denom, gauge.DistributeTo.Duration, growth); err != nil {
+			return nil, err
+		}
+	}
+
+	err = k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
+
+// GetAllRewardGrowthAccumulators returns all reward growth accumulators, ordered by denom and duration.
+func (k Keeper) GetAllRewardGrowthAccumulators(ctx sdk.Context) ([]types.RewardGrowthAccumulator, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixRewardGrowthAccumulator, parseRewardGrowthAccumulator)
+}
+
+func (k Keeper) setRewardGrowthAccumulator(ctx sdk.Context, accumulator types.RewardGrowthAccumulator) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyRewardGrowthAccumulator(accumulator.Denom, accumulator.Duration), &accumulator)
+}
+
+// addRewardGrowth adds the given growth per share to the reward growth accumulator of the given denom and duration,
+// creating it if it does not exist yet.
+func (k Keeper) addRewardGrowth(ctx sdk.Context, denom string, duration time.Duration, growth sdk.DecCoins) error {
+	accumulator := types.RewardGrowthAccumulator{Denom: denom, Duration: duration}
+	_, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyRewardGrowthAccumulator(denom, duration), &accumulator)
+	if err != nil {
+		return err
+	}
+	accumulator.GrowthPerShare = accumulator.GrowthPerShare.Add(growth...)
+	k.setRewardGrowthAccumulator(ctx, accumulator)
+	return nil
+}
+
+// getRewardGrowthForDuration returns the reward growth per share accrued to locks of the given denom and duration,
+// that is the sum of the growth of the denom's accumulators with a duration of at most the given duration.
+func (k Keeper) getRewardGrowthForDuration(ctx sdk.Context, denom string, duration time.Duration) (sdk.DecCoins, error) {
+	// accumulator keys of a denom are ordered by duration, so the end key is the one right after the given duration.
+	keyEnd := append(types.KeyPrefixRewardGrowthAccumulatorsForDenom(denom), sdk.Uint64ToBigEndian(uint64(duration)+1)...)
+	accumulators, err := osmoutils.GatherValuesFromStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardGrowthAccumulatorsForDenom(denom), keyEnd, parseRewardGrowthAccumulator)
+	if err != nil {
+		return nil, err
+	}
+
+	growth := sdk.NewDecCoins()
+	for _, accumulator := range accumulators {
+		growth = growth.Add(accumulator.GrowthPerShare...)
+	}
+	return growth, nil
+}
+
+func parseRewardGrowthAccumulator(bz []byte) (types.RewardGrowthAccumulator, error) {
+	accumulator := types.RewardGrowthAccumulator{}
+	err := accumulator.Unmarshal(bz)
+	return accumulator, err
+}
+
+// GetAllLockRewardCheckpoints returns the reward growth checkpoints of all locks.
+func (k Keeper) GetAllLockRewardCheckpoints(ctx sdk.Context) ([]types.LockRewardCheckpoint, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixLockRewardCheckpoint, func(bz []byte) (types.LockRewardCheckpoint, error) {
+		checkpoint := types.LockRewardCheckpoint{}
+		err := checkpoint.Unmarshal(bz)
+		return checkpoint, err
+	})
+}
+
+func (k Keeper) getLockRewardCheckpoint(ctx sdk.Context, lockId uint64) (types.LockRewardCheckpoint, bool, error) {
+	checkpoint := types.LockRewardCheckpoint{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyLockRewardCheckpoint(lockId), &checkpoint)
+	if err != nil || !found {
+		return types.LockRewardCheckpoint{}, false, err
+	}
+	return checkpoint, true, nil
+}
+
+func (k Keeper) setLockRewardCheckpointRecord(ctx sdk.Context, checkpoint types.LockRewardCheckpoint) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyLockRewardCheckpoint(checkpoint.LockId), &checkpoint)
+}
+
+// setLockRewardCheckpoint checkpoints the given coin and duration of a lock, along with the reward growth per share
+// currently accrued to the duration.
+func (k Keeper) setLockRewardCheckpoint(ctx sdk.Context, lockId uint64, coin sdk.Coin, duration time.Duration) error {
+	growth, err := k.getRewardGrowthForDuration(ctx, coin.Denom, duration)
+	if err != nil {
+		return err
+	}
+	k.setLockRewardCheckpointRecord(ctx, types.LockRewardCheckpoint{
+		LockId:         lockId,
+		Denom:          coin.Denom,
+		Amount:         coin.Amount,
+		Duration:       duration,
+		GrowthPerShare: growth,
+	})
+	return nil
+}
+
+func (k Keeper) deleteLockRewardCheckpoint(ctx sdk.Context, lockId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyLockRewardCheckpoint(lockId))
+}
+
+// getPendingLockRewards returns the rewards distributed to the lock through the reward growth accumulators since
+// its checkpoint, that is its checkpointed amount times the growth per share accrued to its checkpointed duration
+// since, scaled down and rounded down. Returns no rewards if the lock has no checkpoint.
+func (k Keeper) getPendingLockRewards(ctx sdk.Context, lockId uint64) (sdk.Coins, error) {
+	checkpoint, found, err := k.getLockRewardCheckpoint(ctx, lockId)
+	if err != nil || !found {
+		return sdk.NewCoins(), err
+	}
+
+	growth, err := k.getRewardGrowthForDuration(ctx, checkpoint.Denom, checkpoint.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := sdk.NewCoins()
+	for _, denomGrowth := range growth {
+		growthSinceCheckpoint := denomGrowth.Amount.Sub(checkpoint.GrowthPerShare.AmountOf(denomGrowth.Denom))
+		amount := growthSinceCheckpoint.MulInt(checkpoint.Amount).QuoTruncateMut(rewardGrowthScalingFactor).TruncateInt()
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.NewCoin(denomGrowth.Denom, amount))
+		}
+	}
+	return rewards, nil
+}
+
+// settleLockRewards accrues the rewards distributed to the lock through the reward growth accumulators since its
+// checkpoint to its reward record. The checkpoint is left as is, so it must be updated or deleted by the caller.
+func (k Keeper) settleLockRewards(ctx sdk.Context, lockId uint64, owner, rewardReceiver string) error {
+	rewards, err := k.getPendingLockRewards(ctx, lockId)
+	if err != nil || rewards.Empty() {
+		return err
+	}
+	k.accrueLockRewards(ctx, types.LockRewardRecord{LockId: lockId, Owner: owner, RewardReceiver: rewardReceiver, Rewards: rewards})
+	return nil
+}
+
+// syncLockRewardCheckpoint settles the rewards distributed to the lock through the reward growth accumulators since
+// its checkpoint, and checkpoints its current coin and duration. It must be called whenever the coin or duration of
+// a lock changes, so that the lock is rewarded for the shares it held when the rewards were distributed.
+// Locks not holding exactly one coin have no checkpoint.
+func (k Keeper) syncLockRewardCheckpoint(ctx sdk.Context, lockId uint64) error {
+	lock, err := k.lk.GetLockByID(ctx, lockId)
+	if err != nil {
+		return err
+	}
+	if err := k.settleLockRewards(ctx, lockId, lock.Owner, lock.RewardReceiverAddress); err != nil {
+		return err
+	}
+	if len(lock.Coins) != 1 {
+		k.deleteLockRewardCheckpoint(ctx, lockId)
+		return nil
+	}
+	return k.setLockRewardCheckpoint(ctx, lockId, lock.Coins[0], lock.Duration)
+}
+
+// BackfillLockRewardCheckpoints checkpoints all existing locks holding exactly one coin, streaming them from the
+// lockup store. Locks created afterwards are checkpointed through the lockup hooks.
+func (k Keeper) BackfillLockRewardCheckpoints(ctx sdk.Context) error {
+	return k.lk.IterateLocks(ctx, func(lock lockuptypes.PeriodLock) error {
+		if len(lock.Coins) != 1 {
+			return nil
+		}
+		return k.setLockRewardCheckpoint(ctx, lock.ID, lock.Coins[0], lock.Duration)
+	})
+}
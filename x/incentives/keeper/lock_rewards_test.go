@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/incentives/types"
+)
+
+// TestDistribute_LazyRewardClaiming tests that with lazy reward claiming enabled, lock rewards are distributed
+// through the reward growth accumulators instead of being sent, and that they can be claimed by the lock owner only.
+func (s *KeeperTestSuite) TestDistribute_LazyRewardClaiming() {
+	s.SetupTest()
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	params.LazyRewardClaiming = true
+	s.App.IncentivesKeeper.SetParams(s.Ctx, params)
+
+	lockOwner, gaugeID, _, startTime := s.SetupLockAndGauge(false)
+	lockID := uint64(1)
+	expectedRewards := sdk.Coins{sdk.NewInt64Coin("stake", 5)}
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+	s.Require().NoError(err)
+	err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+	s.Require().NoError(err)
+
+	distrCoins, err := s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRewards, distrCoins)
+
+	// The rewards stay in the module account, and are not settled to a reward record until claimed.
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, lockOwner).IsZero())
+	_, found, err := s.App.IncentivesKeeper.GetLockRewardRecord(s.Ctx, lockID)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	res, err := s.querier.LockRewards(s.Ctx, &types.QueryLockRewardsRequest{LockId: lockID})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRewards, res.Rewards)
+	s.Require().Equal(distrCoins, s.App.IncentivesKeeper.GetModuleDistributedCoins(s.Ctx))
+
+	// Only the lock owner can claim the rewards.
+	otherAddr := s.TestAccs[0]
+	_, err = s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, otherAddr, []uint64{lockID})
+	s.Require().ErrorIs(err, types.LockRewardRecordOwnerMismatchError{LockId: lockID, Owner: lockOwner.String(), Sender: otherAddr.String()})
+
+	claimedRewards, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, lockOwner, []uint64{lockID})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRewards, claimedRewards)
+	s.Require().Equal(expectedRewards, s.App.BankKeeper.GetAllBalances(s.Ctx, lockOwner))
+
+	// The reward record is removed once claimed.
+	_, found, err = s.App.IncentivesKeeper.GetLockRewardRecord(s.Ctx, lockID)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	_, err = s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, lockOwner, []uint64{lockID})
+	s.Require().ErrorIs(err, types.LockRewardRecordNotFoundError{LockId: lockID})
+}
+
+// TestClaimLockRewards_RewardReceiver tests that claimed rewards are sent to the lock's current reward receiver.
+func (s *KeeperTestSuite) TestClaimLockRewards_RewardReceiver() {
+	s.SetupTest()
+	lockOwner := s.TestAccs[0]
+	rewardReceiver := s.TestAccs[1]
+	rewards := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	s.FundModuleAcc(types.ModuleName, rewards)
+
+	s.LockTokens(lockOwner, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, time.Second)
+	lockID := uint64(1)
+	err := s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, lockID, lockOwner, rewardReceiver.String())
+	s.Require().NoError(err)
+
+	s.App.IncentivesKeeper.SetLockRewardRecord(s.Ctx, types.LockRewardRecord{LockId: lockID, Owner: lockOwner.String(), Rewards: rewards})
+
+	receiverBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, rewardReceiver)
+	claimedRewards, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, lockOwner, []uint64{lockID})
+	s.Require().NoError(err)
+	s.Require().Equal(rewards, claimedRewards)
+	s.Require().Equal(receiverBalanceBefore.Add(rewards...), s.App.BankKeeper.GetAllBalances(s.Ctx, rewardReceiver))
+}
+
+// TestLockRewards_UnlockRewardReceiver tests that the rewards settled when a lock is unlocked are recorded
+// with the lock's reward receiver, and sent to it once claimed after the lock is deleted.
+func (s *KeeperTestSuite) TestLockRewards_UnlockRewardReceiver() {
+	s.SetupTest()
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	params.LazyRewardClaiming = true
+	s.App.IncentivesKeeper.SetParams(s.Ctx, params)
+
+	lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	rewardReceiver := sdk.AccAddress([]byte("addr2---------------"))
+	s.LockTokens(lockOwner, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, defaultLockDuration)
+	lockID := uint64(1)
+	err := s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, lockID, lockOwner, rewardReceiver.String())
+	s.Require().NoError(err)
+
+	gaugeID, _, _, startTime := s.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, defaultLockDuration, "lptoken")
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+	s.Require().NoError(err)
+	err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+	s.Require().NoError(err)
+	_, err = s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+	s.Require().NoError(err)
+	expectedRewards := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	// The rewards are settled with the lock's reward receiver when it is unlocked.
+	_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lockID, nil)
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(defaultLockDuration))
+	err = s.App.LockupKeeper.UnlockMaturedLock(s.Ctx, lockID)
+	s.Require().NoError(err)
+	record, found, err := s.App.IncentivesKeeper.GetLockRewardRecord(s.Ctx, lockID)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.LockRewardRecord{LockId: lockID, Owner: lockOwner.String(), RewardReceiver: rewardReceiver.String(), Rewards: expectedRewards}, record)
+
+	claimedRewards, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, lockOwner, []uint64{lockID})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRewards, claimedRewards)
+	s.Require().Equal(expectedRewards, s.App.BankKeeper.GetAllBalances(s.Ctx, rewardReceiver))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, lockOwner, "stake").IsZero())
+}
+
+// TestDistribute_RewardGrowth tests that rewards distributed through the reward growth accumulators are settled
+// to each lock according to the shares it held in every epoch, across gauges of different durations.
+func (s *KeeperTestSuite) TestDistribute_RewardGrowth() {
+	s.SetupTest()
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	params.LazyRewardClaiming = true
+	s.App.IncentivesKeeper.SetParams(s.Ctx, params)
+
+	shortLockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	longLockOwner := sdk.AccAddress([]byte("addr2---------------"))
+	s.LockTokens(shortLockOwner, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, defaultLockDuration)
+	s.LockTokens(longLockOwner, sdk.Coins{sdk.NewInt64Coin("lptoken", 30)}, 2*defaultLockDuration)
+	shortLockID, longLockID := uint64(1), uint64(2)
+
+	// The short gauge distributes to both locks, the long gauge only to the long lock.
+	shortGaugeID, _, _, startTime := s.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin("stake", 80)}, defaultLockDuration, "lptoken")
+	longGaugeID, _, _, _ := s.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, 2*defaultLockDuration, "lptoken")
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	for _, gaugeID := range []uint64{shortGaugeID, longGaugeID} {
+		gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+		s.Require().NoError(err)
+		err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+		s.Require().NoError(err)
+	}
+	distribute := func(gaugeIDs ...uint64) {
+		gauges := []types.Gauge{}
+		for _, gaugeID := range gaugeIDs {
+			gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+			s.Require().NoError(err)
+			gauges = append(gauges, *gauge)
+		}
+		_, err := s.App.IncentivesKeeper.Distribute(s.Ctx, gauges)
+		s.Require().NoError(err)
+	}
+	requireLockRewards := func(lockID uint64, expected int64) {
+		res, err := s.querier.LockRewards(s.Ctx, &types.QueryLockRewardsRequest{LockId: lockID})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", expected)}, res.Rewards)
+	}
+
+	// 80 stake over 40 shares and 60 stake over the 30 shares of the long lock.
+	distribute(shortGaugeID, longGaugeID)
+	requireLockRewards(shortLockID, 20)
+	requireLockRewards(longLockID, 120)
+
+	// Adding tokens to the short lock settles its rewards so far, before its shares change.
+	_, err := s.App.LockupKeeper.AddTokensToLockByID(s.Ctx, shortLockID, shortLockOwner, sdk.NewInt64Coin("lptoken", 30))
+	s.Require().NoError(err)
+	record, found, err := s.App.IncentivesKeeper.GetLockRewardRecord(s.Ctx, shortLockID)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 20)}, record.Rewards)
+
+	// 70 stake over 70 shares, of which the short lock now holds 40.
+	s.AddToGauge(sdk.Coins{sdk.NewInt64Coin("stake", 70)}, shortGaugeID)
+	distribute(shortGaugeID)
+	requireLockRewards(shortLockID, 60)
+	requireLockRewards(longLockID, 150)
+
+	// The long lock's rewards were never settled, and are settled when claimed.
+	_, found, err = s.App.IncentivesKeeper.GetLockRewardRecord(s.Ctx, longLockID)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	claimedRewards, err := s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, shortLockOwner, []uint64{shortLockID})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 60)}, claimedRewards)
+	s.Require().Equal(claimedRewards[0], s.App.BankKeeper.GetBalance(s.Ctx, shortLockOwner, "stake"))
+	claimedRewards, err = s.App.IncentivesKeeper.ClaimLockRewards(s.Ctx, longLockOwner, []uint64{longLockID})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 150)}, claimedRewards)
+
+	// Nothing is left to claim once the rewards are settled.
+	res, err := s.querier.LockRewards(s.Ctx, &types.QueryLockRewardsRequest{LockId: longLockID})
+	s.Require().NoError(err)
+	s.Require().True(res.Rewards.IsZero())
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 
@@ -34,11 +35,24 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, types.CreateGaugeFee, msg.Coins); err != nil {
+	fee := server.keeper.getGaugeFee(ctx, types.CreateGaugeFee, msg.DistributeTo)
+	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, fee, msg.Coins); err != nil {
 		return nil, err
 	}
 
-	gaugeID, err := server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver, msg.PoolId)
+	startTime := msg.StartTime
+	if msg.AlignStartToEpoch {
+		startTime, err = server.keeper.AlignGaugeStartTimeToEpoch(ctx, startTime)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	minLockAmount := osmomath.ZeroInt()
+	if msg.MinLockAmount != nil {
+		minLockAmount = *msg.MinLockAmount
+	}
+	gaugeID, err := server.keeper.CreateGaugeWithLockFilters(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, startTime, msg.NumEpochsPaidOver, msg.PoolId, msg.RecipientAllowlist, msg.RecipientDenylist, minLockAmount)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -62,7 +76,12 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 		return nil, err
 	}
 
-	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, types.AddToGaugeFee, msg.Rewards); err != nil {
+	gauge, err := server.keeper.GetGaugeByID(ctx, msg.GaugeId)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	fee := server.keeper.getGaugeFee(ctx, types.AddToGaugeFee, gauge.DistributeTo)
+	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, fee, msg.Rewards); err != nil {
 		return nil, err
 	}
 	err = server.keeper.AddToGaugeRewards(ctx, owner, msg.Rewards, msg.GaugeId)
@@ -80,6 +99,39 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 	return &types.MsgAddToGaugeResponse{}, nil
 }
 
+// AddToGaugeAndExtend adds coins to a non-perpetual gauge and extends the number of epochs it is paid over.
+// Emits add to gauge and extend event and returns the add to gauge and extend response.
+func (server msgServer) AddToGaugeAndExtend(goCtx context.Context, msg *types.MsgAddToGaugeAndExtend) (*types.MsgAddToGaugeAndExtendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	gauge, err := server.keeper.GetGaugeByID(ctx, msg.GaugeId)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	fee := server.keeper.getGaugeFee(ctx, types.AddToGaugeFee, gauge.DistributeTo)
+	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, fee, msg.Rewards); err != nil {
+		return nil, err
+	}
+	numEpochsPaidOver, err := server.keeper.AddToGaugeRewardsAndExtend(ctx, owner, msg.Rewards, msg.GaugeId, msg.AdditionalEpochs)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtAddToGaugeAndExtend,
+			sdk.NewAttribute(types.AttributeGaugeID, osmoutils.Uint64ToString(msg.GaugeId)),
+			sdk.NewAttribute(types.AttributeNumEpochsPaidOver, osmoutils.Uint64ToString(numEpochsPaidOver)),
+		),
+	})
+
+	return &types.MsgAddToGaugeAndExtendResponse{}, nil
+}
+
 func (server msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	owner, err := sdk.AccAddressFromBech32(msg.Owner)
@@ -87,7 +139,7 @@ func (server msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	groupID, err := server.keeper.CreateGroup(ctx, msg.Coins, msg.NumEpochsPaidOver, owner, msg.PoolIds)
+	groupID, err := server.keeper.CreateGroup(ctx, msg.Coins, msg.NumEpochsPaidOver, owner, msg.PoolIds, msg.SplittingPolicy, msg.EmissionDecay)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -101,3 +153,20 @@ func (server msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateG
 
 	return &types.MsgCreateGroupResponse{GroupId: groupID}, nil
 }
+
+// ClaimRewards claims the rewards accrued to the given locks and sends them to the locks' reward receivers.
+// Emits a claim rewards event per lock and returns the claimed rewards.
+func (server msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	claimedRewards, err := server.keeper.ClaimLockRewards(ctx, owner, msg.LockIds)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgClaimRewardsResponse{ClaimedRewards: claimedRewards}, nil
+}
@@ -30,6 +30,7 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 		accountBalanceToFund sdk.Coins
 		gaugeAddition        sdk.Coins
 		expectedEndBalance   sdk.Coins
+		gaugeFeePerLock      osmomath.Int
 		isPerpetual          bool
 		isModuleAccount      bool
 		expectErr            bool
@@ -80,6 +81,19 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 			gaugeAddition:        sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(30000000))),
 			expectErr:            true,
 		},
+		{
+			name:                 "user creates a gauge and pays the fee per lock for the lock it distributes to",
+			accountBalanceToFund: seventyTokens,
+			gaugeAddition:        tenTokens,
+			gaugeFeePerLock:      osmomath.NewInt(5000000),
+		},
+		{
+			name:                 "user tries to create a gauge but does not have enough funds to pay for the fee per lock",
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(60000000))),
+			gaugeAddition:        tenTokens,
+			gaugeFeePerLock:      osmomath.NewInt(5000000),
+			expectErr:            true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -106,6 +120,15 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 			accountKeeper.SetModuleAccount(s.Ctx, modAcc)
 		}
 
+		expectedFee := types.CreateGaugeFee
+		if !tc.gaugeFeePerLock.IsNil() {
+			params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+			params.GaugeFeePerLock = tc.gaugeFeePerLock
+			s.App.IncentivesKeeper.SetParams(s.Ctx, params)
+			// the gauge distributes to the single lock set up below.
+			expectedFee = expectedFee.Add(tc.gaugeFeePerLock)
+		}
+
 		s.SetupManyLocks(1, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
 		distrTo := lockuptypes.QueryCondition{
 			LockQueryType: lockuptypes.ByDuration,
@@ -135,7 +158,7 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 		if tc.expectErr {
 			s.Require().Equal(tc.accountBalanceToFund.String(), balanceAmount.String(), "test: %v", tc.name)
 		} else {
-			fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.CreateGaugeFee))
+			fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expectedFee))
 			accountBalance := tc.accountBalanceToFund.Sub(tc.gaugeAddition...)
 			finalAccountBalance := accountBalance.Sub(fee...)
 			s.Require().Equal(finalAccountBalance.String(), balanceAmount.String(), "test: %v", tc.name)
@@ -107,6 +107,54 @@ func (k Keeper) addGaugeIDForDenom(ctx sdk.Context, ID uint64, denom string) err
 	return k.addGaugeRefByKey(ctx, gaugeDenomStoreKey(denom), ID)
 }
 
+// addGaugeRewardDenomRefs indexes the given gauge ID under the denoms of the given coins.
+func (k Keeper) addGaugeRewardDenomRefs(ctx sdk.Context, gaugeID uint64, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range coins {
+		store.Set(types.KeyGaugeByRewardDenom(coin.Denom, gaugeID), sdk.Uint64ToBigEndian(gaugeID))
+	}
+}
+
+// addNewGaugeRewardDenomRefs indexes the given gauge under the denoms of the coins being added to it
+// that it does not reward yet. It must be called before the coins are added to the gauge.
+func (k Keeper) addNewGaugeRewardDenomRefs(ctx sdk.Context, gauge *types.Gauge, addedCoins sdk.Coins) {
+	newDenomCoins := sdk.Coins{}
+	for _, coin := range addedCoins {
+		if gauge.Coins.AmountOf(coin.Denom).IsZero() {
+			newDenomCoins = append(newDenomCoins, coin)
+		}
+	}
+	k.addGaugeRewardDenomRefs(ctx, gauge.Id, newDenomCoins)
+}
+
+// deleteGaugeRewardDenomRefs removes the given gauge from the index of gauges by reward denom.
+func (k Keeper) deleteGaugeRewardDenomRefs(ctx sdk.Context, gauge *types.Gauge) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range gauge.Coins {
+		store.Delete(types.KeyGaugeByRewardDenom(coin.Denom, gauge.Id))
+	}
+}
+
+// BackfillGaugeRewardDenomRefs indexes all unfinished gauges in state by the denoms of their reward coins.
+// It is meant to be run once, to index the gauges created before the index was introduced.
+func (k Keeper) BackfillGaugeRewardDenomRefs(ctx sdk.Context) error {
+	gauges, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixPeriodGauge, func(bz []byte) (types.Gauge, error) {
+		gauge := types.Gauge{}
+		err := proto.Unmarshal(bz, &gauge)
+		return gauge, err
+	})
+	if err != nil {
+		return err
+	}
+	for _, gauge := range gauges {
+		if gauge.IsFinishedGauge(ctx.BlockTime()) {
+			continue
+		}
+		k.addGaugeRewardDenomRefs(ctx, gauge.Id, gauge.Coins)
+	}
+	return nil
+}
+
 // SetGroup sets groupGroup for a specific key.
 // TODO: explore if we can store this better, this has GroupGaugeId in key and value
 func (k Keeper) SetGroup(ctx sdk.Context, group types.Group) {
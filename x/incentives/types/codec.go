@@ -13,6 +13,8 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGauge{}, "osmosis/incentives/create-gauge", nil)
 	cdc.RegisterConcrete(&MsgAddToGauge{}, "osmosis/incentives/add-to-gauge", nil)
+	cdc.RegisterConcrete(&MsgAddToGaugeAndExtend{}, "osmosis/incentives/add-extend-gauge", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "osmosis/incentives/claim-rewards", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateGroupsProposal{}, "osmosis/create-groups-proposal", nil)
@@ -24,6 +26,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateGauge{},
 		&MsgAddToGauge{},
+		&MsgAddToGaugeAndExtend{},
+		&MsgClaimRewards{},
 	)
 
 	registry.RegisterImplementations(
@@ -21,4 +21,21 @@ var (
 	// other than zero, the gauge is non-perpetual. Zero is invalid.
 	PerpetualNumEpochsPaidOver = uint64(0)
 	DefaultMinValueForDistr    = sdk.NewCoin(appparams.BaseCoinUnit, sdkmath.NewInt(10000)) // 0.01 OSMO
+
+	// MaxGaugeRecipientListLength is the maximum number of addresses
+	// in a gauge's recipient allowlist or denylist.
+	MaxGaugeRecipientListLength = 100
+
+	// MaxUpcomingEmissionsEpochs is the maximum number of epochs
+	// that upcoming emissions can be projected for.
+	MaxUpcomingEmissionsEpochs = uint64(365)
+
+	// MaxGaugeStartTimePastTolerance is how far in the past the start time
+	// of a gauge aligned to the distribution epoch may be.
+	MaxGaugeStartTimePastTolerance = time.Hour
+
+	// MaxBeforeSendHookRewardsGas is the gas budget for sending the rewards in denoms with a
+	// tokenfactory before send hook during a single distribution. Each send runs its hook,
+	// so the rewards left once the budget is spent are accrued to their locks to be claimed instead.
+	MaxBeforeSendHookRewardsGas = uint64(25_000_000)
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
@@ -11,6 +12,7 @@ var (
 	ErrNoPoolIDsGiven        = fmt.Errorf("no pool IDs given")
 	ErrZeroNumEpochsPaidOver = fmt.Errorf("num epochs paid over must be greater than zero for non-perpetual gauges")
 	ErrUnauthorized          = fmt.Errorf("unauthorized to perform this action. Must be an incentives module account")
+	ErrBothRecipientListsSet = fmt.Errorf("recipient allowlist and denylist cannot both be set")
 )
 
 type UnsupportedSplittingPolicyError struct {
@@ -48,6 +50,43 @@ func (e UnexpectedFinishedGaugeError) Error() string {
 	return fmt.Sprintf("gauge with ID (%d) is already finished", e.GaugeId)
 }
 
+type PerpetualGaugeExtensionError struct {
+	GaugeId uint64
+}
+
+func (e PerpetualGaugeExtensionError) Error() string {
+	return fmt.Sprintf("gauge with ID (%d) is perpetual and cannot be extended", e.GaugeId)
+}
+
+type GaugeEmissionDecreasedError struct {
+	GaugeId                  uint64
+	Denom                    string
+	EmissionPerEpoch         osmomath.Int
+	ExtendedEmissionPerEpoch osmomath.Int
+}
+
+func (e GaugeEmissionDecreasedError) Error() string {
+	return fmt.Sprintf("extending gauge with ID (%d) would decrease its emission of %s per epoch from %s to %s", e.GaugeId, e.Denom, e.EmissionPerEpoch, e.ExtendedEmissionPerEpoch)
+}
+
+type LockRewardRecordNotFoundError struct {
+	LockId uint64
+}
+
+func (e LockRewardRecordNotFoundError) Error() string {
+	return fmt.Sprintf("no rewards accrued to lock with ID (%d)", e.LockId)
+}
+
+type LockRewardRecordOwnerMismatchError struct {
+	LockId uint64
+	Owner  string
+	Sender string
+}
+
+func (e LockRewardRecordOwnerMismatchError) Error() string {
+	return fmt.Sprintf("rewards accrued to lock with ID (%d) are owned by %s, not %s", e.LockId, e.Owner, e.Sender)
+}
+
 type GroupNotFoundError struct {
 	GroupGaugeId uint64
 }
@@ -111,3 +150,70 @@ type NoRouteForDenomError struct {
 func (e NoRouteForDenomError) Error() string {
 	return fmt.Sprintf("denom %s does not exist as a protorev hot route, therefore, the value of rewards at time of epoch distribution will not be able to be determined", e.Denom)
 }
+
+type RecipientListTooLongError struct {
+	Length    int
+	MaxLength int
+}
+
+func (e RecipientListTooLongError) Error() string {
+	return fmt.Sprintf("recipient list length (%d) exceeds the maximum (%d)", e.Length, e.MaxLength)
+}
+
+type DuplicateRecipientError struct {
+	Address string
+}
+
+func (e DuplicateRecipientError) Error() string {
+	return fmt.Sprintf("recipient list contains a duplicate address: %s", e.Address)
+}
+
+type RecipientListUnsupportedError struct {
+	LockQueryType lockuptypes.LockQueryType
+}
+
+func (e RecipientListUnsupportedError) Error() string {
+	return fmt.Sprintf("recipient lists are only supported for gauges distributing by duration, got %s", e.LockQueryType)
+}
+
+type NegativeMinLockAmountError struct {
+	MinLockAmount osmomath.Int
+}
+
+func (e NegativeMinLockAmountError) Error() string {
+	return fmt.Sprintf("min lock amount (%s) cannot be negative", e.MinLockAmount)
+}
+
+type MinLockAmountUnsupportedError struct {
+	LockQueryType lockuptypes.LockQueryType
+}
+
+func (e MinLockAmountUnsupportedError) Error() string {
+	return fmt.Sprintf("min lock amount is only supported for gauges distributing by duration, got %s", e.LockQueryType)
+}
+
+type GaugeStartTimeInPastError struct {
+	StartTime time.Time
+	BlockTime time.Time
+	Tolerance time.Duration
+}
+
+func (e GaugeStartTimeInPastError) Error() string {
+	return fmt.Sprintf("gauge start time (%s) is more than %s before the block time (%s)", e.StartTime, e.Tolerance, e.BlockTime)
+}
+
+type InvalidSplittingPolicyError struct {
+	SplittingPolicy SplittingPolicy
+}
+
+func (e InvalidSplittingPolicyError) Error() string {
+	return fmt.Sprintf("invalid splitting policy: %s", e.SplittingPolicy)
+}
+
+type InvalidEmissionDecayFactorError struct {
+	Factor osmomath.Dec
+}
+
+func (e InvalidEmissionDecayFactorError) Error() string {
+	return fmt.Sprintf("emission decay factor must be in (0, 1), was %s", e.Factor)
+}
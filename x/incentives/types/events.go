@@ -2,14 +2,22 @@ package types
 
 // Incentive module event types.
 const (
-	TypeEvtCreateGauge  = "create_gauge"
-	TypeEvtAddToGauge   = "add_to_gauge"
-	TypeEvtCreateGroup  = "create_group"
-	TypeEvtDistribution = "distribution"
+	TypeEvtCreateGauge         = "create_gauge"
+	TypeEvtAddToGauge          = "add_to_gauge"
+	TypeEvtAddToGaugeAndExtend = "add_to_gauge_and_extend"
+	TypeEvtCreateGroup         = "create_group"
+	TypeEvtDistribution        = "distribution"
+	TypeEvtRewardsAccrued      = "rewards_accrued"
+	TypeEvtClaimRewards        = "claim_rewards"
+	TypeEvtRewardSendFailed    = "reward_send_failed"
 
-	AttributeGaugeID     = "gauge_id"
-	AttributeGroupID     = "group_id"
-	AttributeLockedDenom = "denom"
-	AttributeReceiver    = "receiver"
-	AttributeAmount      = "amount"
+	AttributeGaugeID           = "gauge_id"
+	AttributeNumEpochsPaidOver = "num_epochs_paid_over"
+	AttributeGroupID           = "group_id"
+	AttributeLockedDenom       = "denom"
+	AttributeReceiver          = "receiver"
+	AttributeAmount            = "amount"
+	AttributeLockID            = "lock_id"
+	AttributeNumLocks          = "num_locks"
+	AttributeError             = "error"
 )
@@ -21,6 +21,8 @@ type BankKeeper interface {
 
 	SendCoinsFromModuleToManyAccounts(ctx context.Context, senderModule string, recipientAddrs []sdk.AccAddress, amts []sdk.Coins) error
 
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
@@ -28,8 +30,10 @@ type BankKeeper interface {
 type LockupKeeper interface {
 	GetLocksLongerThanDurationDenom(ctx sdk.Context, denom string, duration time.Duration) []lockuptypes.PeriodLock
 	GetPeriodLocksAccumulation(ctx sdk.Context, query lockuptypes.QueryCondition) osmomath.Int
+	GetPeriodLocksCount(ctx sdk.Context, query lockuptypes.QueryCondition) osmomath.Int
 	GetAccountPeriodLocks(ctx sdk.Context, addr sdk.AccAddress) []lockuptypes.PeriodLock
 	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
+	IterateLocks(ctx sdk.Context, fn func(lock lockuptypes.PeriodLock) error) error
 }
 
 // EpochKeeper defines the expected interface needed to retrieve epoch info.
@@ -77,3 +81,8 @@ type PoolManagerKeeper interface {
 type ProtorevKeeper interface {
 	GetPoolForDenomPairNoOrder(ctx sdk.Context, denom1, denom2 string) (uint64, error)
 }
+
+// TokenFactoryKeeper defines the expected interface needed to look up the before send hooks of tokenfactory denoms.
+type TokenFactoryKeeper interface {
+	GetBeforeSendHook(ctx sdk.Context, denom string) string
+}
@@ -7,6 +7,7 @@ import (
 	time "time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -62,6 +63,21 @@ func (gauge Gauge) IsLastNonPerpetualDistribution() bool {
 	return !gauge.IsPerpetual && gauge.FilledEpochs+1 >= gauge.NumEpochsPaidOver
 }
 
+// NextEpochDistribution returns the coins the gauge distributes at the end of its next active epoch, assuming
+// that no coins are added to it: its remaining coins split evenly over its remaining epochs, or all of its
+// remaining coins for perpetual gauges.
+func (gauge Gauge) NextEpochDistribution() sdk.Coins {
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual && gauge.NumEpochsPaidOver > gauge.FilledEpochs {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+	distrCoins := sdk.NewCoins()
+	for _, coin := range gauge.Coins.Sub(gauge.DistributedCoins...) {
+		distrCoins = distrCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(remainEpochs))))
+	}
+	return distrCoins
+}
+
 func (gauge Gauge) IsDurationLockGauge() bool {
 	return gauge.DistributeTo.LockQueryType == lockuptypes.ByDuration
 }
@@ -86,3 +102,75 @@ func (gauge Gauge) IsLinkedToPool(poolID uint64) bool {
 	_, foundSuffix := strings.CutSuffix(gauge.DistributeTo.Denom, fmt.Sprintf("/%s", strconv.FormatUint(poolID, 10)))
 	return foundSuffix
 }
+
+// HasRecipientList returns true if the gauge restricts its distribution recipients
+// with either an allowlist or a denylist.
+func (gauge Gauge) HasRecipientList() bool {
+	return len(gauge.RecipientAllowlist) > 0 || len(gauge.RecipientDenylist) > 0
+}
+
+// IsEligibleRecipient returns true if the given lock may receive distributions from the gauge
+// according to its recipient allowlist or denylist. The lists are matched against the lock's
+// reward receiver, which is its owner unless overridden with MsgSetRewardReceiverAddress.
+func (gauge Gauge) IsEligibleRecipient(lock *lockuptypes.PeriodLock) bool {
+	rewardReceiver := lock.RewardReceiverAddress
+	if rewardReceiver == "" {
+		rewardReceiver = lock.Owner
+	}
+	if len(gauge.RecipientAllowlist) > 0 {
+		return osmoutils.Contains(gauge.RecipientAllowlist, rewardReceiver)
+	}
+	return !osmoutils.Contains(gauge.RecipientDenylist, rewardReceiver)
+}
+
+// HasMinLockAmount returns true if the gauge only distributes to locks holding at least
+// its min lock amount of the distributed denom.
+func (gauge Gauge) HasMinLockAmount() bool {
+	return gauge.MinLockAmount != nil && gauge.MinLockAmount.IsPositive()
+}
+
+// ValidateMinLockAmount validates a gauge's min lock amount. A nil or zero amount disables the filter.
+// Returns error if the amount is negative, or if it is positive for a gauge that is not lockuptypes.ByDuration.
+func ValidateMinLockAmount(minLockAmount osmomath.Int, lockQueryType lockuptypes.LockQueryType) error {
+	if minLockAmount.IsNil() || minLockAmount.IsZero() {
+		return nil
+	}
+	if minLockAmount.IsNegative() {
+		return NegativeMinLockAmountError{MinLockAmount: minLockAmount}
+	}
+	if lockQueryType != lockuptypes.ByDuration {
+		return MinLockAmountUnsupportedError{LockQueryType: lockQueryType}
+	}
+	return nil
+}
+
+// ValidateRecipientLists validates a gauge's recipient allowlist and denylist.
+// At most one of them can be set, its length is capped by MaxGaugeRecipientListLength
+// and it must consist of unique valid addresses.
+func ValidateRecipientLists(allowlist, denylist []string) error {
+	if len(allowlist) > 0 && len(denylist) > 0 {
+		return ErrBothRecipientListsSet
+	}
+
+	recipients := allowlist
+	if len(denylist) > 0 {
+		recipients = denylist
+	}
+
+	if len(recipients) > MaxGaugeRecipientListLength {
+		return RecipientListTooLongError{Length: len(recipients), MaxLength: MaxGaugeRecipientListLength}
+	}
+
+	seen := make(map[string]struct{}, len(recipients))
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return fmt.Errorf("invalid recipient address (%s): %w", recipient, err)
+		}
+		if _, ok := seen[recipient]; ok {
+			return DuplicateRecipientError{Address: recipient}
+		}
+		seen[recipient] = struct{}{}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +60,20 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// recipient_allowlist is an optional list of lock reward receiver addresses.
+	// If set, only locks whose reward receiver is one of these addresses receive
+	// distributions from the gauge.
+	// Cannot be set together with recipient_denylist.
+	RecipientAllowlist []string `protobuf:"bytes,9,rep,name=recipient_allowlist,json=recipientAllowlist,proto3" json:"recipient_allowlist,omitempty" yaml:"recipient_allowlist"`
+	// recipient_denylist is an optional list of lock reward receiver addresses.
+	// If set, locks whose reward receiver is one of these addresses do not
+	// receive distributions from the gauge.
+	// Cannot be set together with recipient_allowlist.
+	RecipientDenylist []string `protobuf:"bytes,10,rep,name=recipient_denylist,json=recipientDenylist,proto3" json:"recipient_denylist,omitempty" yaml:"recipient_denylist"`
+	// min_lock_amount is an optional minimum amount of the distributed denom a
+	// lock must hold to receive distributions from the gauge. Locks below it are
+	// skipped at distribution time. Unset or zero disables the filter.
+	MinLockAmount *cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=min_lock_amount,json=minLockAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_lock_amount,omitempty" yaml:"min_lock_amount"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -150,6 +165,20 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetRecipientAllowlist() []string {
+	if m != nil {
+		return m.RecipientAllowlist
+	}
+	return nil
+}
+
+func (m *Gauge) GetRecipientDenylist() []string {
+	if m != nil {
+		return m.RecipientDenylist
+	}
+	return nil
+}
+
 type LockableDurationsInfo struct {
 	// List of incentivised durations that gauges will pay out to
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
@@ -195,50 +224,297 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 	return nil
 }
 
+// LockRewardRecord holds the rewards accrued to a lock that have not been
+// claimed yet. Rewards are only accrued to reward records when lazy reward
+// claiming is enabled.
+type LockRewardRecord struct {
+	// lock_id is the ID of the lock the rewards were accrued to
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// owner is the address of the lock's owner, who can claim the rewards
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// reward_receiver is the address the rewards are sent to when claimed.
+	// If empty, the rewards are sent to the owner.
+	RewardReceiver string `protobuf:"bytes,3,opt,name=reward_receiver,json=rewardReceiver,proto3" json:"reward_receiver,omitempty" yaml:"reward_receiver"`
+	// rewards are the coins accrued to the lock
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *LockRewardRecord) Reset()         { *m = LockRewardRecord{} }
+func (m *LockRewardRecord) String() string { return proto.CompactTextString(m) }
+func (*LockRewardRecord) ProtoMessage()    {}
+func (*LockRewardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{2}
+}
+func (m *LockRewardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardRecord.Merge(m, src)
+}
+func (m *LockRewardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardRecord proto.InternalMessageInfo
+
+func (m *LockRewardRecord) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRewardRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LockRewardRecord) GetRewardReceiver() string {
+	if m != nil {
+		return m.RewardReceiver
+	}
+	return ""
+}
+
+func (m *LockRewardRecord) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// LockRewardCheckpoint holds the shares of a lock in the reward growth
+// accumulators of its denom as of when its rewards were last settled, along
+// with the reward growth per share accrued to its duration at that point.
+// The rewards accrued to the lock since are its amount times the reward
+// growth per share accrued to its duration since the checkpoint.
+type LockRewardCheckpoint struct {
+	// lock_id is the ID of the lock
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// denom is the denom of the lock's coin
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount of the lock's coin
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// duration is the lock's duration
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// growth_per_share is the scaled reward growth per share accrued to the
+	// lock's duration at the checkpoint
+	GrowthPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=growth_per_share,json=growthPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"growth_per_share"`
+}
+
+func (m *LockRewardCheckpoint) Reset()         { *m = LockRewardCheckpoint{} }
+func (m *LockRewardCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*LockRewardCheckpoint) ProtoMessage()    {}
+func (*LockRewardCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{3}
+}
+func (m *LockRewardCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewardCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardCheckpoint.Merge(m, src)
+}
+func (m *LockRewardCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardCheckpoint proto.InternalMessageInfo
+
+func (m *LockRewardCheckpoint) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRewardCheckpoint) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LockRewardCheckpoint) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *LockRewardCheckpoint) GetGrowthPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GrowthPerShare
+	}
+	return nil
+}
+
+// RewardGrowthAccumulator holds the rewards distributed per share to the locks
+// of a denom with at least the given duration, by gauges distributing lazily.
+type RewardGrowthAccumulator struct {
+	// denom is the denom of the locks
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// duration is the min duration of the locks
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// growth_per_share is the total reward distributed per locked share,
+	// scaled up by 1e18 to keep precision for denoms with large share amounts
+	GrowthPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=growth_per_share,json=growthPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"growth_per_share"`
+}
+
+func (m *RewardGrowthAccumulator) Reset()         { *m = RewardGrowthAccumulator{} }
+func (m *RewardGrowthAccumulator) String() string { return proto.CompactTextString(m) }
+func (*RewardGrowthAccumulator) ProtoMessage()    {}
+func (*RewardGrowthAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{4}
+}
+func (m *RewardGrowthAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardGrowthAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardGrowthAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardGrowthAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardGrowthAccumulator.Merge(m, src)
+}
+func (m *RewardGrowthAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardGrowthAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardGrowthAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardGrowthAccumulator proto.InternalMessageInfo
+
+func (m *RewardGrowthAccumulator) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RewardGrowthAccumulator) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *RewardGrowthAccumulator) GetGrowthPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GrowthPerShare
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
+	proto.RegisterType((*LockRewardRecord)(nil), "osmosis.incentives.LockRewardRecord")
+	proto.RegisterType((*LockRewardCheckpoint)(nil), "osmosis.incentives.LockRewardCheckpoint")
+	proto.RegisterType((*RewardGrowthAccumulator)(nil), "osmosis.incentives.RewardGrowthAccumulator")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0xad, 0x1d, 0xc3, 0xed, 0x10, 0xb5, 0x86, 0x94, 0x56, 0x22, 0x2d, 0x45, 0x48,
-	0xbd, 0xcc, 0x66, 0x45, 0xda, 0x81, 0x63, 0x07, 0x42, 0x93, 0x90, 0x28, 0xd5, 0x0e, 0x88, 0x4b,
-	0xe4, 0x24, 0x6e, 0x66, 0x35, 0xc9, 0x2f, 0x8a, 0x9d, 0x6a, 0x7d, 0x03, 0x8e, 0x13, 0x27, 0x9e,
-	0x81, 0x27, 0xd9, 0x71, 0x47, 0x4e, 0x1b, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0xb1, 0x13, 0xb5, 0x2a,
-	0x57, 0x4e, 0x8e, 0x7f, 0xdf, 0xdf, 0xbf, 0xef, 0x47, 0x0e, 0x72, 0x40, 0xc6, 0x20, 0x85, 0xa4,
-	0x22, 0xf1, 0x79, 0xa2, 0xc4, 0x82, 0x4b, 0x1a, 0xb2, 0x3c, 0xe4, 0x24, 0xcd, 0x40, 0x01, 0xc6,
-	0xa5, 0x4e, 0x36, 0x7a, 0xf7, 0x38, 0x84, 0x10, 0xb4, 0x4c, 0x8b, 0x2f, 0x93, 0xd9, 0x75, 0x42,
-	0x80, 0x30, 0xe2, 0x54, 0xdf, 0xbc, 0x7c, 0x46, 0x83, 0x3c, 0x63, 0x4a, 0x40, 0x52, 0xea, 0xbd,
-	0x5d, 0x5d, 0x89, 0x98, 0x4b, 0xc5, 0xe2, 0xb4, 0x6a, 0xe0, 0xeb, 0x59, 0xd4, 0x63, 0x92, 0xd3,
-	0xc5, 0xa9, 0xc7, 0x15, 0x3b, 0xa5, 0x3e, 0x88, 0xaa, 0x41, 0xa7, 0x5a, 0x35, 0x02, 0x7f, 0x9e,
-	0xa7, 0xfa, 0x30, 0xd2, 0xe0, 0x7b, 0x1d, 0x35, 0x3e, 0x14, 0x5b, 0xe3, 0x27, 0x68, 0x4f, 0x04,
-	0xb6, 0xd5, 0xb7, 0x86, 0xf5, 0xe9, 0x9e, 0x08, 0xf0, 0x0b, 0xd4, 0x12, 0xd2, 0x4d, 0x79, 0x96,
-	0x72, 0x95, 0xb3, 0xc8, 0xde, 0xeb, 0x5b, 0xc3, 0xc3, 0x69, 0x53, 0xc8, 0x49, 0x15, 0xc2, 0x17,
-	0xe8, 0x28, 0x10, 0x52, 0x65, 0xc2, 0xcb, 0x15, 0x77, 0x15, 0xd8, 0xfb, 0x7d, 0x6b, 0xd8, 0x1c,
-	0x39, 0xa4, 0xb2, 0x6e, 0xe6, 0x91, 0xcf, 0x39, 0xcf, 0x96, 0xe7, 0x90, 0x04, 0xa2, 0x70, 0x35,
-	0xae, 0xdf, 0xde, 0xf7, 0x6a, 0xd3, 0xd6, 0xa6, 0xf4, 0x12, 0x30, 0x43, 0x8d, 0x62, 0x61, 0x69,
-	0xd7, 0xfb, 0xfb, 0xc3, 0xe6, 0xa8, 0x43, 0x8c, 0x25, 0x52, 0x58, 0x22, 0xa5, 0x25, 0x72, 0x0e,
-	0x22, 0x19, 0xbf, 0x2e, 0xaa, 0x7f, 0x3e, 0xf4, 0x86, 0xa1, 0x50, 0x57, 0xb9, 0x47, 0x7c, 0x88,
-	0x69, 0xe9, 0xdf, 0x1c, 0x27, 0x32, 0x98, 0x53, 0xb5, 0x4c, 0xb9, 0xd4, 0x05, 0x72, 0x6a, 0x3a,
-	0xe3, 0x2f, 0x08, 0x49, 0xc5, 0x32, 0xe5, 0x16, 0xf8, 0xec, 0x86, 0x5e, 0xb5, 0x4b, 0x0c, 0x5b,
-	0x52, 0xb1, 0x25, 0x97, 0x15, 0xdb, 0xf1, 0xf3, 0x62, 0xd0, 0x9f, 0xfb, 0x5e, 0x7b, 0xc9, 0xe2,
-	0xe8, 0xed, 0x60, 0x53, 0x3b, 0xb8, 0x79, 0xe8, 0x59, 0xd3, 0xc7, 0x3a, 0x50, 0xa4, 0x63, 0x8a,
-	0x8e, 0x93, 0x3c, 0x76, 0x79, 0x0a, 0xfe, 0x95, 0x74, 0x53, 0x26, 0x02, 0x17, 0x16, 0x3c, 0xb3,
-	0x0f, 0x34, 0xcc, 0x76, 0x92, 0xc7, 0xef, 0xb5, 0x34, 0x61, 0x22, 0xf8, 0xb4, 0xe0, 0x19, 0x7e,
-	0x89, 0x8e, 0x66, 0x22, 0x8a, 0x78, 0x50, 0xd6, 0xd8, 0x8f, 0x74, 0x66, 0xcb, 0x04, 0x4d, 0x32,
-	0xbe, 0x46, 0xed, 0x0d, 0xa2, 0xc0, 0x35, 0x78, 0x0e, 0xff, 0x3f, 0x9e, 0xa7, 0x5b, 0x53, 0x74,
-	0x64, 0xf0, 0xcd, 0x42, 0xcf, 0x3e, 0x82, 0x3f, 0x67, 0x5e, 0xc4, 0xdf, 0x95, 0x6f, 0x51, 0x5e,
-	0x24, 0x33, 0xc0, 0x80, 0x70, 0x54, 0x0a, 0x6e, 0xf5, 0x4a, 0xa5, 0x6d, 0x95, 0x4b, 0xed, 0xb2,
-	0xac, 0x6a, 0xc7, 0xaf, 0x4a, 0x94, 0x1d, 0x83, 0xf2, 0xdf, 0x16, 0x83, 0x1f, 0x05, 0xd2, 0x76,
-	0xb4, 0x3b, 0x74, 0x3c, 0xb9, 0x5d, 0x39, 0xd6, 0xdd, 0xca, 0xb1, 0x7e, 0xaf, 0x1c, 0xeb, 0x66,
-	0xed, 0xd4, 0xee, 0xd6, 0x4e, 0xed, 0xd7, 0xda, 0xa9, 0x7d, 0x3d, 0xdb, 0x32, 0x58, 0xbe, 0xb7,
-	0x93, 0x88, 0x79, 0xb2, 0xba, 0xd0, 0xc5, 0xe8, 0x8c, 0x5e, 0x6f, 0xff, 0x9d, 0xda, 0xb4, 0x77,
-	0xa0, 0xd7, 0x7b, 0xf3, 0x37, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x54, 0xda, 0x1d, 0xc0, 0x03, 0x00,
-	0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6e, 0xe3, 0x44,
+	0x18, 0xaf, 0x93, 0x26, 0x6d, 0xa6, 0x7f, 0xb6, 0x19, 0xba, 0xac, 0x1b, 0xb1, 0x76, 0x30, 0x02,
+	0x45, 0x42, 0x6b, 0xb3, 0xad, 0xd8, 0x03, 0x17, 0xd4, 0xb4, 0x68, 0x55, 0x69, 0xa5, 0x2d, 0x66,
+	0x0f, 0x08, 0x0e, 0xd6, 0xc4, 0x9e, 0x26, 0xa3, 0xd8, 0x33, 0xd6, 0xcc, 0x38, 0xdd, 0x8a, 0x17,
+	0xe0, 0x84, 0xf6, 0xc8, 0x33, 0xf0, 0x08, 0x3c, 0xc1, 0x1e, 0xf7, 0x88, 0x38, 0x64, 0x51, 0x7b,
+	0xe6, 0x92, 0x17, 0x00, 0xcd, 0x8c, 0x9d, 0x44, 0x61, 0x17, 0x71, 0x28, 0x7b, 0xb2, 0xe7, 0xf7,
+	0xfd, 0xfd, 0xfd, 0xe6, 0xfb, 0x6c, 0xe0, 0x30, 0x91, 0x31, 0x41, 0x44, 0x40, 0x68, 0x8c, 0xa9,
+	0x24, 0x13, 0x2c, 0x82, 0x21, 0x2a, 0x86, 0xd8, 0xcf, 0x39, 0x93, 0x0c, 0xc2, 0xd2, 0xee, 0x2f,
+	0xec, 0x9d, 0xfd, 0x21, 0x1b, 0x32, 0x6d, 0x0e, 0xd4, 0x9b, 0xf1, 0xec, 0x38, 0x43, 0xc6, 0x86,
+	0x29, 0x0e, 0xf4, 0x69, 0x50, 0x5c, 0x04, 0x49, 0xc1, 0x91, 0x24, 0x8c, 0x96, 0x76, 0x77, 0xd5,
+	0x2e, 0x49, 0x86, 0x85, 0x44, 0x59, 0x5e, 0x25, 0x88, 0x75, 0xad, 0x60, 0x80, 0x04, 0x0e, 0x26,
+	0x0f, 0x07, 0x58, 0xa2, 0x87, 0x41, 0xcc, 0x48, 0x95, 0xe0, 0xa0, 0x6a, 0x35, 0x65, 0xf1, 0xb8,
+	0xc8, 0xf5, 0xc3, 0x98, 0xbc, 0x9f, 0x9a, 0xa0, 0xf1, 0x58, 0x75, 0x0d, 0x77, 0x41, 0x8d, 0x24,
+	0xb6, 0xd5, 0xb5, 0x7a, 0xeb, 0x61, 0x8d, 0x24, 0xf0, 0x43, 0xb0, 0x4d, 0x44, 0x94, 0x63, 0x9e,
+	0x63, 0x59, 0xa0, 0xd4, 0xae, 0x75, 0xad, 0xde, 0x66, 0xb8, 0x45, 0xc4, 0x79, 0x05, 0xc1, 0x33,
+	0xb0, 0x93, 0x10, 0x21, 0x39, 0x19, 0x14, 0x12, 0x47, 0x92, 0xd9, 0xf5, 0xae, 0xd5, 0xdb, 0x3a,
+	0x74, 0xfc, 0x8a, 0xba, 0xa9, 0xe7, 0x7f, 0x5d, 0x60, 0x7e, 0x75, 0xc2, 0x68, 0x42, 0x14, 0xab,
+	0xfe, 0xfa, 0xcb, 0xa9, 0xbb, 0x16, 0x6e, 0x2f, 0x42, 0x9f, 0x31, 0x88, 0x40, 0x43, 0x35, 0x2c,
+	0xec, 0xf5, 0x6e, 0xbd, 0xb7, 0x75, 0x78, 0xe0, 0x1b, 0x4a, 0xbe, 0xa2, 0xe4, 0x97, 0x94, 0xfc,
+	0x13, 0x46, 0x68, 0xff, 0x33, 0x15, 0xfd, 0xcb, 0x6b, 0xb7, 0x37, 0x24, 0x72, 0x54, 0x0c, 0xfc,
+	0x98, 0x65, 0x41, 0xc9, 0xdf, 0x3c, 0x1e, 0x88, 0x64, 0x1c, 0xc8, 0xab, 0x1c, 0x0b, 0x1d, 0x20,
+	0x42, 0x93, 0x19, 0x7e, 0x0b, 0x80, 0x90, 0x88, 0xcb, 0x48, 0xc9, 0x67, 0x37, 0x74, 0xab, 0x1d,
+	0xdf, 0x68, 0xeb, 0x57, 0xda, 0xfa, 0xcf, 0x2a, 0x6d, 0xfb, 0xf7, 0x55, 0xa1, 0xd9, 0xd4, 0x6d,
+	0x5f, 0xa1, 0x2c, 0xfd, 0xc2, 0x5b, 0xc4, 0x7a, 0x2f, 0x5e, 0xbb, 0x56, 0xd8, 0xd2, 0x80, 0x72,
+	0x87, 0x01, 0xd8, 0xa7, 0x45, 0x16, 0xe1, 0x9c, 0xc5, 0x23, 0x11, 0xe5, 0x88, 0x24, 0x11, 0x9b,
+	0x60, 0x6e, 0x37, 0xb5, 0x98, 0x6d, 0x5a, 0x64, 0x5f, 0x69, 0xd3, 0x39, 0x22, 0xc9, 0xd3, 0x09,
+	0xe6, 0xf0, 0x23, 0xb0, 0x73, 0x41, 0xd2, 0x14, 0x27, 0x65, 0x8c, 0xbd, 0xa1, 0x3d, 0xb7, 0x0d,
+	0x68, 0x9c, 0xe1, 0x73, 0xd0, 0x5e, 0x48, 0x94, 0x44, 0x46, 0x9e, 0xcd, 0xdb, 0x97, 0x67, 0x6f,
+	0xa9, 0x8a, 0x46, 0xe0, 0x53, 0xf0, 0x1e, 0xc7, 0x31, 0xc9, 0x09, 0xa6, 0x32, 0x42, 0x69, 0xca,
+	0x2e, 0x53, 0x22, 0xa4, 0xdd, 0xea, 0xd6, 0x7b, 0xad, 0xbe, 0x33, 0x9b, 0xba, 0x1d, 0x23, 0xc9,
+	0x1b, 0x9c, 0xbc, 0x10, 0xce, 0xd1, 0xe3, 0x0a, 0x84, 0x4f, 0xc0, 0x02, 0x8d, 0x12, 0x4c, 0xaf,
+	0x74, 0x3e, 0xa0, 0xf3, 0xdd, 0x9f, 0x4d, 0xdd, 0x83, 0xd5, 0x7c, 0x95, 0x8f, 0x17, 0xb6, 0xe7,
+	0xe0, 0x69, 0x89, 0xc1, 0xef, 0xc1, 0x9d, 0x8c, 0xd0, 0x48, 0x0d, 0x57, 0x84, 0x32, 0x56, 0x50,
+	0x69, 0x6f, 0x75, 0xad, 0x5e, 0xab, 0x7f, 0xf4, 0xfb, 0xd4, 0xbd, 0x6b, 0x58, 0x8a, 0x64, 0xec,
+	0x13, 0x16, 0x64, 0x48, 0x8e, 0xfc, 0x33, 0x2a, 0x67, 0x53, 0xf7, 0x7d, 0x53, 0x63, 0x25, 0xd2,
+	0x0b, 0x77, 0x32, 0x42, 0x9f, 0xb0, 0x78, 0x7c, 0x6c, 0xce, 0x3f, 0x5a, 0xe0, 0xae, 0x3a, 0xa2,
+	0x41, 0x8a, 0x4f, 0xcb, 0x3d, 0x14, 0x67, 0xf4, 0x82, 0x41, 0x06, 0x60, 0x5a, 0x1a, 0xa2, 0x6a,
+	0x43, 0x85, 0x6d, 0x95, 0x17, 0xb2, 0x3a, 0x47, 0x55, 0x6c, 0xff, 0xe3, 0x72, 0x8c, 0x4a, 0x8e,
+	0xff, 0x4c, 0xe1, 0xfd, 0xac, 0xc6, 0xa9, 0x9d, 0xae, 0x16, 0xf5, 0xfe, 0xb2, 0xc0, 0x9e, 0x6a,
+	0x25, 0xc4, 0x97, 0x88, 0x27, 0x21, 0x8e, 0x19, 0x4f, 0xe0, 0x3d, 0xb0, 0xa1, 0xdb, 0x9f, 0xef,
+	0x6a, 0x53, 0x1d, 0xcf, 0x12, 0xf8, 0x09, 0x68, 0xb0, 0x4b, 0x8a, 0xb9, 0x5e, 0xd4, 0x56, 0x7f,
+	0x6f, 0x36, 0x75, 0xb7, 0x4d, 0x49, 0x0d, 0x7b, 0xa1, 0x31, 0xc3, 0x13, 0x70, 0x87, 0xeb, 0x84,
+	0x11, 0xc7, 0x31, 0x26, 0x6a, 0x4e, 0xeb, 0x3a, 0xa2, 0xb3, 0x10, 0x69, 0xc5, 0xc1, 0x0b, 0x77,
+	0x79, 0xd5, 0x83, 0x06, 0x20, 0x06, 0x1b, 0x06, 0xf9, 0x5f, 0x16, 0xb6, 0xca, 0xed, 0xfd, 0x5a,
+	0x03, 0xfb, 0x0b, 0x05, 0x4e, 0x46, 0x38, 0x1e, 0xe7, 0x8c, 0x50, 0xf9, 0x76, 0x15, 0xf6, 0x41,
+	0x23, 0xc1, 0x94, 0x65, 0x46, 0x85, 0xd0, 0x1c, 0xe0, 0xe7, 0xa0, 0x59, 0x0e, 0x8a, 0xa1, 0xaa,
+	0x57, 0xfb, 0xad, 0xc3, 0x12, 0x96, 0xce, 0xf0, 0x4b, 0xb0, 0x59, 0xdd, 0x92, 0xbd, 0xde, 0xb5,
+	0xfe, 0xfd, 0x9e, 0x37, 0x55, 0x4e, 0x7d, 0x95, 0xf3, 0x20, 0xf8, 0x03, 0xd8, 0x1b, 0x72, 0x76,
+	0x29, 0x47, 0xea, 0x3b, 0x1a, 0x89, 0x11, 0xe2, 0xea, 0xc3, 0xa3, 0xf4, 0xfa, 0xe0, 0x8d, 0x7a,
+	0x9d, 0xe2, 0x58, 0x4b, 0x76, 0x54, 0x4a, 0xf6, 0xe9, 0x7f, 0x90, 0xac, 0x8c, 0x11, 0xe1, 0xae,
+	0x29, 0x75, 0x8e, 0xf9, 0x37, 0xaa, 0x90, 0xf7, 0xa7, 0x05, 0xee, 0x19, 0xe1, 0x1e, 0x6b, 0xc3,
+	0x71, 0x1c, 0x17, 0x59, 0x91, 0x22, 0xc9, 0xf8, 0x42, 0x26, 0x6b, 0x59, 0xa6, 0x65, 0xbe, 0xb5,
+	0xdb, 0xe2, 0x5b, 0x7f, 0x47, 0x7c, 0xfb, 0xe7, 0x2f, 0xaf, 0x1d, 0xeb, 0xd5, 0xb5, 0x63, 0xfd,
+	0x71, 0xed, 0x58, 0x2f, 0x6e, 0x9c, 0xb5, 0x57, 0x37, 0xce, 0xda, 0x6f, 0x37, 0xce, 0xda, 0x77,
+	0x8f, 0x96, 0xd2, 0x96, 0xbf, 0xa6, 0x07, 0x29, 0x1a, 0x88, 0xea, 0x10, 0x4c, 0x0e, 0x1f, 0x05,
+	0xcf, 0x97, 0x7f, 0xe4, 0xba, 0xd4, 0xa0, 0xa9, 0x59, 0x1f, 0xfd, 0x3d, 0x00, 0x10, 0xf5, 0x69,
+	0x51, 0xeb, 0x07, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -261,6 +537,36 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinLockAmount != nil {
+		{
+			size := m.MinLockAmount.Size()
+			i -= size
+			if _, err := m.MinLockAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGauge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RecipientDenylist) > 0 {
+		for iNdEx := len(m.RecipientDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecipientDenylist[iNdEx])
+			copy(dAtA[i:], m.RecipientDenylist[iNdEx])
+			i = encodeVarintGauge(dAtA, i, uint64(len(m.RecipientDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RecipientAllowlist) > 0 {
+		for iNdEx := len(m.RecipientAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecipientAllowlist[iNdEx])
+			copy(dAtA[i:], m.RecipientAllowlist[iNdEx])
+			i = encodeVarintGauge(dAtA, i, uint64(len(m.RecipientAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -370,6 +676,181 @@ func (m *LockableDurationsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockRewardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RewardReceiver) > 0 {
+		i -= len(m.RewardReceiver)
+		copy(dAtA[i:], m.RewardReceiver)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.RewardReceiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRewardCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewardCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewardCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrowthPerShare) > 0 {
+		for iNdEx := len(m.GrowthPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GrowthPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGauge(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardGrowthAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardGrowthAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardGrowthAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrowthPerShare) > 0 {
+		for iNdEx := len(m.GrowthPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GrowthPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGauge(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGauge(v)
 	base := offset
@@ -415,31 +896,565 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.RecipientAllowlist) > 0 {
+		for _, s := range m.RecipientAllowlist {
+			l = len(s)
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if len(m.RecipientDenylist) > 0 {
+		for _, s := range m.RecipientDenylist {
+			l = len(s)
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.MinLockAmount != nil {
+		l = m.MinLockAmount.Size()
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	return n
+}
+
+func (m *LockableDurationsInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockableDurations) > 0 {
+		for _, e := range m.LockableDurations {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockRewardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovGauge(uint64(m.LockId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = len(m.RewardReceiver)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockRewardCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovGauge(uint64(m.LockId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGauge(uint64(l))
+	if len(m.GrowthPerShare) > 0 {
+		for _, e := range m.GrowthPerShare {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardGrowthAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGauge(uint64(l))
+	if len(m.GrowthPerShare) > 0 {
+		for _, e := range m.GrowthPerShare {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGauge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGauge(x uint64) (n int) {
+	return sovGauge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPerpetual = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributeTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+			}
+			m.NumEpochsPaidOver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+			}
+			m.FilledEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types1.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAllowlist = append(m.RecipientAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientDenylist = append(m.RecipientDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinLockAmount = &v
+			if err := m.MinLockAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockableDurationsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockableDurationsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockableDurationsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *LockableDurationsInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LockableDurations) > 0 {
-		for _, e := range m.LockableDurations {
-			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
-			n += 1 + l + sovGauge(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGauge(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGauge(x uint64) (n int) {
-	return sovGauge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Gauge) Unmarshal(dAtA []byte) error {
+func (m *LockRewardRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -462,17 +1477,17 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockRewardRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockRewardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			m.Id = 0
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -482,16 +1497,16 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -501,17 +1516,29 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPerpetual = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributeTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -521,28 +1548,27 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGauge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGauge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributeTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -569,16 +1595,85 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockRewardCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRewardCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRewardCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -588,30 +1683,29 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGauge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGauge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.NumEpochsPaidOver = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -621,16 +1715,31 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
 			}
-			m.FilledEpochs = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -640,14 +1749,28 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FilledEpochs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrowthPerShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -674,8 +1797,8 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributedCoins = append(m.DistributedCoins, types1.Coin{})
-			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GrowthPerShare = append(m.GrowthPerShare, types1.DecCoin{})
+			if err := m.GrowthPerShare[len(m.GrowthPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -700,7 +1823,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockableDurationsInfo) Unmarshal(dAtA []byte) error {
+func (m *RewardGrowthAccumulator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -723,15 +1846,47 @@ func (m *LockableDurationsInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockableDurationsInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardGrowthAccumulator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockableDurationsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardGrowthAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -758,8 +1913,41 @@ func (m *LockableDurationsInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrowthPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrowthPerShare = append(m.GrowthPerShare, types1.DecCoin{})
+			if err := m.GrowthPerShare[len(m.GrowthPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
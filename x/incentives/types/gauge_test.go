@@ -3,7 +3,10 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 )
 
 // Validates that the gauge returns true if this is the last distributing epoch of the gauge.
@@ -57,3 +60,110 @@ func TestIsLastNonPerpetualDistribution(t *testing.T) {
 		})
 	}
 }
+
+// Validates that the gauge splits its remaining coins evenly over its remaining epochs.
+func TestNextEpochDistribution(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 100))
+
+	tests := map[string]struct {
+		gauge    Gauge
+		expected sdk.Coins
+	}{
+		"first epoch": {
+			gauge: Gauge{
+				Coins:             coins,
+				NumEpochsPaidOver: 10,
+			},
+			expected: sdk.NewCoins(sdk.NewInt64Coin("bar", 100), sdk.NewInt64Coin("foo", 10)),
+		},
+		"remaining coins over remaining epochs": {
+			gauge: Gauge{
+				Coins:             coins,
+				DistributedCoins:  sdk.NewCoins(sdk.NewInt64Coin("bar", 500)),
+				FilledEpochs:      5,
+				NumEpochsPaidOver: 10,
+			},
+			expected: sdk.NewCoins(sdk.NewInt64Coin("bar", 100), sdk.NewInt64Coin("foo", 20)),
+		},
+		"perpetual distributes all remaining coins": {
+			gauge: Gauge{
+				IsPerpetual:      true,
+				Coins:            coins,
+				DistributedCoins: sdk.NewCoins(sdk.NewInt64Coin("foo", 100)),
+			},
+			expected: sdk.NewCoins(sdk.NewInt64Coin("bar", 1000)),
+		},
+		"over-filled distributes all remaining coins": {
+			gauge: Gauge{
+				Coins:             coins,
+				FilledEpochs:      10,
+				NumEpochsPaidOver: 10,
+			},
+			expected: coins,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.gauge.NextEpochDistribution())
+		})
+	}
+}
+
+// Validates that the gauge's recipient lists are matched against the lock's reward receiver,
+// which is its owner unless overridden.
+func TestIsEligibleRecipient(t *testing.T) {
+	owner := "osmo1owner"
+	rewardReceiver := "osmo1receiver"
+
+	tests := map[string]struct {
+		gauge          Gauge
+		rewardReceiver string
+		expected       bool
+	}{
+		"no recipient lists": {
+			gauge:    Gauge{},
+			expected: true,
+		},
+		"owner in allowlist": {
+			gauge:    Gauge{RecipientAllowlist: []string{owner}},
+			expected: true,
+		},
+		"owner not in allowlist": {
+			gauge:    Gauge{RecipientAllowlist: []string{rewardReceiver}},
+			expected: false,
+		},
+		"owner in denylist": {
+			gauge:    Gauge{RecipientDenylist: []string{owner}},
+			expected: false,
+		},
+		"overridden reward receiver in allowlist, owner not": {
+			gauge:          Gauge{RecipientAllowlist: []string{rewardReceiver}},
+			rewardReceiver: rewardReceiver,
+			expected:       true,
+		},
+		"owner in allowlist, overridden reward receiver not": {
+			gauge:          Gauge{RecipientAllowlist: []string{owner}},
+			rewardReceiver: rewardReceiver,
+			expected:       false,
+		},
+		"overridden reward receiver in denylist": {
+			gauge:          Gauge{RecipientDenylist: []string{rewardReceiver}},
+			rewardReceiver: rewardReceiver,
+			expected:       false,
+		},
+		"owner in denylist, overridden reward receiver not": {
+			gauge:          Gauge{RecipientDenylist: []string{owner}},
+			rewardReceiver: rewardReceiver,
+			expected:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			lock := lockuptypes.PeriodLock{Owner: owner, RewardReceiverAddress: tc.rewardReceiver}
+
+			require.Equal(t, tc.expected, tc.gauge.IsEligibleRecipient(&lock))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -50,5 +51,29 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	for _, record := range gs.LockRewardRecords {
+		if record.Owner == "" {
+			return fmt.Errorf("owner of lock reward record for lock %d should NOT be empty", record.LockId)
+		}
+		if err := record.Rewards.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, checkpoint := range gs.LockRewardCheckpoints {
+		if checkpoint.Amount.IsNil() || checkpoint.Amount.IsNegative() {
+			return fmt.Errorf("amount of reward checkpoint for lock %d should NOT be nil or negative", checkpoint.LockId)
+		}
+		if err := checkpoint.GrowthPerShare.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, accumulator := range gs.RewardGrowthAccumulators {
+		if err := accumulator.GrowthPerShare.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
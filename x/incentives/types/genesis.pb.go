@@ -45,6 +45,14 @@ type GenesisState struct {
 	GroupGauges []Gauge `protobuf:"bytes,5,rep,name=group_gauges,json=groupGauges,proto3" json:"group_gauges"`
 	// groups are all the groups that should exist at genesis
 	Groups []Group `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups"`
+	// lock_reward_records are all rewards accrued to locks that have not been
+	// claimed yet
+	LockRewardRecords []LockRewardRecord `protobuf:"bytes,7,rep,name=lock_reward_records,json=lockRewardRecords,proto3" json:"lock_reward_records"`
+	// lock_reward_checkpoints are the checkpoints of all locks in the reward
+	// growth accumulators
+	LockRewardCheckpoints []LockRewardCheckpoint `protobuf:"bytes,8,rep,name=lock_reward_checkpoints,json=lockRewardCheckpoints,proto3" json:"lock_reward_checkpoints"`
+	// reward_growth_accumulators are all reward growth accumulators
+	RewardGrowthAccumulators []RewardGrowthAccumulator `protobuf:"bytes,9,rep,name=reward_growth_accumulators,json=rewardGrowthAccumulators,proto3" json:"reward_growth_accumulators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +130,27 @@ func (m *GenesisState) GetGroups() []Group {
 	return nil
 }
 
+func (m *GenesisState) GetLockRewardRecords() []LockRewardRecord {
+	if m != nil {
+		return m.LockRewardRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRewardCheckpoints() []LockRewardCheckpoint {
+	if m != nil {
+		return m.LockRewardCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardGrowthAccumulators() []RewardGrowthAccumulator {
+	if m != nil {
+		return m.RewardGrowthAccumulators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
 }
@@ -129,31 +158,38 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
-	0x10, 0xc6, 0x93, 0x7f, 0xfb, 0xcf, 0x21, 0xa9, 0x07, 0x17, 0x0f, 0x69, 0x0f, 0x49, 0x08, 0x08,
-	0xbd, 0x98, 0x85, 0x0a, 0x55, 0x3c, 0x06, 0xa1, 0x78, 0x2b, 0xf5, 0xe6, 0x25, 0x6c, 0xda, 0x35,
-	0x06, 0x93, 0x6c, 0xc8, 0x6c, 0x8a, 0x7d, 0x0b, 0xc1, 0x8b, 0x8f, 0xd4, 0x63, 0x8f, 0x9e, 0xaa,
-	0xb4, 0x6f, 0xe0, 0x13, 0x48, 0x36, 0x59, 0x15, 0x5a, 0x8a, 0xb7, 0x4c, 0xe6, 0x37, 0xdf, 0xcc,
-	0xf7, 0xad, 0xee, 0x30, 0x48, 0x19, 0xc4, 0x80, 0xe3, 0x6c, 0x4a, 0x33, 0x1e, 0xcf, 0x29, 0xe0,
-	0x88, 0x66, 0x14, 0x62, 0xf0, 0xf2, 0x82, 0x71, 0x86, 0x50, 0x43, 0x78, 0x3f, 0x44, 0xef, 0x24,
-	0x62, 0x11, 0x13, 0x6d, 0x5c, 0x7d, 0xd5, 0x64, 0xcf, 0x8a, 0x18, 0x8b, 0x12, 0x8a, 0x45, 0x15,
-	0x96, 0xf7, 0x78, 0x56, 0x16, 0x84, 0xc7, 0x2c, 0x6b, 0xfa, 0xf6, 0x9e, 0x5d, 0x39, 0x29, 0x48,
-	0x0a, 0x52, 0x60, 0xdf, 0x31, 0xa4, 0x8c, 0xe8, 0xa1, 0x7e, 0xc1, 0xca, 0xbc, 0xee, 0xbb, 0x2f,
-	0x2d, 0xbd, 0x33, 0xaa, 0x8f, 0xbf, 0xe5, 0x84, 0x53, 0x74, 0xa9, 0x6b, 0xf5, 0x02, 0x53, 0x75,
-	0xd4, 0xbe, 0x31, 0xe8, 0x79, 0xbb, 0x66, 0xbc, 0xb1, 0x20, 0xfc, 0xf6, 0x72, 0x6d, 0x2b, 0x93,
-	0x86, 0x47, 0x17, 0xba, 0x26, 0x36, 0x83, 0xf9, 0xcf, 0x69, 0xf5, 0x8d, 0x41, 0x77, 0xdf, 0xe4,
-	0xa8, 0x22, 0xe4, 0x60, 0x8d, 0x23, 0xa6, 0xa3, 0x84, 0x4d, 0x1f, 0x49, 0x98, 0xd0, 0x40, 0xfa,
-	0x07, 0xb3, 0xd5, 0x88, 0xd4, 0x09, 0x79, 0x32, 0x21, 0xef, 0xba, 0x21, 0xfc, 0xd3, 0x4a, 0xe4,
-	0x73, 0x6d, 0x77, 0x17, 0x24, 0x4d, 0xae, 0xdc, 0x5d, 0x09, 0xf7, 0xf5, 0xdd, 0x56, 0x27, 0xc7,
-	0xb2, 0x21, 0x07, 0x01, 0xb9, 0xfa, 0x51, 0x42, 0x80, 0x07, 0x62, 0x7f, 0x10, 0xcf, 0xcc, 0xb6,
-	0xa3, 0xf6, 0xdb, 0x13, 0xa3, 0xfa, 0x29, 0x0e, 0xbc, 0x99, 0x21, 0x5f, 0xef, 0x88, 0x9c, 0x82,
-	0xc6, 0xd3, 0xff, 0xbf, 0x79, 0x32, 0xc4, 0xd0, 0xa8, 0x36, 0x56, 0x25, 0x52, 0x95, 0x60, 0x6a,
-	0x07, 0xa6, 0x2b, 0xe2, 0x3b, 0x11, 0x81, 0xfb, 0xe3, 0xe5, 0xc6, 0x52, 0x57, 0x1b, 0x4b, 0xfd,
-	0xd8, 0x58, 0xea, 0xf3, 0xd6, 0x52, 0x56, 0x5b, 0x4b, 0x79, 0xdb, 0x5a, 0xca, 0xdd, 0x30, 0x8a,
-	0xf9, 0x43, 0x19, 0x7a, 0x53, 0x96, 0xe2, 0x46, 0xec, 0x2c, 0x21, 0x21, 0xc8, 0x02, 0xcf, 0x07,
-	0x43, 0xfc, 0xf4, 0xfb, 0xb5, 0xf9, 0x22, 0xa7, 0x10, 0x6a, 0x22, 0xbf, 0xf3, 0xaf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x11, 0x79, 0xea, 0xc7, 0xbd, 0x02, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x9a, 0x06, 0x70, 0xca, 0xa1, 0x0b, 0x08, 0x37, 0x07, 0x27, 0x8a, 0x40, 0x8a,
+	0x84, 0xb0, 0xa5, 0x20, 0x15, 0xc4, 0x8d, 0x80, 0x14, 0x21, 0x71, 0xa8, 0xcc, 0xad, 0x17, 0x6b,
+	0x6d, 0x6f, 0x1d, 0x2b, 0x6b, 0x8f, 0xb5, 0xb3, 0x6e, 0xe9, 0x5b, 0x70, 0xe4, 0x91, 0x72, 0xec,
+	0x91, 0x53, 0x41, 0xc9, 0x1b, 0xf0, 0x04, 0x68, 0xd7, 0xeb, 0xb6, 0x22, 0x56, 0xd4, 0x9b, 0x77,
+	0xe7, 0xfb, 0xff, 0x7f, 0x66, 0x6c, 0xdb, 0x23, 0xc0, 0x1c, 0x30, 0x43, 0x3f, 0x2b, 0x62, 0x56,
+	0xc8, 0xec, 0x9c, 0xa1, 0x9f, 0xb2, 0x82, 0x61, 0x86, 0x5e, 0x29, 0x40, 0x02, 0x21, 0x86, 0xf0,
+	0x6e, 0x89, 0xc1, 0xb3, 0x14, 0x52, 0xd0, 0x65, 0x5f, 0x3d, 0xd5, 0xe4, 0xc0, 0x4d, 0x01, 0x52,
+	0xce, 0x7c, 0x7d, 0x8a, 0xaa, 0x33, 0x3f, 0xa9, 0x04, 0x95, 0x19, 0x14, 0xa6, 0x3e, 0x6c, 0xc9,
+	0x2a, 0xa9, 0xa0, 0x39, 0x36, 0x06, 0x6d, 0xcd, 0xd0, 0x2a, 0x65, 0xbb, 0xea, 0x02, 0xaa, 0xb2,
+	0xae, 0x8f, 0x57, 0xfb, 0xf6, 0xc1, 0xbc, 0x6e, 0xfe, 0x9b, 0xa4, 0x92, 0x91, 0xf7, 0x76, 0xaf,
+	0x0e, 0x70, 0xac, 0x91, 0x35, 0xe9, 0x4f, 0x07, 0xde, 0xf6, 0x30, 0xde, 0x89, 0x26, 0x66, 0xdd,
+	0xd5, 0xf5, 0xb0, 0x13, 0x18, 0x9e, 0xbc, 0xb3, 0x7b, 0x3a, 0x19, 0x9d, 0x07, 0xa3, 0xbd, 0x49,
+	0x7f, 0x7a, 0xd4, 0xa6, 0x9c, 0x2b, 0xa2, 0x11, 0xd6, 0x38, 0x01, 0x9b, 0x70, 0x88, 0x97, 0x34,
+	0xe2, 0x2c, 0x6c, 0xe6, 0x47, 0x67, 0xcf, 0x98, 0xd4, 0x1b, 0xf2, 0x9a, 0x0d, 0x79, 0x9f, 0x0d,
+	0x31, 0x7b, 0xa5, 0x4c, 0xfe, 0x5e, 0x0f, 0x8f, 0x2e, 0x69, 0xce, 0x3f, 0x8c, 0xb7, 0x2d, 0xc6,
+	0x3f, 0x7f, 0x0f, 0xad, 0xe0, 0xb0, 0x29, 0x34, 0x42, 0x24, 0x63, 0xfb, 0x09, 0xa7, 0x28, 0x43,
+	0x9d, 0x1f, 0x66, 0x89, 0xd3, 0x1d, 0x59, 0x93, 0x6e, 0xd0, 0x57, 0x97, 0xba, 0xc1, 0x2f, 0x09,
+	0x99, 0xd9, 0x07, 0x7a, 0x4f, 0xa1, 0x99, 0x69, 0xff, 0x7e, 0x33, 0xf5, 0xb5, 0x68, 0x5e, 0x0f,
+	0xa6, 0x36, 0xa2, 0x8e, 0xe8, 0xf4, 0x76, 0xa8, 0x15, 0x71, 0xb3, 0x11, 0x8d, 0x93, 0x53, 0xfb,
+	0xa9, 0xea, 0x3a, 0x14, 0xec, 0x82, 0x8a, 0x24, 0x14, 0x2c, 0x06, 0x91, 0xa0, 0xf3, 0x50, 0xbb,
+	0xbc, 0x6c, 0x73, 0xf9, 0x0a, 0xf1, 0x32, 0xd0, 0x74, 0xa0, 0x61, 0x63, 0x78, 0xc8, 0xff, 0xbb,
+	0x47, 0x72, 0x66, 0xbf, 0xb8, 0xeb, 0x1d, 0x2f, 0x58, 0xbc, 0x2c, 0x21, 0x2b, 0x24, 0x3a, 0x8f,
+	0xb4, 0xff, 0x64, 0xb7, 0xff, 0xa7, 0x1b, 0x81, 0xc9, 0x78, 0xce, 0x5b, 0x6a, 0xea, 0xad, 0x0e,
+	0x4c, 0x44, 0x2a, 0xe0, 0x42, 0x2e, 0x42, 0x1a, 0xc7, 0x55, 0x5e, 0x71, 0x2a, 0x41, 0xa0, 0xf3,
+	0x58, 0x47, 0xbd, 0x6e, 0x8b, 0xaa, 0xad, 0xe6, 0x5a, 0xf4, 0xf1, 0x56, 0x63, 0xd2, 0x1c, 0xd1,
+	0x5e, 0xc6, 0xd9, 0xc9, 0x6a, 0xed, 0x5a, 0x57, 0x6b, 0xd7, 0xfa, 0xb3, 0x76, 0xad, 0x1f, 0x1b,
+	0xb7, 0x73, 0xb5, 0x71, 0x3b, 0xbf, 0x36, 0x6e, 0xe7, 0xf4, 0x38, 0xcd, 0xe4, 0xa2, 0x8a, 0xbc,
+	0x18, 0x72, 0xdf, 0x04, 0xbe, 0xe1, 0x34, 0xc2, 0xe6, 0xe0, 0x9f, 0x4f, 0x8f, 0xfd, 0xef, 0x77,
+	0x7f, 0x11, 0x79, 0x59, 0x32, 0x8c, 0x7a, 0xfa, 0xa3, 0x7b, 0xfb, 0x6f, 0x00, 0x22, 0xee, 0xc1,
+	0x87, 0xf2, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +212,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardGrowthAccumulators) > 0 {
+		for iNdEx := len(m.RewardGrowthAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardGrowthAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LockRewardCheckpoints) > 0 {
+		for iNdEx := len(m.LockRewardCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewardCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LockRewardRecords) > 0 {
+		for iNdEx := len(m.LockRewardRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewardRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Groups) > 0 {
 		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -294,6 +372,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LockRewardRecords) > 0 {
+		for _, e := range m.LockRewardRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRewardCheckpoints) > 0 {
+		for _, e := range m.LockRewardCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardGrowthAccumulators) > 0 {
+		for _, e := range m.RewardGrowthAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -520,6 +616,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewardRecords = append(m.LockRewardRecords, LockRewardRecord{})
+			if err := m.LockRewardRecords[len(m.LockRewardRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewardCheckpoints = append(m.LockRewardCheckpoints, LockRewardCheckpoint{})
+			if err := m.LockRewardCheckpoints[len(m.LockRewardCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardGrowthAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardGrowthAccumulators = append(m.RewardGrowthAccumulators, RewardGrowthAccumulator{})
+			if err := m.RewardGrowthAccumulators[len(m.RewardGrowthAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
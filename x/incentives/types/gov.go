@@ -51,6 +51,12 @@ func (p *CreateGroupsProposal) ValidateBasic() error {
 		if len(group.PoolIds) <= 1 {
 			return fmt.Errorf("each group much be comprised of at least two pool ids")
 		}
+		if err := ValidateSplittingPolicy(group.SplittingPolicy); err != nil {
+			return err
+		}
+		if err := ValidateEmissionDecay(group.EmissionDecay); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -59,7 +65,7 @@ func (p *CreateGroupsProposal) ValidateBasic() error {
 func (p CreateGroupsProposal) String() string {
 	recordsStr := ""
 	for _, group := range p.CreateGroups {
-		recordsStr = recordsStr + fmt.Sprintf("(PoolIDs: %d) ", group.PoolIds)
+		recordsStr = recordsStr + fmt.Sprintf("(PoolIDs: %d, SplittingPolicy: %s) ", group.PoolIds, group.SplittingPolicy)
 	}
 
 	var b strings.Builder
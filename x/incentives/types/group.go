@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/coinutil"
+)
+
+// ValidateSplittingPolicy returns an error if the given splitting policy is not supported.
+func ValidateSplittingPolicy(splittingPolicy SplittingPolicy) error {
+	if _, ok := SplittingPolicy_name[int32(splittingPolicy)]; !ok {
+		return InvalidSplittingPolicyError{SplittingPolicy: splittingPolicy}
+	}
+	return nil
+}
+
+// ValidateEmissionDecay returns an error if the given emission decay is set and its factor is not in (0, 1).
+func ValidateEmissionDecay(emissionDecay *EmissionDecay) error {
+	if emissionDecay == nil {
+		return nil
+	}
+	if emissionDecay.Factor.IsNil() || !emissionDecay.Factor.IsPositive() || emissionDecay.Factor.GTE(osmomath.OneDec()) {
+		return InvalidEmissionDecayFactorError{Factor: emissionDecay.Factor}
+	}
+	return nil
+}
+
+// DecayedEmission returns the coins to distribute this epoch out of the given remaining coins of a group gauge
+// with the given emission decay. A perpetual group gauge distributes its remaining coins multiplied by
+// factor^epochsElapsed, so that its emission keeps tapering when the group gauge is refilled between epochs.
+// A non-perpetual group gauge with n remaining epochs distributes (1 - factor) / (1 - factor^n) of its remaining
+// coins, so that its remaining coins are distributed in full over the remaining epochs while each epoch's emission
+// is factor times the previous one's. The epochs elapsed are already accounted for by the remaining epochs.
+// CONTRACT: remainingEpochs is positive if the group gauge is non-perpetual.
+func (d EmissionDecay) DecayedEmission(remainingCoins sdk.Coins, isPerpetual bool, remainingEpochs, epochsElapsed uint64) sdk.Coins {
+	if isPerpetual {
+		return coinutil.MulDec(remainingCoins, d.Factor.Power(epochsElapsed))
+	}
+	emissionRatio := osmomath.OneDec().Sub(d.Factor).Quo(osmomath.OneDec().Sub(d.Factor.Power(remainingEpochs)))
+	return coinutil.MulDec(remainingCoins, emissionRatio)
+}
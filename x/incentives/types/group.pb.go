@@ -32,15 +32,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type SplittingPolicy int32
 
 const (
+	// ByVolume splits incentives proportionally to the volume of each pool
+	// since the last epoch.
 	ByVolume SplittingPolicy = 0
+	// Evenly splits incentives evenly across all pools.
+	Evenly SplittingPolicy = 1
 )
 
 var SplittingPolicy_name = map[int32]string{
 	0: "ByVolume",
+	1: "Evenly",
 }
 
 var SplittingPolicy_value = map[string]int32{
 	"ByVolume": 0,
+	"Evenly":   1,
 }
 
 func (x SplittingPolicy) String() string {
@@ -162,6 +168,12 @@ type Group struct {
 	GroupGaugeId      uint64            `protobuf:"varint,1,opt,name=group_gauge_id,json=groupGaugeId,proto3" json:"group_gauge_id,omitempty"`
 	InternalGaugeInfo InternalGaugeInfo `protobuf:"bytes,2,opt,name=internal_gauge_info,json=internalGaugeInfo,proto3" json:"internal_gauge_info"`
 	SplittingPolicy   SplittingPolicy   `protobuf:"varint,3,opt,name=splitting_policy,json=splittingPolicy,proto3,enum=osmosis.incentives.SplittingPolicy" json:"splitting_policy,omitempty"`
+	// emission_decay tapers the group's per-epoch emission if set.
+	EmissionDecay *EmissionDecay `protobuf:"bytes,4,opt,name=emission_decay,json=emissionDecay,proto3" json:"emission_decay,omitempty"`
+	// emission_decay_start_epoch is the number of the distribution epoch the
+	// group was created in, from which the epochs elapsed by the emission decay
+	// are counted.
+	EmissionDecayStartEpoch int64 `protobuf:"varint,5,opt,name=emission_decay_start_epoch,json=emissionDecayStartEpoch,proto3" json:"emission_decay_start_epoch,omitempty"`
 }
 
 func (m *Group) Reset()         { *m = Group{} }
@@ -218,17 +230,79 @@ func (m *Group) GetSplittingPolicy() SplittingPolicy {
 	return ByVolume
 }
 
+func (m *Group) GetEmissionDecay() *EmissionDecay {
+	if m != nil {
+		return m.EmissionDecay
+	}
+	return nil
+}
+
+func (m *Group) GetEmissionDecayStartEpoch() int64 {
+	if m != nil {
+		return m.EmissionDecayStartEpoch
+	}
+	return 0
+}
+
+// EmissionDecay is the decay schedule of a group's per-epoch emission.
+// A perpetual group distributes its remaining coins multiplied by factor to
+// the power of the epochs elapsed since the group was created, so that its
+// emission tapers even when its gauge is refilled every epoch. A
+// non-perpetual group distributes its remaining coins over its remaining
+// epochs following the geometric series of ratio factor.
+type EmissionDecay struct {
+	// factor is the ratio between consecutive epochs' emissions, in (0, 1).
+	Factor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=factor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"factor" yaml:"factor"`
+}
+
+func (m *EmissionDecay) Reset()         { *m = EmissionDecay{} }
+func (m *EmissionDecay) String() string { return proto.CompactTextString(m) }
+func (*EmissionDecay) ProtoMessage()    {}
+func (*EmissionDecay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90cab10cb3a674f3, []int{3}
+}
+func (m *EmissionDecay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionDecay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionDecay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionDecay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionDecay.Merge(m, src)
+}
+func (m *EmissionDecay) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionDecay) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionDecay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionDecay proto.InternalMessageInfo
+
 // CreateGroup is called via governance to create a new group.
-// It takes an array of pool IDs to split the incentives across.
+// It takes an array of pool IDs to split the incentives across, according to
+// the given splitting policy.
 type CreateGroup struct {
-	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	PoolIds         []uint64        `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	SplittingPolicy SplittingPolicy `protobuf:"varint,2,opt,name=splitting_policy,json=splittingPolicy,proto3,enum=osmosis.incentives.SplittingPolicy" json:"splitting_policy,omitempty"`
+	// emission_decay tapers the group's per-epoch emission if set.
+	EmissionDecay *EmissionDecay `protobuf:"bytes,3,opt,name=emission_decay,json=emissionDecay,proto3" json:"emission_decay,omitempty"`
 }
 
 func (m *CreateGroup) Reset()         { *m = CreateGroup{} }
 func (m *CreateGroup) String() string { return proto.CompactTextString(m) }
 func (*CreateGroup) ProtoMessage()    {}
 func (*CreateGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90cab10cb3a674f3, []int{3}
+	return fileDescriptor_90cab10cb3a674f3, []int{4}
 }
 func (m *CreateGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,6 +338,20 @@ func (m *CreateGroup) GetPoolIds() []uint64 {
 	return nil
 }
 
+func (m *CreateGroup) GetSplittingPolicy() SplittingPolicy {
+	if m != nil {
+		return m.SplittingPolicy
+	}
+	return ByVolume
+}
+
+func (m *CreateGroup) GetEmissionDecay() *EmissionDecay {
+	if m != nil {
+		return m.EmissionDecay
+	}
+	return nil
+}
+
 // GroupsWithGauge is a helper struct that stores a group and its
 // associated gauge.
 type GroupsWithGauge struct {
@@ -275,7 +363,7 @@ func (m *GroupsWithGauge) Reset()         { *m = GroupsWithGauge{} }
 func (m *GroupsWithGauge) String() string { return proto.CompactTextString(m) }
 func (*GroupsWithGauge) ProtoMessage()    {}
 func (*GroupsWithGauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90cab10cb3a674f3, []int{4}
+	return fileDescriptor_90cab10cb3a674f3, []int{5}
 }
 func (m *GroupsWithGauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,6 +411,7 @@ func init() {
 	proto.RegisterType((*InternalGaugeInfo)(nil), "osmosis.incentives.InternalGaugeInfo")
 	proto.RegisterType((*InternalGaugeRecord)(nil), "osmosis.incentives.InternalGaugeRecord")
 	proto.RegisterType((*Group)(nil), "osmosis.incentives.Group")
+	proto.RegisterType((*EmissionDecay)(nil), "osmosis.incentives.EmissionDecay")
 	proto.RegisterType((*CreateGroup)(nil), "osmosis.incentives.CreateGroup")
 	proto.RegisterType((*GroupsWithGauge)(nil), "osmosis.incentives.GroupsWithGauge")
 }
@@ -330,46 +419,53 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/group.proto", fileDescriptor_90cab10cb3a674f3) }
 
 var fileDescriptor_90cab10cb3a674f3 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xed, 0x36, 0xa5, 0x65, 0x93, 0xe6, 0x8f, 0x03, 0x52, 0x12, 0x09, 0x3b, 0x32, 0x54,
-	0x44, 0x48, 0x78, 0xd5, 0x00, 0x3d, 0xf4, 0x18, 0x90, 0xa2, 0x70, 0x40, 0x95, 0x91, 0x88, 0x04,
-	0x87, 0x68, 0x6d, 0x6f, 0x9c, 0x55, 0x6d, 0xaf, 0xe5, 0x5d, 0x07, 0x72, 0xe2, 0xca, 0x91, 0x47,
-	0x40, 0xe2, 0x45, 0x38, 0xf6, 0xd8, 0x23, 0xaa, 0x44, 0x84, 0x92, 0x0b, 0xe7, 0x3e, 0x01, 0xf2,
-	0xda, 0x26, 0x4d, 0x1b, 0x01, 0x27, 0x7b, 0x76, 0xbe, 0x6f, 0x76, 0x7e, 0xa3, 0x59, 0xa0, 0x52,
-	0xe6, 0x53, 0x46, 0x18, 0x24, 0x81, 0x8d, 0x03, 0x4e, 0xa6, 0x98, 0x41, 0x37, 0xa2, 0x71, 0x68,
-	0x84, 0x11, 0xe5, 0x54, 0x51, 0xb2, 0xbc, 0xb1, 0xca, 0xb7, 0xee, 0xb8, 0xd4, 0xa5, 0x22, 0x0d,
-	0x93, 0xbf, 0x54, 0xd9, 0x52, 0x5d, 0x4a, 0x5d, 0x0f, 0x43, 0x11, 0x59, 0xf1, 0x18, 0x3a, 0x71,
-	0x84, 0x38, 0xa1, 0x41, 0x96, 0xd7, 0xae, 0xe7, 0x39, 0xf1, 0x31, 0xe3, 0xc8, 0x0f, 0xf3, 0x02,
-	0xb6, 0xb8, 0x0b, 0x5a, 0x88, 0x61, 0x38, 0x3d, 0xb4, 0x30, 0x47, 0x87, 0xd0, 0xa6, 0x24, 0x2f,
-	0xd0, 0xcc, 0x5b, 0xf5, 0xa8, 0x7d, 0x1a, 0x87, 0xe2, 0x93, 0x5b, 0x37, 0x51, 0xa0, 0xd8, 0xc5,
-	0x69, 0x5e, 0xff, 0x26, 0x83, 0xda, 0x20, 0xe0, 0x38, 0x0a, 0x90, 0xd7, 0x4f, 0xce, 0x07, 0xc1,
-	0x98, 0x2a, 0x43, 0x50, 0xe2, 0x94, 0x23, 0x6f, 0xf4, 0x1e, 0x13, 0x77, 0xc2, 0x1b, 0x72, 0x5b,
-	0xee, 0xdc, 0xee, 0x3d, 0x3d, 0x9b, 0x6b, 0xd2, 0xc5, 0x5c, 0xbb, 0x9b, 0xb6, 0xc3, 0x9c, 0x53,
-	0x83, 0x50, 0xe8, 0x23, 0x3e, 0x31, 0x06, 0x01, 0xbf, 0x9c, 0x6b, 0xf5, 0x19, 0xf2, 0xbd, 0x63,
-	0xfd, 0xaa, 0x55, 0x37, 0x8b, 0x22, 0x1c, 0x8a, 0x48, 0x31, 0xc1, 0xbe, 0xb8, 0x7d, 0x14, 0x61,
-	0x9b, 0x46, 0x0e, 0x6b, 0x6c, 0xb5, 0xb7, 0x3b, 0xc5, 0xee, 0x43, 0xe3, 0xe6, 0x30, 0x8d, 0xb5,
-	0xb6, 0x4c, 0xa1, 0xef, 0x15, 0x92, 0x16, 0xcc, 0x92, 0xbb, 0x3a, 0x62, 0xfa, 0x0f, 0x19, 0xd4,
-	0x37, 0x68, 0x15, 0x03, 0xec, 0xa5, 0x77, 0x11, 0x47, 0x00, 0x14, 0x7a, 0xf5, 0xcb, 0xb9, 0x56,
-	0x49, 0x7b, 0xcc, 0x33, 0xba, 0xb9, 0x2b, 0x7e, 0x07, 0x8e, 0xf2, 0x02, 0x94, 0xed, 0x38, 0x8a,
-	0x70, 0xc0, 0x73, 0xec, 0x2d, 0x81, 0x7d, 0xef, 0xaf, 0xd8, 0xe6, 0x7e, 0x66, 0xca, 0x08, 0x5f,
-	0x82, 0x9a, 0x1d, 0xfb, 0xb1, 0x87, 0x12, 0x88, 0xbc, 0xd0, 0xf6, 0xff, 0x14, 0xaa, 0xae, 0x7c,
-	0x69, 0xad, 0xe3, 0xc2, 0xaf, 0x2f, 0x9a, 0xac, 0x5f, 0xc8, 0x60, 0xa7, 0x9f, 0x2c, 0x9e, 0xf2,
-	0x00, 0x94, 0xc5, 0x06, 0x8e, 0xd6, 0xb9, 0xcc, 0x92, 0x38, 0xed, 0x67, 0x1c, 0xef, 0x40, 0x9d,
-	0x64, 0xe3, 0xc8, 0x85, 0xc1, 0x98, 0x0a, 0x98, 0x62, 0xf7, 0xe0, 0x9f, 0x93, 0x4e, 0x16, 0x20,
-	0x9b, 0x73, 0x8d, 0xdc, 0xd8, 0x8c, 0x57, 0xa0, 0xca, 0x42, 0x8f, 0x70, 0x4e, 0x02, 0x77, 0x14,
-	0x52, 0x8f, 0xd8, 0x33, 0x41, 0x57, 0xee, 0xde, 0xdf, 0x54, 0xf9, 0x75, 0xae, 0x3d, 0x11, 0x52,
-	0xb3, 0xc2, 0xd6, 0x0f, 0xf4, 0x0e, 0x28, 0x3e, 0x8f, 0x30, 0xe2, 0x38, 0x25, 0x6c, 0x82, 0xbd,
-	0x90, 0x52, 0x6f, 0x44, 0x1c, 0xd6, 0x90, 0xdb, 0xdb, 0x9d, 0x82, 0xb9, 0x9b, 0xc4, 0x03, 0x87,
-	0xe9, 0x1f, 0x41, 0x45, 0x68, 0xd8, 0x90, 0xf0, 0x89, 0x68, 0x48, 0x79, 0x06, 0x76, 0x04, 0xb9,
-	0x18, 0x43, 0xb1, 0xdb, 0xdc, 0xd4, 0x81, 0xf0, 0x64, 0x3c, 0xa9, 0x5a, 0xd8, 0x12, 0x7f, 0x36,
-	0x92, 0xcd, 0xb6, 0x44, 0xf0, 0xc7, 0x96, 0x04, 0x8f, 0x0e, 0x40, 0xe5, 0x1a, 0x8e, 0x52, 0x02,
-	0x7b, 0xbd, 0xd9, 0x1b, 0xea, 0xc5, 0x3e, 0xae, 0x4a, 0xad, 0xc2, 0xa7, 0xaf, 0xaa, 0xd4, 0x3b,
-	0x39, 0x5b, 0xa8, 0xf2, 0xf9, 0x42, 0x95, 0x7f, 0x2e, 0x54, 0xf9, 0xf3, 0x52, 0x95, 0xce, 0x97,
-	0xaa, 0xf4, 0x7d, 0xa9, 0x4a, 0x6f, 0x8f, 0x5c, 0xc2, 0x27, 0xb1, 0x65, 0xd8, 0xd4, 0x87, 0xd9,
-	0x95, 0x8f, 0x3d, 0x64, 0xb1, 0x3c, 0x80, 0xd3, 0xee, 0x11, 0xfc, 0x70, 0xf5, 0xa5, 0xf2, 0x59,
-	0x88, 0x99, 0x75, 0x4b, 0x3c, 0xd5, 0x27, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0x6f, 0x30,
-	0xb6, 0x92, 0x04, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x14, 0x8c, 0x93, 0xf4, 0x83, 0xcd, 0x67, 0x1d, 0x10, 0x69, 0x10, 0x4e, 0x30, 0x20, 0x22, 0x24,
+	0x6c, 0x35, 0x40, 0x0f, 0xe5, 0x16, 0x5a, 0x95, 0xa0, 0x0a, 0x55, 0xae, 0x44, 0x25, 0x10, 0xb2,
+	0x36, 0xeb, 0x8d, 0xb3, 0xaa, 0xed, 0xb5, 0xbc, 0xeb, 0x40, 0x4e, 0x5c, 0x39, 0xf2, 0x13, 0x90,
+	0xf8, 0x23, 0x88, 0x53, 0x8f, 0x3d, 0x22, 0x24, 0x22, 0x68, 0x2f, 0x9c, 0xfb, 0x0b, 0x90, 0xd7,
+	0x36, 0x6d, 0xda, 0xa8, 0x20, 0xc4, 0xc9, 0x7e, 0xfb, 0x66, 0x66, 0xdf, 0x3c, 0x8f, 0x0c, 0x14,
+	0xca, 0x5c, 0xca, 0x08, 0xd3, 0x89, 0x87, 0xb0, 0xc7, 0xc9, 0x08, 0x33, 0xdd, 0x0e, 0x68, 0xe8,
+	0x6b, 0x7e, 0x40, 0x39, 0x95, 0xe5, 0xa4, 0xaf, 0x9d, 0xf4, 0x1b, 0x97, 0x6d, 0x6a, 0x53, 0xd1,
+	0xd6, 0xa3, 0xb7, 0x18, 0xd9, 0x50, 0x6c, 0x4a, 0x6d, 0x07, 0xeb, 0xa2, 0xea, 0x87, 0x03, 0xdd,
+	0x0a, 0x03, 0xc8, 0x09, 0xf5, 0x92, 0x7e, 0xf3, 0x6c, 0x9f, 0x13, 0x17, 0x33, 0x0e, 0x5d, 0x3f,
+	0x15, 0x40, 0xe2, 0x2e, 0xbd, 0x0f, 0x19, 0xd6, 0x47, 0x2b, 0x7d, 0xcc, 0xe1, 0x8a, 0x8e, 0x28,
+	0x49, 0x05, 0x96, 0xd3, 0x51, 0x1d, 0x8a, 0xf6, 0x42, 0x5f, 0x3c, 0x52, 0xea, 0x2c, 0x17, 0x30,
+	0xb4, 0x71, 0xdc, 0x57, 0x3f, 0x49, 0x60, 0xa9, 0xe7, 0x71, 0x1c, 0x78, 0xd0, 0xd9, 0x8c, 0xce,
+	0x7b, 0xde, 0x80, 0xca, 0xbb, 0xa0, 0xc8, 0x29, 0x87, 0x8e, 0xf9, 0x1a, 0x13, 0x7b, 0xc8, 0xeb,
+	0x52, 0x4b, 0x6a, 0x5f, 0xea, 0x3e, 0xd8, 0x9f, 0x34, 0x33, 0x5f, 0x27, 0xcd, 0x2b, 0xf1, 0x38,
+	0xcc, 0xda, 0xd3, 0x08, 0xd5, 0x5d, 0xc8, 0x87, 0x5a, 0xcf, 0xe3, 0xc7, 0x93, 0x66, 0x6d, 0x0c,
+	0x5d, 0x67, 0x4d, 0x3d, 0x4d, 0x55, 0x8d, 0x82, 0x28, 0x77, 0x45, 0x25, 0x1b, 0xa0, 0x24, 0x6e,
+	0x37, 0x03, 0x8c, 0x68, 0x60, 0xb1, 0x7a, 0xb6, 0x95, 0x6b, 0x17, 0x3a, 0x77, 0xb4, 0xf3, 0xcb,
+	0xd4, 0xa6, 0xc6, 0x32, 0x04, 0xbe, 0x9b, 0x8f, 0x46, 0x30, 0x8a, 0xf6, 0xc9, 0x11, 0x53, 0xbf,
+	0x49, 0xa0, 0x36, 0x03, 0x2b, 0x6b, 0x60, 0x31, 0xbe, 0x8b, 0x58, 0xc2, 0x40, 0xbe, 0x5b, 0x3b,
+	0x9e, 0x34, 0x2b, 0xf1, 0x8c, 0x69, 0x47, 0x35, 0x16, 0xc4, 0x6b, 0xcf, 0x92, 0xd7, 0x41, 0x19,
+	0x85, 0x41, 0x80, 0x3d, 0x9e, 0xda, 0xce, 0x0a, 0xdb, 0xd7, 0x2f, 0xb4, 0x6d, 0x94, 0x12, 0x52,
+	0xe2, 0xf0, 0x29, 0x58, 0x42, 0xa1, 0x1b, 0x3a, 0x30, 0x32, 0x91, 0x0a, 0xe5, 0xfe, 0x46, 0xa8,
+	0x7a, 0xc2, 0x8b, 0xb5, 0xd6, 0xf2, 0x3f, 0x3f, 0x34, 0x25, 0xf5, 0x47, 0x16, 0xcc, 0x6d, 0x46,
+	0xc1, 0x93, 0x6f, 0x81, 0xb2, 0x48, 0xa0, 0x39, 0xed, 0xcb, 0x28, 0x8a, 0xd3, 0xcd, 0xc4, 0xc7,
+	0x4b, 0x50, 0x23, 0xc9, 0x3a, 0x52, 0xa0, 0x37, 0xa0, 0xc2, 0x4c, 0xa1, 0x73, 0xfb, 0x8f, 0x9b,
+	0x8e, 0x02, 0x90, 0xec, 0x79, 0x89, 0x9c, 0x4b, 0xc6, 0x33, 0x50, 0x65, 0xbe, 0x43, 0x38, 0x27,
+	0x9e, 0x6d, 0xfa, 0xd4, 0x21, 0x68, 0x2c, 0xdc, 0x95, 0x3b, 0x37, 0x67, 0x29, 0xef, 0xa4, 0xd8,
+	0x6d, 0x01, 0x35, 0x2a, 0x6c, 0xfa, 0x40, 0x7e, 0x02, 0xca, 0xd8, 0x25, 0x8c, 0x11, 0xea, 0x99,
+	0x16, 0x46, 0x70, 0x5c, 0xcf, 0x8b, 0x39, 0x6f, 0xcc, 0x52, 0xdb, 0x48, 0x90, 0xeb, 0x11, 0xd0,
+	0x28, 0xe1, 0xd3, 0xa5, 0xfc, 0x08, 0x34, 0xa6, 0x95, 0x4c, 0xc6, 0x61, 0xc0, 0x4d, 0xec, 0x53,
+	0x34, 0xac, 0xcf, 0xb5, 0xa4, 0x76, 0xce, 0xb8, 0x3a, 0x45, 0xd9, 0x89, 0xfa, 0x1b, 0x51, 0x5b,
+	0x7d, 0x05, 0x4a, 0x53, 0xe2, 0xf2, 0x16, 0x98, 0x1f, 0x40, 0xc4, 0x69, 0x70, 0x26, 0xfb, 0xd7,
+	0xce, 0x7f, 0xbb, 0x2d, 0x6c, 0x43, 0x34, 0x5e, 0xc7, 0xe8, 0x78, 0xd2, 0x2c, 0xc5, 0xe9, 0x8a,
+	0xa9, 0xaa, 0x91, 0x68, 0xa8, 0x9f, 0x25, 0x50, 0x78, 0x1c, 0x60, 0xc8, 0x71, 0xfc, 0x21, 0x97,
+	0xc1, 0xa2, 0x4f, 0xa9, 0x63, 0x12, 0x8b, 0xd5, 0xa5, 0x56, 0xae, 0x9d, 0x37, 0x16, 0xa2, 0xba,
+	0x67, 0xb1, 0x99, 0x0b, 0xce, 0xfe, 0xd7, 0x05, 0xe7, 0xfe, 0x6d, 0xc1, 0xea, 0x5b, 0x50, 0x11,
+	0xd3, 0xb3, 0x5d, 0xc2, 0x87, 0x22, 0x11, 0xf2, 0x43, 0x30, 0x27, 0xa2, 0x27, 0x96, 0x54, 0xe8,
+	0x2c, 0xcf, 0xd2, 0x14, 0x9c, 0x24, 0x50, 0x31, 0x5a, 0xd0, 0x22, 0x7e, 0x3d, 0x7b, 0x01, 0x2d,
+	0x02, 0xfc, 0xa6, 0x45, 0xc5, 0xdd, 0x15, 0x50, 0x39, 0x63, 0x57, 0x2e, 0x82, 0xc5, 0xee, 0xf8,
+	0x39, 0x75, 0x42, 0x17, 0x57, 0x33, 0x32, 0x00, 0xf3, 0x1b, 0x23, 0xec, 0x39, 0xe3, 0xaa, 0xd4,
+	0xc8, 0xbf, 0xfb, 0xa8, 0x64, 0xba, 0xdb, 0xfb, 0x87, 0x8a, 0x74, 0x70, 0xa8, 0x48, 0xdf, 0x0f,
+	0x15, 0xe9, 0xfd, 0x91, 0x92, 0x39, 0x38, 0x52, 0x32, 0x5f, 0x8e, 0x94, 0xcc, 0x8b, 0x55, 0x9b,
+	0xf0, 0x61, 0xd8, 0xd7, 0x10, 0x75, 0xf5, 0xe4, 0xfa, 0x7b, 0x0e, 0xec, 0xb3, 0xb4, 0xd0, 0x47,
+	0x9d, 0x55, 0xfd, 0xcd, 0xe9, 0xdf, 0x26, 0x1f, 0xfb, 0x98, 0xf5, 0xe7, 0xc5, 0x7f, 0xf3, 0xfe,
+	0xaf, 0x01, 0x00, 0xc4, 0xc8, 0xeb, 0xa4, 0x1f, 0x06, 0x00, 0x00,
 }
 
 func (this *InternalGaugeRecord) Equal(that interface{}) bool {
@@ -517,6 +613,23 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmissionDecayStartEpoch != 0 {
+		i = encodeVarintGroup(dAtA, i, uint64(m.EmissionDecayStartEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EmissionDecay != nil {
+		{
+			size, err := m.EmissionDecay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGroup(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SplittingPolicy != 0 {
 		i = encodeVarintGroup(dAtA, i, uint64(m.SplittingPolicy))
 		i--
@@ -540,6 +653,39 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EmissionDecay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionDecay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionDecay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Factor.Size()
+		i -= size
+		if _, err := m.Factor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGroup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CreateGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -560,21 +706,38 @@ func (m *CreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmissionDecay != nil {
+		{
+			size, err := m.EmissionDecay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGroup(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SplittingPolicy != 0 {
+		i = encodeVarintGroup(dAtA, i, uint64(m.SplittingPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.PoolIds) > 0 {
-		dAtA3 := make([]byte, len(m.PoolIds)*10)
-		var j2 int
+		dAtA5 := make([]byte, len(m.PoolIds)*10)
+		var j4 int
 		for _, num := range m.PoolIds {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j4++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintGroup(dAtA, i, uint64(j2))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGroup(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -682,6 +845,24 @@ func (m *Group) Size() (n int) {
 	if m.SplittingPolicy != 0 {
 		n += 1 + sovGroup(uint64(m.SplittingPolicy))
 	}
+	if m.EmissionDecay != nil {
+		l = m.EmissionDecay.Size()
+		n += 1 + l + sovGroup(uint64(l))
+	}
+	if m.EmissionDecayStartEpoch != 0 {
+		n += 1 + sovGroup(uint64(m.EmissionDecayStartEpoch))
+	}
+	return n
+}
+
+func (m *EmissionDecay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Factor.Size()
+	n += 1 + l + sovGroup(uint64(l))
 	return n
 }
 
@@ -698,6 +879,13 @@ func (m *CreateGroup) Size() (n int) {
 		}
 		n += 1 + sovGroup(uint64(l)) + l
 	}
+	if m.SplittingPolicy != 0 {
+		n += 1 + sovGroup(uint64(m.SplittingPolicy))
+	}
+	if m.EmissionDecay != nil {
+		l = m.EmissionDecay.Size()
+		n += 1 + l + sovGroup(uint64(l))
+	}
 	return n
 }
 
@@ -1075,6 +1263,145 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionDecay == nil {
+				m.EmissionDecay = &EmissionDecay{}
+			}
+			if err := m.EmissionDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionDecayStartEpoch", wireType)
+			}
+			m.EmissionDecayStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionDecayStartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionDecay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionDecay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionDecay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGroup(dAtA[iNdEx:])
@@ -1201,6 +1528,61 @@ func (m *CreateGroup) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplittingPolicy", wireType)
+			}
+			m.SplittingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplittingPolicy |= SplittingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionDecay == nil {
+				m.EmissionDecay = &EmissionDecay{}
+			}
+			if err := m.EmissionDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGroup(dAtA[iNdEx:])
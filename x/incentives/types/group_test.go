@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func TestValidateEmissionDecay(t *testing.T) {
+	tests := map[string]struct {
+		emissionDecay *EmissionDecay
+		expectErr     bool
+	}{
+		"unset": {
+			emissionDecay: nil,
+		},
+		"valid factor": {
+			emissionDecay: &EmissionDecay{Factor: osmomath.MustNewDecFromStr("0.9")},
+		},
+		"nil factor": {
+			emissionDecay: &EmissionDecay{},
+			expectErr:     true,
+		},
+		"zero factor": {
+			emissionDecay: &EmissionDecay{Factor: osmomath.ZeroDec()},
+			expectErr:     true,
+		},
+		"factor of one": {
+			emissionDecay: &EmissionDecay{Factor: osmomath.OneDec()},
+			expectErr:     true,
+		},
+		"negative factor": {
+			emissionDecay: &EmissionDecay{Factor: osmomath.MustNewDecFromStr("-0.5")},
+			expectErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateEmissionDecay(tc.emissionDecay)
+			if tc.expectErr {
+				require.ErrorAs(t, err, &InvalidEmissionDecayFactorError{})
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDecayedEmission(t *testing.T) {
+	remainingCoins := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 700_000))
+	decay := EmissionDecay{Factor: osmomath.MustNewDecFromStr("0.5")}
+
+	tests := map[string]struct {
+		isPerpetual     bool
+		remainingEpochs uint64
+		epochsElapsed   uint64
+		expected        sdk.Coins
+	}{
+		"perpetual distributes all of the remaining coins at its first epoch": {
+			isPerpetual: true,
+			expected:    remainingCoins,
+		},
+		"perpetual distributes factor^epochsElapsed of the remaining coins": {
+			isPerpetual:   true,
+			epochsElapsed: 2,
+			expected:      sdk.NewCoins(sdk.NewInt64Coin("uosmo", 175_000)),
+		},
+		"non-perpetual does not depend on the epochs elapsed": {
+			remainingEpochs: 3,
+			epochsElapsed:   5,
+			expected:        sdk.NewCoins(sdk.NewInt64Coin("uosmo", 400_000)),
+		},
+		"non-perpetual front-loads by the geometric series": {
+			// 700_000 * 0.5 / (1 - 0.5^3) = 400_000, leaving 200_000 and 100_000 for the next epochs.
+			remainingEpochs: 3,
+			expected:        sdk.NewCoins(sdk.NewInt64Coin("uosmo", 400_000)),
+		},
+		"non-perpetual distributes everything at its last epoch": {
+			remainingEpochs: 1,
+			expected:        remainingCoins,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, decay.DecayedEmission(remainingCoins, tc.isPerpetual, tc.remainingEpochs, tc.epochsElapsed))
+		})
+	}
+}
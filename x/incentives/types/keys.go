@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var (
 	// ModuleName defines the module name.
@@ -48,6 +53,18 @@ var (
 	// KeyPrefixGroup defines prefix key for storing groups.
 	KeyPrefixGroup = []byte{0x08}
 
+	// KeyPrefixLockRewardRecord defines prefix key for storing the rewards accrued to locks.
+	KeyPrefixLockRewardRecord = []byte{0x09}
+
+	// KeyPrefixGaugesByRewardDenom defines prefix key for indexing gauge IDs by the denoms of their reward coins.
+	KeyPrefixGaugesByRewardDenom = []byte{0x0A}
+
+	// KeyPrefixLockRewardCheckpoint defines prefix key for storing the checkpoints of locks in the reward growth accumulators.
+	KeyPrefixLockRewardCheckpoint = []byte{0x0B}
+
+	// KeyPrefixRewardGrowthAccumulator defines prefix key for storing the reward growth accumulators by denom and duration.
+	KeyPrefixRewardGrowthAccumulator = []byte{0x0C}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 
@@ -73,3 +90,38 @@ func NoLockInternalGaugeDenom(poolId uint64) string {
 func KeyGroupByGaugeID(groupGaugeId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d%s", KeyPrefixGroup, groupGaugeId, KeyIndexSeparator))
 }
+
+// KeyLockRewardRecord returns the key of the reward record for the given lock ID.
+func KeyLockRewardRecord(lockId uint64) []byte {
+	return append(KeyPrefixLockRewardRecord, sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// KeyPrefixGaugesByRewardDenomForDenom returns the prefix of the keys indexing the gauges rewarding the given denom.
+func KeyPrefixGaugesByRewardDenomForDenom(denom string) []byte {
+	key := append([]byte{}, KeyPrefixGaugesByRewardDenom...)
+	key = append(key, []byte(denom)...)
+	return append(key, KeyIndexSeparator...)
+}
+
+// KeyGaugeByRewardDenom returns the key indexing the given gauge ID under the given reward denom.
+func KeyGaugeByRewardDenom(denom string, gaugeId uint64) []byte {
+	return append(KeyPrefixGaugesByRewardDenomForDenom(denom), sdk.Uint64ToBigEndian(gaugeId)...)
+}
+
+// KeyLockRewardCheckpoint returns the key of the reward growth checkpoint of the given lock ID.
+func KeyLockRewardCheckpoint(lockId uint64) []byte {
+	return append(KeyPrefixLockRewardCheckpoint, sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// KeyPrefixRewardGrowthAccumulatorsForDenom returns the prefix of the keys of the reward growth accumulators of the given denom.
+func KeyPrefixRewardGrowthAccumulatorsForDenom(denom string) []byte {
+	key := append([]byte{}, KeyPrefixRewardGrowthAccumulator...)
+	key = append(key, []byte(denom)...)
+	return append(key, KeyIndexSeparator...)
+}
+
+// KeyRewardGrowthAccumulator returns the key of the reward growth accumulator of the given denom and duration.
+// Keys of the same denom are ordered by duration.
+func KeyRewardGrowthAccumulator(denom string, duration time.Duration) []byte {
+	return append(KeyPrefixRewardGrowthAccumulatorsForDenom(denom), sdk.Uint64ToBigEndian(uint64(duration))...)
+}
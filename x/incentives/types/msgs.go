@@ -12,9 +12,11 @@ import (
 )
 
 const (
-	TypeMsgCreateGauge = "create_gauge"
-	TypeMsgAddToGauge  = "add_to_gauge"
-	TypeMsgCreateGroup = "create_group"
+	TypeMsgCreateGauge         = "create_gauge"
+	TypeMsgAddToGauge          = "add_to_gauge"
+	TypeMsgAddToGaugeAndExtend = "add_to_gauge_and_extend"
+	TypeMsgCreateGroup         = "create_group"
+	TypeMsgClaimRewards        = "claim_rewards"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -83,6 +85,19 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		}
 	}
 
+	if err := ValidateRecipientLists(m.RecipientAllowlist, m.RecipientDenylist); err != nil {
+		return err
+	}
+	if (len(m.RecipientAllowlist) > 0 || len(m.RecipientDenylist) > 0) && lockType != lockuptypes.ByDuration {
+		return RecipientListUnsupportedError{LockQueryType: lockType}
+	}
+
+	if m.MinLockAmount != nil {
+		if err := ValidateMinLockAmount(*m.MinLockAmount, lockType); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -127,6 +142,46 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{owner}
 }
 
+var _ sdk.Msg = &MsgAddToGaugeAndExtend{}
+
+// NewMsgAddToGaugeAndExtend creates a message to add rewards to a specific non-perpetual gauge
+// and extend the number of epochs it is paid over.
+func NewMsgAddToGaugeAndExtend(owner sdk.AccAddress, gaugeId uint64, rewards sdk.Coins, additionalEpochs uint64) *MsgAddToGaugeAndExtend {
+	return &MsgAddToGaugeAndExtend{
+		Owner:            owner.String(),
+		GaugeId:          gaugeId,
+		Rewards:          rewards,
+		AdditionalEpochs: additionalEpochs,
+	}
+}
+
+// Route takes an add to gauge and extend message, then returns the RouterKey.
+func (m MsgAddToGaugeAndExtend) Route() string { return RouterKey }
+
+// Type takes an add to gauge and extend message, then returns the message type.
+func (m MsgAddToGaugeAndExtend) Type() string { return TypeMsgAddToGaugeAndExtend }
+
+// ValidateBasic checks that the add to gauge and extend message is valid.
+func (m MsgAddToGaugeAndExtend) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if m.Rewards.Empty() {
+		return errors.New("additional rewards should not be empty")
+	}
+	if m.AdditionalEpochs == 0 {
+		return errors.New("additional epochs should be greater than zero")
+	}
+
+	return nil
+}
+
+// GetSigners takes an add to gauge and extend message and returns the owner in a byte array.
+func (m MsgAddToGaugeAndExtend) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
 var _ sdk.Msg = &MsgCreateGroup{}
 
 // NewMsgCreateGroup creates a message to create a group with the provided parameters.
@@ -162,6 +217,14 @@ func (m MsgCreateGroup) ValidateBasic() error {
 		return errors.New("pool ids should be unique")
 	}
 
+	if err := ValidateSplittingPolicy(m.SplittingPolicy); err != nil {
+		return err
+	}
+
+	if err := ValidateEmissionDecay(m.EmissionDecay); err != nil {
+		return err
+	}
+
 	// Temporarily disable non perpetual group creation
 	// https://github.com/osmosis-labs/osmosis/issues/6540
 	if m.NumEpochsPaidOver != PerpetualNumEpochsPaidOver {
@@ -176,3 +239,40 @@ func (m MsgCreateGroup) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a message to claim the rewards accrued to the given locks.
+func NewMsgClaimRewards(owner sdk.AccAddress, lockIds []uint64) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner:   owner.String(),
+		LockIds: lockIds,
+	}
+}
+
+// Route takes a claim rewards message, then returns the RouterKey.
+func (m MsgClaimRewards) Route() string { return RouterKey }
+
+// Type takes a claim rewards message, then returns the message type.
+func (m MsgClaimRewards) Type() string { return TypeMsgClaimRewards }
+
+// ValidateBasic checks that the claim rewards message is valid.
+func (m MsgClaimRewards) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if len(m.LockIds) == 0 {
+		return errors.New("lock ids should not be empty")
+	}
+	if !osmoassert.Uint64ArrayValuesAreUnique(m.LockIds) {
+		return errors.New("lock ids should be unique")
+	}
+
+	return nil
+}
+
+// GetSigners takes a claim rewards message and returns the owner in a byte array.
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -210,6 +210,101 @@ func TestMsgCreateGauge(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid recipient allowlist",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.RecipientAllowlist = []string{addr1.String()}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "valid recipient denylist",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.RecipientDenylist = []string{addr1.String()}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid: both recipient allowlist and denylist set",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.RecipientAllowlist = []string{addr1.String()}
+				msg.RecipientDenylist = []string{addr1.String()}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid: recipient list with invalid address",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.RecipientDenylist = []string{"invalid"}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid: recipient list with duplicate address",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.RecipientAllowlist = []string{addr1.String(), addr1.String()}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid: recipient list too long",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.RecipientDenylist = make([]string, types.MaxGaugeRecipientListLength+1)
+				for i := range msg.RecipientDenylist {
+					msg.RecipientDenylist[i] = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid: recipient list on no lock gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = ""
+				msg.DistributeTo.Duration = 0
+				msg.PoolId = 1
+				msg.RecipientAllowlist = []string{addr1.String()}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid min lock amount",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				minLockAmount := osmomath.NewInt(100)
+				msg.MinLockAmount = &minLockAmount
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid: negative min lock amount",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				minLockAmount := osmomath.NewInt(-1)
+				msg.MinLockAmount = &minLockAmount
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid: min lock amount on no lock gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = ""
+				msg.DistributeTo.Duration = 0
+				msg.PoolId = 1
+				minLockAmount := osmomath.NewInt(100)
+				msg.MinLockAmount = &minLockAmount
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -290,6 +385,150 @@ func TestMsgAddToGauge(t *testing.T) {
 	}
 }
 
+// TestMsgAddToGaugeAndExtend tests if valid/invalid add to gauge and extend messages are properly validated/invalidated
+func TestMsgAddToGaugeAndExtend(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper addToGaugeAndExtend message
+	createMsg := func(after func(msg incentivestypes.MsgAddToGaugeAndExtend) incentivestypes.MsgAddToGaugeAndExtend) incentivestypes.MsgAddToGaugeAndExtend {
+		properMsg := *incentivestypes.NewMsgAddToGaugeAndExtend(
+			addr1,
+			1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+			1,
+		)
+
+		return after(properMsg)
+	}
+
+	// validate addToGaugeAndExtend message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgAddToGaugeAndExtend) incentivestypes.MsgAddToGaugeAndExtend {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "add_to_gauge_and_extend")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgAddToGaugeAndExtend
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgAddToGaugeAndExtend) incentivestypes.MsgAddToGaugeAndExtend {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg incentivestypes.MsgAddToGaugeAndExtend) incentivestypes.MsgAddToGaugeAndExtend {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty rewards",
+			msg: createMsg(func(msg incentivestypes.MsgAddToGaugeAndExtend) incentivestypes.MsgAddToGaugeAndExtend {
+				msg.Rewards = sdk.Coins{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero additional epochs",
+			msg: createMsg(func(msg incentivestypes.MsgAddToGaugeAndExtend) incentivestypes.MsgAddToGaugeAndExtend {
+				msg.AdditionalEpochs = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgClaimRewards(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper claimRewards message
+	createMsg := func(after func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+		properMsg := *incentivestypes.NewMsgClaimRewards(addr1, []uint64{1, 2})
+
+		return after(properMsg)
+	}
+
+	// validate claimRewards message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "claim_rewards")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgClaimRewards
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty lock ids",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.LockIds = []uint64{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate lock ids",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.LockIds = []uint64{1, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestMsgCreateGroup(t *testing.T) {
 	// generate a private/public key pair and get the respective address
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -369,6 +608,22 @@ func TestMsgCreateGroup(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid emission decay",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroup) incentivestypes.MsgCreateGroup {
+				msg.EmissionDecay = &incentivestypes.EmissionDecay{Factor: osmomath.MustNewDecFromStr("0.95")}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "emission decay factor of one",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroup) incentivestypes.MsgCreateGroup {
+				msg.EmissionDecay = &incentivestypes.EmissionDecay{Factor: osmomath.OneDec()}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -400,6 +655,22 @@ func TestAuthzMsg(t *testing.T) {
 				Rewards: sdk.NewCoins(coin),
 			},
 		},
+		{
+			name: "MsgAddToGaugeAndExtend",
+			incentivesMsg: &incentivestypes.MsgAddToGaugeAndExtend{
+				Owner:            addr1,
+				GaugeId:          1,
+				Rewards:          sdk.NewCoins(coin),
+				AdditionalEpochs: 1,
+			},
+		},
+		{
+			name: "MsgClaimRewards",
+			incentivesMsg: &incentivestypes.MsgClaimRewards{
+				Owner:   addr1,
+				LockIds: []uint64{1, 2},
+			},
+		},
 		{
 			name: "MsgCreateGauge",
 			incentivesMsg: &incentivestypes.MsgCreateGauge{
@@ -23,6 +23,14 @@ var (
 	KeyCreatorWhitelist     = []byte("CreatorWhitelist")
 	KeyInternalUptime       = []byte("InternalUptime")
 	KeyMinValueForDistr     = []byte("MinValueForDistr")
+	KeyLazyRewardClaiming   = []byte("LazyRewardClaiming")
+	KeyGaugeFeePerLock      = []byte("GaugeFeePerLock")
+
+	// Lock rewards are sent during the epoch hook by default.
+	DefaultLazyRewardClaiming = false
+
+	// Gauge fees do not scale with the number of locks by default.
+	DefaultGaugeFeePerLock = osmomath.ZeroInt()
 
 	// 100 OSMO
 	DefaultGroupCreationFee = sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(100_000_000)))
@@ -52,6 +60,8 @@ func DefaultParams() Params {
 		UnrestrictedCreatorWhitelist: []string{},
 		InternalUptime:               DefaultConcentratedUptime,
 		MinValueForDistribution:      DefaultMinValueForDistr,
+		LazyRewardClaiming:           DefaultLazyRewardClaiming,
+		GaugeFeePerLock:              DefaultGaugeFeePerLock,
 	}
 }
 
@@ -77,6 +87,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateLazyRewardClaiming(p.LazyRewardClaiming); err != nil {
+		return err
+	}
+
+	if err := ValidateGaugeFeePerLock(p.GaugeFeePerLock); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -127,6 +145,25 @@ func ValidateMinValueForDistr(i interface{}) error {
 	return nil
 }
 
+func ValidateLazyRewardClaiming(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func ValidateGaugeFeePerLock(i interface{}) error {
+	v, ok := i.(osmomath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("gauge fee per lock must not be negative: %s", v)
+	}
+	return nil
+}
+
 // ParamSetPairs takes the parameter struct and associates the paramsubspace key and field of the parameters as a KVStore.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
@@ -135,5 +172,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCreatorWhitelist, &p.UnrestrictedCreatorWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyInternalUptime, &p.InternalUptime, ValidateInternalUptime),
 		paramtypes.NewParamSetPair(KeyMinValueForDistr, &p.MinValueForDistribution, ValidateMinValueForDistr),
+		paramtypes.NewParamSetPair(KeyLazyRewardClaiming, &p.LazyRewardClaiming, ValidateLazyRewardClaiming),
+		paramtypes.NewParamSetPair(KeyGaugeFeePerLock, &p.GaugeFeePerLock, ValidateGaugeFeePerLock),
 	}
 }
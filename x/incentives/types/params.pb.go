@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -60,6 +61,17 @@ type Params struct {
 	// registered), it will not be distributed and is forfeited to the remaining
 	// distributees that are eligible.
 	MinValueForDistribution types.Coin `protobuf:"bytes,5,opt,name=min_value_for_distribution,json=minValueForDistribution,proto3" json:"min_value_for_distribution"`
+	// lazy_reward_claiming determines whether lock rewards are paid out
+	// lazily. If true, the rewards distributed to locks at every epoch are
+	// accrued to per-lock reward records held by the module account, which the
+	// lock owners claim with MsgClaimRewards. If false, the rewards are sent to
+	// the lock owners during the epoch hook.
+	LazyRewardClaiming bool `protobuf:"varint,6,opt,name=lazy_reward_claiming,json=lazyRewardClaiming,proto3" json:"lazy_reward_claiming,omitempty" yaml:"lazy_reward_claiming"`
+	// gauge_fee_per_lock is the fee, in the tx fee base denom, charged per lock
+	// that a gauge distributes to when it is created or added to. It is
+	// charged on top of the flat gauge fees and sent to the community pool, to
+	// price in the cost of distributing to many locks. Zero disables it.
+	GaugeFeePerLock cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=gauge_fee_per_lock,json=gaugeFeePerLock,proto3,customtype=cosmossdk.io/math.Int" json:"gauge_fee_per_lock" yaml:"gauge_fee_per_lock"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -130,6 +142,13 @@ func (m *Params) GetMinValueForDistribution() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetLazyRewardClaiming() bool {
+	if m != nil {
+		return m.LazyRewardClaiming
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.incentives.Params")
 }
@@ -137,37 +156,43 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/params.proto", fileDescriptor_1cc8b460d089f845) }
 
 var fileDescriptor_1cc8b460d089f845 = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xdd, 0x6a, 0xd4, 0x40,
-	0x18, 0xdd, 0xb8, 0x6b, 0xa1, 0x11, 0x54, 0x42, 0xa9, 0x71, 0xd1, 0x64, 0x0d, 0x08, 0xeb, 0x45,
-	0x33, 0xb6, 0x42, 0x2f, 0xbc, 0xcc, 0xd6, 0x82, 0x77, 0x25, 0xa0, 0x05, 0x11, 0x42, 0x7e, 0xbe,
-	0x64, 0x3f, 0x4c, 0xf2, 0x85, 0x99, 0xc9, 0xea, 0xbe, 0x85, 0xe0, 0x8d, 0xcf, 0xe0, 0x93, 0xf4,
-	0xb2, 0x97, 0x5e, 0x6d, 0x65, 0xf7, 0x0d, 0xf6, 0x09, 0x24, 0x93, 0x44, 0x17, 0x51, 0xaf, 0x92,
-	0x39, 0xe7, 0x7c, 0x73, 0xe6, 0xcc, 0x19, 0xdd, 0x26, 0x51, 0x90, 0x40, 0xc1, 0xb0, 0x8c, 0xa1,
-	0x94, 0xb8, 0x00, 0xc1, 0xaa, 0x90, 0x87, 0x85, 0x70, 0x2b, 0x4e, 0x92, 0x0c, 0xa3, 0x13, 0xb8,
-	0xbf, 0x05, 0xe3, 0x83, 0x8c, 0x32, 0x52, 0x34, 0x6b, 0xfe, 0x5a, 0xe5, 0xd8, 0x8a, 0x95, 0x94,
-	0x45, 0xa1, 0x00, 0xb6, 0x38, 0x8e, 0x40, 0x86, 0xc7, 0x2c, 0x26, 0x2c, 0x7b, 0x3e, 0x23, 0xca,
-	0x72, 0x60, 0x6a, 0x15, 0xd5, 0x29, 0x4b, 0x6a, 0x1e, 0x4a, 0xa4, 0x8e, 0x77, 0xbe, 0x8c, 0xf4,
-	0xbd, 0x0b, 0x65, 0x6d, 0x5c, 0xea, 0x87, 0x09, 0x0a, 0xc9, 0x03, 0xa8, 0x28, 0x9e, 0x07, 0x98,
-	0x34, 0xce, 0x29, 0x02, 0x37, 0xb5, 0x89, 0x36, 0xdd, 0xf7, 0x9e, 0x6c, 0x57, 0xf6, 0xe3, 0x65,
-	0x58, 0xe4, 0x2f, 0x9d, 0xbf, 0xeb, 0x1c, 0xff, 0x40, 0x11, 0xaf, 0x1a, 0xfc, 0xf5, 0x2f, 0xd8,
-	0x58, 0xea, 0x46, 0xc6, 0xa9, 0xae, 0x82, 0x98, 0x83, 0xf2, 0x0e, 0x52, 0x00, 0xf3, 0xd6, 0x64,
-	0x38, 0xbd, 0x73, 0xf2, 0xd0, 0x6d, 0x03, 0xb8, 0x4d, 0x00, 0xb7, 0x0b, 0xe0, 0xce, 0x08, 0x4b,
-	0xef, 0xf9, 0xd5, 0xca, 0x1e, 0x7c, 0xbb, 0xb1, 0xa7, 0x19, 0xca, 0x79, 0x1d, 0xb9, 0x31, 0x15,
-	0xac, 0x4b, 0xdb, 0x7e, 0x8e, 0x44, 0xf2, 0x81, 0xc9, 0x65, 0x05, 0x42, 0x0d, 0x08, 0xff, 0xbe,
-	0xb2, 0x99, 0x75, 0x2e, 0xe7, 0x00, 0x06, 0xe9, 0x56, 0x5d, 0x72, 0x10, 0x92, 0x63, 0x2c, 0x21,
-	0x69, 0x4f, 0x40, 0x3c, 0xf8, 0x38, 0x47, 0x09, 0x39, 0x0a, 0x69, 0x0e, 0x27, 0xc3, 0xe9, 0xbe,
-	0xf7, 0x6c, 0xbb, 0xb2, 0x9f, 0xb6, 0xd9, 0xfe, 0xaf, 0x77, 0xfc, 0x47, 0xbb, 0x82, 0x59, 0xcb,
-	0x5f, 0xf6, 0xb4, 0x91, 0xea, 0xf7, 0xb0, 0x94, 0xc0, 0xcb, 0x30, 0x0f, 0xea, 0x4a, 0x62, 0x01,
-	0xe6, 0x68, 0xa2, 0xa9, 0xa0, 0x6d, 0x13, 0x6e, 0xdf, 0x84, 0x7b, 0xd6, 0x35, 0xe1, 0x39, 0x4d,
-	0xd0, 0xed, 0xca, 0x3e, 0x6c, 0x0f, 0xf0, 0xc7, 0xbc, 0xf3, 0xf5, 0xc6, 0xd6, 0xfc, 0xbb, 0x3d,
-	0xfa, 0x46, 0x81, 0xc6, 0x7b, 0x7d, 0x5c, 0x60, 0x19, 0x2c, 0xc2, 0xbc, 0x86, 0x20, 0x25, 0x1e,
-	0xa8, 0x9b, 0xc7, 0xa8, 0x6e, 0x76, 0x34, 0x6f, 0x77, 0x96, 0xff, 0xbc, 0xdb, 0x51, 0x63, 0xe9,
-	0x3f, 0x28, 0xb0, 0x7c, 0xdb, 0xec, 0x70, 0x4e, 0xfc, 0x6c, 0x67, 0xde, 0xbb, 0xb8, 0x5a, 0x5b,
-	0xda, 0xf5, 0xda, 0xd2, 0x7e, 0xac, 0x2d, 0xed, 0xf3, 0xc6, 0x1a, 0x5c, 0x6f, 0xac, 0xc1, 0xf7,
-	0x8d, 0x35, 0x78, 0x77, 0xba, 0x53, 0x46, 0xf7, 0x48, 0x8f, 0xf2, 0x30, 0x12, 0xfd, 0x82, 0x2d,
-	0x4e, 0x4e, 0xd9, 0xa7, 0xdd, 0x87, 0xad, 0x0a, 0x8a, 0xf6, 0x54, 0xec, 0x17, 0x3f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x63, 0x22, 0x93, 0x4d, 0xfb, 0x02, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x6e, 0xd4, 0x3c,
+	0x14, 0x9d, 0x7c, 0xfd, 0xf9, 0x68, 0x90, 0x28, 0xb2, 0x4a, 0x49, 0x0b, 0x24, 0x43, 0x24, 0xa4,
+	0x61, 0xd1, 0x98, 0x16, 0xa9, 0x8b, 0x2e, 0x33, 0xa5, 0x52, 0x25, 0x16, 0x25, 0x12, 0x54, 0x42,
+	0x48, 0x96, 0x93, 0xdc, 0xc9, 0x58, 0x93, 0xc4, 0x91, 0xed, 0x4c, 0x19, 0x9e, 0x82, 0x25, 0xcf,
+	0xc0, 0x93, 0x74, 0x47, 0x97, 0x88, 0x45, 0x8a, 0x66, 0xde, 0x60, 0x9e, 0x00, 0xc5, 0xc9, 0xc0,
+	0x08, 0x0a, 0xab, 0xc4, 0xe7, 0x1c, 0xfb, 0xdc, 0xe3, 0x7b, 0x6d, 0x3a, 0x5c, 0x66, 0x5c, 0x32,
+	0x89, 0x59, 0x1e, 0x41, 0xae, 0xd8, 0x18, 0x24, 0x2e, 0xa8, 0xa0, 0x99, 0xf4, 0x0a, 0xc1, 0x15,
+	0x47, 0xa8, 0x15, 0x78, 0xbf, 0x04, 0xbb, 0x5b, 0x09, 0x4f, 0xb8, 0xa6, 0x71, 0xfd, 0xd7, 0x28,
+	0x77, 0xed, 0x48, 0x4b, 0x71, 0x48, 0x25, 0xe0, 0xf1, 0x7e, 0x08, 0x8a, 0xee, 0xe3, 0x88, 0xb3,
+	0x7c, 0xc1, 0x27, 0x9c, 0x27, 0x29, 0x60, 0xbd, 0x0a, 0xcb, 0x01, 0x8e, 0x4b, 0x41, 0x15, 0xe3,
+	0x2d, 0xef, 0x7e, 0x59, 0x33, 0xd7, 0xcf, 0xb4, 0x35, 0x3a, 0x37, 0xb7, 0x63, 0x26, 0x95, 0x20,
+	0x50, 0xf0, 0x68, 0x48, 0x58, 0x5c, 0x3b, 0x0f, 0x18, 0x08, 0xcb, 0xe8, 0x1a, 0xbd, 0x0d, 0xff,
+	0xf1, 0xbc, 0x72, 0x1e, 0x4d, 0x68, 0x96, 0x1e, 0xb9, 0x37, 0xeb, 0xdc, 0x60, 0x4b, 0x13, 0x2f,
+	0x6a, 0xfc, 0xf4, 0x27, 0x8c, 0x26, 0x26, 0x4a, 0x04, 0x2f, 0x0b, 0x12, 0x09, 0xd0, 0xde, 0x64,
+	0x00, 0x60, 0xfd, 0xd7, 0x5d, 0xe9, 0xdd, 0x3e, 0xd8, 0xf1, 0x9a, 0x00, 0x5e, 0x1d, 0xc0, 0x6b,
+	0x03, 0x78, 0x7d, 0xce, 0x72, 0xff, 0xd9, 0x65, 0xe5, 0x74, 0x3e, 0x5f, 0x3b, 0xbd, 0x84, 0xa9,
+	0x61, 0x19, 0x7a, 0x11, 0xcf, 0x70, 0x9b, 0xb6, 0xf9, 0xec, 0xc9, 0x78, 0x84, 0xd5, 0xa4, 0x00,
+	0xa9, 0x37, 0xc8, 0xe0, 0xae, 0xb6, 0xe9, 0xb7, 0x2e, 0x27, 0x00, 0x88, 0x9b, 0x76, 0x99, 0x0b,
+	0x90, 0x4a, 0xb0, 0x48, 0x41, 0xdc, 0x54, 0xc0, 0x05, 0xb9, 0x18, 0x32, 0x05, 0x29, 0x93, 0xca,
+	0x5a, 0xe9, 0xae, 0xf4, 0x36, 0xfc, 0xa7, 0xf3, 0xca, 0x79, 0xd2, 0x64, 0xfb, 0xb7, 0xde, 0x0d,
+	0x1e, 0x2e, 0x0b, 0xfa, 0x0d, 0x7f, 0xbe, 0xa0, 0xd1, 0xc0, 0xdc, 0x64, 0xb9, 0x02, 0x91, 0xd3,
+	0x94, 0x94, 0x85, 0x62, 0x19, 0x58, 0xab, 0x5d, 0x43, 0x07, 0x6d, 0x3a, 0xe1, 0x2d, 0x3a, 0xe1,
+	0x1d, 0xb7, 0x9d, 0xf0, 0xdd, 0x3a, 0xe8, 0xbc, 0x72, 0xb6, 0x9b, 0x02, 0x7e, 0xdb, 0xef, 0x7e,
+	0xba, 0x76, 0x8c, 0xe0, 0xce, 0x02, 0x7d, 0xad, 0x41, 0xf4, 0xce, 0xdc, 0xcd, 0x58, 0x4e, 0xc6,
+	0x34, 0x2d, 0x81, 0x0c, 0xb8, 0x20, 0xfa, 0xe6, 0x59, 0x58, 0xd6, 0x27, 0x5a, 0x6b, 0xad, 0xe5,
+	0x5f, 0xef, 0x76, 0xb5, 0xb6, 0x0c, 0xee, 0x67, 0x2c, 0x7f, 0x53, 0x9f, 0x70, 0xc2, 0xc5, 0xf1,
+	0xd2, 0x7e, 0xf4, 0xca, 0xdc, 0x4a, 0xe9, 0x87, 0x09, 0x11, 0x70, 0x41, 0x45, 0x4c, 0xa2, 0x94,
+	0xb2, 0x8c, 0xe5, 0x89, 0xb5, 0xde, 0x35, 0x7a, 0xb7, 0x7c, 0x67, 0x5e, 0x39, 0x0f, 0x9a, 0x5a,
+	0x6f, 0x52, 0xb9, 0x01, 0xaa, 0xe1, 0x40, 0xa3, 0xfd, 0x16, 0x44, 0x89, 0x89, 0x12, 0x5a, 0x26,
+	0x50, 0xf7, 0x9e, 0x14, 0x20, 0x48, 0xca, 0xa3, 0x91, 0xf5, 0xbf, 0x9e, 0xac, 0xa3, 0xba, 0x9a,
+	0x6f, 0x95, 0x73, 0xaf, 0xa9, 0x57, 0xc6, 0x23, 0x8f, 0x71, 0x9c, 0x51, 0x35, 0xf4, 0x4e, 0x73,
+	0x35, 0xaf, 0x9c, 0x9d, 0xc6, 0xed, 0xcf, 0x03, 0xdc, 0x60, 0x53, 0x83, 0x27, 0x00, 0x67, 0x20,
+	0x5e, 0xf2, 0x68, 0xe4, 0x9f, 0x5d, 0x4e, 0x6d, 0xe3, 0x6a, 0x6a, 0x1b, 0xdf, 0xa7, 0xb6, 0xf1,
+	0x71, 0x66, 0x77, 0xae, 0x66, 0x76, 0xe7, 0xeb, 0xcc, 0xee, 0xbc, 0x3d, 0x5c, 0x1a, 0xa4, 0xf6,
+	0x81, 0xed, 0xa5, 0x34, 0x94, 0x8b, 0x05, 0x1e, 0x1f, 0x1c, 0xe2, 0xf7, 0xcb, 0x8f, 0x52, 0x0f,
+	0x57, 0xb8, 0xae, 0x5b, 0xf6, 0xfc, 0xc7, 0x00, 0x81, 0xb1, 0x51, 0x64, 0xb7, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -190,6 +215,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GaugeFeePerLock.Size()
+		i -= size
+		if _, err := m.GaugeFeePerLock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.LazyRewardClaiming {
+		i--
+		if m.LazyRewardClaiming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size, err := m.MinValueForDistribution.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -278,6 +323,11 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MinValueForDistribution.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.LazyRewardClaiming {
+		n += 2
+	}
+	l = m.GaugeFeePerLock.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -480,6 +530,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LazyRewardClaiming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LazyRewardClaiming = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeFeePerLock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GaugeFeePerLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
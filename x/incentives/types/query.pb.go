@@ -20,6 +20,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -1680,21 +1681,22 @@ func (m *QueryGaugesByPoolIDResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-type ParamsRequest struct {
+type QueryLockRewardsRequest struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 }
 
-func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
-func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*ParamsRequest) ProtoMessage()    {}
-func (*ParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLockRewardsRequest) Reset()         { *m = QueryLockRewardsRequest{} }
+func (m *QueryLockRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockRewardsRequest) ProtoMessage()    {}
+func (*QueryLockRewardsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8124258a89427f98, []int{35}
 }
-func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLockRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLockRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLockRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1704,34 +1706,41 @@ func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamsRequest.Merge(m, src)
+func (m *QueryLockRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockRewardsRequest.Merge(m, src)
 }
-func (m *ParamsRequest) XXX_Size() int {
+func (m *QueryLockRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+func (m *QueryLockRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLockRewardsRequest proto.InternalMessageInfo
 
-type ParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryLockRewardsRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
 }
 
-func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
-func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*ParamsResponse) ProtoMessage()    {}
-func (*ParamsResponse) Descriptor() ([]byte, []int) {
+type QueryLockRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryLockRewardsResponse) Reset()         { *m = QueryLockRewardsResponse{} }
+func (m *QueryLockRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockRewardsResponse) ProtoMessage()    {}
+func (*QueryLockRewardsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8124258a89427f98, []int{36}
 }
-func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLockRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLockRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLockRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1741,1376 +1750,1490 @@ func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamsResponse.Merge(m, src)
+func (m *QueryLockRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockRewardsResponse.Merge(m, src)
 }
-func (m *ParamsResponse) XXX_Size() int {
+func (m *QueryLockRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+func (m *QueryLockRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLockRewardsResponse proto.InternalMessageInfo
 
-func (m *ParamsResponse) GetParams() Params {
+func (m *QueryLockRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Params
+		return m.Rewards
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
-	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
-	proto.RegisterType((*GaugeByIDRequest)(nil), "osmosis.incentives.GaugeByIDRequest")
-	proto.RegisterType((*GaugeByIDResponse)(nil), "osmosis.incentives.GaugeByIDResponse")
-	proto.RegisterType((*GaugesRequest)(nil), "osmosis.incentives.GaugesRequest")
-	proto.RegisterType((*GaugesResponse)(nil), "osmosis.incentives.GaugesResponse")
-	proto.RegisterType((*ActiveGaugesRequest)(nil), "osmosis.incentives.ActiveGaugesRequest")
-	proto.RegisterType((*ActiveGaugesResponse)(nil), "osmosis.incentives.ActiveGaugesResponse")
-	proto.RegisterType((*ActiveGaugesPerDenomRequest)(nil), "osmosis.incentives.ActiveGaugesPerDenomRequest")
-	proto.RegisterType((*ActiveGaugesPerDenomResponse)(nil), "osmosis.incentives.ActiveGaugesPerDenomResponse")
-	proto.RegisterType((*UpcomingGaugesRequest)(nil), "osmosis.incentives.UpcomingGaugesRequest")
-	proto.RegisterType((*UpcomingGaugesResponse)(nil), "osmosis.incentives.UpcomingGaugesResponse")
-	proto.RegisterType((*UpcomingGaugesPerDenomRequest)(nil), "osmosis.incentives.UpcomingGaugesPerDenomRequest")
-	proto.RegisterType((*UpcomingGaugesPerDenomResponse)(nil), "osmosis.incentives.UpcomingGaugesPerDenomResponse")
-	proto.RegisterType((*RewardsEstRequest)(nil), "osmosis.incentives.RewardsEstRequest")
-	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
-	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
-	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
-	proto.RegisterType((*QueryAllGroupsRequest)(nil), "osmosis.incentives.QueryAllGroupsRequest")
-	proto.RegisterType((*QueryAllGroupsResponse)(nil), "osmosis.incentives.QueryAllGroupsResponse")
-	proto.RegisterType((*QueryAllGroupsGaugesRequest)(nil), "osmosis.incentives.QueryAllGroupsGaugesRequest")
-	proto.RegisterType((*QueryAllGroupsGaugesResponse)(nil), "osmosis.incentives.QueryAllGroupsGaugesResponse")
-	proto.RegisterType((*QueryAllGroupsWithGaugeRequest)(nil), "osmosis.incentives.QueryAllGroupsWithGaugeRequest")
-	proto.RegisterType((*QueryAllGroupsWithGaugeResponse)(nil), "osmosis.incentives.QueryAllGroupsWithGaugeResponse")
-	proto.RegisterType((*QueryGroupByGroupGaugeIDRequest)(nil), "osmosis.incentives.QueryGroupByGroupGaugeIDRequest")
-	proto.RegisterType((*QueryGroupByGroupGaugeIDResponse)(nil), "osmosis.incentives.QueryGroupByGroupGaugeIDResponse")
-	proto.RegisterType((*QueryCurrentWeightByGroupGaugeIDRequest)(nil), "osmosis.incentives.QueryCurrentWeightByGroupGaugeIDRequest")
-	proto.RegisterType((*QueryCurrentWeightByGroupGaugeIDResponse)(nil), "osmosis.incentives.QueryCurrentWeightByGroupGaugeIDResponse")
-	proto.RegisterType((*GaugeWeight)(nil), "osmosis.incentives.GaugeWeight")
-	proto.RegisterType((*QueryInternalGaugesRequest)(nil), "osmosis.incentives.QueryInternalGaugesRequest")
-	proto.RegisterType((*QueryInternalGaugesResponse)(nil), "osmosis.incentives.QueryInternalGaugesResponse")
-	proto.RegisterType((*QueryExternalGaugesRequest)(nil), "osmosis.incentives.QueryExternalGaugesRequest")
-	proto.RegisterType((*QueryExternalGaugesResponse)(nil), "osmosis.incentives.QueryExternalGaugesResponse")
-	proto.RegisterType((*QueryGaugesByPoolIDRequest)(nil), "osmosis.incentives.QueryGaugesByPoolIDRequest")
-	proto.RegisterType((*QueryGaugesByPoolIDResponse)(nil), "osmosis.incentives.QueryGaugesByPoolIDResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.incentives.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.incentives.ParamsResponse")
+type QueryUpcomingEmissionsRequest struct {
+	// Pool ID being queried
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Number of upcoming epochs to project emissions for
+	NumEpochs uint64 `protobuf:"varint,2,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
 }
 
-func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
-
-var fileDescriptor_8124258a89427f98 = []byte{
-	// 1690 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x13, 0x47,
-	0x14, 0xc7, 0xb3, 0xf9, 0x05, 0x79, 0x09, 0x09, 0x19, 0x7e, 0x25, 0x0e, 0xd8, 0xe9, 0x16, 0x82,
-	0x81, 0x66, 0x97, 0x24, 0x24, 0xd0, 0xd0, 0x56, 0xc5, 0x84, 0x52, 0x2a, 0x10, 0xc1, 0x2a, 0x8a,
-	0x5a, 0x09, 0xad, 0xd6, 0xde, 0xe9, 0x66, 0x95, 0xf5, 0x8e, 0xf1, 0xae, 0x49, 0xac, 0x28, 0x97,
-	0xaa, 0x52, 0xa5, 0x1e, 0x50, 0x7f, 0xa0, 0xaa, 0x07, 0xa4, 0xde, 0x7a, 0x68, 0xd5, 0x4b, 0x2b,
-	0x55, 0x3d, 0xf5, 0xd0, 0x13, 0x47, 0xa4, 0x5e, 0xaa, 0x1e, 0x42, 0x05, 0xbd, 0x57, 0xe2, 0x2f,
-	0xa8, 0x76, 0x66, 0xd6, 0xf6, 0x6e, 0x66, 0xd7, 0x0e, 0x05, 0x94, 0x93, 0x33, 0x9e, 0x37, 0xef,
-	0x7d, 0xde, 0xf3, 0xcc, 0xce, 0xfb, 0x6e, 0x20, 0x4d, 0xdc, 0x12, 0x71, 0x2d, 0x57, 0xb5, 0x9c,
-	0x22, 0x76, 0x3c, 0xeb, 0x0e, 0x76, 0xd5, 0xdb, 0x55, 0x5c, 0xa9, 0x29, 0xe5, 0x0a, 0xf1, 0x08,
-	0x42, 0x7c, 0x5e, 0x69, 0xcc, 0xa7, 0xf6, 0x9b, 0xc4, 0x24, 0x74, 0x5a, 0xf5, 0xff, 0x62, 0x96,
-	0xa9, 0xc3, 0x26, 0x21, 0xa6, 0x8d, 0x55, 0xbd, 0x6c, 0xa9, 0xba, 0xe3, 0x10, 0x4f, 0xf7, 0x2c,
-	0xe2, 0xb8, 0x7c, 0x36, 0xcd, 0x67, 0xe9, 0xa8, 0x50, 0xfd, 0x48, 0x35, 0xaa, 0x15, 0x6a, 0x10,
-	0xcc, 0x17, 0x69, 0x20, 0xb5, 0xa0, 0xbb, 0x58, 0xbd, 0x33, 0x55, 0xc0, 0x9e, 0x3e, 0xa5, 0x16,
-	0x89, 0x15, 0xcc, 0x9f, 0x6c, 0x9e, 0xa7, 0x80, 0x75, 0xab, 0xb2, 0x6e, 0x5a, 0x4e, 0xc8, 0x97,
-	0x20, 0x27, 0x53, 0xaf, 0x9a, 0x98, 0xcf, 0x8f, 0x06, 0xf3, 0x36, 0x29, 0xae, 0x54, 0xcb, 0xf4,
-	0x23, 0x69, 0x69, 0x85, 0x54, 0xcb, 0x7c, 0x3e, 0x23, 0x98, 0x2f, 0xeb, 0x15, 0xbd, 0xc4, 0xf3,
-	0x94, 0xc7, 0x21, 0x7d, 0x8d, 0x18, 0x55, 0x1b, 0xbf, 0x4f, 0x16, 0x2c, 0xd7, 0xab, 0x58, 0x85,
-	0xaa, 0x87, 0x2f, 0x12, 0xcb, 0x71, 0xf3, 0xf8, 0x76, 0x15, 0xbb, 0x9e, 0xfc, 0x89, 0x04, 0x99,
-	0x58, 0x13, 0xb7, 0x4c, 0x1c, 0x17, 0x23, 0x1d, 0x7a, 0xfc, 0xdc, 0xdd, 0x11, 0x69, 0xbc, 0x2b,
-	0xdb, 0x3f, 0x3d, 0xaa, 0xb0, 0xec, 0x15, 0x3f, 0x7b, 0x85, 0xe7, 0xad, 0xf8, 0x4b, 0x72, 0xa7,
-	0x1f, 0x6c, 0x66, 0x3a, 0xbe, 0x7f, 0x94, 0xc9, 0x9a, 0x96, 0xb7, 0x5c, 0x2d, 0x28, 0x45, 0x52,
-	0x52, 0x79, 0xa9, 0xd8, 0xc7, 0xa4, 0x6b, 0xac, 0xa8, 0x5e, 0xad, 0x8c, 0x5d, 0x85, 0xc5, 0x60,
-	0x9e, 0x65, 0x19, 0xf6, 0x5e, 0xf6, 0x6b, 0x92, 0xab, 0x5d, 0x59, 0xe0, 0x68, 0x68, 0x10, 0x3a,
-	0x2d, 0x63, 0x44, 0x1a, 0x97, 0xb2, 0xdd, 0xf9, 0x4e, 0xcb, 0x90, 0x17, 0x60, 0xb8, 0xc9, 0x86,
-	0xb3, 0xa9, 0xd0, 0x43, 0x8b, 0x49, 0xed, 0x7c, 0xb6, 0xad, 0x3b, 0x44, 0xa1, 0xab, 0xf2, 0xcc,
-	0x4e, 0x5e, 0x82, 0x3d, 0x74, 0x1c, 0x54, 0x00, 0xbd, 0x03, 0xd0, 0xf8, 0xcd, 0xb8, 0x9b, 0x89,
-	0x50, 0x8a, 0x6c, 0x07, 0x06, 0x89, 0x2e, 0xea, 0x26, 0xe6, 0x6b, 0xf3, 0x4d, 0x2b, 0xe5, 0xbb,
-	0x12, 0x0c, 0x06, 0x9e, 0x39, 0xdc, 0x0c, 0x74, 0x1b, 0xba, 0xa7, 0xd7, 0xeb, 0x16, 0xc7, 0x96,
-	0xeb, 0xf6, 0xeb, 0x96, 0xa7, 0xc6, 0xe8, 0x72, 0x88, 0xa7, 0x93, 0xf2, 0x1c, 0x6f, 0xc9, 0xc3,
-	0x22, 0x86, 0x80, 0x6e, 0xc1, 0xbe, 0x0b, 0x45, 0x3f, 0xca, 0x8b, 0xc9, 0xf7, 0x9e, 0x04, 0xfb,
-	0xc3, 0xfe, 0x77, 0x44, 0xd6, 0xeb, 0x30, 0xd6, 0x4c, 0xb5, 0x88, 0x2b, 0x0b, 0xd8, 0x21, 0xa5,
-	0x20, 0xfb, 0xfd, 0xd0, 0x63, 0xf8, 0x63, 0x9a, 0x78, 0x5f, 0x9e, 0x0d, 0x22, 0x35, 0xe9, 0x7c,
-	0xe6, 0x9a, 0xdc, 0x97, 0xe0, 0xb0, 0x38, 0xfa, 0x8e, 0xa8, 0x8d, 0x06, 0x07, 0x6e, 0x96, 0x8b,
-	0xa4, 0x64, 0x39, 0xe6, 0x8b, 0xd9, 0x13, 0x5f, 0x4b, 0x70, 0x30, 0x1a, 0x61, 0x47, 0x64, 0xbe,
-	0x01, 0x47, 0xc2, 0x5c, 0x2f, 0x77, 0x5f, 0xfc, 0x2c, 0x41, 0x3a, 0x2e, 0x3e, 0xaf, 0xcf, 0xbb,
-	0x30, 0x54, 0xe5, 0x16, 0x1a, 0x7d, 0x52, 0xb9, 0xed, 0x96, 0x6a, 0xb0, 0x1a, 0xf2, 0xfc, 0xfc,
-	0x8a, 0xe6, 0xc2, 0x70, 0x1e, 0xaf, 0xea, 0x15, 0xc3, 0xbd, 0xe4, 0x7a, 0x41, 0xa1, 0x26, 0xa0,
-	0x87, 0xac, 0x3a, 0xb8, 0xc2, 0x0a, 0x95, 0xdb, 0xfb, 0x74, 0x33, 0x33, 0x50, 0xd3, 0x4b, 0xf6,
-	0xbc, 0x4c, 0xbf, 0x96, 0xf3, 0x6c, 0x1a, 0x8d, 0xc2, 0x6e, 0xff, 0x26, 0xd3, 0x2c, 0xc3, 0x1d,
-	0xe9, 0x1c, 0xef, 0xca, 0x76, 0xe7, 0x77, 0xf9, 0xe3, 0x2b, 0x86, 0x8b, 0xc6, 0xa0, 0x0f, 0x3b,
-	0x86, 0x86, 0xcb, 0xa4, 0xb8, 0x3c, 0xd2, 0x35, 0x2e, 0x65, 0xbb, 0xf2, 0xbb, 0xb1, 0x63, 0x5c,
-	0xf2, 0xc7, 0xf2, 0x2a, 0xa0, 0xe6, 0xa0, 0x2f, 0xef, 0x0a, 0xca, 0xc0, 0x91, 0x1b, 0x7e, 0x5d,
-	0xae, 0x92, 0xe2, 0x8a, 0x5e, 0xb0, 0xf1, 0x02, 0x6f, 0x09, 0xea, 0x57, 0xe5, 0x17, 0x12, 0xa4,
-	0xe3, 0x2c, 0x38, 0x26, 0x01, 0x64, 0xf3, 0x49, 0x2d, 0x68, 0x29, 0x1a, 0xcc, 0xac, 0xe9, 0x50,
-	0x82, 0xa6, 0x43, 0x09, 0xd6, 0xe7, 0x8e, 0xf9, 0xcc, 0x4f, 0x37, 0x33, 0xa3, 0xac, 0x90, 0x5b,
-	0x5d, 0xc8, 0xdf, 0x3c, 0xca, 0x48, 0xf9, 0x61, 0x3b, 0x1a, 0x58, 0x3e, 0x04, 0x07, 0x28, 0xd2,
-	0x05, 0xdb, 0xbe, 0xec, 0x37, 0x06, 0x75, 0xd8, 0x1b, 0x70, 0x30, 0x3a, 0xc1, 0x19, 0xcf, 0x42,
-	0x2f, 0xed, 0x21, 0x92, 0xf7, 0x97, 0x6f, 0xc1, 0xf7, 0x17, 0x37, 0x97, 0x8f, 0xc0, 0x58, 0xd8,
-	0x65, 0xe8, 0x19, 0x22, 0x2f, 0xc1, 0x61, 0xf1, 0x74, 0x53, 0xdc, 0x6d, 0xed, 0x6b, 0x6e, 0xee,
-	0x37, 0x31, 0x61, 0xc7, 0x4b, 0x96, 0xb7, 0xcc, 0xee, 0x74, 0x1e, 0x7a, 0x0d, 0x32, 0xb1, 0x16,
-	0x3c, 0xfa, 0x4d, 0x18, 0x66, 0x69, 0x68, 0xab, 0x96, 0xb7, 0xac, 0x05, 0x3d, 0x83, 0x0f, 0xf2,
-	0x6a, 0x6c, 0x01, 0x1a, 0x7e, 0x38, 0xd2, 0x90, 0x19, 0xfe, 0x5a, 0x9e, 0xe2, 0x91, 0x59, 0xbd,
-	0xd8, 0x07, 0x9d, 0x89, 0x6f, 0x63, 0x3e, 0x80, 0xf1, 0xf8, 0x25, 0x9c, 0x76, 0x16, 0x7a, 0x68,
-	0xa4, 0xc4, 0xae, 0xa6, 0xe9, 0x27, 0x62, 0xd6, 0xf2, 0x75, 0x38, 0x4e, 0x5d, 0x5f, 0xac, 0x56,
-	0x2a, 0xd8, 0xf1, 0x96, 0xb0, 0x65, 0x2e, 0x7b, 0x62, 0xaa, 0xa3, 0x30, 0x48, 0xd7, 0xb0, 0x4a,
-	0x68, 0x75, 0xc2, 0x01, 0xb3, 0x61, 0x6c, 0xc8, 0x1e, 0x64, 0x5b, 0x3b, 0xac, 0x3f, 0xc0, 0x06,
-	0x98, 0xaf, 0x55, 0x6a, 0xc5, 0x8b, 0x9b, 0x89, 0xfd, 0x95, 0xb9, 0x33, 0x96, 0x40, 0xbf, 0xd9,
-	0xf8, 0x4a, 0xfe, 0x54, 0x82, 0xfe, 0x26, 0x13, 0xff, 0x51, 0x12, 0xa1, 0xdc, 0x65, 0x32, 0x40,
-	0x74, 0x0b, 0x06, 0x58, 0x38, 0x8d, 0x9e, 0x08, 0xfa, 0xb4, 0xeb, 0xcb, 0xcd, 0xfb, 0x3e, 0xff,
-	0xda, 0xcc, 0x8c, 0xb1, 0x13, 0xef, 0x1a, 0x2b, 0x8a, 0x45, 0xd4, 0x92, 0xee, 0x2d, 0x2b, 0x57,
-	0xb1, 0xa9, 0x17, 0x6b, 0x0b, 0xb8, 0xf8, 0x74, 0x33, 0xb3, 0x8f, 0x1d, 0xb7, 0x66, 0x07, 0x72,
-	0xbe, 0x9f, 0x0d, 0xf3, 0x74, 0x64, 0x40, 0x8a, 0xe6, 0x7f, 0xc5, 0xf1, 0x70, 0xc5, 0xd1, 0xed,
-	0x17, 0x73, 0x6b, 0x7e, 0x2b, 0xf1, 0x93, 0x15, 0x0d, 0xf3, 0x3f, 0x4f, 0xce, 0xf3, 0xbb, 0x09,
-	0x82, 0x3a, 0x5c, 0x5a, 0x7b, 0x29, 0x75, 0x88, 0x86, 0xd9, 0x31, 0x75, 0xf0, 0x78, 0x1d, 0x18,
-	0x58, 0xae, 0xb6, 0x48, 0x88, 0x1d, 0x7b, 0xd2, 0x9f, 0x5b, 0xf7, 0x50, 0xaf, 0x4b, 0x34, 0xec,
-	0x8e, 0xa9, 0xcb, 0x10, 0xec, 0x59, 0xa4, 0xba, 0x33, 0x78, 0x22, 0xbf, 0x07, 0x83, 0xc1, 0x17,
-	0x1c, 0xf2, 0x1c, 0xf4, 0x32, 0x69, 0xca, 0x37, 0x48, 0x4a, 0x04, 0xc9, 0xd6, 0x04, 0x94, 0xcc,
-	0x7e, 0xfa, 0xb3, 0x11, 0xe8, 0xa1, 0xe9, 0xa3, 0xdf, 0x25, 0x38, 0x14, 0x23, 0x56, 0xd1, 0xb4,
-	0xc8, 0x5f, 0xb2, 0xf8, 0x4d, 0xcd, 0x6c, 0x6b, 0x0d, 0x4b, 0x44, 0x7e, 0xeb, 0xe3, 0x3f, 0xfe,
-	0xf9, 0xaa, 0xf3, 0x1c, 0x9a, 0x53, 0x05, 0xea, 0x3b, 0x78, 0x0b, 0x50, 0xa2, 0x4e, 0x34, 0x8f,
-	0x68, 0x46, 0xdd, 0x8d, 0x46, 0xfb, 0x0c, 0x74, 0x57, 0x82, 0xbe, 0xba, 0x8e, 0x45, 0x47, 0xe3,
-	0x7f, 0xab, 0x86, 0x14, 0x4e, 0x1d, 0x6b, 0x61, 0xc5, 0xd1, 0xce, 0x50, 0x34, 0x05, 0xbd, 0x96,
-	0x84, 0xc6, 0x1e, 0xa5, 0x85, 0x9a, 0x66, 0x19, 0xea, 0xba, 0x65, 0x6c, 0xa0, 0x75, 0xe8, 0xe5,
-	0x9d, 0xe3, 0x2b, 0xb1, 0x61, 0xea, 0x25, 0x93, 0x93, 0x4c, 0x38, 0xc6, 0x49, 0x8a, 0x71, 0x14,
-	0xc9, 0x2d, 0x31, 0x5c, 0x74, 0x4f, 0x82, 0x81, 0x66, 0xc5, 0x84, 0x8e, 0x8b, 0x02, 0x08, 0x74,
-	0x6c, 0x2a, 0xdb, 0xda, 0x90, 0xf3, 0x4c, 0x51, 0x9e, 0x53, 0xe8, 0x44, 0x12, 0x8f, 0x4e, 0x57,
-	0xf2, 0xd6, 0x1b, 0xfd, 0x12, 0x11, 0xb7, 0x41, 0xbb, 0x8e, 0xd4, 0x56, 0x51, 0x23, 0xc2, 0x22,
-	0x75, 0xba, 0xfd, 0x05, 0x1c, 0xf7, 0x3c, 0xc5, 0x9d, 0x45, 0x33, 0x6d, 0xe3, 0x6a, 0x65, 0x5c,
-	0xd1, 0x98, 0x62, 0xb9, 0x2f, 0xc1, 0x60, 0x58, 0x69, 0xa0, 0x13, 0x22, 0x02, 0xa1, 0x0e, 0x4c,
-	0x9d, 0x6c, 0xc7, 0x94, 0x63, 0xce, 0x50, 0xcc, 0x49, 0x74, 0x2a, 0x09, 0x33, 0x22, 0x69, 0xd0,
-	0x6f, 0x5b, 0x04, 0x62, 0xbd, 0xb2, 0x53, 0xad, 0x63, 0x47, 0x6b, 0x3b, 0xbd, 0x9d, 0x25, 0x1c,
-	0xfb, 0x4d, 0x8a, 0x7d, 0x16, 0xcd, 0x6e, 0x03, 0xbb, 0xa9, 0xbe, 0xf7, 0x24, 0x80, 0x86, 0x3e,
-	0x41, 0xc2, 0x83, 0xb9, 0x45, 0x34, 0xa5, 0x26, 0x5a, 0x99, 0x71, 0xb8, 0xb3, 0x14, 0x6e, 0x0a,
-	0xa9, 0x49, 0x70, 0x15, 0xb6, 0x4e, 0xc3, 0xae, 0xa7, 0xae, 0x53, 0xb1, 0xb5, 0x81, 0x7e, 0x92,
-	0x60, 0x78, 0x8b, 0x2c, 0x11, 0x97, 0x34, 0x51, 0xe4, 0x88, 0x4b, 0x9a, 0xac, 0x7a, 0xe4, 0x39,
-	0x4a, 0x7d, 0x1a, 0x29, 0x49, 0xd4, 0x5b, 0x45, 0x0d, 0xfa, 0x52, 0x82, 0xbe, 0x7a, 0xcb, 0x2e,
-	0xde, 0xa6, 0x42, 0x71, 0x23, 0xde, 0xa6, 0x62, 0xb9, 0x23, 0x2b, 0x14, 0x2e, 0x8b, 0x26, 0x12,
-	0x4f, 0x93, 0x6d, 0x6b, 0xac, 0xb5, 0x47, 0x3f, 0x48, 0x30, 0x14, 0x91, 0x30, 0xe2, 0x43, 0x9f,
-	0xa0, 0x85, 0xc4, 0x87, 0x3e, 0x49, 0x1d, 0xc9, 0xb3, 0x14, 0x53, 0x45, 0x93, 0xed, 0x61, 0x06,
-	0xe7, 0xe9, 0x57, 0x09, 0xd0, 0x56, 0xd5, 0x83, 0xa6, 0x5b, 0xc7, 0x8f, 0x8a, 0x28, 0xf1, 0x65,
-	0xd8, 0x42, 0x56, 0xc9, 0xaf, 0x53, 0xec, 0x19, 0x34, 0xd5, 0x26, 0x76, 0x43, 0x7c, 0xf9, 0x97,
-	0xf9, 0x3e, 0x81, 0x06, 0x42, 0xf1, 0x1c, 0xf1, 0x22, 0x2b, 0x75, 0x66, 0x7b, 0x8b, 0x38, 0xfd,
-	0xdb, 0x94, 0x7e, 0x1e, 0x9d, 0x4b, 0xbc, 0xa8, 0xa8, 0x4c, 0x2a, 0xd4, 0xb4, 0xb0, 0x5e, 0x62,
-	0x77, 0xe7, 0xbf, 0x12, 0x8c, 0x25, 0x88, 0x23, 0x74, 0x3e, 0x96, 0xab, 0xb5, 0x46, 0x4b, 0xbd,
-	0xf1, 0x6c, 0x8b, 0x79, 0x72, 0x37, 0x69, 0x72, 0xd7, 0xd1, 0xb5, 0xa4, 0xe4, 0x8a, 0xcc, 0x11,
-	0xd7, 0x6c, 0xa2, 0x2c, 0xc3, 0xe3, 0x0d, 0xf4, 0x9d, 0x04, 0x83, 0x61, 0x9d, 0x82, 0x94, 0x58,
-	0x4e, 0xa1, 0x6e, 0x4a, 0xa9, 0x6d, 0xdb, 0x6f, 0xe7, 0xaa, 0xb1, 0xf8, 0xda, 0xe0, 0x68, 0xf8,
-	0xa0, 0x61, 0x21, 0x91, 0x00, 0x2a, 0x14, 0x36, 0x09, 0xa0, 0x62, 0x85, 0xd2, 0x1e, 0x28, 0x5e,
-	0x0b, 0x83, 0xfe, 0x58, 0xff, 0xc7, 0x41, 0xd0, 0xd9, 0x27, 0x80, 0x0a, 0x95, 0x47, 0x02, 0xa8,
-	0x58, 0x32, 0xc8, 0xf3, 0x14, 0xf4, 0x0c, 0x9a, 0x6e, 0xdd, 0xa2, 0xf9, 0x9b, 0xa2, 0x4c, 0x88,
-	0xdd, 0xdc, 0x2f, 0xb2, 0x3e, 0x5d, 0xdc, 0x2f, 0x86, 0x84, 0x80, 0xb8, 0x5f, 0x0c, 0x4b, 0x83,
-	0xf6, 0xfa, 0x45, 0x26, 0x06, 0x72, 0x8b, 0x0f, 0x1e, 0xa7, 0xa5, 0x87, 0x8f, 0xd3, 0xd2, 0xdf,
-	0x8f, 0xd3, 0xd2, 0xe7, 0x4f, 0xd2, 0x1d, 0x0f, 0x9f, 0xa4, 0x3b, 0xfe, 0x7c, 0x92, 0xee, 0xf8,
-	0x70, 0xae, 0xe9, 0x85, 0x1f, 0xf7, 0x33, 0x69, 0xeb, 0x05, 0xb7, 0xee, 0xf4, 0xce, 0xf4, 0x9c,
-	0xba, 0xd6, 0xec, 0x9a, 0xbe, 0x04, 0x2c, 0xf4, 0xd2, 0xf7, 0x71, 0x33, 0xff, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0x00, 0xbe, 0x17, 0xc5, 0x7c, 0x1c, 0x00, 0x00,
+func (m *QueryUpcomingEmissionsRequest) Reset()         { *m = QueryUpcomingEmissionsRequest{} }
+func (m *QueryUpcomingEmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingEmissionsRequest) ProtoMessage()    {}
+func (*QueryUpcomingEmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{37}
+}
+func (m *QueryUpcomingEmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingEmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingEmissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingEmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingEmissionsRequest.Merge(m, src)
+}
+func (m *QueryUpcomingEmissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingEmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingEmissionsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryUpcomingEmissionsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ModuleToDistributeCoins returns coins that are going to be distributed
-	ModuleToDistributeCoins(ctx context.Context, in *ModuleToDistributeCoinsRequest, opts ...grpc.CallOption) (*ModuleToDistributeCoinsResponse, error)
-	// GaugeByID returns gauges by their respective ID
-	GaugeByID(ctx context.Context, in *GaugeByIDRequest, opts ...grpc.CallOption) (*GaugeByIDResponse, error)
-	// Gauges returns both upcoming and active gauges
-	Gauges(ctx context.Context, in *GaugesRequest, opts ...grpc.CallOption) (*GaugesResponse, error)
-	// ActiveGauges returns active gauges
-	ActiveGauges(ctx context.Context, in *ActiveGaugesRequest, opts ...grpc.CallOption) (*ActiveGaugesResponse, error)
-	// ActiveGaugesPerDenom returns active gauges by denom
-	ActiveGaugesPerDenom(ctx context.Context, in *ActiveGaugesPerDenomRequest, opts ...grpc.CallOption) (*ActiveGaugesPerDenomResponse, error)
-	// Returns scheduled gauges that have not yet occurred
-	UpcomingGauges(ctx context.Context, in *UpcomingGaugesRequest, opts ...grpc.CallOption) (*UpcomingGaugesResponse, error)
-	// UpcomingGaugesPerDenom returns scheduled gauges that have not yet occurred
-	// by denom
-	UpcomingGaugesPerDenom(ctx context.Context, in *UpcomingGaugesPerDenomRequest, opts ...grpc.CallOption) (*UpcomingGaugesPerDenomResponse, error)
-	// RewardsEst returns an estimate of the rewards from now until a specified
-	// time in the future The querier either provides an address or a set of locks
-	// for which they want to find the associated rewards
-	RewardsEst(ctx context.Context, in *RewardsEstRequest, opts ...grpc.CallOption) (*RewardsEstResponse, error)
-	// LockableDurations returns lockable durations that are valid to distribute
-	// incentives for
-	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
-	// AllGroups returns all groups
-	AllGroups(ctx context.Context, in *QueryAllGroupsRequest, opts ...grpc.CallOption) (*QueryAllGroupsResponse, error)
-	// AllGroupsGauges returns all group gauges
-	AllGroupsGauges(ctx context.Context, in *QueryAllGroupsGaugesRequest, opts ...grpc.CallOption) (*QueryAllGroupsGaugesResponse, error)
-	// AllGroupsWithGauge returns all groups with their group gauge
-	AllGroupsWithGauge(ctx context.Context, in *QueryAllGroupsWithGaugeRequest, opts ...grpc.CallOption) (*QueryAllGroupsWithGaugeResponse, error)
-	// GroupByGroupGaugeID returns a group given its group gauge ID
-	GroupByGroupGaugeID(ctx context.Context, in *QueryGroupByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryGroupByGroupGaugeIDResponse, error)
-	// CurrentWeightByGroupGaugeID returns the current weight since the
-	// the last epoch given a group gauge ID
-	CurrentWeightByGroupGaugeID(ctx context.Context, in *QueryCurrentWeightByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryCurrentWeightByGroupGaugeIDResponse, error)
-	InternalGauges(ctx context.Context, in *QueryInternalGaugesRequest, opts ...grpc.CallOption) (*QueryInternalGaugesResponse, error)
-	ExternalGauges(ctx context.Context, in *QueryExternalGaugesRequest, opts ...grpc.CallOption) (*QueryExternalGaugesResponse, error)
-	GaugesByPoolID(ctx context.Context, in *QueryGaugesByPoolIDRequest, opts ...grpc.CallOption) (*QueryGaugesByPoolIDResponse, error)
-	// Params returns incentives module params.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+func (m *QueryUpcomingEmissionsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryUpcomingEmissionsRequest) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryUpcomingEmissionsResponse struct {
+	// Projected emissions for each of the upcoming epochs, in order
+	Emissions []EpochEmission `protobuf:"bytes,1,rep,name=emissions,proto3" json:"emissions"`
 }
 
-func (c *queryClient) ModuleToDistributeCoins(ctx context.Context, in *ModuleToDistributeCoinsRequest, opts ...grpc.CallOption) (*ModuleToDistributeCoinsResponse, error) {
-	out := new(ModuleToDistributeCoinsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ModuleToDistributeCoins", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryUpcomingEmissionsResponse) Reset()         { *m = QueryUpcomingEmissionsResponse{} }
+func (m *QueryUpcomingEmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingEmissionsResponse) ProtoMessage()    {}
+func (*QueryUpcomingEmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{38}
 }
-
-func (c *queryClient) GaugeByID(ctx context.Context, in *GaugeByIDRequest, opts ...grpc.CallOption) (*GaugeByIDResponse, error) {
-	out := new(GaugeByIDResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GaugeByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUpcomingEmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingEmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingEmissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryUpcomingEmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingEmissionsResponse.Merge(m, src)
+}
+func (m *QueryUpcomingEmissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingEmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingEmissionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Gauges(ctx context.Context, in *GaugesRequest, opts ...grpc.CallOption) (*GaugesResponse, error) {
-	out := new(GaugesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/Gauges", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUpcomingEmissionsResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingEmissionsResponse) GetEmissions() []EpochEmission {
+	if m != nil {
+		return m.Emissions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ActiveGauges(ctx context.Context, in *ActiveGaugesRequest, opts ...grpc.CallOption) (*ActiveGaugesResponse, error) {
-	out := new(ActiveGaugesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ActiveGauges", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGaugesByRewardDenomRequest struct {
+	// Reward denom being queried
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Whether to only return the gauges that are currently active
+	ActiveOnly bool `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// Pagination defines pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ActiveGaugesPerDenom(ctx context.Context, in *ActiveGaugesPerDenomRequest, opts ...grpc.CallOption) (*ActiveGaugesPerDenomResponse, error) {
-	out := new(ActiveGaugesPerDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ActiveGaugesPerDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGaugesByRewardDenomRequest) Reset()         { *m = QueryGaugesByRewardDenomRequest{} }
+func (m *QueryGaugesByRewardDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesByRewardDenomRequest) ProtoMessage()    {}
+func (*QueryGaugesByRewardDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{39}
+}
+func (m *QueryGaugesByRewardDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugesByRewardDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesByRewardDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGaugesByRewardDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesByRewardDenomRequest.Merge(m, src)
+}
+func (m *QueryGaugesByRewardDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugesByRewardDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesByRewardDenomRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UpcomingGauges(ctx context.Context, in *UpcomingGaugesRequest, opts ...grpc.CallOption) (*UpcomingGaugesResponse, error) {
-	out := new(UpcomingGaugesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/UpcomingGauges", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGaugesByRewardDenomRequest proto.InternalMessageInfo
+
+func (m *QueryGaugesByRewardDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) UpcomingGaugesPerDenom(ctx context.Context, in *UpcomingGaugesPerDenomRequest, opts ...grpc.CallOption) (*UpcomingGaugesPerDenomResponse, error) {
-	out := new(UpcomingGaugesPerDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/UpcomingGaugesPerDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGaugesByRewardDenomRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) RewardsEst(ctx context.Context, in *RewardsEstRequest, opts ...grpc.CallOption) (*RewardsEstResponse, error) {
-	out := new(RewardsEstResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/RewardsEst", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGaugesByRewardDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error) {
-	out := new(QueryLockableDurationsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/LockableDurations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGaugesByRewardDenomResponse struct {
+	Gauges []GaugeRewardDenomInfo `protobuf:"bytes,1,rep,name=gauges,proto3" json:"gauges"`
+	// Pagination defines pagination for the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) AllGroups(ctx context.Context, in *QueryAllGroupsRequest, opts ...grpc.CallOption) (*QueryAllGroupsResponse, error) {
-	out := new(QueryAllGroupsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/AllGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGaugesByRewardDenomResponse) Reset()         { *m = QueryGaugesByRewardDenomResponse{} }
+func (m *QueryGaugesByRewardDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesByRewardDenomResponse) ProtoMessage()    {}
+func (*QueryGaugesByRewardDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{40}
 }
-
-func (c *queryClient) AllGroupsGauges(ctx context.Context, in *QueryAllGroupsGaugesRequest, opts ...grpc.CallOption) (*QueryAllGroupsGaugesResponse, error) {
-	out := new(QueryAllGroupsGaugesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/AllGroupsGauges", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGaugesByRewardDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) AllGroupsWithGauge(ctx context.Context, in *QueryAllGroupsWithGaugeRequest, opts ...grpc.CallOption) (*QueryAllGroupsWithGaugeResponse, error) {
-	out := new(QueryAllGroupsWithGaugeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/AllGroupsWithGauge", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGaugesByRewardDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesByRewardDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGaugesByRewardDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesByRewardDenomResponse.Merge(m, src)
+}
+func (m *QueryGaugesByRewardDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugesByRewardDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesByRewardDenomResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GroupByGroupGaugeID(ctx context.Context, in *QueryGroupByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryGroupByGroupGaugeIDResponse, error) {
-	out := new(QueryGroupByGroupGaugeIDResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GroupByGroupGaugeID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGaugesByRewardDenomResponse proto.InternalMessageInfo
+
+func (m *QueryGaugesByRewardDenomResponse) GetGauges() []GaugeRewardDenomInfo {
+	if m != nil {
+		return m.Gauges
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CurrentWeightByGroupGaugeID(ctx context.Context, in *QueryCurrentWeightByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryCurrentWeightByGroupGaugeIDResponse, error) {
-	out := new(QueryCurrentWeightByGroupGaugeIDResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/CurrentWeightByGroupGaugeID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGaugesByRewardDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) InternalGauges(ctx context.Context, in *QueryInternalGaugesRequest, opts ...grpc.CallOption) (*QueryInternalGaugesResponse, error) {
-	out := new(QueryInternalGaugesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/InternalGauges", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// GaugeRewardDenomInfo is a gauge rewarding a denom along with the state of
+// its rewards of that denom
+type GaugeRewardDenomInfo struct {
+	Gauge Gauge `protobuf:"bytes,1,opt,name=gauge,proto3" json:"gauge"`
+	// Remaining balance of the denom that the gauge has yet to distribute
+	Remaining types.Coin `protobuf:"bytes,2,opt,name=remaining,proto3" json:"remaining"`
+	// Number of the epoch at whose end the gauge is projected to make its last
+	// distribution, or 0 if the gauge is perpetual or finished
+	EndEpoch int64 `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty" yaml:"end_epoch"`
 }
 
-func (c *queryClient) ExternalGauges(ctx context.Context, in *QueryExternalGaugesRequest, opts ...grpc.CallOption) (*QueryExternalGaugesResponse, error) {
-	out := new(QueryExternalGaugesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ExternalGauges", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GaugeRewardDenomInfo) Reset()         { *m = GaugeRewardDenomInfo{} }
+func (m *GaugeRewardDenomInfo) String() string { return proto.CompactTextString(m) }
+func (*GaugeRewardDenomInfo) ProtoMessage()    {}
+func (*GaugeRewardDenomInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{41}
+}
+func (m *GaugeRewardDenomInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeRewardDenomInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeRewardDenomInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GaugeRewardDenomInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeRewardDenomInfo.Merge(m, src)
+}
+func (m *GaugeRewardDenomInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeRewardDenomInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeRewardDenomInfo.DiscardUnknown(m)
 }
 
-func (c *queryClient) GaugesByPoolID(ctx context.Context, in *QueryGaugesByPoolIDRequest, opts ...grpc.CallOption) (*QueryGaugesByPoolIDResponse, error) {
-	out := new(QueryGaugesByPoolIDResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GaugesByPoolID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GaugeRewardDenomInfo proto.InternalMessageInfo
+
+func (m *GaugeRewardDenomInfo) GetGauge() Gauge {
+	if m != nil {
+		return m.Gauge
 	}
-	return out, nil
+	return Gauge{}
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GaugeRewardDenomInfo) GetRemaining() types.Coin {
+	if m != nil {
+		return m.Remaining
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ModuleToDistributeCoins returns coins that are going to be distributed
-	ModuleToDistributeCoins(context.Context, *ModuleToDistributeCoinsRequest) (*ModuleToDistributeCoinsResponse, error)
-	// GaugeByID returns gauges by their respective ID
-	GaugeByID(context.Context, *GaugeByIDRequest) (*GaugeByIDResponse, error)
-	// Gauges returns both upcoming and active gauges
-	Gauges(context.Context, *GaugesRequest) (*GaugesResponse, error)
-	// ActiveGauges returns active gauges
-	ActiveGauges(context.Context, *ActiveGaugesRequest) (*ActiveGaugesResponse, error)
-	// ActiveGaugesPerDenom returns active gauges by denom
-	ActiveGaugesPerDenom(context.Context, *ActiveGaugesPerDenomRequest) (*ActiveGaugesPerDenomResponse, error)
-	// Returns scheduled gauges that have not yet occurred
-	UpcomingGauges(context.Context, *UpcomingGaugesRequest) (*UpcomingGaugesResponse, error)
-	// UpcomingGaugesPerDenom returns scheduled gauges that have not yet occurred
-	// by denom
-	UpcomingGaugesPerDenom(context.Context, *UpcomingGaugesPerDenomRequest) (*UpcomingGaugesPerDenomResponse, error)
-	// RewardsEst returns an estimate of the rewards from now until a specified
-	// time in the future The querier either provides an address or a set of locks
-	// for which they want to find the associated rewards
-	RewardsEst(context.Context, *RewardsEstRequest) (*RewardsEstResponse, error)
-	// LockableDurations returns lockable durations that are valid to distribute
-	// incentives for
-	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
-	// AllGroups returns all groups
-	AllGroups(context.Context, *QueryAllGroupsRequest) (*QueryAllGroupsResponse, error)
-	// AllGroupsGauges returns all group gauges
-	AllGroupsGauges(context.Context, *QueryAllGroupsGaugesRequest) (*QueryAllGroupsGaugesResponse, error)
-	// AllGroupsWithGauge returns all groups with their group gauge
-	AllGroupsWithGauge(context.Context, *QueryAllGroupsWithGaugeRequest) (*QueryAllGroupsWithGaugeResponse, error)
-	// GroupByGroupGaugeID returns a group given its group gauge ID
-	GroupByGroupGaugeID(context.Context, *QueryGroupByGroupGaugeIDRequest) (*QueryGroupByGroupGaugeIDResponse, error)
-	// CurrentWeightByGroupGaugeID returns the current weight since the
-	// the last epoch given a group gauge ID
-	CurrentWeightByGroupGaugeID(context.Context, *QueryCurrentWeightByGroupGaugeIDRequest) (*QueryCurrentWeightByGroupGaugeIDResponse, error)
-	InternalGauges(context.Context, *QueryInternalGaugesRequest) (*QueryInternalGaugesResponse, error)
-	ExternalGauges(context.Context, *QueryExternalGaugesRequest) (*QueryExternalGaugesResponse, error)
-	GaugesByPoolID(context.Context, *QueryGaugesByPoolIDRequest) (*QueryGaugesByPoolIDResponse, error)
-	// Params returns incentives module params.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+func (m *GaugeRewardDenomInfo) GetEndEpoch() int64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// EpochEmission is the projected emission of a pool's gauges at the end of an
+// epoch
+type EpochEmission struct {
+	// Number of the epoch at whose end the emission is distributed
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// Projected time of the distribution
+	DistributionTime time.Time `protobuf:"bytes,2,opt,name=distribution_time,json=distributionTime,proto3,stdtime" json:"distribution_time" yaml:"distribution_time"`
+	// Coins distributed by the pool's gauges
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 }
 
-func (*UnimplementedQueryServer) ModuleToDistributeCoins(ctx context.Context, req *ModuleToDistributeCoinsRequest) (*ModuleToDistributeCoinsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleToDistributeCoins not implemented")
-}
-func (*UnimplementedQueryServer) GaugeByID(ctx context.Context, req *GaugeByIDRequest) (*GaugeByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GaugeByID not implemented")
-}
-func (*UnimplementedQueryServer) Gauges(ctx context.Context, req *GaugesRequest) (*GaugesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
+func (m *EpochEmission) Reset()         { *m = EpochEmission{} }
+func (m *EpochEmission) String() string { return proto.CompactTextString(m) }
+func (*EpochEmission) ProtoMessage()    {}
+func (*EpochEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{42}
 }
-func (*UnimplementedQueryServer) ActiveGauges(ctx context.Context, req *ActiveGaugesRequest) (*ActiveGaugesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveGauges not implemented")
+func (m *EpochEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ActiveGaugesPerDenom(ctx context.Context, req *ActiveGaugesPerDenomRequest) (*ActiveGaugesPerDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveGaugesPerDenom not implemented")
+func (m *EpochEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UpcomingGauges(ctx context.Context, req *UpcomingGaugesRequest) (*UpcomingGaugesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpcomingGauges not implemented")
+func (m *EpochEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochEmission.Merge(m, src)
 }
-func (*UnimplementedQueryServer) UpcomingGaugesPerDenom(ctx context.Context, req *UpcomingGaugesPerDenomRequest) (*UpcomingGaugesPerDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpcomingGaugesPerDenom not implemented")
+func (m *EpochEmission) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEstRequest) (*RewardsEstResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsEst not implemented")
+func (m *EpochEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochEmission.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
+
+var xxx_messageInfo_EpochEmission proto.InternalMessageInfo
+
+func (m *EpochEmission) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) AllGroups(ctx context.Context, req *QueryAllGroupsRequest) (*QueryAllGroupsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllGroups not implemented")
+
+func (m *EpochEmission) GetDistributionTime() time.Time {
+	if m != nil {
+		return m.DistributionTime
+	}
+	return time.Time{}
 }
-func (*UnimplementedQueryServer) AllGroupsGauges(ctx context.Context, req *QueryAllGroupsGaugesRequest) (*QueryAllGroupsGaugesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllGroupsGauges not implemented")
+
+func (m *EpochEmission) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) AllGroupsWithGauge(ctx context.Context, req *QueryAllGroupsWithGaugeRequest) (*QueryAllGroupsWithGaugeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllGroupsWithGauge not implemented")
+
+type ParamsRequest struct {
 }
-func (*UnimplementedQueryServer) GroupByGroupGaugeID(ctx context.Context, req *QueryGroupByGroupGaugeIDRequest) (*QueryGroupByGroupGaugeIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GroupByGroupGaugeID not implemented")
+
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{43}
 }
-func (*UnimplementedQueryServer) CurrentWeightByGroupGaugeID(ctx context.Context, req *QueryCurrentWeightByGroupGaugeIDRequest) (*QueryCurrentWeightByGroupGaugeIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentWeightByGroupGaugeID not implemented")
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) InternalGauges(ctx context.Context, req *QueryInternalGaugesRequest) (*QueryInternalGaugesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InternalGauges not implemented")
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ExternalGauges(ctx context.Context, req *QueryExternalGaugesRequest) (*QueryExternalGaugesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExternalGauges not implemented")
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GaugesByPoolID(ctx context.Context, req *QueryGaugesByPoolIDRequest) (*QueryGaugesByPoolIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GaugesByPoolID not implemented")
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
 
-func _Query_ModuleToDistributeCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModuleToDistributeCoinsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleToDistributeCoins(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/ModuleToDistributeCoins",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleToDistributeCoins(ctx, req.(*ModuleToDistributeCoinsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func _Query_GaugeByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GaugeByIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GaugeByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/GaugeByID",
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{44}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GaugeByID(ctx, req.(*GaugeByIDRequest))
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GaugesRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
+	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
+	proto.RegisterType((*GaugeByIDRequest)(nil), "osmosis.incentives.GaugeByIDRequest")
+	proto.RegisterType((*GaugeByIDResponse)(nil), "osmosis.incentives.GaugeByIDResponse")
+	proto.RegisterType((*GaugesRequest)(nil), "osmosis.incentives.GaugesRequest")
+	proto.RegisterType((*GaugesResponse)(nil), "osmosis.incentives.GaugesResponse")
+	proto.RegisterType((*ActiveGaugesRequest)(nil), "osmosis.incentives.ActiveGaugesRequest")
+	proto.RegisterType((*ActiveGaugesResponse)(nil), "osmosis.incentives.ActiveGaugesResponse")
+	proto.RegisterType((*ActiveGaugesPerDenomRequest)(nil), "osmosis.incentives.ActiveGaugesPerDenomRequest")
+	proto.RegisterType((*ActiveGaugesPerDenomResponse)(nil), "osmosis.incentives.ActiveGaugesPerDenomResponse")
+	proto.RegisterType((*UpcomingGaugesRequest)(nil), "osmosis.incentives.UpcomingGaugesRequest")
+	proto.RegisterType((*UpcomingGaugesResponse)(nil), "osmosis.incentives.UpcomingGaugesResponse")
+	proto.RegisterType((*UpcomingGaugesPerDenomRequest)(nil), "osmosis.incentives.UpcomingGaugesPerDenomRequest")
+	proto.RegisterType((*UpcomingGaugesPerDenomResponse)(nil), "osmosis.incentives.UpcomingGaugesPerDenomResponse")
+	proto.RegisterType((*RewardsEstRequest)(nil), "osmosis.incentives.RewardsEstRequest")
+	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
+	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
+	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
+	proto.RegisterType((*QueryAllGroupsRequest)(nil), "osmosis.incentives.QueryAllGroupsRequest")
+	proto.RegisterType((*QueryAllGroupsResponse)(nil), "osmosis.incentives.QueryAllGroupsResponse")
+	proto.RegisterType((*QueryAllGroupsGaugesRequest)(nil), "osmosis.incentives.QueryAllGroupsGaugesRequest")
+	proto.RegisterType((*QueryAllGroupsGaugesResponse)(nil), "osmosis.incentives.QueryAllGroupsGaugesResponse")
+	proto.RegisterType((*QueryAllGroupsWithGaugeRequest)(nil), "osmosis.incentives.QueryAllGroupsWithGaugeRequest")
+	proto.RegisterType((*QueryAllGroupsWithGaugeResponse)(nil), "osmosis.incentives.QueryAllGroupsWithGaugeResponse")
+	proto.RegisterType((*QueryGroupByGroupGaugeIDRequest)(nil), "osmosis.incentives.QueryGroupByGroupGaugeIDRequest")
+	proto.RegisterType((*QueryGroupByGroupGaugeIDResponse)(nil), "osmosis.incentives.QueryGroupByGroupGaugeIDResponse")
+	proto.RegisterType((*QueryCurrentWeightByGroupGaugeIDRequest)(nil), "osmosis.incentives.QueryCurrentWeightByGroupGaugeIDRequest")
+	proto.RegisterType((*QueryCurrentWeightByGroupGaugeIDResponse)(nil), "osmosis.incentives.QueryCurrentWeightByGroupGaugeIDResponse")
+	proto.RegisterType((*GaugeWeight)(nil), "osmosis.incentives.GaugeWeight")
+	proto.RegisterType((*QueryInternalGaugesRequest)(nil), "osmosis.incentives.QueryInternalGaugesRequest")
+	proto.RegisterType((*QueryInternalGaugesResponse)(nil), "osmosis.incentives.QueryInternalGaugesResponse")
+	proto.RegisterType((*QueryExternalGaugesRequest)(nil), "osmosis.incentives.QueryExternalGaugesRequest")
+	proto.RegisterType((*QueryExternalGaugesResponse)(nil), "osmosis.incentives.QueryExternalGaugesResponse")
+	proto.RegisterType((*QueryGaugesByPoolIDRequest)(nil), "osmosis.incentives.QueryGaugesByPoolIDRequest")
+	proto.RegisterType((*QueryGaugesByPoolIDResponse)(nil), "osmosis.incentives.QueryGaugesByPoolIDResponse")
+	proto.RegisterType((*QueryLockRewardsRequest)(nil), "osmosis.incentives.QueryLockRewardsRequest")
+	proto.RegisterType((*QueryLockRewardsResponse)(nil), "osmosis.incentives.QueryLockRewardsResponse")
+	proto.RegisterType((*QueryUpcomingEmissionsRequest)(nil), "osmosis.incentives.QueryUpcomingEmissionsRequest")
+	proto.RegisterType((*QueryUpcomingEmissionsResponse)(nil), "osmosis.incentives.QueryUpcomingEmissionsResponse")
+	proto.RegisterType((*QueryGaugesByRewardDenomRequest)(nil), "osmosis.incentives.QueryGaugesByRewardDenomRequest")
+	proto.RegisterType((*QueryGaugesByRewardDenomResponse)(nil), "osmosis.incentives.QueryGaugesByRewardDenomResponse")
+	proto.RegisterType((*GaugeRewardDenomInfo)(nil), "osmosis.incentives.GaugeRewardDenomInfo")
+	proto.RegisterType((*EpochEmission)(nil), "osmosis.incentives.EpochEmission")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.incentives.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.incentives.ParamsResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
+
+var fileDescriptor_8124258a89427f98 = []byte{
+	// 2080 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0xf5, 0x65, 0xeb, 0x49, 0x96, 0xad, 0xb1, 0x12, 0xcb, 0x94, 0xbd, 0x2b, 0xb3, 0x8e,
+	0xbd, 0xb6, 0x63, 0xd2, 0x5a, 0xf9, 0xab, 0x4e, 0x53, 0x34, 0x1b, 0xc9, 0xae, 0x8a, 0xa4, 0x56,
+	0x16, 0x31, 0x84, 0x16, 0x08, 0x08, 0xee, 0x72, 0x42, 0x11, 0xe6, 0xc7, 0x66, 0xc9, 0xb5, 0xb4,
+	0x10, 0x74, 0x68, 0x11, 0xa0, 0xb7, 0x20, 0x6d, 0x8d, 0xa2, 0x87, 0x00, 0xed, 0xa1, 0xe8, 0xa1,
+	0x45, 0x51, 0xa0, 0x05, 0x8a, 0x1e, 0x8a, 0x16, 0xe8, 0x29, 0xc7, 0x00, 0xbd, 0x14, 0x3d, 0x28,
+	0x85, 0xdd, 0x7b, 0x01, 0xff, 0x05, 0x01, 0x67, 0x1e, 0xb9, 0xe4, 0x2e, 0xc9, 0xdd, 0x55, 0x2c,
+	0x43, 0xa7, 0xd5, 0x70, 0xde, 0xc7, 0xef, 0x3d, 0xbe, 0xe1, 0x7b, 0xf3, 0x13, 0x14, 0x5c, 0xcf,
+	0x76, 0x3d, 0xd3, 0x53, 0x4c, 0xa7, 0x4e, 0x1d, 0xdf, 0x7c, 0x4c, 0x3d, 0xe5, 0xa3, 0x16, 0x6d,
+	0xb6, 0xe5, 0x46, 0xd3, 0xf5, 0x5d, 0x42, 0x70, 0x5f, 0xee, 0xec, 0x8b, 0x73, 0x86, 0x6b, 0xb8,
+	0x6c, 0x5b, 0x09, 0xfe, 0xe2, 0x92, 0xe2, 0x59, 0xc3, 0x75, 0x0d, 0x8b, 0x2a, 0x5a, 0xc3, 0x54,
+	0x34, 0xc7, 0x71, 0x7d, 0xcd, 0x37, 0x5d, 0xc7, 0xc3, 0xdd, 0x02, 0xee, 0xb2, 0x55, 0xad, 0xf5,
+	0xa1, 0xa2, 0xb7, 0x9a, 0x4c, 0x00, 0xf7, 0x8b, 0xdd, 0xfb, 0xbe, 0x69, 0x53, 0xcf, 0xd7, 0xec,
+	0x46, 0x68, 0xa0, 0xce, 0x90, 0x28, 0x35, 0xcd, 0xa3, 0xca, 0xe3, 0xa5, 0x1a, 0xf5, 0xb5, 0x25,
+	0xa5, 0xee, 0x9a, 0xa1, 0x81, 0x2b, 0xf1, 0x7d, 0x16, 0x41, 0x24, 0xd5, 0xd0, 0x0c, 0xd3, 0x89,
+	0x3b, 0x4b, 0x0b, 0xda, 0xd0, 0x5a, 0x06, 0xc5, 0xfd, 0x33, 0xe1, 0xbe, 0xe5, 0xd6, 0x1f, 0xb5,
+	0x1a, 0xec, 0x27, 0x4f, 0xb5, 0xe9, 0xb6, 0x42, 0x98, 0xc5, 0x94, 0xfd, 0x86, 0xd6, 0xd4, 0x6c,
+	0x4c, 0x84, 0xb4, 0x08, 0x85, 0x77, 0x5d, 0xbd, 0x65, 0xd1, 0xf7, 0xdd, 0x15, 0xd3, 0xf3, 0x9b,
+	0x66, 0xad, 0xe5, 0xd3, 0xb7, 0x5d, 0xd3, 0xf1, 0xaa, 0xf4, 0xa3, 0x16, 0xf5, 0x7c, 0xe9, 0x63,
+	0x01, 0x8a, 0x99, 0x22, 0x5e, 0xc3, 0x75, 0x3c, 0x4a, 0x34, 0x18, 0x0f, 0x62, 0xf7, 0xe6, 0x85,
+	0xc5, 0xd1, 0xd2, 0x54, 0xf9, 0x8c, 0xcc, 0xa3, 0x97, 0x83, 0xe8, 0x65, 0x8c, 0x5b, 0x0e, 0x54,
+	0x2a, 0xd7, 0x3f, 0xdf, 0x2b, 0x1e, 0xf9, 0xdd, 0x97, 0xc5, 0x92, 0x61, 0xfa, 0x9b, 0xad, 0x9a,
+	0x5c, 0x77, 0x6d, 0x05, 0x53, 0xc5, 0x7f, 0xae, 0x79, 0xfa, 0x23, 0xc5, 0x6f, 0x37, 0xa8, 0x27,
+	0x73, 0x1f, 0xdc, 0xb2, 0x24, 0xc1, 0xc9, 0xfb, 0x41, 0x4e, 0x2a, 0xed, 0xb5, 0x15, 0x84, 0x46,
+	0x66, 0x60, 0xc4, 0xd4, 0xe7, 0x85, 0x45, 0xa1, 0x34, 0x56, 0x1d, 0x31, 0x75, 0x69, 0x05, 0x66,
+	0x63, 0x32, 0x88, 0x4d, 0x81, 0x71, 0x96, 0x4c, 0x26, 0x17, 0x60, 0xeb, 0x2d, 0x21, 0x99, 0x69,
+	0x55, 0xb9, 0x9c, 0xb4, 0x01, 0xc7, 0xd9, 0x3a, 0xcc, 0x00, 0xb9, 0x07, 0xd0, 0x79, 0x67, 0x68,
+	0xe6, 0x62, 0x22, 0x44, 0x5e, 0xa2, 0x61, 0xa0, 0xeb, 0x9a, 0x41, 0x51, 0xb7, 0x1a, 0xd3, 0x94,
+	0x3e, 0x11, 0x60, 0x26, 0xb4, 0x8c, 0xe0, 0x96, 0x61, 0x4c, 0xd7, 0x7c, 0x2d, 0xca, 0x5b, 0x16,
+	0xb6, 0xca, 0x58, 0x90, 0xb7, 0x2a, 0x13, 0x26, 0xf7, 0x13, 0x78, 0x46, 0x18, 0x9e, 0x4b, 0x7d,
+	0xf1, 0x70, 0x8f, 0x09, 0x40, 0x1f, 0xc0, 0xa9, 0xb7, 0xea, 0x81, 0x97, 0x83, 0x89, 0xf7, 0x89,
+	0x00, 0x73, 0x49, 0xfb, 0x87, 0x22, 0xea, 0x1d, 0x58, 0x88, 0xa3, 0x5a, 0xa7, 0xcd, 0x15, 0xea,
+	0xb8, 0x76, 0x18, 0xfd, 0x1c, 0x8c, 0xeb, 0xc1, 0x9a, 0x05, 0x3e, 0x59, 0xe5, 0x0b, 0x72, 0x2f,
+	0xc5, 0xfb, 0x7e, 0x72, 0xf2, 0x99, 0x00, 0x67, 0xd3, 0xbd, 0x1f, 0x8a, 0xdc, 0xa8, 0xf0, 0xca,
+	0xc3, 0x46, 0xdd, 0xb5, 0x4d, 0xc7, 0x38, 0x98, 0x9a, 0xf8, 0x85, 0x00, 0xaf, 0x76, 0x7b, 0x38,
+	0x14, 0x91, 0xef, 0xc2, 0xb9, 0x24, 0xae, 0x97, 0x5b, 0x17, 0x7f, 0x16, 0xa0, 0x90, 0xe5, 0x1f,
+	0xf3, 0xf3, 0x5d, 0x38, 0xd1, 0x42, 0x09, 0x95, 0x7d, 0xa9, 0xbc, 0x41, 0x53, 0x35, 0xd3, 0x4a,
+	0x58, 0x7e, 0x71, 0x49, 0xf3, 0x60, 0xb6, 0x4a, 0xb7, 0xb4, 0xa6, 0xee, 0xad, 0x7a, 0x7e, 0x98,
+	0xa8, 0x8b, 0x30, 0xee, 0x6e, 0x39, 0xb4, 0xc9, 0x13, 0x55, 0x39, 0xf9, 0x7c, 0xaf, 0x38, 0xdd,
+	0xd6, 0x6c, 0xeb, 0xae, 0xc4, 0x1e, 0x4b, 0x55, 0xbe, 0x4d, 0xce, 0xc0, 0xb1, 0xa0, 0x93, 0xa9,
+	0xa6, 0xee, 0xcd, 0x8f, 0x2c, 0x8e, 0x96, 0xc6, 0xaa, 0x47, 0x83, 0xf5, 0x9a, 0xee, 0x91, 0x05,
+	0x98, 0xa4, 0x8e, 0xae, 0xd2, 0x86, 0x5b, 0xdf, 0x9c, 0x1f, 0x5d, 0x14, 0x4a, 0xa3, 0xd5, 0x63,
+	0xd4, 0xd1, 0x57, 0x83, 0xb5, 0xb4, 0x05, 0x24, 0xee, 0xf4, 0xe5, 0xb5, 0xa0, 0x22, 0x9c, 0x7b,
+	0x2f, 0xc8, 0xcb, 0x3b, 0x6e, 0xfd, 0x91, 0x56, 0xb3, 0xe8, 0x0a, 0xce, 0x0c, 0x51, 0xab, 0xfc,
+	0xa9, 0x00, 0x85, 0x2c, 0x09, 0x84, 0xe9, 0x02, 0xb1, 0x70, 0x53, 0x0d, 0x67, 0x8e, 0x0e, 0x66,
+	0x3e, 0x75, 0xc8, 0xe1, 0xd4, 0x21, 0x87, 0xfa, 0x95, 0xd7, 0x02, 0xcc, 0xcf, 0xf7, 0x8a, 0x67,
+	0x78, 0x22, 0x7b, 0x4d, 0x48, 0xbf, 0xfc, 0xb2, 0x28, 0x54, 0x67, 0xad, 0x6e, 0xc7, 0xd2, 0x69,
+	0x78, 0x85, 0x41, 0x7a, 0xcb, 0xb2, 0xee, 0x07, 0x83, 0x41, 0x04, 0xf6, 0x3d, 0x78, 0xb5, 0x7b,
+	0x03, 0x31, 0xde, 0x86, 0x09, 0x36, 0x43, 0xe4, 0xd7, 0x57, 0x20, 0x81, 0xf5, 0x85, 0xe2, 0xd2,
+	0x39, 0x58, 0x48, 0x9a, 0x4c, 0x7c, 0x43, 0xa4, 0x0d, 0x38, 0x9b, 0xbe, 0x1d, 0xf3, 0x3b, 0x54,
+	0x5d, 0xa3, 0x78, 0x30, 0xc4, 0x24, 0x0d, 0x6f, 0x98, 0xfe, 0x26, 0xef, 0xe9, 0xe8, 0x7a, 0x1b,
+	0x8a, 0x99, 0x12, 0xe8, 0xfd, 0x21, 0xcc, 0xf2, 0x30, 0xd4, 0x2d, 0xd3, 0xdf, 0x54, 0xc3, 0x99,
+	0x21, 0x00, 0xf2, 0x8d, 0xcc, 0x04, 0x74, 0xec, 0x20, 0xa4, 0x13, 0x46, 0xf2, 0xb1, 0xb4, 0x84,
+	0x9e, 0x79, 0xbe, 0xf8, 0x0f, 0xdb, 0xc9, 0x1e, 0x63, 0x7e, 0x00, 0x8b, 0xd9, 0x2a, 0x88, 0xf6,
+	0x26, 0x8c, 0x33, 0x4f, 0xb9, 0x53, 0x4d, 0xec, 0x15, 0x71, 0x69, 0xe9, 0x01, 0x5c, 0x62, 0xa6,
+	0xdf, 0x6e, 0x35, 0x9b, 0xd4, 0xf1, 0x37, 0xa8, 0x69, 0x6c, 0xfa, 0xe9, 0xa8, 0x2e, 0xc0, 0x0c,
+	0xd3, 0xe1, 0x99, 0x50, 0x23, 0x84, 0xd3, 0x46, 0x47, 0x58, 0x97, 0x7c, 0x28, 0xf5, 0x37, 0x18,
+	0x7d, 0xc0, 0xa6, 0xb9, 0xad, 0x2d, 0x26, 0x85, 0xc9, 0x2d, 0x66, 0xbe, 0x65, 0x34, 0xc6, 0x03,
+	0x98, 0x32, 0x3a, 0x8f, 0xa4, 0x9f, 0x08, 0x30, 0x15, 0x13, 0x09, 0x3e, 0x25, 0x5d, 0x28, 0x8f,
+	0x1a, 0x1c, 0x20, 0xf9, 0x00, 0xa6, 0xb9, 0x3b, 0x95, 0x9d, 0x08, 0xf6, 0xb5, 0x9b, 0xac, 0xdc,
+	0x0d, 0x6c, 0xfe, 0x67, 0xaf, 0xb8, 0xc0, 0x4f, 0xbc, 0xa7, 0x3f, 0x92, 0x4d, 0x57, 0xb1, 0x35,
+	0x7f, 0x53, 0x7e, 0x87, 0x1a, 0x5a, 0xbd, 0xbd, 0x42, 0xeb, 0xcf, 0xf7, 0x8a, 0xa7, 0xf8, 0x71,
+	0x8b, 0x1b, 0x90, 0xaa, 0x53, 0x7c, 0x59, 0x65, 0x2b, 0x1d, 0x44, 0x16, 0xff, 0x9a, 0xe3, 0xd3,
+	0xa6, 0xa3, 0x59, 0x07, 0xd3, 0x35, 0x7f, 0x25, 0xc0, 0x42, 0xaa, 0x9b, 0xaf, 0x79, 0x72, 0x5e,
+	0x5c, 0x27, 0x08, 0xf3, 0xb0, 0xba, 0xfd, 0x52, 0xf2, 0xd0, 0xed, 0xe6, 0xd0, 0xe4, 0xc1, 0xc7,
+	0x3c, 0x70, 0x60, 0x95, 0xf6, 0xba, 0xeb, 0x5a, 0x99, 0x27, 0xfd, 0x85, 0x4d, 0x0f, 0x51, 0x5e,
+	0xba, 0xdd, 0x1e, 0x9a, 0xbc, 0x94, 0xe1, 0x74, 0xd4, 0x19, 0xb1, 0x7b, 0x87, 0x49, 0x39, 0x0d,
+	0x47, 0x71, 0x0e, 0xc0, 0xcc, 0x4c, 0xf0, 0x31, 0x40, 0xfa, 0x91, 0x00, 0xf3, 0xbd, 0x4a, 0x18,
+	0x12, 0x85, 0xa3, 0x4d, 0xfe, 0xe8, 0x20, 0x3a, 0x7e, 0x68, 0x5b, 0xda, 0xc0, 0x9e, 0x1f, 0xce,
+	0x66, 0xab, 0xb6, 0xe9, 0x79, 0xb1, 0x9e, 0x1f, 0xa0, 0x6f, 0xb8, 0xae, 0x15, 0x43, 0x1f, 0x2c,
+	0xd7, 0x74, 0x72, 0x0e, 0xc0, 0x69, 0xd9, 0x7c, 0x86, 0xf1, 0x58, 0xea, 0xc6, 0xaa, 0x93, 0x4e,
+	0xcb, 0x66, 0x43, 0x8c, 0x27, 0x19, 0x50, 0xc8, 0x32, 0x8c, 0x11, 0xae, 0xc2, 0x24, 0x0d, 0x1f,
+	0x62, 0x8c, 0xe7, 0xd3, 0xde, 0x1b, 0x33, 0x18, 0xaa, 0xe3, 0xfb, 0xeb, 0x68, 0x4a, 0xbf, 0x16,
+	0xc2, 0x0e, 0x84, 0xb5, 0xc1, 0x33, 0x39, 0xc0, 0x6c, 0x5b, 0x84, 0x29, 0x8d, 0x5d, 0x55, 0x54,
+	0xd7, 0xb1, 0xda, 0x2c, 0x84, 0x63, 0x55, 0xe0, 0x8f, 0x1e, 0x38, 0x56, 0xbb, 0xab, 0x7c, 0x47,
+	0xbf, 0xce, 0xf0, 0xbb, 0x98, 0x0d, 0x11, 0xd3, 0x71, 0xaf, 0xab, 0x86, 0x4b, 0xd9, 0x17, 0xf9,
+	0x8e, 0xf6, 0x9a, 0xf3, 0xa1, 0x7b, 0x50, 0x25, 0xfd, 0x0f, 0x01, 0xe6, 0xd2, 0xfc, 0xb1, 0xde,
+	0x3c, 0x10, 0xe3, 0x10, 0xf5, 0xe6, 0x60, 0x41, 0xde, 0x84, 0xc9, 0x26, 0xb5, 0x35, 0xd3, 0x31,
+	0x1d, 0x03, 0x71, 0xe5, 0xd4, 0x34, 0xbe, 0xe7, 0x48, 0x83, 0x2c, 0xf5, 0xcc, 0xcc, 0x95, 0xb9,
+	0xe7, 0x7b, 0xc5, 0x93, 0xbc, 0x85, 0x45, 0x5b, 0x52, 0x6c, 0x92, 0xfe, 0x78, 0x04, 0x8e, 0x27,
+	0xaa, 0x87, 0x9c, 0x87, 0x69, 0x26, 0xa5, 0x3a, 0x2d, 0xbb, 0x86, 0x23, 0xfc, 0x68, 0x75, 0x8a,
+	0x3d, 0xfb, 0x3e, 0x7b, 0x44, 0x6c, 0x98, 0xd5, 0x43, 0x1a, 0xc8, 0x74, 0x1d, 0xd5, 0x37, 0x6d,
+	0x8a, 0x70, 0xc5, 0x9e, 0x01, 0xf6, 0xfd, 0x90, 0x36, 0xab, 0x5c, 0xc0, 0x09, 0x76, 0x9e, 0xe3,
+	0xe9, 0x31, 0x21, 0x7d, 0x1a, 0x0c, 0xb0, 0x27, 0xe3, 0xcf, 0x03, 0xe5, 0xce, 0x5c, 0x3f, 0x7a,
+	0x60, 0x73, 0xfd, 0x09, 0x38, 0xbe, 0xce, 0x38, 0xb1, 0x70, 0x5a, 0xfc, 0x1e, 0xcc, 0x84, 0x0f,
+	0xb0, 0xf8, 0xee, 0xc0, 0x04, 0xa7, 0xcd, 0xf0, 0x9d, 0x8a, 0x69, 0xef, 0x94, 0xeb, 0x84, 0xe5,
+	0xc6, 0xe5, 0xcb, 0x7f, 0x3c, 0x0b, 0xe3, 0xac, 0xb6, 0xc9, 0x3f, 0x05, 0x38, 0x9d, 0x41, 0xa4,
+	0x91, 0x72, 0x9a, 0xbd, 0x7c, 0x62, 0x4e, 0x5c, 0x1e, 0x4a, 0x87, 0x07, 0x22, 0x7d, 0xfb, 0xc7,
+	0xff, 0xfa, 0xdf, 0xcf, 0x47, 0xee, 0x90, 0x5b, 0x4a, 0x0a, 0x33, 0x18, 0x32, 0x94, 0x36, 0x33,
+	0xa2, 0xfa, 0xae, 0x1a, 0xbd, 0x0e, 0xaa, 0xb2, 0x5c, 0x91, 0x4f, 0x04, 0x98, 0x8c, 0x38, 0x36,
+	0x72, 0x21, 0xbb, 0xb4, 0x3b, 0x34, 0x9d, 0xf8, 0x5a, 0x1f, 0x29, 0x84, 0x76, 0x83, 0x41, 0x93,
+	0xc9, 0xeb, 0x79, 0xd0, 0xf8, 0x98, 0x57, 0x6b, 0xab, 0xa6, 0xae, 0xec, 0x98, 0xfa, 0x2e, 0xd9,
+	0x81, 0x09, 0xbc, 0xd5, 0x9e, 0xcf, 0x74, 0x13, 0xa5, 0x4c, 0xca, 0x13, 0x41, 0x18, 0x57, 0x18,
+	0x8c, 0x0b, 0x44, 0xea, 0x0b, 0xc3, 0x23, 0x4f, 0x04, 0x98, 0x8e, 0xb3, 0x39, 0xe4, 0x52, 0x9a,
+	0x83, 0x14, 0x8e, 0x4d, 0x2c, 0xf5, 0x17, 0x44, 0x3c, 0x4b, 0x0c, 0xcf, 0x55, 0x72, 0x39, 0x0f,
+	0x0f, 0x7e, 0xa7, 0x11, 0xd6, 0x5f, 0xba, 0x88, 0xb7, 0x90, 0x4a, 0x20, 0x4a, 0x3f, 0xaf, 0x5d,
+	0xa4, 0x87, 0x78, 0x7d, 0x70, 0x05, 0x84, 0xfb, 0x06, 0x83, 0x7b, 0x93, 0x2c, 0x0f, 0x0c, 0x57,
+	0x6d, 0xd0, 0xa6, 0xca, 0x3b, 0xce, 0x67, 0x02, 0xcc, 0x24, 0x59, 0x10, 0x72, 0x39, 0x0d, 0x41,
+	0x2a, 0x47, 0x25, 0x5e, 0x19, 0x44, 0x14, 0x61, 0x2e, 0x33, 0x98, 0xd7, 0xc8, 0xd5, 0x3c, 0x98,
+	0x5d, 0x74, 0x0b, 0xf9, 0x7b, 0x0f, 0x79, 0x15, 0x65, 0x76, 0xa9, 0xbf, 0xef, 0xee, 0xdc, 0x96,
+	0x87, 0x51, 0x41, 0xd8, 0x6f, 0x32, 0xd8, 0xb7, 0xc9, 0xcd, 0x21, 0x60, 0xc7, 0xf2, 0xfb, 0x44,
+	0x00, 0xe8, 0x70, 0x27, 0x24, 0xf5, 0x60, 0xf6, 0x10, 0x3a, 0xe2, 0xc5, 0x7e, 0x62, 0x08, 0xee,
+	0x36, 0x03, 0xb7, 0x44, 0x94, 0x3c, 0x70, 0x38, 0x58, 0xa9, 0xd4, 0xf3, 0x95, 0x1d, 0x46, 0x04,
+	0xed, 0x92, 0x3f, 0x09, 0x30, 0xdb, 0x43, 0x99, 0xa4, 0xa7, 0x34, 0x97, 0x80, 0x11, 0xcb, 0xc3,
+	0xa8, 0x20, 0xea, 0x5b, 0x0c, 0xf5, 0x75, 0x22, 0xe7, 0xa1, 0xee, 0x25, 0x5c, 0xc8, 0xcf, 0x04,
+	0x98, 0x8c, 0xe8, 0x04, 0x72, 0x39, 0xd3, 0x73, 0x37, 0xf1, 0x22, 0x5e, 0x19, 0x44, 0x14, 0xc1,
+	0xc9, 0x0c, 0x5c, 0x89, 0x5c, 0xcc, 0x3d, 0x4d, 0x96, 0xa5, 0x72, 0xda, 0x81, 0xfc, 0x5e, 0x80,
+	0x13, 0x5d, 0xf4, 0x0a, 0x51, 0xfa, 0xfb, 0x4b, 0x9e, 0xa3, 0xeb, 0x83, 0x2b, 0x20, 0xcc, 0x9b,
+	0x0c, 0xa6, 0x42, 0xae, 0x0d, 0x06, 0x33, 0x3c, 0x4f, 0x7f, 0x15, 0x80, 0xf4, 0x32, 0x32, 0xa4,
+	0xdc, 0xdf, 0x7f, 0x37, 0xc1, 0x23, 0x2e, 0x0f, 0xa5, 0x83, 0xb0, 0xbf, 0xc9, 0x60, 0x2f, 0x93,
+	0xa5, 0x01, 0x61, 0x77, 0x88, 0xa1, 0xa0, 0x99, 0x9f, 0x4a, 0xe1, 0x67, 0x48, 0x36, 0x8e, 0x6c,
+	0x02, 0x48, 0xbc, 0x31, 0x9c, 0x12, 0xa2, 0xff, 0x0e, 0x43, 0x7f, 0x97, 0xdc, 0xc9, 0x6d, 0x54,
+	0x81, 0x66, 0xd0, 0x2f, 0x93, 0x5c, 0x0e, 0xef, 0x9d, 0xff, 0x17, 0x60, 0x21, 0x87, 0xb8, 0x21,
+	0x6f, 0x64, 0xe2, 0xea, 0xcf, 0x1f, 0x89, 0xdf, 0xda, 0x9f, 0x32, 0x06, 0xf7, 0x90, 0x05, 0xf7,
+	0x80, 0xbc, 0x9b, 0x17, 0x5c, 0x9d, 0x1b, 0x42, 0x3e, 0x29, 0x2d, 0xca, 0xe4, 0x7a, 0x97, 0xfc,
+	0x56, 0x80, 0x99, 0x24, 0x87, 0x42, 0xe4, 0x4c, 0x9c, 0xa9, 0x9c, 0x8e, 0xa8, 0x0c, 0x2c, 0x3f,
+	0x4c, 0xab, 0x31, 0x51, 0x37, 0x3c, 0x1a, 0x01, 0xd0, 0xd5, 0xed, 0x01, 0x81, 0xae, 0x6e, 0x0f,
+	0x07, 0x74, 0x75, 0x7b, 0xff, 0x40, 0xe9, 0x76, 0x12, 0xe8, 0x1f, 0xa2, 0x7f, 0x6a, 0x86, 0xac,
+	0x43, 0x0e, 0xd0, 0x54, 0x56, 0x44, 0x54, 0x06, 0x96, 0x47, 0xa0, 0x77, 0x19, 0xd0, 0x1b, 0xa4,
+	0xdc, 0x7f, 0x44, 0x0b, 0x8a, 0x02, 0xef, 0xe7, 0xbc, 0xe6, 0x7f, 0x23, 0xc0, 0x54, 0x8c, 0x4f,
+	0x20, 0x57, 0x73, 0x5b, 0x46, 0x92, 0xaa, 0x10, 0x5f, 0x1f, 0x4c, 0x78, 0x18, 0x98, 0x8c, 0xfa,
+	0xc0, 0xa6, 0xa8, 0xec, 0x20, 0x11, 0xb2, 0x4b, 0xfe, 0x26, 0xc0, 0x6c, 0x0f, 0x35, 0x90, 0xd3,
+	0x12, 0xb3, 0xf8, 0x09, 0xb1, 0x3c, 0x8c, 0xca, 0x30, 0x5f, 0x96, 0x68, 0xca, 0x88, 0xa8, 0x06,
+	0x65, 0x07, 0x33, 0xcd, 0xe0, 0x9f, 0x4a, 0xb9, 0xcc, 0xe7, 0x7d, 0x1e, 0x33, 0xd9, 0x09, 0xf1,
+	0xc6, 0x70, 0x4a, 0xfb, 0x2b, 0x12, 0xfe, 0x0a, 0x70, 0x4e, 0xda, 0x81, 0x09, 0x7e, 0x99, 0x4b,
+	0xbf, 0x54, 0x24, 0x6e, 0x8b, 0xa2, 0x94, 0x27, 0x32, 0xcc, 0xa5, 0x82, 0xdf, 0x18, 0x2b, 0xeb,
+	0x9f, 0x3f, 0x2d, 0x08, 0x5f, 0x3c, 0x2d, 0x08, 0xff, 0x7d, 0x5a, 0x10, 0x3e, 0x7d, 0x56, 0x38,
+	0xf2, 0xc5, 0xb3, 0xc2, 0x91, 0x7f, 0x3f, 0x2b, 0x1c, 0xf9, 0xe1, 0xad, 0xd8, 0xcd, 0x16, 0xed,
+	0x5c, 0xb3, 0xb4, 0x9a, 0x17, 0x19, 0x7d, 0x5c, 0xbe, 0xa5, 0x6c, 0xc7, 0x4d, 0xb3, 0xdb, 0x6e,
+	0x6d, 0x82, 0xdd, 0xc7, 0x97, 0xbf, 0x1a, 0x00, 0x3b, 0xd2, 0x68, 0x49, 0x5e, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ModuleToDistributeCoins returns coins that are going to be distributed
+	ModuleToDistributeCoins(ctx context.Context, in *ModuleToDistributeCoinsRequest, opts ...grpc.CallOption) (*ModuleToDistributeCoinsResponse, error)
+	// GaugeByID returns gauges by their respective ID
+	GaugeByID(ctx context.Context, in *GaugeByIDRequest, opts ...grpc.CallOption) (*GaugeByIDResponse, error)
+	// Gauges returns both upcoming and active gauges
+	Gauges(ctx context.Context, in *GaugesRequest, opts ...grpc.CallOption) (*GaugesResponse, error)
+	// ActiveGauges returns active gauges
+	ActiveGauges(ctx context.Context, in *ActiveGaugesRequest, opts ...grpc.CallOption) (*ActiveGaugesResponse, error)
+	// ActiveGaugesPerDenom returns active gauges by denom
+	ActiveGaugesPerDenom(ctx context.Context, in *ActiveGaugesPerDenomRequest, opts ...grpc.CallOption) (*ActiveGaugesPerDenomResponse, error)
+	// Returns scheduled gauges that have not yet occurred
+	UpcomingGauges(ctx context.Context, in *UpcomingGaugesRequest, opts ...grpc.CallOption) (*UpcomingGaugesResponse, error)
+	// UpcomingGaugesPerDenom returns scheduled gauges that have not yet occurred
+	// by denom
+	UpcomingGaugesPerDenom(ctx context.Context, in *UpcomingGaugesPerDenomRequest, opts ...grpc.CallOption) (*UpcomingGaugesPerDenomResponse, error)
+	// RewardsEst returns an estimate of the rewards from now until a specified
+	// time in the future The querier either provides an address or a set of locks
+	// for which they want to find the associated rewards
+	RewardsEst(ctx context.Context, in *RewardsEstRequest, opts ...grpc.CallOption) (*RewardsEstResponse, error)
+	// LockableDurations returns lockable durations that are valid to distribute
+	// incentives for
+	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
+	// AllGroups returns all groups
+	AllGroups(ctx context.Context, in *QueryAllGroupsRequest, opts ...grpc.CallOption) (*QueryAllGroupsResponse, error)
+	// AllGroupsGauges returns all group gauges
+	AllGroupsGauges(ctx context.Context, in *QueryAllGroupsGaugesRequest, opts ...grpc.CallOption) (*QueryAllGroupsGaugesResponse, error)
+	// AllGroupsWithGauge returns all groups with their group gauge
+	AllGroupsWithGauge(ctx context.Context, in *QueryAllGroupsWithGaugeRequest, opts ...grpc.CallOption) (*QueryAllGroupsWithGaugeResponse, error)
+	// GroupByGroupGaugeID returns a group given its group gauge ID
+	GroupByGroupGaugeID(ctx context.Context, in *QueryGroupByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryGroupByGroupGaugeIDResponse, error)
+	// CurrentWeightByGroupGaugeID returns the current weight since the
+	// the last epoch given a group gauge ID
+	CurrentWeightByGroupGaugeID(ctx context.Context, in *QueryCurrentWeightByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryCurrentWeightByGroupGaugeIDResponse, error)
+	InternalGauges(ctx context.Context, in *QueryInternalGaugesRequest, opts ...grpc.CallOption) (*QueryInternalGaugesResponse, error)
+	ExternalGauges(ctx context.Context, in *QueryExternalGaugesRequest, opts ...grpc.CallOption) (*QueryExternalGaugesResponse, error)
+	GaugesByPoolID(ctx context.Context, in *QueryGaugesByPoolIDRequest, opts ...grpc.CallOption) (*QueryGaugesByPoolIDResponse, error)
+	// LockRewards returns the rewards accrued to a lock that have not been
+	// claimed yet
+	LockRewards(ctx context.Context, in *QueryLockRewardsRequest, opts ...grpc.CallOption) (*QueryLockRewardsResponse, error)
+	// UpcomingEmissions returns the projected per epoch emissions of all active
+	// and upcoming gauges attached to a pool
+	UpcomingEmissions(ctx context.Context, in *QueryUpcomingEmissionsRequest, opts ...grpc.CallOption) (*QueryUpcomingEmissionsResponse, error)
+	// GaugesByRewardDenom returns the unfinished gauges whose rewards include
+	// the given denom, along with their remaining balance of it and their end
+	// epoch
+	GaugesByRewardDenom(ctx context.Context, in *QueryGaugesByRewardDenomRequest, opts ...grpc.CallOption) (*QueryGaugesByRewardDenomResponse, error)
+	// Params returns incentives module params.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ModuleToDistributeCoins(ctx context.Context, in *ModuleToDistributeCoinsRequest, opts ...grpc.CallOption) (*ModuleToDistributeCoinsResponse, error) {
+	out := new(ModuleToDistributeCoinsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ModuleToDistributeCoins", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Gauges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/Gauges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Gauges(ctx, req.(*GaugesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ActiveGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActiveGaugesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GaugeByID(ctx context.Context, in *GaugeByIDRequest, opts ...grpc.CallOption) (*GaugeByIDResponse, error) {
+	out := new(GaugeByIDResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GaugeByID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveGauges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/ActiveGauges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveGauges(ctx, req.(*ActiveGaugesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ActiveGaugesPerDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActiveGaugesPerDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Gauges(ctx context.Context, in *GaugesRequest, opts ...grpc.CallOption) (*GaugesResponse, error) {
+	out := new(GaugesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/Gauges", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveGaugesPerDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/ActiveGaugesPerDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveGaugesPerDenom(ctx, req.(*ActiveGaugesPerDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_UpcomingGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpcomingGaugesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ActiveGauges(ctx context.Context, in *ActiveGaugesRequest, opts ...grpc.CallOption) (*ActiveGaugesResponse, error) {
+	out := new(ActiveGaugesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ActiveGauges", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UpcomingGauges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/UpcomingGauges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UpcomingGauges(ctx, req.(*UpcomingGaugesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_UpcomingGaugesPerDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpcomingGaugesPerDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ActiveGaugesPerDenom(ctx context.Context, in *ActiveGaugesPerDenomRequest, opts ...grpc.CallOption) (*ActiveGaugesPerDenomResponse, error) {
+	out := new(ActiveGaugesPerDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ActiveGaugesPerDenom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UpcomingGaugesPerDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/UpcomingGaugesPerDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UpcomingGaugesPerDenom(ctx, req.(*UpcomingGaugesPerDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RewardsEst_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RewardsEstRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) UpcomingGauges(ctx context.Context, in *UpcomingGaugesRequest, opts ...grpc.CallOption) (*UpcomingGaugesResponse, error) {
+	out := new(UpcomingGaugesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/UpcomingGauges", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsEst(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/RewardsEst",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsEst(ctx, req.(*RewardsEstRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLockableDurationsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) UpcomingGaugesPerDenom(ctx context.Context, in *UpcomingGaugesPerDenomRequest, opts ...grpc.CallOption) (*UpcomingGaugesPerDenomResponse, error) {
+	out := new(UpcomingGaugesPerDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/UpcomingGaugesPerDenom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LockableDurations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/LockableDurations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LockableDurations(ctx, req.(*QueryLockableDurationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGroupsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) RewardsEst(ctx context.Context, in *RewardsEstRequest, opts ...grpc.CallOption) (*RewardsEstResponse, error) {
+	out := new(RewardsEstResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/RewardsEst", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllGroups(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/AllGroups",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllGroups(ctx, req.(*QueryAllGroupsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllGroupsGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGroupsGaugesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error) {
+	out := new(QueryLockableDurationsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/LockableDurations", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllGroupsGauges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/AllGroupsGauges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllGroupsGauges(ctx, req.(*QueryAllGroupsGaugesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllGroupsWithGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGroupsWithGaugeRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllGroups(ctx context.Context, in *QueryAllGroupsRequest, opts ...grpc.CallOption) (*QueryAllGroupsResponse, error) {
+	out := new(QueryAllGroupsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/AllGroups", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllGroupsWithGauge(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/AllGroupsWithGauge",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllGroupsWithGauge(ctx, req.(*QueryAllGroupsWithGaugeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GroupByGroupGaugeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupByGroupGaugeIDRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllGroupsGauges(ctx context.Context, in *QueryAllGroupsGaugesRequest, opts ...grpc.CallOption) (*QueryAllGroupsGaugesResponse, error) {
+	out := new(QueryAllGroupsGaugesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/AllGroupsGauges", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GroupByGroupGaugeID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/GroupByGroupGaugeID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GroupByGroupGaugeID(ctx, req.(*QueryGroupByGroupGaugeIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CurrentWeightByGroupGaugeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentWeightByGroupGaugeIDRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllGroupsWithGauge(ctx context.Context, in *QueryAllGroupsWithGaugeRequest, opts ...grpc.CallOption) (*QueryAllGroupsWithGaugeResponse, error) {
+	out := new(QueryAllGroupsWithGaugeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/AllGroupsWithGauge", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentWeightByGroupGaugeID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/CurrentWeightByGroupGaugeID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentWeightByGroupGaugeID(ctx, req.(*QueryCurrentWeightByGroupGaugeIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_InternalGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInternalGaugesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GroupByGroupGaugeID(ctx context.Context, in *QueryGroupByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryGroupByGroupGaugeIDResponse, error) {
+	out := new(QueryGroupByGroupGaugeIDResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GroupByGroupGaugeID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).InternalGauges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/InternalGauges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InternalGauges(ctx, req.(*QueryInternalGaugesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ExternalGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExternalGaugesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CurrentWeightByGroupGaugeID(ctx context.Context, in *QueryCurrentWeightByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryCurrentWeightByGroupGaugeIDResponse, error) {
+	out := new(QueryCurrentWeightByGroupGaugeIDResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/CurrentWeightByGroupGaugeID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExternalGauges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/ExternalGauges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExternalGauges(ctx, req.(*QueryExternalGaugesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GaugesByPoolID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGaugesByPoolIDRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) InternalGauges(ctx context.Context, in *QueryInternalGaugesRequest, opts ...grpc.CallOption) (*QueryInternalGaugesResponse, error) {
+	out := new(QueryInternalGaugesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/InternalGauges", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GaugesByPoolID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/GaugesByPoolID",
+	return out, nil
+}
+
+func (c *queryClient) ExternalGauges(ctx context.Context, in *QueryExternalGaugesRequest, opts ...grpc.CallOption) (*QueryExternalGaugesResponse, error) {
+	out := new(QueryExternalGaugesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ExternalGauges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GaugesByPoolID(ctx, req.(*QueryGaugesByPoolIDRequest))
+	return out, nil
+}
+
+func (c *queryClient) GaugesByPoolID(ctx context.Context, in *QueryGaugesByPoolIDRequest, opts ...grpc.CallOption) (*QueryGaugesByPoolIDResponse, error) {
+	out := new(QueryGaugesByPoolIDResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GaugesByPoolID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) LockRewards(ctx context.Context, in *QueryLockRewardsRequest, opts ...grpc.CallOption) (*QueryLockRewardsResponse, error) {
+	out := new(QueryLockRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/LockRewards", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) UpcomingEmissions(ctx context.Context, in *QueryUpcomingEmissionsRequest, opts ...grpc.CallOption) (*QueryUpcomingEmissionsResponse, error) {
+	out := new(QueryUpcomingEmissionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/UpcomingEmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.incentives.Query/Params",
+	return out, nil
+}
+
+func (c *queryClient) GaugesByRewardDenom(ctx context.Context, in *QueryGaugesByRewardDenomRequest, opts ...grpc.CallOption) (*QueryGaugesByRewardDenomResponse, error) {
+	out := new(QueryGaugesByRewardDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GaugesByRewardDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.incentives.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ModuleToDistributeCoins",
-			Handler:    _Query_ModuleToDistributeCoins_Handler,
-		},
-		{
-			MethodName: "GaugeByID",
-			Handler:    _Query_GaugeByID_Handler,
-		},
-		{
-			MethodName: "Gauges",
-			Handler:    _Query_Gauges_Handler,
-		},
-		{
-			MethodName: "ActiveGauges",
-			Handler:    _Query_ActiveGauges_Handler,
-		},
-		{
-			MethodName: "ActiveGaugesPerDenom",
-			Handler:    _Query_ActiveGaugesPerDenom_Handler,
-		},
-		{
-			MethodName: "UpcomingGauges",
-			Handler:    _Query_UpcomingGauges_Handler,
-		},
-		{
-			MethodName: "UpcomingGaugesPerDenom",
-			Handler:    _Query_UpcomingGaugesPerDenom_Handler,
-		},
-		{
-			MethodName: "RewardsEst",
-			Handler:    _Query_RewardsEst_Handler,
-		},
-		{
-			MethodName: "LockableDurations",
-			Handler:    _Query_LockableDurations_Handler,
-		},
-		{
-			MethodName: "AllGroups",
-			Handler:    _Query_AllGroups_Handler,
-		},
-		{
-			MethodName: "AllGroupsGauges",
-			Handler:    _Query_AllGroupsGauges_Handler,
-		},
-		{
-			MethodName: "AllGroupsWithGauge",
-			Handler:    _Query_AllGroupsWithGauge_Handler,
-		},
-		{
-			MethodName: "GroupByGroupGaugeID",
-			Handler:    _Query_GroupByGroupGaugeID_Handler,
-		},
-		{
-			MethodName: "CurrentWeightByGroupGaugeID",
-			Handler:    _Query_CurrentWeightByGroupGaugeID_Handler,
-		},
-		{
-			MethodName: "InternalGauges",
-			Handler:    _Query_InternalGauges_Handler,
-		},
-		{
-			MethodName: "ExternalGauges",
-			Handler:    _Query_ExternalGauges_Handler,
-		},
-		{
-			MethodName: "GaugesByPoolID",
-			Handler:    _Query_GaugesByPoolID_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/incentives/query.proto",
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ModuleToDistributeCoins returns coins that are going to be distributed
+	ModuleToDistributeCoins(context.Context, *ModuleToDistributeCoinsRequest) (*ModuleToDistributeCoinsResponse, error)
+	// GaugeByID returns gauges by their respective ID
+	GaugeByID(context.Context, *GaugeByIDRequest) (*GaugeByIDResponse, error)
+	// Gauges returns both upcoming and active gauges
+	Gauges(context.Context, *GaugesRequest) (*GaugesResponse, error)
+	// ActiveGauges returns active gauges
+	ActiveGauges(context.Context, *ActiveGaugesRequest) (*ActiveGaugesResponse, error)
+	// ActiveGaugesPerDenom returns active gauges by denom
+	ActiveGaugesPerDenom(context.Context, *ActiveGaugesPerDenomRequest) (*ActiveGaugesPerDenomResponse, error)
+	// Returns scheduled gauges that have not yet occurred
+	UpcomingGauges(context.Context, *UpcomingGaugesRequest) (*UpcomingGaugesResponse, error)
+	// UpcomingGaugesPerDenom returns scheduled gauges that have not yet occurred
+	// by denom
+	UpcomingGaugesPerDenom(context.Context, *UpcomingGaugesPerDenomRequest) (*UpcomingGaugesPerDenomResponse, error)
+	// RewardsEst returns an estimate of the rewards from now until a specified
+	// time in the future The querier either provides an address or a set of locks
+	// for which they want to find the associated rewards
+	RewardsEst(context.Context, *RewardsEstRequest) (*RewardsEstResponse, error)
+	// LockableDurations returns lockable durations that are valid to distribute
+	// incentives for
+	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
+	// AllGroups returns all groups
+	AllGroups(context.Context, *QueryAllGroupsRequest) (*QueryAllGroupsResponse, error)
+	// AllGroupsGauges returns all group gauges
+	AllGroupsGauges(context.Context, *QueryAllGroupsGaugesRequest) (*QueryAllGroupsGaugesResponse, error)
+	// AllGroupsWithGauge returns all groups with their group gauge
+	AllGroupsWithGauge(context.Context, *QueryAllGroupsWithGaugeRequest) (*QueryAllGroupsWithGaugeResponse, error)
+	// GroupByGroupGaugeID returns a group given its group gauge ID
+	GroupByGroupGaugeID(context.Context, *QueryGroupByGroupGaugeIDRequest) (*QueryGroupByGroupGaugeIDResponse, error)
+	// CurrentWeightByGroupGaugeID returns the current weight since the
+	// the last epoch given a group gauge ID
+	CurrentWeightByGroupGaugeID(context.Context, *QueryCurrentWeightByGroupGaugeIDRequest) (*QueryCurrentWeightByGroupGaugeIDResponse, error)
+	InternalGauges(context.Context, *QueryInternalGaugesRequest) (*QueryInternalGaugesResponse, error)
+	ExternalGauges(context.Context, *QueryExternalGaugesRequest) (*QueryExternalGaugesResponse, error)
+	GaugesByPoolID(context.Context, *QueryGaugesByPoolIDRequest) (*QueryGaugesByPoolIDResponse, error)
+	// LockRewards returns the rewards accrued to a lock that have not been
+	// claimed yet
+	LockRewards(context.Context, *QueryLockRewardsRequest) (*QueryLockRewardsResponse, error)
+	// UpcomingEmissions returns the projected per epoch emissions of all active
+	// and upcoming gauges attached to a pool
+	UpcomingEmissions(context.Context, *QueryUpcomingEmissionsRequest) (*QueryUpcomingEmissionsResponse, error)
+	// GaugesByRewardDenom returns the unfinished gauges whose rewards include
+	// the given denom, along with their remaining balance of it and their end
+	// epoch
+	GaugesByRewardDenom(context.Context, *QueryGaugesByRewardDenomRequest) (*QueryGaugesByRewardDenomResponse, error)
+	// Params returns incentives module params.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
 
-func (m *ModuleToDistributeCoinsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ModuleToDistributeCoinsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ModuleToDistributeCoins(ctx context.Context, req *ModuleToDistributeCoinsRequest) (*ModuleToDistributeCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleToDistributeCoins not implemented")
 }
-
-func (m *ModuleToDistributeCoinsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GaugeByID(ctx context.Context, req *GaugeByIDRequest) (*GaugeByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaugeByID not implemented")
 }
-
-func (m *ModuleToDistributeCoinsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Gauges(ctx context.Context, req *GaugesRequest) (*GaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
 }
-
-func (m *ModuleToDistributeCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ActiveGauges(ctx context.Context, req *ActiveGaugesRequest) (*ActiveGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveGauges not implemented")
 }
-
-func (m *ModuleToDistributeCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ActiveGaugesPerDenom(ctx context.Context, req *ActiveGaugesPerDenomRequest) (*ActiveGaugesPerDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveGaugesPerDenom not implemented")
 }
-
-func (m *GaugeByIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) UpcomingGauges(ctx context.Context, req *UpcomingGaugesRequest) (*UpcomingGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingGauges not implemented")
+}
+func (*UnimplementedQueryServer) UpcomingGaugesPerDenom(ctx context.Context, req *UpcomingGaugesPerDenomRequest) (*UpcomingGaugesPerDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingGaugesPerDenom not implemented")
+}
+func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEstRequest) (*RewardsEstResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsEst not implemented")
+}
+func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
+}
+func (*UnimplementedQueryServer) AllGroups(ctx context.Context, req *QueryAllGroupsRequest) (*QueryAllGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllGroups not implemented")
+}
+func (*UnimplementedQueryServer) AllGroupsGauges(ctx context.Context, req *QueryAllGroupsGaugesRequest) (*QueryAllGroupsGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllGroupsGauges not implemented")
+}
+func (*UnimplementedQueryServer) AllGroupsWithGauge(ctx context.Context, req *QueryAllGroupsWithGaugeRequest) (*QueryAllGroupsWithGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllGroupsWithGauge not implemented")
+}
+func (*UnimplementedQueryServer) GroupByGroupGaugeID(ctx context.Context, req *QueryGroupByGroupGaugeIDRequest) (*QueryGroupByGroupGaugeIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupByGroupGaugeID not implemented")
+}
+func (*UnimplementedQueryServer) CurrentWeightByGroupGaugeID(ctx context.Context, req *QueryCurrentWeightByGroupGaugeIDRequest) (*QueryCurrentWeightByGroupGaugeIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentWeightByGroupGaugeID not implemented")
+}
+func (*UnimplementedQueryServer) InternalGauges(ctx context.Context, req *QueryInternalGaugesRequest) (*QueryInternalGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalGauges not implemented")
+}
+func (*UnimplementedQueryServer) ExternalGauges(ctx context.Context, req *QueryExternalGaugesRequest) (*QueryExternalGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalGauges not implemented")
+}
+func (*UnimplementedQueryServer) GaugesByPoolID(ctx context.Context, req *QueryGaugesByPoolIDRequest) (*QueryGaugesByPoolIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaugesByPoolID not implemented")
+}
+func (*UnimplementedQueryServer) LockRewards(ctx context.Context, req *QueryLockRewardsRequest) (*QueryLockRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockRewards not implemented")
+}
+func (*UnimplementedQueryServer) UpcomingEmissions(ctx context.Context, req *QueryUpcomingEmissionsRequest) (*QueryUpcomingEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingEmissions not implemented")
+}
+func (*UnimplementedQueryServer) GaugesByRewardDenom(ctx context.Context, req *QueryGaugesByRewardDenomRequest) (*QueryGaugesByRewardDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaugesByRewardDenom not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *GaugeByIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *GaugeByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func _Query_ModuleToDistributeCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModuleToDistributeCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleToDistributeCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/ModuleToDistributeCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleToDistributeCoins(ctx, req.(*ModuleToDistributeCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GaugeByIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GaugeByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GaugeByIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GaugeByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/GaugeByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaugeByID(ctx, req.(*GaugeByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GaugeByIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GaugeByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		{
-			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Gauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/Gauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauges(ctx, req.(*GaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GaugesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ActiveGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveGaugesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GaugesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveGauges(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/ActiveGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveGauges(ctx, req.(*ActiveGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GaugesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ActiveGaugesPerDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveGaugesPerDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GaugesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveGaugesPerDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/ActiveGaugesPerDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveGaugesPerDenom(ctx, req.(*ActiveGaugesPerDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_UpcomingGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpcomingGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingGauges(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/UpcomingGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingGauges(ctx, req.(*UpcomingGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UpcomingGaugesPerDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpcomingGaugesPerDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingGaugesPerDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/UpcomingGaugesPerDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingGaugesPerDenom(ctx, req.(*UpcomingGaugesPerDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RewardsEst_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardsEstRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsEst(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/RewardsEst",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsEst(ctx, req.(*RewardsEstRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockableDurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LockableDurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/LockableDurations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockableDurations(ctx, req.(*QueryLockableDurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGroupsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/AllGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllGroups(ctx, req.(*QueryAllGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AllGroupsGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGroupsGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllGroupsGauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/AllGroupsGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllGroupsGauges(ctx, req.(*QueryAllGroupsGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_AllGroupsWithGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGroupsWithGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AllGroupsWithGauge(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/AllGroupsWithGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllGroupsWithGauge(ctx, req.(*QueryAllGroupsWithGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesPerDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GroupByGroupGaugeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupByGroupGaugeIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ActiveGaugesPerDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ActiveGaugesPerDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GroupByGroupGaugeID(ctx, in)
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/GroupByGroupGaugeID",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupByGroupGaugeID(ctx, req.(*QueryGroupByGroupGaugeIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesPerDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CurrentWeightByGroupGaugeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentWeightByGroupGaugeIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ActiveGaugesPerDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentWeightByGroupGaugeID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/CurrentWeightByGroupGaugeID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentWeightByGroupGaugeID(ctx, req.(*QueryCurrentWeightByGroupGaugeIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveGaugesPerDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_InternalGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInternalGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).InternalGauges(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/InternalGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InternalGauges(ctx, req.(*QueryInternalGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpcomingGaugesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ExternalGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalGaugesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalGauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/ExternalGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalGauges(ctx, req.(*QueryExternalGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpcomingGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GaugesByPoolID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesByPoolIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GaugesByPoolID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/GaugesByPoolID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaugesByPoolID(ctx, req.(*QueryGaugesByPoolIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpcomingGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_LockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LockRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/LockRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockRewards(ctx, req.(*QueryLockRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpcomingGaugesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UpcomingEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingEmissionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/UpcomingEmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingEmissions(ctx, req.(*QueryUpcomingEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpcomingGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GaugesByRewardDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesByRewardDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GaugesByRewardDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/GaugesByRewardDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaugesByRewardDenom(ctx, req.(*QueryGaugesByRewardDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpcomingGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpcomingGaugesPerDenomRequest) Marshal() (dAtA []byte, err error) {
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.incentives.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleToDistributeCoins",
+			Handler:    _Query_ModuleToDistributeCoins_Handler,
+		},
+		{
+			MethodName: "GaugeByID",
+			Handler:    _Query_GaugeByID_Handler,
+		},
+		{
+			MethodName: "Gauges",
+			Handler:    _Query_Gauges_Handler,
+		},
+		{
+			MethodName: "ActiveGauges",
+			Handler:    _Query_ActiveGauges_Handler,
+		},
+		{
+			MethodName: "ActiveGaugesPerDenom",
+			Handler:    _Query_ActiveGaugesPerDenom_Handler,
+		},
+		{
+			MethodName: "UpcomingGauges",
+			Handler:    _Query_UpcomingGauges_Handler,
+		},
+		{
+			MethodName: "UpcomingGaugesPerDenom",
+			Handler:    _Query_UpcomingGaugesPerDenom_Handler,
+		},
+		{
+			MethodName: "RewardsEst",
+			Handler:    _Query_RewardsEst_Handler,
+		},
+		{
+			MethodName: "LockableDurations",
+			Handler:    _Query_LockableDurations_Handler,
+		},
+		{
+			MethodName: "AllGroups",
+			Handler:    _Query_AllGroups_Handler,
+		},
+		{
+			MethodName: "AllGroupsGauges",
+			Handler:    _Query_AllGroupsGauges_Handler,
+		},
+		{
+			MethodName: "AllGroupsWithGauge",
+			Handler:    _Query_AllGroupsWithGauge_Handler,
+		},
+		{
+			MethodName: "GroupByGroupGaugeID",
+			Handler:    _Query_GroupByGroupGaugeID_Handler,
+		},
+		{
+			MethodName: "CurrentWeightByGroupGaugeID",
+			Handler:    _Query_CurrentWeightByGroupGaugeID_Handler,
+		},
+		{
+			MethodName: "InternalGauges",
+			Handler:    _Query_InternalGauges_Handler,
+		},
+		{
+			MethodName: "ExternalGauges",
+			Handler:    _Query_ExternalGauges_Handler,
+		},
+		{
+			MethodName: "GaugesByPoolID",
+			Handler:    _Query_GaugesByPoolID_Handler,
+		},
+		{
+			MethodName: "LockRewards",
+			Handler:    _Query_LockRewards_Handler,
+		},
+		{
+			MethodName: "UpcomingEmissions",
+			Handler:    _Query_UpcomingEmissions_Handler,
+		},
+		{
+			MethodName: "GaugesByRewardDenom",
+			Handler:    _Query_GaugesByRewardDenom_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/incentives/query.proto",
+}
+
+func (m *ModuleToDistributeCoinsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3120,39 +3243,20 @@ func (m *UpcomingGaugesPerDenomRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpcomingGaugesPerDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ModuleToDistributeCoinsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpcomingGaugesPerDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ModuleToDistributeCoinsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpcomingGaugesPerDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *ModuleToDistributeCoinsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3162,32 +3266,20 @@ func (m *UpcomingGaugesPerDenomResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpcomingGaugesPerDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ModuleToDistributeCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpcomingGaugesPerDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ModuleToDistributeCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UpcomingGauges) > 0 {
-		for iNdEx := len(m.UpcomingGauges) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UpcomingGauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3201,7 +3293,7 @@ func (m *UpcomingGaugesPerDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *RewardsEstRequest) Marshal() (dAtA []byte, err error) {
+func (m *GaugeByIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3211,50 +3303,25 @@ func (m *RewardsEstRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RewardsEstRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GaugeByIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardsEstRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GaugeByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EndEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.LockIds) > 0 {
-		dAtA13 := make([]byte, len(m.LockIds)*10)
-		var j12 int
-		for _, num := range m.LockIds {
-			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j12++
-			}
-			dAtA13[j12] = uint8(num)
-			j12++
-		}
-		i -= j12
-		copy(dAtA[i:], dAtA13[:j12])
-		i = encodeVarintQuery(dAtA, i, uint64(j12))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RewardsEstResponse) Marshal() (dAtA []byte, err error) {
+func (m *GaugeByIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3264,34 +3331,32 @@ func (m *RewardsEstResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RewardsEstResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GaugeByIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardsEstResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GaugeByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockableDurationsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GaugesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3301,47 +3366,73 @@ func (m *QueryLockableDurationsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockableDurationsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GaugesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockableDurationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryLockableDurationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockableDurationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GaugesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LockableDurations) > 0 {
-		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
-			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i -= n
-			i = encodeVarintQuery(dAtA, i, uint64(n))
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3349,7 +3440,7 @@ func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ActiveGaugesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3359,20 +3450,32 @@ func (m *QueryAllGroupsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActiveGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActiveGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ActiveGaugesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3382,20 +3485,32 @@ func (m *QueryAllGroupsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActiveGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActiveGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3409,7 +3524,7 @@ func (m *QueryAllGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsGaugesRequest) Marshal() (dAtA []byte, err error) {
+func (m *ActiveGaugesPerDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3419,20 +3534,39 @@ func (m *QueryAllGroupsGaugesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActiveGaugesPerDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGroupsGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActiveGaugesPerDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsGaugesResponse) Marshal() (dAtA []byte, err error) {
+func (m *ActiveGaugesPerDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3442,20 +3576,32 @@ func (m *QueryAllGroupsGaugesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActiveGaugesPerDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGroupsGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActiveGaugesPerDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Gauges) > 0 {
-		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3469,7 +3615,7 @@ func (m *QueryAllGroupsGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsWithGaugeRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpcomingGaugesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3479,20 +3625,32 @@ func (m *QueryAllGroupsWithGaugeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsWithGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpcomingGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGroupsWithGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpcomingGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsWithGaugeResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpcomingGaugesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3502,20 +3660,32 @@ func (m *QueryAllGroupsWithGaugeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsWithGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpcomingGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGroupsWithGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpcomingGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupsWithGauge) > 0 {
-		for iNdEx := len(m.GroupsWithGauge) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.GroupsWithGauge[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3529,7 +3699,7 @@ func (m *QueryAllGroupsWithGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupByGroupGaugeIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpcomingGaugesPerDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3539,25 +3709,39 @@ func (m *QueryGroupByGroupGaugeIDRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupByGroupGaugeIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpcomingGaugesPerDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupByGroupGaugeIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpcomingGaugesPerDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupByGroupGaugeIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpcomingGaugesPerDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3567,30 +3751,46 @@ func (m *QueryGroupByGroupGaugeIDResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupByGroupGaugeIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpcomingGaugesPerDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupByGroupGaugeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpcomingGaugesPerDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpcomingGauges) > 0 {
+		for iNdEx := len(m.UpcomingGauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpcomingGauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentWeightByGroupGaugeIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *RewardsEstRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3600,25 +3800,50 @@ func (m *QueryCurrentWeightByGroupGaugeIDRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentWeightByGroupGaugeIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardsEstRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentWeightByGroupGaugeIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardsEstRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupGaugeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GroupGaugeId))
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if len(m.LockIds) > 0 {
+		dAtA13 := make([]byte, len(m.LockIds)*10)
+		var j12 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentWeightByGroupGaugeIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *RewardsEstResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3628,20 +3853,20 @@ func (m *QueryCurrentWeightByGroupGaugeIDResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentWeightByGroupGaugeIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardsEstResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentWeightByGroupGaugeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardsEstResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GaugeWeight) > 0 {
-		for iNdEx := len(m.GaugeWeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.GaugeWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3655,7 +3880,7 @@ func (m *QueryCurrentWeightByGroupGaugeIDResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *GaugeWeight) Marshal() (dAtA []byte, err error) {
+func (m *QueryLockableDurationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3665,35 +3890,20 @@ func (m *GaugeWeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GaugeWeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLockableDurationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GaugeWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLockableDurationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.WeightRatio.Size()
-		i -= size
-		if _, err := m.WeightRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.GaugeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryInternalGaugesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLockableDurationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3703,32 +3913,32 @@ func (m *QueryInternalGaugesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryInternalGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLockableDurationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInternalGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+	if len(m.LockableDurations) > 0 {
+		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i -= n
+			i = encodeVarintQuery(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryInternalGaugesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGroupsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3738,32 +3948,43 @@ func (m *QueryInternalGaugesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryInternalGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInternalGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Gauges) > 0 {
-		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3777,7 +3998,7 @@ func (m *QueryInternalGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExternalGaugesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGroupsGaugesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3787,32 +4008,20 @@ func (m *QueryExternalGaugesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExternalGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExternalGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExternalGaugesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGroupsGaugesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3822,28 +4031,16 @@ func (m *QueryExternalGaugesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExternalGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExternalGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.Gauges) > 0 {
 		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3861,7 +4058,7 @@ func (m *QueryExternalGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGaugesByPoolIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGroupsWithGaugeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3871,37 +4068,20 @@ func (m *QueryGaugesByPoolIDRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGaugesByPoolIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsWithGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGaugesByPoolIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsWithGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGaugesByPoolIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGroupsWithGaugeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3911,32 +4091,20 @@ func (m *QueryGaugesByPoolIDResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGaugesByPoolIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsWithGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGaugesByPoolIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGroupsWithGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Gauges) > 0 {
-		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.GroupsWithGauge) > 0 {
+		for iNdEx := len(m.GroupsWithGauge) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GroupsWithGauge[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3950,7 +4118,7 @@ func (m *QueryGaugesByPoolIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGroupByGroupGaugeIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3960,20 +4128,25 @@ func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGroupByGroupGaugeIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGroupByGroupGaugeIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGroupByGroupGaugeIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3983,18 +4156,18 @@ func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGroupByGroupGaugeIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGroupByGroupGaugeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4006,505 +4179,822 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCurrentWeightByGroupGaugeIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ModuleToDistributeCoinsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryCurrentWeightByGroupGaugeIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModuleToDistributeCoinsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCurrentWeightByGroupGaugeIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.GroupGaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupGaugeId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GaugeByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryCurrentWeightByGroupGaugeIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GaugeByIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryCurrentWeightByGroupGaugeIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCurrentWeightByGroupGaugeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GaugeWeight) > 0 {
+		for iNdEx := len(m.GaugeWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GaugeWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.WeightRatio.Size()
+		i -= size
+		if _, err := m.WeightRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActiveGaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInternalGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInternalGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInternalGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActiveGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInternalGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInternalGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInternalGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActiveGaugesPerDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExternalGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActiveGaugesPerDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExternalGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpcomingGaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGaugesByPoolIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpcomingGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGaugesByPoolIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesByPoolIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpcomingGaugesPerDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGaugesByPoolIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpcomingGaugesPerDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UpcomingGauges) > 0 {
-		for _, e := range m.UpcomingGauges {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGaugesByPoolIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardsEstRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGaugesByPoolIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.LockIds) > 0 {
-		l = 0
-		for _, e := range m.LockIds {
-			l += sovQuery(uint64(e))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.EndEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.EndEpoch))
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RewardsEstResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryLockRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLockableDurationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryLockRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockableDurationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLockRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LockableDurations) > 0 {
-		for _, e := range m.LockableDurations {
-			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLockRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLockRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Groups) > 0 {
-		for _, e := range m.Groups {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsGaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUpcomingEmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUpcomingEmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingEmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Gauges) > 0 {
-		for _, e := range m.Gauges {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGroupsWithGaugeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUpcomingEmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllGroupsWithGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GroupsWithGauge) > 0 {
-		for _, e := range m.GroupsWithGauge {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryUpcomingEmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupByGroupGaugeIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUpcomingEmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if len(m.Emissions) > 0 {
+		for iNdEx := len(m.Emissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Emissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupByGroupGaugeIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGaugesByRewardDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Group.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentWeightByGroupGaugeIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupGaugeId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupGaugeId))
-	}
-	return n
+func (m *QueryGaugesByRewardDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentWeightByGroupGaugeIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGaugesByRewardDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GaugeWeight) > 0 {
-		for _, e := range m.GaugeWeight {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *GaugeWeight) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ActiveOnly {
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.GaugeId != 0 {
-		n += 1 + sovQuery(uint64(m.GaugeId))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.WeightRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInternalGaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGaugesByRewardDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryInternalGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGaugesByRewardDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesByRewardDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Gauges) > 0 {
-		for _, e := range m.Gauges {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExternalGaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GaugeRewardDenomInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryExternalGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GaugeRewardDenomInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeRewardDenomInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Gauges) > 0 {
-		for _, e := range m.Gauges {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGaugesByPoolIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EpochEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DistributionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DistributionTime):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintQuery(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ModuleToDistributeCoinsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModuleToDistributeCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Id != 0 {
 		n += 1 + sovQuery(uint64(m.Id))
 	}
+	return n
+}
+
+func (m *GaugeByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -4512,52 +5002,1112 @@ func (m *QueryGaugesByPoolIDRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGaugesByPoolIDResponse) Size() (n int) {
+func (m *GaugesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Gauges) > 0 {
-		for _, e := range m.Gauges {
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveGaugesPerDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveGaugesPerDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UpcomingGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UpcomingGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UpcomingGaugesPerDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UpcomingGaugesPerDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UpcomingGauges) > 0 {
+		for _, e := range m.UpcomingGauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardsEstRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *RewardsEstResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockableDurationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockableDurationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockableDurations) > 0 {
+		for _, e := range m.LockableDurations {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllGroupsGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllGroupsGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllGroupsWithGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllGroupsWithGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupsWithGauge) > 0 {
+		for _, e := range m.GroupsWithGauge {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupByGroupGaugeIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGroupByGroupGaugeIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Group.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentWeightByGroupGaugeIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupGaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupGaugeId))
+	}
+	return n
+}
+
+func (m *QueryCurrentWeightByGroupGaugeIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GaugeWeight) > 0 {
+		for _, e := range m.GaugeWeight {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	l = m.WeightRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInternalGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInternalGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugesByPoolIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugesByPoolIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *QueryLockRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUpcomingEmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *QueryUpcomingEmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Emissions) > 0 {
+		for _, e := range m.Emissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGaugesByRewardDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugesByRewardDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GaugeRewardDenomInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gauge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *EpochEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DistributionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleToDistributeCoinsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleToDistributeCoinsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleToDistributeCoinsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleToDistributeCoinsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleToDistributeCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Gauge{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
+func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4580,12 +6130,48 @@ func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleToDistributeCoinsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleToDistributeCoinsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4607,7 +6193,7 @@ func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleToDistributeCoinsResponse) Unmarshal(dAtA []byte) error {
+func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4630,15 +6216,15 @@ func (m *ModuleToDistributeCoinsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleToDistributeCoinsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveGaugesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleToDistributeCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4665,8 +6251,44 @@ func (m *ModuleToDistributeCoinsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, Gauge{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4691,7 +6313,7 @@ func (m *ModuleToDistributeCoinsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4714,17 +6336,17 @@ func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugeByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveGaugesPerDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugeByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveGaugesPerDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4734,11 +6356,60 @@ func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4760,7 +6431,7 @@ func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
+func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4783,15 +6454,49 @@ func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugeByIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveGaugesPerDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugeByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveGaugesPerDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Gauge{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4818,10 +6523,10 @@ func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4846,7 +6551,7 @@ func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4869,10 +6574,10 @@ func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4932,7 +6637,7 @@ func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4955,10 +6660,10 @@ func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingGaugesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5052,7 +6757,7 @@ func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5075,13 +6780,45 @@ func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingGaugesPerDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingGaugesPerDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5138,7 +6875,7 @@ func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5161,15 +6898,15 @@ func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingGaugesPerDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingGaugesPerDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingGauges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5196,8 +6933,8 @@ func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, Gauge{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UpcomingGauges = append(m.UpcomingGauges, Gauge{})
+			if err := m.UpcomingGauges[len(m.UpcomingGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5258,7 +6995,7 @@ func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5281,15 +7018,15 @@ func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveGaugesPerDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsEstRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveGaugesPerDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsEstRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5317,13 +7054,89 @@ func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
 			}
-			var msglen int
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5333,28 +7146,11 @@ func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EndEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5376,7 +7172,7 @@ func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5399,15 +7195,15 @@ func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveGaugesPerDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsEstResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveGaugesPerDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsEstResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5434,47 +7230,61 @@ func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, Gauge{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockableDurationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockableDurationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockableDurationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5496,7 +7306,7 @@ func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5519,15 +7329,15 @@ func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockableDurationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockableDurationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5554,10 +7364,8 @@ func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5582,7 +7390,7 @@ func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5605,49 +7413,65 @@ func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, Gauge{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5674,10 +7498,8 @@ func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Groups = append(m.Groups, Group{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5702,7 +7524,7 @@ func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGroupsGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5725,47 +7547,65 @@ func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingGaugesPerDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGroupsGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingGaugesPerDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGroupsGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGroupsGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGroupsGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGroupsGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5792,10 +7632,8 @@ func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5820,7 +7658,7 @@ func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGroupsWithGaugeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5843,49 +7681,65 @@ func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingGaugesPerDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGroupsWithGaugeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingGaugesPerDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGroupsWithGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingGauges", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpcomingGauges = append(m.UpcomingGauges, Gauge{})
-			if err := m.UpcomingGauges[len(m.UpcomingGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGroupsWithGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGroupsWithGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGroupsWithGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsWithGauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5912,10 +7766,8 @@ func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GroupsWithGauge = append(m.GroupsWithGauge, GroupsWithGauge{})
+			if err := m.GroupsWithGauge[len(m.GroupsWithGauge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5940,7 +7792,7 @@ func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5963,125 +7815,17 @@ func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsEstRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupByGroupGaugeIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsEstRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupByGroupGaugeIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.LockIds = append(m.LockIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.LockIds) == 0 {
-					m.LockIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LockIds = append(m.LockIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
-			}
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.EndEpoch = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6091,7 +7835,7 @@ func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndEpoch |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6117,7 +7861,7 @@ func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6140,15 +7884,15 @@ func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsEstResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupByGroupGaugeIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsEstResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupByGroupGaugeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6175,8 +7919,7 @@ func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6201,7 +7944,7 @@ func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockableDurationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentWeightByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6224,12 +7967,31 @@ func (m *QueryLockableDurationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockableDurationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentWeightByGroupGaugeIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockableDurationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentWeightByGroupGaugeIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupGaugeId", wireType)
+			}
+			m.GroupGaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupGaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6251,7 +8013,7 @@ func (m *QueryLockableDurationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentWeightByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6274,15 +8036,15 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockableDurationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentWeightByGroupGaugeIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockableDurationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentWeightByGroupGaugeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeWeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6309,8 +8071,8 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+			m.GaugeWeight = append(m.GaugeWeight, GaugeWeight{})
+			if err := m.GaugeWeight[len(m.GaugeWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6335,7 +8097,7 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGroupsRequest) Unmarshal(dAtA []byte) error {
+func (m *GaugeWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6358,12 +8120,65 @@ func (m *QueryAllGroupsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GaugeWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GaugeWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6385,7 +8200,7 @@ func (m *QueryAllGroupsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInternalGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6408,15 +8223,15 @@ func (m *QueryAllGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInternalGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInternalGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6443,8 +8258,10 @@ func (m *QueryAllGroupsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, Group{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6469,7 +8286,7 @@ func (m *QueryAllGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGroupsGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInternalGaugesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6492,12 +8309,82 @@ func (m *QueryAllGroupsGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGroupsGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInternalGaugesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGroupsGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInternalGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6519,7 +8406,7 @@ func (m *QueryAllGroupsGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGroupsGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6542,15 +8429,15 @@ func (m *QueryAllGroupsGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGroupsGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGroupsGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6577,8 +8464,10 @@ func (m *QueryAllGroupsGaugesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gauges = append(m.Gauges, Gauge{})
-			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6603,7 +8492,7 @@ func (m *QueryAllGroupsGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGroupsWithGaugeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalGaugesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6626,12 +8515,82 @@ func (m *QueryAllGroupsWithGaugeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGroupsWithGaugeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalGaugesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGroupsWithGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6653,7 +8612,7 @@ func (m *QueryAllGroupsWithGaugeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGroupsWithGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesByPoolIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6676,15 +8635,34 @@ func (m *QueryAllGroupsWithGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGroupsWithGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesByPoolIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGroupsWithGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesByPoolIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupsWithGauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6711,8 +8689,10 @@ func (m *QueryAllGroupsWithGaugeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupsWithGauge = append(m.GroupsWithGauge, GroupsWithGauge{})
-			if err := m.GroupsWithGauge[len(m.GroupsWithGauge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6737,7 +8717,7 @@ func (m *QueryAllGroupsWithGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesByPoolIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6760,17 +8740,17 @@ func (m *QueryGroupByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupByGroupGaugeIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesByPoolIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupByGroupGaugeIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesByPoolIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6780,64 +8760,29 @@ func (m *QueryGroupByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupByGroupGaugeIDResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupByGroupGaugeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6864,7 +8809,10 @@ func (m *QueryGroupByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6889,7 +8837,7 @@ func (m *QueryGroupByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentWeightByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6912,17 +8860,17 @@ func (m *QueryCurrentWeightByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentWeightByGroupGaugeIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentWeightByGroupGaugeIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupGaugeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			m.GroupGaugeId = 0
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6932,7 +8880,7 @@ func (m *QueryCurrentWeightByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupGaugeId |= uint64(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6958,7 +8906,7 @@ func (m *QueryCurrentWeightByGroupGaugeIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentWeightByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6981,15 +8929,15 @@ func (m *QueryCurrentWeightByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentWeightByGroupGaugeIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentWeightByGroupGaugeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GaugeWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7016,8 +8964,8 @@ func (m *QueryCurrentWeightByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GaugeWeight = append(m.GaugeWeight, GaugeWeight{})
-			if err := m.GaugeWeight[len(m.GaugeWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7042,7 +8990,7 @@ func (m *QueryCurrentWeightByGroupGaugeIDResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GaugeWeight) Unmarshal(dAtA []byte) error {
+func (m *QueryUpcomingEmissionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7065,17 +9013,17 @@ func (m *GaugeWeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugeWeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpcomingEmissionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugeWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpcomingEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.GaugeId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7085,16 +9033,16 @@ func (m *GaugeWeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GaugeId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WeightRatio", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
 			}
-			var stringLen uint64
+			m.NumEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7104,26 +9052,11 @@ func (m *GaugeWeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WeightRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7145,7 +9078,7 @@ func (m *GaugeWeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInternalGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpcomingEmissionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7168,15 +9101,15 @@ func (m *QueryInternalGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInternalGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpcomingEmissionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInternalGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpcomingEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emissions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7203,10 +9136,8 @@ func (m *QueryInternalGaugesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Emissions = append(m.Emissions, EpochEmission{})
+			if err := m.Emissions[len(m.Emissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7231,7 +9162,7 @@ func (m *QueryInternalGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInternalGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesByRewardDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7254,17 +9185,17 @@ func (m *QueryInternalGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInternalGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesByRewardDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInternalGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesByRewardDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7274,31 +9205,29 @@ func (m *QueryInternalGaugesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gauges = append(m.Gauges, Gauge{})
-			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7308,79 +9237,13 @@ func (m *QueryInternalGaugesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryExternalGaugesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExternalGaugesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExternalGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ActiveOnly = bool(v != 0)
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7437,7 +9300,7 @@ func (m *QueryExternalGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExternalGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesByRewardDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7460,10 +9323,10 @@ func (m *QueryExternalGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExternalGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesByRewardDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExternalGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesByRewardDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7495,7 +9358,7 @@ func (m *QueryExternalGaugesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gauges = append(m.Gauges, Gauge{})
+			m.Gauges = append(m.Gauges, GaugeRewardDenomInfo{})
 			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -7557,7 +9420,7 @@ func (m *QueryExternalGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGaugesByPoolIDRequest) Unmarshal(dAtA []byte) error {
+func (m *GaugeRewardDenomInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7580,17 +9443,17 @@ func (m *QueryGaugesByPoolIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGaugesByPoolIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GaugeRewardDenomInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGaugesByPoolIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GaugeRewardDenomInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7600,14 +9463,28 @@ func (m *QueryGaugesByPoolIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7634,13 +9511,29 @@ func (m *QueryGaugesByPoolIDRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7662,7 +9555,7 @@ func (m *QueryGaugesByPoolIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGaugesByPoolIDResponse) Unmarshal(dAtA []byte) error {
+func (m *EpochEmission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7685,15 +9578,34 @@ func (m *QueryGaugesByPoolIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGaugesByPoolIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochEmission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGaugesByPoolIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochEmission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7720,14 +9632,13 @@ func (m *QueryGaugesByPoolIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gauges = append(m.Gauges, Gauge{})
-			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DistributionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7754,10 +9665,8 @@ func (m *QueryGaugesByPoolIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11,6 +11,14 @@ var (
 	// * group_gauge_id - the ID of the group gauge
 	// * err - error
 	SyncGroupGaugeFailureMetricName = formatIncentivesMetricName("incentives_group_gauge_sync_failure")
+
+	// incentives_reward_send_failure
+	//
+	// counter that is increased for each denom of the rewards of a lock whose send fails, for denoms with a before send hook
+	//
+	// Has the following labels:
+	// * denom - the denom of the rewards
+	RewardSendFailureMetricName = formatIncentivesMetricName("incentives_reward_send_failure")
 )
 
 // formatIncentivesMetricName formats the incentives module metric name.
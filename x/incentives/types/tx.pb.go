@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
@@ -65,6 +66,25 @@ type MsgCreateGauge struct {
 	// incentivestypes.NoLockExternalGaugeDenom(<pool-id>) so that the gauges
 	// associated with a pool can be queried by this prefix if needed.
 	PoolId uint64 `protobuf:"varint,7,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// recipient_allowlist is an optional list of lock reward receiver addresses
+	// that are the only ones allowed to receive distributions from the gauge.
+	// Only supported for gauges distributing to locks by duration.
+	// Cannot be set together with recipient_denylist.
+	RecipientAllowlist []string `protobuf:"bytes,8,rep,name=recipient_allowlist,json=recipientAllowlist,proto3" json:"recipient_allowlist,omitempty" yaml:"recipient_allowlist"`
+	// recipient_denylist is an optional list of lock reward receiver addresses
+	// that are excluded from distributions of the gauge.
+	// Only supported for gauges distributing to locks by duration.
+	// Cannot be set together with recipient_allowlist.
+	RecipientDenylist []string `protobuf:"bytes,9,rep,name=recipient_denylist,json=recipientDenylist,proto3" json:"recipient_denylist,omitempty" yaml:"recipient_denylist"`
+	// min_lock_amount is an optional minimum amount of the distributed denom a
+	// lock must hold to receive distributions from the gauge.
+	// Only supported for gauges distributing to locks by duration.
+	MinLockAmount *cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=min_lock_amount,json=minLockAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_lock_amount,omitempty" yaml:"min_lock_amount"`
+	// align_start_to_epoch moves start_time forward to the next boundary of the
+	// distribution epoch, which is when the gauge first distributes. When set,
+	// start_time must not be further in the past than
+	// MaxGaugeStartTimePastTolerance.
+	AlignStartToEpoch bool `protobuf:"varint,11,opt,name=align_start_to_epoch,json=alignStartToEpoch,proto3" json:"align_start_to_epoch,omitempty" yaml:"align_start_to_epoch"`
 }
 
 func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
@@ -149,6 +169,27 @@ func (m *MsgCreateGauge) GetPoolId() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGauge) GetRecipientAllowlist() []string {
+	if m != nil {
+		return m.RecipientAllowlist
+	}
+	return nil
+}
+
+func (m *MsgCreateGauge) GetRecipientDenylist() []string {
+	if m != nil {
+		return m.RecipientDenylist
+	}
+	return nil
+}
+
+func (m *MsgCreateGauge) GetAlignStartToEpoch() bool {
+	if m != nil {
+		return m.AlignStartToEpoch
+	}
+	return false
+}
+
 type MsgCreateGaugeResponse struct {
 }
 
@@ -285,6 +326,118 @@ func (m *MsgAddToGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToGaugeResponse proto.InternalMessageInfo
 
+// MsgAddToGaugeAndExtend adds coins to a previously created non-perpetual
+// gauge and extends the number of epochs it is paid over, as long as the
+// gauge's emission per epoch does not decrease
+type MsgAddToGaugeAndExtend struct {
+	// owner is the address of the account adding to the gauge
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// gauge_id is the ID of gauge that rewards are getting added to
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// rewards are the coin(s) to add to gauge
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// additional_epochs is the number of epochs to add to the gauge's
+	// num_epochs_paid_over
+	AdditionalEpochs uint64 `protobuf:"varint,4,opt,name=additional_epochs,json=additionalEpochs,proto3" json:"additional_epochs,omitempty"`
+}
+
+func (m *MsgAddToGaugeAndExtend) Reset()         { *m = MsgAddToGaugeAndExtend{} }
+func (m *MsgAddToGaugeAndExtend) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToGaugeAndExtend) ProtoMessage()    {}
+func (*MsgAddToGaugeAndExtend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{4}
+}
+func (m *MsgAddToGaugeAndExtend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToGaugeAndExtend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToGaugeAndExtend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddToGaugeAndExtend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToGaugeAndExtend.Merge(m, src)
+}
+func (m *MsgAddToGaugeAndExtend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToGaugeAndExtend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToGaugeAndExtend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddToGaugeAndExtend proto.InternalMessageInfo
+
+func (m *MsgAddToGaugeAndExtend) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgAddToGaugeAndExtend) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *MsgAddToGaugeAndExtend) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *MsgAddToGaugeAndExtend) GetAdditionalEpochs() uint64 {
+	if m != nil {
+		return m.AdditionalEpochs
+	}
+	return 0
+}
+
+type MsgAddToGaugeAndExtendResponse struct {
+}
+
+func (m *MsgAddToGaugeAndExtendResponse) Reset()         { *m = MsgAddToGaugeAndExtendResponse{} }
+func (m *MsgAddToGaugeAndExtendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToGaugeAndExtendResponse) ProtoMessage()    {}
+func (*MsgAddToGaugeAndExtendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{5}
+}
+func (m *MsgAddToGaugeAndExtendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToGaugeAndExtendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToGaugeAndExtendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddToGaugeAndExtendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToGaugeAndExtendResponse.Merge(m, src)
+}
+func (m *MsgAddToGaugeAndExtendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToGaugeAndExtendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToGaugeAndExtendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddToGaugeAndExtendResponse proto.InternalMessageInfo
+
 // MsgCreateGroup creates a group to distribute rewards to a group of pools
 type MsgCreateGroup struct {
 	// coins are the provided coins that the group will distribute
@@ -296,13 +449,18 @@ type MsgCreateGroup struct {
 	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	// pool_ids are the IDs of pools that the group is comprised of
 	PoolIds []uint64 `protobuf:"varint,4,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	// splitting_policy determines how the group's incentives are split across
+	// its pools
+	SplittingPolicy SplittingPolicy `protobuf:"varint,5,opt,name=splitting_policy,json=splittingPolicy,proto3,enum=osmosis.incentives.SplittingPolicy" json:"splitting_policy,omitempty"`
+	// emission_decay tapers the group's per-epoch emission if set
+	EmissionDecay *EmissionDecay `protobuf:"bytes,6,opt,name=emission_decay,json=emissionDecay,proto3" json:"emission_decay,omitempty"`
 }
 
 func (m *MsgCreateGroup) Reset()         { *m = MsgCreateGroup{} }
 func (m *MsgCreateGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGroup) ProtoMessage()    {}
 func (*MsgCreateGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ea120e22291556e, []int{4}
+	return fileDescriptor_8ea120e22291556e, []int{6}
 }
 func (m *MsgCreateGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,6 +517,20 @@ func (m *MsgCreateGroup) GetPoolIds() []uint64 {
 	return nil
 }
 
+func (m *MsgCreateGroup) GetSplittingPolicy() SplittingPolicy {
+	if m != nil {
+		return m.SplittingPolicy
+	}
+	return ByVolume
+}
+
+func (m *MsgCreateGroup) GetEmissionDecay() *EmissionDecay {
+	if m != nil {
+		return m.EmissionDecay
+	}
+	return nil
+}
+
 type MsgCreateGroupResponse struct {
 	// group_id is the ID of the group that is created from this msg
 	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
@@ -368,7 +540,7 @@ func (m *MsgCreateGroupResponse) Reset()         { *m = MsgCreateGroupResponse{}
 func (m *MsgCreateGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGroupResponse) ProtoMessage()    {}
 func (*MsgCreateGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ea120e22291556e, []int{5}
+	return fileDescriptor_8ea120e22291556e, []int{7}
 }
 func (m *MsgCreateGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,66 +576,195 @@ func (m *MsgCreateGroupResponse) GetGroupId() uint64 {
 	return 0
 }
 
+// MsgClaimRewards claims the rewards accrued to the given locks and sends
+// them to each lock's reward receiver
+type MsgClaimRewards struct {
+	// owner is the address of the owner of the locks
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// lock_ids are the IDs of the locks to claim rewards for
+	LockIds []uint64 `protobuf:"varint,2,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{8}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgClaimRewards) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+type MsgClaimRewardsResponse struct {
+	// claimed_rewards are the rewards claimed across all given locks
+	ClaimedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed_rewards,json=claimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_rewards"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{9}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetClaimedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
 	proto.RegisterType((*MsgAddToGauge)(nil), "osmosis.incentives.MsgAddToGauge")
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
+	proto.RegisterType((*MsgAddToGaugeAndExtend)(nil), "osmosis.incentives.MsgAddToGaugeAndExtend")
+	proto.RegisterType((*MsgAddToGaugeAndExtendResponse)(nil), "osmosis.incentives.MsgAddToGaugeAndExtendResponse")
 	proto.RegisterType((*MsgCreateGroup)(nil), "osmosis.incentives.MsgCreateGroup")
 	proto.RegisterType((*MsgCreateGroupResponse)(nil), "osmosis.incentives.MsgCreateGroupResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "osmosis.incentives.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "osmosis.incentives.MsgClaimRewardsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x4f, 0xdb, 0x4c,
-	0x18, 0x8e, 0x93, 0x40, 0xe0, 0x02, 0x9f, 0xc0, 0xe2, 0xfb, 0x30, 0xf9, 0x2a, 0x27, 0x78, 0xa8,
-	0x52, 0xa4, 0xdc, 0x95, 0x20, 0x31, 0xb0, 0x35, 0xa8, 0xaa, 0x32, 0xa0, 0x52, 0x0b, 0xa9, 0x12,
-	0x52, 0x15, 0x5d, 0xec, 0xab, 0x39, 0x11, 0xfb, 0x2c, 0xdf, 0x39, 0xc0, 0xda, 0xb1, 0x13, 0x5b,
-	0xc7, 0xee, 0x9d, 0xf8, 0x33, 0x18, 0x19, 0x3b, 0x54, 0x50, 0xc1, 0x80, 0xba, 0xf2, 0x17, 0x54,
-	0x77, 0xb6, 0xf3, 0x43, 0x85, 0xa6, 0x95, 0xda, 0x25, 0x97, 0xf7, 0x7d, 0xde, 0x7b, 0xfd, 0xde,
-	0xf3, 0x3c, 0x3e, 0x83, 0xff, 0x19, 0xf7, 0x19, 0xa7, 0x1c, 0xd1, 0xc0, 0x21, 0x81, 0xa0, 0x7d,
-	0xc2, 0x91, 0x38, 0x86, 0x61, 0xc4, 0x04, 0xd3, 0xf5, 0x14, 0x84, 0x43, 0xb0, 0xb2, 0xe4, 0x31,
-	0x8f, 0x29, 0x18, 0xc9, 0x7f, 0x49, 0x65, 0x65, 0x11, 0xfb, 0x34, 0x60, 0x48, 0xfd, 0xa6, 0xa9,
-	0xaa, 0xc7, 0x98, 0xd7, 0x23, 0x48, 0x45, 0xdd, 0xf8, 0x2d, 0x12, 0xd4, 0x27, 0x5c, 0x60, 0x3f,
-	0x4c, 0x0b, 0x4c, 0x47, 0xb5, 0x47, 0x5d, 0xcc, 0x09, 0xea, 0xaf, 0x77, 0x89, 0xc0, 0xeb, 0xc8,
-	0x61, 0x34, 0xc8, 0xf0, 0x7b, 0x46, 0xf3, 0x70, 0xec, 0x91, 0x14, 0x5f, 0xc9, 0xf0, 0x1e, 0x73,
-	0x0e, 0xe3, 0x50, 0x2d, 0x29, 0xb4, 0x9c, 0xb6, 0xf6, 0xb9, 0x87, 0xfa, 0xeb, 0x72, 0x49, 0x00,
-	0xeb, 0x4b, 0x01, 0xfc, 0xb3, 0xc3, 0xbd, 0xed, 0x88, 0x60, 0x41, 0x5e, 0xc8, 0x66, 0xfa, 0x2a,
-	0x98, 0xa3, 0xbc, 0x13, 0x92, 0x28, 0x24, 0x22, 0xc6, 0x3d, 0x43, 0xab, 0x69, 0xf5, 0x19, 0xbb,
-	0x4c, 0xf9, 0x6e, 0x96, 0xd2, 0x1f, 0x83, 0x29, 0x76, 0x14, 0x90, 0xc8, 0xc8, 0xd7, 0xb4, 0xfa,
-	0x6c, 0x6b, 0xe1, 0xee, 0xb2, 0x3a, 0x77, 0x82, 0xfd, 0xde, 0x96, 0xa5, 0xd2, 0x96, 0x9d, 0xc0,
-	0x7a, 0x1b, 0xcc, 0xbb, 0x94, 0x8b, 0x88, 0x76, 0x63, 0x41, 0x3a, 0x82, 0x19, 0x85, 0x9a, 0x56,
-	0x2f, 0x37, 0x4d, 0x98, 0xf1, 0x98, 0x4c, 0x0a, 0x5f, 0xc5, 0x24, 0x3a, 0xd9, 0x66, 0x81, 0x4b,
-	0x05, 0x65, 0x41, 0xab, 0x78, 0x7e, 0x59, 0xcd, 0xd9, 0x73, 0xc3, 0xad, 0x7b, 0x4c, 0xc7, 0x60,
-	0x4a, 0x52, 0xc1, 0x8d, 0x62, 0xad, 0x50, 0x2f, 0x37, 0x57, 0x60, 0x72, 0x22, 0x28, 0xc9, 0x82,
-	0x29, 0x59, 0x70, 0x9b, 0xd1, 0xa0, 0xf5, 0x54, 0xee, 0xfe, 0x74, 0x55, 0xad, 0x7b, 0x54, 0x1c,
-	0xc4, 0x5d, 0xe8, 0x30, 0x1f, 0xa5, 0xc7, 0x4f, 0x96, 0x06, 0x77, 0x0f, 0x91, 0x38, 0x09, 0x09,
-	0x57, 0x1b, 0xb8, 0x9d, 0x74, 0xd6, 0x5f, 0x03, 0xc0, 0x05, 0x8e, 0x44, 0x47, 0x0a, 0x63, 0x4c,
-	0xa9, 0x51, 0x2b, 0x30, 0x51, 0x0d, 0x66, 0xaa, 0xc1, 0xbd, 0x4c, 0xb5, 0xd6, 0x23, 0xf9, 0xa0,
-	0xbb, 0xcb, 0xea, 0x42, 0x72, 0xf4, 0x81, 0x9c, 0xd6, 0xe9, 0x55, 0x55, 0xb3, 0x67, 0x55, 0x2f,
-	0x59, 0xad, 0x23, 0xb0, 0x14, 0xc4, 0x7e, 0x87, 0x84, 0xcc, 0x39, 0xe0, 0x9d, 0x10, 0x53, 0xb7,
-	0xc3, 0xfa, 0x24, 0x32, 0xa6, 0x6b, 0x5a, 0xbd, 0x68, 0x2f, 0x06, 0xb1, 0xff, 0x5c, 0x41, 0xbb,
-	0x98, 0xba, 0x2f, 0xfb, 0x24, 0xd2, 0x97, 0x41, 0x29, 0x64, 0xac, 0xd7, 0xa1, 0xae, 0x51, 0x52,
-	0x35, 0xd3, 0x32, 0x6c, 0xbb, 0x5b, 0xf0, 0xdd, 0xed, 0xd9, 0x5a, 0x42, 0xee, 0xfb, 0xdb, 0xb3,
-	0xb5, 0xea, 0x3d, 0x8e, 0x70, 0x94, 0x94, 0x0d, 0x65, 0x0c, 0xcb, 0x00, 0xff, 0x8d, 0xab, 0x6b,
-	0x13, 0x1e, 0xb2, 0x80, 0x13, 0xeb, 0x9b, 0x06, 0xe6, 0x77, 0xb8, 0xf7, 0xcc, 0x75, 0xf7, 0x58,
-	0xa2, 0xfb, 0x40, 0x54, 0xed, 0xe7, 0xa2, 0xae, 0x80, 0x19, 0xd5, 0x5c, 0x4e, 0x97, 0x57, 0xd3,
-	0x95, 0x54, 0xdc, 0x76, 0x75, 0x02, 0x4a, 0x11, 0x39, 0xc2, 0x91, 0xcb, 0x8d, 0xc2, 0x9f, 0x97,
-	0x29, 0xeb, 0xfd, 0x2b, 0x2c, 0x60, 0xd7, 0x6d, 0x08, 0x96, 0xb2, 0xb0, 0x0c, 0xfe, 0x1d, 0x3b,
-	0xea, 0x80, 0x84, 0x0f, 0xf9, 0x51, 0xf7, 0x47, 0x2c, 0x0e, 0x87, 0x3e, 0xd3, 0xfe, 0x9a, 0xcf,
-	0x1e, 0xb2, 0x43, 0xfe, 0x21, 0x3b, 0x0c, 0x94, 0x29, 0x4c, 0x54, 0x26, 0xb5, 0x4d, 0xf2, 0x9a,
-	0x14, 0xed, 0x52, 0xe2, 0x1b, 0xfe, 0x3b, 0xc6, 0x91, 0x34, 0x58, 0x1b, 0xa3, 0xc6, 0x91, 0x99,
-	0x8c, 0x33, 0x25, 0xbf, 0x4c, 0x48, 0xf9, 0xb5, 0x54, 0x7e, 0x19, 0xb7, 0xdd, 0xe6, 0xc7, 0x3c,
-	0x28, 0xec, 0x70, 0x4f, 0x7f, 0x03, 0xca, 0xa3, 0x17, 0x8a, 0x05, 0x7f, 0xbc, 0x36, 0xe1, 0xb8,
-	0x2d, 0x2b, 0x6b, 0x93, 0x6b, 0x06, 0x13, 0xec, 0x03, 0x30, 0x62, 0xdb, 0xd5, 0x07, 0x76, 0x0e,
-	0x4b, 0x2a, 0x4f, 0x26, 0x96, 0x0c, 0x7a, 0x0f, 0x47, 0x57, 0x6e, 0x98, 0x30, 0xba, 0xac, 0x99,
-	0x34, 0xfa, 0x28, 0x79, 0xad, 0xdd, 0xf3, 0x6b, 0x53, 0xbb, 0xb8, 0x36, 0xb5, 0xaf, 0xd7, 0xa6,
-	0x76, 0x7a, 0x63, 0xe6, 0x2e, 0x6e, 0xcc, 0xdc, 0xe7, 0x1b, 0x33, 0xb7, 0xbf, 0x39, 0xe2, 0xa2,
-	0xb4, 0x5f, 0xa3, 0x87, 0xbb, 0x3c, 0x0b, 0x50, 0xbf, 0xb9, 0x89, 0x8e, 0xc7, 0xbe, 0x4a, 0xd2,
-	0x59, 0xdd, 0x69, 0x75, 0x31, 0x6d, 0x7c, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x9f, 0x3c, 0xb6,
-	0xb8, 0x06, 0x00, 0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x2d, 0xc7, 0x8e, 0x57, 0xfe, 0x64, 0x1c, 0x9b, 0x56, 0xde, 0x57, 0x94, 0x69, 0xa0,
+	0x50, 0x1d, 0x98, 0xac, 0x65, 0x20, 0x07, 0xdf, 0x2c, 0xc7, 0x68, 0x05, 0xc4, 0x8d, 0xcb, 0x18,
+	0x28, 0x90, 0xa2, 0x60, 0x57, 0xe2, 0x96, 0x5e, 0x98, 0xdc, 0x25, 0xb8, 0x4b, 0xd9, 0xba, 0x16,
+	0xe8, 0xa5, 0x97, 0xe6, 0x3f, 0xf4, 0x96, 0x53, 0xfa, 0x2f, 0x72, 0xcc, 0xb1, 0xe8, 0x41, 0x29,
+	0xec, 0x43, 0xd0, 0xab, 0x4e, 0x3d, 0x16, 0xbb, 0xfc, 0x90, 0xe4, 0xca, 0x91, 0x03, 0x34, 0x87,
+	0x5e, 0x4c, 0xef, 0xcc, 0xb3, 0x33, 0xc3, 0x67, 0x66, 0x1e, 0x11, 0x3c, 0xa0, 0x2c, 0xa0, 0x0c,
+	0x33, 0x0b, 0x93, 0x16, 0x22, 0x1c, 0xb7, 0x11, 0xb3, 0xf8, 0x85, 0x19, 0x46, 0x94, 0x53, 0x55,
+	0x4d, 0x9d, 0x66, 0xdf, 0x59, 0x5a, 0xf1, 0xa8, 0x47, 0xa5, 0xdb, 0x12, 0xff, 0x25, 0xc8, 0xd2,
+	0x32, 0x0c, 0x30, 0xa1, 0x96, 0xfc, 0x9b, 0x9a, 0x74, 0x8f, 0x52, 0xcf, 0x47, 0x96, 0x3c, 0x35,
+	0xe3, 0xef, 0x2d, 0x8e, 0x03, 0xc4, 0x38, 0x0c, 0xc2, 0x14, 0x50, 0x6e, 0xc9, 0xf0, 0x56, 0x13,
+	0x32, 0x64, 0xb5, 0x77, 0x9a, 0x88, 0xc3, 0x1d, 0xab, 0x45, 0x31, 0xc9, 0xfc, 0x23, 0x4a, 0xf3,
+	0x60, 0xec, 0xa1, 0xf7, 0xf9, 0x23, 0x1a, 0x67, 0xf1, 0xd7, 0x33, 0xbf, 0x4f, 0x5b, 0x67, 0x71,
+	0x28, 0x1f, 0xa9, 0x6b, 0x2d, 0x4d, 0x1d, 0x30, 0xcf, 0x6a, 0xef, 0x88, 0x47, 0xe2, 0x30, 0x5e,
+	0x4e, 0x83, 0x85, 0x23, 0xe6, 0x1d, 0x44, 0x08, 0x72, 0xf4, 0xb9, 0x48, 0xa6, 0x6e, 0x80, 0x39,
+	0xcc, 0x9c, 0x10, 0x45, 0x21, 0xe2, 0x31, 0xf4, 0x35, 0xa5, 0xa2, 0x54, 0xef, 0xda, 0x45, 0xcc,
+	0x8e, 0x33, 0x93, 0xfa, 0x09, 0xb8, 0x43, 0xcf, 0x09, 0x8a, 0xb4, 0xc9, 0x8a, 0x52, 0x9d, 0xad,
+	0x2f, 0xf5, 0xba, 0xfa, 0x5c, 0x07, 0x06, 0xfe, 0x9e, 0x21, 0xcd, 0x86, 0x9d, 0xb8, 0xd5, 0x06,
+	0x98, 0x77, 0x31, 0xe3, 0x11, 0x6e, 0xc6, 0x1c, 0x39, 0x9c, 0x6a, 0x85, 0x8a, 0x52, 0x2d, 0xd6,
+	0xca, 0x66, 0xc6, 0x73, 0x52, 0xa9, 0xf9, 0x55, 0x8c, 0xa2, 0xce, 0x01, 0x25, 0x2e, 0xe6, 0x98,
+	0x92, 0xfa, 0xd4, 0xeb, 0xae, 0x3e, 0x61, 0xcf, 0xf5, 0xaf, 0x9e, 0x50, 0x15, 0x82, 0x3b, 0x82,
+	0x2a, 0xa6, 0x4d, 0x55, 0x0a, 0xd5, 0x62, 0x6d, 0xdd, 0x4c, 0xde, 0xc8, 0x14, 0x64, 0x9a, 0x29,
+	0x99, 0xe6, 0x01, 0xc5, 0xa4, 0xfe, 0x99, 0xb8, 0xfd, 0xf2, 0xad, 0x5e, 0xf5, 0x30, 0x3f, 0x8d,
+	0x9b, 0x66, 0x8b, 0x06, 0x56, 0xfa, 0xfa, 0xc9, 0x63, 0x9b, 0xb9, 0x67, 0x16, 0xef, 0x84, 0x88,
+	0xc9, 0x0b, 0xcc, 0x4e, 0x22, 0xab, 0x5f, 0x03, 0xc0, 0x38, 0x8c, 0xb8, 0x23, 0x1a, 0xa7, 0xdd,
+	0x91, 0xa5, 0x96, 0xcc, 0xa4, 0xab, 0x66, 0xd6, 0x55, 0xf3, 0x24, 0xeb, 0x6a, 0xfd, 0x7f, 0x22,
+	0x51, 0xaf, 0xab, 0x2f, 0x25, 0xaf, 0x9e, 0xb7, 0xdb, 0x78, 0xf1, 0x56, 0x57, 0xec, 0x59, 0x19,
+	0x4b, 0xa0, 0x55, 0x0b, 0xac, 0x90, 0x38, 0x70, 0x50, 0x48, 0x5b, 0xa7, 0xcc, 0x09, 0x21, 0x76,
+	0x1d, 0xda, 0x46, 0x91, 0x36, 0x5d, 0x51, 0xaa, 0x53, 0xf6, 0x32, 0x89, 0x83, 0x43, 0xe9, 0x3a,
+	0x86, 0xd8, 0x7d, 0xda, 0x46, 0x91, 0xba, 0x06, 0x66, 0x42, 0x4a, 0x7d, 0x07, 0xbb, 0xda, 0x8c,
+	0xc4, 0x4c, 0x8b, 0x63, 0xc3, 0x55, 0x9f, 0x82, 0x7b, 0x11, 0x6a, 0xe1, 0x10, 0x23, 0xc2, 0x1d,
+	0xe8, 0xfb, 0xf4, 0xdc, 0xc7, 0x8c, 0x6b, 0x77, 0x2b, 0x85, 0xea, 0x6c, 0xbd, 0xdc, 0xeb, 0xea,
+	0xa5, 0xa4, 0x96, 0x11, 0x20, 0xc3, 0x56, 0x73, 0xeb, 0x7e, 0x66, 0x54, 0x9f, 0x80, 0xbe, 0xd5,
+	0x71, 0x11, 0xe9, 0xc8, 0x78, 0xb3, 0x32, 0xde, 0xff, 0x7b, 0x5d, 0x7d, 0xfd, 0x7a, 0xbc, 0x0c,
+	0x63, 0xd8, 0xcb, 0xb9, 0xf1, 0x71, 0x6a, 0x53, 0xbf, 0x01, 0x8b, 0x01, 0x26, 0x8e, 0xe8, 0xaa,
+	0x03, 0x03, 0x1a, 0x13, 0xae, 0x01, 0x39, 0x21, 0xbb, 0xbf, 0x77, 0xf5, 0xfb, 0x09, 0xfb, 0xcc,
+	0x3d, 0x33, 0x31, 0xb5, 0x02, 0xc8, 0x4f, 0xcd, 0x06, 0xe1, 0xbd, 0xae, 0xbe, 0x9a, 0xe4, 0xb8,
+	0x76, 0xd3, 0xb0, 0xe7, 0x03, 0x4c, 0x9e, 0xd0, 0xd6, 0xd9, 0xbe, 0x3c, 0xab, 0xc7, 0x60, 0x05,
+	0xfa, 0xd8, 0x23, 0x4e, 0xda, 0x24, 0x9a, 0x10, 0xaa, 0x15, 0xc5, 0x7c, 0xd6, 0xf5, 0x5e, 0x57,
+	0x7f, 0x90, 0x04, 0x1a, 0x85, 0x32, 0xec, 0x65, 0x69, 0x7e, 0x26, 0x9b, 0x42, 0x25, 0xdf, 0x7b,
+	0xe6, 0x0f, 0xef, 0x5e, 0x6d, 0x25, 0xa3, 0xfa, 0xd3, 0xbb, 0x57, 0x5b, 0xfa, 0x88, 0xfd, 0x6a,
+	0xc9, 0xc5, 0xd8, 0x96, 0x6b, 0x68, 0x68, 0x60, 0x75, 0x78, 0x57, 0x6c, 0xc4, 0x42, 0x4a, 0x18,
+	0x32, 0xfe, 0x54, 0xc0, 0xfc, 0x11, 0xf3, 0xf6, 0x5d, 0xf7, 0x84, 0x26, 0x5b, 0x94, 0xaf, 0x88,
+	0xf2, 0xfe, 0x15, 0x59, 0x07, 0x77, 0x65, 0x70, 0xd1, 0xeb, 0x49, 0xd9, 0xeb, 0x19, 0x79, 0x6e,
+	0xb8, 0x2a, 0x02, 0x33, 0x11, 0x3a, 0x87, 0x91, 0xcb, 0xb4, 0xc2, 0xbf, 0x3f, 0xf4, 0x59, 0xec,
+	0xdb, 0xb0, 0x00, 0x5d, 0x77, 0x9b, 0xd3, 0x94, 0x85, 0x35, 0x70, 0x7f, 0xe8, 0x55, 0x73, 0x12,
+	0x7e, 0x99, 0x04, 0xab, 0x43, 0x9e, 0x7d, 0xe2, 0x1e, 0x5e, 0x70, 0x44, 0xdc, 0xff, 0x0e, 0x1b,
+	0xea, 0x43, 0xb0, 0x0c, 0xdd, 0x44, 0x87, 0xa0, 0x9f, 0xae, 0xac, 0x36, 0x25, 0x4b, 0x59, 0xea,
+	0x3b, 0x92, 0x7d, 0xdd, 0xab, 0x0d, 0x53, 0xb7, 0x79, 0x03, 0x75, 0x48, 0x92, 0x90, 0xd2, 0x57,
+	0x01, 0xe5, 0xd1, 0x24, 0xe5, 0x3c, 0xfe, 0x5a, 0x18, 0xd4, 0x64, 0x21, 0xf0, 0x7d, 0xf5, 0x53,
+	0x3e, 0x9a, 0xfa, 0xdd, 0x24, 0x52, 0x93, 0x37, 0x89, 0x54, 0xde, 0xd3, 0xc2, 0xd8, 0x9e, 0xa6,
+	0x62, 0x96, 0x88, 0xf7, 0x94, 0x3d, 0x93, 0xa8, 0x19, 0x53, 0xbf, 0x04, 0x4b, 0x2c, 0xf4, 0x31,
+	0xe7, 0x98, 0x78, 0x4e, 0x48, 0x7d, 0xdc, 0xea, 0x48, 0xdd, 0x5d, 0xa8, 0x6d, 0x9a, 0xff, 0xfc,
+	0x29, 0x36, 0x9f, 0x65, 0xd8, 0x63, 0x09, 0xb5, 0x17, 0xd9, 0xb0, 0x41, 0xfd, 0x02, 0x2c, 0xa0,
+	0x00, 0x33, 0x86, 0x29, 0x71, 0x5c, 0xd4, 0x82, 0x1d, 0x29, 0xb1, 0xc5, 0xda, 0xc6, 0xa8, 0x68,
+	0x87, 0x29, 0xf2, 0xb1, 0x00, 0xda, 0xf3, 0x68, 0xf0, 0xf8, 0x21, 0xd2, 0x20, 0x1a, 0x64, 0xec,
+	0x0e, 0x4a, 0x83, 0xb0, 0x64, 0xdd, 0x94, 0x23, 0x2d, 0x0c, 0x62, 0xa4, 0x95, 0x74, 0xa4, 0xc5,
+	0xb9, 0xe1, 0x1a, 0x3f, 0x2a, 0x60, 0x51, 0xdc, 0xf2, 0x21, 0x0e, 0xec, 0x74, 0xfe, 0x3e, 0x60,
+	0x53, 0xa4, 0x58, 0x0a, 0x56, 0x27, 0x13, 0x56, 0xc5, 0xb9, 0xe1, 0xb2, 0x3d, 0x6b, 0xb8, 0xf6,
+	0xca, 0xa8, 0xda, 0x45, 0xca, 0xed, 0x74, 0xe6, 0x8d, 0x9f, 0x15, 0xb0, 0x76, 0xad, 0x8e, 0xbc,
+	0x7c, 0x0e, 0x16, 0x25, 0x18, 0xb9, 0x4e, 0xb6, 0x7e, 0x1f, 0x61, 0x06, 0x17, 0xd2, 0x1c, 0x69,
+	0xf6, 0xda, 0x5f, 0x05, 0x50, 0x38, 0x62, 0x9e, 0xfa, 0x2d, 0x28, 0x0e, 0x7e, 0x9a, 0x18, 0xa3,
+	0xfa, 0x38, 0x2c, 0xc9, 0xa5, 0xad, 0xf1, 0x98, 0xfc, 0xe5, 0x9e, 0x03, 0x30, 0x20, 0xd9, 0x1b,
+	0x37, 0xdc, 0xec, 0x43, 0x4a, 0x9f, 0x8e, 0x85, 0xe4, 0xb1, 0x63, 0x70, 0x6f, 0x94, 0x12, 0x6e,
+	0x8d, 0x8d, 0x90, 0x63, 0x4b, 0xb5, 0xdb, 0x63, 0xf3, 0xb4, 0x7d, 0xc6, 0xa4, 0x70, 0x8c, 0x61,
+	0x4c, 0x60, 0x4a, 0x5b, 0xe3, 0x31, 0x79, 0xf8, 0xef, 0xc0, 0xdc, 0xd0, 0xb8, 0x6e, 0xde, 0x74,
+	0x77, 0x00, 0x54, 0x7a, 0x78, 0x0b, 0x50, 0x96, 0xa1, 0x7e, 0xfc, 0xfa, 0xb2, 0xac, 0xbc, 0xb9,
+	0x2c, 0x2b, 0x7f, 0x5c, 0x96, 0x95, 0x17, 0x57, 0xe5, 0x89, 0x37, 0x57, 0xe5, 0x89, 0xdf, 0xae,
+	0xca, 0x13, 0xcf, 0x1f, 0x0d, 0x8c, 0x53, 0x1a, 0x70, 0xdb, 0x87, 0x4d, 0x96, 0x1d, 0xac, 0x76,
+	0xed, 0x91, 0x75, 0x31, 0xf4, 0x61, 0x2f, 0x46, 0xac, 0x39, 0x2d, 0xbf, 0xdd, 0x76, 0xff, 0x1e,
+	0x00, 0xe3, 0x3e, 0x31, 0x07, 0xfb, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -480,7 +781,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	AddToGaugeAndExtend(ctx context.Context, in *MsgAddToGaugeAndExtend, opts ...grpc.CallOption) (*MsgAddToGaugeAndExtendResponse, error)
 	CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -509,6 +812,15 @@ func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) AddToGaugeAndExtend(ctx context.Context, in *MsgAddToGaugeAndExtend, opts ...grpc.CallOption) (*MsgAddToGaugeAndExtendResponse, error) {
+	out := new(MsgAddToGaugeAndExtendResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/AddToGaugeAndExtend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error) {
 	out := new(MsgCreateGroupResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/CreateGroup", in, out, opts...)
@@ -518,11 +830,22 @@ func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ..
 	return out, nil
 }
 
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	AddToGaugeAndExtend(context.Context, *MsgAddToGaugeAndExtend) (*MsgAddToGaugeAndExtendResponse, error)
 	CreateGroup(context.Context, *MsgCreateGroup) (*MsgCreateGroupResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -535,9 +858,15 @@ func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGa
 func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
 }
+func (*UnimplementedMsgServer) AddToGaugeAndExtend(ctx context.Context, req *MsgAddToGaugeAndExtend) (*MsgAddToGaugeAndExtendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToGaugeAndExtend not implemented")
+}
 func (*UnimplementedMsgServer) CreateGroup(ctx context.Context, req *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -579,6 +908,24 @@ func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddToGaugeAndExtend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToGaugeAndExtend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToGaugeAndExtend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/AddToGaugeAndExtend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToGaugeAndExtend(ctx, req.(*MsgAddToGaugeAndExtend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateGroup)
 	if err := dec(in); err != nil {
@@ -597,6 +944,25 @@ func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -609,10 +975,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToGauge",
 			Handler:    _Msg_AddToGauge_Handler,
 		},
+		{
+			MethodName: "AddToGaugeAndExtend",
+			Handler:    _Msg_AddToGaugeAndExtend_Handler,
+		},
 		{
 			MethodName: "CreateGroup",
 			Handler:    _Msg_CreateGroup_Handler,
 		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -638,6 +1012,46 @@ func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AlignStartToEpoch {
+		i--
+		if m.AlignStartToEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinLockAmount != nil {
+		{
+			size := m.MinLockAmount.Size()
+			i -= size
+			if _, err := m.MinLockAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RecipientDenylist) > 0 {
+		for iNdEx := len(m.RecipientDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecipientDenylist[iNdEx])
+			copy(dAtA[i:], m.RecipientDenylist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RecipientAllowlist) > 0 {
+		for iNdEx := len(m.RecipientAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecipientAllowlist[iNdEx])
+			copy(dAtA[i:], m.RecipientAllowlist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
@@ -795,7 +1209,7 @@ func (m *MsgAddToGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddToGaugeAndExtend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -805,50 +1219,25 @@ func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddToGaugeAndExtend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddToGaugeAndExtend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PoolIds) > 0 {
-		dAtA4 := make([]byte, len(m.PoolIds)*10)
-		var j3 int
-		for _, num := range m.PoolIds {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.NumEpochsPaidOver != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochsPaidOver))
+	if m.AdditionalEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AdditionalEpochs))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -856,16 +1245,135 @@ func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCreateGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToGaugeAndExtendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToGaugeAndExtendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToGaugeAndExtendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmissionDecay != nil {
+		{
+			size, err := m.EmissionDecay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SplittingPolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SplittingPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA5 := make([]byte, len(m.PoolIds)*10)
+		var j4 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NumEpochsPaidOver != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochsPaidOver))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -890,6 +1398,91 @@ func (m *MsgCreateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		dAtA7 := make([]byte, len(m.LockIds)*10)
+		var j6 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimedRewards) > 0 {
+		for iNdEx := len(m.ClaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -930,6 +1523,25 @@ func (m *MsgCreateGauge) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovTx(uint64(m.PoolId))
 	}
+	if len(m.RecipientAllowlist) > 0 {
+		for _, s := range m.RecipientAllowlist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RecipientDenylist) > 0 {
+		for _, s := range m.RecipientDenylist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MinLockAmount != nil {
+		l = m.MinLockAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AlignStartToEpoch {
+		n += 2
+	}
 	return n
 }
 
@@ -973,6 +1585,40 @@ func (m *MsgAddToGaugeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddToGaugeAndExtend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AdditionalEpochs != 0 {
+		n += 1 + sovTx(uint64(m.AdditionalEpochs))
+	}
+	return n
+}
+
+func (m *MsgAddToGaugeAndExtendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreateGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -999,6 +1645,13 @@ func (m *MsgCreateGroup) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	if m.SplittingPolicy != 0 {
+		n += 1 + sovTx(uint64(m.SplittingPolicy))
+	}
+	if m.EmissionDecay != nil {
+		l = m.EmissionDecay.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1014,6 +1667,41 @@ func (m *MsgCreateGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimedRewards) > 0 {
+		for _, e := range m.ClaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1239,20 +1927,140 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAllowlist", wireType)
 			}
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAllowlist = append(m.RecipientAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientDenylist = append(m.RecipientDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinLockAmount = &v
+			if err := m.MinLockAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlignStartToEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AlignStartToEpoch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -1495,7 +2303,7 @@ func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToGaugeAndExtend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1518,17 +2326,17 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToGaugeAndExtend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToGaugeAndExtend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1538,31 +2346,29 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
 			}
-			m.NumEpochsPaidOver = 0
+			m.GaugeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1572,16 +2378,16 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				m.GaugeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1591,99 +2397,44 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PoolIds = append(m.PoolIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalEpochs", wireType)
+			}
+			m.AdditionalEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PoolIds) == 0 {
-					m.PoolIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PoolIds = append(m.PoolIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdditionalEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1706,7 +2457,7 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToGaugeAndExtendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1729,22 +2480,338 @@ func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToGaugeAndExtendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToGaugeAndExtendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+			}
+			m.NumEpochsPaidOver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplittingPolicy", wireType)
+			}
+			m.SplittingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplittingPolicy |= SplittingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionDecay == nil {
+				m.EmissionDecay = &EmissionDecay{}
+			}
+			if err := m.EmissionDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
@@ -1775,6 +2842,248 @@ func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedRewards = append(m.ClaimedRewards, types1.Coin{})
+			if err := m.ClaimedRewards[len(m.ClaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,8 +12,11 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 }
 
-// Called every block to automatically unlock matured locks.
+// Called every block to create the locks of queued bulk lock distributions,
+// and every 30 blocks to automatically unlock matured locks.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.ProcessBulkLockQueue(ctx)
+
 	if ctx.BlockHeight()%30 == 0 {
 		// TODO: Change this logic to "know" when the next unbonding time is, and only unlock at that time.
 		// At each unbond, do an iterate to find the next unbonding time and wait until then.
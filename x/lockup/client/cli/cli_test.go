@@ -25,6 +25,14 @@ func TestLockTokensCmd(t *testing.T) {
 				Coins:    sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 201)),
 			},
 		},
+		"lock 201stake tokens permanently": {
+			Cmd: "201uosmo --duration=permanent --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgLockTokens{
+				Owner:    testAddresses[0].String(),
+				Duration: types.PermanentLockDuration,
+				Coins:    sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 201)),
+			},
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
@@ -130,3 +138,17 @@ func TestCmdTotalLockedByDenom(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+
+func TestConvertPermanentLockCmd(t *testing.T) {
+	desc, _ := NewConvertPermanentLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgConvertPermanentLock]{
+		"basic test": {
+			Cmd: "10 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgConvertPermanentLock{
+				Owner: testAddresses[0].String(),
+				ID:    10,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
@@ -9,12 +9,15 @@ const (
 	FlagDuration    = "duration"
 	FlagMinDuration = "min-duration"
 	FlagAmount      = "amount"
+
+	// PermanentDuration is the value of the duration flag for permanent locks.
+	PermanentDuration = "permanent"
 )
 
 // FlagSetLockTokens returns flags for LockTokens msg builder.
 func FlagSetLockTokens() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
-	fs.String(FlagDuration, "24h", "The duration token to be locked. e.g. 24h, 168h, 336h")
+	fs.String(FlagDuration, "24h", "The duration token to be locked. e.g. 24h, 168h, 336h, permanent")
 	return fs
 }
 
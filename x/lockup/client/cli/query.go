@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdAccountLockedPastTime)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdAccountLockedPastTimeNotUnlockingOnly)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdTotalLockedByDenom)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdLockView)
 	cmd.AddCommand(
 		GetCmdAccountUnlockableCoins(),
 		GetCmdAccountLockedCoins(),
@@ -122,6 +123,17 @@ func GetCmdAccountUnlockingCoins() (*osmocli.QueryDescriptor, *types.AccountUnlo
 	}, &types.AccountUnlockingCoinsRequest{}
 }
 
+// GetCmdLockView returns every lock of an account joined with its unlocking status,
+// its synthetic lockup and the gauges it qualifies for.
+func GetCmdLockView() (*osmocli.QueryDescriptor, *types.LockViewRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "lock-view",
+		Short: "Query account's locks along with their synthetic lockups and qualifying gauges",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} lock-view <address>`,
+	}, &types.LockViewRequest{}
+}
+
 // GetCmdAccountLockedCoins returns locked coins that that are still in a locked state from the specified account.
 func GetCmdAccountLockedCoins() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.AccountLockedCoinsRequest](
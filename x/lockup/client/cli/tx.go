@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -14,8 +16,12 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(cmd, NewLockTokensCmd)
 	osmocli.AddTxCmd(cmd, NewBeginUnlockingAllCmd)
 	osmocli.AddTxCmd(cmd, NewBeginUnlockByIDCmd)
+	osmocli.AddTxCmd(cmd, NewBeginUnlockPartialCmd)
 	osmocli.AddTxCmd(cmd, NewForceUnlockByIdCmd)
 	osmocli.AddTxCmd(cmd, NewSetRewardReceiverAddress)
+	osmocli.AddTxCmd(cmd, NewSplitLockCmd)
+	osmocli.AddTxCmd(cmd, NewMergeLocksCmd)
+	osmocli.AddTxCmd(cmd, NewConvertPermanentLockCmd)
 
 	return cmd
 }
@@ -24,8 +30,9 @@ func NewLockTokensCmd() (*osmocli.TxCliDesc, *types.MsgLockTokens) {
 	return &osmocli.TxCliDesc{
 		Use:   "lock-tokens",
 		Short: "lock tokens into lockup pool from user account",
-		CustomFlagOverrides: map[string]string{
-			"duration": FlagDuration,
+		Long:  "lock tokens into lockup pool from user account. a duration of permanent creates a permanent lock, which can not be unlocked unless governance enables its conversion",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"duration": osmocli.FlagOnlyParser(parseLockDuration),
 		},
 		Flags: osmocli.FlagDesc{RequiredFlags: []*pflag.FlagSet{FlagSetLockTokens()}},
 	}, &types.MsgLockTokens{}
@@ -51,6 +58,19 @@ func NewBeginUnlockByIDCmd() (*osmocli.TxCliDesc, *types.MsgBeginUnlocking) {
 	}, &types.MsgBeginUnlocking{}
 }
 
+// NewBeginUnlockPartialCmd unlocks a sub-amount of an individual period lock by ID.
+func NewBeginUnlockPartialCmd() (*osmocli.TxCliDesc, *types.MsgBeginUnlockingPartial) {
+	return &osmocli.TxCliDesc{
+		Use:   "begin-unlock-partial",
+		Short: "begin unlock a sub-amount of an individual period lock by ID",
+		Long:  "begin unlock a sub-amount of an individual period lock by ID. the remaining amount stays locked under the same lock ID",
+		CustomFlagOverrides: map[string]string{
+			"coins": FlagAmount,
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
+	}, &types.MsgBeginUnlockingPartial{}
+}
+
 // NewForceUnlockByIdCmd force unlocks individual period lock by ID if proper permissions exist.
 func NewForceUnlockByIdCmd() (*osmocli.TxCliDesc, *types.MsgForceUnlock) {
 	return &osmocli.TxCliDesc{
@@ -72,3 +92,46 @@ func NewSetRewardReceiverAddress() (*osmocli.TxCliDesc, *types.MsgSetRewardRecei
 		Long:  "sets reward receiver address for the designated lock id",
 	}, &types.MsgSetRewardReceiverAddress{}
 }
+
+// NewSplitLockCmd splits a sub-amount of an individual period lock into a new lock.
+func NewSplitLockCmd() (*osmocli.TxCliDesc, *types.MsgSplitLock) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-lock",
+		Short: "splits a sub-amount of an individual period lock by ID into a new lock",
+		Long:  "splits a sub-amount of an individual period lock by ID into a new lock with the same duration. the remaining amount stays in the lock",
+		CustomFlagOverrides: map[string]string{
+			"coins": FlagAmount,
+		},
+		Flags: osmocli.FlagDesc{RequiredFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
+	}, &types.MsgSplitLock{}
+}
+
+// NewMergeLocksCmd merges period locks into the first of them.
+func NewMergeLocksCmd() (*osmocli.TxCliDesc, *types.MsgMergeLocks) {
+	return &osmocli.TxCliDesc{
+		Use:     "merge-locks",
+		Short:   "merges period locks with the same denom and duration into the first of them",
+		Long:    "merges period locks with the same denom, duration and reward receiver into the first of them. the other locks are deleted",
+		Example: "merge-locks 1,2,3 --from val --chain-id osmosis-1",
+	}, &types.MsgMergeLocks{}
+}
+
+// NewConvertPermanentLockCmd converts a permanent lock into a lock with the permanent lock conversion duration.
+func NewConvertPermanentLockCmd() (*osmocli.TxCliDesc, *types.MsgConvertPermanentLock) {
+	return &osmocli.TxCliDesc{
+		Use:   "convert-permanent-lock",
+		Short: "converts a permanent lock by ID into a lock with the permanent lock conversion duration set by governance",
+	}, &types.MsgConvertPermanentLock{}
+}
+
+// parseLockDuration parses the lock duration flag, where permanent stands for the permanent lock duration.
+func parseLockDuration(fs *pflag.FlagSet) (time.Duration, error) {
+	durationStr, err := fs.GetString(FlagDuration)
+	if err != nil {
+		return 0, err
+	}
+	if durationStr == PermanentDuration {
+		return types.PermanentLockDuration, nil
+	}
+	return time.ParseDuration(durationStr)
+}
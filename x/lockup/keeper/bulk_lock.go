@@ -0,0 +1,431 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// bulkLockDistributionStoreKey returns the store key of a bulk lock distribution from its ID.
+func bulkLockDistributionStoreKey(distributionID uint64) []byte {
+	return combineKeys(types.KeyPrefixBulkLockDistribution, sdk.Uint64ToBigEndian(distributionID))
+}
+
+// bulkLockQueuePrefix returns the prefix of the queued entries of a bulk lock distribution.
+func bulkLockQueuePrefix(distributionID uint64) []byte {
+	return combineKeys(types.KeyPrefixBulkLockQueue, sdk.Uint64ToBigEndian(distributionID))
+}
+
+// bulkLockQueueStoreKey returns the store key of a queued bulk lock entry from its distribution ID
+// and queue index. Entries are processed in key order, so distributions are processed in the order
+// they were created and their entries in the order they were queued.
+func bulkLockQueueStoreKey(distributionID, index uint64) []byte {
+	return combineKeys(bulkLockQueuePrefix(distributionID), sdk.Uint64ToBigEndian(index))
+}
+
+// bulkLockQueueKeyDistributionID returns the distribution ID of a queued bulk lock entry store key.
+func bulkLockQueueKeyDistributionID(queueKey []byte) uint64 {
+	offset := len(types.KeyPrefixBulkLockQueue) + len(types.KeyIndexSeparator)
+	return sdk.BigEndianToUint64(queueKey[offset : offset+8])
+}
+
+// bulkLockOwnerPrefix returns the prefix of the owners queued by a bulk lock distribution.
+func bulkLockOwnerPrefix(distributionID uint64) []byte {
+	return combineKeys(types.KeyPrefixBulkLockOwner, sdk.Uint64ToBigEndian(distributionID))
+}
+
+// bulkLockOwnerStoreKey returns the store key marking an owner as queued by a bulk lock distribution.
+func bulkLockOwnerStoreKey(distributionID uint64, owner sdk.AccAddress) []byte {
+	return combineKeys(bulkLockOwnerPrefix(distributionID), owner)
+}
+
+// isBulkLockAuthority returns true if the address is allowed to schedule bulk lock distributions,
+// that is either the governance module account or one of the bulk lock creator addresses.
+func (k Keeper) isBulkLockAuthority(ctx sdk.Context, address sdk.AccAddress) bool {
+	if address.Equals(k.ak.GetModuleAddress(govtypes.ModuleName)) {
+		return true
+	}
+	for _, creator := range k.GetParams(ctx).BulkLockCreatorAddresses {
+		if creator == address.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateBulkLockDistribution schedules a bulk lock distribution creating, for each of the num entries
+// committed to by the merkle root, a lock of the given duration for the entry owner.
+// The funds of the distribution are escrowed from the creator into the lockup module account.
+// Entries are then verified and queued with QueueBulkLockEntries, and turned into locks by the end blocker.
+// Returns the ID of the new distribution, or an error if:
+// - the creator is neither the governance module account nor a bulk lock creator address
+// - the merkle root is not a sha256 hash
+// - the number of entries or the duration is not positive
+// - the funds are not valid and positive
+// - the creator does not have enough balance
+func (k Keeper) CreateBulkLockDistribution(ctx sdk.Context, creator sdk.AccAddress, merkleRoot []byte, numEntries uint64, duration time.Duration, funds sdk.Coins) (uint64, error) {
+	if !k.isBulkLockAuthority(ctx, creator) {
+		return 0, errorsmod.Wrapf(types.ErrBulkLockUnauthorized, "address %s", creator)
+	}
+	if len(merkleRoot) != 32 {
+		return 0, errorsmod.Wrapf(types.ErrInvalidBulkLockDistribution, "merkle root must be 32 bytes, got %d", len(merkleRoot))
+	}
+	if numEntries == 0 {
+		return 0, errorsmod.Wrap(types.ErrInvalidBulkLockDistribution, "number of entries must be positive")
+	}
+	if duration <= 0 {
+		return 0, errorsmod.Wrapf(types.ErrInvalidBulkLockDistribution, "duration must be positive, got %s", duration)
+	}
+	if !funds.IsValid() || funds.IsZero() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidBulkLockDistribution, "funds must be valid and positive, got %s", funds)
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, funds); err != nil {
+		return 0, err
+	}
+
+	distribution := types.BulkLockDistribution{
+		Id:          k.getLastBulkLockDistributionID(ctx) + 1,
+		Creator:     creator.String(),
+		MerkleRoot:  merkleRoot,
+		Duration:    duration,
+		NumEntries:  numEntries,
+		Funds:       funds,
+		QueuedFunds: sdk.NewCoins(),
+	}
+	if err := k.setBulkLockDistribution(ctx, distribution); err != nil {
+		return 0, err
+	}
+	k.setLastBulkLockDistributionID(ctx, distribution.Id)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCreateBulkLockDistribution,
+			sdk.NewAttribute(types.AttributeBulkLockDistributionID, osmoutils.Uint64ToString(distribution.Id)),
+			sdk.NewAttribute(types.AttributeBulkLockCreator, distribution.Creator),
+			sdk.NewAttribute(types.AttributeBulkLockMerkleRoot, hex.EncodeToString(merkleRoot)),
+			sdk.NewAttribute(types.AttributeBulkLockNumEntries, osmoutils.Uint64ToString(numEntries)),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, duration.String()),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, funds.String()),
+		),
+	})
+
+	return distribution.Id, nil
+}
+
+// QueueBulkLockEntries verifies the given leaves against the merkle root of a bulk lock distribution
+// and queues their entries to be turned into locks by the end blocker.
+// Since every entry is verified against the merkle root, anyone can queue the entries of a distribution,
+// allowing a large distribution to be queued in as many transactions as needed.
+// Returns an error if any of the leaves:
+// - is not a valid entry, or is not part of the merkle tree
+// - has an owner that was already queued by the distribution
+// - exceeds the number of entries of the distribution
+// - needs more funds than the distribution has left unreserved
+func (k Keeper) QueueBulkLockEntries(ctx sdk.Context, distributionID uint64, leaves []types.BulkLockLeaf) error {
+	distribution, err := k.GetBulkLockDistribution(ctx, distributionID)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, leaf := range leaves {
+		if err := leaf.Entry.Validate(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidBulkLockDistribution, err.Error())
+		}
+		if !types.VerifyBulkLockProof(distribution.MerkleRoot, leaf.Entry.Hash(), leaf.Proof) {
+			return errorsmod.Wrapf(types.ErrInvalidBulkLockProof, "owner %s, coin %s", leaf.Entry.Owner, leaf.Entry.Coin)
+		}
+
+		owner := sdk.MustAccAddressFromBech32(leaf.Entry.Owner)
+		ownerKey := bulkLockOwnerStoreKey(distributionID, owner)
+		if store.Has(ownerKey) {
+			return errorsmod.Wrapf(types.ErrBulkLockOwnerAlreadyQueued, "owner %s", leaf.Entry.Owner)
+		}
+		if distribution.QueuedEntries >= distribution.NumEntries {
+			return errorsmod.Wrapf(types.ErrInvalidBulkLockDistribution, "all %d entries of distribution %d are already queued", distribution.NumEntries, distributionID)
+		}
+
+		queuedFunds := distribution.QueuedFunds.Add(leaf.Entry.Coin)
+		if !distribution.Funds.IsAllGTE(queuedFunds) {
+			return errorsmod.Wrapf(types.ErrInvalidBulkLockDistribution, "distribution %d funds %s are not enough to queue %s", distributionID, distribution.Funds.Sub(distribution.QueuedFunds...), leaf.Entry.Coin)
+		}
+
+		bz, err := proto.Marshal(&leaf.Entry)
+		if err != nil {
+			return err
+		}
+		store.Set(bulkLockQueueStoreKey(distributionID, distribution.QueuedEntries), bz)
+		store.Set(ownerKey, []byte{})
+
+		distribution.QueuedFunds = queuedFunds
+		distribution.QueuedEntries++
+	}
+
+	return k.setBulkLockDistribution(ctx, distribution)
+}
+
+// CancelBulkLockDistribution cancels a bulk lock distribution, dropping its queued entries and
+// refunding the funds it has not locked yet to its creator. Locks already created are kept.
+// Only the creator of the distribution or the governance module account can cancel it.
+func (k Keeper) CancelBulkLockDistribution(ctx sdk.Context, sender sdk.AccAddress, distributionID uint64) error {
+	distribution, err := k.GetBulkLockDistribution(ctx, distributionID)
+	if err != nil {
+		return err
+	}
+	if sender.String() != distribution.Creator && !sender.Equals(k.ak.GetModuleAddress(govtypes.ModuleName)) {
+		return errorsmod.Wrapf(types.ErrBulkLockUnauthorized, "address %s can not cancel distribution %d", sender, distributionID)
+	}
+
+	refund, err := k.closeBulkLockDistribution(ctx, distribution)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelBulkLockDistribution,
+			sdk.NewAttribute(types.AttributeBulkLockDistributionID, osmoutils.Uint64ToString(distributionID)),
+			sdk.NewAttribute(types.AttributeBulkLockRefund, refund.String()),
+		),
+	})
+	return nil
+}
+
+// ProcessBulkLockQueue turns up to MaxBulkLockEntriesPerBlock queued bulk lock entries into locks.
+// The funds of the entries are already escrowed in the lockup module account, so locks are created
+// without any bank send. Processed entries are removed from the queue, so the next call resumes
+// where this one stopped. Once all entries of a distribution are processed, its unused funds are
+// refunded to its creator and the distribution is removed.
+// An entry whose lock can not be created is skipped, and its funds are refunded along with the rest.
+func (k Keeper) ProcessBulkLockQueue(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// gather the entries to process before writing to the store, to not mutate it while iterating.
+	queueKeys := [][]byte{}
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBulkLockQueue)
+	for ; iterator.Valid() && len(queueKeys) < types.MaxBulkLockEntriesPerBlock; iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	distributions := map[uint64]*types.BulkLockDistribution{}
+	distributionIDs := []uint64{}
+	for _, queueKey := range queueKeys {
+		distributionID := bulkLockQueueKeyDistributionID(queueKey)
+		distribution, ok := distributions[distributionID]
+		if !ok {
+			d, err := k.GetBulkLockDistribution(ctx, distributionID)
+			if err != nil {
+				// unreachable, queued entries are removed along with their distribution.
+				k.Logger(ctx).Error(fmt.Sprintf("bulk lock entry queued for missing distribution %d", distributionID))
+				store.Delete(queueKey)
+				continue
+			}
+			distribution = &d
+			distributions[distributionID] = distribution
+			distributionIDs = append(distributionIDs, distributionID)
+		}
+
+		entry := types.BulkLockEntry{}
+		if err := proto.Unmarshal(store.Get(queueKey), &entry); err != nil {
+			panic(err)
+		}
+		store.Delete(queueKey)
+
+		coins := sdk.NewCoins(entry.Coin)
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			_, err := k.CreateLockNoSend(ctx, sdk.MustAccAddressFromBech32(entry.Owner), coins, distribution.Duration)
+			return err
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to create lock for bulk lock distribution %d entry of %s: %s", distributionID, entry.Owner, err))
+		} else {
+			distribution.Funds = distribution.Funds.Sub(coins...)
+		}
+		distribution.QueuedFunds = distribution.QueuedFunds.Sub(coins...)
+		distribution.ProcessedEntries++
+	}
+
+	for _, distributionID := range distributionIDs {
+		distribution := distributions[distributionID]
+		if distribution.ProcessedEntries < distribution.NumEntries {
+			if err := k.setBulkLockDistribution(ctx, *distribution); err != nil {
+				panic(err)
+			}
+			continue
+		}
+
+		refund, err := k.closeBulkLockDistribution(ctx, *distribution)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to refund bulk lock distribution %d: %s", distributionID, err))
+			continue
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtCompleteBulkLockDistribution,
+				sdk.NewAttribute(types.AttributeBulkLockDistributionID, osmoutils.Uint64ToString(distributionID)),
+				sdk.NewAttribute(types.AttributeBulkLockRefund, refund.String()),
+			),
+		})
+	}
+}
+
+// closeBulkLockDistribution removes a bulk lock distribution along with its queued entries and owners,
+// and refunds the funds it has not locked to its creator. Returns the refunded funds.
+// Creators are usually module accounts, which are blocked from receiving funds from other modules,
+// so the refund is a plain send back to the address the funds were escrowed from.
+func (k Keeper) closeBulkLockDistribution(ctx sdk.Context, distribution types.BulkLockDistribution) (sdk.Coins, error) {
+	if !distribution.Funds.IsZero() {
+		creator := sdk.MustAccAddressFromBech32(distribution.Creator)
+		if err := k.bk.SendCoins(ctx, k.ak.GetModuleAddress(types.ModuleName), creator, distribution.Funds); err != nil {
+			return nil, err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{bulkLockQueuePrefix(distribution.Id), bulkLockOwnerPrefix(distribution.Id)} {
+		keys := [][]byte{}
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	store.Delete(bulkLockDistributionStoreKey(distribution.Id))
+
+	return distribution.Funds, nil
+}
+
+// GetBulkLockDistribution returns the bulk lock distribution with the given ID.
+func (k Keeper) GetBulkLockDistribution(ctx sdk.Context, distributionID uint64) (types.BulkLockDistribution, error) {
+	distribution := types.BulkLockDistribution{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(bulkLockDistributionStoreKey(distributionID))
+	if bz == nil {
+		return distribution, errorsmod.Wrapf(types.ErrBulkLockDistributionNotFound, "distribution %d", distributionID)
+	}
+	err := proto.Unmarshal(bz, &distribution)
+	return distribution, err
+}
+
+// GetAllBulkLockDistributions returns all bulk lock distributions that are not complete yet.
+func (k Keeper) GetAllBulkLockDistributions(ctx sdk.Context) []types.BulkLockDistribution {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBulkLockDistribution)
+	defer iterator.Close()
+
+	distributions := []types.BulkLockDistribution{}
+	for ; iterator.Valid(); iterator.Next() {
+		distribution := types.BulkLockDistribution{}
+		if err := proto.Unmarshal(iterator.Value(), &distribution); err != nil {
+			panic(err)
+		}
+		distributions = append(distributions, distribution)
+	}
+	return distributions
+}
+
+// GetAllBulkLockDistributionStates returns all bulk lock distributions that are not complete yet,
+// along with their queued owners and the queued entries that are not processed yet.
+func (k Keeper) GetAllBulkLockDistributionStates(ctx sdk.Context) []types.BulkLockDistributionState {
+	store := ctx.KVStore(k.storeKey)
+	states := []types.BulkLockDistributionState{}
+	for _, distribution := range k.GetAllBulkLockDistributions(ctx) {
+		state := types.BulkLockDistributionState{
+			Distribution: distribution,
+			QueuedOwners: []string{},
+			Queue:        []types.BulkLockEntry{},
+		}
+
+		ownerPrefix := bulkLockOwnerPrefix(distribution.Id)
+		iterator := storetypes.KVStorePrefixIterator(store, ownerPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			state.QueuedOwners = append(state.QueuedOwners, sdk.AccAddress(iterator.Key()[len(ownerPrefix):]).String())
+		}
+		iterator.Close()
+
+		iterator = storetypes.KVStorePrefixIterator(store, bulkLockQueuePrefix(distribution.Id))
+		for ; iterator.Valid(); iterator.Next() {
+			entry := types.BulkLockEntry{}
+			if err := proto.Unmarshal(iterator.Value(), &entry); err != nil {
+				panic(err)
+			}
+			state.Queue = append(state.Queue, entry)
+		}
+		iterator.Close()
+
+		states = append(states, state)
+	}
+	return states
+}
+
+// setBulkLockDistributionState stores a bulk lock distribution along with its queued owners and
+// the queued entries that are not processed yet. Processed entries are removed from the front of
+// the queue, so the remaining entries are stored from the distribution's processed entries on.
+func (k Keeper) setBulkLockDistributionState(ctx sdk.Context, state types.BulkLockDistributionState) error {
+	distribution := state.Distribution
+	if err := k.setBulkLockDistribution(ctx, distribution); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, owner := range state.QueuedOwners {
+		store.Set(bulkLockOwnerStoreKey(distribution.Id, sdk.MustAccAddressFromBech32(owner)), []byte{})
+	}
+	for i, entry := range state.Queue {
+		bz, err := proto.Marshal(&entry)
+		if err != nil {
+			return err
+		}
+		store.Set(bulkLockQueueStoreKey(distribution.Id, distribution.ProcessedEntries+uint64(i)), bz)
+	}
+	return nil
+}
+
+// GetBulkLockEscrowedCoins returns the coins escrowed in the lockup module account by bulk lock
+// distributions that are not locked yet.
+func (k Keeper) GetBulkLockEscrowedCoins(ctx sdk.Context) sdk.Coins {
+	escrowed := sdk.NewCoins()
+	for _, distribution := range k.GetAllBulkLockDistributions(ctx) {
+		escrowed = escrowed.Add(distribution.Funds...)
+	}
+	return escrowed
+}
+
+func (k Keeper) setBulkLockDistribution(ctx sdk.Context, distribution types.BulkLockDistribution) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&distribution)
+	if err != nil {
+		return err
+	}
+	store.Set(bulkLockDistributionStoreKey(distribution.Id), bz)
+	return nil
+}
+
+func (k Keeper) getLastBulkLockDistributionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLastBulkLockDistributionID)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setLastBulkLockDistributionID(ctx sdk.Context, distributionID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyLastBulkLockDistributionID, sdk.Uint64ToBigEndian(distributionID))
+}
@@ -0,0 +1,389 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+	minttypes "github.com/osmosis-labs/osmosis/v26/x/mint/types"
+)
+
+// bulkLockMerkleTree returns the merkle root of the entries, and the entries along with their proofs.
+func bulkLockMerkleTree(entries []types.BulkLockEntry) ([]byte, []types.BulkLockLeaf) {
+	leaves := make([]types.BulkLockLeaf, len(entries))
+	level := make([][]byte, len(entries))
+	positions := make([]int, len(entries))
+	for i, entry := range entries {
+		leaves[i].Entry = entry
+		level[i] = entry.Hash()
+		positions[i] = i
+	}
+
+	for len(level) > 1 {
+		next := [][]byte{}
+		for j := 0; j < len(level); j += 2 {
+			// an odd node out is promoted to the next level as is.
+			if j+1 == len(level) {
+				next = append(next, level[j])
+				continue
+			}
+			next = append(next, types.HashBulkLockNodes(level[j], level[j+1]))
+		}
+		for i := range leaves {
+			if sibling := positions[i] ^ 1; sibling < len(level) {
+				leaves[i].Proof = append(leaves[i].Proof, level[sibling])
+			}
+			positions[i] /= 2
+		}
+		level = next
+	}
+	return level[0], leaves
+}
+
+func bulkLockEntries(n int, amount int64) []types.BulkLockEntry {
+	entries := make([]types.BulkLockEntry, n)
+	for i := range entries {
+		entries[i] = types.BulkLockEntry{
+			Owner: sdk.AccAddress([]byte(fmt.Sprintf("bulklock%012d", i))).String(),
+			Coin:  sdk.NewInt64Coin("stake", amount),
+		}
+	}
+	return entries
+}
+
+func (s *KeeperTestSuite) requireLocksBalancesInvariant() {
+	msg, broken := keeper.LocksBalancesInvariant(*s.App.LockupKeeper)(s.Ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *KeeperTestSuite) TestCreateBulkLockDistribution() {
+	creator := s.TestAccs[0]
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	root, _ := bulkLockMerkleTree(bulkLockEntries(2, 50))
+
+	tests := map[string]struct {
+		creator     sdk.AccAddress
+		whitelisted bool
+		merkleRoot  []byte
+		numEntries  uint64
+		duration    time.Duration
+		funds       sdk.Coins
+		expectedErr error
+	}{
+		"whitelisted creator": {
+			creator:     creator,
+			whitelisted: true,
+			merkleRoot:  root,
+			numEntries:  2,
+			duration:    time.Hour,
+			funds:       funds,
+		},
+		"governance": {
+			creator:    s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName),
+			merkleRoot: root,
+			numEntries: 2,
+			duration:   time.Hour,
+			funds:      funds,
+		},
+		"error: creator not whitelisted": {
+			creator:     creator,
+			merkleRoot:  root,
+			numEntries:  2,
+			duration:    time.Hour,
+			funds:       funds,
+			expectedErr: types.ErrBulkLockUnauthorized,
+		},
+		"error: invalid merkle root": {
+			creator:     creator,
+			whitelisted: true,
+			merkleRoot:  root[:31],
+			numEntries:  2,
+			duration:    time.Hour,
+			funds:       funds,
+			expectedErr: types.ErrInvalidBulkLockDistribution,
+		},
+		"error: no entries": {
+			creator:     creator,
+			whitelisted: true,
+			merkleRoot:  root,
+			duration:    time.Hour,
+			funds:       funds,
+			expectedErr: types.ErrInvalidBulkLockDistribution,
+		},
+		"error: zero duration": {
+			creator:     creator,
+			whitelisted: true,
+			merkleRoot:  root,
+			numEntries:  2,
+			funds:       funds,
+			expectedErr: types.ErrInvalidBulkLockDistribution,
+		},
+		"error: no funds": {
+			creator:     creator,
+			whitelisted: true,
+			merkleRoot:  root,
+			numEntries:  2,
+			duration:    time.Hour,
+			funds:       sdk.NewCoins(),
+			expectedErr: types.ErrInvalidBulkLockDistribution,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			// module accounts can not be funded from other modules, so the creator is funded with a plain send.
+			s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, funds))
+			s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, s.App.AccountKeeper.GetModuleAddress(minttypes.ModuleName), tc.creator, funds))
+			if tc.whitelisted {
+				params := s.App.LockupKeeper.GetParams(s.Ctx)
+				params.BulkLockCreatorAddresses = []string{tc.creator.String()}
+				s.App.LockupKeeper.SetParams(s.Ctx, params)
+			}
+
+			distributionID, err := s.App.LockupKeeper.CreateBulkLockDistribution(s.Ctx, tc.creator, tc.merkleRoot, tc.numEntries, tc.duration, tc.funds)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(s.App.LockupKeeper.GetAllBulkLockDistributions(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), distributionID)
+
+			distribution, err := s.App.LockupKeeper.GetBulkLockDistribution(s.Ctx, distributionID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.creator.String(), distribution.Creator)
+			s.Require().Equal(tc.funds, distribution.Funds)
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, tc.creator, "stake").IsZero())
+			s.requireLocksBalancesInvariant()
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueueAndProcessBulkLockEntries() {
+	s.SetupTest()
+	creator := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	entries := bulkLockEntries(3, 30)
+	root, leaves := bulkLockMerkleTree(entries)
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.FundModuleAcc(govtypes.ModuleName, funds)
+
+	distributionID, err := s.App.LockupKeeper.CreateBulkLockDistribution(s.Ctx, creator, root, 3, time.Hour, funds)
+	s.Require().NoError(err)
+
+	// an entry that is not part of the tree is rejected.
+	forged := leaves[0]
+	forged.Entry.Coin = sdk.NewInt64Coin("stake", 40)
+	err = s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, []types.BulkLockLeaf{forged})
+	s.Require().ErrorIs(err, types.ErrInvalidBulkLockProof)
+
+	// entries can be queued across multiple calls, but only once per owner.
+	s.Require().NoError(s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[:2]))
+	err = s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[1:2])
+	s.Require().ErrorIs(err, types.ErrBulkLockOwnerAlreadyQueued)
+
+	// the first entries are processed before the last one is queued.
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+	distribution, err := s.App.LockupKeeper.GetBulkLockDistribution(s.Ctx, distributionID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), distribution.ProcessedEntries)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), distribution.Funds)
+	s.Require().True(distribution.QueuedFunds.IsZero())
+	s.requireLocksBalancesInvariant()
+
+	s.Require().NoError(s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[2:]))
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+
+	// every owner got a lock, and the distribution is removed with its leftover funds refunded.
+	for _, entry := range entries {
+		locks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, sdk.MustAccAddressFromBech32(entry.Owner))
+		s.Require().Len(locks, 1)
+		s.Require().Equal(sdk.NewCoins(entry.Coin), locks[0].Coins)
+		s.Require().Equal(time.Hour, locks[0].Duration)
+	}
+	_, err = s.App.LockupKeeper.GetBulkLockDistribution(s.Ctx, distributionID)
+	s.Require().ErrorIs(err, types.ErrBulkLockDistributionNotFound)
+	s.Require().Equal(osmomath.NewInt(10), s.App.BankKeeper.GetBalance(s.Ctx, creator, "stake").Amount)
+	s.Require().Equal(osmomath.NewInt(90), s.App.LockupKeeper.GetModuleBalance(s.Ctx).AmountOf("stake"))
+	s.requireLocksBalancesInvariant()
+
+	// entries can not be queued once the distribution is complete.
+	err = s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[:1])
+	s.Require().ErrorIs(err, types.ErrBulkLockDistributionNotFound)
+}
+
+func (s *KeeperTestSuite) TestQueueBulkLockEntries_ExceedsFunds() {
+	s.SetupTest()
+	creator := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	root, leaves := bulkLockMerkleTree(bulkLockEntries(3, 30))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 60))
+	s.FundModuleAcc(govtypes.ModuleName, funds)
+
+	distributionID, err := s.App.LockupKeeper.CreateBulkLockDistribution(s.Ctx, creator, root, 3, time.Hour, funds)
+	s.Require().NoError(err)
+
+	err = s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves)
+	s.Require().ErrorIs(err, types.ErrInvalidBulkLockDistribution)
+}
+
+func (s *KeeperTestSuite) TestProcessBulkLockQueue_ResumesAcrossBlocks() {
+	s.SetupTest()
+	creator := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	numEntries := types.MaxBulkLockEntriesPerBlock + 10
+	root, leaves := bulkLockMerkleTree(bulkLockEntries(numEntries, 1))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", int64(numEntries)))
+	s.FundModuleAcc(govtypes.ModuleName, funds)
+
+	distributionID, err := s.App.LockupKeeper.CreateBulkLockDistribution(s.Ctx, creator, root, uint64(numEntries), time.Hour, funds)
+	s.Require().NoError(err)
+	s.Require().NoError(s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves))
+
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+	distribution, err := s.App.LockupKeeper.GetBulkLockDistribution(s.Ctx, distributionID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(types.MaxBulkLockEntriesPerBlock), distribution.ProcessedEntries)
+	s.Require().Equal(uint64(types.MaxBulkLockEntriesPerBlock), s.App.LockupKeeper.GetLastLockID(s.Ctx))
+	s.requireLocksBalancesInvariant()
+
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+	_, err = s.App.LockupKeeper.GetBulkLockDistribution(s.Ctx, distributionID)
+	s.Require().ErrorIs(err, types.ErrBulkLockDistributionNotFound)
+	s.Require().Equal(uint64(numEntries), s.App.LockupKeeper.GetLastLockID(s.Ctx))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, creator, "stake").IsZero())
+	s.requireLocksBalancesInvariant()
+}
+
+func (s *KeeperTestSuite) TestCancelBulkLockDistribution() {
+	s.SetupTest()
+	creator := s.TestAccs[0]
+	params := s.App.LockupKeeper.GetParams(s.Ctx)
+	params.BulkLockCreatorAddresses = []string{creator.String()}
+	s.App.LockupKeeper.SetParams(s.Ctx, params)
+
+	root, leaves := bulkLockMerkleTree(bulkLockEntries(3, 30))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 90))
+	s.FundAcc(creator, funds)
+
+	distributionID, err := s.App.LockupKeeper.CreateBulkLockDistribution(s.Ctx, creator, root, 3, time.Hour, funds)
+	s.Require().NoError(err)
+	s.Require().NoError(s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[:1]))
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+	s.Require().NoError(s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[1:2]))
+
+	// only the creator or governance can cancel the distribution.
+	err = s.App.LockupKeeper.CancelBulkLockDistribution(s.Ctx, s.TestAccs[1], distributionID)
+	s.Require().ErrorIs(err, types.ErrBulkLockUnauthorized)
+
+	s.Require().NoError(s.App.LockupKeeper.CancelBulkLockDistribution(s.Ctx, creator, distributionID))
+
+	// the lock created before cancelling is kept, the queued entry is dropped and the rest is refunded.
+	s.Require().Equal(uint64(1), s.App.LockupKeeper.GetLastLockID(s.Ctx))
+	s.Require().Equal(osmomath.NewInt(60), s.App.BankKeeper.GetBalance(s.Ctx, creator, "stake").Amount)
+	_, err = s.App.LockupKeeper.GetBulkLockDistribution(s.Ctx, distributionID)
+	s.Require().ErrorIs(err, types.ErrBulkLockDistributionNotFound)
+
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+	s.Require().Equal(uint64(1), s.App.LockupKeeper.GetLastLockID(s.Ctx))
+	s.requireLocksBalancesInvariant()
+}
+
+func (s *KeeperTestSuite) TestMsgBulkLockDistribution() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+	creator := s.TestAccs[0]
+	params := s.App.LockupKeeper.GetParams(s.Ctx)
+	params.BulkLockCreatorAddresses = []string{creator.String()}
+	s.App.LockupKeeper.SetParams(s.Ctx, params)
+
+	entries := bulkLockEntries(3, 30)
+	root, leaves := bulkLockMerkleTree(entries)
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 90))
+	s.FundAcc(creator, funds)
+
+	// only whitelisted addresses can create a distribution.
+	_, err := msgServer.CreateBulkLockDistribution(s.Ctx, types.NewMsgCreateBulkLockDistribution(s.TestAccs[1], root, 3, time.Hour, funds))
+	s.Require().ErrorIs(err, types.ErrBulkLockUnauthorized)
+
+	createResp, err := msgServer.CreateBulkLockDistribution(s.Ctx, types.NewMsgCreateBulkLockDistribution(creator, root, 3, time.Hour, funds))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), createResp.DistributionId)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, creator, "stake").IsZero())
+
+	// anyone can queue entries, as they are verified against the merkle root.
+	_, err = msgServer.QueueBulkLockEntries(s.Ctx, types.NewMsgQueueBulkLockEntries(s.TestAccs[1], createResp.DistributionId, leaves[:2]))
+	s.Require().NoError(err)
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+	for _, entry := range entries[:2] {
+		locks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, sdk.MustAccAddressFromBech32(entry.Owner))
+		s.Require().Len(locks, 1)
+	}
+
+	// only the creator or governance can cancel the distribution.
+	_, err = msgServer.CancelBulkLockDistribution(s.Ctx, types.NewMsgCancelBulkLockDistribution(s.TestAccs[1], createResp.DistributionId))
+	s.Require().ErrorIs(err, types.ErrBulkLockUnauthorized)
+
+	_, err = msgServer.CancelBulkLockDistribution(s.Ctx, types.NewMsgCancelBulkLockDistribution(creator, createResp.DistributionId))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(30), s.App.BankKeeper.GetBalance(s.Ctx, creator, "stake").Amount)
+	s.requireLocksBalancesInvariant()
+}
+
+func (s *KeeperTestSuite) TestBulkLockDistributionGenesis() {
+	s.SetupTest()
+	creator := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	entries := bulkLockEntries(4, 30)
+	root, leaves := bulkLockMerkleTree(entries)
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 120))
+	s.FundModuleAcc(govtypes.ModuleName, funds)
+
+	distributionID, err := s.App.LockupKeeper.CreateBulkLockDistribution(s.Ctx, creator, root, 4, time.Hour, funds)
+	s.Require().NoError(err)
+	s.Require().NoError(s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[:2]))
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+	s.Require().NoError(s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[2:3]))
+
+	genesis := s.App.LockupKeeper.ExportGenesis(s.Ctx)
+	s.Require().NoError(genesis.Validate())
+	s.Require().Equal(distributionID, genesis.LastBulkLockDistributionId)
+	s.Require().Len(genesis.BulkLockDistributions, 1)
+	state := genesis.BulkLockDistributions[0]
+	s.Require().Len(state.QueuedOwners, 3)
+	s.Require().Equal([]types.BulkLockEntry{entries[2]}, state.Queue)
+	moduleBalance := s.App.LockupKeeper.GetModuleBalance(s.Ctx)
+
+	// import the exported state, along with the module account balance holding the locks and escrowed funds.
+	s.SetupTest()
+	s.FundModuleAcc(types.ModuleName, moduleBalance)
+	s.App.LockupKeeper.InitGenesis(s.Ctx, *genesis)
+	s.requireLocksBalancesInvariant()
+
+	distribution, err := s.App.LockupKeeper.GetBulkLockDistribution(s.Ctx, distributionID)
+	s.Require().NoError(err)
+	s.Require().Equal(state.Distribution, distribution)
+
+	// queued owners can not be queued again, and the queued entry is processed after the import.
+	err = s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[:1])
+	s.Require().ErrorIs(err, types.ErrBulkLockOwnerAlreadyQueued)
+	s.Require().NoError(s.App.LockupKeeper.QueueBulkLockEntries(s.Ctx, distributionID, leaves[3:]))
+	s.App.LockupKeeper.ProcessBulkLockQueue(s.Ctx)
+
+	for _, entry := range entries[2:] {
+		locks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, sdk.MustAccAddressFromBech32(entry.Owner))
+		s.Require().Len(locks, 1)
+		s.Require().Equal(sdk.NewCoins(entry.Coin), locks[0].Coins)
+	}
+	_, err = s.App.LockupKeeper.GetBulkLockDistribution(s.Ctx, distributionID)
+	s.Require().ErrorIs(err, types.ErrBulkLockDistributionNotFound)
+	s.requireLocksBalancesInvariant()
+
+	// new distributions continue from the imported distribution ID.
+	s.FundModuleAcc(govtypes.ModuleName, funds)
+	newDistributionID, err := s.App.LockupKeeper.CreateBulkLockDistribution(s.Ctx, creator, root, 4, time.Hour, funds)
+	s.Require().NoError(err)
+	s.Require().Equal(distributionID+1, newDistributionID)
+}
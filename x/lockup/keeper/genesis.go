@@ -21,6 +21,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err := k.InitializeAllSyntheticLocks(ctx, genState.SyntheticLocks); err != nil {
 		return
 	}
+	for _, alias := range genState.LockAliases {
+		if err := k.setLockAlias(ctx, alias); err != nil {
+			panic(err)
+		}
+	}
+	k.setLastBulkLockDistributionID(ctx, genState.LastBulkLockDistributionId)
+	for _, state := range genState.BulkLockDistributions {
+		if err := k.setBulkLockDistributionState(ctx, state); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -35,5 +46,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Locks:          locks,
 		SyntheticLocks: k.GetAllSyntheticLockups(ctx),
 		Params:         &params,
+		LockAliases:    k.GetAllLockAliases(ctx),
+
+		LastBulkLockDistributionId: k.getLastBulkLockDistributionID(ctx),
+		BulkLockDistributions:      k.GetAllBulkLockDistributionStates(ctx),
 	}
 }
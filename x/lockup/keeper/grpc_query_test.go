@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
 	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 )
 
@@ -577,3 +578,90 @@ func (s *KeeperTestSuite) TestParams() {
 	s.Require().NoError(err)
 	s.Require().Equal([]string{s.TestAccs[0].String()}, res.Params.ForceUnlockAllowedAddresses)
 }
+
+func (s *KeeperTestSuite) TestLockView() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	// no locks
+	res, err := s.querier.LockView(s.Ctx, &types.LockViewRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Locks)
+
+	// a lock qualifying for a gauge, an unlocking lock and a superfluid staked lock
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	s.LockTokens(addr1, coins, time.Second)
+	s.LockTokens(addr1, coins, time.Hour)
+	s.LockTokens(addr1, coins, 2*time.Hour)
+	_, err = s.querier.BeginUnlock(s.Ctx, 2, nil)
+	s.Require().NoError(err)
+	err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, 3, "stake/superbonding", time.Second, false)
+	s.Require().NoError(err)
+
+	gaugeCoins := sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 10)}
+	s.FundAcc(addr1, gaugeCoins)
+	distrTo := types.QueryCondition{LockQueryType: types.ByDuration, Denom: "stake", Duration: time.Hour}
+	gaugeID, err := s.App.IncentivesKeeper.CreateGauge(s.Ctx, true, addr1, gaugeCoins, distrTo, s.Ctx.BlockTime(), 1, 0)
+	s.Require().NoError(err)
+	nextDistrTime := s.App.IncentivesKeeper.GetNextDistributionTime(s.Ctx)
+
+	res, err = s.querier.LockView(s.Ctx, &types.LockViewRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Locks, 3)
+
+	// the lock is shorter than the gauge's duration.
+	s.Require().Equal(uint64(1), res.Locks[0].Lock.ID)
+	s.Require().False(res.Locks[0].IsUnlocking)
+	s.Require().Nil(res.Locks[0].SyntheticLock)
+	s.Require().Empty(res.Locks[0].QualifyingGaugeIds)
+	s.Require().Nil(res.Locks[0].NextDistributionTime)
+
+	s.Require().Equal(uint64(2), res.Locks[1].Lock.ID)
+	s.Require().True(res.Locks[1].IsUnlocking)
+	s.Require().Nil(res.Locks[1].SyntheticLock)
+	s.Require().Equal([]uint64{gaugeID}, res.Locks[1].QualifyingGaugeIds)
+	s.Require().Equal(nextDistrTime, *res.Locks[1].NextDistributionTime)
+
+	s.Require().Equal(uint64(3), res.Locks[2].Lock.ID)
+	s.Require().False(res.Locks[2].IsUnlocking)
+	s.Require().Equal("stake/superbonding", res.Locks[2].SyntheticLock.SynthDenom)
+	s.Require().Equal([]uint64{gaugeID}, res.Locks[2].QualifyingGaugeIds)
+	s.Require().Equal(nextDistrTime, *res.Locks[2].NextDistributionTime)
+
+	// invalid owner
+	_, err = s.querier.LockView(s.Ctx, &types.LockViewRequest{Owner: ""})
+	s.Require().Error(err)
+}
+
+// TestLockView_RewardReceiver tests that the qualifying gauges of a lock match the gauges' recipient lists
+// against the lock's reward receiver rather than its owner.
+func (s *KeeperTestSuite) TestLockView_RewardReceiver() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	// two locks of the same owner, the first of which has its rewards sent to addr2
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	s.LockTokens(addr1, coins, time.Hour)
+	s.LockTokens(addr1, coins, time.Hour)
+	err := s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, 1, addr1, addr2.String())
+	s.Require().NoError(err)
+
+	gaugeCoins := sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 10)}
+	s.FundAcc(addr1, gaugeCoins.Add(gaugeCoins...))
+	distrTo := types.QueryCondition{LockQueryType: types.ByDuration, Denom: "stake", Duration: time.Hour}
+	allowlistGaugeID, err := s.App.IncentivesKeeper.CreateGaugeWithRecipientLists(s.Ctx, true, addr1, gaugeCoins, distrTo, s.Ctx.BlockTime(), 1, 0, []string{addr2.String()}, nil)
+	s.Require().NoError(err)
+	denylistGaugeID, err := s.App.IncentivesKeeper.CreateGaugeWithRecipientLists(s.Ctx, true, addr1, gaugeCoins, distrTo, s.Ctx.BlockTime(), 1, 0, nil, []string{addr2.String()})
+	s.Require().NoError(err)
+
+	res, err := s.querier.LockView(s.Ctx, &types.LockViewRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Locks, 2)
+
+	// the allowlist only pays the lock whose reward receiver is addr2, and the denylist only the other one.
+	s.Require().Equal(uint64(1), res.Locks[0].Lock.ID)
+	s.Require().Equal([]uint64{allowlistGaugeID}, res.Locks[0].QualifyingGaugeIds)
+	s.Require().Equal(uint64(2), res.Locks[1].Lock.ID)
+	s.Require().Equal([]uint64{denylistGaugeID}, res.Locks[1].QualifyingGaugeIds)
+}
@@ -85,16 +85,17 @@ func AccumulationStoreInvariant(keeper Keeper) sdk.Invariant {
 }
 
 // LocksBalancesInvariant ensure that the module balance and the sum of all
-// tokens within all locks have the equivalent amount of tokens.
+// tokens within all locks, and escrowed by bulk lock distributions, have the equivalent amount of tokens.
 func LocksBalancesInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		moduleAcc := keeper.ak.GetModuleAccount(ctx, types.ModuleName)
 		balances := keeper.bk.GetAllBalances(ctx, moduleAcc.GetAddress())
+		escrowed := keeper.GetBulkLockEscrowedCoins(ctx)
 
 		// loop all denoms on lockup module
 		for _, coin := range balances {
 			denom := coin.Denom
-			lockedAmount := osmomath.ZeroInt()
+			lockedAmount := escrowed.AmountOf(denom)
 			locksByDenom := keeper.GetLocksDenom(ctx, denom)
 			for _, lock := range locksByDenom {
 				lockedAmount = lockedAmount.Add(lock.Coins.AmountOf(denom))
@@ -216,7 +216,13 @@ func (k Keeper) beginUnlockFromIterator(ctx sdk.Context, iterator db.Iterator) (
 	// Note: this function is only used for an account
 	// and this has no conflicts with synthetic lockups
 
-	locks := k.getLocksFromIterator(ctx, iterator)
+	// permanent locks can not begin unlocking, so they are left locked.
+	locks := []types.PeriodLock{}
+	for _, lock := range k.getLocksFromIterator(ctx, iterator) {
+		if !lock.IsPermanent() {
+			locks = append(locks, lock)
+		}
+	}
 	for _, lock := range locks {
 		_, err := k.BeginUnlock(ctx, lock.ID, nil)
 		if err != nil {
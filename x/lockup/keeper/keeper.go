@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/log"
 
@@ -23,6 +24,7 @@ type Keeper struct {
 	ak types.AccountKeeper
 	bk types.BankKeeper
 	ck types.CommunityPoolKeeper
+	ik types.IncentivesKeeper
 }
 
 // NewKeeper returns an instance of Keeper.
@@ -61,6 +63,12 @@ func (k Keeper) GetForceUnlockAllowedAddresses(ctx sdk.Context) (forceUnlockAllo
 	return k.GetParams(ctx).ForceUnlockAllowedAddresses
 }
 
+// GetPermanentLockConversionDuration returns the duration permanent locks are converted to,
+// zero if their conversion is disabled.
+func (k Keeper) GetPermanentLockConversionDuration(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).PermanentLockConversionDuration
+}
+
 // Logger returns a logger instance.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -77,6 +85,11 @@ func (k *Keeper) SetHooks(lh types.LockupHooks) *Keeper {
 	return k
 }
 
+// SetIncentivesKeeper sets the incentives keeper, which is created after the lockup keeper.
+func (k *Keeper) SetIncentivesKeeper(incentivesKeeper types.IncentivesKeeper) {
+	k.ik = incentivesKeeper
+}
+
 // AdminKeeper defines a god privilege keeper functions to remove tokens from locks and create new locks
 // For the governance system of token pools, we want a "ragequit" feature
 // So governance changes will take 1 week to go into effect
@@ -47,6 +47,12 @@ func (k Keeper) GetPeriodLocksAccumulation(ctx sdk.Context, query types.QueryCon
 	return k.accumulationStore(ctx, query.Denom).SubsetAccumulation(beginKey, nil)
 }
 
+// GetPeriodLocksCount returns the number of locks matching the condition, without iterating them.
+func (k Keeper) GetPeriodLocksCount(ctx sdk.Context, query types.QueryCondition) osmomath.Int {
+	beginKey := accumulationKey(query.Duration)
+	return k.lockCountStore(ctx, query.Denom).SubsetAccumulation(beginKey, nil)
+}
+
 // BeginUnlockAllNotUnlockings begins unlock for all not unlocking locks of the given account.
 func (k Keeper) BeginUnlockAllNotUnlockings(ctx sdk.Context, account sdk.AccAddress) ([]types.PeriodLock, error) {
 	locks, err := k.beginUnlockFromIterator(ctx, k.AccountLockIterator(ctx, false, account))
@@ -95,6 +101,10 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.Ac
 		return nil, types.ErrNotLockOwner
 	}
 
+	if !lock.Coins.Empty() && lock.Coins.AmountOf(tokensToAdd.Denom).IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrMultiCoinLock, "cannot add %s to lock %d holding %s", tokensToAdd, lockID, lock.Coins)
+	}
+
 	lock.Coins = lock.Coins.Add(tokensToAdd)
 
 	// Send the tokens we are about to add to lock to the lockup module account.
@@ -112,7 +122,7 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.Ac
 	if err != nil {
 		return nil, err
 	}
-	k.accumulationStore(ctx, synthlock.SynthDenom).Increase(accumulationKey(synthlock.Duration), tokensToAdd.Amount)
+	k.increaseSyntheticLockAccumulation(ctx, synthlock.SynthDenom, synthlock.Duration, tokensToAdd.Amount)
 
 	if k.hooks == nil {
 		return lock, nil
@@ -125,8 +135,13 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.Ac
 
 // CreateLock creates a new lock with the specified duration for the owner.
 // Returns an error in the following conditions:
+//   - coins do not consist of exactly one denom
 //   - account does not have enough balance
 func (k Keeper) CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (types.PeriodLock, error) {
+	if err := validateLockCoins(coins); err != nil {
+		return types.PeriodLock{}, err
+	}
+
 	// Send the coins we are about to lock to the lockup module account.
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
 		return types.PeriodLock{}, err
@@ -146,6 +161,10 @@ func (k Keeper) CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coin
 //   - it is gas inefficient
 //   - users should not be able to have cl shares in their account, so this is an extra safety measure
 func (k Keeper) CreateLockNoSend(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (types.PeriodLock, error) {
+	if err := validateLockCoins(coins); err != nil {
+		return types.PeriodLock{}, err
+	}
+
 	ID := k.GetLastLockID(ctx) + 1
 	// unlock time is initially set without a value, gets set as unlock start time + duration
 	// when unlocking starts.
@@ -168,6 +187,15 @@ func (k Keeper) CreateLockNoSend(ctx sdk.Context, owner sdk.AccAddress, coins sd
 	return lock, nil
 }
 
+// validateLockCoins validates that the coins of a new lock consist of exactly one denom.
+// Multi-coin locks are deprecated, as distribution logic assumes a single denom per lock.
+func validateLockCoins(coins sdk.Coins) error {
+	if coins.Len() != 1 {
+		return errorsmod.Wrapf(types.ErrMultiCoinLock, "got %s", coins)
+	}
+	return nil
+}
+
 // lock is an internal utility to lock coins and set corresponding states.
 // This is only called by either of the two possible entry points to lock tokens.
 // 1. CreateLock
@@ -212,6 +240,24 @@ func (k Keeper) BeginUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (ui
 	return unlockingLock, err
 }
 
+// BeginPartialUnlock starts unlocking the given coins of a lock. The coins are split into a new lock
+// that begins unlocking, while the remaining coins stay locked under the given lock ID and keep
+// qualifying for incentives.
+// Returns the ID of the new unlocking lock.
+// Returns an error if the coins are empty or not less than the lock's coins, or if the lock has a synthetic lock.
+// Partially unlocking a superfluid staked lock is done through the superfluid module instead.
+func (k Keeper) BeginPartialUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (uint64, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return 0, err
+	}
+	if coins.Empty() || !coins.IsAllPositive() || !coins.IsAllLTE(lock.Coins) || coins.Equal(lock.Coins) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPartialUnlockAmount, "requested %s, locked %s", coins, lock.Coins)
+	}
+
+	return k.BeginUnlock(ctx, lockID, coins)
+}
+
 // BeginForceUnlock begins force unlock of the given lock.
 // This method should be called by the superfluid module ONLY, as it does not check whether
 // the lock has a synthetic lock or not before unlocking.
@@ -235,6 +281,7 @@ func (k Keeper) BeginForceUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins
 // EndTime of the lock is set within this method.
 // Coins provided as the parameter does not require to have all the tokens in the lock,
 // as we allow partial unlockings of a lock.
+// Permanent locks can not begin unlocking, they have to be converted by ConvertPermanentLock first.
 // Returns lock id, new lock id if the lock was split, else same lock id.
 func (k Keeper) beginUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (uint64, error) {
 	// sanity check
@@ -242,6 +289,10 @@ func (k Keeper) beginUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Co
 		return 0, fmt.Errorf("requested amount to unlock exceeds locked tokens")
 	}
 
+	if lock.IsPermanent() {
+		return 0, errorsmod.Wrapf(types.ErrPermanentLock, "lock %d", lock.ID)
+	}
+
 	if lock.IsUnlocking() {
 		return 0, fmt.Errorf("trying to unlock a lock that is already unlocking")
 	}
@@ -319,6 +370,7 @@ func (k Keeper) RebuildSuperfluidAccumulationStoresForDenom(ctx sdk.Context, den
 	// remove trailing slash
 	superfluidStorePrefix = superfluidStorePrefix[0 : len(superfluidStorePrefix)-1]
 	k.clearKeysByPrefix(ctx, superfluidStorePrefix)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSuperbondedTotal).Delete([]byte(denom))
 
 	accumulationStoreEntries := make(map[string]map[time.Duration]osmomath.Int)
 	locks := k.GetLocksDenom(ctx, denom)
@@ -342,9 +394,17 @@ func (k Keeper) RebuildSuperfluidAccumulationStoresForDenom(ctx sdk.Context, den
 		accumulationStoreEntries[synthLock.SynthDenom] = curDurationMap
 	}
 
+	superbondedTotal := osmomath.ZeroInt()
 	for synthDenom, durationMap := range accumulationStoreEntries {
 		k.writeDurationValuesToAccumTree(ctx, synthDenom, durationMap)
+		if !types.IsSuperbondingDenom(synthDenom) {
+			continue
+		}
+		for _, amt := range durationMap {
+			superbondedTotal = superbondedTotal.Add(amt)
+		}
 	}
+	k.increaseSuperbondedTotal(ctx, denom, superbondedTotal)
 }
 
 func (k Keeper) ClearAccumulationStores(ctx sdk.Context) {
@@ -493,6 +553,8 @@ func (k Keeper) unlockMaturedLockInternalLogic(ctx sdk.Context, lock types.Perio
 		}
 	}
 
+	// the lock is still in state, so that the hooks can read it before it is deleted.
+	k.hooks.BeforeTokenUnlocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	k.deleteLock(ctx, lock.ID)
 
 	// delete lock refs from the unlocking queue
@@ -734,7 +796,7 @@ func (k Keeper) InitializeAllSyntheticLocks(ctx sdk.Context, syntheticLocks []ty
 		ctx.Logger().Debug(msg)
 		for _, d := range durations {
 			amt := curDurationMap[d]
-			k.accumulationStore(ctx, denom).Increase(accumulationKey(d), amt)
+			k.increaseSyntheticLockAccumulation(ctx, denom, d, amt)
 		}
 	}
 
@@ -807,6 +869,10 @@ func (k Keeper) accumulationStore(ctx sdk.Context, denom string) sumtree.Tree {
 	return sumtree.NewTree(prefix.NewStore(ctx.KVStore(k.storeKey), accumulationStorePrefix(denom)), 10)
 }
 
+func (k Keeper) lockCountStore(ctx sdk.Context, denom string) sumtree.Tree {
+	return sumtree.NewTree(prefix.NewStore(ctx.KVStore(k.storeKey), lockCountStorePrefix(denom)), 10)
+}
+
 // removeTokensFromLock is called by lockup slash function.
 // Called by the superfluid module ONLY.
 func (k Keeper) removeTokensFromLock(ctx sdk.Context, lock *types.PeriodLock, coins sdk.Coins) error {
@@ -832,18 +898,24 @@ func (k Keeper) removeTokensFromLock(ctx sdk.Context, lock *types.PeriodLock, co
 
 	// Note: since synthetic lockup deletion is using native lockup's coins to reduce accumulation store
 	// all the synthetic lockups' accumulation should be decreased
-	k.accumulationStore(ctx, synthLock.SynthDenom).Decrease(accumulationKey(synthLock.Duration), coins[0].Amount)
+	k.decreaseSyntheticLockAccumulation(ctx, synthLock.SynthDenom, synthLock.Duration, coins[0].Amount)
 	return nil
 }
 
 // setLock is a utility to store lock object into the store.
+// It keeps the lock count store in sync with the denoms and duration of the lock.
 func (k Keeper) setLock(ctx sdk.Context, lock types.PeriodLock) error {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := proto.Marshal(&lock)
 	if err != nil {
 		return err
 	}
+	var prevLock *types.PeriodLock
+	if storedLock, err := k.GetLockByID(ctx, lock.ID); err == nil {
+		prevLock = storedLock
+	}
 	store.Set(lockStoreKey(lock.ID), bz)
+	k.updateLockCount(ctx, prevLock, &lock)
 	return nil
 }
 
@@ -871,10 +943,42 @@ func (k Keeper) setSyntheticLockAndResetRefs(ctx sdk.Context, lock types.PeriodL
 
 // deleteLock removes the lock object from the state.
 func (k Keeper) deleteLock(ctx sdk.Context, id uint64) {
+	if storedLock, err := k.GetLockByID(ctx, id); err == nil {
+		k.updateLockCount(ctx, storedLock, nil)
+	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(lockStoreKey(id))
 }
 
+// updateLockCount moves a lock in the lock count store from the denoms and duration of prevLock
+// to the ones of newLock. A nil prevLock means the lock is created, a nil newLock that it is deleted.
+func (k Keeper) updateLockCount(ctx sdk.Context, prevLock, newLock *types.PeriodLock) {
+	one := osmomath.OneInt()
+	if prevLock != nil {
+		for _, coin := range prevLock.Coins {
+			if newLock == nil || newLock.Duration != prevLock.Duration || !newLock.Coins.AmountOf(coin.Denom).IsPositive() {
+				k.lockCountStore(ctx, coin.Denom).Decrease(accumulationKey(prevLock.Duration), one)
+			}
+		}
+	}
+	if newLock != nil {
+		for _, coin := range newLock.Coins {
+			if prevLock == nil || prevLock.Duration != newLock.Duration || !prevLock.Coins.AmountOf(coin.Denom).IsPositive() {
+				k.lockCountStore(ctx, coin.Denom).Increase(accumulationKey(newLock.Duration), one)
+			}
+		}
+	}
+}
+
+// RebuildLockCounts rebuilds the lock count store from the locks in state.
+func (k Keeper) RebuildLockCounts(ctx sdk.Context) error {
+	k.clearKeysByPrefix(ctx, types.KeyPrefixLockCount)
+	return k.IterateLocks(ctx, func(lock types.PeriodLock) error {
+		k.updateLockCount(ctx, nil, &lock)
+		return nil
+	})
+}
+
 // SplitLock splits a lock with the given amount, and stores split new lock to the state.
 // Returns the new lock after modifying the state of the old lock.
 func (k Keeper) SplitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins, forceUnlock bool) (types.PeriodLock, error) {
@@ -895,7 +999,14 @@ func (k Keeper) SplitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.RewardReceiverAddress, lock.Duration, lock.EndTime, coins)
 
 	err = k.setLock(ctx, splitLock)
-	return splitLock, err
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockSplit(ctx, lock.ID, splitLock.ID)
+	}
+	return splitLock, nil
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// lockAliasStoreKey returns the lock alias store key from the original lock ID and the split lock ID.
+func lockAliasStoreKey(originalLockID, splitLockID uint64) []byte {
+	return combineKeys(types.KeyPrefixLockAlias, sdk.Uint64ToBigEndian(originalLockID), sdk.Uint64ToBigEndian(splitLockID))
+}
+
+// setLockAlias stores the alias from a lock split off a multi-coin lock to the lock it was split from.
+func (k Keeper) setLockAlias(ctx sdk.Context, alias types.LockAlias) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&alias)
+	if err != nil {
+		return err
+	}
+	store.Set(lockAliasStoreKey(alias.OriginalLockId, alias.SplitLockId), bz)
+	return nil
+}
+
+// GetLockAliases returns the IDs of the locks that were split off the given lock
+// when multi-coin locks were migrated to single-coin locks.
+func (k Keeper) GetLockAliases(ctx sdk.Context, originalLockID uint64) []uint64 {
+	splitLockIDs := []uint64{}
+	for _, alias := range k.getLockAliasesFromPrefix(ctx, combineKeys(types.KeyPrefixLockAlias, sdk.Uint64ToBigEndian(originalLockID))) {
+		splitLockIDs = append(splitLockIDs, alias.SplitLockId)
+	}
+	return splitLockIDs
+}
+
+// GetAllLockAliases returns all lock aliases.
+func (k Keeper) GetAllLockAliases(ctx sdk.Context) []types.LockAlias {
+	return k.getLockAliasesFromPrefix(ctx, types.KeyPrefixLockAlias)
+}
+
+func (k Keeper) getLockAliasesFromPrefix(ctx sdk.Context, prefix []byte) []types.LockAlias {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	aliases := []types.LockAlias{}
+	for ; iterator.Valid(); iterator.Next() {
+		alias := types.LockAlias{}
+		err := proto.Unmarshal(iterator.Value(), &alias)
+		if err != nil {
+			panic(err)
+		}
+		aliases = append(aliases, alias)
+	}
+	return aliases
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+)
+
+// SplitLockByID splits the given coins of a not unlocking lock into a new lock with the same owner,
+// reward receiver and duration. The remaining coins stay in the given lock.
+// Since both locks have the same denom and duration, the accumulation store is left unchanged.
+// Returns the new lock.
+// Returns an error if:
+// - the lock is not owned by owner
+// - the lock can not be restructured, see validateLockRestructurable
+// - coins is empty, not positive or not less than the lock's coins
+func (k Keeper) SplitLockByID(ctx sdk.Context, owner sdk.AccAddress, lockID uint64, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.getRestructurableLock(ctx, owner, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if coins.Empty() || !coins.IsAllPositive() || !coins.IsAllLTE(lock.Coins) || coins.Equal(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("amount to split (%s) must be positive and less than the locked amount (%s)", coins, lock.Coins)
+	}
+
+	splitLock, err := k.SplitLock(ctx, *lock, coins, false)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if err := k.addLockRefs(ctx, splitLock); err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributeNewPeriodLockID, osmoutils.Uint64ToString(splitLock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, splitLock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, splitLock.Coins.String()),
+		),
+	})
+
+	return splitLock, nil
+}
+
+// MergeLocks merges the given not unlocking locks into the first of them, and deletes the others.
+// The locks must have the same owner, denom, duration and reward receiver.
+// Since the merged coins keep their denom and duration, the accumulation store is left unchanged.
+// Returns the merged lock.
+// Returns an error if:
+// - lockIDs has less than two or duplicate lock IDs
+// - any of the locks is not owned by owner
+// - any of the locks can not be restructured, see validateLockRestructurable
+// - the locks differ in denom, duration or reward receiver
+func (k Keeper) MergeLocks(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (types.PeriodLock, error) {
+	if err := types.ValidateMergeLockIds(lockIDs); err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	locks := make([]types.PeriodLock, 0, len(lockIDs))
+	for _, lockID := range lockIDs {
+		lock, err := k.getRestructurableLock(ctx, owner, lockID)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		locks = append(locks, *lock)
+	}
+
+	mergedLock := locks[0]
+	for _, lock := range locks[1:] {
+		if lock.Coins[0].Denom != mergedLock.Coins[0].Denom || lock.Duration != mergedLock.Duration || lock.RewardReceiverAddress != mergedLock.RewardReceiverAddress {
+			return types.PeriodLock{}, fmt.Errorf("lock %d and lock %d differ in denom, duration or reward receiver", mergedLock.ID, lock.ID)
+		}
+	}
+
+	for _, lock := range locks[1:] {
+		if err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock); err != nil {
+			return types.PeriodLock{}, err
+		}
+		k.deleteLock(ctx, lock.ID)
+		mergedLock.Coins = mergedLock.Coins.Add(lock.Coins...)
+	}
+	if err := k.setLock(ctx, mergedLock); err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	mergedLockIDs := make([]uint64, 0, len(locks)-1)
+	mergedLockIDStrs := make([]string, 0, len(locks)-1)
+	for _, lock := range locks[1:] {
+		mergedLockIDs = append(mergedLockIDs, lock.ID)
+		mergedLockIDStrs = append(mergedLockIDStrs, osmoutils.Uint64ToString(lock.ID))
+	}
+	if k.hooks != nil {
+		k.hooks.OnLocksMerged(ctx, mergedLock.ID, mergedLockIDs)
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMergeLocks,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(mergedLock.ID)),
+			sdk.NewAttribute(types.AttributeMergedPeriodLockIDs, strings.Join(mergedLockIDStrs, ",")),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, mergedLock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, mergedLock.Coins.String()),
+		),
+	})
+
+	return mergedLock, nil
+}
+
+// getRestructurableLock returns the lock with the given ID if it is owned by owner and can be restructured.
+func (k Keeper) getRestructurableLock(ctx sdk.Context, owner sdk.AccAddress, lockID uint64) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, errorsmod.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner, lock.Owner)
+	}
+	if err := k.validateLockRestructurable(ctx, *lock); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// validateLockRestructurable returns an error if the lock can not be split or merged, that is if:
+// - the lock is unlocking
+// - the lock has a synthetic lock, as superfluid staked locks are restructured through the superfluid module
+// - the lock holds concentrated liquidity shares, as such locks are tied to their position
+// - the lock does not hold exactly one denom
+func (k Keeper) validateLockRestructurable(ctx sdk.Context, lock types.PeriodLock) error {
+	if lock.IsUnlocking() {
+		return fmt.Errorf("lock %d is unlocking", lock.ID)
+	}
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return fmt.Errorf("lock %d has a synthetic lockup", lock.ID)
+	}
+	if len(lock.Coins) != 1 {
+		return errorsmod.Wrapf(types.ErrMultiCoinLock, "lock %d holds %s", lock.ID, lock.Coins)
+	}
+	if types.IsConcentratedLiquidityDenom(lock.Coins[0].Denom) {
+		return fmt.Errorf("lock %d holds concentrated liquidity shares", lock.ID)
+	}
+	return nil
+}
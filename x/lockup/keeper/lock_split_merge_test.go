@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+)
+
+func (s *KeeperTestSuite) TestSplitAndMergeLocks() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	lockID := s.KeeperTestHelper.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	query := types.QueryCondition{LockQueryType: types.ByDuration, Denom: "stake", Duration: time.Second}
+
+	// split a sub-amount of the lock into a new lock
+	splitLock, err := s.App.LockupKeeper.SplitLockByID(s.Ctx, addr1, lockID, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 4)}, splitLock.Coins)
+	s.Require().Equal(time.Second, splitLock.Duration)
+	s.Require().False(splitLock.IsUnlocking())
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSplitLock, 1)
+
+	lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, lock.Coins)
+	s.Require().Len(s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, addr1, "stake", time.Second), 2)
+	s.Require().Equal(osmomath.NewInt(10), s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, query))
+
+	// merge the locks back into the original lock
+	mergedLock, err := s.App.LockupKeeper.MergeLocks(s.Ctx, addr1, []uint64{lockID, splitLock.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(lockID, mergedLock.ID)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 10)}, mergedLock.Coins)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtMergeLocks, 1)
+
+	_, err = s.App.LockupKeeper.GetLockByID(s.Ctx, splitLock.ID)
+	s.Require().Error(err)
+	locks := s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, addr1, "stake", time.Second)
+	s.Require().Len(locks, 1)
+	s.Require().Equal(mergedLock, locks[0])
+	s.Require().Equal(osmomath.NewInt(10), s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, query))
+}
+
+func (s *KeeperTestSuite) TestSplitLockByID_Errors() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	lockedCoins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	halfCoins := sdk.Coins{sdk.NewInt64Coin("stake", 5)}
+
+	tests := map[string]struct {
+		setup    func(lockID uint64)
+		sender   sdk.AccAddress
+		coins    sdk.Coins
+		denom    string
+		expected string
+	}{
+		"full amount": {
+			sender:   addr1,
+			coins:    lockedCoins,
+			expected: "must be positive and less than the locked amount",
+		},
+		"not the owner": {
+			sender:   addr2,
+			coins:    halfCoins,
+			expected: types.ErrNotLockOwner.Error(),
+		},
+		"unlocking lock": {
+			setup: func(lockID uint64) {
+				_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, lockID, nil)
+				s.Require().NoError(err)
+			},
+			sender:   addr1,
+			coins:    halfCoins,
+			expected: "is unlocking",
+		},
+		"lock with synthetic lockup": {
+			setup: func(lockID uint64) {
+				err := s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lockID, "synthetic", time.Second, false)
+				s.Require().NoError(err)
+			},
+			sender:   addr1,
+			coins:    halfCoins,
+			expected: "has a synthetic lockup",
+		},
+		"concentrated liquidity lock": {
+			sender:   addr1,
+			denom:    "cl/pool/1",
+			coins:    sdk.Coins{sdk.NewInt64Coin("cl/pool/1", 5)},
+			expected: "holds concentrated liquidity shares",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			denom := "stake"
+			if tc.denom != "" {
+				denom = tc.denom
+			}
+			lockID := s.KeeperTestHelper.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin(denom, 10)}, time.Second)
+			if tc.setup != nil {
+				tc.setup(lockID)
+			}
+
+			_, err := s.App.LockupKeeper.SplitLockByID(s.Ctx, tc.sender, lockID, tc.coins)
+			s.Require().ErrorContains(err, tc.expected)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMergeLocks_Errors() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := map[string]struct {
+		otherLock func() uint64
+		expected  string
+	}{
+		"different duration": {
+			otherLock: func() uint64 {
+				return s.KeeperTestHelper.LockTokens(addr1, coins, 2*time.Second)
+			},
+			expected: "differ in denom, duration or reward receiver",
+		},
+		"different denom": {
+			otherLock: func() uint64 {
+				return s.KeeperTestHelper.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 10)}, time.Second)
+			},
+			expected: "differ in denom, duration or reward receiver",
+		},
+		"different owner": {
+			otherLock: func() uint64 {
+				return s.KeeperTestHelper.LockTokens(addr2, coins, time.Second)
+			},
+			expected: types.ErrNotLockOwner.Error(),
+		},
+		"unlocking lock": {
+			otherLock: func() uint64 {
+				lockID := s.KeeperTestHelper.LockTokens(addr1, coins, 2*time.Second)
+				_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, lockID, nil)
+				s.Require().NoError(err)
+				return lockID
+			},
+			expected: "is unlocking",
+		},
+		"duplicate lock": {
+			otherLock: func() uint64 {
+				return 1
+			},
+			expected: "duplicate lockup ID",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			lockID := s.KeeperTestHelper.LockTokens(addr1, coins, time.Second)
+			s.Require().Equal(uint64(1), lockID)
+
+			_, err := s.App.LockupKeeper.MergeLocks(s.Ctx, addr1, []uint64{lockID, tc.otherLock()})
+			s.Require().ErrorContains(err, tc.expected)
+		})
+	}
+}
@@ -345,11 +345,11 @@ func (s *KeeperTestSuite) TestUnlockMaturedLockInternalLogic() {
 			expectedError:              false,
 		},
 		{
-			name:                       "unlock lock with gamm and cl shares (should not be possible)",
+			name:                       "lock with gamm and cl shares is rejected",
 			coinsLocked:                sdk.NewCoins(sdk.NewCoin("gamm/pool/1", osmomath.NewInt(100)), sdk.NewCoin("cl/pool/1/1", osmomath.NewInt(100))),
 			coinsBurned:                sdk.NewCoins(sdk.NewCoin("cl/pool/1/1", osmomath.NewInt(100))),
 			expectedFinalCoinsSentBack: sdk.NewCoins(sdk.NewCoin("gamm/pool/1", osmomath.NewInt(100))),
-			expectedError:              false,
+			expectedError:              true,
 		},
 	}
 
@@ -373,6 +373,10 @@ func (s *KeeperTestSuite) TestUnlockMaturedLockInternalLogic() {
 
 			// Lock the shares
 			lockCreated, err := lockupKeeper.CreateLock(ctx, owner, tc.coinsLocked, time.Hour)
+			if tc.expectedError {
+				s.Require().ErrorIs(err, types.ErrMultiCoinLock)
+				return
+			}
 			s.Require().NoError(err)
 
 			// Begin unlocking the lock
@@ -1094,8 +1098,13 @@ func (s *KeeperTestSuite) TestEndblockerWithdrawAllMaturedLockups() {
 	clPoolDenom := cltypes.GetConcentratedLockupDenomFromPoolId(1)
 
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
-	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin(clPoolDenom, 20))
-	totalCoins := coins.Add(coins...).Add(coins...)
+	// lock stake, CL shares and stake again, as locks can only hold a single denom
+	lockCoins := []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		sdk.NewCoins(sdk.NewInt64Coin(clPoolDenom, 20)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+	totalCoins := lockCoins[0].Add(lockCoins[1]...).Add(lockCoins[2]...)
 
 	// lock coins for 5 second, 1 seconds, and 3 seconds in that order
 	times := []time.Duration{time.Second * 5, time.Second, time.Second * 3}
@@ -1110,7 +1119,7 @@ func (s *KeeperTestSuite) TestEndblockerWithdrawAllMaturedLockups() {
 		}
 
 		for i := 0; i < len(times); i++ {
-			s.LockTokens(addr1, coins, times[i])
+			s.LockTokens(addr1, lockCoins[i], times[i])
 		}
 
 		// consistency check locks
@@ -1225,6 +1234,55 @@ func (s *KeeperTestSuite) TestLockAccumulationStore() {
 	s.Require().Equal(int64(0), acc.Int64())
 }
 
+func (s *KeeperTestSuite) TestLockCountStore() {
+	s.SetupTest()
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	requireLockCounts := func(expectedCounts map[time.Duration]int64) {
+		for duration, expectedCount := range expectedCounts {
+			count := s.App.LockupKeeper.GetPeriodLocksCount(s.Ctx, types.QueryCondition{
+				Denom:    "stake",
+				Duration: duration,
+			})
+			s.Require().Equal(expectedCount, count.Int64(), "duration %s", duration)
+		}
+	}
+
+	// 1 * time.Second: lock 1, lock 2
+	// 2 * time.Second: lock 3, lock 4
+	// 3 * time.Second: lock 5
+	s.LockTokens(addr, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	s.LockTokens(addr, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second)
+	s.LockTokens(addr, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second*2)
+	s.LockTokens(addr, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, time.Second*2)
+	s.LockTokens(addr, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, time.Second*3)
+	requireLockCounts(map[time.Duration]int64{0: 5, time.Second: 5, time.Second * 2: 3, time.Second * 3: 1, time.Second * 4: 0})
+
+	// adding tokens to a lock does not change the counts
+	_, err := s.App.LockupKeeper.AddTokensToLockByID(s.Ctx, 1, addr, sdk.NewInt64Coin("stake", 10))
+	s.Require().NoError(err)
+	requireLockCounts(map[time.Duration]int64{0: 5, time.Second * 2: 3, time.Second * 3: 1})
+
+	// extending a lock moves it to its new duration
+	err = s.App.LockupKeeper.ExtendLockup(s.Ctx, 1, addr, time.Second*3)
+	s.Require().NoError(err)
+	requireLockCounts(map[time.Duration]int64{0: 5, time.Second * 2: 4, time.Second * 3: 2})
+
+	// splitting a lock adds a lock of the same duration
+	_, err = s.App.LockupKeeper.SplitLockByID(s.Ctx, addr, 2, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	s.Require().NoError(err)
+	requireLockCounts(map[time.Duration]int64{0: 6, time.Second * 2: 4, time.Second * 3: 2})
+
+	// merging locks removes the merged ones
+	_, err = s.App.LockupKeeper.MergeLocks(s.Ctx, addr, []uint64{3, 4})
+	s.Require().NoError(err)
+	requireLockCounts(map[time.Duration]int64{0: 5, time.Second * 2: 3, time.Second * 3: 2})
+
+	// rebuilding the store from the locks in state gives the same counts
+	err = s.App.LockupKeeper.RebuildLockCounts(s.Ctx)
+	s.Require().NoError(err)
+	requireLockCounts(map[time.Duration]int64{0: 5, time.Second: 5, time.Second * 2: 3, time.Second * 3: 2, time.Second * 4: 0})
+}
+
 func (s *KeeperTestSuite) TestSlashTokensFromLockByID() {
 	s.SetupTest()
 
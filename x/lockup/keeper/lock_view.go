@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+)
+
+// GetAccountLockViews returns every lock of the given account joined with its unlocking status, its synthetic lockup
+// and the gauges it qualifies for at the next distribution.
+func (k Keeper) GetAccountLockViews(ctx sdk.Context, owner sdk.AccAddress) ([]types.LockView, error) {
+	locks := k.GetAccountPeriodLocks(ctx, owner)
+	nextDistrTime := k.ik.GetNextDistributionTime(ctx)
+
+	lockViews := make([]types.LockView, 0, len(locks))
+	for _, lock := range locks {
+		lockView := types.LockView{
+			Lock:        lock,
+			IsUnlocking: lock.IsUnlocking(),
+		}
+
+		synthLock, found, err := k.GetSyntheticLockupByUnderlyingLockId(ctx, lock.ID)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			lockView.SyntheticLock = &synthLock
+		}
+
+		lockView.QualifyingGaugeIds, err = k.ik.GetQualifyingGaugeIDs(ctx, lock, lockView.SyntheticLock, nextDistrTime)
+		if err != nil {
+			return nil, err
+		}
+		if len(lockView.QualifyingGaugeIds) > 0 {
+			lockView.NextDistributionTime = &nextDistrTime
+		}
+
+		lockViews = append(lockViews, lockView)
+	}
+
+	return lockViews, nil
+}
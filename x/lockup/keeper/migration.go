@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 
@@ -141,3 +142,74 @@ func MergeLockupsForSimilarDurations(
 		// numLocksNormalized, numLocksCreated)
 	}
 }
+
+// SplitMultiCoinLocks splits every lock holding more than one denom into single-coin locks.
+// The first coin of a multi-coin lock stays in the lock under its original ID, while every
+// other coin is moved into a new lock with the same owner, reward receiver, duration and end time.
+// Each new lock is recorded as an alias of the original lock ID, so that references to the
+// original lock can be resolved to all of the locks now holding its coins.
+// Locks are streamed from the store one at a time, and only the IDs of multi-coin locks are kept in memory.
+func SplitMultiCoinLocks(ctx sdk.Context, k Keeper) error {
+	multiCoinLockIDs, err := k.getMultiCoinLockIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	numLocksSplit := 0
+	for _, lockID := range multiCoinLockIDs {
+		lockPtr, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return err
+		}
+		lock := *lockPtr
+
+		lockRefPrefix := types.KeyPrefixNotUnlocking
+		if lock.IsUnlocking() {
+			lockRefPrefix = types.KeyPrefixUnlocking
+		}
+		err = k.deleteLockRefs(ctx, lockRefPrefix, lock)
+		if err != nil {
+			return err
+		}
+
+		splitCoins := lock.Coins[1:]
+		lock.Coins = sdk.NewCoins(lock.Coins[0])
+		err = k.setLockAndAddLockRefs(ctx, lock)
+		if err != nil {
+			return err
+		}
+
+		for _, coin := range splitCoins {
+			splitLockID := k.GetLastLockID(ctx) + 1
+			splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.RewardReceiverAddress, lock.Duration, lock.EndTime, sdk.NewCoins(coin))
+			err = k.setLockAndAddLockRefs(ctx, splitLock)
+			if err != nil {
+				return err
+			}
+			k.SetLastLockID(ctx, splitLockID)
+
+			err = k.setLockAlias(ctx, types.LockAlias{OriginalLockId: lock.ID, SplitLockId: splitLockID})
+			if err != nil {
+				return err
+			}
+		}
+
+		// don't call hooks or touch the accumulation store, tokens are just moved from a lock to another
+		numLocksSplit++
+	}
+
+	ctx.Logger().Info(fmt.Sprintf("split %d multi-coin locks into single-coin locks", numLocksSplit))
+	return nil
+}
+
+// getMultiCoinLockIDs iterates over all locks in ID order and returns the IDs of the ones holding more than one denom.
+func (k Keeper) getMultiCoinLockIDs(ctx sdk.Context) ([]uint64, error) {
+	lockIDs := []uint64{}
+	err := k.IterateLocks(ctx, func(lock types.PeriodLock) error {
+		if len(lock.Coins) > 1 {
+			lockIDs = append(lockIDs, lock.ID)
+		}
+		return nil
+	})
+	return lockIDs, err
+}
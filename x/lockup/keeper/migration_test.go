@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/osmosis-labs/osmosis/v26/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -69,3 +70,63 @@ func (s *KeeperTestSuite) TestLockupMergeMigration() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestSplitMultiCoinLocks() {
+	s.SetupTest()
+	lockupKeeper := s.App.LockupKeeper
+	owner, rewardReceiver := s.TestAccs[0], s.TestAccs[1]
+
+	// Multi-coin locks can no longer be created, so we initialize them as legacy state.
+	multiCoinLock := types.NewPeriodLock(1, owner, rewardReceiver.String(), time.Hour, time.Time{},
+		sdk.NewCoins(sdk.NewInt64Coin("bar", 10), sdk.NewInt64Coin("baz", 20), sdk.NewInt64Coin("foo", 30)))
+	unlockingMultiCoinLock := types.NewPeriodLock(2, owner, "", time.Hour, s.Ctx.BlockTime().Add(time.Hour),
+		sdk.NewCoins(sdk.NewInt64Coin("bar", 40), sdk.NewInt64Coin("foo", 50)))
+	singleCoinLock := types.NewPeriodLock(3, owner, "", time.Hour, time.Time{}, sdk.NewCoins(sdk.NewInt64Coin("foo", 60)))
+	err := lockupKeeper.InitializeAllLocks(s.Ctx, []types.PeriodLock{multiCoinLock, unlockingMultiCoinLock, singleCoinLock})
+	s.Require().NoError(err)
+	lockupKeeper.SetLastLockID(s.Ctx, 3)
+	lockedCoinsBefore := lockupKeeper.GetAccountLockedCoins(s.Ctx, owner)
+
+	err = keeper.SplitMultiCoinLocks(s.Ctx, *lockupKeeper)
+	s.Require().NoError(err)
+
+	// The first coin of each multi-coin lock stays under its original ID.
+	lock, err := lockupKeeper.GetLockByID(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bar", 10)), lock.Coins)
+	lock, err = lockupKeeper.GetLockByID(s.Ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bar", 40)), lock.Coins)
+
+	// The other coins are moved into new locks with the same parameters, aliased to the original ID.
+	expectedSplitLocks := []types.PeriodLock{
+		types.NewPeriodLock(4, owner, rewardReceiver.String(), time.Hour, time.Time{}, sdk.NewCoins(sdk.NewInt64Coin("baz", 20))),
+		types.NewPeriodLock(5, owner, rewardReceiver.String(), time.Hour, time.Time{}, sdk.NewCoins(sdk.NewInt64Coin("foo", 30))),
+		types.NewPeriodLock(6, owner, "", time.Hour, unlockingMultiCoinLock.EndTime, sdk.NewCoins(sdk.NewInt64Coin("foo", 50))),
+	}
+	for _, expectedLock := range expectedSplitLocks {
+		lock, err := lockupKeeper.GetLockByID(s.Ctx, expectedLock.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedLock, *lock)
+	}
+	s.Require().Equal(uint64(6), lockupKeeper.GetLastLockID(s.Ctx))
+	s.Require().Equal([]uint64{4, 5}, lockupKeeper.GetLockAliases(s.Ctx, 1))
+	s.Require().Equal([]uint64{6}, lockupKeeper.GetLockAliases(s.Ctx, 2))
+	s.Require().Empty(lockupKeeper.GetLockAliases(s.Ctx, 3))
+
+	// The lock refs are updated to match the split locks.
+	fooLocks := lockupKeeper.GetLocksLongerThanDurationDenom(s.Ctx, "foo", time.Hour)
+	fooLockIDs := []uint64{}
+	for _, lock := range fooLocks {
+		fooLockIDs = append(fooLockIDs, lock.ID)
+	}
+	s.Require().ElementsMatch([]uint64{3, 5, 6}, fooLockIDs)
+	s.Require().Equal(lockedCoinsBefore, lockupKeeper.GetAccountLockedCoins(s.Ctx, owner))
+
+	// New multi-coin locks are rejected.
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewInt64Coin("bar", 10), sdk.NewInt64Coin("foo", 10)))
+	_, err = lockupKeeper.CreateLock(s.Ctx, owner, sdk.NewCoins(sdk.NewInt64Coin("bar", 10), sdk.NewInt64Coin("foo", 10)), time.Hour)
+	s.Require().ErrorIs(err, types.ErrMultiCoinLock)
+	_, err = lockupKeeper.AddTokensToLockByID(s.Ctx, 3, owner, sdk.NewInt64Coin("bar", 10))
+	s.Require().ErrorIs(err, types.ErrMultiCoinLock)
+}
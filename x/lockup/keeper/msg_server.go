@@ -102,6 +102,29 @@ func (server msgServer) BeginUnlocking(goCtx context.Context, msg *types.MsgBegi
 	return &types.MsgBeginUnlockingResponse{Success: true, UnlockingLockID: unlockingLock}, nil
 }
 
+// BeginUnlockingPartial begins unlocking a sub-amount of the specified lock, keeping the remainder locked.
+func (server msgServer) BeginUnlockingPartial(goCtx context.Context, msg *types.MsgBeginUnlockingPartial) (*types.MsgBeginUnlockingPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lock, err := server.keeper.GetLockByID(ctx, msg.ID)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Owner != lock.Owner {
+		return nil, errorsmod.Wrap(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
+	}
+
+	unlockingLock, err := server.keeper.BeginPartialUnlock(ctx, lock.ID, msg.Coins)
+	if err != nil {
+		return nil, err
+	}
+
+	// N.B. begin unlock event is emitted downstream in the keeper method.
+
+	return &types.MsgBeginUnlockingPartialResponse{UnlockingLockId: unlockingLock}, nil
+}
+
 // BeginUnlockingAll begins unlocking for all the locks that the account has by iterating all the not-unlocking locks the account holds.
 func (server msgServer) BeginUnlockingAll(goCtx context.Context, msg *types.MsgBeginUnlockingAll) (*types.MsgBeginUnlockingAllResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -134,6 +157,103 @@ func (server msgServer) BeginUnlockingAll(goCtx context.Context, msg *types.MsgB
 	return &types.MsgBeginUnlockingAllResponse{}, nil
 }
 
+// SplitLock splits a sub-amount of the specified lock into a new lock.
+func (server msgServer) SplitLock(goCtx context.Context, msg *types.MsgSplitLock) (*types.MsgSplitLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	splitLock, err := server.keeper.SplitLockByID(ctx, owner, msg.ID, msg.Coins)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSplitLockResponse{NewLockId: splitLock.ID}, nil
+}
+
+// MergeLocks merges the specified locks into the first of them.
+func (server msgServer) MergeLocks(goCtx context.Context, msg *types.MsgMergeLocks) (*types.MsgMergeLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedLock, err := server.keeper.MergeLocks(ctx, owner, msg.LockIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMergeLocksResponse{LockId: mergedLock.ID}, nil
+}
+
+// ConvertPermanentLock converts a permanent lock into a lock with the permanent lock conversion duration.
+func (server msgServer) ConvertPermanentLock(goCtx context.Context, msg *types.MsgConvertPermanentLock) (*types.MsgConvertPermanentLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.ConvertPermanentLock(ctx, msg.ID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertPermanentLockResponse{}, nil
+}
+
+// CreateBulkLockDistribution escrows the funds of a bulk lock distribution from the sender.
+func (server msgServer) CreateBulkLockDistribution(goCtx context.Context, msg *types.MsgCreateBulkLockDistribution) (*types.MsgCreateBulkLockDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	distributionID, err := server.keeper.CreateBulkLockDistribution(ctx, sender, msg.MerkleRoot, msg.NumEntries, msg.Duration, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateBulkLockDistributionResponse{DistributionId: distributionID}, nil
+}
+
+// QueueBulkLockEntries verifies and queues entries of a bulk lock distribution.
+func (server msgServer) QueueBulkLockEntries(goCtx context.Context, msg *types.MsgQueueBulkLockEntries) (*types.MsgQueueBulkLockEntriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.QueueBulkLockEntries(ctx, msg.DistributionId, msg.Leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgQueueBulkLockEntriesResponse{}, nil
+}
+
+// CancelBulkLockDistribution cancels a bulk lock distribution and refunds its creator.
+func (server msgServer) CancelBulkLockDistribution(goCtx context.Context, msg *types.MsgCancelBulkLockDistribution) (*types.MsgCancelBulkLockDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.CancelBulkLockDistribution(ctx, sender, msg.DistributionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelBulkLockDistributionResponse{}, nil
+}
+
 func createBeginUnlockEvent(lock *types.PeriodLock) sdk.Event {
 	return sdk.NewEvent(
 		types.TypeEvtBeginUnlock,
@@ -202,6 +202,86 @@ func (s *KeeperTestSuite) TestMsgBeginUnlocking() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgBeginUnlockingPartial() {
+	lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	coinsToLock := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := []struct {
+		name              string
+		coinsToUnlock     sdk.Coins
+		isSyntheticLockup bool
+		expectErr         bool
+	}{
+		{
+			name:          "unlock partial amount of tokens",
+			coinsToUnlock: sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+		},
+		{
+			name:          "unlock full amount of tokens",
+			coinsToUnlock: coinsToLock,
+			expectErr:     true,
+		},
+		{
+			name:          "unlock more than the locked amount of tokens",
+			coinsToUnlock: sdk.Coins{sdk.NewInt64Coin("stake", 11)},
+			expectErr:     true,
+		},
+		{
+			name:          "unlock zero amount of tokens",
+			coinsToUnlock: sdk.Coins{},
+			expectErr:     true,
+		},
+		{
+			name:          "unlock tokens of another denom",
+			coinsToUnlock: sdk.Coins{sdk.NewInt64Coin("foo", 4)},
+			expectErr:     true,
+		},
+		{
+			name:              "unlock partial amount of tokens for lockup with synthetic versions",
+			coinsToUnlock:     sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			isSyntheticLockup: true,
+			expectErr:         true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			s.FundAcc(lockOwner, coinsToLock)
+
+			msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+			resp, err := msgServer.LockTokens(s.Ctx, types.NewMsgLockTokens(lockOwner, time.Second, coinsToLock))
+			s.Require().NoError(err)
+
+			if test.isSyntheticLockup {
+				err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, resp.ID, "synthetic", time.Second, false)
+				s.Require().NoError(err)
+			}
+
+			unlockingResponse, err := msgServer.BeginUnlockingPartial(s.Ctx, types.NewMsgBeginUnlockingPartial(lockOwner, resp.ID, test.coinsToUnlock))
+			if test.expectErr {
+				s.Require().Error(err)
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtBeginUnlock, 0)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtBeginUnlock, 1)
+			s.Require().Equal(resp.ID+1, unlockingResponse.UnlockingLockId)
+
+			// The remainder stays locked under the original lock ID.
+			remainingLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, resp.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(coinsToLock.Sub(test.coinsToUnlock...), remainingLock.Coins)
+			s.Require().False(remainingLock.IsUnlocking())
+
+			unlockingLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, unlockingResponse.UnlockingLockId)
+			s.Require().NoError(err)
+			s.Require().Equal(test.coinsToUnlock, unlockingLock.Coins)
+			s.Require().True(unlockingLock.IsUnlocking())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgBeginUnlockingAll() {
 	type param struct {
 		coinsToLock         sdk.Coins
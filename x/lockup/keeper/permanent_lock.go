@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+)
+
+// ConvertPermanentLock converts a permanent lock into a lock with the permanent lock conversion duration
+// set by governance, after which it can begin unlocking as any other lock.
+// The lock keeps its ID, coins and reward receiver, and its coins are moved to the new duration in the
+// accumulation store.
+// Returns an error if:
+// - the permanent lock conversion is disabled
+// - the lock is not owned by owner
+// - the lock is not a permanent lock
+// - the lock has a synthetic lock, superfluid staked permanent locks must be undelegated first
+func (k Keeper) ConvertPermanentLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress) error {
+	conversionDuration := k.GetPermanentLockConversionDuration(ctx)
+	if conversionDuration == 0 {
+		return types.ErrPermanentLockConversionDisabled
+	}
+
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if lock.Owner != owner.String() {
+		return errorsmod.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner, lock.Owner)
+	}
+	if !lock.IsPermanent() {
+		return fmt.Errorf("lock %d is not a permanent lock", lock.ID)
+	}
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return fmt.Errorf("cannot convert permanent lock %d with synthetic lockup", lock.ID)
+	}
+
+	// permanent locks never begin unlocking, so their refs are in the not unlocking queue.
+	if err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, *lock); err != nil {
+		return err
+	}
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(conversionDuration), coin.Amount)
+	}
+	prevDuration := lock.Duration
+	lock.Duration = conversionDuration
+
+	if err := k.addLockRefs(ctx, *lock); err != nil {
+		return err
+	}
+	if err := k.setLock(ctx, *lock); err != nil {
+		return err
+	}
+	k.hooks.OnLockupExtend(ctx, lock.ID, prevDuration, lock.Duration)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtConvertPermanentLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
+		),
+	})
+
+	return nil
+}
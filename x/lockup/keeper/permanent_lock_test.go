@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+)
+
+func (s *KeeperTestSuite) TestPermanentLock_BeginUnlock() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	permanentLockID := s.KeeperTestHelper.LockTokens(addr1, coins, types.PermanentLockDuration)
+	lockID := s.KeeperTestHelper.LockTokens(addr1, coins, time.Second)
+
+	// permanent locks qualify for any duration.
+	query := types.QueryCondition{LockQueryType: types.ByDuration, Denom: "stake", Duration: 100 * 365 * 24 * time.Hour}
+	s.Require().Equal(osmomath.NewInt(10), s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, query))
+
+	_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, permanentLockID, nil)
+	s.Require().ErrorIs(err, types.ErrPermanentLock)
+	_, err = s.App.LockupKeeper.BeginPartialUnlock(s.Ctx, permanentLockID, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().ErrorIs(err, types.ErrPermanentLock)
+	_, err = s.App.LockupKeeper.BeginForceUnlock(s.Ctx, permanentLockID, nil)
+	s.Require().ErrorIs(err, types.ErrPermanentLock)
+
+	// unlocking all locks of the account leaves the permanent lock locked.
+	unlockingLocks, err := s.App.LockupKeeper.BeginUnlockAllNotUnlockings(s.Ctx, addr1)
+	s.Require().NoError(err)
+	s.Require().Len(unlockingLocks, 1)
+	s.Require().Equal(lockID, unlockingLocks[0].ID)
+
+	permanentLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, permanentLockID)
+	s.Require().NoError(err)
+	s.Require().True(permanentLock.IsPermanent())
+	s.Require().False(permanentLock.IsUnlocking())
+}
+
+func (s *KeeperTestSuite) TestConvertPermanentLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	conversionDuration := 14 * 24 * time.Hour
+
+	tests := map[string]struct {
+		conversionDuration time.Duration
+		lockDuration       time.Duration
+		sender             sdk.AccAddress
+		isSuperfluid       bool
+		expectedErr        error
+	}{
+		"convert permanent lock": {
+			conversionDuration: conversionDuration,
+			lockDuration:       types.PermanentLockDuration,
+			sender:             addr1,
+		},
+		"conversion disabled": {
+			lockDuration: types.PermanentLockDuration,
+			sender:       addr1,
+			expectedErr:  types.ErrPermanentLockConversionDisabled,
+		},
+		"not the owner": {
+			conversionDuration: conversionDuration,
+			lockDuration:       types.PermanentLockDuration,
+			sender:             addr2,
+			expectedErr:        types.ErrNotLockOwner,
+		},
+		"not a permanent lock": {
+			conversionDuration: conversionDuration,
+			lockDuration:       time.Second,
+			sender:             addr1,
+			expectedErr:        fmt.Errorf("lock 1 is not a permanent lock"),
+		},
+		"superfluid staked permanent lock": {
+			conversionDuration: conversionDuration,
+			lockDuration:       types.PermanentLockDuration,
+			sender:             addr1,
+			isSuperfluid:       true,
+			expectedErr:        fmt.Errorf("cannot convert permanent lock 1 with synthetic lockup"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			params := s.App.LockupKeeper.GetParams(s.Ctx)
+			params.PermanentLockConversionDuration = tc.conversionDuration
+			s.App.LockupKeeper.SetParams(s.Ctx, params)
+
+			lockID := s.KeeperTestHelper.LockTokens(addr1, coins, tc.lockDuration)
+			if tc.isSuperfluid {
+				err := s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lockID, "synthstakestakedtovalidator", time.Second, false)
+				s.Require().NoError(err)
+			}
+
+			err := s.App.LockupKeeper.ConvertPermanentLock(s.Ctx, lockID, tc.sender)
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtConvertPermanentLock, 1)
+
+			lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockID)
+			s.Require().NoError(err)
+			s.Require().Equal(conversionDuration, lock.Duration)
+			s.Require().False(lock.IsPermanent())
+			s.Require().Len(s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, addr1, "stake", conversionDuration), 1)
+
+			// the coins are moved to the conversion duration in the accumulation store.
+			query := types.QueryCondition{LockQueryType: types.ByDuration, Denom: "stake", Duration: conversionDuration}
+			s.Require().Equal(osmomath.NewInt(10), s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, query))
+			query.Duration = conversionDuration + time.Second
+			s.Require().True(s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, query).IsZero())
+
+			// the converted lock can be unlocked.
+			_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lockID, nil)
+			s.Require().NoError(err)
+			lock, err = s.App.LockupKeeper.GetLockByID(s.Ctx, lockID)
+			s.Require().NoError(err)
+			s.Require().Equal(s.Ctx.BlockTime().Add(conversionDuration), lock.EndTime)
+		})
+	}
+}
@@ -95,6 +95,15 @@ func accumulationStorePrefix(denom string) (res []byte) {
 	return
 }
 
+// lockCountStorePrefix returns prefix for the lock count store of a denom.
+func lockCountStorePrefix(denom string) (res []byte) {
+	capacity := len(types.KeyPrefixLockCount) + len(denom) + 1
+	res = make([]byte, len(types.KeyPrefixLockCount), capacity)
+	copy(res, types.KeyPrefixLockCount)
+	res = append(res, []byte(denom+"/")...)
+	return
+}
+
 // accumulationKey should return sort key upon duration.
 func accumulationKey(duration time.Duration) (res []byte) {
 	res = make([]byte, 8)
@@ -269,6 +278,24 @@ func (k Keeper) GetLockRewardReceiver(ctx sdk.Context, lockID uint64) (string, e
 	return rewardReceiverAddress, nil
 }
 
+// IterateLocks calls fn on every lock in ID order, without loading all of them into memory.
+// Stops at and returns the first error returned by fn.
+func (k Keeper) IterateLocks(ctx sdk.Context, fn func(lock types.PeriodLock) error) error {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixPeriodLock)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		lock := types.PeriodLock{}
+		if err := proto.Unmarshal(iterator.Value(), &lock); err != nil {
+			return err
+		}
+		if err := fn(lock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetPeriodLocks Returns the period locks on pool.
 func (k Keeper) GetPeriodLocks(ctx sdk.Context) ([]types.PeriodLock, error) {
 	unlockings := k.getLocksFromIterator(ctx, k.LockIterator(ctx, true))
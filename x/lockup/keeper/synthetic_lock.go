@@ -9,6 +9,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 )
 
@@ -158,7 +160,7 @@ func (k Keeper) CreateSyntheticLockup(ctx sdk.Context, lockID uint64, synthDenom
 		return err
 	}
 
-	k.accumulationStore(ctx, synthLock.SynthDenom).Increase(accumulationKey(unlockDuration), coin.Amount)
+	k.increaseSyntheticLockAccumulation(ctx, synthLock.SynthDenom, unlockDuration, coin.Amount)
 	return nil
 }
 
@@ -195,7 +197,74 @@ func (k Keeper) DeleteSyntheticLockup(ctx sdk.Context, lockID uint64, synthdenom
 	if err != nil {
 		return err
 	}
-	k.accumulationStore(ctx, synthLock.SynthDenom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	k.decreaseSyntheticLockAccumulation(ctx, synthLock.SynthDenom, lock.Duration, coin.Amount)
+	return nil
+}
+
+// increaseSyntheticLockAccumulation increases the accumulation store of the synthetic denom at the given duration.
+// The superbonded total of the native denom is increased as well if the synthetic denom is superbonding.
+func (k Keeper) increaseSyntheticLockAccumulation(ctx sdk.Context, synthDenom string, duration time.Duration, amount osmomath.Int) {
+	k.accumulationStore(ctx, synthDenom).Increase(accumulationKey(duration), amount)
+	if types.IsSuperbondingDenom(synthDenom) {
+		k.increaseSuperbondedTotal(ctx, types.NativeDenom(synthDenom), amount)
+	}
+}
+
+// decreaseSyntheticLockAccumulation decreases the accumulation store of the synthetic denom at the given duration.
+// The superbonded total of the native denom is decreased as well if the synthetic denom is superbonding.
+func (k Keeper) decreaseSyntheticLockAccumulation(ctx sdk.Context, synthDenom string, duration time.Duration, amount osmomath.Int) {
+	k.accumulationStore(ctx, synthDenom).Decrease(accumulationKey(duration), amount)
+	if types.IsSuperbondingDenom(synthDenom) {
+		k.increaseSuperbondedTotal(ctx, types.NativeDenom(synthDenom), amount.Neg())
+	}
+}
+
+// GetSuperbondedTotal returns the total amount of the given native denom locked by superbonding synthetic lockups,
+// i.e. superfluid delegated across all validators.
+func (k Keeper) GetSuperbondedTotal(ctx sdk.Context, denom string) osmomath.Int {
+	total, err := osmoutils.GetCoinByDenomFromPrefix(ctx, k.storeKey, types.KeyPrefixSuperbondedTotal, denom)
+	if err != nil {
+		panic(err)
+	}
+	return total.Amount
+}
+
+// increaseSuperbondedTotal increases the superbonded total of the given native denom by the given amount,
+// which is negative for decreases.
+func (k Keeper) increaseSuperbondedTotal(ctx sdk.Context, denom string, amount osmomath.Int) {
+	if err := osmoutils.IncreaseCoinByDenomFromPrefix(ctx, k.storeKey, types.KeyPrefixSuperbondedTotal, denom, amount); err != nil {
+		panic(err)
+	}
+}
+
+// BackfillSuperbondedTotals resets the superbonded totals of all native denoms from the synthetic lockups in state.
+// Used to backfill the totals of the synthetic lockups created before they were tracked.
+// Synthetic lockups are streamed from the store, so that they are never all loaded in memory.
+func (k Keeper) BackfillSuperbondedTotals(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.DeleteAllKeysFromPrefix(store, types.KeyPrefixSuperbondedTotal)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixSyntheticLockup)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		synthLock := types.SyntheticLock{}
+		if err := proto.Unmarshal(iterator.Value(), &synthLock); err != nil {
+			return err
+		}
+		if !types.IsSuperbondingDenom(synthLock.SynthDenom) {
+			continue
+		}
+
+		lock, err := k.GetLockByID(ctx, synthLock.UnderlyingLockId)
+		if err != nil {
+			return err
+		}
+		coin, err := lock.SingleCoin()
+		if err != nil {
+			return err
+		}
+		k.increaseSuperbondedTotal(ctx, types.NativeDenom(synthLock.SynthDenom), coin.Amount)
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 
@@ -346,3 +347,33 @@ func (s *KeeperTestSuite) TestResetAllSyntheticLocks() {
 	})
 	s.Require().Equal(accum.String(), "10")
 }
+
+// TestSuperbondedTotal tests that the superbonded total of a denom tracks the amounts of its superbonding
+// synthetic lockups as they are created and deleted, ignoring superunbonding synthetic lockups,
+// and that the backfill recomputes the same total.
+func (s *KeeperTestSuite) TestSuperbondedTotal() {
+	s.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	for i := 0; i < 3; i++ {
+		s.LockTokens(addr1, coins, time.Second)
+	}
+	s.Require().Equal(osmomath.ZeroInt(), s.App.LockupKeeper.GetSuperbondedTotal(s.Ctx, "stake"))
+
+	err := s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, 1, "stake/superbonding/val1", time.Second, false)
+	s.Require().NoError(err)
+	err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, 2, "stake/superbonding/val2", time.Second, false)
+	s.Require().NoError(err)
+	err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, 3, "stake/superunbonding/val1", time.Second, true)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(20), s.App.LockupKeeper.GetSuperbondedTotal(s.Ctx, "stake"))
+
+	err = s.App.LockupKeeper.DeleteSyntheticLockup(s.Ctx, 2, "stake/superbonding/val2")
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(10), s.App.LockupKeeper.GetSuperbondedTotal(s.Ctx, "stake"))
+
+	err = s.App.LockupKeeper.BackfillSuperbondedTotals(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(10), s.App.LockupKeeper.GetSuperbondedTotal(s.Ctx, "stake"))
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxBulkLockEntriesPerBlock is the maximum number of queued bulk lock entries
+// the end blocker turns into locks in a single block. Distributions larger than this
+// are processed across multiple blocks, resuming from the queue left by the previous block.
+const MaxBulkLockEntriesPerBlock = 500
+
+// Hash returns the merkle leaf hash of the entry, sha256("<owner>/<coin>").
+// Since bech32 addresses can not contain a slash, the encoding is unambiguous.
+func (e BulkLockEntry) Hash() []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s", e.Owner, e.Coin.String())))
+	return hash[:]
+}
+
+// Validate checks that the entry has a valid owner and a positive coin.
+func (e BulkLockEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Owner); err != nil {
+		return fmt.Errorf("invalid bulk lock entry owner %s: %w", e.Owner, err)
+	}
+	if err := e.Coin.Validate(); err != nil {
+		return fmt.Errorf("invalid bulk lock entry coin: %w", err)
+	}
+	if !e.Coin.IsPositive() {
+		return fmt.Errorf("bulk lock entry coin must be positive, got %s", e.Coin)
+	}
+	return nil
+}
+
+// HashBulkLockNodes returns the parent hash of two merkle tree nodes. The pair is sorted
+// before hashing, so proofs do not need to encode whether a sibling is on the left or right.
+func HashBulkLockNodes(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	hash := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return hash[:]
+}
+
+// VerifyBulkLockProof returns true if the proof leads from the leaf hash to the merkle root.
+func VerifyBulkLockProof(root, leaf []byte, proof [][]byte) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = HashBulkLockNodes(computed, sibling)
+	}
+	return bytes.Equal(computed, root)
+}
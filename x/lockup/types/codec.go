@@ -11,9 +11,16 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLockTokens{}, "osmosis/lockup/lock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlockingAll{}, "osmosis/lockup/begin-unlock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlocking{}, "osmosis/lockup/begin-unlock-period-lock", nil)
+	cdc.RegisterConcrete(&MsgBeginUnlockingPartial{}, "osmosis/lockup/begin-unlock-partial", nil)
 	cdc.RegisterConcrete(&MsgExtendLockup{}, "osmosis/lockup/extend-lockup", nil)
 	cdc.RegisterConcrete(&MsgForceUnlock{}, "osmosis/lockup/force-unlock-tokens", nil)
 	cdc.RegisterConcrete(&MsgSetRewardReceiverAddress{}, "osmosis/lockup/set-reward-receiver-address", nil)
+	cdc.RegisterConcrete(&MsgSplitLock{}, "osmosis/lockup/split-lock", nil)
+	cdc.RegisterConcrete(&MsgMergeLocks{}, "osmosis/lockup/merge-locks", nil)
+	cdc.RegisterConcrete(&MsgConvertPermanentLock{}, "osmosis/lockup/convert-permanent-lock", nil)
+	cdc.RegisterConcrete(&MsgCreateBulkLockDistribution{}, "osmosis/lockup/create-bulk-lock-distribution", nil)
+	cdc.RegisterConcrete(&MsgQueueBulkLockEntries{}, "osmosis/lockup/queue-bulk-lock-entries", nil)
+	cdc.RegisterConcrete(&MsgCancelBulkLockDistribution{}, "osmosis/lockup/cancel-bulk-lock-distribution", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,9 +29,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgLockTokens{},
 		&MsgBeginUnlockingAll{},
 		&MsgBeginUnlocking{},
+		&MsgBeginUnlockingPartial{},
 		&MsgExtendLockup{},
 		&MsgForceUnlock{},
 		&MsgSetRewardReceiverAddress{},
+		&MsgSplitLock{},
+		&MsgMergeLocks{},
+		&MsgConvertPermanentLock{},
+		&MsgCreateBulkLockDistribution{},
+		&MsgQueueBulkLockEntries{},
+		&MsgCancelBulkLockDistribution{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
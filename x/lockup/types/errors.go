@@ -13,4 +13,13 @@ var (
 	ErrSyntheticDurationLongerThanNative = errorsmod.Register(ModuleName, 3, "synthetic lockup duration should be shorter than native lockup duration")
 	ErrLockupNotFound                    = errorsmod.Register(ModuleName, 4, "lockup not found")
 	ErrRewardReceiverIsSame              = errorsmod.Register(ModuleName, 5, "reward receiver is the same")
+	ErrMultiCoinLock                     = errorsmod.Register(ModuleName, 6, "locks can only hold a single denom")
+	ErrInvalidPartialUnlockAmount        = errorsmod.Register(ModuleName, 7, "partial unlock amount must be positive and less than the locked amount")
+	ErrPermanentLock                     = errorsmod.Register(ModuleName, 8, "permanent locks can not be unlocked")
+	ErrPermanentLockConversionDisabled   = errorsmod.Register(ModuleName, 9, "permanent lock conversion is disabled")
+	ErrBulkLockUnauthorized              = errorsmod.Register(ModuleName, 10, "address is not allowed to manage bulk lock distributions")
+	ErrBulkLockDistributionNotFound      = errorsmod.Register(ModuleName, 11, "bulk lock distribution not found")
+	ErrInvalidBulkLockProof              = errorsmod.Register(ModuleName, 12, "bulk lock entry is not part of the distribution merkle tree")
+	ErrBulkLockOwnerAlreadyQueued        = errorsmod.Register(ModuleName, 13, "bulk lock entry owner was already queued")
+	ErrInvalidBulkLockDistribution       = errorsmod.Register(ModuleName, 14, "invalid bulk lock distribution")
 )
@@ -2,10 +2,17 @@ package types
 
 // event types.
 const (
-	TypeEvtLockTokens      = "lock_tokens"
-	TypeEvtAddTokensToLock = "add_tokens_to_lock"
-	TypeEvtBeginUnlockAll  = "begin_unlock_all"
-	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtLockTokens           = "lock_tokens"
+	TypeEvtAddTokensToLock      = "add_tokens_to_lock"
+	TypeEvtBeginUnlockAll       = "begin_unlock_all"
+	TypeEvtBeginUnlock          = "begin_unlock"
+	TypeEvtSplitLock            = "split_lock"
+	TypeEvtMergeLocks           = "merge_locks"
+	TypeEvtConvertPermanentLock = "convert_permanent_lock"
+
+	TypeEvtCreateBulkLockDistribution   = "create_bulk_lock_distribution"
+	TypeEvtCompleteBulkLockDistribution = "complete_bulk_lock_distribution"
+	TypeEvtCancelBulkLockDistribution   = "cancel_bulk_lock_distribution"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -14,4 +21,12 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributeNewPeriodLockID      = "new_period_lock_id"
+	AttributeMergedPeriodLockIDs  = "merged_period_lock_ids"
+
+	AttributeBulkLockDistributionID = "bulk_lock_distribution_id"
+	AttributeBulkLockCreator        = "creator"
+	AttributeBulkLockMerkleRoot     = "merkle_root"
+	AttributeBulkLockNumEntries     = "num_entries"
+	AttributeBulkLockRefund         = "refund"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,6 +19,7 @@ type BankKeeper interface {
 
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 }
@@ -25,3 +27,9 @@ type BankKeeper interface {
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// IncentivesKeeper defines the expected interface needed to find the gauges distributing to a lock.
+type IncentivesKeeper interface {
+	GetNextDistributionTime(ctx sdk.Context) time.Time
+	GetQualifyingGaugeIDs(ctx sdk.Context, lock PeriodLock, synthLock *SyntheticLock, distrTime time.Time) ([]uint64, error)
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultIndex is the default capability global index.
 const DefaultIndex uint64 = 1
 
@@ -11,5 +17,30 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	for _, state := range gs.BulkLockDistributions {
+		distribution := state.Distribution
+		if distribution.Id == 0 || distribution.Id > gs.LastBulkLockDistributionId {
+			return fmt.Errorf("bulk lock distribution ID %d must be positive and at most the last distribution ID %d", distribution.Id, gs.LastBulkLockDistributionId)
+		}
+		if distribution.ProcessedEntries > distribution.QueuedEntries || distribution.QueuedEntries > distribution.NumEntries {
+			return fmt.Errorf("bulk lock distribution %d has %d processed and %d queued of %d entries", distribution.Id, distribution.ProcessedEntries, distribution.QueuedEntries, distribution.NumEntries)
+		}
+		if uint64(len(state.QueuedOwners)) != distribution.QueuedEntries {
+			return fmt.Errorf("bulk lock distribution %d has %d queued owners, expected %d", distribution.Id, len(state.QueuedOwners), distribution.QueuedEntries)
+		}
+		if uint64(len(state.Queue)) != distribution.QueuedEntries-distribution.ProcessedEntries {
+			return fmt.Errorf("bulk lock distribution %d has %d queued entries, expected %d", distribution.Id, len(state.Queue), distribution.QueuedEntries-distribution.ProcessedEntries)
+		}
+		for _, owner := range state.QueuedOwners {
+			if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+				return fmt.Errorf("bulk lock distribution %d has an invalid queued owner %s: %w", distribution.Id, owner, err)
+			}
+		}
+		for _, entry := range state.Queue {
+			if err := entry.Validate(); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
@@ -25,10 +25,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the lockup module's genesis state.
 type GenesisState struct {
-	LastLockId     uint64          `protobuf:"varint,1,opt,name=last_lock_id,json=lastLockId,proto3" json:"last_lock_id,omitempty"`
-	Locks          []PeriodLock    `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
-	SyntheticLocks []SyntheticLock `protobuf:"bytes,3,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
-	Params         *Params         `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	LastLockId                 uint64                      `protobuf:"varint,1,opt,name=last_lock_id,json=lastLockId,proto3" json:"last_lock_id,omitempty"`
+	Locks                      []PeriodLock                `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+	SyntheticLocks             []SyntheticLock             `protobuf:"bytes,3,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
+	Params                     *Params                     `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	LockAliases                []LockAlias                 `protobuf:"bytes,5,rep,name=lock_aliases,json=lockAliases,proto3" json:"lock_aliases"`
+	LastBulkLockDistributionId uint64                      `protobuf:"varint,6,opt,name=last_bulk_lock_distribution_id,json=lastBulkLockDistributionId,proto3" json:"last_bulk_lock_distribution_id,omitempty"`
+	BulkLockDistributions      []BulkLockDistributionState `protobuf:"bytes,7,rep,name=bulk_lock_distributions,json=bulkLockDistributions,proto3" json:"bulk_lock_distributions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,33 +95,130 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetLockAliases() []LockAlias {
+	if m != nil {
+		return m.LockAliases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastBulkLockDistributionId() uint64 {
+	if m != nil {
+		return m.LastBulkLockDistributionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBulkLockDistributions() []BulkLockDistributionState {
+	if m != nil {
+		return m.BulkLockDistributions
+	}
+	return nil
+}
+
+// BulkLockDistributionState is a bulk lock distribution that is not complete
+// yet, along with the entries it has queued.
+type BulkLockDistributionState struct {
+	Distribution BulkLockDistribution `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution"`
+	// queued_owners are the owners of every entry queued so far, including the
+	// ones already processed.
+	QueuedOwners []string `protobuf:"bytes,2,rep,name=queued_owners,json=queuedOwners,proto3" json:"queued_owners,omitempty"`
+	// queue are the queued entries that are not processed yet, in processing
+	// order.
+	Queue []BulkLockEntry `protobuf:"bytes,3,rep,name=queue,proto3" json:"queue"`
+}
+
+func (m *BulkLockDistributionState) Reset()         { *m = BulkLockDistributionState{} }
+func (m *BulkLockDistributionState) String() string { return proto.CompactTextString(m) }
+func (*BulkLockDistributionState) ProtoMessage()    {}
+func (*BulkLockDistributionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648db7c6ebb608b0, []int{1}
+}
+func (m *BulkLockDistributionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkLockDistributionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkLockDistributionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkLockDistributionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkLockDistributionState.Merge(m, src)
+}
+func (m *BulkLockDistributionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkLockDistributionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkLockDistributionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkLockDistributionState proto.InternalMessageInfo
+
+func (m *BulkLockDistributionState) GetDistribution() BulkLockDistribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return BulkLockDistribution{}
+}
+
+func (m *BulkLockDistributionState) GetQueuedOwners() []string {
+	if m != nil {
+		return m.QueuedOwners
+	}
+	return nil
+}
+
+func (m *BulkLockDistributionState) GetQueue() []BulkLockEntry {
+	if m != nil {
+		return m.Queue
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.lockup.GenesisState")
+	proto.RegisterType((*BulkLockDistributionState)(nil), "osmosis.lockup.BulkLockDistributionState")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/genesis.proto", fileDescriptor_648db7c6ebb608b0) }
 
 var fileDescriptor_648db7c6ebb608b0 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xea, 0x41, 0x64, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x24, 0x9a, 0x19,
-	0x20, 0x0a, 0x2a, 0x25, 0x8d, 0x26, 0x55, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x5d, 0xe9, 0x19,
-	0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xbe, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x05, 0x2e, 0x9e, 0x9c,
-	0xc4, 0xe2, 0x92, 0x78, 0x90, 0xe2, 0xf8, 0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20,
-	0x2e, 0x90, 0x98, 0x4f, 0x7e, 0x72, 0xb6, 0x67, 0x8a, 0x90, 0x19, 0x17, 0x2b, 0x48, 0xb2, 0x58,
-	0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x4a, 0x0f, 0xd5, 0x81, 0x7a, 0x01, 0xa9, 0x45, 0x99,
-	0xf9, 0x29, 0x20, 0xc5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x94, 0x0b, 0xf9, 0x70,
-	0xf1, 0x17, 0x57, 0xe6, 0x95, 0x64, 0xa4, 0x96, 0x64, 0x26, 0xc7, 0x43, 0x4c, 0x60, 0x06, 0x9b,
-	0x20, 0x8b, 0x6e, 0x42, 0x30, 0x4c, 0x19, 0x92, 0x21, 0x7c, 0xc5, 0xc8, 0x82, 0xc5, 0x42, 0x7a,
-	0x5c, 0x6c, 0x10, 0x8f, 0x48, 0xb0, 0x28, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0x61, 0x38, 0x03, 0x2c,
-	0x1b, 0x04, 0x55, 0xe5, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x33, 0x74, 0x73,
-	0x12, 0x93, 0x8a, 0x61, 0x1c, 0xfd, 0x32, 0x23, 0x33, 0xfd, 0x0a, 0x58, 0xe8, 0x95, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0x43, 0xcf, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x18, 0xcf,
-	0x67, 0xbb, 0x01, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcb, 0x8a, 0xd4, 0x40,
+	0x14, 0xed, 0xd8, 0x0f, 0xb1, 0x3a, 0x8e, 0x50, 0xf8, 0x48, 0xb7, 0x1a, 0xc3, 0xe8, 0xa2, 0x5d,
+	0x98, 0x40, 0x84, 0x01, 0x97, 0x06, 0x45, 0x06, 0x1a, 0x95, 0xcc, 0xce, 0x4d, 0xc8, 0xa3, 0xc8,
+	0x14, 0x49, 0xa7, 0x62, 0x6e, 0x45, 0xed, 0xbf, 0xf0, 0xb3, 0x06, 0xdc, 0xcc, 0x4e, 0x57, 0x22,
+	0xdd, 0x3f, 0x22, 0xf5, 0x08, 0x64, 0x62, 0x0f, 0xb8, 0x4a, 0xea, 0x9e, 0x73, 0xcf, 0x39, 0x75,
+	0xeb, 0xa2, 0x47, 0x0c, 0x36, 0x0c, 0x28, 0x78, 0x25, 0x4b, 0x8b, 0xb6, 0xf6, 0x72, 0x52, 0x11,
+	0xa0, 0xe0, 0xd6, 0x0d, 0xe3, 0x0c, 0x1f, 0x69, 0xd4, 0x55, 0xe8, 0xf2, 0x6e, 0xce, 0x72, 0x26,
+	0x21, 0x4f, 0xfc, 0x29, 0xd6, 0x72, 0x31, 0xd0, 0x10, 0x1f, 0x0d, 0x3d, 0x1c, 0x40, 0x75, 0xdc,
+	0xc4, 0x1b, 0xad, 0x7e, 0xfc, 0x73, 0x8c, 0xcc, 0x77, 0xca, 0xef, 0x8c, 0xc7, 0x9c, 0x60, 0x07,
+	0x99, 0x65, 0x0c, 0x3c, 0x12, 0xe4, 0x88, 0x66, 0x96, 0xe1, 0x18, 0xab, 0x49, 0x88, 0x44, 0x6d,
+	0xcd, 0xd2, 0xe2, 0x34, 0xc3, 0x27, 0x68, 0x2a, 0x40, 0xb0, 0x6e, 0x38, 0xe3, 0xd5, 0xdc, 0x5f,
+	0xba, 0x57, 0x03, 0xba, 0x1f, 0x49, 0x43, 0x59, 0x26, 0xc8, 0xc1, 0xe4, 0xe2, 0xf7, 0x93, 0x51,
+	0xa8, 0xe8, 0x78, 0x8d, 0xee, 0xc0, 0xb6, 0xe2, 0xe7, 0x84, 0xd3, 0x34, 0x52, 0x0a, 0x63, 0xa9,
+	0xf0, 0x78, 0xa8, 0x70, 0xd6, 0xd1, 0x7a, 0x22, 0x47, 0xd0, 0x2f, 0x02, 0x76, 0xd1, 0x4c, 0x5d,
+	0xc4, 0x9a, 0x38, 0xc6, 0x6a, 0xee, 0xdf, 0xff, 0x27, 0x86, 0x44, 0x43, 0xcd, 0xc2, 0x01, 0x32,
+	0xe5, 0x95, 0xe2, 0x92, 0xc6, 0x40, 0xc0, 0x9a, 0x4a, 0xeb, 0xc5, 0xb0, 0x4b, 0x88, 0xbf, 0x16,
+	0x14, 0x6d, 0x3b, 0x2f, 0xbb, 0x02, 0x11, 0x1a, 0xb6, 0x9c, 0x4d, 0xd2, 0x96, 0x85, 0x1a, 0x50,
+	0x46, 0x81, 0x37, 0x34, 0x69, 0x39, 0x65, 0x95, 0x98, 0xd6, 0x4c, 0x4e, 0x6b, 0x29, 0x58, 0x41,
+	0x5b, 0x16, 0x42, 0xed, 0x4d, 0x8f, 0x72, 0x9a, 0xe1, 0x1c, 0x3d, 0x38, 0xdc, 0x0e, 0xd6, 0x4d,
+	0x19, 0xe9, 0xf9, 0x30, 0xd2, 0x21, 0x21, 0xf9, 0x56, 0x3a, 0xe2, 0xbd, 0xe4, 0x00, 0x01, 0x8e,
+	0x7f, 0x18, 0x68, 0x71, 0x6d, 0x2b, 0x7e, 0x8f, 0xcc, 0xbe, 0xb9, 0x7c, 0xe6, 0xb9, 0xff, 0xec,
+	0x7f, 0xbc, 0xb5, 0xed, 0x95, 0x7e, 0xfc, 0x14, 0xdd, 0xfe, 0xdc, 0x92, 0x96, 0x64, 0x11, 0xfb,
+	0x5a, 0x91, 0x46, 0x2d, 0xc7, 0xad, 0xd0, 0x54, 0xc5, 0x0f, 0xb2, 0x86, 0x5f, 0xa1, 0xa9, 0x3c,
+	0x5f, 0xf7, 0xee, 0x9d, 0xdb, 0xdb, 0x8a, 0x37, 0xdb, 0x6e, 0x79, 0x64, 0x47, 0xb0, 0xbe, 0xd8,
+	0xd9, 0xc6, 0xe5, 0xce, 0x36, 0xfe, 0xec, 0x6c, 0xe3, 0xfb, 0xde, 0x1e, 0x5d, 0xee, 0xed, 0xd1,
+	0xaf, 0xbd, 0x3d, 0xfa, 0xe4, 0xe7, 0x94, 0x9f, 0xb7, 0x89, 0x9b, 0xb2, 0x8d, 0xa7, 0xf5, 0x5e,
+	0x94, 0x71, 0x02, 0xdd, 0xc1, 0xfb, 0xe2, 0x9f, 0x78, 0xdf, 0xba, 0xe5, 0xe7, 0xdb, 0x9a, 0x40,
+	0x32, 0x93, 0xcb, 0xff, 0xf2, 0xef, 0x00, 0x99, 0x55, 0x81, 0xa8, 0x7a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +241,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BulkLockDistributions) > 0 {
+		for iNdEx := len(m.BulkLockDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BulkLockDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LastBulkLockDistributionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBulkLockDistributionId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LockAliases) > 0 {
+		for iNdEx := len(m.LockAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -189,6 +322,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BulkLockDistributionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkLockDistributionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkLockDistributionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queue) > 0 {
+		for iNdEx := len(m.Queue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QueuedOwners) > 0 {
+		for iNdEx := len(m.QueuedOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueuedOwners[iNdEx])
+			copy(dAtA[i:], m.QueuedOwners[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueuedOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -225,6 +414,44 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.LockAliases) > 0 {
+		for _, e := range m.LockAliases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastBulkLockDistributionId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBulkLockDistributionId))
+	}
+	if len(m.BulkLockDistributions) > 0 {
+		for _, e := range m.BulkLockDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BulkLockDistributionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.QueuedOwners) > 0 {
+		for _, s := range m.QueuedOwners {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Queue) > 0 {
+		for _, e := range m.Queue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -386,6 +613,242 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockAliases = append(m.LockAliases, LockAlias{})
+			if err := m.LockAliases[len(m.LockAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBulkLockDistributionId", wireType)
+			}
+			m.LastBulkLockDistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBulkLockDistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BulkLockDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BulkLockDistributions = append(m.BulkLockDistributions, BulkLockDistributionState{})
+			if err := m.BulkLockDistributions[len(m.BulkLockDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkLockDistributionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkLockDistributionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkLockDistributionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedOwners = append(m.QueuedOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = append(m.Queue, BulkLockEntry{})
+			if err := m.Queue[len(m.Queue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
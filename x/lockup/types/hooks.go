@@ -10,9 +10,12 @@ type LockupHooks interface {
 	AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins)
 	OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
+	BeforeTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64)
+	OnLocksMerged(ctx sdk.Context, lockID uint64, mergedLockIDs []uint64)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -42,6 +45,12 @@ func (h MultiLockupHooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress,
 	}
 }
 
+func (h MultiLockupHooks) BeforeTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	for i := range h {
+		h[i].BeforeTokenUnlocked(ctx, address, lockID, amount, lockDuration, unlockTime)
+	}
+}
+
 func (h MultiLockupHooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
 	for i := range h {
 		h[i].OnTokenUnlocked(ctx, address, lockID, amount, lockDuration, unlockTime)
@@ -59,3 +68,15 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) OnLockSplit(ctx sdk.Context, lockID, splitLockID uint64) {
+	for i := range h {
+		h[i].OnLockSplit(ctx, lockID, splitLockID)
+	}
+}
+
+func (h MultiLockupHooks) OnLocksMerged(ctx sdk.Context, lockID uint64, mergedLockIDs []uint64) {
+	for i := range h {
+		h[i].OnLocksMerged(ctx, lockID, mergedLockIDs)
+	}
+}
@@ -61,6 +61,30 @@ var (
 	// KeyPrefixSyntheticLockTimestamp defines prefix for the iteration of synthetic lockups by timestamp.
 	KeyPrefixSyntheticLockTimestamp = []byte{0x10}
 
+	// KeyPrefixLockAlias defines prefix to store lock aliases by original lock ID and split lock ID.
+	KeyPrefixLockAlias = []byte{0x11}
+
+	// KeyLastBulkLockDistributionID defines key to store the ID of the last bulk lock distribution.
+	KeyLastBulkLockDistributionID = []byte{0x12}
+
+	// KeyPrefixBulkLockDistribution defines prefix to store bulk lock distributions by ID.
+	KeyPrefixBulkLockDistribution = []byte{0x13}
+
+	// KeyPrefixBulkLockQueue defines prefix to store the queued entries of bulk lock distributions
+	// by distribution ID and queue index.
+	KeyPrefixBulkLockQueue = []byte{0x14}
+
+	// KeyPrefixBulkLockOwner defines prefix to mark the owners already queued by a bulk lock distribution.
+	KeyPrefixBulkLockOwner = []byte{0x15}
+
+	// KeyPrefixSuperbondedTotal defines prefix to store the total amount of each native denom
+	// locked by superbonding synthetic lockups.
+	KeyPrefixSuperbondedTotal = []byte{0x16}
+
+	// KeyPrefixLockCount defines prefix for the lock count store, which keeps the number of locks
+	// of each denom by duration.
+	KeyPrefixLockCount = []byte{0x17}
+
 	// KeyPrefixLockAccumulation defines prefix for the lock accumulation store.
 	KeyPrefixLockAccumulation = []byte{0x20}
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"math/bits"
 	"strings"
@@ -19,6 +20,12 @@ import (
 // Using this as the value for reward receiver would indicate that the lock's reward receiver is the owner.
 const DefaultOwnerReceiverPlaceholder = ""
 
+// PermanentLockDuration is the duration of permanent locks. Permanent locks can not begin unlocking
+// unless governance enables converting them into locks with a finite duration, see
+// Params.PermanentLockConversionDuration.
+// As it is the longest possible duration, permanent locks qualify for all duration based gauges.
+const PermanentLockDuration = time.Duration(math.MaxInt64)
+
 // NewPeriodLock returns a new instance of period lock.
 func NewPeriodLock(ID uint64, owner sdk.AccAddress, reward_address string, duration time.Duration, endTime time.Time, coins sdk.Coins) PeriodLock {
 	// sanity check once more to ensure if reward_address == owner, we store empty string
@@ -35,6 +42,11 @@ func NewPeriodLock(ID uint64, owner sdk.AccAddress, reward_address string, durat
 	}
 }
 
+// IsPermanent returns true if the lock is a permanent lock.
+func (p PeriodLock) IsPermanent() bool {
+	return p.Duration == PermanentLockDuration
+}
+
 // IsUnlocking returns lock started unlocking already.
 func (p PeriodLock) IsUnlocking() bool {
 	return !p.EndTime.Equal(time.Time{})
@@ -122,3 +134,17 @@ func NativeDenom(denom string) string {
 func IsSyntheticDenom(denom string) bool {
 	return NativeDenom(denom) != denom
 }
+
+// IsSuperbondingDenom returns true if the given denom is the synthetic denom of a superfluid delegation.
+func IsSuperbondingDenom(denom string) bool {
+	return strings.Contains(denom, "/superbonding")
+}
+
+// concentratedLiquidityDenomPrefix is the prefix of the denom of concentrated liquidity position shares.
+const concentratedLiquidityDenomPrefix = "cl/pool/"
+
+// IsConcentratedLiquidityDenom returns true if the denom is the share denom of a concentrated liquidity position.
+// Locks of such shares are tied to their position.
+func IsConcentratedLiquidityDenom(denom string) bool {
+	return strings.HasPrefix(denom, concentratedLiquidityDenomPrefix)
+}
@@ -325,59 +325,372 @@ func (m *SyntheticLock) GetDuration() time.Duration {
 	return 0
 }
 
+// LockAlias links a lock that was split off a multi-coin lock to the id of the
+// lock it was split from, so that references to the original lock id can still
+// be resolved to every lock now holding its coins.
+type LockAlias struct {
+	OriginalLockId uint64 `protobuf:"varint,1,opt,name=original_lock_id,json=originalLockId,proto3" json:"original_lock_id,omitempty" yaml:"original_lock_id"`
+	SplitLockId    uint64 `protobuf:"varint,2,opt,name=split_lock_id,json=splitLockId,proto3" json:"split_lock_id,omitempty" yaml:"split_lock_id"`
+}
+
+func (m *LockAlias) Reset()         { *m = LockAlias{} }
+func (m *LockAlias) String() string { return proto.CompactTextString(m) }
+func (*LockAlias) ProtoMessage()    {}
+func (*LockAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e9d7527a237b489, []int{3}
+}
+func (m *LockAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockAlias.Merge(m, src)
+}
+func (m *LockAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockAlias proto.InternalMessageInfo
+
+func (m *LockAlias) GetOriginalLockId() uint64 {
+	if m != nil {
+		return m.OriginalLockId
+	}
+	return 0
+}
+
+func (m *LockAlias) GetSplitLockId() uint64 {
+	if m != nil {
+		return m.SplitLockId
+	}
+	return 0
+}
+
+// BulkLockDistribution is a lockdrop scheduled by governance or a whitelisted
+// module. Its funds are escrowed in the lockup module account up front, and
+// the locks of the owners committed to by the merkle root are created across
+// multiple blocks by the lockup end blocker.
+type BulkLockDistribution struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the address the funds were escrowed from, and the address any
+	// unused funds are refunded to.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// merkle_root is the root of the merkle tree over the distribution's
+	// entries, which every queued entry is verified against.
+	MerkleRoot []byte `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty" yaml:"merkle_root"`
+	// duration is the duration of every lock created by the distribution.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// num_entries is the number of entries committed to by the merkle root.
+	NumEntries uint64 `protobuf:"varint,5,opt,name=num_entries,json=numEntries,proto3" json:"num_entries,omitempty" yaml:"num_entries"`
+	// queued_entries is the number of entries verified and queued so far.
+	QueuedEntries uint64 `protobuf:"varint,6,opt,name=queued_entries,json=queuedEntries,proto3" json:"queued_entries,omitempty" yaml:"queued_entries"`
+	// processed_entries is the number of queued entries the end blocker has
+	// processed so far.
+	ProcessedEntries uint64 `protobuf:"varint,7,opt,name=processed_entries,json=processedEntries,proto3" json:"processed_entries,omitempty" yaml:"processed_entries"`
+	// funds are the escrowed coins that have not been locked yet.
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+	// queued_funds is the part of funds reserved by queued entries that have
+	// not been processed yet.
+	QueuedFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=queued_funds,json=queuedFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"queued_funds"`
+}
+
+func (m *BulkLockDistribution) Reset()         { *m = BulkLockDistribution{} }
+func (m *BulkLockDistribution) String() string { return proto.CompactTextString(m) }
+func (*BulkLockDistribution) ProtoMessage()    {}
+func (*BulkLockDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e9d7527a237b489, []int{4}
+}
+func (m *BulkLockDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkLockDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkLockDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkLockDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkLockDistribution.Merge(m, src)
+}
+func (m *BulkLockDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkLockDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkLockDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkLockDistribution proto.InternalMessageInfo
+
+func (m *BulkLockDistribution) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BulkLockDistribution) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *BulkLockDistribution) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *BulkLockDistribution) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *BulkLockDistribution) GetNumEntries() uint64 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
+func (m *BulkLockDistribution) GetQueuedEntries() uint64 {
+	if m != nil {
+		return m.QueuedEntries
+	}
+	return 0
+}
+
+func (m *BulkLockDistribution) GetProcessedEntries() uint64 {
+	if m != nil {
+		return m.ProcessedEntries
+	}
+	return 0
+}
+
+func (m *BulkLockDistribution) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+func (m *BulkLockDistribution) GetQueuedFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.QueuedFunds
+	}
+	return nil
+}
+
+// BulkLockEntry is a single lock of a bulk lock distribution, and a leaf of
+// its merkle tree.
+type BulkLockEntry struct {
+	Owner string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Coin  types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *BulkLockEntry) Reset()         { *m = BulkLockEntry{} }
+func (m *BulkLockEntry) String() string { return proto.CompactTextString(m) }
+func (*BulkLockEntry) ProtoMessage()    {}
+func (*BulkLockEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e9d7527a237b489, []int{5}
+}
+func (m *BulkLockEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkLockEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkLockEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkLockEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkLockEntry.Merge(m, src)
+}
+func (m *BulkLockEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkLockEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkLockEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkLockEntry proto.InternalMessageInfo
+
+func (m *BulkLockEntry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *BulkLockEntry) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+// BulkLockLeaf is a bulk lock entry along with the merkle proof of its
+// inclusion in the tree committed to by a bulk lock distribution.
+type BulkLockLeaf struct {
+	Entry BulkLockEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	// proof are the sibling hashes on the path from the entry's leaf to the
+	// root.
+	Proof [][]byte `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *BulkLockLeaf) Reset()         { *m = BulkLockLeaf{} }
+func (m *BulkLockLeaf) String() string { return proto.CompactTextString(m) }
+func (*BulkLockLeaf) ProtoMessage()    {}
+func (*BulkLockLeaf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e9d7527a237b489, []int{6}
+}
+func (m *BulkLockLeaf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkLockLeaf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkLockLeaf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkLockLeaf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkLockLeaf.Merge(m, src)
+}
+func (m *BulkLockLeaf) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkLockLeaf) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkLockLeaf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkLockLeaf proto.InternalMessageInfo
+
+func (m *BulkLockLeaf) GetEntry() BulkLockEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return BulkLockEntry{}
+}
+
+func (m *BulkLockLeaf) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.lockup.LockQueryType", LockQueryType_name, LockQueryType_value)
 	proto.RegisterType((*PeriodLock)(nil), "osmosis.lockup.PeriodLock")
 	proto.RegisterType((*QueryCondition)(nil), "osmosis.lockup.QueryCondition")
 	proto.RegisterType((*SyntheticLock)(nil), "osmosis.lockup.SyntheticLock")
+	proto.RegisterType((*LockAlias)(nil), "osmosis.lockup.LockAlias")
+	proto.RegisterType((*BulkLockDistribution)(nil), "osmosis.lockup.BulkLockDistribution")
+	proto.RegisterType((*BulkLockEntry)(nil), "osmosis.lockup.BulkLockEntry")
+	proto.RegisterType((*BulkLockLeaf)(nil), "osmosis.lockup.BulkLockLeaf")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0xf3, 0xa7, 0x7f, 0xae, 0x34, 0xb5, 0x4e, 0x45, 0xa4, 0x01, 0xec, 0xc8, 0x03, 0x8a,
-	0x50, 0x6b, 0xd3, 0x20, 0x31, 0xb0, 0xe1, 0x06, 0xa1, 0xa2, 0x0a, 0x81, 0xa9, 0x18, 0xba, 0x58,
-	0x8e, 0xef, 0x48, 0x4f, 0x8d, 0x7d, 0xe6, 0xce, 0x6e, 0xf1, 0x37, 0x60, 0xec, 0x08, 0x12, 0x1b,
-	0x1b, 0xdf, 0x82, 0xad, 0x63, 0x47, 0xa6, 0x14, 0xb5, 0x1b, 0x63, 0x3f, 0x01, 0xba, 0x3b, 0x3b,
-	0x4d, 0x8b, 0x2a, 0x75, 0x80, 0xc9, 0xbe, 0x7b, 0xbf, 0xdf, 0xbb, 0x9f, 0xdf, 0x7b, 0x67, 0xb0,
-	0x42, 0x79, 0x44, 0x39, 0xe1, 0xce, 0x88, 0x86, 0x7b, 0x59, 0x22, 0x1f, 0x76, 0xc2, 0x68, 0x4a,
-	0x61, 0xb3, 0x80, 0x6c, 0x05, 0xb5, 0x97, 0x87, 0x74, 0x48, 0x25, 0xe4, 0x88, 0x37, 0x55, 0xd5,
-	0x36, 0x86, 0x94, 0x0e, 0x47, 0xd8, 0x91, 0xab, 0x41, 0xf6, 0xde, 0x41, 0x19, 0x0b, 0x52, 0x42,
-	0xe3, 0x02, 0x37, 0xaf, 0xe2, 0x29, 0x89, 0x30, 0x4f, 0x83, 0x28, 0x29, 0x09, 0x42, 0x79, 0x8e,
-	0x33, 0x08, 0x38, 0x76, 0xf6, 0xd7, 0x07, 0x38, 0x0d, 0xd6, 0x9d, 0x90, 0x92, 0x82, 0xc0, 0xfa,
-	0x51, 0x03, 0xe0, 0x35, 0x66, 0x84, 0xa2, 0x2d, 0x1a, 0xee, 0xc1, 0x26, 0xa8, 0x6e, 0xf6, 0x5b,
-	0x5a, 0x47, 0xeb, 0xd6, 0xbd, 0xea, 0x66, 0x1f, 0x3e, 0x00, 0x0d, 0x7a, 0x10, 0x63, 0xd6, 0xaa,
-	0x76, 0xb4, 0xee, 0xbc, 0xab, 0x9f, 0x8f, 0xcd, 0x5b, 0x79, 0x10, 0x8d, 0x9e, 0x5a, 0x72, 0xdb,
-	0xf2, 0x14, 0x0c, 0x77, 0xc1, 0x5c, 0x39, 0x59, 0xab, 0xd6, 0xd1, 0xba, 0x0b, 0xbd, 0x15, 0x5b,
-	0x8d, 0x66, 0x97, 0xa3, 0xd9, 0xfd, 0xa2, 0xc0, 0x5d, 0x3f, 0x1a, 0x9b, 0x95, 0xdf, 0x63, 0x13,
-	0x96, 0x2d, 0xab, 0x34, 0x22, 0x29, 0x8e, 0x92, 0x34, 0x3f, 0x1f, 0x9b, 0x4b, 0x8a, 0xbf, 0xc4,
-	0xac, 0xcf, 0x27, 0xa6, 0xe6, 0x4d, 0xd8, 0xa1, 0x07, 0xe6, 0x70, 0x8c, 0x7c, 0xf1, 0x9d, 0xad,
-	0xba, 0x3c, 0xa9, 0xfd, 0xd7, 0x49, 0xdb, 0xa5, 0x08, 0xee, 0x5d, 0x71, 0xd4, 0x05, 0x69, 0xd9,
-	0x69, 0x1d, 0x0a, 0xd2, 0x59, 0x1c, 0x23, 0x51, 0x0a, 0x03, 0xd0, 0x10, 0x92, 0xf0, 0x56, 0xa3,
-	0x53, 0x93, 0xa3, 0x2b, 0xd1, 0x6c, 0x21, 0x9a, 0x5d, 0x88, 0x66, 0x6f, 0x50, 0x12, 0xbb, 0x8f,
-	0x04, 0xdf, 0xf7, 0x13, 0xb3, 0x3b, 0x24, 0xe9, 0x6e, 0x36, 0xb0, 0x43, 0x1a, 0x39, 0x85, 0xc2,
-	0xea, 0xb1, 0xc6, 0xd1, 0x9e, 0x93, 0xe6, 0x09, 0xe6, 0xb2, 0x81, 0x7b, 0x8a, 0x19, 0xee, 0x80,
-	0x3b, 0x0c, 0x1f, 0x04, 0x0c, 0xf9, 0x0c, 0x87, 0x98, 0xec, 0x63, 0xe6, 0x07, 0x08, 0x31, 0xcc,
-	0x79, 0x6b, 0x46, 0x4a, 0x6b, 0x9d, 0x8f, 0x4d, 0x43, 0x4d, 0x79, 0x4d, 0xa1, 0xe5, 0xdd, 0x56,
-	0x88, 0x57, 0x00, 0xcf, 0x8a, 0xfd, 0x2f, 0x55, 0xd0, 0x7c, 0x93, 0x61, 0x96, 0x6f, 0xd0, 0x18,
-	0x11, 0xa9, 0xd2, 0x73, 0xb0, 0x24, 0x72, 0xe5, 0x7f, 0x10, 0xdb, 0xbe, 0x98, 0x47, 0x9a, 0xda,
-	0xec, 0xdd, 0xb7, 0x2f, 0xe7, 0xce, 0x16, 0xb6, 0xcb, 0xe6, 0xed, 0x3c, 0xc1, 0xde, 0xe2, 0x68,
-	0x7a, 0x09, 0x97, 0x41, 0x03, 0xe1, 0x98, 0x46, 0xca, 0x7e, 0x4f, 0x2d, 0x84, 0x05, 0x37, 0x37,
-	0xfb, 0x8a, 0x03, 0xd7, 0xd9, 0xfa, 0x0e, 0xcc, 0x4f, 0xa2, 0x7b, 0x03, 0x5f, 0xef, 0x15, 0xac,
-	0xba, 0x62, 0x9d, 0xb4, 0x2a, 0x63, 0x2f, 0xa8, 0xac, 0xaf, 0x55, 0xb0, 0xf8, 0x36, 0x8f, 0xd3,
-	0x5d, 0x9c, 0x92, 0x50, 0x46, 0x7c, 0x15, 0xc0, 0x2c, 0x46, 0x98, 0x8d, 0x72, 0x12, 0x0f, 0x7d,
-	0xa9, 0x12, 0x41, 0x45, 0xe4, 0xf5, 0x0b, 0x44, 0xd4, 0x6e, 0x22, 0x68, 0x82, 0x05, 0x2e, 0xda,
-	0xfd, 0x69, 0x1d, 0x80, 0xdc, 0xea, 0x97, 0x62, 0x4c, 0xf2, 0x58, 0xfb, 0x47, 0x79, 0x9c, 0xbe,
-	0x4d, 0xf5, 0xff, 0x79, 0x9b, 0x1e, 0xbe, 0x04, 0x8b, 0x97, 0x02, 0x00, 0x9b, 0x00, 0xb8, 0x79,
-	0xc9, 0xad, 0x57, 0x20, 0x00, 0x33, 0x6e, 0x2e, 0x86, 0xd2, 0x35, 0xf1, 0xfe, 0x8a, 0x8a, 0x72,
-	0xbd, 0x0a, 0x17, 0xc0, 0xac, 0x9b, 0xbf, 0x60, 0x34, 0x4b, 0xf4, 0x5a, 0xbb, 0xfe, 0xe9, 0x9b,
-	0x51, 0x71, 0xb7, 0x8e, 0x4e, 0x0d, 0xed, 0xf8, 0xd4, 0xd0, 0x7e, 0x9d, 0x1a, 0xda, 0xe1, 0x99,
-	0x51, 0x39, 0x3e, 0x33, 0x2a, 0x3f, 0xcf, 0x8c, 0xca, 0x4e, 0x6f, 0xea, 0xb6, 0x14, 0xf1, 0x5b,
-	0x1b, 0x05, 0x03, 0x5e, 0x2e, 0x9c, 0xfd, 0xde, 0x13, 0xe7, 0x63, 0xf9, 0x93, 0x94, 0xb7, 0x67,
-	0x30, 0x23, 0xbf, 0xf4, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x54, 0x48, 0x05, 0x43,
-	0x05, 0x00, 0x00,
+	// 977 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x17, 0x29, 0xc9, 0x7f, 0x9e, 0x2c, 0x45, 0x3d, 0x38, 0x0d, 0xed, 0xa4, 0xa2, 0xc1, 0xa1,
+	0x30, 0x0a, 0x87, 0xac, 0x1d, 0xa0, 0x45, 0x8b, 0x0e, 0x0d, 0x23, 0xb7, 0x70, 0x61, 0x14, 0x2d,
+	0x1b, 0x74, 0xc8, 0x42, 0x50, 0xe2, 0x59, 0x3e, 0x88, 0xe4, 0x31, 0x77, 0xa4, 0x53, 0x7e, 0x83,
+	0x8e, 0x1e, 0x5b, 0xa0, 0x5b, 0xb7, 0x7e, 0x8b, 0x6e, 0x19, 0x33, 0x76, 0x62, 0x0a, 0x7b, 0xeb,
+	0xa8, 0x4f, 0x50, 0xdc, 0x1d, 0x29, 0x4b, 0x36, 0x12, 0x64, 0x48, 0x32, 0x49, 0xef, 0x7e, 0xef,
+	0xfd, 0xde, 0xbb, 0xdf, 0x7b, 0xf7, 0x24, 0xd8, 0xa2, 0x3c, 0xa6, 0x9c, 0x70, 0x27, 0xa2, 0xe3,
+	0x69, 0x9e, 0xca, 0x0f, 0x3b, 0x65, 0x34, 0xa3, 0xa8, 0x57, 0x41, 0xb6, 0x82, 0xb6, 0x37, 0x27,
+	0x74, 0x42, 0x25, 0xe4, 0x88, 0x6f, 0xca, 0x6b, 0x7b, 0x30, 0xa1, 0x74, 0x12, 0x61, 0x47, 0x5a,
+	0xa3, 0xfc, 0xc4, 0x09, 0x73, 0x16, 0x64, 0x84, 0x26, 0x15, 0x6e, 0x5e, 0xc7, 0x33, 0x12, 0x63,
+	0x9e, 0x05, 0x71, 0x5a, 0x13, 0x8c, 0x65, 0x1e, 0x67, 0x14, 0x70, 0xec, 0x9c, 0xed, 0x8f, 0x70,
+	0x16, 0xec, 0x3b, 0x63, 0x4a, 0x2a, 0x02, 0xeb, 0xef, 0x26, 0xc0, 0x0f, 0x98, 0x11, 0x1a, 0x1e,
+	0xd3, 0xf1, 0x14, 0xf5, 0x40, 0x3f, 0x1a, 0x1a, 0xda, 0x8e, 0xb6, 0xdb, 0xf2, 0xf4, 0xa3, 0x21,
+	0xfa, 0x18, 0xda, 0xf4, 0x59, 0x82, 0x99, 0xa1, 0xef, 0x68, 0xbb, 0xeb, 0x6e, 0x7f, 0x56, 0x9a,
+	0x1b, 0x45, 0x10, 0x47, 0x5f, 0x5a, 0xf2, 0xd8, 0xf2, 0x14, 0x8c, 0x4e, 0x61, 0xad, 0xae, 0xcc,
+	0x68, 0xee, 0x68, 0xbb, 0x9d, 0x83, 0x2d, 0x5b, 0x95, 0x66, 0xd7, 0xa5, 0xd9, 0xc3, 0xca, 0xc1,
+	0xdd, 0x7f, 0x5e, 0x9a, 0x8d, 0xff, 0x4a, 0x13, 0xd5, 0x21, 0x7b, 0x34, 0x26, 0x19, 0x8e, 0xd3,
+	0xac, 0x98, 0x95, 0xe6, 0x2d, 0xc5, 0x5f, 0x63, 0xd6, 0x6f, 0x2f, 0x4d, 0xcd, 0x9b, 0xb3, 0x23,
+	0x0f, 0xd6, 0x70, 0x12, 0xfa, 0xe2, 0x9e, 0x46, 0x4b, 0x66, 0xda, 0xbe, 0x91, 0xe9, 0x71, 0x2d,
+	0x82, 0x7b, 0x57, 0xa4, 0xba, 0x22, 0xad, 0x23, 0xad, 0x73, 0x41, 0xba, 0x8a, 0x93, 0x50, 0xb8,
+	0xa2, 0x00, 0xda, 0x42, 0x12, 0x6e, 0xb4, 0x77, 0x9a, 0xb2, 0x74, 0x25, 0x9a, 0x2d, 0x44, 0xb3,
+	0x2b, 0xd1, 0xec, 0x47, 0x94, 0x24, 0xee, 0xa7, 0x82, 0xef, 0xaf, 0x97, 0xe6, 0xee, 0x84, 0x64,
+	0xa7, 0xf9, 0xc8, 0x1e, 0xd3, 0xd8, 0xa9, 0x14, 0x56, 0x1f, 0xf7, 0x79, 0x38, 0x75, 0xb2, 0x22,
+	0xc5, 0x5c, 0x06, 0x70, 0x4f, 0x31, 0xa3, 0x27, 0x70, 0x87, 0xe1, 0x67, 0x01, 0x0b, 0x7d, 0x86,
+	0xc7, 0x98, 0x9c, 0x61, 0xe6, 0x07, 0x61, 0xc8, 0x30, 0xe7, 0xc6, 0x8a, 0x94, 0xd6, 0x9a, 0x95,
+	0xe6, 0x40, 0x55, 0xf9, 0x0a, 0x47, 0xcb, 0xbb, 0xad, 0x10, 0xaf, 0x02, 0x1e, 0x56, 0xe7, 0xbf,
+	0xeb, 0xd0, 0xfb, 0x31, 0xc7, 0xac, 0x78, 0x44, 0x93, 0x90, 0x48, 0x95, 0x0e, 0xe1, 0x96, 0x98,
+	0x2b, 0xff, 0xa9, 0x38, 0xf6, 0x45, 0x3d, 0xb2, 0xa9, 0xbd, 0x83, 0x8f, 0xec, 0xe5, 0xb9, 0xb3,
+	0x45, 0xdb, 0x65, 0xf0, 0xe3, 0x22, 0xc5, 0x5e, 0x37, 0x5a, 0x34, 0xd1, 0x26, 0xb4, 0x43, 0x9c,
+	0xd0, 0x58, 0xb5, 0xdf, 0x53, 0x86, 0x68, 0xc1, 0x9b, 0x37, 0xfb, 0x5a, 0x07, 0x5e, 0xd5, 0xd6,
+	0x9f, 0x61, 0x7d, 0x3e, 0xba, 0x6f, 0xd0, 0xd7, 0x7b, 0x15, 0x6b, 0x5f, 0xb1, 0xce, 0x43, 0x55,
+	0x63, 0xaf, 0xa8, 0xac, 0x3f, 0x74, 0xe8, 0xfe, 0x54, 0x24, 0xd9, 0x29, 0xce, 0xc8, 0x58, 0x8e,
+	0xf8, 0x1e, 0xa0, 0x3c, 0x09, 0x31, 0x8b, 0x0a, 0x92, 0x4c, 0x7c, 0xa9, 0x12, 0x09, 0xab, 0x91,
+	0xef, 0x5f, 0x21, 0xc2, 0xf7, 0x28, 0x44, 0x26, 0x74, 0xb8, 0x08, 0xf7, 0x17, 0x75, 0x00, 0x79,
+	0x34, 0xac, 0xc5, 0x98, 0xcf, 0x63, 0xf3, 0x2d, 0xcd, 0xe3, 0xe2, 0x6b, 0x6a, 0xbd, 0xcb, 0xd7,
+	0x64, 0x9d, 0x6b, 0xb0, 0x2e, 0x6e, 0xfa, 0x30, 0x22, 0x01, 0x47, 0x87, 0xd0, 0xa7, 0x8c, 0x4c,
+	0x48, 0x12, 0x44, 0xcb, 0xc2, 0xb8, 0x77, 0x67, 0xa5, 0x79, 0xa7, 0x7a, 0xf8, 0xd7, 0x3c, 0x2c,
+	0xaf, 0x57, 0x1f, 0x55, 0x9a, 0x7d, 0x05, 0x5d, 0x9e, 0x46, 0x24, 0x9b, 0x73, 0xe8, 0x92, 0xc3,
+	0x98, 0x95, 0xe6, 0xa6, 0xe2, 0x58, 0x82, 0x2d, 0xaf, 0x23, 0x6d, 0x15, 0x6d, 0x9d, 0xb7, 0x61,
+	0xd3, 0xcd, 0xa3, 0xa9, 0x30, 0x87, 0x84, 0x67, 0x8c, 0x8c, 0x72, 0x39, 0x22, 0x3d, 0xd0, 0xe7,
+	0x8d, 0xd2, 0x49, 0x88, 0xf6, 0x60, 0x75, 0xcc, 0x70, 0x90, 0xd1, 0x7a, 0x3b, 0xa1, 0x59, 0x69,
+	0xf6, 0x54, 0x82, 0x0a, 0xb0, 0xbc, 0xda, 0x05, 0x7d, 0x0e, 0x9d, 0x18, 0xb3, 0x69, 0x84, 0x7d,
+	0x46, 0x69, 0x26, 0x5b, 0xb5, 0xe1, 0x7e, 0x38, 0x2b, 0x4d, 0xa4, 0x22, 0x16, 0x40, 0xcb, 0x03,
+	0x65, 0x79, 0x94, 0x66, 0xef, 0xaf, 0x19, 0xa2, 0xc4, 0x24, 0x8f, 0x7d, 0x9c, 0x64, 0x8c, 0x60,
+	0xb1, 0x8c, 0x84, 0x6a, 0x0b, 0x25, 0x2e, 0x80, 0x96, 0x07, 0x49, 0x1e, 0x1f, 0x2a, 0x03, 0x7d,
+	0x0d, 0xbd, 0xa7, 0x39, 0xce, 0x71, 0x38, 0x8f, 0x5d, 0x91, 0xb1, 0x5b, 0xb3, 0xd2, 0xbc, 0xad,
+	0x62, 0x97, 0x71, 0xcb, 0xeb, 0xaa, 0x83, 0x9a, 0xe1, 0x08, 0x3e, 0x48, 0x19, 0x1d, 0x63, 0xce,
+	0x17, 0x48, 0x56, 0x25, 0xc9, 0xbd, 0x59, 0x69, 0x1a, 0x8a, 0xe4, 0x86, 0x8b, 0xe5, 0xf5, 0xe7,
+	0x67, 0x35, 0x55, 0x00, 0xed, 0x93, 0x3c, 0x09, 0xb9, 0xb1, 0xf6, 0x0e, 0x96, 0xa9, 0x64, 0x46,
+	0x09, 0x6c, 0x54, 0xf7, 0x51, 0x99, 0xd6, 0xdf, 0x7e, 0xa6, 0x8e, 0x4a, 0xf0, 0x8d, 0xe0, 0xb7,
+	0x22, 0xe8, 0xd6, 0x13, 0x29, 0x6e, 0x59, 0x5c, 0xfd, 0x2c, 0x6a, 0xaf, 0xff, 0x59, 0x7c, 0x00,
+	0x2d, 0xb1, 0xfe, 0xe5, 0x7c, 0xbe, 0xb6, 0xc0, 0x96, 0x28, 0xd0, 0x93, 0xce, 0x96, 0x0f, 0x1b,
+	0x75, 0xb6, 0x63, 0x1c, 0x9c, 0xa0, 0x2f, 0xa0, 0x2d, 0xe4, 0x2e, 0x64, 0xb2, 0xce, 0xcd, 0x0d,
+	0xbe, 0x54, 0x5a, 0xc5, 0xa4, 0x22, 0xc4, 0xfe, 0x4e, 0x19, 0xa5, 0x27, 0x86, 0xbe, 0xd3, 0xdc,
+	0xdd, 0xf0, 0x94, 0xf1, 0xc9, 0x77, 0xd0, 0x5d, 0xda, 0xfa, 0xa8, 0x07, 0xe0, 0x16, 0xf5, 0x0c,
+	0xf7, 0x1b, 0x08, 0x60, 0xc5, 0x2d, 0xc4, 0x26, 0xea, 0x6b, 0xe2, 0xfb, 0xf7, 0x54, 0xb8, 0xf7,
+	0x75, 0xd4, 0x81, 0x55, 0xb7, 0xf8, 0x96, 0xd1, 0x3c, 0xed, 0x37, 0xb7, 0x5b, 0xbf, 0xfe, 0x39,
+	0x68, 0xb8, 0xc7, 0xcf, 0x2f, 0x06, 0xda, 0x8b, 0x8b, 0x81, 0xf6, 0xef, 0xc5, 0x40, 0x3b, 0xbf,
+	0x1c, 0x34, 0x5e, 0x5c, 0x0e, 0x1a, 0xff, 0x5c, 0x0e, 0x1a, 0x4f, 0x0e, 0x16, 0xb4, 0xae, 0x2a,
+	0xbe, 0x1f, 0x05, 0x23, 0x5e, 0x1b, 0xce, 0xd9, 0xc1, 0x67, 0xce, 0x2f, 0xf5, 0x3f, 0x23, 0xa9,
+	0xfd, 0x68, 0x45, 0xbe, 0xa8, 0x07, 0xff, 0x0f, 0x00, 0x09, 0x2a, 0x43, 0xe5, 0x38, 0x09, 0x00,
+	0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -554,94 +867,1047 @@ func (m *SyntheticLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLock(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLock(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LockAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PeriodLock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LockAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovLock(uint64(m.ID))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovLock(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovLock(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovLock(uint64(l))
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovLock(uint64(l))
-		}
+	if m.SplitLockId != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.SplitLockId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.RewardReceiverAddress)
-	if l > 0 {
-		n += 1 + l + sovLock(uint64(l))
+	if m.OriginalLockId != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.OriginalLockId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCondition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BulkLockDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkLockDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkLockDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LockQueryType != 0 {
-		n += 1 + sovLock(uint64(m.LockQueryType))
+	if len(m.QueuedFunds) > 0 {
+		for iNdEx := len(m.QueuedFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovLock(uint64(l))
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ProcessedEntries != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.ProcessedEntries))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.QueuedEntries != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.QueuedEntries))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumEntries != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.NumEntries))
+		i--
+		dAtA[i] = 0x28
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintLock(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkLockEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkLockEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkLockEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkLockLeaf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkLockLeaf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkLockLeaf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintLock(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PeriodLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovLock(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLock(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLock(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
+	l = len(m.RewardReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockQueryType != 0 {
+		n += 1 + sovLock(uint64(m.LockQueryType))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLock(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovLock(uint64(l))
+	return n
+}
+
+func (m *SyntheticLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnderlyingLockId != 0 {
+		n += 1 + sovLock(uint64(m.UnderlyingLockId))
+	}
+	l = len(m.SynthDenom)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLock(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLock(uint64(l))
+	return n
+}
+
+func (m *LockAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginalLockId != 0 {
+		n += 1 + sovLock(uint64(m.OriginalLockId))
+	}
+	if m.SplitLockId != 0 {
+		n += 1 + sovLock(uint64(m.SplitLockId))
+	}
+	return n
+}
+
+func (m *BulkLockDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLock(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLock(uint64(l))
+	if m.NumEntries != 0 {
+		n += 1 + sovLock(uint64(m.NumEntries))
+	}
+	if m.QueuedEntries != 0 {
+		n += 1 + sovLock(uint64(m.QueuedEntries))
+	}
+	if m.ProcessedEntries != 0 {
+		n += 1 + sovLock(uint64(m.ProcessedEntries))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
+	if len(m.QueuedFunds) > 0 {
+		for _, e := range m.QueuedFunds {
+			l = e.Size()
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BulkLockEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovLock(uint64(l))
+	return n
+}
+
+func (m *BulkLockLeaf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovLock(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLock(x uint64) (n int) {
+	return sovLock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PeriodLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockQueryType", wireType)
+			}
+			m.LockQueryType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockQueryType |= LockQueryType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyntheticLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyntheticLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyntheticLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderlyingLockId", wireType)
+			}
+			m.UnderlyingLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnderlyingLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynthDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SynthDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalLockId", wireType)
+			}
+			m.OriginalLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginalLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitLockId", wireType)
+			}
+			m.SplitLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovLock(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovLock(uint64(l))
-	return n
-}
 
-func (m *SyntheticLock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UnderlyingLockId != 0 {
-		n += 1 + sovLock(uint64(m.UnderlyingLockId))
-	}
-	l = len(m.SynthDenom)
-	if l > 0 {
-		n += 1 + l + sovLock(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovLock(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovLock(uint64(l))
-	return n
-}
-
-func sovLock(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLock(x uint64) (n int) {
-	return sovLock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PeriodLock) Unmarshal(dAtA []byte) error {
+func (m *BulkLockDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -664,17 +1930,17 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeriodLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkLockDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeriodLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkLockDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.ID = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLock
@@ -684,14 +1950,14 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -719,13 +1985,13 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLock
@@ -735,28 +2001,29 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthLock
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLock
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -783,13 +2050,70 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
+			}
+			m.NumEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedEntries", wireType)
+			}
+			m.QueuedEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedEntries", wireType)
+			}
+			m.ProcessedEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -816,16 +2140,16 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedFunds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLock
@@ -835,23 +2159,25 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLock
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLock
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardReceiverAddress = string(dAtA[iNdEx:postIndex])
+			m.QueuedFunds = append(m.QueuedFunds, types.Coin{})
+			if err := m.QueuedFunds[len(m.QueuedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -874,7 +2200,7 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCondition) Unmarshal(dAtA []byte) error {
+func (m *BulkLockEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -897,34 +2223,15 @@ func (m *QueryCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkLockEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkLockEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockQueryType", wireType)
-			}
-			m.LockQueryType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLock
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockQueryType |= LockQueryType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -952,44 +2259,11 @@ func (m *QueryCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLock
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLock
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLock
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1016,7 +2290,7 @@ func (m *QueryCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1041,7 +2315,7 @@ func (m *QueryCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyntheticLock) Unmarshal(dAtA []byte) error {
+func (m *BulkLockLeaf) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1064,66 +2338,15 @@ func (m *SyntheticLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyntheticLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkLockLeaf: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyntheticLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkLockLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnderlyingLockId", wireType)
-			}
-			m.UnderlyingLockId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLock
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnderlyingLockId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynthDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLock
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLock
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLock
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SynthDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1150,15 +2373,15 @@ func (m *SyntheticLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLock
@@ -1168,24 +2391,23 @@ func (m *SyntheticLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthLock
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLock
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11,12 +11,19 @@ import (
 
 // constants.
 const (
-	TypeMsgLockTokens               = "lock_tokens"
-	TypeMsgBeginUnlockingAll        = "begin_unlocking_all"
-	TypeMsgBeginUnlocking           = "begin_unlocking"
-	TypeMsgExtendLockup             = "edit_lockup"
-	TypeForceUnlock                 = "force_unlock"
-	TypeMsgSetRewardReceiverAddress = "set_reward_receiver_address"
+	TypeMsgLockTokens                 = "lock_tokens"
+	TypeMsgBeginUnlockingAll          = "begin_unlocking_all"
+	TypeMsgBeginUnlocking             = "begin_unlocking"
+	TypeMsgBeginUnlockingPartial      = "begin_unlocking_partial"
+	TypeMsgExtendLockup               = "edit_lockup"
+	TypeForceUnlock                   = "force_unlock"
+	TypeMsgSetRewardReceiverAddress   = "set_reward_receiver_address"
+	TypeMsgSplitLock                  = "split_lock"
+	TypeMsgMergeLocks                 = "merge_locks"
+	TypeMsgConvertPermanentLock       = "convert_permanent_lock"
+	TypeMsgCreateBulkLockDistribution = "create_bulk_lock_distribution"
+	TypeMsgQueueBulkLockEntries       = "queue_bulk_lock_entries"
+	TypeMsgCancelBulkLockDistribution = "cancel_bulk_lock_distribution"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -123,6 +130,46 @@ func (m MsgBeginUnlocking) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{owner}
 }
 
+var _ sdk.Msg = &MsgBeginUnlockingPartial{}
+
+// NewMsgBeginUnlockingPartial creates a message to begin unlocking a sub-amount of the tokens of a specific lock.
+func NewMsgBeginUnlockingPartial(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgBeginUnlockingPartial {
+	return &MsgBeginUnlockingPartial{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgBeginUnlockingPartial) Route() string { return RouterKey }
+func (m MsgBeginUnlockingPartial) Type() string  { return TypeMsgBeginUnlockingPartial }
+func (m MsgBeginUnlockingPartial) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+
+	// only allow unlocks with a single denom, which must be set
+	if m.Coins.Len() != 1 {
+		return fmt.Errorf("must unlock exactly one denom per lock ID, got %v", m.Coins)
+	}
+
+	if !m.Coins.IsAllPositive() {
+		return fmt.Errorf("cannot unlock a zero or negative amount")
+	}
+
+	return nil
+}
+
+func (m MsgBeginUnlockingPartial) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
 // NewMsgExtendLockup creates a message to edit the properties of existing locks
 func NewMsgExtendLockup(owner sdk.AccAddress, id uint64, duration time.Duration) *MsgExtendLockup {
 	return &MsgExtendLockup{
@@ -218,3 +265,222 @@ func (m MsgSetRewardReceiverAddress) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgSplitLock{}
+
+// NewMsgSplitLock creates a message to split a sub-amount of a lock into a new lock.
+func NewMsgSplitLock(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgSplitLock {
+	return &MsgSplitLock{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgSplitLock) Route() string { return RouterKey }
+func (m MsgSplitLock) Type() string  { return TypeMsgSplitLock }
+func (m MsgSplitLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+
+	// locks only hold a single denom
+	if m.Coins.Len() != 1 {
+		return fmt.Errorf("must split exactly one denom per lock ID, got %v", m.Coins)
+	}
+
+	if !m.Coins.IsAllPositive() {
+		return fmt.Errorf("cannot split a zero or negative amount")
+	}
+
+	return nil
+}
+
+func (m MsgSplitLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgMergeLocks{}
+
+// NewMsgMergeLocks creates a message to merge locks into the first of them.
+func NewMsgMergeLocks(owner sdk.AccAddress, lockIds []uint64) *MsgMergeLocks {
+	return &MsgMergeLocks{
+		Owner:   owner.String(),
+		LockIds: lockIds,
+	}
+}
+
+func (m MsgMergeLocks) Route() string { return RouterKey }
+func (m MsgMergeLocks) Type() string  { return TypeMsgMergeLocks }
+func (m MsgMergeLocks) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	return ValidateMergeLockIds(m.LockIds)
+}
+
+func (m MsgMergeLocks) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateMergeLockIds validates the IDs of the locks to merge.
+// There must be at least two of them, and they must be non-zero and unique.
+func ValidateMergeLockIds(lockIds []uint64) error {
+	if len(lockIds) < 2 {
+		return fmt.Errorf("at least two locks are required to merge, got %d", len(lockIds))
+	}
+
+	seen := make(map[uint64]struct{}, len(lockIds))
+	for _, lockId := range lockIds {
+		if lockId == 0 {
+			return fmt.Errorf("invalid lockup ID, got %v", lockId)
+		}
+		if _, ok := seen[lockId]; ok {
+			return fmt.Errorf("duplicate lockup ID %d", lockId)
+		}
+		seen[lockId] = struct{}{}
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgConvertPermanentLock{}
+
+// NewMsgConvertPermanentLock creates a message to convert a permanent lock into a lock with the permanent lock conversion duration.
+func NewMsgConvertPermanentLock(owner sdk.AccAddress, id uint64) *MsgConvertPermanentLock {
+	return &MsgConvertPermanentLock{
+		Owner: owner.String(),
+		ID:    id,
+	}
+}
+
+func (m MsgConvertPermanentLock) Route() string { return RouterKey }
+func (m MsgConvertPermanentLock) Type() string  { return TypeMsgConvertPermanentLock }
+func (m MsgConvertPermanentLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	return nil
+}
+
+func (m MsgConvertPermanentLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgCreateBulkLockDistribution{}
+
+// NewMsgCreateBulkLockDistribution creates a message to escrow funds for a bulk lock distribution.
+func NewMsgCreateBulkLockDistribution(sender sdk.AccAddress, merkleRoot []byte, numEntries uint64, duration time.Duration, funds sdk.Coins) *MsgCreateBulkLockDistribution {
+	return &MsgCreateBulkLockDistribution{
+		Sender:     sender.String(),
+		MerkleRoot: merkleRoot,
+		NumEntries: numEntries,
+		Duration:   duration,
+		Funds:      funds,
+	}
+}
+
+func (m MsgCreateBulkLockDistribution) Route() string { return RouterKey }
+func (m MsgCreateBulkLockDistribution) Type() string  { return TypeMsgCreateBulkLockDistribution }
+func (m MsgCreateBulkLockDistribution) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if len(m.MerkleRoot) != 32 {
+		return fmt.Errorf("merkle root must be 32 bytes, got %d", len(m.MerkleRoot))
+	}
+	if m.NumEntries == 0 {
+		return fmt.Errorf("number of entries must be positive")
+	}
+	if m.Duration <= 0 {
+		return fmt.Errorf("duration must be positive, got %s", m.Duration)
+	}
+	if !m.Funds.IsValid() || m.Funds.IsZero() {
+		return fmt.Errorf("funds must be valid and positive, got %s", m.Funds)
+	}
+	return nil
+}
+
+func (m MsgCreateBulkLockDistribution) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgQueueBulkLockEntries{}
+
+// NewMsgQueueBulkLockEntries creates a message to queue entries of a bulk lock distribution.
+func NewMsgQueueBulkLockEntries(sender sdk.AccAddress, distributionID uint64, leaves []BulkLockLeaf) *MsgQueueBulkLockEntries {
+	return &MsgQueueBulkLockEntries{
+		Sender:         sender.String(),
+		DistributionId: distributionID,
+		Leaves:         leaves,
+	}
+}
+
+func (m MsgQueueBulkLockEntries) Route() string { return RouterKey }
+func (m MsgQueueBulkLockEntries) Type() string  { return TypeMsgQueueBulkLockEntries }
+func (m MsgQueueBulkLockEntries) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if m.DistributionId == 0 {
+		return fmt.Errorf("invalid distribution ID, got %v", m.DistributionId)
+	}
+	if len(m.Leaves) == 0 {
+		return fmt.Errorf("at least one entry is required")
+	}
+	for _, leaf := range m.Leaves {
+		if err := leaf.Entry.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MsgQueueBulkLockEntries) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelBulkLockDistribution{}
+
+// NewMsgCancelBulkLockDistribution creates a message to cancel a bulk lock distribution.
+func NewMsgCancelBulkLockDistribution(sender sdk.AccAddress, distributionID uint64) *MsgCancelBulkLockDistribution {
+	return &MsgCancelBulkLockDistribution{
+		Sender:         sender.String(),
+		DistributionId: distributionID,
+	}
+}
+
+func (m MsgCancelBulkLockDistribution) Route() string { return RouterKey }
+func (m MsgCancelBulkLockDistribution) Type() string  { return TypeMsgCancelBulkLockDistribution }
+func (m MsgCancelBulkLockDistribution) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if m.DistributionId == 0 {
+		return fmt.Errorf("invalid distribution ID, got %v", m.DistributionId)
+	}
+	return nil
+}
+
+func (m MsgCancelBulkLockDistribution) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -9,9 +10,14 @@ import (
 
 // Parameter store keys.
 var (
-	KeyForceUnlockAllowedAddresses = []byte("ForceUnlockAllowedAddresses")
+	KeyForceUnlockAllowedAddresses     = []byte("ForceUnlockAllowedAddresses")
+	KeyPermanentLockConversionDuration = []byte("PermanentLockConversionDuration")
+	KeyBulkLockCreatorAddresses        = []byte("BulkLockCreatorAddresses")
 
 	_ paramtypes.ParamSet = &Params{}
+
+	// DefaultPermanentLockConversionDuration disables the conversion of permanent locks.
+	DefaultPermanentLockConversionDuration = time.Duration(0)
 )
 
 // ParamTable for lockup module.
@@ -28,7 +34,9 @@ func NewParams(forceUnlockAllowedAddresses []string) Params {
 // DefaultParams returns default lockup module parameters.
 func DefaultParams() Params {
 	return Params{
-		ForceUnlockAllowedAddresses: []string{},
+		ForceUnlockAllowedAddresses:     []string{},
+		PermanentLockConversionDuration: DefaultPermanentLockConversionDuration,
+		BulkLockCreatorAddresses:        []string{},
 	}
 }
 
@@ -37,6 +45,12 @@ func (p Params) Validate() error {
 	if err := validateAddresses(p.ForceUnlockAllowedAddresses); err != nil {
 		return err
 	}
+	if err := validatePermanentLockConversionDuration(p.PermanentLockConversionDuration); err != nil {
+		return err
+	}
+	if err := validateAddresses(p.BulkLockCreatorAddresses); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -44,6 +58,8 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyForceUnlockAllowedAddresses, &p.ForceUnlockAllowedAddresses, validateAddresses),
+		paramtypes.NewParamSetPair(KeyPermanentLockConversionDuration, &p.PermanentLockConversionDuration, validatePermanentLockConversionDuration),
+		paramtypes.NewParamSetPair(KeyBulkLockCreatorAddresses, &p.BulkLockCreatorAddresses, validateAddresses),
 	}
 }
 
@@ -61,3 +77,16 @@ func validateAddresses(i interface{}) error {
 
 	return nil
 }
+
+// validatePermanentLockConversionDuration validates that the conversion duration is either zero,
+// disabling the conversion, or a positive duration shorter than the permanent lock duration.
+func validatePermanentLockConversionDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if duration < 0 || duration == PermanentLockDuration {
+		return fmt.Errorf("permanent lock conversion duration must be zero or positive and shorter than the permanent lock duration, got %s", duration)
+	}
+	return nil
+}
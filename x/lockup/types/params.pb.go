@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
 	ForceUnlockAllowedAddresses []string `protobuf:"bytes,1,rep,name=force_unlock_allowed_addresses,json=forceUnlockAllowedAddresses,proto3" json:"force_unlock_allowed_addresses,omitempty" yaml:"force_unlock_allowed_address"`
+	// permanent_lock_conversion_duration is the duration permanent locks are
+	// converted to by MsgConvertPermanentLock. Zero disables the conversion, so
+	// permanent locks can not be unlocked.
+	PermanentLockConversionDuration time.Duration `protobuf:"bytes,2,opt,name=permanent_lock_conversion_duration,json=permanentLockConversionDuration,proto3,stdduration" json:"permanent_lock_conversion_duration" yaml:"permanent_lock_conversion_duration"`
+	// bulk_lock_creator_addresses are the addresses, besides the governance
+	// module account, allowed to schedule bulk lock distributions. These are
+	// expected to be module accounts running lockdrops.
+	BulkLockCreatorAddresses []string `protobuf:"bytes,3,rep,name=bulk_lock_creator_addresses,json=bulkLockCreatorAddresses,proto3" json:"bulk_lock_creator_addresses,omitempty" yaml:"bulk_lock_creator_addresses"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,6 +79,20 @@ func (m *Params) GetForceUnlockAllowedAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetPermanentLockConversionDuration() time.Duration {
+	if m != nil {
+		return m.PermanentLockConversionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetBulkLockCreatorAddresses() []string {
+	if m != nil {
+		return m.BulkLockCreatorAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.lockup.Params")
 }
@@ -74,21 +100,30 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/params.proto", fileDescriptor_4595e58f5e17053c) }
 
 var fileDescriptor_4595e58f5e17053c = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x4a, 0xea, 0x41, 0x24, 0xa5, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x52, 0x19, 0x17, 0x5b, 0x00,
-	0x58, 0x97, 0x50, 0x0e, 0x97, 0x5c, 0x5a, 0x7e, 0x51, 0x72, 0x6a, 0x7c, 0x69, 0x1e, 0x48, 0x47,
-	0x7c, 0x62, 0x4e, 0x4e, 0x7e, 0x79, 0x6a, 0x4a, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71,
-	0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xa7, 0x93, 0xfa, 0xa7, 0x7b, 0xf2, 0xca, 0x95, 0x89,
-	0xb9, 0x39, 0x56, 0x4a, 0xf8, 0xd4, 0x2b, 0x05, 0x49, 0x83, 0xa5, 0x43, 0xc1, 0xb2, 0x8e, 0x10,
-	0x49, 0x47, 0x98, 0x59, 0x4e, 0x3e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x94, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf5, 0x82, 0x6e,
-	0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3, 0x5f, 0x66, 0x64, 0xa6, 0x5f, 0x01, 0xf3, 0x72, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x33, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0xc4,
-	0xc0, 0x34, 0x11, 0x01, 0x00, 0x00,
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x4b, 0xf3, 0x40,
+	0x18, 0xc7, 0x93, 0x16, 0x0a, 0x6f, 0x5e, 0x78, 0x87, 0xf0, 0x0e, 0xb5, 0x85, 0x4b, 0x39, 0x41,
+	0xeb, 0x60, 0x0e, 0x2a, 0x3a, 0xb8, 0xb5, 0x3a, 0x76, 0x90, 0x82, 0x8b, 0x4b, 0xb8, 0x24, 0xd7,
+	0x18, 0x7a, 0xc9, 0x13, 0xee, 0x92, 0x6a, 0xbf, 0x85, 0xa3, 0x8b, 0xdf, 0xa7, 0x63, 0x47, 0xa7,
+	0x28, 0xed, 0x37, 0xe8, 0x2e, 0x48, 0xef, 0x92, 0xe2, 0x54, 0xb7, 0x1c, 0xbf, 0x3f, 0xbf, 0x7b,
+	0x9e, 0x7f, 0xce, 0xea, 0x82, 0x4c, 0x40, 0xc6, 0x92, 0x70, 0x08, 0x66, 0x45, 0x46, 0x32, 0x2a,
+	0x68, 0x22, 0xdd, 0x4c, 0x40, 0x0e, 0xf6, 0xbf, 0x0a, 0xba, 0x1a, 0x76, 0xfe, 0x47, 0x10, 0x81,
+	0x42, 0x64, 0xf7, 0xa5, 0x53, 0x1d, 0x14, 0x01, 0x44, 0x9c, 0x11, 0x75, 0xf2, 0x8b, 0x29, 0x09,
+	0x0b, 0x41, 0xf3, 0x18, 0x52, 0xcd, 0xf1, 0x57, 0xc3, 0x6a, 0xdd, 0x29, 0xad, 0xcd, 0x2d, 0x34,
+	0x05, 0x11, 0x30, 0xaf, 0x48, 0x77, 0x4a, 0x8f, 0x72, 0x0e, 0x4f, 0x2c, 0xf4, 0x68, 0x18, 0x0a,
+	0x26, 0x25, 0x93, 0x6d, 0xb3, 0xd7, 0xec, 0xff, 0x19, 0x9d, 0x6e, 0x4b, 0xe7, 0x78, 0x41, 0x13,
+	0x7e, 0x8d, 0x0f, 0xe5, 0xf1, 0xa4, 0xab, 0xf0, 0xbd, 0xa2, 0x43, 0x0d, 0x87, 0xb5, 0xcb, 0x7e,
+	0x33, 0x2d, 0x9c, 0x31, 0x91, 0xd0, 0x94, 0xa5, 0xb9, 0xa7, 0x04, 0x01, 0xa4, 0x73, 0x26, 0x64,
+	0x0c, 0xa9, 0x57, 0x4f, 0xd9, 0x6e, 0xf4, 0xcc, 0xfe, 0xdf, 0xc1, 0x91, 0xab, 0xd7, 0x70, 0xeb,
+	0x35, 0xdc, 0xdb, 0x2a, 0x30, 0xba, 0x5c, 0x96, 0x8e, 0xb1, 0x2d, 0x9d, 0x33, 0x3d, 0xd1, 0xef,
+	0x4a, 0xfc, 0xfa, 0xe1, 0x98, 0x13, 0x67, 0x1f, 0x1c, 0x43, 0x30, 0xbb, 0xd9, 0xc7, 0x6a, 0xaf,
+	0xcd, 0xac, 0xae, 0x5f, 0xf0, 0x59, 0xa5, 0x11, 0x8c, 0xe6, 0x20, 0x7e, 0x54, 0xd1, 0x54, 0x55,
+	0x9c, 0x6c, 0x4b, 0x07, 0xeb, 0x8b, 0x0f, 0x84, 0xf1, 0xa4, 0xbd, 0xa3, 0xea, 0x22, 0xcd, 0xf6,
+	0x35, 0x8c, 0xc6, 0xcb, 0x35, 0x32, 0x57, 0x6b, 0x64, 0x7e, 0xae, 0x91, 0xf9, 0xb2, 0x41, 0xc6,
+	0x6a, 0x83, 0x8c, 0xf7, 0x0d, 0x32, 0x1e, 0x06, 0x51, 0x9c, 0x3f, 0x16, 0xbe, 0x1b, 0x40, 0x42,
+	0xaa, 0x5f, 0x7d, 0xce, 0xa9, 0x2f, 0xeb, 0x03, 0x99, 0x0f, 0xae, 0xc8, 0x73, 0xfd, 0x34, 0xf2,
+	0x45, 0xc6, 0xa4, 0xdf, 0x52, 0xfd, 0x5c, 0x7c, 0x0f, 0x00, 0xc0, 0x1a, 0xe4, 0xe3, 0x39, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +146,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BulkLockCreatorAddresses) > 0 {
+		for iNdEx := len(m.BulkLockCreatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BulkLockCreatorAddresses[iNdEx])
+			copy(dAtA[i:], m.BulkLockCreatorAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BulkLockCreatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PermanentLockConversionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PermanentLockConversionDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	if len(m.ForceUnlockAllowedAddresses) > 0 {
 		for iNdEx := len(m.ForceUnlockAllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ForceUnlockAllowedAddresses[iNdEx])
@@ -146,6 +198,14 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PermanentLockConversionDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.BulkLockCreatorAddresses) > 0 {
+		for _, s := range m.BulkLockCreatorAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +276,71 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ForceUnlockAllowedAddresses = append(m.ForceUnlockAllowedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermanentLockConversionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PermanentLockConversionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BulkLockCreatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BulkLockCreatorAddresses = append(m.BulkLockCreatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
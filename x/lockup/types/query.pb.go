@@ -1868,6 +1868,179 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type LockViewRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *LockViewRequest) Reset()         { *m = LockViewRequest{} }
+func (m *LockViewRequest) String() string { return proto.CompactTextString(m) }
+func (*LockViewRequest) ProtoMessage()    {}
+func (*LockViewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{40}
+}
+func (m *LockViewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockViewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockViewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockViewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockViewRequest.Merge(m, src)
+}
+func (m *LockViewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockViewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockViewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockViewRequest proto.InternalMessageInfo
+
+func (m *LockViewRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// LockView joins a lock with its unlocking status, its synthetic lockup and
+// its incentives eligibility.
+type LockView struct {
+	Lock PeriodLock `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock"`
+	// IsUnlocking is true if the lock has started unlocking.
+	IsUnlocking bool `protobuf:"varint,2,opt,name=is_unlocking,json=isUnlocking,proto3" json:"is_unlocking,omitempty" yaml:"is_unlocking"`
+	// SyntheticLock is the lock's synthetic lockup, nil if the lock is not
+	// superfluid staked or unbonding.
+	SyntheticLock *SyntheticLock `protobuf:"bytes,3,opt,name=synthetic_lock,json=syntheticLock,proto3" json:"synthetic_lock,omitempty" yaml:"synthetic_lock"`
+	// QualifyingGaugeIds are the ids of the gauges distributing to the lock at
+	// the next distribution, including through its synthetic lockup.
+	QualifyingGaugeIds []uint64 `protobuf:"varint,4,rep,packed,name=qualifying_gauge_ids,json=qualifyingGaugeIds,proto3" json:"qualifying_gauge_ids,omitempty" yaml:"qualifying_gauge_ids"`
+	// NextDistributionTime is the time of the next distribution of the
+	// qualifying gauges, nil if the lock qualifies for no gauge.
+	NextDistributionTime *time.Time `protobuf:"bytes,5,opt,name=next_distribution_time,json=nextDistributionTime,proto3,stdtime" json:"next_distribution_time,omitempty" yaml:"next_distribution_time"`
+}
+
+func (m *LockView) Reset()         { *m = LockView{} }
+func (m *LockView) String() string { return proto.CompactTextString(m) }
+func (*LockView) ProtoMessage()    {}
+func (*LockView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{41}
+}
+func (m *LockView) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockView.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockView.Merge(m, src)
+}
+func (m *LockView) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockView) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockView proto.InternalMessageInfo
+
+func (m *LockView) GetLock() PeriodLock {
+	if m != nil {
+		return m.Lock
+	}
+	return PeriodLock{}
+}
+
+func (m *LockView) GetIsUnlocking() bool {
+	if m != nil {
+		return m.IsUnlocking
+	}
+	return false
+}
+
+func (m *LockView) GetSyntheticLock() *SyntheticLock {
+	if m != nil {
+		return m.SyntheticLock
+	}
+	return nil
+}
+
+func (m *LockView) GetQualifyingGaugeIds() []uint64 {
+	if m != nil {
+		return m.QualifyingGaugeIds
+	}
+	return nil
+}
+
+func (m *LockView) GetNextDistributionTime() *time.Time {
+	if m != nil {
+		return m.NextDistributionTime
+	}
+	return nil
+}
+
+type LockViewResponse struct {
+	Locks []LockView `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+}
+
+func (m *LockViewResponse) Reset()         { *m = LockViewResponse{} }
+func (m *LockViewResponse) String() string { return proto.CompactTextString(m) }
+func (*LockViewResponse) ProtoMessage()    {}
+func (*LockViewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{42}
+}
+func (m *LockViewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockViewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockViewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockViewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockViewResponse.Merge(m, src)
+}
+func (m *LockViewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockViewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockViewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockViewResponse proto.InternalMessageInfo
+
+func (m *LockViewResponse) GetLocks() []LockView {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleBalanceRequest)(nil), "osmosis.lockup.ModuleBalanceRequest")
 	proto.RegisterType((*ModuleBalanceResponse)(nil), "osmosis.lockup.ModuleBalanceResponse")
@@ -1909,117 +2082,134 @@ func init() {
 	proto.RegisterType((*AccountLockedLongerDurationDenomResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.lockup.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.lockup.QueryParamsResponse")
+	proto.RegisterType((*LockViewRequest)(nil), "osmosis.lockup.LockViewRequest")
+	proto.RegisterType((*LockView)(nil), "osmosis.lockup.LockView")
+	proto.RegisterType((*LockViewResponse)(nil), "osmosis.lockup.LockViewResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x14, 0x55,
-	0x1c, 0xef, 0x2b, 0xb4, 0xca, 0x17, 0x5b, 0xf0, 0x51, 0xb0, 0x9d, 0x96, 0xdd, 0xf2, 0x80, 0x52,
-	0xb5, 0x9d, 0xa1, 0xa5, 0x29, 0x88, 0xfc, 0x5c, 0x4a, 0x4d, 0xb1, 0x22, 0x2c, 0xa8, 0xf1, 0x57,
-	0x36, 0xb3, 0xbb, 0x8f, 0x65, 0xd2, 0xdd, 0x99, 0x65, 0x67, 0xb6, 0xb0, 0x12, 0x24, 0x82, 0x07,
-	0x0f, 0x1e, 0x30, 0x5e, 0x8c, 0x07, 0xa3, 0xde, 0xd4, 0xc4, 0x78, 0xf1, 0x40, 0xbc, 0x2b, 0xf1,
-	0x60, 0x48, 0xbc, 0x18, 0x0f, 0xc5, 0x50, 0xff, 0x02, 0x4e, 0x26, 0x5e, 0xcc, 0xbc, 0xf7, 0x66,
-	0xbb, 0xf3, 0x73, 0x67, 0xbb, 0xd2, 0xf4, 0xd4, 0xce, 0x7c, 0x7f, 0x7d, 0x3e, 0xdf, 0xf7, 0xf3,
-	0x33, 0x0b, 0x92, 0x61, 0x96, 0x0c, 0x53, 0x33, 0x95, 0xa2, 0x91, 0x5b, 0xa8, 0x96, 0x95, 0x2b,
-	0x55, 0x5a, 0xa9, 0xc9, 0xe5, 0x8a, 0x61, 0x19, 0xb8, 0x57, 0xd8, 0x64, 0x6e, 0x93, 0xfa, 0x0a,
-	0x46, 0xc1, 0x60, 0x26, 0xc5, 0xfe, 0x8f, 0x7b, 0x49, 0x89, 0x1c, 0x73, 0x53, 0xb2, 0xaa, 0x49,
-	0x95, 0xc5, 0x89, 0x2c, 0xb5, 0xd4, 0x09, 0x25, 0x67, 0x68, 0xba, 0xb0, 0x0f, 0x15, 0x0c, 0xa3,
-	0x50, 0xa4, 0x8a, 0x5a, 0xd6, 0x14, 0x55, 0xd7, 0x0d, 0x4b, 0xb5, 0x34, 0x43, 0x37, 0x85, 0x35,
-	0x29, 0xac, 0xec, 0x29, 0x5b, 0xbd, 0xa4, 0x58, 0x5a, 0x89, 0x9a, 0x96, 0x5a, 0x2a, 0x3b, 0xe9,
-	0xbd, 0x0e, 0xf9, 0x6a, 0x85, 0x65, 0x10, 0xf6, 0x01, 0x0f, 0x01, 0xfb, 0x8f, 0x30, 0x0d, 0x7a,
-	0x4c, 0x65, 0xb5, 0xa2, 0x96, 0x44, 0x61, 0xb2, 0x03, 0xfa, 0x5e, 0x31, 0xf2, 0xd5, 0x22, 0x4d,
-	0xa9, 0x45, 0x55, 0xcf, 0xd1, 0x34, 0xbd, 0x52, 0xa5, 0xa6, 0x45, 0xde, 0x83, 0xed, 0x9e, 0xf7,
-	0x66, 0xd9, 0xd0, 0x4d, 0x8a, 0x55, 0xe8, 0xb2, 0x59, 0x99, 0xfd, 0x68, 0x78, 0xc3, 0xe8, 0xe6,
-	0xc9, 0x01, 0x99, 0xf3, 0x96, 0x6d, 0xde, 0xb2, 0xe0, 0x2d, 0x9f, 0x32, 0x34, 0x3d, 0xb5, 0xff,
-	0xde, 0x52, 0xb2, 0xe3, 0xdb, 0x07, 0xc9, 0xd1, 0x82, 0x66, 0x5d, 0xae, 0x66, 0xe5, 0x9c, 0x51,
-	0x52, 0x44, 0x93, 0xf8, 0x9f, 0x71, 0x33, 0xbf, 0xa0, 0x58, 0xb5, 0x32, 0x35, 0x59, 0x80, 0x99,
-	0xe6, 0x99, 0xc9, 0x20, 0x0c, 0xf0, 0xda, 0xf3, 0x46, 0x6e, 0x81, 0xe6, 0x4f, 0x96, 0x8c, 0xaa,
-	0x6e, 0x39, 0xc0, 0x6e, 0x82, 0x14, 0x64, 0x5c, 0x3b, 0x74, 0x2f, 0xc1, 0xce, 0x93, 0xb9, 0x9c,
-	0x5d, 0xf5, 0x35, 0xdd, 0xee, 0xa8, 0x9a, 0x2d, 0x52, 0xee, 0xc0, 0x11, 0xe2, 0x11, 0xe8, 0x32,
-	0xae, 0xea, 0xb4, 0xd2, 0x8f, 0x86, 0xd1, 0xe8, 0xa6, 0xd4, 0xd6, 0x47, 0x4b, 0xc9, 0xa7, 0x6a,
-	0x6a, 0xa9, 0x78, 0x98, 0xb0, 0xd7, 0x24, 0xcd, 0xcd, 0xe4, 0x36, 0x82, 0x44, 0x58, 0xa6, 0xb5,
-	0xa3, 0x33, 0x0b, 0x43, 0x2e, 0x10, 0x9a, 0x5e, 0x58, 0x15, 0x9b, 0x5b, 0xc8, 0xd3, 0x97, 0x95,
-	0x44, 0x6b, 0x47, 0xe6, 0x14, 0x0c, 0x08, 0x0c, 0x7c, 0x76, 0xac, 0x8a, 0xc9, 0x4d, 0x90, 0x82,
-	0x92, 0xac, 0x1d, 0x8b, 0x2f, 0x50, 0x7d, 0x4c, 0x38, 0x82, 0x73, 0xaa, 0x69, 0x5d, 0xd4, 0x4a,
-	0xb4, 0x45, 0x26, 0xf8, 0x75, 0xd8, 0x54, 0xdf, 0x47, 0xfa, 0x3b, 0x87, 0xd1, 0xe8, 0xe6, 0x49,
-	0x49, 0xe6, 0x1b, 0x89, 0xec, 0x6c, 0x24, 0xf2, 0x45, 0xc7, 0x23, 0x35, 0x64, 0x03, 0x7e, 0xb4,
-	0x94, 0xdc, 0xca, 0x73, 0xd5, 0x43, 0xc9, 0x9d, 0x07, 0x49, 0x94, 0x5e, 0x49, 0x45, 0xde, 0xa8,
-	0x0f, 0xb5, 0x17, 0x9f, 0x68, 0xd2, 0x34, 0x74, 0xd9, 0x53, 0xc0, 0x69, 0x92, 0x24, 0xbb, 0xb7,
-	0x50, 0xf9, 0x1c, 0xad, 0x68, 0x46, 0xde, 0x0e, 0x4e, 0x6d, 0xb4, 0x8b, 0xa6, 0xb9, 0x3b, 0xf9,
-	0x1e, 0xc1, 0x58, 0x60, 0xe6, 0xb3, 0xc6, 0xca, 0xac, 0x7a, 0x55, 0x2f, 0xd6, 0xd6, 0x4b, 0x27,
-	0x0a, 0x30, 0x1e, 0x13, 0x6f, 0x9b, 0x9d, 0xf9, 0x1a, 0xc1, 0xb0, 0x6b, 0x79, 0xd1, 0x7c, 0x8a,
-	0x5e, 0x32, 0x2a, 0x74, 0x3d, 0xcd, 0x8b, 0xb7, 0x61, 0x57, 0x04, 0xc6, 0x36, 0x3b, 0x70, 0x17,
-	0xd5, 0xb3, 0xbb, 0x7b, 0x3d, 0x43, 0x75, 0xa3, 0xb4, 0x4e, 0x5a, 0x80, 0xfb, 0xa0, 0x2b, 0x6f,
-	0xe3, 0xe9, 0xdf, 0x60, 0xd7, 0x4f, 0xf3, 0x07, 0xf2, 0x0e, 0x90, 0x28, 0xe8, 0x6d, 0x76, 0xe6,
-	0x7d, 0xc0, 0x3c, 0xad, 0xab, 0x13, 0x75, 0x24, 0xa8, 0x01, 0x09, 0x4e, 0xc3, 0x93, 0xce, 0xcd,
-	0x41, 0xd0, 0x1e, 0xf0, 0xd1, 0x9e, 0x11, 0x0e, 0xa9, 0x41, 0xc1, 0x7a, 0x0b, 0x67, 0xed, 0x04,
-	0x92, 0xcf, 0x6c, 0xd2, 0xf5, 0x3c, 0xe4, 0x5d, 0xd8, 0xe6, 0xaa, 0x2f, 0xe8, 0xcc, 0x42, 0xb7,
-	0xca, 0x4e, 0x67, 0x31, 0x16, 0xb2, 0x9d, 0xed, 0xcf, 0xa5, 0xe4, 0x76, 0xbe, 0xfb, 0x99, 0xf9,
-	0x05, 0x59, 0x33, 0x94, 0x92, 0x6a, 0x5d, 0x96, 0xe7, 0x74, 0xeb, 0xd1, 0x52, 0xb2, 0x87, 0x97,
-	0xe1, 0x41, 0x24, 0x2d, 0xa2, 0xc9, 0x28, 0xf4, 0xf0, 0xf4, 0x0e, 0xb3, 0x67, 0xe0, 0x09, 0x9b,
-	0x78, 0x46, 0xcb, 0xb3, 0xcc, 0x1b, 0xd3, 0xdd, 0xf6, 0xe3, 0x5c, 0x9e, 0x9c, 0x80, 0x5e, 0xc7,
-	0x53, 0x60, 0x90, 0x61, 0xa3, 0x6d, 0x63, 0x7e, 0x91, 0x1d, 0x4d, 0x33, 0x3f, 0x32, 0x05, 0x03,
-	0xec, 0x89, 0x5e, 0x55, 0x2b, 0xf9, 0x34, 0xcd, 0x51, 0x6d, 0x91, 0x56, 0x9a, 0xd6, 0x3d, 0x0d,
-	0x52, 0x50, 0x94, 0xc0, 0xb0, 0x0f, 0xb6, 0x54, 0x98, 0x25, 0x53, 0x11, 0x26, 0x31, 0x24, 0xbd,
-	0x15, 0x57, 0x00, 0xd9, 0x06, 0x4f, 0x9f, 0xa5, 0xd7, 0xd8, 0x14, 0x99, 0x9b, 0x71, 0xee, 0x3b,
-	0xe3, 0x80, 0x1b, 0x5f, 0x8a, 0x9c, 0x11, 0x2d, 0xd8, 0x75, 0xa1, 0xa6, 0x5b, 0x97, 0xa9, 0xa5,
-	0xe5, 0xe6, 0x19, 0x49, 0x33, 0x55, 0xe3, 0xff, 0xd4, 0x73, 0x86, 0x46, 0x1f, 0xee, 0xec, 0x47,
-	0x64, 0x11, 0x48, 0x54, 0x06, 0x01, 0x60, 0x1e, 0xb6, 0x98, 0x8e, 0x57, 0xa6, 0x71, 0xd6, 0xee,
-	0xf4, 0xf6, 0xd8, 0x95, 0x4c, 0x4c, 0xdc, 0x5e, 0xb3, 0xf1, 0xa5, 0xc9, 0xea, 0xbe, 0x08, 0xc3,
-	0x9e, 0xba, 0xf1, 0x81, 0x13, 0xc3, 0x47, 0x3b, 0x00, 0xf3, 0x19, 0xe8, 0x75, 0x63, 0x16, 0xd3,
-	0x22, 0x16, 0xe4, 0x1e, 0x17, 0x64, 0xf2, 0x25, 0xf2, 0x2c, 0xe9, 0x79, 0x43, 0x2f, 0xd0, 0x8a,
-	0xb3, 0x74, 0x5a, 0xdd, 0x8e, 0x1e, 0xcf, 0xb2, 0xdc, 0x1d, 0x89, 0xb0, 0xcd, 0x5d, 0xe7, 0x73,
-	0xef, 0x2d, 0x65, 0x3d, 0x71, 0xf7, 0xde, 0x50, 0xfe, 0x37, 0xd6, 0x3f, 0x20, 0x98, 0x8c, 0xe8,
-	0x6a, 0xbb, 0xf7, 0x94, 0xc7, 0xd1, 0x8b, 0x12, 0x1c, 0x68, 0x09, 0x71, 0x9b, 0x1d, 0xfa, 0x09,
-	0xc1, 0xbe, 0x88, 0x7a, 0xab, 0x3a, 0xad, 0x1f, 0x43, 0x5b, 0x42, 0x4e, 0xea, 0x2c, 0x8c, 0x36,
-	0x07, 0xdf, 0x66, 0x87, 0xfa, 0x00, 0x9f, 0xaf, 0xd2, 0x4a, 0xed, 0x1c, 0x13, 0xe2, 0xce, 0x46,
-	0xff, 0x32, 0x6c, 0x73, 0xbd, 0x15, 0x45, 0xa6, 0xa0, 0x9b, 0x0b, 0x76, 0xb1, 0x59, 0xed, 0xf0,
-	0x55, 0x61, 0x56, 0x51, 0x41, 0xf8, 0x4e, 0xfe, 0x3b, 0x04, 0x5d, 0x2c, 0x1b, 0xfe, 0x18, 0x41,
-	0x8f, 0x4b, 0xc9, 0xe3, 0x3d, 0xde, 0x0c, 0x41, 0x1f, 0x00, 0xa4, 0xbd, 0x4d, 0xbc, 0x38, 0x3c,
-	0x22, 0xdf, 0xfa, 0xfd, 0xef, 0x4f, 0x3b, 0x47, 0xf1, 0x88, 0xe2, 0xf9, 0xca, 0xe0, 0x7c, 0x02,
-	0x29, 0xb1, 0xb0, 0x4c, 0x56, 0x14, 0xff, 0x0a, 0x01, 0xf6, 0xeb, 0x77, 0xfc, 0x6c, 0x70, 0xb5,
-	0x80, 0x0f, 0x00, 0xd2, 0x73, 0x71, 0x5c, 0x05, 0xba, 0x29, 0x86, 0x4e, 0xc6, 0x63, 0x4d, 0xd0,
-	0xf1, 0xcb, 0x6a, 0x86, 0x5f, 0x38, 0xf0, 0x5d, 0x04, 0x3b, 0x82, 0x85, 0x39, 0x1e, 0xf7, 0x16,
-	0x8f, 0xfc, 0x14, 0x20, 0xc9, 0x71, 0xdd, 0x05, 0xde, 0x13, 0x0c, 0xef, 0x61, 0x7c, 0x28, 0x0c,
-	0xaf, 0xca, 0xe3, 0x33, 0xd5, 0x7a, 0x82, 0x0c, 0xd3, 0x8c, 0xca, 0x75, 0xb6, 0x50, 0x6e, 0xe0,
-	0x1f, 0x11, 0x6c, 0x0f, 0x94, 0xe1, 0x78, 0x2c, 0x12, 0x8b, 0x47, 0xf6, 0x4b, 0xe3, 0x31, 0xbd,
-	0x05, 0xf0, 0xe3, 0x0c, 0xf8, 0x0b, 0xf8, 0x60, 0x3c, 0xe0, 0x9a, 0x5e, 0xf0, 0xe0, 0xfe, 0x06,
-	0x01, 0xf6, 0xab, 0x6e, 0xff, 0xbc, 0x08, 0x95, 0xf7, 0xfe, 0x79, 0x11, 0x2e, 0xe2, 0xc9, 0x11,
-	0x06, 0x77, 0x1a, 0x4f, 0x35, 0x83, 0x2b, 0x26, 0x46, 0x68, 0x8f, 0xdd, 0xd7, 0xf9, 0xd0, 0x1e,
-	0x07, 0xca, 0xf8, 0xd0, 0x1e, 0x07, 0x8b, 0xea, 0xf8, 0x3d, 0x16, 0xa0, 0xcb, 0xaa, 0x69, 0xd9,
-	0xc2, 0xa4, 0x8e, 0xfb, 0x1f, 0x04, 0x7b, 0x63, 0xa9, 0x55, 0x7c, 0x24, 0x16, 0xb2, 0x90, 0xc3,
-	0x4e, 0x3a, 0xba, 0xca, 0x68, 0xc1, 0x33, 0xcd, 0x78, 0xce, 0xe3, 0x33, 0x2d, 0xf2, 0xcc, 0xe8,
-	0x46, 0xe3, 0xfc, 0x32, 0xf4, 0x62, 0xad, 0x4e, 0xfd, 0x67, 0x54, 0xff, 0x32, 0xe4, 0x97, 0xa6,
-	0x78, 0x7f, 0xe4, 0x64, 0x0f, 0x50, 0xda, 0xd2, 0x44, 0x0b, 0x11, 0x82, 0xd6, 0x0c, 0xa3, 0x75,
-	0x0c, 0x1f, 0x89, 0xb7, 0x44, 0x68, 0x3e, 0x93, 0x65, 0x49, 0x32, 0xae, 0x31, 0xfc, 0x15, 0x79,
-	0xbe, 0x4e, 0xb9, 0xa4, 0x24, 0x9e, 0x88, 0xd5, 0xfa, 0xc6, 0x33, 0x58, 0x9a, 0x6c, 0x25, 0x44,
-	0x70, 0x39, 0xcd, 0xb8, 0x1c, 0xc7, 0x47, 0x5b, 0x1d, 0x22, 0x76, 0xc8, 0xd6, 0xc9, 0x7c, 0x88,
-	0x60, 0x73, 0x83, 0x72, 0xc4, 0xc4, 0x0b, 0xc5, 0x2f, 0x6b, 0xa5, 0xdd, 0x91, 0x3e, 0x02, 0xdf,
-	0x18, 0xc3, 0x37, 0x82, 0xf7, 0x84, 0xe1, 0x13, 0xb8, 0xb8, 0x26, 0xbe, 0x8d, 0x00, 0x78, 0x96,
-	0x54, 0x6d, 0x6e, 0x06, 0xef, 0x0c, 0xae, 0xe0, 0x00, 0x48, 0x84, 0x99, 0x45, 0xed, 0x69, 0x56,
-	0x7b, 0x3f, 0x96, 0x9b, 0xd4, 0xce, 0xd6, 0x32, 0x5a, 0x5e, 0xb9, 0x2e, 0xf4, 0xcc, 0x0d, 0xfc,
-	0x1d, 0xe2, 0x32, 0xde, 0xad, 0x22, 0xfd, 0x3b, 0x60, 0xa8, 0x3e, 0xf5, 0xef, 0x80, 0xe1, 0xa2,
-	0x94, 0x1c, 0x63, 0x28, 0x0f, 0xe1, 0xe9, 0x28, 0x94, 0x19, 0x8f, 0x6e, 0x6d, 0x40, 0xfb, 0x01,
-	0x02, 0x58, 0xd1, 0xa5, 0x78, 0x97, 0xb7, 0xb4, 0x4f, 0xc8, 0x4a, 0x24, 0xca, 0x25, 0xee, 0xb8,
-	0xe9, 0xf4, 0x1a, 0x9f, 0x54, 0x19, 0x2d, 0x8f, 0x7f, 0x43, 0x20, 0x85, 0x4b, 0x55, 0xff, 0x5a,
-	0x68, 0x2a, 0x8c, 0xfd, 0x6b, 0xa1, 0xb9, 0x12, 0x26, 0x73, 0x0c, 0xf3, 0x09, 0x7c, 0x2c, 0x0c,
-	0xb3, 0x5b, 0x73, 0x56, 0xcb, 0xa6, 0x3d, 0xf4, 0x82, 0xc3, 0x4a, 0x47, 0x3f, 0xea, 0x44, 0xf8,
-	0x17, 0x04, 0x03, 0xa1, 0x32, 0xd6, 0xbf, 0x4b, 0x35, 0x93, 0xcb, 0xd2, 0x44, 0x0b, 0x11, 0x71,
-	0x57, 0xb6, 0x97, 0x4d, 0x20, 0x19, 0x7b, 0x68, 0x06, 0x23, 0xee, 0xd1, 0x38, 0x7a, 0xd3, 0x09,
-	0xd4, 0xd2, 0xd2, 0x81, 0x96, 0x62, 0x04, 0x9f, 0xd9, 0x66, 0xa3, 0xe3, 0xd9, 0xa9, 0x8a, 0x2c,
-	0x4d, 0xc6, 0x51, 0x09, 0xe1, 0x67, 0x7e, 0x9d, 0x4a, 0xf4, 0x99, 0xef, 0x25, 0x31, 0x1e, 0xd3,
-	0x7b, 0x95, 0x67, 0xbe, 0x0f, 0xf7, 0x27, 0x9d, 0xf0, 0x7c, 0x0b, 0xea, 0x0f, 0xa7, 0x5a, 0x68,
-	0x72, 0xd8, 0xf9, 0x7f, 0xaa, 0xad, 0x1c, 0x82, 0xf9, 0x9b, 0x8c, 0xf9, 0x05, 0x7c, 0x7e, 0x75,
-	0x03, 0x17, 0x75, 0x19, 0x58, 0x5e, 0xf9, 0x96, 0x1e, 0x2a, 0xf2, 0xf0, 0xc1, 0x16, 0x48, 0xb8,
-	0x0e, 0xa8, 0x43, 0xad, 0x07, 0x0a, 0xca, 0xf3, 0x8c, 0xf2, 0x2c, 0x9e, 0x59, 0x25, 0x65, 0xf7,
-	0xe1, 0x5a, 0x83, 0x6e, 0x2e, 0x0d, 0xfd, 0xc7, 0xaa, 0x5f, 0x7d, 0xfa, 0x8f, 0xd5, 0x00, 0x2d,
-	0x4a, 0x46, 0x18, 0xc0, 0x61, 0x9c, 0x08, 0x03, 0xc8, 0xd5, 0x67, 0x6a, 0xfe, 0xde, 0xc3, 0x04,
-	0xba, 0xff, 0x30, 0x81, 0xfe, 0x7a, 0x98, 0x40, 0x77, 0x96, 0x13, 0x1d, 0xf7, 0x97, 0x13, 0x1d,
-	0x7f, 0x2c, 0x27, 0x3a, 0xde, 0x9a, 0x6c, 0xf8, 0x2d, 0x4c, 0xe4, 0x18, 0x2f, 0xaa, 0x59, 0xb3,
-	0x9e, 0x70, 0x71, 0x72, 0x5a, 0xb9, 0xe6, 0xa4, 0x65, 0xbf, 0x8d, 0x65, 0xbb, 0x99, 0xc4, 0x3f,
-	0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x93, 0x02, 0x4a, 0xa4, 0x1f, 0x00, 0x00,
+	// 1897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0xdb, 0xd6,
+	0x1d, 0xcf, 0xf3, 0xaf, 0xa5, 0xdf, 0x34, 0x4e, 0xf6, 0x62, 0xbb, 0x12, 0x6d, 0x4b, 0x32, 0xd3,
+	0xb8, 0x6a, 0x6a, 0x91, 0xb1, 0x62, 0xb8, 0xa9, 0x97, 0xa6, 0xa9, 0xe2, 0xba, 0x73, 0xe7, 0x75,
+	0x89, 0xda, 0x75, 0xd8, 0x2f, 0x10, 0x94, 0xf8, 0xa2, 0x10, 0x96, 0x48, 0x45, 0xa4, 0xec, 0x68,
+	0x45, 0xd7, 0xad, 0xdd, 0x61, 0x87, 0x1d, 0x3a, 0xec, 0x32, 0xec, 0x30, 0x6c, 0xbb, 0x75, 0x03,
+	0x86, 0x5d, 0x76, 0x28, 0x76, 0xdf, 0x8a, 0x1d, 0x86, 0x02, 0xbb, 0x0c, 0x3b, 0xa8, 0x43, 0xbc,
+	0xbf, 0xc0, 0xa7, 0x9d, 0x86, 0x81, 0xef, 0x3d, 0xca, 0x22, 0x45, 0x52, 0xa4, 0xb5, 0x18, 0x3e,
+	0xd9, 0xe4, 0xfb, 0xfe, 0xf8, 0x7c, 0xbe, 0xef, 0xcb, 0xf7, 0xe3, 0x23, 0x10, 0x4c, 0xab, 0x61,
+	0x5a, 0xba, 0x25, 0xd7, 0xcd, 0xea, 0x6e, 0xbb, 0x29, 0x3f, 0x6c, 0x93, 0x56, 0x47, 0x6a, 0xb6,
+	0x4c, 0xdb, 0xc4, 0xd3, 0x7c, 0x4c, 0x62, 0x63, 0xc2, 0x4c, 0xcd, 0xac, 0x99, 0x74, 0x48, 0x76,
+	0xfe, 0x63, 0x56, 0x42, 0xa6, 0x4a, 0xcd, 0xe4, 0x8a, 0x6a, 0x11, 0x79, 0x6f, 0xb5, 0x42, 0x6c,
+	0x75, 0x55, 0xae, 0x9a, 0xba, 0xc1, 0xc7, 0x17, 0x6a, 0xa6, 0x59, 0xab, 0x13, 0x59, 0x6d, 0xea,
+	0xb2, 0x6a, 0x18, 0xa6, 0xad, 0xda, 0xba, 0x69, 0x58, 0x7c, 0x34, 0xcb, 0x47, 0xe9, 0x53, 0xa5,
+	0x7d, 0x5f, 0xb6, 0xf5, 0x06, 0xb1, 0x6c, 0xb5, 0xd1, 0x74, 0xc3, 0xfb, 0x0d, 0xb4, 0x76, 0x8b,
+	0x46, 0xe0, 0xe3, 0x69, 0x1f, 0x01, 0xe7, 0x0f, 0x1f, 0x9a, 0xf7, 0x0d, 0x35, 0xd5, 0x96, 0xda,
+	0xe0, 0x89, 0xc5, 0x39, 0x98, 0xf9, 0xaa, 0xa9, 0xb5, 0xeb, 0xa4, 0xa4, 0xd6, 0x55, 0xa3, 0x4a,
+	0xca, 0xe4, 0x61, 0x9b, 0x58, 0xb6, 0xf8, 0x3d, 0x98, 0xf5, 0xbd, 0xb7, 0x9a, 0xa6, 0x61, 0x11,
+	0xac, 0xc2, 0xa4, 0xc3, 0xca, 0x4a, 0xa1, 0xdc, 0x78, 0xfe, 0x5c, 0x31, 0x2d, 0x31, 0xde, 0x92,
+	0xc3, 0x5b, 0xe2, 0xbc, 0xa5, 0x3b, 0xa6, 0x6e, 0x94, 0xae, 0x7d, 0xda, 0xcd, 0x9e, 0xf9, 0xed,
+	0xe7, 0xd9, 0x7c, 0x4d, 0xb7, 0x1f, 0xb4, 0x2b, 0x52, 0xd5, 0x6c, 0xc8, 0xbc, 0x48, 0xec, 0x4f,
+	0xc1, 0xd2, 0x76, 0x65, 0xbb, 0xd3, 0x24, 0x16, 0x75, 0xb0, 0xca, 0x2c, 0xb2, 0x38, 0x0f, 0x69,
+	0x96, 0x7b, 0xc7, 0xac, 0xee, 0x12, 0xed, 0xd5, 0x86, 0xd9, 0x36, 0x6c, 0x17, 0xd8, 0xfb, 0x20,
+	0x04, 0x0d, 0x9e, 0x1c, 0xba, 0xd7, 0x61, 0xf1, 0xd5, 0x6a, 0xd5, 0xc9, 0xfa, 0x75, 0xc3, 0xa9,
+	0xa8, 0x5a, 0xa9, 0x13, 0x66, 0xc0, 0x10, 0xe2, 0x65, 0x98, 0x34, 0xf7, 0x0d, 0xd2, 0x4a, 0xa1,
+	0x1c, 0xca, 0x3f, 0x55, 0xba, 0x78, 0xd8, 0xcd, 0x3e, 0xdd, 0x51, 0x1b, 0xf5, 0x0d, 0x91, 0xbe,
+	0x16, 0xcb, 0x6c, 0x58, 0xfc, 0x10, 0x41, 0x26, 0x2c, 0xd2, 0xc9, 0xd1, 0xd9, 0x82, 0x05, 0x0f,
+	0x08, 0xdd, 0xa8, 0x1d, 0x8b, 0xcd, 0x07, 0x08, 0x16, 0x43, 0x02, 0x9d, 0x1c, 0x99, 0x3b, 0x90,
+	0xe6, 0x18, 0x58, 0x77, 0x1c, 0x8b, 0xc9, 0xfb, 0x20, 0x04, 0x05, 0x39, 0x39, 0x16, 0xbf, 0x44,
+	0xb0, 0xe0, 0x41, 0x70, 0x57, 0xb5, 0xec, 0xb7, 0xf5, 0x06, 0x49, 0xc8, 0x04, 0xbf, 0x03, 0x4f,
+	0xf5, 0xd6, 0x91, 0xd4, 0x58, 0x0e, 0xe5, 0xcf, 0x15, 0x05, 0x89, 0x2d, 0x24, 0x92, 0xbb, 0x90,
+	0x48, 0x6f, 0xbb, 0x16, 0xa5, 0x05, 0x07, 0xf0, 0x61, 0x37, 0x7b, 0x91, 0xc5, 0xea, 0xb9, 0x8a,
+	0x1f, 0x7d, 0x9e, 0x45, 0xe5, 0xa3, 0x50, 0xe2, 0x37, 0x60, 0x31, 0x04, 0x1f, 0x2f, 0xd2, 0x3a,
+	0x4c, 0x3a, 0x2d, 0xe0, 0x16, 0x49, 0x90, 0xbc, 0x4b, 0xa8, 0x74, 0x97, 0xb4, 0x74, 0x53, 0x73,
+	0x9c, 0x4b, 0x13, 0x4e, 0xd2, 0x32, 0x33, 0x17, 0x7f, 0x8f, 0x60, 0x25, 0x30, 0xf2, 0x9b, 0xe6,
+	0x51, 0x57, 0x7d, 0xcd, 0xa8, 0x77, 0x4e, 0x4b, 0x25, 0x6a, 0x50, 0x88, 0x89, 0x77, 0xc4, 0xca,
+	0xfc, 0x06, 0x41, 0xce, 0xf3, 0x79, 0x11, 0xad, 0x44, 0xee, 0x9b, 0x2d, 0x72, 0x9a, 0xfa, 0xe2,
+	0xdb, 0xb0, 0x14, 0x81, 0x71, 0xc4, 0x0a, 0x7c, 0x82, 0x7a, 0xd1, 0xbd, 0xb5, 0xde, 0x24, 0x86,
+	0xd9, 0x38, 0x25, 0x25, 0xc0, 0x33, 0x30, 0xa9, 0x39, 0x78, 0x52, 0xe3, 0x4e, 0xfe, 0x32, 0x7b,
+	0x10, 0xbf, 0x03, 0x62, 0x14, 0xf4, 0x11, 0x2b, 0xf3, 0x7d, 0xc0, 0x2c, 0xac, 0xa7, 0x12, 0x3d,
+	0x24, 0xa8, 0x0f, 0x09, 0x2e, 0xc3, 0x59, 0xf7, 0xe4, 0xc0, 0x69, 0xa7, 0x07, 0x68, 0x6f, 0x72,
+	0x83, 0xd2, 0x3c, 0x67, 0x7d, 0x81, 0xb1, 0x76, 0x1d, 0xc5, 0x9f, 0x3b, 0xa4, 0x7b, 0x71, 0xc4,
+	0xef, 0xc2, 0x25, 0x4f, 0x7e, 0x4e, 0x67, 0x0b, 0xa6, 0x54, 0xba, 0x3b, 0xf3, 0xb9, 0x90, 0x9c,
+	0x68, 0xff, 0xec, 0x66, 0x67, 0xd9, 0xea, 0x67, 0x69, 0xbb, 0x92, 0x6e, 0xca, 0x0d, 0xd5, 0x7e,
+	0x20, 0x6d, 0x1b, 0xf6, 0x61, 0x37, 0x7b, 0x9e, 0xa5, 0x61, 0x4e, 0x62, 0x99, 0x7b, 0x8b, 0x79,
+	0x38, 0xcf, 0xc2, 0xbb, 0xcc, 0x9e, 0x81, 0x2f, 0x38, 0xc4, 0x15, 0x5d, 0xa3, 0x91, 0x27, 0xca,
+	0x53, 0xce, 0xe3, 0xb6, 0x26, 0xde, 0x86, 0x69, 0xd7, 0x92, 0x63, 0x90, 0x60, 0xc2, 0x19, 0xa3,
+	0x76, 0x91, 0x15, 0x2d, 0x53, 0x3b, 0x71, 0x0d, 0xd2, 0xf4, 0x89, 0xec, 0xab, 0x2d, 0xad, 0x4c,
+	0xaa, 0x44, 0xdf, 0x23, 0xad, 0xa1, 0x79, 0x5f, 0x03, 0x21, 0xc8, 0x8b, 0x63, 0x78, 0x0e, 0x2e,
+	0xb4, 0xe8, 0x88, 0xd2, 0xe2, 0x43, 0x7c, 0x4a, 0xa6, 0x5b, 0x1e, 0x07, 0xf1, 0x12, 0x7c, 0xf1,
+	0x4d, 0xf2, 0x88, 0xb6, 0xc8, 0xf6, 0xa6, 0x7b, 0xde, 0x29, 0x00, 0xee, 0x7f, 0xc9, 0x63, 0x46,
+	0x94, 0x60, 0xe9, 0xad, 0x8e, 0x61, 0x3f, 0x20, 0xb6, 0x5e, 0xdd, 0xa1, 0x24, 0xad, 0x52, 0x87,
+	0xfd, 0xd3, 0x8b, 0x19, 0xea, 0xbd, 0x31, 0x96, 0x42, 0xe2, 0x1e, 0x88, 0x51, 0x11, 0x38, 0x80,
+	0x1d, 0xb8, 0x60, 0xb9, 0x56, 0x4a, 0x7f, 0xd7, 0x2e, 0xfa, 0x6b, 0xec, 0x09, 0xc6, 0x1b, 0x77,
+	0xda, 0xea, 0x7f, 0x69, 0xd1, 0xbc, 0x5f, 0x82, 0x9c, 0x2f, 0x6f, 0x7c, 0xe0, 0xa2, 0x09, 0x4b,
+	0x11, 0xce, 0x1c, 0xf3, 0x1b, 0x30, 0xed, 0xc5, 0xcc, 0xdb, 0x22, 0x16, 0xe4, 0xf3, 0x1e, 0xc8,
+	0xe2, 0xaf, 0x90, 0xef, 0x93, 0xde, 0x31, 0x8d, 0x1a, 0x69, 0xb9, 0x9f, 0x4e, 0xd2, 0xe5, 0xe8,
+	0xc9, 0x7c, 0x96, 0x97, 0x23, 0x11, 0x8e, 0xb8, 0xea, 0xfc, 0xc2, 0x7f, 0x4a, 0x39, 0x4d, 0xdc,
+	0xfd, 0x27, 0x94, 0xff, 0x1b, 0xeb, 0x3f, 0x20, 0x28, 0x46, 0x54, 0x75, 0xd4, 0x73, 0xca, 0x93,
+	0xa8, 0x45, 0x03, 0xae, 0x27, 0x42, 0x3c, 0x62, 0x85, 0xfe, 0x84, 0xe0, 0xb9, 0x88, 0x7c, 0xc7,
+	0xda, 0xad, 0x9f, 0x40, 0x59, 0x42, 0x76, 0xea, 0x0a, 0xe4, 0x87, 0x83, 0x1f, 0xb1, 0x42, 0x33,
+	0x80, 0xef, 0x39, 0xfa, 0xc2, 0x5d, 0x7a, 0x11, 0x77, 0x17, 0xfa, 0xaf, 0xc0, 0x25, 0xcf, 0x5b,
+	0x9e, 0x64, 0x0d, 0xa6, 0xd8, 0x85, 0x9d, 0x2f, 0x56, 0x73, 0x03, 0x59, 0xe8, 0x28, 0xcf, 0xc0,
+	0x6d, 0xc5, 0x97, 0xe0, 0x82, 0x93, 0xf7, 0x1d, 0x9d, 0xec, 0x27, 0xbd, 0xfe, 0x7c, 0x3c, 0x0e,
+	0x67, 0x5d, 0x5f, 0xbc, 0x16, 0x77, 0xff, 0xe4, 0xf9, 0xa9, 0x35, 0xde, 0x80, 0xa7, 0x75, 0x4b,
+	0x69, 0xbb, 0x6d, 0x45, 0xa7, 0xec, 0x6c, 0xe9, 0x99, 0xc3, 0x6e, 0xf6, 0x12, 0xcb, 0xd8, 0x3f,
+	0x2a, 0x96, 0xcf, 0xe9, 0x56, 0xaf, 0x05, 0xb1, 0x32, 0xb0, 0x48, 0x8f, 0xc7, 0x59, 0xa4, 0xd3,
+	0x87, 0xdd, 0xec, 0x2c, 0x0b, 0xee, 0x75, 0x17, 0x7d, 0x2b, 0x37, 0xbe, 0x07, 0x33, 0x0f, 0xdb,
+	0x6a, 0x5d, 0xbf, 0xdf, 0xd1, 0x8d, 0x9a, 0x52, 0x53, 0xdb, 0x35, 0xa2, 0xe8, 0x9a, 0x95, 0x9a,
+	0xc8, 0x8d, 0xe7, 0x27, 0x4a, 0xd9, 0xc3, 0x6e, 0x76, 0x9e, 0xc5, 0x09, 0xb2, 0x12, 0xcb, 0xf8,
+	0xe8, 0xf5, 0xeb, 0xce, 0xdb, 0x6d, 0xcd, 0xc2, 0xfb, 0x30, 0x67, 0x90, 0x47, 0xb6, 0xa2, 0xe9,
+	0x96, 0xdd, 0xd2, 0x2b, 0x6d, 0xa7, 0x55, 0x14, 0xe7, 0x4c, 0x98, 0x9a, 0x1c, 0x7a, 0xb2, 0xbc,
+	0x72, 0xd8, 0xcd, 0x2e, 0xb2, 0x84, 0xc1, 0x31, 0xd8, 0x11, 0x73, 0xc6, 0x19, 0xdc, 0xec, 0x1b,
+	0x73, 0x22, 0x88, 0x5f, 0x86, 0x8b, 0x47, 0xd3, 0xdc, 0x6b, 0x18, 0x4f, 0x57, 0xa6, 0xfc, 0x75,
+	0x73, 0x1d, 0x3c, 0x3d, 0x59, 0xfc, 0xef, 0x22, 0x4c, 0xd2, 0xf6, 0xc3, 0x3f, 0x41, 0x70, 0xde,
+	0x23, 0xfd, 0xe0, 0x67, 0xfd, 0x21, 0x82, 0x14, 0x23, 0xe1, 0xca, 0x10, 0x2b, 0x06, 0x4f, 0x94,
+	0x3e, 0xf8, 0xfb, 0xbf, 0x7f, 0x36, 0x96, 0xc7, 0xcb, 0xb2, 0x4f, 0x96, 0x72, 0x35, 0xb3, 0x06,
+	0x75, 0x53, 0x2a, 0x3c, 0xf9, 0xaf, 0x11, 0xe0, 0x41, 0xc1, 0x07, 0x3f, 0x1f, 0x9c, 0x2d, 0x40,
+	0x31, 0x12, 0xae, 0xc6, 0x31, 0xe5, 0xe8, 0xd6, 0x28, 0x3a, 0x09, 0xaf, 0x0c, 0x41, 0xc7, 0x6e,
+	0x37, 0x0a, 0x3b, 0xa1, 0xe2, 0x4f, 0x10, 0xcc, 0x05, 0x2b, 0x39, 0xb8, 0xe0, 0x4f, 0x1e, 0xa9,
+	0x1d, 0x09, 0x52, 0x5c, 0x73, 0x8e, 0xf7, 0x36, 0xc5, 0xbb, 0x81, 0x6f, 0x84, 0xe1, 0x55, 0x99,
+	0xbf, 0xd2, 0xee, 0x05, 0x50, 0xa8, 0xc8, 0x20, 0xbf, 0x4b, 0xbf, 0xf6, 0xf7, 0xf0, 0x1f, 0x11,
+	0xcc, 0x06, 0xea, 0x36, 0x78, 0x25, 0x12, 0x8b, 0x4f, 0x27, 0x12, 0x0a, 0x31, 0xad, 0x39, 0xf0,
+	0x57, 0x28, 0xf0, 0x97, 0xf0, 0x8b, 0xf1, 0x80, 0x3b, 0x1f, 0xa1, 0x17, 0xf7, 0xc7, 0x08, 0xf0,
+	0xa0, 0x4c, 0x33, 0xd8, 0x17, 0xa1, 0x7a, 0x90, 0x70, 0x35, 0x8e, 0x29, 0x87, 0x7b, 0x93, 0xc2,
+	0x5d, 0xc7, 0x6b, 0xc3, 0xe0, 0xf2, 0xc6, 0x08, 0xad, 0xb1, 0xf7, 0xfe, 0x17, 0x5a, 0xe3, 0x40,
+	0xdd, 0x47, 0x28, 0xc4, 0xb4, 0x4e, 0x5a, 0x63, 0x0e, 0xba, 0xa9, 0x5a, 0xb6, 0xb3, 0xe2, 0xf4,
+	0x70, 0xff, 0x07, 0xc1, 0x95, 0x58, 0xf2, 0x06, 0xbe, 0x19, 0x0b, 0x59, 0xc8, 0xe9, 0x48, 0x78,
+	0xf9, 0x98, 0xde, 0x9c, 0x67, 0x99, 0xf2, 0xdc, 0xc1, 0x6f, 0x24, 0xe4, 0xa9, 0x18, 0x66, 0x7f,
+	0x7f, 0x99, 0x46, 0xbd, 0xd3, 0xa3, 0xfe, 0x67, 0xd4, 0x93, 0x12, 0x07, 0xb5, 0x0c, 0x7c, 0x2d,
+	0xb2, 0xd9, 0x03, 0xa4, 0x19, 0x61, 0x35, 0x81, 0x07, 0xa7, 0xb5, 0x49, 0x69, 0xdd, 0xc2, 0x37,
+	0xe3, 0x7d, 0x22, 0x44, 0x53, 0x2a, 0x34, 0x88, 0xe2, 0x99, 0xc3, 0xbf, 0x22, 0x10, 0x02, 0xcb,
+	0x49, 0xcf, 0x32, 0x78, 0x35, 0x56, 0xe9, 0xfb, 0x0f, 0x6d, 0x42, 0x31, 0x89, 0x0b, 0xe7, 0xf2,
+	0x1a, 0xe5, 0xf2, 0x0a, 0x7e, 0x39, 0xe9, 0x14, 0xd1, 0x53, 0x59, 0x8f, 0xcc, 0x8f, 0x10, 0x9c,
+	0xeb, 0x93, 0x1a, 0xb0, 0x18, 0xb4, 0xb9, 0x79, 0x75, 0x10, 0xe1, 0x72, 0xa4, 0x0d, 0xc7, 0xb7,
+	0x42, 0xf1, 0x2d, 0xe3, 0x67, 0xc3, 0xf0, 0x71, 0x5c, 0x4c, 0x44, 0xf9, 0x10, 0x01, 0xb0, 0x28,
+	0xa5, 0xce, 0xf6, 0x26, 0x5e, 0x0c, 0xce, 0xe0, 0x02, 0xc8, 0x84, 0x0d, 0xf3, 0xdc, 0xeb, 0x34,
+	0xf7, 0x35, 0x2c, 0x0d, 0xc9, 0x5d, 0xe9, 0x28, 0xba, 0x26, 0xbf, 0xcb, 0x2f, 0xc0, 0xef, 0xe1,
+	0xdf, 0x21, 0xc0, 0x83, 0xb2, 0xc3, 0xe0, 0x0a, 0x18, 0x2a, 0x68, 0x08, 0x57, 0xe3, 0x98, 0x72,
+	0x94, 0xb7, 0x28, 0xca, 0x1b, 0x78, 0x3d, 0x0a, 0xa5, 0xe2, 0x13, 0x3a, 0xfa, 0xd0, 0xfe, 0x10,
+	0x01, 0x1c, 0x09, 0x19, 0x78, 0xc9, 0x9f, 0x7a, 0x40, 0xf9, 0x10, 0xc4, 0x28, 0x93, 0xb8, 0xf3,
+	0x46, 0xcf, 0x54, 0x1c, 0x04, 0xfe, 0x1b, 0x02, 0x21, 0x5c, 0xdb, 0x18, 0xfc, 0x16, 0x86, 0x2a,
+	0x29, 0x42, 0x31, 0x89, 0x0b, 0xc7, 0xbc, 0x4d, 0x31, 0xdf, 0xc6, 0xb7, 0xc2, 0x30, 0x7b, 0x0f,
+	0xb0, 0xed, 0xa6, 0xe5, 0x4c, 0x3d, 0xe7, 0x70, 0x54, 0xd1, 0x1f, 0x8f, 0x21, 0xfc, 0x17, 0x04,
+	0xe9, 0x50, 0xdd, 0x63, 0x70, 0x95, 0x1a, 0xa6, 0xaf, 0x08, 0xab, 0x09, 0x3c, 0xe2, 0x7e, 0xd9,
+	0x7e, 0x36, 0x81, 0x64, 0x9c, 0xa9, 0x99, 0x8f, 0xb8, 0x78, 0xe1, 0xe8, 0x45, 0x27, 0x50, 0x7c,
+	0x11, 0xae, 0x27, 0xf2, 0xe1, 0x7c, 0xb6, 0x86, 0xcd, 0x8e, 0x6f, 0xa5, 0xaa, 0xd3, 0x30, 0x8a,
+	0x7b, 0xad, 0x0c, 0xdf, 0xf3, 0x7b, 0x54, 0xa2, 0xf7, 0x7c, 0x3f, 0x89, 0x42, 0x4c, 0xeb, 0x63,
+	0xee, 0xf9, 0x03, 0xb8, 0x7f, 0x3a, 0x06, 0x2f, 0x24, 0x90, 0x0b, 0x70, 0x29, 0x41, 0x91, 0xc3,
+	0xf6, 0xff, 0x3b, 0x23, 0xc5, 0xe0, 0xcc, 0xbf, 0x49, 0x99, 0xbf, 0x85, 0xef, 0x1d, 0x6f, 0xe2,
+	0xa2, 0x0e, 0x03, 0x07, 0x47, 0x3f, 0xbe, 0x84, 0xaa, 0x02, 0xf8, 0xc5, 0x04, 0x24, 0x3c, 0x1b,
+	0xd4, 0x8d, 0xe4, 0x8e, 0x9c, 0xf2, 0x0e, 0xa5, 0xbc, 0x85, 0x37, 0x8f, 0x49, 0xd9, 0xbb, 0xb9,
+	0x76, 0x60, 0x8a, 0x69, 0x09, 0x83, 0xdb, 0xea, 0xa0, 0x5c, 0x21, 0x5c, 0x8e, 0xb4, 0xe1, 0x00,
+	0x97, 0x29, 0xc0, 0x1c, 0xce, 0x84, 0x01, 0x64, 0x72, 0x05, 0xfe, 0x01, 0xea, 0xd3, 0x1c, 0xb2,
+	0x61, 0x37, 0x56, 0x37, 0x75, 0x2e, 0xdc, 0x80, 0xe7, 0x5d, 0xa5, 0x79, 0x5f, 0xc0, 0xcf, 0x47,
+	0x6e, 0x56, 0x7b, 0x3a, 0xd9, 0x77, 0xd9, 0x97, 0x76, 0x3e, 0x7d, 0x9c, 0x41, 0x9f, 0x3d, 0xce,
+	0xa0, 0x7f, 0x3d, 0xce, 0xa0, 0x8f, 0x0e, 0x32, 0x67, 0x3e, 0x3b, 0xc8, 0x9c, 0xf9, 0xc7, 0x41,
+	0xe6, 0xcc, 0xb7, 0x8a, 0x7d, 0xbf, 0xdf, 0xf2, 0x70, 0x85, 0xba, 0x5a, 0xb1, 0x7a, 0xb1, 0xf7,
+	0x8a, 0xeb, 0xf2, 0x23, 0x37, 0x03, 0xfd, 0x3d, 0xb7, 0x32, 0x45, 0x6f, 0xfa, 0xd7, 0xff, 0x37,
+	0x00, 0x3d, 0xaf, 0x69, 0xeb, 0x58, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2077,6 +2267,9 @@ type QueryClient interface {
 	AccountLockedLongerDurationDenom(ctx context.Context, in *AccountLockedLongerDurationDenomRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationDenomResponse, error)
 	// Params returns lockup params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LockView returns every lock of an account joined with its unlocking
+	// status, its synthetic lockup and the gauges it qualifies for.
+	LockView(ctx context.Context, in *LockViewRequest, opts ...grpc.CallOption) (*LockViewResponse, error)
 }
 
 type queryClient struct {
@@ -2268,6 +2461,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) LockView(ctx context.Context, in *LockViewRequest, opts ...grpc.CallOption) (*LockViewResponse, error) {
+	out := new(LockViewResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/LockView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Return full balance of the module
@@ -2313,6 +2515,9 @@ type QueryServer interface {
 	AccountLockedLongerDurationDenom(context.Context, *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error)
 	// Params returns lockup params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LockView returns every lock of an account joined with its unlocking
+	// status, its synthetic lockup and the gauges it qualifies for.
+	LockView(context.Context, *LockViewRequest) (*LockViewResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2379,6 +2584,9 @@ func (*UnimplementedQueryServer) AccountLockedLongerDurationDenom(ctx context.Co
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) LockView(ctx context.Context, req *LockViewRequest) (*LockViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockView not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2744,6 +2952,25 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LockView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/LockView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockView(ctx, req.(*LockViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2828,6 +3055,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "LockView",
+			Handler:    _Query_LockView_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/query.proto",
@@ -4193,6 +4424,156 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockViewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockViewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockViewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockView) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextDistributionTime != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextDistributionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextDistributionTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QualifyingGaugeIds) > 0 {
+		dAtA15 := make([]byte, len(m.QualifyingGaugeIds)*10)
+		var j14 int
+		for _, num := range m.QualifyingGaugeIds {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SyntheticLock != nil {
+		{
+			size, err := m.SyntheticLock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsUnlocking {
+		i--
+		if m.IsUnlocking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LockViewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockViewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockViewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4751,25 +5132,82 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *LockViewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *ModuleBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+
+func (m *LockView) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lock.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsUnlocking {
+		n += 2
+	}
+	if m.SyntheticLock != nil {
+		l = m.SyntheticLock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.QualifyingGaugeIds) > 0 {
+		l = 0
+		for _, e := range m.QualifyingGaugeIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.NextDistributionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextDistributionTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LockViewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -8241,6 +8679,423 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LockViewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockViewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockViewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockView) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockView: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockView: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsUnlocking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsUnlocking = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyntheticLock == nil {
+				m.SyntheticLock = &SyntheticLock{}
+			}
+			if err := m.SyntheticLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.QualifyingGaugeIds = append(m.QualifyingGaugeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.QualifyingGaugeIds) == 0 {
+					m.QualifyingGaugeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.QualifyingGaugeIds = append(m.QualifyingGaugeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field QualifyingGaugeIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextDistributionTime == nil {
+				m.NextDistributionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockViewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockViewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockViewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, LockView{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
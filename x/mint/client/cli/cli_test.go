@@ -52,16 +52,20 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","genesis_epoch_provisions":"5000000.000000000000000000","epoch_identifier":"week","reduction_period_in_epochs":"156","reduction_factor":"0.500000000000000000","distribution_proportions":{"staking":"0.400000000000000000","pool_incentives":"0.300000000000000000","developer_rewards":"0.200000000000000000","community_pool":"0.100000000000000000"},"weighted_developer_rewards_receivers":[],"minting_rewards_distribution_start_epoch":"0"}`,
+			`{"mint_denom":"stake","genesis_epoch_provisions":"5000000.000000000000000000","epoch_identifier":"week","reduction_period_in_epochs":"156","reduction_factor":"0.500000000000000000","weighted_developer_rewards_receivers":[],"minting_rewards_distribution_start_epoch":"0","distribution_targets":[{"name":"staking","weight":"0.400000000000000000"},{"name":"pool_incentives","weight":"0.300000000000000000"},{"name":"developer_rewards","weight":"0.200000000000000000"},{"name":"community_pool","weight":"0.100000000000000000"}]}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`distribution_proportions:
-  community_pool: "0.100000000000000000"
-  developer_rewards: "0.200000000000000000"
-  pool_incentives: "0.300000000000000000"
-  staking: "0.400000000000000000"
+			`distribution_targets:
+- name: staking
+  weight: "0.400000000000000000"
+- name: pool_incentives
+  weight: "0.300000000000000000"
+- name: developer_rewards
+  weight: "0.200000000000000000"
+- name: community_pool
+  weight: "0.100000000000000000"
 epoch_identifier: week
 genesis_epoch_provisions: "5000000.000000000000000000"
 mint_denom: stake
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v26/x/mint/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := osmocli.TxIndexCmd(types.ModuleName)
+	cmd.AddCommand(
+		NewRotateDeveloperRewardsReceiverCmd(),
+	)
+
+	return cmd
+}
+
+func NewRotateDeveloperRewardsReceiverCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgRotateDeveloperRewardsReceiver](&osmocli.TxCliDesc{
+		Use:     "rotate-developer-rewards-receiver",
+		Short:   "Replaces the sender with a new address in the developer rewards receivers, keeping its weight. Must be a developer rewards receiver to do so.",
+		Example: "rotate-developer-rewards-receiver osmo1... --from receiver",
+	})
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/mint/types"
+)
+
+// RotateDeveloperRewardsReceiver replaces the address of a developer rewards receiver with newAddress, keeping
+// its weight. Returns error if sender is not a developer rewards receiver or newAddress already is one.
+func (k Keeper) RotateDeveloperRewardsReceiver(ctx sdk.Context, sender, newAddress string) error {
+	receivers := k.GetParams(ctx).WeightedDeveloperRewardsReceivers
+
+	found := false
+	rotatedReceivers := make([]types.WeightedAddress, 0, len(receivers))
+	for _, receiver := range receivers {
+		if receiver.Address == newAddress {
+			return errorsmod.Wrapf(types.ErrNotDeveloperReceiver, "%s is already a developer rewards receiver", newAddress)
+		}
+		if receiver.Address == sender {
+			receiver.Address = newAddress
+			found = true
+		}
+		rotatedReceivers = append(rotatedReceivers, receiver)
+	}
+	if !found {
+		return errorsmod.Wrapf(types.ErrNotDeveloperReceiver, "%s", sender)
+	}
+
+	k.SetParam(ctx, types.KeyDeveloperRewardsReceiver, rotatedReceivers)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtRotateDeveloperRewardsReceiver,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOldAddress, sender),
+		sdk.NewAttribute(types.AttributeKeyNewAddress, newAddress),
+	))
+	return nil
+}
+
+// ClawbackDeveloperVesting claws back amount of the developer rewards receivers' share of the unvested developer
+// allocation, which is the balance of the developer vesting module account. A zero amount claws back their whole share.
+//
+// The remaining vesting schedule is migrated rather than the balance moved: the developer vesting module account keeps
+// vesting at the same rate, but the weights of the receivers are scaled down so that amount of its balance vests to
+// the community pool instead. This leaves the supply schedule of the mint denom unchanged.
+// Returns the amount clawed back.
+func (k Keeper) ClawbackDeveloperVesting(ctx sdk.Context, amount osmomath.Int) (osmomath.Int, error) {
+	params := k.GetParams(ctx)
+	developerVestingAddress := k.accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName)
+	unvestedAmount := k.bankKeeper.GetBalance(ctx, developerVestingAddress, params.MintDenom).Amount
+
+	developerWeight := osmomath.ZeroDec()
+	for _, receiver := range params.WeightedDeveloperRewardsReceivers {
+		if receiver.Address != emptyWeightedAddressReceiver {
+			developerWeight = developerWeight.Add(receiver.Weight)
+		}
+	}
+	developerShare := developerWeight.MulInt(unvestedAmount).TruncateInt()
+	if !developerShare.IsPositive() {
+		return osmomath.Int{}, errorsmod.Wrap(types.ErrInvalidClawback, "no unvested developer allocation to claw back")
+	}
+	if amount.IsZero() {
+		amount = developerShare
+	}
+	if amount.GT(developerShare) {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrInvalidClawback, "amount %s is greater than the unvested developer allocation %s", amount, developerShare)
+	}
+
+	// Receivers whose weight is scaled down to zero are removed, and the weight taken from the receivers
+	// is given to a single community pool receiver, which replaces the existing ones.
+	scale := osmomath.OneDec().Sub(amount.ToLegacyDec().Quo(developerShare.ToLegacyDec()))
+	migratedReceivers := make([]types.WeightedAddress, 0, len(params.WeightedDeveloperRewardsReceivers)+1)
+	migratedDeveloperWeight := osmomath.ZeroDec()
+	for _, receiver := range params.WeightedDeveloperRewardsReceivers {
+		if receiver.Address == emptyWeightedAddressReceiver {
+			continue
+		}
+		receiver.Weight = receiver.Weight.Mul(scale)
+		if receiver.Weight.IsPositive() {
+			migratedReceivers = append(migratedReceivers, receiver)
+			migratedDeveloperWeight = migratedDeveloperWeight.Add(receiver.Weight)
+		}
+	}
+	if communityPoolWeight := osmomath.OneDec().Sub(migratedDeveloperWeight); communityPoolWeight.IsPositive() {
+		migratedReceivers = append(migratedReceivers, types.WeightedAddress{Address: emptyWeightedAddressReceiver, Weight: communityPoolWeight})
+	}
+
+	k.SetParam(ctx, types.KeyDeveloperRewardsReceiver, migratedReceivers)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtClawbackDeveloperVesting,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(params.MintDenom, amount).String()),
+	))
+	return amount, nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/mint/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/mint/types"
+)
+
+var testDeveloperRewardsReceivers = []types.WeightedAddress{
+	{Address: testAddressOne.String(), Weight: osmomath.NewDecWithPrec(5, 1)},
+	{Address: testAddressTwo.String(), Weight: osmomath.NewDecWithPrec(3, 1)},
+	{Address: "", Weight: osmomath.NewDecWithPrec(2, 1)},
+}
+
+func (s *KeeperTestSuite) TestRotateDeveloperRewardsReceiver() {
+	tests := map[string]struct {
+		sender            string
+		newAddress        string
+		expectedReceivers []types.WeightedAddress
+		expectedErr       error
+	}{
+		"rotate a receiver": {
+			sender:     testAddressOne.String(),
+			newAddress: testAddressThree.String(),
+			expectedReceivers: []types.WeightedAddress{
+				{Address: testAddressThree.String(), Weight: osmomath.NewDecWithPrec(5, 1)},
+				testDeveloperRewardsReceivers[1],
+				testDeveloperRewardsReceivers[2],
+			},
+		},
+		"sender is not a receiver": {
+			sender:      testAddressThree.String(),
+			newAddress:  testAddressFour.String(),
+			expectedErr: types.ErrNotDeveloperReceiver,
+		},
+		"new address is already a receiver": {
+			sender:      testAddressOne.String(),
+			newAddress:  testAddressTwo.String(),
+			expectedErr: types.ErrNotDeveloperReceiver,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.MintKeeper.SetParam(s.Ctx, types.KeyDeveloperRewardsReceiver, testDeveloperRewardsReceivers)
+
+			err := s.App.MintKeeper.RotateDeveloperRewardsReceiver(s.Ctx, tc.sender, tc.newAddress)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Equal(testDeveloperRewardsReceivers, s.App.MintKeeper.GetParams(s.Ctx).WeightedDeveloperRewardsReceivers)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedReceivers, s.App.MintKeeper.GetParams(s.Ctx).WeightedDeveloperRewardsReceivers)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestClawbackDeveloperVesting() {
+	// amount and expectedClawedBack are given the receivers' share of the developer vesting balance.
+	tests := map[string]struct {
+		receivers          []types.WeightedAddress
+		amount             func(developerShare osmomath.Int) osmomath.Int
+		expectedClawedBack func(developerShare osmomath.Int) osmomath.Int
+		expectedReceivers  []types.WeightedAddress
+		expectedErr        error
+	}{
+		"claw back half of the receivers' share": {
+			receivers:          testDeveloperRewardsReceivers,
+			amount:             func(developerShare osmomath.Int) osmomath.Int { return developerShare.QuoRaw(2) },
+			expectedClawedBack: func(developerShare osmomath.Int) osmomath.Int { return developerShare.QuoRaw(2) },
+			expectedReceivers: []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: osmomath.NewDecWithPrec(25, 2)},
+				{Address: testAddressTwo.String(), Weight: osmomath.NewDecWithPrec(15, 2)},
+				{Address: "", Weight: osmomath.NewDecWithPrec(6, 1)},
+			},
+		},
+		"zero amount claws back the receivers' whole share": {
+			receivers:          testDeveloperRewardsReceivers,
+			amount:             func(osmomath.Int) osmomath.Int { return osmomath.ZeroInt() },
+			expectedClawedBack: func(developerShare osmomath.Int) osmomath.Int { return developerShare },
+			expectedReceivers:  []types.WeightedAddress{{Address: "", Weight: osmomath.OneDec()}},
+		},
+		"amount greater than the receivers' share": {
+			receivers:   testDeveloperRewardsReceivers,
+			amount:      func(developerShare osmomath.Int) osmomath.Int { return developerShare.AddRaw(1) },
+			expectedErr: types.ErrInvalidClawback,
+		},
+		"no receivers": {
+			receivers:   []types.WeightedAddress{},
+			amount:      func(osmomath.Int) osmomath.Int { return osmomath.ZeroInt() },
+			expectedErr: types.ErrInvalidClawback,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.MintKeeper.SetParam(s.Ctx, types.KeyDeveloperRewardsReceiver, tc.receivers)
+			developerVestingAddress := s.App.AccountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName)
+			mintDenom := s.App.MintKeeper.GetParams(s.Ctx).MintDenom
+			balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, developerVestingAddress, mintDenom)
+			// The receivers' share is 80% of the balance, which must be divisible by ten for the expected weights to be exact.
+			s.Require().True(balanceBefore.Amount.ModRaw(10).IsZero())
+			developerShare := balanceBefore.Amount.MulRaw(8).QuoRaw(10)
+
+			clawedBack, err := s.App.MintKeeper.ClawbackDeveloperVesting(s.Ctx, tc.amount(developerShare))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedClawedBack(developerShare), clawedBack)
+			s.Require().Equal(tc.expectedReceivers, s.App.MintKeeper.GetParams(s.Ctx).WeightedDeveloperRewardsReceivers)
+
+			// The vesting schedule is migrated, the unvested balance stays in the developer vesting module account.
+			s.Require().Equal(balanceBefore, s.App.BankKeeper.GetBalance(s.Ctx, developerVestingAddress, mintDenom))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgClawbackDeveloperVestingAuthority() {
+	s.SetupTest()
+	s.App.MintKeeper.SetParam(s.Ctx, types.KeyDeveloperRewardsReceiver, testDeveloperRewardsReceivers)
+	msgServer := keeper.NewMsgServerImpl(*s.App.MintKeeper)
+
+	_, err := msgServer.ClawbackDeveloperVesting(s.Ctx, &types.MsgClawbackDeveloperVesting{
+		Authority: testAddressOne.String(),
+		Amount:    osmomath.ZeroInt(),
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	res, err := msgServer.ClawbackDeveloperVesting(s.Ctx, &types.MsgClawbackDeveloperVesting{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Amount:    osmomath.ZeroInt(),
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.ClawedBackAmount.IsPositive())
+}
@@ -24,7 +24,7 @@ var customGenesis = types.NewGenesisState(
 			PoolIncentives:   osmomath.NewDecWithPrec(25, 2),
 			DeveloperRewards: osmomath.NewDecWithPrec(25, 2),
 			CommunityPool:    osmomath.NewDecWithPrec(25, 2),
-		},
+		}.ToDistributionTargets(),
 		[]types.WeightedAddress{
 			{
 				Address: "osmo14kjcwdwcqsujkdt8n5qwpd8x8ty2rys5rjrdjj",
@@ -363,7 +363,7 @@ func (s *KeeperTestSuite) TestAfterEpochEnd() {
 				EpochIdentifier:                      tc.epochIdentifier,
 				ReductionPeriodInEpochs:              tc.reductionPeriodInEpochs,
 				ReductionFactor:                      tc.reductionFactor,
-				DistributionProportions:              tc.distributionProportions,
+				DistributionTargets:                  tc.distributionProportions.ToDistributionTargets(),
 				WeightedDeveloperRewardsReceivers:    tc.weightedAddresses,
 				MintingRewardsDistributionStartEpoch: tc.mintStartEpoch,
 			}
@@ -386,7 +386,7 @@ func (s *KeeperTestSuite) TestAfterEpochEnd() {
 				EpochProvisions: defaultGenesisEpochProvisionsDec,
 			})
 
-			expectedDevRewards := tc.expectedDistribution.Mul(mintParams.DistributionProportions.DeveloperRewards)
+			expectedDevRewards := tc.expectedDistribution.Mul(mintParams.GetDistributionTargetWeight(types.DistributionTargetDeveloperRewards))
 
 			developerAccountBalanceBeforeHook := app.BankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName), sdk.DefaultBondDenom)
 
@@ -456,12 +456,12 @@ func (s *KeeperTestSuite) TestAfterEpochEnd_FirstYearThirdening_RealParameters()
 		EpochIdentifier:         defaultEpochIdentifier,
 		ReductionPeriodInEpochs: defaultReductionPeriodInEpochs,
 		ReductionFactor:         defaultReductionFactor,
-		DistributionProportions: types.DistributionProportions{
+		DistributionTargets: types.DistributionProportions{
 			Staking:          osmomath.NewDecWithPrec(25, 2),
 			PoolIncentives:   osmomath.NewDecWithPrec(45, 2),
 			DeveloperRewards: osmomath.NewDecWithPrec(25, 2),
 			CommunityPool:    osmomath.NewDecWithPrec(0o5, 2),
-		},
+		}.ToDistributionTargets(),
 		WeightedDeveloperRewardsReceivers: []types.WeightedAddress{
 			{
 				Address: "osmo14kjcwdwcqsujkdt8n5qwpd8x8ty2rys5rjrdjj",
@@ -570,7 +570,7 @@ func (s *KeeperTestSuite) TestAfterEpochEnd_FirstYearThirdening_RealParameters()
 		// We want supply with offset to exclude unvested developer rewards
 		// Truncation also happens when subtracting dev rewards.
 		// Potential source of minor rounding errors #2.
-		devRewards := truncatedEpochProvisions.Mul(mintParams.DistributionProportions.DeveloperRewards).TruncateInt().ToLegacyDec()
+		devRewards := truncatedEpochProvisions.Mul(mintParams.GetDistributionTargetWeight(types.DistributionTargetDeveloperRewards)).TruncateInt().ToLegacyDec()
 
 		// We aim to exclude developer account balance from the supply with offset calculation.
 		developerAccountBalance := app.BankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName), sdk.DefaultBondDenom)
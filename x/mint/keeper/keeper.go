@@ -28,6 +28,9 @@ type Keeper struct {
 	epochKeeper         types.EpochKeeper
 	hooks               types.MintHooks
 	feeCollectorName    string
+
+	// authority is the address allowed to claw back developer vesting, i.e. governance
+	authority string
 }
 
 type invalidRatioError struct {
@@ -53,7 +56,7 @@ const emptyWeightedAddressReceiver = ""
 func NewKeeper(
 	key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, ck types.CommunityPoolKeeper, epochKeeper types.EpochKeeper,
-	feeCollectorName string,
+	feeCollectorName, authority string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -73,6 +76,7 @@ func NewKeeper(
 		communityPoolKeeper: ck,
 		epochKeeper:         epochKeeper,
 		feeCollectorName:    feeCollectorName,
+		authority:           authority,
 	}
 }
 
@@ -121,39 +125,86 @@ func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
 }
 
 // DistributeMintedCoin implements distribution of a minted coin from mint to external modules.
+// The minted coin is split between the distribution targets by weight, and the community pool also receives
+// what is left over by rounding. Emits an event with the amount distributed to each target.
 func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
 	params := k.GetParams(ctx)
-	proportions := params.DistributionProportions
 
-	// allocate staking incentives into fee collector account to be moved to on next begin blocker by staking module account.
-	stakingIncentivesAmount, err := k.distributeToModule(ctx, k.feeCollectorName, mintedCoin, proportions.Staking)
-	if err != nil {
-		return err
-	}
+	distributedAmount := osmomath.ZeroInt()
+	events := make(sdk.Events, 0, len(params.DistributionTargets))
+	for _, target := range params.DistributionTargets {
+		// the community pool is funded last, with the remainder of the minted coin
+		if target.Name == types.DistributionTargetCommunityPool {
+			continue
+		}
 
-	// allocate pool allocation ratio to pool-incentives module account.
-	poolIncentivesAmount, err := k.distributeToModule(ctx, poolincentivestypes.ModuleName, mintedCoin, proportions.PoolIncentives)
-	if err != nil {
-		return err
-	}
+		var amount osmomath.Int
+		var err error
+		switch target.Name {
+		case types.DistributionTargetStaking:
+			// allocate staking incentives into fee collector account to be moved to on next begin blocker by staking module account.
+			amount, err = k.distributeToModule(ctx, k.feeCollectorName, mintedCoin, target.Weight)
+		case types.DistributionTargetPoolIncentives:
+			// allocate pool allocation ratio to pool-incentives module account.
+			amount, err = k.distributeToModule(ctx, poolincentivestypes.ModuleName, mintedCoin, target.Weight)
+		case types.DistributionTargetDeveloperRewards:
+			// allocate dev rewards to respective accounts from developer vesting module account.
+			amount, err = k.distributeDeveloperRewards(ctx, mintedCoin, target.Weight, params.WeightedDeveloperRewardsReceivers)
+		default:
+			// allocate to the module account or account of the target. If they cannot receive it, e.g. because
+			// the module account does not exist, their share is left over to the community pool.
+			err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+				amount, err = k.distributeToTarget(cacheCtx, target.Name, mintedCoin, target.Weight)
+				return err
+			})
+			if err != nil {
+				ctx.Logger().Error("failed to distribute minted coin", "target", target.Name, "err", err)
+				amount, err = osmomath.ZeroInt(), nil
+			}
+		}
+		if err != nil {
+			return err
+		}
 
-	// allocate dev rewards to respective accounts from developer vesting module account.
-	devRewardAmount, err := k.distributeDeveloperRewards(ctx, mintedCoin, proportions.DeveloperRewards, params.WeightedDeveloperRewardsReceivers)
-	if err != nil {
-		return err
+		distributedAmount = distributedAmount.Add(amount)
+		events = append(events, newDistributionEvent(target, sdk.NewCoin(mintedCoin.Denom, amount)))
 	}
 
 	// subtract from original provision to ensure no coins left over after the allocations
-	communityPoolAmount := mintedCoin.Amount.Sub(stakingIncentivesAmount).Sub(poolIncentivesAmount).Sub(devRewardAmount)
-	err = k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, communityPoolAmount)), k.accountKeeper.GetModuleAddress(types.ModuleName))
+	communityPoolAmount := mintedCoin.Amount.Sub(distributedAmount)
+	err := k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, communityPoolAmount)), k.accountKeeper.GetModuleAddress(types.ModuleName))
 	if err != nil {
 		return err
 	}
+	communityPoolTarget := types.DistributionTarget{
+		Name:   types.DistributionTargetCommunityPool,
+		Weight: params.GetDistributionTargetWeight(types.DistributionTargetCommunityPool),
+	}
+	events = append(events, newDistributionEvent(communityPoolTarget, sdk.NewCoin(mintedCoin.Denom, communityPoolAmount)))
+	ctx.EventManager().EmitEvents(events)
 
 	// call an hook after the minting and distribution of new coins
 	k.hooks.AfterDistributeMintedCoin(ctx)
 
-	return err
+	return nil
+}
+
+func newDistributionEvent(target types.DistributionTarget, distributedCoin sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtDistributeMintedCoin,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDistributionTarget, target.Name),
+		sdk.NewAttribute(types.AttributeKeyDistributionWeight, target.Weight.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, distributedCoin.String()),
+	)
+}
+
+// MigrateDistributionProportions sets the distribution targets param from the legacy distribution proportions
+// param, keeping the split of minted coins unchanged.
+func (k Keeper) MigrateDistributionProportions(ctx sdk.Context) {
+	var proportions types.DistributionProportions
+	k.paramSpace.Get(ctx, types.KeyPoolAllocationRatio, &proportions)
+	k.SetParam(ctx, types.KeyDistributionTargets, proportions.ToDistributionTargets())
 }
 
 // getLastReductionEpochNum returns last reduction epoch number.
@@ -197,6 +248,30 @@ func (k Keeper) distributeToModule(ctx sdk.Context, recipientModule string, mint
 	return distributionCoin.Amount, nil
 }
 
+// distributeToTarget distributes mintedCoin multiplied by proportion to the module account or the account
+// named by the distribution target.
+func (k Keeper) distributeToTarget(ctx sdk.Context, name string, mintedCoin sdk.Coin, proportion osmomath.Dec) (osmomath.Int, error) {
+	if moduleName, ok := types.ParseModuleDistributionTarget(name); ok {
+		if k.accountKeeper.GetModuleAddress(moduleName) == nil {
+			return osmomath.Int{}, fmt.Errorf("module account %s does not exist", moduleName)
+		}
+		return k.distributeToModule(ctx, moduleName, mintedCoin, proportion)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(name)
+	if err != nil {
+		return osmomath.Int{}, fmt.Errorf("unknown distribution target %s", name)
+	}
+	distributionCoin, err := getProportions(mintedCoin, proportion)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(distributionCoin)); err != nil {
+		return osmomath.Int{}, err
+	}
+	return distributionCoin.Amount, nil
+}
+
 // distributeDeveloperRewards distributes developer rewards from developer vesting module account
 // to the respective account receivers by weight (developerRewardsReceivers).
 // If no developer reward receivers given, funds the community pool instead.
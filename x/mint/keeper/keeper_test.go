@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -221,10 +222,10 @@ func (s *KeeperTestSuite) TestDistributeMintedCoin() {
 			params.WeightedDeveloperRewardsReceivers = tc.weightedAddresses
 			mintKeeper.SetParams(ctx, params)
 
-			expectedCommunityPoolAmount := mintAmount.Mul((params.DistributionProportions.CommunityPool))
-			expectedDevRewardsAmount := mintAmount.Mul(params.DistributionProportions.DeveloperRewards)
-			expectedPoolIncentivesAmount := mintAmount.Mul(params.DistributionProportions.PoolIncentives)
-			expectedStakingAmount := tc.mintCoin.Amount.ToLegacyDec().Mul(params.DistributionProportions.Staking)
+			expectedCommunityPoolAmount := mintAmount.Mul(params.GetDistributionTargetWeight(types.DistributionTargetCommunityPool))
+			expectedDevRewardsAmount := mintAmount.Mul(params.GetDistributionTargetWeight(types.DistributionTargetDeveloperRewards))
+			expectedPoolIncentivesAmount := mintAmount.Mul(params.GetDistributionTargetWeight(types.DistributionTargetPoolIncentives))
+			expectedStakingAmount := tc.mintCoin.Amount.ToLegacyDec().Mul(params.GetDistributionTargetWeight(types.DistributionTargetStaking))
 
 			// distributions go to community pool because nil dev reward addresses.
 			if tc.weightedAddresses == nil {
@@ -243,6 +244,9 @@ func (s *KeeperTestSuite) TestDistributeMintedCoin() {
 			s.Require().True(ok, "unexpected type of mint hooks")
 			s.Require().Equal(1, hooks.hookCallCount)
 
+			// validate that the amount distributed to each target was emitted.
+			s.AssertEventEmitted(ctx, types.TypeEvtDistributeMintedCoin, len(params.DistributionTargets))
+
 			// validate distributions to fee collector.
 			feeCollectorBalanceAmount := bankKeeper.GetBalance(ctx, accountKeeper.GetModuleAddress(authtypes.FeeCollectorName), sdk.DefaultBondDenom).Amount.ToLegacyDec()
 			s.Require().Equal(
@@ -268,6 +272,45 @@ func (s *KeeperTestSuite) TestDistributeMintedCoin() {
 	}
 }
 
+// TestDistributeMintedCoin_ModuleAndAccountTargets validates that minted coins are distributed to module account
+// and account targets, and that the share of a target that cannot receive it goes to the community pool.
+func (s *KeeperTestSuite) TestDistributeMintedCoin_ModuleAndAccountTargets() {
+	s.Setup()
+	mintKeeper := s.App.MintKeeper
+	mintKeeper.SetMintHooksUnsafe(&mintHooksMock{})
+
+	params := types.DefaultParams()
+	params.DistributionTargets = []types.DistributionTarget{
+		{Name: types.DistributionTargetStaking, Weight: osmomath.NewDecWithPrec(5, 1)},
+		{Name: types.ModuleDistributionTarget(govtypes.ModuleName), Weight: osmomath.NewDecWithPrec(2, 1)},
+		{Name: testAddressOne.String(), Weight: osmomath.NewDecWithPrec(2, 1)},
+		{Name: types.ModuleDistributionTarget("nonexistent"), Weight: osmomath.NewDecWithPrec(1, 1)},
+	}
+	s.Require().NoError(params.Validate())
+	mintKeeper.SetParams(s.Ctx, params)
+
+	getBalance := func(addr sdk.AccAddress) osmomath.Int {
+		return s.App.BankKeeper.GetBalance(s.Ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+	govAddress := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	communityPoolAddress := s.App.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName)
+	govBalanceBefore := getBalance(govAddress)
+	accountBalanceBefore := getBalance(testAddressOne)
+	communityPoolBalanceBefore := getBalance(communityPoolAddress)
+
+	mintCoin := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(10000))
+	s.MintCoins(sdk.NewCoins(mintCoin))
+
+	err := mintKeeper.DistributeMintedCoin(s.Ctx, mintCoin)
+	s.Require().NoError(err)
+
+	s.Require().Equal(osmomath.NewInt(2000), getBalance(govAddress).Sub(govBalanceBefore))
+	s.Require().Equal(osmomath.NewInt(2000), getBalance(testAddressOne).Sub(accountBalanceBefore))
+	// The share of the module account that does not exist goes to the community pool.
+	s.Require().Equal(osmomath.NewInt(1000), getBalance(communityPoolAddress).Sub(communityPoolBalanceBefore))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtDistributeMintedCoin, len(params.DistributionTargets)+1)
+}
+
 func (s *KeeperTestSuite) TestCreateDeveloperVestingModuleAccount() {
 	testcases := map[string]struct {
 		blockHeight                     int64
@@ -721,3 +764,18 @@ func (s *KeeperTestSuite) TestDistributeDeveloperRewards() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMigrateDistributionProportions() {
+	mintKeeper := s.App.MintKeeper
+	proportions := types.DistributionProportions{
+		Staking:          osmomath.NewDecWithPrec(25, 2),
+		PoolIncentives:   osmomath.NewDecWithPrec(45, 2),
+		DeveloperRewards: osmomath.NewDecWithPrec(25, 2),
+		CommunityPool:    osmomath.NewDecWithPrec(5, 2),
+	}
+	mintKeeper.SetParam(s.Ctx, types.KeyPoolAllocationRatio, proportions)
+
+	mintKeeper.MigrateDistributionProportions(s.Ctx)
+
+	s.Require().Equal(proportions.ToDistributionTargets(), mintKeeper.GetParams(s.Ctx).DistributionTargets)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/mint/types"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) RotateDeveloperRewardsReceiver(goCtx context.Context, msg *types.MsgRotateDeveloperRewardsReceiver) (*types.MsgRotateDeveloperRewardsReceiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.RotateDeveloperRewardsReceiver(ctx, msg.Sender, msg.NewAddress); err != nil {
+		return nil, err
+	}
+	return &types.MsgRotateDeveloperRewardsReceiverResponse{}, nil
+}
+
+func (server msgServer) ClawbackDeveloperVesting(goCtx context.Context, msg *types.MsgClawbackDeveloperVesting) (*types.MsgClawbackDeveloperVestingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != server.keeper.authority {
+		return nil, types.ErrUnauthorized
+	}
+
+	clawedBackAmount, err := server.keeper.ClawbackDeveloperVesting(ctx, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgClawbackDeveloperVestingResponse{ClawedBackAmount: clawedBackAmount}, nil
+}
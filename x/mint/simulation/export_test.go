@@ -5,6 +5,6 @@ const (
 )
 
 var (
-	ExpectedDistributionProportions = distributionProportions
-	ExpectedDevRewardReceivers      = weightedDevRewardReceivers
+	ExpectedDistributionTargets = distributionTargets
+	ExpectedDevRewardReceivers  = weightedDevRewardReceivers
 )
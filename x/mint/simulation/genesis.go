@@ -26,11 +26,11 @@ const (
 
 var (
 	// Taken from: // https://github.com/osmosis-labs/networks/raw/main/osmosis-1/genesis.json
-	distributionProportions = types.DistributionProportions{
-		Staking:          osmomath.NewDecWithPrec(25, 2),
-		PoolIncentives:   osmomath.NewDecWithPrec(45, 2),
-		DeveloperRewards: osmomath.NewDecWithPrec(25, 2),
-		CommunityPool:    osmomath.NewDecWithPrec(0o5, 2),
+	distributionTargets = []types.DistributionTarget{
+		{Name: types.DistributionTargetStaking, Weight: osmomath.NewDecWithPrec(25, 2)},
+		{Name: types.DistributionTargetPoolIncentives, Weight: osmomath.NewDecWithPrec(45, 2)},
+		{Name: types.DistributionTargetDeveloperRewards, Weight: osmomath.NewDecWithPrec(25, 2)},
+		{Name: types.DistributionTargetCommunityPool, Weight: osmomath.NewDecWithPrec(0o5, 2)},
 	}
 	weightedDevRewardReceivers = []types.WeightedAddress{
 		{
@@ -131,7 +131,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		epochIdentifier,
 		reductionFactor,
 		reductionPeriodInEpochs,
-		distributionProportions,
+		distributionTargets,
 		weightedDevRewardReceivers,
 		mintintRewardsDistributionStartEpoch)
 
@@ -74,7 +74,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, expectedReductionPeriodInEpochs, mintGenesis.Params.ReductionPeriodInEpochs)
 
 	// Distribution proportions.
-	require.Equal(t, simulation.ExpectedDistributionProportions, mintGenesis.Params.DistributionProportions)
+	require.Equal(t, simulation.ExpectedDistributionTargets, mintGenesis.Params.DistributionTargets)
 
 	// Weighted developer rewards receivers.
 	require.Equal(t, simulation.ExpectedDevRewardReceivers, mintGenesis.Params.WeightedDeveloperRewardsReceivers)
@@ -8,4 +8,7 @@ var (
 	ErrAmountNilOrZero           = errorsmod.Register(ModuleName, 2, "amount cannot be nil or zero")
 	ErrModuleAccountAlreadyExist = errorsmod.Register(ModuleName, 3, "module account already exists")
 	ErrModuleDoesnotExist        = errorsmod.Register(ModuleName, 4, "module account does not exist")
+	ErrUnauthorized              = errorsmod.Register(ModuleName, 5, "unauthorized account")
+	ErrNotDeveloperReceiver      = errorsmod.Register(ModuleName, 6, "not a developer rewards receiver")
+	ErrInvalidClawback           = errorsmod.Register(ModuleName, 7, "invalid developer vesting clawback")
 )
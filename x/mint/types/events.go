@@ -8,4 +8,23 @@ const (
 	// AttributeEpochNumber is the string representation of the
 	// epoch number event attribute.
 	AttributeEpochNumber = "epoch_number"
+
+	// TypeEvtDistributeMintedCoin is the type of the events emitted for
+	// each distribution target when minted coins are distributed.
+	TypeEvtDistributeMintedCoin = "distribute_minted_coin"
+	// AttributeKeyDistributionTarget is the name of the distribution target.
+	AttributeKeyDistributionTarget = "target"
+	// AttributeKeyDistributionWeight is the weight of the distribution target.
+	AttributeKeyDistributionWeight = "weight"
+
+	// TypeEvtRotateDeveloperRewardsReceiver is the type of the event emitted
+	// when a developer rewards receiver rotates its address.
+	TypeEvtRotateDeveloperRewardsReceiver = "rotate_developer_rewards_receiver"
+	// TypeEvtClawbackDeveloperVesting is the type of the event emitted when
+	// governance claws back developer vesting.
+	TypeEvtClawbackDeveloperVesting = "clawback_developer_vesting"
+	// AttributeKeyOldAddress is the rotated address of a developer rewards receiver.
+	AttributeKeyOldAddress = "old_address"
+	// AttributeKeyNewAddress is the new address of a developer rewards receiver.
+	AttributeKeyNewAddress = "new_address"
 )
@@ -17,6 +17,9 @@ const (
 	// StoreKey is the default store key for mint.
 	StoreKey = ModuleName
 
+	// RouterKey is the message route for the mint module.
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
@@ -114,9 +114,64 @@ func (m *WeightedAddress) GetAddress() string {
 	return ""
 }
 
+// DistributionTarget is a named stakeholder receiving a weighted share of the
+// minted denom.
+type DistributionTarget struct {
+	// name identifies the stakeholder: "staking", "pool_incentives",
+	// "developer_rewards", "community_pool", "module:" followed by the name of a
+	// module account, or the bech32 address of an account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// weight is the proportion of the minted mint_denom that is allocated to the
+	// stakeholder.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight" yaml:"weight"`
+}
+
+func (m *DistributionTarget) Reset()         { *m = DistributionTarget{} }
+func (m *DistributionTarget) String() string { return proto.CompactTextString(m) }
+func (*DistributionTarget) ProtoMessage()    {}
+func (*DistributionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{2}
+}
+func (m *DistributionTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionTarget.Merge(m, src)
+}
+func (m *DistributionTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionTarget proto.InternalMessageInfo
+
+func (m *DistributionTarget) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // DistributionProportions defines the distribution proportions of the minted
 // denom. In other words, defines which stakeholders will receive the minted
 // denoms and how much.
+//
+// Deprecated: the proportions were replaced by the distribution_targets param,
+// this is only kept to migrate them.
 type DistributionProportions struct {
 	// staking defines the proportion of the minted mint_denom that is to be
 	// allocated as staking rewards.
@@ -136,7 +191,7 @@ func (m *DistributionProportions) Reset()         { *m = DistributionProportions
 func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
 func (*DistributionProportions) ProtoMessage()    {}
 func (*DistributionProportions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccb38f8335e0f45b, []int{2}
+	return fileDescriptor_ccb38f8335e0f45b, []int{3}
 }
 func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,24 +234,24 @@ type Params struct {
 	// reduction_factor is the reduction multiplier to execute
 	// at the end of each period set by reduction_period_in_epochs.
 	ReductionFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=reduction_factor,json=reductionFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reduction_factor" yaml:"reduction_factor"`
-	// distribution_proportions defines the distribution proportions of the minted
-	// denom. In other words, defines which stakeholders will receive the minted
-	// denoms and how much.
-	DistributionProportions DistributionProportions `protobuf:"bytes,6,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
 	// weighted_developer_rewards_receivers is the address to receive developer
 	// rewards with weights assignedt to each address. The final amount that each
-	// address receives is: epoch_provisions *
-	// distribution_proportions.developer_rewards * Address's Weight.
+	// address receives is: epoch_provisions * the weight of the
+	// developer_rewards distribution target * Address's Weight.
 	WeightedDeveloperRewardsReceivers []WeightedAddress `protobuf:"bytes,7,rep,name=weighted_developer_rewards_receivers,json=weightedDeveloperRewardsReceivers,proto3" json:"weighted_developer_rewards_receivers" yaml:"developer_rewards_receiver"`
 	// minting_rewards_distribution_start_epoch start epoch to distribute minting
 	// rewards
 	MintingRewardsDistributionStartEpoch int64 `protobuf:"varint,8,opt,name=minting_rewards_distribution_start_epoch,json=mintingRewardsDistributionStartEpoch,proto3" json:"minting_rewards_distribution_start_epoch,omitempty" yaml:"minting_rewards_distribution_start_epoch"`
+	// distribution_targets defines which stakeholders receive the minted denom
+	// and how much. The weights sum to one, the community pool also receives
+	// what is left over by rounding.
+	DistributionTargets []DistributionTarget `protobuf:"bytes,9,rep,name=distribution_targets,json=distributionTargets,proto3" json:"distribution_targets" yaml:"distribution_targets"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccb38f8335e0f45b, []int{3}
+	return fileDescriptor_ccb38f8335e0f45b, []int{4}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,13 +301,6 @@ func (m *Params) GetReductionPeriodInEpochs() int64 {
 	return 0
 }
 
-func (m *Params) GetDistributionProportions() DistributionProportions {
-	if m != nil {
-		return m.DistributionProportions
-	}
-	return DistributionProportions{}
-}
-
 func (m *Params) GetWeightedDeveloperRewardsReceivers() []WeightedAddress {
 	if m != nil {
 		return m.WeightedDeveloperRewardsReceivers
@@ -267,9 +315,17 @@ func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
 	return 0
 }
 
+func (m *Params) GetDistributionTargets() []DistributionTarget {
+	if m != nil {
+		return m.DistributionTargets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "osmosis.mint.v1beta1.Minter")
 	proto.RegisterType((*WeightedAddress)(nil), "osmosis.mint.v1beta1.WeightedAddress")
+	proto.RegisterType((*DistributionTarget)(nil), "osmosis.mint.v1beta1.DistributionTarget")
 	proto.RegisterType((*DistributionProportions)(nil), "osmosis.mint.v1beta1.DistributionProportions")
 	proto.RegisterType((*Params)(nil), "osmosis.mint.v1beta1.Params")
 }
@@ -277,56 +333,59 @@ func init() {
 func init() { proto.RegisterFile("osmosis/mint/v1beta1/mint.proto", fileDescriptor_ccb38f8335e0f45b) }
 
 var fileDescriptor_ccb38f8335e0f45b = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x1b, 0x47,
-	0x18, 0xf5, 0xd6, 0xd4, 0x94, 0x41, 0x60, 0xba, 0xa2, 0x78, 0x0b, 0xaa, 0x17, 0x56, 0x20, 0xb9,
-	0x52, 0xf1, 0x16, 0x68, 0x7b, 0x40, 0xfd, 0xa1, 0x5a, 0x2e, 0x12, 0x15, 0x51, 0xac, 0xc9, 0x21,
-	0x52, 0x2e, 0xab, 0xf5, 0xee, 0x78, 0x3d, 0xc2, 0xbb, 0xb3, 0x9a, 0x19, 0x9b, 0xf8, 0x96, 0x7b,
-	0x14, 0x29, 0xc7, 0x1c, 0x93, 0x43, 0xfe, 0x17, 0x8e, 0x1c, 0xa3, 0x1c, 0xac, 0x08, 0x4e, 0xb9,
-	0xfa, 0x2f, 0x88, 0xe6, 0xc7, 0xda, 0xb0, 0x60, 0xc9, 0xca, 0x6d, 0xe7, 0x7d, 0x6f, 0xde, 0xfb,
-	0xfc, 0xcd, 0xbc, 0x31, 0xb0, 0x09, 0x8b, 0x09, 0xc3, 0xcc, 0x8d, 0x71, 0xc2, 0xdd, 0xc1, 0x41,
-	0x1b, 0x71, 0xff, 0x40, 0x2e, 0xea, 0x29, 0x25, 0x9c, 0x98, 0xeb, 0x9a, 0x50, 0x97, 0x98, 0x26,
-	0x6c, 0xae, 0x47, 0x24, 0x22, 0x92, 0xe0, 0x8a, 0x2f, 0xc5, 0xdd, 0xb4, 0x23, 0x42, 0xa2, 0x1e,
-	0x72, 0xe5, 0xaa, 0xdd, 0xef, 0xb8, 0x1c, 0xc7, 0x88, 0x71, 0x3f, 0x4e, 0x35, 0xe1, 0xc7, 0x3c,
-	0xc1, 0x4f, 0x86, 0xba, 0x54, 0xcd, 0x97, 0xc2, 0x3e, 0xf5, 0x39, 0x26, 0x89, 0xaa, 0x3b, 0x0c,
-	0x94, 0x1e, 0xe1, 0x84, 0x23, 0x6a, 0x62, 0xb0, 0x86, 0x52, 0x12, 0x74, 0xbd, 0x94, 0x92, 0x01,
-	0x66, 0x98, 0x24, 0xcc, 0x32, 0xb6, 0x8d, 0xda, 0x52, 0xe3, 0xef, 0xcb, 0x91, 0x5d, 0xf8, 0x38,
-	0xb2, 0xb7, 0x02, 0xd9, 0x34, 0x0b, 0xcf, 0xeb, 0x98, 0xb8, 0xb1, 0xcf, 0xbb, 0xf5, 0x33, 0x14,
-	0xf9, 0xc1, 0xb0, 0x89, 0x82, 0xf1, 0xc8, 0xae, 0x0c, 0xfd, 0xb8, 0x77, 0xec, 0xe4, 0x45, 0x1c,
-	0x58, 0x96, 0x50, 0x6b, 0x8a, 0xbc, 0x32, 0x40, 0xf9, 0x29, 0xc2, 0x51, 0x97, 0xa3, 0xf0, 0xdf,
-	0x30, 0xa4, 0x88, 0x31, 0xf3, 0x17, 0xb0, 0xe8, 0xab, 0x4f, 0xed, 0x6a, 0x8e, 0x47, 0xf6, 0xaa,
-	0x92, 0xd4, 0x05, 0x07, 0x66, 0x14, 0xf3, 0x0c, 0x94, 0x2e, 0xa4, 0x80, 0xf5, 0x8d, 0x24, 0xff,
-	0x36, 0x5f, 0x8b, 0x2b, 0x4a, 0x4f, 0x6d, 0x75, 0xa0, 0xd6, 0x70, 0xde, 0x17, 0x41, 0xa5, 0x89,
-	0x19, 0xa7, 0xb8, 0xdd, 0x17, 0xb3, 0x69, 0x51, 0x92, 0x12, 0x2a, 0xbe, 0x98, 0xf9, 0x18, 0x2c,
-	0x32, 0xee, 0x9f, 0xe3, 0x24, 0xd2, 0x7d, 0xfd, 0x3e, 0x9f, 0x95, 0x6e, 0x5d, 0xef, 0x75, 0x60,
-	0xa6, 0x62, 0x76, 0x40, 0x39, 0x25, 0xa4, 0xe7, 0xe1, 0x24, 0x40, 0x09, 0xc7, 0x03, 0xc4, 0xf4,
-	0x6f, 0xf8, 0x6b, 0x3e, 0xe1, 0x0d, 0x25, 0x9c, 0xd3, 0x70, 0xe0, 0xaa, 0x40, 0x4e, 0x27, 0x80,
-	0xd9, 0x03, 0xdf, 0x87, 0x68, 0x80, 0x7a, 0x24, 0x45, 0xd4, 0xa3, 0xe8, 0xc2, 0xa7, 0x21, 0xb3,
-	0x8a, 0xd2, 0xe9, 0x9f, 0xf9, 0x9c, 0x2c, 0xe5, 0x74, 0x4f, 0xc5, 0x81, 0x6b, 0x13, 0x0c, 0x2a,
-	0xc8, 0x0c, 0xc0, 0x6a, 0x40, 0xe2, 0xb8, 0x9f, 0x60, 0x3e, 0xf4, 0x44, 0x27, 0xd6, 0x82, 0xb4,
-	0xfa, 0x73, 0x3e, 0xab, 0x1f, 0x94, 0xd5, 0x5d, 0x09, 0x07, 0xae, 0x4c, 0x80, 0x96, 0x58, 0x7f,
-	0x2e, 0x81, 0x52, 0xcb, 0xa7, 0x7e, 0xcc, 0xcc, 0x9f, 0x00, 0x10, 0xc9, 0xf1, 0x42, 0x94, 0x90,
-	0x58, 0x9d, 0x0c, 0x5c, 0x12, 0x48, 0x53, 0x00, 0xe6, 0x0b, 0x03, 0x58, 0x11, 0x4a, 0x10, 0xc3,
-	0xcc, 0xbb, 0x77, 0xab, 0xd5, 0xb8, 0x4f, 0xe6, 0xeb, 0xcc, 0x56, 0x9d, 0xcd, 0x12, 0x73, 0xe0,
-	0x86, 0x2e, 0xfd, 0x77, 0xf7, 0x92, 0x9b, 0x27, 0x59, 0x9e, 0x70, 0x28, 0x8e, 0xa4, 0x83, 0x11,
-	0xd5, 0xe3, 0xdf, 0xca, 0x87, 0x65, 0xca, 0xc8, 0xc2, 0x72, 0x3a, 0x41, 0xcc, 0x36, 0xd8, 0xa4,
-	0x28, 0xec, 0x07, 0xe2, 0x3a, 0x7a, 0x29, 0xa2, 0x98, 0x84, 0x1e, 0x4e, 0x54, 0x23, 0x4c, 0x4e,
-	0xb9, 0xd8, 0xd8, 0x1b, 0x8f, 0xec, 0x1d, 0xa5, 0x38, 0x9b, 0xeb, 0xc0, 0xca, 0xa4, 0xd8, 0x92,
-	0xb5, 0xd3, 0x44, 0x36, 0xcd, 0x44, 0xf6, 0xa7, 0xfb, 0x3a, 0x7e, 0xc0, 0x09, 0xb5, 0xbe, 0xfd,
-	0x8a, 0xec, 0xe7, 0x45, 0x1c, 0x58, 0x9e, 0x40, 0x27, 0x12, 0x31, 0x13, 0x60, 0x85, 0xb7, 0xa2,
-	0x26, 0x46, 0x99, 0x65, 0xcd, 0x2a, 0x6d, 0x1b, 0xb5, 0xe5, 0xc3, 0xfd, 0xfa, 0x43, 0x6f, 0x63,
-	0x7d, 0x46, 0x40, 0x1b, 0x0b, 0xa2, 0x43, 0x58, 0x09, 0x67, 0xe4, 0xf7, 0x9d, 0x01, 0x76, 0x2f,
-	0xf4, 0x5b, 0xe3, 0xdd, 0xbb, 0xca, 0x1e, 0x45, 0x01, 0xc2, 0x03, 0x44, 0x99, 0xb5, 0xb8, 0x5d,
-	0xac, 0x2d, 0x1f, 0xee, 0x3d, 0x6c, 0x9e, 0x7b, 0xad, 0x1a, 0x3f, 0x0b, 0xd3, 0xe9, 0xd0, 0x67,
-	0xeb, 0x3a, 0x70, 0x27, 0x73, 0x6f, 0xe6, 0x32, 0x03, 0x33, 0x6b, 0xf3, 0xa5, 0x01, 0x6a, 0xc2,
-	0x0e, 0x27, 0xd1, 0x44, 0xe0, 0xce, 0x90, 0x18, 0xf7, 0x29, 0x57, 0xc7, 0x68, 0x7d, 0x27, 0x4f,
-	0xfc, 0x68, 0x3c, 0xb2, 0x5d, 0x65, 0x3e, 0xef, 0x4e, 0x07, 0xee, 0x6a, 0xaa, 0x6e, 0xe0, 0xf6,
-	0x44, 0x9f, 0x08, 0x9e, 0xbc, 0x0d, 0xc7, 0x0b, 0x6f, 0xde, 0xda, 0x85, 0xc6, 0xff, 0x97, 0xd7,
-	0x55, 0xe3, 0xea, 0xba, 0x6a, 0x7c, 0xba, 0xae, 0x1a, 0xaf, 0x6f, 0xaa, 0x85, 0xab, 0x9b, 0x6a,
-	0xe1, 0xc3, 0x4d, 0xb5, 0xf0, 0xec, 0xd7, 0x08, 0xf3, 0x6e, 0xbf, 0x5d, 0x0f, 0x48, 0xec, 0xea,
-	0x61, 0xed, 0xf7, 0xfc, 0x36, 0xcb, 0x16, 0xee, 0xe0, 0xf0, 0x0f, 0xf7, 0xb9, 0xfa, 0xe7, 0xe3,
-	0xc3, 0x14, 0xb1, 0x76, 0x49, 0xfe, 0xd7, 0x1c, 0x7d, 0x09, 0x00, 0x00, 0xff, 0xff, 0x51, 0xbb,
-	0x93, 0x38, 0x16, 0x07, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x8f, 0x1b, 0x35,
+	0x1c, 0xcd, 0x90, 0x90, 0x74, 0x5d, 0x75, 0xb3, 0x98, 0xa5, 0x3b, 0xec, 0x8a, 0x4c, 0xeb, 0xb6,
+	0x52, 0x90, 0x20, 0x43, 0x5b, 0xe0, 0x50, 0xf1, 0x47, 0x44, 0x61, 0xa5, 0xad, 0x8a, 0x88, 0x0c,
+	0x12, 0x12, 0x97, 0x91, 0x33, 0xe3, 0x4c, 0xac, 0x66, 0xc6, 0x23, 0xdb, 0xc9, 0x92, 0x1b, 0xe2,
+	0xc2, 0x01, 0x21, 0x71, 0xe4, 0x08, 0x07, 0xbe, 0x4b, 0x8f, 0x3d, 0x22, 0x0e, 0x11, 0xda, 0xfd,
+	0x06, 0xf9, 0x04, 0xc8, 0x7f, 0x92, 0xec, 0x4e, 0x76, 0xa5, 0x08, 0xf5, 0x36, 0x7e, 0xbf, 0xe7,
+	0xf7, 0x5e, 0x7e, 0xb6, 0x7f, 0x01, 0x01, 0x97, 0x19, 0x97, 0x4c, 0x86, 0x19, 0xcb, 0x55, 0x38,
+	0x7d, 0x38, 0xa0, 0x8a, 0x3c, 0x34, 0x8b, 0x4e, 0x21, 0xb8, 0xe2, 0x70, 0xdf, 0x11, 0x3a, 0x06,
+	0x73, 0x84, 0xc3, 0xfd, 0x94, 0xa7, 0xdc, 0x10, 0x42, 0xfd, 0x65, 0xb9, 0x87, 0x41, 0xca, 0x79,
+	0x3a, 0xa6, 0xa1, 0x59, 0x0d, 0x26, 0xc3, 0x50, 0xb1, 0x8c, 0x4a, 0x45, 0xb2, 0xc2, 0x11, 0xde,
+	0x2e, 0x13, 0x48, 0x3e, 0x73, 0xa5, 0x56, 0xb9, 0x94, 0x4c, 0x04, 0x51, 0x8c, 0xe7, 0xb6, 0x8e,
+	0x24, 0xa8, 0x7f, 0xc5, 0x72, 0x45, 0x05, 0x64, 0x60, 0x8f, 0x16, 0x3c, 0x1e, 0x45, 0x85, 0xe0,
+	0x53, 0x26, 0x19, 0xcf, 0xa5, 0xef, 0xdd, 0xf1, 0xda, 0x3b, 0xdd, 0xcf, 0x5e, 0xcc, 0x83, 0xca,
+	0x3f, 0xf3, 0xe0, 0x28, 0x36, 0xa1, 0x65, 0xf2, 0xbc, 0xc3, 0x78, 0x98, 0x11, 0x35, 0xea, 0x3c,
+	0xa3, 0x29, 0x89, 0x67, 0x3d, 0x1a, 0x2f, 0xe6, 0xc1, 0xc1, 0x8c, 0x64, 0xe3, 0x27, 0xa8, 0x2c,
+	0x82, 0x70, 0xd3, 0x40, 0xfd, 0x35, 0xf2, 0xab, 0x07, 0x9a, 0xdf, 0x51, 0x96, 0x8e, 0x14, 0x4d,
+	0xbe, 0x48, 0x12, 0x41, 0xa5, 0x84, 0xef, 0x81, 0x06, 0xb1, 0x9f, 0xce, 0x15, 0x2e, 0xe6, 0xc1,
+	0xae, 0x95, 0x74, 0x05, 0x84, 0x97, 0x14, 0xf8, 0x0c, 0xd4, 0x4f, 0x8d, 0x80, 0xff, 0x9a, 0x21,
+	0x7f, 0xb8, 0x5d, 0xc4, 0x5b, 0x56, 0xcf, 0x6e, 0x45, 0xd8, 0x69, 0xa0, 0x9f, 0x3d, 0x00, 0x7b,
+	0x4c, 0x2a, 0xc1, 0x06, 0x13, 0xdd, 0x9b, 0x6f, 0x89, 0x48, 0xa9, 0x82, 0xf7, 0x40, 0x2d, 0x27,
+	0x19, 0x75, 0x79, 0x9a, 0x8b, 0x79, 0x70, 0xd3, 0xee, 0xd7, 0x28, 0xc2, 0xa6, 0xf8, 0x8a, 0x93,
+	0xfc, 0x55, 0x05, 0x07, 0x17, 0x93, 0xf4, 0x05, 0x2f, 0xb8, 0xd0, 0x5f, 0x12, 0x7e, 0x0d, 0x1a,
+	0x52, 0x91, 0xe7, 0x2c, 0x4f, 0x5d, 0xa2, 0x8f, 0xb6, 0xb3, 0x72, 0x4d, 0x74, 0x7b, 0x11, 0x5e,
+	0xaa, 0xc0, 0x21, 0x68, 0x16, 0x9c, 0x8f, 0x23, 0x96, 0xc7, 0x34, 0x57, 0x6c, 0x4a, 0xa5, 0xfb,
+	0x0d, 0x9f, 0x6e, 0x27, 0x7c, 0xdb, 0x0a, 0x97, 0x34, 0x10, 0xde, 0xd5, 0xc8, 0xc9, 0x0a, 0x80,
+	0x63, 0xf0, 0x46, 0x42, 0xa7, 0x74, 0xcc, 0x0b, 0x2a, 0x22, 0x41, 0x4f, 0x89, 0x48, 0xa4, 0x5f,
+	0x35, 0x4e, 0x9f, 0x6f, 0xe7, 0xe4, 0x5b, 0xa7, 0x0d, 0x15, 0x84, 0xf7, 0x56, 0x18, 0xb6, 0x10,
+	0x8c, 0xc1, 0x6e, 0xcc, 0xb3, 0x6c, 0x92, 0x33, 0x35, 0x8b, 0x74, 0x12, 0xbf, 0x66, 0xac, 0x3e,
+	0xd9, 0xce, 0xea, 0x2d, 0x6b, 0x75, 0x59, 0x02, 0xe1, 0x5b, 0x2b, 0xa0, 0xaf, 0xd7, 0x3f, 0x35,
+	0x40, 0xbd, 0x4f, 0x04, 0xc9, 0x24, 0x7c, 0x07, 0x00, 0xfd, 0x86, 0xa3, 0x84, 0xe6, 0x3c, 0xb3,
+	0x27, 0x83, 0x77, 0x34, 0xd2, 0xd3, 0x00, 0xfc, 0xd1, 0x03, 0x7e, 0x4a, 0x73, 0x2a, 0x99, 0x8c,
+	0x36, 0xde, 0x97, 0x6d, 0xf7, 0xf1, 0x76, 0xc9, 0x02, 0x9b, 0xec, 0x3a, 0x31, 0x84, 0x6f, 0xbb,
+	0xd2, 0x97, 0x97, 0x9f, 0x1b, 0x3c, 0x5e, 0xbe, 0x6c, 0x96, 0xe8, 0x23, 0x19, 0x32, 0x2a, 0x5c,
+	0xfb, 0x8f, 0xca, 0xcf, 0x76, 0xcd, 0x58, 0x3e, 0xdb, 0x93, 0x15, 0x02, 0x07, 0xe0, 0x50, 0xd0,
+	0x64, 0x12, 0xeb, 0xeb, 0x18, 0x15, 0x54, 0x30, 0x9e, 0x44, 0x2c, 0xb7, 0x41, 0xa4, 0xe9, 0x72,
+	0xb5, 0xfb, 0x60, 0x31, 0x0f, 0xee, 0x5a, 0xc5, 0xeb, 0xb9, 0x08, 0x1f, 0xac, 0x8a, 0x7d, 0x53,
+	0x3b, 0xc9, 0x4d, 0x68, 0xa9, 0xa7, 0xd0, 0x7a, 0xdf, 0x90, 0xc4, 0x8a, 0x0b, 0xff, 0xf5, 0xff,
+	0x31, 0x85, 0xca, 0x22, 0x08, 0x37, 0x57, 0xd0, 0xb1, 0x41, 0xe0, 0x9f, 0x1e, 0xb8, 0x7f, 0xea,
+	0xa6, 0x50, 0xb4, 0x71, 0xb5, 0x22, 0x41, 0x63, 0xca, 0xa6, 0x54, 0x48, 0xbf, 0x71, 0xa7, 0xda,
+	0xbe, 0xf9, 0xe8, 0x41, 0xe7, 0xaa, 0x91, 0xdd, 0x29, 0xcd, 0xb1, 0xee, 0xbb, 0x3a, 0xe6, 0xba,
+	0x09, 0xd7, 0xeb, 0x22, 0x7c, 0x77, 0xe9, 0xde, 0x2b, 0xdd, 0x61, 0xbc, 0xb4, 0x86, 0xbf, 0x78,
+	0xa0, 0xad, 0xed, 0x58, 0x9e, 0xae, 0x04, 0x92, 0x0b, 0xf3, 0x21, 0x92, 0x8a, 0x08, 0x65, 0xdb,
+	0xea, 0xdf, 0x30, 0x27, 0xf0, 0x78, 0x31, 0x0f, 0x42, 0x6b, 0xbe, 0xed, 0x4e, 0x84, 0xef, 0x3b,
+	0xaa, 0x0b, 0x70, 0x71, 0x04, 0x7d, 0xa3, 0x79, 0xe6, 0x74, 0xf4, 0x5d, 0xde, 0xbf, 0xa4, 0xa1,
+	0xcc, 0xa0, 0x94, 0xfe, 0x8e, 0xe9, 0x50, 0xfb, 0xea, 0x0e, 0x6d, 0x4e, 0xd6, 0xee, 0x3d, 0xd7,
+	0xa4, 0x23, 0xd7, 0xa4, 0x2b, 0x34, 0x11, 0x7e, 0x33, 0xd9, 0xd8, 0x28, 0x9f, 0xd4, 0x7e, 0xff,
+	0x23, 0xa8, 0x3c, 0xad, 0xdd, 0xa8, 0xef, 0x35, 0xba, 0x4f, 0x5f, 0x9c, 0xb5, 0xbc, 0x97, 0x67,
+	0x2d, 0xef, 0xdf, 0xb3, 0x96, 0xf7, 0xdb, 0x79, 0xab, 0xf2, 0xf2, 0xbc, 0x55, 0xf9, 0xfb, 0xbc,
+	0x55, 0xf9, 0xfe, 0x83, 0x94, 0xa9, 0xd1, 0x64, 0xd0, 0x89, 0x79, 0x16, 0xba, 0x4c, 0xef, 0x8f,
+	0xc9, 0x40, 0x2e, 0x17, 0xe1, 0xf4, 0xd1, 0xc7, 0xe1, 0x0f, 0xf6, 0xcf, 0x59, 0xcd, 0x0a, 0x2a,
+	0x07, 0x75, 0xf3, 0x77, 0xf8, 0xf8, 0xbf, 0x01, 0x00, 0xe0, 0x4f, 0xeb, 0x85, 0xb9, 0x07, 0x00,
+	0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -402,6 +461,46 @@ func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -485,6 +584,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributionTargets) > 0 {
+		for iNdEx := len(m.DistributionTargets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionTargets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartEpoch))
 		i--
@@ -504,16 +617,6 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	{
-		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
 	{
 		size := m.ReductionFactor.Size()
 		i -= size
@@ -593,6 +696,21 @@ func (m *WeightedAddress) Size() (n int) {
 	return n
 }
 
+func (m *DistributionTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func (m *DistributionProportions) Size() (n int) {
 	if m == nil {
 		return 0
@@ -631,8 +749,6 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ReductionFactor.Size()
 	n += 1 + l + sovMint(uint64(l))
-	l = m.DistributionProportions.Size()
-	n += 1 + l + sovMint(uint64(l))
 	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
 		for _, e := range m.WeightedDeveloperRewardsReceivers {
 			l = e.Size()
@@ -642,6 +758,12 @@ func (m *Params) Size() (n int) {
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartEpoch))
 	}
+	if len(m.DistributionTargets) > 0 {
+		for _, e := range m.DistributionTargets {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -851,6 +973,122 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DistributionTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1217,9 +1455,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedDeveloperRewardsReceivers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1246,13 +1484,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WeightedDeveloperRewardsReceivers = append(m.WeightedDeveloperRewardsReceivers, WeightedAddress{})
+			if err := m.WeightedDeveloperRewardsReceivers[len(m.WeightedDeveloperRewardsReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartEpoch", wireType)
+			}
+			m.MintingRewardsDistributionStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingRewardsDistributionStartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WeightedDeveloperRewardsReceivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTargets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1279,30 +1537,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WeightedDeveloperRewardsReceivers = append(m.WeightedDeveloperRewardsReceivers, WeightedAddress{})
-			if err := m.WeightedDeveloperRewardsReceivers[len(m.WeightedDeveloperRewardsReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DistributionTargets = append(m.DistributionTargets, DistributionTarget{})
+			if err := m.DistributionTargets[len(m.DistributionTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartEpoch", wireType)
-			}
-			m.MintingRewardsDistributionStartEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MintingRewardsDistributionStartEpoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -16,8 +16,8 @@ var (
 	defaultProvisionsAmount           = osmomath.NewDec(10)
 	defaultParams                     = types.Params{
 		MintDenom: sdk.DefaultBondDenom,
-		DistributionProportions: types.DistributionProportions{
-			DeveloperRewards: defaultDeveloperVestingProportion,
+		DistributionTargets: []types.DistributionTarget{
+			{Name: types.DistributionTargetDeveloperRewards, Weight: defaultDeveloperVestingProportion},
 		},
 	}
 )
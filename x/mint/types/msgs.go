@@ -0,0 +1,64 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants.
+const (
+	TypeMsgRotateDeveloperRewardsReceiver = "rotate_developer_rewards_receiver"
+	TypeMsgClawbackDeveloperVesting       = "clawback_developer_vesting"
+)
+
+var _ sdk.Msg = &MsgRotateDeveloperRewardsReceiver{}
+
+// NewMsgRotateDeveloperRewardsReceiver creates a message to rotate the address of a developer rewards receiver.
+func NewMsgRotateDeveloperRewardsReceiver(sender, newAddress sdk.AccAddress) *MsgRotateDeveloperRewardsReceiver {
+	return &MsgRotateDeveloperRewardsReceiver{
+		Sender:     sender.String(),
+		NewAddress: newAddress.String(),
+	}
+}
+
+func (m MsgRotateDeveloperRewardsReceiver) Route() string { return RouterKey }
+func (m MsgRotateDeveloperRewardsReceiver) Type() string {
+	return TypeMsgRotateDeveloperRewardsReceiver
+}
+func (m MsgRotateDeveloperRewardsReceiver) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new address (%s)", err)
+	}
+	if m.Sender == m.NewAddress {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new address must differ from the sender")
+	}
+	return nil
+}
+
+func (m MsgRotateDeveloperRewardsReceiver) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClawbackDeveloperVesting{}
+
+func (m MsgClawbackDeveloperVesting) Route() string { return RouterKey }
+func (m MsgClawbackDeveloperVesting) Type() string  { return TypeMsgClawbackDeveloperVesting }
+func (m MsgClawbackDeveloperVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if m.Amount.IsNil() || m.Amount.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount must be non-negative: %s", m.Amount)
+	}
+	return nil
+}
+
+func (m MsgClawbackDeveloperVesting) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
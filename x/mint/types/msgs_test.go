@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/mint/types"
+)
+
+func TestMsgRotateDeveloperRewardsReceiverValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________"))
+	newAddress := sdk.AccAddress([]byte("new_address_________"))
+
+	require.NoError(t, types.NewMsgRotateDeveloperRewardsReceiver(sender, newAddress).ValidateBasic())
+	require.Error(t, types.NewMsgRotateDeveloperRewardsReceiver(sender, sender).ValidateBasic())
+	require.Error(t, (&types.MsgRotateDeveloperRewardsReceiver{Sender: sender.String(), NewAddress: "osmo1invalid"}).ValidateBasic())
+	require.Error(t, (&types.MsgRotateDeveloperRewardsReceiver{NewAddress: newAddress.String()}).ValidateBasic())
+}
+
+func TestMsgClawbackDeveloperVestingValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+
+	require.NoError(t, (&types.MsgClawbackDeveloperVesting{Authority: authority, Amount: osmomath.ZeroInt()}).ValidateBasic())
+	require.NoError(t, (&types.MsgClawbackDeveloperVesting{Authority: authority, Amount: osmomath.NewInt(100)}).ValidateBasic())
+	require.Error(t, (&types.MsgClawbackDeveloperVesting{Authority: authority, Amount: osmomath.NewInt(-1)}).ValidateBasic())
+	require.Error(t, (&types.MsgClawbackDeveloperVesting{Authority: authority}).ValidateBasic())
+	require.Error(t, (&types.MsgClawbackDeveloperVesting{Amount: osmomath.ZeroInt()}).ValidateBasic())
+}
@@ -21,22 +21,46 @@ var (
 	KeyEpochIdentifier                      = []byte("EpochIdentifier")
 	KeyReductionPeriodInEpochs              = []byte("ReductionPeriodInEpochs")
 	KeyReductionFactor                      = []byte("ReductionFactor")
-	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
 	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
 	KeyMintingRewardsDistributionStartEpoch = []byte("MintingRewardsDistributionStartEpoch")
+	KeyDistributionTargets                  = []byte("DistributionTargets")
+
+	// KeyPoolAllocationRatio is the key of the legacy distribution proportions param, which was replaced by
+	// the distribution targets param. It is only registered to migrate it.
+	KeyPoolAllocationRatio = []byte("PoolAllocationRatio")
 
 	_ paramtypes.ParamSet = &Params{}
 )
 
+// Names of the stakeholders minted coins can be distributed to.
+// Minted coins can also be distributed to a module account, named by DistributionTargetModulePrefix
+// followed by the module name, or to an account, named by its bech32 address.
+const (
+	DistributionTargetStaking          = "staking"
+	DistributionTargetPoolIncentives   = "pool_incentives"
+	DistributionTargetDeveloperRewards = "developer_rewards"
+	DistributionTargetCommunityPool    = "community_pool"
+
+	DistributionTargetModulePrefix = "module:"
+)
+
+var distributionTargetNames = map[string]bool{
+	DistributionTargetStaking:          true,
+	DistributionTargetPoolIncentives:   true,
+	DistributionTargetDeveloperRewards: true,
+	DistributionTargetCommunityPool:    true,
+}
+
 // ParamTable for minting module.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &DistributionProportions{}, validateDistributionProportions))
 }
 
 // NewParams returns new mint module parameters initialized to the given values.
 func NewParams(
 	mintDenom string, genesisEpochProvisions osmomath.Dec, epochIdentifier string,
-	ReductionFactor osmomath.Dec, reductionPeriodInEpochs int64, distrProportions DistributionProportions,
+	ReductionFactor osmomath.Dec, reductionPeriodInEpochs int64, distrTargets []DistributionTarget,
 	weightedDevRewardsReceivers []WeightedAddress, mintingRewardsDistributionStartEpoch int64,
 ) Params {
 	return Params{
@@ -45,7 +69,7 @@ func NewParams(
 		EpochIdentifier:                      epochIdentifier,
 		ReductionPeriodInEpochs:              reductionPeriodInEpochs,
 		ReductionFactor:                      ReductionFactor,
-		DistributionProportions:              distrProportions,
+		DistributionTargets:                  distrTargets,
 		WeightedDeveloperRewardsReceivers:    weightedDevRewardsReceivers,
 		MintingRewardsDistributionStartEpoch: mintingRewardsDistributionStartEpoch,
 	}
@@ -54,19 +78,19 @@ func NewParams(
 // DefaultParams returns the default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MintDenom:               sdk.DefaultBondDenom,
-		GenesisEpochProvisions:  osmomath.NewDec(5000000),
-		EpochIdentifier:         "week",                        // 1 week
-		ReductionPeriodInEpochs: 156,                           // 3 years
-		ReductionFactor:         osmomath.NewDecWithPrec(5, 1), // 0.5
-		DistributionProportions: DistributionProportions{
-			Staking:          osmomath.NewDecWithPrec(4, 1), // 0.4
-			PoolIncentives:   osmomath.NewDecWithPrec(3, 1), // 0.3
-			DeveloperRewards: osmomath.NewDecWithPrec(2, 1), // 0.2
-			CommunityPool:    osmomath.NewDecWithPrec(1, 1), // 0.1
-		},
+		MintDenom:                            sdk.DefaultBondDenom,
+		GenesisEpochProvisions:               osmomath.NewDec(5000000),
+		EpochIdentifier:                      "week",                        // 1 week
+		ReductionPeriodInEpochs:              156,                           // 3 years
+		ReductionFactor:                      osmomath.NewDecWithPrec(5, 1), // 0.5
 		WeightedDeveloperRewardsReceivers:    []WeightedAddress{},
 		MintingRewardsDistributionStartEpoch: 0,
+		DistributionTargets: []DistributionTarget{
+			{Name: DistributionTargetStaking, Weight: osmomath.NewDecWithPrec(4, 1)},          // 0.4
+			{Name: DistributionTargetPoolIncentives, Weight: osmomath.NewDecWithPrec(3, 1)},   // 0.3
+			{Name: DistributionTargetDeveloperRewards, Weight: osmomath.NewDecWithPrec(2, 1)}, // 0.2
+			{Name: DistributionTargetCommunityPool, Weight: osmomath.NewDecWithPrec(1, 1)},    // 0.1
+		},
 	}
 }
 
@@ -88,15 +112,15 @@ func (p Params) Validate() error {
 	if err := validateReductionFactor(p.ReductionFactor); err != nil {
 		return err
 	}
-	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
-		return err
-	}
 	if err := validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers); err != nil {
 		return err
 	}
 	if err := validateMintingRewardsDistributionStartEpoch(p.MintingRewardsDistributionStartEpoch); err != nil {
 		return err
 	}
+	if err := validateDistributionTargets(p.DistributionTargets); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -115,9 +139,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyReductionPeriodInEpochs, &p.ReductionPeriodInEpochs, validateReductionPeriodInEpochs),
 		paramtypes.NewParamSetPair(KeyReductionFactor, &p.ReductionFactor, validateReductionFactor),
-		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyDeveloperRewardsReceiver, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
 		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartEpoch, &p.MintingRewardsDistributionStartEpoch, validateMintingRewardsDistributionStartEpoch),
+		paramtypes.NewParamSetPair(KeyDistributionTargets, &p.DistributionTargets, validateDistributionTargets),
 	}
 }
 
@@ -130,7 +154,62 @@ func (p Params) GetInflationProportion() osmomath.Dec {
 // GetDeveloperVestingProportion returns the developer vesting proportion of epoch
 // provisions.osmomath.Dec
 func (p Params) GetDeveloperVestingProportion() osmomath.Dec {
-	return p.DistributionProportions.DeveloperRewards
+	return p.GetDistributionTargetWeight(DistributionTargetDeveloperRewards)
+}
+
+// GetDistributionTargetWeight returns the proportion of epoch provisions distributed
+// to the named target, or zero if it is not a distribution target.
+func (p Params) GetDistributionTargetWeight(name string) osmomath.Dec {
+	for _, target := range p.DistributionTargets {
+		if target.Name == name {
+			return target.Weight
+		}
+	}
+	return osmomath.ZeroDec()
+}
+
+// ModuleDistributionTarget returns the name of the distribution target of the module account.
+func ModuleDistributionTarget(moduleName string) string {
+	return DistributionTargetModulePrefix + moduleName
+}
+
+// ParseModuleDistributionTarget returns the name of the module account the distribution target names,
+// and false if it does not name a module account.
+func ParseModuleDistributionTarget(name string) (string, bool) {
+	moduleName, ok := strings.CutPrefix(name, DistributionTargetModulePrefix)
+	return moduleName, ok
+}
+
+// validateDistributionTargetName checks that the distribution target is a named stakeholder,
+// a module account or an account.
+func validateDistributionTargetName(name string) error {
+	if distributionTargetNames[name] {
+		return nil
+	}
+	if moduleName, ok := ParseModuleDistributionTarget(name); ok {
+		if strings.TrimSpace(moduleName) != moduleName || moduleName == "" {
+			return fmt.Errorf("invalid module account distribution target %s", name)
+		}
+		if moduleName == ModuleName {
+			return fmt.Errorf("minted coins cannot be distributed to the %s module account", ModuleName)
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(name); err != nil {
+		return fmt.Errorf("unknown distribution target %s", name)
+	}
+	return nil
+}
+
+// ToDistributionTargets returns the distribution targets splitting minted coins
+// like the legacy distribution proportions.
+func (v DistributionProportions) ToDistributionTargets() []DistributionTarget {
+	return []DistributionTarget{
+		{Name: DistributionTargetStaking, Weight: v.Staking},
+		{Name: DistributionTargetPoolIncentives, Weight: v.PoolIncentives},
+		{Name: DistributionTargetDeveloperRewards, Weight: v.DeveloperRewards},
+		{Name: DistributionTargetCommunityPool, Weight: v.CommunityPool},
+	}
 }
 
 func validateMintDenom(i interface{}) error {
@@ -223,6 +302,36 @@ func validateDistributionProportions(i interface{}) error {
 	return nil
 }
 
+func validateDistributionTargets(i interface{}) error {
+	v, ok := i.([]DistributionTarget)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	weightSum := osmomath.ZeroDec()
+	for _, target := range v {
+		if err := validateDistributionTargetName(target.Name); err != nil {
+			return err
+		}
+		if seen[target.Name] {
+			return fmt.Errorf("duplicate distribution target %s", target.Name)
+		}
+		seen[target.Name] = true
+
+		if target.Weight.IsNil() || target.Weight.IsNegative() {
+			return fmt.Errorf("%s distribution weight should not be negative", target.Name)
+		}
+		weightSum = weightSum.Add(target.Weight)
+	}
+
+	if !weightSum.Equal(osmomath.OneDec()) {
+		return fmt.Errorf("distribution target weights should sum to 1, got %s", weightSum)
+	}
+
+	return nil
+}
+
 func validateWeightedDeveloperRewardsReceivers(i interface{}) error {
 	v, ok := i.([]WeightedAddress)
 	if !ok {
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -16,8 +17,8 @@ func TestGetInflationProportion(t *testing.T) {
 	expectedInflationProportion := osmomath.OneDec().Sub(developerVestingProportion)
 
 	params := types.Params{
-		DistributionProportions: types.DistributionProportions{
-			DeveloperRewards: developerVestingProportion,
+		DistributionTargets: []types.DistributionTarget{
+			{Name: types.DistributionTargetDeveloperRewards, Weight: developerVestingProportion},
 		},
 	}
 
@@ -32,11 +33,126 @@ func TestGetDeveloperVestingProportion(t *testing.T) {
 	expectedDevVestingProportion := osmomath.NewDecWithPrec(4, 1)
 
 	params := types.Params{
-		DistributionProportions: types.DistributionProportions{
-			DeveloperRewards: expectedDevVestingProportion,
+		DistributionTargets: []types.DistributionTarget{
+			{Name: types.DistributionTargetDeveloperRewards, Weight: expectedDevVestingProportion},
 		},
 	}
 
 	actualDevVestingProportion := params.GetDeveloperVestingProportion()
 	require.Equal(t, expectedDevVestingProportion, actualDevVestingProportion)
 }
+
+// TestValidateDistributionTargets validates that distribution targets must be known stakeholders,
+// module accounts or accounts, unique and non-negative, with weights that sum to one.
+func TestValidateDistributionTargets(t *testing.T) {
+	address := sdk.AccAddress([]byte("addr________________")).String()
+
+	tests := map[string]struct {
+		targets   []types.DistributionTarget
+		expectErr bool
+	}{
+		"default targets": {
+			targets: types.DefaultParams().DistributionTargets,
+		},
+		"single target": {
+			targets: []types.DistributionTarget{
+				{Name: types.DistributionTargetStaking, Weight: osmomath.OneDec()},
+			},
+		},
+		"zero weight": {
+			targets: []types.DistributionTarget{
+				{Name: types.DistributionTargetPoolIncentives, Weight: osmomath.OneDec()},
+				{Name: types.DistributionTargetCommunityPool, Weight: osmomath.ZeroDec()},
+			},
+		},
+		"no targets": {
+			targets:   []types.DistributionTarget{},
+			expectErr: true,
+		},
+		"weights sum to less than one": {
+			targets: []types.DistributionTarget{
+				{Name: types.DistributionTargetStaking, Weight: osmomath.NewDecWithPrec(5, 1)},
+			},
+			expectErr: true,
+		},
+		"unknown target": {
+			targets: []types.DistributionTarget{
+				{Name: "validators", Weight: osmomath.OneDec()},
+			},
+			expectErr: true,
+		},
+		"module account and account targets": {
+			targets: []types.DistributionTarget{
+				{Name: types.DistributionTargetStaking, Weight: osmomath.NewDecWithPrec(5, 1)},
+				{Name: types.ModuleDistributionTarget("gov"), Weight: osmomath.NewDecWithPrec(3, 1)},
+				{Name: address, Weight: osmomath.NewDecWithPrec(2, 1)},
+			},
+		},
+		"module account target without module name": {
+			targets: []types.DistributionTarget{
+				{Name: types.ModuleDistributionTarget(""), Weight: osmomath.OneDec()},
+			},
+			expectErr: true,
+		},
+		"mint module account target": {
+			targets: []types.DistributionTarget{
+				{Name: types.ModuleDistributionTarget(types.ModuleName), Weight: osmomath.OneDec()},
+			},
+			expectErr: true,
+		},
+		"duplicate account target": {
+			targets: []types.DistributionTarget{
+				{Name: address, Weight: osmomath.NewDecWithPrec(5, 1)},
+				{Name: address, Weight: osmomath.NewDecWithPrec(5, 1)},
+			},
+			expectErr: true,
+		},
+		"duplicate target": {
+			targets: []types.DistributionTarget{
+				{Name: types.DistributionTargetStaking, Weight: osmomath.NewDecWithPrec(5, 1)},
+				{Name: types.DistributionTargetStaking, Weight: osmomath.NewDecWithPrec(5, 1)},
+			},
+			expectErr: true,
+		},
+		"negative weight": {
+			targets: []types.DistributionTarget{
+				{Name: types.DistributionTargetStaking, Weight: osmomath.NewDec(2)},
+				{Name: types.DistributionTargetCommunityPool, Weight: osmomath.NewDec(-1)},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.DistributionTargets = tc.targets
+
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestToDistributionTargets validates that the legacy distribution proportions
+// are migrated to targets with the same weights.
+func TestToDistributionTargets(t *testing.T) {
+	proportions := types.DistributionProportions{
+		Staking:          osmomath.NewDecWithPrec(25, 2),
+		PoolIncentives:   osmomath.NewDecWithPrec(45, 2),
+		DeveloperRewards: osmomath.NewDecWithPrec(25, 2),
+		CommunityPool:    osmomath.NewDecWithPrec(5, 2),
+	}
+
+	params := types.DefaultParams()
+	params.DistributionTargets = proportions.ToDistributionTargets()
+	require.NoError(t, params.Validate())
+	require.Equal(t, proportions.Staking, params.GetDistributionTargetWeight(types.DistributionTargetStaking))
+	require.Equal(t, proportions.PoolIncentives, params.GetDistributionTargetWeight(types.DistributionTargetPoolIncentives))
+	require.Equal(t, proportions.DeveloperRewards, params.GetDistributionTargetWeight(types.DistributionTargetDeveloperRewards))
+	require.Equal(t, proportions.CommunityPool, params.GetDistributionTargetWeight(types.DistributionTargetCommunityPool))
+}
@@ -0,0 +1,1029 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/mint/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRotateDeveloperRewardsReceiver replaces the sender with new_address in
+// the weighted developer rewards receivers, keeping its weight. Only a
+// developer rewards receiver can rotate its own address.
+type MsgRotateDeveloperRewardsReceiver struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	NewAddress string `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty" yaml:"new_address"`
+}
+
+func (m *MsgRotateDeveloperRewardsReceiver) Reset()         { *m = MsgRotateDeveloperRewardsReceiver{} }
+func (m *MsgRotateDeveloperRewardsReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDeveloperRewardsReceiver) ProtoMessage()    {}
+func (*MsgRotateDeveloperRewardsReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b4540c28787105, []int{0}
+}
+func (m *MsgRotateDeveloperRewardsReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDeveloperRewardsReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDeveloperRewardsReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDeveloperRewardsReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDeveloperRewardsReceiver.Merge(m, src)
+}
+func (m *MsgRotateDeveloperRewardsReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDeveloperRewardsReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDeveloperRewardsReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDeveloperRewardsReceiver proto.InternalMessageInfo
+
+func (m *MsgRotateDeveloperRewardsReceiver) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRotateDeveloperRewardsReceiver) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+type MsgRotateDeveloperRewardsReceiverResponse struct {
+}
+
+func (m *MsgRotateDeveloperRewardsReceiverResponse) Reset() {
+	*m = MsgRotateDeveloperRewardsReceiverResponse{}
+}
+func (m *MsgRotateDeveloperRewardsReceiverResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRotateDeveloperRewardsReceiverResponse) ProtoMessage() {}
+func (*MsgRotateDeveloperRewardsReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b4540c28787105, []int{1}
+}
+func (m *MsgRotateDeveloperRewardsReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDeveloperRewardsReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDeveloperRewardsReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDeveloperRewardsReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDeveloperRewardsReceiverResponse.Merge(m, src)
+}
+func (m *MsgRotateDeveloperRewardsReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDeveloperRewardsReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDeveloperRewardsReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDeveloperRewardsReceiverResponse proto.InternalMessageInfo
+
+// MsgClawbackDeveloperVesting claws back amount of the developer rewards
+// receivers' share of the unvested developer allocation, which then vests to
+// the community pool instead. A zero amount claws back their whole share.
+// Only governance can claw back developer vesting.
+type MsgClawbackDeveloperVesting struct {
+	Authority string                `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgClawbackDeveloperVesting) Reset()         { *m = MsgClawbackDeveloperVesting{} }
+func (m *MsgClawbackDeveloperVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackDeveloperVesting) ProtoMessage()    {}
+func (*MsgClawbackDeveloperVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b4540c28787105, []int{2}
+}
+func (m *MsgClawbackDeveloperVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackDeveloperVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackDeveloperVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackDeveloperVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackDeveloperVesting.Merge(m, src)
+}
+func (m *MsgClawbackDeveloperVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackDeveloperVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackDeveloperVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackDeveloperVesting proto.InternalMessageInfo
+
+func (m *MsgClawbackDeveloperVesting) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+type MsgClawbackDeveloperVestingResponse struct {
+	// clawed_back_amount is the amount of the unvested developer allocation
+	// that now vests to the community pool.
+	ClawedBackAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=clawed_back_amount,json=clawedBackAmount,proto3,customtype=cosmossdk.io/math.Int" json:"clawed_back_amount"`
+}
+
+func (m *MsgClawbackDeveloperVestingResponse) Reset()         { *m = MsgClawbackDeveloperVestingResponse{} }
+func (m *MsgClawbackDeveloperVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackDeveloperVestingResponse) ProtoMessage()    {}
+func (*MsgClawbackDeveloperVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b4540c28787105, []int{3}
+}
+func (m *MsgClawbackDeveloperVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackDeveloperVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackDeveloperVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackDeveloperVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackDeveloperVestingResponse.Merge(m, src)
+}
+func (m *MsgClawbackDeveloperVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackDeveloperVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackDeveloperVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackDeveloperVestingResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRotateDeveloperRewardsReceiver)(nil), "osmosis.mint.v1beta1.MsgRotateDeveloperRewardsReceiver")
+	proto.RegisterType((*MsgRotateDeveloperRewardsReceiverResponse)(nil), "osmosis.mint.v1beta1.MsgRotateDeveloperRewardsReceiverResponse")
+	proto.RegisterType((*MsgClawbackDeveloperVesting)(nil), "osmosis.mint.v1beta1.MsgClawbackDeveloperVesting")
+	proto.RegisterType((*MsgClawbackDeveloperVestingResponse)(nil), "osmosis.mint.v1beta1.MsgClawbackDeveloperVestingResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/mint/v1beta1/tx.proto", fileDescriptor_c9b4540c28787105) }
+
+var fileDescriptor_c9b4540c28787105 = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcf, 0x8a, 0xd3, 0x40,
+	0x1c, 0x6e, 0x56, 0x28, 0xec, 0x88, 0xb0, 0x1b, 0xaa, 0x96, 0xca, 0xa6, 0x6b, 0x3c, 0xe8, 0xae,
+	0x24, 0xb3, 0xad, 0xe0, 0x62, 0x2f, 0xb2, 0x55, 0x04, 0x95, 0x5e, 0x72, 0xf0, 0xe0, 0xa5, 0x4c,
+	0x92, 0x1f, 0x69, 0x68, 0x33, 0x53, 0x66, 0xa6, 0xe9, 0xf6, 0xea, 0x49, 0x3c, 0x79, 0xf6, 0x1d,
+	0x84, 0xbd, 0xfa, 0x06, 0x7b, 0xdc, 0x8b, 0x20, 0x1e, 0x82, 0xb4, 0x87, 0xbd, 0xf7, 0x09, 0x24,
+	0x99, 0x69, 0xeb, 0x82, 0xdd, 0xa2, 0x97, 0x24, 0x33, 0xdf, 0x1f, 0xbe, 0x6f, 0xe6, 0x17, 0xb4,
+	0xc7, 0x44, 0xc2, 0x44, 0x2c, 0x70, 0x12, 0x53, 0x89, 0xd3, 0x86, 0x0f, 0x92, 0x34, 0xb0, 0x3c,
+	0x75, 0x87, 0x9c, 0x49, 0x66, 0x56, 0x34, 0xec, 0xe6, 0xb0, 0xab, 0xe1, 0x5a, 0x25, 0x62, 0x11,
+	0x2b, 0x08, 0x38, 0xff, 0x52, 0xdc, 0xda, 0x2e, 0x49, 0x62, 0xca, 0x70, 0xf1, 0xd4, 0x5b, 0x77,
+	0x83, 0x42, 0x8f, 0x13, 0x11, 0xe1, 0xb4, 0x91, 0xbf, 0x14, 0x60, 0x7f, 0x33, 0xd0, 0xfd, 0x8e,
+	0x88, 0x3c, 0x26, 0x89, 0x84, 0x97, 0x90, 0xc2, 0x80, 0x0d, 0x81, 0x7b, 0x30, 0x26, 0x3c, 0x14,
+	0x1e, 0x04, 0x10, 0xa7, 0xc0, 0xcd, 0x03, 0x54, 0x16, 0x40, 0x43, 0xe0, 0x55, 0x63, 0xdf, 0x78,
+	0xb4, 0xdd, 0xde, 0x9d, 0x67, 0xf5, 0x5b, 0x13, 0x92, 0x0c, 0x5a, 0xb6, 0xda, 0xb7, 0x3d, 0x4d,
+	0x30, 0x8f, 0xd1, 0x4d, 0x0a, 0xe3, 0x2e, 0x09, 0x43, 0x0e, 0x42, 0x54, 0xb7, 0x0a, 0xfe, 0x9d,
+	0x79, 0x56, 0x37, 0x15, 0xff, 0x0f, 0xd0, 0xf6, 0x10, 0x85, 0xf1, 0x89, 0x5a, 0xb4, 0x8e, 0x3e,
+	0x5c, 0x9e, 0x1d, 0x6a, 0x97, 0x4f, 0x97, 0x67, 0x87, 0xfb, 0x57, 0x0e, 0x84, 0x17, 0x01, 0x9d,
+	0x10, 0x52, 0x87, 0xeb, 0x54, 0xf6, 0x63, 0x74, 0xb0, 0x31, 0xba, 0x07, 0x62, 0xc8, 0xa8, 0x00,
+	0xfb, 0xbb, 0x81, 0xee, 0x75, 0x44, 0xf4, 0x62, 0x40, 0xc6, 0x3e, 0x09, 0xfa, 0x4b, 0xfe, 0x3b,
+	0x10, 0x32, 0xa6, 0x91, 0xd9, 0x44, 0xdb, 0x64, 0x24, 0x7b, 0x8c, 0xc7, 0x72, 0xa2, 0x5b, 0x56,
+	0xe6, 0x59, 0x7d, 0x47, 0xa5, 0x5e, 0x42, 0xb6, 0xb7, 0xa2, 0x99, 0xaf, 0x50, 0x99, 0x24, 0x6c,
+	0x44, 0xa5, 0xae, 0xe9, 0x9e, 0x67, 0xf5, 0xd2, 0xcf, 0xac, 0x7e, 0x5b, 0x9d, 0xb6, 0x08, 0xfb,
+	0x6e, 0xcc, 0x70, 0x42, 0x64, 0xcf, 0x7d, 0x4d, 0xe5, 0xea, 0xcc, 0x94, 0xc8, 0xf6, 0xb4, 0xba,
+	0xd5, 0xca, 0xab, 0xaf, 0x7c, 0xf3, 0xf6, 0x0f, 0xaf, 0xb4, 0x0f, 0x74, 0x6a, 0x27, 0x5c, 0xc4,
+	0x76, 0x52, 0x95, 0xdb, 0xe6, 0xe8, 0xc1, 0x35, 0xb5, 0x16, 0xf5, 0xcd, 0xb7, 0xc8, 0xcc, 0x4d,
+	0x20, 0xec, 0xe6, 0xb4, 0xae, 0x8e, 0xad, 0x7a, 0xee, 0x5d, 0x1b, 0xdb, 0xdb, 0x51, 0xc2, 0x36,
+	0x09, 0xfa, 0x27, 0x85, 0xac, 0xf9, 0x75, 0x0b, 0xdd, 0xe8, 0x88, 0xc8, 0xfc, 0x62, 0x20, 0x6b,
+	0xc3, 0xe4, 0x1c, 0xbb, 0x7f, 0x1b, 0x5c, 0x77, 0xe3, 0xbd, 0xd5, 0x9e, 0xff, 0xa7, 0x70, 0xd9,
+	0xf8, 0xa3, 0x81, 0xaa, 0x6b, 0x6f, 0xbb, 0xb1, 0xd6, 0x7d, 0x9d, 0xa4, 0xf6, 0xec, 0x9f, 0x25,
+	0x8b, 0x28, 0xed, 0x37, 0xe7, 0x53, 0xcb, 0xb8, 0x98, 0x5a, 0xc6, 0xaf, 0xa9, 0x65, 0x7c, 0x9e,
+	0x59, 0xa5, 0x8b, 0x99, 0x55, 0xfa, 0x31, 0xb3, 0x4a, 0xef, 0x8f, 0xa2, 0x58, 0xf6, 0x46, 0xbe,
+	0x1b, 0xb0, 0x04, 0x6b, 0x7b, 0x67, 0x40, 0x7c, 0xb1, 0x58, 0xe0, 0xb4, 0xf9, 0x14, 0x9f, 0xaa,
+	0x21, 0x90, 0x93, 0x21, 0x08, 0xbf, 0x5c, 0xfc, 0xb7, 0x4f, 0x7e, 0x0f, 0x00, 0xf6, 0x17, 0xf1,
+	0x81, 0x30, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RotateDeveloperRewardsReceiver(ctx context.Context, in *MsgRotateDeveloperRewardsReceiver, opts ...grpc.CallOption) (*MsgRotateDeveloperRewardsReceiverResponse, error)
+	ClawbackDeveloperVesting(ctx context.Context, in *MsgClawbackDeveloperVesting, opts ...grpc.CallOption) (*MsgClawbackDeveloperVestingResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RotateDeveloperRewardsReceiver(ctx context.Context, in *MsgRotateDeveloperRewardsReceiver, opts ...grpc.CallOption) (*MsgRotateDeveloperRewardsReceiverResponse, error) {
+	out := new(MsgRotateDeveloperRewardsReceiverResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.mint.v1beta1.Msg/RotateDeveloperRewardsReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackDeveloperVesting(ctx context.Context, in *MsgClawbackDeveloperVesting, opts ...grpc.CallOption) (*MsgClawbackDeveloperVestingResponse, error) {
+	out := new(MsgClawbackDeveloperVestingResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.mint.v1beta1.Msg/ClawbackDeveloperVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RotateDeveloperRewardsReceiver(context.Context, *MsgRotateDeveloperRewardsReceiver) (*MsgRotateDeveloperRewardsReceiverResponse, error)
+	ClawbackDeveloperVesting(context.Context, *MsgClawbackDeveloperVesting) (*MsgClawbackDeveloperVestingResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RotateDeveloperRewardsReceiver(ctx context.Context, req *MsgRotateDeveloperRewardsReceiver) (*MsgRotateDeveloperRewardsReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDeveloperRewardsReceiver not implemented")
+}
+func (*UnimplementedMsgServer) ClawbackDeveloperVesting(ctx context.Context, req *MsgClawbackDeveloperVesting) (*MsgClawbackDeveloperVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackDeveloperVesting not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RotateDeveloperRewardsReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDeveloperRewardsReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDeveloperRewardsReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.mint.v1beta1.Msg/RotateDeveloperRewardsReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDeveloperRewardsReceiver(ctx, req.(*MsgRotateDeveloperRewardsReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackDeveloperVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackDeveloperVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackDeveloperVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.mint.v1beta1.Msg/ClawbackDeveloperVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackDeveloperVesting(ctx, req.(*MsgClawbackDeveloperVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.mint.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RotateDeveloperRewardsReceiver",
+			Handler:    _Msg_RotateDeveloperRewardsReceiver_Handler,
+		},
+		{
+			MethodName: "ClawbackDeveloperVesting",
+			Handler:    _Msg_ClawbackDeveloperVesting_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/mint/v1beta1/tx.proto",
+}
+
+func (m *MsgRotateDeveloperRewardsReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDeveloperRewardsReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDeveloperRewardsReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateDeveloperRewardsReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDeveloperRewardsReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDeveloperRewardsReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackDeveloperVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackDeveloperVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackDeveloperVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackDeveloperVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackDeveloperVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackDeveloperVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClawedBackAmount.Size()
+		i -= size
+		if _, err := m.ClawedBackAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRotateDeveloperRewardsReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateDeveloperRewardsReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawbackDeveloperVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClawbackDeveloperVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClawedBackAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRotateDeveloperRewardsReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateDeveloperRewardsReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateDeveloperRewardsReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateDeveloperRewardsReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateDeveloperRewardsReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateDeveloperRewardsReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackDeveloperVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackDeveloperVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackDeveloperVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackDeveloperVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackDeveloperVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackDeveloperVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBackAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClawedBackAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
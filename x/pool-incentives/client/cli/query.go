@@ -19,6 +19,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdLockableDurations(),
 		GetCmdIncentivizedPools(),
 		GetCmdExternalIncentiveGauges(),
+		GetCmdPoolAPR(),
 	)
 
 	return cmd
@@ -75,3 +76,13 @@ func GetCmdExternalIncentiveGauges() *cobra.Command {
 {{.CommandPrefix}} external-incentivized-gauges
 `, types.ModuleName, types.NewQueryClient)
 }
+
+// GetCmdPoolAPR takes the pool id and returns the APRs of its incentives for each duration.
+func GetCmdPoolAPR() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryPoolAPRRequest](
+		"pool-apr",
+		"Query the APRs of the incentives of a pool for each duration",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-apr 1
+`, types.ModuleName, types.NewQueryClient)
+}
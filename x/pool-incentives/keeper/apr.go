@@ -0,0 +1,322 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+	minttypes "github.com/osmosis-labs/osmosis/v26/x/mint/types"
+	"github.com/osmosis-labs/osmosis/v26/x/pool-incentives/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+const (
+	// aprPriceTwapWindow is the window of the TWAPs incentives and liquidity are valued with.
+	aprPriceTwapWindow = 24 * time.Hour
+
+	year = 365 * 24 * time.Hour
+)
+
+// aprSource is the value of the incentives a source distributes per year to the liquidity locked for at least
+// duration, and the value of that liquidity.
+type aprSource struct {
+	duration    time.Duration
+	yearlyValue osmomath.Dec
+	lockedValue osmomath.Dec
+}
+
+// apr returns the yearly value of the source over the value of its liquidity, or zero without liquidity.
+func (s aprSource) apr() osmomath.Dec {
+	if !s.lockedValue.IsPositive() {
+		return osmomath.ZeroDec()
+	}
+	return s.yearlyValue.Quo(s.lockedValue)
+}
+
+// aprPricer values coins in the quote denom with the TWAP of the protorev pool of each denom and the quote
+// denom, and records the denoms without a price.
+type aprPricer struct {
+	k          Keeper
+	ctx        sdk.Context
+	quoteDenom string
+	prices     map[string]osmomath.Dec
+	unpriced   map[string]bool
+}
+
+func (k Keeper) newAPRPricer(ctx sdk.Context, quoteDenom string) *aprPricer {
+	return &aprPricer{
+		k:          k,
+		ctx:        ctx,
+		quoteDenom: quoteDenom,
+		prices:     map[string]osmomath.Dec{quoteDenom: osmomath.OneDec()},
+		unpriced:   map[string]bool{},
+	}
+}
+
+// value returns the value of amount of denom in the quote denom. Denoms without a price are valued at zero.
+func (p *aprPricer) value(denom string, amount osmomath.Dec) osmomath.Dec {
+	price, ok := p.prices[denom]
+	if !ok {
+		price = p.price(denom)
+		p.prices[denom] = price
+	}
+	return amount.Mul(price)
+}
+
+func (p *aprPricer) price(denom string) osmomath.Dec {
+	poolId, err := p.k.protorevKeeper.GetPoolForDenomPairNoOrder(p.ctx, denom, p.quoteDenom)
+	if err != nil {
+		p.unpriced[denom] = true
+		return osmomath.ZeroDec()
+	}
+	price, err := p.k.twapKeeper.GetArithmeticTwapToNow(p.ctx, poolId, denom, p.quoteDenom, p.ctx.BlockTime().Add(-aprPriceTwapWindow))
+	if err != nil {
+		p.unpriced[denom] = true
+		return osmomath.ZeroDec()
+	}
+	return price
+}
+
+func (p *aprPricer) valueCoins(coins sdk.Coins) osmomath.Dec {
+	total := osmomath.ZeroDec()
+	for _, coin := range coins {
+		total = total.Add(p.value(coin.Denom, coin.Amount.ToLegacyDec()))
+	}
+	return total
+}
+
+func (p *aprPricer) unpricedDenoms() []string {
+	denoms := make([]string, 0, len(p.unpriced))
+	for denom := range p.unpriced {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
+// GetPoolAPR returns the APRs of the incentives of a pool for each duration, valued in the mint denom.
+//
+// For balancer and stableswap pools, the durations are the lockable durations, and the APR of a duration
+// sums the APRs of the internal incentives and external gauges distributing to locks of at most that duration.
+// The APR of each is the value it distributes per year, projected from its next epoch distribution, over
+// the value of the pool shares locked for at least its duration.
+//
+// For concentrated liquidity pools, gauges are distributed through uptime incentive records, so the durations
+// are the authorized uptimes, and the APR of an uptime sums the emission rates of the current incentive records
+// of uptimes up to it, per year, over the value of the pool liquidity.
+func (k Keeper) GetPoolAPR(ctx sdk.Context, poolId uint64) (types.QueryPoolAPRResponse, error) {
+	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return types.QueryPoolAPRResponse{}, err
+	}
+	liquidity, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return types.QueryPoolAPRResponse{}, err
+	}
+
+	pricer := k.newAPRPricer(ctx, k.mintKeeper.GetParams(ctx).MintDenom)
+	tvl := pricer.valueCoins(liquidity)
+
+	var aprs []types.DurationAPR
+	switch pool.GetType() {
+	case poolmanagertypes.Balancer, poolmanagertypes.Stableswap:
+		aprs, err = k.getCFMMPoolAPRs(ctx, poolId, tvl, pricer)
+	case poolmanagertypes.Concentrated:
+		aprs, err = k.getConcentratedPoolAPRs(ctx, poolId, tvl, pricer)
+	default:
+		err = fmt.Errorf("pool %d of type %s has no incentives", poolId, pool.GetType())
+	}
+	if err != nil {
+		return types.QueryPoolAPRResponse{}, err
+	}
+
+	return types.QueryPoolAPRResponse{
+		QuoteDenom:       pricer.quoteDenom,
+		TotalValueLocked: tvl,
+		Aprs:             aprs,
+		UnpricedDenoms:   pricer.unpricedDenoms(),
+	}, nil
+}
+
+func (k Keeper) getCFMMPoolAPRs(ctx sdk.Context, poolId uint64, tvl osmomath.Dec, pricer *aprPricer) ([]types.DurationAPR, error) {
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	shareSupply := k.bankKeeper.GetSupply(ctx, shareDenom).Amount
+
+	// lockedValue returns the value of the shares locked for at least duration.
+	lockedValues := map[time.Duration]osmomath.Dec{}
+	lockedValue := func(duration time.Duration) osmomath.Dec {
+		if value, ok := lockedValues[duration]; ok {
+			return value
+		}
+		value := osmomath.ZeroDec()
+		if shareSupply.IsPositive() {
+			locked := k.lockupKeeper.GetPeriodLocksAccumulation(ctx, lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         shareDenom,
+				Duration:      duration,
+			})
+			value = locked.ToLegacyDec().Mul(tvl).Quo(shareSupply.ToLegacyDec())
+		}
+		lockedValues[duration] = value
+		return value
+	}
+
+	lockableDurations := k.GetLockableDurations(ctx)
+	internalGaugeDurations := make(map[uint64]time.Duration, len(lockableDurations))
+	for _, duration := range lockableDurations {
+		gaugeId, err := k.GetPoolGaugeId(ctx, poolId, duration)
+		if err != nil {
+			return nil, err
+		}
+		internalGaugeDurations[gaugeId] = duration
+	}
+
+	internalSources := k.getInternalAPRSources(ctx, internalGaugeDurations, pricer, lockedValue)
+
+	incentivesEpochsPerYear := epochsPerYear(k.incentivesKeeper.GetEpochInfo(ctx).Duration)
+	externalSources := []aprSource{}
+	for _, gauge := range k.incentivesKeeper.GetActiveGauges(ctx) {
+		if _, isInternal := internalGaugeDurations[gauge.Id]; isInternal {
+			continue
+		}
+		if gauge.DistributeTo.LockQueryType != lockuptypes.ByDuration || gauge.DistributeTo.Denom != shareDenom {
+			continue
+		}
+		externalSources = append(externalSources, aprSource{
+			duration:    gauge.DistributeTo.Duration,
+			yearlyValue: pricer.valueCoins(gauge.NextEpochDistribution()).Mul(incentivesEpochsPerYear),
+			lockedValue: lockedValue(gauge.DistributeTo.Duration),
+		})
+	}
+
+	sortedDurations := append([]time.Duration{}, lockableDurations...)
+	sort.Slice(sortedDurations, func(i, j int) bool { return sortedDurations[i] < sortedDurations[j] })
+	aprs := make([]types.DurationAPR, 0, len(sortedDurations))
+	for _, duration := range sortedDurations {
+		internalAPR := sumAPRsUpTo(internalSources, duration)
+		externalAPR := sumAPRsUpTo(externalSources, duration)
+		aprs = append(aprs, types.DurationAPR{
+			Duration:    duration,
+			InternalApr: internalAPR,
+			ExternalApr: externalAPR,
+			UptimeApr:   osmomath.ZeroDec(),
+			TotalApr:    internalAPR.Add(externalAPR),
+		})
+	}
+	return aprs, nil
+}
+
+// getInternalAPRSources returns the incentives the distribution records allocate to the internal gauges of a
+// pool each mint epoch, directly or through group gauges, per year.
+func (k Keeper) getInternalAPRSources(ctx sdk.Context, internalGaugeDurations map[uint64]time.Duration, pricer *aprPricer, lockedValue func(time.Duration) osmomath.Dec) []aprSource {
+	distrInfo := k.GetDistrInfo(ctx)
+	if distrInfo.TotalWeight.IsZero() {
+		return nil
+	}
+
+	mintParams := k.mintKeeper.GetParams(ctx)
+	poolIncentivesPerEpoch := k.mintKeeper.GetMinter(ctx).EpochProvisions.Mul(mintParams.GetDistributionTargetWeight(minttypes.DistributionTargetPoolIncentives))
+	yearlyValue := pricer.value(mintParams.MintDenom, poolIncentivesPerEpoch).
+		Mul(epochsPerYear(k.epochKeeper.GetEpochInfo(ctx, mintParams.EpochIdentifier).Duration))
+
+	// the weight of the pool incentives allocated to each internal gauge of the pool
+	gaugeWeights := map[uint64]osmomath.Dec{}
+	addWeight := func(gaugeId uint64, weight osmomath.Dec) {
+		if current, ok := gaugeWeights[gaugeId]; ok {
+			weight = weight.Add(current)
+		}
+		gaugeWeights[gaugeId] = weight
+	}
+	for _, record := range distrInfo.Records {
+		if record.GaugeId == types.CommunityPoolDistributionGaugeID || record.Weight.IsZero() {
+			continue
+		}
+		if _, isInternal := internalGaugeDurations[record.GaugeId]; isInternal {
+			addWeight(record.GaugeId, record.Weight.ToLegacyDec())
+			continue
+		}
+
+		group, err := k.incentivesKeeper.GetGroupByGaugeID(ctx, record.GaugeId)
+		if err != nil || group.InternalGaugeInfo.TotalWeight.IsZero() {
+			continue
+		}
+		for _, gaugeRecord := range group.InternalGaugeInfo.GaugeRecords {
+			if _, isInternal := internalGaugeDurations[gaugeRecord.GaugeId]; !isInternal {
+				continue
+			}
+			weight := record.Weight.Mul(gaugeRecord.CurrentWeight).ToLegacyDec().Quo(group.InternalGaugeInfo.TotalWeight.ToLegacyDec())
+			addWeight(gaugeRecord.GaugeId, weight)
+		}
+	}
+
+	sources := make([]aprSource, 0, len(gaugeWeights))
+	for gaugeId, weight := range gaugeWeights {
+		duration := internalGaugeDurations[gaugeId]
+		sources = append(sources, aprSource{
+			duration:    duration,
+			yearlyValue: yearlyValue.Mul(weight).Quo(distrInfo.TotalWeight.ToLegacyDec()),
+			lockedValue: lockedValue(duration),
+		})
+	}
+	return sources
+}
+
+func (k Keeper) getConcentratedPoolAPRs(ctx sdk.Context, poolId uint64, tvl osmomath.Dec, pricer *aprPricer) ([]types.DurationAPR, error) {
+	incentiveRecords, err := k.clKeeper.GetAllIncentiveRecordsForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []aprSource{}
+	for _, record := range incentiveRecords {
+		body := record.IncentiveRecordBody
+		if body.StartTime.After(ctx.BlockTime()) || !body.RemainingCoin.Amount.IsPositive() {
+			continue
+		}
+		sources = append(sources, aprSource{
+			duration:    record.MinUptime,
+			yearlyValue: pricer.value(body.RemainingCoin.Denom, body.EmissionRate.MulInt64(int64(year/time.Second))),
+			lockedValue: tvl,
+		})
+	}
+
+	uptimes := append([]time.Duration{}, k.clKeeper.GetParams(ctx).AuthorizedUptimes...)
+	sort.Slice(uptimes, func(i, j int) bool { return uptimes[i] < uptimes[j] })
+	aprs := make([]types.DurationAPR, 0, len(uptimes))
+	for _, uptime := range uptimes {
+		uptimeAPR := sumAPRsUpTo(sources, uptime)
+		aprs = append(aprs, types.DurationAPR{
+			Duration:    uptime,
+			InternalApr: osmomath.ZeroDec(),
+			ExternalApr: osmomath.ZeroDec(),
+			UptimeApr:   uptimeAPR,
+			TotalApr:    uptimeAPR,
+		})
+	}
+	return aprs, nil
+}
+
+// sumAPRsUpTo sums the APRs of the sources distributing to liquidity locked for at most duration, which
+// liquidity locked for duration qualifies for.
+func sumAPRsUpTo(sources []aprSource, duration time.Duration) osmomath.Dec {
+	total := osmomath.ZeroDec()
+	for _, source := range sources {
+		if source.duration <= duration {
+			total = total.Add(source.apr())
+		}
+	}
+	return total
+}
+
+// epochsPerYear returns the number of epochs of epochDuration in a year.
+func epochsPerYear(epochDuration time.Duration) osmomath.Dec {
+	if epochDuration <= 0 {
+		return osmomath.ZeroDec()
+	}
+	return osmomath.NewDec(int64(year)).QuoInt64(int64(epochDuration))
+}
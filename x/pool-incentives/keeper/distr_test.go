@@ -6,6 +6,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/coinutil"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	incentivestypes "github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 	"github.com/osmosis-labs/osmosis/v26/x/pool-incentives/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -167,10 +168,10 @@ func (s *KeeperTestSuite) TestAllocateAsset_GroupGauge() {
 		s.App.PoolManagerKeeper.SetVolume(s.Ctx, poolID, defaultCoins)
 	}
 
-	groupGaugeIDOne, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, 0, s.TestAccs[1], poolIDs)
+	groupGaugeIDOne, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, 0, s.TestAccs[1], poolIDs, incentivestypes.ByVolume, nil)
 	s.Require().NoError(err)
 
-	groupGaugeIDTwo, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, 0, s.TestAccs[1], poolIDs)
+	groupGaugeIDTwo, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, defaultCoins, 0, s.TestAccs[1], poolIDs, incentivestypes.ByVolume, nil)
 	s.Require().NoError(err)
 
 	err = s.App.PoolIncentivesKeeper.ReplaceDistrRecords(s.Ctx, types.DistrRecord{
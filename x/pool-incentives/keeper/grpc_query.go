@@ -247,3 +247,17 @@ func (q Querier) ExternalIncentiveGauges(ctx context.Context, req *types.QueryEx
 
 	return &types.QueryExternalIncentiveGaugesResponse{Data: gauges}, nil
 }
+
+// PoolAPR returns the APRs of the incentives of a pool for each duration.
+func (q Querier) PoolAPR(ctx context.Context, req *types.QueryPoolAPRRequest) (*types.QueryPoolAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res, err := q.Keeper.GetPoolAPR(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &res, nil
+}
@@ -9,8 +9,10 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+	minttypes "github.com/osmosis-labs/osmosis/v26/x/mint/types"
 	"github.com/osmosis-labs/osmosis/v26/x/pool-incentives/types"
 )
 
@@ -234,7 +236,7 @@ func (s *KeeperTestSuite) TestIncentivizedPools() {
 				if tc.setupPerpetualGroupGauge {
 					// If test case requires, create a perpetual group gauge with both balancer and cl pool
 					s.SetupVolumeForPools(groupPoolIDs, []osmomath.Int{osmomath.NewInt(3000000), osmomath.NewInt(3000000)}, map[uint64]osmomath.Int{})
-					groupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, sdk.Coins{}, incentivestypes.PerpetualNumEpochsPaidOver, s.TestAccs[0], groupPoolIDs)
+					groupGaugeID, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, sdk.Coins{}, incentivestypes.PerpetualNumEpochsPaidOver, s.TestAccs[0], groupPoolIDs, incentivestypes.ByVolume, nil)
 					s.Require().NoError(err)
 					// Add this group gauge to the distribution records
 					distRecords = append(distRecords, types.DistrRecord{GaugeId: groupGaugeID, Weight: tc.weights[i]})
@@ -582,3 +584,92 @@ func (s *KeeperTestSuite) TestGaugeIncentivePercentage() {
 	s.Require().Equal("33.333333333333333300", res.GaugeIdsWithDuration[1].GaugeIncentivePercentage)
 	s.Require().Equal("50.000000000000000000", res.GaugeIdsWithDuration[2].GaugeIncentivePercentage)
 }
+
+func (s *KeeperTestSuite) TestPoolAPR() {
+	for _, tc := range []struct {
+		desc             string
+		priceFoo         bool
+		expectedTVL      osmomath.Dec
+		expectedUnpriced []string
+	}{
+		{
+			desc:        "all denoms priced",
+			priceFoo:    true,
+			expectedTVL: osmomath.NewDec(2_000_000),
+		},
+		{
+			desc:             "denom without a protorev pool is unpriced",
+			priceFoo:         false,
+			expectedTVL:      osmomath.NewDec(1_000_000),
+			expectedUnpriced: []string{"foo"},
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			mintParams := s.App.MintKeeper.GetParams(s.Ctx)
+			poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(mintParams.MintDenom, 1_000_000), sdk.NewInt64Coin("foo", 1_000_000))
+			if tc.priceFoo {
+				s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, mintParams.MintDenom, "foo", poolId)
+			}
+			// TWAPs need a record at the start of their window
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(25 * time.Hour))
+
+			lockableDurations := s.App.PoolIncentivesKeeper.GetLockableDurations(s.Ctx)
+			shortestDuration, longestDuration := lockableDurations[0], lockableDurations[len(lockableDurations)-1]
+
+			// half of the shares are locked for the longest duration
+			shareDenom := gammtypes.GetPoolShareDenom(poolId)
+			shares := s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], shareDenom)
+			s.LockTokensNoFund(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(shareDenom, shares.Amount.QuoRaw(2))), longestDuration)
+			lockedValue := tc.expectedTVL.QuoInt64(2)
+
+			// all the pool incentives go to the longest duration gauge of the pool
+			longestGaugeId, err := s.App.PoolIncentivesKeeper.GetPoolGaugeId(s.Ctx, poolId, longestDuration)
+			s.Require().NoError(err)
+			s.App.PoolIncentivesKeeper.SetDistrInfo(s.Ctx, types.DistrInfo{
+				TotalWeight: osmomath.NewInt(100),
+				Records:     []types.DistrRecord{{GaugeId: longestGaugeId, Weight: osmomath.NewInt(100)}},
+			})
+			minter := s.App.MintKeeper.GetMinter(s.Ctx)
+			minter.EpochProvisions = osmomath.NewDec(1_000)
+			s.App.MintKeeper.SetMinter(s.Ctx, minter)
+
+			// an external gauge distributes 1_000 per epoch to the shortest duration
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(mintParams.MintDenom, 10_000)))
+			_, err = s.App.IncentivesKeeper.CreateGauge(s.Ctx, notPerpetual, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(mintParams.MintDenom, 10_000)),
+				lockuptypes.QueryCondition{LockQueryType: lockuptypes.ByDuration, Denom: shareDenom, Duration: shortestDuration}, s.Ctx.BlockTime(), 10, 0)
+			s.Require().NoError(err)
+
+			year := osmomath.NewDec(int64(365 * 24 * time.Hour))
+			mintEpochsPerYear := year.QuoInt64(int64(s.App.EpochsKeeper.GetEpochInfo(s.Ctx, mintParams.EpochIdentifier).Duration))
+			incentivesEpochsPerYear := year.QuoInt64(int64(s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).Duration))
+			expectedInternalAPR := osmomath.NewDec(1_000).Mul(mintParams.GetDistributionTargetWeight(minttypes.DistributionTargetPoolIncentives)).Mul(mintEpochsPerYear).Quo(lockedValue)
+			expectedExternalAPR := osmomath.NewDec(1_000).Mul(incentivesEpochsPerYear).Quo(lockedValue)
+
+			res, err := s.queryClient.PoolAPR(context.Background(), &types.QueryPoolAPRRequest{PoolId: poolId})
+			s.Require().NoError(err)
+
+			s.Require().Equal(mintParams.MintDenom, res.QuoteDenom)
+			s.Require().Equal(tc.expectedTVL, res.TotalValueLocked)
+			s.Require().Equal(tc.expectedUnpriced, res.UnpricedDenoms)
+			s.Require().Len(res.Aprs, len(lockableDurations))
+			for i, apr := range res.Aprs {
+				s.Require().Equal(lockableDurations[i], apr.Duration)
+				s.Require().Equal(expectedExternalAPR, apr.ExternalApr)
+				if apr.Duration == longestDuration {
+					s.Require().Equal(expectedInternalAPR, apr.InternalApr)
+				} else {
+					s.Require().True(apr.InternalApr.IsZero())
+				}
+				s.Require().True(apr.UptimeApr.IsZero())
+				s.Require().Equal(apr.InternalApr.Add(apr.ExternalApr), apr.TotalApr)
+			}
+		})
+	}
+
+	s.Run("nonexistent pool", func() {
+		s.SetupTest()
+		_, err := s.queryClient.PoolAPR(context.Background(), &types.QueryPoolAPRRequest{PoolId: 1000})
+		s.Require().Error(err)
+	})
+}
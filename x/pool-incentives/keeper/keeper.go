@@ -35,9 +35,14 @@ type Keeper struct {
 	distrKeeper       types.DistrKeeper
 	poolmanagerKeeper types.PoolManagerKeeper
 	gammKeeper        types.GAMMKeeper
+	mintKeeper        types.MintKeeper
+	lockupKeeper      types.LockupKeeper
+	clKeeper          types.ConcentratedLiquidityKeeper
+	twapKeeper        types.TwapKeeper
+	protorevKeeper    types.ProtorevKeeper
 }
 
-func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, incentivesKeeper types.IncentivesKeeper, distrKeeper types.DistrKeeper, poolmanagerKeeper types.PoolManagerKeeper, epochKeeper types.EpochKeeper, gammKeeper types.GAMMKeeper) Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, incentivesKeeper types.IncentivesKeeper, distrKeeper types.DistrKeeper, poolmanagerKeeper types.PoolManagerKeeper, epochKeeper types.EpochKeeper, gammKeeper types.GAMMKeeper, mintKeeper types.MintKeeper, lockupKeeper types.LockupKeeper, clKeeper types.ConcentratedLiquidityKeeper, twapKeeper types.TwapKeeper, protorevKeeper types.ProtorevKeeper) Keeper {
 	// ensure pool-incentives module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
@@ -60,6 +65,11 @@ func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, acc
 		poolmanagerKeeper: poolmanagerKeeper,
 		epochKeeper:       epochKeeper,
 		gammKeeper:        gammKeeper,
+		mintKeeper:        mintKeeper,
+		lockupKeeper:      lockupKeeper,
+		clKeeper:          clKeeper,
+		twapKeeper:        twapKeeper,
+		protorevKeeper:    protorevKeeper,
 	}
 }
 
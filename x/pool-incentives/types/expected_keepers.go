@@ -6,9 +6,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	gammmigration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
 	incentivestypes "github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+	minttypes "github.com/osmosis-labs/osmosis/v26/x/mint/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
@@ -22,12 +25,14 @@ type AccountKeeper interface {
 // BankKeeper sends tokens across modules and is able to get account balances.
 type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
 // PoolManagerKeeper gets the pool interface from poolID.
 type PoolManagerKeeper interface {
 	GetNextPoolId(ctx sdk.Context) uint64
 	GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 }
 
 type GAMMKeeper interface {
@@ -39,6 +44,7 @@ type IncentivesKeeper interface {
 	CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64) (uint64, error)
 	GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*incentivestypes.Gauge, error)
 	GetGauges(ctx sdk.Context) []incentivestypes.Gauge
+	GetActiveGauges(ctx sdk.Context) []incentivestypes.Gauge
 	GetParams(ctx sdk.Context) incentivestypes.Params
 	GetEpochInfo(ctx sdk.Context) epochstypes.EpochInfo
 
@@ -56,6 +62,33 @@ type EpochKeeper interface {
 	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
 }
 
+// MintKeeper gets the inflation minted each epoch and the share of it that goes to pool incentives.
+type MintKeeper interface {
+	GetMinter(ctx sdk.Context) minttypes.Minter
+	GetParams(ctx sdk.Context) minttypes.Params
+}
+
+// LockupKeeper gets the amount of tokens locked for at least a duration.
+type LockupKeeper interface {
+	GetPeriodLocksAccumulation(ctx sdk.Context, query lockuptypes.QueryCondition) osmomath.Int
+}
+
+// ConcentratedLiquidityKeeper gets the uptime incentives of concentrated liquidity pools.
+type ConcentratedLiquidityKeeper interface {
+	GetAllIncentiveRecordsForPool(ctx sdk.Context, poolId uint64) ([]cltypes.IncentiveRecord, error)
+	GetParams(ctx sdk.Context) cltypes.Params
+}
+
+// TwapKeeper gets the TWAP prices incentives and liquidity are valued with.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
+
+// ProtorevKeeper gets the pools denoms are priced against the quote denom with.
+type ProtorevKeeper interface {
+	GetPoolForDenomPairNoOrder(ctx sdk.Context, tokenA, tokenB string) (uint64, error)
+}
+
 type SuperfluidKeeper interface {
 	GetAllMigrationInfo(ctx sdk.Context) (MigrationRecords, error)
 }
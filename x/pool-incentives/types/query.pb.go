@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -646,6 +647,171 @@ func (m *QueryExternalIncentiveGaugesResponse) GetData() []types.Gauge {
 	return nil
 }
 
+type QueryPoolAPRRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPoolAPRRequest) Reset()         { *m = QueryPoolAPRRequest{} }
+func (m *QueryPoolAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAPRRequest) ProtoMessage()    {}
+func (*QueryPoolAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9aea78c6f643155, []int{13}
+}
+func (m *QueryPoolAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAPRRequest.Merge(m, src)
+}
+func (m *QueryPoolAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAPRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolAPRRequest proto.InternalMessageInfo
+
+func (m *QueryPoolAPRRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolAPRResponse struct {
+	// Denom incentives and liquidity are valued in
+	QuoteDenom string `protobuf:"bytes,1,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// Value of the pool's liquidity
+	TotalValueLocked cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=total_value_locked,json=totalValueLocked,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_value_locked" yaml:"total_value_locked"`
+	// APRs for each lockable duration of the pool, or for each authorized
+	// uptime of concentrated liquidity pools, in ascending order
+	Aprs []DurationAPR `protobuf:"bytes,3,rep,name=aprs,proto3" json:"aprs"`
+	// Denoms of incentives or liquidity without a TWAP price, which are valued
+	// at zero
+	UnpricedDenoms []string `protobuf:"bytes,4,rep,name=unpriced_denoms,json=unpricedDenoms,proto3" json:"unpriced_denoms,omitempty" yaml:"unpriced_denoms"`
+}
+
+func (m *QueryPoolAPRResponse) Reset()         { *m = QueryPoolAPRResponse{} }
+func (m *QueryPoolAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAPRResponse) ProtoMessage()    {}
+func (*QueryPoolAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9aea78c6f643155, []int{14}
+}
+func (m *QueryPoolAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAPRResponse.Merge(m, src)
+}
+func (m *QueryPoolAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAPRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolAPRResponse proto.InternalMessageInfo
+
+func (m *QueryPoolAPRResponse) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryPoolAPRResponse) GetAprs() []DurationAPR {
+	if m != nil {
+		return m.Aprs
+	}
+	return nil
+}
+
+func (m *QueryPoolAPRResponse) GetUnpricedDenoms() []string {
+	if m != nil {
+		return m.UnpricedDenoms
+	}
+	return nil
+}
+
+// DurationAPR is the APR of the incentives earned by liquidity locked for, or
+// in range for, at least a duration. APRs are fractions, e.g. 0.1 is 10%.
+type DurationAPR struct {
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// APR of the pool incentives funded by inflation
+	InternalApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=internal_apr,json=internalApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"internal_apr" yaml:"internal_apr"`
+	// APR of the externally funded gauges
+	ExternalApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=external_apr,json=externalApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"external_apr" yaml:"external_apr"`
+	// APR of the concentrated liquidity uptime incentives
+	UptimeApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=uptime_apr,json=uptimeApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"uptime_apr" yaml:"uptime_apr"`
+	// Sum of the internal, external and uptime APRs
+	TotalApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=total_apr,json=totalApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_apr" yaml:"total_apr"`
+}
+
+func (m *DurationAPR) Reset()         { *m = DurationAPR{} }
+func (m *DurationAPR) String() string { return proto.CompactTextString(m) }
+func (*DurationAPR) ProtoMessage()    {}
+func (*DurationAPR) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9aea78c6f643155, []int{15}
+}
+func (m *DurationAPR) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DurationAPR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DurationAPR.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DurationAPR) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationAPR.Merge(m, src)
+}
+func (m *DurationAPR) XXX_Size() int {
+	return m.Size()
+}
+func (m *DurationAPR) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationAPR.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationAPR proto.InternalMessageInfo
+
+func (m *DurationAPR) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryGaugeIdsRequest)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsRequest")
 	proto.RegisterType((*QueryGaugeIdsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsResponse")
@@ -661,6 +827,9 @@ func init() {
 	proto.RegisterType((*QueryIncentivizedPoolsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryIncentivizedPoolsResponse")
 	proto.RegisterType((*QueryExternalIncentiveGaugesRequest)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesRequest")
 	proto.RegisterType((*QueryExternalIncentiveGaugesResponse)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesResponse")
+	proto.RegisterType((*QueryPoolAPRRequest)(nil), "osmosis.poolincentives.v1beta1.QueryPoolAPRRequest")
+	proto.RegisterType((*QueryPoolAPRResponse)(nil), "osmosis.poolincentives.v1beta1.QueryPoolAPRResponse")
+	proto.RegisterType((*DurationAPR)(nil), "osmosis.poolincentives.v1beta1.DurationAPR")
 }
 
 func init() {
@@ -668,65 +837,84 @@ func init() {
 }
 
 var fileDescriptor_c9aea78c6f643155 = []byte{
-	// 924 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x34, 0x21, 0x4d, 0x26, 0x12, 0xd4, 0x93, 0x40, 0x9c, 0x15, 0xac, 0xc3, 0xd0, 0x42,
-	0x4a, 0x95, 0x5d, 0x6a, 0xb7, 0x39, 0xb4, 0x01, 0x24, 0x37, 0x08, 0x45, 0xe2, 0x10, 0x56, 0x42,
-	0x48, 0x70, 0xb0, 0xd6, 0xde, 0xc9, 0x66, 0xc4, 0x7a, 0xc7, 0xf5, 0xac, 0x43, 0x03, 0xea, 0xa5,
-	0x12, 0x77, 0x10, 0x17, 0xce, 0x88, 0x9e, 0x39, 0xf1, 0x0f, 0x38, 0xf4, 0x46, 0x25, 0x2e, 0x5c,
-	0x30, 0x28, 0xe1, 0xc0, 0xd9, 0xbf, 0x00, 0xed, 0xec, 0x9b, 0xad, 0xbd, 0x6b, 0x7b, 0xed, 0x72,
-	0x5b, 0xef, 0x7b, 0xef, 0x7b, 0xdf, 0x37, 0xef, 0xcd, 0xb7, 0xc6, 0x6f, 0x0b, 0xd9, 0x16, 0x92,
-	0x4b, 0xbb, 0x23, 0x44, 0xc0, 0xc3, 0x16, 0x0b, 0x23, 0x7e, 0xca, 0xa4, 0x7d, 0x7a, 0xb3, 0xc9,
-	0x22, 0xf7, 0xa6, 0x7d, 0xbf, 0xc7, 0xba, 0x67, 0x56, 0xa7, 0x2b, 0x22, 0x41, 0x4c, 0xc8, 0xb5,
-	0x46, 0x73, 0x2d, 0xc8, 0x35, 0x36, 0x7c, 0xe1, 0x0b, 0x95, 0x6a, 0xc7, 0x4f, 0x49, 0x95, 0xf1,
-	0xaa, 0x2f, 0x84, 0x1f, 0x30, 0xdb, 0xed, 0x70, 0xdb, 0x0d, 0x43, 0x11, 0xb9, 0x11, 0x17, 0xa1,
-	0x84, 0xa8, 0x09, 0x51, 0xf5, 0xab, 0xd9, 0x3b, 0xb6, 0xbd, 0x5e, 0x57, 0x25, 0xe8, 0xb8, 0xe6,
-	0x37, 0xc4, 0xcd, 0x77, 0x7b, 0x3e, 0x83, 0xb8, 0x5d, 0xc0, 0x7f, 0x88, 0xa6, 0x2a, 0xa0, 0xf7,
-	0xf0, 0xc6, 0xc7, 0xb1, 0xa6, 0x0f, 0x63, 0x90, 0x43, 0x4f, 0x3a, 0xec, 0x7e, 0x8f, 0xc9, 0x88,
-	0xdc, 0xc0, 0x97, 0x63, 0x88, 0x06, 0xf7, 0xca, 0x68, 0x1b, 0xed, 0x2c, 0xd5, 0xc9, 0xa0, 0x5f,
-	0x79, 0xf1, 0xcc, 0x6d, 0x07, 0x77, 0x28, 0x04, 0xa8, 0xb3, 0x1c, 0x3f, 0x1d, 0x7a, 0xf4, 0x9b,
-	0x45, 0xfc, 0x72, 0x06, 0x45, 0x76, 0x44, 0x28, 0x19, 0xf9, 0x09, 0xe1, 0x4d, 0xc5, 0xaf, 0xc1,
-	0x3d, 0xd9, 0xf8, 0x92, 0x47, 0x27, 0x0d, 0xad, 0xa8, 0x8c, 0xb6, 0x17, 0x77, 0xd6, 0xaa, 0x87,
-	0xd6, 0xf4, 0x63, 0xb4, 0xc6, 0x02, 0x5b, 0xf0, 0xe2, 0x53, 0x1e, 0x9d, 0x1c, 0x00, 0x60, 0x9d,
-	0x0e, 0xfa, 0x15, 0x33, 0xa1, 0x38, 0xa1, 0x27, 0x75, 0x36, 0x7c, 0x40, 0x1a, 0xae, 0x34, 0x7e,
-	0x45, 0x78, 0x7d, 0x0c, 0x22, 0xb1, 0xf0, 0x8a, 0x46, 0x82, 0x63, 0x58, 0x1f, 0xf4, 0x2b, 0x2f,
-	0x8d, 0xf6, 0xa0, 0xce, 0x65, 0x00, 0x25, 0xef, 0xe3, 0x95, 0x54, 0xde, 0xa5, 0x6d, 0xb4, 0xb3,
-	0x56, 0xdd, 0xb2, 0x92, 0x89, 0x5a, 0x7a, 0xa2, 0x56, 0x4a, 0x77, 0xe5, 0x49, 0xbf, 0xb2, 0xf0,
-	0xc3, 0x5f, 0x15, 0xe4, 0xa4, 0x45, 0x64, 0x1f, 0x1b, 0x00, 0xab, 0x0f, 0xa2, 0xd1, 0x61, 0xdd,
-	0xf8, 0xd1, 0xf5, 0x59, 0x79, 0x71, 0x1b, 0xed, 0xac, 0x3a, 0xe5, 0xa4, 0x9b, 0x4e, 0x38, 0x4a,
-	0xe3, 0x74, 0x13, 0xc6, 0x70, 0xc0, 0x65, 0xd4, 0x3d, 0x0c, 0x8f, 0x05, 0x4c, 0x93, 0x3e, 0xc4,
-	0xaf, 0x64, 0x03, 0x30, 0xa0, 0x16, 0xc6, 0x5e, 0xfc, 0xb2, 0xc1, 0xc3, 0x63, 0xa1, 0x34, 0xae,
-	0x55, 0xaf, 0x17, 0x8d, 0x24, 0x85, 0xa9, 0x6f, 0xc5, 0x1a, 0x06, 0xfd, 0x4a, 0x29, 0x39, 0x92,
-	0x67, 0x50, 0xd4, 0x59, 0xf5, 0x74, 0x16, 0xdd, 0xc0, 0x44, 0xb5, 0x3f, 0x72, 0xbb, 0x6e, 0x5b,
-	0xaf, 0x18, 0xfd, 0x1c, 0xaf, 0x8f, 0xbc, 0x05, 0x46, 0x07, 0x78, 0xb9, 0xa3, 0xde, 0x00, 0x9b,
-	0x37, 0x8b, 0xd8, 0x24, 0xf5, 0xf5, 0xa5, 0x98, 0x8a, 0x03, 0xb5, 0xb4, 0x82, 0x5f, 0x53, 0xe0,
-	0x1f, 0x89, 0xd6, 0x17, 0x6e, 0x33, 0x60, 0xfa, 0xd4, 0xd3, 0xee, 0xdf, 0x21, 0x6c, 0x4e, 0xca,
-	0x00, 0x26, 0x02, 0x93, 0x00, 0x82, 0xe9, 0x06, 0x49, 0x58, 0xdb, 0x29, 0x73, 0xbd, 0x06, 0x67,
-	0xb2, 0x95, 0x9c, 0x49, 0x1e, 0x82, 0xaa, 0xa1, 0x97, 0x82, 0x6c, 0xe3, 0x94, 0xb4, 0x9e, 0x2d,
-	0xff, 0x8a, 0x79, 0x47, 0x42, 0x04, 0x29, 0xe9, 0x3f, 0x11, 0xbe, 0x92, 0x0d, 0xce, 0x75, 0x55,
-	0x49, 0x80, 0x4b, 0x39, 0x42, 0xc5, 0xab, 0x7a, 0x15, 0x24, 0x95, 0x27, 0x48, 0x4a, 0x14, 0x5d,
-	0xc9, 0x2a, 0x1a, 0xb9, 0x3f, 0x8b, 0xc5, 0xf7, 0x87, 0x3e, 0xd6, 0x43, 0x19, 0x73, 0x02, 0x30,
-	0x94, 0x47, 0x08, 0x13, 0x3e, 0x14, 0x6d, 0xc4, 0xc2, 0xf4, 0x54, 0xde, 0x29, 0xda, 0x95, 0x2c,
-	0x6e, 0xfd, 0xf5, 0xd1, 0x61, 0xe5, 0x91, 0xa9, 0x53, 0xe2, 0x59, 0x32, 0xf4, 0x1a, 0x7e, 0x43,
-	0xd1, 0xfc, 0xe0, 0x41, 0xc4, 0xba, 0xa1, 0x1b, 0xa4, 0x97, 0x51, 0x99, 0xc8, 0xd0, 0x86, 0x5f,
-	0x9d, 0x9e, 0x06, 0x9a, 0x6a, 0x78, 0xc9, 0x73, 0x23, 0x37, 0x5d, 0x2d, 0x2d, 0x62, 0x48, 0x80,
-	0xaa, 0x80, 0x1d, 0x57, 0xc9, 0xd5, 0xc7, 0xab, 0xf8, 0x05, 0x85, 0x4e, 0x7e, 0x41, 0x78, 0x45,
-	0x1b, 0x24, 0xb9, 0x35, 0xa7, 0x9f, 0x2a, 0xa6, 0xc6, 0xed, 0xe7, 0x72, 0x61, 0xba, 0xff, 0xe8,
-	0xf7, 0x7f, 0xbe, 0xbf, 0xb4, 0x47, 0x6e, 0x8d, 0x7c, 0x77, 0x76, 0xc7, 0x7c, 0x78, 0xd4, 0x84,
-	0x77, 0xb9, 0x27, 0xed, 0xaf, 0x61, 0x27, 0x1f, 0x92, 0x9f, 0x11, 0x5e, 0x4d, 0xad, 0x84, 0xcc,
-	0x46, 0x21, 0x6b, 0x6d, 0xc6, 0xde, 0xbc, 0x65, 0x40, 0xbd, 0xa6, 0xa8, 0xef, 0x92, 0x1b, 0x85,
-	0xd4, 0x9f, 0x99, 0x1a, 0xf9, 0x11, 0xe1, 0xe5, 0xc4, 0x6e, 0x48, 0x75, 0xa6, 0xbe, 0x23, 0x8e,
-	0x67, 0xd4, 0xe6, 0xaa, 0x01, 0xa2, 0xb6, 0x22, 0x7a, 0x9d, 0xbc, 0x55, 0x48, 0x34, 0xb1, 0x3e,
-	0xf2, 0x1b, 0xc2, 0xa5, 0x9c, 0xa9, 0x91, 0x77, 0x67, 0xea, 0x3d, 0xc9, 0x2e, 0x8d, 0xf7, 0x9e,
-	0xb7, 0x1c, 0x54, 0xdc, 0x55, 0x2a, 0x6e, 0x93, 0x5a, 0xa1, 0x8a, 0xbc, 0x5f, 0x2a, 0x45, 0x39,
-	0x47, 0x98, 0x51, 0xd1, 0x24, 0x2f, 0x9d, 0x51, 0xd1, 0x44, 0x23, 0x9a, 0x43, 0x51, 0xde, 0x54,
-	0xc8, 0xbf, 0x08, 0x6f, 0x4e, 0x70, 0x05, 0x72, 0x6f, 0x26, 0x62, 0xd3, 0xad, 0xc7, 0x38, 0xf8,
-	0x7f, 0x20, 0xa0, 0xb1, 0xae, 0x34, 0xee, 0x93, 0x3b, 0x85, 0x1a, 0x19, 0x20, 0x0d, 0xfd, 0x71,
-	0x51, 0x57, 0x5e, 0xd6, 0x3f, 0x79, 0x72, 0x6e, 0xa2, 0xa7, 0xe7, 0x26, 0xfa, 0xfb, 0xdc, 0x44,
-	0xdf, 0x5e, 0x98, 0x0b, 0x4f, 0x2f, 0xcc, 0x85, 0x3f, 0x2e, 0xcc, 0x85, 0xcf, 0xee, 0xfa, 0x3c,
-	0x3a, 0xe9, 0x35, 0xad, 0x96, 0x68, 0x6b, 0xfc, 0xdd, 0xc0, 0x6d, 0xca, 0xb4, 0xd9, 0x69, 0x75,
-	0xcf, 0x7e, 0x90, 0x6b, 0x19, 0x9d, 0x75, 0x98, 0x6c, 0x2e, 0xab, 0xaf, 0x54, 0xed, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xe4, 0xad, 0xff, 0x04, 0xb2, 0x0b, 0x00, 0x00,
+	// 1223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x9b, 0x6c, 0xdb, 0x4c, 0xd0, 0x6e, 0x3b, 0x2d, 0x6d, 0x6a, 0x20, 0x29, 0xc3, 0x2e,
+	0x74, 0xa9, 0x6a, 0xb3, 0x69, 0xb7, 0x8b, 0xba, 0x65, 0xa1, 0x6e, 0x56, 0xa8, 0x52, 0x0f, 0xc5,
+	0x02, 0x56, 0x02, 0x21, 0xcb, 0x89, 0xa7, 0xae, 0xb5, 0x8e, 0xc7, 0xb5, 0x9d, 0xd2, 0x82, 0xf6,
+	0xb2, 0x12, 0x77, 0x10, 0x17, 0xce, 0x08, 0xce, 0x88, 0x03, 0x27, 0xae, 0x1c, 0x56, 0xe2, 0xc0,
+	0x4a, 0x5c, 0x00, 0x89, 0x80, 0x5a, 0x0e, 0x9c, 0xf3, 0x0b, 0x90, 0xc7, 0x33, 0x8e, 0x93, 0x34,
+	0x75, 0x12, 0x6e, 0xf6, 0xbc, 0x79, 0xdf, 0x7c, 0xdf, 0x7b, 0x6f, 0xe6, 0x3d, 0xf0, 0x2a, 0xf1,
+	0xeb, 0xc4, 0xb7, 0x7c, 0xd9, 0x25, 0xc4, 0xb6, 0x9c, 0x1a, 0x76, 0x02, 0xeb, 0x18, 0xfb, 0xf2,
+	0xf1, 0xad, 0x2a, 0x0e, 0xf4, 0x5b, 0xf2, 0x51, 0x03, 0x7b, 0xa7, 0x92, 0xeb, 0x91, 0x80, 0xc0,
+	0x22, 0xdb, 0x2b, 0x75, 0xee, 0x95, 0xd8, 0x5e, 0x71, 0xce, 0x24, 0x26, 0xa1, 0x5b, 0xe5, 0xf0,
+	0x2b, 0xf2, 0x12, 0x9f, 0x37, 0x09, 0x31, 0x6d, 0x2c, 0xeb, 0xae, 0x25, 0xeb, 0x8e, 0x43, 0x02,
+	0x3d, 0xb0, 0x88, 0xe3, 0x33, 0x6b, 0x91, 0x59, 0xe9, 0x5f, 0xb5, 0x71, 0x20, 0x1b, 0x0d, 0x8f,
+	0x6e, 0xe0, 0x76, 0xce, 0x2f, 0xc1, 0xcd, 0xd4, 0x1b, 0x26, 0x66, 0x76, 0x39, 0x85, 0x7f, 0x82,
+	0x26, 0x75, 0x40, 0x3b, 0x60, 0xee, 0x9d, 0x50, 0xd3, 0xdb, 0x21, 0xc8, 0xae, 0xe1, 0xab, 0xf8,
+	0xa8, 0x81, 0xfd, 0x00, 0xae, 0x80, 0xc9, 0x10, 0x42, 0xb3, 0x8c, 0x82, 0xb0, 0x24, 0x2c, 0x67,
+	0x15, 0xd8, 0x6a, 0x96, 0xae, 0x9e, 0xea, 0x75, 0x7b, 0x13, 0x31, 0x03, 0x52, 0x27, 0xc2, 0xaf,
+	0x5d, 0x03, 0x7d, 0x96, 0x01, 0xcf, 0x76, 0xa1, 0xf8, 0x2e, 0x71, 0x7c, 0x0c, 0xbf, 0x11, 0xc0,
+	0x02, 0xe5, 0xa7, 0x59, 0x86, 0xaf, 0x7d, 0x6c, 0x05, 0x87, 0x1a, 0x57, 0x54, 0x10, 0x96, 0x32,
+	0xcb, 0xf9, 0xf2, 0xae, 0x74, 0x79, 0x18, 0xa5, 0x0b, 0x81, 0x25, 0xb6, 0xf0, 0xc0, 0x0a, 0x0e,
+	0x2b, 0x0c, 0x50, 0x41, 0xad, 0x66, 0xa9, 0x18, 0x51, 0xec, 0x73, 0x26, 0x52, 0xe7, 0x4c, 0x86,
+	0x94, 0xf4, 0x14, 0x7f, 0x12, 0xc0, 0xec, 0x05, 0x88, 0x50, 0x02, 0x53, 0x1c, 0x89, 0x85, 0x61,
+	0xb6, 0xd5, 0x2c, 0x5d, 0xeb, 0x3c, 0x03, 0xa9, 0x93, 0x0c, 0x14, 0xbe, 0x09, 0xa6, 0x62, 0x79,
+	0xe3, 0x4b, 0xc2, 0x72, 0xbe, 0xbc, 0x28, 0x45, 0x19, 0x95, 0x78, 0x46, 0xa5, 0x98, 0xee, 0xd4,
+	0x93, 0x66, 0x69, 0xec, 0xab, 0xbf, 0x4a, 0x82, 0x1a, 0x3b, 0xc1, 0x2d, 0x20, 0x32, 0x58, 0x1e,
+	0x08, 0xcd, 0xc5, 0x5e, 0xf8, 0xa9, 0x9b, 0xb8, 0x90, 0x59, 0x12, 0x96, 0x73, 0x6a, 0x21, 0x3a,
+	0x8d, 0x6f, 0xd8, 0x8f, 0xed, 0x68, 0x81, 0xa5, 0xa1, 0x62, 0xf9, 0x81, 0xb7, 0xeb, 0x1c, 0x10,
+	0x96, 0x4d, 0xf4, 0x08, 0xcc, 0x77, 0x1b, 0x58, 0x82, 0x6a, 0x00, 0x18, 0xe1, 0xa2, 0x66, 0x39,
+	0x07, 0x84, 0x6a, 0xcc, 0x97, 0x6f, 0xa6, 0xa5, 0x24, 0x86, 0x51, 0x16, 0x43, 0x0d, 0xad, 0x66,
+	0x69, 0x26, 0x0a, 0x49, 0x1b, 0x0a, 0xa9, 0x39, 0x83, 0xef, 0x42, 0x73, 0x00, 0xd2, 0xe3, 0xf7,
+	0x75, 0x4f, 0xaf, 0xf3, 0x12, 0x43, 0x1f, 0x82, 0xd9, 0x8e, 0x55, 0xc6, 0xa8, 0x02, 0x26, 0x5c,
+	0xba, 0xc2, 0xd8, 0xbc, 0x9c, 0xc6, 0x26, 0xf2, 0x57, 0xb2, 0x21, 0x15, 0x95, 0xf9, 0xa2, 0x12,
+	0x78, 0x81, 0x82, 0xef, 0x91, 0xda, 0x43, 0xbd, 0x6a, 0x63, 0x1e, 0xf5, 0xf8, 0xf4, 0x2f, 0x04,
+	0x50, 0xec, 0xb7, 0x83, 0x31, 0x21, 0x00, 0xda, 0xcc, 0x18, 0x57, 0x90, 0xcf, 0xca, 0xf6, 0x92,
+	0xbc, 0xde, 0x60, 0x31, 0x59, 0x8c, 0x62, 0xd2, 0x0b, 0x81, 0x68, 0xd2, 0x67, 0xec, 0xee, 0x83,
+	0x63, 0xd2, 0x3c, 0xb7, 0xd6, 0x27, 0xd8, 0xd8, 0x27, 0xc4, 0x8e, 0x49, 0xff, 0x29, 0x80, 0xe9,
+	0x6e, 0xe3, 0x50, 0x57, 0x15, 0xda, 0x60, 0xa6, 0x87, 0x50, 0x7a, 0xa9, 0x5e, 0x67, 0x92, 0x0a,
+	0x7d, 0x24, 0x45, 0x8a, 0xa6, 0xbb, 0x15, 0x75, 0xdc, 0x9f, 0x4c, 0xfa, 0xfd, 0x41, 0xdf, 0xf2,
+	0xa4, 0x5c, 0x10, 0x01, 0x96, 0x94, 0xc7, 0x02, 0x80, 0x56, 0xc2, 0xaa, 0x85, 0xc2, 0x78, 0x56,
+	0x5e, 0x4b, 0xab, 0x95, 0x6e, 0x5c, 0xe5, 0xc5, 0xce, 0x64, 0xf5, 0x22, 0x23, 0x75, 0xc6, 0xea,
+	0x26, 0x83, 0x6e, 0x80, 0x97, 0x28, 0xcd, 0xfb, 0x27, 0x01, 0xf6, 0x1c, 0xdd, 0x8e, 0x2f, 0x23,
+	0x7d, 0x44, 0x12, 0x15, 0x7e, 0xfd, 0xf2, 0x6d, 0x4c, 0xd3, 0x1a, 0xc8, 0x1a, 0x7a, 0xa0, 0xc7,
+	0xa5, 0xc5, 0x45, 0x24, 0x04, 0x50, 0x0f, 0x56, 0xe3, 0x74, 0x33, 0x52, 0xf8, 0xf5, 0x21, 0xc4,
+	0xde, 0xde, 0x57, 0x47, 0x7a, 0xb8, 0x7f, 0x1e, 0x07, 0x73, 0x9d, 0x20, 0x8c, 0xd1, 0x1d, 0x90,
+	0x3f, 0x6a, 0x90, 0x00, 0x6b, 0x06, 0x76, 0x48, 0x9d, 0x22, 0xe5, 0x94, 0xf9, 0x56, 0xb3, 0x04,
+	0x23, 0xa4, 0x84, 0x11, 0xa9, 0x80, 0xfe, 0x55, 0xc2, 0x1f, 0xe8, 0x00, 0x18, 0x90, 0x40, 0xb7,
+	0xb5, 0x63, 0xdd, 0x6e, 0x60, 0x2d, 0xac, 0x08, 0x6c, 0xd0, 0x02, 0xcb, 0x29, 0x6f, 0x85, 0xec,
+	0xff, 0x68, 0x96, 0x9e, 0xab, 0x51, 0x81, 0xbe, 0xf1, 0x50, 0xb2, 0x88, 0x5c, 0xd7, 0x83, 0x43,
+	0x69, 0x0f, 0x9b, 0x7a, 0xed, 0xb4, 0x82, 0x6b, 0xed, 0x54, 0xf4, 0xc2, 0x20, 0x75, 0x9a, 0x2e,
+	0xbe, 0x1f, 0xae, 0xed, 0xd1, 0x25, 0x78, 0x1f, 0x64, 0x75, 0xd7, 0xf3, 0x0b, 0x19, 0x1a, 0xba,
+	0x95, 0xd4, 0x97, 0x8b, 0x55, 0xe6, 0xf6, 0xbe, 0xca, 0x83, 0x19, 0xba, 0xc3, 0x1d, 0x70, 0xad,
+	0xe1, 0xb8, 0x9e, 0x55, 0xc3, 0x46, 0xa4, 0xca, 0x2f, 0x64, 0x97, 0x32, 0xcb, 0x39, 0x45, 0x6c,
+	0x35, 0x4b, 0xf3, 0x11, 0xa1, 0xae, 0x0d, 0x48, 0xbd, 0xca, 0x57, 0x2a, 0xd1, 0xc2, 0x8f, 0x19,
+	0x90, 0x4f, 0x1c, 0xd0, 0xd1, 0x0d, 0x84, 0x51, 0xba, 0xc1, 0x47, 0xe0, 0x19, 0xcb, 0x89, 0x4a,
+	0x47, 0xd3, 0x5d, 0x8f, 0x85, 0x71, 0x73, 0xb0, 0x30, 0xce, 0xf2, 0x8a, 0x6e, 0x03, 0x20, 0x35,
+	0xcf, 0x7f, 0xb7, 0x5d, 0x2f, 0x84, 0xc7, 0x27, 0x09, 0xf8, 0xcc, 0x08, 0xf0, 0x49, 0x00, 0xa4,
+	0xe6, 0xf1, 0x49, 0x1b, 0xfe, 0x01, 0x00, 0x0d, 0x37, 0xb0, 0xea, 0x98, 0x82, 0x67, 0x29, 0xf8,
+	0xeb, 0x83, 0x81, 0xb3, 0x76, 0xd2, 0x76, 0x47, 0x6a, 0x2e, 0xfa, 0x09, 0x81, 0xdf, 0x05, 0xb9,
+	0xa8, 0x38, 0x42, 0xdc, 0x2b, 0x14, 0xf7, 0xce, 0x60, 0xb8, 0xd3, 0xc9, 0xd2, 0xa2, 0xb0, 0x53,
+	0xf4, 0x7b, 0xdb, 0xf5, 0xca, 0xbf, 0x03, 0x70, 0x85, 0xde, 0x05, 0xf8, 0x83, 0x00, 0xa6, 0xf8,
+	0xc0, 0x01, 0xd7, 0x87, 0x9c, 0x4f, 0xe8, 0x2d, 0x14, 0x6f, 0x8f, 0x34, 0xd5, 0xa0, 0xad, 0xc7,
+	0xbf, 0xfe, 0xf3, 0xe5, 0xf8, 0x06, 0x5c, 0xef, 0x98, 0xe3, 0x56, 0x2f, 0x18, 0xe4, 0xe8, 0x8b,
+	0xb9, 0x6a, 0x19, 0xbe, 0xfc, 0x29, 0xbb, 0xd5, 0x8f, 0xe0, 0x77, 0x02, 0xc8, 0xc5, 0xad, 0x19,
+	0x0e, 0x46, 0xa1, 0x7b, 0x54, 0x10, 0x37, 0x86, 0x75, 0x63, 0xd4, 0xd7, 0x28, 0xf5, 0x55, 0xb8,
+	0x92, 0x4a, 0xbd, 0x3d, 0x24, 0xc0, 0xaf, 0x05, 0x30, 0x11, 0xb5, 0x6f, 0x58, 0x1e, 0xe8, 0xdc,
+	0x8e, 0x09, 0x42, 0x5c, 0x1b, 0xca, 0x87, 0x11, 0x95, 0x29, 0xd1, 0x9b, 0xf0, 0x95, 0x54, 0xa2,
+	0xd1, 0x28, 0x01, 0x7f, 0x11, 0xc0, 0x4c, 0xcf, 0x90, 0x00, 0xdf, 0x18, 0xe8, 0xec, 0x7e, 0xe3,
+	0x87, 0x78, 0x6f, 0x54, 0x77, 0xa6, 0xe2, 0x2e, 0x55, 0x71, 0x1b, 0xae, 0xa5, 0xaa, 0xe8, 0x9d,
+	0x3f, 0xa8, 0xa2, 0x9e, 0x0e, 0x3b, 0xa0, 0xa2, 0x7e, 0xb3, 0x89, 0x78, 0x6f, 0x54, 0xf7, 0xa1,
+	0x15, 0xf5, 0x36, 0x69, 0xf8, 0xaf, 0x00, 0x16, 0xfa, 0x74, 0x59, 0xb8, 0x33, 0x10, 0xb1, 0xcb,
+	0x5b, 0xb9, 0x58, 0xf9, 0x7f, 0x20, 0x4c, 0xa3, 0x42, 0x35, 0x6e, 0xc1, 0xcd, 0x54, 0x8d, 0xf1,
+	0xbb, 0x1a, 0xdb, 0x34, 0x33, 0x92, 0xf3, 0xbd, 0x00, 0x26, 0x59, 0xbb, 0x86, 0x03, 0x5e, 0x80,
+	0x8e, 0x09, 0x41, 0x5c, 0x1f, 0xce, 0x69, 0xe8, 0xf4, 0x84, 0xeb, 0xe1, 0xe3, 0xda, 0x7e, 0x99,
+	0x94, 0xf7, 0x9e, 0x9c, 0x15, 0x85, 0xa7, 0x67, 0x45, 0xe1, 0xef, 0xb3, 0xa2, 0xf0, 0xf9, 0x79,
+	0x71, 0xec, 0xe9, 0x79, 0x71, 0xec, 0xb7, 0xf3, 0xe2, 0xd8, 0x07, 0x77, 0x4d, 0x2b, 0x38, 0x6c,
+	0x54, 0xa5, 0x1a, 0xa9, 0x73, 0xe0, 0x55, 0x5b, 0xaf, 0xfa, 0xf1, 0x29, 0xc7, 0xe5, 0x0d, 0xf9,
+	0xa4, 0xe7, 0xac, 0xe0, 0xd4, 0xc5, 0x7e, 0x75, 0x82, 0xb6, 0xd1, 0xb5, 0xff, 0x06, 0x00, 0xd2,
+	0xf0, 0x0c, 0xe4, 0xb6, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -753,6 +941,10 @@ type QueryClient interface {
 	IncentivizedPools(ctx context.Context, in *QueryIncentivizedPoolsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPoolsResponse, error)
 	// ExternalIncentiveGauges returns external incentive gauges.
 	ExternalIncentiveGauges(ctx context.Context, in *QueryExternalIncentiveGaugesRequest, opts ...grpc.CallOption) (*QueryExternalIncentiveGaugesResponse, error)
+	// PoolAPR returns the APR of the incentives of a pool for each duration,
+	// combining internal incentives, external gauges and concentrated liquidity
+	// uptime incentives, valued with TWAP prices.
+	PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error)
 }
 
 type queryClient struct {
@@ -817,6 +1009,15 @@ func (c *queryClient) ExternalIncentiveGauges(ctx context.Context, in *QueryExte
 	return out, nil
 }
 
+func (c *queryClient) PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error) {
+	out := new(QueryPoolAPRResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/PoolAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GaugeIds takes the pool id and returns the matching gauge ids and durations
@@ -831,6 +1032,10 @@ type QueryServer interface {
 	IncentivizedPools(context.Context, *QueryIncentivizedPoolsRequest) (*QueryIncentivizedPoolsResponse, error)
 	// ExternalIncentiveGauges returns external incentive gauges.
 	ExternalIncentiveGauges(context.Context, *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error)
+	// PoolAPR returns the APR of the incentives of a pool for each duration,
+	// combining internal incentives, external gauges and concentrated liquidity
+	// uptime incentives, valued with TWAP prices.
+	PoolAPR(context.Context, *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -855,6 +1060,9 @@ func (*UnimplementedQueryServer) IncentivizedPools(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) ExternalIncentiveGauges(ctx context.Context, req *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExternalIncentiveGauges not implemented")
 }
+func (*UnimplementedQueryServer) PoolAPR(ctx context.Context, req *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAPR not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -968,6 +1176,25 @@ func _Query_ExternalIncentiveGauges_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolincentives.v1beta1.Query/PoolAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAPR(ctx, req.(*QueryPoolAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolincentives.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -996,6 +1223,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExternalIncentiveGauges",
 			Handler:    _Query_ExternalIncentiveGauges_Handler,
 		},
+		{
+			MethodName: "PoolAPR",
+			Handler:    _Query_PoolAPR_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolincentives/v1beta1/query.proto",
@@ -1440,6 +1671,168 @@ func (m *QueryExternalIncentiveGaugesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPoolAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnpricedDenoms) > 0 {
+		for iNdEx := len(m.UnpricedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnpricedDenoms[iNdEx])
+			copy(dAtA[i:], m.UnpricedDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.UnpricedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Aprs) > 0 {
+		for iNdEx := len(m.Aprs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aprs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalValueLocked.Size()
+		i -= size
+		if _, err := m.TotalValueLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DurationAPR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DurationAPR) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DurationAPR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalApr.Size()
+		i -= size
+		if _, err := m.TotalApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.UptimeApr.Size()
+		i -= size
+		if _, err := m.UptimeApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExternalApr.Size()
+		i -= size
+		if _, err := m.ExternalApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InternalApr.Size()
+		i -= size
+		if _, err := m.InternalApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1625,21 +2018,79 @@ func (m *QueryExternalIncentiveGaugesResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryPoolAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
 }
-func (m *QueryGaugeIdsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryPoolAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalValueLocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Aprs) > 0 {
+		for _, e := range m.Aprs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnpricedDenoms) > 0 {
+		for _, s := range m.UnpricedDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DurationAPR) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InternalApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExternalApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UptimeApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGaugeIdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -2707,6 +3158,476 @@ func (m *QueryExternalIncentiveGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPoolAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValueLocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValueLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aprs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aprs = append(m.Aprs, DurationAPR{})
+			if err := m.Aprs[len(m.Aprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpricedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnpricedDenoms = append(m.UnpricedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DurationAPR) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DurationAPR: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DurationAPR: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InternalApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UptimeApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,130 @@
+package poolmanager
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// SetCanonicalRoute registers the given route as the canonical route pricing its denom in its quote denom,
+// replacing any previously registered route of the denom pair.
+// A route with no hops removes the denom pair's canonical route.
+// Returns error if:
+// - any pool of the route does not exist.
+// - any pool does not contain its hop's token in or token out.
+// - any pool holds less than the canonical route min pool liquidity of its hop's token in or token out.
+func (k Keeper) SetCanonicalRoute(ctx sdk.Context, route types.CanonicalRoute) error {
+	if len(route.Route) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.FormatCanonicalRouteKey(route.Denom, route.QuoteDenom))
+		return nil
+	}
+
+	var minLiquidity osmomath.Int
+	k.paramSpace.Get(ctx, types.KeyCanonicalRouteMinPoolLiquidity, &minLiquidity)
+
+	tokenInDenom := route.Denom
+	for _, hop := range route.Route {
+		poolDenoms, err := k.RouteGetPoolDenoms(ctx, hop.PoolId)
+		if err != nil {
+			return err
+		}
+		liquidity, err := k.GetTotalPoolLiquidity(ctx, hop.PoolId)
+		if err != nil {
+			return err
+		}
+
+		for _, denom := range []string{tokenInDenom, hop.TokenOutDenom} {
+			if !slices.Contains(poolDenoms, denom) {
+				return types.InvalidCanonicalRouteError{Denom: route.Denom, QuoteDenom: route.QuoteDenom, Reason: fmt.Sprintf("pool %d does not contain %s", hop.PoolId, denom)}
+			}
+			if amount := liquidity.AmountOf(denom); amount.LT(minLiquidity) {
+				return types.CanonicalRouteInsufficientLiquidityError{PoolId: hop.PoolId, Denom: denom, Liquidity: amount, MinLiquidity: minLiquidity}
+			}
+		}
+
+		tokenInDenom = hop.TokenOutDenom
+	}
+
+	k.setCanonicalRoute(ctx, route)
+	return nil
+}
+
+func (k Keeper) setCanonicalRoute(ctx sdk.Context, route types.CanonicalRoute) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatCanonicalRouteKey(route.Denom, route.QuoteDenom), &route)
+}
+
+// GetCanonicalRoute returns the canonical route pricing denom in quoteDenom.
+// Returns false if no route has been registered for the denom pair.
+func (k Keeper) GetCanonicalRoute(ctx sdk.Context, denom, quoteDenom string) ([]types.SwapAmountInRoute, bool) {
+	route := types.CanonicalRoute{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatCanonicalRouteKey(denom, quoteDenom), &route)
+	if err != nil || !found {
+		return nil, false
+	}
+	return route.Route, true
+}
+
+// GetAllCanonicalRoutes returns all canonical routes.
+func (k Keeper) GetAllCanonicalRoutes(ctx sdk.Context) ([]types.CanonicalRoute, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyCanonicalRoutePrefix, func(bz []byte) (types.CanonicalRoute, error) {
+		route := types.CanonicalRoute{}
+		err := route.Unmarshal(bz)
+		return route, err
+	})
+}
+
+// CanonicalRouteSpotPrice returns the spot price of denom in quoteDenom through the denom pair's canonical route,
+// as the product of the spot prices of its hops. Fees are not accounted for.
+// Returns a CanonicalRouteNotFoundError if no route has been registered for the denom pair.
+func (k Keeper) CanonicalRouteSpotPrice(ctx sdk.Context, denom, quoteDenom string) (osmomath.BigDec, error) {
+	route, found := k.GetCanonicalRoute(ctx, denom, quoteDenom)
+	if !found {
+		return osmomath.BigDec{}, types.CanonicalRouteNotFoundError{Denom: denom, QuoteDenom: quoteDenom}
+	}
+
+	spotPrice := osmomath.OneBigDec()
+	tokenInDenom := denom
+	for _, hop := range route {
+		hopSpotPrice, err := k.RouteCalculateSpotPrice(ctx, hop.PoolId, hop.TokenOutDenom, tokenInDenom)
+		if err != nil {
+			return osmomath.BigDec{}, err
+		}
+		spotPrice = spotPrice.MulMut(hopSpotPrice)
+		tokenInDenom = hop.TokenOutDenom
+	}
+	return spotPrice, nil
+}
+
+// CanonicalRouteArithmeticTwapToNow returns the arithmetic TWAP of denom in quoteDenom from startTime until now
+// through the denom pair's canonical route, as the product of the arithmetic TWAPs of its hops.
+// Returns a CanonicalRouteNotFoundError if no route has been registered for the denom pair,
+// and an error if any hop's pool has no TWAP over the window.
+func (k Keeper) CanonicalRouteArithmeticTwapToNow(ctx sdk.Context, denom, quoteDenom string, startTime time.Time) (osmomath.Dec, error) {
+	if k.twapKeeper == nil {
+		return osmomath.Dec{}, errors.New("twap keeper is not set")
+	}
+
+	route, found := k.GetCanonicalRoute(ctx, denom, quoteDenom)
+	if !found {
+		return osmomath.Dec{}, types.CanonicalRouteNotFoundError{Denom: denom, QuoteDenom: quoteDenom}
+	}
+
+	twap := osmomath.OneDec()
+	tokenInDenom := denom
+	for _, hop := range route {
+		hopTwap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom, startTime)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		twap = twap.Mul(hopTwap)
+		tokenInDenom = hop.TokenOutDenom
+	}
+	return twap, nil
+}
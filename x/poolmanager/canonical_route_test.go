@@ -0,0 +1,112 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestSetCanonicalRoute() {
+	tests := map[string]struct {
+		quoteDenom  string
+		route       func(fooBarPoolId, barBazPoolId, shallowPoolId uint64) []types.SwapAmountInRoute
+		expectedErr error
+	}{
+		"single hop": {
+			quoteDenom: "bar",
+			route: func(fooBarPoolId, _, _ uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: fooBarPoolId, TokenOutDenom: "bar"}}
+			},
+		},
+		"two hops": {
+			quoteDenom: "baz",
+			route: func(fooBarPoolId, barBazPoolId, _ uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: fooBarPoolId, TokenOutDenom: "bar"}, {PoolId: barBazPoolId, TokenOutDenom: "baz"}}
+			},
+		},
+		"pool does not contain the hop's token in": {
+			quoteDenom: "baz",
+			route: func(_, barBazPoolId, _ uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: barBazPoolId, TokenOutDenom: "baz"}}
+			},
+			expectedErr: types.InvalidCanonicalRouteError{Denom: "foo", QuoteDenom: "baz", Reason: "pool 2 does not contain foo"},
+		},
+		"pool below the min liquidity": {
+			quoteDenom: "baz",
+			route: func(_, _, shallowPoolId uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: shallowPoolId, TokenOutDenom: "baz"}}
+			},
+			expectedErr: types.CanonicalRouteInsufficientLiquidityError{PoolId: 3, Denom: "foo", Liquidity: osmomath.NewInt(1_000_000), MinLiquidity: types.DefaultCanonicalRouteMinPoolLiquidity},
+		},
+		"pool does not exist": {
+			quoteDenom: "baz",
+			route: func(_, _, _ uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: 100, TokenOutDenom: "baz"}}
+			},
+			expectedErr: types.FailedToFindRouteError{PoolId: 100},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			fooBarPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("foo", osmomath.NewInt(100_000_000_000)), sdk.NewCoin("bar", osmomath.NewInt(200_000_000_000)))
+			barBazPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("bar", osmomath.NewInt(100_000_000_000)), sdk.NewCoin("baz", osmomath.NewInt(300_000_000_000)))
+			shallowPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("foo", osmomath.NewInt(1_000_000)), sdk.NewCoin("baz", osmomath.NewInt(1_000_000)))
+
+			route := types.CanonicalRoute{Denom: "foo", QuoteDenom: tc.quoteDenom, Route: tc.route(fooBarPoolId, barBazPoolId, shallowPoolId)}
+
+			err := s.App.PoolManagerKeeper.SetCanonicalRoute(s.Ctx, route)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				_, found := s.App.PoolManagerKeeper.GetCanonicalRoute(s.Ctx, route.Denom, route.QuoteDenom)
+				s.Require().False(found)
+				return
+			}
+			s.Require().NoError(err)
+
+			storedRoute, found := s.App.PoolManagerKeeper.GetCanonicalRoute(s.Ctx, route.Denom, route.QuoteDenom)
+			s.Require().True(found)
+			s.Require().Equal(route.Route, storedRoute)
+
+			routes, err := s.App.PoolManagerKeeper.GetAllCanonicalRoutes(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.CanonicalRoute{route}, routes)
+
+			// A route with no hops removes the denom pair's canonical route.
+			err = s.App.PoolManagerKeeper.SetCanonicalRoute(s.Ctx, types.CanonicalRoute{Denom: route.Denom, QuoteDenom: route.QuoteDenom})
+			s.Require().NoError(err)
+			_, found = s.App.PoolManagerKeeper.GetCanonicalRoute(s.Ctx, route.Denom, route.QuoteDenom)
+			s.Require().False(found)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCanonicalRoutePrices() {
+	s.SetupTest()
+	fooBarPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("foo", osmomath.NewInt(100_000_000_000)), sdk.NewCoin("bar", osmomath.NewInt(200_000_000_000)))
+	barBazPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("bar", osmomath.NewInt(100_000_000_000)), sdk.NewCoin("baz", osmomath.NewInt(300_000_000_000)))
+
+	_, err := s.App.PoolManagerKeeper.CanonicalRouteSpotPrice(s.Ctx, "foo", "baz")
+	s.Require().ErrorIs(err, types.CanonicalRouteNotFoundError{Denom: "foo", QuoteDenom: "baz"})
+
+	err = s.App.PoolManagerKeeper.SetCanonicalRoute(s.Ctx, types.CanonicalRoute{
+		Denom:      "foo",
+		QuoteDenom: "baz",
+		Route:      []types.SwapAmountInRoute{{PoolId: fooBarPoolId, TokenOutDenom: "bar"}, {PoolId: barBazPoolId, TokenOutDenom: "baz"}},
+	})
+	s.Require().NoError(err)
+
+	// foo is worth 2 bar, and bar is worth 3 baz.
+	spotPrice, err := s.App.PoolManagerKeeper.CanonicalRouteSpotPrice(s.Ctx, "foo", "baz")
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewBigDec(6), spotPrice)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+	twap, err := s.App.PoolManagerKeeper.CanonicalRouteArithmeticTwapToNow(s.Ctx, "foo", "baz", s.Ctx.BlockTime().Add(-30*time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDec(6), twap)
+}
@@ -213,6 +213,32 @@ func TestGetCmdEstimateSwapExactAmountIn(t *testing.T) {
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
 
+func TestGetCmdRouteSpotPrice(t *testing.T) {
+	desc, _ := cli.GetCmdRouteSpotPrice()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.RouteSpotPriceRequest]{
+		"basic test": {
+			Cmd: "stake --swap-route-pool-ids=2,3 --swap-route-denoms=node0token,uosmo",
+			ExpectedQuery: &queryproto.RouteSpotPriceRequest{
+				TokenInDenom:        "stake",
+				RoutesPoolId:        []uint64{2, 3},
+				RoutesTokenOutDenom: []string{"node0token", "uosmo"},
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdEstimateRouteGas(t *testing.T) {
+	desc, _ := cli.GetCmdEstimateRouteGas()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.EstimateRouteGasRequest]{
+		"basic test": {
+			Cmd:           "2,3",
+			ExpectedQuery: &queryproto.EstimateRouteGasRequest{RoutesPoolId: []uint64{2, 3}},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func TestGetCmdEstimateSwapExactAmountOut(t *testing.T) {
 	desc, _ := cli.GetCmdEstimateSwapExactAmountOut()
 	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.EstimateSwapExactAmountOutRequest]{
@@ -26,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSpotPrice)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRouteSpotPrice)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolTypeGasCosts)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateRouteGas)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalPoolLiquidity)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPool)
@@ -40,6 +43,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromDenom)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromPoolId)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetAllRegisteredAlloyedPools)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdCanonicalRoute)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllCanonicalRoutes)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -113,6 +118,40 @@ func GetCmdSpotPrice() (*osmocli.QueryDescriptor, *queryproto.SpotPriceRequest)
 `,
 	}, &queryproto.SpotPriceRequest{}
 }
+
+// GetCmdRouteSpotPrice returns the spot price and combined fees of a multihop route.
+func GetCmdRouteSpotPrice() (*osmocli.QueryDescriptor, *queryproto.RouteSpotPriceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "route-spot-price",
+		Short: "Query the spot price and combined fees of a multihop route",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} route-spot-price uosmo --swap-route-pool-ids=1,2 --swap-route-denoms=uion,uatom`,
+		ParseQuery:          RouteSpotPriceParseArgs,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		QueryFnName:         "RouteSpotPrice",
+		CustomFlagOverrides: customRouterFlagOverride,
+	}, &queryproto.RouteSpotPriceRequest{}
+}
+
+// GetCmdPoolTypeGasCosts returns the base gas costs of the operations on pools of every pool type.
+func GetCmdPoolTypeGasCosts() (*osmocli.QueryDescriptor, *queryproto.PoolTypeGasCostsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-type-gas-costs",
+		Short: "Query the base gas costs of swaps, joins and exits of every pool type",
+		Long:  "{{.Short}}",
+	}, &queryproto.PoolTypeGasCostsRequest{}
+}
+
+// GetCmdEstimateRouteGas returns the estimated base gas cost of swapping through a multihop route.
+func GetCmdEstimateRouteGas() (*osmocli.QueryDescriptor, *queryproto.EstimateRouteGasRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-route-gas",
+		Short: "Query the estimated base gas cost of swapping through the pools of a route",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-route-gas 1,2`,
+	}, &queryproto.EstimateRouteGasRequest{}
+}
+
 func GetCmdListPoolsByDenom() (*osmocli.QueryDescriptor, *queryproto.ListPoolsByDenomRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "list-pools-by-denom",
@@ -141,6 +180,20 @@ func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.
 	}, nil
 }
 
+func RouteSpotPriceParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	routes, err := swapAmountInRoutes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &queryproto.RouteSpotPriceRequest{TokenInDenom: args[0]}
+	for _, route := range routes {
+		req.RoutesPoolId = append(req.RoutesPoolId, route.PoolId)
+		req.RoutesTokenOutDenom = append(req.RoutesTokenOutDenom, route.TokenOutDenom)
+	}
+	return req, nil
+}
+
 func EstimateSwapExactAmountOutParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
 	poolID, err := strconv.Atoi(args[0])
 	if err != nil {
@@ -279,3 +332,22 @@ func GetAllRegisteredAlloyedPools() (*osmocli.QueryDescriptor, *queryproto.AllRe
 		Long:  "{{.Short}}",
 	}, &queryproto.AllRegisteredAlloyedPoolsRequest{}
 }
+
+// GetCmdCanonicalRoute returns the canonical route pricing a denom in a quote denom.
+func GetCmdCanonicalRoute() (*osmocli.QueryDescriptor, *queryproto.CanonicalRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "canonical-route",
+		Short: "Query the canonical route pricing a denom in a quote denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} canonical-route uatom uosmo`,
+	}, &queryproto.CanonicalRouteRequest{}
+}
+
+// GetCmdAllCanonicalRoutes returns all canonical routes.
+func GetCmdAllCanonicalRoutes() (*osmocli.QueryDescriptor, *queryproto.AllCanonicalRoutesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "all-canonical-routes",
+		Short: "Query all canonical routes",
+		Long:  "{{.Short}}",
+	}, &queryproto.AllCanonicalRoutesRequest{}
+}
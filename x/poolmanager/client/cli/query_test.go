@@ -60,6 +60,30 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			},
 			&poolmanagerqueryproto.EstimateSwapExactAmountOutResponse{},
 		},
+		{
+			"Query route spot price",
+			"/osmosis.poolmanager.v1beta1.Query/RouteSpotPrice",
+			&poolmanagerqueryproto.RouteSpotPriceRequest{
+				TokenInDenom:        "bar",
+				RoutesPoolId:        []uint64{1},
+				RoutesTokenOutDenom: []string{"baz"},
+			},
+			&poolmanagerqueryproto.RouteSpotPriceResponse{},
+		},
+		{
+			"Query pool type gas costs",
+			"/osmosis.poolmanager.v1beta1.Query/PoolTypeGasCosts",
+			&poolmanagerqueryproto.PoolTypeGasCostsRequest{},
+			&poolmanagerqueryproto.PoolTypeGasCostsResponse{},
+		},
+		{
+			"Query estimate route gas",
+			"/osmosis.poolmanager.v1beta1.Query/EstimateRouteGas",
+			&poolmanagerqueryproto.EstimateRouteGasRequest{
+				RoutesPoolId: []uint64{1},
+			},
+			&poolmanagerqueryproto.EstimateRouteGasResponse{},
+		},
 		{
 			"Query estimate trade amount in amount out based on price impact",
 			"/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact",
@@ -72,6 +96,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			},
 			&poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{},
 		},
+		{
+			"Query all canonical routes",
+			"/osmosis.poolmanager.v1beta1.Query/AllCanonicalRoutes",
+			&poolmanagerqueryproto.AllCanonicalRoutesRequest{},
+			&poolmanagerqueryproto.AllCanonicalRoutesResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -504,6 +504,105 @@ Ex) denom-pair-taker-fee-proposal uion,uosmo,0.0016,stake,uosmo,0.005,uatom,uosm
 	return cmd
 }
 
+// NewCmdHandleSetPoolMigrationLinksProposal implements a command handler for set pool migration links proposal
+func NewCmdHandleSetPoolMigrationLinksProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-migration-links-proposal [pool-migration-links] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a set pool migration links proposal",
+		Long: strings.TrimSpace(`Submit a set pool migration links proposal.
+
+Passing in pool-migration-links separated by commas would be parsed automatically to pairs of legacy and replacement pool ids.
+Once a gamm pool is linked, spot price, TWAP and route queries against it are served by its replacement concentrated liquidity pool.
+Ex) set-pool-migration-links-proposal 1,1066,2,1067,3,0 ->
+[pool 1 migrated to pool 1066]
+[pool 2 migrated to pool 1067]
+[pool 3, removes its migration link since its replacement is being set to 0]
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parsePoolMigrationLinksArgToContent(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdHandleSetCanonicalRoutesProposal implements a command handler for set canonical routes proposal
+func NewCmdHandleSetCanonicalRoutesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-canonical-routes-proposal [denom] [quote-denom] [route] [flags]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit a set canonical routes proposal",
+		Long: strings.TrimSpace(`Submit a set canonical routes proposal.
+
+Passing in the route separated by commas would be parsed automatically to pairs of pool id and token out denom.
+The canonical route is used to price the denom in the quote denom by txfees, TWAP-based pricing and protorev.
+Ex) set-canonical-routes-proposal uatom uosmo 1,uion,2,uosmo ->
+[uatom priced in uosmo by swapping uatom for uion in pool 1 and uion for uosmo in pool 2]
+
+Omitting the route removes the canonical route of the denom pair.
+Ex) set-canonical-routes-proposal uatom uosmo
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			routeArg := ""
+			if len(args) == 3 {
+				routeArg = args[2]
+			}
+			content, err := parseCanonicalRouteArgsToContent(cmd, args[0], args[1], routeArg)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
 func NewSetDenomPairTakerFeeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-denom-pair-taker-fee [flags]",
@@ -614,3 +713,113 @@ func ParseDenomPairTakerFee(arg string) ([]types.DenomPairTakerFee, error) {
 
 	return finaldenomPairTakerFeeRecordsRecords, nil
 }
+
+func parsePoolMigrationLinksArgToContent(cmd *cobra.Command, arg string) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := ParsePoolMigrationLinks(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetPoolMigrationLinksProposal{
+		Title:       title,
+		Description: description,
+		Links:       links,
+	}
+
+	return content, nil
+}
+
+func ParsePoolMigrationLinks(arg string) ([]types.PoolMigrationLink, error) {
+	poolIds := strings.Split(arg, ",")
+
+	if len(poolIds)%2 != 0 {
+		return nil, fmt.Errorf("pool migration links must be a list of legacy pool id and replacement pool id separated by commas")
+	}
+
+	links := make([]types.PoolMigrationLink, 0, len(poolIds)/2)
+	for i := 0; i < len(poolIds); i += 2 {
+		legacyPoolId, err := strconv.ParseUint(poolIds[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		replacementPoolId, err := strconv.ParseUint(poolIds[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		links = append(links, types.PoolMigrationLink{
+			LegacyPoolId:      legacyPoolId,
+			ReplacementPoolId: replacementPoolId,
+		})
+	}
+
+	return links, nil
+}
+
+func parseCanonicalRouteArgsToContent(cmd *cobra.Command, denom, quoteDenom, routeArg string) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := ParseCanonicalRoute(routeArg)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetCanonicalRoutesProposal{
+		Title:       title,
+		Description: description,
+		Routes: []types.CanonicalRoute{{
+			Denom:      denom,
+			QuoteDenom: quoteDenom,
+			Route:      route,
+		}},
+	}
+
+	return content, nil
+}
+
+// ParseCanonicalRoute parses a route of pool ids and token out denoms separated by commas.
+// An empty arg is parsed to an empty route.
+func ParseCanonicalRoute(arg string) ([]types.SwapAmountInRoute, error) {
+	if arg == "" {
+		return []types.SwapAmountInRoute{}, nil
+	}
+
+	hops := strings.Split(arg, ",")
+	if len(hops)%2 != 0 {
+		return nil, fmt.Errorf("canonical route must be a list of pool id and token out denom separated by commas")
+	}
+
+	route := make([]types.SwapAmountInRoute, 0, len(hops)/2)
+	for i := 0; i < len(hops); i += 2 {
+		poolId, err := strconv.ParseUint(hops[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		route = append(route, types.SwapAmountInRoute{
+			PoolId:        poolId,
+			TokenOutDenom: hops[i+1],
+		})
+	}
+
+	return route, nil
+}
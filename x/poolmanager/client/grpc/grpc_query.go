@@ -91,6 +91,16 @@ func (q Querier) SpotPrice(grpcCtx context.Context,
 	return q.Q.SpotPrice(ctx, *req)
 }
 
+func (q Querier) RouteSpotPrice(grpcCtx context.Context,
+	req *queryproto.RouteSpotPriceRequest,
+) (*queryproto.RouteSpotPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RouteSpotPrice(ctx, *req)
+}
+
 func (q Querier) RegisteredAlloyedPoolFromPoolId(grpcCtx context.Context,
 	req *queryproto.RegisteredAlloyedPoolFromPoolIdRequest,
 ) (*queryproto.RegisteredAlloyedPoolFromPoolIdResponse, error) {
@@ -111,6 +121,16 @@ func (q Querier) RegisteredAlloyedPoolFromDenom(grpcCtx context.Context,
 	return q.Q.RegisteredAlloyedPoolFromDenom(ctx, *req)
 }
 
+func (q Querier) PoolTypeGasCosts(grpcCtx context.Context,
+	req *queryproto.PoolTypeGasCostsRequest,
+) (*queryproto.PoolTypeGasCostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolTypeGasCosts(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -221,6 +241,16 @@ func (q Querier) EstimateSinglePoolSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSinglePoolSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) EstimateRouteGas(grpcCtx context.Context,
+	req *queryproto.EstimateRouteGasRequest,
+) (*queryproto.EstimateRouteGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateRouteGas(ctx, *req)
+}
+
 func (q Querier) AllTakerFeeShareAgreements(grpcCtx context.Context,
 	req *queryproto.AllTakerFeeShareAgreementsRequest,
 ) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
@@ -251,6 +281,26 @@ func (q Querier) AllRegisteredAlloyedPools(grpcCtx context.Context,
 	return q.Q.AllRegisteredAlloyedPools(ctx, *req)
 }
 
+func (q Querier) CanonicalRoute(grpcCtx context.Context,
+	req *queryproto.CanonicalRouteRequest,
+) (*queryproto.CanonicalRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.CanonicalRoute(ctx, *req)
+}
+
+func (q Querier) AllCanonicalRoutes(grpcCtx context.Context,
+	req *queryproto.AllCanonicalRoutesRequest,
+) (*queryproto.AllCanonicalRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AllCanonicalRoutes(ctx, *req)
+}
+
 func (q Querier) AllPools(grpcCtx context.Context,
 	req *queryproto.AllPoolsRequest,
 ) (*queryproto.AllPoolsResponse, error) {
@@ -7,5 +7,7 @@ import (
 )
 
 var (
-	DenomPairTakerFeeProposalHandler = govclient.NewProposalHandler(cli.NewCmdHandleDenomPairTakerFeeProposal)
+	DenomPairTakerFeeProposalHandler     = govclient.NewProposalHandler(cli.NewCmdHandleDenomPairTakerFeeProposal)
+	SetPoolMigrationLinksProposalHandler = govclient.NewProposalHandler(cli.NewCmdHandleSetPoolMigrationLinksProposal)
+	SetCanonicalRoutesProposalHandler    = govclient.NewProposalHandler(cli.NewCmdHandleSetCanonicalRoutesProposal)
 )
@@ -52,13 +52,15 @@ func (q Querier) EstimateSwapExactAmountIn(ctx sdk.Context, req queryproto.Estim
 		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
 	}
 
-	tokenOutAmount, err := q.K.MultihopEstimateOutGivenExactAmountIn(ctx, req.Routes, tokenIn)
+	routes := q.K.ResolveMigratedSwapAmountInRoutes(ctx, req.Routes)
+	hops, err := q.K.MultihopEstimateOutGivenExactAmountInWithHops(ctx, routes, tokenIn)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &queryproto.EstimateSwapExactAmountInResponse{
-		TokenOutAmount: tokenOutAmount,
+		TokenOutAmount: hops[len(hops)-1].TokenOut.Amount,
+		Hops:           hops,
 	}, nil
 }
 
@@ -78,19 +80,20 @@ func (q Querier) EstimateSwapExactAmountInWithPrimitiveTypes(ctx sdk.Context, re
 
 	for idx, poolId := range req.RoutesPoolId {
 		var route types.SwapAmountInRoute
-		route.PoolId = poolId
+		route.PoolId = q.K.ResolveMigratedPoolId(ctx, poolId)
 		route.TokenOutDenom = req.RoutesTokenOutDenom[idx]
 
 		routes = append(routes, route)
 	}
 
-	tokenOutAmount, err := q.K.MultihopEstimateOutGivenExactAmountIn(ctx, routes, tokenIn)
+	hops, err := q.K.MultihopEstimateOutGivenExactAmountInWithHops(ctx, routes, tokenIn)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &queryproto.EstimateSwapExactAmountInResponse{
-		TokenOutAmount: tokenOutAmount,
+		TokenOutAmount: hops[len(hops)-1].TokenOut.Amount,
+		Hops:           hops,
 	}, nil
 }
 
@@ -109,13 +112,15 @@ func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context, req queryproto.Esti
 		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
 	}
 
-	tokenInAmount, err := q.K.MultihopEstimateInGivenExactAmountOut(ctx, req.Routes, tokenOut)
+	routes := q.K.ResolveMigratedSwapAmountOutRoutes(ctx, req.Routes)
+	hops, err := q.K.MultihopEstimateInGivenExactAmountOutWithHops(ctx, routes, tokenOut)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &queryproto.EstimateSwapExactAmountOutResponse{
-		TokenInAmount: tokenInAmount,
+		TokenInAmount: hops[0].TokenIn.Amount,
+		Hops:          hops,
 	}, nil
 }
 
@@ -129,7 +134,7 @@ func (q Querier) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx sdk.Context, r
 
 	for idx, poolId := range req.RoutesPoolId {
 		var route types.SwapAmountOutRoute
-		route.PoolId = poolId
+		route.PoolId = q.K.ResolveMigratedPoolId(ctx, poolId)
 		route.TokenInDenom = req.RoutesTokenInDenom[idx]
 	}
 
@@ -142,13 +147,14 @@ func (q Querier) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx sdk.Context, r
 		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
 	}
 
-	tokenInAmount, err := q.K.MultihopEstimateInGivenExactAmountOut(ctx, routes, tokenOut)
+	hops, err := q.K.MultihopEstimateInGivenExactAmountOutWithHops(ctx, routes, tokenOut)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &queryproto.EstimateSwapExactAmountOutResponse{
-		TokenInAmount: tokenInAmount,
+		TokenInAmount: hops[0].TokenIn.Amount,
+		Hops:          hops,
 	}, nil
 }
 
@@ -247,7 +253,7 @@ func (q Querier) SpotPrice(ctx sdk.Context, req queryproto.SpotPriceRequest) (*q
 		return nil, status.Error(codes.InvalidArgument, "invalid quote asset denom")
 	}
 
-	sp, err := q.K.RouteCalculateSpotPrice(ctx, req.PoolId, req.QuoteAssetDenom, req.BaseAssetDenom)
+	sp, err := q.K.RouteCalculateSpotPrice(ctx, q.K.ResolveMigratedPoolId(ctx, req.PoolId), req.QuoteAssetDenom, req.BaseAssetDenom)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -259,6 +265,58 @@ func (q Querier) SpotPrice(ctx sdk.Context, req queryproto.SpotPriceRequest) (*q
 	}, err
 }
 
+// RouteSpotPrice returns the spot price of swapping through a multihop route along with the
+// combined spread and taker fees charged across its hops. 18 decimals.
+func (q Querier) RouteSpotPrice(ctx sdk.Context, req queryproto.RouteSpotPriceRequest) (*queryproto.RouteSpotPriceResponse, error) {
+	if req.TokenInDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token in denom")
+	}
+
+	if len(req.RoutesPoolId) != len(req.RoutesTokenOutDenom) {
+		return nil, status.Error(codes.InvalidArgument, "routes pool ids and token out denoms mismatch")
+	}
+
+	routes := make([]types.SwapAmountInRoute, 0, len(req.RoutesPoolId))
+	for idx, poolId := range req.RoutesPoolId {
+		routes = append(routes, types.SwapAmountInRoute{
+			PoolId:        q.K.ResolveMigratedPoolId(ctx, poolId),
+			TokenOutDenom: req.RoutesTokenOutDenom[idx],
+		})
+	}
+
+	spotPrice, spotPriceAfterFees, totalFee, err := q.K.RouteSpotPrice(ctx, routes, req.TokenInDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.RouteSpotPriceResponse{
+		SpotPrice:          spotPrice.Dec(),
+		SpotPriceAfterFees: spotPriceAfterFees.Dec(),
+		TotalFee:           totalFee,
+	}, nil
+}
+
+// PoolTypeGasCosts returns the base gas costs of the operations on pools of every pool type.
+func (q Querier) PoolTypeGasCosts(ctx sdk.Context, req queryproto.PoolTypeGasCostsRequest) (*queryproto.PoolTypeGasCostsResponse, error) {
+	return &queryproto.PoolTypeGasCostsResponse{
+		PoolTypeGasCosts: q.K.GetPoolTypeGasCosts(ctx),
+	}, nil
+}
+
+// EstimateRouteGas returns the estimated base gas cost of swapping through the pools of a route.
+func (q Querier) EstimateRouteGas(ctx sdk.Context, req queryproto.EstimateRouteGasRequest) (*queryproto.EstimateRouteGasResponse, error) {
+	if len(req.RoutesPoolId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no routes provided")
+	}
+
+	gas, err := q.K.EstimateRouteGas(ctx, req.RoutesPoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateRouteGasResponse{Gas: gas}, nil
+}
+
 // SpotPriceV2 returns the spot price of the pool with the given quote and base asset denoms. 36 decimals.
 func (q QuerierV2) SpotPriceV2(ctx sdk.Context, req queryprotov2.SpotPriceRequest) (*queryprotov2.SpotPriceResponse, error) {
 	if req.BaseAssetDenom == "" {
@@ -269,7 +327,7 @@ func (q QuerierV2) SpotPriceV2(ctx sdk.Context, req queryprotov2.SpotPriceReques
 		return nil, status.Error(codes.InvalidArgument, "invalid quote asset denom")
 	}
 
-	sp, err := q.K.RouteCalculateSpotPrice(ctx, req.PoolId, req.QuoteAssetDenom, req.BaseAssetDenom)
+	sp, err := q.K.RouteCalculateSpotPrice(ctx, q.K.ResolveMigratedPoolId(ctx, req.PoolId), req.QuoteAssetDenom, req.BaseAssetDenom)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -479,3 +537,27 @@ func (q Querier) AllRegisteredAlloyedPools(ctx sdk.Context, req queryproto.AllRe
 		ContractStates: contractStates,
 	}, nil
 }
+
+// CanonicalRoute returns the canonical route pricing the given denom in the given quote denom.
+func (q Querier) CanonicalRoute(ctx sdk.Context, req queryproto.CanonicalRouteRequest) (*queryproto.CanonicalRouteResponse, error) {
+	route, found := q.K.GetCanonicalRoute(ctx, req.Denom, req.QuoteDenom)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.CanonicalRouteNotFoundError{Denom: req.Denom, QuoteDenom: req.QuoteDenom}.Error())
+	}
+
+	return &queryproto.CanonicalRouteResponse{
+		Route: route,
+	}, nil
+}
+
+// AllCanonicalRoutes returns all canonical routes.
+func (q Querier) AllCanonicalRoutes(ctx sdk.Context, req queryproto.AllCanonicalRoutesRequest) (*queryproto.AllCanonicalRoutesResponse, error) {
+	routes, err := q.K.GetAllCanonicalRoutes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.AllCanonicalRoutesResponse{
+		CanonicalRoutes: routes,
+	}, nil
+}
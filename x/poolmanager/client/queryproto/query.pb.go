@@ -329,6 +329,8 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) GetTokenOutDenom() string {
 
 type EstimateSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// hops is the estimated outcome of every hop of the route, in route order.
+	Hops []types.SwapHopEstimate `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops" yaml:"hops"`
 }
 
 func (m *EstimateSwapExactAmountInResponse) Reset()         { *m = EstimateSwapExactAmountInResponse{} }
@@ -364,6 +366,13 @@ func (m *EstimateSwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EstimateSwapExactAmountInResponse proto.InternalMessageInfo
 
+func (m *EstimateSwapExactAmountInResponse) GetHops() []types.SwapHopEstimate {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
 // =============================== EstimateSwapExactAmountOut
 type EstimateSwapExactAmountOutRequest struct {
 	// DEPRECATED
@@ -575,6 +584,8 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) GetTokenOut() string {
 
 type EstimateSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	// hops is the estimated outcome of every hop of the route, in route order.
+	Hops []types.SwapHopEstimate `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops" yaml:"hops"`
 }
 
 func (m *EstimateSwapExactAmountOutResponse) Reset()         { *m = EstimateSwapExactAmountOutResponse{} }
@@ -610,6 +621,13 @@ func (m *EstimateSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EstimateSwapExactAmountOutResponse proto.InternalMessageInfo
 
+func (m *EstimateSwapExactAmountOutResponse) GetHops() []types.SwapHopEstimate {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
 // =============================== NumPools
 type NumPoolsRequest struct {
 }
@@ -1061,6 +1079,284 @@ func (m *SpotPriceResponse) GetSpotPrice() string {
 	return ""
 }
 
+// =============================== RouteSpotPrice
+type RouteSpotPriceRequest struct {
+	TokenInDenom        string   `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	RoutesPoolId        []uint64 `protobuf:"varint,2,rep,packed,name=routes_pool_id,json=routesPoolId,proto3" json:"routes_pool_id,omitempty" yaml:"routes_pool_id"`
+	RoutesTokenOutDenom []string `protobuf:"bytes,3,rep,name=routes_token_out_denom,json=routesTokenOutDenom,proto3" json:"routes_token_out_denom,omitempty" yaml:"routes_token_out_denom"`
+}
+
+func (m *RouteSpotPriceRequest) Reset()         { *m = RouteSpotPriceRequest{} }
+func (m *RouteSpotPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*RouteSpotPriceRequest) ProtoMessage()    {}
+func (*RouteSpotPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{20}
+}
+func (m *RouteSpotPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteSpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteSpotPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteSpotPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteSpotPriceRequest.Merge(m, src)
+}
+func (m *RouteSpotPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteSpotPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteSpotPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteSpotPriceRequest proto.InternalMessageInfo
+
+func (m *RouteSpotPriceRequest) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *RouteSpotPriceRequest) GetRoutesPoolId() []uint64 {
+	if m != nil {
+		return m.RoutesPoolId
+	}
+	return nil
+}
+
+func (m *RouteSpotPriceRequest) GetRoutesTokenOutDenom() []string {
+	if m != nil {
+		return m.RoutesTokenOutDenom
+	}
+	return nil
+}
+
+// RouteSpotPriceResponse defines the gRPC response structure for a
+// RouteSpotPrice query.
+type RouteSpotPriceResponse struct {
+	// spot_price is the amount of the route's final token out received per
+	// unit of token in, ignoring fees.
+	SpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spot_price" yaml:"spot_price"`
+	// spot_price_after_fees is the spot price after the spread and taker fees
+	// of every hop are deducted.
+	SpotPriceAfterFees cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=spot_price_after_fees,json=spotPriceAfterFees,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spot_price_after_fees" yaml:"spot_price_after_fees"`
+	// total_fee is the fraction of the token in that is lost to the combined
+	// spread and taker fees of every hop.
+	TotalFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=total_fee,json=totalFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_fee" yaml:"total_fee"`
+}
+
+func (m *RouteSpotPriceResponse) Reset()         { *m = RouteSpotPriceResponse{} }
+func (m *RouteSpotPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*RouteSpotPriceResponse) ProtoMessage()    {}
+func (*RouteSpotPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{21}
+}
+func (m *RouteSpotPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteSpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteSpotPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteSpotPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteSpotPriceResponse.Merge(m, src)
+}
+func (m *RouteSpotPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteSpotPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteSpotPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteSpotPriceResponse proto.InternalMessageInfo
+
+// =============================== PoolTypeGasCosts
+type PoolTypeGasCostsRequest struct {
+}
+
+func (m *PoolTypeGasCostsRequest) Reset()         { *m = PoolTypeGasCostsRequest{} }
+func (m *PoolTypeGasCostsRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolTypeGasCostsRequest) ProtoMessage()    {}
+func (*PoolTypeGasCostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{22}
+}
+func (m *PoolTypeGasCostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTypeGasCostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTypeGasCostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTypeGasCostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTypeGasCostsRequest.Merge(m, src)
+}
+func (m *PoolTypeGasCostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTypeGasCostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTypeGasCostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTypeGasCostsRequest proto.InternalMessageInfo
+
+type PoolTypeGasCostsResponse struct {
+	PoolTypeGasCosts []types.PoolTypeGasCosts `protobuf:"bytes,1,rep,name=pool_type_gas_costs,json=poolTypeGasCosts,proto3" json:"pool_type_gas_costs" yaml:"pool_type_gas_costs"`
+}
+
+func (m *PoolTypeGasCostsResponse) Reset()         { *m = PoolTypeGasCostsResponse{} }
+func (m *PoolTypeGasCostsResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolTypeGasCostsResponse) ProtoMessage()    {}
+func (*PoolTypeGasCostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{23}
+}
+func (m *PoolTypeGasCostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTypeGasCostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTypeGasCostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTypeGasCostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTypeGasCostsResponse.Merge(m, src)
+}
+func (m *PoolTypeGasCostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTypeGasCostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTypeGasCostsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTypeGasCostsResponse proto.InternalMessageInfo
+
+func (m *PoolTypeGasCostsResponse) GetPoolTypeGasCosts() []types.PoolTypeGasCosts {
+	if m != nil {
+		return m.PoolTypeGasCosts
+	}
+	return nil
+}
+
+// =============================== EstimateRouteGas
+type EstimateRouteGasRequest struct {
+	RoutesPoolId []uint64 `protobuf:"varint,1,rep,packed,name=routes_pool_id,json=routesPoolId,proto3" json:"routes_pool_id,omitempty" yaml:"routes_pool_id"`
+}
+
+func (m *EstimateRouteGasRequest) Reset()         { *m = EstimateRouteGasRequest{} }
+func (m *EstimateRouteGasRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateRouteGasRequest) ProtoMessage()    {}
+func (*EstimateRouteGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{24}
+}
+func (m *EstimateRouteGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateRouteGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateRouteGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateRouteGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateRouteGasRequest.Merge(m, src)
+}
+func (m *EstimateRouteGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateRouteGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateRouteGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateRouteGasRequest proto.InternalMessageInfo
+
+func (m *EstimateRouteGasRequest) GetRoutesPoolId() []uint64 {
+	if m != nil {
+		return m.RoutesPoolId
+	}
+	return nil
+}
+
+type EstimateRouteGasResponse struct {
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty" yaml:"gas"`
+}
+
+func (m *EstimateRouteGasResponse) Reset()         { *m = EstimateRouteGasResponse{} }
+func (m *EstimateRouteGasResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateRouteGasResponse) ProtoMessage()    {}
+func (*EstimateRouteGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{25}
+}
+func (m *EstimateRouteGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateRouteGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateRouteGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateRouteGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateRouteGasResponse.Merge(m, src)
+}
+func (m *EstimateRouteGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateRouteGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateRouteGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateRouteGasResponse proto.InternalMessageInfo
+
+func (m *EstimateRouteGasResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // =============================== TotalPoolLiquidity
 type TotalPoolLiquidityRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -1070,7 +1366,7 @@ func (m *TotalPoolLiquidityRequest) Reset()         { *m = TotalPoolLiquidityReq
 func (m *TotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalPoolLiquidityRequest) ProtoMessage()    {}
 func (*TotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{20}
+	return fileDescriptor_6256a4106f701b7d, []int{26}
 }
 func (m *TotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1410,7 @@ func (m *TotalPoolLiquidityResponse) Reset()         { *m = TotalPoolLiquidityRe
 func (m *TotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalPoolLiquidityResponse) ProtoMessage()    {}
 func (*TotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{21}
+	return fileDescriptor_6256a4106f701b7d, []int{27}
 }
 func (m *TotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,7 +1454,7 @@ func (m *TotalLiquidityRequest) Reset()         { *m = TotalLiquidityRequest{} }
 func (m *TotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalLiquidityRequest) ProtoMessage()    {}
 func (*TotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{22}
+	return fileDescriptor_6256a4106f701b7d, []int{28}
 }
 func (m *TotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,7 +1491,7 @@ func (m *TotalLiquidityResponse) Reset()         { *m = TotalLiquidityResponse{}
 func (m *TotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalLiquidityResponse) ProtoMessage()    {}
 func (*TotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{23}
+	return fileDescriptor_6256a4106f701b7d, []int{29}
 }
 func (m *TotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1240,7 +1536,7 @@ func (m *TotalVolumeForPoolRequest) Reset()         { *m = TotalVolumeForPoolReq
 func (m *TotalVolumeForPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalVolumeForPoolRequest) ProtoMessage()    {}
 func (*TotalVolumeForPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{24}
+	return fileDescriptor_6256a4106f701b7d, []int{30}
 }
 func (m *TotalVolumeForPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1284,7 +1580,7 @@ func (m *TotalVolumeForPoolResponse) Reset()         { *m = TotalVolumeForPoolRe
 func (m *TotalVolumeForPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalVolumeForPoolResponse) ProtoMessage()    {}
 func (*TotalVolumeForPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{25}
+	return fileDescriptor_6256a4106f701b7d, []int{31}
 }
 func (m *TotalVolumeForPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1330,7 +1626,7 @@ func (m *TradingPairTakerFeeRequest) Reset()         { *m = TradingPairTakerFeeR
 func (m *TradingPairTakerFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeRequest) ProtoMessage()    {}
 func (*TradingPairTakerFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{26}
+	return fileDescriptor_6256a4106f701b7d, []int{32}
 }
 func (m *TradingPairTakerFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1381,7 +1677,7 @@ func (m *TradingPairTakerFeeResponse) Reset()         { *m = TradingPairTakerFee
 func (m *TradingPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeResponse) ProtoMessage()    {}
 func (*TradingPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{27}
+	return fileDescriptor_6256a4106f701b7d, []int{33}
 }
 func (m *TradingPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1437,7 +1733,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactRequest) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{28}
+	return fileDescriptor_6256a4106f701b7d, []int{34}
 }
 func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1802,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactResponse) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{29}
+	return fileDescriptor_6256a4106f701b7d, []int{35}
 }
 func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1852,7 @@ func (m *AllTakerFeeShareAgreementsRequest) Reset()         { *m = AllTakerFeeSh
 func (m *AllTakerFeeShareAgreementsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAgreementsRequest) ProtoMessage()    {}
 func (*AllTakerFeeShareAgreementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{30}
+	return fileDescriptor_6256a4106f701b7d, []int{36}
 }
 func (m *AllTakerFeeShareAgreementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1889,7 @@ func (m *AllTakerFeeShareAgreementsResponse) Reset()         { *m = AllTakerFeeS
 func (m *AllTakerFeeShareAgreementsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAgreementsResponse) ProtoMessage()    {}
 func (*AllTakerFeeShareAgreementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{31}
+	return fileDescriptor_6256a4106f701b7d, []int{37}
 }
 func (m *AllTakerFeeShareAgreementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1639,7 +1935,7 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Reset() {
 func (m *TakerFeeShareAgreementFromDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareAgreementFromDenomRequest) ProtoMessage()    {}
 func (*TakerFeeShareAgreementFromDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{32}
+	return fileDescriptor_6256a4106f701b7d, []int{38}
 }
 func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1685,7 +1981,7 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Reset() {
 func (m *TakerFeeShareAgreementFromDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareAgreementFromDenomResponse) ProtoMessage()    {}
 func (*TakerFeeShareAgreementFromDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{33}
+	return fileDescriptor_6256a4106f701b7d, []int{39}
 }
 func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1732,7 +2028,7 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Reset() {
 func (m *TakerFeeShareDenomsToAccruedValueRequest) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareDenomsToAccruedValueRequest) ProtoMessage()    {}
 func (*TakerFeeShareDenomsToAccruedValueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{34}
+	return fileDescriptor_6256a4106f701b7d, []int{40}
 }
 func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1787,7 +2083,7 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) String() string {
 }
 func (*TakerFeeShareDenomsToAccruedValueResponse) ProtoMessage() {}
 func (*TakerFeeShareDenomsToAccruedValueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{35}
+	return fileDescriptor_6256a4106f701b7d, []int{41}
 }
 func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +2119,7 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Reset()         { *m = AllTakerFee
 func (m *AllTakerFeeShareAccumulatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAccumulatorsRequest) ProtoMessage()    {}
 func (*AllTakerFeeShareAccumulatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{36}
+	return fileDescriptor_6256a4106f701b7d, []int{42}
 }
 func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1860,7 +2156,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Reset()         { *m = AllTakerFe
 func (m *AllTakerFeeShareAccumulatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAccumulatorsResponse) ProtoMessage()    {}
 func (*AllTakerFeeShareAccumulatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{37}
+	return fileDescriptor_6256a4106f701b7d, []int{43}
 }
 func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,7 +2200,7 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Reset()         { *m = Registere
 func (m *RegisteredAlloyedPoolFromDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromDenomRequest) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{38}
+	return fileDescriptor_6256a4106f701b7d, []int{44}
 }
 func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1950,7 +2246,7 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Reset() {
 func (m *RegisteredAlloyedPoolFromDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromDenomResponse) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{39}
+	return fileDescriptor_6256a4106f701b7d, []int{45}
 }
 func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1996,7 +2292,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Reset() {
 func (m *RegisteredAlloyedPoolFromPoolIdRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromPoolIdRequest) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromPoolIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{40}
+	return fileDescriptor_6256a4106f701b7d, []int{46}
 }
 func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2042,7 +2338,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Reset() {
 func (m *RegisteredAlloyedPoolFromPoolIdResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromPoolIdResponse) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromPoolIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{41}
+	return fileDescriptor_6256a4106f701b7d, []int{47}
 }
 func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2085,7 +2381,7 @@ func (m *AllRegisteredAlloyedPoolsRequest) Reset()         { *m = AllRegisteredA
 func (m *AllRegisteredAlloyedPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllRegisteredAlloyedPoolsRequest) ProtoMessage()    {}
 func (*AllRegisteredAlloyedPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{42}
+	return fileDescriptor_6256a4106f701b7d, []int{48}
 }
 func (m *AllRegisteredAlloyedPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2122,7 +2418,7 @@ func (m *AllRegisteredAlloyedPoolsResponse) Reset()         { *m = AllRegistered
 func (m *AllRegisteredAlloyedPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllRegisteredAlloyedPoolsResponse) ProtoMessage()    {}
 func (*AllRegisteredAlloyedPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{43}
+	return fileDescriptor_6256a4106f701b7d, []int{49}
 }
 func (m *AllRegisteredAlloyedPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2158,51 +2454,237 @@ func (m *AllRegisteredAlloyedPoolsResponse) GetContractStates() []types.AlloyCon
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
-	proto.RegisterType((*EstimateSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInRequest")
-	proto.RegisterType((*EstimateSwapExactAmountInWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInWithPrimitiveTypesRequest")
-	proto.RegisterType((*EstimateSinglePoolSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountInRequest")
-	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
-	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
-	proto.RegisterType((*EstimateSwapExactAmountOutWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutWithPrimitiveTypesRequest")
-	proto.RegisterType((*EstimateSinglePoolSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountOutRequest")
-	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutResponse")
-	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.NumPoolsRequest")
-	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.NumPoolsResponse")
-	proto.RegisterType((*PoolRequest)(nil), "osmosis.poolmanager.v1beta1.PoolRequest")
-	proto.RegisterType((*PoolResponse)(nil), "osmosis.poolmanager.v1beta1.PoolResponse")
-	proto.RegisterType((*AllPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolsRequest")
-	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
-	proto.RegisterType((*ListPoolsByDenomRequest)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomRequest")
-	proto.RegisterType((*ListPoolsByDenomResponse)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomResponse")
-	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
-	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
-	proto.RegisterType((*TotalPoolLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityRequest")
-	proto.RegisterType((*TotalPoolLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityResponse")
-	proto.RegisterType((*TotalLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityRequest")
-	proto.RegisterType((*TotalLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityResponse")
-	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolRequest")
-	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolResponse")
-	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeRequest")
-	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeResponse")
-	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
-	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
-	proto.RegisterType((*AllTakerFeeShareAgreementsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsRequest")
-	proto.RegisterType((*AllTakerFeeShareAgreementsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsResponse")
-	proto.RegisterType((*TakerFeeShareAgreementFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomRequest")
-	proto.RegisterType((*TakerFeeShareAgreementFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomResponse")
-	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueRequest")
-	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueResponse")
-	proto.RegisterType((*AllTakerFeeShareAccumulatorsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsRequest")
-	proto.RegisterType((*AllTakerFeeShareAccumulatorsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsResponse")
-	proto.RegisterType((*RegisteredAlloyedPoolFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomRequest")
+type CanonicalRouteRequest struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+}
+
+func (m *CanonicalRouteRequest) Reset()         { *m = CanonicalRouteRequest{} }
+func (m *CanonicalRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*CanonicalRouteRequest) ProtoMessage()    {}
+func (*CanonicalRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{50}
+}
+func (m *CanonicalRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalRouteRequest.Merge(m, src)
+}
+func (m *CanonicalRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalRouteRequest proto.InternalMessageInfo
+
+func (m *CanonicalRouteRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CanonicalRouteRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+type CanonicalRouteResponse struct {
+	Route []types.SwapAmountInRoute `protobuf:"bytes,1,rep,name=route,proto3" json:"route" yaml:"route"`
+}
+
+func (m *CanonicalRouteResponse) Reset()         { *m = CanonicalRouteResponse{} }
+func (m *CanonicalRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*CanonicalRouteResponse) ProtoMessage()    {}
+func (*CanonicalRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{51}
+}
+func (m *CanonicalRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalRouteResponse.Merge(m, src)
+}
+func (m *CanonicalRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalRouteResponse proto.InternalMessageInfo
+
+func (m *CanonicalRouteResponse) GetRoute() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+type AllCanonicalRoutesRequest struct {
+}
+
+func (m *AllCanonicalRoutesRequest) Reset()         { *m = AllCanonicalRoutesRequest{} }
+func (m *AllCanonicalRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*AllCanonicalRoutesRequest) ProtoMessage()    {}
+func (*AllCanonicalRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{52}
+}
+func (m *AllCanonicalRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllCanonicalRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllCanonicalRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllCanonicalRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllCanonicalRoutesRequest.Merge(m, src)
+}
+func (m *AllCanonicalRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllCanonicalRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllCanonicalRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllCanonicalRoutesRequest proto.InternalMessageInfo
+
+type AllCanonicalRoutesResponse struct {
+	CanonicalRoutes []types.CanonicalRoute `protobuf:"bytes,1,rep,name=canonical_routes,json=canonicalRoutes,proto3" json:"canonical_routes" yaml:"canonical_routes"`
+}
+
+func (m *AllCanonicalRoutesResponse) Reset()         { *m = AllCanonicalRoutesResponse{} }
+func (m *AllCanonicalRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*AllCanonicalRoutesResponse) ProtoMessage()    {}
+func (*AllCanonicalRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{53}
+}
+func (m *AllCanonicalRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllCanonicalRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllCanonicalRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllCanonicalRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllCanonicalRoutesResponse.Merge(m, src)
+}
+func (m *AllCanonicalRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllCanonicalRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllCanonicalRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllCanonicalRoutesResponse proto.InternalMessageInfo
+
+func (m *AllCanonicalRoutesResponse) GetCanonicalRoutes() []types.CanonicalRoute {
+	if m != nil {
+		return m.CanonicalRoutes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
+	proto.RegisterType((*EstimateSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateSwapExactAmountInWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInWithPrimitiveTypesRequest")
+	proto.RegisterType((*EstimateSinglePoolSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
+	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
+	proto.RegisterType((*EstimateSwapExactAmountOutWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutWithPrimitiveTypesRequest")
+	proto.RegisterType((*EstimateSinglePoolSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountOutRequest")
+	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutResponse")
+	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.NumPoolsRequest")
+	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.NumPoolsResponse")
+	proto.RegisterType((*PoolRequest)(nil), "osmosis.poolmanager.v1beta1.PoolRequest")
+	proto.RegisterType((*PoolResponse)(nil), "osmosis.poolmanager.v1beta1.PoolResponse")
+	proto.RegisterType((*AllPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolsRequest")
+	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
+	proto.RegisterType((*ListPoolsByDenomRequest)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomRequest")
+	proto.RegisterType((*ListPoolsByDenomResponse)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomResponse")
+	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
+	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
+	proto.RegisterType((*RouteSpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.RouteSpotPriceRequest")
+	proto.RegisterType((*RouteSpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.RouteSpotPriceResponse")
+	proto.RegisterType((*PoolTypeGasCostsRequest)(nil), "osmosis.poolmanager.v1beta1.PoolTypeGasCostsRequest")
+	proto.RegisterType((*PoolTypeGasCostsResponse)(nil), "osmosis.poolmanager.v1beta1.PoolTypeGasCostsResponse")
+	proto.RegisterType((*EstimateRouteGasRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateRouteGasRequest")
+	proto.RegisterType((*EstimateRouteGasResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateRouteGasResponse")
+	proto.RegisterType((*TotalPoolLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityRequest")
+	proto.RegisterType((*TotalPoolLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityResponse")
+	proto.RegisterType((*TotalLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityRequest")
+	proto.RegisterType((*TotalLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityResponse")
+	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolRequest")
+	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolResponse")
+	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeRequest")
+	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeResponse")
+	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
+	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
+	proto.RegisterType((*AllTakerFeeShareAgreementsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsRequest")
+	proto.RegisterType((*AllTakerFeeShareAgreementsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsResponse")
+	proto.RegisterType((*TakerFeeShareAgreementFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomRequest")
+	proto.RegisterType((*TakerFeeShareAgreementFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomResponse")
+	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueRequest")
+	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueResponse")
+	proto.RegisterType((*AllTakerFeeShareAccumulatorsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsRequest")
+	proto.RegisterType((*AllTakerFeeShareAccumulatorsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsResponse")
+	proto.RegisterType((*RegisteredAlloyedPoolFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomRequest")
 	proto.RegisterType((*RegisteredAlloyedPoolFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomResponse")
 	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdRequest")
 	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdResponse")
 	proto.RegisterType((*AllRegisteredAlloyedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsRequest")
 	proto.RegisterType((*AllRegisteredAlloyedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsResponse")
+	proto.RegisterType((*CanonicalRouteRequest)(nil), "osmosis.poolmanager.v1beta1.CanonicalRouteRequest")
+	proto.RegisterType((*CanonicalRouteResponse)(nil), "osmosis.poolmanager.v1beta1.CanonicalRouteResponse")
+	proto.RegisterType((*AllCanonicalRoutesRequest)(nil), "osmosis.poolmanager.v1beta1.AllCanonicalRoutesRequest")
+	proto.RegisterType((*AllCanonicalRoutesResponse)(nil), "osmosis.poolmanager.v1beta1.AllCanonicalRoutesResponse")
 }
 
 func init() {
@@ -2210,173 +2692,206 @@ func init() {
 }
 
 var fileDescriptor_6256a4106f701b7d = []byte{
-	// 2651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x8f, 0x1d, 0xaf, 0xfd, 0x12, 0xff, 0xa4, 0x36, 0x8e, 0xed, 0x49, 0xf0, 0x38, 0xe5,
-	0xc4, 0x71, 0x36, 0xf1, 0xcc, 0xda, 0xce, 0xfe, 0x90, 0x5d, 0x27, 0x99, 0xf1, 0x4f, 0x62, 0x36,
-	0x21, 0xce, 0xd8, 0x64, 0x61, 0xd9, 0x6c, 0xab, 0x3d, 0x53, 0x19, 0xb7, 0x3c, 0xdd, 0x3d, 0xe9,
-	0xae, 0x76, 0x3c, 0x42, 0x39, 0x80, 0x84, 0xe0, 0x84, 0x02, 0x8b, 0xb4, 0x48, 0x20, 0xad, 0xf6,
-	0xc0, 0x05, 0x0e, 0x08, 0x09, 0x21, 0x71, 0x81, 0x0b, 0x87, 0x08, 0x09, 0x14, 0x89, 0x03, 0x08,
-	0x89, 0x01, 0x25, 0x1c, 0x10, 0x70, 0x9a, 0x23, 0x17, 0x50, 0x57, 0x55, 0xf7, 0xf4, 0x8c, 0x67,
-	0xba, 0x7b, 0x66, 0x02, 0xda, 0x53, 0xec, 0xaa, 0xf7, 0x5e, 0xbd, 0xef, 0xab, 0xf7, 0xaa, 0xaa,
-	0xbf, 0x18, 0xce, 0x19, 0x96, 0x66, 0x58, 0xaa, 0x95, 0x2a, 0x19, 0x46, 0x51, 0x53, 0x74, 0xa5,
-	0x40, 0xcc, 0xd4, 0xde, 0xfc, 0x36, 0xa1, 0xca, 0x7c, 0xea, 0x81, 0x4d, 0xcc, 0x72, 0xb2, 0x64,
-	0x1a, 0xd4, 0x40, 0x27, 0x85, 0x61, 0xd2, 0x67, 0x98, 0x14, 0x86, 0xf1, 0xe3, 0x05, 0xa3, 0x60,
-	0x30, 0xbb, 0x94, 0xf3, 0x13, 0x77, 0x89, 0x9f, 0x0f, 0x8a, 0x5d, 0x20, 0x3a, 0x61, 0xe1, 0x98,
-	0xe9, 0x99, 0x20, 0x53, 0xba, 0x2f, 0xac, 0x2e, 0x06, 0x59, 0x59, 0x0f, 0x95, 0x92, 0x6c, 0x1a,
-	0x36, 0x25, 0xc2, 0x7a, 0x3e, 0x30, 0xa6, 0xb2, 0x4b, 0x4c, 0xf9, 0x3e, 0x21, 0xb2, 0xb5, 0xa3,
-	0x98, 0xae, 0xcb, 0x64, 0x8e, 0xf9, 0xa4, 0xb6, 0x15, 0x8b, 0x78, 0xa6, 0x39, 0x43, 0xd5, 0xc5,
-	0xfc, 0x2b, 0xfe, 0x79, 0xc6, 0x8e, 0x67, 0x55, 0x52, 0x0a, 0xaa, 0xae, 0x50, 0xd5, 0x70, 0x6d,
-	0x4f, 0x15, 0x0c, 0xa3, 0x50, 0x24, 0x29, 0xa5, 0xa4, 0xa6, 0x14, 0x5d, 0x37, 0x28, 0x9b, 0x74,
-	0x01, 0x4f, 0x88, 0x59, 0xf6, 0xdb, 0xb6, 0x7d, 0x3f, 0xa5, 0xe8, 0x65, 0x77, 0x8a, 0x2f, 0x22,
-	0x73, 0x3e, 0xf9, 0x2f, 0x62, 0x2a, 0xd1, 0xe8, 0x45, 0x55, 0x8d, 0x58, 0x54, 0xd1, 0x4a, 0xdc,
-	0x00, 0x0f, 0xc3, 0xe0, 0x86, 0x62, 0x2a, 0x9a, 0x95, 0x25, 0x0f, 0x6c, 0x62, 0x51, 0xbc, 0x09,
-	0x43, 0xee, 0x80, 0x55, 0x32, 0x74, 0x8b, 0xa0, 0x34, 0xf4, 0x95, 0xd8, 0xc8, 0xb8, 0x34, 0x25,
-	0xcd, 0x1e, 0x59, 0x98, 0x4e, 0x06, 0xec, 0x6c, 0x92, 0x3b, 0x67, 0x7a, 0x9f, 0x54, 0x12, 0x87,
-	0xb2, 0xc2, 0x11, 0xff, 0x34, 0x06, 0x53, 0xab, 0x16, 0x55, 0x35, 0x85, 0x92, 0xcd, 0x87, 0x4a,
-	0x69, 0x75, 0x5f, 0xc9, 0xd1, 0xb4, 0x66, 0xd8, 0x3a, 0x5d, 0xd7, 0xc5, 0xca, 0x68, 0x09, 0xfa,
-	0x2c, 0xa2, 0xe7, 0x89, 0xc9, 0xd6, 0x19, 0xc8, 0x9c, 0xad, 0x56, 0x12, 0x89, 0xb2, 0xa2, 0x15,
-	0x2f, 0x63, 0x3e, 0x8e, 0x2f, 0xe6, 0x49, 0xc9, 0x24, 0x39, 0x85, 0x92, 0xfc, 0x65, 0x4c, 0x4d,
-	0x9b, 0xe0, 0x71, 0x29, 0x2b, 0x9c, 0xd0, 0x55, 0x78, 0xc9, 0xc9, 0x47, 0x56, 0xf3, 0xe3, 0xb1,
-	0x29, 0x69, 0xb6, 0x37, 0x33, 0x53, 0xad, 0x24, 0xa6, 0xb8, 0xbf, 0x98, 0x68, 0x11, 0xc0, 0x99,
-	0x5d, 0xcf, 0xa3, 0x24, 0xf4, 0x53, 0x63, 0x97, 0xe8, 0xb2, 0xaa, 0x8f, 0xf7, 0xb0, 0x0c, 0x5e,
-	0xae, 0x56, 0x12, 0xc3, 0x3c, 0x82, 0x3b, 0x83, 0xb3, 0x2f, 0xb1, 0x1f, 0xd7, 0x75, 0x74, 0x0f,
-	0xfa, 0x58, 0xf5, 0x58, 0xe3, 0xbd, 0x53, 0x3d, 0xb3, 0x47, 0x16, 0x92, 0x81, 0xbc, 0x38, 0xb0,
-	0x3d, 0xc4, 0x8e, 0x5b, 0x66, 0xd4, 0xa1, 0xa8, 0x5a, 0x49, 0x0c, 0xf2, 0x15, 0x78, 0x2c, 0x9c,
-	0x15, 0x41, 0xf1, 0x2f, 0x63, 0xb0, 0xd0, 0x92, 0xb3, 0x77, 0x55, 0xba, 0xb3, 0x61, 0xaa, 0x9a,
-	0x4a, 0xd5, 0x3d, 0xb2, 0x55, 0x2e, 0x11, 0x77, 0xff, 0xfc, 0x34, 0x48, 0x5d, 0xd3, 0x10, 0x8b,
-	0x40, 0xc3, 0x55, 0x18, 0xe2, 0x19, 0xcb, 0xee, 0xba, 0x3d, 0x53, 0x3d, 0xb3, 0xbd, 0x99, 0x89,
-	0x6a, 0x25, 0x31, 0xea, 0x87, 0xe6, 0xce, 0xe3, 0xec, 0x51, 0x3e, 0xb0, 0xc1, 0x17, 0xbc, 0x0b,
-	0x27, 0x84, 0x01, 0x8f, 0x6e, 0xd8, 0x54, 0xce, 0x13, 0xdd, 0xd0, 0x18, 0xaf, 0x03, 0x99, 0xd3,
-	0xd5, 0x4a, 0xe2, 0x33, 0x75, 0x81, 0x1a, 0xec, 0x70, 0xf6, 0x65, 0x3e, 0xb1, 0xe5, 0x8c, 0xdf,
-	0xb6, 0xe9, 0x0a, 0x1b, 0xfd, 0xad, 0x04, 0xaf, 0x78, 0x04, 0xaa, 0x7a, 0xa1, 0x48, 0x9c, 0x05,
-	0x5b, 0x96, 0xdf, 0x85, 0x46, 0xe2, 0x50, 0xb5, 0x92, 0x18, 0xaa, 0x27, 0xae, 0x63, 0x92, 0x32,
-	0x30, 0xdc, 0x08, 0x8e, 0x97, 0x58, 0xbc, 0x5a, 0x49, 0x9c, 0xf0, 0xbb, 0xf9, 0x50, 0x0d, 0xd2,
-	0x3a, 0x3c, 0xdf, 0x90, 0xe0, 0x74, 0x40, 0x13, 0x89, 0x6e, 0xdd, 0x86, 0x91, 0x5a, 0x20, 0x85,
-	0xcd, 0x8a, 0x7e, 0x7a, 0xd3, 0xa9, 0xb7, 0x3f, 0x55, 0x12, 0xa3, 0xfc, 0x84, 0xb0, 0xf2, 0xbb,
-	0x49, 0xd5, 0x48, 0x69, 0x0a, 0xdd, 0x49, 0xae, 0xeb, 0xb4, 0x5a, 0x49, 0x8c, 0x35, 0xe6, 0xc1,
-	0xdd, 0x71, 0x76, 0xc8, 0x4d, 0x84, 0xaf, 0x86, 0x7f, 0x1e, 0x6b, 0x99, 0xc9, 0x6d, 0x9b, 0x7e,
-	0x5a, 0xfa, 0xf9, 0x03, 0xaf, 0x3f, 0x7b, 0x58, 0x7f, 0xa6, 0x22, 0xf6, 0xa7, 0x03, 0x21, 0x42,
-	0x83, 0xa2, 0x79, 0x18, 0xf0, 0xa8, 0x1a, 0xef, 0x65, 0x10, 0x8f, 0x57, 0x2b, 0x89, 0x91, 0x06,
-	0x16, 0x71, 0xb6, 0xdf, 0xa5, 0x0f, 0xff, 0x2a, 0x06, 0x8b, 0xad, 0x89, 0xfb, 0x1f, 0x36, 0xf5,
-	0xc1, 0x26, 0x8d, 0xb5, 0xd7, 0xa4, 0x9b, 0x30, 0x5a, 0xd7, 0x7c, 0xaa, 0xee, 0x95, 0xb1, 0xd3,
-	0xa3, 0x53, 0xd5, 0x4a, 0xe2, 0x54, 0x93, 0x1e, 0x75, 0xcd, 0x70, 0x16, 0xf9, 0x5a, 0x74, 0x5d,
-	0x67, 0x15, 0xdd, 0x09, 0x83, 0xbf, 0x93, 0xe0, 0x42, 0x68, 0x53, 0xfb, 0x8a, 0xb0, 0xad, 0xae,
-	0xbe, 0x0a, 0x43, 0x0d, 0xe8, 0x78, 0x6f, 0xfb, 0x58, 0x6a, 0x84, 0x75, 0x94, 0xb6, 0x04, 0xd4,
-	0x13, 0x09, 0xd0, 0xd7, 0x25, 0xc0, 0x41, 0xbd, 0x24, 0xda, 0x5a, 0x76, 0x0f, 0x10, 0x55, 0xaf,
-	0xef, 0xea, 0x37, 0xc2, 0xba, 0xfa, 0x44, 0x43, 0xe2, 0x6e, 0x53, 0x0f, 0x8a, 0xcc, 0x45, 0x4f,
-	0x1f, 0x83, 0xe1, 0xcf, 0xdb, 0x9a, 0x43, 0xa6, 0xf7, 0x14, 0x58, 0x85, 0x91, 0xda, 0x90, 0xc8,
-	0x63, 0x1e, 0x06, 0x74, 0x5b, 0x63, 0x55, 0x62, 0x09, 0x46, 0x7d, 0x08, 0xbd, 0x29, 0x9c, 0xed,
-	0xd7, 0x85, 0x2b, 0xbe, 0x0c, 0x47, 0x9c, 0x1f, 0x3a, 0xd9, 0x11, 0xbc, 0x0c, 0x47, 0xb9, 0xaf,
-	0x58, 0x7e, 0x11, 0x7a, 0x9d, 0x19, 0xf1, 0x12, 0x39, 0x9e, 0xe4, 0xcf, 0x9b, 0xa4, 0xfb, 0xbc,
-	0x49, 0xa6, 0xf5, 0x72, 0x66, 0xe0, 0x37, 0x3f, 0x9b, 0x3b, 0xcc, 0xca, 0x36, 0xcb, 0x8c, 0x1d,
-	0x68, 0xe9, 0x62, 0xb1, 0x0e, 0xda, 0x3a, 0x8c, 0xd4, 0x86, 0x44, 0xec, 0xd7, 0xe0, 0xb0, 0x0b,
-	0xab, 0x27, 0x4a, 0x70, 0x6e, 0x8d, 0xd3, 0x30, 0x76, 0x53, 0xb5, 0x28, 0x8b, 0x95, 0x29, 0xb3,
-	0x3a, 0x70, 0xa1, 0xce, 0xc0, 0x61, 0x5e, 0x46, 0x7c, 0xab, 0x46, 0xaa, 0x95, 0xc4, 0x51, 0x0e,
-	0x54, 0x54, 0x0f, 0x9f, 0xc6, 0x77, 0x60, 0xfc, 0x60, 0x88, 0xee, 0xb2, 0x7a, 0x2a, 0xc1, 0xc8,
-	0x66, 0xc9, 0xa0, 0x1b, 0xa6, 0x9a, 0x23, 0x1d, 0x35, 0xc3, 0x2a, 0x8c, 0x38, 0xaf, 0x56, 0x59,
-	0xb1, 0x2c, 0x42, 0xeb, 0xda, 0xe1, 0x64, 0xed, 0xae, 0x68, 0xb4, 0xc0, 0xd9, 0x21, 0x67, 0x28,
-	0xed, 0x8c, 0xf0, 0x96, 0xb8, 0x01, 0xc7, 0x1e, 0xd8, 0x06, 0xad, 0x8f, 0xc3, 0x5b, 0xe3, 0x54,
-	0xb5, 0x92, 0x18, 0xe7, 0x71, 0x0e, 0x98, 0xe0, 0xec, 0x30, 0x1b, 0xab, 0x45, 0xc2, 0xeb, 0x70,
-	0xcc, 0x87, 0x48, 0xd0, 0x73, 0x09, 0xc0, 0x2a, 0x19, 0x54, 0x2e, 0x39, 0xa3, 0x82, 0xe7, 0xd1,
-	0x6a, 0x25, 0x71, 0x4c, 0x5c, 0x34, 0xde, 0x1c, 0xce, 0x0e, 0x58, 0xae, 0x37, 0xbe, 0x01, 0x13,
-	0x5b, 0x06, 0x55, 0x58, 0x01, 0xdc, 0x54, 0x1f, 0xd8, 0x6a, 0x5e, 0xa5, 0xe5, 0x8e, 0x0a, 0xf4,
-	0xfb, 0x12, 0xc4, 0x9b, 0x85, 0x12, 0xe9, 0x3d, 0x82, 0x81, 0xa2, 0x3b, 0x28, 0x76, 0x70, 0x22,
-	0x29, 0x5e, 0xe8, 0x0e, 0x51, 0xde, 0xf5, 0xb3, 0x6c, 0xa8, 0x7a, 0x66, 0x45, 0x5c, 0x38, 0xa2,
-	0x9b, 0x3c, 0x4f, 0xfc, 0xa3, 0xbf, 0x24, 0x66, 0x0b, 0x2a, 0xdd, 0xb1, 0xb7, 0x93, 0x39, 0x43,
-	0x13, 0x4f, 0x7c, 0xf1, 0xcf, 0x9c, 0x95, 0xdf, 0x4d, 0x51, 0xe7, 0xb6, 0x60, 0x41, 0xac, 0x6c,
-	0x6d, 0x45, 0x3c, 0x06, 0xa3, 0x2c, 0xb9, 0x46, 0x8c, 0xf8, 0x23, 0x09, 0x4e, 0x34, 0xce, 0x7c,
-	0x3a, 0x52, 0x76, 0xb7, 0xe6, 0xae, 0x51, 0xb4, 0x35, 0xb2, 0x66, 0x98, 0x1d, 0x9f, 0x1d, 0xdf,
-	0x71, 0xb7, 0xa6, 0x21, 0x94, 0xc0, 0x49, 0xa1, 0x6f, 0x8f, 0x4d, 0x84, 0x83, 0x4c, 0xd7, 0x3f,
-	0x04, 0xb8, 0x5b, 0x7b, 0x08, 0xc5, 0x5a, 0x78, 0x0f, 0xe2, 0x5b, 0xa6, 0x92, 0x57, 0xf5, 0xc2,
-	0x86, 0xa2, 0x9a, 0x5b, 0xce, 0x47, 0xe5, 0x1a, 0xf1, 0x37, 0x28, 0xab, 0x7e, 0xf9, 0x55, 0x51,
-	0xca, 0x3e, 0x7c, 0x62, 0x02, 0x67, 0xfb, 0xd8, 0x4f, 0xaf, 0xd6, 0x8c, 0xe7, 0x45, 0x5f, 0x1e,
-	0x30, 0x9e, 0x77, 0x8d, 0xe7, 0xb1, 0x0c, 0x27, 0x9b, 0xae, 0x2b, 0xc8, 0xb8, 0x06, 0x03, 0xde,
-	0x07, 0xae, 0x58, 0x7a, 0x5a, 0x5c, 0x2c, 0x27, 0x0f, 0x5e, 0x2c, 0x37, 0x49, 0x41, 0xc9, 0x95,
-	0x57, 0x48, 0x2e, 0xdb, 0x4f, 0x45, 0x24, 0xe7, 0x73, 0x65, 0xc6, 0xbd, 0xc7, 0x9c, 0x95, 0x48,
-	0x46, 0xb1, 0x48, 0xfe, 0xb6, 0xce, 0x1a, 0x6e, 0x5d, 0x2b, 0x29, 0x39, 0xef, 0x4e, 0x7e, 0x1b,
-	0x06, 0xee, 0x9b, 0x86, 0x26, 0x3b, 0xdf, 0xc9, 0xe2, 0x24, 0x0f, 0x20, 0x9f, 0x7f, 0x49, 0xf6,
-	0x3b, 0x1e, 0xce, 0xef, 0x08, 0xc3, 0x20, 0x35, 0x98, 0xaf, 0xff, 0x50, 0xca, 0x1e, 0xa1, 0x86,
-	0x33, 0xcd, 0x0f, 0x9d, 0xb1, 0x5a, 0x9d, 0x38, 0x47, 0x4d, 0xaf, 0x77, 0xa8, 0xdd, 0x82, 0x11,
-	0x4d, 0xd9, 0xe7, 0x27, 0x82, 0xac, 0xb2, 0xac, 0xc4, 0xc3, 0x23, 0x12, 0xdc, 0x21, 0x4d, 0xd9,
-	0xf7, 0x01, 0x42, 0x9f, 0x83, 0x21, 0xb2, 0x4f, 0x89, 0xa9, 0x2b, 0x45, 0x71, 0x02, 0x1d, 0x8e,
-	0x1e, 0x6c, 0xd0, 0x75, 0xe5, 0x67, 0xd2, 0x8f, 0x25, 0x38, 0x17, 0x4a, 0xa0, 0xd8, 0xae, 0x2b,
-	0x00, 0xaa, 0x5e, 0xb2, 0x69, 0x5b, 0x14, 0x0e, 0x30, 0x17, 0xc6, 0xe1, 0x35, 0x38, 0x62, 0xd8,
-	0xd4, 0x0b, 0x10, 0x8b, 0x16, 0x00, 0xb8, 0x8f, 0x33, 0x82, 0xa7, 0xe1, 0x74, 0xba, 0x58, 0x74,
-	0xeb, 0x68, 0x73, 0x47, 0x31, 0x49, 0xba, 0x60, 0x12, 0xa2, 0x11, 0x9d, 0x7a, 0xb7, 0xec, 0x0f,
-	0x24, 0xc0, 0x41, 0x56, 0x02, 0xcd, 0x1e, 0xc4, 0x1b, 0xd4, 0x15, 0x59, 0xf1, 0xac, 0x44, 0x77,
-	0x2e, 0x06, 0x3e, 0xde, 0x9b, 0xaf, 0x20, 0xd2, 0x1e, 0xa3, 0xcd, 0xd7, 0xc7, 0x57, 0x60, 0xa6,
-	0xb9, 0xe3, 0x9a, 0x69, 0x68, 0x75, 0x17, 0xf9, 0xf1, 0xba, 0x8b, 0xdc, 0xbd, 0xb6, 0x3f, 0x96,
-	0xe0, 0x5c, 0x68, 0x00, 0xef, 0xb4, 0x99, 0x68, 0x89, 0x51, 0x6c, 0x60, 0x17, 0x10, 0x4f, 0x34,
-	0x87, 0x88, 0xef, 0xc3, 0x6c, 0x9d, 0x1f, 0xcb, 0xc9, 0xda, 0x32, 0xd2, 0xb9, 0x9c, 0x69, 0x93,
-	0xfc, 0x5d, 0xa5, 0x68, 0x93, 0x40, 0x8c, 0xe8, 0x0c, 0x0c, 0xba, 0xb1, 0x57, 0x7c, 0xdd, 0x56,
-	0x3f, 0x88, 0x2d, 0x38, 0x1f, 0x61, 0x1d, 0x41, 0xc5, 0x1a, 0xf4, 0xd5, 0xbd, 0x60, 0x93, 0x61,
-	0x2f, 0x58, 0x71, 0xec, 0xba, 0x0f, 0x57, 0xe1, 0x8d, 0xcf, 0xc2, 0xf4, 0x81, 0xe2, 0xca, 0xe5,
-	0x6c, 0xcd, 0x2e, 0x2a, 0xd4, 0x30, 0xbd, 0x22, 0xfc, 0x44, 0x82, 0x33, 0xc1, 0x76, 0x22, 0xaf,
-	0x32, 0x9c, 0xf4, 0x6d, 0xd1, 0xae, 0xaa, 0xc9, 0x8a, 0xcf, 0x4c, 0xd4, 0xe1, 0xa5, 0x68, 0x9b,
-	0xb4, 0xab, 0x6a, 0xbe, 0x35, 0xc4, 0x2e, 0x8d, 0xd3, 0xe6, 0xd3, 0x16, 0x5e, 0x82, 0xb3, 0x59,
-	0x52, 0x50, 0x2d, 0x4a, 0x4c, 0x92, 0x4f, 0x17, 0x8b, 0x46, 0x99, 0xe4, 0x9d, 0xcb, 0x2a, 0x62,
-	0x21, 0x7e, 0x28, 0xc1, 0x4c, 0x98, 0xbf, 0x00, 0xa9, 0xc2, 0x50, 0xce, 0xd0, 0xa9, 0xa9, 0xe4,
-	0xa8, 0x6c, 0x51, 0x85, 0x12, 0x51, 0x7c, 0x6f, 0x07, 0xe2, 0x62, 0x21, 0x97, 0x85, 0x5f, 0x1d,
-	0x93, 0x9b, 0x4e, 0x0c, 0x81, 0x6f, 0xd0, 0x8d, 0xcc, 0x06, 0x71, 0x3a, 0x20, 0x29, 0xfe, 0x55,
-	0xe9, 0xa2, 0x1a, 0x6b, 0xb8, 0xd6, 0xbd, 0x2b, 0xfc, 0xbb, 0x12, 0x9c, 0x0b, 0x8d, 0xf1, 0xff,
-	0x47, 0x86, 0x61, 0x2a, 0x5d, 0x2c, 0x36, 0x4d, 0xcc, 0x2b, 0xbb, 0xc7, 0x12, 0x3b, 0x21, 0x5b,
-	0x19, 0x89, 0xa4, 0x77, 0x61, 0xb8, 0x3e, 0x69, 0xb7, 0xce, 0x5e, 0x44, 0xd6, 0x43, 0x75, 0x59,
-	0x5b, 0x0b, 0x7f, 0x38, 0x0f, 0x87, 0xef, 0xd8, 0xc4, 0x2c, 0xa3, 0x6f, 0x49, 0xd0, 0xc7, 0x85,
-	0x5a, 0xf4, 0x4a, 0x04, 0x35, 0x57, 0x60, 0x8a, 0x5f, 0x88, 0x64, 0xcb, 0xa1, 0xe1, 0x0b, 0x5f,
-	0xfb, 0xfd, 0xdf, 0x3e, 0x8c, 0x9d, 0x45, 0xd3, 0xa9, 0x20, 0x59, 0x5d, 0x64, 0xf1, 0x77, 0x09,
-	0x26, 0x5a, 0x6a, 0x5b, 0x68, 0x29, 0x70, 0xdd, 0x30, 0x61, 0x39, 0x7e, 0xa5, 0x53, 0x77, 0x81,
-	0xe4, 0x26, 0x43, 0xb2, 0x86, 0x56, 0x02, 0x91, 0x7c, 0x45, 0x94, 0xf0, 0xa3, 0x14, 0x11, 0x11,
-	0xf9, 0xff, 0x30, 0x10, 0x27, 0xa6, 0xf8, 0xea, 0x96, 0x55, 0x1d, 0x7d, 0x12, 0xf3, 0x29, 0x18,
-	0xe1, 0xba, 0x2e, 0xba, 0xdd, 0x59, 0xf6, 0x2d, 0xc5, 0xa4, 0xae, 0xe9, 0x50, 0x18, 0x1d, 0x5f,
-	0x46, 0x5f, 0x7a, 0x11, 0x74, 0xc8, 0x0f, 0x55, 0xba, 0xe3, 0xbc, 0x9d, 0x78, 0xa2, 0x32, 0x7b,
-	0x33, 0xa3, 0x6f, 0xc6, 0x60, 0x3a, 0x82, 0x74, 0x8b, 0xae, 0x47, 0x83, 0x12, 0x2a, 0xfe, 0x76,
-	0xcd, 0xc9, 0x17, 0x19, 0x27, 0x59, 0xb4, 0xd1, 0x36, 0x27, 0x2c, 0x37, 0xae, 0xba, 0x35, 0x2d,
-	0x97, 0x7f, 0x49, 0x10, 0x6f, 0xad, 0x0f, 0xa1, 0x8e, 0x12, 0xaf, 0xe9, 0x63, 0xf1, 0xab, 0x1d,
-	0xfb, 0x0b, 0xe4, 0xb7, 0x18, 0xf2, 0xeb, 0x68, 0xb5, 0xfb, 0x6a, 0x30, 0x6c, 0x8a, 0x7e, 0x18,
-	0x83, 0x8b, 0xed, 0x28, 0xa4, 0x68, 0xa3, 0x43, 0x00, 0xad, 0xfb, 0xa3, 0x6b, 0x4a, 0xb6, 0x19,
-	0x25, 0xef, 0xa3, 0xf7, 0x5e, 0x08, 0x25, 0xcd, 0x3b, 0xe4, 0x71, 0x0c, 0xce, 0x44, 0xd1, 0x41,
-	0xd1, 0x8d, 0xee, 0x5a, 0xe4, 0x45, 0x96, 0xca, 0x3d, 0xc6, 0xcb, 0xbb, 0xe8, 0x0b, 0x6d, 0xf2,
-	0xe2, 0xb0, 0x10, 0xd2, 0x28, 0x4e, 0xe9, 0x7c, 0x24, 0x41, 0xbf, 0xab, 0x57, 0xa2, 0x8b, 0x81,
-	0xc9, 0x36, 0x28, 0x9d, 0xf1, 0xb9, 0x88, 0xd6, 0x02, 0x48, 0x92, 0x01, 0x99, 0x45, 0x33, 0x81,
-	0x40, 0x3c, 0x31, 0x14, 0x7d, 0x5b, 0x82, 0x5e, 0x27, 0x02, 0x9a, 0x0d, 0xbe, 0x40, 0x6b, 0x4a,
-	0x47, 0xfc, 0x7c, 0x04, 0x4b, 0x91, 0xcd, 0x25, 0x96, 0x4d, 0x12, 0x5d, 0x0c, 0xcc, 0x86, 0x65,
-	0x52, 0x23, 0x97, 0xb1, 0xe5, 0x4a, 0xa0, 0x21, 0x6c, 0x35, 0x88, 0xa7, 0x21, 0x6c, 0x35, 0xea,
-	0xaa, 0x11, 0xd9, 0x52, 0x8a, 0xc5, 0x39, 0xce, 0xd6, 0x2f, 0x24, 0x18, 0x69, 0x94, 0x43, 0x51,
-	0xf0, 0xbb, 0xbb, 0x85, 0x00, 0x1b, 0x7f, 0xad, 0x4d, 0x2f, 0x91, 0xf1, 0x9b, 0x2c, 0xe3, 0x05,
-	0xf4, 0x6a, 0x60, 0xc6, 0x45, 0xd5, 0xa2, 0x3c, 0xe5, 0xb9, 0xed, 0xf2, 0x1c, 0xff, 0x5c, 0xfa,
-	0x58, 0x82, 0x01, 0x4f, 0xa4, 0x44, 0xc1, 0x44, 0x35, 0xca, 0xb3, 0xf1, 0x64, 0x54, 0x73, 0x91,
-	0xe6, 0x22, 0x4b, 0x73, 0x0e, 0x5d, 0x68, 0x9a, 0x66, 0xc3, 0x86, 0xa7, 0x98, 0x3e, 0x61, 0xa1,
-	0xa7, 0x12, 0xa0, 0x83, 0x82, 0x25, 0x7a, 0x3d, 0xf8, 0xbb, 0xa6, 0x95, 0x58, 0x1a, 0x7f, 0xa3,
-	0x6d, 0x3f, 0x91, 0xfc, 0x3a, 0x4b, 0x7e, 0x19, 0xa5, 0xdb, 0xa9, 0xda, 0x14, 0x75, 0x02, 0xf2,
-	0x43, 0xc0, 0x93, 0x0c, 0xd1, 0x4f, 0x24, 0x18, 0xaa, 0x17, 0x33, 0xd1, 0x42, 0x78, 0x5a, 0x07,
-	0xa0, 0x2c, 0xb6, 0xe5, 0xd3, 0x56, 0xf3, 0xf1, 0xb4, 0x6b, 0x19, 0x3f, 0x71, 0x37, 0xa1, 0x4e,
-	0x9a, 0x8c, 0xb2, 0x09, 0xcd, 0x64, 0xd1, 0x28, 0x9b, 0xd0, 0x54, 0x03, 0xc5, 0x69, 0x96, 0xfd,
-	0x5b, 0xe8, 0xb3, 0x1d, 0x6c, 0x02, 0x17, 0x34, 0xd1, 0xaf, 0x25, 0x78, 0xb9, 0x89, 0xb2, 0x88,
-	0x42, 0x72, 0x6a, 0xa9, 0x81, 0xc6, 0xdf, 0x6c, 0xdf, 0x51, 0xa0, 0xb9, 0xcc, 0xd0, 0x5c, 0x42,
-	0x0b, 0xc1, 0x7b, 0xc1, 0x23, 0xc8, 0x25, 0x45, 0x35, 0x65, 0xf6, 0x45, 0x7e, 0x9f, 0x10, 0xf4,
-	0x4f, 0x09, 0x12, 0x21, 0xea, 0x1b, 0x5a, 0x8e, 0x74, 0x01, 0x06, 0x8b, 0x9f, 0xf1, 0x95, 0xee,
-	0x82, 0x08, 0xa8, 0x4b, 0x0c, 0xea, 0x1b, 0xe8, 0xb5, 0x76, 0xaf, 0x52, 0x07, 0x3d, 0x41, 0xcf,
-	0x24, 0x88, 0xb7, 0x16, 0xe6, 0x42, 0x1e, 0x95, 0xa1, 0xba, 0x5f, 0xc8, 0x4b, 0x21, 0x5c, 0x11,
-	0xc4, 0xcb, 0x0c, 0xde, 0x12, 0x7a, 0x2b, 0xec, 0xca, 0x90, 0x5b, 0x0b, 0x87, 0xe8, 0x3f, 0x12,
-	0x24, 0x42, 0xe4, 0xb9, 0x90, 0x2d, 0x8d, 0xa6, 0x0e, 0x86, 0x6c, 0x69, 0x44, 0x85, 0x10, 0xdf,
-	0x61, 0x98, 0xdf, 0x41, 0xeb, 0xc1, 0x5b, 0xca, 0xee, 0x99, 0x47, 0xa9, 0x96, 0xb8, 0x65, 0x26,
-	0xad, 0xf3, 0xdb, 0xe8, 0x7b, 0x31, 0x38, 0x1d, 0xaa, 0xcb, 0xa1, 0xd5, 0xe8, 0xe9, 0x07, 0xe8,
-	0x87, 0xf1, 0xb5, 0x6e, 0xc3, 0x08, 0x1e, 0xf2, 0x8c, 0x87, 0x0f, 0xd0, 0xfb, 0xc1, 0x3c, 0xd4,
-	0x09, 0x90, 0x8f, 0x5a, 0xf2, 0xc2, 0x86, 0x2d, 0x99, 0x1a, 0xb2, 0xc2, 0x17, 0x93, 0xf7, 0x18,
-	0xe8, 0x7f, 0x48, 0x70, 0x2a, 0x48, 0x15, 0x44, 0xd7, 0xda, 0xab, 0xe1, 0x83, 0xc2, 0x63, 0x3c,
-	0xdd, 0x45, 0x04, 0xc1, 0xc5, 0x2a, 0xe3, 0xe2, 0x2a, 0x5a, 0x6a, 0xbf, 0x0f, 0xfc, 0x58, 0xfe,
-	0x2d, 0xc1, 0x64, 0xb0, 0x3e, 0x88, 0x32, 0x81, 0xc9, 0x46, 0x12, 0x27, 0xe3, 0xcb, 0x5d, 0xc5,
-	0x10, 0x90, 0x6f, 0x33, 0xc8, 0xeb, 0xe8, 0x7a, 0xa4, 0x36, 0x30, 0xbd, 0xa0, 0xb2, 0xc2, 0xa3,
-	0xf2, 0xc7, 0x81, 0xaf, 0x09, 0xbe, 0x1a, 0x83, 0x44, 0x88, 0x86, 0x88, 0x3a, 0xcc, 0xbc, 0x4e,
-	0xc5, 0x0c, 0x39, 0x06, 0x22, 0xca, 0x98, 0x78, 0x93, 0xe1, 0xbf, 0x85, 0xde, 0x89, 0x78, 0xb2,
-	0x07, 0x32, 0x20, 0xac, 0xd0, 0x9f, 0x25, 0x98, 0x68, 0x29, 0x46, 0x86, 0xc8, 0x6b, 0x61, 0x4a,
-	0x67, 0xfc, 0x4a, 0xa7, 0xee, 0x6d, 0x3d, 0x42, 0x9c, 0x22, 0x6f, 0x81, 0xd5, 0xca, 0xdc, 0x7b,
-	0xf2, 0x6c, 0x52, 0x7a, 0xfa, 0x6c, 0x52, 0xfa, 0xeb, 0xb3, 0x49, 0xe9, 0xf1, 0xf3, 0xc9, 0x43,
-	0x4f, 0x9f, 0x4f, 0x1e, 0xfa, 0xe3, 0xf3, 0xc9, 0x43, 0xef, 0x2d, 0xfb, 0xfe, 0x87, 0x56, 0x84,
-	0x9f, 0x2b, 0x2a, 0xdb, 0x96, 0xb7, 0xd6, 0xde, 0xc2, 0xeb, 0xa9, 0xfd, 0xba, 0x15, 0x73, 0x45,
-	0x95, 0xe8, 0x94, 0xff, 0xb5, 0x2e, 0xff, 0x2b, 0x8b, 0x3e, 0xf6, 0xcf, 0xe2, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xe5, 0xbb, 0x1b, 0x5b, 0xfc, 0x2c, 0x00, 0x00,
+	// 3172 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4f, 0x6c, 0x1b, 0xc7,
+	0xd5, 0xf7, 0x52, 0xb2, 0x62, 0x3d, 0x5b, 0xb4, 0x3c, 0xb6, 0x24, 0x6a, 0xed, 0x4f, 0x94, 0xc7,
+	0xff, 0x94, 0xd8, 0x22, 0x23, 0xc9, 0x8e, 0x13, 0x27, 0xb6, 0x43, 0x4a, 0x96, 0xad, 0xc4, 0xf9,
+	0xec, 0xd0, 0x4e, 0xf2, 0x7d, 0xfe, 0x92, 0x2c, 0x56, 0xe4, 0x98, 0x5e, 0x78, 0xb9, 0x4b, 0x73,
+	0x87, 0xb2, 0x84, 0xc0, 0xc0, 0xd7, 0x9e, 0x7a, 0x28, 0x8a, 0xb4, 0x69, 0x91, 0x02, 0x6d, 0x11,
+	0xe4, 0xd0, 0x4b, 0x7b, 0x68, 0x0b, 0x14, 0x45, 0x7b, 0x69, 0x7a, 0x68, 0x81, 0xa0, 0x40, 0x0b,
+	0x03, 0xbd, 0x14, 0x45, 0xcb, 0x16, 0x4e, 0x0f, 0x45, 0xdb, 0x4b, 0x79, 0xec, 0xa5, 0xc5, 0xce,
+	0xcc, 0x2e, 0x77, 0x57, 0xe4, 0xfe, 0x21, 0x9d, 0x22, 0xa7, 0x88, 0x33, 0xef, 0xbd, 0x79, 0xbf,
+	0x37, 0xef, 0xbd, 0x79, 0xfb, 0x9e, 0x03, 0x27, 0x4c, 0xab, 0x66, 0x5a, 0x9a, 0x95, 0xaf, 0x9b,
+	0xa6, 0x5e, 0x53, 0x0d, 0xb5, 0x4a, 0x1a, 0xf9, 0x8d, 0x85, 0x75, 0x42, 0xd5, 0x85, 0xfc, 0xbd,
+	0x26, 0x69, 0x6c, 0xe5, 0xea, 0x0d, 0x93, 0x9a, 0xe8, 0xa0, 0x20, 0xcc, 0x79, 0x08, 0x73, 0x82,
+	0x50, 0x3e, 0x50, 0x35, 0xab, 0x26, 0xa3, 0xcb, 0xdb, 0x7f, 0x71, 0x16, 0xf9, 0xc9, 0x30, 0xd9,
+	0x55, 0x62, 0x10, 0x26, 0x8e, 0x91, 0xe6, 0xc2, 0x48, 0x6b, 0x66, 0xa5, 0xa9, 0x13, 0xa5, 0x61,
+	0x36, 0x29, 0x11, 0xf4, 0x47, 0xc3, 0xe8, 0xe9, 0xa6, 0xa0, 0x3a, 0x15, 0x46, 0x65, 0xdd, 0x57,
+	0xeb, 0x3e, 0x99, 0x0b, 0xa1, 0x32, 0xd5, 0xbb, 0xa4, 0xa1, 0xdc, 0x26, 0x44, 0xb1, 0xee, 0xa8,
+	0x0d, 0x87, 0x65, 0xa6, 0xcc, 0x78, 0xf2, 0xeb, 0xaa, 0x45, 0x5c, 0xd2, 0xb2, 0xa9, 0x19, 0x62,
+	0xff, 0x29, 0xef, 0x3e, 0xb3, 0xa6, 0x4b, 0x55, 0x57, 0xab, 0x9a, 0xa1, 0x52, 0xcd, 0x74, 0x68,
+	0x0f, 0x55, 0x4d, 0xb3, 0xaa, 0x93, 0xbc, 0x5a, 0xd7, 0xf2, 0xaa, 0x61, 0x98, 0x94, 0x6d, 0x3a,
+	0x06, 0x9a, 0x16, 0xbb, 0xec, 0xd7, 0x7a, 0xf3, 0x76, 0x5e, 0x35, 0xb6, 0x9c, 0x2d, 0x7e, 0x88,
+	0xc2, 0xed, 0xcf, 0x7f, 0x88, 0xad, 0x6c, 0x90, 0x8b, 0x6a, 0x35, 0x62, 0x51, 0xb5, 0x56, 0xe7,
+	0x04, 0x78, 0x2f, 0x8c, 0x5d, 0x57, 0x1b, 0x6a, 0xcd, 0x2a, 0x91, 0x7b, 0x4d, 0x62, 0x51, 0x7c,
+	0x03, 0xd2, 0xce, 0x82, 0x55, 0x37, 0x0d, 0x8b, 0xa0, 0x02, 0x8c, 0xd4, 0xd9, 0x4a, 0x46, 0x9a,
+	0x95, 0xe6, 0x76, 0x2f, 0x1e, 0xc9, 0x85, 0x78, 0x42, 0x8e, 0x33, 0x17, 0x87, 0x3f, 0x6e, 0x65,
+	0x77, 0x94, 0x04, 0x23, 0xfe, 0x41, 0x0a, 0x66, 0x2f, 0x59, 0x54, 0xab, 0xa9, 0x94, 0xdc, 0xb8,
+	0xaf, 0xd6, 0x2f, 0x6d, 0xaa, 0x65, 0x5a, 0xa8, 0x99, 0x4d, 0x83, 0xae, 0x19, 0xe2, 0x64, 0x74,
+	0x1e, 0x46, 0x2c, 0x62, 0x54, 0x48, 0x83, 0x9d, 0x33, 0x5a, 0x3c, 0xd6, 0x6e, 0x65, 0xb3, 0x5b,
+	0x6a, 0x4d, 0x3f, 0x87, 0xf9, 0x3a, 0x3e, 0x55, 0x21, 0xf5, 0x06, 0x29, 0xab, 0x94, 0x54, 0xce,
+	0x61, 0xda, 0x68, 0x12, 0x9c, 0x91, 0x4a, 0x82, 0x09, 0x5d, 0x84, 0x27, 0x6c, 0x7d, 0x14, 0xad,
+	0x92, 0x49, 0xcd, 0x4a, 0x73, 0xc3, 0xc5, 0xe3, 0xed, 0x56, 0x76, 0x96, 0xf3, 0x8b, 0x8d, 0x1e,
+	0x02, 0xec, 0xdd, 0xb5, 0x0a, 0xca, 0xc1, 0x2e, 0x6a, 0xde, 0x25, 0x86, 0xa2, 0x19, 0x99, 0x21,
+	0xa6, 0xc1, 0xfe, 0x76, 0x2b, 0xbb, 0x97, 0x4b, 0x70, 0x76, 0x70, 0xe9, 0x09, 0xf6, 0xe7, 0x9a,
+	0x81, 0xde, 0x82, 0x11, 0xe6, 0x3d, 0x56, 0x66, 0x78, 0x76, 0x68, 0x6e, 0xf7, 0x62, 0x2e, 0xd4,
+	0x2e, 0x36, 0x6c, 0x17, 0xb1, 0xcd, 0x56, 0x9c, 0xb0, 0x4d, 0xd4, 0x6e, 0x65, 0xc7, 0xf8, 0x09,
+	0x5c, 0x16, 0x2e, 0x09, 0xa1, 0xf8, 0xa7, 0x29, 0x58, 0xec, 0x69, 0xb3, 0x37, 0x34, 0x7a, 0xe7,
+	0x7a, 0x43, 0xab, 0x69, 0x54, 0xdb, 0x20, 0x37, 0xb7, 0xea, 0xc4, 0xb9, 0x3f, 0xaf, 0x19, 0xa4,
+	0x81, 0xcd, 0x90, 0x8a, 0x61, 0x86, 0x8b, 0x90, 0xe6, 0x1a, 0x2b, 0xce, 0xb9, 0x43, 0xb3, 0x43,
+	0x73, 0xc3, 0xc5, 0xe9, 0x76, 0x2b, 0x3b, 0xe1, 0x85, 0xe6, 0xec, 0xe3, 0xd2, 0x1e, 0xbe, 0x70,
+	0x9d, 0x1f, 0xf8, 0x3a, 0x4c, 0x0a, 0x02, 0x2e, 0xdd, 0x6c, 0x52, 0xa5, 0x42, 0x0c, 0xb3, 0xc6,
+	0xec, 0x3a, 0x5a, 0x3c, 0xdc, 0x6e, 0x65, 0xff, 0xcb, 0x27, 0x28, 0x40, 0x87, 0x4b, 0xfb, 0xf9,
+	0xc6, 0x4d, 0x7b, 0xfd, 0x5a, 0x93, 0xae, 0xb0, 0xd5, 0x5f, 0x49, 0xf0, 0x94, 0x6b, 0x40, 0xcd,
+	0xa8, 0xea, 0xc4, 0x3e, 0xb0, 0xa7, 0xfb, 0x9d, 0x0c, 0x1a, 0x0e, 0xb5, 0x5b, 0xd9, 0xb4, 0xdf,
+	0x70, 0x7d, 0x1b, 0xa9, 0x08, 0x7b, 0x83, 0xe0, 0xb8, 0x8b, 0xc9, 0xed, 0x56, 0x76, 0xd2, 0xcb,
+	0xe6, 0x41, 0x35, 0x46, 0x7d, 0x78, 0x5a, 0x12, 0x1c, 0x0e, 0x09, 0x22, 0x11, 0xad, 0xeb, 0x30,
+	0xde, 0x11, 0xa4, 0xb2, 0x5d, 0x11, 0x4f, 0xcf, 0xda, 0xfe, 0xf6, 0xbb, 0x56, 0x76, 0x82, 0x67,
+	0x08, 0xab, 0x72, 0x37, 0xa7, 0x99, 0xf9, 0x9a, 0x4a, 0xef, 0xe4, 0xd6, 0x0c, 0xda, 0x6e, 0x65,
+	0xa7, 0x82, 0x7a, 0x70, 0x76, 0x5c, 0x4a, 0x3b, 0x8a, 0xf0, 0xd3, 0xd0, 0x6b, 0x30, 0x7c, 0xc7,
+	0xac, 0x5b, 0x99, 0x14, 0xf3, 0xfb, 0x53, 0x91, 0x7e, 0x7f, 0xc5, 0xac, 0x3b, 0x8a, 0x17, 0xf7,
+	0x0b, 0xaf, 0xdf, 0xcd, 0x0f, 0xb3, 0xe5, 0xe0, 0x12, 0x13, 0x87, 0x7f, 0x94, 0xea, 0x09, 0xf0,
+	0x5a, 0x93, 0x7e, 0x56, 0xd2, 0xc4, 0xdb, 0x6e, 0xd8, 0x0f, 0x31, 0xf8, 0xf9, 0x98, 0x61, 0x6f,
+	0x43, 0x88, 0x11, 0xf7, 0x68, 0x01, 0x46, 0xdd, 0x1b, 0xc8, 0x0c, 0x33, 0x88, 0x07, 0xda, 0xad,
+	0xec, 0x78, 0xe0, 0x72, 0x70, 0x69, 0x97, 0x73, 0x2b, 0xf8, 0xa3, 0x14, 0x2c, 0xf5, 0x36, 0xdc,
+	0xa7, 0x98, 0x2b, 0xb6, 0xc7, 0x7e, 0x2a, 0x59, 0xec, 0xdf, 0x80, 0x09, 0x5f, 0x4c, 0x6b, 0x86,
+	0x1b, 0x1d, 0x76, 0xe8, 0xcf, 0xb6, 0x5b, 0xd9, 0x43, 0x5d, 0x42, 0xdf, 0x21, 0xc3, 0x25, 0xe4,
+	0x89, 0xfc, 0x35, 0x83, 0x05, 0x4a, 0x3f, 0x16, 0xfc, 0xb5, 0x04, 0x27, 0x23, 0x73, 0x85, 0xc7,
+	0x09, 0x13, 0x25, 0x8b, 0x8b, 0x90, 0x0e, 0xa0, 0xe3, 0x29, 0xc3, 0x63, 0xa5, 0x20, 0xac, 0x3d,
+	0xb4, 0x27, 0xa0, 0xa1, 0x58, 0x80, 0x7e, 0x2f, 0x01, 0x0e, 0x8b, 0x25, 0x91, 0x2d, 0x14, 0x27,
+	0x2f, 0x69, 0x86, 0x3f, 0x59, 0x9c, 0x8d, 0x4a, 0x16, 0x93, 0x01, 0xc5, 0x9d, 0x5c, 0x31, 0x26,
+	0x34, 0xff, 0x74, 0x53, 0xc5, 0x3e, 0xd8, 0xfb, 0xdf, 0xcd, 0x9a, 0x7d, 0x47, 0x6e, 0xe1, 0x72,
+	0x09, 0xc6, 0x3b, 0x4b, 0x02, 0xde, 0x02, 0x8c, 0x1a, 0xcd, 0x1a, 0x73, 0x3e, 0x4b, 0x5c, 0x94,
+	0xc7, 0x70, 0xee, 0x16, 0x2e, 0xed, 0x32, 0x04, 0x2b, 0x3e, 0x07, 0xbb, 0xed, 0x3f, 0xfa, 0xb9,
+	0x68, 0xbc, 0x0c, 0x7b, 0x38, 0xaf, 0x38, 0x7e, 0x09, 0x86, 0xed, 0x1d, 0x51, 0x37, 0x1d, 0xc8,
+	0xf1, 0x62, 0x2c, 0xe7, 0x14, 0x63, 0xb9, 0x82, 0xb1, 0x55, 0x1c, 0xfd, 0xe5, 0x0f, 0xe7, 0x77,
+	0xb2, 0x68, 0x28, 0x31, 0x62, 0x1b, 0x5a, 0x41, 0xd7, 0x7d, 0xd0, 0xd6, 0x60, 0xbc, 0xb3, 0x24,
+	0x64, 0x9f, 0x81, 0x9d, 0x0e, 0xac, 0xa1, 0x38, 0xc2, 0x39, 0x35, 0x2e, 0xc0, 0xd4, 0x55, 0xcd,
+	0xa2, 0x4c, 0x56, 0x71, 0x8b, 0xb9, 0x97, 0x03, 0xf5, 0x38, 0xec, 0xe4, 0xde, 0xc9, 0x3d, 0x60,
+	0xbc, 0xdd, 0xca, 0xee, 0xe1, 0x40, 0x85, 0x53, 0xf2, 0x6d, 0xfc, 0x2a, 0x64, 0xb6, 0x8b, 0x18,
+	0x4c, 0xab, 0x87, 0x12, 0x8c, 0xdf, 0xa8, 0x9b, 0xf4, 0x7a, 0x43, 0x2b, 0x93, 0xbe, 0x62, 0xec,
+	0x12, 0x8c, 0xdb, 0x35, 0xb6, 0xa2, 0x5a, 0x16, 0xa1, 0xbe, 0x28, 0x3b, 0xd8, 0x79, 0xd9, 0x82,
+	0x14, 0xb8, 0x94, 0xb6, 0x97, 0x0a, 0xf6, 0x0a, 0x8f, 0xb4, 0x2b, 0xb0, 0xef, 0x5e, 0xd3, 0xa4,
+	0x7e, 0x39, 0x3c, 0xe2, 0x0e, 0xb5, 0x5b, 0xd9, 0x0c, 0x97, 0xb3, 0x8d, 0x04, 0x97, 0xf6, 0xb2,
+	0xb5, 0x8e, 0x24, 0xbc, 0x06, 0xfb, 0x3c, 0x88, 0x84, 0x79, 0x4e, 0x03, 0x58, 0x75, 0x93, 0x2a,
+	0x75, 0x7b, 0x55, 0xd8, 0x79, 0xa2, 0xdd, 0xca, 0xee, 0xe3, 0x72, 0x3b, 0x7b, 0xb8, 0x34, 0x6a,
+	0x39, 0xdc, 0xf8, 0x1f, 0x12, 0x4c, 0xb0, 0xa7, 0x63, 0x9b, 0x89, 0xb6, 0x67, 0x16, 0x29, 0x59,
+	0x66, 0x19, 0x38, 0x81, 0xf7, 0x2e, 0xde, 0x86, 0x06, 0x2a, 0xde, 0x3e, 0x4a, 0xc1, 0x64, 0x10,
+	0xb3, 0x30, 0xe2, 0x1b, 0x5d, 0x8c, 0xe8, 0xd4, 0x36, 0x07, 0xb7, 0xa7, 0xab, 0xab, 0xa4, 0xaa,
+	0x96, 0xb7, 0x56, 0x48, 0x39, 0xca, 0xce, 0x68, 0x03, 0x26, 0x3a, 0x3b, 0x8a, 0x7a, 0x9b, 0xf2,
+	0x0f, 0x3e, 0x4b, 0x38, 0xd2, 0x72, 0xbc, 0x33, 0x0e, 0x05, 0xcf, 0xf0, 0x48, 0xc2, 0x25, 0xe4,
+	0x1e, 0x57, 0xb0, 0x57, 0x57, 0x09, 0xb1, 0xd0, 0x4d, 0x3b, 0xbd, 0x53, 0x55, 0xb7, 0x49, 0x32,
+	0x43, 0xbe, 0xf4, 0x1b, 0x71, 0x96, 0xfb, 0x02, 0x08, 0x6e, 0xf6, 0x02, 0x50, 0x55, 0x5f, 0x25,
+	0x04, 0x4f, 0xc3, 0x94, 0x7d, 0x47, 0xf6, 0x83, 0x7f, 0x59, 0xb5, 0x96, 0x4d, 0x8b, 0xba, 0xf9,
+	0xe4, 0x5b, 0x12, 0x64, 0xb6, 0xef, 0x09, 0xf3, 0xfe, 0xbf, 0x04, 0xfb, 0xd9, 0x65, 0xd3, 0xad,
+	0x3a, 0x51, 0xaa, 0xaa, 0xa5, 0x94, 0xed, 0x7d, 0x11, 0xd1, 0xf3, 0xe1, 0x1f, 0x7f, 0x01, 0xa1,
+	0x45, 0x2c, 0x52, 0xb8, 0xec, 0x09, 0x5b, 0xbf, 0x5c, 0x5c, 0x1a, 0xaf, 0x07, 0xb8, 0xf0, 0x2d,
+	0x98, 0x72, 0x1e, 0x01, 0xe6, 0x03, 0x97, 0x55, 0x4f, 0xc9, 0x12, 0x74, 0x58, 0x29, 0x91, 0xc3,
+	0xe2, 0x17, 0x20, 0xb3, 0x5d, 0xb6, 0x80, 0x3e, 0x0b, 0x43, 0x55, 0xd5, 0x79, 0x28, 0xd2, 0xed,
+	0x56, 0x16, 0xb8, 0xc4, 0xaa, 0x6a, 0xe1, 0x92, 0xbd, 0x85, 0xaf, 0xc0, 0xf4, 0x4d, 0xdb, 0xc0,
+	0xb6, 0xb0, 0xab, 0xda, 0xbd, 0xa6, 0x56, 0xd1, 0xe8, 0x56, 0x5f, 0x6f, 0xc5, 0x37, 0x24, 0x90,
+	0xbb, 0x89, 0x12, 0xaa, 0x3c, 0x80, 0x51, 0xdd, 0x59, 0x14, 0xa6, 0x9f, 0xce, 0x89, 0x4f, 0x7b,
+	0x3b, 0x67, 0xb9, 0x26, 0x5f, 0x36, 0x35, 0xa3, 0xb8, 0x22, 0xcc, 0x2c, 0xfc, 0xc1, 0xe5, 0xc4,
+	0xdf, 0xf9, 0x63, 0x76, 0xae, 0xaa, 0xd1, 0x3b, 0xcd, 0xf5, 0x5c, 0xd9, 0xac, 0x89, 0xde, 0x80,
+	0xf8, 0xcf, 0xbc, 0x55, 0xb9, 0x9b, 0xb7, 0x2f, 0xc3, 0x62, 0x42, 0xac, 0x52, 0xe7, 0x44, 0x3c,
+	0x05, 0x13, 0x4c, 0xb9, 0x20, 0x46, 0xfc, 0xbe, 0x04, 0x93, 0xc1, 0x9d, 0xcf, 0x86, 0xca, 0xce,
+	0xd5, 0xbc, 0x6e, 0xea, 0xcd, 0x1a, 0x59, 0x35, 0x1b, 0x7d, 0x3f, 0xe3, 0x5f, 0x71, 0xae, 0x26,
+	0x20, 0x4a, 0xe0, 0xa4, 0x30, 0xb2, 0xc1, 0x36, 0xa2, 0x41, 0x16, 0xfc, 0xa5, 0x3e, 0x67, 0x4b,
+	0x86, 0x50, 0x9c, 0x85, 0x37, 0x40, 0xbe, 0xd9, 0x50, 0x2b, 0x9a, 0x51, 0xbd, 0xae, 0x6a, 0x8d,
+	0x9b, 0x76, 0x37, 0x6a, 0x95, 0x78, 0xdf, 0x4a, 0x96, 0x4d, 0x95, 0xa7, 0x45, 0x42, 0xf4, 0xe0,
+	0x13, 0x1b, 0xb8, 0x34, 0xc2, 0xfe, 0x7a, 0xba, 0x43, 0xbc, 0x90, 0x49, 0x75, 0x27, 0x5e, 0x70,
+	0x88, 0x17, 0xb0, 0x02, 0x07, 0xbb, 0x9e, 0x2b, 0x8c, 0xf1, 0x22, 0x8c, 0xba, 0x9d, 0x31, 0x71,
+	0xf4, 0x91, 0x18, 0xb9, 0xab, 0xb4, 0x8b, 0x0a, 0x49, 0x76, 0x9f, 0xe3, 0xb8, 0x13, 0x91, 0xf6,
+	0x49, 0xa4, 0xa8, 0x5a, 0xa4, 0x72, 0xcd, 0x60, 0x49, 0x72, 0xad, 0x56, 0x57, 0xcb, 0x6e, 0xd5,
+	0xfd, 0x02, 0x8c, 0xde, 0x6e, 0x98, 0x35, 0xc5, 0x6e, 0xb0, 0x89, 0xa2, 0x2a, 0xc4, 0xf8, 0xbc,
+	0x05, 0xb5, 0xcb, 0xe6, 0xb0, 0x7f, 0x23, 0x0c, 0x63, 0xd4, 0x64, 0xbc, 0xde, 0xfa, 0xa0, 0xb4,
+	0x9b, 0x9a, 0xf6, 0x36, 0x7f, 0x0f, 0xa7, 0x3a, 0x7e, 0x62, 0x27, 0xe2, 0x61, 0xb7, 0xbe, 0x78,
+	0x05, 0xc6, 0x6b, 0xea, 0xa6, 0x48, 0xe8, 0x1a, 0xd3, 0x2a, 0x33, 0x1c, 0x1f, 0x6e, 0xba, 0xa6,
+	0x6e, 0x7a, 0x00, 0xa1, 0x97, 0x20, 0x4d, 0x36, 0x29, 0x69, 0x18, 0xaa, 0x2e, 0xde, 0xb1, 0x9d,
+	0xf1, 0x85, 0x8d, 0x39, 0xac, 0xbc, 0x3c, 0xf8, 0xae, 0x04, 0x27, 0x22, 0x0d, 0x28, 0xae, 0xeb,
+	0x02, 0x80, 0x66, 0xd4, 0x9b, 0x34, 0x91, 0x09, 0x47, 0x19, 0x0b, 0xb3, 0xe1, 0x8b, 0xb0, 0xdb,
+	0x6c, 0x52, 0x57, 0x40, 0x2a, 0x9e, 0x00, 0xe0, 0x3c, 0xf6, 0x0a, 0x3e, 0x02, 0x87, 0x0b, 0xba,
+	0xee, 0xf8, 0xd1, 0x0d, 0xbb, 0x97, 0x5a, 0xa8, 0x36, 0x08, 0xa9, 0x11, 0xa3, 0xf3, 0x40, 0x7d,
+	0x53, 0x02, 0x1c, 0x46, 0x25, 0xd0, 0x6c, 0x80, 0x1c, 0x68, 0xcb, 0x2a, 0xaa, 0x4b, 0x25, 0xa2,
+	0x73, 0x29, 0xf4, 0xc1, 0xea, 0x7e, 0x82, 0x50, 0x7b, 0x8a, 0x76, 0x3f, 0x1f, 0x5f, 0x80, 0xe3,
+	0xdd, 0x19, 0x57, 0x1b, 0x66, 0xcd, 0x57, 0x53, 0x1f, 0xf0, 0xd5, 0xd4, 0x4e, 0x05, 0xfd, 0x81,
+	0x04, 0x27, 0x22, 0x05, 0xb8, 0xd9, 0x66, 0xba, 0x27, 0x46, 0x71, 0x81, 0x03, 0x40, 0x9c, 0xec,
+	0x0e, 0x11, 0xdf, 0x86, 0x39, 0x1f, 0x1f, 0xd3, 0xc9, 0xba, 0x69, 0x16, 0xca, 0xe5, 0x46, 0x93,
+	0x54, 0x5e, 0x57, 0xf5, 0x26, 0x09, 0xc5, 0x88, 0x8e, 0xc2, 0x98, 0x23, 0x7b, 0xc5, 0x13, 0x6d,
+	0xfe, 0x45, 0x6c, 0xc1, 0x93, 0x31, 0xce, 0x11, 0xa6, 0x58, 0x85, 0x11, 0xdf, 0x37, 0x6a, 0x2e,
+	0xea, 0x1b, 0x55, 0xa4, 0x5d, 0xe7, 0xd3, 0x54, 0x70, 0xe3, 0x63, 0x70, 0x64, 0x9b, 0x73, 0x95,
+	0xcb, 0xcd, 0x5a, 0x53, 0x57, 0xa9, 0xd9, 0x70, 0x9d, 0xf0, 0x43, 0x09, 0x8e, 0x86, 0xd3, 0x09,
+	0xbd, 0xb6, 0xe0, 0xa0, 0xe7, 0x8a, 0xee, 0x6a, 0x35, 0x45, 0xf5, 0x90, 0x09, 0x3f, 0x3c, 0x1d,
+	0xef, 0x92, 0xee, 0x6a, 0x35, 0xcf, 0x19, 0xe2, 0x96, 0x32, 0xb4, 0xfb, 0xb6, 0x85, 0xcf, 0xc3,
+	0xb1, 0x12, 0xa9, 0x6a, 0x16, 0x25, 0x0d, 0x52, 0x29, 0xe8, 0xba, 0xb9, 0x45, 0x2a, 0xf6, 0x63,
+	0x15, 0xd3, 0x11, 0xdf, 0x93, 0xe0, 0x78, 0x14, 0xbf, 0x00, 0xa9, 0x41, 0xba, 0x6c, 0x1a, 0xb4,
+	0xa1, 0x96, 0xa9, 0x62, 0x51, 0x95, 0x12, 0xe1, 0x7c, 0x2f, 0x84, 0xe2, 0x62, 0x22, 0x97, 0x05,
+	0x9f, 0xcf, 0x92, 0x37, 0x6c, 0x19, 0x02, 0xdf, 0x98, 0x23, 0x99, 0x2d, 0xe2, 0x42, 0x88, 0x52,
+	0xbc, 0x8a, 0x73, 0x50, 0x4d, 0x05, 0x9e, 0x75, 0xf7, 0x09, 0xff, 0xaa, 0x04, 0x27, 0x22, 0x65,
+	0xfc, 0xe7, 0x91, 0x61, 0x98, 0x2d, 0xe8, 0x7a, 0x57, 0xc5, 0x5c, 0xb7, 0x7b, 0x57, 0x82, 0xc3,
+	0x21, 0x44, 0x42, 0xe9, 0xbb, 0xb0, 0xd7, 0xaf, 0xb4, 0xe3, 0x67, 0x8f, 0x43, 0xeb, 0xb4, 0x4f,
+	0x6b, 0x0b, 0x6f, 0xc2, 0xc4, 0xb2, 0x6a, 0x98, 0x86, 0x56, 0x56, 0x75, 0x56, 0x34, 0x27, 0x6c,
+	0x19, 0xa0, 0xb3, 0xb0, 0x9b, 0x7f, 0x33, 0x7b, 0x3f, 0xcc, 0x27, 0xdb, 0xad, 0x2c, 0xf2, 0x7e,
+	0x50, 0x0b, 0x1e, 0x60, 0xbf, 0x78, 0x7e, 0xa0, 0x30, 0x19, 0x3c, 0x59, 0x18, 0xe0, 0x16, 0xec,
+	0x64, 0x75, 0x7d, 0x46, 0xea, 0x6b, 0xf8, 0x72, 0x40, 0x54, 0x66, 0x7b, 0x3c, 0xdf, 0x0c, 0xb8,
+	0xc4, 0x45, 0xe2, 0x83, 0x30, 0x5d, 0xd0, 0x75, 0xff, 0xc1, 0xee, 0xfd, 0x7c, 0x4d, 0x02, 0xb9,
+	0xdb, 0xae, 0xd0, 0xeb, 0x3e, 0x8c, 0x97, 0x9d, 0x2d, 0x45, 0x34, 0x8a, 0xb9, 0x8a, 0x27, 0x43,
+	0x55, 0xf4, 0xcb, 0x2b, 0x66, 0x85, 0x7e, 0xa2, 0x73, 0x11, 0x14, 0x89, 0x4b, 0x7b, 0xcb, 0x7e,
+	0x05, 0x16, 0xbf, 0xb8, 0x00, 0x3b, 0x5f, 0xb5, 0x27, 0x8c, 0xe8, 0x4b, 0x12, 0x8c, 0xf0, 0x31,
+	0x1c, 0x7a, 0x2a, 0xc6, 0xac, 0x4e, 0x00, 0x93, 0x4f, 0xc6, 0xa2, 0xe5, 0x30, 0xf1, 0xc9, 0xcf,
+	0xff, 0xe6, 0xcf, 0xef, 0xa5, 0x8e, 0xa1, 0x23, 0xf9, 0xb0, 0xa1, 0xa9, 0xd0, 0xe2, 0x2f, 0x12,
+	0x4c, 0xf7, 0x9c, 0x5c, 0xa0, 0xf3, 0xa1, 0xe7, 0x46, 0x8d, 0x0d, 0xe5, 0x0b, 0xfd, 0xb2, 0x0b,
+	0x24, 0x57, 0x19, 0x92, 0x55, 0xb4, 0x12, 0x8a, 0xe4, 0x1d, 0x91, 0x67, 0x1e, 0xe4, 0x89, 0x90,
+	0xc8, 0xe7, 0xc7, 0xc4, 0x96, 0x29, 0x9a, 0x9f, 0x8a, 0x66, 0xa0, 0x0f, 0x53, 0x70, 0xb2, 0xe7,
+	0x99, 0xdb, 0x3b, 0xf1, 0xe8, 0x5a, 0x7f, 0xda, 0xf7, 0xec, 0xe9, 0x0f, 0x6c, 0x0e, 0x95, 0x99,
+	0xe3, 0xff, 0xd0, 0xff, 0x3e, 0x0e, 0x73, 0x28, 0xf7, 0x35, 0x7a, 0x47, 0xa9, 0x3b, 0x8a, 0xb2,
+	0x4f, 0x7f, 0x0b, 0x7d, 0x21, 0x05, 0x47, 0x62, 0x0c, 0xe6, 0xd0, 0xe5, 0x78, 0x50, 0x22, 0x47,
+	0x7b, 0x03, 0xdb, 0xe4, 0x7f, 0x98, 0x4d, 0x4a, 0xe8, 0x7a, 0x62, 0x9b, 0x30, 0xdd, 0x78, 0x2b,
+	0xa2, 0xab, 0xbb, 0xfc, 0x5d, 0x02, 0xb9, 0x77, 0x9b, 0x1e, 0xf5, 0xa5, 0x78, 0x67, 0x4c, 0x21,
+	0x5f, 0xec, 0x9b, 0x5f, 0x20, 0x7f, 0x85, 0x21, 0xbf, 0x8c, 0x2e, 0x0d, 0xee, 0x0d, 0x66, 0x93,
+	0xa2, 0x6f, 0xa7, 0xe0, 0x54, 0x92, 0x41, 0x15, 0xba, 0xde, 0x27, 0x80, 0xde, 0xf1, 0x31, 0xb0,
+	0x49, 0xd6, 0x99, 0x49, 0xde, 0x44, 0xb7, 0x1e, 0x8b, 0x49, 0xba, 0x47, 0xc8, 0xbb, 0x29, 0x38,
+	0x1a, 0x67, 0x1c, 0x85, 0xae, 0x0c, 0x16, 0x22, 0x8f, 0xd3, 0x55, 0xde, 0x62, 0x76, 0x79, 0x03,
+	0xbd, 0x96, 0xd0, 0x2e, 0xb6, 0x15, 0x22, 0x02, 0xc5, 0x76, 0x9d, 0xf7, 0x25, 0xd8, 0xe5, 0xcc,
+	0x77, 0x50, 0xf8, 0x1c, 0x29, 0x30, 0x19, 0x92, 0xe7, 0x63, 0x52, 0x0b, 0x20, 0x39, 0x06, 0x64,
+	0x0e, 0x1d, 0x0f, 0x05, 0xe2, 0x0e, 0x8f, 0xd0, 0x97, 0x25, 0x18, 0xb6, 0x25, 0xa0, 0xb9, 0xc8,
+	0xde, 0xa8, 0xa3, 0xd1, 0x93, 0x31, 0x28, 0x85, 0x36, 0xa7, 0x99, 0x36, 0x39, 0x74, 0x2a, 0x54,
+	0x1b, 0xa6, 0x49, 0xc7, 0xb8, 0xcc, 0x5a, 0xce, 0xc8, 0x28, 0xc2, 0x5a, 0x81, 0x61, 0x93, 0x3c,
+	0x1f, 0x93, 0x3a, 0x91, 0xb5, 0x54, 0x5d, 0x9f, 0xe7, 0xd6, 0xfa, 0x89, 0x04, 0xe3, 0xc1, 0xf1,
+	0x11, 0x0a, 0xff, 0x38, 0xea, 0x31, 0xb0, 0x92, 0xcf, 0x24, 0xe4, 0x12, 0x1a, 0x3f, 0xcb, 0x34,
+	0x5e, 0x44, 0x4f, 0x87, 0x6a, 0xac, 0x6b, 0x16, 0xe5, 0x2a, 0xcf, 0xaf, 0x6f, 0xcd, 0xf3, 0x32,
+	0xf6, 0x03, 0x09, 0x46, 0xdd, 0x79, 0x04, 0x0a, 0x37, 0x54, 0x70, 0x56, 0x23, 0xe7, 0xe2, 0x92,
+	0x0b, 0x35, 0x97, 0x98, 0x9a, 0xf3, 0xe8, 0x64, 0x57, 0x35, 0x03, 0x17, 0x9e, 0x67, 0x4d, 0x24,
+	0x0b, 0x7d, 0x5f, 0x82, 0xb4, 0x7f, 0x6c, 0x82, 0x16, 0x43, 0xcf, 0xed, 0x3a, 0x57, 0x92, 0x97,
+	0x12, 0xf1, 0x08, 0x85, 0xcf, 0x30, 0x85, 0xf3, 0x68, 0x3e, 0xd4, 0xae, 0xac, 0x7e, 0x55, 0x3a,
+	0xd3, 0x11, 0xe6, 0x10, 0xc1, 0xb9, 0x41, 0x84, 0x43, 0xf4, 0x98, 0x6b, 0xc8, 0x67, 0x12, 0x72,
+	0x25, 0x72, 0x88, 0x2e, 0xb3, 0x0b, 0xf4, 0x63, 0x09, 0xc6, 0x83, 0xd3, 0x84, 0x08, 0xdd, 0x7b,
+	0x0c, 0x36, 0xe4, 0x33, 0x09, 0xb9, 0x84, 0xee, 0x67, 0x99, 0xee, 0x0b, 0x28, 0x1f, 0xaa, 0xbb,
+	0x9b, 0x6b, 0xb9, 0xf5, 0xab, 0xaa, 0x85, 0x1e, 0x4a, 0x80, 0xb6, 0xcf, 0x1f, 0xd0, 0x33, 0xe1,
+	0x6d, 0x8a, 0x5e, 0xb3, 0x0f, 0xf9, 0x6c, 0x62, 0x3e, 0x01, 0x60, 0x8d, 0x01, 0x58, 0x46, 0x85,
+	0x24, 0xf9, 0x2d, 0xcf, 0x27, 0x5e, 0xec, 0xa7, 0x3b, 0x01, 0x40, 0xdf, 0x93, 0x20, 0xed, 0x9f,
+	0x4d, 0x44, 0x38, 0x7f, 0xd7, 0x11, 0x87, 0xbc, 0x94, 0x88, 0x27, 0x51, 0x9a, 0xe6, 0x6a, 0x77,
+	0x34, 0xfe, 0xd8, 0xb9, 0x04, 0xdf, 0xa4, 0x21, 0xce, 0x25, 0x74, 0x9b, 0x72, 0xc8, 0x67, 0x13,
+	0xf3, 0x09, 0xed, 0x0b, 0x4c, 0xfb, 0xe7, 0xd1, 0x73, 0x7d, 0x5c, 0x02, 0x9f, 0x4f, 0xa0, 0x9f,
+	0x4b, 0xb0, 0xbf, 0xcb, 0xa0, 0x00, 0x45, 0xe8, 0xd4, 0x73, 0xa4, 0x21, 0x3f, 0x9b, 0x9c, 0x51,
+	0xa0, 0x39, 0xc7, 0xd0, 0x9c, 0x46, 0x8b, 0xe1, 0x77, 0xc1, 0x25, 0x28, 0x75, 0x55, 0x6b, 0x28,
+	0xac, 0xc1, 0x76, 0x9b, 0x10, 0xf4, 0x37, 0x09, 0xb2, 0x11, 0xcd, 0x74, 0xb4, 0x1c, 0x2b, 0x54,
+	0xc3, 0x67, 0x19, 0xf2, 0xca, 0x60, 0x42, 0x04, 0xd4, 0xf3, 0x0c, 0xea, 0x59, 0x74, 0x26, 0x69,
+	0xd1, 0x65, 0xa3, 0x27, 0xe8, 0x11, 0xef, 0x65, 0xf4, 0xe8, 0xb3, 0x47, 0x7c, 0x7e, 0x44, 0xb6,
+	0xf1, 0xe5, 0x8b, 0x7d, 0xf3, 0x0b, 0x78, 0xcb, 0x0c, 0xde, 0x79, 0xf4, 0x7c, 0x54, 0x71, 0xa1,
+	0xf4, 0x9e, 0x03, 0xa0, 0x7f, 0x49, 0x90, 0x8d, 0xe8, 0xb6, 0x47, 0x5c, 0x69, 0xbc, 0x66, 0xbf,
+	0xbc, 0x32, 0x98, 0x10, 0x81, 0xf9, 0x55, 0x86, 0xf9, 0x65, 0xb4, 0x16, 0x7e, 0xa5, 0xac, 0x22,
+	0x79, 0x90, 0xef, 0x89, 0x5b, 0x61, 0x93, 0x32, 0x46, 0x85, 0xbe, 0x9e, 0x82, 0xc3, 0x91, 0x6d,
+	0x76, 0x74, 0x29, 0xbe, 0xfa, 0x21, 0xe3, 0x00, 0x79, 0x75, 0x50, 0x31, 0xc2, 0x0e, 0x15, 0x66,
+	0x87, 0xb7, 0xd1, 0x9b, 0xe1, 0x76, 0xf0, 0xcd, 0x13, 0x1e, 0xf4, 0xb4, 0x0b, 0x5b, 0xb6, 0x14,
+	0x6a, 0x2a, 0x2a, 0x3f, 0x4c, 0xd9, 0x60, 0xa0, 0xff, 0x2a, 0xc1, 0xa1, 0xb0, 0x26, 0x3f, 0x7a,
+	0x31, 0x99, 0x0f, 0x6f, 0x9f, 0x23, 0xc8, 0x85, 0x01, 0x24, 0x08, 0x5b, 0x5c, 0x62, 0xb6, 0xb8,
+	0x88, 0xce, 0x27, 0x8f, 0x03, 0x2f, 0x96, 0x7f, 0x4a, 0x30, 0x13, 0xde, 0xee, 0x47, 0xc5, 0xf0,
+	0xca, 0x2f, 0xce, 0xac, 0x41, 0x5e, 0x1e, 0x48, 0x86, 0x80, 0x7c, 0x8d, 0x41, 0x5e, 0x43, 0x97,
+	0x63, 0x85, 0x41, 0xc3, 0x15, 0xaa, 0xa8, 0x5c, 0x2a, 0x2f, 0x0e, 0x3c, 0x41, 0xf0, 0xb9, 0x14,
+	0x64, 0x23, 0x46, 0x02, 0xa8, 0x4f, 0xcd, 0x7d, 0x43, 0x09, 0x79, 0x65, 0x30, 0x21, 0x02, 0xff,
+	0x0d, 0x86, 0xff, 0x15, 0xf4, 0x72, 0xcc, 0xcc, 0x1e, 0x6a, 0x01, 0x41, 0x85, 0xfe, 0x20, 0xb1,
+	0xce, 0x76, 0x57, 0x1d, 0xac, 0x88, 0x46, 0x6c, 0xd4, 0xe0, 0x42, 0xbe, 0xd0, 0x2f, 0x7b, 0xa2,
+	0x22, 0xc4, 0x76, 0xf2, 0x1e, 0x58, 0x2d, 0xf4, 0x0b, 0x09, 0xd2, 0xfe, 0x46, 0x7a, 0x44, 0x05,
+	0xd8, 0x75, 0xac, 0x21, 0x2f, 0x25, 0xe2, 0x11, 0xea, 0xbf, 0xc4, 0xd4, 0x5f, 0x41, 0xc5, 0x50,
+	0xf5, 0x03, 0x8d, 0x7c, 0xd7, 0x81, 0xdf, 0xf1, 0x8c, 0x40, 0x1e, 0xa0, 0x9f, 0x49, 0x80, 0xb6,
+	0xcf, 0x18, 0x22, 0xea, 0xc2, 0x9e, 0x23, 0x0b, 0xf9, 0x6c, 0x62, 0x3e, 0x81, 0xe9, 0x39, 0x86,
+	0x69, 0x09, 0x2d, 0x44, 0x5e, 0x49, 0x00, 0x97, 0x55, 0x7c, 0xeb, 0xe3, 0x47, 0x33, 0xd2, 0xc3,
+	0x47, 0x33, 0xd2, 0x9f, 0x1e, 0xcd, 0x48, 0xef, 0x7e, 0x32, 0xb3, 0xe3, 0xe1, 0x27, 0x33, 0x3b,
+	0x7e, 0xfb, 0xc9, 0xcc, 0x8e, 0x5b, 0xcb, 0x9e, 0x7f, 0xfb, 0x22, 0xc4, 0xce, 0xeb, 0xea, 0xba,
+	0xe5, 0x9e, 0xb1, 0xb1, 0xf8, 0x4c, 0x7e, 0xd3, 0x77, 0x52, 0x59, 0xd7, 0x88, 0x41, 0xf9, 0xff,
+	0x40, 0xc5, 0xff, 0x29, 0xe9, 0x08, 0xfb, 0xcf, 0xd2, 0xbf, 0x07, 0x00, 0xfb, 0xa6, 0x63, 0x10,
+	0xbf, 0x36, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2422,6 +2937,24 @@ type QueryClient interface {
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error)
+	// RouteSpotPrice returns the spot price of swapping through a multihop
+	// route, which may mix pool types, along with the combined spread and taker
+	// fee charged across all of its hops. Each index in the routes_pool_id
+	// field corresponds to the respective routes_token_out_denom value.
+	// example usage:
+	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/route_spot_price?
+	// token_in_denom=uosmo&routes_pool_id=1&routes_token_out_denom=uatom
+	RouteSpotPrice(ctx context.Context, in *RouteSpotPriceRequest, opts ...grpc.CallOption) (*RouteSpotPriceResponse, error)
+	// PoolTypeGasCosts returns the base gas costs of swaps, joins and exits
+	// declared by the pool module of every pool type.
+	PoolTypeGasCosts(ctx context.Context, in *PoolTypeGasCostsRequest, opts ...grpc.CallOption) (*PoolTypeGasCostsResponse, error)
+	// EstimateRouteGas returns the estimated base gas cost of swapping through
+	// a multihop route, summing the swap gas cost of the pool type of every
+	// hop.
+	// example usage:
+	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/estimate_route_gas?
+	// routes_pool_id=1&routes_pool_id=2
+	EstimateRouteGas(ctx context.Context, in *EstimateRouteGasRequest, opts ...grpc.CallOption) (*EstimateRouteGasResponse, error)
 	// TotalPoolLiquidity returns the total liquidity of the specified pool.
 	TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error)
 	// TotalLiquidity returns the total liquidity across all pools.
@@ -2470,6 +3003,11 @@ type QueryClient interface {
 	// the current distribution composition of taker fee share denoms within the
 	// alloyed pool.
 	AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error)
+	// CanonicalRoute returns the canonical route pricing the given denom in the
+	// given quote denom.
+	CanonicalRoute(ctx context.Context, in *CanonicalRouteRequest, opts ...grpc.CallOption) (*CanonicalRouteResponse, error)
+	// AllCanonicalRoutes returns all canonical routes.
+	AllCanonicalRoutes(ctx context.Context, in *AllCanonicalRoutesRequest, opts ...grpc.CallOption) (*AllCanonicalRoutesResponse, error)
 }
 
 type queryClient struct {
@@ -2588,6 +3126,33 @@ func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) RouteSpotPrice(ctx context.Context, in *RouteSpotPriceRequest, opts ...grpc.CallOption) (*RouteSpotPriceResponse, error) {
+	out := new(RouteSpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RouteSpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolTypeGasCosts(ctx context.Context, in *PoolTypeGasCostsRequest, opts ...grpc.CallOption) (*PoolTypeGasCostsResponse, error) {
+	out := new(PoolTypeGasCostsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/PoolTypeGasCosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateRouteGas(ctx context.Context, in *EstimateRouteGasRequest, opts ...grpc.CallOption) (*EstimateRouteGasResponse, error) {
+	out := new(EstimateRouteGasResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateRouteGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error) {
 	out := new(TotalPoolLiquidityResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
@@ -2696,6 +3261,24 @@ func (c *queryClient) AllRegisteredAlloyedPools(ctx context.Context, in *AllRegi
 	return out, nil
 }
 
+func (c *queryClient) CanonicalRoute(ctx context.Context, in *CanonicalRouteRequest, opts ...grpc.CallOption) (*CanonicalRouteResponse, error) {
+	out := new(CanonicalRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/CanonicalRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllCanonicalRoutes(ctx context.Context, in *AllCanonicalRoutesRequest, opts ...grpc.CallOption) (*AllCanonicalRoutesResponse, error) {
+	out := new(AllCanonicalRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllCanonicalRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
@@ -2729,6 +3312,24 @@ type QueryServer interface {
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(context.Context, *SpotPriceRequest) (*SpotPriceResponse, error)
+	// RouteSpotPrice returns the spot price of swapping through a multihop
+	// route, which may mix pool types, along with the combined spread and taker
+	// fee charged across all of its hops. Each index in the routes_pool_id
+	// field corresponds to the respective routes_token_out_denom value.
+	// example usage:
+	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/route_spot_price?
+	// token_in_denom=uosmo&routes_pool_id=1&routes_token_out_denom=uatom
+	RouteSpotPrice(context.Context, *RouteSpotPriceRequest) (*RouteSpotPriceResponse, error)
+	// PoolTypeGasCosts returns the base gas costs of swaps, joins and exits
+	// declared by the pool module of every pool type.
+	PoolTypeGasCosts(context.Context, *PoolTypeGasCostsRequest) (*PoolTypeGasCostsResponse, error)
+	// EstimateRouteGas returns the estimated base gas cost of swapping through
+	// a multihop route, summing the swap gas cost of the pool type of every
+	// hop.
+	// example usage:
+	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/estimate_route_gas?
+	// routes_pool_id=1&routes_pool_id=2
+	EstimateRouteGas(context.Context, *EstimateRouteGasRequest) (*EstimateRouteGasResponse, error)
 	// TotalPoolLiquidity returns the total liquidity of the specified pool.
 	TotalPoolLiquidity(context.Context, *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error)
 	// TotalLiquidity returns the total liquidity across all pools.
@@ -2777,6 +3378,11 @@ type QueryServer interface {
 	// the current distribution composition of taker fee share denoms within the
 	// alloyed pool.
 	AllRegisteredAlloyedPools(context.Context, *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error)
+	// CanonicalRoute returns the canonical route pricing the given denom in the
+	// given quote denom.
+	CanonicalRoute(context.Context, *CanonicalRouteRequest) (*CanonicalRouteResponse, error)
+	// AllCanonicalRoutes returns all canonical routes.
+	AllCanonicalRoutes(context.Context, *AllCanonicalRoutesRequest) (*AllCanonicalRoutesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2819,6 +3425,15 @@ func (*UnimplementedQueryServer) ListPoolsByDenom(ctx context.Context, req *List
 func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *SpotPriceRequest) (*SpotPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
 }
+func (*UnimplementedQueryServer) RouteSpotPrice(ctx context.Context, req *RouteSpotPriceRequest) (*RouteSpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteSpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) PoolTypeGasCosts(ctx context.Context, req *PoolTypeGasCostsRequest) (*PoolTypeGasCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolTypeGasCosts not implemented")
+}
+func (*UnimplementedQueryServer) EstimateRouteGas(ctx context.Context, req *EstimateRouteGasRequest) (*EstimateRouteGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateRouteGas not implemented")
+}
 func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
 }
@@ -2855,6 +3470,12 @@ func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromPoolId(ctx context.Con
 func (*UnimplementedQueryServer) AllRegisteredAlloyedPools(ctx context.Context, req *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllRegisteredAlloyedPools not implemented")
 }
+func (*UnimplementedQueryServer) CanonicalRoute(ctx context.Context, req *CanonicalRouteRequest) (*CanonicalRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalRoute not implemented")
+}
+func (*UnimplementedQueryServer) AllCanonicalRoutes(ctx context.Context, req *AllCanonicalRoutesRequest) (*AllCanonicalRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllCanonicalRoutes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3076,6 +3697,60 @@ func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RouteSpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteSpotPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteSpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RouteSpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteSpotPrice(ctx, req.(*RouteSpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolTypeGasCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolTypeGasCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolTypeGasCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/PoolTypeGasCosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolTypeGasCosts(ctx, req.(*PoolTypeGasCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateRouteGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateRouteGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateRouteGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateRouteGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateRouteGas(ctx, req.(*EstimateRouteGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TotalPoolLiquidityRequest)
 	if err := dec(in); err != nil {
@@ -3292,6 +3967,43 @@ func _Query_AllRegisteredAlloyedPools_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CanonicalRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanonicalRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/CanonicalRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalRoute(ctx, req.(*CanonicalRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllCanonicalRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllCanonicalRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllCanonicalRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllCanonicalRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllCanonicalRoutes(ctx, req.(*AllCanonicalRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3344,6 +4056,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SpotPrice",
 			Handler:    _Query_SpotPrice_Handler,
 		},
+		{
+			MethodName: "RouteSpotPrice",
+			Handler:    _Query_RouteSpotPrice_Handler,
+		},
+		{
+			MethodName: "PoolTypeGasCosts",
+			Handler:    _Query_PoolTypeGasCosts_Handler,
+		},
+		{
+			MethodName: "EstimateRouteGas",
+			Handler:    _Query_EstimateRouteGas_Handler,
+		},
 		{
 			MethodName: "TotalPoolLiquidity",
 			Handler:    _Query_TotalPoolLiquidity_Handler,
@@ -3392,7 +4116,15 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllRegisteredAlloyedPools",
 			Handler:    _Query_AllRegisteredAlloyedPools_Handler,
 		},
-	},
+		{
+			MethodName: "CanonicalRoute",
+			Handler:    _Query_CanonicalRoute_Handler,
+		},
+		{
+			MethodName: "AllCanonicalRoutes",
+			Handler:    _Query_AllCanonicalRoutes_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
 }
@@ -3633,6 +4365,20 @@ func (m *EstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.TokenOutAmount.Size()
 		i -= size
@@ -3826,6 +4572,20 @@ func (m *EstimateSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.TokenInAmount.Size()
 		i -= size
@@ -4152,6 +4912,245 @@ func (m *SpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RouteSpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteSpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteSpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoutesTokenOutDenom) > 0 {
+		for iNdEx := len(m.RoutesTokenOutDenom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoutesTokenOutDenom[iNdEx])
+			copy(dAtA[i:], m.RoutesTokenOutDenom[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenOutDenom[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RoutesPoolId) > 0 {
+		dAtA8 := make([]byte, len(m.RoutesPoolId)*10)
+		var j7 int
+		for _, num := range m.RoutesPoolId {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteSpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteSpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteSpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalFee.Size()
+		i -= size
+		if _, err := m.TotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SpotPriceAfterFees.Size()
+		i -= size
+		if _, err := m.SpotPriceAfterFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTypeGasCostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTypeGasCostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTypeGasCostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTypeGasCostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTypeGasCostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTypeGasCostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolTypeGasCosts) > 0 {
+		for iNdEx := len(m.PoolTypeGasCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTypeGasCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateRouteGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateRouteGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateRouteGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoutesPoolId) > 0 {
+		dAtA10 := make([]byte, len(m.RoutesPoolId)*10)
+		var j9 int
+		for _, num := range m.RoutesPoolId {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateRouteGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateRouteGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateRouteGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4957,41 +5956,175 @@ func (m *AllRegisteredAlloyedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CanonicalRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *CanonicalRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CanonicalRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllCanonicalRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllCanonicalRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllCanonicalRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllCanonicalRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllCanonicalRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllCanonicalRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CanonicalRoutes) > 0 {
+		for iNdEx := len(m.CanonicalRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanonicalRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Sender)
@@ -5071,6 +6204,12 @@ func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
 	_ = l
 	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5157,6 +6296,12 @@ func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
 	_ = l
 	l = m.TokenInAmount.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5291,6 +6436,99 @@ func (m *SpotPriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *RouteSpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RoutesPoolId) > 0 {
+		l = 0
+		for _, e := range m.RoutesPoolId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.RoutesTokenOutDenom) > 0 {
+		for _, s := range m.RoutesTokenOutDenom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RouteSpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPriceAfterFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PoolTypeGasCostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PoolTypeGasCostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolTypeGasCosts) > 0 {
+		for _, e := range m.PoolTypeGasCosts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateRouteGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoutesPoolId) > 0 {
+		l = 0
+		for _, e := range m.RoutesPoolId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EstimateRouteGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
 func (m *TotalPoolLiquidityRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5603,20 +6841,76 @@ func (m *AllRegisteredAlloyedPoolsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *CanonicalRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *CanonicalRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *AllCanonicalRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllCanonicalRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CanonicalRoutes) > 0 {
+		for _, e := range m.CanonicalRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
@@ -6314,6 +7608,40 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, types.SwapHopEstimate{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6907,6 +8235,40 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, types.SwapHopEstimate{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7717,7 +9079,7 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *RouteSpotPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7740,17 +9102,17 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouteSpotPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouteSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7760,66 +9122,105 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RoutesPoolId = append(m.RoutesPoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
+					m.RoutesPoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoutesPoolId = append(m.RoutesPoolId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7829,25 +9230,23 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7870,7 +9269,7 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *RouteSpotPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7893,67 +9292,85 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouteSpotPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouteSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceAfterFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SpotPriceAfterFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7963,23 +9380,23 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8004,7 +9421,7 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolTypeGasCostsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8027,31 +9444,12 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolTypeGasCostsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolTypeGasCostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8073,7 +9471,7 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolTypeGasCostsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8096,15 +9494,15 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolTypeGasCostsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolTypeGasCostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeGasCosts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8131,8 +9529,8 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volume = append(m.Volume, types2.Coin{})
-			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolTypeGasCosts = append(m.PoolTypeGasCosts, types.PoolTypeGasCosts{})
+			if err := m.PoolTypeGasCosts[len(m.PoolTypeGasCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8157,7 +9555,7 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateRouteGasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8180,60 +9578,979 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateRouteGasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateRouteGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.RoutesPoolId = append(m.RoutesPoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
+					m.RoutesPoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoutesPoolId = append(m.RoutesPoolId, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_0 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateRouteGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateRouteGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateRouteGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalVolumeForPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalVolumeForPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types2.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
@@ -8248,7 +10565,259 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeShareAgreements = append(m.TakerFeeShareAgreements, types.TakerFeeShareAgreement{})
+			if err := m.TakerFeeShareAgreements[len(m.TakerFeeShareAgreements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8271,7 +10840,7 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8294,15 +10863,15 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8330,9 +10899,7 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8355,7 +10922,7 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8378,15 +10945,15 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8413,126 +10980,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFeeShareAgreement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8557,7 +11005,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,17 +11028,17 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8600,30 +11048,29 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8633,24 +11080,23 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TakerFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8673,57 +11119,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8746,17 +11142,17 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8766,23 +11162,23 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeeShareAgreements = append(m.TakerFeeShareAgreements, types.TakerFeeShareAgreement{})
-			if err := m.TakerFeeShareAgreements[len(m.TakerFeeShareAgreements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8807,7 +11203,7 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8830,44 +11226,12 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8889,7 +11253,7 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8912,15 +11276,15 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeSkimAccumulators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8947,7 +11311,8 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeShareAgreement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakerFeeSkimAccumulators = append(m.TakerFeeSkimAccumulators, types.TakerFeeSkimAccumulator{})
+			if err := m.TakerFeeSkimAccumulators[len(m.TakerFeeSkimAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8972,7 +11337,7 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8995,10 +11360,10 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9033,38 +11398,6 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TakerFeeDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9086,7 +11419,7 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9109,17 +11442,17 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9129,23 +11462,22 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9170,7 +11502,7 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9193,12 +11525,31 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9220,7 +11571,7 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9243,15 +11594,15 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeSkimAccumulators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9278,8 +11629,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeeSkimAccumulators = append(m.TakerFeeSkimAccumulators, types.TakerFeeSkimAccumulator{})
-			if err := m.TakerFeeSkimAccumulators[len(m.TakerFeeSkimAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9304,7 +11654,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9327,44 +11677,12 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9386,7 +11704,7 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9409,15 +11727,15 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9444,7 +11762,8 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractStates = append(m.ContractStates, types.AlloyContractTakerFeeShareState{})
+			if err := m.ContractStates[len(m.ContractStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9469,7 +11788,7 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *CanonicalRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9492,17 +11811,17 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanonicalRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanonicalRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9512,11 +11831,56 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9538,7 +11902,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *CanonicalRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9561,15 +11925,15 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanonicalRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanonicalRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9596,7 +11960,8 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Route = append(m.Route, types.SwapAmountInRoute{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9621,7 +11986,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *AllCanonicalRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9644,10 +12009,10 @@ func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllCanonicalRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllCanonicalRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9671,7 +12036,7 @@ func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *AllCanonicalRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9694,15 +12059,15 @@ func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllCanonicalRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllCanonicalRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9729,8 +12094,8 @@ func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractStates = append(m.ContractStates, types.AlloyContractTakerFeeShareState{})
-			if err := m.ContractStates[len(m.ContractStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CanonicalRoutes = append(m.CanonicalRoutes, types.CanonicalRoute{})
+			if err := m.CanonicalRoutes[len(m.CanonicalRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20,9 +20,9 @@ func (k Keeper) validateCreatedPool(poolId uint64, pool types.PoolI) error {
 }
 
 // CreatePool attempts to create a pool returning the newly created pool ID or
-// an error upon failure. The pool creation fee is used to fund the community
-// pool. It will create a dedicated module account for the pool and sends the
-// initial liquidity to the created module account.
+// an error upon failure. The creation fee of the pool type is either used to fund the
+// community pool or burned, as set in the pool type creation fee params. It will create
+// a dedicated module account for the pool and sends the initial liquidity to the created module account.
 //
 // After the initial liquidity is sent to the pool's account, this function calls an
 // InitializePool function from the source module. That module is responsible for:
@@ -46,7 +46,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 	}
 
 	sender := msg.PoolCreator()
-	err = k.fundCommunityPoolIfNotWhitelisted(ctx, sender)
+	err = k.chargePoolCreationFeeIfNotWhitelisted(ctx, sender, poolType)
 	if err != nil {
 		return 0, err
 	}
@@ -249,9 +249,10 @@ func parsePoolRouteWithKey(key []byte, value []byte) (types.ModuleRoute, error)
 	return parsedValue, nil
 }
 
-// fundCommunityPoolIfNotWhitelisted checks if the sender is in the UnrestrictedPoolCreatorWhitelist
-// and funds the community pool if the sender is not whitelisted.
-func (k Keeper) fundCommunityPoolIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress) error {
+// chargePoolCreationFeeIfNotWhitelisted checks if the sender is in the UnrestrictedPoolCreatorWhitelist
+// and charges the sender the creation fee of the pool type if the sender is not whitelisted.
+// The fee either funds the community pool or is burned, depending on the destination of the pool type's fee.
+func (k Keeper) chargePoolCreationFeeIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress, poolType types.PoolType) error {
 	// Get the UnrestrictedPoolCreatorWhitelist from the concentrated pool module
 	whitelist := k.concentratedKeeper.GetWhitelistedAddresses(ctx)
 
@@ -264,13 +265,23 @@ func (k Keeper) fundCommunityPoolIfNotWhitelisted(ctx sdk.Context, sender sdk.Ac
 		}
 	}
 
-	// Run FundCommunityPool logic if sender is not in the whitelist
-	if !isWhitelisted {
-		poolCreationFee := k.GetParams(ctx).PoolCreationFee
-		if err := k.communityPoolKeeper.FundCommunityPool(ctx, poolCreationFee, sender); err != nil {
+	// Charge the pool creation fee if the sender is not in the whitelist
+	if isWhitelisted {
+		return nil
+	}
+
+	poolCreationFee := k.GetParams(ctx).GetPoolTypeCreationFee(poolType)
+	if poolCreationFee.Fee.IsZero() {
+		return nil
+	}
+
+	switch poolCreationFee.Destination {
+	case types.PoolCreationFeeDestinationBurn:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.PoolCreationFeeBurnerName, poolCreationFee.Fee); err != nil {
 			return err
 		}
+		return k.bankKeeper.BurnCoins(ctx, types.PoolCreationFeeBurnerName, poolCreationFee.Fee)
+	default:
+		return k.communityPoolKeeper.FundCommunityPool(ctx, poolCreationFee.Fee, sender)
 	}
-
-	return nil
 }
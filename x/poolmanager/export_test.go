@@ -50,14 +50,6 @@ func (k Keeper) CreateMultihopExpectedSwapOuts(
 	return k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
 }
 
-func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
-	k.trackVolume(ctx, poolId, volumeGenerated)
-}
-
-func (k Keeper) ChargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
-	return k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, exactIn)
-}
-
 func (k Keeper) QueryAndCheckAlloyedDenom(ctx sdk.Context, contractAddr sdk.AccAddress) (string, error) {
 	return k.queryAndCheckAlloyedDenom(ctx, contractAddr)
 }
@@ -151,6 +143,6 @@ func (k *Keeper) SetCacheTrackers(takerFeeShareAgreement map[string]types.TakerF
 	}
 }
 
-func (k Keeper) FundCommunityPoolIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress) error {
-	return k.fundCommunityPoolIfNotWhitelisted(ctx, sender)
+func (k Keeper) ChargePoolCreationFeeIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress, poolType types.PoolType) error {
+	return k.chargePoolCreationFeeIfNotWhitelisted(ctx, sender, poolType)
 }
@@ -0,0 +1,53 @@
+package poolmanager
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// GetPoolTypeGasCosts returns the base gas costs of every pool type with a registered pool module,
+// ordered by pool type.
+func (k Keeper) GetPoolTypeGasCosts(ctx sdk.Context) []types.PoolTypeGasCosts {
+	poolTypes := make([]types.PoolType, 0, len(k.routes))
+	for poolType := range k.routes {
+		poolTypes = append(poolTypes, poolType)
+	}
+	sort.Slice(poolTypes, func(i, j int) bool { return poolTypes[i] < poolTypes[j] })
+
+	gasCosts := make([]types.PoolTypeGasCosts, 0, len(poolTypes))
+	for _, poolType := range poolTypes {
+		gasCosts = append(gasCosts, k.getPoolTypeGasCosts(poolType))
+	}
+	return gasCosts
+}
+
+// EstimateRouteGas returns the estimated base gas cost of swapping through the given pools,
+// summing the swap gas cost of the pool type of every hop.
+// Returns error if the route is empty or any of its pools does not exist.
+func (k Keeper) EstimateRouteGas(ctx sdk.Context, poolIds []uint64) (uint64, error) {
+	if len(poolIds) == 0 {
+		return 0, types.ErrEmptyRoutes
+	}
+
+	gas := uint64(0)
+	for _, poolId := range poolIds {
+		poolType, err := k.GetPoolType(ctx, poolId)
+		if err != nil {
+			return 0, err
+		}
+		gas += k.getPoolTypeGasCosts(poolType).SwapGas
+	}
+	return gas, nil
+}
+
+// getPoolTypeGasCosts returns the gas costs declared by the pool module of the given pool type,
+// or the default gas costs if the module does not declare any.
+func (k Keeper) getPoolTypeGasCosts(poolType types.PoolType) types.PoolTypeGasCosts {
+	if poolModule, ok := k.routes[poolType].(types.PoolModuleGasCostsI); ok {
+		return poolModule.GetPoolTypeGasCosts(poolType)
+	}
+	return types.DefaultPoolTypeGasCosts(poolType)
+}
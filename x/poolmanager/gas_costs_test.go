@@ -0,0 +1,65 @@
+package poolmanager_test
+
+import (
+	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	cwpooltypes "github.com/osmosis-labs/osmosis/v26/x/cosmwasmpool/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestGetPoolTypeGasCosts() {
+	s.SetupTest()
+
+	expectedGasCosts := []types.PoolTypeGasCosts{
+		{PoolType: types.Balancer, SwapGas: gammtypes.BalancerSwapGas, JoinGas: gammtypes.BalancerJoinGas, ExitGas: gammtypes.BalancerExitGas},
+		{PoolType: types.Stableswap, SwapGas: gammtypes.StableswapSwapGas, JoinGas: gammtypes.StableswapJoinGas, ExitGas: gammtypes.StableswapExitGas},
+		{PoolType: types.Concentrated, SwapGas: cltypes.ConcentratedSwapGas, JoinGas: cltypes.ConcentratedJoinGas, ExitGas: cltypes.ConcentratedExitGas},
+		{PoolType: types.CosmWasm, SwapGas: cwpooltypes.CosmWasmSwapGas},
+	}
+
+	s.Require().Equal(expectedGasCosts, s.App.PoolManagerKeeper.GetPoolTypeGasCosts(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestEstimateRouteGas() {
+	s.SetupTest()
+
+	balancerId := s.PrepareBalancerPool()
+	stableswapId := s.PrepareBasicStableswapPool()
+	clPool := s.PrepareConcentratedPool()
+	cwPool := s.PrepareCosmWasmPool()
+
+	tests := map[string]struct {
+		poolIds     []uint64
+		expectedGas uint64
+		expectedErr error
+	}{
+		"single hop": {
+			poolIds:     []uint64{balancerId},
+			expectedGas: gammtypes.BalancerSwapGas,
+		},
+		"multihop through every pool type": {
+			poolIds:     []uint64{balancerId, stableswapId, clPool.GetId(), cwPool.GetId()},
+			expectedGas: gammtypes.BalancerSwapGas + gammtypes.StableswapSwapGas + cltypes.ConcentratedSwapGas + cwpooltypes.CosmWasmSwapGas,
+		},
+		"error: empty route": {
+			poolIds:     []uint64{},
+			expectedErr: types.ErrEmptyRoutes,
+		},
+		"error: pool does not exist": {
+			poolIds:     []uint64{balancerId, 100},
+			expectedErr: types.FailedToFindRouteError{PoolId: 100},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			gas, err := s.App.PoolManagerKeeper.EstimateRouteGas(s.Ctx, tc.poolIds)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedGas, gas)
+		})
+	}
+}
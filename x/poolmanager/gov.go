@@ -17,11 +17,33 @@ func (k Keeper) HandleDenomPairTakerFeeProposal(ctx sdk.Context, p *types.DenomP
 	return nil
 }
 
+func (k Keeper) HandleSetPoolMigrationLinksProposal(ctx sdk.Context, p *types.SetPoolMigrationLinksProposal) error {
+	for _, link := range p.Links {
+		if err := k.SetPoolMigrationLink(ctx, link); err != nil {
+			return fmt.Errorf("failed to set migration link of pool %d: %w", link.LegacyPoolId, err)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) HandleSetCanonicalRoutesProposal(ctx sdk.Context, p *types.SetCanonicalRoutesProposal) error {
+	for _, route := range p.Routes {
+		if err := k.SetCanonicalRoute(ctx, route); err != nil {
+			return fmt.Errorf("failed to set canonical route from %s to %s: %w", route.Denom, route.QuoteDenom, err)
+		}
+	}
+	return nil
+}
+
 func NewPoolManagerProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
 		case *types.DenomPairTakerFeeProposal:
 			return k.HandleDenomPairTakerFeeProposal(ctx, c)
+		case *types.SetPoolMigrationLinksProposal:
+			return k.HandleSetPoolMigrationLinksProposal(ctx, c)
+		case *types.SetCanonicalRoutesProposal:
+			return k.HandleSetCanonicalRoutesProposal(ctx, c)
 
 		default:
 			return fmt.Errorf("unrecognized pool manager proposal content type: %T", c)
@@ -28,6 +28,7 @@ type Keeper struct {
 	stakingKeeper        types.StakingKeeper
 	protorevKeeper       types.ProtorevKeeper
 	wasmKeeper           types.WasmKeeper
+	twapKeeper           types.TwapKeeper
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -123,7 +124,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 
 	k.SetParams(ctx, genState.Params)
 
+	// Routes to pool types without a registered pool module would make their pools unusable,
+	// so they are rejected rather than imported.
 	for _, poolRoute := range genState.PoolRoutes {
+		if _, ok := k.routes[poolRoute.PoolType]; !ok {
+			panic(types.UndefinedRouteError{PoolType: poolRoute.PoolType, PoolId: poolRoute.PoolId})
+		}
 		k.SetPoolRoute(ctx, poolRoute.PoolId, poolRoute.PoolType)
 	}
 
@@ -149,6 +155,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.TokenInDenom, denomPairTakerFee.TokenOutDenom, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the pool migration links KVStore.
+	for _, link := range genState.PoolMigrationLinks {
+		k.setPoolMigrationLink(ctx, link)
+	}
+
+	// Set the canonical routes KVStore.
+	for _, route := range genState.CanonicalRoutes {
+		k.setCanonicalRoute(ctx, route)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -174,6 +190,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	poolMigrationLinks, err := k.GetAllPoolMigrationLinks(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	canonicalRoutes, err := k.GetAllCanonicalRoutes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -187,6 +213,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		PoolMigrationLinks:     poolMigrationLinks,
+		CanonicalRoutes:        canonicalRoutes,
 	}
 }
 
@@ -224,6 +252,11 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
 	k.wasmKeeper = wasmKeeper
 }
 
+// SetTwapKeeper sets twap keeper
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
 // BeginBlock sets the poolmanager caches if they are empty
 func (k *Keeper) BeginBlock(ctx sdk.Context) {
 	// Here, the only time in which these caches are empty is during the start up of the node.
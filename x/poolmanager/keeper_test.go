@@ -2,6 +2,7 @@ package poolmanager_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -38,6 +39,8 @@ var (
 	testAdminAddresses                                 = []string{"osmo106x8q2nv7xsg7qrec2zgdf3vvq0t3gn49zvaha", "osmo105l5r3rjtynn7lg362r2m9hkpfvmgmjtkglsn9"}
 	testCommunityPoolDenomToSwapNonWhitelistedAssetsTo = "uusdc"
 	testAuthorizedQuoteDenoms                          = []string{appparams.BaseCoinUnit, "uion", "uatom"}
+	testRouteSpotPriceMaxTwapDeviation                 = osmomath.MustNewDecFromStr("0.2")
+	testRouteSpotPriceTwapWindow                       = 10 * time.Minute
 
 	testPoolRoute = []types.ModuleRoute{
 		{
@@ -136,7 +139,9 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 				AdminAddresses:                                 testAdminAddresses,
 				CommunityPoolDenomToSwapNonWhitelistedAssetsTo: testCommunityPoolDenomToSwapNonWhitelistedAssetsTo,
 			},
-			AuthorizedQuoteDenoms: testAuthorizedQuoteDenoms,
+			AuthorizedQuoteDenoms:          testAuthorizedQuoteDenoms,
+			RouteSpotPriceMaxTwapDeviation: testRouteSpotPriceMaxTwapDeviation,
+			RouteSpotPriceTwapWindow:       testRouteSpotPriceTwapWindow,
 		},
 		NextPoolId:             testExpectedPoolId,
 		PoolRoutes:             testPoolRoute,
@@ -154,6 +159,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	s.Require().Equal(testAdminAddresses, params.TakerFeeParams.AdminAddresses)
 	s.Require().Equal(testCommunityPoolDenomToSwapNonWhitelistedAssetsTo, params.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo)
 	s.Require().Equal(testAuthorizedQuoteDenoms, params.AuthorizedQuoteDenoms)
+	s.Require().Equal(testRouteSpotPriceMaxTwapDeviation, params.RouteSpotPriceMaxTwapDeviation)
+	s.Require().Equal(testRouteSpotPriceTwapWindow, params.RouteSpotPriceTwapWindow)
 	s.Require().Equal(testPoolRoute, s.App.PoolManagerKeeper.GetAllPoolRoutes(s.Ctx))
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToStakers, s.App.PoolManagerKeeper.GetTakerFeeTrackerForStakers(s.Ctx))
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToCommunityPool, s.App.PoolManagerKeeper.GetTakerFeeTrackerForCommunityPool(s.Ctx))
@@ -169,6 +176,22 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	s.Require().Equal(testDenomPairTakerFees[1].TakerFee, takerFee)
 }
 
+// TestInitGenesis_UnregisteredPoolModule tests that routes to pool types without a registered pool module
+// are rejected at InitGenesis.
+func (s *KeeperTestSuite) TestInitGenesis_UnregisteredPoolModule() {
+	s.App.PoolManagerKeeper.SetPoolRoutesUnsafe(map[types.PoolType]types.PoolModuleI{
+		types.Balancer: s.App.GAMMKeeper,
+	})
+
+	genState := types.DefaultGenesis()
+	genState.NextPoolId = testExpectedPoolId
+	genState.PoolRoutes = testPoolRoute
+
+	s.Require().PanicsWithValue(types.UndefinedRouteError{PoolType: types.Stableswap, PoolId: 2}, func() {
+		s.App.PoolManagerKeeper.InitGenesis(s.Ctx, genState)
+	})
+}
+
 func (s *KeeperTestSuite) TestExportGenesis() {
 	// Need to create two pools to properly export pool volumes.
 	s.PrepareBalancerPool()
@@ -184,7 +207,9 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 				AdminAddresses:                                 testAdminAddresses,
 				CommunityPoolDenomToSwapNonWhitelistedAssetsTo: testCommunityPoolDenomToSwapNonWhitelistedAssetsTo,
 			},
-			AuthorizedQuoteDenoms: testAuthorizedQuoteDenoms,
+			AuthorizedQuoteDenoms:          testAuthorizedQuoteDenoms,
+			RouteSpotPriceMaxTwapDeviation: testRouteSpotPriceMaxTwapDeviation,
+			RouteSpotPriceTwapWindow:       testRouteSpotPriceTwapWindow,
 		},
 		NextPoolId:             testExpectedPoolId,
 		PoolRoutes:             testPoolRoute,
@@ -202,6 +227,8 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testAdminAddresses, genesis.Params.TakerFeeParams.AdminAddresses)
 	s.Require().Equal(testCommunityPoolDenomToSwapNonWhitelistedAssetsTo, genesis.Params.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo)
 	s.Require().Equal(testAuthorizedQuoteDenoms, genesis.Params.AuthorizedQuoteDenoms)
+	s.Require().Equal(testRouteSpotPriceMaxTwapDeviation, genesis.Params.RouteSpotPriceMaxTwapDeviation)
+	s.Require().Equal(testRouteSpotPriceTwapWindow, genesis.Params.RouteSpotPriceTwapWindow)
 	s.Require().Equal(testPoolRoute, genesis.PoolRoutes)
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToStakers, genesis.TakerFeesTracker.TakerFeesToStakers)
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToCommunityPool, genesis.TakerFeesTracker.TakerFeesToCommunityPool)
@@ -435,31 +462,63 @@ func (s *KeeperTestSuite) TestEndBlock() {
 	}
 }
 
-func (s *KeeperTestSuite) TestFundCommunityPoolIfNotWhitelisted() {
+func (s *KeeperTestSuite) TestChargePoolCreationFeeIfNotWhitelisted() {
+	concentratedFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50_000_000))
+	cosmWasmFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20_000_000))
+	poolTypeCreationFees := []types.PoolTypeCreationFee{
+		{PoolType: types.Concentrated, Fee: concentratedFee, Destination: types.PoolCreationFeeDestinationCommunityPool},
+		{PoolType: types.CosmWasm, Fee: cosmWasmFee, Destination: types.PoolCreationFeeDestinationBurn},
+	}
+
 	tests := []struct {
 		name           string
 		whitelist      []string
 		sender         sdk.AccAddress
+		poolType       types.PoolType
 		expectFundCall bool
+		expectBurn     bool
 	}{
 		{
 			name:           "sender is whitelisted",
 			whitelist:      []string{"osmo1044qatzg4a0wm63jchrfdnn2u8nwdgxxt6e524"},
 			sender:         sdk.MustAccAddressFromBech32("osmo1044qatzg4a0wm63jchrfdnn2u8nwdgxxt6e524"),
+			poolType:       types.Balancer,
 			expectFundCall: false,
 		},
 		{
 			name:           "sender is not whitelisted",
 			whitelist:      []string{"osmo1044qatzg4a0wm63jchrfdnn2u8nwdgxxt6e524"},
 			sender:         sdk.MustAccAddressFromBech32("osmo1j537vtv60wz322n2sgfm4st7y3dm8e4e9js57h"),
+			poolType:       types.Balancer,
 			expectFundCall: true,
 		},
 		{
 			name:           "whitelist is empty",
 			whitelist:      []string{},
 			sender:         sdk.MustAccAddressFromBech32("osmo1j537vtv60wz322n2sgfm4st7y3dm8e4e9js57h"),
+			poolType:       types.Balancer,
 			expectFundCall: true,
 		},
+		{
+			name:           "pool type fee funding the community pool",
+			whitelist:      []string{},
+			sender:         sdk.MustAccAddressFromBech32("osmo1j537vtv60wz322n2sgfm4st7y3dm8e4e9js57h"),
+			poolType:       types.Concentrated,
+			expectFundCall: true,
+		},
+		{
+			name:       "pool type fee burned",
+			whitelist:  []string{},
+			sender:     sdk.MustAccAddressFromBech32("osmo1j537vtv60wz322n2sgfm4st7y3dm8e4e9js57h"),
+			poolType:   types.CosmWasm,
+			expectBurn: true,
+		},
+		{
+			name:      "sender is whitelisted for a burned pool type fee",
+			whitelist: []string{"osmo1044qatzg4a0wm63jchrfdnn2u8nwdgxxt6e524"},
+			sender:    sdk.MustAccAddressFromBech32("osmo1044qatzg4a0wm63jchrfdnn2u8nwdgxxt6e524"),
+			poolType:  types.CosmWasm,
+		},
 	}
 
 	for _, tc := range tests {
@@ -470,24 +529,40 @@ func (s *KeeperTestSuite) TestFundCommunityPoolIfNotWhitelisted() {
 			oldParams.UnrestrictedPoolCreatorWhitelist = tc.whitelist
 			s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, oldParams)
 
+			poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			poolManagerParams.PoolTypeCreationFees = poolTypeCreationFees
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+
 			// Fund the sender with pool creation fee
-			poolCreationFee := s.App.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee
+			poolCreationFee := poolManagerParams.GetPoolTypeCreationFee(tc.poolType).Fee
 			s.FundAcc(tc.sender, poolCreationFee)
 
-			// Get the community pool balance
+			// Get the community pool balance and the supply of the fee
 			preCommunityPoolBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName))
+			preSupply := s.App.BankKeeper.GetSupply(s.Ctx, poolCreationFee[0].Denom)
 
-			err := s.App.PoolManagerKeeper.FundCommunityPoolIfNotWhitelisted(s.Ctx, tc.sender)
+			err := s.App.PoolManagerKeeper.ChargePoolCreationFeeIfNotWhitelisted(s.Ctx, tc.sender, tc.poolType)
 			s.Require().NoError(err)
 
-			// Get the community pool balance after the function call
+			// Get the community pool balance and the supply of the fee after the function call
 			postCommunityPoolBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName))
+			postSupply := s.App.BankKeeper.GetSupply(s.Ctx, poolCreationFee[0].Denom)
 
 			if tc.expectFundCall {
 				s.Require().Equal(postCommunityPoolBalance, preCommunityPoolBalance.Add(poolCreationFee...))
 			} else {
 				s.Require().Equal(preCommunityPoolBalance, postCommunityPoolBalance)
 			}
+
+			if tc.expectBurn {
+				s.Require().Equal(preSupply.Sub(poolCreationFee[0]), postSupply)
+			} else {
+				s.Require().Equal(preSupply, postSupply)
+			}
+
+			if tc.expectFundCall || tc.expectBurn {
+				s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, tc.sender).IsZero())
+			}
 		})
 	}
 }
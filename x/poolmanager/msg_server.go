@@ -29,14 +29,18 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	tokenOutAmount, takerFeesCharged, spreadFeesCharged, err := server.keeper.routeExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
 	if err != nil {
 		return nil, err
 	}
 
 	// Swap event is handled elsewhere
 
-	return &types.MsgSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+	return &types.MsgSwapExactAmountInResponse{
+		TokenOutAmount:    tokenOutAmount,
+		TakerFeesCharged:  takerFeesCharged,
+		SpreadFeesCharged: spreadFeesCharged,
+	}, nil
 }
 
 // TODO: spec and tests, including events
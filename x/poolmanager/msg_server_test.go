@@ -26,6 +26,53 @@ var (
 	pool4_out = types.SwapAmountOutRoute{PoolId: 4, TokenInDenom: "baz"}
 )
 
+// TestSwapExactAmountInFeeAccounting tests that swapping through a route mixing balancer and
+// concentrated pools reports the taker and spread fees charged on every hop.
+func (s *KeeperTestSuite) TestSwapExactAmountInFeeAccounting() {
+	s.SetupTest()
+	msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+	k := s.App.PoolManagerKeeper
+
+	poolCoins := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(FOO, defaultInitPoolAmount), sdk.NewCoin(BAR, defaultInitPoolAmount)),
+		sdk.NewCoins(sdk.NewCoin(BAR, defaultInitPoolAmount), sdk.NewCoin(BAZ, defaultInitPoolAmount)),
+	}
+	for i, poolType := range []types.PoolType{types.Balancer, types.Concentrated} {
+		s.FundAcc(s.TestAccs[0], poolCoins[i])
+		s.CreatePoolFromTypeWithCoinsAndSpreadFactor(poolType, poolCoins[i], defaultPoolSpreadFactor)
+	}
+	k.SetDenomPairTakerFee(s.Ctx, FOO, BAR, pointThreePercent)
+	k.SetDenomPairTakerFee(s.Ctx, BAR, BAZ, pointThreePercent)
+
+	tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(100000))
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}, {PoolId: 2, TokenOutDenom: BAZ}}
+
+	// compute the expected fees of each hop
+	firstHopOut, err := k.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routes[:1], tokenIn)
+	s.Require().NoError(err)
+	expectedOut, err := k.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routes, tokenIn)
+	s.Require().NoError(err)
+
+	firstHopIn, firstHopTakerFee := poolmanagerKeeper.CalcTakerFeeExactIn(tokenIn, pointThreePercent)
+	secondHopIn, secondHopTakerFee := poolmanagerKeeper.CalcTakerFeeExactIn(sdk.NewCoin(BAR, firstHopOut), pointThreePercent)
+	expectedSpreadFees := sdk.NewCoins(
+		sdk.NewCoin(FOO, firstHopIn.Amount.ToLegacyDec().Mul(defaultPoolSpreadFactor).TruncateInt()),
+		sdk.NewCoin(BAR, secondHopIn.Amount.ToLegacyDec().Mul(defaultPoolSpreadFactor).TruncateInt()),
+	)
+
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+	res, err := msgServer.SwapExactAmountIn(s.Ctx, &types.MsgSwapExactAmountIn{
+		Sender:            s.TestAccs[1].String(),
+		Routes:            routes,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: osmomath.OneInt(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedOut, res.TokenOutAmount)
+	s.Require().Equal(sdk.NewCoins(firstHopTakerFee, secondHopTakerFee), res.TakerFeesCharged)
+	s.Require().Equal(expectedSpreadFees, res.SpreadFeesCharged)
+}
+
 func (s *KeeperTestSuite) TestSplitRouteSwapExactAmountIn() {
 	testcases := map[string]struct {
 		routes            []types.SwapAmountInSplitRoute
@@ -0,0 +1,110 @@
+package poolmanager
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// SetPoolMigrationLink links the given legacy gamm pool to its replacement concentrated liquidity pool,
+// after which spot price, TWAP and route queries against the legacy pool are served by the replacement pool.
+// A replacement pool id of zero removes the legacy pool's link.
+// Returns error if:
+// - the legacy pool is not a balancer or stableswap pool.
+// - the replacement pool is not a concentrated liquidity pool.
+// - any of the replacement pool's denoms is not in the legacy pool.
+func (k Keeper) SetPoolMigrationLink(ctx sdk.Context, link types.PoolMigrationLink) error {
+	legacyPoolType, err := k.GetPoolType(ctx, link.LegacyPoolId)
+	if err != nil {
+		return err
+	}
+	if legacyPoolType != types.Balancer && legacyPoolType != types.Stableswap {
+		return types.InvalidPoolTypeError{PoolType: legacyPoolType}
+	}
+
+	if link.ReplacementPoolId == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.FormatPoolMigrationLinkKey(link.LegacyPoolId))
+		return nil
+	}
+
+	replacementPoolType, err := k.GetPoolType(ctx, link.ReplacementPoolId)
+	if err != nil {
+		return err
+	}
+	if replacementPoolType != types.Concentrated {
+		return types.InvalidPoolTypeError{PoolType: replacementPoolType}
+	}
+
+	legacyDenoms, err := k.RouteGetPoolDenoms(ctx, link.LegacyPoolId)
+	if err != nil {
+		return err
+	}
+	replacementDenoms, err := k.RouteGetPoolDenoms(ctx, link.ReplacementPoolId)
+	if err != nil {
+		return err
+	}
+	for _, denom := range replacementDenoms {
+		if !slices.Contains(legacyDenoms, denom) {
+			return types.PoolMigrationLinkDenomMismatchError{LegacyPoolId: link.LegacyPoolId, ReplacementPoolId: link.ReplacementPoolId, Denom: denom}
+		}
+	}
+
+	k.setPoolMigrationLink(ctx, link)
+	return nil
+}
+
+func (k Keeper) setPoolMigrationLink(ctx sdk.Context, link types.PoolMigrationLink) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatPoolMigrationLinkKey(link.LegacyPoolId), &link)
+}
+
+// GetPoolMigrationLink returns the id of the pool replacing the given legacy pool.
+// Returns false if the pool has not been marked as migrated.
+func (k Keeper) GetPoolMigrationLink(ctx sdk.Context, legacyPoolId uint64) (uint64, bool) {
+	link := types.PoolMigrationLink{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatPoolMigrationLinkKey(legacyPoolId), &link)
+	if err != nil || !found {
+		return 0, false
+	}
+	return link.ReplacementPoolId, true
+}
+
+// GetAllPoolMigrationLinks returns all links from migrated pools to their replacement pools.
+func (k Keeper) GetAllPoolMigrationLinks(ctx sdk.Context) ([]types.PoolMigrationLink, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPoolMigrationLinkPrefix, func(bz []byte) (types.PoolMigrationLink, error) {
+		link := types.PoolMigrationLink{}
+		err := link.Unmarshal(bz)
+		return link, err
+	})
+}
+
+// ResolveMigratedPoolId returns the id of the pool that queries against the given pool should be served by.
+// This is the replacement pool if the given pool has been marked as migrated, and the given pool otherwise.
+func (k Keeper) ResolveMigratedPoolId(ctx sdk.Context, poolId uint64) uint64 {
+	if replacementPoolId, found := k.GetPoolMigrationLink(ctx, poolId); found {
+		return replacementPoolId
+	}
+	return poolId
+}
+
+// ResolveMigratedSwapAmountInRoutes returns a copy of the given routes with every migrated pool
+// replaced by its replacement pool.
+func (k Keeper) ResolveMigratedSwapAmountInRoutes(ctx sdk.Context, routes []types.SwapAmountInRoute) []types.SwapAmountInRoute {
+	resolvedRoutes := make([]types.SwapAmountInRoute, len(routes))
+	for i, route := range routes {
+		resolvedRoutes[i] = types.SwapAmountInRoute{PoolId: k.ResolveMigratedPoolId(ctx, route.PoolId), TokenOutDenom: route.TokenOutDenom}
+	}
+	return resolvedRoutes
+}
+
+// ResolveMigratedSwapAmountOutRoutes returns a copy of the given routes with every migrated pool
+// replaced by its replacement pool.
+func (k Keeper) ResolveMigratedSwapAmountOutRoutes(ctx sdk.Context, routes []types.SwapAmountOutRoute) []types.SwapAmountOutRoute {
+	resolvedRoutes := make([]types.SwapAmountOutRoute, len(routes))
+	for i, route := range routes {
+		resolvedRoutes[i] = types.SwapAmountOutRoute{PoolId: k.ResolveMigratedPoolId(ctx, route.PoolId), TokenInDenom: route.TokenInDenom}
+	}
+	return resolvedRoutes
+}
@@ -0,0 +1,100 @@
+package poolmanager_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestSetPoolMigrationLink() {
+	tests := map[string]struct {
+		link        func(balancerPoolId, stableswapPoolId, clPoolId, mismatchedClPoolId uint64) types.PoolMigrationLink
+		expectedErr bool
+	}{
+		"balancer to concentrated": {
+			link: func(balancerPoolId, _, clPoolId, _ uint64) types.PoolMigrationLink {
+				return types.PoolMigrationLink{LegacyPoolId: balancerPoolId, ReplacementPoolId: clPoolId}
+			},
+		},
+		"stableswap to concentrated": {
+			link: func(_, stableswapPoolId, clPoolId, _ uint64) types.PoolMigrationLink {
+				return types.PoolMigrationLink{LegacyPoolId: stableswapPoolId, ReplacementPoolId: clPoolId}
+			},
+		},
+		"legacy pool is concentrated": {
+			link: func(_, _, clPoolId, mismatchedClPoolId uint64) types.PoolMigrationLink {
+				return types.PoolMigrationLink{LegacyPoolId: clPoolId, ReplacementPoolId: mismatchedClPoolId}
+			},
+			expectedErr: true,
+		},
+		"replacement pool is not concentrated": {
+			link: func(balancerPoolId, stableswapPoolId, _, _ uint64) types.PoolMigrationLink {
+				return types.PoolMigrationLink{LegacyPoolId: balancerPoolId, ReplacementPoolId: stableswapPoolId}
+			},
+			expectedErr: true,
+		},
+		"replacement pool denom not in legacy pool": {
+			link: func(balancerPoolId, _, _, mismatchedClPoolId uint64) types.PoolMigrationLink {
+				return types.PoolMigrationLink{LegacyPoolId: balancerPoolId, ReplacementPoolId: mismatchedClPoolId}
+			},
+			expectedErr: true,
+		},
+		"replacement pool does not exist": {
+			link: func(balancerPoolId, _, _, _ uint64) types.PoolMigrationLink {
+				return types.PoolMigrationLink{LegacyPoolId: balancerPoolId, ReplacementPoolId: 100}
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			balancerPoolId := s.PrepareBalancerPool()
+			stableswapPoolId := s.PrepareBasicStableswapPool()
+			clPoolId := s.PrepareConcentratedPoolWithCoins("bar", "foo").GetId()
+			mismatchedClPoolId := s.PrepareConcentratedPoolWithCoins("foo", "qux").GetId()
+
+			link := tc.link(balancerPoolId, stableswapPoolId, clPoolId, mismatchedClPoolId)
+			err := s.App.PoolManagerKeeper.SetPoolMigrationLink(s.Ctx, link)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				s.Require().Equal(link.LegacyPoolId, s.App.PoolManagerKeeper.ResolveMigratedPoolId(s.Ctx, link.LegacyPoolId))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(link.ReplacementPoolId, s.App.PoolManagerKeeper.ResolveMigratedPoolId(s.Ctx, link.LegacyPoolId))
+
+			links, err := s.App.PoolManagerKeeper.GetAllPoolMigrationLinks(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.PoolMigrationLink{link}, links)
+
+			// A replacement pool id of zero removes the link.
+			err = s.App.PoolManagerKeeper.SetPoolMigrationLink(s.Ctx, types.PoolMigrationLink{LegacyPoolId: link.LegacyPoolId})
+			s.Require().NoError(err)
+			_, found := s.App.PoolManagerKeeper.GetPoolMigrationLink(s.Ctx, link.LegacyPoolId)
+			s.Require().False(found)
+			s.Require().Equal(link.LegacyPoolId, s.App.PoolManagerKeeper.ResolveMigratedPoolId(s.Ctx, link.LegacyPoolId))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestResolveMigratedSwapAmountInRoutes() {
+	s.SetupTest()
+	balancerPoolId := s.PrepareBalancerPool()
+	otherBalancerPoolId := s.PrepareBalancerPool()
+	clPoolId := s.PrepareConcentratedPoolWithCoins("bar", "foo").GetId()
+
+	err := s.App.PoolManagerKeeper.SetPoolMigrationLink(s.Ctx, types.PoolMigrationLink{LegacyPoolId: balancerPoolId, ReplacementPoolId: clPoolId})
+	s.Require().NoError(err)
+
+	routes := []types.SwapAmountInRoute{
+		{PoolId: balancerPoolId, TokenOutDenom: "bar"},
+		{PoolId: otherBalancerPoolId, TokenOutDenom: "baz"},
+	}
+	resolvedRoutes := s.App.PoolManagerKeeper.ResolveMigratedSwapAmountInRoutes(s.Ctx, routes)
+	s.Require().Equal([]types.SwapAmountInRoute{
+		{PoolId: clPoolId, TokenOutDenom: "bar"},
+		{PoolId: otherBalancerPoolId, TokenOutDenom: "baz"},
+	}, resolvedRoutes)
+	// The given routes are left untouched.
+	s.Require().Equal(balancerPoolId, routes[0].PoolId)
+}
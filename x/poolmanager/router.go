@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -57,12 +58,31 @@ func (k Keeper) RouteExactAmountIn(
 	tokenIn sdk.Coin,
 	tokenOutMinAmount osmomath.Int,
 ) (tokenOutAmount osmomath.Int, err error) {
+	tokenOutAmount, _, _, err = k.routeExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount)
+	return tokenOutAmount, err
+}
+
+// routeExactAmountIn implements RouteExactAmountIn, additionally returning the total
+// taker fees and spread fees charged across all hops of the route. Each hop is resolved
+// to its pool's swap module, so a route may mix pool types.
+func (k Keeper) routeExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount osmomath.Int,
+) (tokenOutAmount osmomath.Int, totalTakerFeesCharged, totalSpreadFeesCharged sdk.Coins, err error) {
 	// Ensure that provided route is not empty and has valid denom format.
 	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
-		return osmomath.Int{}, err
+		return osmomath.Int{}, nil, nil, err
 	}
 
-	totalTakerFeesCharged := sdk.Coins{}
+	if err := k.validateSwapAmountInRouteSpotPrices(ctx, route, tokenIn.Denom); err != nil {
+		return osmomath.Int{}, nil, nil, err
+	}
+
+	totalTakerFeesCharged = sdk.Coins{}
+	totalSpreadFeesCharged = sdk.Coins{}
 	denomsInvolvedInRoute := []string{tokenIn.Denom}
 
 	// Iterate through the route and execute a series of swaps through each pool.
@@ -74,17 +94,18 @@ func (k Keeper) RouteExactAmountIn(
 			_outMinAmount = tokenOutMinAmount
 		}
 
-		var takerFeeCharged sdk.Coin
-		tokenOutAmount, takerFeeCharged, err = k.SwapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount)
+		var takerFeeCharged, spreadFeeCharged sdk.Coin
+		tokenOutAmount, takerFeeCharged, spreadFeeCharged, err = k.swapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount)
 		if err != nil {
-			return osmomath.Int{}, err
+			return osmomath.Int{}, nil, nil, err
 		}
 
 		// Chain output of current pool as the input for the next routed pool
 		tokenIn = sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)
 
-		// Track taker fees charged
+		// Track taker and spread fees charged
 		totalTakerFeesCharged = totalTakerFeesCharged.Add(takerFeeCharged)
+		totalSpreadFeesCharged = totalSpreadFeesCharged.Add(spreadFeeCharged)
 
 		// Add the token out denom to the denoms involved in the route, IFF it is not already in the slice
 		if !osmoutils.Contains(denomsInvolvedInRoute, routeStep.TokenOutDenom) {
@@ -95,10 +116,10 @@ func (k Keeper) RouteExactAmountIn(
 	// Run taker fee skim logic
 	err = k.TakerFeeSkim(ctx, denomsInvolvedInRoute, totalTakerFeesCharged)
 	if err != nil {
-		return osmomath.Int{}, err
+		return osmomath.Int{}, nil, nil, err
 	}
 
-	return tokenOutAmount, nil
+	return tokenOutAmount, totalTakerFeesCharged, totalSpreadFeesCharged, nil
 }
 
 // SplitRouteExactAmountIn routes the swap across multiple multihop paths
@@ -182,31 +203,48 @@ func (k Keeper) SwapExactAmountIn(
 	tokenOutDenom string,
 	tokenOutMinAmount osmomath.Int,
 ) (tokenOutAmount osmomath.Int, takerFeeCharged sdk.Coin, err error) {
+	tokenOutAmount, takerFeeCharged, _, err = k.swapExactAmountIn(ctx, sender, poolId, tokenIn, tokenOutDenom, tokenOutMinAmount)
+	return tokenOutAmount, takerFeeCharged, err
+}
+
+// swapExactAmountIn implements SwapExactAmountIn, additionally returning the spread fee
+// charged by the pool. The spread fee is computed from the pool's spread factor and the
+// token in remaining after the taker fee is deducted.
+func (k Keeper) swapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount osmomath.Int,
+) (tokenOutAmount osmomath.Int, takerFeeCharged, spreadFeeCharged sdk.Coin, err error) {
 	swapModule, pool, err := k.GetPoolModuleAndPool(ctx, poolId)
 	if err != nil {
-		return osmomath.Int{}, sdk.Coin{}, err
+		return osmomath.Int{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	// Check if pool has swaps enabled.
 	if !pool.IsActive(ctx) {
-		return osmomath.Int{}, sdk.Coin{}, fmt.Errorf("pool %d is not active", pool.GetId())
+		return osmomath.Int{}, sdk.Coin{}, sdk.Coin{}, fmt.Errorf("pool %d is not active", pool.GetId())
 	}
 
-	tokenInAfterSubTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
+	tokenInAfterSubTakerFee, takerFeeCharged, err := k.ChargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
 	if err != nil {
-		return osmomath.Int{}, sdk.Coin{}, err
+		return osmomath.Int{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	// routeStep to the pool-specific SwapExactAmountIn implementation.
-	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenInAfterSubTakerFee, tokenOutDenom, tokenOutMinAmount, pool.GetSpreadFactor(ctx))
+	spreadFactor := pool.GetSpreadFactor(ctx)
+	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenInAfterSubTakerFee, tokenOutDenom, tokenOutMinAmount, spreadFactor)
 	if err != nil {
-		return osmomath.Int{}, sdk.Coin{}, err
+		return osmomath.Int{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	// Track volume for volume-splitting incentives
-	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.TrackVolume(ctx, pool.GetId(), tokenIn)
 
-	return tokenOutAmount, takerFeeCharged, nil
+	spreadFeeCharged = sdk.NewCoin(tokenIn.Denom, tokenInAfterSubTakerFee.Amount.ToLegacyDec().Mul(spreadFactor).TruncateInt())
+	return tokenOutAmount, takerFeeCharged, spreadFeeCharged, nil
 }
 
 // SwapExactAmountInNoTakerFee is an API for swapping an exact amount of tokens
@@ -239,7 +277,7 @@ func (k Keeper) SwapExactAmountInNoTakerFee(
 	}
 
 	// Track volume for volume-splitting incentives
-	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.TrackVolume(ctx, pool.GetId(), tokenIn)
 
 	return tokenOutAmount, nil
 }
@@ -260,6 +298,29 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 	return k.multihopEstimateOutGivenExactAmountInInternal(ctx, route, tokenIn, true)
 }
 
+// MultihopEstimateOutGivenExactAmountInWithHops dry runs a swap along the given route against a cached context,
+// returning the amounts swapped and fees charged on every hop. No state is written and no funds are required.
+func (k Keeper) MultihopEstimateOutGivenExactAmountInWithHops(
+	ctx sdk.Context,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+) (hops []types.SwapHopEstimate, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			hops = nil
+			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("function MultihopEstimateOutGivenExactAmountInWithHops failed due to lack of gas: %v", d)
+			} else {
+				err = fmt.Errorf("function MultihopEstimateOutGivenExactAmountInWithHops failed due to internal reason: %v", r)
+			}
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.estimateSwapAmountInHops(cacheCtx, route, tokenIn, true)
+}
+
 func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 	ctx sdk.Context,
 	route []types.SwapAmountInRoute,
@@ -278,53 +339,79 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 		}
 	}()
 
-	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+	hops, err := k.estimateSwapAmountInHops(ctx, route, tokenIn, applyTakerFee)
+	if err != nil {
 		return osmomath.Int{}, err
 	}
+	return hops[len(hops)-1].TokenOut.Amount, nil
+}
+
+// estimateSwapAmountInHops estimates the outcome of every hop of a swap of tokenIn along the given route,
+// chaining the output of each hop as the input of the next one.
+func (k Keeper) estimateSwapAmountInHops(
+	ctx sdk.Context,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	applyTakerFee bool,
+) ([]types.SwapHopEstimate, error) {
+	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+		return nil, err
+	}
 
+	hops := make([]types.SwapHopEstimate, 0, len(route))
 	for _, routeStep := range route {
 		swapModule, poolI, err := k.GetPoolModuleAndPool(ctx, routeStep.PoolId)
 		if err != nil {
-			return osmomath.Int{}, err
+			return nil, err
 		}
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
 		actualTokenIn := tokenIn
+		takerFeeCharged := sdk.NewCoin(tokenIn.Denom, osmomath.ZeroInt())
 		// apply taker fee if applicable
 		if applyTakerFee {
 			takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, routeStep.TokenOutDenom)
 			if err != nil {
-				return osmomath.Int{}, err
+				return nil, err
 			}
 
-			actualTokenIn, _ = CalcTakerFeeExactIn(tokenIn, takerFee)
+			actualTokenIn, takerFeeCharged = CalcTakerFeeExactIn(tokenIn, takerFee)
 		}
 
 		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, poolI, actualTokenIn, routeStep.TokenOutDenom, spreadFactor)
 		if err != nil {
-			return osmomath.Int{}, err
+			return nil, err
 		}
 
-		tokenOutAmount = tokenOut.Amount
-		if !tokenOutAmount.IsPositive() {
-			return osmomath.Int{}, errors.New("token amount must be positive")
+		if !tokenOut.Amount.IsPositive() {
+			return nil, errors.New("token amount must be positive")
 		}
 
+		hops = append(hops, types.SwapHopEstimate{
+			PoolId:           routeStep.PoolId,
+			TokenIn:          tokenIn,
+			TokenOut:         tokenOut,
+			TakerFeeCharged:  takerFeeCharged,
+			SpreadFeeCharged: sdk.NewCoin(tokenIn.Denom, actualTokenIn.Amount.ToLegacyDec().Mul(spreadFactor).TruncateInt()),
+		})
+
 		// Chain output of current pool as the input for the next routed pool
 		// We don't need to validate the denom,
 		// as CalcOutAmtGivenIn is responsible for ensuring the denom exists in the pool.
-		tokenIn = sdk.Coin{Denom: routeStep.TokenOutDenom, Amount: tokenOutAmount}
+		tokenIn = sdk.Coin{Denom: routeStep.TokenOutDenom, Amount: tokenOut.Amount}
 	}
-	return tokenOutAmount, err
+	return hops, nil
 }
 
 // RouteExactAmountOut processes a swap along the given route using the swap function corresponding
 // to poolID's pool type. This function is responsible for computing the optimal output amount
 // for a given input amount when swapping tokens, taking into account the current price of the
 // tokens in the pool and any slippage.
-// Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined
-// tokenInMaxAmount defined.
+// The route is computed backwards from tokenOut: every pool is asked for the output the next pool
+// needs as input, including that pool's taker fee.
+// Transaction succeeds if the calculated tokenInAmount of the first pool, including its taker fee,
+// is at most tokenInMaxAmount.
 func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	sender sdk.AccAddress,
 	route []types.SwapAmountOutRoute,
@@ -348,6 +435,10 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 	}()
 
+	if err := k.validateSwapAmountOutRouteSpotPrices(ctx, route, tokenOut.Denom); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	var insExpected []osmomath.Int
 	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
 
@@ -397,19 +488,25 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 
 		tokenIn := sdk.NewCoin(routeStep.TokenInDenom, curTokenInAmount)
-		tokenInAfterAddTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false)
+		tokenInAfterAddTakerFee, takerFeeCharged, err := k.ChargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
 
 		// Track volume for volume-splitting incentives
-		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount))
+		k.TrackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount))
 
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
 		// swaps.
 		if i == 0 {
 			tokenInAmount = tokenInAfterAddTakerFee.Amount
+
+			// The first pool only checks its own token in against tokenInMaxAmount, while the taker fee
+			// is charged on top of it. Ensure the sender never pays more than tokenInMaxAmount in total.
+			if tokenInAmount.GT(tokenInMaxAmount) {
+				return osmomath.Int{}, types.PriceImpactProtectionExactOutError{Actual: tokenInAmount, MaxAmount: tokenInMaxAmount}
+			}
 		}
 
 		// Track taker fees charged
@@ -535,6 +632,122 @@ func (k Keeper) RouteCalculateSpotPrice(
 	return price, nil
 }
 
+// RouteSpotPrice returns the spot price of swapping tokenInDenom through the given route,
+// expressed as the amount of the route's final token out per unit of token in. It also
+// returns the spot price after fees and the fraction of the token in charged as combined
+// spread and taker fees across all hops. Each hop is resolved to its pool's swap module,
+// so a route may mix pool types.
+func (k Keeper) RouteSpotPrice(
+	ctx sdk.Context,
+	route []types.SwapAmountInRoute,
+	tokenInDenom string,
+) (spotPrice, spotPriceAfterFees osmomath.BigDec, totalFee osmomath.Dec, err error) {
+	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, osmomath.Dec{}, err
+	}
+	if err := sdk.ValidateDenom(tokenInDenom); err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, osmomath.Dec{}, err
+	}
+
+	spotPrice = osmomath.OneBigDec()
+	// remainingAfterFees is the fraction of the token in that is left after
+	// the spread and taker fees of every hop so far are deducted.
+	remainingAfterFees := osmomath.OneDec()
+	for _, routeStep := range route {
+		swapModule, pool, err := k.GetPoolModuleAndPool(ctx, routeStep.PoolId)
+		if err != nil {
+			return osmomath.BigDec{}, osmomath.BigDec{}, osmomath.Dec{}, err
+		}
+
+		hopSpotPrice, err := swapModule.CalculateSpotPrice(ctx, routeStep.PoolId, routeStep.TokenOutDenom, tokenInDenom)
+		if err != nil {
+			return osmomath.BigDec{}, osmomath.BigDec{}, osmomath.Dec{}, err
+		}
+
+		takerFee, err := k.GetTradingPairTakerFee(ctx, tokenInDenom, routeStep.TokenOutDenom)
+		if err != nil {
+			return osmomath.BigDec{}, osmomath.BigDec{}, osmomath.Dec{}, err
+		}
+
+		spotPrice = spotPrice.MulMut(hopSpotPrice)
+		remainingAfterFees = remainingAfterFees.Mul(osmomath.OneDec().Sub(takerFee)).Mul(osmomath.OneDec().Sub(pool.GetSpreadFactor(ctx)))
+
+		// Chain the token out of the current hop as the token in of the next hop
+		tokenInDenom = routeStep.TokenOutDenom
+	}
+
+	spotPriceAfterFees = spotPrice.Mul(osmomath.BigDecFromDec(remainingAfterFees))
+	return spotPrice, spotPriceAfterFees, osmomath.OneDec().Sub(remainingAfterFees), nil
+}
+
+// validateSwapAmountInRouteSpotPrices checks the spot price of every hop of the given route
+// against its pool's recent TWAP. See validateSpotPriceAgainstTwap.
+func (k Keeper) validateSwapAmountInRouteSpotPrices(ctx sdk.Context, route []types.SwapAmountInRoute, tokenInDenom string) error {
+	var maxDeviation osmomath.Dec
+	k.paramSpace.Get(ctx, types.KeyRouteSpotPriceMaxTwapDeviation, &maxDeviation)
+	if maxDeviation.IsZero() {
+		return nil
+	}
+
+	for _, routeStep := range route {
+		if err := k.validateSpotPriceAgainstTwap(ctx, routeStep.PoolId, tokenInDenom, routeStep.TokenOutDenom, maxDeviation); err != nil {
+			return err
+		}
+		tokenInDenom = routeStep.TokenOutDenom
+	}
+	return nil
+}
+
+// validateSwapAmountOutRouteSpotPrices checks the spot price of every hop of the given route
+// against its pool's recent TWAP. See validateSpotPriceAgainstTwap.
+func (k Keeper) validateSwapAmountOutRouteSpotPrices(ctx sdk.Context, route []types.SwapAmountOutRoute, tokenOutDenom string) error {
+	var maxDeviation osmomath.Dec
+	k.paramSpace.Get(ctx, types.KeyRouteSpotPriceMaxTwapDeviation, &maxDeviation)
+	if maxDeviation.IsZero() {
+		return nil
+	}
+
+	for i, routeStep := range route {
+		hopTokenOutDenom := tokenOutDenom
+		if i != len(route)-1 {
+			hopTokenOutDenom = route[i+1].TokenInDenom
+		}
+		if err := k.validateSpotPriceAgainstTwap(ctx, routeStep.PoolId, routeStep.TokenInDenom, hopTokenOutDenom, maxDeviation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSpotPriceAgainstTwap returns a SpotPriceTwapDeviationError if the spot price of the given pool
+// deviates from its arithmetic TWAP over the route spot price TWAP window by more than maxDeviation,
+// relative to the TWAP. This protects users from routing through a pool whose price has just been manipulated.
+// The check is skipped if the pool has no TWAP covering the whole window yet, e.g. because it was just created.
+func (k Keeper) validateSpotPriceAgainstTwap(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, maxDeviation osmomath.Dec) error {
+	if k.twapKeeper == nil {
+		return nil
+	}
+
+	var twapWindow time.Duration
+	k.paramSpace.Get(ctx, types.KeyRouteSpotPriceTwapWindow, &twapWindow)
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, tokenInDenom, tokenOutDenom, ctx.BlockTime().Add(-twapWindow))
+	if err != nil || !twap.IsPositive() {
+		return nil
+	}
+
+	spotPrice, err := k.RouteCalculateSpotPrice(ctx, poolId, tokenOutDenom, tokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	twapBigDec := osmomath.BigDecFromDec(twap)
+	deviation := spotPrice.Sub(twapBigDec).Abs().QuoMut(twapBigDec)
+	if deviation.GT(osmomath.BigDecFromDec(maxDeviation)) {
+		return types.SpotPriceTwapDeviationError{PoolId: poolId, SpotPrice: spotPrice, Twap: twap, MaxDeviation: maxDeviation}
+	}
+	return nil
+}
+
 func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
@@ -571,6 +784,33 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	return insExpected[0], nil
 }
 
+// MultihopEstimateInGivenExactAmountOutWithHops dry runs a swap along the given route against a cached context,
+// returning the amounts swapped and fees charged on every hop. No state is written and no funds are required.
+func (k Keeper) MultihopEstimateInGivenExactAmountOutWithHops(
+	ctx sdk.Context,
+	route []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+) (hops []types.SwapHopEstimate, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			hops = nil
+			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("function MultihopEstimateInGivenExactAmountOutWithHops failed due to lack of gas: %v", d)
+			} else {
+				err = fmt.Errorf("function MultihopEstimateInGivenExactAmountOutWithHops failed due to internal reason: %v", r)
+			}
+		}
+	}()
+
+	if err := types.SwapAmountOutRoutes(route).Validate(); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.estimateSwapAmountOutHops(cacheCtx, route, tokenOut)
+}
+
 func (k Keeper) GetPool(
 	ctx sdk.Context,
 	poolId uint64,
@@ -643,7 +883,27 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) ([]osmomath.Int, error) {
-	insExpected := make([]osmomath.Int, len(route))
+	hops, err := k.estimateSwapAmountOutHops(ctx, route, tokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	insExpected := make([]osmomath.Int, len(hops))
+	for i, hop := range hops {
+		insExpected[i] = hop.TokenIn.Amount
+	}
+	return insExpected, nil
+}
+
+// estimateSwapAmountOutHops estimates the outcome of every hop of a swap for tokenOut along the given route,
+// walking the route backwards and chaining the input of each hop as the output of the previous one.
+// The returned hops are in route order.
+func (k Keeper) estimateSwapAmountOutHops(
+	ctx sdk.Context,
+	route []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+) ([]types.SwapHopEstimate, error) {
+	hops := make([]types.SwapHopEstimate, len(route))
 	for i := len(route) - 1; i >= 0; i-- {
 		routeStep := route[i]
 
@@ -664,13 +924,19 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
-		tokenInAfterTakerFee, _ := CalcTakerFeeExactOut(tokenIn, takerFee)
+		tokenInAfterTakerFee, takerFeeCharged := CalcTakerFeeExactOut(tokenIn, takerFee)
 
-		insExpected[i] = tokenInAfterTakerFee.Amount
+		hops[i] = types.SwapHopEstimate{
+			PoolId:           routeStep.PoolId,
+			TokenIn:          tokenInAfterTakerFee,
+			TokenOut:         tokenOut,
+			TakerFeeCharged:  takerFeeCharged,
+			SpreadFeeCharged: sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToLegacyDec().Mul(spreadFactor).TruncateInt()),
+		}
 		tokenOut = tokenInAfterTakerFee
 	}
 
-	return insExpected, nil
+	return hops, nil
 }
 
 // GetTotalPoolLiquidity gets the total liquidity for a given poolId.
@@ -707,13 +973,13 @@ func (k Keeper) TotalLiquidity(ctx sdk.Context) (sdk.Coins, error) {
 }
 
 // nolint: unused
-// trackVolume converts the input token into OSMO units and adds it to the global tracked volume for the given pool ID.
+// TrackVolume converts the input token into OSMO units and adds it to the global tracked volume for the given pool ID.
 // Fails quietly if an OSMO paired pool cannot be found, although this should only happen in rare scenarios where OSMO is
 // removed as a base denom from the protorev module (which this function relies on).
 //
 // CONTRACT: `volumeGenerated` corresponds to one of the denoms in the pool
 // CONTRACT: pool with `poolId` exists
-func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
+func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
 	// If the denom is already denominated in uosmo, we can just use it directly
 	OSMO, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
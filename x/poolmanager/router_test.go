@@ -3,6 +3,7 @@ package poolmanager_test
 import (
 	"errors"
 	"reflect"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -737,6 +738,48 @@ func (s *KeeperTestSuite) TestMultihopSwapExactAmountIn() {
 	}
 }
 
+// TestRouteSpotPrice tests that the spot price and combined fees of a route mixing
+// balancer and concentrated pools are computed across all of its hops.
+func (s *KeeperTestSuite) TestRouteSpotPrice() {
+	s.SetupTest()
+	k := s.App.PoolManagerKeeper
+
+	// pool 1 is a balancer pool where 1 foo is worth 2 bar.
+	// pool 2 is a concentrated pool where 1 bar is worth 1 baz.
+	poolCoins := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(FOO, defaultInitPoolAmount), sdk.NewCoin(BAR, defaultInitPoolAmount.MulRaw(2))),
+		sdk.NewCoins(sdk.NewCoin(BAR, defaultInitPoolAmount), sdk.NewCoin(BAZ, defaultInitPoolAmount)),
+	}
+	for i, poolType := range []types.PoolType{types.Balancer, types.Concentrated} {
+		s.FundAcc(s.TestAccs[0], poolCoins[i])
+		s.CreatePoolFromTypeWithCoinsAndSpreadFactor(poolType, poolCoins[i], defaultPoolSpreadFactor)
+	}
+	k.SetDenomPairTakerFee(s.Ctx, FOO, BAR, pointThreePercent)
+	k.SetDenomPairTakerFee(s.Ctx, BAR, BAZ, pointThreePercent)
+
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}, {PoolId: 2, TokenOutDenom: BAZ}}
+	spotPrice, spotPriceAfterFees, totalFee, err := k.RouteSpotPrice(s.Ctx, routes, FOO)
+	s.Require().NoError(err)
+
+	hopRemaining := osmomath.OneDec().Sub(pointThreePercent).Mul(osmomath.OneDec().Sub(defaultPoolSpreadFactor))
+	expectedTotalFee := osmomath.OneDec().Sub(hopRemaining.Mul(hopRemaining))
+	s.Require().Equal(osmomath.NewBigDec(2), spotPrice)
+	s.Require().Equal(expectedTotalFee, totalFee)
+	s.Require().Equal(osmomath.NewBigDec(2).Mul(osmomath.BigDecFromDec(osmomath.OneDec().Sub(expectedTotalFee))), spotPriceAfterFees)
+
+	// the route must start from a denom in the first pool
+	_, _, _, err = k.RouteSpotPrice(s.Ctx, routes, BAZ)
+	s.Require().Error(err)
+
+	// the route must not be empty
+	_, _, _, err = k.RouteSpotPrice(s.Ctx, []types.SwapAmountInRoute{}, FOO)
+	s.Require().Error(err)
+
+	// every pool in the route must exist
+	_, _, _, err = k.RouteSpotPrice(s.Ctx, []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: BAR}}, FOO)
+	s.Require().Error(err)
+}
+
 // TestMultihopSwapExactAmountOut tests that the swaps are routed correctly.
 // That is:
 // - to the correct module (concentrated-liquidity or gamm)
@@ -974,6 +1017,41 @@ func (s *KeeperTestSuite) TestMultihopSwapExactAmountOut() {
 	}
 }
 
+// TestMultihopSwapExactAmountOut_TokenInMaxAmountIncludesTakerFee tests that the token in cap of a multihop exact amount out
+// swap covers the taker fee charged on top of the first pool's token in.
+func (s *KeeperTestSuite) TestMultihopSwapExactAmountOut_TokenInMaxAmountIncludesTakerFee() {
+	s.SetupTest()
+	poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	poolManagerParams.TakerFeeParams.DefaultTakerFee = osmomath.MustNewDecFromStr("0.01")
+	s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+
+	firstPoolId := s.PrepareBalancerPool()
+	secondPoolId := s.PrepareBalancerPool()
+	routes := []types.SwapAmountOutRoute{
+		{PoolId: firstPoolId, TokenInDenom: FOO},
+		{PoolId: secondPoolId, TokenInDenom: BAR},
+	}
+	tokenOut := sdk.NewCoin(BAZ, osmomath.NewInt(100000))
+
+	expectedTokenInAmount, err := s.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, routes, tokenOut)
+	s.Require().NoError(err)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(FOO, expectedTokenInAmount)))
+
+	// The first pool's token in without the taker fee is below the cap, but the total token in is not.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	maxAmount := expectedTokenInAmount.Sub(osmomath.OneInt())
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountOut(cacheCtx, s.TestAccs[0], routes, maxAmount, tokenOut)
+	var priceImpactErr types.PriceImpactProtectionExactOutError
+	s.Require().ErrorAs(err, &priceImpactErr)
+	s.Require().Equal(expectedTokenInAmount, priceImpactErr.Actual)
+
+	balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], BAZ)
+	tokenInAmount, err := s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], routes, expectedTokenInAmount, tokenOut)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTokenInAmount, tokenInAmount)
+	s.Require().Equal(balanceBefore.Add(tokenOut), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], BAZ))
+}
+
 // TestEstimateMultihopSwapExactAmountIn tests that the estimation done via `EstimateSwapExactAmountIn`
 // results in the same amount of token out as the actual swap.
 func (s *KeeperTestSuite) TestEstimateMultihopSwapExactAmountIn() {
@@ -3355,7 +3433,7 @@ func (s *KeeperTestSuite) runMultipleTrackVolumes(poolId uint64, volume sdk.Coin
 // Testing strategy:
 // 1. If applicable, create an OSMO-paired pool
 // 2. Set OSMO-paired pool as canonical for that denom pair in state
-// 3. Run `trackVolume` on test input amount (cases include both OSMO and non-OSMO volumes)
+// 3. Run `TrackVolume` on test input amount (cases include both OSMO and non-OSMO volumes)
 // 4. Assert correct amount was added to pool volume
 func (s *KeeperTestSuite) TestTrackVolume() {
 	hundred := osmomath.NewInt(100)
@@ -3788,7 +3866,7 @@ func (s *KeeperTestSuite) TestTakerFee() {
 }
 
 // This test validates that SwapExactAmountIn tracks volume correctly.
-// It is a simple check to make sure that trackVolume() is called.
+// It is a simple check to make sure that TrackVolume() is called.
 func (s *KeeperTestSuite) TestSwapExactAmountIn_VolumeTracked() {
 	const withTakerFee = false
 
@@ -3924,3 +4002,100 @@ func (suite *KeeperTestSuite) TestListPoolsByDenom() {
 		})
 	}
 }
+
+// TestRouteSpotPriceTwapCheck tests that routes through a pool whose spot price deviates from its
+// recent TWAP by more than the max deviation param are rejected before being executed.
+func (s *KeeperTestSuite) TestRouteSpotPriceTwapCheck() {
+	for name, maxDeviation := range map[string]osmomath.Dec{
+		"check disabled": osmomath.ZeroDec(),
+		"check enabled":  osmomath.MustNewDecFromStr("0.1"),
+	} {
+		s.Run(name, func() {
+			s.SetupTest()
+			k := s.App.PoolManagerKeeper
+			k.SetParam(s.Ctx, types.KeyRouteSpotPriceMaxTwapDeviation, maxDeviation)
+			k.SetParam(s.Ctx, types.KeyRouteSpotPriceTwapWindow, 10*time.Minute)
+
+			poolId := s.PrepareBalancerPool()
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+			route := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: BAR}}
+			tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(1000))
+
+			// The spot price is in line with the TWAP.
+			_, err := k.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, osmomath.OneInt())
+			s.Require().NoError(err)
+
+			// Manipulate the spot price of the pool within the block, so that it is not yet reflected in its TWAP.
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			_, err = s.App.GAMMKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], pool, sdk.NewCoin(FOO, osmomath.NewInt(5000000)), BAR, osmomath.OneInt(), osmomath.ZeroDec())
+			s.Require().NoError(err)
+
+			_, err = k.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, osmomath.OneInt())
+			if maxDeviation.IsZero() {
+				s.Require().NoError(err)
+				return
+			}
+			s.Require().ErrorAs(err, &types.SpotPriceTwapDeviationError{})
+
+			_, err = k.RouteExactAmountOut(s.Ctx, s.TestAccs[0], []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: FOO}}, osmomath.NewInt(1000000), sdk.NewCoin(BAR, osmomath.NewInt(1000)))
+			s.Require().ErrorAs(err, &types.SpotPriceTwapDeviationError{})
+		})
+	}
+}
+
+// TestMultihopEstimateWithHops tests that the per-hop estimates chain into each other, match the
+// estimated and executed swap amounts and leave the pools untouched.
+func (s *KeeperTestSuite) TestMultihopEstimateWithHops() {
+	s.SetupTest()
+	k := s.App.PoolManagerKeeper
+	poolParams := balancer.PoolParams{SwapFee: defaultPoolSpreadFactor, ExitFee: osmomath.ZeroDec()}
+	firstPoolId := s.PrepareBalancerPoolWithPoolParams(poolParams)
+	secondPoolId := s.PrepareBalancerPoolWithPoolParams(poolParams)
+	tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(100000))
+
+	firstPool, err := k.GetPool(s.Ctx, firstPoolId)
+	s.Require().NoError(err)
+
+	// Exact amount in.
+	inRoute := []types.SwapAmountInRoute{{PoolId: firstPoolId, TokenOutDenom: BAR}, {PoolId: secondPoolId, TokenOutDenom: BAZ}}
+	hops, err := k.MultihopEstimateOutGivenExactAmountInWithHops(s.Ctx, inRoute, tokenIn)
+	s.Require().NoError(err)
+	s.Require().Len(hops, 2)
+	s.Require().Equal(tokenIn, hops[0].TokenIn)
+	s.Require().Equal(hops[0].TokenOut, hops[1].TokenIn)
+	for i, hop := range hops {
+		s.Require().Equal(inRoute[i].PoolId, hop.PoolId)
+		s.Require().Equal(hop.TokenIn.Denom, hop.TakerFeeCharged.Denom)
+		s.Require().Equal(hop.TokenIn.Denom, hop.SpreadFeeCharged.Denom)
+		s.Require().True(hop.SpreadFeeCharged.IsPositive())
+	}
+
+	estimatedTokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(s.Ctx, inRoute, tokenIn)
+	s.Require().NoError(err)
+	s.Require().Equal(estimatedTokenOutAmount, hops[1].TokenOut.Amount)
+
+	// The estimate does not alter the pools.
+	firstPoolAfterEstimate, err := k.GetPool(s.Ctx, firstPoolId)
+	s.Require().NoError(err)
+	s.Require().Equal(firstPool, firstPoolAfterEstimate)
+
+	// Exact amount out.
+	tokenOut := sdk.NewCoin(BAZ, osmomath.NewInt(100000))
+	outRoute := []types.SwapAmountOutRoute{{PoolId: firstPoolId, TokenInDenom: FOO}, {PoolId: secondPoolId, TokenInDenom: BAR}}
+	hops, err = k.MultihopEstimateInGivenExactAmountOutWithHops(s.Ctx, outRoute, tokenOut)
+	s.Require().NoError(err)
+	s.Require().Len(hops, 2)
+	s.Require().Equal(tokenOut, hops[1].TokenOut)
+	s.Require().Equal(hops[0].TokenOut, hops[1].TokenIn)
+
+	estimatedTokenInAmount, err := k.MultihopEstimateInGivenExactAmountOut(s.Ctx, outRoute, tokenOut)
+	s.Require().NoError(err)
+	s.Require().Equal(estimatedTokenInAmount, hops[0].TokenIn.Amount)
+
+	// The executed swap matches the estimate.
+	tokenOutAmount, err := k.RouteExactAmountIn(s.Ctx, s.TestAccs[0], inRoute, tokenIn, osmomath.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(estimatedTokenOutAmount, tokenOutAmount)
+}
@@ -0,0 +1,177 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// swapTestVectorLiquidityDivisors are the divisors of a denom's pool liquidity used as default swap amounts,
+// along with the smallest amount of 1, so that vectors cover swaps from dust up to a tenth of the pool.
+var swapTestVectorLiquidityDivisors = []int64{10_000, 1_000, 100, 10}
+
+// GenerateSwapTestVectors generates swap test vectors for the given pools at the current state, for client SDKs
+// to validate their local swap math against. If no pool ids are given, vectors are generated for all pools.
+//
+// For every ordered pair of denoms of a pool, an exact in vector is generated for each amount of the denom in
+// and an exact out vector for each amount of the denom out, estimated the same way the swap is executed,
+// including the taker fee of the pair. If no amounts are given, the amounts default to 1 and to fractions of
+// the pool liquidity of the denom.
+// Swaps the chain rejects produce a vector with the error instead of the expected amounts.
+// No state is written.
+func (k Keeper) GenerateSwapTestVectors(ctx sdk.Context, poolIds []uint64, amounts []osmomath.Int) (types.SwapTestVectors, error) {
+	if len(poolIds) == 0 {
+		pools, err := k.AllPools(ctx)
+		if err != nil {
+			return types.SwapTestVectors{}, err
+		}
+		for _, pool := range pools {
+			poolIds = append(poolIds, pool.GetId())
+		}
+	}
+
+	testVectors := types.SwapTestVectors{
+		Height:    ctx.BlockHeight(),
+		BlockTime: ctx.BlockTime(),
+		Pools:     make([]types.SwapTestVectorPool, 0, len(poolIds)),
+		Vectors:   []types.SwapTestVector{},
+	}
+	for _, poolId := range poolIds {
+		pool, err := k.getSwapTestVectorPool(ctx, poolId)
+		if err != nil {
+			return types.SwapTestVectors{}, err
+		}
+		testVectors.Pools = append(testVectors.Pools, pool)
+
+		denoms, err := k.RouteGetPoolDenoms(ctx, poolId)
+		if err != nil {
+			return types.SwapTestVectors{}, err
+		}
+		for _, tokenInDenom := range denoms {
+			for _, tokenOutDenom := range denoms {
+				if tokenInDenom == tokenOutDenom {
+					continue
+				}
+				vectors, err := k.generatePairSwapTestVectors(ctx, poolId, tokenInDenom, tokenOutDenom, pool.TotalLiquidity, amounts)
+				if err != nil {
+					return types.SwapTestVectors{}, err
+				}
+				testVectors.Vectors = append(testVectors.Vectors, vectors...)
+			}
+		}
+	}
+	return testVectors, nil
+}
+
+// getSwapTestVectorPool returns the state of the pool the swap test vectors are computed against.
+func (k Keeper) getSwapTestVectorPool(ctx sdk.Context, poolId uint64) (types.SwapTestVectorPool, error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return types.SwapTestVectorPool{}, err
+	}
+
+	totalLiquidity, err := k.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return types.SwapTestVectorPool{}, err
+	}
+
+	poolJson, err := codec.ProtoMarshalJSON(pool.AsSerializablePool(), nil)
+	if err != nil {
+		return types.SwapTestVectorPool{}, err
+	}
+
+	return types.SwapTestVectorPool{
+		PoolId:         poolId,
+		PoolType:       pool.GetType().String(),
+		SpreadFactor:   pool.GetSpreadFactor(ctx),
+		TotalLiquidity: totalLiquidity,
+		Pool:           poolJson,
+	}, nil
+}
+
+// generatePairSwapTestVectors returns the exact in and exact out swap test vectors of the pool for swapping
+// tokenInDenom for tokenOutDenom.
+func (k Keeper) generatePairSwapTestVectors(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, poolLiquidity sdk.Coins, amounts []osmomath.Int) ([]types.SwapTestVector, error) {
+	takerFee, err := k.GetTradingPairTakerFee(ctx, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := []types.SwapTestVector{}
+	for _, amount := range swapTestVectorAmounts(amounts, poolLiquidity.AmountOf(tokenInDenom)) {
+		tokenIn := sdk.NewCoin(tokenInDenom, amount)
+		vector := types.SwapTestVector{
+			PoolId:   poolId,
+			SwapType: types.SwapTypeExactIn,
+			TakerFee: takerFee,
+			TokenIn:  tokenIn,
+			Rounding: types.RoundingDown,
+		}
+		vectors = append(vectors, estimateSwapTestVector(ctx, vector, func(ctx sdk.Context) ([]types.SwapHopEstimate, error) {
+			return k.estimateSwapAmountInHops(ctx, []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}, tokenIn, true)
+		}))
+	}
+
+	for _, amount := range swapTestVectorAmounts(amounts, poolLiquidity.AmountOf(tokenOutDenom)) {
+		tokenOut := sdk.NewCoin(tokenOutDenom, amount)
+		vector := types.SwapTestVector{
+			PoolId:   poolId,
+			SwapType: types.SwapTypeExactOut,
+			TakerFee: takerFee,
+			TokenOut: tokenOut,
+			Rounding: types.RoundingUp,
+		}
+		vectors = append(vectors, estimateSwapTestVector(ctx, vector, func(ctx sdk.Context) ([]types.SwapHopEstimate, error) {
+			return k.estimateSwapAmountOutHops(ctx, []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: tokenInDenom}}, tokenOut)
+		}))
+	}
+	return vectors, nil
+}
+
+// estimateSwapTestVector fills in the expected outcome of the vector's swap with the single hop estimate,
+// or the error the chain rejects the swap with.
+// The estimate runs in a cache context so that pools updating state on swap estimates write nothing.
+func estimateSwapTestVector(ctx sdk.Context, vector types.SwapTestVector, estimate func(sdk.Context) ([]types.SwapHopEstimate, error)) (result types.SwapTestVector) {
+	result = vector
+	defer func() {
+		if r := recover(); r != nil {
+			result = vector
+			result.Error = fmt.Sprintf("%v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	hops, err := estimate(cacheCtx)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	hop := hops[0]
+	result.TokenIn = hop.TokenIn
+	result.TokenOut = hop.TokenOut
+	result.TakerFeeCharged = hop.TakerFeeCharged
+	result.SpreadFeeCharged = hop.SpreadFeeCharged
+	return result
+}
+
+// swapTestVectorAmounts returns the given amounts, or if none are given, 1 and the fractions of the denom's
+// pool liquidity that are positive, without duplicates.
+func swapTestVectorAmounts(amounts []osmomath.Int, liquidity osmomath.Int) []osmomath.Int {
+	if len(amounts) != 0 {
+		return amounts
+	}
+
+	defaultAmounts := []osmomath.Int{osmomath.OneInt()}
+	for _, divisor := range swapTestVectorLiquidityDivisors {
+		amount := liquidity.QuoRaw(divisor)
+		if amount.GT(defaultAmounts[len(defaultAmounts)-1]) {
+			defaultAmounts = append(defaultAmounts, amount)
+		}
+	}
+	return defaultAmounts
+}
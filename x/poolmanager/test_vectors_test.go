@@ -0,0 +1,71 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestGenerateSwapTestVectors() {
+	s.SetupTest()
+	poolId := s.PrepareBalancerPool()
+	poolDenoms, err := s.App.PoolManagerKeeper.RouteGetPoolDenoms(s.Ctx, poolId)
+	s.Require().NoError(err)
+	numPairs := len(poolDenoms) * (len(poolDenoms) - 1)
+
+	s.Run("given amounts", func() {
+		amounts := []osmomath.Int{osmomath.NewInt(1_000), osmomath.NewInt(1_000_000_000_000)}
+		testVectors, err := s.App.PoolManagerKeeper.GenerateSwapTestVectors(s.Ctx, []uint64{poolId}, amounts)
+		s.Require().NoError(err)
+
+		s.Require().Equal(s.Ctx.BlockHeight(), testVectors.Height)
+		s.Require().Len(testVectors.Pools, 1)
+		s.Require().Equal(poolId, testVectors.Pools[0].PoolId)
+		s.Require().Equal(types.Balancer.String(), testVectors.Pools[0].PoolType)
+		s.Require().Len(testVectors.Vectors, 2*numPairs*len(amounts))
+
+		for _, vector := range testVectors.Vectors {
+			if vector.TokenIn.Amount.Equal(amounts[1]) || vector.TokenOut.Amount.Equal(amounts[1]) {
+				// more than the pool liquidity can't be swapped
+				s.Require().NotEmpty(vector.Error)
+				continue
+			}
+			s.Require().Empty(vector.Error)
+
+			// the vector matches the outcome of executing the swap
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(vector.TokenIn))
+			cacheCtx, _ := s.Ctx.CacheContext()
+			if vector.SwapType == types.SwapTypeExactIn {
+				s.Require().Equal(types.RoundingDown, vector.Rounding)
+				tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(cacheCtx, s.TestAccs[0], []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: vector.TokenOut.Denom}}, vector.TokenIn, osmomath.OneInt())
+				s.Require().NoError(err)
+				s.Require().Equal(vector.TokenOut.Amount, tokenOutAmount)
+			} else {
+				s.Require().Equal(types.RoundingUp, vector.Rounding)
+				tokenInAmount, err := s.App.PoolManagerKeeper.RouteExactAmountOut(cacheCtx, s.TestAccs[0], []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: vector.TokenIn.Denom}}, vector.TokenIn.Amount, vector.TokenOut)
+				s.Require().NoError(err)
+				s.Require().Equal(vector.TokenIn.Amount, tokenInAmount)
+			}
+		}
+	})
+
+	s.Run("default amounts", func() {
+		testVectors, err := s.App.PoolManagerKeeper.GenerateSwapTestVectors(s.Ctx, nil, nil)
+		s.Require().NoError(err)
+
+		s.Require().Len(testVectors.Pools, 1)
+		// 1 and the 4 fractions of the pool liquidity of each denom
+		s.Require().Len(testVectors.Vectors, 2*numPairs*5)
+		for _, vector := range testVectors.Vectors {
+			amount := vector.TokenIn.Amount
+			if vector.SwapType == types.SwapTypeExactOut {
+				amount = vector.TokenOut.Amount
+			}
+			// swaps of a single unit may round to nothing
+			if amount.GT(osmomath.OneInt()) {
+				s.Require().Empty(vector.Error)
+			}
+		}
+	})
+}
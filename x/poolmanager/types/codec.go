@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
@@ -14,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/poolmanager/swap-exact-amount-out", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/poolmanager/split-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/poolmanager/split-amount-out", nil)
+	cdc.RegisterConcrete(&SetPoolMigrationLinksProposal{}, "osmosis/poolmanager/set-pool-migration-links-proposal", nil)
+	cdc.RegisterConcrete(&SetCanonicalRoutesProposal{}, "osmosis/poolmanager/set-canonical-routes-proposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,5 +27,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSplitRouteSwapExactAmountIn{},
 		&MsgSplitRouteSwapExactAmountOut{},
 	)
+	registry.RegisterImplementations(
+		(*govtypesv1.Content)(nil),
+		&SetPoolMigrationLinksProposal{},
+		&SetCanonicalRoutesProposal{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -204,3 +204,98 @@ type InvalidTakerFeeSharePercentageError struct {
 func (e InvalidTakerFeeSharePercentageError) Error() string {
 	return fmt.Sprintf("invalid taker fee share percentage: %s, must be between 0 and 1", e.Percentage)
 }
+
+type InvalidPoolMigrationLinkError struct {
+	LegacyPoolId      uint64
+	ReplacementPoolId uint64
+}
+
+func (e InvalidPoolMigrationLinkError) Error() string {
+	return fmt.Sprintf("invalid pool migration link from pool %d to pool %d", e.LegacyPoolId, e.ReplacementPoolId)
+}
+
+type DuplicatePoolMigrationLinkError struct {
+	LegacyPoolId uint64
+}
+
+func (e DuplicatePoolMigrationLinkError) Error() string {
+	return fmt.Sprintf("pool %d has more than one migration link", e.LegacyPoolId)
+}
+
+type PoolMigrationLinkDenomMismatchError struct {
+	LegacyPoolId      uint64
+	ReplacementPoolId uint64
+	Denom             string
+}
+
+func (e PoolMigrationLinkDenomMismatchError) Error() string {
+	return fmt.Sprintf("replacement pool %d denom %s is not in legacy pool %d", e.ReplacementPoolId, e.Denom, e.LegacyPoolId)
+}
+
+type SpotPriceTwapDeviationError struct {
+	PoolId       uint64
+	SpotPrice    osmomath.BigDec
+	Twap         osmomath.Dec
+	MaxDeviation osmomath.Dec
+}
+
+func (e SpotPriceTwapDeviationError) Error() string {
+	return fmt.Sprintf("spot price (%s) of pool %d deviates from its twap (%s) by more than the max deviation (%s)", e.SpotPrice, e.PoolId, e.Twap, e.MaxDeviation)
+}
+
+type InvalidPoolRouteError struct {
+	PoolId     uint64
+	PoolType   PoolType
+	NextPoolId uint64
+}
+
+func (e InvalidPoolRouteError) Error() string {
+	return fmt.Sprintf("route of pool %d to pool type (%s) is invalid, pool id must be positive and less than next pool id %d and pool type must be defined", e.PoolId, e.PoolType, e.NextPoolId)
+}
+
+type DuplicatePoolRouteError struct {
+	PoolId uint64
+}
+
+func (e DuplicatePoolRouteError) Error() string {
+	return fmt.Sprintf("pool %d has more than one route", e.PoolId)
+}
+
+type InvalidCanonicalRouteError struct {
+	Denom      string
+	QuoteDenom string
+	Reason     string
+}
+
+func (e InvalidCanonicalRouteError) Error() string {
+	return fmt.Sprintf("invalid canonical route from %s to %s: %s", e.Denom, e.QuoteDenom, e.Reason)
+}
+
+type DuplicateCanonicalRouteError struct {
+	Denom      string
+	QuoteDenom string
+}
+
+func (e DuplicateCanonicalRouteError) Error() string {
+	return fmt.Sprintf("denom pair %s/%s has more than one canonical route", e.Denom, e.QuoteDenom)
+}
+
+type CanonicalRouteInsufficientLiquidityError struct {
+	PoolId       uint64
+	Denom        string
+	Liquidity    osmomath.Int
+	MinLiquidity osmomath.Int
+}
+
+func (e CanonicalRouteInsufficientLiquidityError) Error() string {
+	return fmt.Sprintf("pool %d has %s%s liquidity, less than the canonical route minimum of %s", e.PoolId, e.Liquidity, e.Denom, e.MinLiquidity)
+}
+
+type CanonicalRouteNotFoundError struct {
+	Denom      string
+	QuoteDenom string
+}
+
+func (e CanonicalRouteNotFoundError) Error() string {
+	return fmt.Sprintf("no canonical route from %s to %s", e.Denom, e.QuoteDenom)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,6 +28,7 @@ type BankI interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
 // CommunityPoolI defines the contract needed to be fulfilled for distribution keeper.
@@ -97,6 +99,13 @@ type PoolModuleI interface {
 	GetTotalLiquidity(ctx sdk.Context) (sdk.Coins, error)
 }
 
+// PoolModuleGasCostsI is optionally implemented by pool modules to declare the base gas costs
+// of the operations on their pool types, which are used to estimate the gas of swap routes.
+// Pool types whose module does not implement it are estimated with DefaultPoolTypeGasCosts.
+type PoolModuleGasCostsI interface {
+	GetPoolTypeGasCosts(poolType PoolType) PoolTypeGasCosts
+}
+
 type ConcentratedI interface {
 	PoolModuleI
 	GetWhitelistedAddresses(ctx sdk.Context) []string
@@ -123,3 +132,7 @@ type ProtorevKeeper interface {
 type WasmKeeper interface {
 	QuerySmart(ctx context.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
 }
+
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
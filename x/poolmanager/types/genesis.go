@@ -28,5 +28,37 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := validatePoolRoutes(gs.PoolRoutes, gs.NextPoolId); err != nil {
+		return err
+	}
+	for _, link := range gs.PoolMigrationLinks {
+		if link.ReplacementPoolId == 0 {
+			return InvalidPoolMigrationLinkError{LegacyPoolId: link.LegacyPoolId, ReplacementPoolId: link.ReplacementPoolId}
+		}
+	}
+	if err := validatePoolMigrationLinks(gs.PoolMigrationLinks); err != nil {
+		return err
+	}
+	for _, route := range gs.CanonicalRoutes {
+		if len(route.Route) == 0 {
+			return InvalidCanonicalRouteError{Denom: route.Denom, QuoteDenom: route.QuoteDenom, Reason: "route is empty"}
+		}
+	}
+	return validateCanonicalRoutes(gs.CanonicalRoutes)
+}
+
+// validatePoolRoutes returns an error if any of the given routes has a pool id that is zero,
+// not less than nextPoolId or routed more than once, or has an undefined pool type.
+func validatePoolRoutes(routes []ModuleRoute, nextPoolId uint64) error {
+	seenPoolIds := make(map[uint64]struct{}, len(routes))
+	for _, route := range routes {
+		if _, ok := PoolType_name[int32(route.PoolType)]; !ok || route.PoolId == 0 || route.PoolId >= nextPoolId {
+			return InvalidPoolRouteError{PoolId: route.PoolId, PoolType: route.PoolType, NextPoolId: nextPoolId}
+		}
+		if _, ok := seenPoolIds[route.PoolId]; ok {
+			return DuplicatePoolRouteError{PoolId: route.PoolId}
+		}
+		seenPoolIds[route.PoolId] = struct{}{}
+	}
 	return nil
 }
@@ -12,16 +12,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +32,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PoolCreationFeeDestination is where the creation fee of a pool is sent.
+type PoolCreationFeeDestination int32
+
+const (
+	// PoolCreationFeeDestinationCommunityPool funds the community pool with the
+	// fee.
+	PoolCreationFeeDestinationCommunityPool PoolCreationFeeDestination = 0
+	// PoolCreationFeeDestinationBurn burns the fee.
+	PoolCreationFeeDestinationBurn PoolCreationFeeDestination = 1
+)
+
+var PoolCreationFeeDestination_name = map[int32]string{
+	0: "PoolCreationFeeDestinationCommunityPool",
+	1: "PoolCreationFeeDestinationBurn",
+}
+
+var PoolCreationFeeDestination_value = map[string]int32{
+	"PoolCreationFeeDestinationCommunityPool": 0,
+	"PoolCreationFeeDestinationBurn":          1,
+}
+
+func (x PoolCreationFeeDestination) String() string {
+	return proto.EnumName(PoolCreationFeeDestination_name, int32(x))
+}
+
+func (PoolCreationFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{0}
+}
+
 // Params holds parameters for the poolmanager module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
@@ -43,6 +75,23 @@ type Params struct {
 	// orders at prices in terms of token1 (quote asset) that are easy to reason
 	// about.
 	AuthorizedQuoteDenoms []string `protobuf:"bytes,3,rep,name=authorized_quote_denoms,json=authorizedQuoteDenoms,proto3" json:"authorized_quote_denoms,omitempty" yaml:"authorized_quote_denoms"`
+	// route_spot_price_max_twap_deviation is the maximum relative deviation of
+	// the spot price of every hop of a route from its pool's arithmetic TWAP
+	// over route_spot_price_twap_window. Routes with a hop deviating further are
+	// rejected before being executed, protecting users from routing through a
+	// manipulated pool. Zero disables the check.
+	RouteSpotPriceMaxTwapDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=route_spot_price_max_twap_deviation,json=routeSpotPriceMaxTwapDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"route_spot_price_max_twap_deviation" yaml:"route_spot_price_max_twap_deviation"`
+	// route_spot_price_twap_window is the window of the TWAP the spot price of
+	// every hop of a route is checked against.
+	RouteSpotPriceTwapWindow time.Duration `protobuf:"bytes,5,opt,name=route_spot_price_twap_window,json=routeSpotPriceTwapWindow,proto3,stdduration" json:"route_spot_price_twap_window" yaml:"route_spot_price_twap_window"`
+	// canonical_route_min_pool_liquidity is the minimum amount of both the
+	// token in and the token out of a hop that its pool must hold for a
+	// canonical route through it to be registered.
+	CanonicalRouteMinPoolLiquidity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=canonical_route_min_pool_liquidity,json=canonicalRouteMinPoolLiquidity,proto3,customtype=cosmossdk.io/math.Int" json:"canonical_route_min_pool_liquidity" yaml:"canonical_route_min_pool_liquidity"`
+	// pool_type_creation_fees overrides the pool creation fee and its
+	// destination per pool type. Pool types without an override are charged
+	// pool_creation_fee, which funds the community pool.
+	PoolTypeCreationFees []PoolTypeCreationFee `protobuf:"bytes,7,rep,name=pool_type_creation_fees,json=poolTypeCreationFees,proto3" json:"pool_type_creation_fees" yaml:"pool_type_creation_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -99,6 +148,82 @@ func (m *Params) GetAuthorizedQuoteDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetRouteSpotPriceTwapWindow() time.Duration {
+	if m != nil {
+		return m.RouteSpotPriceTwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetPoolTypeCreationFees() []PoolTypeCreationFee {
+	if m != nil {
+		return m.PoolTypeCreationFees
+	}
+	return nil
+}
+
+// PoolTypeCreationFee is the fee charged for creating a pool of a pool type,
+// along with where it is sent.
+type PoolTypeCreationFee struct {
+	PoolType    PoolType                                 `protobuf:"varint,1,opt,name=pool_type,json=poolType,proto3,enum=osmosis.poolmanager.v1beta1.PoolType" json:"pool_type,omitempty" yaml:"pool_type"`
+	Fee         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee" yaml:"fee"`
+	Destination PoolCreationFeeDestination               `protobuf:"varint,3,opt,name=destination,proto3,enum=osmosis.poolmanager.v1beta1.PoolCreationFeeDestination" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *PoolTypeCreationFee) Reset()         { *m = PoolTypeCreationFee{} }
+func (m *PoolTypeCreationFee) String() string { return proto.CompactTextString(m) }
+func (*PoolTypeCreationFee) ProtoMessage()    {}
+func (*PoolTypeCreationFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{1}
+}
+func (m *PoolTypeCreationFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTypeCreationFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTypeCreationFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTypeCreationFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTypeCreationFee.Merge(m, src)
+}
+func (m *PoolTypeCreationFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTypeCreationFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTypeCreationFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTypeCreationFee proto.InternalMessageInfo
+
+func (m *PoolTypeCreationFee) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return Balancer
+}
+
+func (m *PoolTypeCreationFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *PoolTypeCreationFee) GetDestination() PoolCreationFeeDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return PoolCreationFeeDestinationCommunityPool
+}
+
 // GenesisState defines the poolmanager module's genesis state.
 type GenesisState struct {
 	// the next_pool_id
@@ -111,13 +236,15 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	PoolMigrationLinks     []PoolMigrationLink `protobuf:"bytes,7,rep,name=pool_migration_links,json=poolMigrationLinks,proto3" json:"pool_migration_links"`
+	CanonicalRoutes        []CanonicalRoute    `protobuf:"bytes,8,rep,name=canonical_routes,json=canonicalRoutes,proto3" json:"canonical_routes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{1}
+	return fileDescriptor_aa099d9fbdf68b35, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,6 +315,20 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetPoolMigrationLinks() []PoolMigrationLink {
+	if m != nil {
+		return m.PoolMigrationLinks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCanonicalRoutes() []CanonicalRoute {
+	if m != nil {
+		return m.CanonicalRoutes
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -233,7 +374,7 @@ func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
 func (m *TakerFeeParams) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeParams) ProtoMessage()    {}
 func (*TakerFeeParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{2}
+	return fileDescriptor_aa099d9fbdf68b35, []int{3}
 }
 func (m *TakerFeeParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +449,7 @@ func (m *TakerFeeDistributionPercentage) Reset()         { *m = TakerFeeDistribu
 func (m *TakerFeeDistributionPercentage) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeDistributionPercentage) ProtoMessage()    {}
 func (*TakerFeeDistributionPercentage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{3}
+	return fileDescriptor_aa099d9fbdf68b35, []int{4}
 }
 func (m *TakerFeeDistributionPercentage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +488,7 @@ func (m *TakerFeesTracker) Reset()         { *m = TakerFeesTracker{} }
 func (m *TakerFeesTracker) String() string { return proto.CompactTextString(m) }
 func (*TakerFeesTracker) ProtoMessage()    {}
 func (*TakerFeesTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+	return fileDescriptor_aa099d9fbdf68b35, []int{5}
 }
 func (m *TakerFeesTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +551,7 @@ func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
 func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
 func (*PoolVolume) ProtoMessage()    {}
 func (*PoolVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
 }
 func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,13 +594,139 @@ func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// PoolMigrationLink links a legacy gamm pool that has been migrated to its
+// replacement concentrated liquidity pool. Spot price, TWAP and route queries
+// against the legacy pool are transparently served by the replacement pool.
+type PoolMigrationLink struct {
+	// legacy_pool_id is the id of the migrated gamm pool.
+	LegacyPoolId uint64 `protobuf:"varint,1,opt,name=legacy_pool_id,json=legacyPoolId,proto3" json:"legacy_pool_id,omitempty" yaml:"legacy_pool_id"`
+	// replacement_pool_id is the id of the concentrated liquidity pool
+	// replacing the legacy pool.
+	ReplacementPoolId uint64 `protobuf:"varint,2,opt,name=replacement_pool_id,json=replacementPoolId,proto3" json:"replacement_pool_id,omitempty" yaml:"replacement_pool_id"`
+}
+
+func (m *PoolMigrationLink) Reset()         { *m = PoolMigrationLink{} }
+func (m *PoolMigrationLink) String() string { return proto.CompactTextString(m) }
+func (*PoolMigrationLink) ProtoMessage()    {}
+func (*PoolMigrationLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{7}
+}
+func (m *PoolMigrationLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolMigrationLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolMigrationLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolMigrationLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolMigrationLink.Merge(m, src)
+}
+func (m *PoolMigrationLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolMigrationLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolMigrationLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolMigrationLink proto.InternalMessageInfo
+
+func (m *PoolMigrationLink) GetLegacyPoolId() uint64 {
+	if m != nil {
+		return m.LegacyPoolId
+	}
+	return 0
+}
+
+func (m *PoolMigrationLink) GetReplacementPoolId() uint64 {
+	if m != nil {
+		return m.ReplacementPoolId
+	}
+	return 0
+}
+
+// CanonicalRoute is the governance-registered route pricing a denom in a quote
+// denom. It is used by txfees to price fee tokens, by TWAP-based pricing and by
+// protorev to convert profits, instead of a single pool.
+type CanonicalRoute struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// route swaps denom for quote_denom.
+	Route []SwapAmountInRoute `protobuf:"bytes,3,rep,name=route,proto3" json:"route" yaml:"route"`
+}
+
+func (m *CanonicalRoute) Reset()         { *m = CanonicalRoute{} }
+func (m *CanonicalRoute) String() string { return proto.CompactTextString(m) }
+func (*CanonicalRoute) ProtoMessage()    {}
+func (*CanonicalRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{8}
+}
+func (m *CanonicalRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalRoute.Merge(m, src)
+}
+func (m *CanonicalRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalRoute proto.InternalMessageInfo
+
+func (m *CanonicalRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CanonicalRoute) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *CanonicalRoute) GetRoute() []SwapAmountInRoute {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.poolmanager.v1beta1.PoolCreationFeeDestination", PoolCreationFeeDestination_name, PoolCreationFeeDestination_value)
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
+	proto.RegisterType((*PoolTypeCreationFee)(nil), "osmosis.poolmanager.v1beta1.PoolTypeCreationFee")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
 	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
+	proto.RegisterType((*PoolMigrationLink)(nil), "osmosis.poolmanager.v1beta1.PoolMigrationLink")
+	proto.RegisterType((*CanonicalRoute)(nil), "osmosis.poolmanager.v1beta1.CanonicalRoute")
 }
 
 func init() {
@@ -467,75 +734,109 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1081 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
-	0x18, 0x8e, 0xbb, 0xe9, 0xa2, 0x4c, 0x42, 0x92, 0x0e, 0xa4, 0x71, 0x93, 0xb2, 0x5e, 0xb9, 0x95,
-	0x58, 0x84, 0xe2, 0xa5, 0x41, 0x0a, 0x12, 0xd0, 0x43, 0x36, 0x51, 0x10, 0xa8, 0xb4, 0xa9, 0x13,
-	0x81, 0x54, 0x0e, 0xa3, 0x59, 0xfb, 0x8d, 0xd7, 0x5a, 0xdb, 0xb3, 0xcc, 0x8c, 0xf3, 0xc1, 0x5f,
-	0xe0, 0x82, 0xd4, 0x2b, 0x67, 0x0e, 0xdc, 0x90, 0xf8, 0x11, 0x3d, 0xf6, 0x88, 0x38, 0x6c, 0x51,
-	0x72, 0xe6, 0xb2, 0xbf, 0x00, 0x79, 0x66, 0xf6, 0xb3, 0xc9, 0x36, 0xc0, 0x69, 0xd7, 0xef, 0xfb,
-	0x3e, 0x8f, 0x9f, 0xf7, 0x6b, 0xc6, 0xe8, 0x03, 0x26, 0x52, 0x26, 0x62, 0x51, 0xef, 0x30, 0x96,
-	0xa4, 0x34, 0xa3, 0x11, 0xf0, 0xfa, 0xf1, 0x83, 0x26, 0x48, 0xfa, 0xa0, 0x1e, 0x41, 0x06, 0x22,
-	0x16, 0x5e, 0x87, 0x33, 0xc9, 0xf0, 0xba, 0x09, 0xf5, 0x46, 0x42, 0x3d, 0x13, 0xba, 0xf6, 0x6e,
-	0xc4, 0x22, 0xa6, 0xe2, 0xea, 0xc5, 0x3f, 0x0d, 0x59, 0xbb, 0x13, 0x31, 0x16, 0x25, 0x50, 0x57,
-	0x4f, 0xcd, 0xfc, 0xa8, 0x4e, 0xb3, 0xb3, 0xbe, 0x2b, 0x50, 0x74, 0x44, 0x63, 0xf4, 0x83, 0x71,
-	0x55, 0x26, 0x51, 0x61, 0xce, 0xa9, 0x8c, 0x59, 0xd6, 0xf7, 0xeb, 0xe8, 0x7a, 0x93, 0x0a, 0x18,
-	0x68, 0x0d, 0x58, 0xdc, 0xf7, 0x7b, 0xd3, 0x72, 0x4a, 0x59, 0x98, 0x27, 0x40, 0x38, 0xcb, 0x25,
-	0x98, 0xf8, 0xfb, 0xd3, 0xe2, 0xe5, 0xa9, 0x8e, 0x72, 0x7b, 0x37, 0x50, 0x79, 0x9f, 0x72, 0x9a,
-	0x0a, 0xfc, 0xdc, 0x42, 0xb7, 0x8a, 0x58, 0x12, 0x70, 0x50, 0xc2, 0xc8, 0x11, 0x80, 0x6d, 0x55,
-	0x4b, 0xb5, 0xf9, 0xcd, 0x3b, 0x9e, 0xc9, 0xa5, 0x50, 0xd7, 0x2f, 0x8f, 0xb7, 0xc3, 0xe2, 0xac,
-	0xf1, 0xe8, 0x45, 0xd7, 0x99, 0xe9, 0x75, 0x1d, 0xfb, 0x8c, 0xa6, 0xc9, 0xa7, 0xee, 0x6b, 0x0c,
-	0xee, 0xaf, 0xaf, 0x9c, 0x5a, 0x14, 0xcb, 0x56, 0xde, 0xf4, 0x02, 0x96, 0x9a, 0xa2, 0x98, 0x9f,
-	0x0d, 0x11, 0xb6, 0xeb, 0xf2, 0xac, 0x03, 0x42, 0x91, 0x09, 0x7f, 0xa9, 0xc0, 0xef, 0x18, 0xf8,
-	0x1e, 0x00, 0x3e, 0x46, 0xcb, 0x92, 0xb6, 0x81, 0x17, 0x54, 0xa4, 0xa3, 0x94, 0xda, 0x37, 0xaa,
-	0x56, 0x6d, 0x7e, 0xf3, 0x43, 0x6f, 0x4a, 0xeb, 0xbc, 0xc3, 0x02, 0xb4, 0x07, 0xa0, 0x93, 0x6b,
-	0x38, 0x46, 0xe5, 0xaa, 0x56, 0x39, 0x49, 0xe9, 0xfa, 0x8b, 0x72, 0x0c, 0x80, 0x9f, 0xa1, 0x55,
-	0x9a, 0xcb, 0x16, 0xe3, 0xf1, 0x0f, 0x10, 0x92, 0xef, 0x73, 0x26, 0x81, 0x84, 0x90, 0xb1, 0x54,
-	0xd8, 0xa5, 0x6a, 0xa9, 0x36, 0xd7, 0x70, 0x7b, 0x5d, 0xa7, 0xa2, 0xd9, 0xae, 0x08, 0x74, 0xfd,
-	0x95, 0xa1, 0xe7, 0x69, 0xe1, 0xd8, 0xd5, 0xf6, 0x57, 0x25, 0xb4, 0xf0, 0x85, 0x9e, 0xc2, 0x03,
-	0x49, 0x25, 0xe0, 0x2a, 0x5a, 0xc8, 0xe0, 0x54, 0x12, 0x55, 0xbc, 0x38, 0xb4, 0xad, 0xaa, 0x55,
-	0x9b, 0xf5, 0x51, 0x61, 0xdb, 0x67, 0x2c, 0xf9, 0x32, 0xc4, 0xdb, 0xa8, 0x3c, 0x96, 0xfc, 0xbd,
-	0xa9, 0xc9, 0x9b, 0xa4, 0x67, 0x8b, 0xa4, 0x7d, 0x03, 0xc4, 0x4f, 0xd0, 0xbc, 0xe2, 0x57, 0x43,
-	0xa2, 0xb3, 0x98, 0xdf, 0xac, 0x4d, 0xe5, 0xf9, 0x5a, 0x8d, 0x95, 0x5f, 0x00, 0x0c, 0x19, 0x2a,
-	0xc2, 0x94, 0x41, 0xe0, 0xef, 0x10, 0x1e, 0xd4, 0x51, 0x10, 0xc9, 0x69, 0xd0, 0x06, 0x6e, 0xcf,
-	0x2a, 0x7d, 0x1b, 0xd7, 0x6a, 0x8e, 0x38, 0xd4, 0x20, 0x7f, 0x59, 0x4e, 0x58, 0xf0, 0x57, 0x68,
-	0x41, 0xa9, 0x3d, 0x66, 0x49, 0x9e, 0x82, 0xb0, 0x6f, 0x2a, 0xb9, 0xef, 0x4f, 0x4f, 0x9b, 0xb1,
-	0xe4, 0x1b, 0x15, 0xef, 0xab, 0x54, 0xf5, 0x7f, 0x81, 0x3b, 0x68, 0x4d, 0x75, 0x84, 0x74, 0x68,
-	0xcc, 0xc9, 0xb0, 0xf7, 0x42, 0x32, 0x0e, 0x76, 0x59, 0x31, 0x7b, 0x53, 0x99, 0x55, 0xe3, 0xf6,
-	0x69, 0xcc, 0xfb, 0xca, 0x4d, 0x39, 0x6e, 0x87, 0x93, 0x8e, 0x83, 0x82, 0xd3, 0xfd, 0xb1, 0x8c,
-	0x16, 0xc7, 0x27, 0x10, 0x37, 0xd1, 0xad, 0x10, 0x8e, 0x68, 0x9e, 0xc8, 0xa1, 0x02, 0xd5, 0xe8,
-	0xb9, 0xc6, 0x56, 0xc1, 0xf5, 0x67, 0xd7, 0x59, 0xd7, 0x4b, 0x21, 0xc2, 0xb6, 0x17, 0xb3, 0x7a,
-	0x4a, 0x65, 0xcb, 0x7b, 0x04, 0x11, 0x0d, 0xce, 0x76, 0x21, 0x38, 0xef, 0x3a, 0x4b, 0xbb, 0x1a,
-	0xdf, 0x27, 0xf6, 0x97, 0xc2, 0x71, 0x03, 0xfe, 0xd9, 0x42, 0xea, 0x3c, 0x1b, 0xc9, 0x31, 0x8c,
-	0x85, 0xe4, 0x71, 0x33, 0x2f, 0xf6, 0xc9, 0xcc, 0xce, 0x67, 0xd7, 0xea, 0xcd, 0xee, 0x08, 0x70,
-	0x1f, 0x78, 0x00, 0x99, 0xa4, 0x11, 0x34, 0xaa, 0x85, 0xd6, 0xf3, 0xae, 0x63, 0x3f, 0x11, 0x29,
-	0xbb, 0x2c, 0xd6, 0xb7, 0xd9, 0x15, 0x1e, 0xfc, 0x8b, 0x85, 0x9c, 0x8c, 0x65, 0x64, 0x9a, 0xc4,
-	0xd2, 0xff, 0x97, 0x78, 0xcf, 0x48, 0x5c, 0x7f, 0xcc, 0xb2, 0x2b, 0x55, 0xae, 0x67, 0x57, 0x3b,
-	0xf1, 0x0e, 0x5a, 0xa2, 0x61, 0x1a, 0x67, 0x84, 0x86, 0x21, 0x07, 0x21, 0x40, 0xd8, 0xb3, 0x6a,
-	0xe9, 0xd7, 0x7a, 0x5d, 0xe7, 0xb6, 0x59, 0xfa, 0xf1, 0x00, 0xd7, 0x5f, 0x54, 0x96, 0xed, 0xbe,
-	0x01, 0xff, 0x66, 0xa1, 0xad, 0x80, 0xa5, 0x69, 0x9e, 0xc5, 0xf2, 0x4c, 0xaf, 0xb6, 0x9e, 0x42,
-	0xc9, 0x88, 0x38, 0xa1, 0x1d, 0x52, 0x94, 0xe2, 0xa4, 0x15, 0x4b, 0x48, 0x62, 0x21, 0x21, 0x24,
-	0x54, 0x08, 0x90, 0x82, 0x48, 0x66, 0xdf, 0x54, 0x63, 0xb1, 0xdd, 0xeb, 0x3a, 0x0f, 0xf5, 0xcb,
-	0xfe, 0x1b, 0x8f, 0xeb, 0x7b, 0x03, 0x60, 0xb1, 0x1b, 0x6a, 0x8a, 0x0f, 0xd9, 0xc1, 0x09, 0xed,
-	0x3c, 0x66, 0xd9, 0xb7, 0x43, 0xc8, 0xb6, 0x42, 0x1c, 0x32, 0x7c, 0x88, 0x56, 0x38, 0x84, 0x79,
-	0x00, 0xa1, 0xea, 0xcc, 0x80, 0x55, 0x2d, 0xc9, 0x5c, 0xa3, 0xda, 0xeb, 0x3a, 0x77, 0xb5, 0xa2,
-	0x4b, 0xc3, 0x5c, 0xff, 0x1d, 0x63, 0xdf, 0x03, 0x18, 0xf0, 0xbb, 0x7f, 0x5b, 0xa8, 0x32, 0xbd,
-	0x67, 0xf8, 0x08, 0x2d, 0x09, 0x49, 0xdb, 0x71, 0x16, 0x11, 0x0e, 0x27, 0x94, 0x87, 0xc2, 0xec,
-	0xc6, 0xc3, 0x6b, 0xec, 0xc6, 0xb0, 0x29, 0x13, 0x1c, 0xae, 0xbf, 0x68, 0x2c, 0xbe, 0x36, 0xe0,
-	0x00, 0x2d, 0x8e, 0xd7, 0x52, 0xed, 0xc4, 0x5c, 0xe3, 0xf3, 0xeb, 0xbd, 0x66, 0xe5, 0xb2, 0x76,
-	0xb8, 0xfe, 0xdb, 0x63, 0x65, 0x76, 0x7f, 0xbf, 0x81, 0x96, 0x27, 0x8f, 0x38, 0xec, 0xa3, 0x95,
-	0xd1, 0xd3, 0x92, 0x11, 0xa1, 0x1e, 0xc5, 0x9b, 0x6f, 0x58, 0x7d, 0xd4, 0xe0, 0xe1, 0x11, 0xc9,
-	0x0e, 0x34, 0x14, 0x13, 0x74, 0x77, 0x9c, 0xf3, 0xb5, 0xdc, 0xae, 0x45, 0x6d, 0x8f, 0x50, 0xef,
-	0x8c, 0x66, 0x82, 0xdb, 0xe8, 0xbd, 0x16, 0xc4, 0x51, 0x4b, 0x12, 0x1a, 0x04, 0x2c, 0xcf, 0x64,
-	0x51, 0x5c, 0x21, 0x29, 0x97, 0x82, 0x1c, 0x71, 0x96, 0xaa, 0x75, 0x2d, 0x35, 0x6a, 0xbd, 0xae,
-	0x73, 0x5f, 0x97, 0x66, 0x6a, 0xb8, 0xeb, 0xaf, 0x69, 0xff, 0xf6, 0xc0, 0x7d, 0xa0, 0xbc, 0x7b,
-	0x85, 0xf3, 0xb9, 0x85, 0xd0, 0xf0, 0x08, 0xc7, 0xab, 0xe8, 0xad, 0xf1, 0xfb, 0xb0, 0xdc, 0xd1,
-	0x77, 0x61, 0x62, 0x2e, 0x32, 0x7d, 0x35, 0xbc, 0x39, 0xc9, 0x8f, 0x8a, 0x24, 0xff, 0xd5, 0x57,
-	0x08, 0x1a, 0xde, 0x1e, 0x8d, 0xa7, 0x2f, 0xce, 0x2b, 0xd6, 0xcb, 0xf3, 0x8a, 0xf5, 0xd7, 0x79,
-	0xc5, 0xfa, 0xe9, 0xa2, 0x32, 0xf3, 0xf2, 0xa2, 0x32, 0xf3, 0xc7, 0x45, 0x65, 0xe6, 0xd9, 0x27,
-	0x23, 0x7c, 0xe6, 0xb8, 0xda, 0x48, 0x68, 0x53, 0xf4, 0x1f, 0xea, 0xc7, 0x9b, 0x5b, 0xf5, 0xd3,
-	0xb1, 0xef, 0x2f, 0xf5, 0x92, 0x66, 0x59, 0x7d, 0x7b, 0x7d, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x22, 0x45, 0x06, 0x9b, 0xa7, 0x0a, 0x00, 0x00,
+	// 1630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xc7, 0x49, 0x66, 0x53, 0x0e, 0x8e, 0x53, 0x93, 0x4c, 0x3a, 0xce, 0xe0, 0xb6, 0x3a,
+	0xcb, 0xae, 0x77, 0x97, 0x69, 0xef, 0x06, 0x69, 0x46, 0x02, 0x16, 0x94, 0x4e, 0x14, 0x34, 0xab,
+	0x64, 0x36, 0xd3, 0x89, 0x58, 0x34, 0x20, 0xb5, 0xca, 0xdd, 0x15, 0xa7, 0x65, 0x77, 0x57, 0xa7,
+	0xab, 0x9c, 0x3f, 0x7c, 0x02, 0x04, 0x07, 0x90, 0x46, 0x42, 0x1c, 0x10, 0x17, 0xfe, 0x1c, 0xb8,
+	0x21, 0xf1, 0x21, 0x46, 0x42, 0x88, 0x39, 0x22, 0x0e, 0x3d, 0x28, 0x73, 0xe6, 0xe2, 0x4f, 0x80,
+	0xba, 0xaa, 0xda, 0xee, 0xb6, 0x13, 0xdb, 0x03, 0xa7, 0xc4, 0xf5, 0x7e, 0xef, 0x57, 0xef, 0xbd,
+	0x7a, 0xff, 0x1a, 0x7c, 0x44, 0xa8, 0x4f, 0xa8, 0x47, 0x1b, 0x21, 0x21, 0x1d, 0x1f, 0x05, 0xa8,
+	0x85, 0xa3, 0xc6, 0xc5, 0x67, 0x4d, 0xcc, 0xd0, 0x67, 0x8d, 0x16, 0x0e, 0x30, 0xf5, 0xa8, 0x11,
+	0x46, 0x84, 0x11, 0xb8, 0x29, 0xa1, 0x46, 0x06, 0x6a, 0x48, 0x68, 0x65, 0xb5, 0x45, 0x5a, 0x84,
+	0xe3, 0x1a, 0xc9, 0x7f, 0x42, 0xa5, 0xb2, 0xd1, 0x22, 0xa4, 0xd5, 0xc1, 0x0d, 0xfe, 0xab, 0xd9,
+	0x3d, 0x6d, 0xa0, 0xe0, 0x3a, 0x15, 0x39, 0x9c, 0xce, 0x16, 0x3a, 0xe2, 0x87, 0x14, 0x55, 0x87,
+	0xb5, 0xdc, 0x6e, 0x84, 0x98, 0x47, 0x82, 0x54, 0x2e, 0xd0, 0x8d, 0x26, 0xa2, 0xb8, 0x6f, 0xab,
+	0x43, 0xbc, 0x54, 0x6e, 0x8c, 0xf3, 0xc9, 0x27, 0x6e, 0xb7, 0x83, 0xed, 0x88, 0x74, 0x19, 0x96,
+	0xf8, 0x6f, 0x8e, 0xc3, 0xd3, 0x4b, 0x14, 0xe6, 0xd0, 0xef, 0x8f, 0x43, 0xb3, 0x2b, 0x81, 0xd2,
+	0xff, 0x76, 0x0f, 0x2c, 0x1c, 0xa1, 0x08, 0xf9, 0x14, 0xbe, 0x54, 0xc0, 0x4a, 0x82, 0xb5, 0x9d,
+	0x08, 0x73, 0x37, 0xec, 0x53, 0x8c, 0x55, 0xa5, 0x56, 0xa8, 0x17, 0xb7, 0x37, 0x0c, 0xe9, 0x79,
+	0xe2, 0x4b, 0x1a, 0x4c, 0x63, 0x97, 0x78, 0x81, 0x79, 0xf0, 0x2a, 0xd6, 0x66, 0x7a, 0xb1, 0xa6,
+	0x5e, 0x23, 0xbf, 0xf3, 0x6d, 0x7d, 0x84, 0x41, 0xff, 0xf3, 0x1b, 0xad, 0xde, 0xf2, 0xd8, 0x59,
+	0xb7, 0x69, 0x38, 0xc4, 0x97, 0x21, 0x94, 0x7f, 0x1e, 0x51, 0xb7, 0xdd, 0x60, 0xd7, 0x21, 0xa6,
+	0x9c, 0x8c, 0x5a, 0xcb, 0x89, 0xfe, 0xae, 0x54, 0xdf, 0xc7, 0x18, 0x5e, 0x80, 0x32, 0x43, 0x6d,
+	0x1c, 0x25, 0x54, 0x76, 0xc8, 0x2d, 0x55, 0x67, 0x6b, 0x4a, 0xbd, 0xb8, 0xfd, 0x89, 0x31, 0xe6,
+	0xa1, 0x8d, 0x93, 0x44, 0x69, 0x1f, 0x63, 0xe1, 0x9c, 0xa9, 0x49, 0x2b, 0xd7, 0x85, 0x95, 0xc3,
+	0x94, 0xba, 0x55, 0x62, 0x39, 0x05, 0xf8, 0x02, 0xac, 0xa3, 0x2e, 0x3b, 0x23, 0x91, 0xf7, 0x53,
+	0xec, 0xda, 0xe7, 0x5d, 0xc2, 0xb0, 0xed, 0xe2, 0x80, 0xf8, 0x54, 0x2d, 0xd4, 0x0a, 0xf5, 0x45,
+	0x53, 0xef, 0xc5, 0x5a, 0x55, 0xb0, 0xdd, 0x01, 0xd4, 0xad, 0xb5, 0x81, 0xe4, 0x79, 0x22, 0xd8,
+	0xe3, 0xe7, 0xf0, 0x77, 0x0a, 0xd8, 0xe2, 0x4f, 0x65, 0xd3, 0x90, 0x30, 0x3b, 0x8c, 0x3c, 0x07,
+	0xdb, 0x3e, 0xba, 0xb2, 0x59, 0xf2, 0x88, 0x2e, 0xbe, 0xf0, 0xb8, 0xfb, 0xea, 0x5c, 0x4d, 0xa9,
+	0x2f, 0x9a, 0xcf, 0x13, 0xd3, 0xff, 0x15, 0x6b, 0x9b, 0x22, 0x64, 0xd4, 0x6d, 0x1b, 0x1e, 0x69,
+	0xf8, 0x88, 0x9d, 0x19, 0x07, 0xb8, 0x85, 0x9c, 0xeb, 0x3d, 0xec, 0xf4, 0x62, 0xed, 0x63, 0x61,
+	0xcb, 0x14, 0xbc, 0xba, 0x55, 0xe5, 0xa8, 0xe3, 0x90, 0xb0, 0xa3, 0x04, 0x73, 0x88, 0xae, 0x4e,
+	0x2e, 0x51, 0xb8, 0x97, 0x02, 0xe0, 0xcf, 0x15, 0xf0, 0x70, 0x84, 0x88, 0x93, 0x5c, 0x7a, 0x81,
+	0x4b, 0x2e, 0xd5, 0x79, 0xfe, 0x02, 0x1b, 0x86, 0xa8, 0x00, 0x23, 0xad, 0x00, 0x63, 0x4f, 0x56,
+	0x80, 0xd9, 0x90, 0xf1, 0xde, 0xba, 0xc3, 0xaa, 0x0c, 0x99, 0xfe, 0x9b, 0x37, 0x9a, 0x62, 0xa9,
+	0x79, 0x93, 0x12, 0x7b, 0xbe, 0xe2, 0x62, 0xf8, 0x6b, 0x05, 0xe8, 0x0e, 0x0a, 0x48, 0xe0, 0x39,
+	0xa8, 0x23, 0x52, 0xdc, 0xf6, 0xbd, 0xc0, 0xe6, 0x99, 0xd6, 0xf1, 0xce, 0xbb, 0x9e, 0xeb, 0xb1,
+	0x6b, 0x75, 0x81, 0x07, 0xeb, 0x0b, 0x19, 0xac, 0xb5, 0xd1, 0x60, 0x3d, 0x0d, 0x58, 0x2f, 0xd6,
+	0x3e, 0x12, 0x06, 0x4d, 0x26, 0xd4, 0xad, 0x6a, 0x1f, 0x64, 0x25, 0x98, 0x43, 0x2f, 0x38, 0x22,
+	0xa4, 0x73, 0x90, 0x02, 0xe0, 0x2f, 0x15, 0xb0, 0xce, 0x75, 0x92, 0x04, 0xce, 0xe5, 0x3c, 0x55,
+	0xef, 0xf1, 0xb2, 0xf9, 0x74, 0x6c, 0x8a, 0x26, 0x6c, 0x27, 0xd7, 0x21, 0xce, 0xa4, 0xbb, 0xf9,
+	0x81, 0x8c, 0x5b, 0x35, 0x53, 0x4d, 0xa3, 0xf4, 0xba, 0xb5, 0x1a, 0x8e, 0x2a, 0x53, 0xfd, 0x1f,
+	0xb3, 0xe0, 0xfe, 0x2d, 0xac, 0xf0, 0x47, 0x60, 0xb1, 0xcf, 0xa4, 0x2a, 0x35, 0xa5, 0x5e, 0xda,
+	0xfe, 0xc6, 0x54, 0xa6, 0x99, 0xab, 0xbd, 0x58, 0x2b, 0x0f, 0xd9, 0xa2, 0x5b, 0xef, 0xa5, 0xb7,
+	0xc3, 0x36, 0x28, 0x24, 0x5d, 0x62, 0x76, 0x52, 0x97, 0xf8, 0x9e, 0xf4, 0x0b, 0x08, 0xae, 0x77,
+	0xee, 0x0b, 0xc9, 0x2d, 0xf0, 0x1c, 0x14, 0x5d, 0x4c, 0x99, 0x17, 0x88, 0xf2, 0x28, 0x70, 0x47,
+	0x9e, 0x4c, 0x74, 0x24, 0x13, 0x89, 0xbd, 0x81, 0xba, 0xf9, 0xa0, 0x17, 0x6b, 0x50, 0x98, 0x93,
+	0x61, 0xd5, 0xad, 0xec, 0x1d, 0xfa, 0x1f, 0xe7, 0xc1, 0xd2, 0x0f, 0xc4, 0x78, 0x39, 0x66, 0x88,
+	0x61, 0x58, 0x03, 0x4b, 0x01, 0xbe, 0x62, 0x22, 0x59, 0x3c, 0x97, 0x47, 0x73, 0xce, 0x02, 0xc9,
+	0x59, 0x72, 0xd7, 0x53, 0x17, 0xee, 0x80, 0x85, 0x5c, 0x9f, 0xda, 0x1a, 0x6f, 0xa0, 0xe8, 0x4f,
+	0x73, 0x49, 0x7c, 0x2c, 0xa9, 0x08, 0xbf, 0x04, 0x45, 0xce, 0xcf, 0x73, 0x53, 0x34, 0x9c, 0xe2,
+	0x76, 0x7d, 0x2c, 0xcf, 0x21, 0x9f, 0x17, 0x3c, 0x51, 0x25, 0x19, 0x48, 0x60, 0xfc, 0x80, 0xc2,
+	0x1f, 0x03, 0xd8, 0x6f, 0x79, 0xd4, 0x66, 0x11, 0x72, 0xda, 0x38, 0xe2, 0xfd, 0xa5, 0xb8, 0xfd,
+	0x68, 0xaa, 0x3e, 0x4a, 0x4f, 0x84, 0x92, 0x55, 0x66, 0x43, 0x27, 0xf0, 0x0b, 0xb0, 0xc4, 0xad,
+	0xbd, 0x20, 0x9d, 0xae, 0x8f, 0xa9, 0x3a, 0xcf, 0xcd, 0xfd, 0x70, 0xe2, 0xbb, 0xfc, 0x90, 0xe3,
+	0xad, 0x62, 0xd8, 0xff, 0x9f, 0xc2, 0x10, 0x54, 0x78, 0xf3, 0xb4, 0x43, 0xe4, 0x45, 0xf6, 0xa0,
+	0x4d, 0x53, 0x46, 0x22, 0xac, 0x2e, 0x70, 0x66, 0x63, 0x2c, 0x33, 0xef, 0xb1, 0x47, 0xc8, 0x8b,
+	0x52, 0xcb, 0x65, 0x38, 0x1e, 0xb8, 0xc3, 0x82, 0xe3, 0x84, 0x13, 0x9e, 0x02, 0x5e, 0x4b, 0xb6,
+	0xef, 0xb5, 0x44, 0xef, 0xb2, 0x3b, 0x5e, 0xd0, 0x4e, 0x2b, 0xd8, 0x98, 0xe8, 0xc5, 0x61, 0xaa,
+	0x77, 0xe0, 0x05, 0x6d, 0x79, 0x17, 0x0c, 0x87, 0x05, 0x14, 0xfe, 0x04, 0x94, 0x87, 0x9a, 0x0e,
+	0x55, 0xdf, 0xab, 0x15, 0x26, 0x0e, 0xb2, 0xdd, 0x5c, 0x13, 0x92, 0x17, 0x2c, 0xe7, 0x5b, 0x13,
+	0xd5, 0x7f, 0xb1, 0x00, 0x4a, 0xf9, 0x91, 0x07, 0x9b, 0x60, 0xc5, 0xc5, 0xa7, 0xa8, 0xdb, 0x61,
+	0x83, 0x38, 0xf2, 0x74, 0x5d, 0x34, 0x1f, 0x4f, 0x31, 0x52, 0x6e, 0x62, 0x6d, 0x79, 0x4f, 0xe8,
+	0xa7, 0xc4, 0xd6, 0xb2, 0x9b, 0x3f, 0x80, 0xbf, 0x55, 0x00, 0x5f, 0xb7, 0x32, 0x2f, 0xe5, 0x7a,
+	0x94, 0x45, 0x5e, 0xb3, 0xcb, 0x4b, 0x54, 0x54, 0xc0, 0x77, 0xa6, 0xca, 0xb0, 0xbd, 0x8c, 0xe2,
+	0x11, 0x8e, 0x1c, 0x1c, 0x30, 0xd4, 0xc2, 0x66, 0x2d, 0xb1, 0xf5, 0x26, 0xd6, 0xd4, 0x2f, 0xa9,
+	0x4f, 0x6e, 0xc3, 0x5a, 0x2a, 0xb9, 0x43, 0x02, 0xff, 0xa4, 0x00, 0x2d, 0x20, 0x81, 0x3d, 0xce,
+	0xc4, 0xc2, 0xff, 0x6f, 0xe2, 0x96, 0x34, 0x71, 0xf3, 0x19, 0x09, 0xee, 0xb4, 0x72, 0x33, 0xb8,
+	0x5b, 0x08, 0x77, 0xc1, 0x32, 0x72, 0x93, 0x19, 0x84, 0x5c, 0x37, 0xc2, 0x94, 0x62, 0xaa, 0xce,
+	0xf1, 0x2d, 0xa3, 0xd2, 0x8b, 0xb5, 0x07, 0x72, 0xcb, 0xc8, 0x03, 0x74, 0xab, 0xc4, 0x4f, 0x76,
+	0xd2, 0x03, 0xf8, 0x17, 0x05, 0x3c, 0x76, 0x88, 0xef, 0x77, 0x03, 0x8f, 0x5d, 0x8b, 0x06, 0x25,
+	0x6a, 0x89, 0x11, 0x9b, 0xaf, 0x87, 0x49, 0x28, 0x2e, 0xcf, 0x3c, 0x86, 0x3b, 0x1e, 0x65, 0xd8,
+	0xb5, 0x11, 0xa5, 0x98, 0x51, 0x9b, 0x11, 0x3e, 0xcf, 0x17, 0xcd, 0x9d, 0x5e, 0xac, 0x7d, 0x2e,
+	0xe7, 0xe3, 0xff, 0xc4, 0xa3, 0x5b, 0x46, 0x5f, 0x31, 0xa9, 0x0d, 0x5e, 0x8b, 0x27, 0xe4, 0xf8,
+	0x12, 0x85, 0xcf, 0x48, 0xf0, 0xd5, 0x40, 0x65, 0x87, 0x6b, 0x9c, 0x10, 0x78, 0x02, 0xd6, 0x22,
+	0xec, 0x76, 0x1d, 0xec, 0xf2, 0x97, 0xe9, 0xb3, 0xf2, 0x52, 0x5f, 0x34, 0x6b, 0xbd, 0x58, 0x7b,
+	0x28, 0x57, 0x88, 0xdb, 0x60, 0xba, 0x75, 0x5f, 0x9e, 0xef, 0x63, 0xdc, 0xe7, 0xd7, 0xff, 0xa3,
+	0x80, 0xea, 0xf8, 0x37, 0x83, 0xa7, 0x60, 0x99, 0x32, 0xd4, 0xf6, 0x82, 0x96, 0x1d, 0xe1, 0x4b,
+	0x14, 0xb9, 0x54, 0xd6, 0xc6, 0xe7, 0xd3, 0xad, 0x5b, 0xf2, 0x51, 0x86, 0x38, 0x74, 0xab, 0x24,
+	0x4f, 0x2c, 0x71, 0x00, 0x1d, 0x50, 0xca, 0xc7, 0x92, 0xd7, 0xc4, 0xa2, 0xf9, 0xdd, 0xe9, 0xae,
+	0x59, 0xbb, 0xed, 0x39, 0x74, 0xeb, 0x6b, 0xb9, 0x30, 0xeb, 0x7f, 0x9d, 0x05, 0xe5, 0xe1, 0x46,
+	0x0d, 0x2d, 0xb0, 0x96, 0xed, 0xf9, 0xc4, 0xa6, 0xfc, 0x27, 0x9d, 0xbc, 0xd2, 0xcb, 0x26, 0x36,
+	0x68, 0xf4, 0xe4, 0x58, 0xa8, 0x42, 0x1b, 0x3c, 0xcc, 0x73, 0x8e, 0xf8, 0x36, 0x15, 0xb5, 0x9a,
+	0xa1, 0xde, 0xcd, 0x7a, 0x02, 0xdb, 0xe0, 0xeb, 0x67, 0xd8, 0x6b, 0x9d, 0x31, 0x1b, 0x39, 0x0e,
+	0xe9, 0x06, 0x2c, 0x09, 0x2e, 0x65, 0x28, 0x62, 0xd4, 0x3e, 0x8d, 0x88, 0xcf, 0xcb, 0xb5, 0x60,
+	0xd6, 0x7b, 0xb1, 0xf6, 0xbe, 0x08, 0xcd, 0x58, 0xb8, 0x6e, 0x55, 0x84, 0x7c, 0xa7, 0x2f, 0x3e,
+	0xe6, 0xd2, 0xfd, 0x44, 0xf8, 0x52, 0x01, 0x60, 0x30, 0x88, 0xe0, 0x3a, 0xb8, 0x97, 0x9f, 0xea,
+	0x0b, 0xa1, 0x98, 0xe8, 0x1d, 0x50, 0xcc, 0x0c, 0xb8, 0xc9, 0x4e, 0x7e, 0x9a, 0x38, 0xf9, 0x4e,
+	0xeb, 0x0d, 0x18, 0xcc, 0x40, 0xfd, 0x0f, 0x0a, 0x58, 0x19, 0x19, 0x2c, 0xf0, 0xfb, 0xa0, 0xd4,
+	0xe1, 0x79, 0x91, 0xdf, 0x3c, 0xcc, 0x8d, 0x41, 0x92, 0xe4, 0xe5, 0xba, 0xb5, 0x24, 0x0e, 0xe4,
+	0x5a, 0xf2, 0x0c, 0xdc, 0x8f, 0x70, 0xd8, 0x41, 0x0e, 0xf6, 0x71, 0x30, 0xd8, 0x5f, 0x66, 0x39,
+	0x4b, 0xb5, 0x17, 0x6b, 0x95, 0xb4, 0xce, 0x46, 0x40, 0xba, 0xb5, 0x92, 0x39, 0x15, 0x7c, 0xfa,
+	0xdf, 0x15, 0x50, 0xca, 0xcf, 0x26, 0xf8, 0x01, 0x98, 0xe7, 0x8d, 0x42, 0x56, 0x52, 0xb9, 0x17,
+	0x6b, 0x4b, 0xe9, 0x82, 0x15, 0x24, 0x8f, 0x21, 0xc4, 0xf0, 0x09, 0x28, 0x66, 0xbe, 0x93, 0x64,
+	0x41, 0x64, 0xd6, 0xb1, 0x8c, 0x50, 0xb7, 0xc0, 0x79, 0xff, 0xcb, 0x09, 0xbe, 0x00, 0xf3, 0x7c,
+	0x72, 0xaa, 0x85, 0x29, 0x86, 0x73, 0xd2, 0x75, 0x76, 0xfc, 0xe4, 0xd5, 0x9f, 0x06, 0x62, 0x76,
+	0xae, 0xca, 0x25, 0x74, 0x29, 0xf3, 0x51, 0xa2, 0x5b, 0x82, 0xf2, 0x63, 0x0a, 0x2a, 0x77, 0x2f,
+	0x8b, 0xf0, 0x13, 0xf0, 0xe1, 0xdd, 0xd2, 0x5c, 0x0a, 0x97, 0x67, 0xa0, 0x0e, 0xaa, 0x63, 0xf6,
+	0xce, 0x6e, 0x14, 0x94, 0x95, 0xca, 0xdc, 0xcf, 0x7e, 0x5f, 0x9d, 0x31, 0x9f, 0xbf, 0xba, 0xa9,
+	0x2a, 0xaf, 0x6f, 0xaa, 0xca, 0xbf, 0x6f, 0xaa, 0xca, 0xaf, 0xde, 0x56, 0x67, 0x5e, 0xbf, 0xad,
+	0xce, 0xfc, 0xf3, 0x6d, 0x75, 0xe6, 0xc5, 0x93, 0x4c, 0xee, 0x48, 0x2f, 0x1f, 0x75, 0x50, 0x93,
+	0xa6, 0x3f, 0x1a, 0x17, 0xdb, 0x8f, 0x1b, 0x57, 0xb9, 0x8f, 0x7b, 0x9e, 0x50, 0xcd, 0x05, 0xfe,
+	0x31, 0xf6, 0xad, 0xff, 0x0e, 0x00, 0xd1, 0xc6, 0xdb, 0x4f, 0x32, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -558,6 +859,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolTypeCreationFees) > 0 {
+		for iNdEx := len(m.PoolTypeCreationFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTypeCreationFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.CanonicalRouteMinPoolLiquidity.Size()
+		i -= size
+		if _, err := m.CanonicalRouteMinPoolLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RouteSpotPriceTwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RouteSpotPriceTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RouteSpotPriceMaxTwapDeviation.Size()
+		i -= size
+		if _, err := m.RouteSpotPriceMaxTwapDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.AuthorizedQuoteDenoms) > 0 {
 		for iNdEx := len(m.AuthorizedQuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AuthorizedQuoteDenoms[iNdEx])
@@ -594,6 +937,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolTypeCreationFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTypeCreationFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTypeCreationFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -614,6 +1004,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CanonicalRoutes) > 0 {
+		for iNdEx := len(m.CanonicalRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanonicalRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PoolMigrationLinks) > 0 {
+		for iNdEx := len(m.PoolMigrationLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolMigrationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -905,27 +1323,111 @@ func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolMigrationLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PoolMigrationLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolMigrationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.ReplacementPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReplacementPoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LegacyPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LegacyPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
 	l = m.TakerFeeParams.Size()
@@ -936,6 +1438,39 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.RouteSpotPriceMaxTwapDeviation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RouteSpotPriceTwapWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CanonicalRouteMinPoolLiquidity.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolTypeCreationFees) > 0 {
+		for _, e := range m.PoolTypeCreationFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolTypeCreationFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolType != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolType))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Destination != 0 {
+		n += 1 + sovGenesis(uint64(m.Destination))
+	}
 	return n
 }
 
@@ -972,6 +1507,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolMigrationLinks) > 0 {
+		for _, e := range m.PoolMigrationLinks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CanonicalRoutes) > 0 {
+		for _, e := range m.CanonicalRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1061,6 +1608,44 @@ func (m *PoolVolume) Size() (n int) {
 	return n
 }
 
+func (m *PoolMigrationLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LegacyPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.LegacyPoolId))
+	}
+	if m.ReplacementPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.ReplacementPoolId))
+	}
+	return n
+}
+
+func (m *CanonicalRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1195,6 +1780,263 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorizedQuoteDenoms = append(m.AuthorizedQuoteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteSpotPriceMaxTwapDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouteSpotPriceMaxTwapDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteSpotPriceTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RouteSpotPriceTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalRouteMinPoolLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CanonicalRouteMinPoolLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeCreationFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTypeCreationFees = append(m.PoolTypeCreationFees, PoolTypeCreationFee{})
+			if err := m.PoolTypeCreationFees[len(m.PoolTypeCreationFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolTypeCreationFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolTypeCreationFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolTypeCreationFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= PoolCreationFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1264,9 +2106,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
+			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesTracker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1293,13 +2202,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TakerFeesTracker == nil {
+				m.TakerFeesTracker = &TakerFeesTracker{}
+			}
+			if err := m.TakerFeesTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1326,14 +2238,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
-			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolVolumes = append(m.PoolVolumes, &PoolVolume{})
+			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFeeStore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1360,16 +2272,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TakerFeesTracker == nil {
-				m.TakerFeesTracker = &TakerFeesTracker{}
-			}
-			if err := m.TakerFeesTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomPairTakerFeeStore = append(m.DenomPairTakerFeeStore, DenomPairTakerFee{})
+			if err := m.DenomPairTakerFeeStore[len(m.DenomPairTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMigrationLinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1396,14 +2306,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolVolumes = append(m.PoolVolumes, &PoolVolume{})
-			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolMigrationLinks = append(m.PoolMigrationLinks, PoolMigrationLink{})
+			if err := m.PoolMigrationLinks[len(m.PoolMigrationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFeeStore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1430,8 +2340,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomPairTakerFeeStore = append(m.DenomPairTakerFeeStore, DenomPairTakerFee{})
-			if err := m.DenomPairTakerFeeStore[len(m.DenomPairTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CanonicalRoutes = append(m.CanonicalRoutes, CanonicalRoute{})
+			if err := m.CanonicalRoutes[len(m.CanonicalRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2060,6 +2970,242 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolMigrationLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolMigrationLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolMigrationLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyPoolId", wireType)
+			}
+			m.LegacyPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegacyPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementPoolId", wireType)
+			}
+			m.ReplacementPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplacementPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanonicalRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, SwapAmountInRoute{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func TestGenesisState_Validate_PoolRoutes(t *testing.T) {
+	tests := map[string]struct {
+		routes      []types.ModuleRoute
+		expectedErr error
+	}{
+		"valid routes": {
+			routes: []types.ModuleRoute{{PoolId: 1, PoolType: types.Balancer}, {PoolId: 2, PoolType: types.CosmWasm}},
+		},
+		"zero pool id": {
+			routes:      []types.ModuleRoute{{PoolId: 0, PoolType: types.Balancer}},
+			expectedErr: types.InvalidPoolRouteError{PoolId: 0, PoolType: types.Balancer, NextPoolId: 3},
+		},
+		"pool id not less than next pool id": {
+			routes:      []types.ModuleRoute{{PoolId: 3, PoolType: types.Concentrated}},
+			expectedErr: types.InvalidPoolRouteError{PoolId: 3, PoolType: types.Concentrated, NextPoolId: 3},
+		},
+		"undefined pool type": {
+			routes:      []types.ModuleRoute{{PoolId: 1, PoolType: types.PoolType(4)}},
+			expectedErr: types.InvalidPoolRouteError{PoolId: 1, PoolType: types.PoolType(4), NextPoolId: 3},
+		},
+		"duplicate pool id": {
+			routes:      []types.ModuleRoute{{PoolId: 1, PoolType: types.Balancer}, {PoolId: 1, PoolType: types.Stableswap}},
+			expectedErr: types.DuplicatePoolRouteError{PoolId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			genState := types.DefaultGenesis()
+			genState.NextPoolId = 3
+			genState.PoolRoutes = tc.routes
+
+			err := genState.Validate()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,22 +1,30 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 const (
-	ProposalTypeDenomPairTakerFee = "DenomPairTakerFee"
+	ProposalTypeDenomPairTakerFee     = "DenomPairTakerFee"
+	ProposalTypeSetPoolMigrationLinks = "SetPoolMigrationLinks"
+	ProposalTypeSetCanonicalRoutes    = "SetCanonicalRoutes"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeDenomPairTakerFee)
+	govtypesv1.RegisterProposalType(ProposalTypeSetPoolMigrationLinks)
+	govtypesv1.RegisterProposalType(ProposalTypeSetCanonicalRoutes)
 }
 
 var (
 	_ govtypesv1.Content = &DenomPairTakerFeeProposal{}
+	_ govtypesv1.Content = &SetPoolMigrationLinksProposal{}
+	_ govtypesv1.Content = &SetCanonicalRoutesProposal{}
 )
 
 // NewDenomPairTakerFeeProposal returns a new instance of a denom pair taker fee proposal struct.
@@ -66,3 +74,176 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+// NewSetPoolMigrationLinksProposal returns a new instance of a set pool migration links proposal struct.
+func NewSetPoolMigrationLinksProposal(title, description string, links []PoolMigrationLink) govtypesv1.Content {
+	return &SetPoolMigrationLinksProposal{
+		Title:       title,
+		Description: description,
+		Links:       links,
+	}
+}
+
+func (p *SetPoolMigrationLinksProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetPoolMigrationLinksProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetPoolMigrationLinksProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetPoolMigrationLinksProposal) ProposalType() string {
+	return ProposalTypeSetPoolMigrationLinks
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetPoolMigrationLinksProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Links) == 0 {
+		return errors.New("proposal must contain at least one pool migration link")
+	}
+
+	return validatePoolMigrationLinks(p.Links)
+}
+
+// String returns a string containing the set pool migration links proposal.
+func (p SetPoolMigrationLinksProposal) String() string {
+	linksStr := ""
+	for _, link := range p.Links {
+		linksStr = linksStr + fmt.Sprintf("(LegacyPoolId: %d, ReplacementPoolId: %d) ", link.LegacyPoolId, link.ReplacementPoolId)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Migration Links Proposal:
+Title:       %s
+Description: %s
+Links:       %s
+`, p.Title, p.Description, linksStr))
+	return b.String()
+}
+
+// validatePoolMigrationLinks validates that every link has a legacy pool id, does not link a pool
+// to itself, and that no legacy pool is linked more than once.
+// A replacement pool id of zero is valid and denotes the removal of the legacy pool's link.
+func validatePoolMigrationLinks(links []PoolMigrationLink) error {
+	seenLegacyPoolIds := make(map[uint64]struct{}, len(links))
+	for _, link := range links {
+		if link.LegacyPoolId == 0 || link.LegacyPoolId == link.ReplacementPoolId {
+			return InvalidPoolMigrationLinkError{LegacyPoolId: link.LegacyPoolId, ReplacementPoolId: link.ReplacementPoolId}
+		}
+		if _, ok := seenLegacyPoolIds[link.LegacyPoolId]; ok {
+			return DuplicatePoolMigrationLinkError{LegacyPoolId: link.LegacyPoolId}
+		}
+		seenLegacyPoolIds[link.LegacyPoolId] = struct{}{}
+	}
+	return nil
+}
+
+// NewSetCanonicalRoutesProposal returns a new instance of a set canonical routes proposal struct.
+func NewSetCanonicalRoutesProposal(title, description string, routes []CanonicalRoute) govtypesv1.Content {
+	return &SetCanonicalRoutesProposal{
+		Title:       title,
+		Description: description,
+		Routes:      routes,
+	}
+}
+
+func (p *SetCanonicalRoutesProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetCanonicalRoutesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetCanonicalRoutesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetCanonicalRoutesProposal) ProposalType() string {
+	return ProposalTypeSetCanonicalRoutes
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetCanonicalRoutesProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Routes) == 0 {
+		return errors.New("proposal must contain at least one canonical route")
+	}
+
+	return validateCanonicalRoutes(p.Routes)
+}
+
+// String returns a string containing the set canonical routes proposal.
+func (p SetCanonicalRoutesProposal) String() string {
+	routesStr := ""
+	for _, route := range p.Routes {
+		routesStr = routesStr + fmt.Sprintf("(Denom: %s, QuoteDenom: %s, Route: %v) ", route.Denom, route.QuoteDenom, route.Route)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Canonical Routes Proposal:
+Title:       %s
+Description: %s
+Routes:      %s
+`, p.Title, p.Description, routesStr))
+	return b.String()
+}
+
+// validateCanonicalRoutes validates that every route prices a valid denom in a different valid quote denom,
+// that its hops end in the quote denom without passing through the denom, and that no denom pair has more than one route.
+// A route with no hops is valid and denotes the removal of the denom pair's canonical route.
+func validateCanonicalRoutes(routes []CanonicalRoute) error {
+	seenPairs := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		if err := route.validate(); err != nil {
+			return err
+		}
+		pair := string(FormatCanonicalRouteKey(route.Denom, route.QuoteDenom))
+		if _, ok := seenPairs[pair]; ok {
+			return DuplicateCanonicalRouteError{Denom: route.Denom, QuoteDenom: route.QuoteDenom}
+		}
+		seenPairs[pair] = struct{}{}
+	}
+	return nil
+}
+
+func (r CanonicalRoute) validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return InvalidCanonicalRouteError{Denom: r.Denom, QuoteDenom: r.QuoteDenom, Reason: err.Error()}
+	}
+	if err := sdk.ValidateDenom(r.QuoteDenom); err != nil {
+		return InvalidCanonicalRouteError{Denom: r.Denom, QuoteDenom: r.QuoteDenom, Reason: err.Error()}
+	}
+	if r.Denom == r.QuoteDenom {
+		return InvalidCanonicalRouteError{Denom: r.Denom, QuoteDenom: r.QuoteDenom, Reason: "denom and quote denom must be different"}
+	}
+	if len(r.Route) == 0 {
+		return nil
+	}
+
+	if err := SwapAmountInRoutes(r.Route).Validate(); err != nil {
+		return InvalidCanonicalRouteError{Denom: r.Denom, QuoteDenom: r.QuoteDenom, Reason: err.Error()}
+	}
+	for i, hop := range r.Route {
+		if hop.PoolId == 0 {
+			return InvalidCanonicalRouteError{Denom: r.Denom, QuoteDenom: r.QuoteDenom, Reason: "pool id must be positive"}
+		}
+		if hop.TokenOutDenom == r.Denom {
+			return InvalidCanonicalRouteError{Denom: r.Denom, QuoteDenom: r.QuoteDenom, Reason: "route must not swap back to the denom"}
+		}
+		if i != len(r.Route)-1 && hop.TokenOutDenom == r.QuoteDenom {
+			return InvalidCanonicalRouteError{Denom: r.Denom, QuoteDenom: r.QuoteDenom, Reason: "only the last hop may swap to the quote denom"}
+		}
+	}
+	if r.Route[len(r.Route)-1].TokenOutDenom != r.QuoteDenom {
+		return InvalidCanonicalRouteError{Denom: r.Denom, QuoteDenom: r.QuoteDenom, Reason: "route must end in the quote denom"}
+	}
+	return nil
+}
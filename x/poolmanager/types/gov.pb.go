@@ -63,8 +63,92 @@ func (m *DenomPairTakerFeeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DenomPairTakerFeeProposal proto.InternalMessageInfo
 
+// SetPoolMigrationLinksProposal is a type for adding/removing links from
+// migrated gamm pools to their replacement concentrated liquidity pools.
+// A link with a replacement pool id of 0 removes the legacy pool's link.
+type SetPoolMigrationLinksProposal struct {
+	Title       string              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Links       []PoolMigrationLink `protobuf:"bytes,3,rep,name=links,proto3" json:"links"`
+}
+
+func (m *SetPoolMigrationLinksProposal) Reset()      { *m = SetPoolMigrationLinksProposal{} }
+func (*SetPoolMigrationLinksProposal) ProtoMessage() {}
+func (*SetPoolMigrationLinksProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95b3c1cda2a8632, []int{1}
+}
+func (m *SetPoolMigrationLinksProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolMigrationLinksProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolMigrationLinksProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolMigrationLinksProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolMigrationLinksProposal.Merge(m, src)
+}
+func (m *SetPoolMigrationLinksProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolMigrationLinksProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolMigrationLinksProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolMigrationLinksProposal proto.InternalMessageInfo
+
+// SetCanonicalRoutesProposal is a type for adding/removing the canonical routes
+// pricing denoms in quote denoms. A route with no hops removes the canonical
+// route of its denom pair.
+type SetCanonicalRoutesProposal struct {
+	Title       string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Routes      []CanonicalRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *SetCanonicalRoutesProposal) Reset()      { *m = SetCanonicalRoutesProposal{} }
+func (*SetCanonicalRoutesProposal) ProtoMessage() {}
+func (*SetCanonicalRoutesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95b3c1cda2a8632, []int{2}
+}
+func (m *SetCanonicalRoutesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCanonicalRoutesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCanonicalRoutesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCanonicalRoutesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCanonicalRoutesProposal.Merge(m, src)
+}
+func (m *SetCanonicalRoutesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCanonicalRoutesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCanonicalRoutesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCanonicalRoutesProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DenomPairTakerFeeProposal)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFeeProposal")
+	proto.RegisterType((*SetPoolMigrationLinksProposal)(nil), "osmosis.poolmanager.v1beta1.SetPoolMigrationLinksProposal")
+	proto.RegisterType((*SetCanonicalRoutesProposal)(nil), "osmosis.poolmanager.v1beta1.SetCanonicalRoutesProposal")
 }
 
 func init() {
@@ -72,25 +156,31 @@ func init() {
 }
 
 var fileDescriptor_c95b3c1cda2a8632 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x86, 0x2a, 0xd3, 0x43, 0x52, 0xa6, 0x07, 0x55, 0x26, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x48, 0xa9, 0xe0, 0x33, 0xb9, 0xa4, 0x02, 0xa2,
-	0x4a, 0xe9, 0x08, 0x23, 0x97, 0xa4, 0x4b, 0x6a, 0x5e, 0x7e, 0x6e, 0x40, 0x62, 0x66, 0x51, 0x48,
-	0x62, 0x76, 0x6a, 0x91, 0x5b, 0x6a, 0x6a, 0x40, 0x51, 0x7e, 0x41, 0x7e, 0x71, 0x62, 0x8e, 0x90,
-	0x08, 0x17, 0x6b, 0x49, 0x66, 0x49, 0x4e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x84,
-	0x23, 0xa4, 0xc0, 0xc5, 0x9d, 0x92, 0x5a, 0x9c, 0x5c, 0x94, 0x59, 0x50, 0x92, 0x99, 0x9f, 0x27,
-	0xc1, 0x04, 0x96, 0x43, 0x16, 0x12, 0x4a, 0xe5, 0x12, 0x49, 0x01, 0x19, 0x1a, 0x5f, 0x90, 0x98,
-	0x59, 0x14, 0x5f, 0x02, 0x32, 0x36, 0x3e, 0x2d, 0x35, 0x55, 0x82, 0x59, 0x81, 0x59, 0x83, 0xdb,
-	0x48, 0x4f, 0x0f, 0x8f, 0x6f, 0xf4, 0x30, 0x5c, 0xe3, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x90,
-	0x60, 0x0a, 0xba, 0x84, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x19, 0x0b, 0xe4, 0x19, 0x9c, 0x02,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6a, 0xad, 0x6e, 0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3,
-	0x5f, 0x66, 0x64, 0xa6, 0x5f, 0x81, 0x12, 0x48, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0,
-	0x00, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x31, 0x1b, 0xd3, 0xcd, 0xa2, 0x01, 0x00, 0x00,
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x41, 0x6b, 0x1a, 0x41,
+	0x14, 0xc7, 0x77, 0x6a, 0x95, 0x76, 0x3c, 0x75, 0xf1, 0x60, 0x2d, 0x5d, 0x45, 0x5a, 0xb0, 0x94,
+	0xee, 0xa2, 0x85, 0x16, 0x72, 0x34, 0x21, 0x90, 0x90, 0x80, 0xd1, 0x9c, 0x72, 0x91, 0x59, 0x7d,
+	0xd9, 0x0c, 0xee, 0xee, 0x5b, 0x66, 0x46, 0x31, 0xdf, 0x20, 0xc7, 0x1c, 0x73, 0xf4, 0x9a, 0x43,
+	0xbe, 0x45, 0x0e, 0x1e, 0x3d, 0xe6, 0x14, 0x82, 0x7e, 0x91, 0xb0, 0xeb, 0x84, 0x68, 0x84, 0x25,
+	0xe0, 0x6d, 0x67, 0xe7, 0x3f, 0xbf, 0xff, 0xef, 0xc1, 0xa3, 0x3f, 0x51, 0x06, 0x28, 0xb9, 0x74,
+	0x22, 0x44, 0x3f, 0x60, 0x21, 0xf3, 0x40, 0x38, 0xa3, 0xba, 0x0b, 0x8a, 0xd5, 0x1d, 0x0f, 0x47,
+	0x76, 0x24, 0x50, 0xa1, 0xf9, 0x4d, 0xc7, 0xec, 0x95, 0x98, 0xad, 0x63, 0xa5, 0x82, 0x87, 0x1e,
+	0x26, 0x39, 0x27, 0xfe, 0x5a, 0x3e, 0x29, 0xfd, 0x48, 0x23, 0xab, 0xb1, 0x4e, 0xfd, 0x4a, 0xed,
+	0x87, 0x10, 0x92, 0xd2, 0x38, 0x5a, 0xbd, 0x27, 0xf4, 0xeb, 0x1e, 0x84, 0x18, 0xb4, 0x18, 0x17,
+	0xa7, 0x6c, 0x00, 0x62, 0x1f, 0xa0, 0x25, 0x30, 0x42, 0xc9, 0x7c, 0xb3, 0x40, 0xb3, 0x8a, 0x2b,
+	0x1f, 0x8a, 0xa4, 0x42, 0x6a, 0x9f, 0xdb, 0xcb, 0x83, 0x59, 0xa1, 0xf9, 0x3e, 0xc8, 0x9e, 0xe0,
+	0x91, 0xe2, 0x18, 0x16, 0x3f, 0x24, 0x77, 0xab, 0xbf, 0x4c, 0xa0, 0x85, 0x7e, 0x0c, 0xed, 0x46,
+	0x8c, 0x8b, 0xae, 0x8a, 0xb1, 0xdd, 0x73, 0x80, 0x62, 0xa6, 0x92, 0xa9, 0xe5, 0x1b, 0xb6, 0x9d,
+	0x32, 0xb8, 0xbd, 0x61, 0xd3, 0xfc, 0x38, 0x7d, 0x2c, 0x1b, 0xed, 0x2f, 0xfd, 0xb7, 0x17, 0x3b,
+	0x9f, 0xae, 0x26, 0x65, 0xe3, 0x66, 0x52, 0x36, 0xaa, 0x77, 0x84, 0x7e, 0xef, 0x80, 0x6a, 0x21,
+	0xfa, 0xc7, 0xdc, 0x13, 0x2c, 0xb6, 0x38, 0xe2, 0xe1, 0x40, 0x6e, 0x3d, 0xca, 0x21, 0xcd, 0xfa,
+	0x31, 0xe8, 0x5d, 0xee, 0x1b, 0xfd, 0xda, 0x7d, 0x89, 0x58, 0xf1, 0xbd, 0x25, 0xb4, 0xd4, 0x01,
+	0xb5, 0xcb, 0x42, 0x0c, 0x79, 0x8f, 0xf9, 0x6d, 0x1c, 0x2a, 0xd8, 0x5e, 0xf6, 0x80, 0xe6, 0x44,
+	0x42, 0xd2, 0xb6, 0xbf, 0x53, 0x6d, 0xd7, 0xdb, 0xb5, 0xaa, 0x06, 0xbc, 0xba, 0x36, 0x4f, 0xa6,
+	0x73, 0x8b, 0xcc, 0xe6, 0x16, 0x79, 0x9a, 0x5b, 0xe4, 0x7a, 0x61, 0x19, 0xb3, 0x85, 0x65, 0x3c,
+	0x2c, 0x2c, 0xe3, 0xec, 0xbf, 0xc7, 0xd5, 0xc5, 0xd0, 0xb5, 0x7b, 0x18, 0x38, 0xba, 0xe8, 0x8f,
+	0xcf, 0x5c, 0xf9, 0x72, 0x70, 0x46, 0x8d, 0x7f, 0xce, 0x78, 0x6d, 0x0b, 0xd5, 0x65, 0x04, 0xd2,
+	0xcd, 0x25, 0xcb, 0xf7, 0xf7, 0x79, 0x00, 0x0d, 0xac, 0x4f, 0x3c, 0x29, 0x03, 0x00, 0x00,
 }
 
 func (m *DenomPairTakerFeeProposal) Marshal() (dAtA []byte, err error) {
@@ -144,6 +234,108 @@ func (m *DenomPairTakerFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *SetPoolMigrationLinksProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolMigrationLinksProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolMigrationLinksProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCanonicalRoutesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCanonicalRoutesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCanonicalRoutesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -178,6 +370,52 @@ func (m *DenomPairTakerFeeProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetPoolMigrationLinksProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetCanonicalRoutesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -332,6 +570,302 @@ func (m *DenomPairTakerFeeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetPoolMigrationLinksProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolMigrationLinksProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolMigrationLinksProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, PoolMigrationLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCanonicalRoutesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCanonicalRoutesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCanonicalRoutesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, CanonicalRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
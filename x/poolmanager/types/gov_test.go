@@ -150,3 +150,127 @@ func TestDenomPairTakerFeeProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestSetPoolMigrationLinksProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		links      []types.PoolMigrationLink
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			links:      []types.PoolMigrationLink{{LegacyPoolId: 1, ReplacementPoolId: 3}, {LegacyPoolId: 2, ReplacementPoolId: 4}},
+			expectPass: true,
+		},
+		{
+			name:       "link removal",
+			links:      []types.PoolMigrationLink{{LegacyPoolId: 1, ReplacementPoolId: 0}},
+			expectPass: true,
+		},
+		{
+			name:       "no links",
+			links:      []types.PoolMigrationLink{},
+			expectPass: false,
+		},
+		{
+			name:       "zero legacy pool id",
+			links:      []types.PoolMigrationLink{{LegacyPoolId: 0, ReplacementPoolId: 3}},
+			expectPass: false,
+		},
+		{
+			name:       "pool linked to itself",
+			links:      []types.PoolMigrationLink{{LegacyPoolId: 1, ReplacementPoolId: 1}},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate legacy pool id",
+			links:      []types.PoolMigrationLink{{LegacyPoolId: 1, ReplacementPoolId: 3}, {LegacyPoolId: 1, ReplacementPoolId: 4}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		setPoolMigrationLinksProposal := types.NewSetPoolMigrationLinksProposal("title", "description", test.links)
+
+		if test.expectPass {
+			require.NoError(t, setPoolMigrationLinksProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, setPoolMigrationLinksProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestSetCanonicalRoutesProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		routes     []types.CanonicalRoute
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			routes: []types.CanonicalRoute{
+				{Denom: "uatom", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uion"}, {PoolId: 2, TokenOutDenom: "uosmo"}}},
+				{Denom: "uion", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "uosmo"}}},
+			},
+			expectPass: true,
+		},
+		{
+			name:       "route removal",
+			routes:     []types.CanonicalRoute{{Denom: "uatom", QuoteDenom: "uosmo"}},
+			expectPass: true,
+		},
+		{
+			name:       "no routes",
+			routes:     []types.CanonicalRoute{},
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			routes:     []types.CanonicalRoute{{Denom: "", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}}}},
+			expectPass: false,
+		},
+		{
+			name:       "denom equal to quote denom",
+			routes:     []types.CanonicalRoute{{Denom: "uosmo", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}}}},
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			routes:     []types.CanonicalRoute{{Denom: "uatom", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 0, TokenOutDenom: "uosmo"}}}},
+			expectPass: false,
+		},
+		{
+			name:       "route not ending in quote denom",
+			routes:     []types.CanonicalRoute{{Denom: "uatom", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uion"}}}},
+			expectPass: false,
+		},
+		{
+			name:       "route swapping back to the denom",
+			routes:     []types.CanonicalRoute{{Denom: "uatom", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uion"}, {PoolId: 1, TokenOutDenom: "uatom"}, {PoolId: 2, TokenOutDenom: "uosmo"}}}},
+			expectPass: false,
+		},
+		{
+			name:       "route passing through the quote denom",
+			routes:     []types.CanonicalRoute{{Denom: "uatom", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}, {PoolId: 2, TokenOutDenom: "uion"}, {PoolId: 2, TokenOutDenom: "uosmo"}}}},
+			expectPass: false,
+		},
+		{
+			name: "duplicate denom pair",
+			routes: []types.CanonicalRoute{
+				{Denom: "uatom", QuoteDenom: "uosmo", Route: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}}},
+				{Denom: "uatom", QuoteDenom: "uosmo"},
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		setCanonicalRoutesProposal := types.NewSetCanonicalRoutesProposal("title", "description", test.routes)
+
+		if test.expectPass {
+			require.NoError(t, setCanonicalRoutesProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, setCanonicalRoutesProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -17,6 +17,10 @@ const (
 	StoreKey = ModuleName
 
 	RouterKey = ModuleName
+
+	// PoolCreationFeeBurnerName is the module account name that burns the creation fees of pool types
+	// whose fee destination is PoolCreationFeeDestinationBurn.
+	PoolCreationFeeBurnerName = "poolmanager_pool_creation_fee_burner"
 )
 
 var (
@@ -57,6 +61,12 @@ var (
 
 	// KeyRegisteredAlloyPool defines the key to store registered alloy pool data.
 	KeyRegisteredAlloyPool = []byte{0x0C}
+
+	// KeyPoolMigrationLinkPrefix defines the prefix to store links from migrated gamm pools to their replacement pools.
+	KeyPoolMigrationLinkPrefix = []byte{0x0D}
+
+	// KeyCanonicalRoutePrefix defines the prefix to store the canonical routes pricing denoms in quote denoms.
+	KeyCanonicalRoutePrefix = []byte{0x0E}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -147,3 +157,13 @@ func FormatRegisteredAlloyPoolKey(poolId uint64, alloyedDenom string) []byte {
 func FormatRegisteredAlloyPoolKeyPoolIdOnly(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d", KeyRegisteredAlloyPool, KeySeparator, poolId))
 }
+
+// FormatPoolMigrationLinkKey returns the key storing the migration link of the given legacy pool.
+func FormatPoolMigrationLinkKey(legacyPoolId uint64) []byte {
+	return append(KeyPoolMigrationLinkPrefix, sdk.Uint64ToBigEndian(legacyPoolId)...)
+}
+
+// FormatCanonicalRouteKey returns the key storing the canonical route pricing denom in quoteDenom.
+func FormatCanonicalRouteKey(denom, quoteDenom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", KeyCanonicalRoutePrefix, KeySeparator, denom, KeySeparator, quoteDenom))
+}
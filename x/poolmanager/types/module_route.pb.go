@@ -119,9 +119,85 @@ func (m *ModuleRoute) GetPoolId() uint64 {
 	return 0
 }
 
+// PoolTypeGasCosts are the base gas costs of the operations on pools of a
+// pool type, as declared by the pool module of the type. They are used to
+// estimate the gas of swap routes without executing them.
+type PoolTypeGasCosts struct {
+	PoolType PoolType `protobuf:"varint,1,opt,name=pool_type,json=poolType,proto3,enum=osmosis.poolmanager.v1beta1.PoolType" json:"pool_type,omitempty" yaml:"pool_type"`
+	// swap_gas is the base gas cost of a swap through a pool of the type.
+	SwapGas uint64 `protobuf:"varint,2,opt,name=swap_gas,json=swapGas,proto3" json:"swap_gas,omitempty" yaml:"swap_gas"`
+	// join_gas is the base gas cost of adding liquidity to a pool of the type.
+	JoinGas uint64 `protobuf:"varint,3,opt,name=join_gas,json=joinGas,proto3" json:"join_gas,omitempty" yaml:"join_gas"`
+	// exit_gas is the base gas cost of removing liquidity from a pool of the
+	// type.
+	ExitGas uint64 `protobuf:"varint,4,opt,name=exit_gas,json=exitGas,proto3" json:"exit_gas,omitempty" yaml:"exit_gas"`
+}
+
+func (m *PoolTypeGasCosts) Reset()         { *m = PoolTypeGasCosts{} }
+func (m *PoolTypeGasCosts) String() string { return proto.CompactTextString(m) }
+func (*PoolTypeGasCosts) ProtoMessage()    {}
+func (*PoolTypeGasCosts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96bfcc7b6d387cee, []int{1}
+}
+func (m *PoolTypeGasCosts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTypeGasCosts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTypeGasCosts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTypeGasCosts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTypeGasCosts.Merge(m, src)
+}
+func (m *PoolTypeGasCosts) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTypeGasCosts) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTypeGasCosts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTypeGasCosts proto.InternalMessageInfo
+
+func (m *PoolTypeGasCosts) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return Balancer
+}
+
+func (m *PoolTypeGasCosts) GetSwapGas() uint64 {
+	if m != nil {
+		return m.SwapGas
+	}
+	return 0
+}
+
+func (m *PoolTypeGasCosts) GetJoinGas() uint64 {
+	if m != nil {
+		return m.JoinGas
+	}
+	return 0
+}
+
+func (m *PoolTypeGasCosts) GetExitGas() uint64 {
+	if m != nil {
+		return m.ExitGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.poolmanager.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterType((*ModuleRoute)(nil), "osmosis.poolmanager.v1beta1.ModuleRoute")
+	proto.RegisterType((*PoolTypeGasCosts)(nil), "osmosis.poolmanager.v1beta1.PoolTypeGasCosts")
 }
 
 func init() {
@@ -129,27 +205,33 @@ func init() {
 }
 
 var fileDescriptor_96bfcc7b6d387cee = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0x02, 0x41,
-	0x1c, 0xc6, 0x77, 0x4c, 0xcc, 0x26, 0x91, 0x65, 0x89, 0x10, 0x83, 0x51, 0x84, 0x40, 0x82, 0x66,
-	0xd0, 0xa0, 0xa0, 0xe3, 0x7a, 0xea, 0x50, 0x94, 0x05, 0x41, 0x17, 0x99, 0x75, 0x87, 0x4d, 0x98,
-	0xd9, 0xff, 0xb2, 0x33, 0x5a, 0x5e, 0x3b, 0x75, 0xec, 0x1d, 0x7a, 0x19, 0x8f, 0x1e, 0x3b, 0x49,
-	0xb8, 0x6f, 0xd0, 0x13, 0xc4, 0x6c, 0x2b, 0xd4, 0xa5, 0xdb, 0x37, 0xcc, 0xef, 0xfb, 0xc1, 0xff,
-	0xc3, 0x14, 0xb4, 0x02, 0x3d, 0xd1, 0x2c, 0x01, 0x90, 0x8a, 0xc7, 0x3c, 0x12, 0x29, 0x9b, 0xf5,
-	0x02, 0x61, 0x78, 0x8f, 0x29, 0x08, 0xa7, 0x52, 0x8c, 0x52, 0x98, 0x1a, 0x41, 0x93, 0x14, 0x0c,
-	0x78, 0x07, 0x05, 0x4f, 0x7f, 0xf1, 0xb4, 0xe0, 0x9b, 0x7b, 0x11, 0x44, 0x90, 0x73, 0xcc, 0xa6,
-	0x9f, 0x4a, 0xe7, 0x05, 0xe1, 0xdd, 0xcb, 0xdc, 0x34, 0xb4, 0x22, 0xcf, 0xc7, 0x3b, 0xb6, 0x3c,
-	0x32, 0xf3, 0x44, 0x34, 0x50, 0x1b, 0x75, 0xeb, 0xfd, 0x43, 0xfa, 0x8f, 0x96, 0x5e, 0x03, 0xc8,
-	0xbb, 0x79, 0x22, 0x86, 0xd5, 0xa4, 0x48, 0x1e, 0xc3, 0xdb, 0xb9, 0x63, 0x12, 0x36, 0x4a, 0x6d,
-	0xd4, 0x2d, 0xfb, 0xfb, 0x8b, 0x55, 0x0b, 0x7d, 0xad, 0x5a, 0xf5, 0x39, 0x57, 0xf2, 0xbc, 0x53,
-	0x7c, 0x76, 0x86, 0x15, 0x9b, 0x2e, 0xc2, 0xa3, 0x2b, 0x5c, 0xdd, 0x68, 0xbc, 0x1a, 0xae, 0xfa,
-	0x5c, 0xf2, 0x78, 0x2c, 0x52, 0xd7, 0xf1, 0xea, 0x18, 0xdf, 0x1a, 0x1e, 0x48, 0xa1, 0x9f, 0x78,
-	0xe2, 0x22, 0xcf, 0xc5, 0xb5, 0x01, 0xc4, 0x63, 0x11, 0x9b, 0x94, 0x1b, 0x11, 0xba, 0x25, 0xcb,
-	0x0f, 0x40, 0xab, 0x7b, 0xae, 0x95, 0xbb, 0xd5, 0x2c, 0xbf, 0xbe, 0x13, 0xc7, 0xbf, 0x59, 0xac,
-	0x09, 0x5a, 0xae, 0x09, 0xfa, 0x5c, 0x13, 0xf4, 0x96, 0x11, 0x67, 0x99, 0x11, 0xe7, 0x23, 0x23,
-	0xce, 0xc3, 0x59, 0x34, 0x31, 0x8f, 0xd3, 0x80, 0x8e, 0x41, 0xb1, 0xe2, 0xaa, 0x63, 0xc9, 0x03,
-	0xbd, 0x79, 0xb0, 0x59, 0xff, 0x94, 0x3d, 0xff, 0xd9, 0xdb, 0x2e, 0xa1, 0x83, 0x4a, 0x3e, 0xd7,
-	0xc9, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x69, 0xd2, 0x7d, 0x93, 0x01, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0xab, 0xd3, 0x40,
+	0x14, 0x85, 0x33, 0xef, 0x95, 0x97, 0x38, 0x3e, 0x6a, 0x88, 0x0f, 0x29, 0x15, 0x92, 0x32, 0x20,
+	0x14, 0xc1, 0x19, 0x5a, 0x41, 0xc1, 0x65, 0xba, 0x28, 0x2e, 0x14, 0x8d, 0x82, 0xe2, 0xa6, 0x4c,
+	0x9a, 0x21, 0x46, 0x92, 0xdc, 0x90, 0x99, 0xd6, 0x76, 0xeb, 0xca, 0xa5, 0xff, 0xc1, 0x3f, 0xd3,
+	0x65, 0x97, 0xae, 0x82, 0xb4, 0xff, 0xa0, 0x6b, 0x17, 0x32, 0x69, 0x02, 0xb5, 0x0b, 0x17, 0x6f,
+	0x77, 0x86, 0xf3, 0xdd, 0x33, 0x87, 0xcb, 0xc5, 0x14, 0x64, 0x06, 0x32, 0x91, 0xac, 0x00, 0x48,
+	0x33, 0x9e, 0xf3, 0x58, 0x94, 0x6c, 0x39, 0x0a, 0x85, 0xe2, 0x23, 0x96, 0x41, 0xb4, 0x48, 0xc5,
+	0xac, 0x84, 0x85, 0x12, 0xb4, 0x28, 0x41, 0x81, 0xf3, 0xb0, 0xe1, 0xe9, 0x09, 0x4f, 0x1b, 0xbe,
+	0x7f, 0x13, 0x43, 0x0c, 0x35, 0xc7, 0xb4, 0x3a, 0x8e, 0x90, 0x6f, 0x08, 0xdf, 0x7d, 0x55, 0x27,
+	0x05, 0x3a, 0xc8, 0xf1, 0xf1, 0x1d, 0x3d, 0x3c, 0x53, 0xeb, 0x42, 0xf4, 0xd0, 0x00, 0x0d, 0xbb,
+	0xe3, 0x47, 0xf4, 0x3f, 0xb1, 0xf4, 0x0d, 0x40, 0xfa, 0x7e, 0x5d, 0x88, 0xc0, 0x2a, 0x1a, 0xe5,
+	0x30, 0x6c, 0xd6, 0x19, 0x49, 0xd4, 0xbb, 0x18, 0xa0, 0x61, 0xc7, 0x7f, 0xb0, 0xa9, 0x3c, 0x74,
+	0xa8, 0xbc, 0xee, 0x9a, 0x67, 0xe9, 0x0b, 0xd2, 0x98, 0x24, 0xb8, 0xd2, 0xea, 0x65, 0x44, 0xfe,
+	0x20, 0x6c, 0xb7, 0x39, 0x53, 0x2e, 0x27, 0x20, 0x95, 0x74, 0x3e, 0xde, 0xb6, 0x89, 0x7f, 0x73,
+	0xa8, 0x3c, 0xfb, 0xe4, 0x2b, 0x9d, 0x40, 0x4e, 0xfa, 0x51, 0x6c, 0xc9, 0xaf, 0xbc, 0x98, 0xc5,
+	0x5c, 0x36, 0x05, 0xef, 0x1f, 0x2a, 0xef, 0xde, 0x71, 0xa2, 0x75, 0x48, 0x60, 0x6a, 0x39, 0xe5,
+	0x52, 0xf3, 0x5f, 0x20, 0xc9, 0x6b, 0xfe, 0xf2, 0x9c, 0x6f, 0x1d, 0x12, 0x98, 0x5a, 0x36, 0xbc,
+	0x58, 0x25, 0xaa, 0xe6, 0x3b, 0xe7, 0x7c, 0xeb, 0x90, 0xc0, 0xd4, 0x72, 0xca, 0xe5, 0xe3, 0xd7,
+	0xd8, 0x6a, 0xbb, 0x3b, 0xd7, 0xd8, 0xf2, 0x79, 0xca, 0xf3, 0xb9, 0x28, 0x6d, 0xc3, 0xe9, 0x62,
+	0xfc, 0x4e, 0xf1, 0x30, 0x15, 0xba, 0x8a, 0x8d, 0x1c, 0x1b, 0x5f, 0x4f, 0x20, 0x9f, 0x8b, 0x5c,
+	0x95, 0x5c, 0x89, 0xc8, 0xbe, 0xd0, 0xfc, 0x04, 0x64, 0xf6, 0x81, 0xcb, 0xcc, 0xbe, 0xec, 0x77,
+	0xbe, 0xff, 0x74, 0x0d, 0xff, 0xed, 0x66, 0xe7, 0xa2, 0xed, 0xce, 0x45, 0xbf, 0x77, 0x2e, 0xfa,
+	0xb1, 0x77, 0x8d, 0xed, 0xde, 0x35, 0x7e, 0xed, 0x5d, 0xe3, 0xd3, 0xf3, 0x38, 0x51, 0x9f, 0x17,
+	0x21, 0x9d, 0x43, 0xc6, 0x9a, 0x55, 0x3e, 0x49, 0x79, 0x28, 0xdb, 0x07, 0x5b, 0x8e, 0x9f, 0xb1,
+	0xd5, 0x3f, 0xe7, 0xa6, 0x97, 0x27, 0xc3, 0xab, 0xfa, 0x5a, 0x9e, 0xfe, 0x1d, 0x00, 0x16, 0xdb,
+	0x4f, 0x66, 0x92, 0x02, 0x00, 0x00,
 }
 
 func (m *ModuleRoute) Marshal() (dAtA []byte, err error) {
@@ -185,6 +267,49 @@ func (m *ModuleRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolTypeGasCosts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTypeGasCosts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTypeGasCosts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExitGas != 0 {
+		i = encodeVarintModuleRoute(dAtA, i, uint64(m.ExitGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JoinGas != 0 {
+		i = encodeVarintModuleRoute(dAtA, i, uint64(m.JoinGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SwapGas != 0 {
+		i = encodeVarintModuleRoute(dAtA, i, uint64(m.SwapGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintModuleRoute(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModuleRoute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModuleRoute(v)
 	base := offset
@@ -211,6 +336,27 @@ func (m *ModuleRoute) Size() (n int) {
 	return n
 }
 
+func (m *PoolTypeGasCosts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolType != 0 {
+		n += 1 + sovModuleRoute(uint64(m.PoolType))
+	}
+	if m.SwapGas != 0 {
+		n += 1 + sovModuleRoute(uint64(m.SwapGas))
+	}
+	if m.JoinGas != 0 {
+		n += 1 + sovModuleRoute(uint64(m.JoinGas))
+	}
+	if m.ExitGas != 0 {
+		n += 1 + sovModuleRoute(uint64(m.ExitGas))
+	}
+	return n
+}
+
 func sovModuleRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -305,6 +451,132 @@ func (m *ModuleRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolTypeGasCosts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolTypeGasCosts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolTypeGasCosts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapGas", wireType)
+			}
+			m.SwapGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinGas", wireType)
+			}
+			m.JoinGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitGas", wireType)
+			}
+			m.ExitGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipModuleRoute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
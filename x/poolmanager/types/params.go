@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -21,9 +22,21 @@ var (
 	KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo = []byte("CommunityPoolDenomToSwapNonWhitelistedAssetsTo")
 	KeyAuthorizedQuoteDenoms                          = []byte("AuthorizedQuoteDenoms")
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
+	KeyRouteSpotPriceMaxTwapDeviation                 = []byte("RouteSpotPriceMaxTwapDeviation")
+	KeyRouteSpotPriceTwapWindow                       = []byte("RouteSpotPriceTwapWindow")
+	KeyCanonicalRouteMinPoolLiquidity                 = []byte("CanonicalRouteMinPoolLiquidity")
+	KeyPoolTypeCreationFees                           = []byte("PoolTypeCreationFees")
 
 	ZeroDec = osmomath.ZeroDec()
 	OneDec  = osmomath.OneDec()
+
+	// DefaultRouteSpotPriceMaxTwapDeviation disables the route spot price TWAP check.
+	DefaultRouteSpotPriceMaxTwapDeviation = ZeroDec
+	DefaultRouteSpotPriceTwapWindow       = 5 * time.Minute
+	// DefaultCanonicalRouteMinPoolLiquidity is 10,000 of a 6 decimal asset.
+	DefaultCanonicalRouteMinPoolLiquidity = osmomath.NewInt(10_000_000_000)
+	// DefaultPoolTypeCreationFees charges pool_creation_fee for every pool type.
+	DefaultPoolTypeCreationFees = []PoolTypeCreationFee{}
 )
 
 // ParamTable for gamm module.
@@ -73,6 +86,10 @@ func DefaultParams() Params {
 			"ibc/0CD3A0285E1341859B5E86B6AB7682F023D03E97607CCC1DC95706411D866DF7", // DAI
 			"ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 		},
+		RouteSpotPriceMaxTwapDeviation: DefaultRouteSpotPriceMaxTwapDeviation,
+		RouteSpotPriceTwapWindow:       DefaultRouteSpotPriceTwapWindow,
+		CanonicalRouteMinPoolLiquidity: DefaultCanonicalRouteMinPoolLiquidity,
+		PoolTypeCreationFees:           DefaultPoolTypeCreationFees,
 	}
 }
 
@@ -102,6 +119,18 @@ func (p Params) Validate() error {
 	if err := validateAuthorizedQuoteDenoms(p.AuthorizedQuoteDenoms); err != nil {
 		return err
 	}
+	if err := validateRouteSpotPriceMaxTwapDeviation(p.RouteSpotPriceMaxTwapDeviation); err != nil {
+		return err
+	}
+	if err := validateRouteSpotPriceTwapWindow(p.RouteSpotPriceTwapWindow); err != nil {
+		return err
+	}
+	if err := validateCanonicalRouteMinPoolLiquidity(p.CanonicalRouteMinPoolLiquidity); err != nil {
+		return err
+	}
+	if err := validatePoolTypeCreationFees(p.PoolTypeCreationFees); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -117,6 +146,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo, &p.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo, validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo),
 		paramtypes.NewParamSetPair(KeyAuthorizedQuoteDenoms, &p.AuthorizedQuoteDenoms, validateAuthorizedQuoteDenoms),
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyRouteSpotPriceMaxTwapDeviation, &p.RouteSpotPriceMaxTwapDeviation, validateRouteSpotPriceMaxTwapDeviation),
+		paramtypes.NewParamSetPair(KeyRouteSpotPriceTwapWindow, &p.RouteSpotPriceTwapWindow, validateRouteSpotPriceTwapWindow),
+		paramtypes.NewParamSetPair(KeyCanonicalRouteMinPoolLiquidity, &p.CanonicalRouteMinPoolLiquidity, validateCanonicalRouteMinPoolLiquidity),
+		paramtypes.NewParamSetPair(KeyPoolTypeCreationFees, &p.PoolTypeCreationFees, validatePoolTypeCreationFees),
 	}
 }
 
@@ -227,6 +260,91 @@ func validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo(i interface{}) error
 	return nil
 }
 
+func validateRouteSpotPriceMaxTwapDeviation(i interface{}) error {
+	maxDeviation, ok := i.(osmomath.Dec)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxDeviation.IsNil() || maxDeviation.IsNegative() {
+		return fmt.Errorf("route spot price max twap deviation must be non-negative: %s", maxDeviation)
+	}
+
+	return nil
+}
+
+func validateRouteSpotPriceTwapWindow(i interface{}) error {
+	window, ok := i.(time.Duration)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if window <= 0 {
+		return fmt.Errorf("route spot price twap window must be positive: %s", window)
+	}
+
+	return nil
+}
+
+func validateCanonicalRouteMinPoolLiquidity(i interface{}) error {
+	minLiquidity, ok := i.(osmomath.Int)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if minLiquidity.IsNil() || minLiquidity.IsNegative() {
+		return fmt.Errorf("canonical route min pool liquidity must be non-negative: %s", minLiquidity)
+	}
+
+	return nil
+}
+
+func validatePoolTypeCreationFees(i interface{}) error {
+	poolTypeCreationFees, ok := i.([]PoolTypeCreationFee)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenPoolTypes := make(map[PoolType]struct{}, len(poolTypeCreationFees))
+	for _, poolTypeCreationFee := range poolTypeCreationFees {
+		if _, ok := PoolType_name[int32(poolTypeCreationFee.PoolType)]; !ok {
+			return fmt.Errorf("invalid pool type: %d", poolTypeCreationFee.PoolType)
+		}
+		if _, ok := seenPoolTypes[poolTypeCreationFee.PoolType]; ok {
+			return fmt.Errorf("duplicate pool creation fee for pool type %s", poolTypeCreationFee.PoolType)
+		}
+		seenPoolTypes[poolTypeCreationFee.PoolType] = struct{}{}
+
+		if err := poolTypeCreationFee.Fee.Validate(); err != nil {
+			return fmt.Errorf("invalid pool creation fee for pool type %s: %w", poolTypeCreationFee.PoolType, err)
+		}
+		if _, ok := PoolCreationFeeDestination_name[int32(poolTypeCreationFee.Destination)]; !ok {
+			return fmt.Errorf("invalid pool creation fee destination for pool type %s: %d", poolTypeCreationFee.PoolType, poolTypeCreationFee.Destination)
+		}
+	}
+
+	return nil
+}
+
+// GetPoolTypeCreationFee returns the creation fee of pools of the given pool type.
+// Pool types without an override in PoolTypeCreationFees are charged PoolCreationFee, which funds the community pool.
+func (p Params) GetPoolTypeCreationFee(poolType PoolType) PoolTypeCreationFee {
+	for _, poolTypeCreationFee := range p.PoolTypeCreationFees {
+		if poolTypeCreationFee.PoolType == poolType {
+			return poolTypeCreationFee
+		}
+	}
+	return PoolTypeCreationFee{
+		PoolType:    poolType,
+		Fee:         p.PoolCreationFee,
+		Destination: PoolCreationFeeDestinationCommunityPool,
+	}
+}
+
 func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	if len(pairs) == 0 {
 		return fmt.Errorf("Empty denom pair taker fee")
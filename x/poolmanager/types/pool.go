@@ -10,6 +10,26 @@ import (
 
 var MaxPoolId uint64 = 99_999_999_999
 
+const (
+	// DefaultSwapGas is the base gas cost of a swap through a pool whose module does not declare its gas costs.
+	DefaultSwapGas uint64 = 100_000
+	// DefaultJoinGas is the base gas cost of adding liquidity to a pool whose module does not declare its gas costs.
+	DefaultJoinGas uint64 = 150_000
+	// DefaultExitGas is the base gas cost of removing liquidity from a pool whose module does not declare its gas costs.
+	DefaultExitGas uint64 = 150_000
+)
+
+// DefaultPoolTypeGasCosts returns the gas costs of the given pool type used when its pool module
+// does not declare its own.
+func DefaultPoolTypeGasCosts(poolType PoolType) PoolTypeGasCosts {
+	return PoolTypeGasCosts{
+		PoolType: poolType,
+		SwapGas:  DefaultSwapGas,
+		JoinGas:  DefaultJoinGas,
+		ExitGas:  DefaultExitGas,
+	}
+}
+
 // PoolI defines an interface for pools that hold tokens.
 type PoolI interface {
 	proto.Message
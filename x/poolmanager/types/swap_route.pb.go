@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -218,11 +219,94 @@ func (m *SwapAmountOutSplitRoute) GetPools() []SwapAmountOutRoute {
 	return nil
 }
 
+// SwapHopEstimate is the estimated outcome of a single hop of a routed swap.
+type SwapHopEstimate struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is the amount swapped into the hop's pool, including the taker
+	// fee.
+	TokenIn  types.Coin `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOut types.Coin `protobuf:"bytes,3,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// taker_fee_charged is denominated in the hop's token in denom.
+	TakerFeeCharged types.Coin `protobuf:"bytes,4,opt,name=taker_fee_charged,json=takerFeeCharged,proto3" json:"taker_fee_charged" yaml:"taker_fee_charged"`
+	// spread_fee_charged is the spread fee charged by the hop's pool,
+	// denominated in the hop's token in denom.
+	SpreadFeeCharged types.Coin `protobuf:"bytes,5,opt,name=spread_fee_charged,json=spreadFeeCharged,proto3" json:"spread_fee_charged" yaml:"spread_fee_charged"`
+}
+
+func (m *SwapHopEstimate) Reset()         { *m = SwapHopEstimate{} }
+func (m *SwapHopEstimate) String() string { return proto.CompactTextString(m) }
+func (*SwapHopEstimate) ProtoMessage()    {}
+func (*SwapHopEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cddd97a9a05492a8, []int{4}
+}
+func (m *SwapHopEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapHopEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapHopEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapHopEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapHopEstimate.Merge(m, src)
+}
+func (m *SwapHopEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapHopEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapHopEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapHopEstimate proto.InternalMessageInfo
+
+func (m *SwapHopEstimate) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapHopEstimate) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *SwapHopEstimate) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *SwapHopEstimate) GetTakerFeeCharged() types.Coin {
+	if m != nil {
+		return m.TakerFeeCharged
+	}
+	return types.Coin{}
+}
+
+func (m *SwapHopEstimate) GetSpreadFeeCharged() types.Coin {
+	if m != nil {
+		return m.SpreadFeeCharged
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountOutRoute")
 	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountInSplitRoute")
 	proto.RegisterType((*SwapAmountOutSplitRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountOutSplitRoute")
+	proto.RegisterType((*SwapHopEstimate)(nil), "osmosis.poolmanager.v1beta1.SwapHopEstimate")
 }
 
 func init() {
@@ -230,35 +314,44 @@ func init() {
 }
 
 var fileDescriptor_cddd97a9a05492a8 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcb, 0xaa, 0xd3, 0x40,
-	0x1c, 0xc6, 0x33, 0x5e, 0x8e, 0x38, 0x1e, 0xab, 0x86, 0x73, 0xa9, 0x47, 0x48, 0x4a, 0x56, 0x05,
-	0x75, 0x86, 0x56, 0xb0, 0xe2, 0x46, 0x0c, 0x6e, 0xb2, 0x2a, 0xa6, 0xbb, 0xba, 0x08, 0x93, 0x26,
-	0xa4, 0xa1, 0xc9, 0x4c, 0xe8, 0x4c, 0x5a, 0xbb, 0x15, 0x1f, 0xc0, 0xc7, 0xea, 0xb2, 0x1b, 0x41,
-	0xba, 0x08, 0xd2, 0xbe, 0x41, 0x9e, 0x40, 0x72, 0xb3, 0x49, 0x85, 0x2a, 0xee, 0xe6, 0xf2, 0xff,
-	0xe6, 0xff, 0xfb, 0xbe, 0x99, 0x81, 0x2f, 0x18, 0x0f, 0x19, 0xf7, 0x39, 0x8e, 0x18, 0x0b, 0x42,
-	0x42, 0x89, 0xe7, 0xce, 0xf1, 0xa2, 0x67, 0xbb, 0x82, 0xf4, 0x30, 0x5f, 0x92, 0xc8, 0x9a, 0xb3,
-	0x58, 0xb8, 0x28, 0x9a, 0x33, 0xc1, 0xe4, 0x67, 0x65, 0x35, 0xaa, 0x55, 0xa3, 0xb2, 0xfa, 0xe6,
-	0xc2, 0x63, 0x1e, 0xcb, 0xeb, 0x70, 0x36, 0x2a, 0x24, 0xda, 0x57, 0x00, 0x9f, 0x8c, 0x96, 0x24,
-	0x7a, 0x1f, 0xb2, 0x98, 0x0a, 0x83, 0x9a, 0xd9, 0x71, 0xf2, 0x73, 0x78, 0x2f, 0x3b, 0xc2, 0xf2,
-	0x9d, 0x36, 0xe8, 0x80, 0xee, 0x1d, 0x5d, 0x4e, 0x13, 0xb5, 0xb5, 0x22, 0x61, 0xf0, 0x56, 0x2b,
-	0x37, 0x34, 0xf3, 0x2c, 0x1b, 0x19, 0x8e, 0xac, 0xc3, 0x47, 0x82, 0xcd, 0x5c, 0x6a, 0xb1, 0x58,
-	0x58, 0x8e, 0x4b, 0x59, 0xd8, 0xbe, 0xd5, 0x01, 0xdd, 0xfb, 0xfa, 0x4d, 0x9a, 0xa8, 0x57, 0x85,
-	0xe8, 0xa8, 0x40, 0x33, 0x1f, 0xe6, 0x2b, 0xc3, 0x58, 0x7c, 0xc8, 0xe7, 0x5f, 0x00, 0x94, 0x0f,
-	0x18, 0xc3, 0x58, 0xfc, 0x07, 0xc7, 0x3b, 0xd8, 0x2a, 0xda, 0xf8, 0xb4, 0x81, 0xf1, 0x34, 0x4d,
-	0xd4, 0xcb, 0x3a, 0x46, 0xb5, 0xaf, 0x99, 0xe7, 0xf9, 0x82, 0x41, 0x0b, 0x88, 0xef, 0x00, 0x5e,
-	0xd5, 0xb3, 0x18, 0x45, 0x81, 0x5f, 0x82, 0x8c, 0xe1, 0xdd, 0xac, 0x0b, 0x6f, 0x83, 0xce, 0xed,
-	0xee, 0x83, 0x3e, 0x42, 0x27, 0x92, 0x46, 0x7f, 0xe4, 0xa9, 0x5f, 0xac, 0x13, 0x55, 0x4a, 0x13,
-	0xf5, 0xfc, 0x80, 0xce, 0x35, 0xb3, 0x38, 0x52, 0xb6, 0xaa, 0xfc, 0x7c, 0x6a, 0x91, 0x5c, 0x56,
-	0x82, 0x0f, 0x32, 0xd5, 0x36, 0x51, 0x2f, 0x27, 0x79, 0x37, 0xee, 0xcc, 0x90, 0xcf, 0x70, 0x48,
-	0xc4, 0x14, 0x19, 0x54, 0x1c, 0x87, 0xfb, 0x5b, 0x5d, 0x85, 0x6b, 0xd0, 0x02, 0x42, 0xdb, 0x02,
-	0x78, 0xdd, 0x08, 0xb7, 0x66, 0xec, 0x53, 0xd3, 0x18, 0xfe, 0x47, 0x63, 0xd5, 0x0d, 0x9d, 0x76,
-	0x66, 0xc3, 0xc7, 0x87, 0x8b, 0x6f, 0x58, 0x7b, 0xf3, 0x37, 0x6b, 0xd7, 0xc7, 0xef, 0xa6, 0xf2,
-	0xd6, 0xaa, 0x1e, 0x4e, 0x01, 0xa2, 0x7f, 0x5c, 0xef, 0x14, 0xb0, 0xd9, 0x29, 0xe0, 0xe7, 0x4e,
-	0x01, 0xdf, 0xf6, 0x8a, 0xb4, 0xd9, 0x2b, 0xd2, 0x8f, 0xbd, 0x22, 0x8d, 0x07, 0x9e, 0x2f, 0xa6,
-	0xb1, 0x8d, 0x26, 0x2c, 0xc4, 0xa5, 0xab, 0x97, 0x01, 0xb1, 0x79, 0x35, 0xc1, 0x8b, 0xfe, 0x6b,
-	0xfc, 0xb9, 0xf1, 0xb3, 0xc4, 0x2a, 0x72, 0xb9, 0x7d, 0x96, 0x7f, 0x8d, 0x57, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x83, 0xb1, 0x15, 0xd4, 0x7d, 0x03, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6e, 0xd3, 0x4e,
+	0x14, 0x8f, 0xff, 0xfd, 0x9e, 0xf6, 0xdf, 0xb4, 0x56, 0xdb, 0x38, 0x41, 0xb2, 0x83, 0x57, 0x91,
+	0x00, 0x5b, 0x0d, 0x12, 0x45, 0x6c, 0x10, 0x2e, 0x20, 0xb2, 0x40, 0x05, 0x77, 0x57, 0x16, 0xd6,
+	0x38, 0x1e, 0x1c, 0x93, 0x78, 0xc6, 0xf2, 0x8c, 0x5b, 0xba, 0x45, 0x1c, 0x80, 0xc3, 0x70, 0x88,
+	0x2e, 0xbb, 0x41, 0x42, 0x5d, 0x58, 0x28, 0xb9, 0x41, 0x4e, 0x80, 0xec, 0x19, 0x27, 0x71, 0x2a,
+	0x35, 0xc0, 0xce, 0x7e, 0xf3, 0xde, 0xef, 0x6b, 0x9e, 0x0d, 0x1e, 0x12, 0x1a, 0x12, 0x1a, 0x50,
+	0x33, 0x22, 0x64, 0x10, 0x42, 0x0c, 0x7d, 0x14, 0x9b, 0xe7, 0x87, 0x2e, 0x62, 0xf0, 0xd0, 0xa4,
+	0x17, 0x30, 0x72, 0x62, 0x92, 0x30, 0x64, 0x44, 0x31, 0x61, 0x44, 0xbe, 0x27, 0xba, 0x8d, 0x99,
+	0x6e, 0x43, 0x74, 0x37, 0xf6, 0x7c, 0xe2, 0x93, 0xbc, 0xcf, 0xcc, 0x9e, 0xf8, 0x48, 0x43, 0xed,
+	0xe6, 0x33, 0xa6, 0x0b, 0x29, 0x9a, 0x00, 0x77, 0x49, 0x80, 0xf9, 0xb9, 0xfe, 0x55, 0x02, 0xbb,
+	0xa7, 0x17, 0x30, 0x7a, 0x11, 0x92, 0x04, 0xb3, 0x0e, 0xb6, 0x33, 0x3a, 0xf9, 0x01, 0x58, 0xcb,
+	0x28, 0x9c, 0xc0, 0x53, 0xa4, 0xa6, 0xd4, 0x5a, 0xb6, 0xe4, 0x71, 0xaa, 0x6d, 0x5f, 0xc2, 0x70,
+	0xf0, 0x4c, 0x17, 0x07, 0xba, 0xbd, 0x9a, 0x3d, 0x75, 0x3c, 0xd9, 0x02, 0x55, 0x46, 0xfa, 0x08,
+	0x3b, 0x24, 0x61, 0x8e, 0x87, 0x30, 0x09, 0x95, 0xff, 0x9a, 0x52, 0x6b, 0xc3, 0x6a, 0x8c, 0x53,
+	0xed, 0x80, 0x0f, 0xcd, 0x35, 0xe8, 0xf6, 0xff, 0x79, 0xe5, 0x24, 0x61, 0x2f, 0xf3, 0xf7, 0x2f,
+	0x12, 0x90, 0xa7, 0x32, 0x4e, 0x12, 0xf6, 0x0f, 0x3a, 0x9e, 0x83, 0x6d, 0x4e, 0x13, 0xe0, 0x92,
+	0x8c, 0xfa, 0x38, 0xd5, 0xf6, 0x67, 0x65, 0x14, 0xe7, 0xba, 0xbd, 0x95, 0x17, 0x3a, 0x98, 0x8b,
+	0xf8, 0x21, 0x81, 0x83, 0xd9, 0x2c, 0x4e, 0xa3, 0x41, 0x20, 0x84, 0x9c, 0x81, 0x95, 0x8c, 0x85,
+	0x2a, 0x52, 0x73, 0xa9, 0xb5, 0xd9, 0x36, 0x8c, 0x3b, 0x6e, 0xc2, 0xb8, 0x95, 0xa7, 0xb5, 0x77,
+	0x95, 0x6a, 0x95, 0x71, 0xaa, 0x6d, 0x4d, 0xa5, 0x53, 0xdd, 0xe6, 0x90, 0xb2, 0x53, 0xe4, 0x17,
+	0x60, 0x07, 0xe6, 0x63, 0x42, 0xf8, 0x51, 0x36, 0x75, 0x93, 0x6a, 0xfb, 0xfc, 0x0e, 0xa9, 0xd7,
+	0x37, 0x02, 0x62, 0x86, 0x90, 0xf5, 0x8c, 0x0e, 0x66, 0xf3, 0xe1, 0x4e, 0xa6, 0x8b, 0x70, 0x3b,
+	0x98, 0x8b, 0xd0, 0x6f, 0x24, 0x50, 0x2b, 0x85, 0x3b, 0x63, 0xec, 0x43, 0xd9, 0x98, 0xf9, 0x87,
+	0xc6, 0x8a, 0x1b, 0xba, 0xdb, 0x99, 0x0b, 0x76, 0xa6, 0x17, 0x5f, 0xb2, 0xf6, 0x74, 0x91, 0xb5,
+	0xda, 0xfc, 0xde, 0x14, 0xde, 0xb6, 0x8b, 0xc5, 0x11, 0xe6, 0xbe, 0x2f, 0x81, 0x6a, 0xa6, 0xeb,
+	0x0d, 0x89, 0x5e, 0x51, 0x16, 0x84, 0xf0, 0x6f, 0xd7, 0xe6, 0x2d, 0x58, 0x2f, 0x02, 0xcc, 0xc5,
+	0x6d, 0xb6, 0xeb, 0x06, 0x57, 0x65, 0x64, 0x1f, 0xcd, 0xc4, 0xfc, 0x31, 0x09, 0xb0, 0x55, 0x13,
+	0x76, 0xab, 0xe5, 0xe4, 0x75, 0x7b, 0x4d, 0x44, 0x2e, 0xbf, 0x03, 0x1b, 0x13, 0xd1, 0xca, 0xd2,
+	0x22, 0x3c, 0x45, 0xe0, 0xed, 0xcc, 0xd9, 0xd5, 0xed, 0xf5, 0xc2, 0xa7, 0xec, 0x83, 0x5d, 0x06,
+	0xfb, 0x28, 0x76, 0x3e, 0x22, 0xe4, 0x74, 0x7b, 0x30, 0xf6, 0x91, 0xa7, 0x2c, 0x2f, 0x42, 0x6e,
+	0x0a, 0x64, 0x45, 0x20, 0xcf, 0x23, 0xe8, 0x76, 0x35, 0xaf, 0xbd, 0x46, 0xe8, 0x98, 0x57, 0xe4,
+	0x4f, 0x40, 0xa6, 0x51, 0x8c, 0xa0, 0x57, 0x62, 0x5a, 0x59, 0xc4, 0x74, 0x5f, 0x30, 0xd5, 0x39,
+	0xd3, 0x6d, 0x08, 0xdd, 0xde, 0xe1, 0xc5, 0x29, 0x97, 0xf5, 0xfe, 0x6a, 0xa8, 0x4a, 0xd7, 0x43,
+	0x55, 0xfa, 0x35, 0x54, 0xa5, 0x6f, 0x23, 0xb5, 0x72, 0x3d, 0x52, 0x2b, 0x3f, 0x47, 0x6a, 0xe5,
+	0xec, 0xc8, 0x0f, 0x58, 0x2f, 0x71, 0x8d, 0x2e, 0x09, 0x4d, 0xb1, 0x8c, 0x8f, 0x06, 0xd0, 0xa5,
+	0xc5, 0x8b, 0x79, 0xde, 0x7e, 0x62, 0x7e, 0x2e, 0xfd, 0x30, 0xd9, 0x65, 0x84, 0xa8, 0xbb, 0x9a,
+	0xff, 0xd1, 0x1e, 0xff, 0x1e, 0x00, 0xa2, 0xf8, 0xf9, 0x15, 0x54, 0x05, 0x00, 0x00,
 }
 
 func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
@@ -425,6 +518,74 @@ func (m *SwapAmountOutSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapHopEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapHopEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapHopEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SpreadFeeCharged.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TakerFeeCharged.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintSwapRoute(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwapRoute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwapRoute(v)
 	base := offset
@@ -502,6 +663,26 @@ func (m *SwapAmountOutSplitRoute) Size() (n int) {
 	return n
 }
 
+func (m *SwapHopEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovSwapRoute(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	l = m.TakerFeeCharged.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	l = m.SpreadFeeCharged.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	return n
+}
+
 func sovSwapRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -946,6 +1127,207 @@ func (m *SwapAmountOutSplitRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapHopEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapHopEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapHopEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeCharged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeCharged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFeeCharged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadFeeCharged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwapRoute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
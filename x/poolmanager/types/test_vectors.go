@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+const (
+	// SwapTypeExactIn is the swap type of vectors that swap an exact token in for a token out rounded down.
+	SwapTypeExactIn = "exact_in"
+	// SwapTypeExactOut is the swap type of vectors that swap a token in rounded up for an exact token out.
+	SwapTypeExactOut = "exact_out"
+
+	RoundingDown = "down"
+	RoundingUp   = "up"
+)
+
+// SwapTestVectors are canonical swap math test vectors generated from chain state, for client SDKs
+// to validate their local swap math against.
+type SwapTestVectors struct {
+	Height    int64                `json:"height"`
+	BlockTime time.Time            `json:"block_time"`
+	Pools     []SwapTestVectorPool `json:"pools"`
+	Vectors   []SwapTestVector     `json:"vectors"`
+}
+
+// SwapTestVectorPool is the state of a pool the swap test vectors are computed against.
+// TickLiquidity is only set for concentrated liquidity pools.
+type SwapTestVectorPool struct {
+	PoolId         uint64          `json:"pool_id"`
+	PoolType       string          `json:"pool_type"`
+	SpreadFactor   osmomath.Dec    `json:"spread_factor"`
+	TotalLiquidity sdk.Coins       `json:"total_liquidity"`
+	Pool           json.RawMessage `json:"pool"`
+	TickLiquidity  json.RawMessage `json:"tick_liquidity,omitempty"`
+}
+
+// SwapTestVector is the expected outcome of a single pool swap.
+// For SwapTypeExactIn vectors, the input is TokenIn and TokenOut is rounded down.
+// For SwapTypeExactOut vectors, the input is TokenOut and TokenIn is rounded up.
+// TokenIn includes the taker fee, which is charged in its denom along with the pool's spread fee.
+// If the chain rejects the swap, Error is set and the expected amounts are empty.
+type SwapTestVector struct {
+	PoolId           uint64       `json:"pool_id"`
+	SwapType         string       `json:"swap_type"`
+	TakerFee         osmomath.Dec `json:"taker_fee"`
+	TokenIn          sdk.Coin     `json:"token_in"`
+	TokenOut         sdk.Coin     `json:"token_out"`
+	TakerFeeCharged  sdk.Coin     `json:"taker_fee_charged"`
+	SpreadFeeCharged sdk.Coin     `json:"spread_fee_charged"`
+	Rounding         string       `json:"rounding"`
+	Error            string       `json:"error,omitempty"`
+}
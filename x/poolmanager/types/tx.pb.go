@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -96,6 +97,12 @@ func (m *MsgSwapExactAmountIn) GetTokenIn() types.Coin {
 
 type MsgSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// taker_fees_charged is the sum of the taker fees charged on every hop of
+	// the route, denominated in each hop's token in denom.
+	TakerFeesCharged github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=taker_fees_charged,json=takerFeesCharged,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees_charged" yaml:"taker_fees_charged"`
+	// spread_fees_charged is the sum of the spread fees charged by the pool of
+	// every hop of the route, denominated in each hop's token in denom.
+	SpreadFeesCharged github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spread_fees_charged,json=spreadFeesCharged,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spread_fees_charged" yaml:"spread_fees_charged"`
 }
 
 func (m *MsgSwapExactAmountInResponse) Reset()         { *m = MsgSwapExactAmountInResponse{} }
@@ -131,6 +138,20 @@ func (m *MsgSwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapExactAmountInResponse proto.InternalMessageInfo
 
+func (m *MsgSwapExactAmountInResponse) GetTakerFeesCharged() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerFeesCharged
+	}
+	return nil
+}
+
+func (m *MsgSwapExactAmountInResponse) GetSpreadFeesCharged() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpreadFeesCharged
+	}
+	return nil
+}
+
 // ===================== MsgSplitRouteSwapExactAmountIn
 type MsgSplitRouteSwapExactAmountIn struct {
 	Sender            string                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -829,89 +850,94 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x53, 0xdb, 0x46,
-	0x18, 0x46, 0x98, 0xf0, 0xb1, 0x10, 0x82, 0x15, 0x28, 0x8e, 0x49, 0x2d, 0x2a, 0x48, 0x4a, 0x52,
-	0x24, 0xc7, 0xc0, 0x34, 0xc4, 0xd0, 0x49, 0x70, 0x68, 0x66, 0x98, 0xc6, 0x03, 0x51, 0x72, 0xea,
-	0x4c, 0xc7, 0xb3, 0xb6, 0x36, 0x46, 0xc5, 0xd2, 0x7a, 0xa4, 0x35, 0x81, 0x5b, 0x9b, 0x66, 0xda,
-	0x29, 0xd3, 0x43, 0x4f, 0xbd, 0x76, 0xa6, 0xbf, 0x20, 0xb9, 0xf4, 0x37, 0xe4, 0x98, 0x63, 0xa7,
-	0x07, 0x4f, 0x0b, 0x87, 0xf4, 0xec, 0x5f, 0xd0, 0x59, 0xed, 0x4a, 0xb6, 0x85, 0xfc, 0x05, 0x13,
-	0x2e, 0x89, 0xa4, 0x7d, 0x9f, 0xf7, 0xe3, 0x79, 0x9f, 0x7d, 0x77, 0x0d, 0x98, 0xc7, 0x8e, 0x89,
-	0x1d, 0xc3, 0x49, 0x96, 0x31, 0x2e, 0x99, 0xd0, 0x82, 0x45, 0x64, 0x27, 0xf7, 0x53, 0x79, 0x44,
-	0x60, 0x2a, 0x49, 0x0e, 0xd4, 0xb2, 0x8d, 0x09, 0x16, 0x67, 0xb8, 0x95, 0xda, 0x60, 0xa5, 0x72,
-	0xab, 0xf8, 0x64, 0x11, 0x17, 0xb1, 0x6b, 0x97, 0xa4, 0x4f, 0x0c, 0x12, 0x8f, 0x42, 0xd3, 0xb0,
-	0x70, 0xd2, 0xfd, 0x97, 0x7f, 0x4a, 0x14, 0x5c, 0x37, 0xc9, 0x3c, 0x74, 0x90, 0x1f, 0xa3, 0x80,
-	0x0d, 0x8b, 0xaf, 0x2f, 0xb6, 0xcb, 0xc5, 0x79, 0x01, 0xcb, 0x39, 0x1b, 0x57, 0x08, 0xe2, 0xd6,
-	0xd3, 0xdc, 0x9b, 0xe9, 0x14, 0x93, 0xfb, 0x29, 0xfa, 0x1f, 0x5b, 0x90, 0x7f, 0x8a, 0x80, 0xc9,
-	0xac, 0x53, 0x7c, 0xfa, 0x02, 0x96, 0xbf, 0x3c, 0x80, 0x05, 0xb2, 0x61, 0xe2, 0x8a, 0x45, 0xb6,
-	0x2c, 0xf1, 0x16, 0x18, 0x74, 0x90, 0xa5, 0x23, 0x3b, 0x26, 0xcc, 0x0a, 0x0b, 0x23, 0x99, 0x68,
-	0xad, 0x2a, 0x5d, 0x3e, 0x84, 0x66, 0x29, 0x2d, 0xb3, 0xef, 0xb2, 0xc6, 0x0d, 0xc4, 0xc7, 0x60,
-	0xd0, 0x8d, 0xe5, 0xc4, 0xfa, 0x67, 0x23, 0x0b, 0xa3, 0x4b, 0xaa, 0xda, 0x86, 0x01, 0x95, 0x86,
-	0xf2, 0xa2, 0x68, 0x14, 0x96, 0x19, 0x78, 0x5b, 0x95, 0xfa, 0x34, 0xee, 0x43, 0xcc, 0x82, 0x61,
-	0x82, 0xf7, 0x90, 0x95, 0x33, 0xac, 0x58, 0x64, 0x56, 0x58, 0x18, 0x5d, 0xba, 0xa6, 0xb2, 0xec,
-	0x55, 0xca, 0x85, 0xef, 0xe7, 0x21, 0x36, 0xac, 0xcc, 0x34, 0x85, 0xd6, 0xaa, 0xd2, 0x15, 0x96,
-	0x99, 0x07, 0x94, 0xb5, 0x21, 0xf7, 0x71, 0xcb, 0x12, 0x4d, 0x30, 0xc9, 0xbe, 0xe2, 0x0a, 0xc9,
-	0x99, 0x86, 0x95, 0x83, 0x6e, 0xec, 0xd8, 0x80, 0x5b, 0xd5, 0x3a, 0xc5, 0xff, 0x5d, 0x95, 0xa6,
-	0x58, 0x04, 0x47, 0xdf, 0x53, 0x0d, 0x9c, 0x34, 0x21, 0xd9, 0x55, 0xb7, 0x2c, 0x52, 0xab, 0x4a,
-	0x33, 0x8d, 0x8e, 0x9b, 0x5d, 0xc8, 0x5a, 0xd4, 0xfd, 0xbc, 0x5d, 0x21, 0x59, 0xc3, 0x62, 0x25,
-	0xa5, 0x57, 0x5f, 0xbe, 0x7f, 0x7d, 0x9b, 0x13, 0x73, 0xf4, 0xfe, 0xf5, 0xed, 0x85, 0xb0, 0x36,
-	0xd1, 0xf6, 0x28, 0x88, 0xd2, 0xad, 0x30, 0x57, 0x8a, 0x61, 0xc9, 0x2f, 0x05, 0x70, 0x3d, 0xac,
-	0x13, 0x1a, 0x72, 0xca, 0xd8, 0x72, 0x90, 0x98, 0x07, 0x13, 0xf5, 0x34, 0x78, 0x15, 0xac, 0x37,
-	0xab, 0x9d, 0xaa, 0x98, 0x0e, 0x56, 0xe1, 0x55, 0x30, 0xee, 0x55, 0xc0, 0xa2, 0xc9, 0x3f, 0x44,
-	0x40, 0x82, 0x26, 0x51, 0x2e, 0x19, 0xc4, 0x6d, 0xce, 0xb9, 0x84, 0xf1, 0x24, 0x20, 0x8c, 0xe5,
-	0xae, 0x85, 0x51, 0x4f, 0x20, 0xa0, 0x8e, 0xfb, 0x60, 0xdc, 0x6b, 0x72, 0x4e, 0x47, 0x16, 0x36,
-	0x5d, 0x8d, 0x8c, 0x64, 0xae, 0xd5, 0xaa, 0xd2, 0x54, 0xb3, 0x08, 0xd8, 0xba, 0xac, 0x8d, 0x71,
-	0x29, 0x6c, 0xd2, 0xd7, 0x8b, 0xd6, 0xc3, 0x72, 0x40, 0x0f, 0x73, 0xa1, 0x7a, 0xa0, 0xd5, 0x36,
-	0x48, 0xe1, 0x17, 0x01, 0xdc, 0x6c, 0xdf, 0x85, 0x0b, 0x15, 0xc5, 0x51, 0x04, 0x4c, 0x9d, 0x56,
-	0xe6, 0x76, 0x85, 0xf4, 0xa2, 0x85, 0x6c, 0x40, 0x0b, 0xc9, 0x2e, 0xb5, 0xb0, 0x5d, 0x09, 0xd5,
-	0xc1, 0xb7, 0xe0, 0xaa, 0xdf, 0x67, 0x13, 0x1e, 0x78, 0xa5, 0x33, 0x31, 0xac, 0x75, 0x2a, 0x3d,
-	0x1e, 0x50, 0x4a, 0xdd, 0x83, 0xac, 0x4d, 0x70, 0xb9, 0x64, 0xe1, 0x01, 0xcb, 0x40, 0xdc, 0x01,
-	0x23, 0x3e, 0x49, 0xae, 0x4e, 0xda, 0x8e, 0xa4, 0x18, 0x1f, 0x49, 0x13, 0x01, 0x7a, 0x65, 0x6d,
-	0xd8, 0xe3, 0x35, 0x7d, 0x2f, 0xa0, 0x8a, 0x5b, 0xdd, 0x4d, 0x09, 0xea, 0xe5, 0x3b, 0x01, 0x7c,
-	0x1c, 0xda, 0x0c, 0x5f, 0x12, 0x39, 0x70, 0xc5, 0x2f, 0xac, 0x49, 0x11, 0x77, 0x3b, 0xd1, 0xf2,
-	0x51, 0x80, 0x16, 0x8f, 0x92, 0xcb, 0x9c, 0x12, 0xae, 0x87, 0x1f, 0x23, 0x40, 0x6a, 0x27, 0xcf,
-	0x1e, 0x95, 0xa1, 0x05, 0x94, 0xb1, 0xd2, 0xbd, 0x32, 0x5a, 0x8e, 0x89, 0x8c, 0xc7, 0x01, 0xd5,
-	0x75, 0xe3, 0x9c, 0x88, 0x07, 0xcb, 0xf4, 0x0d, 0xbc, 0x32, 0xb7, 0x2b, 0x84, 0x4d, 0x8a, 0x16,
-	0x12, 0x1b, 0xf8, 0x00, 0x12, 0x4b, 0xaf, 0x04, 0x04, 0x31, 0xdf, 0x71, 0x4c, 0x50, 0x2d, 0x1c,
-	0x09, 0xe0, 0xd3, 0x0e, 0x8d, 0xb8, 0x38, 0x55, 0xfc, 0xdc, 0x0f, 0xa6, 0x69, 0x32, 0x88, 0xd1,
-	0xb7, 0x03, 0x0d, 0xfb, 0x19, 0xdc, 0x43, 0xf6, 0x23, 0x84, 0x7a, 0x51, 0xc3, 0x2b, 0x01, 0x4c,
-	0xba, 0xfd, 0xc8, 0x95, 0xa1, 0x61, 0xe7, 0x08, 0x75, 0x91, 0x7b, 0x8e, 0x50, 0x57, 0x77, 0x8b,
-	0x53, 0x91, 0x33, 0x73, 0x7c, 0x37, 0xf2, 0xb9, 0x1d, 0xe6, 0x59, 0xd6, 0xa2, 0x7a, 0x10, 0x97,
-	0x5e, 0x0f, 0x34, 0x24, 0xf4, 0xba, 0xe5, 0x20, 0xa2, 0xb8, 0x50, 0x85, 0x7a, 0x54, 0x5c, 0x8f,
-	0x0a, 0xf5, 0xb8, 0xc6, 0x36, 0x48, 0x08, 0x15, 0x7e, 0x3f, 0x62, 0x60, 0xc8, 0xa9, 0x14, 0x0a,
-	0xc8, 0x71, 0x5c, 0x4e, 0x86, 0x35, 0xef, 0x55, 0xfe, 0xb7, 0x1f, 0xcc, 0x33, 0xb4, 0x07, 0x7a,
-	0xba, 0x0b, 0x6d, 0xb4, 0x51, 0xb4, 0x11, 0x32, 0x91, 0x45, 0x1e, 0x61, 0x9b, 0x09, 0xb4, 0x07,
-	0x56, 0x6f, 0x82, 0x4b, 0x6c, 0x17, 0xf4, 0xbb, 0x96, 0x13, 0xb5, 0xaa, 0x34, 0xd6, 0xc0, 0x88,
-	0xac, 0xb1, 0x65, 0xf1, 0x1b, 0x30, 0xe6, 0xec, 0x19, 0x66, 0xae, 0x8c, 0xec, 0x02, 0xf2, 0xe7,
-	0x69, 0x9a, 0x4b, 0x64, 0xe6, 0xb4, 0x44, 0x1e, 0xa3, 0x22, 0x2c, 0x1c, 0x6e, 0xa2, 0x42, 0xad,
-	0x2a, 0x5d, 0xe5, 0xb1, 0x1b, 0x1c, 0xc8, 0xda, 0x28, 0x7d, 0xdd, 0x61, 0x6f, 0x62, 0x9a, 0xbb,
-	0x87, 0xba, 0x6e, 0xd3, 0xca, 0xd9, 0x5e, 0x9a, 0x0e, 0x60, 0xf9, 0x2a, 0xc7, 0x6e, 0xb0, 0xb7,
-	0xf4, 0x57, 0x81, 0x8e, 0xac, 0xb5, 0xea, 0x88, 0xdf, 0x06, 0xc5, 0xa1, 0xbc, 0x29, 0xd0, 0x23,
-	0x4e, 0x79, 0x8e, 0x6d, 0xd6, 0x2f, 0x59, 0x05, 0x8b, 0xdd, 0x50, 0xec, 0x75, 0x4b, 0xfe, 0x53,
-	0x00, 0x33, 0x0c, 0xa0, 0xa1, 0xa2, 0xe1, 0x10, 0x64, 0x23, 0x7d, 0xa3, 0x54, 0xc2, 0x87, 0x48,
-	0xdf, 0xc1, 0xb8, 0xd4, 0x4b, 0x2b, 0x3e, 0x03, 0x43, 0x34, 0xe3, 0x9c, 0xa1, 0xbb, 0xcd, 0x18,
-	0xc8, 0x88, 0xb5, 0xaa, 0x34, 0xce, 0x6c, 0xf9, 0x82, 0xac, 0x0d, 0xd2, 0xa7, 0x2d, 0x3d, 0x7d,
-	0x3f, 0x50, 0x74, 0xb2, 0x55, 0xd1, 0xb6, 0x9f, 0x96, 0x02, 0x59, 0x5e, 0x0a, 0x35, 0x91, 0x6f,
-	0x80, 0xb9, 0x36, 0x79, 0xfb, 0xf5, 0xfd, 0xd7, 0x0f, 0xa2, 0xa7, 0xb7, 0xed, 0x17, 0x60, 0xd0,
-	0xa5, 0xeb, 0x0e, 0xaf, 0xea, 0x46, 0xad, 0x2a, 0x49, 0x0d, 0xb2, 0xb9, 0x23, 0x2f, 0xea, 0xa8,
-	0x6c, 0xa3, 0x02, 0x24, 0x48, 0x4f, 0xcb, 0xc4, 0xae, 0x20, 0x39, 0x26, 0x68, 0x1c, 0xe4, 0xc3,
-	0x53, 0x5c, 0x75, 0x41, 0x78, 0xaa, 0x1d, 0x3c, 0x25, 0x3e, 0x03, 0x23, 0xf5, 0xdd, 0x1f, 0x69,
-	0x9a, 0x55, 0x1d, 0x84, 0xe8, 0x1d, 0xbd, 0xf5, 0x1d, 0x3e, 0x4c, 0xea, 0x35, 0x35, 0xdd, 0x07,
-	0xb9, 0x04, 0xbb, 0xbe, 0x3e, 0x3e, 0x00, 0xcd, 0xa7, 0x44, 0xec, 0x52, 0x8f, 0xc7, 0xca, 0xd2,
-	0x9b, 0x61, 0x10, 0xc9, 0x3a, 0x45, 0xf1, 0x7b, 0x01, 0x44, 0x4f, 0xdf, 0xae, 0x53, 0x6d, 0xe7,
-	0x5b, 0xd8, 0xef, 0x83, 0xf8, 0xbd, 0x9e, 0x21, 0xfe, 0x10, 0x7a, 0x25, 0x00, 0x31, 0xe4, 0xf0,
-	0x5e, 0xea, 0xd1, 0xe3, 0x76, 0x85, 0xc4, 0xd3, 0xbd, 0x63, 0xfc, 0x34, 0x7e, 0x17, 0xc0, 0x4c,
-	0xbb, 0x9f, 0x1c, 0x6b, 0x1d, 0x7d, 0xb7, 0x06, 0xc7, 0x1f, 0x9e, 0x03, 0xec, 0x67, 0xf8, 0x87,
-	0x00, 0xae, 0xb7, 0xbd, 0xef, 0xac, 0x9f, 0x39, 0x0a, 0x25, 0x6f, 0xf3, 0x3c, 0x68, 0x3f, 0xc9,
-	0x23, 0x01, 0x4c, 0x86, 0x1e, 0xbf, 0x2b, 0x1d, 0xdd, 0x87, 0xa0, 0xe2, 0xeb, 0x67, 0x41, 0xf9,
-	0xc9, 0xbc, 0x11, 0xc0, 0x27, 0x9d, 0x8f, 0xb0, 0x8d, 0x2e, 0x62, 0xb4, 0x77, 0x11, 0xdf, 0x3a,
-	0xb7, 0x0b, 0x3f, 0xe7, 0xdf, 0x04, 0x10, 0x6b, 0x39, 0xe2, 0x57, 0xbb, 0x88, 0x13, 0x8a, 0x8c,
-	0x3f, 0x38, 0x2b, 0xd2, 0x4b, 0x2c, 0xf3, 0xe4, 0xed, 0x71, 0x42, 0x78, 0x77, 0x9c, 0x10, 0xfe,
-	0x39, 0x4e, 0x08, 0xbf, 0x9e, 0x24, 0xfa, 0xde, 0x9d, 0x24, 0xfa, 0xfe, 0x3a, 0x49, 0xf4, 0x7d,
-	0x7d, 0xb7, 0x68, 0x90, 0xdd, 0x4a, 0x5e, 0x2d, 0x60, 0xd3, 0x3b, 0x1b, 0x94, 0x12, 0xcc, 0x3b,
-	0xfe, 0x41, 0xb1, 0xbf, 0xf4, 0x79, 0xf2, 0xa0, 0xe9, 0xb8, 0x20, 0x87, 0x65, 0xe4, 0xe4, 0x07,
-	0xdd, 0xbf, 0xff, 0x2c, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xb3, 0x30, 0xd1, 0xd4, 0x12,
-	0x00, 0x00,
+	// 1383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x73, 0xd3, 0x46,
+	0x18, 0x8e, 0xe2, 0x10, 0x92, 0xe5, 0xcb, 0x16, 0xa1, 0x31, 0x0e, 0xb5, 0xa8, 0xf8, 0x68, 0xa0,
+	0x48, 0xc2, 0x81, 0x29, 0xe0, 0xa4, 0x03, 0x31, 0x94, 0x99, 0x4c, 0x71, 0x13, 0x04, 0xa7, 0xce,
+	0x74, 0x34, 0x6b, 0x6b, 0x71, 0xd4, 0x58, 0x5a, 0x8f, 0x56, 0x86, 0xe4, 0xd6, 0x0f, 0xa6, 0x9d,
+	0x66, 0x7a, 0xe8, 0x89, 0x99, 0x9e, 0x3a, 0xd3, 0x63, 0x4f, 0x70, 0x69, 0xff, 0x02, 0x47, 0x8e,
+	0x9d, 0x1e, 0xdc, 0x16, 0x0e, 0xf4, 0xec, 0x5f, 0xd0, 0x59, 0xed, 0x4a, 0xb6, 0x65, 0x59, 0xb6,
+	0xc9, 0x94, 0x4b, 0x62, 0x69, 0xf7, 0x79, 0xde, 0xaf, 0xe7, 0x7d, 0x77, 0x6d, 0x70, 0x1a, 0x13,
+	0x1b, 0x13, 0x8b, 0x68, 0x0d, 0x8c, 0xeb, 0x36, 0x74, 0x60, 0x0d, 0xb9, 0xda, 0xc3, 0x42, 0x05,
+	0x79, 0xb0, 0xa0, 0x79, 0xdb, 0x6a, 0xc3, 0xc5, 0x1e, 0x16, 0x17, 0xf8, 0x2e, 0xb5, 0x6b, 0x97,
+	0xca, 0x77, 0xe5, 0xe6, 0x6a, 0xb8, 0x86, 0xfd, 0x7d, 0x1a, 0xfd, 0xc4, 0x20, 0xb9, 0x0c, 0xb4,
+	0x2d, 0x07, 0x6b, 0xfe, 0x5f, 0xfe, 0x2a, 0x5f, 0xf5, 0x69, 0xb4, 0x0a, 0x24, 0x28, 0xb4, 0x51,
+	0xc5, 0x96, 0xc3, 0xd7, 0x2f, 0x24, 0xf9, 0x42, 0x1e, 0xc1, 0x86, 0xe1, 0xe2, 0xa6, 0x87, 0xf8,
+	0xee, 0x79, 0xce, 0x66, 0x93, 0x9a, 0xf6, 0xb0, 0x40, 0xff, 0xb1, 0x05, 0xf9, 0xbb, 0x14, 0x98,
+	0x2b, 0x93, 0xda, 0xbd, 0x47, 0xb0, 0xf1, 0xf1, 0x36, 0xac, 0x7a, 0xab, 0x36, 0x6e, 0x3a, 0xde,
+	0x9a, 0x23, 0x9e, 0x03, 0xd3, 0x04, 0x39, 0x26, 0x72, 0xb3, 0xc2, 0x49, 0x61, 0x71, 0xb6, 0x94,
+	0x69, 0xb7, 0xa4, 0x43, 0x3b, 0xd0, 0xae, 0x17, 0x65, 0xf6, 0x5e, 0xd6, 0xf9, 0x06, 0xf1, 0x0e,
+	0x98, 0xf6, 0x6d, 0x91, 0xec, 0xe4, 0xc9, 0xd4, 0xe2, 0x81, 0x25, 0x55, 0x4d, 0xc8, 0x80, 0x4a,
+	0x4d, 0x05, 0x56, 0x74, 0x0a, 0x2b, 0x4d, 0x3d, 0x6f, 0x49, 0x13, 0x3a, 0xe7, 0x10, 0xcb, 0x60,
+	0xc6, 0xc3, 0x5b, 0xc8, 0x31, 0x2c, 0x27, 0x9b, 0x3a, 0x29, 0x2c, 0x1e, 0x58, 0x3a, 0xae, 0x32,
+	0xef, 0x55, 0x9a, 0x8b, 0x90, 0xe7, 0x26, 0xb6, 0x9c, 0xd2, 0x3c, 0x85, 0xb6, 0x5b, 0xd2, 0x11,
+	0xe6, 0x59, 0x00, 0x94, 0xf5, 0xfd, 0xfe, 0xc7, 0x35, 0x47, 0xb4, 0xc1, 0x1c, 0x7b, 0x8b, 0x9b,
+	0x9e, 0x61, 0x5b, 0x8e, 0x01, 0x7d, 0xdb, 0xd9, 0x29, 0x3f, 0xaa, 0x15, 0x8a, 0xff, 0xb3, 0x25,
+	0x1d, 0x63, 0x16, 0x88, 0xb9, 0xa5, 0x5a, 0x58, 0xb3, 0xa1, 0xb7, 0xa9, 0xae, 0x39, 0x5e, 0xbb,
+	0x25, 0x2d, 0x74, 0x13, 0xf7, 0x52, 0xc8, 0x7a, 0xc6, 0x7f, 0xbd, 0xde, 0xf4, 0xca, 0x96, 0xc3,
+	0x42, 0x2a, 0x5e, 0xfd, 0xfa, 0xf5, 0xd3, 0xf3, 0x3c, 0x31, 0xbb, 0xaf, 0x9f, 0x9e, 0x5f, 0x8c,
+	0x2b, 0x13, 0x2d, 0x8f, 0x82, 0x68, 0xba, 0x15, 0x46, 0xa5, 0x58, 0x8e, 0xfc, 0x7b, 0x0a, 0x9c,
+	0x88, 0xab, 0x84, 0x8e, 0x48, 0x03, 0x3b, 0x04, 0x89, 0x15, 0x90, 0xee, 0xb8, 0xc1, 0xa3, 0x60,
+	0xb5, 0xb9, 0x3a, 0x2c, 0x8a, 0xf9, 0x68, 0x14, 0x41, 0x04, 0x87, 0x83, 0x08, 0x98, 0x35, 0xf1,
+	0x89, 0x00, 0x44, 0x0f, 0x6e, 0x21, 0xd7, 0x78, 0x80, 0x10, 0x31, 0xaa, 0x9b, 0xd0, 0xad, 0x21,
+	0x93, 0xd7, 0x35, 0xa1, 0x0e, 0x65, 0x5e, 0x87, 0xe3, 0xdc, 0x50, 0x1f, 0x85, 0xfc, 0xeb, 0x5f,
+	0xd2, 0x62, 0xcd, 0xf2, 0x36, 0x9b, 0x15, 0xb5, 0x8a, 0x6d, 0x8d, 0xeb, 0x91, 0xfd, 0x53, 0x88,
+	0xb9, 0xa5, 0x79, 0x3b, 0x0d, 0x44, 0x7c, 0x36, 0xa2, 0xa7, 0x7d, 0x82, 0xdb, 0x08, 0x91, 0x9b,
+	0x0c, 0x2e, 0xfe, 0x24, 0x80, 0xa3, 0xa4, 0xe1, 0x22, 0x68, 0xf6, 0x7a, 0x96, 0x1a, 0xe6, 0xd9,
+	0xa7, 0xdc, 0xb3, 0x1c, 0xd7, 0x6e, 0x3f, 0xc7, 0x78, 0xae, 0x65, 0x18, 0x43, 0x97, 0x6f, 0xf2,
+	0x37, 0x29, 0x90, 0xa7, 0x95, 0x6b, 0xd4, 0x2d, 0xcf, 0x57, 0xf4, 0x9e, 0xba, 0xe9, 0x6e, 0xa4,
+	0x9b, 0x2e, 0x8d, 0xdc, 0x4d, 0x1d, 0x07, 0x22, 0x2d, 0x75, 0x1d, 0x1c, 0x0e, 0x3a, 0xc3, 0x30,
+	0x91, 0x83, 0x6d, 0xbf, 0xb1, 0x66, 0x4b, 0xc7, 0xdb, 0x2d, 0xe9, 0x58, 0x6f, 0xe7, 0xb0, 0x75,
+	0x59, 0x3f, 0xc8, 0xfb, 0xe7, 0x16, 0x7d, 0x7c, 0xdb, 0x4d, 0x74, 0x29, 0xd2, 0x44, 0xa7, 0x62,
+	0x9b, 0x88, 0x46, 0xdb, 0xd5, 0x3f, 0x3f, 0x08, 0xe0, 0x6c, 0x72, 0x15, 0xde, 0x66, 0x27, 0xc9,
+	0xbb, 0x29, 0x70, 0xac, 0xbf, 0x9d, 0xd7, 0x9b, 0xde, 0x38, 0x5a, 0x28, 0x47, 0xb4, 0xa0, 0x8d,
+	0xa8, 0x85, 0xf5, 0x66, 0xac, 0x0e, 0xbe, 0x00, 0x47, 0xc3, 0x3a, 0xdb, 0x70, 0x3b, 0x08, 0x9d,
+	0x89, 0x61, 0x79, 0x58, 0xe8, 0xb9, 0x88, 0x52, 0x3a, 0x0c, 0xb2, 0x9e, 0xe6, 0x72, 0x29, 0xc3,
+	0x6d, 0x3e, 0x49, 0x36, 0xc0, 0x6c, 0x98, 0x24, 0x5f, 0x27, 0x89, 0x5d, 0x9a, 0xe5, 0x5d, 0x9a,
+	0x8e, 0xa4, 0x57, 0xd6, 0x67, 0x82, 0xbc, 0x16, 0xaf, 0x45, 0x54, 0x71, 0x6e, 0xb4, 0xd1, 0x4a,
+	0x59, 0xbe, 0x14, 0xc0, 0xbb, 0xb1, 0xc5, 0x08, 0x25, 0x61, 0x80, 0x23, 0x61, 0x60, 0x3d, 0x8a,
+	0xb8, 0x32, 0x2c, 0x2d, 0xef, 0x44, 0xd2, 0x12, 0xa4, 0xe4, 0x10, 0x4f, 0x09, 0xd7, 0xc3, 0xb7,
+	0x29, 0x20, 0x25, 0xc9, 0x73, 0x4c, 0x65, 0xe8, 0x11, 0x65, 0x5c, 0x1e, 0x5d, 0x19, 0x03, 0xc7,
+	0x44, 0x09, 0x1c, 0xe9, 0xe8, 0xba, 0x7b, 0x4e, 0xe4, 0xa2, 0x61, 0x86, 0x1b, 0x82, 0x30, 0xd7,
+	0x9b, 0x1e, 0x9b, 0x14, 0x03, 0x24, 0x36, 0xf5, 0x3f, 0x48, 0xac, 0x78, 0x39, 0x22, 0x88, 0xd3,
+	0x43, 0xc7, 0x04, 0xd5, 0xc2, 0xae, 0x00, 0xde, 0x1f, 0x52, 0x88, 0xb7, 0xa7, 0x8a, 0xef, 0x27,
+	0xc1, 0x3c, 0x75, 0x06, 0xb1, 0xf4, 0x6d, 0x40, 0xcb, 0xbd, 0xcf, 0x8f, 0xbe, 0x71, 0xd4, 0xf0,
+	0x58, 0x00, 0x73, 0x7e, 0x3d, 0x8c, 0x06, 0xb4, 0x5c, 0x23, 0x3c, 0x7e, 0x47, 0xba, 0x90, 0xf5,
+	0x59, 0x2e, 0x9d, 0xe2, 0xdd, 0xc8, 0xe7, 0x76, 0x1c, 0xb3, 0xac, 0x67, 0xcc, 0x28, 0xae, 0xb8,
+	0x12, 0x29, 0x48, 0xec, 0x1d, 0x95, 0x20, 0x4f, 0xf1, 0xa1, 0x0a, 0x65, 0x54, 0x7c, 0x46, 0x85,
+	0x32, 0x2e, 0x03, 0x69, 0x40, 0x2a, 0xc2, 0x7a, 0x64, 0xc1, 0x7e, 0xd2, 0xac, 0x56, 0x11, 0x21,
+	0x7e, 0x4e, 0x66, 0xf4, 0xe0, 0x51, 0xfe, 0x67, 0x12, 0x9c, 0x66, 0xe8, 0x00, 0x74, 0x6f, 0x13,
+	0xba, 0x68, 0xb5, 0xe6, 0x22, 0x64, 0x23, 0xc7, 0xbb, 0x8d, 0x5d, 0x26, 0xd0, 0x31, 0xb2, 0x7a,
+	0x16, 0xec, 0x63, 0x5d, 0x30, 0xe9, 0xef, 0x4c, 0xb7, 0x5b, 0xd2, 0xc1, 0xae, 0x8c, 0xc8, 0x3a,
+	0x5b, 0x16, 0x3f, 0x07, 0x07, 0xc9, 0x96, 0x65, 0x1b, 0x0d, 0xe4, 0x56, 0x51, 0x38, 0x4f, 0x8b,
+	0x5c, 0x22, 0x0b, 0xfd, 0x12, 0xb9, 0x83, 0x6a, 0xb0, 0xba, 0x73, 0x0b, 0x55, 0xdb, 0x2d, 0xe9,
+	0x28, 0xb7, 0xdd, 0x45, 0x20, 0xeb, 0x07, 0xe8, 0xe3, 0x06, 0x7b, 0x12, 0x8b, 0x9c, 0x1e, 0x9a,
+	0xa6, 0x4b, 0x23, 0x67, 0xbd, 0x34, 0x1f, 0xc1, 0xf2, 0x55, 0x8e, 0x5d, 0x65, 0x4f, 0xc5, 0x4f,
+	0x22, 0x15, 0x59, 0x1e, 0x54, 0x91, 0xb0, 0x0c, 0x0a, 0xa1, 0x79, 0x53, 0x60, 0x90, 0x38, 0xe5,
+	0x01, 0x76, 0x59, 0xbd, 0x64, 0x15, 0x5c, 0x18, 0x25, 0xc5, 0x41, 0xb5, 0xe4, 0xdf, 0x04, 0xb0,
+	0xc0, 0x00, 0x3a, 0xaa, 0x59, 0xc4, 0x43, 0x2e, 0x32, 0x57, 0xeb, 0x75, 0xbc, 0x83, 0xcc, 0x0d,
+	0x8c, 0xeb, 0xe3, 0x94, 0xe2, 0x03, 0xb0, 0x9f, 0x7a, 0x6c, 0x58, 0xa6, 0x5f, 0x8c, 0xa9, 0x92,
+	0xd8, 0x6e, 0x49, 0x87, 0xd9, 0x5e, 0xbe, 0x20, 0xeb, 0xd3, 0xf4, 0xd3, 0x9a, 0x59, 0xbc, 0x1e,
+	0x09, 0x5a, 0x1b, 0x14, 0xb4, 0x1b, 0xba, 0xa5, 0x40, 0xe6, 0x97, 0x42, 0xb7, 0xc8, 0x67, 0xc0,
+	0xa9, 0x04, 0xbf, 0xc3, 0xf8, 0xfe, 0x9d, 0x04, 0x99, 0xfe, 0xb6, 0xfd, 0x08, 0x4c, 0xfb, 0xe9,
+	0xba, 0xc8, 0xa3, 0x3a, 0xd3, 0x6e, 0x49, 0x52, 0x97, 0x6c, 0x2e, 0xca, 0x17, 0x4c, 0xd4, 0x70,
+	0x51, 0x15, 0x7a, 0xc8, 0x2c, 0xca, 0x9e, 0xdb, 0x44, 0x72, 0x56, 0xd0, 0x39, 0x28, 0x84, 0x17,
+	0xb2, 0x93, 0xb1, 0xf0, 0x42, 0x12, 0xbc, 0x20, 0xde, 0x07, 0xb3, 0x9d, 0xee, 0x4f, 0xf5, 0xcc,
+	0xaa, 0x21, 0x42, 0x4c, 0x47, 0xae, 0xee, 0xf4, 0xe8, 0xed, 0xc4, 0xd4, 0x73, 0x1f, 0xcc, 0x4e,
+	0x8d, 0x77, 0x7d, 0xbc, 0x01, 0x7a, 0x4f, 0x89, 0xec, 0xbe, 0x31, 0x8f, 0x95, 0xa5, 0x67, 0x33,
+	0x20, 0x55, 0x26, 0x35, 0xf1, 0x2b, 0x01, 0x64, 0xfa, 0x6f, 0xd7, 0x85, 0xc4, 0xf9, 0x16, 0xf7,
+	0xa5, 0x2a, 0x77, 0x6d, 0x6c, 0x48, 0x38, 0x84, 0x1e, 0x0b, 0x40, 0x8c, 0x39, 0xbc, 0x97, 0xc6,
+	0x64, 0x5c, 0x6f, 0x7a, 0xb9, 0xe2, 0xf8, 0x98, 0xd0, 0x8d, 0x9f, 0x05, 0xb0, 0x90, 0xf4, 0x95,
+	0x63, 0x79, 0x28, 0xf7, 0x60, 0x70, 0xee, 0xe6, 0x1e, 0xc0, 0xa1, 0x87, 0xbf, 0x08, 0xe0, 0x44,
+	0xe2, 0x7d, 0x67, 0xe5, 0x8d, 0xad, 0xd0, 0xe4, 0xdd, 0xda, 0x0b, 0x3a, 0x74, 0x72, 0x57, 0x00,
+	0x73, 0xb1, 0xc7, 0xef, 0xe5, 0xa1, 0xf4, 0x31, 0xa8, 0xdc, 0xca, 0x9b, 0xa0, 0x42, 0x67, 0x9e,
+	0x09, 0xe0, 0xbd, 0xe1, 0x47, 0xd8, 0xea, 0x08, 0x36, 0x92, 0x29, 0x72, 0x6b, 0x7b, 0xa6, 0x08,
+	0x7d, 0x7e, 0x22, 0x80, 0xec, 0xc0, 0x11, 0x7f, 0x75, 0x04, 0x3b, 0xb1, 0xc8, 0xdc, 0x8d, 0x37,
+	0x45, 0x06, 0x8e, 0x95, 0xee, 0x3e, 0x7f, 0x99, 0x17, 0x5e, 0xbc, 0xcc, 0x0b, 0x7f, 0xbf, 0xcc,
+	0x0b, 0x3f, 0xbe, 0xca, 0x4f, 0xbc, 0x78, 0x95, 0x9f, 0xf8, 0xe3, 0x55, 0x7e, 0xe2, 0xb3, 0x2b,
+	0x5d, 0xdf, 0xf6, 0xb9, 0x15, 0xa5, 0x0e, 0x2b, 0x24, 0x78, 0xd0, 0x1e, 0x2e, 0x7d, 0xa8, 0x6d,
+	0xf7, 0x1c, 0x17, 0xfe, 0x4f, 0x00, 0x95, 0x69, 0xff, 0x47, 0xb3, 0x4b, 0xff, 0x0d, 0x00, 0xa6,
+	0x0a, 0xe3, 0xf3, 0x09, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1294,6 +1320,34 @@ func (m *MsgSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpreadFeesCharged) > 0 {
+		for iNdEx := len(m.SpreadFeesCharged) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadFeesCharged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TakerFeesCharged) > 0 {
+		for iNdEx := len(m.TakerFeesCharged) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeesCharged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.TokenOutAmount.Size()
 		i -= size
@@ -1907,6 +1961,18 @@ func (m *MsgSwapExactAmountInResponse) Size() (n int) {
 	_ = l
 	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.TakerFeesCharged) > 0 {
+		for _, e := range m.TakerFeesCharged {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SpreadFeesCharged) > 0 {
+		for _, e := range m.SpreadFeesCharged {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2383,6 +2449,74 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesCharged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeesCharged = append(m.TakerFeesCharged, types.Coin{})
+			if err := m.TakerFeesCharged[len(m.TakerFeesCharged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFeesCharged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadFeesCharged = append(m.SpreadFeesCharged, types.Coin{})
+			if err := m.SpreadFeesCharged[len(m.SpreadFeesCharged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
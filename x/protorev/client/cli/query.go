@@ -31,6 +31,9 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryInfoByPoolTypeCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllProtocolRevenueCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProfitDistributionsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryExecutionGasLimitCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryRevertedTradeAttemptsCmd)
 
 	return cmd
 }
@@ -177,3 +180,27 @@ func parseRoute(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, e
 	}
 	return route, osmocli.UsedArg, err
 }
+
+// NewQueryProfitDistributionsCmd returns the command to query the cumulative profits distributed by protorev
+func NewQueryProfitDistributionsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevProfitDistributionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "profit-distributions",
+		Short: "Query the cumulative profits distributed by protorev, per destination",
+	}, &types.QueryGetProtoRevProfitDistributionsRequest{}
+}
+
+// NewQueryExecutionGasLimitCmd returns the command to query the gas limit of the execution of the backrun of a tx
+func NewQueryExecutionGasLimitCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevExecutionGasLimitRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "execution-gas-limit",
+		Short: "Query the gas limit of the execution of the backrun of a tx",
+	}, &types.QueryGetProtoRevExecutionGasLimitRequest{}
+}
+
+// NewQueryRevertedTradeAttemptsCmd returns the command to query the number of reverted backrun attempts
+func NewQueryRevertedTradeAttemptsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevRevertedTradeAttemptsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "reverted-trade-attempts",
+		Short: "Query the number of backrun attempts protorev reverted, per reason",
+	}, &types.QueryGetProtoRevRevertedTradeAttemptsRequest{}
+}
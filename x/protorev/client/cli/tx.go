@@ -26,6 +26,7 @@ func NewCmdTx() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, CmdSetDeveloperAccount)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerTx)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerBlock)
+	osmocli.AddTxCmd(txCmd, CmdSetExecutionGasLimit)
 	txCmd.AddCommand(
 		CmdSetDeveloperHotRoutes().BuildCommandCustomFn(),
 		CmdSetInfoByPoolType().BuildCommandCustomFn(),
@@ -140,6 +141,26 @@ func CmdSetMaxPoolPointsPerBlock() (*osmocli.TxCliDesc, *types.MsgSetMaxPoolPoin
 	}, &types.MsgSetMaxPoolPointsPerBlock{}
 }
 
+// CmdSetExecutionGasLimit implements the command to set the gas limit of the execution of the backrun of a tx
+func CmdSetExecutionGasLimit() (*osmocli.TxCliDesc, *types.MsgSetExecutionGasLimit) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-execution-gas-limit",
+		Short:   "set the gas limit of the execution of the backrun of a tx",
+		NumArgs: 1,
+		ParseAndBuildMsg: func(clientCtx client.Context, args []string, flags *pflag.FlagSet) (sdk.Msg, error) {
+			executionGasLimit, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.MsgSetExecutionGasLimit{
+				ExecutionGasLimit: executionGasLimit,
+				Admin:             clientCtx.GetFromAddress().String(),
+			}, nil
+		},
+	}, &types.MsgSetExecutionGasLimit{}
+}
+
 // CmdSetInfoByPoolType implements the command to set the pool information used throughout the module
 func CmdSetInfoByPoolType() *osmocli.TxCliDesc {
 	desc := osmocli.TxCliDesc{
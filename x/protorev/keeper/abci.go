@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock emits a summary of all of the trades executed by the module in the current block.
+// No event is emitted if no trades were executed.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	numberOfTrades := k.GetBlockNumberOfTrades(ctx)
+	if numberOfTrades == 0 {
+		return
+	}
+
+	EmitBlockSummaryEvent(ctx, numberOfTrades, k.GetAllBlockProfits(ctx))
+}
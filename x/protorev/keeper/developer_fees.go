@@ -2,12 +2,16 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v26/x/txfees/types"
 )
 
 // DistributeProfit sends the developer fee from the module account to the developer account
-// and burns the remaining profit if denominated in osmo.
+// and distributes the remaining profit according to the profit distribution param.
+// The distributed profits are added to the cumulative profit distributions.
 func (k Keeper) DistributeProfit(ctx sdk.Context, arbProfits sdk.Coins) error {
 	// Developer account must be set in order to be able to withdraw developer fees
 	developerAccount, err := k.GetDeveloperAccount(ctx)
@@ -49,27 +53,86 @@ func (k Keeper) DistributeProfit(ctx sdk.Context, arbProfits sdk.Coins) error {
 	// Remove the developer profit from the remaining arb profits
 	remainingProfit = arbProfits.Sub(devProfit...)
 
-	// If the remaining arb profits has the OSMO denom for one of the coins, burn the OSMO by sending to the null address
-	arbProfitsOsmoCoin := sdk.NewCoin(types.OsmosisDenomination, remainingProfit.AmountOf(types.OsmosisDenomination))
-	if arbProfitsOsmoCoin.IsPositive() {
+	distributed := splitProfit(remainingProfit, k.GetParams(ctx).ProfitDistribution)
+	distributed.Developer = sdk.NewCoins(devProfit...)
+
+	// Burn the burned profit by sending it to the null address
+	if !distributed.Burned.IsZero() {
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(
 			ctx,
 			types.ModuleName,
 			types.DefaultNullAddress,
-			sdk.NewCoins(arbProfitsOsmoCoin),
+			distributed.Burned,
 		)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Remove the burned OSMO from the remaining arb profits
-	remainingProfit = remainingProfit.Sub(arbProfitsOsmoCoin)
+	// Send the stakers profit to the txfees fee collectors. OSMO is sent to the fee collector and distributed
+	// to stakers directly, other denoms are swapped to OSMO by txfees at the end of the epoch first.
+	if !distributed.Stakers.IsZero() {
+		if err := k.sendToStakers(ctx, distributed.Stakers); err != nil {
+			return err
+		}
+	}
 
-	// Send all remaining arb profits to the community pool
-	return k.distributionKeeper.FundCommunityPool(
+	// Send the community pool profit to the community pool
+	err = k.distributionKeeper.FundCommunityPool(
 		ctx,
-		remainingProfit,
+		distributed.CommunityPool,
 		k.accountKeeper.GetModuleAddress(types.ModuleName),
 	)
+	if err != nil {
+		return err
+	}
+
+	k.addProfitDistributions(ctx, distributed)
+	return nil
+}
+
+// splitProfit splits the profit between burning, the community pool and stakers by the weights of the profit
+// distribution. The community pool receives the truncation remainder.
+// If the profit distribution is the legacy one, OSMO profits are burned and all other profits are sent to the community pool.
+func splitProfit(profit sdk.Coins, distribution types.ProfitDistribution) types.ProfitDistributions {
+	if distribution.IsLegacy() {
+		burned := sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, profit.AmountOf(types.OsmosisDenomination)))
+		return types.ProfitDistributions{
+			Burned:        burned,
+			CommunityPool: profit.Sub(burned...),
+		}
+	}
+
+	burned := sdk.NewCoins()
+	stakers := sdk.NewCoins()
+	for _, coin := range profit {
+		burned = burned.Add(sdk.NewCoin(coin.Denom, weightedAmount(coin.Amount, distribution.BurnWeight)))
+		stakers = stakers.Add(sdk.NewCoin(coin.Denom, weightedAmount(coin.Amount, distribution.StakerWeight)))
+	}
+
+	return types.ProfitDistributions{
+		Burned:        burned,
+		CommunityPool: profit.Sub(burned...).Sub(stakers...),
+		Stakers:       stakers,
+	}
+}
+
+func weightedAmount(amount osmomath.Int, weight osmomath.Dec) osmomath.Int {
+	return amount.ToLegacyDec().Mul(weight).TruncateInt()
+}
+
+// sendToStakers sends OSMO to the fee collector and all other denoms to the txfees non native fee collector.
+func (k Keeper) sendToStakers(ctx sdk.Context, profit sdk.Coins) error {
+	osmoProfit := sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, profit.AmountOf(types.OsmosisDenomination)))
+	if !osmoProfit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, osmoProfit); err != nil {
+			return err
+		}
+	}
+
+	nonOsmoProfit := profit.Sub(osmoProfit...)
+	if !nonOsmoProfit.IsZero() {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, txfeestypes.NonNativeTxFeeCollectorName, nonOsmoProfit)
+	}
+	return nil
 }
@@ -3,11 +3,13 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v26/x/txfees/types"
 )
 
 var (
@@ -161,6 +163,128 @@ func (suite *KeeperTestSuite) TestDistributeProfit() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestDistributeProfitWithProfitDistribution() {
+	cases := []struct {
+		description             string
+		profitDistribution      types.ProfitDistribution
+		denom                   string
+		expectedDistributions   types.ProfitDistributions
+		expectedStakerCollector string
+	}{
+		{
+			description:        "Default profit distribution burns osmo",
+			profitDistribution: types.DefaultProfitDistribution,
+			denom:              types.OsmosisDenomination,
+			expectedDistributions: types.ProfitDistributions{
+				Developer: sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 50)),
+				Burned:    sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 950)),
+			},
+			expectedStakerCollector: authtypes.FeeCollectorName,
+		},
+		{
+			description: "Split osmo profits, stakers are paid through the fee collector",
+			profitDistribution: types.ProfitDistribution{
+				BurnWeight:          osmomath.MustNewDecFromStr("0.5"),
+				CommunityPoolWeight: osmomath.MustNewDecFromStr("0.3"),
+				StakerWeight:        osmomath.MustNewDecFromStr("0.2"),
+			},
+			denom: types.OsmosisDenomination,
+			expectedDistributions: types.ProfitDistributions{
+				Developer:     sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 50)),
+				Burned:        sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 475)),
+				CommunityPool: sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 285)),
+				Stakers:       sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 190)),
+			},
+			expectedStakerCollector: authtypes.FeeCollectorName,
+		},
+		{
+			description: "Split non-osmo profits, community pool receives the truncation remainder",
+			profitDistribution: types.ProfitDistribution{
+				BurnWeight:          osmomath.MustNewDecFromStr("0.333333333333333333"),
+				CommunityPoolWeight: osmomath.MustNewDecFromStr("0.333333333333333334"),
+				StakerWeight:        osmomath.MustNewDecFromStr("0.333333333333333333"),
+			},
+			denom: usdcDenom,
+			expectedDistributions: types.ProfitDistributions{
+				Developer:     sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 50)),
+				Burned:        sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 316)),
+				CommunityPool: sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 318)),
+				Stakers:       sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 316)),
+			},
+			expectedStakerCollector: txfeestypes.NonNativeTxFeeCollectorName,
+		},
+		{
+			description: "All profits to stakers",
+			profitDistribution: types.ProfitDistribution{
+				BurnWeight:          osmomath.ZeroDec(),
+				CommunityPoolWeight: osmomath.ZeroDec(),
+				StakerWeight:        osmomath.OneDec(),
+			},
+			denom: usdcDenom,
+			expectedDistributions: types.ProfitDistributions{
+				Developer: sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 50)),
+				Stakers:   sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 950)),
+			},
+			expectedStakerCollector: txfeestypes.NonNativeTxFeeCollectorName,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.description, func() {
+			suite.SetupNoPools()
+
+			params := suite.App.ProtoRevKeeper.GetParams(suite.Ctx)
+			params.ProfitDistribution = tc.profitDistribution
+			suite.App.ProtoRevKeeper.SetParams(suite.Ctx, params)
+
+			account := apptesting.CreateRandomAccounts(1)[0]
+			suite.App.ProtoRevKeeper.SetDeveloperAccount(suite.Ctx, account)
+
+			commAccount := suite.App.AppKeepers.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName)
+			commBalanceBefore := suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, commAccount, tc.denom)
+			stakerAccount := suite.App.AppKeepers.AccountKeeper.GetModuleAddress(tc.expectedStakerCollector)
+			stakerBalanceBefore := suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, stakerAccount, tc.denom)
+
+			// distribute twice to check that the distributions are cumulative
+			for i := 0; i < 2; i++ {
+				err := suite.pseudoExecuteTrade(tc.denom, arbProfit, 1000)
+				suite.Require().NoError(err)
+				err = suite.App.ProtoRevKeeper.DistributeProfit(suite.Ctx, sdk.NewCoins(sdk.NewCoin(tc.denom, arbProfit)))
+				suite.Require().NoError(err)
+			}
+
+			double := func(coins sdk.Coins) sdk.Coins {
+				if coins.Empty() {
+					return nil
+				}
+				return coins.Add(coins...)
+			}
+			expected := types.ProfitDistributions{
+				Developer:     double(tc.expectedDistributions.Developer),
+				Burned:        double(tc.expectedDistributions.Burned),
+				CommunityPool: double(tc.expectedDistributions.CommunityPool),
+				Stakers:       double(tc.expectedDistributions.Stakers),
+			}
+
+			res, err := suite.queryClient.GetProtoRevProfitDistributions(suite.Ctx, &types.QueryGetProtoRevProfitDistributionsRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(expected, res.ProfitDistributions)
+
+			developerFee := suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, account, tc.denom)
+			suite.Require().Equal(expected.Developer.AmountOf(tc.denom), developerFee.Amount)
+
+			burnBal := suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, types.DefaultNullAddress, tc.denom)
+			suite.Require().Equal(expected.Burned.AmountOf(tc.denom), burnBal.Amount)
+
+			commBalanceAfter := suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, commAccount, tc.denom)
+			suite.Require().Equal(expected.CommunityPool.AmountOf(tc.denom), commBalanceAfter.Sub(commBalanceBefore).Amount)
+
+			stakerBalanceAfter := suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, stakerAccount, tc.denom)
+			suite.Require().Equal(expected.Stakers.AmountOf(tc.denom), stakerBalanceAfter.Sub(stakerBalanceBefore).Amount)
+		})
+	}
+}
+
 // pseudoExecuteTrade is a helper function to execute a trade given denom of profit, profit, and days since genesis
 func (suite *KeeperTestSuite) pseudoExecuteTrade(denom string, profit osmomath.Int, daysSinceGenesis uint64) error {
 	// Initialize the number of days since genesis
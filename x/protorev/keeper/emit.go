@@ -10,13 +10,24 @@ import (
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
 )
 
 // EmitBackrunEvent updates and emits a backrunEvent
-func EmitBackrunEvent(ctx sdk.Context, pool SwapToBackrun, inputCoin sdk.Coin, profit, tokenOutAmount osmomath.Int, remainingTxPoolPoints, remainingBlockPoolPoints uint64) {
+func EmitBackrunEvent(ctx sdk.Context, pool SwapToBackrun, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, profit, tokenOutAmount osmomath.Int, beneficiary sdk.AccAddress, remainingTxPoolPoints, remainingBlockPoolPoints uint64) {
 	// Get tx hash
 	txHash := strings.ToUpper(hex.EncodeToString(tmhash.Sum(ctx.TxBytes())))
+
+	// The route denoms start with the arb denom and are followed by the token out denom of every hop
+	routePoolIds := make([]string, 0, len(route))
+	routeDenoms := make([]string, 0, len(route)+1)
+	routeDenoms = append(routeDenoms, inputCoin.Denom)
+	for _, hop := range route {
+		routePoolIds = append(routePoolIds, strconv.FormatUint(hop.PoolId, 10))
+		routeDenoms = append(routeDenoms, hop.TokenOutDenom)
+	}
+
 	// Update the backrun event and add it to the context
 	backrunEvent := sdk.NewEvent(
 		types.TypeEvtBackrun,
@@ -31,6 +42,20 @@ func EmitBackrunEvent(ctx sdk.Context, pool SwapToBackrun, inputCoin sdk.Coin, p
 		sdk.NewAttribute(types.AttributeKeyProtorevAmountIn, inputCoin.Amount.String()),
 		sdk.NewAttribute(types.AttributeKeyProtorevAmountOut, tokenOutAmount.String()),
 		sdk.NewAttribute(types.AttributeKeyProtorevArbDenom, inputCoin.Denom),
+		sdk.NewAttribute(types.AttributeKeyRoutePoolIds, strings.Join(routePoolIds, ",")),
+		sdk.NewAttribute(types.AttributeKeyRouteDenoms, strings.Join(routeDenoms, ",")),
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, beneficiary.String()),
 	)
 	ctx.EventManager().EmitEvent(backrunEvent)
 }
+
+// EmitBlockSummaryEvent emits an event summarizing all of the trades executed by the module in the current block
+func EmitBlockSummaryEvent(ctx sdk.Context, numberOfTrades uint64, profits sdk.Coins) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtBlockSummary,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		sdk.NewAttribute(types.AttributeKeyNumberOfTrades, strconv.FormatUint(numberOfTrades, 10)),
+		sdk.NewAttribute(types.AttributeKeyProfits, profits.String()),
+	))
+}
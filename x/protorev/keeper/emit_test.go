@@ -10,6 +10,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v26/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
 )
@@ -17,6 +18,8 @@ import (
 func (s *KeeperTestSuite) TestBackRunEvent() {
 	testcases := map[string]struct {
 		pool                     keeper.SwapToBackrun
+		route                    poolmanagertypes.SwapAmountInRoutes
+		beneficiary              sdk.AccAddress
 		remainingTxPoolPoints    uint64
 		remainingBlockPoolPoints uint64
 		profit                   osmomath.Int
@@ -29,6 +32,12 @@ func (s *KeeperTestSuite) TestBackRunEvent() {
 				TokenInDenom:  appparams.BaseCoinUnit,
 				TokenOutDenom: "uatom",
 			},
+			route: poolmanagertypes.SwapAmountInRoutes{
+				{PoolId: 1, TokenOutDenom: "uatom"},
+				{PoolId: 2, TokenOutDenom: "uusdc"},
+				{PoolId: 3, TokenOutDenom: appparams.BaseCoinUnit},
+			},
+			beneficiary:              s.App.AccountKeeper.GetModuleAddress(types.ModuleName),
 			remainingTxPoolPoints:    100,
 			remainingBlockPoolPoints: 100,
 			profit:                   osmomath.NewInt(100),
@@ -52,9 +61,12 @@ func (s *KeeperTestSuite) TestBackRunEvent() {
 				sdk.NewAttribute(types.AttributeKeyProtorevAmountIn, tc.inputCoin.Amount.String()),
 				sdk.NewAttribute(types.AttributeKeyProtorevAmountOut, tc.tokenOutAmount.String()),
 				sdk.NewAttribute(types.AttributeKeyProtorevArbDenom, tc.inputCoin.Denom),
+				sdk.NewAttribute(types.AttributeKeyRoutePoolIds, "1,2,3"),
+				sdk.NewAttribute(types.AttributeKeyRouteDenoms, appparams.BaseCoinUnit+",uatom,uusdc,"+appparams.BaseCoinUnit),
+				sdk.NewAttribute(types.AttributeKeyBeneficiary, tc.beneficiary.String()),
 			)
 
-			keeper.EmitBackrunEvent(s.Ctx, tc.pool, tc.inputCoin, tc.profit, tc.tokenOutAmount, tc.remainingTxPoolPoints, tc.remainingBlockPoolPoints)
+			keeper.EmitBackrunEvent(s.Ctx, tc.pool, tc.route, tc.inputCoin, tc.profit, tc.tokenOutAmount, tc.beneficiary, tc.remainingTxPoolPoints, tc.remainingBlockPoolPoints)
 
 			// Get last event emitted and ensure it is the expected event
 			actualEvent := s.Ctx.EventManager().Events()[len(s.Ctx.EventManager().Events())-1]
@@ -62,3 +74,62 @@ func (s *KeeperTestSuite) TestBackRunEvent() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestBlockSummaryEvent() {
+	testcases := map[string]struct {
+		trades         []sdk.Coin
+		expectEmission bool
+		expectedTrades uint64
+		expectedProfit sdk.Coins
+	}{
+		"no trades in the block": {
+			expectEmission: false,
+		},
+		"single trade": {
+			trades:         []sdk.Coin{sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(100))},
+			expectEmission: true,
+			expectedTrades: 1,
+			expectedProfit: sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(100))),
+		},
+		"multiple trades across denoms": {
+			trades: []sdk.Coin{
+				sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(100)),
+				sdk.NewCoin("uatom", osmomath.NewInt(50)),
+				sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(25)),
+			},
+			expectEmission: true,
+			expectedTrades: 3,
+			expectedProfit: sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(125)), sdk.NewCoin("uatom", osmomath.NewInt(50))),
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+			route := poolmanagertypes.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: "uatom"}, {PoolId: 2, TokenOutDenom: appparams.BaseCoinUnit}}
+			for _, trade := range tc.trades {
+				err := s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, trade.Denom, trade.Amount)
+				s.Require().NoError(err)
+			}
+
+			s.Require().Equal(tc.expectedTrades, s.App.ProtoRevKeeper.GetBlockNumberOfTrades(s.Ctx))
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			s.App.ProtoRevKeeper.EndBlock(s.Ctx)
+
+			if !tc.expectEmission {
+				s.Require().Empty(s.Ctx.EventManager().Events())
+				return
+			}
+
+			expectedEvent := sdk.NewEvent(
+				types.TypeEvtBlockSummary,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(s.Ctx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeyNumberOfTrades, strconv.FormatUint(tc.expectedTrades, 10)),
+				sdk.NewAttribute(types.AttributeKeyProfits, tc.expectedProfit.String()),
+			)
+			s.Require().Equal(sdk.Events{expectedEvent}, s.Ctx.EventManager().Events())
+		})
+	}
+}
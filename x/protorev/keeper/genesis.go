@@ -100,6 +100,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	} else {
 		k.SetCyclicArbProfitTrackerStartHeight(ctx, ctx.BlockHeight())
 	}
+
+	// Set the cumulative profits distributed by Protorev.
+	k.SetProfitDistributions(ctx, genState.ProfitDistributions)
+
+	// Configure the gas limit of the execution of the backrun of a tx. Genesis files exported before the limit
+	// was added do not set it, in which case the default limit is used.
+	if genState.ExecutionGasLimit != 0 {
+		if err := k.SetExecutionGasLimit(ctx, genState.ExecutionGasLimit); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the number of backrun attempts that were reverted, per reason.
+	k.SetRevertedTradeAttempts(ctx, genState.RevertedTradeAttempts)
 }
 
 // ExportGenesis returns the module's exported genesis. ExportGenesis intentionally ignores a few of the errors thrown
@@ -176,5 +190,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 	genesis.CyclicArbTracker = &cyclicArbTracker
 
+	// Export the cumulative profits distributed by Protorev.
+	genesis.ProfitDistributions = k.GetProfitDistributions(ctx)
+
+	// Export the gas limit of the execution of the backrun of a tx.
+	genesis.ExecutionGasLimit = k.GetExecutionGasLimit(ctx)
+
+	// Export the number of backrun attempts that were reverted, per reason.
+	genesis.RevertedTradeAttempts = k.GetRevertedTradeAttempts(ctx)
+
 	return genesis
 }
@@ -1,6 +1,10 @@
 package keeper_test
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
+)
 
 // TestInitGenesis tests the initialization and export of the module's genesis state.
 func (s *KeeperTestSuite) TestInitGenesis() {
@@ -68,4 +72,24 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 
 	cyclicArbProfitAccountingHeight := s.App.ProtoRevKeeper.GetCyclicArbProfitTrackerStartHeight(s.Ctx)
 	s.Require().Equal(cyclicArbProfitAccountingHeight, exportedGenesis.CyclicArbTracker.HeightAccountingStartsFrom)
+
+	executionGasLimit := s.App.ProtoRevKeeper.GetExecutionGasLimit(s.Ctx)
+	s.Require().Equal(executionGasLimit, exportedGenesis.ExecutionGasLimit)
+
+	revertedTradeAttempts := s.App.ProtoRevKeeper.GetRevertedTradeAttempts(s.Ctx)
+	s.Require().Equal(revertedTradeAttempts, exportedGenesis.RevertedTradeAttempts)
+}
+
+// TestInitGenesis_ExecutionGuardrails tests that the execution gas limit and the reverted trade attempts
+// are initialized from genesis.
+func (s *KeeperTestSuite) TestInitGenesis_ExecutionGuardrails() {
+	genesis := types.DefaultGenesis()
+	genesis.ExecutionGasLimit = types.MinExecutionGasLimit
+	genesis.RevertedTradeAttempts = types.RevertedTradeAttempts{OutOfGas: 1, Panicked: 2, Failed: 3}
+
+	s.App.ProtoRevKeeper.InitGenesis(s.Ctx, *genesis)
+
+	exportedGenesis := s.App.ProtoRevKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(genesis.ExecutionGasLimit, exportedGenesis.ExecutionGasLimit)
+	s.Require().Equal(genesis.RevertedTradeAttempts, exportedGenesis.RevertedTradeAttempts)
 }
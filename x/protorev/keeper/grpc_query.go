@@ -254,3 +254,33 @@ func (q Querier) GetAllProtocolRevenue(c context.Context, req *types.QueryGetAll
 
 	return &types.QueryGetAllProtocolRevenueResponse{AllProtocolRevenue: allProtocolRevenue}, nil
 }
+
+// GetProtoRevProfitDistributions queries the cumulative profits distributed by the module, per destination
+func (q Querier) GetProtoRevProfitDistributions(c context.Context, req *types.QueryGetProtoRevProfitDistributionsRequest) (*types.QueryGetProtoRevProfitDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevProfitDistributionsResponse{ProfitDistributions: q.Keeper.GetProfitDistributions(ctx)}, nil
+}
+
+// GetProtoRevExecutionGasLimit queries the gas limit of the execution of the backrun of a tx
+func (q Querier) GetProtoRevExecutionGasLimit(c context.Context, req *types.QueryGetProtoRevExecutionGasLimitRequest) (*types.QueryGetProtoRevExecutionGasLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevExecutionGasLimitResponse{ExecutionGasLimit: q.Keeper.GetExecutionGasLimit(ctx)}, nil
+}
+
+// GetProtoRevRevertedTradeAttempts queries the number of backrun attempts that were reverted, per reason
+func (q Querier) GetProtoRevRevertedTradeAttempts(c context.Context, req *types.QueryGetProtoRevRevertedTradeAttemptsRequest) (*types.QueryGetProtoRevRevertedTradeAttemptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevRevertedTradeAttemptsResponse{RevertedTradeAttempts: q.Keeper.GetRevertedTradeAttempts(ctx)}, nil
+}
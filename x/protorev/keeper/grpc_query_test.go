@@ -334,6 +334,22 @@ func (s *KeeperTestSuite) TestGetProtoRevMaxPoolPointsPerBlock() {
 	s.Require().Equal(maxPoolPointsPerBlock, res.MaxPoolPointsPerBlock)
 }
 
+// TestGetProtoRevExecutionGasLimit tests the query to retrieve the execution gas limit
+func (s *KeeperTestSuite) TestGetProtoRevExecutionGasLimit() {
+	req := &types.QueryGetProtoRevExecutionGasLimitRequest{}
+	res, err := s.queryClient.GetProtoRevExecutionGasLimit(s.Ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultExecutionGasLimit, res.ExecutionGasLimit)
+
+	// Set the execution gas limit
+	err = s.App.AppKeepers.ProtoRevKeeper.SetExecutionGasLimit(s.Ctx, types.MaxExecutionGasLimit)
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.GetProtoRevExecutionGasLimit(s.Ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(types.MaxExecutionGasLimit, res.ExecutionGasLimit)
+}
+
 // TestGetProtoRevBaseDenoms tests the query to retrieve the base denoms
 func (s *KeeperTestSuite) TestGetProtoRevBaseDenoms() {
 	// base denoms already set in setup
@@ -63,6 +63,10 @@ func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 	h.k.StoreSwap(ctx, poolId, input[0].Denom, output[0].Denom)
 }
 
+// AfterSmoothWeightChangeCompleted is a noop.
+func (h Hooks) AfterSmoothWeightChangeCompleted(ctx sdk.Context, poolId uint64) {
+}
+
 // ----------------------------------------------------------------------------
 // CONCENTRATED LIQUIDITY HOOKS
 // ----------------------------------------------------------------------------
@@ -27,9 +27,13 @@ type (
 		poolmanagerKeeper           types.PoolManagerKeeper
 		concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper
 		distributionKeeper          types.DistributionKeeper
+
+		strategy types.ArbitrageStrategy
 	}
 )
 
+var _ types.ArbitrageStrategy = Keeper{}
+
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
@@ -66,3 +70,23 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetStrategy sets the arbitrage strategy used by the posthandler to search, estimate and execute
+// arbitrage routes. If no strategy is set, the keeper's own route search, estimation and execution is used.
+func (k *Keeper) SetStrategy(strategy types.ArbitrageStrategy) *Keeper {
+	if k.strategy != nil {
+		panic("cannot set protorev strategy twice")
+	}
+
+	k.strategy = strategy
+
+	return k
+}
+
+// GetStrategy returns the arbitrage strategy used by the posthandler.
+func (k Keeper) GetStrategy() types.ArbitrageStrategy {
+	if k.strategy == nil {
+		return k
+	}
+	return k.strategy
+}
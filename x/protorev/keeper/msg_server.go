@@ -162,6 +162,23 @@ func (m MsgServer) SetBaseDenoms(c context.Context, msg *types.MsgSetBaseDenoms)
 	return &types.MsgSetBaseDenomsResponse{}, nil
 }
 
+// SetExecutionGasLimit sets the gas limit of the execution of the backrun of a tx
+func (m MsgServer) SetExecutionGasLimit(c context.Context, msg *types.MsgSetExecutionGasLimit) (*types.MsgSetExecutionGasLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Ensure the account has the admin role and can make the tx
+	if err := m.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	// Set the execution gas limit
+	if err := m.k.SetExecutionGasLimit(ctx, msg.ExecutionGasLimit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetExecutionGasLimitResponse{}, nil
+}
+
 // AdminCheck ensures that the sender is the admin account.
 func (m MsgServer) AdminCheck(ctx sdk.Context, admin string) error {
 	sender, err := sdk.AccAddressFromBech32(admin)
@@ -466,6 +466,78 @@ func (s *KeeperTestSuite) TestMsgSetMaxPoolPointsPerBlock() {
 	}
 }
 
+// TestMsgSetExecutionGasLimit tests the MsgSetExecutionGasLimit message.
+func (s *KeeperTestSuite) TestMsgSetExecutionGasLimit() {
+	cases := []struct {
+		description       string
+		admin             string
+		executionGasLimit uint64
+		passValidateBasic bool
+		pass              bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			types.DefaultExecutionGasLimit,
+			false,
+			false,
+		},
+		{
+			"Invalid message (execution gas limit too low)",
+			s.adminAccount.String(),
+			types.MinExecutionGasLimit - 1,
+			false,
+			false,
+		},
+		{
+			"Invalid message (wrong admin)",
+			apptesting.CreateRandomAccounts(1)[0].String(),
+			types.DefaultExecutionGasLimit,
+			true,
+			false,
+		},
+		{
+			"Valid message (correct admin)",
+			s.adminAccount.String(),
+			types.MinExecutionGasLimit,
+			true,
+			true,
+		},
+		{
+			"Invalid message (correct admin, execution gas limit too high)",
+			s.adminAccount.String(),
+			types.MaxExecutionGasLimit + 1,
+			false,
+			false,
+		},
+	}
+
+	for _, testCase := range cases {
+		s.Run(testCase.description, func() {
+			msg := types.NewMsgSetExecutionGasLimit(testCase.admin, testCase.executionGasLimit)
+
+			err := msg.ValidateBasic()
+			if testCase.passValidateBasic {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+				return
+			}
+
+			server := keeper.NewMsgServer(*s.App.AppKeepers.ProtoRevKeeper)
+			response, err := server.SetExecutionGasLimit(s.Ctx, msg)
+			if testCase.pass {
+				s.Require().NoError(err)
+				s.Require().Equal(response, &types.MsgSetExecutionGasLimitResponse{})
+				s.Require().Equal(testCase.executionGasLimit, s.App.AppKeepers.ProtoRevKeeper.GetExecutionGasLimit(s.Ctx))
+			} else {
+				s.Require().Error(err)
+				s.Require().Equal(types.DefaultExecutionGasLimit, s.App.AppKeepers.ProtoRevKeeper.GetExecutionGasLimit(s.Ctx))
+			}
+		})
+	}
+}
+
 // TestMsgSetPoolTypeInfo tests the MsgSetInfoByPoolType message.
 func (s *KeeperTestSuite) TestMsgSetPoolTypeInfo() {
 	cases := []struct {
@@ -11,19 +11,19 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
 )
 
-type SwapToBackrun struct {
-	PoolId        uint64
-	TokenOutDenom string
-	TokenInDenom  string
-}
+// SwapToBackrun is kept as an alias of types.SwapToBackrun for backwards compatibility.
+type SwapToBackrun = types.SwapToBackrun
 
+// ProtoRevDecorator holds a pointer to the keeper, so that it runs the arbitrage strategy
+// set on the keeper (see SetStrategy) even if it is set after the decorator is built.
 type ProtoRevDecorator struct {
-	ProtoRevKeeper Keeper
+	ProtoRevKeeper *Keeper
 }
 
-func NewProtoRevDecorator(protoRevDecorator Keeper) ProtoRevDecorator {
+func NewProtoRevDecorator(protoRevDecorator *Keeper) ProtoRevDecorator {
 	return ProtoRevDecorator{
 		ProtoRevKeeper: protoRevDecorator,
 	}
@@ -44,9 +44,11 @@ func (protoRevDec ProtoRevDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simu
 	// In our case, the cache ctx is given a new gas meter instance entirely,
 	// so gas usage is not counted towards tx gas usage.
 	//
-	// 50M is chosen as a large enough number to ensure that the posthandler will not run out of gas,
-	// but will eventually terminate in event of an accidental infinite loop with some gas usage.
-	upperGasLimitMeter := storetypes.NewGasMeter(storetypes.Gas(50_000_000))
+	// The execution gas limit is set by the admin account (50M by default) as a large enough number to ensure that
+	// the posthandler will not run out of gas, but will eventually terminate in event of an accidental infinite loop
+	// with some gas usage.
+	executionGasLimit := protoRevDec.ProtoRevKeeper.GetExecutionGasLimit(cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	upperGasLimitMeter := storetypes.NewGasMeter(executionGasLimit)
 	cacheCtx = cacheCtx.WithGasMeter(upperGasLimitMeter)
 
 	// Check if the protorev posthandler can be executed
@@ -60,11 +62,13 @@ func (protoRevDec ProtoRevDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simu
 		return next(ctx, tx, success, simulate)
 	}
 
-	// Attempt to execute arbitrage trades
+	// Attempt to execute arbitrage trades. Any failure, including a panic or running out of the execution gas limit,
+	// only discards the cache context and never reverts the tx that was backrun.
 	if err := protoRevDec.ProtoRevKeeper.ProtoRevTrade(cacheCtx, swappedPools); err == nil {
 		write()
 	} else {
 		ctx.Logger().Error("ProtoRevTrade failed with error: " + err.Error())
+		protoRevDec.ProtoRevKeeper.IncrementRevertedTradeAttempts(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), err)
 	}
 
 	// Delete swaps to backrun for next transaction without consuming gas
@@ -112,16 +116,16 @@ func (k Keeper) AnteHandleCheck(ctx sdk.Context) error {
 	return nil
 }
 
-// ProtoRevTrade wraps around the build routes, iterate routes, and execute trade functionality to execute cyclic arbitrage trades
-// if they exist. It returns an error if there was an issue executing any single trade.
+// ProtoRevTrade wraps around the build routes, iterate routes, and execute trade functionality of the module's arbitrage strategy
+// (see SetStrategy) to execute cyclic arbitrage trades if they exist. It returns an error if there was an issue executing any single trade.
 func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (err error) {
 	// recover from panic
 	defer func() {
 		if r := recover(); r != nil {
 			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
-				err = fmt.Errorf("protorev failed due to lack of gas: %v", d)
+				err = fmt.Errorf("%w: %v", types.ErrBackrunOutOfGas, d)
 			} else {
-				err = fmt.Errorf("protorev failed due to internal reason: %v", r)
+				err = fmt.Errorf("%w: %v", types.ErrBackrunPanicked, r)
 			}
 		}
 	}()
@@ -132,17 +136,19 @@ func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (er
 		return err
 	}
 
+	strategy := k.GetStrategy()
+
 	// Iterate and build arbitrage routes for each pool that was swapped on
 	for _, pool := range swappedPools {
 		// Build the routes for the pool that was swapped on
-		routes := k.BuildRoutes(ctx, pool.TokenInDenom, pool.TokenOutDenom, pool.PoolId)
+		routes := strategy.BuildRoutes(ctx, pool.TokenInDenom, pool.TokenOutDenom, pool.PoolId)
 
 		// Find optimal route (input coin, profit, route) for the given routes
-		maxProfitInputCoin, maxProfitAmount, optimalRoute := k.IterateRoutes(ctx, routes, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+		maxProfitInputCoin, maxProfitAmount, optimalRoute := strategy.IterateRoutes(ctx, routes, &remainingTxPoolPoints, &remainingBlockPoolPoints)
 
 		// The error that returns here is particularly focused on the minting/burning of coins, and the execution of the MultiHopSwapExactAmountIn.
 		if maxProfitAmount.GT(osmomath.ZeroInt()) {
-			if err := k.ExecuteTrade(ctx, optimalRoute, maxProfitInputCoin, pool, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
+			if err := strategy.ExecuteTrade(ctx, optimalRoute, maxProfitInputCoin, pool, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
 				return err
 			}
 		}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 	"testing"
@@ -423,7 +424,7 @@ func (s *KeeperTestSuite) TestPostHandle() {
 				s.CreateCLPoolAndArbRouteWith_28000_Ticks()
 			}
 
-			protoRevDecorator := keeper.NewProtoRevDecorator(*s.App.ProtoRevKeeper)
+			protoRevDecorator := keeper.NewProtoRevDecorator(s.App.ProtoRevKeeper)
 			posthandlerProtoRev := sdk.ChainPostDecorators(protoRevDecorator)
 
 			// Added so we can check the gas consumed during the posthandler
@@ -675,6 +676,158 @@ func (s *KeeperTestSuite) TestExtractSwappedPools() {
 	}
 }
 
+// mockStrategy is an arbitrage strategy that returns a fixed route and profit, and records the trades it is asked to execute.
+type mockStrategy struct {
+	route          poolmanagertypes.SwapAmountInRoutes
+	profit         osmomath.Int
+	builtFor       []keeper.SwapToBackrun
+	executedTrades []keeper.SwapToBackrun
+}
+
+var _ types.ArbitrageStrategy = &mockStrategy{}
+
+func (m *mockStrategy) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) []keeper.RouteMetaData {
+	m.builtFor = append(m.builtFor, keeper.SwapToBackrun{PoolId: poolId, TokenInDenom: tokenIn, TokenOutDenom: tokenOut})
+	return []keeper.RouteMetaData{{Route: m.route, PoolPoints: 1, StepSize: osmomath.OneInt()}}
+}
+
+func (m *mockStrategy) IterateRoutes(ctx sdk.Context, routes []keeper.RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, poolmanagertypes.SwapAmountInRoutes) {
+	return sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)), m.profit, routes[0].Route
+}
+
+func (m *mockStrategy) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool keeper.SwapToBackrun, remainingTxPoolPoints, remainingBlockPoolPoints uint64) error {
+	m.executedTrades = append(m.executedTrades, pool)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestProtoRevTradeWithStrategy() {
+	swappedPools := []keeper.SwapToBackrun{
+		{PoolId: 1, TokenInDenom: "akash", TokenOutDenom: types.OsmosisDenomination},
+		{PoolId: 2, TokenInDenom: types.OsmosisDenomination, TokenOutDenom: "juno"},
+	}
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: types.OsmosisDenomination}}
+
+	tests := map[string]struct {
+		profit                 osmomath.Int
+		expectedExecutedTrades []keeper.SwapToBackrun
+	}{
+		"profitable routes are executed by the strategy": {
+			profit:                 osmomath.OneInt(),
+			expectedExecutedTrades: swappedPools,
+		},
+		"unprofitable routes are not executed": {
+			profit: osmomath.ZeroInt(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			strategy := &mockStrategy{route: route, profit: tc.profit}
+
+			protoRevKeeper := *s.App.ProtoRevKeeper
+			protoRevKeeper.SetStrategy(strategy)
+			s.Require().Equal(strategy, protoRevKeeper.GetStrategy())
+
+			err := protoRevKeeper.ProtoRevTrade(s.Ctx, swappedPools)
+			s.Require().NoError(err)
+			s.Require().Equal(swappedPools, strategy.builtFor)
+			s.Require().Equal(tc.expectedExecutedTrades, strategy.executedTrades)
+
+			// The keeper's own trade execution must not have been used
+			_, err = s.App.ProtoRevKeeper.GetNumberOfTrades(s.Ctx)
+			s.Require().Error(err)
+		})
+	}
+}
+
+// failingStrategy is a mockStrategy whose trade execution is replaced by the given function, which is used to
+// write to state before failing.
+type failingStrategy struct {
+	mockStrategy
+	executeTrade func(ctx sdk.Context) error
+}
+
+func (f *failingStrategy) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool keeper.SwapToBackrun, remainingTxPoolPoints, remainingBlockPoolPoints uint64) error {
+	return f.executeTrade(ctx)
+}
+
+func (s *KeeperTestSuite) TestPostHandleRevertsFailedBackrun() {
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: types.OsmosisDenomination}}
+	trades := []types.Trade{{Pool: 1, TokenIn: "akash", TokenOut: types.OsmosisDenomination}}
+
+	tests := map[string]struct {
+		executeTrade           func(ctx sdk.Context) error
+		expectedNumOfTrades    osmomath.Int
+		expectedRevertedTrades types.RevertedTradeAttempts
+	}{
+		"successful backrun is written": {
+			executeTrade: func(ctx sdk.Context) error {
+				return s.App.ProtoRevKeeper.IncrementNumberOfTrades(ctx)
+			},
+			expectedNumOfTrades: osmomath.OneInt(),
+		},
+		"backrun returning an error is reverted": {
+			executeTrade: func(ctx sdk.Context) error {
+				s.Require().NoError(s.App.ProtoRevKeeper.IncrementNumberOfTrades(ctx))
+				return errors.New("trade failed")
+			},
+			expectedNumOfTrades:    osmomath.ZeroInt(),
+			expectedRevertedTrades: types.RevertedTradeAttempts{Failed: 1},
+		},
+		"panicking backrun is reverted": {
+			executeTrade: func(ctx sdk.Context) error {
+				s.Require().NoError(s.App.ProtoRevKeeper.IncrementNumberOfTrades(ctx))
+				panic("exotic pool")
+			},
+			expectedNumOfTrades:    osmomath.ZeroInt(),
+			expectedRevertedTrades: types.RevertedTradeAttempts{Panicked: 1},
+		},
+		"backrun running out of the execution gas limit is reverted": {
+			executeTrade: func(ctx sdk.Context) error {
+				s.Require().NoError(s.App.ProtoRevKeeper.IncrementNumberOfTrades(ctx))
+				ctx.GasMeter().ConsumeGas(types.MinExecutionGasLimit, "exceed execution gas limit")
+				return nil
+			},
+			expectedNumOfTrades:    osmomath.ZeroInt(),
+			expectedRevertedTrades: types.RevertedTradeAttempts{OutOfGas: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Require().NoError(s.App.ProtoRevKeeper.SetExecutionGasLimit(s.Ctx, types.MinExecutionGasLimit))
+
+			// The strategy is set after the decorator is built, and must still be used by it.
+			protoRevKeeper := *s.App.ProtoRevKeeper
+			posthandlerProtoRev := sdk.ChainPostDecorators(keeper.NewProtoRevDecorator(&protoRevKeeper))
+			protoRevKeeper.SetStrategy(&failingStrategy{
+				mockStrategy: mockStrategy{route: route, profit: osmomath.OneInt()},
+				executeTrade: tc.executeTrade,
+			})
+
+			s.Ctx = s.Ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+			s.Require().NoError(s.App.ProtoRevKeeper.AddSwapsToSwapsToBackrun(s.Ctx, trades))
+			gasBefore := s.Ctx.GasMeter().GasConsumed()
+
+			// The tx that is backrun never fails, nor pays for the backrun
+			_, err := posthandlerProtoRev(s.Ctx, s.clientCtx.TxConfig.NewTxBuilder().GetTx(), false, true)
+			s.Require().NoError(err)
+			s.Require().Equal(gasBefore, s.Ctx.GasMeter().GasConsumed())
+
+			s.Ctx = s.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			numOfTrades, _ := s.App.ProtoRevKeeper.GetNumberOfTrades(s.Ctx)
+			s.Require().Equal(tc.expectedNumOfTrades, numOfTrades)
+			s.Require().Equal(tc.expectedRevertedTrades, s.App.ProtoRevKeeper.GetRevertedTradeAttempts(s.Ctx))
+
+			swapsToBackrun, err := s.App.ProtoRevKeeper.GetSwapsToBackrun(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Empty(swapsToBackrun.Trades)
+		})
+	}
+}
+
 // benchmarkWrapper is a wrapper function for the benchmark tests. It sets up the suite, accepts the
 // messages to be sent, and the expected number of trades. It then runs the benchmark and checks the
 // number of trades after the post handler is run.
@@ -745,7 +898,7 @@ func setUpBenchmarkSuite(msgs []sdk.Msg) (*KeeperTestSuite, authsigning.Tx, sdk.
 	tx := s.BuildTx(txBuilder, msgs, sigV2, "", sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(10000))), 500000)
 
 	// Set up the post handler
-	protoRevDecorator := keeper.NewProtoRevDecorator(*s.App.ProtoRevKeeper)
+	protoRevDecorator := keeper.NewProtoRevDecorator(s.App.ProtoRevKeeper)
 	posthandlerProtoRev := sdk.ChainPostDecorators(protoRevDecorator)
 
 	return s, tx, posthandlerProtoRev
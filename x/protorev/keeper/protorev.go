@@ -499,6 +499,28 @@ func (k Keeper) SetMaxPointsPerBlock(ctx sdk.Context, maxPoints uint64) error {
 	return nil
 }
 
+// GetExecutionGasLimit returns the gas limit of the execution of the backrun of a tx. It defaults to
+// types.DefaultExecutionGasLimit if the admin account has not set it.
+func (k Keeper) GetExecutionGasLimit(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyExecutionGasLimit)
+	if bz == nil {
+		return types.DefaultExecutionGasLimit
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetExecutionGasLimit sets the gas limit of the execution of the backrun of a tx.
+func (k Keeper) SetExecutionGasLimit(ctx sdk.Context, gasLimit uint64) error {
+	if err := types.ValidateExecutionGasLimit(gasLimit); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyExecutionGasLimit, sdk.Uint64ToBigEndian(gasLimit))
+
+	return nil
+}
+
 // GetInfoByPoolType retrieves the metadata about the different pool types. This is used to determine the execution costs of
 // different pool types when calculating the optimal route (in terms of time and gas consumption).
 func (k Keeper) GetInfoByPoolType(ctx sdk.Context) types.InfoByPoolType {
@@ -51,7 +51,9 @@ func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remaining
 	return maxProfitInputCoin, maxProfit, optimalRoute
 }
 
-// ConvertProfits converts the profit denom to uosmo to allow for a fair comparison of profits
+// ConvertProfits converts the profit denom to uosmo to allow for a fair comparison of profits.
+// The profit is converted through the poolmanager canonical route of the denom to uosmo if governance registered one,
+// and through the highest liquidity uosmo pool of the denom otherwise.
 //
 // NOTE: This does not check the underlying pool before swapping so this may go over the MaxTicksCrossed.
 func (k Keeper) ConvertProfits(ctx sdk.Context, inputCoin sdk.Coin, profit osmomath.Int) (osmomath.Int, error) {
@@ -59,6 +61,14 @@ func (k Keeper) ConvertProfits(ctx sdk.Context, inputCoin sdk.Coin, profit osmom
 		return profit, nil
 	}
 
+	if route, found := k.poolmanagerKeeper.GetCanonicalRoute(ctx, inputCoin.Denom, types.OsmosisDenomination); found {
+		conversionTokenOut, err := k.poolmanagerKeeper.MultihopEstimateOutGivenExactAmountInNoTakerFee(ctx, route, sdk.NewCoin(inputCoin.Denom, profit))
+		if err != nil {
+			return profit, err
+		}
+		return conversionTokenOut, nil
+	}
+
 	// Get highest liquidity pool ID for the input coin and uosmo
 	conversionPoolID, err := k.GetPoolForDenomPair(ctx, types.OsmosisDenomination, inputCoin.Denom)
 	if err != nil {
@@ -146,6 +156,27 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	}
 
+	// Routes through concentrated liquidity pools are searched with a ternary search
+	hasConcentratedPool, err := k.routeHasConcentratedPool(ctx, route)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+	if hasConcentratedPool {
+		// The ternary search takes more quotes than the route pool points cover, so the extra quotes are charged
+		// before searching. If there are not enough pool points left, the route is not searched.
+		extraPoolPoints := ternarySearchExtraPoolPoints(route.PoolPoints)
+		if extraPoolPoints > *remainingTxPoolPoints || extraPoolPoints > *remainingBlockPoolPoints {
+			return sdk.Coin{}, osmomath.ZeroInt(), nil
+		}
+		*remainingTxPoolPoints -= extraPoolPoints
+		*remainingBlockPoolPoints -= extraPoolPoints
+		if err := k.IncrementPointCountForBlock(ctx, extraPoolPoints); err != nil {
+			return sdk.Coin{}, osmomath.ZeroInt(), err
+		}
+
+		return k.findMaxProfitTernarySearch(ctx, route, inputDenom, curLeft, curRight)
+	}
+
 	// Binary search to find the max profit
 	for iteration := 0; curLeft.LT(curRight) && iteration < types.MaxIterations; iteration++ {
 		curMid := (curLeft.Add(curRight)).Quo(twoInt)
@@ -178,6 +209,78 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 	return tokenIn, profit, nil
 }
 
+var threeInt = osmomath.NewInt(3)
+
+// findMaxProfitTernarySearch runs a ternary search over the [curLeft, curRight] step range to find the max profit
+// for a route through concentrated liquidity pools. Tick-aware quotes are truncated at every tick crossed, which
+// creates flat segments in the profit function where comparing adjacent amounts, as the binary search does, can
+// move past the optimal amount. Comparing amounts a third of the range apart is not affected by those segments.
+// Ties are broken towards the larger amount, as in the binary search.
+func (k Keeper) findMaxProfitTernarySearch(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight osmomath.Int) (sdk.Coin, osmomath.Int, error) {
+	for iteration := 0; curRight.Sub(curLeft).GTE(threeInt) && iteration < types.MaxTernarySearchIterations; iteration++ {
+		third := curRight.Sub(curLeft).Quo(threeInt)
+		curLowerMid := curLeft.Add(third)
+		curUpperMid := curRight.Sub(third)
+
+		_, profitLowerMid, err := k.EstimateMultihopProfit(ctx, inputDenom, curLowerMid.Mul(route.StepSize), route.Route)
+		if err != nil {
+			return sdk.Coin{}, osmomath.ZeroInt(), err
+		}
+
+		_, profitUpperMid, err := k.EstimateMultihopProfit(ctx, inputDenom, curUpperMid.Mul(route.StepSize), route.Route)
+		if err != nil {
+			return sdk.Coin{}, osmomath.ZeroInt(), err
+		}
+
+		// Reduce subspace to search for max profit
+		if profitLowerMid.LTE(profitUpperMid) {
+			curLeft = curLowerMid.Add(oneInt)
+		} else {
+			curRight = curUpperMid.Sub(oneInt)
+		}
+	}
+
+	// Select the most profitable of the remaining amounts
+	tokenIn := sdk.Coin{}
+	profit := osmomath.ZeroInt()
+	for cur := curLeft; cur.LTE(curRight); cur = cur.Add(oneInt) {
+		tokenInCur, profitCur, err := k.EstimateMultihopProfit(ctx, inputDenom, cur.Mul(route.StepSize), route.Route)
+		if err != nil {
+			return sdk.Coin{}, osmomath.ZeroInt(), err
+		}
+
+		if tokenIn.IsNil() || profitCur.GTE(profit) {
+			tokenIn = tokenInCur
+			profit = profitCur
+		}
+	}
+
+	return tokenIn, profit, nil
+}
+
+// ternarySearchExtraPoolPoints returns the pool points charged for the quotes the ternary search takes on top of
+// the binary search ones covered by the route pool points, rounded up.
+func ternarySearchExtraPoolPoints(routePoolPoints uint64) uint64 {
+	extraQuotes := types.TernarySearchQuotes - types.BinarySearchQuotes
+	return (routePoolPoints*extraQuotes + types.BinarySearchQuotes - 1) / types.BinarySearchQuotes
+}
+
+// routeHasConcentratedPool returns true if the route swaps through a concentrated liquidity pool.
+func (k Keeper) routeHasConcentratedPool(ctx sdk.Context, route RouteMetaData) (bool, error) {
+	for _, poolId := range route.Route.PoolIds() {
+		poolType, err := k.poolmanagerKeeper.GetPoolType(ctx, poolId)
+		if err != nil {
+			return false, err
+		}
+
+		if poolType == poolmanagertypes.Concentrated {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // UpdateSearchRangeIfNeeded updates the search range for the binary search. First, we check if there are any
 // concentrated liquidity pools in the route. If there are, then we may need to reduce the upper bound of the
 // binary search since it is gas intensive to move across several ticks. Next, we determine if the current bound
@@ -379,7 +482,7 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 	}
 
 	// Create and emit the backrun event and add it to the context
-	EmitBackrunEvent(ctx, pool, inputCoin, profit, tokenOutAmount, remainingTxPoolPoints, remainingBlockPoolPoints)
+	EmitBackrunEvent(ctx, pool, route, inputCoin, profit, tokenOutAmount, protorevModuleAddress, remainingTxPoolPoints, remainingBlockPoolPoints)
 
 	return nil
 }
@@ -238,6 +238,8 @@ func (s *KeeperTestSuite) TestFindMaxProfitRoute() {
 		expectedAmtIn   osmomath.Int
 		expectedProfit  osmomath.Int
 		routePoolPoints uint64
+		// pool points charged for the extra quotes of the ternary search on routes through concentrated pools
+		ternarySearchPoolPoints uint64
 	}
 
 	tests := []struct {
@@ -348,30 +350,33 @@ func (s *KeeperTestSuite) TestFindMaxProfitRoute() {
 		{
 			name: "CL Route (extended range)", // This will search up to 131072 * stepsize
 			param: param{
-				route:           clPoolRouteExtended,
-				expectedAmtIn:   osmomath.NewInt(131_072_000_000),
-				expectedProfit:  osmomath.NewInt(295_125_808),
-				routePoolPoints: 7,
+				route:                   clPoolRouteExtended,
+				expectedAmtIn:           osmomath.NewInt(131_072_000_000),
+				expectedProfit:          osmomath.NewInt(295_125_808),
+				routePoolPoints:         7,
+				ternarySearchPoolPoints: 5,
 			},
 			expectPass: true,
 		},
 		{
 			name: "CL Route", // This will search up to 131072 * stepsize
 			param: param{
-				route:           clPoolRoute,
-				expectedAmtIn:   osmomath.NewInt(13_159_000_000),
-				expectedProfit:  osmomath.NewInt(18_055_586),
-				routePoolPoints: 7,
+				route:                   clPoolRoute,
+				expectedAmtIn:           osmomath.NewInt(13_159_000_000),
+				expectedProfit:          osmomath.NewInt(18_055_586),
+				routePoolPoints:         7,
+				ternarySearchPoolPoints: 5,
 			},
 			expectPass: true,
 		},
 		{
 			name: "CL Route Multi", // This will search up to 131072 * stepsize
 			param: param{
-				route:           clPoolRouteMulti,
-				expectedAmtIn:   osmomath.NewInt(414_000_000),
-				expectedProfit:  osmomath.NewInt(171_555_698),
-				routePoolPoints: 12,
+				route:                   clPoolRouteMulti,
+				expectedAmtIn:           osmomath.NewInt(414_000_000),
+				expectedProfit:          osmomath.NewInt(171_555_698),
+				routePoolPoints:         12,
+				ternarySearchPoolPoints: 9,
 			},
 			expectPass: true,
 		},
@@ -414,11 +419,41 @@ func (s *KeeperTestSuite) TestFindMaxProfitRoute() {
 			}
 
 			// check that the remaining pool points is correct
-			s.Require().Equal(uint64(1000), remainingPoolPoints+test.param.routePoolPoints)
+			s.Require().Equal(uint64(1000), remainingPoolPoints+test.param.routePoolPoints+test.param.ternarySearchPoolPoints)
+			s.Require().Equal(uint64(1000), remainingBlockPoolPoints+test.param.routePoolPoints+test.param.ternarySearchPoolPoints)
 		})
 	}
 }
 
+// TestFindMaxProfitRoute_TernarySearchPoolPoints tests that routes through concentrated pools are not searched
+// when the pool points left do not cover the extra quotes of the ternary search.
+func (s *KeeperTestSuite) TestFindMaxProfitRoute_TernarySearchPoolPoints() {
+	s.SetupPoolsTest()
+	route := protorevtypes.RouteMetaData{
+		Route:      clPoolRoute,
+		PoolPoints: 7,
+		StepSize:   osmomath.NewInt(1_000_000),
+	}
+
+	// Enough pool points for the route, but not for the ternary search.
+	remainingPoolPoints := uint64(11)
+	remainingBlockPoolPoints := uint64(1000)
+	amtIn, profit, err := s.App.ProtoRevKeeper.FindMaxProfitForRoute(s.Ctx, route, &remainingPoolPoints, &remainingBlockPoolPoints)
+	s.Require().NoError(err)
+	s.Require().True(amtIn.IsNil())
+	s.Require().True(profit.IsZero())
+	s.Require().Equal(uint64(4), remainingPoolPoints)
+	s.Require().Equal(uint64(993), remainingBlockPoolPoints)
+
+	// Enough pool points for both.
+	remainingPoolPoints = uint64(12)
+	amtIn, profit, err = s.App.ProtoRevKeeper.FindMaxProfitForRoute(s.Ctx, route, &remainingPoolPoints, &remainingBlockPoolPoints)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(13_159_000_000), amtIn.Amount)
+	s.Require().Equal(osmomath.NewInt(18_055_586), profit)
+	s.Require().Equal(uint64(0), remainingPoolPoints)
+}
+
 func (s *KeeperTestSuite) TestExecuteTrade() {
 	s.SetupPoolsTest()
 	type param struct {
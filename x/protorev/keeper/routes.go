@@ -11,14 +11,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
 )
 
-type RouteMetaData struct {
-	// The route that was built
-	Route poolmanagertypes.SwapAmountInRoutes
-	// The number of pool points that were consumed to build the route
-	PoolPoints uint64
-	// The step size that should be used in the binary search for the optimal swap amount
-	StepSize osmomath.Int
-}
+// RouteMetaData is kept as an alias of types.RouteMetaData for backwards compatibility.
+type RouteMetaData = types.RouteMetaData
 
 // BuildRoutes builds all of the possible arbitrage routes given the tokenIn, tokenOut and poolId that were used in the swap.
 func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) []RouteMetaData {
@@ -238,6 +232,9 @@ func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes
 		case poolmanagertypes.Stableswap:
 			totalWeight += infoByPoolType.Stable.Weight
 		case poolmanagertypes.Concentrated:
+			if err := k.ValidateConcentratedPoolLiquidity(ctx, poolId, infoByPoolType.Concentrated.MinActiveLiquidity); err != nil {
+				return 0, err
+			}
 			totalWeight += infoByPoolType.Concentrated.Weight
 		case poolmanagertypes.CosmWasm:
 			weight, ok := uint64(0), false
@@ -276,6 +273,36 @@ func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes
 	return totalWeight, nil
 }
 
+// concentratedPool is the subset of a concentrated liquidity pool used to filter routes by liquidity.
+type concentratedPool interface {
+	GetLiquidity() osmomath.Dec
+}
+
+// ValidateConcentratedPoolLiquidity checks that the concentrated pool has at least minActiveLiquidity liquidity
+// in its current tick. Routes through thinner pools are not built, as their tick-aware quotes move across many
+// ticks for small amounts. A minActiveLiquidity of zero disables the check.
+func (k Keeper) ValidateConcentratedPoolLiquidity(ctx sdk.Context, poolId uint64, minActiveLiquidity uint64) error {
+	if minActiveLiquidity == 0 {
+		return nil
+	}
+
+	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	clPool, ok := pool.(concentratedPool)
+	if !ok {
+		return fmt.Errorf("pool %d is not a concentrated pool", poolId)
+	}
+
+	if clPool.GetLiquidity().LT(osmomath.NewDecFromInt(osmomath.NewIntFromUint64(minActiveLiquidity))) {
+		return fmt.Errorf("concentrated pool %d has %s active liquidity, less than the minimum of %d", poolId, clPool.GetLiquidity(), minActiveLiquidity)
+	}
+
+	return nil
+}
+
 // IsValidPool checks if the pool is active and exists
 func (k Keeper) IsValidPool(ctx sdk.Context, pool poolmanagertypes.PoolI) error {
 	if !pool.IsActive(ctx) {
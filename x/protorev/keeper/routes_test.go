@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"math"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
@@ -485,3 +487,59 @@ func (s *KeeperTestSuite) TestCalculateRoutePoolPoints() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCalculateRoutePoolPoints_MinActiveLiquidity() {
+	s.SetupPoolsTest()
+	s.Require().NoError(s.App.ProtoRevKeeper.SetMaxPointsPerTx(s.Ctx, 25))
+	s.Require().NoError(s.App.ProtoRevKeeper.SetMaxPointsPerBlock(s.Ctx, 100))
+
+	clRoute := []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ""}, {PoolId: 50, TokenOutDenom: ""}}
+	balancerRoute := []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ""}, {PoolId: 2, TokenOutDenom: ""}}
+
+	cases := []struct {
+		description        string
+		minActiveLiquidity uint64
+		route              poolmanagertypes.SwapAmountInRoutes
+		expectedPass       bool
+	}{
+		{
+			description:        "filter disabled",
+			minActiveLiquidity: 0,
+			route:              clRoute,
+			expectedPass:       true,
+		},
+		{
+			description:        "cl pool above the minimum active liquidity",
+			minActiveLiquidity: 1,
+			route:              clRoute,
+			expectedPass:       true,
+		},
+		{
+			description:        "cl pool below the minimum active liquidity",
+			minActiveLiquidity: math.MaxUint64,
+			route:              clRoute,
+			expectedPass:       false,
+		},
+		{
+			description:        "route without cl pools is not filtered",
+			minActiveLiquidity: math.MaxUint64,
+			route:              balancerRoute,
+			expectedPass:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			infoByPoolType := s.App.ProtoRevKeeper.GetInfoByPoolType(s.Ctx)
+			infoByPoolType.Concentrated.MinActiveLiquidity = tc.minActiveLiquidity
+			s.App.ProtoRevKeeper.SetInfoByPoolType(s.Ctx, infoByPoolType)
+
+			_, err := s.App.ProtoRevKeeper.CalculateRoutePoolPoints(s.Ctx, tc.route)
+			if tc.expectedPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, "less than the minimum")
+			}
+		})
+	}
+}
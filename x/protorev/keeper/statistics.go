@@ -129,6 +129,60 @@ func (k Keeper) SetCyclicArbProfitTrackerStartHeight(ctx sdk.Context, startHeigh
 	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyCyclicArbTrackerStartHeight, &gogotypes.Int64Value{Value: startHeight})
 }
 
+// GetProfitDistributions returns the cumulative profits distributed by the ProtoRev module, per destination.
+func (k Keeper) GetProfitDistributions(ctx sdk.Context) types.ProfitDistributions {
+	var distributions types.ProfitDistributions
+	if _, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyProfitDistributions, &distributions); err != nil {
+		// We can only encounter an error if a database or serialization errors occurs, so we panic here.
+		panic(err)
+	}
+	return distributions
+}
+
+// SetProfitDistributions sets the cumulative profits distributed by the ProtoRev module, per destination.
+func (k Keeper) SetProfitDistributions(ctx sdk.Context, distributions types.ProfitDistributions) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyProfitDistributions, &distributions)
+}
+
+// addProfitDistributions adds the given distributed profits to the cumulative profits distributed per destination.
+func (k Keeper) addProfitDistributions(ctx sdk.Context, distributed types.ProfitDistributions) {
+	distributions := k.GetProfitDistributions(ctx)
+	distributions.Developer = distributions.Developer.Add(distributed.Developer...)
+	distributions.Burned = distributions.Burned.Add(distributed.Burned...)
+	distributions.CommunityPool = distributions.CommunityPool.Add(distributed.CommunityPool...)
+	distributions.Stakers = distributions.Stakers.Add(distributed.Stakers...)
+	k.SetProfitDistributions(ctx, distributions)
+}
+
+// GetRevertedTradeAttempts returns the number of backrun attempts that were reverted by the ProtoRev module, per reason.
+func (k Keeper) GetRevertedTradeAttempts(ctx sdk.Context) types.RevertedTradeAttempts {
+	var attempts types.RevertedTradeAttempts
+	if _, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyRevertedTradeAttempts, &attempts); err != nil {
+		// We can only encounter an error if a database or serialization errors occurs, so we panic here.
+		panic(err)
+	}
+	return attempts
+}
+
+// SetRevertedTradeAttempts sets the number of backrun attempts that were reverted by the ProtoRev module, per reason.
+func (k Keeper) SetRevertedTradeAttempts(ctx sdk.Context, attempts types.RevertedTradeAttempts) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyRevertedTradeAttempts, &attempts)
+}
+
+// IncrementRevertedTradeAttempts increments the number of reverted backrun attempts for the reason of the given error.
+func (k Keeper) IncrementRevertedTradeAttempts(ctx sdk.Context, err error) {
+	attempts := k.GetRevertedTradeAttempts(ctx)
+	switch {
+	case errors.Is(err, types.ErrBackrunOutOfGas):
+		attempts.OutOfGas++
+	case errors.Is(err, types.ErrBackrunPanicked):
+		attempts.Panicked++
+	default:
+		attempts.Failed++
+	}
+	k.SetRevertedTradeAttempts(ctx, attempts)
+}
+
 // UpdateProfitsByDenom updates the profits made by the ProtoRev module for the given denom
 func (k Keeper) UpdateProfitsByDenom(ctx sdk.Context, denom string, tradeProfit osmomath.Int) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixProfitByDenom)
@@ -278,5 +332,87 @@ func (k Keeper) UpdateStatistics(ctx sdk.Context, route poolmanagertypes.SwapAmo
 		return err
 	}
 
+	// Update the summary of the trades executed in the current block
+	k.IncrementBlockNumberOfTrades(ctx)
+	if err := k.UpdateBlockProfitsByDenom(ctx, denom, profit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ----------------------- Block Summary Stores  ----------------------- //
+// The block summary stores are transient and are therefore reset at the end of every block.
+
+// GetBlockNumberOfTrades returns the number of trades executed by the ProtoRev module in the current block
+func (k Keeper) GetBlockNumberOfTrades(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixBlockNumberOfTrades)
+
+	bz := store.Get(types.KeyPrefixBlockNumberOfTrades)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementBlockNumberOfTrades increments the number of trades executed by the ProtoRev module in the current block
+func (k Keeper) IncrementBlockNumberOfTrades(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixBlockNumberOfTrades)
+
+	numberOfTrades := k.GetBlockNumberOfTrades(ctx)
+	store.Set(types.KeyPrefixBlockNumberOfTrades, sdk.Uint64ToBigEndian(numberOfTrades+1))
+}
+
+// GetBlockProfitsByDenom returns the profits made by the ProtoRev module in the current block for the given denom
+func (k Keeper) GetBlockProfitsByDenom(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixBlockProfitsByDenom)
+	key := types.GetKeyPrefixBlockProfitByDenom(denom)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, osmomath.ZeroInt()), fmt.Errorf("no profits in the current block for denom %s", denom)
+	}
+
+	profits := sdk.Coin{}
+	if err := profits.Unmarshal(bz); err != nil {
+		return sdk.NewCoin(denom, osmomath.ZeroInt()), err
+	}
+
+	return profits, nil
+}
+
+// GetAllBlockProfits returns all of the profits made by the ProtoRev module in the current block
+func (k Keeper) GetAllBlockProfits(ctx sdk.Context) sdk.Coins {
+	profits := sdk.NewCoins()
+
+	store := ctx.TransientStore(k.transientKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBlockProfitsByDenom)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		profit := sdk.Coin{}
+		if err := profit.Unmarshal(bz); err == nil {
+			profits = profits.Add(profit)
+		}
+	}
+
+	return profits
+}
+
+// UpdateBlockProfitsByDenom updates the profits made by the ProtoRev module in the current block for the given denom
+func (k Keeper) UpdateBlockProfitsByDenom(ctx sdk.Context, denom string, tradeProfit osmomath.Int) error {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixBlockProfitsByDenom)
+	key := types.GetKeyPrefixBlockProfitByDenom(denom)
+
+	profits, _ := k.GetBlockProfitsByDenom(ctx, denom)
+	profits.Amount = profits.Amount.Add(tradeProfit)
+	bz, err := profits.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
 	return nil
 }
@@ -21,6 +21,7 @@ const (
 	setMaxPoolPointsPerBlock = "osmosis/MsgSetMaxPoolPointsPerBlock"
 	setInfoByPoolType        = "osmosis/MsgSetInfoByPoolType"
 	setBaseDenoms            = "osmosis/MsgSetBaseDenoms"
+	setExecutionGasLimit     = "osmosis/MsgSetExecutionGasLimit"
 
 	// proposals
 	setProtoRevEnabledProposal      = "osmosis/SetProtoRevEnabledProposal"
@@ -41,6 +42,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetMaxPoolPointsPerBlock{}, setMaxPoolPointsPerBlock, nil)
 	cdc.RegisterConcrete(&MsgSetInfoByPoolType{}, setInfoByPoolType, nil)
 	cdc.RegisterConcrete(&MsgSetBaseDenoms{}, setBaseDenoms, nil)
+	cdc.RegisterConcrete(&MsgSetExecutionGasLimit{}, setExecutionGasLimit, nil)
 
 	// proposals
 	cdc.RegisterConcrete(&SetProtoRevEnabledProposal{}, setProtoRevEnabledProposal, nil)
@@ -56,6 +58,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetMaxPoolPointsPerBlock{},
 		&MsgSetInfoByPoolType{},
 		&MsgSetBaseDenoms{},
+		&MsgSetExecutionGasLimit{},
 	)
 
 	// proposals
@@ -20,6 +20,18 @@ var ExtendedMaxInputAmount = osmomath.NewInt(131_072)
 // Max iterations for binary search (log2(131_072) = 17)
 const MaxIterations int = 17
 
+// Max iterations for the ternary search used on routes through concentrated liquidity pools.
+// Each iteration keeps two thirds of the range (log1.5(131_072 / 3) ~= 27)
+const MaxTernarySearchIterations int = 28
+
+// The route pool points cover the quotes of a binary search, which takes two quotes per iteration on top of
+// the minimum amount in quote. The ternary search takes two quotes per iteration and up to three more for the
+// remaining amounts, so routes searched with it are charged its extra quotes in proportion of the route pool points.
+const (
+	BinarySearchQuotes  uint64 = 2*uint64(MaxIterations) + 1
+	TernarySearchQuotes uint64 = 2*uint64(MaxTernarySearchIterations) + 3 + 1
+)
+
 // Max number of pool points that can be consumed per tx. This roughly corresponds
 // to the maximum execution time (in ms) of protorev per tx
 const MaxPoolPointsPerTx uint64 = 50
@@ -28,6 +40,16 @@ const MaxPoolPointsPerTx uint64 = 50
 // to the maximum execution time (in ms) of protorev per block
 const MaxPoolPointsPerBlock uint64 = 200
 
+// Default gas limit of the execution of the backrun of a tx. The execution runs on its own gas meter,
+// so this gas is not charged to the tx, but bounds the execution in the event of an accidental infinite loop.
+const DefaultExecutionGasLimit uint64 = 50_000_000
+
+// Min and max gas limit of the execution of the backrun of a tx that can be set by the admin account
+const (
+	MinExecutionGasLimit uint64 = 1_000_000
+	MaxExecutionGasLimit uint64 = 200_000_000
+)
+
 // Max number of ticks we can move in a concentrated pool swap.
 const MaxTicksCrossed uint64 = 10
 
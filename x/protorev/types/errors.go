@@ -14,4 +14,8 @@ func (e NoPoolForDenomPairError) Error() string {
 	return fmt.Sprintf("highest liquidity pool between base %s and match denom %s not found", e.BaseDenom, e.MatchDenom)
 }
 
-var ErrRouteDoubleContainsPool = errors.New("cannot be trading on the same pool twice")
+var (
+	ErrRouteDoubleContainsPool = errors.New("cannot be trading on the same pool twice")
+	ErrBackrunOutOfGas         = errors.New("protorev failed due to lack of gas")
+	ErrBackrunPanicked         = errors.New("protorev failed due to internal reason")
+)
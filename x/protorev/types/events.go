@@ -1,7 +1,8 @@
 package types
 
 const (
-	TypeEvtBackrun = "protorev_backrun"
+	TypeEvtBackrun      = "protorev_backrun"
+	TypeEvtBlockSummary = "protorev_block_summary"
 
 	AttributeValueCategory               = ModuleName
 	AttributeKeyTxHash                   = "tx_hash"
@@ -14,4 +15,10 @@ const (
 	AttributeKeyProtorevAmountIn         = "amount_in"
 	AttributeKeyProtorevAmountOut        = "amount_out"
 	AttributeKeyProtorevArbDenom         = "arb_denom"
+	AttributeKeyRoutePoolIds             = "route_pool_ids"
+	AttributeKeyRouteDenoms              = "route_denoms"
+	AttributeKeyBeneficiary              = "beneficiary"
+	AttributeKeyBlockHeight              = "block_height"
+	AttributeKeyNumberOfTrades           = "number_of_trades"
+	AttributeKeyProfits                  = "profits"
 )
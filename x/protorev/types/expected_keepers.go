@@ -21,6 +21,7 @@ type AccountKeeper interface {
 // creating a x/protorev keeper.
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
@@ -65,6 +66,7 @@ type PoolManagerKeeper interface {
 	GetPoolModule(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolModuleI, error)
 	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 	RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+	GetCanonicalRoute(ctx sdk.Context, denom, quoteDenom string) ([]poolmanagertypes.SwapAmountInRoute, bool)
 	GetTakerFeeTrackerForStakers(ctx sdk.Context) []sdk.Coin
 	GetTakerFeeTrackerForCommunityPool(ctx sdk.Context) []sdk.Coin
 	GetTakerFeeTrackerStartHeight(ctx sdk.Context) int64
@@ -43,6 +43,8 @@ var (
 		CyclicArb:                  sdk.Coins(nil),
 		HeightAccountingStartsFrom: 0,
 	}
+	DefaultProfitDistributions   = ProfitDistributions{}
+	DefaultRevertedTradeAttempts = RevertedTradeAttempts{}
 )
 
 // DefaultGenesis returns the default genesis state
@@ -61,6 +63,9 @@ func DefaultGenesis() *GenesisState {
 		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
 		Profits:                DefaultProfits,
 		CyclicArbTracker:       &DefaultCyclicArbTracker,
+		ProfitDistributions:    DefaultProfitDistributions,
+		ExecutionGasLimit:      DefaultExecutionGasLimit,
+		RevertedTradeAttempts:  DefaultRevertedTradeAttempts,
 	}
 }
 
@@ -103,6 +108,18 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the profit distributions
+	if err := gs.ProfitDistributions.Validate(); err != nil {
+		return err
+	}
+
+	// Validate the execution gas limit if it is set
+	if gs.ExecutionGasLimit != 0 {
+		if err := ValidateExecutionGasLimit(gs.ExecutionGasLimit); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -60,6 +60,12 @@ type GenesisState struct {
 	// consumption of a swap on a given pool type.
 	InfoByPoolType   InfoByPoolType    `protobuf:"bytes,13,opt,name=info_by_pool_type,json=infoByPoolType,proto3" json:"info_by_pool_type" yaml:"info_by_pool_type"`
 	CyclicArbTracker *CyclicArbTracker `protobuf:"bytes,14,opt,name=cyclic_arb_tracker,json=cyclicArbTracker,proto3" json:"cyclic_arb_tracker,omitempty" yaml:"cyclic_arb_tracker"`
+	// The cumulative profits distributed by the module, per destination.
+	ProfitDistributions ProfitDistributions `protobuf:"bytes,15,opt,name=profit_distributions,json=profitDistributions,proto3" json:"profit_distributions" yaml:"profit_distributions"`
+	// The gas limit of the execution of the backrun of a tx.
+	ExecutionGasLimit uint64 `protobuf:"varint,16,opt,name=execution_gas_limit,json=executionGasLimit,proto3" json:"execution_gas_limit,omitempty" yaml:"execution_gas_limit"`
+	// The number of backrun attempts that were reverted, per reason.
+	RevertedTradeAttempts RevertedTradeAttempts `protobuf:"bytes,17,opt,name=reverted_trade_attempts,json=revertedTradeAttempts,proto3" json:"reverted_trade_attempts" yaml:"reverted_trade_attempts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -194,6 +200,27 @@ func (m *GenesisState) GetCyclicArbTracker() *CyclicArbTracker {
 	return nil
 }
 
+func (m *GenesisState) GetProfitDistributions() ProfitDistributions {
+	if m != nil {
+		return m.ProfitDistributions
+	}
+	return ProfitDistributions{}
+}
+
+func (m *GenesisState) GetExecutionGasLimit() uint64 {
+	if m != nil {
+		return m.ExecutionGasLimit
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRevertedTradeAttempts() RevertedTradeAttempts {
+	if m != nil {
+		return m.RevertedTradeAttempts
+	}
+	return RevertedTradeAttempts{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -203,57 +230,64 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 789 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0x8f, 0x69, 0x48, 0xe9, 0x6c, 0xba, 0x6a, 0x06, 0x12, 0xcd, 0xae, 0x88, 0xd7, 0x0c, 0x0d,
-	0xac, 0x50, 0x6b, 0xab, 0x01, 0x71, 0xe8, 0x01, 0x29, 0x0e, 0x2a, 0x20, 0x44, 0x15, 0x39, 0x41,
-	0x48, 0x20, 0x31, 0x8c, 0xed, 0xd9, 0x8d, 0x15, 0xdb, 0x63, 0xcd, 0xcc, 0xa6, 0xeb, 0x07, 0xe0,
-	0xce, 0xc3, 0xf0, 0x10, 0x3d, 0x56, 0x9c, 0x38, 0xad, 0x50, 0x72, 0xe4, 0xb6, 0x4f, 0x80, 0x3c,
-	0x33, 0xbb, 0x69, 0x37, 0x6b, 0x7a, 0xcb, 0x7c, 0xdf, 0xef, 0xcf, 0xf7, 0x1b, 0x7f, 0x93, 0x05,
-	0x9f, 0x70, 0x59, 0x70, 0x99, 0xc9, 0xa0, 0x12, 0x5c, 0x71, 0xc1, 0x2e, 0x83, 0xcb, 0x27, 0x31,
-	0x53, 0xf4, 0x49, 0x30, 0x66, 0x25, 0x93, 0x99, 0xf4, 0x75, 0x03, 0x22, 0x8b, 0xf3, 0x17, 0x38,
-	0xdf, 0xe2, 0xfa, 0x1f, 0x8c, 0xf9, 0x98, 0xeb, 0x6a, 0xd0, 0xfc, 0x65, 0x00, 0xfd, 0x4f, 0x5b,
-	0x75, 0x97, 0x02, 0x06, 0x78, 0xd0, 0x0e, 0xa4, 0x82, 0x16, 0xd6, 0xb0, 0xdf, 0x4b, 0x34, 0x8e,
-	0x18, 0x23, 0x73, 0xb0, 0x2d, 0xd7, 0x9c, 0x82, 0x98, 0x4a, 0xb6, 0x24, 0x27, 0x3c, 0x2b, 0x4d,
-	0x1f, 0xff, 0x0b, 0xc0, 0xf6, 0x37, 0x26, 0xcc, 0xa9, 0xa2, 0x8a, 0xc1, 0xaf, 0xc0, 0x96, 0xd1,
-	0x46, 0x8e, 0xe7, 0x0c, 0x3b, 0x87, 0x9e, 0xdf, 0x16, 0xce, 0x3f, 0xd1, 0xb8, 0x70, 0xf3, 0xe5,
-	0x6c, 0xb0, 0x11, 0x59, 0x16, 0xfc, 0xdd, 0x01, 0xbb, 0x8a, 0x5f, 0xb0, 0x92, 0x54, 0x34, 0x13,
-	0x84, 0x8a, 0x98, 0x08, 0x3e, 0x51, 0x4c, 0xa2, 0x77, 0xbc, 0x3b, 0xc3, 0xce, 0xe1, 0xa3, 0x76,
-	0xbd, 0xb3, 0x86, 0x76, 0x42, 0x33, 0x71, 0x24, 0xe2, 0x48, 0x73, 0xc2, 0x87, 0x8d, 0xf6, 0x7c,
-	0x36, 0xf8, 0xb0, 0xa6, 0x45, 0xfe, 0x14, 0xaf, 0x15, 0xc6, 0x11, 0x54, 0xb7, 0x98, 0xf0, 0x37,
-	0xd0, 0x69, 0x32, 0x93, 0x94, 0x95, 0xbc, 0x90, 0xe8, 0x8e, 0x36, 0xff, 0xb8, 0xdd, 0x3c, 0xa4,
-	0x92, 0x7d, 0xdd, 0x60, 0xc3, 0xbe, 0xf5, 0x84, 0xc6, 0xf3, 0x35, 0x15, 0x1c, 0x81, 0x78, 0x01,
-	0x93, 0xb0, 0x06, 0xdb, 0x15, 0xe7, 0x39, 0x79, 0xc1, 0xb2, 0xf1, 0xb9, 0x92, 0x68, 0x53, 0xdf,
-	0xd7, 0xc1, 0xff, 0xdc, 0x17, 0xe7, 0xf9, 0x4f, 0x06, 0x1c, 0x06, 0xd6, 0xe4, 0xc0, 0x98, 0xbc,
-	0x2e, 0x84, 0x1f, 0xa5, 0xac, 0x12, 0x2c, 0xa1, 0x8a, 0xa5, 0x4f, 0xb1, 0x12, 0x13, 0x86, 0x91,
-	0x13, 0x75, 0xaa, 0x1b, 0x36, 0x24, 0xa0, 0x97, 0xd2, 0x5a, 0x12, 0x99, 0x95, 0x09, 0x23, 0x05,
-	0x4f, 0x27, 0x39, 0x23, 0x76, 0x27, 0xd1, 0xbb, 0x9e, 0x33, 0xdc, 0x0c, 0x1f, 0xce, 0x67, 0x03,
-	0xcf, 0x88, 0xb7, 0x42, 0x71, 0xb4, 0xd7, 0xf4, 0x4e, 0x9b, 0xd6, 0x0f, 0xba, 0x63, 0x57, 0x01,
-	0x12, 0xd0, 0x4d, 0xd9, 0x25, 0xcb, 0x79, 0xc5, 0x04, 0x19, 0x31, 0x26, 0xd1, 0x96, 0xbe, 0xc0,
-	0x9e, 0x6f, 0xb7, 0xab, 0xb9, 0x87, 0x65, 0xb0, 0x63, 0x9e, 0x95, 0xe1, 0xbe, 0x4d, 0xb4, 0x6b,
-	0x4d, 0xdf, 0xa0, 0xe3, 0xe8, 0xfe, 0xb2, 0xf0, 0x8c, 0x31, 0x09, 0x9f, 0x83, 0xf7, 0x73, 0xaa,
-	0x98, 0x54, 0x24, 0xce, 0x79, 0x72, 0x41, 0xce, 0x75, 0x32, 0x74, 0x57, 0xcf, 0xee, 0xce, 0x67,
-	0x83, 0xbe, 0x91, 0x59, 0x03, 0xc2, 0xd1, 0x8e, 0xa9, 0x86, 0x4d, 0xf1, 0x5b, 0x5d, 0x83, 0xbf,
-	0x80, 0x9d, 0x1b, 0x47, 0x9a, 0xa6, 0x82, 0x49, 0x89, 0xde, 0xf3, 0x9c, 0xe1, 0xbd, 0xd0, 0x9f,
-	0xcf, 0x06, 0x68, 0x75, 0x28, 0x0b, 0xc1, 0x7f, 0xfd, 0xf9, 0xb8, 0x6b, 0x23, 0x1d, 0x99, 0x52,
-	0xf4, 0x60, 0x89, 0xb2, 0x15, 0xf8, 0x2b, 0xe8, 0x15, 0x74, 0x4a, 0xf4, 0x47, 0xaa, 0x78, 0x56,
-	0x2a, 0x49, 0x1a, 0x0d, 0x3d, 0x14, 0xba, 0xb7, 0x7a, 0xdd, 0xad, 0x50, 0x1c, 0xed, 0x16, 0x74,
-	0xda, 0x6c, 0xc1, 0x89, 0xee, 0x9c, 0x30, 0xa1, 0x23, 0xc0, 0x1f, 0xc1, 0xde, 0x3a, 0x92, 0x9a,
-	0x22, 0xa0, 0xc5, 0x3f, 0x9a, 0xcf, 0x06, 0xfb, 0xed, 0xe2, 0x6a, 0x8a, 0x23, 0xb8, 0xaa, 0x7c,
-	0x36, 0x85, 0xa7, 0x60, 0x57, 0xa3, 0x48, 0xc2, 0x27, 0xa5, 0x22, 0x23, 0xbe, 0x18, 0xb9, 0xa3,
-	0x55, 0xbd, 0x9b, 0x77, 0xb5, 0x16, 0x86, 0x23, 0xa8, 0xeb, 0xc7, 0x4d, 0xf9, 0x19, 0xb7, 0xb3,
-	0x7e, 0x0f, 0xee, 0x56, 0x82, 0x8f, 0x32, 0x25, 0xd1, 0xf6, 0xdb, 0x56, 0x62, 0xcf, 0xae, 0x44,
-	0xd7, 0xba, 0x18, 0x1e, 0x8e, 0x16, 0x0a, 0x70, 0x02, 0x76, 0xb2, 0x72, 0xc4, 0x49, 0x5c, 0x9b,
-	0x50, 0xaa, 0xae, 0x18, 0xba, 0xaf, 0xdf, 0xd1, 0xb0, 0xfd, 0x1d, 0x7d, 0x57, 0x8e, 0x78, 0x58,
-	0x37, 0x69, 0xcf, 0xea, 0x8a, 0x85, 0x9e, 0x75, 0xb1, 0xdf, 0xf8, 0x96, 0x20, 0x8e, 0xba, 0xd9,
-	0x1b, 0x0c, 0xf8, 0x02, 0xc0, 0xa4, 0x4e, 0xf2, 0x2c, 0xd1, 0xff, 0x45, 0x94, 0xa0, 0xc9, 0x05,
-	0x13, 0xa8, 0xab, 0x7d, 0x3f, 0x6b, 0xf7, 0x3d, 0xd6, 0x9c, 0x23, 0x11, 0x9f, 0x19, 0x46, 0xb8,
-	0x3f, 0x9f, 0x0d, 0x7a, 0xc6, 0xf5, 0xb6, 0x1e, 0x8e, 0x1e, 0x24, 0xab, 0x84, 0xe7, 0x2f, 0xaf,
-	0x5c, 0xe7, 0xd5, 0x95, 0xeb, 0xfc, 0x73, 0xe5, 0x3a, 0x7f, 0x5c, 0xbb, 0x1b, 0xaf, 0xae, 0xdd,
-	0x8d, 0xbf, 0xaf, 0xdd, 0x8d, 0x9f, 0xbf, 0x18, 0x67, 0xea, 0x7c, 0x12, 0xfb, 0x09, 0x2f, 0x02,
-	0x3b, 0xc0, 0xe3, 0x9c, 0xc6, 0x72, 0x71, 0x08, 0x2e, 0x0f, 0xbf, 0x0c, 0xa6, 0x37, 0xbf, 0x03,
-	0x4d, 0x30, 0x19, 0x6f, 0xe9, 0xf3, 0xe7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x57, 0x7e, 0x07,
-	0xec, 0xa9, 0x06, 0x00, 0x00,
+	// 907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xcf, 0xfe, 0x9b, 0x7f, 0x4a, 0x37, 0x89, 0x89, 0xa7, 0x75, 0x58, 0x1b, 0x62, 0x9b, 0x69,
+	0x53, 0x2c, 0xd4, 0x78, 0xd5, 0x80, 0xb8, 0xe8, 0x05, 0x52, 0x36, 0x55, 0x0b, 0x02, 0xaa, 0x68,
+	0x63, 0x84, 0x04, 0x12, 0xc3, 0xec, 0xee, 0xd8, 0x19, 0x65, 0x77, 0x67, 0x35, 0x33, 0x76, 0xed,
+	0x07, 0xe0, 0x1a, 0x1e, 0x86, 0x87, 0xe8, 0x65, 0x85, 0x84, 0xc4, 0x95, 0x85, 0x92, 0x37, 0xf0,
+	0x13, 0xa0, 0xf9, 0xb0, 0x93, 0x38, 0xde, 0x72, 0x97, 0x39, 0xe7, 0xf7, 0x71, 0x7e, 0xe3, 0x33,
+	0x1b, 0xf7, 0x31, 0x13, 0x19, 0x13, 0x54, 0xf8, 0x05, 0x67, 0x92, 0x71, 0x32, 0xf2, 0x47, 0x4f,
+	0x23, 0x22, 0xf1, 0x53, 0x7f, 0x40, 0x72, 0x22, 0xa8, 0xe8, 0xea, 0x06, 0xf0, 0x2c, 0xae, 0x3b,
+	0xc7, 0x75, 0x2d, 0xae, 0xf1, 0x60, 0xc0, 0x06, 0x4c, 0x57, 0x7d, 0xf5, 0x97, 0x01, 0x34, 0x3e,
+	0x29, 0xd5, 0x5d, 0x08, 0x18, 0xe0, 0x7e, 0x39, 0x10, 0x73, 0x9c, 0x59, 0xc3, 0x46, 0x3d, 0xd6,
+	0x38, 0x64, 0x8c, 0xcc, 0xc1, 0xb6, 0x9a, 0xe6, 0xe4, 0x47, 0x58, 0x90, 0x05, 0x39, 0x66, 0x34,
+	0x37, 0x7d, 0xf8, 0xd7, 0xb6, 0xbb, 0xf5, 0xd2, 0x84, 0x39, 0x95, 0x58, 0x12, 0xf0, 0xa5, 0xbb,
+	0x61, 0xb4, 0x3d, 0xa7, 0xed, 0x74, 0x36, 0x0f, 0xdb, 0xdd, 0xb2, 0x70, 0xdd, 0x13, 0x8d, 0x0b,
+	0xd6, 0xdf, 0x4c, 0x5b, 0x6b, 0xa1, 0x65, 0x81, 0x5f, 0x1d, 0xb7, 0x26, 0xd9, 0x39, 0xc9, 0x51,
+	0x81, 0x29, 0x47, 0x98, 0x47, 0x88, 0xb3, 0xa1, 0x24, 0xc2, 0xfb, 0x5f, 0xfb, 0x4e, 0x67, 0xf3,
+	0xf0, 0x49, 0xb9, 0x5e, 0x4f, 0xd1, 0x4e, 0x30, 0xe5, 0x47, 0x3c, 0x0a, 0x35, 0x27, 0x78, 0xa4,
+	0xb4, 0x67, 0xd3, 0xd6, 0x47, 0x13, 0x9c, 0xa5, 0xcf, 0xe0, 0x4a, 0x61, 0x18, 0x02, 0x79, 0x8b,
+	0x09, 0x7e, 0x71, 0x37, 0x55, 0x66, 0x94, 0x90, 0x9c, 0x65, 0xc2, 0xbb, 0xa3, 0xcd, 0x1f, 0x96,
+	0x9b, 0x07, 0x58, 0x90, 0xe7, 0x0a, 0x1b, 0x34, 0xac, 0x27, 0x30, 0x9e, 0xd7, 0x54, 0x60, 0xe8,
+	0x46, 0x73, 0x98, 0x00, 0x13, 0x77, 0xab, 0x60, 0x2c, 0x45, 0xaf, 0x09, 0x1d, 0x9c, 0x49, 0xe1,
+	0xad, 0xeb, 0xfb, 0xda, 0x7f, 0xc7, 0x7d, 0x31, 0x96, 0xfe, 0x60, 0xc0, 0x81, 0x6f, 0x4d, 0xf6,
+	0x8d, 0xc9, 0x75, 0x21, 0xf8, 0x24, 0x21, 0x05, 0x27, 0x31, 0x96, 0x24, 0x79, 0x06, 0x25, 0x1f,
+	0x12, 0xe8, 0x39, 0xe1, 0x66, 0x71, 0xc5, 0x06, 0xc8, 0xad, 0x27, 0x78, 0x22, 0x90, 0xa0, 0x79,
+	0x4c, 0x50, 0xc6, 0x92, 0x61, 0x4a, 0x90, 0xdd, 0x49, 0xef, 0xff, 0x6d, 0xa7, 0xb3, 0x1e, 0x3c,
+	0x9a, 0x4d, 0x5b, 0x6d, 0x23, 0x5e, 0x0a, 0x85, 0xe1, 0xae, 0xea, 0x9d, 0xaa, 0xd6, 0x77, 0xba,
+	0x63, 0x57, 0x01, 0x20, 0xb7, 0x92, 0x90, 0x11, 0x49, 0x59, 0x41, 0x38, 0xea, 0x13, 0x22, 0xbc,
+	0x0d, 0x7d, 0x81, 0xf5, 0xae, 0xdd, 0x2e, 0x75, 0x0f, 0x8b, 0x60, 0xc7, 0x8c, 0xe6, 0xc1, 0x9e,
+	0x4d, 0x54, 0xb3, 0xa6, 0x37, 0xe8, 0x30, 0xdc, 0x5e, 0x14, 0x5e, 0x10, 0x22, 0xc0, 0x2b, 0xf7,
+	0x7e, 0x8a, 0x25, 0x11, 0x12, 0x45, 0x29, 0x8b, 0xcf, 0xd1, 0x99, 0x4e, 0xe6, 0xdd, 0xd5, 0xb3,
+	0x37, 0x67, 0xd3, 0x56, 0xc3, 0xc8, 0xac, 0x00, 0xc1, 0xb0, 0x6a, 0xaa, 0x81, 0x2a, 0x7e, 0xa5,
+	0x6b, 0xe0, 0x27, 0xb7, 0x7a, 0xe5, 0x88, 0x93, 0x84, 0x13, 0x21, 0xbc, 0xf7, 0xda, 0x4e, 0xe7,
+	0x5e, 0xd0, 0x9d, 0x4d, 0x5b, 0xde, 0xf2, 0x50, 0x16, 0x02, 0xff, 0xfc, 0xe3, 0xa0, 0x62, 0x23,
+	0x1d, 0x99, 0x52, 0xb8, 0xb3, 0x40, 0xd9, 0x0a, 0xf8, 0xd9, 0xad, 0x67, 0x78, 0x8c, 0xf4, 0x8f,
+	0x54, 0x30, 0x9a, 0x4b, 0x81, 0x94, 0x86, 0x1e, 0xca, 0xbb, 0xb7, 0x7c, 0xdd, 0xa5, 0x50, 0x18,
+	0xd6, 0x32, 0x3c, 0x56, 0x5b, 0x70, 0xa2, 0x3b, 0x27, 0x84, 0xeb, 0x08, 0xe0, 0x7b, 0x77, 0x77,
+	0x15, 0x49, 0x8e, 0x3d, 0x57, 0x8b, 0x7f, 0x3c, 0x9b, 0xb6, 0xf6, 0xca, 0xc5, 0xe5, 0x18, 0x86,
+	0x60, 0x59, 0xb9, 0x37, 0x06, 0xa7, 0x6e, 0x4d, 0xa3, 0x50, 0xcc, 0x86, 0xb9, 0x44, 0x7d, 0x36,
+	0x1f, 0x79, 0x53, 0xab, 0xb6, 0xaf, 0xde, 0xd5, 0x4a, 0x18, 0x0c, 0x81, 0xae, 0x1f, 0xab, 0xf2,
+	0x0b, 0x66, 0x67, 0xfd, 0xc6, 0xbd, 0x5b, 0x70, 0xd6, 0xa7, 0x52, 0x78, 0x5b, 0xff, 0xb5, 0x12,
+	0xbb, 0x76, 0x25, 0x2a, 0xd6, 0xc5, 0xf0, 0x60, 0x38, 0x57, 0x00, 0x43, 0xb7, 0x4a, 0xf3, 0x3e,
+	0x43, 0xd1, 0xc4, 0x84, 0x92, 0x93, 0x82, 0x78, 0xdb, 0xfa, 0x1d, 0x75, 0xca, 0xdf, 0xd1, 0xd7,
+	0x79, 0x9f, 0x05, 0x13, 0x95, 0xb6, 0x37, 0x29, 0x48, 0xd0, 0xb6, 0x2e, 0xf6, 0x37, 0xbe, 0x25,
+	0x08, 0xc3, 0x0a, 0xbd, 0xc1, 0x00, 0xaf, 0x5d, 0x10, 0x4f, 0xe2, 0x94, 0xc6, 0xfa, 0x2b, 0x22,
+	0x39, 0x8e, 0xcf, 0x09, 0xf7, 0x2a, 0xda, 0xf7, 0xd3, 0x72, 0xdf, 0x63, 0xcd, 0x39, 0xe2, 0x51,
+	0xcf, 0x30, 0x82, 0xbd, 0xd9, 0xb4, 0x55, 0x37, 0xae, 0xb7, 0xf5, 0x60, 0xb8, 0x13, 0x2f, 0x11,
+	0xd4, 0xc7, 0xf1, 0x81, 0xc9, 0x8e, 0x12, 0x2a, 0x24, 0xa7, 0xd1, 0x50, 0x52, 0x96, 0x0b, 0xef,
+	0x7d, 0xed, 0x7d, 0xf0, 0x8e, 0x6f, 0x87, 0x66, 0x3d, 0xbf, 0x4e, 0x0a, 0x1e, 0xda, 0xe0, 0x1f,
+	0x5e, 0xbf, 0xde, 0x9b, 0xc2, 0x30, 0xbc, 0x5f, 0xdc, 0x66, 0xaa, 0xd7, 0x47, 0xc6, 0x24, 0xd6,
+	0x27, 0x34, 0xc0, 0x02, 0xa5, 0x34, 0xa3, 0xd2, 0xdb, 0x59, 0x7e, 0x7d, 0x2b, 0x40, 0x30, 0xac,
+	0x2e, 0xaa, 0x2f, 0xb1, 0xf8, 0x56, 0xd5, 0xc0, 0x6f, 0x8e, 0xfb, 0x01, 0x27, 0x23, 0xc2, 0x25,
+	0x49, 0x54, 0xfe, 0x84, 0x20, 0x2c, 0x25, 0xc9, 0x0a, 0x29, 0xbc, 0xaa, 0x8e, 0xe6, 0x97, 0x47,
+	0x0b, 0x2d, 0xb1, 0xa7, 0x78, 0x47, 0x96, 0x16, 0x3c, 0xb6, 0xe1, 0x9a, 0x66, 0x92, 0x12, 0x75,
+	0x18, 0xd6, 0xf8, 0x4a, 0xfa, 0xab, 0x37, 0x17, 0x4d, 0xe7, 0xed, 0x45, 0xd3, 0xf9, 0xe7, 0xa2,
+	0xe9, 0xfc, 0x7e, 0xd9, 0x5c, 0x7b, 0x7b, 0xd9, 0x5c, 0xfb, 0xfb, 0xb2, 0xb9, 0xf6, 0xe3, 0xe7,
+	0x03, 0x2a, 0xcf, 0x86, 0x51, 0x37, 0x66, 0x99, 0x6f, 0x67, 0x3a, 0x48, 0x71, 0x24, 0xe6, 0x07,
+	0x7f, 0x74, 0xf8, 0x85, 0x3f, 0xbe, 0xfa, 0x8f, 0xab, 0x56, 0x48, 0x44, 0x1b, 0xfa, 0xfc, 0xd9,
+	0xbf, 0x03, 0x00, 0xbc, 0x70, 0x6d, 0x98, 0x13, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -276,6 +310,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.RevertedTradeAttempts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.ExecutionGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutionGasLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.ProfitDistributions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
 	if m.CyclicArbTracker != nil {
 		{
 			size, err := m.CyclicArbTracker.MarshalToSizedBuffer(dAtA[:i])
@@ -479,6 +542,13 @@ func (m *GenesisState) Size() (n int) {
 		l = m.CyclicArbTracker.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.ProfitDistributions.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ExecutionGasLimit != 0 {
+		n += 2 + sovGenesis(uint64(m.ExecutionGasLimit))
+	}
+	l = m.RevertedTradeAttempts.Size()
+	n += 2 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -915,6 +985,91 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfitDistributions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasLimit", wireType)
+			}
+			m.ExecutionGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedTradeAttempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevertedTradeAttempts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -20,6 +20,24 @@ func TestGenesisStateValidate(t *testing.T) {
 			genState:    types.DefaultGenesis(),
 			valid:       true,
 		},
+		{
+			description: "Unset execution gas limit",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.ExecutionGasLimit = 0
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			description: "Execution gas limit above the max",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.ExecutionGasLimit = types.MaxExecutionGasLimit + 1
+				return genState
+			}(),
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
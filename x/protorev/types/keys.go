@@ -41,6 +41,11 @@ const (
 	prefixcyclicArbTracker
 	prefixcyclicArbTrackerStartHeight
 	prefixBaseDenoms
+	prefixBlockNumberOfTrades
+	prefixBlockProfitsByDenom
+	prefixProfitDistributions
+	prefixExecutionGasLimit
+	prefixRevertedTradeAttempts
 )
 
 var (
@@ -103,6 +108,22 @@ var (
 
 	// KeyPrefixBaseDenoms is the prefix that is used to store the base denoms that are used to create cyclic arbitrage routes
 	KeyPrefixBaseDenoms = []byte{prefixBaseDenoms}
+
+	// KeyProfitDistributions is the key for store that keeps track of the cumulative profits distributed per destination
+	KeyProfitDistributions = []byte{prefixProfitDistributions}
+
+	// KeyExecutionGasLimit is the key for store that keeps track of the gas limit of the execution of the backrun of a tx
+	KeyExecutionGasLimit = []byte{prefixExecutionGasLimit}
+
+	// KeyRevertedTradeAttempts is the key for store that keeps track of the number of reverted backrun attempts per reason
+	KeyRevertedTradeAttempts = []byte{prefixRevertedTradeAttempts}
+
+	// -------------- Keys for block summary (transient) stores -------------- //
+	// KeyPrefixBlockNumberOfTrades is the prefix for the transient store that keeps track of the number of trades executed in the current block
+	KeyPrefixBlockNumberOfTrades = []byte{prefixBlockNumberOfTrades}
+
+	// KeyPrefixBlockProfitsByDenom is the prefix for the transient store that keeps track of the profits made in the current block
+	KeyPrefixBlockProfitsByDenom = []byte{prefixBlockProfitsByDenom}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -125,6 +146,11 @@ func GetKeyPrefixProfitByDenom(denom string) []byte {
 	return append(KeyPrefixProfitByDenom, []byte(denom)...)
 }
 
+// Returns the key needed to fetch the profit made in the current block by denom
+func GetKeyPrefixBlockProfitByDenom(denom string) []byte {
+	return append(KeyPrefixBlockProfitsByDenom, []byte(denom)...)
+}
+
 // Returns the key needed to fetch the number of trades by route
 func GetKeyPrefixTradesByRoute(route []uint64) []byte {
 	return append(KeyPrefixTradesByRoute, CreateRouteKey(route)...)
@@ -12,6 +12,7 @@ var (
 	_ sdk.Msg = &MsgSetMaxPoolPointsPerBlock{}
 	_ sdk.Msg = &MsgSetInfoByPoolType{}
 	_ sdk.Msg = &MsgSetBaseDenoms{}
+	_ sdk.Msg = &MsgSetExecutionGasLimit{}
 )
 
 const (
@@ -21,6 +22,7 @@ const (
 	TypeMsgSetMaxPoolPointsPerBlock = "set_max_pool_points_per_block"
 	TypeMsgSetPoolTypeInfo          = "set_info_by_pool_type"
 	TypeMsgSetBaseDenoms            = "set_base_denoms"
+	TypeMsgSetExecutionGasLimit     = "set_execution_gas_limit"
 )
 
 // ---------------------- Interface for MsgSetHotRoutes ---------------------- //
@@ -263,3 +265,43 @@ func (msg MsgSetBaseDenoms) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.Admin)
 	return []sdk.AccAddress{addr}
 }
+
+// ---------------------- Interface for MsgSetExecutionGasLimit ---------------------- //
+// NewMsgSetExecutionGasLimit creates a new MsgSetExecutionGasLimit instance
+func NewMsgSetExecutionGasLimit(admin string, executionGasLimit uint64) *MsgSetExecutionGasLimit {
+	return &MsgSetExecutionGasLimit{
+		Admin:             admin,
+		ExecutionGasLimit: executionGasLimit,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetExecutionGasLimit) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetExecutionGasLimit) Type() string {
+	return TypeMsgSetExecutionGasLimit
+}
+
+// ValidateBasic validates the MsgSetExecutionGasLimit
+func (msg MsgSetExecutionGasLimit) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	// Execution gas limit must be in the valid range
+	if err := ValidateExecutionGasLimit(msg.ExecutionGasLimit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetExecutionGasLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
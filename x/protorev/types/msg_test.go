@@ -507,6 +507,52 @@ func TestMsgSetMaxPoolPointsPerBlock(t *testing.T) {
 	}
 }
 
+func TestMsgSetExecutionGasLimit(t *testing.T) {
+	cases := []struct {
+		description       string
+		admin             string
+		executionGasLimit uint64
+		pass              bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			types.DefaultExecutionGasLimit,
+			false,
+		},
+		{
+			"Invalid message (execution gas limit too low)",
+			createAccount().String(),
+			types.MinExecutionGasLimit - 1,
+			false,
+		},
+		{
+			"Valid message",
+			createAccount().String(),
+			types.DefaultExecutionGasLimit,
+			true,
+		},
+		{
+			"Invalid message (execution gas limit too high)",
+			createAccount().String(),
+			types.MaxExecutionGasLimit + 1,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			msg := types.NewMsgSetExecutionGasLimit(tc.admin, tc.executionGasLimit)
+			err := msg.ValidateBasic()
+			if tc.pass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgSetPoolTypeInfo(t *testing.T) {
 	cases := []struct {
 		description    string
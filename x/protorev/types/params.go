@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -19,8 +21,17 @@ var (
 	// as of V24. This address is equivalent to osmo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqmcn030.
 	DefaultNullAddress = sdk.AccAddress([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
 
-	ParamStoreKeyEnableModule = []byte("EnableProtoRevModule")
-	ParamStoreKeyAdminAccount = []byte("AdminAccount")
+	// DefaultProfitDistribution has all weights set to zero, so OSMO profits are burned and all other profits
+	// are sent to the community pool.
+	DefaultProfitDistribution = ProfitDistribution{
+		BurnWeight:          osmomath.ZeroDec(),
+		CommunityPoolWeight: osmomath.ZeroDec(),
+		StakerWeight:        osmomath.ZeroDec(),
+	}
+
+	ParamStoreKeyEnableModule       = []byte("EnableProtoRevModule")
+	ParamStoreKeyAdminAccount       = []byte("AdminAccount")
+	ParamStoreKeyProfitDistribution = []byte("ProfitDistribution")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -29,16 +40,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enable bool, admin string) Params {
+func NewParams(enable bool, admin string, profitDistribution ProfitDistribution) Params {
 	return Params{
-		Enabled: enable,
-		Admin:   admin,
+		Enabled:            enable,
+		Admin:              admin,
+		ProfitDistribution: profitDistribution,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableModule, DefaultAdminAccount)
+	return NewParams(DefaultEnableModule, DefaultAdminAccount, DefaultProfitDistribution)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -46,6 +58,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableModule, &p.Enabled, ValidateBoolean),
 		paramtypes.NewParamSetPair(ParamStoreKeyAdminAccount, &p.Admin, ValidateAccount),
+		paramtypes.NewParamSetPair(ParamStoreKeyProfitDistribution, &p.ProfitDistribution, ValidateProfitDistribution),
 	}
 }
 
@@ -55,6 +68,40 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid admin account address: %s", p.Admin)
 	}
 
+	if err := ValidateProfitDistribution(p.ProfitDistribution); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsLegacy returns true if all weights are zero, in which case OSMO profits are burned
+// and all other profits are sent to the community pool.
+func (d ProfitDistribution) IsLegacy() bool {
+	return d.BurnWeight.IsZero() && d.CommunityPoolWeight.IsZero() && d.StakerWeight.IsZero()
+}
+
+// ValidateProfitDistribution validates that the profit distribution weights are non-negative,
+// and either sum to one or are all zero.
+func ValidateProfitDistribution(i interface{}) error {
+	v, ok := i.(ProfitDistribution)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	weights := []osmomath.Dec{v.BurnWeight, v.CommunityPoolWeight, v.StakerWeight}
+	sum := osmomath.ZeroDec()
+	for _, weight := range weights {
+		if weight.IsNil() || weight.IsNegative() {
+			return fmt.Errorf("profit distribution weights must be non-negative: %s", weight)
+		}
+		sum = sum.Add(weight)
+	}
+
+	if !sum.IsZero() && !sum.Equal(osmomath.OneDec()) {
+		return fmt.Errorf("profit distribution weights must sum to one, or all be zero: %s", sum)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -30,6 +31,9 @@ type Params struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 	// The admin account (settings manager) of the protorev module.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// How the arbitrage profits remaining after the developer fee are
+	// distributed at the end of each week.
+	ProfitDistribution ProfitDistribution `protobuf:"bytes,3,opt,name=profit_distribution,json=profitDistribution,proto3" json:"profit_distribution" yaml:"profit_distribution"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +83,63 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetProfitDistribution() ProfitDistribution {
+	if m != nil {
+		return m.ProfitDistribution
+	}
+	return ProfitDistribution{}
+}
+
+// ProfitDistribution splits the arbitrage profits remaining after the
+// developer fee between burning, the community pool and stakers. The weights
+// must sum to one. If all weights are zero, OSMO profits are burned and all
+// other profits are sent to the community pool.
+type ProfitDistribution struct {
+	// The share of the profits that is burned.
+	BurnWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=burn_weight,json=burnWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_weight" yaml:"burn_weight"`
+	// The share of the profits that is sent to the community pool.
+	CommunityPoolWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool_weight,json=communityPoolWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_weight" yaml:"community_pool_weight"`
+	// The share of the profits that is distributed to stakers through the
+	// txfees fee collectors.
+	StakerWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=staker_weight,json=stakerWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staker_weight" yaml:"staker_weight"`
+}
+
+func (m *ProfitDistribution) Reset()         { *m = ProfitDistribution{} }
+func (m *ProfitDistribution) String() string { return proto.CompactTextString(m) }
+func (*ProfitDistribution) ProtoMessage()    {}
+func (*ProfitDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72168e5a5a65ae7e, []int{1}
+}
+func (m *ProfitDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitDistribution.Merge(m, src)
+}
+func (m *ProfitDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitDistribution proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
+	proto.RegisterType((*ProfitDistribution)(nil), "osmosis.protorev.v1beta1.ProfitDistribution")
 }
 
 func init() {
@@ -88,22 +147,35 @@ func init() {
 }
 
 var fileDescriptor_72168e5a5a65ae7e = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0x8b, 0x0b, 0x49,
-	0x40, 0x95, 0xe9, 0xc1, 0x94, 0xe9, 0x41, 0x95, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45,
-	0xf5, 0x41, 0x2c, 0x88, 0x02, 0x29, 0xc9, 0x64, 0xb0, 0x86, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91,
-	0x52, 0x8a, 0xe3, 0x62, 0x0b, 0x00, 0x1b, 0x2d, 0xa4, 0xc3, 0xc5, 0x9e, 0x9a, 0x97, 0x98, 0x94,
-	0x93, 0x9a, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe1, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54, 0x42, 0x29, 0x08, 0xa6, 0x44, 0x48, 0x8d, 0x8b, 0x35, 0x31,
-	0x25, 0x37, 0x33, 0x4f, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x1e,
-	0x88, 0x5a, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0xda, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xa1, 0xfe, 0xd1, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0xcc, 0xf4, 0x2b, 0x10,
-	0x21, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xe6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xa4, 0xa0, 0xeb, 0x9b, 0x2a, 0x01, 0x00, 0x00,
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x5a, 0xac, 0x76, 0x5a, 0x45, 0xa6, 0x15, 0xd6, 0x55, 0x92, 0x65, 0x40, 0xd9,
+	0x43, 0x3b, 0x43, 0xab, 0x78, 0xf0, 0x18, 0x16, 0x44, 0x10, 0x59, 0xf7, 0x22, 0x78, 0x59, 0x26,
+	0xd9, 0x31, 0x3b, 0x34, 0x93, 0x09, 0x99, 0xc9, 0x6a, 0x8e, 0x1e, 0xbc, 0xfb, 0x61, 0xfc, 0x10,
+	0x3d, 0x16, 0x4f, 0xe2, 0x21, 0x48, 0xf6, 0x1b, 0xe4, 0xe0, 0x59, 0x76, 0x26, 0xd1, 0xda, 0x56,
+	0xe8, 0x2d, 0xef, 0xfd, 0xff, 0xef, 0xff, 0x9b, 0x79, 0x64, 0xe0, 0x23, 0xa5, 0xa5, 0xd2, 0x42,
+	0xd3, 0x2c, 0x57, 0x46, 0xe5, 0x7c, 0x49, 0x97, 0x47, 0x21, 0x37, 0xec, 0x88, 0x66, 0x2c, 0x67,
+	0x52, 0x13, 0xdb, 0x47, 0xfd, 0xd6, 0x46, 0x3a, 0x1b, 0x69, 0x6d, 0x83, 0xfd, 0x58, 0xc5, 0xca,
+	0x76, 0xe9, 0xfa, 0xcb, 0x19, 0x06, 0xf7, 0x23, 0x3b, 0x30, 0x73, 0x82, 0x2b, 0x9c, 0x84, 0x6b,
+	0x00, 0xb7, 0x26, 0x36, 0x1b, 0x1d, 0xc0, 0x9b, 0x3c, 0x65, 0x61, 0xc2, 0xe7, 0x7d, 0x30, 0x04,
+	0xa3, 0x5b, 0x01, 0x6a, 0x2a, 0xff, 0x4e, 0xc9, 0x64, 0xf2, 0x1c, 0xb7, 0x02, 0x9e, 0x76, 0x16,
+	0xf4, 0x18, 0xde, 0x60, 0x73, 0x29, 0xd2, 0xfe, 0xc6, 0x10, 0x8c, 0xb6, 0x83, 0xbb, 0x4d, 0xe5,
+	0xef, 0x3a, 0xaf, 0x6d, 0xe3, 0xa9, 0x93, 0xd1, 0x27, 0x00, 0xf7, 0xb2, 0x5c, 0xbd, 0x17, 0x66,
+	0x36, 0x17, 0xda, 0xe4, 0x22, 0x2c, 0x8c, 0x50, 0x69, 0x7f, 0x73, 0x08, 0x46, 0x3b, 0xc7, 0x07,
+	0xe4, 0x7f, 0x57, 0x21, 0x13, 0x3b, 0x34, 0x3e, 0x37, 0x13, 0xe0, 0xd3, 0xca, 0xef, 0x35, 0x95,
+	0x3f, 0x70, 0xa0, 0x2b, 0x62, 0xf1, 0x14, 0x65, 0x97, 0xe6, 0xf0, 0xaf, 0x0d, 0x88, 0x2e, 0xc7,
+	0xa1, 0x05, 0xdc, 0x09, 0x8b, 0x3c, 0x9d, 0x7d, 0xe0, 0x22, 0x5e, 0x18, 0x7b, 0xe9, 0xed, 0xe0,
+	0xc5, 0x9a, 0xf1, 0xa3, 0xf2, 0x1f, 0xb8, 0x35, 0xe9, 0xf9, 0x09, 0x11, 0x8a, 0x4a, 0x66, 0x16,
+	0xe4, 0x15, 0x8f, 0x59, 0x54, 0x8e, 0x79, 0xd4, 0x54, 0x3e, 0x72, 0x47, 0x38, 0x37, 0x8f, 0xbf,
+	0x7d, 0x3d, 0x84, 0xed, 0x6e, 0xc7, 0x3c, 0x9a, 0xc2, 0xb5, 0xf6, 0xd6, 0x4a, 0xe8, 0x33, 0x80,
+	0xf7, 0x22, 0x25, 0x65, 0x91, 0x0a, 0x53, 0xce, 0x32, 0xa5, 0x92, 0x0e, 0xea, 0xb6, 0xf7, 0xe6,
+	0x7a, 0xd0, 0x87, 0x0e, 0x7a, 0x65, 0xd2, 0x45, 0xfc, 0xde, 0x1f, 0xd7, 0x44, 0xa9, 0xa4, 0x3d,
+	0x47, 0x0a, 0x6f, 0x6b, 0xc3, 0x4e, 0x78, 0xde, 0xe1, 0x37, 0x2d, 0xfe, 0xe5, 0xf5, 0xf0, 0xfb,
+	0x0e, 0xff, 0x4f, 0xc2, 0x45, 0xec, 0xae, 0x53, 0x1d, 0x2f, 0x78, 0x7d, 0x5a, 0x7b, 0xe0, 0xac,
+	0xf6, 0xc0, 0xcf, 0xda, 0x03, 0x5f, 0x56, 0x5e, 0xef, 0x6c, 0xe5, 0xf5, 0xbe, 0xaf, 0xbc, 0xde,
+	0xbb, 0xa7, 0xb1, 0x30, 0x8b, 0x22, 0x24, 0x91, 0x92, 0xb4, 0xfd, 0x05, 0x0e, 0x13, 0x16, 0xea,
+	0xae, 0xa0, 0xcb, 0xe3, 0x67, 0xf4, 0xe3, 0xdf, 0x77, 0x60, 0xca, 0x8c, 0xeb, 0x70, 0xcb, 0xd6,
+	0x4f, 0x7e, 0x0f, 0x00, 0xc7, 0x1d, 0xcf, 0x4c, 0x28, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +198,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ProfitDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -146,6 +228,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProfitDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfitDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfitDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StakerWeight.Size()
+		i -= size
+		if _, err := m.StakerWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPoolWeight.Size()
+		i -= size
+		if _, err := m.CommunityPoolWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BurnWeight.Size()
+		i -= size
+		if _, err := m.BurnWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -170,6 +305,23 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = m.ProfitDistribution.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ProfitDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommunityPoolWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.StakerWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -260,6 +412,191 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfitDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfitDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfitDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfitDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakerWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
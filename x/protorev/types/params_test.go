@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/protorev/types"
+)
+
+func TestValidateProfitDistribution(t *testing.T) {
+	cases := []struct {
+		description  string
+		distribution types.ProfitDistribution
+		valid        bool
+	}{
+		{
+			description:  "Default profit distribution",
+			distribution: types.DefaultProfitDistribution,
+			valid:        true,
+		},
+		{
+			description: "Weights sum to one",
+			distribution: types.ProfitDistribution{
+				BurnWeight:          osmomath.MustNewDecFromStr("0.5"),
+				CommunityPoolWeight: osmomath.MustNewDecFromStr("0.25"),
+				StakerWeight:        osmomath.MustNewDecFromStr("0.25"),
+			},
+			valid: true,
+		},
+		{
+			description: "Weights sum to less than one",
+			distribution: types.ProfitDistribution{
+				BurnWeight:          osmomath.MustNewDecFromStr("0.5"),
+				CommunityPoolWeight: osmomath.MustNewDecFromStr("0.25"),
+				StakerWeight:        osmomath.ZeroDec(),
+			},
+			valid: false,
+		},
+		{
+			description: "Negative weight",
+			distribution: types.ProfitDistribution{
+				BurnWeight:          osmomath.MustNewDecFromStr("1.5"),
+				CommunityPoolWeight: osmomath.MustNewDecFromStr("-0.5"),
+				StakerWeight:        osmomath.ZeroDec(),
+			},
+			valid: false,
+		},
+		{
+			description: "Nil weight",
+			distribution: types.ProfitDistribution{
+				BurnWeight:          osmomath.OneDec(),
+				CommunityPoolWeight: osmomath.ZeroDec(),
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := types.ValidateProfitDistribution(tc.distribution)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
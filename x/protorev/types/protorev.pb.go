@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -521,6 +522,9 @@ type ConcentratedPoolInfo struct {
 	Weight uint64 `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty" yaml:"weight"`
 	// The maximum number of ticks we can move when rebalancing
 	MaxTicksCrossed uint64 `protobuf:"varint,2,opt,name=max_ticks_crossed,json=maxTicksCrossed,proto3" json:"max_ticks_crossed,omitempty" yaml:"max_ticks_crossed"`
+	// The minimum liquidity a concentrated pool must have in its current tick
+	// for routes through the pool to be built. Zero disables the filter.
+	MinActiveLiquidity uint64 `protobuf:"varint,3,opt,name=min_active_liquidity,json=minActiveLiquidity,proto3" json:"min_active_liquidity,omitempty" yaml:"min_active_liquidity"`
 }
 
 func (m *ConcentratedPoolInfo) Reset()         { *m = ConcentratedPoolInfo{} }
@@ -570,6 +574,13 @@ func (m *ConcentratedPoolInfo) GetMaxTicksCrossed() uint64 {
 	return 0
 }
 
+func (m *ConcentratedPoolInfo) GetMinActiveLiquidity() uint64 {
+	if m != nil {
+		return m.MinActiveLiquidity
+	}
+	return 0
+}
+
 // CosmwasmPoolInfo contains meta data pertaining to a cosmwasm pool type.
 type CosmwasmPoolInfo struct {
 	// The weight of a cosmwasm pool (by contract address)
@@ -883,6 +894,146 @@ func (m *CyclicArbTracker) GetHeightAccountingStartsFrom() int64 {
 	return 0
 }
 
+// ProfitDistributions tracks the cumulative arbitrage profits distributed by
+// the module, per destination.
+type ProfitDistributions struct {
+	// Profits sent to the developer account.
+	Developer github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=developer,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"developer" yaml:"developer"`
+	// Profits burned by sending them to the null address.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned" yaml:"burned"`
+	// Profits sent to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool" yaml:"community_pool"`
+	// Profits sent to the txfees fee collectors for stakers.
+	Stakers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=stakers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"stakers" yaml:"stakers"`
+}
+
+func (m *ProfitDistributions) Reset()         { *m = ProfitDistributions{} }
+func (m *ProfitDistributions) String() string { return proto.CompactTextString(m) }
+func (*ProfitDistributions) ProtoMessage()    {}
+func (*ProfitDistributions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{15}
+}
+func (m *ProfitDistributions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitDistributions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitDistributions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitDistributions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitDistributions.Merge(m, src)
+}
+func (m *ProfitDistributions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitDistributions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitDistributions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitDistributions proto.InternalMessageInfo
+
+func (m *ProfitDistributions) GetDeveloper() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Developer
+	}
+	return nil
+}
+
+func (m *ProfitDistributions) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *ProfitDistributions) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *ProfitDistributions) GetStakers() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Stakers
+	}
+	return nil
+}
+
+// RevertedTradeAttempts tracks the number of backrun attempts whose state
+// changes were reverted, per reason. A reverted backrun never reverts the
+// transaction that was backrun.
+type RevertedTradeAttempts struct {
+	// Attempts that ran out of the execution gas limit.
+	OutOfGas uint64 `protobuf:"varint,1,opt,name=out_of_gas,json=outOfGas,proto3" json:"out_of_gas,omitempty" yaml:"out_of_gas"`
+	// Attempts that panicked, e.g. when swapping on a pool.
+	Panicked uint64 `protobuf:"varint,2,opt,name=panicked,proto3" json:"panicked,omitempty" yaml:"panicked"`
+	// Attempts that returned an error.
+	Failed uint64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty" yaml:"failed"`
+}
+
+func (m *RevertedTradeAttempts) Reset()         { *m = RevertedTradeAttempts{} }
+func (m *RevertedTradeAttempts) String() string { return proto.CompactTextString(m) }
+func (*RevertedTradeAttempts) ProtoMessage()    {}
+func (*RevertedTradeAttempts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{16}
+}
+func (m *RevertedTradeAttempts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevertedTradeAttempts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevertedTradeAttempts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevertedTradeAttempts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertedTradeAttempts.Merge(m, src)
+}
+func (m *RevertedTradeAttempts) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevertedTradeAttempts) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertedTradeAttempts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertedTradeAttempts proto.InternalMessageInfo
+
+func (m *RevertedTradeAttempts) GetOutOfGas() uint64 {
+	if m != nil {
+		return m.OutOfGas
+	}
+	return 0
+}
+
+func (m *RevertedTradeAttempts) GetPanicked() uint64 {
+	if m != nil {
+		return m.Panicked
+	}
+	return 0
+}
+
+func (m *RevertedTradeAttempts) GetFailed() uint64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TokenPairArbRoutes)(nil), "osmosis.protorev.v1beta1.TokenPairArbRoutes")
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
@@ -899,6 +1050,8 @@ func init() {
 	proto.RegisterType((*BaseDenoms)(nil), "osmosis.protorev.v1beta1.BaseDenoms")
 	proto.RegisterType((*AllProtocolRevenue)(nil), "osmosis.protorev.v1beta1.AllProtocolRevenue")
 	proto.RegisterType((*CyclicArbTracker)(nil), "osmosis.protorev.v1beta1.CyclicArbTracker")
+	proto.RegisterType((*ProfitDistributions)(nil), "osmosis.protorev.v1beta1.ProfitDistributions")
+	proto.RegisterType((*RevertedTradeAttempts)(nil), "osmosis.protorev.v1beta1.RevertedTradeAttempts")
 }
 
 func init() {
@@ -906,84 +1059,99 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 1218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x37, 0xdb, 0x6d, 0x33, 0x69, 0x37, 0xe9, 0x74, 0xdb, 0x66, 0x53, 0x88, 0x97, 0x69,
-	0x81, 0x14, 0xd1, 0x44, 0x1b, 0x10, 0x42, 0x45, 0x45, 0x5a, 0x2f, 0xaa, 0xa8, 0x10, 0x6d, 0x35,
-	0x1b, 0xa9, 0x82, 0x8b, 0x19, 0x3b, 0x93, 0xac, 0x95, 0xd8, 0x13, 0x79, 0x26, 0xdb, 0x6c, 0x91,
-	0x2a, 0x21, 0x8e, 0x5c, 0xb8, 0xf4, 0xc6, 0x81, 0x1b, 0x27, 0xfe, 0x06, 0xae, 0x3d, 0xf6, 0x58,
-	0x71, 0xb0, 0x50, 0x7b, 0x41, 0x1c, 0xf3, 0x17, 0xa0, 0xf9, 0x61, 0x27, 0xf1, 0x36, 0x6d, 0x57,
-	0x42, 0xdc, 0xec, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x7d, 0xe3, 0x99, 0x4c, 0xc0, 0xfb, 0x8c, 0x87,
-	0x8c, 0x07, 0xbc, 0x35, 0x8a, 0x99, 0x60, 0x31, 0x3d, 0x68, 0x1d, 0x6c, 0x7b, 0x54, 0x90, 0xed,
-	0x2c, 0xd0, 0x54, 0x0f, 0xb0, 0x6a, 0x0a, 0x9b, 0x59, 0xdc, 0x14, 0xd6, 0x36, 0x7d, 0x95, 0x72,
-	0x55, 0xa2, 0xa5, 0x5f, 0x74, 0x55, 0x6d, 0xa3, 0xcf, 0xfa, 0x4c, 0xc7, 0xe5, 0x93, 0x89, 0xd6,
-	0x75, 0x4d, 0xcb, 0x23, 0x9c, 0x66, 0x72, 0x3e, 0x0b, 0x22, 0x93, 0xbf, 0x96, 0xf5, 0xc4, 0xd8,
-	0x30, 0x24, 0x11, 0xe9, 0xd3, 0x38, 0xab, 0xeb, 0xd3, 0x88, 0x66, 0x6d, 0xd4, 0xae, 0xa6, 0xa5,
-	0x62, 0xd2, 0xa3, 0x94, 0xbf, 0xbc, 0x0a, 0x3d, 0xb3, 0x00, 0xec, 0xb0, 0x01, 0x8d, 0xee, 0x91,
-	0x20, 0xde, 0x89, 0x3d, 0xcc, 0xc6, 0x82, 0x72, 0xf8, 0x0d, 0x00, 0x24, 0xf6, 0xdc, 0x58, 0xbd,
-	0x55, 0xad, 0xad, 0x42, 0xa3, 0xd4, 0xb6, 0x9b, 0xcb, 0xe6, 0x6c, 0x2a, 0x94, 0xb3, 0xf9, 0x24,
-	0xb1, 0x57, 0xa6, 0x89, 0x7d, 0xee, 0x90, 0x84, 0xc3, 0x1b, 0x68, 0x46, 0x80, 0x70, 0x91, 0x64,
-	0xd4, 0x4d, 0x70, 0x5a, 0x48, 0x41, 0x37, 0x88, 0xaa, 0x27, 0xb6, 0xac, 0x46, 0xd1, 0x39, 0x3f,
-	0x4d, 0xec, 0xb2, 0xc6, 0xa4, 0x19, 0x84, 0x4f, 0xa9, 0xc7, 0xdb, 0x11, 0xdc, 0x06, 0x45, 0x1d,
-	0x65, 0x63, 0x51, 0x2d, 0x28, 0xc0, 0xc6, 0x34, 0xb1, 0x2b, 0xf3, 0x00, 0x36, 0x16, 0x08, 0x6b,
-	0xda, 0xbb, 0x63, 0x71, 0x63, 0xf5, 0xef, 0x5f, 0x6d, 0x0b, 0xfd, 0x6e, 0x81, 0x93, 0x4a, 0x13,
-	0xde, 0x01, 0x6b, 0x22, 0x26, 0xdd, 0x37, 0x99, 0xa4, 0x23, 0xeb, 0x9c, 0x0b, 0x66, 0x92, 0xb3,
-	0x46, 0x44, 0x81, 0x11, 0x36, 0x2c, 0xf0, 0x0e, 0x28, 0x72, 0x41, 0x47, 0x2e, 0x0f, 0x1e, 0x52,
-	0x33, 0xc3, 0xb6, 0x44, 0xfc, 0x99, 0xd8, 0x17, 0xf4, 0x02, 0xf2, 0xee, 0xa0, 0x19, 0xb0, 0x56,
-	0x48, 0xc4, 0x7e, 0xf3, 0x76, 0x24, 0x66, 0xfd, 0x66, 0x38, 0x84, 0x4f, 0xcb, 0xe7, 0xbd, 0xe0,
-	0x21, 0x35, 0xfd, 0x3e, 0xb6, 0xc0, 0x49, 0x25, 0x0f, 0xaf, 0x80, 0x55, 0xb9, 0xbe, 0x55, 0x6b,
-	0xcb, 0x6a, 0xac, 0x3a, 0xe5, 0x69, 0x62, 0x97, 0x34, 0x5a, 0x46, 0x11, 0x56, 0xc9, 0xff, 0xcf,
-	0xc7, 0x7f, 0x2c, 0x50, 0x56, 0x3e, 0xee, 0x09, 0x22, 0x02, 0x2e, 0x02, 0x9f, 0xc3, 0xaf, 0xc0,
-	0xa9, 0x51, 0xcc, 0x7a, 0x81, 0x48, 0x2d, 0xdd, 0x6c, 0x9a, 0xaf, 0x5b, 0x7e, 0xb9, 0x99, 0x9b,
-	0xbb, 0x2c, 0x88, 0x9c, 0x8b, 0xc6, 0xcc, 0x75, 0x33, 0x83, 0xc6, 0x21, 0x9c, 0x32, 0x40, 0x0f,
-	0x54, 0xa2, 0x71, 0xe8, 0xd1, 0xd8, 0x65, 0x3d, 0xd7, 0x2c, 0x94, 0x9e, 0xe8, 0xd3, 0xd7, 0xb9,
-	0x7a, 0x49, 0x73, 0xe6, 0xe1, 0x08, 0xaf, 0xeb, 0xd0, 0xdd, 0x5e, 0x47, 0x2f, 0xd9, 0x7b, 0xe0,
-	0xa4, 0xfa, 0x16, 0xab, 0x85, 0xad, 0x42, 0x63, 0xd5, 0xa9, 0x4c, 0x13, 0xfb, 0x8c, 0xc6, 0xaa,
-	0x30, 0xc2, 0x3a, 0x8d, 0x7e, 0x3b, 0x01, 0x4a, 0xf7, 0x18, 0x1b, 0xde, 0xa7, 0x41, 0x7f, 0x5f,
-	0x70, 0x78, 0x13, 0x9c, 0xe5, 0x82, 0x78, 0x43, 0xea, 0x3e, 0x50, 0x11, 0xb3, 0x26, 0xd5, 0x69,
-	0x62, 0x6f, 0xa4, 0x2b, 0x3a, 0x97, 0x46, 0xf8, 0x8c, 0x7e, 0xd7, 0x78, 0xb8, 0x0b, 0xca, 0x1e,
-	0x19, 0x92, 0xc8, 0xa7, 0x71, 0x4a, 0x70, 0x42, 0x11, 0xd4, 0xa6, 0x89, 0x7d, 0x51, 0x13, 0xe4,
-	0x0a, 0x10, 0x5e, 0x4f, 0x23, 0x86, 0xe4, 0x2e, 0x38, 0xef, 0xb3, 0xc8, 0xa7, 0x91, 0x88, 0x89,
-	0xa0, 0xdd, 0x94, 0xa8, 0xa0, 0x88, 0xea, 0xd3, 0xc4, 0xae, 0x69, 0xa2, 0x97, 0x14, 0x21, 0x0c,
-	0xe7, 0xa3, 0xb3, 0xae, 0xa4, 0xa1, 0x0f, 0x08, 0x0f, 0x53, 0xb2, 0xd5, 0x7c, 0x57, 0xb9, 0x02,
-	0x84, 0xd7, 0xd3, 0x88, 0x26, 0x41, 0xbf, 0x14, 0xc0, 0xfa, 0xed, 0xa8, 0xc7, 0x9c, 0x43, 0xe9,
-	0x57, 0xe7, 0x70, 0x44, 0xe1, 0x7d, 0xb0, 0xa6, 0xa7, 0x57, 0x2e, 0x95, 0xda, 0x8d, 0xe5, 0xfb,
-	0x6c, 0x4f, 0xd5, 0x49, 0xa4, 0xe2, 0xc8, 0x6d, 0x38, 0xcd, 0x82, 0xb0, 0xa1, 0x83, 0x2e, 0x38,
-	0x9d, 0x7a, 0xa2, 0xfc, 0x2b, 0xb5, 0x3f, 0x58, 0x4e, 0xed, 0x98, 0xca, 0x8c, 0xfc, 0x92, 0x21,
-	0x2f, 0x2f, 0xfa, 0x8d, 0x70, 0x46, 0x0a, 0x19, 0x38, 0x33, 0xef, 0x93, 0xf2, 0xb6, 0xd4, 0x6e,
-	0x2e, 0x17, 0xd9, 0x9d, 0xab, 0xce, 0x84, 0x2e, 0x1b, 0xa1, 0xf3, 0x47, 0xd7, 0x03, 0xe1, 0x05,
-	0x01, 0x39, 0x51, 0xea, 0xa7, 0xf2, 0xfe, 0x95, 0x13, 0xed, 0x9a, 0xca, 0x65, 0x13, 0xa5, 0x4c,
-	0x08, 0x67, 0xa4, 0xe8, 0x33, 0xb0, 0xbe, 0xe8, 0x31, 0xbc, 0x06, 0xd6, 0x16, 0xbe, 0xe1, 0x73,
-	0x33, 0xbf, 0xd3, 0x35, 0x36, 0x05, 0xe8, 0x26, 0xa8, 0xe4, 0x5d, 0x3c, 0x0e, 0xfc, 0x27, 0x0b,
-	0x6c, 0xbc, 0xcc, 0xa0, 0x63, 0x70, 0xc0, 0x2f, 0xc1, 0xb9, 0x90, 0x4c, 0x5c, 0x11, 0xf8, 0x03,
-	0xee, 0xfa, 0x31, 0xe3, 0x9c, 0x76, 0xcd, 0xde, 0x79, 0x6b, 0x9a, 0xd8, 0x55, 0x8d, 0x3a, 0x52,
-	0x82, 0x70, 0x39, 0x24, 0x93, 0x8e, 0x0c, 0xed, 0x9a, 0x88, 0x00, 0x95, 0xbc, 0x81, 0xf0, 0x3b,
-	0x50, 0xd2, 0x3a, 0x6e, 0x48, 0x46, 0xe9, 0x19, 0x76, 0x65, 0xf9, 0x0a, 0xe8, 0x6f, 0xfe, 0x6b,
-	0x32, 0x72, 0x6a, 0xc6, 0x7a, 0x38, 0xdf, 0xb6, 0x62, 0x41, 0x18, 0x3c, 0x48, 0xcb, 0x38, 0x7a,
-	0x04, 0x8a, 0x19, 0xe8, 0x38, 0x73, 0xdf, 0x02, 0x15, 0x9f, 0x49, 0xdf, 0x7c, 0xe1, 0x92, 0x6e,
-	0x37, 0xa6, 0x3c, 0x3d, 0x0c, 0x2f, 0xcf, 0xce, 0xbb, 0x7c, 0x05, 0xc2, 0xe5, 0x34, 0xb4, 0x63,
-	0x22, 0x3f, 0x5a, 0xa0, 0xe8, 0x10, 0x4e, 0xbf, 0xa0, 0x11, 0x0b, 0xe5, 0xf1, 0xd7, 0x95, 0x0f,
-	0x4a, 0xbf, 0x38, 0x7f, 0xfc, 0xa9, 0x30, 0xc2, 0x3a, 0xfd, 0x5f, 0xff, 0xb2, 0xa1, 0x08, 0x80,
-	0xac, 0x09, 0x2e, 0x5d, 0x97, 0x3f, 0x0f, 0xae, 0xd2, 0x7a, 0x03, 0xd7, 0x33, 0x68, 0xde, 0xf5,
-	0x39, 0x16, 0x84, 0x81, 0x97, 0x29, 0xa0, 0xc7, 0x05, 0x00, 0x77, 0x86, 0xc3, 0x7b, 0x92, 0xc9,
-	0x67, 0x43, 0x4c, 0x0f, 0x68, 0x34, 0xa6, 0xf0, 0x11, 0x80, 0x82, 0x0c, 0x68, 0xec, 0xca, 0x9b,
-	0x90, 0xfc, 0x8d, 0xf0, 0x07, 0x34, 0x36, 0x87, 0xd4, 0xf5, 0x99, 0xfe, 0xec, 0x4e, 0x35, 0xbb,
-	0x0f, 0x48, 0xd8, 0x2d, 0x4a, 0x79, 0x47, 0x83, 0x9c, 0x77, 0x4c, 0x27, 0x9b, 0xe6, 0x77, 0xf3,
-	0x08, 0x2d, 0xc2, 0x15, 0x91, 0x03, 0xc1, 0x1f, 0x2c, 0x50, 0x16, 0x93, 0x45, 0x75, 0x7d, 0x8e,
-	0xbd, 0x9b, 0xa9, 0xeb, 0x6b, 0xda, 0x4c, 0x78, 0x32, 0xaf, 0xda, 0x36, 0xaa, 0x0d, 0xa3, 0xba,
-	0xc8, 0x85, 0x3e, 0xec, 0xd2, 0x51, 0x4c, 0x7d, 0xb9, 0xd7, 0xe4, 0x6d, 0x65, 0x4c, 0x51, 0xd5,
-	0xc2, 0x67, 0xc5, 0x3c, 0x05, 0xfc, 0x1e, 0x40, 0xff, 0xd0, 0x1f, 0x06, 0xbe, 0x2b, 0x2f, 0x66,
-	0x69, 0x17, 0x85, 0xd7, 0x9e, 0x3d, 0x0a, 0xb3, 0x13, 0x7b, 0x4b, 0x0c, 0x38, 0xca, 0x89, 0x70,
-	0xc5, 0xcf, 0x81, 0xd0, 0x1f, 0x16, 0xa8, 0xe4, 0x99, 0xe0, 0xe7, 0x00, 0xcc, 0xd0, 0xaf, 0xbf,
-	0x47, 0xac, 0x4a, 0x61, 0x5c, 0xcc, 0xb8, 0xe1, 0x00, 0xbc, 0xbd, 0xaf, 0xb7, 0x1f, 0xf1, 0x7d,
-	0x36, 0x8e, 0x44, 0x10, 0xf5, 0x5d, 0x2e, 0x48, 0x2c, 0xb8, 0xdb, 0x8b, 0x59, 0xa8, 0x2c, 0x2e,
-	0x38, 0x8d, 0x69, 0x62, 0x5f, 0xd5, 0xcd, 0xbe, 0xb2, 0x1c, 0xe1, 0x9a, 0xce, 0xef, 0x64, 0xe9,
-	0x3d, 0x95, 0xbd, 0x15, 0xb3, 0xd0, 0xb9, 0xf3, 0xe4, 0x79, 0xdd, 0x7a, 0xfa, 0xbc, 0x6e, 0xfd,
-	0xf5, 0xbc, 0x6e, 0xfd, 0xfc, 0xa2, 0xbe, 0xf2, 0xf4, 0x45, 0x7d, 0xe5, 0xd9, 0x8b, 0xfa, 0xca,
-	0xb7, 0x1f, 0xf7, 0x03, 0xb1, 0x3f, 0xf6, 0x9a, 0x3e, 0x0b, 0x5b, 0xc6, 0xc6, 0xeb, 0x43, 0xe2,
-	0xf1, 0xf4, 0xa5, 0x75, 0xd0, 0xfe, 0xa4, 0x35, 0x99, 0xfd, 0x8b, 0x10, 0x87, 0x23, 0xca, 0xbd,
-	0x35, 0xf5, 0xfe, 0xd1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x71, 0xab, 0xcf, 0x66, 0x0c,
-	0x00, 0x00,
+	// 1469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x1b, 0xce, 0xd6, 0x6e, 0x1a, 0x4f, 0x9a, 0xd8, 0x9d, 0x24, 0xad, 0x93, 0x7e, 0x9f, 0x37, 0xdf,
+	0xb4, 0x1f, 0xb8, 0x88, 0xda, 0x4a, 0x8a, 0x10, 0x2a, 0x2a, 0x92, 0x9d, 0xaa, 0x10, 0x01, 0x4d,
+	0x98, 0x44, 0xaa, 0xe0, 0xb2, 0xcc, 0xae, 0xc7, 0xce, 0xca, 0xde, 0x1d, 0xb3, 0x33, 0x9b, 0x1f,
+	0x45, 0x54, 0x42, 0x1c, 0xb9, 0xc0, 0xa1, 0x37, 0x0e, 0xdc, 0x40, 0x48, 0xfc, 0x0d, 0x5c, 0x7b,
+	0xec, 0xb1, 0xe2, 0x60, 0x50, 0x7b, 0x41, 0x1c, 0xfd, 0x17, 0xa0, 0xf9, 0xb1, 0xeb, 0x1f, 0x49,
+	0x70, 0x23, 0x21, 0x4e, 0xde, 0x7d, 0xdf, 0xf7, 0x79, 0x9e, 0x99, 0x67, 0x3c, 0xef, 0xcc, 0x82,
+	0x57, 0x19, 0x0f, 0x18, 0xf7, 0x79, 0xb5, 0x1b, 0x31, 0xc1, 0x22, 0xba, 0x5f, 0xdd, 0x5f, 0x73,
+	0xa9, 0x20, 0x6b, 0x69, 0xa0, 0xa2, 0x1e, 0x60, 0xd1, 0x14, 0x56, 0xd2, 0xb8, 0x29, 0x5c, 0x59,
+	0xf6, 0x54, 0xca, 0x51, 0x89, 0xaa, 0x7e, 0xd1, 0x55, 0x2b, 0x8b, 0x2d, 0xd6, 0x62, 0x3a, 0x2e,
+	0x9f, 0x4c, 0xb4, 0xa4, 0x6b, 0xaa, 0x2e, 0xe1, 0x34, 0x95, 0xf3, 0x98, 0x1f, 0x9a, 0xfc, 0x8d,
+	0x74, 0x4c, 0x8c, 0x75, 0x02, 0x12, 0x92, 0x16, 0x8d, 0xd2, 0xba, 0x16, 0x0d, 0x69, 0x3a, 0x8c,
+	0x95, 0xeb, 0x49, 0xa9, 0x38, 0x6c, 0x52, 0xca, 0x4f, 0xae, 0x42, 0xcf, 0x2c, 0x00, 0x77, 0x59,
+	0x9b, 0x86, 0xdb, 0xc4, 0x8f, 0x6a, 0x91, 0x8b, 0x59, 0x2c, 0x28, 0x87, 0x1f, 0x03, 0x40, 0x22,
+	0xd7, 0x89, 0xd4, 0x5b, 0xd1, 0x5a, 0xcd, 0x94, 0x67, 0xd7, 0xed, 0xca, 0x69, 0xf3, 0xac, 0x28,
+	0x54, 0x7d, 0xf9, 0x49, 0xcf, 0x9e, 0xea, 0xf7, 0xec, 0x4b, 0x47, 0x24, 0xe8, 0xdc, 0x46, 0x03,
+	0x02, 0x84, 0x73, 0x24, 0xa5, 0xae, 0x80, 0x19, 0x21, 0x05, 0x1d, 0x3f, 0x2c, 0x9e, 0x5b, 0xb5,
+	0xca, 0xb9, 0xfa, 0x42, 0xbf, 0x67, 0xe7, 0x35, 0x26, 0xc9, 0x20, 0x7c, 0x41, 0x3d, 0x6e, 0x86,
+	0x70, 0x0d, 0xe4, 0x74, 0x94, 0xc5, 0xa2, 0x98, 0x51, 0x80, 0xc5, 0x7e, 0xcf, 0x2e, 0x0c, 0x03,
+	0x58, 0x2c, 0x10, 0xd6, 0xb4, 0x5b, 0xb1, 0xb8, 0x9d, 0xfd, 0xe3, 0x7b, 0xdb, 0x42, 0x3f, 0x5b,
+	0xe0, 0xbc, 0xd2, 0x84, 0xf7, 0xc1, 0xb4, 0x88, 0x48, 0xe3, 0x65, 0x66, 0xb2, 0x2b, 0xeb, 0xea,
+	0x4b, 0x66, 0x26, 0x73, 0x46, 0x44, 0x81, 0x11, 0x36, 0x2c, 0xf0, 0x3e, 0xc8, 0x71, 0x41, 0xbb,
+	0x0e, 0xf7, 0x1f, 0x52, 0x33, 0x87, 0x35, 0x89, 0xf8, 0xb5, 0x67, 0x2f, 0xe9, 0x05, 0xe4, 0x8d,
+	0x76, 0xc5, 0x67, 0xd5, 0x80, 0x88, 0xbd, 0xca, 0x66, 0x28, 0x06, 0xe3, 0x4d, 0x71, 0x08, 0xcf,
+	0xc8, 0xe7, 0x1d, 0xff, 0x21, 0x35, 0xe3, 0x7d, 0x6c, 0x81, 0xf3, 0x4a, 0x1e, 0x5e, 0x03, 0x59,
+	0xb9, 0xbe, 0x45, 0x6b, 0xd5, 0x2a, 0x67, 0xeb, 0xf9, 0x7e, 0xcf, 0x9e, 0xd5, 0x68, 0x19, 0x45,
+	0x58, 0x25, 0xff, 0x3d, 0x1f, 0xff, 0xb4, 0x40, 0x5e, 0xf9, 0xb8, 0x23, 0x88, 0xf0, 0xb9, 0xf0,
+	0x3d, 0x0e, 0xdf, 0x07, 0x17, 0xba, 0x11, 0x6b, 0xfa, 0x22, 0xb1, 0x74, 0xb9, 0x62, 0xfe, 0xdd,
+	0xf2, 0x9f, 0x9b, 0xba, 0xb9, 0xc1, 0xfc, 0xb0, 0x7e, 0xd9, 0x98, 0x39, 0x6f, 0xe6, 0xa0, 0x71,
+	0x08, 0x27, 0x0c, 0xd0, 0x05, 0x85, 0x30, 0x0e, 0x5c, 0x1a, 0x39, 0xac, 0xe9, 0x98, 0x85, 0xd2,
+	0x33, 0x7a, 0x6b, 0x92, 0xab, 0x57, 0x34, 0xe7, 0x38, 0x1c, 0xe1, 0x79, 0x1d, 0xda, 0x6a, 0xee,
+	0xea, 0x25, 0x7b, 0x05, 0x9c, 0x57, 0xff, 0xc5, 0x62, 0x66, 0x35, 0x53, 0xce, 0xd6, 0x0b, 0xfd,
+	0x9e, 0x7d, 0x51, 0x63, 0x55, 0x18, 0x61, 0x9d, 0x46, 0x3f, 0x9c, 0x03, 0xb3, 0xdb, 0x8c, 0x75,
+	0x1e, 0x50, 0xbf, 0xb5, 0x27, 0x38, 0xbc, 0x03, 0xe6, 0xb8, 0x20, 0x6e, 0x87, 0x3a, 0x07, 0x2a,
+	0x62, 0xd6, 0xa4, 0xd8, 0xef, 0xd9, 0x8b, 0xc9, 0x8a, 0x0e, 0xa5, 0x11, 0xbe, 0xa8, 0xdf, 0x35,
+	0x1e, 0x6e, 0x80, 0xbc, 0x4b, 0x3a, 0x24, 0xf4, 0x68, 0x94, 0x10, 0x9c, 0x53, 0x04, 0x2b, 0xfd,
+	0x9e, 0x7d, 0x59, 0x13, 0x8c, 0x15, 0x20, 0x3c, 0x9f, 0x44, 0x0c, 0xc9, 0x16, 0x58, 0xf0, 0x58,
+	0xe8, 0xd1, 0x50, 0x44, 0x44, 0xd0, 0x46, 0x42, 0x94, 0x51, 0x44, 0xa5, 0x7e, 0xcf, 0x5e, 0xd1,
+	0x44, 0x27, 0x14, 0x21, 0x0c, 0x87, 0xa3, 0x83, 0x51, 0x49, 0x43, 0x0f, 0x08, 0x0f, 0x12, 0xb2,
+	0xec, 0xf8, 0xa8, 0xc6, 0x0a, 0x10, 0x9e, 0x4f, 0x22, 0x9a, 0x04, 0x7d, 0x97, 0x01, 0xf3, 0x9b,
+	0x61, 0x93, 0xd5, 0x8f, 0xa4, 0x5f, 0xbb, 0x47, 0x5d, 0x0a, 0x1f, 0x80, 0x69, 0x3d, 0x7b, 0xe5,
+	0xd2, 0xec, 0x7a, 0xf9, 0xf4, 0x7d, 0xb6, 0xa3, 0xea, 0x24, 0x52, 0x71, 0x8c, 0x6d, 0x38, 0xcd,
+	0x82, 0xb0, 0xa1, 0x83, 0x0e, 0x98, 0x49, 0x3c, 0x51, 0xfe, 0xcd, 0xae, 0xbf, 0x76, 0x3a, 0x75,
+	0xdd, 0x54, 0xa6, 0xe4, 0x57, 0x0c, 0x79, 0x7e, 0xd4, 0x6f, 0x84, 0x53, 0x52, 0xc8, 0xc0, 0xc5,
+	0x61, 0x9f, 0x94, 0xb7, 0xb3, 0xeb, 0x95, 0xd3, 0x45, 0x36, 0x86, 0xaa, 0x53, 0xa1, 0xab, 0x46,
+	0x68, 0xe1, 0xf8, 0x7a, 0x20, 0x3c, 0x22, 0x20, 0x67, 0x94, 0xf8, 0x59, 0xcc, 0x4e, 0x9a, 0xd1,
+	0x86, 0xa9, 0x3c, 0x6d, 0x46, 0x09, 0x13, 0xc2, 0x29, 0x29, 0x7a, 0x1b, 0xcc, 0x8f, 0x7a, 0x0c,
+	0x6f, 0x80, 0xe9, 0x91, 0xff, 0xf0, 0xa5, 0x81, 0xdf, 0xc9, 0x1a, 0x9b, 0x02, 0x74, 0x07, 0x14,
+	0xc6, 0x5d, 0x3c, 0x0b, 0xbc, 0x67, 0x81, 0xc5, 0x93, 0x0c, 0x3a, 0x03, 0x07, 0x7c, 0x0f, 0x5c,
+	0x0a, 0xc8, 0xa1, 0x23, 0x7c, 0xaf, 0xcd, 0x1d, 0x2f, 0x62, 0x9c, 0xd3, 0x86, 0xd9, 0x3b, 0xff,
+	0xe9, 0xf7, 0xec, 0xa2, 0x46, 0x1d, 0x2b, 0x41, 0x38, 0x1f, 0x90, 0xc3, 0x5d, 0x19, 0xda, 0xd0,
+	0x11, 0xf8, 0x11, 0x58, 0x0c, 0xfc, 0xd0, 0x21, 0x9e, 0xf0, 0xf7, 0xa9, 0xd3, 0xf1, 0x3f, 0x8b,
+	0xfd, 0x86, 0x2f, 0x8e, 0xcc, 0xfe, 0xb1, 0xfb, 0x3d, 0xfb, 0xaa, 0x21, 0x3b, 0xa1, 0x0a, 0x61,
+	0x18, 0xf8, 0x61, 0x4d, 0x45, 0x3f, 0x48, 0x83, 0x02, 0x14, 0xc6, 0xd7, 0x04, 0x7e, 0x0a, 0x66,
+	0xf5, 0xd0, 0x9d, 0x80, 0x74, 0x93, 0xb6, 0x78, 0xed, 0xf4, 0x45, 0xd5, 0xdb, 0xe8, 0x43, 0xd2,
+	0xad, 0xaf, 0x98, 0xd5, 0x84, 0xc3, 0x4e, 0x28, 0x16, 0x84, 0xc1, 0x41, 0x52, 0xc6, 0xd1, 0x23,
+	0x90, 0x4b, 0x41, 0x67, 0xb1, 0xf2, 0x1e, 0x28, 0x78, 0x4c, 0x2e, 0x85, 0x27, 0x1c, 0xd2, 0x68,
+	0x44, 0x94, 0x27, 0xfd, 0xf5, 0xea, 0xa0, 0x85, 0x8e, 0x57, 0x20, 0x9c, 0x4f, 0x42, 0x35, 0x13,
+	0xf9, 0xca, 0x02, 0xb9, 0x3a, 0xe1, 0xf4, 0x2e, 0x0d, 0x59, 0x20, 0x3b, 0x6a, 0x43, 0x3e, 0x28,
+	0xfd, 0xdc, 0x70, 0x47, 0x55, 0x61, 0x84, 0x75, 0xfa, 0x9f, 0x3e, 0x2c, 0x51, 0x08, 0x40, 0x3a,
+	0x08, 0x2e, 0x5d, 0x97, 0x27, 0x8e, 0xa3, 0xb4, 0x5e, 0xc2, 0xf5, 0x14, 0x3a, 0xee, 0xfa, 0x10,
+	0x0b, 0xc2, 0xc0, 0x4d, 0x15, 0xd0, 0xe3, 0x0c, 0x80, 0xb5, 0x4e, 0x67, 0x5b, 0x32, 0x79, 0xac,
+	0x83, 0xe9, 0x3e, 0x0d, 0x63, 0x0a, 0x1f, 0x01, 0x28, 0x48, 0x9b, 0x46, 0x8e, 0xbc, 0x5c, 0xc9,
+	0x63, 0xc7, 0x6b, 0xd3, 0xc8, 0xf4, 0xbd, 0x9b, 0x03, 0xfd, 0xc1, 0x35, 0x6d, 0x70, 0xc5, 0x90,
+	0xb0, 0x7b, 0x94, 0xf2, 0x5d, 0x0d, 0xaa, 0xff, 0xcf, 0x8c, 0x64, 0xd9, 0x1c, 0xc5, 0xc7, 0x68,
+	0x11, 0x2e, 0x88, 0x31, 0x10, 0xfc, 0xd2, 0x02, 0x79, 0x71, 0x38, 0xaa, 0xae, 0x5b, 0xe3, 0xff,
+	0x53, 0x75, 0x7d, 0xf3, 0x1b, 0x08, 0x1f, 0x0e, 0xab, 0xae, 0x1b, 0xd5, 0xb2, 0x51, 0x1d, 0xe5,
+	0x42, 0xaf, 0x37, 0x68, 0x37, 0xa2, 0x9e, 0xdc, 0xbe, 0xf2, 0x02, 0x14, 0x53, 0x54, 0xb4, 0xf0,
+	0x9c, 0x18, 0xa6, 0x80, 0x9f, 0x03, 0xe8, 0x1d, 0x79, 0x1d, 0xdf, 0x73, 0xe4, 0x5d, 0x2f, 0x19,
+	0x45, 0x66, 0x62, 0x3b, 0x53, 0x98, 0x5a, 0xe4, 0x9e, 0x62, 0xc0, 0x71, 0x4e, 0x84, 0x0b, 0xde,
+	0x18, 0x08, 0xfd, 0x62, 0x81, 0xc2, 0x38, 0x13, 0x7c, 0x07, 0x80, 0x01, 0x7a, 0xf2, 0xd5, 0x24,
+	0x2b, 0x85, 0x71, 0x2e, 0xe5, 0x86, 0x6d, 0xf0, 0xdf, 0x3d, 0xbd, 0xfd, 0x88, 0xe7, 0xb1, 0x38,
+	0x14, 0x7e, 0xd8, 0x72, 0xb8, 0x20, 0x91, 0xe0, 0x4e, 0x33, 0x62, 0x81, 0xb2, 0x38, 0x53, 0x2f,
+	0xf7, 0x7b, 0xf6, 0x75, 0x3d, 0xd8, 0xbf, 0x2d, 0x47, 0x78, 0x45, 0xe7, 0x6b, 0x69, 0x7a, 0x47,
+	0x65, 0xef, 0xc9, 0xe4, 0xb7, 0x59, 0xb0, 0xb0, 0xad, 0xee, 0x40, 0x77, 0x7d, 0x2e, 0x22, 0xdf,
+	0x8d, 0x85, 0xcf, 0x42, 0x0e, 0xbf, 0x00, 0xb9, 0x06, 0xdd, 0xa7, 0x1d, 0xd6, 0xa5, 0xd1, 0xe4,
+	0x39, 0xdc, 0x35, 0xe6, 0x15, 0x92, 0xcd, 0x67, 0x90, 0xe8, 0xa7, 0xdf, 0xec, 0x72, 0xcb, 0x17,
+	0x7b, 0xb1, 0x5b, 0xf1, 0x58, 0x60, 0xbe, 0x3e, 0xcc, 0xcf, 0x4d, 0xde, 0x68, 0x57, 0xc5, 0x51,
+	0x97, 0x72, 0x45, 0xc2, 0xf1, 0x40, 0x11, 0x0a, 0x30, 0xed, 0xc6, 0x51, 0xa8, 0xda, 0xed, 0x04,
+	0xed, 0xda, 0xe8, 0xb1, 0xad, 0x61, 0x67, 0x13, 0x36, 0x5a, 0xf0, 0x6b, 0x0b, 0xcc, 0x7b, 0x2c,
+	0x08, 0xe2, 0xd0, 0x17, 0x47, 0x8e, 0xba, 0xfe, 0x66, 0x26, 0xc9, 0x6f, 0x1a, 0xf9, 0xa5, 0xa4,
+	0x85, 0x0d, 0xc3, 0xcf, 0x36, 0x8c, 0xb9, 0x14, 0x2c, 0x1b, 0x3a, 0x3c, 0x00, 0x17, 0xb8, 0xda,
+	0x72, 0xbc, 0x98, 0x9d, 0x34, 0x8a, 0xfa, 0xe8, 0xfd, 0xd6, 0xe0, 0xce, 0x26, 0x9f, 0xa8, 0xa1,
+	0x1f, 0x2d, 0xb0, 0x24, 0x5b, 0x4c, 0x24, 0x68, 0x43, 0x5d, 0x5d, 0x6b, 0x42, 0xd0, 0xa0, 0x2b,
+	0x38, 0xbc, 0x05, 0x00, 0x8b, 0x85, 0xbc, 0xe3, 0xb6, 0x08, 0x37, 0x4d, 0x7f, 0x69, 0xf0, 0xb5,
+	0x35, 0xc8, 0x21, 0x3c, 0xc3, 0x62, 0xb1, 0xd5, 0x7c, 0x97, 0x70, 0x58, 0x05, 0x33, 0x5d, 0x12,
+	0xfa, 0x5e, 0x3b, 0x3d, 0x3c, 0x87, 0x3e, 0x12, 0x92, 0x0c, 0xc2, 0x69, 0x91, 0x3c, 0x56, 0x9a,
+	0xc4, 0xef, 0x98, 0x2b, 0xd0, 0xc8, 0xb1, 0xa2, 0xe3, 0x08, 0x9b, 0x82, 0xfa, 0xfd, 0x27, 0xcf,
+	0x4b, 0xd6, 0xd3, 0xe7, 0x25, 0xeb, 0xf7, 0xe7, 0x25, 0xeb, 0x9b, 0x17, 0xa5, 0xa9, 0xa7, 0x2f,
+	0x4a, 0x53, 0xcf, 0x5e, 0x94, 0xa6, 0x3e, 0x79, 0x63, 0x68, 0xde, 0xa6, 0x0b, 0xdc, 0xec, 0x10,
+	0x97, 0x27, 0x2f, 0xd5, 0xfd, 0xf5, 0x37, 0xab, 0x87, 0x83, 0xef, 0x6a, 0xe5, 0x84, 0x3b, 0xad,
+	0xde, 0x6f, 0xfd, 0x35, 0x00, 0xbc, 0x3a, 0x5d, 0x9f, 0x78, 0x0f, 0x00, 0x00,
 }
 
 func (this *TokenPairArbRoutes) Equal(that interface{}) bool {
@@ -1470,6 +1638,11 @@ func (m *ConcentratedPoolInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinActiveLiquidity != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.MinActiveLiquidity))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.MaxTicksCrossed != 0 {
 		i = encodeVarintProtorev(dAtA, i, uint64(m.MaxTicksCrossed))
 		i--
@@ -1727,6 +1900,123 @@ func (m *CyclicArbTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProfitDistributions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfitDistributions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfitDistributions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stakers) > 0 {
+		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Developer) > 0 {
+		for iNdEx := len(m.Developer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Developer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevertedTradeAttempts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertedTradeAttempts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevertedTradeAttempts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Panicked != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Panicked))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OutOfGas != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.OutOfGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProtorev(v)
 	base := offset
@@ -1896,6 +2186,9 @@ func (m *ConcentratedPoolInfo) Size() (n int) {
 	if m.MaxTicksCrossed != 0 {
 		n += 1 + sovProtorev(uint64(m.MaxTicksCrossed))
 	}
+	if m.MinActiveLiquidity != 0 {
+		n += 1 + sovProtorev(uint64(m.MinActiveLiquidity))
+	}
 	return n
 }
 
@@ -1993,6 +2286,57 @@ func (m *CyclicArbTracker) Size() (n int) {
 	return n
 }
 
+func (m *ProfitDistributions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Developer) > 0 {
+		for _, e := range m.Developer {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.Stakers) > 0 {
+		for _, e := range m.Stakers {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RevertedTradeAttempts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutOfGas != 0 {
+		n += 1 + sovProtorev(uint64(m.OutOfGas))
+	}
+	if m.Panicked != 0 {
+		n += 1 + sovProtorev(uint64(m.Panicked))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovProtorev(uint64(m.Failed))
+	}
+	return n
+}
+
 func sovProtorev(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3105,6 +3449,25 @@ func (m *ConcentratedPoolInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinActiveLiquidity", wireType)
+			}
+			m.MinActiveLiquidity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinActiveLiquidity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -3763,6 +4126,299 @@ func (m *CyclicArbTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProfitDistributions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfitDistributions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfitDistributions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = append(m.Developer, types.Coin{})
+			if err := m.Developer[len(m.Developer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakers = append(m.Stakers, types.Coin{})
+			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevertedTradeAttempts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevertedTradeAttempts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevertedTradeAttempts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfGas", wireType)
+			}
+			m.OutOfGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutOfGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Panicked", wireType)
+			}
+			m.Panicked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Panicked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProtorev(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1469,6 +1469,286 @@ func (m *QueryGetAllProtocolRevenueResponse) GetAllProtocolRevenue() AllProtocol
 	return AllProtocolRevenue{}
 }
 
+// QueryGetProtoRevProfitDistributionsRequest is request type for the
+// Query/GetProtoRevProfitDistributions RPC method.
+type QueryGetProtoRevProfitDistributionsRequest struct {
+}
+
+func (m *QueryGetProtoRevProfitDistributionsRequest) Reset() {
+	*m = QueryGetProtoRevProfitDistributionsRequest{}
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProfitDistributionsRequest) ProtoMessage() {}
+func (*QueryGetProtoRevProfitDistributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitDistributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitDistributionsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitDistributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitDistributionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevProfitDistributionsRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevProfitDistributionsResponse is response type for the
+// Query/GetProtoRevProfitDistributions RPC method.
+type QueryGetProtoRevProfitDistributionsResponse struct {
+	// profit_distributions is the cumulative profits distributed per
+	// destination
+	ProfitDistributions ProfitDistributions `protobuf:"bytes,1,opt,name=profit_distributions,json=profitDistributions,proto3" json:"profit_distributions" yaml:"profit_distributions"`
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) Reset() {
+	*m = QueryGetProtoRevProfitDistributionsResponse{}
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProfitDistributionsResponse) ProtoMessage() {}
+func (*QueryGetProtoRevProfitDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitDistributionsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitDistributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevProfitDistributionsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) GetProfitDistributions() ProfitDistributions {
+	if m != nil {
+		return m.ProfitDistributions
+	}
+	return ProfitDistributions{}
+}
+
+// QueryGetProtoRevExecutionGasLimitRequest is request type for the
+// Query/GetProtoRevExecutionGasLimit RPC method.
+type QueryGetProtoRevExecutionGasLimitRequest struct {
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitRequest) Reset() {
+	*m = QueryGetProtoRevExecutionGasLimitRequest{}
+}
+func (m *QueryGetProtoRevExecutionGasLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevExecutionGasLimitRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevExecutionGasLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{34}
+}
+func (m *QueryGetProtoRevExecutionGasLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevExecutionGasLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevExecutionGasLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevExecutionGasLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevExecutionGasLimitRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevExecutionGasLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevExecutionGasLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevExecutionGasLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevExecutionGasLimitRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevExecutionGasLimitResponse is response type for the
+// Query/GetProtoRevExecutionGasLimit RPC method.
+type QueryGetProtoRevExecutionGasLimitResponse struct {
+	// execution_gas_limit is the gas limit of the execution of the backrun of a
+	// transaction
+	ExecutionGasLimit uint64 `protobuf:"varint,1,opt,name=execution_gas_limit,json=executionGasLimit,proto3" json:"execution_gas_limit,omitempty" yaml:"execution_gas_limit"`
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitResponse) Reset() {
+	*m = QueryGetProtoRevExecutionGasLimitResponse{}
+}
+func (m *QueryGetProtoRevExecutionGasLimitResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevExecutionGasLimitResponse) ProtoMessage() {}
+func (*QueryGetProtoRevExecutionGasLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{35}
+}
+func (m *QueryGetProtoRevExecutionGasLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevExecutionGasLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevExecutionGasLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevExecutionGasLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevExecutionGasLimitResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevExecutionGasLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevExecutionGasLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevExecutionGasLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevExecutionGasLimitResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevExecutionGasLimitResponse) GetExecutionGasLimit() uint64 {
+	if m != nil {
+		return m.ExecutionGasLimit
+	}
+	return 0
+}
+
+// QueryGetProtoRevRevertedTradeAttemptsRequest is request type for the
+// Query/GetProtoRevRevertedTradeAttempts RPC method.
+type QueryGetProtoRevRevertedTradeAttemptsRequest struct {
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) Reset() {
+	*m = QueryGetProtoRevRevertedTradeAttemptsRequest{}
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevRevertedTradeAttemptsRequest) ProtoMessage() {}
+func (*QueryGetProtoRevRevertedTradeAttemptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{36}
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevRevertedTradeAttemptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevRevertedTradeAttemptsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevRevertedTradeAttemptsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevRevertedTradeAttemptsRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevRevertedTradeAttemptsResponse is response type for the
+// Query/GetProtoRevRevertedTradeAttempts RPC method.
+type QueryGetProtoRevRevertedTradeAttemptsResponse struct {
+	// reverted_trade_attempts is the number of backrun attempts that were
+	// reverted, per reason
+	RevertedTradeAttempts RevertedTradeAttempts `protobuf:"bytes,1,opt,name=reverted_trade_attempts,json=revertedTradeAttempts,proto3" json:"reverted_trade_attempts" yaml:"reverted_trade_attempts"`
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) Reset() {
+	*m = QueryGetProtoRevRevertedTradeAttemptsResponse{}
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevRevertedTradeAttemptsResponse) ProtoMessage() {}
+func (*QueryGetProtoRevRevertedTradeAttemptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{37}
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevRevertedTradeAttemptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevRevertedTradeAttemptsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevRevertedTradeAttemptsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevRevertedTradeAttemptsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) GetRevertedTradeAttempts() RevertedTradeAttempts {
+	if m != nil {
+		return m.RevertedTradeAttempts
+	}
+	return RevertedTradeAttempts{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
@@ -1502,6 +1782,12 @@ func init() {
 	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
 	proto.RegisterType((*QueryGetAllProtocolRevenueRequest)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueRequest")
 	proto.RegisterType((*QueryGetAllProtocolRevenueResponse)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitDistributionsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitDistributionsRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitDistributionsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitDistributionsResponse")
+	proto.RegisterType((*QueryGetProtoRevExecutionGasLimitRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevExecutionGasLimitRequest")
+	proto.RegisterType((*QueryGetProtoRevExecutionGasLimitResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevExecutionGasLimitResponse")
+	proto.RegisterType((*QueryGetProtoRevRevertedTradeAttemptsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevRevertedTradeAttemptsRequest")
+	proto.RegisterType((*QueryGetProtoRevRevertedTradeAttemptsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevRevertedTradeAttemptsResponse")
 }
 
 func init() {
@@ -1509,108 +1795,122 @@ func init() {
 }
 
 var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0x1b, 0x55,
-	0x1b, 0xce, 0xf4, 0x92, 0x7c, 0x3d, 0xbd, 0x7c, 0xcd, 0xf9, 0x92, 0xd4, 0x99, 0xa4, 0xb6, 0x73,
-	0x92, 0x34, 0xb7, 0xc6, 0xfe, 0x7a, 0xa1, 0x94, 0x4b, 0x81, 0x4c, 0x03, 0x55, 0x54, 0xd1, 0x98,
-	0x21, 0x6c, 0x40, 0xc2, 0x8c, 0xed, 0x49, 0x3a, 0xea, 0x78, 0xce, 0x74, 0x66, 0x1c, 0xc5, 0x5b,
-	0x2a, 0x81, 0x90, 0x90, 0xb8, 0xfd, 0x00, 0x58, 0x23, 0xfe, 0x00, 0x4b, 0x58, 0x55, 0xb0, 0x29,
-	0x42, 0x42, 0xa8, 0x20, 0x0b, 0xb5, 0x2c, 0x58, 0xfb, 0x07, 0x20, 0x34, 0xe7, 0xbc, 0x63, 0x8f,
-	0xe7, 0xcc, 0x38, 0x9e, 0x44, 0x62, 0x67, 0xcf, 0x79, 0xdf, 0xe7, 0x7d, 0x9e, 0x73, 0x7d, 0x5e,
-	0x34, 0x47, 0xdd, 0x3a, 0x75, 0x0d, 0xb7, 0x68, 0x3b, 0xd4, 0xa3, 0x8e, 0xbe, 0x5b, 0xdc, 0xbd,
-	0x54, 0xd1, 0x3d, 0xed, 0x52, 0xf1, 0x7e, 0x43, 0x77, 0x9a, 0x05, 0xf6, 0x19, 0x67, 0x20, 0xaa,
-	0x10, 0x44, 0x15, 0x20, 0x4a, 0x1e, 0xdb, 0xa1, 0x3b, 0x94, 0x7d, 0x2d, 0xfa, 0xbf, 0x78, 0x80,
-	0x3c, 0xbd, 0x43, 0xe9, 0x8e, 0xa9, 0x17, 0x35, 0xdb, 0x28, 0x6a, 0x96, 0x45, 0x3d, 0xcd, 0x33,
-	0xa8, 0x05, 0xe9, 0xf2, 0x72, 0x95, 0xc1, 0x15, 0x2b, 0x9a, 0xab, 0xf3, 0x32, 0x9d, 0xa2, 0xb6,
-	0xb6, 0x63, 0x58, 0x2c, 0x18, 0x62, 0xe7, 0x13, 0xf9, 0xd9, 0x9a, 0xa3, 0xd5, 0x03, 0xc8, 0x85,
-	0xe4, 0xb0, 0x80, 0x31, 0x0f, 0xcc, 0x86, 0x6b, 0x07, 0x31, 0x55, 0x6a, 0x40, 0x3d, 0x32, 0x86,
-	0xf0, 0x1b, 0x3e, 0xa3, 0x12, 0x43, 0x57, 0xf5, 0xfb, 0x0d, 0xdd, 0xf5, 0xc8, 0x36, 0xfa, 0x5f,
-	0xcf, 0x57, 0xd7, 0xa6, 0x96, 0xab, 0xe3, 0x4d, 0x34, 0xcc, 0x59, 0x64, 0xa4, 0xbc, 0xb4, 0x78,
-	0xf2, 0x72, 0xbe, 0x90, 0x34, 0x4f, 0x05, 0x9e, 0xa9, 0x8c, 0x3f, 0x6c, 0xe5, 0x86, 0xda, 0xad,
-	0xdc, 0xe9, 0xa6, 0x56, 0x37, 0x9f, 0x27, 0x3c, 0x9b, 0xa8, 0x00, 0x43, 0x16, 0xd0, 0x3c, 0xab,
-	0x73, 0x4b, 0xf7, 0x4a, 0x3e, 0x82, 0xaa, 0xef, 0xde, 0x69, 0xd4, 0x2b, 0xba, 0xb3, 0xb9, 0xbd,
-	0xe5, 0x68, 0x35, 0xbd, 0x43, 0xe8, 0x63, 0x09, 0x5d, 0xd8, 0x2f, 0x12, 0x48, 0x56, 0xd0, 0x59,
-	0x8b, 0x8d, 0x94, 0xe9, 0x76, 0xd9, 0x63, 0x63, 0x8c, 0xee, 0x09, 0xe5, 0xba, 0x4f, 0xe6, 0x71,
-	0x2b, 0x37, 0xce, 0xe7, 0xc4, 0xad, 0xdd, 0x2b, 0x18, 0xb4, 0x58, 0xd7, 0xbc, 0xbb, 0x85, 0x0d,
-	0xcb, 0x6b, 0xb7, 0x72, 0xe7, 0x38, 0xcb, 0x68, 0x3a, 0x51, 0xcf, 0x58, 0x3d, 0xb5, 0xc8, 0xa6,
-	0xc8, 0xbb, 0xe4, 0xd0, 0x6d, 0xc3, 0x73, 0x95, 0xe6, 0xba, 0x6e, 0xd1, 0x3a, 0xf0, 0xc6, 0x17,
-	0xd0, 0xf1, 0x9a, 0xff, 0x1f, 0x18, 0x9c, 0x6d, 0xb7, 0x72, 0xa7, 0x78, 0x11, 0xf6, 0x99, 0xa8,
-	0x7c, 0x98, 0x58, 0xa2, 0xbc, 0x28, 0x20, 0xc8, 0x5b, 0x47, 0xc3, 0x36, 0x1b, 0x81, 0x35, 0x98,
-	0x2c, 0x70, 0x35, 0x05, 0x7f, 0x85, 0x3b, 0xd3, 0x7f, 0x93, 0x1a, 0x96, 0x32, 0x1a, 0x9a, 0x78,
-	0x96, 0xe2, 0x4f, 0x3c, 0xff, 0x31, 0x8b, 0x66, 0xa2, 0xf5, 0xd6, 0x4c, 0x13, 0x4a, 0x06, 0x93,
-	0x7e, 0x1f, 0x91, 0x7e, 0x41, 0x40, 0xe8, 0x36, 0x1a, 0xe1, 0xa0, 0xfe, 0x34, 0x1f, 0xed, 0xcf,
-	0x68, 0x02, 0xb6, 0xc3, 0x99, 0x30, 0x2b, 0x97, 0xa8, 0x23, 0x9d, 0x5f, 0x68, 0x31, 0x5a, 0xf2,
-	0x4d, 0xff, 0x30, 0xb9, 0x9e, 0x51, 0x75, 0x95, 0xa6, 0x4a, 0x1b, 0x9e, 0x1e, 0x9a, 0x5b, 0xc7,
-	0xff, 0xcf, 0xca, 0x1e, 0x0b, 0xcf, 0x2d, 0xfb, 0x4c, 0x54, 0x3e, 0x4c, 0x3e, 0x93, 0xd0, 0xd2,
-	0x00, 0xa0, 0x20, 0xa7, 0x86, 0x90, 0xdb, 0x19, 0x84, 0x39, 0x5e, 0x4a, 0xde, 0xe7, 0x2c, 0x39,
-	0x84, 0x36, 0x09, 0x0a, 0x47, 0x39, 0x93, 0x2e, 0x14, 0x51, 0x43, 0xb8, 0x64, 0x45, 0xa4, 0xb4,
-	0x66, 0x9a, 0x11, 0xb0, 0x60, 0x1d, 0x3e, 0x97, 0xd0, 0xf2, 0x20, 0xd1, 0x09, 0x0a, 0x8e, 0xfe,
-	0x5b, 0x0a, 0xb6, 0xe8, 0x3d, 0xdd, 0x2a, 0x69, 0x86, 0xb3, 0xe6, 0x54, 0x18, 0x6a, 0x47, 0xc1,
-	0x47, 0x31, 0x0a, 0xe2, 0xa2, 0x41, 0xc1, 0x3b, 0x68, 0x98, 0x2d, 0x5d, 0xc0, 0xfe, 0x62, 0x32,
-	0x7b, 0x11, 0x25, 0x7a, 0xe7, 0x70, 0x24, 0xa2, 0x02, 0x24, 0x99, 0x47, 0xb3, 0xc2, 0x64, 0xd6,
-	0xea, 0x86, 0xb5, 0x56, 0xad, 0xd2, 0x86, 0xe5, 0x05, 0x94, 0x75, 0x34, 0xd7, 0x3f, 0x0c, 0xb8,
-	0xde, 0x40, 0xa7, 0x35, 0xff, 0x7b, 0x59, 0xe3, 0x03, 0x70, 0xd2, 0x33, 0xed, 0x56, 0x6e, 0x8c,
-	0x13, 0xe8, 0x19, 0x26, 0xea, 0x29, 0x2d, 0x04, 0x43, 0x96, 0xd0, 0x42, 0xb4, 0xcc, 0xba, 0xbe,
-	0xab, 0x9b, 0xd4, 0xd6, 0x9d, 0x08, 0xa3, 0x86, 0x78, 0x36, 0xc4, 0x50, 0x60, 0xb5, 0x81, 0x46,
-	0x6b, 0xc1, 0x58, 0x84, 0xd9, 0x74, 0xbb, 0x95, 0xcb, 0x04, 0x77, 0x50, 0x24, 0x84, 0xa8, 0x67,
-	0x6b, 0x11, 0xc8, 0xb8, 0x3b, 0x7a, 0xc3, 0xda, 0xa6, 0x4a, 0xb3, 0x44, 0xa9, 0xb9, 0xd5, 0xb4,
-	0x83, 0xf3, 0x48, 0xbe, 0x8c, 0xb9, 0xa3, 0xa3, 0x91, 0x40, 0xaf, 0x81, 0x46, 0x0d, 0x6b, 0x9b,
-	0x96, 0x2b, 0xcd, 0xb2, 0x4d, 0xa9, 0x59, 0xf6, 0x9a, 0xb6, 0x0e, 0x67, 0x6d, 0x31, 0x79, 0xad,
-	0x7b, 0xc1, 0x94, 0x3c, 0xac, 0x33, 0x88, 0x11, 0x00, 0x89, 0x7a, 0xc6, 0xe8, 0xc9, 0x20, 0x05,
-	0x74, 0x31, 0x4a, 0xf0, 0x75, 0x6d, 0xcf, 0x1f, 0x2e, 0x51, 0xc3, 0xf2, 0xdc, 0x92, 0xee, 0x28,
-	0x26, 0xad, 0xde, 0x0b, 0x14, 0x7d, 0x22, 0xa1, 0xd5, 0x01, 0x13, 0x40, 0xd8, 0xbb, 0x68, 0xb2,
-	0xae, 0xed, 0x71, 0x0e, 0x36, 0x0b, 0x29, 0xfb, 0xd3, 0x5b, 0xf1, 0x83, 0x98, 0xc0, 0x63, 0xca,
-	0x5c, 0xbb, 0x95, 0xcb, 0x73, 0xca, 0x89, 0xa1, 0x44, 0x1d, 0xaf, 0xc7, 0xd5, 0x89, 0x3b, 0x75,
-	0x51, 0x42, 0x5b, 0x7b, 0x01, 0xfd, 0x07, 0x31, 0xa7, 0x2e, 0x2e, 0x1a, 0xb8, 0xbf, 0x85, 0x26,
-	0xe2, 0x08, 0x79, 0x7b, 0x40, 0x7c, 0xa6, 0xdd, 0xca, 0x9d, 0x4f, 0x26, 0xee, 0xed, 0x11, 0x15,
-	0xd7, 0x05, 0xf8, 0xb8, 0xa7, 0x46, 0xd1, 0x5c, 0x9d, 0xbd, 0x6a, 0x9d, 0x0b, 0xe2, 0x03, 0x49,
-	0x7c, 0x6b, 0xc2, 0x51, 0x40, 0xf1, 0x3d, 0x74, 0xd2, 0x7f, 0x54, 0xca, 0xec, 0xd1, 0x0c, 0x6e,
-	0x87, 0xd9, 0xe4, 0x1d, 0xd3, 0x81, 0x50, 0x64, 0xd8, 0x2c, 0x98, 0x0b, 0x08, 0xa1, 0x10, 0x15,
-	0x55, 0x3a, 0x95, 0x48, 0x1e, 0x65, 0xa3, 0x3c, 0x5e, 0xb5, 0xb4, 0x8a, 0xa9, 0xd7, 0x02, 0xaa,
-	0x9b, 0x28, 0x97, 0x18, 0x01, 0x34, 0x2f, 0xa2, 0x11, 0x9d, 0x7f, 0x62, 0x53, 0xf7, 0x1f, 0x05,
-	0x77, 0xdf, 0x3c, 0x18, 0x20, 0x6a, 0x10, 0xe2, 0x7b, 0x9b, 0x29, 0xe1, 0xf1, 0xa7, 0xd4, 0x0c,
-	0xde, 0xb9, 0xab, 0x08, 0x75, 0xe9, 0xc2, 0x21, 0x1e, 0xef, 0x5e, 0xd0, 0xdd, 0x31, 0xa2, 0x9e,
-	0xe8, 0x28, 0xc1, 0xcf, 0xa2, 0x93, 0xd4, 0xbb, 0xab, 0x3b, 0x90, 0x76, 0x84, 0xa5, 0x4d, 0x74,
-	0x67, 0x20, 0x34, 0x48, 0x54, 0xc4, 0xfe, 0xb1, 0x44, 0x72, 0x1b, 0x4d, 0xc7, 0xb3, 0x01, 0x71,
-	0x2b, 0x68, 0x84, 0x2d, 0xbd, 0x51, 0x83, 0x7d, 0x11, 0x12, 0x07, 0x03, 0xbe, 0xcf, 0xa0, 0xd4,
-	0xdc, 0xa8, 0x85, 0x17, 0x9f, 0x5b, 0x07, 0x8f, 0x56, 0x7d, 0xac, 0x5d, 0xdd, 0x6a, 0x74, 0x2e,
-	0x8e, 0xaf, 0x43, 0x8b, 0x1f, 0x17, 0x05, 0x85, 0x1f, 0x48, 0x68, 0x4c, 0x33, 0xcd, 0xb2, 0x0d,
-	0xe3, 0x65, 0x87, 0x07, 0xc0, 0xc5, 0xd1, 0xe7, 0x91, 0x10, 0x41, 0x95, 0x59, 0xd8, 0x0f, 0x53,
-	0x70, 0x47, 0xc7, 0xe0, 0x12, 0x15, 0x6b, 0x42, 0xe2, 0xe5, 0xbf, 0x33, 0xe8, 0x38, 0x23, 0x8b,
-	0x3f, 0x94, 0xd0, 0x30, 0xb7, 0xb9, 0xb8, 0x4f, 0x6d, 0xd1, 0x5d, 0xcb, 0xab, 0x03, 0x46, 0x73,
-	0xdd, 0x24, 0xff, 0xfe, 0xcf, 0x7f, 0x7e, 0x71, 0x44, 0xc6, 0x99, 0xa2, 0x60, 0xfa, 0xb9, 0x8d,
-	0xc6, 0x3f, 0x48, 0x68, 0x32, 0xd1, 0x18, 0xe3, 0x97, 0xf7, 0x29, 0xb7, 0x9f, 0xf9, 0x96, 0x5f,
-	0x39, 0x38, 0x00, 0x48, 0x58, 0x66, 0x12, 0xe6, 0x30, 0x11, 0x25, 0x44, 0xcd, 0x76, 0x54, 0x4c,
-	0xaf, 0x0d, 0x4e, 0x23, 0x26, 0xd6, 0x91, 0xa7, 0x11, 0x13, 0xef, 0xc0, 0xfb, 0x89, 0x01, 0x1b,
-	0xeb, 0x3f, 0x43, 0xec, 0x64, 0xe1, 0x6f, 0x25, 0x34, 0x1e, 0x6b, 0x9f, 0xf1, 0x0b, 0x83, 0xf3,
-	0x10, 0x9c, 0xb9, 0xfc, 0xe2, 0xc1, 0x92, 0x41, 0xc0, 0x3c, 0x13, 0x90, 0xc3, 0xe7, 0x45, 0x01,
-	0x70, 0x0e, 0x18, 0xc3, 0x5f, 0x24, 0x34, 0xdd, 0xcf, 0x32, 0x63, 0x65, 0x70, 0x16, 0x49, 0x26,
-	0x5e, 0xbe, 0x79, 0x28, 0x0c, 0x10, 0xb4, 0xca, 0x04, 0x2d, 0xe0, 0x79, 0x51, 0x50, 0xd7, 0xb1,
-	0xfa, 0x8b, 0xc2, 0x2c, 0x20, 0x7e, 0x2c, 0xa1, 0xf3, 0x7d, 0xad, 0x34, 0xbe, 0x99, 0x6a, 0x7e,
-	0xe3, 0x6d, 0xbb, 0xbc, 0x7e, 0x38, 0x10, 0xd0, 0x56, 0x60, 0xda, 0x16, 0xf1, 0x85, 0xf8, 0xc5,
-	0x62, 0x8a, 0xca, 0x5d, 0x95, 0xf8, 0xb7, 0x5e, 0x71, 0xa2, 0x3f, 0x4e, 0x23, 0x2e, 0xd1, 0xd1,
-	0xa7, 0x11, 0x97, 0x6c, 0xf4, 0x49, 0x91, 0x89, 0x5b, 0xc2, 0x0b, 0xa2, 0x38, 0xcf, 0xcf, 0x2a,
-	0xdb, 0x9a, 0xe1, 0x94, 0x35, 0xa7, 0xc2, 0x75, 0xba, 0xf8, 0x3b, 0x09, 0x9d, 0x4b, 0x70, 0xe4,
-	0xf8, 0x46, 0x8a, 0xf9, 0x16, 0x0d, 0xbf, 0xfc, 0xd2, 0x41, 0xd3, 0x41, 0xcb, 0x02, 0xd3, 0x32,
-	0x83, 0x73, 0x31, 0x0b, 0x15, 0xee, 0x00, 0xf0, 0x4f, 0x12, 0x9a, 0xea, 0xe3, 0xe1, 0xf1, 0xda,
-	0xe0, 0x44, 0x12, 0x5a, 0x05, 0x59, 0x39, 0x0c, 0x04, 0xe8, 0x59, 0x61, 0x7a, 0xe6, 0xf1, 0xac,
-	0xa8, 0x47, 0xe8, 0x1b, 0xf0, 0x8f, 0xbd, 0x97, 0x76, 0xaf, 0x53, 0x4f, 0x73, 0x69, 0xc7, 0xb6,
-	0x16, 0x69, 0x2e, 0xed, 0xf8, 0x8e, 0xa3, 0x9f, 0x1a, 0xa1, 0x71, 0xc0, 0xbf, 0xf7, 0x9e, 0x21,
-	0xd1, 0x33, 0xa7, 0x39, 0x43, 0x89, 0xfe, 0x3c, 0xcd, 0x19, 0x4a, 0xb6, 0xed, 0xe4, 0xff, 0x4c,
-	0xd9, 0x32, 0x5e, 0x14, 0x95, 0xc5, 0xdb, 0x74, 0xfc, 0x97, 0x84, 0xf2, 0xfb, 0x75, 0x34, 0xf8,
-	0xb5, 0x83, 0x93, 0x0b, 0xf7, 0x50, 0xf2, 0xad, 0x43, 0xe3, 0x80, 0xce, 0x2b, 0x4c, 0xe7, 0x2a,
-	0x5e, 0x19, 0x4c, 0x27, 0xeb, 0xa3, 0xa2, 0xef, 0x6f, 0xb7, 0xa5, 0x48, 0xf3, 0xfe, 0x0a, 0xed,
-	0x4a, 0x9a, 0xf7, 0x57, 0xec, 0x62, 0xfa, 0xbd, 0xbf, 0xa1, 0xbe, 0x04, 0x7f, 0x23, 0x21, 0x2c,
-	0x36, 0x19, 0xf8, 0xfa, 0xe0, 0xb5, 0x7b, 0x3b, 0x17, 0xf9, 0xb9, 0x03, 0x64, 0x02, 0xe5, 0x19,
-	0x46, 0x79, 0x0a, 0x4f, 0x8a, 0x94, 0xa1, 0x8d, 0xc1, 0x5f, 0x49, 0xe8, 0xbf, 0x91, 0x9e, 0x01,
-	0x3f, 0x93, 0xc2, 0x6c, 0x75, 0x3b, 0x1e, 0xf9, 0x5a, 0xda, 0x34, 0x60, 0x99, 0x65, 0x2c, 0x33,
-	0x78, 0x22, 0xc6, 0x99, 0xf9, 0x74, 0xbe, 0xe7, 0xbb, 0x41, 0x6c, 0x07, 0x06, 0xd9, 0x0d, 0x89,
-	0xfd, 0xcb, 0x20, 0xbb, 0x21, 0xb9, 0xad, 0xd9, 0xef, 0x81, 0x8f, 0x76, 0x25, 0xca, 0x9d, 0x87,
-	0x4f, 0xb2, 0xd2, 0xa3, 0x27, 0x59, 0xe9, 0x8f, 0x27, 0x59, 0xe9, 0xd3, 0xa7, 0xd9, 0xa1, 0x47,
-	0x4f, 0xb3, 0x43, 0xbf, 0x3e, 0xcd, 0x0e, 0xbd, 0x7d, 0x75, 0xc7, 0xf0, 0xee, 0x36, 0x2a, 0x85,
-	0x2a, 0xad, 0x07, 0x58, 0xab, 0xa6, 0x56, 0x71, 0x3b, 0xc0, 0xbb, 0x97, 0xaf, 0x15, 0xf7, 0x42,
-	0x4f, 0x6c, 0xd3, 0xd6, 0xdd, 0xca, 0x30, 0xfb, 0x7f, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x9a, 0xa8, 0x72, 0x82, 0x1a, 0x19, 0x00, 0x00,
+	// 1835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdb, 0xc8,
+	0x15, 0x36, 0x73, 0xb1, 0x9b, 0xc9, 0xa5, 0xf1, 0xc4, 0x76, 0x6c, 0xda, 0x91, 0xec, 0xf1, 0xfd,
+	0x26, 0x35, 0x97, 0xa6, 0x69, 0x9b, 0xb4, 0x31, 0xad, 0xd4, 0x30, 0xd2, 0xc6, 0x2e, 0xeb, 0xbe,
+	0xb4, 0x40, 0x59, 0x4a, 0xa2, 0x1d, 0x22, 0x14, 0x47, 0x21, 0x29, 0xc3, 0x42, 0xdf, 0x1a, 0xb4,
+	0x45, 0x81, 0x02, 0xe9, 0xe5, 0x07, 0x34, 0xcf, 0x45, 0x5f, 0xfb, 0xd0, 0xc7, 0xec, 0x53, 0xb0,
+	0xfb, 0x92, 0xc5, 0x02, 0x8b, 0x45, 0x76, 0x57, 0x58, 0x24, 0x8b, 0xc5, 0x3e, 0xeb, 0x17, 0x2c,
+	0x38, 0x73, 0x28, 0x51, 0x1c, 0x52, 0x57, 0x60, 0xdf, 0x24, 0xce, 0x39, 0xdf, 0xf9, 0xbe, 0xb9,
+	0x9c, 0x99, 0x0f, 0x2d, 0x50, 0xb7, 0x44, 0x5d, 0xd3, 0xcd, 0x96, 0x1d, 0xea, 0x51, 0xc7, 0x38,
+	0xce, 0x1e, 0x5f, 0xcf, 0x1b, 0x9e, 0x7e, 0x3d, 0xfb, 0xb4, 0x62, 0x38, 0xd5, 0x0c, 0xfb, 0x8c,
+	0x27, 0x21, 0x2a, 0x13, 0x44, 0x65, 0x20, 0x4a, 0x1e, 0x3b, 0xa2, 0x47, 0x94, 0x7d, 0xcd, 0xfa,
+	0xbf, 0x78, 0x80, 0x3c, 0x73, 0x44, 0xe9, 0x91, 0x65, 0x64, 0xf5, 0xb2, 0x99, 0xd5, 0x6d, 0x9b,
+	0x7a, 0xba, 0x67, 0x52, 0x1b, 0xd2, 0xe5, 0xb5, 0x02, 0x83, 0xcb, 0xe6, 0x75, 0xd7, 0xe0, 0x65,
+	0x1a, 0x45, 0xcb, 0xfa, 0x91, 0x69, 0xb3, 0x60, 0x88, 0x5d, 0x4c, 0xe4, 0x57, 0xd6, 0x1d, 0xbd,
+	0x14, 0x40, 0x2e, 0x27, 0x87, 0x05, 0x8c, 0x79, 0x60, 0x2a, 0x5c, 0x3b, 0x88, 0x29, 0x50, 0x13,
+	0xea, 0x91, 0x31, 0x84, 0x7f, 0xe9, 0x33, 0xda, 0x67, 0xe8, 0xaa, 0xf1, 0xb4, 0x62, 0xb8, 0x1e,
+	0x39, 0x44, 0x57, 0x5a, 0xbe, 0xba, 0x65, 0x6a, 0xbb, 0x06, 0xde, 0x43, 0xc3, 0x9c, 0xc5, 0xa4,
+	0x34, 0x2b, 0xad, 0x9c, 0xbf, 0x31, 0x9b, 0x49, 0x9a, 0xa7, 0x0c, 0xcf, 0x54, 0xc6, 0x5f, 0xd5,
+	0xd2, 0x43, 0xf5, 0x5a, 0xfa, 0x62, 0x55, 0x2f, 0x59, 0x3f, 0x22, 0x3c, 0x9b, 0xa8, 0x00, 0x43,
+	0x96, 0xd1, 0x22, 0xab, 0xb3, 0x63, 0x78, 0xfb, 0x3e, 0x82, 0x6a, 0x1c, 0x3f, 0xaa, 0x94, 0xf2,
+	0x86, 0xb3, 0x77, 0x78, 0xe0, 0xe8, 0x45, 0xa3, 0x41, 0xe8, 0x6f, 0x12, 0x5a, 0xea, 0x14, 0x09,
+	0x24, 0xf3, 0xe8, 0xb2, 0xcd, 0x46, 0x34, 0x7a, 0xa8, 0x79, 0x6c, 0x8c, 0xd1, 0x3d, 0xa7, 0xdc,
+	0xf1, 0xc9, 0xbc, 0xa9, 0xa5, 0xc7, 0xf9, 0x9c, 0xb8, 0xc5, 0x27, 0x19, 0x93, 0x66, 0x4b, 0xba,
+	0xf7, 0x38, 0xb3, 0x6b, 0x7b, 0xf5, 0x5a, 0xfa, 0x2a, 0x67, 0x19, 0x4d, 0x27, 0xea, 0x25, 0xbb,
+	0xa5, 0x16, 0xd9, 0x13, 0x79, 0xef, 0x3b, 0xf4, 0xd0, 0xf4, 0x5c, 0xa5, 0x9a, 0x33, 0x6c, 0x5a,
+	0x02, 0xde, 0x78, 0x09, 0x9d, 0x2d, 0xfa, 0xff, 0x81, 0xc1, 0xe5, 0x7a, 0x2d, 0x7d, 0x81, 0x17,
+	0x61, 0x9f, 0x89, 0xca, 0x87, 0x89, 0x8d, 0x96, 0x3a, 0x01, 0x82, 0xbc, 0x1c, 0x1a, 0x2e, 0xb3,
+	0x11, 0x58, 0x83, 0xa9, 0x0c, 0x57, 0x93, 0xf1, 0x57, 0xb8, 0x31, 0xfd, 0xdb, 0xd4, 0xb4, 0x95,
+	0xd1, 0xd0, 0xc4, 0xb3, 0x14, 0x7f, 0xe2, 0xf9, 0x8f, 0x79, 0x34, 0x17, 0xad, 0xb7, 0x65, 0x59,
+	0x50, 0x32, 0x98, 0xf4, 0xa7, 0x88, 0xb4, 0x0b, 0x02, 0x42, 0x0f, 0xd1, 0x08, 0x07, 0xf5, 0xa7,
+	0xf9, 0x74, 0x7b, 0x46, 0x13, 0xb0, 0x1d, 0x2e, 0x85, 0x59, 0xb9, 0x44, 0x1d, 0x69, 0xfc, 0x42,
+	0x2b, 0xd1, 0x92, 0xbf, 0xf2, 0x0f, 0x93, 0xeb, 0x99, 0x05, 0x57, 0xa9, 0xaa, 0xb4, 0xe2, 0x19,
+	0xa1, 0xb9, 0x75, 0xfc, 0xff, 0xac, 0xec, 0x99, 0xf0, 0xdc, 0xb2, 0xcf, 0x44, 0xe5, 0xc3, 0xe4,
+	0x1f, 0x12, 0x5a, 0xed, 0x02, 0x14, 0xe4, 0x14, 0x11, 0x72, 0x1b, 0x83, 0x30, 0xc7, 0xab, 0xc9,
+	0xfb, 0x9c, 0x25, 0x87, 0xd0, 0xa6, 0x40, 0xe1, 0x28, 0x67, 0xd2, 0x84, 0x22, 0x6a, 0x08, 0x97,
+	0xac, 0x8b, 0x94, 0xb6, 0x2c, 0x2b, 0x02, 0x16, 0xac, 0xc3, 0x3f, 0x25, 0xb4, 0xd6, 0x4d, 0x74,
+	0x82, 0x82, 0xd3, 0xdf, 0x96, 0x82, 0x03, 0xfa, 0xc4, 0xb0, 0xf7, 0x75, 0xd3, 0xd9, 0x72, 0xf2,
+	0x0c, 0xb5, 0xa1, 0xe0, 0xaf, 0x31, 0x0a, 0xe2, 0xa2, 0x41, 0xc1, 0x6f, 0xd1, 0x30, 0x5b, 0xba,
+	0x80, 0xfd, 0x46, 0x32, 0x7b, 0x11, 0x25, 0xda, 0x73, 0x38, 0x12, 0x51, 0x01, 0x92, 0x2c, 0xa2,
+	0x79, 0x61, 0x32, 0x8b, 0x25, 0xd3, 0xde, 0x2a, 0x14, 0x68, 0xc5, 0xf6, 0x02, 0xca, 0x06, 0x5a,
+	0x68, 0x1f, 0x06, 0x5c, 0xef, 0xa1, 0x8b, 0xba, 0xff, 0x5d, 0xd3, 0xf9, 0x00, 0x9c, 0xf4, 0xc9,
+	0x7a, 0x2d, 0x3d, 0xc6, 0x09, 0xb4, 0x0c, 0x13, 0xf5, 0x82, 0x1e, 0x82, 0x21, 0xab, 0x68, 0x39,
+	0x5a, 0x26, 0x67, 0x1c, 0x1b, 0x16, 0x2d, 0x1b, 0x4e, 0x84, 0x51, 0x05, 0xad, 0x74, 0x0e, 0x05,
+	0x56, 0xbb, 0x68, 0xb4, 0x18, 0x8c, 0x45, 0x98, 0xcd, 0xd4, 0x6b, 0xe9, 0xc9, 0xa0, 0x07, 0x45,
+	0x42, 0x88, 0x7a, 0xb9, 0x18, 0x81, 0x8c, 0xeb, 0xd1, 0xbb, 0xf6, 0x21, 0x55, 0xaa, 0xfb, 0x94,
+	0x5a, 0x07, 0xd5, 0x72, 0x70, 0x1e, 0xc9, 0xbf, 0x63, 0x7a, 0x74, 0x34, 0x12, 0xe8, 0x55, 0xd0,
+	0xa8, 0x69, 0x1f, 0x52, 0x2d, 0x5f, 0xd5, 0xca, 0x94, 0x5a, 0x9a, 0x57, 0x2d, 0x1b, 0x70, 0xd6,
+	0x56, 0x92, 0xd7, 0xba, 0x15, 0x4c, 0x99, 0x85, 0x75, 0x06, 0x31, 0x02, 0x20, 0x51, 0x2f, 0x99,
+	0x2d, 0x19, 0x24, 0x83, 0x36, 0xa2, 0x04, 0x7f, 0xa1, 0x9f, 0xf8, 0xc3, 0xfb, 0xd4, 0xb4, 0x3d,
+	0x77, 0xdf, 0x70, 0x14, 0x8b, 0x16, 0x9e, 0x04, 0x8a, 0x9e, 0x4b, 0x68, 0xb3, 0xcb, 0x04, 0x10,
+	0xf6, 0x3b, 0x34, 0x55, 0xd2, 0x4f, 0x38, 0x87, 0x32, 0x0b, 0xd1, 0xfc, 0xe9, 0xcd, 0xfb, 0x41,
+	0x4c, 0xe0, 0x19, 0x65, 0xa1, 0x5e, 0x4b, 0xcf, 0x72, 0xca, 0x89, 0xa1, 0x44, 0x1d, 0x2f, 0xc5,
+	0xd5, 0x89, 0x3b, 0x75, 0x51, 0x42, 0x07, 0x27, 0x01, 0xfd, 0x67, 0x31, 0xa7, 0x2e, 0x2e, 0x1a,
+	0xb8, 0xff, 0x1a, 0x4d, 0xc4, 0x11, 0xf2, 0x4e, 0x80, 0xf8, 0x5c, 0xbd, 0x96, 0xbe, 0x96, 0x4c,
+	0xdc, 0x3b, 0x21, 0x2a, 0x2e, 0x09, 0xf0, 0x71, 0x57, 0x8d, 0xa2, 0xbb, 0x06, 0xbb, 0xd5, 0x1a,
+	0x0d, 0xe2, 0xcf, 0x12, 0x22, 0xed, 0xa2, 0x80, 0xe2, 0xef, 0xd1, 0x79, 0xff, 0x52, 0xd1, 0xd8,
+	0xa5, 0x19, 0x74, 0x87, 0xf9, 0xe4, 0x1d, 0xd3, 0x80, 0x50, 0x64, 0xd8, 0x2c, 0x98, 0x0b, 0x08,
+	0xa1, 0x10, 0x15, 0xe5, 0x1b, 0x95, 0xc8, 0x2c, 0x4a, 0x45, 0x79, 0x3c, 0xb0, 0xf5, 0xbc, 0x65,
+	0x14, 0x03, 0xaa, 0x7b, 0x28, 0x9d, 0x18, 0x01, 0x34, 0x37, 0xd0, 0x88, 0xc1, 0x3f, 0xb1, 0xa9,
+	0xfb, 0x8e, 0x82, 0x9b, 0x77, 0x1e, 0x0c, 0x10, 0x35, 0x08, 0xf1, 0xdf, 0x36, 0xd3, 0xc2, 0xe5,
+	0x4f, 0xa9, 0x15, 0xdc, 0x73, 0xb7, 0x10, 0x6a, 0xd2, 0x85, 0x43, 0x3c, 0xde, 0x6c, 0xd0, 0xcd,
+	0x31, 0xa2, 0x9e, 0x6b, 0x28, 0xc1, 0x3f, 0x40, 0xe7, 0xa9, 0xf7, 0xd8, 0x70, 0x20, 0xed, 0x14,
+	0x4b, 0x9b, 0x68, 0xce, 0x40, 0x68, 0x90, 0xa8, 0x88, 0xfd, 0x63, 0x89, 0xe4, 0x21, 0x9a, 0x89,
+	0x67, 0x03, 0xe2, 0xd6, 0xd1, 0x08, 0x5b, 0x7a, 0xb3, 0x08, 0xfb, 0x22, 0x24, 0x0e, 0x06, 0xfc,
+	0x77, 0x06, 0xa5, 0xd6, 0x6e, 0x31, 0xbc, 0xf8, 0xfc, 0xe9, 0xe0, 0xd1, 0x82, 0x8f, 0x75, 0x6c,
+	0xd8, 0x95, 0x46, 0xe3, 0xf8, 0x4f, 0x68, 0xf1, 0xe3, 0xa2, 0xa0, 0xf0, 0x33, 0x09, 0x8d, 0xe9,
+	0x96, 0xa5, 0x95, 0x61, 0x5c, 0x73, 0x78, 0x00, 0x34, 0x8e, 0x36, 0x97, 0x84, 0x08, 0xaa, 0xcc,
+	0xc3, 0x7e, 0x98, 0x86, 0x1e, 0x1d, 0x83, 0x4b, 0x54, 0xac, 0x0b, 0x89, 0x64, 0x43, 0x3c, 0x53,
+	0xfc, 0x45, 0x94, 0x33, 0x5d, 0xcf, 0x31, 0xf3, 0x15, 0xf6, 0xf2, 0x0f, 0xa4, 0xfd, 0x4f, 0x42,
+	0xeb, 0x5d, 0x85, 0x83, 0xc6, 0x3f, 0x49, 0x68, 0x8c, 0xbf, 0x85, 0xb4, 0x62, 0x38, 0x00, 0x34,
+	0x6e, 0xb6, 0x79, 0x70, 0x8b, 0xa8, 0x51, 0x91, 0x71, 0xc0, 0x44, 0xbd, 0x52, 0x16, 0x33, 0xc9,
+	0x9a, 0x78, 0xd7, 0x3c, 0x38, 0x31, 0x0a, 0x6c, 0x74, 0x47, 0x77, 0x7f, 0x6e, 0x96, 0xcc, 0xc6,
+	0xbd, 0xf4, 0x07, 0xb4, 0xda, 0x45, 0x2c, 0x08, 0x7c, 0x84, 0xae, 0x18, 0xc1, 0xa0, 0x76, 0xa4,
+	0xbb, 0x9a, 0xe5, 0x0f, 0xc3, 0x4e, 0x4a, 0xd5, 0x6b, 0x69, 0x19, 0x8e, 0x89, 0x18, 0x44, 0xd4,
+	0x51, 0x23, 0x8a, 0x1b, 0xd7, 0xd2, 0xfd, 0x95, 0x72, 0x3c, 0xa3, 0xc8, 0xde, 0xea, 0x5b, 0x9e,
+	0x67, 0x94, 0xca, 0xcd, 0x37, 0xed, 0xcb, 0x98, 0x96, 0x9e, 0x90, 0x00, 0x8c, 0x9f, 0x4b, 0xe8,
+	0xaa, 0x03, 0x11, 0xdc, 0x10, 0x68, 0x3a, 0xc4, 0xc0, 0xaa, 0x64, 0xdb, 0x3c, 0xae, 0xe2, 0xa0,
+	0x95, 0x25, 0x58, 0x97, 0x14, 0xd7, 0x9a, 0x80, 0x4e, 0xd4, 0x71, 0x27, 0x2e, 0xfd, 0xc6, 0x8b,
+	0x14, 0x3a, 0xcb, 0x34, 0xe0, 0xbf, 0x48, 0x68, 0x98, 0x3b, 0x2d, 0xdc, 0x66, 0xfb, 0x8b, 0x06,
+	0x4f, 0xde, 0xec, 0x32, 0x9a, 0xcf, 0x01, 0x99, 0xfd, 0xe3, 0x47, 0x5f, 0xfe, 0xeb, 0x94, 0x8c,
+	0x27, 0xb3, 0x82, 0xef, 0xe4, 0x4e, 0x0e, 0xbf, 0x2f, 0xa1, 0xa9, 0x44, 0x6f, 0x86, 0x7f, 0xda,
+	0xa1, 0x5c, 0x27, 0xff, 0x27, 0xdf, 0xef, 0x1f, 0x00, 0x24, 0xac, 0x31, 0x09, 0x0b, 0x98, 0x88,
+	0x12, 0xa2, 0x7e, 0x2f, 0x2a, 0xa6, 0xd5, 0x89, 0xf5, 0x22, 0x26, 0xd6, 0x14, 0xca, 0xf7, 0xfb,
+	0x07, 0xe8, 0x2c, 0x06, 0x9c, 0x94, 0xff, 0x12, 0x62, 0xcd, 0x1d, 0xff, 0x5f, 0x42, 0xe3, 0xb1,
+	0x0e, 0x0e, 0xff, 0xb8, 0x7b, 0x1e, 0x82, 0x39, 0x94, 0xef, 0xf6, 0x97, 0x0c, 0x02, 0x16, 0x99,
+	0x80, 0x34, 0xbe, 0x26, 0x0a, 0x80, 0x56, 0xcc, 0x18, 0x7e, 0x2c, 0xa1, 0x99, 0x76, 0xae, 0x0d,
+	0x2b, 0xdd, 0xb3, 0x48, 0xf2, 0x91, 0xf2, 0xf6, 0x40, 0x18, 0x20, 0x68, 0x93, 0x09, 0x5a, 0xc6,
+	0x8b, 0xa2, 0xa0, 0xa6, 0x69, 0xf2, 0x17, 0x85, 0xb9, 0x10, 0xfc, 0x46, 0x42, 0xd7, 0xda, 0xba,
+	0x39, 0xbc, 0xdd, 0xd3, 0xfc, 0xc6, 0x3b, 0x47, 0x39, 0x37, 0x18, 0x08, 0x68, 0xcb, 0x30, 0x6d,
+	0x2b, 0x78, 0x29, 0x7e, 0xb1, 0x98, 0x22, 0xad, 0xa9, 0x12, 0x7f, 0xda, 0x2a, 0x4e, 0xb4, 0x68,
+	0xbd, 0x88, 0x4b, 0x34, 0x95, 0x72, 0x6e, 0x30, 0x10, 0x10, 0x97, 0x65, 0xe2, 0x56, 0xf1, 0xb2,
+	0x28, 0xce, 0xf3, 0xb3, 0xb4, 0xb2, 0x6e, 0x3a, 0x9a, 0xee, 0xe4, 0xb9, 0x4e, 0x17, 0xbf, 0x94,
+	0xd0, 0xd5, 0x04, 0x53, 0x88, 0xef, 0xf5, 0x30, 0xdf, 0xa2, 0xe7, 0x94, 0x7f, 0xd2, 0x6f, 0x3a,
+	0x68, 0x59, 0x66, 0x5a, 0xe6, 0x70, 0x3a, 0x66, 0xa1, 0xc2, 0x26, 0x14, 0x7f, 0x28, 0xa1, 0xe9,
+	0x36, 0x36, 0x12, 0x6f, 0x75, 0x4f, 0x24, 0xc1, 0xad, 0xca, 0xca, 0x20, 0x10, 0xa0, 0x67, 0x9d,
+	0xe9, 0x59, 0xc4, 0xf3, 0xa2, 0x1e, 0xc1, 0xba, 0xe2, 0x0f, 0x5a, 0x9b, 0x76, 0xab, 0x59, 0xec,
+	0xa5, 0x69, 0xc7, 0xba, 0x5b, 0xf9, 0x7e, 0xff, 0x00, 0x9d, 0xd5, 0x08, 0xde, 0x15, 0x7f, 0xd6,
+	0x7a, 0x86, 0x44, 0xdb, 0xd6, 0xcb, 0x19, 0x4a, 0xb4, 0x88, 0x72, 0x6e, 0x30, 0x10, 0x50, 0xf6,
+	0x3d, 0xa6, 0x6c, 0x0d, 0xaf, 0x88, 0xca, 0xe2, 0x9d, 0x22, 0xfe, 0x5a, 0x42, 0xb3, 0x9d, 0x4c,
+	0x35, 0xfe, 0x59, 0xff, 0xe4, 0xc2, 0x36, 0x5e, 0xde, 0x19, 0x18, 0x07, 0x74, 0xde, 0x64, 0x3a,
+	0x37, 0xf1, 0x7a, 0x77, 0x3a, 0x99, 0x95, 0x8f, 0xde, 0xbf, 0x4d, 0x57, 0xdb, 0xcb, 0xfd, 0x2b,
+	0x38, 0x66, 0xf9, 0x6e, 0x7f, 0xc9, 0x9d, 0xef, 0xdf, 0x90, 0x35, 0xc6, 0xff, 0x95, 0x10, 0x16,
+	0x7d, 0x2e, 0xbe, 0xd3, 0x7d, 0xed, 0x56, 0xf3, 0x2c, 0xff, 0xb0, 0x8f, 0x4c, 0xa0, 0x3c, 0xc7,
+	0x28, 0x4f, 0xe3, 0x29, 0x91, 0x32, 0x38, 0x69, 0xfc, 0x42, 0x42, 0xdf, 0x8d, 0xd8, 0x56, 0xfc,
+	0xfd, 0x1e, 0x1e, 0x5b, 0x4d, 0xd3, 0x2d, 0xdf, 0xee, 0x35, 0x0d, 0x58, 0xa6, 0x18, 0xcb, 0x49,
+	0x3c, 0x21, 0xb2, 0xf4, 0xb7, 0x07, 0x7e, 0x8f, 0xef, 0x06, 0xd1, 0x91, 0x76, 0xb3, 0x1b, 0x12,
+	0x2d, 0xb4, 0x7c, 0xb7, 0xbf, 0xe4, 0xee, 0x2e, 0xf8, 0xa8, 0x31, 0xc6, 0x9f, 0x4b, 0x28, 0xd5,
+	0xde, 0xd0, 0xe2, 0x5c, 0xaf, 0x6f, 0xdc, 0x38, 0xfb, 0x2c, 0x3f, 0x18, 0x10, 0xa5, 0xb3, 0xbe,
+	0x38, 0x4f, 0x1c, 0x7d, 0x76, 0x0a, 0x6e, 0xb6, 0x97, 0x67, 0x67, 0x92, 0x6d, 0x96, 0xb7, 0x07,
+	0xc2, 0xe8, 0xfc, 0xec, 0x8c, 0x71, 0xd0, 0xf8, 0xab, 0xd6, 0xb6, 0x1b, 0xeb, 0x4e, 0x7b, 0x69,
+	0xbb, 0xed, 0xac, 0xb6, 0xbc, 0x33, 0x30, 0x0e, 0x88, 0xbc, 0xce, 0x44, 0xae, 0xe3, 0x55, 0x51,
+	0x64, 0x82, 0x75, 0x56, 0x1e, 0xbd, 0x7a, 0x9b, 0x92, 0x5e, 0xbf, 0x4d, 0x49, 0x5f, 0xbc, 0x4d,
+	0x49, 0x7f, 0x7f, 0x97, 0x1a, 0x7a, 0xfd, 0x2e, 0x35, 0xf4, 0xc9, 0xbb, 0xd4, 0xd0, 0x6f, 0x6e,
+	0x1d, 0x99, 0xde, 0xe3, 0x4a, 0x3e, 0x53, 0xa0, 0xa5, 0x00, 0x6e, 0xd3, 0xd2, 0xf3, 0x6e, 0x03,
+	0xfb, 0xf8, 0xc6, 0xed, 0xec, 0x49, 0xb3, 0x82, 0x7f, 0x1b, 0xbb, 0xf9, 0x61, 0xf6, 0xff, 0xe6,
+	0x37, 0x03, 0x00, 0x98, 0x7d, 0x13, 0x50, 0x3f, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1668,6 +1968,15 @@ type QueryClient interface {
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error)
+	// GetProtoRevProfitDistributions queries the cumulative arbitrage profits
+	// distributed by the module, per destination
+	GetProtoRevProfitDistributions(ctx context.Context, in *QueryGetProtoRevProfitDistributionsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitDistributionsResponse, error)
+	// GetProtoRevExecutionGasLimit queries the gas limit of the execution of
+	// the backrun of a transaction
+	GetProtoRevExecutionGasLimit(ctx context.Context, in *QueryGetProtoRevExecutionGasLimitRequest, opts ...grpc.CallOption) (*QueryGetProtoRevExecutionGasLimitResponse, error)
+	// GetProtoRevRevertedTradeAttempts queries the number of backrun attempts
+	// that were reverted, per reason
+	GetProtoRevRevertedTradeAttempts(ctx context.Context, in *QueryGetProtoRevRevertedTradeAttemptsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevRevertedTradeAttemptsResponse, error)
 }
 
 type queryClient struct {
@@ -1822,6 +2131,33 @@ func (c *queryClient) GetAllProtocolRevenue(ctx context.Context, in *QueryGetAll
 	return out, nil
 }
 
+func (c *queryClient) GetProtoRevProfitDistributions(ctx context.Context, in *QueryGetProtoRevProfitDistributionsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitDistributionsResponse, error) {
+	out := new(QueryGetProtoRevProfitDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProtoRevExecutionGasLimit(ctx context.Context, in *QueryGetProtoRevExecutionGasLimitRequest, opts ...grpc.CallOption) (*QueryGetProtoRevExecutionGasLimitResponse, error) {
+	out := new(QueryGetProtoRevExecutionGasLimitResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevExecutionGasLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProtoRevRevertedTradeAttempts(ctx context.Context, in *QueryGetProtoRevRevertedTradeAttemptsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevRevertedTradeAttemptsResponse, error) {
+	out := new(QueryGetProtoRevRevertedTradeAttemptsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevRevertedTradeAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1867,6 +2203,15 @@ type QueryServer interface {
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(context.Context, *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error)
+	// GetProtoRevProfitDistributions queries the cumulative arbitrage profits
+	// distributed by the module, per destination
+	GetProtoRevProfitDistributions(context.Context, *QueryGetProtoRevProfitDistributionsRequest) (*QueryGetProtoRevProfitDistributionsResponse, error)
+	// GetProtoRevExecutionGasLimit queries the gas limit of the execution of
+	// the backrun of a transaction
+	GetProtoRevExecutionGasLimit(context.Context, *QueryGetProtoRevExecutionGasLimitRequest) (*QueryGetProtoRevExecutionGasLimitResponse, error)
+	// GetProtoRevRevertedTradeAttempts queries the number of backrun attempts
+	// that were reverted, per reason
+	GetProtoRevRevertedTradeAttempts(context.Context, *QueryGetProtoRevRevertedTradeAttemptsRequest) (*QueryGetProtoRevRevertedTradeAttemptsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1921,6 +2266,15 @@ func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) GetAllProtocolRevenue(ctx context.Context, req *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllProtocolRevenue not implemented")
 }
+func (*UnimplementedQueryServer) GetProtoRevProfitDistributions(ctx context.Context, req *QueryGetProtoRevProfitDistributionsRequest) (*QueryGetProtoRevProfitDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitDistributions not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevExecutionGasLimit(ctx context.Context, req *QueryGetProtoRevExecutionGasLimitRequest) (*QueryGetProtoRevExecutionGasLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevExecutionGasLimit not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevRevertedTradeAttempts(ctx context.Context, req *QueryGetProtoRevRevertedTradeAttemptsRequest) (*QueryGetProtoRevRevertedTradeAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevRevertedTradeAttempts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2214,6 +2568,61 @@ func _Query_GetAllProtocolRevenue_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetProtoRevProfitDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitDistributions(ctx, req.(*QueryGetProtoRevProfitDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevExecutionGasLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevExecutionGasLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevExecutionGasLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevExecutionGasLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevExecutionGasLimit(ctx, req.(*QueryGetProtoRevExecutionGasLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevRevertedTradeAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevRevertedTradeAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevRevertedTradeAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevRevertedTradeAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevRevertedTradeAttempts(ctx, req.(*QueryGetProtoRevRevertedTradeAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2282,6 +2691,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllProtocolRevenue",
 			Handler:    _Query_GetAllProtocolRevenue_Handler,
 		},
+		{
+			MethodName: "GetProtoRevProfitDistributions",
+			Handler:    _Query_GetProtoRevProfitDistributions_Handler,
+		},
+		{
+			MethodName: "GetProtoRevExecutionGasLimit",
+			Handler:    _Query_GetProtoRevExecutionGasLimit_Handler,
+		},
+		{
+			MethodName: "GetProtoRevRevertedTradeAttempts",
+			Handler:    _Query_GetProtoRevRevertedTradeAttempts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/query.proto",
@@ -3219,35 +3640,198 @@ func (m *QueryGetAllProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetProtoRevProfitDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetProtoRevProfitDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevProfitDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProfitDistributions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutionGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RevertedTradeAttempts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
@@ -3595,6 +4179,67 @@ func (m *QueryGetAllProtocolRevenueResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetProtoRevProfitDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevProfitDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProfitDistributions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevExecutionGasLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutionGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutionGasLimit))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RevertedTradeAttempts.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5852,6 +6497,391 @@ func (m *QueryGetAllProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetProtoRevProfitDistributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitDistributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitDistributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitDistributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfitDistributions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevExecutionGasLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevExecutionGasLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevExecutionGasLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevExecutionGasLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevExecutionGasLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevExecutionGasLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasLimit", wireType)
+			}
+			m.ExecutionGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevRevertedTradeAttemptsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevRevertedTradeAttemptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevRevertedTradeAttemptsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevRevertedTradeAttemptsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevRevertedTradeAttemptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedTradeAttempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevertedTradeAttempts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
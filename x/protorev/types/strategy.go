@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// SwapToBackrun is a swap made in a transaction that the posthandler attempts to backrun.
+type SwapToBackrun struct {
+	PoolId        uint64
+	TokenOutDenom string
+	TokenInDenom  string
+}
+
+// RouteMetaData is a cyclic arbitrage route built for a swap along with its search metadata.
+type RouteMetaData struct {
+	// The route that was built
+	Route poolmanagertypes.SwapAmountInRoutes
+	// The number of pool points that were consumed to build the route
+	PoolPoints uint64
+	// The step size that should be used in the binary search for the optimal swap amount
+	StepSize osmomath.Int
+}
+
+// RouteSearcher defines the contract for building the cyclic arbitrage routes
+// of a swap made on a pool.
+type RouteSearcher interface {
+	BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) []RouteMetaData
+}
+
+// ProfitEstimator defines the contract for finding the most profitable route and input amount
+// among the given routes, consuming the remaining pool points.
+type ProfitEstimator interface {
+	IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, poolmanagertypes.SwapAmountInRoutes)
+}
+
+// TradeExecutor defines the contract for executing a profitable arbitrage route.
+type TradeExecutor interface {
+	ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool SwapToBackrun, remainingTxPoolPoints, remainingBlockPoolPoints uint64) error
+}
+
+// ArbitrageStrategy defines the contract an arbitrage strategy must fulfill to be run
+// by the x/protorev posthandler on the swaps of every transaction.
+type ArbitrageStrategy interface {
+	RouteSearcher
+	ProfitEstimator
+	TradeExecutor
+}
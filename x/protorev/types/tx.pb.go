@@ -593,6 +593,100 @@ func (m *MsgSetBaseDenomsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBaseDenomsResponse proto.InternalMessageInfo
 
+// MsgSetExecutionGasLimit defines the Msg/SetExecutionGasLimit request type.
+type MsgSetExecutionGasLimit struct {
+	// admin is the account that is authorized to set the execution gas limit.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// execution_gas_limit is the gas limit of the execution of the backrun of a
+	// transaction.
+	ExecutionGasLimit uint64 `protobuf:"varint,2,opt,name=execution_gas_limit,json=executionGasLimit,proto3" json:"execution_gas_limit,omitempty" yaml:"execution_gas_limit"`
+}
+
+func (m *MsgSetExecutionGasLimit) Reset()         { *m = MsgSetExecutionGasLimit{} }
+func (m *MsgSetExecutionGasLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExecutionGasLimit) ProtoMessage()    {}
+func (*MsgSetExecutionGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{12}
+}
+func (m *MsgSetExecutionGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExecutionGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExecutionGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetExecutionGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExecutionGasLimit.Merge(m, src)
+}
+func (m *MsgSetExecutionGasLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExecutionGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExecutionGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetExecutionGasLimit proto.InternalMessageInfo
+
+func (m *MsgSetExecutionGasLimit) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetExecutionGasLimit) GetExecutionGasLimit() uint64 {
+	if m != nil {
+		return m.ExecutionGasLimit
+	}
+	return 0
+}
+
+// MsgSetExecutionGasLimitResponse defines the Msg/SetExecutionGasLimit
+// response type.
+type MsgSetExecutionGasLimitResponse struct {
+}
+
+func (m *MsgSetExecutionGasLimitResponse) Reset()         { *m = MsgSetExecutionGasLimitResponse{} }
+func (m *MsgSetExecutionGasLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExecutionGasLimitResponse) ProtoMessage()    {}
+func (*MsgSetExecutionGasLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{13}
+}
+func (m *MsgSetExecutionGasLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExecutionGasLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExecutionGasLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetExecutionGasLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExecutionGasLimitResponse.Merge(m, src)
+}
+func (m *MsgSetExecutionGasLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExecutionGasLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExecutionGasLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetExecutionGasLimitResponse proto.InternalMessageInfo
+
 // MsgSetPoolWeights defines the Msg/SetPoolWeights request type.
 type MsgSetPoolWeights struct {
 	// admin is the account that is authorized to set the pool weights.
@@ -605,7 +699,7 @@ func (m *MsgSetPoolWeights) Reset()         { *m = MsgSetPoolWeights{} }
 func (m *MsgSetPoolWeights) String() string { return proto.CompactTextString(m) }
 func (*MsgSetPoolWeights) ProtoMessage()    {}
 func (*MsgSetPoolWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2783dce032fc6954, []int{12}
+	return fileDescriptor_2783dce032fc6954, []int{14}
 }
 func (m *MsgSetPoolWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,72 +755,80 @@ func init() {
 	proto.RegisterType((*MsgSetMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.MsgSetMaxPoolPointsPerBlockResponse")
 	proto.RegisterType((*MsgSetBaseDenoms)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenoms")
 	proto.RegisterType((*MsgSetBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenomsResponse")
+	proto.RegisterType((*MsgSetExecutionGasLimit)(nil), "osmosis.protorev.v1beta1.MsgSetExecutionGasLimit")
+	proto.RegisterType((*MsgSetExecutionGasLimitResponse)(nil), "osmosis.protorev.v1beta1.MsgSetExecutionGasLimitResponse")
 	proto.RegisterType((*MsgSetPoolWeights)(nil), "osmosis.protorev.v1beta1.MsgSetPoolWeights")
 }
 
 func init() { proto.RegisterFile("osmosis/protorev/v1beta1/tx.proto", fileDescriptor_2783dce032fc6954) }
 
 var fileDescriptor_2783dce032fc6954 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x18, 0xed, 0x74, 0x01, 0xb1, 0xd3, 0x02, 0x1b, 0xd3, 0xdd, 0x26, 0xa6, 0x38, 0xe9, 0x94, 0xa5,
-	0x69, 0xd9, 0xc6, 0x24, 0x2c, 0x0b, 0x8a, 0x04, 0x52, 0xad, 0x3d, 0xec, 0x1e, 0x8a, 0x2a, 0x6f,
-	0x11, 0x12, 0x07, 0x8c, 0x9d, 0x4c, 0x5d, 0x6b, 0x63, 0x8f, 0xe5, 0x99, 0x86, 0xe4, 0xba, 0x47,
-	0x4e, 0x48, 0x48, 0x1c, 0xf8, 0x0b, 0x70, 0x58, 0x21, 0xae, 0xdc, 0x97, 0xdb, 0xaa, 0x5c, 0xb8,
-	0x10, 0x41, 0x8b, 0xd4, 0x7b, 0x8e, 0x9c, 0x50, 0x66, 0x1c, 0xa7, 0x13, 0xdb, 0xb4, 0x21, 0x97,
-	0x36, 0x33, 0xf3, 0xbe, 0xf7, 0xbd, 0xf7, 0x6c, 0x7f, 0x03, 0xd7, 0x09, 0xf5, 0x09, 0xf5, 0xa8,
-	0x1e, 0x46, 0x84, 0x91, 0x08, 0x77, 0xf5, 0x6e, 0xdd, 0xc1, 0xcc, 0xae, 0xeb, 0xac, 0x57, 0xe3,
-	0x7b, 0x4a, 0x31, 0x86, 0xd4, 0xc6, 0x90, 0x5a, 0x0c, 0x51, 0x57, 0x5c, 0xe2, 0x12, 0xbe, 0xab,
-	0x8f, 0x7e, 0x09, 0x80, 0x5a, 0xb0, 0x7d, 0x2f, 0x20, 0x3a, 0xff, 0x1b, 0x6f, 0xad, 0xb9, 0x84,
-	0xb8, 0x1d, 0xac, 0xdb, 0xa1, 0xa7, 0xdb, 0x41, 0x40, 0x98, 0xcd, 0x3c, 0x12, 0xc4, 0x8c, 0xea,
-	0x66, 0xae, 0x86, 0xa4, 0xa3, 0x00, 0x96, 0x5a, 0x1c, 0x69, 0x89, 0x96, 0x62, 0x11, 0x1f, 0xad,
-	0x8a, 0x95, 0xee, 0x53, 0x57, 0xef, 0xd6, 0x47, 0xff, 0xc4, 0x01, 0xfa, 0x0b, 0xc0, 0xd7, 0xf6,
-	0xa8, 0xfb, 0x08, 0xb3, 0x07, 0x84, 0x99, 0xe4, 0x98, 0x61, 0xaa, 0x7c, 0x0c, 0x5f, 0xb4, 0xdb,
-	0xbe, 0x17, 0x14, 0x41, 0x05, 0x54, 0xaf, 0x1b, 0xd5, 0xe1, 0xa0, 0xbc, 0xdc, 0xb7, 0xfd, 0x4e,
-	0x13, 0xf1, 0x6d, 0x74, 0xf2, 0xf3, 0xce, 0x4a, 0xcc, 0xbe, 0xdb, 0x6e, 0x47, 0x98, 0xd2, 0x47,
-	0x2c, 0xf2, 0x02, 0xd7, 0x14, 0x65, 0xca, 0x21, 0x84, 0x47, 0x84, 0x59, 0x11, 0x67, 0x2b, 0x2e,
-	0x56, 0xae, 0x55, 0x97, 0x1a, 0x77, 0x6a, 0x79, 0x31, 0xd5, 0x0e, 0xc8, 0x63, 0x1c, 0xec, 0xdb,
-	0x5e, 0xb4, 0x1b, 0x39, 0x42, 0x81, 0x51, 0x7a, 0x36, 0x28, 0x2f, 0x0c, 0x07, 0xe5, 0x82, 0x68,
-	0x3b, 0x61, 0x43, 0xe6, 0xf5, 0xa3, 0xb1, 0xce, 0xe6, 0xdb, 0x4f, 0xce, 0x9f, 0x6e, 0x8b, 0x9e,
-	0x5f, 0x9f, 0x3f, 0xdd, 0x5e, 0x1d, 0xe7, 0x34, 0xe5, 0x07, 0x95, 0xe0, 0xea, 0xd4, 0x96, 0x89,
-	0x69, 0x48, 0x02, 0x8a, 0xd1, 0x09, 0x80, 0xb7, 0xc4, 0xd9, 0x7d, 0xdc, 0xc5, 0x1d, 0x12, 0xe2,
-	0x68, 0xb7, 0xd5, 0x22, 0xc7, 0x01, 0x9b, 0x3b, 0x85, 0x87, 0xb0, 0xd0, 0x1e, 0x73, 0x5a, 0xb6,
-	0x20, 0x2d, 0x2e, 0x72, 0xae, 0xb5, 0xe1, 0xa0, 0x5c, 0x14, 0x5c, 0x29, 0x08, 0x32, 0x6f, 0xb4,
-	0xa7, 0xa4, 0x34, 0x77, 0x64, 0xa3, 0x9a, 0x6c, 0x74, 0x5a, 0x39, 0xaa, 0x40, 0x2d, 0xfb, 0x24,
-	0xb1, 0xfd, 0x0f, 0x80, 0x2b, 0x02, 0xf2, 0x30, 0x38, 0x24, 0x46, 0x7f, 0x9f, 0x90, 0xce, 0x41,
-	0x3f, 0xc4, 0x73, 0x9b, 0x3e, 0x86, 0x05, 0x2f, 0x38, 0x24, 0x96, 0xd3, 0xb7, 0x42, 0x42, 0x3a,
-	0x16, 0xeb, 0x87, 0x98, 0x9b, 0x5e, 0x6a, 0x54, 0xf3, 0xdf, 0x00, 0x59, 0x84, 0x51, 0x89, 0x9f,
-	0x7e, 0x1c, 0x51, 0x8a, 0x10, 0x99, 0xaf, 0x7a, 0x52, 0x45, 0xf3, 0x1d, 0x39, 0xa0, 0x35, 0x39,
-	0x20, 0x99, 0x1e, 0x69, 0x70, 0x2d, 0x6b, 0x3f, 0x09, 0xe7, 0x14, 0xc0, 0xa2, 0x00, 0xec, 0xd9,
-	0xbd, 0xd1, 0xe9, 0x3e, 0xf1, 0x02, 0x46, 0xf7, 0x71, 0x74, 0xd0, 0x9b, 0x3b, 0xa0, 0x4f, 0xe1,
-	0x2d, 0xdf, 0xee, 0x09, 0x2f, 0x21, 0xe7, 0xb5, 0x46, 0x0f, 0x9f, 0xf5, 0x78, 0x4a, 0x2f, 0x18,
-	0xeb, 0xc3, 0x41, 0xf9, 0x4d, 0x41, 0x98, 0x8d, 0x43, 0xa6, 0xe2, 0xa7, 0x64, 0x35, 0x75, 0x39,
-	0x80, 0x8a, 0x1c, 0x40, 0xda, 0x07, 0x42, 0xb0, 0x92, 0x77, 0x96, 0x04, 0x71, 0x0e, 0xe0, 0x1b,
-	0xd9, 0x20, 0xa3, 0x43, 0x5a, 0x8f, 0xe7, 0xce, 0xe2, 0x0b, 0x58, 0xca, 0xf2, 0xe8, 0x8c, 0xc8,
-	0xe3, 0x38, 0xde, 0x1a, 0x0e, 0xca, 0x95, 0xfc, 0x38, 0x38, 0x14, 0x99, 0x37, 0xfd, 0x2c, 0x7d,
-	0xcd, 0xaa, 0x1c, 0x4a, 0x49, 0x0e, 0x65, 0x54, 0xf0, 0x19, 0xf6, 0xdc, 0x23, 0x46, 0xd1, 0x6d,
-	0xb8, 0xf1, 0x1f, 0x46, 0x93, 0x40, 0xfe, 0x00, 0xf0, 0x86, 0xc0, 0x19, 0x36, 0xc5, 0xf7, 0x71,
-	0x40, 0xfc, 0xf9, 0xa7, 0xe5, 0x97, 0x70, 0xc9, 0xb1, 0x29, 0xb6, 0xda, 0x9c, 0x2e, 0x1e, 0x97,
-	0x1b, 0xf9, 0x1f, 0x4b, 0xd2, 0xda, 0x50, 0xe3, 0xef, 0x44, 0x11, 0xed, 0x2e, 0xb0, 0x20, 0x13,
-	0x3a, 0x89, 0xc2, 0xe6, 0xa6, 0x9c, 0x43, 0x51, 0xce, 0x61, 0x62, 0x05, 0xa9, 0xe3, 0x17, 0x7f,
-	0xb2, 0x97, 0x78, 0xa7, 0xb0, 0x90, 0xca, 0x4d, 0x59, 0x91, 0xbc, 0x8f, 0x1d, 0x3d, 0x80, 0xcb,
-	0xfc, 0x41, 0x7d, 0x25, 0x50, 0xf1, 0xf7, 0x7f, 0x3b, 0xdf, 0xd2, 0x05, 0x4a, 0x73, 0x29, 0x9c,
-	0x2c, 0x1a, 0x3f, 0xbc, 0x0c, 0xaf, 0xed, 0x51, 0x57, 0xf9, 0x0e, 0xc0, 0x65, 0xe9, 0x8a, 0xda,
-	0xca, 0x27, 0x9b, 0x1a, 0xf5, 0x6a, 0xfd, 0xca, 0xd0, 0xc4, 0x6b, 0xf5, 0xc9, 0x6f, 0x7f, 0x7f,
-	0xbb, 0x88, 0x50, 0x45, 0x4f, 0x5d, 0xbd, 0x14, 0x33, 0x6b, 0x72, 0x1d, 0x29, 0x3f, 0x01, 0xf8,
-	0x7a, 0xd6, 0xe5, 0xf1, 0xee, 0x65, 0x4d, 0xa7, 0x2b, 0xd4, 0x0f, 0x67, 0xad, 0x48, 0xd4, 0xea,
-	0x5c, 0xed, 0x16, 0xda, 0xcc, 0x56, 0x9b, 0xba, 0x61, 0x94, 0x5f, 0x00, 0xbc, 0x99, 0x3d, 0xdd,
-	0x1a, 0x97, 0x89, 0x48, 0xd7, 0xa8, 0xcd, 0xd9, 0x6b, 0x12, 0xe9, 0x77, 0xb9, 0xf4, 0x1a, 0xba,
-	0x93, 0x2d, 0x3d, 0x7b, 0x02, 0x2a, 0xbf, 0x02, 0x58, 0xcc, 0x1d, 0x4a, 0xef, 0xcf, 0x2a, 0x87,
-	0x97, 0xa9, 0x1f, 0xfd, 0xaf, 0xb2, 0xc4, 0xc8, 0x07, 0xdc, 0x48, 0x1d, 0xe9, 0x57, 0x37, 0xc2,
-	0x67, 0x97, 0xf2, 0x23, 0x80, 0x85, 0xf4, 0x35, 0x5c, 0xbb, 0x4c, 0x8d, 0x8c, 0x57, 0xef, 0xcd,
-	0x86, 0xbf, 0xea, 0xab, 0x93, 0xba, 0x79, 0x95, 0xef, 0x01, 0x7c, 0x45, 0x1e, 0x7f, 0xdb, 0x97,
-	0xb5, 0x9e, 0x60, 0xd5, 0xc6, 0xd5, 0xb1, 0x89, 0xc4, 0x2d, 0x2e, 0x71, 0x03, 0xad, 0x67, 0x4b,
-	0xbc, 0x30, 0xf4, 0x8c, 0x4f, 0x9e, 0x9d, 0x6a, 0xe0, 0xf9, 0xa9, 0x06, 0xfe, 0x3c, 0xd5, 0xc0,
-	0x37, 0x67, 0xda, 0xc2, 0xf3, 0x33, 0x6d, 0xe1, 0xf7, 0x33, 0x6d, 0xe1, 0xf3, 0xbb, 0xae, 0xc7,
-	0x8e, 0x8e, 0x9d, 0x5a, 0x8b, 0xf8, 0x63, 0x9a, 0x9d, 0x8e, 0xed, 0xd0, 0x84, 0xb3, 0xdb, 0xb8,
-	0xa7, 0xf7, 0x26, 0xcc, 0x23, 0xaf, 0xd4, 0x79, 0x89, 0xaf, 0xdf, 0xfb, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x5d, 0xe5, 0xc0, 0xbc, 0x05, 0x0c, 0x00, 0x00,
+	// 1030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xc1, 0x6f, 0xdb, 0x54,
+	0x1c, 0xee, 0xeb, 0x00, 0xa9, 0xaf, 0x05, 0x16, 0xaf, 0x5b, 0x13, 0x53, 0x9c, 0xf4, 0x95, 0xd1,
+	0xb4, 0x5b, 0x63, 0x12, 0xc6, 0x80, 0x48, 0x20, 0x35, 0x1a, 0x62, 0x93, 0xe8, 0x54, 0x79, 0x45,
+	0x48, 0x1c, 0x30, 0x76, 0xf2, 0xea, 0x5a, 0x8b, 0xfd, 0x2c, 0x3f, 0x27, 0x24, 0xd7, 0x1d, 0x39,
+	0x21, 0x21, 0x71, 0xe0, 0x6f, 0xe0, 0x30, 0x21, 0x24, 0x4e, 0xdc, 0xc7, 0x6d, 0x1a, 0x17, 0x38,
+	0x10, 0x41, 0x0b, 0xea, 0x3d, 0x47, 0x4e, 0xc8, 0xef, 0x39, 0x4e, 0x5f, 0x6c, 0x93, 0x86, 0x5c,
+	0xb6, 0xfa, 0xbd, 0xef, 0xf7, 0xfd, 0xbe, 0xef, 0xf3, 0xcb, 0xef, 0x19, 0x6e, 0x10, 0xea, 0x10,
+	0x6a, 0x53, 0xd5, 0xf3, 0x49, 0x40, 0x7c, 0xdc, 0x55, 0xbb, 0x55, 0x13, 0x07, 0x46, 0x55, 0x0d,
+	0x7a, 0x15, 0xb6, 0x26, 0xe5, 0x23, 0x48, 0x65, 0x04, 0xa9, 0x44, 0x10, 0x79, 0xd5, 0x22, 0x16,
+	0x61, 0xab, 0x6a, 0xf8, 0x17, 0x07, 0xc8, 0x39, 0xc3, 0xb1, 0x5d, 0xa2, 0xb2, 0x7f, 0xa3, 0xa5,
+	0x75, 0x8b, 0x10, 0xab, 0x8d, 0x55, 0xc3, 0xb3, 0x55, 0xc3, 0x75, 0x49, 0x60, 0x04, 0x36, 0x71,
+	0x23, 0x46, 0x79, 0x2b, 0x53, 0x43, 0xdc, 0x91, 0x03, 0x0b, 0x4d, 0x86, 0xd4, 0x79, 0x4b, 0xfe,
+	0x10, 0x6d, 0xad, 0xf1, 0x27, 0xd5, 0xa1, 0x96, 0xda, 0xad, 0x86, 0xff, 0xf1, 0x0d, 0xf4, 0x27,
+	0x80, 0x2f, 0xef, 0x53, 0xeb, 0x01, 0x0e, 0xee, 0x92, 0x40, 0x23, 0x9d, 0x00, 0x53, 0xe9, 0x7d,
+	0xf8, 0xbc, 0xd1, 0x72, 0x6c, 0x37, 0x0f, 0x4a, 0xa0, 0xbc, 0xd4, 0x28, 0x0f, 0x07, 0xc5, 0x95,
+	0xbe, 0xe1, 0xb4, 0xeb, 0x88, 0x2d, 0xa3, 0x67, 0x3f, 0xec, 0xae, 0x46, 0xec, 0x7b, 0xad, 0x96,
+	0x8f, 0x29, 0x7d, 0x10, 0xf8, 0xb6, 0x6b, 0x69, 0xbc, 0x4c, 0x3a, 0x82, 0xf0, 0x98, 0x04, 0xba,
+	0xcf, 0xd8, 0xf2, 0x8b, 0xa5, 0x4b, 0xe5, 0xe5, 0xda, 0xcd, 0x4a, 0x56, 0x4c, 0x95, 0x43, 0xf2,
+	0x10, 0xbb, 0x07, 0x86, 0xed, 0xef, 0xf9, 0x26, 0x57, 0xd0, 0x28, 0x3c, 0x19, 0x14, 0x17, 0x86,
+	0x83, 0x62, 0x8e, 0xb7, 0x1d, 0xb3, 0x21, 0x6d, 0xe9, 0x78, 0xa4, 0xb3, 0xfe, 0xfa, 0xa3, 0xb3,
+	0xc7, 0x3b, 0xbc, 0xe7, 0x97, 0x67, 0x8f, 0x77, 0xd6, 0x46, 0x39, 0x4d, 0xf8, 0x41, 0x05, 0xb8,
+	0x36, 0xb1, 0xa4, 0x61, 0xea, 0x11, 0x97, 0x62, 0xf4, 0x0c, 0xc0, 0x6b, 0x7c, 0xef, 0x0e, 0xee,
+	0xe2, 0x36, 0xf1, 0xb0, 0xbf, 0xd7, 0x6c, 0x92, 0x8e, 0x1b, 0xcc, 0x9d, 0xc2, 0x3d, 0x98, 0x6b,
+	0x8d, 0x38, 0x75, 0x83, 0x93, 0xe6, 0x17, 0x19, 0xd7, 0xfa, 0x70, 0x50, 0xcc, 0x73, 0xae, 0x04,
+	0x04, 0x69, 0x97, 0x5b, 0x13, 0x52, 0xea, 0xbb, 0xa2, 0x51, 0x45, 0x34, 0x3a, 0xa9, 0x1c, 0x95,
+	0xa0, 0x92, 0xbe, 0x13, 0xdb, 0xfe, 0x07, 0xc0, 0x55, 0x0e, 0xb9, 0xe7, 0x1e, 0x91, 0x46, 0xff,
+	0x80, 0x90, 0xf6, 0x61, 0xdf, 0xc3, 0x73, 0x9b, 0xee, 0xc0, 0x9c, 0xed, 0x1e, 0x11, 0xdd, 0xec,
+	0xeb, 0x1e, 0x21, 0x6d, 0x3d, 0xe8, 0x7b, 0x98, 0x99, 0x5e, 0xae, 0x95, 0xb3, 0x4f, 0x80, 0x28,
+	0xa2, 0x51, 0x8a, 0xde, 0x7e, 0x14, 0x51, 0x82, 0x10, 0x69, 0x2f, 0xd9, 0x42, 0x45, 0xfd, 0x86,
+	0x18, 0xd0, 0xba, 0x18, 0x90, 0x48, 0x8f, 0x14, 0xb8, 0x9e, 0xb6, 0x1e, 0x87, 0x73, 0x02, 0x60,
+	0x9e, 0x03, 0xf6, 0x8d, 0x5e, 0xb8, 0x7b, 0x40, 0x6c, 0x37, 0xa0, 0x07, 0xd8, 0x3f, 0xec, 0xcd,
+	0x1d, 0xd0, 0xc7, 0xf0, 0x9a, 0x63, 0xf4, 0xb8, 0x17, 0x8f, 0xf1, 0xea, 0xe1, 0xcb, 0x0f, 0x7a,
+	0x2c, 0xa5, 0xe7, 0x1a, 0x1b, 0xc3, 0x41, 0xf1, 0x55, 0x4e, 0x98, 0x8e, 0x43, 0x9a, 0xe4, 0x24,
+	0x64, 0xd5, 0x55, 0x31, 0x80, 0x92, 0x18, 0x40, 0xd2, 0x07, 0x42, 0xb0, 0x94, 0xb5, 0x17, 0x07,
+	0x71, 0x06, 0xe0, 0x2b, 0xe9, 0xa0, 0x46, 0x9b, 0x34, 0x1f, 0xce, 0x9d, 0xc5, 0x67, 0xb0, 0x90,
+	0xe6, 0xd1, 0x0c, 0xc9, 0xa3, 0x38, 0x5e, 0x1b, 0x0e, 0x8a, 0xa5, 0xec, 0x38, 0x18, 0x14, 0x69,
+	0x57, 0x9d, 0x34, 0x7d, 0xf5, 0xb2, 0x18, 0x4a, 0x41, 0x0c, 0x25, 0x2c, 0xf8, 0x04, 0xdb, 0xd6,
+	0x71, 0x40, 0xd1, 0x75, 0xb8, 0xf9, 0x1f, 0x46, 0xe3, 0x40, 0x7e, 0x07, 0xf0, 0x32, 0xc7, 0x35,
+	0x0c, 0x8a, 0xef, 0x60, 0x97, 0x38, 0xf3, 0x4f, 0xcb, 0xcf, 0xe1, 0xb2, 0x69, 0x50, 0xac, 0xb7,
+	0x18, 0x5d, 0x34, 0x2e, 0x37, 0xb3, 0x7f, 0x2c, 0x71, 0xeb, 0x86, 0x1c, 0xfd, 0x4e, 0x24, 0xde,
+	0xee, 0x1c, 0x0b, 0xd2, 0xa0, 0x19, 0x2b, 0xac, 0x6f, 0x89, 0x39, 0xe4, 0xc5, 0x1c, 0xc6, 0x56,
+	0x90, 0x0c, 0xf3, 0x93, 0x6b, 0xb1, 0xf7, 0xdf, 0xc0, 0x68, 0x8a, 0x7e, 0xd0, 0xc3, 0xcd, 0x4e,
+	0x78, 0x41, 0x7d, 0x68, 0xd0, 0x8f, 0x6c, 0xc7, 0x9e, 0x7f, 0x54, 0xde, 0x87, 0x57, 0xf0, 0x88,
+	0x54, 0xb7, 0x0c, 0xaa, 0xb7, 0x43, 0xda, 0xe8, 0x08, 0x28, 0xc3, 0x41, 0x51, 0xe6, 0x6c, 0x29,
+	0x20, 0xa4, 0xe5, 0xf0, 0xa4, 0x9e, 0x7a, 0x45, 0x34, 0x5c, 0x14, 0x0d, 0x27, 0xf4, 0xa3, 0x0d,
+	0x58, 0xcc, 0xd8, 0x8a, 0xed, 0x53, 0x98, 0x4b, 0x1c, 0x1b, 0x69, 0x55, 0xf0, 0x3d, 0x72, 0x73,
+	0x17, 0xae, 0xb0, 0x73, 0xfa, 0x05, 0x47, 0x45, 0xe3, 0xef, 0x7a, 0xf6, 0x1b, 0x3d, 0x47, 0xa9,
+	0x2d, 0x7b, 0xe3, 0x87, 0xda, 0xdf, 0x4b, 0xf0, 0xd2, 0x3e, 0xb5, 0xa4, 0x6f, 0x00, 0x5c, 0x11,
+	0x6e, 0xe8, 0xed, 0x6c, 0xb2, 0x89, 0x9b, 0x4e, 0xae, 0x5e, 0x18, 0x1a, 0x7b, 0x2d, 0x3f, 0xfa,
+	0xe5, 0xaf, 0xaf, 0x17, 0x11, 0x2a, 0xa9, 0x89, 0x2f, 0x0f, 0x8a, 0x03, 0x7d, 0x7c, 0x1b, 0x4b,
+	0xdf, 0x03, 0x78, 0x25, 0xed, 0xee, 0x7c, 0x63, 0x5a, 0xd3, 0xc9, 0x0a, 0xf9, 0x9d, 0x59, 0x2b,
+	0x62, 0xb5, 0x2a, 0x53, 0xbb, 0x8d, 0xb6, 0xd2, 0xd5, 0x26, 0x2e, 0x58, 0xe9, 0x27, 0x00, 0xaf,
+	0xa6, 0x0f, 0xf7, 0xda, 0x34, 0x11, 0xc9, 0x1a, 0xb9, 0x3e, 0x7b, 0x4d, 0x2c, 0xfd, 0x16, 0x93,
+	0x5e, 0x41, 0x37, 0xd3, 0xa5, 0xa7, 0x5f, 0x00, 0xd2, 0xcf, 0x00, 0xe6, 0x33, 0x67, 0xf2, 0x5b,
+	0xb3, 0xca, 0x61, 0x65, 0xf2, 0x7b, 0xff, 0xab, 0x2c, 0x36, 0xf2, 0x36, 0x33, 0x52, 0x45, 0xea,
+	0xc5, 0x8d, 0xb0, 0xd1, 0x2d, 0x7d, 0x07, 0x60, 0x2e, 0xf9, 0x15, 0x52, 0x99, 0xa6, 0x46, 0xc4,
+	0xcb, 0xb7, 0x67, 0xc3, 0x5f, 0xf4, 0xe8, 0x24, 0x3e, 0x3c, 0xa4, 0x6f, 0x01, 0x7c, 0x51, 0x9c,
+	0xfe, 0x3b, 0xd3, 0x5a, 0x8f, 0xb1, 0x72, 0xed, 0xe2, 0xd8, 0x58, 0xe2, 0x36, 0x93, 0xb8, 0x89,
+	0x36, 0xd2, 0x25, 0x9e, 0x9b, 0xf9, 0xd2, 0x8f, 0x00, 0xae, 0xa6, 0x8e, 0xe7, 0xa9, 0x23, 0x20,
+	0x51, 0x22, 0xbf, 0x3b, 0x73, 0x49, 0xac, 0xb8, 0xca, 0x14, 0xdf, 0x40, 0xdb, 0xe9, 0x8a, 0x53,
+	0x66, 0x78, 0xe3, 0xfe, 0x93, 0x13, 0x05, 0x3c, 0x3d, 0x51, 0xc0, 0x1f, 0x27, 0x0a, 0xf8, 0xea,
+	0x54, 0x59, 0x78, 0x7a, 0xaa, 0x2c, 0xfc, 0x7a, 0xaa, 0x2c, 0x7c, 0x7a, 0xcb, 0xb2, 0x83, 0xe3,
+	0x8e, 0x59, 0x69, 0x12, 0x67, 0x44, 0xb7, 0xdb, 0x36, 0x4c, 0x1a, 0x73, 0x77, 0x6b, 0xb7, 0xd5,
+	0xde, 0xb8, 0x43, 0xf8, 0x96, 0xa8, 0xf9, 0x02, 0x7b, 0x7e, 0xf3, 0xdf, 0x01, 0x00, 0x6d, 0xcd,
+	0xa8, 0xb5, 0xbe, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -759,6 +861,9 @@ type MsgClient interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opts ...grpc.CallOption) (*MsgSetBaseDenomsResponse, error)
+	// SetExecutionGasLimit sets the gas limit of the execution of the backrun of
+	// a transaction. Can only be called by the admin account.
+	SetExecutionGasLimit(ctx context.Context, in *MsgSetExecutionGasLimit, opts ...grpc.CallOption) (*MsgSetExecutionGasLimitResponse, error)
 }
 
 type msgClient struct {
@@ -823,6 +928,15 @@ func (c *msgClient) SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opt
 	return out, nil
 }
 
+func (c *msgClient) SetExecutionGasLimit(ctx context.Context, in *MsgSetExecutionGasLimit, opts ...grpc.CallOption) (*MsgSetExecutionGasLimitResponse, error) {
+	out := new(MsgSetExecutionGasLimitResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Msg/SetExecutionGasLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetHotRoutes sets the hot routes that will be explored when creating
@@ -843,6 +957,9 @@ type MsgServer interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(context.Context, *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error)
+	// SetExecutionGasLimit sets the gas limit of the execution of the backrun of
+	// a transaction. Can only be called by the admin account.
+	SetExecutionGasLimit(context.Context, *MsgSetExecutionGasLimit) (*MsgSetExecutionGasLimitResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -867,6 +984,9 @@ func (*UnimplementedMsgServer) SetInfoByPoolType(ctx context.Context, req *MsgSe
 func (*UnimplementedMsgServer) SetBaseDenoms(ctx context.Context, req *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBaseDenoms not implemented")
 }
+func (*UnimplementedMsgServer) SetExecutionGasLimit(ctx context.Context, req *MsgSetExecutionGasLimit) (*MsgSetExecutionGasLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExecutionGasLimit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -980,6 +1100,25 @@ func _Msg_SetBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetExecutionGasLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExecutionGasLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetExecutionGasLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Msg/SetExecutionGasLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetExecutionGasLimit(ctx, req.(*MsgSetExecutionGasLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1008,6 +1147,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBaseDenoms",
 			Handler:    _Msg_SetBaseDenoms_Handler,
 		},
+		{
+			MethodName: "SetExecutionGasLimit",
+			Handler:    _Msg_SetExecutionGasLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/tx.proto",
@@ -1386,6 +1529,64 @@ func (m *MsgSetBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetExecutionGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExecutionGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExecutionGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionGasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetExecutionGasLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExecutionGasLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExecutionGasLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSetPoolWeights) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1595,6 +1796,31 @@ func (m *MsgSetBaseDenomsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetExecutionGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutionGasLimit != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionGasLimit))
+	}
+	return n
+}
+
+func (m *MsgSetExecutionGasLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSetPoolWeights) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2581,6 +2807,157 @@ func (m *MsgSetBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetExecutionGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetExecutionGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetExecutionGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasLimit", wireType)
+			}
+			m.ExecutionGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetExecutionGasLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetExecutionGasLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetExecutionGasLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSetPoolWeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -152,6 +152,16 @@ func ValidateDeveloperFees(fees []sdk.Coin) error {
 	return nil
 }
 
+// Validate validates the profit distributions, each of which must be valid coins.
+func (d ProfitDistributions) Validate() error {
+	for _, coins := range []sdk.Coins{d.Developer, d.Burned, d.CommunityPool, d.Stakers} {
+		if err := coins.Validate(); err != nil {
+			return fmt.Errorf("invalid profit distributions: %w", err)
+		}
+	}
+	return nil
+}
+
 // ---------------------- Pool Point Validation ---------------------- //
 // ValidateMaxPoolPointsPerBlock validates the max pool points per block.
 func ValidateMaxPoolPointsPerBlock(points uint64) error {
@@ -170,3 +180,12 @@ func ValidateMaxPoolPointsPerTx(points uint64) error {
 
 	return nil
 }
+
+// ValidateExecutionGasLimit validates the gas limit of the execution of the backrun of a tx.
+func ValidateExecutionGasLimit(gasLimit uint64) error {
+	if gasLimit < MinExecutionGasLimit || gasLimit > MaxExecutionGasLimit {
+		return fmt.Errorf("execution gas limit must be between %d and %d", MinExecutionGasLimit, MaxExecutionGasLimit)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package superfluid
 
 import (
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/keeper"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
 
@@ -15,5 +16,13 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper) {
 	}
 	if numBlocksSinceEpochStart == 0 {
 		k.AfterEpochStartBeginBlock(ctx)
+
+		// Snapshot the superfluid delegations once they are refreshed for the new epoch.
+		// A failing snapshot is skipped rather than halting the chain.
+		curEpoch := ek.GetEpochInfo(ctx, k.GetEpochIdentifier(ctx)).CurrentEpoch
+		//nolint:errcheck
+		osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.SnapshotSuperfluidDelegations(cacheCtx, curEpoch)
+		})
 	}
 }
@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdAllSuperfluidAssets(),
 		GetCmdAssetMultiplier(),
+		GetCmdAssetUtilization(),
 		GetCmdAllIntermediaryAccounts(),
 		GetCmdConnectedIntermediaryAccount(),
 		GetCmdSuperfluidDelegationAmount(),
@@ -28,6 +29,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalSuperfluidDelegations(),
 		GetCmdTotalDelegationByDelegator(),
 		GetCmdUnpoolWhitelist(),
+		GetCmdSuperfluidValidatorSetSplit(),
+		GetCmdDelegationSnapshotsByDelegator(),
+		GetCmdDelegationSnapshotsByEpoch(),
+		GetCmdTotalSuperfluidDelegationsByValidator(),
+		GetCmdUserSuperfluidPositionsValue(),
 	)
 
 	return cmd
@@ -85,6 +91,18 @@ func GetCmdAssetMultiplier() *cobra.Command {
 	)
 }
 
+// GetCmdAssetUtilization implements a command to fetch the superfluid utilization of an LP share asset.
+func GetCmdAssetUtilization() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.AssetUtilizationRequest](
+		"asset-utilization",
+		"Query the fraction of an LP share asset's supply that is superfluid bonded",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} asset-utilization gamm/pool/1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
 func GetCmdAllIntermediaryAccounts() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.AllIntermediaryAccountsRequest](
 		"all-intermediary-accounts",
@@ -157,3 +175,49 @@ func GetCmdUnpoolWhitelist() *cobra.Command {
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+// GetCmdSuperfluidValidatorSetSplit returns the split of a delegator's superfluid delegations of a denom over validators,
+// compared to the delegator's validator set preference.
+func GetCmdSuperfluidValidatorSetSplit() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.SuperfluidValidatorSetSplitRequest](
+		"superfluid-valset-split",
+		"Query the split of a delegator's superfluid delegations of a denom over its validator set preference", "",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdDelegationSnapshotsByDelegator returns the retained snapshots of a delegator's superfluid delegations.
+func GetCmdDelegationSnapshotsByDelegator() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.DelegationSnapshotsByDelegatorRequest](
+		"delegation-snapshots-by-delegator",
+		"Query the retained epoch snapshots of a delegator's superfluid delegations", "",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdDelegationSnapshotsByEpoch returns the snapshots of all superfluid delegations taken at the start of an epoch.
+func GetCmdDelegationSnapshotsByEpoch() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.DelegationSnapshotsByEpochRequest](
+		"delegation-snapshots-by-epoch",
+		"Query the snapshot of all superfluid delegations taken at the start of an epoch", "",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdTotalSuperfluidDelegationsByValidator returns the OSMO-equivalent amount superfluid staked to every validator.
+func GetCmdTotalSuperfluidDelegationsByValidator() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.TotalSuperfluidDelegationsByValidatorRequest](
+		"total-superfluid-delegations-by-validator",
+		"Query the OSMO-equivalent amount superfluid staked to every validator, by superfluid asset", "",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdUserSuperfluidPositionsValue returns the OSMO-equivalent amount of a delegator's bonded superfluid positions.
+func GetCmdUserSuperfluidPositionsValue() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.UserSuperfluidPositionsValueRequest](
+		"user-superfluid-positions-value",
+		"Query the OSMO-equivalent amount of a delegator's bonded superfluid positions, by superfluid asset", "",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
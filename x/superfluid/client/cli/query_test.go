@@ -141,6 +141,18 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.TotalSuperfluidDelegationsRequest{},
 			&types.TotalSuperfluidDelegationsResponse{},
 		},
+		{
+			"Query total sfs delegations by validator",
+			"/osmosis.superfluid.Query/TotalSuperfluidDelegationsByValidator",
+			&types.TotalSuperfluidDelegationsByValidatorRequest{},
+			&types.TotalSuperfluidDelegationsByValidatorResponse{},
+		},
+		{
+			"Query user sfs positions value",
+			"/osmosis.superfluid.Query/UserSuperfluidPositionsValue",
+			&types.UserSuperfluidPositionsValueRequest{DelegatorAddress: s.TestAccs[0].String()},
+			&types.UserSuperfluidPositionsValueResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -38,6 +38,9 @@ func GetTxCmd() *cobra.Command {
 		NewCmdLockAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
 		NewUnbondConvertAndStake(),
+		NewSuperfluidDelegateToValidatorSetCmd(),
+		NewSuperfluidRebalanceValidatorSetCmd(),
+		NewSuperfluidUndelegateValidatorSetCmd(),
 	)
 	osmocli.AddTxCmd(cmd, NewCreateFullRangePositionAndSuperfluidDelegateCmd)
 	osmocli.AddTxCmd(cmd, NewAddToConcentratedLiquiditySuperfluidPositionCmd)
@@ -109,6 +112,30 @@ func NewSuperfluidUndelegateAndUnbondLockCmd() *cobra.Command {
 	})
 }
 
+func NewSuperfluidDelegateToValidatorSetCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSuperfluidDelegateToValidatorSet](&osmocli.TxCliDesc{
+		Use:     "delegate-to-valset",
+		Short:   "superfluid delegate a lock split over the sender's validator set preference",
+		Example: "delegate-to-valset 5",
+	})
+}
+
+func NewSuperfluidRebalanceValidatorSetCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSuperfluidRebalanceValidatorSet](&osmocli.TxCliDesc{
+		Use:     "rebalance-valset",
+		Short:   "superfluid undelegate the locks of a denom delegated beyond the sender's validator set preference",
+		Example: "rebalance-valset gamm/pool/1",
+	})
+}
+
+func NewSuperfluidUndelegateValidatorSetCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSuperfluidUndelegateValidatorSet](&osmocli.TxCliDesc{
+		Use:     "undelegate-valset",
+		Short:   "superfluid undelegate all the sender's bonded locks of a denom, across the validators they are delegated to",
+		Example: "undelegate-valset gamm/pool/1",
+	})
+}
+
 // NewCmdSubmitSetSuperfluidAssetsProposal implements a command handler for submitting a superfluid asset set proposal transaction.
 func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+// SnapshotSuperfluidDelegations snapshots the OSMO equivalent of the superfluid delegations of every delegator
+// to every validator at the start of the given epoch, and prunes the snapshots that fall out of the retention window.
+// No snapshots are taken, and all existing ones are pruned, if snapshots are disabled.
+func (k Keeper) SnapshotSuperfluidDelegations(ctx sdk.Context, epochNumber int64) error {
+	retentionEpochs := k.GetParams(ctx).DelegationSnapshotRetentionEpochs
+	if retentionEpochs <= uint64(epochNumber) {
+		k.deleteDelegationSnapshotsBefore(ctx, epochNumber-int64(retentionEpochs)+1)
+	}
+	if retentionEpochs == 0 {
+		return nil
+	}
+
+	// The equivalent staked amounts of all the superfluid delegations of a delegator to a validator are summed up,
+	// whatever the denom of the delegations.
+	type delegationKey struct {
+		delegator string
+		validator string
+	}
+	amounts := map[delegationKey]osmomath.Int{}
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		syntheticDenom := stakingSyntheticDenom(acc.Denom, acc.ValAddr)
+		for _, lock := range k.lk.GetLocksLongerThanDurationDenom(ctx, syntheticDenom, time.Second) {
+			equivalentAmount, err := k.GetSuperfluidOSMOTokens(ctx, acc.Denom, lock.Coins.AmountOf(acc.Denom))
+			if err != nil {
+				return err
+			}
+
+			key := delegationKey{delegator: lock.Owner, validator: acc.ValAddr}
+			if amount, ok := amounts[key]; ok {
+				equivalentAmount = equivalentAmount.Add(amount)
+			}
+			amounts[key] = equivalentAmount
+		}
+	}
+
+	keys := make([]delegationKey, 0, len(amounts))
+	for key := range amounts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].delegator != keys[j].delegator {
+			return keys[i].delegator < keys[j].delegator
+		}
+		return keys[i].validator < keys[j].validator
+	})
+
+	for _, key := range keys {
+		err := k.SetDelegationSnapshot(ctx, types.DelegationSnapshot{
+			EpochNumber:            epochNumber,
+			DelegatorAddress:       key.delegator,
+			ValidatorAddress:       key.validator,
+			EquivalentStakedAmount: sdk.NewCoin(appparams.BaseCoinUnit, amounts[key]),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) SetDelegationSnapshot(ctx sdk.Context, snapshot types.DelegationSnapshot) error {
+	delegator, err := sdk.AccAddressFromBech32(snapshot.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	validator, err := sdk.ValAddressFromBech32(snapshot.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixDelegationSnapshot)
+
+	bz, err := proto.Marshal(&snapshot)
+	if err != nil {
+		return err
+	}
+	prefixStore.Set(types.GetDelegationSnapshotKey(snapshot.EpochNumber, delegator, validator), bz)
+	return nil
+}
+
+// GetDelegationSnapshotsByEpoch returns the snapshots of all superfluid delegations taken at the start of an epoch.
+func (k Keeper) GetDelegationSnapshotsByEpoch(ctx sdk.Context, epochNumber int64) []types.DelegationSnapshot {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixDelegationSnapshot)
+	return getDelegationSnapshots(prefixStore, types.GetDelegationSnapshotEpochPrefix(epochNumber))
+}
+
+// GetDelegationSnapshotsByDelegator returns the retained snapshots of a delegator's superfluid delegations,
+// ordered by epoch.
+func (k Keeper) GetDelegationSnapshotsByDelegator(ctx sdk.Context, delegator sdk.AccAddress) []types.DelegationSnapshot {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixDelegationSnapshot)
+
+	// Snapshots are keyed by epoch first, so we seek from each retained epoch to the next one,
+	// only reading the delegator's snapshots of each epoch.
+	snapshots := []types.DelegationSnapshot{}
+	var start []byte
+	for {
+		iterator := prefixStore.Iterator(start, nil)
+		if !iterator.Valid() {
+			iterator.Close()
+			return snapshots
+		}
+		epochNumber := int64(sdk.BigEndianToUint64(iterator.Key()[:8]))
+		iterator.Close()
+
+		snapshots = append(snapshots, getDelegationSnapshots(prefixStore, types.GetDelegationSnapshotDelegatorPrefix(epochNumber, delegator))...)
+		start = types.GetDelegationSnapshotEpochPrefix(epochNumber + 1)
+	}
+}
+
+func (k Keeper) GetAllDelegationSnapshots(ctx sdk.Context) []types.DelegationSnapshot {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixDelegationSnapshot)
+	return getDelegationSnapshots(prefixStore, nil)
+}
+
+// deleteDelegationSnapshotsBefore deletes the snapshots of superfluid delegations taken before the given epoch.
+func (k Keeper) deleteDelegationSnapshotsBefore(ctx sdk.Context, epochNumber int64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixDelegationSnapshot)
+
+	iterator := prefixStore.Iterator(nil, types.GetDelegationSnapshotEpochPrefix(epochNumber))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+func getDelegationSnapshots(store storetypes.KVStore, keyPrefix []byte) []types.DelegationSnapshot {
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	snapshots := []types.DelegationSnapshot{}
+	for ; iterator.Valid(); iterator.Next() {
+		snapshot := types.DelegationSnapshot{}
+		if err := proto.Unmarshal(iterator.Value(), &snapshot); err != nil {
+			panic(err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
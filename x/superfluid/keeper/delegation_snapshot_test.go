@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+// TestSnapshotSuperfluidDelegations tests that the superfluid delegations of every delegator to every validator
+// are snapshotted, summed over denoms, and that snapshots are pruned once they fall out of the retention window.
+func (s *KeeperTestSuite) TestSnapshotSuperfluidDelegations() {
+	s.SetupTest()
+	params := s.App.SuperfluidKeeper.GetParams(s.Ctx)
+	params.DelegationSnapshotRetentionEpochs = 2
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(10)})
+	delAddrs := CreateRandomAccounts(2)
+
+	// The first delegator delegates both denoms to the same validator, the second one delegates to another validator.
+	s.setupSuperfluidDelegate(delAddrs[0], valAddrs[0], denoms[0], 1000000)
+	s.setupSuperfluidDelegate(delAddrs[0], valAddrs[0], denoms[1], 1000000)
+	s.setupSuperfluidDelegate(delAddrs[1], valAddrs[1], denoms[0], 1000000)
+
+	equivalentAmount := func(denom string, amount int64) osmomath.Int {
+		osmoTokens, err := s.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(s.Ctx, denom, osmomath.NewInt(amount))
+		s.Require().NoError(err)
+		return osmoTokens
+	}
+	expectedSnapshots := func(epochNumber int64) []types.DelegationSnapshot {
+		return []types.DelegationSnapshot{
+			{
+				EpochNumber:            epochNumber,
+				DelegatorAddress:       delAddrs[0].String(),
+				ValidatorAddress:       valAddrs[0].String(),
+				EquivalentStakedAmount: sdk.NewCoin(appparams.BaseCoinUnit, equivalentAmount(denoms[0], 1000000).Add(equivalentAmount(denoms[1], 1000000))),
+			},
+			{
+				EpochNumber:            epochNumber,
+				DelegatorAddress:       delAddrs[1].String(),
+				ValidatorAddress:       valAddrs[1].String(),
+				EquivalentStakedAmount: sdk.NewCoin(appparams.BaseCoinUnit, equivalentAmount(denoms[0], 1000000)),
+			},
+		}
+	}
+	snapshotsByDelegator := func(delAddr sdk.AccAddress) []types.DelegationSnapshot {
+		snapshots := []types.DelegationSnapshot{}
+		for _, snapshot := range s.App.SuperfluidKeeper.GetAllDelegationSnapshots(s.Ctx) {
+			if snapshot.DelegatorAddress == delAddr.String() {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+		return snapshots
+	}
+
+	err := s.App.SuperfluidKeeper.SnapshotSuperfluidDelegations(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(expectedSnapshots(1), s.App.SuperfluidKeeper.GetDelegationSnapshotsByEpoch(s.Ctx, 1))
+	s.Require().Equal(snapshotsByDelegator(delAddrs[0]), s.App.SuperfluidKeeper.GetDelegationSnapshotsByDelegator(s.Ctx, delAddrs[0]))
+	s.Require().Len(s.App.SuperfluidKeeper.GetDelegationSnapshotsByDelegator(s.Ctx, delAddrs[0]), 1)
+
+	// Only the snapshots of the last two epochs are retained.
+	for _, epochNumber := range []int64{2, 3} {
+		err = s.App.SuperfluidKeeper.SnapshotSuperfluidDelegations(s.Ctx, epochNumber)
+		s.Require().NoError(err)
+	}
+	s.Require().Empty(s.App.SuperfluidKeeper.GetDelegationSnapshotsByEpoch(s.Ctx, 1))
+	s.Require().ElementsMatch(append(expectedSnapshots(2), expectedSnapshots(3)...), s.App.SuperfluidKeeper.GetAllDelegationSnapshots(s.Ctx))
+
+	delegatorSnapshots := s.App.SuperfluidKeeper.GetDelegationSnapshotsByDelegator(s.Ctx, delAddrs[1])
+	s.Require().Equal(snapshotsByDelegator(delAddrs[1]), delegatorSnapshots)
+	s.Require().Len(delegatorSnapshots, 2)
+	s.Require().Equal(int64(2), delegatorSnapshots[0].EpochNumber)
+	s.Require().Equal(int64(3), delegatorSnapshots[1].EpochNumber)
+
+	// Snapshots of an epoch are paginated.
+	res, err := s.queryClient.DelegationSnapshotsByEpoch(s.Ctx, &types.DelegationSnapshotsByEpochRequest{
+		EpochNumber: 3,
+		Pagination:  &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Snapshots, 1)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// Disabling snapshots prunes all of them.
+	params.DelegationSnapshotRetentionEpochs = 0
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+	err = s.App.SuperfluidKeeper.SnapshotSuperfluidDelegations(s.Ctx, 4)
+	s.Require().NoError(err)
+	s.Require().Empty(s.App.SuperfluidKeeper.GetAllDelegationSnapshots(s.Ctx))
+}
@@ -24,6 +24,7 @@ func (s *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliers() {
 		expectedMultiplier    osmomath.Dec
 		removeStakingAsset    bool
 		poolDoesNotExist      bool
+		stableswapPool        bool
 		expectedError         error
 		expectedZeroMultipler bool
 	}{
@@ -44,6 +45,19 @@ func (s *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliers() {
 			removeStakingAsset: true,
 			expectedError:      errors.New("pool 1 has zero OSMO amount"),
 		},
+		{
+			name:               "update stableswap LP token Osmo equivalent successfully",
+			asset:              types.SuperfluidAsset{Denom: DefaultGammAsset, AssetType: types.SuperfluidAssetTypeLPShare},
+			stableswapPool:     true,
+			expectedMultiplier: osmomath.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:               "update stableswap LP token Osmo equivalent with pool unexpectedly removed Osmo",
+			asset:              types.SuperfluidAsset{Denom: DefaultGammAsset, AssetType: types.SuperfluidAssetTypeLPShare},
+			stableswapPool:     true,
+			removeStakingAsset: true,
+			expectedError:      errors.New("pool 1 has zero OSMO amount"),
+		},
 		{
 			name:               "update concentrated share Osmo equivalent successfully",
 			asset:              types.SuperfluidAsset{Denom: cltypes.GetConcentratedLockupDenomFromPoolId(1), AssetType: types.SuperfluidAssetTypeConcentratedShare},
@@ -86,7 +100,9 @@ func (s *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliers() {
 
 			// Create the respective pool if the test case requires it
 			if !tc.poolDoesNotExist {
-				if tc.asset.AssetType == types.SuperfluidAssetTypeLPShare {
+				if tc.asset.AssetType == types.SuperfluidAssetTypeLPShare && tc.stableswapPool {
+					s.PrepareStableswapPoolWithCoins(poolCoins...)
+				} else if tc.asset.AssetType == types.SuperfluidAssetTypeLPShare {
 					s.PrepareBalancerPoolWithCoins(poolCoins...)
 				} else if tc.asset.AssetType == types.SuperfluidAssetTypeConcentratedShare {
 					s.PrepareConcentratedPoolWithCoinsAndLockedFullRangePosition(stakeDenom, "foo")
@@ -37,6 +37,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	for _, receiptToken := range genState.LockIdReceiptTokens {
+		k.SetLockIdReceiptToken(ctx, receiptToken)
+	}
+
+	for _, snapshot := range genState.DelegationSnapshots {
+		if err := k.SetDelegationSnapshot(ctx, snapshot); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +57,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		LockIdReceiptTokens:           k.GetAllLockIdReceiptTokens(ctx),
+		DelegationSnapshots:           k.GetAllDelegationSnapshots(ctx),
 	}
 }
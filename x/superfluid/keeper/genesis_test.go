@@ -21,7 +21,8 @@ var now = time.Now().UTC()
 
 var testGenesis = types.GenesisState{
 	Params: types.Params{
-		MinimumRiskFactor: osmomath.NewDecWithPrec(5, 1), // 50%
+		MinimumRiskFactor:      osmomath.NewDecWithPrec(5, 1), // 50%
+		MaxPoolSuperfluidRatio: osmomath.OneDec(),
 	},
 	SuperfluidAssets: []types.SuperfluidAsset{
 		{
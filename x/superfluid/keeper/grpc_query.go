@@ -100,6 +100,29 @@ func (q Querier) AssetMultiplier(goCtx context.Context, req *types.AssetMultipli
 	}, nil
 }
 
+// AssetUtilization returns the superfluid utilization of an LP share asset.
+func (q Querier) AssetUtilization(goCtx context.Context, req *types.AssetUtilizationRequest) (*types.AssetUtilizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bonded, totalSupply, utilization, remainingHeadroom, err := q.Keeper.GetSuperfluidAssetUtilization(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.AssetUtilizationResponse{
+		SuperfluidBondedAmount: bonded,
+		TotalSupply:            totalSupply,
+		Utilization:            utilization,
+		RemainingHeadroom:      remainingHeadroom,
+	}, nil
+}
+
 // AllIntermediaryAccounts returns all superfluid intermediary accounts.
 func (q Querier) AllIntermediaryAccounts(goCtx context.Context, req *types.AllIntermediaryAccountsRequest) (*types.AllIntermediaryAccountsResponse, error) {
 	if req == nil {
@@ -722,6 +745,31 @@ func (q Querier) filterConcentratedPositionLocks(ctx sdk.Context, positions []mo
 	return clPoolUserPositionRecords, nil
 }
 
+// SuperfluidValidatorSetSplit returns the split of a delegator's bonded superfluid delegations of a denom over validators,
+// compared to the delegator's validator set preference.
+func (q Querier) SuperfluidValidatorSetSplit(goCtx context.Context, req *types.SuperfluidValidatorSetSplitRequest) (*types.SuperfluidValidatorSetSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records, total, err := q.Keeper.GetValidatorSetSplit(ctx, delAddr, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SuperfluidValidatorSetSplitResponse{Records: records, TotalDelegatedAmount: total}, nil
+}
+
 // TEMPORARY CODE
 func (q Querier) RestSupply(goCtx context.Context, req *types.QueryRestSupplyRequest) (*types.QueryRestSupplyResponse, error) {
 	if req == nil {
@@ -731,3 +779,82 @@ func (q Querier) RestSupply(goCtx context.Context, req *types.QueryRestSupplyReq
 	supply := q.bk.GetSupply(sdk.UnwrapSDKContext(goCtx), req.Denom)
 	return &types.QueryRestSupplyResponse{Amount: supply}, nil
 }
+
+// DelegationSnapshotsByDelegator returns the retained snapshots of a delegator's superfluid delegations.
+func (q Querier) DelegationSnapshotsByDelegator(goCtx context.Context, req *types.DelegationSnapshotsByDelegatorRequest) (*types.DelegationSnapshotsByDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	snapshots := q.Keeper.GetDelegationSnapshotsByDelegator(sdk.UnwrapSDKContext(goCtx), delAddr)
+	return &types.DelegationSnapshotsByDelegatorResponse{Snapshots: snapshots}, nil
+}
+
+// DelegationSnapshotsByEpoch returns the snapshots of all superfluid delegations taken at the start of an epoch.
+func (q Querier) DelegationSnapshotsByEpoch(goCtx context.Context, req *types.DelegationSnapshotsByEpochRequest) (*types.DelegationSnapshotsByEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.KeyPrefixDelegationSnapshot)
+	epochStore := prefix.NewStore(store, types.GetDelegationSnapshotEpochPrefix(req.EpochNumber))
+
+	snapshots := []types.DelegationSnapshot{}
+	pageRes, err := query.Paginate(epochStore, req.Pagination, func(key, value []byte) error {
+		snapshot := types.DelegationSnapshot{}
+		if err := proto.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.DelegationSnapshotsByEpochResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TotalSuperfluidDelegationsByValidator returns the OSMO-equivalent amount superfluid staked to every validator,
+// broken down by superfluid asset.
+func (q Querier) TotalSuperfluidDelegationsByValidator(goCtx context.Context, req *types.TotalSuperfluidDelegationsByValidatorRequest) (*types.TotalSuperfluidDelegationsByValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	validators, err := q.Keeper.GetTotalSuperfluidDelegationsByValidator(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TotalSuperfluidDelegationsByValidatorResponse{Validators: validators}, nil
+}
+
+// UserSuperfluidPositionsValue returns the OSMO-equivalent amount of a delegator's bonded superfluid positions,
+// broken down by superfluid asset.
+func (q Querier) UserSuperfluidPositionsValue(goCtx context.Context, req *types.UserSuperfluidPositionsValueRequest) (*types.UserSuperfluidPositionsValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	assets, total, err := q.Keeper.GetUserSuperfluidPositionsValue(sdk.UnwrapSDKContext(goCtx), delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UserSuperfluidPositionsValueResponse{Assets: assets, TotalOsmoEquivalent: total}, nil
+}
@@ -20,6 +20,32 @@ func (s *KeeperTestSuite) TestGRPCParams() {
 	s.Require().True(res.Params.MinimumRiskFactor.Equal(types.DefaultParams().MinimumRiskFactor))
 }
 
+func (s *KeeperTestSuite) TestGRPCAssetUtilization() {
+	s.SetupTest()
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+	s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 2000000}}, denoms)
+
+	totalSupply := s.App.BankKeeper.GetSupply(s.Ctx, denoms[0]).Amount
+	params := s.App.SuperfluidKeeper.GetParams(s.Ctx)
+	params.MaxPoolSuperfluidRatio = osmomath.MustNewDecFromStr("0.5")
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+
+	res, err := s.querier.AssetUtilization(s.Ctx, &types.AssetUtilizationRequest{Denom: denoms[0]})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(3000000), res.SuperfluidBondedAmount)
+	s.Require().Equal(totalSupply, res.TotalSupply)
+	s.Require().Equal(osmomath.NewDec(3000000).QuoInt(totalSupply), res.Utilization)
+	s.Require().Equal(totalSupply.QuoRaw(2).SubRaw(3000000), res.RemainingHeadroom)
+
+	// non superfluid assets have no utilization
+	_, err = s.querier.AssetUtilization(s.Ctx, &types.AssetUtilizationRequest{Denom: "nonexistent"})
+	s.Require().Error(err)
+
+	_, err = s.querier.AssetUtilization(s.Ctx, &types.AssetUtilizationRequest{})
+	s.Require().Error(err)
+}
+
 func (s *KeeperTestSuite) TestAllIntermediaryAccounts() {
 	s.SetupTest()
 	// set account 1
@@ -66,11 +66,8 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	mintParams := s.App.MintKeeper.GetParams(s.Ctx)
 	mintParams.EpochIdentifier = superfluidEpochIdentifer
-	mintParams.DistributionProportions = minttypes.DistributionProportions{
-		Staking:          osmomath.OneDec(),
-		PoolIncentives:   osmomath.ZeroDec(),
-		DeveloperRewards: osmomath.ZeroDec(),
-		CommunityPool:    osmomath.ZeroDec(),
+	mintParams.DistributionTargets = []minttypes.DistributionTarget{
+		{Name: minttypes.DistributionTargetStaking, Weight: osmomath.OneDec()},
 	}
 	s.App.MintKeeper.SetParams(s.Ctx, mintParams)
 	s.App.MintKeeper.SetMinter(s.Ctx, minttypes.NewMinter(osmomath.NewDec(1_000_000)))
@@ -283,6 +283,11 @@ func (k Keeper) validateMigration(ctx sdk.Context, sender sdk.AccAddress, lockId
 		return types.MigrationPoolIDs{}, &lockuptypes.PeriodLock{}, 0, err
 	}
 
+	// Permanent locks can only be unlocked once converted, so they can not be broken by a migration.
+	if preMigrationLock.IsPermanent() {
+		return types.MigrationPoolIDs{}, &lockuptypes.PeriodLock{}, 0, types.PermanentLockMigrationError{LockId: lockId}
+	}
+
 	// Before we break the lock, we must note the time remaining on the lock.
 	remainingLockTime, err := k.getExistingLockRemainingDuration(ctx, preMigrationLock)
 	if err != nil {
@@ -693,6 +693,7 @@ func (s *KeeperTestSuite) TestValidateMigration() {
 		overwriteSender           bool
 		overwriteSharesDenomValue string
 		overwriteLockId           bool
+		permanentLock             bool
 		percentOfSharesToMigrate  osmomath.Dec
 		expectedError             error
 	}
@@ -748,6 +749,10 @@ func (s *KeeperTestSuite) TestValidateMigration() {
 			percentOfSharesToMigrate: osmomath.MustNewDecFromStr("1"),
 			expectedError:            lockuptypes.ErrLockupNotFound,
 		},
+		"error: permanent lock": {
+			permanentLock:            true,
+			percentOfSharesToMigrate: osmomath.MustNewDecFromStr("1"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -778,6 +783,12 @@ func (s *KeeperTestSuite) TestValidateMigration() {
 				coinsToMigrate.Denom = tc.overwriteSharesDenomValue
 			}
 
+			if tc.permanentLock {
+				err := s.App.LockupKeeper.ExtendLockup(ctx, originalGammLockId, poolJoinAcc, lockuptypes.PermanentLockDuration)
+				s.Require().NoError(err)
+				tc.expectedError = types.PermanentLockMigrationError{LockId: originalGammLockId}
+			}
+
 			// System under test.
 			migratedPools, preMigrationLock, remainingLockTime, err := superfluidKeeper.ValidateMigration(ctx, poolJoinAcc, originalGammLockId, coinsToMigrate)
 			if tc.expectedError != nil {
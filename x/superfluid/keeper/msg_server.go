@@ -9,7 +9,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
-	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/keeper/internal/events"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
@@ -116,32 +115,22 @@ func (server msgServer) SuperfluidUndelegateAndUnbondLock(goCtx context.Context,
 // `SuperfluidDelegate` from the superfluid module msg server.
 func (server msgServer) LockAndSuperfluidDelegate(goCtx context.Context, msg *types.MsgLockAndSuperfluidDelegate) (*types.MsgLockAndSuperfluidDelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	stakingParams, err := server.keeper.sk.GetParams(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return &types.MsgLockAndSuperfluidDelegateResponse{}, err
 	}
 
-	lockupMsg := lockuptypes.MsgLockTokens{
-		Owner:    msg.Sender,
-		Duration: stakingParams.UnbondingTime,
-		Coins:    msg.Coins,
-	}
-
-	lockupRes, err := server.keeper.lms.LockTokens(goCtx, &lockupMsg)
+	lockID, err := server.keeper.LockAndSuperfluidDelegate(ctx, sender, msg.Coins, msg.ValAddr)
 	if err != nil {
 		return &types.MsgLockAndSuperfluidDelegateResponse{}, err
 	}
 
-	superfluidDelegateMsg := types.MsgSuperfluidDelegate{
-		Sender:  msg.Sender,
-		LockId:  lockupRes.GetID(),
-		ValAddr: msg.ValAddr,
-	}
+	events.EmitSuperfluidDelegateEvent(ctx, lockID, msg.ValAddr, msg.Coins)
 
-	_, err = server.SuperfluidDelegate(goCtx, &superfluidDelegateMsg)
 	return &types.MsgLockAndSuperfluidDelegateResponse{
-		ID: lockupRes.ID,
-	}, err
+		ID: lockID,
+	}, nil
 }
 
 func (server msgServer) UnPoolWhitelistedPool(goCtx context.Context, msg *types.MsgUnPoolWhitelistedPool) (*types.MsgUnPoolWhitelistedPoolResponse, error) {
@@ -179,37 +168,27 @@ func (server msgServer) UnPoolWhitelistedPool(goCtx context.Context, msg *types.
 
 func (server msgServer) CreateFullRangePositionAndSuperfluidDelegate(goCtx context.Context, msg *types.MsgCreateFullRangePositionAndSuperfluidDelegate) (*types.MsgCreateFullRangePositionAndSuperfluidDelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	stakingParams, err := server.keeper.sk.GetParams(ctx)
-	if err != nil {
-		return &types.MsgCreateFullRangePositionAndSuperfluidDelegateResponse{}, err
-	}
 
 	address, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return &types.MsgCreateFullRangePositionAndSuperfluidDelegateResponse{}, err
 	}
-	positionData, lockId, err := server.keeper.clk.CreateFullRangePositionLocked(ctx, msg.PoolId, address, msg.Coins, stakingParams.UnbondingTime)
+
+	positionID, lockID, err := server.keeper.CreateFullRangePositionAndSuperfluidDelegate(ctx, address, msg.PoolId, msg.Coins, msg.ValAddr)
 	if err != nil {
 		return &types.MsgCreateFullRangePositionAndSuperfluidDelegateResponse{}, err
 	}
 
-	superfluidDelegateMsg := types.MsgSuperfluidDelegate{
-		Sender:  msg.Sender,
-		LockId:  lockId,
-		ValAddr: msg.ValAddr,
-	}
-
-	_, err = server.SuperfluidDelegate(goCtx, &superfluidDelegateMsg)
-
+	lock, err := server.keeper.lk.GetLockByID(ctx, lockID)
 	if err != nil {
 		return &types.MsgCreateFullRangePositionAndSuperfluidDelegateResponse{}, err
 	}
-
-	events.EmitCreateFullRangePositionAndSuperfluidDelegateEvent(ctx, lockId, positionData.ID, msg.ValAddr)
+	events.EmitSuperfluidDelegateEvent(ctx, lockID, msg.ValAddr, lock.Coins)
+	events.EmitCreateFullRangePositionAndSuperfluidDelegateEvent(ctx, lockID, positionID, msg.ValAddr)
 
 	return &types.MsgCreateFullRangePositionAndSuperfluidDelegateResponse{
-		LockID:     lockId,
-		PositionID: positionData.ID,
+		LockID:     lockID,
+		PositionID: positionID,
 	}, nil
 }
 
@@ -269,3 +248,66 @@ func (server msgServer) UnbondConvertAndStake(goCtx context.Context, msg *types.
 
 	return &types.MsgUnbondConvertAndStakeResponse{TotalAmtStaked: totalAmtConverted}, nil
 }
+
+// SuperfluidDelegateToValidatorSet splits the lock by the sender's validator set preference and superfluid delegates
+// each part to its validator.
+func (server msgServer) SuperfluidDelegateToValidatorSet(goCtx context.Context, msg *types.MsgSuperfluidDelegateToValidatorSet) (*types.MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockIDs, err := server.keeper.SuperfluidDelegateToValidatorSet(ctx, msg.Sender, msg.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lockID := range lockIDs {
+		lock, err := server.keeper.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		intermediaryAcc, _ := server.keeper.GetIntermediaryAccountFromLockId(ctx, lockID)
+		events.EmitSuperfluidDelegateEvent(ctx, lockID, intermediaryAcc.ValAddr, lock.Coins)
+	}
+
+	return &types.MsgSuperfluidDelegateToValidatorSetResponse{LockIds: lockIDs}, nil
+}
+
+// SuperfluidRebalanceValidatorSet superfluid undelegates the sender's locks of the denom that are delegated beyond
+// the sender's validator set preference.
+func (server msgServer) SuperfluidRebalanceValidatorSet(goCtx context.Context, msg *types.MsgSuperfluidRebalanceValidatorSet) (*types.MsgSuperfluidRebalanceValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockIDs, err := server.keeper.SuperfluidRebalanceValidatorSet(ctx, msg.Sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lockID := range lockIDs {
+		lock, err := server.keeper.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		events.EmitSuperfluidUndelegateEvent(ctx, lockID, lock.Coins)
+	}
+
+	return &types.MsgSuperfluidRebalanceValidatorSetResponse{UndelegatedLockIds: lockIDs}, nil
+}
+
+// SuperfluidUndelegateValidatorSet superfluid undelegates all the sender's bonded locks of the denom.
+func (server msgServer) SuperfluidUndelegateValidatorSet(goCtx context.Context, msg *types.MsgSuperfluidUndelegateValidatorSet) (*types.MsgSuperfluidUndelegateValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockIDs, err := server.keeper.SuperfluidUndelegateValidatorSet(ctx, msg.Sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lockID := range lockIDs {
+		lock, err := server.keeper.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		events.EmitSuperfluidUndelegateEvent(ctx, lockID, lock.Coins)
+	}
+
+	return &types.MsgSuperfluidUndelegateValidatorSetResponse{UndelegatedLockIds: lockIDs}, nil
+}
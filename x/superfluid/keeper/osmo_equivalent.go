@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+// GetTotalSuperfluidDelegationsByValidator returns the amount superfluid staked to every validator, valued in OSMO
+// with the osmo equivalent multiplier of each asset after the risk factor, as used for the intermediary account delegations.
+// Validators are ordered by address and their assets by denom. Intermediary accounts without locked tokens are skipped.
+func (k Keeper) GetTotalSuperfluidDelegationsByValidator(ctx sdk.Context) ([]types.ValidatorSuperfluidDelegations, error) {
+	assetsByValidator := map[string][]types.SuperfluidAssetOsmoEquivalent{}
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		amount, err := k.GetTotalSyntheticAssetsLocked(ctx, stakingSyntheticDenom(acc.Denom, acc.ValAddr))
+		if err != nil {
+			return nil, err
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		asset, err := k.getSuperfluidAssetOsmoEquivalent(ctx, acc.Denom, amount)
+		if err != nil {
+			return nil, err
+		}
+		assetsByValidator[acc.ValAddr] = append(assetsByValidator[acc.ValAddr], asset)
+	}
+
+	validators := make([]types.ValidatorSuperfluidDelegations, 0, len(assetsByValidator))
+	for valAddr, assets := range assetsByValidator {
+		validators = append(validators, newValidatorSuperfluidDelegations(valAddr, assets))
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].ValidatorAddress < validators[j].ValidatorAddress
+	})
+	return validators, nil
+}
+
+// GetUserSuperfluidPositionsValue returns the amount of every asset the delegator has bonded superfluid staked, valued in OSMO
+// with the osmo equivalent multiplier of the asset after the risk factor, along with the total OSMO-equivalent amount.
+// Assets are ordered by denom. Undelegating positions are not included.
+func (k Keeper) GetUserSuperfluidPositionsValue(ctx sdk.Context, delAddr sdk.AccAddress) ([]types.SuperfluidAssetOsmoEquivalent, osmomath.Int, error) {
+	lockedCoins := sdk.NewCoins()
+	for _, syntheticLock := range k.lk.GetAllSyntheticLockupsByAddr(ctx, delAddr) {
+		if strings.Contains(syntheticLock.SynthDenom, "superunbonding") {
+			continue
+		}
+
+		lock, err := k.lk.GetLockByID(ctx, syntheticLock.UnderlyingLockId)
+		if err != nil {
+			return nil, osmomath.Int{}, err
+		}
+		lockedCoins = lockedCoins.Add(lock.Coins...)
+	}
+
+	// sdk.Coins are sorted by denom.
+	assets := make([]types.SuperfluidAssetOsmoEquivalent, 0, len(lockedCoins))
+	total := osmomath.ZeroInt()
+	for _, coin := range lockedCoins {
+		asset, err := k.getSuperfluidAssetOsmoEquivalent(ctx, coin.Denom, coin.Amount)
+		if err != nil {
+			return nil, osmomath.Int{}, err
+		}
+		assets = append(assets, asset)
+		total = total.Add(asset.OsmoEquivalent)
+	}
+	return assets, total, nil
+}
+
+// getSuperfluidAssetOsmoEquivalent values the amount of the superfluid asset in OSMO, after the risk factor.
+func (k Keeper) getSuperfluidAssetOsmoEquivalent(ctx sdk.Context, denom string, amount osmomath.Int) (types.SuperfluidAssetOsmoEquivalent, error) {
+	osmoEquivalent, err := k.GetSuperfluidOSMOTokens(ctx, denom, amount)
+	if err != nil {
+		return types.SuperfluidAssetOsmoEquivalent{}, err
+	}
+	return types.SuperfluidAssetOsmoEquivalent{
+		Denom:          denom,
+		Amount:         amount,
+		OsmoEquivalent: osmoEquivalent,
+	}, nil
+}
+
+// newValidatorSuperfluidDelegations sorts the assets superfluid staked to the validator by denom and sums their OSMO-equivalent amount.
+func newValidatorSuperfluidDelegations(valAddr string, assets []types.SuperfluidAssetOsmoEquivalent) types.ValidatorSuperfluidDelegations {
+	sort.Slice(assets, func(i, j int) bool {
+		return assets[i].Denom < assets[j].Denom
+	})
+	total := osmomath.ZeroInt()
+	for _, asset := range assets {
+		total = total.Add(asset.OsmoEquivalent)
+	}
+	return types.ValidatorSuperfluidDelegations{
+		ValidatorAddress:    valAddr,
+		Assets:              assets,
+		TotalOsmoEquivalent: total,
+	}
+}
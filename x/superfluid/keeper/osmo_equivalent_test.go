@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+func (s *KeeperTestSuite) TestSuperfluidOsmoEquivalentQueries() {
+	s.SetupTest()
+
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(10)})
+
+	superfluidDelegations := []superfluidDelegation{
+		{0, 0, 0, 1000000},
+		{0, 1, 1, 1000000},
+		{1, 0, 1, 2000000},
+		{1, 0, 0, 500000},
+	}
+	delAddrs, _, locks := s.setupSuperfluidDelegations(valAddrs, superfluidDelegations, denoms)
+
+	minRiskFactor := s.App.SuperfluidKeeper.GetParams(s.Ctx).MinimumRiskFactor
+	osmoEquivalent := func(denomIndex int, amount int64) types.SuperfluidAssetOsmoEquivalent {
+		multiplier := s.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(s.Ctx, denoms[denomIndex])
+		value := multiplier.MulInt64(amount).RoundInt()
+		return types.SuperfluidAssetOsmoEquivalent{
+			Denom:          denoms[denomIndex],
+			Amount:         osmomath.NewInt(amount),
+			OsmoEquivalent: value.Sub(value.ToLegacyDec().Mul(minRiskFactor).RoundInt()),
+		}
+	}
+
+	// validator 0 holds both denoms, validator 1 only denom 1.
+	val0Assets := []types.SuperfluidAssetOsmoEquivalent{osmoEquivalent(0, 1500000), osmoEquivalent(1, 2000000)}
+	val1Assets := []types.SuperfluidAssetOsmoEquivalent{osmoEquivalent(1, 1000000)}
+
+	res, err := s.queryClient.TotalSuperfluidDelegationsByValidator(s.Ctx, &types.TotalSuperfluidDelegationsByValidatorRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Validators, 2)
+	s.Require().True(res.Validators[0].ValidatorAddress < res.Validators[1].ValidatorAddress)
+	for _, validator := range res.Validators {
+		expectedAssets := val0Assets
+		if validator.ValidatorAddress == valAddrs[1].String() {
+			expectedAssets = val1Assets
+		}
+		s.Require().Equal(expectedAssets, validator.Assets)
+
+		expectedTotal := osmomath.ZeroInt()
+		for _, asset := range expectedAssets {
+			expectedTotal = expectedTotal.Add(asset.OsmoEquivalent)
+		}
+		s.Require().Equal(expectedTotal, validator.TotalOsmoEquivalent)
+	}
+
+	userRes, err := s.queryClient.UserSuperfluidPositionsValue(s.Ctx, &types.UserSuperfluidPositionsValueRequest{DelegatorAddress: delAddrs[0].String()})
+	s.Require().NoError(err)
+	expectedUserAssets := []types.SuperfluidAssetOsmoEquivalent{osmoEquivalent(0, 1000000), osmoEquivalent(1, 1000000)}
+	s.Require().Equal(expectedUserAssets, userRes.Assets)
+	s.Require().Equal(expectedUserAssets[0].OsmoEquivalent.Add(expectedUserAssets[1].OsmoEquivalent), userRes.TotalOsmoEquivalent)
+
+	// undelegating positions no longer count towards the validator nor the delegator.
+	err = s.App.SuperfluidKeeper.SuperfluidUndelegate(s.Ctx, locks[1].Owner, locks[1].ID)
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.TotalSuperfluidDelegationsByValidator(s.Ctx, &types.TotalSuperfluidDelegationsByValidatorRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Validators, 1)
+	s.Require().Equal(valAddrs[0].String(), res.Validators[0].ValidatorAddress)
+
+	userRes, err = s.queryClient.UserSuperfluidPositionsValue(s.Ctx, &types.UserSuperfluidPositionsValueRequest{DelegatorAddress: delAddrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedUserAssets[:1], userRes.Assets)
+	s.Require().Equal(expectedUserAssets[0].OsmoEquivalent, userRes.TotalOsmoEquivalent)
+
+	_, err = s.queryClient.UserSuperfluidPositionsValue(s.Ctx, &types.UserSuperfluidPositionsValueRequest{DelegatorAddress: "invalid"})
+	s.Require().Error(err)
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+func (k Keeper) SetLockIdReceiptToken(ctx sdk.Context, receiptToken types.LockIdReceiptToken) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockIdReceiptToken)
+
+	bz, err := proto.Marshal(&receiptToken)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(sdk.Uint64ToBigEndian(receiptToken.LockId), bz)
+}
+
+// GetLockIdReceiptToken returns the receipt tokens issued for the given lock.
+// Returns false if no receipt tokens have been issued for the lock.
+func (k Keeper) GetLockIdReceiptToken(ctx sdk.Context, lockId uint64) (types.LockIdReceiptToken, bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockIdReceiptToken)
+
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(lockId))
+	if bz == nil {
+		return types.LockIdReceiptToken{}, false
+	}
+	receiptToken := types.LockIdReceiptToken{}
+	if err := proto.Unmarshal(bz, &receiptToken); err != nil {
+		panic(err)
+	}
+	return receiptToken, true
+}
+
+func (k Keeper) GetAllLockIdReceiptTokens(ctx sdk.Context) []types.LockIdReceiptToken {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockIdReceiptToken)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	receiptTokens := []types.LockIdReceiptToken{}
+	for ; iterator.Valid(); iterator.Next() {
+		receiptToken := types.LockIdReceiptToken{}
+		if err := proto.Unmarshal(iterator.Value(), &receiptToken); err != nil {
+			panic(err)
+		}
+		receiptTokens = append(receiptTokens, receiptToken)
+	}
+	return receiptTokens
+}
+
+func (k Keeper) DeleteLockIdReceiptToken(ctx sdk.Context, lockId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockIdReceiptToken)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockId))
+}
+
+// getOrCreateReceiptTokenDenom returns the receipt token denom for the given superfluid asset denom,
+// creating it in the tokenfactory module if it does not exist yet.
+// Returns false if the asset has no valid receipt token denom, e.g. because the resulting subdenom is too long.
+func (k Keeper) getOrCreateReceiptTokenDenom(ctx sdk.Context, denom string) (string, bool, error) {
+	receiptTokenDenom, err := types.GetReceiptTokenDenom(denom)
+	if err != nil {
+		return "", false, nil
+	}
+
+	authorityMetadata, err := k.tfk.GetAuthorityMetadata(ctx, receiptTokenDenom)
+	if err != nil {
+		return "", false, err
+	}
+	if authorityMetadata.Admin != "" {
+		return receiptTokenDenom, true, nil
+	}
+
+	moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
+	receiptTokenDenom, err = k.tfk.CreateModuleDenom(ctx, moduleAddr.String(), types.ReceiptTokenSubdenomPrefix+"/"+denom)
+	if err != nil {
+		return "", false, err
+	}
+	return receiptTokenDenom, true, nil
+}
+
+// mintReceiptTokens mints receipt tokens for the given superfluid staked coin of the lock, one for every
+// staked unit, and sends them to the lock owner. The minted tokens are recorded for the lock,
+// so that exactly those are burned once the lock is superfluid undelegated.
+// It is a no-op if receipt tokens are disabled, or if the staked asset has no valid receipt token denom.
+func (k Keeper) mintReceiptTokens(ctx sdk.Context, lock *lockuptypes.PeriodLock, stakedCoin sdk.Coin) error {
+	if !k.GetParams(ctx).ReceiptTokensEnabled || !stakedCoin.IsPositive() {
+		return nil
+	}
+
+	receiptTokenDenom, ok, err := k.getOrCreateReceiptTokenDenom(ctx, stakedCoin.Denom)
+	if err != nil || !ok {
+		return err
+	}
+	receiptToken := sdk.NewCoin(receiptTokenDenom, stakedCoin.Amount)
+
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(receiptToken)); err != nil {
+		return err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(receiptToken)); err != nil {
+		return err
+	}
+
+	if existing, found := k.GetLockIdReceiptToken(ctx, lock.ID); found {
+		receiptToken = receiptToken.Add(existing.ReceiptToken)
+	}
+	k.SetLockIdReceiptToken(ctx, types.LockIdReceiptToken{LockId: lock.ID, ReceiptToken: receiptToken})
+	return nil
+}
+
+// burnReceiptTokens burns the receipt tokens issued for the given lock from the lock owner.
+// It is a no-op if no receipt tokens have been issued for the lock.
+// Returns error if the lock owner no longer holds the receipt tokens, which is only possible
+// if receipt tokens have been made transferable.
+func (k Keeper) burnReceiptTokens(ctx sdk.Context, lock *lockuptypes.PeriodLock) error {
+	receiptToken, found := k.GetLockIdReceiptToken(ctx, lock.ID)
+	if !found {
+		return nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(receiptToken.ReceiptToken)); err != nil {
+		return errorsmod.Wrapf(err, "failed to return receipt tokens of lock id %d", lock.ID)
+	}
+	if err := k.bk.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(receiptToken.ReceiptToken)); err != nil {
+		return err
+	}
+
+	k.DeleteLockIdReceiptToken(ctx, lock.ID)
+	return nil
+}
+
+// ReceiptTokenSendRestriction is a bank send restriction that prevents superfluid receipt tokens
+// from being transferred between accounts, unless governance has made them transferable.
+// Sends from and to the superfluid module account, which issue and burn receipt tokens, are always allowed.
+func (k Keeper) ReceiptTokenSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		if !types.IsReceiptTokenDenom(coin.Denom) {
+			continue
+		}
+
+		moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
+		if fromAddr.Equals(moduleAddr) || toAddr.Equals(moduleAddr) {
+			return toAddr, nil
+		}
+		if k.GetParams(sdk.UnwrapSDKContext(ctx)).ReceiptTokensTransferable {
+			return toAddr, nil
+		}
+		return toAddr, errorsmod.Wrapf(types.ErrNonTransferableReceiptToken, "denom: %s", coin.Denom)
+	}
+	return toAddr, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+// TestSuperfluidReceiptTokens tests that receipt tokens are issued to the owner of a superfluid staked lock,
+// are only transferable if enabled by governance, and are burned once the lock is superfluid undelegated.
+func (s *KeeperTestSuite) TestSuperfluidReceiptTokens() {
+	s.SetupTest()
+	params := s.App.SuperfluidKeeper.GetParams(s.Ctx)
+	params.ReceiptTokensEnabled = true
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+	accs := CreateRandomAccounts(2)
+	delAddr, otherAddr := accs[0], accs[1]
+
+	receiptTokenDenom, err := types.GetReceiptTokenDenom(denoms[0])
+	s.Require().NoError(err)
+	s.Require().True(types.IsReceiptTokenDenom(receiptTokenDenom))
+
+	// Superfluid delegating issues receipt tokens for the locked amount.
+	lock := s.setupSuperfluidDelegate(delAddr, valAddrs[0], denoms[0], 1000000)
+	s.Require().Equal(sdk.NewInt64Coin(receiptTokenDenom, 1000000), s.App.BankKeeper.GetBalance(s.Ctx, delAddr, receiptTokenDenom))
+
+	// Adding to the superfluid staked lock issues receipt tokens for the added amount.
+	s.setupSuperfluidDelegate(delAddr, valAddrs[0], denoms[0], 1000000)
+	expectedReceiptToken := sdk.NewInt64Coin(receiptTokenDenom, 2000000)
+	s.Require().Equal(expectedReceiptToken, s.App.BankKeeper.GetBalance(s.Ctx, delAddr, receiptTokenDenom))
+	receiptToken, found := s.App.SuperfluidKeeper.GetLockIdReceiptToken(s.Ctx, lock.ID)
+	s.Require().True(found)
+	s.Require().Equal(types.LockIdReceiptToken{LockId: lock.ID, ReceiptToken: expectedReceiptToken}, receiptToken)
+
+	// Receipt tokens are not transferable by default.
+	oneReceiptToken := sdk.NewCoins(sdk.NewInt64Coin(receiptTokenDenom, 1))
+	err = s.App.BankKeeper.SendCoins(s.Ctx, delAddr, otherAddr, oneReceiptToken)
+	s.Require().ErrorIs(err, types.ErrNonTransferableReceiptToken)
+
+	params.ReceiptTokensTransferable = true
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+	err = s.App.BankKeeper.SendCoins(s.Ctx, delAddr, otherAddr, oneReceiptToken)
+	s.Require().NoError(err)
+
+	// Undelegating fails if the lock owner no longer holds all receipt tokens of the lock.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	err = s.App.SuperfluidKeeper.SuperfluidUndelegate(cacheCtx, lock.Owner, lock.ID)
+	s.Require().Error(err)
+
+	err = s.App.BankKeeper.SendCoins(s.Ctx, otherAddr, delAddr, oneReceiptToken)
+	s.Require().NoError(err)
+
+	// Undelegating burns the receipt tokens of the lock.
+	err = s.App.SuperfluidKeeper.SuperfluidUndelegate(s.Ctx, lock.Owner, lock.ID)
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, delAddr, receiptTokenDenom).IsZero())
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, receiptTokenDenom).IsZero())
+	_, found = s.App.SuperfluidKeeper.GetLockIdReceiptToken(s.Ctx, lock.ID)
+	s.Require().False(found)
+}
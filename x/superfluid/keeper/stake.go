@@ -136,7 +136,11 @@ func (k Keeper) IncreaseSuperfluidDelegation(ctx sdk.Context, lockID uint64, amo
 		return err
 	}
 
-	return nil
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	return k.mintReceiptTokens(ctx, lock, sdk.NewCoin(acc.Denom, amount.AmountOf(acc.Denom)))
 }
 
 // basic validation for locks to be eligible for superfluid delegation. This includes checking
@@ -217,6 +221,59 @@ func (k Keeper) validateValAddrForDelegate(ctx sdk.Context, valAddr string) (sta
 // The actual amount of delegation is not equal to the equivalent amount of osmo the lock has. That is,
 // the actual amount of delegation is amount * osmo equivalent multiplier * (1 - k.RiskFactor(asset)).
 func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64, valAddr string) error {
+	return k.superfluidDelegate(ctx, sender, lockID, valAddr, true)
+}
+
+// LockAndSuperfluidDelegate locks the given coins for the unbonding duration in a new lock owned by the sender,
+// and superfluid delegates that lock to the given validator. The coins are always locked in a new lock, rather
+// than added to an existing lock of the sender, as the existing lock may already be superfluid delegated.
+// Either both steps succeed, or no state is changed.
+func (k Keeper) LockAndSuperfluidDelegate(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins, valAddr string) (lockID uint64, err error) {
+	unbondingTime, err := k.sk.UnbondingTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		lock, err := k.lk.CreateLock(cacheCtx, sender, coins, unbondingTime)
+		if err != nil {
+			return err
+		}
+		lockID = lock.ID
+		return k.SuperfluidDelegate(cacheCtx, sender.String(), lockID, valAddr)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return lockID, nil
+}
+
+// CreateFullRangePositionAndSuperfluidDelegate creates a full range position in the given concentrated pool
+// with the given coins, locks it for the unbonding duration and superfluid delegates the lock to the given validator.
+// Either all steps succeed, or no state is changed.
+func (k Keeper) CreateFullRangePositionAndSuperfluidDelegate(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, coins sdk.Coins, valAddr string) (positionID uint64, lockID uint64, err error) {
+	unbondingTime, err := k.sk.UnbondingTime(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		positionData, concentratedLockID, err := k.clk.CreateFullRangePositionLocked(cacheCtx, poolId, sender, coins, unbondingTime)
+		if err != nil {
+			return err
+		}
+		positionID, lockID = positionData.ID, concentratedLockID
+		return k.SuperfluidDelegate(cacheCtx, sender.String(), lockID, valAddr)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return positionID, lockID, nil
+}
+
+// superfluidDelegate superfluid delegates the given lock, see SuperfluidDelegate.
+// The pool's superfluid cap is only enforced if enforceCap is true.
+func (k Keeper) superfluidDelegate(ctx sdk.Context, sender string, lockID uint64, valAddr string, enforceCap bool) error {
 	lock, err := k.lk.GetLockByID(ctx, lockID)
 	if err != nil {
 		return err
@@ -231,6 +288,13 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 	}
 	lockedCoin := lock.Coins[0]
 
+	// ensure that the pool's superfluid cap is not exceeded by this delegation
+	if enforceCap {
+		if err := k.validateSuperfluidCap(ctx, lockedCoin); err != nil {
+			return err
+		}
+	}
+
 	// get the intermediate account for this (denom, validator) pair.
 	// This account tracks the amount of osmo being considered as staked.
 	// If an intermediary account doesn't exist, then create it + a perpetual gauge.
@@ -257,7 +321,13 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 		return types.ErrOsmoEquivalentZeroNotAllowed
 	}
 
-	return k.mintOsmoTokensAndDelegate(ctx, amount, acc)
+	err = k.mintOsmoTokensAndDelegate(ctx, amount, acc)
+	if err != nil {
+		return err
+	}
+
+	// issue receipt tokens representing the superfluid staked position to the lock owner
+	return k.mintReceiptTokens(ctx, lock, lockedCoin)
 }
 
 // undelegateCommon is a helper function for SuperfluidUndelegate and superfluidUndelegateToConcentratedPosition.
@@ -265,6 +335,7 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 // - checks that the lock is valid for superfluid staking
 // - gets the intermediary account associated with the lock id
 // - deletes the connection between the lock id and the intermediary account
+// - burns the receipt tokens issued for the lock, if any
 // - deletes the synthetic lockup associated with the lock id
 // - undelegates the superfluid staking position associated with the lock id and burns the underlying osmo tokens
 // - returns the intermediary account
@@ -286,6 +357,12 @@ func (k Keeper) undelegateCommon(ctx sdk.Context, sender string, lockID uint64)
 	}
 	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
 
+	// burn the receipt tokens issued for the superfluid staked position
+	err = k.burnReceiptTokens(ctx, lock)
+	if err != nil {
+		return types.SuperfluidIntermediaryAccount{}, err
+	}
+
 	// Delete the old synthetic lockup
 	synthdenom := stakingSyntheticDenom(lockedCoin.Denom, intermediaryAcc.ValAddr)
 	err = k.lk.DeleteSyntheticLockup(ctx, lockID, synthdenom)
@@ -397,8 +474,10 @@ func (k Keeper) SuperfluidUndelegateAndUnbondLock(ctx sdk.Context, lockID uint64
 		return 0, err
 	}
 
-	// re-delegate remainder
-	err = k.SuperfluidDelegate(ctx, sender, lockID, intermediaryAcc.ValAddr)
+	// re-delegate remainder.
+	// The remainder was superfluid delegated until now, so re-delegating it does not increase
+	// the pool's superfluid bonded amount and must not be blocked by the pool's superfluid cap.
+	err = k.superfluidDelegate(ctx, sender, lockID, intermediaryAcc.ValAddr, false)
 	if err != nil {
 		return 0, err
 	}
@@ -156,6 +156,36 @@ func (s *KeeperTestSuite) TestSuperfluidDelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSuperfluidDelegateMaxPoolSuperfluidRatio() {
+	s.SetupTest()
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+
+	// with the default ratio of 100%, delegations are not capped
+	s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+	// lower the ratio so that the pool has no remaining headroom
+	params := s.App.SuperfluidKeeper.GetParams(s.Ctx)
+	params.MaxPoolSuperfluidRatio = osmomath.SmallestDec()
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+
+	stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	delAddr := s.TestAccs[0]
+	lockID := s.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), stakingParams.UnbondingTime)
+
+	err = s.App.SuperfluidKeeper.SuperfluidDelegate(s.Ctx, delAddr.String(), lockID, valAddrs[0].String())
+	s.Require().ErrorAs(err, &types.SuperfluidCapExceededError{})
+	s.Require().ErrorContains(err, "remaining headroom is 0"+denoms[0])
+
+	// raising the ratio back allows the delegation
+	params.MaxPoolSuperfluidRatio = osmomath.OneDec()
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+
+	err = s.App.SuperfluidKeeper.SuperfluidDelegate(s.Ctx, delAddr.String(), lockID, valAddrs[0].String())
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestValidateLockForSFDelegate() {
 	lockOwner := s.TestAccs[0]
 
@@ -262,6 +292,82 @@ func (s *KeeperTestSuite) TestValidateLockForSFDelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestLockAndSuperfluidDelegate() {
+	testCases := []struct {
+		name                   string
+		existingSuperfluidLock bool
+		denom                  func(denoms []string) string
+		valAddr                func(valAddrs []sdk.ValAddress) string
+		expectErr              bool
+	}{
+		{
+			name:    "locks and superfluid delegates in a new lock",
+			denom:   func(denoms []string) string { return denoms[0] },
+			valAddr: func(valAddrs []sdk.ValAddress) string { return valAddrs[0].String() },
+		},
+		{
+			name:                   "does not add to an existing superfluid lock of the sender",
+			existingSuperfluidLock: true,
+			denom:                  func(denoms []string) string { return denoms[0] },
+			valAddr:                func(valAddrs []sdk.ValAddress) string { return valAddrs[0].String() },
+		},
+		{
+			name:      "error: invalid validator rolls back the lock",
+			denom:     func(denoms []string) string { return denoms[0] },
+			valAddr:   func(valAddrs []sdk.ValAddress) string { return "invalid" },
+			expectErr: true,
+		},
+		{
+			name:      "error: not a superfluid asset rolls back the lock",
+			denom:     func(denoms []string) string { return "foo" },
+			valAddr:   func(valAddrs []sdk.ValAddress) string { return valAddrs[0].String() },
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender := s.TestAccs[0]
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+
+			denom := tc.denom(denoms)
+			coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000000))
+			s.FundAcc(sender, coins)
+
+			if tc.existingSuperfluidLock {
+				s.setupSuperfluidDelegate(sender, valAddrs[0], denom, 1000000)
+			}
+
+			lastLockID := s.App.LockupKeeper.GetLastLockID(s.Ctx)
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+
+			// System under test
+			lockID, err := s.App.SuperfluidKeeper.LockAndSuperfluidDelegate(s.Ctx, sender, coins, tc.valAddr(valAddrs))
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Equal(lastLockID, s.App.LockupKeeper.GetLastLockID(s.Ctx))
+				s.Require().Equal(balanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(lastLockID+1, lockID)
+
+			lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockID)
+			s.Require().NoError(err)
+			s.Require().Equal(coins, lock.Coins)
+
+			_, found, err := s.App.LockupKeeper.GetSyntheticLockupByUnderlyingLockId(s.Ctx, lockID)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(balanceBefore.AmountOf(denom).Sub(coins.AmountOf(denom)), s.App.BankKeeper.GetBalance(s.Ctx, sender, denom).Amount)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestSuperfluidUndelegate() {
 	testCases := []struct {
 		name                  string
@@ -700,6 +806,7 @@ func (s *KeeperTestSuite) TestSuperfluidUndelegateAndUnbondLock() {
 		splitLockId     bool
 		undelegating    bool
 		unbond          bool
+		capExceeded     bool
 	}{
 		{
 			name:            "lock doesn't exist",
@@ -746,6 +853,16 @@ func (s *KeeperTestSuite) TestSuperfluidUndelegateAndUnbondLock() {
 			undelegating:    false,
 			unbond:          false,
 		},
+		{
+			name:            "lock is split if the pool's superfluid cap is exceeded",
+			testInvalidLock: false,
+			unlockAmount:    osmomath.NewInt(lockAmount / 2),
+			expectErr:       false,
+			splitLockId:     true,
+			undelegating:    false,
+			unbond:          false,
+			capExceeded:     true,
+		},
 		{
 			name:            "undelegate and unbond an undelegating lock",
 			testInvalidLock: false,
@@ -780,6 +897,13 @@ func (s *KeeperTestSuite) TestSuperfluidUndelegateAndUnbondLock() {
 			s.checkIntermediaryAccountDelegations(intermediaryAccs)
 			s.Require().True(len(locks) > 0)
 
+			// lower the ratio so that the pool is above its superfluid cap
+			if tc.capExceeded {
+				params := s.App.SuperfluidKeeper.GetParams(s.Ctx)
+				params.MaxPoolSuperfluidRatio = osmomath.SmallestDec()
+				s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+			}
+
 			// test invalid lock
 			if tc.testInvalidLock {
 				lock := lockuptypes.PeriodLock{}
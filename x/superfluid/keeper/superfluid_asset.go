@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
@@ -49,3 +51,70 @@ func (k Keeper) AddNewSuperfluidAsset(ctx sdk.Context, asset types.SuperfluidAss
 		return err
 	})
 }
+
+// GetTotalSuperfluidBondedAmount returns the total amount of the given denom that is superfluid bonded
+// across all validators. The total is kept up to date by the lockup module as superbonding synthetic
+// lockups are created, deleted, topped up or slashed, so that it is read without iterating validators.
+func (k Keeper) GetTotalSuperfluidBondedAmount(ctx sdk.Context, denom string) (osmomath.Int, error) {
+	return k.lk.GetSuperbondedTotal(ctx, denom), nil
+}
+
+// GetSuperfluidAssetUtilization returns the amount of the given LP share denom that is superfluid bonded,
+// the total supply of the denom, the fraction of the supply that is bonded and the amount that may still
+// be bonded before the max pool superfluid ratio is reached.
+func (k Keeper) GetSuperfluidAssetUtilization(ctx sdk.Context, denom string) (bonded, totalSupply osmomath.Int, utilization osmomath.Dec, remainingHeadroom osmomath.Int, err error) {
+	asset, err := k.GetSuperfluidAsset(ctx, denom)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, osmomath.Int{}, err
+	}
+	if asset.AssetType != types.SuperfluidAssetTypeLPShare {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, osmomath.Int{}, errorsmod.Wrapf(types.ErrNonSuperfluidAsset, "utilization is only tracked for LP share assets, got %s", asset.AssetType)
+	}
+
+	bonded, err = k.GetTotalSuperfluidBondedAmount(ctx, denom)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, osmomath.Dec{}, osmomath.Int{}, err
+	}
+
+	totalSupply = k.bk.GetSupply(ctx, denom).Amount
+	utilization = osmomath.ZeroDec()
+	if totalSupply.IsPositive() {
+		utilization = bonded.ToLegacyDec().QuoInt(totalSupply)
+	}
+
+	maxBonded := k.GetParams(ctx).MaxPoolSuperfluidRatio.MulInt(totalSupply).TruncateInt()
+	remainingHeadroom = osmomath.ZeroInt()
+	if maxBonded.GT(bonded) {
+		remainingHeadroom = maxBonded.Sub(bonded)
+	}
+
+	return bonded, totalSupply, utilization, remainingHeadroom, nil
+}
+
+// validateSuperfluidCap returns an error if superfluid bonding the given amount of an LP share asset
+// would exceed the max pool superfluid ratio. Assets of other types are not capped.
+func (k Keeper) validateSuperfluidCap(ctx sdk.Context, coin sdk.Coin) error {
+	asset, err := k.GetSuperfluidAsset(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if asset.AssetType != types.SuperfluidAssetTypeLPShare {
+		return nil
+	}
+
+	_, _, _, remainingHeadroom, err := k.GetSuperfluidAssetUtilization(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	if coin.Amount.GT(remainingHeadroom) {
+		return types.SuperfluidCapExceededError{
+			Denom:                  coin.Denom,
+			Amount:                 coin.Amount,
+			RemainingHeadroom:      remainingHeadroom,
+			MaxPoolSuperfluidRatio: k.GetParams(ctx).MaxPoolSuperfluidRatio,
+		}
+	}
+
+	return nil
+}
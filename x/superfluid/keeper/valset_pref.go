@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+	valsettypes "github.com/osmosis-labs/osmosis/v26/x/valset-pref/types"
+)
+
+// valSetDelegation is a bonded superfluid delegation of a lock to a validator.
+type valSetDelegation struct {
+	lockID  uint64
+	valAddr string
+	amount  osmomath.Int
+}
+
+// SuperfluidDelegateToValidatorSet splits the given lock into one lock per validator of the sender's validator set preference,
+// proportionally to the validator weights, and superfluid delegates each lock to its validator.
+// The original lock holds the share of the first validator with a non zero share, and the last validator receives the
+// truncation remainder. Validators whose share truncates to zero are skipped.
+// Returns the superfluid delegated lock IDs, in the order of the validator set preference.
+// Returns an error if:
+// - the sender has no validator set preference
+// - the lock can not be superfluid delegated, see validateLockForSFDelegate
+// - the lock has to be split but can not be restructured, e.g. it holds concentrated liquidity shares
+func (k Keeper) SuperfluidDelegateToValidatorSet(ctx sdk.Context, sender string, lockID uint64) ([]uint64, error) {
+	preferences, found := k.vspk.GetValidatorSetPreference(ctx, sender)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNoValidatorSetPreference, "delegator %s", sender)
+	}
+
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validateLockForSFDelegate(ctx, lock, sender); err != nil {
+		return nil, err
+	}
+	lockedCoin := lock.Coins[0]
+
+	amounts := splitByValidatorSetPreference(lockedCoin.Amount, preferences.Preferences)
+
+	// the first validator with a non zero share keeps the original lock, the other shares are split into new locks.
+	lockIDs := make([]uint64, len(preferences.Preferences))
+	originalLockAssigned := false
+	for i, amount := range amounts {
+		if amount.IsZero() {
+			continue
+		}
+		if !originalLockAssigned {
+			lockIDs[i] = lockID
+			originalLockAssigned = true
+			continue
+		}
+		splitLock, err := k.lk.SplitLockByID(ctx, lock.OwnerAddress(), lockID, sdk.NewCoins(sdk.NewCoin(lockedCoin.Denom, amount)))
+		if err != nil {
+			return nil, err
+		}
+		lockIDs[i] = splitLock.ID
+	}
+
+	delegatedLockIDs := make([]uint64, 0, len(lockIDs))
+	for i, preference := range preferences.Preferences {
+		if lockIDs[i] == 0 {
+			continue
+		}
+		if err := k.SuperfluidDelegate(ctx, sender, lockIDs[i], preference.ValOperAddress); err != nil {
+			return nil, err
+		}
+		delegatedLockIDs = append(delegatedLockIDs, lockIDs[i])
+	}
+
+	return delegatedLockIDs, nil
+}
+
+// SuperfluidRebalanceValidatorSet superfluid undelegates the sender's bonded locks of the given denom that are delegated
+// to validators holding more than their share of the sender's validator set preference, including validators that are
+// not part of the preference anymore. The shares are computed over the bonded and unbonding superfluid delegations of the
+// denom, so that rebalancing again while previously undelegated locks are unbonding is a no-op.
+// Locks are undelegated in ascending lock ID order, for as long as their validator holds more than its share.
+// Once unbonded, the undelegated locks can be superfluid delegated to the validator set again.
+// Returns the undelegated lock IDs.
+func (k Keeper) SuperfluidRebalanceValidatorSet(ctx sdk.Context, sender, denom string) ([]uint64, error) {
+	preferences, found := k.vspk.GetValidatorSetPreference(ctx, sender)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNoValidatorSetPreference, "delegator %s", sender)
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, totalDelegated, err := k.getValidatorSetDelegations(ctx, senderAddr, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	excessByValidator := map[string]osmomath.Int{}
+	for _, delegation := range delegations {
+		excess, ok := excessByValidator[delegation.valAddr]
+		if !ok {
+			excess = osmomath.ZeroInt()
+		}
+		excessByValidator[delegation.valAddr] = excess.Add(delegation.amount)
+	}
+	for _, preference := range preferences.Preferences {
+		if excess, ok := excessByValidator[preference.ValOperAddress]; ok {
+			excessByValidator[preference.ValOperAddress] = excess.Sub(preference.Weight.MulInt(totalDelegated).TruncateInt())
+		}
+	}
+
+	undelegatedLockIDs := []uint64{}
+	for _, delegation := range delegations {
+		excess := excessByValidator[delegation.valAddr]
+		if !excess.IsPositive() {
+			continue
+		}
+		if err := k.SuperfluidUndelegate(ctx, sender, delegation.lockID); err != nil {
+			return nil, err
+		}
+		excessByValidator[delegation.valAddr] = excess.Sub(delegation.amount)
+		undelegatedLockIDs = append(undelegatedLockIDs, delegation.lockID)
+	}
+
+	return undelegatedLockIDs, nil
+}
+
+// GetValidatorSetSplit returns the split of the delegator's bonded superfluid delegations of the given denom over
+// validators, compared to the delegator's validator set preference. The records of the validators of the preference
+// come first, in the preference order, followed by the other validators the denom is delegated to.
+// If the delegator has no validator set preference, all the preference weights are zero.
+func (k Keeper) GetValidatorSetSplit(ctx sdk.Context, delegator sdk.AccAddress, denom string) ([]types.ValidatorSetSplitRecord, sdk.Coin, error) {
+	delegations, _, err := k.getValidatorSetDelegations(ctx, delegator, denom)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+
+	records := []types.ValidatorSetSplitRecord{}
+	recordIndex := map[string]int{}
+	addRecord := func(valAddr string, weight osmomath.Dec) {
+		recordIndex[valAddr] = len(records)
+		records = append(records, types.ValidatorSetSplitRecord{
+			ValidatorAddress: valAddr,
+			PreferenceWeight: weight,
+			DelegationAmount: sdk.NewCoin(denom, osmomath.ZeroInt()),
+			EffectiveWeight:  osmomath.ZeroDec(),
+		})
+	}
+
+	if preferences, found := k.vspk.GetValidatorSetPreference(ctx, delegator.String()); found {
+		for _, preference := range preferences.Preferences {
+			addRecord(preference.ValOperAddress, preference.Weight)
+		}
+	}
+
+	total := sdk.NewCoin(denom, osmomath.ZeroInt())
+	for _, delegation := range delegations {
+		if _, ok := recordIndex[delegation.valAddr]; !ok {
+			addRecord(delegation.valAddr, osmomath.ZeroDec())
+		}
+		record := &records[recordIndex[delegation.valAddr]]
+		record.DelegationAmount = record.DelegationAmount.AddAmount(delegation.amount)
+		total = total.AddAmount(delegation.amount)
+	}
+
+	if total.IsPositive() {
+		for i := range records {
+			records[i].EffectiveWeight = records[i].DelegationAmount.Amount.ToLegacyDec().QuoInt(total.Amount)
+		}
+	}
+
+	return records, total, nil
+}
+
+// SuperfluidUndelegateValidatorSet superfluid undelegates all the sender's bonded locks of the given denom, in ascending
+// lock ID order, whichever validator they are delegated to. This undelegates the locks split over the sender's validator
+// set preference by SuperfluidDelegateToValidatorSet at once.
+// Returns the undelegated lock IDs.
+func (k Keeper) SuperfluidUndelegateValidatorSet(ctx sdk.Context, sender, denom string) ([]uint64, error) {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, _, err := k.getValidatorSetDelegations(ctx, senderAddr, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	undelegatedLockIDs := make([]uint64, 0, len(delegations))
+	for _, delegation := range delegations {
+		if err := k.SuperfluidUndelegate(ctx, sender, delegation.lockID); err != nil {
+			return nil, err
+		}
+		undelegatedLockIDs = append(undelegatedLockIDs, delegation.lockID)
+	}
+
+	return undelegatedLockIDs, nil
+}
+
+// getValidatorSetDelegations returns the bonded superfluid delegations of the delegator's locks of the given denom,
+// sorted by lock ID, along with the total amount of the bonded and unbonding superfluid delegations of the denom.
+func (k Keeper) getValidatorSetDelegations(ctx sdk.Context, delegator sdk.AccAddress, denom string) ([]valSetDelegation, osmomath.Int, error) {
+	delegations := []valSetDelegation{}
+	total := osmomath.ZeroInt()
+	for _, syntheticLock := range k.lk.GetAllSyntheticLockupsByAddr(ctx, delegator) {
+		valAddr, err := ValidatorAddressFromSyntheticDenom(syntheticLock.SynthDenom)
+		if err != nil {
+			return nil, osmomath.Int{}, err
+		}
+		isBonded := syntheticLock.SynthDenom == stakingSyntheticDenom(denom, valAddr)
+		if !isBonded && syntheticLock.SynthDenom != unstakingSyntheticDenom(denom, valAddr) {
+			continue
+		}
+
+		lock, err := k.lk.GetLockByID(ctx, syntheticLock.UnderlyingLockId)
+		if err != nil {
+			return nil, osmomath.Int{}, err
+		}
+		amount := lock.Coins.AmountOf(denom)
+		total = total.Add(amount)
+		if isBonded {
+			delegations = append(delegations, valSetDelegation{
+				lockID:  lock.ID,
+				valAddr: valAddr,
+				amount:  amount,
+			})
+		}
+	}
+
+	sort.Slice(delegations, func(i, j int) bool {
+		return delegations[i].lockID < delegations[j].lockID
+	})
+	return delegations, total, nil
+}
+
+// splitByValidatorSetPreference splits the amount by the weights of the preferences, in the preferences order.
+// The last preference receives the truncation remainder.
+func splitByValidatorSetPreference(amount osmomath.Int, preferences []valsettypes.ValidatorPreference) []osmomath.Int {
+	amounts := make([]osmomath.Int, len(preferences))
+	remaining := amount
+	for i, preference := range preferences {
+		if i == len(preferences)-1 {
+			amounts[i] = remaining
+			break
+		}
+		amounts[i] = preference.Weight.MulInt(amount).TruncateInt()
+		remaining = remaining.Sub(amounts[i])
+	}
+	return amounts
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+	valsettypes "github.com/osmosis-labs/osmosis/v26/x/valset-pref/types"
+)
+
+// valSetWeight is the weight of the validator at valIndex in a validator set preference.
+type valSetWeight struct {
+	valIndex int
+	weight   string
+}
+
+func (s *KeeperTestSuite) setValidatorSetPreference(delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, weights []valSetWeight) {
+	preferences := []valsettypes.ValidatorPreference{}
+	for _, w := range weights {
+		preferences = append(preferences, valsettypes.ValidatorPreference{
+			ValOperAddress: valAddrs[w.valIndex].String(),
+			Weight:         osmomath.MustNewDecFromStr(w.weight),
+		})
+	}
+	s.App.ValidatorSetPreferenceKeeper.SetValidatorSetPreferences(s.Ctx, delAddr.String(), valsettypes.ValidatorSetPreferences{Preferences: preferences})
+}
+
+// setupValidatorSetDelegation locks the amount of the denom and superfluid delegates it to the delegator's validator set preference.
+func (s *KeeperTestSuite) setupValidatorSetDelegation(delAddr sdk.AccAddress, denom string, amount int64) []uint64 {
+	stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	lockID := s.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, amount)), stakingParams.UnbondingTime)
+
+	lockIDs, err := s.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(s.Ctx, delAddr.String(), lockID)
+	s.Require().NoError(err)
+	return lockIDs
+}
+
+func (s *KeeperTestSuite) TestSuperfluidDelegateToValidatorSet() {
+	tests := map[string]struct {
+		preferences          []valSetWeight
+		lockAmount           int64
+		alreadyDelegated     bool
+		expectedValIndexes   []int
+		expectedLockedAmount []int64
+		expectedErr          error
+	}{
+		"single validator keeps the lock": {
+			preferences:          []valSetWeight{{0, "1"}},
+			lockAmount:           1000000,
+			expectedValIndexes:   []int{0},
+			expectedLockedAmount: []int64{1000000},
+		},
+		"split over two validators": {
+			preferences:          []valSetWeight{{0, "0.7"}, {1, "0.3"}},
+			lockAmount:           1000000,
+			expectedValIndexes:   []int{0, 1},
+			expectedLockedAmount: []int64{700000, 300000},
+		},
+		"last validator receives the truncation remainder": {
+			preferences:          []valSetWeight{{2, "0.5"}, {0, "0.25"}, {1, "0.25"}},
+			lockAmount:           1000001,
+			expectedValIndexes:   []int{2, 0, 1},
+			expectedLockedAmount: []int64{500000, 250000, 250001},
+		},
+		"validators with a zero share are skipped": {
+			preferences:          []valSetWeight{{0, "0.000000000000000001"}, {1, "0.999999999999999999"}},
+			lockAmount:           1000000,
+			expectedValIndexes:   []int{1},
+			expectedLockedAmount: []int64{1000000},
+		},
+		"no validator set preference": {
+			lockAmount:  1000000,
+			expectedErr: types.ErrNoValidatorSetPreference,
+		},
+		"lock already superfluid delegated": {
+			preferences:      []valSetWeight{{0, "0.5"}, {1, "0.5"}},
+			lockAmount:       1000000,
+			alreadyDelegated: true,
+			expectedErr:      types.ErrAlreadyUsedSuperfluidLockup,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+			delAddr := s.TestAccs[0]
+			if tc.preferences != nil {
+				s.setValidatorSetPreference(delAddr, valAddrs, tc.preferences)
+			}
+
+			stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+			s.Require().NoError(err)
+			lockID := s.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], tc.lockAmount)), stakingParams.UnbondingTime)
+			if tc.alreadyDelegated {
+				err := s.App.SuperfluidKeeper.SuperfluidDelegate(s.Ctx, delAddr.String(), lockID, valAddrs[0].String())
+				s.Require().NoError(err)
+			}
+
+			lockIDs, err := s.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(s.Ctx, delAddr.String(), lockID)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(lockIDs, len(tc.expectedValIndexes))
+			s.Require().Equal(lockID, lockIDs[0])
+
+			for i, id := range lockIDs {
+				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, id)
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denoms[0], tc.expectedLockedAmount[i])), lock.Coins)
+
+				intermediaryAcc, found := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, id)
+				s.Require().True(found)
+				s.Require().Equal(valAddrs[tc.expectedValIndexes[i]].String(), intermediaryAcc.ValAddr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSuperfluidRebalanceValidatorSet() {
+	tests := map[string]struct {
+		newPreferences []valSetWeight
+		// indexes of the locks delegated to validator 0 and 1 respectively
+		expectedUndelegated []int
+	}{
+		"unchanged preference": {
+			newPreferences:      []valSetWeight{{0, "0.5"}, {1, "0.5"}},
+			expectedUndelegated: []int{},
+		},
+		"validator removed from the preference": {
+			newPreferences:      []valSetWeight{{0, "1"}},
+			expectedUndelegated: []int{1},
+		},
+		"validator replaced in the preference": {
+			newPreferences:      []valSetWeight{{0, "0.5"}, {2, "0.5"}},
+			expectedUndelegated: []int{1},
+		},
+		"validator weight lowered": {
+			newPreferences:      []valSetWeight{{0, "0.25"}, {1, "0.75"}},
+			expectedUndelegated: []int{0},
+		},
+		"all validators replaced": {
+			newPreferences:      []valSetWeight{{2, "1"}},
+			expectedUndelegated: []int{0, 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+			delAddr := s.TestAccs[0]
+
+			s.setValidatorSetPreference(delAddr, valAddrs, []valSetWeight{{0, "0.5"}, {1, "0.5"}})
+			lockIDs := s.setupValidatorSetDelegation(delAddr, denoms[0], 1000000)
+			s.Require().Len(lockIDs, 2)
+
+			s.setValidatorSetPreference(delAddr, valAddrs, tc.newPreferences)
+			undelegated, err := s.App.SuperfluidKeeper.SuperfluidRebalanceValidatorSet(s.Ctx, delAddr.String(), denoms[0])
+			s.Require().NoError(err)
+
+			expectedUndelegated := []uint64{}
+			for _, i := range tc.expectedUndelegated {
+				expectedUndelegated = append(expectedUndelegated, lockIDs[i])
+			}
+			s.Require().Equal(expectedUndelegated, undelegated)
+
+			for _, id := range expectedUndelegated {
+				synthLock, found, err := s.App.LockupKeeper.GetSyntheticLockupByUnderlyingLockId(s.Ctx, id)
+				s.Require().NoError(err)
+				s.Require().True(found)
+				s.Require().True(strings.Contains(synthLock.SynthDenom, "superunbonding"))
+			}
+
+			// rebalancing again is a no-op, as unbonding delegations are not considered
+			undelegated, err = s.App.SuperfluidKeeper.SuperfluidRebalanceValidatorSet(s.Ctx, delAddr.String(), denoms[0])
+			s.Require().NoError(err)
+			s.Require().Empty(undelegated)
+		})
+	}
+
+	s.Run("no validator set preference", func() {
+		s.SetupTest()
+		_, err := s.App.SuperfluidKeeper.SuperfluidRebalanceValidatorSet(s.Ctx, s.TestAccs[0].String(), DefaultGammAsset)
+		s.Require().ErrorIs(err, types.ErrNoValidatorSetPreference)
+	})
+}
+
+func (s *KeeperTestSuite) TestSuperfluidUndelegateValidatorSet() {
+	s.SetupTest()
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+	delAddr := s.TestAccs[0]
+
+	s.setValidatorSetPreference(delAddr, valAddrs, []valSetWeight{{0, "0.5"}, {1, "0.3"}, {2, "0.2"}})
+	lockIDs := s.setupValidatorSetDelegation(delAddr, denoms[0], 1000000)
+	s.Require().Len(lockIDs, 3)
+
+	// a lock of another denom is not undelegated
+	otherLock := s.setupSuperfluidDelegate(delAddr, valAddrs[0], denoms[1], 1000000)
+
+	undelegated, err := s.App.SuperfluidKeeper.SuperfluidUndelegateValidatorSet(s.Ctx, delAddr.String(), denoms[0])
+	s.Require().NoError(err)
+	s.Require().Equal(lockIDs, undelegated)
+
+	for _, id := range lockIDs {
+		synthLock, found, err := s.App.LockupKeeper.GetSyntheticLockupByUnderlyingLockId(s.Ctx, id)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().True(strings.Contains(synthLock.SynthDenom, "superunbonding"))
+	}
+
+	synthLock, found, err := s.App.LockupKeeper.GetSyntheticLockupByUnderlyingLockId(s.Ctx, otherLock.ID)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().False(strings.Contains(synthLock.SynthDenom, "superunbonding"))
+
+	// undelegating again is a no-op, as unbonding delegations are not considered
+	undelegated, err = s.App.SuperfluidKeeper.SuperfluidUndelegateValidatorSet(s.Ctx, delAddr.String(), denoms[0])
+	s.Require().NoError(err)
+	s.Require().Empty(undelegated)
+}
+
+func (s *KeeperTestSuite) TestGRPCSuperfluidValidatorSetSplit() {
+	s.SetupTest()
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+	delAddr := s.TestAccs[0]
+
+	s.setValidatorSetPreference(delAddr, valAddrs, []valSetWeight{{0, "0.7"}, {1, "0.3"}})
+	s.setupValidatorSetDelegation(delAddr, denoms[0], 1000000)
+
+	// change the preference, the delegations are not rebalanced yet
+	s.setValidatorSetPreference(delAddr, valAddrs, []valSetWeight{{1, "0.5"}, {2, "0.5"}})
+
+	res, err := s.queryClient.SuperfluidValidatorSetSplit(s.Ctx, &types.SuperfluidValidatorSetSplitRequest{
+		DelegatorAddress: delAddr.String(),
+		Denom:            denoms[0],
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denoms[0], 1000000), res.TotalDelegatedAmount)
+	s.Require().Equal([]types.ValidatorSetSplitRecord{
+		{
+			ValidatorAddress: valAddrs[1].String(),
+			PreferenceWeight: osmomath.MustNewDecFromStr("0.5"),
+			DelegationAmount: sdk.NewInt64Coin(denoms[0], 300000),
+			EffectiveWeight:  osmomath.MustNewDecFromStr("0.3"),
+		},
+		{
+			ValidatorAddress: valAddrs[2].String(),
+			PreferenceWeight: osmomath.MustNewDecFromStr("0.5"),
+			DelegationAmount: sdk.NewInt64Coin(denoms[0], 0),
+			EffectiveWeight:  osmomath.ZeroDec(),
+		},
+		{
+			ValidatorAddress: valAddrs[0].String(),
+			PreferenceWeight: osmomath.ZeroDec(),
+			DelegationAmount: sdk.NewInt64Coin(denoms[0], 700000),
+			EffectiveWeight:  osmomath.MustNewDecFromStr("0.7"),
+		},
+	}, res.Records)
+
+	// invalid requests
+	_, err = s.queryClient.SuperfluidValidatorSetSplit(s.Ctx, &types.SuperfluidValidatorSetSplitRequest{DelegatorAddress: "invalid", Denom: denoms[0]})
+	s.Require().Error(err)
+	_, err = s.queryClient.SuperfluidValidatorSetSplit(s.Ctx, &types.SuperfluidValidatorSetSplitRequest{DelegatorAddress: delAddr.String()})
+	s.Require().Error(err)
+}
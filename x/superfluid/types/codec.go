@@ -22,6 +22,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateFullRangePositionAndSuperfluidDelegate{}, "osmosis/full-range-and-sf-delegate", nil)
 	cdc.RegisterConcrete(&MsgAddToConcentratedLiquiditySuperfluidPosition{}, "osmosis/add-to-cl-superfluid-position", nil)
 	cdc.RegisterConcrete(&MsgUnbondConvertAndStake{}, "osmosis/unbond-convert-and-stake", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidDelegateToValidatorSet{}, "osmosis/superfluid-delegate-to-valset", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidRebalanceValidatorSet{}, "osmosis/superfluid-rebalance-valset", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidUndelegateValidatorSet{}, "osmosis/superfluid-undelegate-valset", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -37,6 +40,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateFullRangePositionAndSuperfluidDelegate{},
 		&MsgAddToConcentratedLiquiditySuperfluidPosition{},
 		&MsgUnbondConvertAndStake{},
+		&MsgSuperfluidDelegateToValidatorSet{},
+		&MsgSuperfluidRebalanceValidatorSet{},
+		&MsgSuperfluidUndelegateValidatorSet{},
 	)
 
 	registry.RegisterImplementations(
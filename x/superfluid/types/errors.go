@@ -24,6 +24,10 @@ var (
 
 	ErrNonSuperfluidAsset = errorsmod.Register(ModuleName, 10, "provided asset is not supported for superfluid staking")
 
+	ErrNonTransferableReceiptToken = errorsmod.Register(ModuleName, 11, "superfluid receipt tokens are not transferable")
+
+	ErrNoValidatorSetPreference = errorsmod.Register(ModuleName, 12, "no validator set preference set for the delegator")
+
 	ErrPoolNotWhitelisted   = errorsmod.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = errorsmod.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = errorsmod.Register(ModuleName, 43, "lock has more than one asset")
@@ -46,6 +50,14 @@ func (e LockImproperStateError) Error() string {
 	return fmt.Sprintf("lock ID %d must be bonded for %s and not unbonding.", e.LockId, e.UnbondingDuration)
 }
 
+type PermanentLockMigrationError struct {
+	LockId uint64
+}
+
+func (e PermanentLockMigrationError) Error() string {
+	return fmt.Sprintf("lock ID %d is a permanent lock and can not be migrated.", e.LockId)
+}
+
 type LockOwnerMismatchError struct {
 	LockId        uint64
 	LockOwner     string
@@ -125,3 +137,14 @@ type TokenConvertedLessThenDesiredStakeError struct {
 func (e TokenConvertedLessThenDesiredStakeError) Error() string {
 	return fmt.Sprintf("actual amount converted to stake (%s) is less then minimum amount expected to be staked (%s)", e.ActualTotalAmtToStake, e.ExpectedTotalAmtToStake)
 }
+
+type SuperfluidCapExceededError struct {
+	Denom                  string
+	Amount                 osmomath.Int
+	RemainingHeadroom      osmomath.Int
+	MaxPoolSuperfluidRatio osmomath.Dec
+}
+
+func (e SuperfluidCapExceededError) Error() string {
+	return fmt.Sprintf("superfluid delegating %s%s would exceed the max pool superfluid ratio (%s), remaining headroom is %s%s", e.Amount, e.Denom, e.MaxPoolSuperfluidRatio, e.RemainingHeadroom, e.Denom)
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 var (
 	// ModuleName defines the module name.
 	ModuleName = "superfluid"
@@ -27,4 +32,28 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixLockIdReceiptToken defines prefix to connect lockId and the receipt tokens minted for it.
+	KeyPrefixLockIdReceiptToken = []byte{0x07}
+
+	// KeyPrefixDelegationSnapshot defines prefix to store the snapshots of superfluid delegations by epoch.
+	KeyPrefixDelegationSnapshot = []byte{0x08}
 )
+
+// GetDelegationSnapshotEpochPrefix returns the key prefix of the delegation snapshots taken at the start of an epoch,
+// within the delegation snapshot store.
+func GetDelegationSnapshotEpochPrefix(epochNumber int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(epochNumber))
+}
+
+// GetDelegationSnapshotDelegatorPrefix returns the key prefix of a delegator's delegation snapshots taken at the start
+// of an epoch, within the delegation snapshot store.
+func GetDelegationSnapshotDelegatorPrefix(epochNumber int64, delegator sdk.AccAddress) []byte {
+	return append(GetDelegationSnapshotEpochPrefix(epochNumber), address.MustLengthPrefix(delegator)...)
+}
+
+// GetDelegationSnapshotKey returns the key of the snapshot of a delegator's superfluid delegations to a validator
+// taken at the start of an epoch, within the delegation snapshot store.
+func GetDelegationSnapshotKey(epochNumber int64, delegator sdk.AccAddress, validator sdk.ValAddress) []byte {
+	return append(GetDelegationSnapshotDelegatorPrefix(epochNumber, delegator), validator...)
+}
@@ -25,6 +25,9 @@ const (
 	TypeMsgCreateFullRangePositionAndSuperfluidDelegate = "create_full_range_position_and_delegate"
 	TypeMsgAddToConcentratedLiquiditySuperfluidPosition = "add_to_concentrated_liquidity_superfluid_position"
 	TypeMsgUnbondConvertAndStake                        = "unbond_convert_and_stake"
+	TypeMsgSuperfluidDelegateToValidatorSet             = "superfluid_delegate_to_validator_set"
+	TypeMsgSuperfluidRebalanceValidatorSet              = "superfluid_rebalance_validator_set"
+	TypeMsgSuperfluidUndelegateValidatorSet             = "superfluid_undelegate_validator_set"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -394,3 +397,88 @@ func (msg MsgUnbondConvertAndStake) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSuperfluidDelegateToValidatorSet{}
+
+// NewMsgSuperfluidDelegateToValidatorSet creates a message to superfluid delegate a lock to the sender's validator set preference.
+func NewMsgSuperfluidDelegateToValidatorSet(sender sdk.AccAddress, lockId uint64) *MsgSuperfluidDelegateToValidatorSet {
+	return &MsgSuperfluidDelegateToValidatorSet{
+		Sender: sender.String(),
+		LockId: lockId,
+	}
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) Route() string { return RouterKey }
+func (m MsgSuperfluidDelegateToValidatorSet) Type() string {
+	return TypeMsgSuperfluidDelegateToValidatorSet
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	return nil
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidRebalanceValidatorSet{}
+
+// NewMsgSuperfluidRebalanceValidatorSet creates a message to rebalance the sender's superfluid delegations of a denom
+// towards the sender's validator set preference.
+func NewMsgSuperfluidRebalanceValidatorSet(sender sdk.AccAddress, denom string) *MsgSuperfluidRebalanceValidatorSet {
+	return &MsgSuperfluidRebalanceValidatorSet{
+		Sender: sender.String(),
+		Denom:  denom,
+	}
+}
+
+func (m MsgSuperfluidRebalanceValidatorSet) Route() string { return RouterKey }
+func (m MsgSuperfluidRebalanceValidatorSet) Type() string {
+	return TypeMsgSuperfluidRebalanceValidatorSet
+}
+
+func (m MsgSuperfluidRebalanceValidatorSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return sdk.ValidateDenom(m.Denom)
+}
+
+func (m MsgSuperfluidRebalanceValidatorSet) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidUndelegateValidatorSet{}
+
+// NewMsgSuperfluidUndelegateValidatorSet creates a message to superfluid undelegate all the sender's bonded locks of a denom.
+func NewMsgSuperfluidUndelegateValidatorSet(sender sdk.AccAddress, denom string) *MsgSuperfluidUndelegateValidatorSet {
+	return &MsgSuperfluidUndelegateValidatorSet{
+		Sender: sender.String(),
+		Denom:  denom,
+	}
+}
+
+func (m MsgSuperfluidUndelegateValidatorSet) Route() string { return RouterKey }
+func (m MsgSuperfluidUndelegateValidatorSet) Type() string {
+	return TypeMsgSuperfluidUndelegateValidatorSet
+}
+
+func (m MsgSuperfluidUndelegateValidatorSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return sdk.ValidateDenom(m.Denom)
+}
+
+func (m MsgSuperfluidUndelegateValidatorSet) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -13,6 +13,21 @@ import (
 var (
 	KeyMinimumRiskFactor     = []byte("MinimumRiskFactor")
 	defaultMinimumRiskFactor = osmomath.NewDecWithPrec(5, 1) // 50%
+
+	KeyMaxPoolSuperfluidRatio     = []byte("MaxPoolSuperfluidRatio")
+	DefaultMaxPoolSuperfluidRatio = osmomath.OneDec() // 100%
+
+	KeyReceiptTokensEnabled     = []byte("ReceiptTokensEnabled")
+	DefaultReceiptTokensEnabled = false
+
+	KeyReceiptTokensTransferable     = []byte("ReceiptTokensTransferable")
+	DefaultReceiptTokensTransferable = false
+
+	KeyDelegationSnapshotRetentionEpochs     = []byte("DelegationSnapshotRetentionEpochs")
+	DefaultDelegationSnapshotRetentionEpochs = uint64(0)
+	// MaxDelegationSnapshotRetentionEpochs bounds the number of epochs snapshots are kept for, since every epoch
+	// of the window stores a snapshot of every superfluid delegation.
+	MaxDelegationSnapshotRetentionEpochs = uint64(365)
 )
 
 // ParamTable for minting module.
@@ -20,28 +35,49 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(minimumRiskFactor osmomath.Dec) Params {
+func NewParams(minimumRiskFactor, maxPoolSuperfluidRatio osmomath.Dec) Params {
 	return Params{
-		MinimumRiskFactor: minimumRiskFactor,
+		MinimumRiskFactor:      minimumRiskFactor,
+		MaxPoolSuperfluidRatio: maxPoolSuperfluidRatio,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinimumRiskFactor: defaultMinimumRiskFactor, // 5%
+		MinimumRiskFactor:                 defaultMinimumRiskFactor,      // 5%
+		MaxPoolSuperfluidRatio:            DefaultMaxPoolSuperfluidRatio, // 100%
+		ReceiptTokensEnabled:              DefaultReceiptTokensEnabled,
+		ReceiptTokensTransferable:         DefaultReceiptTokensTransferable,
+		DelegationSnapshotRetentionEpochs: DefaultDelegationSnapshotRetentionEpochs,
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	return nil
+	if err := ValidateMinimumRiskFactor(p.MinimumRiskFactor); err != nil {
+		return err
+	}
+	if err := ValidateMaxPoolSuperfluidRatio(p.MaxPoolSuperfluidRatio); err != nil {
+		return err
+	}
+	if err := ValidateReceiptTokensParam(p.ReceiptTokensEnabled); err != nil {
+		return err
+	}
+	if err := ValidateReceiptTokensParam(p.ReceiptTokensTransferable); err != nil {
+		return err
+	}
+	return ValidateDelegationSnapshotRetentionEpochs(p.DelegationSnapshotRetentionEpochs)
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinimumRiskFactor, &p.MinimumRiskFactor, ValidateMinimumRiskFactor),
+		paramtypes.NewParamSetPair(KeyMaxPoolSuperfluidRatio, &p.MaxPoolSuperfluidRatio, ValidateMaxPoolSuperfluidRatio),
+		paramtypes.NewParamSetPair(KeyReceiptTokensEnabled, &p.ReceiptTokensEnabled, ValidateReceiptTokensParam),
+		paramtypes.NewParamSetPair(KeyReceiptTokensTransferable, &p.ReceiptTokensTransferable, ValidateReceiptTokensParam),
+		paramtypes.NewParamSetPair(KeyDelegationSnapshotRetentionEpochs, &p.DelegationSnapshotRetentionEpochs, ValidateDelegationSnapshotRetentionEpochs),
 	}
 }
 
@@ -51,13 +87,48 @@ func ValidateMinimumRiskFactor(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.IsNegative() || v.GT(osmomath.NewDec(100)) {
+	if v.IsNil() || v.IsNegative() || v.GT(osmomath.NewDec(100)) {
 		return fmt.Errorf("minimum risk factor should be between 0 - 100: %s", v.String())
 	}
 
 	return nil
 }
 
+func ValidateMaxPoolSuperfluidRatio(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(osmomath.OneDec()) {
+		return fmt.Errorf("max pool superfluid ratio should be greater than 0 and at most 1: %s", v)
+	}
+
+	return nil
+}
+
+func ValidateReceiptTokensParam(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func ValidateDelegationSnapshotRetentionEpochs(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxDelegationSnapshotRetentionEpochs {
+		return fmt.Errorf("delegation snapshot retention epochs should be at most %d: %d", MaxDelegationSnapshotRetentionEpochs, v)
+	}
+
+	return nil
+}
+
 func ValidateUnbondingDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -32,6 +32,22 @@ type Params struct {
 	// to counter-balance the staked amount on chain's exposure to various asset
 	// volatilities, and have base staking be 'resistant' to volatility.
 	MinimumRiskFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=minimum_risk_factor,json=minimumRiskFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"minimum_risk_factor" yaml:"minimum_risk_factor"`
+	// max_pool_superfluid_ratio is the maximum fraction of a pool's LP share
+	// supply that may be superfluid bonded, default: 100%. It is enforced for
+	// LP share assets when superfluid delegating.
+	MaxPoolSuperfluidRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_pool_superfluid_ratio,json=maxPoolSuperfluidRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_pool_superfluid_ratio" yaml:"max_pool_superfluid_ratio"`
+	// receipt_tokens_enabled determines whether superfluid delegating a lock
+	// mints receipt tokens representing the superfluid staked position to the
+	// lock owner. The receipt tokens are burned when the lock is superfluid
+	// undelegated.
+	ReceiptTokensEnabled bool `protobuf:"varint,3,opt,name=receipt_tokens_enabled,json=receiptTokensEnabled,proto3" json:"receipt_tokens_enabled,omitempty" yaml:"receipt_tokens_enabled"`
+	// receipt_tokens_transferable determines whether receipt tokens can be
+	// transferred between accounts. Default: false.
+	ReceiptTokensTransferable bool `protobuf:"varint,4,opt,name=receipt_tokens_transferable,json=receiptTokensTransferable,proto3" json:"receipt_tokens_transferable,omitempty" yaml:"receipt_tokens_transferable"`
+	// delegation_snapshot_retention_epochs is the number of epochs the snapshots
+	// of superfluid delegations taken at every epoch start are kept for. Zero
+	// disables delegation snapshots, and it is at most 365. Default: 0.
+	DelegationSnapshotRetentionEpochs uint64 `protobuf:"varint,5,opt,name=delegation_snapshot_retention_epochs,json=delegationSnapshotRetentionEpochs,proto3" json:"delegation_snapshot_retention_epochs,omitempty" yaml:"delegation_snapshot_retention_epochs"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,6 +83,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetReceiptTokensEnabled() bool {
+	if m != nil {
+		return m.ReceiptTokensEnabled
+	}
+	return false
+}
+
+func (m *Params) GetReceiptTokensTransferable() bool {
+	if m != nil {
+		return m.ReceiptTokensTransferable
+	}
+	return false
+}
+
+func (m *Params) GetDelegationSnapshotRetentionEpochs() uint64 {
+	if m != nil {
+		return m.DelegationSnapshotRetentionEpochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.superfluid.Params")
 }
@@ -74,24 +111,35 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/params.proto", fileDescriptor_0985261dfaf2a82e) }
 
 var fileDescriptor_0985261dfaf2a82e = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x2d, 0x48, 0x2d, 0x4a, 0xcb, 0x29, 0xcd, 0x4c, 0xd1, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x52,
-	0x72, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x4a, 0x69,
-	0x51, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x44, 0x5e, 0xa9, 0x9a, 0x8b, 0x2d, 0x00, 0x6c, 0xb2, 0x50,
-	0x21, 0x97, 0x70, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e, 0x7c, 0x51, 0x66, 0x71, 0x76, 0x7c,
-	0x5a, 0x62, 0x72, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xe3, 0x89, 0x7b,
-	0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0x4b, 0x27, 0x83, 0x6d, 0x2e, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7,
-	0xcf, 0x4d, 0x2c, 0xc9, 0xd0, 0xf3, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x49, 0x4d, 0xfe, 0x74,
-	0x4f, 0x5e, 0xaa, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0x8b, 0x39, 0x4a, 0x41, 0x82, 0x50, 0xd1,
-	0xa0, 0xcc, 0xe2, 0x6c, 0x37, 0xb0, 0x98, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43,
-	0xfd, 0xaa, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x99, 0xe9, 0x57, 0x20, 0x87,
-	0x4f, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x57, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x52, 0x46, 0xca, 0xa7, 0x42, 0x01, 0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x18, 0x86, 0x3b, 0x5a, 0x17, 0xcd, 0xcd, 0xb8, 0x2c, 0xd9, 0x2e, 0x26, 0xdd, 0x20, 0x52, 0x10,
+	0x33, 0xa0, 0xb0, 0x07, 0x6f, 0x16, 0x57, 0x2f, 0x1e, 0x4a, 0x76, 0x41, 0xf0, 0x32, 0x4c, 0x92,
+	0x49, 0x3a, 0x34, 0x93, 0x6f, 0x9c, 0x99, 0x48, 0x7b, 0x13, 0x7f, 0x81, 0x3f, 0xca, 0xc3, 0x1e,
+	0xf7, 0x28, 0x1e, 0x82, 0xb4, 0xff, 0xa0, 0xbf, 0x40, 0x3a, 0xc9, 0xda, 0x2a, 0x15, 0xf6, 0x96,
+	0xef, 0x7d, 0x9f, 0xef, 0x7d, 0x13, 0xf2, 0x39, 0x01, 0x68, 0x01, 0x9a, 0x6b, 0xac, 0x6b, 0xc9,
+	0x54, 0x5e, 0xd6, 0x3c, 0xc3, 0x92, 0x2a, 0x2a, 0x74, 0x24, 0x15, 0x18, 0x70, 0xdd, 0x0e, 0x88,
+	0xb6, 0xc0, 0xe0, 0xb0, 0x80, 0x02, 0xac, 0x8d, 0x37, 0x4f, 0x2d, 0x39, 0xf0, 0x0b, 0x80, 0xa2,
+	0x64, 0xd8, 0x4e, 0x49, 0x9d, 0xe3, 0xac, 0x56, 0xd4, 0x70, 0xa8, 0x5a, 0x3f, 0xfc, 0xde, 0x77,
+	0x0e, 0x26, 0x36, 0xda, 0xfd, 0xe4, 0x3c, 0x12, 0xbc, 0xe2, 0xa2, 0x16, 0x44, 0x71, 0x3d, 0x23,
+	0x39, 0x4d, 0x0d, 0x28, 0x0f, 0x0d, 0xd1, 0xe8, 0xc1, 0xf8, 0xf5, 0x55, 0x13, 0xf4, 0x7e, 0x36,
+	0xc1, 0x49, 0x6a, 0xab, 0x75, 0x36, 0x8b, 0x38, 0x60, 0x41, 0xcd, 0x34, 0x7a, 0xcf, 0x0a, 0x9a,
+	0x2e, 0xde, 0xb0, 0x74, 0xdd, 0x04, 0x83, 0x05, 0x15, 0xe5, 0xab, 0x70, 0x4f, 0x4e, 0x18, 0x3f,
+	0xec, 0xd4, 0x98, 0xeb, 0xd9, 0x5b, 0xab, 0xb9, 0x5f, 0x91, 0x73, 0x2c, 0xe8, 0x9c, 0x48, 0x80,
+	0x92, 0x6c, 0xbf, 0x85, 0xd8, 0x57, 0xf4, 0xee, 0xd8, 0xe6, 0x77, 0xb7, 0x6b, 0x1e, 0x76, 0xcd,
+	0xff, 0x4b, 0x0b, 0xe3, 0x23, 0x41, 0xe7, 0x13, 0x80, 0xf2, 0xe2, 0x8f, 0x13, 0x6f, 0x0c, 0xf7,
+	0x83, 0x73, 0xa4, 0x58, 0xca, 0xb8, 0x34, 0xc4, 0xc0, 0x8c, 0x55, 0x9a, 0xb0, 0x8a, 0x26, 0x25,
+	0xcb, 0xbc, 0xbb, 0x43, 0x34, 0xba, 0x3f, 0x3e, 0x5d, 0x37, 0xc1, 0xe3, 0x36, 0x7d, 0x3f, 0x17,
+	0xc6, 0x87, 0x9d, 0x71, 0x69, 0xf5, 0xf3, 0x56, 0x76, 0x73, 0xe7, 0xe4, 0x9f, 0x05, 0xa3, 0x68,
+	0xa5, 0x73, 0xa6, 0x36, 0xbe, 0xd7, 0xb7, 0xe9, 0x4f, 0xd7, 0x4d, 0x10, 0xee, 0x4d, 0xdf, 0x85,
+	0xc3, 0xf8, 0xf8, 0xaf, 0x8a, 0xcb, 0x1d, 0xcf, 0xfd, 0x82, 0x9c, 0x27, 0x19, 0x2b, 0x59, 0x61,
+	0x7f, 0x2c, 0xd1, 0x15, 0x95, 0x7a, 0x0a, 0x86, 0x28, 0x66, 0x58, 0x65, 0x25, 0x26, 0x21, 0x9d,
+	0x6a, 0xef, 0xde, 0x10, 0x8d, 0xfa, 0x63, 0xbc, 0x6e, 0x82, 0x67, 0x6d, 0xe3, 0x6d, 0xb6, 0xc2,
+	0xf8, 0x74, 0x8b, 0x5d, 0x74, 0x54, 0x7c, 0x03, 0x9d, 0x5b, 0x66, 0x3c, 0xb9, 0x5a, 0xfa, 0xe8,
+	0x7a, 0xe9, 0xa3, 0x5f, 0x4b, 0x1f, 0x7d, 0x5b, 0xf9, 0xbd, 0xeb, 0x95, 0xdf, 0xfb, 0xb1, 0xf2,
+	0x7b, 0x1f, 0xcf, 0x0a, 0x6e, 0xa6, 0x75, 0x12, 0xa5, 0x20, 0x70, 0x77, 0xb5, 0xcf, 0x4b, 0x9a,
+	0xe8, 0x9b, 0x01, 0x7f, 0x7e, 0x71, 0x86, 0xe7, 0xbb, 0x97, 0x6e, 0x16, 0x92, 0xe9, 0xe4, 0xc0,
+	0xde, 0xe7, 0xcb, 0xdf, 0x03, 0x00, 0xb4, 0xa4, 0xae, 0x86, 0x0c, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +162,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegationSnapshotRetentionEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DelegationSnapshotRetentionEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReceiptTokensTransferable {
+		i--
+		if m.ReceiptTokensTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReceiptTokensEnabled {
+		i--
+		if m.ReceiptTokensEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxPoolSuperfluidRatio.Size()
+		i -= size
+		if _, err := m.MaxPoolSuperfluidRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.MinimumRiskFactor.Size()
 		i -= size
@@ -146,6 +229,17 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinimumRiskFactor.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxPoolSuperfluidRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReceiptTokensEnabled {
+		n += 2
+	}
+	if m.ReceiptTokensTransferable {
+		n += 2
+	}
+	if m.DelegationSnapshotRetentionEpochs != 0 {
+		n += 1 + sovParams(uint64(m.DelegationSnapshotRetentionEpochs))
+	}
 	return n
 }
 
@@ -218,6 +312,99 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolSuperfluidRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPoolSuperfluidRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptTokensEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiptTokensEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptTokensTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiptTokensTransferable = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationSnapshotRetentionEpochs", wireType)
+			}
+			m.DelegationSnapshotRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationSnapshotRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := map[string]struct {
+		modify      func(*types.Params)
+		expectedErr bool
+	}{
+		"default params": {
+			modify: func(*types.Params) {},
+		},
+		"negative minimum risk factor": {
+			modify:      func(p *types.Params) { p.MinimumRiskFactor = osmomath.NewDec(-1) },
+			expectedErr: true,
+		},
+		"zero max pool superfluid ratio": {
+			modify:      func(p *types.Params) { p.MaxPoolSuperfluidRatio = osmomath.ZeroDec() },
+			expectedErr: true,
+		},
+		"max pool superfluid ratio above one": {
+			modify:      func(p *types.Params) { p.MaxPoolSuperfluidRatio = osmomath.NewDecWithPrec(11, 1) },
+			expectedErr: true,
+		},
+		"unset max pool superfluid ratio": {
+			modify:      func(p *types.Params) { p.MaxPoolSuperfluidRatio = osmomath.Dec{} },
+			expectedErr: true,
+		},
+		"max delegation snapshot retention epochs": {
+			modify: func(p *types.Params) {
+				p.DelegationSnapshotRetentionEpochs = types.MaxDelegationSnapshotRetentionEpochs
+			},
+		},
+		"delegation snapshot retention epochs above max": {
+			modify: func(p *types.Params) {
+				p.DelegationSnapshotRetentionEpochs = types.MaxDelegationSnapshotRetentionEpochs + 1
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+
+			err := params.Validate()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v26/x/tokenfactory/types"
+)
+
+// ReceiptTokenSubdenomPrefix is the prefix of the tokenfactory subdenoms of superfluid receipt tokens.
+const ReceiptTokenSubdenomPrefix = "sfreceipt"
+
+// GetReceiptTokenDenom returns the tokenfactory denom of the receipt tokens minted for superfluid delegations
+// of the given denom. The denom is created by the superfluid module account, e.g. factory/{module address}/sfreceipt/gamm/pool/1.
+// Returns error if the resulting subdenom exceeds the tokenfactory subdenom length limit.
+func GetReceiptTokenDenom(denom string) (string, error) {
+	return tokenfactorytypes.GetTokenDenom(authtypes.NewModuleAddress(ModuleName).String(), fmt.Sprintf("%s/%s", ReceiptTokenSubdenomPrefix, denom))
+}
+
+// IsReceiptTokenDenom returns true if the given denom is the denom of superfluid receipt tokens.
+func IsReceiptTokenDenom(denom string) bool {
+	if !strings.HasPrefix(denom, tokenfactorytypes.ModuleDenomPrefix) {
+		return false
+	}
+	receiptTokenDenomPrefix := strings.Join([]string{tokenfactorytypes.ModuleDenomPrefix, authtypes.NewModuleAddress(ModuleName).String(), ReceiptTokenSubdenomPrefix, ""}, "/")
+	return strings.HasPrefix(denom, receiptTokenDenomPrefix)
+}
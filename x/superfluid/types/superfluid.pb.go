@@ -295,6 +295,68 @@ func (m *SuperfluidDelegationRecord) GetEquivalentStakedAmount() *types.Coin {
 	return nil
 }
 
+// ValidatorSetSplitRecord is a struct used to compare the superfluid
+// delegations of a delegator to a validator with the validator's weight in the
+// delegator's validator set preference.
+type ValidatorSetSplitRecord struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// preference_weight is the validator's weight in the validator set
+	// preference, zero if the validator is not part of it.
+	PreferenceWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=preference_weight,json=preferenceWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"preference_weight"`
+	// delegation_amount is the amount superfluid delegated to the validator.
+	DelegationAmount types.Coin `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount"`
+	// effective_weight is the share of the delegator's superfluid delegations
+	// of the denom held by the validator.
+	EffectiveWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=effective_weight,json=effectiveWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_weight"`
+}
+
+func (m *ValidatorSetSplitRecord) Reset()         { *m = ValidatorSetSplitRecord{} }
+func (m *ValidatorSetSplitRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetSplitRecord) ProtoMessage()    {}
+func (*ValidatorSetSplitRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{4}
+}
+func (m *ValidatorSetSplitRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetSplitRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetSplitRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetSplitRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetSplitRecord.Merge(m, src)
+}
+func (m *ValidatorSetSplitRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetSplitRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetSplitRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetSplitRecord proto.InternalMessageInfo
+
+func (m *ValidatorSetSplitRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorSetSplitRecord) GetDelegationAmount() types.Coin {
+	if m != nil {
+		return m.DelegationAmount
+	}
+	return types.Coin{}
+}
+
 // LockIdIntermediaryAccountConnection is a struct used to indicate the
 // relationship between the underlying lock id and superfluid delegation done
 // via lp shares.
@@ -307,7 +369,7 @@ func (m *LockIdIntermediaryAccountConnection) Reset()         { *m = LockIdInter
 func (m *LockIdIntermediaryAccountConnection) String() string { return proto.CompactTextString(m) }
 func (*LockIdIntermediaryAccountConnection) ProtoMessage()    {}
 func (*LockIdIntermediaryAccountConnection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{4}
+	return fileDescriptor_79d3c29d82dbb734, []int{5}
 }
 func (m *LockIdIntermediaryAccountConnection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,6 +412,131 @@ func (m *LockIdIntermediaryAccountConnection) GetIntermediaryAccount() string {
 	return ""
 }
 
+// LockIdReceiptToken is a struct used to indicate the receipt tokens minted
+// for a superfluid delegated lock, which are burned when the lock is superfluid
+// undelegated.
+type LockIdReceiptToken struct {
+	LockId       uint64     `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	ReceiptToken types.Coin `protobuf:"bytes,2,opt,name=receipt_token,json=receiptToken,proto3" json:"receipt_token"`
+}
+
+func (m *LockIdReceiptToken) Reset()         { *m = LockIdReceiptToken{} }
+func (m *LockIdReceiptToken) String() string { return proto.CompactTextString(m) }
+func (*LockIdReceiptToken) ProtoMessage()    {}
+func (*LockIdReceiptToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{6}
+}
+func (m *LockIdReceiptToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockIdReceiptToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockIdReceiptToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockIdReceiptToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockIdReceiptToken.Merge(m, src)
+}
+func (m *LockIdReceiptToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockIdReceiptToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockIdReceiptToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockIdReceiptToken proto.InternalMessageInfo
+
+func (m *LockIdReceiptToken) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockIdReceiptToken) GetReceiptToken() types.Coin {
+	if m != nil {
+		return m.ReceiptToken
+	}
+	return types.Coin{}
+}
+
+// DelegationSnapshot is the OSMO equivalent of all the superfluid delegations
+// of a delegator to a validator at the start of an epoch.
+type DelegationSnapshot struct {
+	EpochNumber            int64      `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	DelegatorAddress       string     `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress       string     `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EquivalentStakedAmount types.Coin `protobuf:"bytes,4,opt,name=equivalent_staked_amount,json=equivalentStakedAmount,proto3" json:"equivalent_staked_amount"`
+}
+
+func (m *DelegationSnapshot) Reset()         { *m = DelegationSnapshot{} }
+func (m *DelegationSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DelegationSnapshot) ProtoMessage()    {}
+func (*DelegationSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
+}
+func (m *DelegationSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationSnapshot.Merge(m, src)
+}
+func (m *DelegationSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationSnapshot proto.InternalMessageInfo
+
+func (m *DelegationSnapshot) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *DelegationSnapshot) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationSnapshot) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationSnapshot) GetEquivalentStakedAmount() types.Coin {
+	if m != nil {
+		return m.EquivalentStakedAmount
+	}
+	return types.Coin{}
+}
+
 type UnpoolWhitelistedPools struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
@@ -358,7 +545,7 @@ func (m *UnpoolWhitelistedPools) Reset()         { *m = UnpoolWhitelistedPools{}
 func (m *UnpoolWhitelistedPools) String() string { return proto.CompactTextString(m) }
 func (*UnpoolWhitelistedPools) ProtoMessage()    {}
 func (*UnpoolWhitelistedPools) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{5}
+	return fileDescriptor_79d3c29d82dbb734, []int{8}
 }
 func (m *UnpoolWhitelistedPools) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +594,7 @@ func (m *ConcentratedPoolUserPositionRecord) Reset()         { *m = Concentrated
 func (m *ConcentratedPoolUserPositionRecord) String() string { return proto.CompactTextString(m) }
 func (*ConcentratedPoolUserPositionRecord) ProtoMessage()    {}
 func (*ConcentratedPoolUserPositionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{6}
+	return fileDescriptor_79d3c29d82dbb734, []int{9}
 }
 func (m *ConcentratedPoolUserPositionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +671,10 @@ func init() {
 	proto.RegisterType((*SuperfluidIntermediaryAccount)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccount")
 	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
 	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
+	proto.RegisterType((*ValidatorSetSplitRecord)(nil), "osmosis.superfluid.ValidatorSetSplitRecord")
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
+	proto.RegisterType((*LockIdReceiptToken)(nil), "osmosis.superfluid.LockIdReceiptToken")
+	proto.RegisterType((*DelegationSnapshot)(nil), "osmosis.superfluid.DelegationSnapshot")
 	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
 	proto.RegisterType((*ConcentratedPoolUserPositionRecord)(nil), "osmosis.superfluid.ConcentratedPoolUserPositionRecord")
 }
@@ -494,59 +684,68 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x6e, 0xd2, 0x4c, 0xa0, 0xb8, 0xdb, 0xa8, 0x24, 0x41, 0xd9, 0x0d, 0x5b, 0xa4,
-	0x5a, 0xad, 0xba, 0xab, 0x04, 0xa9, 0x42, 0xbd, 0x39, 0x29, 0x48, 0x46, 0xa1, 0x58, 0x6b, 0x2a,
-	0x10, 0x97, 0xd5, 0x78, 0xe7, 0x75, 0x3d, 0xf2, 0xec, 0xce, 0x76, 0x67, 0xd6, 0xe0, 0x1b, 0x07,
-	0x0e, 0x3d, 0xf2, 0x27, 0x54, 0xe2, 0xc6, 0x95, 0x7f, 0xa2, 0xc7, 0x4a, 0x5c, 0x10, 0x87, 0x80,
-	0x92, 0x0b, 0xe7, 0xfe, 0x05, 0x68, 0x66, 0x7f, 0x78, 0xd3, 0xb8, 0x42, 0x5c, 0xe8, 0xc9, 0x33,
-	0xef, 0x7b, 0xf3, 0xde, 0xf7, 0xcd, 0xfb, 0x3c, 0x8b, 0x6e, 0x71, 0x11, 0x73, 0x41, 0x85, 0x27,
-	0xf2, 0x14, 0xb2, 0x27, 0x2c, 0xa7, 0xa4, 0xb1, 0x74, 0xd3, 0x8c, 0x4b, 0x6e, 0x9a, 0x65, 0x92,
-	0xbb, 0x44, 0x76, 0xb7, 0x22, 0x1e, 0x71, 0x0d, 0x7b, 0x6a, 0x55, 0x64, 0xee, 0x5a, 0x11, 0xe7,
-	0x11, 0x03, 0x4f, 0xef, 0x26, 0xf9, 0x13, 0x8f, 0xe4, 0x19, 0x96, 0x94, 0x27, 0x25, 0x6e, 0xbf,
-	0x8e, 0x4b, 0x1a, 0x83, 0x90, 0x38, 0x4e, 0xab, 0x02, 0xa1, 0xee, 0xe5, 0x4d, 0xb0, 0x00, 0x6f,
-	0x7e, 0x30, 0x01, 0x89, 0x0f, 0xbc, 0x90, 0xd3, 0xaa, 0xc0, 0x4e, 0xc5, 0x97, 0xf1, 0x70, 0x96,
-	0xa7, 0xfa, 0xa7, 0x80, 0x9c, 0x05, 0x7a, 0x6f, 0x5c, 0xf3, 0x1b, 0x08, 0x01, 0xd2, 0xdc, 0x42,
-	0x57, 0x08, 0x24, 0x3c, 0xde, 0x36, 0xf6, 0x8d, 0xfe, 0x86, 0x5f, 0x6c, 0xcc, 0xcf, 0x10, 0xc2,
-	0x0a, 0x0e, 0xe4, 0x22, 0x85, 0xed, 0xf6, 0xbe, 0xd1, 0xbf, 0x76, 0x78, 0xdb, 0xbd, 0xac, 0xd1,
-	0x7d, 0xad, 0xdc, 0x57, 0x8b, 0x14, 0xfc, 0x0d, 0x5c, 0x2d, 0x1f, 0x5c, 0x7d, 0xf6, 0xdc, 0x6e,
-	0xfd, 0xfd, 0xdc, 0x36, 0x9c, 0x19, 0xda, 0x5b, 0xe6, 0x0e, 0x13, 0x09, 0x59, 0x0c, 0x84, 0xe2,
-	0x6c, 0x31, 0x08, 0x43, 0x9e, 0x27, 0x6f, 0x22, 0xb2, 0x83, 0xae, 0xce, 0x31, 0x0b, 0x30, 0x21,
-	0x99, 0xa6, 0xb1, 0xe1, 0xaf, 0xcf, 0x31, 0x1b, 0x10, 0x92, 0x29, 0x28, 0xc2, 0x79, 0x04, 0x01,
-	0x25, 0xdb, 0x9d, 0x7d, 0xa3, 0xdf, 0xf5, 0xd7, 0xf5, 0x7e, 0x48, 0x9c, 0x5f, 0x0d, 0x64, 0x7d,
-	0x29, 0x62, 0xfe, 0xe9, 0xd3, 0x9c, 0xce, 0x31, 0x83, 0x44, 0x7e, 0x91, 0x33, 0x49, 0x53, 0x46,
-	0x21, 0xf3, 0x21, 0xe4, 0x19, 0x31, 0x3f, 0x44, 0xef, 0x40, 0xca, 0xc3, 0x69, 0x90, 0xe4, 0xf1,
-	0x04, 0x32, 0xdd, 0xb5, 0xe3, 0x6f, 0xea, 0xd8, 0x23, 0x1d, 0x5a, 0x32, 0x6a, 0x37, 0x19, 0x7d,
-	0x83, 0x50, 0x5c, 0x17, 0xd3, 0x8d, 0x37, 0x8e, 0x3e, 0x79, 0x71, 0x6a, 0xb7, 0xfe, 0x38, 0xb5,
-	0x3f, 0x28, 0x46, 0x23, 0xc8, 0xcc, 0xa5, 0xdc, 0x8b, 0xb1, 0x9c, 0xba, 0x27, 0x10, 0xe1, 0x70,
-	0xf1, 0x10, 0xc2, 0x57, 0xa7, 0xf6, 0xf5, 0x05, 0x8e, 0xd9, 0x03, 0x67, 0x79, 0xdc, 0xf1, 0x1b,
-	0xb5, 0x9c, 0x57, 0x6d, 0xb4, 0xbb, 0xbc, 0xa3, 0x87, 0xc0, 0x20, 0xd2, 0xc6, 0x28, 0x19, 0xdf,
-	0x45, 0xd7, 0x49, 0x11, 0xe3, 0x99, 0xbe, 0x10, 0x10, 0xa2, 0xbc, 0xac, 0x5e, 0x0d, 0x0c, 0x8a,
-	0xb8, 0x4a, 0x9e, 0x63, 0x46, 0xc9, 0x85, 0xe4, 0x42, 0x47, 0xaf, 0x06, 0xaa, 0xe4, 0xef, 0xea,
-	0xca, 0x94, 0x27, 0x01, 0x8e, 0xd5, 0x3c, 0xb4, 0xb2, 0xcd, 0xc3, 0x1d, 0xb7, 0x90, 0xe4, 0x2a,
-	0xb7, 0xb9, 0xa5, 0xdb, 0xdc, 0x63, 0x4e, 0x93, 0x23, 0x4f, 0x89, 0xfe, 0xe5, 0x4f, 0xfb, 0x76,
-	0x44, 0xe5, 0x34, 0x9f, 0xb8, 0x21, 0x8f, 0xbd, 0xd2, 0x9a, 0xc5, 0xcf, 0x3d, 0x41, 0x66, 0x9e,
-	0x32, 0x90, 0xd0, 0x07, 0x6a, 0x96, 0x94, 0x27, 0x03, 0xdd, 0xc3, 0xfc, 0xc1, 0x40, 0xdb, 0x50,
-	0xcf, 0x28, 0x10, 0x12, 0xcf, 0x80, 0x54, 0x04, 0xba, 0xff, 0x46, 0xe0, 0xee, 0x7f, 0x69, 0x7e,
-	0x73, 0xd9, 0x67, 0xac, 0xdb, 0x14, 0x14, 0x9c, 0xa7, 0xe8, 0xd6, 0x09, 0x0f, 0x67, 0xc3, 0x55,
-	0x9e, 0x3c, 0xe6, 0x49, 0x02, 0xa1, 0xe2, 0x6b, 0xbe, 0x8f, 0xd6, 0xd5, 0xff, 0x48, 0x79, 0xcd,
-	0xd0, 0x5e, 0x5b, 0x63, 0xfa, 0x94, 0x79, 0x80, 0xb6, 0x68, 0xe3, 0x64, 0x80, 0x8b, 0xa3, 0xe5,
-	0x5d, 0xdf, 0xa0, 0x97, 0xab, 0x3a, 0x77, 0xd0, 0xcd, 0xc7, 0x49, 0xca, 0x39, 0xfb, 0x7a, 0x4a,
-	0x25, 0x30, 0x2a, 0x24, 0x90, 0x11, 0xe7, 0x4c, 0x98, 0x3d, 0xd4, 0xa1, 0x44, 0x0d, 0xb5, 0xd3,
-	0xef, 0xfa, 0x6a, 0xe9, 0xfc, 0xd6, 0x41, 0xce, 0x31, 0x4f, 0x42, 0x48, 0x64, 0x86, 0xcb, 0xbc,
-	0xc7, 0x02, 0xb2, 0x11, 0x17, 0xf4, 0xa2, 0x37, 0x2e, 0x8f, 0xdb, 0x78, 0xc3, 0xb8, 0x6d, 0xb4,
-	0x99, 0x96, 0xc7, 0x95, 0x9e, 0xb6, 0xd6, 0x83, 0xaa, 0xd0, 0x90, 0x34, 0xc5, 0x76, 0x2e, 0x88,
-	0xfd, 0x1c, 0x5d, 0x13, 0x8b, 0x44, 0x4e, 0x41, 0xd2, 0x30, 0x50, 0xb1, 0x72, 0x48, 0x7b, 0xf5,
-	0xd3, 0x50, 0xbc, 0x39, 0xee, 0xb8, 0xca, 0x52, 0x77, 0x7b, 0xd4, 0x55, 0x4e, 0xf1, 0xdf, 0x15,
-	0xcd, 0xe0, 0x6a, 0xd3, 0x5d, 0x79, 0xdb, 0xa6, 0x5b, 0xfb, 0x3f, 0x4c, 0x77, 0xe7, 0x47, 0x03,
-	0xdd, 0x58, 0xf1, 0x72, 0x9a, 0x7b, 0x68, 0x67, 0x45, 0xf8, 0x11, 0x96, 0x74, 0x0e, 0xbd, 0x96,
-	0x69, 0x35, 0xdf, 0x87, 0x1a, 0x3e, 0x19, 0x8d, 0xa7, 0x38, 0x83, 0x9e, 0x61, 0xf6, 0xd1, 0x47,
-	0x2b, 0xf0, 0xa6, 0x7d, 0x8a, 0xcc, 0xf6, 0x6e, 0xf7, 0xd9, 0xcf, 0x56, 0xeb, 0x68, 0xf4, 0xe2,
-	0xcc, 0x32, 0x5e, 0x9e, 0x59, 0xc6, 0x5f, 0x67, 0x96, 0xf1, 0xd3, 0xb9, 0xd5, 0x7a, 0x79, 0x6e,
-	0xb5, 0x7e, 0x3f, 0xb7, 0x5a, 0xdf, 0xde, 0x6f, 0x28, 0x2c, 0x47, 0x7b, 0x8f, 0xe1, 0x89, 0xa8,
-	0x36, 0xde, 0xfc, 0xf0, 0xbe, 0xf7, 0x7d, 0xf3, 0x8b, 0xa8, 0x55, 0x4f, 0xd6, 0xf4, 0x77, 0xe6,
-	0xe3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xbf, 0xf2, 0xd5, 0x34, 0x07, 0x00, 0x00,
+	// 969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4b, 0x6f, 0x1b, 0x45,
+	0x1c, 0xf7, 0xda, 0x6e, 0xd3, 0x4c, 0xfa, 0x70, 0xb6, 0x51, 0xeb, 0x18, 0x65, 0x1d, 0x36, 0x48,
+	0xb5, 0x5a, 0x75, 0x57, 0x09, 0x52, 0x85, 0x7a, 0x73, 0x12, 0x90, 0x82, 0x42, 0xb0, 0xd6, 0x2d,
+	0x05, 0x2e, 0xab, 0xf1, 0xee, 0xdf, 0xeb, 0x91, 0x77, 0x77, 0xb6, 0x3b, 0xb3, 0x2e, 0xbe, 0x71,
+	0xe0, 0xd0, 0x23, 0x1f, 0xa1, 0x12, 0x37, 0xae, 0x48, 0x7c, 0x86, 0x1e, 0x2b, 0x71, 0x41, 0x1c,
+	0x02, 0x4a, 0x2e, 0x1c, 0x38, 0xf5, 0x13, 0xa0, 0x99, 0x7d, 0x78, 0xd3, 0x38, 0xe0, 0x82, 0x04,
+	0xa7, 0x9d, 0xf9, 0x3f, 0x7f, 0xff, 0xe7, 0x2c, 0xda, 0xa2, 0x2c, 0xa0, 0x8c, 0x30, 0x93, 0x25,
+	0x11, 0xc4, 0x43, 0x3f, 0x21, 0x6e, 0xe9, 0x68, 0x44, 0x31, 0xe5, 0x54, 0x55, 0x33, 0x21, 0x63,
+	0xc6, 0x69, 0xad, 0x79, 0xd4, 0xa3, 0x92, 0x6d, 0x8a, 0x53, 0x2a, 0xd9, 0xd2, 0x3c, 0x4a, 0x3d,
+	0x1f, 0x4c, 0x79, 0x1b, 0x24, 0x43, 0xd3, 0x4d, 0x62, 0xcc, 0x09, 0x0d, 0x33, 0x7e, 0xfb, 0x4d,
+	0x3e, 0x27, 0x01, 0x30, 0x8e, 0x83, 0x28, 0x37, 0xe0, 0x48, 0x5f, 0xe6, 0x00, 0x33, 0x30, 0x27,
+	0xdb, 0x03, 0xe0, 0x78, 0xdb, 0x74, 0x28, 0xc9, 0x0d, 0xac, 0xe7, 0x78, 0x7d, 0xea, 0x8c, 0x93,
+	0x48, 0x7e, 0x52, 0x96, 0x3e, 0x45, 0x37, 0xfa, 0x05, 0xbe, 0x2e, 0x63, 0xc0, 0xd5, 0x35, 0x74,
+	0xc9, 0x85, 0x90, 0x06, 0x4d, 0x65, 0x53, 0xe9, 0x2c, 0x5b, 0xe9, 0x45, 0xfd, 0x08, 0x21, 0x2c,
+	0xd8, 0x36, 0x9f, 0x46, 0xd0, 0xac, 0x6e, 0x2a, 0x9d, 0xeb, 0x3b, 0x77, 0x8c, 0xf3, 0x31, 0x1a,
+	0x6f, 0x98, 0x7b, 0x34, 0x8d, 0xc0, 0x5a, 0xc6, 0xf9, 0xf1, 0xe1, 0x95, 0xe7, 0x2f, 0xda, 0x95,
+	0xdf, 0x5f, 0xb4, 0x15, 0x7d, 0x8c, 0x36, 0x66, 0xb2, 0x07, 0x21, 0x87, 0x38, 0x00, 0x97, 0xe0,
+	0x78, 0xda, 0x75, 0x1c, 0x9a, 0x84, 0x17, 0x01, 0x59, 0x47, 0x57, 0x26, 0xd8, 0xb7, 0xb1, 0xeb,
+	0xc6, 0x12, 0xc6, 0xb2, 0xb5, 0x34, 0xc1, 0x7e, 0xd7, 0x75, 0x63, 0xc1, 0xf2, 0x70, 0xe2, 0x81,
+	0x4d, 0xdc, 0x66, 0x6d, 0x53, 0xe9, 0xd4, 0xad, 0x25, 0x79, 0x3f, 0x70, 0xf5, 0x1f, 0x14, 0xa4,
+	0x7d, 0xca, 0x02, 0xfa, 0xe1, 0xd3, 0x84, 0x4c, 0xb0, 0x0f, 0x21, 0xff, 0x24, 0xf1, 0x39, 0x89,
+	0x7c, 0x02, 0xb1, 0x05, 0x0e, 0x8d, 0x5d, 0xf5, 0x5d, 0x74, 0x15, 0x22, 0xea, 0x8c, 0xec, 0x30,
+	0x09, 0x06, 0x10, 0x4b, 0xaf, 0x35, 0x6b, 0x45, 0xd2, 0x8e, 0x24, 0x69, 0x86, 0xa8, 0x5a, 0x46,
+	0xf4, 0x39, 0x42, 0x41, 0x61, 0x4c, 0x3a, 0x5e, 0xde, 0xfd, 0xe0, 0xe5, 0x71, 0xbb, 0xf2, 0xcb,
+	0x71, 0xfb, 0x9d, 0xb4, 0x34, 0xcc, 0x1d, 0x1b, 0x84, 0x9a, 0x01, 0xe6, 0x23, 0xe3, 0x10, 0x3c,
+	0xec, 0x4c, 0xf7, 0xc1, 0x79, 0x7d, 0xdc, 0x5e, 0x9d, 0xe2, 0xc0, 0x7f, 0xa8, 0xcf, 0xd4, 0x75,
+	0xab, 0x64, 0x4b, 0x7f, 0x5d, 0x45, 0xad, 0x59, 0x8e, 0xf6, 0xc1, 0x07, 0x4f, 0x36, 0x46, 0x86,
+	0xf8, 0x1e, 0x5a, 0x75, 0x53, 0x1a, 0x8d, 0x65, 0x42, 0x80, 0xb1, 0x2c, 0x59, 0x8d, 0x82, 0xd1,
+	0x4d, 0xe9, 0x42, 0x78, 0x82, 0x7d, 0xe2, 0x9e, 0x11, 0x4e, 0xe3, 0x68, 0x14, 0x8c, 0x5c, 0xf8,
+	0x59, 0x61, 0x99, 0xd0, 0xd0, 0xc6, 0x81, 0xa8, 0x87, 0x8c, 0x6c, 0x65, 0x67, 0xdd, 0x48, 0x43,
+	0x32, 0x44, 0xb7, 0x19, 0x59, 0xb7, 0x19, 0x7b, 0x94, 0x84, 0xbb, 0xa6, 0x08, 0xfa, 0xfb, 0x5f,
+	0xdb, 0x77, 0x3c, 0xc2, 0x47, 0xc9, 0xc0, 0x70, 0x68, 0x60, 0x66, 0xad, 0x99, 0x7e, 0xee, 0x33,
+	0x77, 0x6c, 0x8a, 0x06, 0x62, 0x52, 0xa1, 0x40, 0x49, 0x68, 0xd8, 0x95, 0x3e, 0xd4, 0xaf, 0x15,
+	0xd4, 0x84, 0xa2, 0x46, 0x36, 0xe3, 0x78, 0x0c, 0x6e, 0x0e, 0xa0, 0xfe, 0x77, 0x00, 0xee, 0xbd,
+	0x8d, 0xf3, 0x5b, 0x33, 0x3f, 0x7d, 0xe9, 0x26, 0x85, 0xa0, 0xff, 0x58, 0x45, 0xb7, 0x3f, 0xcb,
+	0x13, 0xd2, 0x07, 0xde, 0x8f, 0x7c, 0xc2, 0x67, 0x19, 0x3f, 0x9f, 0x44, 0xe5, 0x82, 0x24, 0xf6,
+	0xd0, 0x6a, 0x14, 0xc3, 0x10, 0x62, 0x08, 0x1d, 0xb0, 0x9f, 0x01, 0xf1, 0x46, 0x3c, 0xcd, 0xf8,
+	0xee, 0xd6, 0x02, 0xed, 0x61, 0x35, 0x66, 0xda, 0x4f, 0xa4, 0xb2, 0x7a, 0xf8, 0x8f, 0xca, 0x52,
+	0x17, 0xce, 0xe6, 0xe4, 0xfa, 0x08, 0x35, 0x60, 0x38, 0x04, 0x87, 0x93, 0x49, 0x01, 0xaf, 0xbe,
+	0x38, 0xbc, 0x1b, 0x85, 0x72, 0x8a, 0x4e, 0x7f, 0x8a, 0xb6, 0x0e, 0xa9, 0x33, 0x3e, 0x98, 0x37,
+	0xcc, 0x7b, 0x34, 0x0c, 0x85, 0x30, 0x0d, 0xd5, 0xdb, 0x68, 0x49, 0x2c, 0x20, 0x31, 0xa4, 0x8a,
+	0x1c, 0xd2, 0xcb, 0xbe, 0xd4, 0x52, 0xb7, 0xd1, 0x1a, 0x29, 0x69, 0xda, 0x38, 0x55, 0xcd, 0x9a,
+	0xf4, 0x26, 0x39, 0x6f, 0x55, 0x67, 0x48, 0x4d, 0x5d, 0x5a, 0xe0, 0x00, 0x89, 0xf8, 0x23, 0x3a,
+	0x86, 0xbf, 0xf0, 0xb0, 0x8f, 0xae, 0xc5, 0xa9, 0xa0, 0xcd, 0x85, 0x64, 0xb3, 0xba, 0x58, 0xee,
+	0xae, 0xc6, 0x25, 0xf3, 0xfa, 0x1f, 0x0a, 0x52, 0x67, 0xb3, 0xd8, 0x0f, 0x71, 0xc4, 0x46, 0x94,
+	0x2f, 0xb2, 0x3f, 0xe6, 0x0e, 0x6c, 0xf5, 0x6d, 0x06, 0xb6, 0x76, 0x41, 0xaf, 0x7d, 0xf1, 0x6f,
+	0xc6, 0x26, 0x0d, 0xf2, 0xa2, 0x79, 0xb8, 0x8b, 0x6e, 0x3d, 0x0e, 0x23, 0x4a, 0xfd, 0x27, 0x23,
+	0xc2, 0xc1, 0x27, 0x8c, 0x83, 0xdb, 0xa3, 0xd4, 0x67, 0x6a, 0x03, 0xd5, 0x88, 0x2b, 0xfa, 0xbf,
+	0xd6, 0xa9, 0x5b, 0xe2, 0xa8, 0xff, 0x54, 0x43, 0xfa, 0x1e, 0x0d, 0x1d, 0x08, 0x79, 0x8c, 0x33,
+	0xb9, 0xc7, 0x0c, 0xe2, 0x1e, 0x65, 0xe4, 0xec, 0xe2, 0x5a, 0x7c, 0x8c, 0xda, 0x68, 0x25, 0xca,
+	0xd4, 0x45, 0x45, 0xab, 0xb2, 0xa2, 0x28, 0x27, 0x1d, 0xb8, 0xe5, 0x72, 0xd7, 0xce, 0x94, 0xfb,
+	0x63, 0x74, 0x9d, 0x4d, 0x43, 0x3e, 0x02, 0x4e, 0x1c, 0x5b, 0xd0, 0xb2, 0x54, 0x6c, 0x14, 0xef,
+	0x56, 0xfa, 0x20, 0x1a, 0xfd, 0x5c, 0x4a, 0x34, 0x53, 0x96, 0x8e, 0x6b, 0xac, 0x4c, 0x9c, 0xbf,
+	0x11, 0x2f, 0xfd, 0xdf, 0x1b, 0xf1, 0xf2, 0x7f, 0xb1, 0x11, 0xef, 0x7e, 0xa3, 0xa0, 0x9b, 0x73,
+	0x9e, 0x75, 0x75, 0x03, 0xad, 0xcf, 0x21, 0x1f, 0x61, 0xb1, 0x13, 0x1a, 0x15, 0x55, 0x43, 0xad,
+	0x39, 0xec, 0xc3, 0x5e, 0x7f, 0x84, 0x63, 0x68, 0x28, 0x6a, 0x07, 0xbd, 0x37, 0x87, 0x5f, 0x6e,
+	0x9f, 0x54, 0xb2, 0xda, 0xaa, 0x3f, 0xff, 0x4e, 0xab, 0xec, 0xf6, 0x5e, 0x9e, 0x68, 0xca, 0xab,
+	0x13, 0x4d, 0xf9, 0xed, 0x44, 0x53, 0xbe, 0x3d, 0xd5, 0x2a, 0xaf, 0x4e, 0xb5, 0xca, 0xcf, 0xa7,
+	0x5a, 0xe5, 0xcb, 0x07, 0xa5, 0x08, 0xb3, 0xd2, 0xde, 0xf7, 0xf1, 0x80, 0xe5, 0x17, 0x73, 0xb2,
+	0xf3, 0xc0, 0xfc, 0xaa, 0xfc, 0xbb, 0x26, 0xa3, 0x1e, 0x5c, 0x96, 0x3f, 0x41, 0xef, 0xff, 0x39,
+	0x00, 0x16, 0x81, 0x1f, 0x43, 0xd1, 0x09, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -757,6 +956,66 @@ func (m *SuperfluidDelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorSetSplitRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetSplitRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetSplitRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EffectiveWeight.Size()
+		i -= size
+		if _, err := m.EffectiveWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.DelegationAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PreferenceWeight.Size()
+		i -= size
+		if _, err := m.PreferenceWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LockIdIntermediaryAccountConnection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -792,6 +1051,96 @@ func (m *LockIdIntermediaryAccountConnection) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *LockIdReceiptToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockIdReceiptToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockIdReceiptToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReceiptToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UnpoolWhitelistedPools) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -813,20 +1162,20 @@ func (m *UnpoolWhitelistedPools) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.Ids) > 0 {
-		dAtA4 := make([]byte, len(m.Ids)*10)
-		var j3 int
+		dAtA7 := make([]byte, len(m.Ids)*10)
+		var j6 int
 		for _, num := range m.Ids {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j6++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintSuperfluid(dAtA, i, uint64(j3))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintSuperfluid(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -993,19 +1342,74 @@ func (m *SuperfluidDelegationRecord) Size() (n int) {
 	return n
 }
 
-func (m *LockIdIntermediaryAccountConnection) Size() (n int) {
+func (m *ValidatorSetSplitRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.LockId != 0 {
-		n += 1 + sovSuperfluid(uint64(m.LockId))
-	}
-	l = len(m.IntermediaryAccount)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovSuperfluid(uint64(l))
 	}
+	l = m.PreferenceWeight.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = m.EffectiveWeight.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *LockIdIntermediaryAccountConnection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = len(m.IntermediaryAccount)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	return n
+}
+
+func (m *LockIdReceiptToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = m.ReceiptToken.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *DelegationSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovSuperfluid(uint64(m.EpochNumber))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = m.EquivalentStakedAmount.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
 	return n
 }
 
@@ -1610,6 +2014,189 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorSetSplitRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetSplitRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetSplitRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferenceWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreferenceWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1711,6 +2298,274 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LockIdReceiptToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockIdReceiptToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockIdReceiptToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceiptToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentStakedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UnpoolWhitelistedPools) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1140,6 +1140,325 @@ func (m *MsgUnbondConvertAndStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnbondConvertAndStakeResponse proto.InternalMessageInfo
 
+// ===================== MsgSuperfluidDelegateToValidatorSet
+// MsgSuperfluidDelegateToValidatorSet splits the lock into one lock per
+// validator of the sender's validator set preference, proportionally to the
+// validator weights, and superfluid delegates each lock to its validator.
+type MsgSuperfluidDelegateToValidatorSet struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) Reset()         { *m = MsgSuperfluidDelegateToValidatorSet{} }
+func (m *MsgSuperfluidDelegateToValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidDelegateToValidatorSet) ProtoMessage()    {}
+func (*MsgSuperfluidDelegateToValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{20}
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.Merge(m, src)
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet proto.InternalMessageInfo
+
+func (m *MsgSuperfluidDelegateToValidatorSet) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type MsgSuperfluidDelegateToValidatorSetResponse struct {
+	// lock_ids are the IDs of the superfluid delegated locks, in the order of
+	// the validator set preference. The first one is the original lock.
+	LockIds []uint64 `protobuf:"varint,1,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Reset() {
+	*m = MsgSuperfluidDelegateToValidatorSetResponse{}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSuperfluidDelegateToValidatorSetResponse) ProtoMessage() {}
+func (*MsgSuperfluidDelegateToValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{21}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+// ===================== MsgSuperfluidRebalanceValidatorSet
+// MsgSuperfluidRebalanceValidatorSet superfluid undelegates the sender's
+// bonded locks of the denom that are delegated to validators holding more
+// than their validator set preference share. Once unbonded, the locks can be
+// superfluid delegated to the validator set again.
+type MsgSuperfluidRebalanceValidatorSet struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSet) Reset()         { *m = MsgSuperfluidRebalanceValidatorSet{} }
+func (m *MsgSuperfluidRebalanceValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRebalanceValidatorSet) ProtoMessage()    {}
+func (*MsgSuperfluidRebalanceValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{22}
+}
+func (m *MsgSuperfluidRebalanceValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRebalanceValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRebalanceValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRebalanceValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRebalanceValidatorSet.Merge(m, src)
+}
+func (m *MsgSuperfluidRebalanceValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRebalanceValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRebalanceValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRebalanceValidatorSet proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRebalanceValidatorSet) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgSuperfluidRebalanceValidatorSetResponse struct {
+	// undelegated_lock_ids are the IDs of the locks that were superfluid
+	// undelegated.
+	UndelegatedLockIds []uint64 `protobuf:"varint,1,rep,packed,name=undelegated_lock_ids,json=undelegatedLockIds,proto3" json:"undelegated_lock_ids,omitempty" yaml:"undelegated_lock_ids"`
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) Reset() {
+	*m = MsgSuperfluidRebalanceValidatorSetResponse{}
+}
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSuperfluidRebalanceValidatorSetResponse) ProtoMessage() {}
+func (*MsgSuperfluidRebalanceValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{23}
+}
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRebalanceValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRebalanceValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRebalanceValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRebalanceValidatorSetResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) GetUndelegatedLockIds() []uint64 {
+	if m != nil {
+		return m.UndelegatedLockIds
+	}
+	return nil
+}
+
+// ===================== MsgSuperfluidUndelegateValidatorSet
+// MsgSuperfluidUndelegateValidatorSet superfluid undelegates all the sender's
+// bonded locks of the denom, e.g. the locks split over the sender's validator
+// set preference by MsgSuperfluidDelegateToValidatorSet.
+type MsgSuperfluidUndelegateValidatorSet struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSet) Reset()         { *m = MsgSuperfluidUndelegateValidatorSet{} }
+func (m *MsgSuperfluidUndelegateValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidUndelegateValidatorSet) ProtoMessage()    {}
+func (*MsgSuperfluidUndelegateValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{24}
+}
+func (m *MsgSuperfluidUndelegateValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidUndelegateValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidUndelegateValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidUndelegateValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidUndelegateValidatorSet.Merge(m, src)
+}
+func (m *MsgSuperfluidUndelegateValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidUndelegateValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidUndelegateValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidUndelegateValidatorSet proto.InternalMessageInfo
+
+func (m *MsgSuperfluidUndelegateValidatorSet) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgSuperfluidUndelegateValidatorSetResponse struct {
+	// undelegated_lock_ids are the IDs of the locks that were superfluid
+	// undelegated, in ascending order.
+	UndelegatedLockIds []uint64 `protobuf:"varint,1,rep,packed,name=undelegated_lock_ids,json=undelegatedLockIds,proto3" json:"undelegated_lock_ids,omitempty" yaml:"undelegated_lock_ids"`
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) Reset() {
+	*m = MsgSuperfluidUndelegateValidatorSetResponse{}
+}
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSuperfluidUndelegateValidatorSetResponse) ProtoMessage() {}
+func (*MsgSuperfluidUndelegateValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{25}
+}
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidUndelegateValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidUndelegateValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidUndelegateValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidUndelegateValidatorSetResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) GetUndelegatedLockIds() []uint64 {
+	if m != nil {
+		return m.UndelegatedLockIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -1161,110 +1480,130 @@ func init() {
 	proto.RegisterType((*MsgAddToConcentratedLiquiditySuperfluidPositionResponse)(nil), "osmosis.superfluid.MsgAddToConcentratedLiquiditySuperfluidPositionResponse")
 	proto.RegisterType((*MsgUnbondConvertAndStake)(nil), "osmosis.superfluid.MsgUnbondConvertAndStake")
 	proto.RegisterType((*MsgUnbondConvertAndStakeResponse)(nil), "osmosis.superfluid.MsgUnbondConvertAndStakeResponse")
+	proto.RegisterType((*MsgSuperfluidDelegateToValidatorSet)(nil), "osmosis.superfluid.MsgSuperfluidDelegateToValidatorSet")
+	proto.RegisterType((*MsgSuperfluidDelegateToValidatorSetResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateToValidatorSetResponse")
+	proto.RegisterType((*MsgSuperfluidRebalanceValidatorSet)(nil), "osmosis.superfluid.MsgSuperfluidRebalanceValidatorSet")
+	proto.RegisterType((*MsgSuperfluidRebalanceValidatorSetResponse)(nil), "osmosis.superfluid.MsgSuperfluidRebalanceValidatorSetResponse")
+	proto.RegisterType((*MsgSuperfluidUndelegateValidatorSet)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateValidatorSet")
+	proto.RegisterType((*MsgSuperfluidUndelegateValidatorSetResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateValidatorSetResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 1556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x3d, 0x6c, 0xdb, 0x46,
-	0x14, 0x36, 0x25, 0xc7, 0x4e, 0xce, 0xb1, 0x63, 0xb3, 0x71, 0xac, 0x28, 0x89, 0xe4, 0xb0, 0x69,
-	0xeb, 0xfc, 0x88, 0xb4, 0x9c, 0x3f, 0x57, 0x1d, 0x1a, 0xcb, 0x42, 0x0b, 0x35, 0x36, 0x1a, 0x30,
-	0x0e, 0x0a, 0x74, 0x51, 0x29, 0xdd, 0x99, 0x66, 0x4d, 0xf2, 0x1c, 0xdd, 0xc9, 0xb1, 0xd1, 0xad,
-	0x43, 0x0b, 0x04, 0x6d, 0x11, 0x74, 0xe9, 0x54, 0x74, 0xe8, 0xd6, 0x29, 0x43, 0x81, 0x0e, 0x5d,
-	0x3a, 0x66, 0xcc, 0x58, 0xb4, 0x80, 0x53, 0x24, 0x43, 0xd0, 0xd5, 0x4b, 0xa7, 0x02, 0xc5, 0x91,
-	0x47, 0x8a, 0xa2, 0x49, 0x49, 0x54, 0xbc, 0x74, 0x49, 0xc4, 0xbb, 0x7b, 0x7f, 0xdf, 0x7b, 0xdf,
-	0x7b, 0x77, 0x06, 0x67, 0x30, 0xb1, 0x30, 0x31, 0x88, 0x42, 0x5a, 0x5b, 0xa8, 0xb9, 0x6e, 0xb6,
-	0x0c, 0xa8, 0xd0, 0x1d, 0x79, 0xab, 0x89, 0x29, 0x16, 0x45, 0xbe, 0x29, 0xb7, 0x37, 0xb3, 0x27,
-	0x75, 0xac, 0x63, 0x67, 0x5b, 0x61, 0xbf, 0xdc, 0x93, 0xd9, 0x29, 0xcd, 0x32, 0x6c, 0xac, 0x38,
-	0xff, 0xf2, 0xa5, 0x9c, 0x8e, 0xb1, 0x6e, 0x22, 0xc5, 0xf9, 0xaa, 0xb7, 0xd6, 0x15, 0xd8, 0x6a,
-	0x6a, 0xd4, 0xc0, 0xb6, 0xb7, 0xdf, 0x70, 0xb4, 0x2b, 0x75, 0x8d, 0x20, 0x65, 0xbb, 0x58, 0x47,
-	0x54, 0x2b, 0x2a, 0x0d, 0x6c, 0x78, 0xfb, 0xf9, 0xb0, 0x3c, 0x35, 0x2c, 0x44, 0xa8, 0x66, 0x6d,
-	0xf1, 0x03, 0xaf, 0x47, 0xb8, 0xde, 0xfe, 0xc9, 0x0f, 0xcd, 0x70, 0x2b, 0x16, 0xd1, 0x95, 0xed,
-	0x22, 0xfb, 0xcf, 0xdd, 0x90, 0x7e, 0x14, 0xc0, 0xf4, 0x2a, 0xd1, 0xef, 0xfa, 0x02, 0x15, 0x64,
-	0x22, 0x5d, 0xa3, 0x48, 0xbc, 0x08, 0x46, 0x08, 0xb2, 0x21, 0x6a, 0x66, 0x84, 0x59, 0x61, 0xee,
-	0x58, 0x79, 0x6a, 0x7f, 0x2f, 0x3f, 0xbe, 0xab, 0x59, 0x66, 0x49, 0x72, 0xd7, 0x25, 0x95, 0x1f,
-	0x10, 0x67, 0xc0, 0xa8, 0x89, 0x1b, 0x9b, 0x35, 0x03, 0x66, 0x52, 0xb3, 0xc2, 0xdc, 0xb0, 0x3a,
-	0xc2, 0x3e, 0xab, 0x50, 0x3c, 0x0d, 0x8e, 0x6e, 0x6b, 0x66, 0x4d, 0x83, 0xb0, 0x99, 0x49, 0x33,
-	0x2d, 0xea, 0xe8, 0xb6, 0x66, 0x2e, 0x41, 0xd8, 0x2c, 0x5d, 0xfe, 0xfc, 0xe5, 0xe3, 0x4b, 0x5c,
-	0xc1, 0xc3, 0x97, 0x8f, 0x2f, 0x45, 0x64, 0xa0, 0x00, 0xb9, 0x2f, 0x52, 0x1e, 0x9c, 0x8b, 0x74,
-	0x52, 0x45, 0x64, 0x0b, 0xdb, 0x04, 0x49, 0x5f, 0x09, 0x60, 0xa6, 0xe3, 0xc4, 0x3d, 0x1b, 0x1e,
-	0x62, 0x20, 0xa5, 0x42, 0xc8, 0xdb, 0x73, 0x11, 0xde, 0xb6, 0x7c, 0x93, 0xd2, 0x79, 0x90, 0x8f,
-	0xf1, 0xc6, 0xf7, 0xf8, 0xeb, 0x83, 0x1e, 0xd7, 0xb1, 0x0d, 0x57, 0x70, 0x63, 0xf3, 0x50, 0x3c,
-	0x96, 0x43, 0x1e, 0xe7, 0x22, 0x3d, 0x66, 0x26, 0x0b, 0x4c, 0x22, 0xc2, 0x65, 0xcf, 0x1d, 0xdf,
-	0xe5, 0xbf, 0x05, 0x70, 0x21, 0x26, 0xac, 0x25, 0xfb, 0x90, 0xfd, 0x17, 0xcb, 0x60, 0x98, 0xb1,
-	0xc0, 0x29, 0x9b, 0xb1, 0x85, 0xd3, 0xb2, 0x5b, 0xc0, 0x32, 0xa3, 0x89, 0xcc, 0x69, 0x22, 0x2f,
-	0x63, 0xc3, 0x2e, 0xbf, 0xf6, 0x64, 0x2f, 0x3f, 0xb4, 0xbf, 0x97, 0x1f, 0x73, 0x0d, 0x30, 0x21,
-	0x49, 0x75, 0x64, 0x4b, 0x6f, 0x87, 0x30, 0xb8, 0xd8, 0x35, 0x6b, 0x1d, 0x70, 0xbc, 0x0f, 0xae,
-	0xf4, 0x13, 0xaa, 0x87, 0x4d, 0x30, 0x0e, 0x21, 0x18, 0x87, 0xf4, 0xaf, 0x00, 0xce, 0xae, 0x12,
-	0x9d, 0x1d, 0x5e, 0xb2, 0xe1, 0xab, 0xf1, 0x4c, 0x03, 0x47, 0x58, 0x5c, 0x24, 0x93, 0x9a, 0x4d,
-	0x77, 0x07, 0x65, 0x9e, 0x81, 0xf2, 0xd3, 0xb3, 0xfc, 0x9c, 0x6e, 0xd0, 0x8d, 0x56, 0x5d, 0x6e,
-	0x60, 0x4b, 0xe1, 0x2d, 0xc0, 0xfd, 0xaf, 0x40, 0xe0, 0xa6, 0x42, 0x77, 0xb7, 0x10, 0x71, 0x04,
-	0x88, 0xea, 0x6a, 0xee, 0xc6, 0xd8, 0x6b, 0x21, 0x34, 0x2f, 0x78, 0x68, 0xb2, 0x48, 0x0b, 0x9a,
-	0x0d, 0x0b, 0x51, 0xd4, 0xbd, 0xe1, 0xd4, 0x4c, 0x6c, 0xf8, 0x3e, 0x80, 0x13, 0x20, 0x55, 0xad,
-	0x70, 0xec, 0x52, 0xd5, 0x8a, 0xf4, 0x6b, 0x0a, 0x28, 0xab, 0x44, 0x5f, 0x6e, 0x22, 0x8d, 0xa2,
-	0xf7, 0x5a, 0xa6, 0xa9, 0x6a, 0xb6, 0x8e, 0xee, 0x60, 0x62, 0xb0, 0xe6, 0xf9, 0xff, 0x86, 0x52,
-	0xbc, 0x0c, 0x46, 0xb7, 0x30, 0x36, 0x59, 0xb5, 0x0c, 0xb3, 0x88, 0xcb, 0xe2, 0xfe, 0x5e, 0x7e,
-	0xc2, 0xf5, 0x94, 0x6f, 0x48, 0xea, 0x08, 0xfb, 0x55, 0x85, 0xa5, 0x85, 0x10, 0xee, 0x92, 0x87,
-	0xfb, 0x7a, 0xcb, 0x34, 0x0b, 0x4d, 0x06, 0x8b, 0x8b, 0xfe, 0x7a, 0x1b, 0xf5, 0xfb, 0xe0, 0x66,
-	0x42, 0xf0, 0xfc, 0x44, 0x9c, 0x02, 0x6e, 0xe9, 0x56, 0x3a, 0x0a, 0xb9, 0x22, 0xe6, 0x00, 0xd8,
-	0xe2, 0x0a, 0xaa, 0x15, 0x4e, 0xd6, 0xc0, 0x0a, 0x9b, 0x24, 0x99, 0x55, 0xa2, 0xdf, 0xb3, 0xef,
-	0x60, 0x6c, 0x7e, 0xb4, 0x61, 0x50, 0x64, 0x1a, 0x84, 0x22, 0xc8, 0x3e, 0x93, 0x64, 0x26, 0x80,
-	0x4d, 0xaa, 0x27, 0x36, 0x4a, 0x08, 0x9b, 0xbc, 0x87, 0x4d, 0xcb, 0x66, 0x27, 0x0a, 0x0f, 0xda,
-	0x7e, 0x14, 0xd8, 0x82, 0xf4, 0x01, 0x98, 0x8d, 0x73, 0xd2, 0x47, 0xe0, 0x4d, 0x70, 0x02, 0xed,
-	0x18, 0x14, 0xc1, 0x1a, 0xa7, 0x34, 0xc9, 0x08, 0xb3, 0xe9, 0xb9, 0x61, 0x75, 0xdc, 0x5d, 0x5e,
-	0x71, 0x98, 0x4d, 0xa4, 0x9f, 0xd3, 0x60, 0xd1, 0x51, 0x66, 0xba, 0xd5, 0xbd, 0x6a, 0xe8, 0x4d,
-	0x8d, 0xa2, 0xbb, 0x1b, 0x5a, 0x13, 0x91, 0x35, 0xec, 0xe3, 0xbe, 0x8c, 0xed, 0x06, 0xb2, 0x29,
-	0xdb, 0x83, 0x5e, 0x0e, 0x12, 0x22, 0x12, 0xec, 0x91, 0xe9, 0x20, 0x22, 0x7c, 0x43, 0xf2, 0xfb,
-	0xa6, 0x0e, 0xa6, 0x88, 0xe3, 0x40, 0x8d, 0xe2, 0x9a, 0xe5, 0x7a, 0xd4, 0xbb, 0x89, 0xce, 0xf2,
-	0x26, 0x9a, 0xe1, 0x1e, 0x84, 0x35, 0x48, 0xea, 0x09, 0xc2, 0xc3, 0xe2, 0x51, 0x8a, 0x0f, 0x05,
-	0x30, 0x41, 0xf1, 0x26, 0xb2, 0x6b, 0xb8, 0x45, 0x6b, 0x16, 0xe3, 0xd2, 0x70, 0x2f, 0x2e, 0x55,
-	0xb9, 0x99, 0x69, 0xd7, 0x4c, 0xa7, 0xb8, 0x94, 0x88, 0x64, 0xc7, 0x1d, 0xe1, 0x0f, 0x5b, 0x74,
-	0xd5, 0xb0, 0x49, 0xe9, 0x52, 0xa8, 0x0e, 0xb2, 0xed, 0x3a, 0xf0, 0xbb, 0x93, 0x17, 0xca, 0xf7,
-	0x69, 0x70, 0x6b, 0xd0, 0xb4, 0xf9, 0x35, 0x52, 0x05, 0xa3, 0x9a, 0x85, 0x5b, 0x36, 0x9d, 0xe7,
-	0xf9, 0x53, 0x58, 0x68, 0x7f, 0xec, 0xe5, 0xa7, 0x5d, 0x7f, 0x09, 0xdc, 0x94, 0x0d, 0xac, 0x58,
-	0x1a, 0xdd, 0x90, 0xab, 0x36, 0x6d, 0x27, 0x8c, 0x4b, 0x49, 0xaa, 0x27, 0xdf, 0x56, 0x55, 0x74,
-	0xd2, 0x9b, 0x54, 0x55, 0xd1, 0x57, 0x55, 0x14, 0x4d, 0x30, 0x65, 0x1a, 0xf7, 0x5b, 0x06, 0x34,
-	0xe8, 0x6e, 0xad, 0xe1, 0xb0, 0x1f, 0xba, 0xbd, 0xa7, 0xfc, 0x2e, 0x57, 0x7a, 0xe6, 0xa0, 0xd2,
-	0x15, 0xa4, 0x6b, 0x8d, 0xdd, 0x0a, 0x6a, 0xb4, 0x0b, 0xe0, 0x80, 0x16, 0x49, 0x9d, 0xf4, 0xd7,
-	0xdc, 0xb6, 0x02, 0xc5, 0x7b, 0xe0, 0xd8, 0xa7, 0xd8, 0xb0, 0x6b, 0xec, 0x46, 0xea, 0xf4, 0xb1,
-	0xb1, 0x85, 0xac, 0xec, 0x5e, 0x57, 0x65, 0xef, 0xba, 0x2a, 0xaf, 0x79, 0xd7, 0xd5, 0xf2, 0x59,
-	0x9e, 0xfc, 0x49, 0xd7, 0x84, 0x2f, 0x2a, 0x3d, 0x7a, 0x96, 0x17, 0xd4, 0xa3, 0xec, 0x9b, 0x1d,
-	0x96, 0xbe, 0x49, 0x3b, 0x9d, 0x7f, 0x09, 0xc2, 0x35, 0x1c, 0xcc, 0xc1, 0x8a, 0x67, 0xbf, 0xdd,
-	0xbc, 0x7c, 0x36, 0xdd, 0x04, 0x63, 0x5e, 0x2b, 0xf2, 0x47, 0x70, 0xf9, 0xd4, 0xfe, 0x5e, 0x5e,
-	0xf4, 0x1a, 0x87, 0xbf, 0x29, 0x05, 0xba, 0x16, 0x0c, 0xd0, 0x30, 0xd5, 0x8b, 0x86, 0x35, 0xaf,
-	0xde, 0x21, 0x22, 0x46, 0x13, 0xc1, 0xf9, 0xde, 0xb4, 0x3a, 0x17, 0x55, 0xef, 0x9e, 0xb8, 0xa4,
-	0x8e, 0x3b, 0x0b, 0x15, 0xfe, 0x7d, 0xc0, 0x40, 0x91, 0x83, 0x3a, 0xa0, 0x81, 0x62, 0xc8, 0x40,
-	0xb1, 0x74, 0x3d, 0xc4, 0x92, 0x37, 0x3c, 0x96, 0x68, 0x10, 0x16, 0x28, 0x2e, 0x34, 0xcc, 0xe0,
-	0x08, 0xf7, 0x50, 0x92, 0xbe, 0x4b, 0x3b, 0xd3, 0x24, 0x49, 0x42, 0x7c, 0x9e, 0x0c, 0x9c, 0x98,
-	0x00, 0xc1, 0x52, 0x87, 0x47, 0xb0, 0xf4, 0x2b, 0x12, 0xec, 0x13, 0x30, 0x6e, 0xa3, 0x07, 0x35,
-	0x9f, 0x0a, 0x99, 0x23, 0x8e, 0xc2, 0x77, 0xfa, 0x23, 0xd7, 0x49, 0x57, 0x6d, 0x87, 0x06, 0x49,
-	0x3d, 0x6e, 0xa3, 0x07, 0x3e, 0x94, 0xc1, 0x66, 0x7f, 0xe0, 0x6a, 0x10, 0x6e, 0xf6, 0xd2, 0x2f,
-	0x69, 0x3e, 0x73, 0xd9, 0x7d, 0x74, 0x19, 0xdb, 0xdb, 0xa8, 0x49, 0xd9, 0x74, 0xa7, 0xda, 0x26,
-	0x0a, 0x6a, 0x12, 0x7a, 0x69, 0x4a, 0xc2, 0x83, 0x2e, 0xf7, 0x1a, 0x0d, 0x4c, 0x5a, 0x86, 0x5d,
-	0xd3, 0x2c, 0xca, 0x66, 0x07, 0x61, 0x6e, 0x38, 0x51, 0x1c, 0x2b, 0x2f, 0xf6, 0x82, 0x7c, 0xc6,
-	0x35, 0x16, 0x16, 0x97, 0xd4, 0x71, 0xcb, 0xb0, 0x97, 0x2c, 0xba, 0x86, 0xdd, 0xa8, 0xbe, 0x15,
-	0x82, 0x03, 0xae, 0xe1, 0xc6, 0xec, 0xa4, 0xa1, 0x2b, 0x51, 0x6e, 0xc7, 0x0d, 0x38, 0xae, 0x81,
-	0x0d, 0x9f, 0xb7, 0xfa, 0x1c, 0x3e, 0xed, 0x59, 0xc8, 0x21, 0x2f, 0xcd, 0x87, 0x88, 0x35, 0xdb,
-	0x1e, 0x3f, 0xce, 0x93, 0x82, 0x1b, 0x71, 0xaf, 0x69, 0x4e, 0x58, 0x5f, 0x08, 0xfc, 0x22, 0x12,
-	0x91, 0x39, 0x9f, 0x3c, 0x75, 0x30, 0x49, 0x31, 0x65, 0x58, 0x5b, 0xd4, 0x85, 0x03, 0xf2, 0x69,
-	0xd3, 0x2f, 0x9c, 0x61, 0x71, 0x49, 0x9d, 0x70, 0x96, 0x96, 0x2c, 0xea, 0x98, 0x82, 0x0b, 0xff,
-	0x8c, 0x81, 0xf4, 0x2a, 0xd1, 0xc5, 0x26, 0x10, 0xa3, 0x6e, 0xd4, 0xf2, 0xc1, 0xbf, 0x7d, 0xc8,
-	0x91, 0x4f, 0xf1, 0x6c, 0xb1, 0xef, 0xa3, 0x7e, 0x7c, 0x3b, 0xe0, 0x64, 0xe4, 0x8b, 0xfd, 0x72,
-	0x4f, 0x55, 0xed, 0xc3, 0xd9, 0xab, 0x09, 0x0e, 0xc7, 0x59, 0xf6, 0x5f, 0xae, 0xfd, 0x58, 0xf6,
-	0x0e, 0xf7, 0x65, 0xf9, 0xc0, 0x43, 0xf1, 0x07, 0x01, 0x9c, 0xef, 0xfd, 0x82, 0x5e, 0x4c, 0x10,
-	0x54, 0x87, 0x64, 0xf6, 0xd6, 0xa0, 0x92, 0xbe, 0x87, 0x5f, 0x0a, 0xe0, 0x74, 0xfc, 0x73, 0x75,
-	0x3e, 0x46, 0x7f, 0xac, 0x44, 0x76, 0x31, 0xa9, 0x84, 0xef, 0xc9, 0x6f, 0x02, 0xb8, 0x92, 0xe8,
-	0x01, 0xb8, 0x1c, 0x63, 0x2a, 0x89, 0x92, 0xec, 0xed, 0x43, 0x50, 0xe2, 0x87, 0xf0, 0x19, 0x98,
-	0x8e, 0x7e, 0x11, 0x5d, 0x89, 0xb1, 0x12, 0x79, 0x3a, 0x7b, 0x2d, 0xc9, 0x69, 0xdf, 0xf8, 0x9f,
-	0x02, 0xb8, 0x3e, 0xd8, 0xeb, 0x64, 0x25, 0xd6, 0xde, 0x00, 0xda, 0xb2, 0x6b, 0x87, 0xa9, 0xad,
-	0xa3, 0x3a, 0x12, 0x5d, 0x12, 0xe3, 0xaa, 0x23, 0x89, 0x92, 0xd8, 0xea, 0x18, 0xe8, 0x76, 0xe4,
-	0x54, 0x47, 0xd4, 0xec, 0x8e, 0xaf, 0x8e, 0x88, 0xd3, 0x5d, 0xaa, 0xa3, 0xcb, 0x74, 0x29, 0xdf,
-	0x79, 0xf2, 0x3c, 0x27, 0x3c, 0x7d, 0x9e, 0x13, 0xfe, 0x7a, 0x9e, 0x13, 0x1e, 0xbd, 0xc8, 0x0d,
-	0x3d, 0x7d, 0x91, 0x1b, 0xfa, 0xfd, 0x45, 0x6e, 0xe8, 0xe3, 0x1b, 0x81, 0x41, 0xc8, 0x35, 0x17,
-	0x4c, 0xad, 0x4e, 0xbc, 0x0f, 0x65, 0x7b, 0xe1, 0x86, 0xb2, 0xd3, 0xf1, 0xb7, 0x72, 0x36, 0x1c,
-	0xeb, 0x23, 0xce, 0xad, 0xff, 0xea, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x30, 0x80, 0x0a,
-	0x4e, 0x17, 0x00, 0x00,
+	// 1787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x41, 0x6c, 0x1c, 0x49,
+	0x15, 0x4d, 0xcf, 0x38, 0x71, 0x52, 0x89, 0x13, 0xa7, 0x37, 0xd9, 0x4c, 0x3a, 0x9b, 0x69, 0x6f,
+	0x6d, 0x58, 0xb2, 0x71, 0xa6, 0xdb, 0xe3, 0x64, 0xe3, 0x30, 0x48, 0xec, 0x7a, 0x3c, 0x02, 0x0d,
+	0xb1, 0x45, 0xe8, 0x38, 0x20, 0x21, 0xa1, 0xa1, 0x67, 0xaa, 0xd2, 0x69, 0xdc, 0xdd, 0xe5, 0x9d,
+	0xaa, 0x71, 0x12, 0x71, 0x00, 0x71, 0x00, 0x69, 0x05, 0x68, 0xc5, 0x05, 0x21, 0x81, 0x38, 0x70,
+	0x43, 0x08, 0xed, 0x61, 0x25, 0x0e, 0x5c, 0x38, 0xee, 0x71, 0xb9, 0x21, 0x90, 0x66, 0x51, 0x72,
+	0x58, 0x71, 0xf5, 0x1d, 0x09, 0x55, 0x77, 0x75, 0x4d, 0x4f, 0xbb, 0xdb, 0x33, 0x3d, 0xf1, 0x1e,
+	0xf6, 0x12, 0x4f, 0x57, 0xd5, 0xff, 0xff, 0xfd, 0x57, 0xff, 0xff, 0xfa, 0x55, 0x01, 0x57, 0x08,
+	0xf5, 0x09, 0x75, 0xa9, 0x49, 0x07, 0xbb, 0xb8, 0xff, 0xc8, 0x1b, 0xb8, 0xc8, 0x64, 0x4f, 0x8d,
+	0xdd, 0x3e, 0x61, 0x44, 0x55, 0xc5, 0xa4, 0x31, 0x9a, 0xd4, 0x2e, 0x38, 0xc4, 0x21, 0xe1, 0xb4,
+	0xc9, 0x7f, 0x45, 0x2b, 0xb5, 0xf3, 0xb6, 0xef, 0x06, 0xc4, 0x0c, 0xff, 0x15, 0x43, 0x55, 0x87,
+	0x10, 0xc7, 0xc3, 0x66, 0xf8, 0xd5, 0x1d, 0x3c, 0x32, 0xd1, 0xa0, 0x6f, 0x33, 0x97, 0x04, 0xf1,
+	0x7c, 0x2f, 0xd4, 0x6e, 0x76, 0x6d, 0x8a, 0xcd, 0xbd, 0x7a, 0x17, 0x33, 0xbb, 0x6e, 0xf6, 0x88,
+	0x1b, 0xcf, 0xeb, 0x69, 0x79, 0xe6, 0xfa, 0x98, 0x32, 0xdb, 0xdf, 0x15, 0x0b, 0xde, 0xc8, 0x80,
+	0x3e, 0xfa, 0x29, 0x16, 0x5d, 0x12, 0x56, 0x7c, 0xea, 0x98, 0x7b, 0x75, 0xfe, 0x27, 0x9a, 0x80,
+	0x7f, 0x54, 0xc0, 0xc5, 0x2d, 0xea, 0x3c, 0x90, 0x02, 0x2d, 0xec, 0x61, 0xc7, 0x66, 0x58, 0x7d,
+	0x0b, 0x9c, 0xa0, 0x38, 0x40, 0xb8, 0x5f, 0x51, 0x96, 0x94, 0xeb, 0xa7, 0x9a, 0xe7, 0xf7, 0x87,
+	0xfa, 0xc2, 0x33, 0xdb, 0xf7, 0x1a, 0x30, 0x1a, 0x87, 0x96, 0x58, 0xa0, 0x5e, 0x02, 0xf3, 0x1e,
+	0xe9, 0xed, 0x74, 0x5c, 0x54, 0x29, 0x2d, 0x29, 0xd7, 0xe7, 0xac, 0x13, 0xfc, 0xb3, 0x8d, 0xd4,
+	0xcb, 0xe0, 0xe4, 0x9e, 0xed, 0x75, 0x6c, 0x84, 0xfa, 0x95, 0x32, 0xd7, 0x62, 0xcd, 0xef, 0xd9,
+	0xde, 0x3a, 0x42, 0xfd, 0xc6, 0xf2, 0x4f, 0x3f, 0xfb, 0xf0, 0x86, 0x50, 0xf0, 0xfe, 0x67, 0x1f,
+	0xde, 0xc8, 0xd8, 0x81, 0x1a, 0x12, 0x58, 0xa0, 0x0e, 0xae, 0x66, 0x82, 0xb4, 0x30, 0xdd, 0x25,
+	0x01, 0xc5, 0xf0, 0x17, 0x0a, 0xb8, 0x34, 0xb6, 0xe2, 0x61, 0x80, 0x8e, 0xd0, 0x91, 0x46, 0x2d,
+	0x85, 0xf6, 0x6a, 0x06, 0xda, 0x81, 0x34, 0x09, 0x5f, 0x07, 0x7a, 0x0e, 0x1a, 0x89, 0xf8, 0x97,
+	0x07, 0x11, 0x77, 0x49, 0x80, 0x36, 0x49, 0x6f, 0xe7, 0x48, 0x10, 0x1b, 0x29, 0xc4, 0xd5, 0x4c,
+	0xc4, 0xdc, 0x64, 0x8d, 0x4b, 0x64, 0x40, 0x8e, 0xe1, 0x48, 0xc8, 0xff, 0x55, 0xc0, 0xb5, 0x1c,
+	0xb7, 0xd6, 0x83, 0x23, 0xc6, 0xaf, 0x36, 0xc1, 0x1c, 0xcf, 0x82, 0x30, 0x6c, 0x4e, 0xaf, 0x5e,
+	0x36, 0xa2, 0x00, 0x36, 0x78, 0x9a, 0x18, 0x22, 0x4d, 0x8c, 0x0d, 0xe2, 0x06, 0xcd, 0x57, 0x3e,
+	0x1e, 0xea, 0xc7, 0xf6, 0x87, 0xfa, 0xe9, 0xc8, 0x00, 0x17, 0x82, 0x56, 0x28, 0xdb, 0xf8, 0x4a,
+	0x8a, 0x83, 0xb7, 0x0e, 0xdd, 0xb5, 0x31, 0x3a, 0xbe, 0x01, 0x6e, 0x4e, 0xe3, 0x6a, 0xcc, 0x4d,
+	0xd2, 0x0f, 0x25, 0xe9, 0x07, 0xfc, 0x9f, 0x02, 0x5e, 0xdb, 0xa2, 0x0e, 0x5f, 0xbc, 0x1e, 0xa0,
+	0x97, 0xcb, 0x33, 0x1b, 0x1c, 0xe7, 0x7e, 0xd1, 0x4a, 0x69, 0xa9, 0x7c, 0x38, 0x29, 0x2b, 0x9c,
+	0x94, 0x3f, 0x7d, 0xaa, 0x5f, 0x77, 0x5c, 0xf6, 0x78, 0xd0, 0x35, 0x7a, 0xc4, 0x37, 0x45, 0x09,
+	0x88, 0xfe, 0xd4, 0x28, 0xda, 0x31, 0xd9, 0xb3, 0x5d, 0x4c, 0x43, 0x01, 0x6a, 0x45, 0x9a, 0x0f,
+	0xcb, 0xd8, 0xdb, 0x29, 0x36, 0xaf, 0xc5, 0x6c, 0x72, 0x4f, 0x6b, 0x76, 0x80, 0x6a, 0x59, 0xa9,
+	0x7b, 0x07, 0x5c, 0x3b, 0xcc, 0x7d, 0x49, 0xe0, 0x59, 0x50, 0x6a, 0xb7, 0x04, 0x77, 0xa5, 0x76,
+	0x0b, 0xfe, 0xad, 0x04, 0xcc, 0x2d, 0xea, 0x6c, 0xf4, 0xb1, 0xcd, 0xf0, 0xd7, 0x07, 0x9e, 0x67,
+	0xd9, 0x81, 0x83, 0xef, 0x13, 0xea, 0xf2, 0xe2, 0xf9, 0xc5, 0xa6, 0x52, 0x5d, 0x06, 0xf3, 0xbb,
+	0x84, 0x78, 0x3c, 0x5a, 0xe6, 0xb8, 0xc7, 0x4d, 0x75, 0x7f, 0xa8, 0x9f, 0x8d, 0x90, 0x8a, 0x09,
+	0x68, 0x9d, 0xe0, 0xbf, 0xda, 0xa8, 0xb1, 0x9a, 0xe2, 0x1d, 0xc6, 0xbc, 0x3f, 0x1a, 0x78, 0x5e,
+	0xad, 0xcf, 0x69, 0x89, 0xd8, 0x7f, 0x34, 0x62, 0xfd, 0x3d, 0xb0, 0x56, 0x90, 0x3c, 0xb9, 0x11,
+	0xaf, 0x82, 0x28, 0x74, 0x5b, 0x63, 0x81, 0xdc, 0x52, 0xab, 0x00, 0xec, 0x0a, 0x05, 0xed, 0x96,
+	0x48, 0xd6, 0xc4, 0x08, 0x3f, 0x49, 0x2a, 0x5b, 0xd4, 0x79, 0x18, 0xdc, 0x27, 0xc4, 0xfb, 0xee,
+	0x63, 0x97, 0x61, 0xcf, 0xa5, 0x0c, 0x23, 0xfe, 0x59, 0x64, 0x67, 0x12, 0xdc, 0x94, 0x26, 0x72,
+	0x63, 0xa6, 0xb8, 0xd1, 0x63, 0x6e, 0x06, 0x01, 0x5f, 0x51, 0x7b, 0x32, 0xc2, 0x51, 0xe3, 0x03,
+	0xf0, 0x9b, 0x60, 0x29, 0x0f, 0xa4, 0x64, 0xe0, 0x4d, 0x70, 0x0e, 0x3f, 0x75, 0x19, 0x46, 0x1d,
+	0x91, 0xd2, 0xb4, 0xa2, 0x2c, 0x95, 0xaf, 0xcf, 0x59, 0x0b, 0xd1, 0xf0, 0x66, 0x98, 0xd9, 0x14,
+	0x7e, 0x54, 0x06, 0x77, 0x43, 0x65, 0x5e, 0x14, 0xdd, 0x5b, 0xae, 0xd3, 0xb7, 0x19, 0x7e, 0xf0,
+	0xd8, 0xee, 0x63, 0xba, 0x4d, 0x24, 0xef, 0x1b, 0x24, 0xe8, 0xe1, 0x80, 0xf1, 0x39, 0x14, 0xef,
+	0x41, 0x41, 0x46, 0x92, 0x35, 0xb2, 0x9c, 0x64, 0x44, 0x4c, 0x40, 0x59, 0x37, 0x1d, 0x70, 0x9e,
+	0x86, 0x00, 0x3a, 0x8c, 0x74, 0xfc, 0x08, 0xd1, 0xe4, 0x22, 0xba, 0x24, 0x8a, 0x68, 0x45, 0x20,
+	0x48, 0x6b, 0x80, 0xd6, 0x39, 0x2a, 0xdc, 0x12, 0x5e, 0xaa, 0xef, 0x2b, 0xe0, 0x2c, 0x23, 0x3b,
+	0x38, 0xe8, 0x90, 0x01, 0xeb, 0xf8, 0x3c, 0x97, 0xe6, 0x26, 0xe5, 0x52, 0x5b, 0x98, 0xb9, 0x18,
+	0x99, 0x19, 0x17, 0x87, 0x85, 0x92, 0xec, 0x4c, 0x28, 0xfc, 0xad, 0x01, 0xdb, 0x72, 0x03, 0xda,
+	0xb8, 0x91, 0x8a, 0x03, 0x6d, 0x14, 0x07, 0xb2, 0x3a, 0xc5, 0xae, 0xfc, 0xbe, 0x0c, 0xde, 0x9d,
+	0x75, 0xdb, 0x64, 0x8c, 0xb4, 0xc1, 0xbc, 0xed, 0x93, 0x41, 0xc0, 0x56, 0xc4, 0xfe, 0x99, 0xdc,
+	0xb5, 0x7f, 0x0d, 0xf5, 0x8b, 0x11, 0x5e, 0x8a, 0x76, 0x0c, 0x97, 0x98, 0xbe, 0xcd, 0x1e, 0x1b,
+	0xed, 0x80, 0x8d, 0x36, 0x4c, 0x48, 0x41, 0x2b, 0x96, 0x1f, 0xa9, 0xaa, 0x57, 0x4a, 0x33, 0xa8,
+	0xaa, 0x4b, 0x55, 0x75, 0xd5, 0x03, 0xe7, 0x3d, 0xf7, 0xbd, 0x81, 0x8b, 0x5c, 0xf6, 0xac, 0xd3,
+	0x0b, 0xb3, 0x1f, 0x45, 0xb5, 0xa7, 0xf9, 0x8e, 0x50, 0x7a, 0xe5, 0xa0, 0xd2, 0x4d, 0xec, 0xd8,
+	0xbd, 0x67, 0x2d, 0xdc, 0x1b, 0x05, 0xc0, 0x01, 0x2d, 0xd0, 0x5a, 0x94, 0x63, 0x51, 0x59, 0x41,
+	0xea, 0x43, 0x70, 0xea, 0x87, 0xc4, 0x0d, 0x3a, 0xbc, 0x23, 0x0d, 0xeb, 0xd8, 0xe9, 0x55, 0xcd,
+	0x88, 0xda, 0x55, 0x23, 0x6e, 0x57, 0x8d, 0xed, 0xb8, 0x5d, 0x6d, 0xbe, 0x26, 0x36, 0x7f, 0x31,
+	0x32, 0x21, 0x45, 0xe1, 0x07, 0x9f, 0xea, 0x8a, 0x75, 0x92, 0x7f, 0xf3, 0xc5, 0xf0, 0x57, 0xe5,
+	0xb0, 0xf2, 0xaf, 0x23, 0xb4, 0x4d, 0x92, 0x7b, 0xb0, 0x19, 0xdb, 0x1f, 0x15, 0x2f, 0x99, 0x4d,
+	0x6b, 0xe0, 0x74, 0x5c, 0x8a, 0xe4, 0x11, 0xdc, 0x7c, 0x75, 0x7f, 0xa8, 0xab, 0x71, 0xe1, 0x90,
+	0x93, 0x30, 0x51, 0xb5, 0x50, 0x22, 0x0d, 0x4b, 0x93, 0xd2, 0xb0, 0x13, 0xc7, 0x3b, 0xc2, 0xd4,
+	0xed, 0x63, 0xb4, 0x32, 0x39, 0xad, 0xae, 0x66, 0xc5, 0x7b, 0x2c, 0x0e, 0xad, 0x85, 0x70, 0xa0,
+	0x25, 0xbe, 0x0f, 0x18, 0xa8, 0x57, 0xe6, 0x5e, 0xc6, 0x40, 0x3d, 0x65, 0xa0, 0xde, 0x78, 0x3b,
+	0x95, 0x25, 0x5f, 0x8a, 0xb3, 0xc4, 0x46, 0xa8, 0xc6, 0x48, 0xad, 0xe7, 0x25, 0x8f, 0xf0, 0x98,
+	0x25, 0xf8, 0x9b, 0x32, 0x58, 0x2b, 0xb8, 0x21, 0x32, 0x4f, 0x66, 0xde, 0x98, 0x44, 0x82, 0x95,
+	0x8e, 0x2e, 0xc1, 0xca, 0x2f, 0x99, 0x60, 0x3f, 0x00, 0x0b, 0x01, 0x7e, 0xd2, 0x91, 0xa9, 0x50,
+	0x39, 0x1e, 0x2a, 0xfc, 0xea, 0x74, 0xc9, 0x75, 0x21, 0x52, 0x3b, 0xa6, 0x01, 0x5a, 0x67, 0x02,
+	0xfc, 0x44, 0x52, 0x99, 0x2c, 0xf6, 0x07, 0x5a, 0x83, 0x74, 0xb1, 0x87, 0x7f, 0x2d, 0x8b, 0x33,
+	0x97, 0xf7, 0xa3, 0x1b, 0x24, 0xd8, 0xc3, 0x7d, 0xc6, 0x4f, 0x77, 0x66, 0xef, 0xe0, 0xa4, 0x26,
+	0x65, 0x92, 0xa6, 0x22, 0x79, 0x70, 0x48, 0x5f, 0x63, 0x83, 0x45, 0xdf, 0x0d, 0x3a, 0xb6, 0xcf,
+	0xf8, 0xd9, 0x41, 0x39, 0x8c, 0xd0, 0x8b, 0x53, 0xcd, 0xbb, 0x93, 0x28, 0xbf, 0x14, 0x19, 0x4b,
+	0x8b, 0x43, 0x6b, 0xc1, 0x77, 0x83, 0x75, 0x9f, 0x6d, 0x93, 0xc8, 0xab, 0x5f, 0x2b, 0xc9, 0x03,
+	0xae, 0x17, 0xf9, 0x5c, 0x39, 0x3e, 0x29, 0x51, 0xee, 0xe5, 0x1d, 0x70, 0x42, 0x03, 0x3f, 0x7c,
+	0xbe, 0x3c, 0xe5, 0xe1, 0x33, 0x3a, 0x0b, 0x05, 0xe5, 0x8d, 0x95, 0x54, 0x62, 0x2d, 0x8d, 0x8e,
+	0x9f, 0xf0, 0x4a, 0x21, 0x8c, 0x44, 0x6d, 0x5a, 0xe8, 0xd6, 0xcf, 0x14, 0xd1, 0x88, 0x64, 0xec,
+	0x9c, 0x4c, 0x9e, 0x2e, 0x58, 0x64, 0x84, 0x71, 0xae, 0x7d, 0x16, 0xd1, 0x81, 0x2a, 0x4a, 0x21,
+	0x3a, 0xd3, 0xe2, 0xd0, 0x3a, 0x1b, 0x0e, 0xad, 0xfb, 0xec, 0x41, 0x34, 0xf0, 0x91, 0x02, 0xde,
+	0xc8, 0xbc, 0x5b, 0x6f, 0x93, 0xef, 0xd8, 0x9e, 0x8b, 0x6c, 0x46, 0xfa, 0x0f, 0x30, 0x7b, 0x89,
+	0x7e, 0xe5, 0xd0, 0xc0, 0xcb, 0xaf, 0x49, 0x19, 0x97, 0x09, 0x5e, 0xa3, 0xf6, 0x6c, 0x8f, 0x62,
+	0x06, 0xbf, 0x0f, 0x96, 0xa7, 0x40, 0x2d, 0x99, 0x34, 0xc0, 0xc9, 0xf1, 0x5e, 0xae, 0xf9, 0xca,
+	0xfe, 0x50, 0x3f, 0x37, 0x86, 0x89, 0x42, 0x6b, 0xde, 0x13, 0xad, 0xdd, 0x9f, 0x15, 0x00, 0xc7,
+	0xf4, 0x5b, 0xb8, 0x6b, 0x7b, 0x76, 0xd0, 0xc3, 0xb3, 0x92, 0xf2, 0x26, 0x38, 0x8e, 0x70, 0x40,
+	0x7c, 0x91, 0x5f, 0x8b, 0xfb, 0x43, 0xfd, 0x4c, 0xb4, 0x32, 0x1c, 0x86, 0x56, 0x34, 0xdd, 0xb8,
+	0x95, 0xe2, 0x23, 0xe3, 0x79, 0xa7, 0xd6, 0x8f, 0xc1, 0xc4, 0x6c, 0xfc, 0x18, 0xdc, 0x98, 0x8c,
+	0x56, 0x92, 0xf1, 0x6d, 0x70, 0x61, 0x74, 0xeb, 0x4d, 0x37, 0xb9, 0x4d, 0x7d, 0x7f, 0xa8, 0x5f,
+	0x89, 0x90, 0x65, 0xad, 0x82, 0x96, 0x9a, 0x18, 0x8e, 0x5b, 0xe1, 0xbf, 0xa4, 0xa3, 0x68, 0x74,
+	0x5f, 0xfe, 0xbc, 0x09, 0xcb, 0xbd, 0x96, 0x66, 0x5f, 0xf2, 0x05, 0x63, 0x3f, 0x51, 0xc0, 0xf2,
+	0x14, 0x80, 0x3f, 0x47, 0xce, 0x56, 0xff, 0x71, 0x0e, 0x94, 0xb7, 0xa8, 0xa3, 0xf6, 0x81, 0x9a,
+	0x75, 0x97, 0x35, 0x0e, 0xbe, 0x3a, 0x1a, 0x99, 0x21, 0xaf, 0xd5, 0xa7, 0x5e, 0x2a, 0xdd, 0x79,
+	0x0a, 0x2e, 0x64, 0xbe, 0x95, 0x2d, 0x4f, 0x54, 0x35, 0x5a, 0xac, 0xdd, 0x2a, 0xb0, 0x38, 0xcf,
+	0xb2, 0x7c, 0x33, 0x9a, 0xc6, 0x72, 0xbc, 0x58, 0xbb, 0x55, 0x60, 0xb1, 0xb4, 0xfc, 0x07, 0x05,
+	0xbc, 0x3e, 0xf9, 0xed, 0xea, 0x6e, 0x01, 0xa7, 0xc6, 0x24, 0xb5, 0x77, 0x67, 0x95, 0x94, 0x08,
+	0x7f, 0xae, 0x80, 0xcb, 0xf9, 0x0f, 0x45, 0x2b, 0x39, 0xfa, 0x73, 0x25, 0xb4, 0xbb, 0x45, 0x25,
+	0x24, 0x92, 0xbf, 0x2b, 0xe0, 0x66, 0xa1, 0xa7, 0x97, 0x8d, 0x1c, 0x53, 0x45, 0x94, 0x68, 0xf7,
+	0x8e, 0x40, 0x89, 0x74, 0xe1, 0x47, 0xe0, 0x62, 0xf6, 0x5b, 0xc4, 0xcd, 0x1c, 0x2b, 0x99, 0xab,
+	0xb5, 0xdb, 0x45, 0x56, 0x4b, 0xe3, 0xff, 0x56, 0xc0, 0xdb, 0xb3, 0xbd, 0x0b, 0x6c, 0xe6, 0xda,
+	0x9b, 0x41, 0x9b, 0xb6, 0x7d, 0x94, 0xda, 0xc6, 0xa2, 0xa3, 0xd0, 0xf5, 0x2c, 0x2f, 0x3a, 0x8a,
+	0x28, 0xd1, 0xee, 0x1d, 0x81, 0x92, 0xf1, 0xe8, 0xc8, 0xea, 0x9a, 0xf3, 0xa3, 0x23, 0x63, 0xb5,
+	0x76, 0xbb, 0xc8, 0x6a, 0x69, 0xfc, 0x77, 0x0a, 0x58, 0x9a, 0xd8, 0x70, 0xad, 0x4d, 0x5d, 0xd5,
+	0xc7, 0x05, 0xb5, 0x77, 0x66, 0x14, 0x94, 0xf0, 0x7e, 0xab, 0x00, 0x7d, 0x52, 0xe7, 0x73, 0x67,
+	0xa2, 0x91, 0x4c, 0x39, 0xed, 0x6b, 0xb3, 0xc9, 0xe5, 0x50, 0x97, 0xd3, 0x65, 0xac, 0x15, 0xa8,
+	0xc4, 0x05, 0xa9, 0x3b, 0xbc, 0x4d, 0x68, 0xde, 0xff, 0xf8, 0x79, 0x55, 0xf9, 0xe4, 0x79, 0x55,
+	0xf9, 0xcf, 0xf3, 0xaa, 0xf2, 0xc1, 0x8b, 0xea, 0xb1, 0x4f, 0x5e, 0x54, 0x8f, 0xfd, 0xf3, 0x45,
+	0xf5, 0xd8, 0xf7, 0xee, 0x24, 0x2e, 0x17, 0xc2, 0x48, 0xcd, 0xb3, 0xbb, 0x34, 0xfe, 0x30, 0xf7,
+	0x56, 0xef, 0x98, 0x4f, 0xc7, 0xfe, 0xff, 0x91, 0x5f, 0x38, 0xba, 0x27, 0xc2, 0x97, 0x94, 0x5b,
+	0xff, 0x1f, 0x00, 0x8c, 0x52, 0xf4, 0xc7, 0xa2, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1297,6 +1636,16 @@ type MsgClient interface {
 	// UnbondConvertAndStake breaks all locks / superfluid staked assets,
 	// converts them to osmo then stakes the osmo to the designated validator.
 	UnbondConvertAndStake(ctx context.Context, in *MsgUnbondConvertAndStake, opts ...grpc.CallOption) (*MsgUnbondConvertAndStakeResponse, error)
+	// SuperfluidDelegateToValidatorSet splits a lock by the sender's validator
+	// set preference and superfluid delegates each part to its validator.
+	SuperfluidDelegateToValidatorSet(ctx context.Context, in *MsgSuperfluidDelegateToValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidDelegateToValidatorSetResponse, error)
+	// SuperfluidRebalanceValidatorSet superfluid undelegates the sender's locks
+	// of a denom that are delegated beyond the sender's validator set
+	// preference.
+	SuperfluidRebalanceValidatorSet(ctx context.Context, in *MsgSuperfluidRebalanceValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidRebalanceValidatorSetResponse, error)
+	// SuperfluidUndelegateValidatorSet superfluid undelegates all the sender's
+	// bonded locks of a denom, across the validators they are delegated to.
+	SuperfluidUndelegateValidatorSet(ctx context.Context, in *MsgSuperfluidUndelegateValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateValidatorSetResponse, error)
 }
 
 type msgClient struct {
@@ -1397,6 +1746,33 @@ func (c *msgClient) UnbondConvertAndStake(ctx context.Context, in *MsgUnbondConv
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidDelegateToValidatorSet(ctx context.Context, in *MsgSuperfluidDelegateToValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	out := new(MsgSuperfluidDelegateToValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidDelegateToValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuperfluidRebalanceValidatorSet(ctx context.Context, in *MsgSuperfluidRebalanceValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidRebalanceValidatorSetResponse, error) {
+	out := new(MsgSuperfluidRebalanceValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidRebalanceValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuperfluidUndelegateValidatorSet(ctx context.Context, in *MsgSuperfluidUndelegateValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateValidatorSetResponse, error) {
+	out := new(MsgSuperfluidUndelegateValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUndelegateValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Execute superfluid delegation for a lockup
@@ -1417,6 +1793,16 @@ type MsgServer interface {
 	// UnbondConvertAndStake breaks all locks / superfluid staked assets,
 	// converts them to osmo then stakes the osmo to the designated validator.
 	UnbondConvertAndStake(context.Context, *MsgUnbondConvertAndStake) (*MsgUnbondConvertAndStakeResponse, error)
+	// SuperfluidDelegateToValidatorSet splits a lock by the sender's validator
+	// set preference and superfluid delegates each part to its validator.
+	SuperfluidDelegateToValidatorSet(context.Context, *MsgSuperfluidDelegateToValidatorSet) (*MsgSuperfluidDelegateToValidatorSetResponse, error)
+	// SuperfluidRebalanceValidatorSet superfluid undelegates the sender's locks
+	// of a denom that are delegated beyond the sender's validator set
+	// preference.
+	SuperfluidRebalanceValidatorSet(context.Context, *MsgSuperfluidRebalanceValidatorSet) (*MsgSuperfluidRebalanceValidatorSetResponse, error)
+	// SuperfluidUndelegateValidatorSet superfluid undelegates all the sender's
+	// bonded locks of a denom, across the validators they are delegated to.
+	SuperfluidUndelegateValidatorSet(context.Context, *MsgSuperfluidUndelegateValidatorSet) (*MsgSuperfluidUndelegateValidatorSetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1453,6 +1839,15 @@ func (*UnimplementedMsgServer) AddToConcentratedLiquiditySuperfluidPosition(ctx
 func (*UnimplementedMsgServer) UnbondConvertAndStake(ctx context.Context, req *MsgUnbondConvertAndStake) (*MsgUnbondConvertAndStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnbondConvertAndStake not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidDelegateToValidatorSet(ctx context.Context, req *MsgSuperfluidDelegateToValidatorSet) (*MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegateToValidatorSet not implemented")
+}
+func (*UnimplementedMsgServer) SuperfluidRebalanceValidatorSet(ctx context.Context, req *MsgSuperfluidRebalanceValidatorSet) (*MsgSuperfluidRebalanceValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRebalanceValidatorSet not implemented")
+}
+func (*UnimplementedMsgServer) SuperfluidUndelegateValidatorSet(ctx context.Context, req *MsgSuperfluidUndelegateValidatorSet) (*MsgSuperfluidUndelegateValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegateValidatorSet not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1638,6 +2033,61 @@ func _Msg_UnbondConvertAndStake_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidDelegateToValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidDelegateToValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidDelegateToValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidDelegateToValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidDelegateToValidatorSet(ctx, req.(*MsgSuperfluidDelegateToValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuperfluidRebalanceValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidRebalanceValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidRebalanceValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidRebalanceValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidRebalanceValidatorSet(ctx, req.(*MsgSuperfluidRebalanceValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuperfluidUndelegateValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidUndelegateValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidUndelegateValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidUndelegateValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidUndelegateValidatorSet(ctx, req.(*MsgSuperfluidUndelegateValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1682,6 +2132,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnbondConvertAndStake",
 			Handler:    _Msg_UnbondConvertAndStake_Handler,
 		},
+		{
+			MethodName: "SuperfluidDelegateToValidatorSet",
+			Handler:    _Msg_SuperfluidDelegateToValidatorSet_Handler,
+		},
+		{
+			MethodName: "SuperfluidRebalanceValidatorSet",
+			Handler:    _Msg_SuperfluidRebalanceValidatorSet_Handler,
+		},
+		{
+			MethodName: "SuperfluidUndelegateValidatorSet",
+			Handler:    _Msg_SuperfluidUndelegateValidatorSet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -2518,9 +2980,241 @@ func (m *MsgUnbondConvertAndStakeResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
+func (m *MsgSuperfluidDelegateToValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		dAtA10 := make([]byte, len(m.LockIds)*10)
+		var j9 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UndelegatedLockIds) > 0 {
+		dAtA12 := make([]byte, len(m.UndelegatedLockIds)*10)
+		var j11 int
+		for _, num := range m.UndelegatedLockIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UndelegatedLockIds) > 0 {
+		dAtA14 := make([]byte, len(m.UndelegatedLockIds)*10)
+		var j13 int
+		for _, num := range m.UndelegatedLockIds {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -2863,41 +3557,139 @@ func (m *MsgUnbondConvertAndStakeResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSuperfluidDelegateToValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
 }
-func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegate: wiretype end group for non-group")
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UndelegatedLockIds) > 0 {
+		l = 0
+		for _, e := range m.UndelegatedLockIds {
+			l += sovTx(uint64(e))
 		}
-		switch fieldNum {
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UndelegatedLockIds) > 0 {
+		l = 0
+		for _, e := range m.UndelegatedLockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
@@ -5250,6 +6042,713 @@ func (m *MsgUnbondConvertAndStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSuperfluidDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidRebalanceValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRebalanceValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRebalanceValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidRebalanceValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRebalanceValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRebalanceValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UndelegatedLockIds = append(m.UndelegatedLockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UndelegatedLockIds) == 0 {
+					m.UndelegatedLockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UndelegatedLockIds = append(m.UndelegatedLockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatedLockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegateValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegateValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UndelegatedLockIds = append(m.UndelegatedLockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UndelegatedLockIds) == 0 {
+					m.UndelegatedLockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UndelegatedLockIds = append(m.UndelegatedLockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatedLockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
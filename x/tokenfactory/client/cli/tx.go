@@ -22,6 +22,8 @@ func GetTxCmd() *cobra.Command {
 		NewChangeAdminCmd(),
 		NewSetBeforeSendHookCmd(),
 		NewMsgSetDenomMetadata(),
+		NewSetDenomFrozenCmd(),
+		NewRenounceDenomCapabilitiesCmd(),
 	)
 
 	return cmd
@@ -62,6 +64,21 @@ func NewChangeAdminCmd() *cobra.Command {
 	})
 }
 
+func NewSetDenomFrozenCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetDenomFrozen](&osmocli.TxCliDesc{
+		Use:   "set-denom-frozen",
+		Short: "Freezes or unfreezes transfers of a factory-created denom. Must have admin authority to do so.",
+	})
+}
+
+func NewRenounceDenomCapabilitiesCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgRenounceDenomCapabilities](&osmocli.TxCliDesc{
+		Use:     "renounce-denom-capabilities",
+		Short:   "Permanently gives up admin capabilities over a factory-created denom. Must have admin authority to do so.",
+		Example: "renounce-denom-capabilities factory/osmo1.../mytoken false true true",
+	})
+}
+
 // NewChangeAdminCmd broadcast MsgChangeAdmin
 func NewSetBeforeSendHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -47,3 +47,18 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// renounceDenomCapabilities permanently removes the given admin capabilities of a denom.
+// Capabilities that are already renounced stay renounced.
+func (k Keeper) renounceDenomCapabilities(ctx sdk.Context, denom string, setDenomMetadata, burnFrom, forceTransfer bool) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	metadata.SetDenomMetadataRenounced = metadata.SetDenomMetadataRenounced || setDenomMetadata
+	metadata.BurnFromRenounced = metadata.BurnFromRenounced || burnFrom
+	metadata.ForceTransferRenounced = metadata.ForceTransferRenounced || forceTransfer
+
+	return metadata, k.setAuthorityMetadata(ctx, denom, metadata)
+}
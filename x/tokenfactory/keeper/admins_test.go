@@ -551,3 +551,107 @@ func (s *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRenounceDenomCapabilities() {
+	for _, tc := range []struct {
+		desc                     string
+		sender                   func() string
+		setDenomMetadata         bool
+		burnFrom                 bool
+		forceTransfer            bool
+		changeAdminAfterRenounce bool
+		expectErr                error
+	}{
+		{
+			desc:             "admin renounces setting denom metadata",
+			sender:           func() string { return s.TestAccs[0].String() },
+			setDenomMetadata: true,
+		},
+		{
+			desc:          "admin renounces burn from and force transfer",
+			sender:        func() string { return s.TestAccs[0].String() },
+			burnFrom:      true,
+			forceTransfer: true,
+		},
+		{
+			desc:                     "renounced capabilities stay renounced after an admin change",
+			sender:                   func() string { return s.TestAccs[0].String() },
+			setDenomMetadata:         true,
+			burnFrom:                 true,
+			forceTransfer:            true,
+			changeAdminAfterRenounce: true,
+		},
+		{
+			desc:      "non admin can not renounce capabilities",
+			sender:    func() string { return s.TestAccs[1].String() },
+			burnFrom:  true,
+			expectErr: types.ErrUnauthorized,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			admin := s.TestAccs[0]
+			holder := s.TestAccs[1]
+
+			_, err := s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+			s.Require().NoError(err)
+			err = s.App.BankKeeper.SendCoins(s.Ctx, admin, holder, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 50)))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.RenounceDenomCapabilities(s.Ctx, types.NewMsgRenounceDenomCapabilities(tc.sender(), s.defaultDenom, tc.setDenomMetadata, tc.burnFrom, tc.forceTransfer))
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeMsgRenounceDenomCapabilities, 1)
+
+			if tc.changeAdminAfterRenounce {
+				_, err = s.msgServer.ChangeAdmin(s.Ctx, types.NewMsgChangeAdmin(admin.String(), s.defaultDenom, s.TestAccs[2].String()))
+				s.Require().NoError(err)
+				admin = s.TestAccs[2]
+			}
+
+			authorityMetadata, err := s.App.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, s.defaultDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.setDenomMetadata, authorityMetadata.SetDenomMetadataRenounced)
+			s.Require().Equal(tc.burnFrom, authorityMetadata.BurnFromRenounced)
+			s.Require().Equal(tc.forceTransfer, authorityMetadata.ForceTransferRenounced)
+
+			assertRenounced := func(renounced bool, err error) {
+				if renounced {
+					s.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+				} else {
+					s.Require().NoError(err)
+				}
+			}
+
+			_, err = s.msgServer.SetDenomMetadata(s.Ctx, types.NewMsgSetDenomMetadata(admin.String(), banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{
+					{
+						Denom:    s.defaultDenom,
+						Exponent: 0,
+					},
+				},
+				Base:    s.defaultDenom,
+				Display: s.defaultDenom,
+				Name:    "bitcoin",
+				Symbol:  "BTC",
+			}))
+			assertRenounced(tc.setDenomMetadata, err)
+
+			_, err = s.msgServer.Burn(s.Ctx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10), holder.String()))
+			assertRenounced(tc.burnFrom, err)
+
+			_, err = s.msgServer.ForceTransfer(s.Ctx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10), holder.String(), admin.String()))
+			assertRenounced(tc.forceTransfer, err)
+
+			// the admin can always burn its own tokens.
+			if !tc.changeAdminAfterRenounce {
+				_, err = s.msgServer.Burn(s.Ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
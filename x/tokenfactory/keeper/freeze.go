@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/tokenfactory/types"
+)
+
+// denomFrozenCheckPrefix is the prefix of all x/tokenfactory denoms, the only ones that can be frozen.
+var denomFrozenCheckPrefix = types.ModuleDenomPrefix + "/"
+
+// setDenomFrozen freezes or unfreezes transfers of the given x/tokenfactory denom.
+func (k Keeper) setDenomFrozen(ctx sdk.Context, denom string, frozen bool) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if frozen {
+		store.Set([]byte(types.DenomFrozenPrefixKey), []byte{1})
+	} else {
+		store.Delete([]byte(types.DenomFrozenPrefixKey))
+	}
+	return nil
+}
+
+// IsDenomFrozen returns whether transfers of the given denom are frozen.
+func (k Keeper) IsDenomFrozen(ctx sdk.Context, denom string) bool {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	return store.Has([]byte(types.DenomFrozenPrefixKey))
+}
+
+// DenomFrozenSendRestriction is a bank send restriction that prevents transfers of frozen x/tokenfactory denoms.
+// Sends from and to the tokenfactory module account are always allowed, so that the admin
+// can still mint and burn a frozen denom.
+// Coins that are not x/tokenfactory denoms can not be frozen, so they are skipped without reading the store.
+func (k Keeper) DenomFrozenSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, coin := range amt {
+		if !strings.HasPrefix(coin.Denom, denomFrozenCheckPrefix) {
+			continue
+		}
+		if !k.IsDenomFrozen(sdkCtx, coin.Denom) {
+			continue
+		}
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if fromAddr.Equals(moduleAddr) || toAddr.Equals(moduleAddr) {
+			continue
+		}
+		return toAddr, errorsmod.Wrapf(types.ErrDenomFrozen, "denom: %s", coin.Denom)
+	}
+	return toAddr, nil
+}
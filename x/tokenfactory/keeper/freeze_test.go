@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestSetDenomFrozen() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	for _, tc := range []struct {
+		desc        string
+		sender      func() string
+		frozen      bool
+		freezeFirst bool
+		expectErr   bool
+	}{
+		{
+			desc:   "admin freezes denom",
+			sender: func() string { return s.TestAccs[0].String() },
+			frozen: true,
+		},
+		{
+			desc:        "admin unfreezes denom",
+			sender:      func() string { return s.TestAccs[0].String() },
+			freezeFirst: true,
+		},
+		{
+			desc:      "non admin can not freeze denom",
+			sender:    func() string { return s.TestAccs[1].String() },
+			frozen:    true,
+			expectErr: true,
+		},
+		{
+			desc:        "non admin can not unfreeze denom",
+			sender:      func() string { return s.TestAccs[1].String() },
+			freezeFirst: true,
+			expectErr:   true,
+		},
+		{
+			desc:        "governance unfreezes denom",
+			sender:      func() string { return govAddr },
+			freezeFirst: true,
+		},
+		{
+			desc:      "governance can not freeze denom",
+			sender:    func() string { return govAddr },
+			frozen:    true,
+			expectErr: true,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+
+			if tc.freezeFirst {
+				_, err := s.msgServer.SetDenomFrozen(s.Ctx, types.NewMsgSetDenomFrozen(s.TestAccs[0].String(), s.defaultDenom, true))
+				s.Require().NoError(err)
+			}
+
+			_, err := s.msgServer.SetDenomFrozen(s.Ctx, types.NewMsgSetDenomFrozen(tc.sender(), s.defaultDenom, tc.frozen))
+			if tc.expectErr {
+				s.Require().ErrorIs(err, types.ErrUnauthorized)
+				s.Require().Equal(tc.freezeFirst, s.App.TokenFactoryKeeper.IsDenomFrozen(s.Ctx, s.defaultDenom))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.frozen, s.App.TokenFactoryKeeper.IsDenomFrozen(s.Ctx, s.defaultDenom))
+			s.AssertEventEmitted(s.Ctx, types.TypeMsgSetDenomFrozen, 1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDenomFrozenSendRestriction() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0]
+
+	_, err := s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetDenomFrozen(s.Ctx, types.NewMsgSetDenomFrozen(admin.String(), s.defaultDenom, true))
+	s.Require().NoError(err)
+
+	// transfers of the frozen denom fail
+	err = s.App.BankKeeper.SendCoins(s.Ctx, admin, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+
+	// transfers of other denoms are unaffected
+	err = s.App.BankKeeper.SendCoins(s.Ctx, admin, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(apptesting.SecondaryDenom, 10)))
+	s.Require().NoError(err)
+
+	// checking denoms that are not x/tokenfactory denoms does not read the store
+	gasCtx := s.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = s.App.TokenFactoryKeeper.DenomFrozenSendRestriction(gasCtx, admin, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(apptesting.SecondaryDenom, 10)))
+	s.Require().NoError(err)
+	s.Require().Zero(gasCtx.GasMeter().GasConsumed())
+
+	// the admin can still mint and burn the frozen denom
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10), s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Burn(s.Ctx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10), s.TestAccs[1].String()))
+	s.Require().NoError(err)
+
+	// transfers succeed once the denom is unfrozen
+	_, err = s.msgServer.SetDenomFrozen(s.Ctx, types.NewMsgSetDenomFrozen(admin.String(), s.defaultDenom, false))
+	s.Require().NoError(err)
+	err = s.App.BankKeeper.SendCoins(s.Ctx, admin, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(10), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], s.defaultDenom).Amount.Int64())
+}
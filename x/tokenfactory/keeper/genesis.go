@@ -29,6 +29,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		err = k.setDenomFrozen(ctx, genDenom.GetDenom(), genDenom.GetFrozen())
+		if err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -48,6 +52,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		genDenoms = append(genDenoms, types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
+			Frozen:            k.IsDenomFrozen(ctx, denom),
 		})
 	}
 
@@ -22,6 +22,7 @@ func (s *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn",
 				},
+				Frozen: true,
 			},
 			{
 				Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/litecoin",
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v26/x/tokenfactory/types"
@@ -95,6 +97,10 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		msg.BurnFromAddress = msg.Sender
 	}
 
+	if msg.BurnFromAddress != msg.Sender && authorityMetadata.BurnFromRenounced {
+		return nil, errorsmod.Wrapf(types.ErrCapabilityRenounced, "burn from: %s", msg.Amount.GetDenom())
+	}
+
 	accountI := server.Keeper.accountKeeper.GetAccount(ctx, sdk.AccAddress(msg.BurnFromAddress))
 	_, ok := accountI.(sdk.ModuleAccountI)
 	if ok {
@@ -129,6 +135,10 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.ForceTransferRenounced {
+		return nil, errorsmod.Wrapf(types.ErrCapabilityRenounced, "force transfer: %s", msg.Amount.GetDenom())
+	}
+
 	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
 	if err != nil {
 		return nil, err
@@ -191,6 +201,10 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.SetDenomMetadataRenounced {
+		return nil, errorsmod.Wrapf(types.ErrCapabilityRenounced, "set denom metadata: %s", msg.Metadata.Base)
+	}
+
 	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -231,3 +245,63 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
+
+func (server msgServer) SetDenomFrozen(goCtx context.Context, msg *types.MsgSetDenomFrozen) (*types.MsgSetDenomFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// governance can only override the admin to unfreeze a denom
+	isGovUnfreeze := msg.Sender == server.Keeper.authority && !msg.Frozen
+	if msg.Sender != authorityMetadata.GetAdmin() && !isGovUnfreeze {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setDenomFrozen(ctx, msg.Denom, msg.Frozen)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomFrozen,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	})
+
+	return &types.MsgSetDenomFrozenResponse{}, nil
+}
+
+func (server msgServer) RenounceDenomCapabilities(goCtx context.Context, msg *types.MsgRenounceDenomCapabilities) (*types.MsgRenounceDenomCapabilitiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	authorityMetadata, err = server.Keeper.renounceDenomCapabilities(ctx, msg.Denom, msg.SetDenomMetadata, msg.BurnFrom, msg.ForceTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceDenomCapabilities,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeSetDenomMetadata, strconv.FormatBool(authorityMetadata.SetDenomMetadataRenounced)),
+			sdk.NewAttribute(types.AttributeBurnFrom, strconv.FormatBool(authorityMetadata.BurnFromRenounced)),
+			sdk.NewAttribute(types.AttributeForceTransfer, strconv.FormatBool(authorityMetadata.ForceTransferRenounced)),
+		),
+	})
+
+	return &types.MsgRenounceDenomCapabilitiesResponse{}, nil
+}
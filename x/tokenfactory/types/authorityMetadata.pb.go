@@ -30,6 +30,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// set_denom_metadata_renounced is set once the admin permanently gave up
+	// the power to overwrite the denom's bank metadata.
+	SetDenomMetadataRenounced bool `protobuf:"varint,2,opt,name=set_denom_metadata_renounced,json=setDenomMetadataRenounced,proto3" json:"set_denom_metadata_renounced,omitempty" yaml:"set_denom_metadata_renounced"`
+	// burn_from_renounced is set once the admin permanently gave up the power
+	// to burn the denom from other accounts.
+	BurnFromRenounced bool `protobuf:"varint,3,opt,name=burn_from_renounced,json=burnFromRenounced,proto3" json:"burn_from_renounced,omitempty" yaml:"burn_from_renounced"`
+	// force_transfer_renounced is set once the admin permanently gave up the
+	// power to force transfer the denom between accounts.
+	ForceTransferRenounced bool `protobuf:"varint,4,opt,name=force_transfer_renounced,json=forceTransferRenounced,proto3" json:"force_transfer_renounced,omitempty" yaml:"force_transfer_renounced"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,6 +81,27 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetSetDenomMetadataRenounced() bool {
+	if m != nil {
+		return m.SetDenomMetadataRenounced
+	}
+	return false
+}
+
+func (m *DenomAuthorityMetadata) GetBurnFromRenounced() bool {
+	if m != nil {
+		return m.BurnFromRenounced
+	}
+	return false
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferRenounced() bool {
+	if m != nil {
+		return m.ForceTransferRenounced
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "osmosis.tokenfactory.v1beta1.DenomAuthorityMetadata")
 }
@@ -81,22 +111,31 @@ func init() {
 }
 
 var fileDescriptor_99435de88ae175f7 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xea, 0xd2, 0x43, 0xd6, 0xa5, 0x07, 0xd5, 0x25, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0xc9, 0x25, 0x83, 0x35, 0xe9, 0x27, 0x25, 0x16,
-	0xa7, 0xc2, 0x2d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6,
-	0xe5, 0xe7, 0x3a, 0xa2, 0xdb, 0x29, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x53, 0xd0, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x3d, 0xa0, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97,
-	0x19, 0x99, 0xe9, 0x57, 0xa0, 0x86, 0x44, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x89,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x56, 0x7a, 0x08, 0xe1, 0x2e, 0x01, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6a, 0xdb, 0x40,
+	0x14, 0x45, 0x2d, 0xd7, 0x2d, 0xad, 0xe8, 0xa2, 0x55, 0x8b, 0x71, 0x8d, 0x19, 0x19, 0x19, 0x5a,
+	0x6f, 0xaa, 0xc1, 0x6d, 0x29, 0xc1, 0xbb, 0x98, 0x90, 0x5d, 0xb2, 0x10, 0x59, 0x05, 0x82, 0x18,
+	0x49, 0x23, 0x5b, 0xc4, 0x33, 0xcf, 0xcc, 0x8c, 0x4c, 0xf4, 0x17, 0xf9, 0x84, 0x7c, 0x4e, 0x96,
+	0x5e, 0x66, 0xa5, 0x04, 0x7b, 0x93, 0xb5, 0xbe, 0x20, 0x78, 0x24, 0x3b, 0x4e, 0x48, 0xb2, 0x93,
+	0xde, 0xbd, 0xe7, 0xde, 0x07, 0x6f, 0xcc, 0x7f, 0x20, 0x19, 0xc8, 0x44, 0x62, 0x05, 0xe7, 0x94,
+	0xc7, 0x24, 0x54, 0x20, 0x32, 0x3c, 0x1f, 0x04, 0x54, 0x91, 0x01, 0x26, 0xa9, 0x9a, 0x80, 0x48,
+	0x54, 0x76, 0x44, 0x15, 0x89, 0x88, 0x22, 0xee, 0x4c, 0x80, 0x02, 0xab, 0x53, 0x51, 0xee, 0x2e,
+	0xe5, 0x56, 0x54, 0xfb, 0xfb, 0x18, 0xc6, 0xa0, 0x8d, 0x78, 0xfd, 0x55, 0x32, 0x6d, 0x14, 0x6a,
+	0x08, 0x07, 0x44, 0xd2, 0x6d, 0x41, 0x08, 0x09, 0x2f, 0x75, 0xe7, 0xb6, 0x6e, 0x36, 0x0f, 0x28,
+	0x07, 0xb6, 0xff, 0xbc, 0xd4, 0xfa, 0x69, 0xbe, 0x27, 0x11, 0x4b, 0x78, 0xcb, 0xe8, 0x1a, 0xfd,
+	0x4f, 0xa3, 0x2f, 0x45, 0x6e, 0x7f, 0xce, 0x08, 0x9b, 0x0e, 0x1d, 0x3d, 0x76, 0xbc, 0x52, 0xb6,
+	0x26, 0x66, 0x47, 0x52, 0xe5, 0x47, 0xeb, 0x14, 0x9f, 0x55, 0xb4, 0x2f, 0x28, 0x87, 0x94, 0x87,
+	0x34, 0x6a, 0xd5, 0xbb, 0x46, 0xff, 0xe3, 0xe8, 0x57, 0x91, 0xdb, 0xbd, 0x12, 0x7f, 0xcb, 0xed,
+	0x78, 0x3f, 0x24, 0x55, 0x7a, 0xa3, 0xcd, 0x22, 0xde, 0x46, 0xb3, 0x8e, 0xcd, 0x6f, 0x41, 0x2a,
+	0xb8, 0x1f, 0x0b, 0x60, 0x3b, 0x05, 0xef, 0x74, 0x01, 0x2a, 0x72, 0xbb, 0x5d, 0x16, 0xbc, 0x60,
+	0x72, 0xbc, 0xaf, 0xeb, 0xe9, 0xa1, 0x00, 0xf6, 0x98, 0x77, 0x66, 0xb6, 0x62, 0x10, 0x21, 0xf5,
+	0x95, 0x20, 0x5c, 0xc6, 0x54, 0xec, 0x84, 0x36, 0x74, 0x68, 0xaf, 0xc8, 0x6d, 0xbb, 0x0c, 0x7d,
+	0xcd, 0xe9, 0x78, 0x4d, 0x2d, 0x9d, 0x54, 0xca, 0x36, 0x7e, 0xd8, 0xb8, 0xbf, 0xb2, 0x8d, 0x91,
+	0x77, 0xbd, 0x44, 0xc6, 0x62, 0x89, 0x8c, 0xbb, 0x25, 0x32, 0x2e, 0x57, 0xa8, 0xb6, 0x58, 0xa1,
+	0xda, 0xcd, 0x0a, 0xd5, 0x4e, 0xf7, 0xc6, 0x89, 0x9a, 0xa4, 0x81, 0x1b, 0x02, 0xc3, 0xd5, 0x69,
+	0x7f, 0x4f, 0x49, 0x20, 0x37, 0x3f, 0x78, 0xfe, 0xe7, 0x3f, 0xbe, 0x78, 0xfa, 0x46, 0x54, 0x36,
+	0xa3, 0x32, 0xf8, 0xa0, 0x8f, 0xf7, 0xf7, 0x61, 0x00, 0x1b, 0xdb, 0xa7, 0x3e, 0x48, 0x02, 0x00,
+	0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +160,15 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if this.SetDenomMetadataRenounced != that1.SetDenomMetadataRenounced {
+		return false
+	}
+	if this.BurnFromRenounced != that1.BurnFromRenounced {
+		return false
+	}
+	if this.ForceTransferRenounced != that1.ForceTransferRenounced {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +191,36 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ForceTransferRenounced {
+		i--
+		if m.ForceTransferRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BurnFromRenounced {
+		i--
+		if m.BurnFromRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SetDenomMetadataRenounced {
+		i--
+		if m.SetDenomMetadataRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -174,6 +252,15 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if m.SetDenomMetadataRenounced {
+		n += 2
+	}
+	if m.BurnFromRenounced {
+		n += 2
+	}
+	if m.ForceTransferRenounced {
+		n += 2
+	}
 	return n
 }
 
@@ -244,6 +331,66 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetDenomMetadataRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetDenomMetadataRenounced = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnFromRenounced = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceTransferRenounced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
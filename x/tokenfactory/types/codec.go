@@ -18,6 +18,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "osmosis/tokenfactory/set-denom-metadata")
 	legacy.RegisterAminoMsg(cdc, &MsgSetBeforeSendHook{}, "osmosis/tokenfactory/set-bef-send-hook")
 	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "osmosis/tokenfactory/force-transfer")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomFrozen{}, "osmosis/tokenfactory/set-denom-frozen")
+	legacy.RegisterAminoMsg(cdc, &MsgRenounceDenomCapabilities{}, "osmosis/tokenfactory/renounce-denom-capabilities")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +32,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetDenomMetadata{},
 		&MsgSetBeforeSendHook{},
 		&MsgForceTransfer{},
+		&MsgSetDenomFrozen{},
+		&MsgRenounceDenomCapabilities{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
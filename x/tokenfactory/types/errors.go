@@ -21,4 +21,6 @@ var (
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
 	ErrBeforeSendHookOutOfGas   = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
+	ErrDenomFrozen              = errorsmod.Register(ModuleName, 13, "transfers of denom are frozen")
+	ErrCapabilityRenounced      = errorsmod.Register(ModuleName, 14, "admin capability has been renounced for denom")
 )
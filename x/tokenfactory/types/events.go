@@ -14,4 +14,8 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeFrozen                = "frozen"
+	AttributeSetDenomMetadata      = "set_denom_metadata"
+	AttributeBurnFrom              = "burn_from"
+	AttributeForceTransfer         = "force_transfer"
 )
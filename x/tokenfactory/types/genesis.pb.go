@@ -28,6 +28,8 @@ type GenesisState struct {
 	// params defines the parameters of the module.
 	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	FactoryDenoms []GenesisDenom `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	// frozen is whether transfers of the denom are frozen by its admin
+	Frozen bool `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,12 +79,21 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 	return nil
 }
 
+func (m *GenesisState) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
 // denom's admin.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// frozen is whether transfers of the denom are frozen by its admin
+	Frozen bool `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +143,13 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +160,32 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x05, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x55, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0x91,
-	0x99, 0x7e, 0x05, 0x6a, 0x8c, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xda, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0x45, 0x5c, 0xf9, 0x88, 0xac, 0x02, 0x00, 0x00,
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6b, 0xe2, 0x40,
+	0x18, 0xc6, 0x33, 0xea, 0xca, 0x6e, 0xd4, 0x65, 0x0d, 0xbb, 0x90, 0x95, 0x36, 0xb1, 0xa1, 0x14,
+	0x15, 0x9a, 0xa0, 0x95, 0x52, 0xbc, 0x35, 0x14, 0x7a, 0x2a, 0x94, 0xf4, 0xd6, 0x8b, 0x4c, 0x74,
+	0x8c, 0xa1, 0x26, 0x13, 0x32, 0xa3, 0x34, 0xfd, 0x00, 0x3d, 0xf7, 0x23, 0xf4, 0xe3, 0x78, 0xf4,
+	0xd8, 0x53, 0x28, 0x7a, 0xe9, 0xad, 0xc5, 0x4f, 0x50, 0x9c, 0x4c, 0xff, 0x58, 0x21, 0xd0, 0x5b,
+	0xf2, 0xce, 0xef, 0x79, 0xe6, 0x7d, 0xde, 0x79, 0xc5, 0x06, 0x26, 0x1e, 0x26, 0x2e, 0x31, 0x28,
+	0xbe, 0x42, 0xfe, 0x00, 0xf6, 0x28, 0x0e, 0x23, 0x63, 0xd2, 0xb4, 0x11, 0x85, 0x4d, 0xc3, 0x41,
+	0x3e, 0x22, 0x2e, 0xd1, 0x83, 0x10, 0x53, 0x2c, 0x6d, 0x71, 0x56, 0xff, 0xcc, 0xea, 0x9c, 0xad,
+	0xfc, 0x75, 0xb0, 0x83, 0x19, 0x68, 0xac, 0xbe, 0x12, 0x4d, 0xa5, 0x9d, 0xea, 0x0f, 0xc7, 0x74,
+	0x88, 0x43, 0x97, 0x46, 0x67, 0x88, 0xc2, 0x3e, 0xa4, 0x90, 0xab, 0xea, 0xa9, 0xaa, 0x00, 0x86,
+	0xd0, 0xe3, 0x4d, 0x69, 0xcf, 0x40, 0x2c, 0x9e, 0x26, 0x6d, 0x5e, 0x50, 0x48, 0x91, 0x64, 0x8a,
+	0xf9, 0x04, 0x90, 0x41, 0x15, 0xd4, 0x0a, 0xad, 0x5d, 0x3d, 0xad, 0x6d, 0xfd, 0x9c, 0xb1, 0x66,
+	0x6e, 0x1a, 0xab, 0x82, 0xc5, 0x95, 0x52, 0x20, 0xfe, 0xe6, 0x5c, 0xb7, 0x8f, 0x7c, 0xec, 0x11,
+	0x39, 0x53, 0xcd, 0xd6, 0x0a, 0xad, 0x46, 0xba, 0x17, 0xef, 0xe3, 0x64, 0x25, 0x31, 0xb7, 0x57,
+	0x8e, 0xcb, 0x58, 0xfd, 0x17, 0x41, 0x6f, 0xd4, 0xd1, 0xd6, 0xfd, 0x34, 0xab, 0xc4, 0x0b, 0x0c,
+	0x26, 0x52, 0x5d, 0xcc, 0x0f, 0x42, 0x7c, 0x83, 0x7c, 0x39, 0x5b, 0x05, 0xb5, 0x9f, 0x66, 0x79,
+	0x19, 0xab, 0x25, 0xae, 0x64, 0x75, 0xcd, 0xe2, 0x80, 0xf6, 0xf2, 0x91, 0x98, 0x89, 0xa5, 0x3d,
+	0xf1, 0x07, 0x73, 0x65, 0x81, 0x7f, 0x99, 0x7f, 0x96, 0xb1, 0x5a, 0x4c, 0xa4, 0xac, 0xac, 0x59,
+	0xc9, 0xb1, 0x74, 0x0b, 0x44, 0xe9, 0x7d, 0xe2, 0x5d, 0x8f, 0x8f, 0x5c, 0xce, 0xb0, 0x31, 0xb5,
+	0xd3, 0xa3, 0xb1, 0x9b, 0x8e, 0xbf, 0x3e, 0x97, 0xb9, 0xc3, 0x43, 0xfe, 0x4f, 0xee, 0xdb, 0x74,
+	0xd7, 0xac, 0xf2, 0xc6, 0x23, 0x7f, 0x23, 0x6c, 0x27, 0xf7, 0x74, 0xaf, 0x02, 0xd3, 0x9a, 0xce,
+	0x15, 0x30, 0x9b, 0x2b, 0xe0, 0x71, 0xae, 0x80, 0xbb, 0x85, 0x22, 0xcc, 0x16, 0x8a, 0xf0, 0xb0,
+	0x50, 0x84, 0xcb, 0x23, 0xc7, 0xa5, 0xc3, 0xb1, 0xad, 0xf7, 0xb0, 0x67, 0xf0, 0x00, 0xfb, 0x23,
+	0x68, 0x93, 0xb7, 0x1f, 0x63, 0xd2, 0x3a, 0x34, 0xae, 0xd7, 0xf7, 0x88, 0x46, 0x01, 0x22, 0x76,
+	0x9e, 0xed, 0xcf, 0xc1, 0xeb, 0x00, 0xc9, 0x04, 0x9e, 0x0d, 0x02, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +213,9 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -215,6 +238,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.FactoryDenoms) > 0 {
 		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -262,6 +295,16 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -307,6 +350,9 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Frozen {
+		n += 2
+	}
 	return n
 }
 
@@ -322,6 +368,9 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.Frozen {
+		n += 2
+	}
 	return n
 }
 
@@ -427,6 +476,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -542,6 +611,26 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
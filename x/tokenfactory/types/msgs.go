@@ -18,6 +18,9 @@ const (
 	TypeMsgChangeAdmin       = "change_admin"
 	TypeMsgSetDenomMetadata  = "set_denom_metadata"
 	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgSetDenomFrozen    = "set_denom_frozen"
+
+	TypeMsgRenounceDenomCapabilities = "renounce_denom_capabilities"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -280,3 +283,73 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetDenomFrozen{}
+
+// NewMsgSetDenomFrozen creates a message to freeze or unfreeze transfers of a denom
+func NewMsgSetDenomFrozen(sender string, denom string, frozen bool) *MsgSetDenomFrozen {
+	return &MsgSetDenomFrozen{
+		Sender: sender,
+		Denom:  denom,
+		Frozen: frozen,
+	}
+}
+
+func (m MsgSetDenomFrozen) Route() string { return RouterKey }
+func (m MsgSetDenomFrozen) Type() string  { return TypeMsgSetDenomFrozen }
+func (m MsgSetDenomFrozen) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomFrozen) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRenounceDenomCapabilities{}
+
+// NewMsgRenounceDenomCapabilities creates a message to permanently give up admin capabilities over a denom
+func NewMsgRenounceDenomCapabilities(sender string, denom string, setDenomMetadata, burnFrom, forceTransfer bool) *MsgRenounceDenomCapabilities {
+	return &MsgRenounceDenomCapabilities{
+		Sender:           sender,
+		Denom:            denom,
+		SetDenomMetadata: setDenomMetadata,
+		BurnFrom:         burnFrom,
+		ForceTransfer:    forceTransfer,
+	}
+}
+
+func (m MsgRenounceDenomCapabilities) Route() string { return RouterKey }
+func (m MsgRenounceDenomCapabilities) Type() string  { return TypeMsgRenounceDenomCapabilities }
+func (m MsgRenounceDenomCapabilities) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	if !m.SetDenomMetadata && !m.BurnFrom && !m.ForceTransfer {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one capability must be renounced")
+	}
+
+	return nil
+}
+
+func (m MsgRenounceDenomCapabilities) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -450,3 +450,72 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgRenounceDenomCapabilities tests if valid/invalid renounce denom capabilities messages are properly validated/invalidated
+func TestMsgRenounceDenomCapabilities(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper renounceDenomCapabilities message
+	baseMsg := types.NewMsgRenounceDenomCapabilities(addr1.String(), tokenFactoryDenom, false, true, true)
+
+	// validate renounceDenomCapabilities message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "renounce_denom_capabilities")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgRenounceDenomCapabilities
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgRenounceDenomCapabilities {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgRenounceDenomCapabilities {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgRenounceDenomCapabilities {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no capability renounced",
+			msg: func() *types.MsgRenounceDenomCapabilities {
+				msg := *baseMsg
+				msg.BurnFrom = false
+				msg.ForceTransfer = false
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -736,6 +736,229 @@ func (m *MsgForceTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgForceTransferResponse proto.InternalMessageInfo
 
+// MsgSetDenomFrozen is the sdk.Msg type for allowing an admin account to
+// freeze or unfreeze transfers of a denom. Governance can also unfreeze a
+// denom, overriding its admin.
+type MsgSetDenomFrozen struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Frozen bool   `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+}
+
+func (m *MsgSetDenomFrozen) Reset()         { *m = MsgSetDenomFrozen{} }
+func (m *MsgSetDenomFrozen) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomFrozen) ProtoMessage()    {}
+func (*MsgSetDenomFrozen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{14}
+}
+func (m *MsgSetDenomFrozen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomFrozen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomFrozen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomFrozen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomFrozen.Merge(m, src)
+}
+func (m *MsgSetDenomFrozen) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomFrozen) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomFrozen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomFrozen proto.InternalMessageInfo
+
+func (m *MsgSetDenomFrozen) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetDenomFrozen) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetDenomFrozen) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+// MsgSetDenomFrozenResponse defines the response structure for an executed
+// MsgSetDenomFrozen message.
+type MsgSetDenomFrozenResponse struct {
+}
+
+func (m *MsgSetDenomFrozenResponse) Reset()         { *m = MsgSetDenomFrozenResponse{} }
+func (m *MsgSetDenomFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomFrozenResponse) ProtoMessage()    {}
+func (*MsgSetDenomFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{15}
+}
+func (m *MsgSetDenomFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomFrozenResponse.Merge(m, src)
+}
+func (m *MsgSetDenomFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomFrozenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomFrozenResponse proto.InternalMessageInfo
+
+// MsgRenounceDenomCapabilities is the sdk.Msg type for allowing an admin
+// account to permanently give up some of its powers over a denom. Renounced
+// capabilities stay renounced when the admin is changed.
+type MsgRenounceDenomCapabilities struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// set_denom_metadata renounces the power to overwrite the denom's bank
+	// metadata.
+	SetDenomMetadata bool `protobuf:"varint,3,opt,name=set_denom_metadata,json=setDenomMetadata,proto3" json:"set_denom_metadata,omitempty" yaml:"set_denom_metadata"`
+	// burn_from renounces the power to burn the denom from other accounts.
+	BurnFrom bool `protobuf:"varint,4,opt,name=burn_from,json=burnFrom,proto3" json:"burn_from,omitempty" yaml:"burn_from"`
+	// force_transfer renounces the power to force transfer the denom between
+	// accounts.
+	ForceTransfer bool `protobuf:"varint,5,opt,name=force_transfer,json=forceTransfer,proto3" json:"force_transfer,omitempty" yaml:"force_transfer"`
+}
+
+func (m *MsgRenounceDenomCapabilities) Reset()         { *m = MsgRenounceDenomCapabilities{} }
+func (m *MsgRenounceDenomCapabilities) String() string { return proto.CompactTextString(m) }
+func (*MsgRenounceDenomCapabilities) ProtoMessage()    {}
+func (*MsgRenounceDenomCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{16}
+}
+func (m *MsgRenounceDenomCapabilities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenounceDenomCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenounceDenomCapabilities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenounceDenomCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenounceDenomCapabilities.Merge(m, src)
+}
+func (m *MsgRenounceDenomCapabilities) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenounceDenomCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenounceDenomCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenounceDenomCapabilities proto.InternalMessageInfo
+
+func (m *MsgRenounceDenomCapabilities) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRenounceDenomCapabilities) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgRenounceDenomCapabilities) GetSetDenomMetadata() bool {
+	if m != nil {
+		return m.SetDenomMetadata
+	}
+	return false
+}
+
+func (m *MsgRenounceDenomCapabilities) GetBurnFrom() bool {
+	if m != nil {
+		return m.BurnFrom
+	}
+	return false
+}
+
+func (m *MsgRenounceDenomCapabilities) GetForceTransfer() bool {
+	if m != nil {
+		return m.ForceTransfer
+	}
+	return false
+}
+
+// MsgRenounceDenomCapabilitiesResponse defines the response structure for an
+// executed MsgRenounceDenomCapabilities message.
+type MsgRenounceDenomCapabilitiesResponse struct {
+}
+
+func (m *MsgRenounceDenomCapabilitiesResponse) Reset()         { *m = MsgRenounceDenomCapabilitiesResponse{} }
+func (m *MsgRenounceDenomCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenounceDenomCapabilitiesResponse) ProtoMessage()    {}
+func (*MsgRenounceDenomCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{17}
+}
+func (m *MsgRenounceDenomCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenounceDenomCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenounceDenomCapabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenounceDenomCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenounceDenomCapabilitiesResponse.Merge(m, src)
+}
+func (m *MsgRenounceDenomCapabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenounceDenomCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenounceDenomCapabilitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenounceDenomCapabilitiesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateDenom)(nil), "osmosis.tokenfactory.v1beta1.MsgCreateDenom")
 	proto.RegisterType((*MsgCreateDenomResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgCreateDenomResponse")
@@ -751,6 +974,10 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomMetadataResponse")
 	proto.RegisterType((*MsgForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransfer")
 	proto.RegisterType((*MsgForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransferResponse")
+	proto.RegisterType((*MsgSetDenomFrozen)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomFrozen")
+	proto.RegisterType((*MsgSetDenomFrozenResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomFrozenResponse")
+	proto.RegisterType((*MsgRenounceDenomCapabilities)(nil), "osmosis.tokenfactory.v1beta1.MsgRenounceDenomCapabilities")
+	proto.RegisterType((*MsgRenounceDenomCapabilitiesResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgRenounceDenomCapabilitiesResponse")
 }
 
 func init() {
@@ -758,66 +985,78 @@ func init() {
 }
 
 var fileDescriptor_283b6c9a90a846b4 = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x73, 0xdb, 0x44,
-	0x14, 0x8e, 0xda, 0x12, 0xd2, 0x2d, 0x21, 0xb6, 0x12, 0x1a, 0x57, 0xa4, 0x52, 0x2b, 0xa6, 0x85,
-	0x66, 0x90, 0x34, 0x4e, 0x99, 0x00, 0xbe, 0xd5, 0x85, 0x4e, 0x67, 0x8a, 0x2f, 0x6a, 0x4e, 0x4c,
-	0x67, 0x3c, 0x6b, 0x7b, 0xad, 0x68, 0x5c, 0xed, 0x06, 0xed, 0x3a, 0x6e, 0x6e, 0x0c, 0xdc, 0x38,
-	0xf1, 0x1f, 0x70, 0x85, 0x5b, 0xfe, 0x8c, 0x72, 0x60, 0xa6, 0x47, 0x4e, 0x1a, 0x26, 0x39, 0xe4,
-	0xc6, 0x30, 0x3a, 0x70, 0x66, 0xf6, 0x87, 0x64, 0x4b, 0x36, 0xd8, 0x3e, 0x30, 0xb9, 0x24, 0xd6,
-	0xbe, 0xef, 0xfb, 0xf4, 0xde, 0xb7, 0xfb, 0xde, 0x0a, 0xdc, 0x23, 0x34, 0x22, 0x34, 0xa4, 0x1e,
-	0x23, 0x03, 0x84, 0xfb, 0xb0, 0xcb, 0x48, 0x7c, 0xe2, 0x1d, 0xd7, 0x3b, 0x88, 0xc1, 0xba, 0xc7,
-	0x5e, 0xb9, 0x47, 0x31, 0x61, 0x44, 0xdf, 0x51, 0x30, 0x77, 0x12, 0xe6, 0x2a, 0x98, 0xb1, 0x15,
-	0x90, 0x80, 0x08, 0xa0, 0xc7, 0x7f, 0x49, 0x8e, 0x51, 0x85, 0x51, 0x88, 0x89, 0x27, 0xfe, 0xaa,
-	0x25, 0xb3, 0x2b, 0x74, 0xbc, 0x0e, 0xa4, 0x28, 0x7f, 0x49, 0x97, 0x84, 0x78, 0x2a, 0x8e, 0x07,
-	0x79, 0x9c, 0x3f, 0xa8, 0xf8, 0xb6, 0x8a, 0x47, 0x34, 0xf0, 0x8e, 0xeb, 0xfc, 0x9f, 0x0c, 0xd8,
-	0x3f, 0x69, 0xe0, 0xdd, 0x16, 0x0d, 0x1e, 0xc7, 0x08, 0x32, 0xf4, 0x05, 0xc2, 0x24, 0xd2, 0x1f,
-	0x80, 0x55, 0x8a, 0x70, 0x0f, 0xc5, 0x35, 0xed, 0x8e, 0xf6, 0xd1, 0xf5, 0x66, 0x35, 0x4d, 0xac,
-	0xf5, 0x13, 0x18, 0xbd, 0x6c, 0xd8, 0x72, 0xdd, 0xf6, 0x15, 0x40, 0xf7, 0xc0, 0x1a, 0x1d, 0x76,
-	0x7a, 0x9c, 0x56, 0xbb, 0x22, 0xc0, 0x9b, 0x69, 0x62, 0x6d, 0x28, 0xb0, 0x8a, 0xd8, 0x7e, 0x0e,
-	0x6a, 0xd4, 0xbf, 0xbb, 0x38, 0xdd, 0x55, 0xec, 0x1f, 0x2e, 0x4e, 0x77, 0xef, 0xce, 0x74, 0xb1,
-	0x2b, 0xb2, 0x71, 0x24, 0xfb, 0x05, 0xb8, 0x59, 0x4c, 0xd0, 0x47, 0xf4, 0x88, 0x60, 0x8a, 0xf4,
-	0x26, 0xd8, 0xc0, 0x68, 0xd4, 0x16, 0xd4, 0xb6, 0x4c, 0x42, 0x66, 0x6c, 0xa4, 0x89, 0x75, 0x53,
-	0x26, 0x51, 0x02, 0xd8, 0xfe, 0x3a, 0x46, 0xa3, 0x03, 0xbe, 0x20, 0xb4, 0xec, 0xbf, 0x34, 0xf0,
-	0x76, 0x8b, 0x06, 0xad, 0x10, 0xb3, 0x65, 0x0a, 0x7f, 0x0a, 0x56, 0x61, 0x44, 0x86, 0x98, 0x89,
-	0xb2, 0x6f, 0xec, 0xdd, 0x72, 0xa5, 0xc1, 0x2e, 0xdf, 0xa0, 0x6c, 0x7b, 0xdd, 0xc7, 0x24, 0xc4,
-	0xcd, 0xf7, 0x5e, 0x27, 0xd6, 0xca, 0x58, 0x49, 0xd2, 0x6c, 0x5f, 0xf1, 0xf5, 0x2f, 0xc1, 0x7a,
-	0x14, 0x62, 0x76, 0x40, 0x1e, 0xf5, 0x7a, 0x31, 0xa2, 0xb4, 0x76, 0x55, 0xbc, 0xdb, 0x1a, 0x97,
-	0xc0, 0xc3, 0x6d, 0x46, 0xda, 0x50, 0x02, 0xec, 0x9f, 0x2f, 0x4e, 0x77, 0x35, 0xbf, 0xc8, 0x6a,
-	0x3c, 0x28, 0x19, 0x7b, 0x6b, 0xa6, 0xb1, 0x9c, 0x63, 0x57, 0xc1, 0x86, 0xaa, 0x38, 0x73, 0xd2,
-	0xfe, 0x5b, 0xba, 0xd0, 0x1c, 0xc6, 0xf8, 0x72, 0x5c, 0x78, 0x06, 0x36, 0x3a, 0xc3, 0x18, 0x3f,
-	0x89, 0x49, 0x54, 0xf4, 0xe1, 0x6e, 0x9a, 0x58, 0x35, 0xc9, 0xe1, 0x80, 0x76, 0x3f, 0x26, 0x51,
-	0xc9, 0x89, 0x32, 0x73, 0x41, 0x2f, 0x38, 0x4b, 0x79, 0xc1, 0xeb, 0xce, 0xbd, 0xf8, 0x55, 0x75,
-	0xc4, 0x21, 0xc4, 0x01, 0x7a, 0xd4, 0x8b, 0xc2, 0xa5, 0x2c, 0xb9, 0x0f, 0xde, 0x9a, 0x6c, 0x87,
-	0x4a, 0x9a, 0x58, 0xef, 0x48, 0xa4, 0x3a, 0x7f, 0x32, 0xac, 0xd7, 0xc1, 0x75, 0x7e, 0x34, 0x21,
-	0xd7, 0x57, 0xa5, 0x6e, 0xa5, 0x89, 0x55, 0x19, 0x9f, 0x5a, 0x11, 0xb2, 0xfd, 0x35, 0x8c, 0x46,
-	0x22, 0x8b, 0x45, 0x7b, 0x47, 0xe4, 0xed, 0x48, 0x76, 0x4d, 0xf6, 0xce, 0xb8, 0x94, 0xbc, 0xca,
-	0x3f, 0x35, 0xb0, 0xd5, 0xa2, 0xc1, 0x73, 0xc4, 0x9a, 0xa8, 0x4f, 0x62, 0xf4, 0x1c, 0xe1, 0xde,
-	0x53, 0x42, 0x06, 0xff, 0x47, 0xad, 0xcf, 0x40, 0x85, 0x9f, 0x8b, 0x11, 0xa4, 0xf9, 0xd6, 0xa9,
-	0x92, 0xef, 0xa4, 0x89, 0xb5, 0x2d, 0x29, 0x65, 0x44, 0xb6, 0xb9, 0xd9, 0x7a, 0xb6, 0xb9, 0xfb,
-	0x25, 0x17, 0xee, 0xcf, 0x74, 0x81, 0x22, 0xe6, 0x74, 0x50, 0xdf, 0xe1, 0x38, 0xe7, 0x90, 0x90,
-	0x81, 0x6d, 0x82, 0x9d, 0x59, 0xf5, 0xe6, 0x86, 0xfc, 0xa6, 0x81, 0x4d, 0x09, 0x10, 0x83, 0xa1,
-	0x85, 0x18, 0xec, 0x41, 0x06, 0x97, 0xf1, 0xc3, 0x07, 0x6b, 0x91, 0xa2, 0xa9, 0x86, 0xb8, 0x3d,
-	0x6e, 0x08, 0x3c, 0xc8, 0x1b, 0x22, 0xd3, 0x6e, 0x6e, 0xab, 0xa6, 0x50, 0x03, 0x33, 0x23, 0xdb,
-	0x7e, 0xae, 0xd3, 0xf8, 0xb4, 0x54, 0xee, 0x87, 0xff, 0x5a, 0xae, 0xf0, 0xda, 0xc9, 0x35, 0x6e,
-	0x83, 0xf7, 0x67, 0x94, 0x93, 0x97, 0x9b, 0x5c, 0x01, 0x95, 0x16, 0x0d, 0x9e, 0x90, 0xb8, 0x8b,
-	0x0e, 0x62, 0x88, 0x69, 0x1f, 0xc5, 0x97, 0xd3, 0xfa, 0x3e, 0xd8, 0x64, 0x2a, 0x81, 0xe9, 0xf6,
-	0xe7, 0x07, 0x64, 0x47, 0xf2, 0x32, 0x50, 0x71, 0x04, 0xf8, 0xb3, 0xc8, 0xfa, 0x57, 0xa0, 0x9a,
-	0x2d, 0x8f, 0x07, 0xeb, 0x35, 0xa1, 0x68, 0xa6, 0x89, 0x65, 0x94, 0x14, 0x27, 0x86, 0xab, 0x3f,
-	0x4d, 0x6c, 0x3c, 0x2c, 0xed, 0xc1, 0x07, 0x33, 0xf7, 0xa0, 0xcf, 0xad, 0x74, 0x32, 0xb6, 0x6d,
-	0x80, 0x5a, 0xd9, 0xdf, 0xcc, 0xfc, 0xbd, 0x5f, 0x56, 0xc1, 0xd5, 0x16, 0x0d, 0xf4, 0x6f, 0xc0,
-	0x8d, 0xc9, 0x8b, 0xf7, 0x63, 0xf7, 0xbf, 0x3e, 0x16, 0xdc, 0xe2, 0x2d, 0x68, 0x7c, 0xb2, 0x0c,
-	0x3a, 0xbf, 0x33, 0x5f, 0x80, 0x6b, 0xe2, 0xae, 0xbb, 0x37, 0x97, 0xcd, 0x61, 0x86, 0xb3, 0x10,
-	0x6c, 0x52, 0x5d, 0xdc, 0x21, 0xf3, 0xd5, 0x39, 0x6c, 0x01, 0xf5, 0xc9, 0xc9, 0x2c, 0xec, 0x9a,
-	0x98, 0xca, 0x0b, 0xd8, 0x35, 0x46, 0x2f, 0x62, 0xd7, 0xf4, 0x98, 0xd4, 0xbf, 0xd5, 0x40, 0x65,
-	0x6a, 0x24, 0xd4, 0xe7, 0x4a, 0x95, 0x29, 0xc6, 0xe7, 0x4b, 0x53, 0xf2, 0x14, 0xbe, 0xd7, 0x40,
-	0x75, 0x7a, 0x4c, 0xef, 0x2d, 0x22, 0x58, 0xe4, 0x18, 0x8d, 0xe5, 0x39, 0x79, 0x16, 0x23, 0xb0,
-	0x5e, 0x9c, 0x15, 0xee, 0x5c, 0xb1, 0x02, 0xde, 0xd8, 0x5f, 0x0e, 0x9f, 0xbd, 0xb8, 0xe9, 0xbf,
-	0x3e, 0x33, 0xb5, 0x37, 0x67, 0xa6, 0xf6, 0xc7, 0x99, 0xa9, 0xfd, 0x78, 0x6e, 0xae, 0xbc, 0x39,
-	0x37, 0x57, 0x7e, 0x3f, 0x37, 0x57, 0xbe, 0xfe, 0x2c, 0x08, 0xd9, 0xe1, 0xb0, 0xe3, 0x76, 0x49,
-	0xe4, 0x29, 0x6d, 0xe7, 0x25, 0xec, 0xd0, 0xec, 0xc1, 0x3b, 0xde, 0xdb, 0xf7, 0x5e, 0x15, 0x9b,
-	0x94, 0x9d, 0x1c, 0x21, 0xda, 0x59, 0x15, 0xdf, 0xbe, 0x0f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff,
-	0x9e, 0x79, 0xd7, 0xf9, 0xc4, 0x0b, 0x00, 0x00,
+	// 1134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0x66, 0x49, 0x42, 0xcd, 0xa4, 0x80, 0x6d, 0x08, 0xd8, 0x1b, 0xf0, 0x26, 0xdb, 0x42, 0x1b,
+	0x54, 0xef, 0xd6, 0x24, 0x25, 0xad, 0x4f, 0x89, 0x69, 0x51, 0xa4, 0xd4, 0x97, 0x0d, 0xa7, 0x2a,
+	0x92, 0xb5, 0xb6, 0xc7, 0x66, 0x05, 0x3b, 0x43, 0x77, 0xd6, 0x38, 0xe4, 0x54, 0xb5, 0xb7, 0x9e,
+	0x7a, 0xef, 0xa1, 0xd7, 0x1e, 0xf9, 0x1f, 0x7a, 0x49, 0x0f, 0x95, 0x72, 0xec, 0x69, 0x55, 0xc1,
+	0x81, 0x5b, 0x55, 0x59, 0x6a, 0xcf, 0xd5, 0xfc, 0xd8, 0xf1, 0xee, 0xda, 0xc4, 0x76, 0x24, 0x94,
+	0x0b, 0xb0, 0x33, 0xdf, 0xf7, 0xe6, 0xbd, 0xef, 0xfd, 0x98, 0x01, 0xac, 0x63, 0xe2, 0x62, 0xe2,
+	0x10, 0xd3, 0xc7, 0x07, 0x10, 0xb5, 0xec, 0x86, 0x8f, 0xbd, 0x13, 0xf3, 0xb8, 0x54, 0x87, 0xbe,
+	0x5d, 0x32, 0xfd, 0x17, 0xc6, 0x91, 0x87, 0x7d, 0x9c, 0x5d, 0x15, 0x30, 0x23, 0x0a, 0x33, 0x04,
+	0x4c, 0x5d, 0x6a, 0xe3, 0x36, 0x66, 0x40, 0x93, 0xfe, 0xc5, 0x39, 0x6a, 0xc6, 0x76, 0x1d, 0x84,
+	0x4d, 0xf6, 0x53, 0x2c, 0x15, 0x1a, 0xcc, 0x8e, 0x59, 0xb7, 0x09, 0x94, 0x87, 0x34, 0xb0, 0x83,
+	0x06, 0xf6, 0xd1, 0x81, 0xdc, 0xa7, 0x1f, 0x62, 0x7f, 0x45, 0xec, 0xbb, 0xa4, 0x6d, 0x1e, 0x97,
+	0xe8, 0x2f, 0xbe, 0xa1, 0xff, 0xa2, 0x80, 0xf9, 0x2a, 0x69, 0xef, 0x78, 0xd0, 0xf6, 0xe1, 0x97,
+	0x10, 0x61, 0x37, 0x7b, 0x0f, 0xcc, 0x10, 0x88, 0x9a, 0xd0, 0xcb, 0x29, 0x77, 0x94, 0x8f, 0x67,
+	0x2b, 0x99, 0x5e, 0xa0, 0xcd, 0x9d, 0xd8, 0xee, 0x61, 0x59, 0xe7, 0xeb, 0xba, 0x25, 0x00, 0x59,
+	0x13, 0xa4, 0x48, 0xa7, 0xde, 0xa4, 0xb4, 0xdc, 0x34, 0x03, 0x2f, 0xf6, 0x02, 0x6d, 0x41, 0x80,
+	0xc5, 0x8e, 0x6e, 0x49, 0x50, 0xb9, 0xf4, 0xfd, 0xc5, 0xe9, 0xa6, 0x60, 0xff, 0x78, 0x71, 0xba,
+	0x79, 0x77, 0xa8, 0x8a, 0x0d, 0xe6, 0x4d, 0x91, 0xb3, 0x9f, 0x83, 0xe5, 0xb8, 0x83, 0x16, 0x24,
+	0x47, 0x18, 0x11, 0x98, 0xad, 0x80, 0x05, 0x04, 0xbb, 0x35, 0x46, 0xad, 0x71, 0x27, 0xb8, 0xc7,
+	0x6a, 0x2f, 0xd0, 0x96, 0xb9, 0x13, 0x09, 0x80, 0x6e, 0xcd, 0x21, 0xd8, 0xdd, 0xa3, 0x0b, 0xcc,
+	0x96, 0xfe, 0x8f, 0x02, 0xde, 0xab, 0x92, 0x76, 0xd5, 0x41, 0xfe, 0x24, 0x81, 0x3f, 0x01, 0x33,
+	0xb6, 0x8b, 0x3b, 0xc8, 0x67, 0x61, 0xdf, 0xdc, 0xca, 0x1b, 0x5c, 0x60, 0x83, 0x26, 0x28, 0x4c,
+	0xaf, 0xb1, 0x83, 0x1d, 0x54, 0xb9, 0xf5, 0x2a, 0xd0, 0xa6, 0xfa, 0x96, 0x38, 0x4d, 0xb7, 0x04,
+	0x3f, 0xfb, 0x15, 0x98, 0x73, 0x1d, 0xe4, 0xef, 0xe1, 0xc7, 0xcd, 0xa6, 0x07, 0x09, 0xc9, 0x5d,
+	0x63, 0x67, 0x6b, 0xfd, 0x10, 0xe8, 0x76, 0xcd, 0xc7, 0x35, 0x9b, 0x03, 0xf4, 0x5f, 0x2f, 0x4e,
+	0x37, 0x15, 0x2b, 0xce, 0x2a, 0xdf, 0x4b, 0x08, 0x9b, 0x1f, 0x2a, 0x2c, 0xe5, 0xe8, 0x19, 0xb0,
+	0x20, 0x22, 0x0e, 0x95, 0xd4, 0xff, 0xe3, 0x2a, 0x54, 0x3a, 0x1e, 0x7a, 0x37, 0x2a, 0x3c, 0x05,
+	0x0b, 0xf5, 0x8e, 0x87, 0x76, 0x3d, 0xec, 0xc6, 0x75, 0xb8, 0xdb, 0x0b, 0xb4, 0x1c, 0xe7, 0x50,
+	0x40, 0xad, 0xe5, 0x61, 0x37, 0xa1, 0x44, 0x92, 0x39, 0xa6, 0x16, 0x94, 0x25, 0xb4, 0xa0, 0x71,
+	0x4b, 0x2d, 0x7e, 0x17, 0x1d, 0xb1, 0x6f, 0xa3, 0x36, 0x7c, 0xdc, 0x74, 0x9d, 0x89, 0x24, 0xd9,
+	0x00, 0x37, 0xa2, 0xed, 0x90, 0xee, 0x05, 0xda, 0xfb, 0x1c, 0x29, 0xea, 0x8f, 0x6f, 0x67, 0x4b,
+	0x60, 0x96, 0x96, 0xa6, 0x4d, 0xed, 0x8b, 0x50, 0x97, 0x7a, 0x81, 0x96, 0xee, 0x57, 0x2d, 0xdb,
+	0xd2, 0xad, 0x14, 0x82, 0x5d, 0xe6, 0xc5, 0xb8, 0xbd, 0xc3, 0xfc, 0x2e, 0x72, 0x76, 0x8e, 0xf7,
+	0x4e, 0x3f, 0x14, 0x19, 0xe5, 0xdf, 0x0a, 0x58, 0xaa, 0x92, 0xf6, 0x33, 0xe8, 0x57, 0x60, 0x0b,
+	0x7b, 0xf0, 0x19, 0x44, 0xcd, 0x27, 0x18, 0x1f, 0x5c, 0x45, 0xac, 0x4f, 0x41, 0x9a, 0xd6, 0x45,
+	0xd7, 0x26, 0x32, 0x75, 0x22, 0xe4, 0x3b, 0xbd, 0x40, 0x5b, 0xe1, 0x94, 0x24, 0x22, 0x4c, 0x6e,
+	0xb8, 0x1e, 0x26, 0x77, 0x3b, 0xa1, 0xc2, 0xc6, 0x50, 0x15, 0x08, 0xf4, 0x8b, 0x75, 0xd8, 0x2a,
+	0x52, 0x5c, 0x71, 0x1f, 0xe3, 0x03, 0xbd, 0x00, 0x56, 0x87, 0xc5, 0x2b, 0x05, 0xf9, 0x43, 0x01,
+	0x8b, 0x1c, 0xc0, 0x06, 0x43, 0x15, 0xfa, 0x76, 0xd3, 0xf6, 0xed, 0x49, 0xf4, 0xb0, 0x40, 0xca,
+	0x15, 0x34, 0xd1, 0x10, 0x6b, 0xfd, 0x86, 0x40, 0x07, 0xb2, 0x21, 0x42, 0xdb, 0x95, 0x15, 0xd1,
+	0x14, 0x62, 0x60, 0x86, 0x64, 0xdd, 0x92, 0x76, 0xca, 0x0f, 0x13, 0xe1, 0x7e, 0x74, 0x69, 0xb8,
+	0x4c, 0xeb, 0xa2, 0xb4, 0xb1, 0x06, 0x6e, 0x0f, 0x09, 0x47, 0x86, 0x1b, 0x4c, 0x83, 0x74, 0x95,
+	0xb4, 0x77, 0xb1, 0xd7, 0x80, 0x7b, 0x9e, 0x8d, 0x48, 0x0b, 0x7a, 0xef, 0xa6, 0xf5, 0x2d, 0xb0,
+	0xe8, 0x0b, 0x07, 0x06, 0xdb, 0x9f, 0x16, 0xc8, 0x2a, 0xe7, 0x85, 0xa0, 0xf8, 0x08, 0xb0, 0x86,
+	0x91, 0xb3, 0x5f, 0x83, 0x4c, 0xb8, 0xdc, 0x1f, 0xac, 0xd7, 0x99, 0xc5, 0x42, 0x2f, 0xd0, 0xd4,
+	0x84, 0xc5, 0xc8, 0x70, 0xb5, 0x06, 0x89, 0xe5, 0xfb, 0x89, 0x1c, 0x7c, 0x30, 0x34, 0x07, 0x2d,
+	0x2a, 0x65, 0x31, 0x64, 0xeb, 0x2a, 0xc8, 0x25, 0xf5, 0x8d, 0xd6, 0x5a, 0x26, 0x92, 0x9c, 0x5d,
+	0x0f, 0xbf, 0x84, 0x57, 0x32, 0x65, 0x0c, 0x30, 0xd3, 0x62, 0xc6, 0x99, 0x9c, 0xa9, 0xca, 0x72,
+	0xdf, 0x24, 0x5f, 0x17, 0x5d, 0x26, 0x50, 0xe5, 0xcf, 0x12, 0x91, 0xae, 0x8f, 0xa8, 0x36, 0x61,
+	0xe4, 0x36, 0xc8, 0x0f, 0x84, 0x23, 0x83, 0xfd, 0x77, 0x9a, 0x75, 0x9e, 0x05, 0x11, 0xee, 0xa0,
+	0x06, 0xbf, 0xc2, 0x77, 0xec, 0x23, 0xbb, 0xee, 0x1c, 0x3a, 0xbe, 0x03, 0xc9, 0xd5, 0x4c, 0x9c,
+	0x2c, 0x81, 0x3e, 0xbf, 0xf2, 0x6b, 0xb2, 0x27, 0xb9, 0x06, 0x6b, 0xbd, 0x40, 0xcb, 0x87, 0xe6,
+	0x93, 0x18, 0xdd, 0x4a, 0x93, 0xe4, 0x04, 0x28, 0x81, 0x59, 0x79, 0xf5, 0xb0, 0x22, 0x4a, 0x45,
+	0x47, 0xb5, 0xdc, 0xd2, 0xad, 0x54, 0x78, 0x11, 0x65, 0x1f, 0x81, 0x79, 0x56, 0x0e, 0xb5, 0xb0,
+	0x1c, 0x72, 0x37, 0x18, 0x2f, 0xdf, 0x0b, 0xb4, 0x5b, 0x42, 0xff, 0xd8, 0xbe, 0x6e, 0xcd, 0xb5,
+	0xa2, 0xa5, 0x52, 0x7e, 0x94, 0xc8, 0xc4, 0xa7, 0x43, 0x33, 0xe1, 0x09, 0x51, 0x45, 0x3a, 0x1a,
+	0x11, 0x59, 0xf5, 0x0d, 0xf0, 0xe1, 0x9b, 0x64, 0x0f, 0xf3, 0xb3, 0xf5, 0x5b, 0x0a, 0x5c, 0xab,
+	0x92, 0x76, 0xf6, 0x5b, 0x70, 0x33, 0xfa, 0x0a, 0xfc, 0xc4, 0x78, 0xd3, 0xcb, 0xd5, 0x88, 0x3f,
+	0xc9, 0xd4, 0x07, 0x93, 0xa0, 0xe5, 0x03, 0xee, 0x39, 0xb8, 0xce, 0x1e, 0x5e, 0xeb, 0x23, 0xd9,
+	0x14, 0xa6, 0x16, 0xc7, 0x82, 0x45, 0xad, 0xb3, 0x07, 0xcd, 0x68, 0xeb, 0x14, 0xa6, 0x16, 0xc7,
+	0x82, 0x49, 0xeb, 0x54, 0xae, 0xc8, 0x13, 0x61, 0x0c, 0xb9, 0xfa, 0x68, 0xf5, 0xc1, 0x24, 0x68,
+	0x79, 0xe4, 0x77, 0x0a, 0x48, 0x0f, 0xdc, 0x4f, 0xa5, 0x91, 0xa6, 0x92, 0x14, 0xf5, 0x8b, 0x89,
+	0x29, 0xd2, 0x85, 0x1f, 0x14, 0x90, 0x19, 0x7c, 0x33, 0x6c, 0x8d, 0x63, 0x30, 0xce, 0x51, 0xcb,
+	0x93, 0x73, 0xa4, 0x17, 0x5d, 0x30, 0x17, 0xbf, 0xb8, 0x8c, 0x91, 0xc6, 0x62, 0x78, 0x75, 0x7b,
+	0x32, 0xbc, 0x3c, 0xf8, 0x25, 0x98, 0x4f, 0x0c, 0x6d, 0x73, 0x6c, 0x2d, 0x39, 0x41, 0x7d, 0x38,
+	0x21, 0x41, 0x9e, 0xfd, 0xb3, 0x02, 0xf2, 0x97, 0x0f, 0xd1, 0xd1, 0x72, 0x5e, 0xca, 0x55, 0x2b,
+	0x6f, 0xcf, 0x0d, 0xbd, 0xab, 0x58, 0xaf, 0xce, 0x0a, 0xca, 0xeb, 0xb3, 0x82, 0xf2, 0xd7, 0x59,
+	0x41, 0xf9, 0xe9, 0xbc, 0x30, 0xf5, 0xfa, 0xbc, 0x30, 0xf5, 0xe7, 0x79, 0x61, 0xea, 0x9b, 0xcf,
+	0xdb, 0x8e, 0xbf, 0xdf, 0xa9, 0x1b, 0x0d, 0xec, 0x9a, 0xe2, 0x9c, 0xe2, 0xa1, 0x5d, 0x27, 0xe1,
+	0x87, 0x79, 0xbc, 0xb5, 0x6d, 0xbe, 0x88, 0xcf, 0x35, 0xff, 0xe4, 0x08, 0x92, 0xfa, 0x0c, 0xfb,
+	0x17, 0xf5, 0xfe, 0xff, 0x03, 0x00, 0xb9, 0xe8, 0x97, 0x1d, 0x6b, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -839,6 +1078,8 @@ type MsgClient interface {
 	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+	SetDenomFrozen(ctx context.Context, in *MsgSetDenomFrozen, opts ...grpc.CallOption) (*MsgSetDenomFrozenResponse, error)
+	RenounceDenomCapabilities(ctx context.Context, in *MsgRenounceDenomCapabilities, opts ...grpc.CallOption) (*MsgRenounceDenomCapabilitiesResponse, error)
 }
 
 type msgClient struct {
@@ -912,6 +1153,24 @@ func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opt
 	return out, nil
 }
 
+func (c *msgClient) SetDenomFrozen(ctx context.Context, in *MsgSetDenomFrozen, opts ...grpc.CallOption) (*MsgSetDenomFrozenResponse, error) {
+	out := new(MsgSetDenomFrozenResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetDenomFrozen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenounceDenomCapabilities(ctx context.Context, in *MsgRenounceDenomCapabilities, opts ...grpc.CallOption) (*MsgRenounceDenomCapabilitiesResponse, error) {
+	out := new(MsgRenounceDenomCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/RenounceDenomCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
@@ -921,6 +1180,8 @@ type MsgServer interface {
 	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	SetDenomFrozen(context.Context, *MsgSetDenomFrozen) (*MsgSetDenomFrozenResponse, error)
+	RenounceDenomCapabilities(context.Context, *MsgRenounceDenomCapabilities) (*MsgRenounceDenomCapabilitiesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -948,6 +1209,12 @@ func (*UnimplementedMsgServer) SetBeforeSendHook(ctx context.Context, req *MsgSe
 func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgForceTransfer) (*MsgForceTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
 }
+func (*UnimplementedMsgServer) SetDenomFrozen(ctx context.Context, req *MsgSetDenomFrozen) (*MsgSetDenomFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomFrozen not implemented")
+}
+func (*UnimplementedMsgServer) RenounceDenomCapabilities(ctx context.Context, req *MsgRenounceDenomCapabilities) (*MsgRenounceDenomCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenounceDenomCapabilities not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1079,6 +1346,43 @@ func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDenomFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomFrozen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetDenomFrozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomFrozen(ctx, req.(*MsgSetDenomFrozen))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenounceDenomCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenounceDenomCapabilities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenounceDenomCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/RenounceDenomCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenounceDenomCapabilities(ctx, req.(*MsgRenounceDenomCapabilities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1111,6 +1415,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceTransfer",
 			Handler:    _Msg_ForceTransfer_Handler,
 		},
+		{
+			MethodName: "SetDenomFrozen",
+			Handler:    _Msg_SetDenomFrozen_Handler,
+		},
+		{
+			MethodName: "RenounceDenomCapabilities",
+			Handler:    _Msg_RenounceDenomCapabilities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/tx.proto",
@@ -1597,67 +1909,227 @@ func (m *MsgForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetDenomFrozen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetDenomFrozen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomFrozen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Subdenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NewTokenDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetDenomFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetDenomFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.MintToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintResponse) Size() (n int) {
+func (m *MsgRenounceDenomCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceDenomCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceDenomCapabilities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForceTransfer {
+		i--
+		if m.ForceTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BurnFrom {
+		i--
+		if m.BurnFrom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SetDenomMetadata {
+		i--
+		if m.SetDenomMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounceDenomCapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceDenomCapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceDenomCapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.MintToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1810,6 +2282,70 @@ func (m *MsgForceTransferResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetDenomFrozen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetDenomFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenounceDenomCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SetDenomMetadata {
+		n += 2
+	}
+	if m.BurnFrom {
+		n += 2
+	}
+	if m.ForceTransfer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRenounceDenomCapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2108,7 +2644,400 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2136,7 +3065,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintToAddress = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2159,7 +3088,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2182,10 +3111,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2209,7 +3138,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2232,10 +3161,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBeforeSendHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2272,9 +3201,9 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2284,28 +3213,27 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2333,7 +3261,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2356,7 +3284,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2379,10 +3307,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2406,7 +3334,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2429,10 +3357,10 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2469,9 +3397,9 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2481,55 +3409,24 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2552,7 +3449,7 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2575,10 +3472,10 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2602,7 +3499,7 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2625,10 +3522,10 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2665,7 +3562,40 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2693,11 +3623,11 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2725,7 +3655,7 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2748,7 +3678,7 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2771,10 +3701,10 @@ func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2798,7 +3728,7 @@ func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomFrozen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,10 +3751,10 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomFrozen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2861,9 +3791,9 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2873,25 +3803,44 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2913,7 +3862,7 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomFrozenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2936,10 +3885,10 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomFrozenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2963,7 +3912,7 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgRenounceDenomCapabilities) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2986,10 +3935,10 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenounceDenomCapabilities: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenounceDenomCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3026,9 +3975,9 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3038,30 +3987,29 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetDenomMetadata", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3071,29 +4019,17 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SetDenomMetadata = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFrom", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3103,24 +4039,32 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.BurnFrom = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransfer", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ForceTransfer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3142,7 +4086,7 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenounceDenomCapabilitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3165,10 +4109,10 @@ func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenounceDenomCapabilitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenounceDenomCapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,6 +1,7 @@
 package twap
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -79,6 +80,73 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetTwap returns the twap of the given strategy from start time until end time for quote and base
+// assets in a given pool. It fails on an unknown strategy, and otherwise in the same cases as GetArithmeticTwap.
+func (k Keeper) GetTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	strategyType types.TwapStrategy,
+) (osmomath.Dec, error) {
+	strategy, err := k.getStrategy(strategyType)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, strategy)
+}
+
+// ComparePairTwapWindows returns the twaps of the given strategy for quote and base assets in a given pool over
+// the short window and the long window ending at the current block time, along with the relative deviation
+// (shortTwap - longTwap) / longTwap of the short window twap from the long window twap.
+// Both twaps are computed from the same end record. It fails if the short window is not positive or not shorter
+// than the long window, on an unknown strategy, and otherwise in the same cases as GetArithmeticTwapToNow.
+func (k Keeper) ComparePairTwapWindows(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	shortWindow time.Duration,
+	longWindow time.Duration,
+	strategyType types.TwapStrategy,
+) (shortTwap osmomath.Dec, longTwap osmomath.Dec, deviation osmomath.Dec, err error) {
+	if shortWindow <= 0 || shortWindow >= longWindow {
+		return osmomath.Dec{}, osmomath.Dec{}, osmomath.Dec{}, types.InvalidTwapWindowsError{ShortWindow: shortWindow, LongWindow: longWindow}
+	}
+	strategy, err := k.getStrategy(strategyType)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, osmomath.Dec{}, err
+	}
+
+	endRecord, err := k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, osmomath.Dec{}, err
+	}
+	twapOverWindow := func(window time.Duration) (osmomath.Dec, error) {
+		startRecord, err := k.getInterpolatedRecord(ctx, poolId, ctx.BlockTime().Add(-window), baseAssetDenom, quoteAssetDenom)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		return computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
+	}
+
+	shortTwap, err = twapOverWindow(shortWindow)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, osmomath.Dec{}, err
+	}
+	longTwap, err = twapOverWindow(longWindow)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, osmomath.Dec{}, err
+	}
+	if longTwap.IsZero() {
+		return osmomath.Dec{}, osmomath.Dec{}, osmomath.Dec{}, fmt.Errorf("twap of pool %d over %s is zero", poolId, longWindow)
+	}
+
+	return shortTwap, longTwap, shortTwap.Sub(longTwap).Quo(longTwap), nil
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
 // of twap returned depends on the strategy given and can be either arithmetic or geometric.
 func (k Keeper) getTwap(
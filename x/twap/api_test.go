@@ -851,6 +851,144 @@ func (s *TestSuite) TestGetArithmeticTwapToNow_ThreeAsset() {
 // TestGeometricTwapToNow_BalancerPool_Randomized the goal of this test case is to validate
 // that no internal panics occur when computing geometric twap. It also sanity checks
 // that geometric twap is roughly close to spot price.
+// TestGetTwap tests that GetTwap computes the twap of the given strategy,
+// and fails on an unknown strategy.
+func (s *TestSuite) TestGetTwap() {
+	tests := map[string]struct {
+		strategy      types.TwapStrategy
+		expectedError error
+	}{
+		"arithmetic": {
+			strategy: types.TwapStrategyArithmetic,
+		},
+		"geometric": {
+			strategy: types.TwapStrategyGeometric,
+		},
+		"unknown strategy": {
+			strategy:      types.TwapStrategy(2),
+			expectedError: types.InvalidTwapStrategyError{Strategy: types.TwapStrategy(2)},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{baseRecord, tPlus10sp5Record})
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+			input := makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(20*time.Second), baseQuoteBA)
+
+			twap, err := s.twapkeeper.GetTwap(s.Ctx, input.poolId,
+				input.baseAssetDenom, input.quoteAssetDenom,
+				input.startTime, input.endTime, test.strategy)
+
+			if test.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			getStrategyTwap := s.twapkeeper.GetArithmeticTwap
+			if test.strategy == types.TwapStrategyGeometric {
+				getStrategyTwap = s.twapkeeper.GetGeometricTwap
+			}
+			expTwap, err := getStrategyTwap(s.Ctx, input.poolId,
+				input.baseAssetDenom, input.quoteAssetDenom,
+				input.startTime, input.endTime)
+			s.Require().NoError(err)
+			s.Require().Equal(expTwap, twap)
+		})
+	}
+}
+
+func (s *TestSuite) TestComparePairTwapWindows() {
+	tests := map[string]struct {
+		shortWindow       time.Duration
+		longWindow        time.Duration
+		strategy          types.TwapStrategy
+		expShortTwap      osmomath.Dec
+		expLongTwap       osmomath.Dec
+		expectedDeviation osmomath.Dec
+		expectedError     error
+	}{
+		"arithmetic": {
+			shortWindow: 10 * time.Second,
+			longWindow:  20 * time.Second,
+			strategy:    types.TwapStrategyArithmetic,
+			// 5 for 10s
+			expShortTwap: osmomath.NewDec(5),
+			// 10 for 10s, 5 for 10s
+			expLongTwap:       osmomath.NewDecWithPrec(75, 1),
+			expectedDeviation: osmomath.NewDec(-1).Quo(osmomath.NewDec(3)),
+		},
+		"geometric": {
+			shortWindow: 10 * time.Second,
+			longWindow:  20 * time.Second,
+			strategy:    types.TwapStrategyGeometric,
+		},
+		"short window equal to long window": {
+			shortWindow:   20 * time.Second,
+			longWindow:    20 * time.Second,
+			strategy:      types.TwapStrategyArithmetic,
+			expectedError: types.InvalidTwapWindowsError{ShortWindow: 20 * time.Second, LongWindow: 20 * time.Second},
+		},
+		"zero short window": {
+			longWindow:    20 * time.Second,
+			strategy:      types.TwapStrategyArithmetic,
+			expectedError: types.InvalidTwapWindowsError{LongWindow: 20 * time.Second},
+		},
+		"unknown strategy": {
+			shortWindow:   10 * time.Second,
+			longWindow:    20 * time.Second,
+			strategy:      types.TwapStrategy(2),
+			expectedError: types.InvalidTwapStrategyError{Strategy: types.TwapStrategy(2)},
+		},
+		"long window before first record": {
+			shortWindow:   10 * time.Second,
+			longWindow:    time.Hour,
+			strategy:      types.TwapStrategyArithmetic,
+			expectedError: twap.TimeTooOldError{Time: baseTime.Add(20 * time.Second).Add(-time.Hour)},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{baseRecord, tPlus10sp5Record})
+			s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(20 * time.Second))
+			input := makeSimpleTwapInput(baseTime, baseTime, baseQuoteBA)
+
+			shortTwap, longTwap, deviation, err := s.twapkeeper.ComparePairTwapWindows(s.Ctx, input.poolId,
+				input.baseAssetDenom, input.quoteAssetDenom,
+				test.shortWindow, test.longWindow, test.strategy)
+
+			if test.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Both twaps match the twaps to now of the same strategy over their window.
+			expShortTwap, err := s.twapkeeper.GetTwap(s.Ctx, input.poolId,
+				input.baseAssetDenom, input.quoteAssetDenom,
+				s.Ctx.BlockTime().Add(-test.shortWindow), s.Ctx.BlockTime(), test.strategy)
+			s.Require().NoError(err)
+			s.Require().Equal(expShortTwap, shortTwap)
+			expLongTwap, err := s.twapkeeper.GetTwap(s.Ctx, input.poolId,
+				input.baseAssetDenom, input.quoteAssetDenom,
+				s.Ctx.BlockTime().Add(-test.longWindow), s.Ctx.BlockTime(), test.strategy)
+			s.Require().NoError(err)
+			s.Require().Equal(expLongTwap, longTwap)
+			s.Require().Equal(shortTwap.Sub(longTwap).Quo(longTwap), deviation)
+
+			if !test.expShortTwap.IsNil() {
+				s.Require().Equal(test.expShortTwap, shortTwap)
+				s.Require().Equal(test.expLongTwap, longTwap)
+				s.Require().Equal(test.expectedDeviation, deviation)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 	seed := int64(1)
 	r := rand.New(rand.NewSource(seed))
@@ -34,10 +34,45 @@ func GetQueryCmd() *cobra.Command {
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
 	)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTwapFreezes)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPairsWithRecentErrors)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdComparePairTwapWindows)
 
 	return cmd
 }
 
+// GetCmdTwapFreezes returns the active twap freezes of a pool, or of all pools.
+func GetCmdTwapFreezes() (*osmocli.QueryDescriptor, *queryproto.TwapFreezesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "twap-freezes [pool-id]",
+		Short: "Query the active twap freezes of a pool, or of all pools if the pool id is 0",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} twap-freezes 1
+{{.CommandPrefix}} twap-freezes 0`,
+	}, &queryproto.TwapFreezesRequest{}
+}
+
+// GetCmdListPairsWithRecentErrors returns the pairs whose last spot price error happened within the given window.
+func GetCmdListPairsWithRecentErrors() (*osmocli.QueryDescriptor, *queryproto.ListPairsWithRecentErrorsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pairs-with-recent-errors [window]",
+		Short: "Query the pairs whose last spot price error happened within the given window before the current block time",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pairs-with-recent-errors 1h`,
+	}, &queryproto.ListPairsWithRecentErrorsRequest{}
+}
+
+// GetCmdComparePairTwapWindows returns the twaps of a pair over a short and a long window and their relative deviation.
+func GetCmdComparePairTwapWindows() (*osmocli.QueryDescriptor, *queryproto.ComparePairTwapWindowsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "compare-pair-twap-windows [pool-id] [base-denom] [quote-denom] [short-window] [long-window] [strategy]",
+		Short: "Query the twaps of a pair over a short and a long window ending at the current block time, and the deviation of the short window twap from the long window twap",
+		Long: `{{.Short}}
+The strategy is 0 for arithmetic twaps and 1 for geometric twaps.{{.ExampleHeader}}
+{{.CommandPrefix}} compare-pair-twap-windows 1 uosmo uion 10m 24h 0`,
+	}, &queryproto.ComparePairTwapWindowsRequest{}
+}
+
 // GetQueryArithmeticCommand returns an arithmetic twap query command.
 func GetQueryArithmeticCommand() *cobra.Command {
 	cmd := &cobra.Command{
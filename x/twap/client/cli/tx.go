@@ -0,0 +1,180 @@
+package twapcli
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+const (
+	FlagFreezeTime      = "freeze-time"
+	FlagMarkUnavailable = "mark-unavailable"
+)
+
+// NewCmdHandleFreezeTwapProposal implements a command handler for freeze twap proposal
+func NewCmdHandleFreezeTwapProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-twap-proposal [pool-id] [denom-a] [denom-b] [duration] [flags]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a freeze twap proposal",
+		Long: strings.TrimSpace(`Submit a freeze twap proposal.
+
+Freezes the TWAP of the pool's denom pair for the given duration after the proposal is executed.
+While frozen, the TWAP is computed as if the spot price stayed at its value at the freeze time,
+or is unavailable if --mark-unavailable is set. A duration of 0 lifts an active freeze of the pair.
+The freeze time is given in unix seconds, and defaults to the time the proposal is executed at.
+Ex) freeze-twap-proposal 1 uosmo uion 72h --freeze-time=1667088000 ->
+[TWAP of uion<>uosmo in pool 1 frozen at its value at 1667088000, for 72 hours]
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseFreezeTwapArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Int64(FlagFreezeTime, 0, "Unix time in seconds of the last good spot price of the pair, defaults to the proposal execution time")
+	cmd.Flags().Bool(FlagMarkUnavailable, false, "Mark the TWAP of the pair unavailable instead of freezing it at its value at the freeze time")
+
+	return cmd
+}
+
+func parseFreezeTwapArgsToContent(cmd *cobra.Command, args []string) (*types.FreezeTwapProposal, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := time.ParseDuration(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	freezeTimeUnix, err := cmd.Flags().GetInt64(FlagFreezeTime)
+	if err != nil {
+		return nil, err
+	}
+	freezeTime := time.Time{}
+	if freezeTimeUnix != 0 {
+		freezeTime = time.Unix(freezeTimeUnix, 0).UTC()
+	}
+
+	markUnavailable, err := cmd.Flags().GetBool(FlagMarkUnavailable)
+	if err != nil {
+		return nil, err
+	}
+
+	content := types.NewFreezeTwapProposal(title, description, poolId, args[1], args[2], freezeTime, duration, markUnavailable)
+	return content.(*types.FreezeTwapProposal), nil
+}
+
+// NewCmdHandleSetPoolObservationIntervalProposal implements a command handler for set pool observation interval proposal
+func NewCmdHandleSetPoolObservationIntervalProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-observation-interval-proposal [pool-id] [interval] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a set pool observation interval proposal",
+		Long: strings.TrimSpace(`Submit a set pool observation interval proposal.
+
+Sets the minimum time between the TWAP records of the pool. The pool is recorded at most once per interval,
+and within the interval its spot prices are taken to be the ones of its most recent record.
+An interval of 0 removes it, recording the pool every block it changes again.
+Ex) set-pool-observation-interval-proposal 1 30s ->
+[TWAP records of pool 1 written at most once every 30 seconds]
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetPoolObservationIntervalArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func parseSetPoolObservationIntervalArgsToContent(cmd *cobra.Command, args []string) (*types.SetPoolObservationIntervalProposal, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := time.ParseDuration(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	content := types.NewSetPoolObservationIntervalProposal(title, description, poolId, interval)
+	return content.(*types.SetPoolObservationIntervalProposal), nil
+}
@@ -21,6 +21,26 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) TwapFreezes(grpcCtx context.Context,
+	req *queryproto.TwapFreezesRequest,
+) (*queryproto.TwapFreezesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TwapFreezes(ctx, *req)
+}
+
+func (q Querier) Twap(grpcCtx context.Context,
+	req *queryproto.TwapRequest,
+) (*queryproto.TwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Twap(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -31,6 +51,16 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) ListPairsWithRecentErrors(grpcCtx context.Context,
+	req *queryproto.ListPairsWithRecentErrorsRequest,
+) (*queryproto.ListPairsWithRecentErrorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ListPairsWithRecentErrors(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
@@ -51,6 +81,16 @@ func (q Querier) GeometricTwap(grpcCtx context.Context,
 	return q.Q.GeometricTwap(ctx, *req)
 }
 
+func (q Querier) ComparePairTwapWindows(grpcCtx context.Context,
+	req *queryproto.ComparePairTwapWindowsRequest,
+) (*queryproto.ComparePairTwapWindowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ComparePairTwapWindows(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
@@ -0,0 +1,12 @@
+package client
+
+import (
+	twapcli "github.com/osmosis-labs/osmosis/v26/x/twap/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	FreezeTwapProposalHandler                 = govclient.NewProposalHandler(twapcli.NewCmdHandleFreezeTwapProposal)
+	SetPoolObservationIntervalProposalHandler = govclient.NewProposalHandler(twapcli.NewCmdHandleSetPoolObservationIntervalProposal)
+)
@@ -25,7 +25,7 @@ func (q Querier) ArithmeticTwap(ctx sdk.Context,
 		*req.EndTime = ctx.BlockTime()
 	}
 
-	twap, err := q.K.GetArithmeticTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	twap, err := q.K.GetArithmeticTwap(ctx, q.K.ResolveMigratedPoolId(ctx, req.PoolId), req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
 
 	return &queryproto.ArithmeticTwapResponse{ArithmeticTwap: twap}, err
 }
@@ -33,7 +33,7 @@ func (q Querier) ArithmeticTwap(ctx sdk.Context,
 func (q Querier) ArithmeticTwapToNow(ctx sdk.Context,
 	req queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
-	twap, err := q.K.GetArithmeticTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	twap, err := q.K.GetArithmeticTwapToNow(ctx, q.K.ResolveMigratedPoolId(ctx, req.PoolId), req.BaseAsset, req.QuoteAsset, req.StartTime)
 
 	return &queryproto.ArithmeticTwapToNowResponse{ArithmeticTwap: twap}, err
 }
@@ -48,7 +48,7 @@ func (q Querier) GeometricTwap(ctx sdk.Context,
 		*req.EndTime = ctx.BlockTime()
 	}
 
-	twap, err := q.K.GetGeometricTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	twap, err := q.K.GetGeometricTwap(ctx, q.K.ResolveMigratedPoolId(ctx, req.PoolId), req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
 
 	return &queryproto.GeometricTwapResponse{GeometricTwap: twap}, err
 }
@@ -56,7 +56,7 @@ func (q Querier) GeometricTwap(ctx sdk.Context,
 func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	req queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
-	twap, err := q.K.GetGeometricTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	twap, err := q.K.GetGeometricTwapToNow(ctx, q.K.ResolveMigratedPoolId(ctx, req.PoolId), req.BaseAsset, req.QuoteAsset, req.StartTime)
 
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
@@ -67,3 +67,48 @@ func (q Querier) Params(ctx sdk.Context,
 	params := q.K.GetParams(ctx)
 	return &queryproto.ParamsResponse{Params: params}, nil
 }
+
+func (q Querier) Twap(ctx sdk.Context,
+	req queryproto.TwapRequest,
+) (*queryproto.TwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetTwap(ctx, q.K.ResolveMigratedPoolId(ctx, req.PoolId), req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime, req.Strategy)
+
+	return &queryproto.TwapResponse{Twap: twap}, err
+}
+
+func (q Querier) ListPairsWithRecentErrors(ctx sdk.Context,
+	req queryproto.ListPairsWithRecentErrorsRequest,
+) (*queryproto.ListPairsWithRecentErrorsResponse, error) {
+	pairs, err := q.K.GetPairsWithRecentErrors(ctx, req.Window)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.ListPairsWithRecentErrorsResponse{Pairs: pairs}, nil
+}
+
+func (q Querier) TwapFreezes(ctx sdk.Context,
+	req queryproto.TwapFreezesRequest,
+) (*queryproto.TwapFreezesResponse, error) {
+	freezes, err := q.K.GetActiveTwapFreezes(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.TwapFreezesResponse{Freezes: freezes}, nil
+}
+
+func (q Querier) ComparePairTwapWindows(ctx sdk.Context,
+	req queryproto.ComparePairTwapWindowsRequest,
+) (*queryproto.ComparePairTwapWindowsResponse, error) {
+	shortTwap, longTwap, deviation, err := q.K.ComparePairTwapWindows(ctx, q.K.ResolveMigratedPoolId(ctx, req.PoolId), req.BaseAsset, req.QuoteAsset, req.ShortWindow, req.LongWindow, req.Strategy)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.ComparePairTwapWindowsResponse{ShortWindowTwap: shortTwap, LongWindowTwap: longTwap, Deviation: deviation}, nil
+}
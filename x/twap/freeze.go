@@ -0,0 +1,121 @@
+package twap
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// SetTwapFreeze stores the given twap freeze, overwriting any freeze of the same pool and asset pair.
+func (k Keeper) SetTwapFreeze(ctx sdk.Context, freeze types.TwapFreeze) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatTwapFreezeKey(freeze.PoolId, freeze.Asset0Denom, freeze.Asset1Denom)
+	osmoutils.MustSet(store, key, &freeze)
+}
+
+// DeleteTwapFreeze deletes the twap freeze of the given pool and asset pair, if any.
+func (k Keeper) DeleteTwapFreeze(ctx sdk.Context, poolId uint64, asset0Denom, asset1Denom string) error {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatTwapFreezeKey(poolId, asset0Denom, asset1Denom))
+	return nil
+}
+
+// getActiveTwapFreeze returns the twap freeze of the given pool and asset pair,
+// and whether it is active at the current block time.
+// Expired freezes are not active, and are deleted at the next prune epoch, see pruneExpiredTwapFreezes.
+func (k Keeper) getActiveTwapFreeze(ctx sdk.Context, poolId uint64, assetA, assetB string) (types.TwapFreeze, bool, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(assetA, assetB)
+	if err != nil {
+		return types.TwapFreeze{}, false, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	freeze := types.TwapFreeze{}
+	found, err := osmoutils.Get(store, types.FormatTwapFreezeKey(poolId, asset0Denom, asset1Denom), &freeze)
+	if err != nil || !found {
+		return types.TwapFreeze{}, false, err
+	}
+	return freeze, isTwapFreezeActive(freeze, ctx.BlockTime()), nil
+}
+
+// GetActiveTwapFreezes returns the twap freezes of the given pool that are active at the current block time.
+// If the pool id is zero, the active twap freezes of all pools are returned.
+func (k Keeper) GetActiveTwapFreezes(ctx sdk.Context, poolId uint64) ([]types.TwapFreeze, error) {
+	prefix := []byte(types.TwapFreezePrefix)
+	if poolId != 0 {
+		prefix = types.FormatTwapFreezePoolPrefix(poolId)
+	}
+	freezes, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), prefix, types.ParseTwapFreezeFromBz)
+	if err != nil {
+		return nil, err
+	}
+
+	activeFreezes := []types.TwapFreeze{}
+	for _, freeze := range freezes {
+		if isTwapFreezeActive(freeze, ctx.BlockTime()) {
+			activeFreezes = append(activeFreezes, freeze)
+		}
+	}
+	return activeFreezes, nil
+}
+
+// pruneExpiredTwapFreezes deletes the twap freezes of all pools that expired by the current block time.
+// Freezes are set by governance, so there are few of them to iterate.
+func (k Keeper) pruneExpiredTwapFreezes(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	freezes, err := osmoutils.GatherValuesFromStorePrefix(store, []byte(types.TwapFreezePrefix), types.ParseTwapFreezeFromBz)
+	if err != nil {
+		return err
+	}
+	for _, freeze := range freezes {
+		if !isTwapFreezeActive(freeze, ctx.BlockTime()) {
+			store.Delete(types.FormatTwapFreezeKey(freeze.PoolId, freeze.Asset0Denom, freeze.Asset1Denom))
+		}
+	}
+	return nil
+}
+
+func isTwapFreezeActive(freeze types.TwapFreeze, blockTime time.Time) bool {
+	return blockTime.Before(freeze.Expiry)
+}
+
+// getFrozenRecordIfActive returns the frozen record of the pair at time t if the pair has an active twap freeze,
+// see getFrozenRecord. If the pair has no active freeze, frozen is false.
+func (k Keeper) getFrozenRecordIfActive(ctx sdk.Context, poolId uint64, t time.Time, assetA, assetB string) (record types.TwapRecord, frozen bool, err error) {
+	freeze, active, err := k.getActiveTwapFreeze(ctx, poolId, assetA, assetB)
+	if err != nil || !active {
+		return types.TwapRecord{}, false, err
+	}
+	return getFrozenRecord(freeze, t)
+}
+
+// getFrozenRecord returns the record of the pair at time t under the given active freeze.
+// If t is at or before the freeze time, the record is unaffected by the freeze and frozen is false.
+// Otherwise, the frozen record is interpolated to t, as if the spot price stayed at its value at the freeze time.
+// Returns a TwapFrozenError if the freeze marks the twap of the pair as unavailable.
+func getFrozenRecord(freeze types.TwapFreeze, t time.Time) (record types.TwapRecord, frozen bool, err error) {
+	if freeze.MarkUnavailable {
+		return types.TwapRecord{}, false, types.TwapFrozenError{
+			PoolId:      freeze.PoolId,
+			Asset0Denom: freeze.Asset0Denom,
+			Asset1Denom: freeze.Asset1Denom,
+			Expiry:      freeze.Expiry,
+		}
+	}
+	if !t.After(freeze.FreezeTime) {
+		return types.TwapRecord{}, false, nil
+	}
+
+	record = freeze.Record
+	// if it had errored on the frozen record, make this record inherit the error
+	if record.Time.Equal(record.LastErrorTime) {
+		record.LastErrorTime = t
+	}
+	return recordWithUpdatedAccumulators(record, t), true, nil
+}
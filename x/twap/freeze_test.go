@@ -0,0 +1,127 @@
+package twap_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// TestFreezeTwap stores a record series where the spot price of the pair jumps from 1 to 10,
+// and tests that freezing the twap before the jump keeps the twap at 1 until the freeze expires.
+func (s *TestSuite) TestFreezeTwap() {
+	s.SetupTest()
+	freezeTime := baseTime.Add(5 * time.Second)
+	manipulatedTime := baseTime.Add(10 * time.Second)
+	now := baseTime.Add(20 * time.Second)
+
+	s.twapkeeper.StoreNewRecord(s.Ctx, types.TwapRecord{
+		PoolId:                      basePoolId,
+		Asset0Denom:                 denom0,
+		Asset1Denom:                 denom1,
+		Height:                      1,
+		Time:                        baseTime,
+		P0LastSpotPrice:             osmomath.OneDec(),
+		P1LastSpotPrice:             osmomath.OneDec(),
+		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
+		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
+		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+	})
+	s.twapkeeper.StoreNewRecord(s.Ctx, types.TwapRecord{
+		PoolId:                      basePoolId,
+		Asset0Denom:                 denom0,
+		Asset1Denom:                 denom1,
+		Height:                      2,
+		Time:                        manipulatedTime,
+		P0LastSpotPrice:             osmomath.NewDec(10),
+		P1LastSpotPrice:             osmomath.MustNewDecFromStr("0.1"),
+		P0ArithmeticTwapAccumulator: osmomath.NewDec(10000),
+		P1ArithmeticTwapAccumulator: osmomath.NewDec(10000),
+		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+	})
+	s.Ctx = s.Ctx.WithBlockTime(now)
+
+	freezeProposal := func(freezeTime time.Time, duration time.Duration, markUnavailable bool) *types.FreezeTwapProposal {
+		return types.NewFreezeTwapProposal("title", "description", basePoolId, denom1, denom0, freezeTime, duration, markUnavailable).(*types.FreezeTwapProposal)
+	}
+	requireTwaps := func(expectedFrozen bool) {
+		for _, endTime := range []time.Time{now, now.Add(-time.Second)} {
+			twap, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, basePoolId, denom0, denom1, baseTime, endTime)
+			s.Require().NoError(err)
+			if expectedFrozen {
+				s.Require().Equal(osmomath.OneDec(), twap)
+			} else {
+				s.Require().True(twap.GT(osmomath.OneDec()))
+			}
+		}
+	}
+
+	// not frozen
+	requireTwaps(false)
+
+	// the freeze time can not be in the future
+	err := s.twapkeeper.HandleFreezeTwapProposal(s.Ctx, freezeProposal(now.Add(time.Second), time.Hour, false))
+	s.Require().Error(err)
+
+	// frozen at the last good spot price
+	err = s.twapkeeper.HandleFreezeTwapProposal(s.Ctx, freezeProposal(freezeTime, time.Hour, false))
+	s.Require().NoError(err)
+	requireTwaps(true)
+
+	freezes, err := s.twapkeeper.GetActiveTwapFreezes(s.Ctx, basePoolId)
+	s.Require().NoError(err)
+	s.Require().Len(freezes, 1)
+	s.Require().Equal(denom0, freezes[0].Asset0Denom)
+	s.Require().Equal(freezeTime, freezes[0].FreezeTime)
+	s.Require().Equal(now.Add(time.Hour), freezes[0].Expiry)
+	s.Require().Equal(freezeTime, freezes[0].Record.Time)
+	s.Require().NoError(freezes[0].Validate())
+	s.Require().Equal(freezes, s.twapkeeper.ExportGenesis(s.Ctx).Freezes)
+
+	freezes, err = s.twapkeeper.GetActiveTwapFreezes(s.Ctx, basePoolId+1)
+	s.Require().NoError(err)
+	s.Require().Empty(freezes)
+
+	// the freeze expires, and is not pruned before the prune epoch ends
+	s.Ctx = s.Ctx.WithBlockTime(now.Add(time.Hour))
+	freezes, err = s.twapkeeper.GetActiveTwapFreezes(s.Ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(freezes)
+	s.Require().Len(s.twapFreezesInState(), 1)
+
+	// the expired freeze is pruned at the end of the prune epoch, an active one is kept
+	s.twapkeeper.SetTwapFreeze(s.Ctx, types.TwapFreeze{PoolId: basePoolId + 1, Asset0Denom: denom0, Asset1Denom: denom1, Expiry: now.Add(2 * time.Hour)})
+	err = s.twapkeeper.EpochHooks().AfterEpochEnd(s.Ctx, s.twapkeeper.PruneEpochIdentifier(s.Ctx), 1)
+	s.Require().NoError(err)
+	freezesInState := s.twapFreezesInState()
+	s.Require().Len(freezesInState, 1)
+	s.Require().Equal(basePoolId+1, freezesInState[0].PoolId)
+	s.Ctx = s.Ctx.WithBlockTime(now)
+
+	// marked unavailable
+	err = s.twapkeeper.HandleFreezeTwapProposal(s.Ctx, freezeProposal(freezeTime, time.Hour, true))
+	s.Require().NoError(err)
+	_, err = s.twapkeeper.GetArithmeticTwapToNow(s.Ctx, basePoolId, denom0, denom1, baseTime)
+	s.Require().ErrorAs(err, &types.TwapFrozenError{})
+
+	// frozen again after being marked unavailable
+	err = s.twapkeeper.HandleFreezeTwapProposal(s.Ctx, freezeProposal(freezeTime, time.Hour, false))
+	s.Require().NoError(err)
+	requireTwaps(true)
+
+	// lifted by a zero duration
+	err = s.twapkeeper.HandleFreezeTwapProposal(s.Ctx, freezeProposal(time.Time{}, 0, false))
+	s.Require().NoError(err)
+	requireTwaps(false)
+	freezes, err = s.twapkeeper.GetActiveTwapFreezes(s.Ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(freezes)
+}
+
+// twapFreezesInState returns all twap freezes in state, including expired ones.
+func (s *TestSuite) twapFreezesInState() []types.TwapFreeze {
+	freezes, err := osmoutils.GatherValuesFromStorePrefix(s.Ctx.KVStore(s.App.AppKeepers.GetKey(types.StoreKey)), []byte(types.TwapFreezePrefix), types.ParseTwapFreezeFromBz)
+	s.Require().NoError(err)
+	return freezes
+}
@@ -0,0 +1,94 @@
+package twap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// HandleFreezeTwapProposal freezes the twap of the proposal's pool and asset pair until the proposal's duration
+// has passed, overwriting any freeze of the pair. A duration of zero lifts the freeze of the pair instead.
+// Unless the twap is marked unavailable, the record of the pair at the freeze time is stored with the freeze.
+// An already active freeze of the pair applies to this record, so a frozen pair can be frozen again at a later time
+// while keeping its frozen value.
+// Returns error if the freeze time is in the future, or the record of the pair at the freeze time can not be found.
+func (k Keeper) HandleFreezeTwapProposal(ctx sdk.Context, p *types.FreezeTwapProposal) error {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(p.Asset0Denom, p.Asset1Denom)
+	if err != nil {
+		return err
+	}
+
+	if p.Duration == 0 {
+		return k.DeleteTwapFreeze(ctx, p.PoolId, asset0Denom, asset1Denom)
+	}
+
+	freezeTime := p.FreezeTime
+	if freezeTime.IsZero() {
+		freezeTime = ctx.BlockTime()
+	}
+	if freezeTime.After(ctx.BlockTime()) {
+		return fmt.Errorf("twap freeze time (%s) cannot be after the current block time (%s)", freezeTime, ctx.BlockTime())
+	}
+
+	freeze := types.TwapFreeze{
+		PoolId:          p.PoolId,
+		Asset0Denom:     asset0Denom,
+		Asset1Denom:     asset1Denom,
+		FreezeTime:      freezeTime,
+		Expiry:          ctx.BlockTime().Add(p.Duration),
+		MarkUnavailable: p.MarkUnavailable,
+	}
+
+	if p.MarkUnavailable {
+		// the pair must still exist in the pool
+		if _, err := k.getMostRecentRecordStoreRepresentation(ctx, p.PoolId, asset0Denom, asset1Denom); err != nil {
+			return err
+		}
+	} else {
+		// an active freeze that marks the pair unavailable has no record to freeze at, so it is lifted first.
+		existingFreeze, active, err := k.getActiveTwapFreeze(ctx, p.PoolId, asset0Denom, asset1Denom)
+		if err != nil {
+			return err
+		}
+		if active && existingFreeze.MarkUnavailable {
+			if err := k.DeleteTwapFreeze(ctx, p.PoolId, asset0Denom, asset1Denom); err != nil {
+				return err
+			}
+		}
+
+		freeze.Record, err = k.getInterpolatedRecord(ctx, p.PoolId, freezeTime, asset0Denom, asset1Denom)
+		if err != nil {
+			return fmt.Errorf("failed to get the twap record of pool %d for assets %s %s at the freeze time: %w", p.PoolId, asset0Denom, asset1Denom, err)
+		}
+	}
+
+	k.SetTwapFreeze(ctx, freeze)
+	return nil
+}
+
+// HandleSetPoolObservationIntervalProposal sets the observation interval of the proposal's pool.
+// Returns error if the pool does not exist.
+func (k Keeper) HandleSetPoolObservationIntervalProposal(ctx sdk.Context, p *types.SetPoolObservationIntervalProposal) error {
+	if _, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, p.PoolId); err != nil {
+		return err
+	}
+	return k.SetPoolObservationInterval(ctx, p.PoolId, p.Interval)
+}
+
+func NewTwapProposalHandler(k Keeper) govtypesv1.Handler {
+	return func(ctx sdk.Context, content govtypesv1.Content) error {
+		switch c := content.(type) {
+		case *types.FreezeTwapProposal:
+			return k.HandleFreezeTwapProposal(ctx, c)
+		case *types.SetPoolObservationIntervalProposal:
+			return k.HandleSetPoolObservationIntervalProposal(ctx, c)
+
+		default:
+			return fmt.Errorf("unrecognized twap proposal content type: %T", c)
+		}
+	}
+}
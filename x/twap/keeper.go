@@ -48,6 +48,12 @@ func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
 	k.paramSpace.Set(ctx, key, value)
 }
 
+// ResolveMigratedPoolId returns the id of the pool that TWAP queries against the given pool should be served by,
+// following the pool's migration link in x/poolmanager if it has one.
+func (k Keeper) ResolveMigratedPoolId(ctx sdk.Context, poolId uint64) uint64 {
+	return k.poolmanagerKeeper.ResolveMigratedPoolId(ctx, poolId)
+}
+
 func (k *Keeper) PruneEpochIdentifier(ctx sdk.Context) string {
 	return k.GetParams(ctx).PruneEpochIdentifier
 }
@@ -75,6 +81,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, twap := range genState.Twaps {
 		k.StoreNewRecord(ctx, twap)
 	}
+
+	for _, freeze := range genState.Freezes {
+		k.SetTwapFreeze(ctx, freeze)
+	}
+
+	for _, interval := range genState.ObservationIntervals {
+		if err := k.SetPoolObservationInterval(ctx, interval.PoolId, interval.Interval); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, poolId := range genState.PendingObservationPoolIds {
+		k.trackPendingObservation(ctx, poolId)
+	}
 }
 
 // ExportGenesis returns the twap module's exported genesis.
@@ -84,9 +104,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	freezes, err := k.GetActiveTwapFreezes(ctx, 0)
+	if err != nil {
+		panic(err)
+	}
+
+	observationIntervals, err := k.GetAllPoolObservationIntervals(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	pendingObservationPoolIds, err := k.getPendingObservationPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:                    k.GetParams(ctx),
+		Twaps:                     twapRecords,
+		Freezes:                   freezes,
+		ObservationIntervals:      observationIntervals,
+		PendingObservationPoolIds: pendingObservationPoolIds,
 	}
 }
 
@@ -100,6 +138,18 @@ func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
 
+// getStrategy returns the TWAP strategy matching the given strategy type.
+func (k Keeper) getStrategy(strategy types.TwapStrategy) (twapStrategy, error) {
+	switch strategy {
+	case types.TwapStrategyArithmetic:
+		return k.GetArithmeticStrategy(), nil
+	case types.TwapStrategyGeometric:
+		return k.GetGeometricStrategy(), nil
+	default:
+		return nil, types.InvalidTwapStrategyError{Strategy: strategy}
+	}
+}
+
 // GetPruningState gets the current pruning state, which is used to determine
 // whether to prune historical records in the EndBlock. This allows us to spread
 // out the computational cost of pruning over time rather than all at once at epoch.
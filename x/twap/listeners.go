@@ -31,13 +31,13 @@ func (*epochhook) GetModuleName() string {
 func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	if epochIdentifier == hook.k.PruneEpochIdentifier(ctx) {
 		lastKeptTime := ctx.BlockTime().Add(-hook.k.RecordHistoryKeepPeriod(ctx))
-		poolIdToStartFrom := hook.k.poolmanagerKeeper.GetNextPoolId(ctx) - 1
-		if poolIdToStartFrom > 0 {
-			hook.k.SetPruningState(ctx, types.PruningState{
-				IsPruning:      true,
-				LastKeptTime:   lastKeptTime,
-				LastSeenPoolId: poolIdToStartFrom,
-			})
+		hook.k.SetPruningState(ctx, types.PruningState{
+			IsPruning:    true,
+			LastKeptTime: lastKeptTime,
+		})
+
+		if err := hook.k.pruneExpiredTwapFreezes(ctx); err != nil {
+			ctx.Logger().Error("Error pruning expired twap freezes at the epoch end", err)
 		}
 	}
 	return nil
@@ -73,6 +73,11 @@ func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, pool
 	hook.k.trackChangedPool(ctx, poolId)
 }
 
+// AfterSmoothWeightChangeCompleted is called after the weights of a balancer pool reached their target in x/gamm.
+func (hook *gammhook) AfterSmoothWeightChangeCompleted(ctx sdk.Context, poolId uint64) {
+	hook.k.trackChangedPool(ctx, poolId)
+}
+
 type concentratedLiquidityListener struct {
 	k Keeper
 }
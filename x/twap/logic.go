@@ -18,7 +18,7 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 	}
 	previousErrorTime := time.Time{} // no previous error
 	sp0, sp1, lastErrorTime := getSpotPrices(ctx, k, poolId, denom0, denom1, previousErrorTime)
-	return types.TwapRecord{
+	record := types.TwapRecord{
 		PoolId:                      poolId,
 		Asset0Denom:                 denom0,
 		Asset1Denom:                 denom1,
@@ -30,7 +30,9 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
-	}, nil
+	}
+	emitSpotPriceErrorEventIfErrored(ctx, record)
+	return record, nil
 }
 
 // getSpotPrices gets the spot prices for the pool,
@@ -106,10 +108,17 @@ func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
 func (k Keeper) EndBlock(ctx sdk.Context) {
 	// get changed pools grabs all altered pool ids from the transient store.
 	// 'altered pool ids' gets automatically cleared on commit by being a transient store
-	changedPoolIds := k.getChangedPools(ctx)
-	for _, id := range changedPoolIds {
+	// pools with an observation interval that changed in an earlier block, but were not recorded yet,
+	// are kept pending in the store instead.
+	poolIds, err := k.getPoolsToUpdate(ctx)
+	if err != nil {
+		ctx.Logger().Error("Error getting the pending twap observations at the end block", err)
+	}
+	for _, id := range poolIds {
 		err := k.updateRecords(ctx, id)
 		if err != nil {
+			// the pool is recorded again once it changes, rather than retrying every block.
+			k.deletePendingObservation(ctx, id)
 			ctx.Logger().Error(fmt.Errorf(
 				"error in TWAP end block, for updating records for pool id %d."+
 					" Skipping record update. Underlying err: %w", id, err).Error())
@@ -134,6 +143,9 @@ func (k Keeper) EndBlock(ctx sdk.Context) {
 //   - fails to get denoms from the pool.
 //   - the number of records does not match expected relative to the
 //     number of denoms in the pool.
+//
+// If the pool has an observation interval that has not elapsed since its most recent records,
+// no spot prices are computed, and the pool is kept pending to be recorded in a later block.
 func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
 	denoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, poolId)
 	if err != nil {
@@ -155,13 +167,28 @@ func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
 		return types.InvalidRecordCountError{Expected: expectedRecordsLength, Actual: len(records)}
 	}
 
+	isDue, err := k.isObservationDue(ctx, poolId, records)
+	if err != nil {
+		return err
+	}
+	if !isDue {
+		k.trackPendingObservation(ctx, poolId)
+		return nil
+	}
+
+	newRecords := make([]types.TwapRecord, 0, len(records))
 	for _, record := range records {
 		newRecord, err := k.updateRecord(ctx, record)
 		if err != nil {
 			return err
 		}
+		newRecords = append(newRecords, newRecord)
+	}
+
+	for _, newRecord := range newRecords {
 		k.StoreNewRecord(ctx, newRecord)
 	}
+	k.deletePendingObservation(ctx, poolId)
 	return nil
 }
 
@@ -199,6 +226,7 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 	newRecord.P0LastSpotPrice = newSp0
 	newRecord.P1LastSpotPrice = newSp1
 	newRecord.LastErrorTime = lastErrorTime
+	emitSpotPriceErrorEventIfErrored(ctx, newRecord)
 
 	return newRecord, nil
 }
@@ -217,36 +245,9 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	timeDelta := types.CanonicalTimeMs(newTime) - types.CanonicalTimeMs(record.Time)
 	newRecord.Time = newTime
 
-	// record.LastSpotPrice is the last spot price from the block the record was created in,
-	// thus it is treated as the effective spot price until the new time.
-	// (As there was no change until at or after this time)
-	p0NewAccum := types.SpotPriceMulDuration(record.P0LastSpotPrice, timeDelta)
-	newRecord.P0ArithmeticTwapAccumulator = p0NewAccum.AddMut(newRecord.P0ArithmeticTwapAccumulator)
-
-	p1NewAccum := types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta)
-	newRecord.P1ArithmeticTwapAccumulator = p1NewAccum.AddMut(newRecord.P1ArithmeticTwapAccumulator)
-
-	// If the last spot price is zero, then the logarithm is undefined.
-	// As a result, we cannot update the geometric accumulator.
-	// We set the last error time to be the new time, and return the record.
-	if record.P0LastSpotPrice.IsZero() {
-		newRecord.LastErrorTime = newTime
-		return newRecord
+	for _, strategy := range accumulatorStrategies {
+		newRecord = strategy.updateAccumulators(record, newRecord, timeDelta)
 	}
-
-	// NOTE: An edge case exists here. If a pool is drained of all it's liquidity, and then the pool's
-	// spot price is set to exactly one and the GeometricTWAP is queried, the the result will be zero.
-	// This is because the P0LastSpotPrice is one, which makes log_{2}{P_0} = 0, and thus the geometric
-	// accumulator is the same as the time of pool drain.
-
-	// This is a edge case almost certainly to never be hit in prod, but its good to be aware of.
-
-	// logP0SpotPrice = log_{2}{P_0}
-	logP0SpotPrice := twapLog(record.P0LastSpotPrice)
-	// p0NewGeomAccum = log_{2}{P_0} * timeDelta
-	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
-	newRecord.GeometricTwapAccumulator = p0NewGeomAccum.AddMut(newRecord.GeometricTwapAccumulator)
-
 	return newRecord
 }
 
@@ -254,7 +255,11 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 // This is achieved by getting the record `r` that is at, or immediately preceding in state time `t`.
 // To be clear: the record r s.t. `t - r.Time` is minimized AND `t >= r.Time`
 // If for the record obtained, r.Time == r.LastErrorTime, this will also hold for the interpolated record.
+// If the pair has an active twap freeze and `t` is after its freeze time, the frozen record is interpolated instead.
 func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Time, assetA, assetB string) (types.TwapRecord, error) {
+	if record, frozen, err := k.getFrozenRecordIfActive(ctx, poolId, t, assetA, assetB); err != nil || frozen {
+		return record, err
+	}
 	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, t, assetA, assetB)
 	if err != nil {
 		return types.TwapRecord{}, err
@@ -268,6 +273,9 @@ func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Tim
 }
 
 func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, assetB string) (types.TwapRecord, error) {
+	if record, frozen, err := k.getFrozenRecordIfActive(ctx, poolId, ctx.BlockTime(), assetA, assetB); err != nil || frozen {
+		return record, err
+	}
 	record, err := k.getMostRecentRecordStoreRepresentation(ctx, poolId, assetA, assetB)
 	if err != nil {
 		return types.TwapRecord{}, err
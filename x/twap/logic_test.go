@@ -244,9 +244,17 @@ func (s *TestSuite) TestUpdateRecord() {
 				defaultTwoAssetCoins[1].Denom, defaultTwoAssetCoins[0].Denom,
 				test.spotPriceResult1.Sp, test.spotPriceResult1.Err)
 
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
 			newRecord, err := s.twapkeeper.UpdateRecord(s.Ctx, test.record)
 			s.Require().NoError(err)
 			s.Equal(test.expRecord, newRecord)
+
+			// A spot price error event is emitted only if getting the spot prices errored at update.
+			expectedSpotPriceErrorEvents := 0
+			if test.expRecord.LastErrorTime.Equal(updateTime) {
+				expectedSpotPriceErrorEvents = 1
+			}
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSpotPriceError, expectedSpotPriceErrorEvents)
 		})
 	}
 }
@@ -0,0 +1,107 @@
+package twap
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// SetPoolObservationInterval sets the minimum time between the twap records of the given pool.
+// An interval of zero removes it, so that the pool is recorded every block it changes again.
+func (k Keeper) SetPoolObservationInterval(ctx sdk.Context, poolId uint64, interval time.Duration) error {
+	if err := types.ValidateObservationInterval(interval); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if interval == 0 {
+		store.Delete(types.FormatObservationIntervalKey(poolId))
+		return nil
+	}
+	osmoutils.MustSet(store, types.FormatObservationIntervalKey(poolId), &types.PoolObservationInterval{
+		PoolId:   poolId,
+		Interval: interval,
+	})
+	return nil
+}
+
+// GetPoolObservationInterval returns the observation interval of the given pool.
+// Returns zero if the pool has no observation interval set.
+func (k Keeper) GetPoolObservationInterval(ctx sdk.Context, poolId uint64) (time.Duration, error) {
+	interval := types.PoolObservationInterval{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatObservationIntervalKey(poolId), &interval)
+	if err != nil || !found {
+		return 0, err
+	}
+	return interval.Interval, nil
+}
+
+// GetAllPoolObservationIntervals returns the observation intervals of all pools.
+func (k Keeper) GetAllPoolObservationIntervals(ctx sdk.Context) ([]types.PoolObservationInterval, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.ObservationIntervalPrefix), types.ParsePoolObservationIntervalFromBz)
+}
+
+// trackPendingObservation marks the pool as changed since its most recent record,
+// so that it is recorded once its observation interval elapses, even if it does not change again.
+// The key is only written if the pool is not pending yet, as it is checked every block the pool is pending.
+func (k Keeper) trackPendingObservation(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatPendingObservationKey(poolId)
+	if !store.Has(key) {
+		store.Set(key, sentinelExistsValue)
+	}
+}
+
+func (k Keeper) deletePendingObservation(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatPendingObservationKey(poolId))
+}
+
+// getPendingObservationPools returns the pools that changed since their most recent record, in ascending order.
+func (k Keeper) getPendingObservationPools(ctx sdk.Context) ([]uint64, error) {
+	prefix := []byte(types.PendingObservationPrefix)
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), prefix, func(key, _ []byte) (uint64, error) {
+		return strconv.ParseUint(string(key[len(prefix):]), 10, 64)
+	})
+}
+
+// getPoolsToUpdate returns the pools to update the records of this block: the pools that changed this block,
+// followed by the pools that changed in an earlier block but were not recorded yet due to their observation interval.
+func (k Keeper) getPoolsToUpdate(ctx sdk.Context) ([]uint64, error) {
+	poolIds := k.getChangedPools(ctx)
+	pendingPoolIds, err := k.getPendingObservationPools(ctx)
+	if err != nil {
+		return poolIds, err
+	}
+
+	changedPools := make(map[uint64]struct{}, len(poolIds))
+	for _, poolId := range poolIds {
+		changedPools[poolId] = struct{}{}
+	}
+	for _, poolId := range pendingPoolIds {
+		if _, ok := changedPools[poolId]; !ok {
+			poolIds = append(poolIds, poolId)
+		}
+	}
+	return poolIds, nil
+}
+
+// isObservationDue returns whether the records of the pool should be updated this block.
+// That is the case if the pool has no observation interval, or the interval has elapsed since its most recent records.
+// It is checked before computing the pool's spot prices, so a pool within its interval costs no spot price queries.
+func (k Keeper) isObservationDue(ctx sdk.Context, poolId uint64, records []types.TwapRecord) (bool, error) {
+	interval, err := k.GetPoolObservationInterval(ctx, poolId)
+	if err != nil || interval == 0 {
+		return true, err
+	}
+
+	for _, record := range records {
+		if !ctx.BlockTime().Before(record.Time.Add(interval)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
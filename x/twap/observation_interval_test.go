@@ -0,0 +1,107 @@
+package twap_test
+
+import (
+	"time"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+func (s *TestSuite) TestHandleSetPoolObservationIntervalProposal() {
+	tests := map[string]struct {
+		poolId      uint64
+		interval    time.Duration
+		expectedErr bool
+	}{
+		"valid interval": {
+			poolId:   1,
+			interval: 30 * time.Second,
+		},
+		"zero interval removes it": {
+			poolId:   1,
+			interval: 0,
+		},
+		"interval above max": {
+			poolId:      1,
+			interval:    types.MaxObservationInterval + time.Second,
+			expectedErr: true,
+		},
+		"pool does not exist": {
+			poolId:      2,
+			interval:    30 * time.Second,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, _, _ := s.setupDefaultPool()
+			err := s.twapkeeper.SetPoolObservationInterval(s.Ctx, poolId, time.Minute)
+			s.Require().NoError(err)
+
+			proposal := types.NewSetPoolObservationIntervalProposal("title", "description", tc.poolId, tc.interval).(*types.SetPoolObservationIntervalProposal)
+			err = s.twapkeeper.HandleSetPoolObservationIntervalProposal(s.Ctx, proposal)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			interval, err := s.twapkeeper.GetPoolObservationInterval(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.interval, interval)
+
+			intervals := s.twapkeeper.ExportGenesis(s.Ctx).ObservationIntervals
+			if tc.interval == 0 {
+				s.Require().Empty(intervals)
+			} else {
+				s.Require().Equal([]types.PoolObservationInterval{{PoolId: poolId, Interval: tc.interval}}, intervals)
+			}
+		})
+	}
+}
+
+// TestEndBlock_ObservationInterval tests that a pool with an observation interval is recorded at most once per interval,
+// and that a change within the interval is recorded once it elapses, even if the pool does not change again.
+func (s *TestSuite) TestEndBlock_ObservationInterval() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(baseTime)
+	poolId := s.CreatePoolFromTypeWithCoins(poolmanagertypes.Balancer, defaultTwoAssetCoins)
+	err := s.twapkeeper.SetPoolObservationInterval(s.Ctx, poolId, 3*time.Second)
+	s.Require().NoError(err)
+
+	recordAfterPoolCreation, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom1)
+	s.Require().NoError(err)
+	s.EndBlock()
+	s.Commit()
+
+	// the swap changes the spot price within the interval, so it is not recorded.
+	s.RunBasicSwap(poolId)
+	s.EndBlock()
+	s.Commit()
+	record, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(recordAfterPoolCreation, record)
+	s.Require().Equal([]uint64{poolId}, s.twapkeeper.ExportGenesis(s.Ctx).PendingObservationPoolIds)
+
+	s.EndBlock()
+	s.Commit()
+	record, err = s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(recordAfterPoolCreation, record)
+
+	// the interval elapses, so the pool is recorded at the spot price after the swap.
+	observationTime := s.Ctx.BlockTime()
+	s.Require().Equal(baseTime.Add(3*time.Second), observationTime)
+	s.EndBlock()
+	s.Commit()
+	record, err = s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().True(observationTime.Equal(record.Time))
+	spotPrice, err := s.App.PoolManagerKeeper.RouteCalculateSpotPrice(s.Ctx, poolId, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(spotPrice.Dec(), record.P0LastSpotPrice)
+	s.Require().NotEqual(recordAfterPoolCreation.P0LastSpotPrice, record.P0LastSpotPrice)
+	s.Require().Empty(s.twapkeeper.ExportGenesis(s.Ctx).PendingObservationPoolIds)
+}
@@ -0,0 +1,56 @@
+package twap
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// emitSpotPriceErrorEventIfErrored emits an event for the pair of the given record
+// if getting its spot prices errored this block, so that its TWAPs are derived from erroneous spot prices.
+func emitSpotPriceErrorEventIfErrored(ctx sdk.Context, record types.TwapRecord) {
+	if !record.LastErrorTime.Equal(ctx.BlockTime()) {
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSpotPriceError,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(record.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyAsset0Denom, record.Asset0Denom),
+		sdk.NewAttribute(types.AttributeKeyAsset1Denom, record.Asset1Denom),
+	))
+}
+
+// GetPairsWithRecentErrors returns the pairs whose last spot price error happened within the given window
+// before the current block time, i.e. the pairs whose TWAPs over the window are derived from erroneous spot prices.
+// Returns error if the window is not positive.
+func (k Keeper) GetPairsWithRecentErrors(ctx sdk.Context, window time.Duration) ([]queryproto.PairWithRecentError, error) {
+	if window <= 0 {
+		return nil, errors.New("window must be positive")
+	}
+
+	records, err := types.GetAllMostRecentTwaps(ctx.KVStore(k.storeKey))
+	if err != nil {
+		return nil, err
+	}
+
+	windowStart := ctx.BlockTime().Add(-window)
+	pairs := []queryproto.PairWithRecentError{}
+	for _, record := range records {
+		// The zero last error time of pairs that never errored is never within the window.
+		if record.LastErrorTime.Before(windowStart) {
+			continue
+		}
+		pairs = append(pairs, queryproto.PairWithRecentError{
+			PoolId:        record.PoolId,
+			Asset0Denom:   record.Asset0Denom,
+			Asset1Denom:   record.Asset1Denom,
+			LastErrorTime: record.LastErrorTime,
+		})
+	}
+	return pairs, nil
+}
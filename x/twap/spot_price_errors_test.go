@@ -0,0 +1,62 @@
+package twap_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v26/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// TestGetPairsWithRecentErrors tests that only the pairs whose last spot price error
+// is within the window before the block time are returned.
+func (s *TestSuite) TestGetPairsWithRecentErrors() {
+	recordAB, recordAC, recordBC := newThreeAssetPoolTwapRecordWithDefaults(baseTime, ThreePlusOneThird, zeroDec, zeroDec, zeroDec, zeroDec, zeroDec, zeroDec)
+	// AB never errored, AC errored at base time and BC errored 10 seconds before the block time.
+	recordAC = withLastErrTime(recordAC, baseTime)
+	recordBC = withLastErrTime(recordBC, tPlusOneMin.Add(-10*time.Second))
+
+	tests := map[string]struct {
+		window        time.Duration
+		expectedPairs []queryproto.PairWithRecentError
+		expectedErr   error
+	}{
+		"window including both errors": {
+			window: time.Minute,
+			expectedPairs: []queryproto.PairWithRecentError{
+				{PoolId: recordAC.PoolId, Asset0Denom: recordAC.Asset0Denom, Asset1Denom: recordAC.Asset1Denom, LastErrorTime: recordAC.LastErrorTime},
+				{PoolId: recordBC.PoolId, Asset0Denom: recordBC.Asset0Denom, Asset1Denom: recordBC.Asset1Denom, LastErrorTime: recordBC.LastErrorTime},
+			},
+		},
+		"window including the most recent error": {
+			window: 30 * time.Second,
+			expectedPairs: []queryproto.PairWithRecentError{
+				{PoolId: recordBC.PoolId, Asset0Denom: recordBC.Asset0Denom, Asset1Denom: recordBC.Asset1Denom, LastErrorTime: recordBC.LastErrorTime},
+			},
+		},
+		"window including no error": {
+			window:        5 * time.Second,
+			expectedPairs: []queryproto.PairWithRecentError{},
+		},
+		"error: zero window": {
+			window:      0,
+			expectedErr: errors.New("window must be positive"),
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{recordAB, recordAC, recordBC})
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			pairs, err := s.twapkeeper.GetPairsWithRecentErrors(s.Ctx, test.window)
+			if test.expectedErr != nil {
+				s.Require().ErrorContains(err, test.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedPairs, pairs)
+		})
+	}
+}
@@ -13,9 +13,9 @@ import (
 	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
 )
 
-// NumRecordsToPrunePerBlock is the number of twap records indexed by pool ID to prune per block.
-// One record indexed by pool ID is deleted per incentive record.
-// Therefore, setting this to 200 means 200 complete incentive records are deleted per block.
+// NumRecordsToPrunePerBlock is the number of pruning time index entries to visit per block.
+// Every visited entry is either deleted along with its record, or kept as the newest record
+// of its pair before the pruning time.
 // The choice is somewhat arbitrary
 // However, th intuition is that the number should be low enough to not make blocks take longer but
 // not too small where it would take all the way to the next epoch.
@@ -69,10 +69,12 @@ func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
 }
 
 // storeHistoricalTWAP writes a twap to the store, indexed by pool id.
+// The record key is also written to the pruning time index, so that pruning can find old records by time.
 func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.FormatHistoricalPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
 	osmoutils.MustSet(store, key, &twap)
+	store.Set(types.FormatPruningTimeIndexKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time), key)
 }
 
 // pruneRecordsBeforeTimeButNewest prunes all records for each pool before the given time but the newest
@@ -89,63 +91,96 @@ func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
 //
-// If we reach the per block pruning limit, we store the last key seen in the pruning state.
+// Records are found by iterating the pruning time index from the oldest record up to the pruning time,
+// so only records older than the pruning time are visited. The index entry of the kept record is deleted once
+// it has been visited, and the record kept by the previous pruning is deleted when a newer one is kept.
+// If we reach the per block pruning limit, we store the next time index key in the pruning state.
 // This is so that we can continue pruning from where we left off in the next block.
 // If we have pruned all records, we set the pruning state to not pruning.
 func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, state types.PruningState) error {
 	store := ctx.KVStore(k.storeKey)
 
-	var numPruned uint16
-	var lastPoolIdCompleted uint64
+	startKey := state.LastSeenTimeIndexKey
+	if len(startKey) == 0 {
+		startKey = []byte(types.PruningTimeIndexPrefix)
+	}
+	iter := store.Iterator(startKey, types.FormatPruningTimeIndexTimePrefix(state.LastKeptTime))
+	defer iter.Close()
 
-	for poolId := state.LastSeenPoolId; poolId > 0; poolId-- {
-		denoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, poolId)
+	var numVisited uint16
+	for ; iter.Valid(); iter.Next() {
+		if numVisited >= NumRecordsToPrunePerBlock {
+			// We have hit the limit, so we resume from this entry in the next block.
+			state.LastSeenTimeIndexKey = iter.Key()
+			k.SetPruningState(ctx, state)
+			return nil
+		}
+		numVisited += 1
+
+		timeIndexKey, recordKey := iter.Key(), iter.Value()
+		bz := store.Get(recordKey)
+		if bz == nil {
+			// The record has already been deleted, so only the index entry is left to delete.
+			store.Delete(timeIndexKey)
+			continue
+		}
+		record, err := types.ParseTwapFromBz(bz)
 		if err != nil {
 			return err
 		}
 
-		// Notice, if we hit the prune limit in the middle of a pool, we will re-iterate over the completed pruned pool records.
-		// This is acceptable overhead for the simplification this provides.
-		denomPairs := types.GetAllUniqueDenomPairs(denoms)
-		for _, denomPair := range denomPairs {
-			// Reverse iterator guarantees that we iterate through the newest per pool first.
-			// Due to how it is indexed, we will only iterate times starting from
-			// lastKeptTime exclusively down to the oldest record.
-			iter := store.ReverseIterator(
-				types.FormatHistoricalPoolIndexDenomPairTWAPKey(poolId, denomPair.Denom0, denomPair.Denom1),
-				types.FormatHistoricalPoolIndexTWAPKey(poolId, denomPair.Denom0, denomPair.Denom1, state.LastKeptTime))
-			defer iter.Close()
-
-			firstIteration := true
-			for ; iter.Valid(); iter.Next() {
-				if !firstIteration {
-					// We have stored the newest record, so we can prune the rest.
-					timeIndexKey := iter.Key()
-					store.Delete(timeIndexKey)
-					numPruned += 1
-
-					if numPruned >= NumRecordsToPrunePerBlock {
-						// We have hit the limit in the middle of a pool.
-						// We store this pool as the last seen pool in the pruning state.
-						// We accept re-iterating over denomPairs as acceptable overhead.
-						state.LastSeenPoolId = poolId
-						k.SetPruningState(ctx, state)
-						return nil
-					}
-				} else {
-					// If this is the first iteration after we have gotten through the records after lastKeptTime, we
-					// still keep the record in order to allow interpolation (see function description for more details).
-					firstIteration = false
-				}
-			}
+		if hasRecordInTimeRange(store, record, state.LastKeptTime) {
+			store.Delete(recordKey)
+			store.Delete(timeIndexKey)
+			continue
+		}
+
+		// The newest record before lastKeptTime is kept in order to allow interpolation
+		// (see function description for more details). Its index entry is deleted, so that it is not
+		// visited again by later prunings, and the record kept by the previous pruning is deleted
+		// in its place, since that one has no index entry left.
+		store.Delete(timeIndexKey)
+		if err := deleteRecordsBefore(store, record); err != nil {
+			return err
+		}
+	}
+
+	// We have pruned all records.
+	state.IsPruning = false
+	state.LastSeenTimeIndexKey = nil
+	k.SetPruningState(ctx, state)
+	return nil
+}
+
+// hasRecordInTimeRange returns true if there is a historical record for the pair of the given record,
+// written after the given record and strictly before endTime.
+func hasRecordInTimeRange(store storetypes.KVStore, record types.TwapRecord, endTime time.Time) bool {
+	iter := store.Iterator(
+		types.FormatHistoricalPoolIndexTimeSuffix(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time),
+		types.FormatHistoricalPoolIndexTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, endTime))
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// deleteRecordsBefore deletes the historical records for the pair of the given record, written before the given record.
+func deleteRecordsBefore(store storetypes.KVStore, record types.TwapRecord) error {
+	iter := store.Iterator(
+		types.FormatHistoricalPoolIndexTimePrefix(record.PoolId, record.Asset0Denom, record.Asset1Denom),
+		types.FormatHistoricalPoolIndexTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time))
+	olderRecords := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		olderRecord, err := types.ParseTwapFromBz(iter.Value())
+		if err != nil {
+			iter.Close()
+			return err
 		}
-		lastPoolIdCompleted = poolId
+		olderRecords = append(olderRecords, olderRecord)
 	}
+	iter.Close()
 
-	if lastPoolIdCompleted == 1 {
-		// We have pruned all records.
-		state.IsPruning = false
-		k.SetPruningState(ctx, state)
+	for _, olderRecord := range olderRecords {
+		store.Delete(types.FormatHistoricalPoolIndexTWAPKey(olderRecord.PoolId, olderRecord.Asset0Denom, olderRecord.Asset1Denom, olderRecord.Time))
+		store.Delete(types.FormatPruningTimeIndexKey(olderRecord.PoolId, olderRecord.Asset0Denom, olderRecord.Asset1Denom, olderRecord.Time))
 	}
 	return nil
 }
@@ -154,6 +189,24 @@ func (k Keeper) DeleteHistoricalRecord(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.FormatHistoricalPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
 	store.Delete(key)
+	store.Delete(types.FormatPruningTimeIndexKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time))
+}
+
+// BackfillPruningTimeIndex writes the pruning time index entry of every historical record.
+// This is to be used in the upgrade handler, to index the records stored before the pruning time index existed.
+// The records are streamed from the store rather than loaded in memory, as there can be millions of them.
+func (k Keeper) BackfillPruningTimeIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, []byte(types.HistoricalTWAPPoolIndexPrefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		record, err := types.ParseTwapFromBz(iter.Value())
+		if err != nil {
+			return err
+		}
+		store.Set(types.FormatPruningTimeIndexKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time), iter.Key())
+	}
+	return nil
 }
 
 // getMostRecentRecordStoreRepresentation returns the most recent twap record in the store
@@ -252,8 +305,8 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 
 	twap, err := osmoutils.GetFirstValueInRange(store, startKey, endKey, reverseIterate, types.ParseTwapFromBz)
 	if err != nil {
-		// diagnose why we have no results by seeing what happens for getMostRecentRecord for this pool id
-		_, errDiagnose := k.getMostRecentRecord(ctx, poolId, asset0Denom, asset1Denom)
+		// diagnose why we have no results by seeing what happens for getMostRecentRecordStoreRepresentation for this pool id
+		_, errDiagnose := k.getMostRecentRecordStoreRepresentation(ctx, poolId, asset0Denom, asset1Denom)
 		if errDiagnose != nil {
 			return types.TwapRecord{}, fmt.Errorf(
 				"getTwapRecord: querying for assets %s %s that are not in pool id %d",
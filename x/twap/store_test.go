@@ -519,23 +519,20 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 
 			expectedKeptRecords: []types.TwapRecord{},
 		},
-		"base time; across pool 3 and pool 5; pool 3: 4 total records; 3 before lastKeptTime; 2 in queue due to pool with larger ID hitting limit. pool 5: 24 total records; 12 before lastKeptTime; 9 deleted and 15 kept, 3 in queue due to prune limit": {
+		"base time; across pool 3 and pool 5; pool 3: 4 total records; 3 before lastKeptTime; 2 in queue due to older records hitting limit. pool 5: 24 total records; 12 before lastKeptTime; 9 deleted and 15 kept, 3 in queue due to prune limit": {
 			recordsToPreSet: []types.TwapRecord{
 				pool3BaseSecMin3Ms, // base time - 3ms; in queue for deletion
 				pool3BaseSecMin2Ms, // base time - 2ms; in queue for deletion
 				pool3BaseSecMin1Ms, // base time - 1ms; kept since newest before lastKeptTime
 				pool3BaseSecBaseMs, // base time; kept since at lastKeptTime
 
-				pool5Min2SBaseMsAB, pool5Min2SBaseMsAC, // base time - 2s; deleted
-				pool5Min2SBaseMsBC,                                         // base time - 2s; in queue for deletion
-				pool5Min1SBaseMsAB, pool5Min1SBaseMsAC, pool5Min1SBaseMsBC, // base time - 1s; ; deleted
+				pool5Min2SBaseMsAB, pool5Min2SBaseMsAC, pool5Min2SBaseMsBC, // base time - 2s; deleted
+				pool5Min1SBaseMsAB, pool5Min1SBaseMsAC, pool5Min1SBaseMsBC, // base time - 1s; in queue for deletion
 				pool5BaseSecBaseMsAB, pool5BaseSecBaseMsAC, pool5BaseSecBaseMsBC, // base time; kept since at lastKeptTime
 				pool5Plus1SBaseMsAB, pool5Plus1SBaseMsAC, pool5Plus1SBaseMsBC, // base time + 1s; kept since older than lastKeptTime
 
-				pool5Min2SMin1MsAB, pool5Min2SMin1MsAC, // base time - 2s - 1ms; deleted
-				pool5Min2SMin1MsBC,                     // base time - 2s - 1ms; in queue for deletion
-				pool5Min1SMin1MsAB, pool5Min1SMin1MsAC, // base time - 1s - 1ms; deleted
-				pool5Min1SMin1MsBC,                                               // base time - 1s - 1ms; in queue for deletion
+				pool5Min2SMin1MsAB, pool5Min2SMin1MsAC, pool5Min2SMin1MsBC, // base time - 2s - 1ms; deleted
+				pool5Min1SMin1MsAB, pool5Min1SMin1MsAC, pool5Min1SMin1MsBC, // base time - 1s - 1ms; deleted
 				pool5BaseSecMin1MsAB, pool5BaseSecMin1MsAC, pool5BaseSecMin1MsBC, // base time - 1ms; kept since newest before lastKeptTime
 				pool5Plus1SMin1MsAB, pool5Plus1SMin1MsAC, pool5Plus1SMin1MsBC, // base time + 1s - 1ms; kept since older than lastKeptTime
 			},
@@ -551,9 +548,7 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 				pool5BaseSecBaseMsAB, pool5BaseSecBaseMsAC, pool5BaseSecBaseMsBC,
 				pool5Plus1SMin1MsAB, pool5Plus1SMin1MsAC, pool5Plus1SMin1MsBC,
 				pool5Plus1SBaseMsAB, pool5Plus1SBaseMsAC, pool5Plus1SBaseMsBC,
-				pool5Min2SMin1MsBC, // in queue for deletion
-				pool5Min2SBaseMsBC, // in queue for deletion
-				pool5Min1SMin1MsBC, // in queue for deletion
+				pool5Min1SBaseMsAB, pool5Min1SBaseMsAC, pool5Min1SBaseMsBC, // in queue for deletion
 			},
 
 			overwriteLimit: 9, // 5 total records in queue to be deleted due to limit
@@ -578,9 +573,8 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 			}
 
 			state := types.PruningState{
-				IsPruning:      true,
-				LastKeptTime:   tc.lastKeptTime,
-				LastSeenPoolId: s.App.PoolManagerKeeper.GetNextPoolId(s.Ctx) - 1,
+				IsPruning:    true,
+				LastKeptTime: tc.lastKeptTime,
 			}
 
 			err := twapKeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, state)
@@ -639,9 +633,9 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewestPerBlock() {
 		pool2Min1SMin1MsAB, pool2Min1SMin1MsAC, pool2Min1SMin1MsBC, // base time - 1s - 1ms; kept since older
 		pool2Min1SBaseMsAB, pool2Min1SBaseMsAC, pool2Min1SBaseMsBC, // base time - 1s; kept since older
 
-		pool1Min2SMin3Ms, // base time - 2s - 3ms; will be deleted in block 2
-		pool1Min2SMin2Ms, // base time - 2s - 2ms; will be deleted in block 2
-		pool1Min2SMin1Ms, // base time - 2s - 1ms; should be deleted, but will be kept due to bug
+		pool1Min2SMin3Ms, // base time - 2s - 3ms; will be deleted in block 1
+		pool1Min2SMin2Ms, // base time - 2s - 2ms; will be deleted in block 1
+		pool1Min2SMin1Ms, // base time - 2s - 1ms; will be deleted in block 1
 		pool1Min2SBaseMs, // base time - 2s; kept since newest before lastKeptTime
 
 		pool4Plus1SMin3Ms, // base time + 1s - 3ms; kept since older
@@ -654,36 +648,35 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewestPerBlock() {
 		pool5BaseSecBaseMsAB, pool5BaseSecBaseMsAC, pool5BaseSecBaseMsBC, // base time; kept since older
 		pool5Plus1SBaseMsAB, pool5Plus1SBaseMsAC, pool5Plus1SBaseMsBC, // base time + 1s; kept since older
 
-		pool5Min2SMin1MsAB, pool5Min2SMin1MsAC, pool5Min2SMin1MsBC, // base time - 2s - 1ms; will be deleted in block 1
+		pool5Min2SMin1MsAB, pool5Min2SMin1MsAC, pool5Min2SMin1MsBC, // base time - 2s - 1ms; will be deleted in block 2
 		pool5Min1SMin1MsAB, pool5Min1SMin1MsAC, pool5Min1SMin1MsBC, // base time - 1s - 1ms; kept since older
 		pool5BaseSecMin1MsAB, pool5BaseSecMin1MsAC, pool5BaseSecMin1MsBC, // base time - 1ms; kept since older
 		pool5Plus1SMin1MsAB, pool5Plus1SMin1MsAC, pool5Plus1SMin1MsBC, // base time + 1s - 1ms; kept since older
 	}
 	s.preSetRecords(recordsToPreSet)
 
-	twap.NumRecordsToPrunePerBlock = 3 // 3 records max will be pruned per block
+	twap.NumRecordsToPrunePerBlock = 3 // 3 time index entries max will be visited per block
 	lastKeptTime := baseTime.Add(-time.Second).Add(2 * -time.Millisecond)
 
 	state := types.PruningState{
-		IsPruning:      true,
-		LastKeptTime:   lastKeptTime,
-		LastSeenPoolId: s.App.PoolManagerKeeper.GetNextPoolId(s.Ctx) - 1,
+		IsPruning:    true,
+		LastKeptTime: lastKeptTime,
 	}
 
 	// Block 1
 	err := s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, state)
 	s.Require().NoError(err)
 
-	// Pruning state should show pruning is still true, lastKeptTime is the same, and the last key seen is the last key we deleted.
+	// Pruning state should show pruning is still true, lastKeptTime is the same, and the next key to visit is the first
+	// record of pool 5, which is indexed after pool 1's record of the same time.
 	newPruningState := s.twapkeeper.GetPruningState(s.Ctx)
 	s.Require().Equal(true, newPruningState.IsPruning)
 	s.Require().Equal(lastKeptTime, newPruningState.LastKeptTime)
+	s.Require().Equal(types.FormatPruningTimeIndexKey(pool5Min2SMin1MsAB.PoolId, pool5Min2SMin1MsAB.Asset0Denom, pool5Min2SMin1MsAB.Asset1Denom, pool5Min2SMin1MsAB.Time), newPruningState.LastSeenTimeIndexKey)
 
-	// 46 records
+	// 45 records
 	expectedKeptRecords := []types.TwapRecord{
-		pool1Min2SMin3Ms,                                           // base time - 2s - 3ms; in queue to be deleted
-		pool1Min2SMin2Ms,                                           // base time - 2s - 2ms; in queue to be deleted
-		pool1Min2SMin1Ms,                                           // base time - 2s - 1ms; in queue to be deleted
+		pool5Min2SMin1MsAB, pool5Min2SMin1MsAC, pool5Min2SMin1MsBC, // base time - 2s - 1ms; in queue to be deleted
 		pool1Min2SBaseMs,                                           // base time - 2s; kept since newest before lastKeptTime
 		pool5Min2SBaseMsAB, pool5Min2SBaseMsAC, pool5Min2SBaseMsBC, // base time - 2s; kept since newest before lastKeptTime
 		pool2Min1SMin3MsAB, pool2Min1SMin3MsAC, pool2Min1SMin3MsBC, // base time - 1s - 3ms; kept since newest before lastKeptTime
@@ -711,7 +704,7 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewestPerBlock() {
 	err = s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, newPruningState)
 	s.Require().NoError(err)
 
-	// Pruning state should still be true because pool 3 brought us to the pruning limit, despite no more records needing to be pruned.
+	// Pruning state should still be true because the records kept as newest before lastKeptTime are yet to be visited.
 	newPruningState = s.twapkeeper.GetPruningState(s.Ctx)
 	s.Require().Equal(true, newPruningState.IsPruning)
 
@@ -738,21 +731,98 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewestPerBlock() {
 		pool4Plus1SBaseMs,                                             // base time + 1s; kept since older
 		pool5Plus1SBaseMsAB, pool5Plus1SBaseMsAC, pool5Plus1SBaseMsBC, // base time + 1s; kept since older
 	}
-
 	s.validateExpectedRecords(expectedKeptRecords)
 
-	// Block 3
+	// Blocks 3 and 4 only visit the 7 records kept as newest before lastKeptTime.
+	for i := 0; i < 2; i++ {
+		err = s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, newPruningState)
+		s.Require().NoError(err)
+
+		newPruningState = s.twapkeeper.GetPruningState(s.Ctx)
+		s.Require().Equal(true, newPruningState.IsPruning)
+	}
+
+	// Block 5
 	err = s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, newPruningState)
 	s.Require().NoError(err)
 
 	// Pruning state should now be false since we've iterated through all the records.
 	newPruningState = s.twapkeeper.GetPruningState(s.Ctx)
 	s.Require().Equal(false, newPruningState.IsPruning)
+	s.Require().Empty(newPruningState.LastSeenTimeIndexKey)
 
-	// Records don't change from last block since there were no more records to prune.
+	// Records don't change from block 2 since there were no more records to prune.
 	s.validateExpectedRecords(expectedKeptRecords)
 }
 
+// TestBackfillPruningTimeIndex tests that records stored without a pruning time index entry
+// are pruned once the index is backfilled.
+func (s *TestSuite) TestBackfillPruningTimeIndex() {
+	s.SetupTest()
+	s.prepPoolsAndRemoveRecords([]sdk.Coins{twoAssetPoolCoins})
+
+	olderRecord := newEmptyPriceRecord(basePoolId, baseTime.Add(-time.Second), denom0, denom1)
+	newerRecord := newEmptyPriceRecord(basePoolId, baseTime, denom0, denom1)
+	s.preSetRecords([]types.TwapRecord{olderRecord, newerRecord})
+
+	// Remove the pruning time index entries, as for records stored before the index existed.
+	store := s.Ctx.KVStore(s.App.AppKeepers.GetKey(types.StoreKey))
+	for _, record := range []types.TwapRecord{olderRecord, newerRecord} {
+		store.Delete(types.FormatPruningTimeIndexKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time))
+	}
+
+	state := types.PruningState{
+		IsPruning:    true,
+		LastKeptTime: baseTime.Add(time.Second),
+	}
+
+	// Without the index, no records are found to prune.
+	err := s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, state)
+	s.Require().NoError(err)
+	s.validateExpectedRecords([]types.TwapRecord{olderRecord, newerRecord})
+
+	err = s.twapkeeper.BackfillPruningTimeIndex(s.Ctx)
+	s.Require().NoError(err)
+
+	// With the index backfilled, the older record is pruned and the newest kept.
+	err = s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, state)
+	s.Require().NoError(err)
+	s.validateExpectedRecords([]types.TwapRecord{newerRecord})
+}
+
+// TestPruneRecordsBeforeTimeButNewest_KeptRecord tests that the index entry of the record kept by a pruning
+// is deleted, and that the record is deleted by the next pruning once a newer record is kept.
+func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest_KeptRecord() {
+	s.SetupTest()
+	s.prepPoolsAndRemoveRecords([]sdk.Coins{twoAssetPoolCoins})
+
+	tMin2Record := newEmptyPriceRecord(basePoolId, baseTime.Add(-2*time.Second), denom0, denom1)
+	tMin1Record := newEmptyPriceRecord(basePoolId, baseTime.Add(-time.Second), denom0, denom1)
+	baseRecord := newEmptyPriceRecord(basePoolId, baseTime, denom0, denom1)
+	tPlus1Record := newEmptyPriceRecord(basePoolId, baseTime.Add(time.Second), denom0, denom1)
+	s.preSetRecords([]types.TwapRecord{tMin2Record, tMin1Record, baseRecord})
+
+	store := s.Ctx.KVStore(s.App.AppKeepers.GetKey(types.StoreKey))
+	isIndexed := func(record types.TwapRecord) bool {
+		return store.Has(types.FormatPruningTimeIndexKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time))
+	}
+
+	// The first pruning keeps the record at t - 1s, and deletes its index entry.
+	err := s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, types.PruningState{IsPruning: true, LastKeptTime: baseTime.Add(-time.Millisecond)})
+	s.Require().NoError(err)
+	s.validateExpectedRecords([]types.TwapRecord{tMin1Record, baseRecord})
+	s.Require().False(isIndexed(tMin1Record))
+	s.Require().True(isIndexed(baseRecord))
+
+	// The second pruning keeps the record at t, and deletes the record at t - 1s in its place.
+	s.preSetRecords([]types.TwapRecord{tPlus1Record})
+	err = s.twapkeeper.PruneRecordsBeforeTimeButNewest(s.Ctx, types.PruningState{IsPruning: true, LastKeptTime: baseTime.Add(time.Millisecond)})
+	s.Require().NoError(err)
+	s.validateExpectedRecords([]types.TwapRecord{baseRecord, tPlus1Record})
+	s.Require().False(isIndexed(baseRecord))
+	s.Require().True(isIndexed(tPlus1Record))
+}
+
 func (s *TestSuite) TestGetAllHistoricalTimeIndexedTWAPs() {
 	tests := map[string]struct {
 		expectedRecords []types.TwapRecord
@@ -927,10 +997,12 @@ func (s *TestSuite) prepPoolsAndRemoveRecords(poolCoins []sdk.Coins) {
 
 	twapStoreKey := s.App.AppKeepers.GetKey(types.StoreKey)
 	store := s.Ctx.KVStore(twapStoreKey)
-	iter := storetypes.KVStoreReversePrefixIterator(store, []byte(types.HistoricalTWAPPoolIndexPrefix))
-	defer iter.Close()
-	for iter.Valid() {
-		store.Delete(iter.Key())
-		iter.Next()
+	for _, prefix := range []string{types.HistoricalTWAPPoolIndexPrefix, types.PruningTimeIndexPrefix} {
+		iter := storetypes.KVStoreReversePrefixIterator(store, []byte(prefix))
+		for iter.Valid() {
+			store.Delete(iter.Key())
+			iter.Next()
+		}
+		iter.Close()
 	}
 }
@@ -10,11 +10,20 @@ import (
 // twapStrategy is an interface for computing TWAPs.
 // We have two strategies implementing the interface - arithmetic and geometric.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
+// Each strategy owns the math of its accumulators, so that adding a strategy
+// does not require changes to how records are stored.
 type twapStrategy interface {
+	// updateAccumulators returns newRecord with the strategy's accumulators advanced
+	// by timeDelta milliseconds at the last spot prices of record.
+	updateAccumulators(record types.TwapRecord, newRecord types.TwapRecord, timeDelta int64) types.TwapRecord
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
 	computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec
 }
 
+// accumulatorStrategies are the strategies whose accumulators are updated on every record.
+// The order matters, as a strategy may mark the record as errored.
+var accumulatorStrategies = []twapStrategy{&arithmetic{}, &geometric{}}
+
 type arithmetic struct {
 	TwapKeeper Keeper
 }
@@ -23,6 +32,48 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+// updateAccumulators advances the arithmetic accumulators of both assets by
+// their last spot price multiplied by timeDelta.
+func (s *arithmetic) updateAccumulators(record types.TwapRecord, newRecord types.TwapRecord, timeDelta int64) types.TwapRecord {
+	// record.LastSpotPrice is the last spot price from the block the record was created in,
+	// thus it is treated as the effective spot price until the new time.
+	// (As there was no change until at or after this time)
+	p0NewAccum := types.SpotPriceMulDuration(record.P0LastSpotPrice, timeDelta)
+	newRecord.P0ArithmeticTwapAccumulator = p0NewAccum.AddMut(newRecord.P0ArithmeticTwapAccumulator)
+
+	p1NewAccum := types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta)
+	newRecord.P1ArithmeticTwapAccumulator = p1NewAccum.AddMut(newRecord.P1ArithmeticTwapAccumulator)
+
+	return newRecord
+}
+
+// updateAccumulators advances the geometric accumulator by the base 2 logarithm
+// of the last spot price of asset 0 multiplied by timeDelta.
+func (s *geometric) updateAccumulators(record types.TwapRecord, newRecord types.TwapRecord, timeDelta int64) types.TwapRecord {
+	// If the last spot price is zero, then the logarithm is undefined.
+	// As a result, we cannot update the geometric accumulator.
+	// We set the last error time to be the new time, and return the record.
+	if record.P0LastSpotPrice.IsZero() {
+		newRecord.LastErrorTime = newRecord.Time
+		return newRecord
+	}
+
+	// NOTE: An edge case exists here. If a pool is drained of all it's liquidity, and then the pool's
+	// spot price is set to exactly one and the GeometricTWAP is queried, the the result will be zero.
+	// This is because the P0LastSpotPrice is one, which makes log_{2}{P_0} = 0, and thus the geometric
+	// accumulator is the same as the time of pool drain.
+
+	// This is a edge case almost certainly to never be hit in prod, but its good to be aware of.
+
+	// logP0SpotPrice = log_{2}{P_0}
+	logP0SpotPrice := twapLog(record.P0LastSpotPrice)
+	// p0NewGeomAccum = log_{2}{P_0} * timeDelta
+	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
+	newRecord.GeometricTwapAccumulator = p0NewGeomAccum.AddMut(newRecord.GeometricTwapAccumulator)
+
+	return newRecord
+}
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
@@ -0,0 +1,201 @@
+// Package testutil generates deterministic TWAP record series for testing.
+//
+// A series is described by a SeriesSpec: a price path giving the pool's spot price at every step,
+// the time between steps, and optionally the steps at which no record was written (gaps) or at which
+// the spot price query errored. GenerateRecordSeries turns the spec into the records the twap keeper
+// would have written for it, with accumulators computed the same way the keeper interpolates them,
+// and RegisterRecordSeries stores them in the keeper.
+package testutil
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/twap"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// PricePath returns the spot price of asset1 quoted in asset0 (P0) at the given step of a series.
+type PricePath func(step int) osmomath.Dec
+
+// ConstantPath returns a price path that is price at every step.
+func ConstantPath(price osmomath.Dec) PricePath {
+	return func(step int) osmomath.Dec {
+		return price
+	}
+}
+
+// StepPath returns a price path that is before until stepAt, and after from stepAt onwards.
+func StepPath(before, after osmomath.Dec, stepAt int) PricePath {
+	return func(step int) osmomath.Dec {
+		if step < stepAt {
+			return before
+		}
+		return after
+	}
+}
+
+// RampPath returns a price path that moves linearly from start at step zero to end at rampSteps,
+// and stays at end afterwards.
+func RampPath(start, end osmomath.Dec, rampSteps int) PricePath {
+	return func(step int) osmomath.Dec {
+		if step >= rampSteps {
+			return end
+		}
+		return start.Add(end.Sub(start).MulInt64(int64(step)).QuoInt64(int64(rampSteps)))
+	}
+}
+
+// SpikePath returns a price path that is base, except for spikeLength steps starting at spikeAt
+// during which it is spike.
+func SpikePath(base, spike osmomath.Dec, spikeAt, spikeLength int) PricePath {
+	return func(step int) osmomath.Dec {
+		if step >= spikeAt && step < spikeAt+spikeLength {
+			return spike
+		}
+		return base
+	}
+}
+
+// SeriesSpec specifies a series of TWAP records for a single pool and denom pair.
+type SeriesSpec struct {
+	PoolId      uint64
+	Asset0Denom string
+	Asset1Denom string
+
+	// StartTime and StartHeight are the time and height of the first step.
+	StartTime   time.Time
+	StartHeight int64
+	// Interval is the time between two consecutive steps. Every step is one block.
+	Interval  time.Duration
+	NumSteps  int
+	PricePath PricePath
+
+	// GapSteps are the steps at which no record is written, as if the pool had no activity in that block.
+	// The first step can not be a gap.
+	GapSteps []int
+	// ErrorSteps are the steps at which the spot price query errored.
+	// The record at such a step has its last error time set to its own time.
+	ErrorSteps []int
+}
+
+// GenerateRecordSeries returns the records the twap keeper would have written for the given spec,
+// ordered by time. P1 is the inverse of P0 at every step.
+// Panics if the spec is invalid.
+func GenerateRecordSeries(spec SeriesSpec) []types.TwapRecord {
+	if err := spec.validate(); err != nil {
+		panic(err)
+	}
+
+	gapSteps := toStepSet(spec.GapSteps)
+	errorSteps := toStepSet(spec.ErrorSteps)
+
+	records := make([]types.TwapRecord, 0, spec.NumSteps)
+	for step := 0; step < spec.NumSteps; step++ {
+		if gapSteps[step] {
+			continue
+		}
+
+		stepTime := spec.StartTime.Add(time.Duration(step) * spec.Interval)
+		var record types.TwapRecord
+		if len(records) == 0 {
+			record = types.TwapRecord{
+				PoolId:                      spec.PoolId,
+				Asset0Denom:                 spec.Asset0Denom,
+				Asset1Denom:                 spec.Asset1Denom,
+				P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
+				P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
+				GeometricTwapAccumulator:    osmomath.ZeroDec(),
+			}
+		} else {
+			// Like the keeper, a new record inherits the last error time of the previous record.
+			previousRecord := records[len(records)-1]
+			record = recordWithUpdatedAccumulators(previousRecord, stepTime)
+			record.LastErrorTime = previousRecord.LastErrorTime
+		}
+		record.Height = spec.StartHeight + int64(step)
+		record.Time = stepTime
+
+		sp0 := spec.PricePath(step)
+		record.P0LastSpotPrice = sp0
+		record.P1LastSpotPrice = osmomath.ZeroDec()
+		if sp0.IsPositive() {
+			record.P1LastSpotPrice = osmomath.OneDec().Quo(sp0)
+		}
+		if errorSteps[step] {
+			record.LastErrorTime = stepTime
+		}
+
+		records = append(records, record)
+	}
+	return records
+}
+
+// RegisterRecordSeries stores the given records in the twap keeper in order,
+// so that the last record becomes the most recent record of its pool and denom pair.
+func RegisterRecordSeries(ctx sdk.Context, k *twap.Keeper, records []types.TwapRecord) {
+	for _, record := range records {
+		k.StoreNewRecord(ctx, record)
+	}
+}
+
+// GenerateAndRegisterRecordSeries generates the record series for the given spec and stores it in the twap keeper.
+// Returns the generated records.
+func GenerateAndRegisterRecordSeries(ctx sdk.Context, k *twap.Keeper, spec SeriesSpec) []types.TwapRecord {
+	records := GenerateRecordSeries(spec)
+	RegisterRecordSeries(ctx, k, records)
+	return records
+}
+
+func (spec SeriesSpec) validate() error {
+	if spec.Asset0Denom >= spec.Asset1Denom {
+		return fmt.Errorf("asset0 denom (%s) must be lexicographically smaller than asset1 denom (%s)", spec.Asset0Denom, spec.Asset1Denom)
+	}
+	if spec.Interval <= 0 {
+		return fmt.Errorf("interval (%s) must be positive", spec.Interval)
+	}
+	if spec.NumSteps <= 0 {
+		return fmt.Errorf("number of steps (%d) must be positive", spec.NumSteps)
+	}
+	if spec.PricePath == nil {
+		return fmt.Errorf("price path must be set")
+	}
+	for _, step := range spec.GapSteps {
+		if step == 0 {
+			return fmt.Errorf("the first step can not be a gap")
+		}
+	}
+	return nil
+}
+
+// recordWithUpdatedAccumulators interpolates the given record to newTime, mirroring the twap keeper:
+// the record's last spot prices are treated as the effective spot prices until newTime.
+// If the last spot price is zero, the geometric accumulator is left unchanged and the last error time is set to newTime.
+func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) types.TwapRecord {
+	newRecord := record
+	newRecord.Time = newTime
+	timeDelta := types.CanonicalTimeMs(newTime) - types.CanonicalTimeMs(record.Time)
+
+	newRecord.P0ArithmeticTwapAccumulator = types.SpotPriceMulDuration(record.P0LastSpotPrice, timeDelta).Add(record.P0ArithmeticTwapAccumulator)
+	newRecord.P1ArithmeticTwapAccumulator = types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta).Add(record.P1ArithmeticTwapAccumulator)
+
+	if record.P0LastSpotPrice.IsZero() {
+		newRecord.LastErrorTime = newTime
+		return newRecord
+	}
+
+	logP0SpotPrice := osmomath.BigDecFromDec(record.P0LastSpotPrice).LogBase2().Dec()
+	newRecord.GeometricTwapAccumulator = types.SpotPriceMulDuration(logP0SpotPrice, timeDelta).Add(record.GeometricTwapAccumulator)
+	return newRecord
+}
+
+func toStepSet(steps []int) map[int]bool {
+	stepSet := make(map[int]bool, len(steps))
+	for _, step := range steps {
+		stepSet[step] = true
+	}
+	return stepSet
+}
@@ -0,0 +1,59 @@
+package twap_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/twap"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/testutil"
+)
+
+// TestGenerateAndRegisterRecordSeries checks that the generated records match the keeper's interpolation
+// and that TWAPs over the registered series reflect the price path, its gaps and its errors.
+func (s *TestSuite) TestGenerateAndRegisterRecordSeries() {
+	interval := 10 * time.Second
+	spec := testutil.SeriesSpec{
+		PoolId:      basePoolId,
+		Asset0Denom: denom0,
+		Asset1Denom: denom1,
+		StartTime:   baseTime,
+		StartHeight: 1,
+		Interval:    interval,
+		NumSteps:    10,
+		PricePath:   testutil.StepPath(osmomath.NewDec(10), osmomath.NewDec(20), 5),
+		GapSteps:    []int{7},
+		ErrorSteps:  []int{8},
+	}
+	stepTime := func(step int) time.Time {
+		return baseTime.Add(time.Duration(step) * interval)
+	}
+
+	s.Ctx = s.Ctx.WithBlockTime(stepTime(spec.NumSteps - 1))
+	records := testutil.GenerateAndRegisterRecordSeries(s.Ctx, s.twapkeeper, spec)
+	s.Require().Len(records, spec.NumSteps-len(spec.GapSteps))
+
+	for i := 1; i < len(records); i++ {
+		expectedRecord := twap.RecordWithUpdatedAccumulators(records[i-1], records[i].Time)
+		s.Require().Equal(expectedRecord.P0ArithmeticTwapAccumulator, records[i].P0ArithmeticTwapAccumulator)
+		s.Require().Equal(expectedRecord.P1ArithmeticTwapAccumulator, records[i].P1ArithmeticTwapAccumulator)
+		s.Require().Equal(expectedRecord.GeometricTwapAccumulator, records[i].GeometricTwapAccumulator)
+	}
+
+	mostRecentRecord, err := s.twapkeeper.GetBeginBlockAccumulatorRecord(s.Ctx, basePoolId, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(records[len(records)-1], mostRecentRecord)
+
+	// Before the step, the price is constant.
+	twapBeforeStep, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, basePoolId, denom1, denom0, stepTime(0), stepTime(5))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDec(10), twapBeforeStep)
+
+	// The window ends in the gap, so its end is interpolated from the record before the gap.
+	twapAcrossStep, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, basePoolId, denom1, denom0, stepTime(3), stepTime(7))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDec(15), twapAcrossStep)
+
+	// The window contains the error step.
+	_, err = s.twapkeeper.GetArithmeticTwap(s.Ctx, basePoolId, denom1, denom0, stepTime(3), stepTime(8))
+	s.Require().Error(err)
+}
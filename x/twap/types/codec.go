@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/twap interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&FreezeTwapProposal{}, "osmosis/twap/freeze-twap-proposal", nil)
+	cdc.RegisterConcrete(&SetPoolObservationIntervalProposal{}, "osmosis/twap/set-pool-observation-interval-proposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypesv1.Content)(nil),
+		&FreezeTwapProposal{},
+		&SetPoolObservationIntervalProposal{},
+	)
+}
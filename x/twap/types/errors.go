@@ -73,3 +73,31 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type TwapFrozenError struct {
+	PoolId      uint64
+	Asset0Denom string
+	Asset1Denom string
+	Expiry      time.Time
+}
+
+func (e TwapFrozenError) Error() string {
+	return fmt.Sprintf("twap of pool %d for assets %s %s is frozen by governance and unavailable until %s", e.PoolId, e.Asset0Denom, e.Asset1Denom, e.Expiry)
+}
+
+type InvalidTwapStrategyError struct {
+	Strategy TwapStrategy
+}
+
+func (e InvalidTwapStrategyError) Error() string {
+	return fmt.Sprintf("invalid twap strategy %d", e.Strategy)
+}
+
+type InvalidTwapWindowsError struct {
+	ShortWindow time.Duration
+	LongWindow  time.Duration
+}
+
+func (e InvalidTwapWindowsError) Error() string {
+	return fmt.Sprintf("short window %s must be positive and shorter than long window %s", e.ShortWindow, e.LongWindow)
+}
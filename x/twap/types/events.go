@@ -0,0 +1,9 @@
+package types
+
+const (
+	TypeEvtSpotPriceError = "twap_spot_price_error"
+
+	AttributeKeyPoolId      = "pool_id"
+	AttributeKeyAsset0Denom = "asset0_denom"
+	AttributeKeyAsset1Denom = "asset1_denom"
+)
@@ -20,4 +20,7 @@ type PoolManagerInterface interface {
 		baseAssetDenom string,
 	) (price osmomath.BigDec, err error)
 	GetNextPoolId(ctx sdk.Context) uint64
+	// ResolveMigratedPoolId returns the id of the pool replacing the given pool if it has been
+	// marked as migrated, and the given pool id otherwise.
+	ResolveMigratedPoolId(ctx sdk.Context, poolId uint64) uint64
 }
@@ -8,8 +8,11 @@ import (
 // NewGenesisState returns genesis state with the given parameters and twap records.
 func NewGenesisState(params Params, twapRecords []TwapRecord) *GenesisState {
 	return &GenesisState{
-		Params: params,
-		Twaps:  twapRecords,
+		Params:  params,
+		Twaps:   twapRecords,
+		Freezes: []TwapFreeze{},
+
+		ObservationIntervals: []PoolObservationInterval{},
 	}
 }
 
@@ -29,6 +32,91 @@ func (g *GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	seenFreezes := make(map[string]struct{}, len(g.Freezes))
+	for _, freeze := range g.Freezes {
+		if err := freeze.Validate(); err != nil {
+			return err
+		}
+		key := string(FormatTwapFreezeKey(freeze.PoolId, freeze.Asset0Denom, freeze.Asset1Denom))
+		if _, ok := seenFreezes[key]; ok {
+			return fmt.Errorf("duplicate twap freeze for pool %d and assets %s %s", freeze.PoolId, freeze.Asset0Denom, freeze.Asset1Denom)
+		}
+		seenFreezes[key] = struct{}{}
+	}
+
+	seenIntervals := make(map[uint64]struct{}, len(g.ObservationIntervals))
+	for _, interval := range g.ObservationIntervals {
+		if err := interval.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenIntervals[interval.PoolId]; ok {
+			return fmt.Errorf("duplicate observation interval for pool %d", interval.PoolId)
+		}
+		seenIntervals[interval.PoolId] = struct{}{}
+	}
+
+	seenPendingPools := make(map[uint64]struct{}, len(g.PendingObservationPoolIds))
+	for _, poolId := range g.PendingObservationPoolIds {
+		if poolId == 0 {
+			return errors.New("pending observation pool id cannot be 0")
+		}
+		if _, ok := seenPendingPools[poolId]; ok {
+			return fmt.Errorf("duplicate pending observation for pool %d", poolId)
+		}
+		seenPendingPools[poolId] = struct{}{}
+	}
+	return nil
+}
+
+// Validate validates the pool observation interval, returns nil on success, error otherwise.
+func (i PoolObservationInterval) Validate() error {
+	if i.PoolId == 0 {
+		return errors.New("pool id cannot be 0")
+	}
+	if i.Interval == 0 {
+		return errors.New("observation interval cannot be 0")
+	}
+	return ValidateObservationInterval(i.Interval)
+}
+
+// Validate validates the twap freeze, returns nil on success, error otherwise.
+func (f TwapFreeze) Validate() error {
+	if f.PoolId == 0 {
+		return errors.New("pool id cannot be 0")
+	}
+
+	asset0Denom, asset1Denom, err := LexicographicalOrderDenoms(f.Asset0Denom, f.Asset1Denom)
+	if err != nil {
+		return err
+	}
+	if asset0Denom != f.Asset0Denom || asset1Denom != f.Asset1Denom {
+		return fmt.Errorf("twap freeze denoms must be lexicographically ordered, were (%s, %s)", f.Asset0Denom, f.Asset1Denom)
+	}
+
+	if f.FreezeTime.IsZero() {
+		return errors.New("twap freeze time cannot be 0")
+	}
+
+	if !f.Expiry.After(f.FreezeTime) {
+		return fmt.Errorf("twap freeze expiry (%s) must be after the freeze time (%s)", f.Expiry, f.FreezeTime)
+	}
+
+	// the frozen record is only used if the twap is not marked unavailable.
+	if f.MarkUnavailable {
+		return nil
+	}
+	if err := f.Record.validate(); err != nil {
+		return err
+	}
+	if f.Record.PoolId != f.PoolId || f.Record.Asset0Denom != f.Asset0Denom || f.Record.Asset1Denom != f.Asset1Denom {
+		return fmt.Errorf("twap freeze record must be for pool %d and assets %s %s, was for pool %d and assets %s %s",
+			f.PoolId, f.Asset0Denom, f.Asset1Denom, f.Record.PoolId, f.Record.Asset0Denom, f.Record.Asset1Denom)
+	}
+	if !f.Record.Time.Equal(f.FreezeTime) {
+		return fmt.Errorf("twap freeze record time (%s) must be the freeze time (%s)", f.Record.Time, f.FreezeTime)
+	}
+
 	return nil
 }
 
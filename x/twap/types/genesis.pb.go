@@ -88,6 +88,13 @@ type GenesisState struct {
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// freezes is the collection of all active twap freezes.
+	Freezes []TwapFreeze `protobuf:"bytes,3,rep,name=freezes,proto3" json:"freezes"`
+	// observation_intervals is the collection of all pool observation intervals.
+	ObservationIntervals []PoolObservationInterval `protobuf:"bytes,4,rep,name=observation_intervals,json=observationIntervals,proto3" json:"observation_intervals"`
+	// pending_observation_pool_ids are the pools that changed since their most
+	// recent record, and are recorded once their observation interval elapses.
+	PendingObservationPoolIds []uint64 `protobuf:"varint,5,rep,packed,name=pending_observation_pool_ids,json=pendingObservationPoolIds,proto3" json:"pending_observation_pool_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -137,6 +144,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetFreezes() []TwapFreeze {
+	if m != nil {
+		return m.Freezes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObservationIntervals() []PoolObservationInterval {
+	if m != nil {
+		return m.ObservationIntervals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingObservationPoolIds() []uint64 {
+	if m != nil {
+		return m.PendingObservationPoolIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
@@ -147,32 +175,38 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0xee, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0x3e, 0x2a, 0x91, 0x3f, 0x53, 0x54, 0x41, 0x5b, 0xa1, 0x34, 0x64, 0x40, 0x5d,
-	0x6a, 0xd3, 0x82, 0x18, 0x2a, 0xa6, 0x0a, 0xc4, 0xd7, 0x52, 0x05, 0x26, 0x96, 0xc8, 0x49, 0x6e,
-	0x53, 0x8b, 0x26, 0xb6, 0x6c, 0xa7, 0x25, 0x0f, 0x80, 0xc4, 0xc8, 0xc8, 0x13, 0xa1, 0x8e, 0x1d,
-	0x99, 0x0a, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0x89, 0x5d, 0x06, 0xd4, 0xff, 0x96, 0xab, 0xdf, 0x39,
-	0x47, 0x27, 0xf7, 0xda, 0x0d, 0xb9, 0x2a, 0xb8, 0x62, 0x8a, 0xe8, 0x2d, 0x15, 0x64, 0x33, 0x49,
-	0x40, 0xd3, 0x09, 0xc9, 0xa1, 0x04, 0xc5, 0x14, 0x16, 0x92, 0x6b, 0xee, 0x75, 0xad, 0x06, 0x37,
-	0x1a, 0x6c, 0x35, 0x83, 0x6e, 0xce, 0x73, 0xde, 0x0a, 0x48, 0xf3, 0x65, 0xb4, 0x83, 0x47, 0x17,
-	0xf3, 0x9a, 0x21, 0x96, 0x90, 0x72, 0x99, 0x59, 0x5d, 0x3f, 0xe7, 0x3c, 0x5f, 0x03, 0x69, 0xa7,
-	0xa4, 0x5a, 0x12, 0x5a, 0xd6, 0x67, 0x94, 0xb6, 0x19, 0xb1, 0xc9, 0x36, 0x83, 0x45, 0xfe, 0xff,
-	0xae, 0xac, 0x92, 0x54, 0x33, 0x5e, 0x1a, 0x1e, 0xfe, 0x40, 0x6e, 0x67, 0x41, 0x25, 0x2d, 0x94,
-	0xf7, 0xd4, 0xbd, 0x27, 0x64, 0x55, 0x42, 0x0c, 0x82, 0xa7, 0xab, 0x98, 0x65, 0x50, 0x6a, 0xb6,
-	0x64, 0x20, 0x7b, 0x28, 0x40, 0xa3, 0x3b, 0x51, 0xb7, 0xa5, 0x2f, 0x1b, 0xf8, 0xe6, 0x1f, 0xf3,
-	0xbe, 0x20, 0x77, 0x60, 0x7a, 0xc6, 0x2b, 0xa6, 0x34, 0x97, 0x75, 0xfc, 0x09, 0x40, 0xc4, 0x02,
-	0x24, 0xe3, 0x59, 0xef, 0x46, 0x80, 0x46, 0x57, 0xd3, 0x3e, 0x36, 0x35, 0xf0, 0xb9, 0x06, 0x7e,
-	0x61, 0x6b, 0xcc, 0xc7, 0xbb, 0xc3, 0xd0, 0xf9, 0x73, 0x18, 0x3e, 0xac, 0x69, 0xb1, 0x9e, 0x85,
-	0xd7, 0x47, 0x85, 0xdf, 0x7f, 0x0d, 0x51, 0x74, 0xdf, 0x08, 0x5e, 0x1b, 0xfe, 0x0e, 0x40, 0x2c,
-	0x0c, 0xfd, 0x8a, 0xdc, 0xbb, 0xaf, 0xcc, 0x11, 0xde, 0x6b, 0xaa, 0xc1, 0x7b, 0xee, 0xde, 0x6e,
-	0x96, 0xa8, 0x7a, 0x28, 0xb8, 0x39, 0xba, 0x9a, 0x06, 0xf8, 0xd2, 0x4d, 0xf0, 0x87, 0x2d, 0x15,
-	0x51, 0x1b, 0x39, 0xbf, 0xd5, 0x34, 0x89, 0x8c, 0xc9, 0x9b, 0xb9, 0x1d, 0xd1, 0xae, 0xc5, 0xfe,
-	0xc1, 0x83, 0xcb, 0x76, 0xb3, 0x3a, 0x6b, 0xb5, 0x8e, 0xf9, 0xdb, 0xdd, 0xd1, 0x47, 0xfb, 0xa3,
-	0x8f, 0x7e, 0x1f, 0x7d, 0xf4, 0xed, 0xe4, 0x3b, 0xfb, 0x93, 0xef, 0xfc, 0x3c, 0xf9, 0xce, 0xc7,
-	0xc7, 0x39, 0xd3, 0xab, 0x2a, 0xc1, 0x29, 0x2f, 0x88, 0xcd, 0x1b, 0xaf, 0x69, 0xa2, 0xce, 0x03,
-	0xd9, 0x4c, 0x9f, 0x91, 0xcf, 0xe6, 0x25, 0xe8, 0x5a, 0x80, 0x4a, 0x3a, 0xed, 0xc6, 0x9e, 0xfc,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x09, 0x74, 0xb5, 0x1f, 0x76, 0x02, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0xce, 0xb5, 0x69, 0x10, 0x2e, 0xd3, 0x29, 0xc0, 0x25, 0xaa, 0x2e, 0x21, 0x03, 0xca, 0x92,
+	0x3b, 0x1a, 0x10, 0x43, 0x85, 0x04, 0x8a, 0xf8, 0x15, 0x18, 0xa8, 0x02, 0x13, 0x8b, 0xe5, 0xcb,
+	0xbd, 0x5c, 0x2c, 0x2e, 0xf7, 0x2c, 0xdb, 0x49, 0x09, 0x3b, 0x3b, 0x23, 0x7f, 0x11, 0xea, 0xd8,
+	0x91, 0xa9, 0xa0, 0xe4, 0x3f, 0x40, 0x62, 0x47, 0x67, 0x3b, 0x50, 0x41, 0x2a, 0x36, 0x3f, 0x7d,
+	0x3f, 0xde, 0xe7, 0xcf, 0x26, 0x1d, 0x54, 0x33, 0x54, 0x5c, 0xc5, 0xfa, 0x84, 0x89, 0x78, 0x71,
+	0x98, 0x80, 0x66, 0x87, 0x71, 0x06, 0x05, 0x28, 0xae, 0x22, 0x21, 0x51, 0xa3, 0x5f, 0x77, 0x9c,
+	0xa8, 0xe4, 0x44, 0x8e, 0xd3, 0xac, 0x67, 0x98, 0xa1, 0x21, 0xc4, 0xe5, 0xc9, 0x72, 0x9b, 0xb7,
+	0xb7, 0xfa, 0x95, 0x03, 0x95, 0x30, 0x46, 0x99, 0x3a, 0x5e, 0x23, 0x43, 0xcc, 0x72, 0x88, 0xcd,
+	0x94, 0xcc, 0x27, 0x31, 0x2b, 0x96, 0x1b, 0x68, 0x6c, 0x3c, 0xa8, 0xf5, 0xb6, 0x83, 0x83, 0xc2,
+	0xbf, 0x55, 0xe9, 0x5c, 0x32, 0xcd, 0xb1, 0xb0, 0x78, 0xe7, 0x8b, 0x47, 0x6a, 0xc7, 0x4c, 0xb2,
+	0x99, 0xf2, 0xef, 0x91, 0x1b, 0x42, 0xce, 0x0b, 0xa0, 0x20, 0x70, 0x3c, 0xa5, 0x3c, 0x85, 0x42,
+	0xf3, 0x09, 0x07, 0x19, 0x78, 0x6d, 0xaf, 0x7b, 0x75, 0x54, 0x37, 0xe8, 0x93, 0x12, 0x1c, 0xfe,
+	0xc6, 0xfc, 0x8f, 0x1e, 0x69, 0xda, 0x9c, 0x74, 0xca, 0x95, 0x46, 0xb9, 0xa4, 0xef, 0x00, 0x04,
+	0x15, 0x20, 0x39, 0xa6, 0xc1, 0x4e, 0xdb, 0xeb, 0xee, 0xf7, 0x1b, 0x91, 0x8d, 0x11, 0x6d, 0x62,
+	0x44, 0x8f, 0x5d, 0x8c, 0x41, 0xef, 0xf4, 0xbc, 0x55, 0xf9, 0x71, 0xde, 0xba, 0xb5, 0x64, 0xb3,
+	0xfc, 0xa8, 0x73, 0xb9, 0x55, 0xe7, 0xf3, 0xb7, 0x96, 0x37, 0xba, 0x69, 0x09, 0xcf, 0x2d, 0xfe,
+	0x12, 0x40, 0x1c, 0x5b, 0xf4, 0xe7, 0x0e, 0xb9, 0xf6, 0xcc, 0x3e, 0xc2, 0x6b, 0xcd, 0x34, 0xf8,
+	0x0f, 0xc8, 0x5e, 0x59, 0xa2, 0x0a, 0xbc, 0xf6, 0x6e, 0x77, 0xbf, 0xdf, 0x8e, 0xb6, 0xbd, 0x49,
+	0xf4, 0xe6, 0x84, 0x89, 0x91, 0xb1, 0x1c, 0x54, 0xcb, 0x24, 0x23, 0x2b, 0xf2, 0x8f, 0x48, 0x4d,
+	0x98, 0x5a, 0xdc, 0x0d, 0x0e, 0xb6, 0xcb, 0x6d, 0x75, 0x4e, 0xea, 0x14, 0xfe, 0x23, 0x72, 0x65,
+	0x22, 0x01, 0x3e, 0x80, 0x0a, 0x76, 0xff, 0xb7, 0xfb, 0xa9, 0x21, 0x3a, 0x83, 0x8d, 0xcc, 0x9f,
+	0x92, 0xeb, 0x98, 0x28, 0x90, 0x0b, 0xd3, 0x11, 0xe5, 0x85, 0x2e, 0xcf, 0xb9, 0x0a, 0xaa, 0xc6,
+	0xaf, 0x77, 0x49, 0x18, 0xc4, 0xfc, 0xd5, 0x1f, 0xd9, 0xd0, 0xa9, 0x9c, 0x79, 0x1d, 0xff, 0x85,
+	0x94, 0xff, 0x90, 0x1c, 0x08, 0x28, 0x52, 0x5e, 0x64, 0xf4, 0xe2, 0x46, 0x81, 0x98, 0x53, 0x9e,
+	0xaa, 0x60, 0xaf, 0xbd, 0xdb, 0xad, 0x8e, 0x1a, 0x8e, 0x73, 0xc1, 0xbd, 0x5c, 0x36, 0x4c, 0xd5,
+	0xe0, 0xc5, 0xe9, 0x2a, 0xf4, 0xce, 0x56, 0xa1, 0xf7, 0x7d, 0x15, 0x7a, 0x9f, 0xd6, 0x61, 0xe5,
+	0x6c, 0x1d, 0x56, 0xbe, 0xae, 0xc3, 0xca, 0xdb, 0x3b, 0x19, 0xd7, 0xd3, 0x79, 0x12, 0x8d, 0x71,
+	0x16, 0xbb, 0xbc, 0xbd, 0x9c, 0x25, 0x6a, 0x33, 0xc4, 0x8b, 0xfe, 0xfd, 0xf8, 0xbd, 0xfd, 0xf6,
+	0x7a, 0x29, 0x40, 0x25, 0x35, 0xf3, 0x3d, 0xee, 0xfe, 0x1a, 0x00, 0x54, 0xe2, 0x35, 0x9f, 0x63,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -233,6 +267,52 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingObservationPoolIds) > 0 {
+		dAtA3 := make([]byte, len(m.PendingObservationPoolIds)*10)
+		var j2 int
+		for _, num := range m.PendingObservationPoolIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ObservationIntervals) > 0 {
+		for iNdEx := len(m.ObservationIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservationIntervals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Freezes) > 0 {
+		for iNdEx := len(m.Freezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Freezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -300,6 +380,25 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Freezes) > 0 {
+		for _, e := range m.Freezes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservationIntervals) > 0 {
+		for _, e := range m.ObservationIntervals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingObservationPoolIds) > 0 {
+		l = 0
+		for _, e := range m.PendingObservationPoolIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -520,6 +619,150 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freezes = append(m.Freezes, TwapFreeze{})
+			if err := m.Freezes[len(m.Freezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationIntervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationIntervals = append(m.ObservationIntervals, PoolObservationInterval{})
+			if err := m.ObservationIntervals[len(m.ObservationIntervals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingObservationPoolIds = append(m.PendingObservationPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingObservationPoolIds) == 0 {
+					m.PendingObservationPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingObservationPoolIds = append(m.PendingObservationPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingObservationPoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -78,6 +78,11 @@ func TestGenesisState_Validate(t *testing.T) {
 		record.GeometricTwapAccumulator = geometricAcc
 		return record
 	}
+	withObservationIntervals := func(genesis *GenesisState, intervals []PoolObservationInterval, pendingPoolIds []uint64) *GenesisState {
+		genesis.ObservationIntervals = intervals
+		genesis.PendingObservationPoolIds = pendingPoolIds
+		return genesis
+	}
 
 	testCases := map[string]struct {
 		twapGenesis *GenesisState
@@ -99,6 +104,30 @@ func TestGenesisState_Validate(t *testing.T) {
 		"valid geometric twap acc is negative": {
 			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricAcc(baseRecord, osmomath.NewDec(-1))}),
 		},
+		"valid observation intervals": {
+			twapGenesis: withObservationIntervals(NewGenesisState(basicParams, []TwapRecord{baseRecord}),
+				[]PoolObservationInterval{{PoolId: basePoolId, Interval: time.Minute}}, []uint64{basePoolId}),
+		},
+		"invalid zero observation interval": {
+			twapGenesis: withObservationIntervals(NewGenesisState(basicParams, []TwapRecord{baseRecord}),
+				[]PoolObservationInterval{{PoolId: basePoolId}}, nil),
+			expectedErr: true,
+		},
+		"invalid observation interval above max": {
+			twapGenesis: withObservationIntervals(NewGenesisState(basicParams, []TwapRecord{baseRecord}),
+				[]PoolObservationInterval{{PoolId: basePoolId, Interval: MaxObservationInterval + time.Second}}, nil),
+			expectedErr: true,
+		},
+		"invalid duplicate observation interval": {
+			twapGenesis: withObservationIntervals(NewGenesisState(basicParams, []TwapRecord{baseRecord}),
+				[]PoolObservationInterval{{PoolId: basePoolId, Interval: time.Minute}, {PoolId: basePoolId, Interval: time.Second}}, nil),
+			expectedErr: true,
+		},
+		"invalid duplicate pending observation": {
+			twapGenesis: withObservationIntervals(NewGenesisState(basicParams, []TwapRecord{baseRecord}),
+				nil, []uint64{basePoolId, basePoolId}),
+			expectedErr: true,
+		},
 		"invalid geometric twap acc is nil": {
 			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricAcc(baseRecord, osmomath.Dec{})}),
 			expectedErr: true,
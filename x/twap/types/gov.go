@@ -0,0 +1,168 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeFreezeTwap                 = "FreezeTwap"
+	ProposalTypeSetPoolObservationInterval = "SetPoolObservationInterval"
+
+	// MaxTwapFreezeDuration is the longest duration a twap freeze can be set for.
+	// Freezes are meant to bridge the time until an exploit is resolved, longer
+	// freezes have to be renewed by a new proposal.
+	MaxTwapFreezeDuration = 14 * 24 * time.Hour
+
+	// MaxObservationInterval is the longest observation interval a pool can be set to.
+	// TWAPs over a window are off by at most the price moves within an interval at either
+	// end of the window, so longer intervals would make short windows meaningless.
+	MaxObservationInterval = time.Hour
+)
+
+func init() {
+	govtypesv1.RegisterProposalType(ProposalTypeFreezeTwap)
+	govtypesv1.RegisterProposalType(ProposalTypeSetPoolObservationInterval)
+}
+
+var (
+	_ govtypesv1.Content = &FreezeTwapProposal{}
+	_ govtypesv1.Content = &SetPoolObservationIntervalProposal{}
+)
+
+// NewFreezeTwapProposal returns a new instance of a freeze twap proposal struct.
+func NewFreezeTwapProposal(title, description string, poolId uint64, asset0Denom, asset1Denom string, freezeTime time.Time, duration time.Duration, markUnavailable bool) govtypesv1.Content {
+	return &FreezeTwapProposal{
+		Title:           title,
+		Description:     description,
+		PoolId:          poolId,
+		Asset0Denom:     asset0Denom,
+		Asset1Denom:     asset1Denom,
+		FreezeTime:      freezeTime,
+		Duration:        duration,
+		MarkUnavailable: markUnavailable,
+	}
+}
+
+func (p *FreezeTwapProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *FreezeTwapProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *FreezeTwapProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *FreezeTwapProposal) ProposalType() string {
+	return ProposalTypeFreezeTwap
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *FreezeTwapProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return errors.New("pool id cannot be 0")
+	}
+	if err := sdk.ValidateDenom(p.Asset0Denom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.Asset1Denom); err != nil {
+		return err
+	}
+	if _, _, err := LexicographicalOrderDenoms(p.Asset0Denom, p.Asset1Denom); err != nil {
+		return err
+	}
+
+	if p.Duration < 0 {
+		return fmt.Errorf("twap freeze duration cannot be negative, was %s", p.Duration)
+	}
+	if p.Duration > MaxTwapFreezeDuration {
+		return fmt.Errorf("twap freeze duration (%s) cannot be greater than %s", p.Duration, MaxTwapFreezeDuration)
+	}
+
+	return nil
+}
+
+// String returns a string containing the freeze twap proposal.
+func (p FreezeTwapProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Freeze Twap Proposal:
+Title:            %s
+Description:      %s
+Pool ID:          %d
+Asset 0 Denom:    %s
+Asset 1 Denom:    %s
+Freeze Time:      %s
+Duration:         %s
+Mark Unavailable: %t
+`, p.Title, p.Description, p.PoolId, p.Asset0Denom, p.Asset1Denom, p.FreezeTime, p.Duration, p.MarkUnavailable))
+	return b.String()
+}
+
+// NewSetPoolObservationIntervalProposal returns a new instance of a set pool observation interval proposal struct.
+func NewSetPoolObservationIntervalProposal(title, description string, poolId uint64, interval time.Duration) govtypesv1.Content {
+	return &SetPoolObservationIntervalProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		Interval:    interval,
+	}
+}
+
+func (p *SetPoolObservationIntervalProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetPoolObservationIntervalProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetPoolObservationIntervalProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetPoolObservationIntervalProposal) ProposalType() string {
+	return ProposalTypeSetPoolObservationInterval
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetPoolObservationIntervalProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return errors.New("pool id cannot be 0")
+	}
+	return ValidateObservationInterval(p.Interval)
+}
+
+// String returns a string containing the set pool observation interval proposal.
+func (p SetPoolObservationIntervalProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Observation Interval Proposal:
+Title:       %s
+Description: %s
+Pool ID:     %d
+Interval:    %s
+`, p.Title, p.Description, p.PoolId, p.Interval))
+	return b.String()
+}
+
+// ValidateObservationInterval validates that the observation interval is between zero and MaxObservationInterval.
+func ValidateObservationInterval(interval time.Duration) error {
+	if interval < 0 {
+		return fmt.Errorf("observation interval cannot be negative, was %s", interval)
+	}
+	if interval > MaxObservationInterval {
+		return fmt.Errorf("observation interval (%s) cannot be greater than %s", interval, MaxObservationInterval)
+	}
+	return nil
+}
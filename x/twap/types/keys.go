@@ -32,11 +32,16 @@ var (
 	DeprecatedHistoricalTWAPsIsPruningKey = []byte{0x02}
 	mostRecentTWAPsNoSeparator            = "recent_twap"
 	historicalTWAPPoolIndexNoSeparator    = "historical_pool_index"
+	twapFreezeNoSeparator                 = "twap_freeze"
+	observationIntervalNoSeparator        = "observation_interval"
+	pendingObservationNoSeparator         = "pending_observation"
+	pruningTimeIndexNoSeparator           = "pruning_time_index"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
 	// * Get all records for all pools, within a given time range
 	// * Get all records for a (pool id, asset 1, asset 2), within a given time range
+	// * Get all records older than a given time, for pruning
 
 	// format is just pool id | denom1 | denom2
 	// made for getting most recent key
@@ -44,6 +49,18 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// format is pool id | denom1 | denom2
+	// made for getting the governance set freeze of a pair
+	TwapFreezePrefix = twapFreezeNoSeparator + KeySeparator
+	// format is pool id
+	// made for getting the governance set observation interval of a pool
+	ObservationIntervalPrefix = observationIntervalNoSeparator + KeySeparator
+	// format is pool id
+	// made for iterating the pools that changed since their most recent record
+	PendingObservationPrefix = pendingObservationNoSeparator + KeySeparator
+	// format is time | pool id | denom1 | denom2, with the historical pool index key as value
+	// made for pruning records by time range, without iterating every pool
+	PruningTimeIndexPrefix = pruningTimeIndexNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -57,6 +74,26 @@ func FormatMostRecentTWAPKey(poolId uint64, denom1, denom2 string) []byte {
 	return []byte(fmt.Sprintf("%s%s%s%s%s%s", mostRecentTWAPsPrefix, poolIdS, KeySeparator, denom1, KeySeparator, denom2))
 }
 
+func FormatTwapFreezeKey(poolId uint64, denom1, denom2 string) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s", TwapFreezePrefix, poolIdS, KeySeparator, denom1, KeySeparator, denom2))
+}
+
+func FormatTwapFreezePoolPrefix(poolId uint64) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s%s", TwapFreezePrefix, poolIdS, KeySeparator))
+}
+
+func FormatObservationIntervalKey(poolId uint64) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s", ObservationIntervalPrefix, poolIdS))
+}
+
+func FormatPendingObservationKey(poolId uint64) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s", PendingObservationPrefix, poolIdS))
+}
+
 func FormatHistoricalPoolIndexDenomPairTWAPKey(poolId uint64, denom1, denom2 string) []byte {
 	var buffer bytes.Buffer
 	fmt.Fprintf(&buffer, "%s%d%s%s%s%s%s", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator)
@@ -74,6 +111,19 @@ func FormatHistoricalPoolIndexTWAPKeyFromStrTime(poolId uint64, denom1, denom2 s
 	return buffer.Bytes()
 }
 
+func FormatPruningTimeIndexKey(poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s%s%s", PruningTimeIndexPrefix, timeS, KeySeparator, poolIdS, KeySeparator, denom1, KeySeparator, denom2))
+}
+
+// FormatPruningTimeIndexTimePrefix returns the pruning time index prefix of the given time.
+// Every record written strictly before the given time sorts before it.
+func FormatPruningTimeIndexTimePrefix(accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	return []byte(fmt.Sprintf("%s%s", PruningTimeIndexPrefix, timeS))
+}
+
 func FormatHistoricalPoolIndexTimePrefix(poolId uint64, denom1, denom2 string) []byte {
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator))
 }
@@ -94,6 +144,10 @@ func GetAllMostRecentTwapsForPool(store storetypes.KVStore, poolId uint64) ([]Tw
 	return osmoutils.GatherValuesFromStore(store, []byte(startPrefix), []byte(endPrefix), ParseTwapFromBz)
 }
 
+func GetAllMostRecentTwaps(store storetypes.KVStore) ([]TwapRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(store, []byte(mostRecentTWAPsPrefix), ParseTwapFromBz)
+}
+
 func GetMostRecentTwapForPool(store storetypes.KVStore, poolId uint64, denom1, denom2 string) (TwapRecord, error) {
 	key := FormatMostRecentTWAPKey(poolId, denom1, denom2)
 	bz := store.Get(key)
@@ -110,3 +164,19 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	}
 	return twap, err
 }
+
+func ParseTwapFreezeFromBz(bz []byte) (freeze TwapFreeze, err error) {
+	if len(bz) == 0 {
+		return TwapFreeze{}, errors.New("twap freeze not found")
+	}
+	err = proto.Unmarshal(bz, &freeze)
+	return freeze, err
+}
+
+func ParsePoolObservationIntervalFromBz(bz []byte) (interval PoolObservationInterval, err error) {
+	if len(bz) == 0 {
+		return PoolObservationInterval{}, errors.New("pool observation interval not found")
+	}
+	err = proto.Unmarshal(bz, &interval)
+	return interval, err
+}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -28,6 +29,33 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TwapStrategy is the method by which a TWAP is computed from the accumulators
+// of the records of a pair.
+type TwapStrategy int32
+
+const (
+	TwapStrategyArithmetic TwapStrategy = 0
+	TwapStrategyGeometric  TwapStrategy = 1
+)
+
+var TwapStrategy_name = map[int32]string{
+	0: "TwapStrategyArithmetic",
+	1: "TwapStrategyGeometric",
+}
+
+var TwapStrategy_value = map[string]int32{
+	"TwapStrategyArithmetic": 0,
+	"TwapStrategyGeometric":  1,
+}
+
+func (x TwapStrategy) String() string {
+	return proto.EnumName(TwapStrategy_name, int32(x))
+}
+
+func (TwapStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{0}
+}
+
 // A TWAP record should be indexed in state by pool_id, (asset pair), timestamp
 // The asset pair assets should be lexicographically sorted.
 // Technically (pool_id, asset_0_denom, asset_1_denom, height) do not need to
@@ -147,11 +175,13 @@ type PruningState struct {
 	LastKeptTime time.Time `protobuf:"bytes,2,opt,name=last_kept_time,json=lastKeptTime,proto3,stdtime" json:"last_kept_time" yaml:"last_kept_time"`
 	// Deprecated: This field is deprecated.
 	LastKeySeen []byte `protobuf:"bytes,3,opt,name=last_key_seen,json=lastKeySeen,proto3" json:"last_key_seen,omitempty" deprecated:"true"` // Deprecated: Do not use.
-	// last_seen_pool_id is the pool_id that we will begin pruning in the next
-	// block. This value starts at the highest pool_id at time of epoch, and
-	// decreases until it reaches 1. When it reaches 1, the pruning
-	// process is complete.
-	LastSeenPoolId uint64 `protobuf:"varint,4,opt,name=last_seen_pool_id,json=lastSeenPoolId,proto3" json:"last_seen_pool_id,omitempty"`
+	// Deprecated: This field is deprecated. Pruning now iterates the time
+	// index, see last_seen_time_index_key.
+	LastSeenPoolId uint64 `protobuf:"varint,4,opt,name=last_seen_pool_id,json=lastSeenPoolId,proto3" json:"last_seen_pool_id,omitempty" deprecated:"true"` // Deprecated: Do not use.
+	// last_seen_time_index_key is the pruning time index key that we will
+	// resume pruning from in the next block. It is empty when pruning starts
+	// from the oldest indexed record.
+	LastSeenTimeIndexKey []byte `protobuf:"bytes,5,opt,name=last_seen_time_index_key,json=lastSeenTimeIndexKey,proto3" json:"last_seen_time_index_key,omitempty"`
 }
 
 func (m *PruningState) Reset()         { *m = PruningState{} }
@@ -209,6 +239,7 @@ func (m *PruningState) GetLastKeySeen() []byte {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *PruningState) GetLastSeenPoolId() uint64 {
 	if m != nil {
 		return m.LastSeenPoolId
@@ -216,9 +247,179 @@ func (m *PruningState) GetLastSeenPoolId() uint64 {
 	return 0
 }
 
+func (m *PruningState) GetLastSeenTimeIndexKey() []byte {
+	if m != nil {
+		return m.LastSeenTimeIndexKey
+	}
+	return nil
+}
+
+// TwapFreeze freezes the TWAPs of a pool's asset pair, set by governance
+// while the pool's spot prices are known to be manipulated. Until expiry,
+// TWAPs are computed as if the spot price stayed at its value at freeze_time,
+// or are unavailable if mark_unavailable is set.
+type TwapFreeze struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Lexicographically smaller denom of the pair
+	Asset0Denom string `protobuf:"bytes,2,opt,name=asset0_denom,json=asset0Denom,proto3" json:"asset0_denom,omitempty"`
+	// Lexicographically larger denom of the pair
+	Asset1Denom string `protobuf:"bytes,3,opt,name=asset1_denom,json=asset1Denom,proto3" json:"asset1_denom,omitempty"`
+	// The time of the last good spot price of the pair
+	FreezeTime time.Time `protobuf:"bytes,4,opt,name=freeze_time,json=freezeTime,proto3,stdtime" json:"freeze_time" yaml:"freeze_time"`
+	// The time at which the freeze is lifted
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+	// If set, TWAP queries on the pair fail instead of returning the frozen
+	// value.
+	MarkUnavailable bool `protobuf:"varint,6,opt,name=mark_unavailable,json=markUnavailable,proto3" json:"mark_unavailable,omitempty"`
+	// The record of the pair interpolated to freeze_time. It is kept with the
+	// freeze, as the historical records around freeze_time may be pruned
+	// before the freeze expires. Unset if mark_unavailable is set.
+	Record TwapRecord `protobuf:"bytes,7,opt,name=record,proto3" json:"record"`
+}
+
+func (m *TwapFreeze) Reset()         { *m = TwapFreeze{} }
+func (m *TwapFreeze) String() string { return proto.CompactTextString(m) }
+func (*TwapFreeze) ProtoMessage()    {}
+func (*TwapFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{2}
+}
+func (m *TwapFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapFreeze.Merge(m, src)
+}
+func (m *TwapFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapFreeze proto.InternalMessageInfo
+
+func (m *TwapFreeze) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapFreeze) GetAsset0Denom() string {
+	if m != nil {
+		return m.Asset0Denom
+	}
+	return ""
+}
+
+func (m *TwapFreeze) GetAsset1Denom() string {
+	if m != nil {
+		return m.Asset1Denom
+	}
+	return ""
+}
+
+func (m *TwapFreeze) GetFreezeTime() time.Time {
+	if m != nil {
+		return m.FreezeTime
+	}
+	return time.Time{}
+}
+
+func (m *TwapFreeze) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *TwapFreeze) GetMarkUnavailable() bool {
+	if m != nil {
+		return m.MarkUnavailable
+	}
+	return false
+}
+
+func (m *TwapFreeze) GetRecord() TwapRecord {
+	if m != nil {
+		return m.Record
+	}
+	return TwapRecord{}
+}
+
+// PoolObservationInterval is the minimum time between the TWAP records of a
+// pool, set by governance. Within the interval, the spot prices of the pool
+// are taken to be the ones of its most recent record.
+type PoolObservationInterval struct {
+	PoolId   uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval" yaml:"interval"`
+}
+
+func (m *PoolObservationInterval) Reset()         { *m = PoolObservationInterval{} }
+func (m *PoolObservationInterval) String() string { return proto.CompactTextString(m) }
+func (*PoolObservationInterval) ProtoMessage()    {}
+func (*PoolObservationInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{3}
+}
+func (m *PoolObservationInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolObservationInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolObservationInterval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolObservationInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolObservationInterval.Merge(m, src)
+}
+func (m *PoolObservationInterval) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolObservationInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolObservationInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolObservationInterval proto.InternalMessageInfo
+
+func (m *PoolObservationInterval) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolObservationInterval) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.twap.v1beta1.TwapStrategy", TwapStrategy_name, TwapStrategy_value)
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
 	proto.RegisterType((*PruningState)(nil), "osmosis.twap.v1beta1.PruningState")
+	proto.RegisterType((*TwapFreeze)(nil), "osmosis.twap.v1beta1.TwapFreeze")
+	proto.RegisterType((*PoolObservationInterval)(nil), "osmosis.twap.v1beta1.PoolObservationInterval")
 }
 
 func init() {
@@ -226,47 +427,62 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x21, 0xbf, 0x00, 0x9b, 0xf0, 0x43, 0x58, 0xb4, 0x58, 0x41, 0xb5, 0x83, 0x2b, 0x55,
-	0xe1, 0x50, 0x3b, 0xa6, 0x52, 0x0f, 0xf4, 0x44, 0x44, 0x0f, 0x6d, 0x51, 0x15, 0x19, 0x4e, 0xbd,
-	0x58, 0x1b, 0x7b, 0x70, 0x2c, 0xe2, 0xec, 0x6a, 0x77, 0x03, 0xcd, 0xb7, 0xe0, 0x2b, 0xf5, 0xc6,
-	0x91, 0x63, 0xd5, 0x43, 0x5a, 0xc1, 0xad, 0x47, 0x8e, 0x3d, 0x55, 0xbb, 0xeb, 0xa4, 0x84, 0xfe,
-	0x01, 0x6e, 0x9e, 0xd9, 0x37, 0xef, 0xbd, 0xf1, 0xce, 0x0e, 0x7a, 0x46, 0x78, 0x4e, 0x78, 0xc6,
-	0x7d, 0x71, 0x8a, 0xa9, 0x7f, 0x12, 0x74, 0x41, 0xe0, 0x40, 0x05, 0x11, 0x83, 0x98, 0xb0, 0xc4,
-	0xa3, 0x8c, 0x08, 0x62, 0xae, 0x15, 0x38, 0x4f, 0x1e, 0x79, 0x05, 0xae, 0xbe, 0x96, 0x92, 0x94,
-	0x28, 0x80, 0x2f, 0xbf, 0x34, 0xb6, 0xee, 0xa4, 0x84, 0xa4, 0x7d, 0xf0, 0x55, 0xd4, 0x1d, 0x1e,
-	0xf9, 0x22, 0xcb, 0x81, 0x0b, 0x9c, 0x53, 0x0d, 0x70, 0x3f, 0x55, 0x10, 0x3a, 0x3c, 0xc5, 0x34,
-	0x54, 0x0a, 0xe6, 0x3a, 0x5a, 0xa0, 0x84, 0xf4, 0xa3, 0x2c, 0xb1, 0x8c, 0x86, 0xd1, 0x2c, 0x87,
-	0x15, 0x19, 0xbe, 0x49, 0xcc, 0x4d, 0x54, 0xc3, 0x9c, 0x83, 0x68, 0x45, 0x09, 0x0c, 0x48, 0x6e,
-	0xcd, 0x35, 0x8c, 0xe6, 0x52, 0x58, 0xd5, 0xb9, 0x3d, 0x99, 0x9a, 0x42, 0x82, 0x02, 0x32, 0x7f,
-	0x03, 0x12, 0x68, 0xc8, 0x2e, 0xaa, 0xf4, 0x20, 0x4b, 0x7b, 0xc2, 0x2a, 0x37, 0x8c, 0xe6, 0x7c,
-	0x7b, 0xeb, 0xfb, 0xd8, 0x59, 0xd6, 0xcd, 0x45, 0xfa, 0xe0, 0x7a, 0xec, 0xac, 0x8d, 0x70, 0xde,
-	0xdf, 0x71, 0x67, 0xd2, 0x6e, 0x58, 0x14, 0x9a, 0xef, 0x51, 0x59, 0xf6, 0x60, 0xfd, 0xd7, 0x30,
-	0x9a, 0xd5, 0xed, 0xba, 0xa7, 0x1b, 0xf4, 0x26, 0x0d, 0x7a, 0x87, 0x93, 0x06, 0xdb, 0xf6, 0xf9,
-	0xd8, 0x29, 0x5d, 0x8f, 0x1d, 0x73, 0x86, 0x4f, 0x16, 0xbb, 0x67, 0x5f, 0x1d, 0x23, 0x54, 0x3c,
-	0x66, 0x07, 0x99, 0xb4, 0x15, 0xf5, 0x31, 0x17, 0x11, 0xa7, 0x44, 0x44, 0x94, 0x65, 0x31, 0x58,
-	0x15, 0xe9, 0xbd, 0xfd, 0x54, 0x32, 0x7c, 0x19, 0x3b, 0x1b, 0xb1, 0xfa, 0xe5, 0x3c, 0x39, 0xf6,
-	0x32, 0xe2, 0xe7, 0x58, 0xf4, 0xbc, 0x7d, 0x48, 0x71, 0x3c, 0xda, 0x83, 0x38, 0x5c, 0xa1, 0xad,
-	0x7d, 0xcc, 0xc5, 0x01, 0x25, 0xa2, 0x23, 0x6b, 0x15, 0x63, 0xf0, 0x1b, 0xe3, 0xc2, 0x43, 0x18,
-	0x83, 0x59, 0xc6, 0x1e, 0xb2, 0x69, 0x2b, 0xc2, 0x2c, 0x13, 0xbd, 0x1c, 0x44, 0x16, 0x47, 0x6a,
-	0x28, 0x70, 0x1c, 0x0f, 0xf3, 0x61, 0x1f, 0x0b, 0xc2, 0xac, 0xc5, 0xfb, 0xb3, 0x6f, 0xd0, 0xd6,
-	0xee, 0x94, 0x49, 0x5e, 0xfd, 0xee, 0x2f, 0x1e, 0xa5, 0x14, 0xfc, 0x53, 0x69, 0xe9, 0x21, 0x4a,
-	0xc1, 0xdf, 0x95, 0x30, 0xaa, 0xa7, 0x40, 0x72, 0x10, 0xec, 0x4f, 0x2a, 0xe8, 0xfe, 0x2a, 0xd6,
-	0x94, 0xe6, 0xb6, 0xc4, 0x11, 0x5a, 0x51, 0xb7, 0x00, 0x8c, 0x11, 0xa6, 0x2e, 0xde, 0xaa, 0xde,
-	0x39, 0x35, 0x6e, 0x31, 0x35, 0x8f, 0xf5, 0xd4, 0xdc, 0x22, 0xd0, 0x93, 0xb3, 0x2c, 0xb3, 0xaf,
-	0x65, 0x52, 0xd6, 0xb9, 0x3f, 0x0c, 0x54, 0xeb, 0xb0, 0xe1, 0x20, 0x1b, 0xa4, 0x07, 0x02, 0x0b,
-	0x30, 0x9f, 0x20, 0x94, 0xf1, 0x88, 0xea, 0x94, 0x7a, 0x48, 0x8b, 0xe1, 0x52, 0xc6, 0x0b, 0x8c,
-	0x19, 0xa3, 0xff, 0x15, 0xed, 0x31, 0x50, 0xa1, 0x6d, 0xcd, 0xdd, 0x69, 0x6b, 0xb3, 0xb0, 0xf5,
-	0xe8, 0x86, 0xad, 0x69, 0xbd, 0x76, 0x55, 0x93, 0xc9, 0x77, 0x40, 0x85, 0xac, 0x32, 0x5f, 0xa1,
-	0xe5, 0x02, 0x34, 0x8a, 0x38, 0xc0, 0x40, 0x3d, 0xc7, 0x5a, 0x7b, 0xfd, 0x7a, 0xec, 0xac, 0x26,
-	0x40, 0x19, 0xc4, 0x58, 0x40, 0xb2, 0xe3, 0x0a, 0x36, 0x04, 0xd7, 0x32, 0xc2, 0xaa, 0xae, 0x1e,
-	0x1d, 0x00, 0x0c, 0xcc, 0x2d, 0xb4, 0xaa, 0xe7, 0x17, 0x60, 0x10, 0x4d, 0x16, 0x42, 0x59, 0x2d,
-	0x04, 0x65, 0x5d, 0x82, 0x3a, 0x6a, 0x31, 0xb4, 0xdf, 0x9e, 0x5f, 0xda, 0xc6, 0xc5, 0xa5, 0x6d,
-	0x7c, 0xbb, 0xb4, 0x8d, 0xb3, 0x2b, 0xbb, 0x74, 0x71, 0x65, 0x97, 0x3e, 0x5f, 0xd9, 0xa5, 0x0f,
-	0xad, 0x34, 0x13, 0xbd, 0x61, 0xd7, 0x8b, 0x49, 0xee, 0x17, 0x2b, 0xeb, 0x79, 0x1f, 0x77, 0xf9,
-	0x24, 0xf0, 0x4f, 0xb6, 0x5f, 0xfa, 0x1f, 0xf5, 0xb6, 0x13, 0x23, 0x0a, 0xbc, 0x5b, 0x51, 0x8d,
-	0xbf, 0xf8, 0x19, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x9d, 0x58, 0x6f, 0x0a, 0x05, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x37, 0xcb, 0x36, 0x99, 0xdd, 0x90, 0xd4, 0x4a, 0x1b, 0x67, 0x23, 0xbc, 0x5b, 0x23,
+	0xa1, 0x14, 0x09, 0x7b, 0x37, 0x48, 0x3d, 0x14, 0x09, 0x29, 0xab, 0x00, 0x0a, 0x29, 0x10, 0x39,
+	0xe5, 0x02, 0x07, 0x6b, 0xd6, 0x7e, 0xf1, 0x8e, 0x62, 0x7b, 0x46, 0xe3, 0xd9, 0x34, 0xcb, 0x91,
+	0x03, 0xe2, 0xd8, 0x23, 0x77, 0xfe, 0x12, 0x6e, 0x95, 0xb8, 0xf4, 0x88, 0x38, 0x2c, 0x28, 0xb9,
+	0x71, 0xcc, 0x5f, 0x80, 0xe6, 0xc7, 0xfe, 0x48, 0xda, 0xd0, 0xe4, 0xc0, 0xcd, 0xf3, 0xde, 0xfb,
+	0xbe, 0xf7, 0x9e, 0xdf, 0x9b, 0x6f, 0xd0, 0x07, 0xb4, 0xcc, 0x69, 0x49, 0xca, 0x40, 0x3c, 0xc7,
+	0x2c, 0x38, 0xe9, 0xf6, 0x41, 0xe0, 0xae, 0x3a, 0x44, 0x1c, 0x62, 0xca, 0x13, 0x9f, 0x71, 0x2a,
+	0xa8, 0xbd, 0x66, 0xe2, 0x7c, 0xe9, 0xf2, 0x4d, 0x5c, 0x73, 0x2d, 0xa5, 0x29, 0x55, 0x01, 0x81,
+	0xfc, 0xd2, 0xb1, 0x4d, 0x37, 0xa5, 0x34, 0xcd, 0x20, 0x50, 0xa7, 0xfe, 0xf0, 0x28, 0x48, 0x86,
+	0x1c, 0x0b, 0x42, 0x0b, 0xe3, 0x6f, 0x5d, 0xf5, 0x0b, 0x92, 0x43, 0x29, 0x70, 0xce, 0x74, 0x80,
+	0xf7, 0x5b, 0x0d, 0xa1, 0x67, 0xcf, 0x31, 0x0b, 0x55, 0x05, 0xf6, 0x3a, 0xba, 0xcb, 0x28, 0xcd,
+	0x22, 0x92, 0x38, 0x56, 0xdb, 0xda, 0xaa, 0x86, 0x35, 0x79, 0xdc, 0x4b, 0xec, 0x87, 0xa8, 0x81,
+	0xcb, 0x12, 0x44, 0x27, 0x4a, 0xa0, 0xa0, 0xb9, 0x73, 0xa7, 0x6d, 0x6d, 0x2d, 0x85, 0x75, 0x6d,
+	0xdb, 0x95, 0xa6, 0x69, 0x48, 0xd7, 0x84, 0x2c, 0xcc, 0x85, 0x74, 0x75, 0xc8, 0x0e, 0xaa, 0x0d,
+	0x80, 0xa4, 0x03, 0xe1, 0x54, 0xdb, 0xd6, 0xd6, 0x42, 0xef, 0xd1, 0x3f, 0xe3, 0xd6, 0xb2, 0x6e,
+	0x3e, 0xd2, 0x8e, 0x8b, 0x71, 0x6b, 0x6d, 0x84, 0xf3, 0xec, 0x89, 0x77, 0xc9, 0xec, 0x85, 0x06,
+	0x68, 0x7f, 0x8d, 0xaa, 0xb2, 0x07, 0xe7, 0x9d, 0xb6, 0xb5, 0x55, 0xdf, 0x6e, 0xfa, 0xba, 0x41,
+	0x7f, 0xd2, 0xa0, 0xff, 0x6c, 0xd2, 0x60, 0xcf, 0x7d, 0x39, 0x6e, 0x55, 0x2e, 0xc6, 0x2d, 0xfb,
+	0x12, 0x9f, 0x04, 0x7b, 0x2f, 0xfe, 0x6a, 0x59, 0xa1, 0xe2, 0xb1, 0x0f, 0x90, 0xcd, 0x3a, 0x51,
+	0x86, 0x4b, 0x11, 0x95, 0x8c, 0x8a, 0x88, 0x71, 0x12, 0x83, 0x53, 0x93, 0xb5, 0xf7, 0xde, 0x97,
+	0x0c, 0x7f, 0x8e, 0x5b, 0x9b, 0xb1, 0x1a, 0x49, 0x99, 0x1c, 0xfb, 0x84, 0x06, 0x39, 0x16, 0x03,
+	0xff, 0x29, 0xa4, 0x38, 0x1e, 0xed, 0x42, 0x1c, 0xae, 0xb0, 0xce, 0x53, 0x5c, 0x8a, 0x43, 0x46,
+	0xc5, 0x81, 0xc4, 0x2a, 0xc6, 0xee, 0x6b, 0x8c, 0x77, 0x6f, 0xc3, 0xd8, 0xbd, 0xcc, 0x38, 0x40,
+	0x2e, 0xeb, 0x44, 0x98, 0x13, 0x31, 0xc8, 0x41, 0x90, 0x38, 0x52, 0x4b, 0x83, 0xe3, 0x78, 0x98,
+	0x0f, 0x33, 0x2c, 0x28, 0x77, 0x16, 0x6f, 0xce, 0xbe, 0xc9, 0x3a, 0x3b, 0x53, 0x26, 0x39, 0xfa,
+	0x9d, 0x19, 0x8f, 0xca, 0xd4, 0xfd, 0xcf, 0x4c, 0x4b, 0xb7, 0xc9, 0xd4, 0xbd, 0x3e, 0x13, 0x46,
+	0xcd, 0x14, 0x68, 0x0e, 0x82, 0xbf, 0x29, 0x0b, 0xba, 0x79, 0x16, 0x67, 0x4a, 0x73, 0x35, 0xc5,
+	0x11, 0x5a, 0x51, 0x53, 0x00, 0xce, 0x29, 0x57, 0x83, 0x77, 0xea, 0x6f, 0xdd, 0x1a, 0xcf, 0x6c,
+	0xcd, 0x03, 0xbd, 0x35, 0x57, 0x08, 0xf4, 0xe6, 0x2c, 0x4b, 0xeb, 0x67, 0xd2, 0x28, 0x71, 0xde,
+	0xef, 0x77, 0x50, 0xe3, 0x80, 0x0f, 0x0b, 0x52, 0xa4, 0x87, 0x02, 0x0b, 0xb0, 0xdf, 0x43, 0x88,
+	0x94, 0x11, 0xd3, 0x26, 0x75, 0x91, 0x16, 0xc3, 0x25, 0x52, 0x9a, 0x18, 0x3b, 0x46, 0xef, 0x2a,
+	0xda, 0x63, 0x60, 0x42, 0x97, 0x75, 0xe7, 0xad, 0x65, 0x3d, 0x34, 0x65, 0xdd, 0x9f, 0x2b, 0x6b,
+	0x8a, 0xd7, 0x55, 0x35, 0xa4, 0x71, 0x1f, 0x98, 0x90, 0x28, 0xfb, 0x13, 0xb4, 0x6c, 0x82, 0x46,
+	0x51, 0x09, 0x50, 0xa8, 0xeb, 0xd8, 0xe8, 0xad, 0x5f, 0x8c, 0x5b, 0xf7, 0x12, 0x60, 0x1c, 0x62,
+	0x2c, 0x20, 0x79, 0xe2, 0x09, 0x3e, 0x04, 0xcf, 0xb1, 0xc2, 0xba, 0x46, 0x8f, 0x0e, 0x01, 0x0a,
+	0xbb, 0x87, 0xee, 0xe9, 0xfd, 0x05, 0x28, 0xa2, 0x89, 0x20, 0xc8, 0x2b, 0x5b, 0xbd, 0x9e, 0x40,
+	0xf5, 0x24, 0xd1, 0x07, 0x5a, 0x31, 0x1e, 0x23, 0x67, 0xc6, 0x21, 0xab, 0x8c, 0x48, 0x91, 0xc0,
+	0xa9, 0x2c, 0x48, 0x5d, 0xde, 0x46, 0xb8, 0x36, 0x41, 0xc8, 0x82, 0xf7, 0xa4, 0x73, 0x1f, 0x46,
+	0xde, 0x8f, 0x0b, 0x5a, 0x91, 0x3e, 0xe7, 0x00, 0x3f, 0xc0, 0xff, 0xad, 0x48, 0xdf, 0xa3, 0xfa,
+	0x91, 0x4a, 0xa4, 0x07, 0x51, 0xbd, 0xad, 0xaa, 0xcc, 0x81, 0xf5, 0x14, 0x90, 0xb6, 0xa8, 0x19,
+	0x7c, 0x85, 0x6a, 0x70, 0xca, 0x08, 0x1f, 0xdd, 0x40, 0xad, 0x36, 0x0c, 0xef, 0xb2, 0xe6, 0xd5,
+	0x38, 0x4d, 0x69, 0x48, 0xec, 0x47, 0x68, 0x35, 0xc7, 0xfc, 0x38, 0x1a, 0x16, 0xf8, 0x04, 0x93,
+	0x0c, 0xf7, 0x33, 0x2d, 0x54, 0x8b, 0xe1, 0x8a, 0xb4, 0x7f, 0x3b, 0x33, 0xdb, 0x9f, 0xa2, 0x9a,
+	0xd6, 0x3b, 0xa5, 0x3b, 0xf5, 0xed, 0xb6, 0xff, 0xa6, 0x47, 0xc5, 0x9f, 0x29, 0x7f, 0xaf, 0x2a,
+	0xf3, 0x87, 0x06, 0xe5, 0xfd, 0x64, 0xa1, 0x75, 0x39, 0xc7, 0x6f, 0xfa, 0x25, 0xf0, 0x13, 0xf5,
+	0xa2, 0xec, 0x15, 0x42, 0x7e, 0x66, 0xd7, 0x4f, 0x24, 0x44, 0x8b, 0xc4, 0x04, 0x99, 0x8d, 0xde,
+	0x78, 0xad, 0xe1, 0x5d, 0xf3, 0x3e, 0xf5, 0x36, 0x4d, 0xbf, 0x2b, 0xba, 0xdf, 0x09, 0xd0, 0xfb,
+	0x45, 0x76, 0x3c, 0xe5, 0xf9, 0x70, 0x1f, 0x35, 0x64, 0x91, 0x87, 0x82, 0x63, 0x01, 0xe9, 0xc8,
+	0x6e, 0xa2, 0x07, 0xf3, 0xe7, 0x99, 0xbe, 0xac, 0x56, 0xec, 0x0d, 0x74, 0x7f, 0xde, 0xf7, 0xc5,
+	0x44, 0x17, 0x56, 0xad, 0x66, 0xf5, 0xe7, 0x5f, 0xdd, 0x4a, 0xef, 0xcb, 0x97, 0x67, 0xae, 0xf5,
+	0xea, 0xcc, 0xb5, 0xfe, 0x3e, 0x73, 0xad, 0x17, 0xe7, 0x6e, 0xe5, 0xd5, 0xb9, 0x5b, 0xf9, 0xe3,
+	0xdc, 0xad, 0x7c, 0xd7, 0x49, 0x89, 0x18, 0x0c, 0xfb, 0x7e, 0x4c, 0xf3, 0xc0, 0xfc, 0xa9, 0x8f,
+	0x32, 0xdc, 0x2f, 0x27, 0x87, 0xe0, 0x64, 0xfb, 0x71, 0x70, 0xaa, 0x5f, 0x6e, 0x31, 0x62, 0x50,
+	0xf6, 0x6b, 0xaa, 0xa5, 0x8f, 0xff, 0x1d, 0x00, 0x6e, 0x93, 0x1d, 0x49, 0xd6, 0x07, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -402,6 +618,13 @@ func (m *PruningState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LastSeenTimeIndexKey) > 0 {
+		i -= len(m.LastSeenTimeIndexKey)
+		copy(dAtA[i:], m.LastSeenTimeIndexKey)
+		i = encodeVarintTwapRecord(dAtA, i, uint64(len(m.LastSeenTimeIndexKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.LastSeenPoolId != 0 {
 		i = encodeVarintTwapRecord(dAtA, i, uint64(m.LastSeenPoolId))
 		i--
@@ -435,6 +658,120 @@ func (m *PruningState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwapFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MarkUnavailable {
+		i--
+		if m.MarkUnavailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FreezeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FreezeTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Asset1Denom) > 0 {
+		i -= len(m.Asset1Denom)
+		copy(dAtA[i:], m.Asset1Denom)
+		i = encodeVarintTwapRecord(dAtA, i, uint64(len(m.Asset1Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Asset0Denom) > 0 {
+		i -= len(m.Asset0Denom)
+		copy(dAtA[i:], m.Asset0Denom)
+		i = encodeVarintTwapRecord(dAtA, i, uint64(len(m.Asset0Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolObservationInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolObservationInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolObservationInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTwapRecord(v)
 	base := offset
@@ -501,6 +838,53 @@ func (m *PruningState) Size() (n int) {
 	if m.LastSeenPoolId != 0 {
 		n += 1 + sovTwapRecord(uint64(m.LastSeenPoolId))
 	}
+	l = len(m.LastSeenTimeIndexKey)
+	if l > 0 {
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *TwapFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwapRecord(uint64(m.PoolId))
+	}
+	l = len(m.Asset0Denom)
+	if l > 0 {
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	l = len(m.Asset1Denom)
+	if l > 0 {
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FreezeTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	if m.MarkUnavailable {
+		n += 2
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *PoolObservationInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwapRecord(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTwapRecord(uint64(l))
 	return n
 }
 
@@ -1033,6 +1417,394 @@ func (m *PruningState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenTimeIndexKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastSeenTimeIndexKey = append(m.LastSeenTimeIndexKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastSeenTimeIndexKey == nil {
+				m.LastSeenTimeIndexKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset0Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset1Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FreezeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkUnavailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MarkUnavailable = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolObservationInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolObservationInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolObservationInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
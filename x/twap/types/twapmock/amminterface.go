@@ -89,3 +89,7 @@ func (p *ProgrammedPoolManagerInterface) RouteCalculateSpotPrice(ctx sdk.Context
 func (p *ProgrammedPoolManagerInterface) GetNextPoolId(ctx sdk.Context) uint64 {
 	return p.underlyingKeeper.GetNextPoolId(ctx)
 }
+
+func (p *ProgrammedPoolManagerInterface) ResolveMigratedPoolId(ctx sdk.Context, poolId uint64) uint64 {
+	return p.underlyingKeeper.ResolveMigratedPoolId(ctx, poolId)
+}
@@ -16,6 +16,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdFeeTokens(),
 		GetCmdDenomPoolID(),
 		GetCmdBaseDenom(),
+		GetCmdEpochBurnedFees(),
+		GetCmdDynamicBaseFee(),
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
 	)
@@ -58,6 +60,29 @@ func GetCmdBaseDenom() *cobra.Command {
 	)
 }
 
+func GetCmdEpochBurnedFees() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryEpochBurnedFeesRequest](
+		"epoch-burned-fees",
+		"Query the tx fees burned at the end of an epoch",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} epoch-burned-fees [epoch-identifier] [epoch-number]
+{{.CommandPrefix}} epoch-burned-fees day 1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdDynamicBaseFee() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryDynamicBaseFeeRequest](
+		"dynamic-base-fee",
+		"Query the in-protocol dynamic base fee, which is zero if disabled",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} dynamic-base-fee
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
 func GetCmdQueryBaseFee() (*osmocli.QueryDescriptor, *types.QueryEipBaseFeeRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "base-fee",
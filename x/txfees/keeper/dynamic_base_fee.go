@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	mempool1559 "github.com/osmosis-labs/osmosis/v26/x/txfees/keeper/mempool-1559"
+	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
+)
+
+// GetDynamicBaseFee returns the in-protocol min gas price, in the base denom, that txs must pay in DeliverTx.
+// Returns zero if the dynamic base fee is disabled. Otherwise, returns the base fee set at the end of
+// the previous block, starting from the min dynamic base fee, and clamped to the current param bounds.
+func (k Keeper) GetDynamicBaseFee(ctx sdk.Context) osmomath.Dec {
+	params := k.GetParams(ctx)
+	if !params.DynamicBaseFeeEnabled {
+		return osmomath.ZeroDec()
+	}
+
+	baseFee, err := osmoutils.GetDec(ctx.KVStore(k.storeKey), types.DynamicBaseFeeKey)
+	if err != nil {
+		baseFee = params.MinDynamicBaseFee
+	}
+	return clampDynamicBaseFee(baseFee, params.MinDynamicBaseFee, params.MaxDynamicBaseFee)
+}
+
+// UpdateDynamicBaseFee adjusts the dynamic base fee for the next block given the gas used in the current block,
+// in the same way as EIP-1559. The base fee moves by at most the max change rate, proportionally to how far
+// the gas used is from the target gas, and is clamped to the min and max dynamic base fee.
+// If the dynamic base fee is disabled, the stored base fee is cleared, so that re-enabling it starts from the min.
+func (k Keeper) UpdateDynamicBaseFee(ctx sdk.Context, gasUsed, targetGas int64) {
+	store := ctx.KVStore(k.storeKey)
+	params := k.GetParams(ctx)
+	if !params.DynamicBaseFeeEnabled {
+		store.Delete(types.DynamicBaseFeeKey)
+		return
+	}
+	if targetGas <= 0 {
+		return
+	}
+
+	baseFee := k.GetDynamicBaseFee(ctx)
+	// change = (gasUsed - targetGas) / targetGas * maxChangeRate, which is at least -maxChangeRate
+	// since gasUsed is non-negative, and is capped at maxChangeRate when blocks are more than twice the target.
+	change := osmomath.NewDec(gasUsed - targetGas).QuoInt64(targetGas).Mul(params.DynamicBaseFeeMaxChangeRate)
+	change = osmomath.MinDec(change, params.DynamicBaseFeeMaxChangeRate)
+	baseFee = baseFee.Mul(osmomath.OneDec().Add(change))
+
+	osmoutils.MustSetDec(store, types.DynamicBaseFeeKey, clampDynamicBaseFee(baseFee, params.MinDynamicBaseFee, params.MaxDynamicBaseFee))
+}
+
+// GetDynamicBaseFeeTargetGas returns the target gas of the dynamic base fee, the target block space
+// percent of the consensus max block gas. It is read from the block's consensus params rather than the
+// mempool's target gas, which is node local state that is only updated when a node sees the params change.
+// Returns zero, leaving the base fee unchanged, if the max block gas is not set or unlimited.
+func (k Keeper) GetDynamicBaseFeeTargetGas(ctx sdk.Context) int64 {
+	consParams := ctx.ConsensusParams()
+	if consParams.Block == nil || consParams.Block.MaxGas <= 0 {
+		return 0
+	}
+	return mempool1559.TargetBlockSpacePercent.MulInt64(consParams.Block.MaxGas).TruncateInt().Int64()
+}
+
+// clampDynamicBaseFee returns the base fee bounded to [minBaseFee, maxBaseFee].
+func clampDynamicBaseFee(baseFee, minBaseFee, maxBaseFee osmomath.Dec) osmomath.Dec {
+	return osmomath.MaxDec(minBaseFee, osmomath.MinDec(baseFee, maxBaseFee))
+}
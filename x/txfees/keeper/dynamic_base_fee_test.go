@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateDynamicBaseFee() {
+	const targetGas = int64(1_000_000)
+
+	tests := map[string]struct {
+		disabled        bool
+		startingBaseFee osmomath.Dec
+		gasUsed         int64
+		expectedBaseFee osmomath.Dec
+	}{
+		"disabled: base fee is zero": {
+			disabled:        true,
+			gasUsed:         2 * targetGas,
+			expectedBaseFee: osmomath.ZeroDec(),
+		},
+		"block at target: base fee unchanged": {
+			startingBaseFee: osmomath.OneDec(),
+			gasUsed:         targetGas,
+			expectedBaseFee: osmomath.OneDec(),
+		},
+		"full block: base fee increases by the max change rate": {
+			startingBaseFee: osmomath.OneDec(),
+			gasUsed:         2 * targetGas,
+			expectedBaseFee: osmomath.MustNewDecFromStr("1.1"),
+		},
+		"block above twice the target: increase capped at the max change rate": {
+			startingBaseFee: osmomath.OneDec(),
+			gasUsed:         4 * targetGas,
+			expectedBaseFee: osmomath.MustNewDecFromStr("1.1"),
+		},
+		"half full block: base fee decreases proportionally": {
+			startingBaseFee: osmomath.OneDec(),
+			gasUsed:         targetGas / 2,
+			expectedBaseFee: osmomath.MustNewDecFromStr("0.95"),
+		},
+		"empty block: base fee decreases by the max change rate": {
+			startingBaseFee: osmomath.OneDec(),
+			gasUsed:         0,
+			expectedBaseFee: osmomath.MustNewDecFromStr("0.9"),
+		},
+		"no starting base fee: starts from the min": {
+			gasUsed:         targetGas,
+			expectedBaseFee: types.DefaultMinDynamicBaseFee,
+		},
+		"base fee clamped to the min": {
+			startingBaseFee: types.DefaultMinDynamicBaseFee,
+			gasUsed:         0,
+			expectedBaseFee: types.DefaultMinDynamicBaseFee,
+		},
+		"base fee clamped to the max": {
+			startingBaseFee: types.DefaultMaxDynamicBaseFee,
+			gasUsed:         2 * targetGas,
+			expectedBaseFee: types.DefaultMaxDynamicBaseFee,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyDynamicBaseFeeEnabled, !tc.disabled)
+			if !tc.startingBaseFee.IsNil() {
+				s.App.TxFeesKeeper.SetDynamicBaseFee(s.Ctx, tc.startingBaseFee)
+			}
+
+			// System under test.
+			s.App.TxFeesKeeper.UpdateDynamicBaseFee(s.Ctx, tc.gasUsed, targetGas)
+
+			s.Require().Equal(tc.expectedBaseFee.String(), s.App.TxFeesKeeper.GetDynamicBaseFee(s.Ctx).String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetDynamicBaseFee_ReenabledStartsFromMin() {
+	s.SetupTest(false)
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyDynamicBaseFeeEnabled, true)
+	s.App.TxFeesKeeper.SetDynamicBaseFee(s.Ctx, osmomath.OneDec())
+
+	// disabling clears the stored base fee at the end of the block.
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyDynamicBaseFeeEnabled, false)
+	s.App.TxFeesKeeper.UpdateDynamicBaseFee(s.Ctx, 0, 1)
+	s.Require().True(s.App.TxFeesKeeper.GetDynamicBaseFee(s.Ctx).IsZero())
+
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyDynamicBaseFeeEnabled, true)
+	s.Require().Equal(types.DefaultMinDynamicBaseFee.String(), s.App.TxFeesKeeper.GetDynamicBaseFee(s.Ctx).String())
+}
+
+func (s *KeeperTestSuite) TestGetDynamicBaseFeeTargetGas() {
+	tests := map[string]struct {
+		block             *cmtproto.BlockParams
+		expectedTargetGas int64
+	}{
+		"target block space percent of the max block gas": {
+			block:             &cmtproto.BlockParams{MaxGas: 300_000_000},
+			expectedTargetGas: 187_500_000,
+		},
+		"unlimited max block gas": {
+			block:             &cmtproto.BlockParams{MaxGas: -1},
+			expectedTargetGas: 0,
+		},
+		"no block params": {
+			expectedTargetGas: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			ctx := s.Ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: tc.block})
+
+			s.Require().Equal(tc.expectedTargetGas, s.App.TxFeesKeeper.GetDynamicBaseFeeTargetGas(ctx))
+		})
+	}
+}
@@ -1,11 +1,25 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
-func (k Keeper) SwapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, feeCollectorAddress sdk.AccAddress) {
-	k.swapNonNativeFeeToDenom(ctx, denomToSwapTo, feeCollectorAddress)
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
+)
+
+func (k Keeper) SwapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, feeCollectorAddress sdk.AccAddress) sdk.Coin {
+	return k.swapNonNativeFeeToDenom(ctx, denomToSwapTo, feeCollectorAddress)
 }
 
 func (k Keeper) ClearTakerFeeShareAccumulators(ctx sdk.Context) {
 	k.clearTakerFeeShareAccumulators(ctx)
 }
+
+func (k Keeper) BurnFeeShare(ctx sdk.Context, epochIdentifier string, epochNumber int64, baseDenom string) {
+	k.burnFeeShare(ctx, epochIdentifier, epochNumber, baseDenom)
+}
+
+func (k Keeper) SetDynamicBaseFee(ctx sdk.Context, baseFee osmomath.Dec) {
+	osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.DynamicBaseFeeKey, baseFee)
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
+)
+
+// burnFeeShare burns the fee burn fraction of the base denom balance held by the non-native fee collector
+// and records the burned amount for the given epoch. The remaining balance is left for distribution to stakers.
+// The base denom tx fees burned from the auth fee collector since the previous end of the epoch identifier
+// are recorded for the epoch as well.
+// If the burn fails, nothing is burned and the failure is reported via telemetry.
+func (k Keeper) burnFeeShare(ctx sdk.Context, epochIdentifier string, epochNumber int64, baseDenom string) {
+	k.recordFeeCollectorBurnedFees(ctx, epochIdentifier, epochNumber, baseDenom)
+
+	burned, ok := k.burnModuleBalanceShare(ctx, types.NonNativeTxFeeCollectorName, baseDenom)
+	if ok {
+		k.addEpochBurnedFees(ctx, epochIdentifier, epochNumber, burned)
+	}
+}
+
+// BurnFeeCollectorShare burns the fee burn fraction of the base denom balance held by the auth fee collector.
+// It runs at the start of BeginBlock, before the epochs module sends minted and swapped tokens to the fee
+// collector and before the distribution module distributes its balance to stakers, so that only the base
+// denom tx fees collected in the previous block are burned. The burned amount is added to a running total,
+// which is recorded for each epoch at its end by burnFeeShare.
+// If the burn fails, nothing is burned and the failure is reported via telemetry.
+func (k Keeper) BurnFeeCollectorShare(ctx sdk.Context) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return
+	}
+
+	burned, ok := k.burnModuleBalanceShare(ctx, authtypes.FeeCollectorName, baseDenom)
+	if !ok {
+		return
+	}
+	feeCollectorBurnedFees := k.getFeeCollectorBurnedFees(ctx, baseDenom).Add(burned)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FeeCollectorBurnedFeesKey, &feeCollectorBurnedFees)
+}
+
+// burnModuleBalanceShare burns the fee burn fraction of the base denom balance held by the given module account.
+// Returns the burned coin and true if anything was burned.
+// If the burn fails, nothing is burned and the failure is reported via telemetry.
+func (k Keeper) burnModuleBalanceShare(ctx sdk.Context, moduleName, baseDenom string) (sdk.Coin, bool) {
+	feeBurnFraction := k.GetParams(ctx).FeeBurnFraction
+	if feeBurnFraction.IsNil() || !feeBurnFraction.IsPositive() {
+		return sdk.Coin{}, false
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(moduleName)
+	baseDenomBalance := k.bankKeeper.GetBalance(ctx, moduleAddress, baseDenom)
+	burnAmount := baseDenomBalance.Amount.ToLegacyDec().Mul(feeBurnFraction).TruncateInt()
+	if !burnAmount.IsPositive() {
+		return sdk.Coin{}, false
+	}
+
+	coinsToBurn := sdk.NewCoins(sdk.NewCoin(baseDenom, burnAmount))
+	burned := false
+	applyFuncIfNoErrorAndLog(ctx, func(cacheCtx sdk.Context) error {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, moduleName, types.FeeBurnerName, coinsToBurn)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.BurnCoins(cacheCtx, types.FeeBurnerName, coinsToBurn)
+		if err != nil {
+			return err
+		}
+		burned = true
+		return nil
+	}, types.FeeBurnFailedMetricName, coinsToBurn)
+	return sdk.NewCoin(baseDenom, burnAmount), burned
+}
+
+// recordFeeCollectorBurnedFees records for the given epoch the base denom tx fees burned from the auth fee collector
+// since the previous end of its epoch identifier, i.e. the growth of the running total since then.
+func (k Keeper) recordFeeCollectorBurnedFees(ctx sdk.Context, epochIdentifier string, epochNumber int64, baseDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	feeCollectorBurnedFees := k.getFeeCollectorBurnedFees(ctx, baseDenom)
+
+	atLastEpochEnd := sdk.Coin{}
+	key := types.FormatFeeCollectorBurnedFeesAtEpochEndKey(epochIdentifier)
+	found, err := osmoutils.Get(store, key, &atLastEpochEnd)
+	if err != nil || !found || atLastEpochEnd.Denom != baseDenom {
+		atLastEpochEnd = sdk.NewCoin(baseDenom, osmomath.ZeroInt())
+	}
+
+	if burnedSinceLastEpochEnd := feeCollectorBurnedFees.Sub(atLastEpochEnd); burnedSinceLastEpochEnd.IsPositive() {
+		k.addEpochBurnedFees(ctx, epochIdentifier, epochNumber, burnedSinceLastEpochEnd)
+	}
+	osmoutils.MustSet(store, key, &feeCollectorBurnedFees)
+}
+
+// getFeeCollectorBurnedFees returns the running total of base denom tx fees burned from the auth fee collector.
+// Returns a zero coin of the base denom if nothing was burned or the base denom changed since.
+func (k Keeper) getFeeCollectorBurnedFees(ctx sdk.Context, baseDenom string) sdk.Coin {
+	burnedFees := sdk.Coin{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FeeCollectorBurnedFeesKey, &burnedFees)
+	if err != nil || !found || burnedFees.Denom != baseDenom {
+		return sdk.NewCoin(baseDenom, osmomath.ZeroInt())
+	}
+	return burnedFees
+}
+
+// GetEpochBurnedFees returns the amount of tx fees burned at the end of the given epoch.
+// Returns a zero coin of the base denom if nothing was burned.
+func (k Keeper) GetEpochBurnedFees(ctx sdk.Context, epochIdentifier string, epochNumber int64) (sdk.Coin, error) {
+	burnedFees := sdk.Coin{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatEpochBurnedFeesKey(epochIdentifier, epochNumber), &burnedFees)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !found {
+		baseDenom, err := k.GetBaseDenom(ctx)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return sdk.NewCoin(baseDenom, osmomath.ZeroInt()), nil
+	}
+	return burnedFees, nil
+}
+
+// addEpochBurnedFees adds the given burned coin to the burned fees tracked for the given epoch.
+func (k Keeper) addEpochBurnedFees(ctx sdk.Context, epochIdentifier string, epochNumber int64, burned sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatEpochBurnedFeesKey(epochIdentifier, epochNumber)
+
+	burnedFees := sdk.Coin{}
+	found, err := osmoutils.Get(store, key, &burnedFees)
+	if err == nil && found && burnedFees.Denom == burned.Denom {
+		burned = burned.Add(burnedFees)
+	}
+	osmoutils.MustSet(store, key, &burned)
+}
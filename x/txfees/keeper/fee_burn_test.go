@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestBurnFeeShare() {
+	tests := map[string]struct {
+		feeBurnFraction   osmomath.Dec
+		collectedFees     osmomath.Int
+		expectedBurned    osmomath.Int
+		expectedRemaining osmomath.Int
+	}{
+		"no burn fraction": {
+			feeBurnFraction:   osmomath.ZeroDec(),
+			collectedFees:     osmomath.NewInt(1000),
+			expectedBurned:    osmomath.ZeroInt(),
+			expectedRemaining: osmomath.NewInt(1000),
+		},
+		"half of the fees are burned": {
+			feeBurnFraction:   osmomath.MustNewDecFromStr("0.5"),
+			collectedFees:     osmomath.NewInt(1000),
+			expectedBurned:    osmomath.NewInt(500),
+			expectedRemaining: osmomath.NewInt(500),
+		},
+		"burned amount is truncated": {
+			feeBurnFraction:   osmomath.MustNewDecFromStr("0.333"),
+			collectedFees:     osmomath.NewInt(1000),
+			expectedBurned:    osmomath.NewInt(333),
+			expectedRemaining: osmomath.NewInt(667),
+		},
+		"all fees are burned": {
+			feeBurnFraction:   osmomath.OneDec(),
+			collectedFees:     osmomath.NewInt(1000),
+			expectedBurned:    osmomath.NewInt(1000),
+			expectedRemaining: osmomath.ZeroInt(),
+		},
+		"no collected fees": {
+			feeBurnFraction:   osmomath.MustNewDecFromStr("0.5"),
+			collectedFees:     osmomath.ZeroInt(),
+			expectedBurned:    osmomath.ZeroInt(),
+			expectedRemaining: osmomath.ZeroInt(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+			s.Require().NoError(err)
+
+			s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyFeeBurnFraction, tc.feeBurnFraction)
+			if tc.collectedFees.IsPositive() {
+				s.FundModuleAcc(types.NonNativeTxFeeCollectorName, sdk.NewCoins(sdk.NewCoin(baseDenom, tc.collectedFees)))
+			}
+			supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, baseDenom)
+
+			s.App.TxFeesKeeper.BurnFeeShare(s.Ctx, "day", 1, baseDenom)
+
+			collectorAddress := s.App.AccountKeeper.GetModuleAddress(types.NonNativeTxFeeCollectorName)
+			s.Require().Equal(tc.expectedRemaining, s.App.BankKeeper.GetBalance(s.Ctx, collectorAddress, baseDenom).Amount)
+
+			supplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, baseDenom)
+			s.Require().Equal(tc.expectedBurned, supplyBefore.Amount.Sub(supplyAfter.Amount))
+
+			burnedFees, err := s.App.TxFeesKeeper.GetEpochBurnedFees(s.Ctx, "day", 1)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoin(baseDenom, tc.expectedBurned), burnedFees)
+
+			// Burns are tracked per epoch.
+			burnedFees, err = s.App.TxFeesKeeper.GetEpochBurnedFees(s.Ctx, "day", 2)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoin(baseDenom, osmomath.ZeroInt()), burnedFees)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAfterEpochEnd_BurnsFeeShare() {
+	s.SetupTest(false)
+	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyFeeBurnFraction, osmomath.MustNewDecFromStr("0.25"))
+	s.FundModuleAcc(types.NonNativeTxFeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)))
+
+	feeCollectorAddress := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, baseDenom)
+
+	err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, "week", 3)
+	s.Require().NoError(err)
+
+	// The burned share is recorded for the epoch and the rest is sent to the fee collector.
+	burnedFees, err := s.App.TxFeesKeeper.GetEpochBurnedFees(s.Ctx, "week", 3)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(baseDenom, 250), burnedFees)
+
+	feeCollectorBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, baseDenom)
+	s.Require().Equal(osmomath.NewInt(750), feeCollectorBalanceAfter.Amount.Sub(feeCollectorBalanceBefore.Amount))
+
+	// Querying the burned fees returns the same amount.
+	res, err := s.queryClient.EpochBurnedFees(s.Ctx.Context(), &types.QueryEpochBurnedFeesRequest{EpochIdentifier: "week", EpochNumber: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(burnedFees, res.BurnedFees)
+}
+
+func (s *KeeperTestSuite) TestBurnFeeCollectorShare() {
+	s.SetupTest(false)
+	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyFeeBurnFraction, osmomath.MustNewDecFromStr("0.25"))
+
+	feeCollectorAddress := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, baseDenom)
+	s.FundModuleAcc(authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000).Sub(feeCollectorBalance)))
+	supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, baseDenom)
+
+	// The burn share of the fee collector balance is burned.
+	s.App.TxFeesKeeper.BurnFeeCollectorShare(s.Ctx)
+	s.Require().Equal(osmomath.NewInt(750), s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, baseDenom).Amount)
+	s.Require().Equal(osmomath.NewInt(250), supplyBefore.Amount.Sub(s.App.BankKeeper.GetSupply(s.Ctx, baseDenom).Amount))
+
+	// A second block burns the share of what is left.
+	s.App.TxFeesKeeper.BurnFeeCollectorShare(s.Ctx)
+	s.Require().Equal(osmomath.NewInt(563), s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, baseDenom).Amount)
+
+	// Both burns are recorded for the next epoch of each identifier, and only once.
+	err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, "day", 1)
+	s.Require().NoError(err)
+	err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, "week", 1)
+	s.Require().NoError(err)
+	err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, "day", 2)
+	s.Require().NoError(err)
+
+	for _, epoch := range []struct {
+		identifier string
+		number     int64
+		expected   int64
+	}{{"day", 1, 437}, {"week", 1, 437}, {"day", 2, 0}} {
+		burnedFees, err := s.App.TxFeesKeeper.GetEpochBurnedFees(s.Ctx, epoch.identifier, epoch.number)
+		s.Require().NoError(err)
+		s.Require().Equal(osmomath.NewInt(epoch.expected), burnedFees.Amount, epoch.identifier)
+	}
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// getFeeSwapRoutes returns the route to swap the given denom into denomToSwapTo at the end of each epoch.
+// The route override set by governance for the denom is used if it ends in denomToSwapTo.
+// Otherwise, the poolmanager canonical route of the denom pair is used if registered,
+// falling back to the single pool protorev knows for the denom pair.
+func (k Keeper) getFeeSwapRoutes(ctx sdk.Context, denom, denomToSwapTo string) (poolmanagertypes.SwapAmountInRoutes, error) {
+	for _, feeSwapRoute := range k.GetParams(ctx).FeeSwapRoutes {
+		if feeSwapRoute.Denom != denom {
+			continue
+		}
+		routes := poolmanagertypes.SwapAmountInRoutes(feeSwapRoute.Routes)
+		if routes[len(routes)-1].TokenOutDenom == denomToSwapTo {
+			return routes, nil
+		}
+	}
+
+	if route, found := k.poolManager.GetCanonicalRoute(ctx, denom, denomToSwapTo); found {
+		return route, nil
+	}
+
+	poolId, err := k.protorevKeeper.GetPoolForDenomPairNoOrder(ctx, denomToSwapTo, denom)
+	if err != nil {
+		return nil, err
+	}
+	return poolmanagertypes.SwapAmountInRoutes{{PoolId: poolId, TokenOutDenom: denomToSwapTo}}, nil
+}
+
+// getFeeSwapMinAmountOut returns the minimum amount out of swapping tokenIn through the routes,
+// that is the amount out at the spot price of the routes reduced by the fee swap max slippage.
+// Returns zero, allowing full slippage, if the fee swap max slippage is disabled.
+// Since the spot price is read in the same block as the swap, this bounds the price impact of the swap,
+// protecting fees from being dumped into illiquid pools, rather than price manipulation.
+func (k Keeper) getFeeSwapMinAmountOut(ctx sdk.Context, routes poolmanagertypes.SwapAmountInRoutes, tokenIn sdk.Coin) (osmomath.Int, error) {
+	maxSlippage := k.GetParams(ctx).FeeSwapMaxSlippage
+	if maxSlippage.IsZero() {
+		return osmomath.ZeroInt(), nil
+	}
+
+	// the spot price of the route is the product of the spot prices of its hops.
+	spotPrice := osmomath.OneBigDec()
+	denomIn := tokenIn.Denom
+	for _, route := range routes {
+		hopSpotPrice, err := k.poolManager.RouteCalculateSpotPrice(ctx, route.PoolId, route.TokenOutDenom, denomIn)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		spotPrice = spotPrice.Mul(hopSpotPrice)
+		denomIn = route.TokenOutDenom
+	}
+
+	spotAmountOut := osmomath.BigDecFromSDKInt(tokenIn.Amount).Mul(spotPrice)
+	return spotAmountOut.Mul(osmomath.BigDecFromDec(osmomath.OneDec().Sub(maxSlippage))).Dec().TruncateInt(), nil
+}
+
+// swapFeeThroughRoutes swaps tokenIn from the sender through the routes without charging a taker fee,
+// since fees are accruing from the taker fee itself. Returns an error if the amount out is below tokenOutMinAmount.
+// The caller is expected to run this in a cache context, so that a failed hop reverts the previous ones.
+func (k Keeper) swapFeeThroughRoutes(ctx sdk.Context, sender sdk.AccAddress, routes poolmanagertypes.SwapAmountInRoutes, tokenIn sdk.Coin, tokenOutMinAmount osmomath.Int) (osmomath.Int, error) {
+	for _, route := range routes {
+		tokenOutAmount, err := k.poolManager.SwapExactAmountInNoTakerFee(ctx, sender, route.PoolId, tokenIn, route.TokenOutDenom, osmomath.ZeroInt())
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
+	}
+
+	if tokenIn.Amount.LT(tokenOutMinAmount) {
+		return osmomath.Int{}, fmt.Errorf("fee swap amount out %s is below the minimum amount out %s", tokenIn.Amount, tokenOutMinAmount)
+	}
+	return tokenIn.Amount, nil
+}
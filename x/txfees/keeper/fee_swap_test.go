@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
+)
+
+// prepareFullRangePool creates a concentrated pool with a full range position of the given coins.
+func (s *KeeperTestSuite) prepareFullRangePool(poolCoins sdk.Coins) uint64 {
+	poolId := s.PrepareConcentratedPoolWithCoins(poolCoins[0].Denom, poolCoins[1].Denom).GetId()
+	s.FundAcc(s.TestAccs[0], poolCoins)
+	_, err := s.App.ConcentratedLiquidityKeeper.CreateFullRangePosition(s.Ctx, poolId, s.TestAccs[0], poolCoins)
+	s.Require().NoError(err)
+	return poolId
+}
+
+func (s *KeeperTestSuite) TestSwapNonNativeFeeToDenom_FeeSwapRoutes() {
+	tests := map[string]struct {
+		setRoute              bool
+		routeEndsInOtherDenom bool
+		expectSwapped         bool
+	}{
+		"no route override and no protorev link: not swapped": {},
+		"route override into the denom to swap to: swapped": {
+			setRoute:      true,
+			expectSwapped: true,
+		},
+		"route override into another denom: not swapped": {
+			setRoute:              true,
+			routeEndsInOtherDenom: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+			s.Require().NoError(err)
+
+			// foo is only paired with bar, which is paired with the base denom.
+			fooBarPoolId := s.prepareFullRangePool(sdk.NewCoins(sdk.NewInt64Coin(preSwapDenom, 1_000_000), sdk.NewInt64Coin(otherPreSwapDenom, 1_000_000)))
+			barBasePoolId := s.prepareFullRangePool(sdk.NewCoins(sdk.NewInt64Coin(otherPreSwapDenom, 1_000_000), sdk.NewInt64Coin(baseDenom, 1_000_000)))
+
+			if tc.setRoute {
+				routes := []poolmanagertypes.SwapAmountInRoute{
+					{PoolId: fooBarPoolId, TokenOutDenom: otherPreSwapDenom},
+					{PoolId: barBasePoolId, TokenOutDenom: baseDenom},
+				}
+				if tc.routeEndsInOtherDenom {
+					routes = routes[:1]
+				}
+				s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyFeeSwapRoutes, []types.FeeSwapRoute{{Denom: preSwapDenom, Routes: routes}})
+			}
+
+			testAccount := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(testAccount, sdk.NewCoins(sdk.NewInt64Coin(preSwapDenom, 1000)))
+
+			// System under test.
+			coinOut := s.App.TxFeesKeeper.SwapNonNativeFeeToDenom(s.Ctx, baseDenom, testAccount)
+
+			balances := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+			s.Require().Len(balances, 1)
+			if tc.expectSwapped {
+				s.Require().Equal(baseDenom, balances[0].Denom)
+				s.Require().Equal(balances[0], coinOut)
+			} else {
+				s.Require().Equal(preSwapDenom, balances[0].Denom)
+				s.Require().True(coinOut.IsZero())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapNonNativeFeeToDenom_FeeSwapMaxSlippage() {
+	tests := map[string]struct {
+		maxSlippage   osmomath.Dec
+		expectSwapped bool
+	}{
+		"guard disabled: swapped with full slippage": {
+			maxSlippage:   osmomath.ZeroDec(),
+			expectSwapped: true,
+		},
+		"price impact within max slippage: swapped": {
+			maxSlippage:   osmomath.MustNewDecFromStr("0.2"),
+			expectSwapped: true,
+		},
+		"price impact above max slippage: not swapped": {
+			maxSlippage: osmomath.MustNewDecFromStr("0.05"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+			s.Require().NoError(err)
+
+			poolId := s.prepareFullRangePool(sdk.NewCoins(sdk.NewInt64Coin(preSwapDenom, 1000), sdk.NewInt64Coin(baseDenom, 1000)))
+			s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, preSwapDenom, baseDenom, poolId)
+			s.App.TxFeesKeeper.SetParam(s.Ctx, types.KeyFeeSwapMaxSlippage, tc.maxSlippage)
+
+			// swapping a tenth of the pool liquidity has a price impact of about 10%.
+			testAccount := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(testAccount, sdk.NewCoins(sdk.NewInt64Coin(preSwapDenom, 100)))
+
+			// System under test.
+			s.App.TxFeesKeeper.SwapNonNativeFeeToDenom(s.Ctx, baseDenom, testAccount)
+
+			balances := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+			s.Require().Len(balances, 1)
+			if tc.expectSwapped {
+				s.Require().Equal(baseDenom, balances[0].Denom)
+			} else {
+				s.Require().Equal(sdk.NewInt64Coin(preSwapDenom, 100), balances[0])
+			}
+		})
+	}
+}
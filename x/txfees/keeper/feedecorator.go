@@ -2,12 +2,16 @@ package keeper
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"math"
 	"path/filepath"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/skip-mev/block-sdk/v2/block/base"
 
 	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -161,8 +165,9 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 }
 
 func (mfd MempoolFeeDecorator) getMinBaseGasPrice(ctx sdk.Context, baseDenom string, simulate bool, feeTx sdk.FeeTx) osmomath.Dec {
-	// In block execution (DeliverTx), its set to the governance decided upon consensus min fee.
-	minBaseGasPrice := types.ConsensusMinFee
+	// In block execution (DeliverTx), its set to the governance decided upon consensus min fee,
+	// raised to the dynamic base fee if it is enabled.
+	minBaseGasPrice := osmomath.MaxDec(types.ConsensusMinFee, mfd.TxFeesKeeper.GetDynamicBaseFee(ctx))
 	// If we are in CheckTx, a separate function is ran locally to ensure sufficient fees for entering our mempool.
 	// So we ensure that the provided fees meet a minimum threshold for the validator
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
@@ -202,6 +207,59 @@ func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice osmomath.Dec, g
 	return nil
 }
 
+// GetTxPriority returns the mempool priority of a tx paying the given fee for the given gas:
+// its gas price in the base denom, multiplied by TxPriorityGasPriceScale.
+// Fees in other denoms are converted to the base denom at TWAP, see ConvertToBaseTokenTwap.
+// Returns 0 if the fee is not a single coin, the gas is zero, or the fee can't be converted.
+func (k Keeper) GetTxPriority(ctx sdk.Context, fee sdk.Coins, gas uint64) int64 {
+	if len(fee) != 1 || gas == 0 {
+		return 0
+	}
+
+	// The conversion is read only, and must not consume the tx's gas so that CheckTx uses as much gas as simulation.
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	baseFee, err := k.ConvertToBaseTokenTwap(ctx, fee[0])
+	if err != nil {
+		return 0
+	}
+
+	priority := baseFee.Amount.MulRaw(types.TxPriorityGasPriceScale).Quo(osmomath.NewIntFromUint64(gas))
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+	return priority.Int64()
+}
+
+// MempoolTxPriority returns the priority the app-side mempool orders txs by: the worth of their fee in the base denom
+// per unit of gas, see GetTxPriority, rather than the raw fee amount, so that txs paying in alternative fee tokens are
+// neither unfairly prioritized nor deprioritized.
+func (k Keeper) MempoolTxPriority() base.TxPriority[int64] {
+	return base.TxPriority[int64]{
+		GetTxPriority: func(goCtx context.Context, tx sdk.Tx) int64 {
+			ctx, ok := goCtx.(sdk.Context)
+			if !ok {
+				return 0
+			}
+			feeTx, ok := tx.(sdk.FeeTx)
+			if !ok {
+				return 0
+			}
+			return k.GetTxPriority(ctx, feeTx.GetFee(), feeTx.GetGas())
+		},
+		Compare: func(a, b int64) int {
+			switch {
+			case a > b:
+				return 1
+			case a < b:
+				return -1
+			default:
+				return 0
+			}
+		},
+		MinValue: math.MinInt64,
+	}
+}
+
 func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx) osmomath.Dec {
 	var is1559enabled = mfd.Opts.Mempool1559Enabled
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -13,6 +14,8 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	signerextraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block/base"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
@@ -22,6 +25,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v26/x/txfees/keeper"
 	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
 )
@@ -331,6 +335,111 @@ func (s *KeeperTestSuite) TestMempoolFeeDecorator_AnteHandle_MsgTransfer() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGetTxPriority() {
+	s.SetupTest(false)
+	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	// foo is worth half the base denom.
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1_000_000_000), sdk.NewInt64Coin("foo", 2_000_000_000))
+	s.Require().NoError(s.ExecuteUpgradeFeeTokenProposal("foo", poolId))
+
+	tests := map[string]struct {
+		fee              sdk.Coins
+		gas              uint64
+		expectedPriority int64
+	}{
+		"base denom fee": {
+			fee:              sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2500)),
+			gas:              1_000_000,
+			expectedPriority: 2500,
+		},
+		"fee token fee worth the same": {
+			fee:              sdk.NewCoins(sdk.NewInt64Coin("foo", 5000)),
+			gas:              1_000_000,
+			expectedPriority: 2500,
+		},
+		"zero gas": {
+			fee: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2500)),
+		},
+		"no fee": {
+			fee: sdk.NewCoins(),
+			gas: 1_000_000,
+		},
+		"multiple fee coins": {
+			fee: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2500), sdk.NewInt64Coin("foo", 5000)),
+			gas: 1_000_000,
+		},
+		"not a fee token": {
+			fee: sdk.NewCoins(sdk.NewInt64Coin("bar", 5000)),
+			gas: 1_000_000,
+		},
+		"priority overflows": {
+			fee:              sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 10_000_000_000_000)),
+			gas:              1,
+			expectedPriority: math.MaxInt64,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			// No TWAP is available yet, so fees in fee tokens are converted at the spot price.
+			s.Require().Equal(tc.expectedPriority, s.App.TxFeesKeeper.GetTxPriority(s.Ctx, tc.fee, tc.gas))
+		})
+	}
+
+	// Once the TWAP is available, a swap moving the spot price of foo does not change the priority of fees paid in foo.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * types.FeePriorityTwapWindow))
+	fooFee := sdk.NewInt64Coin("foo", 5000)
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: baseDenom}}, sdk.NewInt64Coin("foo", 5_000_000), osmomath.OneInt())
+	s.Require().NoError(err)
+
+	spotConvertedFee, err := s.App.TxFeesKeeper.ConvertToBaseToken(s.Ctx, fooFee)
+	s.Require().NoError(err)
+	s.Require().True(spotConvertedFee.Amount.LT(osmomath.NewInt(2500)))
+	s.Require().Equal(int64(2500), s.App.TxFeesKeeper.GetTxPriority(s.Ctx, sdk.NewCoins(fooFee), 1_000_000))
+}
+
+func (s *KeeperTestSuite) TestMempoolTxPriority() {
+	s.SetupTest(false)
+	s.Ctx = s.Ctx.WithIsCheckTx(true)
+	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	// foo is worth half the base denom.
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 1_000_000_000), sdk.NewInt64Coin("foo", 2_000_000_000))
+	s.Require().NoError(s.ExecuteUpgradeFeeTokenProposal("foo", poolId))
+
+	// The txs built by prepareTx request the same gas, from different signers. They are expected to be ordered by
+	// the worth of their fee in the base denom, so the tx paying the largest raw amount, in foo, comes second.
+	expectedOrder := []sdk.Coin{
+		sdk.NewInt64Coin(baseDenom, 300_000),
+		sdk.NewInt64Coin("foo", 500_000),
+		sdk.NewInt64Coin(baseDenom, 200_000),
+	}
+
+	mempool := base.NewMempool(s.App.TxFeesKeeper.MempoolTxPriority(), signerextraction.NewDefaultAdapter(), 100)
+	for i := len(expectedOrder) - 1; i >= 0; i-- {
+		tx, err := s.prepareTx(&ibctransfertypes.MsgTransfer{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-0",
+			Token:         sdk.NewCoin(baseDenom, osmomath.NewInt(1000)),
+			Sender:        "osmo1sender",
+			Receiver:      "osmo1receiver",
+		}, sdk.NewCoins(expectedOrder[i]))
+		s.Require().NoError(err)
+		s.Require().NoError(mempool.Insert(s.Ctx, tx))
+	}
+
+	order := []sdk.Coin{}
+	for it := mempool.Select(s.Ctx, nil); it != nil; it = it.Next() {
+		feeTx, ok := it.Tx().(sdk.FeeTx)
+		s.Require().True(ok)
+		order = append(order, feeTx.GetFee()[0])
+	}
+	s.Require().Equal(expectedOrder, order)
+}
+
 func (s *KeeperTestSuite) prepareTx(msg sdk.Msg, txFee sdk.Coins) (sdk.Tx, error) {
 	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
 	priv0, _, addr0 := testdata.KeyTestPubAddr()
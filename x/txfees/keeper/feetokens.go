@@ -40,10 +40,45 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 	return sdk.NewCoin(baseDenom, spotPrice.Dec().MulIntMut(inputFee.Amount).RoundInt()), nil
 }
 
+// ConvertToBaseTokenTwap converts a fee amount in a whitelisted fee token to the base fee token amount
+// at the arithmetic TWAP of the fee token's pool over the last FeePriorityTwapWindow.
+// If governance registered a canonical route from the fee token to the base denom, its TWAP is used instead of the pool's.
+// If no TWAP is available yet, e.g. because the pool was just created, the spot price is used instead.
+func (k Keeper) ConvertToBaseTokenTwap(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if inputFee.Denom == baseDenom {
+		return inputFee, nil
+	}
+
+	feeToken, err := k.GetFeeToken(ctx, inputFee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	startTime := ctx.BlockTime().Add(-types.FeePriorityTwapWindow)
+	var twapPrice osmomath.Dec
+	if _, found := k.poolManager.GetCanonicalRoute(ctx, feeToken.Denom, baseDenom); found {
+		twapPrice, err = k.poolManager.CanonicalRouteArithmeticTwapToNow(ctx, feeToken.Denom, baseDenom, startTime)
+	} else {
+		twapPrice, err = k.twapKeeper.GetArithmeticTwapToNow(ctx, feeToken.PoolID, feeToken.Denom, baseDenom, startTime)
+	}
+	if err != nil {
+		return k.ConvertToBaseToken(ctx, inputFee)
+	}
+
+	return sdk.NewCoin(baseDenom, twapPrice.MulInt(inputFee.Amount).RoundInt()), nil
+}
+
 // CalcFeeSpotPrice converts the provided tx fees into their equivalent value in the base denomination.
 // Spot Price Calculation: spotPrice / (1 - spreadFactor),
 // where spotPrice is defined as:
 // (tokenBalanceIn / tokenWeightIn) / (tokenBalanceOut / tokenWeightOut)
+// If governance registered a canonical route from the fee token to the base denom, the spot price of the route
+// is used instead of the spot price of the fee token's pool.
 func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (osmomath.BigDec, error) {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -55,6 +90,10 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (osmomath.B
 		return osmomath.BigDec{}, err
 	}
 
+	if _, found := k.poolManager.GetCanonicalRoute(ctx, feeToken.Denom, baseDenom); found {
+		return k.poolManager.CanonicalRouteSpotPrice(ctx, feeToken.Denom, baseDenom)
+	}
+
 	spotPrice, err := k.poolManager.RouteCalculateSpotPrice(ctx, feeToken.PoolID, baseDenom, feeToken.Denom)
 	if err != nil {
 		return osmomath.BigDec{}, err
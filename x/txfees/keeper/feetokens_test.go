@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -77,6 +78,48 @@ func (s *KeeperTestSuite) TestCalcFeeSpotPrice() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCalcFeeSpotPrice_CanonicalRoute() {
+	s.SetupTest(false)
+
+	feeTokenPoolId := s.PrepareBalancerPoolWithCoins(
+		sdk.NewCoin("foo", osmomath.NewInt(10000000)),
+		sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(10000000)),
+	)
+	fooBarPoolId := s.PrepareBalancerPoolWithCoins(
+		sdk.NewCoin("foo", osmomath.NewInt(100_000_000_000)),
+		sdk.NewCoin("bar", osmomath.NewInt(200_000_000_000)),
+	)
+	barBasePoolId := s.PrepareBalancerPoolWithCoins(
+		sdk.NewCoin("bar", osmomath.NewInt(100_000_000_000)),
+		sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(300_000_000_000)),
+	)
+	err := s.ExecuteUpgradeFeeTokenProposal("foo", feeTokenPoolId)
+	s.Require().NoError(err)
+
+	sp, err := s.App.TxFeesKeeper.CalcFeeSpotPrice(s.Ctx, "foo")
+	s.Require().NoError(err)
+	s.Require().True(sp.Equal(osmomath.NewBigDec(1)))
+
+	// once a canonical route is registered, it prices the fee token instead of its pool.
+	err = s.App.PoolManagerKeeper.SetCanonicalRoute(s.Ctx, poolmanagertypes.CanonicalRoute{
+		Denom:      "foo",
+		QuoteDenom: sdk.DefaultBondDenom,
+		Route: []poolmanagertypes.SwapAmountInRoute{
+			{PoolId: fooBarPoolId, TokenOutDenom: "bar"},
+			{PoolId: barBasePoolId, TokenOutDenom: sdk.DefaultBondDenom},
+		},
+	})
+	s.Require().NoError(err)
+
+	sp, err = s.App.TxFeesKeeper.CalcFeeSpotPrice(s.Ctx, "foo")
+	s.Require().NoError(err)
+	s.Require().True(sp.Equal(osmomath.NewBigDec(6)))
+
+	converted, err := s.App.TxFeesKeeper.ConvertToBaseToken(s.Ctx, sdk.NewInt64Coin("foo", 10))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60), converted)
+}
+
 func (s *KeeperTestSuite) TestUpgradeFeeTokenProposals() {
 	s.SetupTest(false)
 
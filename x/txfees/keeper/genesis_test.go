@@ -33,6 +33,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		Feetokens: testFeeTokens,
 		Params: types.Params{
 			WhitelistedFeeTokenSetters: testWhitelistAddrs,
+			FeeBurnFraction:            types.DefaultFeeBurnFraction,
 		},
 	})
 
@@ -55,6 +56,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		Feetokens: testFeeTokens,
 		Params: types.Params{
 			WhitelistedFeeTokenSetters: testWhitelistAddrs,
+			FeeBurnFraction:            types.DefaultFeeBurnFraction,
 		},
 	})
 
@@ -90,3 +90,27 @@ func (q Querier) GetEipBaseFee(_ context.Context, _ *types.QueryEipBaseFeeReques
 	response := mempool1559.CurEipState.GetCurBaseFee()
 	return &types.QueryEipBaseFeeResponse{BaseFee: response}, nil
 }
+
+func (q Querier) DynamicBaseFee(ctx context.Context, _ *types.QueryDynamicBaseFeeRequest) (*types.QueryDynamicBaseFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryDynamicBaseFeeResponse{BaseFee: q.Keeper.GetDynamicBaseFee(sdkCtx)}, nil
+}
+
+func (q Querier) EpochBurnedFees(ctx context.Context, req *types.QueryEpochBurnedFeesRequest) (*types.QueryEpochBurnedFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.EpochIdentifier) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty epoch identifier")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	burnedFees, err := q.Keeper.GetEpochBurnedFees(sdkCtx, req.EpochIdentifier, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochBurnedFeesResponse{BurnedFees: burnedFees}, nil
+}
@@ -25,7 +25,8 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 // at the end of each epoch, swap all non-OSMO fees into the desired denom and send either to fee collector or community pool.
 // Staking fee collector for staking rewards.
 // - All non-native rewards that have a pool with liquidity and a link set in protorev get swapped to native denom
-// - All resulting native tokens get sent to the fee collector.
+// - The fee burn fraction of the resulting native tokens gets burned and tracked for the epoch, together with the native tx fees burned from the fee collector in BeginBlock since the last epoch end of the identifier.
+// - All remaining native tokens get sent to the fee collector.
 // - Any non-native tokens that did not have associated pool stay in the balance of staking fee collector.
 // Community pool fee collector.
 // - All non-native rewards that have a pool with liquidity and a link set in protorev get swapped to a denom configured by parameter.
@@ -39,6 +40,9 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	// Non-native fee token collector for staking rewards get swapped entirely into base denom.
 	k.swapNonNativeFeeToDenom(ctx, defaultFeesDenom, nonNativefeeTokenCollectorAddress)
 
+	// Burn the configured share of the swapped fees before the remainder is distributed to stakers.
+	k.burnFeeShare(ctx, epochIdentifier, epochNumber, defaultFeesDenom)
+
 	// Now that the rewards have been swapped, transfer any base denom existing in the non-native tx fee collector to the auth fee token collector (indirectly distributing to stakers)
 	baseDenomCoins := sdk.NewCoins(k.bankKeeper.GetBalance(ctx, nonNativefeeTokenCollectorAddress, defaultFeesDenom))
 	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
@@ -223,7 +227,9 @@ func (k Keeper) calculateDistributeAndTrackTakerFees(ctx sdk.Context, defaultFee
 // swapNonNativeFeeToDenom swaps coins into the denomToSwapTo from the given fee collector address.
 // If an error in swap occurs for a given denom, it will be silently skipped.
 // CONTRACT: a pool must exist between each denom in the balance and denomToSwapTo. If doesn't exist. Silently skip swap.
-// CONTRACT: protorev must be configured to have a pool for the given denom pair. Otherwise, the denom will be skipped.
+// CONTRACT: protorev must be configured to have a pool for the given denom pair, unless a fee swap route ending in
+// denomToSwapTo is set for the denom. Otherwise, the denom will be skipped.
+// Swaps whose amount out is below the fee swap max slippage from the spot price of their route are skipped as well.
 func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, feeCollectorAddress sdk.AccAddress) sdk.Coin {
 	coinsToSwap := k.bankKeeper.GetAllBalances(ctx, feeCollectorAddress)
 	totalCoinOut := sdk.NewCoin(denomToSwapTo, osmomath.ZeroInt())
@@ -234,13 +240,13 @@ func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, f
 			continue
 		}
 
-		// Search for the denom pair route via the protorev store.
+		// Use the route governance set for this denom if any, otherwise search for the denom pair route via the protorev store.
 		// Since OSMO is one of the protorev denoms, many of the routes will exist in this store.
 		// There will be times when this store does not know about a route, but this is acceptable
 		// since this will likely be a very small value of a relatively unknown token. If this begins
 		// to accrue more value, we can always manually register the route and it will get swapped in
 		// the next epoch.
-		poolId, err := k.protorevKeeper.GetPoolForDenomPairNoOrder(ctx, denomToSwapTo, coin.Denom)
+		routes, err := k.getFeeSwapRoutes(ctx, coin.Denom, denomToSwapTo)
 		if err != nil {
 			telemetry.IncrCounterWithLabels([]string{txfeestypes.TakerFeeNoSkipRouteMetricName}, 1, []metrics.Label{
 				{
@@ -265,17 +271,20 @@ func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, f
 
 		// Do the swap of this fee token denom to base denom.
 		err = osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(cacheCtx sdk.Context) error {
-			// We allow full slippage. There's not really an effective way to bound slippage until TWAP's land,
-			// but even then the point is a bit moot.
-			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
+			// The minimum amount out is bound by the fee swap max slippage from the spot price of the route.
+			// When it is disabled, we allow full slippage, and the only thing that could be done is a costly griefing
+			// attack to reduce the amount of osmo given as tx fees.
 			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
-			minAmountOut := osmomath.ZeroInt()
+			minAmountOut, err := k.getFeeSwapMinAmountOut(cacheCtx, routes, coin)
+			if err != nil {
+				return err
+			}
 
 			// We swap without charging a taker fee / sending to the non native fee collector, since these are funds that
 			// are accruing from the taker fee itself.
-			amtOutInt, err := k.poolManager.SwapExactAmountInNoTakerFee(cacheCtx, feeCollectorAddress, poolId, coin, denomToSwapTo, minAmountOut)
+			amtOutInt, err := k.swapFeeThroughRoutes(cacheCtx, feeCollectorAddress, routes, coin, minAmountOut)
 			if err != nil {
-				coinsNotSwapped = append(coinsNotSwapped, fmt.Sprintf("%s via pool %v", coin.String(), poolId))
+				coinsNotSwapped = append(coinsNotSwapped, fmt.Sprintf("%s via pools %v", coin.String(), routes.PoolIds()))
 			} else {
 				totalCoinOut = totalCoinOut.Add(sdk.NewCoin(denomToSwapTo, amtOutInt))
 			}
@@ -289,7 +298,7 @@ func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, f
 				},
 				{
 					Name:  "pool_id",
-					Value: strconv.FormatUint(poolId, 10),
+					Value: strconv.FormatUint(routes[0].PoolId, 10),
 				},
 				{
 					Name:  "err",
@@ -22,6 +22,7 @@ type Keeper struct {
 	accountKeeper      types.AccountKeeper
 	bankKeeper         types.BankKeeper
 	poolManager        types.PoolManager
+	twapKeeper         types.TwapKeeper
 	protorevKeeper     types.ProtorevKeeper
 	distributionKeeper types.DistributionKeeper
 	consensusKeeper    types.ConsensusKeeper
@@ -37,6 +38,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	storeKey storetypes.StoreKey,
 	poolManager types.PoolManager,
+	twapKeeper types.TwapKeeper,
 	protorevKeeper types.ProtorevKeeper,
 	distributionKeeper types.DistributionKeeper,
 	consensusKeeper types.ConsensusKeeper,
@@ -53,6 +55,7 @@ func NewKeeper(
 		bankKeeper:         bankKeeper,
 		storeKey:           storeKey,
 		poolManager:        poolManager,
+		twapKeeper:         twapKeeper,
 		protorevKeeper:     protorevKeeper,
 		distributionKeeper: distributionKeeper,
 		consensusKeeper:    consensusKeeper,
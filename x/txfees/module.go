@@ -166,6 +166,8 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock executes all ABCI BeginBlock logic respective to the txfees module.
 func (am AppModule) BeginBlock(context context.Context) error {
 	ctx := sdk.UnwrapSDKContext(context)
+	// Burn the fee burn share of the previous block's tx fees before they are distributed to stakers.
+	am.keeper.BurnFeeCollectorShare(ctx)
 	mempool1559.BeginBlockCode(ctx)
 
 	// Check if the block gas limit has changed.
@@ -179,6 +181,9 @@ func (am AppModule) BeginBlock(context context.Context) error {
 func (am AppModule) EndBlock(context context.Context) error {
 	ctx := sdk.UnwrapSDKContext(context)
 	mempool1559.EndBlockCode(ctx)
+
+	// Adjust the in-protocol dynamic base fee for the next block to this block's gas utilization.
+	am.keeper.UpdateDynamicBaseFee(ctx, int64(ctx.BlockGasMeter().GasConsumed()), am.keeper.GetDynamicBaseFeeTargetGas(ctx))
 	return nil
 }
 
@@ -1,9 +1,19 @@
 package types
 
 import (
+	"time"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // ConsensusMinFee is a governance set parameter from prop 354 (https://www.mintscan.io/osmosis/proposals/354)
 // Its intended to be .0025 uosmo / gas
 var ConsensusMinFee osmomath.Dec = osmomath.NewDecWithPrec(25, 4)
+
+// TxPriorityGasPriceScale is what the gas price of a tx in the base denom is multiplied by to get its mempool priority,
+// so that sub-unit gas prices such as the consensus min fee still yield distinct priorities.
+const TxPriorityGasPriceScale = 1_000_000
+
+// FeePriorityTwapWindow is the window of the TWAP that fees paid in non-base denoms
+// are converted to the base denom at to derive the tx priority.
+const FeePriorityTwapWindow = 5 * time.Minute
@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -52,6 +53,9 @@ type PoolManager interface {
 		quoteAssetDenom string,
 		baseAssetDenom string,
 	) (price osmomath.BigDec, err error)
+	GetCanonicalRoute(ctx sdk.Context, denom, quoteDenom string) ([]poolmanagertypes.SwapAmountInRoute, bool)
+	CanonicalRouteSpotPrice(ctx sdk.Context, denom, quoteDenom string) (osmomath.BigDec, error)
+	CanonicalRouteArithmeticTwapToNow(ctx sdk.Context, denom, quoteDenom string, startTime time.Time) (osmomath.Dec, error)
 	UpdateTakerFeeTrackerForCommunityPoolByDenom(ctx sdk.Context, denom string, increasedAmt osmomath.Int) error
 	UpdateTakerFeeTrackerForStakersByDenom(ctx sdk.Context, denom string, increasedAmt osmomath.Int) error
 	GetAllTakerFeeShareAccumulators(ctx sdk.Context) ([]poolmanagertypes.TakerFeeSkimAccumulator, error)
@@ -80,6 +84,7 @@ type BankKeeper interface {
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
 // TxFeesKeeper defines the expected transaction fee keeper
@@ -89,6 +94,11 @@ type TxFeesKeeper interface {
 	GetFeeToken(ctx sdk.Context, denom string) (FeeToken, error)
 }
 
+// TwapKeeper defines the contract needed to convert fees to the base denom at TWAP prices.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
+
 type ProtorevKeeper interface {
 	GetPoolForDenomPairNoOrder(ctx sdk.Context, baseDenom, denomToMatch string) (uint64, error)
 }
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	// ModuleName defines the module name.
 	ModuleName   = "txfees"
@@ -28,13 +30,32 @@ const (
 	// TakerFeeCollectorName is the module account name for the taker fee collector account address. It collects both native and non-native taker fees.
 	TakerFeeCollectorName = "taker_fee_collector"
 
+	// FeeBurnerName is the module account name that burns the share of tx fees slated for burning at the end of each epoch.
+	// It is the only txfees module account with burner permissions, so that the fee collectors themselves cannot burn funds.
+	FeeBurnerName = "txfees_fee_burner"
+
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
 
 var (
-	BaseDenomKey                       = []byte("base_denom")
-	FeeTokensStorePrefix               = []byte("fee_tokens")
-	KeyTxFeeProtorevTracker            = []byte("txfee_protorev_tracker")
-	KeyTxFeeProtorevTrackerStartHeight = []byte("txfee_protorev_tracker_start_height")
+	BaseDenomKey                           = []byte("base_denom")
+	FeeTokensStorePrefix                   = []byte("fee_tokens")
+	KeyTxFeeProtorevTracker                = []byte("txfee_protorev_tracker")
+	KeyTxFeeProtorevTrackerStartHeight     = []byte("txfee_protorev_tracker_start_height")
+	EpochBurnedFeesPrefix                  = []byte("epoch_burned_fees")
+	DynamicBaseFeeKey                      = []byte("dynamic_base_fee")
+	FeeCollectorBurnedFeesKey              = []byte("fee_collector_burned_fees")
+	FeeCollectorBurnedFeesAtEpochEndPrefix = []byte("fee_collector_burned_fees_at_epoch_end")
 )
+
+// FormatFeeCollectorBurnedFeesAtEpochEndKey returns the store key for the total tx fees burned from the auth fee
+// collector as of the last end of the given epoch identifier.
+func FormatFeeCollectorBurnedFeesAtEpochEndKey(epochIdentifier string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", FeeCollectorBurnedFeesAtEpochEndPrefix, KeySeparator, epochIdentifier))
+}
+
+// FormatEpochBurnedFeesKey returns the store key for the tx fees burned at the end of the given epoch.
+func FormatEpochBurnedFeesKey(epochIdentifier string, epochNumber int64) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%d", EpochBurnedFeesPrefix, KeySeparator, epochIdentifier, KeySeparator, epochNumber))
+}
@@ -1,14 +1,40 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store keys.
 var (
 	KeyWhitelistedFeeTokenSetters = []byte("WhitelistedFeeTokenSetters")
+
+	KeyFeeBurnFraction     = []byte("FeeBurnFraction")
+	DefaultFeeBurnFraction = osmomath.ZeroDec() // 0%
+
+	KeyFeeSwapRoutes     = []byte("FeeSwapRoutes")
+	DefaultFeeSwapRoutes = []FeeSwapRoute{}
+
+	KeyFeeSwapMaxSlippage     = []byte("FeeSwapMaxSlippage")
+	DefaultFeeSwapMaxSlippage = osmomath.ZeroDec() // disabled
+
+	KeyDynamicBaseFeeEnabled     = []byte("DynamicBaseFeeEnabled")
+	DefaultDynamicBaseFeeEnabled = false
+
+	KeyMinDynamicBaseFee     = []byte("MinDynamicBaseFee")
+	DefaultMinDynamicBaseFee = osmomath.MustNewDecFromStr("0.0025")
+
+	KeyMaxDynamicBaseFee     = []byte("MaxDynamicBaseFee")
+	DefaultMaxDynamicBaseFee = osmomath.NewDec(5)
+
+	KeyDynamicBaseFeeMaxChangeRate     = []byte("DynamicBaseFeeMaxChangeRate")
+	DefaultDynamicBaseFeeMaxChangeRate = osmomath.MustNewDecFromStr("0.1")
 )
 
 // ParamTable for txfees module.
@@ -16,16 +42,30 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(whitelistedFeeTokenSetters []string) Params {
+func NewParams(whitelistedFeeTokenSetters []string, feeBurnFraction osmomath.Dec) Params {
 	return Params{
-		WhitelistedFeeTokenSetters: whitelistedFeeTokenSetters,
+		WhitelistedFeeTokenSetters:  whitelistedFeeTokenSetters,
+		FeeBurnFraction:             feeBurnFraction,
+		FeeSwapRoutes:               DefaultFeeSwapRoutes,
+		FeeSwapMaxSlippage:          DefaultFeeSwapMaxSlippage,
+		DynamicBaseFeeEnabled:       DefaultDynamicBaseFeeEnabled,
+		MinDynamicBaseFee:           DefaultMinDynamicBaseFee,
+		MaxDynamicBaseFee:           DefaultMaxDynamicBaseFee,
+		DynamicBaseFeeMaxChangeRate: DefaultDynamicBaseFeeMaxChangeRate,
 	}
 }
 
 // DefaultParams are the default txfees module parameters.
 func DefaultParams() Params {
 	return Params{
-		WhitelistedFeeTokenSetters: []string{},
+		WhitelistedFeeTokenSetters:  []string{},
+		FeeBurnFraction:             DefaultFeeBurnFraction,
+		FeeSwapRoutes:               DefaultFeeSwapRoutes,
+		FeeSwapMaxSlippage:          DefaultFeeSwapMaxSlippage,
+		DynamicBaseFeeEnabled:       DefaultDynamicBaseFeeEnabled,
+		MinDynamicBaseFee:           DefaultMinDynamicBaseFee,
+		MaxDynamicBaseFee:           DefaultMaxDynamicBaseFee,
+		DynamicBaseFeeMaxChangeRate: DefaultDynamicBaseFeeMaxChangeRate,
 	}
 }
 
@@ -35,6 +75,38 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateFeeBurnFraction(p.FeeBurnFraction); err != nil {
+		return err
+	}
+
+	if err := ValidateFeeSwapRoutes(p.FeeSwapRoutes); err != nil {
+		return err
+	}
+
+	if err := ValidateFeeSwapMaxSlippage(p.FeeSwapMaxSlippage); err != nil {
+		return err
+	}
+
+	if err := ValidateDynamicBaseFeeEnabled(p.DynamicBaseFeeEnabled); err != nil {
+		return err
+	}
+
+	if err := ValidateDynamicBaseFee(p.MinDynamicBaseFee); err != nil {
+		return err
+	}
+
+	if err := ValidateDynamicBaseFee(p.MaxDynamicBaseFee); err != nil {
+		return err
+	}
+
+	if p.MinDynamicBaseFee.GT(p.MaxDynamicBaseFee) {
+		return fmt.Errorf("min dynamic base fee %s should not be greater than max dynamic base fee %s", p.MinDynamicBaseFee, p.MaxDynamicBaseFee)
+	}
+
+	if err := ValidateDynamicBaseFeeMaxChangeRate(p.DynamicBaseFeeMaxChangeRate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -42,5 +114,111 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyWhitelistedFeeTokenSetters, &p.WhitelistedFeeTokenSetters, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyFeeBurnFraction, &p.FeeBurnFraction, ValidateFeeBurnFraction),
+		paramtypes.NewParamSetPair(KeyFeeSwapRoutes, &p.FeeSwapRoutes, ValidateFeeSwapRoutes),
+		paramtypes.NewParamSetPair(KeyFeeSwapMaxSlippage, &p.FeeSwapMaxSlippage, ValidateFeeSwapMaxSlippage),
+		paramtypes.NewParamSetPair(KeyDynamicBaseFeeEnabled, &p.DynamicBaseFeeEnabled, ValidateDynamicBaseFeeEnabled),
+		paramtypes.NewParamSetPair(KeyMinDynamicBaseFee, &p.MinDynamicBaseFee, ValidateDynamicBaseFee),
+		paramtypes.NewParamSetPair(KeyMaxDynamicBaseFee, &p.MaxDynamicBaseFee, ValidateDynamicBaseFee),
+		paramtypes.NewParamSetPair(KeyDynamicBaseFeeMaxChangeRate, &p.DynamicBaseFeeMaxChangeRate, ValidateDynamicBaseFeeMaxChangeRate),
+	}
+}
+
+// ValidateFeeBurnFraction validates that the fee burn fraction is between 0 and 1 inclusive.
+func ValidateFeeBurnFraction(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(osmomath.OneDec()) {
+		return fmt.Errorf("fee burn fraction should be between 0 and 1: %s", v)
 	}
+
+	return nil
+}
+
+// ValidateFeeSwapRoutes validates that every fee swap route has a valid denom and a non-empty route
+// through valid pools, and that no denom has more than one route.
+func ValidateFeeSwapRoutes(i interface{}) error {
+	feeSwapRoutes, ok := i.([]FeeSwapRoute)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]struct{}, len(feeSwapRoutes))
+	for _, feeSwapRoute := range feeSwapRoutes {
+		if err := sdk.ValidateDenom(feeSwapRoute.Denom); err != nil {
+			return err
+		}
+		if _, ok := seenDenoms[feeSwapRoute.Denom]; ok {
+			return fmt.Errorf("duplicate fee swap route for denom %s", feeSwapRoute.Denom)
+		}
+		seenDenoms[feeSwapRoute.Denom] = struct{}{}
+
+		routes := poolmanagertypes.SwapAmountInRoutes(feeSwapRoute.Routes)
+		if err := routes.Validate(); err != nil {
+			return fmt.Errorf("invalid fee swap route for denom %s: %w", feeSwapRoute.Denom, err)
+		}
+		for _, route := range routes {
+			if route.PoolId == 0 {
+				return fmt.Errorf("invalid fee swap route for denom %s: pool id must be positive", feeSwapRoute.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateFeeSwapMaxSlippage validates that the fee swap max slippage is between 0 and 1 exclusive.
+func ValidateFeeSwapMaxSlippage(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("fee swap max slippage should be between 0 inclusive and 1 exclusive: %s", v)
+	}
+
+	return nil
+}
+
+// ValidateDynamicBaseFeeEnabled validates the dynamic base fee enabled param type.
+func ValidateDynamicBaseFeeEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// ValidateDynamicBaseFee validates that a dynamic base fee bound is positive.
+// The min dynamic base fee must be positive, since the base fee is adjusted multiplicatively and could
+// never increase from zero.
+func ValidateDynamicBaseFee(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("dynamic base fee bound should be positive: %s", v)
+	}
+
+	return nil
+}
+
+// ValidateDynamicBaseFeeMaxChangeRate validates that the dynamic base fee max change rate is between 0 exclusive and 1 exclusive.
+func ValidateDynamicBaseFeeMaxChangeRate(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("dynamic base fee max change rate should be between 0 and 1 exclusive: %s", v)
+	}
+
+	return nil
 }
@@ -4,9 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -26,6 +28,32 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the txfees module
 type Params struct {
 	WhitelistedFeeTokenSetters []string `protobuf:"bytes,1,rep,name=whitelisted_fee_token_setters,json=whitelistedFeeTokenSetters,proto3" json:"whitelisted_fee_token_setters,omitempty" yaml:"whitelisted_fee_token_setters"`
+	// fee_burn_fraction is the fraction of the tx fees swapped into the base
+	// denom at the end of each epoch that is burned instead of being sent to
+	// the fee collector for stakers.
+	FeeBurnFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_fraction" yaml:"fee_burn_fraction"`
+	// fee_swap_routes override, per fee token denom, the route the fee token is
+	// swapped through at the end of each epoch. Fee tokens without a route
+	// override are swapped through the pool protorev knows for the denom pair.
+	FeeSwapRoutes []FeeSwapRoute `protobuf:"bytes,3,rep,name=fee_swap_routes,json=feeSwapRoutes,proto3" json:"fee_swap_routes" yaml:"fee_swap_routes"`
+	// fee_swap_max_slippage is the maximum fraction the amount out of a fee
+	// token swap at the end of each epoch can be below the amount out at the
+	// spot price of its route. Swaps exceeding it fail, leaving the fee token in
+	// its collector until the next epoch. Zero disables the guard.
+	FeeSwapMaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee_swap_max_slippage,json=feeSwapMaxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_swap_max_slippage" yaml:"fee_swap_max_slippage"`
+	// dynamic_base_fee_enabled enables the in-protocol base fee, a minimum gas
+	// price enforced on every tx, including in block execution, that adjusts
+	// every block to the gas used by the previous block versus the target gas.
+	DynamicBaseFeeEnabled bool `protobuf:"varint,5,opt,name=dynamic_base_fee_enabled,json=dynamicBaseFeeEnabled,proto3" json:"dynamic_base_fee_enabled,omitempty" yaml:"dynamic_base_fee_enabled"`
+	// min_dynamic_base_fee is the lowest the dynamic base fee can go, and the
+	// base fee it starts from once enabled.
+	MinDynamicBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_dynamic_base_fee,json=minDynamicBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_dynamic_base_fee" yaml:"min_dynamic_base_fee"`
+	// max_dynamic_base_fee is the highest the dynamic base fee can go.
+	MaxDynamicBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_dynamic_base_fee,json=maxDynamicBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_dynamic_base_fee" yaml:"max_dynamic_base_fee"`
+	// dynamic_base_fee_max_change_rate is the fraction the dynamic base fee
+	// changes by when the previous block used twice, or none of, the target
+	// gas.
+	DynamicBaseFeeMaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=dynamic_base_fee_max_change_rate,json=dynamicBaseFeeMaxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dynamic_base_fee_max_change_rate" yaml:"dynamic_base_fee_max_change_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,8 +96,77 @@ func (m *Params) GetWhitelistedFeeTokenSetters() []string {
 	return nil
 }
 
+func (m *Params) GetFeeSwapRoutes() []FeeSwapRoute {
+	if m != nil {
+		return m.FeeSwapRoutes
+	}
+	return nil
+}
+
+func (m *Params) GetDynamicBaseFeeEnabled() bool {
+	if m != nil {
+		return m.DynamicBaseFeeEnabled
+	}
+	return false
+}
+
+// FeeSwapRoute is the route a fee token is swapped through at the end of each
+// epoch.
+type FeeSwapRoute struct {
+	Denom  string                    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Routes []types.SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *FeeSwapRoute) Reset()         { *m = FeeSwapRoute{} }
+func (m *FeeSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*FeeSwapRoute) ProtoMessage()    {}
+func (*FeeSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{1}
+}
+func (m *FeeSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSwapRoute.Merge(m, src)
+}
+func (m *FeeSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSwapRoute proto.InternalMessageInfo
+
+func (m *FeeSwapRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeSwapRoute) GetRoutes() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+	proto.RegisterType((*FeeSwapRoute)(nil), "osmosis.txfees.v1beta1.FeeSwapRoute")
 }
 
 func init() {
@@ -77,22 +174,46 @@ func init() {
 }
 
 var fileDescriptor_fcbfbe8e37bb08e6 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x2a, 0xd2, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0x95, 0x2a, 0xb9, 0xd8, 0x02, 0xc0, 0xba, 0x85, 0xf2, 0xb9,
-	0x64, 0xcb, 0x33, 0x32, 0x4b, 0x52, 0x73, 0x32, 0x8b, 0x4b, 0x52, 0x53, 0xe2, 0xd3, 0x52, 0x53,
-	0xe3, 0x4b, 0xf2, 0xb3, 0x53, 0xf3, 0xe2, 0x8b, 0x53, 0x4b, 0x4a, 0x52, 0x8b, 0x8a, 0x25, 0x18,
-	0x15, 0x98, 0x35, 0x38, 0x9d, 0x74, 0x4e, 0xdc, 0x93, 0x67, 0xf8, 0x74, 0x4f, 0x5e, 0xa5, 0x32,
-	0x31, 0x37, 0xc7, 0x4a, 0x09, 0xaf, 0x16, 0xa5, 0x20, 0x29, 0x24, 0x79, 0xb7, 0xd4, 0xd4, 0x10,
-	0x90, 0x6c, 0x30, 0x44, 0xd2, 0xc9, 0xe7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x8c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0xbe, 0xd1,
-	0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0xcc, 0xf4, 0x2b, 0x60, 0xa1, 0x50, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x8f, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xe6,
-	0x6d, 0xc0, 0x24, 0x01, 0x00, 0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x4e, 0xdb, 0x40,
+	0x10, 0x8d, 0xa1, 0x04, 0x70, 0x41, 0x2d, 0x16, 0x20, 0x0b, 0x5a, 0x3b, 0x32, 0xa8, 0xcd, 0x81,
+	0xda, 0x82, 0x4a, 0x3d, 0xf4, 0x52, 0xd5, 0xd0, 0x48, 0x95, 0xa0, 0xaa, 0x4c, 0x4f, 0x55, 0x2b,
+	0x6b, 0xec, 0x4c, 0x1c, 0x0b, 0x7b, 0xd7, 0xf2, 0x6e, 0xc0, 0xf9, 0x8b, 0x5e, 0xf8, 0x8b, 0x7e,
+	0x08, 0x47, 0x8e, 0x55, 0x0f, 0x51, 0x05, 0x7f, 0x90, 0x2f, 0xa8, 0x6c, 0x6f, 0x82, 0x09, 0x50,
+	0x45, 0xbd, 0x25, 0xf3, 0xde, 0xbc, 0x37, 0x33, 0x7e, 0x5a, 0x79, 0x8b, 0xb2, 0x98, 0xb2, 0x90,
+	0x59, 0x3c, 0xeb, 0x20, 0x32, 0xeb, 0x74, 0xd7, 0x43, 0x0e, 0xbb, 0x56, 0x02, 0x29, 0xc4, 0xcc,
+	0x4c, 0x52, 0xca, 0xa9, 0xb2, 0x2e, 0x48, 0x66, 0x49, 0x32, 0x05, 0x69, 0x63, 0x35, 0xa0, 0x01,
+	0x2d, 0x28, 0x56, 0xfe, 0xab, 0x64, 0x6f, 0xec, 0x8c, 0x24, 0x13, 0x4a, 0xa3, 0x18, 0x08, 0x04,
+	0x98, 0x8e, 0x75, 0xd9, 0x19, 0x24, 0x6e, 0x4a, 0x7b, 0x1c, 0x4b, 0xb6, 0xf1, 0x73, 0x5e, 0xae,
+	0x7f, 0x2e, 0xcc, 0x14, 0x2a, 0x3f, 0x3f, 0xeb, 0x86, 0x1c, 0xa3, 0x90, 0x71, 0x6c, 0xbb, 0x1d,
+	0x44, 0x97, 0xd3, 0x13, 0x24, 0x2e, 0x43, 0xce, 0x31, 0x65, 0xaa, 0xd4, 0x98, 0x6d, 0x2e, 0xda,
+	0x3b, 0x17, 0x03, 0xbd, 0x36, 0x1c, 0xe8, 0xdb, 0x7d, 0x88, 0xa3, 0xb7, 0xc6, 0x3f, 0x5b, 0x0c,
+	0x67, 0xa3, 0x82, 0xb7, 0x10, 0xbf, 0xe4, 0xe8, 0x71, 0x09, 0x2a, 0x27, 0xf2, 0x4a, 0xde, 0xe1,
+	0xf5, 0x52, 0xe2, 0x76, 0x52, 0xf0, 0x79, 0x48, 0x89, 0x3a, 0xd3, 0x90, 0x9a, 0x8b, 0xf6, 0xbb,
+	0xdc, 0xe4, 0xf7, 0x40, 0xdf, 0xf4, 0x8b, 0x6d, 0x58, 0xfb, 0xc4, 0x0c, 0xa9, 0x15, 0x03, 0xef,
+	0x9a, 0x87, 0x18, 0x80, 0xdf, 0x3f, 0x40, 0x7f, 0x38, 0xd0, 0xd5, 0x72, 0x86, 0x3b, 0x2a, 0x86,
+	0xf3, 0xa4, 0x83, 0x68, 0xf7, 0x52, 0xd2, 0x12, 0x15, 0x25, 0x92, 0xf3, 0x92, 0x7b, 0x73, 0x00,
+	0xa6, 0xce, 0x36, 0x66, 0x9b, 0x8f, 0xf7, 0xb6, 0xcd, 0xfb, 0xcf, 0x6b, 0xb6, 0x10, 0x8f, 0xcf,
+	0x20, 0x71, 0x72, 0xb2, 0xad, 0x89, 0xad, 0xd7, 0x6f, 0x1c, 0x2b, 0x52, 0x86, 0xb3, 0xdc, 0xa9,
+	0xb0, 0x99, 0x72, 0x2a, 0xaf, 0x8d, 0x29, 0x31, 0x64, 0x2e, 0x8b, 0xc2, 0x24, 0x81, 0x00, 0xd5,
+	0x47, 0xc5, 0x7a, 0xfb, 0xd3, 0xad, 0xf7, 0x6c, 0xc2, 0xac, 0xaa, 0x64, 0x38, 0x8a, 0xb0, 0x3c,
+	0x82, 0xec, 0x58, 0x14, 0x95, 0x6f, 0xb2, 0xda, 0xee, 0x13, 0x88, 0x43, 0xdf, 0xf5, 0x80, 0x61,
+	0xf1, 0x45, 0x90, 0x80, 0x17, 0x61, 0x5b, 0x9d, 0x6b, 0x48, 0xcd, 0x05, 0x7b, 0x6b, 0x38, 0xd0,
+	0xf5, 0x52, 0xf7, 0x21, 0xa6, 0xe1, 0xac, 0x09, 0xc8, 0x06, 0x86, 0x2d, 0xc4, 0x0f, 0x65, 0x5d,
+	0x61, 0xf2, 0x6a, 0x1c, 0x12, 0x77, 0xb2, 0x4f, 0xad, 0x17, 0x4b, 0xd9, 0xd3, 0x2d, 0xb5, 0x59,
+	0x9a, 0xdf, 0x27, 0x64, 0x38, 0x2b, 0x71, 0x48, 0x0e, 0x6e, 0x79, 0x17, 0xa6, 0x90, 0xdd, 0x35,
+	0x9d, 0xff, 0x1f, 0x53, 0xc8, 0xee, 0x35, 0x85, 0x6c, 0xc2, 0xf4, 0x5c, 0x92, 0x1b, 0x77, 0xce,
+	0x93, 0x77, 0xfb, 0x5d, 0x20, 0x01, 0xba, 0x29, 0x70, 0x54, 0x17, 0x8a, 0x09, 0x3e, 0x4d, 0x37,
+	0xc1, 0xcb, 0x07, 0x6e, 0x3e, 0x21, 0x6a, 0x38, 0x9b, 0xb7, 0x6f, 0x7f, 0x04, 0xd9, 0x7e, 0x01,
+	0x3b, 0x39, 0x7a, 0x2e, 0xc9, 0x4b, 0xd5, 0x5c, 0x2a, 0x2f, 0xe4, 0xb9, 0x36, 0x12, 0x1a, 0xab,
+	0x52, 0x31, 0xcc, 0xd3, 0xe1, 0x40, 0x5f, 0x12, 0x4e, 0x79, 0xd9, 0x70, 0x4a, 0x58, 0xf9, 0x2e,
+	0xd7, 0x45, 0xea, 0x67, 0x8a, 0xd4, 0x9b, 0xe3, 0xd4, 0x57, 0x9e, 0x89, 0x71, 0xf4, 0x73, 0xfd,
+	0xf7, 0x31, 0xed, 0x11, 0xfe, 0x91, 0x94, 0xf9, 0x5f, 0x13, 0xf9, 0x5f, 0x2e, 0xc5, 0x47, 0xb1,
+	0x17, 0xa2, 0xf6, 0xe1, 0xc5, 0x95, 0x26, 0x5d, 0x5e, 0x69, 0xd2, 0x9f, 0x2b, 0x4d, 0xfa, 0x71,
+	0xad, 0xd5, 0x2e, 0xaf, 0xb5, 0xda, 0xaf, 0x6b, 0xad, 0xf6, 0x75, 0x2f, 0x08, 0x79, 0xb7, 0xe7,
+	0x99, 0x3e, 0x8d, 0x2d, 0x61, 0xf9, 0x2a, 0x02, 0x8f, 0x8d, 0xfe, 0x58, 0xa7, 0x7b, 0x6f, 0xac,
+	0x6c, 0xf4, 0xfe, 0xf1, 0x7e, 0x82, 0xcc, 0xab, 0x17, 0x6f, 0xd3, 0xeb, 0xbf, 0x03, 0x00, 0xcb,
+	0xc8, 0x7d, 0xce, 0x1e, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +236,80 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DynamicBaseFeeMaxChangeRate.Size()
+		i -= size
+		if _, err := m.DynamicBaseFeeMaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxDynamicBaseFee.Size()
+		i -= size
+		if _, err := m.MaxDynamicBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinDynamicBaseFee.Size()
+		i -= size
+		if _, err := m.MinDynamicBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.DynamicBaseFeeEnabled {
+		i--
+		if m.DynamicBaseFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.FeeSwapMaxSlippage.Size()
+		i -= size
+		if _, err := m.FeeSwapMaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.FeeSwapRoutes) > 0 {
+		for iNdEx := len(m.FeeSwapRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSwapRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.FeeBurnFraction.Size()
+		i -= size
+		if _, err := m.FeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.WhitelistedFeeTokenSetters) > 0 {
 		for iNdEx := len(m.WhitelistedFeeTokenSetters) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.WhitelistedFeeTokenSetters[iNdEx])
@@ -127,6 +322,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -150,6 +389,44 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.FeeBurnFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.FeeSwapRoutes) > 0 {
+		for _, e := range m.FeeSwapRoutes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FeeSwapMaxSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicBaseFeeEnabled {
+		n += 2
+	}
+	l = m.MinDynamicBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxDynamicBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DynamicBaseFeeMaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -220,6 +497,346 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.WhitelistedFeeTokenSetters = append(m.WhitelistedFeeTokenSetters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSwapRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSwapRoutes = append(m.FeeSwapRoutes, FeeSwapRoute{})
+			if err := m.FeeSwapRoutes[len(m.FeeSwapRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSwapMaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSwapMaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBaseFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicBaseFeeEnabled = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDynamicBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDynamicBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDynamicBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDynamicBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBaseFeeMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicBaseFeeMaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
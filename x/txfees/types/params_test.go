@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v26/x/txfees/types"
+)
+
+func TestValidateFeeSwapRoutes(t *testing.T) {
+	validRoutes := []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}}
+
+	tests := map[string]struct {
+		feeSwapRoutes []types.FeeSwapRoute
+		expectErr     bool
+	}{
+		"valid": {
+			feeSwapRoutes: []types.FeeSwapRoute{{Denom: "foo", Routes: validRoutes}, {Denom: "bar", Routes: validRoutes}},
+		},
+		"invalid denom": {
+			feeSwapRoutes: []types.FeeSwapRoute{{Denom: "1", Routes: validRoutes}},
+			expectErr:     true,
+		},
+		"duplicate denom": {
+			feeSwapRoutes: []types.FeeSwapRoute{{Denom: "foo", Routes: validRoutes}, {Denom: "foo", Routes: validRoutes}},
+			expectErr:     true,
+		},
+		"empty route": {
+			feeSwapRoutes: []types.FeeSwapRoute{{Denom: "foo"}},
+			expectErr:     true,
+		},
+		"zero pool id": {
+			feeSwapRoutes: []types.FeeSwapRoute{{Denom: "foo", Routes: []poolmanagertypes.SwapAmountInRoute{{TokenOutDenom: "uosmo"}}}},
+			expectErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateFeeSwapRoutes(tc.feeSwapRoutes)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
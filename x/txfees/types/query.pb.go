@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -445,6 +446,177 @@ func (m *QueryEipBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEipBaseFeeResponse proto.InternalMessageInfo
 
+// QueryEpochBurnedFeesRequest defines grpc request structure for querying the
+// tx fees burned at the end of an epoch.
+type QueryEpochBurnedFeesRequest struct {
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+	EpochNumber     int64  `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+}
+
+func (m *QueryEpochBurnedFeesRequest) Reset()         { *m = QueryEpochBurnedFeesRequest{} }
+func (m *QueryEpochBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryEpochBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
+}
+func (m *QueryEpochBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryEpochBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochBurnedFeesRequest proto.InternalMessageInfo
+
+func (m *QueryEpochBurnedFeesRequest) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *QueryEpochBurnedFeesRequest) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+type QueryEpochBurnedFeesResponse struct {
+	BurnedFees types.Coin `protobuf:"bytes,1,opt,name=burned_fees,json=burnedFees,proto3" json:"burned_fees" yaml:"burned_fees"`
+}
+
+func (m *QueryEpochBurnedFeesResponse) Reset()         { *m = QueryEpochBurnedFeesResponse{} }
+func (m *QueryEpochBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryEpochBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryEpochBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryEpochBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochBurnedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryEpochBurnedFeesResponse) GetBurnedFees() types.Coin {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return types.Coin{}
+}
+
+type QueryDynamicBaseFeeRequest struct {
+}
+
+func (m *QueryDynamicBaseFeeRequest) Reset()         { *m = QueryDynamicBaseFeeRequest{} }
+func (m *QueryDynamicBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDynamicBaseFeeRequest) ProtoMessage()    {}
+func (*QueryDynamicBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{12}
+}
+func (m *QueryDynamicBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDynamicBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDynamicBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDynamicBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDynamicBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryDynamicBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDynamicBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDynamicBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDynamicBaseFeeRequest proto.InternalMessageInfo
+
+type QueryDynamicBaseFeeResponse struct {
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee" yaml:"base_fee"`
+}
+
+func (m *QueryDynamicBaseFeeResponse) Reset()         { *m = QueryDynamicBaseFeeResponse{} }
+func (m *QueryDynamicBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDynamicBaseFeeResponse) ProtoMessage()    {}
+func (*QueryDynamicBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{13}
+}
+func (m *QueryDynamicBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDynamicBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDynamicBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDynamicBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDynamicBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryDynamicBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDynamicBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDynamicBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDynamicBaseFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -456,6 +628,10 @@ func init() {
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
 	proto.RegisterType((*QueryEipBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeRequest")
 	proto.RegisterType((*QueryEipBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeResponse")
+	proto.RegisterType((*QueryEpochBurnedFeesRequest)(nil), "osmosis.txfees.v1beta1.QueryEpochBurnedFeesRequest")
+	proto.RegisterType((*QueryEpochBurnedFeesResponse)(nil), "osmosis.txfees.v1beta1.QueryEpochBurnedFeesResponse")
+	proto.RegisterType((*QueryDynamicBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryDynamicBaseFeeRequest")
+	proto.RegisterType((*QueryDynamicBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryDynamicBaseFeeResponse")
 }
 
 func init() {
@@ -463,51 +639,65 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x4e, 0x13, 0x5d,
-	0x14, 0xee, 0xf0, 0xff, 0xa0, 0xbd, 0x28, 0xea, 0x8d, 0x40, 0x1d, 0xcc, 0x94, 0xdc, 0xa8, 0x21,
-	0x98, 0xce, 0x85, 0xa2, 0xc4, 0xb8, 0xb3, 0xa9, 0x18, 0x13, 0x62, 0x60, 0x34, 0x31, 0x61, 0x33,
-	0x99, 0x69, 0xcf, 0x94, 0x09, 0x6d, 0xef, 0xd0, 0x7b, 0x4b, 0x68, 0x8c, 0x1b, 0x9f, 0xc0, 0xc4,
-	0xc4, 0x07, 0x60, 0xe3, 0xce, 0xbd, 0x6f, 0xc0, 0x92, 0xc4, 0x8d, 0x71, 0xd1, 0x18, 0xf0, 0x09,
-	0x78, 0x02, 0x33, 0x77, 0xee, 0x74, 0x0a, 0x74, 0x68, 0xd9, 0x75, 0xe6, 0x9c, 0xf3, 0x7d, 0xdf,
-	0x99, 0xf3, 0x7d, 0x29, 0x22, 0x8c, 0x37, 0x18, 0xf7, 0x39, 0x15, 0xfb, 0x1e, 0x00, 0xa7, 0x7b,
-	0xcb, 0x2e, 0x08, 0x67, 0x99, 0xee, 0xb6, 0xa1, 0xd5, 0x31, 0x83, 0x16, 0x13, 0x0c, 0xcf, 0xa8,
-	0x1e, 0x33, 0xea, 0x31, 0x55, 0x8f, 0x7e, 0xb7, 0xc6, 0x6a, 0x4c, 0xb6, 0xd0, 0xf0, 0x57, 0xd4,
-	0xad, 0xdf, 0xaf, 0x31, 0x56, 0xab, 0x03, 0x75, 0x02, 0x9f, 0x3a, 0xcd, 0x26, 0x13, 0x8e, 0xf0,
-	0x59, 0x93, 0xab, 0xaa, 0xa1, 0xaa, 0xf2, 0xc9, 0x6d, 0x7b, 0xb4, 0xda, 0x6e, 0xc9, 0x06, 0x55,
-	0x7f, 0x98, 0xa2, 0xc7, 0x03, 0x10, 0x6c, 0x07, 0x54, 0x1b, 0x99, 0x45, 0xd3, 0x9b, 0xa1, 0xc2,
-	0x35, 0x80, 0x77, 0xe1, 0x6b, 0x6e, 0xc1, 0x6e, 0x1b, 0xb8, 0x20, 0x02, 0xcd, 0x9c, 0x2f, 0xf0,
-	0x80, 0x35, 0x39, 0xe0, 0x2d, 0x84, 0x3c, 0x00, 0x5b, 0xa2, 0xf0, 0x9c, 0x36, 0xff, 0xdf, 0xc2,
-	0x64, 0x71, 0xde, 0x1c, 0xbc, 0x9a, 0x19, 0x8f, 0x97, 0xee, 0x1d, 0x76, 0xf3, 0x99, 0xd3, 0x6e,
-	0xfe, 0x4e, 0xc7, 0x69, 0xd4, 0x9f, 0x93, 0x04, 0x81, 0x58, 0x59, 0x2f, 0xe6, 0x20, 0x65, 0xa4,
-	0x4b, 0xd6, 0x32, 0x34, 0x59, 0xe3, 0x6d, 0xc0, 0xc4, 0x46, 0xcb, 0xaf, 0x80, 0xd2, 0x84, 0x1f,
-	0xa1, 0xf1, 0x6a, 0x58, 0xc8, 0x69, 0xf3, 0xda, 0x42, 0xb6, 0x74, 0xfb, 0xb4, 0x9b, 0xbf, 0x11,
-	0xc1, 0xc9, 0xd7, 0xc4, 0x8a, 0xca, 0xe4, 0x40, 0x43, 0x73, 0x03, 0x61, 0xd4, 0x06, 0x8b, 0x68,
-	0x22, 0x60, 0xac, 0xfe, 0xba, 0x2c, 0x81, 0xfe, 0x2f, 0xe1, 0xd3, 0x6e, 0x7e, 0x2a, 0x02, 0x0a,
-	0xdf, 0xdb, 0x7e, 0x95, 0x58, 0xaa, 0x03, 0xbf, 0x47, 0x88, 0x07, 0x4c, 0xd8, 0x41, 0x88, 0x90,
-	0x1b, 0x93, 0xc4, 0xcf, 0xc2, 0x5d, 0x7e, 0x77, 0xf3, 0x73, 0x15, 0xb9, 0x35, 0xaf, 0xee, 0x98,
-	0x3e, 0xa3, 0x0d, 0x47, 0x6c, 0x9b, 0xeb, 0x50, 0x73, 0x2a, 0x9d, 0x32, 0x54, 0x92, 0x55, 0x93,
-	0x71, 0x62, 0x65, 0x79, 0x2c, 0x86, 0xbc, 0x40, 0xb3, 0x89, 0xc6, 0x8d, 0x90, 0xac, 0x7a, 0xd5,
-	0x3d, 0xd7, 0x50, 0xee, 0x22, 0xc4, 0xd5, 0x77, 0xec, 0x99, 0xa0, 0xe4, 0x70, 0x90, 0x58, 0xb1,
-	0x09, 0xde, 0x28, 0x13, 0xf4, 0x15, 0x14, 0xfc, 0x13, 0x84, 0x5c, 0x87, 0x83, 0xdd, 0xaf, 0x73,
-	0x3a, 0xd9, 0x39, 0xa9, 0x11, 0x2b, 0xeb, 0xc6, 0xd3, 0x24, 0xa7, 0xf0, 0x5e, 0xfa, 0x41, 0x08,
-	0xb9, 0x06, 0xf1, 0x69, 0x49, 0x5d, 0x7d, 0x8d, 0xfe, 0x8a, 0xa2, 0xda, 0x44, 0xd7, 0x25, 0x9c,
-	0x07, 0xa0, 0x88, 0x56, 0x47, 0xfb, 0xfe, 0xb7, 0xfa, 0xb4, 0x78, 0x00, 0xc4, 0xba, 0xe6, 0x46,
-	0xd0, 0xc5, 0x1f, 0x13, 0x68, 0x5c, 0xd2, 0xe1, 0xaf, 0x1a, 0xca, 0xf6, 0x2c, 0x8e, 0x0b, 0x69,
-	0x36, 0x1e, 0x98, 0x11, 0xdd, 0x1c, 0xb5, 0x3d, 0xda, 0x84, 0x2c, 0x7e, 0xfa, 0xf9, 0xf7, 0xcb,
-	0xd8, 0x03, 0x4c, 0x68, 0x7a, 0x38, 0x55, 0x2a, 0xf0, 0x77, 0x0d, 0x4d, 0x9d, 0xb5, 0x2f, 0x2e,
-	0x5e, 0x4a, 0x37, 0x30, 0x32, 0xfa, 0xca, 0x95, 0x66, 0x94, 0xce, 0x15, 0xa9, 0xb3, 0x80, 0x1f,
-	0xa7, 0xe9, 0x4c, 0x2c, 0x6d, 0xbb, 0x9d, 0xe8, 0xce, 0xf8, 0x9b, 0x86, 0x26, 0xfb, 0x8c, 0x88,
-	0xe9, 0x70, 0xe6, 0x33, 0xae, 0xd7, 0x97, 0x46, 0x1f, 0x50, 0x3a, 0x9f, 0x4a, 0x9d, 0x14, 0x17,
-	0xd2, 0x74, 0x4a, 0x65, 0xb6, 0xf2, 0x3b, 0xfd, 0x20, 0x1f, 0x3f, 0xca, 0x9b, 0xf7, 0x1c, 0x3d,
-	0xe4, 0xe6, 0xe7, 0x23, 0x31, 0xe4, 0xe6, 0x17, 0x82, 0x32, 0xfc, 0xe6, 0x49, 0x54, 0xf0, 0x81,
-	0x86, 0x6e, 0xbe, 0x02, 0x91, 0x64, 0x00, 0x5f, 0xce, 0x76, 0x21, 0x46, 0x3a, 0x1d, 0xb9, 0x5f,
-	0xc9, 0x5b, 0x92, 0xf2, 0x16, 0xf1, 0x42, 0x9a, 0xbc, 0x4a, 0xbb, 0x65, 0x83, 0x1f, 0xd8, 0x71,
-	0x8a, 0x4a, 0xeb, 0x87, 0xc7, 0x86, 0x76, 0x74, 0x6c, 0x68, 0x7f, 0x8e, 0x0d, 0xed, 0xf3, 0x89,
-	0x91, 0x39, 0x3a, 0x31, 0x32, 0xbf, 0x4e, 0x8c, 0xcc, 0x56, 0xb1, 0xe6, 0x8b, 0xed, 0xb6, 0x6b,
-	0x56, 0x58, 0x23, 0x46, 0x2b, 0xd4, 0x1d, 0x97, 0xf7, 0xa0, 0xf7, 0x8a, 0xab, 0x74, 0x3f, 0x26,
-	0x10, 0x9d, 0x00, 0xb8, 0x3b, 0x21, 0xff, 0x86, 0x56, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1c,
-	0x5b, 0x5a, 0x44, 0x3f, 0x07, 0x00, 0x00,
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xb6, 0xb4, 0xe0, 0x71, 0x69, 0xca, 0x40, 0x63, 0x77, 0x13, 0xd9, 0xd1, 0x08, 0x50,
+	0x14, 0x94, 0x9d, 0xd6, 0x29, 0x15, 0xea, 0x0d, 0xe3, 0x1a, 0x55, 0x94, 0xaa, 0xdd, 0x22, 0x90,
+	0x7a, 0x59, 0xed, 0xae, 0xdf, 0x3a, 0xab, 0xda, 0x3b, 0xdb, 0x9d, 0xdd, 0xa8, 0x56, 0x94, 0x0b,
+	0xbf, 0x00, 0x09, 0x89, 0x3b, 0xbd, 0x70, 0x40, 0xe2, 0x0f, 0xf0, 0x07, 0x7a, 0x0c, 0xe2, 0x82,
+	0x38, 0x58, 0x28, 0xe1, 0x17, 0xf8, 0x17, 0xa0, 0x9d, 0x99, 0xdd, 0x75, 0x9c, 0x75, 0x62, 0x1f,
+	0xb8, 0x79, 0xe7, 0xbd, 0xf7, 0x7d, 0xdf, 0x9b, 0x37, 0xef, 0x93, 0x11, 0x61, 0x7c, 0xc8, 0xb8,
+	0xcf, 0x69, 0xfc, 0xca, 0x03, 0xe0, 0x74, 0xff, 0x8e, 0x03, 0xb1, 0x7d, 0x87, 0xbe, 0x4c, 0x20,
+	0x1a, 0x19, 0x61, 0xc4, 0x62, 0x86, 0xd7, 0x54, 0x8e, 0x21, 0x73, 0x0c, 0x95, 0xa3, 0x7f, 0xd0,
+	0x67, 0x7d, 0x26, 0x52, 0x68, 0xfa, 0x4b, 0x66, 0xeb, 0x1b, 0x7d, 0xc6, 0xfa, 0x03, 0xa0, 0x76,
+	0xe8, 0x53, 0x3b, 0x08, 0x58, 0x6c, 0xc7, 0x3e, 0x0b, 0xb8, 0x8a, 0x36, 0x54, 0x54, 0x7c, 0x39,
+	0x89, 0x47, 0x7b, 0x49, 0x24, 0x12, 0xb2, 0xb8, 0x2b, 0xc8, 0xa8, 0x63, 0x73, 0xc8, 0xc5, 0xb8,
+	0xcc, 0xcf, 0xe2, 0x1f, 0xcd, 0xd1, 0xeb, 0x01, 0xc4, 0xec, 0x05, 0xa8, 0x34, 0x52, 0x43, 0x37,
+	0x9f, 0xa6, 0x1d, 0x74, 0x01, 0xbe, 0x49, 0x8f, 0xb9, 0x09, 0x2f, 0x13, 0xe0, 0x31, 0x89, 0xd1,
+	0xda, 0x6c, 0x80, 0x87, 0x2c, 0xe0, 0x80, 0x9f, 0x23, 0xe4, 0x01, 0x58, 0x02, 0x85, 0xd7, 0xb5,
+	0xcd, 0xcb, 0x5b, 0xd5, 0xd6, 0xa6, 0x51, 0xde, 0xba, 0x91, 0x95, 0xb7, 0x6f, 0xbd, 0x19, 0x37,
+	0x57, 0x26, 0xe3, 0xe6, 0x7b, 0x23, 0x7b, 0x38, 0xb8, 0x4f, 0x0a, 0x04, 0x62, 0x56, 0xbc, 0x8c,
+	0x83, 0x74, 0x90, 0x2e, 0x58, 0x3b, 0x10, 0xb0, 0xe1, 0xb3, 0x90, 0xc5, 0x4f, 0x22, 0xdf, 0x05,
+	0xa5, 0x09, 0x7f, 0x8c, 0xae, 0xf4, 0xd2, 0x40, 0x5d, 0xdb, 0xd4, 0xb6, 0x2a, 0xed, 0x1b, 0x93,
+	0x71, 0xf3, 0x9a, 0x84, 0x13, 0xc7, 0xc4, 0x94, 0x61, 0xf2, 0x5a, 0x43, 0xeb, 0xa5, 0x30, 0xaa,
+	0x83, 0x6d, 0x74, 0x35, 0x64, 0x6c, 0xf0, 0xb0, 0x23, 0x80, 0xde, 0x6a, 0xe3, 0xc9, 0xb8, 0x79,
+	0x5d, 0x02, 0xa5, 0xe7, 0x96, 0xdf, 0x23, 0xa6, 0xca, 0xc0, 0xdf, 0x21, 0xc4, 0x43, 0x16, 0x5b,
+	0x61, 0x8a, 0x50, 0xbf, 0x24, 0x88, 0x3f, 0x4b, 0x7b, 0xf9, 0x7b, 0xdc, 0x5c, 0x97, 0x33, 0xe0,
+	0xbd, 0x17, 0x86, 0xcf, 0xe8, 0xd0, 0x8e, 0xf7, 0x8c, 0x47, 0xd0, 0xb7, 0xdd, 0x51, 0x07, 0xdc,
+	0xa2, 0xd5, 0xa2, 0x9c, 0x98, 0x15, 0x9e, 0x89, 0x21, 0x9f, 0xa3, 0x5a, 0xa1, 0xf1, 0x49, 0x4a,
+	0xd6, 0x5b, 0xb6, 0xcf, 0x2e, 0xaa, 0x9f, 0x85, 0x58, 0xbe, 0xc7, 0xfc, 0x11, 0xb4, 0x6d, 0x0e,
+	0x02, 0x2b, 0x7b, 0x04, 0x8f, 0xd1, 0xda, 0x6c, 0x40, 0xc1, 0xdf, 0x45, 0x28, 0x7d, 0x79, 0xd6,
+	0xb4, 0xce, 0x9b, 0x45, 0xcf, 0x45, 0x8c, 0x98, 0x15, 0x27, 0xab, 0x26, 0x75, 0x85, 0xf7, 0xc0,
+	0x0f, 0x53, 0xc8, 0x2e, 0x64, 0xa3, 0x25, 0x03, 0x54, 0x3b, 0x13, 0x51, 0x54, 0x4f, 0xd1, 0x3b,
+	0x02, 0xce, 0x03, 0x50, 0x44, 0xf7, 0x16, 0xbb, 0xff, 0xd5, 0x29, 0x2d, 0x1e, 0x00, 0x31, 0xdf,
+	0x76, 0x24, 0x34, 0xf9, 0x39, 0x7b, 0x20, 0x0f, 0x42, 0xe6, 0xee, 0xb5, 0x93, 0x28, 0x80, 0x5e,
+	0x17, 0x20, 0x7b, 0xfc, 0xb8, 0x8b, 0x6e, 0x40, 0x1a, 0xb1, 0xfc, 0x1e, 0x04, 0xb1, 0xef, 0xf9,
+	0x10, 0x29, 0xea, 0xf5, 0xc9, 0xb8, 0x59, 0x93, 0xb8, 0xb3, 0x19, 0xc4, 0x5c, 0x15, 0x47, 0x0f,
+	0xf3, 0x13, 0x7c, 0x1f, 0x5d, 0x93, 0x59, 0x41, 0x32, 0x74, 0x20, 0x12, 0xcf, 0xe7, 0x72, 0xbb,
+	0x36, 0x19, 0x37, 0xdf, 0x9f, 0xc6, 0x90, 0x51, 0x62, 0x56, 0xc5, 0xe7, 0x63, 0xf9, 0xb5, 0x8f,
+	0x36, 0xca, 0x25, 0xaa, 0x6b, 0xf9, 0x16, 0x55, 0x1d, 0x71, 0x9a, 0xf6, 0xc6, 0x85, 0xbc, 0x6a,
+	0xeb, 0x96, 0x21, 0xaf, 0xc4, 0x48, 0x3b, 0xcd, 0x97, 0xf0, 0x0b, 0xe6, 0x07, 0x6d, 0x5d, 0x2d,
+	0x20, 0x56, 0xb7, 0x52, 0xd4, 0x12, 0x13, 0x39, 0x39, 0x3e, 0xd9, 0xc8, 0x56, 0x70, 0x14, 0xd8,
+	0x43, 0xdf, 0x9d, 0x99, 0x53, 0x88, 0xd6, 0x4b, 0xa3, 0xff, 0xdb, 0xac, 0x5a, 0xbf, 0x57, 0xd0,
+	0x15, 0x41, 0x89, 0x7f, 0xd2, 0x50, 0x25, 0xb7, 0x23, 0xbc, 0x33, 0xcf, 0x72, 0x4a, 0xfd, 0x4c,
+	0x37, 0x16, 0x4d, 0x97, 0x9d, 0x90, 0xed, 0xef, 0xff, 0xfc, 0xf7, 0xc7, 0x4b, 0x1f, 0x62, 0x42,
+	0xe7, 0x1b, 0xa9, 0x72, 0x30, 0xfc, 0x9b, 0x86, 0xae, 0x9f, 0xb6, 0x1a, 0xdc, 0x3a, 0x97, 0xae,
+	0xd4, 0xde, 0xf4, 0xdd, 0xa5, 0x6a, 0x94, 0xce, 0x5d, 0xa1, 0x73, 0x07, 0x7f, 0x32, 0x4f, 0x67,
+	0x61, 0x3f, 0x96, 0x33, 0x92, 0x3b, 0x89, 0x7f, 0xd1, 0x50, 0x75, 0xca, 0x34, 0x30, 0xbd, 0x98,
+	0xf9, 0x94, 0x43, 0xe9, 0xb7, 0x17, 0x2f, 0x50, 0x3a, 0x3f, 0x15, 0x3a, 0x29, 0xde, 0x99, 0xa7,
+	0x53, 0x28, 0xb3, 0x94, 0x37, 0xd1, 0x03, 0xf1, 0x79, 0x28, 0x66, 0x9e, 0xbb, 0xcf, 0x05, 0x33,
+	0x9f, 0xb5, 0x2f, 0xdd, 0x58, 0x34, 0x7d, 0xd1, 0x99, 0x17, 0xb6, 0x86, 0x5f, 0x6b, 0xe8, 0xdd,
+	0x2f, 0x21, 0x2e, 0xfc, 0x0a, 0x9f, 0xcf, 0x76, 0xc6, 0xf2, 0x74, 0xba, 0x70, 0xbe, 0x92, 0x77,
+	0x5b, 0xc8, 0xdb, 0xc6, 0x5b, 0xf3, 0xe4, 0xb9, 0x49, 0x64, 0x81, 0x1f, 0x5a, 0xd9, 0x16, 0xe1,
+	0x3f, 0x34, 0xb4, 0x3a, 0xe3, 0x1f, 0xf8, 0xfc, 0x57, 0x56, 0x6e, 0x88, 0xfa, 0xdd, 0xe5, 0x8a,
+	0x94, 0xe0, 0x67, 0x42, 0xf0, 0xd7, 0xf8, 0xab, 0x79, 0x82, 0xa5, 0xfd, 0x4d, 0x59, 0x11, 0x3d,
+	0x98, 0x75, 0xd5, 0x43, 0x7a, 0x30, 0x6d, 0x92, 0x87, 0xf8, 0xd7, 0x74, 0xd9, 0x4e, 0xb9, 0xcf,
+	0x45, 0xcb, 0x56, 0x66, 0x64, 0xfa, 0xee, 0x52, 0x35, 0x8b, 0x4e, 0xa0, 0x27, 0xeb, 0xf2, 0x09,
+	0xb4, 0x1f, 0xbd, 0x39, 0x6e, 0x68, 0x47, 0xc7, 0x0d, 0xed, 0x9f, 0xe3, 0x86, 0xf6, 0xc3, 0x49,
+	0x63, 0xe5, 0xe8, 0xa4, 0xb1, 0xf2, 0xd7, 0x49, 0x63, 0xe5, 0x79, 0xab, 0xef, 0xc7, 0x7b, 0x89,
+	0x63, 0xb8, 0x6c, 0x98, 0xa1, 0xed, 0x0c, 0x6c, 0x87, 0xe7, 0xd0, 0xfb, 0xad, 0x7b, 0xf4, 0x55,
+	0x46, 0x10, 0x8f, 0x42, 0xe0, 0xce, 0x55, 0xf1, 0xa7, 0x6d, 0xf7, 0xbf, 0x01, 0x00, 0x33, 0xe1,
+	0x7c, 0x00, 0x8d, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -534,6 +724,13 @@ type QueryClient interface {
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeResponse, error)
+	// EpochBurnedFees returns the amount of tx fees burned at the end of the
+	// given epoch.
+	EpochBurnedFees(ctx context.Context, in *QueryEpochBurnedFeesRequest, opts ...grpc.CallOption) (*QueryEpochBurnedFeesResponse, error)
+	// DynamicBaseFee returns the in-protocol base fee, the minimum gas price in
+	// the base denom every tx must pay in the current block. It is zero when
+	// the dynamic base fee is disabled.
+	DynamicBaseFee(ctx context.Context, in *QueryDynamicBaseFeeRequest, opts ...grpc.CallOption) (*QueryDynamicBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -589,6 +786,24 @@ func (c *queryClient) GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequ
 	return out, nil
 }
 
+func (c *queryClient) EpochBurnedFees(ctx context.Context, in *QueryEpochBurnedFeesRequest, opts ...grpc.CallOption) (*QueryEpochBurnedFeesResponse, error) {
+	out := new(QueryEpochBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/EpochBurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DynamicBaseFee(ctx context.Context, in *QueryDynamicBaseFeeRequest, opts ...grpc.CallOption) (*QueryDynamicBaseFeeResponse, error) {
+	out := new(QueryDynamicBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/DynamicBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -603,6 +818,13 @@ type QueryServer interface {
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(context.Context, *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error)
+	// EpochBurnedFees returns the amount of tx fees burned at the end of the
+	// given epoch.
+	EpochBurnedFees(context.Context, *QueryEpochBurnedFeesRequest) (*QueryEpochBurnedFeesResponse, error)
+	// DynamicBaseFee returns the in-protocol base fee, the minimum gas price in
+	// the base denom every tx must pay in the current block. It is zero when
+	// the dynamic base fee is disabled.
+	DynamicBaseFee(context.Context, *QueryDynamicBaseFeeRequest) (*QueryDynamicBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -624,6 +846,12 @@ func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDe
 func (*UnimplementedQueryServer) GetEipBaseFee(ctx context.Context, req *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEipBaseFee not implemented")
 }
+func (*UnimplementedQueryServer) EpochBurnedFees(ctx context.Context, req *QueryEpochBurnedFeesRequest) (*QueryEpochBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochBurnedFees not implemented")
+}
+func (*UnimplementedQueryServer) DynamicBaseFee(ctx context.Context, req *QueryDynamicBaseFeeRequest) (*QueryDynamicBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -719,6 +947,43 @@ func _Query_GetEipBaseFee_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochBurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochBurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/EpochBurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochBurnedFees(ctx, req.(*QueryEpochBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DynamicBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/DynamicBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicBaseFee(ctx, req.(*QueryDynamicBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -743,6 +1008,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEipBaseFee",
 			Handler:    _Query_GetEipBaseFee_Handler,
 		},
+		{
+			MethodName: "EpochBurnedFees",
+			Handler:    _Query_EpochBurnedFees_Handler,
+		},
+		{
+			MethodName: "DynamicBaseFee",
+			Handler:    _Query_DynamicBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -1043,6 +1316,130 @@ func (m *QueryEipBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1172,15 +1569,62 @@ func (m *QueryEipBaseFeeResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *QueryEpochBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryEpochBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDynamicBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDynamicBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1914,6 +2358,324 @@ func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochBurnedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochBurnedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochBurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochBurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDynamicBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDynamicBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDynamicBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDynamicBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDynamicBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDynamicBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,13 @@ var (
 	// * match_denom - the match denom to swap to.
 	// * err - the error occurred
 	TakerFeeNoSkipRouteMetricName = formatTxFeesMetricName("takerfee_no_skip_route")
+	// txfees_fee_burn_failed
+	//
+	// counter that is increased if burning the fee burn share at the end of an epoch fails
+	// Has the following labels:
+	// * coins - the coins that fail to be burned.
+	// * err - the error occurred
+	FeeBurnFailedMetricName = formatTxFeesMetricName("fee_burn_failed")
 )
 
 // formatTxFeesMetricName formats the tx fees module metric name.
@@ -11,7 +11,7 @@ import (
 // GetQueryCmd returns the cli query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
-	cmd.AddCommand(GetCmdValSetPref())
+	cmd.AddCommand(GetCmdValSetPref(), GetCmdValSetSuggestions())
 	return cmd
 }
 
@@ -23,3 +23,13 @@ func GetCmdValSetPref() *cobra.Command {
 		types.ModuleName, queryproto.NewQueryClient,
 	)
 }
+
+// GetCmdValSetSuggestions takes the address and returns the scores of its current validator set
+// and a suggested reweighting of it.
+func GetCmdValSetSuggestions() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.ValidatorPreferenceSuggestionsRequest](
+		"val-set-suggestions",
+		"Query the scores of the current validator set for a specific user address, and a suggested reweighting of it", "",
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
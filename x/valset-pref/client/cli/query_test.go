@@ -66,6 +66,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&queryproto.UserValidatorPreferencesRequest{Address: sdk.AccAddress([]byte("addr1---------------")).String()},
 			&queryproto.UserValidatorPreferencesResponse{},
 		},
+		{
+			"Query delegators validator set suggestions",
+			"/osmosis.valsetpref.v1beta1.Query/ValidatorPreferenceSuggestions",
+			&queryproto.ValidatorPreferenceSuggestionsRequest{Address: sdk.AccAddress([]byte("addr1---------------")).String()},
+			&queryproto.ValidatorPreferenceSuggestionsResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -31,3 +31,13 @@ func (q Querier) UserValidatorPreferences(grpcCtx context.Context,
 	return q.Q.UserValidatorPreferences(ctx, *req)
 }
 
+func (q Querier) ValidatorPreferenceSuggestions(grpcCtx context.Context,
+	req *queryproto.ValidatorPreferenceSuggestionsRequest,
+) (*queryproto.ValidatorPreferenceSuggestionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ValidatorPreferenceSuggestions(ctx, *req)
+}
+
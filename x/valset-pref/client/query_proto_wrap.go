@@ -27,3 +27,15 @@ func (q Querier) UserValidatorPreferences(ctx sdk.Context, req queryproto.UserVa
 		Preferences: validatorSet.Preferences,
 	}, nil
 }
+
+func (q Querier) ValidatorPreferenceSuggestions(ctx sdk.Context, req queryproto.ValidatorPreferenceSuggestionsRequest) (*queryproto.ValidatorPreferenceSuggestionsResponse, error) {
+	scores, suggestedPreferences, err := q.K.GetValidatorPreferenceSuggestions(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.ValidatorPreferenceSuggestionsResponse{
+		Scores:               scores,
+		SuggestedPreferences: suggestedPreferences,
+	}, nil
+}
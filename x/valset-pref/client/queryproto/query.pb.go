@@ -107,9 +107,95 @@ func (m *UserValidatorPreferencesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UserValidatorPreferencesResponse proto.InternalMessageInfo
 
+// Request type for ValidatorPreferenceSuggestions.
+type ValidatorPreferenceSuggestionsRequest struct {
+	// user account address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ValidatorPreferenceSuggestionsRequest) Reset()         { *m = ValidatorPreferenceSuggestionsRequest{} }
+func (m *ValidatorPreferenceSuggestionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPreferenceSuggestionsRequest) ProtoMessage()    {}
+func (*ValidatorPreferenceSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e2d5b0777f607c6, []int{2}
+}
+func (m *ValidatorPreferenceSuggestionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPreferenceSuggestionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPreferenceSuggestionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPreferenceSuggestionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPreferenceSuggestionsRequest.Merge(m, src)
+}
+func (m *ValidatorPreferenceSuggestionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPreferenceSuggestionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPreferenceSuggestionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPreferenceSuggestionsRequest proto.InternalMessageInfo
+
+// Response type for the ValidatorPreferenceSuggestions query request
+type ValidatorPreferenceSuggestionsResponse struct {
+	// scores of the validators in the user's current validator set
+	Scores []types.ValidatorScore `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores"`
+	// suggested_preferences reweights the user's current validator set in
+	// proportion to weight * score, leaving out flagged validators. It is
+	// empty if every validator is flagged.
+	SuggestedPreferences []types.ValidatorPreference `protobuf:"bytes,2,rep,name=suggested_preferences,json=suggestedPreferences,proto3" json:"suggested_preferences" yaml:"suggested_preferences"`
+}
+
+func (m *ValidatorPreferenceSuggestionsResponse) Reset() {
+	*m = ValidatorPreferenceSuggestionsResponse{}
+}
+func (m *ValidatorPreferenceSuggestionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPreferenceSuggestionsResponse) ProtoMessage()    {}
+func (*ValidatorPreferenceSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e2d5b0777f607c6, []int{3}
+}
+func (m *ValidatorPreferenceSuggestionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPreferenceSuggestionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPreferenceSuggestionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPreferenceSuggestionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPreferenceSuggestionsResponse.Merge(m, src)
+}
+func (m *ValidatorPreferenceSuggestionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPreferenceSuggestionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPreferenceSuggestionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPreferenceSuggestionsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*UserValidatorPreferencesRequest)(nil), "osmosis.valsetpref.v1beta1.UserValidatorPreferencesRequest")
 	proto.RegisterType((*UserValidatorPreferencesResponse)(nil), "osmosis.valsetpref.v1beta1.UserValidatorPreferencesResponse")
+	proto.RegisterType((*ValidatorPreferenceSuggestionsRequest)(nil), "osmosis.valsetpref.v1beta1.ValidatorPreferenceSuggestionsRequest")
+	proto.RegisterType((*ValidatorPreferenceSuggestionsResponse)(nil), "osmosis.valsetpref.v1beta1.ValidatorPreferenceSuggestionsResponse")
 }
 
 func init() {
@@ -117,29 +203,36 @@ func init() {
 }
 
 var fileDescriptor_6e2d5b0777f607c6 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4f, 0x4b, 0x3a, 0x41,
-	0x18, 0xde, 0xf9, 0xfd, 0xfa, 0x43, 0xeb, 0x6d, 0xe9, 0xb0, 0x2c, 0x31, 0x8a, 0x07, 0xf1, 0xe2,
-	0x0c, 0x1a, 0x74, 0xb1, 0x93, 0x7d, 0x81, 0x12, 0x2a, 0xe8, 0x36, 0xab, 0xaf, 0xdb, 0xc2, 0xba,
-	0xef, 0x3a, 0xef, 0x28, 0x85, 0x74, 0xe9, 0x13, 0x04, 0x7d, 0xa6, 0xc0, 0x5b, 0x42, 0x97, 0x4e,
-	0x51, 0xda, 0x07, 0x09, 0xdd, 0x15, 0x13, 0xd2, 0xa0, 0xd3, 0xee, 0xcc, 0x3c, 0x7f, 0xe6, 0x79,
-	0x9f, 0xb1, 0x4b, 0x48, 0x5d, 0xa4, 0x90, 0xe4, 0x40, 0x45, 0x04, 0x26, 0xd1, 0xd0, 0x91, 0x83,
-	0xaa, 0x0f, 0x46, 0x55, 0x65, 0xaf, 0x0f, 0xfa, 0x56, 0x24, 0x1a, 0x0d, 0x3a, 0x5e, 0x86, 0x13,
-	0x4b, 0x9c, 0xc8, 0x70, 0xde, 0x7e, 0x80, 0x01, 0xce, 0x61, 0x72, 0xf6, 0x97, 0x32, 0xbc, 0x83,
-	0x00, 0x31, 0x88, 0x40, 0xaa, 0x24, 0x94, 0x2a, 0x8e, 0xd1, 0x28, 0x13, 0x62, 0x4c, 0xd9, 0xe9,
-	0x26, 0x5f, 0x32, 0xca, 0x40, 0x8a, 0x2b, 0xd6, 0xed, 0xfc, 0x39, 0x81, 0xbe, 0x50, 0x51, 0xd8,
-	0x56, 0x06, 0xf5, 0xa9, 0x86, 0x0e, 0x68, 0x88, 0x5b, 0x40, 0x4d, 0xe8, 0xf5, 0x81, 0x8c, 0xe3,
-	0xda, 0xbb, 0xaa, 0xdd, 0xd6, 0x40, 0xe4, 0xb2, 0x02, 0x2b, 0xef, 0x35, 0x17, 0xcb, 0xe2, 0xd0,
-	0x2e, 0xac, 0x27, 0x53, 0x82, 0x31, 0x81, 0x73, 0x69, 0xe7, 0x92, 0xe5, 0xb6, 0xcb, 0x0a, 0xff,
-	0xcb, 0xb9, 0x9a, 0x14, 0xeb, 0xe3, 0x8a, 0x1f, 0xe4, 0x1a, 0x5b, 0xa3, 0xb7, 0xbc, 0xd5, 0xfc,
-	0xae, 0x54, 0x7b, 0x66, 0xf6, 0xf6, 0xd9, 0x6c, 0x82, 0xce, 0x13, 0xb3, 0xdd, 0x75, 0xf7, 0x70,
-	0xea, 0x9b, 0xac, 0x7e, 0x89, 0xee, 0x1d, 0xff, 0x8d, 0x9c, 0x46, 0x2f, 0x8a, 0xfb, 0x97, 0xcf,
-	0xc7, 0x7f, 0x65, 0xa7, 0x24, 0x57, 0xcb, 0xa8, 0xac, 0xb4, 0x31, 0xcc, 0xa6, 0x79, 0xd7, 0x50,
-	0xa3, 0x0f, 0x6e, 0x8d, 0x26, 0x9c, 0x8d, 0x27, 0x9c, 0xbd, 0x4f, 0x38, 0x7b, 0x98, 0x72, 0x6b,
-	0x3c, 0xe5, 0xd6, 0xeb, 0x94, 0x5b, 0x57, 0x27, 0x41, 0x68, 0xae, 0xfb, 0xbe, 0x68, 0x61, 0x77,
-	0xa1, 0x57, 0x89, 0x94, 0x4f, 0x4b, 0xf1, 0xda, 0x91, 0xbc, 0x59, 0xb1, 0x68, 0x45, 0x21, 0xc4,
-	0x26, 0x7d, 0x67, 0xf3, 0xba, 0xfd, 0x9d, 0xf9, 0xe7, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x55,
-	0x75, 0x38, 0x25, 0x97, 0x02, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xce, 0xb4, 0x5a, 0x71, 0x7a, 0x1b, 0x2a, 0x2c, 0xa1, 0xcc, 0x2e, 0x41, 0x97, 0x45, 0x68,
+	0x86, 0xae, 0xe2, 0xa1, 0xf5, 0xd2, 0xf5, 0xe2, 0x51, 0xb7, 0xa8, 0xe0, 0x45, 0x66, 0x77, 0x5f,
+	0x63, 0x20, 0x9b, 0x49, 0xe7, 0x4d, 0x8a, 0xa5, 0x78, 0xf1, 0xe8, 0x49, 0xf0, 0x6f, 0xf1, 0x4f,
+	0x10, 0xf6, 0x58, 0xf0, 0xe2, 0xa9, 0xe8, 0xc6, 0xbf, 0xc0, 0x7f, 0x40, 0x49, 0x32, 0x6d, 0xba,
+	0xb8, 0x3f, 0xea, 0xf6, 0x94, 0x64, 0xf2, 0x7d, 0xdf, 0x7b, 0xdf, 0x7b, 0x1f, 0x43, 0x9b, 0x0a,
+	0x87, 0x0a, 0x43, 0x14, 0x47, 0x32, 0x42, 0x30, 0x89, 0x86, 0x03, 0x71, 0xb4, 0xdd, 0x03, 0x23,
+	0xb7, 0xc5, 0x61, 0x0a, 0xfa, 0xd8, 0x4f, 0xb4, 0x32, 0x8a, 0xb9, 0x16, 0xe7, 0x57, 0x38, 0xdf,
+	0xe2, 0xdc, 0x8d, 0x40, 0x05, 0xaa, 0x80, 0x89, 0xfc, 0xad, 0x64, 0xb8, 0x9b, 0x81, 0x52, 0x41,
+	0x04, 0x42, 0x26, 0xa1, 0x90, 0x71, 0xac, 0x8c, 0x34, 0xa1, 0x8a, 0xd1, 0xfe, 0x9d, 0x57, 0x17,
+	0x8d, 0x34, 0x50, 0xe2, 0xbc, 0x5d, 0x5a, 0x7f, 0x81, 0xa0, 0x5f, 0xca, 0x28, 0x1c, 0x48, 0xa3,
+	0xf4, 0x33, 0x0d, 0x07, 0xa0, 0x21, 0xee, 0x03, 0x76, 0xe1, 0x30, 0x05, 0x34, 0xac, 0x46, 0x6f,
+	0xc9, 0xc1, 0x40, 0x03, 0x62, 0x8d, 0x34, 0x48, 0xeb, 0x76, 0xf7, 0xfc, 0xd3, 0x3b, 0xa1, 0x8d,
+	0xd9, 0x64, 0x4c, 0x54, 0x8c, 0xc0, 0x5e, 0xd1, 0xf5, 0xa4, 0x3a, 0xae, 0x91, 0xc6, 0x6a, 0x6b,
+	0xbd, 0x2d, 0xfc, 0xd9, 0x76, 0xfd, 0x29, 0x72, 0x9d, 0x1b, 0xa3, 0xb3, 0xba, 0xd3, 0xbd, 0xac,
+	0xe4, 0xed, 0xd1, 0x7b, 0x53, 0x90, 0xfb, 0x69, 0x10, 0x00, 0x16, 0x93, 0x58, 0xdc, 0xff, 0x1f,
+	0x42, 0x9b, 0x8b, 0x34, 0xac, 0x8d, 0xa7, 0x74, 0x0d, 0xfb, 0x4a, 0x5f, 0x38, 0xb8, 0x7f, 0x25,
+	0x07, 0xfb, 0x39, 0xc5, 0x36, 0x6f, 0xf9, 0xec, 0x23, 0xa1, 0x77, 0xb0, 0xac, 0x00, 0x83, 0x37,
+	0x97, 0x67, 0xb3, 0xb2, 0xdc, 0x6c, 0xee, 0xe6, 0xf2, 0xbf, 0xcf, 0xea, 0x9b, 0xc7, 0x72, 0x18,
+	0xed, 0x78, 0x53, 0xb5, 0xbd, 0xee, 0xc6, 0xc5, 0x79, 0x45, 0xc5, 0xf6, 0x97, 0x55, 0x7a, 0xf3,
+	0x79, 0x1e, 0x43, 0xf6, 0x95, 0xd0, 0xda, 0xac, 0x65, 0xb2, 0xdd, 0x79, 0x3d, 0x2d, 0xc8, 0x8f,
+	0xfb, 0x78, 0x39, 0x72, 0x39, 0x78, 0xcf, 0xff, 0xf0, 0xed, 0xd7, 0xe7, 0x95, 0x16, 0x6b, 0x8a,
+	0xc9, 0x44, 0x6f, 0x4d, 0x44, 0xfa, 0xc4, 0xae, 0xf4, 0x3d, 0xcb, 0x08, 0xe5, 0xf3, 0x77, 0xca,
+	0xf6, 0xfe, 0x73, 0xc2, 0xff, 0x66, 0xca, 0xed, 0x5c, 0x47, 0xc2, 0x3a, 0xdb, 0x29, 0x9c, 0x3d,
+	0x64, 0xed, 0xab, 0x39, 0x13, 0x58, 0x69, 0x74, 0xe4, 0xe8, 0x27, 0x77, 0x46, 0x63, 0x4e, 0x4e,
+	0xc7, 0x9c, 0xfc, 0x18, 0x73, 0xf2, 0x29, 0xe3, 0xce, 0x69, 0xc6, 0x9d, 0xef, 0x19, 0x77, 0x5e,
+	0x3f, 0x09, 0x42, 0xf3, 0x36, 0xed, 0xf9, 0x7d, 0x35, 0x3c, 0xd7, 0xde, 0x8a, 0x64, 0x0f, 0xab,
+	0x42, 0xed, 0x47, 0xe2, 0xdd, 0x44, 0xb9, 0x7e, 0x14, 0x42, 0x6c, 0xca, 0x2b, 0xa9, 0xb8, 0x19,
+	0x7a, 0x6b, 0xc5, 0xe3, 0xc1, 0xdf, 0x01, 0x00, 0x89, 0x9a, 0xbc, 0xca, 0xc2, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +249,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Returns the list of ValidatorPreferences for the user.
 	UserValidatorPreferences(ctx context.Context, in *UserValidatorPreferencesRequest, opts ...grpc.CallOption) (*UserValidatorPreferencesResponse, error)
+	// Returns the scores of the validators in the user's current validator
+	// set, and a suggested reweighting of the set away from flagged
+	// validators.
+	ValidatorPreferenceSuggestions(ctx context.Context, in *ValidatorPreferenceSuggestionsRequest, opts ...grpc.CallOption) (*ValidatorPreferenceSuggestionsResponse, error)
 }
 
 type queryClient struct {
@@ -175,10 +272,23 @@ func (c *queryClient) UserValidatorPreferences(ctx context.Context, in *UserVali
 	return out, nil
 }
 
+func (c *queryClient) ValidatorPreferenceSuggestions(ctx context.Context, in *ValidatorPreferenceSuggestionsRequest, opts ...grpc.CallOption) (*ValidatorPreferenceSuggestionsResponse, error) {
+	out := new(ValidatorPreferenceSuggestionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Query/ValidatorPreferenceSuggestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns the list of ValidatorPreferences for the user.
 	UserValidatorPreferences(context.Context, *UserValidatorPreferencesRequest) (*UserValidatorPreferencesResponse, error)
+	// Returns the scores of the validators in the user's current validator
+	// set, and a suggested reweighting of the set away from flagged
+	// validators.
+	ValidatorPreferenceSuggestions(context.Context, *ValidatorPreferenceSuggestionsRequest) (*ValidatorPreferenceSuggestionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -188,6 +298,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) UserValidatorPreferences(ctx context.Context, req *UserValidatorPreferencesRequest) (*UserValidatorPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserValidatorPreferences not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorPreferenceSuggestions(ctx context.Context, req *ValidatorPreferenceSuggestionsRequest) (*ValidatorPreferenceSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPreferenceSuggestions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -211,6 +324,25 @@ func _Query_UserValidatorPreferences_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorPreferenceSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPreferenceSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPreferenceSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Query/ValidatorPreferenceSuggestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPreferenceSuggestions(ctx, req.(*ValidatorPreferenceSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.valsetpref.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -219,6 +351,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserValidatorPreferences",
 			Handler:    _Query_UserValidatorPreferences_Handler,
 		},
+		{
+			MethodName: "ValidatorPreferenceSuggestions",
+			Handler:    _Query_ValidatorPreferenceSuggestions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/valsetpref/v1beta1/query.proto",
@@ -291,6 +427,87 @@ func (m *UserValidatorPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPreferenceSuggestionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPreferenceSuggestionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPreferenceSuggestionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPreferenceSuggestionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPreferenceSuggestionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPreferenceSuggestionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SuggestedPreferences) > 0 {
+		for iNdEx := len(m.SuggestedPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuggestedPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -330,6 +547,40 @@ func (m *UserValidatorPreferencesResponse) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorPreferenceSuggestionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorPreferenceSuggestionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SuggestedPreferences) > 0 {
+		for _, e := range m.SuggestedPreferences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -502,6 +753,206 @@ func (m *UserValidatorPreferencesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorPreferenceSuggestionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPreferenceSuggestionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPreferenceSuggestionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPreferenceSuggestionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPreferenceSuggestionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPreferenceSuggestionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scores = append(m.Scores, types.ValidatorScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestedPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuggestedPreferences = append(m.SuggestedPreferences, types.ValidatorPreference{})
+			if err := m.SuggestedPreferences[len(m.SuggestedPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,6 +24,7 @@ type Keeper struct {
 	stakingKeeper      types.StakingInterface
 	distirbutionKeeper types.DistributionKeeper
 	lockupKeeper       types.LockupKeeper
+	slashingKeeper     types.SlashingKeeper
 }
 
 func NewKeeper(storeKey storetypes.StoreKey,
@@ -31,6 +32,7 @@ func NewKeeper(storeKey storetypes.StoreKey,
 	stakingKeeper types.StakingInterface,
 	distirbutionKeeper types.DistributionKeeper,
 	lockupKeeper types.LockupKeeper,
+	slashingKeeper types.SlashingKeeper,
 ) Keeper {
 	return Keeper{
 		storeKey:           storeKey,
@@ -38,6 +40,7 @@ func NewKeeper(storeKey storetypes.StoreKey,
 		stakingKeeper:      stakingKeeper,
 		distirbutionKeeper: distirbutionKeeper,
 		lockupKeeper:       lockupKeeper,
+		slashingKeeper:     slashingKeeper,
 	}
 }
 
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/valset-pref/types"
+)
+
+// GetValidatorPreferenceSuggestions scores the validators in the delegator's current validator set,
+// that is its validator set preference or else its existing delegations, and suggests reweighting
+// the set in proportion to weight * score, leaving out flagged validators.
+// The suggested preferences are empty if every validator is flagged, otherwise their weights sum to exactly one.
+func (k Keeper) GetValidatorPreferenceSuggestions(ctx sdk.Context, delegator string) ([]types.ValidatorScore, []types.ValidatorPreference, error) {
+	valSet, err := k.GetDelegationPreferences(ctx, delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signedBlocksWindow, err := k.slashingKeeper.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scores := make([]types.ValidatorScore, 0, len(valSet.Preferences))
+	totalScoredWeight := osmomath.ZeroDec()
+	for _, pref := range valSet.Preferences {
+		score, err := k.scoreValidator(ctx, pref, signedBlocksWindow)
+		if err != nil {
+			return nil, nil, err
+		}
+		scores = append(scores, score)
+		if !score.IsFlagged() {
+			totalScoredWeight = totalScoredWeight.Add(score.Weight.Mul(score.Score))
+		}
+	}
+
+	suggested := []types.ValidatorPreference{}
+	if !totalScoredWeight.IsPositive() {
+		return scores, suggested, nil
+	}
+
+	remainingWeight := osmomath.OneDec()
+	for _, score := range scores {
+		if score.IsFlagged() || !score.Score.IsPositive() {
+			continue
+		}
+		weight := score.Weight.Mul(score.Score).Quo(totalScoredWeight)
+		suggested = append(suggested, types.ValidatorPreference{ValOperAddress: score.ValOperAddress, Weight: weight})
+		remainingWeight = remainingWeight.Sub(weight)
+	}
+	// assign the rounding remainder to the last validator so that the weights sum to exactly one.
+	suggested[len(suggested)-1].Weight = suggested[len(suggested)-1].Weight.Add(remainingWeight)
+
+	return scores, suggested, nil
+}
+
+// scoreValidator scores the validator of the given preference on its uptime over the slashing window,
+// its commission rate and the number of times it was slashed, and flags it if it is jailed, tombstoned,
+// not bonded, slashed, or if its uptime or commission rate are worse than the suggested bounds.
+// Validators without signing info are considered to have zero uptime.
+func (k Keeper) scoreValidator(ctx sdk.Context, pref types.ValidatorPreference, signedBlocksWindow int64) (types.ValidatorScore, error) {
+	valAddr, err := sdk.ValAddressFromBech32(pref.ValOperAddress)
+	if err != nil {
+		return types.ValidatorScore{}, fmt.Errorf("validator address not formatted, %w", err)
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.ValidatorScore{}, types.ValidatorNotFoundError{ValidatorAddr: pref.ValOperAddress}
+	}
+
+	uptime := osmomath.ZeroDec()
+	tombstoned := false
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return types.ValidatorScore{}, err
+	}
+	signingInfo, err := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if err == nil && signedBlocksWindow > 0 {
+		tombstoned = signingInfo.Tombstoned
+		missed := osmomath.NewDec(signingInfo.MissedBlocksCounter).QuoInt64(signedBlocksWindow)
+		if missed.LT(osmomath.OneDec()) {
+			uptime = osmomath.OneDec().Sub(missed)
+		}
+	}
+
+	slashCount := uint64(0)
+	k.distirbutionKeeper.IterateValidatorSlashEventsBetween(ctx, valAddr, 0, uint64(ctx.BlockHeight()), func(_ uint64, _ distrtypes.ValidatorSlashEvent) (stop bool) {
+		slashCount++
+		return false
+	})
+
+	commissionRate := validator.Commission.Rate
+	flagReasons := []string{}
+	if validator.IsJailed() {
+		flagReasons = append(flagReasons, types.FlagReasonJailed)
+	}
+	if tombstoned {
+		flagReasons = append(flagReasons, types.FlagReasonTombstoned)
+	}
+	if !validator.IsBonded() {
+		flagReasons = append(flagReasons, types.FlagReasonNotBonded)
+	}
+	if uptime.LT(types.MinSuggestedUptime) {
+		flagReasons = append(flagReasons, types.FlagReasonLowUptime)
+	}
+	if commissionRate.GT(types.MaxSuggestedCommissionRate) {
+		flagReasons = append(flagReasons, types.FlagReasonHighCommission)
+	}
+	if slashCount > 0 {
+		flagReasons = append(flagReasons, types.FlagReasonSlashed)
+	}
+
+	score := osmomath.ZeroDec()
+	if !validator.IsJailed() && !tombstoned && validator.IsBonded() {
+		score = uptime.Mul(osmomath.OneDec().Sub(commissionRate)).QuoInt64(int64(slashCount) + 1)
+	}
+
+	return types.ValidatorScore{
+		ValOperAddress: pref.ValOperAddress,
+		Weight:         pref.Weight,
+		Uptime:         uptime,
+		CommissionRate: commissionRate,
+		SlashCount:     slashCount,
+		Jailed:         validator.IsJailed(),
+		Tombstoned:     tombstoned,
+		Score:          score,
+		FlagReasons:    flagReasons,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/valset-pref/types"
+)
+
+func (s *KeeperTestSuite) TestGetValidatorPreferenceSuggestions() {
+	delegator := sdk.AccAddress([]byte("addr1---------------"))
+	type suggestion struct {
+		valIndex int
+		weight   osmomath.Dec
+	}
+
+	tests := []struct {
+		name string
+		// modifyValidator is applied to the validator at the given index of the validator set
+		modifyValidator     map[int]func(val stakingtypes.Validator) stakingtypes.Validator
+		missedBlocks        map[int]int64
+		expectedFlags       map[int][]string
+		expectedSuggestions []suggestion
+	}{
+		{
+			name: "no flagged validators, suggestions keep the current weights",
+			expectedSuggestions: []suggestion{
+				{valIndex: 0, weight: osmomath.NewDecWithPrec(5, 1)},
+				{valIndex: 1, weight: osmomath.NewDecWithPrec(3, 1)},
+				{valIndex: 2, weight: osmomath.NewDecWithPrec(2, 1)},
+			},
+		},
+		{
+			name: "jailed and high commission validators are left out",
+			modifyValidator: map[int]func(val stakingtypes.Validator) stakingtypes.Validator{
+				0: func(val stakingtypes.Validator) stakingtypes.Validator {
+					val.Jailed = true
+					return val
+				},
+				1: func(val stakingtypes.Validator) stakingtypes.Validator {
+					val.Commission.Rate = osmomath.NewDecWithPrec(5, 1)
+					return val
+				},
+			},
+			expectedFlags: map[int][]string{
+				0: {types.FlagReasonJailed},
+				1: {types.FlagReasonHighCommission},
+			},
+			expectedSuggestions: []suggestion{
+				{valIndex: 2, weight: osmomath.OneDec()},
+			},
+		},
+		{
+			name:         "low uptime validator is left out, commission discounts the score",
+			missedBlocks: map[int]int64{1: 1},
+			modifyValidator: map[int]func(val stakingtypes.Validator) stakingtypes.Validator{
+				1: func(val stakingtypes.Validator) stakingtypes.Validator {
+					val.Commission.Rate = osmomath.NewDecWithPrec(1, 1)
+					return val
+				},
+				2: func(val stakingtypes.Validator) stakingtypes.Validator {
+					val.Commission.Rate = osmomath.NewDecWithPrec(1, 1)
+					return val
+				},
+			},
+			expectedFlags: map[int][]string{
+				1: {types.FlagReasonLowUptime},
+			},
+			// 0.5 * 1 / (0.5 * 1 + 0.2 * 0.9) and 0.2 * 0.9 / (0.5 * 1 + 0.2 * 0.9)
+			expectedSuggestions: []suggestion{
+				{valIndex: 0, weight: osmomath.MustNewDecFromStr("0.735294117647058824")},
+				{valIndex: 2, weight: osmomath.MustNewDecFromStr("0.264705882352941176")},
+			},
+		},
+		{
+			name: "every validator is flagged, no suggestions",
+			modifyValidator: map[int]func(val stakingtypes.Validator) stakingtypes.Validator{
+				0: func(val stakingtypes.Validator) stakingtypes.Validator {
+					return val.UpdateStatus(stakingtypes.Unbonded)
+				},
+				1: func(val stakingtypes.Validator) stakingtypes.Validator {
+					return val.UpdateStatus(stakingtypes.Unbonded)
+				},
+				2: func(val stakingtypes.Validator) stakingtypes.Validator {
+					return val.UpdateStatus(stakingtypes.Unbonded)
+				},
+			},
+			expectedFlags: map[int][]string{
+				0: {types.FlagReasonNotBonded},
+				1: {types.FlagReasonNotBonded},
+				2: {types.FlagReasonNotBonded},
+			},
+			expectedSuggestions: []suggestion{},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			valAddrs := s.SetupMultipleValidators(3)
+			weights := []osmomath.Dec{osmomath.NewDecWithPrec(5, 1), osmomath.NewDecWithPrec(3, 1), osmomath.NewDecWithPrec(2, 1)}
+			preferences := make([]types.ValidatorPreference, len(valAddrs))
+			for i, valAddr := range valAddrs {
+				preferences[i] = types.ValidatorPreference{ValOperAddress: valAddr, Weight: weights[i]}
+			}
+			s.App.ValidatorSetPreferenceKeeper.SetValidatorSetPreferences(s.Ctx, delegator.String(), types.ValidatorSetPreferences{Preferences: preferences})
+
+			// make a single missed block bring the uptime below the minimum
+			slashingParams, err := s.App.SlashingKeeper.GetParams(s.Ctx)
+			s.Require().NoError(err)
+			slashingParams.SignedBlocksWindow = 10
+			err = s.App.SlashingKeeper.SetParams(s.Ctx, slashingParams)
+			s.Require().NoError(err)
+
+			for i, valAddrStr := range valAddrs {
+				valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+				s.Require().NoError(err)
+				val, err := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+				s.Require().NoError(err)
+				if modify, ok := test.modifyValidator[i]; ok {
+					err = s.App.StakingKeeper.SetValidator(s.Ctx, modify(val))
+					s.Require().NoError(err)
+				}
+				if missed, ok := test.missedBlocks[i]; ok {
+					consAddr, err := val.GetConsAddr()
+					s.Require().NoError(err)
+					signingInfo, err := s.App.SlashingKeeper.GetValidatorSigningInfo(s.Ctx, consAddr)
+					s.Require().NoError(err)
+					signingInfo.MissedBlocksCounter = missed
+					err = s.App.SlashingKeeper.SetValidatorSigningInfo(s.Ctx, consAddr, signingInfo)
+					s.Require().NoError(err)
+				}
+			}
+
+			scores, suggestions, err := s.App.ValidatorSetPreferenceKeeper.GetValidatorPreferenceSuggestions(s.Ctx, delegator.String())
+			s.Require().NoError(err)
+
+			s.Require().Len(scores, len(valAddrs))
+			for i, score := range scores {
+				s.Require().Equal(valAddrs[i], score.ValOperAddress)
+				s.Require().Equal(weights[i], score.Weight)
+				expectedFlags, ok := test.expectedFlags[i]
+				if !ok {
+					expectedFlags = []string{}
+				}
+				s.Require().Equal(expectedFlags, score.FlagReasons)
+			}
+
+			totalWeight := osmomath.ZeroDec()
+			s.Require().Len(suggestions, len(test.expectedSuggestions))
+			for i, expected := range test.expectedSuggestions {
+				s.Require().Equal(valAddrs[expected.valIndex], suggestions[i].ValOperAddress)
+				s.Require().Equal(expected.weight, suggestions[i].Weight)
+				totalWeight = totalWeight.Add(suggestions[i].Weight)
+			}
+			if len(suggestions) > 0 {
+				s.Require().Equal(osmomath.OneDec(), totalWeight)
+			}
+		})
+	}
+}
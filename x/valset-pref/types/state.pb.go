@@ -113,9 +113,69 @@ func (m *ValidatorSetPreferences) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSetPreferences proto.InternalMessageInfo
 
+// ValidatorScore scores a validator of a delegator's validator set on its
+// uptime, commission and slashing history.
+type ValidatorScore struct {
+	// val_oper_address is the address of the scored validator.
+	ValOperAddress string `protobuf:"bytes,1,opt,name=val_oper_address,json=valOperAddress,proto3" json:"val_oper_address,omitempty" yaml:"val_oper_address"`
+	// weight is the validator's current weight in the validator set.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+	// uptime is the fraction of the blocks of the slashing window the
+	// validator signed.
+	Uptime cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=uptime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"uptime"`
+	// commission_rate is the validator's current commission rate.
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate" yaml:"commission_rate"`
+	// slash_count is the number of times the validator was slashed.
+	SlashCount uint64 `protobuf:"varint,5,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty" yaml:"slash_count"`
+	// jailed is whether the validator is jailed.
+	Jailed bool `protobuf:"varint,6,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// tombstoned is whether the validator is tombstoned for double signing.
+	Tombstoned bool `protobuf:"varint,7,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// score is uptime * (1 - commission_rate) / (1 + slash_count), or zero if
+	// the validator is jailed, tombstoned or not bonded.
+	Score cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=score,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"score"`
+	// flag_reasons lists why the validator is flagged, it is empty if the
+	// validator is not flagged.
+	FlagReasons []string `protobuf:"bytes,9,rep,name=flag_reasons,json=flagReasons,proto3" json:"flag_reasons,omitempty" yaml:"flag_reasons"`
+}
+
+func (m *ValidatorScore) Reset()         { *m = ValidatorScore{} }
+func (m *ValidatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScore) ProtoMessage()    {}
+func (*ValidatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1c846861b49d50b, []int{2}
+}
+func (m *ValidatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScore.Merge(m, src)
+}
+func (m *ValidatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorScore proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ValidatorPreference)(nil), "osmosis.valsetpref.v1beta1.ValidatorPreference")
 	proto.RegisterType((*ValidatorSetPreferences)(nil), "osmosis.valsetpref.v1beta1.ValidatorSetPreferences")
+	proto.RegisterType((*ValidatorScore)(nil), "osmosis.valsetpref.v1beta1.ValidatorScore")
 }
 
 func init() {
@@ -123,30 +183,41 @@ func init() {
 }
 
 var fileDescriptor_f1c846861b49d50b = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x14, 0xc6, 0x93, 0x5e, 0x28, 0xdc, 0x14, 0x2e, 0x97, 0xdc, 0x0b, 0x2d, 0xa9, 0x24, 0x25, 0x82,
-	0x74, 0xd3, 0x19, 0x5a, 0x41, 0x41, 0x57, 0x16, 0xdd, 0x09, 0x4a, 0x45, 0x17, 0x6e, 0xca, 0x49,
-	0x72, 0x9a, 0x06, 0x93, 0x4c, 0x98, 0x19, 0xa3, 0x7d, 0x03, 0x97, 0x6e, 0x7d, 0xa3, 0x2e, 0xbb,
-	0x14, 0x17, 0x41, 0xdb, 0x37, 0xe8, 0x13, 0x48, 0x93, 0xd0, 0x16, 0xd1, 0xdd, 0xfc, 0xf9, 0x7d,
-	0x87, 0xef, 0x3b, 0x9f, 0xb6, 0xc7, 0x44, 0xc4, 0x44, 0x20, 0x68, 0x0a, 0xa1, 0x40, 0x99, 0x70,
-	0x1c, 0xd1, 0xb4, 0xeb, 0xa0, 0x84, 0x2e, 0x15, 0x12, 0x24, 0x92, 0x84, 0x33, 0xc9, 0x74, 0xa3,
-	0xe4, 0xc8, 0x86, 0x23, 0x25, 0x67, 0xfc, 0xf7, 0x99, 0xcf, 0x72, 0x8c, 0xae, 0x4e, 0x85, 0xc2,
-	0xd8, 0xf1, 0x19, 0xf3, 0x43, 0xa4, 0x90, 0x04, 0x14, 0xe2, 0x98, 0x49, 0x90, 0x01, 0x8b, 0x45,
-	0xf1, 0x6b, 0xbf, 0xa8, 0xda, 0xbf, 0x1b, 0x08, 0x03, 0x0f, 0x24, 0xe3, 0x97, 0x1c, 0x47, 0xc8,
-	0x31, 0x76, 0x51, 0x3f, 0xd3, 0xfe, 0xa6, 0x10, 0x0e, 0x59, 0x82, 0x7c, 0x08, 0x9e, 0xc7, 0x51,
-	0x88, 0x86, 0xda, 0x52, 0xdb, 0xbf, 0xfb, 0xcd, 0x65, 0x66, 0xd5, 0x27, 0x10, 0x85, 0x47, 0xf6,
-	0x57, 0xc2, 0x1e, 0xfc, 0x49, 0x21, 0xbc, 0x48, 0x90, 0x9f, 0x14, 0x0f, 0xfa, 0xb1, 0x56, 0x7d,
-	0xc0, 0xc0, 0x1f, 0xcb, 0x46, 0x25, 0x17, 0xef, 0x4e, 0x33, 0x4b, 0x79, 0xcb, 0xac, 0xa6, 0x9b,
-	0xe7, 0x10, 0xde, 0x1d, 0x09, 0x18, 0x8d, 0x40, 0x8e, 0xc9, 0x39, 0xfa, 0xe0, 0x4e, 0x4e, 0xd1,
-	0x1d, 0x94, 0x12, 0xfb, 0x49, 0xd5, 0xea, 0x6b, 0x6f, 0x57, 0x28, 0x37, 0xf6, 0x84, 0x1e, 0x69,
-	0xb5, 0x64, 0x73, 0x6d, 0x54, 0x5a, 0xbf, 0xda, 0xb5, 0x1e, 0x25, 0x3f, 0x6f, 0x87, 0x7c, 0x93,
-	0xb2, 0x6f, 0xac, 0xec, 0x2c, 0x33, 0x4b, 0x2f, 0xf2, 0x6c, 0x4d, 0xb4, 0x07, 0xdb, 0xf3, 0xfb,
-	0xd7, 0xd3, 0x0f, 0x53, 0x99, 0xce, 0x4d, 0x75, 0x36, 0x37, 0xd5, 0xf7, 0xb9, 0xa9, 0x3e, 0x2f,
-	0x4c, 0x65, 0xb6, 0x30, 0x95, 0xd7, 0x85, 0xa9, 0xdc, 0x1e, 0xfa, 0x81, 0x1c, 0xdf, 0x3b, 0xc4,
-	0x65, 0x11, 0x2d, 0x1d, 0x74, 0x42, 0x70, 0x04, 0x5d, 0x97, 0xda, 0x3b, 0xa0, 0x8f, 0x65, 0xb5,
-	0x9d, 0xbc, 0x5b, 0x39, 0x49, 0x50, 0x38, 0xd5, 0xbc, 0x84, 0xfd, 0xcf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x42, 0x68, 0xfa, 0xec, 0xfe, 0x01, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0x4f, 0x8b, 0xd3, 0x5e,
+	0x14, 0x6d, 0xa6, 0x9d, 0xfe, 0xa6, 0xaf, 0x3f, 0xaa, 0x64, 0xa4, 0x0d, 0x1d, 0x49, 0x4a, 0x04,
+	0xe9, 0x66, 0xf2, 0x98, 0x11, 0x1c, 0x1c, 0x71, 0x61, 0xd5, 0x9d, 0xa0, 0x44, 0x74, 0xe1, 0xa6,
+	0xbc, 0x26, 0xb7, 0xe9, 0xd3, 0x24, 0x37, 0xbc, 0xf7, 0x5a, 0xed, 0x37, 0x70, 0xe9, 0xd6, 0xa5,
+	0xdf, 0xa6, 0xcb, 0x59, 0x8a, 0x8b, 0xa0, 0xed, 0x37, 0xe8, 0x27, 0x90, 0xfc, 0x61, 0x52, 0x06,
+	0x85, 0x71, 0xe7, 0x2e, 0xe7, 0xde, 0x73, 0x0e, 0xe7, 0xde, 0xbc, 0x4b, 0xee, 0xa2, 0x8c, 0x50,
+	0x72, 0x49, 0x17, 0x2c, 0x94, 0xa0, 0x12, 0x01, 0x53, 0xba, 0x38, 0x99, 0x80, 0x62, 0x27, 0x54,
+	0x2a, 0xa6, 0xc0, 0x49, 0x04, 0x2a, 0xd4, 0xfb, 0x25, 0xcf, 0xa9, 0x78, 0x4e, 0xc9, 0xeb, 0xdf,
+	0x0a, 0x30, 0xc0, 0x9c, 0x46, 0xb3, 0xaf, 0x42, 0xd1, 0xbf, 0x1d, 0x20, 0x06, 0x21, 0x50, 0x96,
+	0x70, 0xca, 0xe2, 0x18, 0x15, 0x53, 0x1c, 0x63, 0x59, 0x74, 0xed, 0x2f, 0x1a, 0x39, 0x7c, 0xc3,
+	0x42, 0xee, 0x33, 0x85, 0xe2, 0xa5, 0x80, 0x29, 0x08, 0x88, 0x3d, 0xd0, 0x9f, 0x91, 0x9b, 0x0b,
+	0x16, 0x8e, 0x31, 0x01, 0x31, 0x66, 0xbe, 0x2f, 0x40, 0x4a, 0x43, 0x1b, 0x68, 0xc3, 0xd6, 0xe8,
+	0x68, 0x9b, 0x5a, 0xbd, 0x25, 0x8b, 0xc2, 0x73, 0xfb, 0x2a, 0xc3, 0x76, 0x3b, 0x0b, 0x16, 0xbe,
+	0x48, 0x40, 0x3c, 0x2e, 0x0a, 0xfa, 0x43, 0xd2, 0xfc, 0x00, 0x3c, 0x98, 0x29, 0x63, 0x2f, 0x17,
+	0xdf, 0x59, 0xa5, 0x56, 0xed, 0x7b, 0x6a, 0x1d, 0x79, 0xf9, 0x1c, 0xd2, 0x7f, 0xef, 0x70, 0xa4,
+	0x11, 0x53, 0x33, 0xe7, 0x39, 0x04, 0xcc, 0x5b, 0x3e, 0x05, 0xcf, 0x2d, 0x25, 0xf6, 0x27, 0x8d,
+	0xf4, 0x2e, 0xb3, 0xbd, 0x02, 0x55, 0xc5, 0x93, 0x7a, 0x44, 0xda, 0x49, 0x05, 0x8d, 0xbd, 0x41,
+	0x7d, 0xd8, 0x3e, 0xa5, 0xce, 0x9f, 0xb7, 0xe3, 0xfc, 0x66, 0xca, 0x51, 0x3f, 0x8b, 0xb3, 0x4d,
+	0x2d, 0xbd, 0x98, 0x67, 0xc7, 0xd1, 0x76, 0x77, 0xfd, 0xed, 0xaf, 0x0d, 0xd2, 0xa9, 0xa2, 0x78,
+	0x28, 0xfe, 0x89, 0x0d, 0x65, 0xe2, 0x79, 0xa2, 0x78, 0x04, 0x46, 0xfd, 0x2f, 0xc4, 0x85, 0x44,
+	0x9f, 0x92, 0x1b, 0x1e, 0x46, 0x11, 0x97, 0x92, 0x63, 0x3c, 0x16, 0x4c, 0x81, 0xd1, 0xc8, 0x5d,
+	0x1e, 0x5d, 0xc3, 0x65, 0x9b, 0x5a, 0xdd, 0x62, 0xc4, 0x2b, 0x1e, 0xb6, 0xdb, 0xa9, 0x2a, 0x2e,
+	0x53, 0xa0, 0x9f, 0x91, 0xb6, 0x0c, 0x99, 0x9c, 0x8d, 0x3d, 0x9c, 0xc7, 0xca, 0xd8, 0x1f, 0x68,
+	0xc3, 0xc6, 0xa8, 0x5b, 0x6d, 0x7d, 0xa7, 0x69, 0xbb, 0x24, 0x47, 0x4f, 0x32, 0xa0, 0x77, 0x49,
+	0xf3, 0x1d, 0xe3, 0x21, 0xf8, 0x46, 0x73, 0xa0, 0x0d, 0x0f, 0xdc, 0x12, 0xe9, 0x26, 0x21, 0x0a,
+	0xa3, 0x89, 0x54, 0x18, 0x83, 0x6f, 0xfc, 0x97, 0xf7, 0x76, 0x2a, 0xfa, 0x03, 0xb2, 0x2f, 0xb3,
+	0x5f, 0x64, 0x1c, 0x5c, 0x7f, 0x29, 0x85, 0x42, 0x3f, 0x27, 0xff, 0x4f, 0x43, 0x16, 0x8c, 0x05,
+	0x30, 0x89, 0xb1, 0x34, 0x5a, 0x83, 0xfa, 0xb0, 0x35, 0xea, 0x6d, 0x53, 0xeb, 0xb0, 0x08, 0xbb,
+	0xdb, 0xb5, 0xdd, 0x76, 0x06, 0xdd, 0x02, 0x8d, 0x5e, 0xaf, 0x7e, 0x9a, 0xb5, 0xd5, 0xda, 0xd4,
+	0x2e, 0xd6, 0xa6, 0xf6, 0x63, 0x6d, 0x6a, 0x9f, 0x37, 0x66, 0xed, 0x62, 0x63, 0xd6, 0xbe, 0x6d,
+	0xcc, 0xda, 0xdb, 0xb3, 0x80, 0xab, 0xd9, 0x7c, 0xe2, 0x78, 0x18, 0xd1, 0xf2, 0x95, 0x1e, 0x87,
+	0x6c, 0x22, 0xe9, 0xe5, 0xe1, 0x9f, 0xde, 0xa7, 0x1f, 0xcb, 0xf3, 0x3f, 0xce, 0xef, 0x5f, 0x2d,
+	0x13, 0x90, 0x93, 0x66, 0x7e, 0xa8, 0xf7, 0x7e, 0x0d, 0x00, 0x45, 0x1b, 0xb2, 0x90, 0x22, 0x04,
+	0x00, 0x00,
 }
 
 func (m *ValidatorPreference) Marshal() (dAtA []byte, err error) {
@@ -226,6 +297,110 @@ func (m *ValidatorSetPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlagReasons) > 0 {
+		for iNdEx := len(m.FlagReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FlagReasons[iNdEx])
+			copy(dAtA[i:], m.FlagReasons[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.FlagReasons[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SlashCount != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValOperAddress) > 0 {
+		i -= len(m.ValOperAddress)
+		copy(dAtA[i:], m.ValOperAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ValOperAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -267,6 +442,42 @@ func (m *ValidatorSetPreferences) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValOperAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Uptime.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.SlashCount != 0 {
+		n += 1 + sovState(uint64(m.SlashCount))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovState(uint64(l))
+	if len(m.FlagReasons) > 0 {
+		for _, s := range m.FlagReasons {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -473,6 +684,315 @@ func (m *ValidatorSetPreferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValOperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValOperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+			}
+			m.SlashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlagReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlagReasons = append(m.FlagReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
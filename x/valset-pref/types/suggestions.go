@@ -0,0 +1,25 @@
+package types
+
+import "github.com/osmosis-labs/osmosis/osmomath"
+
+var (
+	// MinSuggestedUptime is the uptime below which a validator is flagged.
+	MinSuggestedUptime = osmomath.NewDecWithPrec(95, 2)
+	// MaxSuggestedCommissionRate is the commission rate above which a validator is flagged.
+	MaxSuggestedCommissionRate = osmomath.NewDecWithPrec(20, 2)
+)
+
+// reasons a validator is flagged for in its ValidatorScore.
+const (
+	FlagReasonJailed         = "jailed"
+	FlagReasonTombstoned     = "tombstoned"
+	FlagReasonNotBonded      = "not_bonded"
+	FlagReasonLowUptime      = "low_uptime"
+	FlagReasonHighCommission = "high_commission"
+	FlagReasonSlashed        = "slashed"
+)
+
+// IsFlagged returns whether the validator is flagged for any reason.
+func (s ValidatorScore) IsFlagged() bool {
+	return len(s.FlagReasons) > 0
+}